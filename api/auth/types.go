@@ -509,6 +509,50 @@ type Statement struct {
 	Resources []string
 	// Effect indicates action on the resource is allowed or not, can be "allow" or "deny"
 	Effect Effect
+	// Conditions restrict the objects the statement applies to, all of them
+	// must be satisfied.
+	// +optional
+	Conditions []Condition
+}
+
+// ConditionKey is the attribute of the requested object evaluated by a condition.
+type ConditionKey string
+
+const (
+	// ConditionCluster is the name of the cluster.
+	ConditionCluster ConditionKey = "cluster"
+	// ConditionNamespace is the namespace in the cluster.
+	ConditionNamespace ConditionKey = "namespace"
+	// ConditionProject is the name of the business project.
+	ConditionProject ConditionKey = "project"
+	// ConditionChartGroup is the name of the chart group, for chart groups and
+	// the charts in them.
+	ConditionChartGroup ConditionKey = "chartgroup"
+)
+
+// ConditionOperator is the operator of a condition.
+type ConditionOperator string
+
+const (
+	// ConditionIn requires the attribute is one of the values.
+	ConditionIn ConditionOperator = "In"
+	// ConditionNotIn requires the attribute is none of the values, or absent.
+	ConditionNotIn ConditionOperator = "NotIn"
+	// ConditionLike requires the attribute matches one of the wildcard patterns.
+	ConditionLike ConditionOperator = "Like"
+	// ConditionNotLike requires the attribute matches none of the wildcard
+	// patterns, or absent.
+	ConditionNotLike ConditionOperator = "NotLike"
+)
+
+// Condition restricts the requested objects by an attribute, such as the
+// cluster or namespace of the object.
+type Condition struct {
+	Key      ConditionKey
+	Operator ConditionOperator
+	// Values are the names, or the patterns where * matches any sequence of
+	// characters and ? matches a single character.
+	Values []string
 }
 
 // PolicyStatus represents information about the status of a policy.
@@ -611,6 +655,19 @@ type CustomPolicyBindingSpec struct {
 	RulePrefix string
 	Users      []Subject
 	Groups     []Subject
+	// Reason records why the access is granted, it is required for expiring bindings.
+	// +optional
+	Reason string
+	// ExpireTime is the time after which the binding is revoked automatically.
+	// The binding never expires if not set.
+	// +optional
+	ExpireTime *metav1.Time
+	// Granter is the user who creates the binding.
+	// +optional
+	Granter string
+	// Extender is the user who last extends or removes the expire time of the binding.
+	// +optional
+	Extender string
 }
 
 // CustomPolicyBindingStatus represents information about the status of a CustomPolicyBinding.
@@ -635,7 +692,7 @@ const (
 r = sub, dom, obj, act
 
 [policy_definition]
-p = sub, dom, obj, act, eft
+p = sub, dom, obj, act, eft, cond
 
 [role_definition]
 g = _, _, _
@@ -644,7 +701,7 @@ g = _, _, _
 e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
 
 [matchers]
-m = g(r.sub, p.sub, r.dom) && keyMatchCustom(r.obj, p.obj) && keyMatchCustom(r.act, p.act)
+m = g(r.sub, p.sub, r.dom) && keyMatchCustom(r.obj, p.obj) && keyMatchCustom(r.act, p.act) && conditionMatch(r.obj, p.cond)
 `
 )
 
@@ -518,6 +518,50 @@ type Statement struct {
 	Resources []string `json:"resources" protobuf:"bytes,2,rep,name=resources"`
 	// Effect indicates action on the resource is allowed or not, can be "allow" or "deny"
 	Effect Effect `json:"effect" protobuf:"bytes,3,opt,name=effect,casttype=Effect"`
+	// Conditions restrict the objects the statement applies to, all of them
+	// must be satisfied.
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty" protobuf:"bytes,4,rep,name=conditions"`
+}
+
+// ConditionKey is the attribute of the requested object evaluated by a condition.
+type ConditionKey string
+
+const (
+	// ConditionCluster is the name of the cluster.
+	ConditionCluster ConditionKey = "cluster"
+	// ConditionNamespace is the namespace in the cluster.
+	ConditionNamespace ConditionKey = "namespace"
+	// ConditionProject is the name of the business project.
+	ConditionProject ConditionKey = "project"
+	// ConditionChartGroup is the name of the chart group, for chart groups and
+	// the charts in them.
+	ConditionChartGroup ConditionKey = "chartgroup"
+)
+
+// ConditionOperator is the operator of a condition.
+type ConditionOperator string
+
+const (
+	// ConditionIn requires the attribute is one of the values.
+	ConditionIn ConditionOperator = "In"
+	// ConditionNotIn requires the attribute is none of the values, or absent.
+	ConditionNotIn ConditionOperator = "NotIn"
+	// ConditionLike requires the attribute matches one of the wildcard patterns.
+	ConditionLike ConditionOperator = "Like"
+	// ConditionNotLike requires the attribute matches none of the wildcard
+	// patterns, or absent.
+	ConditionNotLike ConditionOperator = "NotLike"
+)
+
+// Condition restricts the requested objects by an attribute, such as the
+// cluster or namespace of the object.
+type Condition struct {
+	Key      ConditionKey      `json:"key" protobuf:"bytes,1,opt,name=key,casttype=ConditionKey"`
+	Operator ConditionOperator `json:"operator" protobuf:"bytes,2,opt,name=operator,casttype=ConditionOperator"`
+	// Values are the names, or the patterns where * matches any sequence of
+	// characters and ? matches a single character.
+	Values []string `json:"values" protobuf:"bytes,3,rep,name=values"`
 }
 
 // PolicyStatus represents information about the status of a policy.
@@ -605,6 +649,19 @@ type CustomPolicyBindingSpec struct {
 	RulePrefix string          `json:"rulePrefix" protobuf:"bytes,7,opt,name=rulePrefix"`
 	Users      []Subject       `json:"users" protobuf:"bytes,8,rep,name=users"`
 	Groups     []Subject       `json:"groups" protobuf:"bytes,9,rep,name=groups"`
+	// Reason records why the access is granted, it is required for expiring bindings.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,10,opt,name=reason"`
+	// ExpireTime is the time after which the binding is revoked automatically.
+	// The binding never expires if not set.
+	// +optional
+	ExpireTime *metav1.Time `json:"expireTime,omitempty" protobuf:"bytes,11,opt,name=expireTime"`
+	// Granter is the user who creates the binding.
+	// +optional
+	Granter string `json:"granter,omitempty" protobuf:"bytes,12,opt,name=granter"`
+	// Extender is the user who last extends or removes the expire time of the binding.
+	// +optional
+	Extender string `json:"extender,omitempty" protobuf:"bytes,13,opt,name=extender"`
 }
 
 // CustomPolicyBindingStatus represents information about the status of a CustomPolicyBinding.
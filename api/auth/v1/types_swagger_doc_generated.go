@@ -199,6 +199,15 @@ func (ClientSpec) SwaggerDoc() map[string]string {
 	return map_ClientSpec
 }
 
+var map_Condition = map[string]string{
+	"":       "Condition restricts the requested objects by an attribute, such as the cluster or namespace of the object.",
+	"values": "Values are the names, or the patterns where * matches any sequence of characters and ? matches a single character.",
+}
+
+func (Condition) SwaggerDoc() map[string]string {
+	return map_Condition
+}
+
 var map_ConfigMap = map[string]string{
 	"":           "ConfigMap holds configuration data for tke to consume.",
 	"data":       "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
@@ -236,7 +245,11 @@ func (CustomPolicyBindingList) SwaggerDoc() map[string]string {
 }
 
 var map_CustomPolicyBindingSpec = map[string]string{
-	"": "CustomPolicyBindingSpec defines the desired identities of CustomPolicyBindingSpec document in this set.",
+	"":           "CustomPolicyBindingSpec defines the desired identities of CustomPolicyBindingSpec document in this set.",
+	"reason":     "Reason records why the access is granted, it is required for expiring bindings.",
+	"expireTime": "ExpireTime is the time after which the binding is revoked automatically. The binding never expires if not set.",
+	"granter":    "Granter is the user who creates the binding.",
+	"extender":   "Extender is the user who last extends or removes the expire time of the binding.",
 }
 
 func (CustomPolicyBindingSpec) SwaggerDoc() map[string]string {
@@ -596,8 +609,9 @@ func (RuleSpec) SwaggerDoc() map[string]string {
 }
 
 var map_Statement = map[string]string{
-	"":       "Statement defines a series of action on resource can be done or not.",
-	"effect": "Effect indicates action on the resource is allowed or not, can be \"allow\" or \"deny\"",
+	"":           "Statement defines a series of action on resource can be done or not.",
+	"effect":     "Effect indicates action on the resource is allowed or not, can be \"allow\" or \"deny\"",
+	"conditions": "Conditions restrict the objects the statement applies to, all of them must be satisfied.",
 }
 
 func (Statement) SwaggerDoc() map[string]string {
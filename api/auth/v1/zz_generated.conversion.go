@@ -25,6 +25,7 @@ package v1
 import (
 	unsafe "unsafe"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	auth "tkestack.io/tke/api/auth"
@@ -207,6 +208,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Condition)(nil), (*auth.Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Condition_To_auth_Condition(a.(*Condition), b.(*auth.Condition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*auth.Condition)(nil), (*Condition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_auth_Condition_To_v1_Condition(a.(*auth.Condition), b.(*Condition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConfigMap)(nil), (*auth.ConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ConfigMap_To_auth_ConfigMap(a.(*ConfigMap), b.(*auth.ConfigMap), scope)
 	}); err != nil {
@@ -1158,6 +1169,30 @@ func Convert_auth_ClientSpec_To_v1_ClientSpec(in *auth.ClientSpec, out *ClientSp
 	return autoConvert_auth_ClientSpec_To_v1_ClientSpec(in, out, s)
 }
 
+func autoConvert_v1_Condition_To_auth_Condition(in *Condition, out *auth.Condition, s conversion.Scope) error {
+	out.Key = auth.ConditionKey(in.Key)
+	out.Operator = auth.ConditionOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1_Condition_To_auth_Condition is an autogenerated conversion function.
+func Convert_v1_Condition_To_auth_Condition(in *Condition, out *auth.Condition, s conversion.Scope) error {
+	return autoConvert_v1_Condition_To_auth_Condition(in, out, s)
+}
+
+func autoConvert_auth_Condition_To_v1_Condition(in *auth.Condition, out *Condition, s conversion.Scope) error {
+	out.Key = ConditionKey(in.Key)
+	out.Operator = ConditionOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_auth_Condition_To_v1_Condition is an autogenerated conversion function.
+func Convert_auth_Condition_To_v1_Condition(in *auth.Condition, out *Condition, s conversion.Scope) error {
+	return autoConvert_auth_Condition_To_v1_Condition(in, out, s)
+}
+
 func autoConvert_v1_ConfigMap_To_auth_ConfigMap(in *ConfigMap, out *auth.ConfigMap, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
@@ -1268,6 +1303,10 @@ func autoConvert_v1_CustomPolicyBindingSpec_To_auth_CustomPolicyBindingSpec(in *
 	out.RulePrefix = in.RulePrefix
 	out.Users = *(*[]auth.Subject)(unsafe.Pointer(&in.Users))
 	out.Groups = *(*[]auth.Subject)(unsafe.Pointer(&in.Groups))
+	out.Reason = in.Reason
+	out.ExpireTime = (*metav1.Time)(unsafe.Pointer(in.ExpireTime))
+	out.Granter = in.Granter
+	out.Extender = in.Extender
 	return nil
 }
 
@@ -1286,6 +1325,10 @@ func autoConvert_auth_CustomPolicyBindingSpec_To_v1_CustomPolicyBindingSpec(in *
 	out.RulePrefix = in.RulePrefix
 	out.Users = *(*[]Subject)(unsafe.Pointer(&in.Users))
 	out.Groups = *(*[]Subject)(unsafe.Pointer(&in.Groups))
+	out.Reason = in.Reason
+	out.ExpireTime = (*metav1.Time)(unsafe.Pointer(in.ExpireTime))
+	out.Granter = in.Granter
+	out.Extender = in.Extender
 	return nil
 }
 
@@ -2344,6 +2387,7 @@ func autoConvert_v1_Statement_To_auth_Statement(in *Statement, out *auth.Stateme
 	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
 	out.Effect = auth.Effect(in.Effect)
+	out.Conditions = *(*[]auth.Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -2356,6 +2400,7 @@ func autoConvert_auth_Statement_To_v1_Statement(in *auth.Statement, out *Stateme
 	out.Actions = *(*[]string)(unsafe.Pointer(&in.Actions))
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
 	out.Effect = Effect(in.Effect)
+	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
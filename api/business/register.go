@@ -83,6 +83,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+
+		&NamespaceRequest{},
+		&NamespaceRequestList{},
 	)
 	return nil
 }
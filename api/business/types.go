@@ -578,3 +578,95 @@ const (
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +genclient:method=UpdateApproval,verb=update,subresource=approval
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceRequest is a namespace requested by a developer of a project, which
+// is created after it is approved by the project administrators.
+type NamespaceRequest struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of namespace requests in this set.
+	// +optional
+	Spec NamespaceRequestSpec
+	// +optional
+	Status NamespaceRequestStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceRequestList is the whole list of all namespace requests which owned by a tenant.
+type NamespaceRequestList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of namespace requests
+	Items []NamespaceRequest
+}
+
+// NamespaceRequestSpec represents a namespace requested by a developer of a project.
+type NamespaceRequestSpec struct {
+	TenantID    string
+	ClusterName string
+	Namespace   string
+	// Template is the name of the namespace template which describes the size of
+	// namespace, the template resources are overridden by Hard.
+	// +optional
+	Template string
+	// Hard represents the total resources of the requested namespace.
+	// +optional
+	Hard ResourceList
+	// Reason describes why the namespace is requested.
+	// +optional
+	Reason string
+	// Requester is the user who requests the namespace, which is set by server.
+	// +optional
+	Requester string
+}
+
+// NamespaceRequestStatus represents information about the status of a namespace request.
+type NamespaceRequestStatus struct {
+	// +optional
+	Phase NamespaceRequestPhase
+	// Approver is the user who approves or rejects the request.
+	// +optional
+	Approver string
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string
+	// NamespaceName is the name of the business namespace created for the request.
+	// +optional
+	NamespaceName string
+	// LastNotifyTime is the time when the requester was notified of the result.
+	// +optional
+	LastNotifyTime metav1.Time
+}
+
+// NamespaceRequestPhase indicates the phase of namespace requests.
+type NamespaceRequestPhase string
+
+// These are valid phases of namespace requests.
+const (
+	// NamespaceRequestPending indicates that the request is waiting to be approved.
+	NamespaceRequestPending NamespaceRequestPhase = "Pending"
+	// NamespaceRequestApproved indicates that the request is approved and the
+	// namespace is being created.
+	NamespaceRequestApproved NamespaceRequestPhase = "Approved"
+	// NamespaceRequestRejected indicates that the request is rejected.
+	NamespaceRequestRejected NamespaceRequestPhase = "Rejected"
+	// NamespaceRequestCreated indicates that the namespace has been created.
+	NamespaceRequestCreated NamespaceRequestPhase = "Created"
+	// NamespaceRequestFailed indicates that the namespace failed to be created.
+	NamespaceRequestFailed NamespaceRequestPhase = "Failed"
+)
@@ -31,6 +31,7 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForNamespace,
 		AddFieldLabelConversionsForImageNamespace,
 		AddFieldLabelConversionsForChartGroup,
+		AddFieldLabelConversionsForNamespaceRequest,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -127,3 +128,23 @@ func AddFieldLabelConversionsForChartGroup(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForNamespaceRequest adds a conversion function to convert
+// field selectors of NamespaceRequest from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForNamespaceRequest(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("NamespaceRequest"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.namespace",
+				"spec.requester",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
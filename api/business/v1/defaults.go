@@ -88,3 +88,9 @@ func SetDefaults_NsEmigrationStatus(obj *NsEmigrationStatus) {
 		obj.Phase = NsEmigrationPending
 	}
 }
+
+func SetDefaults_NamespaceRequestStatus(obj *NamespaceRequestStatus) {
+	if obj.Phase == "" {
+		obj.Phase = NamespaceRequestPending
+	}
+}
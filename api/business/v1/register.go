@@ -71,6 +71,9 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 
 		&NsEmigration{},
 		&NsEmigrationList{},
+
+		&NamespaceRequest{},
+		&NamespaceRequestList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
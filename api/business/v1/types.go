@@ -582,3 +582,95 @@ const (
 	// NsEmigrationFailed indicates that the emigration failed.
 	NsEmigrationFailed NsEmigrationPhase = "Failed"
 )
+
+// +genclient
+// +genclient:method=UpdateApproval,verb=update,subresource=approval
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceRequest is a namespace requested by a developer of a project, which
+// is created after it is approved by the project administrators.
+type NamespaceRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of namespace requests in this set.
+	// +optional
+	Spec NamespaceRequestSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status NamespaceRequestStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// NamespaceRequestList is the whole list of all namespace requests which owned by a tenant.
+type NamespaceRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of namespace requests
+	Items []NamespaceRequest `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// NamespaceRequestSpec represents a namespace requested by a developer of a project.
+type NamespaceRequestSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Namespace   string `json:"namespace" protobuf:"bytes,3,opt,name=namespace"`
+	// Template is the name of the namespace template which describes the size of
+	// namespace, the template resources are overridden by Hard.
+	// +optional
+	Template string `json:"template,omitempty" protobuf:"bytes,4,opt,name=template"`
+	// Hard represents the total resources of the requested namespace.
+	// +optional
+	Hard ResourceList `json:"hard,omitempty" protobuf:"bytes,5,rep,name=hard,casttype=ResourceList"`
+	// Reason describes why the namespace is requested.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+	// Requester is the user who requests the namespace, which is set by server.
+	// +optional
+	Requester string `json:"requester,omitempty" protobuf:"bytes,7,opt,name=requester"`
+}
+
+// NamespaceRequestStatus represents information about the status of a namespace request.
+type NamespaceRequestStatus struct {
+	// +optional
+	Phase NamespaceRequestPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=NamespaceRequestPhase"`
+	// Approver is the user who approves or rejects the request.
+	// +optional
+	Approver string `json:"approver,omitempty" protobuf:"bytes,2,opt,name=approver"`
+	// The last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+	// The reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,4,opt,name=reason"`
+	// A human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// NamespaceName is the name of the business namespace created for the request.
+	// +optional
+	NamespaceName string `json:"namespaceName,omitempty" protobuf:"bytes,6,opt,name=namespaceName"`
+	// LastNotifyTime is the time when the requester was notified of the result.
+	// +optional
+	LastNotifyTime metav1.Time `json:"lastNotifyTime,omitempty" protobuf:"bytes,7,opt,name=lastNotifyTime"`
+}
+
+// NamespaceRequestPhase indicates the phase of namespace requests.
+type NamespaceRequestPhase string
+
+// These are valid phases of namespace requests.
+const (
+	// NamespaceRequestPending indicates that the request is waiting to be approved.
+	NamespaceRequestPending NamespaceRequestPhase = "Pending"
+	// NamespaceRequestApproved indicates that the request is approved and the
+	// namespace is being created.
+	NamespaceRequestApproved NamespaceRequestPhase = "Approved"
+	// NamespaceRequestRejected indicates that the request is rejected.
+	NamespaceRequestRejected NamespaceRequestPhase = "Rejected"
+	// NamespaceRequestCreated indicates that the namespace has been created.
+	NamespaceRequestCreated NamespaceRequestPhase = "Created"
+	// NamespaceRequestFailed indicates that the namespace failed to be created.
+	NamespaceRequestFailed NamespaceRequestPhase = "Failed"
+)
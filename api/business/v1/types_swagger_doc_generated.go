@@ -167,6 +167,50 @@ func (NamespaceList) SwaggerDoc() map[string]string {
 	return map_NamespaceList
 }
 
+var map_NamespaceRequest = map[string]string{
+	"":     "NamespaceRequest is a namespace request.",
+	"spec": "Spec defines the desired identities of namespace requests in this set.",
+}
+
+func (NamespaceRequest) SwaggerDoc() map[string]string {
+	return map_NamespaceRequest
+}
+
+var map_NamespaceRequestList = map[string]string{
+	"":      "NamespaceRequestList is the whole list of all namespace requests which owned by a tenant.",
+	"items": "List of namespace requests",
+}
+
+func (NamespaceRequestList) SwaggerDoc() map[string]string {
+	return map_NamespaceRequestList
+}
+
+var map_NamespaceRequestSpec = map[string]string{
+	"":          "NamespaceRequestSpec represents a namespace requested by a developer of a project.",
+	"template":  "Template is the name of the namespace template which describes the size of namespace, the template resources are overridden by Hard.",
+	"hard":      "Hard represents the total resources of the requested namespace.",
+	"reason":    "Reason describes why the namespace is requested.",
+	"requester": "Requester is the user who requests the namespace, which is set by server.",
+}
+
+func (NamespaceRequestSpec) SwaggerDoc() map[string]string {
+	return map_NamespaceRequestSpec
+}
+
+var map_NamespaceRequestStatus = map[string]string{
+	"":                   "NamespaceRequestStatus represents information about the status of a namespace request.",
+	"approver":           "Approver is the user who approves or rejects the request.",
+	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
+	"reason":             "The reason for the condition's last transition.",
+	"message":            "A human readable message indicating details about the transition.",
+	"namespaceName":      "NamespaceName is the name of the business namespace created for the request.",
+	"lastNotifyTime":     "LastNotifyTime is the time when the requester was notified of the result.",
+}
+
+func (NamespaceRequestStatus) SwaggerDoc() map[string]string {
+	return map_NamespaceRequestStatus
+}
+
 var map_NamespaceSpec = map[string]string{
 	"":           "NamespaceSpec represents a namespace in cluster of a project.",
 	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
@@ -187,6 +187,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceRequest)(nil), (*business.NamespaceRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceRequest_To_business_NamespaceRequest(a.(*NamespaceRequest), b.(*business.NamespaceRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceRequest)(nil), (*NamespaceRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceRequest_To_v1_NamespaceRequest(a.(*business.NamespaceRequest), b.(*NamespaceRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceRequestList)(nil), (*business.NamespaceRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceRequestList_To_business_NamespaceRequestList(a.(*NamespaceRequestList), b.(*business.NamespaceRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceRequestList)(nil), (*NamespaceRequestList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceRequestList_To_v1_NamespaceRequestList(a.(*business.NamespaceRequestList), b.(*NamespaceRequestList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceRequestSpec)(nil), (*business.NamespaceRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec(a.(*NamespaceRequestSpec), b.(*business.NamespaceRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceRequestSpec)(nil), (*NamespaceRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec(a.(*business.NamespaceRequestSpec), b.(*NamespaceRequestSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceRequestStatus)(nil), (*business.NamespaceRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus(a.(*NamespaceRequestStatus), b.(*business.NamespaceRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*business.NamespaceRequestStatus)(nil), (*NamespaceRequestStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus(a.(*business.NamespaceRequestStatus), b.(*NamespaceRequestStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamespaceSpec)(nil), (*business.NamespaceSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_NamespaceSpec_To_business_NamespaceSpec(a.(*NamespaceSpec), b.(*business.NamespaceSpec), scope)
 	}); err != nil {
@@ -728,6 +768,124 @@ func Convert_business_NamespaceList_To_v1_NamespaceList(in *business.NamespaceLi
 	return autoConvert_business_NamespaceList_To_v1_NamespaceList(in, out, s)
 }
 
+func autoConvert_v1_NamespaceRequest_To_business_NamespaceRequest(in *NamespaceRequest, out *business.NamespaceRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_NamespaceRequest_To_business_NamespaceRequest is an autogenerated conversion function.
+func Convert_v1_NamespaceRequest_To_business_NamespaceRequest(in *NamespaceRequest, out *business.NamespaceRequest, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceRequest_To_business_NamespaceRequest(in, out, s)
+}
+
+func autoConvert_business_NamespaceRequest_To_v1_NamespaceRequest(in *business.NamespaceRequest, out *NamespaceRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_business_NamespaceRequest_To_v1_NamespaceRequest is an autogenerated conversion function.
+func Convert_business_NamespaceRequest_To_v1_NamespaceRequest(in *business.NamespaceRequest, out *NamespaceRequest, s conversion.Scope) error {
+	return autoConvert_business_NamespaceRequest_To_v1_NamespaceRequest(in, out, s)
+}
+
+func autoConvert_v1_NamespaceRequestList_To_business_NamespaceRequestList(in *NamespaceRequestList, out *business.NamespaceRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]business.NamespaceRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_NamespaceRequestList_To_business_NamespaceRequestList is an autogenerated conversion function.
+func Convert_v1_NamespaceRequestList_To_business_NamespaceRequestList(in *NamespaceRequestList, out *business.NamespaceRequestList, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceRequestList_To_business_NamespaceRequestList(in, out, s)
+}
+
+func autoConvert_business_NamespaceRequestList_To_v1_NamespaceRequestList(in *business.NamespaceRequestList, out *NamespaceRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]NamespaceRequest)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_business_NamespaceRequestList_To_v1_NamespaceRequestList is an autogenerated conversion function.
+func Convert_business_NamespaceRequestList_To_v1_NamespaceRequestList(in *business.NamespaceRequestList, out *NamespaceRequestList, s conversion.Scope) error {
+	return autoConvert_business_NamespaceRequestList_To_v1_NamespaceRequestList(in, out, s)
+}
+
+func autoConvert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec(in *NamespaceRequestSpec, out *business.NamespaceRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Template = in.Template
+	out.Hard = *(*business.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec is an autogenerated conversion function.
+func Convert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec(in *NamespaceRequestSpec, out *business.NamespaceRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceRequestSpec_To_business_NamespaceRequestSpec(in, out, s)
+}
+
+func autoConvert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec(in *business.NamespaceRequestSpec, out *NamespaceRequestSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	out.Template = in.Template
+	out.Hard = *(*ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Reason = in.Reason
+	out.Requester = in.Requester
+	return nil
+}
+
+// Convert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec is an autogenerated conversion function.
+func Convert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec(in *business.NamespaceRequestSpec, out *NamespaceRequestSpec, s conversion.Scope) error {
+	return autoConvert_business_NamespaceRequestSpec_To_v1_NamespaceRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus(in *NamespaceRequestStatus, out *business.NamespaceRequestStatus, s conversion.Scope) error {
+	out.Phase = business.NamespaceRequestPhase(in.Phase)
+	out.Approver = in.Approver
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.NamespaceName = in.NamespaceName
+	out.LastNotifyTime = in.LastNotifyTime
+	return nil
+}
+
+// Convert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus is an autogenerated conversion function.
+func Convert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus(in *NamespaceRequestStatus, out *business.NamespaceRequestStatus, s conversion.Scope) error {
+	return autoConvert_v1_NamespaceRequestStatus_To_business_NamespaceRequestStatus(in, out, s)
+}
+
+func autoConvert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus(in *business.NamespaceRequestStatus, out *NamespaceRequestStatus, s conversion.Scope) error {
+	out.Phase = NamespaceRequestPhase(in.Phase)
+	out.Approver = in.Approver
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.NamespaceName = in.NamespaceName
+	out.LastNotifyTime = in.LastNotifyTime
+	return nil
+}
+
+// Convert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus is an autogenerated conversion function.
+func Convert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus(in *business.NamespaceRequestStatus, out *NamespaceRequestStatus, s conversion.Scope) error {
+	return autoConvert_business_NamespaceRequestStatus_To_v1_NamespaceRequestStatus(in, out, s)
+}
+
 func autoConvert_v1_NamespaceSpec_To_business_NamespaceSpec(in *NamespaceSpec, out *business.NamespaceSpec, s conversion.Scope) error {
 	out.Finalizers = *(*[]business.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
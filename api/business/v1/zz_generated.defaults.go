@@ -38,6 +38,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ImageNamespaceList{}, func(obj interface{}) { SetObjectDefaults_ImageNamespaceList(obj.(*ImageNamespaceList)) })
 	scheme.AddTypeDefaultingFunc(&Namespace{}, func(obj interface{}) { SetObjectDefaults_Namespace(obj.(*Namespace)) })
 	scheme.AddTypeDefaultingFunc(&NamespaceList{}, func(obj interface{}) { SetObjectDefaults_NamespaceList(obj.(*NamespaceList)) })
+	scheme.AddTypeDefaultingFunc(&NamespaceRequest{}, func(obj interface{}) { SetObjectDefaults_NamespaceRequest(obj.(*NamespaceRequest)) })
+	scheme.AddTypeDefaultingFunc(&NamespaceRequestList{}, func(obj interface{}) { SetObjectDefaults_NamespaceRequestList(obj.(*NamespaceRequestList)) })
 	scheme.AddTypeDefaultingFunc(&NsEmigration{}, func(obj interface{}) { SetObjectDefaults_NsEmigration(obj.(*NsEmigration)) })
 	scheme.AddTypeDefaultingFunc(&NsEmigrationList{}, func(obj interface{}) { SetObjectDefaults_NsEmigrationList(obj.(*NsEmigrationList)) })
 	scheme.AddTypeDefaultingFunc(&Project{}, func(obj interface{}) { SetObjectDefaults_Project(obj.(*Project)) })
@@ -90,6 +92,17 @@ func SetObjectDefaults_NamespaceList(in *NamespaceList) {
 	}
 }
 
+func SetObjectDefaults_NamespaceRequest(in *NamespaceRequest) {
+	SetDefaults_NamespaceRequestStatus(&in.Status)
+}
+
+func SetObjectDefaults_NamespaceRequestList(in *NamespaceRequestList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_NamespaceRequest(a)
+	}
+}
+
 func SetObjectDefaults_NsEmigration(in *NsEmigration) {
 	SetDefaults_NsEmigrationStatus(&in.Status)
 }
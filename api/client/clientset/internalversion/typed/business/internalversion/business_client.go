@@ -31,6 +31,7 @@ type BusinessInterface interface {
 	ConfigMapsGetter
 	ImageNamespacesGetter
 	NamespacesGetter
+	NamespaceRequestsGetter
 	NsEmigrationsGetter
 	PlatformsGetter
 	PortalsGetter
@@ -58,6 +59,10 @@ func (c *BusinessClient) Namespaces(namespace string) NamespaceInterface {
 	return newNamespaces(c, namespace)
 }
 
+func (c *BusinessClient) NamespaceRequests(namespace string) NamespaceRequestInterface {
+	return newNamespaceRequests(c, namespace)
+}
+
 func (c *BusinessClient) NsEmigrations(namespace string) NsEmigrationInterface {
 	return newNsEmigrations(c, namespace)
 }
@@ -28,6 +28,8 @@ type ImageNamespaceExpansion interface{}
 
 type NamespaceExpansion interface{}
 
+type NamespaceRequestExpansion interface{}
+
 type NsEmigrationExpansion interface{}
 
 type PlatformExpansion interface{}
@@ -30,6 +30,10 @@ type FakeMonitor struct {
 	*testing.Fake
 }
 
+func (c *FakeMonitor) BlackboxProbes() internalversion.BlackboxProbeInterface {
+	return &FakeBlackboxProbes{c}
+}
+
 func (c *FakeMonitor) ClusterOverviews() internalversion.ClusterOverviewInterface {
 	return &FakeClusterOverviews{c}
 }
@@ -38,10 +42,22 @@ func (c *FakeMonitor) ConfigMaps() internalversion.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeMonitor) EventAlertPolicies() internalversion.EventAlertPolicyInterface {
+	return &FakeEventAlertPolicies{c}
+}
+
+func (c *FakeMonitor) FederatedQueries() internalversion.FederatedQueryInterface {
+	return &FakeFederatedQueries{c}
+}
+
 func (c *FakeMonitor) Metrics() internalversion.MetricInterface {
 	return &FakeMetrics{c}
 }
 
+func (c *FakeMonitor) ProjectUsages() internalversion.ProjectUsageInterface {
+	return &FakeProjectUsages{c}
+}
+
 func (c *FakeMonitor) Prometheuses() internalversion.PrometheusInterface {
 	return &FakePrometheuses{c}
 }
@@ -27,9 +27,13 @@ import (
 
 type MonitorInterface interface {
 	RESTClient() rest.Interface
+	BlackboxProbesGetter
 	ClusterOverviewsGetter
 	ConfigMapsGetter
+	EventAlertPoliciesGetter
+	FederatedQueriesGetter
 	MetricsGetter
+	ProjectUsagesGetter
 	PrometheusesGetter
 }
 
@@ -38,6 +42,10 @@ type MonitorClient struct {
 	restClient rest.Interface
 }
 
+func (c *MonitorClient) BlackboxProbes() BlackboxProbeInterface {
+	return newBlackboxProbes(c)
+}
+
 func (c *MonitorClient) ClusterOverviews() ClusterOverviewInterface {
 	return newClusterOverviews(c)
 }
@@ -46,10 +54,22 @@ func (c *MonitorClient) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *MonitorClient) EventAlertPolicies() EventAlertPolicyInterface {
+	return newEventAlertPolicies(c)
+}
+
+func (c *MonitorClient) FederatedQueries() FederatedQueryInterface {
+	return newFederatedQueries(c)
+}
+
 func (c *MonitorClient) Metrics() MetricInterface {
 	return newMetrics(c)
 }
 
+func (c *MonitorClient) ProjectUsages() ProjectUsageInterface {
+	return newProjectUsages(c)
+}
+
 func (c *MonitorClient) Prometheuses() PrometheusInterface {
 	return newPrometheuses(c)
 }
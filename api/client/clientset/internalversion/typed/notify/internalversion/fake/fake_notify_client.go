@@ -38,6 +38,14 @@ func (c *FakeNotify) ConfigMaps() internalversion.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeNotify) Escalations() internalversion.EscalationInterface {
+	return &FakeEscalations{c}
+}
+
+func (c *FakeNotify) EscalationPolicies() internalversion.EscalationPolicyInterface {
+	return &FakeEscalationPolicies{c}
+}
+
 func (c *FakeNotify) Messages() internalversion.MessageInterface {
 	return &FakeMessages{c}
 }
@@ -46,6 +54,10 @@ func (c *FakeNotify) MessageRequests(namespace string) internalversion.MessageRe
 	return &FakeMessageRequests{c, namespace}
 }
 
+func (c *FakeNotify) OnCallSchedules() internalversion.OnCallScheduleInterface {
+	return &FakeOnCallSchedules{c}
+}
+
 func (c *FakeNotify) Receivers() internalversion.ReceiverInterface {
 	return &FakeReceivers{c}
 }
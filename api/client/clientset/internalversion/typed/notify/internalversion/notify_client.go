@@ -29,8 +29,11 @@ type NotifyInterface interface {
 	RESTClient() rest.Interface
 	ChannelsGetter
 	ConfigMapsGetter
+	EscalationsGetter
+	EscalationPoliciesGetter
 	MessagesGetter
 	MessageRequestsGetter
+	OnCallSchedulesGetter
 	ReceiversGetter
 	ReceiverGroupsGetter
 	TemplatesGetter
@@ -49,6 +52,14 @@ func (c *NotifyClient) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *NotifyClient) Escalations() EscalationInterface {
+	return newEscalations(c)
+}
+
+func (c *NotifyClient) EscalationPolicies() EscalationPolicyInterface {
+	return newEscalationPolicies(c)
+}
+
 func (c *NotifyClient) Messages() MessageInterface {
 	return newMessages(c)
 }
@@ -57,6 +68,10 @@ func (c *NotifyClient) MessageRequests(namespace string) MessageRequestInterface
 	return newMessageRequests(c, namespace)
 }
 
+func (c *NotifyClient) OnCallSchedules() OnCallScheduleInterface {
+	return newOnCallSchedules(c)
+}
+
 func (c *NotifyClient) Receivers() ReceiverInterface {
 	return newReceivers(c)
 }
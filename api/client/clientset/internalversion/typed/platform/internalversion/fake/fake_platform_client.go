@@ -58,6 +58,34 @@ func (c *FakePlatform) CronHPAs() internalversion.CronHPAInterface {
 	return &FakeCronHPAs{c}
 }
 
+func (c *FakePlatform) EgressGateways() internalversion.EgressGatewayInterface {
+	return &FakeEgressGateways{c}
+}
+
+func (c *FakePlatform) MetalLBs() internalversion.MetalLBInterface {
+	return &FakeMetalLBs{c}
+}
+
+func (c *FakePlatform) Multuses() internalversion.MultusInterface {
+	return &FakeMultuses{c}
+}
+
+func (c *FakePlatform) DevicePlugins() internalversion.DevicePluginInterface {
+	return &FakeDevicePlugins{c}
+}
+
+func (c *FakePlatform) FloatingIPReservations() internalversion.FloatingIPReservationInterface {
+	return &FakeFloatingIPReservations{c}
+}
+
+func (c *FakePlatform) GPUNodeConfigs() internalversion.GPUNodeConfigInterface {
+	return &FakeGPUNodeConfigs{c}
+}
+
+func (c *FakePlatform) ClusterOperations() internalversion.ClusterOperationInterface {
+	return &FakeClusterOperations{c}
+}
+
 func (c *FakePlatform) Helms() internalversion.HelmInterface {
 	return &FakeHelms{c}
 }
@@ -70,6 +98,10 @@ func (c *FakePlatform) LBCFs() internalversion.LBCFInterface {
 	return &FakeLBCFs{c}
 }
 
+func (c *FakePlatform) Licenses() internalversion.LicenseInterface {
+	return &FakeLicenses{c}
+}
+
 func (c *FakePlatform) LogCollectors() internalversion.LogCollectorInterface {
 	return &FakeLogCollectors{c}
 }
@@ -34,9 +34,17 @@ type PlatformInterface interface {
 	ClusterCredentialsGetter
 	ConfigMapsGetter
 	CronHPAsGetter
+	EgressGatewaysGetter
+	MetalLBsGetter
+	MultusesGetter
+	DevicePluginsGetter
+	FloatingIPReservationsGetter
+	GPUNodeConfigsGetter
+	ClusterOperationsGetter
 	HelmsGetter
 	IPAMsGetter
 	LBCFsGetter
+	LicensesGetter
 	LogCollectorsGetter
 	MachinesGetter
 	PersistentEventsGetter
@@ -79,6 +87,34 @@ func (c *PlatformClient) CronHPAs() CronHPAInterface {
 	return newCronHPAs(c)
 }
 
+func (c *PlatformClient) EgressGateways() EgressGatewayInterface {
+	return newEgressGateways(c)
+}
+
+func (c *PlatformClient) MetalLBs() MetalLBInterface {
+	return newMetalLBs(c)
+}
+
+func (c *PlatformClient) Multuses() MultusInterface {
+	return newMultuses(c)
+}
+
+func (c *PlatformClient) DevicePlugins() DevicePluginInterface {
+	return newDevicePlugins(c)
+}
+
+func (c *PlatformClient) FloatingIPReservations() FloatingIPReservationInterface {
+	return newFloatingIPReservations(c)
+}
+
+func (c *PlatformClient) GPUNodeConfigs() GPUNodeConfigInterface {
+	return newGPUNodeConfigs(c)
+}
+
+func (c *PlatformClient) ClusterOperations() ClusterOperationInterface {
+	return newClusterOperations(c)
+}
+
 func (c *PlatformClient) Helms() HelmInterface {
 	return newHelms(c)
 }
@@ -91,6 +127,10 @@ func (c *PlatformClient) LBCFs() LBCFInterface {
 	return newLBCFs(c)
 }
 
+func (c *PlatformClient) Licenses() LicenseInterface {
+	return newLicenses(c)
+}
+
 func (c *PlatformClient) LogCollectors() LogCollectorInterface {
 	return newLogCollectors(c)
 }
@@ -32,6 +32,7 @@ type BusinessV1Interface interface {
 	ConfigMapsGetter
 	ImageNamespacesGetter
 	NamespacesGetter
+	NamespaceRequestsGetter
 	NsEmigrationsGetter
 	PlatformsGetter
 	PortalsGetter
@@ -59,6 +60,10 @@ func (c *BusinessV1Client) Namespaces(namespace string) NamespaceInterface {
 	return newNamespaces(c, namespace)
 }
 
+func (c *BusinessV1Client) NamespaceRequests(namespace string) NamespaceRequestInterface {
+	return newNamespaceRequests(c, namespace)
+}
+
 func (c *BusinessV1Client) NsEmigrations(namespace string) NsEmigrationInterface {
 	return newNsEmigrations(c, namespace)
 }
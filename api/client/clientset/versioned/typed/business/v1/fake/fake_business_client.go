@@ -46,6 +46,10 @@ func (c *FakeBusinessV1) Namespaces(namespace string) v1.NamespaceInterface {
 	return &FakeNamespaces{c, namespace}
 }
 
+func (c *FakeBusinessV1) NamespaceRequests(namespace string) v1.NamespaceRequestInterface {
+	return &FakeNamespaceRequests{c, namespace}
+}
+
 func (c *FakeBusinessV1) NsEmigrations(namespace string) v1.NsEmigrationInterface {
 	return &FakeNsEmigrations{c, namespace}
 }
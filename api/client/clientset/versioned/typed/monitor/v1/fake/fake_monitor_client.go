@@ -30,6 +30,10 @@ type FakeMonitorV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeMonitorV1) BlackboxProbes() v1.BlackboxProbeInterface {
+	return &FakeBlackboxProbes{c}
+}
+
 func (c *FakeMonitorV1) ClusterOverviews() v1.ClusterOverviewInterface {
 	return &FakeClusterOverviews{c}
 }
@@ -38,10 +42,22 @@ func (c *FakeMonitorV1) ConfigMaps() v1.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeMonitorV1) EventAlertPolicies() v1.EventAlertPolicyInterface {
+	return &FakeEventAlertPolicies{c}
+}
+
+func (c *FakeMonitorV1) FederatedQueries() v1.FederatedQueryInterface {
+	return &FakeFederatedQueries{c}
+}
+
 func (c *FakeMonitorV1) Metrics() v1.MetricInterface {
 	return &FakeMetrics{c}
 }
 
+func (c *FakeMonitorV1) ProjectUsages() v1.ProjectUsageInterface {
+	return &FakeProjectUsages{c}
+}
+
 func (c *FakeMonitorV1) Prometheuses() v1.PrometheusInterface {
 	return &FakePrometheuses{c}
 }
@@ -20,10 +20,18 @@
 
 package v1
 
+type BlackboxProbeExpansion interface{}
+
 type ClusterOverviewExpansion interface{}
 
 type ConfigMapExpansion interface{}
 
+type EventAlertPolicyExpansion interface{}
+
+type FederatedQueryExpansion interface{}
+
 type MetricExpansion interface{}
 
+type ProjectUsageExpansion interface{}
+
 type PrometheusExpansion interface{}
@@ -28,9 +28,13 @@ import (
 
 type MonitorV1Interface interface {
 	RESTClient() rest.Interface
+	BlackboxProbesGetter
 	ClusterOverviewsGetter
 	ConfigMapsGetter
+	EventAlertPoliciesGetter
+	FederatedQueriesGetter
 	MetricsGetter
+	ProjectUsagesGetter
 	PrometheusesGetter
 }
 
@@ -39,6 +43,10 @@ type MonitorV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *MonitorV1Client) BlackboxProbes() BlackboxProbeInterface {
+	return newBlackboxProbes(c)
+}
+
 func (c *MonitorV1Client) ClusterOverviews() ClusterOverviewInterface {
 	return newClusterOverviews(c)
 }
@@ -47,10 +55,22 @@ func (c *MonitorV1Client) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *MonitorV1Client) EventAlertPolicies() EventAlertPolicyInterface {
+	return newEventAlertPolicies(c)
+}
+
+func (c *MonitorV1Client) FederatedQueries() FederatedQueryInterface {
+	return newFederatedQueries(c)
+}
+
 func (c *MonitorV1Client) Metrics() MetricInterface {
 	return newMetrics(c)
 }
 
+func (c *MonitorV1Client) ProjectUsages() ProjectUsageInterface {
+	return newProjectUsages(c)
+}
+
 func (c *MonitorV1Client) Prometheuses() PrometheusInterface {
 	return newPrometheuses(c)
 }
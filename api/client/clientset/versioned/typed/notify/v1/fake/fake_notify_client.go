@@ -38,6 +38,14 @@ func (c *FakeNotifyV1) ConfigMaps() v1.ConfigMapInterface {
 	return &FakeConfigMaps{c}
 }
 
+func (c *FakeNotifyV1) Escalations() v1.EscalationInterface {
+	return &FakeEscalations{c}
+}
+
+func (c *FakeNotifyV1) EscalationPolicies() v1.EscalationPolicyInterface {
+	return &FakeEscalationPolicies{c}
+}
+
 func (c *FakeNotifyV1) Messages() v1.MessageInterface {
 	return &FakeMessages{c}
 }
@@ -46,6 +54,10 @@ func (c *FakeNotifyV1) MessageRequests(namespace string) v1.MessageRequestInterf
 	return &FakeMessageRequests{c, namespace}
 }
 
+func (c *FakeNotifyV1) OnCallSchedules() v1.OnCallScheduleInterface {
+	return &FakeOnCallSchedules{c}
+}
+
 func (c *FakeNotifyV1) Receivers() v1.ReceiverInterface {
 	return &FakeReceivers{c}
 }
@@ -24,10 +24,16 @@ type ChannelExpansion interface{}
 
 type ConfigMapExpansion interface{}
 
+type EscalationExpansion interface{}
+
+type EscalationPolicyExpansion interface{}
+
 type MessageExpansion interface{}
 
 type MessageRequestExpansion interface{}
 
+type OnCallScheduleExpansion interface{}
+
 type ReceiverExpansion interface{}
 
 type ReceiverGroupExpansion interface{}
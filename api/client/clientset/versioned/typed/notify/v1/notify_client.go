@@ -30,8 +30,11 @@ type NotifyV1Interface interface {
 	RESTClient() rest.Interface
 	ChannelsGetter
 	ConfigMapsGetter
+	EscalationsGetter
+	EscalationPoliciesGetter
 	MessagesGetter
 	MessageRequestsGetter
+	OnCallSchedulesGetter
 	ReceiversGetter
 	ReceiverGroupsGetter
 	TemplatesGetter
@@ -50,6 +53,14 @@ func (c *NotifyV1Client) ConfigMaps() ConfigMapInterface {
 	return newConfigMaps(c)
 }
 
+func (c *NotifyV1Client) Escalations() EscalationInterface {
+	return newEscalations(c)
+}
+
+func (c *NotifyV1Client) EscalationPolicies() EscalationPolicyInterface {
+	return newEscalationPolicies(c)
+}
+
 func (c *NotifyV1Client) Messages() MessageInterface {
 	return newMessages(c)
 }
@@ -58,6 +69,10 @@ func (c *NotifyV1Client) MessageRequests(namespace string) MessageRequestInterfa
 	return newMessageRequests(c, namespace)
 }
 
+func (c *NotifyV1Client) OnCallSchedules() OnCallScheduleInterface {
+	return newOnCallSchedules(c)
+}
+
 func (c *NotifyV1Client) Receivers() ReceiverInterface {
 	return newReceivers(c)
 }
@@ -58,6 +58,34 @@ func (c *FakePlatformV1) CronHPAs() v1.CronHPAInterface {
 	return &FakeCronHPAs{c}
 }
 
+func (c *FakePlatformV1) EgressGateways() v1.EgressGatewayInterface {
+	return &FakeEgressGateways{c}
+}
+
+func (c *FakePlatformV1) MetalLBs() v1.MetalLBInterface {
+	return &FakeMetalLBs{c}
+}
+
+func (c *FakePlatformV1) Multuses() v1.MultusInterface {
+	return &FakeMultuses{c}
+}
+
+func (c *FakePlatformV1) DevicePlugins() v1.DevicePluginInterface {
+	return &FakeDevicePlugins{c}
+}
+
+func (c *FakePlatformV1) FloatingIPReservations() v1.FloatingIPReservationInterface {
+	return &FakeFloatingIPReservations{c}
+}
+
+func (c *FakePlatformV1) GPUNodeConfigs() v1.GPUNodeConfigInterface {
+	return &FakeGPUNodeConfigs{c}
+}
+
+func (c *FakePlatformV1) ClusterOperations() v1.ClusterOperationInterface {
+	return &FakeClusterOperations{c}
+}
+
 func (c *FakePlatformV1) Helms() v1.HelmInterface {
 	return &FakeHelms{c}
 }
@@ -70,6 +98,10 @@ func (c *FakePlatformV1) LBCFs() v1.LBCFInterface {
 	return &FakeLBCFs{c}
 }
 
+func (c *FakePlatformV1) Licenses() v1.LicenseInterface {
+	return &FakeLicenses{c}
+}
+
 func (c *FakePlatformV1) LogCollectors() v1.LogCollectorInterface {
 	return &FakeLogCollectors{c}
 }
@@ -34,12 +34,28 @@ type ConfigMapExpansion interface{}
 
 type CronHPAExpansion interface{}
 
+type EgressGatewayExpansion interface{}
+
+type MetalLBExpansion interface{}
+
+type MultusExpansion interface{}
+
+type DevicePluginExpansion interface{}
+
+type FloatingIPReservationExpansion interface{}
+
+type GPUNodeConfigExpansion interface{}
+
+type ClusterOperationExpansion interface{}
+
 type HelmExpansion interface{}
 
 type IPAMExpansion interface{}
 
 type LBCFExpansion interface{}
 
+type LicenseExpansion interface{}
+
 type LogCollectorExpansion interface{}
 
 type MachineExpansion interface{}
@@ -35,9 +35,17 @@ type PlatformV1Interface interface {
 	ClusterCredentialsGetter
 	ConfigMapsGetter
 	CronHPAsGetter
+	EgressGatewaysGetter
+	MetalLBsGetter
+	MultusesGetter
+	DevicePluginsGetter
+	FloatingIPReservationsGetter
+	GPUNodeConfigsGetter
+	ClusterOperationsGetter
 	HelmsGetter
 	IPAMsGetter
 	LBCFsGetter
+	LicensesGetter
 	LogCollectorsGetter
 	MachinesGetter
 	PersistentEventsGetter
@@ -80,6 +88,34 @@ func (c *PlatformV1Client) CronHPAs() CronHPAInterface {
 	return newCronHPAs(c)
 }
 
+func (c *PlatformV1Client) EgressGateways() EgressGatewayInterface {
+	return newEgressGateways(c)
+}
+
+func (c *PlatformV1Client) MetalLBs() MetalLBInterface {
+	return newMetalLBs(c)
+}
+
+func (c *PlatformV1Client) Multuses() MultusInterface {
+	return newMultuses(c)
+}
+
+func (c *PlatformV1Client) DevicePlugins() DevicePluginInterface {
+	return newDevicePlugins(c)
+}
+
+func (c *PlatformV1Client) FloatingIPReservations() FloatingIPReservationInterface {
+	return newFloatingIPReservations(c)
+}
+
+func (c *PlatformV1Client) GPUNodeConfigs() GPUNodeConfigInterface {
+	return newGPUNodeConfigs(c)
+}
+
+func (c *PlatformV1Client) ClusterOperations() ClusterOperationInterface {
+	return newClusterOperations(c)
+}
+
 func (c *PlatformV1Client) Helms() HelmInterface {
 	return newHelms(c)
 }
@@ -92,6 +128,10 @@ func (c *PlatformV1Client) LBCFs() LBCFInterface {
 	return newLBCFs(c)
 }
 
+func (c *PlatformV1Client) Licenses() LicenseInterface {
+	return newLicenses(c)
+}
+
 func (c *PlatformV1Client) LogCollectors() LogCollectorInterface {
 	return newLogCollectors(c)
 }
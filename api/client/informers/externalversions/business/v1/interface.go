@@ -34,6 +34,8 @@ type Interface interface {
 	ImageNamespaces() ImageNamespaceInformer
 	// Namespaces returns a NamespaceInformer.
 	Namespaces() NamespaceInformer
+	// NamespaceRequests returns a NamespaceRequestInformer.
+	NamespaceRequests() NamespaceRequestInformer
 	// NsEmigrations returns a NsEmigrationInformer.
 	NsEmigrations() NsEmigrationInformer
 	// Platforms returns a PlatformInformer.
@@ -73,6 +75,11 @@ func (v *version) Namespaces() NamespaceInformer {
 	return &namespaceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// NamespaceRequests returns a NamespaceRequestInformer.
+func (v *version) NamespaceRequests() NamespaceRequestInformer {
+	return &namespaceRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // NsEmigrations returns a NsEmigrationInformer.
 func (v *version) NsEmigrations() NsEmigrationInformer {
 	return &nsEmigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -109,6 +109,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().ImageNamespaces().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().Namespaces().Informer()}, nil
+	case businessv1.SchemeGroupVersion.WithResource("namespacerequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NamespaceRequests().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().V1().NsEmigrations().Informer()}, nil
 	case businessv1.SchemeGroupVersion.WithResource("platforms"):
@@ -129,8 +131,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Mesh().V1().MeshManagers().Informer()}, nil
 
 		// Group=monitor.tkestack.io, Version=v1
+	case monitorv1.SchemeGroupVersion.WithResource("blackboxprobes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().V1().BlackboxProbes().Informer()}, nil
 	case monitorv1.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().V1().ConfigMaps().Informer()}, nil
+	case monitorv1.SchemeGroupVersion.WithResource("eventalertpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().V1().EventAlertPolicies().Informer()}, nil
 	case monitorv1.SchemeGroupVersion.WithResource("prometheuses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().V1().Prometheuses().Informer()}, nil
 
@@ -139,10 +145,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Channels().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().ConfigMaps().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("escalations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Escalations().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("escalationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().EscalationPolicies().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("messages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Messages().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("messagerequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().MessageRequests().Informer()}, nil
+	case notifyv1.SchemeGroupVersion.WithResource("oncallschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().OnCallSchedules().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("receivers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().V1().Receivers().Informer()}, nil
 	case notifyv1.SchemeGroupVersion.WithResource("receivergroups"):
@@ -161,12 +173,28 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ConfigMaps().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("cronhpas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().CronHPAs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("egressgateways"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().EgressGateways().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("metallbs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().MetalLBs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("multuses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Multuses().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("deviceplugins"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().DevicePlugins().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("floatingipreservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().FloatingIPReservations().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("gpunodeconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().GPUNodeConfigs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("clusteroperations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().ClusterOperations().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("helms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Helms().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("ipams"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().IPAMs().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("lbcfs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().LBCFs().Informer()}, nil
+	case platformv1.SchemeGroupVersion.WithResource("licenses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().Licenses().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("logcollectors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().V1().LogCollectors().Informer()}, nil
 	case platformv1.SchemeGroupVersion.WithResource("machines"):
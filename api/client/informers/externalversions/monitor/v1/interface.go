@@ -26,8 +26,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BlackboxProbes returns a BlackboxProbeInformer.
+	BlackboxProbes() BlackboxProbeInformer
 	// ConfigMaps returns a ConfigMapInformer.
 	ConfigMaps() ConfigMapInformer
+	// EventAlertPolicies returns a EventAlertPolicyInformer.
+	EventAlertPolicies() EventAlertPolicyInformer
 	// Prometheuses returns a PrometheusInformer.
 	Prometheuses() PrometheusInformer
 }
@@ -43,11 +47,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BlackboxProbes returns a BlackboxProbeInformer.
+func (v *version) BlackboxProbes() BlackboxProbeInformer {
+	return &blackboxProbeInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ConfigMaps returns a ConfigMapInformer.
 func (v *version) ConfigMaps() ConfigMapInformer {
 	return &configMapInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EventAlertPolicies returns a EventAlertPolicyInformer.
+func (v *version) EventAlertPolicies() EventAlertPolicyInformer {
+	return &eventAlertPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Prometheuses returns a PrometheusInformer.
 func (v *version) Prometheuses() PrometheusInformer {
 	return &prometheusInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -30,10 +30,16 @@ type Interface interface {
 	Channels() ChannelInformer
 	// ConfigMaps returns a ConfigMapInformer.
 	ConfigMaps() ConfigMapInformer
+	// Escalations returns a EscalationInformer.
+	Escalations() EscalationInformer
+	// EscalationPolicies returns a EscalationPolicyInformer.
+	EscalationPolicies() EscalationPolicyInformer
 	// Messages returns a MessageInformer.
 	Messages() MessageInformer
 	// MessageRequests returns a MessageRequestInformer.
 	MessageRequests() MessageRequestInformer
+	// OnCallSchedules returns a OnCallScheduleInformer.
+	OnCallSchedules() OnCallScheduleInformer
 	// Receivers returns a ReceiverInformer.
 	Receivers() ReceiverInformer
 	// ReceiverGroups returns a ReceiverGroupInformer.
@@ -63,6 +69,16 @@ func (v *version) ConfigMaps() ConfigMapInformer {
 	return &configMapInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Escalations returns a EscalationInformer.
+func (v *version) Escalations() EscalationInformer {
+	return &escalationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// EscalationPolicies returns a EscalationPolicyInformer.
+func (v *version) EscalationPolicies() EscalationPolicyInformer {
+	return &escalationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Messages returns a MessageInformer.
 func (v *version) Messages() MessageInformer {
 	return &messageInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -73,6 +89,11 @@ func (v *version) MessageRequests() MessageRequestInformer {
 	return &messageRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OnCallSchedules returns a OnCallScheduleInformer.
+func (v *version) OnCallSchedules() OnCallScheduleInformer {
+	return &onCallScheduleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Receivers returns a ReceiverInformer.
 func (v *version) Receivers() ReceiverInformer {
 	return &receiverInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
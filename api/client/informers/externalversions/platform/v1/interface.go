@@ -36,12 +36,28 @@ type Interface interface {
 	ConfigMaps() ConfigMapInformer
 	// CronHPAs returns a CronHPAInformer.
 	CronHPAs() CronHPAInformer
+	// EgressGateways returns a EgressGatewayInformer.
+	EgressGateways() EgressGatewayInformer
+	// MetalLBs returns a MetalLBInformer.
+	MetalLBs() MetalLBInformer
+	// Multuses returns a MultusInformer.
+	Multuses() MultusInformer
+	// DevicePlugins returns a DevicePluginInformer.
+	DevicePlugins() DevicePluginInformer
+	// FloatingIPReservations returns a FloatingIPReservationInformer.
+	FloatingIPReservations() FloatingIPReservationInformer
+	// GPUNodeConfigs returns a GPUNodeConfigInformer.
+	GPUNodeConfigs() GPUNodeConfigInformer
+	// ClusterOperations returns a ClusterOperationInformer.
+	ClusterOperations() ClusterOperationInformer
 	// Helms returns a HelmInformer.
 	Helms() HelmInformer
 	// IPAMs returns a IPAMInformer.
 	IPAMs() IPAMInformer
 	// LBCFs returns a LBCFInformer.
 	LBCFs() LBCFInformer
+	// Licenses returns a LicenseInformer.
+	Licenses() LicenseInformer
 	// LogCollectors returns a LogCollectorInformer.
 	LogCollectors() LogCollectorInformer
 	// Machines returns a MachineInformer.
@@ -94,6 +110,41 @@ func (v *version) CronHPAs() CronHPAInformer {
 	return &cronHPAInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// EgressGateways returns a EgressGatewayInformer.
+func (v *version) EgressGateways() EgressGatewayInformer {
+	return &egressGatewayInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// MetalLBs returns a MetalLBInformer.
+func (v *version) MetalLBs() MetalLBInformer {
+	return &metalLBInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// Multuses returns a MultusInformer.
+func (v *version) Multuses() MultusInformer {
+	return &multusInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// DevicePlugins returns a DevicePluginInformer.
+func (v *version) DevicePlugins() DevicePluginInformer {
+	return &devicePluginInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// FloatingIPReservations returns a FloatingIPReservationInformer.
+func (v *version) FloatingIPReservations() FloatingIPReservationInformer {
+	return &floatingIPReservationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// GPUNodeConfigs returns a GPUNodeConfigInformer.
+func (v *version) GPUNodeConfigs() GPUNodeConfigInformer {
+	return &gPUNodeConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ClusterOperations returns a ClusterOperationInformer.
+func (v *version) ClusterOperations() ClusterOperationInformer {
+	return &clusterOperationInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Helms returns a HelmInformer.
 func (v *version) Helms() HelmInformer {
 	return &helmInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -109,6 +160,11 @@ func (v *version) LBCFs() LBCFInformer {
 	return &lBCFInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// Licenses returns a LicenseInformer.
+func (v *version) Licenses() LicenseInformer {
+	return &licenseInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // LogCollectors returns a LogCollectorInformer.
 func (v *version) LogCollectors() LogCollectorInformer {
 	return &logCollectorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
@@ -111,6 +111,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().ImageNamespaces().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("namespaces"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().Namespaces().Informer()}, nil
+	case business.SchemeGroupVersion.WithResource("namespacerequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NamespaceRequests().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("nsemigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Business().InternalVersion().NsEmigrations().Informer()}, nil
 	case business.SchemeGroupVersion.WithResource("platforms"):
@@ -131,8 +133,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Mesh().InternalVersion().MeshManagers().Informer()}, nil
 
 		// Group=monitor.tkestack.io, Version=internalVersion
+	case monitor.SchemeGroupVersion.WithResource("blackboxprobes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().InternalVersion().BlackboxProbes().Informer()}, nil
 	case monitor.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().InternalVersion().ConfigMaps().Informer()}, nil
+	case monitor.SchemeGroupVersion.WithResource("eventalertpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().InternalVersion().EventAlertPolicies().Informer()}, nil
 	case monitor.SchemeGroupVersion.WithResource("prometheuses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Monitor().InternalVersion().Prometheuses().Informer()}, nil
 
@@ -141,10 +147,16 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Channels().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("configmaps"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().ConfigMaps().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("escalations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Escalations().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("escalationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().EscalationPolicies().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("messages"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Messages().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("messagerequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().MessageRequests().Informer()}, nil
+	case notify.SchemeGroupVersion.WithResource("oncallschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().OnCallSchedules().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("receivers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Notify().InternalVersion().Receivers().Informer()}, nil
 	case notify.SchemeGroupVersion.WithResource("receivergroups"):
@@ -163,12 +175,28 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ConfigMaps().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("cronhpas"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().CronHPAs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("egressgateways"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().EgressGateways().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("metallbs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().MetalLBs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("multuses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Multuses().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("deviceplugins"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().DevicePlugins().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("floatingipreservations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().FloatingIPReservations().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("gpunodeconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().GPUNodeConfigs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("clusteroperations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().ClusterOperations().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("helms"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Helms().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("ipams"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().IPAMs().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("lbcfs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().LBCFs().Informer()}, nil
+	case platform.SchemeGroupVersion.WithResource("licenses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().Licenses().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("logcollectors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Platform().InternalVersion().LogCollectors().Informer()}, nil
 	case platform.SchemeGroupVersion.WithResource("machines"):
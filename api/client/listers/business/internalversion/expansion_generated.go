@@ -48,6 +48,14 @@ type NamespaceListerExpansion interface{}
 // NamespaceNamespaceLister.
 type NamespaceNamespaceListerExpansion interface{}
 
+// NamespaceRequestListerExpansion allows custom methods to be added to
+// NamespaceRequestLister.
+type NamespaceRequestListerExpansion interface{}
+
+// NamespaceRequestNamespaceListerExpansion allows custom methods to be added to
+// NamespaceRequestNamespaceLister.
+type NamespaceRequestNamespaceListerExpansion interface{}
+
 // NsEmigrationListerExpansion allows custom methods to be added to
 // NsEmigrationLister.
 type NsEmigrationListerExpansion interface{}
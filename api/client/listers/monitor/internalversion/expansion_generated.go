@@ -20,10 +20,18 @@
 
 package internalversion
 
+// BlackboxProbeListerExpansion allows custom methods to be added to
+// BlackboxProbeLister.
+type BlackboxProbeListerExpansion interface{}
+
 // ConfigMapListerExpansion allows custom methods to be added to
 // ConfigMapLister.
 type ConfigMapListerExpansion interface{}
 
+// EventAlertPolicyListerExpansion allows custom methods to be added to
+// EventAlertPolicyLister.
+type EventAlertPolicyListerExpansion interface{}
+
 // PrometheusListerExpansion allows custom methods to be added to
 // PrometheusLister.
 type PrometheusListerExpansion interface{}
@@ -28,6 +28,14 @@ type ChannelListerExpansion interface{}
 // ConfigMapLister.
 type ConfigMapListerExpansion interface{}
 
+// EscalationListerExpansion allows custom methods to be added to
+// EscalationLister.
+type EscalationListerExpansion interface{}
+
+// EscalationPolicyListerExpansion allows custom methods to be added to
+// EscalationPolicyLister.
+type EscalationPolicyListerExpansion interface{}
+
 // MessageListerExpansion allows custom methods to be added to
 // MessageLister.
 type MessageListerExpansion interface{}
@@ -40,6 +48,10 @@ type MessageRequestListerExpansion interface{}
 // MessageRequestNamespaceLister.
 type MessageRequestNamespaceListerExpansion interface{}
 
+// OnCallScheduleListerExpansion allows custom methods to be added to
+// OnCallScheduleLister.
+type OnCallScheduleListerExpansion interface{}
+
 // ReceiverListerExpansion allows custom methods to be added to
 // ReceiverLister.
 type ReceiverListerExpansion interface{}
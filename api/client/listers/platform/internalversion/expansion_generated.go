@@ -44,6 +44,34 @@ type ConfigMapListerExpansion interface{}
 // CronHPALister.
 type CronHPAListerExpansion interface{}
 
+// EgressGatewayListerExpansion allows custom methods to be added to
+// EgressGatewayLister.
+type EgressGatewayListerExpansion interface{}
+
+// MetalLBListerExpansion allows custom methods to be added to
+// MetalLBLister.
+type MetalLBListerExpansion interface{}
+
+// MultusListerExpansion allows custom methods to be added to
+// MultusLister.
+type MultusListerExpansion interface{}
+
+// DevicePluginListerExpansion allows custom methods to be added to
+// DevicePluginLister.
+type DevicePluginListerExpansion interface{}
+
+// FloatingIPReservationListerExpansion allows custom methods to be added to
+// FloatingIPReservationLister.
+type FloatingIPReservationListerExpansion interface{}
+
+// GPUNodeConfigListerExpansion allows custom methods to be added to
+// GPUNodeConfigLister.
+type GPUNodeConfigListerExpansion interface{}
+
+// ClusterOperationListerExpansion allows custom methods to be added to
+// ClusterOperationLister.
+type ClusterOperationListerExpansion interface{}
+
 // HelmListerExpansion allows custom methods to be added to
 // HelmLister.
 type HelmListerExpansion interface{}
@@ -56,6 +84,10 @@ type IPAMListerExpansion interface{}
 // LBCFLister.
 type LBCFListerExpansion interface{}
 
+// LicenseListerExpansion allows custom methods to be added to
+// LicenseLister.
+type LicenseListerExpansion interface{}
+
 // LogCollectorListerExpansion allows custom methods to be added to
 // LogCollectorLister.
 type LogCollectorListerExpansion interface{}
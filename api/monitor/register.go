@@ -69,6 +69,17 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMap{},
 		&ConfigMapList{},
 
-		&ClusterOverview{})
+		&ClusterOverview{},
+
+		&ProjectUsage{},
+
+		&FederatedQuery{},
+
+		&EventAlertPolicy{},
+		&EventAlertPolicyList{},
+
+		&BlackboxProbe{},
+		&BlackboxProbeList{},
+	)
 	return nil
 }
@@ -266,6 +266,407 @@ type ClusterStatistic struct {
 	EtcdHealthy              bool
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsage defines the structure for querying the resource usage of
+// business projects request and result.
+type ProjectUsage struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the projects and the time range to aggregate.
+	// +optional
+	Spec ProjectUsageSpec
+	// +optional
+	Result *ProjectUsageResult
+}
+
+// ProjectUsageSpec describes the projects and the time range of a usage query.
+type ProjectUsageSpec struct {
+	// Projects are the names of the business projects to aggregate, all projects
+	// of the tenant if not specified.
+	// +optional
+	Projects []string
+	// StartTime is the beginning of the time range in milliseconds.
+	StartTime int64
+	// EndTime is the end of the time range in milliseconds.
+	EndTime int64
+	// StepSeconds is the resolution of the time series, defaults to 3600.
+	// +optional
+	StepSeconds int64
+	// WithSeries returns the time series of each project along with the summary.
+	// +optional
+	WithSeries bool
+	// CostWeights are the prices used to calculate the cost of the usage, no cost
+	// is calculated if not specified.
+	// +optional
+	CostWeights *ProjectUsageCostWeights
+}
+
+// ProjectUsageCostWeights are the prices of resources per hour.
+type ProjectUsageCostWeights struct {
+	// CPU is the price of one core per hour.
+	// +optional
+	CPU float64
+	// Memory is the price of one GiB memory per hour.
+	// +optional
+	Memory float64
+	// GPU is the price of one gpu per hour.
+	// +optional
+	GPU float64
+	// Storage is the price of one GiB requested persistent volume per hour.
+	// +optional
+	Storage float64
+}
+
+// ProjectUsageResult is the aggregated usage of the projects.
+type ProjectUsageResult struct {
+	Projects []ProjectUsageSummary
+}
+
+// ProjectUsageSummary is the aggregated usage of a project.
+type ProjectUsageSummary struct {
+	ProjectName string
+	// Average is the average usage over the time range.
+	Average ProjectResourceUsage
+	// Total is the accumulated usage over the time range, in core-hours,
+	// GiB-hours and gpu-hours.
+	Total ProjectResourceUsage
+	// +optional
+	Cost float64
+	// +optional
+	Namespaces []ProjectNamespaceUsage
+	// +optional
+	Series []ProjectUsagePoint
+}
+
+// ProjectNamespaceUsage is the aggregated usage of a namespace of a project.
+type ProjectNamespaceUsage struct {
+	ClusterName string
+	Namespace   string
+	Average     ProjectResourceUsage
+	Total       ProjectResourceUsage
+	// +optional
+	Cost float64
+}
+
+// ProjectResourceUsage is the usage of cpu in cores, memory and storage in bytes
+// and gpu in cards, or the accumulated usage of them.
+type ProjectResourceUsage struct {
+	CPU     float64
+	Memory  float64
+	GPU     float64
+	Storage float64
+}
+
+// ProjectUsagePoint is the usage of a project at a time.
+type ProjectUsagePoint struct {
+	// Timestamp in milliseconds.
+	Timestamp int64
+	Usage     ProjectResourceUsage
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedQuery defines the structure for querying the prometheus of multiple
+// clusters request and result.
+type FederatedQuery struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the query and the clusters to query.
+	// +optional
+	Spec FederatedQuerySpec
+	// +optional
+	Result *FederatedQueryResult
+}
+
+// FederatedQuerySpec describes the PromQL query and the clusters to fan out.
+type FederatedQuerySpec struct {
+	// Query is the PromQL expression evaluated by the prometheus of each cluster.
+	Query string
+	// Clusters are the names of the clusters to query, all clusters of the tenant
+	// if not specified.
+	// +optional
+	Clusters []string
+	// ClusterSelector is a label selector of the clusters to query.
+	// +optional
+	ClusterSelector string
+	// Time is the evaluation time of an instant query in milliseconds, defaults
+	// to now.
+	// +optional
+	Time int64
+	// StartTime and EndTime in milliseconds make the query a range query.
+	// +optional
+	StartTime int64
+	// +optional
+	EndTime int64
+	// StepSeconds is the resolution of a range query, defaults to 60.
+	// +optional
+	StepSeconds int64
+}
+
+// FederatedQueryResult is the merged result of the clusters.
+type FederatedQueryResult struct {
+	// Series are the series of all clusters, each of which has the cluster_id
+	// label.
+	Series []FederatedQuerySeries
+	// Errors are the clusters failed to query, the result is partial if any.
+	// +optional
+	Errors []FederatedQueryError
+}
+
+// FederatedQuerySeries is a series returned by the prometheus of a cluster.
+type FederatedQuerySeries struct {
+	ClusterName string
+	// Metric is the labels of the series.
+	// +optional
+	Metric map[string]string
+	// Values has one sample for an instant query and the samples in the time
+	// range for a range query.
+	Values []FederatedQuerySample
+}
+
+// FederatedQuerySample is a value of a series at a time.
+type FederatedQuerySample struct {
+	// Timestamp in milliseconds.
+	Timestamp int64
+	// Value is formatted by prometheus, which may be NaN or Inf.
+	Value string
+}
+
+// FederatedQueryError is the failure of querying a cluster.
+type FederatedQueryError struct {
+	ClusterName string
+	Message     string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventAlertPolicy watches the events of a cluster and sends alerts through
+// notify when the events matching the rules exceed the thresholds.
+type EventAlertPolicy struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the rules and notify settings of EventAlertPolicy.
+	// +optional
+	Spec EventAlertPolicySpec
+	// +optional
+	Status EventAlertPolicyStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventAlertPolicyList is the whole list of all EventAlertPolicies.
+type EventAlertPolicyList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of EventAlertPolicies
+	Items []EventAlertPolicy
+}
+
+// EventAlertPolicySpec describes the attributes of an EventAlertPolicy.
+type EventAlertPolicySpec struct {
+	TenantID    string
+	ClusterName string
+	// Rules are the patterns of events, an alert is sent for each rule whose
+	// threshold is exceeded.
+	Rules []EventAlertRule
+	// Notify describes where the alerts are sent.
+	Notify EventAlertNotify
+	// SilenceSeconds is the interval within which the alert of a rule is not
+	// sent again. Defaults to 1800.
+	// +optional
+	SilenceSeconds int32
+}
+
+// EventAlertRule matches the events by reason, involved object and type, and
+// fires when the number of the matched events within the window reaches the
+// threshold.
+type EventAlertRule struct {
+	Name string
+	// Reasons of the events, such as FailedScheduling and BackOff.
+	Reasons []string
+	// InvolvedObjectKinds are the kinds of the involved objects, empty means
+	// all kinds.
+	// +optional
+	InvolvedObjectKinds []string
+	// Namespaces of the involved objects, empty means all namespaces.
+	// +optional
+	Namespaces []string
+	// Type of the events, Warning or Normal, empty means both.
+	// +optional
+	Type string
+	// Threshold is the number of the matched events within the window which
+	// fires the alert. Defaults to 1.
+	// +optional
+	Threshold int32
+	// WindowSeconds is the sliding window counting the events. Defaults to 300.
+	// +optional
+	WindowSeconds int32
+}
+
+// EventAlertNotify describes the channel, template and receivers of the
+// alerts.
+type EventAlertNotify struct {
+	Channel  string
+	Template string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
+}
+
+// EventAlertPolicyStatus is information about the alerts of an
+// EventAlertPolicy.
+type EventAlertPolicyStatus struct {
+	// LastAlertTime is the time when the last alert was sent.
+	// +optional
+	LastAlertTime metav1.Time
+	// LastAlertRule is the rule of the last alert.
+	// +optional
+	LastAlertRule string
+	// LastAlertMessage is the message of the latest event of the last alert.
+	// +optional
+	LastAlertMessage string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BlackboxProbe probes the availability of the targets from a cluster through
+// the blackbox exporter, and alerts when the availability breaches the SLO.
+type BlackboxProbe struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the targets and SLO of BlackboxProbe.
+	// +optional
+	Spec BlackboxProbeSpec
+	// +optional
+	Status BlackboxProbeStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BlackboxProbeList is the whole list of all BlackboxProbes.
+type BlackboxProbeList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of BlackboxProbes
+	Items []BlackboxProbe
+}
+
+// BlackboxProbeSpec describes the attributes of a BlackboxProbe.
+type BlackboxProbeSpec struct {
+	TenantID    string
+	ClusterName string
+	// Module is the protocol of probing, HTTP, TCP or ICMP.
+	Module string
+	// Targets are the urls of HTTP, the host:port of TCP or the hosts of ICMP,
+	// such as http://nginx.default.svc/healthz.
+	Targets []string
+	// IntervalSeconds is the interval of probing. Defaults to 30.
+	// +optional
+	IntervalSeconds int32
+	// TimeoutSeconds is the timeout of each probing, which must be less than
+	// the interval. Defaults to 5.
+	// +optional
+	TimeoutSeconds int32
+	// HTTP is the settings of the HTTP module.
+	// +optional
+	HTTP *BlackboxProbeHTTP
+	// SLO generates the alert rule which fires when the availability of a
+	// target is lower than the objective.
+	// +optional
+	SLO *BlackboxProbeSLO
+}
+
+// BlackboxProbeHTTP is the settings of probing HTTP targets.
+type BlackboxProbeHTTP struct {
+	// Method of the request. Defaults to GET.
+	// +optional
+	Method string
+	// ValidStatusCodes are the expected status codes, empty means 2xx.
+	// +optional
+	ValidStatusCodes []int32
+	// InsecureSkipVerify skips verifying the certificates of the targets.
+	// +optional
+	InsecureSkipVerify bool
+}
+
+// BlackboxProbeSLO is the availability objective of the targets.
+type BlackboxProbeSLO struct {
+	// Objective is the percentage of the successful probes within the window,
+	// such as 99.9.
+	Objective string
+	// WindowSeconds is the window in which the availability is computed.
+	// Defaults to 3600.
+	// +optional
+	WindowSeconds int32
+	// Notify describes where the alerts are sent.
+	Notify BlackboxProbeNotify
+}
+
+// BlackboxProbeNotify describes the channel, template and receivers of the
+// alerts.
+type BlackboxProbeNotify struct {
+	Channel  string
+	Template string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
+}
+
+// BlackboxProbeStatus is information about the current status of a
+// BlackboxProbe.
+type BlackboxProbeStatus struct {
+	// +optional
+	Phase BlackboxProbePhase
+	// A human readable message indicating details about why the probe is in
+	// this phase.
+	// +optional
+	Message string
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time
+}
+
+// BlackboxProbePhase is the phase of the blackbox probe.
+type BlackboxProbePhase string
+
+const (
+	// BlackboxProbePending means the probe is waiting to be deployed.
+	BlackboxProbePending BlackboxProbePhase = "Pending"
+	// BlackboxProbeRunning means the probe is deployed to the cluster.
+	BlackboxProbeRunning BlackboxProbePhase = "Running"
+	// BlackboxProbeFailed means the probe failed to be deployed.
+	BlackboxProbeFailed BlackboxProbePhase = "Failed"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
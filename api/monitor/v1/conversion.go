@@ -26,6 +26,8 @@ import (
 func addConversionFuncs(scheme *runtime.Scheme) error {
 	funcs := []func(scheme *runtime.Scheme) error{
 		AddFieldLabelConversionsForPrometheus,
+		AddFieldLabelConversionsForEventAlertPolicy,
+		AddFieldLabelConversionsForBlackboxProbe,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -55,3 +57,37 @@ func AddFieldLabelConversionsForPrometheus(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForEventAlertPolicy adds a conversion function to
+// convert field selectors of EventAlertPolicy from the given version to
+// internal version representation.
+func AddFieldLabelConversionsForEventAlertPolicy(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("EventAlertPolicy"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForBlackboxProbe adds a conversion function to
+// convert field selectors of BlackboxProbe from the given version to internal
+// version representation.
+func AddFieldLabelConversionsForBlackboxProbe(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("BlackboxProbe"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
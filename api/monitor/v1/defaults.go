@@ -37,3 +37,45 @@ func SetDefaults_PrometheusStatus(obj *PrometheusStatus) {
 		obj.Phase = AddonPhaseInitializing
 	}
 }
+
+func SetDefaults_EventAlertPolicySpec(obj *EventAlertPolicySpec) {
+	if obj.SilenceSeconds == 0 {
+		obj.SilenceSeconds = 1800
+	}
+}
+
+func SetDefaults_EventAlertRule(obj *EventAlertRule) {
+	if obj.Threshold == 0 {
+		obj.Threshold = 1
+	}
+	if obj.WindowSeconds == 0 {
+		obj.WindowSeconds = 300
+	}
+}
+
+func SetDefaults_BlackboxProbeSpec(obj *BlackboxProbeSpec) {
+	if obj.IntervalSeconds == 0 {
+		obj.IntervalSeconds = 30
+	}
+	if obj.TimeoutSeconds == 0 {
+		obj.TimeoutSeconds = 5
+	}
+}
+
+func SetDefaults_BlackboxProbeHTTP(obj *BlackboxProbeHTTP) {
+	if obj.Method == "" {
+		obj.Method = "GET"
+	}
+}
+
+func SetDefaults_BlackboxProbeSLO(obj *BlackboxProbeSLO) {
+	if obj.WindowSeconds == 0 {
+		obj.WindowSeconds = 3600
+	}
+}
+
+func SetDefaults_BlackboxProbeStatus(obj *BlackboxProbeStatus) {
+	if obj.Phase == "" {
+		obj.Phase = BlackboxProbePending
+	}
+}
@@ -57,7 +57,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMap{},
 		&ConfigMapList{},
 
-		&ClusterOverview{})
+		&ClusterOverview{},
+
+		&ProjectUsage{},
+
+		&FederatedQuery{},
+
+		&EventAlertPolicy{},
+		&EventAlertPolicyList{},
+
+		&BlackboxProbe{},
+		&BlackboxProbeList{},
+	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
@@ -265,6 +265,407 @@ type ClusterStatistic struct {
 	EtcdHealthy              bool    `json:"etcdHealthy" protobuf:"bytes,33,opt,name=etcdHealthy"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProjectUsage defines the structure for querying the resource usage of
+// business projects request and result.
+type ProjectUsage struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the projects and the time range to aggregate.
+	// +optional
+	Spec ProjectUsageSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Result *ProjectUsageResult `json:"result,omitempty" protobuf:"bytes,3,opt,name=result"`
+}
+
+// ProjectUsageSpec describes the projects and the time range of a usage query.
+type ProjectUsageSpec struct {
+	// Projects are the names of the business projects to aggregate, all projects
+	// of the tenant if not specified.
+	// +optional
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,1,rep,name=projects"`
+	// StartTime is the beginning of the time range in milliseconds.
+	StartTime int64 `json:"startTime" protobuf:"varint,2,opt,name=startTime"`
+	// EndTime is the end of the time range in milliseconds.
+	EndTime int64 `json:"endTime" protobuf:"varint,3,opt,name=endTime"`
+	// StepSeconds is the resolution of the time series, defaults to 3600.
+	// +optional
+	StepSeconds int64 `json:"stepSeconds,omitempty" protobuf:"varint,4,opt,name=stepSeconds"`
+	// WithSeries returns the time series of each project along with the summary.
+	// +optional
+	WithSeries bool `json:"withSeries,omitempty" protobuf:"varint,5,opt,name=withSeries"`
+	// CostWeights are the prices used to calculate the cost of the usage, no cost
+	// is calculated if not specified.
+	// +optional
+	CostWeights *ProjectUsageCostWeights `json:"costWeights,omitempty" protobuf:"bytes,6,opt,name=costWeights"`
+}
+
+// ProjectUsageCostWeights are the prices of resources per hour.
+type ProjectUsageCostWeights struct {
+	// CPU is the price of one core per hour.
+	// +optional
+	CPU float64 `json:"cpu,omitempty" protobuf:"bytes,1,opt,name=cpu"`
+	// Memory is the price of one GiB memory per hour.
+	// +optional
+	Memory float64 `json:"memory,omitempty" protobuf:"bytes,2,opt,name=memory"`
+	// GPU is the price of one gpu per hour.
+	// +optional
+	GPU float64 `json:"gpu,omitempty" protobuf:"bytes,3,opt,name=gpu"`
+	// Storage is the price of one GiB requested persistent volume per hour.
+	// +optional
+	Storage float64 `json:"storage,omitempty" protobuf:"bytes,4,opt,name=storage"`
+}
+
+// ProjectUsageResult is the aggregated usage of the projects.
+type ProjectUsageResult struct {
+	Projects []ProjectUsageSummary `json:"projects" protobuf:"bytes,1,rep,name=projects"`
+}
+
+// ProjectUsageSummary is the aggregated usage of a project.
+type ProjectUsageSummary struct {
+	ProjectName string `json:"projectName" protobuf:"bytes,1,opt,name=projectName"`
+	// Average is the average usage over the time range.
+	Average ProjectResourceUsage `json:"average" protobuf:"bytes,2,opt,name=average"`
+	// Total is the accumulated usage over the time range, in core-hours,
+	// GiB-hours and gpu-hours.
+	Total ProjectResourceUsage `json:"total" protobuf:"bytes,3,opt,name=total"`
+	// +optional
+	Cost float64 `json:"cost,omitempty" protobuf:"bytes,4,opt,name=cost"`
+	// +optional
+	Namespaces []ProjectNamespaceUsage `json:"namespaces,omitempty" protobuf:"bytes,5,rep,name=namespaces"`
+	// +optional
+	Series []ProjectUsagePoint `json:"series,omitempty" protobuf:"bytes,6,rep,name=series"`
+}
+
+// ProjectNamespaceUsage is the aggregated usage of a namespace of a project.
+type ProjectNamespaceUsage struct {
+	ClusterName string               `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	Namespace   string               `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	Average     ProjectResourceUsage `json:"average" protobuf:"bytes,3,opt,name=average"`
+	Total       ProjectResourceUsage `json:"total" protobuf:"bytes,4,opt,name=total"`
+	// +optional
+	Cost float64 `json:"cost,omitempty" protobuf:"bytes,5,opt,name=cost"`
+}
+
+// ProjectResourceUsage is the usage of cpu in cores, memory and storage in bytes
+// and gpu in cards, or the accumulated usage of them.
+type ProjectResourceUsage struct {
+	CPU     float64 `json:"cpu" protobuf:"bytes,1,opt,name=cpu"`
+	Memory  float64 `json:"memory" protobuf:"bytes,2,opt,name=memory"`
+	GPU     float64 `json:"gpu" protobuf:"bytes,3,opt,name=gpu"`
+	Storage float64 `json:"storage" protobuf:"bytes,4,opt,name=storage"`
+}
+
+// ProjectUsagePoint is the usage of a project at a time.
+type ProjectUsagePoint struct {
+	// Timestamp in milliseconds.
+	Timestamp int64                `json:"timestamp" protobuf:"varint,1,opt,name=timestamp"`
+	Usage     ProjectResourceUsage `json:"usage" protobuf:"bytes,2,opt,name=usage"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=create
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FederatedQuery defines the structure for querying the prometheus of multiple
+// clusters request and result.
+type FederatedQuery struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the query and the clusters to query.
+	// +optional
+	Spec FederatedQuerySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Result *FederatedQueryResult `json:"result,omitempty" protobuf:"bytes,3,opt,name=result"`
+}
+
+// FederatedQuerySpec describes the PromQL query and the clusters to fan out.
+type FederatedQuerySpec struct {
+	// Query is the PromQL expression evaluated by the prometheus of each cluster.
+	Query string `json:"query" protobuf:"bytes,1,opt,name=query"`
+	// Clusters are the names of the clusters to query, all clusters of the tenant
+	// if not specified.
+	// +optional
+	Clusters []string `json:"clusters,omitempty" protobuf:"bytes,2,rep,name=clusters"`
+	// ClusterSelector is a label selector of the clusters to query.
+	// +optional
+	ClusterSelector string `json:"clusterSelector,omitempty" protobuf:"bytes,3,opt,name=clusterSelector"`
+	// Time is the evaluation time of an instant query in milliseconds, defaults
+	// to now.
+	// +optional
+	Time int64 `json:"time,omitempty" protobuf:"varint,4,opt,name=time"`
+	// StartTime and EndTime in milliseconds make the query a range query.
+	// +optional
+	StartTime int64 `json:"startTime,omitempty" protobuf:"varint,5,opt,name=startTime"`
+	// +optional
+	EndTime int64 `json:"endTime,omitempty" protobuf:"varint,6,opt,name=endTime"`
+	// StepSeconds is the resolution of a range query, defaults to 60.
+	// +optional
+	StepSeconds int64 `json:"stepSeconds,omitempty" protobuf:"varint,7,opt,name=stepSeconds"`
+}
+
+// FederatedQueryResult is the merged result of the clusters.
+type FederatedQueryResult struct {
+	// Series are the series of all clusters, each of which has the cluster_id
+	// label.
+	Series []FederatedQuerySeries `json:"series" protobuf:"bytes,1,rep,name=series"`
+	// Errors are the clusters failed to query, the result is partial if any.
+	// +optional
+	Errors []FederatedQueryError `json:"errors,omitempty" protobuf:"bytes,2,rep,name=errors"`
+}
+
+// FederatedQuerySeries is a series returned by the prometheus of a cluster.
+type FederatedQuerySeries struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	// Metric is the labels of the series.
+	// +optional
+	Metric map[string]string `json:"metric,omitempty" protobuf:"bytes,2,rep,name=metric" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Values has one sample for an instant query and the samples in the time
+	// range for a range query.
+	Values []FederatedQuerySample `json:"values" protobuf:"bytes,3,rep,name=values"`
+}
+
+// FederatedQuerySample is a value of a series at a time.
+type FederatedQuerySample struct {
+	// Timestamp in milliseconds.
+	Timestamp int64 `json:"timestamp" protobuf:"varint,1,opt,name=timestamp"`
+	// Value is formatted by prometheus, which may be NaN or Inf.
+	Value string `json:"value" protobuf:"bytes,2,opt,name=value"`
+}
+
+// FederatedQueryError is the failure of querying a cluster.
+type FederatedQueryError struct {
+	ClusterName string `json:"clusterName" protobuf:"bytes,1,opt,name=clusterName"`
+	Message     string `json:"message" protobuf:"bytes,2,opt,name=message"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventAlertPolicy watches the events of a cluster and sends alerts through
+// notify when the events matching the rules exceed the thresholds.
+type EventAlertPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the rules and notify settings of EventAlertPolicy.
+	// +optional
+	Spec EventAlertPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status EventAlertPolicyStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventAlertPolicyList is the whole list of all EventAlertPolicies.
+type EventAlertPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of EventAlertPolicies
+	Items []EventAlertPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// EventAlertPolicySpec describes the attributes of an EventAlertPolicy.
+type EventAlertPolicySpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// Rules are the patterns of events, an alert is sent for each rule whose
+	// threshold is exceeded.
+	Rules []EventAlertRule `json:"rules" protobuf:"bytes,3,rep,name=rules"`
+	// Notify describes where the alerts are sent.
+	Notify EventAlertNotify `json:"notify" protobuf:"bytes,4,opt,name=notify"`
+	// SilenceSeconds is the interval within which the alert of a rule is not
+	// sent again. Defaults to 1800.
+	// +optional
+	SilenceSeconds int32 `json:"silenceSeconds,omitempty" protobuf:"varint,5,opt,name=silenceSeconds"`
+}
+
+// EventAlertRule matches the events by reason, involved object and type, and
+// fires when the number of the matched events within the window reaches the
+// threshold.
+type EventAlertRule struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Reasons of the events, such as FailedScheduling and BackOff.
+	Reasons []string `json:"reasons" protobuf:"bytes,2,rep,name=reasons"`
+	// InvolvedObjectKinds are the kinds of the involved objects, empty means
+	// all kinds.
+	// +optional
+	InvolvedObjectKinds []string `json:"involvedObjectKinds,omitempty" protobuf:"bytes,3,rep,name=involvedObjectKinds"`
+	// Namespaces of the involved objects, empty means all namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,4,rep,name=namespaces"`
+	// Type of the events, Warning or Normal, empty means both.
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,5,opt,name=type"`
+	// Threshold is the number of the matched events within the window which
+	// fires the alert. Defaults to 1.
+	// +optional
+	Threshold int32 `json:"threshold,omitempty" protobuf:"varint,6,opt,name=threshold"`
+	// WindowSeconds is the sliding window counting the events. Defaults to 300.
+	// +optional
+	WindowSeconds int32 `json:"windowSeconds,omitempty" protobuf:"varint,7,opt,name=windowSeconds"`
+}
+
+// EventAlertNotify describes the channel, template and receivers of the
+// alerts.
+type EventAlertNotify struct {
+	Channel  string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	Template string `json:"template" protobuf:"bytes,2,opt,name=template"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,4,rep,name=receiverGroups"`
+}
+
+// EventAlertPolicyStatus is information about the alerts of an
+// EventAlertPolicy.
+type EventAlertPolicyStatus struct {
+	// LastAlertTime is the time when the last alert was sent.
+	// +optional
+	LastAlertTime metav1.Time `json:"lastAlertTime,omitempty" protobuf:"bytes,1,opt,name=lastAlertTime"`
+	// LastAlertRule is the rule of the last alert.
+	// +optional
+	LastAlertRule string `json:"lastAlertRule,omitempty" protobuf:"bytes,2,opt,name=lastAlertRule"`
+	// LastAlertMessage is the message of the latest event of the last alert.
+	// +optional
+	LastAlertMessage string `json:"lastAlertMessage,omitempty" protobuf:"bytes,3,opt,name=lastAlertMessage"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BlackboxProbe probes the availability of the targets from a cluster through
+// the blackbox exporter, and alerts when the availability breaches the SLO.
+type BlackboxProbe struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the targets and SLO of BlackboxProbe.
+	// +optional
+	Spec BlackboxProbeSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status BlackboxProbeStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BlackboxProbeList is the whole list of all BlackboxProbes.
+type BlackboxProbeList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of BlackboxProbes
+	Items []BlackboxProbe `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// BlackboxProbeSpec describes the attributes of a BlackboxProbe.
+type BlackboxProbeSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// Module is the protocol of probing, HTTP, TCP or ICMP.
+	Module string `json:"module" protobuf:"bytes,3,opt,name=module"`
+	// Targets are the urls of HTTP, the host:port of TCP or the hosts of ICMP,
+	// such as http://nginx.default.svc/healthz.
+	Targets []string `json:"targets" protobuf:"bytes,4,rep,name=targets"`
+	// IntervalSeconds is the interval of probing. Defaults to 30.
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty" protobuf:"varint,5,opt,name=intervalSeconds"`
+	// TimeoutSeconds is the timeout of each probing, which must be less than
+	// the interval. Defaults to 5.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty" protobuf:"varint,6,opt,name=timeoutSeconds"`
+	// HTTP is the settings of the HTTP module.
+	// +optional
+	HTTP *BlackboxProbeHTTP `json:"http,omitempty" protobuf:"bytes,7,opt,name=http"`
+	// SLO generates the alert rule which fires when the availability of a
+	// target is lower than the objective.
+	// +optional
+	SLO *BlackboxProbeSLO `json:"slo,omitempty" protobuf:"bytes,8,opt,name=slo"`
+}
+
+// BlackboxProbeHTTP is the settings of probing HTTP targets.
+type BlackboxProbeHTTP struct {
+	// Method of the request. Defaults to GET.
+	// +optional
+	Method string `json:"method,omitempty" protobuf:"bytes,1,opt,name=method"`
+	// ValidStatusCodes are the expected status codes, empty means 2xx.
+	// +optional
+	ValidStatusCodes []int32 `json:"validStatusCodes,omitempty" protobuf:"bytes,2,rep,name=validStatusCodes"`
+	// InsecureSkipVerify skips verifying the certificates of the targets.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,3,opt,name=insecureSkipVerify"`
+}
+
+// BlackboxProbeSLO is the availability objective of the targets.
+type BlackboxProbeSLO struct {
+	// Objective is the percentage of the successful probes within the window,
+	// such as 99.9.
+	Objective string `json:"objective" protobuf:"bytes,1,opt,name=objective"`
+	// WindowSeconds is the window in which the availability is computed.
+	// Defaults to 3600.
+	// +optional
+	WindowSeconds int32 `json:"windowSeconds,omitempty" protobuf:"varint,2,opt,name=windowSeconds"`
+	// Notify describes where the alerts are sent.
+	Notify BlackboxProbeNotify `json:"notify" protobuf:"bytes,3,opt,name=notify"`
+}
+
+// BlackboxProbeNotify describes the channel, template and receivers of the
+// alerts.
+type BlackboxProbeNotify struct {
+	Channel  string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	Template string `json:"template" protobuf:"bytes,2,opt,name=template"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,4,rep,name=receiverGroups"`
+}
+
+// BlackboxProbeStatus is information about the current status of a
+// BlackboxProbe.
+type BlackboxProbeStatus struct {
+	// +optional
+	Phase BlackboxProbePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=BlackboxProbePhase"`
+	// A human readable message indicating details about why the probe is in
+	// this phase.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+}
+
+// BlackboxProbePhase is the phase of the blackbox probe.
+type BlackboxProbePhase string
+
+const (
+	// BlackboxProbePending means the probe is waiting to be deployed.
+	BlackboxProbePending BlackboxProbePhase = "Pending"
+	// BlackboxProbeRunning means the probe is deployed to the cluster.
+	BlackboxProbeRunning BlackboxProbePhase = "Running"
+	// BlackboxProbeFailed means the probe failed to be deployed.
+	BlackboxProbeFailed BlackboxProbePhase = "Failed"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
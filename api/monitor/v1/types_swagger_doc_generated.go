@@ -29,6 +29,78 @@ package v1
 // Those methods can be generated by using hack/update-generated-swagger-docs.sh
 
 // AUTO-GENERATED FUNCTIONS START HERE. DO NOT EDIT.
+var map_BlackboxProbe = map[string]string{
+	"":     "BlackboxProbe probes the availability of the targets from a cluster through the blackbox exporter, and alerts when the availability breaches the SLO.",
+	"spec": "Spec defines the targets and SLO of BlackboxProbe.",
+}
+
+func (BlackboxProbe) SwaggerDoc() map[string]string {
+	return map_BlackboxProbe
+}
+
+var map_BlackboxProbeHTTP = map[string]string{
+	"":                   "BlackboxProbeHTTP is the settings of probing HTTP targets.",
+	"method":             "Method of the request. Defaults to GET.",
+	"validStatusCodes":   "ValidStatusCodes are the expected status codes, empty means 2xx.",
+	"insecureSkipVerify": "InsecureSkipVerify skips verifying the certificates of the targets.",
+}
+
+func (BlackboxProbeHTTP) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeHTTP
+}
+
+var map_BlackboxProbeList = map[string]string{
+	"":      "BlackboxProbeList is the whole list of all BlackboxProbes.",
+	"items": "List of BlackboxProbes",
+}
+
+func (BlackboxProbeList) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeList
+}
+
+var map_BlackboxProbeNotify = map[string]string{
+	"": "BlackboxProbeNotify describes the channel, template and receivers of the alerts.",
+}
+
+func (BlackboxProbeNotify) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeNotify
+}
+
+var map_BlackboxProbeSLO = map[string]string{
+	"":              "BlackboxProbeSLO is the availability objective of the targets.",
+	"objective":     "Objective is the percentage of the successful probes within the window, such as 99.9.",
+	"windowSeconds": "WindowSeconds is the window in which the availability is computed. Defaults to 3600.",
+	"notify":        "Notify describes where the alerts are sent.",
+}
+
+func (BlackboxProbeSLO) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeSLO
+}
+
+var map_BlackboxProbeSpec = map[string]string{
+	"":                "BlackboxProbeSpec describes the attributes of a BlackboxProbe.",
+	"module":          "Module is the protocol of probing, HTTP, TCP or ICMP.",
+	"targets":         "Targets are the urls of HTTP, the host:port of TCP or the hosts of ICMP, such as http://nginx.default.svc/healthz.",
+	"intervalSeconds": "IntervalSeconds is the interval of probing. Defaults to 30.",
+	"timeoutSeconds":  "TimeoutSeconds is the timeout of each probing, which must be less than the interval. Defaults to 5.",
+	"http":            "HTTP is the settings of the HTTP module.",
+	"slo":             "SLO generates the alert rule which fires when the availability of a target is lower than the objective.",
+}
+
+func (BlackboxProbeSpec) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeSpec
+}
+
+var map_BlackboxProbeStatus = map[string]string{
+	"":                   "BlackboxProbeStatus is information about the current status of a BlackboxProbe.",
+	"message":            "A human readable message indicating details about why the probe is in this phase.",
+	"lastTransitionTime": "The last time the phase transitioned from one to another.",
+}
+
+func (BlackboxProbeStatus) SwaggerDoc() map[string]string {
+	return map_BlackboxProbeStatus
+}
+
 var map_ClusterOverview = map[string]string{
 	"": "ClusterOverview defines the structure for querying clusters' overview data request and result.",
 }
@@ -56,6 +128,129 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_EventAlertNotify = map[string]string{
+	"": "EventAlertNotify describes the channel, template and receivers of the alerts.",
+}
+
+func (EventAlertNotify) SwaggerDoc() map[string]string {
+	return map_EventAlertNotify
+}
+
+var map_EventAlertPolicy = map[string]string{
+	"":     "EventAlertPolicy watches the events of a cluster and sends alerts through notify when the events matching the rules exceed the thresholds.",
+	"spec": "Spec defines the rules and notify settings of EventAlertPolicy.",
+}
+
+func (EventAlertPolicy) SwaggerDoc() map[string]string {
+	return map_EventAlertPolicy
+}
+
+var map_EventAlertPolicyList = map[string]string{
+	"":      "EventAlertPolicyList is the whole list of all EventAlertPolicies.",
+	"items": "List of EventAlertPolicies",
+}
+
+func (EventAlertPolicyList) SwaggerDoc() map[string]string {
+	return map_EventAlertPolicyList
+}
+
+var map_EventAlertPolicySpec = map[string]string{
+	"":               "EventAlertPolicySpec describes the attributes of an EventAlertPolicy.",
+	"rules":          "Rules are the patterns of events, an alert is sent for each rule whose threshold is exceeded.",
+	"notify":         "Notify describes where the alerts are sent.",
+	"silenceSeconds": "SilenceSeconds is the interval within which the alert of a rule is not sent again. Defaults to 1800.",
+}
+
+func (EventAlertPolicySpec) SwaggerDoc() map[string]string {
+	return map_EventAlertPolicySpec
+}
+
+var map_EventAlertPolicyStatus = map[string]string{
+	"":                 "EventAlertPolicyStatus is information about the alerts of an EventAlertPolicy.",
+	"lastAlertTime":    "LastAlertTime is the time when the last alert was sent.",
+	"lastAlertRule":    "LastAlertRule is the rule of the last alert.",
+	"lastAlertMessage": "LastAlertMessage is the message of the latest event of the last alert.",
+}
+
+func (EventAlertPolicyStatus) SwaggerDoc() map[string]string {
+	return map_EventAlertPolicyStatus
+}
+
+var map_EventAlertRule = map[string]string{
+	"":                    "EventAlertRule matches the events by reason, involved object and type, and fires when the number of the matched events within the window reaches the threshold.",
+	"reasons":             "Reasons of the events, such as FailedScheduling and BackOff.",
+	"involvedObjectKinds": "InvolvedObjectKinds are the kinds of the involved objects, empty means all kinds.",
+	"namespaces":          "Namespaces of the involved objects, empty means all namespaces.",
+	"type":                "Type of the events, Warning or Normal, empty means both.",
+	"threshold":           "Threshold is the number of the matched events within the window which fires the alert. Defaults to 1.",
+	"windowSeconds":       "WindowSeconds is the sliding window counting the events. Defaults to 300.",
+}
+
+func (EventAlertRule) SwaggerDoc() map[string]string {
+	return map_EventAlertRule
+}
+
+var map_FederatedQuery = map[string]string{
+	"":     "FederatedQuery defines the structure for querying the prometheus of multiple clusters request and result.",
+	"spec": "Spec defines the query and the clusters to query.",
+}
+
+func (FederatedQuery) SwaggerDoc() map[string]string {
+	return map_FederatedQuery
+}
+
+var map_FederatedQueryError = map[string]string{
+	"": "FederatedQueryError is the failure of querying a cluster.",
+}
+
+func (FederatedQueryError) SwaggerDoc() map[string]string {
+	return map_FederatedQueryError
+}
+
+var map_FederatedQueryResult = map[string]string{
+	"":       "FederatedQueryResult is the merged result of the clusters.",
+	"series": "Series are the series of all clusters, each of which has the cluster_id label.",
+	"errors": "Errors are the clusters failed to query, the result is partial if any.",
+}
+
+func (FederatedQueryResult) SwaggerDoc() map[string]string {
+	return map_FederatedQueryResult
+}
+
+var map_FederatedQuerySample = map[string]string{
+	"":          "FederatedQuerySample is a value of a series at a time.",
+	"timestamp": "Timestamp in milliseconds.",
+	"value":     "Value is formatted by prometheus, which may be NaN or Inf.",
+}
+
+func (FederatedQuerySample) SwaggerDoc() map[string]string {
+	return map_FederatedQuerySample
+}
+
+var map_FederatedQuerySeries = map[string]string{
+	"":       "FederatedQuerySeries is a series returned by the prometheus of a cluster.",
+	"metric": "Metric is the labels of the series.",
+	"values": "Values has one sample for an instant query and the samples in the time range for a range query.",
+}
+
+func (FederatedQuerySeries) SwaggerDoc() map[string]string {
+	return map_FederatedQuerySeries
+}
+
+var map_FederatedQuerySpec = map[string]string{
+	"":                "FederatedQuerySpec describes the PromQL query and the clusters to fan out.",
+	"query":           "Query is the PromQL expression evaluated by the prometheus of each cluster.",
+	"clusters":        "Clusters are the names of the clusters to query, all clusters of the tenant if not specified.",
+	"clusterSelector": "ClusterSelector is a label selector of the clusters to query.",
+	"time":            "Time is the evaluation time of an instant query in milliseconds, defaults to now.",
+	"startTime":       "StartTime and EndTime in milliseconds make the query a range query.",
+	"stepSeconds":     "StepSeconds is the resolution of a range query, defaults to 60.",
+}
+
+func (FederatedQuerySpec) SwaggerDoc() map[string]string {
+	return map_FederatedQuerySpec
+}
+
 var map_Metric = map[string]string{
 	"": "Metric defines the structure for querying monitoring data requests and results.",
 }
@@ -73,6 +268,84 @@ func (MetricList) SwaggerDoc() map[string]string {
 	return map_MetricList
 }
 
+var map_ProjectNamespaceUsage = map[string]string{
+	"": "ProjectNamespaceUsage is the aggregated usage of a namespace of a project.",
+}
+
+func (ProjectNamespaceUsage) SwaggerDoc() map[string]string {
+	return map_ProjectNamespaceUsage
+}
+
+var map_ProjectResourceUsage = map[string]string{
+	"": "ProjectResourceUsage is the usage of cpu in cores, memory and storage in bytes and gpu in cards, or the accumulated usage of them.",
+}
+
+func (ProjectResourceUsage) SwaggerDoc() map[string]string {
+	return map_ProjectResourceUsage
+}
+
+var map_ProjectUsage = map[string]string{
+	"":     "ProjectUsage defines the structure for querying the resource usage of business projects request and result.",
+	"spec": "Spec defines the projects and the time range to aggregate.",
+}
+
+func (ProjectUsage) SwaggerDoc() map[string]string {
+	return map_ProjectUsage
+}
+
+var map_ProjectUsageCostWeights = map[string]string{
+	"":        "ProjectUsageCostWeights are the prices of resources per hour.",
+	"cpu":     "CPU is the price of one core per hour.",
+	"memory":  "Memory is the price of one GiB memory per hour.",
+	"gpu":     "GPU is the price of one gpu per hour.",
+	"storage": "Storage is the price of one GiB requested persistent volume per hour.",
+}
+
+func (ProjectUsageCostWeights) SwaggerDoc() map[string]string {
+	return map_ProjectUsageCostWeights
+}
+
+var map_ProjectUsagePoint = map[string]string{
+	"":          "ProjectUsagePoint is the usage of a project at a time.",
+	"timestamp": "Timestamp in milliseconds.",
+}
+
+func (ProjectUsagePoint) SwaggerDoc() map[string]string {
+	return map_ProjectUsagePoint
+}
+
+var map_ProjectUsageResult = map[string]string{
+	"": "ProjectUsageResult is the aggregated usage of the projects.",
+}
+
+func (ProjectUsageResult) SwaggerDoc() map[string]string {
+	return map_ProjectUsageResult
+}
+
+var map_ProjectUsageSpec = map[string]string{
+	"":            "ProjectUsageSpec describes the projects and the time range of a usage query.",
+	"projects":    "Projects are the names of the business projects to aggregate, all projects of the tenant if not specified.",
+	"startTime":   "StartTime is the beginning of the time range in milliseconds.",
+	"endTime":     "EndTime is the end of the time range in milliseconds.",
+	"stepSeconds": "StepSeconds is the resolution of the time series, defaults to 3600.",
+	"withSeries":  "WithSeries returns the time series of each project along with the summary.",
+	"costWeights": "CostWeights are the prices used to calculate the cost of the usage, no cost is calculated if not specified.",
+}
+
+func (ProjectUsageSpec) SwaggerDoc() map[string]string {
+	return map_ProjectUsageSpec
+}
+
+var map_ProjectUsageSummary = map[string]string{
+	"":        "ProjectUsageSummary is the aggregated usage of a project.",
+	"average": "Average is the average usage over the time range.",
+	"total":   "Total is the accumulated usage over the time range, in core-hours, GiB-hours and gpu-hours.",
+}
+
+func (ProjectUsageSummary) SwaggerDoc() map[string]string {
+	return map_ProjectUsageSummary
+}
+
 var map_Prometheus = map[string]string{
 	"":     "Prometheus is a kubernetes package manager.",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -37,6 +37,76 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbe)(nil), (*monitor.BlackboxProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbe_To_monitor_BlackboxProbe(a.(*BlackboxProbe), b.(*monitor.BlackboxProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbe)(nil), (*BlackboxProbe)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbe_To_v1_BlackboxProbe(a.(*monitor.BlackboxProbe), b.(*BlackboxProbe), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeHTTP)(nil), (*monitor.BlackboxProbeHTTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeHTTP_To_monitor_BlackboxProbeHTTP(a.(*BlackboxProbeHTTP), b.(*monitor.BlackboxProbeHTTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeHTTP)(nil), (*BlackboxProbeHTTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeHTTP_To_v1_BlackboxProbeHTTP(a.(*monitor.BlackboxProbeHTTP), b.(*BlackboxProbeHTTP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeList)(nil), (*monitor.BlackboxProbeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeList_To_monitor_BlackboxProbeList(a.(*BlackboxProbeList), b.(*monitor.BlackboxProbeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeList)(nil), (*BlackboxProbeList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeList_To_v1_BlackboxProbeList(a.(*monitor.BlackboxProbeList), b.(*BlackboxProbeList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeNotify)(nil), (*monitor.BlackboxProbeNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify(a.(*BlackboxProbeNotify), b.(*monitor.BlackboxProbeNotify), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeNotify)(nil), (*BlackboxProbeNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify(a.(*monitor.BlackboxProbeNotify), b.(*BlackboxProbeNotify), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeSLO)(nil), (*monitor.BlackboxProbeSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeSLO_To_monitor_BlackboxProbeSLO(a.(*BlackboxProbeSLO), b.(*monitor.BlackboxProbeSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeSLO)(nil), (*BlackboxProbeSLO)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeSLO_To_v1_BlackboxProbeSLO(a.(*monitor.BlackboxProbeSLO), b.(*BlackboxProbeSLO), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeSpec)(nil), (*monitor.BlackboxProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec(a.(*BlackboxProbeSpec), b.(*monitor.BlackboxProbeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeSpec)(nil), (*BlackboxProbeSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec(a.(*monitor.BlackboxProbeSpec), b.(*BlackboxProbeSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlackboxProbeStatus)(nil), (*monitor.BlackboxProbeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus(a.(*BlackboxProbeStatus), b.(*monitor.BlackboxProbeStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.BlackboxProbeStatus)(nil), (*BlackboxProbeStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus(a.(*monitor.BlackboxProbeStatus), b.(*BlackboxProbeStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterOverview)(nil), (*monitor.ClusterOverview)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterOverview_To_monitor_ClusterOverview(a.(*ClusterOverview), b.(*monitor.ClusterOverview), scope)
 	}); err != nil {
@@ -87,6 +157,126 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertNotify)(nil), (*monitor.EventAlertNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertNotify_To_monitor_EventAlertNotify(a.(*EventAlertNotify), b.(*monitor.EventAlertNotify), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertNotify)(nil), (*EventAlertNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertNotify_To_v1_EventAlertNotify(a.(*monitor.EventAlertNotify), b.(*EventAlertNotify), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertPolicy)(nil), (*monitor.EventAlertPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertPolicy_To_monitor_EventAlertPolicy(a.(*EventAlertPolicy), b.(*monitor.EventAlertPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertPolicy)(nil), (*EventAlertPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertPolicy_To_v1_EventAlertPolicy(a.(*monitor.EventAlertPolicy), b.(*EventAlertPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertPolicyList)(nil), (*monitor.EventAlertPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertPolicyList_To_monitor_EventAlertPolicyList(a.(*EventAlertPolicyList), b.(*monitor.EventAlertPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertPolicyList)(nil), (*EventAlertPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertPolicyList_To_v1_EventAlertPolicyList(a.(*monitor.EventAlertPolicyList), b.(*EventAlertPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertPolicySpec)(nil), (*monitor.EventAlertPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec(a.(*EventAlertPolicySpec), b.(*monitor.EventAlertPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertPolicySpec)(nil), (*EventAlertPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec(a.(*monitor.EventAlertPolicySpec), b.(*EventAlertPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertPolicyStatus)(nil), (*monitor.EventAlertPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus(a.(*EventAlertPolicyStatus), b.(*monitor.EventAlertPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertPolicyStatus)(nil), (*EventAlertPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus(a.(*monitor.EventAlertPolicyStatus), b.(*EventAlertPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EventAlertRule)(nil), (*monitor.EventAlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EventAlertRule_To_monitor_EventAlertRule(a.(*EventAlertRule), b.(*monitor.EventAlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.EventAlertRule)(nil), (*EventAlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_EventAlertRule_To_v1_EventAlertRule(a.(*monitor.EventAlertRule), b.(*EventAlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQuery)(nil), (*monitor.FederatedQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQuery_To_monitor_FederatedQuery(a.(*FederatedQuery), b.(*monitor.FederatedQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQuery)(nil), (*FederatedQuery)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQuery_To_v1_FederatedQuery(a.(*monitor.FederatedQuery), b.(*FederatedQuery), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQueryError)(nil), (*monitor.FederatedQueryError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQueryError_To_monitor_FederatedQueryError(a.(*FederatedQueryError), b.(*monitor.FederatedQueryError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQueryError)(nil), (*FederatedQueryError)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQueryError_To_v1_FederatedQueryError(a.(*monitor.FederatedQueryError), b.(*FederatedQueryError), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQueryResult)(nil), (*monitor.FederatedQueryResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQueryResult_To_monitor_FederatedQueryResult(a.(*FederatedQueryResult), b.(*monitor.FederatedQueryResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQueryResult)(nil), (*FederatedQueryResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQueryResult_To_v1_FederatedQueryResult(a.(*monitor.FederatedQueryResult), b.(*FederatedQueryResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQuerySample)(nil), (*monitor.FederatedQuerySample)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQuerySample_To_monitor_FederatedQuerySample(a.(*FederatedQuerySample), b.(*monitor.FederatedQuerySample), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQuerySample)(nil), (*FederatedQuerySample)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQuerySample_To_v1_FederatedQuerySample(a.(*monitor.FederatedQuerySample), b.(*FederatedQuerySample), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQuerySeries)(nil), (*monitor.FederatedQuerySeries)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQuerySeries_To_monitor_FederatedQuerySeries(a.(*FederatedQuerySeries), b.(*monitor.FederatedQuerySeries), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQuerySeries)(nil), (*FederatedQuerySeries)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQuerySeries_To_v1_FederatedQuerySeries(a.(*monitor.FederatedQuerySeries), b.(*FederatedQuerySeries), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FederatedQuerySpec)(nil), (*monitor.FederatedQuerySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec(a.(*FederatedQuerySpec), b.(*monitor.FederatedQuerySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.FederatedQuerySpec)(nil), (*FederatedQuerySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec(a.(*monitor.FederatedQuerySpec), b.(*FederatedQuerySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Metric)(nil), (*monitor.Metric)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Metric_To_monitor_Metric(a.(*Metric), b.(*monitor.Metric), scope)
 	}); err != nil {
@@ -127,6 +317,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectNamespaceUsage)(nil), (*monitor.ProjectNamespaceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectNamespaceUsage_To_monitor_ProjectNamespaceUsage(a.(*ProjectNamespaceUsage), b.(*monitor.ProjectNamespaceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectNamespaceUsage)(nil), (*ProjectNamespaceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectNamespaceUsage_To_v1_ProjectNamespaceUsage(a.(*monitor.ProjectNamespaceUsage), b.(*ProjectNamespaceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectResourceUsage)(nil), (*monitor.ProjectResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(a.(*ProjectResourceUsage), b.(*monitor.ProjectResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectResourceUsage)(nil), (*ProjectResourceUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(a.(*monitor.ProjectResourceUsage), b.(*ProjectResourceUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsage)(nil), (*monitor.ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsage_To_monitor_ProjectUsage(a.(*ProjectUsage), b.(*monitor.ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsage)(nil), (*ProjectUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsage_To_v1_ProjectUsage(a.(*monitor.ProjectUsage), b.(*ProjectUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageCostWeights)(nil), (*monitor.ProjectUsageCostWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageCostWeights_To_monitor_ProjectUsageCostWeights(a.(*ProjectUsageCostWeights), b.(*monitor.ProjectUsageCostWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageCostWeights)(nil), (*ProjectUsageCostWeights)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageCostWeights_To_v1_ProjectUsageCostWeights(a.(*monitor.ProjectUsageCostWeights), b.(*ProjectUsageCostWeights), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsagePoint)(nil), (*monitor.ProjectUsagePoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsagePoint_To_monitor_ProjectUsagePoint(a.(*ProjectUsagePoint), b.(*monitor.ProjectUsagePoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsagePoint)(nil), (*ProjectUsagePoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsagePoint_To_v1_ProjectUsagePoint(a.(*monitor.ProjectUsagePoint), b.(*ProjectUsagePoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageResult)(nil), (*monitor.ProjectUsageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageResult_To_monitor_ProjectUsageResult(a.(*ProjectUsageResult), b.(*monitor.ProjectUsageResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageResult)(nil), (*ProjectUsageResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageResult_To_v1_ProjectUsageResult(a.(*monitor.ProjectUsageResult), b.(*ProjectUsageResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageSpec)(nil), (*monitor.ProjectUsageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(a.(*ProjectUsageSpec), b.(*monitor.ProjectUsageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageSpec)(nil), (*ProjectUsageSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(a.(*monitor.ProjectUsageSpec), b.(*ProjectUsageSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectUsageSummary)(nil), (*monitor.ProjectUsageSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectUsageSummary_To_monitor_ProjectUsageSummary(a.(*ProjectUsageSummary), b.(*monitor.ProjectUsageSummary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*monitor.ProjectUsageSummary)(nil), (*ProjectUsageSummary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_monitor_ProjectUsageSummary_To_v1_ProjectUsageSummary(a.(*monitor.ProjectUsageSummary), b.(*ProjectUsageSummary), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*monitor.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Prometheus_To_monitor_Prometheus(a.(*Prometheus), b.(*monitor.Prometheus), scope)
 	}); err != nil {
@@ -190,6 +460,196 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_BlackboxProbe_To_monitor_BlackboxProbe(in *BlackboxProbe, out *monitor.BlackboxProbe, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_BlackboxProbe_To_monitor_BlackboxProbe is an autogenerated conversion function.
+func Convert_v1_BlackboxProbe_To_monitor_BlackboxProbe(in *BlackboxProbe, out *monitor.BlackboxProbe, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbe_To_monitor_BlackboxProbe(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbe_To_v1_BlackboxProbe(in *monitor.BlackboxProbe, out *BlackboxProbe, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitor_BlackboxProbe_To_v1_BlackboxProbe is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbe_To_v1_BlackboxProbe(in *monitor.BlackboxProbe, out *BlackboxProbe, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbe_To_v1_BlackboxProbe(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeHTTP_To_monitor_BlackboxProbeHTTP(in *BlackboxProbeHTTP, out *monitor.BlackboxProbeHTTP, s conversion.Scope) error {
+	out.Method = in.Method
+	out.ValidStatusCodes = *(*[]int32)(unsafe.Pointer(&in.ValidStatusCodes))
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1_BlackboxProbeHTTP_To_monitor_BlackboxProbeHTTP is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeHTTP_To_monitor_BlackboxProbeHTTP(in *BlackboxProbeHTTP, out *monitor.BlackboxProbeHTTP, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeHTTP_To_monitor_BlackboxProbeHTTP(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeHTTP_To_v1_BlackboxProbeHTTP(in *monitor.BlackboxProbeHTTP, out *BlackboxProbeHTTP, s conversion.Scope) error {
+	out.Method = in.Method
+	out.ValidStatusCodes = *(*[]int32)(unsafe.Pointer(&in.ValidStatusCodes))
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeHTTP_To_v1_BlackboxProbeHTTP is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeHTTP_To_v1_BlackboxProbeHTTP(in *monitor.BlackboxProbeHTTP, out *BlackboxProbeHTTP, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeHTTP_To_v1_BlackboxProbeHTTP(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeList_To_monitor_BlackboxProbeList(in *BlackboxProbeList, out *monitor.BlackboxProbeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]monitor.BlackboxProbe)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_BlackboxProbeList_To_monitor_BlackboxProbeList is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeList_To_monitor_BlackboxProbeList(in *BlackboxProbeList, out *monitor.BlackboxProbeList, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeList_To_monitor_BlackboxProbeList(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeList_To_v1_BlackboxProbeList(in *monitor.BlackboxProbeList, out *BlackboxProbeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]BlackboxProbe)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeList_To_v1_BlackboxProbeList is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeList_To_v1_BlackboxProbeList(in *monitor.BlackboxProbeList, out *BlackboxProbeList, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeList_To_v1_BlackboxProbeList(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify(in *BlackboxProbeNotify, out *monitor.BlackboxProbeNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify(in *BlackboxProbeNotify, out *monitor.BlackboxProbeNotify, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify(in *monitor.BlackboxProbeNotify, out *BlackboxProbeNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify(in *monitor.BlackboxProbeNotify, out *BlackboxProbeNotify, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeSLO_To_monitor_BlackboxProbeSLO(in *BlackboxProbeSLO, out *monitor.BlackboxProbeSLO, s conversion.Scope) error {
+	out.Objective = in.Objective
+	out.WindowSeconds = in.WindowSeconds
+	if err := Convert_v1_BlackboxProbeNotify_To_monitor_BlackboxProbeNotify(&in.Notify, &out.Notify, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_BlackboxProbeSLO_To_monitor_BlackboxProbeSLO is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeSLO_To_monitor_BlackboxProbeSLO(in *BlackboxProbeSLO, out *monitor.BlackboxProbeSLO, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeSLO_To_monitor_BlackboxProbeSLO(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeSLO_To_v1_BlackboxProbeSLO(in *monitor.BlackboxProbeSLO, out *BlackboxProbeSLO, s conversion.Scope) error {
+	out.Objective = in.Objective
+	out.WindowSeconds = in.WindowSeconds
+	if err := Convert_monitor_BlackboxProbeNotify_To_v1_BlackboxProbeNotify(&in.Notify, &out.Notify, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeSLO_To_v1_BlackboxProbeSLO is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeSLO_To_v1_BlackboxProbeSLO(in *monitor.BlackboxProbeSLO, out *BlackboxProbeSLO, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeSLO_To_v1_BlackboxProbeSLO(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec(in *BlackboxProbeSpec, out *monitor.BlackboxProbeSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Module = in.Module
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.HTTP = (*monitor.BlackboxProbeHTTP)(unsafe.Pointer(in.HTTP))
+	out.SLO = (*monitor.BlackboxProbeSLO)(unsafe.Pointer(in.SLO))
+	return nil
+}
+
+// Convert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec(in *BlackboxProbeSpec, out *monitor.BlackboxProbeSpec, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeSpec_To_monitor_BlackboxProbeSpec(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec(in *monitor.BlackboxProbeSpec, out *BlackboxProbeSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Module = in.Module
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.HTTP = (*BlackboxProbeHTTP)(unsafe.Pointer(in.HTTP))
+	out.SLO = (*BlackboxProbeSLO)(unsafe.Pointer(in.SLO))
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec(in *monitor.BlackboxProbeSpec, out *BlackboxProbeSpec, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeSpec_To_v1_BlackboxProbeSpec(in, out, s)
+}
+
+func autoConvert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus(in *BlackboxProbeStatus, out *monitor.BlackboxProbeStatus, s conversion.Scope) error {
+	out.Phase = monitor.BlackboxProbePhase(in.Phase)
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus is an autogenerated conversion function.
+func Convert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus(in *BlackboxProbeStatus, out *monitor.BlackboxProbeStatus, s conversion.Scope) error {
+	return autoConvert_v1_BlackboxProbeStatus_To_monitor_BlackboxProbeStatus(in, out, s)
+}
+
+func autoConvert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus(in *monitor.BlackboxProbeStatus, out *BlackboxProbeStatus, s conversion.Scope) error {
+	out.Phase = BlackboxProbePhase(in.Phase)
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus is an autogenerated conversion function.
+func Convert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus(in *monitor.BlackboxProbeStatus, out *BlackboxProbeStatus, s conversion.Scope) error {
+	return autoConvert_monitor_BlackboxProbeStatus_To_v1_BlackboxProbeStatus(in, out, s)
+}
+
 func autoConvert_v1_ClusterOverview_To_monitor_ClusterOverview(in *ClusterOverview, out *monitor.ClusterOverview, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Result = (*monitor.ClusterOverviewResult)(unsafe.Pointer(in.Result))
@@ -396,6 +856,324 @@ func Convert_monitor_ConfigMapList_To_v1_ConfigMapList(in *monitor.ConfigMapList
 	return autoConvert_monitor_ConfigMapList_To_v1_ConfigMapList(in, out, s)
 }
 
+func autoConvert_v1_EventAlertNotify_To_monitor_EventAlertNotify(in *EventAlertNotify, out *monitor.EventAlertNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_v1_EventAlertNotify_To_monitor_EventAlertNotify is an autogenerated conversion function.
+func Convert_v1_EventAlertNotify_To_monitor_EventAlertNotify(in *EventAlertNotify, out *monitor.EventAlertNotify, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertNotify_To_monitor_EventAlertNotify(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertNotify_To_v1_EventAlertNotify(in *monitor.EventAlertNotify, out *EventAlertNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	return nil
+}
+
+// Convert_monitor_EventAlertNotify_To_v1_EventAlertNotify is an autogenerated conversion function.
+func Convert_monitor_EventAlertNotify_To_v1_EventAlertNotify(in *monitor.EventAlertNotify, out *EventAlertNotify, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertNotify_To_v1_EventAlertNotify(in, out, s)
+}
+
+func autoConvert_v1_EventAlertPolicy_To_monitor_EventAlertPolicy(in *EventAlertPolicy, out *monitor.EventAlertPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_EventAlertPolicy_To_monitor_EventAlertPolicy is an autogenerated conversion function.
+func Convert_v1_EventAlertPolicy_To_monitor_EventAlertPolicy(in *EventAlertPolicy, out *monitor.EventAlertPolicy, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertPolicy_To_monitor_EventAlertPolicy(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertPolicy_To_v1_EventAlertPolicy(in *monitor.EventAlertPolicy, out *EventAlertPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitor_EventAlertPolicy_To_v1_EventAlertPolicy is an autogenerated conversion function.
+func Convert_monitor_EventAlertPolicy_To_v1_EventAlertPolicy(in *monitor.EventAlertPolicy, out *EventAlertPolicy, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertPolicy_To_v1_EventAlertPolicy(in, out, s)
+}
+
+func autoConvert_v1_EventAlertPolicyList_To_monitor_EventAlertPolicyList(in *EventAlertPolicyList, out *monitor.EventAlertPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]monitor.EventAlertPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_EventAlertPolicyList_To_monitor_EventAlertPolicyList is an autogenerated conversion function.
+func Convert_v1_EventAlertPolicyList_To_monitor_EventAlertPolicyList(in *EventAlertPolicyList, out *monitor.EventAlertPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertPolicyList_To_monitor_EventAlertPolicyList(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertPolicyList_To_v1_EventAlertPolicyList(in *monitor.EventAlertPolicyList, out *EventAlertPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]EventAlertPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_monitor_EventAlertPolicyList_To_v1_EventAlertPolicyList is an autogenerated conversion function.
+func Convert_monitor_EventAlertPolicyList_To_v1_EventAlertPolicyList(in *monitor.EventAlertPolicyList, out *EventAlertPolicyList, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertPolicyList_To_v1_EventAlertPolicyList(in, out, s)
+}
+
+func autoConvert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec(in *EventAlertPolicySpec, out *monitor.EventAlertPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Rules = *(*[]monitor.EventAlertRule)(unsafe.Pointer(&in.Rules))
+	if err := Convert_v1_EventAlertNotify_To_monitor_EventAlertNotify(&in.Notify, &out.Notify, s); err != nil {
+		return err
+	}
+	out.SilenceSeconds = in.SilenceSeconds
+	return nil
+}
+
+// Convert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec is an autogenerated conversion function.
+func Convert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec(in *EventAlertPolicySpec, out *monitor.EventAlertPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertPolicySpec_To_monitor_EventAlertPolicySpec(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec(in *monitor.EventAlertPolicySpec, out *EventAlertPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Rules = *(*[]EventAlertRule)(unsafe.Pointer(&in.Rules))
+	if err := Convert_monitor_EventAlertNotify_To_v1_EventAlertNotify(&in.Notify, &out.Notify, s); err != nil {
+		return err
+	}
+	out.SilenceSeconds = in.SilenceSeconds
+	return nil
+}
+
+// Convert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec is an autogenerated conversion function.
+func Convert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec(in *monitor.EventAlertPolicySpec, out *EventAlertPolicySpec, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertPolicySpec_To_v1_EventAlertPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus(in *EventAlertPolicyStatus, out *monitor.EventAlertPolicyStatus, s conversion.Scope) error {
+	out.LastAlertTime = in.LastAlertTime
+	out.LastAlertRule = in.LastAlertRule
+	out.LastAlertMessage = in.LastAlertMessage
+	return nil
+}
+
+// Convert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus is an autogenerated conversion function.
+func Convert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus(in *EventAlertPolicyStatus, out *monitor.EventAlertPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertPolicyStatus_To_monitor_EventAlertPolicyStatus(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus(in *monitor.EventAlertPolicyStatus, out *EventAlertPolicyStatus, s conversion.Scope) error {
+	out.LastAlertTime = in.LastAlertTime
+	out.LastAlertRule = in.LastAlertRule
+	out.LastAlertMessage = in.LastAlertMessage
+	return nil
+}
+
+// Convert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus is an autogenerated conversion function.
+func Convert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus(in *monitor.EventAlertPolicyStatus, out *EventAlertPolicyStatus, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertPolicyStatus_To_v1_EventAlertPolicyStatus(in, out, s)
+}
+
+func autoConvert_v1_EventAlertRule_To_monitor_EventAlertRule(in *EventAlertRule, out *monitor.EventAlertRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.InvolvedObjectKinds = *(*[]string)(unsafe.Pointer(&in.InvolvedObjectKinds))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Type = in.Type
+	out.Threshold = in.Threshold
+	out.WindowSeconds = in.WindowSeconds
+	return nil
+}
+
+// Convert_v1_EventAlertRule_To_monitor_EventAlertRule is an autogenerated conversion function.
+func Convert_v1_EventAlertRule_To_monitor_EventAlertRule(in *EventAlertRule, out *monitor.EventAlertRule, s conversion.Scope) error {
+	return autoConvert_v1_EventAlertRule_To_monitor_EventAlertRule(in, out, s)
+}
+
+func autoConvert_monitor_EventAlertRule_To_v1_EventAlertRule(in *monitor.EventAlertRule, out *EventAlertRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reasons = *(*[]string)(unsafe.Pointer(&in.Reasons))
+	out.InvolvedObjectKinds = *(*[]string)(unsafe.Pointer(&in.InvolvedObjectKinds))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Type = in.Type
+	out.Threshold = in.Threshold
+	out.WindowSeconds = in.WindowSeconds
+	return nil
+}
+
+// Convert_monitor_EventAlertRule_To_v1_EventAlertRule is an autogenerated conversion function.
+func Convert_monitor_EventAlertRule_To_v1_EventAlertRule(in *monitor.EventAlertRule, out *EventAlertRule, s conversion.Scope) error {
+	return autoConvert_monitor_EventAlertRule_To_v1_EventAlertRule(in, out, s)
+}
+
+func autoConvert_v1_FederatedQuery_To_monitor_FederatedQuery(in *FederatedQuery, out *monitor.FederatedQuery, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Result = (*monitor.FederatedQueryResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_v1_FederatedQuery_To_monitor_FederatedQuery is an autogenerated conversion function.
+func Convert_v1_FederatedQuery_To_monitor_FederatedQuery(in *FederatedQuery, out *monitor.FederatedQuery, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQuery_To_monitor_FederatedQuery(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQuery_To_v1_FederatedQuery(in *monitor.FederatedQuery, out *FederatedQuery, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Result = (*FederatedQueryResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_monitor_FederatedQuery_To_v1_FederatedQuery is an autogenerated conversion function.
+func Convert_monitor_FederatedQuery_To_v1_FederatedQuery(in *monitor.FederatedQuery, out *FederatedQuery, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQuery_To_v1_FederatedQuery(in, out, s)
+}
+
+func autoConvert_v1_FederatedQueryError_To_monitor_FederatedQueryError(in *FederatedQueryError, out *monitor.FederatedQueryError, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_FederatedQueryError_To_monitor_FederatedQueryError is an autogenerated conversion function.
+func Convert_v1_FederatedQueryError_To_monitor_FederatedQueryError(in *FederatedQueryError, out *monitor.FederatedQueryError, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQueryError_To_monitor_FederatedQueryError(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQueryError_To_v1_FederatedQueryError(in *monitor.FederatedQueryError, out *FederatedQueryError, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_monitor_FederatedQueryError_To_v1_FederatedQueryError is an autogenerated conversion function.
+func Convert_monitor_FederatedQueryError_To_v1_FederatedQueryError(in *monitor.FederatedQueryError, out *FederatedQueryError, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQueryError_To_v1_FederatedQueryError(in, out, s)
+}
+
+func autoConvert_v1_FederatedQueryResult_To_monitor_FederatedQueryResult(in *FederatedQueryResult, out *monitor.FederatedQueryResult, s conversion.Scope) error {
+	out.Series = *(*[]monitor.FederatedQuerySeries)(unsafe.Pointer(&in.Series))
+	out.Errors = *(*[]monitor.FederatedQueryError)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_v1_FederatedQueryResult_To_monitor_FederatedQueryResult is an autogenerated conversion function.
+func Convert_v1_FederatedQueryResult_To_monitor_FederatedQueryResult(in *FederatedQueryResult, out *monitor.FederatedQueryResult, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQueryResult_To_monitor_FederatedQueryResult(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQueryResult_To_v1_FederatedQueryResult(in *monitor.FederatedQueryResult, out *FederatedQueryResult, s conversion.Scope) error {
+	out.Series = *(*[]FederatedQuerySeries)(unsafe.Pointer(&in.Series))
+	out.Errors = *(*[]FederatedQueryError)(unsafe.Pointer(&in.Errors))
+	return nil
+}
+
+// Convert_monitor_FederatedQueryResult_To_v1_FederatedQueryResult is an autogenerated conversion function.
+func Convert_monitor_FederatedQueryResult_To_v1_FederatedQueryResult(in *monitor.FederatedQueryResult, out *FederatedQueryResult, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQueryResult_To_v1_FederatedQueryResult(in, out, s)
+}
+
+func autoConvert_v1_FederatedQuerySample_To_monitor_FederatedQuerySample(in *FederatedQuerySample, out *monitor.FederatedQuerySample, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_v1_FederatedQuerySample_To_monitor_FederatedQuerySample is an autogenerated conversion function.
+func Convert_v1_FederatedQuerySample_To_monitor_FederatedQuerySample(in *FederatedQuerySample, out *monitor.FederatedQuerySample, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQuerySample_To_monitor_FederatedQuerySample(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQuerySample_To_v1_FederatedQuerySample(in *monitor.FederatedQuerySample, out *FederatedQuerySample, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	out.Value = in.Value
+	return nil
+}
+
+// Convert_monitor_FederatedQuerySample_To_v1_FederatedQuerySample is an autogenerated conversion function.
+func Convert_monitor_FederatedQuerySample_To_v1_FederatedQuerySample(in *monitor.FederatedQuerySample, out *FederatedQuerySample, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQuerySample_To_v1_FederatedQuerySample(in, out, s)
+}
+
+func autoConvert_v1_FederatedQuerySeries_To_monitor_FederatedQuerySeries(in *FederatedQuerySeries, out *monitor.FederatedQuerySeries, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Metric = *(*map[string]string)(unsafe.Pointer(&in.Metric))
+	out.Values = *(*[]monitor.FederatedQuerySample)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_v1_FederatedQuerySeries_To_monitor_FederatedQuerySeries is an autogenerated conversion function.
+func Convert_v1_FederatedQuerySeries_To_monitor_FederatedQuerySeries(in *FederatedQuerySeries, out *monitor.FederatedQuerySeries, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQuerySeries_To_monitor_FederatedQuerySeries(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQuerySeries_To_v1_FederatedQuerySeries(in *monitor.FederatedQuerySeries, out *FederatedQuerySeries, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Metric = *(*map[string]string)(unsafe.Pointer(&in.Metric))
+	out.Values = *(*[]FederatedQuerySample)(unsafe.Pointer(&in.Values))
+	return nil
+}
+
+// Convert_monitor_FederatedQuerySeries_To_v1_FederatedQuerySeries is an autogenerated conversion function.
+func Convert_monitor_FederatedQuerySeries_To_v1_FederatedQuerySeries(in *monitor.FederatedQuerySeries, out *FederatedQuerySeries, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQuerySeries_To_v1_FederatedQuerySeries(in, out, s)
+}
+
+func autoConvert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec(in *FederatedQuerySpec, out *monitor.FederatedQuerySpec, s conversion.Scope) error {
+	out.Query = in.Query
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.ClusterSelector = in.ClusterSelector
+	out.Time = in.Time
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.StepSeconds = in.StepSeconds
+	return nil
+}
+
+// Convert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec is an autogenerated conversion function.
+func Convert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec(in *FederatedQuerySpec, out *monitor.FederatedQuerySpec, s conversion.Scope) error {
+	return autoConvert_v1_FederatedQuerySpec_To_monitor_FederatedQuerySpec(in, out, s)
+}
+
+func autoConvert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec(in *monitor.FederatedQuerySpec, out *FederatedQuerySpec, s conversion.Scope) error {
+	out.Query = in.Query
+	out.Clusters = *(*[]string)(unsafe.Pointer(&in.Clusters))
+	out.ClusterSelector = in.ClusterSelector
+	out.Time = in.Time
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.StepSeconds = in.StepSeconds
+	return nil
+}
+
+// Convert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec is an autogenerated conversion function.
+func Convert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec(in *monitor.FederatedQuerySpec, out *FederatedQuerySpec, s conversion.Scope) error {
+	return autoConvert_monitor_FederatedQuerySpec_To_v1_FederatedQuerySpec(in, out, s)
+}
+
 func autoConvert_v1_Metric_To_monitor_Metric(in *Metric, out *monitor.Metric, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_MetricQuery_To_monitor_MetricQuery(&in.Query, &out.Query, s); err != nil {
@@ -508,6 +1286,236 @@ func Convert_monitor_MetricQueryCondition_To_v1_MetricQueryCondition(in *monitor
 	return autoConvert_monitor_MetricQueryCondition_To_v1_MetricQueryCondition(in, out, s)
 }
 
+func autoConvert_v1_ProjectNamespaceUsage_To_monitor_ProjectNamespaceUsage(in *ProjectNamespaceUsage, out *monitor.ProjectNamespaceUsage, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	if err := Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(&in.Average, &out.Average, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_v1_ProjectNamespaceUsage_To_monitor_ProjectNamespaceUsage is an autogenerated conversion function.
+func Convert_v1_ProjectNamespaceUsage_To_monitor_ProjectNamespaceUsage(in *ProjectNamespaceUsage, out *monitor.ProjectNamespaceUsage, s conversion.Scope) error {
+	return autoConvert_v1_ProjectNamespaceUsage_To_monitor_ProjectNamespaceUsage(in, out, s)
+}
+
+func autoConvert_monitor_ProjectNamespaceUsage_To_v1_ProjectNamespaceUsage(in *monitor.ProjectNamespaceUsage, out *ProjectNamespaceUsage, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Namespace = in.Namespace
+	if err := Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(&in.Average, &out.Average, s); err != nil {
+		return err
+	}
+	if err := Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	out.Cost = in.Cost
+	return nil
+}
+
+// Convert_monitor_ProjectNamespaceUsage_To_v1_ProjectNamespaceUsage is an autogenerated conversion function.
+func Convert_monitor_ProjectNamespaceUsage_To_v1_ProjectNamespaceUsage(in *monitor.ProjectNamespaceUsage, out *ProjectNamespaceUsage, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectNamespaceUsage_To_v1_ProjectNamespaceUsage(in, out, s)
+}
+
+func autoConvert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(in *ProjectResourceUsage, out *monitor.ProjectResourceUsage, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.GPU = in.GPU
+	out.Storage = in.Storage
+	return nil
+}
+
+// Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage is an autogenerated conversion function.
+func Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(in *ProjectResourceUsage, out *monitor.ProjectResourceUsage, s conversion.Scope) error {
+	return autoConvert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(in, out, s)
+}
+
+func autoConvert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(in *monitor.ProjectResourceUsage, out *ProjectResourceUsage, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.GPU = in.GPU
+	out.Storage = in.Storage
+	return nil
+}
+
+// Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage is an autogenerated conversion function.
+func Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(in *monitor.ProjectResourceUsage, out *ProjectResourceUsage, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsage_To_monitor_ProjectUsage(in *ProjectUsage, out *monitor.ProjectUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Result = (*monitor.ProjectUsageResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_v1_ProjectUsage_To_monitor_ProjectUsage is an autogenerated conversion function.
+func Convert_v1_ProjectUsage_To_monitor_ProjectUsage(in *ProjectUsage, out *monitor.ProjectUsage, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsage_To_monitor_ProjectUsage(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsage_To_v1_ProjectUsage(in *monitor.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	out.Result = (*ProjectUsageResult)(unsafe.Pointer(in.Result))
+	return nil
+}
+
+// Convert_monitor_ProjectUsage_To_v1_ProjectUsage is an autogenerated conversion function.
+func Convert_monitor_ProjectUsage_To_v1_ProjectUsage(in *monitor.ProjectUsage, out *ProjectUsage, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsage_To_v1_ProjectUsage(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageCostWeights_To_monitor_ProjectUsageCostWeights(in *ProjectUsageCostWeights, out *monitor.ProjectUsageCostWeights, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.GPU = in.GPU
+	out.Storage = in.Storage
+	return nil
+}
+
+// Convert_v1_ProjectUsageCostWeights_To_monitor_ProjectUsageCostWeights is an autogenerated conversion function.
+func Convert_v1_ProjectUsageCostWeights_To_monitor_ProjectUsageCostWeights(in *ProjectUsageCostWeights, out *monitor.ProjectUsageCostWeights, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageCostWeights_To_monitor_ProjectUsageCostWeights(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageCostWeights_To_v1_ProjectUsageCostWeights(in *monitor.ProjectUsageCostWeights, out *ProjectUsageCostWeights, s conversion.Scope) error {
+	out.CPU = in.CPU
+	out.Memory = in.Memory
+	out.GPU = in.GPU
+	out.Storage = in.Storage
+	return nil
+}
+
+// Convert_monitor_ProjectUsageCostWeights_To_v1_ProjectUsageCostWeights is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageCostWeights_To_v1_ProjectUsageCostWeights(in *monitor.ProjectUsageCostWeights, out *ProjectUsageCostWeights, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageCostWeights_To_v1_ProjectUsageCostWeights(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsagePoint_To_monitor_ProjectUsagePoint(in *ProjectUsagePoint, out *monitor.ProjectUsagePoint, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	if err := Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(&in.Usage, &out.Usage, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ProjectUsagePoint_To_monitor_ProjectUsagePoint is an autogenerated conversion function.
+func Convert_v1_ProjectUsagePoint_To_monitor_ProjectUsagePoint(in *ProjectUsagePoint, out *monitor.ProjectUsagePoint, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsagePoint_To_monitor_ProjectUsagePoint(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsagePoint_To_v1_ProjectUsagePoint(in *monitor.ProjectUsagePoint, out *ProjectUsagePoint, s conversion.Scope) error {
+	out.Timestamp = in.Timestamp
+	if err := Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(&in.Usage, &out.Usage, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_monitor_ProjectUsagePoint_To_v1_ProjectUsagePoint is an autogenerated conversion function.
+func Convert_monitor_ProjectUsagePoint_To_v1_ProjectUsagePoint(in *monitor.ProjectUsagePoint, out *ProjectUsagePoint, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsagePoint_To_v1_ProjectUsagePoint(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageResult_To_monitor_ProjectUsageResult(in *ProjectUsageResult, out *monitor.ProjectUsageResult, s conversion.Scope) error {
+	out.Projects = *(*[]monitor.ProjectUsageSummary)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_v1_ProjectUsageResult_To_monitor_ProjectUsageResult is an autogenerated conversion function.
+func Convert_v1_ProjectUsageResult_To_monitor_ProjectUsageResult(in *ProjectUsageResult, out *monitor.ProjectUsageResult, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageResult_To_monitor_ProjectUsageResult(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageResult_To_v1_ProjectUsageResult(in *monitor.ProjectUsageResult, out *ProjectUsageResult, s conversion.Scope) error {
+	out.Projects = *(*[]ProjectUsageSummary)(unsafe.Pointer(&in.Projects))
+	return nil
+}
+
+// Convert_monitor_ProjectUsageResult_To_v1_ProjectUsageResult is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageResult_To_v1_ProjectUsageResult(in *monitor.ProjectUsageResult, out *ProjectUsageResult, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageResult_To_v1_ProjectUsageResult(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in *ProjectUsageSpec, out *monitor.ProjectUsageSpec, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.StepSeconds = in.StepSeconds
+	out.WithSeries = in.WithSeries
+	out.CostWeights = (*monitor.ProjectUsageCostWeights)(unsafe.Pointer(in.CostWeights))
+	return nil
+}
+
+// Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec is an autogenerated conversion function.
+func Convert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in *ProjectUsageSpec, out *monitor.ProjectUsageSpec, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageSpec_To_monitor_ProjectUsageSpec(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in *monitor.ProjectUsageSpec, out *ProjectUsageSpec, s conversion.Scope) error {
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.StepSeconds = in.StepSeconds
+	out.WithSeries = in.WithSeries
+	out.CostWeights = (*ProjectUsageCostWeights)(unsafe.Pointer(in.CostWeights))
+	return nil
+}
+
+// Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in *monitor.ProjectUsageSpec, out *ProjectUsageSpec, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageSpec_To_v1_ProjectUsageSpec(in, out, s)
+}
+
+func autoConvert_v1_ProjectUsageSummary_To_monitor_ProjectUsageSummary(in *ProjectUsageSummary, out *monitor.ProjectUsageSummary, s conversion.Scope) error {
+	out.ProjectName = in.ProjectName
+	if err := Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(&in.Average, &out.Average, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ProjectResourceUsage_To_monitor_ProjectResourceUsage(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	out.Cost = in.Cost
+	out.Namespaces = *(*[]monitor.ProjectNamespaceUsage)(unsafe.Pointer(&in.Namespaces))
+	out.Series = *(*[]monitor.ProjectUsagePoint)(unsafe.Pointer(&in.Series))
+	return nil
+}
+
+// Convert_v1_ProjectUsageSummary_To_monitor_ProjectUsageSummary is an autogenerated conversion function.
+func Convert_v1_ProjectUsageSummary_To_monitor_ProjectUsageSummary(in *ProjectUsageSummary, out *monitor.ProjectUsageSummary, s conversion.Scope) error {
+	return autoConvert_v1_ProjectUsageSummary_To_monitor_ProjectUsageSummary(in, out, s)
+}
+
+func autoConvert_monitor_ProjectUsageSummary_To_v1_ProjectUsageSummary(in *monitor.ProjectUsageSummary, out *ProjectUsageSummary, s conversion.Scope) error {
+	out.ProjectName = in.ProjectName
+	if err := Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(&in.Average, &out.Average, s); err != nil {
+		return err
+	}
+	if err := Convert_monitor_ProjectResourceUsage_To_v1_ProjectResourceUsage(&in.Total, &out.Total, s); err != nil {
+		return err
+	}
+	out.Cost = in.Cost
+	out.Namespaces = *(*[]ProjectNamespaceUsage)(unsafe.Pointer(&in.Namespaces))
+	out.Series = *(*[]ProjectUsagePoint)(unsafe.Pointer(&in.Series))
+	return nil
+}
+
+// Convert_monitor_ProjectUsageSummary_To_v1_ProjectUsageSummary is an autogenerated conversion function.
+func Convert_monitor_ProjectUsageSummary_To_v1_ProjectUsageSummary(in *monitor.ProjectUsageSummary, out *ProjectUsageSummary, s conversion.Scope) error {
+	return autoConvert_monitor_ProjectUsageSummary_To_v1_ProjectUsageSummary(in, out, s)
+}
+
 func autoConvert_v1_Prometheus_To_monitor_Prometheus(in *Prometheus, out *monitor.Prometheus, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_PrometheusSpec_To_monitor_PrometheusSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -30,13 +30,35 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&BlackboxProbe{}, func(obj interface{}) { SetObjectDefaults_BlackboxProbe(obj.(*BlackboxProbe)) })
+	scheme.AddTypeDefaultingFunc(&BlackboxProbeList{}, func(obj interface{}) { SetObjectDefaults_BlackboxProbeList(obj.(*BlackboxProbeList)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMap{}, func(obj interface{}) { SetObjectDefaults_ConfigMap(obj.(*ConfigMap)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
+	scheme.AddTypeDefaultingFunc(&EventAlertPolicy{}, func(obj interface{}) { SetObjectDefaults_EventAlertPolicy(obj.(*EventAlertPolicy)) })
+	scheme.AddTypeDefaultingFunc(&EventAlertPolicyList{}, func(obj interface{}) { SetObjectDefaults_EventAlertPolicyList(obj.(*EventAlertPolicyList)) })
 	scheme.AddTypeDefaultingFunc(&Prometheus{}, func(obj interface{}) { SetObjectDefaults_Prometheus(obj.(*Prometheus)) })
 	scheme.AddTypeDefaultingFunc(&PrometheusList{}, func(obj interface{}) { SetObjectDefaults_PrometheusList(obj.(*PrometheusList)) })
 	return nil
 }
 
+func SetObjectDefaults_BlackboxProbe(in *BlackboxProbe) {
+	SetDefaults_BlackboxProbeSpec(&in.Spec)
+	if in.Spec.HTTP != nil {
+		SetDefaults_BlackboxProbeHTTP(in.Spec.HTTP)
+	}
+	if in.Spec.SLO != nil {
+		SetDefaults_BlackboxProbeSLO(in.Spec.SLO)
+	}
+	SetDefaults_BlackboxProbeStatus(&in.Status)
+}
+
+func SetObjectDefaults_BlackboxProbeList(in *BlackboxProbeList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_BlackboxProbe(a)
+	}
+}
+
 func SetObjectDefaults_ConfigMap(in *ConfigMap) {
 	SetDefaults_ConfigMap(in)
 }
@@ -48,6 +70,21 @@ func SetObjectDefaults_ConfigMapList(in *ConfigMapList) {
 	}
 }
 
+func SetObjectDefaults_EventAlertPolicy(in *EventAlertPolicy) {
+	SetDefaults_EventAlertPolicySpec(&in.Spec)
+	for i := range in.Spec.Rules {
+		a := &in.Spec.Rules[i]
+		SetDefaults_EventAlertRule(a)
+	}
+}
+
+func SetObjectDefaults_EventAlertPolicyList(in *EventAlertPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EventAlertPolicy(a)
+	}
+}
+
 func SetObjectDefaults_Prometheus(in *Prometheus) {
 	SetDefaults_PrometheusStatus(&in.Status)
 }
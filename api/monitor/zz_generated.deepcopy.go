@@ -26,6 +26,179 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbe) DeepCopyInto(out *BlackboxProbe) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbe.
+func (in *BlackboxProbe) DeepCopy() *BlackboxProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlackboxProbe) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeHTTP) DeepCopyInto(out *BlackboxProbeHTTP) {
+	*out = *in
+	if in.ValidStatusCodes != nil {
+		in, out := &in.ValidStatusCodes, &out.ValidStatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeHTTP.
+func (in *BlackboxProbeHTTP) DeepCopy() *BlackboxProbeHTTP {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeHTTP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeList) DeepCopyInto(out *BlackboxProbeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BlackboxProbe, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeList.
+func (in *BlackboxProbeList) DeepCopy() *BlackboxProbeList {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BlackboxProbeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeNotify) DeepCopyInto(out *BlackboxProbeNotify) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeNotify.
+func (in *BlackboxProbeNotify) DeepCopy() *BlackboxProbeNotify {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeNotify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeSLO) DeepCopyInto(out *BlackboxProbeSLO) {
+	*out = *in
+	in.Notify.DeepCopyInto(&out.Notify)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeSLO.
+func (in *BlackboxProbeSLO) DeepCopy() *BlackboxProbeSLO {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeSLO)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeSpec) DeepCopyInto(out *BlackboxProbeSpec) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(BlackboxProbeHTTP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SLO != nil {
+		in, out := &in.SLO, &out.SLO
+		*out = new(BlackboxProbeSLO)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeSpec.
+func (in *BlackboxProbeSpec) DeepCopy() *BlackboxProbeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackboxProbeStatus) DeepCopyInto(out *BlackboxProbeStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackboxProbeStatus.
+func (in *BlackboxProbeStatus) DeepCopy() *BlackboxProbeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackboxProbeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterOverview) DeepCopyInto(out *ClusterOverview) {
 	*out = *in
@@ -181,6 +354,306 @@ func (in *ConfigMapList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertNotify) DeepCopyInto(out *EventAlertNotify) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertNotify.
+func (in *EventAlertNotify) DeepCopy() *EventAlertNotify {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertNotify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertPolicy) DeepCopyInto(out *EventAlertPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertPolicy.
+func (in *EventAlertPolicy) DeepCopy() *EventAlertPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventAlertPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertPolicyList) DeepCopyInto(out *EventAlertPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EventAlertPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertPolicyList.
+func (in *EventAlertPolicyList) DeepCopy() *EventAlertPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EventAlertPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertPolicySpec) DeepCopyInto(out *EventAlertPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]EventAlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Notify.DeepCopyInto(&out.Notify)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertPolicySpec.
+func (in *EventAlertPolicySpec) DeepCopy() *EventAlertPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertPolicyStatus) DeepCopyInto(out *EventAlertPolicyStatus) {
+	*out = *in
+	in.LastAlertTime.DeepCopyInto(&out.LastAlertTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertPolicyStatus.
+func (in *EventAlertPolicyStatus) DeepCopy() *EventAlertPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventAlertRule) DeepCopyInto(out *EventAlertRule) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvolvedObjectKinds != nil {
+		in, out := &in.InvolvedObjectKinds, &out.InvolvedObjectKinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventAlertRule.
+func (in *EventAlertRule) DeepCopy() *EventAlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(EventAlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQuery) DeepCopyInto(out *FederatedQuery) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(FederatedQueryResult)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQuery.
+func (in *FederatedQuery) DeepCopy() *FederatedQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FederatedQuery) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQueryError) DeepCopyInto(out *FederatedQueryError) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQueryError.
+func (in *FederatedQueryError) DeepCopy() *FederatedQueryError {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQueryError)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQueryResult) DeepCopyInto(out *FederatedQueryResult) {
+	*out = *in
+	if in.Series != nil {
+		in, out := &in.Series, &out.Series
+		*out = make([]FederatedQuerySeries, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]FederatedQueryError, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQueryResult.
+func (in *FederatedQueryResult) DeepCopy() *FederatedQueryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQueryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQuerySample) DeepCopyInto(out *FederatedQuerySample) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQuerySample.
+func (in *FederatedQuerySample) DeepCopy() *FederatedQuerySample {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQuerySample)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQuerySeries) DeepCopyInto(out *FederatedQuerySeries) {
+	*out = *in
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]FederatedQuerySample, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQuerySeries.
+func (in *FederatedQuerySeries) DeepCopy() *FederatedQuerySeries {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQuerySeries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FederatedQuerySpec) DeepCopyInto(out *FederatedQuerySpec) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FederatedQuerySpec.
+func (in *FederatedQuerySpec) DeepCopy() *FederatedQuerySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FederatedQuerySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metric) DeepCopyInto(out *Metric) {
 	*out = *in
@@ -298,6 +771,182 @@ func (in *MetricQueryCondition) DeepCopy() *MetricQueryCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectNamespaceUsage) DeepCopyInto(out *ProjectNamespaceUsage) {
+	*out = *in
+	out.Average = in.Average
+	out.Total = in.Total
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectNamespaceUsage.
+func (in *ProjectNamespaceUsage) DeepCopy() *ProjectNamespaceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectNamespaceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectResourceUsage) DeepCopyInto(out *ProjectResourceUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectResourceUsage.
+func (in *ProjectResourceUsage) DeepCopy() *ProjectResourceUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectResourceUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsage) DeepCopyInto(out *ProjectUsage) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(ProjectUsageResult)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsage.
+func (in *ProjectUsage) DeepCopy() *ProjectUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ProjectUsage) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageCostWeights) DeepCopyInto(out *ProjectUsageCostWeights) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageCostWeights.
+func (in *ProjectUsageCostWeights) DeepCopy() *ProjectUsageCostWeights {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageCostWeights)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsagePoint) DeepCopyInto(out *ProjectUsagePoint) {
+	*out = *in
+	out.Usage = in.Usage
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsagePoint.
+func (in *ProjectUsagePoint) DeepCopy() *ProjectUsagePoint {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsagePoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageResult) DeepCopyInto(out *ProjectUsageResult) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]ProjectUsageSummary, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageResult.
+func (in *ProjectUsageResult) DeepCopy() *ProjectUsageResult {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageSpec) DeepCopyInto(out *ProjectUsageSpec) {
+	*out = *in
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CostWeights != nil {
+		in, out := &in.CostWeights, &out.CostWeights
+		*out = new(ProjectUsageCostWeights)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageSpec.
+func (in *ProjectUsageSpec) DeepCopy() *ProjectUsageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectUsageSummary) DeepCopyInto(out *ProjectUsageSummary) {
+	*out = *in
+	out.Average = in.Average
+	out.Total = in.Total
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]ProjectNamespaceUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.Series != nil {
+		in, out := &in.Series, &out.Series
+		*out = make([]ProjectUsagePoint, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectUsageSummary.
+func (in *ProjectUsageSummary) DeepCopy() *ProjectUsageSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectUsageSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prometheus) DeepCopyInto(out *Prometheus) {
 	*out = *in
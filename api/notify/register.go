@@ -79,6 +79,15 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMapList{},
 
 		&Message{},
-		&MessageList{})
+		&MessageList{},
+
+		&EscalationPolicy{},
+		&EscalationPolicyList{},
+
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
+
+		&Escalation{},
+		&EscalationList{})
 	return nil
 }
@@ -32,6 +32,75 @@ func SetDefaults_ChannelStatus(obj *ChannelStatus) {
 	}
 }
 
+// defaultRobotRateLimit is the number of messages per minute allowed by the
+// group robots of WeCom and DingTalk.
+const defaultRobotRateLimit = 20
+
+func SetDefaults_ChannelWeCom(obj *ChannelWeCom) {
+	if obj.RateLimit == 0 {
+		obj.RateLimit = defaultRobotRateLimit
+	}
+}
+
+func SetDefaults_ChannelDingTalk(obj *ChannelDingTalk) {
+	if obj.RateLimit == 0 {
+		obj.RateLimit = defaultRobotRateLimit
+	}
+}
+
+func SetDefaults_ChannelLark(obj *ChannelLark) {
+	if obj.RateLimit == 0 {
+		obj.RateLimit = defaultRobotRateLimit
+	}
+}
+
+const (
+	defaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+	defaultOpsgenieURL  = "https://api.opsgenie.com"
+)
+
+func SetDefaults_ChannelPagerDuty(obj *ChannelPagerDuty) {
+	if obj.URL == "" {
+		obj.URL = defaultPagerDutyURL
+	}
+}
+
+func SetDefaults_ChannelOpsgenie(obj *ChannelOpsgenie) {
+	if obj.URL == "" {
+		obj.URL = defaultOpsgenieURL
+	}
+}
+
+const (
+	defaultVoiceSeverity             = "critical"
+	defaultVoiceAnswerTimeoutSeconds = 60
+	defaultVoicePlayTimes            = 2
+)
+
+func SetDefaults_ChannelVoice(obj *ChannelVoice) {
+	if len(obj.Severities) == 0 {
+		obj.Severities = []string{defaultVoiceSeverity}
+	}
+	if obj.AnswerTimeoutSeconds == 0 {
+		obj.AnswerTimeoutSeconds = defaultVoiceAnswerTimeoutSeconds
+	}
+}
+
+func SetDefaults_ChannelTencentCloudVMS(obj *ChannelTencentCloudVMS) {
+	if obj.PlayTimes == 0 {
+		obj.PlayTimes = defaultVoicePlayTimes
+	}
+}
+
+// defaultFlapKey is the variable of the alert status set by the alarm webhook.
+const defaultFlapKey = "alertStatus"
+
+func SetDefaults_ChannelAggregation(obj *ChannelAggregation) {
+	if obj.FlapKey == "" {
+		obj.FlapKey = defaultFlapKey
+	}
+}
+
 func SetDefaults_TemplateSpec(obj *TemplateSpec) {
 	if obj.Keys == nil {
 		obj.Keys = []string{}
@@ -82,3 +151,21 @@ func SetDefaults_ConfigMap(obj *ConfigMap) {
 		obj.Data = make(map[string]string)
 	}
 }
+
+func SetDefaults_OnCallScheduleSpec(obj *OnCallScheduleSpec) {
+	if obj.ShiftSeconds == 0 {
+		obj.ShiftSeconds = 86400
+	}
+}
+
+func SetDefaults_EscalationSpec(obj *EscalationSpec) {
+	if obj.Variables == nil {
+		obj.Variables = make(map[string]string)
+	}
+}
+
+func SetDefaults_EscalationStatus(obj *EscalationStatus) {
+	if obj.Phase == "" {
+		obj.Phase = EscalationEscalating
+	}
+}
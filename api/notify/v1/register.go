@@ -67,7 +67,16 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ConfigMapList{},
 
 		&Message{},
-		&MessageList{})
+		&MessageList{},
+
+		&EscalationPolicy{},
+		&EscalationPolicyList{},
+
+		&OnCallSchedule{},
+		&OnCallScheduleList{},
+
+		&Escalation{},
+		&EscalationList{})
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
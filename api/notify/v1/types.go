@@ -75,12 +75,35 @@ type ChannelSpec struct {
 	SMTP *ChannelSMTP `json:"smtp,omitempty" protobuf:"bytes,6,opt,name=smtp"`
 	// +optional
 	Webhook *ChannelWebhook `json:"webhook,omitempty" protobuf:"bytes,7,opt,name=webhook"`
+	// +optional
+	WeCom *ChannelWeCom `json:"weCom,omitempty" protobuf:"bytes,8,opt,name=weCom"`
+	// +optional
+	DingTalk *ChannelDingTalk `json:"dingTalk,omitempty" protobuf:"bytes,9,opt,name=dingTalk"`
+	// +optional
+	Lark *ChannelLark `json:"lark,omitempty" protobuf:"bytes,10,opt,name=lark"`
+	// +optional
+	Aggregation *ChannelAggregation `json:"aggregation,omitempty" protobuf:"bytes,11,opt,name=aggregation"`
+	// +optional
+	PagerDuty *ChannelPagerDuty `json:"pagerDuty,omitempty" protobuf:"bytes,12,opt,name=pagerDuty"`
+	// +optional
+	Opsgenie *ChannelOpsgenie `json:"opsgenie,omitempty" protobuf:"bytes,13,opt,name=opsgenie"`
+	// +optional
+	Quota *ChannelQuota `json:"quota,omitempty" protobuf:"bytes,14,opt,name=quota"`
+	// +optional
+	Voice *ChannelVoice `json:"voice,omitempty" protobuf:"bytes,15,opt,name=voice"`
 }
 
 // ChannelStatus represents information about the status of a cluster.
 type ChannelStatus struct {
 	// +optional
 	Phase ChannelPhase `json:"phase,omitempty" protobuf:"bytes,3,opt,name=phase,casttype=ChannelPhase"`
+	// QuotaUsages are the messages sent through the channel reported by the
+	// message request controller.
+	// +optional
+	QuotaUsages []ChannelQuotaUsage `json:"quotaUsages,omitempty" protobuf:"bytes,4,rep,name=quotaUsages"`
+	// LastQuotaUpdateTime is the time the quota usages are reported.
+	// +optional
+	LastQuotaUpdateTime metav1.Time `json:"lastQuotaUpdateTime,omitempty" protobuf:"bytes,5,opt,name=lastQuotaUpdateTime"`
 }
 
 // ChannelPhase defines the phase of channel constructor.
@@ -130,6 +153,184 @@ type ChannelWebhook struct {
 	Headers map[string]string `json:"headers" protobuf:"bytes,2,opt,name=headers"`
 }
 
+// ChannelWeCom indicates a channel configuration for sending notifications
+// to a group through the robot of WeCom (WeChat Work).
+type ChannelWeCom struct {
+	// URL is the webhook address of the group robot, including the key.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// RateLimit is the maximum number of messages sent through the robot per
+	// minute. Defaults to 20.
+	// +optional
+	RateLimit int32 `json:"rateLimit,omitempty" protobuf:"varint,2,opt,name=rateLimit"`
+}
+
+// ChannelDingTalk indicates a channel configuration for sending notifications
+// to a group through the custom robot of DingTalk.
+type ChannelDingTalk struct {
+	// URL is the webhook address of the robot, including the access token.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Secret signs the requests when the robot enables the signature security
+	// setting.
+	// +optional
+	Secret string `json:"secret,omitempty" protobuf:"bytes,2,opt,name=secret"`
+	// RateLimit is the maximum number of messages sent through the robot per
+	// minute. Defaults to 20.
+	// +optional
+	RateLimit int32 `json:"rateLimit,omitempty" protobuf:"varint,3,opt,name=rateLimit"`
+}
+
+// ChannelLark indicates a channel configuration for sending notifications to
+// a group through the custom bot of Lark.
+type ChannelLark struct {
+	// URL is the webhook address of the bot.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Secret signs the requests when the bot enables the signature verification.
+	// +optional
+	Secret string `json:"secret,omitempty" protobuf:"bytes,2,opt,name=secret"`
+	// RateLimit is the maximum number of messages sent through the bot per
+	// minute. Defaults to 20.
+	// +optional
+	RateLimit int32 `json:"rateLimit,omitempty" protobuf:"varint,3,opt,name=rateLimit"`
+}
+
+// ChannelAggregation indicates how the message requests of a channel are
+// deduplicated and suppressed before sending.
+type ChannelAggregation struct {
+	// DedupKeys are the variables identifying the same message. Message requests
+	// with the same template, receivers and values of the keys are deduplicated.
+	// All variables are used if not specified.
+	// +optional
+	DedupKeys []string `json:"dedupKeys,omitempty" protobuf:"bytes,1,rep,name=dedupKeys"`
+	// GroupWaitSeconds is the time to wait for the duplicate message requests
+	// before sending one message with the count of them. Deduplication is
+	// disabled if it is 0.
+	// +optional
+	GroupWaitSeconds int32 `json:"groupWaitSeconds,omitempty" protobuf:"varint,2,opt,name=groupWaitSeconds"`
+	// FlapKey is the variable whose changes of value are counted to detect
+	// flapping. Defaults to alertStatus.
+	// +optional
+	FlapKey string `json:"flapKey,omitempty" protobuf:"bytes,3,opt,name=flapKey"`
+	// FlapWindowSeconds is the time window in which the changes are counted.
+	// +optional
+	FlapWindowSeconds int32 `json:"flapWindowSeconds,omitempty" protobuf:"varint,4,opt,name=flapWindowSeconds"`
+	// FlapThreshold is the number of changes in the window since which the
+	// message requests are suppressed. Flap detection is disabled if it is 0.
+	// +optional
+	FlapThreshold int32 `json:"flapThreshold,omitempty" protobuf:"varint,5,opt,name=flapThreshold"`
+}
+
+// ChannelPagerDuty indicates a channel configuration for triggering and
+// resolving incidents of a service in PagerDuty through the Events API v2.
+type ChannelPagerDuty struct {
+	// RoutingKey is the integration key of the service.
+	RoutingKey string `json:"routingKey" protobuf:"bytes,1,opt,name=routingKey"`
+	// URL is the address of the Events API v2. Defaults to
+	// https://events.pagerduty.com/v2/enqueue.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// Severities maps the severity of alerts to the severity of events, which is
+	// one of critical, error, warning and info.
+	// +optional
+	Severities map[string]string `json:"severities,omitempty" protobuf:"bytes,3,rep,name=severities" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// ChannelOpsgenie indicates a channel configuration for creating and closing
+// alerts in Opsgenie.
+type ChannelOpsgenie struct {
+	// APIKey is the key of the API integration.
+	APIKey string `json:"apiKey" protobuf:"bytes,1,opt,name=apiKey"`
+	// URL is the address of the Alert API. Defaults to https://api.opsgenie.com,
+	// and https://api.eu.opsgenie.com for the EU instance.
+	// +optional
+	URL string `json:"url,omitempty" protobuf:"bytes,2,opt,name=url"`
+	// Priorities maps the severity of alerts to the priority of Opsgenie
+	// alerts, which is one of P1 to P5.
+	// +optional
+	Priorities map[string]string `json:"priorities,omitempty" protobuf:"bytes,3,rep,name=priorities" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// ChannelVoice indicates a channel configuration for calling the receivers and
+// reading out the alarm, which is used for the alarms of configured severities.
+// The receivers are called one after another until one of them answers.
+type ChannelVoice struct {
+	// TencentCloudVMS calls through Tencent Cloud Voice Message Service.
+	// +optional
+	TencentCloudVMS *ChannelTencentCloudVMS `json:"tencentCloudVMS,omitempty" protobuf:"bytes,1,opt,name=tencentCloudVMS"`
+	// API calls through the HTTP API of a SIP gateway or a voice provider.
+	// +optional
+	API *ChannelVoiceAPI `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+	// Severities are the severities of the alarms which trigger calls, the
+	// messages of other severities are not sent. Defaults to critical.
+	// +optional
+	Severities []string `json:"severities,omitempty" protobuf:"bytes,3,rep,name=severities"`
+	// AnswerTimeoutSeconds is the time to wait for a receiver to answer before
+	// calling the next one. Defaults to 60.
+	// +optional
+	AnswerTimeoutSeconds int32 `json:"answerTimeoutSeconds,omitempty" protobuf:"varint,4,opt,name=answerTimeoutSeconds"`
+}
+
+// ChannelTencentCloudVMS indicates the configuration for calling through Tencent
+// Cloud Voice Message Service with a voice template.
+// See: https://cloud.tencent.com/document/product/1128
+type ChannelTencentCloudVMS struct {
+	AppKey   string `json:"appKey" protobuf:"bytes,1,opt,name=appKey"`
+	SdkAppID string `json:"sdkAppID" protobuf:"bytes,2,opt,name=sdkAppID"`
+	// PlayTimes is the times the message is read out in a call. Defaults to 2.
+	// +optional
+	PlayTimes int32 `json:"playTimes,omitempty" protobuf:"varint,3,opt,name=playTimes"`
+}
+
+// ChannelVoiceAPI indicates the HTTP API of a voice provider. The call is
+// made by posting {"callee": mobile, "content": text} to the URL, which
+// responds with {"callID": id}, and the state of the call is queried by
+// getting URL/{id}, which responds with {"status": status}, where status is
+// answered when the callee answered, and failed, busy or noanswer when the
+// call ended unanswered.
+type ChannelVoiceAPI struct {
+	// URL is the address of the call API.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Headers are added to the requests, such as the authorization header.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty" protobuf:"bytes,2,rep,name=headers" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// ChannelQuota limits the messages sent through the channel, and through the
+// channel for each project, in the sliding windows of the last minute and the
+// last day. A non-positive limit means no limit.
+type ChannelQuota struct {
+	// MessagesPerMinute is the maximum number of messages sent through the
+	// channel in the last minute.
+	// +optional
+	MessagesPerMinute int32 `json:"messagesPerMinute,omitempty" protobuf:"varint,1,opt,name=messagesPerMinute"`
+	// MessagesPerDay is the maximum number of messages sent through the channel
+	// in the last 24 hours.
+	// +optional
+	MessagesPerDay int32 `json:"messagesPerDay,omitempty" protobuf:"varint,2,opt,name=messagesPerDay"`
+	// ProjectMessagesPerMinute is the maximum number of messages sent through
+	// the channel for each project in the last minute.
+	// +optional
+	ProjectMessagesPerMinute int32 `json:"projectMessagesPerMinute,omitempty" protobuf:"varint,3,opt,name=projectMessagesPerMinute"`
+	// ProjectMessagesPerDay is the maximum number of messages sent through the
+	// channel for each project in the last 24 hours.
+	// +optional
+	ProjectMessagesPerDay int32 `json:"projectMessagesPerDay,omitempty" protobuf:"varint,4,opt,name=projectMessagesPerDay"`
+}
+
+// ChannelQuotaUsage is the number of messages sent through the channel, or
+// through the channel for a project.
+type ChannelQuotaUsage struct {
+	// Project is the project the messages are sent for, empty for all the
+	// messages of the channel.
+	// +optional
+	Project string `json:"project,omitempty" protobuf:"bytes,1,opt,name=project"`
+	// MessagesLastMinute is the number of messages sent in the last minute.
+	// +optional
+	MessagesLastMinute int32 `json:"messagesLastMinute,omitempty" protobuf:"varint,2,opt,name=messagesLastMinute"`
+	// MessagesLastDay is the number of messages sent in the last 24 hours.
+	// +optional
+	MessagesLastDay int32 `json:"messagesLastDay,omitempty" protobuf:"varint,3,opt,name=messagesLastDay"`
+}
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -167,6 +368,22 @@ type TemplateSpec struct {
 	Wechat *TemplateWechat `json:"wechat,omitempty" protobuf:"bytes,5,opt,name=wechat"`
 	// +optional
 	Text *TemplateText `json:"text,omitempty" protobuf:"bytes,6,opt,name=text"`
+	// +optional
+	WeCom *TemplateWeCom `json:"weCom,omitempty" protobuf:"bytes,7,opt,name=weCom"`
+	// +optional
+	DingTalk *TemplateDingTalk `json:"dingTalk,omitempty" protobuf:"bytes,8,opt,name=dingTalk"`
+	// +optional
+	Lark *TemplateLark `json:"lark,omitempty" protobuf:"bytes,9,opt,name=lark"`
+	// Localizations are used instead of the template for the receivers of the
+	// locales.
+	// +optional
+	Localizations []TemplateLocalization `json:"localizations,omitempty" protobuf:"bytes,10,rep,name=localizations"`
+	// +optional
+	PagerDuty *TemplatePagerDuty `json:"pagerDuty,omitempty" protobuf:"bytes,11,opt,name=pagerDuty"`
+	// +optional
+	Opsgenie *TemplateOpsgenie `json:"opsgenie,omitempty" protobuf:"bytes,12,opt,name=opsgenie"`
+	// +optional
+	Voice *TemplateVoice `json:"voice,omitempty" protobuf:"bytes,13,opt,name=voice"`
 }
 
 // TemplateTencentCloudSMS indicates the template used when sending text
@@ -208,6 +425,85 @@ type TemplateText struct {
 	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
 }
 
+// TemplateWeCom indicates the template when sending a markdown message
+// through the robot of WeCom.
+type TemplateWeCom struct {
+	// Content is the markdown content of the message.
+	Content string `json:"content" protobuf:"bytes,1,opt,name=content"`
+}
+
+// TemplateDingTalk indicates the template when sending a markdown message
+// through the robot of DingTalk.
+type TemplateDingTalk struct {
+	// Title is shown in the conversation list and the notification.
+	Title string `json:"title" protobuf:"bytes,1,opt,name=title"`
+	// Content is the markdown content of the message.
+	Content string `json:"content" protobuf:"bytes,2,opt,name=content"`
+}
+
+// TemplateLark indicates the template when sending a message card through
+// the bot of Lark.
+type TemplateLark struct {
+	// Title is the header of the card.
+	Title string `json:"title" protobuf:"bytes,1,opt,name=title"`
+	// Content is the lark_md content of the card.
+	Content string `json:"content" protobuf:"bytes,2,opt,name=content"`
+	// Color is the template color of the card header, such as red. Defaults
+	// to blue.
+	// +optional
+	Color string `json:"color,omitempty" protobuf:"bytes,3,opt,name=color"`
+}
+
+// TemplatePagerDuty indicates the template of the incidents triggered in
+// PagerDuty.
+type TemplatePagerDuty struct {
+	// Summary is the title of the incident.
+	Summary string `json:"summary" protobuf:"bytes,1,opt,name=summary"`
+	// Source is the affected system, such as the cluster. Defaults to tke.
+	// +optional
+	Source string `json:"source,omitempty" protobuf:"bytes,2,opt,name=source"`
+}
+
+// TemplateOpsgenie indicates the template of the alerts created in Opsgenie.
+type TemplateOpsgenie struct {
+	// Message is the title of the alert.
+	Message string `json:"message" protobuf:"bytes,1,opt,name=message"`
+	// Description is the detail of the alert.
+	// +optional
+	Description string `json:"description,omitempty" protobuf:"bytes,2,opt,name=description"`
+}
+
+// TemplateVoice indicates the template of the voice calls.
+type TemplateVoice struct {
+	// TemplateID is the voice template of Tencent Cloud VMS, whose parameters are
+	// filled by the variables referenced in the content in order.
+	// +optional
+	TemplateID string `json:"templateID,omitempty" protobuf:"bytes,1,opt,name=templateID"`
+	// Content is the text read out in the call.
+	Content string `json:"content" protobuf:"bytes,2,opt,name=content"`
+}
+
+// TemplateLocalization indicates the translation of a template for the
+// receivers of a locale. The non-empty fields override the corresponding
+// fields of the template.
+type TemplateLocalization struct {
+	// Locale is the language of the receivers, such as zh or en.
+	Locale string `json:"locale" protobuf:"bytes,1,opt,name=locale"`
+	// Header overrides the header of the text template.
+	// +optional
+	Header string `json:"header,omitempty" protobuf:"bytes,2,opt,name=header"`
+	// Body overrides the body of the text, sms and wechat templates.
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,3,opt,name=body"`
+	// Title overrides the title of the dingtalk and lark templates.
+	// +optional
+	Title string `json:"title,omitempty" protobuf:"bytes,4,opt,name=title"`
+	// Content overrides the content of the wecom, dingtalk, lark and voice
+	// templates.
+	// +optional
+	Content string `json:"content,omitempty" protobuf:"bytes,5,opt,name=content"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
@@ -249,6 +545,15 @@ const (
 	ReceiverChannelWechatOpenID ReceiverChannel = "wechat_openid"
 	// ReceiverChannelWebhook only indicates channel type webhook
 	ReceiverChannelWebhook ReceiverChannel = "webhook"
+	// ReceiverChannelWeCom represents the userid of receiver in WeCom, which is
+	// mentioned in the group messages of WeCom robots.
+	ReceiverChannelWeCom ReceiverChannel = "wecom_userid"
+	// ReceiverChannelDingTalk represents the mobile of receiver in DingTalk,
+	// which is mentioned in the group messages of DingTalk robots.
+	ReceiverChannelDingTalk ReceiverChannel = "dingtalk_mobile"
+	// ReceiverChannelLark represents the open_id of receiver in Lark, which is
+	// mentioned in the message cards of Lark bots.
+	ReceiverChannelLark ReceiverChannel = "lark_openid"
 )
 
 // ReceiverSpec is a description of a receiver.
@@ -265,6 +570,15 @@ type ReceiverSpec struct {
 	// email address.
 	// +optional
 	Identities map[ReceiverChannel]string `json:"identities,omitempty" protobuf:"bytes,4,rep,name=identities"`
+	// Locale is the language of messages sent to the receiver, such as zh or en.
+	// The template is used as it is if it has no localization of the locale.
+	// +optional
+	Locale string `json:"locale,omitempty" protobuf:"bytes,5,opt,name=locale"`
+	// AuthUser is the user of tke-auth which the receiver is synchronized from,
+	// the receivers synchronized are deleted when the user leaves all the
+	// synchronized groups.
+	// +optional
+	AuthUser string `json:"authUser,omitempty" protobuf:"bytes,6,opt,name=authUser"`
 }
 
 // +genclient
@@ -288,6 +602,11 @@ type ReceiverGroupSpec struct {
 	DisplayName string `json:"displayName" protobuf:"bytes,2,opt,name=displayName"`
 	// +optional
 	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,opt,name=receivers"`
+	// AuthGroup is the group of tke-auth, such as an LDAP or OIDC group, whose
+	// members are synchronized to the receivers of the group. The receivers and
+	// their email and mobile are kept updated from the identity provider.
+	// +optional
+	AuthGroup string `json:"authGroup,omitempty" protobuf:"bytes,4,opt,name=authGroup"`
 }
 
 // +genclient:nonNamespaced
@@ -371,6 +690,12 @@ const (
 	MessageRequestFailed MessageRequestPhase = "Failed"
 	// MessageRequestPartialFailure indicates that the partial failure to sent.
 	MessageRequestPartialFailure MessageRequestPhase = "PartialFailure"
+	// MessageRequestAggregated indicates that the message request has been merged
+	// into another message request with the same deduplication key.
+	MessageRequestAggregated MessageRequestPhase = "Aggregated"
+	// MessageRequestSuppressed indicates that the message request is not sent
+	// because its deduplication key is flapping.
+	MessageRequestSuppressed MessageRequestPhase = "Suppressed"
 )
 
 // +genclient
@@ -425,6 +750,12 @@ type MessageSpec struct {
 	ReceiverChannelName string `json:"receiverChannelName,omitempty" protobuf:"bytes,11,opt,name=receiverChannelName"`
 	// +optional
 	ClusterID string `json:"clusterID,omitempty" protobuf:"bytes,12,opt,name=clusterID"`
+	// TemplateName is the template under the channel used to render the message.
+	// +optional
+	TemplateName string `json:"templateName,omitempty" protobuf:"bytes,13,opt,name=templateName"`
+	// Variables are used to render the message again when it is retried.
+	// +optional
+	Variables map[string]string `json:"variables,omitempty" protobuf:"bytes,14,rep,name=variables" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // MessageStatus represents information about the status of a message.
@@ -434,6 +765,29 @@ type MessageStatus struct {
 	// The last time the condition transitioned from one status to another.
 	// +optional
 	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,2,opt,name=lastTransitionTime"`
+	// DeliveryPhase indicates whether the message has been delivered. Messages
+	// without it are delivered.
+	// +optional
+	DeliveryPhase MessageDeliveryPhase `json:"deliveryPhase,omitempty" protobuf:"bytes,3,opt,name=deliveryPhase,casttype=MessageDeliveryPhase"`
+	// RetryCount is the number of failed attempts since the message was sent or
+	// re-sent.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty" protobuf:"varint,4,opt,name=retryCount"`
+	// NextAttemptTime is when the message is retried.
+	// +optional
+	NextAttemptTime metav1.Time `json:"nextAttemptTime,omitempty" protobuf:"bytes,5,opt,name=nextAttemptTime"`
+	// Attempts are the latest attempts to deliver the message.
+	// +optional
+	Attempts []MessageDeliveryAttempt `json:"attempts,omitempty" protobuf:"bytes,6,rep,name=attempts"`
+}
+
+// MessageDeliveryAttempt records an attempt to deliver a message.
+type MessageDeliveryAttempt struct {
+	// Time is when the attempt was made.
+	Time metav1.Time `json:"time" protobuf:"bytes,1,opt,name=time"`
+	// Error is the reason why the attempt failed, empty if it succeeded.
+	// +optional
+	Error string `json:"error,omitempty" protobuf:"bytes,2,opt,name=error"`
 }
 
 // MessagePhase indicates the status of message.
@@ -447,6 +801,21 @@ const (
 	MessageRead MessagePhase = "Read"
 )
 
+// MessageDeliveryPhase indicates the delivery status of message.
+type MessageDeliveryPhase string
+
+// These are valid delivery status of message.
+const (
+	// MessageDelivered indicates that the message has been sent to the receiver.
+	MessageDelivered MessageDeliveryPhase = "Delivered"
+	// MessageRetrying indicates that the message failed to be sent and will be
+	// retried.
+	MessageRetrying MessageDeliveryPhase = "Retrying"
+	// MessageDeadLetter indicates that the message failed to be sent after all
+	// retries, and is only sent again when it is re-sent.
+	MessageDeadLetter MessageDeliveryPhase = "DeadLetter"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
@@ -488,3 +857,185 @@ type ConfigMapList struct {
 	// Items is the list of ConfigMaps.
 	Items []ConfigMap `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EscalationPolicy indicates the ordered steps to notify until an escalation
+// is acknowledged.
+type EscalationPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired escalation policy.
+	// +optional
+	Spec EscalationPolicySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EscalationPolicyList is the whole list of all escalation policies which
+// owned by a tenant.
+type EscalationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of escalation policies.
+	Items []EscalationPolicy `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// EscalationPolicySpec is a description of an escalation policy.
+type EscalationPolicySpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	DisplayName string `json:"displayName" protobuf:"bytes,2,opt,name=displayName"`
+	// Steps are notified in order until the escalation is acknowledged.
+	Steps []EscalationStep `json:"steps" protobuf:"bytes,3,rep,name=steps"`
+}
+
+// EscalationStep indicates the receivers notified in a step of escalation.
+type EscalationStep struct {
+	// WaitSeconds is the time to wait since the previous step before notifying
+	// this step. The first step waits since the escalation is created.
+	// +optional
+	WaitSeconds int32 `json:"waitSeconds,omitempty" protobuf:"varint,1,opt,name=waitSeconds"`
+	// Channel is the name of channel used to notify.
+	Channel string `json:"channel" protobuf:"bytes,2,opt,name=channel"`
+	// Template is the name of template under the channel.
+	Template string `json:"template" protobuf:"bytes,3,opt,name=template"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,4,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,5,rep,name=receiverGroups"`
+	// OnCallSchedules notifies the current on-call receivers of the schedules.
+	// +optional
+	OnCallSchedules []string `json:"onCallSchedules,omitempty" protobuf:"bytes,6,rep,name=onCallSchedules"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallSchedule indicates the rotation of on-call receivers in a receiver
+// group.
+type OnCallSchedule struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired on-call schedule.
+	// +optional
+	Spec OnCallScheduleSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OnCallScheduleList is the whole list of all on-call schedules which owned
+// by a tenant.
+type OnCallScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of on-call schedules.
+	Items []OnCallSchedule `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// OnCallScheduleSpec is a description of an on-call schedule.
+type OnCallScheduleSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	DisplayName string `json:"displayName" protobuf:"bytes,2,opt,name=displayName"`
+	// ReceiverGroup is the receiver group whose receivers take turns to be
+	// on call in the order of receivers.
+	ReceiverGroup string `json:"receiverGroup" protobuf:"bytes,3,opt,name=receiverGroup"`
+	// StartTime is the beginning of the shift of the first receiver.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,4,opt,name=startTime"`
+	// ShiftSeconds is the duration of each shift. Defaults to 86400.
+	// +optional
+	ShiftSeconds int32 `json:"shiftSeconds,omitempty" protobuf:"varint,5,opt,name=shiftSeconds"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Escalation indicates an alert being escalated by an escalation policy.
+type Escalation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired escalation.
+	// +optional
+	Spec EscalationSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status EscalationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EscalationList is the whole list of all escalations which owned by a
+// tenant.
+type EscalationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of escalations.
+	Items []Escalation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// EscalationSpec is a description of an escalation.
+type EscalationSpec struct {
+	TenantID string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	// PolicyName is the name of escalation policy.
+	PolicyName string `json:"policyName" protobuf:"bytes,2,opt,name=policyName"`
+	// Variables are used to render the templates of each step.
+	// +optional
+	Variables map[string]string `json:"variables,omitempty" protobuf:"bytes,3,rep,name=variables" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// EscalationStatus represents information about the status of an escalation.
+type EscalationStatus struct {
+	// +optional
+	Phase EscalationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=EscalationPhase"`
+	// Step is the number of steps which have been notified.
+	// +optional
+	Step int32 `json:"step,omitempty" protobuf:"varint,2,opt,name=step"`
+	// LastStepTime is the time when the last step was notified.
+	// +optional
+	LastStepTime metav1.Time `json:"lastStepTime,omitempty" protobuf:"bytes,3,opt,name=lastStepTime"`
+	// AcknowledgedBy is the username who acknowledged the escalation.
+	// +optional
+	AcknowledgedBy string `json:"acknowledgedBy,omitempty" protobuf:"bytes,4,opt,name=acknowledgedBy"`
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,5,opt,name=lastTransitionTime"`
+}
+
+// EscalationPhase indicates the phase of escalation.
+type EscalationPhase string
+
+// These are valid phases of escalation.
+const (
+	// EscalationEscalating indicates that the steps of escalation are being
+	// notified one by one.
+	EscalationEscalating EscalationPhase = "Escalating"
+	// EscalationAcknowledged indicates that a receiver acknowledged the
+	// escalation, and the remaining steps are stopped.
+	EscalationAcknowledged EscalationPhase = "Acknowledged"
+	// EscalationResolved indicates that the alert of escalation is resolved
+	// before acknowledged.
+	EscalationResolved EscalationPhase = "Resolved"
+	// EscalationExhausted indicates that all the steps have been notified
+	// without acknowledgment.
+	EscalationExhausted EscalationPhase = "Exhausted"
+)
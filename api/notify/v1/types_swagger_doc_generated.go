@@ -38,6 +38,41 @@ func (Channel) SwaggerDoc() map[string]string {
 	return map_Channel
 }
 
+var map_ChannelAggregation = map[string]string{
+	"":                  "ChannelAggregation indicates how the message requests of a channel are deduplicated and suppressed before sending.",
+	"dedupKeys":         "DedupKeys are the variables identifying the same message. Message requests with the same template, receivers and values of the keys are deduplicated. All variables are used if not specified.",
+	"groupWaitSeconds":  "GroupWaitSeconds is the time to wait for the duplicate message requests before sending one message with the count of them. Deduplication is disabled if it is 0.",
+	"flapKey":           "FlapKey is the variable whose changes of value are counted to detect flapping. Defaults to alertStatus.",
+	"flapWindowSeconds": "FlapWindowSeconds is the time window in which the changes are counted.",
+	"flapThreshold":     "FlapThreshold is the number of changes in the window since which the message requests are suppressed. Flap detection is disabled if it is 0.",
+}
+
+func (ChannelAggregation) SwaggerDoc() map[string]string {
+	return map_ChannelAggregation
+}
+
+var map_ChannelDingTalk = map[string]string{
+	"":          "ChannelDingTalk indicates a channel configuration for sending notifications to a group through the custom robot of DingTalk.",
+	"url":       "URL is the webhook address of the robot, including the access token.",
+	"secret":    "Secret signs the requests when the robot enables the signature security setting.",
+	"rateLimit": "RateLimit is the maximum number of messages sent through the robot per minute. Defaults to 20.",
+}
+
+func (ChannelDingTalk) SwaggerDoc() map[string]string {
+	return map_ChannelDingTalk
+}
+
+var map_ChannelLark = map[string]string{
+	"":          "ChannelLark indicates a channel configuration for sending notifications to a group through the custom bot of Lark.",
+	"url":       "URL is the webhook address of the bot.",
+	"secret":    "Secret signs the requests when the bot enables the signature verification.",
+	"rateLimit": "RateLimit is the maximum number of messages sent through the bot per minute. Defaults to 20.",
+}
+
+func (ChannelLark) SwaggerDoc() map[string]string {
+	return map_ChannelLark
+}
+
 var map_ChannelList = map[string]string{
 	"":      "ChannelList is the whole list of all channels which owned by a tenant.",
 	"items": "List of channels.",
@@ -47,6 +82,51 @@ func (ChannelList) SwaggerDoc() map[string]string {
 	return map_ChannelList
 }
 
+var map_ChannelOpsgenie = map[string]string{
+	"":           "ChannelOpsgenie indicates a channel configuration for creating and closing alerts in Opsgenie.",
+	"apiKey":     "APIKey is the key of the API integration.",
+	"url":        "URL is the address of the Alert API. Defaults to https://api.opsgenie.com, and https://api.eu.opsgenie.com for the EU instance.",
+	"priorities": "Priorities maps the severity of alerts to the priority of Opsgenie alerts, which is one of P1 to P5.",
+}
+
+func (ChannelOpsgenie) SwaggerDoc() map[string]string {
+	return map_ChannelOpsgenie
+}
+
+var map_ChannelPagerDuty = map[string]string{
+	"":           "ChannelPagerDuty indicates a channel configuration for triggering and resolving incidents of a service in PagerDuty through the Events API v2.",
+	"routingKey": "RoutingKey is the integration key of the service.",
+	"url":        "URL is the address of the Events API v2. Defaults to https://events.pagerduty.com/v2/enqueue.",
+	"severities": "Severities maps the severity of alerts to the severity of events, which is one of critical, error, warning and info.",
+}
+
+func (ChannelPagerDuty) SwaggerDoc() map[string]string {
+	return map_ChannelPagerDuty
+}
+
+var map_ChannelQuota = map[string]string{
+	"":                         "ChannelQuota limits the messages sent through the channel, and through the channel for each project, in the sliding windows of the last minute and the last day. A non-positive limit means no limit.",
+	"messagesPerMinute":        "MessagesPerMinute is the maximum number of messages sent through the channel in the last minute.",
+	"messagesPerDay":           "MessagesPerDay is the maximum number of messages sent through the channel in the last 24 hours.",
+	"projectMessagesPerMinute": "ProjectMessagesPerMinute is the maximum number of messages sent through the channel for each project in the last minute.",
+	"projectMessagesPerDay":    "ProjectMessagesPerDay is the maximum number of messages sent through the channel for each project in the last 24 hours.",
+}
+
+func (ChannelQuota) SwaggerDoc() map[string]string {
+	return map_ChannelQuota
+}
+
+var map_ChannelQuotaUsage = map[string]string{
+	"":                   "ChannelQuotaUsage is the number of messages sent through the channel, or through the channel for a project.",
+	"project":            "Project is the project the messages are sent for, empty for all the messages of the channel.",
+	"messagesLastMinute": "MessagesLastMinute is the number of messages sent in the last minute.",
+	"messagesLastDay":    "MessagesLastDay is the number of messages sent in the last 24 hours.",
+}
+
+func (ChannelQuotaUsage) SwaggerDoc() map[string]string {
+	return map_ChannelQuotaUsage
+}
+
 var map_ChannelSMTP = map[string]string{
 	"": "ChannelSMTP indicates a channel configuration for sending email notifications using the SMTP server.",
 }
@@ -65,7 +145,9 @@ func (ChannelSpec) SwaggerDoc() map[string]string {
 }
 
 var map_ChannelStatus = map[string]string{
-	"": "ChannelStatus represents information about the status of a cluster.",
+	"":                    "ChannelStatus represents information about the status of a cluster.",
+	"quotaUsages":         "QuotaUsages are the messages sent through the channel reported by the message request controller.",
+	"lastQuotaUpdateTime": "LastQuotaUpdateTime is the time the quota usages are reported.",
 }
 
 func (ChannelStatus) SwaggerDoc() map[string]string {
@@ -80,6 +162,47 @@ func (ChannelTencentCloudSMS) SwaggerDoc() map[string]string {
 	return map_ChannelTencentCloudSMS
 }
 
+var map_ChannelTencentCloudVMS = map[string]string{
+	"":          "ChannelTencentCloudVMS indicates the configuration for calling through Tencent Cloud Voice Message Service with a voice template. See: https://cloud.tencent.com/document/product/1128",
+	"playTimes": "PlayTimes is the times the message is read out in a call. Defaults to 2.",
+}
+
+func (ChannelTencentCloudVMS) SwaggerDoc() map[string]string {
+	return map_ChannelTencentCloudVMS
+}
+
+var map_ChannelVoice = map[string]string{
+	"":                     "ChannelVoice indicates a channel configuration for calling the receivers and reading out the alarm, which is used for the alarms of configured severities. The receivers are called one after another until one of them answers.",
+	"tencentCloudVMS":      "TencentCloudVMS calls through Tencent Cloud Voice Message Service.",
+	"api":                  "API calls through the HTTP API of a SIP gateway or a voice provider.",
+	"severities":           "Severities are the severities of the alarms which trigger calls, the messages of other severities are not sent. Defaults to critical.",
+	"answerTimeoutSeconds": "AnswerTimeoutSeconds is the time to wait for a receiver to answer before calling the next one. Defaults to 60.",
+}
+
+func (ChannelVoice) SwaggerDoc() map[string]string {
+	return map_ChannelVoice
+}
+
+var map_ChannelVoiceAPI = map[string]string{
+	"":        "ChannelVoiceAPI indicates the HTTP API of a voice provider. The call is made by posting {\"callee\": mobile, \"content\": text} to the URL, which responds with {\"callID\": id}, and the state of the call is queried by getting URL/{id}, which responds with {\"status\": status}, where status is answered when the callee answered, and failed, busy or noanswer when the call ended unanswered.",
+	"url":     "URL is the address of the call API.",
+	"headers": "Headers are added to the requests, such as the authorization header.",
+}
+
+func (ChannelVoiceAPI) SwaggerDoc() map[string]string {
+	return map_ChannelVoiceAPI
+}
+
+var map_ChannelWeCom = map[string]string{
+	"":          "ChannelWeCom indicates a channel configuration for sending notifications to a group through the robot of WeCom (WeChat Work).",
+	"url":       "URL is the webhook address of the group robot, including the key.",
+	"rateLimit": "RateLimit is the maximum number of messages sent through the robot per minute. Defaults to 20.",
+}
+
+func (ChannelWeCom) SwaggerDoc() map[string]string {
+	return map_ChannelWeCom
+}
+
 var map_ChannelWebhook = map[string]string{
 	"": "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
 }
@@ -116,6 +239,85 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_Escalation = map[string]string{
+	"":     "Escalation indicates an alert being escalated by an escalation policy.",
+	"spec": "Spec defines the desired escalation.",
+}
+
+func (Escalation) SwaggerDoc() map[string]string {
+	return map_Escalation
+}
+
+var map_EscalationList = map[string]string{
+	"":      "EscalationList is the whole list of all escalations which owned by a tenant.",
+	"items": "List of escalations.",
+}
+
+func (EscalationList) SwaggerDoc() map[string]string {
+	return map_EscalationList
+}
+
+var map_EscalationPolicy = map[string]string{
+	"":     "EscalationPolicy indicates the ordered steps to notify until an escalation is acknowledged.",
+	"spec": "Spec defines the desired escalation policy.",
+}
+
+func (EscalationPolicy) SwaggerDoc() map[string]string {
+	return map_EscalationPolicy
+}
+
+var map_EscalationPolicyList = map[string]string{
+	"":      "EscalationPolicyList is the whole list of all escalation policies which owned by a tenant.",
+	"items": "List of escalation policies.",
+}
+
+func (EscalationPolicyList) SwaggerDoc() map[string]string {
+	return map_EscalationPolicyList
+}
+
+var map_EscalationPolicySpec = map[string]string{
+	"":      "EscalationPolicySpec is a description of an escalation policy.",
+	"steps": "Steps are notified in order until the escalation is acknowledged.",
+}
+
+func (EscalationPolicySpec) SwaggerDoc() map[string]string {
+	return map_EscalationPolicySpec
+}
+
+var map_EscalationSpec = map[string]string{
+	"":           "EscalationSpec is a description of an escalation.",
+	"policyName": "PolicyName is the name of escalation policy.",
+	"variables":  "Variables are used to render the templates of each step.",
+}
+
+func (EscalationSpec) SwaggerDoc() map[string]string {
+	return map_EscalationSpec
+}
+
+var map_EscalationStatus = map[string]string{
+	"":                   "EscalationStatus represents information about the status of an escalation.",
+	"step":               "Step is the number of steps which have been notified.",
+	"lastStepTime":       "LastStepTime is the time when the last step was notified.",
+	"acknowledgedBy":     "AcknowledgedBy is the username who acknowledged the escalation.",
+	"lastTransitionTime": "The last time the phase transitioned from one to another.",
+}
+
+func (EscalationStatus) SwaggerDoc() map[string]string {
+	return map_EscalationStatus
+}
+
+var map_EscalationStep = map[string]string{
+	"":                "EscalationStep indicates the receivers notified in a step of escalation.",
+	"waitSeconds":     "WaitSeconds is the time to wait since the previous step before notifying this step. The first step waits since the escalation is created.",
+	"channel":         "Channel is the name of channel used to notify.",
+	"template":        "Template is the name of template under the channel.",
+	"onCallSchedules": "OnCallSchedules notifies the current on-call receivers of the schedules.",
+}
+
+func (EscalationStep) SwaggerDoc() map[string]string {
+	return map_EscalationStep
+}
+
 var map_Message = map[string]string{
 	"":     "Message indicates a message in the notification system.",
 	"spec": "Spec defines the desired message.",
@@ -125,6 +327,16 @@ func (Message) SwaggerDoc() map[string]string {
 	return map_Message
 }
 
+var map_MessageDeliveryAttempt = map[string]string{
+	"":      "MessageDeliveryAttempt records an attempt to deliver a message.",
+	"time":  "Time is when the attempt was made.",
+	"error": "Error is the reason why the attempt failed, empty if it succeeded.",
+}
+
+func (MessageDeliveryAttempt) SwaggerDoc() map[string]string {
+	return map_MessageDeliveryAttempt
+}
+
 var map_MessageList = map[string]string{
 	"":      "MessageList is the whole list of all message which owned by a tenant.",
 	"items": "List of messages.",
@@ -171,7 +383,9 @@ func (MessageRequestStatus) SwaggerDoc() map[string]string {
 }
 
 var map_MessageSpec = map[string]string{
-	"": "MessageSpec is a description of a message.",
+	"":             "MessageSpec is a description of a message.",
+	"templateName": "TemplateName is the template under the channel used to render the message.",
+	"variables":    "Variables are used to render the message again when it is retried.",
 }
 
 func (MessageSpec) SwaggerDoc() map[string]string {
@@ -181,12 +395,45 @@ func (MessageSpec) SwaggerDoc() map[string]string {
 var map_MessageStatus = map[string]string{
 	"":                   "MessageStatus represents information about the status of a message.",
 	"lastTransitionTime": "The last time the condition transitioned from one status to another.",
+	"deliveryPhase":      "DeliveryPhase indicates whether the message has been delivered. Messages without it are delivered.",
+	"retryCount":         "RetryCount is the number of failed attempts since the message was sent or re-sent.",
+	"nextAttemptTime":    "NextAttemptTime is when the message is retried.",
+	"attempts":           "Attempts are the latest attempts to deliver the message.",
 }
 
 func (MessageStatus) SwaggerDoc() map[string]string {
 	return map_MessageStatus
 }
 
+var map_OnCallSchedule = map[string]string{
+	"":     "OnCallSchedule indicates the rotation of on-call receivers in a receiver group.",
+	"spec": "Spec defines the desired on-call schedule.",
+}
+
+func (OnCallSchedule) SwaggerDoc() map[string]string {
+	return map_OnCallSchedule
+}
+
+var map_OnCallScheduleList = map[string]string{
+	"":      "OnCallScheduleList is the whole list of all on-call schedules which owned by a tenant.",
+	"items": "List of on-call schedules.",
+}
+
+func (OnCallScheduleList) SwaggerDoc() map[string]string {
+	return map_OnCallScheduleList
+}
+
+var map_OnCallScheduleSpec = map[string]string{
+	"":              "OnCallScheduleSpec is a description of an on-call schedule.",
+	"receiverGroup": "ReceiverGroup is the receiver group whose receivers take turns to be on call in the order of receivers.",
+	"startTime":     "StartTime is the beginning of the shift of the first receiver.",
+	"shiftSeconds":  "ShiftSeconds is the duration of each shift. Defaults to 86400.",
+}
+
+func (OnCallScheduleSpec) SwaggerDoc() map[string]string {
+	return map_OnCallScheduleSpec
+}
+
 var map_Receiver = map[string]string{
 	"":     "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
 	"spec": "Spec defines the desired receiver.",
@@ -215,7 +462,8 @@ func (ReceiverGroupList) SwaggerDoc() map[string]string {
 }
 
 var map_ReceiverGroupSpec = map[string]string{
-	"": "ReceiverGroupSpec is a description of a receiver group.",
+	"":          "ReceiverGroupSpec is a description of a receiver group.",
+	"authGroup": "AuthGroup is the group of tke-auth, such as an LDAP or OIDC group, whose members are synchronized to the receivers of the group. The receivers and their email and mobile are kept updated from the identity provider.",
 }
 
 func (ReceiverGroupSpec) SwaggerDoc() map[string]string {
@@ -234,6 +482,8 @@ func (ReceiverList) SwaggerDoc() map[string]string {
 var map_ReceiverSpec = map[string]string{
 	"":           "ReceiverSpec is a description of a receiver.",
 	"identities": "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+	"locale":     "Locale is the language of messages sent to the receiver, such as zh or en. The template is used as it is if it has no localization of the locale.",
+	"authUser":   "AuthUser is the user of tke-auth which the receiver is synchronized from, the receivers synchronized are deleted when the user leaves all the synchronized groups.",
 }
 
 func (ReceiverSpec) SwaggerDoc() map[string]string {
@@ -249,6 +499,27 @@ func (Template) SwaggerDoc() map[string]string {
 	return map_Template
 }
 
+var map_TemplateDingTalk = map[string]string{
+	"":        "TemplateDingTalk indicates the template when sending a markdown message through the robot of DingTalk.",
+	"title":   "Title is shown in the conversation list and the notification.",
+	"content": "Content is the markdown content of the message.",
+}
+
+func (TemplateDingTalk) SwaggerDoc() map[string]string {
+	return map_TemplateDingTalk
+}
+
+var map_TemplateLark = map[string]string{
+	"":        "TemplateLark indicates the template when sending a message card through the bot of Lark.",
+	"title":   "Title is the header of the card.",
+	"content": "Content is the lark_md content of the card.",
+	"color":   "Color is the template color of the card header, such as red. Defaults to blue.",
+}
+
+func (TemplateLark) SwaggerDoc() map[string]string {
+	return map_TemplateLark
+}
+
 var map_TemplateList = map[string]string{
 	"":      "TemplateList is the whole list of all template which owned by a channel.",
 	"items": "List of templates.",
@@ -258,8 +529,42 @@ func (TemplateList) SwaggerDoc() map[string]string {
 	return map_TemplateList
 }
 
+var map_TemplateLocalization = map[string]string{
+	"":        "TemplateLocalization indicates the translation of a template for the receivers of a locale. The non-empty fields override the corresponding fields of the template.",
+	"locale":  "Locale is the language of the receivers, such as zh or en.",
+	"header":  "Header overrides the header of the text template.",
+	"body":    "Body overrides the body of the text, sms and wechat templates.",
+	"title":   "Title overrides the title of the dingtalk and lark templates.",
+	"content": "Content overrides the content of the wecom, dingtalk, lark and voice templates.",
+}
+
+func (TemplateLocalization) SwaggerDoc() map[string]string {
+	return map_TemplateLocalization
+}
+
+var map_TemplateOpsgenie = map[string]string{
+	"":            "TemplateOpsgenie indicates the template of the alerts created in Opsgenie.",
+	"message":     "Message is the title of the alert.",
+	"description": "Description is the detail of the alert.",
+}
+
+func (TemplateOpsgenie) SwaggerDoc() map[string]string {
+	return map_TemplateOpsgenie
+}
+
+var map_TemplatePagerDuty = map[string]string{
+	"":        "TemplatePagerDuty indicates the template of the incidents triggered in PagerDuty.",
+	"summary": "Summary is the title of the incident.",
+	"source":  "Source is the affected system, such as the cluster. Defaults to tke.",
+}
+
+func (TemplatePagerDuty) SwaggerDoc() map[string]string {
+	return map_TemplatePagerDuty
+}
+
 var map_TemplateSpec = map[string]string{
-	"": "TemplateSpec is a description of a template.",
+	"":              "TemplateSpec is a description of a template.",
+	"localizations": "Localizations are used instead of the template for the receivers of the locales.",
 }
 
 func (TemplateSpec) SwaggerDoc() map[string]string {
@@ -282,6 +587,25 @@ func (TemplateText) SwaggerDoc() map[string]string {
 	return map_TemplateText
 }
 
+var map_TemplateVoice = map[string]string{
+	"":           "TemplateVoice indicates the template of the voice calls.",
+	"templateID": "TemplateID is the voice template of Tencent Cloud VMS, whose parameters are filled by the variables referenced in the content in order.",
+	"content":    "Content is the text read out in the call.",
+}
+
+func (TemplateVoice) SwaggerDoc() map[string]string {
+	return map_TemplateVoice
+}
+
+var map_TemplateWeCom = map[string]string{
+	"":        "TemplateWeCom indicates the template when sending a markdown message through the robot of WeCom.",
+	"content": "Content is the markdown content of the message.",
+}
+
+func (TemplateWeCom) SwaggerDoc() map[string]string {
+	return map_TemplateWeCom
+}
+
 var map_TemplateWechat = map[string]string{
 	"":                 "TemplateWechat indicates the template when sending a text message using the WeChat public account. The template must be approved and registered.",
 	"templateID":       "TemplateID indicates the template id of the template message notification. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
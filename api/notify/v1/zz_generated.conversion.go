@@ -47,6 +47,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelAggregation)(nil), (*notify.ChannelAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelAggregation_To_notify_ChannelAggregation(a.(*ChannelAggregation), b.(*notify.ChannelAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelAggregation)(nil), (*ChannelAggregation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelAggregation_To_v1_ChannelAggregation(a.(*notify.ChannelAggregation), b.(*ChannelAggregation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelDingTalk)(nil), (*notify.ChannelDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(a.(*ChannelDingTalk), b.(*notify.ChannelDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelDingTalk)(nil), (*ChannelDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(a.(*notify.ChannelDingTalk), b.(*ChannelDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelLark)(nil), (*notify.ChannelLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelLark_To_notify_ChannelLark(a.(*ChannelLark), b.(*notify.ChannelLark), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelLark)(nil), (*ChannelLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelLark_To_v1_ChannelLark(a.(*notify.ChannelLark), b.(*ChannelLark), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelList)(nil), (*notify.ChannelList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelList_To_notify_ChannelList(a.(*ChannelList), b.(*notify.ChannelList), scope)
 	}); err != nil {
@@ -57,6 +87,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelOpsgenie)(nil), (*notify.ChannelOpsgenie)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelOpsgenie_To_notify_ChannelOpsgenie(a.(*ChannelOpsgenie), b.(*notify.ChannelOpsgenie), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelOpsgenie)(nil), (*ChannelOpsgenie)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelOpsgenie_To_v1_ChannelOpsgenie(a.(*notify.ChannelOpsgenie), b.(*ChannelOpsgenie), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelPagerDuty)(nil), (*notify.ChannelPagerDuty)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelPagerDuty_To_notify_ChannelPagerDuty(a.(*ChannelPagerDuty), b.(*notify.ChannelPagerDuty), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelPagerDuty)(nil), (*ChannelPagerDuty)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelPagerDuty_To_v1_ChannelPagerDuty(a.(*notify.ChannelPagerDuty), b.(*ChannelPagerDuty), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelQuota)(nil), (*notify.ChannelQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelQuota_To_notify_ChannelQuota(a.(*ChannelQuota), b.(*notify.ChannelQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelQuota)(nil), (*ChannelQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelQuota_To_v1_ChannelQuota(a.(*notify.ChannelQuota), b.(*ChannelQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelQuotaUsage)(nil), (*notify.ChannelQuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelQuotaUsage_To_notify_ChannelQuotaUsage(a.(*ChannelQuotaUsage), b.(*notify.ChannelQuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelQuotaUsage)(nil), (*ChannelQuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelQuotaUsage_To_v1_ChannelQuotaUsage(a.(*notify.ChannelQuotaUsage), b.(*ChannelQuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelSMTP)(nil), (*notify.ChannelSMTP)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelSMTP_To_notify_ChannelSMTP(a.(*ChannelSMTP), b.(*notify.ChannelSMTP), scope)
 	}); err != nil {
@@ -97,6 +167,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChannelTencentCloudVMS)(nil), (*notify.ChannelTencentCloudVMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelTencentCloudVMS_To_notify_ChannelTencentCloudVMS(a.(*ChannelTencentCloudVMS), b.(*notify.ChannelTencentCloudVMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelTencentCloudVMS)(nil), (*ChannelTencentCloudVMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelTencentCloudVMS_To_v1_ChannelTencentCloudVMS(a.(*notify.ChannelTencentCloudVMS), b.(*ChannelTencentCloudVMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelVoice)(nil), (*notify.ChannelVoice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelVoice_To_notify_ChannelVoice(a.(*ChannelVoice), b.(*notify.ChannelVoice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelVoice)(nil), (*ChannelVoice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelVoice_To_v1_ChannelVoice(a.(*notify.ChannelVoice), b.(*ChannelVoice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelVoiceAPI)(nil), (*notify.ChannelVoiceAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelVoiceAPI_To_notify_ChannelVoiceAPI(a.(*ChannelVoiceAPI), b.(*notify.ChannelVoiceAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelVoiceAPI)(nil), (*ChannelVoiceAPI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelVoiceAPI_To_v1_ChannelVoiceAPI(a.(*notify.ChannelVoiceAPI), b.(*ChannelVoiceAPI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChannelWeCom)(nil), (*notify.ChannelWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ChannelWeCom_To_notify_ChannelWeCom(a.(*ChannelWeCom), b.(*notify.ChannelWeCom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.ChannelWeCom)(nil), (*ChannelWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_ChannelWeCom_To_v1_ChannelWeCom(a.(*notify.ChannelWeCom), b.(*ChannelWeCom), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ChannelWebhook)(nil), (*notify.ChannelWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ChannelWebhook_To_notify_ChannelWebhook(a.(*ChannelWebhook), b.(*notify.ChannelWebhook), scope)
 	}); err != nil {
@@ -137,6 +247,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Escalation)(nil), (*notify.Escalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Escalation_To_notify_Escalation(a.(*Escalation), b.(*notify.Escalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.Escalation)(nil), (*Escalation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_Escalation_To_v1_Escalation(a.(*notify.Escalation), b.(*Escalation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationList)(nil), (*notify.EscalationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationList_To_notify_EscalationList(a.(*EscalationList), b.(*notify.EscalationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationList)(nil), (*EscalationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationList_To_v1_EscalationList(a.(*notify.EscalationList), b.(*EscalationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicy)(nil), (*notify.EscalationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicy_To_notify_EscalationPolicy(a.(*EscalationPolicy), b.(*notify.EscalationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicy)(nil), (*EscalationPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicy_To_v1_EscalationPolicy(a.(*notify.EscalationPolicy), b.(*EscalationPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicyList)(nil), (*notify.EscalationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(a.(*EscalationPolicyList), b.(*notify.EscalationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicyList)(nil), (*EscalationPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(a.(*notify.EscalationPolicyList), b.(*EscalationPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationPolicySpec)(nil), (*notify.EscalationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(a.(*EscalationPolicySpec), b.(*notify.EscalationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationPolicySpec)(nil), (*EscalationPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(a.(*notify.EscalationPolicySpec), b.(*EscalationPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationSpec)(nil), (*notify.EscalationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationSpec_To_notify_EscalationSpec(a.(*EscalationSpec), b.(*notify.EscalationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationSpec)(nil), (*EscalationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationSpec_To_v1_EscalationSpec(a.(*notify.EscalationSpec), b.(*EscalationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationStatus)(nil), (*notify.EscalationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationStatus_To_notify_EscalationStatus(a.(*EscalationStatus), b.(*notify.EscalationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationStatus)(nil), (*EscalationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationStatus_To_v1_EscalationStatus(a.(*notify.EscalationStatus), b.(*EscalationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EscalationStep)(nil), (*notify.EscalationStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EscalationStep_To_notify_EscalationStep(a.(*EscalationStep), b.(*notify.EscalationStep), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.EscalationStep)(nil), (*EscalationStep)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_EscalationStep_To_v1_EscalationStep(a.(*notify.EscalationStep), b.(*EscalationStep), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Message)(nil), (*notify.Message)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Message_To_notify_Message(a.(*Message), b.(*notify.Message), scope)
 	}); err != nil {
@@ -147,6 +337,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MessageDeliveryAttempt)(nil), (*notify.MessageDeliveryAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MessageDeliveryAttempt_To_notify_MessageDeliveryAttempt(a.(*MessageDeliveryAttempt), b.(*notify.MessageDeliveryAttempt), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.MessageDeliveryAttempt)(nil), (*MessageDeliveryAttempt)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_MessageDeliveryAttempt_To_v1_MessageDeliveryAttempt(a.(*notify.MessageDeliveryAttempt), b.(*MessageDeliveryAttempt), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MessageList)(nil), (*notify.MessageList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_MessageList_To_notify_MessageList(a.(*MessageList), b.(*notify.MessageList), scope)
 	}); err != nil {
@@ -217,6 +417,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OnCallSchedule)(nil), (*notify.OnCallSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallSchedule_To_notify_OnCallSchedule(a.(*OnCallSchedule), b.(*notify.OnCallSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallSchedule)(nil), (*OnCallSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallSchedule_To_v1_OnCallSchedule(a.(*notify.OnCallSchedule), b.(*OnCallSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallScheduleList)(nil), (*notify.OnCallScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(a.(*OnCallScheduleList), b.(*notify.OnCallScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallScheduleList)(nil), (*OnCallScheduleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(a.(*notify.OnCallScheduleList), b.(*OnCallScheduleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OnCallScheduleSpec)(nil), (*notify.OnCallScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(a.(*OnCallScheduleSpec), b.(*notify.OnCallScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.OnCallScheduleSpec)(nil), (*OnCallScheduleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(a.(*notify.OnCallScheduleSpec), b.(*OnCallScheduleSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Receiver)(nil), (*notify.Receiver)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Receiver_To_notify_Receiver(a.(*Receiver), b.(*notify.Receiver), scope)
 	}); err != nil {
@@ -287,6 +517,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateDingTalk)(nil), (*notify.TemplateDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateDingTalk_To_notify_TemplateDingTalk(a.(*TemplateDingTalk), b.(*notify.TemplateDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateDingTalk)(nil), (*TemplateDingTalk)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateDingTalk_To_v1_TemplateDingTalk(a.(*notify.TemplateDingTalk), b.(*TemplateDingTalk), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateLark)(nil), (*notify.TemplateLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateLark_To_notify_TemplateLark(a.(*TemplateLark), b.(*notify.TemplateLark), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateLark)(nil), (*TemplateLark)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateLark_To_v1_TemplateLark(a.(*notify.TemplateLark), b.(*TemplateLark), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TemplateList)(nil), (*notify.TemplateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TemplateList_To_notify_TemplateList(a.(*TemplateList), b.(*notify.TemplateList), scope)
 	}); err != nil {
@@ -297,6 +547,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateLocalization)(nil), (*notify.TemplateLocalization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateLocalization_To_notify_TemplateLocalization(a.(*TemplateLocalization), b.(*notify.TemplateLocalization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateLocalization)(nil), (*TemplateLocalization)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateLocalization_To_v1_TemplateLocalization(a.(*notify.TemplateLocalization), b.(*TemplateLocalization), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateOpsgenie)(nil), (*notify.TemplateOpsgenie)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateOpsgenie_To_notify_TemplateOpsgenie(a.(*TemplateOpsgenie), b.(*notify.TemplateOpsgenie), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateOpsgenie)(nil), (*TemplateOpsgenie)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateOpsgenie_To_v1_TemplateOpsgenie(a.(*notify.TemplateOpsgenie), b.(*TemplateOpsgenie), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplatePagerDuty)(nil), (*notify.TemplatePagerDuty)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplatePagerDuty_To_notify_TemplatePagerDuty(a.(*TemplatePagerDuty), b.(*notify.TemplatePagerDuty), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplatePagerDuty)(nil), (*TemplatePagerDuty)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplatePagerDuty_To_v1_TemplatePagerDuty(a.(*notify.TemplatePagerDuty), b.(*TemplatePagerDuty), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TemplateSpec)(nil), (*notify.TemplateSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TemplateSpec_To_notify_TemplateSpec(a.(*TemplateSpec), b.(*notify.TemplateSpec), scope)
 	}); err != nil {
@@ -327,6 +607,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TemplateVoice)(nil), (*notify.TemplateVoice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateVoice_To_notify_TemplateVoice(a.(*TemplateVoice), b.(*notify.TemplateVoice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateVoice)(nil), (*TemplateVoice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateVoice_To_v1_TemplateVoice(a.(*notify.TemplateVoice), b.(*TemplateVoice), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TemplateWeCom)(nil), (*notify.TemplateWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TemplateWeCom_To_notify_TemplateWeCom(a.(*TemplateWeCom), b.(*notify.TemplateWeCom), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*notify.TemplateWeCom)(nil), (*TemplateWeCom)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_notify_TemplateWeCom_To_v1_TemplateWeCom(a.(*notify.TemplateWeCom), b.(*TemplateWeCom), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*TemplateWechat)(nil), (*notify.TemplateWechat)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_TemplateWechat_To_notify_TemplateWechat(a.(*TemplateWechat), b.(*notify.TemplateWechat), scope)
 	}); err != nil {
@@ -372,6 +672,82 @@ func Convert_notify_Channel_To_v1_Channel(in *notify.Channel, out *Channel, s co
 	return autoConvert_notify_Channel_To_v1_Channel(in, out, s)
 }
 
+func autoConvert_v1_ChannelAggregation_To_notify_ChannelAggregation(in *ChannelAggregation, out *notify.ChannelAggregation, s conversion.Scope) error {
+	out.DedupKeys = *(*[]string)(unsafe.Pointer(&in.DedupKeys))
+	out.GroupWaitSeconds = in.GroupWaitSeconds
+	out.FlapKey = in.FlapKey
+	out.FlapWindowSeconds = in.FlapWindowSeconds
+	out.FlapThreshold = in.FlapThreshold
+	return nil
+}
+
+// Convert_v1_ChannelAggregation_To_notify_ChannelAggregation is an autogenerated conversion function.
+func Convert_v1_ChannelAggregation_To_notify_ChannelAggregation(in *ChannelAggregation, out *notify.ChannelAggregation, s conversion.Scope) error {
+	return autoConvert_v1_ChannelAggregation_To_notify_ChannelAggregation(in, out, s)
+}
+
+func autoConvert_notify_ChannelAggregation_To_v1_ChannelAggregation(in *notify.ChannelAggregation, out *ChannelAggregation, s conversion.Scope) error {
+	out.DedupKeys = *(*[]string)(unsafe.Pointer(&in.DedupKeys))
+	out.GroupWaitSeconds = in.GroupWaitSeconds
+	out.FlapKey = in.FlapKey
+	out.FlapWindowSeconds = in.FlapWindowSeconds
+	out.FlapThreshold = in.FlapThreshold
+	return nil
+}
+
+// Convert_notify_ChannelAggregation_To_v1_ChannelAggregation is an autogenerated conversion function.
+func Convert_notify_ChannelAggregation_To_v1_ChannelAggregation(in *notify.ChannelAggregation, out *ChannelAggregation, s conversion.Scope) error {
+	return autoConvert_notify_ChannelAggregation_To_v1_ChannelAggregation(in, out, s)
+}
+
+func autoConvert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in *ChannelDingTalk, out *notify.ChannelDingTalk, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Secret = in.Secret
+	out.RateLimit = in.RateLimit
+	return nil
+}
+
+// Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk is an autogenerated conversion function.
+func Convert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in *ChannelDingTalk, out *notify.ChannelDingTalk, s conversion.Scope) error {
+	return autoConvert_v1_ChannelDingTalk_To_notify_ChannelDingTalk(in, out, s)
+}
+
+func autoConvert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in *notify.ChannelDingTalk, out *ChannelDingTalk, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Secret = in.Secret
+	out.RateLimit = in.RateLimit
+	return nil
+}
+
+// Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk is an autogenerated conversion function.
+func Convert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in *notify.ChannelDingTalk, out *ChannelDingTalk, s conversion.Scope) error {
+	return autoConvert_notify_ChannelDingTalk_To_v1_ChannelDingTalk(in, out, s)
+}
+
+func autoConvert_v1_ChannelLark_To_notify_ChannelLark(in *ChannelLark, out *notify.ChannelLark, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Secret = in.Secret
+	out.RateLimit = in.RateLimit
+	return nil
+}
+
+// Convert_v1_ChannelLark_To_notify_ChannelLark is an autogenerated conversion function.
+func Convert_v1_ChannelLark_To_notify_ChannelLark(in *ChannelLark, out *notify.ChannelLark, s conversion.Scope) error {
+	return autoConvert_v1_ChannelLark_To_notify_ChannelLark(in, out, s)
+}
+
+func autoConvert_notify_ChannelLark_To_v1_ChannelLark(in *notify.ChannelLark, out *ChannelLark, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Secret = in.Secret
+	out.RateLimit = in.RateLimit
+	return nil
+}
+
+// Convert_notify_ChannelLark_To_v1_ChannelLark is an autogenerated conversion function.
+func Convert_notify_ChannelLark_To_v1_ChannelLark(in *notify.ChannelLark, out *ChannelLark, s conversion.Scope) error {
+	return autoConvert_notify_ChannelLark_To_v1_ChannelLark(in, out, s)
+}
+
 func autoConvert_v1_ChannelList_To_notify_ChannelList(in *ChannelList, out *notify.ChannelList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]notify.Channel)(unsafe.Pointer(&in.Items))
@@ -394,6 +770,104 @@ func Convert_notify_ChannelList_To_v1_ChannelList(in *notify.ChannelList, out *C
 	return autoConvert_notify_ChannelList_To_v1_ChannelList(in, out, s)
 }
 
+func autoConvert_v1_ChannelOpsgenie_To_notify_ChannelOpsgenie(in *ChannelOpsgenie, out *notify.ChannelOpsgenie, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.URL = in.URL
+	out.Priorities = *(*map[string]string)(unsafe.Pointer(&in.Priorities))
+	return nil
+}
+
+// Convert_v1_ChannelOpsgenie_To_notify_ChannelOpsgenie is an autogenerated conversion function.
+func Convert_v1_ChannelOpsgenie_To_notify_ChannelOpsgenie(in *ChannelOpsgenie, out *notify.ChannelOpsgenie, s conversion.Scope) error {
+	return autoConvert_v1_ChannelOpsgenie_To_notify_ChannelOpsgenie(in, out, s)
+}
+
+func autoConvert_notify_ChannelOpsgenie_To_v1_ChannelOpsgenie(in *notify.ChannelOpsgenie, out *ChannelOpsgenie, s conversion.Scope) error {
+	out.APIKey = in.APIKey
+	out.URL = in.URL
+	out.Priorities = *(*map[string]string)(unsafe.Pointer(&in.Priorities))
+	return nil
+}
+
+// Convert_notify_ChannelOpsgenie_To_v1_ChannelOpsgenie is an autogenerated conversion function.
+func Convert_notify_ChannelOpsgenie_To_v1_ChannelOpsgenie(in *notify.ChannelOpsgenie, out *ChannelOpsgenie, s conversion.Scope) error {
+	return autoConvert_notify_ChannelOpsgenie_To_v1_ChannelOpsgenie(in, out, s)
+}
+
+func autoConvert_v1_ChannelPagerDuty_To_notify_ChannelPagerDuty(in *ChannelPagerDuty, out *notify.ChannelPagerDuty, s conversion.Scope) error {
+	out.RoutingKey = in.RoutingKey
+	out.URL = in.URL
+	out.Severities = *(*map[string]string)(unsafe.Pointer(&in.Severities))
+	return nil
+}
+
+// Convert_v1_ChannelPagerDuty_To_notify_ChannelPagerDuty is an autogenerated conversion function.
+func Convert_v1_ChannelPagerDuty_To_notify_ChannelPagerDuty(in *ChannelPagerDuty, out *notify.ChannelPagerDuty, s conversion.Scope) error {
+	return autoConvert_v1_ChannelPagerDuty_To_notify_ChannelPagerDuty(in, out, s)
+}
+
+func autoConvert_notify_ChannelPagerDuty_To_v1_ChannelPagerDuty(in *notify.ChannelPagerDuty, out *ChannelPagerDuty, s conversion.Scope) error {
+	out.RoutingKey = in.RoutingKey
+	out.URL = in.URL
+	out.Severities = *(*map[string]string)(unsafe.Pointer(&in.Severities))
+	return nil
+}
+
+// Convert_notify_ChannelPagerDuty_To_v1_ChannelPagerDuty is an autogenerated conversion function.
+func Convert_notify_ChannelPagerDuty_To_v1_ChannelPagerDuty(in *notify.ChannelPagerDuty, out *ChannelPagerDuty, s conversion.Scope) error {
+	return autoConvert_notify_ChannelPagerDuty_To_v1_ChannelPagerDuty(in, out, s)
+}
+
+func autoConvert_v1_ChannelQuota_To_notify_ChannelQuota(in *ChannelQuota, out *notify.ChannelQuota, s conversion.Scope) error {
+	out.MessagesPerMinute = in.MessagesPerMinute
+	out.MessagesPerDay = in.MessagesPerDay
+	out.ProjectMessagesPerMinute = in.ProjectMessagesPerMinute
+	out.ProjectMessagesPerDay = in.ProjectMessagesPerDay
+	return nil
+}
+
+// Convert_v1_ChannelQuota_To_notify_ChannelQuota is an autogenerated conversion function.
+func Convert_v1_ChannelQuota_To_notify_ChannelQuota(in *ChannelQuota, out *notify.ChannelQuota, s conversion.Scope) error {
+	return autoConvert_v1_ChannelQuota_To_notify_ChannelQuota(in, out, s)
+}
+
+func autoConvert_notify_ChannelQuota_To_v1_ChannelQuota(in *notify.ChannelQuota, out *ChannelQuota, s conversion.Scope) error {
+	out.MessagesPerMinute = in.MessagesPerMinute
+	out.MessagesPerDay = in.MessagesPerDay
+	out.ProjectMessagesPerMinute = in.ProjectMessagesPerMinute
+	out.ProjectMessagesPerDay = in.ProjectMessagesPerDay
+	return nil
+}
+
+// Convert_notify_ChannelQuota_To_v1_ChannelQuota is an autogenerated conversion function.
+func Convert_notify_ChannelQuota_To_v1_ChannelQuota(in *notify.ChannelQuota, out *ChannelQuota, s conversion.Scope) error {
+	return autoConvert_notify_ChannelQuota_To_v1_ChannelQuota(in, out, s)
+}
+
+func autoConvert_v1_ChannelQuotaUsage_To_notify_ChannelQuotaUsage(in *ChannelQuotaUsage, out *notify.ChannelQuotaUsage, s conversion.Scope) error {
+	out.Project = in.Project
+	out.MessagesLastMinute = in.MessagesLastMinute
+	out.MessagesLastDay = in.MessagesLastDay
+	return nil
+}
+
+// Convert_v1_ChannelQuotaUsage_To_notify_ChannelQuotaUsage is an autogenerated conversion function.
+func Convert_v1_ChannelQuotaUsage_To_notify_ChannelQuotaUsage(in *ChannelQuotaUsage, out *notify.ChannelQuotaUsage, s conversion.Scope) error {
+	return autoConvert_v1_ChannelQuotaUsage_To_notify_ChannelQuotaUsage(in, out, s)
+}
+
+func autoConvert_notify_ChannelQuotaUsage_To_v1_ChannelQuotaUsage(in *notify.ChannelQuotaUsage, out *ChannelQuotaUsage, s conversion.Scope) error {
+	out.Project = in.Project
+	out.MessagesLastMinute = in.MessagesLastMinute
+	out.MessagesLastDay = in.MessagesLastDay
+	return nil
+}
+
+// Convert_notify_ChannelQuotaUsage_To_v1_ChannelQuotaUsage is an autogenerated conversion function.
+func Convert_notify_ChannelQuotaUsage_To_v1_ChannelQuotaUsage(in *notify.ChannelQuotaUsage, out *ChannelQuotaUsage, s conversion.Scope) error {
+	return autoConvert_notify_ChannelQuotaUsage_To_v1_ChannelQuotaUsage(in, out, s)
+}
+
 func autoConvert_v1_ChannelSMTP_To_notify_ChannelSMTP(in *ChannelSMTP, out *notify.ChannelSMTP, s conversion.Scope) error {
 	out.SMTPHost = in.SMTPHost
 	out.SMTPPort = in.SMTPPort
@@ -430,6 +904,14 @@ func autoConvert_v1_ChannelSpec_To_notify_ChannelSpec(in *ChannelSpec, out *noti
 	out.Wechat = (*notify.ChannelWechat)(unsafe.Pointer(in.Wechat))
 	out.SMTP = (*notify.ChannelSMTP)(unsafe.Pointer(in.SMTP))
 	out.Webhook = (*notify.ChannelWebhook)(unsafe.Pointer(in.Webhook))
+	out.WeCom = (*notify.ChannelWeCom)(unsafe.Pointer(in.WeCom))
+	out.DingTalk = (*notify.ChannelDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.Lark = (*notify.ChannelLark)(unsafe.Pointer(in.Lark))
+	out.Aggregation = (*notify.ChannelAggregation)(unsafe.Pointer(in.Aggregation))
+	out.PagerDuty = (*notify.ChannelPagerDuty)(unsafe.Pointer(in.PagerDuty))
+	out.Opsgenie = (*notify.ChannelOpsgenie)(unsafe.Pointer(in.Opsgenie))
+	out.Quota = (*notify.ChannelQuota)(unsafe.Pointer(in.Quota))
+	out.Voice = (*notify.ChannelVoice)(unsafe.Pointer(in.Voice))
 	return nil
 }
 
@@ -446,6 +928,14 @@ func autoConvert_notify_ChannelSpec_To_v1_ChannelSpec(in *notify.ChannelSpec, ou
 	out.Wechat = (*ChannelWechat)(unsafe.Pointer(in.Wechat))
 	out.SMTP = (*ChannelSMTP)(unsafe.Pointer(in.SMTP))
 	out.Webhook = (*ChannelWebhook)(unsafe.Pointer(in.Webhook))
+	out.WeCom = (*ChannelWeCom)(unsafe.Pointer(in.WeCom))
+	out.DingTalk = (*ChannelDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.Lark = (*ChannelLark)(unsafe.Pointer(in.Lark))
+	out.Aggregation = (*ChannelAggregation)(unsafe.Pointer(in.Aggregation))
+	out.PagerDuty = (*ChannelPagerDuty)(unsafe.Pointer(in.PagerDuty))
+	out.Opsgenie = (*ChannelOpsgenie)(unsafe.Pointer(in.Opsgenie))
+	out.Quota = (*ChannelQuota)(unsafe.Pointer(in.Quota))
+	out.Voice = (*ChannelVoice)(unsafe.Pointer(in.Voice))
 	return nil
 }
 
@@ -456,6 +946,8 @@ func Convert_notify_ChannelSpec_To_v1_ChannelSpec(in *notify.ChannelSpec, out *C
 
 func autoConvert_v1_ChannelStatus_To_notify_ChannelStatus(in *ChannelStatus, out *notify.ChannelStatus, s conversion.Scope) error {
 	out.Phase = notify.ChannelPhase(in.Phase)
+	out.QuotaUsages = *(*[]notify.ChannelQuotaUsage)(unsafe.Pointer(&in.QuotaUsages))
+	out.LastQuotaUpdateTime = in.LastQuotaUpdateTime
 	return nil
 }
 
@@ -466,6 +958,8 @@ func Convert_v1_ChannelStatus_To_notify_ChannelStatus(in *ChannelStatus, out *no
 
 func autoConvert_notify_ChannelStatus_To_v1_ChannelStatus(in *notify.ChannelStatus, out *ChannelStatus, s conversion.Scope) error {
 	out.Phase = ChannelPhase(in.Phase)
+	out.QuotaUsages = *(*[]ChannelQuotaUsage)(unsafe.Pointer(&in.QuotaUsages))
+	out.LastQuotaUpdateTime = in.LastQuotaUpdateTime
 	return nil
 }
 
@@ -486,16 +980,110 @@ func Convert_v1_ChannelTencentCloudSMS_To_notify_ChannelTencentCloudSMS(in *Chan
 	return autoConvert_v1_ChannelTencentCloudSMS_To_notify_ChannelTencentCloudSMS(in, out, s)
 }
 
-func autoConvert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in *notify.ChannelTencentCloudSMS, out *ChannelTencentCloudSMS, s conversion.Scope) error {
-	out.AppKey = in.AppKey
-	out.SdkAppID = in.SdkAppID
-	out.Extend = in.Extend
+func autoConvert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in *notify.ChannelTencentCloudSMS, out *ChannelTencentCloudSMS, s conversion.Scope) error {
+	out.AppKey = in.AppKey
+	out.SdkAppID = in.SdkAppID
+	out.Extend = in.Extend
+	return nil
+}
+
+// Convert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS is an autogenerated conversion function.
+func Convert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in *notify.ChannelTencentCloudSMS, out *ChannelTencentCloudSMS, s conversion.Scope) error {
+	return autoConvert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in, out, s)
+}
+
+func autoConvert_v1_ChannelTencentCloudVMS_To_notify_ChannelTencentCloudVMS(in *ChannelTencentCloudVMS, out *notify.ChannelTencentCloudVMS, s conversion.Scope) error {
+	out.AppKey = in.AppKey
+	out.SdkAppID = in.SdkAppID
+	out.PlayTimes = in.PlayTimes
+	return nil
+}
+
+// Convert_v1_ChannelTencentCloudVMS_To_notify_ChannelTencentCloudVMS is an autogenerated conversion function.
+func Convert_v1_ChannelTencentCloudVMS_To_notify_ChannelTencentCloudVMS(in *ChannelTencentCloudVMS, out *notify.ChannelTencentCloudVMS, s conversion.Scope) error {
+	return autoConvert_v1_ChannelTencentCloudVMS_To_notify_ChannelTencentCloudVMS(in, out, s)
+}
+
+func autoConvert_notify_ChannelTencentCloudVMS_To_v1_ChannelTencentCloudVMS(in *notify.ChannelTencentCloudVMS, out *ChannelTencentCloudVMS, s conversion.Scope) error {
+	out.AppKey = in.AppKey
+	out.SdkAppID = in.SdkAppID
+	out.PlayTimes = in.PlayTimes
+	return nil
+}
+
+// Convert_notify_ChannelTencentCloudVMS_To_v1_ChannelTencentCloudVMS is an autogenerated conversion function.
+func Convert_notify_ChannelTencentCloudVMS_To_v1_ChannelTencentCloudVMS(in *notify.ChannelTencentCloudVMS, out *ChannelTencentCloudVMS, s conversion.Scope) error {
+	return autoConvert_notify_ChannelTencentCloudVMS_To_v1_ChannelTencentCloudVMS(in, out, s)
+}
+
+func autoConvert_v1_ChannelVoice_To_notify_ChannelVoice(in *ChannelVoice, out *notify.ChannelVoice, s conversion.Scope) error {
+	out.TencentCloudVMS = (*notify.ChannelTencentCloudVMS)(unsafe.Pointer(in.TencentCloudVMS))
+	out.API = (*notify.ChannelVoiceAPI)(unsafe.Pointer(in.API))
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.AnswerTimeoutSeconds = in.AnswerTimeoutSeconds
+	return nil
+}
+
+// Convert_v1_ChannelVoice_To_notify_ChannelVoice is an autogenerated conversion function.
+func Convert_v1_ChannelVoice_To_notify_ChannelVoice(in *ChannelVoice, out *notify.ChannelVoice, s conversion.Scope) error {
+	return autoConvert_v1_ChannelVoice_To_notify_ChannelVoice(in, out, s)
+}
+
+func autoConvert_notify_ChannelVoice_To_v1_ChannelVoice(in *notify.ChannelVoice, out *ChannelVoice, s conversion.Scope) error {
+	out.TencentCloudVMS = (*ChannelTencentCloudVMS)(unsafe.Pointer(in.TencentCloudVMS))
+	out.API = (*ChannelVoiceAPI)(unsafe.Pointer(in.API))
+	out.Severities = *(*[]string)(unsafe.Pointer(&in.Severities))
+	out.AnswerTimeoutSeconds = in.AnswerTimeoutSeconds
+	return nil
+}
+
+// Convert_notify_ChannelVoice_To_v1_ChannelVoice is an autogenerated conversion function.
+func Convert_notify_ChannelVoice_To_v1_ChannelVoice(in *notify.ChannelVoice, out *ChannelVoice, s conversion.Scope) error {
+	return autoConvert_notify_ChannelVoice_To_v1_ChannelVoice(in, out, s)
+}
+
+func autoConvert_v1_ChannelVoiceAPI_To_notify_ChannelVoiceAPI(in *ChannelVoiceAPI, out *notify.ChannelVoiceAPI, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_v1_ChannelVoiceAPI_To_notify_ChannelVoiceAPI is an autogenerated conversion function.
+func Convert_v1_ChannelVoiceAPI_To_notify_ChannelVoiceAPI(in *ChannelVoiceAPI, out *notify.ChannelVoiceAPI, s conversion.Scope) error {
+	return autoConvert_v1_ChannelVoiceAPI_To_notify_ChannelVoiceAPI(in, out, s)
+}
+
+func autoConvert_notify_ChannelVoiceAPI_To_v1_ChannelVoiceAPI(in *notify.ChannelVoiceAPI, out *ChannelVoiceAPI, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Headers = *(*map[string]string)(unsafe.Pointer(&in.Headers))
+	return nil
+}
+
+// Convert_notify_ChannelVoiceAPI_To_v1_ChannelVoiceAPI is an autogenerated conversion function.
+func Convert_notify_ChannelVoiceAPI_To_v1_ChannelVoiceAPI(in *notify.ChannelVoiceAPI, out *ChannelVoiceAPI, s conversion.Scope) error {
+	return autoConvert_notify_ChannelVoiceAPI_To_v1_ChannelVoiceAPI(in, out, s)
+}
+
+func autoConvert_v1_ChannelWeCom_To_notify_ChannelWeCom(in *ChannelWeCom, out *notify.ChannelWeCom, s conversion.Scope) error {
+	out.URL = in.URL
+	out.RateLimit = in.RateLimit
+	return nil
+}
+
+// Convert_v1_ChannelWeCom_To_notify_ChannelWeCom is an autogenerated conversion function.
+func Convert_v1_ChannelWeCom_To_notify_ChannelWeCom(in *ChannelWeCom, out *notify.ChannelWeCom, s conversion.Scope) error {
+	return autoConvert_v1_ChannelWeCom_To_notify_ChannelWeCom(in, out, s)
+}
+
+func autoConvert_notify_ChannelWeCom_To_v1_ChannelWeCom(in *notify.ChannelWeCom, out *ChannelWeCom, s conversion.Scope) error {
+	out.URL = in.URL
+	out.RateLimit = in.RateLimit
 	return nil
 }
 
-// Convert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS is an autogenerated conversion function.
-func Convert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in *notify.ChannelTencentCloudSMS, out *ChannelTencentCloudSMS, s conversion.Scope) error {
-	return autoConvert_notify_ChannelTencentCloudSMS_To_v1_ChannelTencentCloudSMS(in, out, s)
+// Convert_notify_ChannelWeCom_To_v1_ChannelWeCom is an autogenerated conversion function.
+func Convert_notify_ChannelWeCom_To_v1_ChannelWeCom(in *notify.ChannelWeCom, out *ChannelWeCom, s conversion.Scope) error {
+	return autoConvert_notify_ChannelWeCom_To_v1_ChannelWeCom(in, out, s)
 }
 
 func autoConvert_v1_ChannelWebhook_To_notify_ChannelWebhook(in *ChannelWebhook, out *notify.ChannelWebhook, s conversion.Scope) error {
@@ -588,6 +1176,214 @@ func Convert_notify_ConfigMapList_To_v1_ConfigMapList(in *notify.ConfigMapList,
 	return autoConvert_notify_ConfigMapList_To_v1_ConfigMapList(in, out, s)
 }
 
+func autoConvert_v1_Escalation_To_notify_Escalation(in *Escalation, out *notify.Escalation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EscalationSpec_To_notify_EscalationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_EscalationStatus_To_notify_EscalationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Escalation_To_notify_Escalation is an autogenerated conversion function.
+func Convert_v1_Escalation_To_notify_Escalation(in *Escalation, out *notify.Escalation, s conversion.Scope) error {
+	return autoConvert_v1_Escalation_To_notify_Escalation(in, out, s)
+}
+
+func autoConvert_notify_Escalation_To_v1_Escalation(in *notify.Escalation, out *Escalation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_EscalationSpec_To_v1_EscalationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_notify_EscalationStatus_To_v1_EscalationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_Escalation_To_v1_Escalation is an autogenerated conversion function.
+func Convert_notify_Escalation_To_v1_Escalation(in *notify.Escalation, out *Escalation, s conversion.Scope) error {
+	return autoConvert_notify_Escalation_To_v1_Escalation(in, out, s)
+}
+
+func autoConvert_v1_EscalationList_To_notify_EscalationList(in *EscalationList, out *notify.EscalationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.Escalation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_EscalationList_To_notify_EscalationList is an autogenerated conversion function.
+func Convert_v1_EscalationList_To_notify_EscalationList(in *EscalationList, out *notify.EscalationList, s conversion.Scope) error {
+	return autoConvert_v1_EscalationList_To_notify_EscalationList(in, out, s)
+}
+
+func autoConvert_notify_EscalationList_To_v1_EscalationList(in *notify.EscalationList, out *EscalationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Escalation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_EscalationList_To_v1_EscalationList is an autogenerated conversion function.
+func Convert_notify_EscalationList_To_v1_EscalationList(in *notify.EscalationList, out *EscalationList, s conversion.Scope) error {
+	return autoConvert_notify_EscalationList_To_v1_EscalationList(in, out, s)
+}
+
+func autoConvert_v1_EscalationPolicy_To_notify_EscalationPolicy(in *EscalationPolicy, out *notify.EscalationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_EscalationPolicy_To_notify_EscalationPolicy is an autogenerated conversion function.
+func Convert_v1_EscalationPolicy_To_notify_EscalationPolicy(in *EscalationPolicy, out *notify.EscalationPolicy, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicy_To_notify_EscalationPolicy(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicy_To_v1_EscalationPolicy(in *notify.EscalationPolicy, out *EscalationPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_EscalationPolicy_To_v1_EscalationPolicy is an autogenerated conversion function.
+func Convert_notify_EscalationPolicy_To_v1_EscalationPolicy(in *notify.EscalationPolicy, out *EscalationPolicy, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicy_To_v1_EscalationPolicy(in, out, s)
+}
+
+func autoConvert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in *EscalationPolicyList, out *notify.EscalationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.EscalationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList is an autogenerated conversion function.
+func Convert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in *EscalationPolicyList, out *notify.EscalationPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicyList_To_notify_EscalationPolicyList(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in *notify.EscalationPolicyList, out *EscalationPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]EscalationPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList is an autogenerated conversion function.
+func Convert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in *notify.EscalationPolicyList, out *EscalationPolicyList, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicyList_To_v1_EscalationPolicyList(in, out, s)
+}
+
+func autoConvert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in *EscalationPolicySpec, out *notify.EscalationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Steps = *(*[]notify.EscalationStep)(unsafe.Pointer(&in.Steps))
+	return nil
+}
+
+// Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec is an autogenerated conversion function.
+func Convert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in *EscalationPolicySpec, out *notify.EscalationPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_EscalationPolicySpec_To_notify_EscalationPolicySpec(in, out, s)
+}
+
+func autoConvert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in *notify.EscalationPolicySpec, out *EscalationPolicySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Steps = *(*[]EscalationStep)(unsafe.Pointer(&in.Steps))
+	return nil
+}
+
+// Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec is an autogenerated conversion function.
+func Convert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in *notify.EscalationPolicySpec, out *EscalationPolicySpec, s conversion.Scope) error {
+	return autoConvert_notify_EscalationPolicySpec_To_v1_EscalationPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_EscalationSpec_To_notify_EscalationSpec(in *EscalationSpec, out *notify.EscalationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.PolicyName = in.PolicyName
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
+	return nil
+}
+
+// Convert_v1_EscalationSpec_To_notify_EscalationSpec is an autogenerated conversion function.
+func Convert_v1_EscalationSpec_To_notify_EscalationSpec(in *EscalationSpec, out *notify.EscalationSpec, s conversion.Scope) error {
+	return autoConvert_v1_EscalationSpec_To_notify_EscalationSpec(in, out, s)
+}
+
+func autoConvert_notify_EscalationSpec_To_v1_EscalationSpec(in *notify.EscalationSpec, out *EscalationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.PolicyName = in.PolicyName
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
+	return nil
+}
+
+// Convert_notify_EscalationSpec_To_v1_EscalationSpec is an autogenerated conversion function.
+func Convert_notify_EscalationSpec_To_v1_EscalationSpec(in *notify.EscalationSpec, out *EscalationSpec, s conversion.Scope) error {
+	return autoConvert_notify_EscalationSpec_To_v1_EscalationSpec(in, out, s)
+}
+
+func autoConvert_v1_EscalationStatus_To_notify_EscalationStatus(in *EscalationStatus, out *notify.EscalationStatus, s conversion.Scope) error {
+	out.Phase = notify.EscalationPhase(in.Phase)
+	out.Step = in.Step
+	out.LastStepTime = in.LastStepTime
+	out.AcknowledgedBy = in.AcknowledgedBy
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_EscalationStatus_To_notify_EscalationStatus is an autogenerated conversion function.
+func Convert_v1_EscalationStatus_To_notify_EscalationStatus(in *EscalationStatus, out *notify.EscalationStatus, s conversion.Scope) error {
+	return autoConvert_v1_EscalationStatus_To_notify_EscalationStatus(in, out, s)
+}
+
+func autoConvert_notify_EscalationStatus_To_v1_EscalationStatus(in *notify.EscalationStatus, out *EscalationStatus, s conversion.Scope) error {
+	out.Phase = EscalationPhase(in.Phase)
+	out.Step = in.Step
+	out.LastStepTime = in.LastStepTime
+	out.AcknowledgedBy = in.AcknowledgedBy
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_notify_EscalationStatus_To_v1_EscalationStatus is an autogenerated conversion function.
+func Convert_notify_EscalationStatus_To_v1_EscalationStatus(in *notify.EscalationStatus, out *EscalationStatus, s conversion.Scope) error {
+	return autoConvert_notify_EscalationStatus_To_v1_EscalationStatus(in, out, s)
+}
+
+func autoConvert_v1_EscalationStep_To_notify_EscalationStep(in *EscalationStep, out *notify.EscalationStep, s conversion.Scope) error {
+	out.WaitSeconds = in.WaitSeconds
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	out.OnCallSchedules = *(*[]string)(unsafe.Pointer(&in.OnCallSchedules))
+	return nil
+}
+
+// Convert_v1_EscalationStep_To_notify_EscalationStep is an autogenerated conversion function.
+func Convert_v1_EscalationStep_To_notify_EscalationStep(in *EscalationStep, out *notify.EscalationStep, s conversion.Scope) error {
+	return autoConvert_v1_EscalationStep_To_notify_EscalationStep(in, out, s)
+}
+
+func autoConvert_notify_EscalationStep_To_v1_EscalationStep(in *notify.EscalationStep, out *EscalationStep, s conversion.Scope) error {
+	out.WaitSeconds = in.WaitSeconds
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	out.OnCallSchedules = *(*[]string)(unsafe.Pointer(&in.OnCallSchedules))
+	return nil
+}
+
+// Convert_notify_EscalationStep_To_v1_EscalationStep is an autogenerated conversion function.
+func Convert_notify_EscalationStep_To_v1_EscalationStep(in *notify.EscalationStep, out *EscalationStep, s conversion.Scope) error {
+	return autoConvert_notify_EscalationStep_To_v1_EscalationStep(in, out, s)
+}
+
 func autoConvert_v1_Message_To_notify_Message(in *Message, out *notify.Message, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_MessageSpec_To_notify_MessageSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -620,6 +1416,28 @@ func Convert_notify_Message_To_v1_Message(in *notify.Message, out *Message, s co
 	return autoConvert_notify_Message_To_v1_Message(in, out, s)
 }
 
+func autoConvert_v1_MessageDeliveryAttempt_To_notify_MessageDeliveryAttempt(in *MessageDeliveryAttempt, out *notify.MessageDeliveryAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_v1_MessageDeliveryAttempt_To_notify_MessageDeliveryAttempt is an autogenerated conversion function.
+func Convert_v1_MessageDeliveryAttempt_To_notify_MessageDeliveryAttempt(in *MessageDeliveryAttempt, out *notify.MessageDeliveryAttempt, s conversion.Scope) error {
+	return autoConvert_v1_MessageDeliveryAttempt_To_notify_MessageDeliveryAttempt(in, out, s)
+}
+
+func autoConvert_notify_MessageDeliveryAttempt_To_v1_MessageDeliveryAttempt(in *notify.MessageDeliveryAttempt, out *MessageDeliveryAttempt, s conversion.Scope) error {
+	out.Time = in.Time
+	out.Error = in.Error
+	return nil
+}
+
+// Convert_notify_MessageDeliveryAttempt_To_v1_MessageDeliveryAttempt is an autogenerated conversion function.
+func Convert_notify_MessageDeliveryAttempt_To_v1_MessageDeliveryAttempt(in *notify.MessageDeliveryAttempt, out *MessageDeliveryAttempt, s conversion.Scope) error {
+	return autoConvert_notify_MessageDeliveryAttempt_To_v1_MessageDeliveryAttempt(in, out, s)
+}
+
 func autoConvert_v1_MessageList_To_notify_MessageList(in *MessageList, out *notify.MessageList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]notify.Message)(unsafe.Pointer(&in.Items))
@@ -761,6 +1579,8 @@ func autoConvert_v1_MessageSpec_To_notify_MessageSpec(in *MessageSpec, out *noti
 	out.AlarmPolicyType = in.AlarmPolicyType
 	out.ReceiverChannelName = in.ReceiverChannelName
 	out.ClusterID = in.ClusterID
+	out.TemplateName = in.TemplateName
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
 	return nil
 }
 
@@ -782,6 +1602,8 @@ func autoConvert_notify_MessageSpec_To_v1_MessageSpec(in *notify.MessageSpec, ou
 	out.AlarmPolicyType = in.AlarmPolicyType
 	out.ReceiverChannelName = in.ReceiverChannelName
 	out.ClusterID = in.ClusterID
+	out.TemplateName = in.TemplateName
+	out.Variables = *(*map[string]string)(unsafe.Pointer(&in.Variables))
 	return nil
 }
 
@@ -793,6 +1615,10 @@ func Convert_notify_MessageSpec_To_v1_MessageSpec(in *notify.MessageSpec, out *M
 func autoConvert_v1_MessageStatus_To_notify_MessageStatus(in *MessageStatus, out *notify.MessageStatus, s conversion.Scope) error {
 	out.Phase = notify.MessagePhase(in.Phase)
 	out.LastTransitionTime = in.LastTransitionTime
+	out.DeliveryPhase = notify.MessageDeliveryPhase(in.DeliveryPhase)
+	out.RetryCount = in.RetryCount
+	out.NextAttemptTime = in.NextAttemptTime
+	out.Attempts = *(*[]notify.MessageDeliveryAttempt)(unsafe.Pointer(&in.Attempts))
 	return nil
 }
 
@@ -804,6 +1630,10 @@ func Convert_v1_MessageStatus_To_notify_MessageStatus(in *MessageStatus, out *no
 func autoConvert_notify_MessageStatus_To_v1_MessageStatus(in *notify.MessageStatus, out *MessageStatus, s conversion.Scope) error {
 	out.Phase = MessagePhase(in.Phase)
 	out.LastTransitionTime = in.LastTransitionTime
+	out.DeliveryPhase = MessageDeliveryPhase(in.DeliveryPhase)
+	out.RetryCount = in.RetryCount
+	out.NextAttemptTime = in.NextAttemptTime
+	out.Attempts = *(*[]MessageDeliveryAttempt)(unsafe.Pointer(&in.Attempts))
 	return nil
 }
 
@@ -812,6 +1642,82 @@ func Convert_notify_MessageStatus_To_v1_MessageStatus(in *notify.MessageStatus,
 	return autoConvert_notify_MessageStatus_To_v1_MessageStatus(in, out, s)
 }
 
+func autoConvert_v1_OnCallSchedule_To_notify_OnCallSchedule(in *OnCallSchedule, out *notify.OnCallSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_OnCallSchedule_To_notify_OnCallSchedule is an autogenerated conversion function.
+func Convert_v1_OnCallSchedule_To_notify_OnCallSchedule(in *OnCallSchedule, out *notify.OnCallSchedule, s conversion.Scope) error {
+	return autoConvert_v1_OnCallSchedule_To_notify_OnCallSchedule(in, out, s)
+}
+
+func autoConvert_notify_OnCallSchedule_To_v1_OnCallSchedule(in *notify.OnCallSchedule, out *OnCallSchedule, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_notify_OnCallSchedule_To_v1_OnCallSchedule is an autogenerated conversion function.
+func Convert_notify_OnCallSchedule_To_v1_OnCallSchedule(in *notify.OnCallSchedule, out *OnCallSchedule, s conversion.Scope) error {
+	return autoConvert_notify_OnCallSchedule_To_v1_OnCallSchedule(in, out, s)
+}
+
+func autoConvert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in *OnCallScheduleList, out *notify.OnCallScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]notify.OnCallSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList is an autogenerated conversion function.
+func Convert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in *OnCallScheduleList, out *notify.OnCallScheduleList, s conversion.Scope) error {
+	return autoConvert_v1_OnCallScheduleList_To_notify_OnCallScheduleList(in, out, s)
+}
+
+func autoConvert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in *notify.OnCallScheduleList, out *OnCallScheduleList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]OnCallSchedule)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList is an autogenerated conversion function.
+func Convert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in *notify.OnCallScheduleList, out *OnCallScheduleList, s conversion.Scope) error {
+	return autoConvert_notify_OnCallScheduleList_To_v1_OnCallScheduleList(in, out, s)
+}
+
+func autoConvert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in *OnCallScheduleSpec, out *notify.OnCallScheduleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.ReceiverGroup = in.ReceiverGroup
+	out.StartTime = in.StartTime
+	out.ShiftSeconds = in.ShiftSeconds
+	return nil
+}
+
+// Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec is an autogenerated conversion function.
+func Convert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in *OnCallScheduleSpec, out *notify.OnCallScheduleSpec, s conversion.Scope) error {
+	return autoConvert_v1_OnCallScheduleSpec_To_notify_OnCallScheduleSpec(in, out, s)
+}
+
+func autoConvert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in *notify.OnCallScheduleSpec, out *OnCallScheduleSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.ReceiverGroup = in.ReceiverGroup
+	out.StartTime = in.StartTime
+	out.ShiftSeconds = in.ShiftSeconds
+	return nil
+}
+
+// Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec is an autogenerated conversion function.
+func Convert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in *notify.OnCallScheduleSpec, out *OnCallScheduleSpec, s conversion.Scope) error {
+	return autoConvert_notify_OnCallScheduleSpec_To_v1_OnCallScheduleSpec(in, out, s)
+}
+
 func autoConvert_v1_Receiver_To_notify_Receiver(in *Receiver, out *notify.Receiver, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_ReceiverSpec_To_notify_ReceiverSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -890,6 +1796,7 @@ func autoConvert_v1_ReceiverGroupSpec_To_notify_ReceiverGroupSpec(in *ReceiverGr
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.AuthGroup = in.AuthGroup
 	return nil
 }
 
@@ -902,6 +1809,7 @@ func autoConvert_notify_ReceiverGroupSpec_To_v1_ReceiverGroupSpec(in *notify.Rec
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
 	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.AuthGroup = in.AuthGroup
 	return nil
 }
 
@@ -937,6 +1845,8 @@ func autoConvert_v1_ReceiverSpec_To_notify_ReceiverSpec(in *ReceiverSpec, out *n
 	out.DisplayName = in.DisplayName
 	out.Username = in.Username
 	out.Identities = *(*map[notify.ReceiverChannel]string)(unsafe.Pointer(&in.Identities))
+	out.Locale = in.Locale
+	out.AuthUser = in.AuthUser
 	return nil
 }
 
@@ -950,6 +1860,8 @@ func autoConvert_notify_ReceiverSpec_To_v1_ReceiverSpec(in *notify.ReceiverSpec,
 	out.DisplayName = in.DisplayName
 	out.Username = in.Username
 	out.Identities = *(*map[ReceiverChannel]string)(unsafe.Pointer(&in.Identities))
+	out.Locale = in.Locale
+	out.AuthUser = in.AuthUser
 	return nil
 }
 
@@ -984,6 +1896,52 @@ func Convert_notify_Template_To_v1_Template(in *notify.Template, out *Template,
 	return autoConvert_notify_Template_To_v1_Template(in, out, s)
 }
 
+func autoConvert_v1_TemplateDingTalk_To_notify_TemplateDingTalk(in *TemplateDingTalk, out *notify.TemplateDingTalk, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1_TemplateDingTalk_To_notify_TemplateDingTalk is an autogenerated conversion function.
+func Convert_v1_TemplateDingTalk_To_notify_TemplateDingTalk(in *TemplateDingTalk, out *notify.TemplateDingTalk, s conversion.Scope) error {
+	return autoConvert_v1_TemplateDingTalk_To_notify_TemplateDingTalk(in, out, s)
+}
+
+func autoConvert_notify_TemplateDingTalk_To_v1_TemplateDingTalk(in *notify.TemplateDingTalk, out *TemplateDingTalk, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_notify_TemplateDingTalk_To_v1_TemplateDingTalk is an autogenerated conversion function.
+func Convert_notify_TemplateDingTalk_To_v1_TemplateDingTalk(in *notify.TemplateDingTalk, out *TemplateDingTalk, s conversion.Scope) error {
+	return autoConvert_notify_TemplateDingTalk_To_v1_TemplateDingTalk(in, out, s)
+}
+
+func autoConvert_v1_TemplateLark_To_notify_TemplateLark(in *TemplateLark, out *notify.TemplateLark, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Content = in.Content
+	out.Color = in.Color
+	return nil
+}
+
+// Convert_v1_TemplateLark_To_notify_TemplateLark is an autogenerated conversion function.
+func Convert_v1_TemplateLark_To_notify_TemplateLark(in *TemplateLark, out *notify.TemplateLark, s conversion.Scope) error {
+	return autoConvert_v1_TemplateLark_To_notify_TemplateLark(in, out, s)
+}
+
+func autoConvert_notify_TemplateLark_To_v1_TemplateLark(in *notify.TemplateLark, out *TemplateLark, s conversion.Scope) error {
+	out.Title = in.Title
+	out.Content = in.Content
+	out.Color = in.Color
+	return nil
+}
+
+// Convert_notify_TemplateLark_To_v1_TemplateLark is an autogenerated conversion function.
+func Convert_notify_TemplateLark_To_v1_TemplateLark(in *notify.TemplateLark, out *TemplateLark, s conversion.Scope) error {
+	return autoConvert_notify_TemplateLark_To_v1_TemplateLark(in, out, s)
+}
+
 func autoConvert_v1_TemplateList_To_notify_TemplateList(in *TemplateList, out *notify.TemplateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]notify.Template)(unsafe.Pointer(&in.Items))
@@ -1006,6 +1964,78 @@ func Convert_notify_TemplateList_To_v1_TemplateList(in *notify.TemplateList, out
 	return autoConvert_notify_TemplateList_To_v1_TemplateList(in, out, s)
 }
 
+func autoConvert_v1_TemplateLocalization_To_notify_TemplateLocalization(in *TemplateLocalization, out *notify.TemplateLocalization, s conversion.Scope) error {
+	out.Locale = in.Locale
+	out.Header = in.Header
+	out.Body = in.Body
+	out.Title = in.Title
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1_TemplateLocalization_To_notify_TemplateLocalization is an autogenerated conversion function.
+func Convert_v1_TemplateLocalization_To_notify_TemplateLocalization(in *TemplateLocalization, out *notify.TemplateLocalization, s conversion.Scope) error {
+	return autoConvert_v1_TemplateLocalization_To_notify_TemplateLocalization(in, out, s)
+}
+
+func autoConvert_notify_TemplateLocalization_To_v1_TemplateLocalization(in *notify.TemplateLocalization, out *TemplateLocalization, s conversion.Scope) error {
+	out.Locale = in.Locale
+	out.Header = in.Header
+	out.Body = in.Body
+	out.Title = in.Title
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_notify_TemplateLocalization_To_v1_TemplateLocalization is an autogenerated conversion function.
+func Convert_notify_TemplateLocalization_To_v1_TemplateLocalization(in *notify.TemplateLocalization, out *TemplateLocalization, s conversion.Scope) error {
+	return autoConvert_notify_TemplateLocalization_To_v1_TemplateLocalization(in, out, s)
+}
+
+func autoConvert_v1_TemplateOpsgenie_To_notify_TemplateOpsgenie(in *TemplateOpsgenie, out *notify.TemplateOpsgenie, s conversion.Scope) error {
+	out.Message = in.Message
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1_TemplateOpsgenie_To_notify_TemplateOpsgenie is an autogenerated conversion function.
+func Convert_v1_TemplateOpsgenie_To_notify_TemplateOpsgenie(in *TemplateOpsgenie, out *notify.TemplateOpsgenie, s conversion.Scope) error {
+	return autoConvert_v1_TemplateOpsgenie_To_notify_TemplateOpsgenie(in, out, s)
+}
+
+func autoConvert_notify_TemplateOpsgenie_To_v1_TemplateOpsgenie(in *notify.TemplateOpsgenie, out *TemplateOpsgenie, s conversion.Scope) error {
+	out.Message = in.Message
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_notify_TemplateOpsgenie_To_v1_TemplateOpsgenie is an autogenerated conversion function.
+func Convert_notify_TemplateOpsgenie_To_v1_TemplateOpsgenie(in *notify.TemplateOpsgenie, out *TemplateOpsgenie, s conversion.Scope) error {
+	return autoConvert_notify_TemplateOpsgenie_To_v1_TemplateOpsgenie(in, out, s)
+}
+
+func autoConvert_v1_TemplatePagerDuty_To_notify_TemplatePagerDuty(in *TemplatePagerDuty, out *notify.TemplatePagerDuty, s conversion.Scope) error {
+	out.Summary = in.Summary
+	out.Source = in.Source
+	return nil
+}
+
+// Convert_v1_TemplatePagerDuty_To_notify_TemplatePagerDuty is an autogenerated conversion function.
+func Convert_v1_TemplatePagerDuty_To_notify_TemplatePagerDuty(in *TemplatePagerDuty, out *notify.TemplatePagerDuty, s conversion.Scope) error {
+	return autoConvert_v1_TemplatePagerDuty_To_notify_TemplatePagerDuty(in, out, s)
+}
+
+func autoConvert_notify_TemplatePagerDuty_To_v1_TemplatePagerDuty(in *notify.TemplatePagerDuty, out *TemplatePagerDuty, s conversion.Scope) error {
+	out.Summary = in.Summary
+	out.Source = in.Source
+	return nil
+}
+
+// Convert_notify_TemplatePagerDuty_To_v1_TemplatePagerDuty is an autogenerated conversion function.
+func Convert_notify_TemplatePagerDuty_To_v1_TemplatePagerDuty(in *notify.TemplatePagerDuty, out *TemplatePagerDuty, s conversion.Scope) error {
+	return autoConvert_notify_TemplatePagerDuty_To_v1_TemplatePagerDuty(in, out, s)
+}
+
 func autoConvert_v1_TemplateSpec_To_notify_TemplateSpec(in *TemplateSpec, out *notify.TemplateSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.DisplayName = in.DisplayName
@@ -1013,6 +2043,13 @@ func autoConvert_v1_TemplateSpec_To_notify_TemplateSpec(in *TemplateSpec, out *n
 	out.TencentCloudSMS = (*notify.TemplateTencentCloudSMS)(unsafe.Pointer(in.TencentCloudSMS))
 	out.Wechat = (*notify.TemplateWechat)(unsafe.Pointer(in.Wechat))
 	out.Text = (*notify.TemplateText)(unsafe.Pointer(in.Text))
+	out.WeCom = (*notify.TemplateWeCom)(unsafe.Pointer(in.WeCom))
+	out.DingTalk = (*notify.TemplateDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.Lark = (*notify.TemplateLark)(unsafe.Pointer(in.Lark))
+	out.Localizations = *(*[]notify.TemplateLocalization)(unsafe.Pointer(&in.Localizations))
+	out.PagerDuty = (*notify.TemplatePagerDuty)(unsafe.Pointer(in.PagerDuty))
+	out.Opsgenie = (*notify.TemplateOpsgenie)(unsafe.Pointer(in.Opsgenie))
+	out.Voice = (*notify.TemplateVoice)(unsafe.Pointer(in.Voice))
 	return nil
 }
 
@@ -1028,6 +2065,13 @@ func autoConvert_notify_TemplateSpec_To_v1_TemplateSpec(in *notify.TemplateSpec,
 	out.TencentCloudSMS = (*TemplateTencentCloudSMS)(unsafe.Pointer(in.TencentCloudSMS))
 	out.Wechat = (*TemplateWechat)(unsafe.Pointer(in.Wechat))
 	out.Text = (*TemplateText)(unsafe.Pointer(in.Text))
+	out.WeCom = (*TemplateWeCom)(unsafe.Pointer(in.WeCom))
+	out.DingTalk = (*TemplateDingTalk)(unsafe.Pointer(in.DingTalk))
+	out.Lark = (*TemplateLark)(unsafe.Pointer(in.Lark))
+	out.Localizations = *(*[]TemplateLocalization)(unsafe.Pointer(&in.Localizations))
+	out.PagerDuty = (*TemplatePagerDuty)(unsafe.Pointer(in.PagerDuty))
+	out.Opsgenie = (*TemplateOpsgenie)(unsafe.Pointer(in.Opsgenie))
+	out.Voice = (*TemplateVoice)(unsafe.Pointer(in.Voice))
 	return nil
 }
 
@@ -1082,6 +2126,48 @@ func Convert_notify_TemplateText_To_v1_TemplateText(in *notify.TemplateText, out
 	return autoConvert_notify_TemplateText_To_v1_TemplateText(in, out, s)
 }
 
+func autoConvert_v1_TemplateVoice_To_notify_TemplateVoice(in *TemplateVoice, out *notify.TemplateVoice, s conversion.Scope) error {
+	out.TemplateID = in.TemplateID
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1_TemplateVoice_To_notify_TemplateVoice is an autogenerated conversion function.
+func Convert_v1_TemplateVoice_To_notify_TemplateVoice(in *TemplateVoice, out *notify.TemplateVoice, s conversion.Scope) error {
+	return autoConvert_v1_TemplateVoice_To_notify_TemplateVoice(in, out, s)
+}
+
+func autoConvert_notify_TemplateVoice_To_v1_TemplateVoice(in *notify.TemplateVoice, out *TemplateVoice, s conversion.Scope) error {
+	out.TemplateID = in.TemplateID
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_notify_TemplateVoice_To_v1_TemplateVoice is an autogenerated conversion function.
+func Convert_notify_TemplateVoice_To_v1_TemplateVoice(in *notify.TemplateVoice, out *TemplateVoice, s conversion.Scope) error {
+	return autoConvert_notify_TemplateVoice_To_v1_TemplateVoice(in, out, s)
+}
+
+func autoConvert_v1_TemplateWeCom_To_notify_TemplateWeCom(in *TemplateWeCom, out *notify.TemplateWeCom, s conversion.Scope) error {
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1_TemplateWeCom_To_notify_TemplateWeCom is an autogenerated conversion function.
+func Convert_v1_TemplateWeCom_To_notify_TemplateWeCom(in *TemplateWeCom, out *notify.TemplateWeCom, s conversion.Scope) error {
+	return autoConvert_v1_TemplateWeCom_To_notify_TemplateWeCom(in, out, s)
+}
+
+func autoConvert_notify_TemplateWeCom_To_v1_TemplateWeCom(in *notify.TemplateWeCom, out *TemplateWeCom, s conversion.Scope) error {
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_notify_TemplateWeCom_To_v1_TemplateWeCom is an autogenerated conversion function.
+func Convert_notify_TemplateWeCom_To_v1_TemplateWeCom(in *notify.TemplateWeCom, out *TemplateWeCom, s conversion.Scope) error {
+	return autoConvert_notify_TemplateWeCom_To_v1_TemplateWeCom(in, out, s)
+}
+
 func autoConvert_v1_TemplateWechat_To_notify_TemplateWechat(in *TemplateWechat, out *notify.TemplateWechat, s conversion.Scope) error {
 	out.TemplateID = in.TemplateID
 	out.URL = in.URL
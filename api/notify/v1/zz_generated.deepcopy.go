@@ -32,7 +32,7 @@ func (in *Channel) DeepCopyInto(out *Channel) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -54,6 +54,59 @@ func (in *Channel) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelAggregation) DeepCopyInto(out *ChannelAggregation) {
+	*out = *in
+	if in.DedupKeys != nil {
+		in, out := &in.DedupKeys, &out.DedupKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelAggregation.
+func (in *ChannelAggregation) DeepCopy() *ChannelAggregation {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelAggregation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelDingTalk) DeepCopyInto(out *ChannelDingTalk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelDingTalk.
+func (in *ChannelDingTalk) DeepCopy() *ChannelDingTalk {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelDingTalk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelLark) DeepCopyInto(out *ChannelLark) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelLark.
+func (in *ChannelLark) DeepCopy() *ChannelLark {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelLark)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelList) DeepCopyInto(out *ChannelList) {
 	*out = *in
@@ -87,6 +140,84 @@ func (in *ChannelList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelOpsgenie) DeepCopyInto(out *ChannelOpsgenie) {
+	*out = *in
+	if in.Priorities != nil {
+		in, out := &in.Priorities, &out.Priorities
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelOpsgenie.
+func (in *ChannelOpsgenie) DeepCopy() *ChannelOpsgenie {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelOpsgenie)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelPagerDuty) DeepCopyInto(out *ChannelPagerDuty) {
+	*out = *in
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelPagerDuty.
+func (in *ChannelPagerDuty) DeepCopy() *ChannelPagerDuty {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelPagerDuty)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelQuota) DeepCopyInto(out *ChannelQuota) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelQuota.
+func (in *ChannelQuota) DeepCopy() *ChannelQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelQuotaUsage) DeepCopyInto(out *ChannelQuotaUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelQuotaUsage.
+func (in *ChannelQuotaUsage) DeepCopy() *ChannelQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelSMTP) DeepCopyInto(out *ChannelSMTP) {
 	*out = *in
@@ -131,6 +262,46 @@ func (in *ChannelSpec) DeepCopyInto(out *ChannelSpec) {
 		*out = new(ChannelWebhook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WeCom != nil {
+		in, out := &in.WeCom, &out.WeCom
+		*out = new(ChannelWeCom)
+		**out = **in
+	}
+	if in.DingTalk != nil {
+		in, out := &in.DingTalk, &out.DingTalk
+		*out = new(ChannelDingTalk)
+		**out = **in
+	}
+	if in.Lark != nil {
+		in, out := &in.Lark, &out.Lark
+		*out = new(ChannelLark)
+		**out = **in
+	}
+	if in.Aggregation != nil {
+		in, out := &in.Aggregation, &out.Aggregation
+		*out = new(ChannelAggregation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(ChannelPagerDuty)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Opsgenie != nil {
+		in, out := &in.Opsgenie, &out.Opsgenie
+		*out = new(ChannelOpsgenie)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Quota != nil {
+		in, out := &in.Quota, &out.Quota
+		*out = new(ChannelQuota)
+		**out = **in
+	}
+	if in.Voice != nil {
+		in, out := &in.Voice, &out.Voice
+		*out = new(ChannelVoice)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -147,6 +318,12 @@ func (in *ChannelSpec) DeepCopy() *ChannelSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelStatus) DeepCopyInto(out *ChannelStatus) {
 	*out = *in
+	if in.QuotaUsages != nil {
+		in, out := &in.QuotaUsages, &out.QuotaUsages
+		*out = make([]ChannelQuotaUsage, len(*in))
+		copy(*out, *in)
+	}
+	in.LastQuotaUpdateTime.DeepCopyInto(&out.LastQuotaUpdateTime)
 	return
 }
 
@@ -176,6 +353,92 @@ func (in *ChannelTencentCloudSMS) DeepCopy() *ChannelTencentCloudSMS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelTencentCloudVMS) DeepCopyInto(out *ChannelTencentCloudVMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelTencentCloudVMS.
+func (in *ChannelTencentCloudVMS) DeepCopy() *ChannelTencentCloudVMS {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelTencentCloudVMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelVoice) DeepCopyInto(out *ChannelVoice) {
+	*out = *in
+	if in.TencentCloudVMS != nil {
+		in, out := &in.TencentCloudVMS, &out.TencentCloudVMS
+		*out = new(ChannelTencentCloudVMS)
+		**out = **in
+	}
+	if in.API != nil {
+		in, out := &in.API, &out.API
+		*out = new(ChannelVoiceAPI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelVoice.
+func (in *ChannelVoice) DeepCopy() *ChannelVoice {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelVoice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelVoiceAPI) DeepCopyInto(out *ChannelVoiceAPI) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelVoiceAPI.
+func (in *ChannelVoiceAPI) DeepCopy() *ChannelVoiceAPI {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelVoiceAPI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChannelWeCom) DeepCopyInto(out *ChannelWeCom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChannelWeCom.
+func (in *ChannelWeCom) DeepCopy() *ChannelWeCom {
+	if in == nil {
+		return nil
+	}
+	out := new(ChannelWeCom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChannelWebhook) DeepCopyInto(out *ChannelWebhook) {
 	*out = *in
@@ -210,98 +473,314 @@ func (in *ChannelWechat) DeepCopy() *ChannelWechat {
 	if in == nil {
 		return nil
 	}
-	out := new(ChannelWechat)
+	out := new(ChannelWechat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BinaryData != nil {
+		in, out := &in.BinaryData, &out.BinaryData
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
+func (in *ConfigMap) DeepCopy() *ConfigMap {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMap) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ConfigMap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
+func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ConfigMapList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Escalation) DeepCopyInto(out *Escalation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Escalation.
+func (in *Escalation) DeepCopy() *Escalation {
+	if in == nil {
+		return nil
+	}
+	out := new(Escalation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Escalation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationList) DeepCopyInto(out *EscalationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Escalation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationList.
+func (in *EscalationList) DeepCopy() *EscalationList {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EscalationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicy) DeepCopyInto(out *EscalationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicy.
+func (in *EscalationPolicy) DeepCopy() *EscalationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EscalationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicyList) DeepCopyInto(out *EscalationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EscalationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicyList.
+func (in *EscalationPolicyList) DeepCopy() *EscalationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EscalationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationPolicySpec) DeepCopyInto(out *EscalationPolicySpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]EscalationStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationPolicySpec.
+func (in *EscalationPolicySpec) DeepCopy() *EscalationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EscalationPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMap) DeepCopyInto(out *ConfigMap) {
+func (in *EscalationSpec) DeepCopyInto(out *EscalationSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	if in.BinaryData != nil {
-		in, out := &in.BinaryData, &out.BinaryData
-		*out = make(map[string][]byte, len(*in))
-		for key, val := range *in {
-			var outVal []byte
-			if val == nil {
-				(*out)[key] = nil
-			} else {
-				in, out := &val, &outVal
-				*out = make([]byte, len(*in))
-				copy(*out, *in)
-			}
-			(*out)[key] = outVal
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMap.
-func (in *ConfigMap) DeepCopy() *ConfigMap {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationSpec.
+func (in *EscalationSpec) DeepCopy() *EscalationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMap)
+	out := new(EscalationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMap) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EscalationStatus) DeepCopyInto(out *EscalationStatus) {
+	*out = *in
+	in.LastStepTime.DeepCopyInto(&out.LastStepTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationStatus.
+func (in *EscalationStatus) DeepCopy() *EscalationStatus {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(EscalationStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConfigMapList) DeepCopyInto(out *ConfigMapList) {
+func (in *EscalationStep) DeepCopyInto(out *EscalationStep) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ConfigMap, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OnCallSchedules != nil {
+		in, out := &in.OnCallSchedules, &out.OnCallSchedules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapList.
-func (in *ConfigMapList) DeepCopy() *ConfigMapList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EscalationStep.
+func (in *EscalationStep) DeepCopy() *EscalationStep {
 	if in == nil {
 		return nil
 	}
-	out := new(ConfigMapList)
+	out := new(EscalationStep)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ConfigMapList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Message) DeepCopyInto(out *Message) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -324,6 +803,23 @@ func (in *Message) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MessageDeliveryAttempt) DeepCopyInto(out *MessageDeliveryAttempt) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MessageDeliveryAttempt.
+func (in *MessageDeliveryAttempt) DeepCopy() *MessageDeliveryAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(MessageDeliveryAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MessageList) DeepCopyInto(out *MessageList) {
 	*out = *in
@@ -478,6 +974,13 @@ func (in *MessageRequestStatus) DeepCopy() *MessageRequestStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MessageSpec) DeepCopyInto(out *MessageSpec) {
 	*out = *in
+	if in.Variables != nil {
+		in, out := &in.Variables, &out.Variables
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
@@ -495,6 +998,14 @@ func (in *MessageSpec) DeepCopy() *MessageSpec {
 func (in *MessageStatus) DeepCopyInto(out *MessageStatus) {
 	*out = *in
 	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	in.NextAttemptTime.DeepCopyInto(&out.NextAttemptTime)
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]MessageDeliveryAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -508,6 +1019,83 @@ func (in *MessageStatus) DeepCopy() *MessageStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallSchedule) DeepCopyInto(out *OnCallSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallSchedule.
+func (in *OnCallSchedule) DeepCopy() *OnCallSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleList) DeepCopyInto(out *OnCallScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OnCallSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleList.
+func (in *OnCallScheduleList) DeepCopy() *OnCallScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OnCallScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OnCallScheduleSpec) DeepCopyInto(out *OnCallScheduleSpec) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OnCallScheduleSpec.
+func (in *OnCallScheduleSpec) DeepCopy() *OnCallScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OnCallScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Receiver) DeepCopyInto(out *Receiver) {
 	*out = *in
@@ -699,6 +1287,38 @@ func (in *Template) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateDingTalk) DeepCopyInto(out *TemplateDingTalk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateDingTalk.
+func (in *TemplateDingTalk) DeepCopy() *TemplateDingTalk {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateDingTalk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLark) DeepCopyInto(out *TemplateLark) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLark.
+func (in *TemplateLark) DeepCopy() *TemplateLark {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLark)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateList) DeepCopyInto(out *TemplateList) {
 	*out = *in
@@ -732,6 +1352,54 @@ func (in *TemplateList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateLocalization) DeepCopyInto(out *TemplateLocalization) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateLocalization.
+func (in *TemplateLocalization) DeepCopy() *TemplateLocalization {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateLocalization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateOpsgenie) DeepCopyInto(out *TemplateOpsgenie) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateOpsgenie.
+func (in *TemplateOpsgenie) DeepCopy() *TemplateOpsgenie {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateOpsgenie)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplatePagerDuty) DeepCopyInto(out *TemplatePagerDuty) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplatePagerDuty.
+func (in *TemplatePagerDuty) DeepCopy() *TemplatePagerDuty {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplatePagerDuty)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 	*out = *in
@@ -755,6 +1423,41 @@ func (in *TemplateSpec) DeepCopyInto(out *TemplateSpec) {
 		*out = new(TemplateText)
 		**out = **in
 	}
+	if in.WeCom != nil {
+		in, out := &in.WeCom, &out.WeCom
+		*out = new(TemplateWeCom)
+		**out = **in
+	}
+	if in.DingTalk != nil {
+		in, out := &in.DingTalk, &out.DingTalk
+		*out = new(TemplateDingTalk)
+		**out = **in
+	}
+	if in.Lark != nil {
+		in, out := &in.Lark, &out.Lark
+		*out = new(TemplateLark)
+		**out = **in
+	}
+	if in.Localizations != nil {
+		in, out := &in.Localizations, &out.Localizations
+		*out = make([]TemplateLocalization, len(*in))
+		copy(*out, *in)
+	}
+	if in.PagerDuty != nil {
+		in, out := &in.PagerDuty, &out.PagerDuty
+		*out = new(TemplatePagerDuty)
+		**out = **in
+	}
+	if in.Opsgenie != nil {
+		in, out := &in.Opsgenie, &out.Opsgenie
+		*out = new(TemplateOpsgenie)
+		**out = **in
+	}
+	if in.Voice != nil {
+		in, out := &in.Voice, &out.Voice
+		*out = new(TemplateVoice)
+		**out = **in
+	}
 	return
 }
 
@@ -800,6 +1503,38 @@ func (in *TemplateText) DeepCopy() *TemplateText {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateVoice) DeepCopyInto(out *TemplateVoice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateVoice.
+func (in *TemplateVoice) DeepCopy() *TemplateVoice {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateVoice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TemplateWeCom) DeepCopyInto(out *TemplateWeCom) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TemplateWeCom.
+func (in *TemplateWeCom) DeepCopy() *TemplateWeCom {
+	if in == nil {
+		return nil
+	}
+	out := new(TemplateWeCom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateWechat) DeepCopyInto(out *TemplateWechat) {
 	*out = *in
@@ -34,10 +34,14 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ChannelList{}, func(obj interface{}) { SetObjectDefaults_ChannelList(obj.(*ChannelList)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMap{}, func(obj interface{}) { SetObjectDefaults_ConfigMap(obj.(*ConfigMap)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
+	scheme.AddTypeDefaultingFunc(&Escalation{}, func(obj interface{}) { SetObjectDefaults_Escalation(obj.(*Escalation)) })
+	scheme.AddTypeDefaultingFunc(&EscalationList{}, func(obj interface{}) { SetObjectDefaults_EscalationList(obj.(*EscalationList)) })
 	scheme.AddTypeDefaultingFunc(&Message{}, func(obj interface{}) { SetObjectDefaults_Message(obj.(*Message)) })
 	scheme.AddTypeDefaultingFunc(&MessageList{}, func(obj interface{}) { SetObjectDefaults_MessageList(obj.(*MessageList)) })
 	scheme.AddTypeDefaultingFunc(&MessageRequest{}, func(obj interface{}) { SetObjectDefaults_MessageRequest(obj.(*MessageRequest)) })
 	scheme.AddTypeDefaultingFunc(&MessageRequestList{}, func(obj interface{}) { SetObjectDefaults_MessageRequestList(obj.(*MessageRequestList)) })
+	scheme.AddTypeDefaultingFunc(&OnCallSchedule{}, func(obj interface{}) { SetObjectDefaults_OnCallSchedule(obj.(*OnCallSchedule)) })
+	scheme.AddTypeDefaultingFunc(&OnCallScheduleList{}, func(obj interface{}) { SetObjectDefaults_OnCallScheduleList(obj.(*OnCallScheduleList)) })
 	scheme.AddTypeDefaultingFunc(&Receiver{}, func(obj interface{}) { SetObjectDefaults_Receiver(obj.(*Receiver)) })
 	scheme.AddTypeDefaultingFunc(&ReceiverGroup{}, func(obj interface{}) { SetObjectDefaults_ReceiverGroup(obj.(*ReceiverGroup)) })
 	scheme.AddTypeDefaultingFunc(&ReceiverGroupList{}, func(obj interface{}) { SetObjectDefaults_ReceiverGroupList(obj.(*ReceiverGroupList)) })
@@ -48,6 +52,30 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 }
 
 func SetObjectDefaults_Channel(in *Channel) {
+	if in.Spec.WeCom != nil {
+		SetDefaults_ChannelWeCom(in.Spec.WeCom)
+	}
+	if in.Spec.DingTalk != nil {
+		SetDefaults_ChannelDingTalk(in.Spec.DingTalk)
+	}
+	if in.Spec.Lark != nil {
+		SetDefaults_ChannelLark(in.Spec.Lark)
+	}
+	if in.Spec.Aggregation != nil {
+		SetDefaults_ChannelAggregation(in.Spec.Aggregation)
+	}
+	if in.Spec.PagerDuty != nil {
+		SetDefaults_ChannelPagerDuty(in.Spec.PagerDuty)
+	}
+	if in.Spec.Opsgenie != nil {
+		SetDefaults_ChannelOpsgenie(in.Spec.Opsgenie)
+	}
+	if in.Spec.Voice != nil {
+		SetDefaults_ChannelVoice(in.Spec.Voice)
+		if in.Spec.Voice.TencentCloudVMS != nil {
+			SetDefaults_ChannelTencentCloudVMS(in.Spec.Voice.TencentCloudVMS)
+		}
+	}
 	SetDefaults_ChannelStatus(&in.Status)
 }
 
@@ -69,6 +97,18 @@ func SetObjectDefaults_ConfigMapList(in *ConfigMapList) {
 	}
 }
 
+func SetObjectDefaults_Escalation(in *Escalation) {
+	SetDefaults_EscalationSpec(&in.Spec)
+	SetDefaults_EscalationStatus(&in.Status)
+}
+
+func SetObjectDefaults_EscalationList(in *EscalationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Escalation(a)
+	}
+}
+
 func SetObjectDefaults_Message(in *Message) {
 	SetDefaults_MessageStatus(&in.Status)
 }
@@ -92,6 +132,17 @@ func SetObjectDefaults_MessageRequestList(in *MessageRequestList) {
 	}
 }
 
+func SetObjectDefaults_OnCallSchedule(in *OnCallSchedule) {
+	SetDefaults_OnCallScheduleSpec(&in.Spec)
+}
+
+func SetObjectDefaults_OnCallScheduleList(in *OnCallScheduleList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_OnCallSchedule(a)
+	}
+}
+
 func SetObjectDefaults_Receiver(in *Receiver) {
 	SetDefaults_ReceiverSpec(&in.Spec)
 }
@@ -802,6 +802,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/auth/v1.Client":                                          schema_tke_api_auth_v1_Client(ref),
 		"tkestack.io/tke/api/auth/v1.ClientList":                                      schema_tke_api_auth_v1_ClientList(ref),
 		"tkestack.io/tke/api/auth/v1.ClientSpec":                                      schema_tke_api_auth_v1_ClientSpec(ref),
+		"tkestack.io/tke/api/auth/v1.Condition":                                       schema_tke_api_auth_v1_Condition(ref),
 		"tkestack.io/tke/api/auth/v1.ConfigMap":                                       schema_tke_api_auth_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/auth/v1.ConfigMapList":                                   schema_tke_api_auth_v1_ConfigMapList(ref),
 		"tkestack.io/tke/api/auth/v1.CustomPolicyBinding":                             schema_tke_api_auth_v1_CustomPolicyBinding(ref),
@@ -869,6 +870,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/business/v1.NamespaceCert":                               schema_tke_api_business_v1_NamespaceCert(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceCertOptions":                        schema_tke_api_business_v1_NamespaceCertOptions(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceList":                               schema_tke_api_business_v1_NamespaceList(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceRequest":                            schema_tke_api_business_v1_NamespaceRequest(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceRequestList":                        schema_tke_api_business_v1_NamespaceRequestList(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceRequestSpec":                        schema_tke_api_business_v1_NamespaceRequestSpec(ref),
+		"tkestack.io/tke/api/business/v1.NamespaceRequestStatus":                      schema_tke_api_business_v1_NamespaceRequestStatus(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceSpec":                               schema_tke_api_business_v1_NamespaceSpec(ref),
 		"tkestack.io/tke/api/business/v1.NamespaceStatus":                             schema_tke_api_business_v1_NamespaceStatus(ref),
 		"tkestack.io/tke/api/business/v1.NsEmigration":                                schema_tke_api_business_v1_NsEmigration(ref),
@@ -906,15 +911,42 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/mesh/v1.MeshManagerSpec":                                 schema_tke_api_mesh_v1_MeshManagerSpec(ref),
 		"tkestack.io/tke/api/mesh/v1.MeshManagerStatus":                               schema_tke_api_mesh_v1_MeshManagerStatus(ref),
 		"tkestack.io/tke/api/mesh/v1.StorageBackend":                                  schema_tke_api_mesh_v1_StorageBackend(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbe":                                schema_tke_api_monitor_v1_BlackboxProbe(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeHTTP":                            schema_tke_api_monitor_v1_BlackboxProbeHTTP(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeList":                            schema_tke_api_monitor_v1_BlackboxProbeList(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeNotify":                          schema_tke_api_monitor_v1_BlackboxProbeNotify(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeSLO":                             schema_tke_api_monitor_v1_BlackboxProbeSLO(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeSpec":                            schema_tke_api_monitor_v1_BlackboxProbeSpec(ref),
+		"tkestack.io/tke/api/monitor/v1.BlackboxProbeStatus":                          schema_tke_api_monitor_v1_BlackboxProbeStatus(ref),
 		"tkestack.io/tke/api/monitor/v1.ClusterOverview":                              schema_tke_api_monitor_v1_ClusterOverview(ref),
 		"tkestack.io/tke/api/monitor/v1.ClusterOverviewResult":                        schema_tke_api_monitor_v1_ClusterOverviewResult(ref),
 		"tkestack.io/tke/api/monitor/v1.ClusterStatistic":                             schema_tke_api_monitor_v1_ClusterStatistic(ref),
 		"tkestack.io/tke/api/monitor/v1.ConfigMap":                                    schema_tke_api_monitor_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/monitor/v1.ConfigMapList":                                schema_tke_api_monitor_v1_ConfigMapList(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertNotify":                             schema_tke_api_monitor_v1_EventAlertNotify(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertPolicy":                             schema_tke_api_monitor_v1_EventAlertPolicy(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertPolicyList":                         schema_tke_api_monitor_v1_EventAlertPolicyList(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertPolicySpec":                         schema_tke_api_monitor_v1_EventAlertPolicySpec(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertPolicyStatus":                       schema_tke_api_monitor_v1_EventAlertPolicyStatus(ref),
+		"tkestack.io/tke/api/monitor/v1.EventAlertRule":                               schema_tke_api_monitor_v1_EventAlertRule(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQuery":                               schema_tke_api_monitor_v1_FederatedQuery(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQueryError":                          schema_tke_api_monitor_v1_FederatedQueryError(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQueryResult":                         schema_tke_api_monitor_v1_FederatedQueryResult(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQuerySample":                         schema_tke_api_monitor_v1_FederatedQuerySample(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQuerySeries":                         schema_tke_api_monitor_v1_FederatedQuerySeries(ref),
+		"tkestack.io/tke/api/monitor/v1.FederatedQuerySpec":                           schema_tke_api_monitor_v1_FederatedQuerySpec(ref),
 		"tkestack.io/tke/api/monitor/v1.Metric":                                       schema_tke_api_monitor_v1_Metric(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricList":                                   schema_tke_api_monitor_v1_MetricList(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricQuery":                                  schema_tke_api_monitor_v1_MetricQuery(ref),
 		"tkestack.io/tke/api/monitor/v1.MetricQueryCondition":                         schema_tke_api_monitor_v1_MetricQueryCondition(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectNamespaceUsage":                        schema_tke_api_monitor_v1_ProjectNamespaceUsage(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectResourceUsage":                         schema_tke_api_monitor_v1_ProjectResourceUsage(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsage":                                 schema_tke_api_monitor_v1_ProjectUsage(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageCostWeights":                      schema_tke_api_monitor_v1_ProjectUsageCostWeights(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsagePoint":                            schema_tke_api_monitor_v1_ProjectUsagePoint(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageResult":                           schema_tke_api_monitor_v1_ProjectUsageResult(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageSpec":                             schema_tke_api_monitor_v1_ProjectUsageSpec(ref),
+		"tkestack.io/tke/api/monitor/v1.ProjectUsageSummary":                          schema_tke_api_monitor_v1_ProjectUsageSummary(ref),
 		"tkestack.io/tke/api/monitor/v1.Prometheus":                                   schema_tke_api_monitor_v1_Prometheus(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusList":                               schema_tke_api_monitor_v1_PrometheusList(ref),
 		"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr":                         schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref),
@@ -922,16 +954,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/monitor/v1.PrometheusStatus":                             schema_tke_api_monitor_v1_PrometheusStatus(ref),
 		"tkestack.io/tke/api/monitor/v1.ResourceRequirements":                         schema_tke_api_monitor_v1_ResourceRequirements(ref),
 		"tkestack.io/tke/api/notify/v1.Channel":                                       schema_tke_api_notify_v1_Channel(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelAggregation":                            schema_tke_api_notify_v1_ChannelAggregation(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelDingTalk":                               schema_tke_api_notify_v1_ChannelDingTalk(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelLark":                                   schema_tke_api_notify_v1_ChannelLark(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelList":                                   schema_tke_api_notify_v1_ChannelList(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelOpsgenie":                               schema_tke_api_notify_v1_ChannelOpsgenie(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelPagerDuty":                              schema_tke_api_notify_v1_ChannelPagerDuty(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelQuota":                                  schema_tke_api_notify_v1_ChannelQuota(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelQuotaUsage":                             schema_tke_api_notify_v1_ChannelQuotaUsage(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelSMTP":                                   schema_tke_api_notify_v1_ChannelSMTP(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelSpec":                                   schema_tke_api_notify_v1_ChannelSpec(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelStatus":                                 schema_tke_api_notify_v1_ChannelStatus(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS":                        schema_tke_api_notify_v1_ChannelTencentCloudSMS(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelTencentCloudVMS":                        schema_tke_api_notify_v1_ChannelTencentCloudVMS(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelVoice":                                  schema_tke_api_notify_v1_ChannelVoice(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelVoiceAPI":                               schema_tke_api_notify_v1_ChannelVoiceAPI(ref),
+		"tkestack.io/tke/api/notify/v1.ChannelWeCom":                                  schema_tke_api_notify_v1_ChannelWeCom(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelWebhook":                                schema_tke_api_notify_v1_ChannelWebhook(ref),
 		"tkestack.io/tke/api/notify/v1.ChannelWechat":                                 schema_tke_api_notify_v1_ChannelWechat(ref),
 		"tkestack.io/tke/api/notify/v1.ConfigMap":                                     schema_tke_api_notify_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/notify/v1.ConfigMapList":                                 schema_tke_api_notify_v1_ConfigMapList(ref),
+		"tkestack.io/tke/api/notify/v1.Escalation":                                    schema_tke_api_notify_v1_Escalation(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationList":                                schema_tke_api_notify_v1_EscalationList(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationPolicy":                              schema_tke_api_notify_v1_EscalationPolicy(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationPolicyList":                          schema_tke_api_notify_v1_EscalationPolicyList(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationPolicySpec":                          schema_tke_api_notify_v1_EscalationPolicySpec(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationSpec":                                schema_tke_api_notify_v1_EscalationSpec(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationStatus":                              schema_tke_api_notify_v1_EscalationStatus(ref),
+		"tkestack.io/tke/api/notify/v1.EscalationStep":                                schema_tke_api_notify_v1_EscalationStep(ref),
 		"tkestack.io/tke/api/notify/v1.Message":                                       schema_tke_api_notify_v1_Message(ref),
+		"tkestack.io/tke/api/notify/v1.MessageDeliveryAttempt":                        schema_tke_api_notify_v1_MessageDeliveryAttempt(ref),
 		"tkestack.io/tke/api/notify/v1.MessageList":                                   schema_tke_api_notify_v1_MessageList(ref),
 		"tkestack.io/tke/api/notify/v1.MessageRequest":                                schema_tke_api_notify_v1_MessageRequest(ref),
 		"tkestack.io/tke/api/notify/v1.MessageRequestList":                            schema_tke_api_notify_v1_MessageRequestList(ref),
@@ -939,6 +991,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/notify/v1.MessageRequestStatus":                          schema_tke_api_notify_v1_MessageRequestStatus(ref),
 		"tkestack.io/tke/api/notify/v1.MessageSpec":                                   schema_tke_api_notify_v1_MessageSpec(ref),
 		"tkestack.io/tke/api/notify/v1.MessageStatus":                                 schema_tke_api_notify_v1_MessageStatus(ref),
+		"tkestack.io/tke/api/notify/v1.OnCallSchedule":                                schema_tke_api_notify_v1_OnCallSchedule(ref),
+		"tkestack.io/tke/api/notify/v1.OnCallScheduleList":                            schema_tke_api_notify_v1_OnCallScheduleList(ref),
+		"tkestack.io/tke/api/notify/v1.OnCallScheduleSpec":                            schema_tke_api_notify_v1_OnCallScheduleSpec(ref),
 		"tkestack.io/tke/api/notify/v1.Receiver":                                      schema_tke_api_notify_v1_Receiver(ref),
 		"tkestack.io/tke/api/notify/v1.ReceiverGroup":                                 schema_tke_api_notify_v1_ReceiverGroup(ref),
 		"tkestack.io/tke/api/notify/v1.ReceiverGroupList":                             schema_tke_api_notify_v1_ReceiverGroupList(ref),
@@ -946,20 +1001,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/notify/v1.ReceiverList":                                  schema_tke_api_notify_v1_ReceiverList(ref),
 		"tkestack.io/tke/api/notify/v1.ReceiverSpec":                                  schema_tke_api_notify_v1_ReceiverSpec(ref),
 		"tkestack.io/tke/api/notify/v1.Template":                                      schema_tke_api_notify_v1_Template(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateDingTalk":                              schema_tke_api_notify_v1_TemplateDingTalk(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateLark":                                  schema_tke_api_notify_v1_TemplateLark(ref),
 		"tkestack.io/tke/api/notify/v1.TemplateList":                                  schema_tke_api_notify_v1_TemplateList(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateLocalization":                          schema_tke_api_notify_v1_TemplateLocalization(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateOpsgenie":                              schema_tke_api_notify_v1_TemplateOpsgenie(ref),
+		"tkestack.io/tke/api/notify/v1.TemplatePagerDuty":                             schema_tke_api_notify_v1_TemplatePagerDuty(ref),
 		"tkestack.io/tke/api/notify/v1.TemplateSpec":                                  schema_tke_api_notify_v1_TemplateSpec(ref),
 		"tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS":                       schema_tke_api_notify_v1_TemplateTencentCloudSMS(ref),
 		"tkestack.io/tke/api/notify/v1.TemplateText":                                  schema_tke_api_notify_v1_TemplateText(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateVoice":                                 schema_tke_api_notify_v1_TemplateVoice(ref),
+		"tkestack.io/tke/api/notify/v1.TemplateWeCom":                                 schema_tke_api_notify_v1_TemplateWeCom(ref),
 		"tkestack.io/tke/api/notify/v1.TemplateWechat":                                schema_tke_api_notify_v1_TemplateWechat(ref),
+		"tkestack.io/tke/api/platform/v1.AddonCondition":                              schema_tke_api_platform_v1_AddonCondition(ref),
 		"tkestack.io/tke/api/platform/v1.AddonSpec":                                   schema_tke_api_platform_v1_AddonSpec(ref),
+		"tkestack.io/tke/api/platform/v1.AuditLogBackend":                             schema_tke_api_platform_v1_AuditLogBackend(ref),
+		"tkestack.io/tke/api/platform/v1.AuditWebhookBackend":                         schema_tke_api_platform_v1_AuditWebhookBackend(ref),
 		"tkestack.io/tke/api/platform/v1.AuthzWebhookAddr":                            schema_tke_api_platform_v1_AuthzWebhookAddr(ref),
 		"tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr":                     schema_tke_api_platform_v1_BuiltinAuthzWebhookAddr(ref),
+		"tkestack.io/tke/api/platform/v1.CRDConflict":                                 schema_tke_api_platform_v1_CRDConflict(ref),
 		"tkestack.io/tke/api/platform/v1.CSIOperator":                                 schema_tke_api_platform_v1_CSIOperator(ref),
 		"tkestack.io/tke/api/platform/v1.CSIOperatorFeature":                          schema_tke_api_platform_v1_CSIOperatorFeature(ref),
 		"tkestack.io/tke/api/platform/v1.CSIOperatorList":                             schema_tke_api_platform_v1_CSIOperatorList(ref),
 		"tkestack.io/tke/api/platform/v1.CSIOperatorSpec":                             schema_tke_api_platform_v1_CSIOperatorSpec(ref),
 		"tkestack.io/tke/api/platform/v1.CSIOperatorStatus":                           schema_tke_api_platform_v1_CSIOperatorStatus(ref),
 		"tkestack.io/tke/api/platform/v1.CSIProxyOptions":                             schema_tke_api_platform_v1_CSIProxyOptions(ref),
+		"tkestack.io/tke/api/platform/v1.CalicoNetwork":                               schema_tke_api_platform_v1_CalicoNetwork(ref),
+		"tkestack.io/tke/api/platform/v1.CertificateExpiration":                       schema_tke_api_platform_v1_CertificateExpiration(ref),
+		"tkestack.io/tke/api/platform/v1.CertificateRotation":                         schema_tke_api_platform_v1_CertificateRotation(ref),
+		"tkestack.io/tke/api/platform/v1.CertificatesStatus":                          schema_tke_api_platform_v1_CertificatesStatus(ref),
+		"tkestack.io/tke/api/platform/v1.CiliumNetwork":                               schema_tke_api_platform_v1_CiliumNetwork(ref),
 		"tkestack.io/tke/api/platform/v1.Cluster":                                     schema_tke_api_platform_v1_Cluster(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterAddon":                                schema_tke_api_platform_v1_ClusterAddon(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterAddonList":                            schema_tke_api_platform_v1_ClusterAddonList(ref),
@@ -969,29 +1040,71 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/platform/v1.ClusterAddonTypeList":                        schema_tke_api_platform_v1_ClusterAddonTypeList(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterAddress":                              schema_tke_api_platform_v1_ClusterAddress(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterApplyOptions":                         schema_tke_api_platform_v1_ClusterApplyOptions(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterAudit":                                schema_tke_api_platform_v1_ClusterAudit(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterComponent":                            schema_tke_api_platform_v1_ClusterComponent(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterComponentReplicas":                    schema_tke_api_platform_v1_ClusterComponentReplicas(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterCondition":                            schema_tke_api_platform_v1_ClusterCondition(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterCredential":                           schema_tke_api_platform_v1_ClusterCredential(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterCredentialList":                       schema_tke_api_platform_v1_ClusterCredentialList(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterDNS":                                  schema_tke_api_platform_v1_ClusterDNS(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterFeature":                              schema_tke_api_platform_v1_ClusterFeature(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterHealth":                               schema_tke_api_platform_v1_ClusterHealth(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterHealthDimension":                      schema_tke_api_platform_v1_ClusterHealthDimension(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterImage":                                schema_tke_api_platform_v1_ClusterImage(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterList":                                 schema_tke_api_platform_v1_ClusterList(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterLogsOptions":                          schema_tke_api_platform_v1_ClusterLogsOptions(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterMachine":                              schema_tke_api_platform_v1_ClusterMachine(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterOperation":                            schema_tke_api_platform_v1_ClusterOperation(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterOperationList":                        schema_tke_api_platform_v1_ClusterOperationList(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterOperationSpec":                        schema_tke_api_platform_v1_ClusterOperationSpec(ref),
+		"tkestack.io/tke/api/platform/v1.ClusterOperationStatus":                      schema_tke_api_platform_v1_ClusterOperationStatus(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterProperty":                             schema_tke_api_platform_v1_ClusterProperty(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterResource":                             schema_tke_api_platform_v1_ClusterResource(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterSpec":                                 schema_tke_api_platform_v1_ClusterSpec(ref),
 		"tkestack.io/tke/api/platform/v1.ClusterStatus":                               schema_tke_api_platform_v1_ClusterStatus(ref),
 		"tkestack.io/tke/api/platform/v1.ConfigMap":                                   schema_tke_api_platform_v1_ConfigMap(ref),
 		"tkestack.io/tke/api/platform/v1.ConfigMapList":                               schema_tke_api_platform_v1_ConfigMapList(ref),
+		"tkestack.io/tke/api/platform/v1.ContainerRegistryConfig":                     schema_tke_api_platform_v1_ContainerRegistryConfig(ref),
+		"tkestack.io/tke/api/platform/v1.ControlPlaneOverrides":                       schema_tke_api_platform_v1_ControlPlaneOverrides(ref),
 		"tkestack.io/tke/api/platform/v1.CronHPA":                                     schema_tke_api_platform_v1_CronHPA(ref),
 		"tkestack.io/tke/api/platform/v1.CronHPAList":                                 schema_tke_api_platform_v1_CronHPAList(ref),
 		"tkestack.io/tke/api/platform/v1.CronHPAProxyOptions":                         schema_tke_api_platform_v1_CronHPAProxyOptions(ref),
 		"tkestack.io/tke/api/platform/v1.CronHPASpec":                                 schema_tke_api_platform_v1_CronHPASpec(ref),
 		"tkestack.io/tke/api/platform/v1.CronHPAStatus":                               schema_tke_api_platform_v1_CronHPAStatus(ref),
+		"tkestack.io/tke/api/platform/v1.DNSAutoscaler":                               schema_tke_api_platform_v1_DNSAutoscaler(ref),
+		"tkestack.io/tke/api/platform/v1.DNSStubDomain":                               schema_tke_api_platform_v1_DNSStubDomain(ref),
+		"tkestack.io/tke/api/platform/v1.DevicePlugin":                                schema_tke_api_platform_v1_DevicePlugin(ref),
+		"tkestack.io/tke/api/platform/v1.DevicePluginList":                            schema_tke_api_platform_v1_DevicePluginList(ref),
+		"tkestack.io/tke/api/platform/v1.DevicePluginSpec":                            schema_tke_api_platform_v1_DevicePluginSpec(ref),
+		"tkestack.io/tke/api/platform/v1.DevicePluginStatus":                          schema_tke_api_platform_v1_DevicePluginStatus(ref),
+		"tkestack.io/tke/api/platform/v1.EgressGateway":                               schema_tke_api_platform_v1_EgressGateway(ref),
+		"tkestack.io/tke/api/platform/v1.EgressGatewayList":                           schema_tke_api_platform_v1_EgressGatewayList(ref),
+		"tkestack.io/tke/api/platform/v1.EgressGatewaySpec":                           schema_tke_api_platform_v1_EgressGatewaySpec(ref),
+		"tkestack.io/tke/api/platform/v1.EgressGatewayStatus":                         schema_tke_api_platform_v1_EgressGatewayStatus(ref),
+		"tkestack.io/tke/api/platform/v1.EgressIPAssignment":                          schema_tke_api_platform_v1_EgressIPAssignment(ref),
+		"tkestack.io/tke/api/platform/v1.EgressIPPool":                                schema_tke_api_platform_v1_EgressIPPool(ref),
 		"tkestack.io/tke/api/platform/v1.Etcd":                                        schema_tke_api_platform_v1_Etcd(ref),
+		"tkestack.io/tke/api/platform/v1.EtcdBackup":                                  schema_tke_api_platform_v1_EtcdBackup(ref),
+		"tkestack.io/tke/api/platform/v1.EtcdMaintenance":                             schema_tke_api_platform_v1_EtcdMaintenance(ref),
+		"tkestack.io/tke/api/platform/v1.EtcdMaintenanceStatus":                       schema_tke_api_platform_v1_EtcdMaintenanceStatus(ref),
+		"tkestack.io/tke/api/platform/v1.EtcdSnapshot":                                schema_tke_api_platform_v1_EtcdSnapshot(ref),
 		"tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr":                    schema_tke_api_platform_v1_ExternalAuthzWebhookAddr(ref),
 		"tkestack.io/tke/api/platform/v1.ExternalEtcd":                                schema_tke_api_platform_v1_ExternalEtcd(ref),
 		"tkestack.io/tke/api/platform/v1.File":                                        schema_tke_api_platform_v1_File(ref),
+		"tkestack.io/tke/api/platform/v1.FloatingIPPool":                              schema_tke_api_platform_v1_FloatingIPPool(ref),
+		"tkestack.io/tke/api/platform/v1.FloatingIPReservation":                       schema_tke_api_platform_v1_FloatingIPReservation(ref),
+		"tkestack.io/tke/api/platform/v1.FloatingIPReservationList":                   schema_tke_api_platform_v1_FloatingIPReservationList(ref),
+		"tkestack.io/tke/api/platform/v1.FloatingIPReservationSpec":                   schema_tke_api_platform_v1_FloatingIPReservationSpec(ref),
+		"tkestack.io/tke/api/platform/v1.FloatingIPReservationStatus":                 schema_tke_api_platform_v1_FloatingIPReservationStatus(ref),
+		"tkestack.io/tke/api/platform/v1.GPUMIGDeviceConfig":                          schema_tke_api_platform_v1_GPUMIGDeviceConfig(ref),
+		"tkestack.io/tke/api/platform/v1.GPUMIGProfile":                               schema_tke_api_platform_v1_GPUMIGProfile(ref),
+		"tkestack.io/tke/api/platform/v1.GPUMIGSlice":                                 schema_tke_api_platform_v1_GPUMIGSlice(ref),
+		"tkestack.io/tke/api/platform/v1.GPUManagerFeature":                           schema_tke_api_platform_v1_GPUManagerFeature(ref),
+		"tkestack.io/tke/api/platform/v1.GPUNodeConfig":                               schema_tke_api_platform_v1_GPUNodeConfig(ref),
+		"tkestack.io/tke/api/platform/v1.GPUNodeConfigList":                           schema_tke_api_platform_v1_GPUNodeConfigList(ref),
+		"tkestack.io/tke/api/platform/v1.GPUNodeConfigSpec":                           schema_tke_api_platform_v1_GPUNodeConfigSpec(ref),
+		"tkestack.io/tke/api/platform/v1.GPUNodeConfigStatus":                         schema_tke_api_platform_v1_GPUNodeConfigStatus(ref),
+		"tkestack.io/tke/api/platform/v1.GalaxyNetwork":                               schema_tke_api_platform_v1_GalaxyNetwork(ref),
 		"tkestack.io/tke/api/platform/v1.HA":                                          schema_tke_api_platform_v1_HA(ref),
 		"tkestack.io/tke/api/platform/v1.Helm":                                        schema_tke_api_platform_v1_Helm(ref),
 		"tkestack.io/tke/api/platform/v1.HelmList":                                    schema_tke_api_platform_v1_HelmList(ref),
@@ -1003,11 +1116,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/platform/v1.IPAMProxyOptions":                            schema_tke_api_platform_v1_IPAMProxyOptions(ref),
 		"tkestack.io/tke/api/platform/v1.IPAMSpec":                                    schema_tke_api_platform_v1_IPAMSpec(ref),
 		"tkestack.io/tke/api/platform/v1.IPAMStatus":                                  schema_tke_api_platform_v1_IPAMStatus(ref),
+		"tkestack.io/tke/api/platform/v1.ImageAdmission":                              schema_tke_api_platform_v1_ImageAdmission(ref),
+		"tkestack.io/tke/api/platform/v1.KMSPlugin":                                   schema_tke_api_platform_v1_KMSPlugin(ref),
+		"tkestack.io/tke/api/platform/v1.KubeProxy":                                   schema_tke_api_platform_v1_KubeProxy(ref),
+		"tkestack.io/tke/api/platform/v1.KubeletConfigurationOverrides":               schema_tke_api_platform_v1_KubeletConfigurationOverrides(ref),
 		"tkestack.io/tke/api/platform/v1.LBCF":                                        schema_tke_api_platform_v1_LBCF(ref),
+		"tkestack.io/tke/api/platform/v1.LBCFHealthCheck":                             schema_tke_api_platform_v1_LBCFHealthCheck(ref),
 		"tkestack.io/tke/api/platform/v1.LBCFList":                                    schema_tke_api_platform_v1_LBCFList(ref),
 		"tkestack.io/tke/api/platform/v1.LBCFProxyOptions":                            schema_tke_api_platform_v1_LBCFProxyOptions(ref),
 		"tkestack.io/tke/api/platform/v1.LBCFSpec":                                    schema_tke_api_platform_v1_LBCFSpec(ref),
 		"tkestack.io/tke/api/platform/v1.LBCFStatus":                                  schema_tke_api_platform_v1_LBCFStatus(ref),
+		"tkestack.io/tke/api/platform/v1.License":                                     schema_tke_api_platform_v1_License(ref),
+		"tkestack.io/tke/api/platform/v1.LicenseList":                                 schema_tke_api_platform_v1_LicenseList(ref),
+		"tkestack.io/tke/api/platform/v1.LicenseNotify":                               schema_tke_api_platform_v1_LicenseNotify(ref),
+		"tkestack.io/tke/api/platform/v1.LicenseSpec":                                 schema_tke_api_platform_v1_LicenseSpec(ref),
+		"tkestack.io/tke/api/platform/v1.LicenseStatus":                               schema_tke_api_platform_v1_LicenseStatus(ref),
 		"tkestack.io/tke/api/platform/v1.LocalEtcd":                                   schema_tke_api_platform_v1_LocalEtcd(ref),
 		"tkestack.io/tke/api/platform/v1.LogCollector":                                schema_tke_api_platform_v1_LogCollector(ref),
 		"tkestack.io/tke/api/platform/v1.LogCollectorList":                            schema_tke_api_platform_v1_LogCollectorList(ref),
@@ -1021,36 +1144,80 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"tkestack.io/tke/api/platform/v1.MachineSpec":                                 schema_tke_api_platform_v1_MachineSpec(ref),
 		"tkestack.io/tke/api/platform/v1.MachineStatus":                               schema_tke_api_platform_v1_MachineStatus(ref),
 		"tkestack.io/tke/api/platform/v1.MachineSystemInfo":                           schema_tke_api_platform_v1_MachineSystemInfo(ref),
+		"tkestack.io/tke/api/platform/v1.MachineUpgradeBackup":                        schema_tke_api_platform_v1_MachineUpgradeBackup(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLB":                                     schema_tke_api_platform_v1_MetalLB(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLBAddressPool":                          schema_tke_api_platform_v1_MetalLBAddressPool(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLBList":                                 schema_tke_api_platform_v1_MetalLBList(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLBPeer":                                 schema_tke_api_platform_v1_MetalLBPeer(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLBSpec":                                 schema_tke_api_platform_v1_MetalLBSpec(ref),
+		"tkestack.io/tke/api/platform/v1.MetalLBStatus":                               schema_tke_api_platform_v1_MetalLBStatus(ref),
+		"tkestack.io/tke/api/platform/v1.Multus":                                      schema_tke_api_platform_v1_Multus(ref),
+		"tkestack.io/tke/api/platform/v1.MultusIPAM":                                  schema_tke_api_platform_v1_MultusIPAM(ref),
+		"tkestack.io/tke/api/platform/v1.MultusList":                                  schema_tke_api_platform_v1_MultusList(ref),
+		"tkestack.io/tke/api/platform/v1.MultusNetworkAttachment":                     schema_tke_api_platform_v1_MultusNetworkAttachment(ref),
+		"tkestack.io/tke/api/platform/v1.MultusSRIOV":                                 schema_tke_api_platform_v1_MultusSRIOV(ref),
+		"tkestack.io/tke/api/platform/v1.MultusSRIOVResource":                         schema_tke_api_platform_v1_MultusSRIOVResource(ref),
+		"tkestack.io/tke/api/platform/v1.MultusSpec":                                  schema_tke_api_platform_v1_MultusSpec(ref),
+		"tkestack.io/tke/api/platform/v1.MultusStatus":                                schema_tke_api_platform_v1_MultusStatus(ref),
+		"tkestack.io/tke/api/platform/v1.NetworkEncryption":                           schema_tke_api_platform_v1_NetworkEncryption(ref),
+		"tkestack.io/tke/api/platform/v1.NetworkPolicyFeature":                        schema_tke_api_platform_v1_NetworkPolicyFeature(ref),
+		"tkestack.io/tke/api/platform/v1.NetworkPolicyStatus":                         schema_tke_api_platform_v1_NetworkPolicyStatus(ref),
 		"tkestack.io/tke/api/platform/v1.PVCRProxyOptions":                            schema_tke_api_platform_v1_PVCRProxyOptions(ref),
 		"tkestack.io/tke/api/platform/v1.PersistentBackEnd":                           schema_tke_api_platform_v1_PersistentBackEnd(ref),
 		"tkestack.io/tke/api/platform/v1.PersistentEvent":                             schema_tke_api_platform_v1_PersistentEvent(ref),
+		"tkestack.io/tke/api/platform/v1.PersistentEventBuffer":                       schema_tke_api_platform_v1_PersistentEventBuffer(ref),
 		"tkestack.io/tke/api/platform/v1.PersistentEventList":                         schema_tke_api_platform_v1_PersistentEventList(ref),
 		"tkestack.io/tke/api/platform/v1.PersistentEventSpec":                         schema_tke_api_platform_v1_PersistentEventSpec(ref),
 		"tkestack.io/tke/api/platform/v1.PersistentEventStatus":                       schema_tke_api_platform_v1_PersistentEventStatus(ref),
+		"tkestack.io/tke/api/platform/v1.PersistentVolumeClaimUsage":                  schema_tke_api_platform_v1_PersistentVolumeClaimUsage(ref),
+		"tkestack.io/tke/api/platform/v1.PhaseProgress":                               schema_tke_api_platform_v1_PhaseProgress(ref),
+		"tkestack.io/tke/api/platform/v1.Progress":                                    schema_tke_api_platform_v1_Progress(ref),
+		"tkestack.io/tke/api/platform/v1.ProjectImageAdmission":                       schema_tke_api_platform_v1_ProjectImageAdmission(ref),
 		"tkestack.io/tke/api/platform/v1.Prometheus":                                  schema_tke_api_platform_v1_Prometheus(ref),
+		"tkestack.io/tke/api/platform/v1.PrometheusBasicAuth":                         schema_tke_api_platform_v1_PrometheusBasicAuth(ref),
 		"tkestack.io/tke/api/platform/v1.PrometheusList":                              schema_tke_api_platform_v1_PrometheusList(ref),
 		"tkestack.io/tke/api/platform/v1.PrometheusRemoteAddr":                        schema_tke_api_platform_v1_PrometheusRemoteAddr(ref),
+		"tkestack.io/tke/api/platform/v1.PrometheusRemoteWrite":                       schema_tke_api_platform_v1_PrometheusRemoteWrite(ref),
 		"tkestack.io/tke/api/platform/v1.PrometheusSpec":                              schema_tke_api_platform_v1_PrometheusSpec(ref),
 		"tkestack.io/tke/api/platform/v1.PrometheusStatus":                            schema_tke_api_platform_v1_PrometheusStatus(ref),
+		"tkestack.io/tke/api/platform/v1.PrometheusTLSConfig":                         schema_tke_api_platform_v1_PrometheusTLSConfig(ref),
 		"tkestack.io/tke/api/platform/v1.Registry":                                    schema_tke_api_platform_v1_Registry(ref),
+		"tkestack.io/tke/api/platform/v1.RegistryAuth":                                schema_tke_api_platform_v1_RegistryAuth(ref),
 		"tkestack.io/tke/api/platform/v1.RegistryList":                                schema_tke_api_platform_v1_RegistryList(ref),
 		"tkestack.io/tke/api/platform/v1.RegistrySpec":                                schema_tke_api_platform_v1_RegistrySpec(ref),
 		"tkestack.io/tke/api/platform/v1.ResourceRequirements":                        schema_tke_api_platform_v1_ResourceRequirements(ref),
+		"tkestack.io/tke/api/platform/v1.S3Storage":                                   schema_tke_api_platform_v1_S3Storage(ref),
+		"tkestack.io/tke/api/platform/v1.SandboxRuntime":                              schema_tke_api_platform_v1_SandboxRuntime(ref),
+		"tkestack.io/tke/api/platform/v1.SecretsEncryption":                           schema_tke_api_platform_v1_SecretsEncryption(ref),
+		"tkestack.io/tke/api/platform/v1.ServiceOverride":                             schema_tke_api_platform_v1_ServiceOverride(ref),
+		"tkestack.io/tke/api/platform/v1.ServiceOverrides":                            schema_tke_api_platform_v1_ServiceOverrides(ref),
+		"tkestack.io/tke/api/platform/v1.StaticPodOverride":                           schema_tke_api_platform_v1_StaticPodOverride(ref),
 		"tkestack.io/tke/api/platform/v1.StorageBackEndCLS":                           schema_tke_api_platform_v1_StorageBackEndCLS(ref),
 		"tkestack.io/tke/api/platform/v1.StorageBackEndES":                            schema_tke_api_platform_v1_StorageBackEndES(ref),
+		"tkestack.io/tke/api/platform/v1.StorageBackEndKafka":                         schema_tke_api_platform_v1_StorageBackEndKafka(ref),
+		"tkestack.io/tke/api/platform/v1.StorageBackEndLoki":                          schema_tke_api_platform_v1_StorageBackEndLoki(ref),
+		"tkestack.io/tke/api/platform/v1.StorageBackEndSASL":                          schema_tke_api_platform_v1_StorageBackEndSASL(ref),
+		"tkestack.io/tke/api/platform/v1.StorageBackEndTLS":                           schema_tke_api_platform_v1_StorageBackEndTLS(ref),
+		"tkestack.io/tke/api/platform/v1.SystemTuning":                                schema_tke_api_platform_v1_SystemTuning(ref),
 		"tkestack.io/tke/api/platform/v1.TKEHA":                                       schema_tke_api_platform_v1_TKEHA(ref),
 		"tkestack.io/tke/api/platform/v1.TappController":                              schema_tke_api_platform_v1_TappController(ref),
+		"tkestack.io/tke/api/platform/v1.TappControllerConfig":                        schema_tke_api_platform_v1_TappControllerConfig(ref),
 		"tkestack.io/tke/api/platform/v1.TappControllerList":                          schema_tke_api_platform_v1_TappControllerList(ref),
 		"tkestack.io/tke/api/platform/v1.TappControllerProxyOptions":                  schema_tke_api_platform_v1_TappControllerProxyOptions(ref),
 		"tkestack.io/tke/api/platform/v1.TappControllerSpec":                          schema_tke_api_platform_v1_TappControllerSpec(ref),
 		"tkestack.io/tke/api/platform/v1.TappControllerStatus":                        schema_tke_api_platform_v1_TappControllerStatus(ref),
 		"tkestack.io/tke/api/platform/v1.ThirdPartyHA":                                schema_tke_api_platform_v1_ThirdPartyHA(ref),
+		"tkestack.io/tke/api/platform/v1.TimeSync":                                    schema_tke_api_platform_v1_TimeSync(ref),
+		"tkestack.io/tke/api/platform/v1.Ulimit":                                      schema_tke_api_platform_v1_Ulimit(ref),
 		"tkestack.io/tke/api/platform/v1.Upgrade":                                     schema_tke_api_platform_v1_Upgrade(ref),
+		"tkestack.io/tke/api/platform/v1.UpgradeCanary":                               schema_tke_api_platform_v1_UpgradeCanary(ref),
+		"tkestack.io/tke/api/platform/v1.UpgradeCanaryStatus":                         schema_tke_api_platform_v1_UpgradeCanaryStatus(ref),
 		"tkestack.io/tke/api/platform/v1.UpgradeStrategy":                             schema_tke_api_platform_v1_UpgradeStrategy(ref),
 		"tkestack.io/tke/api/platform/v1.VolumeDecorator":                             schema_tke_api_platform_v1_VolumeDecorator(ref),
 		"tkestack.io/tke/api/platform/v1.VolumeDecoratorList":                         schema_tke_api_platform_v1_VolumeDecoratorList(ref),
 		"tkestack.io/tke/api/platform/v1.VolumeDecoratorSpec":                         schema_tke_api_platform_v1_VolumeDecoratorSpec(ref),
 		"tkestack.io/tke/api/platform/v1.VolumeDecoratorStatus":                       schema_tke_api_platform_v1_VolumeDecoratorStatus(ref),
+		"tkestack.io/tke/api/platform/v1.VolumeUsageEnforcement":                      schema_tke_api_platform_v1_VolumeUsageEnforcement(ref),
 		"tkestack.io/tke/api/registry/v1.Chart":                                       schema_tke_api_registry_v1_Chart(ref),
 		"tkestack.io/tke/api/registry/v1.ChartGroup":                                  schema_tke_api_registry_v1_ChartGroup(ref),
 		"tkestack.io/tke/api/registry/v1.ChartGroupImport":                            schema_tke_api_registry_v1_ChartGroupImport(ref),
@@ -38306,6 +38473,46 @@ func schema_tke_api_auth_v1_ClientSpec(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_tke_api_auth_v1_Condition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Condition restricts the requested objects by an attribute, such as the cluster or namespace of the object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the names, or the patterns where * matches any sequence of characters and ? matches a single character.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"key", "operator", "values"},
+			},
+		},
+	}
+}
+
 func schema_tke_api_auth_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -38598,12 +38805,39 @@ func schema_tke_api_auth_v1_CustomPolicyBindingSpec(ref common.ReferenceCallback
 							},
 						},
 					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason records why the access is granted, it is required for expiring bindings.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireTime is the time after which the binding is revoked automatically. The binding never expires if not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"granter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Granter is the user who creates the binding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extender": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extender is the user who last extends or removes the expire time of the binding.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"tenantID", "domain", "lastDomain", "policyID", "resources", "rulePrefix", "users", "groups"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/auth/v1.Subject"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/auth/v1.Subject"},
 	}
 }
 
@@ -40592,10 +40826,25 @@ func schema_tke_api_auth_v1_Statement(ref common.ReferenceCallback) common.OpenA
 							Format:      "",
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions restrict the objects the statement applies to, all of them must be satisfied.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/auth/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"actions", "resources", "effect"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/auth/v1.Condition"},
 	}
 }
 
@@ -41636,6 +41885,229 @@ func schema_tke_api_business_v1_NamespaceList(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_tke_api_business_v1_NamespaceRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceRequest is a namespace request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of namespace requests in this set.",
+							Ref:         ref("tkestack.io/tke/api/business/v1.NamespaceRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/business/v1.NamespaceRequestStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/business/v1.NamespaceRequestSpec", "tkestack.io/tke/api/business/v1.NamespaceRequestStatus"},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceRequestList is the whole list of all namespace requests which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of namespace requests",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/business/v1.NamespaceRequest"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/business/v1.NamespaceRequest"},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceRequestSpec represents a namespace requested by a developer of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Template is the name of the namespace template which describes the size of namespace, the template resources are overridden by Hard.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard represents the total resources of the requested namespace.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason describes why the namespace is requested.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requester": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requester is the user who requests the namespace, which is set by server.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "namespace"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_business_v1_NamespaceRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceRequestStatus represents information about the status of a namespace request.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"approver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approver is the user who approves or rejects the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespaceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceName is the name of the business namespace created for the request.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastNotifyTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastNotifyTime is the time when the requester was notified of the result.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_tke_api_business_v1_NamespaceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -43474,6 +43946,337 @@ func schema_tke_api_mesh_v1_StorageBackend(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_tke_api_monitor_v1_BlackboxProbe(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbe probes the availability of the targets from a cluster through the blackbox exporter, and alerts when the availability breaches the SLO.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the targets and SLO of BlackboxProbe.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.BlackboxProbeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/monitor/v1.BlackboxProbeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.BlackboxProbeSpec", "tkestack.io/tke/api/monitor/v1.BlackboxProbeStatus"},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeHTTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeHTTP is the settings of probing HTTP targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method of the request. Defaults to GET.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"validStatusCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValidStatusCodes are the expected status codes, empty means 2xx.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
+						},
+					},
+					"insecureSkipVerify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureSkipVerify skips verifying the certificates of the targets.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeList is the whole list of all BlackboxProbes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of BlackboxProbes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.BlackboxProbe"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.BlackboxProbe"},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeNotify(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeNotify describes the channel, template and receivers of the alerts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"receiverGroups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"channel", "template"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeSLO(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeSLO is the availability objective of the targets.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"objective": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Objective is the percentage of the successful probes within the window, such as 99.9.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"windowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowSeconds is the window in which the availability is computed. Defaults to 3600.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"notify": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Notify describes where the alerts are sent.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.BlackboxProbeNotify"),
+						},
+					},
+				},
+				Required: []string{"objective", "notify"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.BlackboxProbeNotify"},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeSpec describes the attributes of a BlackboxProbe.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"module": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Module is the protocol of probing, HTTP, TCP or ICMP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Targets are the urls of HTTP, the host:port of TCP or the hosts of ICMP, such as http://nginx.default.svc/healthz.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"intervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IntervalSeconds is the interval of probing. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the timeout of each probing, which must be less than the interval. Defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"http": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTP is the settings of the HTTP module.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.BlackboxProbeHTTP"),
+						},
+					},
+					"slo": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SLO generates the alert rule which fires when the availability of a target is lower than the objective.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.BlackboxProbeSLO"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "module", "targets"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.BlackboxProbeHTTP", "tkestack.io/tke/api/monitor/v1.BlackboxProbeSLO"},
+	}
+}
+
+func schema_tke_api_monitor_v1_BlackboxProbeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlackboxProbeStatus is information about the current status of a BlackboxProbe.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about why the probe is in this phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the phase transitioned from one to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_tke_api_monitor_v1_ClusterOverview(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -43965,11 +44768,63 @@ func schema_tke_api_monitor_v1_ConfigMapList(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_EventAlertNotify(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Metric defines the structure for querying monitoring data requests and results.",
+				Description: "EventAlertNotify describes the channel, template and receivers of the alerts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"template": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"receivers": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"receiverGroups": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"channel", "template"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_EventAlertPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventAlertPolicy watches the events of a cluster and sends alerts through notify when the events matching the rules exceed the thresholds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -43991,30 +44846,30 @@ func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenA
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"query": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/monitor/v1.MetricQuery"),
+							Description: "Spec defines the rules and notify settings of EventAlertPolicy.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.EventAlertPolicySpec"),
 						},
 					},
-					"jsonResult": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("tkestack.io/tke/api/monitor/v1.EventAlertPolicyStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.MetricQuery"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.EventAlertPolicySpec", "tkestack.io/tke/api/monitor/v1.EventAlertPolicyStatus"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_EventAlertPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MetricList is the whole list of all metrics.",
+				Description: "EventAlertPolicyList is the whole list of all EventAlertPolicies.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44038,12 +44893,12 @@ func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.O
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of metrics",
+							Description: "List of EventAlertPolicies",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/monitor/v1.Metric"),
+										Ref: ref("tkestack.io/tke/api/monitor/v1.EventAlertPolicy"),
 									},
 								},
 							},
@@ -44054,186 +44909,187 @@ func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Metric"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.EventAlertPolicy"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_EventAlertPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EventAlertPolicySpec describes the attributes of an EventAlertPolicy.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"table": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"startTime": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"endTime": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
-						},
-					},
-					"fields": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"conditions": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/monitor/v1.MetricQueryCondition"),
-									},
-								},
-							},
-						},
-					},
-					"orderBy": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"order": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"groupBy": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Rules are the patterns of events, an alert is sent for each rule whose threshold is exceeded.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/monitor/v1.EventAlertRule"),
 									},
 								},
 							},
 						},
 					},
-					"limit": {
+					"notify": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Notify describes where the alerts are sent.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.EventAlertNotify"),
 						},
 					},
-					"offset": {
+					"silenceSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "SilenceSeconds is the interval within which the alert of a rule is not sent again. Defaults to 1800.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"table", "fields", "conditions", "groupBy", "limit", "offset"},
+				Required: []string{"tenantID", "clusterName", "rules", "notify"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/monitor/v1.MetricQueryCondition"},
+			"tkestack.io/tke/api/monitor/v1.EventAlertNotify", "tkestack.io/tke/api/monitor/v1.EventAlertRule"},
 	}
 }
 
-func schema_tke_api_monitor_v1_MetricQueryCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_EventAlertPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EventAlertPolicyStatus is information about the alerts of an EventAlertPolicy.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"key": {
+					"lastAlertTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastAlertTime is the time when the last alert was sent.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"expr": {
+					"lastAlertRule": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastAlertRule is the rule of the last alert.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"value": {
+					"lastAlertMessage": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastAlertMessage is the message of the latest event of the last alert.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"key", "expr", "value"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_monitor_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_EventAlertRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Prometheus is a kubernetes package manager.",
+				Description: "EventAlertRule matches the events by reason, involved object and type, and fires when the number of the matched events within the window reaches the threshold.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"reasons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Reasons of the events, such as FailedScheduling and BackOff.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"involvedObjectKinds": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "InvolvedObjectKinds are the kinds of the involved objects, empty means all kinds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"namespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusSpec"),
+							Description: "Namespaces of the involved objects, empty means all namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/monitor/v1.PrometheusStatus"),
+							Description: "Type of the events, Warning or Normal, empty means both.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the number of the matched events within the window which fires the alert. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"windowSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WindowSeconds is the sliding window counting the events. Defaults to 300.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"name", "reasons"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.PrometheusSpec", "tkestack.io/tke/api/monitor/v1.PrometheusStatus"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_FederatedQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
+				Description: "FederatedQuery defines the structure for querying the prometheus of multiple clusters request and result.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44252,98 +45108,140 @@ func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) comm
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Prometheuss",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/monitor/v1.Prometheus"),
-									},
-								},
-							},
+							Description: "Spec defines the query and the clusters to query.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.FederatedQuerySpec"),
+						},
+					},
+					"result": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/monitor/v1.FederatedQueryResult"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Prometheus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.FederatedQueryResult", "tkestack.io/tke/api/monitor/v1.FederatedQuerySpec"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_FederatedQueryError(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
+				Description: "FederatedQueryError is the failure of querying a cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"writeAddr": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"clusterName", "message"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_FederatedQueryResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FederatedQueryResult is the merged result of the clusters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"series": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Series are the series of all clusters, each of which has the cluster_id label.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/monitor/v1.FederatedQuerySeries"),
 									},
 								},
 							},
 						},
 					},
-					"readAddr": {
+					"errors": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Errors are the clusters failed to query, the result is partial if any.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/monitor/v1.FederatedQueryError"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"series"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.FederatedQueryError", "tkestack.io/tke/api/monitor/v1.FederatedQuerySeries"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_FederatedQuerySample(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusSpec describes the attributes on a Prometheus.",
+				Description: "FederatedQuerySample is a value of a series at a time.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"timestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Timestamp in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"clusterName": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Value is formatted by prometheus, which may be NaN or Inf.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+				},
+				Required: []string{"timestamp", "value"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_monitor_v1_FederatedQuerySeries(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FederatedQuerySeries is a series returned by the prometheus of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"subVersion": {
+					"metric": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
+							Description: "Metric is the labels of the series.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -44356,164 +45254,102 @@ func schema_tke_api_monitor_v1_PrometheusSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"remoteAddress": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr"),
-						},
-					},
-					"notifyWebhook": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources is the resource request and limit for prometheus",
-							Ref:         ref("tkestack.io/tke/api/monitor/v1.ResourceRequirements"),
-						},
-					},
-					"runOnMaster": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"alertRepeatInterval": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AlertRepeatInterval indicates repeat interval of alerts",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"withNPD": {
+					"values": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Values has one sample for an instant query and the samples in the time range for a range query.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.FederatedQuerySample"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"clusterName", "values"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/monitor/v1.ResourceRequirements"},
+			"tkestack.io/tke/api/monitor/v1.FederatedQuerySample"},
 	}
 }
 
-func schema_tke_api_monitor_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_FederatedQuerySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusStatus is information about the current status of a Prometheus.",
+				Description: "FederatedQuerySpec describes the PromQL query and the clusters to fan out.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Query is the PromQL expression evaluated by the prometheus of each cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the helm of cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Clusters are the names of the clusters to query, all clusters of the tenant if not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"reason": {
+					"clusterSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "ClusterSelector is a label selector of the clusters to query.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Description: "Time is the evaluation time of an instant query in milliseconds, defaults to now.",
 							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"lastReInitializingTimestamp": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Format:      "int64",
 						},
 					},
-					"subVersion": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "StartTime and EndTime in milliseconds make the query a range query.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_monitor_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ResourceRequirements describes the compute resource requirements.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"limits": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"requests": {
+					"stepSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "StepSeconds is the resolution of a range query, defaults to 60.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
+				Required: []string{"query"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_Metric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Channel represents a message transmission channel in TKE.",
+				Description: "Metric defines the structure for querying monitoring data requests and results.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44535,30 +45371,30 @@ func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenA
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"spec": {
+					"query": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired channel.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelSpec"),
+							Ref: ref("tkestack.io/tke/api/monitor/v1.MetricQuery"),
 						},
 					},
-					"status": {
+					"jsonResult": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelStatus"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ChannelSpec", "tkestack.io/tke/api/notify/v1.ChannelStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.MetricQuery"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_MetricList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelList is the whole list of all channels which owned by a tenant.",
+				Description: "MetricList is the whole list of all metrics.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44582,12 +45418,12 @@ func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.O
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of channels.",
+							Description: "List of metrics",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.Channel"),
+										Ref: ref("tkestack.io/tke/api/monitor/v1.Metric"),
 									},
 								},
 							},
@@ -44598,65 +45434,37 @@ func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.O
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Channel"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Metric"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelSMTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_MetricQuery(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelSMTP indicates a channel configuration for sending email notifications using the SMTP server.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"smtpHost": {
+					"table": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"smtpPort": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"tls": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"email": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Format: "int64",
 						},
 					},
-					"password": {
+					"endTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-				},
-				Required: []string{"smtpHost", "smtpPort", "tls", "email", "password"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelSpec is a description of a channel.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"fields": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -44667,164 +45475,182 @@ func schema_tke_api_notify_v1_ChannelSpec(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
-					"tenantID": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.MetricQueryCondition"),
+									},
+								},
+							},
 						},
 					},
-					"displayName": {
+					"orderBy": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"tencentCloudSMS": {
+					"order": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"wechat": {
+					"groupBy": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWechat"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"smtp": {
+					"limit": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelSMTP"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"webhook": {
+					"offset": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWebhook"),
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"table", "fields", "conditions", "groupBy", "limit", "offset"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/notify/v1.ChannelSMTP", "tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS", "tkestack.io/tke/api/notify/v1.ChannelWebhook", "tkestack.io/tke/api/notify/v1.ChannelWechat"},
+			"tkestack.io/tke/api/monitor/v1.MetricQueryCondition"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_MetricQueryCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelStatus represents information about the status of a cluster.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"expr": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"value": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
+				Required: []string{"key", "expr", "value"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectNamespaceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelTencentCloudSMS indicates the channel configuration for sending messages using Tencent Cloud SMS Gateway. See: https://cloud.tencent.com/document/product/382/5976",
+				Description: "ProjectNamespaceUsage is the aggregated usage of a namespace of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"appKey": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"sdkAppID": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"extend": {
+					"average": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"),
 						},
 					},
-				},
-				Required: []string{"appKey", "sdkAppID"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_notify_v1_ChannelWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"url": {
+					"total": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"),
 						},
 					},
-					"headers": {
+					"cost": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
 				},
-				Required: []string{"url"},
+				Required: []string{"clusterName", "namespace", "average", "total"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"},
 	}
 }
 
-func schema_tke_api_notify_v1_ChannelWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectResourceUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ChannelWechat indicates a channel configuration for sending template notifications using WeChat.",
+				Description: "ProjectResourceUsage is the usage of cpu in cores, memory and storage in bytes and gpu in cards, or the accumulated usage of them.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"appID": {
+					"cpu": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AppID indicates the unique credentials of the third-party user. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
-					"appSecret": {
+					"memory": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
 						},
 					},
 				},
-				Required: []string{"appID", "appSecret"},
+				Required: []string{"cpu", "memory", "gpu", "storage"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "ProjectUsage defines the structure for querying the resource usage of business projects request and result.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -44846,190 +45672,255 @@ func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.Ope
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"data": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Spec defines the projects and the time range to aggregate.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ProjectUsageSpec"),
 						},
 					},
-					"binaryData": {
+					"result": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectUsageResult"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.ProjectUsageResult", "tkestack.io/tke/api/monitor/v1.ProjectUsageSpec"},
 	}
 }
 
-func schema_tke_api_notify_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectUsageCostWeights(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "ProjectUsageCostWeights are the prices of resources per hour.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"cpu": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CPU is the price of one core per hour.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"apiVersion": {
+					"memory": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Memory is the price of one GiB memory per hour.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"metadata": {
+					"gpu": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "GPU is the price of one gpu per hour.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"items": {
+					"storage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.ConfigMap"),
-									},
-								},
-							},
+							Description: "Storage is the price of one GiB requested persistent volume per hour.",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_notify_v1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectUsagePoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Message indicates a message in the notification system.",
+				Description: "ProjectUsagePoint is the usage of a project at a time.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"timestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Timestamp in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"spec": {
+					"usage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired message.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageSpec"),
+							Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"),
 						},
 					},
-					"status": {
+				},
+				Required: []string{"timestamp", "usage"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ProjectUsageResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsageResult is the aggregated usage of the projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.MessageStatus"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectUsageSummary"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"projects"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageSpec", "tkestack.io/tke/api/notify/v1.MessageStatus"},
+			"tkestack.io/tke/api/monitor/v1.ProjectUsageSummary"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ProjectUsageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageList is the whole list of all message which owned by a tenant.",
+				Description: "ProjectUsageSpec describes the projects and the time range of a usage query.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"projects": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Projects are the names of the business projects to aggregate, all projects of the tenant if not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "StartTime is the beginning of the time range in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTime is the end of the time range in milliseconds.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"stepSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StepSeconds is the resolution of the time series, defaults to 3600.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"withSeries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WithSeries returns the time series of each project along with the summary.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"costWeights": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "CostWeights are the prices used to calculate the cost of the usage, no cost is calculated if not specified.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ProjectUsageCostWeights"),
 						},
 					},
-					"items": {
+				},
+				Required: []string{"startTime", "endTime"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.ProjectUsageCostWeights"},
+	}
+}
+
+func schema_tke_api_monitor_v1_ProjectUsageSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectUsageSummary is the aggregated usage of a project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"projectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of messages.",
-							Type:        []string{"array"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"average": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Average is the average usage over the time range.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"),
+						},
+					},
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the accumulated usage over the time range, in core-hours, GiB-hours and gpu-hours.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ProjectResourceUsage"),
+						},
+					},
+					"cost": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"number"},
+							Format: "double",
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.Message"),
+										Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectNamespaceUsage"),
+									},
+								},
+							},
+						},
+					},
+					"series": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/monitor/v1.ProjectUsagePoint"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"projectName", "average", "total"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Message"},
+			"tkestack.io/tke/api/monitor/v1.ProjectNamespaceUsage", "tkestack.io/tke/api/monitor/v1.ProjectResourceUsage", "tkestack.io/tke/api/monitor/v1.ProjectUsagePoint"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequest represents a message sending request, which may include multiple recipients and multiple receiving groups.",
+				Description: "Prometheus is a kubernetes package manager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45053,28 +45944,28 @@ func schema_tke_api_notify_v1_MessageRequest(ref common.ReferenceCallback) commo
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired message.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageRequestSpec"),
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.MessageRequestStatus"),
+							Ref: ref("tkestack.io/tke/api/monitor/v1.PrometheusStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageRequestSpec", "tkestack.io/tke/api/notify/v1.MessageRequestStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/monitor/v1.PrometheusSpec", "tkestack.io/tke/api/monitor/v1.PrometheusStatus"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestList is the whole list of all message which owned by a tenant.",
+				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45098,12 +45989,12 @@ func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) c
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of message requests.",
+							Description: "List of Prometheuss",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.MessageRequest"),
+										Ref: ref("tkestack.io/tke/api/monitor/v1.Prometheus"),
 									},
 								},
 							},
@@ -45114,30 +46005,18 @@ func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.MessageRequest"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/monitor/v1.Prometheus"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestSpec is a description of a message request.",
+				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"templateName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"receivers": {
+					"writeAddr": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -45150,7 +46029,7 @@ func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"receiverGroups": {
+					"readAddr": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -45163,49 +46042,40 @@ func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) c
 							},
 						},
 					},
-					"variables": {
-						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"tenantID", "templateName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageRequestStatus represents information about the status of a message request.",
+				Description: "PrometheusSpec describes the attributes on a Prometheus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"lastTransitionTime": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"errors": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubVersion is the components version such as node-exporter.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -45218,132 +46088,164 @@ func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_tke_api_notify_v1_MessageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MessageSpec is a description of a message.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"remoteAddress": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr"),
 						},
 					},
-					"receiverName": {
+					"notifyWebhook": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"receiverChannel": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Resources is the resource request and limit for prometheus",
+							Ref:         ref("tkestack.io/tke/api/monitor/v1.ResourceRequirements"),
 						},
 					},
-					"identity": {
+					"runOnMaster": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"username": {
+					"alertRepeatInterval": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "AlertRepeatInterval indicates repeat interval of alerts",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"header": {
+					"withNPD": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"body": {
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/monitor/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/monitor/v1.ResourceRequirements"},
+	}
+}
+
+func schema_tke_api_monitor_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusStatus is information about the current status of a Prometheus.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"channelMessageID": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"alarmPolicyName": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"alarmPolicyType": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"receiverChannelName": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"clusterID": {
+					"subVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SubVersion is the components version such as node-exporter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "receiverName", "receiverChannel", "identity"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_notify_v1_MessageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_monitor_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MessageStatus represents information about the status of a message.",
+				Description: "ResourceRequirements describes the compute resource requirements.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"limits": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"lastTransitionTime": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_Channel(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
+				Description: "Channel represents a message transmission channel in TKE.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45367,150 +46269,153 @@ func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.Open
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired receiver.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverSpec"),
+							Description: "Spec defines the desired channel.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverSpec"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ChannelSpec", "tkestack.io/tke/api/notify/v1.ChannelStatus"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelAggregation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroup indicates multiple message recipients.",
+				Description: "ChannelAggregation indicates how the message requests of a channel are deduplicated and suppressed before sending.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"dedupKeys": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DedupKeys are the variables identifying the same message. Message requests with the same template, receivers and values of the keys are deduplicated. All variables are used if not specified.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"groupWaitSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "GroupWaitSeconds is the time to wait for the duplicate message requests before sending one message with the count of them. Deduplication is disabled if it is 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"flapKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlapKey is the variable whose changes of value are counted to detect flapping. Defaults to alertStatus.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"flapWindowSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "FlapWindowSeconds is the time window in which the changes are counted.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"flapThreshold": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired receiver group.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"),
+							Description: "FlapThreshold is the number of changes in the window since which the message requests are suppressed. Flap detection is disabled if it is 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelDingTalk(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroupList is the whole list of all receiver which owned by a tenant.",
+				Description: "ChannelDingTalk indicates a channel configuration for sending notifications to a group through the custom robot of DingTalk.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "URL is the webhook address of the robot, including the access token.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Secret signs the requests when the robot enables the signature security setting.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"rateLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of receiver groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.ReceiverGroup"),
-									},
-								},
-							},
+							Description: "RateLimit is the maximum number of messages sent through the robot per minute. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroup"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelLark(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverGroupSpec is a description of a receiver group.",
+				Description: "ChannelLark indicates a channel configuration for sending notifications to a group through the custom bot of Lark.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "URL is the webhook address of the bot.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Secret signs the requests when the bot enables the signature verification.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"receivers": {
+					"rateLimit": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "RateLimit is the maximum number of messages sent through the bot per minute. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"url"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverList is the whole list of all receiver which owned by a tenant.",
+				Description: "ChannelList is the whole list of all channels which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -45534,12 +46439,12 @@ func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of receivers.",
+							Description: "List of channels.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.Receiver"),
+										Ref: ref("tkestack.io/tke/api/notify/v1.Channel"),
 									},
 								},
 							},
@@ -45550,38 +46455,34 @@ func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Receiver"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Channel"},
 	}
 }
 
-func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelOpsgenie(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ReceiverSpec is a description of a receiver.",
+				Description: "ChannelOpsgenie indicates a channel configuration for creating and closing alerts in Opsgenie.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"displayName": {
+					"apiKey": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIKey is the key of the API integration.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"username": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "URL is the address of the Alert API. Defaults to https://api.opsgenie.com, and https://api.eu.opsgenie.com for the EU instance.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"identities": {
+					"priorities": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+							Description: "Priorities maps the severity of alerts to the priority of Opsgenie alerts, which is one of P1 to P5.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -45595,396 +46496,559 @@ func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"apiKey"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_notify_v1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelPagerDuty(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Template indicates the template used to send notifications under this channel.",
+				Description: "ChannelPagerDuty indicates a channel configuration for triggering and resolving incidents of a service in PagerDuty through the Events API v2.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"routingKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "RoutingKey is the integration key of the service.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL is the address of the Events API v2. Defaults to https://events.pagerduty.com/v2/enqueue.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"severities": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired template.",
-							Ref:         ref("tkestack.io/tke/api/notify/v1.TemplateSpec"),
+							Description: "Severities maps the severity of alerts to the severity of events, which is one of critical, error, warning and info.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"routingKey"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.TemplateSpec"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateList is the whole list of all template which owned by a channel.",
+				Description: "ChannelQuota limits the messages sent through the channel, and through the channel for each project, in the sliding windows of the last minute and the last day. A non-positive limit means no limit.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"messagesPerMinute": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MessagesPerMinute is the maximum number of messages sent through the channel in the last minute.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiVersion": {
+					"messagesPerDay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MessagesPerDay is the maximum number of messages sent through the channel in the last 24 hours.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"projectMessagesPerMinute": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ProjectMessagesPerMinute is the maximum number of messages sent through the channel for each project in the last minute.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"projectMessagesPerDay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of templates.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/notify/v1.Template"),
-									},
-								},
-							},
+							Description: "ProjectMessagesPerDay is the maximum number of messages sent through the channel for each project in the last 24 hours.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Template"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelQuotaUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateSpec is a description of a template.",
+				Description: "ChannelQuotaUsage is the number of messages sent through the channel, or through the channel for a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"project": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Project is the project the messages are sent for, empty for all the messages of the channel.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"displayName": {
+					"messagesLastMinute": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessagesLastMinute is the number of messages sent in the last minute.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"messagesLastDay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MessagesLastDay is the number of messages sent in the last 24 hours.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelSMTP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelSMTP indicates a channel configuration for sending email notifications using the SMTP server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"smtpHost": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"keys": {
+					"smtpPort": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"integer"},
+							Format: "int32",
 						},
 					},
-					"tencentCloudSMS": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"wechat": {
+					"email": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateWechat"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"text": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateText"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "displayName"},
+				Required: []string{"smtpHost", "smtpPort", "tls", "email", "password"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS", "tkestack.io/tke/api/notify/v1.TemplateText", "tkestack.io/tke/api/notify/v1.TemplateWechat"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateTencentCloudSMS indicates the template used when sending text messages using Tencent Cloud SMS Gateway. The template must be approved.",
+				Description: "ChannelSpec is a description of a channel.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"templateID": {
+					"finalizers": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"sign": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"body": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"tencentCloudSMS": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS"),
+						},
+					},
+					"wechat": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWechat"),
+						},
+					},
+					"smtp": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelSMTP"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWebhook"),
+						},
+					},
+					"weCom": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelWeCom"),
+						},
+					},
+					"dingTalk": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelDingTalk"),
+						},
+					},
+					"lark": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelLark"),
+						},
+					},
+					"aggregation": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelAggregation"),
+						},
+					},
+					"pagerDuty": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelPagerDuty"),
+						},
+					},
+					"opsgenie": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelOpsgenie"),
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelQuota"),
+						},
+					},
+					"voice": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.ChannelVoice"),
+						},
+					},
 				},
-				Required: []string{"templateID"},
+				Required: []string{"tenantID", "displayName"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/notify/v1.ChannelAggregation", "tkestack.io/tke/api/notify/v1.ChannelDingTalk", "tkestack.io/tke/api/notify/v1.ChannelLark", "tkestack.io/tke/api/notify/v1.ChannelOpsgenie", "tkestack.io/tke/api/notify/v1.ChannelPagerDuty", "tkestack.io/tke/api/notify/v1.ChannelQuota", "tkestack.io/tke/api/notify/v1.ChannelSMTP", "tkestack.io/tke/api/notify/v1.ChannelTencentCloudSMS", "tkestack.io/tke/api/notify/v1.ChannelVoice", "tkestack.io/tke/api/notify/v1.ChannelWeCom", "tkestack.io/tke/api/notify/v1.ChannelWebhook", "tkestack.io/tke/api/notify/v1.ChannelWechat"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateText(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateText indicates the template used to send notifications using other non-templated channels.",
+				Description: "ChannelStatus represents information about the status of a cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"body": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"header": {
+					"quotaUsages": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "QuotaUsages are the messages sent through the channel reported by the message request controller.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.ChannelQuotaUsage"),
+									},
+								},
+							},
+						},
+					},
+					"lastQuotaUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastQuotaUpdateTime is the time the quota usages are reported.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"body"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/notify/v1.ChannelQuotaUsage"},
 	}
 }
 
-func schema_tke_api_notify_v1_TemplateWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "TemplateWechat indicates the template when sending a text message using the WeChat public account. The template must be approved and registered.",
+				Description: "ChannelTencentCloudSMS indicates the channel configuration for sending messages using Tencent Cloud SMS Gateway. See: https://cloud.tencent.com/document/product/382/5976",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"templateID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TemplateID indicates the template id of the template message notification. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"url": {
-						SchemaProps: spec.SchemaProps{
-							Description: "URL indicates the web address of the user who clicked the notification in WeChat.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"miniProgramAppID": {
+					"appKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MiniProgramAppID indicates the unique identification number of the WeChat applet that the user clicked on the notification in WeChat.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"miniProgramPagePath": {
+					"sdkAppID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"body": {
+					"extend": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"templateID"},
+				Required: []string{"appKey", "sdkAppID"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_AddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelTencentCloudVMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AddonSpec describes the attributes on a Addon.",
+				Description: "ChannelTencentCloudVMS indicates the configuration for calling through Tencent Cloud Voice Message Service with a voice template. See: https://cloud.tencent.com/document/product/1128",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"appKey": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"clusterName": {
+					"sdkAppID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"version": {
+					"playTimes": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "PlayTimes is the times the message is read out in a call. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"appKey", "sdkAppID"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_AuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelVoice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ChannelVoice indicates a channel configuration for calling the receivers and reading out the alarm, which is used for the alarms of configured severities. The receivers are called one after another until one of them answers.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"builtin": {
+					"tencentCloudVMS": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr"),
+							Description: "TencentCloudVMS calls through Tencent Cloud Voice Message Service.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelTencentCloudVMS"),
 						},
 					},
-					"external": {
+					"api": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"),
+							Description: "API calls through the HTTP API of a SIP gateway or a voice provider.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ChannelVoiceAPI"),
+						},
+					},
+					"severities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Severities are the severities of the alarms which trigger calls, the messages of other severities are not sent. Defaults to critical.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"answerTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AnswerTimeoutSeconds is the time to wait for a receiver to answer before calling the next one. Defaults to 60.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"},
+			"tkestack.io/tke/api/notify/v1.ChannelTencentCloudVMS", "tkestack.io/tke/api/notify/v1.ChannelVoiceAPI"},
 	}
 }
 
-func schema_tke_api_platform_v1_BuiltinAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelVoiceAPI(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ChannelVoiceAPI indicates the HTTP API of a voice provider. The call is made by posting {\"callee\": mobile, \"content\": text} to the URL, which responds with {\"callID\": id}, and the state of the call is queried by getting URL/{id}, which responds with {\"status\": status}, where status is answered when the callee answered, and failed, busy or noanswer when the call ended unanswered.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address of the call API.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"headers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Headers are added to the requests, such as the authorization header.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"url"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelWeCom(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperator is a operator to manages CSI external components.",
+				Description: "ChannelWeCom indicates a channel configuration for sending notifications to a group through the robot of WeCom (WeChat Work).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL is the webhook address of the group robot, including the key.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"rateLimit": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "RateLimit is the maximum number of messages sent through the robot per minute. Defaults to 20.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+				},
+				Required: []string{"url"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ChannelWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ChannelWebhook indicates a channel configuration for sending notifications to the webhook server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of storage operator.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.CSIOperatorSpec"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"status": {
+					"headers": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorStatus"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"url"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CSIOperatorSpec", "tkestack.io/tke/api/platform/v1.CSIOperatorStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ChannelWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ChannelWechat indicates a channel configuration for sending template notifications using WeChat.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"appID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AppID indicates the unique credentials of the third-party user. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"appSecret": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
 				},
-				Required: []string{"version"},
+				Required: []string{"appID", "appSecret"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorList is the whole list of all storage operators which owned by a tenant.",
+				Description: "ConfigMap holds configuration data for tke to consume.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46003,124 +47067,100 @@ func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) co
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of storage operators.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperator"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CSIOperator"},
-	}
-}
-
-func schema_tke_api_platform_v1_CSIOperatorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorSpec describes the attributes of a storage operator.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clusterName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"version": {
+					"binaryData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version of the CSI operator.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIOperatorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIOperatorStatus is information about the current status of a storage operator.",
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"storageVendorVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "StorageVendorVersion will be set to the config version of the storage vendor.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.ConfigMap"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ConfigMap"},
 	}
 }
 
-func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_Escalation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.",
+				Description: "Escalation indicates an alert being escalated by an escalation policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46137,29 +47177,35 @@ func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"name": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec defines the desired escalation.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.EscalationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.EscalationStatus"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.EscalationSpec", "tkestack.io/tke/api/notify/v1.EscalationStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Cluster is a Kubernetes cluster in",
+				Description: "EscalationList is the whole list of all escalations which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46178,33 +47224,36 @@ func schema_tke_api_platform_v1_Cluster(ref common.ReferenceCallback) common.Ope
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterSpec"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"status": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterStatus"),
+							Description: "List of escalations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.Escalation"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterSpec", "tkestack.io/tke/api/platform/v1.ClusterStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Escalation"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddon contains the Addon component for the current kubernetes cluster",
+				Description: "EscalationPolicy indicates the ordered steps to notify until an escalation is acknowledged.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46228,28 +47277,23 @@ func schema_tke_api_platform_v1_ClusterAddon(ref common.ReferenceCallback) commo
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of addons in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterAddonSpec"),
-						},
-					},
-					"status": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddonStatus"),
+							Description: "Spec defines the desired escalation policy.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.EscalationPolicySpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonSpec", "tkestack.io/tke/api/platform/v1.ClusterAddonStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.EscalationPolicySpec"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonList is the whole list of all ClusterAddon.",
+				Description: "EscalationPolicyList is the whole list of all escalation policies which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46273,12 +47317,12 @@ func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) c
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of ClusterAddon",
+							Description: "List of escalation policies.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddon"),
+										Ref: ref("tkestack.io/tke/api/notify/v1.EscalationPolicy"),
 									},
 								},
 							},
@@ -46289,245 +47333,220 @@ func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddon"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.EscalationPolicy"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonSpec indicates the specifications of the ClusterAddon.",
+				Description: "EscalationPolicySpec is a description of an escalation policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"level": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddonLevel is level of cluster addon.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"version": {
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Steps are notified in order until the escalation is acknowledged.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.EscalationStep"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "level", "version"},
+				Required: []string{"tenantID", "displayName", "steps"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/notify/v1.EscalationStep"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonStatus is information about the current status of a ClusterAddon.",
+				Description: "EscalationSpec is a description of an escalation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"policyName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Description: "PolicyName is the name of escalation policy.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"variables": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Variables are used to render the templates of each step.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"tenantID", "policyName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonType records the all addons of cluster available.",
+				Description: "EscalationStatus represents information about the status of an escalation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"type": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"level": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AddonLevel is level of cluster addon.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Step is the number of steps which have been notified.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"latestVersion": {
+					"lastStepTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LatestVersion is latest version of the addon.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastStepTime is the time when the last step was notified.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"description": {
+					"acknowledgedBy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is desc of the addon.",
+							Description: "AcknowledgedBy is the username who acknowledged the escalation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"compatibleClusterType": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "The last time the phase transitioned from one to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"type", "level", "latestVersion"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterAddonTypeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_EscalationStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.",
+				Description: "EscalationStep indicates the receivers notified in a step of escalation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"waitSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "WaitSeconds is the time to wait since the previous step before notifying this step. The first step waits since the escalation is created.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"apiVersion": {
+					"channel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Channel is the name of channel used to notify.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Template is the name of template under the channel.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"receivers": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddonType"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonType"},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAddress contains information for the cluster's address.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Cluster address type, one of Public, ExternalIP or InternalIP.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"host": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The cluster address.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"port": {
+					"receiverGroups": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"path": {
+					"onCallSchedules": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "OnCallSchedules notifies the current on-call receivers of the schedules.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "host", "port", "path"},
+				Required: []string{"channel", "template"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterApplyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_Message(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
+				Description: "Message indicates a message in the notification system.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46544,144 +47563,112 @@ func schema_tke_api_platform_v1_ClusterApplyOptions(ref common.ReferenceCallback
 							Format:      "",
 						},
 					},
-					"notUpdate": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_ClusterComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ClusterComponent records the number of copies of each component of the cluster master.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Spec defines the desired message.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageSpec"),
 						},
 					},
-					"replicas": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"),
+							Ref: ref("tkestack.io/tke/api/notify/v1.MessageStatus"),
 						},
 					},
 				},
-				Required: []string{"type", "replicas"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageSpec", "tkestack.io/tke/api/notify/v1.MessageStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterComponentReplicas(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageDeliveryAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterComponentReplicas records the number of copies of each state of each component of the cluster master.",
+				Description: "MessageDeliveryAttempt records an attempt to deliver a message.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"desired": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"current": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
-						},
-					},
-					"available": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Time is when the attempt was made.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"updated": {
+					"error": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Error is the reason why the attempt failed, empty if it succeeded.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"desired", "current", "available", "updated"},
+				Required: []string{"time"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCondition contains details for the current condition of this cluster.",
+				Description: "MessageList is the whole list of all message which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the condition.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastProbeTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time we probed the condition.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastTransitionTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"reason": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"message": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Human-readable message indicating details about last transition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "List of messages.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.Message"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "status"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Message"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCredential records the credential information needed to access the cluster.",
+				Description: "MessageRequest represents a message sending request, which may include multiple recipients and multiple receiving groups.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46703,105 +47690,30 @@ func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback)
 							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clusterName": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"etcdCACert": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For TKE in global reuse",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"etcdCAKey": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"etcdAPIClientCert": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"etcdAPIClientKey": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"caCert": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For connect the cluster",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"caKey": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"clientCert": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver X509 auth",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"clientKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver X509 auth",
-							Type:        []string{"string"},
-							Format:      "byte",
-						},
-					},
-					"token": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver token auth",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"bootstrapToken": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kubeadm init or join",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec defines the desired message.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.MessageRequestSpec"),
 						},
 					},
-					"certificateKey": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "For kubeadm init or join",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("tkestack.io/tke/api/notify/v1.MessageRequestStatus"),
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.MessageRequestSpec", "tkestack.io/tke/api/notify/v1.MessageRequestStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageRequestList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterCredentialList is the whole list of all ClusterCredential which owned by a tenant.",
+				Description: "MessageRequestList is the whole list of all message which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -46825,12 +47737,12 @@ func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallba
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
+							Description: "List of message requests.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterCredential"),
+										Ref: ref("tkestack.io/tke/api/notify/v1.MessageRequest"),
 									},
 								},
 							},
@@ -46841,53 +47753,30 @@ func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterCredential"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.MessageRequest"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterFeature records the features that are enabled by the cluster.",
+				Description: "MessageRequestSpec is a description of a message request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ipvs": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"publicLB": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"internalLB": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"gpuType": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"enableMasterSchedule": {
+					"templateName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"ha": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.HA"),
-						},
-					},
-					"skipConditions": {
+					"receivers": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
@@ -46900,19 +47789,20 @@ func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"files": {
+					"receiverGroups": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.File"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"hooks": {
+					"variables": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
@@ -46926,204 +47816,210 @@ func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"csiOperator": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorFeature"),
-						},
-					},
-					"authzWebhookAddr": {
-						SchemaProps: spec.SchemaProps{
-							Description: "For kube-apiserver authorization webhook",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.AuthzWebhookAddr"),
-						},
-					},
-					"enableMetricsServer": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"ipv6DualStack": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"enableCilium": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
-						},
-					},
-					"upgrade": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Upgrade control upgrade process.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.Upgrade"),
-						},
-					},
 				},
+				Required: []string{"tenantID", "templateName"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.AuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.CSIOperatorFeature", "tkestack.io/tke/api/platform/v1.File", "tkestack.io/tke/api/platform/v1.HA", "tkestack.io/tke/api/platform/v1.Upgrade"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterList is the whole list of all clusters which owned by a tenant.",
+				Description: "MessageRequestStatus represents information about the status of a message request.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "The last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"errors": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.Cluster"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Cluster"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterMachine is the master machine definition of cluster.",
+				Description: "MessageSpec is a description of a message.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ip": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"port": {
+					"receiverName": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"username": {
+					"receiverChannel": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"password": {
+					"identity": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"privateKey": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"passPhrase": {
+					"header": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
-							Format: "byte",
+							Format: "",
 						},
 					},
-					"labels": {
+					"body": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"taints": {
+					"channelMessageID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the node's taints.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alarmPolicyName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"alarmPolicyType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"receiverChannelName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"templateName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateName is the template under the channel used to render the message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"variables": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Variables are used to render the message again when it is retried.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"ip", "port", "username"},
+				Required: []string{"tenantID", "receiverName", "receiverChannel", "identity"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_MessageStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterProperty records the attribute information of the cluster.",
+				Description: "MessageStatus represents information about the status of a message.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"maxClusterServiceNum": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"maxNodePodNum": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "The last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"oversoldRatio": {
+					"deliveryPhase": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "DeliveryPhase indicates whether the message has been delivered. Messages without it are delivered.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is the number of failed attempts since the message was sent or re-sent.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nextAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextAttemptTime is when the message is retried.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts are the latest attempts to deliver the message.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/notify/v1.MessageDeliveryAttempt"),
 									},
 								},
 							},
@@ -47132,86 +48028,106 @@ func schema_tke_api_platform_v1_ClusterProperty(ref common.ReferenceCallback) co
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/notify/v1.MessageDeliveryAttempt"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_OnCallSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterResource records the current available and maximum resource quota information for the cluster.",
+				Description: "OnCallSchedule indicates the rotation of on-call receivers in a receiver group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"capacity": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Capacity represents the total resources of a cluster.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"allocatable": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allocatable represents the resources of a cluster that are available for scheduling. Defaults to Capacity.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"allocated": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired on-call schedule.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.OnCallScheduleSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.OnCallScheduleSpec"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_OnCallScheduleList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterSpec is a description of a cluster.",
+				Description: "OnCallScheduleList is the whole list of all on-call schedules which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of on-call schedules.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/notify/v1.OnCallSchedule"),
 									},
 								},
 							},
 						},
 					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.OnCallSchedule"},
+	}
+}
+
+func schema_tke_api_notify_v1_OnCallScheduleSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OnCallScheduleSpec is a description of an on-call schedule.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -47224,146 +48140,186 @@ func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common
 							Format: "",
 						},
 					},
-					"type": {
+					"receiverGroup": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ReceiverGroup is the receiver group whose receivers take turns to be on call in the order of receivers.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "StartTime is the beginning of the shift of the first receiver.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"networkType": {
+					"shiftSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ShiftSeconds is the duration of each shift. Defaults to 86400.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"networkDevice": {
+				},
+				Required: []string{"tenantID", "displayName", "receiverGroup", "startTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_notify_v1_Receiver(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Receiver indicates a message notification recipient, usually representing a user in the user system or a webhook service address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterCIDR": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceCIDR": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired receiver.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroup indicates multiple message recipients.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dnsDomain": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"publicAlternativeNames": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"features": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterFeature"),
+							Description: "Spec defines the desired receiver group.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"),
 						},
 					},
-					"properties": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroupSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroupList is the whole list of all receiver which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterProperty"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machines": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"dockerExtraArgs": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"kubeletExtraArgs": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "List of receiver groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/notify/v1.ReceiverGroup"),
 									},
 								},
 							},
 						},
 					},
-					"apiServerExtraArgs": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.ReceiverGroup"},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverGroupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverGroupSpec is a description of a receiver group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"controllerManagerExtraArgs": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"schedulerExtraArgs": {
+					"receivers": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -47373,180 +48329,6668 @@ func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
-					"clusterCredentialRef": {
+					"authGroup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "AuthGroup is the group of tke-auth, such as an LDAP or OIDC group, whose members are synchronized to the receivers of the group. The receivers and their email and mobile are kept updated from the identity provider.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"etcd": {
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_ReceiverList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ReceiverList is the whole list of all receiver which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Etcd holds configuration for etcd.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.Etcd"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"hostnameAsNodename": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If true will use hostname as nodename, if false will use machine IP as nodename.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"networkArgs": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"scalingMachines": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "List of receivers.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+										Ref: ref("tkestack.io/tke/api/notify/v1.Receiver"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"tenantID", "type", "version"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference", "tkestack.io/tke/api/platform/v1.ClusterFeature", "tkestack.io/tke/api/platform/v1.ClusterMachine", "tkestack.io/tke/api/platform/v1.ClusterProperty", "tkestack.io/tke/api/platform/v1.Etcd"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Receiver"},
 	}
 }
 
-func schema_tke_api_platform_v1_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_notify_v1_ReceiverSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterStatus represents information about the status of a cluster.",
+				Description: "ReceiverSpec is a description of a receiver.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"version": {
+					"displayName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"identities": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Identities represents the characteristics of the message recipient. The hash table key represents the message delivery channel id, and the value represents the user identification number in the channel. For example, if it is a short message sending channel, then the value is the user's mobile phone number; if it is a mail sending channel, then the value is the user's email address.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterCondition"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"message": {
+					"locale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Locale is the language of messages sent to the receiver, such as zh or en. The template is used as it is if it has no localization of the locale.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"authUser": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuthUser is the user of tke-auth which the receiver is synchronized from, the receivers synchronized are deleted when the user leaves all the synchronized groups.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_Template(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Template indicates the template used to send notifications under this channel.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired template.",
+							Ref:         ref("tkestack.io/tke/api/notify/v1.TemplateSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/notify/v1.TemplateSpec"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateDingTalk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateDingTalk indicates the template when sending a markdown message through the robot of DingTalk.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is shown in the conversation list and the notification.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the markdown content of the message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"title", "content"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateLark(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateLark indicates the template when sending a message card through the bot of Lark.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title is the header of the card.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the lark_md content of the card.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"color": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Color is the template color of the card header, such as red. Defaults to blue.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"title", "content"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateList is the whole list of all template which owned by a channel.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of templates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.Template"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/notify/v1.Template"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateLocalization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateLocalization indicates the translation of a template for the receivers of a locale. The non-empty fields override the corresponding fields of the template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"locale": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Locale is the language of the receivers, such as zh or en.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Header overrides the header of the text template.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body overrides the body of the text, sms and wechat templates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"title": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Title overrides the title of the dingtalk and lark templates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content overrides the content of the wecom, dingtalk, lark and voice templates.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"locale"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateOpsgenie(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateOpsgenie indicates the template of the alerts created in Opsgenie.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the title of the alert.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is the detail of the alert.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"message"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplatePagerDuty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplatePagerDuty indicates the template of the incidents triggered in PagerDuty.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"summary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Summary is the title of the incident.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the affected system, such as the cluster. Defaults to tke.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"summary"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateSpec is a description of a template.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"keys": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tencentCloudSMS": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS"),
+						},
+					},
+					"wechat": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateWechat"),
+						},
+					},
+					"text": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateText"),
+						},
+					},
+					"weCom": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateWeCom"),
+						},
+					},
+					"dingTalk": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateDingTalk"),
+						},
+					},
+					"lark": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateLark"),
+						},
+					},
+					"localizations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Localizations are used instead of the template for the receivers of the locales.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/notify/v1.TemplateLocalization"),
+									},
+								},
+							},
+						},
+					},
+					"pagerDuty": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplatePagerDuty"),
+						},
+					},
+					"opsgenie": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateOpsgenie"),
+						},
+					},
+					"voice": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/notify/v1.TemplateVoice"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "displayName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/notify/v1.TemplateDingTalk", "tkestack.io/tke/api/notify/v1.TemplateLark", "tkestack.io/tke/api/notify/v1.TemplateLocalization", "tkestack.io/tke/api/notify/v1.TemplateOpsgenie", "tkestack.io/tke/api/notify/v1.TemplatePagerDuty", "tkestack.io/tke/api/notify/v1.TemplateTencentCloudSMS", "tkestack.io/tke/api/notify/v1.TemplateText", "tkestack.io/tke/api/notify/v1.TemplateVoice", "tkestack.io/tke/api/notify/v1.TemplateWeCom", "tkestack.io/tke/api/notify/v1.TemplateWechat"},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateTencentCloudSMS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateTencentCloudSMS indicates the template used when sending text messages using Tencent Cloud SMS Gateway. The template must be approved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"templateID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"sign": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"templateID"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateText(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateText indicates the template used to send notifications using other non-templated channels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"header": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"body"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateVoice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateVoice indicates the template of the voice calls.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"templateID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateID is the voice template of Tencent Cloud VMS, whose parameters are filled by the variables referenced in the content in order.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the text read out in the call.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"content"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateWeCom(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateWeCom indicates the template when sending a markdown message through the robot of WeCom.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the markdown content of the message.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"content"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_notify_v1_TemplateWechat(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TemplateWechat indicates the template when sending a text message using the WeChat public account. The template must be approved and registered.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"templateID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TemplateID indicates the template id of the template message notification. See https://mp.weixin.qq.com/wiki?t=resource/res_main&id=mp1421140183",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL indicates the web address of the user who clicked the notification in WeChat.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"miniProgramAppID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MiniProgramAppID indicates the unique identification number of the WeChat applet that the user clicked on the notification in WeChat.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"miniProgramPagePath": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"templateID"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_AddonCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddonCondition is a lifecycle condition of an addon, the same types of conditions are reported by all addons.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition, Installed, Healthy or Upgraded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the addon version which the condition is observed for, such as the target version of an upgrade.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time we probed the condition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human-readable message indicating details about last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_AddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddonSpec describes the attributes on a Addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_AuditLogBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditLogBackend describes the log file and rotation of audit events.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the log file path, default is /var/log/kubernetes/audit/audit.log.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxAge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAge is the maximum number of days to retain old log files.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackup is the maximum number of old log files to retain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSize is the maximum size in megabytes of log file before it gets rotated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_AuditWebhookBackend(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhookBackend describes where the audit events are sent to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is the address of audit component, default is the platform audit address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_AuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"builtin": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr"),
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.BuiltinAuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.ExternalAuthzWebhookAddr"},
+	}
+}
+
+func schema_tke_api_platform_v1_BuiltinAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CRDConflict(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CRDConflict describes a custom resource definition in cluster which conflicts with the addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the custom resource definition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason of the conflict.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a human readable description of the conflict.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner is the current owner of the custom resource definition, such as a helm release or another addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"servedVersions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServedVersions are the versions served by the custom resource definition.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"requiredVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RequiredVersion is the version required by the addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "reason"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperator(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperator is a operator to manages CSI external components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of storage operator.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CSIOperatorSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CSIOperatorSpec", "tkestack.io/tke/api/platform/v1.CSIOperatorStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorList is the whole list of all storage operators which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of storage operators.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperator"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CSIOperator"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorSpec describes the attributes of a storage operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version of the CSI operator.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"crdConflictPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIOperatorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIOperatorStatus is information about the current status of a storage operator.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"storageVendorVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StorageVendorVersion will be set to the config version of the storage vendor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the tapp controller of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"crdConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CRDConflict"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.CRDConflict"},
+	}
+}
+
+func schema_tke_api_platform_v1_CSIProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CalicoNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CalicoNetwork describes the options of Calico.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode is the routing mode of Calico, defaults to VXLAN.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"asNumber": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ASNumber is the BGP AS number of nodes in BGP mode, defaults to 64512.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"mtu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MTU of the pod interfaces, defaults to 1450 in VXLAN mode and 1500 in BGP mode.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CertificateExpiration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertificateExpiration is the expiration of a certificate on a master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the file name relative to the certificates dir, such as apiserver.crt, or the absolute path of the kubelet client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the IP of master.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notAfter": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "node", "notAfter"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_CertificateRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertificateRotation controls the rotation of the certificates generated by kubeadm.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode defaults to Auto.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"renewBefore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RenewBefore is how long before the expiration certificates are rotated in Auto mode, defaults to 720h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"rotateRequestedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RotateRequestedAt requests a rotation if it is after the last rotation.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_CertificatesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CertificatesStatus records the expiration of the certificates on masters.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificates": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CertificateExpiration"),
+									},
+								},
+							},
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.CertificateExpiration"},
+	}
+}
+
+func schema_tke_api_platform_v1_CiliumNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CiliumNetwork describes the options of Cilium.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeProxyReplacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeProxyReplacement replaces kube-proxy with the eBPF service load balancing of Cilium, kube-proxy is not installed if it is enabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_Cluster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Cluster is a Kubernetes cluster in",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterSpec", "tkestack.io/tke/api/platform/v1.ClusterStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddon contains the Addon component for the current kubernetes cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of addons in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterAddonSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddonStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonSpec", "tkestack.io/tke/api/platform/v1.ClusterAddonStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonList is the whole list of all ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ClusterAddon",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddon"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddon"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonSpec indicates the specifications of the ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddonLevel is level of cluster addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "level", "version"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonStatus is information about the current status of a ClusterAddon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonType records the all addons of cluster available.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addon type, one of Helm, PersistentEvent or LogCollector etc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"level": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddonLevel is level of cluster addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latestVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatestVersion is latest version of the addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is desc of the addon.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"compatibleClusterType": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"dependencies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dependencies are the addon types installed in the cluster before the addon, which are created automatically if missing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"type", "level", "latestVersion"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddonTypeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddonType"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterAddonType"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAddress contains information for the cluster's address.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cluster address type, one of Public, ExternalIP or InternalIP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The cluster address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"type", "host", "port", "path"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterApplyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"notUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterAudit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAudit describes the audit policy and backends of apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Policy is the audit policy in yaml, the platform default policy is used if it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log writes the audit events to local files of master nodes.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.AuditLogBackend"),
+						},
+					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook sends the audit events to the platform audit component.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.AuditWebhookBackend"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.AuditLogBackend", "tkestack.io/tke/api/platform/v1.AuditWebhookBackend"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterComponent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterComponent records the number of copies of each component of the cluster master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"),
+						},
+					},
+				},
+				Required: []string{"type", "replicas"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ClusterComponentReplicas"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterComponentReplicas(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterComponentReplicas records the number of copies of each state of each component of the cluster master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"desired": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"current": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"available": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"updated": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"desired", "current", "available", "updated"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCondition contains details for the current condition of this cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastProbeTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time we probed the condition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Human-readable message indicating details about last transition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "status"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCredential records the credential information needed to access the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"etcdCACert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For TKE in global reuse",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"etcdCAKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"etcdAPIClientCert": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"etcdAPIClientKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"caCert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For connect the cluster",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"caKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"clientCert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver X509 auth",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"clientKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver X509 auth",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver token auth",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bootstrapToken": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kubeadm init or join",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificateKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kubeadm init or join",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints are the host:port of kube-apiservers, such as each master of cluster without VIP, which clients fail over to when the cluster address is unreachable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"etcdBackupSecretAccessKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For uploading etcd snapshots to the S3 compatible storage of EtcdBackup",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"registryPasswords": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegistryPasswords are the passwords of the registries in ContainerRegistries of cluster by registry, which are provided through ClusterCredentialRef.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterCredentialList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterCredentialList is the whole list of all ClusterCredential which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterCredential"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterCredential"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterDNS customizes the Corefile of CoreDNS and the autoscaling of its replicas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"stubDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StubDomains forward the queries of the domains to their own nameservers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.DNSStubDomain"),
+									},
+								},
+							},
+						},
+					},
+					"upstreamNameservers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpstreamNameservers are the nameservers for the queries out of the cluster domain and stub domains, defaults to the /etc/resolv.conf of nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"cacheTTL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheTTL is the max TTL in seconds of the cached records, defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaler deploys dns-autoscaler which scales the replicas of CoreDNS in proportion to the nodes and cores of cluster.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.DNSAutoscaler"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.DNSAutoscaler", "tkestack.io/tke/api/platform/v1.DNSStubDomain"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterFeature records the features that are enabled by the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipvs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"publicLB": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"internalLB": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"gpuType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"enableMasterSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"ha": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.HA"),
+						},
+					},
+					"skipConditions": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"files": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.File"),
+									},
+								},
+							},
+						},
+					},
+					"hooks": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"csiOperator": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.CSIOperatorFeature"),
+						},
+					},
+					"authzWebhookAddr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "For kube-apiserver authorization webhook",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.AuthzWebhookAddr"),
+						},
+					},
+					"enableMetricsServer": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"ipv6DualStack": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"enableCilium": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"upgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upgrade control upgrade process.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Upgrade"),
+						},
+					},
+					"sandboxRuntime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SandboxRuntime installs a sandboxed container runtime on the labeled machines.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.SandboxRuntime"),
+						},
+					},
+					"networkEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkEncryption encrypts the pod traffic between nodes.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.NetworkEncryption"),
+						},
+					},
+					"audit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Audit configures the audit policy and backends of apiserver.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterAudit"),
+						},
+					},
+					"etcdBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EtcdBackup takes etcd snapshots before control plane changes such as upgrade.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.EtcdBackup"),
+						},
+					},
+					"secretsEncryption": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretsEncryption encrypts secrets at rest in etcd.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.SecretsEncryption"),
+						},
+					},
+					"certificateRotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateRotation controls the rotation of control plane certificates, they are rotated automatically before expiration if not specified.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CertificateRotation"),
+						},
+					},
+					"kubeletServerTLSBootstrap": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletServerTLSBootstrap makes kubelets request serving certificates signed by the cluster CA, which are approved by platform after validating the node addresses.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cgroupDriver": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CgroupDriver is the cgroup driver of kubelet and container runtime, systemd or cgroupfs. It defaults to systemd for new clusters, and cgroupfs if not set for compatibility.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"systemTuning": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemTuning is applied when nodes are created and kept enforced afterwards.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.SystemTuning"),
+						},
+					},
+					"timeSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeSync installs and configures chrony on nodes, and blocks nodes whose clock skew exceeds the threshold from joining the cluster.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.TimeSync"),
+						},
+					},
+					"serviceOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceOverrides injects environment variables and systemd drop-in overrides into kubelet and the container runtime on all nodes. Static pods are customized with StaticPodOverrides instead.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ServiceOverrides"),
+						},
+					},
+					"galaxyNetwork": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GalaxyNetwork is the underlay network of Galaxy for floating IP pods, which is rendered into the galaxy and floatingip ConfigMaps and kept reconciled.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.GalaxyNetwork"),
+						},
+					},
+					"calico": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Calico is the options of Calico, which is used if the NetworkType of cluster is Calico.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CalicoNetwork"),
+						},
+					},
+					"cilium": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cilium is the options of Cilium, which is used if the NetworkType of cluster is Cilium.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CiliumNetwork"),
+						},
+					},
+					"imageAdmission": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageAdmission deploys an admission webhook which rejects pods with images out of the approved registries.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ImageAdmission"),
+						},
+					},
+					"enableNetworkCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnableNetworkCheck verifies the node-to-node, pod-to-pod, pod-to-service and DNS connectivity with probe pods after the cluster is installed, and fails the creation if any check fails.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"kubeProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeProxy configures the proxy mode, IPVS scheduler and strict ARP of kube-proxy.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.KubeProxy"),
+						},
+					},
+					"dns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS manages the Corefile of CoreDNS and deploys dns-autoscaler, it is reapplied after upgrades which restore the default Corefile.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterDNS"),
+						},
+					},
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicy installs default-deny NetworkPolicies for the namespaces and checks if the CNI enforces NetworkPolicy, the result is reported in status.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.NetworkPolicyFeature"),
+						},
+					},
+					"gpuManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPUManager configures the share policy, memory unit and nodes of GPUManager, which takes effect when GPUType is Virtual.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.GPUManagerFeature"),
+						},
+					},
+					"migStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MIGStrategy is the MIG strategy of NVIDIA device plugin for physical GPU, none, single or mixed, defaults to none. MIG manager is deployed to reconcile the MIG geometry of GPUNodeConfigs if it is not none.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.AuthzWebhookAddr", "tkestack.io/tke/api/platform/v1.CSIOperatorFeature", "tkestack.io/tke/api/platform/v1.CalicoNetwork", "tkestack.io/tke/api/platform/v1.CertificateRotation", "tkestack.io/tke/api/platform/v1.CiliumNetwork", "tkestack.io/tke/api/platform/v1.ClusterAudit", "tkestack.io/tke/api/platform/v1.ClusterDNS", "tkestack.io/tke/api/platform/v1.EtcdBackup", "tkestack.io/tke/api/platform/v1.File", "tkestack.io/tke/api/platform/v1.GPUManagerFeature", "tkestack.io/tke/api/platform/v1.GalaxyNetwork", "tkestack.io/tke/api/platform/v1.HA", "tkestack.io/tke/api/platform/v1.ImageAdmission", "tkestack.io/tke/api/platform/v1.KubeProxy", "tkestack.io/tke/api/platform/v1.NetworkEncryption", "tkestack.io/tke/api/platform/v1.NetworkPolicyFeature", "tkestack.io/tke/api/platform/v1.SandboxRuntime", "tkestack.io/tke/api/platform/v1.SecretsEncryption", "tkestack.io/tke/api/platform/v1.ServiceOverrides", "tkestack.io/tke/api/platform/v1.SystemTuning", "tkestack.io/tke/api/platform/v1.TimeSync", "tkestack.io/tke/api/platform/v1.Upgrade"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterHealth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterHealth is the weighted score of the health dimensions of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"score": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Score ranges from 0 to 100, the lower the riskier.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dimensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dimensions are the scores of ControlPlane, Nodes, Addons, Certificates and Capacity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterHealthDimension"),
+									},
+								},
+							},
+						},
+					},
+					"lastUpdateTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"score"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.ClusterHealthDimension"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterHealthDimension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterHealthDimension is the score of a health dimension of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"score": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Score ranges from 0 to 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"weight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weight is the percentage of the dimension in the composite score.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message describes the problems which lower the score.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "score", "weight"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterImage is an image run by a component in the kube-system namespace of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"component": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Component is the workload running the image, such as deployment/coredns, daemonset/kube-proxy or pod/kube-apiserver for static pods.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Container is the name of container, init containers are included.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the image:tag in the pod spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest is the repository digest reported by container runtime, which is empty before the container starts or when the runtime does not report it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"component", "container", "image"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterList is the whole list of all clusters which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Cluster"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Cluster"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterLogsOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterLogsOptions is the query options to stream the logs of a container of cluster through the platform api.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pod is the name of the pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The container for which to stream logs. Defaults to only container if there is one container in the pod.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"follow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Follow the log stream of the pod. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"previous": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Return previous terminated container logs. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"sinceSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A relative time in seconds before the current time from which to show logs. Only one of sinceSeconds or sinceTime may be specified.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"sinceTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "An RFC3339 timestamp from which to show logs. Only one of sinceSeconds or sinceTime may be specified.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"timestamps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, add an RFC3339 timestamp at the beginning of every line of log output. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"tailLines": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the number of lines from the end of the logs to show, which is limited by the platform api.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"limitBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set, the number of bytes to read from the server before terminating the log output, which is limited by the platform api.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterMachine is the master machine definition of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"passPhrase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the node's taints.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"ip", "port", "username"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Taint"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOperation is a mutation of the resources in a cluster which is queued while the cluster is unreachable, it is applied once the cluster is reachable again or expired.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the request of ClusterOperation.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterOperationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterOperationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.ClusterOperationSpec", "tkestack.io/tke/api/platform/v1.ClusterOperationStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterOperationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOperationList is the whole list of all ClusterOperations which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of ClusterOperations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterOperation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ClusterOperation"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterOperationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOperationSpec describes the request of a ClusterOperation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"verb": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Verb is the HTTP method of the request, POST, PUT, PATCH or DELETE.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the request URI on the kube-apiserver of cluster with the query.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"contentType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContentType is the content type of body.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"body": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Body is the request body.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Username is the user who submitted the operation, the operation is applied as the user.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups are the groups of the user.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"expireTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpireTime is the time after which the operation is expired if the cluster is still unreachable.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "verb", "path", "username", "expireTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterOperationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOperationStatus is information about the current status of a ClusterOperation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts is the number of times the operation is sent to the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastAttemptTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the operation is sent to the cluster.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"code": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Code is the HTTP status code returned by the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about the last attempt.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the phase transitioned from one to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterProperty(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterProperty records the attribute information of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxClusterServiceNum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"maxNodePodNum": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"oversoldRatio": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterResource records the current available and maximum resource quota information for the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity represents the total resources of a cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable represents the resources of a cluster that are available for scheduling. Defaults to Capacity.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"allocated": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterSpec is a description of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"finalizers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"networkType": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"networkDevice": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"serviceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicAlternativeNames": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterFeature"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterProperty"),
+						},
+					},
+					"machines": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+									},
+								},
+							},
+						},
+					},
+					"dockerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"kubeletExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"apiServerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"controllerManagerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"schedulerExtraArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"clusterCredentialRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Etcd holds configuration for etcd.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Etcd"),
+						},
+					},
+					"hostnameAsNodename": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true will use hostname as nodename, if false will use machine IP as nodename.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"networkArgs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"scalingMachines": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterMachine"),
+									},
+								},
+							},
+						},
+					},
+					"controlPlaneOverrides": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneOverrides injects extra volumes, envs and sidecars into the static pod manifests of control plane components.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ControlPlaneOverrides"),
+						},
+					},
+					"containerRegistries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerRegistries configures the registry mirrors, insecure registries and registry credentials of the container runtime on all nodes.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ContainerRegistryConfig"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "type", "version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LocalObjectReference", "tkestack.io/tke/api/platform/v1.ClusterFeature", "tkestack.io/tke/api/platform/v1.ClusterMachine", "tkestack.io/tke/api/platform/v1.ClusterProperty", "tkestack.io/tke/api/platform/v1.ContainerRegistryConfig", "tkestack.io/tke/api/platform/v1.ControlPlaneOverrides", "tkestack.io/tke/api/platform/v1.Etcd"},
+	}
+}
+
+func schema_tke_api_platform_v1_ClusterStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterStatus represents information about the status of a cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"locked": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterCondition"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about why the cluster is in this condition.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A brief CamelCase message indicating details about why the cluster is in this state.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "List of addresses reachable to the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddress"),
+									},
+								},
+							},
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterResource"),
+						},
+					},
+					"components": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterComponent"),
+									},
+								},
+							},
+						},
+					},
+					"serviceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nodeCIDRMaskSize": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"dnsIP": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"registryIPs": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"secondaryServiceCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"secondaryClusterCIDR": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nodeCIDRMaskSizeIPv4": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"nodeCIDRMaskSizeIPv6": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"kubeVendor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"progress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Progress records the phases progress of the current operation.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Progress"),
+						},
+					},
+					"etcdSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EtcdSnapshot records the latest etcd snapshot taken before control plane changes.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.EtcdSnapshot"),
+						},
+					},
+					"certificates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Certificates records the expiration of control plane certificates.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CertificatesStatus"),
+						},
+					},
+					"etcdMaintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EtcdMaintenance records the latest compaction and defragmentation of local etcd.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.EtcdMaintenanceStatus"),
+						},
+					},
+					"upgradeCanary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeCanary records the canary master of the upgrade in progress.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.UpgradeCanaryStatus"),
+						},
+					},
+					"health": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Health is the composite health score of cluster, which is used to sort clusters by risk.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ClusterHealth"),
+						},
+					},
+					"images": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Images lists the images running in kube-system, which is refreshed by the health check of cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterImage"),
+									},
+								},
+							},
+						},
+					},
+					"networkPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicy reports whether the CNI enforces NetworkPolicy.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.NetworkPolicyStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.CertificatesStatus", "tkestack.io/tke/api/platform/v1.ClusterAddress", "tkestack.io/tke/api/platform/v1.ClusterComponent", "tkestack.io/tke/api/platform/v1.ClusterCondition", "tkestack.io/tke/api/platform/v1.ClusterHealth", "tkestack.io/tke/api/platform/v1.ClusterImage", "tkestack.io/tke/api/platform/v1.ClusterResource", "tkestack.io/tke/api/platform/v1.EtcdMaintenanceStatus", "tkestack.io/tke/api/platform/v1.EtcdSnapshot", "tkestack.io/tke/api/platform/v1.NetworkPolicyStatus", "tkestack.io/tke/api/platform/v1.Progress", "tkestack.io/tke/api/platform/v1.UpgradeCanaryStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMap holds configuration data for tke to consume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"binaryData": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "byte",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_tke_api_platform_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ConfigMaps.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ConfigMap"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ConfigMap"},
+	}
+}
+
+func schema_tke_api_platform_v1_ContainerRegistryConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerRegistryConfig describes the registries used by the container runtime of nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mirrors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirrors are the registry mirror endpoints, such as https://mirror.example.com.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"insecureRegistries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InsecureRegistries are pulled over http or without verifying certificates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"auths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Auths are the credentials used to pull images from private registries.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.RegistryAuth"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.RegistryAuth"},
+	}
+}
+
+func schema_tke_api_platform_v1_ControlPlaneOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneOverrides customizes the static pod manifests of control plane components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiServer": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StaticPodOverride"),
+						},
+					},
+					"controllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StaticPodOverride"),
+						},
+					},
+					"scheduler": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StaticPodOverride"),
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Etcd is ignored if the etcd is external.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.StaticPodOverride"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.StaticPodOverride"},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPA is a new kubernetes workload.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of CronHPA.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.CronHPASpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.CronHPAStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CronHPASpec", "tkestack.io/tke/api/platform/v1.CronHPAStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPAList is the whole list of all CronHPAs which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of CronHPAs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CronHPA"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CronHPA"},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPAProxyOptions is the query options to a kube-apiserver proxy call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPASpec describes the attributes on a CronHPA.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"crdConflictPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how to handle the components in cluster which drift from the desired state, defaults to Repair.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeZone is the IANA time zone which the schedules of CronHPAs run in, such as Asia/Shanghai, defaults to the local time of cron-hpa-controller.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CronHPAStatus is information about the current status of a CronHPA.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the CronHPA of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"crdConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CRDConflict"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the lifecycle conditions of the addon.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.AddonCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.AddonCondition", "tkestack.io/tke/api/platform/v1.CRDConflict"},
+	}
+}
+
+func schema_tke_api_platform_v1_DNSAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSAutoscaler describes the linear parameters of dns-autoscaler, the replicas are max(ceil(cores / coresPerReplica), ceil(nodes / nodesPerReplica)) bounded by min and max.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"coresPerReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CoresPerReplica defaults to 256.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodesPerReplica": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodesPerReplica defaults to 16.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"min": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Min is the minimum replicas, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"max": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Max is the maximum replicas, unlimited if zero.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"preventSinglePointFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PreventSinglePointFailure keeps at least 2 replicas if the cluster has more than one node.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_DNSStubDomain(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DNSStubDomain is a domain resolved by its own nameservers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain is the zone to forward, such as example.com.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nameservers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nameservers are the IP addresses with optional ports of the nameservers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"domain", "nameservers"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_DevicePlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DevicePlugin deploys the Kubernetes device plugin of a device class, such as RDMA, FPGA and QAT, and labels the nodes advertising the devices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of DevicePlugin.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.DevicePluginSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.DevicePluginStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.DevicePluginSpec", "tkestack.io/tke/api/platform/v1.DevicePluginStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_DevicePluginList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DevicePluginList is the whole list of all DevicePlugins which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of DevicePlugins",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.DevicePlugin"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.DevicePlugin"},
+	}
+}
+
+func schema_tke_api_platform_v1_DevicePluginSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DevicePluginSpec describes the attributes on a DevicePlugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deviceClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceClass is the class of devices, the built-in classes rdma, fpga and qat have default image, resource prefix and host paths, other classes must specify the image and resource prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image of the device plugin, overrides the default image of the built-in class. The image without registry is pulled from the registry of platform.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirrorImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MirrorImage copies the image to the registry of platform before deploying, then the nodes pull the image without accessing the source registry.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"resourcePrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourcePrefix is the prefix of the extended resources advertised by the device plugin, such as rdma/ and qat.intel.com/, which is used to label the nodes with the devices.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args overrides the default arguments of the device plugin.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the content of the config file of the device plugin, which is mounted at ConfigPath.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigPath is the path of the config file in the container, defaults to the path of the built-in class.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostPaths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostPaths are mounted into the device plugin along with the default host paths of the built-in class and the device plugin directory of kubelet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes to deploy the device plugin, all nodes if not specified.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "version", "deviceClass"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_DevicePluginStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DevicePluginStatus is information about the current status of a DevicePlugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the DevicePlugin of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image is the image deployed, which is the mirrored image if MirrorImage is set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes are the nodes advertising the devices, which are labeled with device.platform.tkestack.io/<class>=true.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the lifecycle conditions of the addon.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.AddonCondition"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.AddonCondition"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGateway is a managed egress gateway which lets the workloads present stable source IPs to the outside of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of EgressGateway.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.EgressGatewaySpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.EgressGatewayStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.EgressGatewaySpec", "tkestack.io/tke/api/platform/v1.EgressGatewayStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressGatewayList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewayList is the whole list of all EgressGateways which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of EgressGateways",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.EgressGateway"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.EgressGateway"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressGatewaySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewaySpec describes the attributes on a EgressGateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ipPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPPools are the static egress IPs and the namespaces using them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.EgressIPPool"),
+									},
+								},
+							},
+						},
+					},
+					"failoverTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FailoverTimeout is how long a gateway node is not ready before its egress IPs are moved to the other gateway nodes of the pool, which is 40s by default.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "tkestack.io/tke/api/platform/v1.EgressIPPool"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressGatewayStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressGatewayStatus is information about the current status of a EgressGateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the EgressGateway of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"assignments": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Assignments are the gateway nodes holding the egress IPs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.EgressIPAssignment"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.EgressIPAssignment"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressIPAssignment(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressIPAssignment records the gateway node which holds an egress IP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pool is the name of the pool the IP belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the gateway node holding the IP, which is empty if no gateway node of the pool is ready.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the IP moved from one node to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"pool", "ip"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_EgressIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EgressIPPool binds a set of static egress IPs to namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ips": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPs are the source IPs which the traffic leaving the cluster from the namespaces is translated to. They are assigned to the gateway nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"namespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespaces are the namespaces whose egress traffic uses the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects are the projects whose namespaces use the pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the gateway nodes which hold the egress IPs.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"allNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllNamespaces makes the pool the default of the namespaces not using other pools, so the egress traffic of all pods goes through the gateway nodes of it. Only one pool can be the default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "ips"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_Etcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Etcd contains elements describing Etcd configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"local": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Local provides configuration knobs for configuring the local etcd instance Local and External are mutually exclusive",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LocalEtcd"),
+						},
+					},
+					"external": {
+						SchemaProps: spec.SchemaProps{
+							Description: "External describes how to connect to an external etcd cluster Local and External are mutually exclusive",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ExternalEtcd"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ExternalEtcd", "tkestack.io/tke/api/platform/v1.LocalEtcd"},
+	}
+}
+
+func schema_tke_api_platform_v1_EtcdBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdBackup describes where the etcd snapshots are saved before control plane changes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the directory on the first master to save snapshots, default is /var/lib/etcd-backup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"s3": {
+						SchemaProps: spec.SchemaProps{
+							Description: "S3 uploads the snapshots to S3 compatible storage such as COS.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.S3Storage"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.S3Storage"},
+	}
+}
+
+func schema_tke_api_platform_v1_EtcdMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdMaintenance describes the compaction and defragmentation of local etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"interval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Interval is the duration between two maintenances, defaults to 168h.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"runRequestedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunRequestedAt requests a maintenance if it is after the last maintenance.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_EtcdMaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdMaintenanceStatus records the latest maintenance of local etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRunTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRunTime is the time when the latest maintenance finished.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"compactedRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompactedRevision is the revision which etcd is compacted to.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_EtcdSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdSnapshot records an etcd snapshot which can be used to restore the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the path on master or the url of object storage.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is the control plane change which the snapshot is taken for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the kubernetes version of the cluster when the snapshot is taken.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTime": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"location"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ExternalAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"ip", "port"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ExternalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints of etcd members. Required for ExternalEtcd.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"caFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CAFile is an SSL Certificate Authority file used to secure etcd communication. Required if using a TLS connection.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertFile is an SSL certification file used to secure etcd communication. Required if using a TLS connection.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"keyFile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KeyFile is an SSL key file used to secure etcd communication. Required if using a TLS connection.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"endpoints", "caFile", "certFile", "keyFile"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"src": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"dst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Only support regular file",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"src", "dst"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_FloatingIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPPool describes the floating IPs of a subnet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"subnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subnet is the CIDR of the floating IPs, such as 10.0.0.0/24.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSubnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSubnet is the CIDR of the nodes which can use the pool, it defaults to Subnet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"gateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gateway is the gateway of Subnet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vlan": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VLAN is the VLAN ID of Subnet, 0 means untagged.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ips": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPs are the IPs or IP ranges of the pool, such as 10.0.0.10 or 10.0.0.20~10.0.0.100.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"subnet", "gateway", "ips"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_FloatingIPReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPReservation reserves a floating IP of the galaxy-ipam addon for a workload ahead of time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the IP and the workload of FloatingIPReservation.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.FloatingIPReservationSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.FloatingIPReservationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.FloatingIPReservationSpec", "tkestack.io/tke/api/platform/v1.FloatingIPReservationStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_FloatingIPReservationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPReservationList is the whole list of all FloatingIPReservations which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of FloatingIPReservations",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.FloatingIPReservation"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.FloatingIPReservation"},
+	}
+}
+
+func schema_tke_api_platform_v1_FloatingIPReservationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPReservationSpec describes the attributes on a FloatingIPReservation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IP is the floating IP to reserve, which must be in the floating IP pools of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the workload.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workloadKind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadKind is the kind of the workload, Deployment, StatefulSet or TApp.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workloadName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkloadName is the name of the workload.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodName is the pod of StatefulSet or TApp using the IP, which is required since the pods of them keep their own IPs. The IP is shared by the pods of Deployment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "ip", "namespace", "workloadKind", "workloadName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_FloatingIPReservationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPReservationStatus is information about the current status of a FloatingIPReservation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subnet is the subnet of the floating IP pool which the IP belongs to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the phase transitioned from one to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUMIGDeviceConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUMIGDeviceConfig partitions the selected GPUs of a node with the same MIG slices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"devices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Devices are the indexes of the GPUs, all the GPUs of the node if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"integer"},
+										Format: "int32",
+									},
+								},
+							},
+						},
+					},
+					"slices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Slices are the MIG devices created on each of the GPUs, MIG is disabled on the GPUs if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.GPUMIGSlice"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.GPUMIGSlice"},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUMIGProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUMIGProfile is the MIG devices of a profile advertised by a node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the MIG profile, such as 1g.5gb.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the extended resource requested by pods for the profile, such as nvidia.com/mig-1g.5gb.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity is the number of MIG devices of the profile.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"allocatable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allocatable is the number of MIG devices of the profile available for pods.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"profile", "resourceName", "capacity", "allocatable"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUMIGSlice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUMIGSlice is a number of MIG devices with the same profile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profile is the MIG profile, such as 1g.5gb and 3g.20gb.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of MIG devices created on each GPU.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"profile", "count"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUManagerFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUManagerFeature configures the vGPU scheduling of GPUManager deployed for the clusters with virtual GPU.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sharePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharePolicy is Fractional or Exclusive, defaults to Fractional.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memoryUnitMiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryUnitMiB is the size in MiB of one unit of tencent.com/vcuda-memory, defaults to 256.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the GPU nodes to run GPUManager in addition to the nodes with GPU driver installed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUNodeConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUNodeConfig declares the MIG geometry of the GPUs of a node, which is reconciled by NVIDIA MIG manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the node and the MIG geometry of its GPUs.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.GPUNodeConfigSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.GPUNodeConfigStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.GPUNodeConfigSpec", "tkestack.io/tke/api/platform/v1.GPUNodeConfigStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUNodeConfigList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUNodeConfigList is the whole list of all GPUNodeConfigs which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of GPUNodeConfigs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.GPUNodeConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.GPUNodeConfig"},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUNodeConfigSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUNodeConfigSpec describes the attributes on a GPUNodeConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the node with MIG capable GPUs, such as A100 and A30.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migDevices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MIGDevices partitions the GPUs into MIG devices, MIG is disabled on all the GPUs of the node if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.GPUMIGDeviceConfig"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName", "nodeName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.GPUMIGDeviceConfig"},
+	}
+}
+
+func schema_tke_api_platform_v1_GPUNodeConfigStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GPUNodeConfigStatus is information about the current status of a GPUNodeConfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"profiles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Profiles are the MIG profiles discovered on the node.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.GPUMIGProfile"),
+									},
+								},
+							},
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The last time the phase transitioned from one to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.GPUMIGProfile"},
+	}
+}
+
+func schema_tke_api_platform_v1_GalaxyNetwork(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GalaxyNetwork describes the underlay network of Galaxy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"device": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Device is the host interface of the underlay network, which may be a bond interface such as bond0. It defaults to the NetworkDevice of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIPPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIPPools are the IP pools allocated to floating IP pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.FloatingIPPool"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.FloatingIPPool"},
+	}
+}
+
+func schema_tke_api_platform_v1_HA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tke": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.TKEHA"),
+						},
+					},
+					"thirdParty": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.ThirdPartyHA"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.TKEHA", "tkestack.io/tke/api/platform/v1.ThirdPartyHA"},
+	}
+}
+
+func schema_tke_api_platform_v1_Helm(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Helm is a kubernetes package manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.HelmSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.HelmStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.HelmSpec", "tkestack.io/tke/api/platform/v1.HelmStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_HelmList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmList is the whole list of all helms which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of Helms",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Helm"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Helm"},
+	}
+}
+
+func schema_tke_api_platform_v1_HelmProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmProxyOptions is the query options to a Helm-api proxy call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URL path to use for the current proxy request to helm-api.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_HelmSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmSpec describes the attributes on a Helm.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_HelmStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HelmStatus is information about the current status of a Helm.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_IPAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAM is a scheduler plugin for assigning IP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.IPAMSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.IPAMStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.IPAMSpec", "tkestack.io/tke/api/platform/v1.IPAMStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_IPAMList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAMList is the whole list of all IPAMs which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of IPAMs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.IPAM"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.IPAM"},
+	}
+}
+
+func schema_tke_api_platform_v1_IPAMProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAMProxyOptions is the query options to a ipam-api proxy call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URL path to use for the current proxy request to ipam-api.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_IPAMSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAMSpec describes the attributes on a IPAM.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_IPAMStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "IPAMStatus is information about the current status of a IPAM.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_ImageAdmission(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageAdmission restricts the images of pods in cluster to approved registries.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode of the webhook, defaults to Enforce.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedRegistries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AllowedRegistries are the image prefixes allowed in all namespaces, such as registry.example.com/ or docker.io/library/.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"projects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Projects are the additional image prefixes allowed in namespaces of the projects.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ProjectImageAdmission"),
+									},
+								},
+							},
+						},
+					},
+					"exemptNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExemptNamespaces are the namespaces not checked by the webhook.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.ProjectImageAdmission"},
+	}
+}
+
+func schema_tke_api_platform_v1_KMSPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSPlugin describes the KMS plugin which apiserver calls to encrypt data keys.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the unix socket of the KMS plugin, such as unix:///var/run/kmsplugin/socket.sock.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cacheSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CacheSize is the number of data encryption keys cached in memory.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "endpoint"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_KubeProxy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeProxy describes the options of kube-proxy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode of kube-proxy, defaults to ipvs if IPVS feature is enabled, otherwise iptables.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipvsScheduler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPVSScheduler is the scheduler of IPVS virtual servers, such as rr, wrr, lc, wlc, sh and dh, defaults to rr. Only used in ipvs mode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"strictARP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StrictARP makes nodes not answer the ARP queries for the addresses on kube-ipvs0, which is required by MetalLB in layer 2 mode. Only used in ipvs mode.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_KubeletConfigurationOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfigurationOverrides overrides the fields of kubelet configuration rendered for all nodes of cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxPods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxPods is the number of pods that can run on the node.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionHard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionHard is a map of signal names to quantities that defines hard eviction thresholds. For example: {\"memory.available\": \"300Mi\"}.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"reservedCPUs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedCPUs is the cpu list reserved for the system and kubernetes components. For example: \"0-1\" or \"0,4\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topologyManagerPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TopologyManagerPolicy is the policy of topology manager, one of none, best-effort, restricted and single-numa-node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCF(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCF is a kubernetes load balancer manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LBCFSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.LBCFStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.LBCFSpec", "tkestack.io/tke/api/platform/v1.LBCFStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCFHealthCheck(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCFHealthCheck is the default health check of the backends registered to load balancers by LBCF drivers.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the HTTP path to probe, TCP is probed if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"intervalSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IntervalSeconds is the interval between probes, defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"timeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeoutSeconds is the timeout of a probe, defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"healthyThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthyThreshold is the consecutive successes for a backend to be healthy, defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"unhealthyThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnhealthyThreshold is the consecutive failures for a backend to be unhealthy, defaults to 3.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCFList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCFList is the whole list of all helms which owned by a tenant.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of LBCFs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.LBCF"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.LBCF"},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCFProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCFProxyOptions is the query options to a kube-apiserver proxy call.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCFSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCFSpec describes the attributes on a Helm.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"healthCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HealthCheck is the default health check of backends, which is published to the drivers in the lbcf-driver-config ConfigMap of kube-system.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LBCFHealthCheck"),
+						},
+					},
+					"webhookTimeoutSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WebhookTimeoutSeconds is the default timeout of the webhooks of drivers, between 1 and 60, defaults to 10.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.LBCFHealthCheck"},
+	}
+}
+
+func schema_tke_api_platform_v1_LBCFStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LBCFStatus is information about the current status of a Helm.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"retryCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastReInitializingTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_License(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "License records the entitlements of the installation, and the usage of them is reported in the status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the entitlements of License.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LicenseSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.LicenseStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.LicenseSpec", "tkestack.io/tke/api/platform/v1.LicenseStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_LicenseList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LicenseList is the whole list of all Licenses.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of Licenses",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.License"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.License"},
+	}
+}
+
+func schema_tke_api_platform_v1_LicenseNotify(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LicenseNotify describes the channel, template and receivers of the notify messages about license.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"channel": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the cluster is in this condition.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"reason": {
+					"template": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the cluster is in this state.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"addresses": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"receivers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses reachable to the cluster.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterAddress"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"resource": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ClusterResource"),
-						},
-					},
-					"components": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"receiverGroups": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterComponent"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"serviceCIDR": {
+				},
+				Required: []string{"channel", "template"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_LicenseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LicenseSpec describes the entitlements of a License.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"licensee": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Licensee is the customer who the license is issued to.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeCIDRMaskSize": {
+					"maxNodes": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "MaxNodes is the max number of nodes in all clusters, 0 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"dnsIP": {
+					"maxClusters": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "MaxClusters is the max number of clusters, 0 means unlimited.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"registryIPs": {
+					"features": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Features are the entitled features, empty means all features.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -47557,167 +55001,205 @@ func schema_tke_api_platform_v1_ClusterStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"secondaryServiceCIDR": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clusterCIDR": {
+					"notBefore": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NotBefore is the time from which the license is valid, zero means valid immediately.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"secondaryClusterCIDR": {
+					"notAfter": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "NotAfter is the expiration time of license, zero means never expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"nodeCIDRMaskSizeIPv4": {
+					"warningPercent": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "WarningPercent is the usage percentage of entitlements from which warnings are sent. Defaults to 90.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"nodeCIDRMaskSizeIPv6": {
+					"notify": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Notify describes where the warnings are sent, no warnings are sent if not specified.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LicenseNotify"),
 						},
 					},
-					"kubeVendor": {
+					"signature": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Signature is the base64 encoded Ed25519 signature of the entitlements, which is signed by the issuer over the JSON object of licensee, maxNodes, maxClusters, features, notBefore and notAfter, and is verified with the public key of the issuer the platform controller is configured with.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
 				},
+				Required: []string{"licensee"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ClusterAddress", "tkestack.io/tke/api/platform/v1.ClusterComponent", "tkestack.io/tke/api/platform/v1.ClusterCondition", "tkestack.io/tke/api/platform/v1.ClusterResource"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.LicenseNotify"},
 	}
 }
 
-func schema_tke_api_platform_v1_ConfigMap(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LicenseStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMap holds configuration data for tke to consume.",
+				Description: "LicenseStatus is information about the usage of entitlements of a License.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Nodes is the number of nodes in all clusters.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"clusters": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Clusters is the number of clusters.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"data": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the configuration data. Each key must consist of alphanumeric characters, '-', '_' or '.'. Values with non-UTF-8 byte sequences must use the BinaryData field. The keys stored in Data must not overlap with the keys in the BinaryData field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Message describes the usage exceeding or approaching the entitlements.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"binaryData": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BinaryData contains the binary data. Each key must consist of alphanumeric characters, '-', '_' or '.'. BinaryData can contain byte sequences that are not in the UTF-8 range. The keys stored in BinaryData must not overlap with the ones in the Data field, this is enforced during validation process.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "byte",
-									},
-								},
-							},
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastWarningTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastWarningTime is the time when the last warning was sent.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_ConfigMapList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ConfigMapList is a resource containing a list of ConfigMap objects.",
+				Description: "LocalEtcd describes that kubeadm should run an etcd cluster locally",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"dataDir": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"extraArgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"serverCertSANs": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"peerCertSANs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ConfigMaps.",
+							Description: "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.ConfigMap"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
+					"quotaBackendBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuotaBackendBytes is the backend storage size limit of etcd in bytes, etcd raises a NOSPACE alarm when it is exceeded. Only takes effect when creating the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"heartbeatInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HeartbeatInterval is the time in milliseconds of a heartbeat interval. Only takes effect when creating the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"electionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ElectionTimeout is the time in milliseconds for an election to timeout. Only takes effect when creating the cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"dataDevice": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataDevice is a dedicated block device of masters such as /dev/vdb, which is formatted and mounted to DataDir before etcd starts.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance compacts and defragments etcd periodically.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.EtcdMaintenance"),
+						},
+					},
 				},
-				Required: []string{"items"},
+				Required: []string{"dataDir"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.ConfigMap"},
+			"tkestack.io/tke/api/platform/v1.EtcdMaintenance"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LogCollector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPA is a new kubernetes workload.",
+				Description: "LogCollector is a manager to collect logs of workload.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47741,28 +55223,28 @@ func schema_tke_api_platform_v1_CronHPA(ref common.ReferenceCallback) common.Ope
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of CronHPA.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.CronHPASpec"),
+							Description: "Spec defines the desired identities of LogCollector.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.LogCollectorSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.CronHPAStatus"),
+							Ref: ref("tkestack.io/tke/api/platform/v1.LogCollectorStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.CronHPASpec", "tkestack.io/tke/api/platform/v1.CronHPAStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.LogCollectorSpec", "tkestack.io/tke/api/platform/v1.LogCollectorStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LogCollectorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAList is the whole list of all CronHPAs which owned by a tenant.",
+				Description: "LogCollectorList is the whole list of all LogCollector which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47786,12 +55268,12 @@ func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of CronHPAs",
+							Description: "List of volume decorators.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.CronHPA"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.LogCollector"),
 									},
 								},
 							},
@@ -47802,15 +55284,15 @@ func schema_tke_api_platform_v1_CronHPAList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.CronHPA"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.LogCollector"},
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LogCollectorProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAProxyOptions is the query options to a kube-apiserver proxy call.",
+				Description: "LogCollectorProxyOptions is the query options to a kube-apiserver proxy call for LogCollector crd object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -47845,11 +55327,11 @@ func schema_tke_api_platform_v1_CronHPAProxyOptions(ref common.ReferenceCallback
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LogCollectorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPASpec describes the attributes on a CronHPA.",
+				Description: "LogCollectorSpec describes the attributes of a LogCollector.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -47877,11 +55359,11 @@ func schema_tke_api_platform_v1_CronHPASpec(ref common.ReferenceCallback) common
 	}
 }
 
-func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_LogCollectorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CronHPAStatus is information about the current status of a CronHPA.",
+				Description: "LogCollectorStatus is information about the current status of a LogCollector.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"version": {
@@ -47892,7 +55374,7 @@ func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) comm
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the CronHPA of cluster.",
+							Description: "Phase is the current lifecycle phase of the LogCollector of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -47925,357 +55407,534 @@ func schema_tke_api_platform_v1_CronHPAStatus(ref common.ReferenceCallback) comm
 	}
 }
 
-func schema_tke_api_platform_v1_Etcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Etcd contains elements describing Etcd configuration.",
+				Description: "Machine instance in Kubernetes cluster",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"local": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Local provides configuration knobs for configuring the local etcd instance Local and External are mutually exclusive",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.LocalEtcd"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"external": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "External describes how to connect to an external etcd cluster Local and External are mutually exclusive",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ExternalEtcd"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of the Machine.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.MachineStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.ExternalEtcd", "tkestack.io/tke/api/platform/v1.LocalEtcd"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MachineSpec", "tkestack.io/tke/api/platform/v1.MachineStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_ExternalAuthzWebhookAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MachineAddress contains information for the machine's address.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ip": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Machine address type, one of Public, ExternalIP or InternalIP.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"port": {
+					"address": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "The machine address.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"ip", "port"},
+				Required: []string{"type", "address"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ExternalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
+				Description: "MachineCondition contains details for the current condition of this Machine.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"endpoints": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Endpoints of etcd members. Required for ExternalEtcd.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Type is the type of the condition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"caFile": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CAFile is an SSL Certificate Authority file used to secure etcd communication. Required if using a TLS connection.",
+							Description: "Status is the status of the condition. Can be True, False, Unknown.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"certFile": {
+					"lastProbeTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertFile is an SSL certification file used to secure etcd communication. Required if using a TLS connection.",
+							Description: "Last time we probed the condition.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"keyFile": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeyFile is an SSL key file used to secure etcd communication. Required if using a TLS connection.",
+							Description: "Human-readable message indicating details about last transition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"endpoints", "caFile", "certFile", "keyFile"},
+				Required: []string{"type", "status"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_File(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MachineList is the whole list of all machine in an cluster.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"src": {
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of clusters",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Machine"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Machine"},
+	}
+}
+
+func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineSpec is a description of machine.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"finalizers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"username": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"dst": {
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"privateKey": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"passPhrase": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "byte",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the node's taints.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"kubeletConfiguration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeletConfiguration overrides the kubelet configuration of cluster on the machine.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.KubeletConfigurationOverrides"),
+						},
+					},
+					"serviceOverrides": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Only support regular file",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ServiceOverrides is merged over the service overrides of cluster on the machine.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ServiceOverrides"),
 						},
 					},
 				},
-				Required: []string{"src", "dst"},
+				Required: []string{"clusterName", "type", "ip", "port", "username"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.Taint", "tkestack.io/tke/api/platform/v1.KubeletConfigurationOverrides", "tkestack.io/tke/api/platform/v1.ServiceOverrides"},
 	}
 }
 
-func schema_tke_api_platform_v1_HA(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "MachineStatus represents information about the status of an machine.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tke": {
+					"locked": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.TKEHA"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
-					"thirdParty": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.ThirdPartyHA"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.TKEHA", "tkestack.io/tke/api/platform/v1.ThirdPartyHA"},
-	}
-}
-
-func schema_tke_api_platform_v1_Helm(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Helm is a kubernetes package manager.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MachineCondition"),
+									},
+								},
+							},
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "A human readable message indicating details about why the machine is in this condition.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "A brief CamelCase message indicating details about why the machine is in this state.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "List of addresses reachable to the machine.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MachineAddress"),
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"machineInfo": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.HelmSpec"),
+							Description: "Set of ids/uuids to uniquely identify the node.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSystemInfo"),
 						},
 					},
-					"status": {
+					"progress": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.HelmStatus"),
+							Description: "Progress records the phases progress of the current operation.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.Progress"),
+						},
+					},
+					"upgradeBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradeBackup records the binaries and configurations of the machine before upgrade, which the machine can be rolled back to.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineUpgradeBackup"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.HelmSpec", "tkestack.io/tke/api/platform/v1.HelmStatus"},
+			"tkestack.io/tke/api/platform/v1.MachineAddress", "tkestack.io/tke/api/platform/v1.MachineCondition", "tkestack.io/tke/api/platform/v1.MachineSystemInfo", "tkestack.io/tke/api/platform/v1.MachineUpgradeBackup", "tkestack.io/tke/api/platform/v1.Progress"},
 	}
 }
 
-func schema_tke_api_platform_v1_HelmList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmList is the whole list of all helms which owned by a tenant.",
+				Description: "MachineSystemInfo is a set of ids/uuids to uniquely identify the node.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"machineID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "MachineID reported by the node. For unique machine identification in the cluster this field is preferred. Learn more from man(5) machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"systemUUID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "SystemUUID reported by the node. For unique machine identification MachineID is preferred. This field is specific to Red Hat hosts https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"bootID": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Boot ID reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"kernelVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Helms",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.Helm"),
-									},
-								},
-							},
+							Description: "Kernel Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Helm"},
-	}
-}
-
-func schema_tke_api_platform_v1_HelmProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmProxyOptions is the query options to a Helm-api proxy call.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"osImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "OS Image reported by the node.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"containerRuntimeVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ContainerRuntime Version reported by the node.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"kubeletVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the URL path to use for the current proxy request to helm-api.",
+							Description: "Kubelet Version reported by the node.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_HelmSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmSpec describes the attributes on a Helm.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"kubeProxyVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "KubeProxy Version reported by the node.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"clusterName": {
+					"operatingSystem": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The Operating System reported by the node",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"architecture": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The Architecture reported by the node",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_HelmStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MachineUpgradeBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HelmStatus is information about the current status of a Helm.",
+				Description: "MachineUpgradeBackup describes the files saved on machine before upgrade.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"version": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Version is the kubelet version before upgrade.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"targetVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Description: "TargetVersion is the version which the machine is upgraded to.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"dir": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "Dir is the directory on machine where the files are saved.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Files are the paths of saved files.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"creationTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"version", "targetVersion", "dir"},
 			},
 		},
 		Dependencies: []string{
@@ -48283,11 +55942,11 @@ func schema_tke_api_platform_v1_HelmStatus(ref common.ReferenceCallback) common.
 	}
 }
 
-func schema_tke_api_platform_v1_IPAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MetalLB(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPAM is a scheduler plugin for assigning IP.",
+				Description: "MetalLB is a load balancer for bare metal clusters, which assigns the addresses of Services of type LoadBalancer and announces them by layer 2 or BGP.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48311,28 +55970,76 @@ func schema_tke_api_platform_v1_IPAM(ref common.ReferenceCallback) common.OpenAP
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.IPAMSpec"),
+							Description: "Spec defines the desired identities of MetalLB.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MetalLBSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.IPAMStatus"),
+							Ref: ref("tkestack.io/tke/api/platform/v1.MetalLBStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.IPAMSpec", "tkestack.io/tke/api/platform/v1.IPAMStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MetalLBSpec", "tkestack.io/tke/api/platform/v1.MetalLBStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_IPAMList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MetalLBAddressPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPAMList is the whole list of all IPAMs which owned by a tenant.",
+				Description: "MetalLBAddressPool is a pool of addresses announced by the same protocol.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol announcing the addresses, layer2 or bgp.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"addresses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Addresses are the CIDRs or the ranges like 192.168.1.100-192.168.1.200.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"autoAssign": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoAssign assigns the addresses of pool to Services automatically, defaults to true. The addresses are only assigned on request if it is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "protocol", "addresses"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_MetalLBList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetalLBList is the whole list of all MetalLBs which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48356,12 +56063,12 @@ func schema_tke_api_platform_v1_IPAMList(ref common.ReferenceCallback) common.Op
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of IPAMs",
+							Description: "List of MetalLBs",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.IPAM"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.MetalLB"),
 									},
 								},
 							},
@@ -48372,49 +56079,72 @@ func schema_tke_api_platform_v1_IPAMList(ref common.ReferenceCallback) common.Op
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.IPAM"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.MetalLB"},
 	}
 }
 
-func schema_tke_api_platform_v1_IPAMProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MetalLBPeer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPAMProxyOptions is the query options to a ipam-api proxy call.",
+				Description: "MetalLBPeer is a BGP router peering with the nodes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"peerAddress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "PeerAddress is the address of router.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"peerASN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PeerASN is the AS number of router.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"path": {
+					"myASN": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path is the URL path to use for the current proxy request to ipam-api.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MyASN is the AS number of nodes.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"peerPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PeerPort is the BGP port of router, defaults to 179.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes peering with the router, all nodes peer with it if not specified.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"peerAddress", "peerASN", "myASN"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_IPAMSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MetalLBSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPAMSpec describes the attributes on a IPAM.",
+				Description: "MetalLBSpec describes the attributes on a MetalLB.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -48435,18 +56165,46 @@ func schema_tke_api_platform_v1_IPAMSpec(ref common.ReferenceCallback) common.Op
 							Format: "",
 						},
 					},
+					"addressPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AddressPools are the addresses assigned to the Services of type LoadBalancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MetalLBAddressPool"),
+									},
+								},
+							},
+						},
+					},
+					"peers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Peers are the BGP routers which the nodes announce the addresses of bgp pools to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MetalLBPeer"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"tenantID", "clusterName", "version", "addressPools"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.MetalLBAddressPool", "tkestack.io/tke/api/platform/v1.MetalLBPeer"},
 	}
 }
 
-func schema_tke_api_platform_v1_IPAMStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MetalLBStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "IPAMStatus is information about the current status of a IPAM.",
+				Description: "MetalLBStatus is information about the current status of a MetalLB.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"version": {
@@ -48457,7 +56215,7 @@ func schema_tke_api_platform_v1_IPAMStatus(ref common.ReferenceCallback) common.
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the addon of cluster.",
+							Description: "Phase is the current lifecycle phase of the MetalLB of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -48482,19 +56240,32 @@ func schema_tke_api_platform_v1_IPAMStatus(ref common.ReferenceCallback) common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the lifecycle conditions of the addon.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.AddonCondition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.AddonCondition"},
 	}
 }
 
-func schema_tke_api_platform_v1_LBCF(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Multus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LBCF is a kubernetes load balancer manager.",
+				Description: "Multus attaches secondary network interfaces such as macvlan, ipvlan and SR-IOV to pods by the NetworkAttachmentDefinitions.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48518,76 +56289,87 @@ func schema_tke_api_platform_v1_LBCF(ref common.ReferenceCallback) common.OpenAP
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.LBCFSpec"),
+							Description: "Spec defines the desired identities of Multus.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MultusSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.LBCFStatus"),
+							Ref: ref("tkestack.io/tke/api/platform/v1.MultusStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.LBCFSpec", "tkestack.io/tke/api/platform/v1.LBCFStatus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MultusSpec", "tkestack.io/tke/api/platform/v1.MultusStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_LBCFList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusIPAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LBCFList is the whole list of all helms which owned by a tenant.",
+				Description: "MultusIPAM describes the IPAM plugin of a network attachment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the IPAM plugin, one of host-local, static and dhcp, defaults to host-local. The addresses of host-local are allocated per node, so the ranges of nodes must not overlap if the interfaces are in the same layer 2 network. The addresses of static are specified by the networks annotation of pods, and dhcp requires the dhcp daemon running on nodes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"subnet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Subnet is the CIDR which the addresses are allocated from, only used by host-local.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"rangeStart": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"items": {
+					"rangeEnd": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of LBCFs",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"gateway": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"routes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Routes are the destination CIDRs routed through the interface.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.LBCF"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.LBCF"},
 	}
 }
 
-func schema_tke_api_platform_v1_LBCFProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LBCFProxyOptions is the query options to a kube-apiserver proxy call.",
+				Description: "MultusList is the whole list of all Multuses which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -48604,127 +56386,145 @@ func schema_tke_api_platform_v1_LBCFProxyOptions(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"name": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"action": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "List of Multuses",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Multus"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Multus"},
 	}
 }
 
-func schema_tke_api_platform_v1_LBCFSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusNetworkAttachment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LBCFSpec describes the attributes on a Helm.",
+				Description: "MultusNetworkAttachment is a NetworkAttachmentDefinition managed by Multus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"clusterName": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"version": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Type is the CNI plugin, one of macvlan, ipvlan, host-device and sriov. It is ignored if Config is specified.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"tenantID", "clusterName"},
-			},
-		},
-	}
-}
-
-func schema_tke_api_platform_v1_LBCFStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "LBCFStatus is information about the current status of a Helm.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"version": {
+					"master": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Master is the interface of nodes which macvlan and ipvlan are created on, or the device moved into pods by host-device.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"phase": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the helm of cluster.",
+							Description: "Mode is the mode of macvlan (bridge, private, vepa or passthru) or ipvlan (l2, l3 or l3s).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"resourceName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "ResourceName is the SR-IOV resource without prefix which the pods request the virtual functions from, it is required by sriov.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
+					"vlan": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Description: "VLAN is the VLAN ID of virtual functions assigned to pods, only used by sriov.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"mtu": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"ipam": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPAM allocates the addresses of the interfaces, the interfaces have no address if it is not specified.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MultusIPAM"),
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the raw CNI configuration in JSON, which is used as it is for the plugins not supported by the fields above.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "namespace"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"tkestack.io/tke/api/platform/v1.MultusIPAM"},
 	}
 }
 
-func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusSRIOV(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LocalEtcd describes that kubeadm should run an etcd cluster locally",
+				Description: "MultusSRIOV describes the resources advertised by SR-IOV network device plugin.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataDir": {
+					"resourcePrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
+							Description: "ResourcePrefix is the prefix of extended resources, defaults to intel.com.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"extraArgs": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
+							Description: "Resources group the virtual functions by the selectors.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MultusSRIOVResource"),
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes with SR-IOV NICs, all nodes if not specified.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -48737,9 +56537,60 @@ func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
-					"serverCertSANs": {
+				},
+				Required: []string{"resources"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.MultusSRIOVResource"},
+	}
+}
+
+func schema_tke_api_platform_v1_MultusSRIOVResource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MultusSRIOVResource is an extended resource of the virtual functions matching all the selectors.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceName is the name of extended resource without prefix.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vendors": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Vendors are the vendor hex codes of devices, such as 8086.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"devices": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Devices are the device hex codes of virtual functions, such as 154c.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"drivers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
+							Description: "Drivers are the drivers of virtual functions, such as iavf and vfio-pci.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -48751,9 +56602,9 @@ func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.O
 							},
 						},
 					},
-					"peerCertSANs": {
+					"pfNames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
+							Description: "PFNames are the physical functions of virtual functions, such as eth1.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -48766,217 +56617,215 @@ func schema_tke_api_platform_v1_LocalEtcd(ref common.ReferenceCallback) common.O
 						},
 					},
 				},
-				Required: []string{"dataDir"},
+				Required: []string{"resourceName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_LogCollector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogCollector is a manager to collect logs of workload.",
+				Description: "MultusSpec describes the attributes on a Multus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"apiVersion": {
+					"clusterName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"metadata": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"spec": {
+					"sriov": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of LogCollector.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.LogCollectorSpec"),
+							Description: "SRIOV deploys the SR-IOV network device plugin and CNI, which advertise the virtual functions of NICs as extended resources of nodes.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.MultusSRIOV"),
 						},
 					},
-					"status": {
+					"networkAttachments": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.LogCollectorStatus"),
+							Description: "NetworkAttachments are created as NetworkAttachmentDefinitions, which are referenced by the k8s.v1.cni.cncf.io/networks annotation of pods.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.MultusNetworkAttachment"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"tenantID", "clusterName", "version"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.LogCollectorSpec", "tkestack.io/tke/api/platform/v1.LogCollectorStatus"},
+			"tkestack.io/tke/api/platform/v1.MultusNetworkAttachment", "tkestack.io/tke/api/platform/v1.MultusSRIOV"},
 	}
 }
 
-func schema_tke_api_platform_v1_LogCollectorList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_MultusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogCollectorList is the whole list of all LogCollector which owned by a tenant.",
+				Description: "MultusStatus is information about the current status of a Multus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current lifecycle phase of the Multus of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Reason is a brief CamelCase string that describes any failure.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"items": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of volume decorators.",
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the lifecycle conditions of the addon.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.LogCollector"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.AddonCondition"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.LogCollector"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.AddonCondition"},
 	}
 }
 
-func schema_tke_api_platform_v1_LogCollectorProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_NetworkEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogCollectorProxyOptions is the query options to a kube-apiserver proxy call for LogCollector crd object.",
+				Description: "NetworkEncryption describes how the pod traffic between nodes is encrypted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"namespace": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"name": {
+					"keyRotationPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "KeyRotationPeriod is the period to rotate the encryption keys, such as \"720h\". The keys are never rotated if it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_LogCollectorSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_NetworkPolicyFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogCollectorSpec describes the attributes of a LogCollector.",
+				Description: "NetworkPolicyFeature bootstraps default-deny NetworkPolicies and verifies the NetworkPolicy enforcement of CNI when the cluster is created.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"clusterName": {
+					"defaultDenyNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "DefaultDenyNamespaces are the namespaces to install the default-deny NetworkPolicy which denies all ingress traffic of pods, the namespaces not existing are created.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"version": {
+					"denyEgress": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "DenyEgress denies the egress traffic of pods in DefaultDenyNamespaces too, except DNS queries.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_LogCollectorStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_NetworkPolicyStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LogCollectorStatus is information about the current status of a LogCollector.",
+				Description: "NetworkPolicyStatus is the result of the NetworkPolicy enforcement check with probe pods.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
-						},
-					},
-					"phase": {
+					"enforced": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the LogCollector of cluster.",
-							Type:        []string{"string"},
+							Description: "Enforced is true if the traffic denied by a NetworkPolicy is blocked.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Description: "Message describes why the enforcement is not verified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"retryCount": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"lastReInitializingTimestamp": {
+					"lastProbeTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"enforced"},
 			},
 		},
 		Dependencies: []string{
@@ -48984,11 +56833,11 @@ func schema_tke_api_platform_v1_LogCollectorStatus(ref common.ReferenceCallback)
 	}
 }
 
-func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PVCRProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Machine instance in Kubernetes cluster",
+				Description: "PVCRProxyOptions is the query options to a kube-apiserver proxy call for PVCR crd object.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49005,119 +56854,136 @@ func schema_tke_api_platform_v1_Machine(ref common.ReferenceCallback) common.Ope
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of the Machine.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSpec"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"status": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.MachineStatus"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.MachineSpec", "tkestack.io/tke/api/platform/v1.MachineStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineAddress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PersistentBackEnd(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineAddress contains information for the machine's address.",
+				Description: "PersistentBackEnd indicates the backend type and attributes of the persistent log store.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"cls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Machine address type, one of Public, ExternalIP or InternalIP.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndCLS"),
 						},
 					},
-					"address": {
+					"es": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The machine address.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndES"),
+						},
+					},
+					"kafka": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndKafka"),
+						},
+					},
+					"loki": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndLoki"),
 						},
 					},
 				},
-				Required: []string{"type", "address"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.StorageBackEndCLS", "tkestack.io/tke/api/platform/v1.StorageBackEndES", "tkestack.io/tke/api/platform/v1.StorageBackEndKafka", "tkestack.io/tke/api/platform/v1.StorageBackEndLoki"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PersistentEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineCondition contains details for the current condition of this Machine.",
+				Description: "PersistentEvent is a recorder of kubernetes event.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the condition.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status is the status of the condition. Can be True, False, Unknown.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastProbeTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time we probed the condition.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastTransitionTime": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PersistentEventSpec"),
 						},
 					},
-					"reason": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Unique, one-word, CamelCase reason for the condition's last transition.",
+							Ref: ref("tkestack.io/tke/api/platform/v1.PersistentEventStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PersistentEventSpec", "tkestack.io/tke/api/platform/v1.PersistentEventStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEventBuffer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEventBuffer describes how events are buffered when the backend storage is slow or unavailable.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"totalLimitSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalLimitSize is the max size of buffered events, such as 32MB, defaults to 32MB.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"overflowAction": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Human-readable message indicating details about last transition.",
+							Description: "OverflowAction is the action when the buffer is full, block pauses reading events, drop_oldest_chunk drops the oldest events, defaults to block.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PersistentEventList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineList is the whole list of all machine in an cluster.",
+				Description: "PersistentEventList is the whole list of all clusters which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49141,12 +57007,12 @@ func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of clusters",
+							Description: "List of PersistentEvents",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.Machine"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.PersistentEvent"),
 									},
 								},
 							},
@@ -49157,31 +57023,17 @@ func schema_tke_api_platform_v1_MachineList(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Machine"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.PersistentEvent"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PersistentEventSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineSpec is a description of machine.",
+				Description: "PersistentEventSpec describes the attributes on a PersistentEvent.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"finalizers": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
 					"tenantID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
@@ -49194,256 +57046,331 @@ func schema_tke_api_platform_v1_MachineSpec(ref common.ReferenceCallback) common
 							Format: "",
 						},
 					},
-					"type": {
+					"persistentBackEnd": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("tkestack.io/tke/api/platform/v1.PersistentBackEnd"),
 						},
 					},
-					"ip": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"port": {
+					"buffer": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Buffer handles the backpressure of the backend storage.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PersistentEventBuffer"),
 						},
 					},
-					"username": {
+				},
+				Required: []string{"tenantID", "clusterName"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.PersistentBackEnd", "tkestack.io/tke/api/platform/v1.PersistentEventBuffer"},
+	}
+}
+
+func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PersistentEventStatus is information about the current status of a PersistentEvent.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"password": {
-						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
-						},
-					},
-					"privateKey": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "Phase is the current lifecycle phase of the persistent event of cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"passPhrase": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "byte",
+							Description: "Reason is a brief CamelCase string that describes any failure.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"retryCount": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"taints": {
+					"lastReInitializingTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the node's taints.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
-									},
-								},
-							},
+							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"clusterName", "type", "ip", "port", "username"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.Taint"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PersistentVolumeClaimUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineStatus represents information about the status of an machine.",
+				Description: "PersistentVolumeClaimUsage is the filesystem usage of a persistent volume claim reported by kubelet.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"locked": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
+							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+					"usedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsedBytes is the bytes used by the filesystem of the volume.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
+					},
+					"capacityBytes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.MachineCondition"),
-									},
-								},
-							},
+							Description: "CapacityBytes is the total bytes of the filesystem of the volume.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"message": {
+					"exceeded": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about why the machine is in this condition.",
+							Description: "Exceeded will be true if the usage exceeds the enforcement threshold.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name", "usedBytes", "capacityBytes"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_PhaseProgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PhaseProgress records the execution of a phase.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the phase.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A brief CamelCase message indicating details about why the machine is in this state.",
+							Description: "Time the phase starts to execute at the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Time the phase finishes successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"retries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Retries is the number of failed executions of the phase.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError is the error message of the last failed execution.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"addresses": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_tke_api_platform_v1_Progress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Progress records the phases progress of the current cluster or machine operation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"total": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Total is the number of phases of the operation.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
+					},
+					"completed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of addresses reachable to the machine.",
+							Description: "Completed is the number of phases which are done or skipped.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"phases": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phases records the execution of each phase in order.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.MachineAddress"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.PhaseProgress"),
 									},
 								},
 							},
 						},
 					},
-					"machineInfo": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Set of ids/uuids to uniquely identify the node.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.MachineSystemInfo"),
-						},
-					},
 				},
+				Required: []string{"total"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.MachineAddress", "tkestack.io/tke/api/platform/v1.MachineCondition", "tkestack.io/tke/api/platform/v1.MachineSystemInfo"},
+			"tkestack.io/tke/api/platform/v1.PhaseProgress"},
 	}
 }
 
-func schema_tke_api_platform_v1_MachineSystemInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_ProjectImageAdmission(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineSystemInfo is a set of ids/uuids to uniquely identify the node.",
+				Description: "ProjectImageAdmission describes the image prefixes allowed in namespaces of a project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineID reported by the node. For unique machine identification in the cluster this field is preferred. Learn more from man(5) machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"systemUUID": {
+					"projectName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SystemUUID reported by the node. For unique machine identification MachineID is preferred. This field is specific to Red Hat hosts https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html",
+							Description: "ProjectName is the name of project in business.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bootID": {
+					"allowedRegistries": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Boot ID reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AllowedRegistries are the image prefixes allowed in namespaces of the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"kernelVersion": {
+				},
+				Required: []string{"projectName", "allowedRegistries"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Prometheus is a kubernetes package manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kernel Version reported by the node.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"osImage": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OS Image reported by the node.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"containerRuntimeVersion": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ContainerRuntime Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"kubeletVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the desired identities of clusters in this set.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusSpec"),
 						},
 					},
-					"kubeProxyVersion": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeProxy Version reported by the node.",
-							Type:        []string{"string"},
-							Format:      "",
+							Ref: ref("tkestack.io/tke/api/platform/v1.PrometheusStatus"),
 						},
 					},
-					"operatingSystem": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PrometheusSpec", "tkestack.io/tke/api/platform/v1.PrometheusStatus"},
+	}
+}
+
+func schema_tke_api_platform_v1_PrometheusBasicAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PrometheusBasicAuth records the basic authentication of a remote endpoint.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"user": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Operating System reported by the node",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"architecture": {
+					"password": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Architecture reported by the node",
+							Description: "Password is the base64 encoded password.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"user", "password"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_PVCRProxyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PVCRProxyOptions is the query options to a kube-apiserver proxy call for PVCR crd object.",
+				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49460,170 +57387,255 @@ func schema_tke_api_platform_v1_PVCRProxyOptions(ref common.ReferenceCallback) c
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"name": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "List of Prometheuss",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Prometheus"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Prometheus"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentBackEnd(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentBackEnd indicates the backend type and attributes of the persistent log store.",
+				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cls": {
+					"writeAddr": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndCLS"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"es": {
+					"readAddr": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.StorageBackEndES"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.StorageBackEndCLS", "tkestack.io/tke/api/platform/v1.StorageBackEndES"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentEvent(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusRemoteWrite(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEvent is a recorder of kubernetes event.",
+				Description: "PrometheusRemoteWrite is a remote write endpoint of prometheus, such as Cortex, Mimir or Thanos receive.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name identifies the endpoint, unique in a prometheus.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "URL is the remote write address, such as http://cortex:9009/api/v1/push.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"basicAuth": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "BasicAuth authenticates to the endpoint, disabled if nil.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusBasicAuth"),
 						},
 					},
-					"spec": {
+					"bearerToken": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.PersistentEventSpec"),
+							Description: "BearerToken is the base64 encoded bearer token to authenticate to the endpoint.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"tls": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.PersistentEventStatus"),
+							Description: "TLS configures the certificates to connect to the endpoint over https.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusTLSConfig"),
+						},
+					},
+					"metricsRegex": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MetricsRegex keeps only the metrics whose names match the regex, all metrics are written if empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "url"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PersistentEventSpec", "tkestack.io/tke/api/platform/v1.PersistentEventStatus"},
+			"tkestack.io/tke/api/platform/v1.PrometheusBasicAuth", "tkestack.io/tke/api/platform/v1.PrometheusTLSConfig"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentEventList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventList is the whole list of all clusters which owned by a tenant.",
+				Description: "PrometheusSpec describes the attributes on a Prometheus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"tenantID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"clusterName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"subVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubVersion is the components version such as node-exporter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"remoteAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusRemoteAddr"),
+						},
+					},
+					"notifyWebhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Resources is the resource request and limit for prometheus",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ResourceRequirements"),
+						},
+					},
+					"runOnMaster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"alertRepeatInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AlertRepeatInterval indicates repeat interval of alerts",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"withNPD": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"remoteWrites": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of PersistentEvents",
+							Description: "RemoteWrites are the extra remote write endpoints besides RemoteAddress.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.PersistentEvent"),
+										Ref: ref("tkestack.io/tke/api/platform/v1.PrometheusRemoteWrite"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.PersistentEvent"},
-	}
-}
-
-func schema_tke_api_platform_v1_PersistentEventSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventSpec describes the attributes on a PersistentEvent.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"externalLabels": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ExternalLabels are added to the metrics sent to remote endpoints, cluster_id, tenant_id and cluster_display_name can not be overridden.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"clusterName": {
+					"retention": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Retention is how long prometheus retains data locally, such as 2h, defaults to 30m.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"persistentBackEnd": {
+					"retentionSize": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.PersistentBackEnd"),
+							Description: "RetentionSize is the max bytes of local data, such as 10GB, defaults to 5GB.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"sizingTier": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "SizingTier sizes the resources of prometheus, ignored if Resources is set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -49631,15 +57643,15 @@ func schema_tke_api_platform_v1_PersistentEventSpec(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.PersistentBackEnd"},
+			"tkestack.io/tke/api/platform/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/platform/v1.PrometheusRemoteWrite", "tkestack.io/tke/api/platform/v1.ResourceRequirements"},
 	}
 }
 
-func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PersistentEventStatus is information about the current status of a PersistentEvent.",
+				Description: "PrometheusStatus is information about the current status of a Prometheus.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"version": {
@@ -49650,7 +57662,7 @@ func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallba
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the persistent event of cluster.",
+							Description: "Phase is the current lifecycle phase of the helm of cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -49675,6 +57687,21 @@ func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallba
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"subVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubVersion is the components version such as node-exporter.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -49683,56 +57710,52 @@ func schema_tke_api_platform_v1_PersistentEventStatus(ref common.ReferenceCallba
 	}
 }
 
-func schema_tke_api_platform_v1_Prometheus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_PrometheusTLSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Prometheus is a kubernetes package manager.",
+				Description: "PrometheusTLSConfig records the certificates to connect to a remote endpoint.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"caCert": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "CACert is the PEM encoded CA certificate to verify the server.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"clientCert": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "ClientCert is the PEM encoded client certificate.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"clientKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the desired identities of clusters in this set.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusSpec"),
+							Description: "ClientKey is the PEM encoded private key of the client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.PrometheusStatus"),
+							Description: "InsecureSkipVerify skips the verification of the server certificate.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.PrometheusSpec", "tkestack.io/tke/api/platform/v1.PrometheusStatus"},
 	}
 }
 
-func schema_tke_api_platform_v1_PrometheusList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusList is the whole list of all prometheus which owned by a tenant.",
+				Description: "Registry records the third-party image repository information stored by the user.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -49751,59 +57774,81 @@ func schema_tke_api_platform_v1_PrometheusList(ref common.ReferenceCallback) com
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of Prometheuss",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.Prometheus"),
-									},
-								},
-							},
+							Ref: ref("tkestack.io/tke/api/platform/v1.RegistrySpec"),
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Prometheus"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.RegistrySpec"},
 	}
 }
 
-func schema_tke_api_platform_v1_PrometheusRemoteAddr(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_RegistryAuth(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusRemoteAddr is the remote write/read address for prometheus",
+				Description: "RegistryAuth is the credential of a private registry, the password is kept in the RegistryPasswords of ClusterCredential by registry.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"writeAddr": {
+					"registry": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"readAddr": {
+					"username": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"registry", "username"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryList is a resource containing a list of Registry objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("tkestack.io/tke/api/platform/v1.Registry"),
 									},
 								},
 							},
@@ -49812,14 +57857,16 @@ func schema_tke_api_platform_v1_PrometheusRemoteAddr(ref common.ReferenceCallbac
 				},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Registry"},
 	}
 }
 
-func schema_tke_api_platform_v1_PrometheusSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusSpec describes the attributes on a Prometheus.",
+				Description: "RegistrySpec indicates the specifications of the third-party image repository.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"tenantID": {
@@ -49828,125 +57875,207 @@ func schema_tke_api_platform_v1_PrometheusSpec(ref common.ReferenceCallback) com
 							Format: "",
 						},
 					},
+					"displayName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
 					"clusterName": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"version": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"subVersion": {
+					"userName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
-							Type:        []string{"object"},
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResourceRequirements describes the compute resource requirements.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"remoteAddress": {
+					"requests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RemoteAddress is the remote address for prometheus when writing/reading outside of cluster.",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.PrometheusRemoteAddr"),
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"notifyWebhook": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_tke_api_platform_v1_S3Storage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "S3Storage describes a bucket of S3 compatible storage, the secret access key is kept in the EtcdBackupSecretAccessKey of ClusterCredential.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NotifyWebhook is the address that alert messages send to, optional. If not set, a default webhook address \"https://[notify-api-address]/webhook\" will be used.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"resources": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources is the resource request and limit for prometheus",
-							Ref:         ref("tkestack.io/tke/api/platform/v1.ResourceRequirements"),
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"runOnMaster": {
+					"bucket": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"alertRepeatInterval": {
+					"prefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AlertRepeatInterval indicates repeat interval of alerts",
+							Description: "Prefix is prepended to the name of uploaded objects.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"withNPD": {
+					"accessKeyID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "WithNPD indicates whether to deploy node-problem-detector or not",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"tenantID", "clusterName"},
+				Required: []string{"endpoint", "bucket", "accessKeyID"},
 			},
 		},
-		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.PrometheusRemoteAddr", "tkestack.io/tke/api/platform/v1.ResourceRequirements"},
 	}
 }
 
-func schema_tke_api_platform_v1_PrometheusStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_SandboxRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PrometheusStatus is information about the current status of a Prometheus.",
+				Description: "SandboxRuntime describes the sandboxed container runtime of cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"phase": {
+					"untrustedNamespaces": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current lifecycle phase of the helm of cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Pods in untrusted namespaces default to the sandboxed RuntimeClass.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"reason": {
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_SecretsEncryption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SecretsEncryption describes how the secrets are encrypted at rest in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is a brief CamelCase string that describes any failure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"string"},
+							Format: "",
 						},
 					},
-					"retryCount": {
+					"kms": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "KMS is the KMS plugin running on masters, required when provider is KMS.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.KMSPlugin"),
 						},
 					},
-					"lastReInitializingTimestamp": {
+					"keyRotationPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "KeyRotationPeriod is the period to rotate the secretbox key, such as \"2160h\". The key is never rotated if it is empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"subVersion": {
+				},
+				Required: []string{"provider"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.KMSPlugin"},
+	}
+}
+
+func schema_tke_api_platform_v1_ServiceOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ServiceOverride describes the override of a systemd service, which is written as a drop-in file of the service.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"env": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubVersion is the components version such as node-exporter.",
+							Description: "Env is the environment variables of the service, such as HTTP_PROXY.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -49959,86 +58088,100 @@ func schema_tke_api_platform_v1_PrometheusStatus(ref common.ReferenceCallback) c
 							},
 						},
 					},
+					"dropIn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DropIn is the extra content of the drop-in file, such as an ExecStartPre directive, directives without section header belong to [Service].",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_tke_api_platform_v1_Registry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_ServiceOverrides(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Registry records the third-party image repository information stored by the user.",
+				Description: "ServiceOverrides describes the overrides of systemd services on nodes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Kubelet overrides the kubelet service.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ServiceOverride"),
 						},
 					},
-					"spec": {
+					"containerRuntime": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("tkestack.io/tke/api/platform/v1.RegistrySpec"),
+							Description: "ContainerRuntime overrides the container runtime service.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.ServiceOverride"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "tkestack.io/tke/api/platform/v1.RegistrySpec"},
+			"tkestack.io/tke/api/platform/v1.ServiceOverride"},
 	}
 }
 
-func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StaticPodOverride(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistryList is a resource containing a list of Registry objects.",
+				Description: "StaticPodOverride describes the extra volumes, envs and sidecars injected into a static pod manifest.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"extraVolumes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExtraVolumes are added to the pod, they can be mounted by the component or sidecars.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"extraVolumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ExtraVolumeMounts are added to the component container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"extraEnv": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "ExtraEnv are added to the component container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"sidecars": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Sidecars are added to the pod, such as audit log shippers.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("tkestack.io/tke/api/platform/v1.Registry"),
+										Ref: ref("k8s.io/api/core/v1.Container"),
 									},
 								},
 							},
@@ -50048,42 +58191,67 @@ func schema_tke_api_platform_v1_RegistryList(ref common.ReferenceCallback) commo
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "tkestack.io/tke/api/platform/v1.Registry"},
+			"k8s.io/api/core/v1.Container", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_tke_api_platform_v1_RegistrySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StorageBackEndCLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RegistrySpec indicates the specifications of the third-party image repository.",
+				Description: "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tenantID": {
+					"logSetID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"displayName": {
+					"topicID": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"clusterName": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_StorageBackEndES(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageBackEndES records the attributes required when the backend storage type is ElasticSearch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ip": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"url": {
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+					"scheme": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
-					"userName": {
+					"indexName": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"user": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
@@ -50095,130 +58263,268 @@ func schema_tke_api_platform_v1_RegistrySpec(ref common.ReferenceCallback) commo
 							Format: "",
 						},
 					},
+					"reserveDays": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StorageBackEndKafka(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResourceRequirements describes the compute resource requirements.",
+				Description: "StorageBackEndKafka records the attributes required when the backend storage type is Kafka.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"limits": {
+					"brokers": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Brokers are the addresses of Kafka brokers, such as 10.0.0.1:9092.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"requests": {
+					"topic": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "Topic is the topic which the events are produced to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sasl": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SASL authenticates to the brokers, disabled if nil.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.StorageBackEndSASL"),
+						},
+					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS connects to the brokers over TLS, disabled if nil.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.StorageBackEndTLS"),
+						},
+					},
+				},
+				Required: []string{"brokers", "topic"},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.StorageBackEndSASL", "tkestack.io/tke/api/platform/v1.StorageBackEndTLS"},
+	}
+}
+
+func schema_tke_api_platform_v1_StorageBackEndLoki(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "StorageBackEndLoki records the attributes required when the backend storage type is Loki.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the address of Loki, such as http://loki:3100.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TenantID is the X-Scope-OrgID of the multi-tenant Loki.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the user of basic authentication.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the base64 encoded password of basic authentication.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are the extra labels of the event streams.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TLS configures the certificates to connect to Loki over https.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.StorageBackEndTLS"),
+						},
+					},
 				},
+				Required: []string{"url"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"tkestack.io/tke/api/platform/v1.StorageBackEndTLS"},
 	}
 }
 
-func schema_tke_api_platform_v1_StorageBackEndCLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StorageBackEndSASL(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
+				Description: "StorageBackEndSASL records the SASL authentication of a backend storage.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"logSetID": {
+					"mechanism": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Mechanism is PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, defaults to PLAIN.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"topicID": {
+					"user": {
 						SchemaProps: spec.SchemaProps{
 							Type:   []string{"string"},
 							Format: "",
 						},
 					},
+					"password": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Password is the base64 encoded password.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"user", "password"},
 			},
 		},
 	}
 }
 
-func schema_tke_api_platform_v1_StorageBackEndES(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_tke_api_platform_v1_StorageBackEndTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "StorageBackEndES records the attributes required when the backend storage type is ElasticSearch.",
+				Description: "StorageBackEndTLS records the certificates to connect to a backend storage.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ip": {
+					"caCert": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "CACert is the PEM encoded CA certificate to verify the server.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"port": {
+					"clientCert": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "ClientCert is the PEM encoded client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"scheme": {
+					"clientKey": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "ClientKey is the PEM encoded private key of the client certificate.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"indexName": {
+					"insecureSkipVerify": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "InsecureSkipVerify skips the verification of the server certificate.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"user": {
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_SystemTuning(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SystemTuning describes the kernel parameters, kernel modules, ulimits and transparent hugepage setting enforced on all nodes of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"sysctls": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Sysctls override or extend the default kernel parameters, such as net.core.somaxconn.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"password": {
+					"kernelModules": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "KernelModules are loaded in addition to the modules required by kubernetes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"reserveDays": {
+					"ulimits": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int32",
+							Description: "Ulimits are the resource limits applied to all users.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.Ulimit"),
+									},
+								},
+							},
+						},
+					},
+					"transparentHugepage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TransparentHugepage is one of always, madvise or never. Left unchanged when empty.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.Ulimit"},
 	}
 }
 
@@ -50292,6 +58598,40 @@ func schema_tke_api_platform_v1_TappController(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_tke_api_platform_v1_TappControllerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TappControllerConfig configures the tapp-controller and the defaults of TApps.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the number of TApps synced concurrently, defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"forceDeletePod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForceDeletePod force deletes the pods on the lost nodes by default, so that the instances are recreated on other nodes. The TApps can override it.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the default number of instances unavailable during the rolling update of the TApps which do not specify it, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_tke_api_platform_v1_TappControllerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -50410,10 +58750,32 @@ func schema_tke_api_platform_v1_TappControllerSpec(ref common.ReferenceCallback)
 							Format: "",
 						},
 					},
+					"crdConflictPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DriftPolicy defines how to handle the components in cluster which drift from the desired state, defaults to Repair.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config configures the tapp-controller and the defaults of TApps.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.TappControllerConfig"),
+						},
+					},
 				},
 				Required: []string{"tenantID", "clusterName"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.TappControllerConfig"},
 	}
 }
 
@@ -50457,11 +58819,37 @@ func schema_tke_api_platform_v1_TappControllerStatus(ref common.ReferenceCallbac
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"crdConflicts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.CRDConflict"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions are the lifecycle conditions of the addon.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.AddonCondition"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.AddonCondition", "tkestack.io/tke/api/platform/v1.CRDConflict"},
 	}
 }
 
@@ -50490,6 +58878,74 @@ func schema_tke_api_platform_v1_ThirdPartyHA(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_tke_api_platform_v1_TimeSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TimeSync describes the time synchronization of nodes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"servers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Servers are the NTP servers used by chrony.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"maxSkewSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSkewSeconds is the max clock skew allowed between the node and the platform controller, defaults to 5.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"servers"},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_Ulimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Ulimit is a resource limit in the format of limits.conf.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the limited item, such as nofile or nproc.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"soft": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"name", "soft", "hard"},
+			},
+		},
+	}
+}
+
 func schema_tke_api_platform_v1_Upgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -50509,11 +58965,94 @@ func schema_tke_api_platform_v1_Upgrade(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("tkestack.io/tke/api/platform/v1.UpgradeStrategy"),
 						},
 					},
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused stops upgrading more nodes, the nodes being upgraded are not interrupted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"canary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canary upgrades the first master only and holds the upgrade for verification before the remaining masters and workers are upgraded.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.UpgradeCanary"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.UpgradeCanary", "tkestack.io/tke/api/platform/v1.UpgradeStrategy"},
+	}
+}
+
+func schema_tke_api_platform_v1_UpgradeCanary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpgradeCanary holds the upgrade after the first master is upgraded until it is approved.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"approvedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApprovedVersion continues the upgrade when it is the version being upgraded to, the same as annotating the cluster with platform.tkestack.io/upgrade-canary-approved.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_tke_api_platform_v1_UpgradeCanaryStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "UpgradeCanaryStatus records the health checks of the canary master.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version which the canary master is upgraded to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"node": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Node is the IP of the canary master.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"checks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Checks are the results of health checks such as APIServer, Scheduling and DNS.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.ClusterCondition"),
+									},
+								},
+							},
+						},
+					},
+					"approved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Approved indicates the upgrade continues to the remaining nodes.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"version", "node"},
 			},
 		},
 		Dependencies: []string{
-			"tkestack.io/tke/api/platform/v1.UpgradeStrategy"},
+			"tkestack.io/tke/api/platform/v1.ClusterCondition"},
 	}
 }
 
@@ -50537,6 +59076,18 @@ func schema_tke_api_platform_v1_UpgradeStrategy(ref common.ReferenceCallback) co
 							Format:      "",
 						},
 					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of worker nodes that can be unavailable during the upgrade. Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%). default value is 1.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The maximum number of worker nodes that can be upgrading above MaxUnavailable, these nodes are prepared for the upgrade while the worker nodes which are not ready or unschedulable are still within MaxUnavailable. Unlike maxSurge of a Deployment, no extra worker nodes are created, the nodes are upgraded in place. Percentages are rounded up. Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%). default value is 0.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
 				},
 			},
 		},
@@ -50682,10 +59233,18 @@ func schema_tke_api_platform_v1_VolumeDecoratorSpec(ref common.ReferenceCallback
 							Format: "",
 						},
 					},
+					"enforcement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enforcement annotates or evicts the pods whose persistent volume claims exceed the usage threshold, disabled if nil.",
+							Ref:         ref("tkestack.io/tke/api/platform/v1.VolumeUsageEnforcement"),
+						},
+					},
 				},
 				Required: []string{"tenantID", "clusterName"},
 			},
 		},
+		Dependencies: []string{
+			"tkestack.io/tke/api/platform/v1.VolumeUsageEnforcement"},
 	}
 }
 
@@ -50757,11 +59316,52 @@ func schema_tke_api_platform_v1_VolumeDecoratorStatus(ref common.ReferenceCallba
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"usages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usages are the filesystem usages of the mounted persistent volume claims in this cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("tkestack.io/tke/api/platform/v1.PersistentVolumeClaimUsage"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time", "tkestack.io/tke/api/platform/v1.PersistentVolumeClaimUsage"},
+	}
+}
+
+func schema_tke_api_platform_v1_VolumeUsageEnforcement(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeUsageEnforcement acts on the pods whose persistent volume claims exceed the usage threshold.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"threshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Threshold is the percentage of used bytes to the capacity of a persistent volume claim, between 1 and 100.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action defaults to Annotate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"threshold"},
+			},
+		},
 	}
 }
 
@@ -63,6 +63,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterApplyOptions{},
+		&ClusterLogsOptions{},
 
 		&ClusterCredential{},
 		&ClusterCredentialList{},
@@ -118,6 +119,30 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LBCF{},
 		&LBCFList{},
 		&LBCFProxyOptions{},
+
+		&EgressGateway{},
+		&EgressGatewayList{},
+
+		&FloatingIPReservation{},
+		&FloatingIPReservationList{},
+
+		&GPUNodeConfig{},
+		&GPUNodeConfigList{},
+
+		&ClusterOperation{},
+		&ClusterOperationList{},
+
+		&License{},
+		&LicenseList{},
+
+		&MetalLB{},
+		&MetalLBList{},
+
+		&Multus{},
+		&MultusList{},
+
+		&DevicePlugin{},
+		&DevicePluginList{},
 	)
 	return nil
 }
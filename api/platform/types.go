@@ -150,6 +150,14 @@ type ClusterSpec struct {
 	HostnameAsNodename bool
 	// +optional
 	NetworkArgs map[string]string
+	// ControlPlaneOverrides injects extra volumes, envs and sidecars into the
+	// static pod manifests of control plane components.
+	// +optional
+	ControlPlaneOverrides *ControlPlaneOverrides
+	// ContainerRegistries configures the registry mirrors, insecure registries
+	// and registry credentials of the container runtime on all nodes.
+	// +optional
+	ContainerRegistries *ContainerRegistryConfig
 }
 
 // ClusterStatus represents information about the status of a cluster.
@@ -201,6 +209,31 @@ type ClusterStatus struct {
 	NodeCIDRMaskSizeIPv6 int32
 	// +optional
 	KubeVendor KubeVendorType
+	// Progress records the phases progress of the current operation.
+	// +optional
+	Progress *Progress
+	// EtcdSnapshot records the latest etcd snapshot taken before control plane changes.
+	// +optional
+	EtcdSnapshot *EtcdSnapshot
+	// Certificates records the expiration of control plane certificates.
+	// +optional
+	Certificates *CertificatesStatus
+	// EtcdMaintenance records the latest compaction and defragmentation of local etcd.
+	// +optional
+	EtcdMaintenance *EtcdMaintenanceStatus
+	// UpgradeCanary records the canary master of the upgrade in progress.
+	// +optional
+	UpgradeCanary *UpgradeCanaryStatus
+	// Health is the composite health score of cluster, which is used to sort clusters by risk.
+	// +optional
+	Health *ClusterHealth
+	// Images lists the images running in kube-system, which is refreshed by the
+	// health check of cluster.
+	// +optional
+	Images []ClusterImage
+	// NetworkPolicy reports whether the CNI enforces NetworkPolicy.
+	// +optional
+	NetworkPolicy *NetworkPolicyStatus
 }
 
 // FinalizerName is the name identifying a finalizer during cluster lifecycle.
@@ -217,6 +250,110 @@ const (
 // NetworkType defines the network type of cluster.
 type NetworkType string
 
+const (
+	// NetworkTypeGalaxy installs Galaxy with flannel as the overlay network.
+	NetworkTypeGalaxy NetworkType = "Galaxy"
+	// NetworkTypeCilium installs Cilium.
+	NetworkTypeCilium NetworkType = "Cilium"
+	// NetworkTypeCalico installs Calico.
+	NetworkTypeCalico NetworkType = "Calico"
+)
+
+// CalicoMode defines the routing mode of Calico.
+type CalicoMode string
+
+const (
+	// CalicoModeBGP routes the pod traffic natively and exchanges the routes by BGP.
+	CalicoModeBGP CalicoMode = "BGP"
+	// CalicoModeVXLAN encapsulates the pod traffic in VXLAN.
+	CalicoModeVXLAN CalicoMode = "VXLAN"
+)
+
+// KubeProxyMode defines the proxy mode of kube-proxy.
+type KubeProxyMode string
+
+const (
+	// KubeProxyModeIPTables programs the services with iptables rules.
+	KubeProxyModeIPTables KubeProxyMode = "iptables"
+	// KubeProxyModeIPVS programs the services with IPVS virtual servers.
+	KubeProxyModeIPVS KubeProxyMode = "ipvs"
+	// KubeProxyModeNFTables programs the services with nftables rules, which
+	// is supported since kubernetes 1.29.
+	KubeProxyModeNFTables KubeProxyMode = "nftables"
+)
+
+// ImageAdmissionMode defines the mode of image admission webhook.
+type ImageAdmissionMode string
+
+const (
+	// ImageAdmissionEnforce rejects the pods with images from disallowed registries.
+	ImageAdmissionEnforce ImageAdmissionMode = "Enforce"
+	// ImageAdmissionWarn admits the pods with images from disallowed registries
+	// but returns warnings to clients.
+	ImageAdmissionWarn ImageAdmissionMode = "Warn"
+)
+
+// CRDConflictPolicy defines how the addons handle the conflicting custom
+// resource definitions in cluster.
+type CRDConflictPolicy string
+
+const (
+	// CRDConflictFail stops installing the addon and reports the conflicts.
+	CRDConflictFail CRDConflictPolicy = "Fail"
+	// CRDConflictAdopt takes over the custom resource definitions owned by
+	// others if the versions required by the addon are served.
+	CRDConflictAdopt CRDConflictPolicy = "Adopt"
+	// CRDConflictOverwrite deletes the conflicting custom resource definitions
+	// so that they are recreated by the addon, the existing custom resources
+	// are deleted too.
+	CRDConflictOverwrite CRDConflictPolicy = "Overwrite"
+)
+
+// CRDConflictReason is the reason of a conflicting custom resource definition.
+type CRDConflictReason string
+
+const (
+	// CRDConflictOwner means the custom resource definition is owned by others.
+	CRDConflictOwner CRDConflictReason = "OwnerMismatch"
+	// CRDConflictVersion means the version required by the addon is not served.
+	CRDConflictVersion CRDConflictReason = "VersionMismatch"
+)
+
+// AddonDriftPolicy defines how the addons handle the components in cluster
+// which drift from the desired state, such as deleted or modified by hand.
+type AddonDriftPolicy string
+
+const (
+	// AddonDriftRepair applies the desired state of the drifted components
+	// again and reports them.
+	AddonDriftRepair AddonDriftPolicy = "Repair"
+	// AddonDriftReport only reports the drifted components.
+	AddonDriftReport AddonDriftPolicy = "Report"
+)
+
+// VolumeUsageAction defines what VolumeDecorator does to the pods whose
+// volumes exceed the usage threshold.
+type VolumeUsageAction string
+
+const (
+	// VolumeUsageAnnotate annotates the pods with the exceeded volumes.
+	VolumeUsageAnnotate VolumeUsageAction = "Annotate"
+	// VolumeUsageEvict annotates and evicts the pods with the exceeded volumes.
+	VolumeUsageEvict VolumeUsageAction = "Evict"
+)
+
+// PrometheusSizingTier defines the resource sizing of prometheus.
+type PrometheusSizingTier string
+
+const (
+	// PrometheusSizingSmall fits clusters with less than 50 nodes.
+	PrometheusSizingSmall PrometheusSizingTier = "Small"
+	// PrometheusSizingMedium fits clusters with less than 200 nodes.
+	PrometheusSizingMedium PrometheusSizingTier = "Medium"
+	// PrometheusSizingLarge fits clusters with more than 200 nodes.
+	PrometheusSizingLarge PrometheusSizingTier = "Large"
+)
+
 // GPUType defines the gpu type of cluster.
 type GPUType string
 
@@ -227,6 +364,30 @@ const (
 	GPUVirtual GPUType = "Virtual"
 )
 
+// GPUSharePolicy defines how GPUManager shares the GPU devices among containers.
+type GPUSharePolicy string
+
+const (
+	// GPUShareFractional allows the containers to request a fraction of a GPU.
+	GPUShareFractional GPUSharePolicy = "Fractional"
+	// GPUShareExclusive only allows the containers to request whole GPUs.
+	GPUShareExclusive GPUSharePolicy = "Exclusive"
+)
+
+// GPUMIGStrategy defines how the NVIDIA device plugin exposes the MIG devices.
+type GPUMIGStrategy string
+
+const (
+	// GPUMIGStrategyNone exposes the whole GPUs only.
+	GPUMIGStrategyNone GPUMIGStrategy = "none"
+	// GPUMIGStrategySingle exposes the MIG devices as nvidia.com/gpu, all the
+	// GPUs of a node must be partitioned with the same profile.
+	GPUMIGStrategySingle GPUMIGStrategy = "single"
+	// GPUMIGStrategyMixed exposes the MIG devices as nvidia.com/mig-<profile>,
+	// which allows pods to request specific MIG slices.
+	GPUMIGStrategyMixed GPUMIGStrategy = "mixed"
+)
+
 // ClusterPhase defines the phase of cluster constructor.
 type ClusterPhase string
 
@@ -268,6 +429,36 @@ type ClusterCondition struct {
 	Message string
 }
 
+// Progress records the phases progress of the current cluster or machine operation.
+type Progress struct {
+	// Total is the number of phases of the operation.
+	Total int32
+	// Completed is the number of phases which are done or skipped.
+	// +optional
+	Completed int32
+	// Phases records the execution of each phase in order.
+	// +optional
+	Phases []PhaseProgress
+}
+
+// PhaseProgress records the execution of a phase.
+type PhaseProgress struct {
+	// Name is the name of the phase.
+	Name string
+	// Time the phase starts to execute at the last time.
+	// +optional
+	StartTime metav1.Time
+	// Time the phase finishes successfully.
+	// +optional
+	EndTime metav1.Time
+	// Retries is the number of failed executions of the phase.
+	// +optional
+	Retries int32
+	// LastError is the error message of the last failed execution.
+	// +optional
+	LastError string
+}
+
 // AddressType indicates the type of cluster apiserver access address.
 type AddressType string
 
@@ -339,6 +530,17 @@ type ClusterCredential struct {
 	// For kubeadm init or join
 	// +optional
 	CertificateKey *string
+	// Endpoints are the host:port of kube-apiservers, such as each master of cluster
+	// without VIP, which clients fail over to when the cluster address is unreachable.
+	// +optional
+	Endpoints []string
+	// For uploading etcd snapshots to the S3 compatible storage of EtcdBackup
+	// +optional
+	EtcdBackupSecretAccessKey []byte
+	// RegistryPasswords are the passwords of the registries in ContainerRegistries
+	// of cluster by registry, which are provided through ClusterCredentialRef.
+	// +optional
+	RegistryPasswords map[string][]byte
 }
 
 // +genclient:nonNamespaced
@@ -388,6 +590,87 @@ type ClusterFeature struct {
 	// Upgrade control upgrade process.
 	// +optional
 	Upgrade Upgrade
+	// SandboxRuntime installs a sandboxed container runtime on the labeled machines.
+	// +optional
+	SandboxRuntime *SandboxRuntime
+	// NetworkEncryption encrypts the pod traffic between nodes.
+	// +optional
+	NetworkEncryption *NetworkEncryption
+	// Audit configures the audit policy and backends of apiserver.
+	// +optional
+	Audit *ClusterAudit
+	// EtcdBackup takes etcd snapshots before control plane changes such as upgrade.
+	// +optional
+	EtcdBackup *EtcdBackup
+	// SecretsEncryption encrypts secrets at rest in etcd.
+	// +optional
+	SecretsEncryption *SecretsEncryption
+	// CertificateRotation controls the rotation of control plane certificates,
+	// they are rotated automatically before expiration if not specified.
+	// +optional
+	CertificateRotation *CertificateRotation
+	// KubeletServerTLSBootstrap makes kubelets request serving certificates signed by
+	// the cluster CA, which are approved by platform after validating the node addresses.
+	// +optional
+	KubeletServerTLSBootstrap *bool
+	// CgroupDriver is the cgroup driver of kubelet and container runtime, systemd or cgroupfs.
+	// It defaults to systemd for new clusters, and cgroupfs if not set for compatibility.
+	// +optional
+	CgroupDriver string
+	// SystemTuning is applied when nodes are created and kept enforced afterwards.
+	// +optional
+	SystemTuning *SystemTuning
+	// TimeSync installs and configures chrony on nodes, and blocks nodes whose
+	// clock skew exceeds the threshold from joining the cluster.
+	// +optional
+	TimeSync *TimeSync
+	// ServiceOverrides injects environment variables and systemd drop-in
+	// overrides into kubelet and the container runtime on all nodes. Static pods
+	// are customized with StaticPodOverrides instead.
+	// +optional
+	ServiceOverrides *ServiceOverrides
+	// GalaxyNetwork is the underlay network of Galaxy for floating IP pods, which
+	// is rendered into the galaxy and floatingip ConfigMaps and kept reconciled.
+	// +optional
+	GalaxyNetwork *GalaxyNetwork
+	// Calico is the options of Calico, which is used if the NetworkType of
+	// cluster is Calico.
+	// +optional
+	Calico *CalicoNetwork
+	// Cilium is the options of Cilium, which is used if the NetworkType of
+	// cluster is Cilium.
+	// +optional
+	Cilium *CiliumNetwork
+	// ImageAdmission deploys an admission webhook which rejects pods with
+	// images out of the approved registries.
+	// +optional
+	ImageAdmission *ImageAdmission
+	// EnableNetworkCheck verifies the node-to-node, pod-to-pod, pod-to-service
+	// and DNS connectivity with probe pods after the cluster is installed, and
+	// fails the creation if any check fails.
+	// +optional
+	EnableNetworkCheck bool
+	// KubeProxy configures the proxy mode, IPVS scheduler and strict ARP of
+	// kube-proxy.
+	// +optional
+	KubeProxy *KubeProxy
+	// DNS manages the Corefile of CoreDNS and deploys dns-autoscaler, it is
+	// reapplied after upgrades which restore the default Corefile.
+	// +optional
+	DNS *ClusterDNS
+	// NetworkPolicy installs default-deny NetworkPolicies for the namespaces and
+	// checks if the CNI enforces NetworkPolicy, the result is reported in status.
+	// +optional
+	NetworkPolicy *NetworkPolicyFeature
+	// GPUManager configures the share policy, memory unit and nodes of GPUManager,
+	// which takes effect when GPUType is Virtual.
+	// +optional
+	GPUManager *GPUManagerFeature
+	// MIGStrategy is the MIG strategy of NVIDIA device plugin for physical GPU,
+	// none, single or mixed, defaults to none. MIG manager is deployed to
+	// reconcile the MIG geometry of GPUNodeConfigs if it is not none.
+	// +optional
+	MIGStrategy GPUMIGStrategy
 }
 
 type HA struct {
@@ -416,302 +699,1479 @@ type CSIOperatorFeature struct {
 	Version string
 }
 
-type AuthzWebhookAddr struct {
-	// +optional
-	Builtin *BuiltinAuthzWebhookAddr
-	// +optional
-	External *ExternalAuthzWebhookAddr
-}
+// SandboxRuntimeType defines the sandboxed container runtime of cluster.
+type SandboxRuntimeType string
 
-type BuiltinAuthzWebhookAddr struct{}
+const (
+	// SandboxRuntimeKata indicates kata-containers is used as the sandboxed runtime.
+	SandboxRuntimeKata SandboxRuntimeType = "Kata"
+	// SandboxRuntimeGVisor indicates gVisor is used as the sandboxed runtime.
+	SandboxRuntimeGVisor SandboxRuntimeType = "GVisor"
+)
 
-type ExternalAuthzWebhookAddr struct {
-	IP   string `json:"ip" protobuf:"bytes,1,name=ip"`
-	Port int32  `json:"port" protobuf:"varint,2,name=port"`
+// SandboxRuntime describes the sandboxed container runtime of cluster.
+type SandboxRuntime struct {
+	Type SandboxRuntimeType
+	// Pods in untrusted namespaces default to the sandboxed RuntimeClass.
+	// +optional
+	UntrustedNamespaces []string
 }
 
-const (
-	// node lifecycle hook
-	HookPreInstall  HookType = "PreInstall"
-	HookPostInstall HookType = "PostInstall"
-	HookPreUpgrade  HookType = "PreUpgrade"
-	HookPostUpgrade HookType = "PostUpgrade"
+// NetworkEncryptionType defines the encryption protocol of the pod traffic between nodes.
+type NetworkEncryptionType string
 
-	// custer lifecycle hook
-	HookPreClusterInstall  HookType = "PreClusterInstall"
-	HookPostClusterInstall HookType = "PostClusterInstall"
-	HookPreClusterUpgrade  HookType = "PreClusterUpgrade"
-	HookPostClusterUpgrade HookType = "PostClusterUpgrade"
-	HookPreClusterDelete   HookType = "PreClusterDelete"
-	HookPostClusterDelete  HookType = "PostClusterDelete"
+const (
+	// NetworkEncryptionWireGuard indicates the traffic is encrypted by WireGuard.
+	NetworkEncryptionWireGuard NetworkEncryptionType = "WireGuard"
+	// NetworkEncryptionIPsec indicates the traffic is encrypted by IPsec.
+	NetworkEncryptionIPsec NetworkEncryptionType = "IPsec"
 )
 
-// ClusterProperty records the attribute information of the cluster.
-type ClusterProperty struct {
+// NetworkEncryption describes how the pod traffic between nodes is encrypted.
+type NetworkEncryption struct {
+	Type NetworkEncryptionType
+	// KeyRotationPeriod is the period to rotate the encryption keys, such as "720h".
+	// The keys are never rotated if it is empty.
 	// +optional
-	MaxClusterServiceNum *int32
+	KeyRotationPeriod string
+}
+
+// ClusterAudit describes the audit policy and backends of apiserver.
+type ClusterAudit struct {
+	// Policy is the audit policy in yaml, the platform default policy is used if it is empty.
 	// +optional
-	MaxNodePodNum *int32
+	Policy string
+	// Log writes the audit events to local files of master nodes.
 	// +optional
-	OversoldRatio map[string]string
+	Log *AuditLogBackend
+	// Webhook sends the audit events to the platform audit component.
+	// +optional
+	Webhook *AuditWebhookBackend
 }
 
-// Etcd contains elements describing Etcd configuration.
-type Etcd struct {
+// AuditLogBackend describes the log file and rotation of audit events.
+type AuditLogBackend struct {
+	// Path is the log file path, default is /var/log/kubernetes/audit/audit.log.
+	// +optional
+	Path string
+	// MaxAge is the maximum number of days to retain old log files.
+	// +optional
+	MaxAge int32
+	// MaxBackup is the maximum number of old log files to retain.
+	// +optional
+	MaxBackup int32
+	// MaxSize is the maximum size in megabytes of log file before it gets rotated.
+	// +optional
+	MaxSize int32
+}
 
-	// Local provides configuration knobs for configuring the local etcd instance
-	// Local and External are mutually exclusive
-	Local *LocalEtcd
+// AuditWebhookBackend describes where the audit events are sent to.
+type AuditWebhookBackend struct {
+	// Address is the address of audit component, default is the platform audit address.
+	// +optional
+	Address string
+}
 
-	// External describes how to connect to an external etcd cluster
-	// Local and External are mutually exclusive
-	External *ExternalEtcd
+// EtcdBackup describes where the etcd snapshots are saved before control plane changes.
+type EtcdBackup struct {
+	// Path is the directory on the first master to save snapshots, default is /var/lib/etcd-backup.
+	// +optional
+	Path string
+	// S3 uploads the snapshots to S3 compatible storage such as COS.
+	// +optional
+	S3 *S3Storage
 }
 
-// LocalEtcd describes that kubeadm should run an etcd cluster locally
-type LocalEtcd struct {
-	// DataDir is the directory etcd will place its data.
-	// Defaults to "/var/lib/etcd".
-	DataDir string
+// S3Storage describes a bucket of S3 compatible storage, the secret access
+// key is kept in the EtcdBackupSecretAccessKey of ClusterCredential.
+type S3Storage struct {
+	Endpoint string
+	// +optional
+	Region string
+	Bucket string
+	// Prefix is prepended to the name of uploaded objects.
+	// +optional
+	Prefix      string
+	AccessKeyID string
+}
 
-	// ExtraArgs are extra arguments provided to the etcd binary
-	// when run inside a static pod.
-	ExtraArgs map[string]string
+// EtcdSnapshot records an etcd snapshot which can be used to restore the cluster.
+type EtcdSnapshot struct {
+	// Location is the path on master or the url of object storage.
+	Location string
+	// Reason is the control plane change which the snapshot is taken for.
+	// +optional
+	Reason string
+	// Version is the kubernetes version of the cluster when the snapshot is taken.
+	// +optional
+	Version string
+	// +optional
+	CreationTime metav1.Time
+}
 
-	// ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.
-	ServerCertSANs []string
-	// PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.
-	PeerCertSANs []string
+// CertificatesStatus records the expiration of the certificates on masters.
+type CertificatesStatus struct {
+	// +optional
+	Certificates []CertificateExpiration
+	// +optional
+	LastRotationTime metav1.Time
 }
 
-// ExternalEtcd describes an external etcd cluster
-type ExternalEtcd struct {
+// CertificateExpiration is the expiration of a certificate on a master.
+type CertificateExpiration struct {
+	// Name is the file name relative to the certificates dir, such as apiserver.crt, or the absolute path of the kubelet client certificate.
+	Name string
+	// Node is the IP of master.
+	Node     string
+	NotAfter metav1.Time
+}
 
-	// Endpoints of etcd members. Useful for using external etcd.
-	// If not provided, kubeadm will run etcd in a static pod.
-	Endpoints []string
-	// CAFile is an SSL Certificate Authority file used to secure etcd communication.
-	CAFile string
-	// CertFile is an SSL certification file used to secure etcd communication.
-	CertFile string
-	// KeyFile is an SSL key file used to secure etcd communication.
-	KeyFile string
+// SecretsEncryptionProvider defines the provider which encrypts secrets in etcd.
+type SecretsEncryptionProvider string
+
+const (
+	// SecretsEncryptionSecretbox encrypts secrets by XSalsa20 and Poly1305 with keys generated by platform.
+	SecretsEncryptionSecretbox SecretsEncryptionProvider = "Secretbox"
+	// SecretsEncryptionKMS encrypts secrets by the envelope encryption of a KMS plugin.
+	SecretsEncryptionKMS SecretsEncryptionProvider = "KMS"
+)
+
+// SecretsEncryption describes how the secrets are encrypted at rest in etcd.
+type SecretsEncryption struct {
+	Provider SecretsEncryptionProvider
+	// KMS is the KMS plugin running on masters, required when provider is KMS.
+	// +optional
+	KMS *KMSPlugin
+	// KeyRotationPeriod is the period to rotate the secretbox key, such as "2160h".
+	// The key is never rotated if it is empty.
+	// +optional
+	KeyRotationPeriod string
 }
 
-type Upgrade struct {
-	// Upgrade mode, default value is Auto.
-	Mode UpgradeMode
-	// Upgrade strategy config.
-	Strategy UpgradeStrategy
+// KMSPlugin describes the KMS plugin which apiserver calls to encrypt data keys.
+type KMSPlugin struct {
+	Name string
+	// Endpoint is the unix socket of the KMS plugin, such as unix:///var/run/kmsplugin/socket.sock.
+	Endpoint string
+	// CacheSize is the number of data encryption keys cached in memory.
+	// +optional
+	CacheSize *int32
 }
 
-type UpgradeMode string
+// CertificateRotationMode defines when the certificates of control plane are rotated.
+type CertificateRotationMode string
 
 const (
-	// Upgrade nodes automatically.
-	UpgradeModeAuto = UpgradeMode("Auto")
-	// Manual upgrade nodes which means user need label node with `platform.tkestack.io/need-upgrade`.
-	UpgradeModeManual = UpgradeMode("Manual")
+	// CertificateRotationAuto rotates certificates before they expire, and on demand.
+	CertificateRotationAuto CertificateRotationMode = "Auto"
+	// CertificateRotationManual rotates certificates on demand only.
+	CertificateRotationManual CertificateRotationMode = "Manual"
 )
 
-// UpgradeStrategy used to control the upgrade process.
-type UpgradeStrategy struct {
-	// The maximum number of pods that can be unready during the upgrade.
-	// 0% means all pods need to be ready after evition.
-	// 100% means ignore any pods unready which may be used in one worker node, use this carefully!
-	// default value is 0%.
-	MaxUnready intstr.IntOrString
-	// Whether drain node before upgrade.
-	// Draining node before upgrade is recommended.
-	// But not all pod running as cows, a few running as pets.
-	// If your pod can not accept be expelled from current node, this value should be false.
-	DrainNodeBeforeUpgrade bool
+// CertificateRotation controls the rotation of the certificates generated by kubeadm.
+type CertificateRotation struct {
+	// Mode defaults to Auto.
+	// +optional
+	Mode CertificateRotationMode
+	// RenewBefore is how long before the expiration certificates are rotated in Auto mode, defaults to 720h.
+	// +optional
+	RenewBefore string
+	// RotateRequestedAt requests a rotation if it is after the last rotation.
+	// +optional
+	RotateRequestedAt *metav1.Time
 }
 
-// ResourceList is a set of (resource name, quantity) pairs.
-type ResourceList map[string]resource.Quantity
+// ControlPlaneOverrides customizes the static pod manifests of control plane components.
+type ControlPlaneOverrides struct {
+	// +optional
+	APIServer *StaticPodOverride
+	// +optional
+	ControllerManager *StaticPodOverride
+	// +optional
+	Scheduler *StaticPodOverride
+	// Etcd is ignored if the etcd is external.
+	// +optional
+	Etcd *StaticPodOverride
+}
 
-// ResourceRequirements describes the compute resource requirements.
-type ResourceRequirements struct {
-	Limits   ResourceList
-	Requests ResourceList
+// StaticPodOverride describes the extra volumes, envs and sidecars injected
+// into a static pod manifest.
+type StaticPodOverride struct {
+	// ExtraVolumes are added to the pod, they can be mounted by the component or sidecars.
+	// +optional
+	ExtraVolumes []corev1.Volume
+	// ExtraVolumeMounts are added to the component container.
+	// +optional
+	ExtraVolumeMounts []corev1.VolumeMount
+	// ExtraEnv are added to the component container.
+	// +optional
+	ExtraEnv []corev1.EnvVar
+	// Sidecars are added to the pod, such as audit log shippers.
+	// +optional
+	Sidecars []corev1.Container
 }
 
-// ClusterResource records the current available and maximum resource quota
-// information for the cluster.
-type ClusterResource struct {
-	// Capacity represents the total resources of a cluster.
+// ContainerRegistryConfig describes the registries used by the container runtime of nodes.
+type ContainerRegistryConfig struct {
+	// Mirrors are the registry mirror endpoints, such as https://mirror.example.com.
 	// +optional
-	Capacity ResourceList
-	// Allocatable represents the resources of a cluster that are available for scheduling.
-	// Defaults to Capacity.
+	Mirrors []string
+	// InsecureRegistries are pulled over http or without verifying certificates.
 	// +optional
-	Allocatable ResourceList
+	InsecureRegistries []string
+	// Auths are the credentials used to pull images from private registries.
 	// +optional
-	Allocated ResourceList
+	Auths []RegistryAuth
 }
 
-// ClusterComponent records the number of copies of each component of the
-// cluster master.
-type ClusterComponent struct {
-	Type     string
-	Replicas ClusterComponentReplicas
+// RegistryAuth is the credential of a private registry, the password is kept
+// in the RegistryPasswords of ClusterCredential by registry.
+type RegistryAuth struct {
+	Registry string
+	Username string
 }
 
-// ClusterComponentReplicas records the number of copies of each state of each
-// component of the cluster master.
-type ClusterComponentReplicas struct {
-	Desired   int32
-	Current   int32
-	Available int32
-	Updated   int32
+// SystemTuning describes the kernel parameters, kernel modules, ulimits and
+// transparent hugepage setting enforced on all nodes of the cluster.
+type SystemTuning struct {
+	// Sysctls override or extend the default kernel parameters, such as net.core.somaxconn.
+	// +optional
+	Sysctls map[string]string
+	// KernelModules are loaded in addition to the modules required by kubernetes.
+	// +optional
+	KernelModules []string
+	// Ulimits are the resource limits applied to all users.
+	// +optional
+	Ulimits []Ulimit
+	// TransparentHugepage is one of always, madvise or never. Left unchanged when empty.
+	// +optional
+	TransparentHugepage string
 }
 
-// AddonLevel indicates the level of cluster addon.
-type AddonLevel string
+// Ulimit is a resource limit in the format of limits.conf.
+type Ulimit struct {
+	// Name is the limited item, such as nofile or nproc.
+	Name string
+	Soft string
+	Hard string
+}
 
-// These are valid level of addon.
-const (
-	// LevelBasic is level for basic of cluster.
-	LevelBasic AddonLevel = "Basic"
-	// LevelEnhance is level for enhance of cluster.
-	LevelEnhance AddonLevel = "Enhance"
+// TimeSync describes the time synchronization of nodes.
+type TimeSync struct {
+	// Servers are the NTP servers used by chrony.
+	Servers []string
+	// MaxSkewSeconds is the max clock skew allowed between the node and the
+	// platform controller, defaults to 5.
+	// +optional
+	MaxSkewSeconds int32
+}
+
+// ServiceOverrides describes the overrides of systemd services on nodes.
+type ServiceOverrides struct {
+	// Kubelet overrides the kubelet service.
+	// +optional
+	Kubelet *ServiceOverride
+	// ContainerRuntime overrides the container runtime service.
+	// +optional
+	ContainerRuntime *ServiceOverride
+}
+
+// ServiceOverride describes the override of a systemd service, which is
+// written as a drop-in file of the service.
+type ServiceOverride struct {
+	// Env is the environment variables of the service, such as HTTP_PROXY.
+	// +optional
+	Env map[string]string
+	// DropIn is the extra content of the drop-in file, such as an
+	// ExecStartPre directive, directives without section header belong to [Service].
+	// +optional
+	DropIn string
+}
+
+// GalaxyNetwork describes the underlay network of Galaxy.
+type GalaxyNetwork struct {
+	// Device is the host interface of the underlay network, which may be a bond
+	// interface such as bond0. It defaults to the NetworkDevice of cluster.
+	// +optional
+	Device string
+	// FloatingIPPools are the IP pools allocated to floating IP pods.
+	// +optional
+	FloatingIPPools []FloatingIPPool
+}
+
+// FloatingIPPool describes the floating IPs of a subnet.
+type FloatingIPPool struct {
+	// Subnet is the CIDR of the floating IPs, such as 10.0.0.0/24.
+	Subnet string
+	// NodeSubnet is the CIDR of the nodes which can use the pool, it defaults to Subnet.
+	// +optional
+	NodeSubnet string
+	// Gateway is the gateway of Subnet.
+	Gateway string
+	// VLAN is the VLAN ID of Subnet, 0 means untagged.
+	// +optional
+	VLAN int32
+	// IPs are the IPs or IP ranges of the pool, such as 10.0.0.10 or 10.0.0.20~10.0.0.100.
+	IPs []string
+}
+
+// CalicoNetwork describes the options of Calico.
+type CalicoNetwork struct {
+	// Mode is the routing mode of Calico, defaults to VXLAN.
+	// +optional
+	Mode CalicoMode
+	// ASNumber is the BGP AS number of nodes in BGP mode, defaults to 64512.
+	// +optional
+	ASNumber int32
+	// MTU of the pod interfaces, defaults to 1450 in VXLAN mode and 1500 in BGP
+	// mode.
+	// +optional
+	MTU int32
+}
+
+// CiliumNetwork describes the options of Cilium.
+type CiliumNetwork struct {
+	// KubeProxyReplacement replaces kube-proxy with the eBPF service load
+	// balancing of Cilium, kube-proxy is not installed if it is enabled.
+	// +optional
+	KubeProxyReplacement bool
+}
+
+// KubeProxy describes the options of kube-proxy.
+type KubeProxy struct {
+	// Mode of kube-proxy, defaults to ipvs if IPVS feature is enabled, otherwise
+	// iptables.
+	// +optional
+	Mode KubeProxyMode
+	// IPVSScheduler is the scheduler of IPVS virtual servers, such as rr, wrr,
+	// lc, wlc, sh and dh, defaults to rr. Only used in ipvs mode.
+	// +optional
+	IPVSScheduler string
+	// StrictARP makes nodes not answer the ARP queries for the addresses on
+	// kube-ipvs0, which is required by MetalLB in layer 2 mode. Only used in ipvs
+	// mode.
+	// +optional
+	StrictARP bool
+}
+
+// ClusterDNS customizes the Corefile of CoreDNS and the autoscaling of its
+// replicas.
+type ClusterDNS struct {
+	// StubDomains forward the queries of the domains to their own nameservers.
+	// +optional
+	StubDomains []DNSStubDomain
+	// UpstreamNameservers are the nameservers for the queries out of the cluster
+	// domain and stub domains, defaults to the /etc/resolv.conf of nodes.
+	// +optional
+	UpstreamNameservers []string
+	// CacheTTL is the max TTL in seconds of the cached records, defaults to 30.
+	// +optional
+	CacheTTL int32
+	// Autoscaler deploys dns-autoscaler which scales the replicas of CoreDNS
+	// in proportion to the nodes and cores of cluster.
+	// +optional
+	Autoscaler *DNSAutoscaler
+}
+
+// NetworkPolicyFeature bootstraps default-deny NetworkPolicies and verifies
+// the NetworkPolicy enforcement of CNI when the cluster is created.
+type NetworkPolicyFeature struct {
+	// DefaultDenyNamespaces are the namespaces to install the default-deny
+	// NetworkPolicy which denies all ingress traffic of pods, the namespaces not
+	// existing are created.
+	// +optional
+	DefaultDenyNamespaces []string
+	// DenyEgress denies the egress traffic of pods in DefaultDenyNamespaces too,
+	// except DNS queries.
+	// +optional
+	DenyEgress bool
+}
+
+// NetworkPolicyStatus is the result of the NetworkPolicy enforcement check with
+// probe pods.
+type NetworkPolicyStatus struct {
+	// Enforced is true if the traffic denied by a NetworkPolicy is blocked.
+	Enforced bool
+	// Message describes why the enforcement is not verified.
+	// +optional
+	Message string
+	// +optional
+	LastProbeTime metav1.Time
+}
+
+// GPUManagerFeature configures the vGPU scheduling of GPUManager deployed for
+// the clusters with virtual GPU.
+type GPUManagerFeature struct {
+	// SharePolicy is Fractional or Exclusive, defaults to Fractional.
+	// +optional
+	SharePolicy GPUSharePolicy
+	// MemoryUnitMiB is the size in MiB of one unit of tencent.com/vcuda-memory,
+	// defaults to 256.
+	// +optional
+	MemoryUnitMiB int32
+	// NodeSelector selects the GPU nodes to run GPUManager in addition to the
+	// nodes with GPU driver installed.
+	// +optional
+	NodeSelector map[string]string
+}
+
+// DNSStubDomain is a domain resolved by its own nameservers.
+type DNSStubDomain struct {
+	// Domain is the zone to forward, such as example.com.
+	Domain string
+	// Nameservers are the IP addresses with optional ports of the nameservers.
+	Nameservers []string
+}
+
+// DNSAutoscaler describes the linear parameters of dns-autoscaler, the
+// replicas are max(ceil(cores / coresPerReplica), ceil(nodes / nodesPerReplica))
+// bounded by min and max.
+type DNSAutoscaler struct {
+	// CoresPerReplica defaults to 256.
+	// +optional
+	CoresPerReplica int32
+	// NodesPerReplica defaults to 16.
+	// +optional
+	NodesPerReplica int32
+	// Min is the minimum replicas, defaults to 1.
+	// +optional
+	Min int32
+	// Max is the maximum replicas, unlimited if zero.
+	// +optional
+	Max int32
+	// PreventSinglePointFailure keeps at least 2 replicas if the cluster has
+	// more than one node.
+	// +optional
+	PreventSinglePointFailure bool
+}
+
+// ImageAdmission restricts the images of pods in cluster to approved registries.
+type ImageAdmission struct {
+	// Mode of the webhook, defaults to Enforce.
+	// +optional
+	Mode ImageAdmissionMode
+	// AllowedRegistries are the image prefixes allowed in all namespaces, such
+	// as registry.example.com/ or docker.io/library/.
+	// +optional
+	AllowedRegistries []string
+	// Projects are the additional image prefixes allowed in namespaces of the
+	// projects.
+	// +optional
+	Projects []ProjectImageAdmission
+	// ExemptNamespaces are the namespaces not checked by the webhook.
+	// +optional
+	ExemptNamespaces []string
+}
+
+// ProjectImageAdmission describes the image prefixes allowed in namespaces of a
+// project.
+type ProjectImageAdmission struct {
+	// ProjectName is the name of project in business.
+	ProjectName string
+	// AllowedRegistries are the image prefixes allowed in namespaces of the
+	// project.
+	AllowedRegistries []string
+}
+
+type AuthzWebhookAddr struct {
+	// +optional
+	Builtin *BuiltinAuthzWebhookAddr
+	// +optional
+	External *ExternalAuthzWebhookAddr
+}
+
+type BuiltinAuthzWebhookAddr struct{}
+
+type ExternalAuthzWebhookAddr struct {
+	IP   string `json:"ip" protobuf:"bytes,1,name=ip"`
+	Port int32  `json:"port" protobuf:"varint,2,name=port"`
+}
+
+const (
+	// node lifecycle hook
+	HookPreInstall  HookType = "PreInstall"
+	HookPostInstall HookType = "PostInstall"
+	HookPreUpgrade  HookType = "PreUpgrade"
+	HookPostUpgrade HookType = "PostUpgrade"
+
+	// custer lifecycle hook
+	HookPreClusterInstall  HookType = "PreClusterInstall"
+	HookPostClusterInstall HookType = "PostClusterInstall"
+	HookPreClusterUpgrade  HookType = "PreClusterUpgrade"
+	HookPostClusterUpgrade HookType = "PostClusterUpgrade"
+	HookPreClusterDelete   HookType = "PreClusterDelete"
+	HookPostClusterDelete  HookType = "PostClusterDelete"
+)
+
+// ClusterProperty records the attribute information of the cluster.
+type ClusterProperty struct {
+	// +optional
+	MaxClusterServiceNum *int32
+	// +optional
+	MaxNodePodNum *int32
+	// +optional
+	OversoldRatio map[string]string
+}
+
+// Etcd contains elements describing Etcd configuration.
+type Etcd struct {
+
+	// Local provides configuration knobs for configuring the local etcd instance
+	// Local and External are mutually exclusive
+	Local *LocalEtcd
+
+	// External describes how to connect to an external etcd cluster
+	// Local and External are mutually exclusive
+	External *ExternalEtcd
+}
+
+// LocalEtcd describes that kubeadm should run an etcd cluster locally
+type LocalEtcd struct {
+	// DataDir is the directory etcd will place its data.
+	// Defaults to "/var/lib/etcd".
+	DataDir string
+
+	// ExtraArgs are extra arguments provided to the etcd binary
+	// when run inside a static pod.
+	ExtraArgs map[string]string
+
+	// ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.
+	ServerCertSANs []string
+	// PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.
+	PeerCertSANs []string
+	// QuotaBackendBytes is the backend storage size limit of etcd in bytes, etcd raises
+	// a NOSPACE alarm when it is exceeded. Only takes effect when creating the cluster.
+	// +optional
+	QuotaBackendBytes *int64
+	// HeartbeatInterval is the time in milliseconds of a heartbeat interval.
+	// Only takes effect when creating the cluster.
+	// +optional
+	HeartbeatInterval *int32
+	// ElectionTimeout is the time in milliseconds for an election to timeout.
+	// Only takes effect when creating the cluster.
+	// +optional
+	ElectionTimeout *int32
+	// DataDevice is a dedicated block device of masters such as /dev/vdb, which is
+	// formatted and mounted to DataDir before etcd starts.
+	// +optional
+	DataDevice string
+	// Maintenance compacts and defragments etcd periodically.
+	// +optional
+	Maintenance *EtcdMaintenance
+}
+
+// EtcdMaintenance describes the compaction and defragmentation of local etcd.
+type EtcdMaintenance struct {
+	// Interval is the duration between two maintenances, defaults to 168h.
+	// +optional
+	Interval string
+	// RunRequestedAt requests a maintenance if it is after the last maintenance.
+	// +optional
+	RunRequestedAt *metav1.Time
+}
+
+// EtcdMaintenanceStatus records the latest maintenance of local etcd.
+type EtcdMaintenanceStatus struct {
+	// LastRunTime is the time when the latest maintenance finished.
+	// +optional
+	LastRunTime metav1.Time
+	// CompactedRevision is the revision which etcd is compacted to.
+	// +optional
+	CompactedRevision int64
+}
+
+// ExternalEtcd describes an external etcd cluster
+type ExternalEtcd struct {
+
+	// Endpoints of etcd members. Useful for using external etcd.
+	// If not provided, kubeadm will run etcd in a static pod.
+	Endpoints []string
+	// CAFile is an SSL Certificate Authority file used to secure etcd communication.
+	CAFile string
+	// CertFile is an SSL certification file used to secure etcd communication.
+	CertFile string
+	// KeyFile is an SSL key file used to secure etcd communication.
+	KeyFile string
+}
+
+type Upgrade struct {
+	// Upgrade mode, default value is Auto.
+	Mode UpgradeMode
+	// Upgrade strategy config.
+	Strategy UpgradeStrategy
+	// Paused stops upgrading more nodes, the nodes being upgraded are not interrupted.
+	Paused bool
+	// Canary upgrades the first master only and holds the upgrade for verification
+	// before the remaining masters and workers are upgraded.
+	// +optional
+	Canary *UpgradeCanary
+}
+
+// UpgradeCanary holds the upgrade after the first master is upgraded until it is approved.
+type UpgradeCanary struct {
+	// ApprovedVersion continues the upgrade when it is the version being upgraded to,
+	// the same as annotating the cluster with platform.tkestack.io/upgrade-canary-approved.
+	// +optional
+	ApprovedVersion string
+}
+
+// UpgradeCanaryStatus records the health checks of the canary master.
+type UpgradeCanaryStatus struct {
+	// Version is the version which the canary master is upgraded to.
+	Version string
+	// Node is the IP of the canary master.
+	Node string
+	// Checks are the results of health checks such as APIServer, Scheduling and DNS.
+	// +optional
+	Checks []ClusterCondition
+	// Approved indicates the upgrade continues to the remaining nodes.
+	// +optional
+	Approved bool
+}
+
+// ClusterHealth is the weighted score of the health dimensions of cluster.
+type ClusterHealth struct {
+	// Score ranges from 0 to 100, the lower the riskier.
+	Score int32
+	// Dimensions are the scores of ControlPlane, Nodes, Addons, Certificates and Capacity.
+	// +optional
+	Dimensions []ClusterHealthDimension
+	// +optional
+	LastUpdateTime metav1.Time
+}
+
+// ClusterHealthDimension is the score of a health dimension of cluster.
+type ClusterHealthDimension struct {
+	Name string
+	// Score ranges from 0 to 100.
+	Score int32
+	// Weight is the percentage of the dimension in the composite score.
+	Weight int32
+	// Message describes the problems which lower the score.
+	// +optional
+	Message string
+}
+
+// ClusterImage is an image run by a component in the kube-system namespace of
+// cluster.
+type ClusterImage struct {
+	// Component is the workload running the image, such as deployment/coredns,
+	// daemonset/kube-proxy or pod/kube-apiserver for static pods.
+	Component string
+	// Container is the name of container, init containers are included.
+	Container string
+	// Image is the image:tag in the pod spec.
+	Image string
+	// Digest is the repository digest reported by container runtime, which is
+	// empty before the container starts or when the runtime does not report it.
+	// +optional
+	Digest string
+}
+
+type UpgradeMode string
+
+const (
+	// Upgrade nodes automatically.
+	UpgradeModeAuto = UpgradeMode("Auto")
+	// Manual upgrade nodes which means user need label node with `platform.tkestack.io/need-upgrade`.
+	UpgradeModeManual = UpgradeMode("Manual")
 )
 
+// UpgradeStrategy used to control the upgrade process.
+type UpgradeStrategy struct {
+	// The maximum number of pods that can be unready during the upgrade.
+	// 0% means all pods need to be ready after evition.
+	// 100% means ignore any pods unready which may be used in one worker node, use this carefully!
+	// default value is 0%.
+	MaxUnready intstr.IntOrString
+	// Whether drain node before upgrade.
+	// Draining node before upgrade is recommended.
+	// But not all pod running as cows, a few running as pets.
+	// If your pod can not accept be expelled from current node, this value should be false.
+	DrainNodeBeforeUpgrade bool
+	// The maximum number of worker nodes that can be unavailable during the upgrade.
+	// Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%).
+	// default value is 1.
+	MaxUnavailable *intstr.IntOrString
+	// The maximum number of worker nodes that can be upgrading above
+	// MaxUnavailable, these nodes are prepared for the upgrade while the worker
+	// nodes which are not ready or unschedulable are still within MaxUnavailable.
+	// Unlike maxSurge of a Deployment, no extra worker nodes are created, the
+	// nodes are upgraded in place. Percentages are rounded up.
+	// Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%).
+	// default value is 0.
+	MaxSurge *intstr.IntOrString
+}
+
+// ResourceList is a set of (resource name, quantity) pairs.
+type ResourceList map[string]resource.Quantity
+
+// ResourceRequirements describes the compute resource requirements.
+type ResourceRequirements struct {
+	Limits   ResourceList
+	Requests ResourceList
+}
+
+// ClusterResource records the current available and maximum resource quota
+// information for the cluster.
+type ClusterResource struct {
+	// Capacity represents the total resources of a cluster.
+	// +optional
+	Capacity ResourceList
+	// Allocatable represents the resources of a cluster that are available for scheduling.
+	// Defaults to Capacity.
+	// +optional
+	Allocatable ResourceList
+	// +optional
+	Allocated ResourceList
+}
+
+// ClusterComponent records the number of copies of each component of the
+// cluster master.
+type ClusterComponent struct {
+	Type     string
+	Replicas ClusterComponentReplicas
+}
+
+// ClusterComponentReplicas records the number of copies of each state of each
+// component of the cluster master.
+type ClusterComponentReplicas struct {
+	Desired   int32
+	Current   int32
+	Available int32
+	Updated   int32
+}
+
+// AddonLevel indicates the level of cluster addon.
+type AddonLevel string
+
+// These are valid level of addon.
+const (
+	// LevelBasic is level for basic of cluster.
+	LevelBasic AddonLevel = "Basic"
+	// LevelEnhance is level for enhance of cluster.
+	LevelEnhance AddonLevel = "Enhance"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=list,get
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAddon contains the Addon component for the current kubernetes cluster
+type ClusterAddon struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Spec defines the desired identities of addons in this set.
+	// +optional
+	Spec ClusterAddonSpec
+	// +optional
+	Status ClusterAddonStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAddonList is the whole list of all ClusterAddon.
+type ClusterAddonList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of ClusterAddon
+	Items []ClusterAddon
+}
+
+// ClusterAddonSpec indicates the specifications of the ClusterAddon.
+type ClusterAddonSpec struct {
+	// Addon type, one of Helm, PersistentEvent or LogCollector etc.
+	Type string
+	// AddonLevel is level of cluster addon.
+	Level AddonLevel
+	// Version
+	Version string
+}
+
+// ClusterAddonStatus is information about the current status of a ClusterAddon.
+type ClusterAddonStatus struct {
+	// +optional
+	Version string
+	// Phase is the current lifecycle phase of the addon of cluster.
+	// +optional
+	Phase string
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:onlyVerbs=list
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAddonType records the all addons of cluster available.
+type ClusterAddonType struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// Addon type, one of Helm, PersistentEvent or LogCollector etc.
+	Type string
+	// AddonLevel is level of cluster addon.
+	Level AddonLevel
+	// LatestVersion is latest version of the addon.
+	LatestVersion string
+	// Description is desc of the addon.
+	Description           string
+	CompatibleClusterType []string
+	// Dependencies are the addon types installed in the cluster before the
+	// addon, which are created automatically if missing.
+	// +optional
+	Dependencies []string
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.
+type ClusterAddonTypeList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// +optional
+	Items []ClusterAddonType
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.
+type ClusterApplyOptions struct {
+	metav1.TypeMeta
+	// +optional
+	NotUpdate bool
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterLogsOptions is the query options to stream the logs of a container of
+// cluster through the platform api.
+type ClusterLogsOptions struct {
+	metav1.TypeMeta
+	// Namespace is the namespace of the pod.
+	// +optional
+	Namespace string
+	// Pod is the name of the pod.
+	// +optional
+	Pod string
+	// The container for which to stream logs. Defaults to only container if there is one container in the pod.
+	// +optional
+	Container string
+	// Follow the log stream of the pod. Defaults to false.
+	// +optional
+	Follow bool
+	// Return previous terminated container logs. Defaults to false.
+	// +optional
+	Previous bool
+	// A relative time in seconds before the current time from which to show logs. Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	SinceSeconds *int64
+	// An RFC3339 timestamp from which to show logs. Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	SinceTime *metav1.Time
+	// If true, add an RFC3339 timestamp at the beginning of every line of log output. Defaults to false.
+	// +optional
+	Timestamps bool
+	// If set, the number of lines from the end of the logs to show, which is limited by the platform api.
+	// +optional
+	TailLines *int64
+	// If set, the number of bytes to read from the server before terminating the log output, which is limited by the platform api.
+	// +optional
+	LimitBytes *int64
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Registry records the third-party image repository information stored by the
+// user.
+type Registry struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+	// +optional
+	Spec RegistrySpec
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// RegistryList is a resource containing a list of Registry objects.
+type RegistryList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// +optional
+	Items []Registry
+}
+
+// RegistrySpec indicates the specifications of the third-party image repository.
+type RegistrySpec struct {
+	// +optional
+	TenantID string
+	// +optional
+	DisplayName string
+	// +optional
+	ClusterName string
+	// +optional
+	URL string
+	// +optional
+	UserName *string
+	// +optional
+	Password *string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PersistentEvent is a recorder of kubernetes event.
+type PersistentEvent struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of clusters in this set.
+	// +optional
+	Spec PersistentEventSpec
+	// +optional
+	Status PersistentEventStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PersistentEventList is the whole list of all clusters which owned by a tenant.
+type PersistentEventList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of PersistentEvents
+	Items []PersistentEvent
+}
+
+// PersistentEventSpec describes the attributes on a PersistentEvent.
+type PersistentEventSpec struct {
+	TenantID          string
+	ClusterName       string
+	PersistentBackEnd PersistentBackEnd
+	Version           string
+	// Buffer handles the backpressure of the backend storage.
+	// +optional
+	Buffer *PersistentEventBuffer
+}
+
+// PersistentEventStatus is information about the current status of a
+// PersistentEvent.
+type PersistentEventStatus struct {
+	// +optional
+	Version string
+	// Phase is the current lifecycle phase of the persistent event of cluster.
+	// +optional
+	Phase AddonPhase
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string
+	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// +optional
+	RetryCount int32
+	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time
+}
+
+// PersistentBackEnd indicates the backend type and attributes of the persistent
+// log store.
+type PersistentBackEnd struct {
+	CLS   *StorageBackEndCLS
+	ES    *StorageBackEndES
+	Kafka *StorageBackEndKafka
+	Loki  *StorageBackEndLoki
+}
+
+// StorageBackEndCLS records the attributes required when the backend storage
+// type is CLS.
+type StorageBackEndCLS struct {
+	LogSetID string
+	TopicID  string
+}
+
+// StorageBackEndES records the attributes required when the backend storage
+// type is ElasticSearch.
+type StorageBackEndES struct {
+	IP          string
+	Port        int32
+	Scheme      string
+	IndexName   string
+	User        string
+	Password    string
+	ReserveDays int32
+}
+
+// StorageBackEndKafka records the attributes required when the backend storage
+// type is Kafka.
+type StorageBackEndKafka struct {
+	// Brokers are the addresses of Kafka brokers, such as 10.0.0.1:9092.
+	Brokers []string
+	// Topic is the topic which the events are produced to.
+	Topic string
+	// SASL authenticates to the brokers, disabled if nil.
+	// +optional
+	SASL *StorageBackEndSASL
+	// TLS connects to the brokers over TLS, disabled if nil.
+	// +optional
+	TLS *StorageBackEndTLS
+}
+
+// StorageBackEndLoki records the attributes required when the backend storage
+// type is Loki.
+type StorageBackEndLoki struct {
+	// URL is the address of Loki, such as http://loki:3100.
+	URL string
+	// TenantID is the X-Scope-OrgID of the multi-tenant Loki.
+	// +optional
+	TenantID string
+	// User is the user of basic authentication.
+	// +optional
+	User string
+	// Password is the base64 encoded password of basic authentication.
+	// +optional
+	Password string
+	// Labels are the extra labels of the event streams.
+	// +optional
+	Labels map[string]string
+	// TLS configures the certificates to connect to Loki over https.
+	// +optional
+	TLS *StorageBackEndTLS
+}
+
+// StorageBackEndSASL records the SASL authentication of a backend storage.
+type StorageBackEndSASL struct {
+	// Mechanism is PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, defaults to PLAIN.
+	// +optional
+	Mechanism string
+	User      string
+	// Password is the base64 encoded password.
+	Password string
+}
+
+// StorageBackEndTLS records the certificates to connect to a backend storage.
+type StorageBackEndTLS struct {
+	// CACert is the PEM encoded CA certificate to verify the server.
+	// +optional
+	CACert string
+	// ClientCert is the PEM encoded client certificate.
+	// +optional
+	ClientCert string
+	// ClientKey is the PEM encoded private key of the client certificate.
+	// +optional
+	ClientKey string
+	// InsecureSkipVerify skips the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool
+}
+
+// PersistentEventBuffer describes how events are buffered when the backend
+// storage is slow or unavailable.
+type PersistentEventBuffer struct {
+	// TotalLimitSize is the max size of buffered events, such as 32MB,
+	// defaults to 32MB.
+	// +optional
+	TotalLimitSize string
+	// OverflowAction is the action when the buffer is full, block pauses
+	// reading events, drop_oldest_chunk drops the oldest events, defaults to
+	// block.
+	// +optional
+	OverflowAction string
+}
+
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelmProxyOptions is the query options to a Helm-api proxy call.
+type HelmProxyOptions struct {
+	metav1.TypeMeta
+
+	// Path is the URL path to use for the current proxy request to helm-api.
+	// +optional
+	Path string
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Helm is a kubernetes package manager.
+type Helm struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the desired identities of clusters in this set.
+	// +optional
+	Spec HelmSpec
+	// +optional
+	Status HelmStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HelmList is the whole list of all helms which owned by a tenant.
+type HelmList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of Helms
+	Items []Helm
+}
+
+// HelmSpec describes the attributes on a Helm.
+type HelmSpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
+}
+
+// HelmStatus is information about the current status of a Helm.
+type HelmStatus struct {
+	// +optional
+	Version string
+	// Phase is the current lifecycle phase of the helm of cluster.
+	// +optional
+	Phase AddonPhase
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string
+	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// +optional
+	RetryCount int32
+	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time
+}
+
 // +genclient
 // +genclient:nonNamespaced
-// +genclient:onlyVerbs=list,get
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ClusterAddon contains the Addon component for the current kubernetes cluster
-type ClusterAddon struct {
+// Prometheus is a kubernetes package manager.
+type Prometheus struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
-	// Spec defines the desired identities of addons in this set.
+
+	// Spec defines the desired identities of clusters in this set.
 	// +optional
-	Spec ClusterAddonSpec
+	Spec PrometheusSpec
 	// +optional
-	Status ClusterAddonStatus
+	Status PrometheusStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ClusterAddonList is the whole list of all ClusterAddon.
-type ClusterAddonList struct {
+// PrometheusList is the whole list of all prometheus which owned by a tenant.
+type PrometheusList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
-	// List of ClusterAddon
-	Items []ClusterAddon
+
+	// List of Prometheuss
+	Items []Prometheus
 }
 
-// ClusterAddonSpec indicates the specifications of the ClusterAddon.
-type ClusterAddonSpec struct {
-	// Addon type, one of Helm, PersistentEvent or LogCollector etc.
-	Type string
-	// AddonLevel is level of cluster addon.
-	Level AddonLevel
-	// Version
-	Version string
+// PrometheusSpec describes the attributes on a Prometheus.
+type PrometheusSpec struct {
+	TenantID      string
+	ClusterName   string
+	Version       string
+	SubVersion    map[string]string
+	RemoteAddress PrometheusRemoteAddr
+	// +optional
+	NotifyWebhook string
+	// +optional
+	Resources ResourceRequirements
+	// +optional
+	RunOnMaster bool
+	// +optional
+	AlertRepeatInterval string
+	// +optional
+	WithNPD bool
+	// RemoteWrites are the extra remote write endpoints besides RemoteAddress.
+	// +optional
+	RemoteWrites []PrometheusRemoteWrite
+	// ExternalLabels are added to the metrics sent to remote endpoints,
+	// cluster_id, tenant_id and cluster_display_name can not be overridden.
+	// +optional
+	ExternalLabels map[string]string
+	// Retention is how long prometheus retains data locally, such as 2h,
+	// defaults to 30m.
+	// +optional
+	Retention string
+	// RetentionSize is the max bytes of local data, such as 10GB, defaults
+	// to 5GB.
+	// +optional
+	RetentionSize string
+	// SizingTier sizes the resources of prometheus, ignored if Resources is
+	// set.
+	// +optional
+	SizingTier PrometheusSizingTier
 }
 
-// ClusterAddonStatus is information about the current status of a ClusterAddon.
-type ClusterAddonStatus struct {
+// PrometheusStatus is information about the current status of a Prometheus.
+type PrometheusStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the addon of cluster.
+	// Phase is the current lifecycle phase of the helm of cluster.
 	// +optional
-	Phase string
+	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
 	// +optional
 	Reason string
+	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// +optional
+	RetryCount int32
+	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time
+	// SubVersion is the components version such as node-exporter.
+	SubVersion map[string]string
+}
+
+// PrometheusRemoteAddr is the remote write/read address for prometheus
+type PrometheusRemoteAddr struct {
+	WriteAddr []string
+	ReadAddr  []string
+}
+
+// PrometheusRemoteWrite is a remote write endpoint of prometheus, such as
+// Cortex, Mimir or Thanos receive.
+type PrometheusRemoteWrite struct {
+	// Name identifies the endpoint, unique in a prometheus.
+	Name string
+	// URL is the remote write address, such as
+	// http://cortex:9009/api/v1/push.
+	URL string
+	// BasicAuth authenticates to the endpoint, disabled if nil.
+	// +optional
+	BasicAuth *PrometheusBasicAuth
+	// BearerToken is the base64 encoded bearer token to authenticate to the
+	// endpoint.
+	// +optional
+	BearerToken string
+	// TLS configures the certificates to connect to the endpoint over https.
+	// +optional
+	TLS *PrometheusTLSConfig
+	// MetricsRegex keeps only the metrics whose names match the regex, all
+	// metrics are written if empty.
+	// +optional
+	MetricsRegex string
+}
+
+// PrometheusBasicAuth records the basic authentication of a remote endpoint.
+type PrometheusBasicAuth struct {
+	User string
+	// Password is the base64 encoded password.
+	Password string
+}
+
+// PrometheusTLSConfig records the certificates to connect to a remote endpoint.
+type PrometheusTLSConfig struct {
+	// CACert is the PEM encoded CA certificate to verify the server.
+	// +optional
+	CACert string
+	// ClientCert is the PEM encoded client certificate.
+	// +optional
+	ClientCert string
+	// ClientKey is the PEM encoded private key of the client certificate.
+	// +optional
+	ClientKey string
+	// InsecureSkipVerify skips the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool
+}
+
+// AddonPhase defines the phase of addon
+type AddonPhase string
+
+const (
+	// AddonPhaseInitializing means is wait initializing.
+	AddonPhaseInitializing AddonPhase = "Initializing"
+	// AddonPhaseReinitializing means is reinitializing.
+	AddonPhaseReinitializing AddonPhase = "Reinitializing"
+	// AddonPhaseChecking means is wait checking.
+	AddonPhaseChecking AddonPhase = "Checking"
+	// AddonPhaseRunning means is running.
+	AddonPhaseRunning AddonPhase = "Running"
+	// AddonPhaseUpgrading means is upgrading.
+	AddonPhaseUpgrading AddonPhase = "Upgrading"
+	// AddonPhaseFailed means has been failed.
+	AddonPhaseFailed AddonPhase = "Failed"
+	// AddonPhasePending means the controller is proceeding deploying
+	AddonPhasePending AddonPhase = "Pending"
+	// AddonPhaseUnhealthy means some pods of GPUManager is partial running
+	AddonPhaseUnhealthy AddonPhase = "Unhealthy"
+	// AddonPhaseTerminating means addon terminating
+	AddonPhaseTerminating AddonPhase = "Terminating"
+	// AddonPhaseUnknown means addon unknown
+	AddonPhaseUnknown AddonPhase = "Unknown"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// IPAMProxyOptions is the query options to a Helm-api proxy call.
+type IPAMProxyOptions struct {
+	metav1.TypeMeta
+
+	// Path is the URL path to use for the current proxy request to helm-api.
+	// +optional
+	Path string
 }
 
 // +genclient
 // +genclient:nonNamespaced
-// +genclient:onlyVerbs=list
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ClusterAddonType records the all addons of cluster available.
-type ClusterAddonType struct {
+// IPAM is a scheduler plugin for assigning IP.
+type IPAM struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
-	// Addon type, one of Helm, PersistentEvent or LogCollector etc.
-	Type string
-	// AddonLevel is level of cluster addon.
-	Level AddonLevel
-	// LatestVersion is latest version of the addon.
-	LatestVersion string
-	// Description is desc of the addon.
-	Description           string
-	CompatibleClusterType []string
+
+	// Spec defines the desired identities of clusters in this set.
+	// +optional
+	Spec IPAMSpec
+	// +optional
+	Status IPAMStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ClusterAddonTypeList is a resource containing a list of ClusterAddonType objects.
-type ClusterAddonTypeList struct {
+// IPAMList is the whole list of all IPAMs which owned by a tenant.
+type IPAMList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
-	// +optional
-	Items []ClusterAddonType
+
+	// List of IPAMs
+	Items []IPAM
 }
 
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// IPAMSpec describes the attributes on a IPAM.
+type IPAMSpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
+}
 
-// ClusterApplyOptions is the query options to a kube-apiserver proxy call for cluster object.
-type ClusterApplyOptions struct {
-	metav1.TypeMeta
+// IPAMStatus is information about the current status of a IPAM.
+type IPAMStatus struct {
 	// +optional
-	NotUpdate bool
+	Version string
+	// Phase is the current lifecycle phase of the addon of cluster.
+	// +optional
+	Phase AddonPhase
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string
+	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// +optional
+	RetryCount int32
+	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time
 }
 
 // +genclient
 // +genclient:nonNamespaced
-// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// Registry records the third-party image repository information stored by the
-// user.
-type Registry struct {
+// ConfigMap holds configuration data for tke to consume.
+type ConfigMap struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
+
+	// Data contains the configuration data.
+	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
+	// Values with non-UTF-8 byte sequences must use the BinaryData field.
+	// The keys stored in Data must not overlap with the keys in
+	// the BinaryData field, this is enforced during validation process.
 	// +optional
-	Spec RegistrySpec
+	Data map[string]string
+
+	// BinaryData contains the binary data.
+	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
+	// BinaryData can contain byte sequences that are not in the UTF-8 range.
+	// The keys stored in BinaryData must not overlap with the ones in
+	// the Data field, this is enforced during validation process.
+	// +optional
+	BinaryData map[string][]byte
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// RegistryList is a resource containing a list of Registry objects.
-type RegistryList struct {
+// ConfigMapList is a resource containing a list of ConfigMap objects.
+type ConfigMapList struct {
 	metav1.TypeMeta
+
 	// +optional
 	metav1.ListMeta
-	// +optional
-	Items []Registry
+
+	// Items is the list of ConfigMaps.
+	Items []ConfigMap
+}
+
+// ConditionStatus defines the status of Condition.
+type ConditionStatus string
+
+// These are valid condition statuses.
+// "ConditionTrue" means a resource is in the condition.
+// "ConditionFalse" means a resource is not in the condition.
+// "ConditionUnknown" means server can't decide if a resource is in the condition
+// or not.
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// AddonSpec describes the attributes on a Addon.
+type AddonSpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
 }
 
-// RegistrySpec indicates the specifications of the third-party image repository.
-type RegistrySpec struct {
-	// +optional
-	TenantID string
-	// +optional
-	DisplayName string
-	// +optional
-	ClusterName string
-	// +optional
-	URL string
-	// +optional
-	UserName *string
-	// +optional
-	Password *string
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TappControllerProxyOptions is the query options to a kube-apiserver proxy call.
+type TappControllerProxyOptions struct {
+	metav1.TypeMeta
+
+	Namespace string
+	Name      string
+	Action    string
 }
 
 // +genclient
@@ -719,46 +2179,55 @@ type RegistrySpec struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// PersistentEvent is a recorder of kubernetes event.
-type PersistentEvent struct {
+// TappController is a new kubernetes workload.
+type TappController struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of clusters in this set.
+	// Spec defines the desired identities of tapp controller.
 	// +optional
-	Spec PersistentEventSpec
+	Spec TappControllerSpec
 	// +optional
-	Status PersistentEventStatus
+	Status TappControllerStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// PersistentEventList is the whole list of all clusters which owned by a tenant.
-type PersistentEventList struct {
+// TappControllerList is the whole list of all tapp controllers which owned by a tenant.
+type TappControllerList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of PersistentEvents
-	Items []PersistentEvent
+	// List of tapp controllers
+	Items []TappController
 }
 
-// PersistentEventSpec describes the attributes on a PersistentEvent.
-type PersistentEventSpec struct {
-	TenantID          string
-	ClusterName       string
-	PersistentBackEnd PersistentBackEnd
-	Version           string
+// TappControllerSpec describes the attributes on a tapp controller.
+type TappControllerSpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy
+	// DriftPolicy defines how to handle the components in cluster which drift
+	// from the desired state, defaults to Repair.
+	// +optional
+	DriftPolicy AddonDriftPolicy
+	// Config configures the tapp-controller and the defaults of TApps.
+	// +optional
+	Config *TappControllerConfig
 }
 
-// PersistentEventStatus is information about the current status of a
-// PersistentEvent.
-type PersistentEventStatus struct {
+// TappControllerStatus is information about the current status of a tapp controller.
+type TappControllerStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the persistent event of cluster.
+	// Phase is the current lifecycle phase of the tapp controller of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -770,44 +2239,86 @@ type PersistentEventStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition
 }
 
-// PersistentBackEnd indicates the backend type and attributes of the persistent
-// log store.
-type PersistentBackEnd struct {
-	CLS *StorageBackEndCLS
-	ES  *StorageBackEndES
+// TappControllerConfig configures the tapp-controller and the defaults of TApps.
+type TappControllerConfig struct {
+	// Workers is the number of TApps synced concurrently, defaults to 5.
+	// +optional
+	Workers int32
+	// ForceDeletePod force deletes the pods on the lost nodes by default, so that
+	// the instances are recreated on other nodes. The TApps can override it.
+	// +optional
+	ForceDeletePod bool
+	// MaxUnavailable is the default number of instances unavailable during the
+	// rolling update of the TApps which do not specify it, defaults to 1.
+	// +optional
+	MaxUnavailable int32
 }
 
-// StorageBackEndCLS records the attributes required when the backend storage
-// type is CLS.
-type StorageBackEndCLS struct {
-	LogSetID string
-	TopicID  string
+// CRDConflict describes a custom resource definition in cluster which conflicts
+// with the addon.
+type CRDConflict struct {
+	// Name of the custom resource definition.
+	Name string
+	// Reason of the conflict.
+	Reason CRDConflictReason
+	// Message is a human readable description of the conflict.
+	// +optional
+	Message string
+	// Owner is the current owner of the custom resource definition, such as a
+	// helm release or another addon.
+	// +optional
+	Owner string
+	// ServedVersions are the versions served by the custom resource definition.
+	// +optional
+	ServedVersions []string
+	// RequiredVersion is the version required by the addon.
+	// +optional
+	RequiredVersion string
 }
 
-// StorageBackEndES records the attributes required when the backend storage
-// type is ElasticSearch.
-type StorageBackEndES struct {
-	IP          string
-	Port        int32
-	Scheme      string
-	IndexName   string
-	User        string
-	Password    string
-	ReserveDays int32
+// AddonCondition is a lifecycle condition of an addon, the same types of
+// conditions are reported by all addons.
+type AddonCondition struct {
+	// Type is the type of the condition, Installed, Healthy or Upgraded.
+	Type string
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status ConditionStatus
+	// Version is the addon version which the condition is observed for, such
+	// as the target version of an upgrade.
+	// +optional
+	Version string
+	// Last time we probed the condition.
+	// +optional
+	LastProbeTime metav1.Time
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	Reason string
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string
 }
 
-// +k8s:conversion-gen:explicit-from=net/url.Values
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// HelmProxyOptions is the query options to a Helm-api proxy call.
-type HelmProxyOptions struct {
+// CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.
+type CSIProxyOptions struct {
 	metav1.TypeMeta
 
-	// Path is the URL path to use for the current proxy request to helm-api.
-	// +optional
-	Path string
+	Namespace string
+	Name      string
 }
 
 // +genclient
@@ -815,44 +2326,52 @@ type HelmProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// Helm is a kubernetes package manager.
-type Helm struct {
+// CSIOperator is a operator to manages CSI external components.
+type CSIOperator struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of clusters in this set.
+	// Spec defines the desired identities of storage operator.
 	// +optional
-	Spec HelmSpec
+	Spec CSIOperatorSpec
 	// +optional
-	Status HelmStatus
+	Status CSIOperatorStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// HelmList is the whole list of all helms which owned by a tenant.
-type HelmList struct {
+// CSIOperatorList is the whole list of all storage operators which owned by a tenant.
+type CSIOperatorList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of Helms
-	Items []Helm
+	// List of storage operators.
+	Items []CSIOperator
 }
 
-// HelmSpec describes the attributes on a Helm.
-type HelmSpec struct {
+// CSIOperatorSpec describes the attributes of a storage operator.
+type CSIOperatorSpec struct {
 	TenantID    string
 	ClusterName string
-	Version     string
+	// Version of the CSI operator.
+	Version string
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy
 }
 
-// HelmStatus is information about the current status of a Helm.
-type HelmStatus struct {
+// CSIOperatorStatus is information about the current status of a storage operator.
+type CSIOperatorStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the helm of cluster.
+	// StorageVendorVersion will be set to the config version of the storage vendor.
+	// +optional
+	StorageVendorVersion string
+	// Phase is the current lifecycle phase of the csi operator of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -864,6 +2383,20 @@ type HelmStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PVCRProxyOptions is the query options to a kube-apiserver proxy call for PVCR crd object.
+type PVCRProxyOptions struct {
+	metav1.TypeMeta
+
+	Namespace string
+	Name      string
 }
 
 // +genclient
@@ -871,56 +2404,59 @@ type HelmStatus struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// Prometheus is a kubernetes package manager.
-type Prometheus struct {
+// VolumeDecorator is a controller to manage PVC information.
+type VolumeDecorator struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of clusters in this set.
+	// Spec defines the desired identities of volume decorator.
 	// +optional
-	Spec PrometheusSpec
+	Spec VolumeDecoratorSpec
 	// +optional
-	Status PrometheusStatus
+	Status VolumeDecoratorStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// PrometheusList is the whole list of all prometheus which owned by a tenant.
-type PrometheusList struct {
+// VolumeDecoratorList is the whole list of all VolumeDecorator which owned by a tenant.
+type VolumeDecoratorList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of Prometheuss
-	Items []Prometheus
+	// List of volume decorators.
+	Items []VolumeDecorator
 }
 
-// PrometheusSpec describes the attributes on a Prometheus.
-type PrometheusSpec struct {
-	TenantID      string
-	ClusterName   string
-	Version       string
-	SubVersion    map[string]string
-	RemoteAddress PrometheusRemoteAddr
-	// +optional
-	NotifyWebhook string
-	// +optional
-	Resources ResourceRequirements
-	// +optional
-	RunOnMaster bool
-	// +optional
-	AlertRepeatInterval string
+// VolumeDecoratorSpec describes the attributes of a VolumeDecorator.
+type VolumeDecoratorSpec struct {
+	TenantID          string
+	ClusterName       string
+	Version           string
+	VolumeTypes       []string
+	WorkloadAdmission bool
+	// Enforcement annotates or evicts the pods whose persistent volume claims
+	// exceed the usage threshold, disabled if nil.
 	// +optional
-	WithNPD bool
+	Enforcement *VolumeUsageEnforcement
 }
 
-// PrometheusStatus is information about the current status of a Prometheus.
-type PrometheusStatus struct {
+// VolumeDecoratorStatus is information about the current status of a VolumeDecorator.
+type VolumeDecoratorStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the helm of cluster.
+	// VolumeTypes is the supported volume types in this cluster.
+	// +optional
+	VolumeTypes []string
+	// WorkloadAdmission will be true to enable the workload admission webhook.
+	// +optional
+	WorkloadAdmission bool
+	// StorageVendorVersion will be set to the config version of the storage vendor.
+	// +optional
+	StorageVendorVersion string
+	// Phase is the current lifecycle phase of the volume-decorator of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -932,51 +2468,45 @@ type PrometheusStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
-	// SubVersion is the components version such as node-exporter.
-	SubVersion map[string]string
-}
-
-// PrometheusRemoteAddr is the remote write/read address for prometheus
-type PrometheusRemoteAddr struct {
-	WriteAddr []string
-	ReadAddr  []string
+	// Usages are the filesystem usages of the mounted persistent volume claims
+	// in this cluster.
+	// +optional
+	Usages []PersistentVolumeClaimUsage
 }
 
-// AddonPhase defines the phase of addon
-type AddonPhase string
-
-const (
-	// AddonPhaseInitializing means is wait initializing.
-	AddonPhaseInitializing AddonPhase = "Initializing"
-	// AddonPhaseReinitializing means is reinitializing.
-	AddonPhaseReinitializing AddonPhase = "Reinitializing"
-	// AddonPhaseChecking means is wait checking.
-	AddonPhaseChecking AddonPhase = "Checking"
-	// AddonPhaseRunning means is running.
-	AddonPhaseRunning AddonPhase = "Running"
-	// AddonPhaseUpgrading means is upgrading.
-	AddonPhaseUpgrading AddonPhase = "Upgrading"
-	// AddonPhaseFailed means has been failed.
-	AddonPhaseFailed AddonPhase = "Failed"
-	// AddonPhasePending means the controller is proceeding deploying
-	AddonPhasePending AddonPhase = "Pending"
-	// AddonPhaseUnhealthy means some pods of GPUManager is partial running
-	AddonPhaseUnhealthy AddonPhase = "Unhealthy"
-	// AddonPhaseTerminating means addon terminating
-	AddonPhaseTerminating AddonPhase = "Terminating"
-	// AddonPhaseUnknown means addon unknown
-	AddonPhaseUnknown AddonPhase = "Unknown"
-)
+// VolumeUsageEnforcement acts on the pods whose persistent volume claims
+// exceed the usage threshold.
+type VolumeUsageEnforcement struct {
+	// Threshold is the percentage of used bytes to the capacity of a persistent
+	// volume claim, between 1 and 100.
+	Threshold int32
+	// Action defaults to Annotate.
+	// +optional
+	Action VolumeUsageAction
+}
+
+// PersistentVolumeClaimUsage is the filesystem usage of a persistent volume
+// claim reported by kubelet.
+type PersistentVolumeClaimUsage struct {
+	Namespace string
+	Name      string
+	// UsedBytes is the bytes used by the filesystem of the volume.
+	UsedBytes int64
+	// CapacityBytes is the total bytes of the filesystem of the volume.
+	CapacityBytes int64
+	// Exceeded will be true if the usage exceeds the enforcement threshold.
+	// +optional
+	Exceeded bool
+}
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// IPAMProxyOptions is the query options to a Helm-api proxy call.
-type IPAMProxyOptions struct {
+// LogCollectorProxyOptions is the query options to a kube-apiserver proxy call for LogCollector crd object.
+type LogCollectorProxyOptions struct {
 	metav1.TypeMeta
 
-	// Path is the URL path to use for the current proxy request to helm-api.
-	// +optional
-	Path string
+	Namespace string
+	Name      string
 }
 
 // +genclient
@@ -984,44 +2514,44 @@ type IPAMProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// IPAM is a scheduler plugin for assigning IP.
-type IPAM struct {
+// LogCollector is a manager to collect logs of workload.
+type LogCollector struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of clusters in this set.
+	// Spec defines the desired identities of LogCollector.
 	// +optional
-	Spec IPAMSpec
+	Spec LogCollectorSpec
 	// +optional
-	Status IPAMStatus
+	Status LogCollectorStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// IPAMList is the whole list of all IPAMs which owned by a tenant.
-type IPAMList struct {
+// LogCollectorList is the whole list of all LogCollector which owned by a tenant.
+type LogCollectorList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of IPAMs
-	Items []IPAM
+	// List of volume decorators.
+	Items []LogCollector
 }
 
-// IPAMSpec describes the attributes on a IPAM.
-type IPAMSpec struct {
+// LogCollectorSpec describes the attributes of a LogCollector.
+type LogCollectorSpec struct {
 	TenantID    string
 	ClusterName string
 	Version     string
 }
 
-// IPAMStatus is information about the current status of a IPAM.
-type IPAMStatus struct {
+// LogCollectorStatus is information about the current status of a LogCollector.
+type LogCollectorStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the addon of cluster.
+	// Phase is the current lifecycle phase of the LogCollector of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -1037,76 +2567,221 @@ type IPAMStatus struct {
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ConfigMap holds configuration data for tke to consume.
-type ConfigMap struct {
+// Machine instance in Kubernetes cluster
+type Machine struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Data contains the configuration data.
-	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
-	// Values with non-UTF-8 byte sequences must use the BinaryData field.
-	// The keys stored in Data must not overlap with the keys in
-	// the BinaryData field, this is enforced during validation process.
+	// Spec defines the desired identities of the Machine.
 	// +optional
-	Data map[string]string
+	Spec MachineSpec
+	// +optional
+	Status MachineStatus
+}
 
-	// BinaryData contains the binary data.
-	// Each key must consist of alphanumeric characters, '-', '_' or '.'.
-	// BinaryData can contain byte sequences that are not in the UTF-8 range.
-	// The keys stored in BinaryData must not overlap with the ones in
-	// the Data field, this is enforced during validation process.
+// MachineSpec is a description of machine.
+type MachineSpec struct {
+	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
 	// +optional
-	BinaryData map[string][]byte
+	Finalizers  []FinalizerName
+	TenantID    string
+	ClusterName string
+	Type        string
+	IP          string
+	Port        int32
+	Username    string
+	Password    []byte
+	PrivateKey  []byte
+	PassPhrase  []byte
+	Labels      map[string]string
+	Taints      []corev1.Taint
+	// KubeletConfiguration overrides the kubelet configuration of cluster on the machine.
+	// +optional
+	KubeletConfiguration *KubeletConfigurationOverrides
+	// ServiceOverrides is merged over the service overrides of cluster on the machine.
+	// +optional
+	ServiceOverrides *ServiceOverrides
 }
 
-// +genclient:nonNamespaced
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// KubeletConfigurationOverrides overrides the fields of kubelet configuration
+// rendered for all nodes of cluster.
+type KubeletConfigurationOverrides struct {
+	// MaxPods is the number of pods that can run on the node.
+	// +optional
+	MaxPods *int32
+	// EvictionHard is a map of signal names to quantities that defines hard eviction thresholds.
+	// For example: {"memory.available": "300Mi"}.
+	// +optional
+	EvictionHard map[string]string
+	// ReservedCPUs is the cpu list reserved for the system and kubernetes components.
+	// For example: "0-1" or "0,4".
+	// +optional
+	ReservedCPUs string
+	// TopologyManagerPolicy is the policy of topology manager, one of none,
+	// best-effort, restricted and single-numa-node.
+	// +optional
+	TopologyManagerPolicy string
+}
 
-// ConfigMapList is a resource containing a list of ConfigMap objects.
-type ConfigMapList struct {
-	metav1.TypeMeta
+// MachineStatus represents information about the status of an machine.
+type MachineStatus struct {
+	// +optional
+	Locked *bool
 
 	// +optional
-	metav1.ListMeta
+	Phase MachinePhase
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []MachineCondition
+	// A human readable message indicating details about why the machine is in this condition.
+	// +optional
+	Message string
+	// A brief CamelCase message indicating details about why the machine is in this state.
+	// +optional
+	Reason string
+	// List of addresses reachable to the machine.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Addresses []MachineAddress
+	// Set of ids/uuids to uniquely identify the node.
+	// +optional
+	MachineInfo MachineSystemInfo
+	// Progress records the phases progress of the current operation.
+	// +optional
+	Progress *Progress
+	// UpgradeBackup records the binaries and configurations of the machine before
+	// upgrade, which the machine can be rolled back to.
+	// +optional
+	UpgradeBackup *MachineUpgradeBackup
+}
 
-	// Items is the list of ConfigMaps.
-	Items []ConfigMap
+// MachineUpgradeBackup describes the files saved on machine before upgrade.
+type MachineUpgradeBackup struct {
+	// Version is the kubelet version before upgrade.
+	Version string
+	// TargetVersion is the version which the machine is upgraded to.
+	TargetVersion string
+	// Dir is the directory on machine where the files are saved.
+	Dir string
+	// Files are the paths of saved files.
+	// +optional
+	Files []string
+	// +optional
+	CreationTime metav1.Time
 }
 
-// ConditionStatus defines the status of Condition.
-type ConditionStatus string
+// MachineSystemInfo is a set of ids/uuids to uniquely identify the node.
+type MachineSystemInfo struct {
+	// MachineID reported by the node. For unique machine identification
+	// in the cluster this field is preferred. Learn more from man(5)
+	// machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html
+	MachineID string
+	// SystemUUID reported by the node. For unique machine identification
+	// MachineID is preferred. This field is specific to Red Hat hosts
+	// https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html
+	SystemUUID string
+	// Boot ID reported by the node.
+	BootID string
+	// Kernel Version reported by the node.
+	KernelVersion string
+	// OS Image reported by the node.
+	OSImage string
+	// ContainerRuntime Version reported by the node.
+	ContainerRuntimeVersion string
+	// Kubelet Version reported by the node.
+	KubeletVersion string
+	// KubeProxy Version reported by the node.
+	KubeProxyVersion string
+	// The Operating System reported by the node
+	OperatingSystem string
+	// The Architecture reported by the node
+	Architecture string
+}
 
-// These are valid condition statuses.
-// "ConditionTrue" means a resource is in the condition.
-// "ConditionFalse" means a resource is not in the condition.
-// "ConditionUnknown" means server can't decide if a resource is in the condition
-// or not.
+// MachineAddress contains information for the machine's address.
+type MachineAddress struct {
+	// Machine address type, one of Public, ExternalIP or InternalIP.
+	Type MachineAddressType
+	// The machine address.
+	Address string
+}
+
+// MachineAddressType represents the type of machine address.
+type MachineAddressType string
+
+// These are valid address type of machine.
 const (
-	ConditionTrue    ConditionStatus = "True"
-	ConditionFalse   ConditionStatus = "False"
-	ConditionUnknown ConditionStatus = "Unknown"
+	MachineHostName    MachineAddressType = "Hostname"
+	MachineExternalIP  MachineAddressType = "ExternalIP"
+	MachineInternalIP  MachineAddressType = "InternalIP"
+	MachineExternalDNS MachineAddressType = "ExternalDNS"
+	MachineInternalDNS MachineAddressType = "InternalDNS"
 )
 
-// AddonSpec describes the attributes on a Addon.
-type AddonSpec struct {
-	TenantID    string
-	ClusterName string
-	Version     string
+// MachineCondition contains details for the current condition of this Machine.
+type MachineCondition struct {
+	// Type is the type of the condition.
+	Type string
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status ConditionStatus
+	// Last time we probed the condition.
+	// +optional
+	LastProbeTime metav1.Time
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	Reason string
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string
+}
+
+// MachinePhase defines the phase of machine constructor
+type MachinePhase string
+
+const (
+	// MachineInitializing is the initialize phase
+	MachineInitializing MachinePhase = "Initializing"
+	// MachineRunning is the normal running phase
+	MachineRunning MachinePhase = "Running"
+	// MachineFailed is the failed phase
+	MachineFailed MachinePhase = "Failed"
+	// MachineUpgrading means that the machine is in upgrading process.
+	MachineUpgrading MachinePhase = "Upgrading"
+	// MachineTerminating is the terminating phase
+	MachineTerminating MachinePhase = "Terminating"
+)
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MachineList is the whole list of all machine in an cluster.
+type MachineList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+	// List of clusters
+	Items []Machine
 }
 
 // +k8s:conversion-gen:explicit-from=net/url.Values
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// TappControllerProxyOptions is the query options to a kube-apiserver proxy call.
-type TappControllerProxyOptions struct {
+// CronHPAProxyOptions is the query options to a kube-apiserver proxy call.
+type CronHPAProxyOptions struct {
 	metav1.TypeMeta
 
 	Namespace string
 	Name      string
-	Action    string
 }
 
 // +genclient
@@ -1114,44 +2789,56 @@ type TappControllerProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// TappController is a new kubernetes workload.
-type TappController struct {
+// CronHPA is a new kubernetes workload.
+type CronHPA struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of tapp controller.
+	// Spec defines the desired identities of CronHPA.
 	// +optional
-	Spec TappControllerSpec
+	Spec CronHPASpec
 	// +optional
-	Status TappControllerStatus
+	Status CronHPAStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// TappControllerList is the whole list of all tapp controllers which owned by a tenant.
-type TappControllerList struct {
+// CronHPAList is the whole list of all CronHPAs which owned by a tenant.
+type CronHPAList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of tapp controllers
-	Items []TappController
+	// List of CronHPAs
+	Items []CronHPA
 }
 
-// TappControllerSpec describes the attributes on a tapp controller.
-type TappControllerSpec struct {
+// CronHPASpec describes the attributes on a CronHPA.
+type CronHPASpec struct {
 	TenantID    string
 	ClusterName string
 	Version     string
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy
+	// DriftPolicy defines how to handle the components in cluster which drift
+	// from the desired state, defaults to Repair.
+	// +optional
+	DriftPolicy AddonDriftPolicy
+	// TimeZone is the IANA time zone which the schedules of CronHPAs run in,
+	// such as Asia/Shanghai, defaults to the local time of cron-hpa-controller.
+	// +optional
+	TimeZone string
 }
 
-// TappControllerStatus is information about the current status of a tapp controller.
-type TappControllerStatus struct {
+// CronHPAStatus is information about the current status of a CronHPA.
+type CronHPAStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the tapp controller of cluster.
+	// Phase is the current lifecycle phase of the CronHPA of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -1163,16 +2850,24 @@ type TappControllerStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// CSIProxyOptions is the query options to a kube-apiserver proxy call for CSI crd object.
-type CSIProxyOptions struct {
+// LBCFProxyOptions is the query options to a kube-apiserver proxy call.
+type LBCFProxyOptions struct {
 	metav1.TypeMeta
 
 	Namespace string
 	Name      string
+	Action    string
 }
 
 // +genclient
@@ -1180,48 +2875,39 @@ type CSIProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// CSIOperator is a operator to manages CSI external components.
-type CSIOperator struct {
+// LBCF is a kubernetes load balancer manager
+type LBCF struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of storage operator.
-	// +optional
-	Spec CSIOperatorSpec
+	// Spec defines the desired identities of LBCF.
 	// +optional
-	Status CSIOperatorStatus
-}
-
-// +genclient:nonNamespaced
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-
-// CSIOperatorList is the whole list of all storage operators which owned by a tenant.
-type CSIOperatorList struct {
-	metav1.TypeMeta
+	Spec LBCFSpec
 	// +optional
-	metav1.ListMeta
-
-	// List of storage operators.
-	Items []CSIOperator
+	Status LBCFStatus
 }
 
-// CSIOperatorSpec describes the attributes of a storage operator.
-type CSIOperatorSpec struct {
+// LBCFSpec defines the desired identities of LBCF.
+type LBCFSpec struct {
 	TenantID    string
 	ClusterName string
-	// Version of the CSI operator.
-	Version string
+	Version     string
+	// HealthCheck is the default health check of backends, which is published to
+	// the drivers in the lbcf-driver-config ConfigMap of kube-system.
+	// +optional
+	HealthCheck *LBCFHealthCheck
+	// WebhookTimeoutSeconds is the default timeout of the webhooks of drivers,
+	// between 1 and 60, defaults to 10.
+	// +optional
+	WebhookTimeoutSeconds int32
 }
 
-// CSIOperatorStatus is information about the current status of a storage operator.
-type CSIOperatorStatus struct {
+// LBCFStatus is information about the current status of a LBCF.
+type LBCFStatus struct {
 	// +optional
 	Version string
-	// StorageVendorVersion will be set to the config version of the storage vendor.
-	// +optional
-	StorageVendorVersion string
-	// Phase is the current lifecycle phase of the csi operator of cluster.
+	// Phase is the current lifecycle phase of the CronHPA of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -1235,14 +2921,39 @@ type CSIOperatorStatus struct {
 	LastReInitializingTimestamp metav1.Time
 }
 
+// LBCFHealthCheck is the default health check of the backends registered to
+// load balancers by LBCF drivers.
+type LBCFHealthCheck struct {
+	// Path is the HTTP path to probe, TCP is probed if empty.
+	// +optional
+	Path string
+	// IntervalSeconds is the interval between probes, defaults to 5.
+	// +optional
+	IntervalSeconds int32
+	// TimeoutSeconds is the timeout of a probe, defaults to 2.
+	// +optional
+	TimeoutSeconds int32
+	// HealthyThreshold is the consecutive successes for a backend to be healthy,
+	// defaults to 3.
+	// +optional
+	HealthyThreshold int32
+	// UnhealthyThreshold is the consecutive failures for a backend to be
+	// unhealthy, defaults to 3.
+	// +optional
+	UnhealthyThreshold int32
+}
+
+// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// PVCRProxyOptions is the query options to a kube-apiserver proxy call for PVCR crd object.
-type PVCRProxyOptions struct {
+// LBCFList is the whole list of all LBCF which owned by a tenant.
+type LBCFList struct {
 	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
 
-	Namespace string
-	Name      string
+	// List of CronHPAs
+	Items []LBCF
 }
 
 // +genclient
@@ -1250,55 +2961,75 @@ type PVCRProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// VolumeDecorator is a controller to manage PVC information.
-type VolumeDecorator struct {
+// EgressGateway is a managed egress gateway which lets the workloads present
+// stable source IPs to the outside of cluster.
+type EgressGateway struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of volume decorator.
+	// Spec defines the desired identities of EgressGateway.
 	// +optional
-	Spec VolumeDecoratorSpec
+	Spec EgressGatewaySpec
 	// +optional
-	Status VolumeDecoratorStatus
+	Status EgressGatewayStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// VolumeDecoratorList is the whole list of all VolumeDecorator which owned by a tenant.
-type VolumeDecoratorList struct {
+// EgressGatewayList is the whole list of all EgressGateways which owned by a tenant.
+type EgressGatewayList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of volume decorators.
-	Items []VolumeDecorator
+	// List of EgressGateways
+	Items []EgressGateway
 }
 
-// VolumeDecoratorSpec describes the attributes of a VolumeDecorator.
-type VolumeDecoratorSpec struct {
-	TenantID          string
-	ClusterName       string
-	Version           string
-	VolumeTypes       []string
-	WorkloadAdmission bool
+// EgressGatewaySpec describes the attributes on a EgressGateway.
+type EgressGatewaySpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
+	// IPPools are the static egress IPs and the namespaces using them.
+	// +optional
+	IPPools []EgressIPPool
+	// FailoverTimeout is how long a gateway node is not ready before its
+	// egress IPs are moved to the other gateway nodes of the pool, which is
+	// 40s by default.
+	// +optional
+	FailoverTimeout *metav1.Duration
 }
 
-// VolumeDecoratorStatus is information about the current status of a VolumeDecorator.
-type VolumeDecoratorStatus struct {
+// EgressIPPool binds a set of static egress IPs to namespaces.
+type EgressIPPool struct {
+	Name string
+	// IPs are the source IPs which the traffic leaving the cluster from the
+	// namespaces is translated to. They are assigned to the gateway nodes.
+	IPs []string
+	// Namespaces are the namespaces whose egress traffic uses the pool.
 	// +optional
-	Version string
-	// VolumeTypes is the supported volume types in this cluster.
+	Namespaces []string
+	// Projects are the projects whose namespaces use the pool.
 	// +optional
-	VolumeTypes []string
-	// WorkloadAdmission will be true to enable the workload admission webhook.
+	Projects []string
+	// NodeSelector selects the gateway nodes which hold the egress IPs.
 	// +optional
-	WorkloadAdmission bool
-	// StorageVendorVersion will be set to the config version of the storage vendor.
+	NodeSelector map[string]string
+	// AllNamespaces makes the pool the default of the namespaces not using
+	// other pools, so the egress traffic of all pods goes through the gateway
+	// nodes of it. Only one pool can be the default.
 	// +optional
-	StorageVendorVersion string
-	// Phase is the current lifecycle phase of the volume-decorator of cluster.
+	AllNamespaces bool
+}
+
+// EgressGatewayStatus is information about the current status of a EgressGateway.
+type EgressGatewayStatus struct {
+	// +optional
+	Version string
+	// Phase is the current lifecycle phase of the EgressGateway of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
@@ -1310,16 +3041,23 @@ type VolumeDecoratorStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// Assignments are the gateway nodes holding the egress IPs.
+	// +optional
+	Assignments []EgressIPAssignment
 }
 
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-
-// LogCollectorProxyOptions is the query options to a kube-apiserver proxy call for LogCollector crd object.
-type LogCollectorProxyOptions struct {
-	metav1.TypeMeta
-
-	Namespace string
-	Name      string
+// EgressIPAssignment records the gateway node which holds an egress IP.
+type EgressIPAssignment struct {
+	// Pool is the name of the pool the IP belongs to.
+	Pool string
+	IP   string
+	// Node is the gateway node holding the IP, which is empty if no gateway
+	// node of the pool is ready.
+	// +optional
+	Node string
+	// The last time the IP moved from one node to another.
+	// +optional
+	LastTransitionTime metav1.Time
 }
 
 // +genclient
@@ -1327,55 +3065,68 @@ type LogCollectorProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// LogCollector is a manager to collect logs of workload.
-type LogCollector struct {
+// FloatingIPReservation reserves a floating IP of the galaxy-ipam addon for a
+// workload ahead of time.
+type FloatingIPReservation struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of LogCollector.
+	// Spec defines the IP and the workload of FloatingIPReservation.
 	// +optional
-	Spec LogCollectorSpec
+	Spec FloatingIPReservationSpec
 	// +optional
-	Status LogCollectorStatus
+	Status FloatingIPReservationStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// LogCollectorList is the whole list of all LogCollector which owned by a tenant.
-type LogCollectorList struct {
+// FloatingIPReservationList is the whole list of all FloatingIPReservations
+// which owned by a tenant.
+type FloatingIPReservationList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of volume decorators.
-	Items []LogCollector
+	// List of FloatingIPReservations
+	Items []FloatingIPReservation
 }
 
-// LogCollectorSpec describes the attributes of a LogCollector.
-type LogCollectorSpec struct {
+// FloatingIPReservationSpec describes the attributes on a FloatingIPReservation.
+type FloatingIPReservationSpec struct {
 	TenantID    string
 	ClusterName string
-	Version     string
+	// IP is the floating IP to reserve, which must be in the floating IP pools
+	// of cluster.
+	IP string
+	// Namespace is the namespace of the workload.
+	Namespace string
+	// WorkloadKind is the kind of the workload, Deployment, StatefulSet or TApp.
+	WorkloadKind string
+	// WorkloadName is the name of the workload.
+	WorkloadName string
+	// PodName is the pod of StatefulSet or TApp using the IP, which is required
+	// since the pods of them keep their own IPs. The IP is shared by the pods of
+	// Deployment.
+	// +optional
+	PodName string
 }
 
-// LogCollectorStatus is information about the current status of a LogCollector.
-type LogCollectorStatus struct {
-	// +optional
-	Version string
-	// Phase is the current lifecycle phase of the LogCollector of cluster.
+// FloatingIPReservationStatus is information about the current status of a
+// FloatingIPReservation.
+type FloatingIPReservationStatus struct {
 	// +optional
-	Phase AddonPhase
+	Phase FloatingIPReservationPhase
 	// Reason is a brief CamelCase string that describes any failure.
 	// +optional
 	Reason string
-	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// Subnet is the subnet of the floating IP pool which the IP belongs to.
 	// +optional
-	RetryCount int32
-	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	Subnet string
+	// The last time the phase transitioned from one to another.
 	// +optional
-	LastReInitializingTimestamp metav1.Time
+	LastTransitionTime metav1.Time
 }
 
 // +genclient
@@ -1383,237 +3134,483 @@ type LogCollectorStatus struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// Machine instance in Kubernetes cluster
-type Machine struct {
+// GPUNodeConfig declares the MIG geometry of the GPUs of a node, which is
+// reconciled by NVIDIA MIG manager.
+type GPUNodeConfig struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of the Machine.
-	// +optional
-	Spec MachineSpec
+	// Spec defines the node and the MIG geometry of its GPUs.
 	// +optional
-	Status MachineStatus
-}
-
-// MachineSpec is a description of machine.
-type MachineSpec struct {
-	// Finalizers is an opaque list of values that must be empty to permanently remove object from storage.
+	Spec GPUNodeConfigSpec
 	// +optional
-	Finalizers  []FinalizerName
-	TenantID    string
-	ClusterName string
-	Type        string
-	IP          string
-	Port        int32
-	Username    string
-	Password    []byte
-	PrivateKey  []byte
-	PassPhrase  []byte
-	Labels      map[string]string
-	Taints      []corev1.Taint
+	Status GPUNodeConfigStatus
 }
 
-// MachineStatus represents information about the status of an machine.
-type MachineStatus struct {
-	// +optional
-	Locked *bool
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
+// GPUNodeConfigList is the whole list of all GPUNodeConfigs which owned by a
+// tenant.
+type GPUNodeConfigList struct {
+	metav1.TypeMeta
 	// +optional
-	Phase MachinePhase
-	// +optional
-	// +patchMergeKey=type
-	// +patchStrategy=merge
-	Conditions []MachineCondition
-	// A human readable message indicating details about why the machine is in this condition.
-	// +optional
-	Message string
-	// A brief CamelCase message indicating details about why the machine is in this state.
-	// +optional
-	Reason string
-	// List of addresses reachable to the machine.
-	// +optional
-	// +patchMergeKey=type
-	// +patchStrategy=merge
-	Addresses []MachineAddress
-	// Set of ids/uuids to uniquely identify the node.
-	// +optional
-	MachineInfo MachineSystemInfo
-}
-
-// MachineSystemInfo is a set of ids/uuids to uniquely identify the node.
-type MachineSystemInfo struct {
-	// MachineID reported by the node. For unique machine identification
-	// in the cluster this field is preferred. Learn more from man(5)
-	// machine-id: http://man7.org/linux/man-pages/man5/machine-id.5.html
-	MachineID string
-	// SystemUUID reported by the node. For unique machine identification
-	// MachineID is preferred. This field is specific to Red Hat hosts
-	// https://access.redhat.com/documentation/en-US/Red_Hat_Subscription_Management/1/html/RHSM/getting-system-uuid.html
-	SystemUUID string
-	// Boot ID reported by the node.
-	BootID string
-	// Kernel Version reported by the node.
-	KernelVersion string
-	// OS Image reported by the node.
-	OSImage string
-	// ContainerRuntime Version reported by the node.
-	ContainerRuntimeVersion string
-	// Kubelet Version reported by the node.
-	KubeletVersion string
-	// KubeProxy Version reported by the node.
-	KubeProxyVersion string
-	// The Operating System reported by the node
-	OperatingSystem string
-	// The Architecture reported by the node
-	Architecture string
-}
+	metav1.ListMeta
 
-// MachineAddress contains information for the machine's address.
-type MachineAddress struct {
-	// Machine address type, one of Public, ExternalIP or InternalIP.
-	Type MachineAddressType
-	// The machine address.
-	Address string
+	// List of GPUNodeConfigs
+	Items []GPUNodeConfig
 }
-
-// MachineAddressType represents the type of machine address.
-type MachineAddressType string
-
-// These are valid address type of machine.
-const (
-	MachineHostName    MachineAddressType = "Hostname"
-	MachineExternalIP  MachineAddressType = "ExternalIP"
-	MachineInternalIP  MachineAddressType = "InternalIP"
-	MachineExternalDNS MachineAddressType = "ExternalDNS"
-	MachineInternalDNS MachineAddressType = "InternalDNS"
-)
-
-// MachineCondition contains details for the current condition of this Machine.
-type MachineCondition struct {
-	// Type is the type of the condition.
-	Type string
-	// Status is the status of the condition.
-	// Can be True, False, Unknown.
-	Status ConditionStatus
-	// Last time we probed the condition.
+
+// GPUNodeConfigSpec describes the attributes on a GPUNodeConfig.
+type GPUNodeConfigSpec struct {
+	TenantID    string
+	ClusterName string
+	// NodeName is the node with MIG capable GPUs, such as A100 and A30.
+	NodeName string
+	// MIGDevices partitions the GPUs into MIG devices, MIG is disabled on all the
+	// GPUs of the node if empty.
 	// +optional
-	LastProbeTime metav1.Time
-	// Last time the condition transitioned from one status to another.
+	MIGDevices []GPUMIGDeviceConfig
+}
+
+// GPUMIGDeviceConfig partitions the selected GPUs of a node with the same
+// MIG slices.
+type GPUMIGDeviceConfig struct {
+	// Devices are the indexes of the GPUs, all the GPUs of the node if empty.
 	// +optional
-	LastTransitionTime metav1.Time
-	// Unique, one-word, CamelCase reason for the condition's last transition.
+	Devices []int32
+	// Slices are the MIG devices created on each of the GPUs, MIG is disabled on
+	// the GPUs if empty.
+	// +optional
+	Slices []GPUMIGSlice
+}
+
+// GPUMIGSlice is a number of MIG devices with the same profile.
+type GPUMIGSlice struct {
+	// Profile is the MIG profile, such as 1g.5gb and 3g.20gb.
+	Profile string
+	// Count is the number of MIG devices created on each GPU.
+	Count int32
+}
+
+// GPUNodeConfigStatus is information about the current status of a
+// GPUNodeConfig.
+type GPUNodeConfigStatus struct {
+	// +optional
+	Phase GPUNodeConfigPhase
+	// Reason is a brief CamelCase string that describes any failure.
 	// +optional
 	Reason string
-	// Human-readable message indicating details about last transition.
+	// Profiles are the MIG profiles discovered on the node.
 	// +optional
-	Message string
+	Profiles []GPUMIGProfile
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time
 }
 
-// MachinePhase defines the phase of machine constructor
-type MachinePhase string
+// GPUMIGProfile is the MIG devices of a profile advertised by a node.
+type GPUMIGProfile struct {
+	// Profile is the MIG profile, such as 1g.5gb.
+	Profile string
+	// ResourceName is the extended resource requested by pods for the profile,
+	// such as nvidia.com/mig-1g.5gb.
+	ResourceName string
+	// Capacity is the number of MIG devices of the profile.
+	Capacity int64
+	// Allocatable is the number of MIG devices of the profile available for pods.
+	Allocatable int64
+}
+
+// GPUNodeConfigPhase indicates the status of GPUNodeConfig.
+type GPUNodeConfigPhase string
 
 const (
-	// MachineInitializing is the initialize phase
-	MachineInitializing MachinePhase = "Initializing"
-	// MachineRunning is the normal running phase
-	MachineRunning MachinePhase = "Running"
-	// MachineFailed is the failed phase
-	MachineFailed MachinePhase = "Failed"
-	// MachineUpgrading means that the machine is in upgrading process.
-	MachineUpgrading MachinePhase = "Upgrading"
-	// MachineTerminating is the terminating phase
-	MachineTerminating MachinePhase = "Terminating"
+	// GPUNodeConfigPending means the MIG geometry is not applied to the node yet.
+	GPUNodeConfigPending GPUNodeConfigPhase = "Pending"
+	// GPUNodeConfigApplying means the GPUs of the node are being repartitioned.
+	GPUNodeConfigApplying GPUNodeConfigPhase = "Applying"
+	// GPUNodeConfigApplied means the GPUs of the node are partitioned as the
+	// geometry.
+	GPUNodeConfigApplied GPUNodeConfigPhase = "Applied"
+	// GPUNodeConfigFailed means the geometry can not be applied, such as the
+	// GPUs of the node do not support the profiles.
+	GPUNodeConfigFailed GPUNodeConfigPhase = "Failed"
+)
+
+// ClusterOperationPhase indicates the status of ClusterOperation.
+type ClusterOperationPhase string
+
+const (
+	// ClusterOperationPending means the operation is queued and waits for the
+	// cluster to be reachable.
+	ClusterOperationPending ClusterOperationPhase = "Pending"
+	// ClusterOperationSucceeded means the operation is applied to the cluster.
+	ClusterOperationSucceeded ClusterOperationPhase = "Succeeded"
+	// ClusterOperationFailed means the operation is rejected by the cluster.
+	ClusterOperationFailed ClusterOperationPhase = "Failed"
+	// ClusterOperationExpired means the cluster is not reachable before the
+	// operation expires, it is never applied.
+	ClusterOperationExpired ClusterOperationPhase = "Expired"
 )
 
+// +genclient
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// MachineList is the whole list of all machine in an cluster.
-type MachineList struct {
+// ClusterOperation is a mutation of the resources in a cluster which is queued
+// while the cluster is unreachable, it is applied once the cluster is reachable
+// again or expired.
+type ClusterOperation struct {
 	metav1.TypeMeta
 	// +optional
-	metav1.ListMeta
-	// List of clusters
-	Items []Machine
+	metav1.ObjectMeta
+
+	// Spec defines the request of ClusterOperation.
+	// +optional
+	Spec ClusterOperationSpec
+	// +optional
+	Status ClusterOperationStatus
 }
 
-// +k8s:conversion-gen:explicit-from=net/url.Values
+// +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// CronHPAProxyOptions is the query options to a kube-apiserver proxy call.
-type CronHPAProxyOptions struct {
+// ClusterOperationList is the whole list of all ClusterOperations which owned
+// by a tenant.
+type ClusterOperationList struct {
 	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
 
-	Namespace string
-	Name      string
+	// List of ClusterOperations
+	Items []ClusterOperation
+}
+
+// ClusterOperationSpec describes the request of a ClusterOperation.
+type ClusterOperationSpec struct {
+	TenantID    string
+	ClusterName string
+	// Verb is the HTTP method of the request, POST, PUT, PATCH or DELETE.
+	Verb string
+	// Path is the request URI on the kube-apiserver of cluster with the query.
+	Path string
+	// ContentType is the content type of body.
+	// +optional
+	ContentType string
+	// Body is the request body.
+	// +optional
+	Body string
+	// Username is the user who submitted the operation, the operation is
+	// applied as the user.
+	Username string
+	// Groups are the groups of the user.
+	// +optional
+	Groups []string
+	// ExpireTime is the time after which the operation is expired if the cluster
+	// is still unreachable.
+	ExpireTime metav1.Time
+}
+
+// ClusterOperationStatus is information about the current status of a
+// ClusterOperation.
+type ClusterOperationStatus struct {
+	// +optional
+	Phase ClusterOperationPhase
+	// Attempts is the number of times the operation is sent to the cluster.
+	// +optional
+	Attempts int32
+	// The last time the operation is sent to the cluster.
+	// +optional
+	LastAttemptTime metav1.Time
+	// Code is the HTTP status code returned by the cluster.
+	// +optional
+	Code int32
+	// A human readable message indicating details about the last attempt.
+	// +optional
+	Message string
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time
 }
 
+// MetalLBProtocol is the protocol used by MetalLB to announce the addresses
+// of a pool.
+type MetalLBProtocol string
+
+const (
+	// MetalLBProtocolLayer2 answers the ARP and NDP requests for the addresses
+	// from one of the nodes.
+	MetalLBProtocolLayer2 MetalLBProtocol = "layer2"
+	// MetalLBProtocolBGP announces the addresses to the BGP peers from all the
+	// nodes.
+	MetalLBProtocolBGP MetalLBProtocol = "bgp"
+)
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// CronHPA is a new kubernetes workload.
-type CronHPA struct {
+// MetalLB is a load balancer for bare metal clusters, which assigns the
+// addresses of Services of type LoadBalancer and announces them by layer 2 or
+// BGP.
+type MetalLB struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of CronHPA.
+	// Spec defines the desired identities of MetalLB.
 	// +optional
-	Spec CronHPASpec
+	Spec MetalLBSpec
 	// +optional
-	Status CronHPAStatus
+	Status MetalLBStatus
 }
 
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// CronHPAList is the whole list of all CronHPAs which owned by a tenant.
-type CronHPAList struct {
+// MetalLBList is the whole list of all MetalLBs which owned by a tenant.
+type MetalLBList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of CronHPAs
-	Items []CronHPA
+	// List of MetalLBs
+	Items []MetalLB
 }
 
-// CronHPASpec describes the attributes on a CronHPA.
-type CronHPASpec struct {
+// MetalLBSpec describes the attributes on a MetalLB.
+type MetalLBSpec struct {
 	TenantID    string
 	ClusterName string
 	Version     string
+	// AddressPools are the addresses assigned to the Services of type
+	// LoadBalancer.
+	AddressPools []MetalLBAddressPool
+	// Peers are the BGP routers which the nodes announce the addresses of bgp
+	// pools to.
+	// +optional
+	Peers []MetalLBPeer
 }
 
-// CronHPAStatus is information about the current status of a CronHPA.
-type CronHPAStatus struct {
+// MetalLBAddressPool is a pool of addresses announced by the same protocol.
+type MetalLBAddressPool struct {
+	Name string
+	// Protocol announcing the addresses, layer2 or bgp.
+	Protocol MetalLBProtocol
+	// Addresses are the CIDRs or the ranges like 192.168.1.100-192.168.1.200.
+	Addresses []string
+	// AutoAssign assigns the addresses of pool to Services automatically,
+	// defaults to true. The addresses are only assigned on request if it is
+	// false.
+	// +optional
+	AutoAssign *bool
+}
+
+// MetalLBPeer is a BGP router peering with the nodes.
+type MetalLBPeer struct {
+	// PeerAddress is the address of router.
+	PeerAddress string
+	// PeerASN is the AS number of router.
+	PeerASN int64
+	// MyASN is the AS number of nodes.
+	MyASN int64
+	// PeerPort is the BGP port of router, defaults to 179.
+	// +optional
+	PeerPort int32
+	// NodeSelector selects the nodes peering with the router, all nodes peer
+	// with it if not specified.
+	// +optional
+	NodeSelector map[string]string
+}
+
+// MetalLBStatus is information about the current status of a MetalLB.
+type MetalLBStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the CronHPA of cluster.
+	// Phase is the current lifecycle phase of the MetalLB of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
 	// +optional
 	Reason string
-	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
 	// +optional
 	RetryCount int32
-	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// LBCFProxyOptions is the query options to a kube-apiserver proxy call.
-type LBCFProxyOptions struct {
+// Multus attaches secondary network interfaces such as macvlan, ipvlan and
+// SR-IOV to pods by the NetworkAttachmentDefinitions.
+type Multus struct {
 	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
 
-	Namespace string
+	// Spec defines the desired identities of Multus.
+	// +optional
+	Spec MultusSpec
+	// +optional
+	Status MultusStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultusList is the whole list of all Multuses which owned by a tenant.
+type MultusList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of Multuses
+	Items []Multus
+}
+
+// MultusSpec describes the attributes on a Multus.
+type MultusSpec struct {
+	TenantID    string
+	ClusterName string
+	Version     string
+	// SRIOV deploys the SR-IOV network device plugin and CNI, which advertise
+	// the virtual functions of NICs as extended resources of nodes.
+	// +optional
+	SRIOV *MultusSRIOV
+	// NetworkAttachments are created as NetworkAttachmentDefinitions, which are
+	// referenced by the k8s.v1.cni.cncf.io/networks annotation of pods.
+	// +optional
+	NetworkAttachments []MultusNetworkAttachment
+}
+
+// MultusSRIOV describes the resources advertised by SR-IOV network device
+// plugin.
+type MultusSRIOV struct {
+	// ResourcePrefix is the prefix of extended resources, defaults to intel.com.
+	// +optional
+	ResourcePrefix string
+	// Resources group the virtual functions by the selectors.
+	Resources []MultusSRIOVResource
+	// NodeSelector selects the nodes with SR-IOV NICs, all nodes if not
+	// specified.
+	// +optional
+	NodeSelector map[string]string
+}
+
+// MultusSRIOVResource is an extended resource of the virtual functions
+// matching all the selectors.
+type MultusSRIOVResource struct {
+	// ResourceName is the name of extended resource without prefix.
+	ResourceName string
+	// Vendors are the vendor hex codes of devices, such as 8086.
+	// +optional
+	Vendors []string
+	// Devices are the device hex codes of virtual functions, such as 154c.
+	// +optional
+	Devices []string
+	// Drivers are the drivers of virtual functions, such as iavf and vfio-pci.
+	// +optional
+	Drivers []string
+	// PFNames are the physical functions of virtual functions, such as eth1.
+	// +optional
+	PFNames []string
+}
+
+// MultusNetworkAttachment is a NetworkAttachmentDefinition managed by Multus.
+type MultusNetworkAttachment struct {
 	Name      string
-	Action    string
+	Namespace string
+	// Type is the CNI plugin, one of macvlan, ipvlan, host-device and sriov.
+	// It is ignored if Config is specified.
+	// +optional
+	Type string
+	// Master is the interface of nodes which macvlan and ipvlan are created on,
+	// or the device moved into pods by host-device.
+	// +optional
+	Master string
+	// Mode is the mode of macvlan (bridge, private, vepa or passthru) or ipvlan
+	// (l2, l3 or l3s).
+	// +optional
+	Mode string
+	// ResourceName is the SR-IOV resource without prefix which the pods request
+	// the virtual functions from, it is required by sriov.
+	// +optional
+	ResourceName string
+	// VLAN is the VLAN ID of virtual functions assigned to pods, only used by
+	// sriov.
+	// +optional
+	VLAN int32
+	// +optional
+	MTU int32
+	// IPAM allocates the addresses of the interfaces, the interfaces have no
+	// address if it is not specified.
+	// +optional
+	IPAM *MultusIPAM
+	// Config is the raw CNI configuration in JSON, which is used as it is for
+	// the plugins not supported by the fields above.
+	// +optional
+	Config string
+}
+
+// MultusIPAM describes the IPAM plugin of a network attachment.
+type MultusIPAM struct {
+	// Type is the IPAM plugin, one of host-local, static and dhcp, defaults to
+	// host-local. The addresses of host-local are allocated per node, so the
+	// ranges of nodes must not overlap if the interfaces are in the same layer 2
+	// network. The addresses of static are specified by the networks annotation
+	// of pods, and dhcp requires the dhcp daemon running on nodes.
+	// +optional
+	Type string
+	// Subnet is the CIDR which the addresses are allocated from, only used by
+	// host-local.
+	// +optional
+	Subnet string
+	// +optional
+	RangeStart string
+	// +optional
+	RangeEnd string
+	// +optional
+	Gateway string
+	// Routes are the destination CIDRs routed through the interface.
+	// +optional
+	Routes []string
+}
+
+// MultusStatus is information about the current status of a Multus.
+type MultusStatus struct {
+	// +optional
+	Version string
+	// Phase is the current lifecycle phase of the Multus of cluster.
+	// +optional
+	Phase AddonPhase
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
+	// +optional
+	RetryCount int32
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition
 }
 
 // +genclient
@@ -1621,53 +3618,234 @@ type LBCFProxyOptions struct {
 // +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// LBCF is a kubernetes load balancer manager
-type LBCF struct {
+// DevicePlugin deploys the Kubernetes device plugin of a device class, such
+// as RDMA, FPGA and QAT, and labels the nodes advertising the devices.
+type DevicePlugin struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ObjectMeta
 
-	// Spec defines the desired identities of LBCF.
+	// Spec defines the desired identities of DevicePlugin.
 	// +optional
-	Spec LBCFSpec
+	Spec DevicePluginSpec
 	// +optional
-	Status LBCFStatus
+	Status DevicePluginStatus
 }
 
-// LBCFSpec defines the desired identities of LBCF.
-type LBCFSpec struct {
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DevicePluginList is the whole list of all DevicePlugins which owned by a
+// tenant.
+type DevicePluginList struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ListMeta
+
+	// List of DevicePlugins
+	Items []DevicePlugin
+}
+
+// DevicePluginSpec describes the attributes on a DevicePlugin.
+type DevicePluginSpec struct {
 	TenantID    string
 	ClusterName string
 	Version     string
+	// DeviceClass is the class of devices, the built-in classes rdma, fpga and qat
+	// have default image, resource prefix and host paths, other classes must
+	// specify the image and resource prefix.
+	DeviceClass string
+	// Image of the device plugin, overrides the default image of the built-in
+	// class. The image without registry is pulled from the registry of platform.
+	// +optional
+	Image string
+	// MirrorImage copies the image to the registry of platform before deploying,
+	// then the nodes pull the image without accessing the source registry.
+	// +optional
+	MirrorImage bool
+	// ResourcePrefix is the prefix of the extended resources advertised by the
+	// device plugin, such as rdma/ and qat.intel.com/, which is used to label the
+	// nodes with the devices.
+	// +optional
+	ResourcePrefix string
+	// Args overrides the default arguments of the device plugin.
+	// +optional
+	Args []string
+	// Config is the content of the config file of the device plugin, which is
+	// mounted at ConfigPath.
+	// +optional
+	Config string
+	// ConfigPath is the path of the config file in the container, defaults to
+	// the path of the built-in class.
+	// +optional
+	ConfigPath string
+	// HostPaths are mounted into the device plugin along with the default host
+	// paths of the built-in class and the device plugin directory of kubelet.
+	// +optional
+	HostPaths []string
+	// NodeSelector selects the nodes to deploy the device plugin, all nodes if
+	// not specified.
+	// +optional
+	NodeSelector map[string]string
 }
 
-// LBCFStatus is information about the current status of a LBCF.
-type LBCFStatus struct {
+// DevicePluginStatus is information about the current status of a
+// DevicePlugin.
+type DevicePluginStatus struct {
 	// +optional
 	Version string
-	// Phase is the current lifecycle phase of the CronHPA of cluster.
+	// Phase is the current lifecycle phase of the DevicePlugin of cluster.
 	// +optional
 	Phase AddonPhase
 	// Reason is a brief CamelCase string that describes any failure.
 	// +optional
 	Reason string
-	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
 	// +optional
 	RetryCount int32
-	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time
+	// Image is the image deployed, which is the mirrored image if MirrorImage is
+	// set.
+	// +optional
+	Image string
+	// Nodes are the nodes advertising the devices, which are labeled with
+	// device.platform.tkestack.io/<class>=true.
+	// +optional
+	Nodes []string
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition
 }
 
+// FloatingIPReservationPhase indicates the status of FloatingIPReservation.
+type FloatingIPReservationPhase string
+
+const (
+	// FloatingIPReservationPending means the IP is not reserved in cluster yet.
+	FloatingIPReservationPending FloatingIPReservationPhase = "Pending"
+	// FloatingIPReservationReserved means the IP is reserved for the workload.
+	FloatingIPReservationReserved FloatingIPReservationPhase = "Reserved"
+	// FloatingIPReservationFailed means the IP can not be reserved, such as it
+	// is out of the pools or allocated to another workload.
+	FloatingIPReservationFailed FloatingIPReservationPhase = "Failed"
+)
+
+// +genclient
 // +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// LBCFList is the whole list of all LBCF which owned by a tenant.
-type LBCFList struct {
+// License records the entitlements of the installation, and the usage of them
+// is reported in the status.
+type License struct {
+	metav1.TypeMeta
+	// +optional
+	metav1.ObjectMeta
+
+	// Spec defines the entitlements of License.
+	// +optional
+	Spec LicenseSpec
+	// +optional
+	Status LicenseStatus
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LicenseList is the whole list of all Licenses.
+type LicenseList struct {
 	metav1.TypeMeta
 	// +optional
 	metav1.ListMeta
 
-	// List of CronHPAs
-	Items []LBCF
+	// List of Licenses
+	Items []License
+}
+
+// LicenseSpec describes the entitlements of a License.
+type LicenseSpec struct {
+	// Licensee is the customer who the license is issued to.
+	Licensee string
+	// MaxNodes is the max number of nodes in all clusters, 0 means unlimited.
+	// +optional
+	MaxNodes int32
+	// MaxClusters is the max number of clusters, 0 means unlimited.
+	// +optional
+	MaxClusters int32
+	// Features are the entitled features, empty means all features.
+	// +optional
+	Features []string
+	// NotBefore is the time from which the license is valid, zero means valid immediately.
+	// +optional
+	NotBefore metav1.Time
+	// NotAfter is the expiration time of license, zero means never expires.
+	// +optional
+	NotAfter metav1.Time
+	// WarningPercent is the usage percentage of entitlements from which warnings
+	// are sent. Defaults to 90.
+	// +optional
+	WarningPercent int32
+	// Notify describes where the warnings are sent, no warnings are sent if not specified.
+	// +optional
+	Notify *LicenseNotify
+	// Signature is the base64 encoded Ed25519 signature of the entitlements,
+	// which is signed by the issuer over the JSON object of licensee, maxNodes,
+	// maxClusters, features, notBefore and notAfter, and is verified with the
+	// public key of the issuer the platform controller is configured with.
+	// +optional
+	Signature []byte
+}
+
+// LicenseNotify describes the channel, template and receivers of the notify
+// messages about license.
+type LicenseNotify struct {
+	Channel  string
+	Template string
+	// +optional
+	Receivers []string
+	// +optional
+	ReceiverGroups []string
+}
+
+// LicenseStatus is information about the usage of entitlements of a License.
+type LicenseStatus struct {
+	// +optional
+	Phase LicensePhase
+	// Nodes is the number of nodes in all clusters.
+	// +optional
+	Nodes int32
+	// Clusters is the number of clusters.
+	// +optional
+	Clusters int32
+	// Message describes the usage exceeding or approaching the entitlements.
+	// +optional
+	Message string
+	// +optional
+	LastUpdateTime metav1.Time
+	// LastWarningTime is the time when the last warning was sent.
+	// +optional
+	LastWarningTime metav1.Time
 }
+
+// LicensePhase indicates the usage of entitlements of License.
+type LicensePhase string
+
+const (
+	// LicenseValid means the usage is within the entitlements.
+	LicenseValid LicensePhase = "Valid"
+	// LicenseWarning means the usage is approaching the entitlements or the
+	// license is expiring.
+	LicenseWarning LicensePhase = "Warning"
+	// LicenseExceeded means the usage exceeds the entitlements.
+	LicenseExceeded LicensePhase = "Exceeded"
+	// LicenseExpired means the license is expired.
+	LicenseExpired LicensePhase = "Expired"
+	// LicenseNotYetValid means the license is not valid yet.
+	LicenseNotYetValid LicensePhase = "NotYetValid"
+	// LicenseInvalid means the signature of license is missing or invalid.
+	LicenseInvalid LicensePhase = "Invalid"
+)
@@ -165,6 +165,92 @@ func (in *Cluster) AuthzWebhookEnabled() bool {
 		(in.Spec.Features.AuthzWebhookAddr.Builtin != nil || in.Spec.Features.AuthzWebhookAddr.External != nil)
 }
 
+// KubeletServerTLSBootstrapEnabled reports whether the kubelets serve with the
+// certificates signed by the cluster CA.
+func (in *Cluster) KubeletServerTLSBootstrapEnabled() bool {
+	return in.Spec.Features.KubeletServerTLSBootstrap != nil && *in.Spec.Features.KubeletServerTLSBootstrap
+}
+
+const (
+	// CgroupDriverSystemd delegates the cgroups to systemd, which is required
+	// by the hosts of cgroup v2.
+	CgroupDriverSystemd = "systemd"
+	// CgroupDriverCgroupfs manages the cgroups directly.
+	CgroupDriverCgroupfs = "cgroupfs"
+)
+
+// CgroupDriver returns the cgroup driver of kubelet and container runtime,
+// clusters created before the driver is configurable use cgroupfs.
+func (in *Cluster) CgroupDriver() string {
+	if in.Spec.Features.CgroupDriver == "" {
+		return CgroupDriverCgroupfs
+	}
+	return in.Spec.Features.CgroupDriver
+}
+
+// Network returns the network type of cluster, clusters created before the
+// network type is selectable use Cilium if it is enabled, otherwise Galaxy.
+func (in *Cluster) Network() NetworkType {
+	if in.Spec.NetworkType != "" {
+		return in.Spec.NetworkType
+	}
+	if in.Spec.Features.EnableCilium {
+		return NetworkTypeCilium
+	}
+	return NetworkTypeGalaxy
+}
+
+// KubeProxyMode returns the proxy mode of kube-proxy, clusters created before
+// the mode is selectable use ipvs if IPVS is enabled, otherwise iptables.
+func (in *Cluster) KubeProxyMode() KubeProxyMode {
+	if in.Spec.Features.KubeProxy != nil && in.Spec.Features.KubeProxy.Mode != "" {
+		return in.Spec.Features.KubeProxy.Mode
+	}
+	if in.Spec.Features.IPVS != nil && *in.Spec.Features.IPVS {
+		return KubeProxyModeIPVS
+	}
+	return KubeProxyModeIPTables
+}
+
+// DefaultGPUMemoryUnitMiB is the size in MiB of one unit of
+// tencent.com/vcuda-memory if not configured.
+const DefaultGPUMemoryUnitMiB = 256
+
+// GPUSharePolicy returns the share policy of GPUManager, which is Fractional
+// if not configured.
+func (in *Cluster) GPUSharePolicy() GPUSharePolicy {
+	if in.Spec.Features.GPUManager == nil || in.Spec.Features.GPUManager.SharePolicy == "" {
+		return GPUShareFractional
+	}
+	return in.Spec.Features.GPUManager.SharePolicy
+}
+
+// GPUMemoryUnitMiB returns the size in MiB of one unit of
+// tencent.com/vcuda-memory.
+func (in *Cluster) GPUMemoryUnitMiB() int32 {
+	if in.Spec.Features.GPUManager == nil || in.Spec.Features.GPUManager.MemoryUnitMiB == 0 {
+		return DefaultGPUMemoryUnitMiB
+	}
+	return in.Spec.Features.GPUManager.MemoryUnitMiB
+}
+
+// MIGStrategy returns the MIG strategy of NVIDIA device plugin, which is none
+// if not configured or the GPU is not physical.
+func (in *Cluster) MIGStrategy() GPUMIGStrategy {
+	if in.Spec.Features.GPUType == nil || *in.Spec.Features.GPUType != GPUPhysical || in.Spec.Features.MIGStrategy == "" {
+		return GPUMIGStrategyNone
+	}
+	return in.Spec.Features.MIGStrategy
+}
+
+// SystemTuning returns the system tuning of cluster, which is empty if not set.
+func (in *Cluster) SystemTuning() *SystemTuning {
+	if in.Spec.Features.SystemTuning == nil {
+		return &SystemTuning{}
+	}
+	return in.Spec.Features.SystemTuning
+}
+
 func (in *Cluster) AuthzWebhookExternEndpoint() (string, bool) {
 	if in.Spec.Features.AuthzWebhookAddr == nil {
 		return "", false
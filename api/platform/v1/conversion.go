@@ -40,6 +40,14 @@ func addConversionFuncs(scheme *runtime.Scheme) error {
 		AddFieldLabelConversionsForPrometheus,
 		AddFieldLabelConversionsForIPAM,
 		AddFieldLabelConversionsForLBCF,
+		AddFieldLabelConversionsForEgressGateway,
+		AddFieldLabelConversionsForFloatingIPReservation,
+		AddFieldLabelConversionsForGPUNodeConfig,
+		AddFieldLabelConversionsForClusterOperation,
+		AddFieldLabelConversionsForLicense,
+		AddFieldLabelConversionsForMetalLB,
+		AddFieldLabelConversionsForMultus,
+		AddFieldLabelConversionsForDevicePlugin,
 	}
 	for _, f := range funcs {
 		if err := f(scheme); err != nil {
@@ -324,3 +332,156 @@ func AddFieldLabelConversionsForLBCF(scheme *runtime.Scheme) error {
 			}
 		})
 }
+
+// AddFieldLabelConversionsForEgressGateway adds a conversion function to convert
+// field selectors of EgressGateway from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForEgressGateway(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("EgressGateway"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.version",
+				"status.phase",
+				"status.version",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForFloatingIPReservation adds a conversion function
+// to convert field selectors of FloatingIPReservation from the given version
+// to internal version representation.
+func AddFieldLabelConversionsForFloatingIPReservation(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("FloatingIPReservation"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.ip",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForClusterOperation adds a conversion function to
+// convert field selectors of ClusterOperation from the given version to
+// internal version representation.
+func AddFieldLabelConversionsForClusterOperation(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("ClusterOperation"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForGPUNodeConfig adds a conversion function to
+// convert field selectors of GPUNodeConfig from the given version to internal
+// version representation.
+func AddFieldLabelConversionsForGPUNodeConfig(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("GPUNodeConfig"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.nodeName",
+				"status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForLicense adds a conversion function to convert
+// field selectors of License from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForLicense(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("License"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "status.phase",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForMetalLB adds a conversion function to convert
+// field selectors of MetalLB from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForMetalLB(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("MetalLB"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.version",
+				"status.phase",
+				"status.version",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForMultus adds a conversion function to convert
+// field selectors of Multus from the given version to internal version
+// representation.
+func AddFieldLabelConversionsForMultus(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("Multus"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.version",
+				"status.phase",
+				"status.version",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
+
+// AddFieldLabelConversionsForDevicePlugin adds a conversion function to
+// convert field selectors of DevicePlugin from the given version to internal
+// version representation.
+func AddFieldLabelConversionsForDevicePlugin(scheme *runtime.Scheme) error {
+	return scheme.AddFieldLabelConversionFunc(SchemeGroupVersion.WithKind("DevicePlugin"),
+		func(label, value string) (string, string, error) {
+			switch label {
+			case "spec.tenantID",
+				"spec.clusterName",
+				"spec.version",
+				"spec.deviceClass",
+				"status.phase",
+				"status.version",
+				"metadata.name":
+				return label, value, nil
+			default:
+				return "", "", fmt.Errorf("field label not supported: %s", label)
+			}
+		})
+}
@@ -35,6 +35,21 @@ func SetDefaults_ClusterSpec(obj *ClusterSpec) {
 		maxUnready := intstr.FromInt(0)
 		obj.Features.Upgrade.Strategy.MaxUnready = &maxUnready
 	}
+	if obj.Features.Upgrade.Strategy.MaxUnavailable == nil {
+		maxUnavailable := intstr.FromInt(1)
+		obj.Features.Upgrade.Strategy.MaxUnavailable = &maxUnavailable
+	}
+	if obj.Features.Upgrade.Strategy.MaxSurge == nil {
+		maxSurge := intstr.FromInt(0)
+		obj.Features.Upgrade.Strategy.MaxSurge = &maxSurge
+	}
+	if obj.Features.CertificateRotation != nil && obj.Features.CertificateRotation.Mode == "" {
+		obj.Features.CertificateRotation.Mode = CertificateRotationAuto
+	}
+	if obj.Etcd != nil && obj.Etcd.Local != nil && obj.Etcd.Local.Maintenance != nil &&
+		obj.Etcd.Local.Maintenance.Interval == "" {
+		obj.Etcd.Local.Maintenance.Interval = "168h"
+	}
 }
 
 func SetDefaults_ClusterStatus(obj *ClusterStatus) {
@@ -126,3 +141,51 @@ func SetDefaults_LBCFStatus(obj *LBCFStatus) {
 		obj.Phase = AddonPhaseInitializing
 	}
 }
+
+func SetDefaults_EgressGatewayStatus(obj *EgressGatewayStatus) {
+	if obj.Phase == "" {
+		obj.Phase = AddonPhaseInitializing
+	}
+}
+
+func SetDefaults_FloatingIPReservationStatus(obj *FloatingIPReservationStatus) {
+	if obj.Phase == "" {
+		obj.Phase = FloatingIPReservationPending
+	}
+}
+
+func SetDefaults_ClusterOperationStatus(obj *ClusterOperationStatus) {
+	if obj.Phase == "" {
+		obj.Phase = ClusterOperationPending
+	}
+}
+
+func SetDefaults_GPUNodeConfigStatus(obj *GPUNodeConfigStatus) {
+	if obj.Phase == "" {
+		obj.Phase = GPUNodeConfigPending
+	}
+}
+
+func SetDefaults_LicenseSpec(obj *LicenseSpec) {
+	if obj.WarningPercent == 0 {
+		obj.WarningPercent = 90
+	}
+}
+
+func SetDefaults_MetalLBStatus(obj *MetalLBStatus) {
+	if obj.Phase == "" {
+		obj.Phase = AddonPhaseInitializing
+	}
+}
+
+func SetDefaults_MultusStatus(obj *MultusStatus) {
+	if obj.Phase == "" {
+		obj.Phase = AddonPhaseInitializing
+	}
+}
+
+func SetDefaults_DevicePluginStatus(obj *DevicePluginStatus) {
+	if obj.Phase == "" {
+		obj.Phase = AddonPhaseInitializing
+	}
+}
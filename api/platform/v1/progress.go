@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewProgress returns the progress of an operation consisting of the phases.
+func NewProgress(phases []string) *Progress {
+	progress := &Progress{
+		Total:  int32(len(phases)),
+		Phases: make([]PhaseProgress, 0, len(phases)),
+	}
+	for _, name := range phases {
+		progress.Phases = append(progress.Phases, PhaseProgress{Name: name})
+	}
+	return progress
+}
+
+// IsFor returns whether the progress records the operation consisting of the phases.
+func (in *Progress) IsFor(phases []string) bool {
+	if in == nil || len(in.Phases) != len(phases) {
+		return false
+	}
+	for i, name := range phases {
+		if in.Phases[i].Name != name {
+			return false
+		}
+	}
+	return true
+}
+
+// IsDone returns whether all phases are done or skipped.
+func (in *Progress) IsDone() bool {
+	return in.Completed >= in.Total
+}
+
+// StartPhase records the phase starts to execute.
+func (in *Progress) StartPhase(name string) {
+	if phase := in.getPhase(name); phase != nil {
+		phase.StartTime = metav1.Now()
+	}
+}
+
+// FinishPhase records the execution result of the phase.
+func (in *Progress) FinishPhase(name string, err error) {
+	i := in.indexOf(name)
+	if i < 0 {
+		return
+	}
+	phase := &in.Phases[i]
+	if err != nil {
+		phase.Retries++
+		phase.LastError = err.Error()
+		return
+	}
+	phase.EndTime = metav1.Now()
+	// phases are executed in order
+	in.Completed = int32(i + 1)
+}
+
+// SkipPhase records the phase is skipped.
+func (in *Progress) SkipPhase(name string) {
+	i := in.indexOf(name)
+	if i < 0 {
+		return
+	}
+	in.Completed = int32(i + 1)
+}
+
+func (in *Progress) getPhase(name string) *PhaseProgress {
+	i := in.indexOf(name)
+	if i < 0 {
+		return nil
+	}
+	return &in.Phases[i]
+}
+
+func (in *Progress) indexOf(name string) int {
+	for i := range in.Phases {
+		if in.Phases[i].Name == name {
+			return i
+		}
+	}
+	return -1
+}
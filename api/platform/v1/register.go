@@ -51,6 +51,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&Cluster{},
 		&ClusterList{},
 		&ClusterApplyOptions{},
+		&ClusterLogsOptions{},
 
 		&ClusterCredential{},
 		&ClusterCredentialList{},
@@ -106,6 +107,30 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&LBCF{},
 		&LBCFList{},
 		&LBCFProxyOptions{},
+
+		&EgressGateway{},
+		&EgressGatewayList{},
+
+		&FloatingIPReservation{},
+		&FloatingIPReservationList{},
+
+		&GPUNodeConfig{},
+		&GPUNodeConfigList{},
+
+		&ClusterOperation{},
+		&ClusterOperationList{},
+
+		&License{},
+		&LicenseList{},
+
+		&MetalLB{},
+		&MetalLBList{},
+
+		&Multus{},
+		&MultusList{},
+
+		&DevicePlugin{},
+		&DevicePluginList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
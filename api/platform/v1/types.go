@@ -156,6 +156,14 @@ type ClusterSpec struct {
 	NetworkArgs map[string]string `json:"networkArgs,omitempty" protobuf:"bytes,24,name=networkArgs"`
 	// +optional
 	ScalingMachines []ClusterMachine `json:"scalingMachines,omitempty" protobuf:"bytes,25,opt,name=scalingMachines"`
+	// ControlPlaneOverrides injects extra volumes, envs and sidecars into the
+	// static pod manifests of control plane components.
+	// +optional
+	ControlPlaneOverrides *ControlPlaneOverrides `json:"controlPlaneOverrides,omitempty" protobuf:"bytes,26,opt,name=controlPlaneOverrides"`
+	// ContainerRegistries configures the registry mirrors, insecure registries
+	// and registry credentials of the container runtime on all nodes.
+	// +optional
+	ContainerRegistries *ContainerRegistryConfig `json:"containerRegistries,omitempty" protobuf:"bytes,27,opt,name=containerRegistries"`
 }
 
 // ClusterStatus represents information about the status of a cluster.
@@ -207,6 +215,31 @@ type ClusterStatus struct {
 	NodeCIDRMaskSizeIPv6 int32 `json:"nodeCIDRMaskSizeIPv6,omitempty" protobuf:"varint,19,opt,name=nodeCIDRMaskSizeIPv6"`
 	// +optional
 	KubeVendor KubeVendorType `json:"kubeVendor" protobuf:"bytes,20,opt,name=kubeVendor"`
+	// Progress records the phases progress of the current operation.
+	// +optional
+	Progress *Progress `json:"progress,omitempty" protobuf:"bytes,21,opt,name=progress"`
+	// EtcdSnapshot records the latest etcd snapshot taken before control plane changes.
+	// +optional
+	EtcdSnapshot *EtcdSnapshot `json:"etcdSnapshot,omitempty" protobuf:"bytes,22,opt,name=etcdSnapshot"`
+	// Certificates records the expiration of control plane certificates.
+	// +optional
+	Certificates *CertificatesStatus `json:"certificates,omitempty" protobuf:"bytes,23,opt,name=certificates"`
+	// EtcdMaintenance records the latest compaction and defragmentation of local etcd.
+	// +optional
+	EtcdMaintenance *EtcdMaintenanceStatus `json:"etcdMaintenance,omitempty" protobuf:"bytes,24,opt,name=etcdMaintenance"`
+	// UpgradeCanary records the canary master of the upgrade in progress.
+	// +optional
+	UpgradeCanary *UpgradeCanaryStatus `json:"upgradeCanary,omitempty" protobuf:"bytes,25,opt,name=upgradeCanary"`
+	// Health is the composite health score of cluster, which is used to sort clusters by risk.
+	// +optional
+	Health *ClusterHealth `json:"health,omitempty" protobuf:"bytes,26,opt,name=health"`
+	// Images lists the images running in kube-system, which is refreshed by the
+	// health check of cluster.
+	// +optional
+	Images []ClusterImage `json:"images,omitempty" protobuf:"bytes,27,rep,name=images"`
+	// NetworkPolicy reports whether the CNI enforces NetworkPolicy.
+	// +optional
+	NetworkPolicy *NetworkPolicyStatus `json:"networkPolicy,omitempty" protobuf:"bytes,28,opt,name=networkPolicy"`
 }
 
 // FinalizerName is the name identifying a finalizer during cluster lifecycle.
@@ -223,6 +256,110 @@ const (
 // NetworkType defines the network type of cluster.
 type NetworkType string
 
+const (
+	// NetworkTypeGalaxy installs Galaxy with flannel as the overlay network.
+	NetworkTypeGalaxy NetworkType = "Galaxy"
+	// NetworkTypeCilium installs Cilium.
+	NetworkTypeCilium NetworkType = "Cilium"
+	// NetworkTypeCalico installs Calico.
+	NetworkTypeCalico NetworkType = "Calico"
+)
+
+// CalicoMode defines the routing mode of Calico.
+type CalicoMode string
+
+const (
+	// CalicoModeBGP routes the pod traffic natively and exchanges the routes by BGP.
+	CalicoModeBGP CalicoMode = "BGP"
+	// CalicoModeVXLAN encapsulates the pod traffic in VXLAN.
+	CalicoModeVXLAN CalicoMode = "VXLAN"
+)
+
+// KubeProxyMode defines the proxy mode of kube-proxy.
+type KubeProxyMode string
+
+const (
+	// KubeProxyModeIPTables programs the services with iptables rules.
+	KubeProxyModeIPTables KubeProxyMode = "iptables"
+	// KubeProxyModeIPVS programs the services with IPVS virtual servers.
+	KubeProxyModeIPVS KubeProxyMode = "ipvs"
+	// KubeProxyModeNFTables programs the services with nftables rules, which
+	// is supported since kubernetes 1.29.
+	KubeProxyModeNFTables KubeProxyMode = "nftables"
+)
+
+// ImageAdmissionMode defines the mode of image admission webhook.
+type ImageAdmissionMode string
+
+const (
+	// ImageAdmissionEnforce rejects the pods with images from disallowed registries.
+	ImageAdmissionEnforce ImageAdmissionMode = "Enforce"
+	// ImageAdmissionWarn admits the pods with images from disallowed registries
+	// but returns warnings to clients.
+	ImageAdmissionWarn ImageAdmissionMode = "Warn"
+)
+
+// CRDConflictPolicy defines how the addons handle the conflicting custom
+// resource definitions in cluster.
+type CRDConflictPolicy string
+
+const (
+	// CRDConflictFail stops installing the addon and reports the conflicts.
+	CRDConflictFail CRDConflictPolicy = "Fail"
+	// CRDConflictAdopt takes over the custom resource definitions owned by
+	// others if the versions required by the addon are served.
+	CRDConflictAdopt CRDConflictPolicy = "Adopt"
+	// CRDConflictOverwrite deletes the conflicting custom resource definitions
+	// so that they are recreated by the addon, the existing custom resources
+	// are deleted too.
+	CRDConflictOverwrite CRDConflictPolicy = "Overwrite"
+)
+
+// CRDConflictReason is the reason of a conflicting custom resource definition.
+type CRDConflictReason string
+
+const (
+	// CRDConflictOwner means the custom resource definition is owned by others.
+	CRDConflictOwner CRDConflictReason = "OwnerMismatch"
+	// CRDConflictVersion means the version required by the addon is not served.
+	CRDConflictVersion CRDConflictReason = "VersionMismatch"
+)
+
+// AddonDriftPolicy defines how the addons handle the components in cluster
+// which drift from the desired state, such as deleted or modified by hand.
+type AddonDriftPolicy string
+
+const (
+	// AddonDriftRepair applies the desired state of the drifted components
+	// again and reports them.
+	AddonDriftRepair AddonDriftPolicy = "Repair"
+	// AddonDriftReport only reports the drifted components.
+	AddonDriftReport AddonDriftPolicy = "Report"
+)
+
+// VolumeUsageAction defines what VolumeDecorator does to the pods whose
+// volumes exceed the usage threshold.
+type VolumeUsageAction string
+
+const (
+	// VolumeUsageAnnotate annotates the pods with the exceeded volumes.
+	VolumeUsageAnnotate VolumeUsageAction = "Annotate"
+	// VolumeUsageEvict annotates and evicts the pods with the exceeded volumes.
+	VolumeUsageEvict VolumeUsageAction = "Evict"
+)
+
+// PrometheusSizingTier defines the resource sizing of prometheus.
+type PrometheusSizingTier string
+
+const (
+	// PrometheusSizingSmall fits clusters with less than 50 nodes.
+	PrometheusSizingSmall PrometheusSizingTier = "Small"
+	// PrometheusSizingMedium fits clusters with less than 200 nodes.
+	PrometheusSizingMedium PrometheusSizingTier = "Medium"
+	// PrometheusSizingLarge fits clusters with more than 200 nodes.
+	PrometheusSizingLarge PrometheusSizingTier = "Large"
+)
+
 // GPUType defines the gpu type of cluster.
 type GPUType string
 
@@ -233,6 +370,30 @@ const (
 	GPUVirtual GPUType = "Virtual"
 )
 
+// GPUSharePolicy defines how GPUManager shares the GPU devices among containers.
+type GPUSharePolicy string
+
+const (
+	// GPUShareFractional allows the containers to request a fraction of a GPU.
+	GPUShareFractional GPUSharePolicy = "Fractional"
+	// GPUShareExclusive only allows the containers to request whole GPUs.
+	GPUShareExclusive GPUSharePolicy = "Exclusive"
+)
+
+// GPUMIGStrategy defines how the NVIDIA device plugin exposes the MIG devices.
+type GPUMIGStrategy string
+
+const (
+	// GPUMIGStrategyNone exposes the whole GPUs only.
+	GPUMIGStrategyNone GPUMIGStrategy = "none"
+	// GPUMIGStrategySingle exposes the MIG devices as nvidia.com/gpu, all the
+	// GPUs of a node must be partitioned with the same profile.
+	GPUMIGStrategySingle GPUMIGStrategy = "single"
+	// GPUMIGStrategyMixed exposes the MIG devices as nvidia.com/mig-<profile>,
+	// which allows pods to request specific MIG slices.
+	GPUMIGStrategyMixed GPUMIGStrategy = "mixed"
+)
+
 // ClusterPhase defines the phase of cluster constructor.
 type ClusterPhase string
 
@@ -274,6 +435,36 @@ type ClusterCondition struct {
 	Message string `json:"message,omitempty" protobuf:"bytes,6,opt,name=message"`
 }
 
+// Progress records the phases progress of the current cluster or machine operation.
+type Progress struct {
+	// Total is the number of phases of the operation.
+	Total int32 `json:"total" protobuf:"varint,1,opt,name=total"`
+	// Completed is the number of phases which are done or skipped.
+	// +optional
+	Completed int32 `json:"completed,omitempty" protobuf:"varint,2,opt,name=completed"`
+	// Phases records the execution of each phase in order.
+	// +optional
+	Phases []PhaseProgress `json:"phases,omitempty" protobuf:"bytes,3,rep,name=phases"`
+}
+
+// PhaseProgress records the execution of a phase.
+type PhaseProgress struct {
+	// Name is the name of the phase.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Time the phase starts to execute at the last time.
+	// +optional
+	StartTime metav1.Time `json:"startTime,omitempty" protobuf:"bytes,2,opt,name=startTime"`
+	// Time the phase finishes successfully.
+	// +optional
+	EndTime metav1.Time `json:"endTime,omitempty" protobuf:"bytes,3,opt,name=endTime"`
+	// Retries is the number of failed executions of the phase.
+	// +optional
+	Retries int32 `json:"retries,omitempty" protobuf:"varint,4,opt,name=retries"`
+	// LastError is the error message of the last failed execution.
+	// +optional
+	LastError string `json:"lastError,omitempty" protobuf:"bytes,5,opt,name=lastError"`
+}
+
 // AddressType indicates the type of cluster apiserver access address.
 type AddressType string
 
@@ -345,6 +536,17 @@ type ClusterCredential struct {
 	// For kubeadm init or join
 	// +optional
 	CertificateKey *string `json:"certificateKey,omitempty" protobuf:"bytes,14,opt,name=certificateKey"`
+	// Endpoints are the host:port of kube-apiservers, such as each master of cluster
+	// without VIP, which clients fail over to when the cluster address is unreachable.
+	// +optional
+	Endpoints []string `json:"endpoints,omitempty" protobuf:"bytes,15,rep,name=endpoints"`
+	// For uploading etcd snapshots to the S3 compatible storage of EtcdBackup
+	// +optional
+	EtcdBackupSecretAccessKey []byte `json:"etcdBackupSecretAccessKey,omitempty" protobuf:"bytes,16,opt,name=etcdBackupSecretAccessKey"`
+	// RegistryPasswords are the passwords of the registries in ContainerRegistries
+	// of cluster by registry, which are provided through ClusterCredentialRef.
+	// +optional
+	RegistryPasswords map[string][]byte `json:"registryPasswords,omitempty" protobuf:"bytes,17,rep,name=registryPasswords"`
 }
 
 // +genclient:nonNamespaced
@@ -394,6 +596,87 @@ type ClusterFeature struct {
 	// Upgrade control upgrade process.
 	// +optional
 	Upgrade Upgrade `json:"upgrade,omitempty" protobuf:"bytes,22,opt,name=upgrade"`
+	// SandboxRuntime installs a sandboxed container runtime on the labeled machines.
+	// +optional
+	SandboxRuntime *SandboxRuntime `json:"sandboxRuntime,omitempty" protobuf:"bytes,23,opt,name=sandboxRuntime"`
+	// NetworkEncryption encrypts the pod traffic between nodes.
+	// +optional
+	NetworkEncryption *NetworkEncryption `json:"networkEncryption,omitempty" protobuf:"bytes,24,opt,name=networkEncryption"`
+	// Audit configures the audit policy and backends of apiserver.
+	// +optional
+	Audit *ClusterAudit `json:"audit,omitempty" protobuf:"bytes,25,opt,name=audit"`
+	// EtcdBackup takes etcd snapshots before control plane changes such as upgrade.
+	// +optional
+	EtcdBackup *EtcdBackup `json:"etcdBackup,omitempty" protobuf:"bytes,26,opt,name=etcdBackup"`
+	// SecretsEncryption encrypts secrets at rest in etcd.
+	// +optional
+	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty" protobuf:"bytes,27,opt,name=secretsEncryption"`
+	// CertificateRotation controls the rotation of control plane certificates,
+	// they are rotated automatically before expiration if not specified.
+	// +optional
+	CertificateRotation *CertificateRotation `json:"certificateRotation,omitempty" protobuf:"bytes,28,opt,name=certificateRotation"`
+	// KubeletServerTLSBootstrap makes kubelets request serving certificates signed by
+	// the cluster CA, which are approved by platform after validating the node addresses.
+	// +optional
+	KubeletServerTLSBootstrap *bool `json:"kubeletServerTLSBootstrap,omitempty" protobuf:"varint,29,opt,name=kubeletServerTLSBootstrap"`
+	// CgroupDriver is the cgroup driver of kubelet and container runtime, systemd or cgroupfs.
+	// It defaults to systemd for new clusters, and cgroupfs if not set for compatibility.
+	// +optional
+	CgroupDriver string `json:"cgroupDriver,omitempty" protobuf:"bytes,30,opt,name=cgroupDriver"`
+	// SystemTuning is applied when nodes are created and kept enforced afterwards.
+	// +optional
+	SystemTuning *SystemTuning `json:"systemTuning,omitempty" protobuf:"bytes,31,opt,name=systemTuning"`
+	// TimeSync installs and configures chrony on nodes, and blocks nodes whose
+	// clock skew exceeds the threshold from joining the cluster.
+	// +optional
+	TimeSync *TimeSync `json:"timeSync,omitempty" protobuf:"bytes,32,opt,name=timeSync"`
+	// ServiceOverrides injects environment variables and systemd drop-in
+	// overrides into kubelet and the container runtime on all nodes. Static pods
+	// are customized with StaticPodOverrides instead.
+	// +optional
+	ServiceOverrides *ServiceOverrides `json:"serviceOverrides,omitempty" protobuf:"bytes,33,opt,name=serviceOverrides"`
+	// GalaxyNetwork is the underlay network of Galaxy for floating IP pods, which
+	// is rendered into the galaxy and floatingip ConfigMaps and kept reconciled.
+	// +optional
+	GalaxyNetwork *GalaxyNetwork `json:"galaxyNetwork,omitempty" protobuf:"bytes,34,opt,name=galaxyNetwork"`
+	// Calico is the options of Calico, which is used if the NetworkType of
+	// cluster is Calico.
+	// +optional
+	Calico *CalicoNetwork `json:"calico,omitempty" protobuf:"bytes,35,opt,name=calico"`
+	// Cilium is the options of Cilium, which is used if the NetworkType of
+	// cluster is Cilium.
+	// +optional
+	Cilium *CiliumNetwork `json:"cilium,omitempty" protobuf:"bytes,36,opt,name=cilium"`
+	// ImageAdmission deploys an admission webhook which rejects pods with
+	// images out of the approved registries.
+	// +optional
+	ImageAdmission *ImageAdmission `json:"imageAdmission,omitempty" protobuf:"bytes,37,opt,name=imageAdmission"`
+	// EnableNetworkCheck verifies the node-to-node, pod-to-pod, pod-to-service
+	// and DNS connectivity with probe pods after the cluster is installed, and
+	// fails the creation if any check fails.
+	// +optional
+	EnableNetworkCheck bool `json:"enableNetworkCheck,omitempty" protobuf:"varint,38,opt,name=enableNetworkCheck"`
+	// KubeProxy configures the proxy mode, IPVS scheduler and strict ARP of
+	// kube-proxy.
+	// +optional
+	KubeProxy *KubeProxy `json:"kubeProxy,omitempty" protobuf:"bytes,39,opt,name=kubeProxy"`
+	// DNS manages the Corefile of CoreDNS and deploys dns-autoscaler, it is
+	// reapplied after upgrades which restore the default Corefile.
+	// +optional
+	DNS *ClusterDNS `json:"dns,omitempty" protobuf:"bytes,40,opt,name=dns"`
+	// NetworkPolicy installs default-deny NetworkPolicies for the namespaces and
+	// checks if the CNI enforces NetworkPolicy, the result is reported in status.
+	// +optional
+	NetworkPolicy *NetworkPolicyFeature `json:"networkPolicy,omitempty" protobuf:"bytes,41,opt,name=networkPolicy"`
+	// GPUManager configures the share policy, memory unit and nodes of GPUManager,
+	// which takes effect when GPUType is Virtual.
+	// +optional
+	GPUManager *GPUManagerFeature `json:"gpuManager,omitempty" protobuf:"bytes,42,opt,name=gpuManager"`
+	// MIGStrategy is the MIG strategy of NVIDIA device plugin for physical GPU,
+	// none, single or mixed, defaults to none. MIG manager is deployed to
+	// reconcile the MIG geometry of GPUNodeConfigs if it is not none.
+	// +optional
+	MIGStrategy GPUMIGStrategy `json:"migStrategy,omitempty" protobuf:"bytes,43,opt,name=migStrategy,casttype=GPUMIGStrategy"`
 }
 
 type HA struct {
@@ -422,6 +705,487 @@ type CSIOperatorFeature struct {
 	Version string `json:"version" protobuf:"bytes,1,name=version"`
 }
 
+// SandboxRuntimeType defines the sandboxed container runtime of cluster.
+type SandboxRuntimeType string
+
+const (
+	// SandboxRuntimeKata indicates kata-containers is used as the sandboxed runtime.
+	SandboxRuntimeKata SandboxRuntimeType = "Kata"
+	// SandboxRuntimeGVisor indicates gVisor is used as the sandboxed runtime.
+	SandboxRuntimeGVisor SandboxRuntimeType = "GVisor"
+)
+
+const (
+	// LabelUntrustedNamespace marks the namespaces whose pods default to the sandboxed RuntimeClass.
+	LabelUntrustedNamespace = GroupName + "/untrusted"
+	// AnnotationSandboxRuntimeClass records the sandboxed RuntimeClass of an untrusted namespace.
+	AnnotationSandboxRuntimeClass = GroupName + "/sandbox-runtime-class"
+)
+
+// SandboxRuntime describes the sandboxed container runtime of cluster.
+type SandboxRuntime struct {
+	Type SandboxRuntimeType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=SandboxRuntimeType"`
+	// Pods in untrusted namespaces default to the sandboxed RuntimeClass.
+	// +optional
+	UntrustedNamespaces []string `json:"untrustedNamespaces,omitempty" protobuf:"bytes,2,rep,name=untrustedNamespaces"`
+}
+
+// NetworkEncryptionType defines the encryption protocol of the pod traffic between nodes.
+type NetworkEncryptionType string
+
+const (
+	// NetworkEncryptionWireGuard indicates the traffic is encrypted by WireGuard.
+	NetworkEncryptionWireGuard NetworkEncryptionType = "WireGuard"
+	// NetworkEncryptionIPsec indicates the traffic is encrypted by IPsec.
+	NetworkEncryptionIPsec NetworkEncryptionType = "IPsec"
+)
+
+// NetworkEncryption describes how the pod traffic between nodes is encrypted.
+type NetworkEncryption struct {
+	Type NetworkEncryptionType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=NetworkEncryptionType"`
+	// KeyRotationPeriod is the period to rotate the encryption keys, such as "720h".
+	// The keys are never rotated if it is empty.
+	// +optional
+	KeyRotationPeriod string `json:"keyRotationPeriod,omitempty" protobuf:"bytes,2,opt,name=keyRotationPeriod"`
+}
+
+// ClusterAudit describes the audit policy and backends of apiserver.
+type ClusterAudit struct {
+	// Policy is the audit policy in yaml, the platform default policy is used if it is empty.
+	// +optional
+	Policy string `json:"policy,omitempty" protobuf:"bytes,1,opt,name=policy"`
+	// Log writes the audit events to local files of master nodes.
+	// +optional
+	Log *AuditLogBackend `json:"log,omitempty" protobuf:"bytes,2,opt,name=log"`
+	// Webhook sends the audit events to the platform audit component.
+	// +optional
+	Webhook *AuditWebhookBackend `json:"webhook,omitempty" protobuf:"bytes,3,opt,name=webhook"`
+}
+
+// AuditLogBackend describes the log file and rotation of audit events.
+type AuditLogBackend struct {
+	// Path is the log file path, default is /var/log/kubernetes/audit/audit.log.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+	// MaxAge is the maximum number of days to retain old log files.
+	// +optional
+	MaxAge int32 `json:"maxAge,omitempty" protobuf:"varint,2,opt,name=maxAge"`
+	// MaxBackup is the maximum number of old log files to retain.
+	// +optional
+	MaxBackup int32 `json:"maxBackup,omitempty" protobuf:"varint,3,opt,name=maxBackup"`
+	// MaxSize is the maximum size in megabytes of log file before it gets rotated.
+	// +optional
+	MaxSize int32 `json:"maxSize,omitempty" protobuf:"varint,4,opt,name=maxSize"`
+}
+
+// AuditWebhookBackend describes where the audit events are sent to.
+type AuditWebhookBackend struct {
+	// Address is the address of audit component, default is the platform audit address.
+	// +optional
+	Address string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
+}
+
+// EtcdBackup describes where the etcd snapshots are saved before control plane changes.
+type EtcdBackup struct {
+	// Path is the directory on the first master to save snapshots, default is /var/lib/etcd-backup.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+	// S3 uploads the snapshots to S3 compatible storage such as COS.
+	// +optional
+	S3 *S3Storage `json:"s3,omitempty" protobuf:"bytes,2,opt,name=s3"`
+}
+
+// S3Storage describes a bucket of S3 compatible storage, the secret access
+// key is kept in the EtcdBackupSecretAccessKey of ClusterCredential.
+type S3Storage struct {
+	Endpoint string `json:"endpoint" protobuf:"bytes,1,opt,name=endpoint"`
+	// +optional
+	Region string `json:"region,omitempty" protobuf:"bytes,2,opt,name=region"`
+	Bucket string `json:"bucket" protobuf:"bytes,3,opt,name=bucket"`
+	// Prefix is prepended to the name of uploaded objects.
+	// +optional
+	Prefix      string `json:"prefix,omitempty" protobuf:"bytes,4,opt,name=prefix"`
+	AccessKeyID string `json:"accessKeyID" protobuf:"bytes,5,opt,name=accessKeyID"`
+}
+
+// EtcdSnapshot records an etcd snapshot which can be used to restore the cluster.
+type EtcdSnapshot struct {
+	// Location is the path on master or the url of object storage.
+	Location string `json:"location" protobuf:"bytes,1,opt,name=location"`
+	// Reason is the control plane change which the snapshot is taken for.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// Version is the kubernetes version of the cluster when the snapshot is taken.
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// +optional
+	CreationTime metav1.Time `json:"creationTime,omitempty" protobuf:"bytes,4,opt,name=creationTime"`
+}
+
+// CertificatesStatus records the expiration of the certificates on masters.
+type CertificatesStatus struct {
+	// +optional
+	Certificates []CertificateExpiration `json:"certificates,omitempty" protobuf:"bytes,1,rep,name=certificates"`
+	// +optional
+	LastRotationTime metav1.Time `json:"lastRotationTime,omitempty" protobuf:"bytes,2,opt,name=lastRotationTime"`
+}
+
+// CertificateExpiration is the expiration of a certificate on a master.
+type CertificateExpiration struct {
+	// Name is the file name relative to the certificates dir, such as apiserver.crt, or the absolute path of the kubelet client certificate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Node is the IP of master.
+	Node     string      `json:"node" protobuf:"bytes,2,opt,name=node"`
+	NotAfter metav1.Time `json:"notAfter" protobuf:"bytes,3,opt,name=notAfter"`
+}
+
+// SecretsEncryptionProvider defines the provider which encrypts secrets in etcd.
+type SecretsEncryptionProvider string
+
+const (
+	// SecretsEncryptionSecretbox encrypts secrets by XSalsa20 and Poly1305 with keys generated by platform.
+	SecretsEncryptionSecretbox SecretsEncryptionProvider = "Secretbox"
+	// SecretsEncryptionKMS encrypts secrets by the envelope encryption of a KMS plugin.
+	SecretsEncryptionKMS SecretsEncryptionProvider = "KMS"
+)
+
+// SecretsEncryption describes how the secrets are encrypted at rest in etcd.
+type SecretsEncryption struct {
+	Provider SecretsEncryptionProvider `json:"provider" protobuf:"bytes,1,opt,name=provider,casttype=SecretsEncryptionProvider"`
+	// KMS is the KMS plugin running on masters, required when provider is KMS.
+	// +optional
+	KMS *KMSPlugin `json:"kms,omitempty" protobuf:"bytes,2,opt,name=kms"`
+	// KeyRotationPeriod is the period to rotate the secretbox key, such as "2160h".
+	// The key is never rotated if it is empty.
+	// +optional
+	KeyRotationPeriod string `json:"keyRotationPeriod,omitempty" protobuf:"bytes,3,opt,name=keyRotationPeriod"`
+}
+
+// KMSPlugin describes the KMS plugin which apiserver calls to encrypt data keys.
+type KMSPlugin struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Endpoint is the unix socket of the KMS plugin, such as unix:///var/run/kmsplugin/socket.sock.
+	Endpoint string `json:"endpoint" protobuf:"bytes,2,opt,name=endpoint"`
+	// CacheSize is the number of data encryption keys cached in memory.
+	// +optional
+	CacheSize *int32 `json:"cacheSize,omitempty" protobuf:"varint,3,opt,name=cacheSize"`
+}
+
+// CertificateRotationMode defines when the certificates of control plane are rotated.
+type CertificateRotationMode string
+
+const (
+	// CertificateRotationAuto rotates certificates before they expire, and on demand.
+	CertificateRotationAuto CertificateRotationMode = "Auto"
+	// CertificateRotationManual rotates certificates on demand only.
+	CertificateRotationManual CertificateRotationMode = "Manual"
+)
+
+// CertificateRotation controls the rotation of the certificates generated by kubeadm.
+type CertificateRotation struct {
+	// Mode defaults to Auto.
+	// +optional
+	Mode CertificateRotationMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=CertificateRotationMode"`
+	// RenewBefore is how long before the expiration certificates are rotated in Auto mode, defaults to 720h.
+	// +optional
+	RenewBefore string `json:"renewBefore,omitempty" protobuf:"bytes,2,opt,name=renewBefore"`
+	// RotateRequestedAt requests a rotation if it is after the last rotation.
+	// +optional
+	RotateRequestedAt *metav1.Time `json:"rotateRequestedAt,omitempty" protobuf:"bytes,3,opt,name=rotateRequestedAt"`
+}
+
+// ControlPlaneOverrides customizes the static pod manifests of control plane components.
+type ControlPlaneOverrides struct {
+	// +optional
+	APIServer *StaticPodOverride `json:"apiServer,omitempty" protobuf:"bytes,1,opt,name=apiServer"`
+	// +optional
+	ControllerManager *StaticPodOverride `json:"controllerManager,omitempty" protobuf:"bytes,2,opt,name=controllerManager"`
+	// +optional
+	Scheduler *StaticPodOverride `json:"scheduler,omitempty" protobuf:"bytes,3,opt,name=scheduler"`
+	// Etcd is ignored if the etcd is external.
+	// +optional
+	Etcd *StaticPodOverride `json:"etcd,omitempty" protobuf:"bytes,4,opt,name=etcd"`
+}
+
+// StaticPodOverride describes the extra volumes, envs and sidecars injected
+// into a static pod manifest.
+type StaticPodOverride struct {
+	// ExtraVolumes are added to the pod, they can be mounted by the component or sidecars.
+	// +optional
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty" protobuf:"bytes,1,rep,name=extraVolumes"`
+	// ExtraVolumeMounts are added to the component container.
+	// +optional
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty" protobuf:"bytes,2,rep,name=extraVolumeMounts"`
+	// ExtraEnv are added to the component container.
+	// +optional
+	ExtraEnv []corev1.EnvVar `json:"extraEnv,omitempty" protobuf:"bytes,3,rep,name=extraEnv"`
+	// Sidecars are added to the pod, such as audit log shippers.
+	// +optional
+	Sidecars []corev1.Container `json:"sidecars,omitempty" protobuf:"bytes,4,rep,name=sidecars"`
+}
+
+// ContainerRegistryConfig describes the registries used by the container runtime of nodes.
+type ContainerRegistryConfig struct {
+	// Mirrors are the registry mirror endpoints, such as https://mirror.example.com.
+	// +optional
+	Mirrors []string `json:"mirrors,omitempty" protobuf:"bytes,1,rep,name=mirrors"`
+	// InsecureRegistries are pulled over http or without verifying certificates.
+	// +optional
+	InsecureRegistries []string `json:"insecureRegistries,omitempty" protobuf:"bytes,2,rep,name=insecureRegistries"`
+	// Auths are the credentials used to pull images from private registries.
+	// +optional
+	Auths []RegistryAuth `json:"auths,omitempty" protobuf:"bytes,3,rep,name=auths"`
+}
+
+// RegistryAuth is the credential of a private registry, the password is kept
+// in the RegistryPasswords of ClusterCredential by registry.
+type RegistryAuth struct {
+	Registry string `json:"registry" protobuf:"bytes,1,opt,name=registry"`
+	Username string `json:"username" protobuf:"bytes,2,opt,name=username"`
+}
+
+// SystemTuning describes the kernel parameters, kernel modules, ulimits and
+// transparent hugepage setting enforced on all nodes of the cluster.
+type SystemTuning struct {
+	// Sysctls override or extend the default kernel parameters, such as net.core.somaxconn.
+	// +optional
+	Sysctls map[string]string `json:"sysctls,omitempty" protobuf:"bytes,1,rep,name=sysctls"`
+	// KernelModules are loaded in addition to the modules required by kubernetes.
+	// +optional
+	KernelModules []string `json:"kernelModules,omitempty" protobuf:"bytes,2,rep,name=kernelModules"`
+	// Ulimits are the resource limits applied to all users.
+	// +optional
+	Ulimits []Ulimit `json:"ulimits,omitempty" protobuf:"bytes,3,rep,name=ulimits"`
+	// TransparentHugepage is one of always, madvise or never. Left unchanged when empty.
+	// +optional
+	TransparentHugepage string `json:"transparentHugepage,omitempty" protobuf:"bytes,4,opt,name=transparentHugepage"`
+}
+
+// Ulimit is a resource limit in the format of limits.conf.
+type Ulimit struct {
+	// Name is the limited item, such as nofile or nproc.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Soft string `json:"soft" protobuf:"bytes,2,opt,name=soft"`
+	Hard string `json:"hard" protobuf:"bytes,3,opt,name=hard"`
+}
+
+// TimeSync describes the time synchronization of nodes.
+type TimeSync struct {
+	// Servers are the NTP servers used by chrony.
+	Servers []string `json:"servers" protobuf:"bytes,1,rep,name=servers"`
+	// MaxSkewSeconds is the max clock skew allowed between the node and the
+	// platform controller, defaults to 5.
+	// +optional
+	MaxSkewSeconds int32 `json:"maxSkewSeconds,omitempty" protobuf:"varint,2,opt,name=maxSkewSeconds"`
+}
+
+// ServiceOverrides describes the overrides of systemd services on nodes.
+type ServiceOverrides struct {
+	// Kubelet overrides the kubelet service.
+	// +optional
+	Kubelet *ServiceOverride `json:"kubelet,omitempty" protobuf:"bytes,1,opt,name=kubelet"`
+	// ContainerRuntime overrides the container runtime service.
+	// +optional
+	ContainerRuntime *ServiceOverride `json:"containerRuntime,omitempty" protobuf:"bytes,2,opt,name=containerRuntime"`
+}
+
+// ServiceOverride describes the override of a systemd service, which is
+// written as a drop-in file of the service.
+type ServiceOverride struct {
+	// Env is the environment variables of the service, such as HTTP_PROXY.
+	// +optional
+	Env map[string]string `json:"env,omitempty" protobuf:"bytes,1,rep,name=env"`
+	// DropIn is the extra content of the drop-in file, such as an
+	// ExecStartPre directive, directives without section header belong to [Service].
+	// +optional
+	DropIn string `json:"dropIn,omitempty" protobuf:"bytes,2,opt,name=dropIn"`
+}
+
+// GalaxyNetwork describes the underlay network of Galaxy.
+type GalaxyNetwork struct {
+	// Device is the host interface of the underlay network, which may be a bond
+	// interface such as bond0. It defaults to the NetworkDevice of cluster.
+	// +optional
+	Device string `json:"device,omitempty" protobuf:"bytes,1,opt,name=device"`
+	// FloatingIPPools are the IP pools allocated to floating IP pods.
+	// +optional
+	FloatingIPPools []FloatingIPPool `json:"floatingIPPools,omitempty" protobuf:"bytes,2,rep,name=floatingIPPools"`
+}
+
+// FloatingIPPool describes the floating IPs of a subnet.
+type FloatingIPPool struct {
+	// Subnet is the CIDR of the floating IPs, such as 10.0.0.0/24.
+	Subnet string `json:"subnet" protobuf:"bytes,1,opt,name=subnet"`
+	// NodeSubnet is the CIDR of the nodes which can use the pool, it defaults to Subnet.
+	// +optional
+	NodeSubnet string `json:"nodeSubnet,omitempty" protobuf:"bytes,2,opt,name=nodeSubnet"`
+	// Gateway is the gateway of Subnet.
+	Gateway string `json:"gateway" protobuf:"bytes,3,opt,name=gateway"`
+	// VLAN is the VLAN ID of Subnet, 0 means untagged.
+	// +optional
+	VLAN int32 `json:"vlan,omitempty" protobuf:"varint,4,opt,name=vlan"`
+	// IPs are the IPs or IP ranges of the pool, such as 10.0.0.10 or 10.0.0.20~10.0.0.100.
+	IPs []string `json:"ips" protobuf:"bytes,5,rep,name=ips"`
+}
+
+// CalicoNetwork describes the options of Calico.
+type CalicoNetwork struct {
+	// Mode is the routing mode of Calico, defaults to VXLAN.
+	// +optional
+	Mode CalicoMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=CalicoMode"`
+	// ASNumber is the BGP AS number of nodes in BGP mode, defaults to 64512.
+	// +optional
+	ASNumber int32 `json:"asNumber,omitempty" protobuf:"varint,2,opt,name=asNumber"`
+	// MTU of the pod interfaces, defaults to 1450 in VXLAN mode and 1500 in BGP
+	// mode.
+	// +optional
+	MTU int32 `json:"mtu,omitempty" protobuf:"varint,3,opt,name=mtu"`
+}
+
+// CiliumNetwork describes the options of Cilium.
+type CiliumNetwork struct {
+	// KubeProxyReplacement replaces kube-proxy with the eBPF service load
+	// balancing of Cilium, kube-proxy is not installed if it is enabled.
+	// +optional
+	KubeProxyReplacement bool `json:"kubeProxyReplacement,omitempty" protobuf:"varint,1,opt,name=kubeProxyReplacement"`
+}
+
+// KubeProxy describes the options of kube-proxy.
+type KubeProxy struct {
+	// Mode of kube-proxy, defaults to ipvs if IPVS feature is enabled, otherwise
+	// iptables.
+	// +optional
+	Mode KubeProxyMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=KubeProxyMode"`
+	// IPVSScheduler is the scheduler of IPVS virtual servers, such as rr, wrr,
+	// lc, wlc, sh and dh, defaults to rr. Only used in ipvs mode.
+	// +optional
+	IPVSScheduler string `json:"ipvsScheduler,omitempty" protobuf:"bytes,2,opt,name=ipvsScheduler"`
+	// StrictARP makes nodes not answer the ARP queries for the addresses on
+	// kube-ipvs0, which is required by MetalLB in layer 2 mode. Only used in ipvs
+	// mode.
+	// +optional
+	StrictARP bool `json:"strictARP,omitempty" protobuf:"varint,3,opt,name=strictARP"`
+}
+
+// ClusterDNS customizes the Corefile of CoreDNS and the autoscaling of its
+// replicas.
+type ClusterDNS struct {
+	// StubDomains forward the queries of the domains to their own nameservers.
+	// +optional
+	StubDomains []DNSStubDomain `json:"stubDomains,omitempty" protobuf:"bytes,1,rep,name=stubDomains"`
+	// UpstreamNameservers are the nameservers for the queries out of the cluster
+	// domain and stub domains, defaults to the /etc/resolv.conf of nodes.
+	// +optional
+	UpstreamNameservers []string `json:"upstreamNameservers,omitempty" protobuf:"bytes,2,rep,name=upstreamNameservers"`
+	// CacheTTL is the max TTL in seconds of the cached records, defaults to 30.
+	// +optional
+	CacheTTL int32 `json:"cacheTTL,omitempty" protobuf:"varint,3,opt,name=cacheTTL"`
+	// Autoscaler deploys dns-autoscaler which scales the replicas of CoreDNS
+	// in proportion to the nodes and cores of cluster.
+	// +optional
+	Autoscaler *DNSAutoscaler `json:"autoscaler,omitempty" protobuf:"bytes,4,opt,name=autoscaler"`
+}
+
+// NetworkPolicyFeature bootstraps default-deny NetworkPolicies and verifies
+// the NetworkPolicy enforcement of CNI when the cluster is created.
+type NetworkPolicyFeature struct {
+	// DefaultDenyNamespaces are the namespaces to install the default-deny
+	// NetworkPolicy which denies all ingress traffic of pods, the namespaces not
+	// existing are created.
+	// +optional
+	DefaultDenyNamespaces []string `json:"defaultDenyNamespaces,omitempty" protobuf:"bytes,1,rep,name=defaultDenyNamespaces"`
+	// DenyEgress denies the egress traffic of pods in DefaultDenyNamespaces too,
+	// except DNS queries.
+	// +optional
+	DenyEgress bool `json:"denyEgress,omitempty" protobuf:"varint,2,opt,name=denyEgress"`
+}
+
+// NetworkPolicyStatus is the result of the NetworkPolicy enforcement check with
+// probe pods.
+type NetworkPolicyStatus struct {
+	// Enforced is true if the traffic denied by a NetworkPolicy is blocked.
+	Enforced bool `json:"enforced" protobuf:"varint,1,opt,name=enforced"`
+	// Message describes why the enforcement is not verified.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,2,opt,name=message"`
+	// +optional
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty" protobuf:"bytes,3,opt,name=lastProbeTime"`
+}
+
+// GPUManagerFeature configures the vGPU scheduling of GPUManager deployed for
+// the clusters with virtual GPU.
+type GPUManagerFeature struct {
+	// SharePolicy is Fractional or Exclusive, defaults to Fractional.
+	// +optional
+	SharePolicy GPUSharePolicy `json:"sharePolicy,omitempty" protobuf:"bytes,1,opt,name=sharePolicy,casttype=GPUSharePolicy"`
+	// MemoryUnitMiB is the size in MiB of one unit of tencent.com/vcuda-memory,
+	// defaults to 256.
+	// +optional
+	MemoryUnitMiB int32 `json:"memoryUnitMiB,omitempty" protobuf:"varint,2,opt,name=memoryUnitMiB"`
+	// NodeSelector selects the GPU nodes to run GPUManager in addition to the
+	// nodes with GPU driver installed.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,3,rep,name=nodeSelector" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DNSStubDomain is a domain resolved by its own nameservers.
+type DNSStubDomain struct {
+	// Domain is the zone to forward, such as example.com.
+	Domain string `json:"domain" protobuf:"bytes,1,opt,name=domain"`
+	// Nameservers are the IP addresses with optional ports of the nameservers.
+	Nameservers []string `json:"nameservers" protobuf:"bytes,2,rep,name=nameservers"`
+}
+
+// DNSAutoscaler describes the linear parameters of dns-autoscaler, the
+// replicas are max(ceil(cores / coresPerReplica), ceil(nodes / nodesPerReplica))
+// bounded by min and max.
+type DNSAutoscaler struct {
+	// CoresPerReplica defaults to 256.
+	// +optional
+	CoresPerReplica int32 `json:"coresPerReplica,omitempty" protobuf:"varint,1,opt,name=coresPerReplica"`
+	// NodesPerReplica defaults to 16.
+	// +optional
+	NodesPerReplica int32 `json:"nodesPerReplica,omitempty" protobuf:"varint,2,opt,name=nodesPerReplica"`
+	// Min is the minimum replicas, defaults to 1.
+	// +optional
+	Min int32 `json:"min,omitempty" protobuf:"varint,3,opt,name=min"`
+	// Max is the maximum replicas, unlimited if zero.
+	// +optional
+	Max int32 `json:"max,omitempty" protobuf:"varint,4,opt,name=max"`
+	// PreventSinglePointFailure keeps at least 2 replicas if the cluster has
+	// more than one node.
+	// +optional
+	PreventSinglePointFailure bool `json:"preventSinglePointFailure,omitempty" protobuf:"varint,5,opt,name=preventSinglePointFailure"`
+}
+
+// ImageAdmission restricts the images of pods in cluster to approved registries.
+type ImageAdmission struct {
+	// Mode of the webhook, defaults to Enforce.
+	// +optional
+	Mode ImageAdmissionMode `json:"mode,omitempty" protobuf:"bytes,1,opt,name=mode,casttype=ImageAdmissionMode"`
+	// AllowedRegistries are the image prefixes allowed in all namespaces, such
+	// as registry.example.com/ or docker.io/library/.
+	// +optional
+	AllowedRegistries []string `json:"allowedRegistries,omitempty" protobuf:"bytes,2,rep,name=allowedRegistries"`
+	// Projects are the additional image prefixes allowed in namespaces of the
+	// projects.
+	// +optional
+	Projects []ProjectImageAdmission `json:"projects,omitempty" protobuf:"bytes,3,rep,name=projects"`
+	// ExemptNamespaces are the namespaces not checked by the webhook.
+	// +optional
+	ExemptNamespaces []string `json:"exemptNamespaces,omitempty" protobuf:"bytes,4,rep,name=exemptNamespaces"`
+}
+
+// ProjectImageAdmission describes the image prefixes allowed in namespaces of a
+// project.
+type ProjectImageAdmission struct {
+	// ProjectName is the name of project in business.
+	ProjectName string `json:"projectName" protobuf:"bytes,1,opt,name=projectName"`
+	// AllowedRegistries are the image prefixes allowed in namespaces of the
+	// project.
+	AllowedRegistries []string `json:"allowedRegistries" protobuf:"bytes,2,rep,name=allowedRegistries"`
+}
+
 type AuthzWebhookAddr struct {
 	// +optional
 	Builtin *BuiltinAuthzWebhookAddr `json:"builtin,omitempty" protobuf:"bytes,1,opt,name=builtin"`
@@ -488,6 +1252,45 @@ type LocalEtcd struct {
 	ServerCertSANs []string `json:"serverCertSANs,omitempty" protobuf:"bytes,3,rep,name=serverCertSANs"`
 	// PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.
 	PeerCertSANs []string `json:"peerCertSANs,omitempty" protobuf:"bytes,4,rep,name=peerCertSANs"`
+	// QuotaBackendBytes is the backend storage size limit of etcd in bytes, etcd raises
+	// a NOSPACE alarm when it is exceeded. Only takes effect when creating the cluster.
+	// +optional
+	QuotaBackendBytes *int64 `json:"quotaBackendBytes,omitempty" protobuf:"varint,5,opt,name=quotaBackendBytes"`
+	// HeartbeatInterval is the time in milliseconds of a heartbeat interval.
+	// Only takes effect when creating the cluster.
+	// +optional
+	HeartbeatInterval *int32 `json:"heartbeatInterval,omitempty" protobuf:"varint,6,opt,name=heartbeatInterval"`
+	// ElectionTimeout is the time in milliseconds for an election to timeout.
+	// Only takes effect when creating the cluster.
+	// +optional
+	ElectionTimeout *int32 `json:"electionTimeout,omitempty" protobuf:"varint,7,opt,name=electionTimeout"`
+	// DataDevice is a dedicated block device of masters such as /dev/vdb, which is
+	// formatted and mounted to DataDir before etcd starts.
+	// +optional
+	DataDevice string `json:"dataDevice,omitempty" protobuf:"bytes,8,opt,name=dataDevice"`
+	// Maintenance compacts and defragments etcd periodically.
+	// +optional
+	Maintenance *EtcdMaintenance `json:"maintenance,omitempty" protobuf:"bytes,9,opt,name=maintenance"`
+}
+
+// EtcdMaintenance describes the compaction and defragmentation of local etcd.
+type EtcdMaintenance struct {
+	// Interval is the duration between two maintenances, defaults to 168h.
+	// +optional
+	Interval string `json:"interval,omitempty" protobuf:"bytes,1,opt,name=interval"`
+	// RunRequestedAt requests a maintenance if it is after the last maintenance.
+	// +optional
+	RunRequestedAt *metav1.Time `json:"runRequestedAt,omitempty" protobuf:"bytes,2,opt,name=runRequestedAt"`
+}
+
+// EtcdMaintenanceStatus records the latest maintenance of local etcd.
+type EtcdMaintenanceStatus struct {
+	// LastRunTime is the time when the latest maintenance finished.
+	// +optional
+	LastRunTime metav1.Time `json:"lastRunTime,omitempty" protobuf:"bytes,1,opt,name=lastRunTime"`
+	// CompactedRevision is the revision which etcd is compacted to.
+	// +optional
+	CompactedRevision int64 `json:"compactedRevision,omitempty" protobuf:"varint,2,opt,name=compactedRevision"`
 }
 
 // ExternalEtcd describes an external etcd cluster.
@@ -516,6 +1319,77 @@ type Upgrade struct {
 	// Upgrade strategy config.
 	// +optional
 	Strategy UpgradeStrategy `json:"strategy,omitempty" protobuf:"bytes,2,opt,name=strategy"`
+	// Paused stops upgrading more nodes, the nodes being upgraded are not interrupted.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,3,opt,name=paused"`
+	// Canary upgrades the first master only and holds the upgrade for verification
+	// before the remaining masters and workers are upgraded.
+	// +optional
+	Canary *UpgradeCanary `json:"canary,omitempty" protobuf:"bytes,4,opt,name=canary"`
+}
+
+// AnnotationUpgradeCanaryApproved approves the canary upgrade of the version in its value.
+const AnnotationUpgradeCanaryApproved = GroupName + "/upgrade-canary-approved"
+
+// UpgradeCanary holds the upgrade after the first master is upgraded until it is approved.
+type UpgradeCanary struct {
+	// ApprovedVersion continues the upgrade when it is the version being upgraded to,
+	// the same as annotating the cluster with platform.tkestack.io/upgrade-canary-approved.
+	// +optional
+	ApprovedVersion string `json:"approvedVersion,omitempty" protobuf:"bytes,1,opt,name=approvedVersion"`
+}
+
+// UpgradeCanaryStatus records the health checks of the canary master.
+type UpgradeCanaryStatus struct {
+	// Version is the version which the canary master is upgraded to.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// Node is the IP of the canary master.
+	Node string `json:"node" protobuf:"bytes,2,opt,name=node"`
+	// Checks are the results of health checks such as APIServer, Scheduling and DNS.
+	// +optional
+	Checks []ClusterCondition `json:"checks,omitempty" protobuf:"bytes,3,rep,name=checks"`
+	// Approved indicates the upgrade continues to the remaining nodes.
+	// +optional
+	Approved bool `json:"approved,omitempty" protobuf:"varint,4,opt,name=approved"`
+}
+
+// ClusterHealth is the weighted score of the health dimensions of cluster.
+type ClusterHealth struct {
+	// Score ranges from 0 to 100, the lower the riskier.
+	Score int32 `json:"score" protobuf:"varint,1,opt,name=score"`
+	// Dimensions are the scores of ControlPlane, Nodes, Addons, Certificates and Capacity.
+	// +optional
+	Dimensions []ClusterHealthDimension `json:"dimensions,omitempty" protobuf:"bytes,2,rep,name=dimensions"`
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
+// ClusterHealthDimension is the score of a health dimension of cluster.
+type ClusterHealthDimension struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Score ranges from 0 to 100.
+	Score int32 `json:"score" protobuf:"varint,2,opt,name=score"`
+	// Weight is the percentage of the dimension in the composite score.
+	Weight int32 `json:"weight" protobuf:"varint,3,opt,name=weight"`
+	// Message describes the problems which lower the score.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// ClusterImage is an image run by a component in the kube-system namespace of
+// cluster.
+type ClusterImage struct {
+	// Component is the workload running the image, such as deployment/coredns,
+	// daemonset/kube-proxy or pod/kube-apiserver for static pods.
+	Component string `json:"component" protobuf:"bytes,1,opt,name=component"`
+	// Container is the name of container, init containers are included.
+	Container string `json:"container" protobuf:"bytes,2,opt,name=container"`
+	// Image is the image:tag in the pod spec.
+	Image string `json:"image" protobuf:"bytes,3,opt,name=image"`
+	// Digest is the repository digest reported by container runtime, which is
+	// empty before the container starts or when the runtime does not report it.
+	// +optional
+	Digest string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
 }
 
 type UpgradeMode string
@@ -541,6 +1415,20 @@ type UpgradeStrategy struct {
 	// If your pod can not accept be expelled from current node, this value should be false.
 	// +optional
 	DrainNodeBeforeUpgrade *bool `json:"drainNodeBeforeUpgrade,omitempty" protobuf:"varint,2,opt,name=drainNodeBeforeUpgrade"`
+	// The maximum number of worker nodes that can be unavailable during the upgrade.
+	// Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%).
+	// default value is 1.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" protobuf:"bytes,3,opt,name=maxUnavailable"`
+	// The maximum number of worker nodes that can be upgrading above
+	// MaxUnavailable, these nodes are prepared for the upgrade while the worker
+	// nodes which are not ready or unschedulable are still within MaxUnavailable.
+	// Unlike maxSurge of a Deployment, no extra worker nodes are created, the
+	// nodes are upgraded in place. Percentages are rounded up.
+	// Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%).
+	// default value is 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" protobuf:"bytes,4,opt,name=maxSurge"`
 }
 
 // ResourceList is a set of (resource name, quantity) pairs.
@@ -663,6 +1551,10 @@ type ClusterAddonType struct {
 	// Description is desc of the addon.
 	Description           string   `json:"description,omitempty" protobuf:"bytes,5,opt,name=description"`
 	CompatibleClusterType []string `json:"compatibleClusterType,omitempty" protobuf:"bytes,6,rep,name=compatibleClusterType"`
+	// Dependencies are the addon types installed in the cluster before the
+	// addon, which are created automatically if missing.
+	// +optional
+	Dependencies []string `json:"dependencies,omitempty" protobuf:"bytes,7,rep,name=dependencies"`
 }
 
 // +genclient:nonNamespaced
@@ -686,6 +1578,45 @@ type ClusterApplyOptions struct {
 	NotUpdate bool `json:"notUpdate,omitempty" protobuf:"varint,1,opt,name=notUpdate"`
 }
 
+// +k8s:conversion-gen:explicit-from=net/url.Values
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterLogsOptions is the query options to stream the logs of a container of
+// cluster through the platform api.
+type ClusterLogsOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Namespace is the namespace of the pod.
+	// +optional
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Pod is the name of the pod.
+	// +optional
+	Pod string `json:"pod,omitempty" protobuf:"bytes,2,opt,name=pod"`
+	// The container for which to stream logs. Defaults to only container if there is one container in the pod.
+	// +optional
+	Container string `json:"container,omitempty" protobuf:"bytes,3,opt,name=container"`
+	// Follow the log stream of the pod. Defaults to false.
+	// +optional
+	Follow bool `json:"follow,omitempty" protobuf:"varint,4,opt,name=follow"`
+	// Return previous terminated container logs. Defaults to false.
+	// +optional
+	Previous bool `json:"previous,omitempty" protobuf:"varint,5,opt,name=previous"`
+	// A relative time in seconds before the current time from which to show logs. Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty" protobuf:"varint,6,opt,name=sinceSeconds"`
+	// An RFC3339 timestamp from which to show logs. Only one of sinceSeconds or sinceTime may be specified.
+	// +optional
+	SinceTime *metav1.Time `json:"sinceTime,omitempty" protobuf:"bytes,7,opt,name=sinceTime"`
+	// If true, add an RFC3339 timestamp at the beginning of every line of log output. Defaults to false.
+	// +optional
+	Timestamps bool `json:"timestamps,omitempty" protobuf:"varint,8,opt,name=timestamps"`
+	// If set, the number of lines from the end of the logs to show, which is limited by the platform api.
+	// +optional
+	TailLines *int64 `json:"tailLines,omitempty" protobuf:"varint,9,opt,name=tailLines"`
+	// If set, the number of bytes to read from the server before terminating the log output, which is limited by the platform api.
+	// +optional
+	LimitBytes *int64 `json:"limitBytes,omitempty" protobuf:"varint,10,opt,name=limitBytes"`
+}
+
 // +genclient
 // +genclient:nonNamespaced
 // +genclient:skipVerbs=deleteCollection
@@ -766,6 +1697,9 @@ type PersistentEventSpec struct {
 	ClusterName       string            `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
 	PersistentBackEnd PersistentBackEnd `json:"persistentBackEnd,omitempty" protobuf:"bytes,3,opt,name=persistentBackEnd"`
 	Version           string            `json:"version,omitempty" protobuf:"bytes,4,opt,name=version"`
+	// Buffer handles the backpressure of the backend storage.
+	// +optional
+	Buffer *PersistentEventBuffer `json:"buffer,omitempty" protobuf:"bytes,5,opt,name=buffer"`
 }
 
 // PersistentEventStatus is information about the current status of a
@@ -790,8 +1724,10 @@ type PersistentEventStatus struct {
 // PersistentBackEnd indicates the backend type and attributes of the persistent
 // log store.
 type PersistentBackEnd struct {
-	CLS *StorageBackEndCLS `json:"cls,omitempty" protobuf:"bytes,1,opt,name=cls"`
-	ES  *StorageBackEndES  `json:"es,omitempty" protobuf:"bytes,2,opt,name=es"`
+	CLS   *StorageBackEndCLS   `json:"cls,omitempty" protobuf:"bytes,1,opt,name=cls"`
+	ES    *StorageBackEndES    `json:"es,omitempty" protobuf:"bytes,2,opt,name=es"`
+	Kafka *StorageBackEndKafka `json:"kafka,omitempty" protobuf:"bytes,3,opt,name=kafka"`
+	Loki  *StorageBackEndLoki  `json:"loki,omitempty" protobuf:"bytes,4,opt,name=loki"`
 }
 
 // StorageBackEndCLS records the attributes required when the backend storage
@@ -813,6 +1749,83 @@ type StorageBackEndES struct {
 	ReserveDays int32  `json:"reserveDays,omitempty" protobuf:"varint,7,opt,name=reserveDays"`
 }
 
+// StorageBackEndKafka records the attributes required when the backend storage
+// type is Kafka.
+type StorageBackEndKafka struct {
+	// Brokers are the addresses of Kafka brokers, such as 10.0.0.1:9092.
+	Brokers []string `json:"brokers" protobuf:"bytes,1,rep,name=brokers"`
+	// Topic is the topic which the events are produced to.
+	Topic string `json:"topic" protobuf:"bytes,2,opt,name=topic"`
+	// SASL authenticates to the brokers, disabled if nil.
+	// +optional
+	SASL *StorageBackEndSASL `json:"sasl,omitempty" protobuf:"bytes,3,opt,name=sasl"`
+	// TLS connects to the brokers over TLS, disabled if nil.
+	// +optional
+	TLS *StorageBackEndTLS `json:"tls,omitempty" protobuf:"bytes,4,opt,name=tls"`
+}
+
+// StorageBackEndLoki records the attributes required when the backend storage
+// type is Loki.
+type StorageBackEndLoki struct {
+	// URL is the address of Loki, such as http://loki:3100.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// TenantID is the X-Scope-OrgID of the multi-tenant Loki.
+	// +optional
+	TenantID string `json:"tenantID,omitempty" protobuf:"bytes,2,opt,name=tenantID"`
+	// User is the user of basic authentication.
+	// +optional
+	User string `json:"user,omitempty" protobuf:"bytes,3,opt,name=user"`
+	// Password is the base64 encoded password of basic authentication.
+	// +optional
+	Password string `json:"password,omitempty" protobuf:"bytes,4,opt,name=password"`
+	// Labels are the extra labels of the event streams.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty" protobuf:"bytes,5,rep,name=labels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// TLS configures the certificates to connect to Loki over https.
+	// +optional
+	TLS *StorageBackEndTLS `json:"tls,omitempty" protobuf:"bytes,6,opt,name=tls"`
+}
+
+// StorageBackEndSASL records the SASL authentication of a backend storage.
+type StorageBackEndSASL struct {
+	// Mechanism is PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, defaults to PLAIN.
+	// +optional
+	Mechanism string `json:"mechanism,omitempty" protobuf:"bytes,1,opt,name=mechanism"`
+	User      string `json:"user" protobuf:"bytes,2,opt,name=user"`
+	// Password is the base64 encoded password.
+	Password string `json:"password" protobuf:"bytes,3,opt,name=password"`
+}
+
+// StorageBackEndTLS records the certificates to connect to a backend storage.
+type StorageBackEndTLS struct {
+	// CACert is the PEM encoded CA certificate to verify the server.
+	// +optional
+	CACert string `json:"caCert,omitempty" protobuf:"bytes,1,opt,name=caCert"`
+	// ClientCert is the PEM encoded client certificate.
+	// +optional
+	ClientCert string `json:"clientCert,omitempty" protobuf:"bytes,2,opt,name=clientCert"`
+	// ClientKey is the PEM encoded private key of the client certificate.
+	// +optional
+	ClientKey string `json:"clientKey,omitempty" protobuf:"bytes,3,opt,name=clientKey"`
+	// InsecureSkipVerify skips the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipVerify"`
+}
+
+// PersistentEventBuffer describes how events are buffered when the backend
+// storage is slow or unavailable.
+type PersistentEventBuffer struct {
+	// TotalLimitSize is the max size of buffered events, such as 32MB,
+	// defaults to 32MB.
+	// +optional
+	TotalLimitSize string `json:"totalLimitSize,omitempty" protobuf:"bytes,1,opt,name=totalLimitSize"`
+	// OverflowAction is the action when the buffer is full, block pauses
+	// reading events, drop_oldest_chunk drops the oldest events, defaults to
+	// block.
+	// +optional
+	OverflowAction string `json:"overflowAction,omitempty" protobuf:"bytes,2,opt,name=overflowAction"`
+}
+
 // +k8s:conversion-gen:explicit-from=net/url.Values
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -936,6 +1949,25 @@ type PrometheusSpec struct {
 	// +optional
 	// WithNPD indicates whether to deploy node-problem-detector or not
 	WithNPD bool `json:"withNPD,omitempty" protobuf:"bytes,10,opt,name=withNPD"`
+	// RemoteWrites are the extra remote write endpoints besides RemoteAddress.
+	// +optional
+	RemoteWrites []PrometheusRemoteWrite `json:"remoteWrites,omitempty" protobuf:"bytes,11,rep,name=remoteWrites"`
+	// ExternalLabels are added to the metrics sent to remote endpoints,
+	// cluster_id, tenant_id and cluster_display_name can not be overridden.
+	// +optional
+	ExternalLabels map[string]string `json:"externalLabels,omitempty" protobuf:"bytes,12,rep,name=externalLabels" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Retention is how long prometheus retains data locally, such as 2h,
+	// defaults to 30m.
+	// +optional
+	Retention string `json:"retention,omitempty" protobuf:"bytes,13,opt,name=retention"`
+	// RetentionSize is the max bytes of local data, such as 10GB, defaults
+	// to 5GB.
+	// +optional
+	RetentionSize string `json:"retentionSize,omitempty" protobuf:"bytes,14,opt,name=retentionSize"`
+	// SizingTier sizes the resources of prometheus, ignored if Resources is
+	// set.
+	// +optional
+	SizingTier PrometheusSizingTier `json:"sizingTier,omitempty" protobuf:"bytes,15,opt,name=sizingTier,casttype=PrometheusSizingTier"`
 }
 
 // PrometheusStatus is information about the current status of a Prometheus.
@@ -964,6 +1996,53 @@ type PrometheusRemoteAddr struct {
 	ReadAddr  []string `json:"readAddr,omitempty" protobuf:"bytes,2,opt,name=readAddr"`
 }
 
+// PrometheusRemoteWrite is a remote write endpoint of prometheus, such as
+// Cortex, Mimir or Thanos receive.
+type PrometheusRemoteWrite struct {
+	// Name identifies the endpoint, unique in a prometheus.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// URL is the remote write address, such as
+	// http://cortex:9009/api/v1/push.
+	URL string `json:"url" protobuf:"bytes,2,opt,name=url"`
+	// BasicAuth authenticates to the endpoint, disabled if nil.
+	// +optional
+	BasicAuth *PrometheusBasicAuth `json:"basicAuth,omitempty" protobuf:"bytes,3,opt,name=basicAuth"`
+	// BearerToken is the base64 encoded bearer token to authenticate to the
+	// endpoint.
+	// +optional
+	BearerToken string `json:"bearerToken,omitempty" protobuf:"bytes,4,opt,name=bearerToken"`
+	// TLS configures the certificates to connect to the endpoint over https.
+	// +optional
+	TLS *PrometheusTLSConfig `json:"tls,omitempty" protobuf:"bytes,5,opt,name=tls"`
+	// MetricsRegex keeps only the metrics whose names match the regex, all
+	// metrics are written if empty.
+	// +optional
+	MetricsRegex string `json:"metricsRegex,omitempty" protobuf:"bytes,6,opt,name=metricsRegex"`
+}
+
+// PrometheusBasicAuth records the basic authentication of a remote endpoint.
+type PrometheusBasicAuth struct {
+	User string `json:"user" protobuf:"bytes,1,opt,name=user"`
+	// Password is the base64 encoded password.
+	Password string `json:"password" protobuf:"bytes,2,opt,name=password"`
+}
+
+// PrometheusTLSConfig records the certificates to connect to a remote endpoint.
+type PrometheusTLSConfig struct {
+	// CACert is the PEM encoded CA certificate to verify the server.
+	// +optional
+	CACert string `json:"caCert,omitempty" protobuf:"bytes,1,opt,name=caCert"`
+	// ClientCert is the PEM encoded client certificate.
+	// +optional
+	ClientCert string `json:"clientCert,omitempty" protobuf:"bytes,2,opt,name=clientCert"`
+	// ClientKey is the PEM encoded private key of the client certificate.
+	// +optional
+	ClientKey string `json:"clientKey,omitempty" protobuf:"bytes,3,opt,name=clientKey"`
+	// InsecureSkipVerify skips the verification of the server certificate.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty" protobuf:"varint,4,opt,name=insecureSkipVerify"`
+}
+
 // AddonPhase defines the phase of helm constructor.
 type AddonPhase string
 
@@ -1168,6 +2247,17 @@ type TappControllerSpec struct {
 	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
 	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
 	Version     string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy `json:"crdConflictPolicy,omitempty" protobuf:"bytes,4,opt,name=crdConflictPolicy,casttype=CRDConflictPolicy"`
+	// DriftPolicy defines how to handle the components in cluster which drift
+	// from the desired state, defaults to Repair.
+	// +optional
+	DriftPolicy AddonDriftPolicy `json:"driftPolicy,omitempty" protobuf:"bytes,5,opt,name=driftPolicy,casttype=AddonDriftPolicy"`
+	// Config configures the tapp-controller and the defaults of TApps.
+	// +optional
+	Config *TappControllerConfig `json:"config,omitempty" protobuf:"bytes,6,opt,name=config"`
 }
 
 // TappControllerStatus is information about the current status of a tapp controller.
@@ -1186,6 +2276,76 @@ type TappControllerStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,5,name=lastReInitializingTimestamp"`
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict `json:"crdConflicts,omitempty" protobuf:"bytes,6,rep,name=crdConflicts"`
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition `json:"conditions,omitempty" protobuf:"bytes,7,rep,name=conditions"`
+}
+
+// TappControllerConfig configures the tapp-controller and the defaults of TApps.
+type TappControllerConfig struct {
+	// Workers is the number of TApps synced concurrently, defaults to 5.
+	// +optional
+	Workers int32 `json:"workers,omitempty" protobuf:"varint,1,opt,name=workers"`
+	// ForceDeletePod force deletes the pods on the lost nodes by default, so that
+	// the instances are recreated on other nodes. The TApps can override it.
+	// +optional
+	ForceDeletePod bool `json:"forceDeletePod,omitempty" protobuf:"varint,2,opt,name=forceDeletePod"`
+	// MaxUnavailable is the default number of instances unavailable during the
+	// rolling update of the TApps which do not specify it, defaults to 1.
+	// +optional
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty" protobuf:"varint,3,opt,name=maxUnavailable"`
+}
+
+// CRDConflict describes a custom resource definition in cluster which conflicts
+// with the addon.
+type CRDConflict struct {
+	// Name of the custom resource definition.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Reason of the conflict.
+	Reason CRDConflictReason `json:"reason" protobuf:"bytes,2,opt,name=reason,casttype=CRDConflictReason"`
+	// Message is a human readable description of the conflict.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// Owner is the current owner of the custom resource definition, such as a
+	// helm release or another addon.
+	// +optional
+	Owner string `json:"owner,omitempty" protobuf:"bytes,4,opt,name=owner"`
+	// ServedVersions are the versions served by the custom resource definition.
+	// +optional
+	ServedVersions []string `json:"servedVersions,omitempty" protobuf:"bytes,5,rep,name=servedVersions"`
+	// RequiredVersion is the version required by the addon.
+	// +optional
+	RequiredVersion string `json:"requiredVersion,omitempty" protobuf:"bytes,6,opt,name=requiredVersion"`
+}
+
+// AddonCondition is a lifecycle condition of an addon, the same types of
+// conditions are reported by all addons.
+type AddonCondition struct {
+	// Type is the type of the condition, Installed, Healthy or Upgraded.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// Status is the status of the condition.
+	// Can be True, False, Unknown.
+	Status ConditionStatus `json:"status" protobuf:"bytes,2,opt,name=status,casttype=ConditionStatus"`
+	// Version is the addon version which the condition is observed for, such
+	// as the target version of an upgrade.
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// Last time we probed the condition.
+	// +optional
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty" protobuf:"bytes,4,opt,name=lastProbeTime"`
+	// Last time the condition transitioned from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,5,opt,name=lastTransitionTime"`
+	// Unique, one-word, CamelCase reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,6,opt,name=reason"`
+	// Human-readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1235,6 +2395,10 @@ type CSIOperatorSpec struct {
 	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
 	// Version of the CSI operator.
 	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy `json:"crdConflictPolicy,omitempty" protobuf:"bytes,4,opt,name=crdConflictPolicy,casttype=CRDConflictPolicy"`
 }
 
 // CSIOperatorStatus is information about the current status of a storage operator.
@@ -1256,6 +2420,10 @@ type CSIOperatorStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,6,name=lastReInitializingTimestamp"`
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict `json:"crdConflicts,omitempty" protobuf:"bytes,7,rep,name=crdConflicts"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1306,6 +2474,10 @@ type VolumeDecoratorSpec struct {
 	Version           string   `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
 	VolumeTypes       []string `json:"volumeTypes,omitempty" protobuf:"bytes,4,opt,name=volumeTypes"`
 	WorkloadAdmission bool     `json:"workloadAdmission,omitempty" protobuf:"bytes,5,opt,name=workloadAdmission"`
+	// Enforcement annotates or evicts the pods whose persistent volume claims
+	// exceed the usage threshold, disabled if nil.
+	// +optional
+	Enforcement *VolumeUsageEnforcement `json:"enforcement,omitempty" protobuf:"bytes,6,opt,name=enforcement"`
 }
 
 // VolumeDecoratorStatus is information about the current status of a VolumeDecorator.
@@ -1333,6 +2505,35 @@ type VolumeDecoratorStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,8,name=lastReInitializingTimestamp"`
+	// Usages are the filesystem usages of the mounted persistent volume claims
+	// in this cluster.
+	// +optional
+	Usages []PersistentVolumeClaimUsage `json:"usages,omitempty" protobuf:"bytes,10,rep,name=usages"`
+}
+
+// VolumeUsageEnforcement acts on the pods whose persistent volume claims
+// exceed the usage threshold.
+type VolumeUsageEnforcement struct {
+	// Threshold is the percentage of used bytes to the capacity of a persistent
+	// volume claim, between 1 and 100.
+	Threshold int32 `json:"threshold" protobuf:"varint,1,opt,name=threshold"`
+	// Action defaults to Annotate.
+	// +optional
+	Action VolumeUsageAction `json:"action,omitempty" protobuf:"bytes,2,opt,name=action,casttype=VolumeUsageAction"`
+}
+
+// PersistentVolumeClaimUsage is the filesystem usage of a persistent volume
+// claim reported by kubelet.
+type PersistentVolumeClaimUsage struct {
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	Name      string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// UsedBytes is the bytes used by the filesystem of the volume.
+	UsedBytes int64 `json:"usedBytes" protobuf:"varint,3,opt,name=usedBytes"`
+	// CapacityBytes is the total bytes of the filesystem of the volume.
+	CapacityBytes int64 `json:"capacityBytes" protobuf:"varint,4,opt,name=capacityBytes"`
+	// Exceeded will be true if the usage exceeds the enforcement threshold.
+	// +optional
+	Exceeded bool `json:"exceeded,omitempty" protobuf:"varint,5,opt,name=exceeded"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1440,6 +2641,36 @@ type MachineSpec struct {
 	// If specified, the node's taints.
 	// +optional
 	Taints []corev1.Taint `json:"taints,omitempty" protobuf:"bytes,12,opt,name=taints"`
+	// KubeletConfiguration overrides the kubelet configuration of cluster on the machine.
+	// +optional
+	KubeletConfiguration *KubeletConfigurationOverrides `json:"kubeletConfiguration,omitempty" protobuf:"bytes,13,opt,name=kubeletConfiguration"`
+	// ServiceOverrides is merged over the service overrides of cluster on the machine.
+	// +optional
+	ServiceOverrides *ServiceOverrides `json:"serviceOverrides,omitempty" protobuf:"bytes,14,opt,name=serviceOverrides"`
+}
+
+// AnnotationMachineKubeletConfigurationHash records the hash of the kubelet
+// configuration overrides applied to the machine.
+const AnnotationMachineKubeletConfigurationHash = GroupName + "/kubelet-configuration-hash"
+
+// KubeletConfigurationOverrides overrides the fields of kubelet configuration
+// rendered for all nodes of cluster.
+type KubeletConfigurationOverrides struct {
+	// MaxPods is the number of pods that can run on the node.
+	// +optional
+	MaxPods *int32 `json:"maxPods,omitempty" protobuf:"varint,1,opt,name=maxPods"`
+	// EvictionHard is a map of signal names to quantities that defines hard eviction thresholds.
+	// For example: {"memory.available": "300Mi"}.
+	// +optional
+	EvictionHard map[string]string `json:"evictionHard,omitempty" protobuf:"bytes,2,rep,name=evictionHard"`
+	// ReservedCPUs is the cpu list reserved for the system and kubernetes components.
+	// For example: "0-1" or "0,4".
+	// +optional
+	ReservedCPUs string `json:"reservedCPUs,omitempty" protobuf:"bytes,3,opt,name=reservedCPUs"`
+	// TopologyManagerPolicy is the policy of topology manager, one of none,
+	// best-effort, restricted and single-numa-node.
+	// +optional
+	TopologyManagerPolicy string `json:"topologyManagerPolicy,omitempty" protobuf:"bytes,4,opt,name=topologyManagerPolicy"`
 }
 
 // MachineStatus represents information about the status of an machine.
@@ -1466,6 +2697,32 @@ type MachineStatus struct {
 	// Set of ids/uuids to uniquely identify the node.
 	// +optional
 	MachineInfo MachineSystemInfo `json:"machineInfo,omitempty" protobuf:"bytes,7,opt,name=machineInfo"`
+	// Progress records the phases progress of the current operation.
+	// +optional
+	Progress *Progress `json:"progress,omitempty" protobuf:"bytes,8,opt,name=progress"`
+	// UpgradeBackup records the binaries and configurations of the machine before
+	// upgrade, which the machine can be rolled back to.
+	// +optional
+	UpgradeBackup *MachineUpgradeBackup `json:"upgradeBackup,omitempty" protobuf:"bytes,9,opt,name=upgradeBackup"`
+}
+
+// AnnotationMachineUpgradeRollback rolls the machine back to the binaries and
+// configurations before upgrade when its value is true.
+const AnnotationMachineUpgradeRollback = GroupName + "/upgrade-rollback"
+
+// MachineUpgradeBackup describes the files saved on machine before upgrade.
+type MachineUpgradeBackup struct {
+	// Version is the kubelet version before upgrade.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// TargetVersion is the version which the machine is upgraded to.
+	TargetVersion string `json:"targetVersion" protobuf:"bytes,2,opt,name=targetVersion"`
+	// Dir is the directory on machine where the files are saved.
+	Dir string `json:"dir" protobuf:"bytes,3,opt,name=dir"`
+	// Files are the paths of saved files.
+	// +optional
+	Files []string `json:"files,omitempty" protobuf:"bytes,4,rep,name=files"`
+	// +optional
+	CreationTime metav1.Time `json:"creationTime,omitempty" protobuf:"bytes,5,opt,name=creationTime"`
 }
 
 // MachineSystemInfo is a set of ids/uuids to uniquely identify the node.
@@ -1613,6 +2870,18 @@ type CronHPASpec struct {
 	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
 	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
 	Version     string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// CRDConflictPolicy defines how to handle the conflicting custom resource
+	// definitions in cluster before installing, defaults to Fail.
+	// +optional
+	CRDConflictPolicy CRDConflictPolicy `json:"crdConflictPolicy,omitempty" protobuf:"bytes,4,opt,name=crdConflictPolicy,casttype=CRDConflictPolicy"`
+	// DriftPolicy defines how to handle the components in cluster which drift
+	// from the desired state, defaults to Repair.
+	// +optional
+	DriftPolicy AddonDriftPolicy `json:"driftPolicy,omitempty" protobuf:"bytes,5,opt,name=driftPolicy,casttype=AddonDriftPolicy"`
+	// TimeZone is the IANA time zone which the schedules of CronHPAs run in,
+	// such as Asia/Shanghai, defaults to the local time of cron-hpa-controller.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,6,opt,name=timeZone"`
 }
 
 // CronHPAStatus is information about the current status of a CronHPA.
@@ -1631,6 +2900,13 @@ type CronHPAStatus struct {
 	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
 	// +optional
 	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,5,name=lastReInitializingTimestamp"`
+	// CRDConflicts are the conflicting custom resource definitions found before
+	// installing, which stop the addon from installing.
+	// +optional
+	CRDConflicts []CRDConflict `json:"crdConflicts,omitempty" protobuf:"bytes,6,rep,name=crdConflicts"`
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition `json:"conditions,omitempty" protobuf:"bytes,7,rep,name=conditions"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1680,6 +2956,14 @@ type LBCFSpec struct {
 	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
 	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
 	Version     string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// HealthCheck is the default health check of backends, which is published to
+	// the drivers in the lbcf-driver-config ConfigMap of kube-system.
+	// +optional
+	HealthCheck *LBCFHealthCheck `json:"healthCheck,omitempty" protobuf:"bytes,4,opt,name=healthCheck"`
+	// WebhookTimeoutSeconds is the default timeout of the webhooks of drivers,
+	// between 1 and 60, defaults to 10.
+	// +optional
+	WebhookTimeoutSeconds int32 `json:"webhookTimeoutSeconds,omitempty" protobuf:"varint,5,opt,name=webhookTimeoutSeconds"`
 }
 
 // LBCFStatus is information about the current status of a Helm.
@@ -1699,3 +2983,919 @@ type LBCFStatus struct {
 	// +optional
 	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,5,name=lastReInitializingTimestamp"`
 }
+
+// LBCFHealthCheck is the default health check of the backends registered to
+// load balancers by LBCF drivers.
+type LBCFHealthCheck struct {
+	// Path is the HTTP path to probe, TCP is probed if empty.
+	// +optional
+	Path string `json:"path,omitempty" protobuf:"bytes,1,opt,name=path"`
+	// IntervalSeconds is the interval between probes, defaults to 5.
+	// +optional
+	IntervalSeconds int32 `json:"intervalSeconds,omitempty" protobuf:"varint,2,opt,name=intervalSeconds"`
+	// TimeoutSeconds is the timeout of a probe, defaults to 2.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty" protobuf:"varint,3,opt,name=timeoutSeconds"`
+	// HealthyThreshold is the consecutive successes for a backend to be healthy,
+	// defaults to 3.
+	// +optional
+	HealthyThreshold int32 `json:"healthyThreshold,omitempty" protobuf:"varint,4,opt,name=healthyThreshold"`
+	// UnhealthyThreshold is the consecutive failures for a backend to be
+	// unhealthy, defaults to 3.
+	// +optional
+	UnhealthyThreshold int32 `json:"unhealthyThreshold,omitempty" protobuf:"varint,5,opt,name=unhealthyThreshold"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressGateway is a managed egress gateway which lets the workloads present
+// stable source IPs to the outside of cluster.
+type EgressGateway struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of EgressGateway.
+	// +optional
+	Spec EgressGatewaySpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status EgressGatewayStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EgressGatewayList is the whole list of all EgressGateways which owned by a tenant.
+type EgressGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of EgressGateways
+	Items []EgressGateway `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// EgressGatewaySpec describes the attributes on a EgressGateway.
+type EgressGatewaySpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Version     string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// IPPools are the static egress IPs and the namespaces using them.
+	// +optional
+	IPPools []EgressIPPool `json:"ipPools,omitempty" protobuf:"bytes,4,rep,name=ipPools"`
+	// FailoverTimeout is how long a gateway node is not ready before its
+	// egress IPs are moved to the other gateway nodes of the pool, which is
+	// 40s by default.
+	// +optional
+	FailoverTimeout *metav1.Duration `json:"failoverTimeout,omitempty" protobuf:"bytes,5,opt,name=failoverTimeout"`
+}
+
+// EgressIPPool binds a set of static egress IPs to namespaces.
+type EgressIPPool struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// IPs are the source IPs which the traffic leaving the cluster from the
+	// namespaces is translated to. They are assigned to the gateway nodes.
+	IPs []string `json:"ips" protobuf:"bytes,2,rep,name=ips"`
+	// Namespaces are the namespaces whose egress traffic uses the pool.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,3,rep,name=namespaces"`
+	// Projects are the projects whose namespaces use the pool.
+	// +optional
+	Projects []string `json:"projects,omitempty" protobuf:"bytes,4,rep,name=projects"`
+	// NodeSelector selects the gateway nodes which hold the egress IPs.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,5,rep,name=nodeSelector"`
+	// AllNamespaces makes the pool the default of the namespaces not using
+	// other pools, so the egress traffic of all pods goes through the gateway
+	// nodes of it. Only one pool can be the default.
+	// +optional
+	AllNamespaces bool `json:"allNamespaces,omitempty" protobuf:"varint,6,opt,name=allNamespaces"`
+}
+
+// EgressGatewayStatus is information about the current status of a EgressGateway.
+type EgressGatewayStatus struct {
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,1,opt,name=version"`
+	// Phase is the current lifecycle phase of the EgressGateway of cluster.
+	// +optional
+	Phase AddonPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// RetryCount is a int between 0 and 5 that describes the time of retrying initializing.
+	// +optional
+	RetryCount int32 `json:"retryCount" protobuf:"varint,4,name=retryCount"`
+	// LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp" protobuf:"bytes,5,name=lastReInitializingTimestamp"`
+	// Assignments are the gateway nodes holding the egress IPs.
+	// +optional
+	Assignments []EgressIPAssignment `json:"assignments,omitempty" protobuf:"bytes,6,rep,name=assignments"`
+}
+
+// EgressIPAssignment records the gateway node which holds an egress IP.
+type EgressIPAssignment struct {
+	// Pool is the name of the pool the IP belongs to.
+	Pool string `json:"pool" protobuf:"bytes,1,opt,name=pool"`
+	IP   string `json:"ip" protobuf:"bytes,2,opt,name=ip"`
+	// Node is the gateway node holding the IP, which is empty if no gateway
+	// node of the pool is ready.
+	// +optional
+	Node string `json:"node,omitempty" protobuf:"bytes,3,opt,name=node"`
+	// The last time the IP moved from one node to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FloatingIPReservation reserves a floating IP of the galaxy-ipam addon for a
+// workload ahead of time.
+type FloatingIPReservation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the IP and the workload of FloatingIPReservation.
+	// +optional
+	Spec FloatingIPReservationSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status FloatingIPReservationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FloatingIPReservationList is the whole list of all FloatingIPReservations
+// which owned by a tenant.
+type FloatingIPReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of FloatingIPReservations
+	Items []FloatingIPReservation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// FloatingIPReservationSpec describes the attributes on a FloatingIPReservation.
+type FloatingIPReservationSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// IP is the floating IP to reserve, which must be in the floating IP pools
+	// of cluster.
+	IP string `json:"ip" protobuf:"bytes,3,opt,name=ip"`
+	// Namespace is the namespace of the workload.
+	Namespace string `json:"namespace" protobuf:"bytes,4,opt,name=namespace"`
+	// WorkloadKind is the kind of the workload, Deployment, StatefulSet or TApp.
+	WorkloadKind string `json:"workloadKind" protobuf:"bytes,5,opt,name=workloadKind"`
+	// WorkloadName is the name of the workload.
+	WorkloadName string `json:"workloadName" protobuf:"bytes,6,opt,name=workloadName"`
+	// PodName is the pod of StatefulSet or TApp using the IP, which is required
+	// since the pods of them keep their own IPs. The IP is shared by the pods of
+	// Deployment.
+	// +optional
+	PodName string `json:"podName,omitempty" protobuf:"bytes,7,opt,name=podName"`
+}
+
+// FloatingIPReservationStatus is information about the current status of a
+// FloatingIPReservation.
+type FloatingIPReservationStatus struct {
+	// +optional
+	Phase FloatingIPReservationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=FloatingIPReservationPhase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// Subnet is the subnet of the floating IP pool which the IP belongs to.
+	// +optional
+	Subnet string `json:"subnet,omitempty" protobuf:"bytes,3,opt,name=subnet"`
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GPUNodeConfig declares the MIG geometry of the GPUs of a node, which is
+// reconciled by NVIDIA MIG manager.
+type GPUNodeConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the node and the MIG geometry of its GPUs.
+	// +optional
+	Spec GPUNodeConfigSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status GPUNodeConfigStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GPUNodeConfigList is the whole list of all GPUNodeConfigs which owned by a
+// tenant.
+type GPUNodeConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of GPUNodeConfigs
+	Items []GPUNodeConfig `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// GPUNodeConfigSpec describes the attributes on a GPUNodeConfig.
+type GPUNodeConfigSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// NodeName is the node with MIG capable GPUs, such as A100 and A30.
+	NodeName string `json:"nodeName" protobuf:"bytes,3,opt,name=nodeName"`
+	// MIGDevices partitions the GPUs into MIG devices, MIG is disabled on all the
+	// GPUs of the node if empty.
+	// +optional
+	MIGDevices []GPUMIGDeviceConfig `json:"migDevices,omitempty" protobuf:"bytes,4,rep,name=migDevices"`
+}
+
+// GPUMIGDeviceConfig partitions the selected GPUs of a node with the same
+// MIG slices.
+type GPUMIGDeviceConfig struct {
+	// Devices are the indexes of the GPUs, all the GPUs of the node if empty.
+	// +optional
+	Devices []int32 `json:"devices,omitempty" protobuf:"bytes,1,rep,name=devices"`
+	// Slices are the MIG devices created on each of the GPUs, MIG is disabled on
+	// the GPUs if empty.
+	// +optional
+	Slices []GPUMIGSlice `json:"slices,omitempty" protobuf:"bytes,2,rep,name=slices"`
+}
+
+// GPUMIGSlice is a number of MIG devices with the same profile.
+type GPUMIGSlice struct {
+	// Profile is the MIG profile, such as 1g.5gb and 3g.20gb.
+	Profile string `json:"profile" protobuf:"bytes,1,opt,name=profile"`
+	// Count is the number of MIG devices created on each GPU.
+	Count int32 `json:"count" protobuf:"varint,2,opt,name=count"`
+}
+
+// GPUNodeConfigStatus is information about the current status of a
+// GPUNodeConfig.
+type GPUNodeConfigStatus struct {
+	// +optional
+	Phase GPUNodeConfigPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=GPUNodeConfigPhase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
+	// Profiles are the MIG profiles discovered on the node.
+	// +optional
+	Profiles []GPUMIGProfile `json:"profiles,omitempty" protobuf:"bytes,3,rep,name=profiles"`
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,4,opt,name=lastTransitionTime"`
+}
+
+// GPUMIGProfile is the MIG devices of a profile advertised by a node.
+type GPUMIGProfile struct {
+	// Profile is the MIG profile, such as 1g.5gb.
+	Profile string `json:"profile" protobuf:"bytes,1,opt,name=profile"`
+	// ResourceName is the extended resource requested by pods for the profile,
+	// such as nvidia.com/mig-1g.5gb.
+	ResourceName string `json:"resourceName" protobuf:"bytes,2,opt,name=resourceName"`
+	// Capacity is the number of MIG devices of the profile.
+	Capacity int64 `json:"capacity" protobuf:"varint,3,opt,name=capacity"`
+	// Allocatable is the number of MIG devices of the profile available for pods.
+	Allocatable int64 `json:"allocatable" protobuf:"varint,4,opt,name=allocatable"`
+}
+
+// GPUNodeConfigPhase indicates the status of GPUNodeConfig.
+type GPUNodeConfigPhase string
+
+const (
+	// GPUNodeConfigPending means the MIG geometry is not applied to the node yet.
+	GPUNodeConfigPending GPUNodeConfigPhase = "Pending"
+	// GPUNodeConfigApplying means the GPUs of the node are being repartitioned.
+	GPUNodeConfigApplying GPUNodeConfigPhase = "Applying"
+	// GPUNodeConfigApplied means the GPUs of the node are partitioned as the
+	// geometry.
+	GPUNodeConfigApplied GPUNodeConfigPhase = "Applied"
+	// GPUNodeConfigFailed means the geometry can not be applied, such as the
+	// GPUs of the node do not support the profiles.
+	GPUNodeConfigFailed GPUNodeConfigPhase = "Failed"
+)
+
+// ClusterOperationPhase indicates the status of ClusterOperation.
+type ClusterOperationPhase string
+
+const (
+	// ClusterOperationPending means the operation is queued and waits for the
+	// cluster to be reachable.
+	ClusterOperationPending ClusterOperationPhase = "Pending"
+	// ClusterOperationSucceeded means the operation is applied to the cluster.
+	ClusterOperationSucceeded ClusterOperationPhase = "Succeeded"
+	// ClusterOperationFailed means the operation is rejected by the cluster.
+	ClusterOperationFailed ClusterOperationPhase = "Failed"
+	// ClusterOperationExpired means the cluster is not reachable before the
+	// operation expires, it is never applied.
+	ClusterOperationExpired ClusterOperationPhase = "Expired"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterOperation is a mutation of the resources in a cluster which is queued
+// while the cluster is unreachable, it is applied once the cluster is reachable
+// again or expired.
+type ClusterOperation struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the request of ClusterOperation.
+	// +optional
+	Spec ClusterOperationSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status ClusterOperationStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterOperationList is the whole list of all ClusterOperations which owned
+// by a tenant.
+type ClusterOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of ClusterOperations
+	Items []ClusterOperation `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ClusterOperationSpec describes the request of a ClusterOperation.
+type ClusterOperationSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	// Verb is the HTTP method of the request, POST, PUT, PATCH or DELETE.
+	Verb string `json:"verb" protobuf:"bytes,3,opt,name=verb"`
+	// Path is the request URI on the kube-apiserver of cluster with the query.
+	Path string `json:"path" protobuf:"bytes,4,opt,name=path"`
+	// ContentType is the content type of body.
+	// +optional
+	ContentType string `json:"contentType,omitempty" protobuf:"bytes,5,opt,name=contentType"`
+	// Body is the request body.
+	// +optional
+	Body string `json:"body,omitempty" protobuf:"bytes,6,opt,name=body"`
+	// Username is the user who submitted the operation, the operation is
+	// applied as the user.
+	Username string `json:"username" protobuf:"bytes,7,opt,name=username"`
+	// Groups are the groups of the user.
+	// +optional
+	Groups []string `json:"groups,omitempty" protobuf:"bytes,8,rep,name=groups"`
+	// ExpireTime is the time after which the operation is expired if the cluster
+	// is still unreachable.
+	ExpireTime metav1.Time `json:"expireTime" protobuf:"bytes,9,opt,name=expireTime"`
+}
+
+// ClusterOperationStatus is information about the current status of a
+// ClusterOperation.
+type ClusterOperationStatus struct {
+	// +optional
+	Phase ClusterOperationPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ClusterOperationPhase"`
+	// Attempts is the number of times the operation is sent to the cluster.
+	// +optional
+	Attempts int32 `json:"attempts,omitempty" protobuf:"varint,2,opt,name=attempts"`
+	// The last time the operation is sent to the cluster.
+	// +optional
+	LastAttemptTime metav1.Time `json:"lastAttemptTime,omitempty" protobuf:"bytes,3,opt,name=lastAttemptTime"`
+	// Code is the HTTP status code returned by the cluster.
+	// +optional
+	Code int32 `json:"code,omitempty" protobuf:"varint,4,opt,name=code"`
+	// A human readable message indicating details about the last attempt.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
+	// The last time the phase transitioned from one to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,6,opt,name=lastTransitionTime"`
+}
+
+// MetalLBProtocol is the protocol used by MetalLB to announce the addresses
+// of a pool.
+type MetalLBProtocol string
+
+const (
+	// MetalLBProtocolLayer2 answers the ARP and NDP requests for the addresses
+	// from one of the nodes.
+	MetalLBProtocolLayer2 MetalLBProtocol = "layer2"
+	// MetalLBProtocolBGP announces the addresses to the BGP peers from all the
+	// nodes.
+	MetalLBProtocolBGP MetalLBProtocol = "bgp"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MetalLB is a load balancer for bare metal clusters, which assigns the
+// addresses of Services of type LoadBalancer and announces them by layer 2 or
+// BGP.
+type MetalLB struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of MetalLB.
+	// +optional
+	Spec MetalLBSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status MetalLBStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MetalLBList is the whole list of all MetalLBs which owned by a tenant.
+type MetalLBList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of MetalLBs
+	Items []MetalLB `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MetalLBSpec describes the attributes on a MetalLB.
+type MetalLBSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Version     string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// AddressPools are the addresses assigned to the Services of type
+	// LoadBalancer.
+	AddressPools []MetalLBAddressPool `json:"addressPools" protobuf:"bytes,4,rep,name=addressPools"`
+	// Peers are the BGP routers which the nodes announce the addresses of bgp
+	// pools to.
+	// +optional
+	Peers []MetalLBPeer `json:"peers,omitempty" protobuf:"bytes,5,rep,name=peers"`
+}
+
+// MetalLBAddressPool is a pool of addresses announced by the same protocol.
+type MetalLBAddressPool struct {
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Protocol announcing the addresses, layer2 or bgp.
+	Protocol MetalLBProtocol `json:"protocol" protobuf:"bytes,2,opt,name=protocol,casttype=MetalLBProtocol"`
+	// Addresses are the CIDRs or the ranges like 192.168.1.100-192.168.1.200.
+	Addresses []string `json:"addresses" protobuf:"bytes,3,rep,name=addresses"`
+	// AutoAssign assigns the addresses of pool to Services automatically,
+	// defaults to true. The addresses are only assigned on request if it is
+	// false.
+	// +optional
+	AutoAssign *bool `json:"autoAssign,omitempty" protobuf:"varint,4,opt,name=autoAssign"`
+}
+
+// MetalLBPeer is a BGP router peering with the nodes.
+type MetalLBPeer struct {
+	// PeerAddress is the address of router.
+	PeerAddress string `json:"peerAddress" protobuf:"bytes,1,opt,name=peerAddress"`
+	// PeerASN is the AS number of router.
+	PeerASN int64 `json:"peerASN" protobuf:"varint,2,opt,name=peerASN"`
+	// MyASN is the AS number of nodes.
+	MyASN int64 `json:"myASN" protobuf:"varint,3,opt,name=myASN"`
+	// PeerPort is the BGP port of router, defaults to 179.
+	// +optional
+	PeerPort int32 `json:"peerPort,omitempty" protobuf:"varint,4,opt,name=peerPort"`
+	// NodeSelector selects the nodes peering with the router, all nodes peer
+	// with it if not specified.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,5,rep,name=nodeSelector" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// MetalLBStatus is information about the current status of a MetalLB.
+type MetalLBStatus struct {
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,1,opt,name=version"`
+	// Phase is the current lifecycle phase of the MetalLB of cluster.
+	// +optional
+	Phase AddonPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=AddonPhase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty" protobuf:"varint,4,opt,name=retryCount"`
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastReInitializingTimestamp"`
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition `json:"conditions,omitempty" protobuf:"bytes,6,rep,name=conditions"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Multus attaches secondary network interfaces such as macvlan, ipvlan and
+// SR-IOV to pods by the NetworkAttachmentDefinitions.
+type Multus struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of Multus.
+	// +optional
+	Spec MultusSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status MultusStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MultusList is the whole list of all Multuses which owned by a tenant.
+type MultusList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of Multuses
+	Items []Multus `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// MultusSpec describes the attributes on a Multus.
+type MultusSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Version     string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// SRIOV deploys the SR-IOV network device plugin and CNI, which advertise
+	// the virtual functions of NICs as extended resources of nodes.
+	// +optional
+	SRIOV *MultusSRIOV `json:"sriov,omitempty" protobuf:"bytes,4,opt,name=sriov"`
+	// NetworkAttachments are created as NetworkAttachmentDefinitions, which are
+	// referenced by the k8s.v1.cni.cncf.io/networks annotation of pods.
+	// +optional
+	NetworkAttachments []MultusNetworkAttachment `json:"networkAttachments,omitempty" protobuf:"bytes,5,rep,name=networkAttachments"`
+}
+
+// MultusSRIOV describes the resources advertised by SR-IOV network device
+// plugin.
+type MultusSRIOV struct {
+	// ResourcePrefix is the prefix of extended resources, defaults to intel.com.
+	// +optional
+	ResourcePrefix string `json:"resourcePrefix,omitempty" protobuf:"bytes,1,opt,name=resourcePrefix"`
+	// Resources group the virtual functions by the selectors.
+	Resources []MultusSRIOVResource `json:"resources" protobuf:"bytes,2,rep,name=resources"`
+	// NodeSelector selects the nodes with SR-IOV NICs, all nodes if not
+	// specified.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,3,rep,name=nodeSelector" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// MultusSRIOVResource is an extended resource of the virtual functions
+// matching all the selectors.
+type MultusSRIOVResource struct {
+	// ResourceName is the name of extended resource without prefix.
+	ResourceName string `json:"resourceName" protobuf:"bytes,1,opt,name=resourceName"`
+	// Vendors are the vendor hex codes of devices, such as 8086.
+	// +optional
+	Vendors []string `json:"vendors,omitempty" protobuf:"bytes,2,rep,name=vendors"`
+	// Devices are the device hex codes of virtual functions, such as 154c.
+	// +optional
+	Devices []string `json:"devices,omitempty" protobuf:"bytes,3,rep,name=devices"`
+	// Drivers are the drivers of virtual functions, such as iavf and vfio-pci.
+	// +optional
+	Drivers []string `json:"drivers,omitempty" protobuf:"bytes,4,rep,name=drivers"`
+	// PFNames are the physical functions of virtual functions, such as eth1.
+	// +optional
+	PFNames []string `json:"pfNames,omitempty" protobuf:"bytes,5,rep,name=pfNames"`
+}
+
+// MultusNetworkAttachment is a NetworkAttachmentDefinition managed by Multus.
+type MultusNetworkAttachment struct {
+	Name      string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// Type is the CNI plugin, one of macvlan, ipvlan, host-device and sriov.
+	// It is ignored if Config is specified.
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,3,opt,name=type"`
+	// Master is the interface of nodes which macvlan and ipvlan are created on,
+	// or the device moved into pods by host-device.
+	// +optional
+	Master string `json:"master,omitempty" protobuf:"bytes,4,opt,name=master"`
+	// Mode is the mode of macvlan (bridge, private, vepa or passthru) or ipvlan
+	// (l2, l3 or l3s).
+	// +optional
+	Mode string `json:"mode,omitempty" protobuf:"bytes,5,opt,name=mode"`
+	// ResourceName is the SR-IOV resource without prefix which the pods request
+	// the virtual functions from, it is required by sriov.
+	// +optional
+	ResourceName string `json:"resourceName,omitempty" protobuf:"bytes,6,opt,name=resourceName"`
+	// VLAN is the VLAN ID of virtual functions assigned to pods, only used by
+	// sriov.
+	// +optional
+	VLAN int32 `json:"vlan,omitempty" protobuf:"varint,7,opt,name=vlan"`
+	// +optional
+	MTU int32 `json:"mtu,omitempty" protobuf:"varint,8,opt,name=mtu"`
+	// IPAM allocates the addresses of the interfaces, the interfaces have no
+	// address if it is not specified.
+	// +optional
+	IPAM *MultusIPAM `json:"ipam,omitempty" protobuf:"bytes,9,opt,name=ipam"`
+	// Config is the raw CNI configuration in JSON, which is used as it is for
+	// the plugins not supported by the fields above.
+	// +optional
+	Config string `json:"config,omitempty" protobuf:"bytes,10,opt,name=config"`
+}
+
+// MultusIPAM describes the IPAM plugin of a network attachment.
+type MultusIPAM struct {
+	// Type is the IPAM plugin, one of host-local, static and dhcp, defaults to
+	// host-local. The addresses of host-local are allocated per node, so the
+	// ranges of nodes must not overlap if the interfaces are in the same layer 2
+	// network. The addresses of static are specified by the networks annotation
+	// of pods, and dhcp requires the dhcp daemon running on nodes.
+	// +optional
+	Type string `json:"type,omitempty" protobuf:"bytes,1,opt,name=type"`
+	// Subnet is the CIDR which the addresses are allocated from, only used by
+	// host-local.
+	// +optional
+	Subnet string `json:"subnet,omitempty" protobuf:"bytes,2,opt,name=subnet"`
+	// +optional
+	RangeStart string `json:"rangeStart,omitempty" protobuf:"bytes,3,opt,name=rangeStart"`
+	// +optional
+	RangeEnd string `json:"rangeEnd,omitempty" protobuf:"bytes,4,opt,name=rangeEnd"`
+	// +optional
+	Gateway string `json:"gateway,omitempty" protobuf:"bytes,5,opt,name=gateway"`
+	// Routes are the destination CIDRs routed through the interface.
+	// +optional
+	Routes []string `json:"routes,omitempty" protobuf:"bytes,6,rep,name=routes"`
+}
+
+// MultusStatus is information about the current status of a Multus.
+type MultusStatus struct {
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,1,opt,name=version"`
+	// Phase is the current lifecycle phase of the Multus of cluster.
+	// +optional
+	Phase AddonPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=AddonPhase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty" protobuf:"varint,4,opt,name=retryCount"`
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastReInitializingTimestamp"`
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition `json:"conditions,omitempty" protobuf:"bytes,6,rep,name=conditions"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DevicePlugin deploys the Kubernetes device plugin of a device class, such
+// as RDMA, FPGA and QAT, and labels the nodes advertising the devices.
+type DevicePlugin struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the desired identities of DevicePlugin.
+	// +optional
+	Spec DevicePluginSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status DevicePluginStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DevicePluginList is the whole list of all DevicePlugins which owned by a
+// tenant.
+type DevicePluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of DevicePlugins
+	Items []DevicePlugin `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// DevicePluginSpec describes the attributes on a DevicePlugin.
+type DevicePluginSpec struct {
+	TenantID    string `json:"tenantID" protobuf:"bytes,1,opt,name=tenantID"`
+	ClusterName string `json:"clusterName" protobuf:"bytes,2,opt,name=clusterName"`
+	Version     string `json:"version" protobuf:"bytes,3,opt,name=version"`
+	// DeviceClass is the class of devices, the built-in classes rdma, fpga and qat
+	// have default image, resource prefix and host paths, other classes must
+	// specify the image and resource prefix.
+	DeviceClass string `json:"deviceClass" protobuf:"bytes,4,opt,name=deviceClass"`
+	// Image of the device plugin, overrides the default image of the built-in
+	// class. The image without registry is pulled from the registry of platform.
+	// +optional
+	Image string `json:"image,omitempty" protobuf:"bytes,5,opt,name=image"`
+	// MirrorImage copies the image to the registry of platform before deploying,
+	// then the nodes pull the image without accessing the source registry.
+	// +optional
+	MirrorImage bool `json:"mirrorImage,omitempty" protobuf:"varint,6,opt,name=mirrorImage"`
+	// ResourcePrefix is the prefix of the extended resources advertised by the
+	// device plugin, such as rdma/ and qat.intel.com/, which is used to label the
+	// nodes with the devices.
+	// +optional
+	ResourcePrefix string `json:"resourcePrefix,omitempty" protobuf:"bytes,7,opt,name=resourcePrefix"`
+	// Args overrides the default arguments of the device plugin.
+	// +optional
+	Args []string `json:"args,omitempty" protobuf:"bytes,8,rep,name=args"`
+	// Config is the content of the config file of the device plugin, which is
+	// mounted at ConfigPath.
+	// +optional
+	Config string `json:"config,omitempty" protobuf:"bytes,9,opt,name=config"`
+	// ConfigPath is the path of the config file in the container, defaults to
+	// the path of the built-in class.
+	// +optional
+	ConfigPath string `json:"configPath,omitempty" protobuf:"bytes,10,opt,name=configPath"`
+	// HostPaths are mounted into the device plugin along with the default host
+	// paths of the built-in class and the device plugin directory of kubelet.
+	// +optional
+	HostPaths []string `json:"hostPaths,omitempty" protobuf:"bytes,11,rep,name=hostPaths"`
+	// NodeSelector selects the nodes to deploy the device plugin, all nodes if
+	// not specified.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty" protobuf:"bytes,12,rep,name=nodeSelector" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+// DevicePluginStatus is information about the current status of a
+// DevicePlugin.
+type DevicePluginStatus struct {
+	// +optional
+	Version string `json:"version,omitempty" protobuf:"bytes,1,opt,name=version"`
+	// Phase is the current lifecycle phase of the DevicePlugin of cluster.
+	// +optional
+	Phase AddonPhase `json:"phase,omitempty" protobuf:"bytes,2,opt,name=phase,casttype=AddonPhase"`
+	// Reason is a brief CamelCase string that describes any failure.
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
+	// RetryCount is a int between 0 and 5 that describes the time of retrying
+	// initializing.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty" protobuf:"varint,4,opt,name=retryCount"`
+	// LastReInitializingTimestamp is a timestamp that describes the last time of
+	// retrying initializing.
+	// +optional
+	LastReInitializingTimestamp metav1.Time `json:"lastReInitializingTimestamp,omitempty" protobuf:"bytes,5,opt,name=lastReInitializingTimestamp"`
+	// Image is the image deployed, which is the mirrored image if MirrorImage is
+	// set.
+	// +optional
+	Image string `json:"image,omitempty" protobuf:"bytes,6,opt,name=image"`
+	// Nodes are the nodes advertising the devices, which are labeled with
+	// device.platform.tkestack.io/<class>=true.
+	// +optional
+	Nodes []string `json:"nodes,omitempty" protobuf:"bytes,7,rep,name=nodes"`
+	// Conditions are the lifecycle conditions of the addon.
+	// +optional
+	Conditions []AddonCondition `json:"conditions,omitempty" protobuf:"bytes,8,rep,name=conditions"`
+}
+
+// FloatingIPReservationPhase indicates the status of FloatingIPReservation.
+type FloatingIPReservationPhase string
+
+const (
+	// FloatingIPReservationPending means the IP is not reserved in cluster yet.
+	FloatingIPReservationPending FloatingIPReservationPhase = "Pending"
+	// FloatingIPReservationReserved means the IP is reserved for the workload.
+	FloatingIPReservationReserved FloatingIPReservationPhase = "Reserved"
+	// FloatingIPReservationFailed means the IP can not be reserved, such as it
+	// is out of the pools or allocated to another workload.
+	FloatingIPReservationFailed FloatingIPReservationPhase = "Failed"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:skipVerbs=deleteCollection
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// License records the entitlements of the installation, and the usage of them
+// is reported in the status.
+type License struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec defines the entitlements of License.
+	// +optional
+	Spec LicenseSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// +optional
+	Status LicenseStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LicenseList is the whole list of all Licenses.
+type LicenseList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// List of Licenses
+	Items []License `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// LicenseSpec describes the entitlements of a License.
+type LicenseSpec struct {
+	// Licensee is the customer who the license is issued to.
+	Licensee string `json:"licensee" protobuf:"bytes,1,opt,name=licensee"`
+	// MaxNodes is the max number of nodes in all clusters, 0 means unlimited.
+	// +optional
+	MaxNodes int32 `json:"maxNodes,omitempty" protobuf:"varint,2,opt,name=maxNodes"`
+	// MaxClusters is the max number of clusters, 0 means unlimited.
+	// +optional
+	MaxClusters int32 `json:"maxClusters,omitempty" protobuf:"varint,3,opt,name=maxClusters"`
+	// Features are the entitled features, empty means all features.
+	// +optional
+	Features []string `json:"features,omitempty" protobuf:"bytes,4,rep,name=features"`
+	// NotBefore is the time from which the license is valid, zero means valid immediately.
+	// +optional
+	NotBefore metav1.Time `json:"notBefore,omitempty" protobuf:"bytes,5,opt,name=notBefore"`
+	// NotAfter is the expiration time of license, zero means never expires.
+	// +optional
+	NotAfter metav1.Time `json:"notAfter,omitempty" protobuf:"bytes,6,opt,name=notAfter"`
+	// WarningPercent is the usage percentage of entitlements from which warnings
+	// are sent. Defaults to 90.
+	// +optional
+	WarningPercent int32 `json:"warningPercent,omitempty" protobuf:"varint,7,opt,name=warningPercent"`
+	// Notify describes where the warnings are sent, no warnings are sent if not specified.
+	// +optional
+	Notify *LicenseNotify `json:"notify,omitempty" protobuf:"bytes,8,opt,name=notify"`
+	// Signature is the base64 encoded Ed25519 signature of the entitlements,
+	// which is signed by the issuer over the JSON object of licensee, maxNodes,
+	// maxClusters, features, notBefore and notAfter, and is verified with the
+	// public key of the issuer the platform controller is configured with.
+	// +optional
+	Signature []byte `json:"signature,omitempty" protobuf:"bytes,9,opt,name=signature"`
+}
+
+// LicenseNotify describes the channel, template and receivers of the notify
+// messages about license.
+type LicenseNotify struct {
+	Channel  string `json:"channel" protobuf:"bytes,1,opt,name=channel"`
+	Template string `json:"template" protobuf:"bytes,2,opt,name=template"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty" protobuf:"bytes,3,rep,name=receivers"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty" protobuf:"bytes,4,rep,name=receiverGroups"`
+}
+
+// LicenseStatus is information about the usage of entitlements of a License.
+type LicenseStatus struct {
+	// +optional
+	Phase LicensePhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=LicensePhase"`
+	// Nodes is the number of nodes in all clusters.
+	// +optional
+	Nodes int32 `json:"nodes,omitempty" protobuf:"varint,2,opt,name=nodes"`
+	// Clusters is the number of clusters.
+	// +optional
+	Clusters int32 `json:"clusters,omitempty" protobuf:"varint,3,opt,name=clusters"`
+	// Message describes the usage exceeding or approaching the entitlements.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,5,opt,name=lastUpdateTime"`
+	// LastWarningTime is the time when the last warning was sent.
+	// +optional
+	LastWarningTime metav1.Time `json:"lastWarningTime,omitempty" protobuf:"bytes,6,opt,name=lastWarningTime"`
+}
+
+// LicensePhase indicates the usage of entitlements of License.
+type LicensePhase string
+
+const (
+	// LicenseValid means the usage is within the entitlements.
+	LicenseValid LicensePhase = "Valid"
+	// LicenseWarning means the usage is approaching the entitlements or the
+	// license is expiring.
+	LicenseWarning LicensePhase = "Warning"
+	// LicenseExceeded means the usage exceeds the entitlements.
+	LicenseExceeded LicensePhase = "Exceeded"
+	// LicenseExpired means the license is expired.
+	LicenseExpired LicensePhase = "Expired"
+	// LicenseNotYetValid means the license is not valid yet.
+	LicenseNotYetValid LicensePhase = "NotYetValid"
+	// LicenseInvalid means the signature of license is missing or invalid.
+	LicenseInvalid LicensePhase = "Invalid"
+)
@@ -29,6 +29,21 @@ package v1
 // Those methods can be generated by using hack/update-generated-swagger-docs.sh
 
 // AUTO-GENERATED FUNCTIONS START HERE. DO NOT EDIT.
+var map_AddonCondition = map[string]string{
+	"":                   "AddonCondition is a lifecycle condition of an addon, the same types of conditions are reported by all addons.",
+	"type":               "Type is the type of the condition, Installed, Healthy or Upgraded.",
+	"status":             "Status is the status of the condition. Can be True, False, Unknown.",
+	"version":            "Version is the addon version which the condition is observed for, such as the target version of an upgrade.",
+	"lastProbeTime":      "Last time we probed the condition.",
+	"lastTransitionTime": "Last time the condition transitioned from one status to another.",
+	"reason":             "Unique, one-word, CamelCase reason for the condition's last transition.",
+	"message":            "Human-readable message indicating details about last transition.",
+}
+
+func (AddonCondition) SwaggerDoc() map[string]string {
+	return map_AddonCondition
+}
+
 var map_AddonSpec = map[string]string{
 	"": "AddonSpec describes the attributes on a Addon.",
 }
@@ -37,6 +52,41 @@ func (AddonSpec) SwaggerDoc() map[string]string {
 	return map_AddonSpec
 }
 
+var map_AuditLogBackend = map[string]string{
+	"":          "AuditLogBackend describes the log file and rotation of audit events.",
+	"path":      "Path is the log file path, default is /var/log/kubernetes/audit/audit.log.",
+	"maxAge":    "MaxAge is the maximum number of days to retain old log files.",
+	"maxBackup": "MaxBackup is the maximum number of old log files to retain.",
+	"maxSize":   "MaxSize is the maximum size in megabytes of log file before it gets rotated.",
+}
+
+func (AuditLogBackend) SwaggerDoc() map[string]string {
+	return map_AuditLogBackend
+}
+
+var map_AuditWebhookBackend = map[string]string{
+	"":        "AuditWebhookBackend describes where the audit events are sent to.",
+	"address": "Address is the address of audit component, default is the platform audit address.",
+}
+
+func (AuditWebhookBackend) SwaggerDoc() map[string]string {
+	return map_AuditWebhookBackend
+}
+
+var map_CRDConflict = map[string]string{
+	"":                "CRDConflict describes a custom resource definition in cluster which conflicts with the addon.",
+	"name":            "Name of the custom resource definition.",
+	"reason":          "Reason of the conflict.",
+	"message":         "Message is a human readable description of the conflict.",
+	"owner":           "Owner is the current owner of the custom resource definition, such as a helm release or another addon.",
+	"servedVersions":  "ServedVersions are the versions served by the custom resource definition.",
+	"requiredVersion": "RequiredVersion is the version required by the addon.",
+}
+
+func (CRDConflict) SwaggerDoc() map[string]string {
+	return map_CRDConflict
+}
+
 var map_CSIOperator = map[string]string{
 	"":     "CSIOperator is a operator to manages CSI external components.",
 	"spec": "Spec defines the desired identities of storage operator.",
@@ -56,8 +106,9 @@ func (CSIOperatorList) SwaggerDoc() map[string]string {
 }
 
 var map_CSIOperatorSpec = map[string]string{
-	"":        "CSIOperatorSpec describes the attributes of a storage operator.",
-	"version": "Version of the CSI operator.",
+	"":                  "CSIOperatorSpec describes the attributes of a storage operator.",
+	"version":           "Version of the CSI operator.",
+	"crdConflictPolicy": "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
 }
 
 func (CSIOperatorSpec) SwaggerDoc() map[string]string {
@@ -71,6 +122,7 @@ var map_CSIOperatorStatus = map[string]string{
 	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
 	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
 	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"crdConflicts":                "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
 }
 
 func (CSIOperatorStatus) SwaggerDoc() map[string]string {
@@ -85,6 +137,55 @@ func (CSIProxyOptions) SwaggerDoc() map[string]string {
 	return map_CSIProxyOptions
 }
 
+var map_CalicoNetwork = map[string]string{
+	"":         "CalicoNetwork describes the options of Calico.",
+	"mode":     "Mode is the routing mode of Calico, defaults to VXLAN.",
+	"asNumber": "ASNumber is the BGP AS number of nodes in BGP mode, defaults to 64512.",
+	"mtu":      "MTU of the pod interfaces, defaults to 1450 in VXLAN mode and 1500 in BGP mode.",
+}
+
+func (CalicoNetwork) SwaggerDoc() map[string]string {
+	return map_CalicoNetwork
+}
+
+var map_CertificateExpiration = map[string]string{
+	"":     "CertificateExpiration is the expiration of a certificate on a master.",
+	"name": "Name is the file name relative to the certificates dir, such as apiserver.crt, or the absolute path of the kubelet client certificate.",
+	"node": "Node is the IP of master.",
+}
+
+func (CertificateExpiration) SwaggerDoc() map[string]string {
+	return map_CertificateExpiration
+}
+
+var map_CertificateRotation = map[string]string{
+	"":                  "CertificateRotation controls the rotation of the certificates generated by kubeadm.",
+	"mode":              "Mode defaults to Auto.",
+	"renewBefore":       "RenewBefore is how long before the expiration certificates are rotated in Auto mode, defaults to 720h.",
+	"rotateRequestedAt": "RotateRequestedAt requests a rotation if it is after the last rotation.",
+}
+
+func (CertificateRotation) SwaggerDoc() map[string]string {
+	return map_CertificateRotation
+}
+
+var map_CertificatesStatus = map[string]string{
+	"": "CertificatesStatus records the expiration of the certificates on masters.",
+}
+
+func (CertificatesStatus) SwaggerDoc() map[string]string {
+	return map_CertificatesStatus
+}
+
+var map_CiliumNetwork = map[string]string{
+	"":                     "CiliumNetwork describes the options of Cilium.",
+	"kubeProxyReplacement": "KubeProxyReplacement replaces kube-proxy with the eBPF service load balancing of Cilium, kube-proxy is not installed if it is enabled.",
+}
+
+func (CiliumNetwork) SwaggerDoc() map[string]string {
+	return map_CiliumNetwork
+}
+
 var map_Cluster = map[string]string{
 	"":     "Cluster is a Kubernetes cluster in",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -139,6 +240,7 @@ var map_ClusterAddonType = map[string]string{
 	"level":         "AddonLevel is level of cluster addon.",
 	"latestVersion": "LatestVersion is latest version of the addon.",
 	"description":   "Description is desc of the addon.",
+	"dependencies":  "Dependencies are the addon types installed in the cluster before the addon, which are created automatically if missing.",
 }
 
 func (ClusterAddonType) SwaggerDoc() map[string]string {
@@ -171,6 +273,17 @@ func (ClusterApplyOptions) SwaggerDoc() map[string]string {
 	return map_ClusterApplyOptions
 }
 
+var map_ClusterAudit = map[string]string{
+	"":        "ClusterAudit describes the audit policy and backends of apiserver.",
+	"policy":  "Policy is the audit policy in yaml, the platform default policy is used if it is empty.",
+	"log":     "Log writes the audit events to local files of master nodes.",
+	"webhook": "Webhook sends the audit events to the platform audit component.",
+}
+
+func (ClusterAudit) SwaggerDoc() map[string]string {
+	return map_ClusterAudit
+}
+
 var map_ClusterComponent = map[string]string{
 	"": "ClusterComponent records the number of copies of each component of the cluster master.",
 }
@@ -202,14 +315,17 @@ func (ClusterCondition) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterCredential = map[string]string{
-	"":               "ClusterCredential records the credential information needed to access the cluster.",
-	"etcdCACert":     "For TKE in global reuse",
-	"caCert":         "For connect the cluster",
-	"clientCert":     "For kube-apiserver X509 auth",
-	"clientKey":      "For kube-apiserver X509 auth",
-	"token":          "For kube-apiserver token auth",
-	"bootstrapToken": "For kubeadm init or join",
-	"certificateKey": "For kubeadm init or join",
+	"":                          "ClusterCredential records the credential information needed to access the cluster.",
+	"etcdCACert":                "For TKE in global reuse",
+	"caCert":                    "For connect the cluster",
+	"clientCert":                "For kube-apiserver X509 auth",
+	"clientKey":                 "For kube-apiserver X509 auth",
+	"token":                     "For kube-apiserver token auth",
+	"bootstrapToken":            "For kubeadm init or join",
+	"certificateKey":            "For kubeadm init or join",
+	"endpoints":                 "Endpoints are the host:port of kube-apiservers, such as each master of cluster without VIP, which clients fail over to when the cluster address is unreachable.",
+	"etcdBackupSecretAccessKey": "For uploading etcd snapshots to the S3 compatible storage of EtcdBackup",
+	"registryPasswords":         "RegistryPasswords are the passwords of the registries in ContainerRegistries of cluster by registry, which are provided through ClusterCredentialRef.",
 }
 
 func (ClusterCredential) SwaggerDoc() map[string]string {
@@ -225,16 +341,82 @@ func (ClusterCredentialList) SwaggerDoc() map[string]string {
 	return map_ClusterCredentialList
 }
 
+var map_ClusterDNS = map[string]string{
+	"":                    "ClusterDNS customizes the Corefile of CoreDNS and the autoscaling of its replicas.",
+	"stubDomains":         "StubDomains forward the queries of the domains to their own nameservers.",
+	"upstreamNameservers": "UpstreamNameservers are the nameservers for the queries out of the cluster domain and stub domains, defaults to the /etc/resolv.conf of nodes.",
+	"cacheTTL":            "CacheTTL is the max TTL in seconds of the cached records, defaults to 30.",
+	"autoscaler":          "Autoscaler deploys dns-autoscaler which scales the replicas of CoreDNS in proportion to the nodes and cores of cluster.",
+}
+
+func (ClusterDNS) SwaggerDoc() map[string]string {
+	return map_ClusterDNS
+}
+
 var map_ClusterFeature = map[string]string{
-	"":                 "ClusterFeature records the features that are enabled by the cluster.",
-	"authzWebhookAddr": "For kube-apiserver authorization webhook",
-	"upgrade":          "Upgrade control upgrade process.",
+	"":                          "ClusterFeature records the features that are enabled by the cluster.",
+	"authzWebhookAddr":          "For kube-apiserver authorization webhook",
+	"upgrade":                   "Upgrade control upgrade process.",
+	"sandboxRuntime":            "SandboxRuntime installs a sandboxed container runtime on the labeled machines.",
+	"networkEncryption":         "NetworkEncryption encrypts the pod traffic between nodes.",
+	"audit":                     "Audit configures the audit policy and backends of apiserver.",
+	"etcdBackup":                "EtcdBackup takes etcd snapshots before control plane changes such as upgrade.",
+	"secretsEncryption":         "SecretsEncryption encrypts secrets at rest in etcd.",
+	"certificateRotation":       "CertificateRotation controls the rotation of control plane certificates, they are rotated automatically before expiration if not specified.",
+	"kubeletServerTLSBootstrap": "KubeletServerTLSBootstrap makes kubelets request serving certificates signed by the cluster CA, which are approved by platform after validating the node addresses.",
+	"cgroupDriver":              "CgroupDriver is the cgroup driver of kubelet and container runtime, systemd or cgroupfs. It defaults to systemd for new clusters, and cgroupfs if not set for compatibility.",
+	"systemTuning":              "SystemTuning is applied when nodes are created and kept enforced afterwards.",
+	"timeSync":                  "TimeSync installs and configures chrony on nodes, and blocks nodes whose clock skew exceeds the threshold from joining the cluster.",
+	"serviceOverrides":          "ServiceOverrides injects environment variables and systemd drop-in overrides into kubelet and the container runtime on all nodes. Static pods are customized with StaticPodOverrides instead.",
+	"galaxyNetwork":             "GalaxyNetwork is the underlay network of Galaxy for floating IP pods, which is rendered into the galaxy and floatingip ConfigMaps and kept reconciled.",
+	"calico":                    "Calico is the options of Calico, which is used if the NetworkType of cluster is Calico.",
+	"cilium":                    "Cilium is the options of Cilium, which is used if the NetworkType of cluster is Cilium.",
+	"imageAdmission":            "ImageAdmission deploys an admission webhook which rejects pods with images out of the approved registries.",
+	"enableNetworkCheck":        "EnableNetworkCheck verifies the node-to-node, pod-to-pod, pod-to-service and DNS connectivity with probe pods after the cluster is installed, and fails the creation if any check fails.",
+	"kubeProxy":                 "KubeProxy configures the proxy mode, IPVS scheduler and strict ARP of kube-proxy.",
+	"dns":                       "DNS manages the Corefile of CoreDNS and deploys dns-autoscaler, it is reapplied after upgrades which restore the default Corefile.",
+	"networkPolicy":             "NetworkPolicy installs default-deny NetworkPolicies for the namespaces and checks if the CNI enforces NetworkPolicy, the result is reported in status.",
+	"gpuManager":                "GPUManager configures the share policy, memory unit and nodes of GPUManager, which takes effect when GPUType is Virtual.",
+	"migStrategy":               "MIGStrategy is the MIG strategy of NVIDIA device plugin for physical GPU, none, single or mixed, defaults to none. MIG manager is deployed to reconcile the MIG geometry of GPUNodeConfigs if it is not none.",
 }
 
 func (ClusterFeature) SwaggerDoc() map[string]string {
 	return map_ClusterFeature
 }
 
+var map_ClusterHealth = map[string]string{
+	"":           "ClusterHealth is the weighted score of the health dimensions of cluster.",
+	"score":      "Score ranges from 0 to 100, the lower the riskier.",
+	"dimensions": "Dimensions are the scores of ControlPlane, Nodes, Addons, Certificates and Capacity.",
+}
+
+func (ClusterHealth) SwaggerDoc() map[string]string {
+	return map_ClusterHealth
+}
+
+var map_ClusterHealthDimension = map[string]string{
+	"":        "ClusterHealthDimension is the score of a health dimension of cluster.",
+	"score":   "Score ranges from 0 to 100.",
+	"weight":  "Weight is the percentage of the dimension in the composite score.",
+	"message": "Message describes the problems which lower the score.",
+}
+
+func (ClusterHealthDimension) SwaggerDoc() map[string]string {
+	return map_ClusterHealthDimension
+}
+
+var map_ClusterImage = map[string]string{
+	"":          "ClusterImage is an image run by a component in the kube-system namespace of cluster.",
+	"component": "Component is the workload running the image, such as deployment/coredns, daemonset/kube-proxy or pod/kube-apiserver for static pods.",
+	"container": "Container is the name of container, init containers are included.",
+	"image":     "Image is the image:tag in the pod spec.",
+	"digest":    "Digest is the repository digest reported by container runtime, which is empty before the container starts or when the runtime does not report it.",
+}
+
+func (ClusterImage) SwaggerDoc() map[string]string {
+	return map_ClusterImage
+}
+
 var map_ClusterList = map[string]string{
 	"":      "ClusterList is the whole list of all clusters which owned by a tenant.",
 	"items": "List of clusters",
@@ -244,6 +426,24 @@ func (ClusterList) SwaggerDoc() map[string]string {
 	return map_ClusterList
 }
 
+var map_ClusterLogsOptions = map[string]string{
+	"":             "ClusterLogsOptions is the query options to stream the logs of a container of cluster through the platform api.",
+	"namespace":    "Namespace is the namespace of the pod.",
+	"pod":          "Pod is the name of the pod.",
+	"container":    "The container for which to stream logs. Defaults to only container if there is one container in the pod.",
+	"follow":       "Follow the log stream of the pod. Defaults to false.",
+	"previous":     "Return previous terminated container logs. Defaults to false.",
+	"sinceSeconds": "A relative time in seconds before the current time from which to show logs. Only one of sinceSeconds or sinceTime may be specified.",
+	"sinceTime":    "An RFC3339 timestamp from which to show logs. Only one of sinceSeconds or sinceTime may be specified.",
+	"timestamps":   "If true, add an RFC3339 timestamp at the beginning of every line of log output. Defaults to false.",
+	"tailLines":    "If set, the number of lines from the end of the logs to show, which is limited by the platform api.",
+	"limitBytes":   "If set, the number of bytes to read from the server before terminating the log output, which is limited by the platform api.",
+}
+
+func (ClusterLogsOptions) SwaggerDoc() map[string]string {
+	return map_ClusterLogsOptions
+}
+
 var map_ClusterMachine = map[string]string{
 	"":       "ClusterMachine is the master machine definition of cluster.",
 	"taints": "If specified, the node's taints.",
@@ -253,6 +453,52 @@ func (ClusterMachine) SwaggerDoc() map[string]string {
 	return map_ClusterMachine
 }
 
+var map_ClusterOperation = map[string]string{
+	"":     "ClusterOperation is a mutation of the resources in a cluster which is queued while the cluster is unreachable, it is applied once the cluster is reachable again or expired.",
+	"spec": "Spec defines the request of ClusterOperation.",
+}
+
+func (ClusterOperation) SwaggerDoc() map[string]string {
+	return map_ClusterOperation
+}
+
+var map_ClusterOperationList = map[string]string{
+	"":      "ClusterOperationList is the whole list of all ClusterOperations which owned by a tenant.",
+	"items": "List of ClusterOperations",
+}
+
+func (ClusterOperationList) SwaggerDoc() map[string]string {
+	return map_ClusterOperationList
+}
+
+var map_ClusterOperationSpec = map[string]string{
+	"":            "ClusterOperationSpec describes the request of a ClusterOperation.",
+	"verb":        "Verb is the HTTP method of the request, POST, PUT, PATCH or DELETE.",
+	"path":        "Path is the request URI on the kube-apiserver of cluster with the query.",
+	"contentType": "ContentType is the content type of body.",
+	"body":        "Body is the request body.",
+	"username":    "Username is the user who submitted the operation, the operation is applied as the user.",
+	"groups":      "Groups are the groups of the user.",
+	"expireTime":  "ExpireTime is the time after which the operation is expired if the cluster is still unreachable.",
+}
+
+func (ClusterOperationSpec) SwaggerDoc() map[string]string {
+	return map_ClusterOperationSpec
+}
+
+var map_ClusterOperationStatus = map[string]string{
+	"":                   "ClusterOperationStatus is information about the current status of a ClusterOperation.",
+	"attempts":           "Attempts is the number of times the operation is sent to the cluster.",
+	"lastAttemptTime":    "The last time the operation is sent to the cluster.",
+	"code":               "Code is the HTTP status code returned by the cluster.",
+	"message":            "A human readable message indicating details about the last attempt.",
+	"lastTransitionTime": "The last time the phase transitioned from one to another.",
+}
+
+func (ClusterOperationStatus) SwaggerDoc() map[string]string {
+	return map_ClusterOperationStatus
+}
+
 var map_ClusterProperty = map[string]string{
 	"": "ClusterProperty records the attribute information of the cluster.",
 }
@@ -272,13 +518,15 @@ func (ClusterResource) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterSpec = map[string]string{
-	"":                     "ClusterSpec is a description of a cluster.",
-	"finalizers":           "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-	"serviceCIDR":          "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
-	"dnsDomain":            "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
-	"clusterCredentialRef": "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
-	"etcd":                 "Etcd holds configuration for etcd.",
-	"hostnameAsNodename":   "If true will use hostname as nodename, if false will use machine IP as nodename.",
+	"":                      "ClusterSpec is a description of a cluster.",
+	"finalizers":            "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"serviceCIDR":           "ServiceCIDR is used to set a separated CIDR for k8s service, it's exclusive with MaxClusterServiceNum.",
+	"dnsDomain":             "DNSDomain is the dns domain used by k8s services. Defaults to \"cluster.local\".",
+	"clusterCredentialRef":  "ClusterCredentialRef for isolate sensitive information. If not specified, cluster controller will create one; If specified, provider must make sure is valid.",
+	"etcd":                  "Etcd holds configuration for etcd.",
+	"hostnameAsNodename":    "If true will use hostname as nodename, if false will use machine IP as nodename.",
+	"controlPlaneOverrides": "ControlPlaneOverrides injects extra volumes, envs and sidecars into the static pod manifests of control plane components.",
+	"containerRegistries":   "ContainerRegistries configures the registry mirrors, insecure registries and registry credentials of the container runtime on all nodes.",
 }
 
 func (ClusterSpec) SwaggerDoc() map[string]string {
@@ -286,10 +534,18 @@ func (ClusterSpec) SwaggerDoc() map[string]string {
 }
 
 var map_ClusterStatus = map[string]string{
-	"":          "ClusterStatus represents information about the status of a cluster.",
-	"message":   "A human readable message indicating details about why the cluster is in this condition.",
-	"reason":    "A brief CamelCase message indicating details about why the cluster is in this state.",
-	"addresses": "List of addresses reachable to the cluster.",
+	"":                "ClusterStatus represents information about the status of a cluster.",
+	"message":         "A human readable message indicating details about why the cluster is in this condition.",
+	"reason":          "A brief CamelCase message indicating details about why the cluster is in this state.",
+	"addresses":       "List of addresses reachable to the cluster.",
+	"progress":        "Progress records the phases progress of the current operation.",
+	"etcdSnapshot":    "EtcdSnapshot records the latest etcd snapshot taken before control plane changes.",
+	"certificates":    "Certificates records the expiration of control plane certificates.",
+	"etcdMaintenance": "EtcdMaintenance records the latest compaction and defragmentation of local etcd.",
+	"upgradeCanary":   "UpgradeCanary records the canary master of the upgrade in progress.",
+	"health":          "Health is the composite health score of cluster, which is used to sort clusters by risk.",
+	"images":          "Images lists the images running in kube-system, which is refreshed by the health check of cluster.",
+	"networkPolicy":   "NetworkPolicy reports whether the CNI enforces NetworkPolicy.",
 }
 
 func (ClusterStatus) SwaggerDoc() map[string]string {
@@ -315,6 +571,26 @@ func (ConfigMapList) SwaggerDoc() map[string]string {
 	return map_ConfigMapList
 }
 
+var map_ContainerRegistryConfig = map[string]string{
+	"":                   "ContainerRegistryConfig describes the registries used by the container runtime of nodes.",
+	"mirrors":            "Mirrors are the registry mirror endpoints, such as https://mirror.example.com.",
+	"insecureRegistries": "InsecureRegistries are pulled over http or without verifying certificates.",
+	"auths":              "Auths are the credentials used to pull images from private registries.",
+}
+
+func (ContainerRegistryConfig) SwaggerDoc() map[string]string {
+	return map_ContainerRegistryConfig
+}
+
+var map_ControlPlaneOverrides = map[string]string{
+	"":     "ControlPlaneOverrides customizes the static pod manifests of control plane components.",
+	"etcd": "Etcd is ignored if the etcd is external.",
+}
+
+func (ControlPlaneOverrides) SwaggerDoc() map[string]string {
+	return map_ControlPlaneOverrides
+}
+
 var map_CronHPA = map[string]string{
 	"":     "CronHPA is a new kubernetes workload.",
 	"spec": "Spec defines the desired identities of CronHPA.",
@@ -342,7 +618,10 @@ func (CronHPAProxyOptions) SwaggerDoc() map[string]string {
 }
 
 var map_CronHPASpec = map[string]string{
-	"": "CronHPASpec describes the attributes on a CronHPA.",
+	"":                  "CronHPASpec describes the attributes on a CronHPA.",
+	"crdConflictPolicy": "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
+	"driftPolicy":       "DriftPolicy defines how to handle the components in cluster which drift from the desired state, defaults to Repair.",
+	"timeZone":          "TimeZone is the IANA time zone which the schedules of CronHPAs run in, such as Asia/Shanghai, defaults to the local time of cron-hpa-controller.",
 }
 
 func (CronHPASpec) SwaggerDoc() map[string]string {
@@ -355,12 +634,152 @@ var map_CronHPAStatus = map[string]string{
 	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
 	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
 	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"crdConflicts":                "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
+	"conditions":                  "Conditions are the lifecycle conditions of the addon.",
 }
 
 func (CronHPAStatus) SwaggerDoc() map[string]string {
 	return map_CronHPAStatus
 }
 
+var map_DNSAutoscaler = map[string]string{
+	"":                          "DNSAutoscaler describes the linear parameters of dns-autoscaler, the replicas are max(ceil(cores / coresPerReplica), ceil(nodes / nodesPerReplica)) bounded by min and max.",
+	"coresPerReplica":           "CoresPerReplica defaults to 256.",
+	"nodesPerReplica":           "NodesPerReplica defaults to 16.",
+	"min":                       "Min is the minimum replicas, defaults to 1.",
+	"max":                       "Max is the maximum replicas, unlimited if zero.",
+	"preventSinglePointFailure": "PreventSinglePointFailure keeps at least 2 replicas if the cluster has more than one node.",
+}
+
+func (DNSAutoscaler) SwaggerDoc() map[string]string {
+	return map_DNSAutoscaler
+}
+
+var map_DNSStubDomain = map[string]string{
+	"":            "DNSStubDomain is a domain resolved by its own nameservers.",
+	"domain":      "Domain is the zone to forward, such as example.com.",
+	"nameservers": "Nameservers are the IP addresses with optional ports of the nameservers.",
+}
+
+func (DNSStubDomain) SwaggerDoc() map[string]string {
+	return map_DNSStubDomain
+}
+
+var map_DevicePlugin = map[string]string{
+	"":     "DevicePlugin deploys the Kubernetes device plugin of a device class, such as RDMA, FPGA and QAT, and labels the nodes advertising the devices.",
+	"spec": "Spec defines the desired identities of DevicePlugin.",
+}
+
+func (DevicePlugin) SwaggerDoc() map[string]string {
+	return map_DevicePlugin
+}
+
+var map_DevicePluginList = map[string]string{
+	"":      "DevicePluginList is the whole list of all DevicePlugins which owned by a tenant.",
+	"items": "List of DevicePlugins",
+}
+
+func (DevicePluginList) SwaggerDoc() map[string]string {
+	return map_DevicePluginList
+}
+
+var map_DevicePluginSpec = map[string]string{
+	"":               "DevicePluginSpec describes the attributes on a DevicePlugin.",
+	"deviceClass":    "DeviceClass is the class of devices, the built-in classes rdma, fpga and qat have default image, resource prefix and host paths, other classes must specify the image and resource prefix.",
+	"image":          "Image of the device plugin, overrides the default image of the built-in class. The image without registry is pulled from the registry of platform.",
+	"mirrorImage":    "MirrorImage copies the image to the registry of platform before deploying, then the nodes pull the image without accessing the source registry.",
+	"resourcePrefix": "ResourcePrefix is the prefix of the extended resources advertised by the device plugin, such as rdma/ and qat.intel.com/, which is used to label the nodes with the devices.",
+	"args":           "Args overrides the default arguments of the device plugin.",
+	"config":         "Config is the content of the config file of the device plugin, which is mounted at ConfigPath.",
+	"configPath":     "ConfigPath is the path of the config file in the container, defaults to the path of the built-in class.",
+	"hostPaths":      "HostPaths are mounted into the device plugin along with the default host paths of the built-in class and the device plugin directory of kubelet.",
+	"nodeSelector":   "NodeSelector selects the nodes to deploy the device plugin, all nodes if not specified.",
+}
+
+func (DevicePluginSpec) SwaggerDoc() map[string]string {
+	return map_DevicePluginSpec
+}
+
+var map_DevicePluginStatus = map[string]string{
+	"":                            "DevicePluginStatus is information about the current status of a DevicePlugin.",
+	"phase":                       "Phase is the current lifecycle phase of the DevicePlugin of cluster.",
+	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
+	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"image":                       "Image is the image deployed, which is the mirrored image if MirrorImage is set.",
+	"nodes":                       "Nodes are the nodes advertising the devices, which are labeled with device.platform.tkestack.io/<class>=true.",
+	"conditions":                  "Conditions are the lifecycle conditions of the addon.",
+}
+
+func (DevicePluginStatus) SwaggerDoc() map[string]string {
+	return map_DevicePluginStatus
+}
+
+var map_EgressGateway = map[string]string{
+	"":     "EgressGateway is a managed egress gateway which lets the workloads present stable source IPs to the outside of cluster.",
+	"spec": "Spec defines the desired identities of EgressGateway.",
+}
+
+func (EgressGateway) SwaggerDoc() map[string]string {
+	return map_EgressGateway
+}
+
+var map_EgressGatewayList = map[string]string{
+	"":      "EgressGatewayList is the whole list of all EgressGateways which owned by a tenant.",
+	"items": "List of EgressGateways",
+}
+
+func (EgressGatewayList) SwaggerDoc() map[string]string {
+	return map_EgressGatewayList
+}
+
+var map_EgressGatewaySpec = map[string]string{
+	"":                "EgressGatewaySpec describes the attributes on a EgressGateway.",
+	"ipPools":         "IPPools are the static egress IPs and the namespaces using them.",
+	"failoverTimeout": "FailoverTimeout is how long a gateway node is not ready before its egress IPs are moved to the other gateway nodes of the pool, which is 40s by default.",
+}
+
+func (EgressGatewaySpec) SwaggerDoc() map[string]string {
+	return map_EgressGatewaySpec
+}
+
+var map_EgressGatewayStatus = map[string]string{
+	"":                            "EgressGatewayStatus is information about the current status of a EgressGateway.",
+	"phase":                       "Phase is the current lifecycle phase of the EgressGateway of cluster.",
+	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
+	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"assignments":                 "Assignments are the gateway nodes holding the egress IPs.",
+}
+
+func (EgressGatewayStatus) SwaggerDoc() map[string]string {
+	return map_EgressGatewayStatus
+}
+
+var map_EgressIPAssignment = map[string]string{
+	"":                   "EgressIPAssignment records the gateway node which holds an egress IP.",
+	"pool":               "Pool is the name of the pool the IP belongs to.",
+	"node":               "Node is the gateway node holding the IP, which is empty if no gateway node of the pool is ready.",
+	"lastTransitionTime": "The last time the IP moved from one node to another.",
+}
+
+func (EgressIPAssignment) SwaggerDoc() map[string]string {
+	return map_EgressIPAssignment
+}
+
+var map_EgressIPPool = map[string]string{
+	"":              "EgressIPPool binds a set of static egress IPs to namespaces.",
+	"ips":           "IPs are the source IPs which the traffic leaving the cluster from the namespaces is translated to. They are assigned to the gateway nodes.",
+	"namespaces":    "Namespaces are the namespaces whose egress traffic uses the pool.",
+	"projects":      "Projects are the projects whose namespaces use the pool.",
+	"nodeSelector":  "NodeSelector selects the gateway nodes which hold the egress IPs.",
+	"allNamespaces": "AllNamespaces makes the pool the default of the namespaces not using other pools, so the egress traffic of all pods goes through the gateway nodes of it. Only one pool can be the default.",
+}
+
+func (EgressIPPool) SwaggerDoc() map[string]string {
+	return map_EgressIPPool
+}
+
 var map_Etcd = map[string]string{
 	"":         "Etcd contains elements describing Etcd configuration.",
 	"local":    "Local provides configuration knobs for configuring the local etcd instance Local and External are mutually exclusive",
@@ -371,6 +790,47 @@ func (Etcd) SwaggerDoc() map[string]string {
 	return map_Etcd
 }
 
+var map_EtcdBackup = map[string]string{
+	"":     "EtcdBackup describes where the etcd snapshots are saved before control plane changes.",
+	"path": "Path is the directory on the first master to save snapshots, default is /var/lib/etcd-backup.",
+	"s3":   "S3 uploads the snapshots to S3 compatible storage such as COS.",
+}
+
+func (EtcdBackup) SwaggerDoc() map[string]string {
+	return map_EtcdBackup
+}
+
+var map_EtcdMaintenance = map[string]string{
+	"":               "EtcdMaintenance describes the compaction and defragmentation of local etcd.",
+	"interval":       "Interval is the duration between two maintenances, defaults to 168h.",
+	"runRequestedAt": "RunRequestedAt requests a maintenance if it is after the last maintenance.",
+}
+
+func (EtcdMaintenance) SwaggerDoc() map[string]string {
+	return map_EtcdMaintenance
+}
+
+var map_EtcdMaintenanceStatus = map[string]string{
+	"":                  "EtcdMaintenanceStatus records the latest maintenance of local etcd.",
+	"lastRunTime":       "LastRunTime is the time when the latest maintenance finished.",
+	"compactedRevision": "CompactedRevision is the revision which etcd is compacted to.",
+}
+
+func (EtcdMaintenanceStatus) SwaggerDoc() map[string]string {
+	return map_EtcdMaintenanceStatus
+}
+
+var map_EtcdSnapshot = map[string]string{
+	"":         "EtcdSnapshot records an etcd snapshot which can be used to restore the cluster.",
+	"location": "Location is the path on master or the url of object storage.",
+	"reason":   "Reason is the control plane change which the snapshot is taken for.",
+	"version":  "Version is the kubernetes version of the cluster when the snapshot is taken.",
+}
+
+func (EtcdSnapshot) SwaggerDoc() map[string]string {
+	return map_EtcdSnapshot
+}
+
 var map_ExternalEtcd = map[string]string{
 	"":          "ExternalEtcd describes an external etcd cluster. Kubeadm has no knowledge of where certificate files live and they must be supplied.",
 	"endpoints": "Endpoints of etcd members. Required for ExternalEtcd.",
@@ -383,6 +843,153 @@ func (ExternalEtcd) SwaggerDoc() map[string]string {
 	return map_ExternalEtcd
 }
 
+var map_FloatingIPPool = map[string]string{
+	"":           "FloatingIPPool describes the floating IPs of a subnet.",
+	"subnet":     "Subnet is the CIDR of the floating IPs, such as 10.0.0.0/24.",
+	"nodeSubnet": "NodeSubnet is the CIDR of the nodes which can use the pool, it defaults to Subnet.",
+	"gateway":    "Gateway is the gateway of Subnet.",
+	"vlan":       "VLAN is the VLAN ID of Subnet, 0 means untagged.",
+	"ips":        "IPs are the IPs or IP ranges of the pool, such as 10.0.0.10 or 10.0.0.20~10.0.0.100.",
+}
+
+func (FloatingIPPool) SwaggerDoc() map[string]string {
+	return map_FloatingIPPool
+}
+
+var map_FloatingIPReservation = map[string]string{
+	"":     "FloatingIPReservation reserves a floating IP of the galaxy-ipam addon for a workload ahead of time.",
+	"spec": "Spec defines the IP and the workload of FloatingIPReservation.",
+}
+
+func (FloatingIPReservation) SwaggerDoc() map[string]string {
+	return map_FloatingIPReservation
+}
+
+var map_FloatingIPReservationList = map[string]string{
+	"":      "FloatingIPReservationList is the whole list of all FloatingIPReservations which owned by a tenant.",
+	"items": "List of FloatingIPReservations",
+}
+
+func (FloatingIPReservationList) SwaggerDoc() map[string]string {
+	return map_FloatingIPReservationList
+}
+
+var map_FloatingIPReservationSpec = map[string]string{
+	"":             "FloatingIPReservationSpec describes the attributes on a FloatingIPReservation.",
+	"ip":           "IP is the floating IP to reserve, which must be in the floating IP pools of cluster.",
+	"namespace":    "Namespace is the namespace of the workload.",
+	"workloadKind": "WorkloadKind is the kind of the workload, Deployment, StatefulSet or TApp.",
+	"workloadName": "WorkloadName is the name of the workload.",
+	"podName":      "PodName is the pod of StatefulSet or TApp using the IP, which is required since the pods of them keep their own IPs. The IP is shared by the pods of Deployment.",
+}
+
+func (FloatingIPReservationSpec) SwaggerDoc() map[string]string {
+	return map_FloatingIPReservationSpec
+}
+
+var map_FloatingIPReservationStatus = map[string]string{
+	"":                   "FloatingIPReservationStatus is information about the current status of a FloatingIPReservation.",
+	"reason":             "Reason is a brief CamelCase string that describes any failure.",
+	"subnet":             "Subnet is the subnet of the floating IP pool which the IP belongs to.",
+	"lastTransitionTime": "The last time the phase transitioned from one to another.",
+}
+
+func (FloatingIPReservationStatus) SwaggerDoc() map[string]string {
+	return map_FloatingIPReservationStatus
+}
+
+var map_GPUMIGDeviceConfig = map[string]string{
+	"":        "GPUMIGDeviceConfig partitions the selected GPUs of a node with the same MIG slices.",
+	"devices": "Devices are the indexes of the GPUs, all the GPUs of the node if empty.",
+	"slices":  "Slices are the MIG devices created on each of the GPUs, MIG is disabled on the GPUs if empty.",
+}
+
+func (GPUMIGDeviceConfig) SwaggerDoc() map[string]string {
+	return map_GPUMIGDeviceConfig
+}
+
+var map_GPUMIGProfile = map[string]string{
+	"":             "GPUMIGProfile is the MIG devices of a profile advertised by a node.",
+	"profile":      "Profile is the MIG profile, such as 1g.5gb.",
+	"resourceName": "ResourceName is the extended resource requested by pods for the profile, such as nvidia.com/mig-1g.5gb.",
+	"capacity":     "Capacity is the number of MIG devices of the profile.",
+	"allocatable":  "Allocatable is the number of MIG devices of the profile available for pods.",
+}
+
+func (GPUMIGProfile) SwaggerDoc() map[string]string {
+	return map_GPUMIGProfile
+}
+
+var map_GPUMIGSlice = map[string]string{
+	"":        "GPUMIGSlice is a number of MIG devices with the same profile.",
+	"profile": "Profile is the MIG profile, such as 1g.5gb and 3g.20gb.",
+	"count":   "Count is the number of MIG devices created on each GPU.",
+}
+
+func (GPUMIGSlice) SwaggerDoc() map[string]string {
+	return map_GPUMIGSlice
+}
+
+var map_GPUManagerFeature = map[string]string{
+	"":              "GPUManagerFeature configures the vGPU scheduling of GPUManager deployed for the clusters with virtual GPU.",
+	"sharePolicy":   "SharePolicy is Fractional or Exclusive, defaults to Fractional.",
+	"memoryUnitMiB": "MemoryUnitMiB is the size in MiB of one unit of tencent.com/vcuda-memory, defaults to 256.",
+	"nodeSelector":  "NodeSelector selects the GPU nodes to run GPUManager in addition to the nodes with GPU driver installed.",
+}
+
+func (GPUManagerFeature) SwaggerDoc() map[string]string {
+	return map_GPUManagerFeature
+}
+
+var map_GPUNodeConfig = map[string]string{
+	"":     "GPUNodeConfig declares the MIG geometry of the GPUs of a node, which is reconciled by NVIDIA MIG manager.",
+	"spec": "Spec defines the node and the MIG geometry of its GPUs.",
+}
+
+func (GPUNodeConfig) SwaggerDoc() map[string]string {
+	return map_GPUNodeConfig
+}
+
+var map_GPUNodeConfigList = map[string]string{
+	"":      "GPUNodeConfigList is the whole list of all GPUNodeConfigs which owned by a tenant.",
+	"items": "List of GPUNodeConfigs",
+}
+
+func (GPUNodeConfigList) SwaggerDoc() map[string]string {
+	return map_GPUNodeConfigList
+}
+
+var map_GPUNodeConfigSpec = map[string]string{
+	"":           "GPUNodeConfigSpec describes the attributes on a GPUNodeConfig.",
+	"nodeName":   "NodeName is the node with MIG capable GPUs, such as A100 and A30.",
+	"migDevices": "MIGDevices partitions the GPUs into MIG devices, MIG is disabled on all the GPUs of the node if empty.",
+}
+
+func (GPUNodeConfigSpec) SwaggerDoc() map[string]string {
+	return map_GPUNodeConfigSpec
+}
+
+var map_GPUNodeConfigStatus = map[string]string{
+	"":                   "GPUNodeConfigStatus is information about the current status of a GPUNodeConfig.",
+	"reason":             "Reason is a brief CamelCase string that describes any failure.",
+	"profiles":           "Profiles are the MIG profiles discovered on the node.",
+	"lastTransitionTime": "The last time the phase transitioned from one to another.",
+}
+
+func (GPUNodeConfigStatus) SwaggerDoc() map[string]string {
+	return map_GPUNodeConfigStatus
+}
+
+var map_GalaxyNetwork = map[string]string{
+	"":                "GalaxyNetwork describes the underlay network of Galaxy.",
+	"device":          "Device is the host interface of the underlay network, which may be a bond interface such as bond0. It defaults to the NetworkDevice of cluster.",
+	"floatingIPPools": "FloatingIPPools are the IP pools allocated to floating IP pods.",
+}
+
+func (GalaxyNetwork) SwaggerDoc() map[string]string {
+	return map_GalaxyNetwork
+}
+
 var map_Helm = map[string]string{
 	"":     "Helm is a kubernetes package manager.",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -477,6 +1084,51 @@ func (IPAMStatus) SwaggerDoc() map[string]string {
 	return map_IPAMStatus
 }
 
+var map_ImageAdmission = map[string]string{
+	"":                  "ImageAdmission restricts the images of pods in cluster to approved registries.",
+	"mode":              "Mode of the webhook, defaults to Enforce.",
+	"allowedRegistries": "AllowedRegistries are the image prefixes allowed in all namespaces, such as registry.example.com/ or docker.io/library/.",
+	"projects":          "Projects are the additional image prefixes allowed in namespaces of the projects.",
+	"exemptNamespaces":  "ExemptNamespaces are the namespaces not checked by the webhook.",
+}
+
+func (ImageAdmission) SwaggerDoc() map[string]string {
+	return map_ImageAdmission
+}
+
+var map_KMSPlugin = map[string]string{
+	"":          "KMSPlugin describes the KMS plugin which apiserver calls to encrypt data keys.",
+	"endpoint":  "Endpoint is the unix socket of the KMS plugin, such as unix:///var/run/kmsplugin/socket.sock.",
+	"cacheSize": "CacheSize is the number of data encryption keys cached in memory.",
+}
+
+func (KMSPlugin) SwaggerDoc() map[string]string {
+	return map_KMSPlugin
+}
+
+var map_KubeProxy = map[string]string{
+	"":              "KubeProxy describes the options of kube-proxy.",
+	"mode":          "Mode of kube-proxy, defaults to ipvs if IPVS feature is enabled, otherwise iptables.",
+	"ipvsScheduler": "IPVSScheduler is the scheduler of IPVS virtual servers, such as rr, wrr, lc, wlc, sh and dh, defaults to rr. Only used in ipvs mode.",
+	"strictARP":     "StrictARP makes nodes not answer the ARP queries for the addresses on kube-ipvs0, which is required by MetalLB in layer 2 mode. Only used in ipvs mode.",
+}
+
+func (KubeProxy) SwaggerDoc() map[string]string {
+	return map_KubeProxy
+}
+
+var map_KubeletConfigurationOverrides = map[string]string{
+	"":                      "KubeletConfigurationOverrides overrides the fields of kubelet configuration rendered for all nodes of cluster.",
+	"maxPods":               "MaxPods is the number of pods that can run on the node.",
+	"evictionHard":          "EvictionHard is a map of signal names to quantities that defines hard eviction thresholds. For example: {\"memory.available\": \"300Mi\"}.",
+	"reservedCPUs":          "ReservedCPUs is the cpu list reserved for the system and kubernetes components. For example: \"0-1\" or \"0,4\".",
+	"topologyManagerPolicy": "TopologyManagerPolicy is the policy of topology manager, one of none, best-effort, restricted and single-numa-node.",
+}
+
+func (KubeletConfigurationOverrides) SwaggerDoc() map[string]string {
+	return map_KubeletConfigurationOverrides
+}
+
 var map_LBCF = map[string]string{
 	"":     "LBCF is a kubernetes load balancer manager.",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -486,6 +1138,19 @@ func (LBCF) SwaggerDoc() map[string]string {
 	return map_LBCF
 }
 
+var map_LBCFHealthCheck = map[string]string{
+	"":                   "LBCFHealthCheck is the default health check of the backends registered to load balancers by LBCF drivers.",
+	"path":               "Path is the HTTP path to probe, TCP is probed if empty.",
+	"intervalSeconds":    "IntervalSeconds is the interval between probes, defaults to 5.",
+	"timeoutSeconds":     "TimeoutSeconds is the timeout of a probe, defaults to 2.",
+	"healthyThreshold":   "HealthyThreshold is the consecutive successes for a backend to be healthy, defaults to 3.",
+	"unhealthyThreshold": "UnhealthyThreshold is the consecutive failures for a backend to be unhealthy, defaults to 3.",
+}
+
+func (LBCFHealthCheck) SwaggerDoc() map[string]string {
+	return map_LBCFHealthCheck
+}
+
 var map_LBCFList = map[string]string{
 	"":      "LBCFList is the whole list of all helms which owned by a tenant.",
 	"items": "List of LBCFs",
@@ -504,7 +1169,9 @@ func (LBCFProxyOptions) SwaggerDoc() map[string]string {
 }
 
 var map_LBCFSpec = map[string]string{
-	"": "LBCFSpec describes the attributes on a Helm.",
+	"":                      "LBCFSpec describes the attributes on a Helm.",
+	"healthCheck":           "HealthCheck is the default health check of backends, which is published to the drivers in the lbcf-driver-config ConfigMap of kube-system.",
+	"webhookTimeoutSeconds": "WebhookTimeoutSeconds is the default timeout of the webhooks of drivers, between 1 and 60, defaults to 10.",
 }
 
 func (LBCFSpec) SwaggerDoc() map[string]string {
@@ -523,12 +1190,72 @@ func (LBCFStatus) SwaggerDoc() map[string]string {
 	return map_LBCFStatus
 }
 
+var map_License = map[string]string{
+	"":     "License records the entitlements of the installation, and the usage of them is reported in the status.",
+	"spec": "Spec defines the entitlements of License.",
+}
+
+func (License) SwaggerDoc() map[string]string {
+	return map_License
+}
+
+var map_LicenseList = map[string]string{
+	"":      "LicenseList is the whole list of all Licenses.",
+	"items": "List of Licenses",
+}
+
+func (LicenseList) SwaggerDoc() map[string]string {
+	return map_LicenseList
+}
+
+var map_LicenseNotify = map[string]string{
+	"": "LicenseNotify describes the channel, template and receivers of the notify messages about license.",
+}
+
+func (LicenseNotify) SwaggerDoc() map[string]string {
+	return map_LicenseNotify
+}
+
+var map_LicenseSpec = map[string]string{
+	"":               "LicenseSpec describes the entitlements of a License.",
+	"licensee":       "Licensee is the customer who the license is issued to.",
+	"maxNodes":       "MaxNodes is the max number of nodes in all clusters, 0 means unlimited.",
+	"maxClusters":    "MaxClusters is the max number of clusters, 0 means unlimited.",
+	"features":       "Features are the entitled features, empty means all features.",
+	"notBefore":      "NotBefore is the time from which the license is valid, zero means valid immediately.",
+	"notAfter":       "NotAfter is the expiration time of license, zero means never expires.",
+	"warningPercent": "WarningPercent is the usage percentage of entitlements from which warnings are sent. Defaults to 90.",
+	"notify":         "Notify describes where the warnings are sent, no warnings are sent if not specified.",
+	"signature":      "Signature is the base64 encoded Ed25519 signature of the entitlements, which is signed by the issuer over the JSON object of licensee, maxNodes, maxClusters, features, notBefore and notAfter, and is verified with the public key of the issuer the platform controller is configured with.",
+}
+
+func (LicenseSpec) SwaggerDoc() map[string]string {
+	return map_LicenseSpec
+}
+
+var map_LicenseStatus = map[string]string{
+	"":                "LicenseStatus is information about the usage of entitlements of a License.",
+	"nodes":           "Nodes is the number of nodes in all clusters.",
+	"clusters":        "Clusters is the number of clusters.",
+	"message":         "Message describes the usage exceeding or approaching the entitlements.",
+	"lastWarningTime": "LastWarningTime is the time when the last warning was sent.",
+}
+
+func (LicenseStatus) SwaggerDoc() map[string]string {
+	return map_LicenseStatus
+}
+
 var map_LocalEtcd = map[string]string{
-	"":               "LocalEtcd describes that kubeadm should run an etcd cluster locally",
-	"dataDir":        "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
-	"extraArgs":      "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
-	"serverCertSANs": "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
-	"peerCertSANs":   "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
+	"":                  "LocalEtcd describes that kubeadm should run an etcd cluster locally",
+	"dataDir":           "DataDir is the directory etcd will place its data. Defaults to \"/var/lib/etcd\".",
+	"extraArgs":         "ExtraArgs are extra arguments provided to the etcd binary when run inside a static pod.",
+	"serverCertSANs":    "ServerCertSANs sets extra Subject Alternative Names for the etcd server signing cert.",
+	"peerCertSANs":      "PeerCertSANs sets extra Subject Alternative Names for the etcd peer signing cert.",
+	"quotaBackendBytes": "QuotaBackendBytes is the backend storage size limit of etcd in bytes, etcd raises a NOSPACE alarm when it is exceeded. Only takes effect when creating the cluster.",
+	"heartbeatInterval": "HeartbeatInterval is the time in milliseconds of a heartbeat interval. Only takes effect when creating the cluster.",
+	"electionTimeout":   "ElectionTimeout is the time in milliseconds for an election to timeout. Only takes effect when creating the cluster.",
+	"dataDevice":        "DataDevice is a dedicated block device of masters such as /dev/vdb, which is formatted and mounted to DataDir before etcd starts.",
+	"maintenance":       "Maintenance compacts and defragments etcd periodically.",
 }
 
 func (LocalEtcd) SwaggerDoc() map[string]string {
@@ -624,9 +1351,11 @@ func (MachineList) SwaggerDoc() map[string]string {
 }
 
 var map_MachineSpec = map[string]string{
-	"":           "MachineSpec is a description of machine.",
-	"finalizers": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
-	"taints":     "If specified, the node's taints.",
+	"":                     "MachineSpec is a description of machine.",
+	"finalizers":           "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.",
+	"taints":               "If specified, the node's taints.",
+	"kubeletConfiguration": "KubeletConfiguration overrides the kubelet configuration of cluster on the machine.",
+	"serviceOverrides":     "ServiceOverrides is merged over the service overrides of cluster on the machine.",
 }
 
 func (MachineSpec) SwaggerDoc() map[string]string {
@@ -634,11 +1363,13 @@ func (MachineSpec) SwaggerDoc() map[string]string {
 }
 
 var map_MachineStatus = map[string]string{
-	"":            "MachineStatus represents information about the status of an machine.",
-	"message":     "A human readable message indicating details about why the machine is in this condition.",
-	"reason":      "A brief CamelCase message indicating details about why the machine is in this state.",
-	"addresses":   "List of addresses reachable to the machine.",
-	"machineInfo": "Set of ids/uuids to uniquely identify the node.",
+	"":              "MachineStatus represents information about the status of an machine.",
+	"message":       "A human readable message indicating details about why the machine is in this condition.",
+	"reason":        "A brief CamelCase message indicating details about why the machine is in this state.",
+	"addresses":     "List of addresses reachable to the machine.",
+	"machineInfo":   "Set of ids/uuids to uniquely identify the node.",
+	"progress":      "Progress records the phases progress of the current operation.",
+	"upgradeBackup": "UpgradeBackup records the binaries and configurations of the machine before upgrade, which the machine can be rolled back to.",
 }
 
 func (MachineStatus) SwaggerDoc() map[string]string {
@@ -663,6 +1394,203 @@ func (MachineSystemInfo) SwaggerDoc() map[string]string {
 	return map_MachineSystemInfo
 }
 
+var map_MachineUpgradeBackup = map[string]string{
+	"":              "MachineUpgradeBackup describes the files saved on machine before upgrade.",
+	"version":       "Version is the kubelet version before upgrade.",
+	"targetVersion": "TargetVersion is the version which the machine is upgraded to.",
+	"dir":           "Dir is the directory on machine where the files are saved.",
+	"files":         "Files are the paths of saved files.",
+}
+
+func (MachineUpgradeBackup) SwaggerDoc() map[string]string {
+	return map_MachineUpgradeBackup
+}
+
+var map_MetalLB = map[string]string{
+	"":     "MetalLB is a load balancer for bare metal clusters, which assigns the addresses of Services of type LoadBalancer and announces them by layer 2 or BGP.",
+	"spec": "Spec defines the desired identities of MetalLB.",
+}
+
+func (MetalLB) SwaggerDoc() map[string]string {
+	return map_MetalLB
+}
+
+var map_MetalLBAddressPool = map[string]string{
+	"":           "MetalLBAddressPool is a pool of addresses announced by the same protocol.",
+	"protocol":   "Protocol announcing the addresses, layer2 or bgp.",
+	"addresses":  "Addresses are the CIDRs or the ranges like 192.168.1.100-192.168.1.200.",
+	"autoAssign": "AutoAssign assigns the addresses of pool to Services automatically, defaults to true. The addresses are only assigned on request if it is false.",
+}
+
+func (MetalLBAddressPool) SwaggerDoc() map[string]string {
+	return map_MetalLBAddressPool
+}
+
+var map_MetalLBList = map[string]string{
+	"":      "MetalLBList is the whole list of all MetalLBs which owned by a tenant.",
+	"items": "List of MetalLBs",
+}
+
+func (MetalLBList) SwaggerDoc() map[string]string {
+	return map_MetalLBList
+}
+
+var map_MetalLBPeer = map[string]string{
+	"":             "MetalLBPeer is a BGP router peering with the nodes.",
+	"peerAddress":  "PeerAddress is the address of router.",
+	"peerASN":      "PeerASN is the AS number of router.",
+	"myASN":        "MyASN is the AS number of nodes.",
+	"peerPort":     "PeerPort is the BGP port of router, defaults to 179.",
+	"nodeSelector": "NodeSelector selects the nodes peering with the router, all nodes peer with it if not specified.",
+}
+
+func (MetalLBPeer) SwaggerDoc() map[string]string {
+	return map_MetalLBPeer
+}
+
+var map_MetalLBSpec = map[string]string{
+	"":             "MetalLBSpec describes the attributes on a MetalLB.",
+	"addressPools": "AddressPools are the addresses assigned to the Services of type LoadBalancer.",
+	"peers":        "Peers are the BGP routers which the nodes announce the addresses of bgp pools to.",
+}
+
+func (MetalLBSpec) SwaggerDoc() map[string]string {
+	return map_MetalLBSpec
+}
+
+var map_MetalLBStatus = map[string]string{
+	"":                            "MetalLBStatus is information about the current status of a MetalLB.",
+	"phase":                       "Phase is the current lifecycle phase of the MetalLB of cluster.",
+	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
+	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"conditions":                  "Conditions are the lifecycle conditions of the addon.",
+}
+
+func (MetalLBStatus) SwaggerDoc() map[string]string {
+	return map_MetalLBStatus
+}
+
+var map_Multus = map[string]string{
+	"":     "Multus attaches secondary network interfaces such as macvlan, ipvlan and SR-IOV to pods by the NetworkAttachmentDefinitions.",
+	"spec": "Spec defines the desired identities of Multus.",
+}
+
+func (Multus) SwaggerDoc() map[string]string {
+	return map_Multus
+}
+
+var map_MultusIPAM = map[string]string{
+	"":       "MultusIPAM describes the IPAM plugin of a network attachment.",
+	"type":   "Type is the IPAM plugin, one of host-local, static and dhcp, defaults to host-local. The addresses of host-local are allocated per node, so the ranges of nodes must not overlap if the interfaces are in the same layer 2 network. The addresses of static are specified by the networks annotation of pods, and dhcp requires the dhcp daemon running on nodes.",
+	"subnet": "Subnet is the CIDR which the addresses are allocated from, only used by host-local.",
+	"routes": "Routes are the destination CIDRs routed through the interface.",
+}
+
+func (MultusIPAM) SwaggerDoc() map[string]string {
+	return map_MultusIPAM
+}
+
+var map_MultusList = map[string]string{
+	"":      "MultusList is the whole list of all Multuses which owned by a tenant.",
+	"items": "List of Multuses",
+}
+
+func (MultusList) SwaggerDoc() map[string]string {
+	return map_MultusList
+}
+
+var map_MultusNetworkAttachment = map[string]string{
+	"":             "MultusNetworkAttachment is a NetworkAttachmentDefinition managed by Multus.",
+	"type":         "Type is the CNI plugin, one of macvlan, ipvlan, host-device and sriov. It is ignored if Config is specified.",
+	"master":       "Master is the interface of nodes which macvlan and ipvlan are created on, or the device moved into pods by host-device.",
+	"mode":         "Mode is the mode of macvlan (bridge, private, vepa or passthru) or ipvlan (l2, l3 or l3s).",
+	"resourceName": "ResourceName is the SR-IOV resource without prefix which the pods request the virtual functions from, it is required by sriov.",
+	"vlan":         "VLAN is the VLAN ID of virtual functions assigned to pods, only used by sriov.",
+	"ipam":         "IPAM allocates the addresses of the interfaces, the interfaces have no address if it is not specified.",
+	"config":       "Config is the raw CNI configuration in JSON, which is used as it is for the plugins not supported by the fields above.",
+}
+
+func (MultusNetworkAttachment) SwaggerDoc() map[string]string {
+	return map_MultusNetworkAttachment
+}
+
+var map_MultusSRIOV = map[string]string{
+	"":               "MultusSRIOV describes the resources advertised by SR-IOV network device plugin.",
+	"resourcePrefix": "ResourcePrefix is the prefix of extended resources, defaults to intel.com.",
+	"resources":      "Resources group the virtual functions by the selectors.",
+	"nodeSelector":   "NodeSelector selects the nodes with SR-IOV NICs, all nodes if not specified.",
+}
+
+func (MultusSRIOV) SwaggerDoc() map[string]string {
+	return map_MultusSRIOV
+}
+
+var map_MultusSRIOVResource = map[string]string{
+	"":             "MultusSRIOVResource is an extended resource of the virtual functions matching all the selectors.",
+	"resourceName": "ResourceName is the name of extended resource without prefix.",
+	"vendors":      "Vendors are the vendor hex codes of devices, such as 8086.",
+	"devices":      "Devices are the device hex codes of virtual functions, such as 154c.",
+	"drivers":      "Drivers are the drivers of virtual functions, such as iavf and vfio-pci.",
+	"pfNames":      "PFNames are the physical functions of virtual functions, such as eth1.",
+}
+
+func (MultusSRIOVResource) SwaggerDoc() map[string]string {
+	return map_MultusSRIOVResource
+}
+
+var map_MultusSpec = map[string]string{
+	"":                   "MultusSpec describes the attributes on a Multus.",
+	"sriov":              "SRIOV deploys the SR-IOV network device plugin and CNI, which advertise the virtual functions of NICs as extended resources of nodes.",
+	"networkAttachments": "NetworkAttachments are created as NetworkAttachmentDefinitions, which are referenced by the k8s.v1.cni.cncf.io/networks annotation of pods.",
+}
+
+func (MultusSpec) SwaggerDoc() map[string]string {
+	return map_MultusSpec
+}
+
+var map_MultusStatus = map[string]string{
+	"":                            "MultusStatus is information about the current status of a Multus.",
+	"phase":                       "Phase is the current lifecycle phase of the Multus of cluster.",
+	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
+	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
+	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"conditions":                  "Conditions are the lifecycle conditions of the addon.",
+}
+
+func (MultusStatus) SwaggerDoc() map[string]string {
+	return map_MultusStatus
+}
+
+var map_NetworkEncryption = map[string]string{
+	"":                  "NetworkEncryption describes how the pod traffic between nodes is encrypted.",
+	"keyRotationPeriod": "KeyRotationPeriod is the period to rotate the encryption keys, such as \"720h\". The keys are never rotated if it is empty.",
+}
+
+func (NetworkEncryption) SwaggerDoc() map[string]string {
+	return map_NetworkEncryption
+}
+
+var map_NetworkPolicyFeature = map[string]string{
+	"":                      "NetworkPolicyFeature bootstraps default-deny NetworkPolicies and verifies the NetworkPolicy enforcement of CNI when the cluster is created.",
+	"defaultDenyNamespaces": "DefaultDenyNamespaces are the namespaces to install the default-deny NetworkPolicy which denies all ingress traffic of pods, the namespaces not existing are created.",
+	"denyEgress":            "DenyEgress denies the egress traffic of pods in DefaultDenyNamespaces too, except DNS queries.",
+}
+
+func (NetworkPolicyFeature) SwaggerDoc() map[string]string {
+	return map_NetworkPolicyFeature
+}
+
+var map_NetworkPolicyStatus = map[string]string{
+	"":         "NetworkPolicyStatus is the result of the NetworkPolicy enforcement check with probe pods.",
+	"enforced": "Enforced is true if the traffic denied by a NetworkPolicy is blocked.",
+	"message":  "Message describes why the enforcement is not verified.",
+}
+
+func (NetworkPolicyStatus) SwaggerDoc() map[string]string {
+	return map_NetworkPolicyStatus
+}
+
 var map_PVCRProxyOptions = map[string]string{
 	"": "PVCRProxyOptions is the query options to a kube-apiserver proxy call for PVCR crd object.",
 }
@@ -688,6 +1616,16 @@ func (PersistentEvent) SwaggerDoc() map[string]string {
 	return map_PersistentEvent
 }
 
+var map_PersistentEventBuffer = map[string]string{
+	"":               "PersistentEventBuffer describes how events are buffered when the backend storage is slow or unavailable.",
+	"totalLimitSize": "TotalLimitSize is the max size of buffered events, such as 32MB, defaults to 32MB.",
+	"overflowAction": "OverflowAction is the action when the buffer is full, block pauses reading events, drop_oldest_chunk drops the oldest events, defaults to block.",
+}
+
+func (PersistentEventBuffer) SwaggerDoc() map[string]string {
+	return map_PersistentEventBuffer
+}
+
 var map_PersistentEventList = map[string]string{
 	"":      "PersistentEventList is the whole list of all clusters which owned by a tenant.",
 	"items": "List of PersistentEvents",
@@ -698,7 +1636,8 @@ func (PersistentEventList) SwaggerDoc() map[string]string {
 }
 
 var map_PersistentEventSpec = map[string]string{
-	"": "PersistentEventSpec describes the attributes on a PersistentEvent.",
+	"":       "PersistentEventSpec describes the attributes on a PersistentEvent.",
+	"buffer": "Buffer handles the backpressure of the backend storage.",
 }
 
 func (PersistentEventSpec) SwaggerDoc() map[string]string {
@@ -717,6 +1656,51 @@ func (PersistentEventStatus) SwaggerDoc() map[string]string {
 	return map_PersistentEventStatus
 }
 
+var map_PersistentVolumeClaimUsage = map[string]string{
+	"":              "PersistentVolumeClaimUsage is the filesystem usage of a persistent volume claim reported by kubelet.",
+	"usedBytes":     "UsedBytes is the bytes used by the filesystem of the volume.",
+	"capacityBytes": "CapacityBytes is the total bytes of the filesystem of the volume.",
+	"exceeded":      "Exceeded will be true if the usage exceeds the enforcement threshold.",
+}
+
+func (PersistentVolumeClaimUsage) SwaggerDoc() map[string]string {
+	return map_PersistentVolumeClaimUsage
+}
+
+var map_PhaseProgress = map[string]string{
+	"":          "PhaseProgress records the execution of a phase.",
+	"name":      "Name is the name of the phase.",
+	"startTime": "Time the phase starts to execute at the last time.",
+	"endTime":   "Time the phase finishes successfully.",
+	"retries":   "Retries is the number of failed executions of the phase.",
+	"lastError": "LastError is the error message of the last failed execution.",
+}
+
+func (PhaseProgress) SwaggerDoc() map[string]string {
+	return map_PhaseProgress
+}
+
+var map_Progress = map[string]string{
+	"":          "Progress records the phases progress of the current cluster or machine operation.",
+	"total":     "Total is the number of phases of the operation.",
+	"completed": "Completed is the number of phases which are done or skipped.",
+	"phases":    "Phases records the execution of each phase in order.",
+}
+
+func (Progress) SwaggerDoc() map[string]string {
+	return map_Progress
+}
+
+var map_ProjectImageAdmission = map[string]string{
+	"":                  "ProjectImageAdmission describes the image prefixes allowed in namespaces of a project.",
+	"projectName":       "ProjectName is the name of project in business.",
+	"allowedRegistries": "AllowedRegistries are the image prefixes allowed in namespaces of the project.",
+}
+
+func (ProjectImageAdmission) SwaggerDoc() map[string]string {
+	return map_ProjectImageAdmission
+}
+
 var map_Prometheus = map[string]string{
 	"":     "Prometheus is a kubernetes package manager.",
 	"spec": "Spec defines the desired identities of clusters in this set.",
@@ -726,6 +1710,15 @@ func (Prometheus) SwaggerDoc() map[string]string {
 	return map_Prometheus
 }
 
+var map_PrometheusBasicAuth = map[string]string{
+	"":         "PrometheusBasicAuth records the basic authentication of a remote endpoint.",
+	"password": "Password is the base64 encoded password.",
+}
+
+func (PrometheusBasicAuth) SwaggerDoc() map[string]string {
+	return map_PrometheusBasicAuth
+}
+
 var map_PrometheusList = map[string]string{
 	"":      "PrometheusList is the whole list of all prometheus which owned by a tenant.",
 	"items": "List of Prometheuss",
@@ -743,6 +1736,20 @@ func (PrometheusRemoteAddr) SwaggerDoc() map[string]string {
 	return map_PrometheusRemoteAddr
 }
 
+var map_PrometheusRemoteWrite = map[string]string{
+	"":             "PrometheusRemoteWrite is a remote write endpoint of prometheus, such as Cortex, Mimir or Thanos receive.",
+	"name":         "Name identifies the endpoint, unique in a prometheus.",
+	"url":          "URL is the remote write address, such as http://cortex:9009/api/v1/push.",
+	"basicAuth":    "BasicAuth authenticates to the endpoint, disabled if nil.",
+	"bearerToken":  "BearerToken is the base64 encoded bearer token to authenticate to the endpoint.",
+	"tls":          "TLS configures the certificates to connect to the endpoint over https.",
+	"metricsRegex": "MetricsRegex keeps only the metrics whose names match the regex, all metrics are written if empty.",
+}
+
+func (PrometheusRemoteWrite) SwaggerDoc() map[string]string {
+	return map_PrometheusRemoteWrite
+}
+
 var map_PrometheusSpec = map[string]string{
 	"":                    "PrometheusSpec describes the attributes on a Prometheus.",
 	"subVersion":          "SubVersion is the components version such as node-exporter.",
@@ -752,6 +1759,11 @@ var map_PrometheusSpec = map[string]string{
 	"runOnMaster":         "RunOnMaster indicates whether to add master Affinity for all monitor components or not",
 	"alertRepeatInterval": "AlertRepeatInterval indicates repeat interval of alerts",
 	"withNPD":             "WithNPD indicates whether to deploy node-problem-detector or not",
+	"remoteWrites":        "RemoteWrites are the extra remote write endpoints besides RemoteAddress.",
+	"externalLabels":      "ExternalLabels are added to the metrics sent to remote endpoints, cluster_id, tenant_id and cluster_display_name can not be overridden.",
+	"retention":           "Retention is how long prometheus retains data locally, such as 2h, defaults to 30m.",
+	"retentionSize":       "RetentionSize is the max bytes of local data, such as 10GB, defaults to 5GB.",
+	"sizingTier":          "SizingTier sizes the resources of prometheus, ignored if Resources is set.",
 }
 
 func (PrometheusSpec) SwaggerDoc() map[string]string {
@@ -771,6 +1783,18 @@ func (PrometheusStatus) SwaggerDoc() map[string]string {
 	return map_PrometheusStatus
 }
 
+var map_PrometheusTLSConfig = map[string]string{
+	"":                   "PrometheusTLSConfig records the certificates to connect to a remote endpoint.",
+	"caCert":             "CACert is the PEM encoded CA certificate to verify the server.",
+	"clientCert":         "ClientCert is the PEM encoded client certificate.",
+	"clientKey":          "ClientKey is the PEM encoded private key of the client certificate.",
+	"insecureSkipVerify": "InsecureSkipVerify skips the verification of the server certificate.",
+}
+
+func (PrometheusTLSConfig) SwaggerDoc() map[string]string {
+	return map_PrometheusTLSConfig
+}
+
 var map_Registry = map[string]string{
 	"": "Registry records the third-party image repository information stored by the user.",
 }
@@ -779,6 +1803,14 @@ func (Registry) SwaggerDoc() map[string]string {
 	return map_Registry
 }
 
+var map_RegistryAuth = map[string]string{
+	"": "RegistryAuth is the credential of a private registry, the password is kept in the RegistryPasswords of ClusterCredential by registry.",
+}
+
+func (RegistryAuth) SwaggerDoc() map[string]string {
+	return map_RegistryAuth
+}
+
 var map_RegistryList = map[string]string{
 	"": "RegistryList is a resource containing a list of Registry objects.",
 }
@@ -803,6 +1835,66 @@ func (ResourceRequirements) SwaggerDoc() map[string]string {
 	return map_ResourceRequirements
 }
 
+var map_S3Storage = map[string]string{
+	"":       "S3Storage describes a bucket of S3 compatible storage, the secret access key is kept in the EtcdBackupSecretAccessKey of ClusterCredential.",
+	"prefix": "Prefix is prepended to the name of uploaded objects.",
+}
+
+func (S3Storage) SwaggerDoc() map[string]string {
+	return map_S3Storage
+}
+
+var map_SandboxRuntime = map[string]string{
+	"":                    "SandboxRuntime describes the sandboxed container runtime of cluster.",
+	"untrustedNamespaces": "Pods in untrusted namespaces default to the sandboxed RuntimeClass.",
+}
+
+func (SandboxRuntime) SwaggerDoc() map[string]string {
+	return map_SandboxRuntime
+}
+
+var map_SecretsEncryption = map[string]string{
+	"":                  "SecretsEncryption describes how the secrets are encrypted at rest in etcd.",
+	"kms":               "KMS is the KMS plugin running on masters, required when provider is KMS.",
+	"keyRotationPeriod": "KeyRotationPeriod is the period to rotate the secretbox key, such as \"2160h\". The key is never rotated if it is empty.",
+}
+
+func (SecretsEncryption) SwaggerDoc() map[string]string {
+	return map_SecretsEncryption
+}
+
+var map_ServiceOverride = map[string]string{
+	"":       "ServiceOverride describes the override of a systemd service, which is written as a drop-in file of the service.",
+	"env":    "Env is the environment variables of the service, such as HTTP_PROXY.",
+	"dropIn": "DropIn is the extra content of the drop-in file, such as an ExecStartPre directive, directives without section header belong to [Service].",
+}
+
+func (ServiceOverride) SwaggerDoc() map[string]string {
+	return map_ServiceOverride
+}
+
+var map_ServiceOverrides = map[string]string{
+	"":                 "ServiceOverrides describes the overrides of systemd services on nodes.",
+	"kubelet":          "Kubelet overrides the kubelet service.",
+	"containerRuntime": "ContainerRuntime overrides the container runtime service.",
+}
+
+func (ServiceOverrides) SwaggerDoc() map[string]string {
+	return map_ServiceOverrides
+}
+
+var map_StaticPodOverride = map[string]string{
+	"":                  "StaticPodOverride describes the extra volumes, envs and sidecars injected into a static pod manifest.",
+	"extraVolumes":      "ExtraVolumes are added to the pod, they can be mounted by the component or sidecars.",
+	"extraVolumeMounts": "ExtraVolumeMounts are added to the component container.",
+	"extraEnv":          "ExtraEnv are added to the component container.",
+	"sidecars":          "Sidecars are added to the pod, such as audit log shippers.",
+}
+
+func (StaticPodOverride) SwaggerDoc() map[string]string {
+	return map_StaticPodOverride
+}
+
 var map_StorageBackEndCLS = map[string]string{
 	"": "StorageBackEndCLS records the attributes required when the backend storage type is CLS.",
 }
@@ -819,6 +1911,66 @@ func (StorageBackEndES) SwaggerDoc() map[string]string {
 	return map_StorageBackEndES
 }
 
+var map_StorageBackEndKafka = map[string]string{
+	"":        "StorageBackEndKafka records the attributes required when the backend storage type is Kafka.",
+	"brokers": "Brokers are the addresses of Kafka brokers, such as 10.0.0.1:9092.",
+	"topic":   "Topic is the topic which the events are produced to.",
+	"sasl":    "SASL authenticates to the brokers, disabled if nil.",
+	"tls":     "TLS connects to the brokers over TLS, disabled if nil.",
+}
+
+func (StorageBackEndKafka) SwaggerDoc() map[string]string {
+	return map_StorageBackEndKafka
+}
+
+var map_StorageBackEndLoki = map[string]string{
+	"":         "StorageBackEndLoki records the attributes required when the backend storage type is Loki.",
+	"url":      "URL is the address of Loki, such as http://loki:3100.",
+	"tenantID": "TenantID is the X-Scope-OrgID of the multi-tenant Loki.",
+	"user":     "User is the user of basic authentication.",
+	"password": "Password is the base64 encoded password of basic authentication.",
+	"labels":   "Labels are the extra labels of the event streams.",
+	"tls":      "TLS configures the certificates to connect to Loki over https.",
+}
+
+func (StorageBackEndLoki) SwaggerDoc() map[string]string {
+	return map_StorageBackEndLoki
+}
+
+var map_StorageBackEndSASL = map[string]string{
+	"":          "StorageBackEndSASL records the SASL authentication of a backend storage.",
+	"mechanism": "Mechanism is PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512, defaults to PLAIN.",
+	"password":  "Password is the base64 encoded password.",
+}
+
+func (StorageBackEndSASL) SwaggerDoc() map[string]string {
+	return map_StorageBackEndSASL
+}
+
+var map_StorageBackEndTLS = map[string]string{
+	"":                   "StorageBackEndTLS records the certificates to connect to a backend storage.",
+	"caCert":             "CACert is the PEM encoded CA certificate to verify the server.",
+	"clientCert":         "ClientCert is the PEM encoded client certificate.",
+	"clientKey":          "ClientKey is the PEM encoded private key of the client certificate.",
+	"insecureSkipVerify": "InsecureSkipVerify skips the verification of the server certificate.",
+}
+
+func (StorageBackEndTLS) SwaggerDoc() map[string]string {
+	return map_StorageBackEndTLS
+}
+
+var map_SystemTuning = map[string]string{
+	"":                    "SystemTuning describes the kernel parameters, kernel modules, ulimits and transparent hugepage setting enforced on all nodes of the cluster.",
+	"sysctls":             "Sysctls override or extend the default kernel parameters, such as net.core.somaxconn.",
+	"kernelModules":       "KernelModules are loaded in addition to the modules required by kubernetes.",
+	"ulimits":             "Ulimits are the resource limits applied to all users.",
+	"transparentHugepage": "TransparentHugepage is one of always, madvise or never. Left unchanged when empty.",
+}
+
+func (SystemTuning) SwaggerDoc() map[string]string {
+	return map_SystemTuning
+}
+
 var map_TappController = map[string]string{
 	"":     "TappController is a new kubernetes workload.",
 	"spec": "Spec defines the desired identities of tapp controller.",
@@ -828,6 +1980,17 @@ func (TappController) SwaggerDoc() map[string]string {
 	return map_TappController
 }
 
+var map_TappControllerConfig = map[string]string{
+	"":               "TappControllerConfig configures the tapp-controller and the defaults of TApps.",
+	"workers":        "Workers is the number of TApps synced concurrently, defaults to 5.",
+	"forceDeletePod": "ForceDeletePod force deletes the pods on the lost nodes by default, so that the instances are recreated on other nodes. The TApps can override it.",
+	"maxUnavailable": "MaxUnavailable is the default number of instances unavailable during the rolling update of the TApps which do not specify it, defaults to 1.",
+}
+
+func (TappControllerConfig) SwaggerDoc() map[string]string {
+	return map_TappControllerConfig
+}
+
 var map_TappControllerList = map[string]string{
 	"":      "TappControllerList is the whole list of all tapp controllers which owned by a tenant.",
 	"items": "List of tapp controllers",
@@ -846,7 +2009,10 @@ func (TappControllerProxyOptions) SwaggerDoc() map[string]string {
 }
 
 var map_TappControllerSpec = map[string]string{
-	"": "TappControllerSpec describes the attributes on a tapp controller.",
+	"":                  "TappControllerSpec describes the attributes on a tapp controller.",
+	"crdConflictPolicy": "CRDConflictPolicy defines how to handle the conflicting custom resource definitions in cluster before installing, defaults to Fail.",
+	"driftPolicy":       "DriftPolicy defines how to handle the components in cluster which drift from the desired state, defaults to Repair.",
+	"config":            "Config configures the tapp-controller and the defaults of TApps.",
 }
 
 func (TappControllerSpec) SwaggerDoc() map[string]string {
@@ -859,25 +2025,71 @@ var map_TappControllerStatus = map[string]string{
 	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
 	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
 	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"crdConflicts":                "CRDConflicts are the conflicting custom resource definitions found before installing, which stop the addon from installing.",
+	"conditions":                  "Conditions are the lifecycle conditions of the addon.",
 }
 
 func (TappControllerStatus) SwaggerDoc() map[string]string {
 	return map_TappControllerStatus
 }
 
+var map_TimeSync = map[string]string{
+	"":               "TimeSync describes the time synchronization of nodes.",
+	"servers":        "Servers are the NTP servers used by chrony.",
+	"maxSkewSeconds": "MaxSkewSeconds is the max clock skew allowed between the node and the platform controller, defaults to 5.",
+}
+
+func (TimeSync) SwaggerDoc() map[string]string {
+	return map_TimeSync
+}
+
+var map_Ulimit = map[string]string{
+	"":     "Ulimit is a resource limit in the format of limits.conf.",
+	"name": "Name is the limited item, such as nofile or nproc.",
+}
+
+func (Ulimit) SwaggerDoc() map[string]string {
+	return map_Ulimit
+}
+
 var map_Upgrade = map[string]string{
 	"mode":     "Upgrade mode, default value is Auto.",
 	"strategy": "Upgrade strategy config.",
+	"paused":   "Paused stops upgrading more nodes, the nodes being upgraded are not interrupted.",
+	"canary":   "Canary upgrades the first master only and holds the upgrade for verification before the remaining masters and workers are upgraded.",
 }
 
 func (Upgrade) SwaggerDoc() map[string]string {
 	return map_Upgrade
 }
 
+var map_UpgradeCanary = map[string]string{
+	"":                "UpgradeCanary holds the upgrade after the first master is upgraded until it is approved.",
+	"approvedVersion": "ApprovedVersion continues the upgrade when it is the version being upgraded to, the same as annotating the cluster with platform.tkestack.io/upgrade-canary-approved.",
+}
+
+func (UpgradeCanary) SwaggerDoc() map[string]string {
+	return map_UpgradeCanary
+}
+
+var map_UpgradeCanaryStatus = map[string]string{
+	"":         "UpgradeCanaryStatus records the health checks of the canary master.",
+	"version":  "Version is the version which the canary master is upgraded to.",
+	"node":     "Node is the IP of the canary master.",
+	"checks":   "Checks are the results of health checks such as APIServer, Scheduling and DNS.",
+	"approved": "Approved indicates the upgrade continues to the remaining nodes.",
+}
+
+func (UpgradeCanaryStatus) SwaggerDoc() map[string]string {
+	return map_UpgradeCanaryStatus
+}
+
 var map_UpgradeStrategy = map[string]string{
 	"":                       "UpgradeStrategy used to control the upgrade process.",
 	"maxUnready":             "The maximum number of pods that can be unready during the upgrade. 0% means all pods need to be ready after evition. 100% means ignore any pods unready which may be used in one worker node, use this carefully! default value is 0%.",
 	"drainNodeBeforeUpgrade": "Whether drain node before upgrade. Draining node before upgrade is recommended. But not all pod running as cows, a few running as pets. If your pod can not accept be expelled from current node, this value should be false.",
+	"maxUnavailable":         "The maximum number of worker nodes that can be unavailable during the upgrade. Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%). default value is 1.",
+	"maxSurge":               "The maximum number of worker nodes that can be upgrading above MaxUnavailable, these nodes are prepared for the upgrade while the worker nodes which are not ready or unschedulable are still within MaxUnavailable. Unlike maxSurge of a Deployment, no extra worker nodes are created, the nodes are upgraded in place. Percentages are rounded up. Value can be an absolute number (ex: 5) or a percentage of worker nodes (ex: 10%). default value is 0.",
 }
 
 func (UpgradeStrategy) SwaggerDoc() map[string]string {
@@ -903,7 +2115,8 @@ func (VolumeDecoratorList) SwaggerDoc() map[string]string {
 }
 
 var map_VolumeDecoratorSpec = map[string]string{
-	"": "VolumeDecoratorSpec describes the attributes of a VolumeDecorator.",
+	"":            "VolumeDecoratorSpec describes the attributes of a VolumeDecorator.",
+	"enforcement": "Enforcement annotates or evicts the pods whose persistent volume claims exceed the usage threshold, disabled if nil.",
 }
 
 func (VolumeDecoratorSpec) SwaggerDoc() map[string]string {
@@ -919,10 +2132,21 @@ var map_VolumeDecoratorStatus = map[string]string{
 	"reason":                      "Reason is a brief CamelCase string that describes any failure.",
 	"retryCount":                  "RetryCount is a int between 0 and 5 that describes the time of retrying initializing.",
 	"lastReInitializingTimestamp": "LastReInitializingTimestamp is a timestamp that describes the last time of retrying initializing.",
+	"usages":                      "Usages are the filesystem usages of the mounted persistent volume claims in this cluster.",
 }
 
 func (VolumeDecoratorStatus) SwaggerDoc() map[string]string {
 	return map_VolumeDecoratorStatus
 }
 
+var map_VolumeUsageEnforcement = map[string]string{
+	"":          "VolumeUsageEnforcement acts on the pods whose persistent volume claims exceed the usage threshold.",
+	"threshold": "Threshold is the percentage of used bytes to the capacity of a persistent volume claim, between 1 and 100.",
+	"action":    "Action defaults to Annotate.",
+}
+
+func (VolumeUsageEnforcement) SwaggerDoc() map[string]string {
+	return map_VolumeUsageEnforcement
+}
+
 // AUTO-GENERATED FUNCTIONS END HERE
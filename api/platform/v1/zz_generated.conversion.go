@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 	platform "tkestack.io/tke/api/platform"
 )
 
@@ -40,6 +41,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AddonCondition)(nil), (*platform.AddonCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AddonCondition_To_platform_AddonCondition(a.(*AddonCondition), b.(*platform.AddonCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AddonCondition)(nil), (*AddonCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AddonCondition_To_v1_AddonCondition(a.(*platform.AddonCondition), b.(*AddonCondition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AddonSpec)(nil), (*platform.AddonSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AddonSpec_To_platform_AddonSpec(a.(*AddonSpec), b.(*platform.AddonSpec), scope)
 	}); err != nil {
@@ -50,6 +61,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditLogBackend)(nil), (*platform.AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditLogBackend_To_platform_AuditLogBackend(a.(*AuditLogBackend), b.(*platform.AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditLogBackend)(nil), (*AuditLogBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditLogBackend_To_v1_AuditLogBackend(a.(*platform.AuditLogBackend), b.(*AuditLogBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhookBackend)(nil), (*platform.AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AuditWebhookBackend_To_platform_AuditWebhookBackend(a.(*AuditWebhookBackend), b.(*platform.AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.AuditWebhookBackend)(nil), (*AuditWebhookBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_AuditWebhookBackend_To_v1_AuditWebhookBackend(a.(*platform.AuditWebhookBackend), b.(*AuditWebhookBackend), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthzWebhookAddr)(nil), (*platform.AuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(a.(*AuthzWebhookAddr), b.(*platform.AuthzWebhookAddr), scope)
 	}); err != nil {
@@ -70,6 +101,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CRDConflict)(nil), (*platform.CRDConflict)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CRDConflict_To_platform_CRDConflict(a.(*CRDConflict), b.(*platform.CRDConflict), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CRDConflict)(nil), (*CRDConflict)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CRDConflict_To_v1_CRDConflict(a.(*platform.CRDConflict), b.(*CRDConflict), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CSIOperator)(nil), (*platform.CSIOperator)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CSIOperator_To_platform_CSIOperator(a.(*CSIOperator), b.(*platform.CSIOperator), scope)
 	}); err != nil {
@@ -130,6 +171,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CalicoNetwork)(nil), (*platform.CalicoNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CalicoNetwork_To_platform_CalicoNetwork(a.(*CalicoNetwork), b.(*platform.CalicoNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CalicoNetwork)(nil), (*CalicoNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CalicoNetwork_To_v1_CalicoNetwork(a.(*platform.CalicoNetwork), b.(*CalicoNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExpiration)(nil), (*platform.CertificateExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateExpiration_To_platform_CertificateExpiration(a.(*CertificateExpiration), b.(*platform.CertificateExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CertificateExpiration)(nil), (*CertificateExpiration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CertificateExpiration_To_v1_CertificateExpiration(a.(*platform.CertificateExpiration), b.(*CertificateExpiration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateRotation)(nil), (*platform.CertificateRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRotation_To_platform_CertificateRotation(a.(*CertificateRotation), b.(*platform.CertificateRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CertificateRotation)(nil), (*CertificateRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CertificateRotation_To_v1_CertificateRotation(a.(*platform.CertificateRotation), b.(*CertificateRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificatesStatus)(nil), (*platform.CertificatesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificatesStatus_To_platform_CertificatesStatus(a.(*CertificatesStatus), b.(*platform.CertificatesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CertificatesStatus)(nil), (*CertificatesStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CertificatesStatus_To_v1_CertificatesStatus(a.(*platform.CertificatesStatus), b.(*CertificatesStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CiliumNetwork)(nil), (*platform.CiliumNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CiliumNetwork_To_platform_CiliumNetwork(a.(*CiliumNetwork), b.(*platform.CiliumNetwork), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.CiliumNetwork)(nil), (*CiliumNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_CiliumNetwork_To_v1_CiliumNetwork(a.(*platform.CiliumNetwork), b.(*CiliumNetwork), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Cluster)(nil), (*platform.Cluster)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Cluster_To_platform_Cluster(a.(*Cluster), b.(*platform.Cluster), scope)
 	}); err != nil {
@@ -220,6 +311,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterAudit)(nil), (*platform.ClusterAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterAudit_To_platform_ClusterAudit(a.(*ClusterAudit), b.(*platform.ClusterAudit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterAudit)(nil), (*ClusterAudit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterAudit_To_v1_ClusterAudit(a.(*platform.ClusterAudit), b.(*ClusterAudit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterComponent)(nil), (*platform.ClusterComponent)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterComponent_To_platform_ClusterComponent(a.(*ClusterComponent), b.(*platform.ClusterComponent), scope)
 	}); err != nil {
@@ -270,6 +371,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterDNS)(nil), (*platform.ClusterDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterDNS_To_platform_ClusterDNS(a.(*ClusterDNS), b.(*platform.ClusterDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterDNS)(nil), (*ClusterDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterDNS_To_v1_ClusterDNS(a.(*platform.ClusterDNS), b.(*ClusterDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterFeature)(nil), (*platform.ClusterFeature)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterFeature_To_platform_ClusterFeature(a.(*ClusterFeature), b.(*platform.ClusterFeature), scope)
 	}); err != nil {
@@ -280,6 +391,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterHealth)(nil), (*platform.ClusterHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterHealth_To_platform_ClusterHealth(a.(*ClusterHealth), b.(*platform.ClusterHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterHealth)(nil), (*ClusterHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterHealth_To_v1_ClusterHealth(a.(*platform.ClusterHealth), b.(*ClusterHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterHealthDimension)(nil), (*platform.ClusterHealthDimension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterHealthDimension_To_platform_ClusterHealthDimension(a.(*ClusterHealthDimension), b.(*platform.ClusterHealthDimension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterHealthDimension)(nil), (*ClusterHealthDimension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterHealthDimension_To_v1_ClusterHealthDimension(a.(*platform.ClusterHealthDimension), b.(*ClusterHealthDimension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterImage)(nil), (*platform.ClusterImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterImage_To_platform_ClusterImage(a.(*ClusterImage), b.(*platform.ClusterImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterImage)(nil), (*ClusterImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterImage_To_v1_ClusterImage(a.(*platform.ClusterImage), b.(*ClusterImage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterList)(nil), (*platform.ClusterList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterList_To_platform_ClusterList(a.(*ClusterList), b.(*platform.ClusterList), scope)
 	}); err != nil {
@@ -290,6 +431,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterLogsOptions)(nil), (*platform.ClusterLogsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterLogsOptions_To_platform_ClusterLogsOptions(a.(*ClusterLogsOptions), b.(*platform.ClusterLogsOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterLogsOptions)(nil), (*ClusterLogsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterLogsOptions_To_v1_ClusterLogsOptions(a.(*platform.ClusterLogsOptions), b.(*ClusterLogsOptions), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterMachine)(nil), (*platform.ClusterMachine)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterMachine_To_platform_ClusterMachine(a.(*ClusterMachine), b.(*platform.ClusterMachine), scope)
 	}); err != nil {
@@ -300,6 +451,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterOperation)(nil), (*platform.ClusterOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterOperation_To_platform_ClusterOperation(a.(*ClusterOperation), b.(*platform.ClusterOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterOperation)(nil), (*ClusterOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterOperation_To_v1_ClusterOperation(a.(*platform.ClusterOperation), b.(*ClusterOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterOperationList)(nil), (*platform.ClusterOperationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterOperationList_To_platform_ClusterOperationList(a.(*ClusterOperationList), b.(*platform.ClusterOperationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterOperationList)(nil), (*ClusterOperationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterOperationList_To_v1_ClusterOperationList(a.(*platform.ClusterOperationList), b.(*ClusterOperationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterOperationSpec)(nil), (*platform.ClusterOperationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec(a.(*ClusterOperationSpec), b.(*platform.ClusterOperationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterOperationSpec)(nil), (*ClusterOperationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec(a.(*platform.ClusterOperationSpec), b.(*ClusterOperationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterOperationStatus)(nil), (*platform.ClusterOperationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus(a.(*ClusterOperationStatus), b.(*platform.ClusterOperationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ClusterOperationStatus)(nil), (*ClusterOperationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus(a.(*platform.ClusterOperationStatus), b.(*ClusterOperationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterProperty)(nil), (*platform.ClusterProperty)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ClusterProperty_To_platform_ClusterProperty(a.(*ClusterProperty), b.(*platform.ClusterProperty), scope)
 	}); err != nil {
@@ -360,6 +551,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerRegistryConfig)(nil), (*platform.ContainerRegistryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ContainerRegistryConfig_To_platform_ContainerRegistryConfig(a.(*ContainerRegistryConfig), b.(*platform.ContainerRegistryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ContainerRegistryConfig)(nil), (*ContainerRegistryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ContainerRegistryConfig_To_v1_ContainerRegistryConfig(a.(*platform.ContainerRegistryConfig), b.(*ContainerRegistryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneOverrides)(nil), (*platform.ControlPlaneOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ControlPlaneOverrides_To_platform_ControlPlaneOverrides(a.(*ControlPlaneOverrides), b.(*platform.ControlPlaneOverrides), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ControlPlaneOverrides)(nil), (*ControlPlaneOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ControlPlaneOverrides_To_v1_ControlPlaneOverrides(a.(*platform.ControlPlaneOverrides), b.(*ControlPlaneOverrides), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CronHPA)(nil), (*platform.CronHPA)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CronHPA_To_platform_CronHPA(a.(*CronHPA), b.(*platform.CronHPA), scope)
 	}); err != nil {
@@ -410,2116 +621,5056 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Etcd)(nil), (*platform.Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Etcd_To_platform_Etcd(a.(*Etcd), b.(*platform.Etcd), scope)
+	if err := s.AddGeneratedConversionFunc((*DNSAutoscaler)(nil), (*platform.DNSAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DNSAutoscaler_To_platform_DNSAutoscaler(a.(*DNSAutoscaler), b.(*platform.DNSAutoscaler), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Etcd)(nil), (*Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Etcd_To_v1_Etcd(a.(*platform.Etcd), b.(*Etcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DNSAutoscaler)(nil), (*DNSAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DNSAutoscaler_To_v1_DNSAutoscaler(a.(*platform.DNSAutoscaler), b.(*DNSAutoscaler), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ExternalAuthzWebhookAddr)(nil), (*platform.ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(a.(*ExternalAuthzWebhookAddr), b.(*platform.ExternalAuthzWebhookAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*DNSStubDomain)(nil), (*platform.DNSStubDomain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DNSStubDomain_To_platform_DNSStubDomain(a.(*DNSStubDomain), b.(*platform.DNSStubDomain), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ExternalAuthzWebhookAddr)(nil), (*ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(a.(*platform.ExternalAuthzWebhookAddr), b.(*ExternalAuthzWebhookAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DNSStubDomain)(nil), (*DNSStubDomain)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DNSStubDomain_To_v1_DNSStubDomain(a.(*platform.DNSStubDomain), b.(*DNSStubDomain), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ExternalEtcd)(nil), (*platform.ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(a.(*ExternalEtcd), b.(*platform.ExternalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*DevicePlugin)(nil), (*platform.DevicePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DevicePlugin_To_platform_DevicePlugin(a.(*DevicePlugin), b.(*platform.DevicePlugin), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ExternalEtcd)(nil), (*ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(a.(*platform.ExternalEtcd), b.(*ExternalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DevicePlugin)(nil), (*DevicePlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DevicePlugin_To_v1_DevicePlugin(a.(*platform.DevicePlugin), b.(*DevicePlugin), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*File)(nil), (*platform.File)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_File_To_platform_File(a.(*File), b.(*platform.File), scope)
+	if err := s.AddGeneratedConversionFunc((*DevicePluginList)(nil), (*platform.DevicePluginList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DevicePluginList_To_platform_DevicePluginList(a.(*DevicePluginList), b.(*platform.DevicePluginList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.File)(nil), (*File)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_File_To_v1_File(a.(*platform.File), b.(*File), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DevicePluginList)(nil), (*DevicePluginList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DevicePluginList_To_v1_DevicePluginList(a.(*platform.DevicePluginList), b.(*DevicePluginList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HA)(nil), (*platform.HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HA_To_platform_HA(a.(*HA), b.(*platform.HA), scope)
+	if err := s.AddGeneratedConversionFunc((*DevicePluginSpec)(nil), (*platform.DevicePluginSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DevicePluginSpec_To_platform_DevicePluginSpec(a.(*DevicePluginSpec), b.(*platform.DevicePluginSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HA)(nil), (*HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HA_To_v1_HA(a.(*platform.HA), b.(*HA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DevicePluginSpec)(nil), (*DevicePluginSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DevicePluginSpec_To_v1_DevicePluginSpec(a.(*platform.DevicePluginSpec), b.(*DevicePluginSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Helm)(nil), (*platform.Helm)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Helm_To_platform_Helm(a.(*Helm), b.(*platform.Helm), scope)
+	if err := s.AddGeneratedConversionFunc((*DevicePluginStatus)(nil), (*platform.DevicePluginStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_DevicePluginStatus_To_platform_DevicePluginStatus(a.(*DevicePluginStatus), b.(*platform.DevicePluginStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Helm)(nil), (*Helm)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Helm_To_v1_Helm(a.(*platform.Helm), b.(*Helm), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.DevicePluginStatus)(nil), (*DevicePluginStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_DevicePluginStatus_To_v1_DevicePluginStatus(a.(*platform.DevicePluginStatus), b.(*DevicePluginStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HelmList)(nil), (*platform.HelmList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HelmList_To_platform_HelmList(a.(*HelmList), b.(*platform.HelmList), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressGateway)(nil), (*platform.EgressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressGateway_To_platform_EgressGateway(a.(*EgressGateway), b.(*platform.EgressGateway), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HelmList)(nil), (*HelmList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HelmList_To_v1_HelmList(a.(*platform.HelmList), b.(*HelmList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressGateway)(nil), (*EgressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressGateway_To_v1_EgressGateway(a.(*platform.EgressGateway), b.(*EgressGateway), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HelmProxyOptions)(nil), (*platform.HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(a.(*HelmProxyOptions), b.(*platform.HelmProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressGatewayList)(nil), (*platform.EgressGatewayList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressGatewayList_To_platform_EgressGatewayList(a.(*EgressGatewayList), b.(*platform.EgressGatewayList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HelmProxyOptions)(nil), (*HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(a.(*platform.HelmProxyOptions), b.(*HelmProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressGatewayList)(nil), (*EgressGatewayList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressGatewayList_To_v1_EgressGatewayList(a.(*platform.EgressGatewayList), b.(*EgressGatewayList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HelmSpec)(nil), (*platform.HelmSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HelmSpec_To_platform_HelmSpec(a.(*HelmSpec), b.(*platform.HelmSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressGatewaySpec)(nil), (*platform.EgressGatewaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec(a.(*EgressGatewaySpec), b.(*platform.EgressGatewaySpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HelmSpec)(nil), (*HelmSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HelmSpec_To_v1_HelmSpec(a.(*platform.HelmSpec), b.(*HelmSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressGatewaySpec)(nil), (*EgressGatewaySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec(a.(*platform.EgressGatewaySpec), b.(*EgressGatewaySpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*HelmStatus)(nil), (*platform.HelmStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_HelmStatus_To_platform_HelmStatus(a.(*HelmStatus), b.(*platform.HelmStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressGatewayStatus)(nil), (*platform.EgressGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus(a.(*EgressGatewayStatus), b.(*platform.EgressGatewayStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.HelmStatus)(nil), (*HelmStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_HelmStatus_To_v1_HelmStatus(a.(*platform.HelmStatus), b.(*HelmStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressGatewayStatus)(nil), (*EgressGatewayStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus(a.(*platform.EgressGatewayStatus), b.(*EgressGatewayStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IPAM)(nil), (*platform.IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IPAM_To_platform_IPAM(a.(*IPAM), b.(*platform.IPAM), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressIPAssignment)(nil), (*platform.EgressIPAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressIPAssignment_To_platform_EgressIPAssignment(a.(*EgressIPAssignment), b.(*platform.EgressIPAssignment), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.IPAM)(nil), (*IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_IPAM_To_v1_IPAM(a.(*platform.IPAM), b.(*IPAM), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressIPAssignment)(nil), (*EgressIPAssignment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressIPAssignment_To_v1_EgressIPAssignment(a.(*platform.EgressIPAssignment), b.(*EgressIPAssignment), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IPAMList)(nil), (*platform.IPAMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IPAMList_To_platform_IPAMList(a.(*IPAMList), b.(*platform.IPAMList), scope)
+	if err := s.AddGeneratedConversionFunc((*EgressIPPool)(nil), (*platform.EgressIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EgressIPPool_To_platform_EgressIPPool(a.(*EgressIPPool), b.(*platform.EgressIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.IPAMList)(nil), (*IPAMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_IPAMList_To_v1_IPAMList(a.(*platform.IPAMList), b.(*IPAMList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EgressIPPool)(nil), (*EgressIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EgressIPPool_To_v1_EgressIPPool(a.(*platform.EgressIPPool), b.(*EgressIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IPAMProxyOptions)(nil), (*platform.IPAMProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(a.(*IPAMProxyOptions), b.(*platform.IPAMProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*Etcd)(nil), (*platform.Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Etcd_To_platform_Etcd(a.(*Etcd), b.(*platform.Etcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.IPAMProxyOptions)(nil), (*IPAMProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(a.(*platform.IPAMProxyOptions), b.(*IPAMProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.Etcd)(nil), (*Etcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Etcd_To_v1_Etcd(a.(*platform.Etcd), b.(*Etcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IPAMSpec)(nil), (*platform.IPAMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IPAMSpec_To_platform_IPAMSpec(a.(*IPAMSpec), b.(*platform.IPAMSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdBackup)(nil), (*platform.EtcdBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdBackup_To_platform_EtcdBackup(a.(*EtcdBackup), b.(*platform.EtcdBackup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.IPAMSpec)(nil), (*IPAMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_IPAMSpec_To_v1_IPAMSpec(a.(*platform.IPAMSpec), b.(*IPAMSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdBackup)(nil), (*EtcdBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdBackup_To_v1_EtcdBackup(a.(*platform.EtcdBackup), b.(*EtcdBackup), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*IPAMStatus)(nil), (*platform.IPAMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IPAMStatus_To_platform_IPAMStatus(a.(*IPAMStatus), b.(*platform.IPAMStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdMaintenance)(nil), (*platform.EtcdMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdMaintenance_To_platform_EtcdMaintenance(a.(*EtcdMaintenance), b.(*platform.EtcdMaintenance), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.IPAMStatus)(nil), (*IPAMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_IPAMStatus_To_v1_IPAMStatus(a.(*platform.IPAMStatus), b.(*IPAMStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdMaintenance)(nil), (*EtcdMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdMaintenance_To_v1_EtcdMaintenance(a.(*platform.EtcdMaintenance), b.(*EtcdMaintenance), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LBCF)(nil), (*platform.LBCF)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LBCF_To_platform_LBCF(a.(*LBCF), b.(*platform.LBCF), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdMaintenanceStatus)(nil), (*platform.EtcdMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdMaintenanceStatus_To_platform_EtcdMaintenanceStatus(a.(*EtcdMaintenanceStatus), b.(*platform.EtcdMaintenanceStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LBCF)(nil), (*LBCF)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LBCF_To_v1_LBCF(a.(*platform.LBCF), b.(*LBCF), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdMaintenanceStatus)(nil), (*EtcdMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdMaintenanceStatus_To_v1_EtcdMaintenanceStatus(a.(*platform.EtcdMaintenanceStatus), b.(*EtcdMaintenanceStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LBCFList)(nil), (*platform.LBCFList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LBCFList_To_platform_LBCFList(a.(*LBCFList), b.(*platform.LBCFList), scope)
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshot)(nil), (*platform.EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(a.(*EtcdSnapshot), b.(*platform.EtcdSnapshot), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LBCFList)(nil), (*LBCFList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LBCFList_To_v1_LBCFList(a.(*platform.LBCFList), b.(*LBCFList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.EtcdSnapshot)(nil), (*EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(a.(*platform.EtcdSnapshot), b.(*EtcdSnapshot), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LBCFProxyOptions)(nil), (*platform.LBCFProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(a.(*LBCFProxyOptions), b.(*platform.LBCFProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*ExternalAuthzWebhookAddr)(nil), (*platform.ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(a.(*ExternalAuthzWebhookAddr), b.(*platform.ExternalAuthzWebhookAddr), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LBCFProxyOptions)(nil), (*LBCFProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(a.(*platform.LBCFProxyOptions), b.(*LBCFProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ExternalAuthzWebhookAddr)(nil), (*ExternalAuthzWebhookAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(a.(*platform.ExternalAuthzWebhookAddr), b.(*ExternalAuthzWebhookAddr), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LBCFSpec)(nil), (*platform.LBCFSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LBCFSpec_To_platform_LBCFSpec(a.(*LBCFSpec), b.(*platform.LBCFSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*ExternalEtcd)(nil), (*platform.ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(a.(*ExternalEtcd), b.(*platform.ExternalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LBCFSpec)(nil), (*LBCFSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LBCFSpec_To_v1_LBCFSpec(a.(*platform.LBCFSpec), b.(*LBCFSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ExternalEtcd)(nil), (*ExternalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(a.(*platform.ExternalEtcd), b.(*ExternalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LBCFStatus)(nil), (*platform.LBCFStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LBCFStatus_To_platform_LBCFStatus(a.(*LBCFStatus), b.(*platform.LBCFStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*File)(nil), (*platform.File)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_File_To_platform_File(a.(*File), b.(*platform.File), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LBCFStatus)(nil), (*LBCFStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LBCFStatus_To_v1_LBCFStatus(a.(*platform.LBCFStatus), b.(*LBCFStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.File)(nil), (*File)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_File_To_v1_File(a.(*platform.File), b.(*File), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LocalEtcd)(nil), (*platform.LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LocalEtcd_To_platform_LocalEtcd(a.(*LocalEtcd), b.(*platform.LocalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*FloatingIPPool)(nil), (*platform.FloatingIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FloatingIPPool_To_platform_FloatingIPPool(a.(*FloatingIPPool), b.(*platform.FloatingIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LocalEtcd)(nil), (*LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LocalEtcd_To_v1_LocalEtcd(a.(*platform.LocalEtcd), b.(*LocalEtcd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.FloatingIPPool)(nil), (*FloatingIPPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_FloatingIPPool_To_v1_FloatingIPPool(a.(*platform.FloatingIPPool), b.(*FloatingIPPool), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LogCollector)(nil), (*platform.LogCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LogCollector_To_platform_LogCollector(a.(*LogCollector), b.(*platform.LogCollector), scope)
+	if err := s.AddGeneratedConversionFunc((*FloatingIPReservation)(nil), (*platform.FloatingIPReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FloatingIPReservation_To_platform_FloatingIPReservation(a.(*FloatingIPReservation), b.(*platform.FloatingIPReservation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LogCollector)(nil), (*LogCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LogCollector_To_v1_LogCollector(a.(*platform.LogCollector), b.(*LogCollector), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.FloatingIPReservation)(nil), (*FloatingIPReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_FloatingIPReservation_To_v1_FloatingIPReservation(a.(*platform.FloatingIPReservation), b.(*FloatingIPReservation), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LogCollectorList)(nil), (*platform.LogCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LogCollectorList_To_platform_LogCollectorList(a.(*LogCollectorList), b.(*platform.LogCollectorList), scope)
+	if err := s.AddGeneratedConversionFunc((*FloatingIPReservationList)(nil), (*platform.FloatingIPReservationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FloatingIPReservationList_To_platform_FloatingIPReservationList(a.(*FloatingIPReservationList), b.(*platform.FloatingIPReservationList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorList)(nil), (*LogCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LogCollectorList_To_v1_LogCollectorList(a.(*platform.LogCollectorList), b.(*LogCollectorList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.FloatingIPReservationList)(nil), (*FloatingIPReservationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_FloatingIPReservationList_To_v1_FloatingIPReservationList(a.(*platform.FloatingIPReservationList), b.(*FloatingIPReservationList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LogCollectorProxyOptions)(nil), (*platform.LogCollectorProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(a.(*LogCollectorProxyOptions), b.(*platform.LogCollectorProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*FloatingIPReservationSpec)(nil), (*platform.FloatingIPReservationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec(a.(*FloatingIPReservationSpec), b.(*platform.FloatingIPReservationSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorProxyOptions)(nil), (*LogCollectorProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(a.(*platform.LogCollectorProxyOptions), b.(*LogCollectorProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.FloatingIPReservationSpec)(nil), (*FloatingIPReservationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec(a.(*platform.FloatingIPReservationSpec), b.(*FloatingIPReservationSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LogCollectorSpec)(nil), (*platform.LogCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(a.(*LogCollectorSpec), b.(*platform.LogCollectorSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*FloatingIPReservationStatus)(nil), (*platform.FloatingIPReservationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus(a.(*FloatingIPReservationStatus), b.(*platform.FloatingIPReservationStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorSpec)(nil), (*LogCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(a.(*platform.LogCollectorSpec), b.(*LogCollectorSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.FloatingIPReservationStatus)(nil), (*FloatingIPReservationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus(a.(*platform.FloatingIPReservationStatus), b.(*FloatingIPReservationStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*LogCollectorStatus)(nil), (*platform.LogCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(a.(*LogCollectorStatus), b.(*platform.LogCollectorStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUMIGDeviceConfig)(nil), (*platform.GPUMIGDeviceConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUMIGDeviceConfig_To_platform_GPUMIGDeviceConfig(a.(*GPUMIGDeviceConfig), b.(*platform.GPUMIGDeviceConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorStatus)(nil), (*LogCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(a.(*platform.LogCollectorStatus), b.(*LogCollectorStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUMIGDeviceConfig)(nil), (*GPUMIGDeviceConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUMIGDeviceConfig_To_v1_GPUMIGDeviceConfig(a.(*platform.GPUMIGDeviceConfig), b.(*GPUMIGDeviceConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*platform.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Machine_To_platform_Machine(a.(*Machine), b.(*platform.Machine), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUMIGProfile)(nil), (*platform.GPUMIGProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUMIGProfile_To_platform_GPUMIGProfile(a.(*GPUMIGProfile), b.(*platform.GPUMIGProfile), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Machine)(nil), (*Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Machine_To_v1_Machine(a.(*platform.Machine), b.(*Machine), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUMIGProfile)(nil), (*GPUMIGProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUMIGProfile_To_v1_GPUMIGProfile(a.(*platform.GPUMIGProfile), b.(*GPUMIGProfile), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineAddress)(nil), (*platform.MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineAddress_To_platform_MachineAddress(a.(*MachineAddress), b.(*platform.MachineAddress), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUMIGSlice)(nil), (*platform.GPUMIGSlice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUMIGSlice_To_platform_GPUMIGSlice(a.(*GPUMIGSlice), b.(*platform.GPUMIGSlice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineAddress)(nil), (*MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineAddress_To_v1_MachineAddress(a.(*platform.MachineAddress), b.(*MachineAddress), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUMIGSlice)(nil), (*GPUMIGSlice)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUMIGSlice_To_v1_GPUMIGSlice(a.(*platform.GPUMIGSlice), b.(*GPUMIGSlice), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineCondition)(nil), (*platform.MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineCondition_To_platform_MachineCondition(a.(*MachineCondition), b.(*platform.MachineCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUManagerFeature)(nil), (*platform.GPUManagerFeature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUManagerFeature_To_platform_GPUManagerFeature(a.(*GPUManagerFeature), b.(*platform.GPUManagerFeature), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineCondition)(nil), (*MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineCondition_To_v1_MachineCondition(a.(*platform.MachineCondition), b.(*MachineCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUManagerFeature)(nil), (*GPUManagerFeature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUManagerFeature_To_v1_GPUManagerFeature(a.(*platform.GPUManagerFeature), b.(*GPUManagerFeature), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*platform.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineList_To_platform_MachineList(a.(*MachineList), b.(*platform.MachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUNodeConfig)(nil), (*platform.GPUNodeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUNodeConfig_To_platform_GPUNodeConfig(a.(*GPUNodeConfig), b.(*platform.GPUNodeConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineList)(nil), (*MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineList_To_v1_MachineList(a.(*platform.MachineList), b.(*MachineList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUNodeConfig)(nil), (*GPUNodeConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUNodeConfig_To_v1_GPUNodeConfig(a.(*platform.GPUNodeConfig), b.(*GPUNodeConfig), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineSpec)(nil), (*platform.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineSpec_To_platform_MachineSpec(a.(*MachineSpec), b.(*platform.MachineSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUNodeConfigList)(nil), (*platform.GPUNodeConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUNodeConfigList_To_platform_GPUNodeConfigList(a.(*GPUNodeConfigList), b.(*platform.GPUNodeConfigList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineSpec)(nil), (*MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineSpec_To_v1_MachineSpec(a.(*platform.MachineSpec), b.(*MachineSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUNodeConfigList)(nil), (*GPUNodeConfigList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUNodeConfigList_To_v1_GPUNodeConfigList(a.(*platform.GPUNodeConfigList), b.(*GPUNodeConfigList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineStatus)(nil), (*platform.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineStatus_To_platform_MachineStatus(a.(*MachineStatus), b.(*platform.MachineStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUNodeConfigSpec)(nil), (*platform.GPUNodeConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec(a.(*GPUNodeConfigSpec), b.(*platform.GPUNodeConfigSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineStatus)(nil), (*MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineStatus_To_v1_MachineStatus(a.(*platform.MachineStatus), b.(*MachineStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUNodeConfigSpec)(nil), (*GPUNodeConfigSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec(a.(*platform.GPUNodeConfigSpec), b.(*GPUNodeConfigSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*MachineSystemInfo)(nil), (*platform.MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(a.(*MachineSystemInfo), b.(*platform.MachineSystemInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*GPUNodeConfigStatus)(nil), (*platform.GPUNodeConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus(a.(*GPUNodeConfigStatus), b.(*platform.GPUNodeConfigStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.MachineSystemInfo)(nil), (*MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(a.(*platform.MachineSystemInfo), b.(*MachineSystemInfo), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GPUNodeConfigStatus)(nil), (*GPUNodeConfigStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus(a.(*platform.GPUNodeConfigStatus), b.(*GPUNodeConfigStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PVCRProxyOptions)(nil), (*platform.PVCRProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PVCRProxyOptions_To_platform_PVCRProxyOptions(a.(*PVCRProxyOptions), b.(*platform.PVCRProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*GalaxyNetwork)(nil), (*platform.GalaxyNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GalaxyNetwork_To_platform_GalaxyNetwork(a.(*GalaxyNetwork), b.(*platform.GalaxyNetwork), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PVCRProxyOptions)(nil), (*PVCRProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PVCRProxyOptions_To_v1_PVCRProxyOptions(a.(*platform.PVCRProxyOptions), b.(*PVCRProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.GalaxyNetwork)(nil), (*GalaxyNetwork)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_GalaxyNetwork_To_v1_GalaxyNetwork(a.(*platform.GalaxyNetwork), b.(*GalaxyNetwork), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentBackEnd)(nil), (*platform.PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(a.(*PersistentBackEnd), b.(*platform.PersistentBackEnd), scope)
+	if err := s.AddGeneratedConversionFunc((*HA)(nil), (*platform.HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HA_To_platform_HA(a.(*HA), b.(*platform.HA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentBackEnd)(nil), (*PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentBackEnd_To_v1_PersistentBackEnd(a.(*platform.PersistentBackEnd), b.(*PersistentBackEnd), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HA)(nil), (*HA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HA_To_v1_HA(a.(*platform.HA), b.(*HA), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEvent)(nil), (*platform.PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEvent_To_platform_PersistentEvent(a.(*PersistentEvent), b.(*platform.PersistentEvent), scope)
+	if err := s.AddGeneratedConversionFunc((*Helm)(nil), (*platform.Helm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Helm_To_platform_Helm(a.(*Helm), b.(*platform.Helm), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEvent)(nil), (*PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEvent_To_v1_PersistentEvent(a.(*platform.PersistentEvent), b.(*PersistentEvent), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.Helm)(nil), (*Helm)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Helm_To_v1_Helm(a.(*platform.Helm), b.(*Helm), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventList)(nil), (*platform.PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventList_To_platform_PersistentEventList(a.(*PersistentEventList), b.(*platform.PersistentEventList), scope)
+	if err := s.AddGeneratedConversionFunc((*HelmList)(nil), (*platform.HelmList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HelmList_To_platform_HelmList(a.(*HelmList), b.(*platform.HelmList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventList)(nil), (*PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventList_To_v1_PersistentEventList(a.(*platform.PersistentEventList), b.(*PersistentEventList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HelmList)(nil), (*HelmList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HelmList_To_v1_HelmList(a.(*platform.HelmList), b.(*HelmList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventSpec)(nil), (*platform.PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventSpec_To_platform_PersistentEventSpec(a.(*PersistentEventSpec), b.(*platform.PersistentEventSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*HelmProxyOptions)(nil), (*platform.HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(a.(*HelmProxyOptions), b.(*platform.HelmProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventSpec)(nil), (*PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(a.(*platform.PersistentEventSpec), b.(*PersistentEventSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HelmProxyOptions)(nil), (*HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(a.(*platform.HelmProxyOptions), b.(*HelmProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PersistentEventStatus)(nil), (*platform.PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(a.(*PersistentEventStatus), b.(*platform.PersistentEventStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*HelmSpec)(nil), (*platform.HelmSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HelmSpec_To_platform_HelmSpec(a.(*HelmSpec), b.(*platform.HelmSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventStatus)(nil), (*PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(a.(*platform.PersistentEventStatus), b.(*PersistentEventStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HelmSpec)(nil), (*HelmSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HelmSpec_To_v1_HelmSpec(a.(*platform.HelmSpec), b.(*HelmSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*platform.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Prometheus_To_platform_Prometheus(a.(*Prometheus), b.(*platform.Prometheus), scope)
+	if err := s.AddGeneratedConversionFunc((*HelmStatus)(nil), (*platform.HelmStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_HelmStatus_To_platform_HelmStatus(a.(*HelmStatus), b.(*platform.HelmStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Prometheus)(nil), (*Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Prometheus_To_v1_Prometheus(a.(*platform.Prometheus), b.(*Prometheus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.HelmStatus)(nil), (*HelmStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_HelmStatus_To_v1_HelmStatus(a.(*platform.HelmStatus), b.(*HelmStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PrometheusList)(nil), (*platform.PrometheusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PrometheusList_To_platform_PrometheusList(a.(*PrometheusList), b.(*platform.PrometheusList), scope)
+	if err := s.AddGeneratedConversionFunc((*IPAM)(nil), (*platform.IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IPAM_To_platform_IPAM(a.(*IPAM), b.(*platform.IPAM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PrometheusList)(nil), (*PrometheusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PrometheusList_To_v1_PrometheusList(a.(*platform.PrometheusList), b.(*PrometheusList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.IPAM)(nil), (*IPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_IPAM_To_v1_IPAM(a.(*platform.IPAM), b.(*IPAM), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PrometheusRemoteAddr)(nil), (*platform.PrometheusRemoteAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PrometheusRemoteAddr_To_platform_PrometheusRemoteAddr(a.(*PrometheusRemoteAddr), b.(*platform.PrometheusRemoteAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*IPAMList)(nil), (*platform.IPAMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IPAMList_To_platform_IPAMList(a.(*IPAMList), b.(*platform.IPAMList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PrometheusRemoteAddr)(nil), (*PrometheusRemoteAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PrometheusRemoteAddr_To_v1_PrometheusRemoteAddr(a.(*platform.PrometheusRemoteAddr), b.(*PrometheusRemoteAddr), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.IPAMList)(nil), (*IPAMList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_IPAMList_To_v1_IPAMList(a.(*platform.IPAMList), b.(*IPAMList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PrometheusSpec)(nil), (*platform.PrometheusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PrometheusSpec_To_platform_PrometheusSpec(a.(*PrometheusSpec), b.(*platform.PrometheusSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*IPAMProxyOptions)(nil), (*platform.IPAMProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(a.(*IPAMProxyOptions), b.(*platform.IPAMProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PrometheusSpec)(nil), (*PrometheusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PrometheusSpec_To_v1_PrometheusSpec(a.(*platform.PrometheusSpec), b.(*PrometheusSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.IPAMProxyOptions)(nil), (*IPAMProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(a.(*platform.IPAMProxyOptions), b.(*IPAMProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*PrometheusStatus)(nil), (*platform.PrometheusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PrometheusStatus_To_platform_PrometheusStatus(a.(*PrometheusStatus), b.(*platform.PrometheusStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*IPAMSpec)(nil), (*platform.IPAMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IPAMSpec_To_platform_IPAMSpec(a.(*IPAMSpec), b.(*platform.IPAMSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.PrometheusStatus)(nil), (*PrometheusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_PrometheusStatus_To_v1_PrometheusStatus(a.(*platform.PrometheusStatus), b.(*PrometheusStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.IPAMSpec)(nil), (*IPAMSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_IPAMSpec_To_v1_IPAMSpec(a.(*platform.IPAMSpec), b.(*IPAMSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Registry)(nil), (*platform.Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Registry_To_platform_Registry(a.(*Registry), b.(*platform.Registry), scope)
+	if err := s.AddGeneratedConversionFunc((*IPAMStatus)(nil), (*platform.IPAMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IPAMStatus_To_platform_IPAMStatus(a.(*IPAMStatus), b.(*platform.IPAMStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Registry)(nil), (*Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Registry_To_v1_Registry(a.(*platform.Registry), b.(*Registry), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.IPAMStatus)(nil), (*IPAMStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_IPAMStatus_To_v1_IPAMStatus(a.(*platform.IPAMStatus), b.(*IPAMStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RegistryList)(nil), (*platform.RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_RegistryList_To_platform_RegistryList(a.(*RegistryList), b.(*platform.RegistryList), scope)
+	if err := s.AddGeneratedConversionFunc((*ImageAdmission)(nil), (*platform.ImageAdmission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ImageAdmission_To_platform_ImageAdmission(a.(*ImageAdmission), b.(*platform.ImageAdmission), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.RegistryList)(nil), (*RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_RegistryList_To_v1_RegistryList(a.(*platform.RegistryList), b.(*RegistryList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.ImageAdmission)(nil), (*ImageAdmission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ImageAdmission_To_v1_ImageAdmission(a.(*platform.ImageAdmission), b.(*ImageAdmission), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*RegistrySpec)(nil), (*platform.RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_RegistrySpec_To_platform_RegistrySpec(a.(*RegistrySpec), b.(*platform.RegistrySpec), scope)
+	if err := s.AddGeneratedConversionFunc((*KMSPlugin)(nil), (*platform.KMSPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KMSPlugin_To_platform_KMSPlugin(a.(*KMSPlugin), b.(*platform.KMSPlugin), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.RegistrySpec)(nil), (*RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_RegistrySpec_To_v1_RegistrySpec(a.(*platform.RegistrySpec), b.(*RegistrySpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.KMSPlugin)(nil), (*KMSPlugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_KMSPlugin_To_v1_KMSPlugin(a.(*platform.KMSPlugin), b.(*KMSPlugin), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ResourceRequirements)(nil), (*platform.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ResourceRequirements_To_platform_ResourceRequirements(a.(*ResourceRequirements), b.(*platform.ResourceRequirements), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeProxy)(nil), (*platform.KubeProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KubeProxy_To_platform_KubeProxy(a.(*KubeProxy), b.(*platform.KubeProxy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ResourceRequirements)(nil), (*ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(a.(*platform.ResourceRequirements), b.(*ResourceRequirements), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.KubeProxy)(nil), (*KubeProxy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_KubeProxy_To_v1_KubeProxy(a.(*platform.KubeProxy), b.(*KubeProxy), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageBackEndCLS)(nil), (*platform.StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(a.(*StorageBackEndCLS), b.(*platform.StorageBackEndCLS), scope)
+	if err := s.AddGeneratedConversionFunc((*KubeletConfigurationOverrides)(nil), (*platform.KubeletConfigurationOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KubeletConfigurationOverrides_To_platform_KubeletConfigurationOverrides(a.(*KubeletConfigurationOverrides), b.(*platform.KubeletConfigurationOverrides), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndCLS)(nil), (*StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_StorageBackEndCLS_To_v1_StorageBackEndCLS(a.(*platform.StorageBackEndCLS), b.(*StorageBackEndCLS), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.KubeletConfigurationOverrides)(nil), (*KubeletConfigurationOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_KubeletConfigurationOverrides_To_v1_KubeletConfigurationOverrides(a.(*platform.KubeletConfigurationOverrides), b.(*KubeletConfigurationOverrides), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*StorageBackEndES)(nil), (*platform.StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_StorageBackEndES_To_platform_StorageBackEndES(a.(*StorageBackEndES), b.(*platform.StorageBackEndES), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCF)(nil), (*platform.LBCF)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCF_To_platform_LBCF(a.(*LBCF), b.(*platform.LBCF), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndES)(nil), (*StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_StorageBackEndES_To_v1_StorageBackEndES(a.(*platform.StorageBackEndES), b.(*StorageBackEndES), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCF)(nil), (*LBCF)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCF_To_v1_LBCF(a.(*platform.LBCF), b.(*LBCF), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TKEHA)(nil), (*platform.TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TKEHA_To_platform_TKEHA(a.(*TKEHA), b.(*platform.TKEHA), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCFHealthCheck)(nil), (*platform.LBCFHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCFHealthCheck_To_platform_LBCFHealthCheck(a.(*LBCFHealthCheck), b.(*platform.LBCFHealthCheck), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TKEHA)(nil), (*TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TKEHA_To_v1_TKEHA(a.(*platform.TKEHA), b.(*TKEHA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCFHealthCheck)(nil), (*LBCFHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCFHealthCheck_To_v1_LBCFHealthCheck(a.(*platform.LBCFHealthCheck), b.(*LBCFHealthCheck), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappController)(nil), (*platform.TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappController_To_platform_TappController(a.(*TappController), b.(*platform.TappController), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCFList)(nil), (*platform.LBCFList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCFList_To_platform_LBCFList(a.(*LBCFList), b.(*platform.LBCFList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappController)(nil), (*TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappController_To_v1_TappController(a.(*platform.TappController), b.(*TappController), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCFList)(nil), (*LBCFList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCFList_To_v1_LBCFList(a.(*platform.LBCFList), b.(*LBCFList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerList)(nil), (*platform.TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerList_To_platform_TappControllerList(a.(*TappControllerList), b.(*platform.TappControllerList), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCFProxyOptions)(nil), (*platform.LBCFProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(a.(*LBCFProxyOptions), b.(*platform.LBCFProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerList)(nil), (*TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerList_To_v1_TappControllerList(a.(*platform.TappControllerList), b.(*TappControllerList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCFProxyOptions)(nil), (*LBCFProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(a.(*platform.LBCFProxyOptions), b.(*LBCFProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerProxyOptions)(nil), (*platform.TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerProxyOptions_To_platform_TappControllerProxyOptions(a.(*TappControllerProxyOptions), b.(*platform.TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCFSpec)(nil), (*platform.LBCFSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCFSpec_To_platform_LBCFSpec(a.(*LBCFSpec), b.(*platform.LBCFSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerProxyOptions)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerProxyOptions_To_v1_TappControllerProxyOptions(a.(*platform.TappControllerProxyOptions), b.(*TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCFSpec)(nil), (*LBCFSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCFSpec_To_v1_LBCFSpec(a.(*platform.LBCFSpec), b.(*LBCFSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerSpec)(nil), (*platform.TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerSpec_To_platform_TappControllerSpec(a.(*TappControllerSpec), b.(*platform.TappControllerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*LBCFStatus)(nil), (*platform.LBCFStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LBCFStatus_To_platform_LBCFStatus(a.(*LBCFStatus), b.(*platform.LBCFStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerSpec)(nil), (*TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerSpec_To_v1_TappControllerSpec(a.(*platform.TappControllerSpec), b.(*TappControllerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LBCFStatus)(nil), (*LBCFStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LBCFStatus_To_v1_LBCFStatus(a.(*platform.LBCFStatus), b.(*LBCFStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TappControllerStatus)(nil), (*platform.TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_TappControllerStatus_To_platform_TappControllerStatus(a.(*TappControllerStatus), b.(*platform.TappControllerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*License)(nil), (*platform.License)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_License_To_platform_License(a.(*License), b.(*platform.License), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.TappControllerStatus)(nil), (*TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_TappControllerStatus_To_v1_TappControllerStatus(a.(*platform.TappControllerStatus), b.(*TappControllerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.License)(nil), (*License)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_License_To_v1_License(a.(*platform.License), b.(*License), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*ThirdPartyHA)(nil), (*platform.ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_ThirdPartyHA_To_platform_ThirdPartyHA(a.(*ThirdPartyHA), b.(*platform.ThirdPartyHA), scope)
+	if err := s.AddGeneratedConversionFunc((*LicenseList)(nil), (*platform.LicenseList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LicenseList_To_platform_LicenseList(a.(*LicenseList), b.(*platform.LicenseList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.ThirdPartyHA)(nil), (*ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(a.(*platform.ThirdPartyHA), b.(*ThirdPartyHA), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LicenseList)(nil), (*LicenseList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LicenseList_To_v1_LicenseList(a.(*platform.LicenseList), b.(*LicenseList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Upgrade)(nil), (*platform.Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Upgrade_To_platform_Upgrade(a.(*Upgrade), b.(*platform.Upgrade), scope)
+	if err := s.AddGeneratedConversionFunc((*LicenseNotify)(nil), (*platform.LicenseNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LicenseNotify_To_platform_LicenseNotify(a.(*LicenseNotify), b.(*platform.LicenseNotify), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.Upgrade)(nil), (*Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_Upgrade_To_v1_Upgrade(a.(*platform.Upgrade), b.(*Upgrade), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LicenseNotify)(nil), (*LicenseNotify)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LicenseNotify_To_v1_LicenseNotify(a.(*platform.LicenseNotify), b.(*LicenseNotify), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*UpgradeStrategy)(nil), (*platform.UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(a.(*UpgradeStrategy), b.(*platform.UpgradeStrategy), scope)
+	if err := s.AddGeneratedConversionFunc((*LicenseSpec)(nil), (*platform.LicenseSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LicenseSpec_To_platform_LicenseSpec(a.(*LicenseSpec), b.(*platform.LicenseSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.UpgradeStrategy)(nil), (*UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(a.(*platform.UpgradeStrategy), b.(*UpgradeStrategy), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LicenseSpec)(nil), (*LicenseSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LicenseSpec_To_v1_LicenseSpec(a.(*platform.LicenseSpec), b.(*LicenseSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VolumeDecorator)(nil), (*platform.VolumeDecorator)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VolumeDecorator_To_platform_VolumeDecorator(a.(*VolumeDecorator), b.(*platform.VolumeDecorator), scope)
+	if err := s.AddGeneratedConversionFunc((*LicenseStatus)(nil), (*platform.LicenseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LicenseStatus_To_platform_LicenseStatus(a.(*LicenseStatus), b.(*platform.LicenseStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecorator)(nil), (*VolumeDecorator)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_VolumeDecorator_To_v1_VolumeDecorator(a.(*platform.VolumeDecorator), b.(*VolumeDecorator), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LicenseStatus)(nil), (*LicenseStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LicenseStatus_To_v1_LicenseStatus(a.(*platform.LicenseStatus), b.(*LicenseStatus), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorList)(nil), (*platform.VolumeDecoratorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VolumeDecoratorList_To_platform_VolumeDecoratorList(a.(*VolumeDecoratorList), b.(*platform.VolumeDecoratorList), scope)
+	if err := s.AddGeneratedConversionFunc((*LocalEtcd)(nil), (*platform.LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LocalEtcd_To_platform_LocalEtcd(a.(*LocalEtcd), b.(*platform.LocalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorList)(nil), (*VolumeDecoratorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_VolumeDecoratorList_To_v1_VolumeDecoratorList(a.(*platform.VolumeDecoratorList), b.(*VolumeDecoratorList), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LocalEtcd)(nil), (*LocalEtcd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LocalEtcd_To_v1_LocalEtcd(a.(*platform.LocalEtcd), b.(*LocalEtcd), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorSpec)(nil), (*platform.VolumeDecoratorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VolumeDecoratorSpec_To_platform_VolumeDecoratorSpec(a.(*VolumeDecoratorSpec), b.(*platform.VolumeDecoratorSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*LogCollector)(nil), (*platform.LogCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LogCollector_To_platform_LogCollector(a.(*LogCollector), b.(*platform.LogCollector), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorSpec)(nil), (*VolumeDecoratorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_VolumeDecoratorSpec_To_v1_VolumeDecoratorSpec(a.(*platform.VolumeDecoratorSpec), b.(*VolumeDecoratorSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LogCollector)(nil), (*LogCollector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LogCollector_To_v1_LogCollector(a.(*platform.LogCollector), b.(*LogCollector), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorStatus)(nil), (*platform.VolumeDecoratorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VolumeDecoratorStatus_To_platform_VolumeDecoratorStatus(a.(*VolumeDecoratorStatus), b.(*platform.VolumeDecoratorStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*LogCollectorList)(nil), (*platform.LogCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LogCollectorList_To_platform_LogCollectorList(a.(*LogCollectorList), b.(*platform.LogCollectorList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorStatus)(nil), (*VolumeDecoratorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(a.(*platform.VolumeDecoratorStatus), b.(*VolumeDecoratorStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorList)(nil), (*LogCollectorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LogCollectorList_To_v1_LogCollectorList(a.(*platform.LogCollectorList), b.(*LogCollectorList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_CronHPAProxyOptions(a.(*url.Values), b.(*CronHPAProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*LogCollectorProxyOptions)(nil), (*platform.LogCollectorProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(a.(*LogCollectorProxyOptions), b.(*platform.LogCollectorProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_HelmProxyOptions(a.(*url.Values), b.(*HelmProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorProxyOptions)(nil), (*LogCollectorProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(a.(*platform.LogCollectorProxyOptions), b.(*LogCollectorProxyOptions), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_url_Values_To_v1_TappControllerProxyOptions(a.(*url.Values), b.(*TappControllerProxyOptions), scope)
+	if err := s.AddGeneratedConversionFunc((*LogCollectorSpec)(nil), (*platform.LogCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(a.(*LogCollectorSpec), b.(*platform.LogCollectorSpec), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorSpec)(nil), (*LogCollectorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(a.(*platform.LogCollectorSpec), b.(*LogCollectorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LogCollectorStatus)(nil), (*platform.LogCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(a.(*LogCollectorStatus), b.(*platform.LogCollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.LogCollectorStatus)(nil), (*LogCollectorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(a.(*platform.LogCollectorStatus), b.(*LogCollectorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Machine)(nil), (*platform.Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Machine_To_platform_Machine(a.(*Machine), b.(*platform.Machine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Machine)(nil), (*Machine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Machine_To_v1_Machine(a.(*platform.Machine), b.(*Machine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineAddress)(nil), (*platform.MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineAddress_To_platform_MachineAddress(a.(*MachineAddress), b.(*platform.MachineAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineAddress)(nil), (*MachineAddress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineAddress_To_v1_MachineAddress(a.(*platform.MachineAddress), b.(*MachineAddress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineCondition)(nil), (*platform.MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineCondition_To_platform_MachineCondition(a.(*MachineCondition), b.(*platform.MachineCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineCondition)(nil), (*MachineCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineCondition_To_v1_MachineCondition(a.(*platform.MachineCondition), b.(*MachineCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineList)(nil), (*platform.MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineList_To_platform_MachineList(a.(*MachineList), b.(*platform.MachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineList)(nil), (*MachineList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineList_To_v1_MachineList(a.(*platform.MachineList), b.(*MachineList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSpec)(nil), (*platform.MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineSpec_To_platform_MachineSpec(a.(*MachineSpec), b.(*platform.MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineSpec)(nil), (*MachineSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineSpec_To_v1_MachineSpec(a.(*platform.MachineSpec), b.(*MachineSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineStatus)(nil), (*platform.MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineStatus_To_platform_MachineStatus(a.(*MachineStatus), b.(*platform.MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineStatus)(nil), (*MachineStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineStatus_To_v1_MachineStatus(a.(*platform.MachineStatus), b.(*MachineStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineSystemInfo)(nil), (*platform.MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(a.(*MachineSystemInfo), b.(*platform.MachineSystemInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineSystemInfo)(nil), (*MachineSystemInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(a.(*platform.MachineSystemInfo), b.(*MachineSystemInfo), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MachineUpgradeBackup)(nil), (*platform.MachineUpgradeBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MachineUpgradeBackup_To_platform_MachineUpgradeBackup(a.(*MachineUpgradeBackup), b.(*platform.MachineUpgradeBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MachineUpgradeBackup)(nil), (*MachineUpgradeBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MachineUpgradeBackup_To_v1_MachineUpgradeBackup(a.(*platform.MachineUpgradeBackup), b.(*MachineUpgradeBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLB)(nil), (*platform.MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLB_To_platform_MetalLB(a.(*MetalLB), b.(*platform.MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLB)(nil), (*MetalLB)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLB_To_v1_MetalLB(a.(*platform.MetalLB), b.(*MetalLB), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBAddressPool)(nil), (*platform.MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(a.(*MetalLBAddressPool), b.(*platform.MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBAddressPool)(nil), (*MetalLBAddressPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(a.(*platform.MetalLBAddressPool), b.(*MetalLBAddressPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBList)(nil), (*platform.MetalLBList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBList_To_platform_MetalLBList(a.(*MetalLBList), b.(*platform.MetalLBList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBList)(nil), (*MetalLBList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBList_To_v1_MetalLBList(a.(*platform.MetalLBList), b.(*MetalLBList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBPeer)(nil), (*platform.MetalLBPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBPeer_To_platform_MetalLBPeer(a.(*MetalLBPeer), b.(*platform.MetalLBPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBPeer)(nil), (*MetalLBPeer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBPeer_To_v1_MetalLBPeer(a.(*platform.MetalLBPeer), b.(*MetalLBPeer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBSpec)(nil), (*platform.MetalLBSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBSpec_To_platform_MetalLBSpec(a.(*MetalLBSpec), b.(*platform.MetalLBSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBSpec)(nil), (*MetalLBSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBSpec_To_v1_MetalLBSpec(a.(*platform.MetalLBSpec), b.(*MetalLBSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MetalLBStatus)(nil), (*platform.MetalLBStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MetalLBStatus_To_platform_MetalLBStatus(a.(*MetalLBStatus), b.(*platform.MetalLBStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MetalLBStatus)(nil), (*MetalLBStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MetalLBStatus_To_v1_MetalLBStatus(a.(*platform.MetalLBStatus), b.(*MetalLBStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Multus)(nil), (*platform.Multus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Multus_To_platform_Multus(a.(*Multus), b.(*platform.Multus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Multus)(nil), (*Multus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Multus_To_v1_Multus(a.(*platform.Multus), b.(*Multus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusIPAM)(nil), (*platform.MultusIPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusIPAM_To_platform_MultusIPAM(a.(*MultusIPAM), b.(*platform.MultusIPAM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusIPAM)(nil), (*MultusIPAM)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusIPAM_To_v1_MultusIPAM(a.(*platform.MultusIPAM), b.(*MultusIPAM), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusList)(nil), (*platform.MultusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusList_To_platform_MultusList(a.(*MultusList), b.(*platform.MultusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusList)(nil), (*MultusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusList_To_v1_MultusList(a.(*platform.MultusList), b.(*MultusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusNetworkAttachment)(nil), (*platform.MultusNetworkAttachment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusNetworkAttachment_To_platform_MultusNetworkAttachment(a.(*MultusNetworkAttachment), b.(*platform.MultusNetworkAttachment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusNetworkAttachment)(nil), (*MultusNetworkAttachment)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusNetworkAttachment_To_v1_MultusNetworkAttachment(a.(*platform.MultusNetworkAttachment), b.(*MultusNetworkAttachment), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusSRIOV)(nil), (*platform.MultusSRIOV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusSRIOV_To_platform_MultusSRIOV(a.(*MultusSRIOV), b.(*platform.MultusSRIOV), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusSRIOV)(nil), (*MultusSRIOV)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusSRIOV_To_v1_MultusSRIOV(a.(*platform.MultusSRIOV), b.(*MultusSRIOV), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusSRIOVResource)(nil), (*platform.MultusSRIOVResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusSRIOVResource_To_platform_MultusSRIOVResource(a.(*MultusSRIOVResource), b.(*platform.MultusSRIOVResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusSRIOVResource)(nil), (*MultusSRIOVResource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusSRIOVResource_To_v1_MultusSRIOVResource(a.(*platform.MultusSRIOVResource), b.(*MultusSRIOVResource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusSpec)(nil), (*platform.MultusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusSpec_To_platform_MultusSpec(a.(*MultusSpec), b.(*platform.MultusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusSpec)(nil), (*MultusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusSpec_To_v1_MultusSpec(a.(*platform.MultusSpec), b.(*MultusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MultusStatus)(nil), (*platform.MultusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_MultusStatus_To_platform_MultusStatus(a.(*MultusStatus), b.(*platform.MultusStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.MultusStatus)(nil), (*MultusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_MultusStatus_To_v1_MultusStatus(a.(*platform.MultusStatus), b.(*MultusStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkEncryption)(nil), (*platform.NetworkEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkEncryption_To_platform_NetworkEncryption(a.(*NetworkEncryption), b.(*platform.NetworkEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.NetworkEncryption)(nil), (*NetworkEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_NetworkEncryption_To_v1_NetworkEncryption(a.(*platform.NetworkEncryption), b.(*NetworkEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyFeature)(nil), (*platform.NetworkPolicyFeature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkPolicyFeature_To_platform_NetworkPolicyFeature(a.(*NetworkPolicyFeature), b.(*platform.NetworkPolicyFeature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.NetworkPolicyFeature)(nil), (*NetworkPolicyFeature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_NetworkPolicyFeature_To_v1_NetworkPolicyFeature(a.(*platform.NetworkPolicyFeature), b.(*NetworkPolicyFeature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NetworkPolicyStatus)(nil), (*platform.NetworkPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NetworkPolicyStatus_To_platform_NetworkPolicyStatus(a.(*NetworkPolicyStatus), b.(*platform.NetworkPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.NetworkPolicyStatus)(nil), (*NetworkPolicyStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_NetworkPolicyStatus_To_v1_NetworkPolicyStatus(a.(*platform.NetworkPolicyStatus), b.(*NetworkPolicyStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PVCRProxyOptions)(nil), (*platform.PVCRProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PVCRProxyOptions_To_platform_PVCRProxyOptions(a.(*PVCRProxyOptions), b.(*platform.PVCRProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PVCRProxyOptions)(nil), (*PVCRProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PVCRProxyOptions_To_v1_PVCRProxyOptions(a.(*platform.PVCRProxyOptions), b.(*PVCRProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentBackEnd)(nil), (*platform.PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(a.(*PersistentBackEnd), b.(*platform.PersistentBackEnd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentBackEnd)(nil), (*PersistentBackEnd)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentBackEnd_To_v1_PersistentBackEnd(a.(*platform.PersistentBackEnd), b.(*PersistentBackEnd), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEvent)(nil), (*platform.PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEvent_To_platform_PersistentEvent(a.(*PersistentEvent), b.(*platform.PersistentEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEvent)(nil), (*PersistentEvent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEvent_To_v1_PersistentEvent(a.(*platform.PersistentEvent), b.(*PersistentEvent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventBuffer)(nil), (*platform.PersistentEventBuffer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventBuffer_To_platform_PersistentEventBuffer(a.(*PersistentEventBuffer), b.(*platform.PersistentEventBuffer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventBuffer)(nil), (*PersistentEventBuffer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventBuffer_To_v1_PersistentEventBuffer(a.(*platform.PersistentEventBuffer), b.(*PersistentEventBuffer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventList)(nil), (*platform.PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventList_To_platform_PersistentEventList(a.(*PersistentEventList), b.(*platform.PersistentEventList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventList)(nil), (*PersistentEventList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventList_To_v1_PersistentEventList(a.(*platform.PersistentEventList), b.(*PersistentEventList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventSpec)(nil), (*platform.PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventSpec_To_platform_PersistentEventSpec(a.(*PersistentEventSpec), b.(*platform.PersistentEventSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventSpec)(nil), (*PersistentEventSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(a.(*platform.PersistentEventSpec), b.(*PersistentEventSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentEventStatus)(nil), (*platform.PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(a.(*PersistentEventStatus), b.(*platform.PersistentEventStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentEventStatus)(nil), (*PersistentEventStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(a.(*platform.PersistentEventStatus), b.(*PersistentEventStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PersistentVolumeClaimUsage)(nil), (*platform.PersistentVolumeClaimUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PersistentVolumeClaimUsage_To_platform_PersistentVolumeClaimUsage(a.(*PersistentVolumeClaimUsage), b.(*platform.PersistentVolumeClaimUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PersistentVolumeClaimUsage)(nil), (*PersistentVolumeClaimUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PersistentVolumeClaimUsage_To_v1_PersistentVolumeClaimUsage(a.(*platform.PersistentVolumeClaimUsage), b.(*PersistentVolumeClaimUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PhaseProgress)(nil), (*platform.PhaseProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PhaseProgress_To_platform_PhaseProgress(a.(*PhaseProgress), b.(*platform.PhaseProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PhaseProgress)(nil), (*PhaseProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PhaseProgress_To_v1_PhaseProgress(a.(*platform.PhaseProgress), b.(*PhaseProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Progress)(nil), (*platform.Progress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Progress_To_platform_Progress(a.(*Progress), b.(*platform.Progress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Progress)(nil), (*Progress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Progress_To_v1_Progress(a.(*platform.Progress), b.(*Progress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectImageAdmission)(nil), (*platform.ProjectImageAdmission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ProjectImageAdmission_To_platform_ProjectImageAdmission(a.(*ProjectImageAdmission), b.(*platform.ProjectImageAdmission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ProjectImageAdmission)(nil), (*ProjectImageAdmission)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ProjectImageAdmission_To_v1_ProjectImageAdmission(a.(*platform.ProjectImageAdmission), b.(*ProjectImageAdmission), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Prometheus)(nil), (*platform.Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Prometheus_To_platform_Prometheus(a.(*Prometheus), b.(*platform.Prometheus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Prometheus)(nil), (*Prometheus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Prometheus_To_v1_Prometheus(a.(*platform.Prometheus), b.(*Prometheus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusBasicAuth)(nil), (*platform.PrometheusBasicAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusBasicAuth_To_platform_PrometheusBasicAuth(a.(*PrometheusBasicAuth), b.(*platform.PrometheusBasicAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusBasicAuth)(nil), (*PrometheusBasicAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusBasicAuth_To_v1_PrometheusBasicAuth(a.(*platform.PrometheusBasicAuth), b.(*PrometheusBasicAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusList)(nil), (*platform.PrometheusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusList_To_platform_PrometheusList(a.(*PrometheusList), b.(*platform.PrometheusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusList)(nil), (*PrometheusList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusList_To_v1_PrometheusList(a.(*platform.PrometheusList), b.(*PrometheusList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusRemoteAddr)(nil), (*platform.PrometheusRemoteAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusRemoteAddr_To_platform_PrometheusRemoteAddr(a.(*PrometheusRemoteAddr), b.(*platform.PrometheusRemoteAddr), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusRemoteAddr)(nil), (*PrometheusRemoteAddr)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusRemoteAddr_To_v1_PrometheusRemoteAddr(a.(*platform.PrometheusRemoteAddr), b.(*PrometheusRemoteAddr), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusRemoteWrite)(nil), (*platform.PrometheusRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusRemoteWrite_To_platform_PrometheusRemoteWrite(a.(*PrometheusRemoteWrite), b.(*platform.PrometheusRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusRemoteWrite)(nil), (*PrometheusRemoteWrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusRemoteWrite_To_v1_PrometheusRemoteWrite(a.(*platform.PrometheusRemoteWrite), b.(*PrometheusRemoteWrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusSpec)(nil), (*platform.PrometheusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusSpec_To_platform_PrometheusSpec(a.(*PrometheusSpec), b.(*platform.PrometheusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusSpec)(nil), (*PrometheusSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusSpec_To_v1_PrometheusSpec(a.(*platform.PrometheusSpec), b.(*PrometheusSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusStatus)(nil), (*platform.PrometheusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusStatus_To_platform_PrometheusStatus(a.(*PrometheusStatus), b.(*platform.PrometheusStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusStatus)(nil), (*PrometheusStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusStatus_To_v1_PrometheusStatus(a.(*platform.PrometheusStatus), b.(*PrometheusStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrometheusTLSConfig)(nil), (*platform.PrometheusTLSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrometheusTLSConfig_To_platform_PrometheusTLSConfig(a.(*PrometheusTLSConfig), b.(*platform.PrometheusTLSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.PrometheusTLSConfig)(nil), (*PrometheusTLSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_PrometheusTLSConfig_To_v1_PrometheusTLSConfig(a.(*platform.PrometheusTLSConfig), b.(*PrometheusTLSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Registry)(nil), (*platform.Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Registry_To_platform_Registry(a.(*Registry), b.(*platform.Registry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Registry)(nil), (*Registry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Registry_To_v1_Registry(a.(*platform.Registry), b.(*Registry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryAuth)(nil), (*platform.RegistryAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistryAuth_To_platform_RegistryAuth(a.(*RegistryAuth), b.(*platform.RegistryAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistryAuth)(nil), (*RegistryAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistryAuth_To_v1_RegistryAuth(a.(*platform.RegistryAuth), b.(*RegistryAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryList)(nil), (*platform.RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistryList_To_platform_RegistryList(a.(*RegistryList), b.(*platform.RegistryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistryList)(nil), (*RegistryList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistryList_To_v1_RegistryList(a.(*platform.RegistryList), b.(*RegistryList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistrySpec)(nil), (*platform.RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RegistrySpec_To_platform_RegistrySpec(a.(*RegistrySpec), b.(*platform.RegistrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.RegistrySpec)(nil), (*RegistrySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_RegistrySpec_To_v1_RegistrySpec(a.(*platform.RegistrySpec), b.(*RegistrySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ResourceRequirements)(nil), (*platform.ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ResourceRequirements_To_platform_ResourceRequirements(a.(*ResourceRequirements), b.(*platform.ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ResourceRequirements)(nil), (*ResourceRequirements)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(a.(*platform.ResourceRequirements), b.(*ResourceRequirements), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*S3Storage)(nil), (*platform.S3Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_S3Storage_To_platform_S3Storage(a.(*S3Storage), b.(*platform.S3Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.S3Storage)(nil), (*S3Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_S3Storage_To_v1_S3Storage(a.(*platform.S3Storage), b.(*S3Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SandboxRuntime)(nil), (*platform.SandboxRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SandboxRuntime_To_platform_SandboxRuntime(a.(*SandboxRuntime), b.(*platform.SandboxRuntime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SandboxRuntime)(nil), (*SandboxRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SandboxRuntime_To_v1_SandboxRuntime(a.(*platform.SandboxRuntime), b.(*SandboxRuntime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SecretsEncryption)(nil), (*platform.SecretsEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SecretsEncryption_To_platform_SecretsEncryption(a.(*SecretsEncryption), b.(*platform.SecretsEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SecretsEncryption)(nil), (*SecretsEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SecretsEncryption_To_v1_SecretsEncryption(a.(*platform.SecretsEncryption), b.(*SecretsEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceOverride)(nil), (*platform.ServiceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ServiceOverride_To_platform_ServiceOverride(a.(*ServiceOverride), b.(*platform.ServiceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ServiceOverride)(nil), (*ServiceOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ServiceOverride_To_v1_ServiceOverride(a.(*platform.ServiceOverride), b.(*ServiceOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ServiceOverrides)(nil), (*platform.ServiceOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ServiceOverrides_To_platform_ServiceOverrides(a.(*ServiceOverrides), b.(*platform.ServiceOverrides), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ServiceOverrides)(nil), (*ServiceOverrides)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ServiceOverrides_To_v1_ServiceOverrides(a.(*platform.ServiceOverrides), b.(*ServiceOverrides), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StaticPodOverride)(nil), (*platform.StaticPodOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StaticPodOverride_To_platform_StaticPodOverride(a.(*StaticPodOverride), b.(*platform.StaticPodOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StaticPodOverride)(nil), (*StaticPodOverride)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StaticPodOverride_To_v1_StaticPodOverride(a.(*platform.StaticPodOverride), b.(*StaticPodOverride), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndCLS)(nil), (*platform.StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(a.(*StorageBackEndCLS), b.(*platform.StorageBackEndCLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndCLS)(nil), (*StorageBackEndCLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndCLS_To_v1_StorageBackEndCLS(a.(*platform.StorageBackEndCLS), b.(*StorageBackEndCLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndES)(nil), (*platform.StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndES_To_platform_StorageBackEndES(a.(*StorageBackEndES), b.(*platform.StorageBackEndES), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndES)(nil), (*StorageBackEndES)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndES_To_v1_StorageBackEndES(a.(*platform.StorageBackEndES), b.(*StorageBackEndES), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndKafka)(nil), (*platform.StorageBackEndKafka)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndKafka_To_platform_StorageBackEndKafka(a.(*StorageBackEndKafka), b.(*platform.StorageBackEndKafka), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndKafka)(nil), (*StorageBackEndKafka)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndKafka_To_v1_StorageBackEndKafka(a.(*platform.StorageBackEndKafka), b.(*StorageBackEndKafka), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndLoki)(nil), (*platform.StorageBackEndLoki)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndLoki_To_platform_StorageBackEndLoki(a.(*StorageBackEndLoki), b.(*platform.StorageBackEndLoki), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndLoki)(nil), (*StorageBackEndLoki)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndLoki_To_v1_StorageBackEndLoki(a.(*platform.StorageBackEndLoki), b.(*StorageBackEndLoki), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndSASL)(nil), (*platform.StorageBackEndSASL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndSASL_To_platform_StorageBackEndSASL(a.(*StorageBackEndSASL), b.(*platform.StorageBackEndSASL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndSASL)(nil), (*StorageBackEndSASL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndSASL_To_v1_StorageBackEndSASL(a.(*platform.StorageBackEndSASL), b.(*StorageBackEndSASL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageBackEndTLS)(nil), (*platform.StorageBackEndTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StorageBackEndTLS_To_platform_StorageBackEndTLS(a.(*StorageBackEndTLS), b.(*platform.StorageBackEndTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.StorageBackEndTLS)(nil), (*StorageBackEndTLS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_StorageBackEndTLS_To_v1_StorageBackEndTLS(a.(*platform.StorageBackEndTLS), b.(*StorageBackEndTLS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SystemTuning)(nil), (*platform.SystemTuning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SystemTuning_To_platform_SystemTuning(a.(*SystemTuning), b.(*platform.SystemTuning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.SystemTuning)(nil), (*SystemTuning)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_SystemTuning_To_v1_SystemTuning(a.(*platform.SystemTuning), b.(*SystemTuning), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TKEHA)(nil), (*platform.TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TKEHA_To_platform_TKEHA(a.(*TKEHA), b.(*platform.TKEHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TKEHA)(nil), (*TKEHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TKEHA_To_v1_TKEHA(a.(*platform.TKEHA), b.(*TKEHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappController)(nil), (*platform.TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappController_To_platform_TappController(a.(*TappController), b.(*platform.TappController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappController)(nil), (*TappController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappController_To_v1_TappController(a.(*platform.TappController), b.(*TappController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerConfig)(nil), (*platform.TappControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerConfig_To_platform_TappControllerConfig(a.(*TappControllerConfig), b.(*platform.TappControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerConfig)(nil), (*TappControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerConfig_To_v1_TappControllerConfig(a.(*platform.TappControllerConfig), b.(*TappControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerList)(nil), (*platform.TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerList_To_platform_TappControllerList(a.(*TappControllerList), b.(*platform.TappControllerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerList)(nil), (*TappControllerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerList_To_v1_TappControllerList(a.(*platform.TappControllerList), b.(*TappControllerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerProxyOptions)(nil), (*platform.TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerProxyOptions_To_platform_TappControllerProxyOptions(a.(*TappControllerProxyOptions), b.(*platform.TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerProxyOptions)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerProxyOptions_To_v1_TappControllerProxyOptions(a.(*platform.TappControllerProxyOptions), b.(*TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerSpec)(nil), (*platform.TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerSpec_To_platform_TappControllerSpec(a.(*TappControllerSpec), b.(*platform.TappControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerSpec)(nil), (*TappControllerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerSpec_To_v1_TappControllerSpec(a.(*platform.TappControllerSpec), b.(*TappControllerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TappControllerStatus)(nil), (*platform.TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TappControllerStatus_To_platform_TappControllerStatus(a.(*TappControllerStatus), b.(*platform.TappControllerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TappControllerStatus)(nil), (*TappControllerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TappControllerStatus_To_v1_TappControllerStatus(a.(*platform.TappControllerStatus), b.(*TappControllerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ThirdPartyHA)(nil), (*platform.ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ThirdPartyHA_To_platform_ThirdPartyHA(a.(*ThirdPartyHA), b.(*platform.ThirdPartyHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.ThirdPartyHA)(nil), (*ThirdPartyHA)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(a.(*platform.ThirdPartyHA), b.(*ThirdPartyHA), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TimeSync)(nil), (*platform.TimeSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TimeSync_To_platform_TimeSync(a.(*TimeSync), b.(*platform.TimeSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.TimeSync)(nil), (*TimeSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_TimeSync_To_v1_TimeSync(a.(*platform.TimeSync), b.(*TimeSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Ulimit)(nil), (*platform.Ulimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Ulimit_To_platform_Ulimit(a.(*Ulimit), b.(*platform.Ulimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Ulimit)(nil), (*Ulimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Ulimit_To_v1_Ulimit(a.(*platform.Ulimit), b.(*Ulimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Upgrade)(nil), (*platform.Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Upgrade_To_platform_Upgrade(a.(*Upgrade), b.(*platform.Upgrade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.Upgrade)(nil), (*Upgrade)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_Upgrade_To_v1_Upgrade(a.(*platform.Upgrade), b.(*Upgrade), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpgradeCanary)(nil), (*platform.UpgradeCanary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UpgradeCanary_To_platform_UpgradeCanary(a.(*UpgradeCanary), b.(*platform.UpgradeCanary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.UpgradeCanary)(nil), (*UpgradeCanary)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_UpgradeCanary_To_v1_UpgradeCanary(a.(*platform.UpgradeCanary), b.(*UpgradeCanary), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpgradeCanaryStatus)(nil), (*platform.UpgradeCanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UpgradeCanaryStatus_To_platform_UpgradeCanaryStatus(a.(*UpgradeCanaryStatus), b.(*platform.UpgradeCanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.UpgradeCanaryStatus)(nil), (*UpgradeCanaryStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_UpgradeCanaryStatus_To_v1_UpgradeCanaryStatus(a.(*platform.UpgradeCanaryStatus), b.(*UpgradeCanaryStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*UpgradeStrategy)(nil), (*platform.UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(a.(*UpgradeStrategy), b.(*platform.UpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.UpgradeStrategy)(nil), (*UpgradeStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(a.(*platform.UpgradeStrategy), b.(*UpgradeStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeDecorator)(nil), (*platform.VolumeDecorator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VolumeDecorator_To_platform_VolumeDecorator(a.(*VolumeDecorator), b.(*platform.VolumeDecorator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecorator)(nil), (*VolumeDecorator)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_VolumeDecorator_To_v1_VolumeDecorator(a.(*platform.VolumeDecorator), b.(*VolumeDecorator), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorList)(nil), (*platform.VolumeDecoratorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VolumeDecoratorList_To_platform_VolumeDecoratorList(a.(*VolumeDecoratorList), b.(*platform.VolumeDecoratorList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorList)(nil), (*VolumeDecoratorList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_VolumeDecoratorList_To_v1_VolumeDecoratorList(a.(*platform.VolumeDecoratorList), b.(*VolumeDecoratorList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorSpec)(nil), (*platform.VolumeDecoratorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VolumeDecoratorSpec_To_platform_VolumeDecoratorSpec(a.(*VolumeDecoratorSpec), b.(*platform.VolumeDecoratorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorSpec)(nil), (*VolumeDecoratorSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_VolumeDecoratorSpec_To_v1_VolumeDecoratorSpec(a.(*platform.VolumeDecoratorSpec), b.(*VolumeDecoratorSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeDecoratorStatus)(nil), (*platform.VolumeDecoratorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VolumeDecoratorStatus_To_platform_VolumeDecoratorStatus(a.(*VolumeDecoratorStatus), b.(*platform.VolumeDecoratorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.VolumeDecoratorStatus)(nil), (*VolumeDecoratorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(a.(*platform.VolumeDecoratorStatus), b.(*VolumeDecoratorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeUsageEnforcement)(nil), (*platform.VolumeUsageEnforcement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VolumeUsageEnforcement_To_platform_VolumeUsageEnforcement(a.(*VolumeUsageEnforcement), b.(*platform.VolumeUsageEnforcement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*platform.VolumeUsageEnforcement)(nil), (*VolumeUsageEnforcement)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_platform_VolumeUsageEnforcement_To_v1_VolumeUsageEnforcement(a.(*platform.VolumeUsageEnforcement), b.(*VolumeUsageEnforcement), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*ClusterLogsOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_ClusterLogsOptions(a.(*url.Values), b.(*ClusterLogsOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*CronHPAProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_CronHPAProxyOptions(a.(*url.Values), b.(*CronHPAProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*HelmProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_HelmProxyOptions(a.(*url.Values), b.(*HelmProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*url.Values)(nil), (*TappControllerProxyOptions)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_url_Values_To_v1_TappControllerProxyOptions(a.(*url.Values), b.(*TappControllerProxyOptions), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1_AddonCondition_To_platform_AddonCondition(in *AddonCondition, out *platform.AddonCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = platform.ConditionStatus(in.Status)
+	out.Version = in.Version
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_AddonCondition_To_platform_AddonCondition is an autogenerated conversion function.
+func Convert_v1_AddonCondition_To_platform_AddonCondition(in *AddonCondition, out *platform.AddonCondition, s conversion.Scope) error {
+	return autoConvert_v1_AddonCondition_To_platform_AddonCondition(in, out, s)
+}
+
+func autoConvert_platform_AddonCondition_To_v1_AddonCondition(in *platform.AddonCondition, out *AddonCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = ConditionStatus(in.Status)
+	out.Version = in.Version
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_AddonCondition_To_v1_AddonCondition is an autogenerated conversion function.
+func Convert_platform_AddonCondition_To_v1_AddonCondition(in *platform.AddonCondition, out *AddonCondition, s conversion.Scope) error {
+	return autoConvert_platform_AddonCondition_To_v1_AddonCondition(in, out, s)
+}
+
+func autoConvert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_AddonSpec_To_platform_AddonSpec is an autogenerated conversion function.
+func Convert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
+	return autoConvert_v1_AddonSpec_To_platform_AddonSpec(in, out, s)
+}
+
+func autoConvert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_AddonSpec_To_v1_AddonSpec is an autogenerated conversion function.
+func Convert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
+	return autoConvert_platform_AddonSpec_To_v1_AddonSpec(in, out, s)
+}
+
+func autoConvert_v1_AuditLogBackend_To_platform_AuditLogBackend(in *AuditLogBackend, out *platform.AuditLogBackend, s conversion.Scope) error {
+	out.Path = in.Path
+	out.MaxAge = in.MaxAge
+	out.MaxBackup = in.MaxBackup
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_v1_AuditLogBackend_To_platform_AuditLogBackend is an autogenerated conversion function.
+func Convert_v1_AuditLogBackend_To_platform_AuditLogBackend(in *AuditLogBackend, out *platform.AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_v1_AuditLogBackend_To_platform_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_platform_AuditLogBackend_To_v1_AuditLogBackend(in *platform.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	out.Path = in.Path
+	out.MaxAge = in.MaxAge
+	out.MaxBackup = in.MaxBackup
+	out.MaxSize = in.MaxSize
+	return nil
+}
+
+// Convert_platform_AuditLogBackend_To_v1_AuditLogBackend is an autogenerated conversion function.
+func Convert_platform_AuditLogBackend_To_v1_AuditLogBackend(in *platform.AuditLogBackend, out *AuditLogBackend, s conversion.Scope) error {
+	return autoConvert_platform_AuditLogBackend_To_v1_AuditLogBackend(in, out, s)
+}
+
+func autoConvert_v1_AuditWebhookBackend_To_platform_AuditWebhookBackend(in *AuditWebhookBackend, out *platform.AuditWebhookBackend, s conversion.Scope) error {
+	out.Address = in.Address
+	return nil
+}
+
+// Convert_v1_AuditWebhookBackend_To_platform_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_v1_AuditWebhookBackend_To_platform_AuditWebhookBackend(in *AuditWebhookBackend, out *platform.AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_v1_AuditWebhookBackend_To_platform_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_platform_AuditWebhookBackend_To_v1_AuditWebhookBackend(in *platform.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	out.Address = in.Address
+	return nil
+}
+
+// Convert_platform_AuditWebhookBackend_To_v1_AuditWebhookBackend is an autogenerated conversion function.
+func Convert_platform_AuditWebhookBackend_To_v1_AuditWebhookBackend(in *platform.AuditWebhookBackend, out *AuditWebhookBackend, s conversion.Scope) error {
+	return autoConvert_platform_AuditWebhookBackend_To_v1_AuditWebhookBackend(in, out, s)
+}
+
+func autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
+	out.Builtin = (*platform.BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
+	out.External = (*platform.ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
+	out.Builtin = (*BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
+	out.External = (*ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return nil
+}
+
+// Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in, out, s)
+}
+
+func autoConvert_v1_CRDConflict_To_platform_CRDConflict(in *CRDConflict, out *platform.CRDConflict, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reason = platform.CRDConflictReason(in.Reason)
+	out.Message = in.Message
+	out.Owner = in.Owner
+	out.ServedVersions = *(*[]string)(unsafe.Pointer(&in.ServedVersions))
+	out.RequiredVersion = in.RequiredVersion
+	return nil
+}
+
+// Convert_v1_CRDConflict_To_platform_CRDConflict is an autogenerated conversion function.
+func Convert_v1_CRDConflict_To_platform_CRDConflict(in *CRDConflict, out *platform.CRDConflict, s conversion.Scope) error {
+	return autoConvert_v1_CRDConflict_To_platform_CRDConflict(in, out, s)
+}
+
+func autoConvert_platform_CRDConflict_To_v1_CRDConflict(in *platform.CRDConflict, out *CRDConflict, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Reason = CRDConflictReason(in.Reason)
+	out.Message = in.Message
+	out.Owner = in.Owner
+	out.ServedVersions = *(*[]string)(unsafe.Pointer(&in.ServedVersions))
+	out.RequiredVersion = in.RequiredVersion
+	return nil
+}
+
+// Convert_platform_CRDConflict_To_v1_CRDConflict is an autogenerated conversion function.
+func Convert_platform_CRDConflict_To_v1_CRDConflict(in *platform.CRDConflict, out *CRDConflict, s conversion.Scope) error {
+	return autoConvert_platform_CRDConflict_To_v1_CRDConflict(in, out, s)
+}
+
+func autoConvert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CSIOperator_To_platform_CSIOperator is an autogenerated conversion function.
+func Convert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperator_To_platform_CSIOperator(in, out, s)
+}
+
+func autoConvert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_CSIOperator_To_v1_CSIOperator is an autogenerated conversion function.
+func Convert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperator_To_v1_CSIOperator(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature is an autogenerated conversion function.
+func Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature is an autogenerated conversion function.
+func Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.CSIOperator)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CSIOperatorList_To_platform_CSIOperatorList is an autogenerated conversion function.
+func Convert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CSIOperator)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_CSIOperatorList_To_v1_CSIOperatorList is an autogenerated conversion function.
+func Convert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.CRDConflictPolicy = platform.CRDConflictPolicy(in.CRDConflictPolicy)
+	return nil
+}
+
+// Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec is an autogenerated conversion function.
+func Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.CRDConflictPolicy = CRDConflictPolicy(in.CRDConflictPolicy)
+	return nil
+}
+
+// Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec is an autogenerated conversion function.
+func Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in, out, s)
+}
+
+func autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.StorageVendorVersion = in.StorageVendorVersion
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]platform.CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	return nil
+}
+
+// Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus is an autogenerated conversion function.
+func Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
+	return autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in, out, s)
+}
+
+func autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.StorageVendorVersion = in.StorageVendorVersion
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	return nil
+}
+
+// Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus is an autogenerated conversion function.
+func Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
+	return autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in, out, s)
+}
+
+func autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions is an autogenerated conversion function.
+func Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in, out, s)
+}
+
+func autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions is an autogenerated conversion function.
+func Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in, out, s)
+}
+
+func autoConvert_v1_CalicoNetwork_To_platform_CalicoNetwork(in *CalicoNetwork, out *platform.CalicoNetwork, s conversion.Scope) error {
+	out.Mode = platform.CalicoMode(in.Mode)
+	out.ASNumber = in.ASNumber
+	out.MTU = in.MTU
+	return nil
+}
+
+// Convert_v1_CalicoNetwork_To_platform_CalicoNetwork is an autogenerated conversion function.
+func Convert_v1_CalicoNetwork_To_platform_CalicoNetwork(in *CalicoNetwork, out *platform.CalicoNetwork, s conversion.Scope) error {
+	return autoConvert_v1_CalicoNetwork_To_platform_CalicoNetwork(in, out, s)
+}
+
+func autoConvert_platform_CalicoNetwork_To_v1_CalicoNetwork(in *platform.CalicoNetwork, out *CalicoNetwork, s conversion.Scope) error {
+	out.Mode = CalicoMode(in.Mode)
+	out.ASNumber = in.ASNumber
+	out.MTU = in.MTU
+	return nil
+}
+
+// Convert_platform_CalicoNetwork_To_v1_CalicoNetwork is an autogenerated conversion function.
+func Convert_platform_CalicoNetwork_To_v1_CalicoNetwork(in *platform.CalicoNetwork, out *CalicoNetwork, s conversion.Scope) error {
+	return autoConvert_platform_CalicoNetwork_To_v1_CalicoNetwork(in, out, s)
+}
+
+func autoConvert_v1_CertificateExpiration_To_platform_CertificateExpiration(in *CertificateExpiration, out *platform.CertificateExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Node = in.Node
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_v1_CertificateExpiration_To_platform_CertificateExpiration is an autogenerated conversion function.
+func Convert_v1_CertificateExpiration_To_platform_CertificateExpiration(in *CertificateExpiration, out *platform.CertificateExpiration, s conversion.Scope) error {
+	return autoConvert_v1_CertificateExpiration_To_platform_CertificateExpiration(in, out, s)
+}
+
+func autoConvert_platform_CertificateExpiration_To_v1_CertificateExpiration(in *platform.CertificateExpiration, out *CertificateExpiration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Node = in.Node
+	out.NotAfter = in.NotAfter
+	return nil
+}
+
+// Convert_platform_CertificateExpiration_To_v1_CertificateExpiration is an autogenerated conversion function.
+func Convert_platform_CertificateExpiration_To_v1_CertificateExpiration(in *platform.CertificateExpiration, out *CertificateExpiration, s conversion.Scope) error {
+	return autoConvert_platform_CertificateExpiration_To_v1_CertificateExpiration(in, out, s)
+}
+
+func autoConvert_v1_CertificateRotation_To_platform_CertificateRotation(in *CertificateRotation, out *platform.CertificateRotation, s conversion.Scope) error {
+	out.Mode = platform.CertificateRotationMode(in.Mode)
+	out.RenewBefore = in.RenewBefore
+	out.RotateRequestedAt = (*metav1.Time)(unsafe.Pointer(in.RotateRequestedAt))
+	return nil
+}
+
+// Convert_v1_CertificateRotation_To_platform_CertificateRotation is an autogenerated conversion function.
+func Convert_v1_CertificateRotation_To_platform_CertificateRotation(in *CertificateRotation, out *platform.CertificateRotation, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRotation_To_platform_CertificateRotation(in, out, s)
+}
+
+func autoConvert_platform_CertificateRotation_To_v1_CertificateRotation(in *platform.CertificateRotation, out *CertificateRotation, s conversion.Scope) error {
+	out.Mode = CertificateRotationMode(in.Mode)
+	out.RenewBefore = in.RenewBefore
+	out.RotateRequestedAt = (*metav1.Time)(unsafe.Pointer(in.RotateRequestedAt))
+	return nil
+}
+
+// Convert_platform_CertificateRotation_To_v1_CertificateRotation is an autogenerated conversion function.
+func Convert_platform_CertificateRotation_To_v1_CertificateRotation(in *platform.CertificateRotation, out *CertificateRotation, s conversion.Scope) error {
+	return autoConvert_platform_CertificateRotation_To_v1_CertificateRotation(in, out, s)
+}
+
+func autoConvert_v1_CertificatesStatus_To_platform_CertificatesStatus(in *CertificatesStatus, out *platform.CertificatesStatus, s conversion.Scope) error {
+	out.Certificates = *(*[]platform.CertificateExpiration)(unsafe.Pointer(&in.Certificates))
+	out.LastRotationTime = in.LastRotationTime
+	return nil
+}
+
+// Convert_v1_CertificatesStatus_To_platform_CertificatesStatus is an autogenerated conversion function.
+func Convert_v1_CertificatesStatus_To_platform_CertificatesStatus(in *CertificatesStatus, out *platform.CertificatesStatus, s conversion.Scope) error {
+	return autoConvert_v1_CertificatesStatus_To_platform_CertificatesStatus(in, out, s)
+}
+
+func autoConvert_platform_CertificatesStatus_To_v1_CertificatesStatus(in *platform.CertificatesStatus, out *CertificatesStatus, s conversion.Scope) error {
+	out.Certificates = *(*[]CertificateExpiration)(unsafe.Pointer(&in.Certificates))
+	out.LastRotationTime = in.LastRotationTime
+	return nil
+}
+
+// Convert_platform_CertificatesStatus_To_v1_CertificatesStatus is an autogenerated conversion function.
+func Convert_platform_CertificatesStatus_To_v1_CertificatesStatus(in *platform.CertificatesStatus, out *CertificatesStatus, s conversion.Scope) error {
+	return autoConvert_platform_CertificatesStatus_To_v1_CertificatesStatus(in, out, s)
+}
+
+func autoConvert_v1_CiliumNetwork_To_platform_CiliumNetwork(in *CiliumNetwork, out *platform.CiliumNetwork, s conversion.Scope) error {
+	out.KubeProxyReplacement = in.KubeProxyReplacement
+	return nil
+}
+
+// Convert_v1_CiliumNetwork_To_platform_CiliumNetwork is an autogenerated conversion function.
+func Convert_v1_CiliumNetwork_To_platform_CiliumNetwork(in *CiliumNetwork, out *platform.CiliumNetwork, s conversion.Scope) error {
+	return autoConvert_v1_CiliumNetwork_To_platform_CiliumNetwork(in, out, s)
+}
+
+func autoConvert_platform_CiliumNetwork_To_v1_CiliumNetwork(in *platform.CiliumNetwork, out *CiliumNetwork, s conversion.Scope) error {
+	out.KubeProxyReplacement = in.KubeProxyReplacement
+	return nil
+}
+
+// Convert_platform_CiliumNetwork_To_v1_CiliumNetwork is an autogenerated conversion function.
+func Convert_platform_CiliumNetwork_To_v1_CiliumNetwork(in *platform.CiliumNetwork, out *CiliumNetwork, s conversion.Scope) error {
+	return autoConvert_platform_CiliumNetwork_To_v1_CiliumNetwork(in, out, s)
+}
+
+func autoConvert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterSpec_To_platform_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterStatus_To_platform_ClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Cluster_To_platform_Cluster is an autogenerated conversion function.
+func Convert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
+	return autoConvert_v1_Cluster_To_platform_Cluster(in, out, s)
+}
+
+func autoConvert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterSpec_To_v1_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterStatus_To_v1_ClusterStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_Cluster_To_v1_Cluster is an autogenerated conversion function.
+func Convert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
+	return autoConvert_platform_Cluster_To_v1_Cluster(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterAddon_To_platform_ClusterAddon is an autogenerated conversion function.
+func Convert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterAddon_To_v1_ClusterAddon is an autogenerated conversion function.
+func Convert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterAddon)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterAddonList_To_platform_ClusterAddonList is an autogenerated conversion function.
+func Convert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAddon)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterAddonList_To_v1_ClusterAddonList is an autogenerated conversion function.
+func Convert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Level = platform.AddonLevel(in.Level)
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec is an autogenerated conversion function.
+func Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Level = AddonLevel(in.Level)
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec is an autogenerated conversion function.
+func Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = in.Phase
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus is an autogenerated conversion function.
+func Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = in.Phase
+	out.Reason = in.Reason
+	return nil
+}
+
+// Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus is an autogenerated conversion function.
+func Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Type = in.Type
+	out.Level = platform.AddonLevel(in.Level)
+	out.LatestVersion = in.LatestVersion
+	out.Description = in.Description
+	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+	out.Dependencies = *(*[]string)(unsafe.Pointer(&in.Dependencies))
+	return nil
+}
+
+// Convert_v1_ClusterAddonType_To_platform_ClusterAddonType is an autogenerated conversion function.
+func Convert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Type = in.Type
+	out.Level = AddonLevel(in.Level)
+	out.LatestVersion = in.LatestVersion
+	out.Description = in.Description
+	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+	out.Dependencies = *(*[]string)(unsafe.Pointer(&in.Dependencies))
+	return nil
+}
+
+// Convert_platform_ClusterAddonType_To_v1_ClusterAddonType is an autogenerated conversion function.
+func Convert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterAddonType)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList is an autogenerated conversion function.
+func Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterAddonType)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList is an autogenerated conversion function.
+func Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in, out, s)
+}
+
+func autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
+	out.Type = platform.AddressType(in.Type)
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_v1_ClusterAddress_To_platform_ClusterAddress is an autogenerated conversion function.
+func Convert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in, out, s)
+}
+
+func autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
+	out.Type = AddressType(in.Type)
+	out.Host = in.Host
+	out.Port = in.Port
+	out.Path = in.Path
+	return nil
+}
+
+// Convert_platform_ClusterAddress_To_v1_ClusterAddress is an autogenerated conversion function.
+func Convert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in, out, s)
+}
+
+func autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
+	out.NotUpdate = in.NotUpdate
+	return nil
+}
+
+// Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions is an autogenerated conversion function.
+func Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
+	return autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in, out, s)
+}
+
+func autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
+	out.NotUpdate = in.NotUpdate
+	return nil
+}
+
+// Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions is an autogenerated conversion function.
+func Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
+	return autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in, out, s)
+}
+
+func autoConvert_v1_ClusterAudit_To_platform_ClusterAudit(in *ClusterAudit, out *platform.ClusterAudit, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Log = (*platform.AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*platform.AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_v1_ClusterAudit_To_platform_ClusterAudit is an autogenerated conversion function.
+func Convert_v1_ClusterAudit_To_platform_ClusterAudit(in *ClusterAudit, out *platform.ClusterAudit, s conversion.Scope) error {
+	return autoConvert_v1_ClusterAudit_To_platform_ClusterAudit(in, out, s)
+}
+
+func autoConvert_platform_ClusterAudit_To_v1_ClusterAudit(in *platform.ClusterAudit, out *ClusterAudit, s conversion.Scope) error {
+	out.Policy = in.Policy
+	out.Log = (*AuditLogBackend)(unsafe.Pointer(in.Log))
+	out.Webhook = (*AuditWebhookBackend)(unsafe.Pointer(in.Webhook))
+	return nil
+}
+
+// Convert_platform_ClusterAudit_To_v1_ClusterAudit is an autogenerated conversion function.
+func Convert_platform_ClusterAudit_To_v1_ClusterAudit(in *platform.ClusterAudit, out *ClusterAudit, s conversion.Scope) error {
+	return autoConvert_platform_ClusterAudit_To_v1_ClusterAudit(in, out, s)
+}
+
+func autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
+	out.Type = in.Type
+	if err := Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterComponent_To_platform_ClusterComponent is an autogenerated conversion function.
+func Convert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
+	return autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in, out, s)
+}
+
+func autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
+	out.Type = in.Type
+	if err := Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterComponent_To_v1_ClusterComponent is an autogenerated conversion function.
+func Convert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
+	return autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in, out, s)
+}
+
+func autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
+	out.Desired = in.Desired
+	out.Current = in.Current
+	out.Available = in.Available
+	out.Updated = in.Updated
+	return nil
+}
+
+// Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas is an autogenerated conversion function.
+func Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
+	return autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in, out, s)
+}
+
+func autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
+	out.Desired = in.Desired
+	out.Current = in.Current
+	out.Available = in.Available
+	out.Updated = in.Updated
+	return nil
+}
+
+// Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas is an autogenerated conversion function.
+func Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
+	return autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in, out, s)
+}
+
+func autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = platform.ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterCondition_To_platform_ClusterCondition is an autogenerated conversion function.
+func Convert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in, out, s)
+}
+
+func autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterCondition_To_v1_ClusterCondition is an autogenerated conversion function.
+func Convert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in, out, s)
+}
+
+func autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
+	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
+	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
+	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
+	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
+	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
+	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
+	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
+	out.Token = (*string)(unsafe.Pointer(in.Token))
+	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
+	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.EtcdBackupSecretAccessKey = *(*[]byte)(unsafe.Pointer(&in.EtcdBackupSecretAccessKey))
+	out.RegistryPasswords = *(*map[string][]byte)(unsafe.Pointer(&in.RegistryPasswords))
+	return nil
+}
+
+// Convert_v1_ClusterCredential_To_platform_ClusterCredential is an autogenerated conversion function.
+func Convert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in, out, s)
+}
+
+func autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
+	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
+	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
+	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
+	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
+	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
+	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
+	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
+	out.Token = (*string)(unsafe.Pointer(in.Token))
+	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
+	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.EtcdBackupSecretAccessKey = *(*[]byte)(unsafe.Pointer(&in.EtcdBackupSecretAccessKey))
+	out.RegistryPasswords = *(*map[string][]byte)(unsafe.Pointer(&in.RegistryPasswords))
+	return nil
+}
+
+// Convert_platform_ClusterCredential_To_v1_ClusterCredential is an autogenerated conversion function.
+func Convert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in, out, s)
+}
+
+func autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList is an autogenerated conversion function.
+func Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in, out, s)
+}
+
+func autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterCredential)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList is an autogenerated conversion function.
+func Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in, out, s)
+}
+
+func autoConvert_v1_ClusterDNS_To_platform_ClusterDNS(in *ClusterDNS, out *platform.ClusterDNS, s conversion.Scope) error {
+	out.StubDomains = *(*[]platform.DNSStubDomain)(unsafe.Pointer(&in.StubDomains))
+	out.UpstreamNameservers = *(*[]string)(unsafe.Pointer(&in.UpstreamNameservers))
+	out.CacheTTL = in.CacheTTL
+	out.Autoscaler = (*platform.DNSAutoscaler)(unsafe.Pointer(in.Autoscaler))
+	return nil
+}
+
+// Convert_v1_ClusterDNS_To_platform_ClusterDNS is an autogenerated conversion function.
+func Convert_v1_ClusterDNS_To_platform_ClusterDNS(in *ClusterDNS, out *platform.ClusterDNS, s conversion.Scope) error {
+	return autoConvert_v1_ClusterDNS_To_platform_ClusterDNS(in, out, s)
+}
+
+func autoConvert_platform_ClusterDNS_To_v1_ClusterDNS(in *platform.ClusterDNS, out *ClusterDNS, s conversion.Scope) error {
+	out.StubDomains = *(*[]DNSStubDomain)(unsafe.Pointer(&in.StubDomains))
+	out.UpstreamNameservers = *(*[]string)(unsafe.Pointer(&in.UpstreamNameservers))
+	out.CacheTTL = in.CacheTTL
+	out.Autoscaler = (*DNSAutoscaler)(unsafe.Pointer(in.Autoscaler))
+	return nil
+}
+
+// Convert_platform_ClusterDNS_To_v1_ClusterDNS is an autogenerated conversion function.
+func Convert_platform_ClusterDNS_To_v1_ClusterDNS(in *platform.ClusterDNS, out *ClusterDNS, s conversion.Scope) error {
+	return autoConvert_platform_ClusterDNS_To_v1_ClusterDNS(in, out, s)
+}
+
+func autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
+	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
+	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
+	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
+	out.GPUType = (*platform.GPUType)(unsafe.Pointer(in.GPUType))
+	out.EnableMasterSchedule = in.EnableMasterSchedule
+	out.HA = (*platform.HA)(unsafe.Pointer(in.HA))
+	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
+	out.Files = *(*[]platform.File)(unsafe.Pointer(&in.Files))
+	out.Hooks = *(*map[platform.HookType]string)(unsafe.Pointer(&in.Hooks))
+	out.CSIOperator = (*platform.CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
+	out.AuthzWebhookAddr = (*platform.AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
+	out.EnableMetricsServer = in.EnableMetricsServer
+	out.IPv6DualStack = in.IPv6DualStack
+	out.EnableCilium = in.EnableCilium
+	if err := Convert_v1_Upgrade_To_platform_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+		return err
+	}
+	out.SandboxRuntime = (*platform.SandboxRuntime)(unsafe.Pointer(in.SandboxRuntime))
+	out.NetworkEncryption = (*platform.NetworkEncryption)(unsafe.Pointer(in.NetworkEncryption))
+	out.Audit = (*platform.ClusterAudit)(unsafe.Pointer(in.Audit))
+	out.EtcdBackup = (*platform.EtcdBackup)(unsafe.Pointer(in.EtcdBackup))
+	out.SecretsEncryption = (*platform.SecretsEncryption)(unsafe.Pointer(in.SecretsEncryption))
+	out.CertificateRotation = (*platform.CertificateRotation)(unsafe.Pointer(in.CertificateRotation))
+	out.KubeletServerTLSBootstrap = (*bool)(unsafe.Pointer(in.KubeletServerTLSBootstrap))
+	out.CgroupDriver = in.CgroupDriver
+	out.SystemTuning = (*platform.SystemTuning)(unsafe.Pointer(in.SystemTuning))
+	out.TimeSync = (*platform.TimeSync)(unsafe.Pointer(in.TimeSync))
+	out.ServiceOverrides = (*platform.ServiceOverrides)(unsafe.Pointer(in.ServiceOverrides))
+	out.GalaxyNetwork = (*platform.GalaxyNetwork)(unsafe.Pointer(in.GalaxyNetwork))
+	out.Calico = (*platform.CalicoNetwork)(unsafe.Pointer(in.Calico))
+	out.Cilium = (*platform.CiliumNetwork)(unsafe.Pointer(in.Cilium))
+	out.ImageAdmission = (*platform.ImageAdmission)(unsafe.Pointer(in.ImageAdmission))
+	out.EnableNetworkCheck = in.EnableNetworkCheck
+	out.KubeProxy = (*platform.KubeProxy)(unsafe.Pointer(in.KubeProxy))
+	out.DNS = (*platform.ClusterDNS)(unsafe.Pointer(in.DNS))
+	out.NetworkPolicy = (*platform.NetworkPolicyFeature)(unsafe.Pointer(in.NetworkPolicy))
+	out.GPUManager = (*platform.GPUManagerFeature)(unsafe.Pointer(in.GPUManager))
+	out.MIGStrategy = platform.GPUMIGStrategy(in.MIGStrategy)
+	return nil
+}
+
+// Convert_v1_ClusterFeature_To_platform_ClusterFeature is an autogenerated conversion function.
+func Convert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
+	return autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in, out, s)
+}
+
+func autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
+	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
+	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
+	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
+	out.GPUType = (*GPUType)(unsafe.Pointer(in.GPUType))
+	out.EnableMasterSchedule = in.EnableMasterSchedule
+	out.HA = (*HA)(unsafe.Pointer(in.HA))
+	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
+	out.Files = *(*[]File)(unsafe.Pointer(&in.Files))
+	out.Hooks = *(*map[HookType]string)(unsafe.Pointer(&in.Hooks))
+	out.CSIOperator = (*CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
+	out.AuthzWebhookAddr = (*AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
+	out.EnableMetricsServer = in.EnableMetricsServer
+	out.EnableCilium = in.EnableCilium
+	out.IPv6DualStack = in.IPv6DualStack
+	if err := Convert_platform_Upgrade_To_v1_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+		return err
+	}
+	out.SandboxRuntime = (*SandboxRuntime)(unsafe.Pointer(in.SandboxRuntime))
+	out.NetworkEncryption = (*NetworkEncryption)(unsafe.Pointer(in.NetworkEncryption))
+	out.Audit = (*ClusterAudit)(unsafe.Pointer(in.Audit))
+	out.EtcdBackup = (*EtcdBackup)(unsafe.Pointer(in.EtcdBackup))
+	out.SecretsEncryption = (*SecretsEncryption)(unsafe.Pointer(in.SecretsEncryption))
+	out.CertificateRotation = (*CertificateRotation)(unsafe.Pointer(in.CertificateRotation))
+	out.KubeletServerTLSBootstrap = (*bool)(unsafe.Pointer(in.KubeletServerTLSBootstrap))
+	out.CgroupDriver = in.CgroupDriver
+	out.SystemTuning = (*SystemTuning)(unsafe.Pointer(in.SystemTuning))
+	out.TimeSync = (*TimeSync)(unsafe.Pointer(in.TimeSync))
+	out.ServiceOverrides = (*ServiceOverrides)(unsafe.Pointer(in.ServiceOverrides))
+	out.GalaxyNetwork = (*GalaxyNetwork)(unsafe.Pointer(in.GalaxyNetwork))
+	out.Calico = (*CalicoNetwork)(unsafe.Pointer(in.Calico))
+	out.Cilium = (*CiliumNetwork)(unsafe.Pointer(in.Cilium))
+	out.ImageAdmission = (*ImageAdmission)(unsafe.Pointer(in.ImageAdmission))
+	out.EnableNetworkCheck = in.EnableNetworkCheck
+	out.KubeProxy = (*KubeProxy)(unsafe.Pointer(in.KubeProxy))
+	out.DNS = (*ClusterDNS)(unsafe.Pointer(in.DNS))
+	out.NetworkPolicy = (*NetworkPolicyFeature)(unsafe.Pointer(in.NetworkPolicy))
+	out.GPUManager = (*GPUManagerFeature)(unsafe.Pointer(in.GPUManager))
+	out.MIGStrategy = GPUMIGStrategy(in.MIGStrategy)
+	return nil
+}
+
+// Convert_platform_ClusterFeature_To_v1_ClusterFeature is an autogenerated conversion function.
+func Convert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
+	return autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in, out, s)
+}
+
+func autoConvert_v1_ClusterHealth_To_platform_ClusterHealth(in *ClusterHealth, out *platform.ClusterHealth, s conversion.Scope) error {
+	out.Score = in.Score
+	out.Dimensions = *(*[]platform.ClusterHealthDimension)(unsafe.Pointer(&in.Dimensions))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1_ClusterHealth_To_platform_ClusterHealth is an autogenerated conversion function.
+func Convert_v1_ClusterHealth_To_platform_ClusterHealth(in *ClusterHealth, out *platform.ClusterHealth, s conversion.Scope) error {
+	return autoConvert_v1_ClusterHealth_To_platform_ClusterHealth(in, out, s)
+}
+
+func autoConvert_platform_ClusterHealth_To_v1_ClusterHealth(in *platform.ClusterHealth, out *ClusterHealth, s conversion.Scope) error {
+	out.Score = in.Score
+	out.Dimensions = *(*[]ClusterHealthDimension)(unsafe.Pointer(&in.Dimensions))
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_platform_ClusterHealth_To_v1_ClusterHealth is an autogenerated conversion function.
+func Convert_platform_ClusterHealth_To_v1_ClusterHealth(in *platform.ClusterHealth, out *ClusterHealth, s conversion.Scope) error {
+	return autoConvert_platform_ClusterHealth_To_v1_ClusterHealth(in, out, s)
+}
+
+func autoConvert_v1_ClusterHealthDimension_To_platform_ClusterHealthDimension(in *ClusterHealthDimension, out *platform.ClusterHealthDimension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Score = in.Score
+	out.Weight = in.Weight
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_ClusterHealthDimension_To_platform_ClusterHealthDimension is an autogenerated conversion function.
+func Convert_v1_ClusterHealthDimension_To_platform_ClusterHealthDimension(in *ClusterHealthDimension, out *platform.ClusterHealthDimension, s conversion.Scope) error {
+	return autoConvert_v1_ClusterHealthDimension_To_platform_ClusterHealthDimension(in, out, s)
+}
+
+func autoConvert_platform_ClusterHealthDimension_To_v1_ClusterHealthDimension(in *platform.ClusterHealthDimension, out *ClusterHealthDimension, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Score = in.Score
+	out.Weight = in.Weight
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_platform_ClusterHealthDimension_To_v1_ClusterHealthDimension is an autogenerated conversion function.
+func Convert_platform_ClusterHealthDimension_To_v1_ClusterHealthDimension(in *platform.ClusterHealthDimension, out *ClusterHealthDimension, s conversion.Scope) error {
+	return autoConvert_platform_ClusterHealthDimension_To_v1_ClusterHealthDimension(in, out, s)
+}
+
+func autoConvert_v1_ClusterImage_To_platform_ClusterImage(in *ClusterImage, out *platform.ClusterImage, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Container = in.Container
+	out.Image = in.Image
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_v1_ClusterImage_To_platform_ClusterImage is an autogenerated conversion function.
+func Convert_v1_ClusterImage_To_platform_ClusterImage(in *ClusterImage, out *platform.ClusterImage, s conversion.Scope) error {
+	return autoConvert_v1_ClusterImage_To_platform_ClusterImage(in, out, s)
+}
+
+func autoConvert_platform_ClusterImage_To_v1_ClusterImage(in *platform.ClusterImage, out *ClusterImage, s conversion.Scope) error {
+	out.Component = in.Component
+	out.Container = in.Container
+	out.Image = in.Image
+	out.Digest = in.Digest
+	return nil
+}
+
+// Convert_platform_ClusterImage_To_v1_ClusterImage is an autogenerated conversion function.
+func Convert_platform_ClusterImage_To_v1_ClusterImage(in *platform.ClusterImage, out *ClusterImage, s conversion.Scope) error {
+	return autoConvert_platform_ClusterImage_To_v1_ClusterImage(in, out, s)
+}
+
+func autoConvert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]platform.Cluster, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Cluster_To_platform_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterList_To_platform_ClusterList is an autogenerated conversion function.
+func Convert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterList_To_platform_ClusterList(in, out, s)
+}
+
+func autoConvert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			if err := Convert_platform_Cluster_To_v1_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_platform_ClusterList_To_v1_ClusterList is an autogenerated conversion function.
+func Convert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterList_To_v1_ClusterList(in, out, s)
+}
+
+func autoConvert_v1_ClusterLogsOptions_To_platform_ClusterLogsOptions(in *ClusterLogsOptions, out *platform.ClusterLogsOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Pod = in.Pod
+	out.Container = in.Container
+	out.Follow = in.Follow
+	out.Previous = in.Previous
+	out.SinceSeconds = (*int64)(unsafe.Pointer(in.SinceSeconds))
+	out.SinceTime = (*metav1.Time)(unsafe.Pointer(in.SinceTime))
+	out.Timestamps = in.Timestamps
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.LimitBytes = (*int64)(unsafe.Pointer(in.LimitBytes))
+	return nil
+}
+
+// Convert_v1_ClusterLogsOptions_To_platform_ClusterLogsOptions is an autogenerated conversion function.
+func Convert_v1_ClusterLogsOptions_To_platform_ClusterLogsOptions(in *ClusterLogsOptions, out *platform.ClusterLogsOptions, s conversion.Scope) error {
+	return autoConvert_v1_ClusterLogsOptions_To_platform_ClusterLogsOptions(in, out, s)
+}
+
+func autoConvert_platform_ClusterLogsOptions_To_v1_ClusterLogsOptions(in *platform.ClusterLogsOptions, out *ClusterLogsOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Pod = in.Pod
+	out.Container = in.Container
+	out.Follow = in.Follow
+	out.Previous = in.Previous
+	out.SinceSeconds = (*int64)(unsafe.Pointer(in.SinceSeconds))
+	out.SinceTime = (*metav1.Time)(unsafe.Pointer(in.SinceTime))
+	out.Timestamps = in.Timestamps
+	out.TailLines = (*int64)(unsafe.Pointer(in.TailLines))
+	out.LimitBytes = (*int64)(unsafe.Pointer(in.LimitBytes))
+	return nil
+}
+
+// Convert_platform_ClusterLogsOptions_To_v1_ClusterLogsOptions is an autogenerated conversion function.
+func Convert_platform_ClusterLogsOptions_To_v1_ClusterLogsOptions(in *platform.ClusterLogsOptions, out *ClusterLogsOptions, s conversion.Scope) error {
+	return autoConvert_platform_ClusterLogsOptions_To_v1_ClusterLogsOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_ClusterLogsOptions(in *url.Values, out *ClusterLogsOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
+			return err
+		}
+	} else {
+		out.Namespace = ""
+	}
+	if values, ok := map[string][]string(*in)["pod"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Pod, s); err != nil {
+			return err
+		}
+	} else {
+		out.Pod = ""
+	}
+	if values, ok := map[string][]string(*in)["container"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Container, s); err != nil {
+			return err
+		}
+	} else {
+		out.Container = ""
+	}
+	if values, ok := map[string][]string(*in)["follow"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Follow, s); err != nil {
+			return err
+		}
+	} else {
+		out.Follow = false
+	}
+	if values, ok := map[string][]string(*in)["previous"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Previous, s); err != nil {
+			return err
+		}
+	} else {
+		out.Previous = false
+	}
+	if values, ok := map[string][]string(*in)["sinceSeconds"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.SinceSeconds, s); err != nil {
+			return err
+		}
+	} else {
+		out.SinceSeconds = nil
+	}
+	if values, ok := map[string][]string(*in)["sinceTime"]; ok && len(values) > 0 {
+		if err := metav1.Convert_Slice_string_To_Pointer_v1_Time(&values, &out.SinceTime, s); err != nil {
+			return err
+		}
+	} else {
+		out.SinceTime = nil
+	}
+	if values, ok := map[string][]string(*in)["timestamps"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_bool(&values, &out.Timestamps, s); err != nil {
+			return err
+		}
+	} else {
+		out.Timestamps = false
+	}
+	if values, ok := map[string][]string(*in)["tailLines"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.TailLines, s); err != nil {
+			return err
+		}
+	} else {
+		out.TailLines = nil
+	}
+	if values, ok := map[string][]string(*in)["limitBytes"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_Pointer_int64(&values, &out.LimitBytes, s); err != nil {
+			return err
+		}
+	} else {
+		out.LimitBytes = nil
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_ClusterLogsOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_ClusterLogsOptions(in *url.Values, out *ClusterLogsOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_ClusterLogsOptions(in, out, s)
+}
+
+func autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_v1_ClusterMachine_To_platform_ClusterMachine is an autogenerated conversion function.
+func Convert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
+	return autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in, out, s)
+}
+
+func autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	return nil
+}
+
+// Convert_platform_ClusterMachine_To_v1_ClusterMachine is an autogenerated conversion function.
+func Convert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
+	return autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in, out, s)
+}
+
+func autoConvert_v1_ClusterOperation_To_platform_ClusterOperation(in *ClusterOperation, out *platform.ClusterOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterOperation_To_platform_ClusterOperation is an autogenerated conversion function.
+func Convert_v1_ClusterOperation_To_platform_ClusterOperation(in *ClusterOperation, out *platform.ClusterOperation, s conversion.Scope) error {
+	return autoConvert_v1_ClusterOperation_To_platform_ClusterOperation(in, out, s)
+}
+
+func autoConvert_platform_ClusterOperation_To_v1_ClusterOperation(in *platform.ClusterOperation, out *ClusterOperation, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_ClusterOperation_To_v1_ClusterOperation is an autogenerated conversion function.
+func Convert_platform_ClusterOperation_To_v1_ClusterOperation(in *platform.ClusterOperation, out *ClusterOperation, s conversion.Scope) error {
+	return autoConvert_platform_ClusterOperation_To_v1_ClusterOperation(in, out, s)
+}
+
+func autoConvert_v1_ClusterOperationList_To_platform_ClusterOperationList(in *ClusterOperationList, out *platform.ClusterOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ClusterOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ClusterOperationList_To_platform_ClusterOperationList is an autogenerated conversion function.
+func Convert_v1_ClusterOperationList_To_platform_ClusterOperationList(in *ClusterOperationList, out *platform.ClusterOperationList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterOperationList_To_platform_ClusterOperationList(in, out, s)
+}
+
+func autoConvert_platform_ClusterOperationList_To_v1_ClusterOperationList(in *platform.ClusterOperationList, out *ClusterOperationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterOperation)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ClusterOperationList_To_v1_ClusterOperationList is an autogenerated conversion function.
+func Convert_platform_ClusterOperationList_To_v1_ClusterOperationList(in *platform.ClusterOperationList, out *ClusterOperationList, s conversion.Scope) error {
+	return autoConvert_platform_ClusterOperationList_To_v1_ClusterOperationList(in, out, s)
+}
+
+func autoConvert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec(in *ClusterOperationSpec, out *platform.ClusterOperationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Verb = in.Verb
+	out.Path = in.Path
+	out.ContentType = in.ContentType
+	out.Body = in.Body
+	out.Username = in.Username
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec is an autogenerated conversion function.
+func Convert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec(in *ClusterOperationSpec, out *platform.ClusterOperationSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterOperationSpec_To_platform_ClusterOperationSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec(in *platform.ClusterOperationSpec, out *ClusterOperationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Verb = in.Verb
+	out.Path = in.Path
+	out.ContentType = in.ContentType
+	out.Body = in.Body
+	out.Username = in.Username
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.ExpireTime = in.ExpireTime
+	return nil
+}
+
+// Convert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec is an autogenerated conversion function.
+func Convert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec(in *platform.ClusterOperationSpec, out *ClusterOperationSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterOperationSpec_To_v1_ClusterOperationSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus(in *ClusterOperationStatus, out *platform.ClusterOperationStatus, s conversion.Scope) error {
+	out.Phase = platform.ClusterOperationPhase(in.Phase)
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = in.LastAttemptTime
+	out.Code = in.Code
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus is an autogenerated conversion function.
+func Convert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus(in *ClusterOperationStatus, out *platform.ClusterOperationStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterOperationStatus_To_platform_ClusterOperationStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus(in *platform.ClusterOperationStatus, out *ClusterOperationStatus, s conversion.Scope) error {
+	out.Phase = ClusterOperationPhase(in.Phase)
+	out.Attempts = in.Attempts
+	out.LastAttemptTime = in.LastAttemptTime
+	out.Code = in.Code
+	out.Message = in.Message
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus is an autogenerated conversion function.
+func Convert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus(in *platform.ClusterOperationStatus, out *ClusterOperationStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterOperationStatus_To_v1_ClusterOperationStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
+	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
+	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
+	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+	return nil
+}
+
+// Convert_v1_ClusterProperty_To_platform_ClusterProperty is an autogenerated conversion function.
+func Convert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
+	return autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in, out, s)
+}
+
+func autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
+	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
+	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
+	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+	return nil
+}
+
+// Convert_platform_ClusterProperty_To_v1_ClusterProperty is an autogenerated conversion function.
+func Convert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
+	return autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in, out, s)
+}
+
+func autoConvert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
+	out.Capacity = *(*platform.ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocated = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocated))
+	return nil
+}
+
+// Convert_v1_ClusterResource_To_platform_ClusterResource is an autogenerated conversion function.
+func Convert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
+	return autoConvert_v1_ClusterResource_To_platform_ClusterResource(in, out, s)
+}
+
+func autoConvert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
+	out.Capacity = *(*ResourceList)(unsafe.Pointer(&in.Capacity))
+	out.Allocatable = *(*ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+	return nil
+}
+
+// Convert_platform_ClusterResource_To_v1_ClusterResource is an autogenerated conversion function.
+func Convert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
+	return autoConvert_platform_ClusterResource_To_v1_ClusterResource(in, out, s)
+}
+
+func autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
+	out.Version = in.Version
+	out.NetworkType = platform.NetworkType(in.NetworkType)
+	out.NetworkDevice = in.NetworkDevice
+	out.ClusterCIDR = in.ClusterCIDR
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	out.DNSDomain = in.DNSDomain
+	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
+	if err := Convert_v1_ClusterFeature_To_platform_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_ClusterProperty_To_platform_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.Machines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.Machines))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
+	out.Etcd = (*platform.Etcd)(unsafe.Pointer(in.Etcd))
+	out.HostnameAsNodename = in.HostnameAsNodename
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.ScalingMachines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
+	out.ControlPlaneOverrides = (*platform.ControlPlaneOverrides)(unsafe.Pointer(in.ControlPlaneOverrides))
+	out.ContainerRegistries = (*platform.ContainerRegistryConfig)(unsafe.Pointer(in.ContainerRegistries))
+	return nil
+}
+
+// Convert_v1_ClusterSpec_To_platform_ClusterSpec is an autogenerated conversion function.
+func Convert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
+	return autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in, out, s)
+}
+
+func autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
+	out.TenantID = in.TenantID
+	out.DisplayName = in.DisplayName
+	out.Type = in.Type
+	out.Version = in.Version
+	out.NetworkType = NetworkType(in.NetworkType)
+	out.NetworkDevice = in.NetworkDevice
+	out.ClusterCIDR = in.ClusterCIDR
+	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
+	out.DNSDomain = in.DNSDomain
+	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
+	if err := Convert_platform_ClusterFeature_To_v1_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_ClusterProperty_To_v1_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+		return err
+	}
+	out.Machines = *(*[]ClusterMachine)(unsafe.Pointer(&in.Machines))
+	out.ScalingMachines = *(*[]ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
+	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
+	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
+	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
+	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
+	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
+	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
+	out.Etcd = (*Etcd)(unsafe.Pointer(in.Etcd))
+	out.HostnameAsNodename = in.HostnameAsNodename
+	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
+	out.ControlPlaneOverrides = (*ControlPlaneOverrides)(unsafe.Pointer(in.ControlPlaneOverrides))
+	out.ContainerRegistries = (*ContainerRegistryConfig)(unsafe.Pointer(in.ContainerRegistries))
+	return nil
+}
+
+// Convert_platform_ClusterSpec_To_v1_ClusterSpec is an autogenerated conversion function.
+func Convert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
+	return autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in, out, s)
+}
+
+func autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Version = in.Version
+	out.Phase = platform.ClusterPhase(in.Phase)
+	out.Conditions = *(*[]platform.ClusterCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
+	out.Reason = in.Reason
+	out.Addresses = *(*[]platform.ClusterAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_v1_ClusterResource_To_platform_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Components = *(*[]platform.ClusterComponent)(unsafe.Pointer(&in.Components))
+	out.ServiceCIDR = in.ServiceCIDR
+	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
+	out.DNSIP = in.DNSIP
+	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
+	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
+	out.ClusterCIDR = in.ClusterCIDR
+	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
+	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
+	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
+	out.KubeVendor = platform.KubeVendorType(in.KubeVendor)
+	out.Progress = (*platform.Progress)(unsafe.Pointer(in.Progress))
+	out.EtcdSnapshot = (*platform.EtcdSnapshot)(unsafe.Pointer(in.EtcdSnapshot))
+	out.Certificates = (*platform.CertificatesStatus)(unsafe.Pointer(in.Certificates))
+	out.EtcdMaintenance = (*platform.EtcdMaintenanceStatus)(unsafe.Pointer(in.EtcdMaintenance))
+	out.UpgradeCanary = (*platform.UpgradeCanaryStatus)(unsafe.Pointer(in.UpgradeCanary))
+	out.Health = (*platform.ClusterHealth)(unsafe.Pointer(in.Health))
+	out.Images = *(*[]platform.ClusterImage)(unsafe.Pointer(&in.Images))
+	out.NetworkPolicy = (*platform.NetworkPolicyStatus)(unsafe.Pointer(in.NetworkPolicy))
+	return nil
+}
+
+// Convert_v1_ClusterStatus_To_platform_ClusterStatus is an autogenerated conversion function.
+func Convert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
+	return autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in, out, s)
+}
+
+func autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Version = in.Version
+	out.Phase = ClusterPhase(in.Phase)
+	out.Conditions = *(*[]ClusterCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
+	out.Reason = in.Reason
+	out.Addresses = *(*[]ClusterAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_platform_ClusterResource_To_v1_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
+		return err
+	}
+	out.Components = *(*[]ClusterComponent)(unsafe.Pointer(&in.Components))
+	out.ServiceCIDR = in.ServiceCIDR
+	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
+	out.DNSIP = in.DNSIP
+	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
+	out.ClusterCIDR = in.ClusterCIDR
+	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
+	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
+	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
+	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
+	out.KubeVendor = KubeVendorType(in.KubeVendor)
+	out.Progress = (*Progress)(unsafe.Pointer(in.Progress))
+	out.EtcdSnapshot = (*EtcdSnapshot)(unsafe.Pointer(in.EtcdSnapshot))
+	out.Certificates = (*CertificatesStatus)(unsafe.Pointer(in.Certificates))
+	out.EtcdMaintenance = (*EtcdMaintenanceStatus)(unsafe.Pointer(in.EtcdMaintenance))
+	out.UpgradeCanary = (*UpgradeCanaryStatus)(unsafe.Pointer(in.UpgradeCanary))
+	out.Health = (*ClusterHealth)(unsafe.Pointer(in.Health))
+	out.Images = *(*[]ClusterImage)(unsafe.Pointer(&in.Images))
+	out.NetworkPolicy = (*NetworkPolicyStatus)(unsafe.Pointer(in.NetworkPolicy))
+	return nil
+}
+
+// Convert_platform_ClusterStatus_To_v1_ClusterStatus is an autogenerated conversion function.
+func Convert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
+	return autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in, out, s)
+}
+
+func autoConvert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+	return nil
+}
+
+// Convert_v1_ConfigMap_To_platform_ConfigMap is an autogenerated conversion function.
+func Convert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
+	return autoConvert_v1_ConfigMap_To_platform_ConfigMap(in, out, s)
+}
+
+func autoConvert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
+	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+	return nil
+}
+
+// Convert_platform_ConfigMap_To_v1_ConfigMap is an autogenerated conversion function.
+func Convert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
+	return autoConvert_platform_ConfigMap_To_v1_ConfigMap(in, out, s)
+}
+
+func autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.ConfigMap)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_ConfigMapList_To_platform_ConfigMapList is an autogenerated conversion function.
+func Convert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
+	return autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in, out, s)
+}
+
+func autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ConfigMap)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_ConfigMapList_To_v1_ConfigMapList is an autogenerated conversion function.
+func Convert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
+	return autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in, out, s)
+}
+
+func autoConvert_v1_ContainerRegistryConfig_To_platform_ContainerRegistryConfig(in *ContainerRegistryConfig, out *platform.ContainerRegistryConfig, s conversion.Scope) error {
+	out.Mirrors = *(*[]string)(unsafe.Pointer(&in.Mirrors))
+	out.InsecureRegistries = *(*[]string)(unsafe.Pointer(&in.InsecureRegistries))
+	out.Auths = *(*[]platform.RegistryAuth)(unsafe.Pointer(&in.Auths))
+	return nil
+}
+
+// Convert_v1_ContainerRegistryConfig_To_platform_ContainerRegistryConfig is an autogenerated conversion function.
+func Convert_v1_ContainerRegistryConfig_To_platform_ContainerRegistryConfig(in *ContainerRegistryConfig, out *platform.ContainerRegistryConfig, s conversion.Scope) error {
+	return autoConvert_v1_ContainerRegistryConfig_To_platform_ContainerRegistryConfig(in, out, s)
+}
+
+func autoConvert_platform_ContainerRegistryConfig_To_v1_ContainerRegistryConfig(in *platform.ContainerRegistryConfig, out *ContainerRegistryConfig, s conversion.Scope) error {
+	out.Mirrors = *(*[]string)(unsafe.Pointer(&in.Mirrors))
+	out.InsecureRegistries = *(*[]string)(unsafe.Pointer(&in.InsecureRegistries))
+	out.Auths = *(*[]RegistryAuth)(unsafe.Pointer(&in.Auths))
+	return nil
+}
+
+// Convert_platform_ContainerRegistryConfig_To_v1_ContainerRegistryConfig is an autogenerated conversion function.
+func Convert_platform_ContainerRegistryConfig_To_v1_ContainerRegistryConfig(in *platform.ContainerRegistryConfig, out *ContainerRegistryConfig, s conversion.Scope) error {
+	return autoConvert_platform_ContainerRegistryConfig_To_v1_ContainerRegistryConfig(in, out, s)
+}
+
+func autoConvert_v1_ControlPlaneOverrides_To_platform_ControlPlaneOverrides(in *ControlPlaneOverrides, out *platform.ControlPlaneOverrides, s conversion.Scope) error {
+	out.APIServer = (*platform.StaticPodOverride)(unsafe.Pointer(in.APIServer))
+	out.ControllerManager = (*platform.StaticPodOverride)(unsafe.Pointer(in.ControllerManager))
+	out.Scheduler = (*platform.StaticPodOverride)(unsafe.Pointer(in.Scheduler))
+	out.Etcd = (*platform.StaticPodOverride)(unsafe.Pointer(in.Etcd))
+	return nil
+}
+
+// Convert_v1_ControlPlaneOverrides_To_platform_ControlPlaneOverrides is an autogenerated conversion function.
+func Convert_v1_ControlPlaneOverrides_To_platform_ControlPlaneOverrides(in *ControlPlaneOverrides, out *platform.ControlPlaneOverrides, s conversion.Scope) error {
+	return autoConvert_v1_ControlPlaneOverrides_To_platform_ControlPlaneOverrides(in, out, s)
+}
+
+func autoConvert_platform_ControlPlaneOverrides_To_v1_ControlPlaneOverrides(in *platform.ControlPlaneOverrides, out *ControlPlaneOverrides, s conversion.Scope) error {
+	out.APIServer = (*StaticPodOverride)(unsafe.Pointer(in.APIServer))
+	out.ControllerManager = (*StaticPodOverride)(unsafe.Pointer(in.ControllerManager))
+	out.Scheduler = (*StaticPodOverride)(unsafe.Pointer(in.Scheduler))
+	out.Etcd = (*StaticPodOverride)(unsafe.Pointer(in.Etcd))
+	return nil
+}
+
+// Convert_platform_ControlPlaneOverrides_To_v1_ControlPlaneOverrides is an autogenerated conversion function.
+func Convert_platform_ControlPlaneOverrides_To_v1_ControlPlaneOverrides(in *platform.ControlPlaneOverrides, out *ControlPlaneOverrides, s conversion.Scope) error {
+	return autoConvert_platform_ControlPlaneOverrides_To_v1_ControlPlaneOverrides(in, out, s)
+}
+
+func autoConvert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CronHPASpec_To_platform_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CronHPA_To_platform_CronHPA is an autogenerated conversion function.
+func Convert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
+	return autoConvert_v1_CronHPA_To_platform_CronHPA(in, out, s)
+}
+
+func autoConvert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_CronHPASpec_To_v1_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_CronHPA_To_v1_CronHPA is an autogenerated conversion function.
+func Convert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
+	return autoConvert_platform_CronHPA_To_v1_CronHPA(in, out, s)
+}
+
+func autoConvert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.CronHPA)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CronHPAList_To_platform_CronHPAList is an autogenerated conversion function.
+func Convert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAList_To_platform_CronHPAList(in, out, s)
+}
+
+func autoConvert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]CronHPA)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_CronHPAList_To_v1_CronHPAList is an autogenerated conversion function.
+func Convert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAList_To_v1_CronHPAList(in, out, s)
+}
+
+func autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in, out, s)
+}
+
+func autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in, out, s)
+}
+
+func autoConvert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
+			return err
+		}
+	} else {
+		out.Namespace = ""
+	}
+	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
+			return err
+		}
+	} else {
+		out.Name = ""
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_CronHPAProxyOptions(in, out, s)
+}
+
+func autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.CRDConflictPolicy = platform.CRDConflictPolicy(in.CRDConflictPolicy)
+	out.DriftPolicy = platform.AddonDriftPolicy(in.DriftPolicy)
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1_CronHPASpec_To_platform_CronHPASpec is an autogenerated conversion function.
+func Convert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
+	return autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in, out, s)
+}
+
+func autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.CRDConflictPolicy = CRDConflictPolicy(in.CRDConflictPolicy)
+	out.DriftPolicy = AddonDriftPolicy(in.DriftPolicy)
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_platform_CronHPASpec_To_v1_CronHPASpec is an autogenerated conversion function.
+func Convert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
+	return autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in, out, s)
+}
+
+func autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]platform.CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	out.Conditions = *(*[]platform.AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-func autoConvert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_v1_AddonSpec_To_platform_AddonSpec is an autogenerated conversion function.
-func Convert_v1_AddonSpec_To_platform_AddonSpec(in *AddonSpec, out *platform.AddonSpec, s conversion.Scope) error {
-	return autoConvert_v1_AddonSpec_To_platform_AddonSpec(in, out, s)
-}
-
-func autoConvert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
-	return nil
-}
-
-// Convert_platform_AddonSpec_To_v1_AddonSpec is an autogenerated conversion function.
-func Convert_platform_AddonSpec_To_v1_AddonSpec(in *platform.AddonSpec, out *AddonSpec, s conversion.Scope) error {
-	return autoConvert_platform_AddonSpec_To_v1_AddonSpec(in, out, s)
-}
-
-func autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
-	out.Builtin = (*platform.BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
-	out.External = (*platform.ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
-	return nil
-}
-
-// Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in *AuthzWebhookAddr, out *platform.AuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_AuthzWebhookAddr_To_platform_AuthzWebhookAddr(in, out, s)
+// Convert_v1_CronHPAStatus_To_platform_CronHPAStatus is an autogenerated conversion function.
+func Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
+	return autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in, out, s)
 }
 
-func autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
-	out.Builtin = (*BuiltinAuthzWebhookAddr)(unsafe.Pointer(in.Builtin))
-	out.External = (*ExternalAuthzWebhookAddr)(unsafe.Pointer(in.External))
+func autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	out.Conditions = *(*[]AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in *platform.AuthzWebhookAddr, out *AuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_AuthzWebhookAddr_To_v1_AuthzWebhookAddr(in, out, s)
+// Convert_platform_CronHPAStatus_To_v1_CronHPAStatus is an autogenerated conversion function.
+func Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
+	return autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in, out, s)
 }
 
-func autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+func autoConvert_v1_DNSAutoscaler_To_platform_DNSAutoscaler(in *DNSAutoscaler, out *platform.DNSAutoscaler, s conversion.Scope) error {
+	out.CoresPerReplica = in.CoresPerReplica
+	out.NodesPerReplica = in.NodesPerReplica
+	out.Min = in.Min
+	out.Max = in.Max
+	out.PreventSinglePointFailure = in.PreventSinglePointFailure
 	return nil
 }
 
-// Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in *BuiltinAuthzWebhookAddr, out *platform.BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_BuiltinAuthzWebhookAddr_To_platform_BuiltinAuthzWebhookAddr(in, out, s)
+// Convert_v1_DNSAutoscaler_To_platform_DNSAutoscaler is an autogenerated conversion function.
+func Convert_v1_DNSAutoscaler_To_platform_DNSAutoscaler(in *DNSAutoscaler, out *platform.DNSAutoscaler, s conversion.Scope) error {
+	return autoConvert_v1_DNSAutoscaler_To_platform_DNSAutoscaler(in, out, s)
 }
 
-func autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
+func autoConvert_platform_DNSAutoscaler_To_v1_DNSAutoscaler(in *platform.DNSAutoscaler, out *DNSAutoscaler, s conversion.Scope) error {
+	out.CoresPerReplica = in.CoresPerReplica
+	out.NodesPerReplica = in.NodesPerReplica
+	out.Min = in.Min
+	out.Max = in.Max
+	out.PreventSinglePointFailure = in.PreventSinglePointFailure
 	return nil
 }
 
-// Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in *platform.BuiltinAuthzWebhookAddr, out *BuiltinAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_BuiltinAuthzWebhookAddr_To_v1_BuiltinAuthzWebhookAddr(in, out, s)
+// Convert_platform_DNSAutoscaler_To_v1_DNSAutoscaler is an autogenerated conversion function.
+func Convert_platform_DNSAutoscaler_To_v1_DNSAutoscaler(in *platform.DNSAutoscaler, out *DNSAutoscaler, s conversion.Scope) error {
+	return autoConvert_platform_DNSAutoscaler_To_v1_DNSAutoscaler(in, out, s)
 }
 
-func autoConvert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
+func autoConvert_v1_DNSStubDomain_To_platform_DNSStubDomain(in *DNSStubDomain, out *platform.DNSStubDomain, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	return nil
+}
+
+// Convert_v1_DNSStubDomain_To_platform_DNSStubDomain is an autogenerated conversion function.
+func Convert_v1_DNSStubDomain_To_platform_DNSStubDomain(in *DNSStubDomain, out *platform.DNSStubDomain, s conversion.Scope) error {
+	return autoConvert_v1_DNSStubDomain_To_platform_DNSStubDomain(in, out, s)
+}
+
+func autoConvert_platform_DNSStubDomain_To_v1_DNSStubDomain(in *platform.DNSStubDomain, out *DNSStubDomain, s conversion.Scope) error {
+	out.Domain = in.Domain
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	return nil
+}
+
+// Convert_platform_DNSStubDomain_To_v1_DNSStubDomain is an autogenerated conversion function.
+func Convert_platform_DNSStubDomain_To_v1_DNSStubDomain(in *platform.DNSStubDomain, out *DNSStubDomain, s conversion.Scope) error {
+	return autoConvert_platform_DNSStubDomain_To_v1_DNSStubDomain(in, out, s)
+}
+
+func autoConvert_v1_DevicePlugin_To_platform_DevicePlugin(in *DevicePlugin, out *platform.DevicePlugin, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_DevicePluginSpec_To_platform_DevicePluginSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_DevicePluginStatus_To_platform_DevicePluginStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_CSIOperator_To_platform_CSIOperator is an autogenerated conversion function.
-func Convert_v1_CSIOperator_To_platform_CSIOperator(in *CSIOperator, out *platform.CSIOperator, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperator_To_platform_CSIOperator(in, out, s)
+// Convert_v1_DevicePlugin_To_platform_DevicePlugin is an autogenerated conversion function.
+func Convert_v1_DevicePlugin_To_platform_DevicePlugin(in *DevicePlugin, out *platform.DevicePlugin, s conversion.Scope) error {
+	return autoConvert_v1_DevicePlugin_To_platform_DevicePlugin(in, out, s)
 }
 
-func autoConvert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
+func autoConvert_platform_DevicePlugin_To_v1_DevicePlugin(in *platform.DevicePlugin, out *DevicePlugin, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_DevicePluginSpec_To_v1_DevicePluginSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_DevicePluginStatus_To_v1_DevicePluginStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_CSIOperator_To_v1_CSIOperator is an autogenerated conversion function.
-func Convert_platform_CSIOperator_To_v1_CSIOperator(in *platform.CSIOperator, out *CSIOperator, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperator_To_v1_CSIOperator(in, out, s)
+// Convert_platform_DevicePlugin_To_v1_DevicePlugin is an autogenerated conversion function.
+func Convert_platform_DevicePlugin_To_v1_DevicePlugin(in *platform.DevicePlugin, out *DevicePlugin, s conversion.Scope) error {
+	return autoConvert_platform_DevicePlugin_To_v1_DevicePlugin(in, out, s)
 }
 
-func autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
+func autoConvert_v1_DevicePluginList_To_platform_DevicePluginList(in *DevicePluginList, out *platform.DevicePluginList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.DevicePlugin)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_DevicePluginList_To_platform_DevicePluginList is an autogenerated conversion function.
+func Convert_v1_DevicePluginList_To_platform_DevicePluginList(in *DevicePluginList, out *platform.DevicePluginList, s conversion.Scope) error {
+	return autoConvert_v1_DevicePluginList_To_platform_DevicePluginList(in, out, s)
+}
+
+func autoConvert_platform_DevicePluginList_To_v1_DevicePluginList(in *platform.DevicePluginList, out *DevicePluginList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]DevicePlugin)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_DevicePluginList_To_v1_DevicePluginList is an autogenerated conversion function.
+func Convert_platform_DevicePluginList_To_v1_DevicePluginList(in *platform.DevicePluginList, out *DevicePluginList, s conversion.Scope) error {
+	return autoConvert_platform_DevicePluginList_To_v1_DevicePluginList(in, out, s)
+}
+
+func autoConvert_v1_DevicePluginSpec_To_platform_DevicePluginSpec(in *DevicePluginSpec, out *platform.DevicePluginSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.DeviceClass = in.DeviceClass
+	out.Image = in.Image
+	out.MirrorImage = in.MirrorImage
+	out.ResourcePrefix = in.ResourcePrefix
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Config = in.Config
+	out.ConfigPath = in.ConfigPath
+	out.HostPaths = *(*[]string)(unsafe.Pointer(&in.HostPaths))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature is an autogenerated conversion function.
-func Convert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in *CSIOperatorFeature, out *platform.CSIOperatorFeature, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorFeature_To_platform_CSIOperatorFeature(in, out, s)
+// Convert_v1_DevicePluginSpec_To_platform_DevicePluginSpec is an autogenerated conversion function.
+func Convert_v1_DevicePluginSpec_To_platform_DevicePluginSpec(in *DevicePluginSpec, out *platform.DevicePluginSpec, s conversion.Scope) error {
+	return autoConvert_v1_DevicePluginSpec_To_platform_DevicePluginSpec(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
+func autoConvert_platform_DevicePluginSpec_To_v1_DevicePluginSpec(in *platform.DevicePluginSpec, out *DevicePluginSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.DeviceClass = in.DeviceClass
+	out.Image = in.Image
+	out.MirrorImage = in.MirrorImage
+	out.ResourcePrefix = in.ResourcePrefix
+	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Config = in.Config
+	out.ConfigPath = in.ConfigPath
+	out.HostPaths = *(*[]string)(unsafe.Pointer(&in.HostPaths))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature is an autogenerated conversion function.
-func Convert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in *platform.CSIOperatorFeature, out *CSIOperatorFeature, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorFeature_To_v1_CSIOperatorFeature(in, out, s)
+// Convert_platform_DevicePluginSpec_To_v1_DevicePluginSpec is an autogenerated conversion function.
+func Convert_platform_DevicePluginSpec_To_v1_DevicePluginSpec(in *platform.DevicePluginSpec, out *DevicePluginSpec, s conversion.Scope) error {
+	return autoConvert_platform_DevicePluginSpec_To_v1_DevicePluginSpec(in, out, s)
 }
 
-func autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
+func autoConvert_v1_DevicePluginStatus_To_platform_DevicePluginStatus(in *DevicePluginStatus, out *platform.DevicePluginStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Image = in.Image
+	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
+	out.Conditions = *(*[]platform.AddonCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1_DevicePluginStatus_To_platform_DevicePluginStatus is an autogenerated conversion function.
+func Convert_v1_DevicePluginStatus_To_platform_DevicePluginStatus(in *DevicePluginStatus, out *platform.DevicePluginStatus, s conversion.Scope) error {
+	return autoConvert_v1_DevicePluginStatus_To_platform_DevicePluginStatus(in, out, s)
+}
+
+func autoConvert_platform_DevicePluginStatus_To_v1_DevicePluginStatus(in *platform.DevicePluginStatus, out *DevicePluginStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Image = in.Image
+	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
+	out.Conditions = *(*[]AddonCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_platform_DevicePluginStatus_To_v1_DevicePluginStatus is an autogenerated conversion function.
+func Convert_platform_DevicePluginStatus_To_v1_DevicePluginStatus(in *platform.DevicePluginStatus, out *DevicePluginStatus, s conversion.Scope) error {
+	return autoConvert_platform_DevicePluginStatus_To_v1_DevicePluginStatus(in, out, s)
+}
+
+func autoConvert_v1_EgressGateway_To_platform_EgressGateway(in *EgressGateway, out *platform.EgressGateway, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_EgressGateway_To_platform_EgressGateway is an autogenerated conversion function.
+func Convert_v1_EgressGateway_To_platform_EgressGateway(in *EgressGateway, out *platform.EgressGateway, s conversion.Scope) error {
+	return autoConvert_v1_EgressGateway_To_platform_EgressGateway(in, out, s)
+}
+
+func autoConvert_platform_EgressGateway_To_v1_EgressGateway(in *platform.EgressGateway, out *EgressGateway, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_platform_EgressGateway_To_v1_EgressGateway is an autogenerated conversion function.
+func Convert_platform_EgressGateway_To_v1_EgressGateway(in *platform.EgressGateway, out *EgressGateway, s conversion.Scope) error {
+	return autoConvert_platform_EgressGateway_To_v1_EgressGateway(in, out, s)
+}
+
+func autoConvert_v1_EgressGatewayList_To_platform_EgressGatewayList(in *EgressGatewayList, out *platform.EgressGatewayList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.CSIOperator)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.EgressGateway)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_CSIOperatorList_To_platform_CSIOperatorList is an autogenerated conversion function.
-func Convert_v1_CSIOperatorList_To_platform_CSIOperatorList(in *CSIOperatorList, out *platform.CSIOperatorList, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorList_To_platform_CSIOperatorList(in, out, s)
+// Convert_v1_EgressGatewayList_To_platform_EgressGatewayList is an autogenerated conversion function.
+func Convert_v1_EgressGatewayList_To_platform_EgressGatewayList(in *EgressGatewayList, out *platform.EgressGatewayList, s conversion.Scope) error {
+	return autoConvert_v1_EgressGatewayList_To_platform_EgressGatewayList(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
+func autoConvert_platform_EgressGatewayList_To_v1_EgressGatewayList(in *platform.EgressGatewayList, out *EgressGatewayList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]CSIOperator)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]EgressGateway)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_CSIOperatorList_To_v1_CSIOperatorList is an autogenerated conversion function.
-func Convert_platform_CSIOperatorList_To_v1_CSIOperatorList(in *platform.CSIOperatorList, out *CSIOperatorList, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorList_To_v1_CSIOperatorList(in, out, s)
+// Convert_platform_EgressGatewayList_To_v1_EgressGatewayList is an autogenerated conversion function.
+func Convert_platform_EgressGatewayList_To_v1_EgressGatewayList(in *platform.EgressGatewayList, out *EgressGatewayList, s conversion.Scope) error {
+	return autoConvert_platform_EgressGatewayList_To_v1_EgressGatewayList(in, out, s)
+}
+
+func autoConvert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec(in *EgressGatewaySpec, out *platform.EgressGatewaySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.IPPools = *(*[]platform.EgressIPPool)(unsafe.Pointer(&in.IPPools))
+	out.FailoverTimeout = (*metav1.Duration)(unsafe.Pointer(in.FailoverTimeout))
+	return nil
+}
+
+// Convert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec is an autogenerated conversion function.
+func Convert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec(in *EgressGatewaySpec, out *platform.EgressGatewaySpec, s conversion.Scope) error {
+	return autoConvert_v1_EgressGatewaySpec_To_platform_EgressGatewaySpec(in, out, s)
+}
+
+func autoConvert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec(in *platform.EgressGatewaySpec, out *EgressGatewaySpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.IPPools = *(*[]EgressIPPool)(unsafe.Pointer(&in.IPPools))
+	out.FailoverTimeout = (*metav1.Duration)(unsafe.Pointer(in.FailoverTimeout))
+	return nil
+}
+
+// Convert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec is an autogenerated conversion function.
+func Convert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec(in *platform.EgressGatewaySpec, out *EgressGatewaySpec, s conversion.Scope) error {
+	return autoConvert_platform_EgressGatewaySpec_To_v1_EgressGatewaySpec(in, out, s)
+}
+
+func autoConvert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus(in *EgressGatewayStatus, out *platform.EgressGatewayStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Assignments = *(*[]platform.EgressIPAssignment)(unsafe.Pointer(&in.Assignments))
+	return nil
+}
+
+// Convert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus is an autogenerated conversion function.
+func Convert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus(in *EgressGatewayStatus, out *platform.EgressGatewayStatus, s conversion.Scope) error {
+	return autoConvert_v1_EgressGatewayStatus_To_platform_EgressGatewayStatus(in, out, s)
+}
+
+func autoConvert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus(in *platform.EgressGatewayStatus, out *EgressGatewayStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Assignments = *(*[]EgressIPAssignment)(unsafe.Pointer(&in.Assignments))
+	return nil
+}
+
+// Convert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus is an autogenerated conversion function.
+func Convert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus(in *platform.EgressGatewayStatus, out *EgressGatewayStatus, s conversion.Scope) error {
+	return autoConvert_platform_EgressGatewayStatus_To_v1_EgressGatewayStatus(in, out, s)
+}
+
+func autoConvert_v1_EgressIPAssignment_To_platform_EgressIPAssignment(in *EgressIPAssignment, out *platform.EgressIPAssignment, s conversion.Scope) error {
+	out.Pool = in.Pool
+	out.IP = in.IP
+	out.Node = in.Node
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1_EgressIPAssignment_To_platform_EgressIPAssignment is an autogenerated conversion function.
+func Convert_v1_EgressIPAssignment_To_platform_EgressIPAssignment(in *EgressIPAssignment, out *platform.EgressIPAssignment, s conversion.Scope) error {
+	return autoConvert_v1_EgressIPAssignment_To_platform_EgressIPAssignment(in, out, s)
+}
+
+func autoConvert_platform_EgressIPAssignment_To_v1_EgressIPAssignment(in *platform.EgressIPAssignment, out *EgressIPAssignment, s conversion.Scope) error {
+	out.Pool = in.Pool
+	out.IP = in.IP
+	out.Node = in.Node
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_platform_EgressIPAssignment_To_v1_EgressIPAssignment is an autogenerated conversion function.
+func Convert_platform_EgressIPAssignment_To_v1_EgressIPAssignment(in *platform.EgressIPAssignment, out *EgressIPAssignment, s conversion.Scope) error {
+	return autoConvert_platform_EgressIPAssignment_To_v1_EgressIPAssignment(in, out, s)
+}
+
+func autoConvert_v1_EgressIPPool_To_platform_EgressIPPool(in *EgressIPPool, out *platform.EgressIPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.AllNamespaces = in.AllNamespaces
+	return nil
+}
+
+// Convert_v1_EgressIPPool_To_platform_EgressIPPool is an autogenerated conversion function.
+func Convert_v1_EgressIPPool_To_platform_EgressIPPool(in *EgressIPPool, out *platform.EgressIPPool, s conversion.Scope) error {
+	return autoConvert_v1_EgressIPPool_To_platform_EgressIPPool(in, out, s)
+}
+
+func autoConvert_platform_EgressIPPool_To_v1_EgressIPPool(in *platform.EgressIPPool, out *EgressIPPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.Projects = *(*[]string)(unsafe.Pointer(&in.Projects))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.AllNamespaces = in.AllNamespaces
+	return nil
+}
+
+// Convert_platform_EgressIPPool_To_v1_EgressIPPool is an autogenerated conversion function.
+func Convert_platform_EgressIPPool_To_v1_EgressIPPool(in *platform.EgressIPPool, out *EgressIPPool, s conversion.Scope) error {
+	return autoConvert_platform_EgressIPPool_To_v1_EgressIPPool(in, out, s)
+}
+
+func autoConvert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
+	out.Local = (*platform.LocalEtcd)(unsafe.Pointer(in.Local))
+	out.External = (*platform.ExternalEtcd)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_v1_Etcd_To_platform_Etcd is an autogenerated conversion function.
+func Convert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
+	return autoConvert_v1_Etcd_To_platform_Etcd(in, out, s)
+}
+
+func autoConvert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
+	out.Local = (*LocalEtcd)(unsafe.Pointer(in.Local))
+	out.External = (*ExternalEtcd)(unsafe.Pointer(in.External))
+	return nil
+}
+
+// Convert_platform_Etcd_To_v1_Etcd is an autogenerated conversion function.
+func Convert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
+	return autoConvert_platform_Etcd_To_v1_Etcd(in, out, s)
+}
+
+func autoConvert_v1_EtcdBackup_To_platform_EtcdBackup(in *EtcdBackup, out *platform.EtcdBackup, s conversion.Scope) error {
+	out.Path = in.Path
+	out.S3 = (*platform.S3Storage)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_v1_EtcdBackup_To_platform_EtcdBackup is an autogenerated conversion function.
+func Convert_v1_EtcdBackup_To_platform_EtcdBackup(in *EtcdBackup, out *platform.EtcdBackup, s conversion.Scope) error {
+	return autoConvert_v1_EtcdBackup_To_platform_EtcdBackup(in, out, s)
+}
+
+func autoConvert_platform_EtcdBackup_To_v1_EtcdBackup(in *platform.EtcdBackup, out *EtcdBackup, s conversion.Scope) error {
+	out.Path = in.Path
+	out.S3 = (*S3Storage)(unsafe.Pointer(in.S3))
+	return nil
+}
+
+// Convert_platform_EtcdBackup_To_v1_EtcdBackup is an autogenerated conversion function.
+func Convert_platform_EtcdBackup_To_v1_EtcdBackup(in *platform.EtcdBackup, out *EtcdBackup, s conversion.Scope) error {
+	return autoConvert_platform_EtcdBackup_To_v1_EtcdBackup(in, out, s)
+}
+
+func autoConvert_v1_EtcdMaintenance_To_platform_EtcdMaintenance(in *EtcdMaintenance, out *platform.EtcdMaintenance, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.RunRequestedAt = (*metav1.Time)(unsafe.Pointer(in.RunRequestedAt))
+	return nil
+}
+
+// Convert_v1_EtcdMaintenance_To_platform_EtcdMaintenance is an autogenerated conversion function.
+func Convert_v1_EtcdMaintenance_To_platform_EtcdMaintenance(in *EtcdMaintenance, out *platform.EtcdMaintenance, s conversion.Scope) error {
+	return autoConvert_v1_EtcdMaintenance_To_platform_EtcdMaintenance(in, out, s)
+}
+
+func autoConvert_platform_EtcdMaintenance_To_v1_EtcdMaintenance(in *platform.EtcdMaintenance, out *EtcdMaintenance, s conversion.Scope) error {
+	out.Interval = in.Interval
+	out.RunRequestedAt = (*metav1.Time)(unsafe.Pointer(in.RunRequestedAt))
+	return nil
+}
+
+// Convert_platform_EtcdMaintenance_To_v1_EtcdMaintenance is an autogenerated conversion function.
+func Convert_platform_EtcdMaintenance_To_v1_EtcdMaintenance(in *platform.EtcdMaintenance, out *EtcdMaintenance, s conversion.Scope) error {
+	return autoConvert_platform_EtcdMaintenance_To_v1_EtcdMaintenance(in, out, s)
+}
+
+func autoConvert_v1_EtcdMaintenanceStatus_To_platform_EtcdMaintenanceStatus(in *EtcdMaintenanceStatus, out *platform.EtcdMaintenanceStatus, s conversion.Scope) error {
+	out.LastRunTime = in.LastRunTime
+	out.CompactedRevision = in.CompactedRevision
+	return nil
+}
+
+// Convert_v1_EtcdMaintenanceStatus_To_platform_EtcdMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1_EtcdMaintenanceStatus_To_platform_EtcdMaintenanceStatus(in *EtcdMaintenanceStatus, out *platform.EtcdMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1_EtcdMaintenanceStatus_To_platform_EtcdMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_platform_EtcdMaintenanceStatus_To_v1_EtcdMaintenanceStatus(in *platform.EtcdMaintenanceStatus, out *EtcdMaintenanceStatus, s conversion.Scope) error {
+	out.LastRunTime = in.LastRunTime
+	out.CompactedRevision = in.CompactedRevision
+	return nil
+}
+
+// Convert_platform_EtcdMaintenanceStatus_To_v1_EtcdMaintenanceStatus is an autogenerated conversion function.
+func Convert_platform_EtcdMaintenanceStatus_To_v1_EtcdMaintenanceStatus(in *platform.EtcdMaintenanceStatus, out *EtcdMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_platform_EtcdMaintenanceStatus_To_v1_EtcdMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in *EtcdSnapshot, out *platform.EtcdSnapshot, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Reason = in.Reason
+	out.Version = in.Version
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot is an autogenerated conversion function.
+func Convert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in *EtcdSnapshot, out *platform.EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_v1_EtcdSnapshot_To_platform_EtcdSnapshot(in, out, s)
+}
+
+func autoConvert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in *platform.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	out.Location = in.Location
+	out.Reason = in.Reason
+	out.Version = in.Version
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot is an autogenerated conversion function.
+func Convert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in *platform.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_platform_EtcdSnapshot_To_v1_EtcdSnapshot(in, out, s)
 }
 
-func autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
 	return nil
 }
 
-// Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec is an autogenerated conversion function.
-func Convert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in *CSIOperatorSpec, out *platform.CSIOperatorSpec, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorSpec_To_platform_CSIOperatorSpec(in, out, s)
+// Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	out.IP = in.IP
+	out.Port = in.Port
 	return nil
 }
 
-// Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec is an autogenerated conversion function.
-func Convert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in *platform.CSIOperatorSpec, out *CSIOperatorSpec, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorSpec_To_v1_CSIOperatorSpec(in, out, s)
+// Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr is an autogenerated conversion function.
+func Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
+	return autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in, out, s)
 }
 
-func autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.StorageVendorVersion = in.StorageVendorVersion
-	out.Phase = platform.AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.CAFile = in.CAFile
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
 	return nil
 }
 
-// Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus is an autogenerated conversion function.
-func Convert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in *CSIOperatorStatus, out *platform.CSIOperatorStatus, s conversion.Scope) error {
-	return autoConvert_v1_CSIOperatorStatus_To_platform_CSIOperatorStatus(in, out, s)
+// Convert_v1_ExternalEtcd_To_platform_ExternalEtcd is an autogenerated conversion function.
+func Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
+	return autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in, out, s)
 }
 
-func autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.StorageVendorVersion = in.StorageVendorVersion
-	out.Phase = AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
+	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
+	out.CAFile = in.CAFile
+	out.CertFile = in.CertFile
+	out.KeyFile = in.KeyFile
 	return nil
 }
 
-// Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus is an autogenerated conversion function.
-func Convert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in *platform.CSIOperatorStatus, out *CSIOperatorStatus, s conversion.Scope) error {
-	return autoConvert_platform_CSIOperatorStatus_To_v1_CSIOperatorStatus(in, out, s)
+// Convert_platform_ExternalEtcd_To_v1_ExternalEtcd is an autogenerated conversion function.
+func Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
+	return autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in, out, s)
 }
 
-func autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
+	out.Src = in.Src
+	out.Dst = in.Dst
 	return nil
 }
 
-// Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions is an autogenerated conversion function.
-func Convert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in *CSIProxyOptions, out *platform.CSIProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_CSIProxyOptions_To_platform_CSIProxyOptions(in, out, s)
+// Convert_v1_File_To_platform_File is an autogenerated conversion function.
+func Convert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
+	return autoConvert_v1_File_To_platform_File(in, out, s)
 }
 
-func autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
+	out.Src = in.Src
+	out.Dst = in.Dst
 	return nil
 }
 
-// Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions is an autogenerated conversion function.
-func Convert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in *platform.CSIProxyOptions, out *CSIProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_CSIProxyOptions_To_v1_CSIProxyOptions(in, out, s)
+// Convert_platform_File_To_v1_File is an autogenerated conversion function.
+func Convert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
+	return autoConvert_platform_File_To_v1_File(in, out, s)
 }
 
-func autoConvert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_ClusterSpec_To_platform_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1_ClusterStatus_To_platform_ClusterStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_FloatingIPPool_To_platform_FloatingIPPool(in *FloatingIPPool, out *platform.FloatingIPPool, s conversion.Scope) error {
+	out.Subnet = in.Subnet
+	out.NodeSubnet = in.NodeSubnet
+	out.Gateway = in.Gateway
+	out.VLAN = in.VLAN
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
 	return nil
 }
 
-// Convert_v1_Cluster_To_platform_Cluster is an autogenerated conversion function.
-func Convert_v1_Cluster_To_platform_Cluster(in *Cluster, out *platform.Cluster, s conversion.Scope) error {
-	return autoConvert_v1_Cluster_To_platform_Cluster(in, out, s)
+// Convert_v1_FloatingIPPool_To_platform_FloatingIPPool is an autogenerated conversion function.
+func Convert_v1_FloatingIPPool_To_platform_FloatingIPPool(in *FloatingIPPool, out *platform.FloatingIPPool, s conversion.Scope) error {
+	return autoConvert_v1_FloatingIPPool_To_platform_FloatingIPPool(in, out, s)
 }
 
-func autoConvert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_ClusterSpec_To_v1_ClusterSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_platform_ClusterStatus_To_v1_ClusterStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_platform_FloatingIPPool_To_v1_FloatingIPPool(in *platform.FloatingIPPool, out *FloatingIPPool, s conversion.Scope) error {
+	out.Subnet = in.Subnet
+	out.NodeSubnet = in.NodeSubnet
+	out.Gateway = in.Gateway
+	out.VLAN = in.VLAN
+	out.IPs = *(*[]string)(unsafe.Pointer(&in.IPs))
 	return nil
 }
 
-// Convert_platform_Cluster_To_v1_Cluster is an autogenerated conversion function.
-func Convert_platform_Cluster_To_v1_Cluster(in *platform.Cluster, out *Cluster, s conversion.Scope) error {
-	return autoConvert_platform_Cluster_To_v1_Cluster(in, out, s)
+// Convert_platform_FloatingIPPool_To_v1_FloatingIPPool is an autogenerated conversion function.
+func Convert_platform_FloatingIPPool_To_v1_FloatingIPPool(in *platform.FloatingIPPool, out *FloatingIPPool, s conversion.Scope) error {
+	return autoConvert_platform_FloatingIPPool_To_v1_FloatingIPPool(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
+func autoConvert_v1_FloatingIPReservation_To_platform_FloatingIPReservation(in *FloatingIPReservation, out *platform.FloatingIPReservation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_ClusterAddon_To_platform_ClusterAddon is an autogenerated conversion function.
-func Convert_v1_ClusterAddon_To_platform_ClusterAddon(in *ClusterAddon, out *platform.ClusterAddon, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddon_To_platform_ClusterAddon(in, out, s)
+// Convert_v1_FloatingIPReservation_To_platform_FloatingIPReservation is an autogenerated conversion function.
+func Convert_v1_FloatingIPReservation_To_platform_FloatingIPReservation(in *FloatingIPReservation, out *platform.FloatingIPReservation, s conversion.Scope) error {
+	return autoConvert_v1_FloatingIPReservation_To_platform_FloatingIPReservation(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
+func autoConvert_platform_FloatingIPReservation_To_v1_FloatingIPReservation(in *platform.FloatingIPReservation, out *FloatingIPReservation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_ClusterAddon_To_v1_ClusterAddon is an autogenerated conversion function.
-func Convert_platform_ClusterAddon_To_v1_ClusterAddon(in *platform.ClusterAddon, out *ClusterAddon, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddon_To_v1_ClusterAddon(in, out, s)
+// Convert_platform_FloatingIPReservation_To_v1_FloatingIPReservation is an autogenerated conversion function.
+func Convert_platform_FloatingIPReservation_To_v1_FloatingIPReservation(in *platform.FloatingIPReservation, out *FloatingIPReservation, s conversion.Scope) error {
+	return autoConvert_platform_FloatingIPReservation_To_v1_FloatingIPReservation(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
+func autoConvert_v1_FloatingIPReservationList_To_platform_FloatingIPReservationList(in *FloatingIPReservationList, out *platform.FloatingIPReservationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterAddon)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.FloatingIPReservation)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterAddonList_To_platform_ClusterAddonList is an autogenerated conversion function.
-func Convert_v1_ClusterAddonList_To_platform_ClusterAddonList(in *ClusterAddonList, out *platform.ClusterAddonList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonList_To_platform_ClusterAddonList(in, out, s)
+// Convert_v1_FloatingIPReservationList_To_platform_FloatingIPReservationList is an autogenerated conversion function.
+func Convert_v1_FloatingIPReservationList_To_platform_FloatingIPReservationList(in *FloatingIPReservationList, out *platform.FloatingIPReservationList, s conversion.Scope) error {
+	return autoConvert_v1_FloatingIPReservationList_To_platform_FloatingIPReservationList(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
+func autoConvert_platform_FloatingIPReservationList_To_v1_FloatingIPReservationList(in *platform.FloatingIPReservationList, out *FloatingIPReservationList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterAddon)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]FloatingIPReservation)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterAddonList_To_v1_ClusterAddonList is an autogenerated conversion function.
-func Convert_platform_ClusterAddonList_To_v1_ClusterAddonList(in *platform.ClusterAddonList, out *ClusterAddonList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonList_To_v1_ClusterAddonList(in, out, s)
+// Convert_platform_FloatingIPReservationList_To_v1_FloatingIPReservationList is an autogenerated conversion function.
+func Convert_platform_FloatingIPReservationList_To_v1_FloatingIPReservationList(in *platform.FloatingIPReservationList, out *FloatingIPReservationList, s conversion.Scope) error {
+	return autoConvert_platform_FloatingIPReservationList_To_v1_FloatingIPReservationList(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Level = platform.AddonLevel(in.Level)
-	out.Version = in.Version
+func autoConvert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec(in *FloatingIPReservationSpec, out *platform.FloatingIPReservationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.IP = in.IP
+	out.Namespace = in.Namespace
+	out.WorkloadKind = in.WorkloadKind
+	out.WorkloadName = in.WorkloadName
+	out.PodName = in.PodName
 	return nil
 }
 
-// Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec is an autogenerated conversion function.
-func Convert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in *ClusterAddonSpec, out *platform.ClusterAddonSpec, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonSpec_To_platform_ClusterAddonSpec(in, out, s)
+// Convert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec is an autogenerated conversion function.
+func Convert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec(in *FloatingIPReservationSpec, out *platform.FloatingIPReservationSpec, s conversion.Scope) error {
+	return autoConvert_v1_FloatingIPReservationSpec_To_platform_FloatingIPReservationSpec(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Level = AddonLevel(in.Level)
-	out.Version = in.Version
+func autoConvert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec(in *platform.FloatingIPReservationSpec, out *FloatingIPReservationSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.IP = in.IP
+	out.Namespace = in.Namespace
+	out.WorkloadKind = in.WorkloadKind
+	out.WorkloadName = in.WorkloadName
+	out.PodName = in.PodName
 	return nil
 }
 
-// Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec is an autogenerated conversion function.
-func Convert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in *platform.ClusterAddonSpec, out *ClusterAddonSpec, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonSpec_To_v1_ClusterAddonSpec(in, out, s)
+// Convert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec is an autogenerated conversion function.
+func Convert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec(in *platform.FloatingIPReservationSpec, out *FloatingIPReservationSpec, s conversion.Scope) error {
+	return autoConvert_platform_FloatingIPReservationSpec_To_v1_FloatingIPReservationSpec(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = in.Phase
+func autoConvert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus(in *FloatingIPReservationStatus, out *platform.FloatingIPReservationStatus, s conversion.Scope) error {
+	out.Phase = platform.FloatingIPReservationPhase(in.Phase)
 	out.Reason = in.Reason
+	out.Subnet = in.Subnet
+	out.LastTransitionTime = in.LastTransitionTime
 	return nil
 }
 
-// Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus is an autogenerated conversion function.
-func Convert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in *ClusterAddonStatus, out *platform.ClusterAddonStatus, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonStatus_To_platform_ClusterAddonStatus(in, out, s)
+// Convert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus is an autogenerated conversion function.
+func Convert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus(in *FloatingIPReservationStatus, out *platform.FloatingIPReservationStatus, s conversion.Scope) error {
+	return autoConvert_v1_FloatingIPReservationStatus_To_platform_FloatingIPReservationStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = in.Phase
+func autoConvert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus(in *platform.FloatingIPReservationStatus, out *FloatingIPReservationStatus, s conversion.Scope) error {
+	out.Phase = FloatingIPReservationPhase(in.Phase)
 	out.Reason = in.Reason
+	out.Subnet = in.Subnet
+	out.LastTransitionTime = in.LastTransitionTime
 	return nil
 }
 
-// Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus is an autogenerated conversion function.
-func Convert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in *platform.ClusterAddonStatus, out *ClusterAddonStatus, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonStatus_To_v1_ClusterAddonStatus(in, out, s)
+// Convert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus is an autogenerated conversion function.
+func Convert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus(in *platform.FloatingIPReservationStatus, out *FloatingIPReservationStatus, s conversion.Scope) error {
+	return autoConvert_platform_FloatingIPReservationStatus_To_v1_FloatingIPReservationStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Type = in.Type
-	out.Level = platform.AddonLevel(in.Level)
-	out.LatestVersion = in.LatestVersion
-	out.Description = in.Description
-	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+func autoConvert_v1_GPUMIGDeviceConfig_To_platform_GPUMIGDeviceConfig(in *GPUMIGDeviceConfig, out *platform.GPUMIGDeviceConfig, s conversion.Scope) error {
+	out.Devices = *(*[]int32)(unsafe.Pointer(&in.Devices))
+	out.Slices = *(*[]platform.GPUMIGSlice)(unsafe.Pointer(&in.Slices))
 	return nil
 }
 
-// Convert_v1_ClusterAddonType_To_platform_ClusterAddonType is an autogenerated conversion function.
-func Convert_v1_ClusterAddonType_To_platform_ClusterAddonType(in *ClusterAddonType, out *platform.ClusterAddonType, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonType_To_platform_ClusterAddonType(in, out, s)
+// Convert_v1_GPUMIGDeviceConfig_To_platform_GPUMIGDeviceConfig is an autogenerated conversion function.
+func Convert_v1_GPUMIGDeviceConfig_To_platform_GPUMIGDeviceConfig(in *GPUMIGDeviceConfig, out *platform.GPUMIGDeviceConfig, s conversion.Scope) error {
+	return autoConvert_v1_GPUMIGDeviceConfig_To_platform_GPUMIGDeviceConfig(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Type = in.Type
-	out.Level = AddonLevel(in.Level)
-	out.LatestVersion = in.LatestVersion
-	out.Description = in.Description
-	out.CompatibleClusterType = *(*[]string)(unsafe.Pointer(&in.CompatibleClusterType))
+func autoConvert_platform_GPUMIGDeviceConfig_To_v1_GPUMIGDeviceConfig(in *platform.GPUMIGDeviceConfig, out *GPUMIGDeviceConfig, s conversion.Scope) error {
+	out.Devices = *(*[]int32)(unsafe.Pointer(&in.Devices))
+	out.Slices = *(*[]GPUMIGSlice)(unsafe.Pointer(&in.Slices))
 	return nil
 }
 
-// Convert_platform_ClusterAddonType_To_v1_ClusterAddonType is an autogenerated conversion function.
-func Convert_platform_ClusterAddonType_To_v1_ClusterAddonType(in *platform.ClusterAddonType, out *ClusterAddonType, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonType_To_v1_ClusterAddonType(in, out, s)
+// Convert_platform_GPUMIGDeviceConfig_To_v1_GPUMIGDeviceConfig is an autogenerated conversion function.
+func Convert_platform_GPUMIGDeviceConfig_To_v1_GPUMIGDeviceConfig(in *platform.GPUMIGDeviceConfig, out *GPUMIGDeviceConfig, s conversion.Scope) error {
+	return autoConvert_platform_GPUMIGDeviceConfig_To_v1_GPUMIGDeviceConfig(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterAddonType)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_GPUMIGProfile_To_platform_GPUMIGProfile(in *GPUMIGProfile, out *platform.GPUMIGProfile, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.ResourceName = in.ResourceName
+	out.Capacity = in.Capacity
+	out.Allocatable = in.Allocatable
 	return nil
 }
 
-// Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList is an autogenerated conversion function.
-func Convert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in *ClusterAddonTypeList, out *platform.ClusterAddonTypeList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddonTypeList_To_platform_ClusterAddonTypeList(in, out, s)
+// Convert_v1_GPUMIGProfile_To_platform_GPUMIGProfile is an autogenerated conversion function.
+func Convert_v1_GPUMIGProfile_To_platform_GPUMIGProfile(in *GPUMIGProfile, out *platform.GPUMIGProfile, s conversion.Scope) error {
+	return autoConvert_v1_GPUMIGProfile_To_platform_GPUMIGProfile(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterAddonType)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_GPUMIGProfile_To_v1_GPUMIGProfile(in *platform.GPUMIGProfile, out *GPUMIGProfile, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.ResourceName = in.ResourceName
+	out.Capacity = in.Capacity
+	out.Allocatable = in.Allocatable
 	return nil
 }
 
-// Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList is an autogenerated conversion function.
-func Convert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in *platform.ClusterAddonTypeList, out *ClusterAddonTypeList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddonTypeList_To_v1_ClusterAddonTypeList(in, out, s)
+// Convert_platform_GPUMIGProfile_To_v1_GPUMIGProfile is an autogenerated conversion function.
+func Convert_platform_GPUMIGProfile_To_v1_GPUMIGProfile(in *platform.GPUMIGProfile, out *GPUMIGProfile, s conversion.Scope) error {
+	return autoConvert_platform_GPUMIGProfile_To_v1_GPUMIGProfile(in, out, s)
 }
 
-func autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
-	out.Type = platform.AddressType(in.Type)
-	out.Host = in.Host
-	out.Port = in.Port
-	out.Path = in.Path
+func autoConvert_v1_GPUMIGSlice_To_platform_GPUMIGSlice(in *GPUMIGSlice, out *platform.GPUMIGSlice, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Count = in.Count
 	return nil
 }
 
-// Convert_v1_ClusterAddress_To_platform_ClusterAddress is an autogenerated conversion function.
-func Convert_v1_ClusterAddress_To_platform_ClusterAddress(in *ClusterAddress, out *platform.ClusterAddress, s conversion.Scope) error {
-	return autoConvert_v1_ClusterAddress_To_platform_ClusterAddress(in, out, s)
+// Convert_v1_GPUMIGSlice_To_platform_GPUMIGSlice is an autogenerated conversion function.
+func Convert_v1_GPUMIGSlice_To_platform_GPUMIGSlice(in *GPUMIGSlice, out *platform.GPUMIGSlice, s conversion.Scope) error {
+	return autoConvert_v1_GPUMIGSlice_To_platform_GPUMIGSlice(in, out, s)
 }
 
-func autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
-	out.Type = AddressType(in.Type)
-	out.Host = in.Host
-	out.Port = in.Port
-	out.Path = in.Path
+func autoConvert_platform_GPUMIGSlice_To_v1_GPUMIGSlice(in *platform.GPUMIGSlice, out *GPUMIGSlice, s conversion.Scope) error {
+	out.Profile = in.Profile
+	out.Count = in.Count
 	return nil
 }
 
-// Convert_platform_ClusterAddress_To_v1_ClusterAddress is an autogenerated conversion function.
-func Convert_platform_ClusterAddress_To_v1_ClusterAddress(in *platform.ClusterAddress, out *ClusterAddress, s conversion.Scope) error {
-	return autoConvert_platform_ClusterAddress_To_v1_ClusterAddress(in, out, s)
+// Convert_platform_GPUMIGSlice_To_v1_GPUMIGSlice is an autogenerated conversion function.
+func Convert_platform_GPUMIGSlice_To_v1_GPUMIGSlice(in *platform.GPUMIGSlice, out *GPUMIGSlice, s conversion.Scope) error {
+	return autoConvert_platform_GPUMIGSlice_To_v1_GPUMIGSlice(in, out, s)
 }
 
-func autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
-	out.NotUpdate = in.NotUpdate
+func autoConvert_v1_GPUManagerFeature_To_platform_GPUManagerFeature(in *GPUManagerFeature, out *platform.GPUManagerFeature, s conversion.Scope) error {
+	out.SharePolicy = platform.GPUSharePolicy(in.SharePolicy)
+	out.MemoryUnitMiB = in.MemoryUnitMiB
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions is an autogenerated conversion function.
-func Convert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in *ClusterApplyOptions, out *platform.ClusterApplyOptions, s conversion.Scope) error {
-	return autoConvert_v1_ClusterApplyOptions_To_platform_ClusterApplyOptions(in, out, s)
+// Convert_v1_GPUManagerFeature_To_platform_GPUManagerFeature is an autogenerated conversion function.
+func Convert_v1_GPUManagerFeature_To_platform_GPUManagerFeature(in *GPUManagerFeature, out *platform.GPUManagerFeature, s conversion.Scope) error {
+	return autoConvert_v1_GPUManagerFeature_To_platform_GPUManagerFeature(in, out, s)
 }
 
-func autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
-	out.NotUpdate = in.NotUpdate
+func autoConvert_platform_GPUManagerFeature_To_v1_GPUManagerFeature(in *platform.GPUManagerFeature, out *GPUManagerFeature, s conversion.Scope) error {
+	out.SharePolicy = GPUSharePolicy(in.SharePolicy)
+	out.MemoryUnitMiB = in.MemoryUnitMiB
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions is an autogenerated conversion function.
-func Convert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in *platform.ClusterApplyOptions, out *ClusterApplyOptions, s conversion.Scope) error {
-	return autoConvert_platform_ClusterApplyOptions_To_v1_ClusterApplyOptions(in, out, s)
+// Convert_platform_GPUManagerFeature_To_v1_GPUManagerFeature is an autogenerated conversion function.
+func Convert_platform_GPUManagerFeature_To_v1_GPUManagerFeature(in *platform.GPUManagerFeature, out *GPUManagerFeature, s conversion.Scope) error {
+	return autoConvert_platform_GPUManagerFeature_To_v1_GPUManagerFeature(in, out, s)
 }
 
-func autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
-	out.Type = in.Type
-	if err := Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+func autoConvert_v1_GPUNodeConfig_To_platform_GPUNodeConfig(in *GPUNodeConfig, out *platform.GPUNodeConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_ClusterComponent_To_platform_ClusterComponent is an autogenerated conversion function.
-func Convert_v1_ClusterComponent_To_platform_ClusterComponent(in *ClusterComponent, out *platform.ClusterComponent, s conversion.Scope) error {
-	return autoConvert_v1_ClusterComponent_To_platform_ClusterComponent(in, out, s)
+// Convert_v1_GPUNodeConfig_To_platform_GPUNodeConfig is an autogenerated conversion function.
+func Convert_v1_GPUNodeConfig_To_platform_GPUNodeConfig(in *GPUNodeConfig, out *platform.GPUNodeConfig, s conversion.Scope) error {
+	return autoConvert_v1_GPUNodeConfig_To_platform_GPUNodeConfig(in, out, s)
 }
 
-func autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
-	out.Type = in.Type
-	if err := Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(&in.Replicas, &out.Replicas, s); err != nil {
+func autoConvert_platform_GPUNodeConfig_To_v1_GPUNodeConfig(in *platform.GPUNodeConfig, out *GPUNodeConfig, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_ClusterComponent_To_v1_ClusterComponent is an autogenerated conversion function.
-func Convert_platform_ClusterComponent_To_v1_ClusterComponent(in *platform.ClusterComponent, out *ClusterComponent, s conversion.Scope) error {
-	return autoConvert_platform_ClusterComponent_To_v1_ClusterComponent(in, out, s)
+// Convert_platform_GPUNodeConfig_To_v1_GPUNodeConfig is an autogenerated conversion function.
+func Convert_platform_GPUNodeConfig_To_v1_GPUNodeConfig(in *platform.GPUNodeConfig, out *GPUNodeConfig, s conversion.Scope) error {
+	return autoConvert_platform_GPUNodeConfig_To_v1_GPUNodeConfig(in, out, s)
 }
 
-func autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
-	out.Desired = in.Desired
-	out.Current = in.Current
-	out.Available = in.Available
-	out.Updated = in.Updated
+func autoConvert_v1_GPUNodeConfigList_To_platform_GPUNodeConfigList(in *GPUNodeConfigList, out *platform.GPUNodeConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.GPUNodeConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_GPUNodeConfigList_To_platform_GPUNodeConfigList is an autogenerated conversion function.
+func Convert_v1_GPUNodeConfigList_To_platform_GPUNodeConfigList(in *GPUNodeConfigList, out *platform.GPUNodeConfigList, s conversion.Scope) error {
+	return autoConvert_v1_GPUNodeConfigList_To_platform_GPUNodeConfigList(in, out, s)
+}
+
+func autoConvert_platform_GPUNodeConfigList_To_v1_GPUNodeConfigList(in *platform.GPUNodeConfigList, out *GPUNodeConfigList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]GPUNodeConfig)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_platform_GPUNodeConfigList_To_v1_GPUNodeConfigList is an autogenerated conversion function.
+func Convert_platform_GPUNodeConfigList_To_v1_GPUNodeConfigList(in *platform.GPUNodeConfigList, out *GPUNodeConfigList, s conversion.Scope) error {
+	return autoConvert_platform_GPUNodeConfigList_To_v1_GPUNodeConfigList(in, out, s)
+}
+
+func autoConvert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec(in *GPUNodeConfigSpec, out *platform.GPUNodeConfigSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.NodeName = in.NodeName
+	out.MIGDevices = *(*[]platform.GPUMIGDeviceConfig)(unsafe.Pointer(&in.MIGDevices))
 	return nil
 }
 
-// Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas is an autogenerated conversion function.
-func Convert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in *ClusterComponentReplicas, out *platform.ClusterComponentReplicas, s conversion.Scope) error {
-	return autoConvert_v1_ClusterComponentReplicas_To_platform_ClusterComponentReplicas(in, out, s)
+// Convert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec is an autogenerated conversion function.
+func Convert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec(in *GPUNodeConfigSpec, out *platform.GPUNodeConfigSpec, s conversion.Scope) error {
+	return autoConvert_v1_GPUNodeConfigSpec_To_platform_GPUNodeConfigSpec(in, out, s)
 }
 
-func autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
-	out.Desired = in.Desired
-	out.Current = in.Current
-	out.Available = in.Available
-	out.Updated = in.Updated
+func autoConvert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec(in *platform.GPUNodeConfigSpec, out *GPUNodeConfigSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.NodeName = in.NodeName
+	out.MIGDevices = *(*[]GPUMIGDeviceConfig)(unsafe.Pointer(&in.MIGDevices))
 	return nil
 }
 
-// Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas is an autogenerated conversion function.
-func Convert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in *platform.ClusterComponentReplicas, out *ClusterComponentReplicas, s conversion.Scope) error {
-	return autoConvert_platform_ClusterComponentReplicas_To_v1_ClusterComponentReplicas(in, out, s)
+// Convert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec is an autogenerated conversion function.
+func Convert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec(in *platform.GPUNodeConfigSpec, out *GPUNodeConfigSpec, s conversion.Scope) error {
+	return autoConvert_platform_GPUNodeConfigSpec_To_v1_GPUNodeConfigSpec(in, out, s)
 }
 
-func autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = platform.ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
+func autoConvert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus(in *GPUNodeConfigStatus, out *platform.GPUNodeConfigStatus, s conversion.Scope) error {
+	out.Phase = platform.GPUNodeConfigPhase(in.Phase)
 	out.Reason = in.Reason
-	out.Message = in.Message
+	out.Profiles = *(*[]platform.GPUMIGProfile)(unsafe.Pointer(&in.Profiles))
+	out.LastTransitionTime = in.LastTransitionTime
 	return nil
 }
 
-// Convert_v1_ClusterCondition_To_platform_ClusterCondition is an autogenerated conversion function.
-func Convert_v1_ClusterCondition_To_platform_ClusterCondition(in *ClusterCondition, out *platform.ClusterCondition, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCondition_To_platform_ClusterCondition(in, out, s)
+// Convert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus is an autogenerated conversion function.
+func Convert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus(in *GPUNodeConfigStatus, out *platform.GPUNodeConfigStatus, s conversion.Scope) error {
+	return autoConvert_v1_GPUNodeConfigStatus_To_platform_GPUNodeConfigStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
+func autoConvert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus(in *platform.GPUNodeConfigStatus, out *GPUNodeConfigStatus, s conversion.Scope) error {
+	out.Phase = GPUNodeConfigPhase(in.Phase)
 	out.Reason = in.Reason
-	out.Message = in.Message
+	out.Profiles = *(*[]GPUMIGProfile)(unsafe.Pointer(&in.Profiles))
+	out.LastTransitionTime = in.LastTransitionTime
 	return nil
 }
 
-// Convert_platform_ClusterCondition_To_v1_ClusterCondition is an autogenerated conversion function.
-func Convert_platform_ClusterCondition_To_v1_ClusterCondition(in *platform.ClusterCondition, out *ClusterCondition, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCondition_To_v1_ClusterCondition(in, out, s)
+// Convert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus is an autogenerated conversion function.
+func Convert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus(in *platform.GPUNodeConfigStatus, out *GPUNodeConfigStatus, s conversion.Scope) error {
+	return autoConvert_platform_GPUNodeConfigStatus_To_v1_GPUNodeConfigStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
-	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
-	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
-	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
-	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
-	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
-	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
-	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
-	out.Token = (*string)(unsafe.Pointer(in.Token))
-	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
-	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+func autoConvert_v1_GalaxyNetwork_To_platform_GalaxyNetwork(in *GalaxyNetwork, out *platform.GalaxyNetwork, s conversion.Scope) error {
+	out.Device = in.Device
+	out.FloatingIPPools = *(*[]platform.FloatingIPPool)(unsafe.Pointer(&in.FloatingIPPools))
 	return nil
 }
 
-// Convert_v1_ClusterCredential_To_platform_ClusterCredential is an autogenerated conversion function.
-func Convert_v1_ClusterCredential_To_platform_ClusterCredential(in *ClusterCredential, out *platform.ClusterCredential, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCredential_To_platform_ClusterCredential(in, out, s)
+// Convert_v1_GalaxyNetwork_To_platform_GalaxyNetwork is an autogenerated conversion function.
+func Convert_v1_GalaxyNetwork_To_platform_GalaxyNetwork(in *GalaxyNetwork, out *platform.GalaxyNetwork, s conversion.Scope) error {
+	return autoConvert_v1_GalaxyNetwork_To_platform_GalaxyNetwork(in, out, s)
 }
 
-func autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.ETCDCACert = *(*[]byte)(unsafe.Pointer(&in.ETCDCACert))
-	out.ETCDCAKey = *(*[]byte)(unsafe.Pointer(&in.ETCDCAKey))
-	out.ETCDAPIClientCert = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientCert))
-	out.ETCDAPIClientKey = *(*[]byte)(unsafe.Pointer(&in.ETCDAPIClientKey))
-	out.CACert = *(*[]byte)(unsafe.Pointer(&in.CACert))
-	out.CAKey = *(*[]byte)(unsafe.Pointer(&in.CAKey))
-	out.ClientCert = *(*[]byte)(unsafe.Pointer(&in.ClientCert))
-	out.ClientKey = *(*[]byte)(unsafe.Pointer(&in.ClientKey))
-	out.Token = (*string)(unsafe.Pointer(in.Token))
-	out.BootstrapToken = (*string)(unsafe.Pointer(in.BootstrapToken))
-	out.CertificateKey = (*string)(unsafe.Pointer(in.CertificateKey))
+func autoConvert_platform_GalaxyNetwork_To_v1_GalaxyNetwork(in *platform.GalaxyNetwork, out *GalaxyNetwork, s conversion.Scope) error {
+	out.Device = in.Device
+	out.FloatingIPPools = *(*[]FloatingIPPool)(unsafe.Pointer(&in.FloatingIPPools))
 	return nil
 }
 
-// Convert_platform_ClusterCredential_To_v1_ClusterCredential is an autogenerated conversion function.
-func Convert_platform_ClusterCredential_To_v1_ClusterCredential(in *platform.ClusterCredential, out *ClusterCredential, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCredential_To_v1_ClusterCredential(in, out, s)
+// Convert_platform_GalaxyNetwork_To_v1_GalaxyNetwork is an autogenerated conversion function.
+func Convert_platform_GalaxyNetwork_To_v1_GalaxyNetwork(in *platform.GalaxyNetwork, out *GalaxyNetwork, s conversion.Scope) error {
+	return autoConvert_platform_GalaxyNetwork_To_v1_GalaxyNetwork(in, out, s)
 }
 
-func autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ClusterCredential)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
+	out.TKEHA = (*platform.TKEHA)(unsafe.Pointer(in.TKEHA))
+	out.ThirdPartyHA = (*platform.ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
 	return nil
 }
 
-// Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList is an autogenerated conversion function.
-func Convert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in *ClusterCredentialList, out *platform.ClusterCredentialList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterCredentialList_To_platform_ClusterCredentialList(in, out, s)
+// Convert_v1_HA_To_platform_HA is an autogenerated conversion function.
+func Convert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
+	return autoConvert_v1_HA_To_platform_HA(in, out, s)
 }
 
-func autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ClusterCredential)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
+	out.TKEHA = (*TKEHA)(unsafe.Pointer(in.TKEHA))
+	out.ThirdPartyHA = (*ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
 	return nil
 }
 
-// Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList is an autogenerated conversion function.
-func Convert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in *platform.ClusterCredentialList, out *ClusterCredentialList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterCredentialList_To_v1_ClusterCredentialList(in, out, s)
+// Convert_platform_HA_To_v1_HA is an autogenerated conversion function.
+func Convert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
+	return autoConvert_platform_HA_To_v1_HA(in, out, s)
 }
 
-func autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
-	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
-	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
-	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
-	out.GPUType = (*platform.GPUType)(unsafe.Pointer(in.GPUType))
-	out.EnableMasterSchedule = in.EnableMasterSchedule
-	out.HA = (*platform.HA)(unsafe.Pointer(in.HA))
-	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
-	out.Files = *(*[]platform.File)(unsafe.Pointer(&in.Files))
-	out.Hooks = *(*map[platform.HookType]string)(unsafe.Pointer(&in.Hooks))
-	out.CSIOperator = (*platform.CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
-	out.AuthzWebhookAddr = (*platform.AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
-	out.EnableMetricsServer = in.EnableMetricsServer
-	out.IPv6DualStack = in.IPv6DualStack
-	out.EnableCilium = in.EnableCilium
-	if err := Convert_v1_Upgrade_To_platform_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+func autoConvert_v1_Helm_To_platform_Helm(in *Helm, out *platform.Helm, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_HelmSpec_To_platform_HelmSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_HelmStatus_To_platform_HelmStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_ClusterFeature_To_platform_ClusterFeature is an autogenerated conversion function.
-func Convert_v1_ClusterFeature_To_platform_ClusterFeature(in *ClusterFeature, out *platform.ClusterFeature, s conversion.Scope) error {
-	return autoConvert_v1_ClusterFeature_To_platform_ClusterFeature(in, out, s)
+// Convert_v1_Helm_To_platform_Helm is an autogenerated conversion function.
+func Convert_v1_Helm_To_platform_Helm(in *Helm, out *platform.Helm, s conversion.Scope) error {
+	return autoConvert_v1_Helm_To_platform_Helm(in, out, s)
 }
 
-func autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
-	out.IPVS = (*bool)(unsafe.Pointer(in.IPVS))
-	out.PublicLB = (*bool)(unsafe.Pointer(in.PublicLB))
-	out.InternalLB = (*bool)(unsafe.Pointer(in.InternalLB))
-	out.GPUType = (*GPUType)(unsafe.Pointer(in.GPUType))
-	out.EnableMasterSchedule = in.EnableMasterSchedule
-	out.HA = (*HA)(unsafe.Pointer(in.HA))
-	out.SkipConditions = *(*[]string)(unsafe.Pointer(&in.SkipConditions))
-	out.Files = *(*[]File)(unsafe.Pointer(&in.Files))
-	out.Hooks = *(*map[HookType]string)(unsafe.Pointer(&in.Hooks))
-	out.CSIOperator = (*CSIOperatorFeature)(unsafe.Pointer(in.CSIOperator))
-	out.AuthzWebhookAddr = (*AuthzWebhookAddr)(unsafe.Pointer(in.AuthzWebhookAddr))
-	out.EnableMetricsServer = in.EnableMetricsServer
-	out.EnableCilium = in.EnableCilium
-	out.IPv6DualStack = in.IPv6DualStack
-	if err := Convert_platform_Upgrade_To_v1_Upgrade(&in.Upgrade, &out.Upgrade, s); err != nil {
+func autoConvert_platform_Helm_To_v1_Helm(in *platform.Helm, out *Helm, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_HelmSpec_To_v1_HelmSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_HelmStatus_To_v1_HelmStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_ClusterFeature_To_v1_ClusterFeature is an autogenerated conversion function.
-func Convert_platform_ClusterFeature_To_v1_ClusterFeature(in *platform.ClusterFeature, out *ClusterFeature, s conversion.Scope) error {
-	return autoConvert_platform_ClusterFeature_To_v1_ClusterFeature(in, out, s)
+// Convert_platform_Helm_To_v1_Helm is an autogenerated conversion function.
+func Convert_platform_Helm_To_v1_Helm(in *platform.Helm, out *Helm, s conversion.Scope) error {
+	return autoConvert_platform_Helm_To_v1_Helm(in, out, s)
 }
 
-func autoConvert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
+func autoConvert_v1_HelmList_To_platform_HelmList(in *HelmList, out *platform.HelmList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]platform.Cluster, len(*in))
-		for i := range *in {
-			if err := Convert_v1_Cluster_To_platform_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Items = *(*[]platform.Helm)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterList_To_platform_ClusterList is an autogenerated conversion function.
-func Convert_v1_ClusterList_To_platform_ClusterList(in *ClusterList, out *platform.ClusterList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterList_To_platform_ClusterList(in, out, s)
+// Convert_v1_HelmList_To_platform_HelmList is an autogenerated conversion function.
+func Convert_v1_HelmList_To_platform_HelmList(in *HelmList, out *platform.HelmList, s conversion.Scope) error {
+	return autoConvert_v1_HelmList_To_platform_HelmList(in, out, s)
 }
 
-func autoConvert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
+func autoConvert_platform_HelmList_To_v1_HelmList(in *platform.HelmList, out *HelmList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
-		for i := range *in {
-			if err := Convert_platform_Cluster_To_v1_Cluster(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Items = *(*[]Helm)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterList_To_v1_ClusterList is an autogenerated conversion function.
-func Convert_platform_ClusterList_To_v1_ClusterList(in *platform.ClusterList, out *ClusterList, s conversion.Scope) error {
-	return autoConvert_platform_ClusterList_To_v1_ClusterList(in, out, s)
+// Convert_platform_HelmList_To_v1_HelmList is an autogenerated conversion function.
+func Convert_platform_HelmList_To_v1_HelmList(in *platform.HelmList, out *HelmList, s conversion.Scope) error {
+	return autoConvert_platform_HelmList_To_v1_HelmList(in, out, s)
 }
 
-func autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+func autoConvert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in *HelmProxyOptions, out *platform.HelmProxyOptions, s conversion.Scope) error {
+	out.Path = in.Path
 	return nil
 }
 
-// Convert_v1_ClusterMachine_To_platform_ClusterMachine is an autogenerated conversion function.
-func Convert_v1_ClusterMachine_To_platform_ClusterMachine(in *ClusterMachine, out *platform.ClusterMachine, s conversion.Scope) error {
-	return autoConvert_v1_ClusterMachine_To_platform_ClusterMachine(in, out, s)
+// Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions is an autogenerated conversion function.
+func Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in *HelmProxyOptions, out *platform.HelmProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in, out, s)
 }
 
-func autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+func autoConvert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in *platform.HelmProxyOptions, out *HelmProxyOptions, s conversion.Scope) error {
+	out.Path = in.Path
 	return nil
 }
 
-// Convert_platform_ClusterMachine_To_v1_ClusterMachine is an autogenerated conversion function.
-func Convert_platform_ClusterMachine_To_v1_ClusterMachine(in *platform.ClusterMachine, out *ClusterMachine, s conversion.Scope) error {
-	return autoConvert_platform_ClusterMachine_To_v1_ClusterMachine(in, out, s)
+// Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions is an autogenerated conversion function.
+func Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in *platform.HelmProxyOptions, out *HelmProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in, out, s)
 }
 
-func autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
-	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
-	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
-	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+func autoConvert_url_Values_To_v1_HelmProxyOptions(in *url.Values, out *HelmProxyOptions, s conversion.Scope) error {
+	// WARNING: Field TypeMeta does not have json tag, skipping.
+
+	if values, ok := map[string][]string(*in)["path"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Path, s); err != nil {
+			return err
+		}
+	} else {
+		out.Path = ""
+	}
 	return nil
 }
 
-// Convert_v1_ClusterProperty_To_platform_ClusterProperty is an autogenerated conversion function.
-func Convert_v1_ClusterProperty_To_platform_ClusterProperty(in *ClusterProperty, out *platform.ClusterProperty, s conversion.Scope) error {
-	return autoConvert_v1_ClusterProperty_To_platform_ClusterProperty(in, out, s)
+// Convert_url_Values_To_v1_HelmProxyOptions is an autogenerated conversion function.
+func Convert_url_Values_To_v1_HelmProxyOptions(in *url.Values, out *HelmProxyOptions, s conversion.Scope) error {
+	return autoConvert_url_Values_To_v1_HelmProxyOptions(in, out, s)
+}
+
+func autoConvert_v1_HelmSpec_To_platform_HelmSpec(in *HelmSpec, out *platform.HelmSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	return nil
 }
 
-func autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
-	out.MaxClusterServiceNum = (*int32)(unsafe.Pointer(in.MaxClusterServiceNum))
-	out.MaxNodePodNum = (*int32)(unsafe.Pointer(in.MaxNodePodNum))
-	out.OversoldRatio = *(*map[string]string)(unsafe.Pointer(&in.OversoldRatio))
+// Convert_v1_HelmSpec_To_platform_HelmSpec is an autogenerated conversion function.
+func Convert_v1_HelmSpec_To_platform_HelmSpec(in *HelmSpec, out *platform.HelmSpec, s conversion.Scope) error {
+	return autoConvert_v1_HelmSpec_To_platform_HelmSpec(in, out, s)
+}
+
+func autoConvert_platform_HelmSpec_To_v1_HelmSpec(in *platform.HelmSpec, out *HelmSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
 	return nil
 }
 
-// Convert_platform_ClusterProperty_To_v1_ClusterProperty is an autogenerated conversion function.
-func Convert_platform_ClusterProperty_To_v1_ClusterProperty(in *platform.ClusterProperty, out *ClusterProperty, s conversion.Scope) error {
-	return autoConvert_platform_ClusterProperty_To_v1_ClusterProperty(in, out, s)
+// Convert_platform_HelmSpec_To_v1_HelmSpec is an autogenerated conversion function.
+func Convert_platform_HelmSpec_To_v1_HelmSpec(in *platform.HelmSpec, out *HelmSpec, s conversion.Scope) error {
+	return autoConvert_platform_HelmSpec_To_v1_HelmSpec(in, out, s)
 }
 
-func autoConvert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
-	out.Capacity = *(*platform.ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocated = *(*platform.ResourceList)(unsafe.Pointer(&in.Allocated))
+func autoConvert_v1_HelmStatus_To_platform_HelmStatus(in *HelmStatus, out *platform.HelmStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_v1_ClusterResource_To_platform_ClusterResource is an autogenerated conversion function.
-func Convert_v1_ClusterResource_To_platform_ClusterResource(in *ClusterResource, out *platform.ClusterResource, s conversion.Scope) error {
-	return autoConvert_v1_ClusterResource_To_platform_ClusterResource(in, out, s)
+// Convert_v1_HelmStatus_To_platform_HelmStatus is an autogenerated conversion function.
+func Convert_v1_HelmStatus_To_platform_HelmStatus(in *HelmStatus, out *platform.HelmStatus, s conversion.Scope) error {
+	return autoConvert_v1_HelmStatus_To_platform_HelmStatus(in, out, s)
 }
 
-func autoConvert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
-	out.Capacity = *(*ResourceList)(unsafe.Pointer(&in.Capacity))
-	out.Allocatable = *(*ResourceList)(unsafe.Pointer(&in.Allocatable))
-	out.Allocated = *(*ResourceList)(unsafe.Pointer(&in.Allocated))
+func autoConvert_platform_HelmStatus_To_v1_HelmStatus(in *platform.HelmStatus, out *HelmStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_platform_ClusterResource_To_v1_ClusterResource is an autogenerated conversion function.
-func Convert_platform_ClusterResource_To_v1_ClusterResource(in *platform.ClusterResource, out *ClusterResource, s conversion.Scope) error {
-	return autoConvert_platform_ClusterResource_To_v1_ClusterResource(in, out, s)
+// Convert_platform_HelmStatus_To_v1_HelmStatus is an autogenerated conversion function.
+func Convert_platform_HelmStatus_To_v1_HelmStatus(in *platform.HelmStatus, out *HelmStatus, s conversion.Scope) error {
+	return autoConvert_platform_HelmStatus_To_v1_HelmStatus(in, out, s)
 }
 
-func autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
-	out.DisplayName = in.DisplayName
-	out.Type = in.Type
-	out.Version = in.Version
-	out.NetworkType = platform.NetworkType(in.NetworkType)
-	out.NetworkDevice = in.NetworkDevice
-	out.ClusterCIDR = in.ClusterCIDR
-	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
-	out.DNSDomain = in.DNSDomain
-	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
-	if err := Convert_v1_ClusterFeature_To_platform_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+func autoConvert_v1_IPAM_To_platform_IPAM(in *IPAM, out *platform.IPAM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_IPAMSpec_To_platform_IPAMSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_ClusterProperty_To_platform_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+	if err := Convert_v1_IPAMStatus_To_platform_IPAMStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Machines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.Machines))
-	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
-	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
-	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
-	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
-	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
-	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
-	out.Etcd = (*platform.Etcd)(unsafe.Pointer(in.Etcd))
-	out.HostnameAsNodename = in.HostnameAsNodename
-	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
-	out.ScalingMachines = *(*[]platform.ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
 	return nil
 }
 
-// Convert_v1_ClusterSpec_To_platform_ClusterSpec is an autogenerated conversion function.
-func Convert_v1_ClusterSpec_To_platform_ClusterSpec(in *ClusterSpec, out *platform.ClusterSpec, s conversion.Scope) error {
-	return autoConvert_v1_ClusterSpec_To_platform_ClusterSpec(in, out, s)
+// Convert_v1_IPAM_To_platform_IPAM is an autogenerated conversion function.
+func Convert_v1_IPAM_To_platform_IPAM(in *IPAM, out *platform.IPAM, s conversion.Scope) error {
+	return autoConvert_v1_IPAM_To_platform_IPAM(in, out, s)
 }
 
-func autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
-	out.TenantID = in.TenantID
-	out.DisplayName = in.DisplayName
-	out.Type = in.Type
-	out.Version = in.Version
-	out.NetworkType = NetworkType(in.NetworkType)
-	out.NetworkDevice = in.NetworkDevice
-	out.ClusterCIDR = in.ClusterCIDR
-	out.ServiceCIDR = (*string)(unsafe.Pointer(in.ServiceCIDR))
-	out.DNSDomain = in.DNSDomain
-	out.PublicAlternativeNames = *(*[]string)(unsafe.Pointer(&in.PublicAlternativeNames))
-	if err := Convert_platform_ClusterFeature_To_v1_ClusterFeature(&in.Features, &out.Features, s); err != nil {
+func autoConvert_platform_IPAM_To_v1_IPAM(in *platform.IPAM, out *IPAM, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_IPAMSpec_To_v1_IPAMSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_ClusterProperty_To_v1_ClusterProperty(&in.Properties, &out.Properties, s); err != nil {
+	if err := Convert_platform_IPAMStatus_To_v1_IPAMStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
-	out.Machines = *(*[]ClusterMachine)(unsafe.Pointer(&in.Machines))
-	out.ScalingMachines = *(*[]ClusterMachine)(unsafe.Pointer(&in.ScalingMachines))
-	out.DockerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.DockerExtraArgs))
-	out.KubeletExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.KubeletExtraArgs))
-	out.APIServerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.APIServerExtraArgs))
-	out.ControllerManagerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ControllerManagerExtraArgs))
-	out.SchedulerExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.SchedulerExtraArgs))
-	out.ClusterCredentialRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.ClusterCredentialRef))
-	out.Etcd = (*Etcd)(unsafe.Pointer(in.Etcd))
-	out.HostnameAsNodename = in.HostnameAsNodename
-	out.NetworkArgs = *(*map[string]string)(unsafe.Pointer(&in.NetworkArgs))
 	return nil
 }
 
-// Convert_platform_ClusterSpec_To_v1_ClusterSpec is an autogenerated conversion function.
-func Convert_platform_ClusterSpec_To_v1_ClusterSpec(in *platform.ClusterSpec, out *ClusterSpec, s conversion.Scope) error {
-	return autoConvert_platform_ClusterSpec_To_v1_ClusterSpec(in, out, s)
+// Convert_platform_IPAM_To_v1_IPAM is an autogenerated conversion function.
+func Convert_platform_IPAM_To_v1_IPAM(in *platform.IPAM, out *IPAM, s conversion.Scope) error {
+	return autoConvert_platform_IPAM_To_v1_IPAM(in, out, s)
 }
 
-func autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Version = in.Version
-	out.Phase = platform.ClusterPhase(in.Phase)
-	out.Conditions = *(*[]platform.ClusterCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]platform.ClusterAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_v1_ClusterResource_To_platform_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
-		return err
-	}
-	out.Components = *(*[]platform.ClusterComponent)(unsafe.Pointer(&in.Components))
-	out.ServiceCIDR = in.ServiceCIDR
-	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
-	out.DNSIP = in.DNSIP
-	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
-	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
-	out.ClusterCIDR = in.ClusterCIDR
-	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
-	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
-	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
-	out.KubeVendor = platform.KubeVendorType(in.KubeVendor)
+func autoConvert_v1_IPAMList_To_platform_IPAMList(in *IPAMList, out *platform.IPAMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.IPAM)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ClusterStatus_To_platform_ClusterStatus is an autogenerated conversion function.
-func Convert_v1_ClusterStatus_To_platform_ClusterStatus(in *ClusterStatus, out *platform.ClusterStatus, s conversion.Scope) error {
-	return autoConvert_v1_ClusterStatus_To_platform_ClusterStatus(in, out, s)
+// Convert_v1_IPAMList_To_platform_IPAMList is an autogenerated conversion function.
+func Convert_v1_IPAMList_To_platform_IPAMList(in *IPAMList, out *platform.IPAMList, s conversion.Scope) error {
+	return autoConvert_v1_IPAMList_To_platform_IPAMList(in, out, s)
 }
 
-func autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Version = in.Version
-	out.Phase = ClusterPhase(in.Phase)
-	out.Conditions = *(*[]ClusterCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
-	out.Reason = in.Reason
-	out.Addresses = *(*[]ClusterAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_platform_ClusterResource_To_v1_ClusterResource(&in.Resource, &out.Resource, s); err != nil {
-		return err
-	}
-	out.Components = *(*[]ClusterComponent)(unsafe.Pointer(&in.Components))
-	out.ServiceCIDR = in.ServiceCIDR
-	out.NodeCIDRMaskSize = in.NodeCIDRMaskSize
-	out.DNSIP = in.DNSIP
-	out.RegistryIPs = *(*[]string)(unsafe.Pointer(&in.RegistryIPs))
-	out.ClusterCIDR = in.ClusterCIDR
-	out.SecondaryServiceCIDR = in.SecondaryServiceCIDR
-	out.SecondaryClusterCIDR = in.SecondaryClusterCIDR
-	out.NodeCIDRMaskSizeIPv4 = in.NodeCIDRMaskSizeIPv4
-	out.NodeCIDRMaskSizeIPv6 = in.NodeCIDRMaskSizeIPv6
-	out.KubeVendor = KubeVendorType(in.KubeVendor)
+func autoConvert_platform_IPAMList_To_v1_IPAMList(in *platform.IPAMList, out *IPAMList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]IPAM)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ClusterStatus_To_v1_ClusterStatus is an autogenerated conversion function.
-func Convert_platform_ClusterStatus_To_v1_ClusterStatus(in *platform.ClusterStatus, out *ClusterStatus, s conversion.Scope) error {
-	return autoConvert_platform_ClusterStatus_To_v1_ClusterStatus(in, out, s)
+// Convert_platform_IPAMList_To_v1_IPAMList is an autogenerated conversion function.
+func Convert_platform_IPAMList_To_v1_IPAMList(in *platform.IPAMList, out *IPAMList, s conversion.Scope) error {
+	return autoConvert_platform_IPAMList_To_v1_IPAMList(in, out, s)
 }
 
-func autoConvert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+func autoConvert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in *IPAMProxyOptions, out *platform.IPAMProxyOptions, s conversion.Scope) error {
+	out.Path = in.Path
 	return nil
 }
 
-// Convert_v1_ConfigMap_To_platform_ConfigMap is an autogenerated conversion function.
-func Convert_v1_ConfigMap_To_platform_ConfigMap(in *ConfigMap, out *platform.ConfigMap, s conversion.Scope) error {
-	return autoConvert_v1_ConfigMap_To_platform_ConfigMap(in, out, s)
+// Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions is an autogenerated conversion function.
+func Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in *IPAMProxyOptions, out *platform.IPAMProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in, out, s)
 }
 
-func autoConvert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	out.Data = *(*map[string]string)(unsafe.Pointer(&in.Data))
-	out.BinaryData = *(*map[string][]byte)(unsafe.Pointer(&in.BinaryData))
+func autoConvert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in *platform.IPAMProxyOptions, out *IPAMProxyOptions, s conversion.Scope) error {
+	out.Path = in.Path
 	return nil
 }
 
-// Convert_platform_ConfigMap_To_v1_ConfigMap is an autogenerated conversion function.
-func Convert_platform_ConfigMap_To_v1_ConfigMap(in *platform.ConfigMap, out *ConfigMap, s conversion.Scope) error {
-	return autoConvert_platform_ConfigMap_To_v1_ConfigMap(in, out, s)
+// Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions is an autogenerated conversion function.
+func Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in *platform.IPAMProxyOptions, out *IPAMProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in, out, s)
 }
 
-func autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.ConfigMap)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_IPAMSpec_To_platform_IPAMSpec(in *IPAMSpec, out *platform.IPAMSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
 	return nil
 }
 
-// Convert_v1_ConfigMapList_To_platform_ConfigMapList is an autogenerated conversion function.
-func Convert_v1_ConfigMapList_To_platform_ConfigMapList(in *ConfigMapList, out *platform.ConfigMapList, s conversion.Scope) error {
-	return autoConvert_v1_ConfigMapList_To_platform_ConfigMapList(in, out, s)
+// Convert_v1_IPAMSpec_To_platform_IPAMSpec is an autogenerated conversion function.
+func Convert_v1_IPAMSpec_To_platform_IPAMSpec(in *IPAMSpec, out *platform.IPAMSpec, s conversion.Scope) error {
+	return autoConvert_v1_IPAMSpec_To_platform_IPAMSpec(in, out, s)
 }
 
-func autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]ConfigMap)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_IPAMSpec_To_v1_IPAMSpec(in *platform.IPAMSpec, out *IPAMSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
 	return nil
 }
 
-// Convert_platform_ConfigMapList_To_v1_ConfigMapList is an autogenerated conversion function.
-func Convert_platform_ConfigMapList_To_v1_ConfigMapList(in *platform.ConfigMapList, out *ConfigMapList, s conversion.Scope) error {
-	return autoConvert_platform_ConfigMapList_To_v1_ConfigMapList(in, out, s)
+// Convert_platform_IPAMSpec_To_v1_IPAMSpec is an autogenerated conversion function.
+func Convert_platform_IPAMSpec_To_v1_IPAMSpec(in *platform.IPAMSpec, out *IPAMSpec, s conversion.Scope) error {
+	return autoConvert_platform_IPAMSpec_To_v1_IPAMSpec(in, out, s)
 }
 
-func autoConvert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CronHPASpec_To_platform_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_IPAMStatus_To_platform_IPAMStatus(in *IPAMStatus, out *platform.IPAMStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	return nil
+}
+
+// Convert_v1_IPAMStatus_To_platform_IPAMStatus is an autogenerated conversion function.
+func Convert_v1_IPAMStatus_To_platform_IPAMStatus(in *IPAMStatus, out *platform.IPAMStatus, s conversion.Scope) error {
+	return autoConvert_v1_IPAMStatus_To_platform_IPAMStatus(in, out, s)
+}
+
+func autoConvert_platform_IPAMStatus_To_v1_IPAMStatus(in *platform.IPAMStatus, out *IPAMStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_v1_CronHPA_To_platform_CronHPA is an autogenerated conversion function.
-func Convert_v1_CronHPA_To_platform_CronHPA(in *CronHPA, out *platform.CronHPA, s conversion.Scope) error {
-	return autoConvert_v1_CronHPA_To_platform_CronHPA(in, out, s)
+// Convert_platform_IPAMStatus_To_v1_IPAMStatus is an autogenerated conversion function.
+func Convert_platform_IPAMStatus_To_v1_IPAMStatus(in *platform.IPAMStatus, out *IPAMStatus, s conversion.Scope) error {
+	return autoConvert_platform_IPAMStatus_To_v1_IPAMStatus(in, out, s)
 }
 
-func autoConvert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_CronHPASpec_To_v1_CronHPASpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_ImageAdmission_To_platform_ImageAdmission(in *ImageAdmission, out *platform.ImageAdmission, s conversion.Scope) error {
+	out.Mode = platform.ImageAdmissionMode(in.Mode)
+	out.AllowedRegistries = *(*[]string)(unsafe.Pointer(&in.AllowedRegistries))
+	out.Projects = *(*[]platform.ProjectImageAdmission)(unsafe.Pointer(&in.Projects))
+	out.ExemptNamespaces = *(*[]string)(unsafe.Pointer(&in.ExemptNamespaces))
 	return nil
 }
 
-// Convert_platform_CronHPA_To_v1_CronHPA is an autogenerated conversion function.
-func Convert_platform_CronHPA_To_v1_CronHPA(in *platform.CronHPA, out *CronHPA, s conversion.Scope) error {
-	return autoConvert_platform_CronHPA_To_v1_CronHPA(in, out, s)
+// Convert_v1_ImageAdmission_To_platform_ImageAdmission is an autogenerated conversion function.
+func Convert_v1_ImageAdmission_To_platform_ImageAdmission(in *ImageAdmission, out *platform.ImageAdmission, s conversion.Scope) error {
+	return autoConvert_v1_ImageAdmission_To_platform_ImageAdmission(in, out, s)
 }
 
-func autoConvert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.CronHPA)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_ImageAdmission_To_v1_ImageAdmission(in *platform.ImageAdmission, out *ImageAdmission, s conversion.Scope) error {
+	out.Mode = ImageAdmissionMode(in.Mode)
+	out.AllowedRegistries = *(*[]string)(unsafe.Pointer(&in.AllowedRegistries))
+	out.Projects = *(*[]ProjectImageAdmission)(unsafe.Pointer(&in.Projects))
+	out.ExemptNamespaces = *(*[]string)(unsafe.Pointer(&in.ExemptNamespaces))
 	return nil
 }
 
-// Convert_v1_CronHPAList_To_platform_CronHPAList is an autogenerated conversion function.
-func Convert_v1_CronHPAList_To_platform_CronHPAList(in *CronHPAList, out *platform.CronHPAList, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAList_To_platform_CronHPAList(in, out, s)
+// Convert_platform_ImageAdmission_To_v1_ImageAdmission is an autogenerated conversion function.
+func Convert_platform_ImageAdmission_To_v1_ImageAdmission(in *platform.ImageAdmission, out *ImageAdmission, s conversion.Scope) error {
+	return autoConvert_platform_ImageAdmission_To_v1_ImageAdmission(in, out, s)
 }
 
-func autoConvert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]CronHPA)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_KMSPlugin_To_platform_KMSPlugin(in *KMSPlugin, out *platform.KMSPlugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
 	return nil
 }
 
-// Convert_platform_CronHPAList_To_v1_CronHPAList is an autogenerated conversion function.
-func Convert_platform_CronHPAList_To_v1_CronHPAList(in *platform.CronHPAList, out *CronHPAList, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAList_To_v1_CronHPAList(in, out, s)
+// Convert_v1_KMSPlugin_To_platform_KMSPlugin is an autogenerated conversion function.
+func Convert_v1_KMSPlugin_To_platform_KMSPlugin(in *KMSPlugin, out *platform.KMSPlugin, s conversion.Scope) error {
+	return autoConvert_v1_KMSPlugin_To_platform_KMSPlugin(in, out, s)
 }
 
-func autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
+func autoConvert_platform_KMSPlugin_To_v1_KMSPlugin(in *platform.KMSPlugin, out *KMSPlugin, s conversion.Scope) error {
 	out.Name = in.Name
+	out.Endpoint = in.Endpoint
+	out.CacheSize = (*int32)(unsafe.Pointer(in.CacheSize))
 	return nil
 }
 
-// Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in *CronHPAProxyOptions, out *platform.CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAProxyOptions_To_platform_CronHPAProxyOptions(in, out, s)
+// Convert_platform_KMSPlugin_To_v1_KMSPlugin is an autogenerated conversion function.
+func Convert_platform_KMSPlugin_To_v1_KMSPlugin(in *platform.KMSPlugin, out *KMSPlugin, s conversion.Scope) error {
+	return autoConvert_platform_KMSPlugin_To_v1_KMSPlugin(in, out, s)
 }
 
-func autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_v1_KubeProxy_To_platform_KubeProxy(in *KubeProxy, out *platform.KubeProxy, s conversion.Scope) error {
+	out.Mode = platform.KubeProxyMode(in.Mode)
+	out.IPVSScheduler = in.IPVSScheduler
+	out.StrictARP = in.StrictARP
 	return nil
 }
 
-// Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in *platform.CronHPAProxyOptions, out *CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAProxyOptions_To_v1_CronHPAProxyOptions(in, out, s)
+// Convert_v1_KubeProxy_To_platform_KubeProxy is an autogenerated conversion function.
+func Convert_v1_KubeProxy_To_platform_KubeProxy(in *KubeProxy, out *platform.KubeProxy, s conversion.Scope) error {
+	return autoConvert_v1_KubeProxy_To_platform_KubeProxy(in, out, s)
 }
 
-func autoConvert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
-
-	if values, ok := map[string][]string(*in)["namespace"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Namespace, s); err != nil {
-			return err
-		}
-	} else {
-		out.Namespace = ""
-	}
-	if values, ok := map[string][]string(*in)["name"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Name, s); err != nil {
-			return err
-		}
-	} else {
-		out.Name = ""
-	}
+func autoConvert_platform_KubeProxy_To_v1_KubeProxy(in *platform.KubeProxy, out *KubeProxy, s conversion.Scope) error {
+	out.Mode = KubeProxyMode(in.Mode)
+	out.IPVSScheduler = in.IPVSScheduler
+	out.StrictARP = in.StrictARP
 	return nil
 }
 
-// Convert_url_Values_To_v1_CronHPAProxyOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_CronHPAProxyOptions(in *url.Values, out *CronHPAProxyOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_CronHPAProxyOptions(in, out, s)
+// Convert_platform_KubeProxy_To_v1_KubeProxy is an autogenerated conversion function.
+func Convert_platform_KubeProxy_To_v1_KubeProxy(in *platform.KubeProxy, out *KubeProxy, s conversion.Scope) error {
+	return autoConvert_platform_KubeProxy_To_v1_KubeProxy(in, out, s)
 }
 
-func autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_v1_KubeletConfigurationOverrides_To_platform_KubeletConfigurationOverrides(in *KubeletConfigurationOverrides, out *platform.KubeletConfigurationOverrides, s conversion.Scope) error {
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.ReservedCPUs = in.ReservedCPUs
+	out.TopologyManagerPolicy = in.TopologyManagerPolicy
 	return nil
 }
 
-// Convert_v1_CronHPASpec_To_platform_CronHPASpec is an autogenerated conversion function.
-func Convert_v1_CronHPASpec_To_platform_CronHPASpec(in *CronHPASpec, out *platform.CronHPASpec, s conversion.Scope) error {
-	return autoConvert_v1_CronHPASpec_To_platform_CronHPASpec(in, out, s)
+// Convert_v1_KubeletConfigurationOverrides_To_platform_KubeletConfigurationOverrides is an autogenerated conversion function.
+func Convert_v1_KubeletConfigurationOverrides_To_platform_KubeletConfigurationOverrides(in *KubeletConfigurationOverrides, out *platform.KubeletConfigurationOverrides, s conversion.Scope) error {
+	return autoConvert_v1_KubeletConfigurationOverrides_To_platform_KubeletConfigurationOverrides(in, out, s)
 }
 
-func autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_platform_KubeletConfigurationOverrides_To_v1_KubeletConfigurationOverrides(in *platform.KubeletConfigurationOverrides, out *KubeletConfigurationOverrides, s conversion.Scope) error {
+	out.MaxPods = (*int32)(unsafe.Pointer(in.MaxPods))
+	out.EvictionHard = *(*map[string]string)(unsafe.Pointer(&in.EvictionHard))
+	out.ReservedCPUs = in.ReservedCPUs
+	out.TopologyManagerPolicy = in.TopologyManagerPolicy
 	return nil
 }
 
-// Convert_platform_CronHPASpec_To_v1_CronHPASpec is an autogenerated conversion function.
-func Convert_platform_CronHPASpec_To_v1_CronHPASpec(in *platform.CronHPASpec, out *CronHPASpec, s conversion.Scope) error {
-	return autoConvert_platform_CronHPASpec_To_v1_CronHPASpec(in, out, s)
+// Convert_platform_KubeletConfigurationOverrides_To_v1_KubeletConfigurationOverrides is an autogenerated conversion function.
+func Convert_platform_KubeletConfigurationOverrides_To_v1_KubeletConfigurationOverrides(in *platform.KubeletConfigurationOverrides, out *KubeletConfigurationOverrides, s conversion.Scope) error {
+	return autoConvert_platform_KubeletConfigurationOverrides_To_v1_KubeletConfigurationOverrides(in, out, s)
 }
 
-func autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = platform.AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_v1_LBCF_To_platform_LBCF(in *LBCF, out *platform.LBCF, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_LBCFSpec_To_platform_LBCFSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_LBCFStatus_To_platform_LBCFStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_CronHPAStatus_To_platform_CronHPAStatus is an autogenerated conversion function.
-func Convert_v1_CronHPAStatus_To_platform_CronHPAStatus(in *CronHPAStatus, out *platform.CronHPAStatus, s conversion.Scope) error {
-	return autoConvert_v1_CronHPAStatus_To_platform_CronHPAStatus(in, out, s)
+// Convert_v1_LBCF_To_platform_LBCF is an autogenerated conversion function.
+func Convert_v1_LBCF_To_platform_LBCF(in *LBCF, out *platform.LBCF, s conversion.Scope) error {
+	return autoConvert_v1_LBCF_To_platform_LBCF(in, out, s)
 }
 
-func autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_platform_LBCF_To_v1_LBCF(in *platform.LBCF, out *LBCF, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_LBCFSpec_To_v1_LBCFSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_LBCFStatus_To_v1_LBCFStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_platform_CronHPAStatus_To_v1_CronHPAStatus is an autogenerated conversion function.
-func Convert_platform_CronHPAStatus_To_v1_CronHPAStatus(in *platform.CronHPAStatus, out *CronHPAStatus, s conversion.Scope) error {
-	return autoConvert_platform_CronHPAStatus_To_v1_CronHPAStatus(in, out, s)
+// Convert_platform_LBCF_To_v1_LBCF is an autogenerated conversion function.
+func Convert_platform_LBCF_To_v1_LBCF(in *platform.LBCF, out *LBCF, s conversion.Scope) error {
+	return autoConvert_platform_LBCF_To_v1_LBCF(in, out, s)
 }
 
-func autoConvert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
-	out.Local = (*platform.LocalEtcd)(unsafe.Pointer(in.Local))
-	out.External = (*platform.ExternalEtcd)(unsafe.Pointer(in.External))
+func autoConvert_v1_LBCFHealthCheck_To_platform_LBCFHealthCheck(in *LBCFHealthCheck, out *platform.LBCFHealthCheck, s conversion.Scope) error {
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.HealthyThreshold = in.HealthyThreshold
+	out.UnhealthyThreshold = in.UnhealthyThreshold
 	return nil
 }
 
-// Convert_v1_Etcd_To_platform_Etcd is an autogenerated conversion function.
-func Convert_v1_Etcd_To_platform_Etcd(in *Etcd, out *platform.Etcd, s conversion.Scope) error {
-	return autoConvert_v1_Etcd_To_platform_Etcd(in, out, s)
+// Convert_v1_LBCFHealthCheck_To_platform_LBCFHealthCheck is an autogenerated conversion function.
+func Convert_v1_LBCFHealthCheck_To_platform_LBCFHealthCheck(in *LBCFHealthCheck, out *platform.LBCFHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1_LBCFHealthCheck_To_platform_LBCFHealthCheck(in, out, s)
 }
 
-func autoConvert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
-	out.Local = (*LocalEtcd)(unsafe.Pointer(in.Local))
-	out.External = (*ExternalEtcd)(unsafe.Pointer(in.External))
+func autoConvert_platform_LBCFHealthCheck_To_v1_LBCFHealthCheck(in *platform.LBCFHealthCheck, out *LBCFHealthCheck, s conversion.Scope) error {
+	out.Path = in.Path
+	out.IntervalSeconds = in.IntervalSeconds
+	out.TimeoutSeconds = in.TimeoutSeconds
+	out.HealthyThreshold = in.HealthyThreshold
+	out.UnhealthyThreshold = in.UnhealthyThreshold
 	return nil
 }
 
-// Convert_platform_Etcd_To_v1_Etcd is an autogenerated conversion function.
-func Convert_platform_Etcd_To_v1_Etcd(in *platform.Etcd, out *Etcd, s conversion.Scope) error {
-	return autoConvert_platform_Etcd_To_v1_Etcd(in, out, s)
+// Convert_platform_LBCFHealthCheck_To_v1_LBCFHealthCheck is an autogenerated conversion function.
+func Convert_platform_LBCFHealthCheck_To_v1_LBCFHealthCheck(in *platform.LBCFHealthCheck, out *LBCFHealthCheck, s conversion.Scope) error {
+	return autoConvert_platform_LBCFHealthCheck_To_v1_LBCFHealthCheck(in, out, s)
 }
 
-func autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
+func autoConvert_v1_LBCFList_To_platform_LBCFList(in *LBCFList, out *platform.LBCFList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.LBCF)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in *ExternalAuthzWebhookAddr, out *platform.ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_v1_ExternalAuthzWebhookAddr_To_platform_ExternalAuthzWebhookAddr(in, out, s)
+// Convert_v1_LBCFList_To_platform_LBCFList is an autogenerated conversion function.
+func Convert_v1_LBCFList_To_platform_LBCFList(in *LBCFList, out *platform.LBCFList, s conversion.Scope) error {
+	return autoConvert_v1_LBCFList_To_platform_LBCFList(in, out, s)
 }
 
-func autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	out.IP = in.IP
-	out.Port = in.Port
+func autoConvert_platform_LBCFList_To_v1_LBCFList(in *platform.LBCFList, out *LBCFList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]LBCF)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr is an autogenerated conversion function.
-func Convert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in *platform.ExternalAuthzWebhookAddr, out *ExternalAuthzWebhookAddr, s conversion.Scope) error {
-	return autoConvert_platform_ExternalAuthzWebhookAddr_To_v1_ExternalAuthzWebhookAddr(in, out, s)
+// Convert_platform_LBCFList_To_v1_LBCFList is an autogenerated conversion function.
+func Convert_platform_LBCFList_To_v1_LBCFList(in *platform.LBCFList, out *LBCFList, s conversion.Scope) error {
+	return autoConvert_platform_LBCFList_To_v1_LBCFList(in, out, s)
 }
 
-func autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
-	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
-	out.CAFile = in.CAFile
-	out.CertFile = in.CertFile
-	out.KeyFile = in.KeyFile
+func autoConvert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in *LBCFProxyOptions, out *platform.LBCFProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = in.Action
 	return nil
 }
 
-// Convert_v1_ExternalEtcd_To_platform_ExternalEtcd is an autogenerated conversion function.
-func Convert_v1_ExternalEtcd_To_platform_ExternalEtcd(in *ExternalEtcd, out *platform.ExternalEtcd, s conversion.Scope) error {
-	return autoConvert_v1_ExternalEtcd_To_platform_ExternalEtcd(in, out, s)
+// Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions is an autogenerated conversion function.
+func Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in *LBCFProxyOptions, out *platform.LBCFProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in, out, s)
 }
 
-func autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
-	out.Endpoints = *(*[]string)(unsafe.Pointer(&in.Endpoints))
-	out.CAFile = in.CAFile
-	out.CertFile = in.CertFile
-	out.KeyFile = in.KeyFile
+func autoConvert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in *platform.LBCFProxyOptions, out *LBCFProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Action = in.Action
 	return nil
 }
 
-// Convert_platform_ExternalEtcd_To_v1_ExternalEtcd is an autogenerated conversion function.
-func Convert_platform_ExternalEtcd_To_v1_ExternalEtcd(in *platform.ExternalEtcd, out *ExternalEtcd, s conversion.Scope) error {
-	return autoConvert_platform_ExternalEtcd_To_v1_ExternalEtcd(in, out, s)
+// Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions is an autogenerated conversion function.
+func Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in *platform.LBCFProxyOptions, out *LBCFProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in, out, s)
 }
 
-func autoConvert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
-	out.Src = in.Src
-	out.Dst = in.Dst
+func autoConvert_v1_LBCFSpec_To_platform_LBCFSpec(in *LBCFSpec, out *platform.LBCFSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.HealthCheck = (*platform.LBCFHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	out.WebhookTimeoutSeconds = in.WebhookTimeoutSeconds
 	return nil
 }
 
-// Convert_v1_File_To_platform_File is an autogenerated conversion function.
-func Convert_v1_File_To_platform_File(in *File, out *platform.File, s conversion.Scope) error {
-	return autoConvert_v1_File_To_platform_File(in, out, s)
+// Convert_v1_LBCFSpec_To_platform_LBCFSpec is an autogenerated conversion function.
+func Convert_v1_LBCFSpec_To_platform_LBCFSpec(in *LBCFSpec, out *platform.LBCFSpec, s conversion.Scope) error {
+	return autoConvert_v1_LBCFSpec_To_platform_LBCFSpec(in, out, s)
 }
 
-func autoConvert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
-	out.Src = in.Src
-	out.Dst = in.Dst
+func autoConvert_platform_LBCFSpec_To_v1_LBCFSpec(in *platform.LBCFSpec, out *LBCFSpec, s conversion.Scope) error {
+	out.TenantID = in.TenantID
+	out.ClusterName = in.ClusterName
+	out.Version = in.Version
+	out.HealthCheck = (*LBCFHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	out.WebhookTimeoutSeconds = in.WebhookTimeoutSeconds
 	return nil
 }
 
-// Convert_platform_File_To_v1_File is an autogenerated conversion function.
-func Convert_platform_File_To_v1_File(in *platform.File, out *File, s conversion.Scope) error {
-	return autoConvert_platform_File_To_v1_File(in, out, s)
+// Convert_platform_LBCFSpec_To_v1_LBCFSpec is an autogenerated conversion function.
+func Convert_platform_LBCFSpec_To_v1_LBCFSpec(in *platform.LBCFSpec, out *LBCFSpec, s conversion.Scope) error {
+	return autoConvert_platform_LBCFSpec_To_v1_LBCFSpec(in, out, s)
 }
 
-func autoConvert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
-	out.TKEHA = (*platform.TKEHA)(unsafe.Pointer(in.TKEHA))
-	out.ThirdPartyHA = (*platform.ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+func autoConvert_v1_LBCFStatus_To_platform_LBCFStatus(in *LBCFStatus, out *platform.LBCFStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_v1_HA_To_platform_HA is an autogenerated conversion function.
-func Convert_v1_HA_To_platform_HA(in *HA, out *platform.HA, s conversion.Scope) error {
-	return autoConvert_v1_HA_To_platform_HA(in, out, s)
+// Convert_v1_LBCFStatus_To_platform_LBCFStatus is an autogenerated conversion function.
+func Convert_v1_LBCFStatus_To_platform_LBCFStatus(in *LBCFStatus, out *platform.LBCFStatus, s conversion.Scope) error {
+	return autoConvert_v1_LBCFStatus_To_platform_LBCFStatus(in, out, s)
 }
 
-func autoConvert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
-	out.TKEHA = (*TKEHA)(unsafe.Pointer(in.TKEHA))
-	out.ThirdPartyHA = (*ThirdPartyHA)(unsafe.Pointer(in.ThirdPartyHA))
+func autoConvert_platform_LBCFStatus_To_v1_LBCFStatus(in *platform.LBCFStatus, out *LBCFStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
 	return nil
 }
 
-// Convert_platform_HA_To_v1_HA is an autogenerated conversion function.
-func Convert_platform_HA_To_v1_HA(in *platform.HA, out *HA, s conversion.Scope) error {
-	return autoConvert_platform_HA_To_v1_HA(in, out, s)
+// Convert_platform_LBCFStatus_To_v1_LBCFStatus is an autogenerated conversion function.
+func Convert_platform_LBCFStatus_To_v1_LBCFStatus(in *platform.LBCFStatus, out *LBCFStatus, s conversion.Scope) error {
+	return autoConvert_platform_LBCFStatus_To_v1_LBCFStatus(in, out, s)
 }
 
-func autoConvert_v1_Helm_To_platform_Helm(in *Helm, out *platform.Helm, s conversion.Scope) error {
+func autoConvert_v1_License_To_platform_License(in *License, out *platform.License, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_HelmSpec_To_platform_HelmSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_LicenseSpec_To_platform_LicenseSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_HelmStatus_To_platform_HelmStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_LicenseStatus_To_platform_LicenseStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_Helm_To_platform_Helm is an autogenerated conversion function.
-func Convert_v1_Helm_To_platform_Helm(in *Helm, out *platform.Helm, s conversion.Scope) error {
-	return autoConvert_v1_Helm_To_platform_Helm(in, out, s)
+// Convert_v1_License_To_platform_License is an autogenerated conversion function.
+func Convert_v1_License_To_platform_License(in *License, out *platform.License, s conversion.Scope) error {
+	return autoConvert_v1_License_To_platform_License(in, out, s)
 }
 
-func autoConvert_platform_Helm_To_v1_Helm(in *platform.Helm, out *Helm, s conversion.Scope) error {
+func autoConvert_platform_License_To_v1_License(in *platform.License, out *License, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_HelmSpec_To_v1_HelmSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_LicenseSpec_To_v1_LicenseSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_HelmStatus_To_v1_HelmStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_LicenseStatus_To_v1_LicenseStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_Helm_To_v1_Helm is an autogenerated conversion function.
-func Convert_platform_Helm_To_v1_Helm(in *platform.Helm, out *Helm, s conversion.Scope) error {
-	return autoConvert_platform_Helm_To_v1_Helm(in, out, s)
+// Convert_platform_License_To_v1_License is an autogenerated conversion function.
+func Convert_platform_License_To_v1_License(in *platform.License, out *License, s conversion.Scope) error {
+	return autoConvert_platform_License_To_v1_License(in, out, s)
 }
 
-func autoConvert_v1_HelmList_To_platform_HelmList(in *HelmList, out *platform.HelmList, s conversion.Scope) error {
+func autoConvert_v1_LicenseList_To_platform_LicenseList(in *LicenseList, out *platform.LicenseList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.Helm)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.License)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_HelmList_To_platform_HelmList is an autogenerated conversion function.
-func Convert_v1_HelmList_To_platform_HelmList(in *HelmList, out *platform.HelmList, s conversion.Scope) error {
-	return autoConvert_v1_HelmList_To_platform_HelmList(in, out, s)
+// Convert_v1_LicenseList_To_platform_LicenseList is an autogenerated conversion function.
+func Convert_v1_LicenseList_To_platform_LicenseList(in *LicenseList, out *platform.LicenseList, s conversion.Scope) error {
+	return autoConvert_v1_LicenseList_To_platform_LicenseList(in, out, s)
 }
 
-func autoConvert_platform_HelmList_To_v1_HelmList(in *platform.HelmList, out *HelmList, s conversion.Scope) error {
+func autoConvert_platform_LicenseList_To_v1_LicenseList(in *platform.LicenseList, out *LicenseList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Helm)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]License)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_HelmList_To_v1_HelmList is an autogenerated conversion function.
-func Convert_platform_HelmList_To_v1_HelmList(in *platform.HelmList, out *HelmList, s conversion.Scope) error {
-	return autoConvert_platform_HelmList_To_v1_HelmList(in, out, s)
+// Convert_platform_LicenseList_To_v1_LicenseList is an autogenerated conversion function.
+func Convert_platform_LicenseList_To_v1_LicenseList(in *platform.LicenseList, out *LicenseList, s conversion.Scope) error {
+	return autoConvert_platform_LicenseList_To_v1_LicenseList(in, out, s)
 }
 
-func autoConvert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in *HelmProxyOptions, out *platform.HelmProxyOptions, s conversion.Scope) error {
-	out.Path = in.Path
+func autoConvert_v1_LicenseNotify_To_platform_LicenseNotify(in *LicenseNotify, out *platform.LicenseNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
 	return nil
 }
 
-// Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions is an autogenerated conversion function.
-func Convert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in *HelmProxyOptions, out *platform.HelmProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_HelmProxyOptions_To_platform_HelmProxyOptions(in, out, s)
+// Convert_v1_LicenseNotify_To_platform_LicenseNotify is an autogenerated conversion function.
+func Convert_v1_LicenseNotify_To_platform_LicenseNotify(in *LicenseNotify, out *platform.LicenseNotify, s conversion.Scope) error {
+	return autoConvert_v1_LicenseNotify_To_platform_LicenseNotify(in, out, s)
 }
 
-func autoConvert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in *platform.HelmProxyOptions, out *HelmProxyOptions, s conversion.Scope) error {
-	out.Path = in.Path
+func autoConvert_platform_LicenseNotify_To_v1_LicenseNotify(in *platform.LicenseNotify, out *LicenseNotify, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
 	return nil
 }
 
-// Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions is an autogenerated conversion function.
-func Convert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in *platform.HelmProxyOptions, out *HelmProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_HelmProxyOptions_To_v1_HelmProxyOptions(in, out, s)
+// Convert_platform_LicenseNotify_To_v1_LicenseNotify is an autogenerated conversion function.
+func Convert_platform_LicenseNotify_To_v1_LicenseNotify(in *platform.LicenseNotify, out *LicenseNotify, s conversion.Scope) error {
+	return autoConvert_platform_LicenseNotify_To_v1_LicenseNotify(in, out, s)
 }
 
-func autoConvert_url_Values_To_v1_HelmProxyOptions(in *url.Values, out *HelmProxyOptions, s conversion.Scope) error {
-	// WARNING: Field TypeMeta does not have json tag, skipping.
+func autoConvert_v1_LicenseSpec_To_platform_LicenseSpec(in *LicenseSpec, out *platform.LicenseSpec, s conversion.Scope) error {
+	out.Licensee = in.Licensee
+	out.MaxNodes = in.MaxNodes
+	out.MaxClusters = in.MaxClusters
+	out.Features = *(*[]string)(unsafe.Pointer(&in.Features))
+	out.NotBefore = in.NotBefore
+	out.NotAfter = in.NotAfter
+	out.WarningPercent = in.WarningPercent
+	out.Notify = (*platform.LicenseNotify)(unsafe.Pointer(in.Notify))
+	out.Signature = *(*[]byte)(unsafe.Pointer(&in.Signature))
+	return nil
+}
 
-	if values, ok := map[string][]string(*in)["path"]; ok && len(values) > 0 {
-		if err := runtime.Convert_Slice_string_To_string(&values, &out.Path, s); err != nil {
-			return err
-		}
-	} else {
-		out.Path = ""
-	}
+// Convert_v1_LicenseSpec_To_platform_LicenseSpec is an autogenerated conversion function.
+func Convert_v1_LicenseSpec_To_platform_LicenseSpec(in *LicenseSpec, out *platform.LicenseSpec, s conversion.Scope) error {
+	return autoConvert_v1_LicenseSpec_To_platform_LicenseSpec(in, out, s)
+}
+
+func autoConvert_platform_LicenseSpec_To_v1_LicenseSpec(in *platform.LicenseSpec, out *LicenseSpec, s conversion.Scope) error {
+	out.Licensee = in.Licensee
+	out.MaxNodes = in.MaxNodes
+	out.MaxClusters = in.MaxClusters
+	out.Features = *(*[]string)(unsafe.Pointer(&in.Features))
+	out.NotBefore = in.NotBefore
+	out.NotAfter = in.NotAfter
+	out.WarningPercent = in.WarningPercent
+	out.Notify = (*LicenseNotify)(unsafe.Pointer(in.Notify))
+	out.Signature = *(*[]byte)(unsafe.Pointer(&in.Signature))
 	return nil
 }
 
-// Convert_url_Values_To_v1_HelmProxyOptions is an autogenerated conversion function.
-func Convert_url_Values_To_v1_HelmProxyOptions(in *url.Values, out *HelmProxyOptions, s conversion.Scope) error {
-	return autoConvert_url_Values_To_v1_HelmProxyOptions(in, out, s)
+// Convert_platform_LicenseSpec_To_v1_LicenseSpec is an autogenerated conversion function.
+func Convert_platform_LicenseSpec_To_v1_LicenseSpec(in *platform.LicenseSpec, out *LicenseSpec, s conversion.Scope) error {
+	return autoConvert_platform_LicenseSpec_To_v1_LicenseSpec(in, out, s)
 }
 
-func autoConvert_v1_HelmSpec_To_platform_HelmSpec(in *HelmSpec, out *platform.HelmSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_v1_LicenseStatus_To_platform_LicenseStatus(in *LicenseStatus, out *platform.LicenseStatus, s conversion.Scope) error {
+	out.Phase = platform.LicensePhase(in.Phase)
+	out.Nodes = in.Nodes
+	out.Clusters = in.Clusters
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastWarningTime = in.LastWarningTime
 	return nil
 }
 
-// Convert_v1_HelmSpec_To_platform_HelmSpec is an autogenerated conversion function.
-func Convert_v1_HelmSpec_To_platform_HelmSpec(in *HelmSpec, out *platform.HelmSpec, s conversion.Scope) error {
-	return autoConvert_v1_HelmSpec_To_platform_HelmSpec(in, out, s)
+// Convert_v1_LicenseStatus_To_platform_LicenseStatus is an autogenerated conversion function.
+func Convert_v1_LicenseStatus_To_platform_LicenseStatus(in *LicenseStatus, out *platform.LicenseStatus, s conversion.Scope) error {
+	return autoConvert_v1_LicenseStatus_To_platform_LicenseStatus(in, out, s)
 }
 
-func autoConvert_platform_HelmSpec_To_v1_HelmSpec(in *platform.HelmSpec, out *HelmSpec, s conversion.Scope) error {
-	out.TenantID = in.TenantID
-	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+func autoConvert_platform_LicenseStatus_To_v1_LicenseStatus(in *platform.LicenseStatus, out *LicenseStatus, s conversion.Scope) error {
+	out.Phase = LicensePhase(in.Phase)
+	out.Nodes = in.Nodes
+	out.Clusters = in.Clusters
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	out.LastWarningTime = in.LastWarningTime
 	return nil
 }
 
-// Convert_platform_HelmSpec_To_v1_HelmSpec is an autogenerated conversion function.
-func Convert_platform_HelmSpec_To_v1_HelmSpec(in *platform.HelmSpec, out *HelmSpec, s conversion.Scope) error {
-	return autoConvert_platform_HelmSpec_To_v1_HelmSpec(in, out, s)
+// Convert_platform_LicenseStatus_To_v1_LicenseStatus is an autogenerated conversion function.
+func Convert_platform_LicenseStatus_To_v1_LicenseStatus(in *platform.LicenseStatus, out *LicenseStatus, s conversion.Scope) error {
+	return autoConvert_platform_LicenseStatus_To_v1_LicenseStatus(in, out, s)
 }
 
-func autoConvert_v1_HelmStatus_To_platform_HelmStatus(in *HelmStatus, out *platform.HelmStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = platform.AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
+	out.DataDir = in.DataDir
+	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
+	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
+	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+	out.QuotaBackendBytes = (*int64)(unsafe.Pointer(in.QuotaBackendBytes))
+	out.HeartbeatInterval = (*int32)(unsafe.Pointer(in.HeartbeatInterval))
+	out.ElectionTimeout = (*int32)(unsafe.Pointer(in.ElectionTimeout))
+	out.DataDevice = in.DataDevice
+	out.Maintenance = (*platform.EtcdMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
-// Convert_v1_HelmStatus_To_platform_HelmStatus is an autogenerated conversion function.
-func Convert_v1_HelmStatus_To_platform_HelmStatus(in *HelmStatus, out *platform.HelmStatus, s conversion.Scope) error {
-	return autoConvert_v1_HelmStatus_To_platform_HelmStatus(in, out, s)
+// Convert_v1_LocalEtcd_To_platform_LocalEtcd is an autogenerated conversion function.
+func Convert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
+	return autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in, out, s)
 }
 
-func autoConvert_platform_HelmStatus_To_v1_HelmStatus(in *platform.HelmStatus, out *HelmStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
+	out.DataDir = in.DataDir
+	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
+	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
+	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+	out.QuotaBackendBytes = (*int64)(unsafe.Pointer(in.QuotaBackendBytes))
+	out.HeartbeatInterval = (*int32)(unsafe.Pointer(in.HeartbeatInterval))
+	out.ElectionTimeout = (*int32)(unsafe.Pointer(in.ElectionTimeout))
+	out.DataDevice = in.DataDevice
+	out.Maintenance = (*EtcdMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
-// Convert_platform_HelmStatus_To_v1_HelmStatus is an autogenerated conversion function.
-func Convert_platform_HelmStatus_To_v1_HelmStatus(in *platform.HelmStatus, out *HelmStatus, s conversion.Scope) error {
-	return autoConvert_platform_HelmStatus_To_v1_HelmStatus(in, out, s)
+// Convert_platform_LocalEtcd_To_v1_LocalEtcd is an autogenerated conversion function.
+func Convert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
+	return autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in, out, s)
 }
 
-func autoConvert_v1_IPAM_To_platform_IPAM(in *IPAM, out *platform.IPAM, s conversion.Scope) error {
+func autoConvert_v1_LogCollector_To_platform_LogCollector(in *LogCollector, out *platform.LogCollector, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_IPAMSpec_To_platform_IPAMSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_IPAMStatus_To_platform_IPAMStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_IPAM_To_platform_IPAM is an autogenerated conversion function.
-func Convert_v1_IPAM_To_platform_IPAM(in *IPAM, out *platform.IPAM, s conversion.Scope) error {
-	return autoConvert_v1_IPAM_To_platform_IPAM(in, out, s)
+// Convert_v1_LogCollector_To_platform_LogCollector is an autogenerated conversion function.
+func Convert_v1_LogCollector_To_platform_LogCollector(in *LogCollector, out *platform.LogCollector, s conversion.Scope) error {
+	return autoConvert_v1_LogCollector_To_platform_LogCollector(in, out, s)
 }
 
-func autoConvert_platform_IPAM_To_v1_IPAM(in *platform.IPAM, out *IPAM, s conversion.Scope) error {
+func autoConvert_platform_LogCollector_To_v1_LogCollector(in *platform.LogCollector, out *LogCollector, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_IPAMSpec_To_v1_IPAMSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_IPAMStatus_To_v1_IPAMStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_IPAM_To_v1_IPAM is an autogenerated conversion function.
-func Convert_platform_IPAM_To_v1_IPAM(in *platform.IPAM, out *IPAM, s conversion.Scope) error {
-	return autoConvert_platform_IPAM_To_v1_IPAM(in, out, s)
+// Convert_platform_LogCollector_To_v1_LogCollector is an autogenerated conversion function.
+func Convert_platform_LogCollector_To_v1_LogCollector(in *platform.LogCollector, out *LogCollector, s conversion.Scope) error {
+	return autoConvert_platform_LogCollector_To_v1_LogCollector(in, out, s)
 }
 
-func autoConvert_v1_IPAMList_To_platform_IPAMList(in *IPAMList, out *platform.IPAMList, s conversion.Scope) error {
+func autoConvert_v1_LogCollectorList_To_platform_LogCollectorList(in *LogCollectorList, out *platform.LogCollectorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.IPAM)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.LogCollector)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_IPAMList_To_platform_IPAMList is an autogenerated conversion function.
-func Convert_v1_IPAMList_To_platform_IPAMList(in *IPAMList, out *platform.IPAMList, s conversion.Scope) error {
-	return autoConvert_v1_IPAMList_To_platform_IPAMList(in, out, s)
+// Convert_v1_LogCollectorList_To_platform_LogCollectorList is an autogenerated conversion function.
+func Convert_v1_LogCollectorList_To_platform_LogCollectorList(in *LogCollectorList, out *platform.LogCollectorList, s conversion.Scope) error {
+	return autoConvert_v1_LogCollectorList_To_platform_LogCollectorList(in, out, s)
 }
 
-func autoConvert_platform_IPAMList_To_v1_IPAMList(in *platform.IPAMList, out *IPAMList, s conversion.Scope) error {
+func autoConvert_platform_LogCollectorList_To_v1_LogCollectorList(in *platform.LogCollectorList, out *LogCollectorList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]IPAM)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]LogCollector)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_IPAMList_To_v1_IPAMList is an autogenerated conversion function.
-func Convert_platform_IPAMList_To_v1_IPAMList(in *platform.IPAMList, out *IPAMList, s conversion.Scope) error {
-	return autoConvert_platform_IPAMList_To_v1_IPAMList(in, out, s)
+// Convert_platform_LogCollectorList_To_v1_LogCollectorList is an autogenerated conversion function.
+func Convert_platform_LogCollectorList_To_v1_LogCollectorList(in *platform.LogCollectorList, out *LogCollectorList, s conversion.Scope) error {
+	return autoConvert_platform_LogCollectorList_To_v1_LogCollectorList(in, out, s)
 }
 
-func autoConvert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in *IPAMProxyOptions, out *platform.IPAMProxyOptions, s conversion.Scope) error {
-	out.Path = in.Path
+func autoConvert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in *LogCollectorProxyOptions, out *platform.LogCollectorProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions is an autogenerated conversion function.
-func Convert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in *IPAMProxyOptions, out *platform.IPAMProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_IPAMProxyOptions_To_platform_IPAMProxyOptions(in, out, s)
+// Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions is an autogenerated conversion function.
+func Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in *LogCollectorProxyOptions, out *platform.LogCollectorProxyOptions, s conversion.Scope) error {
+	return autoConvert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in, out, s)
 }
 
-func autoConvert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in *platform.IPAMProxyOptions, out *IPAMProxyOptions, s conversion.Scope) error {
-	out.Path = in.Path
+func autoConvert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in *platform.LogCollectorProxyOptions, out *LogCollectorProxyOptions, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
 	return nil
 }
 
-// Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions is an autogenerated conversion function.
-func Convert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in *platform.IPAMProxyOptions, out *IPAMProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_IPAMProxyOptions_To_v1_IPAMProxyOptions(in, out, s)
+// Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions is an autogenerated conversion function.
+func Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in *platform.LogCollectorProxyOptions, out *LogCollectorProxyOptions, s conversion.Scope) error {
+	return autoConvert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in, out, s)
 }
 
-func autoConvert_v1_IPAMSpec_To_platform_IPAMSpec(in *IPAMSpec, out *platform.IPAMSpec, s conversion.Scope) error {
+func autoConvert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in *LogCollectorSpec, out *platform.LogCollectorSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
 	return nil
 }
 
-// Convert_v1_IPAMSpec_To_platform_IPAMSpec is an autogenerated conversion function.
-func Convert_v1_IPAMSpec_To_platform_IPAMSpec(in *IPAMSpec, out *platform.IPAMSpec, s conversion.Scope) error {
-	return autoConvert_v1_IPAMSpec_To_platform_IPAMSpec(in, out, s)
+// Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec is an autogenerated conversion function.
+func Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in *LogCollectorSpec, out *platform.LogCollectorSpec, s conversion.Scope) error {
+	return autoConvert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in, out, s)
 }
 
-func autoConvert_platform_IPAMSpec_To_v1_IPAMSpec(in *platform.IPAMSpec, out *IPAMSpec, s conversion.Scope) error {
+func autoConvert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in *platform.LogCollectorSpec, out *LogCollectorSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
 	return nil
 }
 
-// Convert_platform_IPAMSpec_To_v1_IPAMSpec is an autogenerated conversion function.
-func Convert_platform_IPAMSpec_To_v1_IPAMSpec(in *platform.IPAMSpec, out *IPAMSpec, s conversion.Scope) error {
-	return autoConvert_platform_IPAMSpec_To_v1_IPAMSpec(in, out, s)
+// Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec is an autogenerated conversion function.
+func Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in *platform.LogCollectorSpec, out *LogCollectorSpec, s conversion.Scope) error {
+	return autoConvert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in, out, s)
 }
 
-func autoConvert_v1_IPAMStatus_To_platform_IPAMStatus(in *IPAMStatus, out *platform.IPAMStatus, s conversion.Scope) error {
+func autoConvert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in *LogCollectorStatus, out *platform.LogCollectorStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Phase = platform.AddonPhase(in.Phase)
 	out.Reason = in.Reason
@@ -2528,12 +5679,12 @@ func autoConvert_v1_IPAMStatus_To_platform_IPAMStatus(in *IPAMStatus, out *platf
 	return nil
 }
 
-// Convert_v1_IPAMStatus_To_platform_IPAMStatus is an autogenerated conversion function.
-func Convert_v1_IPAMStatus_To_platform_IPAMStatus(in *IPAMStatus, out *platform.IPAMStatus, s conversion.Scope) error {
-	return autoConvert_v1_IPAMStatus_To_platform_IPAMStatus(in, out, s)
+// Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus is an autogenerated conversion function.
+func Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in *LogCollectorStatus, out *platform.LogCollectorStatus, s conversion.Scope) error {
+	return autoConvert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in, out, s)
 }
 
-func autoConvert_platform_IPAMStatus_To_v1_IPAMStatus(in *platform.IPAMStatus, out *IPAMStatus, s conversion.Scope) error {
+func autoConvert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in *platform.LogCollectorStatus, out *LogCollectorStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Phase = AddonPhase(in.Phase)
 	out.Reason = in.Reason
@@ -2542,515 +5693,733 @@ func autoConvert_platform_IPAMStatus_To_v1_IPAMStatus(in *platform.IPAMStatus, o
 	return nil
 }
 
-// Convert_platform_IPAMStatus_To_v1_IPAMStatus is an autogenerated conversion function.
-func Convert_platform_IPAMStatus_To_v1_IPAMStatus(in *platform.IPAMStatus, out *IPAMStatus, s conversion.Scope) error {
-	return autoConvert_platform_IPAMStatus_To_v1_IPAMStatus(in, out, s)
+// Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus is an autogenerated conversion function.
+func Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in *platform.LogCollectorStatus, out *LogCollectorStatus, s conversion.Scope) error {
+	return autoConvert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in, out, s)
 }
 
-func autoConvert_v1_LBCF_To_platform_LBCF(in *LBCF, out *platform.LBCF, s conversion.Scope) error {
+func autoConvert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_LBCFSpec_To_platform_LBCFSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_MachineSpec_To_platform_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_LBCFStatus_To_platform_LBCFStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_MachineStatus_To_platform_MachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_LBCF_To_platform_LBCF is an autogenerated conversion function.
-func Convert_v1_LBCF_To_platform_LBCF(in *LBCF, out *platform.LBCF, s conversion.Scope) error {
-	return autoConvert_v1_LBCF_To_platform_LBCF(in, out, s)
+// Convert_v1_Machine_To_platform_Machine is an autogenerated conversion function.
+func Convert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
+	return autoConvert_v1_Machine_To_platform_Machine(in, out, s)
 }
 
-func autoConvert_platform_LBCF_To_v1_LBCF(in *platform.LBCF, out *LBCF, s conversion.Scope) error {
+func autoConvert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_LBCFSpec_To_v1_LBCFSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_MachineSpec_To_v1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_LBCFStatus_To_v1_LBCFStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_MachineStatus_To_v1_MachineStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_LBCF_To_v1_LBCF is an autogenerated conversion function.
-func Convert_platform_LBCF_To_v1_LBCF(in *platform.LBCF, out *LBCF, s conversion.Scope) error {
-	return autoConvert_platform_LBCF_To_v1_LBCF(in, out, s)
+// Convert_platform_Machine_To_v1_Machine is an autogenerated conversion function.
+func Convert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
+	return autoConvert_platform_Machine_To_v1_Machine(in, out, s)
 }
 
-func autoConvert_v1_LBCFList_To_platform_LBCFList(in *LBCFList, out *platform.LBCFList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.LBCF)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
+	out.Type = platform.MachineAddressType(in.Type)
+	out.Address = in.Address
 	return nil
 }
 
-// Convert_v1_LBCFList_To_platform_LBCFList is an autogenerated conversion function.
-func Convert_v1_LBCFList_To_platform_LBCFList(in *LBCFList, out *platform.LBCFList, s conversion.Scope) error {
-	return autoConvert_v1_LBCFList_To_platform_LBCFList(in, out, s)
+// Convert_v1_MachineAddress_To_platform_MachineAddress is an autogenerated conversion function.
+func Convert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
+	return autoConvert_v1_MachineAddress_To_platform_MachineAddress(in, out, s)
 }
 
-func autoConvert_platform_LBCFList_To_v1_LBCFList(in *platform.LBCFList, out *LBCFList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]LBCF)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
+	out.Type = MachineAddressType(in.Type)
+	out.Address = in.Address
+	return nil
+}
+
+// Convert_platform_MachineAddress_To_v1_MachineAddress is an autogenerated conversion function.
+func Convert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
+	return autoConvert_platform_MachineAddress_To_v1_MachineAddress(in, out, s)
+}
+
+func autoConvert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = platform.ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_MachineCondition_To_platform_MachineCondition is an autogenerated conversion function.
+func Convert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
+	return autoConvert_v1_MachineCondition_To_platform_MachineCondition(in, out, s)
+}
+
+func autoConvert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Status = ConditionStatus(in.Status)
+	out.LastProbeTime = in.LastProbeTime
+	out.LastTransitionTime = in.LastTransitionTime
+	out.Reason = in.Reason
+	out.Message = in.Message
 	return nil
 }
 
-// Convert_platform_LBCFList_To_v1_LBCFList is an autogenerated conversion function.
-func Convert_platform_LBCFList_To_v1_LBCFList(in *platform.LBCFList, out *LBCFList, s conversion.Scope) error {
-	return autoConvert_platform_LBCFList_To_v1_LBCFList(in, out, s)
+// Convert_platform_MachineCondition_To_v1_MachineCondition is an autogenerated conversion function.
+func Convert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
+	return autoConvert_platform_MachineCondition_To_v1_MachineCondition(in, out, s)
 }
 
-func autoConvert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in *LBCFProxyOptions, out *platform.LBCFProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
-	out.Action = in.Action
+func autoConvert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.Machine)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions is an autogenerated conversion function.
-func Convert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in *LBCFProxyOptions, out *platform.LBCFProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_LBCFProxyOptions_To_platform_LBCFProxyOptions(in, out, s)
+// Convert_v1_MachineList_To_platform_MachineList is an autogenerated conversion function.
+func Convert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
+	return autoConvert_v1_MachineList_To_platform_MachineList(in, out, s)
 }
 
-func autoConvert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in *platform.LBCFProxyOptions, out *LBCFProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
-	out.Action = in.Action
+func autoConvert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Machine)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions is an autogenerated conversion function.
-func Convert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in *platform.LBCFProxyOptions, out *LBCFProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_LBCFProxyOptions_To_v1_LBCFProxyOptions(in, out, s)
+// Convert_platform_MachineList_To_v1_MachineList is an autogenerated conversion function.
+func Convert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
+	return autoConvert_platform_MachineList_To_v1_MachineList(in, out, s)
 }
 
-func autoConvert_v1_LBCFSpec_To_platform_LBCFSpec(in *LBCFSpec, out *platform.LBCFSpec, s conversion.Scope) error {
+func autoConvert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+	out.Type = in.Type
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletConfiguration = (*platform.KubeletConfigurationOverrides)(unsafe.Pointer(in.KubeletConfiguration))
+	out.ServiceOverrides = (*platform.ServiceOverrides)(unsafe.Pointer(in.ServiceOverrides))
 	return nil
 }
 
-// Convert_v1_LBCFSpec_To_platform_LBCFSpec is an autogenerated conversion function.
-func Convert_v1_LBCFSpec_To_platform_LBCFSpec(in *LBCFSpec, out *platform.LBCFSpec, s conversion.Scope) error {
-	return autoConvert_v1_LBCFSpec_To_platform_LBCFSpec(in, out, s)
+// Convert_v1_MachineSpec_To_platform_MachineSpec is an autogenerated conversion function.
+func Convert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
+	return autoConvert_v1_MachineSpec_To_platform_MachineSpec(in, out, s)
 }
 
-func autoConvert_platform_LBCFSpec_To_v1_LBCFSpec(in *platform.LBCFSpec, out *LBCFSpec, s conversion.Scope) error {
+func autoConvert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Version = in.Version
+	out.Type = in.Type
+	out.IP = in.IP
+	out.Port = in.Port
+	out.Username = in.Username
+	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
+	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
+	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.KubeletConfiguration = (*KubeletConfigurationOverrides)(unsafe.Pointer(in.KubeletConfiguration))
+	out.ServiceOverrides = (*ServiceOverrides)(unsafe.Pointer(in.ServiceOverrides))
 	return nil
 }
 
-// Convert_platform_LBCFSpec_To_v1_LBCFSpec is an autogenerated conversion function.
-func Convert_platform_LBCFSpec_To_v1_LBCFSpec(in *platform.LBCFSpec, out *LBCFSpec, s conversion.Scope) error {
-	return autoConvert_platform_LBCFSpec_To_v1_LBCFSpec(in, out, s)
+// Convert_platform_MachineSpec_To_v1_MachineSpec is an autogenerated conversion function.
+func Convert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
+	return autoConvert_platform_MachineSpec_To_v1_MachineSpec(in, out, s)
 }
 
-func autoConvert_v1_LBCFStatus_To_platform_LBCFStatus(in *LBCFStatus, out *platform.LBCFStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = platform.AddonPhase(in.Phase)
+func autoConvert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Phase = platform.MachinePhase(in.Phase)
+	out.Conditions = *(*[]platform.MachineCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
 	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Addresses = *(*[]platform.MachineAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
+		return err
+	}
+	out.Progress = (*platform.Progress)(unsafe.Pointer(in.Progress))
+	out.UpgradeBackup = (*platform.MachineUpgradeBackup)(unsafe.Pointer(in.UpgradeBackup))
 	return nil
 }
 
-// Convert_v1_LBCFStatus_To_platform_LBCFStatus is an autogenerated conversion function.
-func Convert_v1_LBCFStatus_To_platform_LBCFStatus(in *LBCFStatus, out *platform.LBCFStatus, s conversion.Scope) error {
-	return autoConvert_v1_LBCFStatus_To_platform_LBCFStatus(in, out, s)
+// Convert_v1_MachineStatus_To_platform_MachineStatus is an autogenerated conversion function.
+func Convert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
+	return autoConvert_v1_MachineStatus_To_platform_MachineStatus(in, out, s)
 }
 
-func autoConvert_platform_LBCFStatus_To_v1_LBCFStatus(in *platform.LBCFStatus, out *LBCFStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = AddonPhase(in.Phase)
+func autoConvert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
+	out.Phase = MachinePhase(in.Phase)
+	out.Conditions = *(*[]MachineCondition)(unsafe.Pointer(&in.Conditions))
+	out.Message = in.Message
 	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Addresses = *(*[]MachineAddress)(unsafe.Pointer(&in.Addresses))
+	if err := Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
+		return err
+	}
+	out.Progress = (*Progress)(unsafe.Pointer(in.Progress))
+	out.UpgradeBackup = (*MachineUpgradeBackup)(unsafe.Pointer(in.UpgradeBackup))
 	return nil
 }
 
-// Convert_platform_LBCFStatus_To_v1_LBCFStatus is an autogenerated conversion function.
-func Convert_platform_LBCFStatus_To_v1_LBCFStatus(in *platform.LBCFStatus, out *LBCFStatus, s conversion.Scope) error {
-	return autoConvert_platform_LBCFStatus_To_v1_LBCFStatus(in, out, s)
+// Convert_platform_MachineStatus_To_v1_MachineStatus is an autogenerated conversion function.
+func Convert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
+	return autoConvert_platform_MachineStatus_To_v1_MachineStatus(in, out, s)
 }
 
-func autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
-	out.DataDir = in.DataDir
-	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
-	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
-	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+func autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
+	out.MachineID = in.MachineID
+	out.SystemUUID = in.SystemUUID
+	out.BootID = in.BootID
+	out.KernelVersion = in.KernelVersion
+	out.OSImage = in.OSImage
+	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
+	out.KubeletVersion = in.KubeletVersion
+	out.KubeProxyVersion = in.KubeProxyVersion
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = in.Architecture
 	return nil
 }
 
-// Convert_v1_LocalEtcd_To_platform_LocalEtcd is an autogenerated conversion function.
-func Convert_v1_LocalEtcd_To_platform_LocalEtcd(in *LocalEtcd, out *platform.LocalEtcd, s conversion.Scope) error {
-	return autoConvert_v1_LocalEtcd_To_platform_LocalEtcd(in, out, s)
+// Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo is an autogenerated conversion function.
+func Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
+	return autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in, out, s)
 }
 
-func autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
-	out.DataDir = in.DataDir
-	out.ExtraArgs = *(*map[string]string)(unsafe.Pointer(&in.ExtraArgs))
-	out.ServerCertSANs = *(*[]string)(unsafe.Pointer(&in.ServerCertSANs))
-	out.PeerCertSANs = *(*[]string)(unsafe.Pointer(&in.PeerCertSANs))
+func autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
+	out.MachineID = in.MachineID
+	out.SystemUUID = in.SystemUUID
+	out.BootID = in.BootID
+	out.KernelVersion = in.KernelVersion
+	out.OSImage = in.OSImage
+	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
+	out.KubeletVersion = in.KubeletVersion
+	out.KubeProxyVersion = in.KubeProxyVersion
+	out.OperatingSystem = in.OperatingSystem
+	out.Architecture = in.Architecture
 	return nil
 }
 
-// Convert_platform_LocalEtcd_To_v1_LocalEtcd is an autogenerated conversion function.
-func Convert_platform_LocalEtcd_To_v1_LocalEtcd(in *platform.LocalEtcd, out *LocalEtcd, s conversion.Scope) error {
-	return autoConvert_platform_LocalEtcd_To_v1_LocalEtcd(in, out, s)
+// Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo is an autogenerated conversion function.
+func Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
+	return autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in, out, s)
 }
 
-func autoConvert_v1_LogCollector_To_platform_LogCollector(in *LogCollector, out *platform.LogCollector, s conversion.Scope) error {
+func autoConvert_v1_MachineUpgradeBackup_To_platform_MachineUpgradeBackup(in *MachineUpgradeBackup, out *platform.MachineUpgradeBackup, s conversion.Scope) error {
+	out.Version = in.Version
+	out.TargetVersion = in.TargetVersion
+	out.Dir = in.Dir
+	out.Files = *(*[]string)(unsafe.Pointer(&in.Files))
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_v1_MachineUpgradeBackup_To_platform_MachineUpgradeBackup is an autogenerated conversion function.
+func Convert_v1_MachineUpgradeBackup_To_platform_MachineUpgradeBackup(in *MachineUpgradeBackup, out *platform.MachineUpgradeBackup, s conversion.Scope) error {
+	return autoConvert_v1_MachineUpgradeBackup_To_platform_MachineUpgradeBackup(in, out, s)
+}
+
+func autoConvert_platform_MachineUpgradeBackup_To_v1_MachineUpgradeBackup(in *platform.MachineUpgradeBackup, out *MachineUpgradeBackup, s conversion.Scope) error {
+	out.Version = in.Version
+	out.TargetVersion = in.TargetVersion
+	out.Dir = in.Dir
+	out.Files = *(*[]string)(unsafe.Pointer(&in.Files))
+	out.CreationTime = in.CreationTime
+	return nil
+}
+
+// Convert_platform_MachineUpgradeBackup_To_v1_MachineUpgradeBackup is an autogenerated conversion function.
+func Convert_platform_MachineUpgradeBackup_To_v1_MachineUpgradeBackup(in *platform.MachineUpgradeBackup, out *MachineUpgradeBackup, s conversion.Scope) error {
+	return autoConvert_platform_MachineUpgradeBackup_To_v1_MachineUpgradeBackup(in, out, s)
+}
+
+func autoConvert_v1_MetalLB_To_platform_MetalLB(in *MetalLB, out *platform.MetalLB, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_MetalLBSpec_To_platform_MetalLBSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_MetalLBStatus_To_platform_MetalLBStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_LogCollector_To_platform_LogCollector is an autogenerated conversion function.
-func Convert_v1_LogCollector_To_platform_LogCollector(in *LogCollector, out *platform.LogCollector, s conversion.Scope) error {
-	return autoConvert_v1_LogCollector_To_platform_LogCollector(in, out, s)
+// Convert_v1_MetalLB_To_platform_MetalLB is an autogenerated conversion function.
+func Convert_v1_MetalLB_To_platform_MetalLB(in *MetalLB, out *platform.MetalLB, s conversion.Scope) error {
+	return autoConvert_v1_MetalLB_To_platform_MetalLB(in, out, s)
 }
 
-func autoConvert_platform_LogCollector_To_v1_LogCollector(in *platform.LogCollector, out *LogCollector, s conversion.Scope) error {
+func autoConvert_platform_MetalLB_To_v1_MetalLB(in *platform.MetalLB, out *MetalLB, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_platform_MetalLBSpec_To_v1_MetalLBSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_platform_MetalLBStatus_To_v1_MetalLBStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_platform_LogCollector_To_v1_LogCollector is an autogenerated conversion function.
-func Convert_platform_LogCollector_To_v1_LogCollector(in *platform.LogCollector, out *LogCollector, s conversion.Scope) error {
-	return autoConvert_platform_LogCollector_To_v1_LogCollector(in, out, s)
+// Convert_platform_MetalLB_To_v1_MetalLB is an autogenerated conversion function.
+func Convert_platform_MetalLB_To_v1_MetalLB(in *platform.MetalLB, out *MetalLB, s conversion.Scope) error {
+	return autoConvert_platform_MetalLB_To_v1_MetalLB(in, out, s)
 }
 
-func autoConvert_v1_LogCollectorList_To_platform_LogCollectorList(in *LogCollectorList, out *platform.LogCollectorList, s conversion.Scope) error {
+func autoConvert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in *MetalLBAddressPool, out *platform.MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = platform.MetalLBProtocol(in.Protocol)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.AutoAssign = (*bool)(unsafe.Pointer(in.AutoAssign))
+	return nil
+}
+
+// Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in *MetalLBAddressPool, out *platform.MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBAddressPool_To_platform_MetalLBAddressPool(in, out, s)
+}
+
+func autoConvert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in *platform.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Protocol = MetalLBProtocol(in.Protocol)
+	out.Addresses = *(*[]string)(unsafe.Pointer(&in.Addresses))
+	out.AutoAssign = (*bool)(unsafe.Pointer(in.AutoAssign))
+	return nil
+}
+
+// Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool is an autogenerated conversion function.
+func Convert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in *platform.MetalLBAddressPool, out *MetalLBAddressPool, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBAddressPool_To_v1_MetalLBAddressPool(in, out, s)
+}
+
+func autoConvert_v1_MetalLBList_To_platform_MetalLBList(in *MetalLBList, out *platform.MetalLBList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.LogCollector)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]platform.MetalLB)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_LogCollectorList_To_platform_LogCollectorList is an autogenerated conversion function.
-func Convert_v1_LogCollectorList_To_platform_LogCollectorList(in *LogCollectorList, out *platform.LogCollectorList, s conversion.Scope) error {
-	return autoConvert_v1_LogCollectorList_To_platform_LogCollectorList(in, out, s)
+// Convert_v1_MetalLBList_To_platform_MetalLBList is an autogenerated conversion function.
+func Convert_v1_MetalLBList_To_platform_MetalLBList(in *MetalLBList, out *platform.MetalLBList, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBList_To_platform_MetalLBList(in, out, s)
 }
 
-func autoConvert_platform_LogCollectorList_To_v1_LogCollectorList(in *platform.LogCollectorList, out *LogCollectorList, s conversion.Scope) error {
+func autoConvert_platform_MetalLBList_To_v1_MetalLBList(in *platform.MetalLBList, out *MetalLBList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	out.Items = *(*[]LogCollector)(unsafe.Pointer(&in.Items))
+	out.Items = *(*[]MetalLB)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_LogCollectorList_To_v1_LogCollectorList is an autogenerated conversion function.
-func Convert_platform_LogCollectorList_To_v1_LogCollectorList(in *platform.LogCollectorList, out *LogCollectorList, s conversion.Scope) error {
-	return autoConvert_platform_LogCollectorList_To_v1_LogCollectorList(in, out, s)
+// Convert_platform_MetalLBList_To_v1_MetalLBList is an autogenerated conversion function.
+func Convert_platform_MetalLBList_To_v1_MetalLBList(in *platform.MetalLBList, out *MetalLBList, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBList_To_v1_MetalLBList(in, out, s)
 }
 
-func autoConvert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in *LogCollectorProxyOptions, out *platform.LogCollectorProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_v1_MetalLBPeer_To_platform_MetalLBPeer(in *MetalLBPeer, out *platform.MetalLBPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.MyASN = in.MyASN
+	out.PeerPort = in.PeerPort
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions is an autogenerated conversion function.
-func Convert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in *LogCollectorProxyOptions, out *platform.LogCollectorProxyOptions, s conversion.Scope) error {
-	return autoConvert_v1_LogCollectorProxyOptions_To_platform_LogCollectorProxyOptions(in, out, s)
+// Convert_v1_MetalLBPeer_To_platform_MetalLBPeer is an autogenerated conversion function.
+func Convert_v1_MetalLBPeer_To_platform_MetalLBPeer(in *MetalLBPeer, out *platform.MetalLBPeer, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBPeer_To_platform_MetalLBPeer(in, out, s)
 }
 
-func autoConvert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in *platform.LogCollectorProxyOptions, out *LogCollectorProxyOptions, s conversion.Scope) error {
-	out.Namespace = in.Namespace
-	out.Name = in.Name
+func autoConvert_platform_MetalLBPeer_To_v1_MetalLBPeer(in *platform.MetalLBPeer, out *MetalLBPeer, s conversion.Scope) error {
+	out.PeerAddress = in.PeerAddress
+	out.PeerASN = in.PeerASN
+	out.MyASN = in.MyASN
+	out.PeerPort = in.PeerPort
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions is an autogenerated conversion function.
-func Convert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in *platform.LogCollectorProxyOptions, out *LogCollectorProxyOptions, s conversion.Scope) error {
-	return autoConvert_platform_LogCollectorProxyOptions_To_v1_LogCollectorProxyOptions(in, out, s)
+// Convert_platform_MetalLBPeer_To_v1_MetalLBPeer is an autogenerated conversion function.
+func Convert_platform_MetalLBPeer_To_v1_MetalLBPeer(in *platform.MetalLBPeer, out *MetalLBPeer, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBPeer_To_v1_MetalLBPeer(in, out, s)
 }
 
-func autoConvert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in *LogCollectorSpec, out *platform.LogCollectorSpec, s conversion.Scope) error {
+func autoConvert_v1_MetalLBSpec_To_platform_MetalLBSpec(in *MetalLBSpec, out *platform.MetalLBSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.AddressPools = *(*[]platform.MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	out.Peers = *(*[]platform.MetalLBPeer)(unsafe.Pointer(&in.Peers))
 	return nil
 }
 
-// Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec is an autogenerated conversion function.
-func Convert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in *LogCollectorSpec, out *platform.LogCollectorSpec, s conversion.Scope) error {
-	return autoConvert_v1_LogCollectorSpec_To_platform_LogCollectorSpec(in, out, s)
+// Convert_v1_MetalLBSpec_To_platform_MetalLBSpec is an autogenerated conversion function.
+func Convert_v1_MetalLBSpec_To_platform_MetalLBSpec(in *MetalLBSpec, out *platform.MetalLBSpec, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBSpec_To_platform_MetalLBSpec(in, out, s)
 }
 
-func autoConvert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in *platform.LogCollectorSpec, out *LogCollectorSpec, s conversion.Scope) error {
+func autoConvert_platform_MetalLBSpec_To_v1_MetalLBSpec(in *platform.MetalLBSpec, out *MetalLBSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.AddressPools = *(*[]MetalLBAddressPool)(unsafe.Pointer(&in.AddressPools))
+	out.Peers = *(*[]MetalLBPeer)(unsafe.Pointer(&in.Peers))
 	return nil
 }
 
-// Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec is an autogenerated conversion function.
-func Convert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in *platform.LogCollectorSpec, out *LogCollectorSpec, s conversion.Scope) error {
-	return autoConvert_platform_LogCollectorSpec_To_v1_LogCollectorSpec(in, out, s)
+// Convert_platform_MetalLBSpec_To_v1_MetalLBSpec is an autogenerated conversion function.
+func Convert_platform_MetalLBSpec_To_v1_MetalLBSpec(in *platform.MetalLBSpec, out *MetalLBSpec, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBSpec_To_v1_MetalLBSpec(in, out, s)
 }
 
-func autoConvert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in *LogCollectorStatus, out *platform.LogCollectorStatus, s conversion.Scope) error {
+func autoConvert_v1_MetalLBStatus_To_platform_MetalLBStatus(in *MetalLBStatus, out *platform.MetalLBStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Phase = platform.AddonPhase(in.Phase)
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Conditions = *(*[]platform.AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus is an autogenerated conversion function.
-func Convert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in *LogCollectorStatus, out *platform.LogCollectorStatus, s conversion.Scope) error {
-	return autoConvert_v1_LogCollectorStatus_To_platform_LogCollectorStatus(in, out, s)
+// Convert_v1_MetalLBStatus_To_platform_MetalLBStatus is an autogenerated conversion function.
+func Convert_v1_MetalLBStatus_To_platform_MetalLBStatus(in *MetalLBStatus, out *platform.MetalLBStatus, s conversion.Scope) error {
+	return autoConvert_v1_MetalLBStatus_To_platform_MetalLBStatus(in, out, s)
 }
 
-func autoConvert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in *platform.LogCollectorStatus, out *LogCollectorStatus, s conversion.Scope) error {
+func autoConvert_platform_MetalLBStatus_To_v1_MetalLBStatus(in *platform.MetalLBStatus, out *MetalLBStatus, s conversion.Scope) error {
 	out.Version = in.Version
 	out.Phase = AddonPhase(in.Phase)
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Conditions = *(*[]AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus is an autogenerated conversion function.
-func Convert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in *platform.LogCollectorStatus, out *LogCollectorStatus, s conversion.Scope) error {
-	return autoConvert_platform_LogCollectorStatus_To_v1_LogCollectorStatus(in, out, s)
+// Convert_platform_MetalLBStatus_To_v1_MetalLBStatus is an autogenerated conversion function.
+func Convert_platform_MetalLBStatus_To_v1_MetalLBStatus(in *platform.MetalLBStatus, out *MetalLBStatus, s conversion.Scope) error {
+	return autoConvert_platform_MetalLBStatus_To_v1_MetalLBStatus(in, out, s)
 }
 
-func autoConvert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
+func autoConvert_v1_Multus_To_platform_Multus(in *Multus, out *platform.Multus, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_MachineSpec_To_platform_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_MultusSpec_To_platform_MultusSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_MachineStatus_To_platform_MachineStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_MultusStatus_To_platform_MultusStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Multus_To_platform_Multus is an autogenerated conversion function.
+func Convert_v1_Multus_To_platform_Multus(in *Multus, out *platform.Multus, s conversion.Scope) error {
+	return autoConvert_v1_Multus_To_platform_Multus(in, out, s)
+}
+
+func autoConvert_platform_Multus_To_v1_Multus(in *platform.Multus, out *Multus, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_platform_MultusSpec_To_v1_MultusSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_platform_MultusStatus_To_v1_MultusStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_Machine_To_platform_Machine is an autogenerated conversion function.
-func Convert_v1_Machine_To_platform_Machine(in *Machine, out *platform.Machine, s conversion.Scope) error {
-	return autoConvert_v1_Machine_To_platform_Machine(in, out, s)
+// Convert_platform_Multus_To_v1_Multus is an autogenerated conversion function.
+func Convert_platform_Multus_To_v1_Multus(in *platform.Multus, out *Multus, s conversion.Scope) error {
+	return autoConvert_platform_Multus_To_v1_Multus(in, out, s)
+}
+
+func autoConvert_v1_MultusIPAM_To_platform_MultusIPAM(in *MultusIPAM, out *platform.MultusIPAM, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Subnet = in.Subnet
+	out.RangeStart = in.RangeStart
+	out.RangeEnd = in.RangeEnd
+	out.Gateway = in.Gateway
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_v1_MultusIPAM_To_platform_MultusIPAM is an autogenerated conversion function.
+func Convert_v1_MultusIPAM_To_platform_MultusIPAM(in *MultusIPAM, out *platform.MultusIPAM, s conversion.Scope) error {
+	return autoConvert_v1_MultusIPAM_To_platform_MultusIPAM(in, out, s)
+}
+
+func autoConvert_platform_MultusIPAM_To_v1_MultusIPAM(in *platform.MultusIPAM, out *MultusIPAM, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Subnet = in.Subnet
+	out.RangeStart = in.RangeStart
+	out.RangeEnd = in.RangeEnd
+	out.Gateway = in.Gateway
+	out.Routes = *(*[]string)(unsafe.Pointer(&in.Routes))
+	return nil
+}
+
+// Convert_platform_MultusIPAM_To_v1_MultusIPAM is an autogenerated conversion function.
+func Convert_platform_MultusIPAM_To_v1_MultusIPAM(in *platform.MultusIPAM, out *MultusIPAM, s conversion.Scope) error {
+	return autoConvert_platform_MultusIPAM_To_v1_MultusIPAM(in, out, s)
+}
+
+func autoConvert_v1_MultusList_To_platform_MultusList(in *MultusList, out *platform.MultusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]platform.Multus)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_MultusList_To_platform_MultusList is an autogenerated conversion function.
+func Convert_v1_MultusList_To_platform_MultusList(in *MultusList, out *platform.MultusList, s conversion.Scope) error {
+	return autoConvert_v1_MultusList_To_platform_MultusList(in, out, s)
 }
 
-func autoConvert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_platform_MachineSpec_To_v1_MachineSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_platform_MachineStatus_To_v1_MachineStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_platform_MultusList_To_v1_MultusList(in *platform.MultusList, out *MultusList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]Multus)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_platform_Machine_To_v1_Machine is an autogenerated conversion function.
-func Convert_platform_Machine_To_v1_Machine(in *platform.Machine, out *Machine, s conversion.Scope) error {
-	return autoConvert_platform_Machine_To_v1_Machine(in, out, s)
+// Convert_platform_MultusList_To_v1_MultusList is an autogenerated conversion function.
+func Convert_platform_MultusList_To_v1_MultusList(in *platform.MultusList, out *MultusList, s conversion.Scope) error {
+	return autoConvert_platform_MultusList_To_v1_MultusList(in, out, s)
 }
 
-func autoConvert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
-	out.Type = platform.MachineAddressType(in.Type)
-	out.Address = in.Address
+func autoConvert_v1_MultusNetworkAttachment_To_platform_MultusNetworkAttachment(in *MultusNetworkAttachment, out *platform.MultusNetworkAttachment, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Type = in.Type
+	out.Master = in.Master
+	out.Mode = in.Mode
+	out.ResourceName = in.ResourceName
+	out.VLAN = in.VLAN
+	out.MTU = in.MTU
+	out.IPAM = (*platform.MultusIPAM)(unsafe.Pointer(in.IPAM))
+	out.Config = in.Config
 	return nil
 }
 
-// Convert_v1_MachineAddress_To_platform_MachineAddress is an autogenerated conversion function.
-func Convert_v1_MachineAddress_To_platform_MachineAddress(in *MachineAddress, out *platform.MachineAddress, s conversion.Scope) error {
-	return autoConvert_v1_MachineAddress_To_platform_MachineAddress(in, out, s)
+// Convert_v1_MultusNetworkAttachment_To_platform_MultusNetworkAttachment is an autogenerated conversion function.
+func Convert_v1_MultusNetworkAttachment_To_platform_MultusNetworkAttachment(in *MultusNetworkAttachment, out *platform.MultusNetworkAttachment, s conversion.Scope) error {
+	return autoConvert_v1_MultusNetworkAttachment_To_platform_MultusNetworkAttachment(in, out, s)
 }
 
-func autoConvert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
-	out.Type = MachineAddressType(in.Type)
-	out.Address = in.Address
+func autoConvert_platform_MultusNetworkAttachment_To_v1_MultusNetworkAttachment(in *platform.MultusNetworkAttachment, out *MultusNetworkAttachment, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Type = in.Type
+	out.Master = in.Master
+	out.Mode = in.Mode
+	out.ResourceName = in.ResourceName
+	out.VLAN = in.VLAN
+	out.MTU = in.MTU
+	out.IPAM = (*MultusIPAM)(unsafe.Pointer(in.IPAM))
+	out.Config = in.Config
 	return nil
 }
 
-// Convert_platform_MachineAddress_To_v1_MachineAddress is an autogenerated conversion function.
-func Convert_platform_MachineAddress_To_v1_MachineAddress(in *platform.MachineAddress, out *MachineAddress, s conversion.Scope) error {
-	return autoConvert_platform_MachineAddress_To_v1_MachineAddress(in, out, s)
+// Convert_platform_MultusNetworkAttachment_To_v1_MultusNetworkAttachment is an autogenerated conversion function.
+func Convert_platform_MultusNetworkAttachment_To_v1_MultusNetworkAttachment(in *platform.MultusNetworkAttachment, out *MultusNetworkAttachment, s conversion.Scope) error {
+	return autoConvert_platform_MultusNetworkAttachment_To_v1_MultusNetworkAttachment(in, out, s)
 }
 
-func autoConvert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = platform.ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_v1_MultusSRIOV_To_platform_MultusSRIOV(in *MultusSRIOV, out *platform.MultusSRIOV, s conversion.Scope) error {
+	out.ResourcePrefix = in.ResourcePrefix
+	out.Resources = *(*[]platform.MultusSRIOVResource)(unsafe.Pointer(&in.Resources))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_v1_MachineCondition_To_platform_MachineCondition is an autogenerated conversion function.
-func Convert_v1_MachineCondition_To_platform_MachineCondition(in *MachineCondition, out *platform.MachineCondition, s conversion.Scope) error {
-	return autoConvert_v1_MachineCondition_To_platform_MachineCondition(in, out, s)
+// Convert_v1_MultusSRIOV_To_platform_MultusSRIOV is an autogenerated conversion function.
+func Convert_v1_MultusSRIOV_To_platform_MultusSRIOV(in *MultusSRIOV, out *platform.MultusSRIOV, s conversion.Scope) error {
+	return autoConvert_v1_MultusSRIOV_To_platform_MultusSRIOV(in, out, s)
 }
 
-func autoConvert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
-	out.Type = in.Type
-	out.Status = ConditionStatus(in.Status)
-	out.LastProbeTime = in.LastProbeTime
-	out.LastTransitionTime = in.LastTransitionTime
-	out.Reason = in.Reason
-	out.Message = in.Message
+func autoConvert_platform_MultusSRIOV_To_v1_MultusSRIOV(in *platform.MultusSRIOV, out *MultusSRIOV, s conversion.Scope) error {
+	out.ResourcePrefix = in.ResourcePrefix
+	out.Resources = *(*[]MultusSRIOVResource)(unsafe.Pointer(&in.Resources))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
 	return nil
 }
 
-// Convert_platform_MachineCondition_To_v1_MachineCondition is an autogenerated conversion function.
-func Convert_platform_MachineCondition_To_v1_MachineCondition(in *platform.MachineCondition, out *MachineCondition, s conversion.Scope) error {
-	return autoConvert_platform_MachineCondition_To_v1_MachineCondition(in, out, s)
+// Convert_platform_MultusSRIOV_To_v1_MultusSRIOV is an autogenerated conversion function.
+func Convert_platform_MultusSRIOV_To_v1_MultusSRIOV(in *platform.MultusSRIOV, out *MultusSRIOV, s conversion.Scope) error {
+	return autoConvert_platform_MultusSRIOV_To_v1_MultusSRIOV(in, out, s)
 }
 
-func autoConvert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]platform.Machine)(unsafe.Pointer(&in.Items))
+func autoConvert_v1_MultusSRIOVResource_To_platform_MultusSRIOVResource(in *MultusSRIOVResource, out *platform.MultusSRIOVResource, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Vendors = *(*[]string)(unsafe.Pointer(&in.Vendors))
+	out.Devices = *(*[]string)(unsafe.Pointer(&in.Devices))
+	out.Drivers = *(*[]string)(unsafe.Pointer(&in.Drivers))
+	out.PFNames = *(*[]string)(unsafe.Pointer(&in.PFNames))
 	return nil
 }
 
-// Convert_v1_MachineList_To_platform_MachineList is an autogenerated conversion function.
-func Convert_v1_MachineList_To_platform_MachineList(in *MachineList, out *platform.MachineList, s conversion.Scope) error {
-	return autoConvert_v1_MachineList_To_platform_MachineList(in, out, s)
+// Convert_v1_MultusSRIOVResource_To_platform_MultusSRIOVResource is an autogenerated conversion function.
+func Convert_v1_MultusSRIOVResource_To_platform_MultusSRIOVResource(in *MultusSRIOVResource, out *platform.MultusSRIOVResource, s conversion.Scope) error {
+	return autoConvert_v1_MultusSRIOVResource_To_platform_MultusSRIOVResource(in, out, s)
 }
 
-func autoConvert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	out.Items = *(*[]Machine)(unsafe.Pointer(&in.Items))
+func autoConvert_platform_MultusSRIOVResource_To_v1_MultusSRIOVResource(in *platform.MultusSRIOVResource, out *MultusSRIOVResource, s conversion.Scope) error {
+	out.ResourceName = in.ResourceName
+	out.Vendors = *(*[]string)(unsafe.Pointer(&in.Vendors))
+	out.Devices = *(*[]string)(unsafe.Pointer(&in.Devices))
+	out.Drivers = *(*[]string)(unsafe.Pointer(&in.Drivers))
+	out.PFNames = *(*[]string)(unsafe.Pointer(&in.PFNames))
 	return nil
 }
 
-// Convert_platform_MachineList_To_v1_MachineList is an autogenerated conversion function.
-func Convert_platform_MachineList_To_v1_MachineList(in *platform.MachineList, out *MachineList, s conversion.Scope) error {
-	return autoConvert_platform_MachineList_To_v1_MachineList(in, out, s)
+// Convert_platform_MultusSRIOVResource_To_v1_MultusSRIOVResource is an autogenerated conversion function.
+func Convert_platform_MultusSRIOVResource_To_v1_MultusSRIOVResource(in *platform.MultusSRIOVResource, out *MultusSRIOVResource, s conversion.Scope) error {
+	return autoConvert_platform_MultusSRIOVResource_To_v1_MultusSRIOVResource(in, out, s)
 }
 
-func autoConvert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]platform.FinalizerName)(unsafe.Pointer(&in.Finalizers))
+func autoConvert_v1_MultusSpec_To_platform_MultusSpec(in *MultusSpec, out *platform.MultusSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Type = in.Type
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Version = in.Version
+	out.SRIOV = (*platform.MultusSRIOV)(unsafe.Pointer(in.SRIOV))
+	out.NetworkAttachments = *(*[]platform.MultusNetworkAttachment)(unsafe.Pointer(&in.NetworkAttachments))
 	return nil
 }
 
-// Convert_v1_MachineSpec_To_platform_MachineSpec is an autogenerated conversion function.
-func Convert_v1_MachineSpec_To_platform_MachineSpec(in *MachineSpec, out *platform.MachineSpec, s conversion.Scope) error {
-	return autoConvert_v1_MachineSpec_To_platform_MachineSpec(in, out, s)
+// Convert_v1_MultusSpec_To_platform_MultusSpec is an autogenerated conversion function.
+func Convert_v1_MultusSpec_To_platform_MultusSpec(in *MultusSpec, out *platform.MultusSpec, s conversion.Scope) error {
+	return autoConvert_v1_MultusSpec_To_platform_MultusSpec(in, out, s)
 }
 
-func autoConvert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
-	out.Finalizers = *(*[]FinalizerName)(unsafe.Pointer(&in.Finalizers))
+func autoConvert_platform_MultusSpec_To_v1_MultusSpec(in *platform.MultusSpec, out *MultusSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
-	out.Type = in.Type
-	out.IP = in.IP
-	out.Port = in.Port
-	out.Username = in.Username
-	out.Password = *(*[]byte)(unsafe.Pointer(&in.Password))
-	out.PrivateKey = *(*[]byte)(unsafe.Pointer(&in.PrivateKey))
-	out.PassPhrase = *(*[]byte)(unsafe.Pointer(&in.PassPhrase))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
-	out.Taints = *(*[]corev1.Taint)(unsafe.Pointer(&in.Taints))
+	out.Version = in.Version
+	out.SRIOV = (*MultusSRIOV)(unsafe.Pointer(in.SRIOV))
+	out.NetworkAttachments = *(*[]MultusNetworkAttachment)(unsafe.Pointer(&in.NetworkAttachments))
 	return nil
 }
 
-// Convert_platform_MachineSpec_To_v1_MachineSpec is an autogenerated conversion function.
-func Convert_platform_MachineSpec_To_v1_MachineSpec(in *platform.MachineSpec, out *MachineSpec, s conversion.Scope) error {
-	return autoConvert_platform_MachineSpec_To_v1_MachineSpec(in, out, s)
+// Convert_platform_MultusSpec_To_v1_MultusSpec is an autogenerated conversion function.
+func Convert_platform_MultusSpec_To_v1_MultusSpec(in *platform.MultusSpec, out *MultusSpec, s conversion.Scope) error {
+	return autoConvert_platform_MultusSpec_To_v1_MultusSpec(in, out, s)
 }
 
-func autoConvert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Phase = platform.MachinePhase(in.Phase)
-	out.Conditions = *(*[]platform.MachineCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
+func autoConvert_v1_MultusStatus_To_platform_MultusStatus(in *MultusStatus, out *platform.MultusStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
 	out.Reason = in.Reason
-	out.Addresses = *(*[]platform.MachineAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
-		return err
-	}
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Conditions = *(*[]platform.AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_v1_MachineStatus_To_platform_MachineStatus is an autogenerated conversion function.
-func Convert_v1_MachineStatus_To_platform_MachineStatus(in *MachineStatus, out *platform.MachineStatus, s conversion.Scope) error {
-	return autoConvert_v1_MachineStatus_To_platform_MachineStatus(in, out, s)
+// Convert_v1_MultusStatus_To_platform_MultusStatus is an autogenerated conversion function.
+func Convert_v1_MultusStatus_To_platform_MultusStatus(in *MultusStatus, out *platform.MultusStatus, s conversion.Scope) error {
+	return autoConvert_v1_MultusStatus_To_platform_MultusStatus(in, out, s)
 }
 
-func autoConvert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
-	out.Locked = (*bool)(unsafe.Pointer(in.Locked))
-	out.Phase = MachinePhase(in.Phase)
-	out.Conditions = *(*[]MachineCondition)(unsafe.Pointer(&in.Conditions))
-	out.Message = in.Message
+func autoConvert_platform_MultusStatus_To_v1_MultusStatus(in *platform.MultusStatus, out *MultusStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
 	out.Reason = in.Reason
-	out.Addresses = *(*[]MachineAddress)(unsafe.Pointer(&in.Addresses))
-	if err := Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(&in.MachineInfo, &out.MachineInfo, s); err != nil {
-		return err
-	}
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Conditions = *(*[]AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
-// Convert_platform_MachineStatus_To_v1_MachineStatus is an autogenerated conversion function.
-func Convert_platform_MachineStatus_To_v1_MachineStatus(in *platform.MachineStatus, out *MachineStatus, s conversion.Scope) error {
-	return autoConvert_platform_MachineStatus_To_v1_MachineStatus(in, out, s)
+// Convert_platform_MultusStatus_To_v1_MultusStatus is an autogenerated conversion function.
+func Convert_platform_MultusStatus_To_v1_MultusStatus(in *platform.MultusStatus, out *MultusStatus, s conversion.Scope) error {
+	return autoConvert_platform_MultusStatus_To_v1_MultusStatus(in, out, s)
 }
 
-func autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
-	out.MachineID = in.MachineID
-	out.SystemUUID = in.SystemUUID
-	out.BootID = in.BootID
-	out.KernelVersion = in.KernelVersion
-	out.OSImage = in.OSImage
-	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
-	out.KubeletVersion = in.KubeletVersion
-	out.KubeProxyVersion = in.KubeProxyVersion
-	out.OperatingSystem = in.OperatingSystem
-	out.Architecture = in.Architecture
+func autoConvert_v1_NetworkEncryption_To_platform_NetworkEncryption(in *NetworkEncryption, out *platform.NetworkEncryption, s conversion.Scope) error {
+	out.Type = platform.NetworkEncryptionType(in.Type)
+	out.KeyRotationPeriod = in.KeyRotationPeriod
 	return nil
 }
 
-// Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo is an autogenerated conversion function.
-func Convert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in *MachineSystemInfo, out *platform.MachineSystemInfo, s conversion.Scope) error {
-	return autoConvert_v1_MachineSystemInfo_To_platform_MachineSystemInfo(in, out, s)
+// Convert_v1_NetworkEncryption_To_platform_NetworkEncryption is an autogenerated conversion function.
+func Convert_v1_NetworkEncryption_To_platform_NetworkEncryption(in *NetworkEncryption, out *platform.NetworkEncryption, s conversion.Scope) error {
+	return autoConvert_v1_NetworkEncryption_To_platform_NetworkEncryption(in, out, s)
 }
 
-func autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
-	out.MachineID = in.MachineID
-	out.SystemUUID = in.SystemUUID
-	out.BootID = in.BootID
-	out.KernelVersion = in.KernelVersion
-	out.OSImage = in.OSImage
-	out.ContainerRuntimeVersion = in.ContainerRuntimeVersion
-	out.KubeletVersion = in.KubeletVersion
-	out.KubeProxyVersion = in.KubeProxyVersion
-	out.OperatingSystem = in.OperatingSystem
-	out.Architecture = in.Architecture
+func autoConvert_platform_NetworkEncryption_To_v1_NetworkEncryption(in *platform.NetworkEncryption, out *NetworkEncryption, s conversion.Scope) error {
+	out.Type = NetworkEncryptionType(in.Type)
+	out.KeyRotationPeriod = in.KeyRotationPeriod
 	return nil
 }
 
-// Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo is an autogenerated conversion function.
-func Convert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in *platform.MachineSystemInfo, out *MachineSystemInfo, s conversion.Scope) error {
-	return autoConvert_platform_MachineSystemInfo_To_v1_MachineSystemInfo(in, out, s)
+// Convert_platform_NetworkEncryption_To_v1_NetworkEncryption is an autogenerated conversion function.
+func Convert_platform_NetworkEncryption_To_v1_NetworkEncryption(in *platform.NetworkEncryption, out *NetworkEncryption, s conversion.Scope) error {
+	return autoConvert_platform_NetworkEncryption_To_v1_NetworkEncryption(in, out, s)
+}
+
+func autoConvert_v1_NetworkPolicyFeature_To_platform_NetworkPolicyFeature(in *NetworkPolicyFeature, out *platform.NetworkPolicyFeature, s conversion.Scope) error {
+	out.DefaultDenyNamespaces = *(*[]string)(unsafe.Pointer(&in.DefaultDenyNamespaces))
+	out.DenyEgress = in.DenyEgress
+	return nil
+}
+
+// Convert_v1_NetworkPolicyFeature_To_platform_NetworkPolicyFeature is an autogenerated conversion function.
+func Convert_v1_NetworkPolicyFeature_To_platform_NetworkPolicyFeature(in *NetworkPolicyFeature, out *platform.NetworkPolicyFeature, s conversion.Scope) error {
+	return autoConvert_v1_NetworkPolicyFeature_To_platform_NetworkPolicyFeature(in, out, s)
+}
+
+func autoConvert_platform_NetworkPolicyFeature_To_v1_NetworkPolicyFeature(in *platform.NetworkPolicyFeature, out *NetworkPolicyFeature, s conversion.Scope) error {
+	out.DefaultDenyNamespaces = *(*[]string)(unsafe.Pointer(&in.DefaultDenyNamespaces))
+	out.DenyEgress = in.DenyEgress
+	return nil
+}
+
+// Convert_platform_NetworkPolicyFeature_To_v1_NetworkPolicyFeature is an autogenerated conversion function.
+func Convert_platform_NetworkPolicyFeature_To_v1_NetworkPolicyFeature(in *platform.NetworkPolicyFeature, out *NetworkPolicyFeature, s conversion.Scope) error {
+	return autoConvert_platform_NetworkPolicyFeature_To_v1_NetworkPolicyFeature(in, out, s)
+}
+
+func autoConvert_v1_NetworkPolicyStatus_To_platform_NetworkPolicyStatus(in *NetworkPolicyStatus, out *platform.NetworkPolicyStatus, s conversion.Scope) error {
+	out.Enforced = in.Enforced
+	out.Message = in.Message
+	out.LastProbeTime = in.LastProbeTime
+	return nil
+}
+
+// Convert_v1_NetworkPolicyStatus_To_platform_NetworkPolicyStatus is an autogenerated conversion function.
+func Convert_v1_NetworkPolicyStatus_To_platform_NetworkPolicyStatus(in *NetworkPolicyStatus, out *platform.NetworkPolicyStatus, s conversion.Scope) error {
+	return autoConvert_v1_NetworkPolicyStatus_To_platform_NetworkPolicyStatus(in, out, s)
+}
+
+func autoConvert_platform_NetworkPolicyStatus_To_v1_NetworkPolicyStatus(in *platform.NetworkPolicyStatus, out *NetworkPolicyStatus, s conversion.Scope) error {
+	out.Enforced = in.Enforced
+	out.Message = in.Message
+	out.LastProbeTime = in.LastProbeTime
+	return nil
+}
+
+// Convert_platform_NetworkPolicyStatus_To_v1_NetworkPolicyStatus is an autogenerated conversion function.
+func Convert_platform_NetworkPolicyStatus_To_v1_NetworkPolicyStatus(in *platform.NetworkPolicyStatus, out *NetworkPolicyStatus, s conversion.Scope) error {
+	return autoConvert_platform_NetworkPolicyStatus_To_v1_NetworkPolicyStatus(in, out, s)
 }
 
 func autoConvert_v1_PVCRProxyOptions_To_platform_PVCRProxyOptions(in *PVCRProxyOptions, out *platform.PVCRProxyOptions, s conversion.Scope) error {
@@ -3078,6 +6447,8 @@ func Convert_platform_PVCRProxyOptions_To_v1_PVCRProxyOptions(in *platform.PVCRP
 func autoConvert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(in *PersistentBackEnd, out *platform.PersistentBackEnd, s conversion.Scope) error {
 	out.CLS = (*platform.StorageBackEndCLS)(unsafe.Pointer(in.CLS))
 	out.ES = (*platform.StorageBackEndES)(unsafe.Pointer(in.ES))
+	out.Kafka = (*platform.StorageBackEndKafka)(unsafe.Pointer(in.Kafka))
+	out.Loki = (*platform.StorageBackEndLoki)(unsafe.Pointer(in.Loki))
 	return nil
 }
 
@@ -3089,6 +6460,8 @@ func Convert_v1_PersistentBackEnd_To_platform_PersistentBackEnd(in *PersistentBa
 func autoConvert_platform_PersistentBackEnd_To_v1_PersistentBackEnd(in *platform.PersistentBackEnd, out *PersistentBackEnd, s conversion.Scope) error {
 	out.CLS = (*StorageBackEndCLS)(unsafe.Pointer(in.CLS))
 	out.ES = (*StorageBackEndES)(unsafe.Pointer(in.ES))
+	out.Kafka = (*StorageBackEndKafka)(unsafe.Pointer(in.Kafka))
+	out.Loki = (*StorageBackEndLoki)(unsafe.Pointer(in.Loki))
 	return nil
 }
 
@@ -3129,6 +6502,28 @@ func Convert_platform_PersistentEvent_To_v1_PersistentEvent(in *platform.Persist
 	return autoConvert_platform_PersistentEvent_To_v1_PersistentEvent(in, out, s)
 }
 
+func autoConvert_v1_PersistentEventBuffer_To_platform_PersistentEventBuffer(in *PersistentEventBuffer, out *platform.PersistentEventBuffer, s conversion.Scope) error {
+	out.TotalLimitSize = in.TotalLimitSize
+	out.OverflowAction = in.OverflowAction
+	return nil
+}
+
+// Convert_v1_PersistentEventBuffer_To_platform_PersistentEventBuffer is an autogenerated conversion function.
+func Convert_v1_PersistentEventBuffer_To_platform_PersistentEventBuffer(in *PersistentEventBuffer, out *platform.PersistentEventBuffer, s conversion.Scope) error {
+	return autoConvert_v1_PersistentEventBuffer_To_platform_PersistentEventBuffer(in, out, s)
+}
+
+func autoConvert_platform_PersistentEventBuffer_To_v1_PersistentEventBuffer(in *platform.PersistentEventBuffer, out *PersistentEventBuffer, s conversion.Scope) error {
+	out.TotalLimitSize = in.TotalLimitSize
+	out.OverflowAction = in.OverflowAction
+	return nil
+}
+
+// Convert_platform_PersistentEventBuffer_To_v1_PersistentEventBuffer is an autogenerated conversion function.
+func Convert_platform_PersistentEventBuffer_To_v1_PersistentEventBuffer(in *platform.PersistentEventBuffer, out *PersistentEventBuffer, s conversion.Scope) error {
+	return autoConvert_platform_PersistentEventBuffer_To_v1_PersistentEventBuffer(in, out, s)
+}
+
 func autoConvert_v1_PersistentEventList_To_platform_PersistentEventList(in *PersistentEventList, out *platform.PersistentEventList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]platform.PersistentEvent)(unsafe.Pointer(&in.Items))
@@ -3158,6 +6553,7 @@ func autoConvert_v1_PersistentEventSpec_To_platform_PersistentEventSpec(in *Pers
 		return err
 	}
 	out.Version = in.Version
+	out.Buffer = (*platform.PersistentEventBuffer)(unsafe.Pointer(in.Buffer))
 	return nil
 }
 
@@ -3173,6 +6569,7 @@ func autoConvert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(in *plat
 		return err
 	}
 	out.Version = in.Version
+	out.Buffer = (*PersistentEventBuffer)(unsafe.Pointer(in.Buffer))
 	return nil
 }
 
@@ -3181,32 +6578,134 @@ func Convert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(in *platform
 	return autoConvert_platform_PersistentEventSpec_To_v1_PersistentEventSpec(in, out, s)
 }
 
-func autoConvert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in *PersistentEventStatus, out *platform.PersistentEventStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = platform.AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in *PersistentEventStatus, out *platform.PersistentEventStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = platform.AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	return nil
+}
+
+// Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus is an autogenerated conversion function.
+func Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in *PersistentEventStatus, out *platform.PersistentEventStatus, s conversion.Scope) error {
+	return autoConvert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in, out, s)
+}
+
+func autoConvert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in *platform.PersistentEventStatus, out *PersistentEventStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Phase = AddonPhase(in.Phase)
+	out.Reason = in.Reason
+	out.RetryCount = in.RetryCount
+	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	return nil
+}
+
+// Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus is an autogenerated conversion function.
+func Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in *platform.PersistentEventStatus, out *PersistentEventStatus, s conversion.Scope) error {
+	return autoConvert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in, out, s)
+}
+
+func autoConvert_v1_PersistentVolumeClaimUsage_To_platform_PersistentVolumeClaimUsage(in *PersistentVolumeClaimUsage, out *platform.PersistentVolumeClaimUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.UsedBytes = in.UsedBytes
+	out.CapacityBytes = in.CapacityBytes
+	out.Exceeded = in.Exceeded
+	return nil
+}
+
+// Convert_v1_PersistentVolumeClaimUsage_To_platform_PersistentVolumeClaimUsage is an autogenerated conversion function.
+func Convert_v1_PersistentVolumeClaimUsage_To_platform_PersistentVolumeClaimUsage(in *PersistentVolumeClaimUsage, out *platform.PersistentVolumeClaimUsage, s conversion.Scope) error {
+	return autoConvert_v1_PersistentVolumeClaimUsage_To_platform_PersistentVolumeClaimUsage(in, out, s)
+}
+
+func autoConvert_platform_PersistentVolumeClaimUsage_To_v1_PersistentVolumeClaimUsage(in *platform.PersistentVolumeClaimUsage, out *PersistentVolumeClaimUsage, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.UsedBytes = in.UsedBytes
+	out.CapacityBytes = in.CapacityBytes
+	out.Exceeded = in.Exceeded
+	return nil
+}
+
+// Convert_platform_PersistentVolumeClaimUsage_To_v1_PersistentVolumeClaimUsage is an autogenerated conversion function.
+func Convert_platform_PersistentVolumeClaimUsage_To_v1_PersistentVolumeClaimUsage(in *platform.PersistentVolumeClaimUsage, out *PersistentVolumeClaimUsage, s conversion.Scope) error {
+	return autoConvert_platform_PersistentVolumeClaimUsage_To_v1_PersistentVolumeClaimUsage(in, out, s)
+}
+
+func autoConvert_v1_PhaseProgress_To_platform_PhaseProgress(in *PhaseProgress, out *platform.PhaseProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Retries = in.Retries
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_v1_PhaseProgress_To_platform_PhaseProgress is an autogenerated conversion function.
+func Convert_v1_PhaseProgress_To_platform_PhaseProgress(in *PhaseProgress, out *platform.PhaseProgress, s conversion.Scope) error {
+	return autoConvert_v1_PhaseProgress_To_platform_PhaseProgress(in, out, s)
+}
+
+func autoConvert_platform_PhaseProgress_To_v1_PhaseProgress(in *platform.PhaseProgress, out *PhaseProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.StartTime = in.StartTime
+	out.EndTime = in.EndTime
+	out.Retries = in.Retries
+	out.LastError = in.LastError
+	return nil
+}
+
+// Convert_platform_PhaseProgress_To_v1_PhaseProgress is an autogenerated conversion function.
+func Convert_platform_PhaseProgress_To_v1_PhaseProgress(in *platform.PhaseProgress, out *PhaseProgress, s conversion.Scope) error {
+	return autoConvert_platform_PhaseProgress_To_v1_PhaseProgress(in, out, s)
+}
+
+func autoConvert_v1_Progress_To_platform_Progress(in *Progress, out *platform.Progress, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Completed = in.Completed
+	out.Phases = *(*[]platform.PhaseProgress)(unsafe.Pointer(&in.Phases))
+	return nil
+}
+
+// Convert_v1_Progress_To_platform_Progress is an autogenerated conversion function.
+func Convert_v1_Progress_To_platform_Progress(in *Progress, out *platform.Progress, s conversion.Scope) error {
+	return autoConvert_v1_Progress_To_platform_Progress(in, out, s)
+}
+
+func autoConvert_platform_Progress_To_v1_Progress(in *platform.Progress, out *Progress, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Completed = in.Completed
+	out.Phases = *(*[]PhaseProgress)(unsafe.Pointer(&in.Phases))
+	return nil
+}
+
+// Convert_platform_Progress_To_v1_Progress is an autogenerated conversion function.
+func Convert_platform_Progress_To_v1_Progress(in *platform.Progress, out *Progress, s conversion.Scope) error {
+	return autoConvert_platform_Progress_To_v1_Progress(in, out, s)
+}
+
+func autoConvert_v1_ProjectImageAdmission_To_platform_ProjectImageAdmission(in *ProjectImageAdmission, out *platform.ProjectImageAdmission, s conversion.Scope) error {
+	out.ProjectName = in.ProjectName
+	out.AllowedRegistries = *(*[]string)(unsafe.Pointer(&in.AllowedRegistries))
 	return nil
 }
 
-// Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus is an autogenerated conversion function.
-func Convert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in *PersistentEventStatus, out *platform.PersistentEventStatus, s conversion.Scope) error {
-	return autoConvert_v1_PersistentEventStatus_To_platform_PersistentEventStatus(in, out, s)
+// Convert_v1_ProjectImageAdmission_To_platform_ProjectImageAdmission is an autogenerated conversion function.
+func Convert_v1_ProjectImageAdmission_To_platform_ProjectImageAdmission(in *ProjectImageAdmission, out *platform.ProjectImageAdmission, s conversion.Scope) error {
+	return autoConvert_v1_ProjectImageAdmission_To_platform_ProjectImageAdmission(in, out, s)
 }
 
-func autoConvert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in *platform.PersistentEventStatus, out *PersistentEventStatus, s conversion.Scope) error {
-	out.Version = in.Version
-	out.Phase = AddonPhase(in.Phase)
-	out.Reason = in.Reason
-	out.RetryCount = in.RetryCount
-	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+func autoConvert_platform_ProjectImageAdmission_To_v1_ProjectImageAdmission(in *platform.ProjectImageAdmission, out *ProjectImageAdmission, s conversion.Scope) error {
+	out.ProjectName = in.ProjectName
+	out.AllowedRegistries = *(*[]string)(unsafe.Pointer(&in.AllowedRegistries))
 	return nil
 }
 
-// Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus is an autogenerated conversion function.
-func Convert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in *platform.PersistentEventStatus, out *PersistentEventStatus, s conversion.Scope) error {
-	return autoConvert_platform_PersistentEventStatus_To_v1_PersistentEventStatus(in, out, s)
+// Convert_platform_ProjectImageAdmission_To_v1_ProjectImageAdmission is an autogenerated conversion function.
+func Convert_platform_ProjectImageAdmission_To_v1_ProjectImageAdmission(in *platform.ProjectImageAdmission, out *ProjectImageAdmission, s conversion.Scope) error {
+	return autoConvert_platform_ProjectImageAdmission_To_v1_ProjectImageAdmission(in, out, s)
 }
 
 func autoConvert_v1_Prometheus_To_platform_Prometheus(in *Prometheus, out *platform.Prometheus, s conversion.Scope) error {
@@ -3241,6 +6740,28 @@ func Convert_platform_Prometheus_To_v1_Prometheus(in *platform.Prometheus, out *
 	return autoConvert_platform_Prometheus_To_v1_Prometheus(in, out, s)
 }
 
+func autoConvert_v1_PrometheusBasicAuth_To_platform_PrometheusBasicAuth(in *PrometheusBasicAuth, out *platform.PrometheusBasicAuth, s conversion.Scope) error {
+	out.User = in.User
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_PrometheusBasicAuth_To_platform_PrometheusBasicAuth is an autogenerated conversion function.
+func Convert_v1_PrometheusBasicAuth_To_platform_PrometheusBasicAuth(in *PrometheusBasicAuth, out *platform.PrometheusBasicAuth, s conversion.Scope) error {
+	return autoConvert_v1_PrometheusBasicAuth_To_platform_PrometheusBasicAuth(in, out, s)
+}
+
+func autoConvert_platform_PrometheusBasicAuth_To_v1_PrometheusBasicAuth(in *platform.PrometheusBasicAuth, out *PrometheusBasicAuth, s conversion.Scope) error {
+	out.User = in.User
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_platform_PrometheusBasicAuth_To_v1_PrometheusBasicAuth is an autogenerated conversion function.
+func Convert_platform_PrometheusBasicAuth_To_v1_PrometheusBasicAuth(in *platform.PrometheusBasicAuth, out *PrometheusBasicAuth, s conversion.Scope) error {
+	return autoConvert_platform_PrometheusBasicAuth_To_v1_PrometheusBasicAuth(in, out, s)
+}
+
 func autoConvert_v1_PrometheusList_To_platform_PrometheusList(in *PrometheusList, out *platform.PrometheusList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]platform.Prometheus)(unsafe.Pointer(&in.Items))
@@ -3285,6 +6806,36 @@ func Convert_platform_PrometheusRemoteAddr_To_v1_PrometheusRemoteAddr(in *platfo
 	return autoConvert_platform_PrometheusRemoteAddr_To_v1_PrometheusRemoteAddr(in, out, s)
 }
 
+func autoConvert_v1_PrometheusRemoteWrite_To_platform_PrometheusRemoteWrite(in *PrometheusRemoteWrite, out *platform.PrometheusRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.BasicAuth = (*platform.PrometheusBasicAuth)(unsafe.Pointer(in.BasicAuth))
+	out.BearerToken = in.BearerToken
+	out.TLS = (*platform.PrometheusTLSConfig)(unsafe.Pointer(in.TLS))
+	out.MetricsRegex = in.MetricsRegex
+	return nil
+}
+
+// Convert_v1_PrometheusRemoteWrite_To_platform_PrometheusRemoteWrite is an autogenerated conversion function.
+func Convert_v1_PrometheusRemoteWrite_To_platform_PrometheusRemoteWrite(in *PrometheusRemoteWrite, out *platform.PrometheusRemoteWrite, s conversion.Scope) error {
+	return autoConvert_v1_PrometheusRemoteWrite_To_platform_PrometheusRemoteWrite(in, out, s)
+}
+
+func autoConvert_platform_PrometheusRemoteWrite_To_v1_PrometheusRemoteWrite(in *platform.PrometheusRemoteWrite, out *PrometheusRemoteWrite, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.BasicAuth = (*PrometheusBasicAuth)(unsafe.Pointer(in.BasicAuth))
+	out.BearerToken = in.BearerToken
+	out.TLS = (*PrometheusTLSConfig)(unsafe.Pointer(in.TLS))
+	out.MetricsRegex = in.MetricsRegex
+	return nil
+}
+
+// Convert_platform_PrometheusRemoteWrite_To_v1_PrometheusRemoteWrite is an autogenerated conversion function.
+func Convert_platform_PrometheusRemoteWrite_To_v1_PrometheusRemoteWrite(in *platform.PrometheusRemoteWrite, out *PrometheusRemoteWrite, s conversion.Scope) error {
+	return autoConvert_platform_PrometheusRemoteWrite_To_v1_PrometheusRemoteWrite(in, out, s)
+}
+
 func autoConvert_v1_PrometheusSpec_To_platform_PrometheusSpec(in *PrometheusSpec, out *platform.PrometheusSpec, s conversion.Scope) error {
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
@@ -3300,6 +6851,11 @@ func autoConvert_v1_PrometheusSpec_To_platform_PrometheusSpec(in *PrometheusSpec
 	out.RunOnMaster = in.RunOnMaster
 	out.AlertRepeatInterval = in.AlertRepeatInterval
 	out.WithNPD = in.WithNPD
+	out.RemoteWrites = *(*[]platform.PrometheusRemoteWrite)(unsafe.Pointer(&in.RemoteWrites))
+	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	out.Retention = in.Retention
+	out.RetentionSize = in.RetentionSize
+	out.SizingTier = platform.PrometheusSizingTier(in.SizingTier)
 	return nil
 }
 
@@ -3323,6 +6879,11 @@ func autoConvert_platform_PrometheusSpec_To_v1_PrometheusSpec(in *platform.Prome
 	out.RunOnMaster = in.RunOnMaster
 	out.AlertRepeatInterval = in.AlertRepeatInterval
 	out.WithNPD = in.WithNPD
+	out.RemoteWrites = *(*[]PrometheusRemoteWrite)(unsafe.Pointer(&in.RemoteWrites))
+	out.ExternalLabels = *(*map[string]string)(unsafe.Pointer(&in.ExternalLabels))
+	out.Retention = in.Retention
+	out.RetentionSize = in.RetentionSize
+	out.SizingTier = PrometheusSizingTier(in.SizingTier)
 	return nil
 }
 
@@ -3361,6 +6922,32 @@ func Convert_platform_PrometheusStatus_To_v1_PrometheusStatus(in *platform.Prome
 	return autoConvert_platform_PrometheusStatus_To_v1_PrometheusStatus(in, out, s)
 }
 
+func autoConvert_v1_PrometheusTLSConfig_To_platform_PrometheusTLSConfig(in *PrometheusTLSConfig, out *platform.PrometheusTLSConfig, s conversion.Scope) error {
+	out.CACert = in.CACert
+	out.ClientCert = in.ClientCert
+	out.ClientKey = in.ClientKey
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1_PrometheusTLSConfig_To_platform_PrometheusTLSConfig is an autogenerated conversion function.
+func Convert_v1_PrometheusTLSConfig_To_platform_PrometheusTLSConfig(in *PrometheusTLSConfig, out *platform.PrometheusTLSConfig, s conversion.Scope) error {
+	return autoConvert_v1_PrometheusTLSConfig_To_platform_PrometheusTLSConfig(in, out, s)
+}
+
+func autoConvert_platform_PrometheusTLSConfig_To_v1_PrometheusTLSConfig(in *platform.PrometheusTLSConfig, out *PrometheusTLSConfig, s conversion.Scope) error {
+	out.CACert = in.CACert
+	out.ClientCert = in.ClientCert
+	out.ClientKey = in.ClientKey
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_platform_PrometheusTLSConfig_To_v1_PrometheusTLSConfig is an autogenerated conversion function.
+func Convert_platform_PrometheusTLSConfig_To_v1_PrometheusTLSConfig(in *platform.PrometheusTLSConfig, out *PrometheusTLSConfig, s conversion.Scope) error {
+	return autoConvert_platform_PrometheusTLSConfig_To_v1_PrometheusTLSConfig(in, out, s)
+}
+
 func autoConvert_v1_Registry_To_platform_Registry(in *Registry, out *platform.Registry, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1_RegistrySpec_To_platform_RegistrySpec(&in.Spec, &out.Spec, s); err != nil {
@@ -3387,6 +6974,28 @@ func Convert_platform_Registry_To_v1_Registry(in *platform.Registry, out *Regist
 	return autoConvert_platform_Registry_To_v1_Registry(in, out, s)
 }
 
+func autoConvert_v1_RegistryAuth_To_platform_RegistryAuth(in *RegistryAuth, out *platform.RegistryAuth, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.Username = in.Username
+	return nil
+}
+
+// Convert_v1_RegistryAuth_To_platform_RegistryAuth is an autogenerated conversion function.
+func Convert_v1_RegistryAuth_To_platform_RegistryAuth(in *RegistryAuth, out *platform.RegistryAuth, s conversion.Scope) error {
+	return autoConvert_v1_RegistryAuth_To_platform_RegistryAuth(in, out, s)
+}
+
+func autoConvert_platform_RegistryAuth_To_v1_RegistryAuth(in *platform.RegistryAuth, out *RegistryAuth, s conversion.Scope) error {
+	out.Registry = in.Registry
+	out.Username = in.Username
+	return nil
+}
+
+// Convert_platform_RegistryAuth_To_v1_RegistryAuth is an autogenerated conversion function.
+func Convert_platform_RegistryAuth_To_v1_RegistryAuth(in *platform.RegistryAuth, out *RegistryAuth, s conversion.Scope) error {
+	return autoConvert_platform_RegistryAuth_To_v1_RegistryAuth(in, out, s)
+}
+
 func autoConvert_v1_RegistryList_To_platform_RegistryList(in *RegistryList, out *platform.RegistryList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]platform.Registry)(unsafe.Pointer(&in.Items))
@@ -3461,6 +7070,150 @@ func Convert_platform_ResourceRequirements_To_v1_ResourceRequirements(in *platfo
 	return autoConvert_platform_ResourceRequirements_To_v1_ResourceRequirements(in, out, s)
 }
 
+func autoConvert_v1_S3Storage_To_platform_S3Storage(in *S3Storage, out *platform.S3Storage, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	return nil
+}
+
+// Convert_v1_S3Storage_To_platform_S3Storage is an autogenerated conversion function.
+func Convert_v1_S3Storage_To_platform_S3Storage(in *S3Storage, out *platform.S3Storage, s conversion.Scope) error {
+	return autoConvert_v1_S3Storage_To_platform_S3Storage(in, out, s)
+}
+
+func autoConvert_platform_S3Storage_To_v1_S3Storage(in *platform.S3Storage, out *S3Storage, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	return nil
+}
+
+// Convert_platform_S3Storage_To_v1_S3Storage is an autogenerated conversion function.
+func Convert_platform_S3Storage_To_v1_S3Storage(in *platform.S3Storage, out *S3Storage, s conversion.Scope) error {
+	return autoConvert_platform_S3Storage_To_v1_S3Storage(in, out, s)
+}
+
+func autoConvert_v1_SandboxRuntime_To_platform_SandboxRuntime(in *SandboxRuntime, out *platform.SandboxRuntime, s conversion.Scope) error {
+	out.Type = platform.SandboxRuntimeType(in.Type)
+	out.UntrustedNamespaces = *(*[]string)(unsafe.Pointer(&in.UntrustedNamespaces))
+	return nil
+}
+
+// Convert_v1_SandboxRuntime_To_platform_SandboxRuntime is an autogenerated conversion function.
+func Convert_v1_SandboxRuntime_To_platform_SandboxRuntime(in *SandboxRuntime, out *platform.SandboxRuntime, s conversion.Scope) error {
+	return autoConvert_v1_SandboxRuntime_To_platform_SandboxRuntime(in, out, s)
+}
+
+func autoConvert_platform_SandboxRuntime_To_v1_SandboxRuntime(in *platform.SandboxRuntime, out *SandboxRuntime, s conversion.Scope) error {
+	out.Type = SandboxRuntimeType(in.Type)
+	out.UntrustedNamespaces = *(*[]string)(unsafe.Pointer(&in.UntrustedNamespaces))
+	return nil
+}
+
+// Convert_platform_SandboxRuntime_To_v1_SandboxRuntime is an autogenerated conversion function.
+func Convert_platform_SandboxRuntime_To_v1_SandboxRuntime(in *platform.SandboxRuntime, out *SandboxRuntime, s conversion.Scope) error {
+	return autoConvert_platform_SandboxRuntime_To_v1_SandboxRuntime(in, out, s)
+}
+
+func autoConvert_v1_SecretsEncryption_To_platform_SecretsEncryption(in *SecretsEncryption, out *platform.SecretsEncryption, s conversion.Scope) error {
+	out.Provider = platform.SecretsEncryptionProvider(in.Provider)
+	out.KMS = (*platform.KMSPlugin)(unsafe.Pointer(in.KMS))
+	out.KeyRotationPeriod = in.KeyRotationPeriod
+	return nil
+}
+
+// Convert_v1_SecretsEncryption_To_platform_SecretsEncryption is an autogenerated conversion function.
+func Convert_v1_SecretsEncryption_To_platform_SecretsEncryption(in *SecretsEncryption, out *platform.SecretsEncryption, s conversion.Scope) error {
+	return autoConvert_v1_SecretsEncryption_To_platform_SecretsEncryption(in, out, s)
+}
+
+func autoConvert_platform_SecretsEncryption_To_v1_SecretsEncryption(in *platform.SecretsEncryption, out *SecretsEncryption, s conversion.Scope) error {
+	out.Provider = SecretsEncryptionProvider(in.Provider)
+	out.KMS = (*KMSPlugin)(unsafe.Pointer(in.KMS))
+	out.KeyRotationPeriod = in.KeyRotationPeriod
+	return nil
+}
+
+// Convert_platform_SecretsEncryption_To_v1_SecretsEncryption is an autogenerated conversion function.
+func Convert_platform_SecretsEncryption_To_v1_SecretsEncryption(in *platform.SecretsEncryption, out *SecretsEncryption, s conversion.Scope) error {
+	return autoConvert_platform_SecretsEncryption_To_v1_SecretsEncryption(in, out, s)
+}
+
+func autoConvert_v1_ServiceOverride_To_platform_ServiceOverride(in *ServiceOverride, out *platform.ServiceOverride, s conversion.Scope) error {
+	out.Env = *(*map[string]string)(unsafe.Pointer(&in.Env))
+	out.DropIn = in.DropIn
+	return nil
+}
+
+// Convert_v1_ServiceOverride_To_platform_ServiceOverride is an autogenerated conversion function.
+func Convert_v1_ServiceOverride_To_platform_ServiceOverride(in *ServiceOverride, out *platform.ServiceOverride, s conversion.Scope) error {
+	return autoConvert_v1_ServiceOverride_To_platform_ServiceOverride(in, out, s)
+}
+
+func autoConvert_platform_ServiceOverride_To_v1_ServiceOverride(in *platform.ServiceOverride, out *ServiceOverride, s conversion.Scope) error {
+	out.Env = *(*map[string]string)(unsafe.Pointer(&in.Env))
+	out.DropIn = in.DropIn
+	return nil
+}
+
+// Convert_platform_ServiceOverride_To_v1_ServiceOverride is an autogenerated conversion function.
+func Convert_platform_ServiceOverride_To_v1_ServiceOverride(in *platform.ServiceOverride, out *ServiceOverride, s conversion.Scope) error {
+	return autoConvert_platform_ServiceOverride_To_v1_ServiceOverride(in, out, s)
+}
+
+func autoConvert_v1_ServiceOverrides_To_platform_ServiceOverrides(in *ServiceOverrides, out *platform.ServiceOverrides, s conversion.Scope) error {
+	out.Kubelet = (*platform.ServiceOverride)(unsafe.Pointer(in.Kubelet))
+	out.ContainerRuntime = (*platform.ServiceOverride)(unsafe.Pointer(in.ContainerRuntime))
+	return nil
+}
+
+// Convert_v1_ServiceOverrides_To_platform_ServiceOverrides is an autogenerated conversion function.
+func Convert_v1_ServiceOverrides_To_platform_ServiceOverrides(in *ServiceOverrides, out *platform.ServiceOverrides, s conversion.Scope) error {
+	return autoConvert_v1_ServiceOverrides_To_platform_ServiceOverrides(in, out, s)
+}
+
+func autoConvert_platform_ServiceOverrides_To_v1_ServiceOverrides(in *platform.ServiceOverrides, out *ServiceOverrides, s conversion.Scope) error {
+	out.Kubelet = (*ServiceOverride)(unsafe.Pointer(in.Kubelet))
+	out.ContainerRuntime = (*ServiceOverride)(unsafe.Pointer(in.ContainerRuntime))
+	return nil
+}
+
+// Convert_platform_ServiceOverrides_To_v1_ServiceOverrides is an autogenerated conversion function.
+func Convert_platform_ServiceOverrides_To_v1_ServiceOverrides(in *platform.ServiceOverrides, out *ServiceOverrides, s conversion.Scope) error {
+	return autoConvert_platform_ServiceOverrides_To_v1_ServiceOverrides(in, out, s)
+}
+
+func autoConvert_v1_StaticPodOverride_To_platform_StaticPodOverride(in *StaticPodOverride, out *platform.StaticPodOverride, s conversion.Scope) error {
+	out.ExtraVolumes = *(*[]corev1.Volume)(unsafe.Pointer(&in.ExtraVolumes))
+	out.ExtraVolumeMounts = *(*[]corev1.VolumeMount)(unsafe.Pointer(&in.ExtraVolumeMounts))
+	out.ExtraEnv = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.ExtraEnv))
+	out.Sidecars = *(*[]corev1.Container)(unsafe.Pointer(&in.Sidecars))
+	return nil
+}
+
+// Convert_v1_StaticPodOverride_To_platform_StaticPodOverride is an autogenerated conversion function.
+func Convert_v1_StaticPodOverride_To_platform_StaticPodOverride(in *StaticPodOverride, out *platform.StaticPodOverride, s conversion.Scope) error {
+	return autoConvert_v1_StaticPodOverride_To_platform_StaticPodOverride(in, out, s)
+}
+
+func autoConvert_platform_StaticPodOverride_To_v1_StaticPodOverride(in *platform.StaticPodOverride, out *StaticPodOverride, s conversion.Scope) error {
+	out.ExtraVolumes = *(*[]corev1.Volume)(unsafe.Pointer(&in.ExtraVolumes))
+	out.ExtraVolumeMounts = *(*[]corev1.VolumeMount)(unsafe.Pointer(&in.ExtraVolumeMounts))
+	out.ExtraEnv = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.ExtraEnv))
+	out.Sidecars = *(*[]corev1.Container)(unsafe.Pointer(&in.Sidecars))
+	return nil
+}
+
+// Convert_platform_StaticPodOverride_To_v1_StaticPodOverride is an autogenerated conversion function.
+func Convert_platform_StaticPodOverride_To_v1_StaticPodOverride(in *platform.StaticPodOverride, out *StaticPodOverride, s conversion.Scope) error {
+	return autoConvert_platform_StaticPodOverride_To_v1_StaticPodOverride(in, out, s)
+}
+
 func autoConvert_v1_StorageBackEndCLS_To_platform_StorageBackEndCLS(in *StorageBackEndCLS, out *platform.StorageBackEndCLS, s conversion.Scope) error {
 	out.LogSetID = in.LogSetID
 	out.TopicID = in.TopicID
@@ -3515,6 +7268,138 @@ func Convert_platform_StorageBackEndES_To_v1_StorageBackEndES(in *platform.Stora
 	return autoConvert_platform_StorageBackEndES_To_v1_StorageBackEndES(in, out, s)
 }
 
+func autoConvert_v1_StorageBackEndKafka_To_platform_StorageBackEndKafka(in *StorageBackEndKafka, out *platform.StorageBackEndKafka, s conversion.Scope) error {
+	out.Brokers = *(*[]string)(unsafe.Pointer(&in.Brokers))
+	out.Topic = in.Topic
+	out.SASL = (*platform.StorageBackEndSASL)(unsafe.Pointer(in.SASL))
+	out.TLS = (*platform.StorageBackEndTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_v1_StorageBackEndKafka_To_platform_StorageBackEndKafka is an autogenerated conversion function.
+func Convert_v1_StorageBackEndKafka_To_platform_StorageBackEndKafka(in *StorageBackEndKafka, out *platform.StorageBackEndKafka, s conversion.Scope) error {
+	return autoConvert_v1_StorageBackEndKafka_To_platform_StorageBackEndKafka(in, out, s)
+}
+
+func autoConvert_platform_StorageBackEndKafka_To_v1_StorageBackEndKafka(in *platform.StorageBackEndKafka, out *StorageBackEndKafka, s conversion.Scope) error {
+	out.Brokers = *(*[]string)(unsafe.Pointer(&in.Brokers))
+	out.Topic = in.Topic
+	out.SASL = (*StorageBackEndSASL)(unsafe.Pointer(in.SASL))
+	out.TLS = (*StorageBackEndTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_platform_StorageBackEndKafka_To_v1_StorageBackEndKafka is an autogenerated conversion function.
+func Convert_platform_StorageBackEndKafka_To_v1_StorageBackEndKafka(in *platform.StorageBackEndKafka, out *StorageBackEndKafka, s conversion.Scope) error {
+	return autoConvert_platform_StorageBackEndKafka_To_v1_StorageBackEndKafka(in, out, s)
+}
+
+func autoConvert_v1_StorageBackEndLoki_To_platform_StorageBackEndLoki(in *StorageBackEndLoki, out *platform.StorageBackEndLoki, s conversion.Scope) error {
+	out.URL = in.URL
+	out.TenantID = in.TenantID
+	out.User = in.User
+	out.Password = in.Password
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.TLS = (*platform.StorageBackEndTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_v1_StorageBackEndLoki_To_platform_StorageBackEndLoki is an autogenerated conversion function.
+func Convert_v1_StorageBackEndLoki_To_platform_StorageBackEndLoki(in *StorageBackEndLoki, out *platform.StorageBackEndLoki, s conversion.Scope) error {
+	return autoConvert_v1_StorageBackEndLoki_To_platform_StorageBackEndLoki(in, out, s)
+}
+
+func autoConvert_platform_StorageBackEndLoki_To_v1_StorageBackEndLoki(in *platform.StorageBackEndLoki, out *StorageBackEndLoki, s conversion.Scope) error {
+	out.URL = in.URL
+	out.TenantID = in.TenantID
+	out.User = in.User
+	out.Password = in.Password
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.TLS = (*StorageBackEndTLS)(unsafe.Pointer(in.TLS))
+	return nil
+}
+
+// Convert_platform_StorageBackEndLoki_To_v1_StorageBackEndLoki is an autogenerated conversion function.
+func Convert_platform_StorageBackEndLoki_To_v1_StorageBackEndLoki(in *platform.StorageBackEndLoki, out *StorageBackEndLoki, s conversion.Scope) error {
+	return autoConvert_platform_StorageBackEndLoki_To_v1_StorageBackEndLoki(in, out, s)
+}
+
+func autoConvert_v1_StorageBackEndSASL_To_platform_StorageBackEndSASL(in *StorageBackEndSASL, out *platform.StorageBackEndSASL, s conversion.Scope) error {
+	out.Mechanism = in.Mechanism
+	out.User = in.User
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_StorageBackEndSASL_To_platform_StorageBackEndSASL is an autogenerated conversion function.
+func Convert_v1_StorageBackEndSASL_To_platform_StorageBackEndSASL(in *StorageBackEndSASL, out *platform.StorageBackEndSASL, s conversion.Scope) error {
+	return autoConvert_v1_StorageBackEndSASL_To_platform_StorageBackEndSASL(in, out, s)
+}
+
+func autoConvert_platform_StorageBackEndSASL_To_v1_StorageBackEndSASL(in *platform.StorageBackEndSASL, out *StorageBackEndSASL, s conversion.Scope) error {
+	out.Mechanism = in.Mechanism
+	out.User = in.User
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_platform_StorageBackEndSASL_To_v1_StorageBackEndSASL is an autogenerated conversion function.
+func Convert_platform_StorageBackEndSASL_To_v1_StorageBackEndSASL(in *platform.StorageBackEndSASL, out *StorageBackEndSASL, s conversion.Scope) error {
+	return autoConvert_platform_StorageBackEndSASL_To_v1_StorageBackEndSASL(in, out, s)
+}
+
+func autoConvert_v1_StorageBackEndTLS_To_platform_StorageBackEndTLS(in *StorageBackEndTLS, out *platform.StorageBackEndTLS, s conversion.Scope) error {
+	out.CACert = in.CACert
+	out.ClientCert = in.ClientCert
+	out.ClientKey = in.ClientKey
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1_StorageBackEndTLS_To_platform_StorageBackEndTLS is an autogenerated conversion function.
+func Convert_v1_StorageBackEndTLS_To_platform_StorageBackEndTLS(in *StorageBackEndTLS, out *platform.StorageBackEndTLS, s conversion.Scope) error {
+	return autoConvert_v1_StorageBackEndTLS_To_platform_StorageBackEndTLS(in, out, s)
+}
+
+func autoConvert_platform_StorageBackEndTLS_To_v1_StorageBackEndTLS(in *platform.StorageBackEndTLS, out *StorageBackEndTLS, s conversion.Scope) error {
+	out.CACert = in.CACert
+	out.ClientCert = in.ClientCert
+	out.ClientKey = in.ClientKey
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_platform_StorageBackEndTLS_To_v1_StorageBackEndTLS is an autogenerated conversion function.
+func Convert_platform_StorageBackEndTLS_To_v1_StorageBackEndTLS(in *platform.StorageBackEndTLS, out *StorageBackEndTLS, s conversion.Scope) error {
+	return autoConvert_platform_StorageBackEndTLS_To_v1_StorageBackEndTLS(in, out, s)
+}
+
+func autoConvert_v1_SystemTuning_To_platform_SystemTuning(in *SystemTuning, out *platform.SystemTuning, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
+	out.Ulimits = *(*[]platform.Ulimit)(unsafe.Pointer(&in.Ulimits))
+	out.TransparentHugepage = in.TransparentHugepage
+	return nil
+}
+
+// Convert_v1_SystemTuning_To_platform_SystemTuning is an autogenerated conversion function.
+func Convert_v1_SystemTuning_To_platform_SystemTuning(in *SystemTuning, out *platform.SystemTuning, s conversion.Scope) error {
+	return autoConvert_v1_SystemTuning_To_platform_SystemTuning(in, out, s)
+}
+
+func autoConvert_platform_SystemTuning_To_v1_SystemTuning(in *platform.SystemTuning, out *SystemTuning, s conversion.Scope) error {
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
+	out.Ulimits = *(*[]Ulimit)(unsafe.Pointer(&in.Ulimits))
+	out.TransparentHugepage = in.TransparentHugepage
+	return nil
+}
+
+// Convert_platform_SystemTuning_To_v1_SystemTuning is an autogenerated conversion function.
+func Convert_platform_SystemTuning_To_v1_SystemTuning(in *platform.SystemTuning, out *SystemTuning, s conversion.Scope) error {
+	return autoConvert_platform_SystemTuning_To_v1_SystemTuning(in, out, s)
+}
+
 func autoConvert_v1_TKEHA_To_platform_TKEHA(in *TKEHA, out *platform.TKEHA, s conversion.Scope) error {
 	out.VIP = in.VIP
 	out.VRID = (*int32)(unsafe.Pointer(in.VRID))
@@ -3569,6 +7454,30 @@ func Convert_platform_TappController_To_v1_TappController(in *platform.TappContr
 	return autoConvert_platform_TappController_To_v1_TappController(in, out, s)
 }
 
+func autoConvert_v1_TappControllerConfig_To_platform_TappControllerConfig(in *TappControllerConfig, out *platform.TappControllerConfig, s conversion.Scope) error {
+	out.Workers = in.Workers
+	out.ForceDeletePod = in.ForceDeletePod
+	out.MaxUnavailable = in.MaxUnavailable
+	return nil
+}
+
+// Convert_v1_TappControllerConfig_To_platform_TappControllerConfig is an autogenerated conversion function.
+func Convert_v1_TappControllerConfig_To_platform_TappControllerConfig(in *TappControllerConfig, out *platform.TappControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1_TappControllerConfig_To_platform_TappControllerConfig(in, out, s)
+}
+
+func autoConvert_platform_TappControllerConfig_To_v1_TappControllerConfig(in *platform.TappControllerConfig, out *TappControllerConfig, s conversion.Scope) error {
+	out.Workers = in.Workers
+	out.ForceDeletePod = in.ForceDeletePod
+	out.MaxUnavailable = in.MaxUnavailable
+	return nil
+}
+
+// Convert_platform_TappControllerConfig_To_v1_TappControllerConfig is an autogenerated conversion function.
+func Convert_platform_TappControllerConfig_To_v1_TappControllerConfig(in *platform.TappControllerConfig, out *TappControllerConfig, s conversion.Scope) error {
+	return autoConvert_platform_TappControllerConfig_To_v1_TappControllerConfig(in, out, s)
+}
+
 func autoConvert_v1_TappControllerList_To_platform_TappControllerList(in *TappControllerList, out *platform.TappControllerList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]platform.TappController)(unsafe.Pointer(&in.Items))
@@ -3651,6 +7560,9 @@ func autoConvert_v1_TappControllerSpec_To_platform_TappControllerSpec(in *TappCo
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.CRDConflictPolicy = platform.CRDConflictPolicy(in.CRDConflictPolicy)
+	out.DriftPolicy = platform.AddonDriftPolicy(in.DriftPolicy)
+	out.Config = (*platform.TappControllerConfig)(unsafe.Pointer(in.Config))
 	return nil
 }
 
@@ -3663,6 +7575,9 @@ func autoConvert_platform_TappControllerSpec_To_v1_TappControllerSpec(in *platfo
 	out.TenantID = in.TenantID
 	out.ClusterName = in.ClusterName
 	out.Version = in.Version
+	out.CRDConflictPolicy = CRDConflictPolicy(in.CRDConflictPolicy)
+	out.DriftPolicy = AddonDriftPolicy(in.DriftPolicy)
+	out.Config = (*TappControllerConfig)(unsafe.Pointer(in.Config))
 	return nil
 }
 
@@ -3677,6 +7592,8 @@ func autoConvert_v1_TappControllerStatus_To_platform_TappControllerStatus(in *Ta
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]platform.CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	out.Conditions = *(*[]platform.AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -3691,6 +7608,8 @@ func autoConvert_platform_TappControllerStatus_To_v1_TappControllerStatus(in *pl
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.CRDConflicts = *(*[]CRDConflict)(unsafe.Pointer(&in.CRDConflicts))
+	out.Conditions = *(*[]AddonCondition)(unsafe.Pointer(&in.Conditions))
 	return nil
 }
 
@@ -3721,11 +7640,59 @@ func Convert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(in *platform.ThirdPartyHA,
 	return autoConvert_platform_ThirdPartyHA_To_v1_ThirdPartyHA(in, out, s)
 }
 
+func autoConvert_v1_TimeSync_To_platform_TimeSync(in *TimeSync, out *platform.TimeSync, s conversion.Scope) error {
+	out.Servers = *(*[]string)(unsafe.Pointer(&in.Servers))
+	out.MaxSkewSeconds = in.MaxSkewSeconds
+	return nil
+}
+
+// Convert_v1_TimeSync_To_platform_TimeSync is an autogenerated conversion function.
+func Convert_v1_TimeSync_To_platform_TimeSync(in *TimeSync, out *platform.TimeSync, s conversion.Scope) error {
+	return autoConvert_v1_TimeSync_To_platform_TimeSync(in, out, s)
+}
+
+func autoConvert_platform_TimeSync_To_v1_TimeSync(in *platform.TimeSync, out *TimeSync, s conversion.Scope) error {
+	out.Servers = *(*[]string)(unsafe.Pointer(&in.Servers))
+	out.MaxSkewSeconds = in.MaxSkewSeconds
+	return nil
+}
+
+// Convert_platform_TimeSync_To_v1_TimeSync is an autogenerated conversion function.
+func Convert_platform_TimeSync_To_v1_TimeSync(in *platform.TimeSync, out *TimeSync, s conversion.Scope) error {
+	return autoConvert_platform_TimeSync_To_v1_TimeSync(in, out, s)
+}
+
+func autoConvert_v1_Ulimit_To_platform_Ulimit(in *Ulimit, out *platform.Ulimit, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Soft = in.Soft
+	out.Hard = in.Hard
+	return nil
+}
+
+// Convert_v1_Ulimit_To_platform_Ulimit is an autogenerated conversion function.
+func Convert_v1_Ulimit_To_platform_Ulimit(in *Ulimit, out *platform.Ulimit, s conversion.Scope) error {
+	return autoConvert_v1_Ulimit_To_platform_Ulimit(in, out, s)
+}
+
+func autoConvert_platform_Ulimit_To_v1_Ulimit(in *platform.Ulimit, out *Ulimit, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Soft = in.Soft
+	out.Hard = in.Hard
+	return nil
+}
+
+// Convert_platform_Ulimit_To_v1_Ulimit is an autogenerated conversion function.
+func Convert_platform_Ulimit_To_v1_Ulimit(in *platform.Ulimit, out *Ulimit, s conversion.Scope) error {
+	return autoConvert_platform_Ulimit_To_v1_Ulimit(in, out, s)
+}
+
 func autoConvert_v1_Upgrade_To_platform_Upgrade(in *Upgrade, out *platform.Upgrade, s conversion.Scope) error {
 	out.Mode = platform.UpgradeMode(in.Mode)
 	if err := Convert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(&in.Strategy, &out.Strategy, s); err != nil {
 		return err
 	}
+	out.Paused = in.Paused
+	out.Canary = (*platform.UpgradeCanary)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -3739,6 +7706,8 @@ func autoConvert_platform_Upgrade_To_v1_Upgrade(in *platform.Upgrade, out *Upgra
 	if err := Convert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(&in.Strategy, &out.Strategy, s); err != nil {
 		return err
 	}
+	out.Paused = in.Paused
+	out.Canary = (*UpgradeCanary)(unsafe.Pointer(in.Canary))
 	return nil
 }
 
@@ -3747,6 +7716,52 @@ func Convert_platform_Upgrade_To_v1_Upgrade(in *platform.Upgrade, out *Upgrade,
 	return autoConvert_platform_Upgrade_To_v1_Upgrade(in, out, s)
 }
 
+func autoConvert_v1_UpgradeCanary_To_platform_UpgradeCanary(in *UpgradeCanary, out *platform.UpgradeCanary, s conversion.Scope) error {
+	out.ApprovedVersion = in.ApprovedVersion
+	return nil
+}
+
+// Convert_v1_UpgradeCanary_To_platform_UpgradeCanary is an autogenerated conversion function.
+func Convert_v1_UpgradeCanary_To_platform_UpgradeCanary(in *UpgradeCanary, out *platform.UpgradeCanary, s conversion.Scope) error {
+	return autoConvert_v1_UpgradeCanary_To_platform_UpgradeCanary(in, out, s)
+}
+
+func autoConvert_platform_UpgradeCanary_To_v1_UpgradeCanary(in *platform.UpgradeCanary, out *UpgradeCanary, s conversion.Scope) error {
+	out.ApprovedVersion = in.ApprovedVersion
+	return nil
+}
+
+// Convert_platform_UpgradeCanary_To_v1_UpgradeCanary is an autogenerated conversion function.
+func Convert_platform_UpgradeCanary_To_v1_UpgradeCanary(in *platform.UpgradeCanary, out *UpgradeCanary, s conversion.Scope) error {
+	return autoConvert_platform_UpgradeCanary_To_v1_UpgradeCanary(in, out, s)
+}
+
+func autoConvert_v1_UpgradeCanaryStatus_To_platform_UpgradeCanaryStatus(in *UpgradeCanaryStatus, out *platform.UpgradeCanaryStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Node = in.Node
+	out.Checks = *(*[]platform.ClusterCondition)(unsafe.Pointer(&in.Checks))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_v1_UpgradeCanaryStatus_To_platform_UpgradeCanaryStatus is an autogenerated conversion function.
+func Convert_v1_UpgradeCanaryStatus_To_platform_UpgradeCanaryStatus(in *UpgradeCanaryStatus, out *platform.UpgradeCanaryStatus, s conversion.Scope) error {
+	return autoConvert_v1_UpgradeCanaryStatus_To_platform_UpgradeCanaryStatus(in, out, s)
+}
+
+func autoConvert_platform_UpgradeCanaryStatus_To_v1_UpgradeCanaryStatus(in *platform.UpgradeCanaryStatus, out *UpgradeCanaryStatus, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Node = in.Node
+	out.Checks = *(*[]ClusterCondition)(unsafe.Pointer(&in.Checks))
+	out.Approved = in.Approved
+	return nil
+}
+
+// Convert_platform_UpgradeCanaryStatus_To_v1_UpgradeCanaryStatus is an autogenerated conversion function.
+func Convert_platform_UpgradeCanaryStatus_To_v1_UpgradeCanaryStatus(in *platform.UpgradeCanaryStatus, out *UpgradeCanaryStatus, s conversion.Scope) error {
+	return autoConvert_platform_UpgradeCanaryStatus_To_v1_UpgradeCanaryStatus(in, out, s)
+}
+
 func autoConvert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(in *UpgradeStrategy, out *platform.UpgradeStrategy, s conversion.Scope) error {
 	if err := metav1.Convert_Pointer_intstr_IntOrString_To_intstr_IntOrString(&in.MaxUnready, &out.MaxUnready, s); err != nil {
 		return err
@@ -3754,6 +7769,8 @@ func autoConvert_v1_UpgradeStrategy_To_platform_UpgradeStrategy(in *UpgradeStrat
 	if err := metav1.Convert_Pointer_bool_To_bool(&in.DrainNodeBeforeUpgrade, &out.DrainNodeBeforeUpgrade, s); err != nil {
 		return err
 	}
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	return nil
 }
 
@@ -3769,6 +7786,8 @@ func autoConvert_platform_UpgradeStrategy_To_v1_UpgradeStrategy(in *platform.Upg
 	if err := metav1.Convert_bool_To_Pointer_bool(&in.DrainNodeBeforeUpgrade, &out.DrainNodeBeforeUpgrade, s); err != nil {
 		return err
 	}
+	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	return nil
 }
 
@@ -3837,6 +7856,7 @@ func autoConvert_v1_VolumeDecoratorSpec_To_platform_VolumeDecoratorSpec(in *Volu
 	out.Version = in.Version
 	out.VolumeTypes = *(*[]string)(unsafe.Pointer(&in.VolumeTypes))
 	out.WorkloadAdmission = in.WorkloadAdmission
+	out.Enforcement = (*platform.VolumeUsageEnforcement)(unsafe.Pointer(in.Enforcement))
 	return nil
 }
 
@@ -3851,6 +7871,7 @@ func autoConvert_platform_VolumeDecoratorSpec_To_v1_VolumeDecoratorSpec(in *plat
 	out.Version = in.Version
 	out.VolumeTypes = *(*[]string)(unsafe.Pointer(&in.VolumeTypes))
 	out.WorkloadAdmission = in.WorkloadAdmission
+	out.Enforcement = (*VolumeUsageEnforcement)(unsafe.Pointer(in.Enforcement))
 	return nil
 }
 
@@ -3868,6 +7889,7 @@ func autoConvert_v1_VolumeDecoratorStatus_To_platform_VolumeDecoratorStatus(in *
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Usages = *(*[]platform.PersistentVolumeClaimUsage)(unsafe.Pointer(&in.Usages))
 	return nil
 }
 
@@ -3885,6 +7907,7 @@ func autoConvert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(in *
 	out.Reason = in.Reason
 	out.RetryCount = in.RetryCount
 	out.LastReInitializingTimestamp = in.LastReInitializingTimestamp
+	out.Usages = *(*[]PersistentVolumeClaimUsage)(unsafe.Pointer(&in.Usages))
 	return nil
 }
 
@@ -3892,3 +7915,25 @@ func autoConvert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(in *
 func Convert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(in *platform.VolumeDecoratorStatus, out *VolumeDecoratorStatus, s conversion.Scope) error {
 	return autoConvert_platform_VolumeDecoratorStatus_To_v1_VolumeDecoratorStatus(in, out, s)
 }
+
+func autoConvert_v1_VolumeUsageEnforcement_To_platform_VolumeUsageEnforcement(in *VolumeUsageEnforcement, out *platform.VolumeUsageEnforcement, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Action = platform.VolumeUsageAction(in.Action)
+	return nil
+}
+
+// Convert_v1_VolumeUsageEnforcement_To_platform_VolumeUsageEnforcement is an autogenerated conversion function.
+func Convert_v1_VolumeUsageEnforcement_To_platform_VolumeUsageEnforcement(in *VolumeUsageEnforcement, out *platform.VolumeUsageEnforcement, s conversion.Scope) error {
+	return autoConvert_v1_VolumeUsageEnforcement_To_platform_VolumeUsageEnforcement(in, out, s)
+}
+
+func autoConvert_platform_VolumeUsageEnforcement_To_v1_VolumeUsageEnforcement(in *platform.VolumeUsageEnforcement, out *VolumeUsageEnforcement, s conversion.Scope) error {
+	out.Threshold = in.Threshold
+	out.Action = VolumeUsageAction(in.Action)
+	return nil
+}
+
+// Convert_platform_VolumeUsageEnforcement_To_v1_VolumeUsageEnforcement is an autogenerated conversion function.
+func Convert_platform_VolumeUsageEnforcement_To_v1_VolumeUsageEnforcement(in *platform.VolumeUsageEnforcement, out *VolumeUsageEnforcement, s conversion.Scope) error {
+	return autoConvert_platform_VolumeUsageEnforcement_To_v1_VolumeUsageEnforcement(in, out, s)
+}
@@ -34,20 +34,36 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CSIOperatorList{}, func(obj interface{}) { SetObjectDefaults_CSIOperatorList(obj.(*CSIOperatorList)) })
 	scheme.AddTypeDefaultingFunc(&Cluster{}, func(obj interface{}) { SetObjectDefaults_Cluster(obj.(*Cluster)) })
 	scheme.AddTypeDefaultingFunc(&ClusterList{}, func(obj interface{}) { SetObjectDefaults_ClusterList(obj.(*ClusterList)) })
+	scheme.AddTypeDefaultingFunc(&ClusterOperation{}, func(obj interface{}) { SetObjectDefaults_ClusterOperation(obj.(*ClusterOperation)) })
+	scheme.AddTypeDefaultingFunc(&ClusterOperationList{}, func(obj interface{}) { SetObjectDefaults_ClusterOperationList(obj.(*ClusterOperationList)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMap{}, func(obj interface{}) { SetObjectDefaults_ConfigMap(obj.(*ConfigMap)) })
 	scheme.AddTypeDefaultingFunc(&ConfigMapList{}, func(obj interface{}) { SetObjectDefaults_ConfigMapList(obj.(*ConfigMapList)) })
 	scheme.AddTypeDefaultingFunc(&CronHPA{}, func(obj interface{}) { SetObjectDefaults_CronHPA(obj.(*CronHPA)) })
 	scheme.AddTypeDefaultingFunc(&CronHPAList{}, func(obj interface{}) { SetObjectDefaults_CronHPAList(obj.(*CronHPAList)) })
+	scheme.AddTypeDefaultingFunc(&DevicePlugin{}, func(obj interface{}) { SetObjectDefaults_DevicePlugin(obj.(*DevicePlugin)) })
+	scheme.AddTypeDefaultingFunc(&DevicePluginList{}, func(obj interface{}) { SetObjectDefaults_DevicePluginList(obj.(*DevicePluginList)) })
+	scheme.AddTypeDefaultingFunc(&EgressGateway{}, func(obj interface{}) { SetObjectDefaults_EgressGateway(obj.(*EgressGateway)) })
+	scheme.AddTypeDefaultingFunc(&EgressGatewayList{}, func(obj interface{}) { SetObjectDefaults_EgressGatewayList(obj.(*EgressGatewayList)) })
+	scheme.AddTypeDefaultingFunc(&FloatingIPReservation{}, func(obj interface{}) { SetObjectDefaults_FloatingIPReservation(obj.(*FloatingIPReservation)) })
+	scheme.AddTypeDefaultingFunc(&FloatingIPReservationList{}, func(obj interface{}) { SetObjectDefaults_FloatingIPReservationList(obj.(*FloatingIPReservationList)) })
+	scheme.AddTypeDefaultingFunc(&GPUNodeConfig{}, func(obj interface{}) { SetObjectDefaults_GPUNodeConfig(obj.(*GPUNodeConfig)) })
+	scheme.AddTypeDefaultingFunc(&GPUNodeConfigList{}, func(obj interface{}) { SetObjectDefaults_GPUNodeConfigList(obj.(*GPUNodeConfigList)) })
 	scheme.AddTypeDefaultingFunc(&Helm{}, func(obj interface{}) { SetObjectDefaults_Helm(obj.(*Helm)) })
 	scheme.AddTypeDefaultingFunc(&HelmList{}, func(obj interface{}) { SetObjectDefaults_HelmList(obj.(*HelmList)) })
 	scheme.AddTypeDefaultingFunc(&IPAM{}, func(obj interface{}) { SetObjectDefaults_IPAM(obj.(*IPAM)) })
 	scheme.AddTypeDefaultingFunc(&IPAMList{}, func(obj interface{}) { SetObjectDefaults_IPAMList(obj.(*IPAMList)) })
 	scheme.AddTypeDefaultingFunc(&LBCF{}, func(obj interface{}) { SetObjectDefaults_LBCF(obj.(*LBCF)) })
 	scheme.AddTypeDefaultingFunc(&LBCFList{}, func(obj interface{}) { SetObjectDefaults_LBCFList(obj.(*LBCFList)) })
+	scheme.AddTypeDefaultingFunc(&License{}, func(obj interface{}) { SetObjectDefaults_License(obj.(*License)) })
+	scheme.AddTypeDefaultingFunc(&LicenseList{}, func(obj interface{}) { SetObjectDefaults_LicenseList(obj.(*LicenseList)) })
 	scheme.AddTypeDefaultingFunc(&LogCollector{}, func(obj interface{}) { SetObjectDefaults_LogCollector(obj.(*LogCollector)) })
 	scheme.AddTypeDefaultingFunc(&LogCollectorList{}, func(obj interface{}) { SetObjectDefaults_LogCollectorList(obj.(*LogCollectorList)) })
 	scheme.AddTypeDefaultingFunc(&Machine{}, func(obj interface{}) { SetObjectDefaults_Machine(obj.(*Machine)) })
 	scheme.AddTypeDefaultingFunc(&MachineList{}, func(obj interface{}) { SetObjectDefaults_MachineList(obj.(*MachineList)) })
+	scheme.AddTypeDefaultingFunc(&MetalLB{}, func(obj interface{}) { SetObjectDefaults_MetalLB(obj.(*MetalLB)) })
+	scheme.AddTypeDefaultingFunc(&MetalLBList{}, func(obj interface{}) { SetObjectDefaults_MetalLBList(obj.(*MetalLBList)) })
+	scheme.AddTypeDefaultingFunc(&Multus{}, func(obj interface{}) { SetObjectDefaults_Multus(obj.(*Multus)) })
+	scheme.AddTypeDefaultingFunc(&MultusList{}, func(obj interface{}) { SetObjectDefaults_MultusList(obj.(*MultusList)) })
 	scheme.AddTypeDefaultingFunc(&PersistentEvent{}, func(obj interface{}) { SetObjectDefaults_PersistentEvent(obj.(*PersistentEvent)) })
 	scheme.AddTypeDefaultingFunc(&PersistentEventList{}, func(obj interface{}) { SetObjectDefaults_PersistentEventList(obj.(*PersistentEventList)) })
 	scheme.AddTypeDefaultingFunc(&Prometheus{}, func(obj interface{}) { SetObjectDefaults_Prometheus(obj.(*Prometheus)) })
@@ -82,6 +98,17 @@ func SetObjectDefaults_ClusterList(in *ClusterList) {
 	}
 }
 
+func SetObjectDefaults_ClusterOperation(in *ClusterOperation) {
+	SetDefaults_ClusterOperationStatus(&in.Status)
+}
+
+func SetObjectDefaults_ClusterOperationList(in *ClusterOperationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterOperation(a)
+	}
+}
+
 func SetObjectDefaults_ConfigMap(in *ConfigMap) {
 	SetDefaults_ConfigMap(in)
 }
@@ -104,6 +131,50 @@ func SetObjectDefaults_CronHPAList(in *CronHPAList) {
 	}
 }
 
+func SetObjectDefaults_DevicePlugin(in *DevicePlugin) {
+	SetDefaults_DevicePluginStatus(&in.Status)
+}
+
+func SetObjectDefaults_DevicePluginList(in *DevicePluginList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_DevicePlugin(a)
+	}
+}
+
+func SetObjectDefaults_EgressGateway(in *EgressGateway) {
+	SetDefaults_EgressGatewayStatus(&in.Status)
+}
+
+func SetObjectDefaults_EgressGatewayList(in *EgressGatewayList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_EgressGateway(a)
+	}
+}
+
+func SetObjectDefaults_FloatingIPReservation(in *FloatingIPReservation) {
+	SetDefaults_FloatingIPReservationStatus(&in.Status)
+}
+
+func SetObjectDefaults_FloatingIPReservationList(in *FloatingIPReservationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_FloatingIPReservation(a)
+	}
+}
+
+func SetObjectDefaults_GPUNodeConfig(in *GPUNodeConfig) {
+	SetDefaults_GPUNodeConfigStatus(&in.Status)
+}
+
+func SetObjectDefaults_GPUNodeConfigList(in *GPUNodeConfigList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_GPUNodeConfig(a)
+	}
+}
+
 func SetObjectDefaults_Helm(in *Helm) {
 	SetDefaults_HelmStatus(&in.Status)
 }
@@ -137,6 +208,17 @@ func SetObjectDefaults_LBCFList(in *LBCFList) {
 	}
 }
 
+func SetObjectDefaults_License(in *License) {
+	SetDefaults_LicenseSpec(&in.Spec)
+}
+
+func SetObjectDefaults_LicenseList(in *LicenseList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_License(a)
+	}
+}
+
 func SetObjectDefaults_LogCollector(in *LogCollector) {
 	SetDefaults_LogCollectorStatus(&in.Status)
 }
@@ -159,6 +241,28 @@ func SetObjectDefaults_MachineList(in *MachineList) {
 	}
 }
 
+func SetObjectDefaults_MetalLB(in *MetalLB) {
+	SetDefaults_MetalLBStatus(&in.Status)
+}
+
+func SetObjectDefaults_MetalLBList(in *MetalLBList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_MetalLB(a)
+	}
+}
+
+func SetObjectDefaults_Multus(in *Multus) {
+	SetDefaults_MultusStatus(&in.Status)
+}
+
+func SetObjectDefaults_MultusList(in *MultusList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Multus(a)
+	}
+}
+
 func SetObjectDefaults_PersistentEvent(in *PersistentEvent) {
 	SetDefaults_PersistentEventStatus(&in.Status)
 }
@@ -23,10 +23,13 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -42,6 +45,19 @@ import (
 
 const MaxTimeOffset = 5 * 300
 
+var (
+	evictionSignals = []string{
+		"memory.available",
+		"nodefs.available",
+		"nodefs.inodesFree",
+		"imagefs.available",
+		"imagefs.inodesFree",
+		"pid.available",
+	}
+	topologyManagerPolicies = []string{"none", "best-effort", "restricted", "single-numa-node"}
+	cpuListRegexp           = regexp.MustCompile(`^\d+(-\d+)?(,\d+(-\d+)?)*$`)
+)
+
 // ValidateMachine validates a given machine.
 func ValidateMachine(ctx context.Context, machine *platform.Machine, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
 	allErrs := apimachineryvalidation.ValidateObjectMeta(&machine.ObjectMeta, false, apimachineryvalidation.NameIsDNSLabel, field.NewPath("metadata"))
@@ -60,6 +76,7 @@ func ValidateMachineUpdate(ctx context.Context, machine *platform.Machine, oldMa
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.IP, oldMachine.Spec.IP, fldPath.Child("ip"))...)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.Labels, oldMachine.Spec.Labels, fldPath.Child("labels"))...)
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(machine.Spec.Taints, oldMachine.Spec.Taints, fldPath.Child("taints"))...)
+	allErrs = append(allErrs, ValidateKubeletConfigurationOverrides(machine.Spec.KubeletConfiguration, fldPath.Child("kubeletConfiguration"))...)
 
 	return allErrs
 }
@@ -86,10 +103,72 @@ func ValidateMachineSpec(ctx context.Context, spec *platform.MachineSpec, fldPat
 		}
 		allErrs = append(allErrs, ValidateWorkerTimeOffset(fldPath, worker, masters)...)
 	}
+	allErrs = append(allErrs, ValidateKubeletConfigurationOverrides(spec.KubeletConfiguration, fldPath.Child("kubeletConfiguration"))...)
 
 	return allErrs
 }
 
+// ValidateKubeletConfigurationOverrides validates a given kubelet configuration overrides.
+func ValidateKubeletConfigurationOverrides(overrides *platform.KubeletConfigurationOverrides, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if overrides == nil {
+		return allErrs
+	}
+
+	if overrides.MaxPods != nil && *overrides.MaxPods <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxPods"), *overrides.MaxPods, "must be greater than 0"))
+	}
+	for signal, value := range overrides.EvictionHard {
+		signalPath := fldPath.Child("evictionHard").Key(signal)
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(signal, signalPath, evictionSignals)...)
+		if !validEvictionThreshold(value) {
+			allErrs = append(allErrs, field.Invalid(signalPath, value, "must be a non-negative quantity or a percentage between 0% and 100%"))
+		}
+	}
+	if overrides.ReservedCPUs != "" && !validCPUList(overrides.ReservedCPUs) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("reservedCPUs"), overrides.ReservedCPUs, "must be a cpu list, e.g. 0-1,4"))
+	}
+	if overrides.TopologyManagerPolicy != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(overrides.TopologyManagerPolicy, fldPath.Child("topologyManagerPolicy"), topologyManagerPolicies)...)
+	}
+
+	return allErrs
+}
+
+// validEvictionThreshold returns true if the value is a threshold accepted by
+// the kubelet, either a non-negative quantity or a percentage.
+func validEvictionThreshold(value string) bool {
+	if strings.HasSuffix(value, "%") {
+		percentage, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 32)
+		return err == nil && percentage >= 0 && percentage <= 100
+	}
+	quantity, err := resource.ParseQuantity(value)
+	return err == nil && quantity.Sign() >= 0
+}
+
+// validCPUList returns true if the cpus is a cpu list in linux cpuset format,
+// the ranges of which are ascending, e.g. 0-1,4.
+func validCPUList(cpus string) bool {
+	if !cpuListRegexp.MatchString(cpus) {
+		return false
+	}
+	for _, r := range strings.Split(cpus, ",") {
+		bounds := strings.SplitN(r, "-", 2)
+		if len(bounds) == 1 {
+			continue
+		}
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return false
+		}
+		end, err := strconv.Atoi(bounds[1])
+		if err != nil || start > end {
+			return false
+		}
+	}
+	return true
+}
+
 // ValidateMachineByProvider validates a given machine by machine provider.
 func ValidateMachineByProvider(machine *platform.Machine) field.ErrorList {
 	p, err := machineprovider.GetProvider(machine.Spec.Type)
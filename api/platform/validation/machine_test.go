@@ -0,0 +1,140 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+func TestValidateKubeletConfigurationOverrides(t *testing.T) {
+	maxPods := int32(64)
+	zero := int32(0)
+	negative := int32(-1)
+	tests := []struct {
+		name      string
+		overrides *platform.KubeletConfigurationOverrides
+		wantErr   bool
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "valid",
+			overrides: &platform.KubeletConfigurationOverrides{
+				MaxPods:               &maxPods,
+				EvictionHard:          map[string]string{"memory.available": "300Mi", "nodefs.available": "10%"},
+				ReservedCPUs:          "0-1,4",
+				TopologyManagerPolicy: "single-numa-node",
+			},
+		},
+		{
+			name:      "zero max pods",
+			overrides: &platform.KubeletConfigurationOverrides{MaxPods: &zero},
+			wantErr:   true,
+		},
+		{
+			name:      "unknown eviction signal",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{"memory.free": "300Mi"}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid eviction threshold",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{"memory.available": "lots"}},
+			wantErr:   true,
+		},
+		{
+			name:      "negative max pods",
+			overrides: &platform.KubeletConfigurationOverrides{MaxPods: &negative},
+			wantErr:   true,
+		},
+		{
+			name:      "negative eviction threshold",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{"memory.available": "-300Mi"}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid eviction percentage",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{"nodefs.available": "ten%"}},
+			wantErr:   true,
+		},
+		{
+			name:      "eviction percentage over 100",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{"nodefs.available": "150%"}},
+			wantErr:   true,
+		},
+		{
+			name: "all eviction signals",
+			overrides: &platform.KubeletConfigurationOverrides{EvictionHard: map[string]string{
+				"memory.available":   "100Mi",
+				"nodefs.available":   "10%",
+				"nodefs.inodesFree":  "5%",
+				"imagefs.available":  "15%",
+				"imagefs.inodesFree": "5%",
+				"pid.available":      "1k",
+			}},
+		},
+		{
+			name:      "invalid reserved cpus",
+			overrides: &platform.KubeletConfigurationOverrides{ReservedCPUs: "0-"},
+			wantErr:   true,
+		},
+		{
+			name:      "descending reserved cpus",
+			overrides: &platform.KubeletConfigurationOverrides{ReservedCPUs: "3-1"},
+			wantErr:   true,
+		},
+		{
+			name:      "reserved cpus with spaces",
+			overrides: &platform.KubeletConfigurationOverrides{ReservedCPUs: "0, 1"},
+			wantErr:   true,
+		},
+		{
+			name:      "single reserved cpu",
+			overrides: &platform.KubeletConfigurationOverrides{ReservedCPUs: "2"},
+		},
+		{
+			name:      "unknown topology manager policy",
+			overrides: &platform.KubeletConfigurationOverrides{TopologyManagerPolicy: "numa"},
+			wantErr:   true,
+		},
+		{
+			name:      "best-effort topology manager policy",
+			overrides: &platform.KubeletConfigurationOverrides{TopologyManagerPolicy: "best-effort"},
+		},
+		{
+			name:      "restricted topology manager policy",
+			overrides: &platform.KubeletConfigurationOverrides{TopologyManagerPolicy: "restricted"},
+		},
+		{
+			name:      "none topology manager policy",
+			overrides: &platform.KubeletConfigurationOverrides{TopologyManagerPolicy: "none"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateKubeletConfigurationOverrides(tt.overrides, field.NewPath("spec", "kubeletConfiguration"))
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("ValidateKubeletConfigurationOverrides() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
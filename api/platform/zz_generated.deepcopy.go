@@ -25,8 +25,27 @@ package platform
 import (
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonCondition) DeepCopyInto(out *AddonCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonCondition.
+func (in *AddonCondition) DeepCopy() *AddonCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddonSpec) DeepCopyInto(out *AddonSpec) {
 	*out = *in
@@ -43,6 +62,38 @@ func (in *AddonSpec) DeepCopy() *AddonSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditLogBackend) DeepCopyInto(out *AuditLogBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditLogBackend.
+func (in *AuditLogBackend) DeepCopy() *AuditLogBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditLogBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhookBackend) DeepCopyInto(out *AuditWebhookBackend) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhookBackend.
+func (in *AuditWebhookBackend) DeepCopy() *AuditWebhookBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhookBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthzWebhookAddr) DeepCopyInto(out *AuthzWebhookAddr) {
 	*out = *in
@@ -85,6 +136,27 @@ func (in *BuiltinAuthzWebhookAddr) DeepCopy() *BuiltinAuthzWebhookAddr {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRDConflict) DeepCopyInto(out *CRDConflict) {
+	*out = *in
+	if in.ServedVersions != nil {
+		in, out := &in.ServedVersions, &out.ServedVersions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRDConflict.
+func (in *CRDConflict) DeepCopy() *CRDConflict {
+	if in == nil {
+		return nil
+	}
+	out := new(CRDConflict)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSIOperator) DeepCopyInto(out *CSIOperator) {
 	*out = *in
@@ -182,6 +254,13 @@ func (in *CSIOperatorSpec) DeepCopy() *CSIOperatorSpec {
 func (in *CSIOperatorStatus) DeepCopyInto(out *CSIOperatorStatus) {
 	*out = *in
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.CRDConflicts != nil {
+		in, out := &in.CRDConflicts, &out.CRDConflicts
+		*out = make([]CRDConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -220,6 +299,99 @@ func (in *CSIProxyOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalicoNetwork) DeepCopyInto(out *CalicoNetwork) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalicoNetwork.
+func (in *CalicoNetwork) DeepCopy() *CalicoNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(CalicoNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateExpiration) DeepCopyInto(out *CertificateExpiration) {
+	*out = *in
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateExpiration.
+func (in *CertificateExpiration) DeepCopy() *CertificateExpiration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateExpiration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRotation) DeepCopyInto(out *CertificateRotation) {
+	*out = *in
+	if in.RotateRequestedAt != nil {
+		in, out := &in.RotateRequestedAt, &out.RotateRequestedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRotation.
+func (in *CertificateRotation) DeepCopy() *CertificateRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatesStatus) DeepCopyInto(out *CertificatesStatus) {
+	*out = *in
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = make([]CertificateExpiration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastRotationTime.DeepCopyInto(&out.LastRotationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatesStatus.
+func (in *CertificatesStatus) DeepCopy() *CertificatesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CiliumNetwork) DeepCopyInto(out *CiliumNetwork) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CiliumNetwork.
+func (in *CiliumNetwork) DeepCopy() *CiliumNetwork {
+	if in == nil {
+		return nil
+	}
+	out := new(CiliumNetwork)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cluster) DeepCopyInto(out *Cluster) {
 	*out = *in
@@ -351,6 +523,11 @@ func (in *ClusterAddonType) DeepCopyInto(out *ClusterAddonType) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -446,6 +623,32 @@ func (in *ClusterApplyOptions) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAudit) DeepCopyInto(out *ClusterAudit) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(AuditLogBackend)
+		**out = **in
+	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhookBackend)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAudit.
+func (in *ClusterAudit) DeepCopy() *ClusterAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterComponent) DeepCopyInto(out *ClusterComponent) {
 	*out = *in
@@ -557,6 +760,31 @@ func (in *ClusterCredential) DeepCopyInto(out *ClusterCredential) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EtcdBackupSecretAccessKey != nil {
+		in, out := &in.EtcdBackupSecretAccessKey, &out.EtcdBackupSecretAccessKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegistryPasswords != nil {
+		in, out := &in.RegistryPasswords, &out.RegistryPasswords
+		*out = make(map[string][]byte, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = make([]byte, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
 	return
 }
 
@@ -611,6 +839,39 @@ func (in *ClusterCredentialList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterDNS) DeepCopyInto(out *ClusterDNS) {
+	*out = *in
+	if in.StubDomains != nil {
+		in, out := &in.StubDomains, &out.StubDomains
+		*out = make([]DNSStubDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UpstreamNameservers != nil {
+		in, out := &in.UpstreamNameservers, &out.UpstreamNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Autoscaler != nil {
+		in, out := &in.Autoscaler, &out.Autoscaler
+		*out = new(DNSAutoscaler)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterDNS.
+func (in *ClusterDNS) DeepCopy() *ClusterDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterFeature) DeepCopyInto(out *ClusterFeature) {
 	*out = *in
@@ -667,6 +928,96 @@ func (in *ClusterFeature) DeepCopyInto(out *ClusterFeature) {
 		(*in).DeepCopyInto(*out)
 	}
 	out.Upgrade = in.Upgrade
+	if in.SandboxRuntime != nil {
+		in, out := &in.SandboxRuntime, &out.SandboxRuntime
+		*out = new(SandboxRuntime)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkEncryption != nil {
+		in, out := &in.NetworkEncryption, &out.NetworkEncryption
+		*out = new(NetworkEncryption)
+		**out = **in
+	}
+	if in.Audit != nil {
+		in, out := &in.Audit, &out.Audit
+		*out = new(ClusterAudit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdBackup != nil {
+		in, out := &in.EtcdBackup, &out.EtcdBackup
+		*out = new(EtcdBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretsEncryption != nil {
+		in, out := &in.SecretsEncryption, &out.SecretsEncryption
+		*out = new(SecretsEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateRotation != nil {
+		in, out := &in.CertificateRotation, &out.CertificateRotation
+		*out = new(CertificateRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeletServerTLSBootstrap != nil {
+		in, out := &in.KubeletServerTLSBootstrap, &out.KubeletServerTLSBootstrap
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SystemTuning != nil {
+		in, out := &in.SystemTuning, &out.SystemTuning
+		*out = new(SystemTuning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeSync != nil {
+		in, out := &in.TimeSync, &out.TimeSync
+		*out = new(TimeSync)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceOverrides != nil {
+		in, out := &in.ServiceOverrides, &out.ServiceOverrides
+		*out = new(ServiceOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GalaxyNetwork != nil {
+		in, out := &in.GalaxyNetwork, &out.GalaxyNetwork
+		*out = new(GalaxyNetwork)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Calico != nil {
+		in, out := &in.Calico, &out.Calico
+		*out = new(CalicoNetwork)
+		**out = **in
+	}
+	if in.Cilium != nil {
+		in, out := &in.Cilium, &out.Cilium
+		*out = new(CiliumNetwork)
+		**out = **in
+	}
+	if in.ImageAdmission != nil {
+		in, out := &in.ImageAdmission, &out.ImageAdmission
+		*out = new(ImageAdmission)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubeProxy != nil {
+		in, out := &in.KubeProxy, &out.KubeProxy
+		*out = new(KubeProxy)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(ClusterDNS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyFeature)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GPUManager != nil {
+		in, out := &in.GPUManager, &out.GPUManager
+		*out = new(GPUManagerFeature)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -681,49 +1032,147 @@ func (in *ClusterFeature) DeepCopy() *ClusterFeature {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+func (in *ClusterHealth) DeepCopyInto(out *ClusterHealth) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Cluster, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]ClusterHealthDimension, len(*in))
+		copy(*out, *in)
 	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
-func (in *ClusterList) DeepCopy() *ClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealth.
+func (in *ClusterHealth) DeepCopy() *ClusterHealth {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterList)
+	out := new(ClusterHealth)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterHealthDimension) DeepCopyInto(out *ClusterHealthDimension) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterHealthDimension.
+func (in *ClusterHealthDimension) DeepCopy() *ClusterHealthDimension {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ClusterHealthDimension)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterMachine) DeepCopyInto(out *ClusterMachine) {
+func (in *ClusterImage) DeepCopyInto(out *ClusterImage) {
 	*out = *in
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.PrivateKey != nil {
-		in, out := &in.PrivateKey, &out.PrivateKey
-		*out = make([]byte, len(*in))
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterImage.
+func (in *ClusterImage) DeepCopy() *ClusterImage {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterList) DeepCopyInto(out *ClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Cluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterList.
+func (in *ClusterList) DeepCopy() *ClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterLogsOptions) DeepCopyInto(out *ClusterLogsOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.SinceSeconds != nil {
+		in, out := &in.SinceSeconds, &out.SinceSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.SinceTime != nil {
+		in, out := &in.SinceTime, &out.SinceTime
+		*out = (*in).DeepCopy()
+	}
+	if in.TailLines != nil {
+		in, out := &in.TailLines, &out.TailLines
+		*out = new(int64)
+		**out = **in
+	}
+	if in.LimitBytes != nil {
+		in, out := &in.LimitBytes, &out.LimitBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterLogsOptions.
+func (in *ClusterLogsOptions) DeepCopy() *ClusterLogsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterLogsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterLogsOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterMachine) DeepCopyInto(out *ClusterMachine) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
 	if in.PassPhrase != nil {
@@ -758,6 +1207,107 @@ func (in *ClusterMachine) DeepCopy() *ClusterMachine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperation) DeepCopyInto(out *ClusterOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperation.
+func (in *ClusterOperation) DeepCopy() *ClusterOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperationList) DeepCopyInto(out *ClusterOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperationList.
+func (in *ClusterOperationList) DeepCopy() *ClusterOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperationSpec) DeepCopyInto(out *ClusterOperationSpec) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpireTime.DeepCopyInto(&out.ExpireTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperationSpec.
+func (in *ClusterOperationSpec) DeepCopy() *ClusterOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterOperationStatus) DeepCopyInto(out *ClusterOperationStatus) {
+	*out = *in
+	in.LastAttemptTime.DeepCopyInto(&out.LastAttemptTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterOperationStatus.
+func (in *ClusterOperationStatus) DeepCopy() *ClusterOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterProperty) DeepCopyInto(out *ClusterProperty) {
 	*out = *in
@@ -914,6 +1464,16 @@ func (in *ClusterSpec) DeepCopyInto(out *ClusterSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ControlPlaneOverrides != nil {
+		in, out := &in.ControlPlaneOverrides, &out.ControlPlaneOverrides
+		*out = new(ControlPlaneOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRegistries != nil {
+		in, out := &in.ContainerRegistries, &out.ContainerRegistries
+		*out = new(ContainerRegistryConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -958,6 +1518,46 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(Progress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificates != nil {
+		in, out := &in.Certificates, &out.Certificates
+		*out = new(CertificatesStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EtcdMaintenance != nil {
+		in, out := &in.EtcdMaintenance, &out.EtcdMaintenance
+		*out = new(EtcdMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeCanary != nil {
+		in, out := &in.UpgradeCanary, &out.UpgradeCanary
+		*out = new(UpgradeCanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Health != nil {
+		in, out := &in.Health, &out.Health
+		*out = new(ClusterHealth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]ClusterImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicyStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1052,6 +1652,73 @@ func (in *ConfigMapList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRegistryConfig) DeepCopyInto(out *ContainerRegistryConfig) {
+	*out = *in
+	if in.Mirrors != nil {
+		in, out := &in.Mirrors, &out.Mirrors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InsecureRegistries != nil {
+		in, out := &in.InsecureRegistries, &out.InsecureRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Auths != nil {
+		in, out := &in.Auths, &out.Auths
+		*out = make([]RegistryAuth, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRegistryConfig.
+func (in *ContainerRegistryConfig) DeepCopy() *ContainerRegistryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRegistryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneOverrides) DeepCopyInto(out *ControlPlaneOverrides) {
+	*out = *in
+	if in.APIServer != nil {
+		in, out := &in.APIServer, &out.APIServer
+		*out = new(StaticPodOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ControllerManager != nil {
+		in, out := &in.ControllerManager, &out.ControllerManager
+		*out = new(StaticPodOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Scheduler != nil {
+		in, out := &in.Scheduler, &out.Scheduler
+		*out = new(StaticPodOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Etcd != nil {
+		in, out := &in.Etcd, &out.Etcd
+		*out = new(StaticPodOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneOverrides.
+func (in *ControlPlaneOverrides) DeepCopy() *ControlPlaneOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CronHPA) DeepCopyInto(out *CronHPA) {
 	*out = *in
@@ -1158,6 +1825,20 @@ func (in *CronHPASpec) DeepCopy() *CronHPASpec {
 func (in *CronHPAStatus) DeepCopyInto(out *CronHPAStatus) {
 	*out = *in
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.CRDConflicts != nil {
+		in, out := &in.CRDConflicts, &out.CRDConflicts
+		*out = make([]CRDConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AddonCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1172,132 +1853,64 @@ func (in *CronHPAStatus) DeepCopy() *CronHPAStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Etcd) DeepCopyInto(out *Etcd) {
+func (in *DNSAutoscaler) DeepCopyInto(out *DNSAutoscaler) {
 	*out = *in
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
-		*out = new(LocalEtcd)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.External != nil {
-		in, out := &in.External, &out.External
-		*out = new(ExternalEtcd)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Etcd.
-func (in *Etcd) DeepCopy() *Etcd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSAutoscaler.
+func (in *DNSAutoscaler) DeepCopy() *DNSAutoscaler {
 	if in == nil {
 		return nil
 	}
-	out := new(Etcd)
+	out := new(DNSAutoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalAuthzWebhookAddr) DeepCopyInto(out *ExternalAuthzWebhookAddr) {
+func (in *DNSStubDomain) DeepCopyInto(out *DNSStubDomain) {
 	*out = *in
+	if in.Nameservers != nil {
+		in, out := &in.Nameservers, &out.Nameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAuthzWebhookAddr.
-func (in *ExternalAuthzWebhookAddr) DeepCopy() *ExternalAuthzWebhookAddr {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSStubDomain.
+func (in *DNSStubDomain) DeepCopy() *DNSStubDomain {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalAuthzWebhookAddr)
+	out := new(DNSStubDomain)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalEtcd) DeepCopyInto(out *ExternalEtcd) {
-	*out = *in
-	if in.Endpoints != nil {
-		in, out := &in.Endpoints, &out.Endpoints
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEtcd.
-func (in *ExternalEtcd) DeepCopy() *ExternalEtcd {
-	if in == nil {
-		return nil
-	}
-	out := new(ExternalEtcd)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *File) DeepCopyInto(out *File) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
-func (in *File) DeepCopy() *File {
-	if in == nil {
-		return nil
-	}
-	out := new(File)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HA) DeepCopyInto(out *HA) {
-	*out = *in
-	if in.TKEHA != nil {
-		in, out := &in.TKEHA, &out.TKEHA
-		*out = new(TKEHA)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.ThirdPartyHA != nil {
-		in, out := &in.ThirdPartyHA, &out.ThirdPartyHA
-		*out = new(ThirdPartyHA)
-		**out = **in
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HA.
-func (in *HA) DeepCopy() *HA {
-	if in == nil {
-		return nil
-	}
-	out := new(HA)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Helm) DeepCopyInto(out *Helm) {
+func (in *DevicePlugin) DeepCopyInto(out *DevicePlugin) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Helm.
-func (in *Helm) DeepCopy() *Helm {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePlugin.
+func (in *DevicePlugin) DeepCopy() *DevicePlugin {
 	if in == nil {
 		return nil
 	}
-	out := new(Helm)
+	out := new(DevicePlugin)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Helm) DeepCopyObject() runtime.Object {
+func (in *DevicePlugin) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1305,13 +1918,13 @@ func (in *Helm) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmList) DeepCopyInto(out *HelmList) {
+func (in *DevicePluginList) DeepCopyInto(out *DevicePluginList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Helm, len(*in))
+		*out = make([]DevicePlugin, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1319,18 +1932,18 @@ func (in *HelmList) DeepCopyInto(out *HelmList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmList.
-func (in *HelmList) DeepCopy() *HelmList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePluginList.
+func (in *DevicePluginList) DeepCopy() *DevicePluginList {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmList)
+	out := new(DevicePluginList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HelmList) DeepCopyObject() runtime.Object {
+func (in *DevicePluginList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1338,85 +1951,89 @@ func (in *HelmList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmProxyOptions) DeepCopyInto(out *HelmProxyOptions) {
+func (in *DevicePluginSpec) DeepCopyInto(out *DevicePluginSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmProxyOptions.
-func (in *HelmProxyOptions) DeepCopy() *HelmProxyOptions {
-	if in == nil {
-		return nil
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out := new(HelmProxyOptions)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *HelmProxyOptions) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.HostPaths != nil {
+		in, out := &in.HostPaths, &out.HostPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmSpec) DeepCopyInto(out *HelmSpec) {
-	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmSpec.
-func (in *HelmSpec) DeepCopy() *HelmSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePluginSpec.
+func (in *DevicePluginSpec) DeepCopy() *DevicePluginSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmSpec)
+	out := new(DevicePluginSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmStatus) DeepCopyInto(out *HelmStatus) {
+func (in *DevicePluginStatus) DeepCopyInto(out *DevicePluginStatus) {
 	*out = *in
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AddonCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmStatus.
-func (in *HelmStatus) DeepCopy() *HelmStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DevicePluginStatus.
+func (in *DevicePluginStatus) DeepCopy() *DevicePluginStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmStatus)
+	out := new(DevicePluginStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAM) DeepCopyInto(out *IPAM) {
+func (in *EgressGateway) DeepCopyInto(out *EgressGateway) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
-func (in *IPAM) DeepCopy() *IPAM {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGateway.
+func (in *EgressGateway) DeepCopy() *EgressGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAM)
+	out := new(EgressGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPAM) DeepCopyObject() runtime.Object {
+func (in *EgressGateway) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1424,13 +2041,13 @@ func (in *IPAM) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMList) DeepCopyInto(out *IPAMList) {
+func (in *EgressGatewayList) DeepCopyInto(out *EgressGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IPAM, len(*in))
+		*out = make([]EgressGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1438,18 +2055,18 @@ func (in *IPAMList) DeepCopyInto(out *IPAMList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMList.
-func (in *IPAMList) DeepCopy() *IPAMList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayList.
+func (in *EgressGatewayList) DeepCopy() *EgressGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMList)
+	out := new(EgressGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPAMList) DeepCopyObject() runtime.Object {
+func (in *EgressGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1457,217 +2074,289 @@ func (in *IPAMList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMProxyOptions) DeepCopyInto(out *IPAMProxyOptions) {
+func (in *EgressGatewaySpec) DeepCopyInto(out *EgressGatewaySpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.IPPools != nil {
+		in, out := &in.IPPools, &out.IPPools
+		*out = make([]EgressIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FailoverTimeout != nil {
+		in, out := &in.FailoverTimeout, &out.FailoverTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMProxyOptions.
-func (in *IPAMProxyOptions) DeepCopy() *IPAMProxyOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewaySpec.
+func (in *EgressGatewaySpec) DeepCopy() *EgressGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMProxyOptions)
+	out := new(EgressGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IPAMProxyOptions) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+func (in *EgressGatewayStatus) DeepCopyInto(out *EgressGatewayStatus) {
 	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Assignments != nil {
+		in, out := &in.Assignments, &out.Assignments
+		*out = make([]EgressIPAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
-func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressGatewayStatus.
+func (in *EgressGatewayStatus) DeepCopy() *EgressGatewayStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMSpec)
+	out := new(EgressGatewayStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
+func (in *EgressIPAssignment) DeepCopyInto(out *EgressIPAssignment) {
 	*out = *in
-	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMStatus.
-func (in *IPAMStatus) DeepCopy() *IPAMStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPAssignment.
+func (in *EgressIPAssignment) DeepCopy() *EgressIPAssignment {
 	if in == nil {
 		return nil
 	}
-	out := new(IPAMStatus)
+	out := new(EgressIPAssignment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LBCF) DeepCopyInto(out *LBCF) {
+func (in *EgressIPPool) DeepCopyInto(out *EgressIPPool) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
-	in.Status.DeepCopyInto(&out.Status)
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCF.
-func (in *LBCF) DeepCopy() *LBCF {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressIPPool.
+func (in *EgressIPPool) DeepCopy() *EgressIPPool {
 	if in == nil {
 		return nil
 	}
-	out := new(LBCF)
+	out := new(EgressIPPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LBCF) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LBCFList) DeepCopyInto(out *LBCFList) {
+func (in *Etcd) DeepCopyInto(out *Etcd) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]LBCF, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalEtcd)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalEtcd)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFList.
-func (in *LBCFList) DeepCopy() *LBCFList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Etcd.
+func (in *Etcd) DeepCopy() *Etcd {
 	if in == nil {
 		return nil
 	}
-	out := new(LBCFList)
+	out := new(Etcd)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LBCFList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LBCFProxyOptions) DeepCopyInto(out *LBCFProxyOptions) {
+func (in *EtcdBackup) DeepCopyInto(out *EtcdBackup) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Storage)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFProxyOptions.
-func (in *LBCFProxyOptions) DeepCopy() *LBCFProxyOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackup.
+func (in *EtcdBackup) DeepCopy() *EtcdBackup {
 	if in == nil {
 		return nil
 	}
-	out := new(LBCFProxyOptions)
+	out := new(EtcdBackup)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LBCFProxyOptions) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LBCFSpec) DeepCopyInto(out *LBCFSpec) {
+func (in *EtcdMaintenance) DeepCopyInto(out *EtcdMaintenance) {
 	*out = *in
+	if in.RunRequestedAt != nil {
+		in, out := &in.RunRequestedAt, &out.RunRequestedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFSpec.
-func (in *LBCFSpec) DeepCopy() *LBCFSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenance.
+func (in *EtcdMaintenance) DeepCopy() *EtcdMaintenance {
 	if in == nil {
 		return nil
 	}
-	out := new(LBCFSpec)
+	out := new(EtcdMaintenance)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LBCFStatus) DeepCopyInto(out *LBCFStatus) {
+func (in *EtcdMaintenanceStatus) DeepCopyInto(out *EtcdMaintenanceStatus) {
 	*out = *in
-	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	in.LastRunTime.DeepCopyInto(&out.LastRunTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFStatus.
-func (in *LBCFStatus) DeepCopy() *LBCFStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdMaintenanceStatus.
+func (in *EtcdMaintenanceStatus) DeepCopy() *EtcdMaintenanceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LBCFStatus)
+	out := new(EtcdMaintenanceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalEtcd) DeepCopyInto(out *LocalEtcd) {
+func (in *EtcdSnapshot) DeepCopyInto(out *EtcdSnapshot) {
 	*out = *in
-	if in.ExtraArgs != nil {
-		in, out := &in.ExtraArgs, &out.ExtraArgs
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshot.
+func (in *EtcdSnapshot) DeepCopy() *EtcdSnapshot {
+	if in == nil {
+		return nil
 	}
-	if in.ServerCertSANs != nil {
-		in, out := &in.ServerCertSANs, &out.ServerCertSANs
+	out := new(EtcdSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalAuthzWebhookAddr) DeepCopyInto(out *ExternalAuthzWebhookAddr) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalAuthzWebhookAddr.
+func (in *ExternalAuthzWebhookAddr) DeepCopy() *ExternalAuthzWebhookAddr {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalAuthzWebhookAddr)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEtcd) DeepCopyInto(out *ExternalEtcd) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.PeerCertSANs != nil {
-		in, out := &in.PeerCertSANs, &out.PeerCertSANs
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEtcd.
+func (in *ExternalEtcd) DeepCopy() *ExternalEtcd {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEtcd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *File) DeepCopyInto(out *File) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new File.
+func (in *File) DeepCopy() *File {
+	if in == nil {
+		return nil
+	}
+	out := new(File)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingIPPool) DeepCopyInto(out *FloatingIPPool) {
+	*out = *in
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalEtcd.
-func (in *LocalEtcd) DeepCopy() *LocalEtcd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPPool.
+func (in *FloatingIPPool) DeepCopy() *FloatingIPPool {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalEtcd)
+	out := new(FloatingIPPool)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollector) DeepCopyInto(out *LogCollector) {
+func (in *FloatingIPReservation) DeepCopyInto(out *FloatingIPReservation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1676,18 +2365,18 @@ func (in *LogCollector) DeepCopyInto(out *LogCollector) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollector.
-func (in *LogCollector) DeepCopy() *LogCollector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPReservation.
+func (in *FloatingIPReservation) DeepCopy() *FloatingIPReservation {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollector)
+	out := new(FloatingIPReservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LogCollector) DeepCopyObject() runtime.Object {
+func (in *FloatingIPReservation) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1695,13 +2384,13 @@ func (in *LogCollector) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollectorList) DeepCopyInto(out *LogCollectorList) {
+func (in *FloatingIPReservationList) DeepCopyInto(out *FloatingIPReservationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LogCollector, len(*in))
+		*out = make([]FloatingIPReservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1709,18 +2398,18 @@ func (in *LogCollectorList) DeepCopyInto(out *LogCollectorList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorList.
-func (in *LogCollectorList) DeepCopy() *LogCollectorList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPReservationList.
+func (in *FloatingIPReservationList) DeepCopy() *FloatingIPReservationList {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollectorList)
+	out := new(FloatingIPReservationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LogCollectorList) DeepCopyObject() runtime.Object {
+func (in *FloatingIPReservationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1728,133 +2417,155 @@ func (in *LogCollectorList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollectorProxyOptions) DeepCopyInto(out *LogCollectorProxyOptions) {
+func (in *FloatingIPReservationSpec) DeepCopyInto(out *FloatingIPReservationSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorProxyOptions.
-func (in *LogCollectorProxyOptions) DeepCopy() *LogCollectorProxyOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPReservationSpec.
+func (in *FloatingIPReservationSpec) DeepCopy() *FloatingIPReservationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollectorProxyOptions)
+	out := new(FloatingIPReservationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LogCollectorProxyOptions) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollectorSpec) DeepCopyInto(out *LogCollectorSpec) {
+func (in *FloatingIPReservationStatus) DeepCopyInto(out *FloatingIPReservationStatus) {
 	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorSpec.
-func (in *LogCollectorSpec) DeepCopy() *LogCollectorSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPReservationStatus.
+func (in *FloatingIPReservationStatus) DeepCopy() *FloatingIPReservationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollectorSpec)
+	out := new(FloatingIPReservationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogCollectorStatus) DeepCopyInto(out *LogCollectorStatus) {
+func (in *GPUMIGDeviceConfig) DeepCopyInto(out *GPUMIGDeviceConfig) {
 	*out = *in
-	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Slices != nil {
+		in, out := &in.Slices, &out.Slices
+		*out = make([]GPUMIGSlice, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorStatus.
-func (in *LogCollectorStatus) DeepCopy() *LogCollectorStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUMIGDeviceConfig.
+func (in *GPUMIGDeviceConfig) DeepCopy() *GPUMIGDeviceConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(LogCollectorStatus)
+	out := new(GPUMIGDeviceConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Machine) DeepCopyInto(out *Machine) {
+func (in *GPUMIGProfile) DeepCopyInto(out *GPUMIGProfile) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
-func (in *Machine) DeepCopy() *Machine {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUMIGProfile.
+func (in *GPUMIGProfile) DeepCopy() *GPUMIGProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(Machine)
+	out := new(GPUMIGProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Machine) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GPUMIGSlice) DeepCopyInto(out *GPUMIGSlice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUMIGSlice.
+func (in *GPUMIGSlice) DeepCopy() *GPUMIGSlice {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(GPUMIGSlice)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineAddress) DeepCopyInto(out *MachineAddress) {
+func (in *GPUManagerFeature) DeepCopyInto(out *GPUManagerFeature) {
 	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAddress.
-func (in *MachineAddress) DeepCopy() *MachineAddress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUManagerFeature.
+func (in *GPUManagerFeature) DeepCopy() *GPUManagerFeature {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineAddress)
+	out := new(GPUManagerFeature)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineCondition) DeepCopyInto(out *MachineCondition) {
+func (in *GPUNodeConfig) DeepCopyInto(out *GPUNodeConfig) {
 	*out = *in
-	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCondition.
-func (in *MachineCondition) DeepCopy() *MachineCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNodeConfig.
+func (in *GPUNodeConfig) DeepCopy() *GPUNodeConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineCondition)
+	out := new(GPUNodeConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GPUNodeConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineList) DeepCopyInto(out *MachineList) {
+func (in *GPUNodeConfigList) DeepCopyInto(out *GPUNodeConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Machine, len(*in))
+		*out = make([]GPUNodeConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1862,18 +2573,18 @@ func (in *MachineList) DeepCopyInto(out *MachineList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineList.
-func (in *MachineList) DeepCopy() *MachineList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNodeConfigList.
+func (in *GPUNodeConfigList) DeepCopy() *GPUNodeConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineList)
+	out := new(GPUNodeConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachineList) DeepCopyObject() runtime.Object {
+func (in *GPUNodeConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1881,38 +2592,11 @@ func (in *MachineList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+func (in *GPUNodeConfigSpec) DeepCopyInto(out *GPUNodeConfigSpec) {
 	*out = *in
-	if in.Finalizers != nil {
-		in, out := &in.Finalizers, &out.Finalizers
-		*out = make([]FinalizerName, len(*in))
-		copy(*out, *in)
-	}
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.PrivateKey != nil {
-		in, out := &in.PrivateKey, &out.PrivateKey
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.PassPhrase != nil {
-		in, out := &in.PassPhrase, &out.PassPhrase
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]v1.Taint, len(*in))
+	if in.MIGDevices != nil {
+		in, out := &in.MIGDevices, &out.MIGDevices
+		*out = make([]GPUMIGDeviceConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1920,139 +2604,142 @@ func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
-func (in *MachineSpec) DeepCopy() *MachineSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNodeConfigSpec.
+func (in *GPUNodeConfigSpec) DeepCopy() *GPUNodeConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSpec)
+	out := new(GPUNodeConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
+func (in *GPUNodeConfigStatus) DeepCopyInto(out *GPUNodeConfigStatus) {
 	*out = *in
-	if in.Locked != nil {
-		in, out := &in.Locked, &out.Locked
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]MachineCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Addresses != nil {
-		in, out := &in.Addresses, &out.Addresses
-		*out = make([]MachineAddress, len(*in))
+	if in.Profiles != nil {
+		in, out := &in.Profiles, &out.Profiles
+		*out = make([]GPUMIGProfile, len(*in))
 		copy(*out, *in)
 	}
-	out.MachineInfo = in.MachineInfo
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStatus.
-func (in *MachineStatus) DeepCopy() *MachineStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GPUNodeConfigStatus.
+func (in *GPUNodeConfigStatus) DeepCopy() *GPUNodeConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineStatus)
+	out := new(GPUNodeConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachineSystemInfo) DeepCopyInto(out *MachineSystemInfo) {
+func (in *GalaxyNetwork) DeepCopyInto(out *GalaxyNetwork) {
 	*out = *in
+	if in.FloatingIPPools != nil {
+		in, out := &in.FloatingIPPools, &out.FloatingIPPools
+		*out = make([]FloatingIPPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSystemInfo.
-func (in *MachineSystemInfo) DeepCopy() *MachineSystemInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GalaxyNetwork.
+func (in *GalaxyNetwork) DeepCopy() *GalaxyNetwork {
 	if in == nil {
 		return nil
 	}
-	out := new(MachineSystemInfo)
+	out := new(GalaxyNetwork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PVCRProxyOptions) DeepCopyInto(out *PVCRProxyOptions) {
+func (in *HA) DeepCopyInto(out *HA) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.TKEHA != nil {
+		in, out := &in.TKEHA, &out.TKEHA
+		*out = new(TKEHA)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ThirdPartyHA != nil {
+		in, out := &in.ThirdPartyHA, &out.ThirdPartyHA
+		*out = new(ThirdPartyHA)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCRProxyOptions.
-func (in *PVCRProxyOptions) DeepCopy() *PVCRProxyOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HA.
+func (in *HA) DeepCopy() *HA {
 	if in == nil {
 		return nil
 	}
-	out := new(PVCRProxyOptions)
+	out := new(HA)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PVCRProxyOptions) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentBackEnd) DeepCopyInto(out *PersistentBackEnd) {
+func (in *Helm) DeepCopyInto(out *Helm) {
 	*out = *in
-	if in.CLS != nil {
-		in, out := &in.CLS, &out.CLS
-		*out = new(StorageBackEndCLS)
-		**out = **in
-	}
-	if in.ES != nil {
-		in, out := &in.ES, &out.ES
-		*out = new(StorageBackEndES)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentBackEnd.
-func (in *PersistentBackEnd) DeepCopy() *PersistentBackEnd {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Helm.
+func (in *Helm) DeepCopy() *Helm {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentBackEnd)
+	out := new(Helm)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Helm) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentEvent) DeepCopyInto(out *PersistentEvent) {
+func (in *HelmList) DeepCopyInto(out *HelmList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Helm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEvent.
-func (in *PersistentEvent) DeepCopy() *PersistentEvent {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmList.
+func (in *HelmList) DeepCopy() *HelmList {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentEvent)
+	out := new(HelmList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PersistentEvent) DeepCopyObject() runtime.Object {
+func (in *HelmList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2060,32 +2747,24 @@ func (in *PersistentEvent) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentEventList) DeepCopyInto(out *PersistentEventList) {
+func (in *HelmProxyOptions) DeepCopyInto(out *HelmProxyOptions) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PersistentEvent, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventList.
-func (in *PersistentEventList) DeepCopy() *PersistentEventList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmProxyOptions.
+func (in *HelmProxyOptions) DeepCopy() *HelmProxyOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentEventList)
+	out := new(HelmProxyOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PersistentEventList) DeepCopyObject() runtime.Object {
+func (in *HelmProxyOptions) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2093,61 +2772,60 @@ func (in *PersistentEventList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentEventSpec) DeepCopyInto(out *PersistentEventSpec) {
+func (in *HelmSpec) DeepCopyInto(out *HelmSpec) {
 	*out = *in
-	in.PersistentBackEnd.DeepCopyInto(&out.PersistentBackEnd)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventSpec.
-func (in *PersistentEventSpec) DeepCopy() *PersistentEventSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmSpec.
+func (in *HelmSpec) DeepCopy() *HelmSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentEventSpec)
+	out := new(HelmSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PersistentEventStatus) DeepCopyInto(out *PersistentEventStatus) {
+func (in *HelmStatus) DeepCopyInto(out *HelmStatus) {
 	*out = *in
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventStatus.
-func (in *PersistentEventStatus) DeepCopy() *PersistentEventStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmStatus.
+func (in *HelmStatus) DeepCopy() *HelmStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PersistentEventStatus)
+	out := new(HelmStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Prometheus) DeepCopyInto(out *Prometheus) {
+func (in *IPAM) DeepCopyInto(out *IPAM) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prometheus.
-func (in *Prometheus) DeepCopy() *Prometheus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAM.
+func (in *IPAM) DeepCopy() *IPAM {
 	if in == nil {
 		return nil
 	}
-	out := new(Prometheus)
+	out := new(IPAM)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Prometheus) DeepCopyObject() runtime.Object {
+func (in *IPAM) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2155,13 +2833,13 @@ func (in *Prometheus) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusList) DeepCopyInto(out *PrometheusList) {
+func (in *IPAMList) DeepCopyInto(out *IPAMList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Prometheus, len(*in))
+		*out = make([]IPAM, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2169,18 +2847,18 @@ func (in *PrometheusList) DeepCopyInto(out *PrometheusList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusList.
-func (in *PrometheusList) DeepCopy() *PrometheusList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMList.
+func (in *IPAMList) DeepCopy() *IPAMList {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusList)
+	out := new(IPAMList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PrometheusList) DeepCopyObject() runtime.Object {
+func (in *IPAMList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2188,62 +2866,1967 @@ func (in *PrometheusList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusRemoteAddr) DeepCopyInto(out *PrometheusRemoteAddr) {
+func (in *IPAMProxyOptions) DeepCopyInto(out *IPAMProxyOptions) {
 	*out = *in
-	if in.WriteAddr != nil {
-		in, out := &in.WriteAddr, &out.WriteAddr
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMProxyOptions.
+func (in *IPAMProxyOptions) DeepCopy() *IPAMProxyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMProxyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IPAMProxyOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMSpec) DeepCopyInto(out *IPAMSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMSpec.
+func (in *IPAMSpec) DeepCopy() *IPAMSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPAMStatus) DeepCopyInto(out *IPAMStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPAMStatus.
+func (in *IPAMStatus) DeepCopy() *IPAMStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IPAMStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageAdmission) DeepCopyInto(out *ImageAdmission) {
+	*out = *in
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ReadAddr != nil {
-		in, out := &in.ReadAddr, &out.ReadAddr
+	if in.Projects != nil {
+		in, out := &in.Projects, &out.Projects
+		*out = make([]ProjectImageAdmission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExemptNamespaces != nil {
+		in, out := &in.ExemptNamespaces, &out.ExemptNamespaces
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteAddr.
-func (in *PrometheusRemoteAddr) DeepCopy() *PrometheusRemoteAddr {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageAdmission.
+func (in *ImageAdmission) DeepCopy() *ImageAdmission {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusRemoteAddr)
+	out := new(ImageAdmission)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
+func (in *KMSPlugin) DeepCopyInto(out *KMSPlugin) {
 	*out = *in
-	if in.SubVersion != nil {
-		in, out := &in.SubVersion, &out.SubVersion
+	if in.CacheSize != nil {
+		in, out := &in.CacheSize, &out.CacheSize
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSPlugin.
+func (in *KMSPlugin) DeepCopy() *KMSPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeProxy) DeepCopyInto(out *KubeProxy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeProxy.
+func (in *KubeProxy) DeepCopy() *KubeProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigurationOverrides) DeepCopyInto(out *KubeletConfigurationOverrides) {
+	*out = *in
+	if in.MaxPods != nil {
+		in, out := &in.MaxPods, &out.MaxPods
+		*out = new(int32)
+		**out = **in
+	}
+	if in.EvictionHard != nil {
+		in, out := &in.EvictionHard, &out.EvictionHard
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
 		}
 	}
-	in.RemoteAddress.DeepCopyInto(&out.RemoteAddress)
-	in.Resources.DeepCopyInto(&out.Resources)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
-func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigurationOverrides.
+func (in *KubeletConfigurationOverrides) DeepCopy() *KubeletConfigurationOverrides {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigurationOverrides)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCF) DeepCopyInto(out *LBCF) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCF.
+func (in *LBCF) DeepCopy() *LBCF {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LBCF) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCFHealthCheck) DeepCopyInto(out *LBCFHealthCheck) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFHealthCheck.
+func (in *LBCFHealthCheck) DeepCopy() *LBCFHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCFHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCFList) DeepCopyInto(out *LBCFList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LBCF, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFList.
+func (in *LBCFList) DeepCopy() *LBCFList {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCFList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LBCFList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCFProxyOptions) DeepCopyInto(out *LBCFProxyOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFProxyOptions.
+func (in *LBCFProxyOptions) DeepCopy() *LBCFProxyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCFProxyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LBCFProxyOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCFSpec) DeepCopyInto(out *LBCFSpec) {
+	*out = *in
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(LBCFHealthCheck)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFSpec.
+func (in *LBCFSpec) DeepCopy() *LBCFSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCFSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LBCFStatus) DeepCopyInto(out *LBCFStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LBCFStatus.
+func (in *LBCFStatus) DeepCopy() *LBCFStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LBCFStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *License) DeepCopyInto(out *License) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new License.
+func (in *License) DeepCopy() *License {
+	if in == nil {
+		return nil
+	}
+	out := new(License)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *License) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseList) DeepCopyInto(out *LicenseList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]License, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseList.
+func (in *LicenseList) DeepCopy() *LicenseList {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LicenseList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseNotify) DeepCopyInto(out *LicenseNotify) {
+	*out = *in
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReceiverGroups != nil {
+		in, out := &in.ReceiverGroups, &out.ReceiverGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseNotify.
+func (in *LicenseNotify) DeepCopy() *LicenseNotify {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseNotify)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseSpec) DeepCopyInto(out *LicenseSpec) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.NotBefore.DeepCopyInto(&out.NotBefore)
+	in.NotAfter.DeepCopyInto(&out.NotAfter)
+	if in.Notify != nil {
+		in, out := &in.Notify, &out.Notify
+		*out = new(LicenseNotify)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseSpec.
+func (in *LicenseSpec) DeepCopy() *LicenseSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LicenseStatus) DeepCopyInto(out *LicenseStatus) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastWarningTime.DeepCopyInto(&out.LastWarningTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LicenseStatus.
+func (in *LicenseStatus) DeepCopy() *LicenseStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LicenseStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalEtcd) DeepCopyInto(out *LocalEtcd) {
+	*out = *in
+	if in.ExtraArgs != nil {
+		in, out := &in.ExtraArgs, &out.ExtraArgs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ServerCertSANs != nil {
+		in, out := &in.ServerCertSANs, &out.ServerCertSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PeerCertSANs != nil {
+		in, out := &in.PeerCertSANs, &out.PeerCertSANs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QuotaBackendBytes != nil {
+		in, out := &in.QuotaBackendBytes, &out.QuotaBackendBytes
+		*out = new(int64)
+		**out = **in
+	}
+	if in.HeartbeatInterval != nil {
+		in, out := &in.HeartbeatInterval, &out.HeartbeatInterval
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ElectionTimeout != nil {
+		in, out := &in.ElectionTimeout, &out.ElectionTimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(EtcdMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalEtcd.
+func (in *LocalEtcd) DeepCopy() *LocalEtcd {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalEtcd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollector) DeepCopyInto(out *LogCollector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollector.
+func (in *LogCollector) DeepCopy() *LogCollector {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogCollector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectorList) DeepCopyInto(out *LogCollectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogCollector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorList.
+func (in *LogCollectorList) DeepCopy() *LogCollectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogCollectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectorProxyOptions) DeepCopyInto(out *LogCollectorProxyOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorProxyOptions.
+func (in *LogCollectorProxyOptions) DeepCopy() *LogCollectorProxyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectorProxyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogCollectorProxyOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectorSpec) DeepCopyInto(out *LogCollectorSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorSpec.
+func (in *LogCollectorSpec) DeepCopy() *LogCollectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogCollectorStatus) DeepCopyInto(out *LogCollectorStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogCollectorStatus.
+func (in *LogCollectorStatus) DeepCopy() *LogCollectorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LogCollectorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Machine) DeepCopyInto(out *Machine) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Machine.
+func (in *Machine) DeepCopy() *Machine {
+	if in == nil {
+		return nil
+	}
+	out := new(Machine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Machine) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineAddress) DeepCopyInto(out *MachineAddress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineAddress.
+func (in *MachineAddress) DeepCopy() *MachineAddress {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineAddress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineCondition) DeepCopyInto(out *MachineCondition) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineCondition.
+func (in *MachineCondition) DeepCopy() *MachineCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineList) DeepCopyInto(out *MachineList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Machine, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineList.
+func (in *MachineList) DeepCopy() *MachineList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachineList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSpec) DeepCopyInto(out *MachineSpec) {
+	*out = *in
+	if in.Finalizers != nil {
+		in, out := &in.Finalizers, &out.Finalizers
+		*out = make([]FinalizerName, len(*in))
+		copy(*out, *in)
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrivateKey != nil {
+		in, out := &in.PrivateKey, &out.PrivateKey
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.PassPhrase != nil {
+		in, out := &in.PassPhrase, &out.PassPhrase
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]v1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.KubeletConfiguration != nil {
+		in, out := &in.KubeletConfiguration, &out.KubeletConfiguration
+		*out = new(KubeletConfigurationOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceOverrides != nil {
+		in, out := &in.ServiceOverrides, &out.ServiceOverrides
+		*out = new(ServiceOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSpec.
+func (in *MachineSpec) DeepCopy() *MachineSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineStatus) DeepCopyInto(out *MachineStatus) {
+	*out = *in
+	if in.Locked != nil {
+		in, out := &in.Locked, &out.Locked
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]MachineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]MachineAddress, len(*in))
+		copy(*out, *in)
+	}
+	out.MachineInfo = in.MachineInfo
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(Progress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeBackup != nil {
+		in, out := &in.UpgradeBackup, &out.UpgradeBackup
+		*out = new(MachineUpgradeBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineStatus.
+func (in *MachineStatus) DeepCopy() *MachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineSystemInfo) DeepCopyInto(out *MachineSystemInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineSystemInfo.
+func (in *MachineSystemInfo) DeepCopy() *MachineSystemInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineSystemInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineUpgradeBackup) DeepCopyInto(out *MachineUpgradeBackup) {
+	*out = *in
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CreationTime.DeepCopyInto(&out.CreationTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineUpgradeBackup.
+func (in *MachineUpgradeBackup) DeepCopy() *MachineUpgradeBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineUpgradeBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLB) DeepCopyInto(out *MetalLB) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLB.
+func (in *MetalLB) DeepCopy() *MetalLB {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLB)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetalLB) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBAddressPool) DeepCopyInto(out *MetalLBAddressPool) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AutoAssign != nil {
+		in, out := &in.AutoAssign, &out.AutoAssign
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBAddressPool.
+func (in *MetalLBAddressPool) DeepCopy() *MetalLBAddressPool {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBAddressPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBList) DeepCopyInto(out *MetalLBList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MetalLB, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBList.
+func (in *MetalLBList) DeepCopy() *MetalLBList {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MetalLBList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBPeer) DeepCopyInto(out *MetalLBPeer) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBPeer.
+func (in *MetalLBPeer) DeepCopy() *MetalLBPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBSpec) DeepCopyInto(out *MetalLBSpec) {
+	*out = *in
+	if in.AddressPools != nil {
+		in, out := &in.AddressPools, &out.AddressPools
+		*out = make([]MetalLBAddressPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]MetalLBPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBSpec.
+func (in *MetalLBSpec) DeepCopy() *MetalLBSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetalLBStatus) DeepCopyInto(out *MetalLBStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AddonCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetalLBStatus.
+func (in *MetalLBStatus) DeepCopy() *MetalLBStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MetalLBStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Multus) DeepCopyInto(out *Multus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Multus.
+func (in *Multus) DeepCopy() *Multus {
+	if in == nil {
+		return nil
+	}
+	out := new(Multus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Multus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusIPAM) DeepCopyInto(out *MultusIPAM) {
+	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusIPAM.
+func (in *MultusIPAM) DeepCopy() *MultusIPAM {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusIPAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusList) DeepCopyInto(out *MultusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Multus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusList.
+func (in *MultusList) DeepCopy() *MultusList {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MultusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusNetworkAttachment) DeepCopyInto(out *MultusNetworkAttachment) {
+	*out = *in
+	if in.IPAM != nil {
+		in, out := &in.IPAM, &out.IPAM
+		*out = new(MultusIPAM)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusNetworkAttachment.
+func (in *MultusNetworkAttachment) DeepCopy() *MultusNetworkAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusNetworkAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusSRIOV) DeepCopyInto(out *MultusSRIOV) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]MultusSRIOVResource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusSRIOV.
+func (in *MultusSRIOV) DeepCopy() *MultusSRIOV {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusSRIOV)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusSRIOVResource) DeepCopyInto(out *MultusSRIOVResource) {
+	*out = *in
+	if in.Vendors != nil {
+		in, out := &in.Vendors, &out.Vendors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Drivers != nil {
+		in, out := &in.Drivers, &out.Drivers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PFNames != nil {
+		in, out := &in.PFNames, &out.PFNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusSRIOVResource.
+func (in *MultusSRIOVResource) DeepCopy() *MultusSRIOVResource {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusSRIOVResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusSpec) DeepCopyInto(out *MultusSpec) {
+	*out = *in
+	if in.SRIOV != nil {
+		in, out := &in.SRIOV, &out.SRIOV
+		*out = new(MultusSRIOV)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkAttachments != nil {
+		in, out := &in.NetworkAttachments, &out.NetworkAttachments
+		*out = make([]MultusNetworkAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusSpec.
+func (in *MultusSpec) DeepCopy() *MultusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultusStatus) DeepCopyInto(out *MultusStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AddonCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultusStatus.
+func (in *MultusStatus) DeepCopy() *MultusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MultusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkEncryption) DeepCopyInto(out *NetworkEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkEncryption.
+func (in *NetworkEncryption) DeepCopy() *NetworkEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyFeature) DeepCopyInto(out *NetworkPolicyFeature) {
+	*out = *in
+	if in.DefaultDenyNamespaces != nil {
+		in, out := &in.DefaultDenyNamespaces, &out.DefaultDenyNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyFeature.
+func (in *NetworkPolicyFeature) DeepCopy() *NetworkPolicyFeature {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyFeature)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicyStatus) DeepCopyInto(out *NetworkPolicyStatus) {
+	*out = *in
+	in.LastProbeTime.DeepCopyInto(&out.LastProbeTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicyStatus.
+func (in *NetworkPolicyStatus) DeepCopy() *NetworkPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PVCRProxyOptions) DeepCopyInto(out *PVCRProxyOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PVCRProxyOptions.
+func (in *PVCRProxyOptions) DeepCopy() *PVCRProxyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(PVCRProxyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PVCRProxyOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentBackEnd) DeepCopyInto(out *PersistentBackEnd) {
+	*out = *in
+	if in.CLS != nil {
+		in, out := &in.CLS, &out.CLS
+		*out = new(StorageBackEndCLS)
+		**out = **in
+	}
+	if in.ES != nil {
+		in, out := &in.ES, &out.ES
+		*out = new(StorageBackEndES)
+		**out = **in
+	}
+	if in.Kafka != nil {
+		in, out := &in.Kafka, &out.Kafka
+		*out = new(StorageBackEndKafka)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loki != nil {
+		in, out := &in.Loki, &out.Loki
+		*out = new(StorageBackEndLoki)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentBackEnd.
+func (in *PersistentBackEnd) DeepCopy() *PersistentBackEnd {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentBackEnd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentEvent) DeepCopyInto(out *PersistentEvent) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEvent.
+func (in *PersistentEvent) DeepCopy() *PersistentEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentEvent) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentEventBuffer) DeepCopyInto(out *PersistentEventBuffer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventBuffer.
+func (in *PersistentEventBuffer) DeepCopy() *PersistentEventBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentEventBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentEventList) DeepCopyInto(out *PersistentEventList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PersistentEvent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventList.
+func (in *PersistentEventList) DeepCopy() *PersistentEventList {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentEventList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PersistentEventList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentEventSpec) DeepCopyInto(out *PersistentEventSpec) {
+	*out = *in
+	in.PersistentBackEnd.DeepCopyInto(&out.PersistentBackEnd)
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(PersistentEventBuffer)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventSpec.
+func (in *PersistentEventSpec) DeepCopy() *PersistentEventSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentEventSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentEventStatus) DeepCopyInto(out *PersistentEventStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentEventStatus.
+func (in *PersistentEventStatus) DeepCopy() *PersistentEventStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentEventStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PersistentVolumeClaimUsage) DeepCopyInto(out *PersistentVolumeClaimUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PersistentVolumeClaimUsage.
+func (in *PersistentVolumeClaimUsage) DeepCopy() *PersistentVolumeClaimUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(PersistentVolumeClaimUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhaseProgress) DeepCopyInto(out *PhaseProgress) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	in.EndTime.DeepCopyInto(&out.EndTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseProgress.
+func (in *PhaseProgress) DeepCopy() *PhaseProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(PhaseProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Progress) DeepCopyInto(out *Progress) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]PhaseProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Progress.
+func (in *Progress) DeepCopy() *Progress {
+	if in == nil {
+		return nil
+	}
+	out := new(Progress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectImageAdmission) DeepCopyInto(out *ProjectImageAdmission) {
+	*out = *in
+	if in.AllowedRegistries != nil {
+		in, out := &in.AllowedRegistries, &out.AllowedRegistries
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectImageAdmission.
+func (in *ProjectImageAdmission) DeepCopy() *ProjectImageAdmission {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectImageAdmission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Prometheus) DeepCopyInto(out *Prometheus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Prometheus.
+func (in *Prometheus) DeepCopy() *Prometheus {
+	if in == nil {
+		return nil
+	}
+	out := new(Prometheus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Prometheus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusBasicAuth) DeepCopyInto(out *PrometheusBasicAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusBasicAuth.
+func (in *PrometheusBasicAuth) DeepCopy() *PrometheusBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusList) DeepCopyInto(out *PrometheusList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Prometheus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusList.
+func (in *PrometheusList) DeepCopy() *PrometheusList {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PrometheusList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteAddr) DeepCopyInto(out *PrometheusRemoteAddr) {
+	*out = *in
+	if in.WriteAddr != nil {
+		in, out := &in.WriteAddr, &out.WriteAddr
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ReadAddr != nil {
+		in, out := &in.ReadAddr, &out.ReadAddr
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteAddr.
+func (in *PrometheusRemoteAddr) DeepCopy() *PrometheusRemoteAddr {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteAddr)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusRemoteWrite) DeepCopyInto(out *PrometheusRemoteWrite) {
+	*out = *in
+	if in.BasicAuth != nil {
+		in, out := &in.BasicAuth, &out.BasicAuth
+		*out = new(PrometheusBasicAuth)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(PrometheusTLSConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusRemoteWrite.
+func (in *PrometheusRemoteWrite) DeepCopy() *PrometheusRemoteWrite {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusRemoteWrite)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusSpec) DeepCopyInto(out *PrometheusSpec) {
+	*out = *in
+	if in.SubVersion != nil {
+		in, out := &in.SubVersion, &out.SubVersion
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.RemoteAddress.DeepCopyInto(&out.RemoteAddress)
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.RemoteWrites != nil {
+		in, out := &in.RemoteWrites, &out.RemoteWrites
+		*out = make([]PrometheusRemoteWrite, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExternalLabels != nil {
+		in, out := &in.ExternalLabels, &out.ExternalLabels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusSpec.
+func (in *PrometheusSpec) DeepCopy() *PrometheusSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
+	*out = *in
+	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.SubVersion != nil {
+		in, out := &in.SubVersion, &out.SubVersion
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStatus.
+func (in *PrometheusStatus) DeepCopy() *PrometheusStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrometheusTLSConfig) DeepCopyInto(out *PrometheusTLSConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusTLSConfig.
+func (in *PrometheusTLSConfig) DeepCopy() *PrometheusTLSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PrometheusTLSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Registry) DeepCopyInto(out *Registry) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registry.
+func (in *Registry) DeepCopy() *Registry {
+	if in == nil {
+		return nil
+	}
+	out := new(Registry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Registry) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryAuth) DeepCopyInto(out *RegistryAuth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryAuth.
+func (in *RegistryAuth) DeepCopy() *RegistryAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryList) DeepCopyInto(out *RegistryList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Registry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryList.
+func (in *RegistryList) DeepCopy() *RegistryList {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RegistryList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
+	*out = *in
+	if in.UserName != nil {
+		in, out := &in.UserName, &out.UserName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
+func (in *RegistrySpec) DeepCopy() *RegistrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ResourceList) DeepCopyInto(out *ResourceList) {
+	{
+		in := &in
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceList.
+func (in ResourceList) DeepCopy() ResourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = make(ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
+func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceRequirements)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Storage) DeepCopyInto(out *S3Storage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Storage.
+func (in *S3Storage) DeepCopy() *S3Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(S3Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SandboxRuntime) DeepCopyInto(out *SandboxRuntime) {
+	*out = *in
+	if in.UntrustedNamespaces != nil {
+		in, out := &in.UntrustedNamespaces, &out.UntrustedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SandboxRuntime.
+func (in *SandboxRuntime) DeepCopy() *SandboxRuntime {
+	if in == nil {
+		return nil
+	}
+	out := new(SandboxRuntime)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretsEncryption) DeepCopyInto(out *SecretsEncryption) {
+	*out = *in
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSPlugin)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretsEncryption.
+func (in *SecretsEncryption) DeepCopy() *SecretsEncryption {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusSpec)
+	out := new(SecretsEncryption)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
+func (in *ServiceOverride) DeepCopyInto(out *ServiceOverride) {
 	*out = *in
-	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
-	if in.SubVersion != nil {
-		in, out := &in.SubVersion, &out.SubVersion
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
 		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
 			(*out)[key] = val
@@ -2252,51 +4835,69 @@ func (in *PrometheusStatus) DeepCopyInto(out *PrometheusStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrometheusStatus.
-func (in *PrometheusStatus) DeepCopy() *PrometheusStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverride.
+func (in *ServiceOverride) DeepCopy() *ServiceOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(PrometheusStatus)
+	out := new(ServiceOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Registry) DeepCopyInto(out *Registry) {
+func (in *ServiceOverrides) DeepCopyInto(out *ServiceOverrides) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Kubelet != nil {
+		in, out := &in.Kubelet, &out.Kubelet
+		*out = new(ServiceOverride)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ContainerRuntime != nil {
+		in, out := &in.ContainerRuntime, &out.ContainerRuntime
+		*out = new(ServiceOverride)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Registry.
-func (in *Registry) DeepCopy() *Registry {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceOverrides.
+func (in *ServiceOverrides) DeepCopy() *ServiceOverrides {
 	if in == nil {
 		return nil
 	}
-	out := new(Registry)
+	out := new(ServiceOverrides)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Registry) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistryList) DeepCopyInto(out *RegistryList) {
+func (in *StaticPodOverride) DeepCopyInto(out *StaticPodOverride) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Registry, len(*in))
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraEnv != nil {
+		in, out := &in.ExtraEnv, &out.ExtraEnv
+		*out = make([]corev1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sidecars != nil {
+		in, out := &in.Sidecars, &out.Sidecars
+		*out = make([]corev1.Container, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2304,130 +4905,168 @@ func (in *RegistryList) DeepCopyInto(out *RegistryList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryList.
-func (in *RegistryList) DeepCopy() *RegistryList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StaticPodOverride.
+func (in *StaticPodOverride) DeepCopy() *StaticPodOverride {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistryList)
+	out := new(StaticPodOverride)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RegistryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBackEndCLS) DeepCopyInto(out *StorageBackEndCLS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndCLS.
+func (in *StorageBackEndCLS) DeepCopy() *StorageBackEndCLS {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(StorageBackEndCLS)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
+func (in *StorageBackEndES) DeepCopyInto(out *StorageBackEndES) {
 	*out = *in
-	if in.UserName != nil {
-		in, out := &in.UserName, &out.UserName
-		*out = new(string)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndES.
+func (in *StorageBackEndES) DeepCopy() *StorageBackEndES {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageBackEndES)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBackEndKafka) DeepCopyInto(out *StorageBackEndKafka) {
+	*out = *in
+	if in.Brokers != nil {
+		in, out := &in.Brokers, &out.Brokers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SASL != nil {
+		in, out := &in.SASL, &out.SASL
+		*out = new(StorageBackEndSASL)
 		**out = **in
 	}
-	if in.Password != nil {
-		in, out := &in.Password, &out.Password
-		*out = new(string)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(StorageBackEndTLS)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
-func (in *RegistrySpec) DeepCopy() *RegistrySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndKafka.
+func (in *StorageBackEndKafka) DeepCopy() *StorageBackEndKafka {
 	if in == nil {
 		return nil
 	}
-	out := new(RegistrySpec)
+	out := new(StorageBackEndKafka)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ResourceList) DeepCopyInto(out *ResourceList) {
-	{
-		in := &in
-		*out = make(ResourceList, len(*in))
+func (in *StorageBackEndLoki) DeepCopyInto(out *StorageBackEndLoki) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
+			(*out)[key] = val
 		}
-		return
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(StorageBackEndTLS)
+		**out = **in
+	}
+	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceList.
-func (in ResourceList) DeepCopy() ResourceList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndLoki.
+func (in *StorageBackEndLoki) DeepCopy() *StorageBackEndLoki {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceList)
+	out := new(StorageBackEndLoki)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
+func (in *StorageBackEndSASL) DeepCopyInto(out *StorageBackEndSASL) {
 	*out = *in
-	if in.Limits != nil {
-		in, out := &in.Limits, &out.Limits
-		*out = make(ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
-	if in.Requests != nil {
-		in, out := &in.Requests, &out.Requests
-		*out = make(ResourceList, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val.DeepCopy()
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRequirements.
-func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndSASL.
+func (in *StorageBackEndSASL) DeepCopy() *StorageBackEndSASL {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceRequirements)
+	out := new(StorageBackEndSASL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBackEndCLS) DeepCopyInto(out *StorageBackEndCLS) {
+func (in *StorageBackEndTLS) DeepCopyInto(out *StorageBackEndTLS) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndCLS.
-func (in *StorageBackEndCLS) DeepCopy() *StorageBackEndCLS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndTLS.
+func (in *StorageBackEndTLS) DeepCopy() *StorageBackEndTLS {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackEndCLS)
+	out := new(StorageBackEndTLS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *StorageBackEndES) DeepCopyInto(out *StorageBackEndES) {
+func (in *SystemTuning) DeepCopyInto(out *SystemTuning) {
 	*out = *in
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.KernelModules != nil {
+		in, out := &in.KernelModules, &out.KernelModules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ulimits != nil {
+		in, out := &in.Ulimits, &out.Ulimits
+		*out = make([]Ulimit, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBackEndES.
-func (in *StorageBackEndES) DeepCopy() *StorageBackEndES {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SystemTuning.
+func (in *SystemTuning) DeepCopy() *SystemTuning {
 	if in == nil {
 		return nil
 	}
-	out := new(StorageBackEndES)
+	out := new(SystemTuning)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2458,7 +5097,7 @@ func (in *TappController) DeepCopyInto(out *TappController) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -2481,6 +5120,22 @@ func (in *TappController) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TappControllerConfig) DeepCopyInto(out *TappControllerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TappControllerConfig.
+func (in *TappControllerConfig) DeepCopy() *TappControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TappControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TappControllerList) DeepCopyInto(out *TappControllerList) {
 	*out = *in
@@ -2542,6 +5197,11 @@ func (in *TappControllerProxyOptions) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TappControllerSpec) DeepCopyInto(out *TappControllerSpec) {
 	*out = *in
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(TappControllerConfig)
+		**out = **in
+	}
 	return
 }
 
@@ -2559,6 +5219,20 @@ func (in *TappControllerSpec) DeepCopy() *TappControllerSpec {
 func (in *TappControllerStatus) DeepCopyInto(out *TappControllerStatus) {
 	*out = *in
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.CRDConflicts != nil {
+		in, out := &in.CRDConflicts, &out.CRDConflicts
+		*out = make([]CRDConflict, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AddonCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2588,10 +5262,52 @@ func (in *ThirdPartyHA) DeepCopy() *ThirdPartyHA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TimeSync) DeepCopyInto(out *TimeSync) {
+	*out = *in
+	if in.Servers != nil {
+		in, out := &in.Servers, &out.Servers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeSync.
+func (in *TimeSync) DeepCopy() *TimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(TimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ulimit) DeepCopyInto(out *Ulimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ulimit.
+func (in *Ulimit) DeepCopy() *Ulimit {
+	if in == nil {
+		return nil
+	}
+	out := new(Ulimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Upgrade) DeepCopyInto(out *Upgrade) {
 	*out = *in
 	out.Strategy = in.Strategy
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(UpgradeCanary)
+		**out = **in
+	}
 	return
 }
 
@@ -2605,10 +5321,59 @@ func (in *Upgrade) DeepCopy() *Upgrade {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCanary) DeepCopyInto(out *UpgradeCanary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCanary.
+func (in *UpgradeCanary) DeepCopy() *UpgradeCanary {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCanary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeCanaryStatus) DeepCopyInto(out *UpgradeCanaryStatus) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]ClusterCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeCanaryStatus.
+func (in *UpgradeCanaryStatus) DeepCopy() *UpgradeCanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeCanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpgradeStrategy) DeepCopyInto(out *UpgradeStrategy) {
 	*out = *in
 	out.MaxUnready = in.MaxUnready
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	return
 }
 
@@ -2691,6 +5456,11 @@ func (in *VolumeDecoratorSpec) DeepCopyInto(out *VolumeDecoratorSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(VolumeUsageEnforcement)
+		**out = **in
+	}
 	return
 }
 
@@ -2713,6 +5483,11 @@ func (in *VolumeDecoratorStatus) DeepCopyInto(out *VolumeDecoratorStatus) {
 		copy(*out, *in)
 	}
 	in.LastReInitializingTimestamp.DeepCopyInto(&out.LastReInitializingTimestamp)
+	if in.Usages != nil {
+		in, out := &in.Usages, &out.Usages
+		*out = make([]PersistentVolumeClaimUsage, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2725,3 +5500,19 @@ func (in *VolumeDecoratorStatus) DeepCopy() *VolumeDecoratorStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeUsageEnforcement) DeepCopyInto(out *VolumeUsageEnforcement) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeUsageEnforcement.
+func (in *VolumeUsageEnforcement) DeepCopy() *VolumeUsageEnforcement {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeUsageEnforcement)
+	in.DeepCopyInto(out)
+	return out
+}
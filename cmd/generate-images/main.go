@@ -28,10 +28,14 @@ import (
 	logagent "tkestack.io/tke/pkg/logagent/controller/logagent/images"
 	mesh "tkestack.io/tke/pkg/mesh/controller/meshmanager/images"
 	cronhpa "tkestack.io/tke/pkg/platform/controller/addon/cronhpa/images"
+	deviceplugin "tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	egressgateway "tkestack.io/tke/pkg/platform/controller/addon/egressgateway/images"
 	helm "tkestack.io/tke/pkg/platform/controller/addon/helm/images"
 	ipam "tkestack.io/tke/pkg/platform/controller/addon/ipam/images"
 	lbcf "tkestack.io/tke/pkg/platform/controller/addon/lbcf/images"
 	logcollector "tkestack.io/tke/pkg/platform/controller/addon/logcollector/images"
+	metallb "tkestack.io/tke/pkg/platform/controller/addon/metallb/images"
+	multus "tkestack.io/tke/pkg/platform/controller/addon/multus/images"
 	persistentevent "tkestack.io/tke/pkg/platform/controller/addon/persistentevent/images"
 	prometheus "tkestack.io/tke/pkg/platform/controller/addon/prometheus/images"
 	volumedecorator "tkestack.io/tke/pkg/platform/controller/addon/storage/volumedecorator/images"
@@ -43,7 +47,7 @@ import (
 )
 
 var (
-	specialUnsupportMultiArch = []string{"nvidia-device-plugin", "gpu"}
+	specialUnsupportMultiArch = []string{"nvidia-device-plugin", "k8s-mig-manager", "gpu"}
 )
 
 func main() {
@@ -51,9 +55,13 @@ func main() {
 	pflag.Parse()
 	unsupportMultiArchImages := []func() []string{
 		cronhpa.List,
+		egressgateway.List,
 		helm.List,
 		lbcf.List,
 		logcollector.List,
+		metallb.List,
+		multus.List,
+		deviceplugin.List,
 		persistentevent.List,
 		prometheus.List,
 		csioperator.List,
@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package app implements the kubectl plugin of TKEStack, which works with the
+// kubeconfig of tke-platform-api.
+package app
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// NewCommand creates the kubectl-tke command.
+func NewCommand() *cobra.Command {
+	flags := genericclioptions.NewConfigFlags(true)
+	streams := genericclioptions.IOStreams{In: os.Stdin, Out: os.Stdout, ErrOut: os.Stderr}
+
+	cmd := &cobra.Command{
+		Use:           "kubectl-tke",
+		Short:         "kubectl-tke manages the clusters of TKEStack",
+		SilenceUsage:  true,
+		SilenceErrors: false,
+	}
+	flags.AddFlags(cmd.PersistentFlags())
+	cmd.AddCommand(newUpgradeCommand(flags, streams))
+	cmd.AddCommand(newSOSReportCommand(flags, streams))
+	cmd.AddCommand(newNetCheckCommand(flags, streams))
+	cmd.AddCommand(newLogsCommand(flags, streams))
+
+	return cmd
+}
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package app
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+)
+
+type logsOptions struct {
+	flags   *genericclioptions.ConfigFlags
+	streams genericclioptions.IOStreams
+
+	cluster    string
+	pod        string
+	container  string
+	follow     bool
+	previous   bool
+	timestamps bool
+	tail       int64
+	since      time.Duration
+	limitBytes int64
+}
+
+func newLogsCommand(flags *genericclioptions.ConfigFlags, streams genericclioptions.IOStreams) *cobra.Command {
+	o := &logsOptions{flags: flags, streams: streams}
+	cmd := &cobra.Command{
+		Use:     "logs CLUSTER POD [-c CONTAINER]",
+		Short:   "Print the logs of a container of the cluster through the platform api",
+		Example: "  kubectl tke logs cls-xxx nginx-7d8b49557c-8x2rw -n default -f --tail 100",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.cluster = args[0]
+			o.pod = args[1]
+			return o.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVarP(&o.container, "container", "c", "", "Print the logs of this container.")
+	cmd.Flags().BoolVarP(&o.follow, "follow", "f", false, "Specify if the logs should be streamed.")
+	cmd.Flags().BoolVarP(&o.previous, "previous", "p", false, "Print the logs of the previous terminated container.")
+	cmd.Flags().BoolVar(&o.timestamps, "timestamps", false, "Include timestamps on each line in the log output.")
+	cmd.Flags().Int64Var(&o.tail, "tail", 0, "Lines of recent log file to display, all lines are displayed if not specified.")
+	cmd.Flags().DurationVar(&o.since, "since", 0, "Only return logs newer than a relative duration like 5s, 2m, or 3h.")
+	cmd.Flags().Int64Var(&o.limitBytes, "limit-bytes", 0, "Maximum bytes of logs to return, limited by the platform api.")
+
+	return cmd
+}
+
+func (o *logsOptions) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	namespace, _, err := o.flags.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return err
+	}
+	config, err := o.flags.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	client, err := platformv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	req := client.RESTClient().Get().
+		Resource("clusters").
+		Name(o.cluster).
+		SubResource("logs").
+		Param("namespace", namespace).
+		Param("pod", o.pod).
+		Param("follow", strconv.FormatBool(o.follow)).
+		Param("previous", strconv.FormatBool(o.previous)).
+		Param("timestamps", strconv.FormatBool(o.timestamps))
+	if o.container != "" {
+		req = req.Param("container", o.container)
+	}
+	if o.tail > 0 {
+		req = req.Param("tailLines", strconv.FormatInt(o.tail, 10))
+	}
+	if o.since > 0 {
+		req = req.Param("sinceSeconds", strconv.FormatInt(int64(o.since.Seconds()), 10))
+	}
+	if o.limitBytes > 0 {
+		req = req.Param("limitBytes", strconv.FormatInt(o.limitBytes, 10))
+	}
+
+	stream, err := req.Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	_, err = io.Copy(o.streams.Out, stream)
+	return err
+}
@@ -0,0 +1,159 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/pkg/platform/util/netcheck"
+)
+
+type netCheckOptions struct {
+	flags   *genericclioptions.ConfigFlags
+	streams genericclioptions.IOStreams
+
+	cluster string
+	image   string
+	timeout time.Duration
+	output  string
+}
+
+func newNetCheckCommand(flags *genericclioptions.ConfigFlags, streams genericclioptions.IOStreams) *cobra.Command {
+	o := &netCheckOptions{flags: flags, streams: streams}
+	cmd := &cobra.Command{
+		Use:     "netcheck CLUSTER",
+		Short:   "Check the node-to-node, pod-to-pod, pod-to-service and DNS connectivity of the cluster",
+		Example: "  kubectl tke netcheck cls-xxx",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.cluster = args[0]
+			return o.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVar(&o.image, "image", "", "The image of probe pods, which must have sh, httpd, wget, ping and nslookup.")
+	cmd.Flags().DurationVar(&o.timeout, "timeout", netcheck.DefaultTimeout, "The timeout of waiting for probe pods ready.")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "Output format, only json is supported.")
+
+	return cmd
+}
+
+func (o *netCheckOptions) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	config, err := o.flags.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	client, err := platformv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	req := client.RESTClient().Get().
+		Resource("clusters").
+		Name(o.cluster).
+		SubResource("netcheck").
+		Param("timeout", strconv.Itoa(int(o.timeout.Seconds())))
+	if o.image != "" {
+		req = req.Param("image", o.image)
+	}
+	data, err := req.DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	report := &netcheck.Report{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return err
+	}
+	if o.output == "json" {
+		_, err = o.streams.Out.Write(data)
+	} else {
+		err = o.print(report)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(report.Failures()) > 0 {
+		return fmt.Errorf("%s", report.Summary())
+	}
+	return nil
+}
+
+// print prints a matrix of source nodes and targets for each check.
+func (o *netCheckOptions) print(report *netcheck.Report) error {
+	w := tabwriter.NewWriter(o.streams.Out, 0, 4, 2, ' ', 0)
+	for i, check := range netcheck.Checks {
+		matrix := report.Matrix(check)
+		var targets []string
+		if check == netcheck.CheckNodeToNode || check == netcheck.CheckPodToPod {
+			targets = report.Nodes
+		} else {
+			seen := map[string]bool{}
+			for _, one := range report.Results {
+				if one.Check == check && !seen[one.To] {
+					seen[one.To] = true
+					targets = append(targets, one.To)
+				}
+			}
+		}
+
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s\t%s\n", strings.ToUpper(check), strings.Join(targets, "\t"))
+		for _, from := range report.Nodes {
+			var cells []string
+			for _, to := range targets {
+				cells = append(cells, cell(matrix[from][to]))
+			}
+			fmt.Fprintf(w, "%s\t%s\n", from, strings.Join(cells, "\t"))
+		}
+	}
+
+	if failures := report.Failures(); len(failures) > 0 {
+		fmt.Fprintf(w, "\nFailures:\n")
+		for _, one := range failures {
+			fmt.Fprintf(w, "  - %s %s -> %s: %s\n", one.Check, one.From, one.To, one.Message)
+		}
+	}
+
+	return w.Flush()
+}
+
+func cell(result *netcheck.Result) string {
+	switch {
+	case result == nil:
+		return "-"
+	case result.Success:
+		return "ok"
+	default:
+		return "FAIL"
+	}
+}
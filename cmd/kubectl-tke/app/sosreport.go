@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+)
+
+type sosreportOptions struct {
+	flags   *genericclioptions.ConfigFlags
+	streams genericclioptions.IOStreams
+
+	name    string
+	cluster string
+	since   time.Duration
+	file    string
+}
+
+func newSOSReportCommand(flags *genericclioptions.ConfigFlags, streams genericclioptions.IOStreams) *cobra.Command {
+	o := &sosreportOptions{flags: flags, streams: streams}
+	cmd := &cobra.Command{
+		Use:   "sosreport MACHINE | sosreport MASTER_IP --cluster CLUSTER",
+		Short: "Collect the journals, network state and key config files of a node over SSH",
+		Example: "  kubectl tke sosreport mc-xxx --since 2h\n" +
+			"  kubectl tke sosreport 10.0.0.1 --cluster cls-xxx -f master.tar.gz",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.name = args[0]
+			return o.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVar(&o.cluster, "cluster", "", "The cluster of the master machine, the argument is the ip of master if specified.")
+	cmd.Flags().DurationVar(&o.since, "since", 24*time.Hour, "The time range of the collected journals.")
+	cmd.Flags().StringVarP(&o.file, "file", "f", "", "The file to save the report, defaults to sosreport-NAME-TIMESTAMP.tar.gz.")
+
+	return cmd
+}
+
+func (o *sosreportOptions) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	config, err := o.flags.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	// collecting the report may take a while on a busy node
+	config.Timeout = 5 * time.Minute
+	client, err := platformv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	req := client.RESTClient().Get().Param("since", o.since.String())
+	if o.cluster != "" {
+		req = req.Resource("clusters").Name(o.cluster).SubResource("sosreport", o.name)
+	} else {
+		req = req.Resource("machines").Name(o.name).SubResource("sosreport")
+	}
+	data, err := req.DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+
+	file := o.file
+	if file == "" {
+		file = fmt.Sprintf("sosreport-%s-%s.tar.gz", o.name, time.Now().Format("20060102150405"))
+	}
+	if err := ioutil.WriteFile(file, data, 0600); err != nil {
+		return err
+	}
+	fmt.Fprintf(o.streams.Out, "Report of %s saved to %s\n", o.name, file)
+	return nil
+}
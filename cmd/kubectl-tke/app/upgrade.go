@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+)
+
+type upgradePlanOptions struct {
+	flags   *genericclioptions.ConfigFlags
+	streams genericclioptions.IOStreams
+
+	cluster string
+	version string
+	output  string
+}
+
+// upgradePlan is the upgrade plan returned by the upgradeplan subresource of cluster.
+type upgradePlan struct {
+	Cluster        string   `json:"cluster"`
+	CurrentVersion string   `json:"currentVersion"`
+	TargetVersion  string   `json:"targetVersion"`
+	Blockers       []string `json:"blockers"`
+	Components     []struct {
+		Name    string `json:"name"`
+		Current string `json:"current"`
+		Target  string `json:"target"`
+	} `json:"components"`
+	Batches []struct {
+		Role  string   `json:"role"`
+		Nodes []string `json:"nodes"`
+		Note  string   `json:"note"`
+	} `json:"batches"`
+	Addons []struct {
+		Name    string `json:"name"`
+		Current string `json:"current"`
+		Target  string `json:"target"`
+	} `json:"addons"`
+	DeprecatedAPIs []struct {
+		Group          string `json:"group"`
+		Version        string `json:"version"`
+		Resource       string `json:"resource"`
+		RemovedRelease string `json:"removedRelease"`
+	} `json:"deprecatedAPIs"`
+}
+
+func newUpgradeCommand(flags *genericclioptions.ConfigFlags, streams genericclioptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade",
+		Short: "Upgrade the clusters",
+	}
+	cmd.AddCommand(newUpgradePlanCommand(flags, streams))
+
+	return cmd
+}
+
+func newUpgradePlanCommand(flags *genericclioptions.ConfigFlags, streams genericclioptions.IOStreams) *cobra.Command {
+	o := &upgradePlanOptions{flags: flags, streams: streams}
+	cmd := &cobra.Command{
+		Use:     "plan CLUSTER --version VERSION",
+		Short:   "Show what changes if the cluster is upgraded to the version, without upgrading it",
+		Example: "  kubectl tke upgrade plan cls-xxx --version 1.20.4",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			o.cluster = args[0]
+			return o.run(cmd.Context())
+		},
+	}
+	cmd.Flags().StringVar(&o.version, "version", "", "The kubernetes version to upgrade to.")
+	cmd.Flags().StringVarP(&o.output, "output", "o", "", "Output format, only json is supported.")
+	_ = cmd.MarkFlagRequired("version")
+
+	return cmd
+}
+
+func (o *upgradePlanOptions) run(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	config, err := o.flags.ToRESTConfig()
+	if err != nil {
+		return err
+	}
+	client, err := platformv1client.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+
+	data, err := client.RESTClient().Get().
+		Resource("clusters").
+		Name(o.cluster).
+		SubResource("upgradeplan").
+		Param("version", o.version).
+		DoRaw(ctx)
+	if err != nil {
+		return err
+	}
+	if o.output == "json" {
+		_, err = o.streams.Out.Write(data)
+		return err
+	}
+
+	plan := &upgradePlan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		return err
+	}
+	return o.print(plan)
+}
+
+func (o *upgradePlanOptions) print(plan *upgradePlan) error {
+	w := tabwriter.NewWriter(o.streams.Out, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Cluster %s: %s -> %s\n", plan.Cluster, plan.CurrentVersion, plan.TargetVersion)
+	if len(plan.Blockers) > 0 {
+		fmt.Fprintf(w, "\nBlockers:\n")
+		for _, blocker := range plan.Blockers {
+			fmt.Fprintf(w, "  - %s\n", blocker)
+		}
+	}
+
+	fmt.Fprintf(w, "\nCOMPONENT\tCURRENT\tTARGET\n")
+	for _, c := range plan.Components {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", c.Name, c.Current, c.Target)
+	}
+
+	fmt.Fprintf(w, "\nBATCH\tROLE\tNODES\tNOTE\n")
+	for i, b := range plan.Batches {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i+1, b.Role, strings.Join(b.Nodes, ","), b.Note)
+	}
+
+	if len(plan.Addons) > 0 {
+		fmt.Fprintf(w, "\nADDON\tCURRENT\tTARGET\n")
+		for _, a := range plan.Addons {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", a.Name, a.Current, a.Target)
+		}
+	}
+
+	if len(plan.DeprecatedAPIs) > 0 {
+		fmt.Fprintf(w, "\nDEPRECATED API\tREMOVED IN\n")
+		for _, api := range plan.DeprecatedAPIs {
+			gv := api.Version
+			if api.Group != "" {
+				gv = api.Group + "/" + api.Version
+			}
+			fmt.Fprintf(w, "%s %s\t%s\n", gv, api.Resource, api.RemovedRelease)
+		}
+	}
+
+	return w.Flush()
+}
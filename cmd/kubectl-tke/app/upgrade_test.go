@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package app
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func TestUpgradePlanPrint(t *testing.T) {
+	data := []byte(`{
+		"cluster": "cls-a",
+		"currentVersion": "1.19.7",
+		"targetVersion": "1.20.4",
+		"blockers": ["cluster phase is Upgrading"],
+		"components": [{"name": "kubelet", "current": "v1.19.7", "target": "v1.20.4"}],
+		"batches": [
+			{"role": "master", "nodes": ["10.0.0.1"]},
+			{"role": "worker", "nodes": ["10.0.1.1", "10.0.1.2"]}
+		],
+		"addons": [{"name": "CronHPA/cronhpa", "current": "v1.0.0", "target": "v1.0.1"}],
+		"deprecatedAPIs": [
+			{"group": "extensions", "version": "v1beta1", "resource": "ingresses", "removedRelease": "1.22"},
+			{"version": "v1beta1", "resource": "events", "removedRelease": "1.25"}
+		]
+	}`)
+	plan := &upgradePlan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		t.Fatal(err)
+	}
+	streams, _, out, _ := genericclioptions.NewTestIOStreams()
+	o := &upgradePlanOptions{streams: streams}
+	if err := o.print(plan); err != nil {
+		t.Fatal(err)
+	}
+
+	var lines [][]string
+	for _, line := range strings.Split(out.String(), "\n") {
+		lines = append(lines, strings.Fields(line))
+	}
+	for _, want := range [][]string{
+		{"Cluster", "cls-a:", "1.19.7", "->", "1.20.4"},
+		{"-", "cluster", "phase", "is", "Upgrading"},
+		{"kubelet", "v1.19.7", "v1.20.4"},
+		{"1", "master", "10.0.0.1"},
+		{"2", "worker", "10.0.1.1,10.0.1.2"},
+		{"CronHPA/cronhpa", "v1.0.0", "v1.0.1"},
+		{"extensions/v1beta1", "ingresses", "1.22"},
+		{"v1beta1", "events", "1.25"},
+	} {
+		found := false
+		for _, line := range lines {
+			if reflect.DeepEqual(line, want) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected line %q in output:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestUpgradePlanPrintWithoutOptionalSections(t *testing.T) {
+	streams, _, out, _ := genericclioptions.NewTestIOStreams()
+	o := &upgradePlanOptions{streams: streams}
+	if err := o.print(&upgradePlan{Cluster: "cls-a"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, section := range []string{"Blockers:", "ADDON", "DEPRECATED API"} {
+		if strings.Contains(out.String(), section) {
+			t.Errorf("unexpected section %q in output:\n%s", section, out.String())
+		}
+	}
+}
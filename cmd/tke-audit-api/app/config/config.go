@@ -23,7 +23,12 @@ import (
 	"path/filepath"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/common"
+	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	generatedopenapi "tkestack.io/tke/api/openapi"
 	"tkestack.io/tke/cmd/tke-audit-api/app/options"
 	"tkestack.io/tke/pkg/apiserver"
@@ -36,6 +41,7 @@ import (
 	"tkestack.io/tke/pkg/audit/apis/config/validation"
 	"tkestack.io/tke/pkg/audit/config/configfiles"
 	auditopenapi "tkestack.io/tke/pkg/audit/openapi"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	utilfs "tkestack.io/tke/pkg/util/filesystem"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -50,6 +56,9 @@ type Config struct {
 	ServerName             string
 	GenericAPIServerConfig *genericapiserver.Config
 	AuditConfig            *auditconfig.AuditConfiguration
+	NotifyClient           notifyversionedclient.NotifyV1Interface
+	BusinessClient         businessversionedclient.BusinessV1Interface
+	AuthClient             authversionedclient.AuthV1Interface
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -77,7 +86,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	genericAPIServerConfig := genericapiserver.NewConfig(apiserver.Codecs)
 	var ignoredAuthPathPrefixes []string
 	ignoredAuthPathPrefixes = append(ignoredAuthPathPrefixes, audit.IgnoredAuthPathPrefixes()...)
-	genericAPIServerConfig.BuildHandlerChainFunc = handler.BuildHandlerChain(ignoredAuthPathPrefixes, nil, nil)
+	genericAPIServerConfig.BuildHandlerChainFunc = handler.BuildHandlerChain(ignoredAuthPathPrefixes, audit.IgnoredAuthzPathPrefixes(), nil)
 	genericAPIServerConfig.EnableIndex = false
 	genericAPIServerConfig.EnableDiscovery = false
 	genericAPIServerConfig.EnableProfiling = false
@@ -110,10 +119,66 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
+	// client config for notify apiserver, the alerts are disabled without it
+	notifyAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && opts.NotifyAPIClient.Required {
+		return nil, fmt.Errorf("failed to initialize client config of notify API server")
+	}
+	var notifyClientV1 notifyversionedclient.NotifyV1Interface
+	if ok {
+		notifyClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(notifyAPIServerClientConfig, "tke-audit-api"))
+		if err != nil {
+			return nil, err
+		}
+		notifyClientV1 = notifyClient.NotifyV1()
+	}
+
+	// client config for business apiserver, the queries of the projects are
+	// disabled without it
+	businessAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.BusinessAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && opts.BusinessAPIClient.Required {
+		return nil, fmt.Errorf("failed to initialize client config of business API server")
+	}
+	var businessClientV1 businessversionedclient.BusinessV1Interface
+	if ok {
+		businessClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(businessAPIServerClientConfig, "tke-audit-api"))
+		if err != nil {
+			return nil, err
+		}
+		businessClientV1 = businessClient.BusinessV1()
+	}
+
+	// client config for auth apiserver, the members of the projects are
+	// resolved by it and the queries of the projects are denied without it
+	authAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.AuthAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if !ok && opts.AuthAPIClient.Required {
+		return nil, fmt.Errorf("failed to initialize client config of auth API server")
+	}
+	var authClientV1 authversionedclient.AuthV1Interface
+	if ok {
+		authClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(authAPIServerClientConfig, "tke-audit-api"))
+		if err != nil {
+			return nil, err
+		}
+		authClientV1 = authClient.AuthV1()
+	}
+
 	return &Config{
 		ServerName:             serverName,
 		GenericAPIServerConfig: genericAPIServerConfig,
 		AuditConfig:            auditConfig,
+		NotifyClient:           notifyClientV1,
+		BusinessClient:         businessClientV1,
+		AuthClient:             authClientV1,
 	}, nil
 }
 
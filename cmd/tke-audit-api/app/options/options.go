@@ -27,6 +27,7 @@ import (
 	"github.com/spf13/viper"
 	"k8s.io/apimachinery/pkg/util/sets"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -43,6 +44,15 @@ type Options struct {
 	Generic        *apiserveroptions.GenericOptions
 	Authentication *apiserveroptions.AuthenticationWithAPIOptions
 	Authorization  *apiserveroptions.AuthorizationOptions
+	// NotifyAPIClient is the client of tke-notify-api sending the alerts of
+	// the audit events.
+	NotifyAPIClient *controlleroptions.APIServerClientOptions
+	// BusinessAPIClient is the client of tke-business-api scoping the queries
+	// to the namespaces of the projects.
+	BusinessAPIClient *controlleroptions.APIServerClientOptions
+	// AuthAPIClient is the client of tke-auth-api resolving the members of the
+	// projects.
+	AuthAPIClient *controlleroptions.APIServerClientOptions
 	// The Audit will load its initial configuration from this file.
 	// The path may be absolute or relative; relative paths are under the Audit's current working directory.
 	AuditConfig string
@@ -51,11 +61,14 @@ type Options struct {
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string) *Options {
 	return &Options{
-		Log:            log.NewOptions(),
-		SecureServing:  apiserveroptions.NewSecureServingOptions(serverName, 9461),
-		Generic:        apiserveroptions.NewGenericOptions(),
-		Authentication: apiserveroptions.NewAuthenticationWithAPIOptions(),
-		Authorization:  apiserveroptions.NewAuthorizationOptions(),
+		Log:               log.NewOptions(),
+		SecureServing:     apiserveroptions.NewSecureServingOptions(serverName, 9461),
+		Generic:           apiserveroptions.NewGenericOptions(),
+		Authentication:    apiserveroptions.NewAuthenticationWithAPIOptions(),
+		Authorization:     apiserveroptions.NewAuthorizationOptions(),
+		NotifyAPIClient:   controlleroptions.NewAPIServerClientOptions("notify", false),
+		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", false),
+		AuthAPIClient:     controlleroptions.NewAPIServerClientOptions("auth", false),
 	}
 }
 
@@ -66,6 +79,9 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Generic.AddFlags(fs)
 	o.Authentication.AddFlags(fs)
 	o.Authorization.AddFlags(fs)
+	o.NotifyAPIClient.AddFlags(fs)
+	o.BusinessAPIClient.AddFlags(fs)
+	o.AuthAPIClient.AddFlags(fs)
 
 	fs.String(flagAuditConfig, o.AuditConfig,
 		"The Audit will load its initial configuration from this file. The path may be absolute or relative; relative paths start at the Audit's current working directory. Omit this flag to use the built-in default configuration values.")
@@ -82,6 +98,9 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Generic.ApplyFlags()...)
 	errs = append(errs, o.Authentication.ApplyFlags()...)
 	errs = append(errs, o.Authorization.ApplyFlags()...)
+	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
+	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
 
 	o.AuditConfig = viper.GetString(configAuditConfig)
 
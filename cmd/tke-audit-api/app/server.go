@@ -46,8 +46,11 @@ func createAuditConfig(cfg *config.Config) *audit.Config {
 			Config: *cfg.GenericAPIServerConfig,
 		},
 		ExtraConfig: audit.ExtraConfig{
-			ServerName:  cfg.ServerName,
-			AuditConfig: cfg.AuditConfig,
+			ServerName:     cfg.ServerName,
+			AuditConfig:    cfg.AuditConfig,
+			NotifyClient:   cfg.NotifyClient,
+			BusinessClient: cfg.BusinessClient,
+			AuthClient:     cfg.AuthClient,
 		},
 	}
 }
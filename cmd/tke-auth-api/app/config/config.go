@@ -48,10 +48,12 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
 	k8sinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/rest"
 
 	authapi "tkestack.io/tke/api/auth"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	generatedopenapi "tkestack.io/tke/api/openapi"
 	"tkestack.io/tke/cmd/tke-auth-api/app/options"
@@ -69,8 +71,10 @@ import (
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
 	"tkestack.io/tke/pkg/auth/authorization/aggregation"
+	authutil "tkestack.io/tke/pkg/auth/util"
 	dexutil "tkestack.io/tke/pkg/auth/util/dex"
 	casbinlogger "tkestack.io/tke/pkg/auth/util/logger"
+	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/log/dex"
@@ -98,6 +102,7 @@ type Config struct {
 	Authorizer           authorizer.Authorizer
 	CasbinReloadInterval time.Duration
 	PrivilegedUsername   string
+	PlatformClient       platformversionedclient.PlatformV1Interface
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -202,6 +207,20 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 
+	// client config for platform apiserver
+	var platformClient platformversionedclient.PlatformV1Interface
+	platformAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.PlatformAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && platformAPIServerClientConfig != nil {
+		client, err := versionedclientset.NewForConfig(rest.AddUserAgent(platformAPIServerClientConfig, serverName))
+		if err != nil {
+			return nil, err
+		}
+		platformClient = client.PlatformV1()
+	}
+
 	return &Config{
 		ServerName:                     serverName,
 		OIDCExternalAddress:            dexConfig.Issuer,
@@ -217,6 +236,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		Authorizer:                     aggregateAuthz,
 		PrivilegedUsername:             opts.Authentication.PrivilegedUsername,
 		CasbinReloadInterval:           opts.Authorization.CasbinReloadInterval,
+		PlatformClient:                 platformClient,
 	}, nil
 }
 
@@ -335,6 +355,7 @@ func setupCasbinEnforcer(authorizationOptions *options.AuthorizationOptions) (*c
 	}
 
 	enforcer.AddFunction("keyMatchCustom", CustomFunctionWrapper)
+	enforcer.AddFunction(authutil.ConditionMatchFunction, authutil.ConditionMatchWrapper)
 
 	return enforcer, nil
 }
@@ -31,6 +31,7 @@ const (
 	flagAuthzWebhookVersion              = "authorization-webhook-version"
 	flagAuthzWebhookCacheUnauthorizedTTL = "authorization-webhook-cache-unauthorized-ttl"
 	flagAuthzWebhookCacheAuthorizedTTL   = "authorization-webhook-cache-authorized-ttl"
+	flagAuthzWebhookChainConfigFile      = "authorization-webhook-chain-config-file"
 	flagAuthzDebug                       = "authorization-debug"
 	flagCasbinModelFile                  = "casbin-model-file"
 	flagCasbinReLoadInterval             = "casbin-reload-interval"
@@ -42,6 +43,7 @@ const (
 	configAuthzWebhookVersion              = "authorization.webhook_version"
 	configAuthzWebhookCacheUnauthorizedTTL = "authorization.webhook_cache_unauthorized_ttl"
 	configAuthzWebhookCacheAuthorizedTTL   = "authorization.webhook_cache_authorized_ttl"
+	configAuthzWebhookChainConfigFile      = "authorization.webhook_chain_config_file"
 	configAuthzDebug                       = "authorization.debug"
 	configCasbinModelFile                  = "casbin.model_file"
 	configCasbinReloadInterval             = "casbin.reload_interval"
@@ -57,6 +59,7 @@ type AuthorizationOptions struct {
 	WebhookVersion              string
 	WebhookCacheAuthorizedTTL   time.Duration
 	WebhookCacheUnauthorizedTTL time.Duration
+	WebhookChainConfigFile      string
 }
 
 // NewAuthorizationOptions creates a AuthorizationOptions object with default
@@ -95,6 +98,11 @@ func (o *AuthorizationOptions) AddFlags(fs *pflag.FlagSet) {
 		"The duration to cache 'unauthorized' responses from the webhook authorizer.")
 	_ = viper.BindPFlag(configAuthzWebhookCacheUnauthorizedTTL, fs.Lookup(flagAuthzWebhookCacheUnauthorizedTTL))
 
+	fs.String(flagAuthzWebhookChainConfigFile, o.WebhookChainConfigFile, ""+
+		"File with the webhooks chained after the built-in policy evaluation, in yaml format. "+
+		"Each webhook can deny requests allowed by the built-in policies.")
+	_ = viper.BindPFlag(configAuthzWebhookChainConfigFile, fs.Lookup(flagAuthzWebhookChainConfigFile))
+
 	fs.String(flagCasbinModelFile, o.CasbinModelFile,
 		"Casbin model file used to store ACL model.")
 	_ = viper.BindPFlag(configCasbinModelFile, fs.Lookup(flagCasbinModelFile))
@@ -121,6 +129,7 @@ func (o *AuthorizationOptions) ApplyFlags() []error {
 	o.WebhookCacheUnauthorizedTTL = viper.GetDuration(configAuthzWebhookCacheUnauthorizedTTL)
 	o.WebhookConfigFile = viper.GetString(configAuthzWebhookConfigFile)
 	o.WebhookVersion = viper.GetString(configAuthzWebhookVersion)
+	o.WebhookChainConfigFile = viper.GetString(configAuthzWebhookChainConfigFile)
 
 	return errs
 }
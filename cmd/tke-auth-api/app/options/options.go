@@ -25,35 +25,38 @@ import (
 	genericapiserveroptions "k8s.io/apiserver/pkg/server/options"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 	storageoptions "tkestack.io/tke/pkg/apiserver/storage/options"
+	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	"tkestack.io/tke/pkg/util/cachesize"
 	"tkestack.io/tke/pkg/util/log"
 )
 
 // Options is the main context object for the TKE auth.
 type Options struct {
-	Log            *log.Options
-	SecureServing  *apiserveroptions.SecureServingOptions
-	Debug          *apiserveroptions.DebugOptions
-	Generic        *apiserveroptions.GenericOptions
-	Authentication *apiserveroptions.AuthenticationWithAPIOptions
-	Authorization  *AuthorizationOptions
-	ETCD           *storageoptions.ETCDStorageOptions
-	Auth           *AuthOptions
-	Audit          *genericapiserveroptions.AuditOptions
+	Log               *log.Options
+	SecureServing     *apiserveroptions.SecureServingOptions
+	Debug             *apiserveroptions.DebugOptions
+	Generic           *apiserveroptions.GenericOptions
+	Authentication    *apiserveroptions.AuthenticationWithAPIOptions
+	Authorization     *AuthorizationOptions
+	ETCD              *storageoptions.ETCDStorageOptions
+	Auth              *AuthOptions
+	Audit             *genericapiserveroptions.AuditOptions
+	PlatformAPIClient *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
 func NewOptions(serverName string) *Options {
 	return &Options{
-		Log:            log.NewOptions(),
-		SecureServing:  apiserveroptions.NewSecureServingOptions(serverName, 9451),
-		Debug:          apiserveroptions.NewDebugOptions(),
-		Generic:        apiserveroptions.NewGenericOptions(),
-		Authentication: apiserveroptions.NewAuthenticationWithAPIOptions(),
-		Authorization:  NewAuthorizationOptions(),
-		ETCD:           storageoptions.NewETCDStorageOptions("/tke/auth-api"),
-		Auth:           NewAuthOptions(),
-		Audit:          genericapiserveroptions.NewAuditOptions(),
+		Log:               log.NewOptions(),
+		SecureServing:     apiserveroptions.NewSecureServingOptions(serverName, 9451),
+		Debug:             apiserveroptions.NewDebugOptions(),
+		Generic:           apiserveroptions.NewGenericOptions(),
+		Authentication:    apiserveroptions.NewAuthenticationWithAPIOptions(),
+		Authorization:     NewAuthorizationOptions(),
+		ETCD:              storageoptions.NewETCDStorageOptions("/tke/auth-api"),
+		Auth:              NewAuthOptions(),
+		Audit:             genericapiserveroptions.NewAuditOptions(),
+		PlatformAPIClient: controlleroptions.NewAPIServerClientOptions("platform", false),
 	}
 }
 
@@ -68,6 +71,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Authorization.AddFlags(fs)
 	o.Auth.AddFlags(fs)
 	o.Audit.AddFlags(fs)
+	o.PlatformAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -83,6 +87,7 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Authentication.ApplyFlags()...)
 	errs = append(errs, o.Authorization.ApplyFlags()...)
 	errs = append(errs, o.Auth.ApplyFlags()...)
+	errs = append(errs, o.PlatformAPIClient.ApplyFlags()...)
 
 	return errs
 }
@@ -72,6 +72,7 @@ func createAPIServerConfig(cfg *config.Config) *apiserver.Config {
 			Authorizer:              cfg.Authorizer,
 			CasbinReloadInterval:    cfg.CasbinReloadInterval,
 			PrivilegedUsername:      cfg.PrivilegedUsername,
+			PlatformClient:          cfg.PlatformClient,
 		},
 	}
 }
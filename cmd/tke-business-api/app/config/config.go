@@ -110,8 +110,6 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	}
 	versionedInformers := versionedinformers.NewSharedInformerFactory(clientgoExternalClient, 10*time.Minute)
 
-	debug.SetupDebug(genericAPIServerConfig, opts.Debug)
-
 	if err := authentication.SetupAuthentication(genericAPIServerConfig, opts.Authentication); err != nil {
 		return nil, err
 	}
@@ -138,6 +136,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		return nil, err
 	}
 	genericAPIServerConfig.BuildHandlerChainFunc = handler.BuildHandlerChain(nil, nil, []filter.Inspector{clusterInspector})
+	debug.SetupDebug(genericAPIServerConfig, opts.Debug)
 
 	cfg := &Config{
 		ServerName:                     serverName,
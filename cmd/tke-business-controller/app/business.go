@@ -28,6 +28,7 @@ import (
 	"tkestack.io/tke/pkg/business/controller/emigration"
 	"tkestack.io/tke/pkg/business/controller/imagenamespace"
 	"tkestack.io/tke/pkg/business/controller/namespace"
+	"tkestack.io/tke/pkg/business/controller/namespacerequest"
 	"tkestack.io/tke/pkg/business/controller/platform"
 	"tkestack.io/tke/pkg/business/controller/project"
 )
@@ -50,6 +51,9 @@ const (
 
 	emigrationSyncPeriod      = 30 * time.Second
 	concurrentEmigrationSyncs = 10
+
+	namespaceRequestSyncPeriod      = 30 * time.Second
+	concurrentNamespaceRequestSyncs = 5
 )
 
 func startNamespaceController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -169,3 +173,19 @@ func startNsEmigrationController(ctx ControllerContext) (http.Handler, bool, err
 
 	return nil, true, nil
 }
+
+func startNamespaceRequestController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: businessv1.GroupName, Version: "v1", Resource: "namespacerequests"}] {
+		return nil, false, nil
+	}
+
+	ctrl := namespacerequest.NewController(
+		ctx.ClientBuilder.ClientOrDie("namespacerequest-controller"),
+		ctx.NotifyClient,
+		ctx.InformerFactory.Business().V1().NamespaceRequests(),
+		namespaceRequestSyncPeriod)
+
+	go ctrl.Run(concurrentNamespaceRequestSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
@@ -50,6 +50,8 @@ type Config struct {
 	RegistryAPIServerClientConfig *restclient.Config
 	// the rest config for the auth apiserver
 	AuthAPIServerClientConfig *restclient.Config
+	// the rest config for the notify apiserver
+	NotifyAPIServerClientConfig *restclient.Config
 
 	Component controlleroptions.ComponentConfiguration
 }
@@ -111,6 +113,14 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		controllerManagerConfig.AuthAPIServerClientConfig = authAPIServerClientConfig
 	}
 
+	notifyAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.NotifyAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && notifyAPIServerClientConfig != nil {
+		controllerManagerConfig.NotifyAPIServerClientConfig = notifyAPIServerClientConfig
+	}
+
 	if err := opts.Component.ApplyTo(&controllerManagerConfig.Component); err != nil {
 		return nil, err
 	}
@@ -120,5 +130,6 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 	return controllerManagerConfig, nil
 }
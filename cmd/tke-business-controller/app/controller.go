@@ -54,6 +54,7 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["chartgroup"] = startChartGroupController
 	controllers["platform"] = startPlatformController
 	controllers["nsemigration"] = startNsEmigrationController
+	controllers["namespacerequest"] = startNamespaceRequestController
 	return controllers
 }
 
@@ -49,6 +49,7 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 	// serverMux is the handler for these controller *after* authn/authz filters have been applied
 	serverMux := controller.NewBaseHandler(&cfg.Component.Debugging, checks...)
 	handler := controller.BuildHandlerChain(serverMux, &cfg.Authorization, &cfg.Authentication, business.Codecs)
+	handler = controller.WithProfiling(handler, &cfg.Component.Debugging, cfg.Component.ProfilingToken)
 	if _, err := cfg.SecureServing.Serve(handler, 0, stopCh); err != nil {
 		return err
 	}
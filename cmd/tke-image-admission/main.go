@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"tkestack.io/tke/pkg/platform/imageadmission"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+func main() {
+	addr := pflag.String("listen", ":8443", "The address to serve the admission webhook.")
+	policyFile := pflag.String("policy-file", "/app/conf/policy.json", "The file of image admission policy.")
+	certFile := pflag.String("tls-cert-file", "/app/certs/tls.crt", "The serving certificate of webhook.")
+	keyFile := pflag.String("tls-private-key-file", "/app/certs/tls.key", "The serving private key of webhook.")
+	pflag.Parse()
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		log.Fatal("Load in cluster config failed", log.Err(err))
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		log.Fatal("Create kubernetes client failed", log.Err(err))
+	}
+	factory := informers.NewSharedInformerFactory(client, 10*time.Minute)
+	namespaceInformer := factory.Core().V1().Namespaces()
+	namespaceLister := namespaceInformer.Lister()
+	ctx := context.Background()
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), namespaceInformer.Informer().HasSynced) {
+		log.Fatal("Sync namespaces failed")
+	}
+
+	webhook, err := imageadmission.NewWebhook(*policyFile, namespaceLister)
+	if err != nil {
+		log.Fatal("Create image admission webhook failed", log.Err(err))
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/validate", webhook)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	log.Info("Serving image admission webhook", log.String("addr", *addr))
+	server := &http.Server{Addr: *addr, Handler: mux}
+	log.Fatal("Serve image admission webhook failed", log.Err(server.ListenAndServeTLS(*certFile, *keyFile)))
+}
@@ -49,6 +49,7 @@ import (
 	k8stypes "k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/authentication/user"
 	"k8s.io/apiserver/pkg/endpoints/request"
@@ -69,6 +70,7 @@ import (
 	"tkestack.io/tke/cmd/tke-installer/app/installer/constants"
 	"tkestack.io/tke/cmd/tke-installer/app/installer/images"
 	"tkestack.io/tke/cmd/tke-installer/app/installer/types"
+	"tkestack.io/tke/pkg/audit/sink"
 	baremetalcluster "tkestack.io/tke/pkg/platform/provider/baremetal/cluster"
 	baremetalconfig "tkestack.io/tke/pkg/platform/provider/baremetal/config"
 	baremetalconstants "tkestack.io/tke/pkg/platform/provider/baremetal/constants"
@@ -721,6 +723,10 @@ func (t *TKE) setConfigDefault(config *types.Config) {
 		}
 	}
 
+	if config.Audit != nil && config.Audit.SinkToken == "" {
+		config.Audit.SinkToken = string(uuid.NewUUID())
+	}
+
 	config.Logagent = new(types.Logagent)
 
 	if config.Application != nil {
@@ -935,6 +941,7 @@ func (t *TKE) completeProviderConfigForRegistry() error {
 	}
 	if t.auditEnabled() {
 		c.Audit.Address = t.determineGatewayHTTPSAddress()
+		c.Audit.Token = t.auditSinkToken()
 	}
 
 	return c.Save(constants.ProviderConfigFile)
@@ -952,6 +959,24 @@ func (t *TKE) determineGatewayHTTPSAddress() string {
 	return fmt.Sprintf("https://%s", host)
 }
 
+// auditSinkToken returns the token of the sink of tke-audit-api, the tokens
+// of the clusters sending the audit events are derived from it.
+func (t *TKE) auditSinkToken() string {
+	if !t.auditEnabled() {
+		return ""
+	}
+	return t.Para.Config.Audit.SinkToken
+}
+
+// auditControlPlaneToken returns the bearer token the platform components
+// send the audit events of the control-plane cluster with.
+func (t *TKE) auditControlPlaneToken() string {
+	if !t.auditEnabled() {
+		return ""
+	}
+	return sink.ClusterToken(t.Para.Config.Audit.SinkToken, "control-plane")
+}
+
 func (t *TKE) auditEnabled() bool {
 	return t.Para.Config.Audit != nil &&
 		t.Para.Config.Audit.ElasticSearch != nil &&
@@ -1491,6 +1516,7 @@ func (t *TKE) prepareBaremetalProviderConfig(ctx context.Context) error {
 	}
 	if t.auditEnabled() {
 		providerConfig.Audit.Address = t.determineGatewayHTTPSAddress()
+		providerConfig.Audit.Token = t.auditSinkToken()
 	}
 	if t.businessEnabled() {
 		providerConfig.Business.Enabled = true
@@ -1645,6 +1671,7 @@ func (t *TKE) installTKELogagentAPI(ctx context.Context) error {
 		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
 		"EnableRegistry": t.Para.Config.Registry.TKERegistry != nil,
 		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -1714,6 +1741,7 @@ func (t *TKE) installTKEAuthAPI(ctx context.Context) error {
 		"RedirectHosts":    redirectHosts,
 		"NodePort":         constants.AuthzWebhookNodePort,
 		"EnableAudit":      t.auditEnabled(),
+		"AuditSinkToken":   t.auditControlPlaneToken(),
 	}
 	err := apiclient.CreateResourceWithDir(ctx, t.globalClient, "manifests/tke-auth-api/*.yaml", option)
 	if err != nil {
@@ -1752,9 +1780,12 @@ func (t *TKE) installTKEAuthController(ctx context.Context) error {
 
 func (t *TKE) installTKEAudit(ctx context.Context) error {
 	options := map[string]interface{}{
-		"Replicas":   t.Config.Replicas,
-		"Image":      images.Get().TKEAudit.FullName(),
-		"EnableAuth": t.Para.Config.Auth.TKEAuth != nil,
+		"Replicas":       t.Config.Replicas,
+		"Image":          images.Get().TKEAudit.FullName(),
+		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
+		"EnableNotify":   t.Para.Config.Monitor != nil,
+		"EnableBusiness": t.businessEnabled(),
+		"SinkToken":      t.auditSinkToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -1786,10 +1817,11 @@ func (t *TKE) installTKEAudit(ctx context.Context) error {
 
 func (t *TKE) installTKEPlatformAPI(ctx context.Context) error {
 	options := map[string]interface{}{
-		"Replicas":    t.Config.Replicas,
-		"Image":       images.Get().TKEPlatformAPI.FullName(),
-		"EnableAuth":  t.Para.Config.Auth.TKEAuth != nil,
-		"EnableAudit": t.auditEnabled(),
+		"Replicas":       t.Config.Replicas,
+		"Image":          images.Get().TKEPlatformAPI.FullName(),
+		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
+		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -1875,6 +1907,7 @@ func (t *TKE) installTKEBusinessAPI(ctx context.Context) error {
 		"EnableAuth":                 t.Para.Config.Auth.TKEAuth != nil,
 		"EnableRegistry":             t.Para.Config.Registry.TKERegistry != nil,
 		"EnableAudit":                t.auditEnabled(),
+		"AuditSinkToken":             t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -1992,6 +2025,7 @@ func (t *TKE) installTKEMonitorAPI(ctx context.Context) error {
 		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
 		"EnableBusiness": t.businessEnabled(),
 		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -2040,6 +2074,7 @@ func (t *TKE) installTKEMonitorController(ctx context.Context) error {
 		"Replicas":                t.Config.Replicas,
 		"Image":                   images.Get().TKEMonitorController.FullName(),
 		"EnableBusiness":          t.businessEnabled(),
+		"EnableAuth":              t.Para.Config.Auth.TKEAuth != nil,
 		"RegistryDomain":          t.Para.Config.Registry.Domain(),
 		"RegistryNamespace":       t.Para.Config.Registry.Namespace(),
 		"MonitorStorageType":      "",
@@ -2102,10 +2137,11 @@ func (t *TKE) installTKEMonitorController(ctx context.Context) error {
 
 func (t *TKE) installTKENotifyAPI(ctx context.Context) error {
 	options := map[string]interface{}{
-		"Replicas":    t.Config.Replicas,
-		"Image":       images.Get().TKENotifyAPI.FullName(),
-		"EnableAuth":  t.Para.Config.Auth.TKEAuth != nil,
-		"EnableAudit": t.auditEnabled(),
+		"Replicas":       t.Config.Replicas,
+		"Image":          images.Get().TKENotifyAPI.FullName(),
+		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
+		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -2162,6 +2198,7 @@ func (t *TKE) installTKERegistryAPI(ctx context.Context) error {
 		"EnableBusiness": t.businessEnabled(),
 		"DomainSuffix":   t.Para.Config.Registry.TKERegistry.Domain,
 		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 		"HarborEnabled":  t.Para.Config.Registry.TKERegistry.HarborEnabled,
 		"HarborCAFile":   t.Para.Config.Registry.TKERegistry.HarborCAFile,
 	}
@@ -2223,6 +2260,7 @@ func (t *TKE) installTKEApplicationAPI(ctx context.Context) error {
 		"EnableAuth":            t.Para.Config.Auth.TKEAuth != nil,
 		"EnableRegistry":        t.Para.Config.Registry.TKERegistry != nil,
 		"EnableAudit":           t.auditEnabled(),
+		"AuditSinkToken":        t.auditControlPlaneToken(),
 		"RegistryAdminUsername": t.Para.Config.Application.RegistryUsername,
 		"RegistryAdminPassword": string(t.Para.Config.Application.RegistryPassword),
 		"RegistryDomainSuffix":  t.Para.Config.Application.RegistryDomain,
@@ -2270,10 +2308,11 @@ func (t *TKE) installTKEApplicationController(ctx context.Context) error {
 
 func (t *TKE) installTKEMeshAPI(ctx context.Context) error {
 	options := map[string]interface{}{
-		"Replicas":    t.Config.Replicas,
-		"Image":       images.Get().TKEMeshAPI.FullName(),
-		"EnableAuth":  t.Para.Config.Auth.TKEAuth != nil,
-		"EnableAudit": t.auditEnabled(),
+		"Replicas":       t.Config.Replicas,
+		"Image":          images.Get().TKEMeshAPI.FullName(),
+		"EnableAuth":     t.Para.Config.Auth.TKEAuth != nil,
+		"EnableAudit":    t.auditEnabled(),
+		"AuditSinkToken": t.auditControlPlaneToken(),
 	}
 	if t.Para.Config.Auth.OIDCAuth != nil {
 		options["OIDCClientID"] = t.Para.Config.Auth.OIDCAuth.ClientID
@@ -79,6 +79,10 @@ type Registry struct {
 
 type Audit struct {
 	ElasticSearch *ElasticSearch `json:"elasticSearch,omitempty"`
+	// SinkToken is the token of the sink of tke-audit, the components and
+	// each cluster send the audit events with the token derived from it for
+	// their cluster name. Generated if not set.
+	SinkToken string `json:"sinkToken,omitempty"`
 }
 
 type ElasticSearch struct {
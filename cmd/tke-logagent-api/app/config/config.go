@@ -64,7 +64,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	genericAPIServerConfig := genericapiserver.NewConfig(logagent.Codecs)
 	//to support file download we need to change logrun
 	genericAPIServerConfig.LongRunningFunc = filter.LongRunningRequestCheck(sets.NewString("watch", "proxy", "connect"),
-		sets.NewString("filedownload"), []string{})
+		sets.NewString("filedownload", "search"), []string{})
 	genericAPIServerConfig.BuildHandlerChainFunc = handler.BuildHandlerChain(nil, nil, nil)
 	genericAPIServerConfig.MergedResourceConfig = apiserver.DefaultAPIResourceConfigSource()
 	genericAPIServerConfig.EnableIndex = false
@@ -100,6 +100,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 	util.Init(opts.FeatureOptions.Domain, opts.FeatureOptions.Namespace)
 	return controllerManagerConfig, nil
 }
@@ -128,5 +128,6 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 	return controllerManagerConfig, nil
 }
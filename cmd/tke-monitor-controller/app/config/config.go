@@ -50,11 +50,14 @@ type Config struct {
 	MonitorAPIServerClientConfig *restclient.Config
 	// the rest config for the business apiserver
 	BusinessAPIServerClientConfig *restclient.Config
+	// the rest config for the auth apiserver
+	AuthAPIServerClientConfig *restclient.Config
 	// the rest config for the platform apiserver
 	PlatformAPIServerClientConfig *restclient.Config
 	Component                     controlleroptions.ComponentConfiguration
 	MonitorConfig                 *monitorconfig.MonitorConfiguration
 	Features                      *options.FeatureOptions
+	Sharding                      *options.ShardingOptions
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -111,6 +114,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		},
 		MonitorConfig: monitorConfig,
 		Features:      opts.FeatureOptions,
+		Sharding:      opts.Sharding,
 	}
 
 	businessAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.BusinessAPIClient)
@@ -121,6 +125,14 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		controllerManagerConfig.BusinessAPIServerClientConfig = businessAPIServerClientConfig
 	}
 
+	authAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.AuthAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && authAPIServerClientConfig != nil {
+		controllerManagerConfig.AuthAPIServerClientConfig = authAPIServerClientConfig
+	}
+
 	platformAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.PlatformAPIClient)
 	if err != nil {
 		return nil, err
@@ -138,5 +150,6 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 	return controllerManagerConfig, nil
 }
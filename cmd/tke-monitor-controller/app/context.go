@@ -28,6 +28,7 @@ import (
 	"net/http"
 	"time"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
 	businessv1 "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
 	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
@@ -35,6 +36,7 @@ import (
 	"tkestack.io/tke/pkg/controller"
 	"tkestack.io/tke/pkg/controller/util"
 	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	"tkestack.io/tke/pkg/monitor/sharding"
 )
 
 // InitFunc is used to launch a particular controller.  It may run additional "should I activate checks".
@@ -72,11 +74,15 @@ type ControllerContext struct {
 	ControllerStartInterval time.Duration
 
 	BusinessClient businessv1.BusinessV1Interface
+	AuthClient     authv1.AuthV1Interface
 	PlatformClient platformv1.PlatformV1Interface
 	MonitorConfig  *monitorconfig.MonitorConfiguration
 	// Remote write/read address for prometheus
 	RemoteAddresses []string
 	RemoteType      string
+	// Sharder decides the clusters handled by this replica, nil if sharding
+	// is disabled or the controllers do not handle clusters.
+	Sharder *sharding.Sharder
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -133,6 +139,14 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		ctx.BusinessClient = businessClient.BusinessV1()
 	}
 
+	if cfg.AuthAPIServerClientConfig != nil {
+		authClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.AuthAPIServerClientConfig, "tke-monitor-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the auth client: %v", err)
+		}
+		ctx.AuthClient = authClient.AuthV1()
+	}
+
 	if cfg.PlatformAPIServerClientConfig != nil {
 		platformClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.PlatformAPIServerClientConfig, "tke-monitor-controller"))
 		if err != nil {
@@ -36,6 +36,11 @@ const (
 // by default.
 var ControllersDisabledByDefault = sets.NewString()
 
+// ShardedControllers are the controllers of the clusters, which run on every
+// replica and handle the clusters of the shards led by the replica if
+// sharding is enabled.
+var ShardedControllers = sets.NewString("prometheus", "eventalert", "blackbox")
+
 // KnownControllers returns the known controllers.
 func KnownControllers() []string {
 	ret := sets.StringKeySet(NewControllerInitializers())
@@ -49,6 +54,10 @@ func NewControllerInitializers() map[string]InitFunc {
 
 	controllers["metric"] = startMetricController
 	controllers["prometheus"] = startPrometheusController
+	controllers["grafana"] = startGrafanaController
+	controllers["eventalert"] = startEventAlertController
+	controllers["downsampling"] = startDownsamplingController
+	controllers["blackbox"] = startBlackboxController
 	return controllers
 }
 
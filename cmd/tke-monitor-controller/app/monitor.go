@@ -23,6 +23,11 @@ import (
 	"net/http"
 	"time"
 	"tkestack.io/tke/api/monitor/v1"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	"tkestack.io/tke/pkg/monitor/controller/blackbox"
+	"tkestack.io/tke/pkg/monitor/controller/downsampling"
+	"tkestack.io/tke/pkg/monitor/controller/eventalert"
+	"tkestack.io/tke/pkg/monitor/controller/grafana"
 	"tkestack.io/tke/pkg/monitor/controller/metric"
 	"tkestack.io/tke/pkg/monitor/controller/prometheus"
 	"tkestack.io/tke/pkg/monitor/storage"
@@ -32,6 +37,15 @@ import (
 const (
 	promEventSyncPeriod = 5 * time.Minute
 	concurrentPromSyncs = 10
+	grafanaSyncPeriod   = 5 * time.Minute
+
+	eventAlertSyncPeriod      = 5 * time.Minute
+	concurrentEventAlertSyncs = 5
+
+	downsamplingSyncPeriod = time.Minute
+
+	blackboxSyncPeriod      = 5 * time.Minute
+	concurrentBlackboxSyncs = 5
 )
 
 func startMetricController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -65,14 +79,21 @@ func startPrometheusController(ctx ControllerContext) (http.Handler, bool, error
 		return nil, false, nil
 	}
 
+	var objectStorage *monitorconfig.ThanosObjectStorage
+	if ctx.MonitorConfig != nil && ctx.MonitorConfig.Storage.Thanos != nil {
+		objectStorage = ctx.MonitorConfig.Storage.Thanos.ObjectStorage
+	}
+
 	ctrl := prometheus.NewController(
 		ctx.ClientBuilder.ClientOrDie("prometheus-controller"),
 		ctx.PlatformClient,
 		ctx.InformerFactory.Monitor().V1().Prometheuses(),
+		ctx.Sharder,
 		promEventSyncPeriod,
 
 		ctx.RemoteAddresses,
 		ctx.RemoteType,
+		objectStorage,
 	)
 
 	go func() {
@@ -81,3 +102,89 @@ func startPrometheusController(ctx ControllerContext) (http.Handler, bool, error
 
 	return nil, true, nil
 }
+
+func startGrafanaController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.MonitorConfig == nil || ctx.MonitorConfig.Grafana == nil {
+		return nil, false, nil
+	}
+	if ctx.PlatformClient == nil {
+		log.Errorf("grafana requires the platform client")
+		return nil, false, nil
+	}
+
+	ctrl := grafana.NewController(
+		ctx.MonitorConfig.Grafana,
+		ctx.PlatformClient,
+		ctx.BusinessClient,
+		ctx.AuthClient,
+		grafanaSyncPeriod,
+	)
+
+	go ctrl.Run(ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startEventAlertController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: v1.Version, Resource: "eventalertpolicies"}] {
+		return nil, false, nil
+	}
+	if ctx.PlatformClient == nil {
+		log.Errorf("event alert requires the platform client")
+		return nil, false, nil
+	}
+
+	ctrl := eventalert.NewController(
+		ctx.ClientBuilder.ClientOrDie("eventalert-controller"),
+		ctx.PlatformClient,
+		ctx.InformerFactory.Monitor().V1().EventAlertPolicies(),
+		ctx.Sharder,
+		eventAlertSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentEventAlertSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startDownsamplingController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.MonitorConfig == nil || ctx.MonitorConfig.Storage.InfluxDB == nil || ctx.MonitorConfig.Storage.InfluxDB.Retention == nil {
+		return nil, false, nil
+	}
+
+	ctrl, err := downsampling.NewController(ctx.MonitorConfig.Storage.InfluxDB, downsamplingSyncPeriod)
+	if err != nil {
+		log.Error("Failed to create downsampling controller", log.Err(err))
+		return nil, false, err
+	}
+
+	go ctrl.Run(ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startBlackboxController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: v1.Version, Resource: "blackboxprobes"}] {
+		return nil, false, nil
+	}
+	if ctx.PlatformClient == nil {
+		log.Errorf("blackbox probe requires the platform client")
+		return nil, false, nil
+	}
+
+	ctrl := blackbox.NewController(
+		ctx.ClientBuilder.ClientOrDie("blackbox-controller"),
+		ctx.PlatformClient,
+		ctx.InformerFactory.Monitor().V1().BlackboxProbes(),
+		ctx.Sharder,
+		blackboxSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentBlackboxSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
@@ -39,9 +39,13 @@ type Options struct {
 	Component         *controlleroptions.ComponentOptions
 	MonitorAPIClient  *controlleroptions.APIServerClientOptions
 	BusinessAPIClient *controlleroptions.APIServerClientOptions
+	// AuthAPIClient is the client of tke-auth-api resolving the members of the
+	// projects.
+	AuthAPIClient     *controlleroptions.APIServerClientOptions
 	PlatformAPIClient *controlleroptions.APIServerClientOptions
 	Registry          *apiserveroptions.RegistryOptions
 	FeatureOptions    *FeatureOptions
+	Sharding          *ShardingOptions
 	// The Registry will load its initial configuration from this file.
 	// The path may be absolute or relative; relative paths are under the Monitor's current working directory.
 	MonitorConfig string
@@ -56,9 +60,11 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		Component:         controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
 		MonitorAPIClient:  controlleroptions.NewAPIServerClientOptions("monitor", true),
 		BusinessAPIClient: controlleroptions.NewAPIServerClientOptions("business", false),
+		AuthAPIClient:     controlleroptions.NewAPIServerClientOptions("auth", false),
 		PlatformAPIClient: controlleroptions.NewAPIServerClientOptions("platform", false),
 		Registry:          apiserveroptions.NewRegistryOptions(),
 		FeatureOptions:    NewFeatureOptions(),
+		Sharding:          NewShardingOptions(),
 	}
 }
 
@@ -70,9 +76,11 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.Component.AddFlags(fs)
 	o.MonitorAPIClient.AddFlags(fs)
 	o.BusinessAPIClient.AddFlags(fs)
+	o.AuthAPIClient.AddFlags(fs)
 	o.PlatformAPIClient.AddFlags(fs)
 	o.Registry.AddFlags(fs)
 	o.FeatureOptions.AddFlags(fs)
+	o.Sharding.AddFlags(fs)
 
 	fs.String(flagMonitorConfig, o.MonitorConfig,
 		"The Monitor will load its initial configuration from this file. The path may be absolute or relative; relative paths start at the Monitor's current working directory. Omit this flag to use the built-in default configuration values.")
@@ -90,9 +98,11 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.Component.ApplyFlags()...)
 	errs = append(errs, o.MonitorAPIClient.ApplyFlags()...)
 	errs = append(errs, o.BusinessAPIClient.ApplyFlags()...)
+	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
 	errs = append(errs, o.PlatformAPIClient.ApplyFlags()...)
 	errs = append(errs, o.Registry.ApplyFlags()...)
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
+	errs = append(errs, o.Sharding.ApplyFlags()...)
 
 	o.MonitorConfig = viper.GetString(configMonitorConfig)
 
@@ -0,0 +1,64 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagShards   = "shards"
+	configShards = "sharding.shards"
+)
+
+// ShardingOptions contains the options of splitting the clusters across the
+// replicas of tke-monitor-controller.
+type ShardingOptions struct {
+	Shards int
+}
+
+// NewShardingOptions creates a new ShardingOptions with a default config.
+func NewShardingOptions() *ShardingOptions {
+	return &ShardingOptions{
+		Shards: 1,
+	}
+}
+
+// AddFlags adds flags for sharding to the specified FlagSet object.
+func (o *ShardingOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.Int(flagShards, o.Shards,
+		"The number of shards the clusters are split into by consistent hashing. The controllers of the clusters run on every replica and each shard is handled by the replica leading it. 1 disables sharding, which requires leader election to be enabled.")
+	_ = viper.BindPFlag(configShards, fs.Lookup(flagShards))
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *ShardingOptions) ApplyFlags() []error {
+	var errs []error
+
+	o.Shards = viper.GetInt(configShards)
+	if o.Shards < 1 {
+		errs = append(errs, fmt.Errorf("--%s must be greater than 0", flagShards))
+	}
+
+	return errs
+}
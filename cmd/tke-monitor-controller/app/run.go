@@ -27,6 +27,7 @@ import (
 	"tkestack.io/tke/api/monitor"
 	"tkestack.io/tke/cmd/tke-monitor-controller/app/config"
 	"tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/monitor/sharding"
 	"tkestack.io/tke/pkg/util/leaderelection"
 	"tkestack.io/tke/pkg/util/leaderelection/resourcelock"
 	"tkestack.io/tke/pkg/util/log"
@@ -48,11 +49,12 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 	// serverMux is the handler for these controller *after* authn/authz filters have been applied
 	serverMux := controller.NewBaseHandler(&cfg.Component.Debugging, checks...)
 	handler := controller.BuildHandlerChain(serverMux, &cfg.Authorization, &cfg.Authentication, monitor.Codecs)
+	handler = controller.WithProfiling(handler, &cfg.Component.Debugging, cfg.Component.ProfilingToken)
 	if _, err := cfg.SecureServing.Serve(handler, 0, stopCh); err != nil {
 		return err
 	}
 
-	run := func(ctx context.Context) {
+	startControllers := func(ctx context.Context, initializers map[string]InitFunc, sharder *sharding.Sharder) {
 		rootClientBuilder := controller.SimpleControllerClientBuilder{
 			ClientConfig: cfg.MonitorAPIServerClientConfig,
 		}
@@ -61,14 +63,19 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 		if err != nil {
 			log.Fatalf("error building controller context: %v", err)
 		}
+		controllerContext.Sharder = sharder
 
-		if err := StartControllers(controllerContext, NewControllerInitializers(), serverMux); err != nil {
+		if err := StartControllers(controllerContext, initializers, serverMux); err != nil {
 			log.Fatalf("error starting controllers: %v", err)
 		}
 
 		controllerContext.InformerFactory.Start(controllerContext.Stop)
 		close(controllerContext.InformersStarted)
+	}
 
+	initializers := NewControllerInitializers()
+	run := func(ctx context.Context) {
+		startControllers(ctx, initializers, nil)
 		select {}
 	}
 
@@ -79,6 +86,9 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 	}()
 
 	if !cfg.Component.LeaderElection.LeaderElect {
+		if cfg.Sharding.Shards > 1 {
+			log.Warn("Sharding requires leader election, all clusters are handled by this process")
+		}
 		run(ctx)
 		panic("unreachable")
 	}
@@ -90,6 +100,30 @@ func Run(cfg *config.Config, stopCh <-chan struct{}) error {
 
 	// add a uniquifier so that two processes on the same host don't accidentally both become active
 	id = id + "_" + string(uuid.NewUUID())
+
+	if cfg.Sharding.Shards > 1 {
+		// the controllers of the clusters run on every replica, and handle the
+		// clusters of the shards led by the replica, while the others run on
+		// the leader only.
+		sharder := sharding.NewSharder(cfg.LeaderElectionClient.MonitorV1(), sharding.Config{
+			Identity:      id,
+			Shards:        cfg.Sharding.Shards,
+			LeaseDuration: cfg.Component.LeaderElection.LeaseDuration.Duration,
+			RenewDeadline: cfg.Component.LeaderElection.RenewDeadline.Duration,
+			RetryPeriod:   cfg.Component.LeaderElection.RetryPeriod.Duration,
+		})
+		go sharder.Run(ctx)
+
+		sharded := make(map[string]InitFunc)
+		for name, initFn := range initializers {
+			if ShardedControllers.Has(name) {
+				sharded[name] = initFn
+				delete(initializers, name)
+			}
+		}
+		startControllers(ctx, sharded, sharder)
+	}
+
 	rl := resourcelock.NewMonitor("tke-monitor-controller",
 		cfg.LeaderElectionClient.MonitorV1(),
 		resourcelock.Config{
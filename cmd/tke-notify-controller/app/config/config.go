@@ -43,7 +43,9 @@ type Config struct {
 	LeaderElectionClient *versionedclientset.Clientset
 	// the rest config for the notify apiserver
 	NotifyAPIServerClientConfig *restclient.Config
-	Component                   controlleroptions.ComponentConfiguration
+	// the rest config for the auth apiserver
+	AuthAPIServerClientConfig *restclient.Config
+	Component                 controlleroptions.ComponentConfiguration
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -78,6 +80,14 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 		},
 	}
 
+	authAPIServerClientConfig, ok, err := controllerconfig.BuildClientConfig(opts.AuthAPIClient)
+	if err != nil {
+		return nil, err
+	}
+	if ok && authAPIServerClientConfig != nil {
+		controllerManagerConfig.AuthAPIServerClientConfig = authAPIServerClientConfig
+	}
+
 	if err := opts.Component.ApplyTo(&controllerManagerConfig.Component); err != nil {
 		return nil, err
 	}
@@ -87,5 +97,6 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 	return controllerManagerConfig, nil
 }
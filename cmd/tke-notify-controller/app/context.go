@@ -23,9 +23,12 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	cacheddiscovery "k8s.io/client-go/discovery/cached"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
 	"net/http"
 	"time"
+	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/cmd/tke-notify-controller/app/config"
 	"tkestack.io/tke/pkg/controller"
@@ -65,6 +68,10 @@ type ControllerContext struct {
 	// with list requests simultaneously.
 	ResyncPeriod            func() time.Duration
 	ControllerStartInterval time.Duration
+
+	// AuthClient is used to synchronize receiver groups from the groups of
+	// tke-auth, nil if the auth api server is not configured.
+	AuthClient authv1.AuthV1Interface
 }
 
 // IsControllerEnabled returns whether the controller has been enabled
@@ -108,5 +115,13 @@ func CreateControllerContext(cfg *config.Config, rootClientBuilder controller.Cl
 		ResyncPeriod:            controller.ResyncPeriod(&cfg.Component),
 		ControllerStartInterval: cfg.Component.ControllerStartInterval,
 	}
+
+	if cfg.AuthAPIServerClientConfig != nil {
+		authClient, err := versionedclientset.NewForConfig(rest.AddUserAgent(cfg.AuthAPIServerClientConfig, "tke-notify-controller"))
+		if err != nil {
+			return ControllerContext{}, fmt.Errorf("failed to create the auth client: %v", err)
+		}
+		ctx.AuthClient = authClient.AuthV1()
+	}
 	return ctx, nil
 }
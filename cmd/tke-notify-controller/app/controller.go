@@ -49,6 +49,8 @@ func NewControllerInitializers() map[string]InitFunc {
 
 	controllers["channel"] = startChannelController
 	controllers["messagerequest"] = startMessageRequestController
+	controllers["escalation"] = startEscalationController
+	controllers["receivergroup"] = startReceiverGroupController
 
 	return controllers
 }
@@ -24,7 +24,9 @@ import (
 	"time"
 	"tkestack.io/tke/api/notify/v1"
 	"tkestack.io/tke/pkg/notify/controller/channel"
+	"tkestack.io/tke/pkg/notify/controller/escalation"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest"
+	"tkestack.io/tke/pkg/notify/controller/receivergroup"
 )
 
 const (
@@ -33,6 +35,12 @@ const (
 
 	messageRequestSyncPeriod      = 5 * time.Minute
 	concurrentMessageRequestSyncs = 10
+
+	escalationSyncPeriod      = 1 * time.Minute
+	concurrentEscalationSyncs = 5
+
+	receiverGroupSyncPeriod      = 5 * time.Minute
+	concurrentReceiverGroupSyncs = 2
 )
 
 func startChannelController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -60,6 +68,7 @@ func startMessageRequestController(ctx ControllerContext) (http.Handler, bool, e
 	ctrl := messagerequest.NewController(
 		ctx.ClientBuilder.ClientOrDie("message-request-controller"),
 		ctx.InformerFactory.Notify().V1().MessageRequests(),
+		ctx.InformerFactory.Notify().V1().Messages(),
 		messageRequestSyncPeriod,
 	)
 
@@ -67,3 +76,39 @@ func startMessageRequestController(ctx ControllerContext) (http.Handler, bool, e
 
 	return nil, true, nil
 }
+
+func startEscalationController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: "v1", Resource: "escalations"}] {
+		return nil, false, nil
+	}
+
+	ctrl := escalation.NewController(
+		ctx.ClientBuilder.ClientOrDie("escalation-controller"),
+		ctx.InformerFactory.Notify().V1().Escalations(),
+		escalationSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentEscalationSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
+
+func startReceiverGroupController(ctx ControllerContext) (http.Handler, bool, error) {
+	if ctx.AuthClient == nil {
+		return nil, false, nil
+	}
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: v1.GroupName, Version: "v1", Resource: "receivergroups"}] {
+		return nil, false, nil
+	}
+
+	ctrl := receivergroup.NewController(
+		ctx.ClientBuilder.ClientOrDie("receivergroup-controller"),
+		ctx.AuthClient,
+		ctx.InformerFactory.Notify().V1().ReceiverGroups(),
+		receiverGroupSyncPeriod,
+	)
+
+	go ctrl.Run(concurrentReceiverGroupSyncs, ctx.Stop)
+
+	return nil, true, nil
+}
@@ -32,6 +32,7 @@ type Options struct {
 	SecureServing   *apiserveroptions.SecureServingOptions
 	Component       *controlleroptions.ComponentOptions
 	NotifyAPIClient *controlleroptions.APIServerClientOptions
+	AuthAPIClient   *controlleroptions.APIServerClientOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -42,6 +43,7 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		SecureServing:   apiserveroptions.NewSecureServingOptions(serverName, 9459),
 		Component:       controlleroptions.NewComponentOptions(allControllers, disabledByDefaultControllers),
 		NotifyAPIClient: controlleroptions.NewAPIServerClientOptions("notify", true),
+		AuthAPIClient:   controlleroptions.NewAPIServerClientOptions("auth", false),
 	}
 }
 
@@ -52,6 +54,7 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.SecureServing.AddFlags(fs)
 	o.Component.AddFlags(fs)
 	o.NotifyAPIClient.AddFlags(fs)
+	o.AuthAPIClient.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -64,6 +67,7 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.SecureServing.ApplyFlags()...)
 	errs = append(errs, o.Component.ApplyFlags()...)
 	errs = append(errs, o.NotifyAPIClient.ApplyFlags()...)
+	errs = append(errs, o.AuthAPIClient.ApplyFlags()...)
 
 	return errs
 }
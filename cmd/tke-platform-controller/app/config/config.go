@@ -31,7 +31,9 @@ import (
 	controllerconfig "tkestack.io/tke/pkg/controller/config"
 	controlleroptions "tkestack.io/tke/pkg/controller/options"
 	clusterconfig "tkestack.io/tke/pkg/platform/controller/cluster/config"
+	licenseconfig "tkestack.io/tke/pkg/platform/controller/license/config"
 	machineconfig "tkestack.io/tke/pkg/platform/controller/machine/config"
+	telemetryconfig "tkestack.io/tke/pkg/platform/controller/telemetry/config"
 )
 
 // Config is the running configuration structure of the TKE controller manager.
@@ -49,8 +51,10 @@ type Config struct {
 	Component                     controlleroptions.ComponentConfiguration
 	Features                      *options.FeatureOptions
 
-	ClusterController clusterconfig.ClusterControllerConfiguration
-	MachineController machineconfig.MachineControllerConfiguration
+	ClusterController   clusterconfig.ClusterControllerConfiguration
+	MachineController   machineconfig.MachineControllerConfiguration
+	LicenseController   licenseconfig.LicenseControllerConfiguration
+	TelemetryController telemetryconfig.TelemetryControllerConfiguration
 }
 
 // CreateConfigFromOptions creates a running configuration instance based
@@ -95,6 +99,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 
 	if err := opts.ClusterController.ApplyTo(&controllerManagerConfig.ClusterController); err != nil {
 		return nil, err
@@ -102,6 +107,12 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.MachineController.ApplyTo(&controllerManagerConfig.MachineController); err != nil {
 		return nil, err
 	}
+	if err := opts.LicenseController.ApplyTo(&controllerManagerConfig.LicenseController); err != nil {
+		return nil, err
+	}
+	if err := opts.TelemetryController.ApplyTo(&controllerManagerConfig.TelemetryController); err != nil {
+		return nil, err
+	}
 
 	return controllerManagerConfig, nil
 }
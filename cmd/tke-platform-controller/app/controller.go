@@ -35,7 +35,10 @@ const (
 
 // ControllersDisabledByDefault configured all controllers that are turned off
 // by default.
-var ControllersDisabledByDefault = sets.NewString()
+var ControllersDisabledByDefault = sets.NewString(
+	// telemetry is opt-in
+	"telemetry",
+)
 
 // KnownControllers returns the known controllers.
 func KnownControllers() []string {
@@ -50,6 +53,10 @@ func NewControllerInitializers() map[string]InitFunc {
 
 	controllers["cluster"] = startClusterController
 	controllers["machine"] = startMachineController
+	controllers["clusteroperation"] = startClusterOperationController
+	controllers["kubeletcsr"] = startKubeletCSRController
+	controllers["license"] = startLicenseController
+	controllers["telemetry"] = startTelemetryController
 	controllers["persistentevent"] = startPersistentEventController
 	controllers["helm"] = startHelmController
 	controllers["tappcontroller"] = startTappControllerController
@@ -59,7 +66,13 @@ func NewControllerInitializers() map[string]InitFunc {
 	controllers["logcollectors"] = startLogCollectorController
 	controllers["prometheus"] = startPrometheusController
 	controllers["ipam"] = startIPAMController
+	controllers["floatingipreservation"] = startFloatingIPReservationController
+	controllers["gpunodeconfig"] = startGPUNodeConfigController
 	controllers["lbcf"] = startLBCFControllerController
+	controllers["egressgateway"] = startEgressGatewayController
+	controllers["metallb"] = startMetalLBController
+	controllers["multus"] = startMultusController
+	controllers["deviceplugin"] = startDevicePluginController
 	return controllers
 }
 
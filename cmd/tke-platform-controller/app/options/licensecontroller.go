@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	licenseconfig "tkestack.io/tke/pkg/platform/controller/license/config"
+)
+
+const (
+	flagLicensePublicKeyFile = "license-public-key-file"
+)
+
+const (
+	configLicensePublicKeyFile = "controller.license_public_key_file"
+)
+
+// LicenseControllerOptions holds the LicenseController options.
+type LicenseControllerOptions struct {
+	*licenseconfig.LicenseControllerConfiguration
+}
+
+// NewLicenseControllerOptions creates a new Options with a default config.
+func NewLicenseControllerOptions() *LicenseControllerOptions {
+	return &LicenseControllerOptions{
+		&licenseconfig.LicenseControllerConfiguration{},
+	}
+}
+
+// AddFlags adds flags related to LicenseController for controller manager to the specified FlagSet.
+func (o *LicenseControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.StringVar(&o.PublicKeyFile, flagLicensePublicKeyFile, o.PublicKeyFile, "The file of PEM encoded Ed25519 public key of the license issuer. The license controller is not started if it is empty")
+	_ = viper.BindPFlag(configLicensePublicKeyFile, fs.Lookup(flagLicensePublicKeyFile))
+}
+
+// ApplyTo fills up LicenseController config with options.
+func (o *LicenseControllerOptions) ApplyTo(cfg *licenseconfig.LicenseControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.PublicKeyFile = o.PublicKeyFile
+
+	return nil
+}
+
+// Validate checks validation of LicenseControllerOptions.
+func (o *LicenseControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	if o.PublicKeyFile != "" {
+		if _, err := os.Stat(o.PublicKeyFile); err != nil {
+			errs = append(errs, fmt.Errorf("--%s: %v", flagLicensePublicKeyFile, err))
+		}
+	}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *LicenseControllerOptions) ApplyFlags() []error {
+	o.PublicKeyFile = viper.GetString(configLicensePublicKeyFile)
+	return nil
+}
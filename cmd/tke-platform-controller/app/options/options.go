@@ -42,8 +42,10 @@ type Options struct {
 	Registry          *apiserveroptions.RegistryOptions
 	FeatureOptions    *FeatureOptions
 
-	ClusterController *ClusterControllerOptions
-	MachineController *MachineControllerOptions
+	ClusterController   *ClusterControllerOptions
+	MachineController   *MachineControllerOptions
+	LicenseController   *LicenseControllerOptions
+	TelemetryController *TelemetryControllerOptions
 }
 
 // NewOptions creates a new Options with a default config.
@@ -57,8 +59,10 @@ func NewOptions(serverName string, allControllers []string, disabledByDefaultCon
 		Registry:          apiserveroptions.NewRegistryOptions(),
 		FeatureOptions:    NewFeatureOptions(),
 
-		ClusterController: NewClusterControllerOptions(),
-		MachineController: NewMachineControllerOptions(),
+		ClusterController:   NewClusterControllerOptions(),
+		MachineController:   NewMachineControllerOptions(),
+		LicenseController:   NewLicenseControllerOptions(),
+		TelemetryController: NewTelemetryControllerOptions(),
 	}
 }
 
@@ -73,6 +77,8 @@ func (o *Options) AddFlags(fs *pflag.FlagSet) {
 	o.FeatureOptions.AddFlags(fs)
 	o.ClusterController.AddFlags(fs)
 	o.MachineController.AddFlags(fs)
+	o.LicenseController.AddFlags(fs)
+	o.TelemetryController.AddFlags(fs)
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -89,6 +95,8 @@ func (o *Options) ApplyFlags() []error {
 	errs = append(errs, o.FeatureOptions.ApplyFlags()...)
 	errs = append(errs, o.ClusterController.ApplyFlags()...)
 	errs = append(errs, o.MachineController.ApplyFlags()...)
+	errs = append(errs, o.LicenseController.ApplyFlags()...)
+	errs = append(errs, o.TelemetryController.ApplyFlags()...)
 
 	return errs
 }
@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package options
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	telemetryconfig "tkestack.io/tke/pkg/platform/controller/telemetry/config"
+)
+
+const (
+	flagTelemetryEndpoint     = "telemetry-endpoint"
+	flagTelemetryReportDir    = "telemetry-report-dir"
+	flagTelemetryReportPeriod = "telemetry-report-period"
+)
+
+const (
+	configTelemetryEndpoint     = "controller.telemetry_endpoint"
+	configTelemetryReportDir    = "controller.telemetry_report_dir"
+	configTelemetryReportPeriod = "controller.telemetry_report_period"
+)
+
+const defaultTelemetryReportPeriod = 24 * time.Hour
+
+// TelemetryControllerOptions holds the TelemetryController options.
+type TelemetryControllerOptions struct {
+	*telemetryconfig.TelemetryControllerConfiguration
+}
+
+// NewTelemetryControllerOptions creates a new Options with a default config.
+func NewTelemetryControllerOptions() *TelemetryControllerOptions {
+	return &TelemetryControllerOptions{
+		&telemetryconfig.TelemetryControllerConfiguration{
+			ReportPeriod: defaultTelemetryReportPeriod,
+		},
+	}
+}
+
+// AddFlags adds flags related to TelemetryController for controller manager to the specified FlagSet.
+func (o *TelemetryControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	if o == nil {
+		return
+	}
+
+	fs.StringVar(&o.Endpoint, flagTelemetryEndpoint, o.Endpoint, "The url which the anonymized usage reports are posted to when the telemetry controller is enabled. Leave it empty to keep the reports local")
+	_ = viper.BindPFlag(configTelemetryEndpoint, fs.Lookup(flagTelemetryEndpoint))
+	fs.StringVar(&o.ReportDir, flagTelemetryReportDir, o.ReportDir, "The local directory which the usage reports are written to when the telemetry controller is enabled")
+	_ = viper.BindPFlag(configTelemetryReportDir, fs.Lookup(flagTelemetryReportDir))
+	fs.DurationVar(&o.ReportPeriod, flagTelemetryReportPeriod, o.ReportPeriod, "The period for generating the usage reports")
+	_ = viper.BindPFlag(configTelemetryReportPeriod, fs.Lookup(flagTelemetryReportPeriod))
+}
+
+// ApplyTo fills up TelemetryController config with options.
+func (o *TelemetryControllerOptions) ApplyTo(cfg *telemetryconfig.TelemetryControllerConfiguration) error {
+	if o == nil {
+		return nil
+	}
+
+	cfg.Endpoint = o.Endpoint
+	cfg.ReportDir = o.ReportDir
+	cfg.ReportPeriod = o.ReportPeriod
+
+	return nil
+}
+
+// Validate checks validation of TelemetryControllerOptions.
+func (o *TelemetryControllerOptions) Validate() []error {
+	if o == nil {
+		return nil
+	}
+
+	errs := []error{}
+	if o.Endpoint != "" {
+		if u, err := url.Parse(o.Endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			errs = append(errs, fmt.Errorf("--%s must be a http or https url", flagTelemetryEndpoint))
+		}
+	}
+	if o.ReportPeriod < time.Hour {
+		errs = append(errs, fmt.Errorf("--%s must be at least 1h", flagTelemetryReportPeriod))
+	}
+	return errs
+}
+
+// ApplyFlags parsing parameters from the command line or configuration file
+// to the options instance.
+func (o *TelemetryControllerOptions) ApplyFlags() []error {
+	o.Endpoint = viper.GetString(configTelemetryEndpoint)
+	o.ReportDir = viper.GetString(configTelemetryReportDir)
+	o.ReportPeriod = viper.GetDuration(configTelemetryReportPeriod)
+	return nil
+}
@@ -19,23 +19,34 @@
 package app
 
 import (
+	"fmt"
 	"net/http"
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/controller/addon/cronhpa"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin"
+	"tkestack.io/tke/pkg/platform/controller/addon/egressgateway"
 	"tkestack.io/tke/pkg/platform/controller/addon/helm"
 	"tkestack.io/tke/pkg/platform/controller/addon/ipam"
 	"tkestack.io/tke/pkg/platform/controller/addon/lbcf"
 	"tkestack.io/tke/pkg/platform/controller/addon/logcollector"
+	"tkestack.io/tke/pkg/platform/controller/addon/metallb"
+	"tkestack.io/tke/pkg/platform/controller/addon/multus"
 	"tkestack.io/tke/pkg/platform/controller/addon/persistentevent"
 	"tkestack.io/tke/pkg/platform/controller/addon/prometheus"
 	"tkestack.io/tke/pkg/platform/controller/addon/storage/csioperator"
 	"tkestack.io/tke/pkg/platform/controller/addon/storage/volumedecorator"
 	"tkestack.io/tke/pkg/platform/controller/addon/tappcontroller"
 	clustercontroller "tkestack.io/tke/pkg/platform/controller/cluster"
+	"tkestack.io/tke/pkg/platform/controller/clusteroperation"
+	"tkestack.io/tke/pkg/platform/controller/gpunodeconfig"
+	"tkestack.io/tke/pkg/platform/controller/kubeletcsr"
+	"tkestack.io/tke/pkg/platform/controller/license"
 	"tkestack.io/tke/pkg/platform/controller/machine"
+	"tkestack.io/tke/pkg/platform/controller/telemetry"
+	"tkestack.io/tke/pkg/util/log"
 )
 
 const (
@@ -50,6 +61,18 @@ const (
 
 	ipamEventSyncPeriod = 5 * time.Minute
 	concurrentIPAMSyncs = 5
+
+	kubeletCSRSyncPeriod      = 30 * time.Second
+	concurrentKubeletCSRSyncs = 5
+
+	licenseSyncPeriod      = 10 * time.Minute
+	concurrentLicenseSyncs = 1
+
+	clusterOperationSyncPeriod      = 5 * time.Minute
+	concurrentClusterOperationSyncs = 5
+
+	gpuNodeConfigSyncPeriod      = 5 * time.Minute
+	concurrentGPUNodeConfigSyncs = 5
 )
 
 func startClusterController(ctx ControllerContext) (http.Handler, bool, error) {
@@ -90,6 +113,76 @@ func startMachineController(ctx ControllerContext) (http.Handler, bool, error) {
 	return nil, true, nil
 }
 
+func startKubeletCSRController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clusters"}] {
+		return nil, false, nil
+	}
+
+	ctrl := kubeletcsr.NewController(
+		ctx.ClientBuilder.ClientOrDie("kubeletcsr-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		kubeletCSRSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentKubeletCSRSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startLicenseController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "licenses"}] {
+		return nil, false, nil
+	}
+
+	cfg := ctx.Config.LicenseController
+	if cfg.PublicKeyFile == "" {
+		log.Warn("License controller is not started without --license-public-key-file")
+		return nil, false, nil
+	}
+	publicKey, err := license.LoadPublicKey(cfg.PublicKeyFile)
+	if err != nil {
+		return nil, false, err
+	}
+
+	ctrl := license.NewController(
+		ctx.ClientBuilder.ClientOrDie("license-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Licenses(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		licenseSyncPeriod,
+		publicKey,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentLicenseSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startTelemetryController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clusters"}] {
+		return nil, false, nil
+	}
+	cfg := ctx.Config.TelemetryController
+	if cfg.Endpoint == "" && cfg.ReportDir == "" {
+		return nil, false, fmt.Errorf("telemetry controller requires --telemetry-endpoint or --telemetry-report-dir")
+	}
+
+	ctrl := telemetry.NewController(
+		ctx.ClientBuilder.ClientOrDie("telemetry-controller").PlatformV1(),
+		ctx.InformerFactory.Platform().V1().Clusters(),
+		cfg,
+	)
+
+	go func() {
+		_ = ctrl.Run(ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
 func startHelmController(ctx ControllerContext) (http.Handler, bool, error) {
 	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "helms"}] {
 		return nil, false, nil
@@ -126,6 +219,61 @@ func startIPAMController(ctx ControllerContext) (http.Handler, bool, error) {
 	return nil, true, nil
 }
 
+func startFloatingIPReservationController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "floatingipreservations"}] {
+		return nil, false, nil
+	}
+
+	ctrl := ipam.NewReservationController(
+		ctx.ClientBuilder.ClientOrDie("floating-ip-reservation-controller"),
+		ctx.InformerFactory.Platform().V1().FloatingIPReservations(),
+		ctx.InformerFactory.Platform().V1().IPAMs(),
+		ipamEventSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentIPAMSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startClusterOperationController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "clusteroperations"}] {
+		return nil, false, nil
+	}
+
+	ctrl := clusteroperation.NewController(
+		ctx.ClientBuilder.ClientOrDie("cluster-operation-controller"),
+		ctx.InformerFactory.Platform().V1().ClusterOperations(),
+		clusterOperationSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentClusterOperationSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startGPUNodeConfigController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "gpunodeconfigs"}] {
+		return nil, false, nil
+	}
+
+	ctrl := gpunodeconfig.NewController(
+		ctx.ClientBuilder.ClientOrDie("gpu-node-config-controller"),
+		ctx.InformerFactory.Platform().V1().GPUNodeConfigs(),
+		gpuNodeConfigSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentGPUNodeConfigSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
 func startPersistentEventController(ctx ControllerContext) (http.Handler, bool, error) {
 	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "persistentevents"}] {
 		return nil, false, nil
@@ -276,3 +424,75 @@ func startLBCFControllerController(ctx ControllerContext) (http.Handler, bool, e
 
 	return nil, true, nil
 }
+
+func startEgressGatewayController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "egressgateways"}] {
+		return nil, false, nil
+	}
+
+	ctrl := egressgateway.NewController(
+		ctx.ClientBuilder.ClientOrDie("egress-gateway-controller"),
+		ctx.InformerFactory.Platform().V1().EgressGateways(),
+		eventSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startMetalLBController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "metallbs"}] {
+		return nil, false, nil
+	}
+
+	ctrl := metallb.NewController(
+		ctx.ClientBuilder.ClientOrDie("metallb-controller"),
+		ctx.InformerFactory.Platform().V1().MetalLBs(),
+		eventSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startMultusController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "multuses"}] {
+		return nil, false, nil
+	}
+
+	ctrl := multus.NewController(
+		ctx.ClientBuilder.ClientOrDie("multus-controller"),
+		ctx.InformerFactory.Platform().V1().Multuses(),
+		eventSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
+
+func startDevicePluginController(ctx ControllerContext) (http.Handler, bool, error) {
+	if !ctx.AvailableResources[schema.GroupVersionResource{Group: platformv1.GroupName, Version: "v1", Resource: "deviceplugins"}] {
+		return nil, false, nil
+	}
+
+	ctrl := deviceplugin.NewController(
+		ctx.ClientBuilder.ClientOrDie("deviceplugin-controller"),
+		ctx.InformerFactory.Platform().V1().DevicePlugins(),
+		eventSyncPeriod,
+	)
+
+	go func() {
+		_ = ctrl.Run(concurrentSyncs, ctx.Stop)
+	}()
+
+	return nil, true, nil
+}
@@ -141,6 +141,7 @@ func CreateConfigFromOptions(serverName string, opts *options.Options) (*Config,
 	if err := opts.Debug.ApplyTo(&controllerManagerConfig.Component.Debugging); err != nil {
 		return nil, err
 	}
+	controllerManagerConfig.Component.ProfilingToken = opts.Debug.ProfilingToken
 
 	if err := opts.Registry.ApplyTo(&controllerManagerConfig.RegistryDefaultConfiguration); err != nil {
 		return nil, err
@@ -19,12 +19,75 @@
 package debug
 
 import (
+	"crypto/subtle"
+	"net/http"
+	"net/http/pprof"
+	"strings"
+
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
 )
 
+// profilingPathPrefix is the path prefix of the profiling endpoints.
+const profilingPathPrefix = "/debug/pprof"
+
 // SetupDebug to setup the generic apiserver by debug options.
+// It must be called after the handler chain is built since the profiling
+// endpoints are protected by the token out of the chain, the requests with
+// the token are never passed to the authentication of the chain.
 func SetupDebug(genericAPIServerConfig *genericapiserver.Config, debugOpts *apiserveroptions.DebugOptions) {
 	genericAPIServerConfig.EnableContentionProfiling = debugOpts.EnableContentionProfiling
 	genericAPIServerConfig.EnableProfiling = debugOpts.EnableProfiling
+	if !debugOpts.EnableProfiling {
+		return
+	}
+	buildHandlerChain := genericAPIServerConfig.BuildHandlerChainFunc
+	token := debugOpts.ProfilingToken
+	genericAPIServerConfig.BuildHandlerChainFunc = func(apiHandler http.Handler, c *genericapiserver.Config) http.Handler {
+		return WithProfilingAuthentication(buildHandlerChain(apiHandler, c), token)
+	}
+}
+
+// WithProfilingAuthentication serves the requests to the profiling endpoints
+// with the bearer token and rejects the ones without it, the other requests
+// are passed to handler. The profiling token is not a credential known to
+// the authenticators of handler, so the profiling requests are served out of
+// handler.
+func WithProfilingAuthentication(handler http.Handler, token string) http.Handler {
+	profiling := profilingHandler()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if !strings.HasPrefix(req.URL.Path, profilingPathPrefix) {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		if !validToken(req, token) {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		profiling.ServeHTTP(w, req)
+	})
+}
+
+// profilingHandler returns the handler of the profiling endpoints which are
+// the same as the ones installed by the generic apiserver.
+func profilingHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(profilingPathPrefix, http.RedirectHandler(profilingPathPrefix+"/", http.StatusMovedPermanently))
+	mux.HandleFunc(profilingPathPrefix+"/", pprof.Index)
+	mux.HandleFunc(profilingPathPrefix+"/profile", pprof.Profile)
+	mux.HandleFunc(profilingPathPrefix+"/symbol", pprof.Symbol)
+	mux.HandleFunc(profilingPathPrefix+"/trace", pprof.Trace)
+	return mux
+}
+
+func validToken(req *http.Request, token string) bool {
+	if token == "" {
+		return false
+	}
+	auth := strings.TrimSpace(req.Header.Get("Authorization"))
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimSpace(parts[1])), []byte(token)) == 1
 }
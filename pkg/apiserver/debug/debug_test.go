@@ -0,0 +1,116 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package debug
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	restclient "k8s.io/client-go/rest"
+	apiserveroptions "tkestack.io/tke/pkg/apiserver/options"
+)
+
+func TestWithProfilingAuthentication(t *testing.T) {
+	handler := WithProfilingAuthentication(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}), "secret")
+	tests := []struct {
+		path  string
+		auth  string
+		token string
+		want  int
+	}{
+		{"/healthz", "", "secret", http.StatusOK},
+		{"/debug/pprof/heap", "", "secret", http.StatusUnauthorized},
+		{"/debug/pprof/heap", "Bearer wrong", "secret", http.StatusUnauthorized},
+		{"/debug/pprof/heap", "Basic secret", "secret", http.StatusUnauthorized},
+		{"/debug/pprof/heap", "Bearer secret", "secret", http.StatusOK},
+		{"/debug/pprof", "bearer secret", "secret", http.StatusMovedPermanently},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.auth != "" {
+			req.Header.Set("Authorization", tt.auth)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s with %q: got %d, want %d", tt.path, tt.auth, w.Code, tt.want)
+		}
+	}
+
+	// an empty token never matches
+	handler = WithProfilingAuthentication(http.NotFoundHandler(), "")
+	req := httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil)
+	req.Header.Set("Authorization", "Bearer ")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("empty token: got %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestSetupDebugHandlerChain(t *testing.T) {
+	config := genericapiserver.NewConfig(serializer.NewCodecFactory(runtime.NewScheme()))
+	config.ExternalAddress = "192.168.10.4:443"
+	config.PublicAddress = net.ParseIP("192.168.10.4")
+	config.LegacyAPIGroupPrefixes = sets.NewString("/api")
+	config.LoopbackClientConfig = &restclient.Config{}
+	// The authenticators of the chain know nothing about the profiling token.
+	config.Authentication.Authenticator = authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+		return nil, false, nil
+	})
+	config.Authorization.Authorizer = authorizerfactory.NewAlwaysDenyAuthorizer()
+	SetupDebug(config, &apiserveroptions.DebugOptions{EnableProfiling: true, ProfilingToken: "secret"})
+
+	s, err := config.Complete(nil).New("test", genericapiserver.NewEmptyDelegate())
+	if err != nil {
+		t.Fatalf("create apiserver: %v", err)
+	}
+	tests := []struct {
+		path string
+		auth string
+		want int
+	}{
+		{"/debug/pprof/", "Bearer secret", http.StatusOK},
+		{"/debug/pprof/heap", "Bearer secret", http.StatusOK},
+		{"/debug/pprof/heap", "Bearer wrong", http.StatusUnauthorized},
+		{"/debug/pprof/heap", "", http.StatusUnauthorized},
+		{"/healthz", "Bearer secret", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		if tt.auth != "" {
+			req.Header.Set("Authorization", tt.auth)
+		}
+		w := httptest.NewRecorder()
+		s.Handler.ServeHTTP(w, req)
+		if w.Code != tt.want {
+			t.Errorf("%s with %q: got %d, want %d", tt.path, tt.auth, w.Code, tt.want)
+		}
+	}
+}
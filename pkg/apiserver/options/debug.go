@@ -19,6 +19,10 @@
 package options
 
 import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 	componentconfig "k8s.io/component-base/config"
@@ -27,17 +31,23 @@ import (
 const (
 	flagDebugProfiling           = "profiling"
 	flagDebugContentionProfiling = "contention-profiling"
+	flagDebugProfilingTokenFile  = "profiling-token-file"
 )
 
 const (
 	configDebugProfiling           = "debug.profiling"
 	configDebugContentionProfiling = "debug.contention_profiling"
+	configDebugProfilingTokenFile  = "debug.profiling_token_file"
 )
 
 // DebugOptions holds the Debugging options.
 type DebugOptions struct {
 	EnableProfiling           bool
 	EnableContentionProfiling bool
+	ProfilingTokenFile        string
+	// ProfilingToken is read from ProfilingTokenFile, which is required as
+	// bearer token to access the profiling endpoints.
+	ProfilingToken string
 }
 
 // NewDebugOptions creates the default DebugOptions object.
@@ -57,6 +67,9 @@ func (o *DebugOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.Bool(flagDebugContentionProfiling, o.EnableContentionProfiling,
 		"Enable lock contention profiling, if profiling is enabled")
 	_ = viper.BindPFlag(configDebugContentionProfiling, fs.Lookup(flagDebugContentionProfiling))
+	fs.String(flagDebugProfilingTokenFile, o.ProfilingTokenFile,
+		"File with the bearer token required to access the profiling endpoints, which is required if profiling is enabled")
+	_ = viper.BindPFlag(configDebugProfilingTokenFile, fs.Lookup(flagDebugProfilingTokenFile))
 }
 
 // ApplyFlags parsing parameters from the command line or configuration file
@@ -66,6 +79,24 @@ func (o *DebugOptions) ApplyFlags() []error {
 
 	o.EnableProfiling = viper.GetBool(configDebugProfiling)
 	o.EnableContentionProfiling = viper.GetBool(configDebugContentionProfiling)
+	o.ProfilingTokenFile = viper.GetString(configDebugProfilingTokenFile)
+
+	if !o.EnableProfiling {
+		return errs
+	}
+	if o.ProfilingTokenFile == "" {
+		errs = append(errs, fmt.Errorf("--%s must be specified if profiling is enabled", flagDebugProfilingTokenFile))
+		return errs
+	}
+	token, err := ioutil.ReadFile(o.ProfilingTokenFile)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to read profiling token file: %v", err))
+		return errs
+	}
+	o.ProfilingToken = strings.TrimSpace(string(token))
+	if o.ProfilingToken == "" {
+		errs = append(errs, fmt.Errorf("profiling token file %s is empty", o.ProfilingTokenFile))
+	}
 
 	return errs
 }
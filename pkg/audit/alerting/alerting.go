@@ -0,0 +1,187 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alerting
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	notifyv1 "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/notify/alert"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// queueSize is the number of the batches of events waiting for
+	// evaluation, the batches are dropped if the queue is full.
+	queueSize      = 1000
+	expireInterval = time.Minute
+	sendTimeout    = 10 * time.Second
+	// maxEvents is the maximum number of the events attached to an alert.
+	maxEvents = 10
+)
+
+// Engine evaluates the rules over the incoming audit events, and sends the
+// alerts fired through the channel of tke-notify.
+type Engine struct {
+	conf   *config.Alerting
+	client notifyv1.NotifyV1Interface
+	rules  []*rule
+	queue  chan []*types.Event
+	stop   chan struct{}
+}
+
+// NewEngine returns the engine evaluating the alert rules.
+func NewEngine(conf *config.Alerting, client notifyv1.NotifyV1Interface) (*Engine, error) {
+	if client == nil {
+		return nil, fmt.Errorf("client of tke-notify-api is not configured")
+	}
+	e := &Engine{
+		conf:   conf,
+		client: client,
+		queue:  make(chan []*types.Event, queueSize),
+		stop:   make(chan struct{}),
+	}
+	for i := range conf.Rules {
+		r, err := newRule(&conf.Rules[i])
+		if err != nil {
+			return nil, err
+		}
+		e.rules = append(e.rules, r)
+	}
+	return e, nil
+}
+
+// Start evaluates the events observed until the engine is stopped.
+func (e *Engine) Start() {
+	go e.run()
+}
+
+// Stop stops evaluating the events.
+func (e *Engine) Stop() {
+	close(e.stop)
+}
+
+// Observe queues the events for evaluation, the events are dropped if the
+// queue is full, so that sinking the events is never blocked.
+func (e *Engine) Observe(events []*types.Event) {
+	if len(events) == 0 {
+		return
+	}
+	select {
+	case e.queue <- events:
+	default:
+		log.Warn("Alerting queue is full, dropped audit events", log.Int("events", len(events)))
+	}
+}
+
+// run evaluates the events in a goroutine, so that the state of the rules
+// needs no locks.
+func (e *Engine) run() {
+	ticker := time.NewTicker(expireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.stop:
+			return
+		case events := <-e.queue:
+			for _, event := range events {
+				e.evaluate(event)
+			}
+		case now := <-ticker.C:
+			for _, r := range e.rules {
+				r.expire(now)
+			}
+		}
+	}
+}
+
+func (e *Engine) evaluate(event *types.Event) {
+	for _, r := range e.rules {
+		if !r.matches(event) {
+			continue
+		}
+		if events, summary := r.evaluate(event); len(events) > 0 {
+			if err := e.send(r, events, summary); err != nil {
+				log.Error("Failed to send audit alert", log.String("rule", r.name), log.Err(err))
+			}
+		}
+	}
+}
+
+// send sends the alert with the latest events attached as a generic alert,
+// the labels and annotations of which are the variables of the template.
+func (e *Engine) send(r *rule, events []*types.Event, summary string) error {
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+	last := events[len(events)-1]
+	data, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	a := alert.GenericAlert{
+		Title:       r.name,
+		Severity:    r.severity,
+		Description: summary,
+		Fingerprint: fmt.Sprintf("%s-%s", r.name, last.AuditID),
+		StartsAt:    time.Unix(0, last.RequestReceivedTimestamp*int64(time.Millisecond)).UTC(),
+		Labels: map[string]string{
+			"rule":        r.name,
+			"ruleType":    r.ruleType,
+			"clusterName": last.ClusterName,
+			"userName":    last.UserName,
+		},
+		Annotations: map[string]string{
+			"summary": summary,
+			"events":  string(data),
+		},
+	}
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	defer cancel()
+	req := e.client.RESTClient().Post().
+		Resource("channels").
+		Name(e.conf.Channel).
+		SubResource("alerts").
+		Param("format", alert.FormatGeneric).
+		SetHeader("Content-Type", "application/json").
+		Body(body)
+	if e.conf.EscalationPolicy != "" {
+		req = req.Param("escalationPolicy", e.conf.EscalationPolicy)
+	} else {
+		req = req.Param("template", e.conf.Template).
+			Param("receivers", strings.Join(e.conf.Receivers, ",")).
+			Param("receiverGroups", strings.Join(e.conf.ReceiverGroups, ","))
+	}
+	if err := req.Do(ctx).Error(); err != nil {
+		return err
+	}
+	log.Info("Sent audit alert", log.String("rule", r.name), log.String("summary", summary))
+	return nil
+}
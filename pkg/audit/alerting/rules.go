@@ -0,0 +1,265 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alerting
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+const (
+	defaultSeverity             = "warning"
+	defaultAuthFailureThreshold = 5
+	defaultAuthFailureWindow    = 5 * time.Minute
+	defaultSourceIPWindow       = 30 * 24 * time.Hour
+)
+
+var defaultPrivilegedRoles = []string{"cluster-admin", "admin"}
+
+// evaluator evaluates the audit events in the order they are received, and
+// returns the events firing an alert with the summary of the alert.
+type evaluator interface {
+	evaluate(event *types.Event) ([]*types.Event, string)
+	// expire forgets the state older than the time.
+	expire(now time.Time)
+}
+
+// rule filters the audit events evaluated by the evaluator of the rule.
+type rule struct {
+	name         string
+	ruleType     string
+	severity     string
+	clusters     sets.String
+	excludeUsers sets.String
+	evaluator
+}
+
+func newRule(conf *config.AlertRule) (*rule, error) {
+	r := &rule{
+		name:         conf.Name,
+		ruleType:     conf.Type,
+		severity:     conf.Severity,
+		clusters:     sets.NewString(conf.ClusterNames...),
+		excludeUsers: sets.NewString(conf.ExcludeUsers...),
+	}
+	if r.severity == "" {
+		r.severity = defaultSeverity
+	}
+	window := time.Duration(conf.WindowSeconds) * time.Second
+	switch conf.Type {
+	case config.AlertRuleClusterScopedDeletion:
+		r.evaluator = &clusterScopedDeletion{resources: sets.NewString(conf.Resources...)}
+	case config.AlertRuleAuthFailures:
+		e := &authFailures{threshold: conf.Threshold, window: window, failures: map[string][]*types.Event{}}
+		if e.threshold <= 0 {
+			e.threshold = defaultAuthFailureThreshold
+		}
+		if e.window <= 0 {
+			e.window = defaultAuthFailureWindow
+		}
+		r.evaluator = e
+	case config.AlertRuleNewSourceIP:
+		e := &newSourceIP{window: window, seen: map[string]map[string]int64{}}
+		if e.window <= 0 {
+			e.window = defaultSourceIPWindow
+		}
+		r.evaluator = e
+	case config.AlertRulePrivilegeEscalation:
+		roles := conf.PrivilegedRoles
+		if len(roles) == 0 {
+			roles = defaultPrivilegedRoles
+		}
+		r.evaluator = &privilegeEscalation{roles: sets.NewString(roles...)}
+	default:
+		return nil, fmt.Errorf("unsupported type %q of alert rule %s", conf.Type, conf.Name)
+	}
+	return r, nil
+}
+
+// matches tests if the event is evaluated by the rule.
+func (r *rule) matches(event *types.Event) bool {
+	if r.clusters.Len() > 0 && !r.clusters.Has(event.ClusterName) {
+		return false
+	}
+	return !r.excludeUsers.Has(event.UserName)
+}
+
+func succeeded(event *types.Event) bool {
+	return event.Code >= 200 && event.Code < 300
+}
+
+// clusterScopedDeletion fires on each deletion of the cluster scoped
+// resources, namespaces are cluster scoped though their events carry the
+// namespace.
+type clusterScopedDeletion struct {
+	resources sets.String
+}
+
+func (r *clusterScopedDeletion) evaluate(event *types.Event) ([]*types.Event, string) {
+	if event.Verb != "delete" && event.Verb != "deletecollection" {
+		return nil, ""
+	}
+	if event.Resource == "" || (event.Namespace != "" && event.Resource != "namespaces") || !succeeded(event) {
+		return nil, ""
+	}
+	if r.resources.Len() > 0 && !r.resources.Has(event.Resource) {
+		return nil, ""
+	}
+	return []*types.Event{event}, fmt.Sprintf("%s %s %s %s of cluster %s", event.UserName, event.Verb, event.Resource, event.Name, event.ClusterName)
+}
+
+func (r *clusterScopedDeletion) expire(time.Time) {}
+
+// authFailures fires when the failures of a user in a cluster reach the
+// threshold in the window, the anonymous failures are keyed by the source
+// ips.
+type authFailures struct {
+	threshold int
+	window    time.Duration
+	failures  map[string][]*types.Event
+}
+
+func (r *authFailures) evaluate(event *types.Event) ([]*types.Event, string) {
+	if event.Code != 401 && event.Code != 403 {
+		return nil, ""
+	}
+	who := event.UserName
+	if who == "" || who == "system:anonymous" {
+		who = event.SourceIPs
+	}
+	key := event.ClusterName + "/" + who
+	since := event.RequestReceivedTimestamp - int64(r.window/time.Millisecond)
+	var events []*types.Event
+	for _, e := range r.failures[key] {
+		if e.RequestReceivedTimestamp > since {
+			events = append(events, e)
+		}
+	}
+	events = append(events, event)
+	if len(events) < r.threshold {
+		r.failures[key] = events
+		return nil, ""
+	}
+	delete(r.failures, key)
+	return events, fmt.Sprintf("%s failed %d requests in %s of cluster %s", who, len(events), r.window, event.ClusterName)
+}
+
+func (r *authFailures) expire(now time.Time) {
+	since := now.Add(-r.window).UnixNano() / int64(time.Millisecond)
+	for key, events := range r.failures {
+		if events[len(events)-1].RequestReceivedTimestamp <= since {
+			delete(r.failures, key)
+		}
+	}
+}
+
+// newSourceIP fires when a user sends requests from a client ip not seen in
+// the window. The users are learned from their first requests without
+// alerting, and the system users are ignored.
+type newSourceIP struct {
+	window time.Duration
+	// seen is the time the client ips of the users are last seen.
+	seen map[string]map[string]int64
+}
+
+func (r *newSourceIP) evaluate(event *types.Event) ([]*types.Event, string) {
+	if event.UserName == "" || strings.HasPrefix(event.UserName, "system:") || event.SourceIPs == "" || !succeeded(event) {
+		return nil, ""
+	}
+	// the first one is the client, the others are the proxies
+	ip := strings.Split(event.SourceIPs, ",")[0]
+	ips, known := r.seen[event.UserName]
+	if !known {
+		r.seen[event.UserName] = map[string]int64{ip: event.RequestReceivedTimestamp}
+		return nil, ""
+	}
+	last, ok := ips[ip]
+	ips[ip] = event.RequestReceivedTimestamp
+	if ok && event.RequestReceivedTimestamp-last <= int64(r.window/time.Millisecond) {
+		return nil, ""
+	}
+	return []*types.Event{event}, fmt.Sprintf("%s sent requests from new ip %s to cluster %s", event.UserName, ip, event.ClusterName)
+}
+
+func (r *newSourceIP) expire(now time.Time) {
+	since := now.Add(-r.window).UnixNano() / int64(time.Millisecond)
+	for user, ips := range r.seen {
+		for ip, last := range ips {
+			if last <= since {
+				delete(ips, ip)
+			}
+		}
+		if len(ips) == 0 {
+			delete(r.seen, user)
+		}
+	}
+}
+
+// privilegeEscalation fires on the bindings to the privileged roles, and the
+// roles granting all verbs on all resources. The objects are only recorded
+// in the events of the RequestResponse audit level.
+type privilegeEscalation struct {
+	roles sets.String
+}
+
+func (r *privilegeEscalation) evaluate(event *types.Event) ([]*types.Event, string) {
+	if (event.Verb != "create" && event.Verb != "update" && event.Verb != "patch") || !succeeded(event) {
+		return nil, ""
+	}
+	// the request object of a patch is the patch, so the response object is
+	// checked too.
+	for _, object := range []string{event.RequestObject, event.ResponseObject} {
+		if object == "" {
+			continue
+		}
+		switch event.Resource {
+		case "clusterrolebindings", "rolebindings":
+			binding := rbacv1.RoleBinding{}
+			if err := json.Unmarshal([]byte(object), &binding); err == nil && r.roles.Has(binding.RoleRef.Name) {
+				return []*types.Event{event}, fmt.Sprintf("%s %s %s %s binding to %s %s of cluster %s",
+					event.UserName, event.Verb, event.Resource, event.Name, binding.RoleRef.Kind, binding.RoleRef.Name, event.ClusterName)
+			}
+		case "clusterroles", "roles":
+			role := rbacv1.Role{}
+			if err := json.Unmarshal([]byte(object), &role); err == nil && grantsAll(role.Rules) {
+				return []*types.Event{event}, fmt.Sprintf("%s %s %s %s granting all verbs on all resources of cluster %s",
+					event.UserName, event.Verb, event.Resource, event.Name, event.ClusterName)
+			}
+		}
+	}
+	return nil, ""
+}
+
+func (r *privilegeEscalation) expire(time.Time) {}
+
+func grantsAll(rules []rbacv1.PolicyRule) bool {
+	for _, rule := range rules {
+		if sets.NewString(rule.Verbs...).Has(rbacv1.VerbAll) && sets.NewString(rule.Resources...).Has(rbacv1.ResourceAll) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alerting
+
+import (
+	"testing"
+	"time"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+func mustRule(t *testing.T, conf *config.AlertRule) *rule {
+	r, err := newRule(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestAuthFailures(t *testing.T) {
+	r := mustRule(t, &config.AlertRule{Name: "auth", Type: config.AlertRuleAuthFailures, Threshold: 3, WindowSeconds: 60})
+	at := func(seconds int64, user string) *types.Event {
+		return &types.Event{UserName: user, ClusterName: "cls-a", Code: 403, RequestReceivedTimestamp: seconds * 1000}
+	}
+	for _, event := range []*types.Event{at(0, "alice"), at(10, "bob"), at(70, "alice"), at(80, "alice")} {
+		if events, _ := r.evaluate(event); len(events) > 0 {
+			t.Fatalf("fired on %v", event)
+		}
+	}
+	events, _ := r.evaluate(at(90, "alice"))
+	if len(events) != 3 {
+		t.Fatalf("expected 3 failures in the window, got %d", len(events))
+	}
+	if events, _ := r.evaluate(at(95, "alice")); len(events) > 0 {
+		t.Fatal("failures are not reset after firing")
+	}
+
+	r.expire(time.Unix(200, 0))
+	if len(r.evaluator.(*authFailures).failures) != 0 {
+		t.Fatal("failures out of the window are not expired")
+	}
+}
+
+func TestNewSourceIP(t *testing.T) {
+	r := mustRule(t, &config.AlertRule{Name: "ip", Type: config.AlertRuleNewSourceIP})
+	from := func(user, ips string) *types.Event {
+		return &types.Event{UserName: user, SourceIPs: ips, Code: 200}
+	}
+	cases := []struct {
+		event *types.Event
+		fired bool
+	}{
+		{from("alice", "10.0.0.1"), false},
+		{from("alice", "10.0.0.1,10.0.0.9"), false},
+		{from("alice", "10.0.0.2"), true},
+		{from("alice", "10.0.0.2"), false},
+		{from("system:serviceaccount:kube-system:default", "10.0.0.3"), false},
+	}
+	for i, c := range cases {
+		if events, _ := r.evaluate(c.event); (len(events) > 0) != c.fired {
+			t.Errorf("case %d: expected fired %v", i, c.fired)
+		}
+	}
+}
+
+func TestPrivilegeEscalation(t *testing.T) {
+	r := mustRule(t, &config.AlertRule{Name: "rbac", Type: config.AlertRulePrivilegeEscalation})
+	cases := []struct {
+		resource string
+		object   string
+		fired    bool
+	}{
+		{"clusterrolebindings", `{"roleRef":{"kind":"ClusterRole","name":"cluster-admin"}}`, true},
+		{"rolebindings", `{"roleRef":{"kind":"ClusterRole","name":"view"}}`, false},
+		{"clusterroles", `{"rules":[{"verbs":["*"],"resources":["*"]}]}`, true},
+		{"roles", `{"rules":[{"verbs":["get"],"resources":["*"]}]}`, false},
+	}
+	for _, c := range cases {
+		event := &types.Event{Verb: "create", Resource: c.resource, RequestObject: c.object, Code: 201}
+		if events, _ := r.evaluate(event); (len(events) > 0) != c.fired {
+			t.Errorf("%s %s: expected fired %v", c.resource, c.object, c.fired)
+		}
+	}
+}
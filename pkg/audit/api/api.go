@@ -26,30 +26,45 @@ import (
 	"fmt"
 	"github.com/emicklei/go-restful"
 	"github.com/fsnotify/fsnotify"
+	"io"
 	"io/ioutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apiserver/pkg/apis/audit"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/klog"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 	"tkestack.io/tke/api/auth"
 	"tkestack.io/tke/api/business"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	"tkestack.io/tke/api/monitor"
 	"tkestack.io/tke/api/notify"
 	"tkestack.io/tke/api/platform"
 	"tkestack.io/tke/api/registry"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/audit/alerting"
 	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
 	auditconfigv1 "tkestack.io/tke/pkg/audit/apis/config/v1"
+	"tkestack.io/tke/pkg/audit/apis/config/validation"
 	"tkestack.io/tke/pkg/audit/config/codec"
 	"tkestack.io/tke/pkg/audit/config/configfiles"
+	"tkestack.io/tke/pkg/audit/project"
+	"tkestack.io/tke/pkg/audit/redaction"
+	"tkestack.io/tke/pkg/audit/retention"
+	"tkestack.io/tke/pkg/audit/sink"
 	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/clickhouse"
 	"tkestack.io/tke/pkg/audit/storage/es"
+	"tkestack.io/tke/pkg/audit/storage/postgres"
 	"tkestack.io/tke/pkg/audit/storage/types"
 	utilfs "tkestack.io/tke/pkg/util/filesystem"
 	"tkestack.io/tke/pkg/util/log"
@@ -63,6 +78,12 @@ const Version = "v1"
 
 const blockKey = "block-clusters.txt"
 
+// mimeNDJSON is the content type of the audit events shipped as json lines.
+const mimeNDJSON = "application/x-ndjson"
+
+// mimeGzip is the content type of the exported archives of the audit events.
+const mimeGzip = "application/gzip"
+
 // ClusterControlPlane is the cluster name the tkestack control-planes like tke-platform-api will use to report audit events
 const ClusterControlPlane = "control-plane"
 
@@ -74,6 +95,18 @@ var (
 	storeCli      storage.AuditStorage
 	blockClusters sets.String
 	storeConf     auditconfig.Storage
+	retentionConf *auditconfig.Retention
+	pruner        *retention.Pruner
+	alertingConf  *auditconfig.Alerting
+	alertEngine   *alerting.Engine
+	notifyClient  notifyversionedclient.NotifyV1Interface
+	redactionConf *auditconfig.Redaction
+	sinkConf      *auditconfig.Sink
+	// redactor is guarded by l as it is replaced when the config changes.
+	redactor       *redaction.Redactor
+	businessClient businessversionedclient.BusinessV1Interface
+	// projectAuthorizer authorizes the queries of the projects to their members.
+	projectAuthorizer *project.Authorizer
 )
 
 func init() {
@@ -123,28 +156,59 @@ func watchEvent(w *fsnotify.Watcher) {
 
 			kc := loadConfig()
 			if kc != nil {
-				var changed = func(a, b *auditconfig.ElasticSearchStorage) bool {
-					if a.Username == b.Username && a.Password == b.Password &&
-						a.ReserveDays == b.ReserveDays && a.Address == b.Address &&
-						a.Indices == b.Indices {
-						return false
-					}
-					return true
-				}
-				if changed(kc.Storage.ElasticSearch, storeConf.ElasticSearch) {
+				if !reflect.DeepEqual(kc.Storage, storeConf) || !reflect.DeepEqual(kc.Retention, retentionConf) {
 					klog.Infof("store config changed: %v", kc.Storage)
-					esCli, err := es.NewStorage(kc.Storage.ElasticSearch)
+					cli, err := newStorage(&kc.Storage)
 					if err != nil {
-						klog.Errorf("failed init es client: %v", err)
+						klog.Errorf("failed init store client: %v", err)
+						continue
+					}
+					p, err := newPruner(cli, kc)
+					if err != nil {
+						klog.Errorf("failed init pruner: %v", err)
+						cli.Stop()
 						continue
 					}
 					storeConf = kc.Storage
+					retentionConf = kc.Retention
+					pruner.Stop()
 					storeCli.Stop()
-					storeCli = esCli
+					storeCli = cli
 					storeCli.Start()
+					pruner = p
+					pruner.Start()
 				} else {
 					klog.Infof("store config not changed")
 				}
+				if !reflect.DeepEqual(kc.Redaction, redactionConf) {
+					klog.Infof("redaction config changed")
+					if kc.Redaction != nil {
+						if err := validation.ValidateRedaction(kc.Redaction); err != nil {
+							klog.Errorf("invalid redaction config: %v", err)
+							kc.Redaction = redactionConf
+						}
+					}
+					redactionConf = kc.Redaction
+					l.Lock()
+					redactor = redaction.New(redactionConf)
+					l.Unlock()
+				}
+				if !reflect.DeepEqual(kc.Alerting, alertingConf) {
+					klog.Infof("alerting config changed")
+					e, err := newAlertEngine(kc.Alerting)
+					if err != nil {
+						klog.Errorf("failed init alert engine: %v", err)
+					} else {
+						alertingConf = kc.Alerting
+						if alertEngine != nil {
+							alertEngine.Stop()
+						}
+						alertEngine = e
+						if alertEngine != nil {
+							alertEngine.Start()
+						}
+					}
+				}
 			} else {
 				klog.Errorf("load store config failed")
 			}
@@ -185,8 +249,17 @@ func loadBlockClusters() []string {
 
 // RegisterRoute is used to register prefix path routing matches for all
 // configured backend components.
-func RegisterRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration) error {
-	return registerAuditRoute(container, cfg)
+func RegisterRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration,
+	notifyCli notifyversionedclient.NotifyV1Interface, businessCli businessversionedclient.BusinessV1Interface,
+	authCli authversionedclient.AuthV1Interface) error {
+	notifyClient = notifyCli
+	businessClient = businessCli
+	projectAuthorizer = project.NewAuthorizer(authCli, businessCli)
+	if err := registerAuditRoute(container, cfg); err != nil {
+		return err
+	}
+	registerProjectRoute(container)
+	return nil
 }
 
 func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConfiguration) error {
@@ -196,15 +269,35 @@ func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConf
 	ws.Consumes(restful.MIME_JSON, "text/csv")
 	var err error
 	storeConf = cfg.Storage
-	storeCli, err = es.NewStorage(cfg.Storage.ElasticSearch)
+	storeCli, err = newStorage(&cfg.Storage)
 	if err != nil {
 		return err
 	}
 	storeCli.Start()
+	retentionConf = cfg.Retention
+	pruner, err = newPruner(storeCli, cfg)
+	if err != nil {
+		return err
+	}
+	pruner.Start()
+	alertingConf = cfg.Alerting
+	alertEngine, err = newAlertEngine(cfg.Alerting)
+	if err != nil {
+		return err
+	}
+	if alertEngine != nil {
+		alertEngine.Start()
+	}
+	redactionConf = cfg.Redaction
+	redactor = redaction.New(cfg.Redaction)
+	sinkConf = cfg.Sink
+	if sinkConf == nil {
+		log.Warn("Sink of audit is not configured, the audit events sent to the sink are rejected")
+	}
 	ws.Route(ws.POST("/sink/{clusterName}").To(sinkEvents).
 		Operation("createEventsByCluster").
 		Doc("Create new audit events").
-		Consumes(restful.MIME_JSON).
+		Consumes(restful.MIME_JSON, mimeNDJSON).
 		Produces(restful.MIME_JSON))
 	ws.Route(ws.GET("/list").To(listEvents).
 		Operation("listEvents").
@@ -246,20 +339,100 @@ func registerAuditRoute(container *restful.Container, cfg *auditconfig.AuditConf
 		Doc("download audit events").
 		Consumes(restful.MIME_JSON).
 		Produces(restful.MIME_JSON, "text/csv"))
+	ws.Route(ws.GET("/export").To(exportEvents).
+		Operation("exportEvents").
+		Doc("export audit events of the time range as gzip compressed json lines").
+		Param(restful.QueryParameter("startTime", "start of the time range in milliseconds")).
+		Param(restful.QueryParameter("endTime", "end of the time range in milliseconds")).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON, mimeGzip))
 	container.Add(ws)
 	return nil
 }
 
+// registerProjectRoute registers the queries scoped to the namespaces of a
+// project, which are authorized to the members of the project instead of
+// tke-auth.
+func registerProjectRoute(container *restful.Container) {
+	ws := new(restful.WebService)
+	ws.Path(fmt.Sprintf("/apis/%s/%s/projects/{projectName}/events", GroupName, Version))
+	ws.Produces(restful.MIME_JSON)
+	ws.Consumes(restful.MIME_JSON)
+	ws.Route(ws.GET("/list").To(listProjectEvents).
+		Operation("listProjectEvents").
+		Doc("list audit events of the namespaces of the project").
+		Param(restful.PathParameter("projectName", "name of the project")).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON))
+	ws.Route(ws.GET("/export").To(exportProjectEvents).
+		Operation("exportProjectEvents").
+		Doc("export audit events of the namespaces of the project as gzip compressed json lines").
+		Param(restful.PathParameter("projectName", "name of the project")).
+		Param(restful.QueryParameter("startTime", "start of the time range in milliseconds")).
+		Param(restful.QueryParameter("endTime", "end of the time range in milliseconds")).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON, mimeGzip))
+	container.Add(ws)
+}
+
+// newStorage returns the client of the backend set in the storage config.
+func newStorage(store *auditconfig.Storage) (storage.AuditStorage, error) {
+	if err := validation.ValidateStorage(store); err != nil {
+		return nil, err
+	}
+	switch {
+	case store.ClickHouse != nil:
+		return clickhouse.NewStorage(store.ClickHouse)
+	case store.PostgreSQL != nil:
+		return postgres.NewStorage(store.PostgreSQL)
+	default:
+		return es.NewStorage(store.ElasticSearch)
+	}
+}
+
+// newPruner returns the pruner of the audit events in the storage.
+func newPruner(cli storage.AuditStorage, conf *auditconfig.AuditConfiguration) (*retention.Pruner, error) {
+	if conf.Retention != nil {
+		if err := validation.ValidateRetention(conf.Retention); err != nil {
+			return nil, err
+		}
+	}
+	return retention.NewPruner(cli, k8sClient, conf)
+}
+
+// newAlertEngine returns the engine alerting on the audit events, or nil if
+// the alerting is not configured.
+func newAlertEngine(conf *auditconfig.Alerting) (*alerting.Engine, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	if err := validation.ValidateAlerting(conf); err != nil {
+		return nil, err
+	}
+	return alerting.NewEngine(conf, notifyClient)
+}
+
 func sinkEvents(request *restful.Request, response *restful.Response) {
-	var eventList audit.EventList
-	err := request.ReadEntity(&eventList)
+	clusterName := request.PathParameter("clusterName")
+	if !sink.Authenticated(sinkConf, request.Request, clusterName) {
+		log.Infof("unauthorized sink request of cluster %s from %s", clusterName, request.Request.RemoteAddr)
+		response.WriteHeader(http.StatusUnauthorized)
+		response.Write([]byte("unauthorized"))
+		return
+	}
+	body := http.MaxBytesReader(response.ResponseWriter, request.Request.Body, sink.MaxRequestBytes(sinkConf))
+	items, err := readEvents(body)
 	if err != nil {
 		log.Infof("failed read events: %v", err)
+		if sink.IsRequestTooLarge(err) {
+			response.WriteHeader(http.StatusRequestEntityTooLarge)
+		} else {
+			response.WriteHeader(http.StatusBadRequest)
+		}
 		response.Write([]byte("failed"))
 		return
 	}
-	clusterName := request.PathParameter("clusterName")
-	events := types.ConvertEvents(eventList.Items)
+	events := types.ConvertEvents(items)
 	for _, event := range events {
 		event.ClusterName = clusterName
 	}
@@ -267,11 +440,68 @@ func sinkEvents(request *restful.Request, response *restful.Response) {
 	err = storeCli.Save(events)
 	if err != nil {
 		log.Errorf("failed save events: %v", err)
+		// the apiserver webhook and the log shippers retry on server errors
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte("failed"))
+		return
+	}
+	if alertEngine != nil {
+		alertEngine.Observe(events)
 	}
 	response.Write([]byte("success"))
 
 }
 
+// readEvents reads the audit events of the body, which is either an EventList
+// sent by the apiserver webhook, or the events shipped from the audit log
+// files as a json array or json lines.
+func readEvents(body io.Reader) ([]audit.Event, error) {
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+	var raws []json.RawMessage
+	if bytes.HasPrefix(data, []byte("[")) {
+		if err := json.Unmarshal(data, &raws); err != nil {
+			return nil, err
+		}
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		for decoder.More() {
+			var raw json.RawMessage
+			if err := decoder.Decode(&raw); err != nil {
+				return nil, err
+			}
+			raws = append(raws, raw)
+		}
+	}
+
+	var events []audit.Event
+	for _, raw := range raws {
+		var list struct {
+			Items json.RawMessage `json:"items"`
+		}
+		if err := json.Unmarshal(raw, &list); err != nil {
+			return nil, err
+		}
+		if list.Items != nil {
+			var eventList audit.EventList
+			if err := json.Unmarshal(raw, &eventList); err != nil {
+				return nil, err
+			}
+			events = append(events, eventList.Items...)
+			continue
+		}
+		var event audit.Event
+		if err := json.Unmarshal(raw, &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
 type filterFunc func(e *types.Event) bool
 
 func controlPlaneFilter(e *types.Event) bool {
@@ -380,16 +610,95 @@ func parseQueryParam(request *restful.Request) *storage.QueryParameter {
 }
 
 func listEvents(request *restful.Request, response *restful.Response) {
-	params := parseQueryParam(request)
+	queryEvents(response, parseQueryParam(request), false)
+}
+
+// queryEvents writes the events of the query redacted, the project tells if
+// the query is scoped to a project.
+func queryEvents(response *restful.Response, params *storage.QueryParameter, project bool) {
+	r := getRedactor()
+	if params.Query != "" && !r.Searchable(project) {
+		writeStatusResponse(response, errSearchRedacted)
+		return
+	}
 	events, total, err := storeCli.Query(params)
 	if err != nil {
 		log.Errorf("failed to query events: %v", err)
 		writeStatusResponse(response, err)
 	} else {
+		r.RedactAll(events, project)
 		response.WriteEntity(Pagination{ResultStatus: ResultStatus{Code: 0, Message: ""}, Total: total, Items: events})
 	}
 }
 
+// errSearchRedacted is returned for the full text search which would reveal
+// the values redacted.
+var errSearchRedacted = fmt.Errorf("full text search is not allowed on the redacted fields")
+
+func getRedactor() *redaction.Redactor {
+	l.RLock()
+	defer l.RUnlock()
+	return redactor
+}
+
+func listProjectEvents(request *restful.Request, response *restful.Response) {
+	params, err := projectQueryParam(request)
+	if err != nil {
+		writeProjectError(response, err)
+		return
+	}
+	if len(params.Scopes) == 0 {
+		response.WriteEntity(Pagination{ResultStatus: ResultStatus{Code: 0, Message: ""}, Items: []*types.Event{}})
+		return
+	}
+	queryEvents(response, params, true)
+}
+
+func exportProjectEvents(request *restful.Request, response *restful.Response) {
+	params, err := projectQueryParam(request)
+	if err != nil {
+		writeProjectError(response, err)
+		return
+	}
+	if len(params.Scopes) == 0 {
+		writeStatusResponse(response, fmt.Errorf("project %s has no namespaces", request.PathParameter("projectName")))
+		return
+	}
+	export(request, response, params, true)
+}
+
+func writeProjectError(response *restful.Response, err error) {
+	if err == project.ErrForbidden {
+		response.WriteHeaderAndEntity(http.StatusForbidden, ResultStatus{Code: -1, Message: err.Error()})
+		return
+	}
+	writeStatusResponse(response, err)
+}
+
+// projectQueryParam authorizes the user to the project, and returns the query
+// parameter scoped to the namespaces of the project.
+func projectQueryParam(request *restful.Request) (*storage.QueryParameter, error) {
+	ctx := request.Request.Context()
+	projectName := request.PathParameter("projectName")
+	userName, tenantID := authentication.UsernameAndTenantID(ctx)
+	if _, err := projectAuthorizer.Authorize(ctx, userName, tenantID, projectName); err != nil {
+		return nil, err
+	}
+
+	namespaces, err := businessClient.Namespaces(projectName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	params := parseQueryParam(request)
+	for _, namespace := range namespaces.Items {
+		params.Scopes = append(params.Scopes, storage.Scope{
+			ClusterName: namespace.Spec.ClusterName,
+			Namespace:   namespace.Spec.Namespace,
+		})
+	}
+	return params, nil
+}
+
 func blockClusterAudit(request *restful.Request, response *restful.Response) {
 	clusterName := request.QueryParameter("clustername")
 	if clusterName == "" {
@@ -460,15 +769,12 @@ func listBlockClusters(request *restful.Request, response *restful.Response) {
 func configStore(request *restful.Request, response *restful.Response) {
 	store := auditconfig.Storage{}
 	request.ReadEntity(&store)
-	if store.ElasticSearch == nil {
-		writeStatusResponse(response, fmt.Errorf("store config not set"))
-		return
-	}
-	_, err := es.NewStorage(store.ElasticSearch)
+	cli, err := newStorage(&store)
 	if err != nil {
 		writeStatusResponse(response, err)
 		return
 	}
+	cli.Stop()
 	cm, err := k8sClient.CoreV1().ConfigMaps("tke").Get(context.Background(), "tke-audit-api", metav1.GetOptions{})
 	if err != nil {
 		writeStatusResponse(response, err)
@@ -476,6 +782,9 @@ func configStore(request *restful.Request, response *restful.Response) {
 	}
 	conf := auditconfig.AuditConfiguration{}
 	conf.Storage = store
+	conf.Retention = retentionConf
+	conf.Alerting = alertingConf
+	conf.Redaction = redactionConf
 	data, err := codec.EncodeAuditConfig(&conf, auditconfigv1.SchemeGroupVersion)
 	if err != nil {
 		writeStatusResponse(response, err)
@@ -492,20 +801,29 @@ func configStore(request *restful.Request, response *restful.Response) {
 }
 
 func getStoreConfig(request *restful.Request, response *restful.Response) {
-	conf := storeConf
-	conf.ElasticSearch.Username = "***"
-	conf.ElasticSearch.Password = "***"
+	conf := storeConf.DeepCopy()
+	if conf.ElasticSearch != nil {
+		conf.ElasticSearch.Username = "***"
+		conf.ElasticSearch.Password = "***"
+	}
+	if conf.ClickHouse != nil {
+		conf.ClickHouse.Username = "***"
+		conf.ClickHouse.Password = "***"
+	}
+	if conf.PostgreSQL != nil {
+		conf.PostgreSQL.Username = "***"
+		conf.PostgreSQL.Password = "***"
+	}
 	response.WriteAsJson(conf)
 }
 
 func testStoreConfig(request *restful.Request, response *restful.Response) {
 	store := auditconfig.Storage{}
 	request.ReadEntity(&store)
-	if store.ElasticSearch == nil {
-		writeStatusResponse(response, fmt.Errorf("store config not set"))
-		return
+	cli, err := newStorage(&store)
+	if err == nil {
+		cli.Stop()
 	}
-	_, err := es.NewStorage(store.ElasticSearch)
 	writeStatusResponse(response, err)
 }
 
@@ -515,12 +833,18 @@ func downloadEvents(request *restful.Request, response *restful.Response) {
 	if params.Size == 10 {
 		params.Size = 10000
 	}
+	r := getRedactor()
+	if params.Query != "" && !r.Searchable(false) {
+		writeStatusResponse(response, errSearchRedacted)
+		return
+	}
 	events, _, err := storeCli.Query(params)
 	if err != nil {
 		log.Errorf("failed to query events: %v", err)
 		writeStatusResponse(response, err)
 	} else {
 		log.Infof("get %d events", len(events))
+		r.RedactAll(events, false)
 		b := &bytes.Buffer{}
 		b.WriteString("\xEF\xBB\xBF") // UTF-8 BOM
 		w := csv.NewWriter(b)
@@ -553,12 +877,75 @@ func downloadEvents(request *restful.Request, response *restful.Response) {
 	}
 }
 
+// exportEvents streams the events of the time range matching the filters as
+// an archive of gzip compressed json lines.
+func exportEvents(request *restful.Request, response *restful.Response) {
+	export(request, response, parseQueryParam(request), false)
+}
+
+func export(request *restful.Request, response *restful.Response, params *storage.QueryParameter, project bool) {
+	if params.StartTime <= 0 || params.EndTime <= 0 || params.StartTime > params.EndTime {
+		writeStatusResponse(response, fmt.Errorf("startTime and endTime of the time range must be specified"))
+		return
+	}
+	r := getRedactor()
+	if params.Query != "" && !r.Searchable(project) {
+		writeStatusResponse(response, errSearchRedacted)
+		return
+	}
+	userName := ""
+	if user, ok := genericapirequest.UserFrom(request.Request.Context()); ok {
+		userName = user.GetName()
+	}
+	w := &attachmentWriter{
+		response: response,
+		filename: fmt.Sprintf("auditevents_%d_%d%s", params.StartTime, params.EndTime, retention.ArchiveExtension),
+	}
+	count, err := retention.Export(w, r.Storage(storeCli, project), params)
+	if err != nil {
+		log.Errorf("failed to export events: %v", err)
+		// the archive is truncated if the error occurs after it is sent
+		if !w.written {
+			writeStatusResponse(response, err)
+		}
+		return
+	}
+	log.Infof("user %q exported %d events between %d and %d", userName, count, params.StartTime, params.EndTime)
+}
+
+// attachmentWriter sets the headers of the attachment before the first write.
+type attachmentWriter struct {
+	response *restful.Response
+	filename string
+	written  bool
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.response.AddHeader("Content-Description", "File Transfer")
+		w.response.AddHeader("Content-Disposition", fmt.Sprintf("attachment; filename=%s", w.filename))
+		w.response.AddHeader("Content-Type", mimeGzip)
+	}
+	return w.response.Write(p)
+}
+
 func listFieldValues(request *restful.Request, response *restful.Response) {
 	result := storeCli.FieldValues()
 	response.WriteEntity(result)
 
 }
 
+// IgnoredAuthzPathPrefixes returns a list of path prefixes that does not need to
+// go through the built-in authorization middleware of apiserver, the queries
+// of the projects are authorized to the members of the projects bound in
+// tke-auth-api.
+func IgnoredAuthzPathPrefixes() []string {
+	return []string{
+		fmt.Sprintf("/apis/%s/%s/projects/", GroupName, Version),
+	}
+}
+
 // IgnoredAuthPathPrefixes returns a list of path prefixes that does not need to
 // go through the built-in authentication and authorization middleware of apiserver.
 func IgnoredAuthPathPrefixes() []string {
@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package api
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReadEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		wantIDs  []string
+		wantVerb string
+		wantErr  bool
+	}{
+		{
+			name: "event list",
+			body: `{"kind":"EventList","apiVersion":"audit.k8s.io/v1","items":[
+				{"auditID":"a1","verb":"create","requestURI":"/api/v1/namespaces/default/pods"},
+				{"auditID":"a2","verb":"create","requestURI":"/api/v1/namespaces/default/pods"}]}`,
+			wantIDs:  []string{"a1", "a2"},
+			wantVerb: "create",
+		},
+		{
+			name: "json array",
+			body: `[
+				{"kind":"Event","auditID":"a1","verb":"delete"},
+				{"kind":"Event","auditID":"a2","verb":"delete"}
+			]`,
+			wantIDs:  []string{"a1", "a2"},
+			wantVerb: "delete",
+		},
+		{
+			name: "json lines",
+			body: `{"kind":"Event","auditID":"a1","verb":"update"}
+{"kind":"Event","auditID":"a2","verb":"update"}
+
+{"kind":"Event","auditID":"a3","verb":"update"}
+`,
+			wantIDs:  []string{"a1", "a2", "a3"},
+			wantVerb: "update",
+		},
+		{
+			name: "event lists in json lines",
+			body: `{"kind":"EventList","items":[{"auditID":"a1","verb":"get"}]}
+{"kind":"EventList","items":[{"auditID":"a2","verb":"get"}]}`,
+			wantIDs:  []string{"a1", "a2"},
+			wantVerb: "get",
+		},
+		{
+			name: "empty",
+			body: "  \n",
+		},
+		{
+			name:    "invalid json lines",
+			body:    `{"auditID":"a1"}` + "\n" + `{"auditID":`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json array",
+			body:    `[{"auditID":"a1"},`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := readEvents(strings.NewReader(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(events) != len(tt.wantIDs) {
+				t.Fatalf("readEvents() = %d events, want %d", len(events), len(tt.wantIDs))
+			}
+			for i, event := range events {
+				if string(event.AuditID) != tt.wantIDs[i] || event.Verb != tt.wantVerb {
+					t.Errorf("event %d = %s %s, want %s %s", i, event.AuditID, event.Verb, tt.wantIDs[i], tt.wantVerb)
+				}
+			}
+		})
+	}
+}
@@ -29,10 +29,30 @@ type AuditConfiguration struct {
 	metav1.TypeMeta
 
 	Storage Storage `json:"storage"`
+	// Retention prunes the audit events older than the retention days, the
+	// reserve days of the storage is used if not set.
+	// +optional
+	Retention *Retention `json:"retention,omitempty"`
+	// Alerting evaluates the rules over the incoming audit events.
+	// +optional
+	Alerting *Alerting `json:"alerting,omitempty"`
+	// Redaction masks the sensitive fields of the audit events queried.
+	// +optional
+	Redaction *Redaction `json:"redaction,omitempty"`
+	// Sink authenticates the audit events sent by the apiservers of the
+	// clusters and the log shippers, the events are rejected if not set.
+	// +optional
+	Sink *Sink `json:"sink,omitempty"`
 }
 
+// Storage is the backend storing the audit events, exactly one of the fields
+// is set.
 type Storage struct {
 	ElasticSearch *ElasticSearchStorage `json:"elasticSearch"`
+	// +optional
+	ClickHouse *ClickHouseStorage `json:"clickHouse,omitempty"`
+	// +optional
+	PostgreSQL *PostgreSQLStorage `json:"postgreSQL,omitempty"`
 }
 
 type ElasticSearchStorage struct {
@@ -46,3 +66,167 @@ type ElasticSearchStorage struct {
 	// +optional
 	Password string `json:"password"`
 }
+
+// ClickHouseStorage stores the audit events in a table of ClickHouse through
+// its http interface.
+type ClickHouseStorage struct {
+	// Address is the address of the http interface, such as
+	// http://clickhouse:8123.
+	Address string `json:"address"`
+	// +optional
+	Database string `json:"database"`
+	// +optional
+	Table string `json:"table"`
+	// +optional
+	ReserveDays int `json:"reserveDays"`
+	// +optional
+	Username string `json:"username"`
+	// Password is encoded in base64.
+	// +optional
+	Password string `json:"password"`
+}
+
+// PostgreSQLStorage stores the audit events in a table of PostgreSQL.
+type PostgreSQLStorage struct {
+	// Address is the host and port of the server, such as postgres:5432.
+	Address string `json:"address"`
+	// +optional
+	Database string `json:"database"`
+	// +optional
+	Table string `json:"table"`
+	// SSLMode is one of disable, require, verify-ca and verify-full.
+	// +optional
+	SSLMode string `json:"sslMode"`
+	// +optional
+	ReserveDays int `json:"reserveDays"`
+	// +optional
+	Username string `json:"username"`
+	// Password is encoded in base64.
+	// +optional
+	Password string `json:"password"`
+}
+
+// Retention is how long the audit events are kept, the events of the clusters
+// matching a policy are kept for the days of the first matching policy, the
+// others are kept for Days.
+type Retention struct {
+	// Days defaults to the reserve days of the storage.
+	// +optional
+	Days int `json:"days"`
+	// +optional
+	Policies []RetentionPolicy `json:"policies,omitempty"`
+	// Archive exports the audit events to object storage before they are
+	// pruned.
+	// +optional
+	Archive *Archive `json:"archive,omitempty"`
+}
+
+// RetentionPolicy keeps the audit events of the clusters for the days.
+type RetentionPolicy struct {
+	ClusterNames []string `json:"clusterNames"`
+	Days         int      `json:"days"`
+}
+
+// Archive is the S3 compatible object storage the pruned audit events are
+// exported to as gzip compressed json lines.
+type Archive struct {
+	// Endpoint is the address of the object storage, such as
+	// https://cos.ap-guangzhou.myqcloud.com.
+	Endpoint string `json:"endpoint"`
+	// +optional
+	Region string `json:"region"`
+	Bucket string `json:"bucket"`
+	// Prefix is prepended to the keys of the archived objects.
+	// +optional
+	Prefix      string `json:"prefix"`
+	AccessKeyID string `json:"accessKeyID"`
+	// SecretAccessKey is encoded in base64.
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
+// Alerting sends the alerts fired by the rules through the channel of
+// tke-notify, the alerts are routed to the receivers with the template, or
+// escalated by the escalation policy.
+type Alerting struct {
+	Channel string `json:"channel"`
+	// +optional
+	Template string `json:"template,omitempty"`
+	// +optional
+	Receivers []string `json:"receivers,omitempty"`
+	// +optional
+	ReceiverGroups []string `json:"receiverGroups,omitempty"`
+	// +optional
+	EscalationPolicy string      `json:"escalationPolicy,omitempty"`
+	Rules            []AlertRule `json:"rules"`
+}
+
+// AlertRule fires alerts on the audit events matching the type of the rule.
+type AlertRule struct {
+	Name string `json:"name"`
+	// Type is one of ClusterScopedDeletion, AuthFailures, NewSourceIP and
+	// PrivilegeEscalation.
+	Type string `json:"type"`
+	// Severity defaults to warning.
+	// +optional
+	Severity string `json:"severity,omitempty"`
+	// ClusterNames limits the rule to the events of the clusters.
+	// +optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+	// ExcludeUsers are the users whose events are ignored by the rule.
+	// +optional
+	ExcludeUsers []string `json:"excludeUsers,omitempty"`
+	// Resources limits ClusterScopedDeletion to the resources.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// Threshold is the number of the failures of a user in the window which
+	// fires AuthFailures. Defaults to 5.
+	// +optional
+	Threshold int `json:"threshold,omitempty"`
+	// WindowSeconds is the window of AuthFailures which defaults to 5
+	// minutes, and how long the source ips of a user are remembered by
+	// NewSourceIP which defaults to 30 days.
+	// +optional
+	WindowSeconds int `json:"windowSeconds,omitempty"`
+	// PrivilegedRoles are the roles which binding to is a privilege
+	// escalation. Defaults to cluster-admin and admin.
+	// +optional
+	PrivilegedRoles []string `json:"privilegedRoles,omitempty"`
+}
+
+// Sink is the secret the bearer tokens of the clusters sending the audit
+// events are derived from, and the limit of the size of the requests.
+type Sink struct {
+	// Token is the secret the bearer token of each cluster is derived from,
+	// the token of a cluster is only accepted for the events of the cluster.
+	Token string `json:"token"`
+	// MaxRequestBytes limits the size of the body of a request. Defaults to
+	// 10 MiB.
+	// +optional
+	MaxRequestBytes int64 `json:"maxRequestBytes,omitempty"`
+}
+
+// Redaction masks the sensitive fields of the audit events returned by the
+// queries and exports, the stored events are not changed.
+type Redaction struct {
+	Rules []RedactionRule `json:"rules"`
+}
+
+// RedactionRule masks the fields of the events, and the values of the keys
+// in the request and response objects.
+type RedactionRule struct {
+	// Scope is All or Project, the rule applies to the queries scoped to
+	// the projects only if it is Project. Defaults to All.
+	// +optional
+	Scope string `json:"scope,omitempty"`
+	// Resources limits the rule to the events of the resources.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+	// Fields are the fields of the events masked, which are requestObject,
+	// responseObject, requestURI, sourceIPs, userAgent, message and details.
+	// +optional
+	Fields []string `json:"fields,omitempty"`
+	// Keys are the keys in the request and response objects whose values are
+	// masked at any depth, such as token and password.
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+}
@@ -37,6 +37,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AlertRule)(nil), (*config.AlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AlertRule_To_config_AlertRule(a.(*AlertRule), b.(*config.AlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.AlertRule)(nil), (*AlertRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_AlertRule_To_v1_AlertRule(a.(*config.AlertRule), b.(*AlertRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Alerting)(nil), (*config.Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Alerting_To_config_Alerting(a.(*Alerting), b.(*config.Alerting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Alerting)(nil), (*Alerting)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Alerting_To_v1_Alerting(a.(*config.Alerting), b.(*Alerting), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Archive)(nil), (*config.Archive)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Archive_To_config_Archive(a.(*Archive), b.(*config.Archive), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Archive)(nil), (*Archive)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Archive_To_v1_Archive(a.(*config.Archive), b.(*Archive), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuditConfiguration)(nil), (*config.AuditConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AuditConfiguration_To_config_AuditConfiguration(a.(*AuditConfiguration), b.(*config.AuditConfiguration), scope)
 	}); err != nil {
@@ -47,6 +77,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClickHouseStorage)(nil), (*config.ClickHouseStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage(a.(*ClickHouseStorage), b.(*config.ClickHouseStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClickHouseStorage)(nil), (*ClickHouseStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage(a.(*config.ClickHouseStorage), b.(*ClickHouseStorage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ElasticSearchStorage)(nil), (*config.ElasticSearchStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ElasticSearchStorage_To_config_ElasticSearchStorage(a.(*ElasticSearchStorage), b.(*config.ElasticSearchStorage), scope)
 	}); err != nil {
@@ -57,6 +97,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PostgreSQLStorage)(nil), (*config.PostgreSQLStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PostgreSQLStorage_To_config_PostgreSQLStorage(a.(*PostgreSQLStorage), b.(*config.PostgreSQLStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PostgreSQLStorage)(nil), (*PostgreSQLStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PostgreSQLStorage_To_v1_PostgreSQLStorage(a.(*config.PostgreSQLStorage), b.(*PostgreSQLStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Redaction)(nil), (*config.Redaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Redaction_To_config_Redaction(a.(*Redaction), b.(*config.Redaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Redaction)(nil), (*Redaction)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Redaction_To_v1_Redaction(a.(*config.Redaction), b.(*Redaction), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RedactionRule)(nil), (*config.RedactionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RedactionRule_To_config_RedactionRule(a.(*RedactionRule), b.(*config.RedactionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RedactionRule)(nil), (*RedactionRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RedactionRule_To_v1_RedactionRule(a.(*config.RedactionRule), b.(*RedactionRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Retention)(nil), (*config.Retention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Retention_To_config_Retention(a.(*Retention), b.(*config.Retention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Retention)(nil), (*Retention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Retention_To_v1_Retention(a.(*config.Retention), b.(*Retention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RetentionPolicy)(nil), (*config.RetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_RetentionPolicy_To_config_RetentionPolicy(a.(*RetentionPolicy), b.(*config.RetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.RetentionPolicy)(nil), (*RetentionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_RetentionPolicy_To_v1_RetentionPolicy(a.(*config.RetentionPolicy), b.(*RetentionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Sink)(nil), (*config.Sink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Sink_To_config_Sink(a.(*Sink), b.(*config.Sink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Sink)(nil), (*Sink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Sink_To_v1_Sink(a.(*config.Sink), b.(*Sink), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*config.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Storage_To_config_Storage(a.(*Storage), b.(*config.Storage), scope)
 	}); err != nil {
@@ -70,10 +170,110 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_AlertRule_To_config_AlertRule(in *AlertRule, out *config.AlertRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Severity = in.Severity
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ExcludeUsers = *(*[]string)(unsafe.Pointer(&in.ExcludeUsers))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Threshold = in.Threshold
+	out.WindowSeconds = in.WindowSeconds
+	out.PrivilegedRoles = *(*[]string)(unsafe.Pointer(&in.PrivilegedRoles))
+	return nil
+}
+
+// Convert_v1_AlertRule_To_config_AlertRule is an autogenerated conversion function.
+func Convert_v1_AlertRule_To_config_AlertRule(in *AlertRule, out *config.AlertRule, s conversion.Scope) error {
+	return autoConvert_v1_AlertRule_To_config_AlertRule(in, out, s)
+}
+
+func autoConvert_config_AlertRule_To_v1_AlertRule(in *config.AlertRule, out *AlertRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Type = in.Type
+	out.Severity = in.Severity
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.ExcludeUsers = *(*[]string)(unsafe.Pointer(&in.ExcludeUsers))
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Threshold = in.Threshold
+	out.WindowSeconds = in.WindowSeconds
+	out.PrivilegedRoles = *(*[]string)(unsafe.Pointer(&in.PrivilegedRoles))
+	return nil
+}
+
+// Convert_config_AlertRule_To_v1_AlertRule is an autogenerated conversion function.
+func Convert_config_AlertRule_To_v1_AlertRule(in *config.AlertRule, out *AlertRule, s conversion.Scope) error {
+	return autoConvert_config_AlertRule_To_v1_AlertRule(in, out, s)
+}
+
+func autoConvert_v1_Alerting_To_config_Alerting(in *Alerting, out *config.Alerting, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	out.EscalationPolicy = in.EscalationPolicy
+	out.Rules = *(*[]config.AlertRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_Alerting_To_config_Alerting is an autogenerated conversion function.
+func Convert_v1_Alerting_To_config_Alerting(in *Alerting, out *config.Alerting, s conversion.Scope) error {
+	return autoConvert_v1_Alerting_To_config_Alerting(in, out, s)
+}
+
+func autoConvert_config_Alerting_To_v1_Alerting(in *config.Alerting, out *Alerting, s conversion.Scope) error {
+	out.Channel = in.Channel
+	out.Template = in.Template
+	out.Receivers = *(*[]string)(unsafe.Pointer(&in.Receivers))
+	out.ReceiverGroups = *(*[]string)(unsafe.Pointer(&in.ReceiverGroups))
+	out.EscalationPolicy = in.EscalationPolicy
+	out.Rules = *(*[]AlertRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_config_Alerting_To_v1_Alerting is an autogenerated conversion function.
+func Convert_config_Alerting_To_v1_Alerting(in *config.Alerting, out *Alerting, s conversion.Scope) error {
+	return autoConvert_config_Alerting_To_v1_Alerting(in, out, s)
+}
+
+func autoConvert_v1_Archive_To_config_Archive(in *Archive, out *config.Archive, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	out.SecretAccessKey = in.SecretAccessKey
+	return nil
+}
+
+// Convert_v1_Archive_To_config_Archive is an autogenerated conversion function.
+func Convert_v1_Archive_To_config_Archive(in *Archive, out *config.Archive, s conversion.Scope) error {
+	return autoConvert_v1_Archive_To_config_Archive(in, out, s)
+}
+
+func autoConvert_config_Archive_To_v1_Archive(in *config.Archive, out *Archive, s conversion.Scope) error {
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.Bucket = in.Bucket
+	out.Prefix = in.Prefix
+	out.AccessKeyID = in.AccessKeyID
+	out.SecretAccessKey = in.SecretAccessKey
+	return nil
+}
+
+// Convert_config_Archive_To_v1_Archive is an autogenerated conversion function.
+func Convert_config_Archive_To_v1_Archive(in *config.Archive, out *Archive, s conversion.Scope) error {
+	return autoConvert_config_Archive_To_v1_Archive(in, out, s)
+}
+
 func autoConvert_v1_AuditConfiguration_To_config_AuditConfiguration(in *AuditConfiguration, out *config.AuditConfiguration, s conversion.Scope) error {
 	if err := Convert_v1_Storage_To_config_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Retention = (*config.Retention)(unsafe.Pointer(in.Retention))
+	out.Alerting = (*config.Alerting)(unsafe.Pointer(in.Alerting))
+	out.Redaction = (*config.Redaction)(unsafe.Pointer(in.Redaction))
+	out.Sink = (*config.Sink)(unsafe.Pointer(in.Sink))
 	return nil
 }
 
@@ -86,6 +286,10 @@ func autoConvert_config_AuditConfiguration_To_v1_AuditConfiguration(in *config.A
 	if err := Convert_config_Storage_To_v1_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Retention = (*Retention)(unsafe.Pointer(in.Retention))
+	out.Alerting = (*Alerting)(unsafe.Pointer(in.Alerting))
+	out.Redaction = (*Redaction)(unsafe.Pointer(in.Redaction))
+	out.Sink = (*Sink)(unsafe.Pointer(in.Sink))
 	return nil
 }
 
@@ -94,6 +298,36 @@ func Convert_config_AuditConfiguration_To_v1_AuditConfiguration(in *config.Audit
 	return autoConvert_config_AuditConfiguration_To_v1_AuditConfiguration(in, out, s)
 }
 
+func autoConvert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in *ClickHouseStorage, out *config.ClickHouseStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage is an autogenerated conversion function.
+func Convert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in *ClickHouseStorage, out *config.ClickHouseStorage, s conversion.Scope) error {
+	return autoConvert_v1_ClickHouseStorage_To_config_ClickHouseStorage(in, out, s)
+}
+
+func autoConvert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in *config.ClickHouseStorage, out *ClickHouseStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage is an autogenerated conversion function.
+func Convert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in *config.ClickHouseStorage, out *ClickHouseStorage, s conversion.Scope) error {
+	return autoConvert_config_ClickHouseStorage_To_v1_ClickHouseStorage(in, out, s)
+}
+
 func autoConvert_v1_ElasticSearchStorage_To_config_ElasticSearchStorage(in *ElasticSearchStorage, out *config.ElasticSearchStorage, s conversion.Scope) error {
 	out.Address = in.Address
 	out.Indices = in.Indices
@@ -122,8 +356,156 @@ func Convert_config_ElasticSearchStorage_To_v1_ElasticSearchStorage(in *config.E
 	return autoConvert_config_ElasticSearchStorage_To_v1_ElasticSearchStorage(in, out, s)
 }
 
+func autoConvert_v1_PostgreSQLStorage_To_config_PostgreSQLStorage(in *PostgreSQLStorage, out *config.PostgreSQLStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.SSLMode = in.SSLMode
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_v1_PostgreSQLStorage_To_config_PostgreSQLStorage is an autogenerated conversion function.
+func Convert_v1_PostgreSQLStorage_To_config_PostgreSQLStorage(in *PostgreSQLStorage, out *config.PostgreSQLStorage, s conversion.Scope) error {
+	return autoConvert_v1_PostgreSQLStorage_To_config_PostgreSQLStorage(in, out, s)
+}
+
+func autoConvert_config_PostgreSQLStorage_To_v1_PostgreSQLStorage(in *config.PostgreSQLStorage, out *PostgreSQLStorage, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Database = in.Database
+	out.Table = in.Table
+	out.SSLMode = in.SSLMode
+	out.ReserveDays = in.ReserveDays
+	out.Username = in.Username
+	out.Password = in.Password
+	return nil
+}
+
+// Convert_config_PostgreSQLStorage_To_v1_PostgreSQLStorage is an autogenerated conversion function.
+func Convert_config_PostgreSQLStorage_To_v1_PostgreSQLStorage(in *config.PostgreSQLStorage, out *PostgreSQLStorage, s conversion.Scope) error {
+	return autoConvert_config_PostgreSQLStorage_To_v1_PostgreSQLStorage(in, out, s)
+}
+
+func autoConvert_v1_Redaction_To_config_Redaction(in *Redaction, out *config.Redaction, s conversion.Scope) error {
+	out.Rules = *(*[]config.RedactionRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_Redaction_To_config_Redaction is an autogenerated conversion function.
+func Convert_v1_Redaction_To_config_Redaction(in *Redaction, out *config.Redaction, s conversion.Scope) error {
+	return autoConvert_v1_Redaction_To_config_Redaction(in, out, s)
+}
+
+func autoConvert_config_Redaction_To_v1_Redaction(in *config.Redaction, out *Redaction, s conversion.Scope) error {
+	out.Rules = *(*[]RedactionRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_config_Redaction_To_v1_Redaction is an autogenerated conversion function.
+func Convert_config_Redaction_To_v1_Redaction(in *config.Redaction, out *Redaction, s conversion.Scope) error {
+	return autoConvert_config_Redaction_To_v1_Redaction(in, out, s)
+}
+
+func autoConvert_v1_RedactionRule_To_config_RedactionRule(in *RedactionRule, out *config.RedactionRule, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Fields = *(*[]string)(unsafe.Pointer(&in.Fields))
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_v1_RedactionRule_To_config_RedactionRule is an autogenerated conversion function.
+func Convert_v1_RedactionRule_To_config_RedactionRule(in *RedactionRule, out *config.RedactionRule, s conversion.Scope) error {
+	return autoConvert_v1_RedactionRule_To_config_RedactionRule(in, out, s)
+}
+
+func autoConvert_config_RedactionRule_To_v1_RedactionRule(in *config.RedactionRule, out *RedactionRule, s conversion.Scope) error {
+	out.Scope = in.Scope
+	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.Fields = *(*[]string)(unsafe.Pointer(&in.Fields))
+	out.Keys = *(*[]string)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_config_RedactionRule_To_v1_RedactionRule is an autogenerated conversion function.
+func Convert_config_RedactionRule_To_v1_RedactionRule(in *config.RedactionRule, out *RedactionRule, s conversion.Scope) error {
+	return autoConvert_config_RedactionRule_To_v1_RedactionRule(in, out, s)
+}
+
+func autoConvert_v1_Retention_To_config_Retention(in *Retention, out *config.Retention, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Policies = *(*[]config.RetentionPolicy)(unsafe.Pointer(&in.Policies))
+	out.Archive = (*config.Archive)(unsafe.Pointer(in.Archive))
+	return nil
+}
+
+// Convert_v1_Retention_To_config_Retention is an autogenerated conversion function.
+func Convert_v1_Retention_To_config_Retention(in *Retention, out *config.Retention, s conversion.Scope) error {
+	return autoConvert_v1_Retention_To_config_Retention(in, out, s)
+}
+
+func autoConvert_config_Retention_To_v1_Retention(in *config.Retention, out *Retention, s conversion.Scope) error {
+	out.Days = in.Days
+	out.Policies = *(*[]RetentionPolicy)(unsafe.Pointer(&in.Policies))
+	out.Archive = (*Archive)(unsafe.Pointer(in.Archive))
+	return nil
+}
+
+// Convert_config_Retention_To_v1_Retention is an autogenerated conversion function.
+func Convert_config_Retention_To_v1_Retention(in *config.Retention, out *Retention, s conversion.Scope) error {
+	return autoConvert_config_Retention_To_v1_Retention(in, out, s)
+}
+
+func autoConvert_v1_RetentionPolicy_To_config_RetentionPolicy(in *RetentionPolicy, out *config.RetentionPolicy, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_v1_RetentionPolicy_To_config_RetentionPolicy is an autogenerated conversion function.
+func Convert_v1_RetentionPolicy_To_config_RetentionPolicy(in *RetentionPolicy, out *config.RetentionPolicy, s conversion.Scope) error {
+	return autoConvert_v1_RetentionPolicy_To_config_RetentionPolicy(in, out, s)
+}
+
+func autoConvert_config_RetentionPolicy_To_v1_RetentionPolicy(in *config.RetentionPolicy, out *RetentionPolicy, s conversion.Scope) error {
+	out.ClusterNames = *(*[]string)(unsafe.Pointer(&in.ClusterNames))
+	out.Days = in.Days
+	return nil
+}
+
+// Convert_config_RetentionPolicy_To_v1_RetentionPolicy is an autogenerated conversion function.
+func Convert_config_RetentionPolicy_To_v1_RetentionPolicy(in *config.RetentionPolicy, out *RetentionPolicy, s conversion.Scope) error {
+	return autoConvert_config_RetentionPolicy_To_v1_RetentionPolicy(in, out, s)
+}
+
+func autoConvert_v1_Sink_To_config_Sink(in *Sink, out *config.Sink, s conversion.Scope) error {
+	out.Token = in.Token
+	out.MaxRequestBytes = in.MaxRequestBytes
+	return nil
+}
+
+// Convert_v1_Sink_To_config_Sink is an autogenerated conversion function.
+func Convert_v1_Sink_To_config_Sink(in *Sink, out *config.Sink, s conversion.Scope) error {
+	return autoConvert_v1_Sink_To_config_Sink(in, out, s)
+}
+
+func autoConvert_config_Sink_To_v1_Sink(in *config.Sink, out *Sink, s conversion.Scope) error {
+	out.Token = in.Token
+	out.MaxRequestBytes = in.MaxRequestBytes
+	return nil
+}
+
+// Convert_config_Sink_To_v1_Sink is an autogenerated conversion function.
+func Convert_config_Sink_To_v1_Sink(in *config.Sink, out *Sink, s conversion.Scope) error {
+	return autoConvert_config_Sink_To_v1_Sink(in, out, s)
+}
+
 func autoConvert_v1_Storage_To_config_Storage(in *Storage, out *config.Storage, s conversion.Scope) error {
 	out.ElasticSearch = (*config.ElasticSearchStorage)(unsafe.Pointer(in.ElasticSearch))
+	out.ClickHouse = (*config.ClickHouseStorage)(unsafe.Pointer(in.ClickHouse))
+	out.PostgreSQL = (*config.PostgreSQLStorage)(unsafe.Pointer(in.PostgreSQL))
 	return nil
 }
 
@@ -134,6 +516,8 @@ func Convert_v1_Storage_To_config_Storage(in *Storage, out *config.Storage, s co
 
 func autoConvert_config_Storage_To_v1_Storage(in *config.Storage, out *Storage, s conversion.Scope) error {
 	out.ElasticSearch = (*ElasticSearchStorage)(unsafe.Pointer(in.ElasticSearch))
+	out.ClickHouse = (*ClickHouseStorage)(unsafe.Pointer(in.ClickHouse))
+	out.PostgreSQL = (*PostgreSQLStorage)(unsafe.Pointer(in.PostgreSQL))
 	return nil
 }
 
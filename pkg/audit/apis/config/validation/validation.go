@@ -1,18 +1,202 @@
 package validation
 
 import (
+	"encoding/base64"
+	"regexp"
+
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/pkg/audit/apis/config"
 )
 
+var (
+	identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	sslModes         = sets.NewString("disable", "require", "verify-ca", "verify-full")
+	alertRuleTypes   = sets.NewString(config.AlertRuleClusterScopedDeletion, config.AlertRuleAuthFailures, config.AlertRuleNewSourceIP, config.AlertRulePrivilegeEscalation)
+	redactionScopes  = sets.NewString(config.RedactionScopeAll, config.RedactionScopeProject)
+	redactionFields  = sets.NewString("requestObject", "responseObject", "requestURI", "sourceIPs", "userAgent", "message", "details")
+)
+
 func ValidateAuditConfiguration(ac *config.AuditConfiguration) error {
-	if ac.Storage.ElasticSearch == nil {
-		fld := field.NewPath("components").Child("elasticSearch")
-		return field.Required(fld, "must be specified")
+	if err := ValidateStorage(&ac.Storage); err != nil {
+		return err
+	}
+	if ac.Retention != nil {
+		if err := ValidateRetention(ac.Retention); err != nil {
+			return err
+		}
+	}
+	if ac.Alerting != nil {
+		if err := ValidateAlerting(ac.Alerting); err != nil {
+			return err
+		}
+	}
+	if ac.Redaction != nil {
+		if err := ValidateRedaction(ac.Redaction); err != nil {
+			return err
+		}
+	}
+	if ac.Sink != nil {
+		return ValidateSink(ac.Sink)
+	}
+	return nil
+}
+
+// ValidateStorage tests if exactly one backend of the storage is set and
+// valid.
+func ValidateStorage(storage *config.Storage) error {
+	fldPath := field.NewPath("storage")
+	count := 0
+	for _, set := range []bool{storage.ElasticSearch != nil, storage.ClickHouse != nil, storage.PostgreSQL != nil} {
+		if set {
+			count++
+		}
+	}
+	if count == 0 {
+		return field.Required(fldPath, "must specify one of elasticSearch, clickHouse and postgreSQL")
+	}
+	if count > 1 {
+		return field.Invalid(fldPath, "", "must specify only one of elasticSearch, clickHouse and postgreSQL")
+	}
+
+	switch {
+	case storage.ElasticSearch != nil:
+		if storage.ElasticSearch.Address == "" {
+			return field.Required(fldPath.Child("elasticSearch", "address"), "must be specified")
+		}
+	case storage.ClickHouse != nil:
+		fldPath = fldPath.Child("clickHouse")
+		if storage.ClickHouse.Address == "" {
+			return field.Required(fldPath.Child("address"), "must be specified")
+		}
+		return validateIdentifiers(fldPath, storage.ClickHouse.Database, storage.ClickHouse.Table)
+	case storage.PostgreSQL != nil:
+		fldPath = fldPath.Child("postgreSQL")
+		if storage.PostgreSQL.Address == "" {
+			return field.Required(fldPath.Child("address"), "must be specified")
+		}
+		if storage.PostgreSQL.SSLMode != "" && !sslModes.Has(storage.PostgreSQL.SSLMode) {
+			return field.NotSupported(fldPath.Child("sslMode"), storage.PostgreSQL.SSLMode, sslModes.List())
+		}
+		return validateIdentifiers(fldPath, storage.PostgreSQL.Database, storage.PostgreSQL.Table)
+	}
+	return nil
+}
+
+// validateIdentifiers tests if the database and table are valid identifiers,
+// which are put into the statements as they are.
+func validateIdentifiers(fldPath *field.Path, database, table string) error {
+	if database != "" && !identifierRegexp.MatchString(database) {
+		return field.Invalid(fldPath.Child("database"), database, "must be a valid identifier")
+	}
+	if table != "" && !identifierRegexp.MatchString(table) {
+		return field.Invalid(fldPath.Child("table"), table, "must be a valid identifier")
+	}
+	return nil
+}
+
+// ValidateRetention tests if the days of the retention are positive, and the
+// archive is complete.
+func ValidateRetention(retention *config.Retention) error {
+	fldPath := field.NewPath("retention")
+	if retention.Days < 0 {
+		return field.Invalid(fldPath.Child("days"), retention.Days, "must be greater than or equal to 0")
+	}
+	for i, policy := range retention.Policies {
+		idxPath := fldPath.Child("policies").Index(i)
+		if len(policy.ClusterNames) == 0 {
+			return field.Required(idxPath.Child("clusterNames"), "must be specified")
+		}
+		if policy.Days <= 0 {
+			return field.Invalid(idxPath.Child("days"), policy.Days, "must be greater than 0")
+		}
+	}
+	if archive := retention.Archive; archive != nil {
+		archivePath := fldPath.Child("archive")
+		if archive.Endpoint == "" {
+			return field.Required(archivePath.Child("endpoint"), "must be specified")
+		}
+		if archive.Bucket == "" {
+			return field.Required(archivePath.Child("bucket"), "must be specified")
+		}
+		if archive.AccessKeyID == "" {
+			return field.Required(archivePath.Child("accessKeyID"), "must be specified")
+		}
+		if _, err := base64.StdEncoding.DecodeString(archive.SecretAccessKey); err != nil {
+			return field.Invalid(archivePath.Child("secretAccessKey"), "", "must be encoded in base64")
+		}
+	}
+	return nil
+}
+
+// ValidateAlerting tests if the alerts are routable, and the rules are
+// valid.
+func ValidateAlerting(alerting *config.Alerting) error {
+	fldPath := field.NewPath("alerting")
+	if alerting.Channel == "" {
+		return field.Required(fldPath.Child("channel"), "must be specified")
+	}
+	if alerting.EscalationPolicy == "" {
+		if alerting.Template == "" {
+			return field.Required(fldPath.Child("template"), "must specify template or escalationPolicy")
+		}
+		if len(alerting.Receivers) == 0 && len(alerting.ReceiverGroups) == 0 {
+			return field.Required(fldPath.Child("receivers"), "must specify receivers or receiverGroups")
+		}
+	}
+	names := sets.NewString()
+	for i, rule := range alerting.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+		if rule.Name == "" {
+			return field.Required(idxPath.Child("name"), "must be specified")
+		}
+		if names.Has(rule.Name) {
+			return field.Duplicate(idxPath.Child("name"), rule.Name)
+		}
+		names.Insert(rule.Name)
+		if !alertRuleTypes.Has(rule.Type) {
+			return field.NotSupported(idxPath.Child("type"), rule.Type, alertRuleTypes.List())
+		}
+		if rule.Threshold < 0 {
+			return field.Invalid(idxPath.Child("threshold"), rule.Threshold, "must be greater than or equal to 0")
+		}
+		if rule.WindowSeconds < 0 {
+			return field.Invalid(idxPath.Child("windowSeconds"), rule.WindowSeconds, "must be greater than or equal to 0")
+		}
+	}
+	return nil
+}
+
+// ValidateRedaction tests if the rules mask the supported fields, or the keys
+// of the objects.
+func ValidateRedaction(redaction *config.Redaction) error {
+	fldPath := field.NewPath("redaction")
+	for i, rule := range redaction.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+		if rule.Scope != "" && !redactionScopes.Has(rule.Scope) {
+			return field.NotSupported(idxPath.Child("scope"), rule.Scope, redactionScopes.List())
+		}
+		if len(rule.Fields) == 0 && len(rule.Keys) == 0 {
+			return field.Required(idxPath.Child("fields"), "must specify fields or keys")
+		}
+		for j, f := range rule.Fields {
+			if !redactionFields.Has(f) {
+				return field.NotSupported(idxPath.Child("fields").Index(j), f, redactionFields.List())
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateSink tests if the token of the sink is set, and the limit of the
+// requests is not negative.
+func ValidateSink(sink *config.Sink) error {
+	fldPath := field.NewPath("sink")
+	if sink.Token == "" {
+		return field.Required(fldPath.Child("token"), "must be specified")
 	}
-	if ac.Storage.ElasticSearch.Address == "" {
-		fld := field.NewPath("components").Child("elasticSearch").Child("address")
-		return field.Required(fld, "must be specified")
+	if sink.MaxRequestBytes < 0 {
+		return field.Invalid(fldPath.Child("maxRequestBytes"), sink.MaxRequestBytes, "must be greater than or equal to 0")
 	}
 	return nil
 }
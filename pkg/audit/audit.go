@@ -21,6 +21,9 @@ package audit
 import (
 	"golang.org/x/oauth2"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
 	"tkestack.io/tke/pkg/audit/api"
 	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
 	"tkestack.io/tke/pkg/util/log"
@@ -28,10 +31,13 @@ import (
 
 // ExtraConfig contains the additional configuration of apiserver.
 type ExtraConfig struct {
-	ServerName    string
-	OAuthConfig   *oauth2.Config
-	AuditConfig   *auditconfig.AuditConfiguration
-	HeaderRequest bool
+	ServerName     string
+	OAuthConfig    *oauth2.Config
+	AuditConfig    *auditconfig.AuditConfiguration
+	HeaderRequest  bool
+	NotifyClient   notifyversionedclient.NotifyV1Interface
+	BusinessClient businessversionedclient.BusinessV1Interface
+	AuthClient     authversionedclient.AuthV1Interface
 }
 
 // Config contains the core configuration instance of server and additional
@@ -75,7 +81,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		return nil, err
 	}
 
-	if err := api.RegisterRoute(s.Handler.GoRestfulContainer, c.ExtraConfig.AuditConfig); err != nil {
+	if err := api.RegisterRoute(s.Handler.GoRestfulContainer, c.ExtraConfig.AuditConfig, c.ExtraConfig.NotifyClient, c.ExtraConfig.BusinessClient, c.ExtraConfig.AuthClient); err != nil {
 		return nil, err
 	}
 
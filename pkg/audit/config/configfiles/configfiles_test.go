@@ -57,6 +57,27 @@ storage:
 			}(),
 			"",
 		},
+		{
+			"clickhouse storage",
+			newString(`kind: AuditConfiguration
+apiVersion: audit.config.tkestack.io/v1
+storage:
+  clickHouse:
+    address: http://clickhouse:8123
+    table: events
+    reserveDays: 30
+`),
+			func() *auditconfig.AuditConfiguration {
+				dd := newConfig(t)
+				dd.Storage.ClickHouse = &auditconfig.ClickHouseStorage{
+					Address:     "http://clickhouse:8123",
+					Table:       "events",
+					ReserveDays: 30,
+				}
+				return dd
+			}(),
+			"",
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,147 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	"tkestack.io/tke/pkg/apiserver/filter"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util"
+)
+
+// ErrForbidden is returned if the user is not a member of the project.
+var ErrForbidden = errors.New("only the members of the project can query its audit events")
+
+// Authorizer authorizes the audit queries of the projects to their members,
+// the membership is resolved through tke-auth-api as the members of the
+// projects are bound there.
+type Authorizer struct {
+	authClient     authversionedclient.AuthV1Interface
+	businessClient businessversionedclient.BusinessV1Interface
+}
+
+// NewAuthorizer creates the Authorizer of the audit queries of the projects.
+func NewAuthorizer(authClient authversionedclient.AuthV1Interface, businessClient businessversionedclient.BusinessV1Interface) *Authorizer {
+	return &Authorizer{
+		authClient:     authClient,
+		businessClient: businessClient,
+	}
+}
+
+// Authorize returns the project if the user is a member of it or administrates
+// the platform of its tenant, otherwise ErrForbidden.
+func (a *Authorizer) Authorize(ctx context.Context, userName, tenantID, projectName string) (*businessv1.Project, error) {
+	if a.businessClient == nil {
+		return nil, fmt.Errorf("client of tke-business-api is not configured")
+	}
+	if a.authClient == nil {
+		return nil, fmt.Errorf("client of tke-auth-api is not configured")
+	}
+	if userName == "" {
+		return nil, ErrForbidden
+	}
+	project, err := a.businessClient.Projects().Get(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if tenantID != "" && project.Spec.TenantID != tenantID {
+		return nil, ErrForbidden
+	}
+	tenantID = project.Spec.TenantID
+
+	admin, err := a.isPlatformAdministrator(ctx, userName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if admin {
+		return project, nil
+	}
+
+	user, err := a.getUser(ctx, userName, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrForbidden
+	}
+	if authutil.IsPlatformAdministrator(*user) {
+		return project, nil
+	}
+
+	belongs := &authv1.ProjectBelongs{}
+	if err := a.authClient.RESTClient().Get().
+		Resource("users").
+		Name(user.Name).
+		SubResource("projects").
+		SetHeader(filter.HeaderTenantID, tenantID).
+		Do(ctx).Into(belongs); err != nil {
+		return nil, err
+	}
+	if _, ok := belongs.MemberdProjects[project.Name]; ok {
+		return project, nil
+	}
+	if _, ok := belongs.ManagedProjects[project.Name]; ok {
+		return project, nil
+	}
+	return nil, ErrForbidden
+}
+
+// isPlatformAdministrator tests if the user administrates the platform of the
+// tenant, who can query the events of all the projects of the tenant.
+func (a *Authorizer) isPlatformAdministrator(ctx context.Context, userName, tenantID string) (bool, error) {
+	platforms, err := a.businessClient.Platforms().List(ctx, metav1.ListOptions{FieldSelector: fmt.Sprintf("spec.tenantID=%s", tenantID)})
+	if err != nil {
+		return false, err
+	}
+	for _, item := range platforms.Items {
+		if item.Spec.TenantID == tenantID && util.InStringSlice(item.Spec.Administrators, userName) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getUser returns the user of tke-auth-api named userName, or nil if there
+// is not.
+func (a *Authorizer) getUser(ctx context.Context, userName, tenantID string) (*authv1.User, error) {
+	users, err := a.authClient.Users().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.AndSelectors(
+			fields.OneTermEqualSelector("keyword", userName),
+			fields.OneTermEqualSelector("spec.tenantID", tenantID),
+		).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range users.Items {
+		if users.Items[i].Spec.Name == userName {
+			return &users.Items[i], nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+)
+
+// fakeAuth serves the users and their projects of tke-auth-api.
+type fakeAuth struct {
+	sync.Mutex
+	admins  map[string]bool
+	members map[string]map[string]bool
+}
+
+func (f *fakeAuth) setMember(user, project string, member bool) {
+	f.Lock()
+	defer f.Unlock()
+	if f.members[user] == nil {
+		f.members[user] = map[string]bool{}
+	}
+	f.members[user][project] = member
+}
+
+func (f *fakeAuth) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	switch r.URL.Path {
+	case "/apis/auth.tkestack.io/v1/users":
+		list := &authv1.UserList{TypeMeta: metav1.TypeMeta{APIVersion: "auth.tkestack.io/v1", Kind: "UserList"}}
+		for name := range f.members {
+			user := authv1.User{
+				ObjectMeta: metav1.ObjectMeta{Name: "usr-" + name},
+				Spec:       authv1.UserSpec{Name: name, TenantID: "default"},
+			}
+			if f.admins[name] {
+				user.Spec.Extra = map[string]string{"administrator": "true"}
+			}
+			list.Items = append(list.Items, user)
+		}
+		_ = json.NewEncoder(w).Encode(list)
+	default:
+		for name, projects := range f.members {
+			if r.URL.Path != "/apis/auth.tkestack.io/v1/users/usr-"+name+"/projects" {
+				continue
+			}
+			belongs := &authv1.ProjectBelongs{
+				TypeMeta:        metav1.TypeMeta{APIVersion: "auth.tkestack.io/v1", Kind: "ProjectBelongs"},
+				TenantID:        "default",
+				MemberdProjects: map[string]authv1.ExtraValue{},
+			}
+			for project, member := range projects {
+				if member {
+					belongs.MemberdProjects[project] = authv1.ExtraValue{}
+				}
+			}
+			_ = json.NewEncoder(w).Encode(belongs)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newAuthorizer(t *testing.T, auth *fakeAuth) *Authorizer {
+	server := httptest.NewServer(auth)
+	t.Cleanup(server.Close)
+	authClient, err := authversionedclient.NewForConfig(&rest.Config{Host: server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	businessClient := fake.NewSimpleClientset(
+		&businessv1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "prj-a"},
+			// Members is frozen at creation and must not be trusted.
+			Spec: businessv1.ProjectSpec{TenantID: "default", Members: []string{"alice", "bob"}},
+		},
+		&businessv1.Platform{
+			ObjectMeta: metav1.ObjectMeta{Name: "plat-default"},
+			Spec:       businessv1.PlatformSpec{TenantID: "default", Administrators: []string{"root"}},
+		},
+	).BusinessV1()
+	return NewAuthorizer(authClient, businessClient)
+}
+
+func TestAuthorizeMembership(t *testing.T) {
+	auth := &fakeAuth{admins: map[string]bool{}, members: map[string]map[string]bool{}}
+	auth.setMember("alice", "prj-a", true)
+	auth.setMember("bob", "prj-b", true)
+	auth.setMember("carol", "prj-b", true)
+	a := newAuthorizer(t, auth)
+	ctx := context.Background()
+
+	if _, err := a.Authorize(ctx, "alice", "default", "prj-a"); err != nil {
+		t.Errorf("member alice: got %v, want allowed", err)
+	}
+	// bob is listed in the spec of the project, but not bound in tke-auth.
+	if _, err := a.Authorize(ctx, "bob", "default", "prj-a"); err != ErrForbidden {
+		t.Errorf("non member bob: got %v, want %v", err, ErrForbidden)
+	}
+
+	// Members added after the project was created.
+	auth.setMember("carol", "prj-a", true)
+	if _, err := a.Authorize(ctx, "carol", "default", "prj-a"); err != nil {
+		t.Errorf("added member carol: got %v, want allowed", err)
+	}
+
+	// Members removed after the project was created.
+	auth.setMember("alice", "prj-a", false)
+	if _, err := a.Authorize(ctx, "alice", "default", "prj-a"); err != ErrForbidden {
+		t.Errorf("removed member alice: got %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestAuthorizeAdministrators(t *testing.T) {
+	auth := &fakeAuth{admins: map[string]bool{"dave": true}, members: map[string]map[string]bool{}}
+	auth.setMember("dave", "prj-b", true)
+	a := newAuthorizer(t, auth)
+	ctx := context.Background()
+
+	if _, err := a.Authorize(ctx, "root", "default", "prj-a"); err != nil {
+		t.Errorf("business platform administrator: got %v, want allowed", err)
+	}
+	if _, err := a.Authorize(ctx, "dave", "default", "prj-a"); err != nil {
+		t.Errorf("tke-auth platform administrator: got %v, want allowed", err)
+	}
+	if _, err := a.Authorize(ctx, "root", "other", "prj-a"); err != ErrForbidden {
+		t.Errorf("other tenant: got %v, want %v", err, ErrForbidden)
+	}
+	if _, err := a.Authorize(ctx, "", "default", "prj-a"); err != ErrForbidden {
+		t.Errorf("anonymous: got %v, want %v", err, ErrForbidden)
+	}
+}
+
+func TestAuthorizeWithoutAuthClient(t *testing.T) {
+	a := NewAuthorizer(nil, fake.NewSimpleClientset().BusinessV1())
+	if _, err := a.Authorize(context.Background(), "alice", "default", "prj-a"); err == nil || err == ErrForbidden {
+		t.Errorf("got %v, want the configuration error", err)
+	}
+}
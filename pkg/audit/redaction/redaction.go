@@ -0,0 +1,205 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package redaction
+
+import (
+	"encoding/json"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+// Mask replaces the values redacted.
+const Mask = "***"
+
+// fields are the fields of the events which can be masked.
+var fields = map[string]func(*types.Event) *string{
+	"requestObject":  func(e *types.Event) *string { return &e.RequestObject },
+	"responseObject": func(e *types.Event) *string { return &e.ResponseObject },
+	"requestURI":     func(e *types.Event) *string { return &e.RequestURI },
+	"sourceIPs":      func(e *types.Event) *string { return &e.SourceIPs },
+	"userAgent":      func(e *types.Event) *string { return &e.UserAgent },
+	"message":        func(e *types.Event) *string { return &e.Message },
+	"details":        func(e *types.Event) *string { return &e.Details },
+}
+
+// searchableFields are the fields matched by the full text search.
+var searchableFields = sets.NewString("message", "details", "requestObject", "responseObject")
+
+type rule struct {
+	project   bool
+	resources sets.String
+	fields    []string
+	keys      sets.String
+}
+
+// Redactor masks the sensitive fields of the audit events, a nil Redactor
+// masks nothing.
+type Redactor struct {
+	rules []*rule
+}
+
+// New returns the redactor of the rules.
+func New(conf *config.Redaction) *Redactor {
+	if conf == nil {
+		return nil
+	}
+	r := &Redactor{}
+	for _, c := range conf.Rules {
+		keys := sets.NewString()
+		for _, key := range c.Keys {
+			keys.Insert(strings.ToLower(key))
+		}
+		r.rules = append(r.rules, &rule{
+			project:   c.Scope == config.RedactionScopeProject,
+			resources: sets.NewString(c.Resources...),
+			fields:    c.Fields,
+			keys:      keys,
+		})
+	}
+	return r
+}
+
+// applies tests if the rule applies to the queries, which are scoped to a
+// project or not.
+func (r *rule) applies(project bool) bool {
+	return project || !r.project
+}
+
+// Redact returns the event with the fields masked by the rules applied to the
+// queries, the event is copied if any field is masked.
+func (r *Redactor) Redact(event *types.Event, project bool) *types.Event {
+	if r == nil {
+		return event
+	}
+	redacted := event
+	for _, rule := range r.rules {
+		if !rule.applies(project) || (rule.resources.Len() > 0 && !rule.resources.Has(event.Resource)) {
+			continue
+		}
+		if redacted == event {
+			copied := *event
+			redacted = &copied
+		}
+		for _, f := range rule.fields {
+			if value := fields[f](redacted); *value != "" {
+				*value = Mask
+			}
+		}
+		if rule.keys.Len() > 0 {
+			redacted.RequestObject = maskKeys(redacted.RequestObject, rule.keys)
+			redacted.ResponseObject = maskKeys(redacted.ResponseObject, rule.keys)
+		}
+	}
+	return redacted
+}
+
+// RedactAll redacts the events in place.
+func (r *Redactor) RedactAll(events []*types.Event, project bool) {
+	for i := range events {
+		events[i] = r.Redact(events[i], project)
+	}
+}
+
+// Searchable tests if the full text search is allowed to the queries, which
+// would reveal the values masked otherwise.
+func (r *Redactor) Searchable(project bool) bool {
+	if r == nil {
+		return true
+	}
+	for _, rule := range r.rules {
+		if !rule.applies(project) {
+			continue
+		}
+		if rule.keys.Len() > 0 {
+			return false
+		}
+		for _, f := range rule.fields {
+			if searchableFields.Has(f) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Storage returns the storage whose scanned events are redacted.
+func (r *Redactor) Storage(store storage.AuditStorage, project bool) storage.AuditStorage {
+	if r == nil {
+		return store
+	}
+	return &redactedStorage{AuditStorage: store, redactor: r, project: project}
+}
+
+type redactedStorage struct {
+	storage.AuditStorage
+	redactor *Redactor
+	project  bool
+}
+
+func (s *redactedStorage) Scan(param *storage.QueryParameter, fn func(*types.Event) error) error {
+	return s.AuditStorage.Scan(param, func(event *types.Event) error {
+		return fn(s.redactor.Redact(event, s.project))
+	})
+}
+
+// maskKeys masks the values of the keys in the json object at any depth, the
+// object is masked entirely if it is not valid json.
+func maskKeys(object string, keys sets.String) string {
+	if object == "" {
+		return object
+	}
+	var value interface{}
+	if err := json.Unmarshal([]byte(object), &value); err != nil {
+		return Mask
+	}
+	if !mask(value, keys) {
+		return object
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return Mask
+	}
+	return string(data)
+}
+
+func mask(value interface{}, keys sets.String) bool {
+	masked := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if keys.Has(strings.ToLower(key)) {
+				v[key] = Mask
+				masked = true
+			} else if mask(child, keys) {
+				masked = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if mask(child, keys) {
+				masked = true
+			}
+		}
+	}
+	return masked
+}
@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package redaction
+
+import (
+	"testing"
+
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+func TestRedact(t *testing.T) {
+	r := New(&config.Redaction{
+		Rules: []config.RedactionRule{
+			{Resources: []string{"secrets"}, Fields: []string{"requestObject", "responseObject"}},
+			{Keys: []string{"token", "password"}},
+			{Scope: config.RedactionScopeProject, Fields: []string{"sourceIPs", "userAgent"}},
+		},
+	})
+	event := &types.Event{
+		Resource:      "secrets",
+		RequestObject: `{"data":{"key":"dmFsdWU="}}`,
+		SourceIPs:     "10.0.0.1",
+	}
+	redacted := r.Redact(event, false)
+	if redacted.RequestObject != Mask || redacted.ResponseObject != "" || redacted.SourceIPs != "10.0.0.1" {
+		t.Errorf("unexpected redacted event %+v", redacted)
+	}
+	if event.RequestObject == Mask {
+		t.Error("the original event is modified")
+	}
+	if redacted := r.Redact(event, true); redacted.SourceIPs != Mask {
+		t.Errorf("project rule is not applied: %+v", redacted)
+	}
+
+	event = &types.Event{
+		Resource:       "tokenreviews",
+		RequestObject:  `{"spec":{"Token":"abc"},"users":[{"name":"u","password":"p"}]}`,
+		ResponseObject: `{"status":{"authenticated":true}}`,
+	}
+	redacted = r.Redact(event, false)
+	if redacted.RequestObject != `{"spec":{"Token":"***"},"users":[{"name":"u","password":"***"}]}` {
+		t.Errorf("unexpected request object %s", redacted.RequestObject)
+	}
+	if redacted.ResponseObject != event.ResponseObject {
+		t.Errorf("unexpected response object %s", redacted.ResponseObject)
+	}
+}
+
+func TestSearchable(t *testing.T) {
+	var r *Redactor
+	if !r.Searchable(true) {
+		t.Error("nil redactor is not searchable")
+	}
+	r = New(&config.Redaction{
+		Rules: []config.RedactionRule{
+			{Fields: []string{"sourceIPs"}},
+			{Scope: config.RedactionScopeProject, Fields: []string{"requestObject"}},
+		},
+	})
+	if !r.Searchable(false) {
+		t.Error("expected searchable without project")
+	}
+	if r.Searchable(true) {
+		t.Error("expected not searchable with project")
+	}
+}
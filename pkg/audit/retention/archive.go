@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+// ArchiveExtension is the file extension of the archives of the audit events.
+const ArchiveExtension = ".ndjson.gz"
+
+// Export writes the events matching the parameter to w as gzip compressed
+// json lines in the order of time, and returns the number of events written.
+func Export(w io.Writer, store storage.AuditStorage, param *storage.QueryParameter) (int, error) {
+	gw := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gw)
+	count := 0
+	err := store.Scan(param, func(event *types.Event) error {
+		count++
+		return encoder.Encode(event)
+	})
+	if err != nil {
+		return count, err
+	}
+	return count, gw.Close()
+}
+
+// archiver uploads the archives of the audit events to the S3 compatible
+// object storage.
+type archiver struct {
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func newArchiver(conf *auditconfig.Archive) (*archiver, error) {
+	secret, err := base64.StdEncoding.DecodeString(conf.SecretAccessKey)
+	if err != nil {
+		return nil, fmt.Errorf("decode secret access key failed: %v", err)
+	}
+	config := aws.NewConfig().
+		WithEndpoint(conf.Endpoint).
+		WithRegion(conf.Region).
+		WithS3ForcePathStyle(true).
+		WithCredentials(credentials.NewStaticCredentials(conf.AccessKeyID, string(secret), ""))
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, fmt.Errorf("create s3 session failed: %v", err)
+	}
+	return &archiver{
+		uploader: s3manager.NewUploader(sess),
+		bucket:   conf.Bucket,
+		prefix:   conf.Prefix,
+	}, nil
+}
+
+// archive streams the events matching the parameter to the object of the
+// name, and returns the url of the object.
+func (a *archiver) archive(store storage.AuditStorage, param *storage.QueryParameter, name string) (string, error) {
+	r, w := io.Pipe()
+	go func() {
+		_, err := Export(w, store, param)
+		w.CloseWithError(err)
+	}()
+	// unblock the export if the upload fails
+	defer r.Close()
+
+	key := path.Join(a.prefix, name+ArchiveExtension)
+	_, err := a.uploader.Upload(&s3manager.UploadInput{
+		Bucket:      aws.String(a.bucket),
+		Key:         aws.String(key),
+		Body:        r,
+		ContentType: aws.String("application/gzip"),
+	})
+	if err != nil {
+		return "", fmt.Errorf("upload archive failed: %v", err)
+	}
+	return fmt.Sprintf("s3://%s/%s", a.bucket, key), nil
+}
@@ -0,0 +1,205 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/util/leaderelection"
+	"tkestack.io/tke/pkg/util/leaderelection/resourcelock"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// lockNamespace and lockName are the configmap electing the replica of
+	// tke-audit-api which prunes the audit events.
+	lockNamespace = "tke"
+	lockName      = "tke-audit-api-retention"
+
+	leaseDuration = 60 * time.Second
+	renewDeadline = 40 * time.Second
+	retryPeriod   = 10 * time.Second
+
+	pruneInterval = time.Hour
+	// defaultScope is the scope of the events of the clusters not matching
+	// any policy.
+	defaultScope = "default"
+)
+
+// policy keeps the events matching the parameter for the days.
+type policy struct {
+	scope string
+	days  int
+	param storage.QueryParameter
+}
+
+// Pruner prunes the audit events older than the retention days, and archives
+// them to the object storage before if the archive is set. Only the elected
+// replica of tke-audit-api prunes the events.
+type Pruner struct {
+	store    storage.AuditStorage
+	client   kubernetes.Interface
+	policies []policy
+	archiver *archiver
+	cancel   context.CancelFunc
+}
+
+// NewPruner returns the pruner of the audit events in the storage.
+func NewPruner(store storage.AuditStorage, client kubernetes.Interface, conf *auditconfig.AuditConfiguration) (*Pruner, error) {
+	p := &Pruner{
+		store:  store,
+		client: client,
+	}
+	retention := conf.Retention
+	if retention == nil {
+		retention = &auditconfig.Retention{}
+	}
+
+	days := retention.Days
+	if days <= 0 {
+		days = reserveDays(&conf.Storage)
+	}
+	defaultPolicy := policy{scope: defaultScope, days: days}
+	seen := make(map[string]bool)
+	for _, rp := range retention.Policies {
+		for _, name := range rp.ClusterNames {
+			// the first matching policy wins
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			p.policies = append(p.policies, policy{
+				scope: name,
+				days:  rp.Days,
+				param: storage.QueryParameter{ClusterName: name},
+			})
+			defaultPolicy.param.ExcludeClusterNames = append(defaultPolicy.param.ExcludeClusterNames, name)
+		}
+	}
+	p.policies = append(p.policies, defaultPolicy)
+
+	if retention.Archive != nil {
+		a, err := newArchiver(retention.Archive)
+		if err != nil {
+			return nil, err
+		}
+		p.archiver = a
+	}
+	return p, nil
+}
+
+// reserveDays returns the reserve days of the backend of the storage.
+func reserveDays(store *auditconfig.Storage) int {
+	var days int
+	switch {
+	case store.ElasticSearch != nil:
+		days = store.ElasticSearch.ReserveDays
+	case store.ClickHouse != nil:
+		days = store.ClickHouse.ReserveDays
+	case store.PostgreSQL != nil:
+		days = store.PostgreSQL.ReserveDays
+	}
+	if days <= 0 {
+		days = storage.DefaultReserveDays
+	}
+	return days
+}
+
+// Start campaigns for pruning the audit events until the pruner is stopped.
+func (p *Pruner) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	id, err := os.Hostname()
+	if err != nil {
+		id = "tke-audit-api"
+	}
+	// add a uniquifier so that two processes on the same host don't accidentally both become active
+	id = id + "_" + string(uuid.NewUUID())
+	lock := resourcelock.NewKube(lockNamespace, lockName, p.client.CoreV1(), resourcelock.Config{Identity: id})
+	go wait.Until(func() {
+		leaderelection.RunOrDie(ctx, leaderelection.ElectionConfig{
+			Lock:          lock,
+			LeaseDuration: leaseDuration,
+			RenewDeadline: renewDeadline,
+			RetryPeriod:   retryPeriod,
+			Callbacks: leaderelection.LeaderCallbacks{
+				OnStartedLeading: func(ctx context.Context) {
+					log.Info("Start pruning audit events", log.String("identity", id))
+					wait.Until(p.prune, pruneInterval, ctx.Done())
+				},
+				OnStoppedLeading: func() {
+					log.Info("Stop pruning audit events", log.String("identity", id))
+				},
+			},
+			Name: lockName,
+		})
+	}, retryPeriod, ctx.Done())
+}
+
+// Stop stops pruning the audit events.
+func (p *Pruner) Stop() {
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+func (p *Pruner) prune() {
+	now := time.Now()
+	for _, policy := range p.policies {
+		// the cutoff is aligned to the start of the day, so that the events
+		// of a day are archived and pruned together.
+		year, month, day := now.AddDate(0, 0, -policy.days).Date()
+		cutoff := time.Date(year, month, day, 0, 0, 0, 0, now.Location())
+		param := policy.param
+		param.EndTime = cutoff.UnixNano()/int64(time.Millisecond) - 1
+
+		count := param
+		count.Size = 0
+		_, total, err := p.store.Query(&count)
+		if err != nil {
+			log.Error("Failed to count expired audit events", log.String("scope", policy.scope), log.Err(err))
+			continue
+		}
+		if total == 0 {
+			continue
+		}
+		if p.archiver != nil {
+			url, err := p.archiver.archive(p.store, &param, fmt.Sprintf("%s/%s-%d", policy.scope, cutoff.Format("20060102"), now.Unix()))
+			if err != nil {
+				log.Error("Failed to archive expired audit events", log.String("scope", policy.scope), log.Err(err))
+				continue
+			}
+			log.Info("Archived expired audit events", log.String("scope", policy.scope), log.String("url", url))
+		}
+		if err := p.store.Delete(&param); err != nil {
+			log.Error("Failed to prune expired audit events", log.String("scope", policy.scope), log.Err(err))
+			continue
+		}
+		log.Info("Pruned expired audit events", log.String("scope", policy.scope), log.Int("days", policy.days), log.Int("events", total))
+	}
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+)
+
+type fakeStorage struct {
+	storage.AuditStorage
+	events []*types.Event
+}
+
+func (s *fakeStorage) Scan(param *storage.QueryParameter, fn func(*types.Event) error) error {
+	for _, event := range s.events {
+		if param.ClusterName != "" && event.ClusterName != param.ClusterName {
+			continue
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestNewPruner(t *testing.T) {
+	conf := &auditconfig.AuditConfiguration{
+		Storage: auditconfig.Storage{
+			ElasticSearch: &auditconfig.ElasticSearchStorage{ReserveDays: 30},
+		},
+		Retention: &auditconfig.Retention{
+			Policies: []auditconfig.RetentionPolicy{
+				{ClusterNames: []string{"cls-a", "cls-b"}, Days: 180},
+				{ClusterNames: []string{"cls-b", "cls-c"}, Days: 90},
+			},
+		},
+	}
+	p, err := NewPruner(&fakeStorage{}, nil, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []policy{
+		{scope: "cls-a", days: 180, param: storage.QueryParameter{ClusterName: "cls-a"}},
+		{scope: "cls-b", days: 180, param: storage.QueryParameter{ClusterName: "cls-b"}},
+		{scope: "cls-c", days: 90, param: storage.QueryParameter{ClusterName: "cls-c"}},
+		{scope: defaultScope, days: 30, param: storage.QueryParameter{ExcludeClusterNames: []string{"cls-a", "cls-b", "cls-c"}}},
+	}
+	if !reflect.DeepEqual(p.policies, expected) {
+		t.Errorf("unexpected policies: %+v", p.policies)
+	}
+
+	conf.Retention = nil
+	conf.Storage.ElasticSearch.ReserveDays = 0
+	p, err = NewPruner(&fakeStorage{}, nil, conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []policy{{scope: defaultScope, days: storage.DefaultReserveDays}}
+	if !reflect.DeepEqual(p.policies, expected) {
+		t.Errorf("unexpected policies: %+v", p.policies)
+	}
+}
+
+func TestExport(t *testing.T) {
+	store := &fakeStorage{events: []*types.Event{
+		{AuditID: "1", ClusterName: "cls-a"},
+		{AuditID: "2", ClusterName: "cls-b"},
+		{AuditID: "3", ClusterName: "cls-a"},
+	}}
+	var buf bytes.Buffer
+	count, err := Export(&buf, store, &storage.QueryParameter{ClusterName: "cls-a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 events, got %d", count)
+	}
+
+	r, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder := json.NewDecoder(r)
+	var ids []string
+	for decoder.More() {
+		event := &types.Event{}
+		if err := decoder.Decode(event); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, event.AuditID)
+	}
+	if !reflect.DeepEqual(ids, []string{"1", "3"}) {
+		t.Errorf("unexpected events: %v", ids)
+	}
+}
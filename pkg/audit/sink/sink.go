@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sink
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+)
+
+// DefaultMaxRequestBytes is the default limit of the size of the requests
+// sending the audit events.
+const DefaultMaxRequestBytes = 10 << 20
+
+// ClusterToken returns the bearer token of the cluster sending the audit
+// events, which is derived from the token of the sink. The token is only
+// accepted for the events of the cluster, so that the clusters can't send the
+// events of each other.
+func ClusterToken(sinkToken string, clusterName string) string {
+	mac := hmac.New(sha256.New, []byte(sinkToken))
+	mac.Write([]byte(clusterName))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Authenticated tests if the request carries the token of the cluster which
+// the events are sent for, all the requests are rejected if the sink is not
+// configured.
+func Authenticated(sink *auditconfig.Sink, req *http.Request, clusterName string) bool {
+	if sink == nil || sink.Token == "" || clusterName == "" {
+		return false
+	}
+	parts := strings.SplitN(strings.TrimSpace(req.Header.Get("Authorization")), " ", 2)
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return false
+	}
+	token := strings.TrimSpace(parts[1])
+	return subtle.ConstantTimeCompare([]byte(token), []byte(ClusterToken(sink.Token, clusterName))) == 1
+}
+
+// MaxRequestBytes returns the limit of the size of the body of a request.
+func MaxRequestBytes(sink *auditconfig.Sink) int64 {
+	if sink == nil || sink.MaxRequestBytes <= 0 {
+		return DefaultMaxRequestBytes
+	}
+	return sink.MaxRequestBytes
+}
+
+// IsRequestTooLarge tests if the error is returned by http.MaxBytesReader
+// when the body exceeds the limit.
+func IsRequestTooLarge(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "request body too large")
+}
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sink
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	auditconfig "tkestack.io/tke/pkg/audit/apis/config"
+)
+
+func TestAuthenticated(t *testing.T) {
+	sink := &auditconfig.Sink{Token: "secret"}
+	token := ClusterToken("secret", "cls-a")
+	tests := []struct {
+		name        string
+		sink        *auditconfig.Sink
+		clusterName string
+		auth        string
+		want        bool
+	}{
+		{name: "valid", sink: sink, clusterName: "cls-a", auth: "Bearer " + token, want: true},
+		{name: "lower case scheme", sink: sink, clusterName: "cls-a", auth: "bearer " + token, want: true},
+		{name: "token of other cluster", sink: sink, clusterName: "cls-b", auth: "Bearer " + token},
+		{name: "token of control plane", sink: sink, clusterName: "cls-a", auth: "Bearer " + ClusterToken("secret", "control-plane")},
+		{name: "secret of sink", sink: sink, clusterName: "cls-a", auth: "Bearer secret"},
+		{name: "prefix of token", sink: sink, clusterName: "cls-a", auth: "Bearer " + token[:10]},
+		{name: "basic", sink: sink, clusterName: "cls-a", auth: "Basic " + token},
+		{name: "missing", sink: sink, clusterName: "cls-a"},
+		{name: "missing cluster", sink: sink, auth: "Bearer " + ClusterToken("secret", "")},
+		{name: "not configured", clusterName: "cls-a", auth: "Bearer " + token},
+		{name: "empty token", sink: &auditconfig.Sink{}, clusterName: "cls-a", auth: "Bearer " + ClusterToken("", "cls-a")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/apis/audit.tkestack.io/v1/events/sink/"+tt.clusterName, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			if got := Authenticated(tt.sink, req, tt.clusterName); got != tt.want {
+				t.Errorf("Authenticated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterToken(t *testing.T) {
+	if ClusterToken("secret", "cls-a") != ClusterToken("secret", "cls-a") {
+		t.Error("expected the token of cluster to be stable")
+	}
+	if ClusterToken("secret", "cls-a") == ClusterToken("other", "cls-a") {
+		t.Error("expected the token of cluster to depend on the secret")
+	}
+}
+
+func TestMaxRequestBytes(t *testing.T) {
+	if got := MaxRequestBytes(nil); got != DefaultMaxRequestBytes {
+		t.Errorf("MaxRequestBytes(nil) = %d", got)
+	}
+	if got := MaxRequestBytes(&auditconfig.Sink{MaxRequestBytes: 1024}); got != 1024 {
+		t.Errorf("MaxRequestBytes() = %d, want 1024", got)
+	}
+}
+
+func TestIsRequestTooLarge(t *testing.T) {
+	w := httptest.NewRecorder()
+	body := http.MaxBytesReader(w, ioutil.NopCloser(strings.NewReader(strings.Repeat("x", 16))), 8)
+	_, err := ioutil.ReadAll(body)
+	if !IsRequestTooLarge(err) {
+		t.Errorf("IsRequestTooLarge(%v) = false", err)
+	}
+	if IsRequestTooLarge(nil) {
+		t.Error("IsRequestTooLarge(nil) = true")
+	}
+}
@@ -0,0 +1,382 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clickhouse
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultDatabase = "tke_audit"
+	defaultTable    = "audit_events"
+	batchSize       = 1000
+	// fieldValuesLimit is the maximum number of values listed for a field.
+	fieldValuesLimit = 1000
+)
+
+type clickhouse struct {
+	addr     string
+	database string
+	table    string
+	username string
+	password string
+	client   *http.Client
+	// scanClient has no timeout for streaming the events.
+	scanClient *http.Client
+	stop       chan struct{}
+
+	lock        sync.Mutex
+	fieldValues map[string][]string
+}
+
+// NewStorage connects to the http interface of clickhouse, and migrates the
+// schema of the audit events to the latest version.
+func NewStorage(conf *config.ClickHouseStorage) (storage.AuditStorage, error) {
+	s := &clickhouse{
+		addr:        strings.TrimSuffix(conf.Address, "/"),
+		database:    conf.Database,
+		table:       conf.Table,
+		username:    conf.Username,
+		client:      &http.Client{Timeout: 30 * time.Second},
+		scanClient:  &http.Client{},
+		stop:        make(chan struct{}),
+		fieldValues: map[string][]string{},
+	}
+	if conf.Password != "" {
+		password, err := base64.StdEncoding.DecodeString(conf.Password)
+		if err != nil {
+			return nil, fmt.Errorf("decode password failed: %v", err)
+		}
+		s.password = string(password)
+	}
+	if s.database == "" {
+		s.database = defaultDatabase
+	}
+	if s.table == "" {
+		s.table = defaultTable
+	}
+	if err := s.migrate(); err != nil {
+		return nil, fmt.Errorf("migrate clickhouse failed: %v", err)
+	}
+	return s, nil
+}
+
+func (s *clickhouse) Start() {
+	go wait.Until(s.updateFieldValues, time.Minute, s.stop)
+}
+
+func (s *clickhouse) Stop() {
+	close(s.stop)
+}
+
+// exec sends the query to clickhouse, the params are bound to the
+// placeholders such as {name:String} of the query.
+func (s *clickhouse) exec(query string, params map[string]string, body io.Reader) ([]byte, error) {
+	resp, err := s.do(s.client, query, params, body)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(resp.Body)
+}
+
+// do sends the query to clickhouse by the client, the body of the response
+// is closed by the caller if no error is returned.
+func (s *clickhouse) do(client *http.Client, query string, params map[string]string, body io.Reader) (*http.Response, error) {
+	values := url.Values{
+		"query": {query},
+		// the 64 bit integers are decoded as numbers rather than strings
+		"output_format_json_quote_64bit_integers": {"0"},
+	}
+	for name, value := range params {
+		values.Set("param_"+name, value)
+	}
+	req, err := http.NewRequest(http.MethodPost, s.addr+"/?"+values.Encode(), body)
+	if err != nil {
+		return nil, err
+	}
+	if s.username != "" {
+		req.SetBasicAuth(s.username, s.password)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		data, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("clickhouse returned %d: %s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return resp, nil
+}
+
+func (s *clickhouse) tableName() string {
+	return s.database + "." + s.table
+}
+
+func (s *clickhouse) Save(events []*types.Event) error {
+	for start := 0; start < len(events); start += batchSize {
+		end := start + batchSize
+		if end > len(events) {
+			end = len(events)
+		}
+		if err := s.batchSave(events[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *clickhouse) batchSave(events []*types.Event) error {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, event := range events {
+		row := make(map[string]interface{}, len(storage.Columns))
+		for i, value := range storage.ColumnValues(event) {
+			row[storage.Columns[i].Name] = value
+		}
+		if err := encoder.Encode(row); err != nil {
+			return err
+		}
+	}
+	if _, err := s.exec(fmt.Sprintf("INSERT INTO %s FORMAT JSONEachRow", s.tableName()), nil, &buf); err != nil {
+		return fmt.Errorf("insert events failed: %v", err)
+	}
+	return nil
+}
+
+func (s *clickhouse) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
+	if param == nil {
+		param = &storage.QueryParameter{Size: 10}
+	}
+	where, params := conditions(param)
+
+	total, err := s.count(where, params)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	params["limit"] = strconv.Itoa(param.Size)
+	params["offset"] = strconv.Itoa(param.Offset)
+	data, err := s.exec(fmt.Sprintf("SELECT %s FROM %s%s ORDER BY request_received_timestamp DESC LIMIT {limit:UInt64} OFFSET {offset:UInt64} FORMAT JSONEachRow",
+		selectColumns(), s.tableName(), where), params, nil)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed query events: %v", err)
+	}
+	events := make([]*types.Event, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, len(data)+1)
+	for scanner.Scan() {
+		event := &types.Event{}
+		if err := json.Unmarshal(scanner.Bytes(), event); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+	return events, total, nil
+}
+
+func (s *clickhouse) count(where string, params map[string]string) (int, error) {
+	data, err := s.exec(fmt.Sprintf("SELECT count() FROM %s%s FORMAT TabSeparated", s.tableName(), where), params, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed count events: %v", err)
+	}
+	return strconv.Atoi(strings.TrimSpace(string(data)))
+}
+
+// selectColumns returns the columns selected as the fields of the events.
+func selectColumns() string {
+	columns := make([]string, 0, len(storage.Columns))
+	for _, column := range storage.Columns {
+		columns = append(columns, fmt.Sprintf("%s AS %s", column.Name, column.Field))
+	}
+	return strings.Join(columns, ", ")
+}
+
+// Scan streams the events matching the parameter in the order of time.
+func (s *clickhouse) Scan(param *storage.QueryParameter, fn func(*types.Event) error) error {
+	where, params := conditions(param)
+	resp, err := s.do(s.scanClient, fmt.Sprintf("SELECT %s FROM %s%s ORDER BY request_received_timestamp FORMAT JSONEachRow",
+		selectColumns(), s.tableName(), where), params, nil)
+	if err != nil {
+		return fmt.Errorf("failed scan events: %v", err)
+	}
+	defer resp.Body.Close()
+	decoder := json.NewDecoder(resp.Body)
+	for decoder.More() {
+		event := &types.Event{}
+		if err := decoder.Decode(event); err != nil {
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// conditions returns the where clause of the query parameter and the values
+// of its placeholders.
+func conditions(param *storage.QueryParameter) (string, map[string]string) {
+	var terms []string
+	params := map[string]string{}
+	equal := func(column, value string) {
+		if value != "" {
+			params[column] = value
+			terms = append(terms, fmt.Sprintf("%s = {%s:String}", column, column))
+		}
+	}
+	equal("cluster_name", param.ClusterName)
+	equal("namespace", param.Namespace)
+	equal("name", param.Name)
+	equal("resource", param.Resource)
+	equal("user_name", param.UserName)
+	if len(param.ExcludeClusterNames) > 0 {
+		placeholders := make([]string, 0, len(param.ExcludeClusterNames))
+		for i, name := range param.ExcludeClusterNames {
+			key := fmt.Sprintf("exclude_cluster_name_%d", i)
+			params[key] = name
+			placeholders = append(placeholders, fmt.Sprintf("{%s:String}", key))
+		}
+		terms = append(terms, fmt.Sprintf("cluster_name NOT IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if len(param.Scopes) > 0 {
+		scopes := make([]string, 0, len(param.Scopes))
+		for i, scope := range param.Scopes {
+			cluster, namespace := fmt.Sprintf("scope_cluster_name_%d", i), fmt.Sprintf("scope_namespace_%d", i)
+			params[cluster] = scope.ClusterName
+			params[namespace] = scope.Namespace
+			scopes = append(scopes, fmt.Sprintf("(cluster_name = {%s:String} AND namespace = {%s:String})", cluster, namespace))
+		}
+		terms = append(terms, "("+strings.Join(scopes, " OR ")+")")
+	}
+	if param.StartTime > 0 {
+		params["start_time"] = strconv.FormatInt(param.StartTime, 10)
+		terms = append(terms, "request_received_timestamp >= {start_time:Int64}")
+	}
+	if param.EndTime > 0 {
+		params["end_time"] = strconv.FormatInt(param.EndTime, 10)
+		terms = append(terms, "request_received_timestamp <= {end_time:Int64}")
+	}
+	if param.Query != "" {
+		params["query"] = param.Query
+		var matches []string
+		for _, column := range []string{"message", "details", "request_object", "response_object"} {
+			matches = append(matches, fmt.Sprintf("positionCaseInsensitiveUTF8(%s, {query:String}) > 0", column))
+		}
+		terms = append(terms, "("+strings.Join(matches, " OR ")+")")
+	}
+	if len(terms) == 0 {
+		return "", params
+	}
+	return " WHERE " + strings.Join(terms, " AND "), params
+}
+
+func (s *clickhouse) FieldValues() map[string][]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make(map[string][]string)
+	for _, field := range storage.EnumFields {
+		result[field] = s.fieldValues[field]
+	}
+	return result
+}
+
+func (s *clickhouse) updateFieldValues() {
+	for _, field := range storage.EnumFields {
+		column := storage.ColumnName(field)
+		data, err := s.exec(fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s != '' LIMIT %d FORMAT TabSeparatedRaw", column, s.tableName(), column, fieldValuesLimit), nil, nil)
+		if err != nil {
+			log.Errorf("failed update field %s values: %v", field, err)
+			continue
+		}
+		var values []string
+		for _, value := range strings.Split(string(data), "\n") {
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) > 0 {
+			s.lock.Lock()
+			s.fieldValues[field] = values
+			s.lock.Unlock()
+		}
+	}
+}
+
+// Delete drops the daily partitions before the day of the end time if the
+// parameter matches all the events before the end time, which is much cheaper
+// than deleting the rows, and then deletes the rest rows by a mutation.
+func (s *clickhouse) Delete(param *storage.QueryParameter) error {
+	if param.EndTime > 0 && param.StartTime == 0 && len(param.ExcludeClusterNames) == 0 && len(param.Scopes) == 0 &&
+		param.ClusterName == "" && param.Namespace == "" && param.Name == "" &&
+		param.Resource == "" && param.UserName == "" && param.Query == "" {
+		if err := s.dropPartitions(time.Unix(param.EndTime/1000, 0).Format("20060102")); err != nil {
+			return err
+		}
+	}
+	where, params := conditions(param)
+	total, err := s.count(where, params)
+	if err != nil || total == 0 {
+		return err
+	}
+	if where == "" {
+		where = " WHERE 1"
+	}
+	if _, err := s.exec(fmt.Sprintf("ALTER TABLE %s DELETE%s", s.tableName(), where), params, nil); err != nil {
+		return fmt.Errorf("failed delete events: %v", err)
+	}
+	return nil
+}
+
+// dropPartitions drops the daily partitions before the day.
+func (s *clickhouse) dropPartitions(before string) error {
+	data, err := s.exec("SELECT DISTINCT partition_id FROM system.parts WHERE database = {database:String} AND table = {table:String} AND active AND partition_id < {before:String} FORMAT TabSeparatedRaw",
+		map[string]string{"database": s.database, "table": s.table, "before": before}, nil)
+	if err != nil {
+		return fmt.Errorf("failed list partitions of audit events: %v", err)
+	}
+	for _, partition := range strings.Split(string(data), "\n") {
+		if partition == "" {
+			continue
+		}
+		if _, err := s.exec(fmt.Sprintf("ALTER TABLE %s DROP PARTITION ID '%s'", s.tableName(), partition), nil, nil); err != nil {
+			return fmt.Errorf("failed drop partition %s of audit events: %v", partition, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clickhouse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// migrations are the schema changes of the audit events applied in order,
+// the versions applied are recorded in the migrations table. {{table}} is
+// replaced with the qualified name of the table. Clickhouse has no
+// transactions, so the migrations must be idempotent for the replicas of
+// tke-audit-api applying them at the same time. Never change the applied
+// ones, append a new one instead.
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS {{table}} (
+	audit_id String,
+	stage LowCardinality(String),
+	request_uri String,
+	verb LowCardinality(String),
+	user_name String,
+	user_agent String,
+	resource LowCardinality(String),
+	namespace String,
+	name String,
+	uid String,
+	api_group LowCardinality(String),
+	api_version LowCardinality(String),
+	source_ips String,
+	status LowCardinality(String),
+	message String,
+	reason String,
+	details String,
+	code Int32,
+	request_object String,
+	response_object String,
+	request_received_timestamp Int64,
+	stage_timestamp Int64,
+	cluster_name LowCardinality(String)
+) ENGINE = MergeTree
+PARTITION BY toYYYYMMDD(toDateTime(intDiv(request_received_timestamp, 1000)))
+ORDER BY (cluster_name, request_received_timestamp)`,
+}
+
+// migrate creates the database, and then applies the migrations not applied
+// yet.
+func (s *clickhouse) migrate() error {
+	if _, err := s.exec(fmt.Sprintf("CREATE DATABASE IF NOT EXISTS %s", s.database), nil, nil); err != nil {
+		return err
+	}
+	migrationsTable := s.tableName() + "_migrations"
+	if _, err := s.exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version UInt32, applied_at DateTime DEFAULT now()) ENGINE = MergeTree ORDER BY version", migrationsTable), nil, nil); err != nil {
+		return err
+	}
+	data, err := s.exec(fmt.Sprintf("SELECT max(version) FROM %s FORMAT TabSeparated", migrationsTable), nil, nil)
+	if err != nil {
+		return err
+	}
+	version, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return err
+	}
+	for i := version; i < len(migrations); i++ {
+		log.Infof("apply clickhouse audit migration %d", i+1)
+		if _, err := s.exec(strings.ReplaceAll(migrations[i], "{{table}}", s.tableName()), nil, nil); err != nil {
+			return fmt.Errorf("migration %d: %v", i+1, err)
+		}
+		if _, err := s.exec(fmt.Sprintf("INSERT INTO %s (version) VALUES (%d)", migrationsTable, i+1), nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
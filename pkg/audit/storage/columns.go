@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import "tkestack.io/tke/pkg/audit/storage/types"
+
+// DefaultReserveDays is the default number of days the audit events are kept.
+const DefaultReserveDays = 7
+
+// EnumFields are the fields whose values are listed as the options of the
+// queries.
+var EnumFields = []string{"userName", "clusterName", "namespace", "resource"}
+
+// Column is a column of the audit events in the SQL backends, Field is the
+// json name of the field of the event stored in the column.
+type Column struct {
+	Name  string
+	Field string
+}
+
+// Columns are the columns of the audit events in the SQL backends, in the
+// order of ColumnValues and ColumnPointers.
+var Columns = []Column{
+	{"audit_id", "auditID"},
+	{"stage", "stage"},
+	{"request_uri", "requestURI"},
+	{"verb", "verb"},
+	{"user_name", "userName"},
+	{"user_agent", "userAgent"},
+	{"resource", "resource"},
+	{"namespace", "namespace"},
+	{"name", "name"},
+	{"uid", "uid"},
+	{"api_group", "apiGroup"},
+	{"api_version", "apiVersion"},
+	{"source_ips", "sourceIPs"},
+	{"status", "status"},
+	{"message", "message"},
+	{"reason", "reason"},
+	{"details", "details"},
+	{"code", "code"},
+	{"request_object", "requestObject"},
+	{"response_object", "responseObject"},
+	{"request_received_timestamp", "requestReceivedTimestamp"},
+	{"stage_timestamp", "stageTimestamp"},
+	{"cluster_name", "clusterName"},
+}
+
+// ColumnName returns the column storing the field of the event.
+func ColumnName(field string) string {
+	for _, column := range Columns {
+		if column.Field == field {
+			return column.Name
+		}
+	}
+	return ""
+}
+
+// ColumnValues returns the values of the columns of the event.
+func ColumnValues(e *types.Event) []interface{} {
+	return []interface{}{
+		e.AuditID, e.Stage, e.RequestURI, e.Verb, e.UserName, e.UserAgent,
+		e.Resource, e.Namespace, e.Name, string(e.UID), e.APIGroup, e.APIVersion, e.SourceIPs,
+		e.Status, e.Message, e.Reason, e.Details, e.Code,
+		e.RequestObject, e.ResponseObject,
+		e.RequestReceivedTimestamp, e.StageTimestamp,
+		e.ClusterName,
+	}
+}
+
+// ColumnPointers returns the pointers to the fields of the event, which the
+// values of the columns are scanned into.
+func ColumnPointers(e *types.Event) []interface{} {
+	return []interface{}{
+		&e.AuditID, &e.Stage, &e.RequestURI, &e.Verb, &e.UserName, &e.UserAgent,
+		&e.Resource, &e.Namespace, &e.Name, &e.UID, &e.APIGroup, &e.APIVersion, &e.SourceIPs,
+		&e.Status, &e.Message, &e.Reason, &e.Details, &e.Code,
+		&e.RequestObject, &e.ResponseObject,
+		&e.RequestReceivedTimestamp, &e.StageTimestamp,
+		&e.ClusterName,
+	}
+}
@@ -20,28 +20,29 @@ import (
 const typ = "tke-k8s-audit-event"
 const batchSize = 100
 const defaultIndices = "auditevent"
-const defaultReverveDays = 7
+
+// scrollSize is the number of events fetched by a scroll request.
+const scrollSize = 1000
+const scrollKeepAlive = "1m"
 
 var fieldEnumCache = map[string][]string{}
 var lock sync.Mutex
 
 type es struct {
-	Addr        string
-	Indices     string
-	ReserveDays int
-	username    string
-	password    string
-	v7          bool
-	stop        chan struct{}
+	Addr     string
+	Indices  string
+	username string
+	password string
+	v7       bool
+	stop     chan struct{}
 }
 
 func NewStorage(conf *config.ElasticSearchStorage) (storage.AuditStorage, error) {
 	cli := &es{
-		Addr:        conf.Address,
-		Indices:     conf.Indices,
-		ReserveDays: conf.ReserveDays,
-		username:    conf.Username,
-		stop:        make(chan struct{}),
+		Addr:     conf.Address,
+		Indices:  conf.Indices,
+		username: conf.Username,
+		stop:     make(chan struct{}),
 	}
 	if conf.Password != "" {
 		password, err := base64.StdEncoding.DecodeString(conf.Password)
@@ -53,9 +54,6 @@ func NewStorage(conf *config.ElasticSearchStorage) (storage.AuditStorage, error)
 	if cli.Indices == "" {
 		cli.Indices = defaultIndices
 	}
-	if cli.ReserveDays < 0 {
-		cli.ReserveDays = defaultReverveDays
-	}
 	err := cli.init()
 	if err != nil {
 		return nil, err
@@ -70,7 +68,6 @@ func (s *es) Start() {
 	fieldEnumCache["clusterName"] = []string{}
 	fieldEnumCache["namespace"] = []string{}
 	fieldEnumCache["resource"] = []string{}
-	go wait.Until(s.cleanup, time.Hour, s.stop)
 	go wait.Until(s.updateFieldEnumCache, time.Minute, s.stop)
 }
 
@@ -182,36 +179,6 @@ func (s *es) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
 	if param == nil {
 		param = &storage.QueryParameter{Size: 10}
 	}
-	var terms []interface{}
-
-	if param.ClusterName != "" {
-		terms = append(terms, map[string]map[string]string{"term": {"clusterName": param.ClusterName}})
-	}
-	if param.Namespace != "" {
-		terms = append(terms, map[string]map[string]string{"term": {"namespace": param.Namespace}})
-	}
-	if param.Name != "" {
-		terms = append(terms, map[string]map[string]string{"term": {"name": param.Name}})
-	}
-	if param.Resource != "" {
-		terms = append(terms, map[string]map[string]string{"term": {"resource": param.Resource}})
-	}
-	if param.UserName != "" {
-		terms = append(terms, map[string]map[string]string{"term": {"userName": param.UserName}})
-	}
-	if param.StartTime > 0 && param.EndTime > 0 {
-		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"gte": param.StartTime, "lte": param.EndTime}}})
-	} else if param.EndTime > 0 {
-		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"lte": param.EndTime}}})
-	} else if param.StartTime > 0 {
-		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"gte": param.StartTime}}})
-	}
-	if param.Query != "" {
-		terms = append(terms, map[string]map[string]interface{}{"multi_match": {
-			"query":  param.Query,
-			"fields": []string{"message", "details", "requestObject", "responseObject"},
-		}})
-	}
 	query := map[string]interface{}{
 		"from": param.Offset,
 		"size": param.Size,
@@ -219,16 +186,10 @@ func (s *es) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
 			map[string]string{"requestReceivedTimestamp": "desc"},
 		},
 	}
-	if len(terms) > 0 {
-		query["query"] = map[string]map[string]interface{}{
-			"bool": {"filter": terms},
-		}
-	}
-	url := fmt.Sprintf("%s/%s/%s/_search", s.Addr, s.Indices, typ)
-	if s.v7 {
-		url = fmt.Sprintf("%s/%s/_search", s.Addr, s.Indices)
+	if filter := boolQuery(param); filter != nil {
+		query["query"] = filter
 	}
-	req := gorequest.New().Get(url).SetBasicAuth(s.username, s.password)
+	req := gorequest.New().Get(s.url("_search")).SetBasicAuth(s.username, s.password)
 	req.Header["content-type"] = "application/json"
 	resp, body, errs := req.SendStruct(query).End()
 	if len(errs) > 0 {
@@ -259,6 +220,70 @@ func (s *es) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
 	return events, res.(*Result).Hits.Total, nil
 }
 
+// url returns the url of the api of the indices.
+func (s *es) url(api string) string {
+	if s.v7 {
+		return fmt.Sprintf("%s/%s/%s", s.Addr, s.Indices, api)
+	}
+	return fmt.Sprintf("%s/%s/%s/%s", s.Addr, s.Indices, typ, api)
+}
+
+// boolQuery returns the query of the events matching the parameter, or nil
+// if all events match.
+func boolQuery(param *storage.QueryParameter) map[string]map[string]interface{} {
+	var terms []interface{}
+
+	if param.ClusterName != "" {
+		terms = append(terms, map[string]map[string]string{"term": {"clusterName": param.ClusterName}})
+	}
+	if param.Namespace != "" {
+		terms = append(terms, map[string]map[string]string{"term": {"namespace": param.Namespace}})
+	}
+	if param.Name != "" {
+		terms = append(terms, map[string]map[string]string{"term": {"name": param.Name}})
+	}
+	if param.Resource != "" {
+		terms = append(terms, map[string]map[string]string{"term": {"resource": param.Resource}})
+	}
+	if param.UserName != "" {
+		terms = append(terms, map[string]map[string]string{"term": {"userName": param.UserName}})
+	}
+	if param.StartTime > 0 && param.EndTime > 0 {
+		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"gte": param.StartTime, "lte": param.EndTime}}})
+	} else if param.EndTime > 0 {
+		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"lte": param.EndTime}}})
+	} else if param.StartTime > 0 {
+		terms = append(terms, map[string]map[string]map[string]int64{"range": {"requestReceivedTimestamp": {"gte": param.StartTime}}})
+	}
+	if param.Query != "" {
+		terms = append(terms, map[string]map[string]interface{}{"multi_match": {
+			"query":  param.Query,
+			"fields": []string{"message", "details", "requestObject", "responseObject"},
+		}})
+	}
+	if len(param.Scopes) > 0 {
+		scopes := make([]interface{}, 0, len(param.Scopes))
+		for _, scope := range param.Scopes {
+			scopes = append(scopes, map[string]map[string][]map[string]map[string]string{"bool": {"filter": {
+				{"term": {"clusterName": scope.ClusterName}},
+				{"term": {"namespace": scope.Namespace}},
+			}}})
+		}
+		terms = append(terms, map[string]map[string]interface{}{"bool": {"should": scopes, "minimum_should_match": 1}})
+	}
+	query := map[string]interface{}{}
+	if len(terms) > 0 {
+		query["filter"] = terms
+	}
+	if len(param.ExcludeClusterNames) > 0 {
+		query["must_not"] = map[string]map[string][]string{"terms": {"clusterName": param.ExcludeClusterNames}}
+	}
+	if len(query) == 0 {
+		return nil
+	}
+	return map[string]map[string]interface{}{"bool": query}
+}
+
 type Result struct {
 	Hits Hits `json:"hits"`
 }
@@ -337,22 +362,82 @@ func (s *es) batchSave(events []*types.Event) error {
 	return nil
 }
 
-func (s *es) cleanup() {
-	log.Infof("trigger es audit event cleanup")
-	url := fmt.Sprintf("%s/%s/%s/_delete_by_query", s.Addr, s.Indices, typ)
-	if s.v7 {
-		url = fmt.Sprintf("%s/%s/_delete_by_query", s.Addr, s.Indices)
+// Scan scrolls the events matching the parameter in the order of time.
+func (s *es) Scan(param *storage.QueryParameter, fn func(*types.Event) error) error {
+	query := map[string]interface{}{
+		"size": scrollSize,
+		"sort": []interface{}{
+			map[string]string{"requestReceivedTimestamp": "asc"},
+		},
 	}
-	req := gorequest.New().Post(url).SetBasicAuth(s.username, s.password)
+	if filter := boolQuery(param); filter != nil {
+		query["query"] = filter
+	}
+	req := gorequest.New().Post(s.url("_search?scroll="+scrollKeepAlive)).SetBasicAuth(s.username, s.password)
 	req.Header["content-type"] = "application/json"
-	t := time.Now().Unix()*1000 - int64(s.ReserveDays*24*60*60*1000)
-	query := fmt.Sprintf(`{"query":{"bool":{"filter":{"range":{"requestReceivedTimestamp":{"lte":%d}}}}}}`, t)
-	_, _, errs := req.SendString(query).End()
-	if len(errs) != 0 {
-		log.Errorf("failed cleanup older audit events: %v", errs)
+	resp, body, errs := req.SendStruct(query).End()
+	var scrollID string
+	defer func() {
+		if scrollID != "" {
+			s.clearScroll(scrollID)
+		}
+	}()
+	for {
+		if len(errs) > 0 {
+			return fmt.Errorf("failed scroll documents: %v", errs)
+		} else if resp.StatusCode >= 300 {
+			return fmt.Errorf("failed scroll documents: %s", body)
+		}
+		result := scrollResult{}
+		if err := json.Unmarshal([]byte(body), &result); err != nil {
+			return err
+		}
+		scrollID = result.ScrollID
+		if len(result.Hits.Hits) == 0 {
+			return nil
+		}
+		for _, doc := range result.Hits.Hits {
+			if err := fn(doc.Event); err != nil {
+				return err
+			}
+		}
+		req := gorequest.New().Post(s.Addr+"/_search/scroll").SetBasicAuth(s.username, s.password)
+		req.Header["content-type"] = "application/json"
+		resp, body, errs = req.SendStruct(map[string]string{"scroll": scrollKeepAlive, "scroll_id": scrollID}).End()
 	}
 }
 
+type scrollResult struct {
+	ScrollID string `json:"_scroll_id"`
+	Hits     struct {
+		Hits []*Document `json:"hits"`
+	} `json:"hits"`
+}
+
+func (s *es) clearScroll(id string) {
+	req := gorequest.New().Delete(s.Addr+"/_search/scroll").SetBasicAuth(s.username, s.password)
+	req.Header["content-type"] = "application/json"
+	req.SendStruct(map[string]string{"scroll_id": id}).End()
+}
+
+func (s *es) Delete(param *storage.QueryParameter) error {
+	query := map[string]interface{}{
+		"query": map[string]interface{}{"match_all": map[string]interface{}{}},
+	}
+	if filter := boolQuery(param); filter != nil {
+		query["query"] = filter
+	}
+	req := gorequest.New().Post(s.url("_delete_by_query?conflicts=proceed")).SetBasicAuth(s.username, s.password)
+	req.Header["content-type"] = "application/json"
+	resp, body, errs := req.SendStruct(query).End()
+	if len(errs) > 0 {
+		return fmt.Errorf("failed delete documents: %v", errs)
+	} else if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed delete documents: %s", body)
+	}
+	return nil
+}
+
 func (s *es) updateFieldEnumCache() {
 	var l sync.Mutex
 	var tmpMap = map[string][]string{}
@@ -0,0 +1,95 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package postgres
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// migrations are the schema changes of the audit events applied in order,
+// the versions applied are recorded in the migrations table. {{table}} is
+// replaced with the name of the table. Never change the applied ones, append
+// a new one instead.
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS {{table}} (
+	id BIGSERIAL PRIMARY KEY,
+	audit_id TEXT NOT NULL DEFAULT '',
+	stage TEXT NOT NULL DEFAULT '',
+	request_uri TEXT NOT NULL DEFAULT '',
+	verb TEXT NOT NULL DEFAULT '',
+	user_name TEXT NOT NULL DEFAULT '',
+	user_agent TEXT NOT NULL DEFAULT '',
+	resource TEXT NOT NULL DEFAULT '',
+	namespace TEXT NOT NULL DEFAULT '',
+	name TEXT NOT NULL DEFAULT '',
+	uid TEXT NOT NULL DEFAULT '',
+	api_group TEXT NOT NULL DEFAULT '',
+	api_version TEXT NOT NULL DEFAULT '',
+	source_ips TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT '',
+	message TEXT NOT NULL DEFAULT '',
+	reason TEXT NOT NULL DEFAULT '',
+	details TEXT NOT NULL DEFAULT '',
+	code INTEGER NOT NULL DEFAULT 0,
+	request_object TEXT NOT NULL DEFAULT '',
+	response_object TEXT NOT NULL DEFAULT '',
+	request_received_timestamp BIGINT NOT NULL DEFAULT 0,
+	stage_timestamp BIGINT NOT NULL DEFAULT 0,
+	cluster_name TEXT NOT NULL DEFAULT ''
+)`,
+	`CREATE INDEX IF NOT EXISTS {{table}}_request_received_timestamp_idx ON {{table}} (request_received_timestamp DESC)`,
+	`CREATE INDEX IF NOT EXISTS {{table}}_cluster_name_idx ON {{table}} (cluster_name, request_received_timestamp DESC)`,
+	`CREATE INDEX IF NOT EXISTS {{table}}_user_name_idx ON {{table}} (user_name, request_received_timestamp DESC)`,
+}
+
+// migrate applies the migrations not applied yet in a transaction, the
+// advisory lock serializes the migrations of the replicas of tke-audit-api.
+func (s *postgres) migrate() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	migrationsTable := s.table + "_migrations"
+	if _, err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext($1))", migrationsTable); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (version INTEGER PRIMARY KEY, applied_at BIGINT NOT NULL)", migrationsTable)); err != nil {
+		return err
+	}
+	var version int
+	if err := tx.QueryRow(fmt.Sprintf("SELECT COALESCE(MAX(version), 0) FROM %s", migrationsTable)).Scan(&version); err != nil {
+		return err
+	}
+	for i := version; i < len(migrations); i++ {
+		log.Infof("apply postgres audit migration %d", i+1)
+		if _, err := tx.Exec(strings.ReplaceAll(migrations[i], "{{table}}", s.table)); err != nil {
+			return fmt.Errorf("migration %d: %v", i+1, err)
+		}
+		if _, err := tx.Exec(fmt.Sprintf("INSERT INTO %s (version, applied_at) VALUES ($1, $2)", migrationsTable), i+1, time.Now().Unix()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,301 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package postgres
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	// register the postgres driver of database/sql
+	_ "github.com/lib/pq"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"tkestack.io/tke/pkg/audit/apis/config"
+	"tkestack.io/tke/pkg/audit/storage"
+	"tkestack.io/tke/pkg/audit/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultDatabase = "tke_audit"
+	defaultTable    = "audit_events"
+	// batchSize is the number of events inserted by a statement, which keeps
+	// the parameters of the statement below the limit of postgres.
+	batchSize = 500
+	// fieldValuesLimit is the maximum number of values listed for a field.
+	fieldValuesLimit = 1000
+)
+
+type postgres struct {
+	db    *sql.DB
+	table string
+	stop  chan struct{}
+
+	lock        sync.Mutex
+	fieldValues map[string][]string
+}
+
+// NewStorage connects to postgres, and migrates the schema of the audit
+// events to the latest version.
+func NewStorage(conf *config.PostgreSQLStorage) (storage.AuditStorage, error) {
+	dsn := url.URL{
+		Scheme: "postgres",
+		Host:   conf.Address,
+		Path:   "/" + conf.Database,
+	}
+	if conf.Database == "" {
+		dsn.Path = "/" + defaultDatabase
+	}
+	if conf.Username != "" {
+		password, err := base64.StdEncoding.DecodeString(conf.Password)
+		if err != nil {
+			return nil, fmt.Errorf("decode password failed: %v", err)
+		}
+		dsn.User = url.UserPassword(conf.Username, string(password))
+	}
+	if conf.SSLMode != "" {
+		dsn.RawQuery = url.Values{"sslmode": {conf.SSLMode}}.Encode()
+	}
+	db, err := sql.Open("postgres", dsn.String())
+	if err != nil {
+		return nil, err
+	}
+	s := &postgres{
+		db:          db,
+		table:       conf.Table,
+		stop:        make(chan struct{}),
+		fieldValues: map[string][]string{},
+	}
+	if s.table == "" {
+		s.table = defaultTable
+	}
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("connect to postgres failed: %v", err)
+	}
+	if err := s.migrate(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("migrate postgres failed: %v", err)
+	}
+	return s, nil
+}
+
+func (s *postgres) Start() {
+	go wait.Until(s.updateFieldValues, time.Minute, s.stop)
+}
+
+func (s *postgres) Stop() {
+	close(s.stop)
+	s.db.Close()
+}
+
+func (s *postgres) Save(events []*types.Event) error {
+	for start := 0; start < len(events); start += batchSize {
+		end := start + batchSize
+		if end > len(events) {
+			end = len(events)
+		}
+		if err := s.batchSave(events[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *postgres) batchSave(events []*types.Event) error {
+	columns := make([]string, 0, len(storage.Columns))
+	for _, column := range storage.Columns {
+		columns = append(columns, column.Name)
+	}
+	rows := make([]string, 0, len(events))
+	args := make([]interface{}, 0, len(events)*len(columns))
+	for _, event := range events {
+		placeholders := make([]string, 0, len(columns))
+		for _, value := range storage.ColumnValues(event) {
+			args = append(args, value)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		rows = append(rows, "("+strings.Join(placeholders, ", ")+")")
+	}
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", s.table, strings.Join(columns, ", "), strings.Join(rows, ", "))
+	if _, err := s.db.Exec(query, args...); err != nil {
+		return fmt.Errorf("insert events failed: %v", err)
+	}
+	return nil
+}
+
+func (s *postgres) Query(param *storage.QueryParameter) ([]*types.Event, int, error) {
+	if param == nil {
+		param = &storage.QueryParameter{Size: 10}
+	}
+	where, args := conditions(param)
+
+	var total int
+	if err := s.db.QueryRow(fmt.Sprintf("SELECT count(*) FROM %s%s", s.table, where), args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed count events: %v", err)
+	}
+
+	columns := make([]string, 0, len(storage.Columns))
+	for _, column := range storage.Columns {
+		columns = append(columns, column.Name)
+	}
+	args = append(args, param.Size, param.Offset)
+	query := fmt.Sprintf("SELECT %s FROM %s%s ORDER BY request_received_timestamp DESC LIMIT $%d OFFSET $%d",
+		strings.Join(columns, ", "), s.table, where, len(args)-1, len(args))
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed query events: %v", err)
+	}
+	defer rows.Close()
+	events := make([]*types.Event, 0)
+	for rows.Next() {
+		event := &types.Event{}
+		if err := rows.Scan(storage.ColumnPointers(event)...); err != nil {
+			return nil, 0, err
+		}
+		events = append(events, event)
+	}
+	return events, total, rows.Err()
+}
+
+// conditions returns the where clause of the query parameter and its
+// arguments.
+func conditions(param *storage.QueryParameter) (string, []interface{}) {
+	var terms []string
+	var args []interface{}
+	equal := func(column, value string) {
+		if value != "" {
+			args = append(args, value)
+			terms = append(terms, fmt.Sprintf("%s = $%d", column, len(args)))
+		}
+	}
+	equal("cluster_name", param.ClusterName)
+	equal("namespace", param.Namespace)
+	equal("name", param.Name)
+	equal("resource", param.Resource)
+	equal("user_name", param.UserName)
+	if len(param.ExcludeClusterNames) > 0 {
+		placeholders := make([]string, 0, len(param.ExcludeClusterNames))
+		for _, name := range param.ExcludeClusterNames {
+			args = append(args, name)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(args)))
+		}
+		terms = append(terms, fmt.Sprintf("cluster_name NOT IN (%s)", strings.Join(placeholders, ", ")))
+	}
+	if len(param.Scopes) > 0 {
+		scopes := make([]string, 0, len(param.Scopes))
+		for _, scope := range param.Scopes {
+			args = append(args, scope.ClusterName, scope.Namespace)
+			scopes = append(scopes, fmt.Sprintf("(cluster_name = $%d AND namespace = $%d)", len(args)-1, len(args)))
+		}
+		terms = append(terms, "("+strings.Join(scopes, " OR ")+")")
+	}
+	if param.StartTime > 0 {
+		args = append(args, param.StartTime)
+		terms = append(terms, fmt.Sprintf("request_received_timestamp >= $%d", len(args)))
+	}
+	if param.EndTime > 0 {
+		args = append(args, param.EndTime)
+		terms = append(terms, fmt.Sprintf("request_received_timestamp <= $%d", len(args)))
+	}
+	if param.Query != "" {
+		args = append(args, "%"+escapeLike(param.Query)+"%")
+		n := len(args)
+		terms = append(terms, fmt.Sprintf("(message ILIKE $%d OR details ILIKE $%d OR request_object ILIKE $%d OR response_object ILIKE $%d)", n, n, n, n))
+	}
+	if len(terms) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(terms, " AND "), args
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+func (s *postgres) FieldValues() map[string][]string {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result := make(map[string][]string)
+	for _, field := range storage.EnumFields {
+		result[field] = s.fieldValues[field]
+	}
+	return result
+}
+
+func (s *postgres) updateFieldValues() {
+	for _, field := range storage.EnumFields {
+		column := storage.ColumnName(field)
+		rows, err := s.db.Query(fmt.Sprintf("SELECT DISTINCT %s FROM %s WHERE %s <> '' LIMIT %d", column, s.table, column, fieldValuesLimit))
+		if err != nil {
+			log.Errorf("failed update field %s values: %v", field, err)
+			continue
+		}
+		var values []string
+		for rows.Next() {
+			var value string
+			if err := rows.Scan(&value); err == nil {
+				values = append(values, value)
+			}
+		}
+		rows.Close()
+		if len(values) > 0 {
+			s.lock.Lock()
+			s.fieldValues[field] = values
+			s.lock.Unlock()
+		}
+	}
+}
+
+// Scan streams the rows matching the parameter in the order of time.
+func (s *postgres) Scan(param *storage.QueryParameter, fn func(*types.Event) error) error {
+	where, args := conditions(param)
+	columns := make([]string, 0, len(storage.Columns))
+	for _, column := range storage.Columns {
+		columns = append(columns, column.Name)
+	}
+	rows, err := s.db.Query(fmt.Sprintf("SELECT %s FROM %s%s ORDER BY request_received_timestamp",
+		strings.Join(columns, ", "), s.table, where), args...)
+	if err != nil {
+		return fmt.Errorf("failed scan events: %v", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		event := &types.Event{}
+		if err := rows.Scan(storage.ColumnPointers(event)...); err != nil {
+			return err
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (s *postgres) Delete(param *storage.QueryParameter) error {
+	where, args := conditions(param)
+	if _, err := s.db.Exec(fmt.Sprintf("DELETE FROM %s%s", s.table, where), args...); err != nil {
+		return fmt.Errorf("failed delete events: %v", err)
+	}
+	return nil
+}
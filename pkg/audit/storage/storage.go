@@ -13,11 +13,27 @@ type QueryParameter struct {
 	EndTime     int64
 	UserName    string
 	Query       string
+	// ExcludeClusterNames excludes the events of the clusters.
+	ExcludeClusterNames []string
+	// Scopes limits the events to those of the namespaces, unless it is
+	// empty.
+	Scopes []Scope
+}
+
+// Scope is a namespace of a cluster.
+type Scope struct {
+	ClusterName string
+	Namespace   string
 }
 
 type AuditStorage interface {
 	Query(param *QueryParameter) ([]*types.Event, int, error)
 	Save([]*types.Event) error
+	// Scan calls fn with the events matching the parameter in the order of
+	// time, the offset and size of the parameter are ignored.
+	Scan(param *QueryParameter, fn func(*types.Event) error) error
+	// Delete deletes the events matching the parameter.
+	Delete(param *QueryParameter) error
 	// list option values for field
 	FieldValues() map[string][]string
 
@@ -27,6 +27,7 @@ import (
 	"tkestack.io/tke/api/auth"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
 	local2 "tkestack.io/tke/pkg/auth/authorization/local"
 
 	dexstorage "github.com/dexidp/dex/storage"
@@ -39,16 +40,20 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	serverstorage "k8s.io/apiserver/pkg/server/storage"
+	restclient "k8s.io/client-go/rest"
 
 	authv1 "tkestack.io/tke/api/auth/v1"
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	versionedclientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	versionedinformers "tkestack.io/tke/api/client/informers/externalversions"
 	"tkestack.io/tke/pkg/apiserver/storage"
 	"tkestack.io/tke/pkg/auth/authentication/authenticator"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
 	authnhandler "tkestack.io/tke/pkg/auth/handler/authn"
 	authzhandler "tkestack.io/tke/pkg/auth/handler/authz"
+	kubeconfighandler "tkestack.io/tke/pkg/auth/handler/kubeconfig"
+	scimhandler "tkestack.io/tke/pkg/auth/handler/scim"
 	authrest "tkestack.io/tke/pkg/auth/registry/rest"
 	"tkestack.io/tke/pkg/auth/route"
 	"tkestack.io/tke/pkg/util/log"
@@ -92,6 +97,7 @@ type ExtraConfig struct {
 	Authorizer           authorizer.Authorizer
 	CasbinReloadInterval time.Duration
 	PrivilegedUsername   string
+	PlatformClient       platformversionedclient.PlatformV1Interface
 }
 
 // Config contains the core configuration instance of apiserver and
@@ -141,7 +147,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 	installHooks(s, hooks)
 	installCasbinPreStopHook(s, c.ExtraConfig.CasbinEnforcer)
 
-	c.registerRoute(&dexHandler, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux)
+	c.registerRoute(&dexHandler, s.Handler.GoRestfulContainer, s.Handler.NonGoRestfulMux, s.LoopbackClientConfig)
 
 	m := &APIServer{
 		GenericAPIServer: s,
@@ -208,12 +214,23 @@ func DefaultAPIResourceConfigSource() *serverstorage.ResourceConfig {
 }
 
 // registerRoute is used to register routes with the api server of project.
-func (c completedConfig) registerRoute(dexHandler http.Handler, container *restful.Container, mux *mux.PathRecorderMux) {
+func (c completedConfig) registerRoute(dexHandler http.Handler, container *restful.Container, mux *mux.PathRecorderMux, loopbackConfig *restclient.Config) {
 	mux.HandlePrefix("/"+auth.IssuerName+"/", dexHandler)
 
 	token := authnhandler.NewHandler(c.ExtraConfig.TokenAuthn, c.ExtraConfig.APIKeyAuthn)
 	authz := authzhandler.NewHandler(c.ExtraConfig.Authorizer)
 	route.RegisterAuthRoute(container, token, authz)
+
+	scim := scimhandler.NewHandler(loopbackConfig, c.ExtraConfig.APIKeyAuthn)
+	route.RegisterSCIMRoute(container, scim)
+
+	if c.ExtraConfig.PlatformClient != nil {
+		configMapLister := c.ExtraConfig.VersionedInformers.Auth().V1().ConfigMaps().Lister()
+		kubeconfig := kubeconfighandler.NewHandler(authinternalclient.NewForConfigOrDie(loopbackConfig), configMapLister, c.ExtraConfig.PlatformClient,
+			c.ExtraConfig.PrivilegedUsername, c.ExtraConfig.TokenAuthn, c.ExtraConfig.APIKeyAuthn)
+		kubeconfigToken := authnhandler.NewHandler(authenticator.NewKubeconfigAuthenticator(configMapLister))
+		route.RegisterKubeconfigRoute(container, kubeconfig, kubeconfigToken)
+	}
 }
 
 // registerHooks is used to register postStart hook to create authn provider with local oidc server.
@@ -228,11 +245,18 @@ func (c completedConfig) registerHooks(dexHandler *identityprovider.DexHander, s
 
 	localIdpHook := local.NewLocalHookHandler(authClient)
 	ldapIdpHook := ldap.NewLdapHookHandler(authClient)
+	samlIdpHook := saml.NewSAMLHookHandler(authClient)
 
 	authVersionedClient := versionedclientset.NewForConfigOrDie(s.LoopbackClientConfig)
 	adapterHook := local2.NewAdapterHookHandler(authVersionedClient, c.ExtraConfig.CasbinEnforcer, c.ExtraConfig.VersionedInformers, c.ExtraConfig.CasbinReloadInterval)
 
-	return []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, adapterHook}
+	hooks := []genericapiserver.PostStartHookProvider{dexHook, apiSigningKeyHook, localIdpHook, ldapIdpHook, samlIdpHook, adapterHook}
+	if c.ExtraConfig.PlatformClient != nil {
+		kubeconfigSweeperHook := kubeconfighandler.NewSweeperHookHandler(authClient, c.ExtraConfig.VersionedInformers.Auth().V1().ConfigMaps().Lister())
+		hooks = append(hooks, kubeconfigSweeperHook)
+	}
+
+	return hooks
 }
 
 // installCasbinPreStopHook is used to register preStop hook to stop casbin enforcer sync.
@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	genericauthenticator "k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/user"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// KubeconfigAuthenticator authenticates the bearer tokens of the short-lived
+// kubeconfigs issued by tke-auth. The clusters authenticate the tokens by the
+// token webhook, so the revoked kubeconfigs are rejected before any
+// authorizer of the cluster allows the requests.
+type KubeconfigAuthenticator struct {
+	configMapLister authv1lister.ConfigMapLister
+}
+
+// NewKubeconfigAuthenticator creates new KubeconfigAuthenticator object.
+func NewKubeconfigAuthenticator(configMapLister authv1lister.ConfigMapLister) *KubeconfigAuthenticator {
+	return &KubeconfigAuthenticator{configMapLister: configMapLister}
+}
+
+// AuthenticateToken verifies the token of the kubeconfig and returns the user
+// it is issued to. The cluster requesting the token review is given by the
+// audiences of context, the kubeconfig issued for other clusters is rejected.
+func (a *KubeconfigAuthenticator) AuthenticateToken(ctx context.Context, token string) (*genericauthenticator.Response, bool, error) {
+	id, secret, ok := util.ParseKubeconfigToken(token)
+	if !ok {
+		return nil, false, nil
+	}
+	configMap, err := a.configMapLister.Get(util.KubeconfigConfigMapName(id))
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, false, fmt.Errorf("kubeconfig %s has been revoked", id)
+		}
+		return nil, false, err
+	}
+	record, ok := util.KubeconfigRecordFromV1ConfigMap(configMap)
+	if !ok || !record.VerifySecret(secret) {
+		return nil, false, fmt.Errorf("invalid token of kubeconfig %s", id)
+	}
+	if record.Expired() {
+		return nil, false, fmt.Errorf("kubeconfig %s has expired", id)
+	}
+	if audiences, ok := genericauthenticator.AudiencesFrom(ctx); !ok || !audiences.Has(record.ClusterName) {
+		return nil, false, fmt.Errorf("kubeconfig %s is not issued for the cluster", id)
+	}
+
+	log.Debug("Authenticate kubeconfig", log.String("kubeconfig", id), log.String("user", record.Username))
+	info := &user.DefaultInfo{
+		Name:   record.Username,
+		Groups: record.Groups,
+	}
+	if record.TenantID != "" {
+		info.Extra = map[string][]string{genericoidc.TenantIDKey: {record.TenantID}}
+	}
+	return &genericauthenticator.Response{User: info}, true, nil
+}
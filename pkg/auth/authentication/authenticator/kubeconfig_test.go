@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package authenticator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	genericauthenticator "k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/client-go/tools/cache"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/auth/util"
+)
+
+func TestKubeconfigAuthenticator(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	now := time.Now()
+	addRecord := func(id, secret string, clusterName string, expireAt time.Time) {
+		record := &util.KubeconfigRecord{
+			ID:          id,
+			TenantID:    "default",
+			Username:    "alice",
+			Groups:      []string{"kubeconfig:" + id, "tenant:default"},
+			ClusterName: clusterName,
+			IssueAt:     metav1.NewTime(now.Add(-time.Minute)),
+			ExpireAt:    metav1.NewTime(expireAt),
+			TokenHash:   util.HashKubeconfigSecret(secret),
+		}
+		configMap := record.ConfigMap()
+		if err := indexer.Add(&authv1.ConfigMap{ObjectMeta: configMap.ObjectMeta, Data: configMap.Data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	addRecord("valid", "secret", "cls-a", now.Add(time.Hour))
+	addRecord("expired", "secret", "cls-a", now.Add(-time.Second))
+	addRecord("other", "secret", "cls-b", now.Add(time.Hour))
+	a := NewKubeconfigAuthenticator(authv1lister.NewConfigMapLister(indexer))
+	ctx := genericauthenticator.WithAudiences(context.Background(), genericauthenticator.Audiences{"cls-a"})
+
+	resp, ok, err := a.AuthenticateToken(ctx, util.KubeconfigToken("valid", "secret"))
+	if !ok || err != nil {
+		t.Fatalf("expect the token to be authenticated, got %v %v", ok, err)
+	}
+	if resp.User.GetName() != "alice" || len(resp.User.GetGroups()) != 2 || resp.User.GetExtra()[genericoidc.TenantIDKey][0] != "default" {
+		t.Errorf("unexpected user %+v", resp.User)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		token   string
+		wantErr bool
+	}{
+		{name: "not a kubeconfig token", ctx: ctx, token: "eyJhbGciOiJSUzI1NiJ9"},
+		{name: "wrong secret", ctx: ctx, token: util.KubeconfigToken("valid", "other"), wantErr: true},
+		{name: "expired", ctx: ctx, token: util.KubeconfigToken("expired", "secret"), wantErr: true},
+		{name: "revoked", ctx: ctx, token: util.KubeconfigToken("revoked", "secret"), wantErr: true},
+		{name: "issued for other cluster", ctx: ctx, token: util.KubeconfigToken("other", "secret"), wantErr: true},
+		{name: "unknown cluster", ctx: context.Background(), token: util.KubeconfigToken("valid", "secret"), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok, err := a.AuthenticateToken(tt.ctx, tt.token)
+			if ok || (err != nil) != tt.wantErr {
+				t.Errorf("AuthenticateToken() = %v, %v, want not authenticated and error %v", ok, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -139,6 +139,11 @@ func (p *localConnector) Login(ctx context.Context, scopes connector.Scopes, use
 		return ident, false, nil
 	}
 
+	if localIdentity.Status.Locked {
+		log.Info("User has been locked", log.String("tenantID", p.tenantID), log.String("user", username))
+		return ident, false, nil
+	}
+
 	extra := map[string]string{
 		oidc.TenantIDKey: localIdentity.Spec.TenantID,
 	}
@@ -185,6 +190,11 @@ func (p *localConnector) Refresh(ctx context.Context, s connector.Scopes, identi
 		return connector.Identity{}, errors.New("user not found")
 	}
 
+	// User has been deactivated, for example by the scim provisioning.
+	if ident.Status.Locked {
+		return connector.Identity{}, errors.New("user has been locked")
+	}
+
 	return identity, nil
 }
 
@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the “License”); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an “AS IS” BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type samlHookHandler struct {
+	authClient authinternalclient.AuthInterface
+	// resourceVersions are the resource versions of the identity providers
+	// loaded, which are rebuilt once changed.
+	resourceVersions map[string]string
+}
+
+// NewSAMLHookHandler creates a new samlHookHandler object.
+func NewSAMLHookHandler(authClient authinternalclient.AuthInterface) genericapiserver.PostStartHookProvider {
+	return &samlHookHandler{
+		authClient:       authClient,
+		resourceVersions: make(map[string]string),
+	}
+}
+
+func (d *samlHookHandler) PostStartHook() (string, genericapiserver.PostStartHookFunc, error) {
+	return "load-saml-idp", func(ctx genericapiserver.PostStartHookContext) error {
+		go wait.JitterUntil(d.load, 30*time.Second, 0.0, false, ctx.StopCh)
+
+		return nil
+	}, nil
+}
+
+// load builds the saml identity providers created or changed since the last
+// load, and removes the deleted ones.
+func (d *samlHookHandler) load() {
+	tenantUserSelector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.type", ConnectorType),
+	)
+	conns, err := d.authClient.IdentityProviders().List(context.Background(), v1.ListOptions{FieldSelector: tenantUserSelector.String()})
+	if err != nil {
+		log.Error("List saml idp from registry failed", log.Err(err))
+		return
+	}
+
+	listed := sets.NewString()
+	for _, conn := range conns.Items {
+		listed.Insert(conn.Name)
+		if version, ok := d.resourceVersions[conn.Name]; ok && version == conn.ResourceVersion {
+			continue
+		}
+
+		var samlConfig Config
+		err = json.Unmarshal([]byte(conn.Spec.Config), &samlConfig)
+		if err != nil {
+			log.Error("Unmarshal idp config failed", log.String("idp", conn.Spec.Name), log.Err(err))
+			continue
+		}
+
+		idp, err := NewSAMLIdentityProvider(samlConfig, conn.Spec.Administrators, conn.Name)
+		if err != nil {
+			log.Error("NewSAMLIdentityProvider failed", log.String("idp", conn.Spec.Name), log.Err(err))
+			continue
+		}
+
+		identityprovider.SetIdentityProvider(conn.Name, idp)
+		d.resourceVersions[conn.Name] = conn.ResourceVersion
+		log.Info("load saml identity provider successfully", log.String("idp", conn.Name), log.String("resourceVersion", conn.ResourceVersion))
+	}
+
+	for name := range d.resourceVersions {
+		if listed.Has(name) {
+			continue
+		}
+		if idp, ok := identityprovider.GetIdentityProvider(name); ok {
+			if _, isSAML := idp.(*identityProvider); isSAML {
+				identityprovider.DeleteIdentityProvider(name)
+			}
+		}
+		delete(d.resourceVersions, name)
+		log.Info("remove saml identity provider", log.String("idp", name))
+	}
+}
@@ -0,0 +1,270 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package saml
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dexidp/dex/connector"
+	dexsaml "github.com/dexidp/dex/connector/saml"
+	dexlog "github.com/dexidp/dex/pkg/log"
+	dexserver "github.com/dexidp/dex/server"
+	"github.com/emicklei/go-restful"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+)
+
+const (
+	// ConnectorType is distinct from the saml connector of dex, which is kept
+	// as is for the identity providers without group mappings.
+	ConnectorType = "tke-saml"
+
+	nameIDFormatPrefix   = "urn:oasis:names:tc:SAML:2.0:nameid-format:"
+	nameIDFormatPrefix11 = "urn:oasis:names:tc:SAML:1.1:nameid-format:"
+	bindingHTTPPost      = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	protocolSAML20       = "urn:oasis:names:tc:SAML:2.0:protocol"
+	metadataNamespace    = "urn:oasis:names:tc:SAML:2.0:metadata"
+)
+
+func init() {
+	// register the saml connector mapping the groups asserted.
+	dexserver.ConnectorsConfig[ConnectorType] = func() dexserver.ConnectorConfig {
+		return new(Config)
+	}
+}
+
+// Config is the saml config of dex, with the groups asserted by the identity
+// provider mapped to the groups of tke.
+type Config struct {
+	dexsaml.Config
+
+	// GroupMappings maps the groups asserted to the groups of tke.
+	GroupMappings []GroupMapping `json:"groupMappings,omitempty"`
+	// DropUnmappedGroups drops the groups asserted which are not mapped.
+	DropUnmappedGroups bool `json:"dropUnmappedGroups,omitempty"`
+	// AllowedGroups limits the login to the users in the groups after
+	// mapping.
+	AllowedGroups []string `json:"allowedGroups,omitempty"`
+}
+
+// GroupMapping maps a group asserted by the identity provider to the groups of
+// tke.
+type GroupMapping struct {
+	IDPGroup string   `json:"idpGroup"`
+	Groups   []string `json:"groups"`
+}
+
+// Open returns a saml connector mapping the groups.
+func (c *Config) Open(id string, logger dexlog.Logger) (connector.Connector, error) {
+	conn, err := c.Config.Open(id, logger)
+	if err != nil {
+		return nil, err
+	}
+	samlConn, ok := conn.(connector.SAMLConnector)
+	if !ok {
+		return nil, fmt.Errorf("saml: unexpected connector %T", conn)
+	}
+	return &samlConnector{SAMLConnector: samlConn, config: c}, nil
+}
+
+// mapGroups returns the groups of tke of the groups asserted, and if the user
+// is allowed to login.
+func (c *Config) mapGroups(asserted []string) ([]string, bool) {
+	groups := sets.NewString()
+	for _, group := range asserted {
+		mapped := false
+		for _, m := range c.GroupMappings {
+			if m.IDPGroup == group {
+				groups.Insert(m.Groups...)
+				mapped = true
+			}
+		}
+		if !mapped && !c.DropUnmappedGroups {
+			groups.Insert(group)
+		}
+	}
+	if len(c.AllowedGroups) > 0 && !groups.HasAny(c.AllowedGroups...) {
+		return nil, false
+	}
+	return groups.List(), true
+}
+
+type samlConnector struct {
+	connector.SAMLConnector
+	config *Config
+}
+
+// HandlePOST validates the assertion by dex, and maps the groups of the
+// identity.
+func (c *samlConnector) HandlePOST(s connector.Scopes, samlResponse, inResponseTo string) (connector.Identity, error) {
+	ident, err := c.SAMLConnector.HandlePOST(s, samlResponse, inResponseTo)
+	if err != nil {
+		return ident, err
+	}
+	groups, allowed := c.config.mapGroups(ident.Groups)
+	if !allowed {
+		return connector.Identity{}, fmt.Errorf("saml: user %q is not in the allowed groups", ident.Username)
+	}
+	ident.Groups = groups
+	return ident, nil
+}
+
+// identityProvider is the third-party idp that support SAML 2.0.
+type identityProvider struct {
+	config         Config
+	administrators []string
+	tenantID       string
+}
+
+// NewSAMLIdentityProvider creates a saml idp for tke login.
+func NewSAMLIdentityProvider(c Config, administrators []string, tenantID string) (identityprovider.IdentityProvider, error) {
+	requiredFields := []struct {
+		name string
+		val  string
+	}{
+		{"ssoURL", c.SSOURL},
+		{"redirectURI", c.RedirectURI},
+		{"usernameAttr", c.UsernameAttr},
+		{"emailAttr", c.EmailAttr},
+	}
+	for _, field := range requiredFields {
+		if field.val == "" {
+			return nil, fmt.Errorf("saml: missing required field %q", field.name)
+		}
+	}
+	if c.CA == "" && len(c.CAData) == 0 && !c.InsecureSkipSignatureValidation {
+		return nil, fmt.Errorf("saml: must provide either ca or caData to validate the signatures")
+	}
+	for i, m := range c.GroupMappings {
+		if m.IDPGroup == "" || len(m.Groups) == 0 {
+			return nil, fmt.Errorf("saml: groupMappings[%d] must specify idpGroup and groups", i)
+		}
+	}
+	return &identityProvider{config: c, administrators: administrators, tenantID: tenantID}, nil
+}
+
+func (c *identityProvider) Open(id string, logger dexlog.Logger) (connector.Connector, error) {
+	return c.config.Open(id, logger)
+}
+
+func (c *identityProvider) Store() (*auth.IdentityProvider, error) {
+	if c.tenantID == "" {
+		return nil, fmt.Errorf("must specify tenantID")
+	}
+
+	bytes, err := json.Marshal(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("mashal saml config failed: %+v", err)
+	}
+
+	return &auth.IdentityProvider{
+		ObjectMeta: v1.ObjectMeta{Name: c.tenantID},
+		Spec: auth.IdentityProviderSpec{
+			Name:           c.tenantID,
+			Type:           ConnectorType,
+			Administrators: c.administrators,
+			Config:         string(bytes),
+		},
+	}, nil
+}
+
+type entityDescriptor struct {
+	XMLName  xml.Name        `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string          `xml:"entityID,attr"`
+	SP       spSSODescriptor `xml:"SPSSODescriptor"`
+}
+
+type spSSODescriptor struct {
+	AuthnRequestsSigned        bool                       `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool                       `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string                     `xml:"protocolSupportEnumeration,attr"`
+	NameIDFormat               string                     `xml:"NameIDFormat"`
+	AssertionConsumerService   []assertionConsumerService `xml:"AssertionConsumerService"`
+}
+
+type assertionConsumerService struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
+
+// Metadata returns the metadata of tke as the service provider, which is
+// imported into the identity provider.
+func (c *Config) Metadata() ([]byte, error) {
+	entityID := c.EntityIssuer
+	if entityID == "" {
+		entityID = c.RedirectURI
+	}
+	data, err := xml.MarshalIndent(entityDescriptor{
+		EntityID: entityID,
+		SP: spSSODescriptor{
+			WantAssertionsSigned:       !c.InsecureSkipSignatureValidation,
+			ProtocolSupportEnumeration: protocolSAML20,
+			NameIDFormat:               nameIDFormat(c.NameIDPolicyFormat),
+			AssertionConsumerService: []assertionConsumerService{
+				{Binding: bindingHTTPPost, Location: c.RedirectURI, Index: 1},
+			},
+		},
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// nameIDFormat expands the short name of the format like dex, which defaults
+// to persistent.
+func nameIDFormat(format string) string {
+	switch {
+	case format == "":
+		return nameIDFormatPrefix + "persistent"
+	case strings.Contains(format, ":"):
+		return format
+	case format == "emailAddress" || format == "unspecified" || format == "X509SubjectName" || format == "WindowsDomainQualifiedName":
+		return nameIDFormatPrefix11 + format
+	default:
+		return nameIDFormatPrefix + format
+	}
+}
+
+// MetadataHandler writes the service provider metadata of the saml idp of the
+// tenant.
+func MetadataHandler(request *restful.Request, response *restful.Response) {
+	tenantID := request.PathParameter("tenantID")
+	idp, ok := identityprovider.GetIdentityProvider(tenantID)
+	samlIDP, isSAML := idp.(*identityProvider)
+	if !ok || !isSAML {
+		_ = response.WriteErrorString(http.StatusNotFound, fmt.Sprintf("saml identity provider of tenant %s not found", tenantID))
+		return
+	}
+	data, err := samlIDP.config.Metadata()
+	if err != nil {
+		_ = response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.AddHeader("Content-Type", "application/samlmetadata+xml")
+	_, _ = response.Write(data)
+}
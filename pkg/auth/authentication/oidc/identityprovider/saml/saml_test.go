@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package saml
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	dexsaml "github.com/dexidp/dex/connector/saml"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
+)
+
+func TestMapGroups(t *testing.T) {
+	c := &Config{
+		GroupMappings: []GroupMapping{
+			{IDPGroup: "CN=Ops,OU=Groups", Groups: []string{"ops"}},
+			{IDPGroup: "CN=Admins,OU=Groups", Groups: []string{"ops", "admins"}},
+		},
+	}
+	groups, allowed := c.mapGroups([]string{"CN=Admins,OU=Groups", "CN=Dev,OU=Groups"})
+	if !allowed || !reflect.DeepEqual(groups, []string{"CN=Dev,OU=Groups", "admins", "ops"}) {
+		t.Errorf("unexpected groups %v, allowed %v", groups, allowed)
+	}
+
+	c.DropUnmappedGroups = true
+	c.AllowedGroups = []string{"admins"}
+	if groups, allowed := c.mapGroups([]string{"CN=Admins,OU=Groups", "CN=Dev,OU=Groups"}); !allowed || !reflect.DeepEqual(groups, []string{"admins", "ops"}) {
+		t.Errorf("unexpected groups %v, allowed %v", groups, allowed)
+	}
+	if _, allowed := c.mapGroups([]string{"CN=Ops,OU=Groups"}); allowed {
+		t.Error("expected the user not in the allowed groups is denied")
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	c := &Config{Config: dexsaml.Config{
+		RedirectURI:        "https://tke.example.com/oidc/callback",
+		NameIDPolicyFormat: "emailAddress",
+	}}
+	data, err := c.Metadata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		`entityID="https://tke.example.com/oidc/callback"`,
+		`WantAssertionsSigned="true"`,
+		`<NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</NameIDFormat>`,
+		`Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://tke.example.com/oidc/callback"`,
+	} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("metadata does not contain %s:\n%s", s, data)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	idp := &auth.IdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Name: "corp", ResourceVersion: "1"},
+		Spec: auth.IdentityProviderSpec{
+			Name: "corp",
+			Type: ConnectorType,
+			Config: `{"ssoURL": "https://idp.example.com/sso", "redirectURI": "https://tke.example.com/oidc/callback",
+				"usernameAttr": "name", "emailAttr": "email", "insecureSkipSignatureValidation": true}`,
+		},
+	}
+	client := fake.NewSimpleClientset(idp)
+	d := NewSAMLHookHandler(client.Auth()).(*samlHookHandler)
+	defer identityprovider.DeleteIdentityProvider("corp")
+
+	d.load()
+	loaded, ok := identityprovider.GetIdentityProvider("corp")
+	if !ok {
+		t.Fatal("saml identity provider is not loaded")
+	}
+	if got := loaded.(*identityProvider).config.RedirectURI; got != "https://tke.example.com/oidc/callback" {
+		t.Errorf("redirectURI = %s", got)
+	}
+
+	// rebuilt once the config is changed
+	idp.ResourceVersion = "2"
+	idp.Spec.Config = strings.Replace(idp.Spec.Config, "tke.example.com", "tke.example.org", 1)
+	if _, err := client.Auth().IdentityProviders().Update(context.Background(), idp, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	d.load()
+	loaded, _ = identityprovider.GetIdentityProvider("corp")
+	if got := loaded.(*identityProvider).config.RedirectURI; got != "https://tke.example.org/oidc/callback" {
+		t.Errorf("redirectURI = %s, want the changed one", got)
+	}
+
+	// removed once the identity provider is deleted
+	if err := client.Auth().IdentityProviders().Delete(context.Background(), "corp", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	d.load()
+	if _, ok := identityprovider.GetIdentityProvider("corp"); ok {
+		t.Error("saml identity provider is not removed")
+	}
+}
@@ -28,6 +28,7 @@ import (
 	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
 	"tkestack.io/tke/cmd/tke-auth-api/app/options"
 	"tkestack.io/tke/pkg/apiserver/authorization/abac"
+	"tkestack.io/tke/pkg/auth/authorization/chain"
 	"tkestack.io/tke/pkg/auth/authorization/local"
 )
 
@@ -69,7 +70,18 @@ func NewAuthorizer(authClient authinternalclient.AuthInterface, authorizationOpt
 		authorizers = append(authorizers, rbacAuthorizer)
 	}
 
-	authorizers = append(authorizers, local.NewAuthorizer(authClient, enforcer, privilegedUsername))
+	var localAuthorizer authorizer.Authorizer = local.NewAuthorizer(authClient, enforcer, privilegedUsername)
+	if len(authorizationOpts.WebhookChainConfigFile) != 0 {
+		chainConfig, err := chain.LoadConfig(authorizationOpts.WebhookChainConfigFile)
+		if err != nil {
+			return nil, err
+		}
+		localAuthorizer, err = chain.New(localAuthorizer, chainConfig, privilegedUsername)
+		if err != nil {
+			return nil, err
+		}
+	}
+	authorizers = append(authorizers, localAuthorizer)
 
 	return union.New(authorizers...), nil
 }
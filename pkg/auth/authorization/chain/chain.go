@@ -0,0 +1,212 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package chain
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/apiserver/plugin/pkg/authorizer/webhook"
+	"sigs.k8s.io/yaml"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// FailurePolicy defines how to handle the failure of calling a webhook.
+type FailurePolicy string
+
+const (
+	// FailurePolicyOpen ignores the webhook when it fails or times out.
+	FailurePolicyOpen FailurePolicy = "Open"
+	// FailurePolicyClosed denies the request when the webhook fails or times out.
+	FailurePolicyClosed FailurePolicy = "Closed"
+)
+
+const (
+	defaultTimeout              = 3 * time.Second
+	defaultVersion              = "v1beta1"
+	defaultCacheAuthorizedTTL   = 5 * time.Minute
+	defaultCacheUnauthorizedTTL = 30 * time.Second
+)
+
+// Config is the configuration of the webhooks chained after the built-in
+// policy evaluation.
+type Config struct {
+	Webhooks []WebhookConfig `json:"webhooks"`
+}
+
+// WebhookConfig is the configuration of a chained webhook.
+type WebhookConfig struct {
+	// Name identifies the webhook in logs and deny reasons.
+	Name string `json:"name"`
+	// KubeConfigFile is the webhook configuration in kubeconfig format.
+	KubeConfigFile string `json:"kubeConfigFile"`
+	// Version is the API version of the SubjectAccessReview, defaults to v1beta1.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Timeout of calling the webhook, defaults to 3s.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	// FailurePolicy is Open or Closed, defaults to Closed.
+	// +optional
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+	// +optional
+	CacheAuthorizedTTL metav1.Duration `json:"cacheAuthorizedTTL,omitempty"`
+	// +optional
+	CacheUnauthorizedTTL metav1.Duration `json:"cacheUnauthorizedTTL,omitempty"`
+}
+
+// LoadConfig reads the chained webhooks configuration from yaml or json file.
+func LoadConfig(filename string) (*Config, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse webhook chain config %s: %v", filename, err)
+	}
+
+	names := sets.NewString()
+	for i := range config.Webhooks {
+		w := &config.Webhooks[i]
+		if w.Name == "" || w.KubeConfigFile == "" {
+			return nil, fmt.Errorf("webhooks[%d] must specify name and kubeConfigFile", i)
+		}
+		if names.Has(w.Name) {
+			return nil, fmt.Errorf("duplicated webhook %s", w.Name)
+		}
+		names.Insert(w.Name)
+		if w.Version == "" {
+			w.Version = defaultVersion
+		}
+		if w.Timeout.Duration == 0 {
+			w.Timeout.Duration = defaultTimeout
+		}
+		switch w.FailurePolicy {
+		case "":
+			w.FailurePolicy = FailurePolicyClosed
+		case FailurePolicyOpen, FailurePolicyClosed:
+		default:
+			return nil, fmt.Errorf("unsupported failure policy %s of webhook %s, must be %s or %s",
+				w.FailurePolicy, w.Name, FailurePolicyOpen, FailurePolicyClosed)
+		}
+		if w.CacheAuthorizedTTL.Duration == 0 {
+			w.CacheAuthorizedTTL.Duration = defaultCacheAuthorizedTTL
+		}
+		if w.CacheUnauthorizedTTL.Duration == 0 {
+			w.CacheUnauthorizedTTL.Duration = defaultCacheUnauthorizedTTL
+		}
+	}
+
+	return config, nil
+}
+
+type chainedWebhook struct {
+	authorizer.Authorizer
+	name          string
+	timeout       time.Duration
+	failurePolicy FailurePolicy
+}
+
+// Authorizer evaluates the chained webhooks after the built-in authorizer
+// allows the request, so any webhook can deny requests allowed by built-in
+// policies but can not allow requests denied by them.
+type Authorizer struct {
+	builtin            authorizer.Authorizer
+	webhooks           []chainedWebhook
+	privilegedUsername string
+}
+
+// New creates an authorizer chaining the webhooks after the built-in authorizer.
+func New(builtin authorizer.Authorizer, config *Config, privilegedUsername string) (*Authorizer, error) {
+	a := &Authorizer{
+		builtin:            builtin,
+		privilegedUsername: privilegedUsername,
+	}
+	for _, w := range config.Webhooks {
+		webhookAuthorizer, err := webhook.New(w.KubeConfigFile, w.Version,
+			w.CacheAuthorizedTTL.Duration, w.CacheUnauthorizedTTL.Duration, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create webhook %s: %v", w.Name, err)
+		}
+		a.webhooks = append(a.webhooks, chainedWebhook{
+			Authorizer:    webhookAuthorizer,
+			name:          w.Name,
+			timeout:       w.Timeout.Duration,
+			failurePolicy: w.FailurePolicy,
+		})
+	}
+
+	return a, nil
+}
+
+// Authorize implements authorizer.Authorizer. The privileged user is never
+// checked by webhooks so that a broken webhook can not lock out the platform.
+func (a *Authorizer) Authorize(ctx context.Context, attr authorizer.Attributes) (authorizer.Decision, string, error) {
+	decision, reason, err := a.builtin.Authorize(ctx, attr)
+	if decision != authorizer.DecisionAllow || attr.GetUser().GetName() == a.privilegedUsername {
+		return decision, reason, err
+	}
+
+	for _, w := range a.webhooks {
+		webhookDecision, webhookReason, err := w.authorize(ctx, attr)
+		if err != nil {
+			log.Warn("Failed to call chained authorization webhook", log.String("webhook", w.name),
+				log.String("user", attr.GetUser().GetName()), log.String("failurePolicy", string(w.failurePolicy)), log.Err(err))
+			if w.failurePolicy == FailurePolicyOpen {
+				continue
+			}
+			return authorizer.DecisionDeny, fmt.Sprintf("authorization webhook %s failed", w.name), err
+		}
+		if webhookDecision == authorizer.DecisionDeny {
+			return authorizer.DecisionDeny, fmt.Sprintf("denied by authorization webhook %s: %s", w.name, webhookReason), nil
+		}
+	}
+
+	return decision, reason, nil
+}
+
+type result struct {
+	decision authorizer.Decision
+	reason   string
+	err      error
+}
+
+func (w *chainedWebhook) authorize(ctx context.Context, attr authorizer.Attributes) (authorizer.Decision, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, w.timeout)
+	defer cancel()
+
+	ch := make(chan result, 1)
+	go func() {
+		decision, reason, err := w.Authorizer.Authorize(ctx, attr)
+		ch <- result{decision: decision, reason: reason, err: err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.decision, r.reason, r.err
+	case <-ctx.Done():
+		return authorizer.DecisionNoOpinion, "", fmt.Errorf("timeout after %s", w.timeout)
+	}
+}
@@ -0,0 +1,108 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package chain
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+func fixed(decision authorizer.Decision, err error) authorizer.Authorizer {
+	return authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		return decision, "", err
+	})
+}
+
+func slow() authorizer.Authorizer {
+	return authorizer.AuthorizerFunc(func(ctx context.Context, a authorizer.Attributes) (authorizer.Decision, string, error) {
+		time.Sleep(time.Second)
+		return authorizer.DecisionAllow, "", nil
+	})
+}
+
+func TestAuthorize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		username string
+		builtin  authorizer.Decision
+		webhooks []chainedWebhook
+		expect   authorizer.Decision
+	}{
+		{
+			name:     "builtin deny is not overridden",
+			builtin:  authorizer.DecisionDeny,
+			webhooks: []chainedWebhook{{Authorizer: fixed(authorizer.DecisionAllow, nil)}},
+			expect:   authorizer.DecisionDeny,
+		},
+		{
+			name:    "webhook denies",
+			builtin: authorizer.DecisionAllow,
+			webhooks: []chainedWebhook{
+				{Authorizer: fixed(authorizer.DecisionNoOpinion, nil)},
+				{Authorizer: fixed(authorizer.DecisionDeny, nil)},
+			},
+			expect: authorizer.DecisionDeny,
+		},
+		{
+			name:     "privileged user skips webhooks",
+			username: "admin",
+			builtin:  authorizer.DecisionAllow,
+			webhooks: []chainedWebhook{{Authorizer: fixed(authorizer.DecisionDeny, nil)}},
+			expect:   authorizer.DecisionAllow,
+		},
+		{
+			name:    "fail open",
+			builtin: authorizer.DecisionAllow,
+			webhooks: []chainedWebhook{
+				{Authorizer: fixed(authorizer.DecisionNoOpinion, errors.New("unavailable")), failurePolicy: FailurePolicyOpen},
+			},
+			expect: authorizer.DecisionAllow,
+		},
+		{
+			name:    "fail closed on timeout",
+			builtin: authorizer.DecisionAllow,
+			webhooks: []chainedWebhook{
+				{Authorizer: slow(), failurePolicy: FailurePolicyClosed},
+			},
+			expect: authorizer.DecisionDeny,
+		},
+	}
+
+	for _, tc := range testCases {
+		for i := range tc.webhooks {
+			tc.webhooks[i].name = "test"
+			tc.webhooks[i].timeout = 100 * time.Millisecond
+		}
+		a := &Authorizer{
+			builtin:            fixed(tc.builtin, nil),
+			webhooks:           tc.webhooks,
+			privilegedUsername: "admin",
+		}
+		attr := &authorizer.AttributesRecord{User: &user.DefaultInfo{Name: tc.username}}
+		decision, _, _ := a.Authorize(context.Background(), attr)
+		if decision != tc.expect {
+			t.Errorf("%s: expect %v, got %v", tc.name, tc.expect, decision)
+		}
+	}
+}
@@ -215,8 +215,14 @@ func (c *Controller) syncItem(key string) error {
 		if customPolicy.Status.Phase == v1.BindingTerminating {
 			log.Info("Delete custom policy", log.String("key", key))
 			err = c.custompolicyedResourcesDeleter.Delete(context.Background(), namespace, name)
+		} else if customPolicy.Spec.ExpireTime != nil && !customPolicy.Spec.ExpireTime.After(time.Now()) {
+			err = c.revoke(context.Background(), customPolicy)
 		} else {
 			err = c.processUpdate(customPolicy, key)
+			if err == nil && customPolicy.Spec.ExpireTime != nil {
+				// resync when the binding expires to revoke it
+				c.queue.AddAfter(key, time.Until(customPolicy.Spec.ExpireTime.Time))
+			}
 		}
 
 		log.Debug("Handle customPolicy", log.Any("customPolicy", customPolicy))
@@ -224,6 +230,33 @@ func (c *Controller) syncItem(key string) error {
 	return err
 }
 
+// revoke deletes the expired binding, the rules of the binding are removed by
+// the deleter when the binding is terminating. The granter, reason and expire
+// time of the binding are recorded in the audit event of the deletion by the
+// apiserver.
+func (c *Controller) revoke(ctx context.Context, binding *v1.CustomPolicyBinding) error {
+	log.Info("Revoke expired custom policy binding",
+		log.String("namespace", binding.Namespace),
+		log.String("name", binding.Name),
+		log.String("tenantID", binding.Spec.TenantID),
+		log.String("policyID", binding.Spec.PolicyID),
+		log.Strings("resources", binding.Spec.Resources),
+		log.Any("users", binding.Spec.Users),
+		log.Any("groups", binding.Spec.Groups),
+		log.String("granter", binding.Spec.Granter),
+		log.String("extender", binding.Spec.Extender),
+		log.String("reason", binding.Spec.Reason),
+		log.Time("expireTime", binding.Spec.ExpireTime.Time))
+
+	err := c.client.AuthV1().CustomPolicyBindings(binding.Namespace).Delete(ctx, binding.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &binding.UID},
+	})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
 func (c *Controller) processUpdate(binding *v1.CustomPolicyBinding, key string) error {
 	// start update policy if needed
 	err := c.handlePhase(key, binding)
@@ -0,0 +1,139 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package custompolicybinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/api/auth"
+	v1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	informers "tkestack.io/tke/api/client/informers/externalversions"
+)
+
+// fakeQueue records the items added with delay.
+type fakeQueue struct {
+	workqueue.RateLimitingInterface
+	delays map[interface{}]time.Duration
+}
+
+func (q *fakeQueue) AddAfter(item interface{}, duration time.Duration) {
+	q.delays[item] = duration
+}
+
+func newTestController(t *testing.T, binding *v1.CustomPolicyBinding, objects ...runtime.Object) (*Controller, *fake.Clientset, *fakeQueue) {
+	client := fake.NewSimpleClientset(append(objects, binding)...)
+	informerFactory := informers.NewSharedInformerFactory(client, 0)
+	bindingInformer := informerFactory.Auth().V1().CustomPolicyBindings()
+	if err := bindingInformer.Informer().GetIndexer().Add(binding); err != nil {
+		t.Fatal(err)
+	}
+	m, err := model.NewModelFromString(auth.DefaultRuleModel)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enforcer, err := casbin.NewSyncedEnforcer(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewController(client, bindingInformer, informerFactory.Auth().V1().Rules(), enforcer, 0, v1.CustomPolicyBindingFinalize)
+	queue := &fakeQueue{RateLimitingInterface: c.queue, delays: make(map[interface{}]time.Duration)}
+	c.queue = queue
+	return c, client, queue
+}
+
+func newBinding(expireTime *metav1.Time) *v1.CustomPolicyBinding {
+	return &v1.CustomPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "cpb-1", Namespace: "default", UID: "uid-1"},
+		Spec: v1.CustomPolicyBindingSpec{
+			PolicyID:   "pol-1",
+			Reason:     "on call",
+			ExpireTime: expireTime,
+			Granter:    "admin",
+		},
+		Status: v1.CustomPolicyBindingStatus{Phase: v1.BindingActive},
+	}
+}
+
+func TestSyncItemExpiration(t *testing.T) {
+	policy := &v1.Policy{ObjectMeta: metav1.ObjectMeta{Name: "pol-1"}}
+	past := metav1.NewTime(time.Now().Add(-time.Minute))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	tests := []struct {
+		name        string
+		expireTime  *metav1.Time
+		wantRevoked bool
+		wantRequeue bool
+	}{
+		{
+			name:       "never expires",
+			expireTime: nil,
+		},
+		{
+			name:        "expires later",
+			expireTime:  &future,
+			wantRequeue: true,
+		},
+		{
+			name:        "expired",
+			expireTime:  &past,
+			wantRevoked: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, client, queue := newTestController(t, newBinding(tt.expireTime), policy)
+			if err := c.syncItem("default/cpb-1"); err != nil {
+				t.Fatalf("syncItem() error = %v", err)
+			}
+
+			_, err := client.AuthV1().CustomPolicyBindings("default").Get(context.Background(), "cpb-1", metav1.GetOptions{})
+			if revoked := errors.IsNotFound(err); revoked != tt.wantRevoked {
+				t.Errorf("revoked = %v, want %v", revoked, tt.wantRevoked)
+			}
+			delay, requeued := queue.delays["default/cpb-1"]
+			if requeued != tt.wantRequeue {
+				t.Fatalf("requeued = %v, want %v", requeued, tt.wantRequeue)
+			}
+			if requeued && (delay <= 59*time.Minute || delay > time.Hour) {
+				t.Errorf("requeued after %v, want about an hour until expiration", delay)
+			}
+		})
+	}
+}
+
+func TestRevokeNotFound(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Minute))
+	binding := newBinding(&past)
+	c, client, _ := newTestController(t, binding)
+	if err := client.AuthV1().CustomPolicyBindings("default").Delete(context.Background(), "cpb-1", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.revoke(context.Background(), binding); err != nil {
+		t.Errorf("revoke() of deleted binding error = %v", err)
+	}
+}
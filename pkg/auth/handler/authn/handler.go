@@ -43,6 +43,14 @@ func NewHandler(authTokenHandlers ...authenticator.Token) *Handler {
 	return &Handler{union.New(authTokenHandlers...)}
 }
 
+// AuthenticateClusterToken handles token authentication http request from
+// the cluster in the path, the tokens issued for other clusters are rejected.
+func (h *Handler) AuthenticateClusterToken(request *restful.Request, response *restful.Response) {
+	ctx := authenticator.WithAudiences(request.Request.Context(), authenticator.Audiences{request.PathParameter("clusterName")})
+	request.Request = request.Request.WithContext(ctx)
+	h.AuthenticateToken(request, response)
+}
+
 // AuthenticateToken handles token authentication http request.
 func (h *Handler) AuthenticateToken(request *restful.Request, response *restful.Response) {
 	tokenReview := &authv1.TokenReview{}
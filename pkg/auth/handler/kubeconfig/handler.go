@@ -0,0 +1,338 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeconfig
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/token/union"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/client-go/tools/clientcmd"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	platformutil "tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util"
+	kubeconfigutil "tkestack.io/tke/pkg/util/kubeconfig"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// DefaultTTL is the validity of the kubeconfig if not specified.
+	DefaultTTL = time.Hour
+	// MaxTTL is the maximum validity of the kubeconfig.
+	MaxTTL = 24 * time.Hour
+)
+
+// IssueRequest is the request to issue a kubeconfig of the cluster.
+type IssueRequest struct {
+	// ClusterName is the name of the cluster.
+	ClusterName string `json:"clusterName"`
+	// TTL is the validity of the kubeconfig, such as 8h. Defaults to 1h.
+	// +optional
+	TTL metav1.Duration `json:"ttl,omitempty"`
+}
+
+// Kubeconfig is the issued kubeconfig and its record.
+type Kubeconfig struct {
+	authutil.KubeconfigRecord
+	// Kubeconfig is the content of the kubeconfig file, with the bearer token
+	// which expires with the kubeconfig.
+	Kubeconfig string `json:"kubeconfig"`
+}
+
+// KubeconfigList is the list of the kubeconfigs issued to the user.
+type KubeconfigList struct {
+	Items []authutil.KubeconfigRecord `json:"items"`
+}
+
+// Handler issues the short-lived kubeconfigs of the clusters. The user
+// exchanges the token for the kubeconfig, whose bearer token is authenticated
+// by the token webhook of tke-auth and expires soon. The token is rejected by
+// the webhook once the kubeconfig is revoked.
+type Handler struct {
+	tokenAuthenticator authenticator.Token
+	authClient         authinternalclient.AuthInterface
+	configMapLister    authv1lister.ConfigMapLister
+	platformClient     platformversionedclient.PlatformV1Interface
+	privilegedUsername string
+}
+
+// NewHandler creates new kubeconfig handler object.
+func NewHandler(authClient authinternalclient.AuthInterface, configMapLister authv1lister.ConfigMapLister, platformClient platformversionedclient.PlatformV1Interface, privilegedUsername string, authTokenHandlers ...authenticator.Token) *Handler {
+	return &Handler{
+		tokenAuthenticator: union.New(authTokenHandlers...),
+		authClient:         authClient,
+		configMapLister:    configMapLister,
+		platformClient:     platformClient,
+		privilegedUsername: privilegedUsername,
+	}
+}
+
+// Issue issues a kubeconfig of the cluster for the user.
+func (h *Handler) Issue(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	userInfo, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	issueRequest := &IssueRequest{}
+	if err := request.ReadEntity(issueRequest); err != nil {
+		writeError(response, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+	ttl := issueRequest.TTL.Duration
+	if ttl == 0 {
+		ttl = DefaultTTL
+	}
+	if ttl < 0 || ttl > MaxTTL {
+		writeError(response, apierrors.NewBadRequest(fmt.Sprintf("ttl must be positive and no more than %s", MaxTTL)))
+		return
+	}
+
+	cluster, err := h.platformClient.Clusters().Get(ctx, issueRequest.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if tenantID != "" && cluster.Spec.TenantID != tenantID {
+		writeError(response, apierrors.NewNotFound(platformv1.Resource("clusters"), issueRequest.ClusterName))
+		return
+	}
+	if cluster.Status.Phase != platformv1.ClusterRunning {
+		writeError(response, apierrors.NewBadRequest(fmt.Sprintf("cluster %s is not running", cluster.ObjectMeta.Name)))
+		return
+	}
+	if cluster.Status.Locked != nil && *cluster.Status.Locked {
+		writeError(response, apierrors.NewBadRequest(fmt.Sprintf("cluster %s has been locked", cluster.ObjectMeta.Name)))
+		return
+	}
+	// The tokens are authenticated by the webhooks of tke-auth, which are
+	// installed on the clusters with the authorization webhook.
+	if !cluster.AuthzWebhookEnabled() {
+		writeError(response, apierrors.NewBadRequest(fmt.Sprintf("cluster %s does not authenticate the requests by tke-auth, the kubeconfig can not be revoked", cluster.ObjectMeta.Name)))
+		return
+	}
+	credential, err := platformutil.GetClusterCredentialV1(ctx, h.platformClient, cluster)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if credential == nil || len(credential.CACert) == 0 {
+		writeError(response, apierrors.NewBadRequest(fmt.Sprintf("the ca of cluster %s is unknown", cluster.ObjectMeta.Name)))
+		return
+	}
+	host, err := serverHost(cluster)
+	if err != nil {
+		writeError(response, apierrors.NewBadRequest(err.Error()))
+		return
+	}
+
+	id, err := newID()
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	secret, err := newID()
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	groups := []string{fmt.Sprintf("%s:%s", authutil.KubeconfigGroupKey, id)}
+	if tenantID != "" {
+		groups = append(groups, fmt.Sprintf("tenant:%s", tenantID))
+	}
+	for _, group := range userInfo.GetGroups() {
+		if !strings.HasPrefix(group, "system:") {
+			groups = append(groups, group)
+		}
+	}
+	now := time.Now()
+	record := authutil.KubeconfigRecord{
+		ID:          id,
+		TenantID:    tenantID,
+		Username:    userInfo.GetName(),
+		Groups:      groups,
+		ClusterName: cluster.ObjectMeta.Name,
+		IssueAt:     metav1.NewTime(now),
+		ExpireAt:    metav1.NewTime(now.Add(ttl)),
+		TokenHash:   authutil.HashKubeconfigSecret(secret),
+	}
+	config := kubeconfigutil.CreateWithToken(host, cluster.ObjectMeta.Name, userInfo.GetName(), credential.CACert, authutil.KubeconfigToken(id, secret))
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		writeError(response, apierrors.NewInternalError(err))
+		return
+	}
+	if _, err := h.authClient.ConfigMaps().Create(ctx, record.ConfigMap(), metav1.CreateOptions{}); err != nil {
+		writeError(response, err)
+		return
+	}
+
+	log.Info("Issue kubeconfig", log.String("kubeconfig", id), log.String("user", userInfo.GetName()),
+		log.String("tenantID", tenantID), log.String("cluster", cluster.ObjectMeta.Name), log.Duration("ttl", ttl))
+	responsewriters.WriteRawJSON(http.StatusCreated, &Kubeconfig{KubeconfigRecord: record, Kubeconfig: string(data)}, response.ResponseWriter)
+}
+
+// List lists the kubeconfigs issued to the user which have not expired. The
+// expired records are cleaned up by the sweeper.
+func (h *Handler) List(request *restful.Request, response *restful.Response) {
+	userInfo, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	configMaps, err := h.configMapLister.List(labels.SelectorFromSet(labels.Set{authutil.KubeconfigLabel: "true"}))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	list := &KubeconfigList{Items: []authutil.KubeconfigRecord{}}
+	for _, configMap := range configMaps {
+		record, ok := authutil.KubeconfigRecordFromV1ConfigMap(configMap)
+		if !ok || record.Expired() {
+			continue
+		}
+		if record.TenantID == tenantID && record.Username == userInfo.GetName() {
+			list.Items = append(list.Items, *record)
+		}
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, list, response.ResponseWriter)
+}
+
+// Revoke revokes the kubeconfig. The kubeconfigs can be revoked by the users
+// they are issued to and the administrators of the tenant.
+func (h *Handler) Revoke(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	userInfo, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	id := request.PathParameter("id")
+	configMap, err := h.configMapLister.Get(authutil.KubeconfigConfigMapName(id))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	record, ok := authutil.KubeconfigRecordFromV1ConfigMap(configMap)
+	if !ok || (record.TenantID != tenantID && userInfo.GetName() != h.privilegedUsername) {
+		writeError(response, apierrors.NewNotFound(auth.Resource("kubeconfigs"), id))
+		return
+	}
+	if record.Username != userInfo.GetName() {
+		isAdmin, err := h.isAdministrator(ctx, userInfo.GetName(), tenantID)
+		if err != nil {
+			writeError(response, err)
+			return
+		}
+		if !isAdmin {
+			writeError(response, apierrors.NewForbidden(auth.Resource("kubeconfigs"), id, fmt.Errorf("kubeconfig is not issued to %s", userInfo.GetName())))
+			return
+		}
+	}
+	if err := h.authClient.ConfigMaps().Delete(ctx, configMap.ObjectMeta.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		writeError(response, err)
+		return
+	}
+
+	log.Info("Revoke kubeconfig", log.String("kubeconfig", id), log.String("user", record.Username),
+		log.String("operator", userInfo.GetName()), log.String("tenantID", tenantID))
+	responsewriters.WriteRawJSON(http.StatusOK, record, response.ResponseWriter)
+}
+
+// caller authenticates the bearer token of the request, which is the id
+// token or the api key of the user.
+func (h *Handler) caller(request *restful.Request) (user.Info, string, error) {
+	token := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if len(token) < len("bearer ") || !strings.EqualFold(token[:len("bearer ")], "bearer ") {
+		return nil, "", apierrors.NewUnauthorized("bearer token is required")
+	}
+	resp, valid, err := h.tokenAuthenticator.AuthenticateToken(request.Request.Context(), strings.TrimSpace(token[len("bearer "):]))
+	if !valid || err != nil {
+		log.Warn("Failed to authenticate kubeconfig request", log.Bool("valid", valid), log.Err(err))
+		return nil, "", apierrors.NewUnauthorized("invalid bearer token")
+	}
+
+	var tenantID string
+	if tenantIDs := resp.User.GetExtra()[genericoidc.TenantIDKey]; len(tenantIDs) > 0 {
+		tenantID = tenantIDs[0]
+	}
+	return resp.User, tenantID, nil
+}
+
+func (h *Handler) isAdministrator(ctx context.Context, username, tenantID string) (bool, error) {
+	if username == h.privilegedUsername {
+		return true, nil
+	}
+	if tenantID == "" {
+		return false, nil
+	}
+	idp, err := h.authClient.IdentityProviders().Get(ctx, tenantID, metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	return util.InStringSlice(idp.Spec.Administrators, username), nil
+}
+
+// serverHost returns the address of the apiserver of the cluster which is
+// accessible to the users.
+func serverHost(cluster *platformv1.Cluster) (string, error) {
+	for _, addrType := range []platformv1.AddressType{platformv1.AddressPublic, platformv1.AddressAdvertise, platformv1.AddressReal, platformv1.AddressInternal} {
+		if address := cluster.Address(addrType); address != nil {
+			return net.JoinHostPort(address.Host, strconv.Itoa(int(address.Port))) + address.Path, nil
+		}
+	}
+	return "", fmt.Errorf("cluster %s has no address", cluster.ObjectMeta.Name)
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func writeError(response *restful.Response, err error) {
+	status, ok := err.(apierrors.APIStatus)
+	if !ok {
+		status = apierrors.NewInternalError(err)
+	}
+	responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), response.ResponseWriter)
+}
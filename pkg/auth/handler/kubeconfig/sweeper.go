@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeconfig
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// SweepInterval is the interval of deleting the records of the expired kubeconfigs.
+const SweepInterval = 10 * time.Minute
+
+type sweeperHookHandler struct {
+	authClient      authinternalclient.AuthInterface
+	configMapLister authv1lister.ConfigMapLister
+	interval        time.Duration
+}
+
+// NewSweeperHookHandler creates a new sweeperHookHandler object, which deletes
+// the records of the expired kubeconfigs in background.
+func NewSweeperHookHandler(authClient authinternalclient.AuthInterface, configMapLister authv1lister.ConfigMapLister) genericapiserver.PostStartHookProvider {
+	return &sweeperHookHandler{
+		authClient:      authClient,
+		configMapLister: configMapLister,
+		interval:        SweepInterval,
+	}
+}
+
+func (s *sweeperHookHandler) PostStartHook() (string, genericapiserver.PostStartHookFunc, error) {
+	return "sweep-expired-kubeconfigs", func(ctx genericapiserver.PostStartHookContext) error {
+		go wait.Until(s.sweep, s.interval, ctx.StopCh)
+		return nil
+	}, nil
+}
+
+// sweep deletes the records of the expired kubeconfigs, the expired tokens are
+// rejected by the token webhook before the records are deleted.
+func (s *sweeperHookHandler) sweep() {
+	configMaps, err := s.configMapLister.List(labels.SelectorFromSet(labels.Set{authutil.KubeconfigLabel: "true"}))
+	if err != nil {
+		log.Warn("Failed to list the records of kubeconfigs", log.Err(err))
+		return
+	}
+	for _, configMap := range configMaps {
+		record, ok := authutil.KubeconfigRecordFromV1ConfigMap(configMap)
+		if !ok || !record.Expired() {
+			continue
+		}
+		err := s.authClient.ConfigMaps().Delete(context.Background(), configMap.ObjectMeta.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			log.Warn("Failed to delete the record of kubeconfig", log.String("kubeconfig", record.ID), log.Err(err))
+			continue
+		}
+		log.Info("Delete the record of expired kubeconfig", log.String("kubeconfig", record.ID))
+	}
+}
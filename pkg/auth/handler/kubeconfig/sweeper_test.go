@@ -0,0 +1,82 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeconfig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	authv1lister "tkestack.io/tke/api/client/listers/auth/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+func TestSweep(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	client := fake.NewSimpleClientset()
+	now := time.Now()
+	for id, expireAt := range map[string]time.Time{
+		"valid":   now.Add(time.Hour),
+		"expired": now.Add(-time.Second),
+	} {
+		record := &authutil.KubeconfigRecord{
+			ID:          id,
+			Username:    "alice",
+			ClusterName: "cls-a",
+			IssueAt:     metav1.NewTime(now.Add(-time.Hour)),
+			ExpireAt:    metav1.NewTime(expireAt),
+			TokenHash:   authutil.HashKubeconfigSecret("secret"),
+		}
+		configMap := record.ConfigMap()
+		if err := client.Tracker().Add(configMap); err != nil {
+			t.Fatal(err)
+		}
+		if err := indexer.Add(&authv1.ConfigMap{ObjectMeta: configMap.ObjectMeta, Data: configMap.Data}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// the config maps which are not kubeconfig records are kept
+	if err := indexer.Add(&authv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "other"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewSweeperHookHandler(client.Auth(), authv1lister.NewConfigMapLister(indexer)).(*sweeperHookHandler)
+	s.sweep()
+
+	ctx := context.Background()
+	if _, err := client.Auth().ConfigMaps().Get(ctx, authutil.KubeconfigConfigMapName("expired"), metav1.GetOptions{}); err == nil {
+		t.Error("expected the record of expired kubeconfig deleted")
+	}
+	if _, err := client.Auth().ConfigMaps().Get(ctx, authutil.KubeconfigConfigMapName("valid"), metav1.GetOptions{}); err != nil {
+		t.Errorf("expected the record of valid kubeconfig kept: %v", err)
+	}
+	deletes := 0
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "delete" {
+			deletes++
+		}
+	}
+	if deletes != 1 {
+		t.Errorf("expected 1 record deleted, got %d", deletes)
+	}
+}
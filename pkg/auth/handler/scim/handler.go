@@ -0,0 +1,761 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scim
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authentication/token/union"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/api/auth"
+	authinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/auth/internalversion"
+	genericoidc "tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// externalIDKey is the key of the extra of the local identities and groups
+	// storing the id of the resource in the identity provider.
+	externalIDKey = "scimExternalID"
+
+	defaultCount = 100
+	maxCount     = 1000
+)
+
+// Handler handles the scim requests of the identity providers. The requests
+// are authenticated by the api keys and forwarded to the apiserver on behalf
+// of the owner of the api key, so the tenant isolation and the authorization
+// apply as usual.
+type Handler struct {
+	tokenAuthenticator authenticator.Token
+	loopbackConfig     *restclient.Config
+}
+
+// NewHandler creates new scim handler object.
+func NewHandler(loopbackConfig *restclient.Config, authTokenHandlers ...authenticator.Token) *Handler {
+	return &Handler{
+		tokenAuthenticator: union.New(authTokenHandlers...),
+		loopbackConfig:     loopbackConfig,
+	}
+}
+
+// ServiceProviderConfig returns the features supported by the service.
+func (h *Handler) ServiceProviderConfig(request *restful.Request, response *restful.Response) {
+	writeJSON(response, http.StatusOK, map[string]interface{}{
+		"schemas":        []string{ServiceProviderConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": maxCount},
+		"changePassword": map[string]bool{"supported": true},
+		"sort":           map[string]bool{"supported": false},
+		"etag":           map[string]bool{"supported": false},
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "API Key",
+			"description": "Authentication with the api key of a tke user, sent as the bearer token",
+			"primary":     true,
+		}},
+	})
+}
+
+// ListUsers lists the users of the tenant.
+func (h *Handler) ListUsers(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	f, err := parseFilter(request.QueryParameter("filter"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	selector := fields.OneTermEqualSelector("spec.tenantID", tenantID)
+	if f != nil {
+		switch f.attribute {
+		case "username":
+			selector = fields.AndSelectors(selector, fields.OneTermEqualSelector("spec.username", f.value))
+		case "id", "externalid":
+		default:
+			writeError(response, badRequest("invalidFilter", "unsupported filter attribute %q", f.attribute))
+			return
+		}
+	}
+
+	localIdentityList, err := client.LocalIdentities().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	var users []interface{}
+	for i := range localIdentityList.Items {
+		localIdentity := &localIdentityList.Items[i]
+		if localIdentity.Status.Phase == auth.LocalIdentityDeleting {
+			continue
+		}
+		if f != nil && ((f.attribute == "id" && localIdentity.ObjectMeta.Name != f.value) ||
+			(f.attribute == "externalid" && localIdentity.Spec.Extra[externalIDKey] != f.value)) {
+			continue
+		}
+		users = append(users, toUser(localIdentity))
+	}
+	writeList(request, response, users)
+}
+
+// GetUser returns the user.
+func (h *Handler) GetUser(request *restful.Request, response *restful.Response) {
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	localIdentity, err := getLocalIdentity(request.Request.Context(), client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	writeJSON(response, http.StatusOK, toUser(localIdentity))
+}
+
+// CreateUser creates a local identity for the user. A random password is
+// generated if not specified, the user logs in through the single sign-on of
+// the identity provider in such case.
+func (h *Handler) CreateUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := &User{}
+	if err := readEntity(request, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	if user.UserName == "" {
+		writeError(response, badRequest("invalidValue", "userName is required"))
+		return
+	}
+
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", tenantID),
+		fields.OneTermEqualSelector("spec.username", user.UserName))
+	localIdentityList, err := client.LocalIdentities().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if len(localIdentityList.Items) > 0 {
+		writeError(response, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "user " + user.UserName + " already exists"})
+		return
+	}
+
+	password := user.Password
+	if password == "" {
+		if password, err = randomPassword(); err != nil {
+			writeError(response, err)
+			return
+		}
+	}
+	localIdentity := &auth.LocalIdentity{
+		Spec: auth.LocalIdentitySpec{
+			Username:       user.UserName,
+			TenantID:       tenantID,
+			HashedPassword: base64.StdEncoding.EncodeToString([]byte(password)),
+		},
+	}
+	fromUser(localIdentity, user)
+
+	localIdentity, err = client.LocalIdentities().Create(ctx, localIdentity, metav1.CreateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if user.Active != nil && !*user.Active {
+		localIdentity.Status.Locked = true
+		if localIdentity, err = client.LocalIdentities().UpdateStatus(ctx, localIdentity, metav1.UpdateOptions{}); err != nil {
+			writeError(response, err)
+			return
+		}
+	}
+	log.Info("Provisioned user by scim", log.String("tenantID", tenantID), log.String("user", localIdentity.Spec.Username))
+
+	writeJSON(response, http.StatusCreated, toUser(localIdentity))
+}
+
+// ReplaceUser replaces the attributes of the user.
+func (h *Handler) ReplaceUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := &User{}
+	if err := readEntity(request, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	localIdentity, err := getLocalIdentity(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if localIdentity, err = updateLocalIdentity(ctx, client, localIdentity, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	writeJSON(response, http.StatusOK, toUser(localIdentity))
+}
+
+// PatchUser modifies the attributes of the user, such as deactivating it.
+func (h *Handler) PatchUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	patch := &PatchOp{}
+	if err := readEntity(request, patch); err != nil {
+		writeError(response, err)
+		return
+	}
+	localIdentity, err := getLocalIdentity(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	user := toUser(localIdentity)
+	if err := applyUserPatch(user, patch.Operations); err != nil {
+		writeError(response, err)
+		return
+	}
+	if localIdentity, err = updateLocalIdentity(ctx, client, localIdentity, user); err != nil {
+		writeError(response, err)
+		return
+	}
+	writeJSON(response, http.StatusOK, toUser(localIdentity))
+}
+
+// DeleteUser deletes the local identity of the user.
+func (h *Handler) DeleteUser(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	localIdentity, err := getLocalIdentity(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if err := client.LocalIdentities().Delete(ctx, localIdentity.ObjectMeta.Name, metav1.DeleteOptions{}); err != nil {
+		writeError(response, err)
+		return
+	}
+	log.Info("Deprovisioned user by scim", log.String("tenantID", tenantID), log.String("user", localIdentity.Spec.Username))
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// ListGroups lists the groups of the tenant.
+func (h *Handler) ListGroups(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	f, err := parseFilter(request.QueryParameter("filter"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+
+	selector := fields.OneTermEqualSelector("spec.tenantID", tenantID)
+	if f != nil {
+		switch f.attribute {
+		case "displayname":
+			selector = fields.AndSelectors(selector, fields.OneTermEqualSelector("spec.displayName", f.value))
+		case "id", "externalid":
+		default:
+			writeError(response, badRequest("invalidFilter", "unsupported filter attribute %q", f.attribute))
+			return
+		}
+	}
+
+	groupList, err := client.LocalGroups().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	withMembers := !strings.Contains(strings.ToLower(request.QueryParameter("excludedAttributes")), "members")
+	var groups []interface{}
+	for i := range groupList.Items {
+		group := &groupList.Items[i]
+		if group.Status.Phase == auth.GroupTerminating {
+			continue
+		}
+		if f != nil && ((f.attribute == "id" && group.ObjectMeta.Name != f.value) ||
+			(f.attribute == "externalid" && group.Spec.Extra[externalIDKey] != f.value)) {
+			continue
+		}
+		groups = append(groups, toGroup(group, withMembers))
+	}
+	writeList(request, response, groups)
+}
+
+// GetGroup returns the group.
+func (h *Handler) GetGroup(request *restful.Request, response *restful.Response) {
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	group, err := getLocalGroup(request.Request.Context(), client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	withMembers := !strings.Contains(strings.ToLower(request.QueryParameter("excludedAttributes")), "members")
+	writeJSON(response, http.StatusOK, toGroup(group, withMembers))
+}
+
+// CreateGroup creates a local group with the members.
+func (h *Handler) CreateGroup(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	group := &Group{}
+	if err := readEntity(request, group); err != nil {
+		writeError(response, err)
+		return
+	}
+	if group.DisplayName == "" {
+		writeError(response, badRequest("invalidValue", "displayName is required"))
+		return
+	}
+
+	selector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.tenantID", tenantID),
+		fields.OneTermEqualSelector("spec.displayName", group.DisplayName))
+	groupList, err := client.LocalGroups().List(ctx, metav1.ListOptions{FieldSelector: selector.String()})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if len(groupList.Items) > 0 {
+		writeError(response, &scimError{status: http.StatusConflict, scimType: "uniqueness", detail: "group " + group.DisplayName + " already exists"})
+		return
+	}
+
+	localGroup := &auth.LocalGroup{
+		Spec: auth.LocalGroupSpec{
+			TenantID: tenantID,
+		},
+	}
+	fromGroup(localGroup, group)
+	localGroup.Status.Users = toSubjects(group.Members)
+
+	localGroup, err = client.LocalGroups().Create(ctx, localGroup, metav1.CreateOptions{})
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	log.Info("Provisioned group by scim", log.String("tenantID", tenantID), log.String("group", localGroup.Spec.DisplayName))
+
+	writeJSON(response, http.StatusCreated, toGroup(localGroup, true))
+}
+
+// ReplaceGroup replaces the attributes and the members of the group.
+func (h *Handler) ReplaceGroup(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	group := &Group{}
+	if err := readEntity(request, group); err != nil {
+		writeError(response, err)
+		return
+	}
+	localGroup, err := getLocalGroup(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if localGroup, err = updateLocalGroup(ctx, client, localGroup, group); err != nil {
+		writeError(response, err)
+		return
+	}
+	writeJSON(response, http.StatusOK, toGroup(localGroup, true))
+}
+
+// PatchGroup modifies the attributes of the group, such as adding or removing
+// members.
+func (h *Handler) PatchGroup(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	patch := &PatchOp{}
+	if err := readEntity(request, patch); err != nil {
+		writeError(response, err)
+		return
+	}
+	localGroup, err := getLocalGroup(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	group := toGroup(localGroup, true)
+	if err := applyGroupPatch(group, patch.Operations); err != nil {
+		writeError(response, err)
+		return
+	}
+	if localGroup, err = updateLocalGroup(ctx, client, localGroup, group); err != nil {
+		writeError(response, err)
+		return
+	}
+	writeJSON(response, http.StatusOK, toGroup(localGroup, true))
+}
+
+// DeleteGroup deletes the local group.
+func (h *Handler) DeleteGroup(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	client, tenantID, err := h.caller(request)
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	localGroup, err := getLocalGroup(ctx, client, tenantID, request.PathParameter("id"))
+	if err != nil {
+		writeError(response, err)
+		return
+	}
+	if err := client.LocalGroups().Delete(ctx, localGroup.ObjectMeta.Name, metav1.DeleteOptions{}); err != nil {
+		writeError(response, err)
+		return
+	}
+	log.Info("Deprovisioned group by scim", log.String("tenantID", tenantID), log.String("group", localGroup.Spec.DisplayName))
+	response.WriteHeader(http.StatusNoContent)
+}
+
+// caller authenticates the bearer token and returns the client acting on
+// behalf of the owner of the token, and the tenant of the owner.
+func (h *Handler) caller(request *restful.Request) (authinternalclient.AuthInterface, string, error) {
+	token := strings.TrimSpace(request.HeaderParameter("Authorization"))
+	if len(token) < len("bearer ") || !strings.EqualFold(token[:len("bearer ")], "bearer ") {
+		return nil, "", &scimError{status: http.StatusUnauthorized, detail: "bearer token is required"}
+	}
+	resp, valid, err := h.tokenAuthenticator.AuthenticateToken(request.Request.Context(), strings.TrimSpace(token[len("bearer "):]))
+	if !valid || err != nil {
+		log.Warn("Failed to authenticate scim request", log.Bool("valid", valid), log.Err(err))
+		return nil, "", &scimError{status: http.StatusUnauthorized, detail: "invalid bearer token"}
+	}
+
+	var tenantID string
+	if tenantIDs := resp.User.GetExtra()[genericoidc.TenantIDKey]; len(tenantIDs) > 0 {
+		tenantID = tenantIDs[0]
+	}
+	if tenantID == "" {
+		return nil, "", &scimError{status: http.StatusForbidden, detail: "the token does not belong to any tenant"}
+	}
+
+	config := restclient.CopyConfig(h.loopbackConfig)
+	config.Impersonate = restclient.ImpersonationConfig{
+		UserName: resp.User.GetName(),
+		Groups:   resp.User.GetGroups(),
+		Extra:    map[string][]string{genericoidc.TenantIDKey: {tenantID}},
+	}
+	client, err := authinternalclient.NewForConfig(config)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, tenantID, nil
+}
+
+func getLocalIdentity(ctx context.Context, client authinternalclient.AuthInterface, tenantID, id string) (*auth.LocalIdentity, error) {
+	localIdentity, err := client.LocalIdentities().Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if localIdentity.Spec.TenantID != tenantID || localIdentity.Status.Phase == auth.LocalIdentityDeleting {
+		return nil, &scimError{status: http.StatusNotFound, detail: "user " + id + " not found"}
+	}
+	return localIdentity, nil
+}
+
+func getLocalGroup(ctx context.Context, client authinternalclient.AuthInterface, tenantID, id string) (*auth.LocalGroup, error) {
+	localGroup, err := client.LocalGroups().Get(ctx, id, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if localGroup.Spec.TenantID != tenantID || localGroup.Status.Phase == auth.GroupTerminating {
+		return nil, &scimError{status: http.StatusNotFound, detail: "group " + id + " not found"}
+	}
+	return localGroup, nil
+}
+
+// updateLocalIdentity updates the local identity with the attributes of the
+// user, and locks or unlocks it according to the active attribute.
+func updateLocalIdentity(ctx context.Context, client authinternalclient.AuthInterface, localIdentity *auth.LocalIdentity, user *User) (*auth.LocalIdentity, error) {
+	if user.UserName != "" && user.UserName != localIdentity.Spec.Username {
+		return nil, badRequest("mutability", "userName can not be changed")
+	}
+	fromUser(localIdentity, user)
+	// The stored password is hashed, keep it unless a new one is specified.
+	localIdentity.Spec.HashedPassword = ""
+	if user.Password != "" {
+		localIdentity.Spec.HashedPassword = base64.StdEncoding.EncodeToString([]byte(user.Password))
+	}
+
+	updated, err := client.LocalIdentities().Update(ctx, localIdentity, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	locked := user.Active != nil && !*user.Active
+	if updated.Status.Locked != locked {
+		updated.Status.Locked = locked
+		if updated, err = client.LocalIdentities().UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+		log.Info("Changed user state by scim", log.String("tenantID", updated.Spec.TenantID), log.String("user", updated.Spec.Username), log.Bool("locked", locked))
+	}
+	return updated, nil
+}
+
+// updateLocalGroup updates the local group with the attributes of the group,
+// the members are changed through the status.
+func updateLocalGroup(ctx context.Context, client authinternalclient.AuthInterface, localGroup *auth.LocalGroup, group *Group) (*auth.LocalGroup, error) {
+	if group.DisplayName == "" {
+		return nil, badRequest("invalidValue", "displayName is required")
+	}
+	fromGroup(localGroup, group)
+
+	updated, err := client.LocalGroups().Update(ctx, localGroup, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	updated.Status.Users = toSubjects(group.Members)
+	return client.LocalGroups().UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+}
+
+func toUser(localIdentity *auth.LocalIdentity) *User {
+	active := !localIdentity.Status.Locked
+	user := &User{
+		Schemas:     []string{UserSchema},
+		ID:          localIdentity.ObjectMeta.Name,
+		ExternalID:  localIdentity.Spec.Extra[externalIDKey],
+		UserName:    localIdentity.Spec.Username,
+		DisplayName: localIdentity.Spec.DisplayName,
+		Active:      &active,
+		Meta:        meta("User", &localIdentity.ObjectMeta, localIdentity.Status.LastUpdateTime),
+	}
+	if localIdentity.Spec.DisplayName != "" {
+		user.Name = &Name{Formatted: localIdentity.Spec.DisplayName}
+	}
+	if localIdentity.Spec.Email != "" {
+		user.Emails = []MultiValue{{Value: localIdentity.Spec.Email, Type: "work", Primary: true}}
+	}
+	if localIdentity.Spec.PhoneNumber != "" {
+		user.PhoneNumbers = []MultiValue{{Value: localIdentity.Spec.PhoneNumber, Type: "mobile", Primary: true}}
+	}
+	return user
+}
+
+func fromUser(localIdentity *auth.LocalIdentity, user *User) {
+	displayName := user.DisplayName
+	if displayName == "" && user.Name != nil {
+		displayName = user.Name.Formatted
+		if displayName == "" {
+			displayName = strings.TrimSpace(user.Name.GivenName + " " + user.Name.FamilyName)
+		}
+	}
+	if displayName == "" {
+		displayName = localIdentity.Spec.Username
+	}
+	localIdentity.Spec.DisplayName = displayName
+	localIdentity.Spec.Email = primaryValue(user.Emails)
+	localIdentity.Spec.PhoneNumber = primaryValue(user.PhoneNumbers)
+	localIdentity.Spec.Extra = setExternalID(localIdentity.Spec.Extra, user.ExternalID)
+}
+
+func toGroup(localGroup *auth.LocalGroup, withMembers bool) *Group {
+	group := &Group{
+		Schemas:     []string{GroupSchema},
+		ID:          localGroup.ObjectMeta.Name,
+		ExternalID:  localGroup.Spec.Extra[externalIDKey],
+		DisplayName: localGroup.Spec.DisplayName,
+		Meta:        meta("Group", &localGroup.ObjectMeta, metav1.Time{}),
+	}
+	if withMembers {
+		for _, subject := range localGroup.Status.Users {
+			group.Members = append(group.Members, MultiValue{Value: subject.ID, Display: subject.Name})
+		}
+	}
+	return group
+}
+
+func fromGroup(localGroup *auth.LocalGroup, group *Group) {
+	localGroup.Spec.DisplayName = group.DisplayName
+	localGroup.Spec.Extra = setExternalID(localGroup.Spec.Extra, group.ExternalID)
+}
+
+// toSubjects converts the members to the subjects of the local group, the
+// names are filled by the apiserver.
+func toSubjects(members []MultiValue) []auth.Subject {
+	var subjects []auth.Subject
+	for _, m := range addMembers(nil, members) {
+		subjects = append(subjects, auth.Subject{ID: m.Value})
+	}
+	return subjects
+}
+
+func setExternalID(extra map[string]string, externalID string) map[string]string {
+	if externalID == "" {
+		delete(extra, externalIDKey)
+		return extra
+	}
+	if extra == nil {
+		extra = map[string]string{}
+	}
+	extra[externalIDKey] = externalID
+	return extra
+}
+
+func meta(resourceType string, objectMeta *metav1.ObjectMeta, lastUpdateTime metav1.Time) *Meta {
+	m := &Meta{
+		ResourceType: resourceType,
+		Created:      objectMeta.CreationTimestamp.UTC().Format(time.RFC3339),
+		LastModified: objectMeta.CreationTimestamp.UTC().Format(time.RFC3339),
+		Location:     PathPrefix + "/" + resourceType + "s/" + objectMeta.Name,
+	}
+	if !lastUpdateTime.IsZero() {
+		m.LastModified = lastUpdateTime.UTC().Format(time.RFC3339)
+	}
+	return m
+}
+
+func randomPassword() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func readEntity(request *restful.Request, entity interface{}) error {
+	if err := json.NewDecoder(request.Request.Body).Decode(entity); err != nil {
+		return badRequest("invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+// writeList writes the page of the resources selected by the startIndex and
+// count parameters.
+func writeList(request *restful.Request, response *restful.Response, resources []interface{}) {
+	startIndex, err := strconv.Atoi(request.QueryParameter("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(request.QueryParameter("count"))
+	if err != nil {
+		count = defaultCount
+	}
+	if count < 0 {
+		count = 0
+	}
+	if count > maxCount {
+		count = maxCount
+	}
+
+	page := []interface{}{}
+	if startIndex <= len(resources) {
+		end := startIndex - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = append(page, resources[startIndex-1:end]...)
+	}
+	writeJSON(response, http.StatusOK, &ListResponse{
+		Schemas:      []string{ListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func writeJSON(response *restful.Response, status int, value interface{}) {
+	if err := response.WriteHeaderAndJson(status, value, MIMEType); err != nil {
+		log.Error("Failed to write scim response", log.Err(err))
+	}
+}
+
+func writeError(response *restful.Response, err error) {
+	e, ok := err.(*scimError)
+	if !ok {
+		e = apiError(err)
+	}
+	writeJSON(response, e.status, e.body())
+}
+
+// apiError converts the error of the apiserver to the scim error.
+func apiError(err error) *scimError {
+	e := &scimError{status: http.StatusInternalServerError, detail: err.Error()}
+	switch {
+	case apierrors.IsNotFound(err):
+		e.status = http.StatusNotFound
+	case apierrors.IsAlreadyExists(err):
+		e.status, e.scimType = http.StatusConflict, "uniqueness"
+	case apierrors.IsConflict(err):
+		e.status = http.StatusConflict
+	case apierrors.IsInvalid(err), apierrors.IsBadRequest(err):
+		e.status, e.scimType = http.StatusBadRequest, "invalidValue"
+	case apierrors.IsUnauthorized(err):
+		e.status = http.StatusUnauthorized
+	case apierrors.IsForbidden(err):
+		e.status = http.StatusForbidden
+	default:
+		log.Error("Failed to handle scim request", log.Err(err))
+	}
+	return e
+}
@@ -0,0 +1,348 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	filterPattern    = regexp.MustCompile(`^\s*([A-Za-z][A-Za-z0-9.]*)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*"|true|false)\s*$`)
+	valuePathPattern = regexp.MustCompile(`^([A-Za-z]+)\[(.+)\](?:\.([A-Za-z]+))?$`)
+)
+
+// filter is an equality filter of the query, the only form sent by the
+// identity providers to look up the resources.
+type filter struct {
+	// attribute is in lower case.
+	attribute string
+	value     string
+}
+
+// parseFilter parses the filter of the form 'attribute eq "value"'.
+func parseFilter(s string) (*filter, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	matches := filterPattern.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, badRequest("invalidFilter", "unsupported filter %q, only the eq operator is supported", s)
+	}
+	value := matches[2]
+	if strings.HasPrefix(value, `"`) {
+		unquoted, err := strconv.Unquote(value)
+		if err != nil {
+			return nil, badRequest("invalidFilter", "invalid filter value %s", value)
+		}
+		value = unquoted
+	}
+	return &filter{attribute: strings.ToLower(matches[1]), value: value}, nil
+}
+
+// trimSchema strips the schema prefix of the attribute path.
+func trimSchema(path string, schema string) string {
+	return strings.TrimSpace(strings.TrimPrefix(path, schema+":"))
+}
+
+func stringValue(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return "", badRequest("invalidValue", "invalid string value %s", string(raw))
+	}
+	return s, nil
+}
+
+// boolValue accepts both the json boolean and the string form, which is sent
+// by some identity providers.
+func boolValue(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	s, err := stringValue(raw)
+	if err != nil {
+		return false, err
+	}
+	b, err = strconv.ParseBool(strings.ToLower(s))
+	if err != nil {
+		return false, badRequest("invalidValue", "invalid boolean value %s", string(raw))
+	}
+	return b, nil
+}
+
+func multiValues(raw json.RawMessage) ([]MultiValue, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var values []MultiValue
+	if err := json.Unmarshal(raw, &values); err != nil {
+		var value MultiValue
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, badRequest("invalidValue", "invalid multi-valued attribute %s", string(raw))
+		}
+		values = []MultiValue{value}
+	}
+	return values, nil
+}
+
+// primaryValue returns the primary item of the multi-valued attribute, or the
+// first one if none is marked as primary.
+func primaryValue(values []MultiValue) string {
+	for _, v := range values {
+		if v.Primary {
+			return v.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+// applyUserPatch applies the operations of a PATCH request to the user.
+// Attributes not stored by tke are ignored.
+func applyUserPatch(user *User, operations []Operation) error {
+	for _, op := range operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path == "" {
+				var attributes map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &attributes); err != nil {
+					return badRequest("invalidSyntax", "value of the operation without path must be an object")
+				}
+				for path, value := range attributes {
+					if err := setUserAttribute(user, path, value); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := setUserAttribute(user, op.Path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return badRequest("noTarget", "path is required by the remove operation")
+			}
+			if err := setUserAttribute(user, op.Path, nil); err != nil {
+				return err
+			}
+		default:
+			return badRequest("invalidSyntax", "unsupported operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+// setUserAttribute sets the attribute of the user, the attribute is removed
+// if the value is nil.
+func setUserAttribute(user *User, path string, value json.RawMessage) (err error) {
+	path = trimSchema(path, UserSchema)
+	if matches := valuePathPattern.FindStringSubmatch(path); matches != nil {
+		// Such as emails[type eq "work"].value, the single value stored by
+		// tke is replaced.
+		if matches[3] != "" && strings.ToLower(matches[3]) != "value" {
+			return nil
+		}
+		path = matches[1]
+		if len(value) > 0 && string(value) != "null" && !strings.HasPrefix(strings.TrimSpace(string(value)), "{") &&
+			!strings.HasPrefix(strings.TrimSpace(string(value)), "[") {
+			s, err := stringValue(value)
+			if err != nil {
+				return err
+			}
+			value, _ = json.Marshal([]MultiValue{{Value: s, Primary: true}})
+		}
+	}
+
+	switch strings.ToLower(path) {
+	case "username":
+		user.UserName, err = stringValue(value)
+	case "externalid":
+		user.ExternalID, err = stringValue(value)
+	case "displayname":
+		user.DisplayName, err = stringValue(value)
+	case "password":
+		user.Password, err = stringValue(value)
+	case "active":
+		if value == nil {
+			user.Active = nil
+			return nil
+		}
+		var active bool
+		if active, err = boolValue(value); err == nil {
+			user.Active = &active
+		}
+	case "name":
+		if value == nil {
+			user.Name = nil
+			return nil
+		}
+		name := &Name{}
+		if err := json.Unmarshal(value, name); err != nil {
+			return badRequest("invalidValue", "invalid name %s", string(value))
+		}
+		user.Name = name
+	case "name.formatted", "name.givenname", "name.familyname":
+		if user.Name == nil {
+			user.Name = &Name{}
+		}
+		var s string
+		if s, err = stringValue(value); err != nil {
+			return err
+		}
+		switch strings.ToLower(path) {
+		case "name.formatted":
+			user.Name.Formatted = s
+		case "name.givenname":
+			user.Name.GivenName = s
+		default:
+			user.Name.FamilyName = s
+		}
+	case "emails":
+		user.Emails, err = multiValues(value)
+	case "phonenumbers":
+		user.PhoneNumbers, err = multiValues(value)
+	}
+	return err
+}
+
+// applyGroupPatch applies the operations of a PATCH request to the group.
+func applyGroupPatch(group *Group, operations []Operation) error {
+	for _, op := range operations {
+		operation := strings.ToLower(op.Op)
+		switch operation {
+		case "add", "replace":
+			if op.Path == "" {
+				var attributes map[string]json.RawMessage
+				if err := json.Unmarshal(op.Value, &attributes); err != nil {
+					return badRequest("invalidSyntax", "value of the operation without path must be an object")
+				}
+				for path, value := range attributes {
+					if err := setGroupAttribute(group, operation, path, value); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if err := setGroupAttribute(group, operation, op.Path, op.Value); err != nil {
+				return err
+			}
+		case "remove":
+			if op.Path == "" {
+				return badRequest("noTarget", "path is required by the remove operation")
+			}
+			if err := setGroupAttribute(group, operation, op.Path, op.Value); err != nil {
+				return err
+			}
+		default:
+			return badRequest("invalidSyntax", "unsupported operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+func setGroupAttribute(group *Group, operation string, path string, value json.RawMessage) (err error) {
+	path = trimSchema(path, GroupSchema)
+	if matches := valuePathPattern.FindStringSubmatch(path); matches != nil && strings.ToLower(matches[1]) == "members" {
+		// Such as members[value eq "usr-xxx"], only removing is meaningful.
+		f, err := parseFilter(matches[2])
+		if err != nil {
+			return err
+		}
+		if f == nil || f.attribute != "value" {
+			return badRequest("invalidPath", "unsupported path %q", path)
+		}
+		if operation == "remove" {
+			group.Members = removeMembers(group.Members, []MultiValue{{Value: f.value}})
+		}
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "displayname":
+		if operation == "remove" {
+			return badRequest("mutability", "displayName is required")
+		}
+		group.DisplayName, err = stringValue(value)
+	case "externalid":
+		if operation == "remove" {
+			group.ExternalID = ""
+			return nil
+		}
+		group.ExternalID, err = stringValue(value)
+	case "members":
+		var members []MultiValue
+		if members, err = multiValues(value); err != nil {
+			return err
+		}
+		switch operation {
+		case "add":
+			group.Members = addMembers(group.Members, members)
+		case "replace":
+			group.Members = addMembers(nil, members)
+		default:
+			if len(members) == 0 {
+				group.Members = nil
+			} else {
+				group.Members = removeMembers(group.Members, members)
+			}
+		}
+	}
+	return err
+}
+
+func addMembers(members []MultiValue, added []MultiValue) []MultiValue {
+	for _, m := range added {
+		exists := false
+		for _, existing := range members {
+			if existing.Value == m.Value {
+				exists = true
+				break
+			}
+		}
+		if !exists && m.Value != "" {
+			members = append(members, MultiValue{Value: m.Value})
+		}
+	}
+	return members
+}
+
+func removeMembers(members []MultiValue, removed []MultiValue) []MultiValue {
+	var remained []MultiValue
+	for _, m := range members {
+		found := false
+		for _, r := range removed {
+			if r.Value == m.Value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			remained = append(remained, m)
+		}
+	}
+	return remained
+}
@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package scim
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseFilter(t *testing.T) {
+	f, err := parseFilter(`userName eq "alice"`)
+	if err != nil || f == nil || f.attribute != "username" || f.value != "alice" {
+		t.Errorf("unexpected filter %+v, err %v", f, err)
+	}
+	f, err = parseFilter(`externalId EQ "a\"b"`)
+	if err != nil || f == nil || f.attribute != "externalid" || f.value != `a"b` {
+		t.Errorf("unexpected filter %+v, err %v", f, err)
+	}
+	if f, err := parseFilter(""); f != nil || err != nil {
+		t.Errorf("expected empty filter, got %+v, err %v", f, err)
+	}
+	if _, err := parseFilter(`userName co "ali"`); err == nil {
+		t.Error("expected the unsupported operator is rejected")
+	}
+}
+
+func TestApplyUserPatch(t *testing.T) {
+	user := &User{UserName: "alice", DisplayName: "Alice", Emails: []MultiValue{{Value: "alice@example.com", Primary: true}}}
+	var operations []Operation
+	if err := json.Unmarshal([]byte(`[
+		{"op": "Replace", "path": "active", "value": "False"},
+		{"op": "replace", "path": "emails[type eq \"work\"].value", "value": "alice@corp.example.com"},
+		{"op": "add", "value": {"displayName": "Alice Liu", "externalId": "00u1", "title": "engineer"}},
+		{"op": "remove", "path": "phoneNumbers"}
+	]`), &operations); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyUserPatch(user, operations); err != nil {
+		t.Fatal(err)
+	}
+	if user.Active == nil || *user.Active {
+		t.Errorf("expected the user is deactivated, got %v", user.Active)
+	}
+	if primaryValue(user.Emails) != "alice@corp.example.com" || user.DisplayName != "Alice Liu" || user.ExternalID != "00u1" {
+		t.Errorf("unexpected user %+v", user)
+	}
+
+	if err := applyUserPatch(user, []Operation{{Op: "move", Path: "active"}}); err == nil {
+		t.Error("expected the unsupported operation is rejected")
+	}
+}
+
+func TestApplyGroupPatch(t *testing.T) {
+	group := &Group{DisplayName: "ops", Members: []MultiValue{{Value: "usr-a"}, {Value: "usr-b"}}}
+	var operations []Operation
+	if err := json.Unmarshal([]byte(`[
+		{"op": "add", "path": "members", "value": [{"value": "usr-c"}, {"value": "usr-a"}]},
+		{"op": "remove", "path": "members[value eq \"usr-b\"]"},
+		{"op": "replace", "value": {"displayName": "sre"}}
+	]`), &operations); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyGroupPatch(group, operations); err != nil {
+		t.Fatal(err)
+	}
+	if group.DisplayName != "sre" || !reflect.DeepEqual(group.Members, []MultiValue{{Value: "usr-a"}, {Value: "usr-c"}}) {
+		t.Errorf("unexpected group %+v", group)
+	}
+
+	if err := applyGroupPatch(group, []Operation{{Op: "remove", Path: "members", Value: json.RawMessage(`[{"value": "usr-a"}]`)}}); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(group.Members, []MultiValue{{Value: "usr-c"}}) {
+		t.Errorf("unexpected members %+v", group.Members)
+	}
+	if err := applyGroupPatch(group, []Operation{{Op: "remove", Path: "members"}}); err != nil || len(group.Members) != 0 {
+		t.Errorf("expected all members are removed, got %+v, err %v", group.Members, err)
+	}
+}
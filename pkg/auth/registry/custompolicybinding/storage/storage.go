@@ -21,12 +21,16 @@ package storage
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/casbin/casbin/v2"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/endpoints/request"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -42,6 +46,14 @@ import (
 	"tkestack.io/tke/pkg/util/log"
 )
 
+const (
+	granterAnnotationKey    = "auth.tkestack.io/binding-granter"
+	extenderAnnotationKey   = "auth.tkestack.io/binding-extender"
+	reasonAnnotationKey     = "auth.tkestack.io/binding-reason"
+	expireTimeAnnotationKey = "auth.tkestack.io/binding-expire-time"
+	expiredAnnotationKey    = "auth.tkestack.io/binding-expired"
+)
+
 // Storage includes storage for policies and all sub resources.
 type Storage struct {
 	CustomPolicy *REST
@@ -206,6 +218,8 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 
 	// upon first request to delete, we switch the phase to start policy termination
 	if policy.DeletionTimestamp.IsZero() {
+		logExpiration(ctx, policy)
+
 		key, err := r.Store.KeyFunc(ctx, name)
 		if err != nil {
 			return nil, false, err
@@ -288,6 +302,20 @@ func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.Va
 	return r.Store.Delete(ctx, name, deleteValidation, options)
 }
 
+// logExpiration records the grant of an expiring binding in the audit event of
+// its deletion, so that the revocation of expired bindings can be audited.
+func logExpiration(ctx context.Context, binding *auth.CustomPolicyBinding) {
+	if binding.Spec.ExpireTime == nil {
+		return
+	}
+	ae := request.AuditEventFrom(ctx)
+	audit.LogAnnotation(ae, granterAnnotationKey, binding.Spec.Granter)
+	audit.LogAnnotation(ae, extenderAnnotationKey, binding.Spec.Extender)
+	audit.LogAnnotation(ae, reasonAnnotationKey, binding.Spec.Reason)
+	audit.LogAnnotation(ae, expireTimeAnnotationKey, binding.Spec.ExpireTime.UTC().Format(time.RFC3339))
+	audit.LogAnnotation(ae, expiredAnnotationKey, strconv.FormatBool(!binding.Spec.ExpireTime.After(time.Now())))
+}
+
 // StatusREST implements the REST endpoint for changing the status of a
 // replication controller.
 type StatusREST struct {
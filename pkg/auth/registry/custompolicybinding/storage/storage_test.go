@@ -0,0 +1,65 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/auth"
+)
+
+func TestLogExpiration(t *testing.T) {
+	expireTime := metav1.NewTime(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))
+	binding := &auth.CustomPolicyBinding{
+		Spec: auth.CustomPolicyBindingSpec{
+			Reason:     "on call",
+			ExpireTime: &expireTime,
+			Granter:    "admin",
+			Extender:   "alice",
+		},
+	}
+	ae := &auditinternal.Event{Level: auditinternal.LevelMetadata}
+	logExpiration(request.WithAuditEvent(context.Background(), ae), binding)
+
+	want := map[string]string{
+		granterAnnotationKey:    "admin",
+		extenderAnnotationKey:   "alice",
+		reasonAnnotationKey:     "on call",
+		expireTimeAnnotationKey: "2020-01-02T03:04:05Z",
+		expiredAnnotationKey:    "true",
+	}
+	for key, value := range want {
+		if ae.Annotations[key] != value {
+			t.Errorf("annotation %s = %q, want %q", key, ae.Annotations[key], value)
+		}
+	}
+
+	// the bindings never expire are not annotated
+	ae = &auditinternal.Event{Level: auditinternal.LevelMetadata}
+	binding.Spec.ExpireTime = nil
+	logExpiration(request.WithAuditEvent(context.Background(), ae), binding)
+	if len(ae.Annotations) != 0 {
+		t.Errorf("annotations = %v, want none", ae.Annotations)
+	}
+}
@@ -69,7 +69,7 @@ func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.Garbage
 // PrepareForUpdate is invoked on update before validation to normalize the
 // object.
 func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
-	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
 	oldBinding, _ := old.(*auth.CustomPolicyBinding)
 	newBinding, _ := obj.(*auth.CustomPolicyBinding)
 	if len(tenantID) != 0 {
@@ -81,6 +81,11 @@ func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
 
 	newBinding.Spec.Groups = util.RemoveDuplicateSubjects(newBinding.Spec.Groups)
 	newBinding.Spec.Users = util.RemoveDuplicateSubjectsByIDOrName(newBinding.Spec.Users)
+	newBinding.Spec.Granter = oldBinding.Spec.Granter
+	newBinding.Spec.Extender = oldBinding.Spec.Extender
+	if expirationExtended(newBinding, oldBinding) {
+		newBinding.Spec.Extender = username
+	}
 }
 
 // NamespaceScoped is true for policies.
@@ -97,10 +102,11 @@ func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) erro
 // the object.
 func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 	binding, _ := obj.(*auth.CustomPolicyBinding)
-	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
 	if len(tenantID) != 0 {
 		binding.Spec.TenantID = tenantID
 	}
+	binding.Spec.Granter = username
 
 	binding.Status.Phase = auth.BindingActive
 
@@ -121,7 +127,9 @@ func (Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
 
 // Validate validates a new policy.
 func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
-	return ValidateProjectPolicyBinding(ctx, obj.(*auth.CustomPolicyBinding), s.authClient)
+	binding := obj.(*auth.CustomPolicyBinding)
+	allErrs := ValidateProjectPolicyBinding(ctx, binding, s.authClient)
+	return append(allErrs, ValidateExpiration(binding, nil)...)
 }
 
 // AllowCreateOnUpdate is false for policies.
@@ -142,7 +150,9 @@ func (Strategy) Canonicalize(obj runtime.Object) {
 
 // ValidateUpdate is the default update validation for an end policy.
 func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
-	return ValidateProjectPolicyBindingUpdate(ctx, obj.(*auth.CustomPolicyBinding), old.(*auth.CustomPolicyBinding), s.authClient)
+	newBinding, oldBinding := obj.(*auth.CustomPolicyBinding), old.(*auth.CustomPolicyBinding)
+	allErrs := ValidateProjectPolicyBindingUpdate(ctx, newBinding, oldBinding, s.authClient)
+	return append(allErrs, ValidateExpiration(newBinding, oldBinding)...)
 }
 
 // GetAttrs returns labels and fields of a given object for filtering purposes.
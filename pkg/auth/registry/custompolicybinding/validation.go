@@ -20,6 +20,7 @@ package custompolicybinding
 
 import (
 	"context"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
@@ -178,3 +179,39 @@ func ValidateProjectPolicyBindingUpdate(ctx context.Context, new *auth.CustomPol
 
 	return allErrs
 }
+
+// ValidateExpiration tests if the expire time of binding is in the future and
+// the reason is given. The expire time is only checked when it is changed, so
+// that an expired binding can still be updated before being revoked. Once set,
+// the expire time can only be brought forward unless a new reason is given.
+func ValidateExpiration(binding *auth.CustomPolicyBinding, old *auth.CustomPolicyBinding) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldSpecPath := field.NewPath("spec")
+	if expirationExtended(binding, old) && binding.Spec.Reason == old.Spec.Reason {
+		allErrs = append(allErrs, field.Forbidden(fldSpecPath.Child("expireTime"), "can not be extended or removed without a new reason"))
+	}
+	if binding.Spec.ExpireTime == nil {
+		return allErrs
+	}
+
+	if binding.Spec.Reason == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("reason"), "must specify reason for expiring binding"))
+	}
+	if old != nil && old.Spec.ExpireTime != nil && old.Spec.ExpireTime.Equal(binding.Spec.ExpireTime) {
+		return allErrs
+	}
+	if !binding.Spec.ExpireTime.Time.After(time.Now()) {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("expireTime"), binding.Spec.ExpireTime.Time, "must be in the future"))
+	}
+
+	return allErrs
+}
+
+// expirationExtended returns true if the expire time of the old binding is
+// extended or removed by the update.
+func expirationExtended(binding *auth.CustomPolicyBinding, old *auth.CustomPolicyBinding) bool {
+	if old == nil || old.Spec.ExpireTime == nil {
+		return false
+	}
+	return binding.Spec.ExpireTime == nil || binding.Spec.ExpireTime.After(old.Spec.ExpireTime.Time)
+}
@@ -0,0 +1,174 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package custompolicybinding
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/auth"
+)
+
+func newExpiringBinding(reason string, expireTime *metav1.Time) *auth.CustomPolicyBinding {
+	return &auth.CustomPolicyBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "cpb-1", Namespace: "default"},
+		Spec: auth.CustomPolicyBindingSpec{
+			PolicyID:   "pol-1",
+			Reason:     reason,
+			ExpireTime: expireTime,
+			Granter:    "admin",
+		},
+	}
+}
+
+func TestValidateExpiration(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	soon := metav1.NewTime(time.Now().Add(time.Hour))
+	later := metav1.NewTime(time.Now().Add(2 * time.Hour))
+	tests := []struct {
+		name    string
+		binding *auth.CustomPolicyBinding
+		old     *auth.CustomPolicyBinding
+		wantErr bool
+	}{
+		{
+			name:    "never expires",
+			binding: newExpiringBinding("", nil),
+		},
+		{
+			name:    "expires in the future",
+			binding: newExpiringBinding("on call", &soon),
+		},
+		{
+			name:    "expires without reason",
+			binding: newExpiringBinding("", &soon),
+			wantErr: true,
+		},
+		{
+			name:    "expires in the past",
+			binding: newExpiringBinding("on call", &past),
+			wantErr: true,
+		},
+		{
+			name:    "expired binding is updated without changing expire time",
+			binding: newExpiringBinding("on call", &past),
+			old:     newExpiringBinding("on call", &past),
+		},
+		{
+			name:    "expire time is brought forward",
+			binding: newExpiringBinding("on call", &soon),
+			old:     newExpiringBinding("on call", &later),
+		},
+		{
+			name:    "expire time is extended without new reason",
+			binding: newExpiringBinding("on call", &later),
+			old:     newExpiringBinding("on call", &soon),
+			wantErr: true,
+		},
+		{
+			name:    "expire time is extended with new reason",
+			binding: newExpiringBinding("incident 42", &later),
+			old:     newExpiringBinding("on call", &soon),
+		},
+		{
+			name:    "expire time is removed without new reason",
+			binding: newExpiringBinding("on call", nil),
+			old:     newExpiringBinding("on call", &soon),
+			wantErr: true,
+		},
+		{
+			name:    "expire time is removed with new reason",
+			binding: newExpiringBinding("permanent maintainer", nil),
+			old:     newExpiringBinding("on call", &soon),
+		},
+		{
+			name:    "expire time is added",
+			binding: newExpiringBinding("on call", &soon),
+			old:     newExpiringBinding("", nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateExpiration(tt.binding, tt.old)
+			if (len(errs) > 0) != tt.wantErr {
+				t.Errorf("ValidateExpiration() = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrepareForUpdateRecordsExtender(t *testing.T) {
+	soon := metav1.NewTime(time.Now().Add(time.Hour))
+	later := metav1.NewTime(time.Now().Add(2 * time.Hour))
+	ctx := request.WithUser(context.Background(), &user.DefaultInfo{Name: "alice"})
+	tests := []struct {
+		name         string
+		binding      *auth.CustomPolicyBinding
+		old          *auth.CustomPolicyBinding
+		wantExtender string
+	}{
+		{
+			name:         "extended",
+			binding:      newExpiringBinding("incident 42", &later),
+			old:          newExpiringBinding("on call", &soon),
+			wantExtender: "alice",
+		},
+		{
+			name:         "removed",
+			binding:      newExpiringBinding("permanent maintainer", nil),
+			old:          newExpiringBinding("on call", &soon),
+			wantExtender: "alice",
+		},
+		{
+			name:    "brought forward",
+			binding: newExpiringBinding("on call", &soon),
+			old:     newExpiringBinding("on call", &later),
+		},
+		{
+			name: "unchanged keeps the last extender",
+			binding: func() *auth.CustomPolicyBinding {
+				b := newExpiringBinding("on call", &soon)
+				b.Spec.Extender = "mallory"
+				b.Spec.Granter = "mallory"
+				return b
+			}(),
+			old: func() *auth.CustomPolicyBinding {
+				b := newExpiringBinding("on call", &soon)
+				b.Spec.Extender = "bob"
+				return b
+			}(),
+			wantExtender: "bob",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			Strategy{}.PrepareForUpdate(ctx, tt.binding, tt.old)
+			if tt.binding.Spec.Extender != tt.wantExtender {
+				t.Errorf("extender = %q, want %q", tt.binding.Spec.Extender, tt.wantExtender)
+			}
+			if tt.binding.Spec.Granter != tt.old.Spec.Granter {
+				t.Errorf("granter = %q, want %q", tt.binding.Spec.Granter, tt.old.Spec.Granter)
+			}
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	oidcidp "tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/ldap"
 	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/local"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
 	"tkestack.io/tke/pkg/auth/registry/identityprovider"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -108,6 +109,16 @@ func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation
 		if err != nil {
 			return nil, errors.NewInternalError(err)
 		}
+	case saml.ConnectorType:
+		var samlConfig saml.Config
+		if err = json.Unmarshal([]byte(idpObj.Spec.Config), &samlConfig); err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
+
+		idp, err = saml.NewSAMLIdentityProvider(samlConfig, idpObj.Spec.Administrators, idpObj.Name)
+		if err != nil {
+			return nil, errors.NewBadRequest(err.Error())
+		}
 	default:
 		log.Warn("Identity provider type has not implemented users or groups api", log.String("type", idpObj.Spec.Type))
 	}
@@ -20,6 +20,8 @@ package policy
 
 import (
 	"context"
+	"path"
+	"strings"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
@@ -70,6 +72,8 @@ func ValidatePolicy(ctx context.Context, policy *auth.Policy, authClient authint
 		allErrs = append(allErrs, field.Invalid(fldStmtPath.Child("effect"), policy.Spec.Statement.Effect, "must specify one of: `allow` or `deny`"))
 	}
 
+	allErrs = append(allErrs, ValidateConditions(policy.Spec.Statement.Conditions, fldStmtPath.Child("conditions"))...)
+
 	var validUsers []auth.Subject
 	fldUserPath := field.NewPath("status", "users")
 	for i, subj := range policy.Status.Users {
@@ -149,3 +153,43 @@ func ValidatePolicyUpdate(ctx context.Context, policy *auth.Policy, old *auth.Po
 
 	return allErrs
 }
+
+// ValidateConditions tests if the conditions of the statement are valid.
+func ValidateConditions(conditions []auth.Condition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, condition := range conditions {
+		fldConditionPath := fldPath.Index(i)
+		switch condition.Key {
+		case auth.ConditionCluster, auth.ConditionNamespace, auth.ConditionProject, auth.ConditionChartGroup:
+		case "":
+			allErrs = append(allErrs, field.Required(fldConditionPath.Child("key"), "must specify key"))
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldConditionPath.Child("key"), condition.Key,
+				[]string{string(auth.ConditionCluster), string(auth.ConditionNamespace), string(auth.ConditionProject), string(auth.ConditionChartGroup)}))
+		}
+
+		switch condition.Operator {
+		case auth.ConditionIn, auth.ConditionNotIn, auth.ConditionLike, auth.ConditionNotLike:
+		case "":
+			allErrs = append(allErrs, field.Required(fldConditionPath.Child("operator"), "must specify operator"))
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldConditionPath.Child("operator"), condition.Operator,
+				[]string{string(auth.ConditionIn), string(auth.ConditionNotIn), string(auth.ConditionLike), string(auth.ConditionNotLike)}))
+		}
+
+		if len(condition.Values) == 0 {
+			allErrs = append(allErrs, field.Required(fldConditionPath.Child("values"), "must specify values"))
+		}
+		for j, value := range condition.Values {
+			fldValuePath := fldConditionPath.Child("values").Index(j)
+			if value == "" || strings.ContainsAny(value, ",;| \t/") {
+				allErrs = append(allErrs, field.Invalid(fldValuePath, value, "must be a non-empty name or pattern without any of: `,;| /`"))
+				continue
+			}
+			if _, err := path.Match(value, ""); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldValuePath, value, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
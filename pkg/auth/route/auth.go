@@ -21,6 +21,7 @@ package route
 import (
 	"net/http"
 	authapi "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/pkg/auth/authentication/oidc/identityprovider/saml"
 	"tkestack.io/tke/pkg/auth/handler/authn"
 
 	"github.com/emicklei/go-restful"
@@ -74,5 +75,15 @@ func RegisterAuthRoute(container *restful.Container, authnHandler *authn.Handler
 		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
 		To(authzHandler.BatchAuthorize))
 
+	ws.Route(ws.
+		GET("/saml/{tenantID}/metadata").
+		Doc("get the service provider metadata of the saml identity provider of the tenant.").
+		Operation("getSAMLMetadata").
+		Param(ws.PathParameter("tenantID", "the tenant of the saml identity provider")).
+		Produces("application/samlmetadata+xml").
+		Returns(http.StatusOK, "Ok", nil).
+		Returns(http.StatusNotFound, "NotFound", nil).
+		To(saml.MetadataHandler))
+
 	container.Add(ws)
 }
@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package route
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	authenticationapi "k8s.io/api/authentication/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/pkg/auth/handler/authn"
+	"tkestack.io/tke/pkg/auth/handler/kubeconfig"
+	authutil "tkestack.io/tke/pkg/auth/util"
+)
+
+// RegisterKubeconfigRoute registers the http handlers which issue and revoke
+// the short-lived kubeconfigs of the clusters, and the token webhook which
+// authenticates the kubeconfigs for the clusters.
+func RegisterKubeconfigRoute(container *restful.Container, handler *kubeconfig.Handler, tokenHandler *authn.Handler) {
+	ws := new(restful.WebService)
+	ws.Path("/auth/kubeconfigs")
+	ws.Produces(restful.MIME_JSON)
+	ws.Consumes(restful.MIME_JSON)
+
+	ws.Route(ws.
+		POST("").
+		Doc("issue a short-lived kubeconfig of the cluster for the user of the bearer token.").
+		Operation("issueKubeconfig").
+		Reads(kubeconfig.IssueRequest{}).
+		Returns(http.StatusCreated, "Created", kubeconfig.Kubeconfig{}).
+		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", v1.Status{}).
+		Returns(http.StatusNotFound, "NotFound", v1.Status{}).
+		To(handler.Issue))
+
+	ws.Route(ws.
+		GET("").
+		Doc("list the unexpired kubeconfigs issued to the user of the bearer token.").
+		Operation("listKubeconfigs").
+		Returns(http.StatusOK, "Ok", kubeconfig.KubeconfigList{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", v1.Status{}).
+		To(handler.List))
+
+	ws.Route(ws.
+		DELETE("/{id}").
+		Doc("revoke the kubeconfig.").
+		Operation("revokeKubeconfig").
+		Param(ws.PathParameter("id", "the id of the kubeconfig")).
+		Returns(http.StatusOK, "Ok", authutil.KubeconfigRecord{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", v1.Status{}).
+		Returns(http.StatusForbidden, "Forbidden", v1.Status{}).
+		Returns(http.StatusNotFound, "NotFound", v1.Status{}).
+		To(handler.Revoke))
+
+	ws.Route(ws.
+		POST("/tokenreview/{clusterName}").
+		Doc("verify the token of the kubeconfig for the token webhook of the cluster.").
+		Operation("reviewKubeconfigToken").
+		Param(ws.PathParameter("clusterName", "name of the cluster which the kubeconfig is issued for").DataType("string").Required(true)).
+		Reads(authenticationapi.TokenReview{}).
+		Returns(http.StatusOK, "Ok", authenticationapi.TokenReview{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", v1.Status{}).
+		Returns(http.StatusBadRequest, "BadRequest", v1.Status{}).
+		To(tokenHandler.AuthenticateClusterToken))
+
+	container.Add(ws)
+}
@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package route
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"tkestack.io/tke/pkg/auth/handler/scim"
+)
+
+// RegisterSCIMRoute registers the http handlers of the scim service, which is
+// used by the identity providers to provision users and groups.
+func RegisterSCIMRoute(container *restful.Container, handler *scim.Handler) {
+	ws := new(restful.WebService)
+	ws.Path(scim.PathPrefix)
+	ws.Produces(scim.MIMEType, restful.MIME_JSON)
+	ws.Consumes(scim.MIMEType, restful.MIME_JSON)
+
+	ws.Route(ws.
+		GET("/ServiceProviderConfig").
+		Doc("get the features supported by the scim service.").
+		Operation("getSCIMServiceProviderConfig").
+		Returns(http.StatusOK, "Ok", nil).
+		To(handler.ServiceProviderConfig))
+
+	ws.Route(ws.
+		GET("/Users").
+		Doc("list the users of the tenant.").
+		Operation("listSCIMUsers").
+		Param(ws.QueryParameter("filter", "the filter of the form 'userName eq \"value\"'")).
+		Param(ws.QueryParameter("startIndex", "the 1-based index of the first result")).
+		Param(ws.QueryParameter("count", "the maximum number of the results")).
+		Returns(http.StatusOK, "Ok", scim.ListResponse{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", scim.Error{}).
+		To(handler.ListUsers))
+
+	ws.Route(ws.
+		POST("/Users").
+		Doc("provision a user.").
+		Operation("createSCIMUser").
+		Reads(scim.User{}).
+		Returns(http.StatusCreated, "Created", scim.User{}).
+		Returns(http.StatusConflict, "Conflict", scim.Error{}).
+		To(handler.CreateUser))
+
+	ws.Route(ws.
+		GET("/Users/{id}").
+		Doc("get the user.").
+		Operation("getSCIMUser").
+		Param(ws.PathParameter("id", "the id of the user")).
+		Returns(http.StatusOK, "Ok", scim.User{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.GetUser))
+
+	ws.Route(ws.
+		PUT("/Users/{id}").
+		Doc("replace the user.").
+		Operation("replaceSCIMUser").
+		Param(ws.PathParameter("id", "the id of the user")).
+		Reads(scim.User{}).
+		Returns(http.StatusOK, "Ok", scim.User{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.ReplaceUser))
+
+	ws.Route(ws.
+		PATCH("/Users/{id}").
+		Doc("modify the user, such as deactivating it.").
+		Operation("patchSCIMUser").
+		Param(ws.PathParameter("id", "the id of the user")).
+		Reads(scim.PatchOp{}).
+		Returns(http.StatusOK, "Ok", scim.User{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.PatchUser))
+
+	ws.Route(ws.
+		DELETE("/Users/{id}").
+		Doc("deprovision the user.").
+		Operation("deleteSCIMUser").
+		Param(ws.PathParameter("id", "the id of the user")).
+		Returns(http.StatusNoContent, "NoContent", nil).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.DeleteUser))
+
+	ws.Route(ws.
+		GET("/Groups").
+		Doc("list the groups of the tenant.").
+		Operation("listSCIMGroups").
+		Param(ws.QueryParameter("filter", "the filter of the form 'displayName eq \"value\"'")).
+		Param(ws.QueryParameter("excludedAttributes", "the attributes excluded from the results, only members is supported")).
+		Param(ws.QueryParameter("startIndex", "the 1-based index of the first result")).
+		Param(ws.QueryParameter("count", "the maximum number of the results")).
+		Returns(http.StatusOK, "Ok", scim.ListResponse{}).
+		Returns(http.StatusUnauthorized, "Unauthorized", scim.Error{}).
+		To(handler.ListGroups))
+
+	ws.Route(ws.
+		POST("/Groups").
+		Doc("provision a group.").
+		Operation("createSCIMGroup").
+		Reads(scim.Group{}).
+		Returns(http.StatusCreated, "Created", scim.Group{}).
+		Returns(http.StatusConflict, "Conflict", scim.Error{}).
+		To(handler.CreateGroup))
+
+	ws.Route(ws.
+		GET("/Groups/{id}").
+		Doc("get the group.").
+		Operation("getSCIMGroup").
+		Param(ws.PathParameter("id", "the id of the group")).
+		Param(ws.QueryParameter("excludedAttributes", "the attributes excluded from the result, only members is supported")).
+		Returns(http.StatusOK, "Ok", scim.Group{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.GetGroup))
+
+	ws.Route(ws.
+		PUT("/Groups/{id}").
+		Doc("replace the group and its members.").
+		Operation("replaceSCIMGroup").
+		Param(ws.PathParameter("id", "the id of the group")).
+		Reads(scim.Group{}).
+		Returns(http.StatusOK, "Ok", scim.Group{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.ReplaceGroup))
+
+	ws.Route(ws.
+		PATCH("/Groups/{id}").
+		Doc("modify the group, such as adding or removing members.").
+		Operation("patchSCIMGroup").
+		Param(ws.PathParameter("id", "the id of the group")).
+		Reads(scim.PatchOp{}).
+		Returns(http.StatusOK, "Ok", scim.Group{}).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.PatchGroup))
+
+	ws.Route(ws.
+		DELETE("/Groups/{id}").
+		Doc("deprovision the group.").
+		Operation("deleteSCIMGroup").
+		Param(ws.PathParameter("id", "the id of the group")).
+		Returns(http.StatusNoContent, "NoContent", nil).
+		Returns(http.StatusNotFound, "NotFound", scim.Error{}).
+		To(handler.DeleteGroup))
+
+	container.Add(ws)
+}
@@ -43,8 +43,8 @@ const (
 	// PRule represents RBAC rules
 	PRule = "p"
 
-	// PRuleFieldNumber represents the maximum number of valid value fields in the Rule object: V0, V1, V2, V3, V4
-	PRuleFieldNumber = 5
+	// PRuleFieldNumber represents the maximum number of valid value fields in the Rule object: V0, V1, V2, V3, V4, V5
+	PRuleFieldNumber = 6
 	// GRuleFieldNumber represents the maximum number of valid value fields in the Rule object: V0, V1, V2
 	GRuleFieldNumber = 3
 )
@@ -94,6 +94,7 @@ func (a *RestAdapter) loadPolicy(rule *authv1.Rule, model model.Model) {
 		lineText += ", " + casRule.V2
 		lineText += ", " + casRule.V3
 		lineText += ", " + casRule.V4
+		lineText += ", " + casRule.V5
 	} else {
 		lineText += ", " + casRule.V0
 		lineText += ", " + casRule.V1
@@ -0,0 +1,154 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"tkestack.io/tke/api/auth"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// ConditionMatchFunction is the name of the casbin function evaluating the
+// conditions of the rules.
+const ConditionMatchFunction = "conditionMatch"
+
+// decodedConditions caches the conditions decoded from the rules, the rules
+// are evaluated for every request.
+var decodedConditions sync.Map
+
+// EncodeConditions encodes the conditions of the statement as the last field
+// of the casbin rules, which is empty if there are no conditions. The
+// conditions are encoded as "key operator value|value;key operator value",
+// without commas which separate the fields of the rules.
+func EncodeConditions(conditions []auth.Condition) string {
+	encoded := make([]string, 0, len(conditions))
+	for _, condition := range conditions {
+		encoded = append(encoded, fmt.Sprintf("%s %s %s", condition.Key, condition.Operator, strings.Join(condition.Values, "|")))
+	}
+	return strings.Join(encoded, ";")
+}
+
+func decodeConditions(encoded string) ([]auth.Condition, error) {
+	if conditions, ok := decodedConditions.Load(encoded); ok {
+		return conditions.([]auth.Condition), nil
+	}
+	var conditions []auth.Condition
+	for _, s := range strings.Split(encoded, ";") {
+		parts := strings.SplitN(s, " ", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("invalid condition %q", s)
+		}
+		conditions = append(conditions, auth.Condition{
+			Key:      auth.ConditionKey(parts[0]),
+			Operator: auth.ConditionOperator(parts[1]),
+			Values:   strings.Split(parts[2], "|"),
+		})
+	}
+	decodedConditions.Store(encoded, conditions)
+	return conditions, nil
+}
+
+// ConditionAttributes extracts the attributes evaluated by the conditions
+// from the requested object, such as cluster:cls-xxx/namespace:default/deployment:nginx.
+func ConditionAttributes(obj string) map[auth.ConditionKey]string {
+	attributes := make(map[auth.ConditionKey]string)
+	var registryNamespace string
+	for _, segment := range strings.Split(strings.ToLower(obj), "/") {
+		parts := strings.SplitN(segment, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		switch kind, name := parts[0], parts[1]; {
+		case kind == "cluster":
+			attributes[auth.ConditionCluster] = name
+		case kind == "namespace":
+			attributes[auth.ConditionNamespace] = name
+		case kind == "project":
+			attributes[auth.ConditionProject] = name
+		case kind == "chartgroup":
+			attributes[auth.ConditionChartGroup] = name
+		case kind == "registrynamespace":
+			registryNamespace = name
+		case strings.HasPrefix(kind, "chart") && registryNamespace != "":
+			// The charts are in the registry namespace named after the chart group.
+			attributes[auth.ConditionChartGroup] = registryNamespace
+		}
+	}
+	return attributes
+}
+
+// ConditionMatch determines whether the requested object satisfies all of
+// the encoded conditions.
+func ConditionMatch(obj string, encoded string) bool {
+	if encoded == "" {
+		return true
+	}
+	conditions, err := decodeConditions(encoded)
+	if err != nil {
+		log.Error("Decode conditions of the rule failed", log.String("conditions", encoded), log.Err(err))
+		return false
+	}
+	attributes := ConditionAttributes(obj)
+	for _, condition := range conditions {
+		if !matchCondition(condition, attributes) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchCondition(condition auth.Condition, attributes map[auth.ConditionKey]string) bool {
+	value, ok := attributes[condition.Key]
+	matched := false
+	if ok {
+		for _, v := range condition.Values {
+			v = strings.ToLower(v)
+			switch condition.Operator {
+			case auth.ConditionLike, auth.ConditionNotLike:
+				matched, _ = path.Match(v, value)
+			default:
+				matched = v == value
+			}
+			if matched {
+				break
+			}
+		}
+	}
+
+	switch condition.Operator {
+	case auth.ConditionIn, auth.ConditionLike:
+		return matched
+	case auth.ConditionNotIn, auth.ConditionNotLike:
+		return !matched
+	default:
+		return false
+	}
+}
+
+// ConditionMatchWrapper wraps ConditionMatch as a casbin function.
+func ConditionMatchWrapper(args ...interface{}) (interface{}, error) {
+	obj, _ := args[0].(string)
+	encoded, _ := args[1].(string)
+
+	return ConditionMatch(obj, encoded), nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"testing"
+
+	"tkestack.io/tke/api/auth"
+)
+
+func TestConditionMatch(t *testing.T) {
+	conditions := EncodeConditions([]auth.Condition{
+		{Key: auth.ConditionCluster, Operator: auth.ConditionIn, Values: []string{"cls-a", "cls-b"}},
+		{Key: auth.ConditionNamespace, Operator: auth.ConditionNotLike, Values: []string{"kube-*"}},
+	})
+	tests := []struct {
+		obj  string
+		want bool
+	}{
+		{"cluster:cls-a/namespace:default/deployment:nginx", true},
+		{"cluster:CLS-B/namespace:default/pods:*", true},
+		{"cluster:cls-c/namespace:default/deployment:nginx", false},
+		{"cluster:cls-a/namespace:kube-system/deployment:coredns", false},
+		{"cluster:cls-a/node:*", true},
+		{"namespace:default/deployment:nginx", false},
+	}
+	for _, test := range tests {
+		if got := ConditionMatch(test.obj, conditions); got != test.want {
+			t.Errorf("ConditionMatch(%q) = %v, want %v", test.obj, got, test.want)
+		}
+	}
+	if !ConditionMatch("cluster:cls-c", "") {
+		t.Errorf("ConditionMatch without conditions should match")
+	}
+}
+
+func TestConditionAttributes(t *testing.T) {
+	attributes := ConditionAttributes("registrynamespace:cg/chart:nginx")
+	if attributes[auth.ConditionChartGroup] != "cg" {
+		t.Errorf("chart group = %q, want cg", attributes[auth.ConditionChartGroup])
+	}
+	attributes = ConditionAttributes("project:prj-a/cluster:cls-a/namespace:default")
+	if attributes[auth.ConditionProject] != "prj-a" || attributes[auth.ConditionCluster] != "cls-a" || attributes[auth.ConditionNamespace] != "default" {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+}
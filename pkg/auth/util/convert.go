@@ -27,9 +27,10 @@ func ConvertPolicyToRuleArray(policy *auth.Policy) [][]string {
 	if policy.Spec.Scope != auth.PolicyProject && len(policy.Status.Users) == 0 && len(policy.Status.Groups) == 0 {
 		return rules
 	}
+	cond := EncodeConditions(policy.Spec.Statement.Conditions)
 	for _, act := range policy.Spec.Statement.Actions {
 		for _, res := range policy.Spec.Statement.Resources {
-			rule := []string{policy.Name, "*", res, act, string(policy.Spec.Statement.Effect)}
+			rule := []string{policy.Name, "*", res, act, string(policy.Spec.Statement.Effect), cond}
 			rules = append(rules, rule)
 		}
 	}
@@ -39,9 +40,10 @@ func ConvertPolicyToRuleArray(policy *auth.Policy) [][]string {
 
 func ConvertPolicyToRuleArrayUsingRuleName(roleName string, policy *auth.Policy) [][]string {
 	var rules [][]string
+	cond := EncodeConditions(policy.Spec.Statement.Conditions)
 	for _, act := range policy.Spec.Statement.Actions {
 		for _, res := range policy.Spec.Statement.Resources {
-			rule := []string{roleName, "*", res, act, string(policy.Spec.Statement.Effect)}
+			rule := []string{roleName, "*", res, act, string(policy.Spec.Statement.Effect), cond}
 			rules = append(rules, rule)
 		}
 	}
@@ -0,0 +1,161 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+	authv1 "tkestack.io/tke/api/auth/v1"
+)
+
+const (
+	// KubeconfigGroupKey is the key of the group "kubeconfig:<id>" of the
+	// users authenticated by the tokens of the issued kubeconfigs.
+	KubeconfigGroupKey = "kubeconfig"
+	// KubeconfigLabel is the label of the config maps storing the records of
+	// the kubeconfigs.
+	KubeconfigLabel = "auth.tkestack.io/kubeconfig"
+
+	kubeconfigConfigMapPrefix = "kubeconfig-"
+	kubeconfigTokenSeparator  = "."
+)
+
+// KubeconfigRecord records a short-lived kubeconfig issued to the user for a
+// cluster. The records are stored as config maps, and the kubeconfig is
+// revoked by deleting its record.
+type KubeconfigRecord struct {
+	ID          string      `json:"id"`
+	TenantID    string      `json:"tenantID"`
+	Username    string      `json:"username"`
+	Groups      []string    `json:"groups,omitempty"`
+	ClusterName string      `json:"clusterName"`
+	IssueAt     metav1.Time `json:"issueAt"`
+	ExpireAt    metav1.Time `json:"expireAt"`
+	// TokenHash is the sha256 of the secret of the token, the token itself
+	// is never stored.
+	TokenHash string `json:"-"`
+}
+
+// KubeconfigConfigMapName returns the name of the config map storing the
+// record of the kubeconfig.
+func KubeconfigConfigMapName(id string) string {
+	return kubeconfigConfigMapPrefix + id
+}
+
+// KubeconfigToken returns the bearer token of the kubeconfig consisting of
+// its id and secret.
+func KubeconfigToken(id, secret string) string {
+	return id + kubeconfigTokenSeparator + secret
+}
+
+// ParseKubeconfigToken returns the id and secret of the bearer token of the
+// kubeconfig, or false if it is not a token of kubeconfig.
+func ParseKubeconfigToken(token string) (string, string, bool) {
+	parts := strings.Split(token, kubeconfigTokenSeparator)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// HashKubeconfigSecret returns the hash of the secret of the token stored in
+// the record.
+func HashKubeconfigSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+// ConfigMap returns the config map storing the record.
+func (r *KubeconfigRecord) ConfigMap() *auth.ConfigMap {
+	data, _ := json.Marshal(r.Groups)
+	return &auth.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   KubeconfigConfigMapName(r.ID),
+			Labels: map[string]string{KubeconfigLabel: "true"},
+		},
+		Data: map[string]string{
+			"tenantID":    r.TenantID,
+			"username":    r.Username,
+			"groups":      string(data),
+			"clusterName": r.ClusterName,
+			"issueAt":     r.IssueAt.UTC().Format(time.RFC3339),
+			"expireAt":    r.ExpireAt.UTC().Format(time.RFC3339),
+			"tokenHash":   r.TokenHash,
+		},
+	}
+}
+
+// Expired reports whether the kubeconfig has expired.
+func (r *KubeconfigRecord) Expired() bool {
+	return !r.ExpireAt.After(time.Now())
+}
+
+// VerifySecret reports whether the secret of the token matches the record.
+func (r *KubeconfigRecord) VerifySecret(secret string) bool {
+	return r.TokenHash != "" && subtle.ConstantTimeCompare([]byte(r.TokenHash), []byte(HashKubeconfigSecret(secret))) == 1
+}
+
+// KubeconfigRecordFromConfigMap returns the record stored in the config map,
+// or false if the config map is not a record of kubeconfig.
+func KubeconfigRecordFromConfigMap(configMap *auth.ConfigMap) (*KubeconfigRecord, bool) {
+	return kubeconfigRecord(configMap.ObjectMeta.Name, configMap.Data)
+}
+
+// KubeconfigRecordFromV1ConfigMap returns the record stored in the versioned
+// config map, or false if the config map is not a record of kubeconfig.
+func KubeconfigRecordFromV1ConfigMap(configMap *authv1.ConfigMap) (*KubeconfigRecord, bool) {
+	return kubeconfigRecord(configMap.ObjectMeta.Name, configMap.Data)
+}
+
+func kubeconfigRecord(name string, data map[string]string) (*KubeconfigRecord, bool) {
+	if !strings.HasPrefix(name, kubeconfigConfigMapPrefix) {
+		return nil, false
+	}
+	issueAt, err := time.Parse(time.RFC3339, data["issueAt"])
+	if err != nil {
+		return nil, false
+	}
+	expireAt, err := time.Parse(time.RFC3339, data["expireAt"])
+	if err != nil {
+		return nil, false
+	}
+	var groups []string
+	if data["groups"] != "" {
+		if err := json.Unmarshal([]byte(data["groups"]), &groups); err != nil {
+			return nil, false
+		}
+	}
+	return &KubeconfigRecord{
+		ID:          strings.TrimPrefix(name, kubeconfigConfigMapPrefix),
+		TenantID:    data["tenantID"],
+		Username:    data["username"],
+		Groups:      groups,
+		ClusterName: data["clusterName"],
+		IssueAt:     metav1.NewTime(issueAt),
+		ExpireAt:    metav1.NewTime(expireAt),
+		TokenHash:   data["tokenHash"],
+	}, true
+}
@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/auth"
+)
+
+func TestKubeconfigRecord(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	record := &KubeconfigRecord{
+		ID:          "0123456789abcdef",
+		TenantID:    "default",
+		Username:    "alice",
+		Groups:      []string{"kubeconfig:0123456789abcdef", "tenant:default"},
+		ClusterName: "cls-a",
+		IssueAt:     metav1.NewTime(now),
+		ExpireAt:    metav1.NewTime(now.Add(time.Hour)),
+		TokenHash:   HashKubeconfigSecret("secret"),
+	}
+	got, ok := KubeconfigRecordFromConfigMap(record.ConfigMap())
+	if !ok {
+		t.Fatalf("record is not decoded from the config map")
+	}
+	if got.ID != record.ID || got.TenantID != record.TenantID || got.Username != record.Username || got.ClusterName != record.ClusterName ||
+		!got.IssueAt.Equal(&record.IssueAt) || !got.ExpireAt.Equal(&record.ExpireAt) || !reflect.DeepEqual(got.Groups, record.Groups) {
+		t.Errorf("decoded record = %+v, want %+v", got, record)
+	}
+	if got.Expired() {
+		t.Errorf("record should not expire")
+	}
+	if !got.VerifySecret("secret") || got.VerifySecret("other") {
+		t.Errorf("secret of the token is not verified by the record")
+	}
+
+	if _, ok := KubeconfigRecordFromConfigMap(&auth.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "tke-auth"}}); ok {
+		t.Errorf("config map tke-auth should not be a record of kubeconfig")
+	}
+}
+
+func TestParseKubeconfigToken(t *testing.T) {
+	id, secret, ok := ParseKubeconfigToken(KubeconfigToken("0123", "abcd"))
+	if !ok || id != "0123" || secret != "abcd" {
+		t.Errorf("ParseKubeconfigToken() = %q, %q, %v", id, secret, ok)
+	}
+	for _, token := range []string{"", "0123", "0123.", ".abcd", "a.b.c"} {
+		if _, _, ok := ParseKubeconfigToken(token); ok {
+			t.Errorf("token %q should not be a token of kubeconfig", token)
+		}
+	}
+}
@@ -0,0 +1,368 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacerequest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	v1 "tkestack.io/tke/api/business/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/notify/v1"
+	businessv1informer "tkestack.io/tke/api/client/informers/externalversions/business/v1"
+	businessv1lister "tkestack.io/tke/api/client/listers/business/v1"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	clientRetryCount    = 5
+	clientRetryInterval = 5 * time.Second
+)
+
+const (
+	controllerName = "namespacerequest-controller"
+)
+
+// Controller is responsible for creating the namespaces of approved requests
+// and notifying the requesters of the results.
+type Controller struct {
+	client       clientset.Interface
+	notifyClient notifyversionedclient.NotifyV1Interface
+	queue        workqueue.RateLimitingInterface
+	lister       businessv1lister.NamespaceRequestLister
+	listerSynced cache.InformerSynced
+	stopCh       <-chan struct{}
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, notifyClient notifyversionedclient.NotifyV1Interface,
+	requestInformer businessv1informer.NamespaceRequestInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:       client,
+		notifyClient: notifyClient,
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.BusinessV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("namespacerequest_controller", client.BusinessV1().RESTClient().GetRateLimiter())
+	}
+
+	requestInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				old, ok1 := oldObj.(*v1.NamespaceRequest)
+				cur, ok2 := newObj.(*v1.NamespaceRequest)
+				if ok1 && ok2 && controller.needsUpdate(old, cur) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = requestInformer.Lister()
+	controller.listerSynced = requestInformer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.NamespaceRequest, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) needsUpdate(old *v1.NamespaceRequest, new *v1.NamespaceRequest) bool {
+	return !reflect.DeepEqual(old.Status, new.Status)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting namespace request controller")
+	defer log.Info("Shutting down namespace request controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for namespace request caches to sync")
+		return
+	}
+
+	c.stopCh = stopCh
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of namespace request objects.
+// Each namespace request can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same namespace request at the same time.
+func (c *Controller) worker() {
+	workFunc := func() bool {
+		key, quit := c.queue.Get()
+		if quit {
+			return true
+		}
+		defer c.queue.Done(key)
+
+		err := c.syncItem(key.(string))
+		if err == nil {
+			// no error, forget this entry and return
+			c.queue.Forget(key)
+			return false
+		}
+
+		// rather than wait for a full resync, re-add the namespace request to the queue to be processed
+		c.queue.AddRateLimited(key)
+		runtime.HandleError(err)
+		return false
+	}
+
+	for {
+		quit := workFunc()
+
+		if quit {
+			return
+		}
+	}
+}
+
+// syncItem will sync the NamespaceRequest with the given key. This function
+// is not meant to be invoked concurrently with the same key.
+func (c *Controller) syncItem(key string) error {
+	startTime := time.Now()
+	log.Info("Start syncing namespace request", log.String("namespaceRequest", key))
+	defer func() {
+		log.Info("Finished syncing namespace request", log.String("namespaceRequest", key),
+			log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	projectName, requestName, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	request, err := c.lister.NamespaceRequests(projectName).Get(requestName)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Namespace request has been deleted",
+			log.String("projectName", projectName), log.String("requestName", requestName))
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve namespace request from store", log.String("projectName", projectName),
+			log.String("requestName", requestName), log.Err(err))
+	default:
+		err = c.processUpdate(context.Background(), request.DeepCopy())
+	}
+	return err
+}
+
+func (c *Controller) processUpdate(ctx context.Context, request *v1.NamespaceRequest) error {
+	switch request.Status.Phase {
+	case v1.NamespaceRequestApproved:
+		return c.processApproved(ctx, request)
+	case v1.NamespaceRequestCreated, v1.NamespaceRequestRejected, v1.NamespaceRequestFailed:
+		return c.processFinished(ctx, request)
+	default:
+		return nil
+	}
+}
+
+func (c *Controller) processApproved(ctx context.Context, request *v1.NamespaceRequest) error {
+	hard, err := c.requestHard(ctx, request)
+	if err != nil {
+		request.Status.Phase = v1.NamespaceRequestFailed
+		request.Status.Reason = "TemplateInvalid"
+		request.Status.Message = err.Error()
+		request.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdate(ctx, request)
+	}
+
+	namespace := &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: request.ObjectMeta.Namespace,
+			Name:      fmt.Sprintf("%s-%s", request.Spec.ClusterName, request.Spec.Namespace),
+		},
+		Spec: v1.NamespaceSpec{
+			TenantID:    request.Spec.TenantID,
+			ClusterName: request.Spec.ClusterName,
+			Namespace:   request.Spec.Namespace,
+			Hard:        hard,
+		},
+	}
+	created, err := c.client.BusinessV1().Namespaces(namespace.Namespace).Create(ctx, namespace, metav1.CreateOptions{})
+	switch {
+	case err == nil:
+		request.Status.Phase = v1.NamespaceRequestCreated
+		request.Status.Reason = ""
+		request.Status.NamespaceName = created.Name
+	case errors.IsAlreadyExists(err):
+		// duplicated namespaces are rejected when the request is created, so
+		// the namespace was created by a previous sync whose update was lost
+		request.Status.Phase = v1.NamespaceRequestCreated
+		request.Status.Reason = ""
+		request.Status.NamespaceName = namespace.Name
+	case errors.IsInvalid(err) || errors.IsForbidden(err):
+		request.Status.Phase = v1.NamespaceRequestFailed
+		request.Status.Reason = "CreateNamespaceFailed"
+		request.Status.Message = err.Error()
+	default:
+		// transient errors are retried by the queue
+		return err
+	}
+	request.Status.LastTransitionTime = metav1.Now()
+	return c.persistUpdate(ctx, request)
+}
+
+// requestHard merges the resources of the template with the requested ones,
+// the requested resources take precedence over the template.
+func (c *Controller) requestHard(ctx context.Context, request *v1.NamespaceRequest) (v1.ResourceList, error) {
+	hard := v1.ResourceList{}
+	if request.Spec.Template != "" {
+		template, err := c.client.BusinessV1().ConfigMaps().Get(ctx, businessutil.NamespaceTemplateConfigMapName(request.Spec.Template), metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		templateHard, err := businessutil.NamespaceTemplateHard(request.Spec.Template, template.Data)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range templateHard {
+			hard[k] = v
+		}
+	}
+	for k, v := range request.Spec.Hard {
+		hard[k] = v
+	}
+	return hard, nil
+}
+
+func (c *Controller) processFinished(ctx context.Context, request *v1.NamespaceRequest) error {
+	if c.notifyClient == nil || !request.Status.LastNotifyTime.IsZero() {
+		return nil
+	}
+
+	config, err := c.client.BusinessV1().ConfigMaps().Get(ctx, businessutil.NamespaceRequestNotifyConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Debug("Notify config of namespace request not found, skip notifying",
+				log.String("requestName", request.ObjectMeta.Name))
+			return nil
+		}
+		return err
+	}
+	channel, template := config.Data["channel"], config.Data["template"]
+	if channel == "" || template == "" {
+		return nil
+	}
+
+	receivers, err := c.notifyClient.Receivers().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.username", request.Spec.Requester).String(),
+	})
+	if err != nil {
+		return err
+	}
+	var receiverNames []string
+	for _, receiver := range receivers.Items {
+		if receiver.Spec.TenantID == request.Spec.TenantID {
+			receiverNames = append(receiverNames, receiver.ObjectMeta.Name)
+		}
+	}
+	if len(receiverNames) == 0 {
+		log.Info("No receiver of the requester found, skip notifying",
+			log.String("requestName", request.ObjectMeta.Name), log.String("requester", request.Spec.Requester))
+	} else {
+		message := &notifyv1.MessageRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:    channel,
+				GenerateName: "nsr-",
+			},
+			Spec: notifyv1.MessageRequestSpec{
+				TenantID:     request.Spec.TenantID,
+				TemplateName: template,
+				Receivers:    receiverNames,
+				Variables: map[string]string{
+					"namespaceRequest": request.ObjectMeta.Name,
+					"project":          request.ObjectMeta.Namespace,
+					"cluster":          request.Spec.ClusterName,
+					"namespace":        request.Spec.Namespace,
+					"phase":            string(request.Status.Phase),
+					"approver":         request.Status.Approver,
+					"message":          request.Status.Message,
+				},
+			},
+		}
+		if _, err := c.notifyClient.MessageRequests(channel).Create(ctx, message, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	request.Status.LastNotifyTime = metav1.Now()
+	return c.persistUpdate(ctx, request)
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, request *v1.NamespaceRequest) error {
+	var err error
+	for i := 0; i < clientRetryCount; i++ {
+		_, err = c.client.BusinessV1().NamespaceRequests(request.ObjectMeta.Namespace).UpdateStatus(ctx, request, metav1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		if errors.IsNotFound(err) {
+			log.Info("Not persisting update to namespace request that no longer exists",
+				log.String("projectName", request.ObjectMeta.Namespace),
+				log.String("requestName", request.ObjectMeta.Name),
+				log.Err(err))
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("not persisting update to namespace request '%s/%s' that has been changed since we received it: %v",
+				request.ObjectMeta.Namespace, request.ObjectMeta.Name, err)
+		}
+		log.Warn(fmt.Sprintf("Failed to persist updated status of namespace request '%s/%s/%s'",
+			request.ObjectMeta.Namespace, request.ObjectMeta.Name, request.Status.Phase),
+			log.String("requestName", request.ObjectMeta.Name),
+			log.Err(err))
+		time.Sleep(clientRetryInterval)
+	}
+	return err
+}
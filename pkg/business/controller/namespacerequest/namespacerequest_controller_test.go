@@ -0,0 +1,225 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacerequest
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	core "k8s.io/client-go/testing"
+	v1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	notifyv1 "tkestack.io/tke/api/notify/v1"
+	businessutil "tkestack.io/tke/pkg/business/util"
+)
+
+func newRequest(phase v1.NamespaceRequestPhase) *v1.NamespaceRequest {
+	return &v1.NamespaceRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prj-a", Name: "nsr-a"},
+		Spec: v1.NamespaceRequestSpec{
+			TenantID:    "default",
+			ClusterName: "cls-a",
+			Namespace:   "dev",
+			Template:    "small",
+			Hard:        v1.ResourceList{"requests.cpu": resource.MustParse("2")},
+			Requester:   "alice",
+		},
+		Status: v1.NamespaceRequestStatus{Phase: phase, Approver: "admin"},
+	}
+}
+
+func template(data map[string]string) *v1.ConfigMap {
+	return &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: businessutil.NamespaceTemplateConfigMapName("small")},
+		Data:       data,
+	}
+}
+
+func getRequest(t *testing.T, client *fake.Clientset) *v1.NamespaceRequest {
+	request, err := client.BusinessV1().NamespaceRequests("prj-a").Get(context.Background(), "nsr-a", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return request
+}
+
+func TestProcessApproved(t *testing.T) {
+	ctx := context.Background()
+	request := newRequest(v1.NamespaceRequestApproved)
+	client := fake.NewSimpleClientset(request, template(map[string]string{
+		"requests.cpu":    "1",
+		"requests.memory": "1Gi",
+	}))
+	c := &Controller{client: client}
+	if err := c.processUpdate(ctx, request.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+
+	got := getRequest(t, client)
+	if got.Status.Phase != v1.NamespaceRequestCreated || got.Status.NamespaceName != "cls-a-dev" {
+		t.Errorf("status = %+v, want created cls-a-dev", got.Status)
+	}
+	namespace, err := client.BusinessV1().Namespaces("prj-a").Get(ctx, "cls-a-dev", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the requested resources take precedence over the template
+	cpu, memory := namespace.Spec.Hard["requests.cpu"], namespace.Spec.Hard["requests.memory"]
+	if cpu.Cmp(resource.MustParse("2")) != 0 || memory.Cmp(resource.MustParse("1Gi")) != 0 {
+		t.Errorf("hard = %v, want 2 cpu and 1Gi memory", namespace.Spec.Hard)
+	}
+
+	// the namespace created by a previous sync whose update was lost
+	if err := c.processUpdate(ctx, request.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if got := getRequest(t, client); got.Status.Phase != v1.NamespaceRequestCreated {
+		t.Errorf("phase after the namespace exists = %s, want %s", got.Status.Phase, v1.NamespaceRequestCreated)
+	}
+}
+
+func TestProcessApprovedFailed(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		createErr  error
+		wantPhase  v1.NamespaceRequestPhase
+		wantReason string
+		wantErr    bool
+	}{
+		{
+			name:       "template not found",
+			wantPhase:  v1.NamespaceRequestFailed,
+			wantReason: "TemplateInvalid",
+		},
+		{
+			name:       "template invalid",
+			objects:    []runtime.Object{template(map[string]string{"requests.cpu": "many"})},
+			wantPhase:  v1.NamespaceRequestFailed,
+			wantReason: "TemplateInvalid",
+		},
+		{
+			name:       "namespace forbidden",
+			objects:    []runtime.Object{template(nil)},
+			createErr:  errors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "cls-a-dev", nil),
+			wantPhase:  v1.NamespaceRequestFailed,
+			wantReason: "CreateNamespaceFailed",
+		},
+		{
+			name:      "transient error",
+			objects:   []runtime.Object{template(nil)},
+			createErr: errors.NewServiceUnavailable("unavailable"),
+			wantPhase: v1.NamespaceRequestApproved,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newRequest(v1.NamespaceRequestApproved)
+			client := fake.NewSimpleClientset(append(tt.objects, request)...)
+			if tt.createErr != nil {
+				client.PrependReactor("create", "namespaces", func(action core.Action) (bool, runtime.Object, error) {
+					return true, nil, tt.createErr
+				})
+			}
+			c := &Controller{client: client}
+			if err := c.processUpdate(ctx, request.DeepCopy()); (err != nil) != tt.wantErr {
+				t.Fatalf("processUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			got := getRequest(t, client)
+			if got.Status.Phase != tt.wantPhase || got.Status.Reason != tt.wantReason {
+				t.Errorf("status = %s %s, want %s %s", got.Status.Phase, got.Status.Reason, tt.wantPhase, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestProcessFinished(t *testing.T) {
+	ctx := context.Background()
+	notifyConfig := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: businessutil.NamespaceRequestNotifyConfigMapName},
+		Data:       map[string]string{"channel": "channel-a", "template": "template-a"},
+	}
+	receivers := []runtime.Object{
+		&notifyv1.Receiver{
+			ObjectMeta: metav1.ObjectMeta{Name: "rcv-a"},
+			Spec:       notifyv1.ReceiverSpec{TenantID: "default", Username: "alice"},
+		},
+		&notifyv1.Receiver{
+			ObjectMeta: metav1.ObjectMeta{Name: "rcv-b"},
+			Spec:       notifyv1.ReceiverSpec{TenantID: "other", Username: "alice"},
+		},
+	}
+
+	request := newRequest(v1.NamespaceRequestRejected)
+	client := fake.NewSimpleClientset(append(receivers, request, notifyConfig)...)
+	var messages []*notifyv1.MessageRequest
+	client.PrependReactor("create", "messagerequests", func(action core.Action) (bool, runtime.Object, error) {
+		message := action.(core.CreateAction).GetObject().(*notifyv1.MessageRequest)
+		messages = append(messages, message)
+		return true, message, nil
+	})
+	c := &Controller{client: client, notifyClient: client.NotifyV1()}
+	if err := c.processUpdate(ctx, request.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 {
+		t.Fatalf("sent %d messages, want 1", len(messages))
+	}
+	message := messages[0]
+	if message.Namespace != "channel-a" || message.Spec.TemplateName != "template-a" {
+		t.Errorf("message sent to %s with %s, want channel-a with template-a", message.Namespace, message.Spec.TemplateName)
+	}
+	if len(message.Spec.Receivers) != 1 || message.Spec.Receivers[0] != "rcv-a" {
+		t.Errorf("receivers = %v, want the receiver of tenant", message.Spec.Receivers)
+	}
+	if message.Spec.Variables["phase"] != string(v1.NamespaceRequestRejected) || message.Spec.Variables["approver"] != "admin" {
+		t.Errorf("variables = %v", message.Spec.Variables)
+	}
+
+	// the requester is notified once
+	got := getRequest(t, client)
+	if got.Status.LastNotifyTime.IsZero() {
+		t.Fatal("last notify time is not recorded")
+	}
+	if err := c.processUpdate(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if len(messages) != 1 {
+		t.Errorf("sent %d messages after notified, want 1", len(messages))
+	}
+}
+
+func TestProcessFinishedWithoutConfig(t *testing.T) {
+	request := newRequest(v1.NamespaceRequestCreated)
+	client := fake.NewSimpleClientset(request)
+	c := &Controller{client: client, notifyClient: client.NotifyV1()}
+	if err := c.processUpdate(context.Background(), request.DeepCopy()); err != nil {
+		t.Fatal(err)
+	}
+	if got := getRequest(t, client); !got.Status.LastNotifyTime.IsZero() {
+		t.Error("notified without the notify config")
+	}
+}
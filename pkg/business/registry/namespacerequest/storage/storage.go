@@ -0,0 +1,252 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/business/registry/namespacerequest"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for namespace requests and all sub resources.
+type Storage struct {
+	NamespaceRequest *REST
+	Status           *StatusREST
+	Approval         *ApprovalREST
+}
+
+// NewStorage returns a Storage object that will work against namespace requests.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, businessClient *businessinternalclient.BusinessClient, privilegedUsername string) *Storage {
+	strategy := namespacerequest.NewStrategy(businessClient)
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &business.NamespaceRequest{} },
+		NewListFunc:              func() runtime.Object { return &business.NamespaceRequestList{} },
+		DefaultQualifiedResource: business.Resource("namespacerequests"),
+		PredicateFunc:            namespacerequest.MatchNamespaceRequest,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+		ExportStrategy: strategy,
+
+		ShouldDeleteDuringUpdate: registry.ShouldDeleteDuringUpdate,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    namespacerequest.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create namespace request etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = namespacerequest.NewStatusStrategy(strategy)
+	statusStore.ExportStrategy = namespacerequest.NewStatusStrategy(strategy)
+
+	approvalStore := *store
+	approvalStore.UpdateStrategy = namespacerequest.NewApprovalStrategy(strategy)
+	approvalStore.ExportStrategy = namespacerequest.NewApprovalStrategy(strategy)
+
+	return &Storage{
+		NamespaceRequest: &REST{store, privilegedUsername},
+		Status:           &StatusREST{&statusStore},
+		Approval:         &ApprovalREST{&approvalStore},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return NamespaceRequest
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*business.NamespaceRequest)
+	if err := util.FilterNamespaceRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// ValidateExportObjectAndTenantID validate name and tenantID, if success return NamespaceRequest
+func ValidateExportObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	obj, err := store.Export(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	o := obj.(*business.NamespaceRequest)
+	if err := util.FilterNamespaceRequest(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// REST implements a RESTStorage for namespace requests against etcd.
+type REST struct {
+	*registry.Store
+	privilegedUsername string
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"nsr"}
+}
+
+// DeleteCollection selects all resources in the storage matching given 'listOptions'
+// and deletes them.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	if !authentication.IsAdministrator(ctx, r.privilegedUsername) {
+		return nil, errors.NewMethodNotSupported(business.Resource("namespacerequests"), "delete collection")
+	}
+	return r.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	return r.Store.List(ctx, wrappedOptions)
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Export an object.  Fields that are not user specified are stripped out
+// Returns the stripped object.
+func (r *REST) Export(ctx context.Context, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	return ValidateExportObjectAndTenantID(ctx, r.Store, name, options)
+}
+
+// Update alters the object subset of an object.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo,
+	createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc,
+	forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// Delete enforces life-cycle rules for namespace request termination.
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc,
+	options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	request := obj.(*business.NamespaceRequest)
+	if request.Status.Phase == business.NamespaceRequestApproved {
+		return nil, false, fmt.Errorf("namespace request is in %s phase, wait for the namespace to be created",
+			request.Status.Phase)
+	}
+
+	return r.Store.Delete(ctx, name, deleteValidation, options)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a namespace request.
+type StatusREST struct {
+	store *registry.Store
+}
+
+// StatusREST implements Patcher
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Export an object.  Fields that are not user specified are stripped out
+// Returns the stripped object.
+func (r *StatusREST) Export(ctx context.Context, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	return ValidateExportObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
+
+// ApprovalREST implements the REST endpoint for approving or rejecting a
+// namespace request.
+type ApprovalREST struct {
+	store *registry.Store
+}
+
+// ApprovalREST implements Patcher
+var _ = rest.Patcher(&ApprovalREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *ApprovalREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ApprovalREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+}
+
+// Update approves or rejects the namespace request.
+func (r *ApprovalREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// subresources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+}
@@ -0,0 +1,244 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacerequest
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for namespace request.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	businessClient *businessinternalclient.BusinessClient
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating namespace request objects.
+func NewStrategy(businessClient *businessinternalclient.BusinessClient) *Strategy {
+	return &Strategy{business.Scheme, namesutil.Generator, businessClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object. Only metadata can be changed, spec is immutable and status is
+// changed by the approval and status subresources.
+func (Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldRequest := old.(*business.NamespaceRequest)
+	newRequest, _ := obj.(*business.NamespaceRequest)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldRequest.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update namespace request information",
+				log.String("oldTenantID", oldRequest.Spec.TenantID),
+				log.String("newTenantID", newRequest.Spec.TenantID),
+				log.String("userTenantID", tenantID))
+		}
+	}
+	newRequest.Spec = oldRequest.Spec
+	newRequest.Status = oldRequest.Status
+}
+
+// NamespaceScoped is true for namespace requests.
+func (Strategy) NamespaceScoped() bool {
+	return true
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	username, tenantID := authentication.UsernameAndTenantID(ctx)
+	request, _ := obj.(*business.NamespaceRequest)
+	if len(tenantID) != 0 {
+		request.Spec.TenantID = tenantID
+	}
+	request.Spec.Requester = username
+
+	if request.ObjectMeta.Name == "" {
+		request.ObjectMeta.GenerateName = "nsr-"
+	}
+
+	request.Status = business.NamespaceRequestStatus{
+		Phase:              business.NamespaceRequestPending,
+		LastTransitionTime: metav1.Now(),
+	}
+}
+
+// AfterCreate implements a further operation to run after a resource is
+// created and before it is decorated, optional.
+func (s *Strategy) AfterCreate(obj runtime.Object) error {
+	return nil
+}
+
+// Validate validates a new namespace request.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateNamespaceRequestCreate(ctx, obj.(*business.NamespaceRequest), s.businessClient)
+}
+
+// AllowCreateOnUpdate is false for namespace requests.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end namespace request.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateNamespaceRequestUpdate(obj.(*business.NamespaceRequest), old.(*business.NamespaceRequest))
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	request, ok := obj.(*business.NamespaceRequest)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a namespace request")
+	}
+	return request.ObjectMeta.Labels, ToSelectableFields(request), nil
+}
+
+// MatchNamespaceRequest returns a generic matcher for a given label and field selector.
+func MatchNamespaceRequest(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"spec.clusterName",
+			"spec.namespace",
+			"spec.requester",
+			"status.phase",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(request *business.NamespaceRequest) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&request.ObjectMeta, true)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID":    request.Spec.TenantID,
+		"spec.clusterName": request.Spec.ClusterName,
+		"spec.namespace":   request.Spec.Namespace,
+		"spec.requester":   request.Spec.Requester,
+		"status.phase":     string(request.Status.Phase),
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
+
+// StatusStrategy implements verification logic for status of namespace request.
+type StatusStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &StatusStrategy{}
+
+// NewStatusStrategy create the StatusStrategy object by given strategy.
+func NewStatusStrategy(strategy *Strategy) *StatusStrategy {
+	return &StatusStrategy{strategy}
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize
+// the object.  For example: remove fields that are not to be persisted,
+// sort order-insensitive list fields, etc.  This should not remove fields
+// whose presence would be considered a validation error.
+func (StatusStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRequest := obj.(*business.NamespaceRequest)
+	oldRequest := old.(*business.NamespaceRequest)
+	newRequest.Spec = oldRequest.Spec
+	// the result of approval can only be changed by the approval subresource
+	newRequest.Status.Approver = oldRequest.Status.Approver
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *StatusStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateNamespaceRequestStatusUpdate(obj.(*business.NamespaceRequest), old.(*business.NamespaceRequest))
+}
+
+// ApprovalStrategy implements verification logic for approval of namespace request.
+type ApprovalStrategy struct {
+	*Strategy
+}
+
+var _ rest.RESTUpdateStrategy = &ApprovalStrategy{}
+
+// NewApprovalStrategy create the ApprovalStrategy object by given strategy.
+func NewApprovalStrategy(strategy *Strategy) *ApprovalStrategy {
+	return &ApprovalStrategy{strategy}
+}
+
+// PrepareForUpdate only takes the phase and message of approval from the
+// request, the approver is the user who sends the request.
+func (ApprovalStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	newRequest := obj.(*business.NamespaceRequest)
+	oldRequest := old.(*business.NamespaceRequest)
+	username, _ := authentication.UsernameAndTenantID(ctx)
+
+	phase, message := newRequest.Status.Phase, newRequest.Status.Message
+	newRequest.Spec = oldRequest.Spec
+	newRequest.Status = oldRequest.Status
+	newRequest.Status.Phase = phase
+	newRequest.Status.Message = message
+	newRequest.Status.Approver = username
+	newRequest.Status.LastTransitionTime = metav1.Now()
+}
+
+// ValidateUpdate is invoked after default fields in the object have been
+// filled in before the object is persisted.  This method should not mutate
+// the object.
+func (s *ApprovalStrategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateNamespaceRequestApproval(obj.(*business.NamespaceRequest), old.(*business.NamespaceRequest))
+}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacerequest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
+	"tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/resource"
+)
+
+var (
+	_forbiddenNamespaces = map[string]bool{
+		"kube-system":     true,
+		"kube-public":     true,
+		"kube-node-lease": true,
+	}
+)
+
+// ValidateNamespaceRequestName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateNamespaceRequestName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateNamespaceRequestCreate tests if required fields in the NamespaceRequest are set correctly.
+func ValidateNamespaceRequestCreate(ctx context.Context, request *business.NamespaceRequest, businessClient *businessinternalclient.BusinessClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&request.ObjectMeta,
+		true, ValidateNamespaceRequestName, field.NewPath("metadata"))
+
+	fldSpecPath := field.NewPath("spec")
+	if request.Spec.ClusterName == "" {
+		allErrs = append(allErrs, field.Required(fldSpecPath.Child("clusterName"), "must specify cluster name"))
+	}
+	fldNamespacePath := fldSpecPath.Child("namespace")
+	if request.Spec.Namespace == "" {
+		allErrs = append(allErrs, field.Required(fldNamespacePath, "must specify namespace name"))
+	} else {
+		for _, msg := range apimachineryvalidation.ValidateNamespaceName(request.Spec.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldNamespacePath, request.Spec.Namespace, msg))
+		}
+		ns := strings.ToLower(request.Spec.Namespace)
+		if _, hit := _forbiddenNamespaces[ns]; hit {
+			allErrs = append(allErrs,
+				field.Invalid(fldNamespacePath, request.Spec.Namespace,
+					fmt.Sprintf("cannot request the cluster's `%s` namespace in the project", ns)))
+		}
+	}
+	fldHardPath := fldSpecPath.Child("hard")
+	for k, v := range request.Spec.Hard {
+		resPath := fldHardPath.Key(k)
+		allErrs = append(allErrs, resource.ValidateResourceQuotaResourceName(k, resPath)...)
+		allErrs = append(allErrs, resource.ValidateResourceQuantityValue(k, v, resPath)...)
+	}
+	if len(allErrs) != 0 {
+		return allErrs
+	}
+
+	if request.Spec.Template != "" {
+		fldTemplatePath := fldSpecPath.Child("template")
+		template, err := businessClient.ConfigMaps().Get(ctx, util.NamespaceTemplateConfigMapName(request.Spec.Template), metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return append(allErrs, field.NotFound(fldTemplatePath, request.Spec.Template))
+			}
+			return append(allErrs, field.InternalError(fldTemplatePath, err))
+		}
+		if _, err := util.NamespaceTemplateHard(request.Spec.Template, template.Data); err != nil {
+			return append(allErrs, field.Invalid(fldTemplatePath, request.Spec.Template, err.Error()))
+		}
+	}
+
+	fldProject := field.NewPath("metadata", "namespace")
+	project, err := businessClient.Projects().Get(ctx, request.ObjectMeta.Namespace, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return append(allErrs, field.NotFound(fldProject, request.ObjectMeta.Namespace))
+		}
+		return append(allErrs, field.InternalError(fldProject, err))
+	}
+	if project.Spec.TenantID != request.Spec.TenantID {
+		return append(allErrs, field.NotFound(fldProject, request.ObjectMeta.Namespace))
+	}
+	if project.Status.Locked != nil && *project.Status.Locked {
+		return append(allErrs, field.Invalid(fldProject, request.ObjectMeta.Namespace, "project has been locked"))
+	}
+	if _, ok := project.Spec.Clusters[request.Spec.ClusterName]; !ok {
+		allErrs = append(allErrs, field.Invalid(fldSpecPath.Child("clusterName"), request.Spec.ClusterName,
+			fmt.Sprintf("project %s can not use the cluster", project.Name)))
+	}
+
+	// the name of business namespace is generated by cluster and namespace
+	name := fmt.Sprintf("%s-%s", request.Spec.ClusterName, request.Spec.Namespace)
+	_, err = businessClient.Namespaces(request.ObjectMeta.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		allErrs = append(allErrs, field.Duplicate(fldNamespacePath, request.Spec.Namespace))
+	} else if !errors.IsNotFound(err) {
+		allErrs = append(allErrs, field.InternalError(fldNamespacePath, err))
+	}
+
+	return allErrs
+}
+
+// ValidateNamespaceRequestUpdate tests if required fields in the NamespaceRequest are set during
+// an update.
+func ValidateNamespaceRequestUpdate(request, old *business.NamespaceRequest) field.ErrorList {
+	return apimachineryvalidation.ValidateObjectMetaUpdate(&request.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+}
+
+// ValidateNamespaceRequestStatusUpdate tests if the status of NamespaceRequest
+// is changed by controller as expected.
+func ValidateNamespaceRequestStatusUpdate(request, old *business.NamespaceRequest) field.ErrorList {
+	allErrs := ValidateNamespaceRequestUpdate(request, old)
+
+	if request.Status.Phase != old.Status.Phase {
+		// only the approved requests can be processed by controller
+		if old.Status.Phase != business.NamespaceRequestApproved ||
+			(request.Status.Phase != business.NamespaceRequestCreated && request.Status.Phase != business.NamespaceRequestFailed) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("status", "phase"), request.Status.Phase,
+				fmt.Sprintf("can not change phase from %s", old.Status.Phase)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateNamespaceRequestApproval tests if the NamespaceRequest is approved
+// or rejected as expected.
+func ValidateNamespaceRequestApproval(request, old *business.NamespaceRequest) field.ErrorList {
+	allErrs := ValidateNamespaceRequestUpdate(request, old)
+
+	fldPhasePath := field.NewPath("status", "phase")
+	if old.Status.Phase != business.NamespaceRequestPending {
+		allErrs = append(allErrs, field.Forbidden(fldPhasePath,
+			fmt.Sprintf("namespace request has already been %s", old.Status.Phase)))
+	}
+	if request.Status.Phase != business.NamespaceRequestApproved && request.Status.Phase != business.NamespaceRequestRejected {
+		allErrs = append(allErrs, field.NotSupported(fldPhasePath, request.Status.Phase,
+			[]string{string(business.NamespaceRequestApproved), string(business.NamespaceRequestRejected)}))
+	}
+
+	return allErrs
+}
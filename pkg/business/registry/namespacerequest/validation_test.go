@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package namespacerequest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/business"
+)
+
+func fields(errs field.ErrorList) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Field)
+	}
+	return result
+}
+
+func newRequest(phase business.NamespaceRequestPhase) *business.NamespaceRequest {
+	return &business.NamespaceRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prj-a", Name: "nsr-a", ResourceVersion: "1"},
+		Spec: business.NamespaceRequestSpec{
+			TenantID:    "default",
+			ClusterName: "cls-a",
+			Namespace:   "dev",
+		},
+		Status: business.NamespaceRequestStatus{Phase: phase},
+	}
+}
+
+func TestValidateNamespaceRequestCreateSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*business.NamespaceRequest)
+		want   []string
+	}{
+		{
+			name:   "no cluster",
+			update: func(r *business.NamespaceRequest) { r.Spec.ClusterName = "" },
+			want:   []string{"spec.clusterName"},
+		},
+		{
+			name:   "no namespace",
+			update: func(r *business.NamespaceRequest) { r.Spec.Namespace = "" },
+			want:   []string{"spec.namespace"},
+		},
+		{
+			name:   "invalid namespace",
+			update: func(r *business.NamespaceRequest) { r.Spec.Namespace = "Dev_1" },
+			want:   []string{"spec.namespace"},
+		},
+		{
+			name:   "system namespace",
+			update: func(r *business.NamespaceRequest) { r.Spec.Namespace = "kube-system" },
+			want:   []string{"spec.namespace"},
+		},
+		{
+			name: "unsupported resource",
+			update: func(r *business.NamespaceRequest) {
+				r.Spec.Hard = business.ResourceList{"cpus": resource.MustParse("1")}
+			},
+			want: []string{"spec.hard[cpus]", "spec.hard[cpus]"},
+		},
+		{
+			name: "negative quantity",
+			update: func(r *business.NamespaceRequest) {
+				r.Spec.Hard = business.ResourceList{"requests.cpu": resource.MustParse("-1")}
+			},
+			want: []string{"spec.hard[requests.cpu]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := newRequest(business.NamespaceRequestPending)
+			request.ResourceVersion = ""
+			tt.update(request)
+			// the spec errors are returned before looking up the project
+			got := fields(ValidateNamespaceRequestCreate(context.Background(), request, nil))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateNamespaceRequestCreate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceRequestStatusUpdate(t *testing.T) {
+	tests := []struct {
+		name string
+		old  business.NamespaceRequestPhase
+		new  business.NamespaceRequestPhase
+		want []string
+	}{
+		{name: "created", old: business.NamespaceRequestApproved, new: business.NamespaceRequestCreated},
+		{name: "failed", old: business.NamespaceRequestApproved, new: business.NamespaceRequestFailed},
+		{name: "unchanged", old: business.NamespaceRequestCreated, new: business.NamespaceRequestCreated},
+		{name: "approved by controller", old: business.NamespaceRequestPending, new: business.NamespaceRequestApproved, want: []string{"status.phase"}},
+		{name: "created before approved", old: business.NamespaceRequestPending, new: business.NamespaceRequestCreated, want: []string{"status.phase"}},
+		{name: "rejected after approved", old: business.NamespaceRequestApproved, new: business.NamespaceRequestRejected, want: []string{"status.phase"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(ValidateNamespaceRequestStatusUpdate(newRequest(tt.new), newRequest(tt.old)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateNamespaceRequestStatusUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateNamespaceRequestApproval(t *testing.T) {
+	tests := []struct {
+		name string
+		old  business.NamespaceRequestPhase
+		new  business.NamespaceRequestPhase
+		want []string
+	}{
+		{name: "approved", old: business.NamespaceRequestPending, new: business.NamespaceRequestApproved},
+		{name: "rejected", old: business.NamespaceRequestPending, new: business.NamespaceRequestRejected},
+		{name: "approved twice", old: business.NamespaceRequestApproved, new: business.NamespaceRequestApproved, want: []string{"status.phase"}},
+		{name: "rejected after created", old: business.NamespaceRequestCreated, new: business.NamespaceRequestRejected, want: []string{"status.phase"}},
+		{name: "created by approver", old: business.NamespaceRequestPending, new: business.NamespaceRequestCreated, want: []string{"status.phase"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(ValidateNamespaceRequestApproval(newRequest(tt.new), newRequest(tt.old)))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateNamespaceRequestApproval() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -37,6 +37,7 @@ import (
 	emigrationstorage "tkestack.io/tke/pkg/business/registry/emigration/storage"
 	imagenamespacestorage "tkestack.io/tke/pkg/business/registry/imagenamespace/storage"
 	namespacestorage "tkestack.io/tke/pkg/business/registry/namespace/storage"
+	namespacerequeststorage "tkestack.io/tke/pkg/business/registry/namespacerequest/storage"
 	platformstorage "tkestack.io/tke/pkg/business/registry/platform/storage"
 	portalstorage "tkestack.io/tke/pkg/business/registry/portal/storage"
 	projectstorage "tkestack.io/tke/pkg/business/registry/project/storage"
@@ -103,6 +104,11 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		emigrationREST := emigrationstorage.NewStorage(restOptionsGetter, businessClient, s.PlatformClient, s.PrivilegedUsername)
 		storageMap["nsemigrations"] = emigrationREST.Emigration
 
+		namespaceRequestREST := namespacerequeststorage.NewStorage(restOptionsGetter, businessClient, s.PrivilegedUsername)
+		storageMap["namespacerequests"] = namespaceRequestREST.NamespaceRequest
+		storageMap["namespacerequests/status"] = namespaceRequestREST.Status
+		storageMap["namespacerequests/approval"] = namespaceRequestREST.Approval
+
 		if s.RegistryClient != nil {
 			imageNamespaceREST := imagenamespacestorage.NewStorage(restOptionsGetter, businessClient, s.RegistryClient, s.PrivilegedUsername)
 			storageMap["imagenamespaces"] = imageNamespaceREST.ImageNamespace
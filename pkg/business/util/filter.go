@@ -98,3 +98,15 @@ func FilterNsEmigration(ctx context.Context, emigration *business.NsEmigration)
 	}
 	return nil
 }
+
+// FilterNamespaceRequest is used to filter namespace requests that do not belong to the tenant.
+func FilterNamespaceRequest(ctx context.Context, request *business.NamespaceRequest) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if request.Spec.TenantID != tenantID {
+		return errors.NewNotFound(businessv1.Resource("namespacerequest"), request.ObjectMeta.Name)
+	}
+	return nil
+}
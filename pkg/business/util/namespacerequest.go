@@ -0,0 +1,53 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	// NamespaceRequestNotifyConfigMapName is the name of business config map which
+	// contains the notify channel and template of namespace request results.
+	NamespaceRequestNotifyConfigMapName = "namespace-request-notify"
+
+	namespaceTemplateConfigMapPrefix = "namespace-template-"
+)
+
+// NamespaceTemplateConfigMapName returns the name of business config map of the
+// namespace template, whose data are the resource names and quantities.
+func NamespaceTemplateConfigMapName(template string) string {
+	return namespaceTemplateConfigMapPrefix + template
+}
+
+// NamespaceTemplateHard parses the resources of a namespace template from the
+// data of its config map.
+func NamespaceTemplateHard(template string, data map[string]string) (map[string]resource.Quantity, error) {
+	hard := make(map[string]resource.Quantity)
+	for name, value := range data {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q of %s in namespace template %s: %v", value, name, template, err)
+		}
+		hard[name] = q
+	}
+	return hard, nil
+}
@@ -84,6 +84,9 @@ type ComponentConfiguration struct {
 	Controllers []string
 	// DebuggingConfiguration holds configuration for Debugging related features.
 	Debugging componentconfig.DebuggingConfiguration
+	// ProfilingToken is the bearer token required to access the profiling
+	// endpoints.
+	ProfilingToken string
 }
 
 // LeaderElectionConfiguration defines the configuration of leader election
@@ -30,8 +30,8 @@ import (
 	genericfilters "k8s.io/apiserver/pkg/server/filters"
 	"k8s.io/apiserver/pkg/server/healthz"
 	"k8s.io/apiserver/pkg/server/mux"
-	"k8s.io/apiserver/pkg/server/routes"
 	componentconfig "k8s.io/component-base/config"
+	"tkestack.io/tke/pkg/apiserver/debug"
 )
 
 // BuildHandlerChain builds a handler chain with a base handler and CompletedConfig.
@@ -52,17 +52,27 @@ func BuildHandlerChain(apiHandler http.Handler, authorizationInfo *apiserver.Aut
 	return handler
 }
 
-// NewBaseHandler takes in CompletedConfig and returns a handler.
+// NewBaseHandler takes in CompletedConfig and returns a handler. The profiling
+// endpoints are not installed since they are served by WithProfiling out of
+// the handler chain.
 func NewBaseHandler(c *componentconfig.DebuggingConfiguration, checks ...healthz.HealthChecker) *mux.PathRecorderMux {
 	m := mux.NewPathRecorderMux("controller-manager")
 	healthz.InstallHandler(m, checks...)
-	if c.EnableProfiling {
-		routes.Profiling{}.Install(m)
-		if c.EnableContentionProfiling {
-			goruntime.SetBlockProfileRate(1)
-		}
+	if c.EnableProfiling && c.EnableContentionProfiling {
+		goruntime.SetBlockProfileRate(1)
 	}
 	m.Handle("/metrics", promhttp.Handler())
 
 	return m
 }
+
+// WithProfiling wraps the handler chain built by BuildHandlerChain to serve
+// the profiling endpoints with the profilingToken as bearer token if
+// profiling is enabled. The profiling token is unknown to the authenticators
+// of the chain, so it must be checked before the chain.
+func WithProfiling(handler http.Handler, c *componentconfig.DebuggingConfiguration, profilingToken string) http.Handler {
+	if !c.EnableProfiling {
+		return handler
+	}
+	return debug.WithProfilingAuthentication(handler, profilingToken)
+}
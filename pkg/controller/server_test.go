@@ -0,0 +1,83 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package controller
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apiserver/pkg/authentication/authenticator"
+	"k8s.io/apiserver/pkg/authorization/authorizerfactory"
+	apiserver "k8s.io/apiserver/pkg/server"
+	componentconfig "k8s.io/component-base/config"
+)
+
+func TestWithProfilingHandlerChain(t *testing.T) {
+	// The authenticators of the chain know nothing about the profiling token.
+	authenticationInfo := &apiserver.AuthenticationInfo{
+		Authenticator: authenticator.RequestFunc(func(req *http.Request) (*authenticator.Response, bool, error) {
+			return nil, false, nil
+		}),
+	}
+	authorizationInfo := &apiserver.AuthorizationInfo{
+		Authorizer: authorizerfactory.NewAlwaysDenyAuthorizer(),
+	}
+	scheme := runtime.NewScheme()
+	metav1.AddToGroupVersion(scheme, schema.GroupVersion{Version: "v1"})
+	codecs := serializer.NewCodecFactory(scheme)
+
+	tests := []struct {
+		name      string
+		profiling bool
+		path      string
+		auth      string
+		want      int
+	}{
+		{"profiling with token", true, "/debug/pprof/", "Bearer secret", http.StatusOK},
+		{"profile with token", true, "/debug/pprof/heap", "Bearer secret", http.StatusOK},
+		{"profiling redirect", true, "/debug/pprof", "Bearer secret", http.StatusMovedPermanently},
+		{"profiling with wrong token", true, "/debug/pprof/heap", "Bearer wrong", http.StatusUnauthorized},
+		{"profiling without token", true, "/debug/pprof/heap", "", http.StatusUnauthorized},
+		{"token is not a chain credential", true, "/healthz", "Bearer secret", http.StatusUnauthorized},
+		{"profiling disabled", false, "/debug/pprof/heap", "Bearer secret", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			debugging := &componentconfig.DebuggingConfiguration{EnableProfiling: tt.profiling}
+			serverMux := NewBaseHandler(debugging)
+			handler := BuildHandlerChain(serverMux, authorizationInfo, authenticationInfo, codecs)
+			handler = WithProfiling(handler, debugging, "secret")
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.auth != "" {
+				req.Header.Set("Authorization", tt.auth)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, req)
+			if w.Code != tt.want {
+				t.Errorf("%s with %q: got %d, want %d", tt.path, tt.auth, w.Code, tt.want)
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ import (
 	"tkestack.io/tke/pkg/gateway/api"
 	gatewayconfig "tkestack.io/tke/pkg/gateway/apis/config"
 	"tkestack.io/tke/pkg/gateway/assets"
+	"tkestack.io/tke/pkg/gateway/portforward"
 	"tkestack.io/tke/pkg/gateway/proxy"
 	"tkestack.io/tke/pkg/gateway/webtty"
 )
@@ -102,6 +103,10 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 		return nil, err
 	}
 
+	if err := portforward.RegisterRoute(s.Handler.NonGoRestfulMux, c.ExtraConfig.GatewayConfig); err != nil {
+		return nil, err
+	}
+
 	if !c.ExtraConfig.HeaderRequest {
 		assets.RegisterRoute(s.Handler.NonGoRestfulMux, c.ExtraConfig.OAuthConfig, c.ExtraConfig.GatewayConfig.DisableOIDCProxy)
 	} else {
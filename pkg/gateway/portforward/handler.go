@@ -0,0 +1,479 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/transport/spdy"
+	"tkestack.io/tke/pkg/gateway/token"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// portForwardProtocolV1Name is the subprotocol used for port forwarding.
+	portForwardProtocolV1Name = "portforward.k8s.io"
+
+	// defaultIdleTimeout is the idle timeout of sessions if it's not specified.
+	defaultIdleTimeout = 10 * time.Minute
+	// maxIdleTimeout is the max idle timeout clients can specify.
+	maxIdleTimeout = time.Hour
+
+	// bufferSize is the size of buffer copying data from the pod.
+	bufferSize = 32 * 1024
+	// writeWait defines time allowed to write a message to the peer.
+	writeWait = 10 * time.Second
+	// maxCloseReasonLength is the max length of reason in websocket close frame.
+	maxCloseReasonLength = 123
+)
+
+var errIdleTimeout = errors.New("idle timeout")
+
+// The origin of requests is checked by default since the token is retrieved
+// from cookie, otherwise other sites could forward ports on behalf of users.
+var wsUpgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: bufferSize,
+}
+
+// target is the pod or service port to forward.
+type target struct {
+	clusterName string
+	projectName string
+	namespace   string
+	podName     string
+	serviceName string
+	port        int32
+}
+
+type handler struct {
+	address string
+}
+
+// NewHandler creates a handler which forwards the port of a pod or service
+// to the websocket connection. The binary messages of the connection are the
+// data of the forwarded port. The requests are sent to the platform component
+// with the token of user, which authorizes the user to forward the port.
+func NewHandler(address string) (http.Handler, error) {
+	if _, err := url.Parse(address); err != nil {
+		log.Error("Failed to parse backend service address", log.String("address", address), log.Err(err))
+		return nil, err
+	}
+	return &handler{address: address}, nil
+}
+
+func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	bearerToken, err := retrieveToken(req)
+	if err != nil {
+		log.Error("Failed to retrieve token from port forward request", log.Err(err))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	target, err := parseTarget(req.URL.Query())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	idleTimeout, err := parseIdleTimeout(req.URL.Query().Get("idleTimeout"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config := h.restConfig(bearerToken, target)
+	podName, podPort := target.podName, target.port
+	if target.serviceName != "" {
+		podName, podPort, err = resolveService(req.Context(), config, target)
+		if err != nil {
+			log.Warn("Failed to resolve the service of port forward", log.String("clusterName", target.clusterName), log.String("namespace", target.namespace), log.String("service", target.serviceName), log.Err(err))
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+
+	// dial before upgrading so that the failures such as forbidden are
+	// responded with http status
+	streamConn, err := dialPortForward(config, target.namespace, podName)
+	if err != nil {
+		log.Warn("Failed to dial port forward", log.String("clusterName", target.clusterName), log.String("namespace", target.namespace), log.String("pod", podName), log.Err(err))
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer streamConn.Close()
+
+	errorStream, dataStream, err := createStreams(streamConn, podPort)
+	if err != nil {
+		log.Warn("Failed to create port forward streams", log.String("clusterName", target.clusterName), log.String("namespace", target.namespace), log.String("pod", podName), log.Err(err))
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	conn, err := wsUpgrader.Upgrade(w, req, nil)
+	if err != nil {
+		log.Error("Failed initialize websocket connection", log.Err(err))
+		return
+	}
+	defer conn.Close()
+
+	s := newSession(conn, errorStream, dataStream, idleTimeout)
+	startTime := time.Now()
+	log.Info("Port forward session started",
+		log.String("clusterName", target.clusterName),
+		log.String("namespace", target.namespace),
+		log.String("pod", podName),
+		log.String("service", target.serviceName),
+		log.Int32("port", podPort),
+		log.String("remoteAddr", req.RemoteAddr))
+	err = s.run()
+	s.close(err)
+	log.Info("Port forward session closed",
+		log.String("clusterName", target.clusterName),
+		log.String("namespace", target.namespace),
+		log.String("pod", podName),
+		log.String("service", target.serviceName),
+		log.Int32("port", podPort),
+		log.Duration("duration", time.Since(startTime)),
+		log.Int64("bytesSent", atomic.LoadInt64(&s.bytesSent)),
+		log.Int64("bytesReceived", atomic.LoadInt64(&s.bytesReceived)),
+		log.String("reason", closeReason(err)))
+}
+
+// retrieveToken returns the token in cookie of browsers, or the bearer token
+// of clients such as command line tools.
+func retrieveToken(req *http.Request) (string, error) {
+	if t, err := token.RetrieveToken(req); err == nil {
+		return strings.TrimSpace(t.ID), nil
+	}
+	auth := strings.TrimSpace(req.Header.Get("Authorization"))
+	parts := strings.SplitN(auth, " ", 2)
+	if len(parts) == 2 && strings.EqualFold(parts[0], "bearer") && strings.TrimSpace(parts[1]) != "" {
+		return strings.TrimSpace(parts[1]), nil
+	}
+	return "", fmt.Errorf("no token found in request")
+}
+
+func parseTarget(query url.Values) (*target, error) {
+	t := &target{
+		clusterName: query.Get("clusterName"),
+		projectName: query.Get("projectName"),
+		namespace:   query.Get("namespace"),
+		podName:     query.Get("podName"),
+		serviceName: query.Get("serviceName"),
+	}
+	if t.clusterName == "" {
+		return nil, fmt.Errorf("clusterName is required")
+	}
+	if t.namespace == "" {
+		return nil, fmt.Errorf("namespace is required")
+	}
+	if (t.podName == "") == (t.serviceName == "") {
+		return nil, fmt.Errorf("one of podName and serviceName is required")
+	}
+	port, err := strconv.ParseInt(query.Get("port"), 10, 32)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, fmt.Errorf("invalid port %q", query.Get("port"))
+	}
+	t.port = int32(port)
+	return t, nil
+}
+
+func parseIdleTimeout(s string) (time.Duration, error) {
+	if s == "" {
+		return defaultIdleTimeout, nil
+	}
+	timeout, err := time.ParseDuration(s)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid idleTimeout %q", s)
+	}
+	if timeout > maxIdleTimeout {
+		timeout = maxIdleTimeout
+	}
+	return timeout, nil
+}
+
+func (h *handler) restConfig(bearerToken string, target *target) *rest.Config {
+	header := http.Header{}
+	header.Set(filter.ClusterNameHeaderKey, target.clusterName)
+	if target.projectName != "" {
+		header.Set(filter.ProjectNameHeaderKey, target.projectName)
+	}
+	return &rest.Config{
+		Host:        h.address,
+		BearerToken: bearerToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: true,
+		},
+		WrapTransport: func(rt http.RoundTripper) http.RoundTripper {
+			return &headerRoundTripper{header: header, rt: rt}
+		},
+	}
+}
+
+// resolveService returns a ready pod of the service and the target port of
+// the service port in the pod.
+func resolveService(ctx context.Context, config *rest.Config, target *target) (string, int32, error) {
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return "", 0, err
+	}
+	svc, err := client.CoreV1().Services(target.namespace).Get(ctx, target.serviceName, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+	endpoints, err := client.CoreV1().Endpoints(target.namespace).Get(ctx, target.serviceName, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, err
+	}
+	return podForServicePort(svc, endpoints, target.port)
+}
+
+// podForServicePort returns the first ready pod of endpoints which serves the
+// port of service.
+func podForServicePort(svc *corev1.Service, endpoints *corev1.Endpoints, port int32) (string, int32, error) {
+	var servicePort *corev1.ServicePort
+	for i := range svc.Spec.Ports {
+		if svc.Spec.Ports[i].Port == port {
+			servicePort = &svc.Spec.Ports[i]
+			break
+		}
+	}
+	if servicePort == nil {
+		return "", 0, fmt.Errorf("service %s has no port %d", svc.Name, port)
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, endpointPort := range subset.Ports {
+			if endpointPort.Name != servicePort.Name {
+				continue
+			}
+			for _, address := range subset.Addresses {
+				if address.TargetRef != nil && address.TargetRef.Kind == "Pod" {
+					return address.TargetRef.Name, endpointPort.Port, nil
+				}
+			}
+		}
+	}
+	return "", 0, fmt.Errorf("service %s has no ready pod for port %d", svc.Name, port)
+}
+
+func dialPortForward(config *rest.Config, namespace, podName string) (httpstream.Connection, error) {
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(config.Host)
+	if err != nil {
+		return nil, err
+	}
+	u.Path = path.Join(u.Path, "api", "v1", "namespaces", namespace, "pods", podName, "portforward")
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, u)
+	streamConn, protocol, err := dialer.Dial(portForwardProtocolV1Name)
+	if err != nil {
+		return nil, err
+	}
+	if protocol != portForwardProtocolV1Name {
+		streamConn.Close()
+		return nil, fmt.Errorf("unable to negotiate protocol: client supports %q, server returned %q", portForwardProtocolV1Name, protocol)
+	}
+	return streamConn, nil
+}
+
+func createStreams(streamConn httpstream.Connection, port int32) (httpstream.Stream, httpstream.Stream, error) {
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(int(port)))
+	headers.Set(corev1.PortForwardRequestIDHeader, "0")
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating error stream: %v", err)
+	}
+	// we're not writing to this stream
+	errorStream.Close()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating data stream: %v", err)
+	}
+	return errorStream, dataStream, nil
+}
+
+// session copies data between the websocket connection and the data stream
+// of the forwarded port until either side is closed or the session is idle.
+type session struct {
+	conn        *websocket.Conn
+	errorStream httpstream.Stream
+	dataStream  httpstream.Stream
+	idleTimeout time.Duration
+	writeLock   sync.Mutex
+
+	lastActive    int64
+	bytesSent     int64
+	bytesReceived int64
+}
+
+func newSession(conn *websocket.Conn, errorStream, dataStream httpstream.Stream, idleTimeout time.Duration) *session {
+	return &session{
+		conn:        conn,
+		errorStream: errorStream,
+		dataStream:  dataStream,
+		idleTimeout: idleTimeout,
+		lastActive:  time.Now().UnixNano(),
+	}
+}
+
+func (s *session) touch() {
+	atomic.StoreInt64(&s.lastActive, time.Now().UnixNano())
+}
+
+func (s *session) idle() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&s.lastActive)))
+}
+
+func (s *session) run() error {
+	done := make(chan error, 3)
+	go func() { done <- s.copyToPod() }()
+	go func() { done <- s.copyFromPod() }()
+	go func() {
+		// the error stream is closed without message if no error occurs
+		message, err := ioutil.ReadAll(s.errorStream)
+		if err == nil && len(message) > 0 {
+			done <- fmt.Errorf("error forwarding port: %s", string(message))
+		}
+	}()
+
+	interval := s.idleTimeout / 10
+	if interval > 30*time.Second {
+		interval = 30 * time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-done:
+			return err
+		case <-ticker.C:
+			if s.idle() >= s.idleTimeout {
+				return errIdleTimeout
+			}
+		}
+	}
+}
+
+// copyToPod copies the messages of websocket connection to the pod.
+func (s *session) copyToPod() error {
+	for {
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			return err
+		}
+		s.touch()
+		if _, err := s.dataStream.Write(data); err != nil {
+			return err
+		}
+		atomic.AddInt64(&s.bytesSent, int64(len(data)))
+	}
+}
+
+// copyFromPod copies the data of pod to the websocket connection.
+func (s *session) copyFromPod() error {
+	buf := make([]byte, bufferSize)
+	for {
+		n, err := s.dataStream.Read(buf)
+		if n > 0 {
+			s.touch()
+			if err := s.write(websocket.BinaryMessage, buf[:n]); err != nil {
+				return err
+			}
+			atomic.AddInt64(&s.bytesReceived, int64(n))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *session) write(messageType int, data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(writeWait))
+	return s.conn.WriteMessage(messageType, data)
+}
+
+// close closes the data stream and tells the client why the session is closed.
+func (s *session) close(err error) {
+	s.dataStream.Close()
+	code := websocket.CloseNormalClosure
+	if err != nil {
+		code = websocket.CloseInternalServerErr
+		if err == errIdleTimeout {
+			code = websocket.CloseGoingAway
+		}
+	}
+	reason := closeReason(err)
+	if len(reason) > maxCloseReasonLength {
+		reason = reason[:maxCloseReasonLength]
+	}
+	_ = s.write(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason))
+}
+
+func closeReason(err error) string {
+	if err == nil {
+		return "closed"
+	}
+	return err.Error()
+}
+
+// headerRoundTripper sets the headers to requests.
+type headerRoundTripper struct {
+	header http.Header
+	rt     http.RoundTripper
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, values := range h.header {
+		req.Header[key] = values
+	}
+	return h.rt.RoundTrip(req)
+}
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package portforward
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		query   string
+		wantErr bool
+	}{
+		{"clusterName=cls-a&namespace=default&podName=web-0&port=8080", false},
+		{"clusterName=cls-a&namespace=default&serviceName=web&port=80", false},
+		{"namespace=default&podName=web-0&port=8080", true},
+		{"clusterName=cls-a&podName=web-0&port=8080", true},
+		{"clusterName=cls-a&namespace=default&port=8080", true},
+		{"clusterName=cls-a&namespace=default&podName=web-0&serviceName=web&port=8080", true},
+		{"clusterName=cls-a&namespace=default&podName=web-0&port=70000", true},
+		{"clusterName=cls-a&namespace=default&podName=web-0", true},
+	}
+	for _, test := range tests {
+		query, _ := url.ParseQuery(test.query)
+		if _, err := parseTarget(query); (err != nil) != test.wantErr {
+			t.Errorf("parseTarget(%s) error = %v, wantErr %v", test.query, err, test.wantErr)
+		}
+	}
+}
+
+func TestParseIdleTimeout(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"", defaultIdleTimeout, false},
+		{"30s", 30 * time.Second, false},
+		{"24h", maxIdleTimeout, false},
+		{"-1s", 0, true},
+		{"abc", 0, true},
+	}
+	for _, test := range tests {
+		got, err := parseIdleTimeout(test.value)
+		if (err != nil) != test.wantErr || got != test.want {
+			t.Errorf("parseIdleTimeout(%q) = %v, %v, want %v, wantErr %v", test.value, got, err, test.want, test.wantErr)
+		}
+	}
+}
+
+func TestPodForServicePort(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "web"},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "http", Port: 80}, {Name: "metrics", Port: 9090}},
+		},
+	}
+	endpoints := &corev1.Endpoints{
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: "10.0.0.2", TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "web-0"}}},
+				Ports:     []corev1.EndpointPort{{Name: "http", Port: 8080}, {Name: "metrics", Port: 9091}},
+			},
+		},
+	}
+	pod, port, err := podForServicePort(svc, endpoints, 80)
+	if err != nil || pod != "web-0" || port != 8080 {
+		t.Errorf("podForServicePort() = %s, %d, %v, want web-0, 8080", pod, port, err)
+	}
+	if _, _, err := podForServicePort(svc, endpoints, 443); err == nil {
+		t.Errorf("expected error for unknown service port")
+	}
+	if _, _, err := podForServicePort(svc, &corev1.Endpoints{}, 80); err == nil {
+		t.Errorf("expected error for service without ready pods")
+	}
+}
+
+func TestServiceProxyPath(t *testing.T) {
+	tests := []struct {
+		path        string
+		wantCluster string
+		wantPath    string
+		wantErr     bool
+	}{
+		{"/serviceproxy/cls-a/default/web:80/", "cls-a", "/api/v1/namespaces/default/services/web:80/proxy/", false},
+		{"/serviceproxy/cls-a/default/web:80/a/b/", "cls-a", "/api/v1/namespaces/default/services/web:80/proxy/a/b/", false},
+		{"/serviceproxy/cls-a/default/web:80", "cls-a", "/api/v1/namespaces/default/services/web:80/proxy/", false},
+		{"/serviceproxy/cls-a/default", "", "", true},
+		{"/serviceproxy/cls-a//web:80/", "", "", true},
+	}
+	for _, test := range tests {
+		cluster, p, err := serviceProxyPath(test.path)
+		if (err != nil) != test.wantErr || cluster != test.wantCluster || p != test.wantPath {
+			t.Errorf("serviceProxyPath(%s) = %s, %s, %v, want %s, %s", test.path, cluster, p, err, test.wantCluster, test.wantPath)
+		}
+	}
+}
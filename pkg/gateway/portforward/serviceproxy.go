@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	netutil "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"tkestack.io/tke/pkg/gateway/token"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/transport"
+)
+
+type serviceProxyHandler struct {
+	reverseProxy *httputil.ReverseProxy
+}
+
+// NewServiceProxyHandler creates a handler which proxies the HTTP requests to
+// services through the service proxy of platform component, the cluster name
+// is carried in the path so that services can be visited by browsers.
+func NewServiceProxyHandler(address string) (http.Handler, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		log.Error("Failed to parse backend service address", log.String("address", address), log.Err(err))
+		return nil, err
+	}
+
+	tr, err := transport.NewOneWayTLSTransport("", true)
+	if err != nil {
+		return nil, err
+	}
+
+	reverseProxy := httputil.NewSingleHostReverseProxy(&url.URL{Scheme: u.Scheme, Host: u.Host})
+	reverseProxy.Transport = tr
+	reverseProxy.ErrorLog = log.StdErrLogger()
+	return &serviceProxyHandler{reverseProxy: reverseProxy}, nil
+}
+
+func (h *serviceProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	bearerToken, err := retrieveToken(req)
+	if err != nil {
+		log.Error("Failed to retrieve token from service proxy request", log.Err(err))
+		responsewriters.WriteRawJSON(http.StatusUnauthorized, errors.NewUnauthorized(err.Error()), w)
+		return
+	}
+	clusterName, proxyPath, err := serviceProxyPath(req.URL.Path)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()), w)
+		return
+	}
+
+	log.Debug("Reverse proxy to service", log.String("clusterName", clusterName), log.String("url", proxyPath))
+	newReq := req.WithContext(context.Background())
+	newReq.Header = netutil.CloneHeader(req.Header)
+	token.StripCookie(newReq)
+	newReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", bearerToken))
+	newReq.Header.Set(filter.ClusterNameHeaderKey, clusterName)
+	newURL := *req.URL
+	newURL.Path = proxyPath
+	newURL.RawPath = ""
+	newReq.URL = &newURL
+	h.reverseProxy.ServeHTTP(w, newReq)
+}
+
+// serviceProxyPath converts the path in the format of
+// /serviceproxy/{cluster}/{namespace}/{service}:{port}/{path} to the
+// service proxy path of platform component.
+func serviceProxyPath(requestPath string) (string, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(requestPath, ServiceProxyPath), "/", 4)
+	if len(parts) < 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("invalid service proxy path %q, expected %s{cluster}/{namespace}/{service}:{port}/{path}", requestPath, ServiceProxyPath)
+	}
+	subPath := ""
+	if len(parts) == 4 {
+		subPath = parts[3]
+	}
+	return parts[0], fmt.Sprintf("/api/v1/namespaces/%s/services/%s/proxy/%s", parts[1], parts[2], subPath), nil
+}
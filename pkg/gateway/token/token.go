@@ -101,3 +101,15 @@ func DeleteCookie(writer http.ResponseWriter) {
 	cookie := http.Cookie{Name: cookieName, Path: "/", MaxAge: -1}
 	http.SetCookie(writer, &cookie)
 }
+
+// StripCookie removes the token cookie from the HTTP request, it is used to
+// avoid leaking the token to the backend which the request is proxied to.
+func StripCookie(request *http.Request) {
+	cookies := request.Cookies()
+	request.Header.Del("Cookie")
+	for _, cookie := range cookies {
+		if cookie.Name != cookieName {
+			request.AddCookie(cookie)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	// MetricsPort is the port where the log agent exposes the metrics of the
+	// collection rules to prometheus.
+	MetricsPort = 24231
+	// metricsFile is the file enabling the metrics of the log agent, it does
+	// not collide with the files of the rules which contain underscores.
+	metricsFile = "metrics.conf"
+	// bytesField is the record field holding the size of the log line.
+	bytesField = "_bytes"
+	// rateLimitPeriodSeconds is the period where the rate of the rate limit
+	// is averaged.
+	rateLimitPeriodSeconds = 10
+	// rateLimitGroupKey groups the logs by containers for the rate limit.
+	rateLimitGroupKey = "kubernetes.namespace_name,kubernetes.pod_name,kubernetes.container_name"
+)
+
+// Overflow actions of the buffer.
+const (
+	OverflowActionBlock           = "block"
+	OverflowActionDropOldestChunk = "drop_oldest_chunk"
+	OverflowActionThrowException  = "throw_exception"
+)
+
+// metricsConfig returns the configuration exposing the metrics of the
+// filters and the outputs.
+// See: https://github.com/fluent/fluent-plugin-prometheus
+func metricsConfig() []byte {
+	var b bytes.Buffer
+	b.WriteString("# Generated by tke for the metrics of the log collectors, DO NOT EDIT.\n")
+	newDirective("source", "").
+		set("@type", "prometheus").
+		set("bind", "0.0.0.0").
+		set("port", fmt.Sprint(MetricsPort)).
+		write(&b, "")
+	newDirective("source", "").
+		set("@type", "prometheus_output_monitor").
+		write(&b, "")
+	return b.Bytes()
+}
+
+// renderMetrics counts the lines and bytes collected by the rule, and then
+// drops the lines exceeding the rate limit, the lines passing the rate limit
+// are counted as well.
+func (r *renderer) renderMetrics(rateLimit *RateLimit) {
+	r.filters = append(r.filters,
+		newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("enable_ruby", "true").
+			add(newDirective("record", "").set(bytesField, "${"+rubyField(defaultSource)+".to_s.bytesize}")),
+		r.counters(
+			counter("logcollector_lines_total", "The total number of lines collected by the rule.", ""),
+			counter("logcollector_bytes_total", "The total bytes of lines collected by the rule.", bytesField),
+		),
+	)
+	if rateLimit != nil {
+		// See: https://github.com/rubrikinc/fluent-plugin-throttle
+		r.filters = append(r.filters,
+			newDirective("filter", r.pattern).
+				set("@type", "throttle").
+				set("group_key", rateLimitGroupKey).
+				set("group_bucket_period_s", fmt.Sprint(rateLimitPeriodSeconds)).
+				set("group_bucket_limit", fmt.Sprint(rateLimit.LinesPerSecond*rateLimitPeriodSeconds)).
+				set("group_drop_logs", "true"),
+			r.counters(counter("logcollector_emitted_lines_total", "The total number of lines passing the rate limit of the rule.", "")),
+		)
+	}
+	r.filters = append(r.filters, newDirective("filter", r.pattern).
+		set("@type", "record_transformer").
+		set("remove_keys", bytesField))
+}
+
+// counters returns the prometheus filter of the counters labeled by the rule.
+func (r *renderer) counters(metrics ...*directive) *directive {
+	filter := newDirective("filter", r.pattern).set("@type", "prometheus")
+	for _, metric := range metrics {
+		filter.add(metric)
+	}
+	return filter.add(newDirective("labels", "").
+		set("logcollector_namespace", r.collector.ObjectMeta.Namespace).
+		set("logcollector", r.collector.ObjectMeta.Name))
+}
+
+func counter(name, desc, key string) *directive {
+	metric := newDirective("metric", "").
+		set("name", name).
+		set("type", "counter").
+		set("desc", desc)
+	if key != "" {
+		metric.set("key", key)
+	}
+	return metric
+}
+
+// renderBuffer adds the buffer to the match of the output.
+func renderBuffer(buffer *Buffer, match *directive) {
+	section := newDirective("buffer", "")
+	if buffer.TotalLimitSize != "" {
+		section.set("total_limit_size", buffer.TotalLimitSize)
+	}
+	if buffer.ChunkLimitSize != "" {
+		section.set("chunk_limit_size", buffer.ChunkLimitSize)
+	}
+	if buffer.FlushIntervalSeconds > 0 {
+		section.set("flush_mode", "interval").
+			set("flush_interval", fmt.Sprintf("%ds", buffer.FlushIntervalSeconds))
+	}
+	if buffer.OverflowAction != "" {
+		section.set("overflow_action", buffer.OverflowAction)
+	}
+	match.add(section)
+}
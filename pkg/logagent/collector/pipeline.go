@@ -0,0 +1,162 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// defaultSource is the field holding the log line collected by the agent.
+	defaultSource = "log"
+	// defaultFlushIntervalSeconds is the default time waiting for the
+	// following lines of a multiline log.
+	defaultFlushIntervalSeconds = 5
+)
+
+// Cast types of the pipeline.
+const (
+	CastTypeString  = "string"
+	CastTypeInteger = "integer"
+	CastTypeFloat   = "float"
+	CastTypeBoolean = "boolean"
+)
+
+// namedGroupRegexp matches the named groups of the ruby syntax.
+var namedGroupRegexp = regexp.MustCompile(`\(\?<([a-zA-Z_][a-zA-Z0-9_]*)>`)
+
+// castExpressions are the ruby expressions converting the field to the types.
+var castExpressions = map[string]string{
+	CastTypeString:  "%s.to_s",
+	CastTypeInteger: "%s.to_i",
+	CastTypeFloat:   "%s.to_f",
+	CastTypeBoolean: "%s.to_s == 'true'",
+}
+
+// renderPipeline relabels the records of the rule, and returns the label
+// where the records are processed by the stages, and the labels to be
+// rendered before it.
+func (r *renderer) renderPipeline(pipeline *Pipeline) (label string, labels []*directive) {
+	label = "@" + Tag(r.collector)
+	r.directives = append(r.directives, relabel(r.pattern, label))
+	r.pattern = "**"
+
+	if pipeline.Multiline != nil {
+		// the logs flushed by timeout are emitted to the label of the stages
+		// rather than the error label.
+		parsed := label + ".parsed"
+		flushInterval := pipeline.Multiline.FlushIntervalSeconds
+		if flushInterval == 0 {
+			flushInterval = defaultFlushIntervalSeconds
+		}
+		concat := newDirective("filter", r.pattern).
+			set("@type", "concat").
+			set("key", defaultSource).
+			set("multiline_start_regexp", rubyRegexp(pipeline.Multiline.FirstLine)).
+			set("flush_interval", fmt.Sprint(flushInterval)).
+			set("timeout_label", parsed)
+		labels = append(labels, newDirective("label", label).add(concat).add(relabel(r.pattern, parsed)))
+		label = parsed
+	}
+
+	for i := range pipeline.Stages {
+		r.filters = append(r.filters, r.renderStage(&pipeline.Stages[i]))
+	}
+	return label, labels
+}
+
+func relabel(pattern, label string) *directive {
+	return newDirective("match", pattern).set("@type", "relabel").set("@label", label)
+}
+
+func (r *renderer) renderStage(stage *Stage) *directive {
+	switch {
+	case stage.JSON != nil:
+		return parserFilter(r.pattern, stage.JSON.Source, stage.JSON.KeepSource, newDirective("parse", "").set("@type", "json"))
+	case stage.Regex != nil:
+		parse := newDirective("parse", "").
+			set("@type", "regexp").
+			set("expression", rubyRegexp(stage.Regex.Expression))
+		return parserFilter(r.pattern, stage.Regex.Source, stage.Regex.KeepSource, parse)
+	case len(stage.Rename) > 0:
+		fields := sortedKeys(stage.Rename)
+		record := newDirective("record", "")
+		for _, field := range fields {
+			record.set(stage.Rename[field], "${"+rubyField(field)+"}")
+		}
+		return newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("enable_ruby", "true").
+			set("remove_keys", strings.Join(fields, ",")).
+			add(record)
+	default:
+		record := newDirective("record", "")
+		for _, field := range sortedKeys(stage.Cast) {
+			value := rubyField(field)
+			record.set(field, fmt.Sprintf("${%s.nil? ? nil : %s}", value, fmt.Sprintf(castExpressions[stage.Cast[field]], value)))
+		}
+		return newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("enable_ruby", "true").
+			add(record)
+	}
+}
+
+// parserFilter parses the source field, the records failed to parse are kept
+// as they are.
+func parserFilter(pattern, source string, keepSource bool, parse *directive) *directive {
+	if source == "" {
+		source = defaultSource
+	}
+	return newDirective("filter", pattern).
+		set("@type", "parser").
+		setQuoted("key_name", source).
+		set("reserve_data", "true").
+		set("remove_key_name_field", fmt.Sprint(!keepSource)).
+		set("emit_invalid_record_to_error", "false").
+		add(parse)
+}
+
+// rubyRegexp converts the regular expression into a ruby regexp literal, the
+// named groups of the go syntax (?P<name>) are accepted.
+func rubyRegexp(expression string) string {
+	return "/" + strings.ReplaceAll(expression, "(?P<", "(?<") + "/"
+}
+
+// goRegexp converts the ruby named groups (?<name>) of the regular
+// expression into the go syntax.
+func goRegexp(expression string) string {
+	return namedGroupRegexp.ReplaceAllString(expression, "(?P<$1>")
+}
+
+func rubyField(field string) string {
+	return "record[" + rubyString(field) + "]"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
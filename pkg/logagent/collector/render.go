@@ -0,0 +1,467 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const (
+	// OutputsDir is the directory where the log agent includes the rendered
+	// configurations of outputs.
+	OutputsDir = "/etc/td-agent/outputs.d"
+	// tagPrefix prefixes the tags of the records collected by the rules.
+	tagPrefix = "logcollector"
+	// partitionKeyField is the record field holding the rendered kafka
+	// partition key.
+	partitionKeyField = "_partition_key"
+)
+
+// placeholderRegexp matches the placeholders of templates, such as
+// ${namespace} and ${labels.app}.
+var placeholderRegexp = regexp.MustCompile(`\$\{([^{}]*)\}`)
+
+// placeholders maps the placeholders to the record fields of the kubernetes
+// metadata attached by the log agent.
+var placeholders = map[string][]string{
+	"namespace": {"kubernetes", "namespace_name"},
+	"pod":       {"kubernetes", "pod_name"},
+	"container": {"kubernetes", "container_name"},
+	"node":      {"kubernetes", "host"},
+}
+
+// SecretGetter returns the value of the key of the secret in the namespace.
+type SecretGetter func(namespace string, selector *SecretKeySelector) ([]byte, error)
+
+// Rendered reports whether the output of the collection rule is rendered by
+// tke rather than the log agent itself.
+func Rendered(collector *LogCollector) bool {
+	switch collector.Spec.Output.Type {
+	case OutputTypeKafka, OutputTypeLoki, OutputTypeSplunkHEC:
+		return true
+	}
+	return false
+}
+
+// Tag returns the tag of the records collected by the rule.
+func Tag(collector *LogCollector) string {
+	return fmt.Sprintf("%s.%s.%s", tagPrefix, collector.ObjectMeta.Namespace, collector.ObjectMeta.Name)
+}
+
+// Render renders the configurations of the outputs of the collection rules
+// into files keyed by file name, which are placed in OutputsDir. The rules
+// failed to render are skipped and reported by their namespaced names.
+func Render(collectors []LogCollector, getSecret SecretGetter) (files map[string][]byte, errs map[string]error) {
+	files = make(map[string][]byte)
+	errs = make(map[string]error)
+	for i := range collectors {
+		collector := &collectors[i]
+		if !Rendered(collector) {
+			continue
+		}
+		r := &renderer{
+			collector: collector,
+			getSecret: getSecret,
+			prefix:    collector.ObjectMeta.Namespace + "_" + collector.ObjectMeta.Name,
+			files:     make(map[string][]byte),
+		}
+		if err := r.render(); err != nil {
+			errs[collector.ObjectMeta.Namespace+"/"+collector.ObjectMeta.Name] = err
+			continue
+		}
+		for name, data := range r.files {
+			files[name] = data
+		}
+	}
+	if len(files) > 0 {
+		files[metricsFile] = metricsConfig()
+	}
+	return files, errs
+}
+
+// recordPath returns the record fields of the placeholder.
+func recordPath(placeholder string) ([]string, error) {
+	if p, ok := placeholders[placeholder]; ok {
+		return p, nil
+	}
+	if strings.HasPrefix(placeholder, "labels.") && len(placeholder) > len("labels.") {
+		return []string{"kubernetes", "labels", strings.TrimPrefix(placeholder, "labels.")}, nil
+	}
+	return nil, fmt.Errorf("unknown placeholder ${%s}, must be one of ${namespace}, ${pod}, ${container}, ${node} or ${labels.<key>}", placeholder)
+}
+
+// rubyTemplate converts the template into a ruby expression of
+// record_transformer which evaluates the placeholders on the record.
+func rubyTemplate(template string) string {
+	var b strings.Builder
+	b.WriteString(`${"`)
+	last := 0
+	for _, loc := range placeholderRegexp.FindAllStringSubmatchIndex(template, -1) {
+		b.WriteString(escapeRuby(template[last:loc[0]]))
+		fields, _ := recordPath(template[loc[2]:loc[3]])
+		b.WriteString("#{" + rubyDig(fields...) + "}")
+		last = loc[1]
+	}
+	b.WriteString(escapeRuby(template[last:]))
+	b.WriteString(`"}`)
+	return b.String()
+}
+
+func escapeRuby(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `#`, `\#`).Replace(s)
+}
+
+// recordAccessor returns the record accessor syntax of the placeholder.
+func recordAccessor(placeholder string) string {
+	fields, _ := recordPath(placeholder)
+	var b strings.Builder
+	b.WriteString("$")
+	for _, f := range fields {
+		b.WriteString("['" + f + "']")
+	}
+	return b.String()
+}
+
+// directive is a section of the fluentd configuration.
+type directive struct {
+	name     string
+	arg      string
+	params   [][2]string
+	children []*directive
+}
+
+func newDirective(name, arg string) *directive {
+	return &directive{name: name, arg: arg}
+}
+
+// set adds a parameter whose value is written as it is.
+func (d *directive) set(key, value string) *directive {
+	d.params = append(d.params, [2]string{key, value})
+	return d
+}
+
+// setQuoted adds a parameter whose value is single quoted, so that it is
+// not interpreted, such as the credentials.
+func (d *directive) setQuoted(key, value string) *directive {
+	return d.set(key, rubyString(value))
+}
+
+func (d *directive) add(child *directive) *directive {
+	d.children = append(d.children, child)
+	return d
+}
+
+func (d *directive) write(b *bytes.Buffer, indent string) {
+	if d.arg != "" {
+		fmt.Fprintf(b, "%s<%s %s>\n", indent, d.name, d.arg)
+	} else {
+		fmt.Fprintf(b, "%s<%s>\n", indent, d.name)
+	}
+	for _, p := range d.params {
+		fmt.Fprintf(b, "%s  %s %s\n", indent, p[0], p[1])
+	}
+	for _, child := range d.children {
+		child.write(b, indent+"  ")
+	}
+	fmt.Fprintf(b, "%s</%s>\n", indent, d.name)
+}
+
+func jsonFormat() *directive {
+	return newDirective("format", "").set("@type", "json")
+}
+
+// renderer renders the configuration of a collection rule.
+type renderer struct {
+	collector *LogCollector
+	getSecret SecretGetter
+	prefix    string
+	files     map[string][]byte
+	// pattern matches the records of the rule in the current section.
+	pattern string
+	// filters go before the match of the output in the section.
+	filters []*directive
+	// directives are the top level directives rendered in order.
+	directives []*directive
+}
+
+func (r *renderer) render() error {
+	tag := Tag(r.collector)
+	r.pattern = tag + " " + tag + ".**"
+
+	if r.collector.Spec.Selector != nil {
+		r.renderSelector(r.collector.Spec.Selector)
+	}
+	r.renderMetrics(r.collector.Spec.RateLimit)
+
+	var label string
+	var labels []*directive
+	if r.collector.Spec.Pipeline != nil {
+		// the logs are selected and limited before they are relabeled.
+		r.directives = append(r.directives, r.filters...)
+		r.filters = nil
+		label, labels = r.renderPipeline(r.collector.Spec.Pipeline)
+	}
+
+	// the id labels the metrics of the output.
+	match := newDirective("match", r.pattern).set("@id", tag)
+	var err error
+	output := &r.collector.Spec.Output
+	switch output.Type {
+	case OutputTypeKafka:
+		err = r.renderKafka(output.KafkaOutput, match)
+	case OutputTypeLoki:
+		err = r.renderLoki(output.LokiOutput, match)
+	case OutputTypeSplunkHEC:
+		err = r.renderSplunkHEC(output.SplunkHECOutput, match)
+	}
+	if err != nil {
+		return err
+	}
+	if r.collector.Spec.Buffer != nil {
+		renderBuffer(r.collector.Spec.Buffer, match)
+	}
+
+	section := append(r.filters, match)
+	if label == "" {
+		r.directives = append(r.directives, section...)
+	} else {
+		r.directives = append(r.directives, labels...)
+		r.directives = append(r.directives, &directive{name: "label", arg: label, children: section})
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# Generated by tke for the log collector %s/%s, DO NOT EDIT.\n", r.collector.ObjectMeta.Namespace, r.collector.ObjectMeta.Name)
+	for _, d := range r.directives {
+		d.write(&b, "")
+	}
+	r.files[r.prefix+".conf"] = b.Bytes()
+	return nil
+}
+
+// secret returns the value of the secret key.
+func (r *renderer) secret(selector *SecretKeySelector) (string, error) {
+	value, err := r.getSecret(r.collector.ObjectMeta.Namespace, selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to get key %s of secret %s: %v", selector.Key, selector.Name, err)
+	}
+	return strings.TrimSpace(string(value)), nil
+}
+
+// secretFile writes the value of the secret key to a file, and returns the
+// path of the file in the log agent.
+func (r *renderer) secretFile(selector *SecretKeySelector, suffix string) (string, error) {
+	value, err := r.getSecret(r.collector.ObjectMeta.Namespace, selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to get key %s of secret %s: %v", selector.Key, selector.Name, err)
+	}
+	name := r.prefix + "-" + suffix
+	r.files[name] = value
+	return path.Join(OutputsDir, name), nil
+}
+
+// tlsFiles writes the certificates of the tls config to files, and returns
+// the paths of ca, cert and key.
+func (r *renderer) tlsFiles(tls *TLSConfig) (ca, cert, key string, err error) {
+	if tls.CA != nil {
+		if ca, err = r.secretFile(tls.CA, "ca.crt"); err != nil {
+			return
+		}
+	}
+	if tls.Cert != nil && tls.Key != nil {
+		if cert, err = r.secretFile(tls.Cert, "tls.crt"); err != nil {
+			return
+		}
+		if key, err = r.secretFile(tls.Key, "tls.key"); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// See: https://github.com/fluent/fluent-plugin-kafka#output-plugin
+func (r *renderer) renderKafka(kafka *KafkaOutput, match *directive) error {
+	brokers := kafka.Brokers
+	if len(brokers) == 0 {
+		brokers = []string{net.JoinHostPort(kafka.Host, fmt.Sprint(kafka.Port))}
+	}
+	match.set("@type", "kafka2").
+		setQuoted("brokers", strings.Join(brokers, ",")).
+		setQuoted("default_topic", kafka.Topic)
+
+	if kafka.PartitionKey != "" {
+		filter := newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("enable_ruby", "true").
+			add(newDirective("record", "").set(partitionKeyField, rubyTemplate(kafka.PartitionKey)))
+		r.filters = append(r.filters, filter)
+		match.set("partition_key_key", partitionKeyField).
+			set("exclude_partition_key", "true")
+	}
+	if kafka.Compression != "" {
+		match.set("compression_codec", kafka.Compression)
+	}
+	if kafka.TLS != nil {
+		ca, cert, key, err := r.tlsFiles(kafka.TLS)
+		if err != nil {
+			return err
+		}
+		if ca != "" {
+			match.set("ssl_ca_cert", ca)
+		}
+		if cert != "" {
+			match.set("ssl_client_cert", cert).set("ssl_client_cert_key", key)
+		}
+		if kafka.TLS.InsecureSkipVerify {
+			match.set("ssl_verify_hostname", "false")
+		}
+	}
+	if kafka.SASL != nil {
+		password, err := r.secret(&kafka.SASL.Password)
+		if err != nil {
+			return err
+		}
+		match.setQuoted("username", kafka.SASL.Username).
+			setQuoted("password", password).
+			set("sasl_over_ssl", fmt.Sprint(kafka.TLS != nil))
+		switch kafka.SASL.Mechanism {
+		case "SCRAM-SHA-256":
+			match.set("scram_mechanism", "sha256")
+		case "SCRAM-SHA-512":
+			match.set("scram_mechanism", "sha512")
+		}
+	}
+	match.add(jsonFormat())
+	return nil
+}
+
+// See: https://grafana.com/docs/loki/latest/clients/fluentd/
+func (r *renderer) renderLoki(loki *LokiOutput, match *directive) error {
+	match.set("@type", "loki").
+		setQuoted("url", loki.URL).
+		set("line_format", "json")
+	if loki.TenantID != "" {
+		match.setQuoted("tenant", loki.TenantID)
+	}
+
+	staticLabels := make(map[string]string)
+	labels := newDirective("label", "")
+	names := make([]string, 0, len(loki.Labels))
+	for name := range loki.Labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value := loki.Labels[name]
+		if m := placeholderRegexp.FindStringSubmatch(value); m != nil {
+			labels.set(name, recordAccessor(m[1]))
+		} else {
+			staticLabels[name] = value
+		}
+	}
+	if len(staticLabels) > 0 {
+		extra, err := json.Marshal(staticLabels)
+		if err != nil {
+			return err
+		}
+		match.setQuoted("extra_labels", string(extra))
+	}
+
+	if loki.BasicAuth != nil {
+		password, err := r.secret(&loki.BasicAuth.Password)
+		if err != nil {
+			return err
+		}
+		match.setQuoted("username", loki.BasicAuth.Username).
+			setQuoted("password", password)
+	}
+	if loki.TLS != nil {
+		ca, cert, key, err := r.tlsFiles(loki.TLS)
+		if err != nil {
+			return err
+		}
+		if ca != "" {
+			match.set("ca_cert", ca)
+		}
+		if cert != "" {
+			match.set("cert", cert).set("key", key)
+		}
+		if loki.TLS.InsecureSkipVerify {
+			match.set("insecure_tls", "true")
+		}
+	}
+	if len(labels.params) > 0 {
+		match.add(labels)
+	}
+	return nil
+}
+
+// See: https://github.com/splunk/fluent-plugin-splunk-hec
+func (r *renderer) renderSplunkHEC(splunk *SplunkHECOutput, match *directive) error {
+	u, err := url.Parse(splunk.URL)
+	if err != nil {
+		return err
+	}
+	port := u.Port()
+	if port == "" {
+		port = "8088"
+	}
+	token, err := r.secret(&splunk.Token)
+	if err != nil {
+		return err
+	}
+	match.set("@type", "splunk_hec").
+		set("protocol", u.Scheme).
+		setQuoted("hec_host", u.Hostname()).
+		set("hec_port", port).
+		setQuoted("hec_token", token)
+	if splunk.Index != "" {
+		match.setQuoted("index", splunk.Index)
+	}
+	if splunk.Source != "" {
+		match.setQuoted("source", splunk.Source)
+	}
+	if splunk.SourceType != "" {
+		match.setQuoted("sourcetype", splunk.SourceType)
+	}
+	if splunk.TLS != nil {
+		ca, cert, key, err := r.tlsFiles(splunk.TLS)
+		if err != nil {
+			return err
+		}
+		if ca != "" {
+			match.set("ca_file", ca)
+		}
+		if cert != "" {
+			match.set("client_cert", cert).set("client_key", key)
+		}
+		if splunk.TLS.InsecureSkipVerify {
+			match.set("insecure_ssl", "true")
+		}
+	}
+	match.add(jsonFormat())
+	return nil
+}
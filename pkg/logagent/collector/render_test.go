@@ -0,0 +1,501 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func fakeSecrets(namespace string, selector *SecretKeySelector) ([]byte, error) {
+	if namespace != "default" || selector.Name != "credentials" {
+		return nil, fmt.Errorf("secret %s/%s not found", namespace, selector.Name)
+	}
+	return []byte(selector.Key + "-value\n"), nil
+}
+
+func newLogCollector(name string, output Output) LogCollector {
+	return LogCollector{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+		Spec:       LogCollectorSpec{Output: output},
+	}
+}
+
+func TestRender(t *testing.T) {
+	collectors := []LogCollector{
+		newLogCollector("cls", Output{Type: OutputTypeCLS}),
+		newLogCollector("kafka", Output{
+			Type: OutputTypeKafka,
+			KafkaOutput: &KafkaOutput{
+				Brokers:      []string{"kafka-0:9093", "kafka-1:9093"},
+				Topic:        "logs",
+				PartitionKey: "${namespace}/${labels.app}",
+				Compression:  "lz4",
+				TLS:          &TLSConfig{CA: &SecretKeySelector{Name: "credentials", Key: "ca"}},
+				SASL: &SASLConfig{
+					Mechanism: "SCRAM-SHA-512",
+					Username:  "tke",
+					Password:  SecretKeySelector{Name: "credentials", Key: "password"},
+				},
+			},
+		}),
+		newLogCollector("loki", Output{
+			Type: OutputTypeLoki,
+			LokiOutput: &LokiOutput{
+				URL:      "https://loki:3100",
+				TenantID: "team-a",
+				Labels:   map[string]string{"cluster": "global", "namespace": "${namespace}", "app": "${labels.app.kubernetes.io/name}"},
+			},
+		}),
+		newLogCollector("splunk", Output{
+			Type: OutputTypeSplunkHEC,
+			SplunkHECOutput: &SplunkHECOutput{
+				URL:        "https://splunk.example.com",
+				Token:      SecretKeySelector{Name: "credentials", Key: "token"},
+				SourceType: "_json",
+				TLS:        &TLSConfig{InsecureSkipVerify: true},
+			},
+		}),
+		newLogCollector("missing", Output{
+			Type: OutputTypeSplunkHEC,
+			SplunkHECOutput: &SplunkHECOutput{
+				URL:   "https://splunk.example.com:8088",
+				Token: SecretKeySelector{Name: "unknown", Key: "token"},
+			},
+		}),
+	}
+
+	files, errs := Render(collectors, fakeSecrets)
+	if len(errs) != 1 || errs["default/missing"] == nil {
+		t.Errorf("expected the error of default/missing only, got %v", errs)
+	}
+	if _, ok := files["default_cls.conf"]; ok {
+		t.Errorf("cls output should not be rendered")
+	}
+	if string(files["default_kafka-ca.crt"]) != "ca-value\n" {
+		t.Errorf("unexpected ca file: %q", files["default_kafka-ca.crt"])
+	}
+
+	tests := []struct {
+		file     string
+		contains []string
+	}{
+		{
+			file: "default_kafka.conf",
+			contains: []string{
+				"<filter logcollector.default.kafka logcollector.default.kafka.**>",
+				`_partition_key ${"#{record.dig('kubernetes', 'namespace_name')}/#{record.dig('kubernetes', 'labels', 'app')}"}`,
+				"<match logcollector.default.kafka logcollector.default.kafka.**>",
+				"@type kafka2",
+				"brokers 'kafka-0:9093,kafka-1:9093'",
+				"partition_key_key _partition_key",
+				"compression_codec lz4",
+				"ssl_ca_cert /etc/td-agent/outputs.d/default_kafka-ca.crt",
+				"password 'password-value'",
+				"sasl_over_ssl true",
+				"scram_mechanism sha512",
+			},
+		},
+		{
+			file: "default_loki.conf",
+			contains: []string{
+				"@type loki",
+				"tenant 'team-a'",
+				`extra_labels '{"cluster":"global"}'`,
+				"app $['kubernetes']['labels']['app.kubernetes.io/name']",
+				"namespace $['kubernetes']['namespace_name']",
+			},
+		},
+		{
+			file: "default_splunk.conf",
+			contains: []string{
+				"@type splunk_hec",
+				"protocol https",
+				"hec_host 'splunk.example.com'",
+				"hec_port 8088",
+				"hec_token 'token-value'",
+				"sourcetype '_json'",
+				"insecure_ssl true",
+			},
+		},
+	}
+	for _, tt := range tests {
+		conf, ok := files[tt.file]
+		if !ok {
+			t.Errorf("%s is not rendered", tt.file)
+			continue
+		}
+		for _, s := range tt.contains {
+			if !strings.Contains(string(conf), s) {
+				t.Errorf("%s does not contain %q:\n%s", tt.file, s, conf)
+			}
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		valid    bool
+	}{
+		{"${namespace}/${pod}", true},
+		{"${node}-${container}", true},
+		{"${labels.app.kubernetes.io/name}", true},
+		{"static", true},
+		{"${labels.}", false},
+		{"${cluster}", false},
+		{"${namespace", false},
+	}
+	for _, tt := range tests {
+		if err := validateTemplate(tt.template); (err == nil) != tt.valid {
+			t.Errorf("validateTemplate(%q) = %v, expected valid %v", tt.template, err, tt.valid)
+		}
+	}
+}
+
+func TestRubyTemplateEscapes(t *testing.T) {
+	got := rubyTemplate(`a"#{b}\${pod}`)
+	expected := `${"a\"\#{b}\\#{record.dig('kubernetes', 'pod_name')}"}`
+	if got != expected {
+		t.Errorf("expected %s, got %s", expected, got)
+	}
+}
+
+func TestRenderPipeline(t *testing.T) {
+	collector := newLogCollector("app", Output{
+		Type:       OutputTypeLoki,
+		LokiOutput: &LokiOutput{URL: "http://loki:3100"},
+	})
+	collector.Spec.Pipeline = &Pipeline{
+		Multiline: &MultilineStage{FirstLine: `^\d{4}-\d{2}-\d{2}`},
+		Stages: []Stage{
+			{Regex: &RegexStage{Expression: `^(?P<time>\S+) (?<level>\w+) (?<message>.*)$`}},
+			{JSON: &JSONStage{Source: "message", KeepSource: true}},
+			{Rename: map[string]string{"lvl": "level"}},
+			{Cast: map[string]string{"status": CastTypeInteger}},
+		},
+	}
+	if allErrs := ValidateLogCollector(&collector); len(allErrs) > 0 {
+		t.Fatalf("unexpected errors: %v", allErrs)
+	}
+
+	files, errs := Render([]LogCollector{collector}, fakeSecrets)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	expected := `<match logcollector.default.app logcollector.default.app.**>
+  @type relabel
+  @label @logcollector.default.app
+</match>
+<label @logcollector.default.app>
+  <filter **>
+    @type concat
+    key log
+    multiline_start_regexp /^\d{4}-\d{2}-\d{2}/
+    flush_interval 5
+    timeout_label @logcollector.default.app.parsed
+  </filter>
+  <match **>
+    @type relabel
+    @label @logcollector.default.app.parsed
+  </match>
+</label>
+<label @logcollector.default.app.parsed>
+  <filter **>
+    @type parser
+    key_name 'log'
+    reserve_data true
+    remove_key_name_field true
+    emit_invalid_record_to_error false
+    <parse>
+      @type regexp
+      expression /^(?<time>\S+) (?<level>\w+) (?<message>.*)$/
+    </parse>
+  </filter>
+  <filter **>
+    @type parser
+    key_name 'message'
+    reserve_data true
+    remove_key_name_field false
+    emit_invalid_record_to_error false
+    <parse>
+      @type json
+    </parse>
+  </filter>
+  <filter **>
+    @type record_transformer
+    enable_ruby true
+    remove_keys lvl
+    <record>
+      level ${record['lvl']}
+    </record>
+  </filter>
+  <filter **>
+    @type record_transformer
+    enable_ruby true
+    <record>
+      status ${record['status'].nil? ? nil : record['status'].to_i}
+    </record>
+  </filter>
+  <match **>
+    @id logcollector.default.app
+    @type loki
+    url 'http://loki:3100'
+    line_format json
+  </match>
+</label>
+`
+	conf := string(files["default_app.conf"])
+	if !strings.HasSuffix(conf, expected) {
+		t.Errorf("unexpected configuration:\n%s", conf)
+	}
+}
+
+func TestValidatePipeline(t *testing.T) {
+	tests := []struct {
+		name     string
+		pipeline Pipeline
+		valid    bool
+	}{
+		{"empty stage", Pipeline{Stages: []Stage{{}}}, false},
+		{"two kinds in a stage", Pipeline{Stages: []Stage{{JSON: &JSONStage{}, Cast: map[string]string{"a": CastTypeFloat}}}}, false},
+		{"unnamed groups", Pipeline{Stages: []Stage{{Regex: &RegexStage{Expression: `^(\w+)$`}}}}, false},
+		{"invalid regexp", Pipeline{Multiline: &MultilineStage{FirstLine: `^(`}}, false},
+		{"unknown cast type", Pipeline{Stages: []Stage{{Cast: map[string]string{"a": "time"}}}}, false},
+		{"invalid field name", Pipeline{Stages: []Stage{{Rename: map[string]string{"a": "b,c"}}}}, false},
+		{"valid", Pipeline{Stages: []Stage{{JSON: &JSONStage{}}, {Rename: map[string]string{"a": "b"}}}}, true},
+	}
+	for _, tt := range tests {
+		collector := newLogCollector("app", Output{Type: OutputTypeLoki, LokiOutput: &LokiOutput{URL: "http://loki:3100"}})
+		collector.Spec.Pipeline = &tt.pipeline
+		if allErrs := ValidateLogCollector(&collector); (len(allErrs) == 0) != tt.valid {
+			t.Errorf("%s: got errors %v, expected valid %v", tt.name, allErrs, tt.valid)
+		}
+	}
+
+	collector := newLogCollector("app", Output{Type: OutputTypeCLS})
+	collector.Spec.Pipeline = &Pipeline{Stages: []Stage{{JSON: &JSONStage{}}}}
+	if allErrs := ValidateLogCollector(&collector); len(allErrs) == 0 {
+		t.Errorf("pipeline should not be supported by cls output")
+	}
+}
+
+func TestRenderSelector(t *testing.T) {
+	collector := newLogCollector("app", Output{
+		Type:       OutputTypeLoki,
+		LokiOutput: &LokiOutput{URL: "http://loki:3100"},
+	})
+	collector.Spec.Selector = &Selector{
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		PodSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpIn, Values: []string{"web", "api"}},
+				{Key: "canary", Operator: metav1.LabelSelectorOpDoesNotExist},
+			},
+		},
+		Containers: []string{"app-*"},
+		Exclusions: []Exclusion{{Namespaces: []string{"kube-system"}}, {Containers: []string{"istio-proxy", "*-sidecar"}}},
+	}
+	if !SetDefaults(&collector) {
+		t.Errorf("input should be defaulted")
+	}
+	if allErrs := ValidateLogCollector(&collector); len(allErrs) > 0 {
+		t.Fatalf("unexpected errors: %v", allErrs)
+	}
+
+	files, errs := Render([]LogCollector{collector}, fakeSecrets)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	expected := `<filter logcollector.default.app logcollector.default.app.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    _selected ${((['web', 'api'].include?(record.dig('kubernetes', 'labels', 'app')) && record.dig('kubernetes', 'labels', 'canary').nil?) && (File.fnmatch?('app-*', record.dig('kubernetes', 'container_name').to_s)) && record.dig('kubernetes', 'namespace_labels', 'team') == 'a' && !['kube-system'].include?(record.dig('kubernetes', 'namespace_name')) && !(File.fnmatch?('istio-proxy', record.dig('kubernetes', 'container_name').to_s) || File.fnmatch?('*-sidecar', record.dig('kubernetes', 'container_name').to_s)))}
+  </record>
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type grep
+  <regexp>
+    key _selected
+    pattern /^true$/
+  </regexp>
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type record_transformer
+  remove_keys _selected
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    _bytes ${record['log'].to_s.bytesize}
+`
+	if conf := string(files["default_app.conf"]); !strings.Contains(conf, expected) {
+		t.Errorf("unexpected configuration:\n%s", conf)
+	}
+}
+
+func TestValidateSelector(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector Selector
+		input    map[string]interface{}
+		valid    bool
+	}{
+		{"all containers", Selector{}, nil, true},
+		{"explicit input", Selector{}, map[string]interface{}{"type": "pod-log"}, false},
+		{"invalid namespace", Selector{Namespaces: []string{"Default"}}, nil, false},
+		{"invalid glob", Selector{Containers: []string{"app-["}}, nil, false},
+		{"empty exclusion", Selector{Exclusions: []Exclusion{{}}}, nil, false},
+		{"invalid pod selector", Selector{PodSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: metav1.LabelSelectorOpIn}},
+		}}, nil, false},
+	}
+	for _, tt := range tests {
+		collector := newLogCollector("app", Output{Type: OutputTypeLoki, LokiOutput: &LokiOutput{URL: "http://loki:3100"}})
+		collector.Spec.Selector = &tt.selector
+		collector.Spec.Input = tt.input
+		SetDefaults(&collector)
+		if allErrs := ValidateLogCollector(&collector); (len(allErrs) == 0) != tt.valid {
+			t.Errorf("%s: got errors %v, expected valid %v", tt.name, allErrs, tt.valid)
+		}
+	}
+}
+
+func TestRenderMetrics(t *testing.T) {
+	collector := newLogCollector("app", Output{
+		Type:       OutputTypeLoki,
+		LokiOutput: &LokiOutput{URL: "http://loki:3100"},
+	})
+	collector.Spec.RateLimit = &RateLimit{LinesPerSecond: 100}
+	collector.Spec.Buffer = &Buffer{TotalLimitSize: "64m", ChunkLimitSize: "8m", FlushIntervalSeconds: 5, OverflowAction: OverflowActionDropOldestChunk}
+	if allErrs := ValidateLogCollector(&collector); len(allErrs) > 0 {
+		t.Fatalf("unexpected errors: %v", allErrs)
+	}
+
+	files, errs := Render([]LogCollector{collector}, fakeSecrets)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if metrics := string(files[metricsFile]); !strings.Contains(metrics, "port 24231") || !strings.Contains(metrics, "@type prometheus_output_monitor") {
+		t.Errorf("unexpected metrics configuration:\n%s", metrics)
+	}
+	expected := `<filter logcollector.default.app logcollector.default.app.**>
+  @type record_transformer
+  enable_ruby true
+  <record>
+    _bytes ${record['log'].to_s.bytesize}
+  </record>
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type prometheus
+  <metric>
+    name logcollector_lines_total
+    type counter
+    desc The total number of lines collected by the rule.
+  </metric>
+  <metric>
+    name logcollector_bytes_total
+    type counter
+    desc The total bytes of lines collected by the rule.
+    key _bytes
+  </metric>
+  <labels>
+    logcollector_namespace default
+    logcollector app
+  </labels>
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type throttle
+  group_key kubernetes.namespace_name,kubernetes.pod_name,kubernetes.container_name
+  group_bucket_period_s 10
+  group_bucket_limit 1000
+  group_drop_logs true
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type prometheus
+  <metric>
+    name logcollector_emitted_lines_total
+    type counter
+    desc The total number of lines passing the rate limit of the rule.
+  </metric>
+  <labels>
+    logcollector_namespace default
+    logcollector app
+  </labels>
+</filter>
+<filter logcollector.default.app logcollector.default.app.**>
+  @type record_transformer
+  remove_keys _bytes
+</filter>
+<match logcollector.default.app logcollector.default.app.**>
+  @id logcollector.default.app
+  @type loki
+  url 'http://loki:3100'
+  line_format json
+  <buffer>
+    total_limit_size 64m
+    chunk_limit_size 8m
+    flush_mode interval
+    flush_interval 5s
+    overflow_action drop_oldest_chunk
+  </buffer>
+</match>
+`
+	if conf := string(files["default_app.conf"]); !strings.HasSuffix(conf, expected) {
+		t.Errorf("unexpected configuration:\n%s", conf)
+	}
+
+	if files, _ := Render([]LogCollector{newLogCollector("cls", Output{Type: OutputTypeCLS})}, fakeSecrets); len(files) != 0 {
+		t.Errorf("metrics should not be rendered without rendered outputs, got %v", files)
+	}
+}
+
+func TestValidateRateLimitAndBuffer(t *testing.T) {
+	tests := []struct {
+		name      string
+		rateLimit *RateLimit
+		buffer    *Buffer
+		valid     bool
+	}{
+		{"zero rate", &RateLimit{}, nil, false},
+		{"invalid size", nil, &Buffer{TotalLimitSize: "64 MiB"}, false},
+		{"negative flush interval", nil, &Buffer{FlushIntervalSeconds: -1}, false},
+		{"unknown overflow action", nil, &Buffer{OverflowAction: "drop"}, false},
+		{"valid", &RateLimit{LinesPerSecond: 1}, &Buffer{TotalLimitSize: "1G", ChunkLimitSize: "512KB"}, true},
+	}
+	for _, tt := range tests {
+		collector := newLogCollector("app", Output{Type: OutputTypeLoki, LokiOutput: &LokiOutput{URL: "http://loki:3100"}})
+		collector.Spec.RateLimit = tt.rateLimit
+		collector.Spec.Buffer = tt.buffer
+		if allErrs := ValidateLogCollector(&collector); (len(allErrs) == 0) != tt.valid {
+			t.Errorf("%s: got errors %v, expected valid %v", tt.name, allErrs, tt.valid)
+		}
+	}
+
+	collector := newLogCollector("app", Output{Type: OutputTypeCLS})
+	collector.Spec.RateLimit = &RateLimit{LinesPerSecond: 1}
+	if allErrs := ValidateLogCollector(&collector); len(allErrs) == 0 {
+		t.Errorf("rate limit should not be supported by cls output")
+	}
+}
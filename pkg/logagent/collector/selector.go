@@ -0,0 +1,164 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// InputTypeContainerLog is the input type collecting the logs of the
+	// containers in the namespaces.
+	InputTypeContainerLog = "container-log"
+	// selectedField is the record field holding whether the record is
+	// selected by the selector.
+	selectedField = "_selected"
+)
+
+// SetDefaults sets the input of the selector based rules to collect the logs
+// of all containers, and reports whether the rule is changed.
+func SetDefaults(collector *LogCollector) bool {
+	if collector.Spec.Selector == nil || len(collector.Spec.Input) > 0 {
+		return false
+	}
+	collector.Spec.Input = map[string]interface{}{
+		"type": InputTypeContainerLog,
+		"container_log_input": map[string]interface{}{
+			"all_namespaces": true,
+		},
+	}
+	return true
+}
+
+// collectsAllContainers reports whether the input collects the logs of all
+// containers.
+func collectsAllContainers(input map[string]interface{}) bool {
+	if input["type"] != InputTypeContainerLog {
+		return false
+	}
+	containerLogInput, ok := input["container_log_input"].(map[string]interface{})
+	return ok && containerLogInput["all_namespaces"] == true
+}
+
+// renderSelector drops the records of the containers not selected.
+func (r *renderer) renderSelector(selector *Selector) {
+	terms := selectorTerms(selector.Namespaces, selector.PodSelector, selector.Containers)
+	if selector.NamespaceSelector != nil {
+		terms = append(terms, labelSelectorExpression(selector.NamespaceSelector, "namespace_labels"))
+	}
+	for i := range selector.Exclusions {
+		exclusion := &selector.Exclusions[i]
+		terms = append(terms, "!"+and(selectorTerms(exclusion.Namespaces, exclusion.PodSelector, exclusion.Containers)))
+	}
+
+	r.directives = append(r.directives,
+		newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("enable_ruby", "true").
+			add(newDirective("record", "").set(selectedField, "${"+and(terms)+"}")),
+		newDirective("filter", r.pattern).
+			set("@type", "grep").
+			add(newDirective("regexp", "").set("key", selectedField).set("pattern", "/^true$/")),
+		newDirective("filter", r.pattern).
+			set("@type", "record_transformer").
+			set("remove_keys", selectedField),
+	)
+}
+
+// selectorTerms returns the ruby expressions matching the containers of the
+// namespaces, pods and container name globs.
+func selectorTerms(namespaces []string, podSelector *metav1.LabelSelector, containers []string) []string {
+	var terms []string
+	if len(namespaces) > 0 {
+		terms = append(terms, fmt.Sprintf("%s.include?(%s)", rubyArray(namespaces), rubyDig("kubernetes", "namespace_name")))
+	}
+	if podSelector != nil {
+		terms = append(terms, labelSelectorExpression(podSelector, "labels"))
+	}
+	if len(containers) > 0 {
+		globs := make([]string, 0, len(containers))
+		for _, container := range containers {
+			globs = append(globs, fmt.Sprintf("File.fnmatch?(%s, %s.to_s)", rubyString(container), rubyDig("kubernetes", "container_name")))
+		}
+		terms = append(terms, "("+strings.Join(globs, " || ")+")")
+	}
+	return terms
+}
+
+// labelSelectorExpression returns the ruby expression matching the labels in
+// the kubernetes metadata field of the record.
+func labelSelectorExpression(selector *metav1.LabelSelector, field string) string {
+	var terms []string
+	keys := make([]string, 0, len(selector.MatchLabels))
+	for key := range selector.MatchLabels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		terms = append(terms, fmt.Sprintf("%s == %s", rubyDig("kubernetes", field, key), rubyString(selector.MatchLabels[key])))
+	}
+	for _, requirement := range selector.MatchExpressions {
+		label := rubyDig("kubernetes", field, requirement.Key)
+		switch requirement.Operator {
+		case metav1.LabelSelectorOpIn:
+			terms = append(terms, fmt.Sprintf("%s.include?(%s)", rubyArray(requirement.Values), label))
+		case metav1.LabelSelectorOpNotIn:
+			terms = append(terms, fmt.Sprintf("!%s.include?(%s)", rubyArray(requirement.Values), label))
+		case metav1.LabelSelectorOpExists:
+			terms = append(terms, fmt.Sprintf("!%s.nil?", label))
+		case metav1.LabelSelectorOpDoesNotExist:
+			terms = append(terms, fmt.Sprintf("%s.nil?", label))
+		}
+	}
+	return and(terms)
+}
+
+func and(terms []string) string {
+	switch len(terms) {
+	case 0:
+		return "true"
+	case 1:
+		return terms[0]
+	}
+	return "(" + strings.Join(terms, " && ") + ")"
+}
+
+func rubyDig(fields ...string) string {
+	quoted := make([]string, 0, len(fields))
+	for _, field := range fields {
+		quoted = append(quoted, rubyString(field))
+	}
+	return "record.dig(" + strings.Join(quoted, ", ") + ")"
+}
+
+func rubyArray(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, rubyString(value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+func rubyString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
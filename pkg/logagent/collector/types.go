@@ -0,0 +1,256 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// Group is the api group of the LogCollector custom resources consumed by
+	// the log agent in the clusters.
+	Group = "tke.cloud.tencent.com"
+	// Version is the api version of the LogCollector custom resources.
+	Version = "v1"
+	// Resource is the resource name of the LogCollector custom resources.
+	Resource = "logcollectors"
+)
+
+// Output types of the collection rules.
+const (
+	OutputTypeCLS           = "cls"
+	OutputTypeCKafka        = "ckafka"
+	OutputTypeKafka         = "kafka"
+	OutputTypeElasticsearch = "elasticsearch"
+	OutputTypeLoki          = "loki"
+	OutputTypeSplunkHEC     = "splunk_hec"
+)
+
+// LogCollector is a collection rule of the log agent, which collects the
+// logs of the input and sends them to the output.
+type LogCollector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              LogCollectorSpec `json:"spec"`
+}
+
+// LogCollectorList is a list of collection rules.
+type LogCollectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LogCollector `json:"items"`
+}
+
+// LogCollectorSpec describes the input and the output of a collection rule.
+// The input is interpreted by the log agent, and is kept as it is.
+type LogCollectorSpec struct {
+	Description string                 `json:"description,omitempty"`
+	Input       map[string]interface{} `json:"input,omitempty"`
+	// Selector selects the containers whose logs are collected dynamically,
+	// the input collects the logs of all containers when it is set. Only the
+	// outputs rendered by tke support it.
+	Selector *Selector `json:"selector,omitempty"`
+	// Pipeline structures the logs before they are sent to the output, only
+	// the outputs rendered by tke support it.
+	Pipeline *Pipeline `json:"pipeline,omitempty"`
+	// RateLimit limits the logs collected from each container, so that a
+	// chatty workload can't starve the log agent of the node. Only the
+	// outputs rendered by tke support it.
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+	// Buffer controls the buffer of the output, only the outputs rendered by
+	// tke support it.
+	Buffer *Buffer `json:"buffer,omitempty"`
+	Output Output  `json:"output"`
+}
+
+// RateLimit drops the logs of a container exceeding the rate.
+type RateLimit struct {
+	// LinesPerSecond is the maximum number of lines collected from a
+	// container per second, averaged over 10 seconds.
+	LinesPerSecond int32 `json:"lines_per_second"`
+}
+
+// Buffer is the buffer holding the logs before they are sent to the output.
+type Buffer struct {
+	// TotalLimitSize is the maximum size of the buffer, such as 64m.
+	TotalLimitSize string `json:"total_limit_size,omitempty"`
+	// ChunkLimitSize is the maximum size of a chunk sent to the output at a
+	// time, such as 8m.
+	ChunkLimitSize string `json:"chunk_limit_size,omitempty"`
+	// FlushIntervalSeconds is the interval of sending the chunks.
+	FlushIntervalSeconds int32 `json:"flush_interval_seconds,omitempty"`
+	// OverflowAction is the behavior when the buffer is full, one of block,
+	// drop_oldest_chunk and throw_exception. Defaults to throw_exception.
+	OverflowAction string `json:"overflow_action,omitempty"`
+}
+
+// Selector selects the containers matching all the fields, and then drops the
+// containers matching any of the exclusions.
+type Selector struct {
+	// Namespaces are the names of the namespaces of the pods.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// NamespaceSelector selects the namespaces of the pods by labels.
+	NamespaceSelector *metav1.LabelSelector `json:"namespace_selector,omitempty"`
+	// PodSelector selects the pods by labels.
+	PodSelector *metav1.LabelSelector `json:"pod_selector,omitempty"`
+	// Containers are the glob patterns of the container names, such as app-*.
+	Containers []string `json:"containers,omitempty"`
+	// Exclusions drop the logs of the noisy containers, such as the system
+	// components.
+	Exclusions []Exclusion `json:"exclusions,omitempty"`
+}
+
+// Exclusion matches the containers matching all the fields, at least one of
+// the fields is set.
+type Exclusion struct {
+	Namespaces  []string              `json:"namespaces,omitempty"`
+	PodSelector *metav1.LabelSelector `json:"pod_selector,omitempty"`
+	Containers  []string              `json:"containers,omitempty"`
+}
+
+// Pipeline joins the lines of multiline logs, and then processes the logs by
+// the stages in order.
+type Pipeline struct {
+	Multiline *MultilineStage `json:"multiline,omitempty"`
+	Stages    []Stage         `json:"stages,omitempty"`
+}
+
+// MultilineStage joins the lines following the first line of a log.
+type MultilineStage struct {
+	// FirstLine is the regular expression matching the first line of a log,
+	// such as ^\d{4}-\d{2}-\d{2}.
+	FirstLine string `json:"first_line"`
+	// FlushIntervalSeconds is the time waiting for the following lines before
+	// the log is sent. Defaults to 5.
+	FlushIntervalSeconds int32 `json:"flush_interval_seconds,omitempty"`
+}
+
+// Stage is a step of the pipeline, exactly one of the fields is set.
+type Stage struct {
+	JSON  *JSONStage  `json:"json,omitempty"`
+	Regex *RegexStage `json:"regex,omitempty"`
+	// Rename maps the fields to their new names.
+	Rename map[string]string `json:"rename,omitempty"`
+	// Cast maps the fields to their types, one of string, integer, float and
+	// boolean.
+	Cast map[string]string `json:"cast,omitempty"`
+}
+
+// JSONStage extracts the fields of the json in the source field.
+type JSONStage struct {
+	// Source is the field to parse. Defaults to log.
+	Source string `json:"source,omitempty"`
+	// KeepSource keeps the source field after it is parsed.
+	KeepSource bool `json:"keep_source,omitempty"`
+}
+
+// RegexStage extracts the named groups of the regular expression matching the
+// source field as fields.
+type RegexStage struct {
+	// Source is the field to parse. Defaults to log.
+	Source string `json:"source,omitempty"`
+	// Expression is the regular expression with named groups, such as
+	// ^(?<level>\w+) (?<message>.*)$.
+	Expression string `json:"expression"`
+	// KeepSource keeps the source field after it is parsed.
+	KeepSource bool `json:"keep_source,omitempty"`
+}
+
+// Output is the consumer of the collected logs, the field of the type is
+// set.
+type Output struct {
+	Type                string                 `json:"type"`
+	CLSOutput           map[string]interface{} `json:"cls_output,omitempty"`
+	CKafkaOutput        map[string]interface{} `json:"ckafka_output,omitempty"`
+	KafkaOutput         *KafkaOutput           `json:"kafka_output,omitempty"`
+	ElasticsearchOutput map[string]interface{} `json:"elasticsearch_output,omitempty"`
+	LokiOutput          *LokiOutput            `json:"loki_output,omitempty"`
+	SplunkHECOutput     *SplunkHECOutput       `json:"splunk_hec_output,omitempty"`
+}
+
+// KafkaOutput sends the logs to the topic of a kafka cluster.
+type KafkaOutput struct {
+	// Host and Port address a single broker, Brokers takes precedence.
+	Host string `json:"host,omitempty"`
+	Port int32  `json:"port,omitempty"`
+	// Brokers are the addresses of the brokers in the form of host:port.
+	Brokers []string `json:"brokers,omitempty"`
+	Topic   string   `json:"topic"`
+	// PartitionKey is the template of the partition key, such as
+	// ${namespace}/${pod}, the logs of the same key go to the same partition.
+	PartitionKey string `json:"partition_key,omitempty"`
+	// Compression is one of gzip, snappy, lz4 and zstd.
+	Compression string      `json:"compression,omitempty"`
+	TLS         *TLSConfig  `json:"tls,omitempty"`
+	SASL        *SASLConfig `json:"sasl,omitempty"`
+}
+
+// LokiOutput pushes the logs to a Grafana Loki server.
+type LokiOutput struct {
+	URL string `json:"url"`
+	// TenantID is the X-Scope-OrgID of the multi-tenant loki.
+	TenantID string `json:"tenant_id,omitempty"`
+	// Labels are the labels of the log streams, the value is either static
+	// or a single placeholder such as ${namespace}.
+	Labels    map[string]string `json:"labels,omitempty"`
+	BasicAuth *BasicAuth        `json:"basic_auth,omitempty"`
+	TLS       *TLSConfig        `json:"tls,omitempty"`
+}
+
+// SplunkHECOutput sends the logs to the HTTP Event Collector of splunk.
+type SplunkHECOutput struct {
+	// URL is the address of the collector, such as https://splunk:8088.
+	URL        string            `json:"url"`
+	Token      SecretKeySelector `json:"token"`
+	Index      string            `json:"index,omitempty"`
+	Source     string            `json:"source,omitempty"`
+	SourceType string            `json:"sourcetype,omitempty"`
+	TLS        *TLSConfig        `json:"tls,omitempty"`
+}
+
+// SecretKeySelector selects a key of a secret in the namespace of the
+// collection rule.
+type SecretKeySelector struct {
+	Name string `json:"name"`
+	Key  string `json:"key"`
+}
+
+// TLSConfig is the tls settings of the connections to the output.
+type TLSConfig struct {
+	// CA verifies the certificate of the output.
+	CA *SecretKeySelector `json:"ca,omitempty"`
+	// Cert and Key are the client certificate for mutual tls.
+	Cert               *SecretKeySelector `json:"cert,omitempty"`
+	Key                *SecretKeySelector `json:"key,omitempty"`
+	InsecureSkipVerify bool               `json:"insecure_skip_verify,omitempty"`
+}
+
+// SASLConfig is the sasl authentication of kafka.
+type SASLConfig struct {
+	// Mechanism is one of PLAIN, SCRAM-SHA-256 and SCRAM-SHA-512.
+	Mechanism string            `json:"mechanism"`
+	Username  string            `json:"username"`
+	Password  SecretKeySelector `json:"password"`
+}
+
+// BasicAuth is the http basic authentication.
+type BasicAuth struct {
+	Username string            `json:"username"`
+	Password SecretKeySelector `json:"password"`
+}
@@ -0,0 +1,385 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package collector
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	supportedOutputTypes = sets.NewString(OutputTypeCLS, OutputTypeCKafka, OutputTypeKafka, OutputTypeElasticsearch, OutputTypeLoki, OutputTypeSplunkHEC)
+	kafkaCompressions    = sets.NewString("gzip", "snappy", "lz4", "zstd")
+	saslMechanisms       = sets.NewString("PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512")
+	castTypes            = sets.NewString(CastTypeString, CastTypeInteger, CastTypeFloat, CastTypeBoolean)
+	overflowActions      = sets.NewString(OverflowActionBlock, OverflowActionDropOldestChunk, OverflowActionThrowException)
+
+	lokiLabelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	fieldNameRegexp     = regexp.MustCompile(`^[a-zA-Z_@][a-zA-Z0-9_.@-]*$`)
+	sizeRegexp          = regexp.MustCompile(`^(?i)[0-9]+[kmgt]?b?$`)
+)
+
+// ValidateLogCollector tests if the output of the collection rule is valid.
+func ValidateLogCollector(collector *LogCollector) field.ErrorList {
+	var allErrs field.ErrorList
+
+	fldPath := field.NewPath("spec", "output")
+	output := &collector.Spec.Output
+	if !supportedOutputTypes.Has(output.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), output.Type, supportedOutputTypes.List()))
+		return allErrs
+	}
+
+	if collector.Spec.Selector != nil {
+		if !Rendered(collector) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "selector"), "", fmt.Sprintf("is not supported by the %s output", output.Type)))
+		} else {
+			allErrs = append(allErrs, validateSelector(collector.Spec.Selector, field.NewPath("spec", "selector"))...)
+		}
+		if !collectsAllContainers(collector.Spec.Input) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "input"), "", "must collect the logs of all containers when selector is set"))
+		}
+	}
+	if collector.Spec.Pipeline != nil {
+		if !Rendered(collector) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "pipeline"), "", fmt.Sprintf("is not supported by the %s output", output.Type)))
+		} else {
+			allErrs = append(allErrs, validatePipeline(collector.Spec.Pipeline, field.NewPath("spec", "pipeline"))...)
+		}
+	}
+	if collector.Spec.RateLimit != nil {
+		if !Rendered(collector) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rate_limit"), "", fmt.Sprintf("is not supported by the %s output", output.Type)))
+		} else if collector.Spec.RateLimit.LinesPerSecond <= 0 {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rate_limit", "lines_per_second"), collector.Spec.RateLimit.LinesPerSecond, "must be greater than 0"))
+		}
+	}
+	if collector.Spec.Buffer != nil {
+		if !Rendered(collector) {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "buffer"), "", fmt.Sprintf("is not supported by the %s output", output.Type)))
+		} else {
+			allErrs = append(allErrs, validateBuffer(collector.Spec.Buffer, field.NewPath("spec", "buffer"))...)
+		}
+	}
+
+	switch output.Type {
+	case OutputTypeKafka:
+		if output.KafkaOutput == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kafka_output"), "must specify kafka output"))
+		} else {
+			allErrs = append(allErrs, validateKafka(output.KafkaOutput, fldPath.Child("kafka_output"))...)
+		}
+	case OutputTypeLoki:
+		if output.LokiOutput == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("loki_output"), "must specify loki output"))
+		} else {
+			allErrs = append(allErrs, validateLoki(output.LokiOutput, fldPath.Child("loki_output"))...)
+		}
+	case OutputTypeSplunkHEC:
+		if output.SplunkHECOutput == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("splunk_hec_output"), "must specify splunk hec output"))
+		} else {
+			allErrs = append(allErrs, validateSplunkHEC(output.SplunkHECOutput, fldPath.Child("splunk_hec_output"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateKafka(kafka *KafkaOutput, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(kafka.Brokers) == 0 {
+		if kafka.Host == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("brokers"), "must specify brokers or host of kafka"))
+		}
+		if kafka.Port <= 0 || kafka.Port > 65535 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), kafka.Port, "must be a valid port"))
+		}
+	}
+	for i, broker := range kafka.Brokers {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("brokers").Index(i), broker, "must be in the form of host:port"))
+		}
+	}
+	if kafka.Topic == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topic"), "must specify topic of kafka"))
+	}
+	if kafka.PartitionKey != "" {
+		if err := validateTemplate(kafka.PartitionKey); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partition_key"), kafka.PartitionKey, err.Error()))
+		}
+	}
+	if kafka.Compression != "" && !kafkaCompressions.Has(kafka.Compression) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("compression"), kafka.Compression, kafkaCompressions.List()))
+	}
+	if kafka.TLS != nil {
+		allErrs = append(allErrs, validateTLS(kafka.TLS, fldPath.Child("tls"))...)
+	}
+	if kafka.SASL != nil {
+		if !saslMechanisms.Has(kafka.SASL.Mechanism) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sasl", "mechanism"), kafka.SASL.Mechanism, saslMechanisms.List()))
+		}
+		if kafka.SASL.Username == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("sasl", "username"), "must specify username of sasl"))
+		}
+		allErrs = append(allErrs, validateSecretKeySelector(&kafka.SASL.Password, fldPath.Child("sasl", "password"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoki(loki *LokiOutput, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateURL(loki.URL, fldPath.Child("url"))...)
+	for name, value := range loki.Labels {
+		if !lokiLabelNameRegexp.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("labels").Key(name), name, "must be a valid prometheus label name"))
+		}
+		if placeholders := placeholderRegexp.FindAllString(value, -1); len(placeholders) > 0 {
+			if len(placeholders) > 1 || placeholders[0] != value {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("labels").Key(name), value, "must be either static or a single placeholder"))
+			} else if err := validateTemplate(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("labels").Key(name), value, err.Error()))
+			}
+		}
+	}
+	if loki.BasicAuth != nil {
+		if loki.BasicAuth.Username == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("basic_auth", "username"), "must specify username of basic auth"))
+		}
+		allErrs = append(allErrs, validateSecretKeySelector(&loki.BasicAuth.Password, fldPath.Child("basic_auth", "password"))...)
+	}
+	if loki.TLS != nil {
+		allErrs = append(allErrs, validateTLS(loki.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+func validateSplunkHEC(splunk *SplunkHECOutput, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateURL(splunk.URL, fldPath.Child("url"))...)
+	allErrs = append(allErrs, validateSecretKeySelector(&splunk.Token, fldPath.Child("token"))...)
+	if splunk.TLS != nil {
+		allErrs = append(allErrs, validateTLS(splunk.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+func validateSelector(selector *Selector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateSelectorTerms(selector.Namespaces, selector.PodSelector, selector.Containers, fldPath)...)
+	if selector.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(selector.NamespaceSelector, fldPath.Child("namespace_selector"))...)
+	}
+	for i := range selector.Exclusions {
+		exclusion := &selector.Exclusions[i]
+		exclusionPath := fldPath.Child("exclusions").Index(i)
+		if len(exclusion.Namespaces) == 0 && exclusion.PodSelector == nil && len(exclusion.Containers) == 0 {
+			allErrs = append(allErrs, field.Required(exclusionPath, "must specify namespaces, pod selector or containers of exclusion"))
+		}
+		allErrs = append(allErrs, validateSelectorTerms(exclusion.Namespaces, exclusion.PodSelector, exclusion.Containers, exclusionPath)...)
+	}
+
+	return allErrs
+}
+
+func validateSelectorTerms(namespaces []string, podSelector *metav1.LabelSelector, containers []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, namespace := range namespaces {
+		for _, msg := range validation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaces").Index(i), namespace, msg))
+		}
+	}
+	if podSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(podSelector, fldPath.Child("pod_selector"))...)
+	}
+	for i, container := range containers {
+		if _, err := path.Match(container, ""); container == "" || err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("containers").Index(i), container, "must be a valid glob pattern"))
+		}
+	}
+
+	return allErrs
+}
+
+func validatePipeline(pipeline *Pipeline, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if pipeline.Multiline != nil {
+		if pipeline.Multiline.FirstLine == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("multiline", "first_line"), "must specify regular expression of first line"))
+		} else if _, err := regexp.Compile(goRegexp(pipeline.Multiline.FirstLine)); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("multiline", "first_line"), pipeline.Multiline.FirstLine, err.Error()))
+		}
+		if pipeline.Multiline.FlushIntervalSeconds < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("multiline", "flush_interval_seconds"), pipeline.Multiline.FlushIntervalSeconds, "must be greater than or equal to 0"))
+		}
+	}
+
+	for i := range pipeline.Stages {
+		stage := &pipeline.Stages[i]
+		stagePath := fldPath.Child("stages").Index(i)
+		count := 0
+		if stage.JSON != nil {
+			count++
+			allErrs = append(allErrs, validateSource(stage.JSON.Source, stagePath.Child("json", "source"))...)
+		}
+		if stage.Regex != nil {
+			count++
+			allErrs = append(allErrs, validateSource(stage.Regex.Source, stagePath.Child("regex", "source"))...)
+			if stage.Regex.Expression == "" {
+				allErrs = append(allErrs, field.Required(stagePath.Child("regex", "expression"), "must specify regular expression"))
+			} else if re, err := regexp.Compile(goRegexp(stage.Regex.Expression)); err != nil {
+				allErrs = append(allErrs, field.Invalid(stagePath.Child("regex", "expression"), stage.Regex.Expression, err.Error()))
+			} else if strings.Join(re.SubexpNames(), "") == "" {
+				allErrs = append(allErrs, field.Invalid(stagePath.Child("regex", "expression"), stage.Regex.Expression, "must have named groups"))
+			}
+		}
+		if len(stage.Rename) > 0 {
+			count++
+			for from, to := range stage.Rename {
+				allErrs = append(allErrs, validateFieldName(from, stagePath.Child("rename").Key(from))...)
+				allErrs = append(allErrs, validateFieldName(to, stagePath.Child("rename").Key(from))...)
+			}
+		}
+		if len(stage.Cast) > 0 {
+			count++
+			for name, castType := range stage.Cast {
+				allErrs = append(allErrs, validateFieldName(name, stagePath.Child("cast").Key(name))...)
+				if !castTypes.Has(castType) {
+					allErrs = append(allErrs, field.NotSupported(stagePath.Child("cast").Key(name), castType, castTypes.List()))
+				}
+			}
+		}
+		if count != 1 {
+			allErrs = append(allErrs, field.Invalid(stagePath, "", "must specify exactly one of json, regex, rename and cast"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateBuffer(buffer *Buffer, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if buffer.TotalLimitSize != "" && !sizeRegexp.MatchString(buffer.TotalLimitSize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("total_limit_size"), buffer.TotalLimitSize, "must be a size such as 64m"))
+	}
+	if buffer.ChunkLimitSize != "" && !sizeRegexp.MatchString(buffer.ChunkLimitSize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("chunk_limit_size"), buffer.ChunkLimitSize, "must be a size such as 8m"))
+	}
+	if buffer.FlushIntervalSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flush_interval_seconds"), buffer.FlushIntervalSeconds, "must be greater than or equal to 0"))
+	}
+	if buffer.OverflowAction != "" && !overflowActions.Has(buffer.OverflowAction) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("overflow_action"), buffer.OverflowAction, overflowActions.List()))
+	}
+	return allErrs
+}
+
+func validateSource(source string, fldPath *field.Path) field.ErrorList {
+	if source == "" {
+		return nil
+	}
+	return validateFieldName(source, fldPath)
+}
+
+func validateFieldName(name string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if !fieldNameRegexp.MatchString(name) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, "must consist of alphanumeric characters, '_', '.', '@' or '-'"))
+	}
+
+	return allErrs
+}
+
+func validateURL(rawURL string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rawURL == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify url"))
+	} else if u, err := url.Parse(rawURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, rawURL, "must be a valid http or https url"))
+	}
+
+	return allErrs
+}
+
+func validateTLS(tls *TLSConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if tls.CA != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(tls.CA, fldPath.Child("ca"))...)
+	}
+	if (tls.Cert == nil) != (tls.Key == nil) {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify both cert and key of client certificate"))
+	}
+	if tls.Cert != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(tls.Cert, fldPath.Child("cert"))...)
+	}
+	if tls.Key != nil {
+		allErrs = append(allErrs, validateSecretKeySelector(tls.Key, fldPath.Child("key"))...)
+	}
+
+	return allErrs
+}
+
+func validateSecretKeySelector(selector *SecretKeySelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if selector.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "must specify name of secret"))
+	}
+	if selector.Key == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("key"), "must specify key of secret"))
+	}
+
+	return allErrs
+}
+
+// validateTemplate tests if the placeholders of the template are known.
+func validateTemplate(template string) error {
+	for _, match := range placeholderRegexp.FindAllStringSubmatch(template, -1) {
+		if _, err := recordPath(match[1]); err != nil {
+			return err
+		}
+	}
+	if strings.Count(template, "${") != len(placeholderRegexp.FindAllString(template, -1)) {
+		return fmt.Errorf("has unclosed placeholder")
+	}
+	return nil
+}
@@ -156,6 +156,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
 	for i := 0; i < workers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
 	}
+	go wait.Until(c.syncOutputs, outputsSyncPeriod, stopCh)
 
 	<-stopCh
 	return nil
@@ -682,6 +683,7 @@ func (c *Controller) genDaemonSet(version string) *appsv1.DaemonSet {
 			},
 		},
 	}
+	addOutputsVolume(daemon)
 
 	return daemon
 }
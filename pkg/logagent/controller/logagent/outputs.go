@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package logagent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/logagent/v1"
+	"tkestack.io/tke/pkg/logagent/collector"
+	"tkestack.io/tke/pkg/logagent/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// outputsSecretName is the secret holding the rendered outputs of the
+	// collection rules, which is mounted into the log agent.
+	outputsSecretName = "logagent-outputs"
+	outputsVolumeName = "outputs"
+
+	outputsSyncPeriod = 30 * time.Second
+)
+
+// syncOutputs renders the outputs of the collection rules of the running log
+// agents into the secret mounted by the log agents.
+func (c *Controller) syncOutputs() {
+	logAgents, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list log agents", log.Err(err))
+		return
+	}
+	for _, logAgent := range logAgents {
+		if logAgent.Status.Phase != v1.AddonPhaseRunning {
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), outputsSyncPeriod)
+		if err := c.syncClusterOutputs(ctx, logAgent); err != nil {
+			log.Error("Failed to sync outputs of log collectors", log.String("clusterName", logAgent.Spec.ClusterName), log.Err(err))
+		}
+		cancel()
+	}
+}
+
+func (c *Controller) syncClusterOutputs(ctx context.Context, logAgent *v1.LogAgent) error {
+	kubeClient, err := util.GetClusterClient(ctx, logAgent.Spec.ClusterName, c.platformClient)
+	if err != nil {
+		return err
+	}
+
+	body, err := kubeClient.CoreV1().RESTClient().Get().
+		AbsPath("/apis", collector.Group, collector.Version, collector.Resource).
+		DoRaw(ctx)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			// the log agent has not registered the custom resource yet
+			return nil
+		}
+		return err
+	}
+	var collectors collector.LogCollectorList
+	if err := json.Unmarshal(body, &collectors); err != nil {
+		return err
+	}
+
+	files, errs := collector.Render(collectors.Items, func(namespace string, selector *collector.SecretKeySelector) ([]byte, error) {
+		secret, err := kubeClient.CoreV1().Secrets(namespace).Get(ctx, selector.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[selector.Key]
+		if !ok {
+			return nil, fmt.Errorf("key not found")
+		}
+		return value, nil
+	})
+	for name, err := range errs {
+		log.Warn("Failed to render output of log collector", log.String("clusterName", logAgent.Spec.ClusterName), log.String("logCollector", name), log.Err(err))
+	}
+
+	if err := applyOutputsSecret(ctx, kubeClient, files); err != nil {
+		return err
+	}
+	return c.ensureOutputsVolume(ctx, kubeClient)
+}
+
+func applyOutputsSecret(ctx context.Context, kubeClient kubernetes.Interface, files map[string][]byte) error {
+	secrets := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem)
+	secret, err := secrets.Get(ctx, outputsSecretName, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      outputsSecretName,
+				Namespace: metav1.NamespaceSystem,
+				Labels:    map[string]string{"app": controllerName},
+			},
+			Data: files,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if reflect.DeepEqual(secret.Data, files) || (len(secret.Data) == 0 && len(files) == 0) {
+		return nil
+	}
+	secret.Data = files
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// ensureOutputsVolume mounts the outputs secret into the log agents installed
+// before the outputs are supported.
+func (c *Controller) ensureOutputsVolume(ctx context.Context, kubeClient kubernetes.Interface) error {
+	daemonSets := kubeClient.AppsV1().DaemonSets(metav1.NamespaceSystem)
+	daemonSet, err := daemonSets.Get(ctx, daemonSetName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	for _, volume := range daemonSet.Spec.Template.Spec.Volumes {
+		if volume.Name == outputsVolumeName {
+			return nil
+		}
+	}
+	addOutputsVolume(daemonSet)
+	_, err = daemonSets.Update(ctx, daemonSet, metav1.UpdateOptions{})
+	return err
+}
+
+func addOutputsVolume(daemonSet *appsv1.DaemonSet) {
+	podSpec := &daemonSet.Spec.Template.Spec
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: outputsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: outputsSecretName,
+				Optional:   boolPtr(true),
+			},
+		},
+	})
+	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      outputsVolumeName,
+		MountPath: collector.OutputsDir,
+		ReadOnly:  true,
+	})
+}
@@ -19,21 +19,28 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strings"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	netutil "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
 	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	"tkestack.io/tke/api/logagent"
+	"tkestack.io/tke/pkg/logagent/collector"
 	"tkestack.io/tke/pkg/logagent/util"
 
 	"tkestack.io/tke/pkg/util/log"
@@ -110,6 +117,12 @@ func (h *logAgentProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Reques
 		loc.Path = fmt.Sprintf("%s/namespaces/%s/logcollectors/%s", prefix, h.namespace, h.name)
 	}
 
+	if req.Method == http.MethodPost || req.Method == http.MethodPut {
+		if !h.validate(w, req) {
+			return
+		}
+	}
+
 	// WithContext creates a shallow clone of the request with the new context.
 	newReq := req.WithContext(context.Background())
 	newReq.Header = netutil.CloneHeader(req.Header)
@@ -124,3 +137,44 @@ func (h *logAgentProxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Reques
 	reverseProxy.ErrorLog = log.StdErrLogger()
 	reverseProxy.ServeHTTP(w, newReq)
 }
+
+// validate sets the defaults of the collection rule in the request body and
+// tests it, and writes the error to the response if it is invalid.
+func (h *logAgentProxyHandler) validate(w http.ResponseWriter, req *http.Request) bool {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, apierrors.NewBadRequest(err.Error()).Status(), w)
+		return false
+	}
+
+	var logCollector collector.LogCollector
+	if err := json.Unmarshal(body, &logCollector); err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, apierrors.NewBadRequest(err.Error()).Status(), w)
+		return false
+	}
+	if collector.SetDefaults(&logCollector) {
+		// patch the input only, so that the fields unknown to tke are kept
+		var object map[string]interface{}
+		if err := json.Unmarshal(body, &object); err != nil {
+			responsewriters.WriteRawJSON(http.StatusBadRequest, apierrors.NewBadRequest(err.Error()).Status(), w)
+			return false
+		}
+		if spec, ok := object["spec"].(map[string]interface{}); ok {
+			spec["input"] = logCollector.Spec.Input
+		}
+		if body, err = json.Marshal(object); err != nil {
+			responsewriters.WriteRawJSON(http.StatusInternalServerError, apierrors.NewInternalError(err).Status(), w)
+			return false
+		}
+		req.ContentLength = int64(len(body))
+		req.Header.Del("Content-Length")
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if allErrs := collector.ValidateLogCollector(&logCollector); len(allErrs) > 0 {
+		gk := schema.GroupKind{Group: collector.Group, Kind: "LogCollector"}
+		responsewriters.WriteRawJSON(http.StatusUnprocessableEntity, apierrors.NewInvalid(gk, logCollector.ObjectMeta.Name, allErrs).Status(), w)
+		return false
+	}
+	return true
+}
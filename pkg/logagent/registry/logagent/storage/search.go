@@ -0,0 +1,197 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/api/logagent"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/apiserver/filter"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/logagent/search"
+	"tkestack.io/tke/pkg/logagent/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// searchSecretName is the secret in the kube-system namespace of the cluster
+// configuring the backend storing the logs.
+const searchSecretName = "logagent-search"
+
+// SearchREST implements searching the logs of the cluster in the backend
+// through logagent api, so the console doesn't need the credentials of the
+// backend.
+type SearchREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platformversionedclient.PlatformV1Interface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *SearchREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *SearchREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &logagent.LogAgentProxyOptions{}, false, ""
+}
+
+// Connect returns a handler which streams the logs matching the query
+// parameters namespace, pod, container, labelSelector, keyword, startTime,
+// endTime and limit as json lines from the latest.
+func (r *SearchREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	logAgent := obj.(*logagent.LogAgent)
+
+	kubeClient, err := util.GetClusterClient(ctx, logAgent.Spec.ClusterName, r.platformClient)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return &searchHandler{
+		clusterName: logAgent.Spec.ClusterName,
+		kubeClient:  kubeClient,
+	}, nil
+}
+
+// New creates a new LogCollector proxy options object
+func (r *SearchREST) New() runtime.Object {
+	return &logagent.LogAgentProxyOptions{}
+}
+
+type searchHandler struct {
+	clusterName string
+	kubeClient  kubernetes.Interface
+}
+
+func (h *searchHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	query, err := search.ParseQuery(req.URL.Query(), time.Now())
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()), w)
+		return
+	}
+	if query.Namespaces, err = h.scopeNamespaces(ctx, query.Namespaces); err != nil {
+		responsewriters.WriteRawJSON(int(err.(*errors.StatusError).Status().Code), err, w)
+		return
+	}
+
+	backend, err := h.backend(ctx)
+	if err != nil {
+		responsewriters.WriteRawJSON(int(err.(*errors.StatusError).Status().Code), err, w)
+		return
+	}
+
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	log.Info("Search logs", log.String("user", username), log.String("clusterName", h.clusterName), log.Strings("namespaces", query.Namespaces), log.String("keyword", query.Keyword))
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	encoder := json.NewEncoder(flushWriter{w})
+	if err := backend.Search(ctx, query, func(entry *search.Entry) error {
+		return encoder.Encode(entry)
+	}); err != nil && ctx.Err() == nil {
+		log.Warn("Search logs failed", log.String("clusterName", h.clusterName), log.Err(err))
+		// the status has been sent, the error is reported as the last line
+		_ = encoder.Encode(map[string]string{"error": err.Error()})
+	}
+}
+
+// scopeNamespaces limits the search in a project to the namespaces belonging
+// to the project, and forbids the namespaces of other projects.
+func (h *searchHandler) scopeNamespaces(ctx context.Context, namespaces []string) ([]string, error) {
+	project := filter.GetValueFromGroups(authentication.Groups(ctx), "project")
+	if project == "" {
+		return namespaces, nil
+	}
+	list, err := h.kubeClient.CoreV1().Namespaces().List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", businessutil.LabelProjectName, project),
+	})
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	owned := make(map[string]bool, len(list.Items))
+	var all []string
+	for _, ns := range list.Items {
+		owned[ns.Name] = true
+		all = append(all, ns.Name)
+	}
+	if len(all) == 0 {
+		return nil, errors.NewForbidden(corev1.Resource("namespaces"), "", fmt.Errorf("project %s has no namespace in cluster %s", project, h.clusterName))
+	}
+	if len(namespaces) == 0 {
+		return all, nil
+	}
+	for _, namespace := range namespaces {
+		if !owned[namespace] {
+			return nil, errors.NewForbidden(corev1.Resource("namespaces"), namespace, fmt.Errorf("namespace does not belong to project %s", project))
+		}
+	}
+	return namespaces, nil
+}
+
+// backend creates the backend configured by the secret in the cluster.
+func (h *searchHandler) backend(ctx context.Context) (search.Backend, error) {
+	secret, err := h.kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, searchSecretName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewBadRequest(fmt.Sprintf("log search backend of cluster %s is not configured by secret %s/%s", h.clusterName, metav1.NamespaceSystem, searchSecretName))
+		}
+		return nil, errors.NewInternalError(err)
+	}
+	config, err := search.ConfigFromSecret(secret.Data)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	backend, err := search.NewBackend(config)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	return backend, nil
+}
+
+// flushWriter flushes the logs to client after each write.
+type flushWriter struct {
+	w http.ResponseWriter
+}
+
+func (fw flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
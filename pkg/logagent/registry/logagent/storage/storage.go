@@ -44,6 +44,7 @@ type Storage struct {
 	LogESDetection *ESDetectionREST
 	LogagentProxy  *LogagentProxyREST
 	LogfileProxy   *LogfileProxyREST
+	LogSearch      *SearchREST
 	Status         *StatusREST
 }
 
@@ -83,6 +84,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 		LogESDetection: &ESDetectionREST{store, platformClient},
 		LogagentProxy:  &LogagentProxyREST{store, platformClient},
 		LogfileProxy:   &LogfileProxyREST{store, platformClient},
+		LogSearch:      &SearchREST{store: store, platformClient: platformClient},
 		Status:         &StatusREST{&statusStore},
 	}
 
@@ -70,6 +70,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["logagents/logcollector"] = logagentRest.LogagentProxy
 		storageMap["logagents/filedownload"] = logagentRest.LogfileProxy
 		storageMap["logagents/esdetection"] = logagentRest.LogESDetection
+		storageMap["logagents/search"] = logagentRest.LogSearch
 		configMapREST := configmapstorage.NewStorage(restOptionsGetter)
 		storageMap["configmaps"] = configMapREST.ConfigMap
 	}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// elasticsearch searches the logs sent by the fluentd elasticsearch output
+// in logstash format.
+type elasticsearch struct {
+	config *Config
+	client *http.Client
+}
+
+type esSearchResponse struct {
+	Hits struct {
+		Hits []struct {
+			Source record        `json:"_source"`
+			Sort   []interface{} `json:"sort"`
+		} `json:"hits"`
+	} `json:"hits"`
+}
+
+func (e *elasticsearch) Search(ctx context.Context, query *Query, emit func(*Entry) error) error {
+	var searchAfter []interface{}
+	for remaining := query.Limit; remaining > 0; {
+		size := remaining
+		if size > pageSize {
+			size = pageSize
+		}
+		resp, err := e.search(ctx, esSearchBody(query, size, searchAfter))
+		if err != nil {
+			return err
+		}
+		for _, hit := range resp.Hits.Hits {
+			t, err := time.Parse(time.RFC3339Nano, hit.Source.Timestamp)
+			if err != nil {
+				return fmt.Errorf("invalid @timestamp %q of log: %v", hit.Source.Timestamp, err)
+			}
+			if err := emit(hit.Source.entry(t)); err != nil {
+				return err
+			}
+			searchAfter = hit.Sort
+		}
+		if len(resp.Hits.Hits) < size {
+			return nil
+		}
+		remaining -= len(resp.Hits.Hits)
+	}
+	return nil
+}
+
+func (e *elasticsearch) search(ctx context.Context, body map[string]interface{}) (*esSearchResponse, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	url := strings.TrimSuffix(e.config.URL, "/") + "/" + e.config.Index + "/_search"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if e.config.Username != "" {
+		req.SetBasicAuth(e.config.Username, e.config.Password)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("elasticsearch responded %d: %s", resp.StatusCode, msg)
+	}
+	var result esSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// esSearchBody builds the search request of the query, the logs are sorted
+// from the latest and paged by search_after.
+func esSearchBody(query *Query, size int, searchAfter []interface{}) map[string]interface{} {
+	filters := []interface{}{
+		map[string]interface{}{
+			"range": map[string]interface{}{
+				"@timestamp": map[string]interface{}{
+					"gte":    query.Start.UTC().Format(time.RFC3339Nano),
+					"lte":    query.End.UTC().Format(time.RFC3339Nano),
+					"format": "strict_date_optional_time",
+				},
+			},
+		},
+	}
+	if len(query.Namespaces) > 0 {
+		namespaces := make([]interface{}, 0, len(query.Namespaces))
+		for _, namespace := range query.Namespaces {
+			namespaces = append(namespaces, matchPhrase("kubernetes.namespace_name", namespace))
+		}
+		filters = append(filters, map[string]interface{}{
+			"bool": map[string]interface{}{
+				"should":               namespaces,
+				"minimum_should_match": 1,
+			},
+		})
+	}
+	if query.Pod != "" {
+		filters = append(filters, matchPhrase("kubernetes.pod_name", query.Pod))
+	}
+	if query.Container != "" {
+		filters = append(filters, matchPhrase("kubernetes.container_name", query.Container))
+	}
+	for _, key := range sortedKeys(query.Labels) {
+		filters = append(filters, matchPhrase("kubernetes.labels."+key, query.Labels[key]))
+	}
+	if query.Keyword != "" {
+		filters = append(filters, matchPhrase("log", query.Keyword))
+	}
+
+	body := map[string]interface{}{
+		"size": size,
+		"sort": []interface{}{
+			map[string]interface{}{"@timestamp": map[string]interface{}{"order": "desc"}},
+		},
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{"filter": filters},
+		},
+	}
+	if len(searchAfter) > 0 {
+		body["search_after"] = searchAfter
+	}
+	return body
+}
+
+func matchPhrase(field, value string) map[string]interface{} {
+	return map[string]interface{}{
+		"match_phrase": map[string]interface{}{field: value},
+	}
+}
@@ -0,0 +1,199 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// invalidLabelCharRegexp matches the characters replaced by the json parser
+// of loki in the extracted labels.
+var invalidLabelCharRegexp = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+// loki searches the logs sent by the loki output, which has the namespace,
+// pod and container labels and the lines in json format.
+type loki struct {
+	config *Config
+	client *http.Client
+}
+
+type lokiQueryResponse struct {
+	Data struct {
+		Result []struct {
+			Stream map[string]string `json:"stream"`
+			Values [][2]string       `json:"values"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+func (l *loki) Search(ctx context.Context, query *Query, emit func(*Entry) error) error {
+	logQL := lokiQuery(query)
+	// end is exclusive
+	end := query.End.Add(time.Nanosecond)
+	for remaining := query.Limit; remaining > 0; {
+		size := remaining
+		if size > pageSize {
+			size = pageSize
+		}
+		entries, err := l.queryRange(ctx, logQL, query.Start, end, size)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := emit(entry); err != nil {
+				return err
+			}
+		}
+		if len(entries) < size {
+			return nil
+		}
+		remaining -= len(entries)
+		end = entries[len(entries)-1].Time
+	}
+	return nil
+}
+
+// queryRange returns the logs of the range from the latest.
+func (l *loki) queryRange(ctx context.Context, logQL string, start, end time.Time, limit int) ([]*Entry, error) {
+	params := url.Values{}
+	params.Set("query", logQL)
+	params.Set("start", strconv.FormatInt(start.UnixNano(), 10))
+	params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+	params.Set("limit", strconv.Itoa(limit))
+	params.Set("direction", "backward")
+	u := strings.TrimSuffix(l.config.URL, "/") + "/loki/api/v1/query_range?" + params.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if l.config.TenantID != "" {
+		req.Header.Set("X-Scope-OrgID", l.config.TenantID)
+	}
+	if l.config.Username != "" {
+		req.SetBasicAuth(l.config.Username, l.config.Password)
+	}
+	resp, err := l.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("loki responded %d: %s", resp.StatusCode, msg)
+	}
+	var result lokiQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, stream := range result.Data.Result {
+		for _, value := range stream.Values {
+			ns, err := strconv.ParseInt(value[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid timestamp %q of log: %v", value[0], err)
+			}
+			entries = append(entries, lokiEntry(time.Unix(0, ns), stream.Stream, value[1]))
+		}
+	}
+	// the streams are merged from the latest
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+// lokiEntry converts the line into a log, the line is either the record in
+// json format or the log itself.
+func lokiEntry(t time.Time, stream map[string]string, line string) *Entry {
+	var r record
+	if err := json.Unmarshal([]byte(line), &r); err != nil || (r.Log == "" && r.Message == "") {
+		return &Entry{
+			Time:      t,
+			Namespace: stream["namespace"],
+			Pod:       stream["pod"],
+			Container: stream["container"],
+			Log:       line,
+		}
+	}
+	entry := r.entry(t)
+	if entry.Namespace == "" {
+		entry.Namespace = stream["namespace"]
+	}
+	if entry.Pod == "" {
+		entry.Pod = stream["pod"]
+	}
+	if entry.Container == "" {
+		entry.Container = stream["container"]
+	}
+	return entry
+}
+
+// lokiQuery builds the LogQL of the query.
+func lokiQuery(query *Query) string {
+	matchers := []string{`namespace=~".+"`}
+	if len(query.Namespaces) > 0 {
+		namespaces := make([]string, 0, len(query.Namespaces))
+		for _, namespace := range query.Namespaces {
+			namespaces = append(namespaces, regexp.QuoteMeta(namespace))
+		}
+		matchers[0] = "namespace=~" + strconv.Quote(strings.Join(namespaces, "|"))
+	}
+	if query.Pod != "" {
+		matchers = append(matchers, "pod="+strconv.Quote(query.Pod))
+	}
+	if query.Container != "" {
+		matchers = append(matchers, "container="+strconv.Quote(query.Container))
+	}
+
+	logQL := "{" + strings.Join(matchers, ", ") + "}"
+	if query.Keyword != "" {
+		logQL += " |= " + strconv.Quote(query.Keyword)
+	}
+	if len(query.Labels) > 0 {
+		logQL += " | json"
+		for _, key := range sortedKeys(query.Labels) {
+			label := "kubernetes_labels_" + invalidLabelCharRegexp.ReplaceAllString(key, "_")
+			logQL += " | " + label + "=" + strconv.Quote(query.Labels[key])
+		}
+	}
+	return logQL
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
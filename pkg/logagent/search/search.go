@@ -0,0 +1,231 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// BackendTypeElasticsearch searches the logs in elasticsearch.
+	BackendTypeElasticsearch = "elasticsearch"
+	// BackendTypeLoki searches the logs in grafana loki.
+	BackendTypeLoki = "loki"
+
+	// DefaultLimit is the default number of logs returned by a search.
+	DefaultLimit = 100
+	// MaxLimit is the max number of logs returned by a search.
+	MaxLimit = 5000
+	// defaultRange is the time range searched if the start is not specified.
+	defaultRange = time.Hour
+	// defaultIndex is the index pattern of the fluentd elasticsearch output.
+	defaultIndex = "logstash-*"
+	// pageSize is the number of logs fetched from the backend by a request.
+	pageSize = 500
+)
+
+// Config is the backend storing the logs of a cluster.
+type Config struct {
+	Type string
+	URL  string
+	// Index is the index pattern of elasticsearch.
+	Index string
+	// TenantID is the X-Scope-OrgID of the multi-tenant loki.
+	TenantID string
+	Username string
+	Password string
+	// CA verifies the certificate of the backend.
+	CA                 []byte
+	InsecureSkipVerify bool
+}
+
+// ConfigFromSecret parses the backend from the data of a secret with the keys
+// type, url, index, tenant_id, username, password, ca.crt and
+// insecure_skip_verify.
+func ConfigFromSecret(data map[string][]byte) (*Config, error) {
+	config := &Config{
+		Type:     strings.TrimSpace(string(data["type"])),
+		URL:      strings.TrimSpace(string(data["url"])),
+		Index:    strings.TrimSpace(string(data["index"])),
+		TenantID: strings.TrimSpace(string(data["tenant_id"])),
+		Username: strings.TrimSpace(string(data["username"])),
+		Password: strings.TrimSpace(string(data["password"])),
+		CA:       data["ca.crt"],
+	}
+	if config.Type != BackendTypeElasticsearch && config.Type != BackendTypeLoki {
+		return nil, fmt.Errorf("unsupported backend type %q, must be %s or %s", config.Type, BackendTypeElasticsearch, BackendTypeLoki)
+	}
+	if u, err := url.Parse(config.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("invalid backend url %q", config.URL)
+	}
+	if config.Index == "" {
+		config.Index = defaultIndex
+	}
+	if s := strings.TrimSpace(string(data["insecure_skip_verify"])); s != "" {
+		insecure, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid insecure_skip_verify: %v", err)
+		}
+		config.InsecureSkipVerify = insecure
+	}
+	return config, nil
+}
+
+// Query is the conditions of the logs to search.
+type Query struct {
+	// Namespaces limits the logs to the namespaces, all namespaces are
+	// searched if it is empty.
+	Namespaces []string
+	Pod        string
+	Container  string
+	// Labels are the labels of the pods.
+	Labels map[string]string
+	// Keyword is the phrase contained in the logs.
+	Keyword string
+	Start   time.Time
+	End     time.Time
+	Limit   int
+}
+
+// Entry is a log returned by the search.
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Namespace string    `json:"namespace,omitempty"`
+	Pod       string    `json:"pod,omitempty"`
+	Container string    `json:"container,omitempty"`
+	Log       string    `json:"log"`
+}
+
+// Backend searches the logs.
+type Backend interface {
+	// Search emits the logs matching the query from the latest, until the
+	// limit of the query is reached or no more logs are found.
+	Search(ctx context.Context, query *Query, emit func(*Entry) error) error
+}
+
+// NewBackend creates the backend of the config.
+func NewBackend(config *Config) (Backend, error) {
+	client, err := httpClient(config)
+	if err != nil {
+		return nil, err
+	}
+	switch config.Type {
+	case BackendTypeElasticsearch:
+		return &elasticsearch{config: config, client: client}, nil
+	case BackendTypeLoki:
+		return &loki{config: config, client: client}, nil
+	}
+	return nil, fmt.Errorf("unsupported backend type %q", config.Type)
+}
+
+// ParseQuery parses the query from the query parameters namespace (which
+// may be repeated), pod, container, labelSelector (in the form of
+// k1=v1,k2=v2), keyword, startTime, endTime (in RFC3339) and limit.
+func ParseQuery(values url.Values, now time.Time) (*Query, error) {
+	query := &Query{
+		Namespaces: values["namespace"],
+		Pod:        values.Get("pod"),
+		Container:  values.Get("container"),
+		Keyword:    values.Get("keyword"),
+		End:        now,
+		Limit:      DefaultLimit,
+	}
+	if s := values.Get("labelSelector"); s != "" {
+		query.Labels = make(map[string]string)
+		for _, term := range strings.Split(s, ",") {
+			kv := strings.SplitN(strings.TrimSpace(term), "=", 2)
+			if len(kv) != 2 || kv[0] == "" {
+				return nil, fmt.Errorf("invalid labelSelector %q, must be in the form of k1=v1,k2=v2", s)
+			}
+			query.Labels[kv[0]] = kv[1]
+		}
+	}
+	var err error
+	if s := values.Get("endTime"); s != "" {
+		if query.End, err = time.Parse(time.RFC3339, s); err != nil {
+			return nil, fmt.Errorf("invalid endTime: %v", err)
+		}
+	}
+	query.Start = query.End.Add(-defaultRange)
+	if s := values.Get("startTime"); s != "" {
+		if query.Start, err = time.Parse(time.RFC3339, s); err != nil {
+			return nil, fmt.Errorf("invalid startTime: %v", err)
+		}
+	}
+	if !query.Start.Before(query.End) {
+		return nil, fmt.Errorf("startTime must be before endTime")
+	}
+	if s := values.Get("limit"); s != "" {
+		if query.Limit, err = strconv.Atoi(s); err != nil || query.Limit <= 0 || query.Limit > MaxLimit {
+			return nil, fmt.Errorf("limit must be a positive integer no more than %d", MaxLimit)
+		}
+	}
+	return query, nil
+}
+
+func httpClient(config *Config) (*http.Client, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: config.InsecureSkipVerify}
+	if len(config.CA) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(config.CA) {
+			return nil, fmt.Errorf("invalid ca certificate of backend")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport, Timeout: 30 * time.Second}, nil
+}
+
+// metadata is the kubernetes metadata attached to the logs by the log agent.
+type metadata struct {
+	NamespaceName string `json:"namespace_name"`
+	PodName       string `json:"pod_name"`
+	ContainerName string `json:"container_name"`
+}
+
+// record is the log record sent by the log agent.
+type record struct {
+	Timestamp  string   `json:"@timestamp"`
+	Log        string   `json:"log"`
+	Message    string   `json:"message"`
+	Kubernetes metadata `json:"kubernetes"`
+}
+
+func (r *record) entry(t time.Time) *Entry {
+	log := r.Log
+	if log == "" {
+		log = r.Message
+	}
+	return &Entry{
+		Time:      t,
+		Namespace: r.Kubernetes.NamespaceName,
+		Pod:       r.Kubernetes.PodName,
+		Container: r.Kubernetes.ContainerName,
+		Log:       log,
+	}
+}
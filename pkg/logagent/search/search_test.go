@@ -0,0 +1,247 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var now = time.Date(2020, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func TestParseQuery(t *testing.T) {
+	query, err := ParseQuery(url.Values{
+		"namespace":     {"a", "b"},
+		"labelSelector": {"app=web, tier=front"},
+		"startTime":     {"2020-10-01T11:30:00Z"},
+		"limit":         {"10"},
+	}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := &Query{
+		Namespaces: []string{"a", "b"},
+		Labels:     map[string]string{"app": "web", "tier": "front"},
+		Start:      now.Add(-30 * time.Minute),
+		End:        now,
+		Limit:      10,
+	}
+	if !reflect.DeepEqual(query, expected) {
+		t.Errorf("expected %+v, got %+v", expected, query)
+	}
+
+	for _, values := range []url.Values{
+		{"labelSelector": {"app"}},
+		{"startTime": {"2020-10-01T12:30:00Z"}},
+		{"endTime": {"yesterday"}},
+		{"limit": {"0"}},
+		{"limit": {strconv.Itoa(MaxLimit + 1)}},
+	} {
+		if _, err := ParseQuery(values, now); err == nil {
+			t.Errorf("expected error of %v", values)
+		}
+	}
+}
+
+func TestConfigFromSecret(t *testing.T) {
+	config, err := ConfigFromSecret(map[string][]byte{
+		"type": []byte("elasticsearch"),
+		"url":  []byte("https://es:9200\n"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.URL != "https://es:9200" || config.Index != defaultIndex {
+		t.Errorf("unexpected config %+v", config)
+	}
+	if _, err := ConfigFromSecret(map[string][]byte{"type": []byte("influxdb"), "url": []byte("http://influxdb")}); err == nil {
+		t.Errorf("expected error of unsupported type")
+	}
+	if _, err := ConfigFromSecret(map[string][]byte{"type": []byte("loki"), "url": []byte("loki:3100")}); err == nil {
+		t.Errorf("expected error of invalid url")
+	}
+}
+
+func collect(t *testing.T, backend Backend, query *Query) []*Entry {
+	var entries []*Entry
+	if err := backend.Search(context.Background(), query, func(entry *Entry) error {
+		entries = append(entries, entry)
+		return nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestElasticsearch(t *testing.T) {
+	var requests []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/logstash-*/_search" {
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+		if username, password, _ := req.BasicAuth(); username != "elastic" || password != "secret" {
+			t.Errorf("unexpected basic auth %s:%s", username, password)
+		}
+		var body map[string]interface{}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		requests = append(requests, body)
+
+		var hits []interface{}
+		offset := (len(requests) - 1) * pageSize
+		for i := 0; i < int(body["size"].(float64)); i++ {
+			n := offset + i
+			hits = append(hits, map[string]interface{}{
+				"_source": map[string]interface{}{
+					"@timestamp": now.Add(-time.Duration(n) * time.Second).Format(time.RFC3339Nano),
+					"log":        fmt.Sprintf("line %d", n),
+					"kubernetes": map[string]interface{}{"namespace_name": "a", "pod_name": "web-0", "container_name": "web"},
+				},
+				"sort": []interface{}{n},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"hits": map[string]interface{}{"hits": hits}})
+	}))
+	defer server.Close()
+
+	backend, err := NewBackend(&Config{Type: BackendTypeElasticsearch, URL: server.URL, Index: defaultIndex, Username: "elastic", Password: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := &Query{Namespaces: []string{"a"}, Keyword: "line", Start: now.Add(-time.Hour), End: now, Limit: 2*pageSize + 10}
+	entries := collect(t, backend, query)
+	if len(entries) != query.Limit {
+		t.Fatalf("expected %d entries, got %d", query.Limit, len(entries))
+	}
+	if last := entries[len(entries)-1]; last.Log != fmt.Sprintf("line %d", query.Limit-1) || entries[0].Pod != "web-0" || !entries[0].Time.Equal(now) {
+		t.Errorf("unexpected entries %+v %+v", entries[0], last)
+	}
+	if len(requests) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(requests))
+	}
+	if _, ok := requests[0]["search_after"]; ok {
+		t.Errorf("first page should not search after")
+	}
+	if !reflect.DeepEqual(requests[2]["search_after"], []interface{}{float64(2*pageSize - 1)}) || requests[2]["size"] != float64(10) {
+		t.Errorf("unexpected last page %v %v", requests[2]["search_after"], requests[2]["size"])
+	}
+}
+
+func TestElasticsearchLastPage(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests++
+		hits := []interface{}{
+			map[string]interface{}{
+				"_source": map[string]interface{}{"@timestamp": now.Format(time.RFC3339Nano), "message": "line"},
+				"sort":    []interface{}{0},
+			},
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"hits": map[string]interface{}{"hits": hits}})
+	}))
+	defer server.Close()
+
+	backend, _ := NewBackend(&Config{Type: BackendTypeElasticsearch, URL: server.URL, Index: defaultIndex})
+	entries := collect(t, backend, &Query{Start: now.Add(-time.Hour), End: now, Limit: DefaultLimit})
+	if len(entries) != 1 || entries[0].Log != "line" || requests != 1 {
+		t.Errorf("expected 1 entry in 1 request, got %d in %d", len(entries), requests)
+	}
+}
+
+func TestLokiQuery(t *testing.T) {
+	tests := []struct {
+		query    Query
+		expected string
+	}{
+		{Query{}, `{namespace=~".+"}`},
+		{
+			Query{Namespaces: []string{"a", "b.c"}, Pod: "web-0", Container: "web", Keyword: `say "hi"`},
+			`{namespace=~"a|b\\.c", pod="web-0", container="web"} |= "say \"hi\""`,
+		},
+		{
+			Query{Labels: map[string]string{"app.kubernetes.io/name": "web", "tier": "front"}},
+			`{namespace=~".+"} | json | kubernetes_labels_app_kubernetes_io_name="web" | kubernetes_labels_tier="front"`,
+		},
+	}
+	for _, tt := range tests {
+		if got := lokiQuery(&tt.query); got != tt.expected {
+			t.Errorf("expected %s, got %s", tt.expected, got)
+		}
+	}
+}
+
+func TestLoki(t *testing.T) {
+	var ends []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/loki/api/v1/query_range" {
+			t.Errorf("unexpected path %s", req.URL.Path)
+		}
+		if req.Header.Get("X-Scope-OrgID") != "team-a" {
+			t.Errorf("unexpected tenant %s", req.Header.Get("X-Scope-OrgID"))
+		}
+		ends = append(ends, req.URL.Query().Get("end"))
+		ts := func(seconds int) string {
+			return strconv.FormatInt(now.Add(-time.Duration(seconds)*time.Second).UnixNano(), 10)
+		}
+		var result []interface{}
+		if len(ends) == 1 {
+			// the logs of the streams are interleaved
+			result = []interface{}{
+				map[string]interface{}{
+					"stream": map[string]string{"namespace": "a", "pod": "web-0", "container": "web"},
+					"values": [][2]string{{ts(0), `{"log":"line 0","kubernetes":{"namespace_name":"a","pod_name":"web-0","container_name":"web"}}`}, {ts(2), "line 2"}},
+				},
+				map[string]interface{}{
+					"stream": map[string]string{"namespace": "a", "pod": "web-1", "container": "web"},
+					"values": [][2]string{{ts(1), "line 1"}},
+				},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"result": result}})
+	}))
+	defer server.Close()
+
+	backend, err := NewBackend(&Config{Type: BackendTypeLoki, URL: server.URL, TenantID: "team-a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := &Query{Start: now.Add(-time.Hour), End: now, Limit: 3}
+	entries := collect(t, backend, query)
+	if len(entries) != 3 {
+		t.Fatalf("expected 3 entries, got %d", len(entries))
+	}
+	for i, entry := range entries {
+		if entry.Log != fmt.Sprintf("line %d", i) {
+			t.Errorf("unexpected entry %d: %+v", i, entry)
+		}
+	}
+	if entries[1].Pod != "web-1" || entries[0].Namespace != "a" {
+		t.Errorf("unexpected metadata %+v %+v", entries[0], entries[1])
+	}
+	if len(ends) != 1 || ends[0] != strconv.FormatInt(now.UnixNano()+1, 10) {
+		t.Errorf("unexpected requests %v", ends)
+	}
+}
@@ -29,6 +29,10 @@ type MonitorConfiguration struct {
 	metav1.TypeMeta
 
 	Storage Storage
+	// Grafana deploys the built-in grafana to the global cluster, which
+	// provides the dashboards of the clusters and projects.
+	// +optional
+	Grafana *Grafana
 }
 
 type Storage struct {
@@ -42,6 +46,45 @@ type Storage struct {
 
 type ThanosStorage struct {
 	Servers []ThanosStorageServer
+	// ObjectStorage makes the prometheus of each cluster upload metrics to the
+	// object storage, which are compacted and read by the thanos compactor and
+	// store gateway deployed in the cluster.
+	// +optional
+	ObjectStorage *ThanosObjectStorage
+}
+
+// ThanosObjectStorage is the object storage of thanos.
+type ThanosObjectStorage struct {
+	// Type is S3 or COS, MinIO is compatible with S3.
+	Type     string
+	Bucket   string
+	Endpoint string
+	// +optional
+	Region string
+	// AppID is required by COS.
+	// +optional
+	AppID     string
+	AccessKey string
+	SecretKey string
+	// Insecure connects to the endpoint over http.
+	// +optional
+	Insecure bool
+	// +optional
+	Retention ThanosRetention
+	// DisableDownsampling disables the 5m and 1h downsampling of compactor.
+	// +optional
+	DisableDownsampling bool
+}
+
+// ThanosRetention is how long the metrics of each resolution are retained in
+// the object storage, such as 90d, 0d retains forever.
+type ThanosRetention struct {
+	// +optional
+	Raw string
+	// +optional
+	Resolution5m string
+	// +optional
+	Resolution1h string
 }
 
 type ThanosStorageServer struct {
@@ -50,6 +93,37 @@ type ThanosStorageServer struct {
 
 type InfluxDBStorage struct {
 	Servers []InfluxDBStorageServer
+	// Retention enables the retention of the database of each cluster, and
+	// the downsampling of the raw metrics to the 5m and 1h resolutions by
+	// tke-monitor-controller.
+	// +optional
+	Retention *InfluxDBRetention
+}
+
+// InfluxDBRetention is how long the metrics of each resolution are retained
+// in the database of each cluster, such as 7d, INF retains forever.
+type InfluxDBRetention struct {
+	// +optional
+	Raw string
+	// +optional
+	Resolution5m string
+	// +optional
+	Resolution1h string
+	// Clusters overrides the retention of the given clusters.
+	// +optional
+	Clusters []InfluxDBClusterRetention
+}
+
+// InfluxDBClusterRetention is the retention of a cluster, the empty fields
+// fall back to the retention of all clusters.
+type InfluxDBClusterRetention struct {
+	ClusterName string
+	// +optional
+	Raw string
+	// +optional
+	Resolution5m string
+	// +optional
+	Resolution1h string
 }
 
 type InfluxDBStorageServer struct {
@@ -73,3 +147,29 @@ type ElasticSearchStorageServer struct {
 	// +optional
 	Password string
 }
+
+// Grafana is the built-in grafana managed by tke-monitor-controller.
+type Grafana struct {
+	// Namespace of the global cluster where grafana is deployed, default is tke.
+	// +optional
+	Namespace string
+	// RootURL is the external url of grafana, which is used as the redirect url
+	// of the sso.
+	RootURL string
+	// AdminPassword is the password of the grafana admin, which is used by the
+	// controller to sync the organizations, datasources and dashboards.
+	AdminPassword string
+	// OIDC is the oauth client of tke-auth used by grafana to login users.
+	OIDC GrafanaOIDC
+}
+
+// GrafanaOIDC is the oauth client of tke-auth.
+type GrafanaOIDC struct {
+	// IssuerURL is the issuer of tke-auth, such as https://tke-auth-api/oidc.
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// InsecureSkipVerify skips verifying the certificate of tke-auth.
+	// +optional
+	InsecureSkipVerify bool
+}
@@ -25,4 +25,31 @@ func addDefaultingFuncs(scheme *runtime.Scheme) error {
 }
 
 func SetDefaults_MonitorConfiguration(obj *MonitorConfiguration) {
+	if obj.Storage.Thanos != nil && obj.Storage.Thanos.ObjectStorage != nil {
+		retention := &obj.Storage.Thanos.ObjectStorage.Retention
+		if retention.Raw == "" {
+			retention.Raw = "90d"
+		}
+		if retention.Resolution5m == "" {
+			retention.Resolution5m = "180d"
+		}
+		if retention.Resolution1h == "" {
+			retention.Resolution1h = "360d"
+		}
+	}
+	if obj.Storage.InfluxDB != nil && obj.Storage.InfluxDB.Retention != nil {
+		retention := obj.Storage.InfluxDB.Retention
+		if retention.Raw == "" {
+			retention.Raw = "7d"
+		}
+		if retention.Resolution5m == "" {
+			retention.Resolution5m = "30d"
+		}
+		if retention.Resolution1h == "" {
+			retention.Resolution1h = "365d"
+		}
+	}
+	if obj.Grafana != nil && obj.Grafana.Namespace == "" {
+		obj.Grafana.Namespace = "tke"
+	}
 }
@@ -29,6 +29,10 @@ type MonitorConfiguration struct {
 	metav1.TypeMeta
 
 	Storage Storage `json:"storage"`
+	// Grafana deploys the built-in grafana to the global cluster, which
+	// provides the dashboards of the clusters and projects.
+	// +optional
+	Grafana *Grafana `json:"grafana,omitempty"`
 }
 
 type Storage struct {
@@ -42,6 +46,45 @@ type Storage struct {
 
 type ThanosStorage struct {
 	Servers []ThanosStorageServer `json:"servers"`
+	// ObjectStorage makes the prometheus of each cluster upload metrics to the
+	// object storage, which are compacted and read by the thanos compactor and
+	// store gateway deployed in the cluster.
+	// +optional
+	ObjectStorage *ThanosObjectStorage `json:"objectStorage,omitempty"`
+}
+
+// ThanosObjectStorage is the object storage of thanos.
+type ThanosObjectStorage struct {
+	// Type is S3 or COS, MinIO is compatible with S3.
+	Type     string `json:"type"`
+	Bucket   string `json:"bucket"`
+	Endpoint string `json:"endpoint"`
+	// +optional
+	Region string `json:"region,omitempty"`
+	// AppID is required by COS.
+	// +optional
+	AppID     string `json:"appID,omitempty"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+	// Insecure connects to the endpoint over http.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+	// +optional
+	Retention ThanosRetention `json:"retention,omitempty"`
+	// DisableDownsampling disables the 5m and 1h downsampling of compactor.
+	// +optional
+	DisableDownsampling bool `json:"disableDownsampling,omitempty"`
+}
+
+// ThanosRetention is how long the metrics of each resolution are retained in
+// the object storage, such as 90d, 0d retains forever.
+type ThanosRetention struct {
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// +optional
+	Resolution5m string `json:"resolution5m,omitempty"`
+	// +optional
+	Resolution1h string `json:"resolution1h,omitempty"`
 }
 
 type ThanosStorageServer struct {
@@ -50,6 +93,37 @@ type ThanosStorageServer struct {
 
 type InfluxDBStorage struct {
 	Servers []InfluxDBStorageServer `json:"servers"`
+	// Retention enables the retention of the database of each cluster, and
+	// the downsampling of the raw metrics to the 5m and 1h resolutions by
+	// tke-monitor-controller.
+	// +optional
+	Retention *InfluxDBRetention `json:"retention,omitempty"`
+}
+
+// InfluxDBRetention is how long the metrics of each resolution are retained
+// in the database of each cluster, such as 7d, INF retains forever.
+type InfluxDBRetention struct {
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// +optional
+	Resolution5m string `json:"resolution5m,omitempty"`
+	// +optional
+	Resolution1h string `json:"resolution1h,omitempty"`
+	// Clusters overrides the retention of the given clusters.
+	// +optional
+	Clusters []InfluxDBClusterRetention `json:"clusters,omitempty"`
+}
+
+// InfluxDBClusterRetention is the retention of a cluster, the empty fields
+// fall back to the retention of all clusters.
+type InfluxDBClusterRetention struct {
+	ClusterName string `json:"clusterName"`
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// +optional
+	Resolution5m string `json:"resolution5m,omitempty"`
+	// +optional
+	Resolution1h string `json:"resolution1h,omitempty"`
 }
 
 type InfluxDBStorageServer struct {
@@ -73,3 +147,29 @@ type ElasticSearchStorageServer struct {
 	// +optional
 	Password string `json:"password,omitempty"`
 }
+
+// Grafana is the built-in grafana managed by tke-monitor-controller.
+type Grafana struct {
+	// Namespace of the global cluster where grafana is deployed, default is tke.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// RootURL is the external url of grafana, which is used as the redirect url
+	// of the sso.
+	RootURL string `json:"rootURL"`
+	// AdminPassword is the password of the grafana admin, which is used by the
+	// controller to sync the organizations, datasources and dashboards.
+	AdminPassword string `json:"adminPassword"`
+	// OIDC is the oauth client of tke-auth used by grafana to login users.
+	OIDC GrafanaOIDC `json:"oidc"`
+}
+
+// GrafanaOIDC is the oauth client of tke-auth.
+type GrafanaOIDC struct {
+	// IssuerURL is the issuer of tke-auth, such as https://tke-auth-api/oidc.
+	IssuerURL    string `json:"issuerURL"`
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	// InsecureSkipVerify skips verifying the certificate of tke-auth.
+	// +optional
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+}
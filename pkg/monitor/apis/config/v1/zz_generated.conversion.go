@@ -57,6 +57,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Grafana)(nil), (*config.Grafana)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Grafana_To_config_Grafana(a.(*Grafana), b.(*config.Grafana), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Grafana)(nil), (*Grafana)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Grafana_To_v1_Grafana(a.(*config.Grafana), b.(*Grafana), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GrafanaOIDC)(nil), (*config.GrafanaOIDC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GrafanaOIDC_To_config_GrafanaOIDC(a.(*GrafanaOIDC), b.(*config.GrafanaOIDC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GrafanaOIDC)(nil), (*GrafanaOIDC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GrafanaOIDC_To_v1_GrafanaOIDC(a.(*config.GrafanaOIDC), b.(*GrafanaOIDC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InfluxDBClusterRetention)(nil), (*config.InfluxDBClusterRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_InfluxDBClusterRetention_To_config_InfluxDBClusterRetention(a.(*InfluxDBClusterRetention), b.(*config.InfluxDBClusterRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InfluxDBClusterRetention)(nil), (*InfluxDBClusterRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InfluxDBClusterRetention_To_v1_InfluxDBClusterRetention(a.(*config.InfluxDBClusterRetention), b.(*InfluxDBClusterRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InfluxDBRetention)(nil), (*config.InfluxDBRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_InfluxDBRetention_To_config_InfluxDBRetention(a.(*InfluxDBRetention), b.(*config.InfluxDBRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InfluxDBRetention)(nil), (*InfluxDBRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InfluxDBRetention_To_v1_InfluxDBRetention(a.(*config.InfluxDBRetention), b.(*InfluxDBRetention), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InfluxDBStorage)(nil), (*config.InfluxDBStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_InfluxDBStorage_To_config_InfluxDBStorage(a.(*InfluxDBStorage), b.(*config.InfluxDBStorage), scope)
 	}); err != nil {
@@ -97,6 +137,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ThanosObjectStorage)(nil), (*config.ThanosObjectStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ThanosObjectStorage_To_config_ThanosObjectStorage(a.(*ThanosObjectStorage), b.(*config.ThanosObjectStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ThanosObjectStorage)(nil), (*ThanosObjectStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ThanosObjectStorage_To_v1_ThanosObjectStorage(a.(*config.ThanosObjectStorage), b.(*ThanosObjectStorage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ThanosRetention)(nil), (*config.ThanosRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ThanosRetention_To_config_ThanosRetention(a.(*ThanosRetention), b.(*config.ThanosRetention), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ThanosRetention)(nil), (*ThanosRetention)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ThanosRetention_To_v1_ThanosRetention(a.(*config.ThanosRetention), b.(*ThanosRetention), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ThanosStorage)(nil), (*config.ThanosStorage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ThanosStorage_To_config_ThanosStorage(a.(*ThanosStorage), b.(*config.ThanosStorage), scope)
 	}); err != nil {
@@ -164,8 +224,117 @@ func Convert_config_ElasticSearchStorageServer_To_v1_ElasticSearchStorageServer(
 	return autoConvert_config_ElasticSearchStorageServer_To_v1_ElasticSearchStorageServer(in, out, s)
 }
 
+func autoConvert_v1_Grafana_To_config_Grafana(in *Grafana, out *config.Grafana, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.RootURL = in.RootURL
+	out.AdminPassword = in.AdminPassword
+	if err := Convert_v1_GrafanaOIDC_To_config_GrafanaOIDC(&in.OIDC, &out.OIDC, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Grafana_To_config_Grafana is an autogenerated conversion function.
+func Convert_v1_Grafana_To_config_Grafana(in *Grafana, out *config.Grafana, s conversion.Scope) error {
+	return autoConvert_v1_Grafana_To_config_Grafana(in, out, s)
+}
+
+func autoConvert_config_Grafana_To_v1_Grafana(in *config.Grafana, out *Grafana, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.RootURL = in.RootURL
+	out.AdminPassword = in.AdminPassword
+	if err := Convert_config_GrafanaOIDC_To_v1_GrafanaOIDC(&in.OIDC, &out.OIDC, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_Grafana_To_v1_Grafana is an autogenerated conversion function.
+func Convert_config_Grafana_To_v1_Grafana(in *config.Grafana, out *Grafana, s conversion.Scope) error {
+	return autoConvert_config_Grafana_To_v1_Grafana(in, out, s)
+}
+
+func autoConvert_v1_GrafanaOIDC_To_config_GrafanaOIDC(in *GrafanaOIDC, out *config.GrafanaOIDC, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.ClientSecret = in.ClientSecret
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_v1_GrafanaOIDC_To_config_GrafanaOIDC is an autogenerated conversion function.
+func Convert_v1_GrafanaOIDC_To_config_GrafanaOIDC(in *GrafanaOIDC, out *config.GrafanaOIDC, s conversion.Scope) error {
+	return autoConvert_v1_GrafanaOIDC_To_config_GrafanaOIDC(in, out, s)
+}
+
+func autoConvert_config_GrafanaOIDC_To_v1_GrafanaOIDC(in *config.GrafanaOIDC, out *GrafanaOIDC, s conversion.Scope) error {
+	out.IssuerURL = in.IssuerURL
+	out.ClientID = in.ClientID
+	out.ClientSecret = in.ClientSecret
+	out.InsecureSkipVerify = in.InsecureSkipVerify
+	return nil
+}
+
+// Convert_config_GrafanaOIDC_To_v1_GrafanaOIDC is an autogenerated conversion function.
+func Convert_config_GrafanaOIDC_To_v1_GrafanaOIDC(in *config.GrafanaOIDC, out *GrafanaOIDC, s conversion.Scope) error {
+	return autoConvert_config_GrafanaOIDC_To_v1_GrafanaOIDC(in, out, s)
+}
+
+func autoConvert_v1_InfluxDBClusterRetention_To_config_InfluxDBClusterRetention(in *InfluxDBClusterRetention, out *config.InfluxDBClusterRetention, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	return nil
+}
+
+// Convert_v1_InfluxDBClusterRetention_To_config_InfluxDBClusterRetention is an autogenerated conversion function.
+func Convert_v1_InfluxDBClusterRetention_To_config_InfluxDBClusterRetention(in *InfluxDBClusterRetention, out *config.InfluxDBClusterRetention, s conversion.Scope) error {
+	return autoConvert_v1_InfluxDBClusterRetention_To_config_InfluxDBClusterRetention(in, out, s)
+}
+
+func autoConvert_config_InfluxDBClusterRetention_To_v1_InfluxDBClusterRetention(in *config.InfluxDBClusterRetention, out *InfluxDBClusterRetention, s conversion.Scope) error {
+	out.ClusterName = in.ClusterName
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	return nil
+}
+
+// Convert_config_InfluxDBClusterRetention_To_v1_InfluxDBClusterRetention is an autogenerated conversion function.
+func Convert_config_InfluxDBClusterRetention_To_v1_InfluxDBClusterRetention(in *config.InfluxDBClusterRetention, out *InfluxDBClusterRetention, s conversion.Scope) error {
+	return autoConvert_config_InfluxDBClusterRetention_To_v1_InfluxDBClusterRetention(in, out, s)
+}
+
+func autoConvert_v1_InfluxDBRetention_To_config_InfluxDBRetention(in *InfluxDBRetention, out *config.InfluxDBRetention, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	out.Clusters = *(*[]config.InfluxDBClusterRetention)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_v1_InfluxDBRetention_To_config_InfluxDBRetention is an autogenerated conversion function.
+func Convert_v1_InfluxDBRetention_To_config_InfluxDBRetention(in *InfluxDBRetention, out *config.InfluxDBRetention, s conversion.Scope) error {
+	return autoConvert_v1_InfluxDBRetention_To_config_InfluxDBRetention(in, out, s)
+}
+
+func autoConvert_config_InfluxDBRetention_To_v1_InfluxDBRetention(in *config.InfluxDBRetention, out *InfluxDBRetention, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	out.Clusters = *(*[]InfluxDBClusterRetention)(unsafe.Pointer(&in.Clusters))
+	return nil
+}
+
+// Convert_config_InfluxDBRetention_To_v1_InfluxDBRetention is an autogenerated conversion function.
+func Convert_config_InfluxDBRetention_To_v1_InfluxDBRetention(in *config.InfluxDBRetention, out *InfluxDBRetention, s conversion.Scope) error {
+	return autoConvert_config_InfluxDBRetention_To_v1_InfluxDBRetention(in, out, s)
+}
+
 func autoConvert_v1_InfluxDBStorage_To_config_InfluxDBStorage(in *InfluxDBStorage, out *config.InfluxDBStorage, s conversion.Scope) error {
 	out.Servers = *(*[]config.InfluxDBStorageServer)(unsafe.Pointer(&in.Servers))
+	out.Retention = (*config.InfluxDBRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -176,6 +345,7 @@ func Convert_v1_InfluxDBStorage_To_config_InfluxDBStorage(in *InfluxDBStorage, o
 
 func autoConvert_config_InfluxDBStorage_To_v1_InfluxDBStorage(in *config.InfluxDBStorage, out *InfluxDBStorage, s conversion.Scope) error {
 	out.Servers = *(*[]InfluxDBStorageServer)(unsafe.Pointer(&in.Servers))
+	out.Retention = (*InfluxDBRetention)(unsafe.Pointer(in.Retention))
 	return nil
 }
 
@@ -214,6 +384,7 @@ func autoConvert_v1_MonitorConfiguration_To_config_MonitorConfiguration(in *Moni
 	if err := Convert_v1_Storage_To_config_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Grafana = (*config.Grafana)(unsafe.Pointer(in.Grafana))
 	return nil
 }
 
@@ -226,6 +397,7 @@ func autoConvert_config_MonitorConfiguration_To_v1_MonitorConfiguration(in *conf
 	if err := Convert_config_Storage_To_v1_Storage(&in.Storage, &out.Storage, s); err != nil {
 		return err
 	}
+	out.Grafana = (*Grafana)(unsafe.Pointer(in.Grafana))
 	return nil
 }
 
@@ -258,8 +430,75 @@ func Convert_config_Storage_To_v1_Storage(in *config.Storage, out *Storage, s co
 	return autoConvert_config_Storage_To_v1_Storage(in, out, s)
 }
 
+func autoConvert_v1_ThanosObjectStorage_To_config_ThanosObjectStorage(in *ThanosObjectStorage, out *config.ThanosObjectStorage, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Bucket = in.Bucket
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.AppID = in.AppID
+	out.AccessKey = in.AccessKey
+	out.SecretKey = in.SecretKey
+	out.Insecure = in.Insecure
+	if err := Convert_v1_ThanosRetention_To_config_ThanosRetention(&in.Retention, &out.Retention, s); err != nil {
+		return err
+	}
+	out.DisableDownsampling = in.DisableDownsampling
+	return nil
+}
+
+// Convert_v1_ThanosObjectStorage_To_config_ThanosObjectStorage is an autogenerated conversion function.
+func Convert_v1_ThanosObjectStorage_To_config_ThanosObjectStorage(in *ThanosObjectStorage, out *config.ThanosObjectStorage, s conversion.Scope) error {
+	return autoConvert_v1_ThanosObjectStorage_To_config_ThanosObjectStorage(in, out, s)
+}
+
+func autoConvert_config_ThanosObjectStorage_To_v1_ThanosObjectStorage(in *config.ThanosObjectStorage, out *ThanosObjectStorage, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Bucket = in.Bucket
+	out.Endpoint = in.Endpoint
+	out.Region = in.Region
+	out.AppID = in.AppID
+	out.AccessKey = in.AccessKey
+	out.SecretKey = in.SecretKey
+	out.Insecure = in.Insecure
+	if err := Convert_config_ThanosRetention_To_v1_ThanosRetention(&in.Retention, &out.Retention, s); err != nil {
+		return err
+	}
+	out.DisableDownsampling = in.DisableDownsampling
+	return nil
+}
+
+// Convert_config_ThanosObjectStorage_To_v1_ThanosObjectStorage is an autogenerated conversion function.
+func Convert_config_ThanosObjectStorage_To_v1_ThanosObjectStorage(in *config.ThanosObjectStorage, out *ThanosObjectStorage, s conversion.Scope) error {
+	return autoConvert_config_ThanosObjectStorage_To_v1_ThanosObjectStorage(in, out, s)
+}
+
+func autoConvert_v1_ThanosRetention_To_config_ThanosRetention(in *ThanosRetention, out *config.ThanosRetention, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	return nil
+}
+
+// Convert_v1_ThanosRetention_To_config_ThanosRetention is an autogenerated conversion function.
+func Convert_v1_ThanosRetention_To_config_ThanosRetention(in *ThanosRetention, out *config.ThanosRetention, s conversion.Scope) error {
+	return autoConvert_v1_ThanosRetention_To_config_ThanosRetention(in, out, s)
+}
+
+func autoConvert_config_ThanosRetention_To_v1_ThanosRetention(in *config.ThanosRetention, out *ThanosRetention, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Resolution5m = in.Resolution5m
+	out.Resolution1h = in.Resolution1h
+	return nil
+}
+
+// Convert_config_ThanosRetention_To_v1_ThanosRetention is an autogenerated conversion function.
+func Convert_config_ThanosRetention_To_v1_ThanosRetention(in *config.ThanosRetention, out *ThanosRetention, s conversion.Scope) error {
+	return autoConvert_config_ThanosRetention_To_v1_ThanosRetention(in, out, s)
+}
+
 func autoConvert_v1_ThanosStorage_To_config_ThanosStorage(in *ThanosStorage, out *config.ThanosStorage, s conversion.Scope) error {
 	out.Servers = *(*[]config.ThanosStorageServer)(unsafe.Pointer(&in.Servers))
+	out.ObjectStorage = (*config.ThanosObjectStorage)(unsafe.Pointer(in.ObjectStorage))
 	return nil
 }
 
@@ -270,6 +509,7 @@ func Convert_v1_ThanosStorage_To_config_ThanosStorage(in *ThanosStorage, out *co
 
 func autoConvert_config_ThanosStorage_To_v1_ThanosStorage(in *config.ThanosStorage, out *ThanosStorage, s conversion.Scope) error {
 	out.Servers = *(*[]ThanosStorageServer)(unsafe.Pointer(&in.Servers))
+	out.ObjectStorage = (*ThanosObjectStorage)(unsafe.Pointer(in.ObjectStorage))
 	return nil
 }
 
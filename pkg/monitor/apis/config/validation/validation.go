@@ -19,11 +19,21 @@
 package validation
 
 import (
+	"net/url"
+	"regexp"
+
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
 )
 
+var retentionRegexp = regexp.MustCompile(`^[0-9]+(s|m|h|d|w|y)$`)
+
+// influxDBRetentionRegexp matches the durations of influxdb retention policy,
+// which are at least 1h.
+var influxDBRetentionRegexp = regexp.MustCompile(`^([1-9][0-9]*(h|d|w)|INF)$`)
+
 // ValidateMonitorConfiguration validates `mc` and returns an error if it is invalid
 func ValidateMonitorConfiguration(mc *monitorconfig.MonitorConfiguration) error {
 	var allErrors []error
@@ -43,6 +53,9 @@ func ValidateMonitorConfiguration(mc *monitorconfig.MonitorConfiguration) error
 				}
 			}
 		}
+		if mc.Storage.InfluxDB.Retention != nil {
+			allErrors = append(allErrors, validateInfluxDBRetention(mc.Storage.InfluxDB.Retention, influxDBFld.Child("retention"))...)
+		}
 	}
 
 	if mc.Storage.ElasticSearch != nil {
@@ -73,6 +86,9 @@ func ValidateMonitorConfiguration(mc *monitorconfig.MonitorConfiguration) error
 				}
 			}
 		}
+		if mc.Storage.Thanos.ObjectStorage != nil {
+			allErrors = append(allErrors, validateThanosObjectStorage(mc.Storage.Thanos.ObjectStorage, thanosFld.Child("objectStorage"))...)
+		}
 	}
 
 	if storageCount == 0 {
@@ -81,5 +97,114 @@ func ValidateMonitorConfiguration(mc *monitorconfig.MonitorConfiguration) error
 		allErrors = append(allErrors, field.Required(fld, "storage can only specify at most one"))
 	}
 
+	if mc.Grafana != nil {
+		allErrors = append(allErrors, validateGrafana(mc.Grafana, field.NewPath("grafana"))...)
+	}
+
 	return utilerrors.NewAggregate(allErrors)
 }
+
+func validateThanosObjectStorage(os *monitorconfig.ThanosObjectStorage, fld *field.Path) []error {
+	var allErrors []error
+
+	switch os.Type {
+	case "S3":
+		if os.Endpoint == "" {
+			allErrors = append(allErrors, field.Required(fld.Child("endpoint"), "must be specify"))
+		}
+	case "COS":
+		if os.Region == "" {
+			allErrors = append(allErrors, field.Required(fld.Child("region"), "must be specify"))
+		}
+		if os.AppID == "" {
+			allErrors = append(allErrors, field.Required(fld.Child("appID"), "must be specify"))
+		}
+	default:
+		allErrors = append(allErrors, field.NotSupported(fld.Child("type"), os.Type, []string{"S3", "COS"}))
+	}
+	if os.Bucket == "" {
+		allErrors = append(allErrors, field.Required(fld.Child("bucket"), "must be specify"))
+	}
+	if os.AccessKey == "" {
+		allErrors = append(allErrors, field.Required(fld.Child("accessKey"), "must be specify"))
+	}
+	if os.SecretKey == "" {
+		allErrors = append(allErrors, field.Required(fld.Child("secretKey"), "must be specify"))
+	}
+
+	retentionFld := fld.Child("retention")
+	for name, value := range map[string]string{
+		"raw":          os.Retention.Raw,
+		"resolution5m": os.Retention.Resolution5m,
+		"resolution1h": os.Retention.Resolution1h,
+	} {
+		if value != "" && !retentionRegexp.MatchString(value) {
+			allErrors = append(allErrors, field.Invalid(retentionFld.Child(name), value, "must be a duration such as 90d"))
+		}
+	}
+
+	return allErrors
+}
+
+func validateInfluxDBRetention(retention *monitorconfig.InfluxDBRetention, fld *field.Path) []error {
+	var allErrors []error
+
+	allErrors = append(allErrors, validateInfluxDBDurations(retention.Raw, retention.Resolution5m, retention.Resolution1h, fld)...)
+	clusterNames := sets.NewString()
+	for index, cluster := range retention.Clusters {
+		clusterFld := fld.Child("clusters").Index(index)
+		if cluster.ClusterName == "" {
+			allErrors = append(allErrors, field.Required(clusterFld.Child("clusterName"), "must be specify"))
+		} else if clusterNames.Has(cluster.ClusterName) {
+			allErrors = append(allErrors, field.Duplicate(clusterFld.Child("clusterName"), cluster.ClusterName))
+		}
+		clusterNames.Insert(cluster.ClusterName)
+		allErrors = append(allErrors, validateInfluxDBDurations(cluster.Raw, cluster.Resolution5m, cluster.Resolution1h, clusterFld)...)
+	}
+
+	return allErrors
+}
+
+func validateInfluxDBDurations(raw, resolution5m, resolution1h string, fld *field.Path) []error {
+	var allErrors []error
+
+	for name, value := range map[string]string{
+		"raw":          raw,
+		"resolution5m": resolution5m,
+		"resolution1h": resolution1h,
+	} {
+		if value != "" && !influxDBRetentionRegexp.MatchString(value) {
+			allErrors = append(allErrors, field.Invalid(fld.Child(name), value, "must be a duration of at least 1h such as 7d, or INF"))
+		}
+	}
+
+	return allErrors
+}
+
+func validateGrafana(grafana *monitorconfig.Grafana, fld *field.Path) []error {
+	var allErrors []error
+
+	if grafana.RootURL == "" {
+		allErrors = append(allErrors, field.Required(fld.Child("rootURL"), "must be specify"))
+	} else if u, err := url.Parse(grafana.RootURL); err != nil || u.Scheme == "" || u.Host == "" {
+		allErrors = append(allErrors, field.Invalid(fld.Child("rootURL"), grafana.RootURL, "must be an absolute url"))
+	}
+	if grafana.AdminPassword == "" {
+		allErrors = append(allErrors, field.Required(fld.Child("adminPassword"), "must be specify"))
+	}
+
+	oidcFld := fld.Child("oidc")
+	if grafana.OIDC.IssuerURL == "" {
+		allErrors = append(allErrors, field.Required(oidcFld.Child("issuerURL"), "must be specify"))
+	} else if u, err := url.Parse(grafana.OIDC.IssuerURL); err != nil || u.Scheme != "https" {
+		allErrors = append(allErrors, field.Invalid(oidcFld.Child("issuerURL"), grafana.OIDC.IssuerURL, "must be a https url"))
+	}
+	if grafana.OIDC.ClientID == "" {
+		allErrors = append(allErrors, field.Required(oidcFld.Child("clientID"), "must be specify"))
+	}
+	if grafana.OIDC.ClientSecret == "" {
+		allErrors = append(allErrors, field.Required(oidcFld.Child("clientSecret"), "must be specify"))
+	}
+
+	return allErrors
+}
@@ -65,3 +65,113 @@ func TestValidateMonitorConfiguration(t *testing.T) {
 		t.Errorf("expect %d errors, got %v", numErrs, len(allErrors.(utilerrors.Aggregate).Errors()))
 	}
 }
+
+func TestValidateThanosObjectStorage(t *testing.T) {
+	successCase := &monitorconfig.MonitorConfiguration{
+		Storage: monitorconfig.Storage{
+			Thanos: &monitorconfig.ThanosStorage{
+				Servers: []monitorconfig.ThanosStorageServer{
+					{
+						Address: "http://thanos-query:9090",
+					},
+				},
+				ObjectStorage: &monitorconfig.ThanosObjectStorage{
+					Type:      "S3",
+					Bucket:    "thanos",
+					Endpoint:  "minio:9000",
+					AccessKey: "fake",
+					SecretKey: "fake",
+					Retention: monitorconfig.ThanosRetention{
+						Raw: "30d",
+					},
+				},
+			},
+		},
+	}
+	if allErrors := ValidateMonitorConfiguration(successCase); allErrors != nil {
+		t.Errorf("expect no errors, got %v", allErrors)
+	}
+
+	errorCase := successCase.DeepCopy()
+	errorCase.Storage.Thanos.ObjectStorage.Type = "COS"
+	errorCase.Storage.Thanos.ObjectStorage.Retention.Resolution1h = "1 year"
+	const numErrs = 3
+	if allErrors := ValidateMonitorConfiguration(errorCase); len(allErrors.(utilerrors.Aggregate).Errors()) != numErrs {
+		t.Errorf("expect %d errors, got %v", numErrs, len(allErrors.(utilerrors.Aggregate).Errors()))
+	}
+}
+
+func TestValidateGrafana(t *testing.T) {
+	successCase := &monitorconfig.MonitorConfiguration{
+		Storage: monitorconfig.Storage{
+			InfluxDB: &monitorconfig.InfluxDBStorage{
+				Servers: []monitorconfig.InfluxDBStorageServer{
+					{
+						Address: "https://127.0.0.1:8080",
+					},
+				},
+			},
+		},
+		Grafana: &monitorconfig.Grafana{
+			RootURL:       "https://grafana.tke.com",
+			AdminPassword: "fake",
+			OIDC: monitorconfig.GrafanaOIDC{
+				IssuerURL:    "https://tke-auth-api/oidc",
+				ClientID:     "grafana",
+				ClientSecret: "fake",
+			},
+		},
+	}
+	if allErrors := ValidateMonitorConfiguration(successCase); allErrors != nil {
+		t.Errorf("expect no errors, got %v", allErrors)
+	}
+
+	errorCase := successCase.DeepCopy()
+	errorCase.Grafana.RootURL = "grafana"
+	errorCase.Grafana.OIDC.IssuerURL = "http://tke-auth-api/oidc"
+	errorCase.Grafana.OIDC.ClientSecret = ""
+	const numErrs = 3
+	if allErrors := ValidateMonitorConfiguration(errorCase); len(allErrors.(utilerrors.Aggregate).Errors()) != numErrs {
+		t.Errorf("expect %d errors, got %v", numErrs, len(allErrors.(utilerrors.Aggregate).Errors()))
+	}
+}
+
+func TestValidateInfluxDBRetention(t *testing.T) {
+	successCase := &monitorconfig.MonitorConfiguration{
+		Storage: monitorconfig.Storage{
+			InfluxDB: &monitorconfig.InfluxDBStorage{
+				Servers: []monitorconfig.InfluxDBStorageServer{
+					{
+						Address: "https://127.0.0.1:8080",
+					},
+				},
+				Retention: &monitorconfig.InfluxDBRetention{
+					Raw:          "7d",
+					Resolution5m: "4w",
+					Resolution1h: "INF",
+					Clusters: []monitorconfig.InfluxDBClusterRetention{
+						{
+							ClusterName: "cls-a",
+							Raw:         "24h",
+						},
+					},
+				},
+			},
+		},
+	}
+	if allErrors := ValidateMonitorConfiguration(successCase); allErrors != nil {
+		t.Errorf("expect no errors, got %v", allErrors)
+	}
+
+	errorCase := successCase.DeepCopy()
+	errorCase.Storage.InfluxDB.Retention.Raw = "30m"
+	errorCase.Storage.InfluxDB.Retention.Resolution1h = "1y"
+	errorCase.Storage.InfluxDB.Retention.Clusters = append(errorCase.Storage.InfluxDB.Retention.Clusters,
+		monitorconfig.InfluxDBClusterRetention{ClusterName: "cls-a"},
+		monitorconfig.InfluxDBClusterRetention{Resolution5m: "0d"},
+	)
+	const numErrs = 5
+	if allErrors := ValidateMonitorConfiguration(errorCase); len(allErrors.(utilerrors.Aggregate).Errors()) != numErrs {
+		t.Errorf("expect %d errors, got %v", numErrs, len(allErrors.(utilerrors.Aggregate).Errors()))
+	}
+}
@@ -63,6 +63,76 @@ func (in *ElasticSearchStorageServer) DeepCopy() *ElasticSearchStorageServer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grafana) DeepCopyInto(out *Grafana) {
+	*out = *in
+	out.OIDC = in.OIDC
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grafana.
+func (in *Grafana) DeepCopy() *Grafana {
+	if in == nil {
+		return nil
+	}
+	out := new(Grafana)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrafanaOIDC) DeepCopyInto(out *GrafanaOIDC) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrafanaOIDC.
+func (in *GrafanaOIDC) DeepCopy() *GrafanaOIDC {
+	if in == nil {
+		return nil
+	}
+	out := new(GrafanaOIDC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfluxDBClusterRetention) DeepCopyInto(out *InfluxDBClusterRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxDBClusterRetention.
+func (in *InfluxDBClusterRetention) DeepCopy() *InfluxDBClusterRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(InfluxDBClusterRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfluxDBRetention) DeepCopyInto(out *InfluxDBRetention) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]InfluxDBClusterRetention, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfluxDBRetention.
+func (in *InfluxDBRetention) DeepCopy() *InfluxDBRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(InfluxDBRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InfluxDBStorage) DeepCopyInto(out *InfluxDBStorage) {
 	*out = *in
@@ -73,6 +143,11 @@ func (in *InfluxDBStorage) DeepCopyInto(out *InfluxDBStorage) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(InfluxDBRetention)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -112,6 +187,11 @@ func (in *MonitorConfiguration) DeepCopyInto(out *MonitorConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Grafana != nil {
+		in, out := &in.Grafana, &out.Grafana
+		*out = new(Grafana)
+		**out = **in
+	}
 	return
 }
 
@@ -164,6 +244,39 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosObjectStorage) DeepCopyInto(out *ThanosObjectStorage) {
+	*out = *in
+	out.Retention = in.Retention
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosObjectStorage.
+func (in *ThanosObjectStorage) DeepCopy() *ThanosObjectStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosObjectStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThanosRetention) DeepCopyInto(out *ThanosRetention) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThanosRetention.
+func (in *ThanosRetention) DeepCopy() *ThanosRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ThanosRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThanosStorage) DeepCopyInto(out *ThanosStorage) {
 	*out = *in
@@ -172,6 +285,11 @@ func (in *ThanosStorage) DeepCopyInto(out *ThanosStorage) {
 		*out = make([]ThanosStorageServer, len(*in))
 		copy(*out, *in)
 	}
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(ThanosObjectStorage)
+		**out = **in
+	}
 	return
 }
 
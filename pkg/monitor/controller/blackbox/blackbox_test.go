@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package blackbox
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+func newProbe(name, module string, targets ...string) *v1.BlackboxProbe {
+	return &v1.BlackboxProbe{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1.BlackboxProbeSpec{
+			ClusterName:     "cls-a",
+			Module:          module,
+			Targets:         targets,
+			IntervalSeconds: 30,
+			TimeoutSeconds:  5,
+		},
+	}
+}
+
+func TestConfigForExporter(t *testing.T) {
+	web := newProbe("web", moduleHTTP, "https://nginx.default.svc/healthz")
+	web.Spec.HTTP = &v1.BlackboxProbeHTTP{Method: "HEAD", ValidStatusCodes: []int32{200, 204}, InsecureSkipVerify: true}
+	config, err := configForExporter([]*v1.BlackboxProbe{
+		web,
+		newProbe("db", moduleTCP, "mysql.default.svc:3306"),
+		newProbe("gw", moduleICMP, "10.0.0.1"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		"  web:\n    http:\n",
+		"method: HEAD",
+		"- 204",
+		"insecure_skip_verify: true",
+		"prober: http",
+		"  db:\n    prober: tcp\n",
+		"prober: icmp",
+		"timeout: 5s",
+	} {
+		if !strings.Contains(config, expect) {
+			t.Errorf("expect %q in config:\n%s", expect, config)
+		}
+	}
+}
+
+func TestServiceMonitorForProbe(t *testing.T) {
+	probe := newProbe("web", moduleHTTP, "http://a/healthz", "http://b/healthz")
+	serviceMonitor := serviceMonitorForProbe(probe)
+	if serviceMonitor.Labels[probeLabel] != "web" {
+		t.Errorf("expect the probe label, got %v", serviceMonitor.Labels)
+	}
+	if len(serviceMonitor.Spec.Endpoints) != 2 {
+		t.Fatalf("expect an endpoint for each target, got %d", len(serviceMonitor.Spec.Endpoints))
+	}
+	endpoint := serviceMonitor.Spec.Endpoints[1]
+	if endpoint.Params["module"][0] != "web" || endpoint.Params["target"][0] != "http://b/healthz" {
+		t.Errorf("unexpected params %v", endpoint.Params)
+	}
+	if endpoint.Interval != "30s" || endpoint.ScrapeTimeout != "6s" {
+		t.Errorf("unexpected interval %s and scrape timeout %s", endpoint.Interval, endpoint.ScrapeTimeout)
+	}
+	if endpoint.RelabelConfigs[0].TargetLabel != "instance" || endpoint.RelabelConfigs[0].Replacement != "http://b/healthz" {
+		t.Errorf("expect the instance label is the target, got %v", endpoint.RelabelConfigs[0])
+	}
+}
+
+func TestRuleForProbe(t *testing.T) {
+	probe := newProbe("web", moduleHTTP, "http://a/healthz")
+	probe.Spec.SLO = &v1.BlackboxProbeSLO{
+		Objective:     "99.9",
+		WindowSeconds: 3600,
+		Notify:        v1.BlackboxProbeNotify{Channel: "email", Template: "slo", Receivers: []string{"a", "b"}},
+	}
+	rule := ruleForProbe(probe)
+	if rule.Labels["role"] != "alert-rules" || rule.Labels[probeLabel] != "web" {
+		t.Errorf("unexpected labels %v", rule.Labels)
+	}
+	r := rule.Spec.Groups[0].Rules[0]
+	expr := `avg by (instance) (avg_over_time(probe_success{probe="web"}[3600s])) * 100 < 99.9`
+	if r.Expr.String() != expr {
+		t.Errorf("expect expr %s, got %s", expr, r.Expr.String())
+	}
+	if r.Annotations["notifyWay"] != "email:slo" || r.Annotations["receivers"] != "a,b" || r.Annotations["evaluateValue"] != "99.9" {
+		t.Errorf("unexpected annotations %v", r.Annotations)
+	}
+}
@@ -0,0 +1,256 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package blackbox
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	monitorv1informer "tkestack.io/tke/api/client/informers/externalversions/monitor/v1"
+	monitorv1lister "tkestack.io/tke/api/client/listers/monitor/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+	"tkestack.io/tke/pkg/monitor/sharding"
+	platformutil "tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "blackbox"
+)
+
+// Controller deploys blackbox exporter to the clusters which have
+// BlackboxProbes, and generates the ServiceMonitors and PrometheusRules of
+// the probes, so that the results are scraped by the prometheus of the
+// clusters and written to the storage of tke-monitor.
+type Controller struct {
+	client         clientset.Interface
+	platformClient platformv1client.PlatformV1Interface
+	queue          workqueue.RateLimitingInterface
+	lister         monitorv1lister.BlackboxProbeLister
+	listerSynced   cache.InformerSynced
+	sharder        *sharding.Sharder
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, platformClient platformv1client.PlatformV1Interface, probeInformer monitorv1informer.BlackboxProbeInformer, sharder *sharding.Sharder, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		client:         client,
+		platformClient: platformClient,
+		sharder:        sharder,
+		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.MonitorV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("blackbox_controller", client.MonitorV1().RESTClient().GetRateLimiter())
+	}
+
+	probeInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueCluster,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldProbe, ok1 := oldObj.(*v1.BlackboxProbe)
+				newProbe, ok2 := newObj.(*v1.BlackboxProbe)
+				// the status updated by the controller itself is skipped.
+				if ok1 && ok2 && oldProbe.ResourceVersion != newProbe.ResourceVersion &&
+					reflect.DeepEqual(oldProbe.Spec, newProbe.Spec) &&
+					newProbe.DeletionTimestamp == nil {
+					return
+				}
+				controller.enqueueCluster(oldObj)
+				controller.enqueueCluster(newObj)
+			},
+			DeleteFunc: controller.enqueueCluster,
+		},
+		resyncPeriod,
+	)
+	controller.lister = probeInformer.Lister()
+	controller.listerSynced = probeInformer.Informer().HasSynced
+	sharder.AddHandler(controller.enqueueAll)
+
+	return controller
+}
+
+// obj could be an *v1.BlackboxProbe, or a DeletionFinalStateUnknown marker
+// item, the cluster of the probe is enqueued.
+func (c *Controller) enqueueCluster(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	probe, ok := obj.(*v1.BlackboxProbe)
+	if !ok {
+		log.Error("Couldn't get blackbox probe from object", log.Any("object", obj))
+		return
+	}
+	c.queue.Add(probe.Spec.ClusterName)
+}
+
+// enqueueAll enqueues the clusters of all the probes after the shards of this
+// replica changed.
+func (c *Controller) enqueueAll() {
+	probes, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list blackbox probes", log.Err(err))
+		return
+	}
+	for _, probe := range probes {
+		c.queue.Add(probe.Spec.ClusterName)
+	}
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting blackbox controller")
+	defer log.Info("Shutting down blackbox controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for blackbox probe caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of objects.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncCluster deploys blackbox exporter and the monitoring objects of the
+// probes to the cluster, and removes them after the last probe of the cluster
+// is deleted.
+func (c *Controller) syncCluster(clusterName string) error {
+	if !c.sharder.Owns(clusterName) {
+		return nil
+	}
+
+	ctx := context.Background()
+	probes, err := c.probesOfCluster(clusterName)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := c.platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Cluster of blackbox probes has been deleted", log.String("clusterName", clusterName))
+			return nil
+		}
+		return err
+	}
+	kubeClient, err := platformutil.BuildExternalClientSet(ctx, cluster, c.platformClient)
+	if err != nil {
+		return c.updateStatus(ctx, probes, err)
+	}
+	monitoringClient, err := platformutil.BuildExternalMonitoringClientSet(ctx, cluster, c.platformClient)
+	if err != nil {
+		return c.updateStatus(ctx, probes, err)
+	}
+
+	if len(probes) == 0 {
+		log.Info("Remove blackbox exporter from cluster", log.String("clusterName", clusterName))
+		if err := deleteMonitoring(ctx, monitoringClient, sets.NewString(), sets.NewString()); err != nil {
+			return err
+		}
+		return uninstallExporter(ctx, kubeClient)
+	}
+
+	if err := installExporter(ctx, kubeClient, probes); err != nil {
+		return c.updateStatus(ctx, probes, err)
+	}
+	err = applyMonitoring(ctx, monitoringClient, probes)
+	return c.updateStatus(ctx, probes, err)
+}
+
+func (c *Controller) probesOfCluster(clusterName string) ([]*v1.BlackboxProbe, error) {
+	probes, err := c.lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var result []*v1.BlackboxProbe
+	for _, probe := range probes {
+		if probe.Spec.ClusterName == clusterName && probe.DeletionTimestamp == nil {
+			result = append(result, probe)
+		}
+	}
+	return result, nil
+}
+
+// updateStatus records the result of deploying in the status of the probes,
+// and returns the error of deploying so that the cluster is retried.
+func (c *Controller) updateStatus(ctx context.Context, probes []*v1.BlackboxProbe, syncErr error) error {
+	phase, message := v1.BlackboxProbeRunning, ""
+	if syncErr != nil {
+		phase, message = v1.BlackboxProbeFailed, syncErr.Error()
+	}
+	for _, probe := range probes {
+		if probe.Status.Phase == phase && probe.Status.Message == message {
+			continue
+		}
+		newProbe := probe.DeepCopy()
+		if newProbe.Status.Phase != phase {
+			newProbe.Status.LastTransitionTime = metav1.Now()
+		}
+		newProbe.Status.Phase = phase
+		newProbe.Status.Message = message
+		if _, err := c.client.MonitorV1().BlackboxProbes().UpdateStatus(ctx, newProbe, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to update the status of blackbox probe", log.String("probeName", probe.Name), log.Err(err))
+		}
+	}
+	return syncErr
+}
@@ -0,0 +1,269 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package blackbox
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+	v1 "tkestack.io/tke/api/monitor/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/monitor/controller/blackbox/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	exporterName           = "blackbox-exporter"
+	exporterPort           = 9115
+	exporterConfigKey      = "blackbox.yml"
+	exporterConfigPath     = "/etc/blackbox_exporter"
+	exporterConfigHashAnno = "tkestack.io/blackbox-config-hash"
+
+	moduleHTTP = "HTTP"
+	moduleTCP  = "TCP"
+	moduleICMP = "ICMP"
+)
+
+// exporterConfig mirrors the configuration file of blackbox exporter.
+type exporterConfig struct {
+	Modules map[string]exporterModule `json:"modules"`
+}
+
+type exporterModule struct {
+	Prober  string      `json:"prober"`
+	Timeout string      `json:"timeout"`
+	HTTP    *httpProber `json:"http,omitempty"`
+	TCP     *ipProber   `json:"tcp,omitempty"`
+	ICMP    *ipProber   `json:"icmp,omitempty"`
+}
+
+type httpProber struct {
+	Method              string    `json:"method,omitempty"`
+	ValidStatusCodes    []int32   `json:"valid_status_codes,omitempty"`
+	PreferredIPProtocol string    `json:"preferred_ip_protocol"`
+	TLSConfig           tlsConfig `json:"tls_config"`
+}
+
+type tlsConfig struct {
+	InsecureSkipVerify bool `json:"insecure_skip_verify"`
+}
+
+type ipProber struct {
+	PreferredIPProtocol string `json:"preferred_ip_protocol"`
+}
+
+// moduleForProbe renders the module of blackbox exporter for the probe, the
+// module is named after the probe so that the timeout and the HTTP settings
+// of each probe are isolated.
+func moduleForProbe(probe *v1.BlackboxProbe) exporterModule {
+	module := exporterModule{
+		Timeout: fmt.Sprintf("%ds", probe.Spec.TimeoutSeconds),
+	}
+	switch probe.Spec.Module {
+	case moduleHTTP:
+		module.Prober = "http"
+		module.HTTP = &httpProber{PreferredIPProtocol: "ip4"}
+		if probe.Spec.HTTP != nil {
+			module.HTTP.Method = probe.Spec.HTTP.Method
+			module.HTTP.ValidStatusCodes = probe.Spec.HTTP.ValidStatusCodes
+			module.HTTP.TLSConfig.InsecureSkipVerify = probe.Spec.HTTP.InsecureSkipVerify
+		}
+	case moduleTCP:
+		module.Prober = "tcp"
+		module.TCP = &ipProber{PreferredIPProtocol: "ip4"}
+	case moduleICMP:
+		module.Prober = "icmp"
+		module.ICMP = &ipProber{PreferredIPProtocol: "ip4"}
+	}
+	return module
+}
+
+// configForExporter renders the configuration of blackbox exporter with the
+// modules of all the probes in the cluster.
+func configForExporter(probes []*v1.BlackboxProbe) (string, error) {
+	config := exporterConfig{Modules: make(map[string]exporterModule, len(probes))}
+	for _, probe := range probes {
+		config.Modules[probe.Name] = moduleForProbe(probe)
+	}
+	data, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func labelsForExporter() map[string]string {
+	return map[string]string{"app": exporterName}
+}
+
+func configMapForExporter(config string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exporterName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labelsForExporter(),
+		},
+		Data: map[string]string{
+			exporterConfigKey: config,
+		},
+	}
+}
+
+func serviceForExporter() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exporterName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labelsForExporter(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labelsForExporter(),
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: exporterPort, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+// deploymentForExporter runs blackbox exporter with the capability of raw
+// sockets, which is required by ICMP probes.
+func deploymentForExporter(config string, components images.Components) *appsv1.Deployment {
+	hash := sha256.Sum256([]byte(config))
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      exporterName,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labelsForExporter(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: controllerutil.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labelsForExporter()},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labelsForExporter(),
+					Annotations: map[string]string{
+						exporterConfigHashAnno: fmt.Sprintf("%x", hash[:8]),
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  exporterName,
+							Image: components.BlackboxExporter.FullName(),
+							Args: []string{
+								"--config.file=" + exporterConfigPath + "/" + exporterConfigKey,
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: exporterPort},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(50, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(64*1024*1024, resource.BinarySI),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(500, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(256*1024*1024, resource.BinarySI),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								Capabilities: &corev1.Capabilities{
+									Add: []corev1.Capability{"NET_RAW"},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "config", MountPath: exporterConfigPath},
+							},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/-/healthy",
+										Port: intstr.FromString("http"),
+									},
+								},
+								PeriodSeconds: 10,
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: exporterName},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// installExporter creates or updates blackbox exporter in the cluster with
+// the modules of the probes.
+func installExporter(ctx context.Context, kubeClient kubernetes.Interface, probes []*v1.BlackboxProbe) error {
+	components := images.Get(images.LatestVersion)
+	sort.Slice(probes, func(i, j int) bool { return probes[i].Name < probes[j].Name })
+	config, err := configForExporter(probes)
+	if err != nil {
+		return fmt.Errorf("render blackbox exporter config failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateConfigMap(ctx, kubeClient, configMapForExporter(config)); err != nil {
+		return fmt.Errorf("apply blackbox exporter configmap failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateService(ctx, kubeClient, serviceForExporter()); err != nil {
+		return fmt.Errorf("apply blackbox exporter service failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateDeployment(ctx, kubeClient, deploymentForExporter(config, components)); err != nil {
+		return fmt.Errorf("apply blackbox exporter deployment failed: %v", err)
+	}
+	return nil
+}
+
+// uninstallExporter deletes blackbox exporter from the cluster after the last
+// probe of the cluster is deleted.
+func uninstallExporter(ctx context.Context, kubeClient kubernetes.Interface) error {
+	var errs []string
+	if err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Delete(ctx, exporterName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err.Error())
+	}
+	if err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Delete(ctx, exporterName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err.Error())
+	}
+	if err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Delete(ctx, exporterName, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("delete blackbox exporter failed: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package blackbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/coreos/prometheus-operator/pkg/apis/monitoring"
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	monitoringclient "github.com/coreos/prometheus-operator/pkg/client/versioned"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+const (
+	// probeLabel marks the ServiceMonitors and PrometheusRules generated for
+	// the probes, and the metrics scraped through them.
+	probeLabel = "tkestack.io/blackbox-probe"
+
+	probeAlarmPolicyType = "probe"
+)
+
+// nameForProbe is the name of the ServiceMonitor and PrometheusRule of the
+// probe.
+func nameForProbe(probe *v1.BlackboxProbe) string {
+	return exporterName + "-" + probe.Name
+}
+
+func labelsForProbe(probe *v1.BlackboxProbe) map[string]string {
+	return map[string]string{probeLabel: probe.Name}
+}
+
+// serviceMonitorForProbe scrapes blackbox exporter once for each target of
+// the probe, the instance label of the metrics is the target.
+func serviceMonitorForProbe(probe *v1.BlackboxProbe) *monitoringv1.ServiceMonitor {
+	interval := fmt.Sprintf("%ds", probe.Spec.IntervalSeconds)
+	// the scrape lasts longer than the probing so that the exporter reports
+	// the timeout instead of the scrape failing.
+	scrapeTimeout := fmt.Sprintf("%ds", probe.Spec.TimeoutSeconds+1)
+
+	endpoints := make([]monitoringv1.Endpoint, 0, len(probe.Spec.Targets))
+	for _, target := range probe.Spec.Targets {
+		endpoints = append(endpoints, monitoringv1.Endpoint{
+			Port:          "http",
+			Path:          "/probe",
+			Params:        map[string][]string{"module": {probe.Name}, "target": {target}},
+			Interval:      interval,
+			ScrapeTimeout: scrapeTimeout,
+			RelabelConfigs: []*monitoringv1.RelabelConfig{
+				{TargetLabel: "instance", Replacement: target},
+				{TargetLabel: "probe", Replacement: probe.Name},
+				{TargetLabel: "module", Replacement: probe.Spec.Module},
+			},
+		})
+	}
+
+	return &monitoringv1.ServiceMonitor{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoring.GroupName + "/v1",
+			Kind:       monitoringv1.ServiceMonitorsKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nameForProbe(probe),
+			Namespace: metav1.NamespaceSystem,
+			Labels:    labelsForProbe(probe),
+		},
+		Spec: monitoringv1.ServiceMonitorSpec{
+			Endpoints: endpoints,
+			Selector:  metav1.LabelSelector{MatchLabels: labelsForExporter()},
+			NamespaceSelector: monitoringv1.NamespaceSelector{
+				MatchNames: []string{metav1.NamespaceSystem},
+			},
+		},
+	}
+}
+
+// ruleForProbe generates the alert rule which fires when the availability of
+// a target within the window of the SLO is lower than the objective. The
+// annotations are the same as the alarm policies so that the alerts are sent
+// to the receivers by notify.
+func ruleForProbe(probe *v1.BlackboxProbe) *monitoringv1.PrometheusRule {
+	slo := probe.Spec.SLO
+	ruleLabels := map[string]string{
+		"prometheus": "k8s",
+		"role":       "alert-rules",
+	}
+	for k, v := range labelsForProbe(probe) {
+		ruleLabels[k] = v
+	}
+	expr := fmt.Sprintf(`avg by (instance) (avg_over_time(probe_success{probe="%s"}[%ds])) * 100 < %s`,
+		probe.Name, slo.WindowSeconds, slo.Objective)
+
+	return &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoring.GroupName + "/v1",
+			Kind:       monitoringv1.PrometheusRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nameForProbe(probe),
+			Namespace: metav1.NamespaceSystem,
+			Labels:    ruleLabels,
+		},
+		Spec: monitoringv1.PrometheusRuleSpec{
+			Groups: []monitoringv1.RuleGroup{
+				{
+					Name: probe.Name,
+					Rules: []monitoringv1.Rule{
+						{
+							Alert: probe.Name,
+							Expr:  intstr.FromString(expr),
+							Labels: map[string]string{
+								"alarmPolicyName": probe.Name,
+								"probe":           probe.Name,
+							},
+							Annotations: map[string]string{
+								"notifyWay":         slo.Notify.Channel + ":" + slo.Notify.Template,
+								"receivers":         strings.Join(slo.Notify.Receivers, ","),
+								"receiverGroups":    strings.Join(slo.Notify.ReceiverGroups, ","),
+								"alarmPolicyType":   probeAlarmPolicyType,
+								"metricDisplayName": "availability of {{ $labels.instance }}",
+								"value":             "{{ $value }}",
+								"unit":              "%",
+								"evaluateType":      "lt",
+								"evaluateValue":     slo.Objective,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// applyMonitoring creates or updates the ServiceMonitors and PrometheusRules
+// of the probes, and deletes the ones of the probes which no longer exist.
+func applyMonitoring(ctx context.Context, client monitoringclient.Interface, probes []*v1.BlackboxProbe) error {
+	serviceMonitors := sets.NewString()
+	rules := sets.NewString()
+	for _, probe := range probes {
+		serviceMonitor := serviceMonitorForProbe(probe)
+		if err := applyServiceMonitor(ctx, client, serviceMonitor); err != nil {
+			return fmt.Errorf("apply service monitor of probe %s failed: %v", probe.Name, err)
+		}
+		serviceMonitors.Insert(serviceMonitor.Name)
+
+		if probe.Spec.SLO == nil {
+			continue
+		}
+		rule := ruleForProbe(probe)
+		if err := applyPrometheusRule(ctx, client, rule); err != nil {
+			return fmt.Errorf("apply prometheus rule of probe %s failed: %v", probe.Name, err)
+		}
+		rules.Insert(rule.Name)
+	}
+	return deleteMonitoring(ctx, client, serviceMonitors, rules)
+}
+
+func applyServiceMonitor(ctx context.Context, client monitoringclient.Interface, serviceMonitor *monitoringv1.ServiceMonitor) error {
+	current, err := client.MonitoringV1().ServiceMonitors(serviceMonitor.Namespace).Get(ctx, serviceMonitor.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.MonitoringV1().ServiceMonitors(serviceMonitor.Namespace).Create(ctx, serviceMonitor, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	serviceMonitor.ResourceVersion = current.ResourceVersion
+	_, err = client.MonitoringV1().ServiceMonitors(serviceMonitor.Namespace).Update(ctx, serviceMonitor, metav1.UpdateOptions{})
+	return err
+}
+
+func applyPrometheusRule(ctx context.Context, client monitoringclient.Interface, rule *monitoringv1.PrometheusRule) error {
+	current, err := client.MonitoringV1().PrometheusRules(rule.Namespace).Get(ctx, rule.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.MonitoringV1().PrometheusRules(rule.Namespace).Create(ctx, rule, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	rule.ResourceVersion = current.ResourceVersion
+	_, err = client.MonitoringV1().PrometheusRules(rule.Namespace).Update(ctx, rule, metav1.UpdateOptions{})
+	return err
+}
+
+// deleteMonitoring deletes the generated ServiceMonitors and PrometheusRules
+// which are not in the kept sets.
+func deleteMonitoring(ctx context.Context, client monitoringclient.Interface, serviceMonitors, rules sets.String) error {
+	// the selector of a bare key matches all the objects having the label.
+	options := metav1.ListOptions{LabelSelector: probeLabel}
+
+	serviceMonitorList, err := client.MonitoringV1().ServiceMonitors(metav1.NamespaceSystem).List(ctx, options)
+	if err != nil {
+		return err
+	}
+	for _, serviceMonitor := range serviceMonitorList.Items {
+		if serviceMonitors.Has(serviceMonitor.Name) {
+			continue
+		}
+		err := client.MonitoringV1().ServiceMonitors(metav1.NamespaceSystem).Delete(ctx, serviceMonitor.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	ruleList, err := client.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).List(ctx, options)
+	if err != nil {
+		return err
+	}
+	for _, rule := range ruleList.Items {
+		if rules.Has(rule.Name) {
+			continue
+		}
+		err := client.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Delete(ctx, rule.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package downsampling
+
+import (
+	"fmt"
+	"time"
+
+	influxclient "github.com/influxdata/influxdb1-client/v2"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// internalDatabase is the database of the statistics of influxdb itself.
+const internalDatabase = "_internal"
+
+// Controller keeps the retention policies of the databases of the clusters in
+// accordance with the configuration, and downsamples the raw metrics to the
+// 5m and 1h resolutions periodically.
+type Controller struct {
+	clients    []influxclient.Client
+	addresses  []string
+	retention  *monitorconfig.InfluxDBRetention
+	syncPeriod time.Duration
+	// lastEnds is the end of the last downsampled range, which is keyed by
+	// the address, database and target retention policy.
+	lastEnds map[string]time.Time
+}
+
+// NewController creates a new downsampling controller of the influxdb servers.
+func NewController(config *monitorconfig.InfluxDBStorage, syncPeriod time.Duration) (*Controller, error) {
+	controller := &Controller{
+		retention:  config.Retention,
+		syncPeriod: syncPeriod,
+		lastEnds:   make(map[string]time.Time),
+	}
+	for _, server := range config.Servers {
+		influxCfg := influxclient.HTTPConfig{
+			Addr:               server.Address,
+			Username:           server.Username,
+			Password:           server.Password,
+			UserAgent:          "tke-monitor-controller",
+			InsecureSkipVerify: true,
+		}
+		if server.TimeoutSeconds != nil {
+			influxCfg.Timeout = time.Duration(*server.TimeoutSeconds) * time.Second
+		}
+		client, err := influxclient.NewHTTPClient(influxCfg)
+		if err != nil {
+			return nil, err
+		}
+		controller.clients = append(controller.clients, client)
+		controller.addresses = append(controller.addresses, server.Address)
+	}
+	if len(controller.clients) == 0 {
+		return nil, fmt.Errorf("no available influxDB client")
+	}
+	return controller, nil
+}
+
+// Run syncs the retention policies and downsamples the metrics until stopCh
+// is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+
+	log.Info("Starting downsampling controller")
+	defer log.Info("Shutting down downsampling controller")
+
+	wait.Until(c.sync, c.syncPeriod, stopCh)
+}
+
+func (c *Controller) sync() {
+	for i, client := range c.clients {
+		address := c.addresses[i]
+		databases, err := showDatabases(client)
+		if err != nil {
+			log.Error("Failed to show databases", log.String("address", address), log.Err(err))
+			continue
+		}
+		for _, database := range databases {
+			if database == internalDatabase {
+				continue
+			}
+			if err := c.syncRetentionPolicies(client, database); err != nil {
+				log.Error("Failed to sync retention policies",
+					log.String("address", address),
+					log.String("database", database),
+					log.Err(err))
+				continue
+			}
+			c.downsample(client, address, database)
+		}
+	}
+}
+
+// syncRetentionPolicies creates the retention policies of the resolutions if
+// they don't exist, and alters the durations of the retention policies if
+// they are changed.
+func (c *Controller) syncRetentionPolicies(client influxclient.Client, database string) error {
+	policies, err := showRetentionPolicies(client, database)
+	if err != nil {
+		return err
+	}
+	for name, value := range retentionOf(c.retention, database) {
+		duration, err := parseDuration(value)
+		if err != nil {
+			return err
+		}
+		current, ok := policies[name]
+		if ok && current == duration {
+			continue
+		}
+
+		verb := "CREATE"
+		replication := " REPLICATION 1"
+		if ok {
+			verb = "ALTER"
+			replication = ""
+		}
+		command := fmt.Sprintf(`%s RETENTION POLICY "%s" ON "%s" DURATION %s%s SHARD DURATION %s`,
+			verb, name, database, formatDuration(duration), replication, formatDuration(shardDuration(duration)))
+		log.Info("Sync retention policy", log.String("database", database), log.String("command", command))
+		if err := query(client, database, command); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// downsample downsamples the metrics of the database for each tier, the 1h
+// tier reads the result of the 5m tier.
+func (c *Controller) downsample(client influxclient.Client, address, database string) {
+	now := time.Now()
+	for _, t := range tiers {
+		key := address + "/" + database + "/" + t.target
+		start, end, ok := t.window(now, c.lastEnds[key])
+		if !ok {
+			continue
+		}
+		if err := query(client, database, t.query(database, start, end)); err != nil {
+			log.Error("Failed to downsample metrics",
+				log.String("address", address),
+				log.String("database", database),
+				log.String("retentionPolicy", t.target),
+				log.Err(err))
+			return
+		}
+		c.lastEnds[key] = end
+	}
+}
+
+func query(client influxclient.Client, database, command string) error {
+	resp, err := client.Query(influxclient.NewQuery(command, database, ""))
+	if err != nil {
+		return err
+	}
+	return resp.Error()
+}
+
+func showDatabases(client influxclient.Client) ([]string, error) {
+	resp, err := client.Query(influxclient.NewQuery("SHOW DATABASES", "", ""))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+
+	var databases []string
+	for _, result := range resp.Results {
+		for _, series := range result.Series {
+			for _, value := range series.Values {
+				if len(value) > 0 {
+					if name, ok := value[0].(string); ok {
+						databases = append(databases, name)
+					}
+				}
+			}
+		}
+	}
+	return databases, nil
+}
+
+// showRetentionPolicies returns the durations of the retention policies of
+// the database.
+func showRetentionPolicies(client influxclient.Client, database string) (map[string]time.Duration, error) {
+	resp, err := client.Query(influxclient.NewQuery(fmt.Sprintf(`SHOW RETENTION POLICIES ON "%s"`, database), database, ""))
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error()
+	}
+
+	policies := make(map[string]time.Duration)
+	for _, result := range resp.Results {
+		for _, series := range result.Series {
+			for _, value := range series.Values {
+				if len(value) < 2 {
+					continue
+				}
+				name, ok1 := value[0].(string)
+				duration, ok2 := value[1].(string)
+				if !ok1 || !ok2 {
+					continue
+				}
+				d, err := time.ParseDuration(duration)
+				if err != nil {
+					return nil, err
+				}
+				policies[name] = d
+			}
+		}
+	}
+	return policies, nil
+}
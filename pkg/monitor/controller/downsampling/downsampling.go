@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package downsampling
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	monitorutil "tkestack.io/tke/pkg/monitor/util"
+)
+
+const (
+	// rawRetentionPolicy is the default retention policy of the database, to
+	// which prometheus writes the raw metrics.
+	rawRetentionPolicy = "autogen"
+	retentionPolicy5m  = "rp_5m"
+	retentionPolicy1h  = "rp_1h"
+
+	infiniteDuration = "INF"
+)
+
+// tier downsamples the metrics of the source retention policy to the target
+// retention policy with the interval.
+type tier struct {
+	source   string
+	target   string
+	interval time.Duration
+	// delay waits for the metrics written late before downsampling an
+	// interval.
+	delay time.Duration
+	// catchup is the number of the intervals downsampled at the first time.
+	catchup int
+}
+
+var tiers = []tier{
+	{
+		source:   rawRetentionPolicy,
+		target:   retentionPolicy5m,
+		interval: 5 * time.Minute,
+		delay:    time.Minute,
+		catchup:  12,
+	},
+	{
+		source:   retentionPolicy5m,
+		target:   retentionPolicy1h,
+		interval: time.Hour,
+		delay:    10 * time.Minute,
+		catchup:  12,
+	},
+}
+
+// window returns the time range to be downsampled, lastEnd is the end of the
+// last downsampled range, or zero if the tier has not been downsampled. The
+// last interval is downsampled again to include the metrics written late.
+func (t tier) window(now, lastEnd time.Time) (time.Time, time.Time, bool) {
+	end := now.Add(-t.delay).Truncate(t.interval)
+	if lastEnd.IsZero() {
+		return end.Add(-time.Duration(t.catchup) * t.interval), end, true
+	}
+	if !end.After(lastEnd) {
+		return time.Time{}, time.Time{}, false
+	}
+	return lastEnd.Add(-t.interval), end, true
+}
+
+// query returns the influxql which downsamples all measurements of the
+// database within the time range.
+func (t tier) query(database string, start, end time.Time) string {
+	return fmt.Sprintf(`SELECT mean("value") AS "value" INTO "%s"."%s".:MEASUREMENT FROM "%s"."%s"./.*/ WHERE time >= '%s' AND time < '%s' GROUP BY time(%s), *`,
+		database, t.target, database, t.source,
+		start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339),
+		formatDuration(t.interval))
+}
+
+// retention is the durations of the retention policies of a database.
+type retention map[string]string
+
+// retentionOf returns the retention of the database, the retention of the
+// cluster overrides the retention of all clusters.
+func retentionOf(config *monitorconfig.InfluxDBRetention, database string) retention {
+	r := retention{
+		rawRetentionPolicy: config.Raw,
+		retentionPolicy5m:  config.Resolution5m,
+		retentionPolicy1h:  config.Resolution1h,
+	}
+	for _, cluster := range config.Clusters {
+		if monitorutil.RenameInfluxDB(cluster.ClusterName) != database {
+			continue
+		}
+		if cluster.Raw != "" {
+			r[rawRetentionPolicy] = cluster.Raw
+		}
+		if cluster.Resolution5m != "" {
+			r[retentionPolicy5m] = cluster.Resolution5m
+		}
+		if cluster.Resolution1h != "" {
+			r[retentionPolicy1h] = cluster.Resolution1h
+		}
+	}
+	return r
+}
+
+// parseDuration parses the duration of retention policy such as 7d, 2w and
+// INF, INF is returned as zero like influxdb.
+func parseDuration(s string) (time.Duration, error) {
+	if s == infiniteDuration {
+		return 0, nil
+	}
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	switch s[len(s)-1] {
+	case 'h':
+		return time.Duration(n) * time.Hour, nil
+	case 'd':
+		return time.Duration(n) * 24 * time.Hour, nil
+	case 'w':
+		return time.Duration(n) * 7 * 24 * time.Hour, nil
+	default:
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+}
+
+// shardDuration returns the shard group duration of the retention policy
+// duration, which follows the defaults of influxdb.
+func shardDuration(d time.Duration) time.Duration {
+	switch {
+	case d == 0 || d > 180*24*time.Hour:
+		return 7 * 24 * time.Hour
+	case d < 2*24*time.Hour:
+		return time.Hour
+	default:
+		return 24 * time.Hour
+	}
+}
+
+// formatDuration formats the duration as the influxql duration literal.
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return infiniteDuration
+	}
+	if d%time.Hour == 0 {
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	}
+	return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+}
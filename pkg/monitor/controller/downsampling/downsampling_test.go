@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package downsampling
+
+import (
+	"testing"
+	"time"
+
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := map[string]time.Duration{
+		"INF": 0,
+		"12h": 12 * time.Hour,
+		"7d":  7 * 24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+	}
+	for s, expect := range tests {
+		d, err := parseDuration(s)
+		if err != nil {
+			t.Errorf("parse %s: %v", s, err)
+		} else if d != expect {
+			t.Errorf("parse %s: expect %v, got %v", s, expect, d)
+		}
+	}
+	for _, s := range []string{"", "d", "1y", "7x"} {
+		if _, err := parseDuration(s); err == nil {
+			t.Errorf("expect error parsing %q", s)
+		}
+	}
+}
+
+func TestShardDuration(t *testing.T) {
+	tests := map[time.Duration]time.Duration{
+		0:                    7 * 24 * time.Hour,
+		24 * time.Hour:       time.Hour,
+		7 * 24 * time.Hour:   24 * time.Hour,
+		365 * 24 * time.Hour: 7 * 24 * time.Hour,
+	}
+	for d, expect := range tests {
+		if got := shardDuration(d); got != expect {
+			t.Errorf("shard duration of %v: expect %v, got %v", d, expect, got)
+		}
+	}
+}
+
+func TestRetentionOf(t *testing.T) {
+	config := &monitorconfig.InfluxDBRetention{
+		Raw:          "7d",
+		Resolution5m: "30d",
+		Resolution1h: "365d",
+		Clusters: []monitorconfig.InfluxDBClusterRetention{
+			{
+				ClusterName: "cls-a",
+				Raw:         "1d",
+			},
+		},
+	}
+
+	r := retentionOf(config, "cls_a")
+	if r[rawRetentionPolicy] != "1d" || r[retentionPolicy5m] != "30d" || r[retentionPolicy1h] != "365d" {
+		t.Errorf("unexpected retention of cluster %v", r)
+	}
+	r = retentionOf(config, "cls_b")
+	if r[rawRetentionPolicy] != "7d" {
+		t.Errorf("unexpected retention of cluster without override %v", r)
+	}
+}
+
+func TestTierWindow(t *testing.T) {
+	tier := tiers[0]
+	now := time.Date(2020, 1, 1, 10, 3, 0, 0, time.UTC)
+
+	start, end, ok := tier.window(now, time.Time{})
+	if !ok || !end.Equal(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)) || !start.Equal(end.Add(-time.Hour)) {
+		t.Errorf("unexpected first window %v - %v", start, end)
+	}
+
+	if _, _, ok := tier.window(now.Add(time.Minute), end); ok {
+		t.Errorf("expect no window before the next interval completes")
+	}
+
+	start, end, ok = tier.window(now.Add(5*time.Minute), end)
+	if !ok || !start.Equal(time.Date(2020, 1, 1, 9, 55, 0, 0, time.UTC)) || !end.Equal(time.Date(2020, 1, 1, 10, 5, 0, 0, time.UTC)) {
+		t.Errorf("unexpected window %v - %v", start, end)
+	}
+}
+
+func TestTierQuery(t *testing.T) {
+	start := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	expect := `SELECT mean("value") AS "value" INTO "cls_a"."rp_1h".:MEASUREMENT FROM "cls_a"."rp_5m"./.*/ WHERE time >= '2020-01-01T09:00:00Z' AND time < '2020-01-01T10:00:00Z' GROUP BY time(1h), *`
+	if got := tiers[1].query("cls_a", start, start.Add(time.Hour)); got != expect {
+		t.Errorf("expect %s, got %s", expect, got)
+	}
+}
@@ -0,0 +1,336 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package eventalert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	monitorv1informer "tkestack.io/tke/api/client/informers/externalversions/monitor/v1"
+	monitorv1lister "tkestack.io/tke/api/client/listers/monitor/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+	"tkestack.io/tke/pkg/monitor/sharding"
+	platformutil "tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "eventalert"
+)
+
+// clusterWatcher watches the events of a cluster.
+type clusterWatcher struct {
+	stopCh chan struct{}
+}
+
+// Controller watches the events of the clusters which have EventAlertPolicies
+// and sends alerts when the events match the rules of the policies.
+type Controller struct {
+	client         clientset.Interface
+	platformClient platformv1client.PlatformV1Interface
+	queue          workqueue.RateLimitingInterface
+	lister         monitorv1lister.EventAlertPolicyLister
+	listerSynced   cache.InformerSynced
+	sharder        *sharding.Sharder
+	stopCh         <-chan struct{}
+
+	// watchers are keyed by the cluster name.
+	watchers sync.Map
+
+	mu sync.Mutex
+	// windows and lastAlerts are keyed by the policy name and rule name.
+	windows    map[string]*window
+	lastAlerts map[string]time.Time
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, platformClient platformv1client.PlatformV1Interface, policyInformer monitorv1informer.EventAlertPolicyInformer, sharder *sharding.Sharder, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		client:         client,
+		platformClient: platformClient,
+		sharder:        sharder,
+		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		windows:        make(map[string]*window),
+		lastAlerts:     make(map[string]time.Time),
+	}
+
+	if client != nil && client.MonitorV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("eventalert_controller", client.MonitorV1().RESTClient().GetRateLimiter())
+	}
+
+	policyInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueCluster,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueCluster(oldObj)
+				controller.enqueueCluster(newObj)
+			},
+			DeleteFunc: controller.enqueueCluster,
+		},
+		resyncPeriod,
+	)
+	controller.lister = policyInformer.Lister()
+	controller.listerSynced = policyInformer.Informer().HasSynced
+	sharder.AddHandler(controller.enqueueAll)
+
+	return controller
+}
+
+// obj could be an *v1.EventAlertPolicy, or a DeletionFinalStateUnknown marker
+// item, the cluster of the policy is enqueued.
+func (c *Controller) enqueueCluster(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	policy, ok := obj.(*v1.EventAlertPolicy)
+	if !ok {
+		log.Error("Couldn't get event alert policy from object", log.Any("object", obj))
+		return
+	}
+	c.queue.Add(policy.Spec.ClusterName)
+}
+
+// enqueueAll enqueues the clusters of all the policies after the shards of
+// this replica changed.
+func (c *Controller) enqueueAll() {
+	policies, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list event alert policies", log.Err(err))
+		return
+	}
+	for _, policy := range policies {
+		c.queue.Add(policy.Spec.ClusterName)
+	}
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting event alert controller")
+	defer log.Info("Shutting down event alert controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for event alert policy caches to sync")
+	}
+
+	c.stopCh = stopCh
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	c.watchers.Range(func(key, value interface{}) bool {
+		close(value.(*clusterWatcher).stopCh)
+		return true
+	})
+	return nil
+}
+
+// worker processes the queue of objects.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncCluster starts watching the events of the cluster if it has any
+// EventAlertPolicy, and stops watching if the last policy was deleted or the
+// cluster is handled by another replica.
+func (c *Controller) syncCluster(clusterName string) error {
+	policies, err := c.policiesOfCluster(clusterName)
+	if err != nil {
+		return err
+	}
+	if !c.sharder.Owns(clusterName) {
+		policies = nil
+	}
+
+	value, watching := c.watchers.Load(clusterName)
+	if len(policies) == 0 {
+		if watching {
+			log.Info("Stop watching events of cluster", log.String("clusterName", clusterName))
+			close(value.(*clusterWatcher).stopCh)
+			c.watchers.Delete(clusterName)
+		}
+		c.forgetPolicies(clusterName, nil)
+		return nil
+	}
+	c.forgetPolicies(clusterName, policies)
+	if watching {
+		return nil
+	}
+
+	kubeClient, err := platformutil.BuildExternalClientSetWithName(context.Background(), c.platformClient, clusterName)
+	if err != nil {
+		return err
+	}
+
+	log.Info("Start watching events of cluster", log.String("clusterName", clusterName))
+	watcher := &clusterWatcher{stopCh: make(chan struct{})}
+	factory := informers.NewSharedInformerFactory(kubeClient, 0)
+	factory.Core().V1().Events().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if event, ok := obj.(*corev1.Event); ok {
+				c.handleEvent(clusterName, nil, event)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldEvent, ok1 := oldObj.(*corev1.Event)
+			newEvent, ok2 := newObj.(*corev1.Event)
+			if ok1 && ok2 && oldEvent.ResourceVersion != newEvent.ResourceVersion {
+				c.handleEvent(clusterName, oldEvent, newEvent)
+			}
+		},
+	})
+	factory.Start(watcher.stopCh)
+	c.watchers.Store(clusterName, watcher)
+	return nil
+}
+
+func (c *Controller) policiesOfCluster(clusterName string) ([]*v1.EventAlertPolicy, error) {
+	policies, err := c.lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var result []*v1.EventAlertPolicy
+	for _, policy := range policies {
+		if policy.Spec.ClusterName == clusterName && policy.DeletionTimestamp == nil {
+			result = append(result, policy)
+		}
+	}
+	return result, nil
+}
+
+// forgetPolicies drops the windows of the rules which no longer exist in the
+// policies of the cluster.
+func (c *Controller) forgetPolicies(clusterName string, policies []*v1.EventAlertPolicy) {
+	rules := sets.NewString()
+	for _, policy := range policies {
+		for _, rule := range policy.Spec.Rules {
+			rules.Insert(windowKey(policy, &rule))
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.windows {
+		if strings.HasPrefix(key, clusterName+"/") && !rules.Has(key) {
+			delete(c.windows, key)
+			delete(c.lastAlerts, key)
+		}
+	}
+}
+
+func windowKey(policy *v1.EventAlertPolicy, rule *v1.EventAlertRule) string {
+	return policy.Spec.ClusterName + "/" + policy.Name + "/" + rule.Name
+}
+
+// handleEvent counts the event for the rules it matches, and sends alerts for
+// the rules reaching the thresholds.
+func (c *Controller) handleEvent(clusterName string, oldEvent, newEvent *corev1.Event) {
+	policies, err := c.policiesOfCluster(clusterName)
+	if err != nil {
+		log.Error("Failed to list event alert policies", log.String("clusterName", clusterName), log.Err(err))
+		return
+	}
+
+	now := time.Now()
+	occurredAt := eventTime(newEvent)
+	for _, policy := range policies {
+		for i := range policy.Spec.Rules {
+			rule := &policy.Spec.Rules[i]
+			size := time.Duration(rule.WindowSeconds) * time.Second
+			if now.Sub(occurredAt) >= size || !matchRule(rule, newEvent) {
+				continue
+			}
+			count, fire := c.count(policy, rule, occurredAt, occurrences(oldEvent, newEvent), now)
+			if !fire {
+				continue
+			}
+			if err := c.alert(policy, rule, newEvent, count); err != nil {
+				log.Error("Failed to send event alert",
+					log.String("policyName", policy.Name),
+					log.String("ruleName", rule.Name),
+					log.Err(err))
+			}
+		}
+	}
+}
+
+// count records the occurrences of the event for the rule, and returns true
+// if the threshold is reached and the rule is not silenced.
+func (c *Controller) count(policy *v1.EventAlertPolicy, rule *v1.EventAlertRule, occurredAt time.Time, occurrences int32, now time.Time) (int32, bool) {
+	key := windowKey(policy, rule)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w, ok := c.windows[key]
+	if !ok {
+		w = &window{}
+		c.windows[key] = w
+	}
+	count := w.add(occurredAt, occurrences, time.Duration(rule.WindowSeconds)*time.Second)
+	if count < rule.Threshold {
+		return count, false
+	}
+	silence := time.Duration(policy.Spec.SilenceSeconds) * time.Second
+	if last, ok := c.lastAlerts[key]; ok && now.Sub(last) < silence {
+		return count, false
+	}
+	w.reset()
+	c.lastAlerts[key] = now
+	return count, true
+}
@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package eventalert
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+// matchRule returns true if the event matches the reasons, involved object
+// kinds, namespaces and type of the rule.
+func matchRule(rule *v1.EventAlertRule, event *corev1.Event) bool {
+	if !sets.NewString(rule.Reasons...).Has(event.Reason) {
+		return false
+	}
+	if len(rule.InvolvedObjectKinds) > 0 && !sets.NewString(rule.InvolvedObjectKinds...).Has(event.InvolvedObject.Kind) {
+		return false
+	}
+	if len(rule.Namespaces) > 0 && !sets.NewString(rule.Namespaces...).Has(event.InvolvedObject.Namespace) {
+		return false
+	}
+	if rule.Type != "" && rule.Type != event.Type {
+		return false
+	}
+	return true
+}
+
+// eventTime returns the time when the event occurred last.
+func eventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
+
+// occurrences returns the number of the occurrences added by the update of
+// event, a recorder aggregates the repeated events by increasing the count.
+func occurrences(oldEvent, newEvent *corev1.Event) int32 {
+	count := newEvent.Count
+	if oldEvent != nil {
+		count -= oldEvent.Count
+	}
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
+
+type sample struct {
+	at    time.Time
+	count int32
+}
+
+// window counts the occurrences of the events within a sliding window.
+type window struct {
+	samples []sample
+}
+
+// add records count occurrences at the given time and returns the total
+// occurrences within size before the time.
+func (w *window) add(at time.Time, count int32, size time.Duration) int32 {
+	w.samples = append(w.samples, sample{at: at, count: count})
+
+	var total int32
+	samples := w.samples[:0]
+	for _, s := range w.samples {
+		if at.Sub(s.at) >= size {
+			continue
+		}
+		samples = append(samples, s)
+		total += s.count
+	}
+	w.samples = samples
+	return total
+}
+
+// reset drops all samples of the window, so that the rule fires again only
+// when the threshold is reached by the new events.
+func (w *window) reset() {
+	w.samples = nil
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package eventalert
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+)
+
+func TestMatchRule(t *testing.T) {
+	event := &corev1.Event{
+		Reason: "BackOff",
+		Type:   corev1.EventTypeWarning,
+		InvolvedObject: corev1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: "default",
+		},
+	}
+	tests := []struct {
+		name  string
+		rule  v1.EventAlertRule
+		match bool
+	}{
+		{"reason", v1.EventAlertRule{Reasons: []string{"BackOff"}}, true},
+		{"other reason", v1.EventAlertRule{Reasons: []string{"FailedScheduling"}}, false},
+		{"kind", v1.EventAlertRule{Reasons: []string{"BackOff"}, InvolvedObjectKinds: []string{"Pod"}}, true},
+		{"other kind", v1.EventAlertRule{Reasons: []string{"BackOff"}, InvolvedObjectKinds: []string{"Node"}}, false},
+		{"namespace", v1.EventAlertRule{Reasons: []string{"BackOff"}, Namespaces: []string{"kube-system", "default"}}, true},
+		{"other namespace", v1.EventAlertRule{Reasons: []string{"BackOff"}, Namespaces: []string{"kube-system"}}, false},
+		{"type", v1.EventAlertRule{Reasons: []string{"BackOff"}, Type: corev1.EventTypeWarning}, true},
+		{"other type", v1.EventAlertRule{Reasons: []string{"BackOff"}, Type: corev1.EventTypeNormal}, false},
+	}
+	for _, test := range tests {
+		if got := matchRule(&test.rule, event); got != test.match {
+			t.Errorf("%s: expect %v, got %v", test.name, test.match, got)
+		}
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	if got := occurrences(nil, &corev1.Event{Count: 3}); got != 3 {
+		t.Errorf("expect 3 occurrences of new event, got %d", got)
+	}
+	if got := occurrences(&corev1.Event{Count: 3}, &corev1.Event{Count: 5}); got != 2 {
+		t.Errorf("expect 2 occurrences of updated event, got %d", got)
+	}
+	if got := occurrences(&corev1.Event{Count: 3}, &corev1.Event{Count: 3}); got != 1 {
+		t.Errorf("expect at least 1 occurrence, got %d", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	now := time.Now()
+	size := time.Minute
+	w := &window{}
+	if got := w.add(now, 1, size); got != 1 {
+		t.Errorf("expect 1, got %d", got)
+	}
+	if got := w.add(now.Add(30*time.Second), 2, size); got != 3 {
+		t.Errorf("expect 3, got %d", got)
+	}
+	if got := w.add(now.Add(70*time.Second), 1, size); got != 3 {
+		t.Errorf("expect the first sample out of window, got %d", got)
+	}
+	w.reset()
+	if got := w.add(now.Add(80*time.Second), 1, size); got != 1 {
+		t.Errorf("expect 1 after reset, got %d", got)
+	}
+}
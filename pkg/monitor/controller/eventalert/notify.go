@@ -0,0 +1,131 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package eventalert
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+	notifyapi "tkestack.io/tke/cmd/tke-notify-api/app"
+)
+
+const (
+	eventAlarmPolicyType = "event"
+)
+
+// alert and notification mirror the alertmanager webhook payload accepted by
+// the notify api.
+type alert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+}
+
+type notification struct {
+	Status string  `json:"status"`
+	Alerts []alert `json:"alerts"`
+}
+
+var notifyHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+}
+
+// alert sends the alert of the rule to the receivers of the policy through
+// the webhook of notify api, and records it in the status of the policy.
+func (c *Controller) alert(policy *v1.EventAlertPolicy, rule *v1.EventAlertRule, event *corev1.Event, count int32) error {
+	ctx := context.Background()
+	cm, err := c.platformClient.ConfigMaps().Get(ctx, notifyapi.NotifyApiConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	address, ok := cm.Annotations[notifyapi.NotifyAPIAddressKey]
+	if !ok || address == "" {
+		return fmt.Errorf("notify api address not found in configmap %s", notifyapi.NotifyApiConfigMapName)
+	}
+
+	body, err := json.Marshal(newNotification(policy, rule, event, count))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address+"/webhook", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := notifyHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("notify api returned %d: %s", resp.StatusCode, string(data))
+	}
+
+	newPolicy := policy.DeepCopy()
+	newPolicy.Status.LastAlertTime = metav1.Now()
+	newPolicy.Status.LastAlertRule = rule.Name
+	newPolicy.Status.LastAlertMessage = event.Message
+	_, err = c.client.MonitorV1().EventAlertPolicies().UpdateStatus(ctx, newPolicy, metav1.UpdateOptions{})
+	return err
+}
+
+func newNotification(policy *v1.EventAlertPolicy, rule *v1.EventAlertRule, event *corev1.Event, count int32) *notification {
+	notify := policy.Spec.Notify
+	object := event.InvolvedObject
+	return &notification{
+		Status: "firing",
+		Alerts: []alert{{
+			Labels: map[string]string{
+				"alertname":       rule.Name,
+				"alarmPolicyName": policy.Name,
+				"cluster_id":      policy.Spec.ClusterName,
+				"namespace":       object.Namespace,
+				"kind":            object.Kind,
+				"name":            object.Name,
+				"reason":          event.Reason,
+			},
+			Annotations: map[string]string{
+				"notifyWay":         notify.Channel + ":" + notify.Template,
+				"receivers":         strings.Join(notify.Receivers, ","),
+				"receiverGroups":    strings.Join(notify.ReceiverGroups, ","),
+				"alarmPolicyType":   eventAlarmPolicyType,
+				"metricDisplayName": event.Message,
+				"value":             fmt.Sprintf("%d", count),
+				"unit":              " events",
+				"evaluateType":      "ge",
+				"evaluateValue":     fmt.Sprintf("%d", rule.Threshold),
+			},
+			StartsAt: eventTime(event),
+		}},
+	}
+}
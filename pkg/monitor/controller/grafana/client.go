@@ -0,0 +1,225 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package grafana
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const orgIDHeader = "X-Grafana-Org-Id"
+
+// client calls the http api of grafana as the admin.
+type client struct {
+	address    string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+func newClient(address, username, password string) *client {
+	return &client{
+		address:    address,
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type statusError struct {
+	code    int
+	message string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("grafana responded %d: %s", e.code, e.message)
+}
+
+func isNotFound(err error) bool {
+	e, ok := err.(*statusError)
+	return ok && e.code == http.StatusNotFound
+}
+
+// do sends the request to grafana, orgID 0 uses the current organization of
+// the admin.
+func (c *client) do(ctx context.Context, method, path string, orgID int64, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Content-Type", "application/json")
+	if orgID != 0 {
+		req.Header.Set(orgIDHeader, strconv.FormatInt(orgID, 10))
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return &statusError{code: resp.StatusCode, message: string(data)}
+	}
+	if out != nil {
+		return json.Unmarshal(data, out)
+	}
+	return nil
+}
+
+type org struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type orgUser struct {
+	UserID int64  `json:"userId"`
+	Login  string `json:"login"`
+	Role   string `json:"role"`
+}
+
+type user struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+}
+
+type datasource struct {
+	ID             int64                  `json:"id,omitempty"`
+	OrgID          int64                  `json:"orgId,omitempty"`
+	Name           string                 `json:"name"`
+	Type           string                 `json:"type"`
+	URL            string                 `json:"url"`
+	Access         string                 `json:"access"`
+	IsDefault      bool                   `json:"isDefault"`
+	JSONData       map[string]interface{} `json:"jsonData,omitempty"`
+	SecureJSONData map[string]string      `json:"secureJsonData,omitempty"`
+}
+
+// ensureOrg returns the id of the organization, which is created if not
+// exists. The admin becomes the admin of the created organization.
+func (c *client) ensureOrg(ctx context.Context, name string) (int64, error) {
+	var o org
+	err := c.do(ctx, http.MethodGet, "/api/orgs/name/"+url.PathEscape(name), 0, nil, &o)
+	if err == nil {
+		return o.ID, nil
+	}
+	if !isNotFound(err) {
+		return 0, err
+	}
+	var created struct {
+		OrgID int64 `json:"orgId"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/api/orgs", 0, map[string]string{"name": name}, &created); err != nil {
+		return 0, err
+	}
+	return created.OrgID, nil
+}
+
+func (c *client) listOrgs(ctx context.Context) ([]org, error) {
+	var orgs []org
+	if err := c.do(ctx, http.MethodGet, "/api/orgs?perpage=1000", 0, nil, &orgs); err != nil {
+		return nil, err
+	}
+	return orgs, nil
+}
+
+func (c *client) deleteOrg(ctx context.Context, orgID int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/orgs/%d", orgID), 0, nil, nil)
+}
+
+func (c *client) listOrgUsers(ctx context.Context, orgID int64) ([]orgUser, error) {
+	var users []orgUser
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/api/orgs/%d/users", orgID), 0, nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// lookupUser returns nil if the user has never logged in grafana.
+func (c *client) lookupUser(ctx context.Context, login string) (*user, error) {
+	var u user
+	err := c.do(ctx, http.MethodGet, "/api/users/lookup?loginOrEmail="+url.QueryEscape(login), 0, nil, &u)
+	if isNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (c *client) addOrgUser(ctx context.Context, orgID int64, login, role string) error {
+	return c.do(ctx, http.MethodPost, fmt.Sprintf("/api/orgs/%d/users", orgID), 0, map[string]string{
+		"loginOrEmail": login,
+		"role":         role,
+	}, nil)
+}
+
+func (c *client) removeOrgUser(ctx context.Context, orgID, userID int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/orgs/%d/users/%d", orgID, userID), 0, nil, nil)
+}
+
+func (c *client) listDatasources(ctx context.Context, orgID int64) ([]datasource, error) {
+	var datasources []datasource
+	if err := c.do(ctx, http.MethodGet, "/api/datasources", orgID, nil, &datasources); err != nil {
+		return nil, err
+	}
+	return datasources, nil
+}
+
+// applyDatasource creates or updates the datasource with the same name.
+func (c *client) applyDatasource(ctx context.Context, orgID int64, ds datasource, existing []datasource) error {
+	for _, e := range existing {
+		if e.Name == ds.Name {
+			ds.ID = e.ID
+			return c.do(ctx, http.MethodPut, fmt.Sprintf("/api/datasources/%d", e.ID), orgID, ds, nil)
+		}
+	}
+	return c.do(ctx, http.MethodPost, "/api/datasources", orgID, ds, nil)
+}
+
+func (c *client) deleteDatasource(ctx context.Context, orgID, id int64) error {
+	return c.do(ctx, http.MethodDelete, fmt.Sprintf("/api/datasources/%d", id), orgID, nil, nil)
+}
+
+// applyDashboard creates or overwrites the dashboard with the same uid.
+func (c *client) applyDashboard(ctx context.Context, orgID int64, dashboard map[string]interface{}) error {
+	return c.do(ctx, http.MethodPost, "/api/dashboards/db", orgID, map[string]interface{}{
+		"dashboard": dashboard,
+		"overwrite": true,
+		"message":   "synced by tke-monitor-controller",
+	}, nil)
+}
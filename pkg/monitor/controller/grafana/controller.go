@@ -0,0 +1,357 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package grafana
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	authversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/apiserver/filter"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	prometheusrule "tkestack.io/tke/pkg/platform/controller/addon/prometheus"
+	platformutil "tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// globalClusterName is the cluster where grafana is deployed.
+	globalClusterName = "global"
+	// platformOrgName is the organization of the platform administrators,
+	// which has the datasources of all clusters.
+	platformOrgName = "platform"
+	// projectOrgPrefix is the name prefix of the organization of project.
+	projectOrgPrefix = "project:"
+	// memberRole is the role of the project members in the organization.
+	memberRole = "Viewer"
+
+	installRetryInterval = 30 * time.Second
+)
+
+// Controller deploys the built-in grafana and keeps its organizations,
+// datasources and dashboards in accordance with the clusters and projects.
+type Controller struct {
+	config         *monitorconfig.Grafana
+	platformClient platformversionedclient.PlatformV1Interface
+	businessClient businessversionedclient.BusinessV1Interface
+	authClient     authversionedclient.AuthV1Interface
+	grafana        *client
+	syncPeriod     time.Duration
+}
+
+// NewController creates a new grafana controller, businessClient and
+// authClient may be nil if the business or auth is not enabled, then only the
+// platform organization is synced.
+func NewController(config *monitorconfig.Grafana, platformClient platformversionedclient.PlatformV1Interface, businessClient businessversionedclient.BusinessV1Interface, authClient authversionedclient.AuthV1Interface, syncPeriod time.Duration) *Controller {
+	address := fmt.Sprintf("http://%s.%s.svc:%d", grafanaName, config.Namespace, grafanaPort)
+	return &Controller{
+		config:         config,
+		platformClient: platformClient,
+		businessClient: businessClient,
+		authClient:     authClient,
+		grafana:        newClient(address, grafanaAdminUser, config.AdminPassword),
+		syncPeriod:     syncPeriod,
+	}
+}
+
+// Run installs grafana to the global cluster and syncs it periodically.
+func (c *Controller) Run(stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+
+	log.Info("Starting grafana controller")
+	defer log.Info("Shutting down grafana controller")
+
+	err := wait.PollImmediateUntil(installRetryInterval, func() (bool, error) {
+		ctx := context.Background()
+		kubeClient, err := platformutil.BuildExternalClientSetWithName(ctx, c.platformClient, globalClusterName)
+		if err != nil {
+			log.Error("Failed to create the client of global cluster", log.Err(err))
+			return false, nil
+		}
+		if err := installGrafana(ctx, kubeClient, c.config); err != nil {
+			log.Error("Failed to install grafana", log.Err(err))
+			return false, nil
+		}
+		return true, nil
+	}, stopCh)
+	if err != nil {
+		return
+	}
+
+	go wait.Until(c.sync, c.syncPeriod, stopCh)
+
+	<-stopCh
+}
+
+func (c *Controller) sync() {
+	ctx := context.Background()
+
+	datasources, err := c.clusterDatasources(ctx)
+	if err != nil {
+		log.Error("Failed to build the datasources of clusters", log.Err(err))
+		return
+	}
+
+	if err := c.syncOrg(ctx, platformOrgName, nil, datasources, nil, true); err != nil {
+		log.Error("Failed to sync the platform organization of grafana", log.Err(err))
+	}
+
+	// the members of projects are bound in tke-auth-api
+	if c.businessClient == nil || c.authClient == nil {
+		return
+	}
+	projects, err := c.businessClient.Projects().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Error("Failed to list projects", log.Err(err))
+		return
+	}
+	orgNames := sets.NewString()
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		if project.Status.Phase == businessv1.ProjectTerminating {
+			continue
+		}
+		orgName := projectOrgPrefix + project.Name
+		orgNames.Insert(orgName)
+
+		var projectDatasources []datasource
+		for _, ds := range datasources {
+			if _, ok := project.Spec.Clusters[ds.Name]; ok {
+				projectDatasources = append(projectDatasources, ds)
+			}
+		}
+		namespaces, err := c.projectNamespaces(ctx, project.Name)
+		if err != nil {
+			log.Error("Failed to list the namespaces of project", log.String("project", project.Name), log.Err(err))
+			continue
+		}
+		members, err := c.projectMembers(ctx, project)
+		if err != nil {
+			log.Error("Failed to list the members of project", log.String("project", project.Name), log.Err(err))
+			continue
+		}
+		if err := c.syncOrg(ctx, orgName, members, projectDatasources, namespaces, false); err != nil {
+			log.Error("Failed to sync the organization of project", log.String("project", project.Name), log.Err(err))
+		}
+	}
+
+	// the organizations of the deleted projects are removed, so that the
+	// members can not see the dashboards any more.
+	orgs, err := c.grafana.listOrgs(ctx)
+	if err != nil {
+		log.Error("Failed to list the organizations of grafana", log.Err(err))
+		return
+	}
+	for _, o := range orgs {
+		if strings.HasPrefix(o.Name, projectOrgPrefix) && !orgNames.Has(o.Name) {
+			if err := c.grafana.deleteOrg(ctx, o.ID); err != nil {
+				log.Error("Failed to delete the organization of grafana", log.String("org", o.Name), log.Err(err))
+			}
+		}
+	}
+}
+
+// syncOrg makes the members, datasources and dashboards of the organization
+// same as desired, the members are nil for the platform organization which
+// is only managed by the admin.
+func (c *Controller) syncOrg(ctx context.Context, name string, members []string, datasources []datasource, namespaces []string, platform bool) error {
+	orgID, err := c.grafana.ensureOrg(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	if !platform {
+		if err := c.syncOrgUsers(ctx, orgID, members); err != nil {
+			return err
+		}
+	}
+
+	existing, err := c.grafana.listDatasources(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	desired := sets.NewString()
+	for i, ds := range datasources {
+		ds.IsDefault = i == 0
+		desired.Insert(ds.Name)
+		if err := c.grafana.applyDatasource(ctx, orgID, ds, existing); err != nil {
+			return fmt.Errorf("apply datasource %s failed: %v", ds.Name, err)
+		}
+	}
+	for _, ds := range existing {
+		if !desired.Has(ds.Name) {
+			if err := c.grafana.deleteDatasource(ctx, orgID, ds.ID); err != nil {
+				return fmt.Errorf("delete datasource %s failed: %v", ds.Name, err)
+			}
+		}
+	}
+
+	for _, t := range dashboardLibrary {
+		if t.platformOnly && !platform {
+			continue
+		}
+		if err := c.grafana.applyDashboard(ctx, orgID, renderDashboard(t, namespaces, platform)); err != nil {
+			return fmt.Errorf("apply dashboard %s failed: %v", t.uid, err)
+		}
+	}
+	return nil
+}
+
+// syncOrgUsers adds the members who have logged in grafana to the
+// organization, and removes the users who are no longer the members. The
+// members who have never logged in are added in the next sync after they
+// log in.
+func (c *Controller) syncOrgUsers(ctx context.Context, orgID int64, members []string) error {
+	users, err := c.grafana.listOrgUsers(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	memberSet := sets.NewString(members...)
+	current := sets.NewString()
+	for _, u := range users {
+		if u.Login == grafanaAdminUser {
+			continue
+		}
+		if !memberSet.Has(u.Login) {
+			if err := c.grafana.removeOrgUser(ctx, orgID, u.UserID); err != nil {
+				return err
+			}
+			continue
+		}
+		current.Insert(u.Login)
+	}
+	for _, member := range memberSet.Difference(current).List() {
+		u, err := c.grafana.lookupUser(ctx, member)
+		if err != nil {
+			return err
+		}
+		if u == nil {
+			continue
+		}
+		if err := c.grafana.addOrgUser(ctx, orgID, member, memberRole); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// projectMembers returns the names of the users bound to the project in
+// tke-auth-api.
+func (c *Controller) projectMembers(ctx context.Context, project *businessv1.Project) ([]string, error) {
+	users := &authv1.UserList{}
+	if err := c.authClient.RESTClient().Get().
+		Resource("projects").
+		Name(project.Name).
+		SubResource("users").
+		SetHeader(filter.HeaderTenantID, project.Spec.TenantID).
+		Do(ctx).Into(users); err != nil {
+		return nil, err
+	}
+	members := sets.NewString()
+	for _, user := range users.Items {
+		members.Insert(user.Spec.Name)
+	}
+	return members.List(), nil
+}
+
+func (c *Controller) projectNamespaces(ctx context.Context, projectName string) ([]string, error) {
+	namespaces, err := c.businessClient.Namespaces(projectName).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	set := sets.NewString()
+	for _, ns := range namespaces.Items {
+		set.Insert(ns.Spec.Namespace)
+	}
+	return set.List(), nil
+}
+
+// clusterDatasources returns the prometheus datasource of each running
+// cluster, which accesses prometheus through the service proxy of the
+// apiserver of the cluster.
+func (c *Controller) clusterDatasources(ctx context.Context) ([]datasource, error) {
+	clusters, err := c.platformClient.Clusters().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var datasources []datasource
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if cluster.Status.Phase != platformv1.ClusterRunning {
+			continue
+		}
+		ds, err := c.clusterDatasource(ctx, cluster)
+		if err != nil {
+			log.Warn("Skip the grafana datasource of cluster", log.String("cluster", cluster.Name), log.Err(err))
+			continue
+		}
+		datasources = append(datasources, *ds)
+	}
+	return datasources, nil
+}
+
+func (c *Controller) clusterDatasource(ctx context.Context, cluster *platformv1.Cluster) (*datasource, error) {
+	host, err := platformutil.ClusterV1Host(cluster)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := platformutil.GetClusterCredentialV1(ctx, c.platformClient, cluster)
+	if err != nil {
+		return nil, err
+	}
+	ds := &datasource{
+		Name:   cluster.Name,
+		Type:   "prometheus",
+		URL:    fmt.Sprintf("https://%s/api/v1/namespaces/%s/services/http:%s:%s/proxy", host, metav1.NamespaceSystem, prometheusrule.PrometheusService, prometheusrule.PrometheusServicePort),
+		Access: "proxy",
+		JSONData: map[string]interface{}{
+			"httpMethod": "POST",
+		},
+		SecureJSONData: map[string]string{},
+	}
+	if credential.CACert != nil {
+		ds.JSONData["tlsAuthWithCACert"] = true
+		ds.SecureJSONData["tlsCACert"] = string(credential.CACert)
+	} else {
+		ds.JSONData["tlsSkipVerify"] = true
+	}
+	switch {
+	case credential.Token != nil:
+		ds.JSONData["httpHeaderName1"] = "Authorization"
+		ds.SecureJSONData["httpHeaderValue1"] = "Bearer " + *credential.Token
+	case credential.ClientCert != nil && credential.ClientKey != nil:
+		ds.JSONData["tlsAuth"] = true
+		ds.SecureJSONData["tlsClientCert"] = string(credential.ClientCert)
+		ds.SecureJSONData["tlsClientKey"] = string(credential.ClientKey)
+	default:
+		return nil, fmt.Errorf("no credential for the cluster")
+	}
+	return ds, nil
+}
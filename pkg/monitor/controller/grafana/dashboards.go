@@ -0,0 +1,203 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package grafana
+
+import (
+	"sort"
+	"strings"
+)
+
+// dashboardPanel is a graph of a dashboard.
+type dashboardPanel struct {
+	title  string
+	expr   string
+	legend string
+	unit   string
+}
+
+// dashboardTemplate is a dashboard of the curated library, the exprs of the
+// panels are filtered by the $namespace variable.
+type dashboardTemplate struct {
+	uid    string
+	title  string
+	panels []dashboardPanel
+	// platformOnly dashboards show the resources of the whole cluster, which
+	// are only synced to the platform organization.
+	platformOnly bool
+}
+
+var dashboardLibrary = []dashboardTemplate{
+	{
+		uid:          "tke-cluster",
+		title:        "TKE / Cluster",
+		platformOnly: true,
+		panels: []dashboardPanel{
+			{title: "CPU Usage", expr: `k8s_cluster_rate_cpu_core_used_cluster`, legend: "cpu", unit: "percent"},
+			{title: "Memory Usage", expr: `k8s_cluster_rate_mem_usage_bytes_cluster`, legend: "memory", unit: "percent"},
+			{title: "Nodes", expr: `k8s_cluster_node_num`, legend: "total", unit: "short"},
+			{title: "Not Ready Nodes", expr: `k8s_cluster_node_not_ready_num`, legend: "not ready", unit: "short"},
+			{title: "Pods", expr: `k8s_cluster_pod_num`, legend: "total", unit: "short"},
+			{title: "Unexpected Workloads", expr: `k8s_cluster_workload_replicas_unexpected_num`, legend: "unexpected", unit: "short"},
+		},
+	},
+	{
+		uid:          "tke-etcd",
+		title:        "TKE / Etcd",
+		platformOnly: true,
+		panels: []dashboardPanel{
+			{title: "Has Leader", expr: `etcd_server_has_leader`, legend: "{{node}}", unit: "short"},
+			{title: "Leader Changes", expr: `sum(increase(etcd_server_leader_changes_seen_total[1h])) by (node)`, legend: "{{node}}", unit: "short"},
+			{title: "WAL Fsync Duration (p99)", expr: `histogram_quantile(0.99, sum(rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])) by (node, le))`, legend: "{{node}}", unit: "s"},
+			{title: "Backend Commit Duration (p99)", expr: `histogram_quantile(0.99, sum(rate(etcd_disk_backend_commit_duration_seconds_bucket[5m])) by (node, le))`, legend: "{{node}}", unit: "s"},
+			{title: "DB Size", expr: `etcd_debugging_mvcc_db_total_size_in_bytes`, legend: "{{node}}", unit: "bytes"},
+			{title: "Failed Proposals", expr: `sum(rate(etcd_server_proposals_failed_total[5m])) by (node)`, legend: "{{node}}", unit: "short"},
+		},
+	},
+	{
+		uid:          "tke-control-plane",
+		title:        "TKE / Control Plane",
+		platformOnly: true,
+		panels: []dashboardPanel{
+			{title: "APIServer Requests", expr: `sum(rate(apiserver_request_total[5m])) by (node, code)`, legend: "{{node}} {{code}}", unit: "reqps"},
+			{title: "APIServer Inflight Requests", expr: `max(apiserver_current_inflight_requests) by (node, request_kind)`, legend: "{{node}} {{request_kind}}", unit: "short"},
+			{title: "APIServer Dropped Requests", expr: `sum(rate(apiserver_dropped_requests_total[5m])) by (node)`, legend: "{{node}}", unit: "reqps"},
+			{title: "APIServer Request Latency (p99)", expr: `histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket{verb!~"WATCH|CONNECT"}[5m])) by (node, le))`, legend: "{{node}}", unit: "s"},
+			{title: "Leaders", expr: `max(leader_election_master_status) by (name, node)`, legend: "{{name}} {{node}}", unit: "short"},
+			{title: "Scheduling Latency", expr: `k8s_component_scheduler_scheduling_latency`, legend: "{{node}}", unit: "µs"},
+		},
+	},
+	{
+		uid:   "tke-namespace",
+		title: "TKE / Namespace",
+		panels: []dashboardPanel{
+			{title: "CPU Used", expr: `k8s_namespace_cpu_core_used{namespace=~"$namespace"}`, legend: "{{namespace}}", unit: "short"},
+			{title: "Memory Used", expr: `k8s_namespace_mem_usage_bytes{namespace=~"$namespace"}`, legend: "{{namespace}}", unit: "bytes"},
+			{title: "Network Receive", expr: `k8s_namespace_network_receive_bytes_bw{namespace=~"$namespace"}`, legend: "{{namespace}}", unit: "Bps"},
+			{title: "Network Transmit", expr: `k8s_namespace_network_transmit_bytes_bw{namespace=~"$namespace"}`, legend: "{{namespace}}", unit: "Bps"},
+			{title: "Storage Requested", expr: `k8s_namespace_pvc_request_bytes{namespace=~"$namespace"}`, legend: "{{namespace}}", unit: "bytes"},
+		},
+	},
+	{
+		uid:   "tke-workload",
+		title: "TKE / Workload",
+		panels: []dashboardPanel{
+			{title: "CPU Used", expr: `k8s_workload_cpu_core_used{namespace=~"$namespace"}`, legend: "{{namespace}}/{{workload_name}}", unit: "short"},
+			{title: "Memory Used", expr: `k8s_workload_mem_usage_bytes{namespace=~"$namespace"}`, legend: "{{namespace}}/{{workload_name}}", unit: "bytes"},
+			{title: "Replicas", expr: `k8s_workload_replicas_current{namespace=~"$namespace"}`, legend: "{{namespace}}/{{workload_name}}", unit: "short"},
+			{title: "Pod Restarts", expr: `k8s_workload_pod_restart_total{namespace=~"$namespace"}`, legend: "{{namespace}}/{{workload_name}}", unit: "short"},
+		},
+	},
+	{
+		uid:   "tke-pod",
+		title: "TKE / Pod",
+		panels: []dashboardPanel{
+			{title: "CPU Used", expr: `k8s_pod_cpu_core_used{namespace=~"$namespace"}`, legend: "{{namespace}}/{{pod_name}}", unit: "short"},
+			{title: "Memory Used", expr: `k8s_pod_mem_usage_bytes{namespace=~"$namespace"}`, legend: "{{namespace}}/{{pod_name}}", unit: "bytes"},
+			{title: "Network Receive", expr: `k8s_pod_network_receive_bytes_bw{namespace=~"$namespace"}`, legend: "{{namespace}}/{{pod_name}}", unit: "Bps"},
+			{title: "Network Transmit", expr: `k8s_pod_network_transmit_bytes_bw{namespace=~"$namespace"}`, legend: "{{namespace}}/{{pod_name}}", unit: "Bps"},
+		},
+	},
+}
+
+// renderDashboard renders the dashboard model of grafana. The namespace
+// variable of the platform organization is queried from the datasource,
+// while that of the project organization only lists the namespaces of the
+// project, so that members do not pick the namespaces of others.
+func renderDashboard(t dashboardTemplate, namespaces []string, platform bool) map[string]interface{} {
+	var namespaceVariable map[string]interface{}
+	if platform {
+		namespaceVariable = map[string]interface{}{
+			"name":       "namespace",
+			"label":      "Namespace",
+			"type":       "query",
+			"datasource": "$datasource",
+			"query":      "label_values(k8s_namespace_cpu_core_used, namespace)",
+			"refresh":    2,
+			"multi":      true,
+			"includeAll": true,
+			"allValue":   ".*",
+		}
+	} else {
+		sorted := append([]string(nil), namespaces...)
+		sort.Strings(sorted)
+		options := make([]map[string]interface{}, 0, len(sorted))
+		for _, namespace := range sorted {
+			options = append(options, map[string]interface{}{"text": namespace, "value": namespace, "selected": false})
+		}
+		// the all value is the alternation of the namespaces instead of .*,
+		// which would match the namespaces of other projects, and a name
+		// which is not a valid namespace if the project has no namespace.
+		allValue := strings.Join(sorted, "|")
+		if allValue == "" {
+			allValue = "-"
+		}
+		namespaceVariable = map[string]interface{}{
+			"name":       "namespace",
+			"label":      "Namespace",
+			"type":       "custom",
+			"query":      strings.Join(sorted, ","),
+			"options":    options,
+			"multi":      true,
+			"includeAll": true,
+			"allValue":   allValue,
+		}
+	}
+
+	panels := make([]map[string]interface{}, 0, len(t.panels))
+	for i, p := range t.panels {
+		panels = append(panels, map[string]interface{}{
+			"id":         i + 1,
+			"title":      p.title,
+			"type":       "graph",
+			"datasource": "$datasource",
+			"gridPos":    map[string]interface{}{"h": 8, "w": 12, "x": (i % 2) * 12, "y": (i / 2) * 8},
+			"targets": []map[string]interface{}{
+				{"expr": p.expr, "legendFormat": p.legend, "refId": "A"},
+			},
+			"yaxes": []map[string]interface{}{
+				{"format": p.unit, "show": true},
+				{"format": "short", "show": false},
+			},
+			"lines":     true,
+			"linewidth": 1,
+		})
+	}
+
+	return map[string]interface{}{
+		"uid":           t.uid,
+		"title":         t.title,
+		"tags":          []string{"tke"},
+		"editable":      false,
+		"schemaVersion": 26,
+		"time":          map[string]interface{}{"from": "now-1h", "to": "now"},
+		"refresh":       "1m",
+		"templating": map[string]interface{}{
+			"list": []map[string]interface{}{
+				{
+					"name":  "datasource",
+					"label": "Cluster",
+					"type":  "datasource",
+					"query": "prometheus",
+				},
+				namespaceVariable,
+			},
+		},
+		"panels": panels,
+	}
+}
@@ -0,0 +1,61 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package grafana
+
+import (
+	"testing"
+)
+
+func namespaceVariable(t *testing.T, dashboard map[string]interface{}) map[string]interface{} {
+	variables := dashboard["templating"].(map[string]interface{})["list"].([]map[string]interface{})
+	for _, v := range variables {
+		if v["name"] == "namespace" {
+			return v
+		}
+	}
+	t.Fatalf("no namespace variable in dashboard %v", dashboard["uid"])
+	return nil
+}
+
+func TestRenderDashboard(t *testing.T) {
+	for _, tmpl := range dashboardLibrary {
+		dashboard := renderDashboard(tmpl, []string{"b", "a"}, false)
+		if dashboard["uid"] != tmpl.uid {
+			t.Errorf("expect uid %s, got %v", tmpl.uid, dashboard["uid"])
+		}
+		if panels := dashboard["panels"].([]map[string]interface{}); len(panels) != len(tmpl.panels) {
+			t.Errorf("expect %d panels, got %d", len(tmpl.panels), len(panels))
+		}
+
+		v := namespaceVariable(t, dashboard)
+		if v["type"] != "custom" || v["query"] != "a,b" || v["allValue"] != "a|b" {
+			t.Errorf("unexpected namespace variable of project %v", v)
+		}
+
+		v = namespaceVariable(t, renderDashboard(tmpl, nil, false))
+		if v["allValue"] != "-" {
+			t.Errorf("expect the namespace variable of project without namespace matches nothing, got %v", v["allValue"])
+		}
+
+		v = namespaceVariable(t, renderDashboard(tmpl, nil, true))
+		if v["type"] != "query" || v["allValue"] != ".*" {
+			t.Errorf("unexpected namespace variable of platform %v", v)
+		}
+	}
+}
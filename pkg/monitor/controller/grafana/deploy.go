@@ -0,0 +1,252 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package grafana
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	"tkestack.io/tke/pkg/monitor/controller/grafana/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	grafanaName           = "tke-grafana"
+	grafanaPort           = 3000
+	grafanaAdminUser      = "admin"
+	grafanaConfigKey      = "grafana.ini"
+	grafanaConfigPath     = "/etc/grafana"
+	grafanaConfigHashAnno = "tkestack.io/grafana-config-hash"
+)
+
+// configForGrafana renders grafana.ini, which logins users by the oauth of
+// tke-auth. Users logging in for the first time are added to the main
+// organization without any datasource, the controller adds them to the
+// organizations of the projects they belong to.
+func configForGrafana(config *monitorconfig.Grafana) string {
+	issuer := strings.TrimSuffix(config.OIDC.IssuerURL, "/")
+	return fmt.Sprintf(`[server]
+http_port = %d
+root_url = %s
+
+[security]
+admin_user = %s
+disable_gravatar = true
+
+[users]
+allow_sign_up = false
+allow_org_create = false
+auto_assign_org = true
+auto_assign_org_id = 1
+auto_assign_org_role = Viewer
+viewers_can_edit = false
+
+[auth]
+disable_signout_menu = false
+oauth_auto_login = false
+
+[auth.anonymous]
+enabled = false
+
+[auth.basic]
+enabled = true
+
+[auth.generic_oauth]
+enabled = true
+name = TKEStack
+allow_sign_up = true
+client_id = %s
+scopes = openid profile email groups
+auth_url = %s/auth
+token_url = %s/token
+api_url = %s/userinfo
+login_attribute_path = name
+tls_skip_verify_insecure = %t
+
+[analytics]
+reporting_enabled = false
+check_for_updates = false
+`, grafanaPort, config.RootURL, grafanaAdminUser, config.OIDC.ClientID,
+		issuer, issuer, issuer, config.OIDC.InsecureSkipVerify)
+}
+
+func labelsForGrafana() map[string]string {
+	return map[string]string{"app": grafanaName}
+}
+
+func secretForGrafana(config *monitorconfig.Grafana) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      grafanaName,
+			Namespace: config.Namespace,
+			Labels:    labelsForGrafana(),
+		},
+		Type: corev1.SecretTypeOpaque,
+		StringData: map[string]string{
+			"admin-password":      config.AdminPassword,
+			"oauth-client-secret": config.OIDC.ClientSecret,
+		},
+	}
+}
+
+func configMapForGrafana(config *monitorconfig.Grafana) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      grafanaName,
+			Namespace: config.Namespace,
+			Labels:    labelsForGrafana(),
+		},
+		Data: map[string]string{
+			grafanaConfigKey: configForGrafana(config),
+		},
+	}
+}
+
+func serviceForGrafana(config *monitorconfig.Grafana) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      grafanaName,
+			Namespace: config.Namespace,
+			Labels:    labelsForGrafana(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labelsForGrafana(),
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: grafanaPort, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+// deploymentForGrafana runs grafana with an emptyDir, all of the
+// organizations, datasources and dashboards are synced by the controller
+// again after grafana restarts.
+func deploymentForGrafana(config *monitorconfig.Grafana, components images.Components) *appsv1.Deployment {
+	secretEnv := func(name, key string) corev1.EnvVar {
+		return corev1.EnvVar{
+			Name: name,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: grafanaName},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	hash := sha256.Sum256([]byte(configForGrafana(config) + config.AdminPassword + config.OIDC.ClientSecret))
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      grafanaName,
+			Namespace: config.Namespace,
+			Labels:    labelsForGrafana(),
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: controllerutil.Int32Ptr(1),
+			Selector: &metav1.LabelSelector{MatchLabels: labelsForGrafana()},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labelsForGrafana(),
+					Annotations: map[string]string{
+						grafanaConfigHashAnno: fmt.Sprintf("%x", hash[:8]),
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "grafana",
+							Image: components.Grafana.FullName(),
+							Env: []corev1.EnvVar{
+								secretEnv("GF_SECURITY_ADMIN_PASSWORD", "admin-password"),
+								secretEnv("GF_AUTH_GENERIC_OAUTH_CLIENT_SECRET", "oauth-client-secret"),
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: grafanaPort},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(128*1024*1024, resource.BinarySI),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(1000, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(1024*1024*1024, resource.BinarySI),
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "config", MountPath: grafanaConfigPath},
+								{Name: "data", MountPath: "/var/lib/grafana"},
+							},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/api/health",
+										Port: intstr.FromString("http"),
+									},
+								},
+								PeriodSeconds: 10,
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: grafanaName},
+								},
+							},
+						},
+						{
+							Name:         "data",
+							VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// installGrafana creates or updates grafana in the global cluster.
+func installGrafana(ctx context.Context, kubeClient kubernetes.Interface, config *monitorconfig.Grafana) error {
+	components := images.Get(images.LatestVersion)
+	if err := apiclient.CreateOrUpdateSecret(ctx, kubeClient, secretForGrafana(config)); err != nil {
+		return fmt.Errorf("apply grafana secret failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateConfigMap(ctx, kubeClient, configMapForGrafana(config)); err != nil {
+		return fmt.Errorf("apply grafana configmap failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateService(ctx, kubeClient, serviceForGrafana(config)); err != nil {
+		return fmt.Errorf("apply grafana service failed: %v", err)
+	}
+	if err := apiclient.CreateOrUpdateDeployment(ctx, kubeClient, deploymentForGrafana(config, components)); err != nil {
+		return fmt.Errorf("apply grafana deployment failed: %v", err)
+	}
+	return nil
+}
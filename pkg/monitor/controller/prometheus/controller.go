@@ -40,6 +40,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/runtime"
@@ -57,7 +58,9 @@ import (
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	notifyapi "tkestack.io/tke/cmd/tke-notify-api/app"
 	controllerutil "tkestack.io/tke/pkg/controller"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
 	"tkestack.io/tke/pkg/monitor/controller/prometheus/images"
+	"tkestack.io/tke/pkg/monitor/sharding"
 	esutil "tkestack.io/tke/pkg/monitor/storage/es/client"
 	monitorutil "tkestack.io/tke/pkg/monitor/util"
 	platformutil "tkestack.io/tke/pkg/platform/util"
@@ -102,6 +105,7 @@ const (
 	prometheusETCDSecret         = "prometheus-etcd"
 	prometheusRuleRecord         = "prometheus-records"
 	PrometheusRuleAlert          = "prometheus-alerts"
+	prometheusRuleControlPlane   = "prometheus-control-plane-alerts"
 	prometheusConfigName         = "prometheus.config.yaml"
 	prometheusImagePath          = "prometheus"
 
@@ -178,23 +182,28 @@ type Controller struct {
 	queue          workqueue.RateLimitingInterface
 	lister         monitorv1lister.PrometheusLister
 	listerSynced   cache.InformerSynced
+	sharder        *sharding.Sharder
 	stopCh         <-chan struct{}
 	// RemoteAddress for prometheus
 	remoteClients []remoteClient
 	remoteType    string
+	// objectStorage deploys thanos to upload metrics to object storage if set
+	objectStorage *monitorconfig.ThanosObjectStorage
 	// NotifyApiAddress
 	notifyAPIAddress string
 }
 
 // NewController creates a new Controller object.
-func NewController(client clientset.Interface, platformClient platformv1client.PlatformV1Interface, prometheusInformer monitorv1informer.PrometheusInformer, resyncPeriod time.Duration, remoteAddress []string, remoteType string) *Controller {
+func NewController(client clientset.Interface, platformClient platformv1client.PlatformV1Interface, prometheusInformer monitorv1informer.PrometheusInformer, sharder *sharding.Sharder, resyncPeriod time.Duration, remoteAddress []string, remoteType string, objectStorage *monitorconfig.ThanosObjectStorage) *Controller {
 	// create the controller so we can inject the enqueue function
 	controller := &Controller{
 		client:         client,
 		platformClient: platformClient,
 		cache:          &prometheusCache{prometheusMap: make(map[string]*cachedPrometheus)},
 		queue:          workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "prometheus"),
+		sharder:        sharder,
 		remoteType:     remoteType,
+		objectStorage:  objectStorage,
 	}
 
 	if client != nil && client.MonitorV1().RESTClient().GetRateLimiter() != nil {
@@ -218,6 +227,7 @@ func NewController(client clientset.Interface, platformClient platformv1client.P
 	)
 	controller.lister = prometheusInformer.Lister()
 	controller.listerSynced = prometheusInformer.Informer().HasSynced
+	sharder.AddHandler(controller.enqueueAll)
 
 	// construct remote client
 	switch remoteType {
@@ -276,6 +286,19 @@ func (c *Controller) enqueuePrometheus(obj interface{}) {
 	c.queue.Add(key)
 }
 
+// enqueueAll enqueues all the prometheuses after the shards of this replica
+// changed.
+func (c *Controller) enqueueAll() {
+	prometheuses, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Error("Failed to list prometheuses", log.Err(err))
+		return
+	}
+	for _, prometheus := range prometheuses {
+		c.enqueuePrometheus(prometheus)
+	}
+}
+
 func (c *Controller) needsUpdate(oldPrometheus *v1.Prometheus, newPrometheus *v1.Prometheus) bool {
 	return !reflect.DeepEqual(oldPrometheus, newPrometheus)
 }
@@ -381,6 +404,12 @@ func (c *Controller) syncPrometheus(key string) error {
 		err = c.processPrometheusDeletion(context.Background(), key)
 	case err != nil:
 		log.Warn("Unable to retrieve prometheus from store", log.String("prome", key), log.Err(err))
+	case !c.sharder.Owns(prometheus.Spec.ClusterName):
+		// the cluster is handled by another replica, stop checking the
+		// health and forget the cache so that the deletion is left to it.
+		log.Info("Prometheus is handled by another replica", log.String("prome", key))
+		c.health.Delete(key)
+		c.cache.delete(key)
 	default:
 		cachedPrometheus = c.cache.getOrCreate(key)
 		err = c.processPrometheusUpdate(context.Background(), cachedPrometheus, prometheus, key)
@@ -744,12 +773,15 @@ func (c *Controller) installPrometheus(ctx context.Context, prometheus *v1.Prome
 	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secretETCDPrometheus(credential), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus-etcd Secret failed: %v", err)
 	}
+	// etcd is scraped with the client certs generated by the baremetal
+	// provider, the credentials of the imported clusters have no certs.
+	withETCD := len(credential.ETCDAPIClientCert) > 0 && len(credential.ETCDAPIClientKey) > 0
 	// Service Prometheus
 	if _, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Create(ctx, servicePrometheus(), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus Service failed: %v", err)
 	}
 	// Secret for prometheus
-	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, createSecretForPrometheus(), metav1.CreateOptions{}); err != nil {
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, createSecretForPrometheus(withETCD), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus Secret failed: %v", err)
 	}
 	// ServiceAccount for prometheus
@@ -772,8 +804,23 @@ func (c *Controller) installPrometheus(ctx context.Context, prometheus *v1.Prome
 	if _, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Create(ctx, alertsForPrometheus(), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus rule alert failed: %v", err)
 	}
+	// default alerts of etcd and the control plane
+	if withETCD {
+		if _, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Create(ctx, controlPlaneAlertsForPrometheus(), metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("create prometheus rule of control plane failed: %v", err)
+		}
+	}
 	// Crd prometheus instance
-	if _, err := mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Create(ctx, createPrometheusCRD(components, prometheus, cluster, remoteWrites, remoteReads, c.remoteType), metav1.CreateOptions{}); err != nil {
+	prometheusCRD := createPrometheusCRD(components, prometheus, cluster, remoteWrites, remoteReads, c.remoteType)
+	if c.objectStorage != nil {
+		log.Infof("Start to create thanos")
+		if err := c.installThanos(ctx, kubeClient, components, cluster); err != nil {
+			return err
+		}
+		prometheusCRD.Spec.Thanos = thanosSpecForPrometheus(components)
+		prometheusCRD.Spec.Retention = thanosPrometheusRetention
+	}
+	if _, err := mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Create(ctx, prometheusCRD, metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus crd instance failed: %v", err)
 	}
 	prometheus.Status.SubVersion[PrometheusService] = components.PrometheusService.Tag
@@ -1265,7 +1312,7 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 				rw.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
 					{
 						SourceLabels: []string{"__name__"},
-						Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|k8s_(.*)|apiserver_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
+						Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|k8s_(.*)|apiserver_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|leader_election_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
 						Action:       "keep",
 					},
 				}
@@ -1281,7 +1328,7 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 			rw.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
 				{
 					SourceLabels: []string{"__name__"},
-					Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|project_(.*)|apiserver_(.*)|k8s_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
+					Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|project_(.*)|apiserver_(.*)|k8s_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|leader_election_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
 					Action:       "keep",
 				},
 			}
@@ -1296,7 +1343,7 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 			rw.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
 				{
 					SourceLabels: []string{"__name__"},
-					Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|project_(.*)|apiserver_(.*)|k8s_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
+					Regex:        "istio_(.*)|envoy_(.*)|pilot_(.*)|project_(.*)|apiserver_(.*)|k8s_(.*)|kube_pod_labels|kube_node_labels|kube_namespace_labels|etcd_(.*)|grpc_(.*)|process_(.*)|scheduler_(.*)|workqueue_(.*)|rest_client_requests_(.*)|leader_election_(.*)|go_goroutines|kubelet_(.*)|volume_manager_(.*)|storage_operation_(.*)|coredns_(.*)|up",
 					Action:       "keep",
 				},
 			}
@@ -1421,8 +1468,8 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 	return monitorV1Prometheus
 }
 
-func createSecretForPrometheus() *corev1.Secret {
-	config := scrapeConfigForPrometheus()
+func createSecretForPrometheus(withETCD bool) *corev1.Secret {
+	config := scrapeConfigForPrometheus(withETCD)
 
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1489,6 +1536,28 @@ func alertsForPrometheus() *monitoringv1.PrometheusRule {
 	}
 }
 
+func controlPlaneAlertsForPrometheus() *monitoringv1.PrometheusRule {
+	reader := strings.NewReader(alertRulesForControlPlane())
+	prometheusRuleSpec := &monitoringv1.PrometheusRuleSpec{}
+	err := yaml.NewYAMLOrJSONDecoder(reader, 4096).Decode(prometheusRuleSpec)
+	if err != nil {
+		log.Error("decode control plane alerts err", log.String("err", err.Error()))
+		return nil
+	}
+	return &monitoringv1.PrometheusRule{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: monitoring.GroupName + "/v1",
+			Kind:       monitoringv1.PrometheusRuleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prometheusRuleControlPlane,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{PrometheusService: PrometheusCRDName, "role": "alert-rules"},
+		},
+		Spec: *prometheusRuleSpec,
+	}
+}
+
 var selectorForAlertManager = metav1.LabelSelector{
 	MatchLabels: map[string]string{"alertmanager": alertManagerCRDName, "app": "alertmanager"},
 }
@@ -2639,6 +2708,7 @@ func (c *Controller) uninstallPrometheus(ctx context.Context, prometheus *v1.Pro
 	if err != nil && !errors.IsNotFound(err) {
 		errs = append(errs, err)
 	}
+	errs = append(errs, uninstallThanos(ctx, kubeClient)...)
 	err = kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, prometheusClusterRoleBinding, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		errs = append(errs, err)
@@ -2664,6 +2734,10 @@ func (c *Controller) uninstallPrometheus(ctx context.Context, prometheus *v1.Pro
 	if err != nil && !errors.IsNotFound(err) {
 		errs = append(errs, err)
 	}
+	err = mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Delete(ctx, prometheusRuleControlPlane, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
 
 	err = mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Delete(ctx, PrometheusCRDName, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
@@ -42,6 +42,7 @@ type Components struct {
 	PrometheusBeatWorkLoad           containerregistry.Image
 	NodeProblemDetector              containerregistry.Image
 	PrometheusAdapter                containerregistry.Image
+	Thanos                           containerregistry.Image
 }
 
 func (c Components) Get(name string) *containerregistry.Image {
@@ -67,6 +68,7 @@ var versionMap = map[string]Components{
 		PrometheusBeatWorkLoad:           containerregistry.Image{Name: "prometheusbeat", Tag: "6.4.1"},
 		NodeProblemDetector:              containerregistry.Image{Name: "node-problem-detector", Tag: "v0.8.2"},
 		PrometheusAdapter:                containerregistry.Image{Name: "k8s-prometheus-adapter", Tag: "v0.8.2"},
+		Thanos:                           containerregistry.Image{Name: "thanos", Tag: "v0.15.0"},
 	},
 }
 
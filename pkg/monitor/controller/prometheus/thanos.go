@@ -0,0 +1,332 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"context"
+	"fmt"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	"gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	monitorconfig "tkestack.io/tke/pkg/monitor/apis/config"
+	"tkestack.io/tke/pkg/monitor/controller/prometheus/images"
+	containerregistryutil "tkestack.io/tke/pkg/util/containerregistry"
+)
+
+const (
+	thanosImagePath           = "thanos"
+	thanosObjectStorageSecret = "thanos-objstore"
+	thanosObjectStorageKey    = "objstore.yml"
+	thanosObjectStoragePath   = "/etc/thanos"
+	thanosStoreService        = "thanos-store"
+	thanosStoreWorkLoad       = "thanos-store"
+	thanosCompactService      = "thanos-compact"
+	thanosCompactWorkLoad     = "thanos-compact"
+	thanosGRPCPort            = 10901
+	thanosHTTPPort            = 10902
+
+	// thanosPrometheusRetention keeps the blocks in prometheus long enough for
+	// the sidecar to upload them, the blocks are cut every 2 hours.
+	thanosPrometheusRetention = "6h"
+)
+
+type thanosBucketConfig struct {
+	Type   string                 `yaml:"type"`
+	Config map[string]interface{} `yaml:"config"`
+}
+
+// objectStorageConfigForThanos renders the bucket config of thanos.
+func objectStorageConfigForThanos(objectStorage *monitorconfig.ThanosObjectStorage) ([]byte, error) {
+	bucketConfig := thanosBucketConfig{Type: objectStorage.Type}
+	switch objectStorage.Type {
+	case "S3":
+		bucketConfig.Config = map[string]interface{}{
+			"bucket":     objectStorage.Bucket,
+			"endpoint":   objectStorage.Endpoint,
+			"region":     objectStorage.Region,
+			"access_key": objectStorage.AccessKey,
+			"secret_key": objectStorage.SecretKey,
+			"insecure":   objectStorage.Insecure,
+		}
+	case "COS":
+		bucketConfig.Config = map[string]interface{}{
+			"bucket":     objectStorage.Bucket,
+			"region":     objectStorage.Region,
+			"app_id":     objectStorage.AppID,
+			"secret_id":  objectStorage.AccessKey,
+			"secret_key": objectStorage.SecretKey,
+		}
+	default:
+		return nil, fmt.Errorf("unsupported object storage type %s", objectStorage.Type)
+	}
+	return yaml.Marshal(bucketConfig)
+}
+
+func createSecretForThanos(objectStorage *monitorconfig.ThanosObjectStorage) (*corev1.Secret, error) {
+	config, err := objectStorageConfigForThanos(objectStorage)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      thanosObjectStorageSecret,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			thanosObjectStorageKey: config,
+		},
+	}, nil
+}
+
+// thanosSpecForPrometheus adds the thanos sidecar to prometheus, which
+// uploads the blocks to the object storage.
+func thanosSpecForPrometheus(components images.Components) *monitoringv1.ThanosSpec {
+	baseImage := containerregistryutil.GetImagePrefix(thanosImagePath)
+	version := components.Thanos.Tag
+	return &monitoringv1.ThanosSpec{
+		BaseImage: &baseImage,
+		Version:   &version,
+		ObjectStorageConfig: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: thanosObjectStorageSecret},
+			Key:                  thanosObjectStorageKey,
+		},
+	}
+}
+
+// selectorForThanos makes store gateway and compactor only handle the blocks
+// uploaded by the prometheus of the cluster, since all clusters share the
+// bucket.
+func selectorForThanos(cluster *platformv1.Cluster) string {
+	return fmt.Sprintf(`[{"action":"keep","source_labels":["cluster_id"],"regex":%q}]`, cluster.Name)
+}
+
+func createServiceForThanosStore() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      thanosStoreService,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{specialLabelName: specialLabelValue, "k8s-app": thanosStoreWorkLoad},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"k8s-app": thanosStoreWorkLoad},
+			Ports: []corev1.ServicePort{
+				{Name: "grpc", Port: thanosGRPCPort, TargetPort: intstr.FromString("grpc"), Protocol: corev1.ProtocolTCP},
+				{Name: "http", Port: thanosHTTPPort, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+func createServiceForThanosCompact() *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      thanosCompactService,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{specialLabelName: specialLabelValue, "k8s-app": thanosCompactWorkLoad},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{"k8s-app": thanosCompactWorkLoad},
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: thanosHTTPPort, TargetPort: intstr.FromString("http"), Protocol: corev1.ProtocolTCP},
+			},
+		},
+	}
+}
+
+func createStatefulSetForThanosStore(components images.Components, cluster *platformv1.Cluster) *appsv1.StatefulSet {
+	args := []string{
+		"store",
+		"--data-dir=/var/thanos/store",
+		fmt.Sprintf("--grpc-address=0.0.0.0:%d", thanosGRPCPort),
+		fmt.Sprintf("--http-address=0.0.0.0:%d", thanosHTTPPort),
+		"--objstore.config-file=" + thanosObjectStoragePath + "/" + thanosObjectStorageKey,
+		"--selector.relabel-config=" + selectorForThanos(cluster),
+	}
+	return statefulSetForThanos(components, thanosStoreWorkLoad, thanosStoreService, args,
+		resourcesForThanos(100, 256, 1000, 2*1024),
+		[]corev1.ContainerPort{
+			{Name: "grpc", ContainerPort: thanosGRPCPort},
+			{Name: "http", ContainerPort: thanosHTTPPort},
+		})
+}
+
+func createStatefulSetForThanosCompact(components images.Components, objectStorage *monitorconfig.ThanosObjectStorage, cluster *platformv1.Cluster) *appsv1.StatefulSet {
+	args := []string{
+		"compact",
+		"--wait",
+		"--data-dir=/var/thanos/compact",
+		fmt.Sprintf("--http-address=0.0.0.0:%d", thanosHTTPPort),
+		"--objstore.config-file=" + thanosObjectStoragePath + "/" + thanosObjectStorageKey,
+		"--selector.relabel-config=" + selectorForThanos(cluster),
+		"--retention.resolution-raw=" + objectStorage.Retention.Raw,
+		"--retention.resolution-5m=" + objectStorage.Retention.Resolution5m,
+		"--retention.resolution-1h=" + objectStorage.Retention.Resolution1h,
+	}
+	if objectStorage.DisableDownsampling {
+		args = append(args, "--downsampling.disable")
+	}
+	return statefulSetForThanos(components, thanosCompactWorkLoad, thanosCompactService, args,
+		resourcesForThanos(100, 512, 1000, 4*1024),
+		[]corev1.ContainerPort{
+			{Name: "http", ContainerPort: thanosHTTPPort},
+		})
+}
+
+// statefulSetForThanos runs a single replica of a thanos component, which
+// keeps its data in an emptyDir since all blocks are in the object storage.
+func statefulSetForThanos(components images.Components, name, service string, args []string, resources corev1.ResourceRequirements, ports []corev1.ContainerPort) *appsv1.StatefulSet {
+	labels := map[string]string{"k8s-app": name}
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels:    map[string]string{specialLabelName: specialLabelValue, "k8s-app": name},
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    controllerutil.Int32Ptr(1),
+			ServiceName: service,
+			Selector:    &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+					Annotations: map[string]string{
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   fmt.Sprintf("%d", thanosHTTPPort),
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:      name,
+							Image:     components.Thanos.FullName(),
+							Args:      args,
+							Ports:     ports,
+							Resources: resources,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "data", MountPath: "/var/thanos"},
+								{Name: "objstore", MountPath: thanosObjectStoragePath, ReadOnly: true},
+							},
+							LivenessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									HTTPGet: &corev1.HTTPGetAction{
+										Path: "/-/healthy",
+										Port: intstr.FromString("http"),
+									},
+								},
+								InitialDelaySeconds: 30,
+								PeriodSeconds:       30,
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name:         "data",
+							VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+						},
+						{
+							Name: "objstore",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: thanosObjectStorageSecret},
+							},
+						},
+					},
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "node-role.kubernetes.io/master",
+							Operator: corev1.TolerationOpExists,
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcesForThanos(requestCPU, requestMemory, limitCPU, limitMemory int64) corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewMilliQuantity(requestCPU, resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(requestMemory*1024*1024, resource.BinarySI),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewMilliQuantity(limitCPU, resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(limitMemory*1024*1024, resource.BinarySI),
+		},
+	}
+}
+
+// installThanos creates the bucket config, store gateway and compactor of
+// the cluster.
+func (c *Controller) installThanos(ctx context.Context, kubeClient kubernetes.Interface, components images.Components, cluster *platformv1.Cluster) error {
+	secret, err := createSecretForThanos(c.objectStorage)
+	if err != nil {
+		return err
+	}
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create thanos object storage secret failed: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Create(ctx, createServiceForThanosStore(), metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create thanos-store service failed: %v", err)
+	}
+	if _, err := kubeClient.AppsV1().StatefulSets(metav1.NamespaceSystem).Create(ctx, createStatefulSetForThanosStore(components, cluster), metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create thanos-store failed: %v", err)
+	}
+	if _, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Create(ctx, createServiceForThanosCompact(), metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create thanos-compact service failed: %v", err)
+	}
+	if _, err := kubeClient.AppsV1().StatefulSets(metav1.NamespaceSystem).Create(ctx, createStatefulSetForThanosCompact(components, c.objectStorage, cluster), metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create thanos-compact failed: %v", err)
+	}
+	return nil
+}
+
+// uninstallThanos deletes the thanos components of the cluster, the blocks
+// in the object storage are kept.
+func uninstallThanos(ctx context.Context, kubeClient kubernetes.Interface) []error {
+	var errs []error
+	for _, name := range []string{thanosStoreWorkLoad, thanosCompactWorkLoad} {
+		err := kubeClient.AppsV1().StatefulSets(metav1.NamespaceSystem).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	for _, name := range []string{thanosStoreService, thanosCompactService} {
+		err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Delete(ctx, name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, err)
+		}
+	}
+	err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, thanosObjectStorageSecret, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
+	return errs
+}
@@ -23,7 +23,10 @@ import (
 	"strings"
 )
 
-func scrapeConfigForPrometheus() string {
+// scrapeConfigForPrometheus returns the additional scrape configs. The etcd
+// of the control plane is scraped only if withETCD, which requires the etcd
+// client certs generated by the baremetal provider.
+func scrapeConfigForPrometheus(withETCD bool) string {
 	cfgStr := `
     # Use kubelet_running_pod_count or kubelet_running_pods to get kube node labels
     - job_name: 'kubernetes-nodes'
@@ -312,12 +315,12 @@ func scrapeConfigForPrometheus() string {
         regex: (.+)
       metric_relabel_configs:
       - source_labels: [ __name__ ]
-        regex: 'scheduler_e2e_scheduling_latency_microseconds_bucket|scheduler_e2e_scheduling_latency_microseconds_sum|scheduler_e2e_scheduling_latency_microseconds_count|scheduler_e2e_scheduling_duration_seconds_(.*)|apiserver_current_inflight_requests|apiserver_dropped_requests_total|apiserver_request_total|apiserver_request_duration_seconds_(.*)|node_sockstat_TCP_inuse|node_network_transmit_bytes_total|node_network_receive_bytes_total|node_filesystem_size_bytes|node_filesystem_avail_bytes|node_disk_written_bytes_total|node_disk_read_bytes_total|node_disk_writes_completed_total|node_disk_reads_completed_total|node_cpu_seconds_total|node_memory_Buffers_bytes|node_memory_Cached_bytes|node_memory_MemTotal_bytes|node_memory_MemFree_bytes|node_boot_time_seconds|node_load1|node_load5|node_load15|node_filefd_allocated|node_filefd_maximum|node_context_switches_total|node_filesystem_free_bytes|node_filesystem_files_free|node_filesystem_files|node_disk_io_time_seconds_total|node_disk_read_time_seconds_total|node_disk_write_time_seconds_total|node_disk_io_time_seconds_total|node_disk_io_time_weighted_seconds_total|node_memory_MemAvailable_bytes|node_vmstat_pgmajfault|node_vmstat_oom_kill|node_network_receive_errs_total|node_network_transmit_errs_total|kubernetes_build_info|workqueue_(.*)|process_cpu_seconds_total|process_resident_memory_bytes|scheduler_schedule_attempts_total|rest_client_requests_total|rest_client_request_latency_seconds_(.*)|go_goroutines'
+        regex: 'scheduler_e2e_scheduling_latency_microseconds_bucket|scheduler_e2e_scheduling_latency_microseconds_sum|scheduler_e2e_scheduling_latency_microseconds_count|scheduler_e2e_scheduling_duration_seconds_(.*)|apiserver_current_inflight_requests|apiserver_dropped_requests_total|apiserver_request_total|apiserver_request_duration_seconds_(.*)|node_sockstat_TCP_inuse|node_network_transmit_bytes_total|node_network_receive_bytes_total|node_filesystem_size_bytes|node_filesystem_avail_bytes|node_disk_written_bytes_total|node_disk_read_bytes_total|node_disk_writes_completed_total|node_disk_reads_completed_total|node_cpu_seconds_total|node_memory_Buffers_bytes|node_memory_Cached_bytes|node_memory_MemTotal_bytes|node_memory_MemFree_bytes|node_boot_time_seconds|node_load1|node_load5|node_load15|node_filefd_allocated|node_filefd_maximum|node_context_switches_total|node_filesystem_free_bytes|node_filesystem_files_free|node_filesystem_files|node_disk_io_time_seconds_total|node_disk_read_time_seconds_total|node_disk_write_time_seconds_total|node_disk_io_time_seconds_total|node_disk_io_time_weighted_seconds_total|node_memory_MemAvailable_bytes|node_vmstat_pgmajfault|node_vmstat_oom_kill|node_network_receive_errs_total|node_network_transmit_errs_total|kubernetes_build_info|workqueue_(.*)|process_cpu_seconds_total|process_resident_memory_bytes|scheduler_schedule_attempts_total|rest_client_requests_total|rest_client_request_latency_seconds_(.*)|go_goroutines|leader_election_master_status|apiserver_current_inqueue_requests|apiserver_registered_watchers'
         action: keep
       - regex: "instance|job|namespace|scope|subresource"
         action: labeldrop
 
-    - job_name: 'tke-etcd'
+    - job_name: 'tke-project-metrics'
       scrape_timeout: 60s
       honor_labels: false
       scheme: https
@@ -325,19 +328,13 @@ func scrapeConfigForPrometheus() string {
       - role: pod
       tls_config:
         insecure_skip_verify: true
-        ca_file: /etc/prometheus/secrets/prometheus-etcd/etcd-ca.crt
-        cert_file: /etc/prometheus/secrets/prometheus-etcd/etcd-client.crt
-        key_file: /etc/prometheus/secrets/prometheus-etcd/etcd-client.key
       relabel_configs:
       - source_labels: [__meta_kubernetes_pod_annotation_tke_prometheus_io_scrape]
         action: keep
         regex: true
-      - source_labels: [__meta_kubernetes_namespace]
-        action: replace
-        target_label: namespace
       - source_labels: [__meta_kubernetes_pod_name]
         action: keep
-        regex: etcd.+
+        regex: tke-monitor-controller.+
       - source_labels: [__meta_kubernetes_pod_name]
         action: replace
         target_label: pod_name
@@ -359,29 +356,27 @@ func scrapeConfigForPrometheus() string {
         regex: (.+)
       metric_relabel_configs:
       - source_labels: [ __name__ ]
-        regex: 'etcd_server_leader_changes_seen_total|etcd_debugging_mvcc_db_total_size_in_bytes|etcd_disk_wal_fsync_duration_seconds(.*)|etcd_disk_backend_commit_duration_seconds(.*)|etcd_network_peer_round_trip_time_seconds(.*)|etcd_server_version|etcd_server_has_leader|grpc_server_started_total|grpc_server_handled_total|etcd_server_proposals_failed_total|etcd_server_proposals_pending|etcd_server_proposals_committed_total|etcd_server_proposals_applied_total|process_resident_memory_bytes|etcd_debugging_snap_save_total_duration_seconds_sum|etcd_network_peer_sent_bytes_total|etcd_network_peer_received_bytes_total|etcd_network_client_grpc_sent_bytes_total|etcd_network_client_grpc_received_bytes_total'
+        regex: 'project_(.*)'
         action: keep
-      - regex: "instance|job|pod_name|namespace|scope|subresource"
+      - regex: "instance|job|pod_name|scope|node|subresource"
         action: labeldrop
 
-    - job_name: 'tke-project-metrics'
+    - job_name: 'node-problem-detector'
       scrape_timeout: 60s
       honor_labels: false
-      scheme: https
       kubernetes_sd_configs:
       - role: pod
       tls_config:
         insecure_skip_verify: true
+        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
       relabel_configs:
       - source_labels: [__meta_kubernetes_pod_annotation_tke_prometheus_io_scrape]
         action: keep
         regex: true
       - source_labels: [__meta_kubernetes_pod_name]
         action: keep
-        regex: tke-monitor-controller.+
-      - source_labels: [__meta_kubernetes_pod_name]
-        action: replace
-        target_label: pod_name
+        regex: node-problem-detector.+
       - source_labels: [__meta_kubernetes_pod_node_name]
         action: replace
         target_label: node
@@ -400,27 +395,45 @@ func scrapeConfigForPrometheus() string {
         regex: (.+)
       metric_relabel_configs:
       - source_labels: [ __name__ ]
-        regex: 'project_(.*)'
+        regex: 'problem_(.*)'
         action: keep
-      - regex: "instance|job|pod_name|scope|node|subresource"
+      - regex: "instance|job|pod_name|namespace|scope|subresource"
         action: labeldrop
+`
+	if withETCD {
+		cfgStr += scrapeConfigForETCD()
+	}
+	return cfgStr
+}
 
-    - job_name: 'node-problem-detector'
+// scrapeConfigForETCD scrapes the static pods of etcd with the client certs
+// in the prometheus-etcd secret.
+func scrapeConfigForETCD() string {
+	return `
+    - job_name: 'tke-etcd'
       scrape_timeout: 60s
       honor_labels: false
+      scheme: https
       kubernetes_sd_configs:
       - role: pod
       tls_config:
         insecure_skip_verify: true
-        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
-      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
+        ca_file: /etc/prometheus/secrets/prometheus-etcd/etcd-ca.crt
+        cert_file: /etc/prometheus/secrets/prometheus-etcd/etcd-client.crt
+        key_file: /etc/prometheus/secrets/prometheus-etcd/etcd-client.key
       relabel_configs:
       - source_labels: [__meta_kubernetes_pod_annotation_tke_prometheus_io_scrape]
         action: keep
         regex: true
+      - source_labels: [__meta_kubernetes_namespace]
+        action: replace
+        target_label: namespace
       - source_labels: [__meta_kubernetes_pod_name]
         action: keep
-        regex: node-problem-detector.+
+        regex: etcd.+
+      - source_labels: [__meta_kubernetes_pod_name]
+        action: replace
+        target_label: pod_name
       - source_labels: [__meta_kubernetes_pod_node_name]
         action: replace
         target_label: node
@@ -439,12 +452,11 @@ func scrapeConfigForPrometheus() string {
         regex: (.+)
       metric_relabel_configs:
       - source_labels: [ __name__ ]
-        regex: 'problem_(.*)'
+        regex: 'etcd_server_leader_changes_seen_total|etcd_debugging_mvcc_db_total_size_in_bytes|etcd_disk_wal_fsync_duration_seconds(.*)|etcd_disk_backend_commit_duration_seconds(.*)|etcd_network_peer_round_trip_time_seconds(.*)|etcd_server_version|etcd_server_has_leader|grpc_server_started_total|grpc_server_handled_total|etcd_server_proposals_failed_total|etcd_server_proposals_pending|etcd_server_proposals_committed_total|etcd_server_proposals_applied_total|process_resident_memory_bytes|etcd_debugging_snap_save_total_duration_seconds_sum|etcd_network_peer_sent_bytes_total|etcd_network_peer_received_bytes_total|etcd_network_client_grpc_sent_bytes_total|etcd_network_client_grpc_received_bytes_total'
         action: keep
       - regex: "instance|job|pod_name|namespace|scope|subresource"
         action: labeldrop
 `
-	return cfgStr
 }
 
 func recordRulesForPrometheus() string {
@@ -978,6 +990,9 @@ groups:
   - record: k8s_namespace_gpu_memory_used
     expr: sum(k8s_pod_gpu_memory_used) by (namespace)
 
+  - record: k8s_namespace_pvc_request_bytes
+    expr: sum(kube_persistentvolumeclaim_resource_requests_storage_bytes) by (namespace)
+
   - record: k8s_namespace_rate_gpu_memory_used_cluster
     expr: k8s_namespace_gpu_memory_used * 100 / scalar(k8s_cluster_gpu_memory_total)
 
@@ -1131,6 +1146,9 @@ groups:
   - record: project_namespace_gpu_memory_used
     expr: k8s_namespace_gpu_memory_used* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
 
+  - record: project_namespace_pvc_request_bytes
+    expr: k8s_namespace_pvc_request_bytes* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
+
   - record: project_namespace_network_receive_bytes_bw
     expr: k8s_namespace_network_receive_bytes_bw* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
 
@@ -1231,6 +1249,108 @@ groups:
 	return rules
 }
 
+// alertRulesForControlPlane returns the default alert rules of etcd and the
+// control plane, which are created for the clusters scraping etcd.
+func alertRulesForControlPlane() string {
+	rules := `
+groups:
+- name: tke-control-plane
+  rules:
+  - alert: EtcdNoLeader
+    expr: etcd_server_has_leader == 0
+    for: 1m
+    labels:
+      severity: critical
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'etcd member on {{ $labels.node }} has no leader'
+      value: '{{ $value }}'
+      unit: ''
+      evaluateType: eq
+      evaluateValue: '0'
+
+  - alert: EtcdFrequentLeaderChanges
+    expr: sum(increase(etcd_server_leader_changes_seen_total[1h])) by (node) > 3
+    for: 5m
+    labels:
+      severity: warning
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'etcd leader changes on {{ $labels.node }} within 1h'
+      value: '{{ $value }}'
+      unit: ''
+      evaluateType: gt
+      evaluateValue: '3'
+
+  - alert: EtcdHighFsyncDuration
+    expr: histogram_quantile(0.99, sum(rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])) by (node, le)) > 0.5
+    for: 10m
+    labels:
+      severity: warning
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'etcd wal fsync p99 on {{ $labels.node }}'
+      value: '{{ $value }}'
+      unit: s
+      evaluateType: gt
+      evaluateValue: '0.5'
+
+  - alert: EtcdHighCommitDuration
+    expr: histogram_quantile(0.99, sum(rate(etcd_disk_backend_commit_duration_seconds_bucket[5m])) by (node, le)) > 0.25
+    for: 10m
+    labels:
+      severity: warning
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'etcd backend commit p99 on {{ $labels.node }}'
+      value: '{{ $value }}'
+      unit: s
+      evaluateType: gt
+      evaluateValue: '0.25'
+
+  - alert: APIServerDroppedRequests
+    expr: sum(rate(apiserver_dropped_requests_total[5m])) by (node) > 0
+    for: 5m
+    labels:
+      severity: warning
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'requests dropped by kube-apiserver on {{ $labels.node }}'
+      value: '{{ $value }}'
+      unit: /s
+      evaluateType: gt
+      evaluateValue: '0'
+
+  - alert: APIServerInflightSaturation
+    expr: max(apiserver_current_inflight_requests{request_kind="readOnly"}) by (node) > 320 or max(apiserver_current_inflight_requests{request_kind="mutating"}) by (node) > 160
+    for: 5m
+    labels:
+      severity: warning
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: 'inflight requests of kube-apiserver on {{ $labels.node }}, 80% of the default limits'
+      value: '{{ $value }}'
+      unit: ''
+      evaluateType: gt
+      evaluateValue: '320/160'
+
+  - alert: ControlPlaneNoLeader
+    expr: max(leader_election_master_status) by (name) < 1
+    for: 5m
+    labels:
+      severity: critical
+    annotations:
+      alarmPolicyType: cluster
+      metricDisplayName: '{{ $labels.name }} has no leader'
+      value: '{{ $value }}'
+      unit: ''
+      evaluateType: lt
+      evaluateValue: '1'
+`
+
+	return rules
+}
+
 func configForAlertManager(webhookAddr string, repeatInterval string) string {
 	config := fmt.Sprintf(`
     global:
@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestScrapeConfigForPrometheus(t *testing.T) {
+	if config := scrapeConfigForPrometheus(false); strings.Contains(config, "tke-etcd") {
+		t.Errorf("expect no etcd job without the etcd client certs")
+	}
+	if config := scrapeConfigForPrometheus(true); !strings.Contains(config, "job_name: 'tke-etcd'") {
+		t.Errorf("expect the etcd job with the etcd client certs")
+	}
+}
+
+func TestControlPlaneAlertsForPrometheus(t *testing.T) {
+	rule := controlPlaneAlertsForPrometheus()
+	if rule == nil {
+		t.Fatal("failed to decode the alert rules of control plane")
+	}
+	if len(rule.Spec.Groups) != 1 || len(rule.Spec.Groups[0].Rules) == 0 {
+		t.Fatalf("unexpected groups %v", rule.Spec.Groups)
+	}
+	for _, r := range rule.Spec.Groups[0].Rules {
+		if r.Alert == "" || r.Expr.String() == "" || r.Annotations["alarmPolicyType"] != "cluster" {
+			t.Errorf("unexpected rule %v", r)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package blackboxprobe
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/monitor"
+)
+
+const (
+	// ModuleHTTP probes the urls.
+	ModuleHTTP = "HTTP"
+	// ModuleTCP probes the host:port.
+	ModuleTCP = "TCP"
+	// ModuleICMP pings the hosts.
+	ModuleICMP = "ICMP"
+
+	// MinWindowSeconds is the min window of SLO.
+	MinWindowSeconds = 60
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+var modules = sets.NewString(ModuleHTTP, ModuleTCP, ModuleICMP)
+
+var httpMethods = sets.NewString("GET", "HEAD", "POST", "PUT", "DELETE", "OPTIONS", "PATCH")
+
+// ValidateBlackboxProbe tests if required fields in the blackbox probe are
+// set.
+func ValidateBlackboxProbe(probe *monitor.BlackboxProbe) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&probe.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specFld := field.NewPath("spec")
+	if len(probe.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specFld.Child("clusterName"), "must specify a cluster name"))
+	}
+	if !modules.Has(probe.Spec.Module) {
+		allErrs = append(allErrs, field.NotSupported(specFld.Child("module"), probe.Spec.Module, modules.List()))
+	}
+
+	targetsFld := specFld.Child("targets")
+	if len(probe.Spec.Targets) == 0 {
+		allErrs = append(allErrs, field.Required(targetsFld, "must specify at least one target"))
+	}
+	targets := sets.NewString()
+	for i, target := range probe.Spec.Targets {
+		fld := targetsFld.Index(i)
+		if targets.Has(target) {
+			allErrs = append(allErrs, field.Duplicate(fld, target))
+		}
+		targets.Insert(target)
+		if msg := validateTarget(probe.Spec.Module, target); msg != "" {
+			allErrs = append(allErrs, field.Invalid(fld, target, msg))
+		}
+	}
+
+	if probe.Spec.IntervalSeconds < 1 {
+		allErrs = append(allErrs, field.Invalid(specFld.Child("intervalSeconds"), probe.Spec.IntervalSeconds, "must be greater than 0"))
+	}
+	if probe.Spec.TimeoutSeconds < 1 || probe.Spec.TimeoutSeconds >= probe.Spec.IntervalSeconds {
+		allErrs = append(allErrs, field.Invalid(specFld.Child("timeoutSeconds"), probe.Spec.TimeoutSeconds, "must be greater than 0 and less than the interval"))
+	}
+
+	if probe.Spec.HTTP != nil {
+		httpFld := specFld.Child("http")
+		if probe.Spec.Module != ModuleHTTP {
+			allErrs = append(allErrs, field.Forbidden(httpFld, "only allowed for the HTTP module"))
+		}
+		if !httpMethods.Has(probe.Spec.HTTP.Method) {
+			allErrs = append(allErrs, field.NotSupported(httpFld.Child("method"), probe.Spec.HTTP.Method, httpMethods.List()))
+		}
+		for i, code := range probe.Spec.HTTP.ValidStatusCodes {
+			if code < 100 || code > 599 {
+				allErrs = append(allErrs, field.Invalid(httpFld.Child("validStatusCodes").Index(i), code, "must be between 100 and 599"))
+			}
+		}
+	}
+
+	if probe.Spec.SLO != nil {
+		allErrs = append(allErrs, validateSLO(probe.Spec.SLO, probe.Spec.IntervalSeconds, specFld.Child("slo"))...)
+	}
+
+	return allErrs
+}
+
+// validateTarget returns the message why the target is invalid for the
+// module, or empty if the target is valid.
+func validateTarget(module, target string) string {
+	switch module {
+	case ModuleHTTP:
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be a http or https url"
+		}
+	case ModuleTCP:
+		host, port, err := net.SplitHostPort(target)
+		if err != nil || host == "" || port == "" {
+			return "must be host:port"
+		}
+	case ModuleICMP:
+		if target == "" || strings.Contains(target, "/") || (strings.Contains(target, ":") && net.ParseIP(target) == nil) {
+			return "must be a host or an ip"
+		}
+	}
+	return ""
+}
+
+func validateSLO(slo *monitor.BlackboxProbeSLO, intervalSeconds int32, fld *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	objective, err := strconv.ParseFloat(slo.Objective, 64)
+	if err != nil || objective <= 0 || objective >= 100 {
+		allErrs = append(allErrs, field.Invalid(fld.Child("objective"), slo.Objective, "must be a percentage between 0 and 100, such as 99.9"))
+	}
+	if slo.WindowSeconds < MinWindowSeconds || slo.WindowSeconds < intervalSeconds {
+		allErrs = append(allErrs, field.Invalid(fld.Child("windowSeconds"), slo.WindowSeconds, "must be at least 60 and not less than the interval"))
+	}
+
+	notifyFld := fld.Child("notify")
+	if slo.Notify.Channel == "" {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("channel"), "must specify a channel"))
+	}
+	if slo.Notify.Template == "" {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("template"), "must specify a template"))
+	}
+	if len(slo.Notify.Receivers) == 0 && len(slo.Notify.ReceiverGroups) == 0 {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("receivers"), "must specify receivers or receiver groups"))
+	}
+
+	return allErrs
+}
+
+// ValidateBlackboxProbeUpdate tests if required fields in the blackbox probe
+// are set during an update.
+func ValidateBlackboxProbeUpdate(probe *monitor.BlackboxProbe, old *monitor.BlackboxProbe) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&probe.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateBlackboxProbe(probe)...)
+
+	if probe.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), probe.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if probe.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), probe.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	return allErrs
+}
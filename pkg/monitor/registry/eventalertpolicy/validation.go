@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package eventalertpolicy
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/monitor"
+)
+
+const (
+	// MaxWindowSeconds is the max window counting the events, which are kept
+	// in memory by the controller.
+	MaxWindowSeconds = 24 * 60 * 60
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+var eventTypes = sets.NewString("", "Warning", "Normal")
+
+// ValidateEventAlertPolicy tests if required fields in the event alert policy
+// are set.
+func ValidateEventAlertPolicy(policy *monitor.EventAlertPolicy) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&policy.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specFld := field.NewPath("spec")
+	if len(policy.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specFld.Child("clusterName"), "must specify a cluster name"))
+	}
+
+	rulesFld := specFld.Child("rules")
+	if len(policy.Spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(rulesFld, "must specify at least one rule"))
+	}
+	ruleNames := sets.NewString()
+	for i, rule := range policy.Spec.Rules {
+		fld := rulesFld.Index(i)
+		if rule.Name == "" {
+			allErrs = append(allErrs, field.Required(fld.Child("name"), "must specify a rule name"))
+		} else if ruleNames.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(fld.Child("name"), rule.Name))
+		}
+		ruleNames.Insert(rule.Name)
+		if len(rule.Reasons) == 0 {
+			allErrs = append(allErrs, field.Required(fld.Child("reasons"), "must specify at least one reason"))
+		}
+		if !eventTypes.Has(rule.Type) {
+			allErrs = append(allErrs, field.NotSupported(fld.Child("type"), rule.Type, []string{"Warning", "Normal"}))
+		}
+		if rule.Threshold < 1 {
+			allErrs = append(allErrs, field.Invalid(fld.Child("threshold"), rule.Threshold, "must be greater than 0"))
+		}
+		if rule.WindowSeconds < 1 || rule.WindowSeconds > MaxWindowSeconds {
+			allErrs = append(allErrs, field.Invalid(fld.Child("windowSeconds"), rule.WindowSeconds, "must be between 1 and 86400"))
+		}
+	}
+
+	notifyFld := specFld.Child("notify")
+	if policy.Spec.Notify.Channel == "" {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("channel"), "must specify a channel"))
+	}
+	if policy.Spec.Notify.Template == "" {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("template"), "must specify a template"))
+	}
+	if len(policy.Spec.Notify.Receivers) == 0 && len(policy.Spec.Notify.ReceiverGroups) == 0 {
+		allErrs = append(allErrs, field.Required(notifyFld.Child("receivers"), "must specify receivers or receiver groups"))
+	}
+
+	if policy.Spec.SilenceSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(specFld.Child("silenceSeconds"), policy.Spec.SilenceSeconds, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateEventAlertPolicyUpdate tests if required fields in the event alert
+// policy are set during an update.
+func ValidateEventAlertPolicyUpdate(policy *monitor.EventAlertPolicy, old *monitor.EventAlertPolicy) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&policy.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateEventAlertPolicy(policy)...)
+
+	if policy.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), policy.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if policy.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), policy.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,291 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/api/monitor"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/monitor/registry/federatedquery"
+	"tkestack.io/tke/pkg/monitor/util"
+	prometheusrule "tkestack.io/tke/pkg/platform/controller/addon/prometheus"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// clusterLabel is added to the series to tell the cluster, which is the same
+	// as the external label of remote write.
+	clusterLabel = "cluster_id"
+	// maxConcurrency limits the clusters queried at the same time.
+	maxConcurrency = 10
+	queryTimeout   = 30 * time.Second
+)
+
+// Storage includes storage for federated queries and all sub resources.
+type Storage struct {
+	FederatedQuery *REST
+}
+
+// NewStorage returns a Storage object that will work against federated queries.
+func NewStorage(_ genericregistry.RESTOptionsGetter, platformClient platformversionedclient.PlatformV1Interface) *Storage {
+	return &Storage{
+		FederatedQuery: &REST{
+			platformClient: platformClient,
+		},
+	}
+}
+
+// REST implements a RESTStorage for federated queries against the prometheus
+// of clusters.
+type REST struct {
+	rest.Storage
+	platformClient platformversionedclient.PlatformV1Interface
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+
+// NamespaceScoped returns true if the storage is namespaced
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// New returns an empty object that can be used with Create and Update after request data has been put into it.
+func (r *REST) New() runtime.Object {
+	return &monitor.FederatedQuery{}
+}
+
+// Create fans the query out to the selected clusters and merges the results.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	query, ok := obj.(*monitor.FederatedQuery)
+	if !ok {
+		return nil, errors.NewBadRequest("failed to processed request body")
+	}
+	if allErrs := federatedquery.ValidateFederatedQuery(query); len(allErrs) > 0 {
+		return nil, errors.NewInvalid(monitor.Kind("FederatedQuery"), query.Name, allErrs)
+	}
+
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	listOptions := metav1.ListOptions{LabelSelector: query.Spec.ClusterSelector}
+	if tenantID != "" {
+		listOptions.FieldSelector = fmt.Sprintf("spec.tenantID=%s", tenantID)
+	}
+	clusterList, err := r.platformClient.Clusters().List(ctx, listOptions)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+
+	result := &monitor.FederatedQueryResult{
+		Series: make([]monitor.FederatedQuerySeries, 0),
+	}
+	clusters := sets.NewString()
+	for _, cls := range clusterList.Items {
+		clusters.Insert(cls.Name)
+	}
+	if len(query.Spec.Clusters) > 0 {
+		requested := sets.NewString(query.Spec.Clusters...)
+		// the clusters of other tenants are reported as not found as well
+		for _, name := range requested.Difference(clusters).List() {
+			result.Errors = append(result.Errors, monitor.FederatedQueryError{
+				ClusterName: name,
+				Message:     fmt.Sprintf("cluster %s not found", name),
+			})
+		}
+		clusters = clusters.Intersection(requested)
+	}
+	log.Infof("create federated query: %s, tenantID: %s, clusters: %v", query.Spec.Query, tenantID, clusters.List())
+
+	var (
+		lock sync.Mutex
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, maxConcurrency)
+	for _, name := range clusters.List() {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(clusterName string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			series, err := r.queryCluster(ctx, clusterName, &query.Spec)
+
+			lock.Lock()
+			defer lock.Unlock()
+			if err != nil {
+				log.Warnf("Failed to query prometheus of cluster %s: %v", clusterName, err)
+				result.Errors = append(result.Errors, monitor.FederatedQueryError{
+					ClusterName: clusterName,
+					Message:     err.Error(),
+				})
+				return
+			}
+			result.Series = append(result.Series, series...)
+		}(name)
+	}
+	wg.Wait()
+
+	sort.SliceStable(result.Series, func(i, j int) bool {
+		return result.Series[i].ClusterName < result.Series[j].ClusterName
+	})
+	sort.Slice(result.Errors, func(i, j int) bool {
+		return result.Errors[i].ClusterName < result.Errors[j].ClusterName
+	})
+	query.Result = result
+	return query, nil
+}
+
+// prometheusResponse is the response of the query api of prometheus.
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSeries struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+	Values [][]interface{}   `json:"values"`
+}
+
+func (r *REST) queryCluster(ctx context.Context, clusterName string, spec *monitor.FederatedQuerySpec) ([]monitor.FederatedQuerySeries, error) {
+	kubeClient, err := util.GetClusterClient(ctx, clusterName, r.platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/query"
+	params := map[string]string{"query": spec.Query}
+	if spec.StartTime > 0 {
+		step := spec.StepSeconds
+		if step == 0 {
+			step = federatedquery.DefaultStepSeconds
+		}
+		path = "/api/v1/query_range"
+		params["start"] = formatTime(spec.StartTime)
+		params["end"] = formatTime(spec.EndTime)
+		params["step"] = strconv.FormatInt(step, 10)
+	} else if spec.Time > 0 {
+		params["time"] = formatTime(spec.Time)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+	body, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).ProxyGet("http",
+		prometheusrule.PrometheusService, prometheusrule.PrometheusServicePort, path, params).DoRaw(ctx)
+	res := &prometheusResponse{}
+	if jsonErr := json.Unmarshal(body, res); jsonErr != nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, jsonErr
+	}
+	if res.Status != "success" {
+		return nil, fmt.Errorf("%s: %s", res.ErrorType, res.Error)
+	}
+	return parseResult(clusterName, res.Data.ResultType, res.Data.Result)
+}
+
+// parseResult converts the vector, matrix and scalar results of prometheus to
+// series with the cluster label.
+func parseResult(clusterName string, resultType string, result json.RawMessage) ([]monitor.FederatedQuerySeries, error) {
+	var items []prometheusSeries
+	switch resultType {
+	case "vector", "matrix":
+		if err := json.Unmarshal(result, &items); err != nil {
+			return nil, err
+		}
+	case "scalar", "string":
+		item := prometheusSeries{}
+		if err := json.Unmarshal(result, &item.Value); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	default:
+		return nil, fmt.Errorf("unexpected result type %s", resultType)
+	}
+
+	series := make([]monitor.FederatedQuerySeries, 0, len(items))
+	for _, item := range items {
+		s := monitor.FederatedQuerySeries{
+			ClusterName: clusterName,
+			Metric:      item.Metric,
+		}
+		if s.Metric == nil {
+			s.Metric = make(map[string]string)
+		}
+		if _, ok := s.Metric[clusterLabel]; !ok {
+			s.Metric[clusterLabel] = clusterName
+		}
+		if item.Value != nil {
+			item.Values = append(item.Values, item.Value)
+		}
+		for _, v := range item.Values {
+			sample, err := parseSample(v)
+			if err != nil {
+				return nil, err
+			}
+			s.Values = append(s.Values, sample)
+		}
+		series = append(series, s)
+	}
+	return series, nil
+}
+
+// parseSample parses the sample of prometheus like [1435781451.781, "1"].
+func parseSample(v []interface{}) (monitor.FederatedQuerySample, error) {
+	if len(v) != 2 {
+		return monitor.FederatedQuerySample{}, fmt.Errorf("invalid sample %v", v)
+	}
+	timestamp, ok := v[0].(float64)
+	if !ok {
+		return monitor.FederatedQuerySample{}, fmt.Errorf("invalid timestamp %v", v[0])
+	}
+	value, ok := v[1].(string)
+	if !ok {
+		return monitor.FederatedQuerySample{}, fmt.Errorf("invalid value %v", v[1])
+	}
+	return monitor.FederatedQuerySample{
+		Timestamp: int64(timestamp * 1000),
+		Value:     value,
+	}, nil
+}
+
+// formatTime formats the time in milliseconds to the seconds accepted by prometheus.
+func formatTime(msec int64) string {
+	return strconv.FormatFloat(float64(msec)/1000, 'f', 3, 64)
+}
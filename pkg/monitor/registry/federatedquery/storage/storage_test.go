@@ -0,0 +1,203 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	restclient "k8s.io/client-go/rest"
+	core "k8s.io/client-go/testing"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	"tkestack.io/tke/api/monitor"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/monitor/util"
+)
+
+type fakeResponse struct {
+	body []byte
+}
+
+func (r *fakeResponse) DoRaw(context.Context) ([]byte, error) {
+	return r.body, nil
+}
+
+func (r *fakeResponse) Stream(context.Context) (io.ReadCloser, error) {
+	return ioutil.NopCloser(bytes.NewReader(r.body)), nil
+}
+
+// fakePrometheus returns a cluster client whose prometheus answers body, the
+// proxied requests are recorded in actions.
+func fakePrometheus(body string, actions *[]core.ProxyGetAction) *kubefake.Clientset {
+	client := kubefake.NewSimpleClientset()
+	client.PrependProxyReactor("services", func(action core.Action) (bool, restclient.ResponseWrapper, error) {
+		*actions = append(*actions, action.(core.ProxyGetAction))
+		return true, &fakeResponse{body: []byte(body)}, nil
+	})
+	return client
+}
+
+func cluster(name string, labels map[string]string) *platformv1.Cluster {
+	return &platformv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec:       platformv1.ClusterSpec{TenantID: "default"},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	const vector = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"__name__":"up"},"value":[1435781451.5,"1"]}]}}`
+	var actionsA, actionsB []core.ProxyGetAction
+	util.ClusterNameToClient.Store("cls-a", fakePrometheus(vector, &actionsA))
+	util.ClusterNameToClient.Store("cls-b", fakePrometheus(`{"status":"error","errorType":"bad_data","error":"parse error"}`, &actionsB))
+	defer util.ClusterNameToClient.Delete("cls-a")
+	defer util.ClusterNameToClient.Delete("cls-b")
+
+	client := fake.NewSimpleClientset(
+		cluster("cls-a", map[string]string{"env": "prod"}),
+		cluster("cls-b", map[string]string{"env": "prod"}),
+		cluster("cls-c", map[string]string{"env": "test"}),
+	)
+	r := NewStorage(nil, client.PlatformV1()).FederatedQuery
+	ctx := genericapirequest.WithUser(context.Background(), &user.DefaultInfo{
+		Name:  "alice",
+		Extra: map[string][]string{oidc.TenantIDKey: {"default"}},
+	})
+
+	query := &monitor.FederatedQuery{Spec: monitor.FederatedQuerySpec{
+		Query:           "up",
+		ClusterSelector: "env=prod",
+		StartTime:       1000,
+		EndTime:         61000,
+	}}
+	obj, err := r.Create(ctx, query, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := obj.(*monitor.FederatedQuery).Result
+	want := []monitor.FederatedQuerySeries{{
+		ClusterName: "cls-a",
+		Metric:      map[string]string{"__name__": "up", clusterLabel: "cls-a"},
+		Values:      []monitor.FederatedQuerySample{{Timestamp: 1435781451500, Value: "1"}},
+	}}
+	if !reflect.DeepEqual(result.Series, want) {
+		t.Errorf("series = %+v, want %+v", result.Series, want)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].ClusterName != "cls-b" || !strings.Contains(result.Errors[0].Message, "parse error") {
+		t.Errorf("errors = %+v, want the error of cls-b", result.Errors)
+	}
+	if len(actionsA) != 1 {
+		t.Fatalf("queried cls-a %d times, want 1", len(actionsA))
+	}
+	wantParams := map[string]string{"query": "up", "start": "1.000", "end": "61.000", "step": "60"}
+	if actionsA[0].GetPath() != "/api/v1/query_range" || !reflect.DeepEqual(actionsA[0].GetParams(), wantParams) {
+		t.Errorf("proxied %s %v, want a range query", actionsA[0].GetPath(), actionsA[0].GetParams())
+	}
+
+	// the requested clusters not found are reported
+	query = &monitor.FederatedQuery{Spec: monitor.FederatedQuerySpec{
+		Query:    "up",
+		Clusters: []string{"cls-a", "cls-missing"},
+		Time:     2000,
+	}}
+	obj, err = r.Create(ctx, query, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result = obj.(*monitor.FederatedQuery).Result
+	if len(result.Series) != 1 || result.Series[0].ClusterName != "cls-a" {
+		t.Errorf("series = %+v, want the series of cls-a", result.Series)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].ClusterName != "cls-missing" {
+		t.Errorf("errors = %+v, want cls-missing not found", result.Errors)
+	}
+	if path, params := actionsA[1].GetPath(), actionsA[1].GetParams(); path != "/api/v1/query" || params["time"] != "2.000" {
+		t.Errorf("proxied %s %v, want an instant query", path, params)
+	}
+}
+
+func TestParseResult(t *testing.T) {
+	tests := []struct {
+		name       string
+		resultType string
+		result     string
+		want       []monitor.FederatedQuerySeries
+		wantErr    bool
+	}{
+		{
+			name:       "matrix",
+			resultType: "matrix",
+			result:     `[{"metric":{"job":"node","cluster_id":"remote"},"values":[[1,"1"],[2.5,"2"]]}]`,
+			want: []monitor.FederatedQuerySeries{{
+				ClusterName: "cls",
+				Metric:      map[string]string{"job": "node", clusterLabel: "remote"},
+				Values:      []monitor.FederatedQuerySample{{Timestamp: 1000, Value: "1"}, {Timestamp: 2500, Value: "2"}},
+			}},
+		},
+		{
+			name:       "scalar",
+			resultType: "scalar",
+			result:     `[1,"3"]`,
+			want: []monitor.FederatedQuerySeries{{
+				ClusterName: "cls",
+				Metric:      map[string]string{clusterLabel: "cls"},
+				Values:      []monitor.FederatedQuerySample{{Timestamp: 1000, Value: "3"}},
+			}},
+		},
+		{
+			name:       "empty vector",
+			resultType: "vector",
+			result:     `[]`,
+			want:       []monitor.FederatedQuerySeries{},
+		},
+		{
+			name:       "invalid sample",
+			resultType: "vector",
+			result:     `[{"metric":{},"value":["1","1"]}]`,
+			wantErr:    true,
+		},
+		{
+			name:       "unexpected type",
+			resultType: "streams",
+			result:     `[]`,
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseResult("cls", tt.resultType, json.RawMessage(tt.result))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseResult() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseResult() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
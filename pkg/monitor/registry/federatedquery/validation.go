@@ -0,0 +1,68 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package federatedquery
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/monitor"
+)
+
+const (
+	// DefaultStepSeconds is the resolution of range queries if not specified.
+	DefaultStepSeconds = 60
+	// maxPoints limits the points of a series the same as prometheus.
+	maxPoints = 11000
+)
+
+// ValidateFederatedQuery tests if required fields in the federated query are set.
+func ValidateFederatedQuery(query *monitor.FederatedQuery) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if query.Spec.Query == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("query"), "must specify a PromQL expression"))
+	}
+
+	if query.Spec.ClusterSelector != "" {
+		if _, err := labels.Parse(query.Spec.ClusterSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("clusterSelector"), query.Spec.ClusterSelector, err.Error()))
+		}
+	}
+
+	if query.Spec.StartTime != 0 || query.Spec.EndTime != 0 {
+		if query.Spec.StartTime <= 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("startTime"), "must specify the start time of a range query"))
+		}
+		if query.Spec.EndTime <= query.Spec.StartTime {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("endTime"), query.Spec.EndTime, "must be later than the start time"))
+		}
+		step := query.Spec.StepSeconds
+		if step == 0 {
+			step = DefaultStepSeconds
+		}
+		if step < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("stepSeconds"), query.Spec.StepSeconds, "must be positive"))
+		} else if (query.Spec.EndTime-query.Spec.StartTime)/1000/step > maxPoints {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("stepSeconds"), query.Spec.StepSeconds, "exceeded maximum resolution of 11000 points per series"))
+		}
+	}
+
+	return allErrs
+}
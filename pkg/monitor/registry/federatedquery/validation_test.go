@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package federatedquery
+
+import (
+	"reflect"
+	"testing"
+
+	"tkestack.io/tke/api/monitor"
+)
+
+func TestValidateFederatedQuery(t *testing.T) {
+	const day = 24 * 3600 * 1000
+	tests := []struct {
+		name string
+		spec monitor.FederatedQuerySpec
+		want []string
+	}{
+		{
+			name: "instant query",
+			spec: monitor.FederatedQuerySpec{Query: "up", ClusterSelector: "env in (prod)"},
+		},
+		{
+			name: "range query",
+			spec: monitor.FederatedQuerySpec{Query: "up", StartTime: 1, EndTime: day},
+		},
+		{
+			name: "no query",
+			want: []string{"spec.query"},
+		},
+		{
+			name: "invalid selector",
+			spec: monitor.FederatedQuerySpec{Query: "up", ClusterSelector: "env in prod"},
+			want: []string{"spec.clusterSelector"},
+		},
+		{
+			name: "no start time",
+			spec: monitor.FederatedQuerySpec{Query: "up", EndTime: day},
+			want: []string{"spec.startTime"},
+		},
+		{
+			name: "end before start",
+			spec: monitor.FederatedQuerySpec{Query: "up", StartTime: day, EndTime: 1},
+			want: []string{"spec.endTime"},
+		},
+		{
+			name: "negative step",
+			spec: monitor.FederatedQuerySpec{Query: "up", StartTime: 1, EndTime: day, StepSeconds: -1},
+			want: []string{"spec.stepSeconds"},
+		},
+		{
+			name: "too many points",
+			spec: monitor.FederatedQuerySpec{Query: "up", StartTime: 1, EndTime: 30 * day},
+			want: []string{"spec.stepSeconds"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidateFederatedQuery(&monitor.FederatedQuery{Spec: tt.spec}) {
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateFederatedQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -29,10 +29,14 @@ import (
 	"tkestack.io/tke/api/monitor"
 	"tkestack.io/tke/api/monitor/v1"
 	"tkestack.io/tke/pkg/apiserver/storage"
+	blackboxprobestorage "tkestack.io/tke/pkg/monitor/registry/blackboxprobe/storage"
 	configmapstorage "tkestack.io/tke/pkg/monitor/registry/configmap/storage"
+	eventalertpolicystorage "tkestack.io/tke/pkg/monitor/registry/eventalertpolicy/storage"
+	federatedquerystorage "tkestack.io/tke/pkg/monitor/registry/federatedquery/storage"
 	metricstorage "tkestack.io/tke/pkg/monitor/registry/metric/storage"
 	clusteroverview "tkestack.io/tke/pkg/monitor/registry/overview/cluster/storage"
 	promstorage "tkestack.io/tke/pkg/monitor/registry/prometheus/storage"
+	projectusage "tkestack.io/tke/pkg/monitor/registry/usage/project/storage"
 	monitorstorage "tkestack.io/tke/pkg/monitor/storage"
 	"tkestack.io/tke/pkg/monitor/util/cache"
 )
@@ -80,9 +84,23 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		clusterOverviewREST := clusteroverview.NewStorage(restOptionsGetter, s.PlatformClient, s.BusinessClient, s.Cacher)
 		storageMap["clusteroverviews"] = clusterOverviewREST.ClusterOverview
 
+		projectUsageREST := projectusage.NewStorage(restOptionsGetter, s.MetricStorage, s.BusinessClient)
+		storageMap["projectusages"] = projectUsageREST.ProjectUsage
+
+		federatedQueryREST := federatedquerystorage.NewStorage(restOptionsGetter, s.PlatformClient)
+		storageMap["federatedqueries"] = federatedQueryREST.FederatedQuery
+
 		promREST := promstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
 		storageMap["prometheuses"] = promREST.Prometheus
 		storageMap["prometheuses/status"] = promREST.Status
+
+		eventAlertPolicyREST := eventalertpolicystorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["eventalertpolicies"] = eventAlertPolicyREST.EventAlertPolicy
+		storageMap["eventalertpolicies/status"] = eventAlertPolicyREST.Status
+
+		blackboxProbeREST := blackboxprobestorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["blackboxprobes"] = blackboxProbeREST.BlackboxProbe
+		storageMap["blackboxprobes/status"] = blackboxProbeREST.Status
 	}
 
 	return storageMap
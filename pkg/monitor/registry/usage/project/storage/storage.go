@@ -0,0 +1,328 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	businessversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	"tkestack.io/tke/api/monitor"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/monitor/registry/usage/project"
+	"tkestack.io/tke/pkg/monitor/storage"
+	"tkestack.io/tke/pkg/monitor/storage/types"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const bytesPerGiB = 1 << 30
+
+// usageMetrics are the recording rules which attach the project labels to the
+// usage of namespaces, in the order of cpu, memory, gpu and storage.
+var usageMetrics = []string{
+	"project_namespace_cpu_core_used",
+	"project_namespace_mem_usage_bytes",
+	"project_namespace_gpu_used",
+	"project_namespace_pvc_request_bytes",
+}
+
+// Storage includes storage for project usages and all sub resources.
+type Storage struct {
+	ProjectUsage *REST
+}
+
+// NewStorage returns a Storage object that will work against project usages.
+func NewStorage(_ genericregistry.RESTOptionsGetter, metricStorage storage.MetricStorage,
+	businessClient businessversionedclient.BusinessV1Interface) *Storage {
+	return &Storage{
+		ProjectUsage: &REST{
+			metricStorage:  metricStorage,
+			businessClient: businessClient,
+		},
+	}
+}
+
+// REST implements a RESTStorage for project usages against metric storage.
+type REST struct {
+	rest.Storage
+	metricStorage  storage.MetricStorage
+	businessClient businessversionedclient.BusinessV1Interface
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+
+// NamespaceScoped returns true if the storage is namespaced
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// New returns an empty object that can be used with Create and Update after request data has been put into it.
+func (r *REST) New() runtime.Object {
+	return &monitor.ProjectUsage{}
+}
+
+// Create aggregates the usage of the requested projects.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, _ rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	usage, ok := obj.(*monitor.ProjectUsage)
+	if !ok {
+		return nil, errors.NewBadRequest("failed to processed request body")
+	}
+	if allErrs := project.ValidateProjectUsage(usage); len(allErrs) > 0 {
+		return nil, errors.NewInvalid(monitor.Kind("ProjectUsage"), usage.Name, allErrs)
+	}
+
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	projects, err := r.projects(ctx, tenantID, usage.Spec.Projects)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("create project usage: %v, tenantID: %s, projects: %v", usage.Spec, tenantID, projects)
+
+	step := usage.Spec.StepSeconds
+	if step == 0 {
+		step = project.DefaultStepSeconds
+	}
+	result := &monitor.ProjectUsageResult{
+		Projects: make([]monitor.ProjectUsageSummary, 0, len(projects)),
+	}
+	for _, projectName := range projects {
+		summary, err := r.aggregate(projectName, &usage.Spec, step)
+		if err != nil {
+			return nil, err
+		}
+		result.Projects = append(result.Projects, *summary)
+	}
+	usage.Result = result
+	return usage, nil
+}
+
+// projects returns the requested projects which belong to the tenant, or all
+// projects of the tenant if none is requested.
+func (r *REST) projects(ctx context.Context, tenantID string, names []string) ([]string, error) {
+	if r.businessClient == nil {
+		if len(names) == 0 {
+			return nil, errors.NewBadRequest("the client for Business API Server is not installed, projects must be specified")
+		}
+		return names, nil
+	}
+
+	if len(names) == 0 {
+		listOptions := metav1.ListOptions{}
+		if tenantID != "" {
+			listOptions.FieldSelector = fmt.Sprintf("spec.tenantID=%s", tenantID)
+		}
+		projectList, err := r.businessClient.Projects().List(ctx, listOptions)
+		if err != nil {
+			return nil, errors.NewInternalError(err)
+		}
+		for _, prj := range projectList.Items {
+			names = append(names, prj.Name)
+		}
+		sort.Strings(names)
+		return names, nil
+	}
+
+	for _, name := range names {
+		prj, err := r.businessClient.Projects().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if tenantID != "" && prj.Spec.TenantID != tenantID {
+			return nil, errors.NewNotFound(businessv1.Resource("projects"), name)
+		}
+	}
+	return names, nil
+}
+
+func (r *REST) aggregate(projectName string, spec *monitor.ProjectUsageSpec, step int64) (*monitor.ProjectUsageSummary, error) {
+	fields := make([]string, 0, len(usageMetrics))
+	for _, metric := range usageMetrics {
+		fields = append(fields, fmt.Sprintf("avg(%s)", metric))
+	}
+	startTime, endTime := spec.StartTime, spec.EndTime
+	query := &monitor.MetricQuery{
+		Table:     "project_namespace",
+		StartTime: &startTime,
+		EndTime:   &endTime,
+		Fields:    fields,
+		Conditions: []monitor.MetricQueryCondition{
+			{Key: "project_name", Expr: "=", Value: projectName},
+		},
+		GroupBy: []string{fmt.Sprintf("timestamp(%ds)", step), "cluster_id", "namespace"},
+	}
+	res, err := r.metricStorage.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	return summarize(projectName, res, spec, step), nil
+}
+
+// usageSeconds accumulates the usage multiplied by the seconds it lasts.
+type usageSeconds struct {
+	clusterName string
+	namespace   string
+	values      [4]float64
+}
+
+func (u *usageSeconds) average(seconds float64) monitor.ProjectResourceUsage {
+	return monitor.ProjectResourceUsage{
+		CPU:     u.values[0] / seconds,
+		Memory:  u.values[1] / seconds,
+		GPU:     u.values[2] / seconds,
+		Storage: u.values[3] / seconds,
+	}
+}
+
+func (u *usageSeconds) total() monitor.ProjectResourceUsage {
+	return monitor.ProjectResourceUsage{
+		CPU:     u.values[0] / 3600,
+		Memory:  u.values[1] / 3600 / bytesPerGiB,
+		GPU:     u.values[2] / 3600,
+		Storage: u.values[3] / 3600 / bytesPerGiB,
+	}
+}
+
+func cost(total monitor.ProjectResourceUsage, weights *monitor.ProjectUsageCostWeights) float64 {
+	if weights == nil {
+		return 0
+	}
+	return total.CPU*weights.CPU + total.Memory*weights.Memory + total.GPU*weights.GPU + total.Storage*weights.Storage
+}
+
+// summarize rolls the usage of namespaces in the merged result of metric
+// storage up to the project.
+func summarize(projectName string, res *types.MetricMergedResult, spec *monitor.ProjectUsageSpec, step int64) *monitor.ProjectUsageSummary {
+	columns := make(map[string]int, len(res.Columns))
+	for i, column := range res.Columns {
+		columns[column] = i
+	}
+
+	projectUsage := &usageSeconds{}
+	namespaces := make(map[string]*usageSeconds)
+	points := make(map[int64]*monitor.ProjectResourceUsage)
+	for _, data := range res.Data {
+		row, ok := data.([]interface{})
+		if !ok || len(row) == 0 || row[0] == nil {
+			continue
+		}
+		timestamp, _ := toFloat(row[0])
+		clusterName := columnString(row, columns, "cluster_id")
+		namespace := columnString(row, columns, "namespace")
+		key := clusterName + "/" + namespace
+		ns, ok := namespaces[key]
+		if !ok {
+			ns = &usageSeconds{clusterName: clusterName, namespace: namespace}
+			namespaces[key] = ns
+		}
+		point, ok := points[int64(timestamp)]
+		if !ok {
+			point = &monitor.ProjectResourceUsage{}
+			points[int64(timestamp)] = point
+		}
+
+		var values [4]float64
+		for i, metric := range usageMetrics {
+			index, ok := columns[metric+"_avg"]
+			if !ok || index >= len(row) {
+				continue
+			}
+			values[i], _ = toFloat(row[index])
+			ns.values[i] += values[i] * float64(step)
+			projectUsage.values[i] += values[i] * float64(step)
+		}
+		point.CPU += values[0]
+		point.Memory += values[1]
+		point.GPU += values[2]
+		point.Storage += values[3]
+	}
+
+	seconds := float64(spec.EndTime-spec.StartTime) / 1000
+	summary := &monitor.ProjectUsageSummary{
+		ProjectName: projectName,
+		Average:     projectUsage.average(seconds),
+		Total:       projectUsage.total(),
+		Namespaces:  make([]monitor.ProjectNamespaceUsage, 0, len(namespaces)),
+	}
+	summary.Cost = cost(summary.Total, spec.CostWeights)
+
+	for _, ns := range namespaces {
+		total := ns.total()
+		summary.Namespaces = append(summary.Namespaces, monitor.ProjectNamespaceUsage{
+			ClusterName: ns.clusterName,
+			Namespace:   ns.namespace,
+			Average:     ns.average(seconds),
+			Total:       total,
+			Cost:        cost(total, spec.CostWeights),
+		})
+	}
+	sort.Slice(summary.Namespaces, func(i, j int) bool {
+		if summary.Namespaces[i].ClusterName != summary.Namespaces[j].ClusterName {
+			return summary.Namespaces[i].ClusterName < summary.Namespaces[j].ClusterName
+		}
+		return summary.Namespaces[i].Namespace < summary.Namespaces[j].Namespace
+	})
+
+	if spec.WithSeries {
+		summary.Series = make([]monitor.ProjectUsagePoint, 0, len(points))
+		for timestamp, point := range points {
+			summary.Series = append(summary.Series, monitor.ProjectUsagePoint{
+				Timestamp: timestamp,
+				Usage:     *point,
+			})
+		}
+		sort.Slice(summary.Series, func(i, j int) bool {
+			return summary.Series[i].Timestamp < summary.Series[j].Timestamp
+		})
+	}
+	return summary
+}
+
+func columnString(row []interface{}, columns map[string]int, column string) string {
+	index, ok := columns[column]
+	if !ok || index >= len(row) || row[index] == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", row[index])
+}
+
+// toFloat converts the values of the different metric storages to float64.
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,195 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	businessv1 "tkestack.io/tke/api/business/v1"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	"tkestack.io/tke/api/monitor"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+	"tkestack.io/tke/pkg/monitor/storage/types"
+)
+
+const gib = float64(bytesPerGiB)
+
+type fakeMetricStorage struct {
+	queries []*monitor.MetricQuery
+	result  *types.MetricMergedResult
+}
+
+func (s *fakeMetricStorage) Query(query *monitor.MetricQuery) (*types.MetricMergedResult, error) {
+	s.queries = append(s.queries, query)
+	return s.result, nil
+}
+
+func usageResult() *types.MetricMergedResult {
+	return &types.MetricMergedResult{
+		Columns: []string{
+			"time", "cluster_id", "namespace",
+			"project_namespace_cpu_core_used_avg",
+			"project_namespace_mem_usage_bytes_avg",
+			"project_namespace_gpu_used_avg",
+			"project_namespace_pvc_request_bytes_avg",
+		},
+		Data: []interface{}{
+			[]interface{}{float64(0), "cls-a", "ns-a", float64(2), gib, float64(0), 2 * gib},
+			[]interface{}{json.Number("3600"), "cls-a", "ns-a", json.Number("4"), gib, nil, nil},
+			[]interface{}{int64(0), "cls-b", "ns-b", float64(1), float64(0), float64(1), float64(0)},
+		},
+	}
+}
+
+func userContext(tenantID string) context.Context {
+	return genericapirequest.WithUser(context.Background(), &user.DefaultInfo{
+		Name:  "alice",
+		Extra: map[string][]string{oidc.TenantIDKey: {tenantID}},
+	})
+}
+
+func equal(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestSummarize(t *testing.T) {
+	spec := &monitor.ProjectUsageSpec{
+		StartTime:   0,
+		EndTime:     7200 * 1000,
+		WithSeries:  true,
+		CostWeights: &monitor.ProjectUsageCostWeights{CPU: 0.5, Memory: 0.1, GPU: 2},
+	}
+	summary := summarize("prj-a", usageResult(), spec, 3600)
+
+	if !equal(summary.Total.CPU, 7) || !equal(summary.Total.Memory, 2) || !equal(summary.Total.GPU, 1) || !equal(summary.Total.Storage, 2) {
+		t.Errorf("total = %+v, want 7 core hours, 2 GiB hours memory, 1 gpu hour and 2 GiB hours storage", summary.Total)
+	}
+	if !equal(summary.Average.CPU, 3.5) || !equal(summary.Average.Memory, gib) {
+		t.Errorf("average = %+v, want 3.5 cores and 1 GiB memory", summary.Average)
+	}
+	if !equal(summary.Cost, 7*0.5+2*0.1+1*2) {
+		t.Errorf("cost = %v, want %v", summary.Cost, 7*0.5+2*0.1+1*2)
+	}
+
+	if len(summary.Namespaces) != 2 {
+		t.Fatalf("namespaces = %+v, want 2", summary.Namespaces)
+	}
+	nsA, nsB := summary.Namespaces[0], summary.Namespaces[1]
+	if nsA.ClusterName != "cls-a" || nsA.Namespace != "ns-a" || nsB.ClusterName != "cls-b" {
+		t.Errorf("namespaces are not sorted by cluster and namespace: %+v", summary.Namespaces)
+	}
+	if !equal(nsA.Total.CPU, 6) || !equal(nsA.Average.CPU, 3) || !equal(nsA.Cost, 6*0.5+2*0.1) {
+		t.Errorf("usage of ns-a = %+v", nsA)
+	}
+	if !equal(nsB.Total.CPU, 1) || !equal(nsB.Average.CPU, 0.5) {
+		t.Errorf("usage of ns-b = %+v", nsB)
+	}
+
+	if len(summary.Series) != 2 || summary.Series[0].Timestamp != 0 || summary.Series[1].Timestamp != 3600 {
+		t.Fatalf("series = %+v, want points at 0 and 3600", summary.Series)
+	}
+	if !equal(summary.Series[0].Usage.CPU, 3) || !equal(summary.Series[1].Usage.CPU, 4) {
+		t.Errorf("series = %+v, want 3 and 4 cores", summary.Series)
+	}
+
+	spec.WithSeries = false
+	spec.CostWeights = nil
+	summary = summarize("prj-a", usageResult(), spec, 3600)
+	if summary.Series != nil || summary.Cost != 0 {
+		t.Errorf("got series %v and cost %v without asking", summary.Series, summary.Cost)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&businessv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "prj-b"}, Spec: businessv1.ProjectSpec{TenantID: "default"}},
+		&businessv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "prj-a"}, Spec: businessv1.ProjectSpec{TenantID: "default"}},
+		&businessv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "prj-other"}, Spec: businessv1.ProjectSpec{TenantID: "other"}},
+	)
+	metricStorage := &fakeMetricStorage{result: &types.MetricMergedResult{}}
+	r := NewStorage(nil, metricStorage, client.BusinessV1()).ProjectUsage
+
+	usage := &monitor.ProjectUsage{Spec: monitor.ProjectUsageSpec{
+		Projects:  []string{"prj-a"},
+		StartTime: 1000,
+		EndTime:   3600 * 1000,
+	}}
+	obj, err := r.Create(userContext("default"), usage, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := obj.(*monitor.ProjectUsage).Result
+	if len(result.Projects) != 1 || result.Projects[0].ProjectName != "prj-a" {
+		t.Errorf("result = %+v, want prj-a", result)
+	}
+	if len(metricStorage.queries) != 1 {
+		t.Fatalf("sent %d queries, want 1", len(metricStorage.queries))
+	}
+	query := metricStorage.queries[0]
+	if query.Conditions[0].Key != "project_name" || query.Conditions[0].Value != "prj-a" {
+		t.Errorf("conditions = %+v, want the project prj-a", query.Conditions)
+	}
+	if query.GroupBy[0] != "timestamp(3600s)" {
+		t.Errorf("group by = %v, want the default step", query.GroupBy)
+	}
+
+	// the projects of other tenants are not found
+	usage.Spec.Projects = []string{"prj-other"}
+	if _, err := r.Create(userContext("default"), usage, nil, nil); !errors.IsNotFound(err) {
+		t.Errorf("Create() with project of other tenant error = %v, want not found", err)
+	}
+
+	// all projects are summarized in order if none is requested
+	usage.Spec.Projects = nil
+	obj, err = r.Create(context.Background(), usage, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, summary := range obj.(*monitor.ProjectUsage).Result.Projects {
+		names = append(names, summary.ProjectName)
+	}
+	if len(names) != 3 || names[0] != "prj-a" || names[1] != "prj-b" {
+		t.Errorf("projects = %v, want sorted projects", names)
+	}
+
+	usage.Spec.EndTime = 0
+	if _, err := r.Create(context.Background(), usage, nil, nil); !errors.IsInvalid(err) {
+		t.Errorf("Create() with invalid spec error = %v, want invalid", err)
+	}
+}
+
+func TestCreateWithoutBusiness(t *testing.T) {
+	r := NewStorage(nil, &fakeMetricStorage{result: &types.MetricMergedResult{}}, nil).ProjectUsage
+	usage := &monitor.ProjectUsage{Spec: monitor.ProjectUsageSpec{StartTime: 1000, EndTime: 3600 * 1000}}
+	if _, err := r.Create(context.Background(), usage, nil, nil); !errors.IsBadRequest(err) {
+		t.Errorf("Create() without projects error = %v, want bad request", err)
+	}
+	usage.Spec.Projects = []string{"prj-a"}
+	if _, err := r.Create(context.Background(), usage, nil, nil); err != nil {
+		t.Errorf("Create() error = %v", err)
+	}
+}
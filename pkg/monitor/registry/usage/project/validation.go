@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/monitor"
+)
+
+const (
+	// DefaultStepSeconds is the resolution of the usage series if not specified.
+	DefaultStepSeconds = 3600
+	// minStepSeconds is the scrape resolution of the recording rules.
+	minStepSeconds = 60
+	// maxPoints limits the points of a series the same as prometheus.
+	maxPoints = 11000
+)
+
+// ValidateProjectUsage tests if required fields in the project usage query are set.
+func ValidateProjectUsage(usage *monitor.ProjectUsage) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	for i, name := range usage.Spec.Projects {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(specPath.Child("projects").Index(i), "must specify a project name"))
+		}
+	}
+
+	if usage.Spec.StartTime <= 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("startTime"), "must specify the start time in milliseconds"))
+	}
+	if usage.Spec.EndTime <= usage.Spec.StartTime {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("endTime"), usage.Spec.EndTime, "must be later than the start time"))
+	}
+
+	step := usage.Spec.StepSeconds
+	if step == 0 {
+		step = DefaultStepSeconds
+	}
+	if step < minStepSeconds {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("stepSeconds"), usage.Spec.StepSeconds, "must be at least 60 seconds"))
+	} else if (usage.Spec.EndTime-usage.Spec.StartTime)/1000/step > maxPoints {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("stepSeconds"), usage.Spec.StepSeconds, "exceeded maximum resolution of 11000 points per series"))
+	}
+
+	if w := usage.Spec.CostWeights; w != nil {
+		weightsPath := specPath.Child("costWeights")
+		weights := []struct {
+			name  string
+			value float64
+		}{{"cpu", w.CPU}, {"memory", w.Memory}, {"gpu", w.GPU}, {"storage", w.Storage}}
+		for _, weight := range weights {
+			if weight.value < 0 {
+				allErrs = append(allErrs, field.Invalid(weightsPath.Child(weight.name), weight.value, "must be greater than or equal to 0"))
+			}
+		}
+	}
+
+	return allErrs
+}
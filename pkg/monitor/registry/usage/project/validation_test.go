@@ -0,0 +1,85 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package project
+
+import (
+	"reflect"
+	"testing"
+
+	"tkestack.io/tke/api/monitor"
+)
+
+func TestValidateProjectUsage(t *testing.T) {
+	const day = 24 * 3600 * 1000
+	tests := []struct {
+		name string
+		spec monitor.ProjectUsageSpec
+		want []string
+	}{
+		{
+			name: "default step",
+			spec: monitor.ProjectUsageSpec{Projects: []string{"prj-a"}, StartTime: 1, EndTime: 30 * day},
+		},
+		{
+			name: "empty project",
+			spec: monitor.ProjectUsageSpec{Projects: []string{"prj-a", ""}, StartTime: 1, EndTime: day},
+			want: []string{"spec.projects[1]"},
+		},
+		{
+			name: "no start time",
+			spec: monitor.ProjectUsageSpec{EndTime: day},
+			want: []string{"spec.startTime"},
+		},
+		{
+			name: "end before start",
+			spec: monitor.ProjectUsageSpec{StartTime: day, EndTime: 1},
+			want: []string{"spec.endTime"},
+		},
+		{
+			name: "short step",
+			spec: monitor.ProjectUsageSpec{StartTime: 1, EndTime: day, StepSeconds: 30},
+			want: []string{"spec.stepSeconds"},
+		},
+		{
+			name: "too many points",
+			spec: monitor.ProjectUsageSpec{StartTime: 1, EndTime: 30 * day, StepSeconds: 60},
+			want: []string{"spec.stepSeconds"},
+		},
+		{
+			name: "negative weights",
+			spec: monitor.ProjectUsageSpec{
+				StartTime:   1,
+				EndTime:     day,
+				CostWeights: &monitor.ProjectUsageCostWeights{CPU: 1, Memory: -1, Storage: -0.5},
+			},
+			want: []string{"spec.costWeights.memory", "spec.costWeights.storage"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidateProjectUsage(&monitor.ProjectUsage{Spec: tt.spec}) {
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateProjectUsage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
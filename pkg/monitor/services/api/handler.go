@@ -39,8 +39,9 @@ import (
 )
 
 const (
-	alarmPolicyPrefix = "alarmpolicies"
-	clustersPrefix    = "clusters"
+	alarmPolicyPrefix         = "alarmpolicies"
+	alarmPolicyTemplatePrefix = "alarmpolicytemplates"
+	clustersPrefix            = "clusters"
 )
 
 type processor struct {
@@ -129,9 +130,58 @@ func (h *processor) RegisterWebService(ws *restful.WebService) {
 			Produces(restful.MIME_JSON),
 	)
 
+	templatesPattern := strings.Join([]string{"", alarmPolicyTemplatePrefix}, "/")
+	templatePattern := strings.Join([]string{"", clustersPrefix, "{clusterName}", alarmPolicyTemplatePrefix, "{template}"}, "/")
+
+	ws.Route(
+		ws.GET(templatesPattern).
+			To(h.ListTemplates).
+			Operation("getAllAlarmPolicyTemplates").
+			Doc("Get all alarm policy templates, which are packs of commonly used alarm metrics").
+			Returns(http.StatusOK, "Get", rest.Response{}).
+			Produces(restful.MIME_JSON),
+	)
+
+	ws.Route(
+		ws.POST(templatePattern).
+			To(h.CreateFromTemplate).
+			Param(ws.PathParameter("clusterName", "cluster name").DataType("string").Required(true)).
+			Param(ws.PathParameter("template", "alarm policy template name").DataType("string").Required(true)).
+			Operation("createAlarmPolicyFromTemplate").
+			Doc("Create a alarm policy of tke from the template, the name, namespace and notify settings are taken from the body").
+			Returns(http.StatusOK, "Created", rest.Response{}).
+			Returns(http.StatusBadRequest, "Error", rest.Response{}).
+			Returns(http.StatusNotFound, "Not Found", rest.Response{}).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON),
+	)
+
 	log.Infof("Register monitor web service")
 }
 
+// newRuleGroup builds the prometheus rule group of the alarm policy
+func newRuleGroup(alarmPolicy *rest.AlarmPolicy, name string, version string) *v1.RuleGroup {
+	ruleGroup := &v1.RuleGroup{
+		Name:     name,
+		Interval: alarmPolicy.GetInterval(),
+		Rules:    []v1.Rule{},
+	}
+	if alarmPolicy.AlarmPolicySettings != nil {
+		for i := range alarmPolicy.AlarmPolicySettings.AlarmMetrics {
+			a := alarmPolicy.AlarmPolicySettings.AlarmMetrics[i]
+			rule := v1.Rule{
+				Alert:       a.MetricName,
+				Expr:        intstr.FromString(a.GetExpr(alarmPolicy)),
+				For:         a.GetFor(alarmPolicy.AlarmPolicySettings.StatisticsPeriod),
+				Labels:      a.GetLabels(name, version),
+				Annotations: a.GetAnnotations(alarmPolicy),
+			}
+			ruleGroup.Rules = append(ruleGroup.Rules, rule)
+		}
+	}
+	return ruleGroup
+}
+
 func writeResult(method string, clusterName, entityName string, status int, result *rest.Response, resp *restful.Response) {
 	if status == http.StatusOK {
 		log.Infof("Successfully %s %s(%s)", method, clusterName, entityName)
@@ -180,24 +230,13 @@ func (h *processor) Create(req *restful.Request, resp *restful.Response) {
 
 	entityName = alarmPolicy.AlarmPolicySettings.AlarmPolicyName
 
-	ruleGroup := &v1.RuleGroup{
-		Name:     entityName,
-		Interval: alarmPolicy.GetInterval(),
-		Rules:    []v1.Rule{},
-	}
-	if alarmPolicy.AlarmPolicySettings != nil {
-		for i := range alarmPolicy.AlarmPolicySettings.AlarmMetrics {
-			a := alarmPolicy.AlarmPolicySettings.AlarmMetrics[i]
-			rule := v1.Rule{
-				Alert:       a.MetricName,
-				Expr:        intstr.FromString(a.GetExpr(alarmPolicy)),
-				For:         a.GetFor(alarmPolicy.AlarmPolicySettings.StatisticsPeriod),
-				Labels:      a.GetLabels(entityName, "1"),
-				Annotations: a.GetAnnotations(alarmPolicy),
-			}
-			ruleGroup.Rules = append(ruleGroup.Rules, rule)
-		}
+	err = h.validateExprs(req.Request.Context(), clusterName, alarmPolicy)
+	if err != nil {
+		result.Err = errors.Wrapf(err, "validate alarmPolicy failed").Error()
+		return
 	}
+
+	ruleGroup := newRuleGroup(alarmPolicy, entityName, "1")
 	err = h.prometheusProcessor.CreateGroup(req.Request.Context(), clusterName, entityName, ruleGroup)
 	if err != nil {
 		result.Err = err.Error()
@@ -300,26 +339,13 @@ func (h *processor) Update(req *restful.Request, resp *restful.Response) {
 		return
 	}
 
-	ruleGroup := &v1.RuleGroup{
-		Name:     alarmPolicyName,
-		Interval: alarmPolicy.GetInterval(),
-		Rules:    []v1.Rule{},
-	}
-
-	if alarmPolicy.AlarmPolicySettings != nil {
-		for i := range alarmPolicy.AlarmPolicySettings.AlarmMetrics {
-			r := alarmPolicy.AlarmPolicySettings.AlarmMetrics[i]
-			rule := v1.Rule{
-				Alert:       r.MetricName,
-				Expr:        intstr.FromString(r.GetExpr(alarmPolicy)),
-				For:         r.GetFor(alarmPolicy.AlarmPolicySettings.StatisticsPeriod),
-				Labels:      r.GetLabels(entityName, version),
-				Annotations: r.GetAnnotations(alarmPolicy),
-			}
-			ruleGroup.Rules = append(ruleGroup.Rules, rule)
-		}
+	err = h.validateExprs(req.Request.Context(), clusterName, alarmPolicy)
+	if err != nil {
+		result.Err = errors.Wrapf(err, "validate alarmPolicy failed").Error()
+		return
 	}
 
+	ruleGroup := newRuleGroup(alarmPolicy, alarmPolicyName, version)
 	err = h.prometheusProcessor.UpdateGroup(req.Request.Context(), clusterName, alarmPolicyName, ruleGroup)
 	if err != nil {
 		result.Err = err.Error()
@@ -329,6 +329,71 @@ func TestProcessor_List(t *testing.T) {
 	}
 }
 
+func TestProcessor_CreateFromTemplate(t *testing.T) {
+	ch := make(chan struct{})
+	_, addr, err := createProcessorServer(ch)
+	if err != nil {
+		t.Errorf("can't create processor server, %v", err)
+		return
+	}
+
+	defer func() {
+		close(ch)
+	}()
+
+	template, _ := rest.GetAlarmPolicyTemplate("workload")
+	notifySettings := &rest.NotifySettings{
+		ReceiverGroups: []string{"75061"},
+		Receivers:      []string{"75061"},
+		NotifyWay:      []rest.NotifyWay{{ChannelName: "chan1", TemplateName: "temp1"}},
+	}
+	expectAlarmPolicy := template.NewAlarmPolicy("workload-default", "default", notifySettings)
+	url := fmt.Sprintf("http://%s/api/v1/monitor/%s/%s", addr, clustersPrefix, testClusterName)
+
+	t.Logf("With not existed template")
+	client := gorequest.New().Post(url + "/" + alarmPolicyTemplatePrefix + "/not-existed")
+	setClient(client)
+	resp, _, _ := client.SendStruct(expectAlarmPolicy).End()
+	if resp.StatusCode == http.StatusOK {
+		t.Errorf("creation should failed")
+		return
+	}
+
+	t.Logf("With workload template")
+	client = gorequest.New().Post(url + "/" + alarmPolicyTemplatePrefix + "/workload")
+	setClient(client)
+	resp, body, _ := client.SendStruct(expectAlarmPolicy).End()
+	r := &rest.ResponseForTest{}
+	_ = r.Decode(strings.NewReader(body))
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("creation should success, code: %s, %s", resp.Status, r.Err)
+		return
+	}
+
+	t.Logf("Validate event rule of policy")
+	client = gorequest.New().Get(url + "/" + alarmPolicyPrefix + "/workload-default")
+	setClient(client)
+	resp, body, _ = client.End()
+	r = &rest.ResponseForTest{}
+	_ = r.Decode(strings.NewReader(body))
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("get should success, code: %s, %s", resp.Status, r.Err)
+		return
+	}
+
+	targetAlarmPolicy := &rest.AlarmPolicy{}
+	err = json.Unmarshal(r.Data, targetAlarmPolicy)
+	if err != nil {
+		t.Errorf("can't decode result, %v", err)
+		return
+	}
+
+	if !reflect.DeepEqual(targetAlarmPolicy, expectAlarmPolicy) {
+		t.Errorf("alarm policy not equal, got %v, expect %v", targetAlarmPolicy, expectAlarmPolicy)
+		return
+	}
+}
+
 func init() {
 	logOpts := log.NewOptions()
 	logOpts.EnableCaller = true
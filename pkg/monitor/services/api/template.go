@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+	"github.com/pkg/errors"
+	"tkestack.io/tke/pkg/monitor/services/rest"
+)
+
+func (h *processor) ListTemplates(req *restful.Request, resp *restful.Response) {
+	result := rest.NewResult(true, "")
+	result.Data = rest.ListAlarmPolicyTemplates()
+	_ = resp.WriteHeaderAndEntity(http.StatusOK, result)
+}
+
+func (h *processor) CreateFromTemplate(req *restful.Request, resp *restful.Response) {
+	result := rest.NewResult(false, "")
+	status := http.StatusBadRequest
+
+	var (
+		entityName  string
+		clusterName string
+	)
+
+	defer func() {
+		writeResult("create from template", clusterName, entityName, status, result, resp)
+	}()
+
+	clusterName = req.PathParameter("clusterName")
+	if clusterName == "" {
+		result.Err = "empty clusterName"
+		return
+	}
+
+	templateName := req.PathParameter("template")
+	template, ok := rest.GetAlarmPolicyTemplate(templateName)
+	if !ok {
+		result.Err = errors.Errorf("alarm policy template %s not found", templateName).Error()
+		return
+	}
+
+	body := new(rest.AlarmPolicy)
+	err := req.ReadEntity(body)
+	if err != nil {
+		result.Err = errors.Wrapf(err, "decode request").Error()
+		return
+	}
+
+	entityName = templateName
+	if body.AlarmPolicySettings != nil && body.AlarmPolicySettings.AlarmPolicyName != "" {
+		entityName = body.AlarmPolicySettings.AlarmPolicyName
+	}
+
+	alarmPolicy := template.NewAlarmPolicy(entityName, body.Namespace, body.NotifySettings)
+	err = alarmPolicy.Validate()
+	if err != nil {
+		result.Err = errors.Wrapf(err, "validate alarmPolicy failed").Error()
+		return
+	}
+
+	ruleGroup := newRuleGroup(alarmPolicy, entityName, "1")
+	err = h.prometheusProcessor.CreateGroup(req.Request.Context(), clusterName, entityName, ruleGroup)
+	if err != nil {
+		result.Err = err.Error()
+		return
+	}
+	result.Data = alarmPolicy
+	result.Result = true
+	status = http.StatusOK
+}
@@ -0,0 +1,76 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/pkg/monitor/services/rest"
+	"tkestack.io/tke/pkg/monitor/util"
+	prometheusrule "tkestack.io/tke/pkg/platform/controller/addon/prometheus"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// prometheusErrorBadData is the error type returned by prometheus if the
+// query can't be parsed.
+const prometheusErrorBadData = "bad_data"
+
+type prometheusResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+}
+
+// validateExprs checks the PromQL given by users against the prometheus of
+// the cluster, rules can't be loaded by prometheus if any of them is invalid.
+func (h *processor) validateExprs(ctx context.Context, clusterName string, alarmPolicy *rest.AlarmPolicy) error {
+	var exprs []string
+	for _, m := range alarmPolicy.AlarmPolicySettings.AlarmMetrics {
+		if m.Expr != "" {
+			exprs = append(exprs, m.GetExpr(alarmPolicy))
+		}
+	}
+	if len(exprs) == 0 {
+		return nil
+	}
+
+	kubeClient, err := util.GetClusterClient(ctx, clusterName, h.platformClient)
+	if err != nil {
+		return err
+	}
+
+	for _, expr := range exprs {
+		body, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).ProxyGet("http",
+			prometheusrule.PrometheusService, prometheusrule.PrometheusServicePort, "/api/v1/query",
+			map[string]string{"query": expr}).DoRaw(ctx)
+		if err == nil {
+			continue
+		}
+		res := &prometheusResponse{}
+		if jsonErr := json.Unmarshal(body, res); jsonErr == nil && res.ErrorType == prometheusErrorBadData {
+			return errors.Errorf("invalid expr %s: %s", expr, res.Error)
+		}
+		// the rules are still saved if prometheus is unavailable
+		log.Warnf("Failed to validate expr %s on prometheus of %s: %v", expr, clusterName, err)
+	}
+	return nil
+}
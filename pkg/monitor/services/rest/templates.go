@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package rest
+
+import "sort"
+
+// AlarmPolicyTemplate defines a pack of alarm metrics which is commonly used
+// for a kind of objects
+type AlarmPolicyTemplate struct {
+	Name             string         `json:"Name"`
+	Description      string         `json:"Description"`
+	AlarmPolicyType  string         `json:"AlarmPolicyType"`
+	AlarmObjectsType string         `json:"AlarmObjectsType"`
+	StatisticsPeriod int64          `json:"StatisticsPeriod"`
+	AlarmMetrics     []*AlarmMetric `json:"AlarmMetrics"`
+}
+
+var alarmPolicyTemplates = map[string]*AlarmPolicyTemplate{
+	"node": {
+		Name:             "node",
+		Description:      "Resource usage and readiness of nodes",
+		AlarmPolicyType:  alarmPolicyTypeNode,
+		AlarmObjectsType: "all",
+		StatisticsPeriod: 60,
+		AlarmMetrics: []*AlarmMetric{
+			{Measurement: "k8s_node", MetricName: "k8s_node_cpu_usage", MetricDisplayName: "CPU Usage", ContinuePeriod: 5, Unit: "%", Evaluator: &Evaluator{Type: greaterStr, Value: "80"}},
+			{Measurement: "k8s_node", MetricName: "k8s_node_mem_usage", MetricDisplayName: "Memory Usage", ContinuePeriod: 5, Unit: "%", Evaluator: &Evaluator{Type: greaterStr, Value: "80"}},
+			{Measurement: "k8s_node", MetricName: "k8s_node_disk_space_rate", MetricDisplayName: "Disk Usage", ContinuePeriod: 5, Unit: "%", Evaluator: &Evaluator{Type: greaterStr, Value: "85"}},
+			{Measurement: "k8s_node", MetricName: "k8s_node_status_ready", MetricDisplayName: "Node Ready", ContinuePeriod: 2, Evaluator: &Evaluator{Type: equalStr, Value: "false"}},
+		},
+	},
+	"workload": {
+		Name:             "workload",
+		Description:      "Resource usage, readiness and back-off events of pods",
+		AlarmPolicyType:  alarmPolicyTypePod,
+		AlarmObjectsType: "all",
+		StatisticsPeriod: 60,
+		AlarmMetrics: []*AlarmMetric{
+			{Measurement: "k8s_pod", MetricName: "k8s_pod_rate_cpu_core_used_limit", MetricDisplayName: "CPU Usage Of Limit", ContinuePeriod: 5, Unit: "%", Evaluator: &Evaluator{Type: greaterStr, Value: "90"}},
+			{Measurement: "k8s_pod", MetricName: "k8s_pod_rate_mem_usage_limit", MetricDisplayName: "Memory Usage Of Limit", ContinuePeriod: 5, Unit: "%", Evaluator: &Evaluator{Type: greaterStr, Value: "90"}},
+			{Measurement: "k8s_pod", MetricName: "k8s_pod_status_ready", MetricDisplayName: "Pod Ready", ContinuePeriod: 5, Evaluator: &Evaluator{Type: equalStr, Value: "false"}},
+			{Measurement: "k8s_event", MetricName: "k8s_pod_back_off", MetricDisplayName: "Back-off Restarting", Kind: AlarmRuleKindEvent, EventReason: "BackOff", EventObjectKind: "Pod", ContinuePeriod: 1, Evaluator: &Evaluator{Type: greaterStr, Value: "0"}},
+		},
+	},
+	"etcd": {
+		Name:             "etcd",
+		Description:      "Leader election and disk latency of etcd",
+		AlarmPolicyType:  alarmPolicyTypeCluster,
+		StatisticsPeriod: 60,
+		AlarmMetrics: []*AlarmMetric{
+			{Measurement: "etcd", MetricName: "etcd_no_leader", MetricDisplayName: "Etcd Has No Leader", ContinuePeriod: 1, Expr: "min(etcd_server_has_leader)", Evaluator: &Evaluator{Type: equalStr, Value: "0"}},
+			{Measurement: "etcd", MetricName: "etcd_leader_changes", MetricDisplayName: "Etcd Leader Changes In An Hour", ContinuePeriod: 1, Expr: "max(increase(etcd_server_leader_changes_seen_total[1h]))", Evaluator: &Evaluator{Type: greaterStr, Value: "3"}},
+			{Measurement: "etcd", MetricName: "etcd_wal_fsync_latency", MetricDisplayName: "Etcd WAL Fsync P99 Latency", ContinuePeriod: 5, Unit: "s", Expr: "histogram_quantile(0.99, sum(rate(etcd_disk_wal_fsync_duration_seconds_bucket[5m])) by (instance, le))", Evaluator: &Evaluator{Type: greaterStr, Value: "0.5"}},
+		},
+	},
+	"apiserver": {
+		Name:             "apiserver",
+		Description:      "Error rate and latency of kube-apiserver",
+		AlarmPolicyType:  alarmPolicyTypeCluster,
+		StatisticsPeriod: 60,
+		AlarmMetrics: []*AlarmMetric{
+			{Measurement: "apiserver", MetricName: "apiserver_error_rate", MetricDisplayName: "Apiserver 5xx Rate", ContinuePeriod: 5, Unit: "%", Expr: `sum(rate(apiserver_request_total{code=~"5.."}[5m])) * 100 / sum(rate(apiserver_request_total[5m]))`, Evaluator: &Evaluator{Type: greaterStr, Value: "5"}},
+			{Measurement: "apiserver", MetricName: "apiserver_request_latency", MetricDisplayName: "Apiserver P99 Latency", ContinuePeriod: 5, Unit: "s", Expr: `histogram_quantile(0.99, sum(rate(apiserver_request_duration_seconds_bucket{verb!~"WATCH|CONNECT"}[5m])) by (le))`, Evaluator: &Evaluator{Type: greaterStr, Value: "1"}},
+		},
+	},
+}
+
+// GetAlarmPolicyTemplate returns the template by name
+func GetAlarmPolicyTemplate(name string) (*AlarmPolicyTemplate, bool) {
+	t, ok := alarmPolicyTemplates[name]
+	return t, ok
+}
+
+// ListAlarmPolicyTemplates returns all templates sorted by name
+func ListAlarmPolicyTemplates() []*AlarmPolicyTemplate {
+	templates := make([]*AlarmPolicyTemplate, 0, len(alarmPolicyTemplates))
+	for _, t := range alarmPolicyTemplates {
+		templates = append(templates, t)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+	return templates
+}
+
+// NewAlarmPolicy creates an AlarmPolicy from the template, the metrics are
+// copied so that the policy can be modified
+func (t *AlarmPolicyTemplate) NewAlarmPolicy(name string, namespace string, notifySettings *NotifySettings) *AlarmPolicy {
+	metrics := make([]*AlarmMetric, 0, len(t.AlarmMetrics))
+	for _, m := range t.AlarmMetrics {
+		metric := *m
+		if m.Evaluator != nil {
+			evaluator := *m.Evaluator
+			metric.Evaluator = &evaluator
+		}
+		metrics = append(metrics, &metric)
+	}
+	return &AlarmPolicy{
+		AlarmPolicySettings: &AlarmPolicySettings{
+			AlarmPolicyName:  name,
+			AlarmPolicyType:  t.AlarmPolicyType,
+			AlarmMetrics:     metrics,
+			AlarmObjectsType: t.AlarmObjectsType,
+			StatisticsPeriod: t.StatisticsPeriod,
+		},
+		NotifySettings: notifySettings,
+		Namespace:      namespace,
+	}
+}
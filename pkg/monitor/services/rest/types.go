@@ -64,6 +64,30 @@ const (
 	evaluateTypeKey        = "evaluateType"
 	evaluateValueKey       = "evaluateValue"
 	metricDisplayNameKey   = "metricDisplayName"
+	ruleKindKey            = "ruleKind"
+	exprKey                = "expr"
+	eventReasonKey         = "eventReason"
+	eventObjectKindKey     = "eventObjectKind"
+	logKeywordKey          = "logKeyword"
+	filterReasonKey        = "reason"
+	filterKindKey          = "kind"
+	filterKeywordKey       = "keyword"
+	// eventMetric is counted by the fluentd of PersistentEvent for each event.
+	eventMetric = "k8s_event_total"
+	// logKeywordMetric is counted by the log collector for each log line
+	// matching a keyword.
+	logKeywordMetric = "k8s_log_keyword_total"
+)
+
+const (
+	// AlarmRuleKindMetric alerts when the value of a metric matches the evaluator.
+	AlarmRuleKindMetric = "metric"
+	// AlarmRuleKindEvent alerts when the count of kubernetes events matches the
+	// evaluator in a statistics period.
+	AlarmRuleKindEvent = "event"
+	// AlarmRuleKindLog alerts when the count of log lines containing a keyword
+	// matches the evaluator in a statistics period.
+	AlarmRuleKindLog = "log"
 )
 
 // Response defines the structure of http response of prometheus and alertmanager
@@ -210,6 +234,16 @@ type AlarmMetric struct {
 	ContinuePeriod    int64      `json:"ContinuePeriod"`
 	Evaluator         *Evaluator `json:"Evaluator"`
 	Unit              string     `json:"Unit"`
+	// Kind is one of metric, event and log, defaults to metric.
+	Kind string `json:"Kind,omitempty"`
+	// Expr is a PromQL expression evaluated instead of MetricName for metric
+	// rules, the Evaluator is applied to it if specified.
+	Expr string `json:"Expr,omitempty"`
+	// EventReason and EventObjectKind select the events counted by event rules.
+	EventReason     string `json:"EventReason,omitempty"`
+	EventObjectKind string `json:"EventObjectKind,omitempty"`
+	// LogKeyword selects the log lines counted by log rules.
+	LogKeyword string `json:"LogKeyword,omitempty"`
 }
 
 // Evaluator contains type and value to form expr
@@ -305,9 +339,82 @@ func (p *AlarmPolicy) Validate() error {
 		return errors.New("zero StatisticsPeriod")
 	}
 
+	names := make(map[string]bool)
+	for _, m := range p.AlarmPolicySettings.AlarmMetrics {
+		if m == nil {
+			return errors.New("empty AlarmMetric")
+		}
+		if err := m.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid AlarmMetric %s", m.MetricName)
+		}
+		if names[m.MetricName] {
+			return errors.Errorf("duplicate AlarmMetric %s", m.MetricName)
+		}
+		names[m.MetricName] = true
+	}
+
 	return nil
 }
 
+// Validate check if the rule of the metric is available
+func (r *AlarmMetric) Validate() error {
+	if r.MetricName == "" {
+		return errors.New("empty MetricName")
+	}
+
+	if r.Evaluator != nil {
+		switch r.Evaluator.Type {
+		case greaterStr, equalStr, lessStr:
+		default:
+			return errors.Errorf("unsupported Evaluator type %s", r.Evaluator.Type)
+		}
+		if _, err := strconv.ParseFloat(r.Evaluator.Value, 64); err != nil {
+			if _, err := parseBool(r.Evaluator.Value); err != nil {
+				return errors.Errorf("Evaluator value %s is neither a number nor a bool", r.Evaluator.Value)
+			}
+		}
+	}
+
+	switch r.GetKind() {
+	case AlarmRuleKindMetric:
+		if r.Evaluator == nil && r.Expr == "" {
+			return errors.New("empty Evaluator")
+		}
+	case AlarmRuleKindEvent:
+		if r.Evaluator == nil {
+			return errors.New("empty Evaluator")
+		}
+		if r.EventReason == "" {
+			return errors.New("empty EventReason")
+		}
+	case AlarmRuleKindLog:
+		if r.Evaluator == nil {
+			return errors.New("empty Evaluator")
+		}
+		if r.LogKeyword == "" {
+			return errors.New("empty LogKeyword")
+		}
+	default:
+		return errors.Errorf("unsupported Kind %s", r.Kind)
+	}
+
+	return nil
+}
+
+// GetKind returns the kind of the rule, defaults to metric
+func (r *AlarmMetric) GetKind() string {
+	if r.Kind == "" {
+		return AlarmRuleKindMetric
+	}
+	return r.Kind
+}
+
+// IsSimple returns whether the expr of the rule is built from the metric name,
+// the filter of the policy and the evaluator only
+func (r *AlarmMetric) IsSimple() bool {
+	return r.GetKind() == AlarmRuleKindMetric && r.Expr == ""
+}
+
 // GetInterval computes interval of ruleGroup from AlarmPolicySettings.StatisticsPeriod
 func (p *AlarmPolicy) GetInterval() string {
 	return fmt.Sprintf("%ds", p.AlarmPolicySettings.StatisticsPeriod)
@@ -315,6 +422,10 @@ func (p *AlarmPolicy) GetInterval() string {
 
 // GetExpr builds expr of prometheus rule from AlarmMetric and AlarmPolicy
 func (r *AlarmMetric) GetExpr(alarmPolicy *AlarmPolicy) string {
+	if r.Evaluator == nil {
+		return r.Expr
+	}
+
 	var (
 		op     string
 		metric string
@@ -328,12 +439,20 @@ func (r *AlarmMetric) GetExpr(alarmPolicy *AlarmPolicy) string {
 		op = lessExpr
 	}
 
-	switch alarmPolicy.AlarmPolicySettings.AlarmPolicyType {
-	case alarmPolicyTypeCluster:
+	switch {
+	case r.GetKind() == AlarmRuleKindEvent:
+		metric = r.getCountExpr(eventMetric, alarmPolicy, "namespace, kind, name, reason",
+			filterReasonKey, r.EventReason, filterKindKey, r.EventObjectKind)
+	case r.GetKind() == AlarmRuleKindLog:
+		metric = r.getCountExpr(logKeywordMetric, alarmPolicy, "namespace, pod, container",
+			filterKeywordKey, r.LogKeyword)
+	case r.Expr != "":
+		metric = fmt.Sprintf("(%s)", r.Expr)
+	case alarmPolicy.AlarmPolicySettings.AlarmPolicyType == alarmPolicyTypeCluster:
 		metric = r.MetricName
-	case alarmPolicyTypeNode:
+	case alarmPolicy.AlarmPolicySettings.AlarmPolicyType == alarmPolicyTypeNode:
 		metric = r.MetricName
-	case alarmPolicyTypePod:
+	case alarmPolicy.AlarmPolicySettings.AlarmPolicyType == alarmPolicyTypePod:
 		filter := MetricFilter{}
 		if alarmPolicy.AlarmPolicySettings.AlarmObjects != "" {
 			alarmObjects := strings.Split(alarmPolicy.AlarmPolicySettings.AlarmObjects, ",")
@@ -366,6 +485,23 @@ func (r *AlarmMetric) GetExpr(alarmPolicy *AlarmPolicy) string {
 	return fmt.Sprintf("%s %s %s", metric, op, value)
 }
 
+// getCountExpr builds the expr which counts the increase of counter in the
+// statistics period, the namespace of policy and the non-empty key values
+// are used as filter
+func (r *AlarmMetric) getCountExpr(counter string, alarmPolicy *AlarmPolicy, by string, keyValues ...string) string {
+	var filters []string
+	if alarmPolicy.Namespace != "" {
+		filters = append(filters, fmt.Sprintf("%s=%q", filterNamespaceKey, alarmPolicy.Namespace))
+	}
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		if keyValues[i+1] != "" {
+			filters = append(filters, fmt.Sprintf("%s=%q", keyValues[i], keyValues[i+1]))
+		}
+	}
+	return fmt.Sprintf("sum(increase(%s{%s}[%ds])) by (%s)", counter, strings.Join(filters, ","),
+		alarmPolicy.AlarmPolicySettings.StatisticsPeriod, by)
+}
+
 // GetFor computes For of prometheus rule from statisticsPeriod and ContinuePeriod
 func (r *AlarmMetric) GetFor(statisticsPeriod int64) string {
 	return fmt.Sprintf("%ds", statisticsPeriod*r.ContinuePeriod)
@@ -376,25 +512,36 @@ func (r *AlarmMetric) GetAnnotations(alarmPolicy *AlarmPolicy) map[string]string
 	annotations := make(map[string]string)
 	notifySettings := alarmPolicy.NotifySettings
 	alarmPolicySettings := alarmPolicy.AlarmPolicySettings
-	var op string
-	switch r.Evaluator.Type {
-	case greaterStr:
-		op = greaterExpr
-	case equalStr:
-		op = equalExpr
-	case lessStr:
-		op = lessExpr
-	}
-	v, err := parseBool(r.Evaluator.Value)
-	if err == nil {
-		annotations[isBoolKey] = "true"
-		annotations[evaluateValueKey] = fmt.Sprintf("%t", v)
-		op = equalExpr
+	if r.Evaluator != nil {
+		var op string
+		switch r.Evaluator.Type {
+		case greaterStr:
+			op = greaterExpr
+		case equalStr:
+			op = equalExpr
+		case lessStr:
+			op = lessExpr
+		}
+		v, err := parseBool(r.Evaluator.Value)
+		if err == nil {
+			annotations[isBoolKey] = "true"
+			annotations[evaluateValueKey] = fmt.Sprintf("%t", v)
+			op = equalExpr
+		} else {
+			annotations[isBoolKey] = "false"
+			annotations[evaluateValueKey] = r.Evaluator.Value
+		}
+		annotations[evaluateTypeKey] = op
 	} else {
 		annotations[isBoolKey] = "false"
-		annotations[evaluateValueKey] = r.Evaluator.Value
 	}
-	annotations[evaluateTypeKey] = op
+	if !r.IsSimple() {
+		annotations[ruleKindKey] = r.GetKind()
+		annotations[exprKey] = r.Expr
+		annotations[eventReasonKey] = r.EventReason
+		annotations[eventObjectKindKey] = r.EventObjectKind
+		annotations[logKeywordKey] = r.LogKeyword
+	}
 	annotations[unitKey] = r.Unit
 	annotations[receiverGroupKey] = strings.Join(notifySettings.ReceiverGroups, notifySettingSep)
 	annotations[receiverKey] = strings.Join(notifySettings.Receivers, notifySettingSep)
@@ -474,6 +621,9 @@ func NewAlarmPolicyFromRuleGroup(ruleGroup *v1.RuleGroup) *AlarmPolicy {
 			}
 		}
 
+		if r.Annotations[exprKey] != "" {
+			continue
+		}
 		if alarmPolicy.Namespace == "" || alarmPolicy.WorkloadType == "" || alarmPolicy.AlarmPolicySettings.AlarmObjects == "" {
 			filter := NewMetricFilterFromExpr(r.Expr.String())
 			alarmPolicy.Namespace = filter.Namespace
@@ -532,7 +682,17 @@ func NewAlarmMetricFromRule(ruleGroup *v1.RuleGroup, rule v1.Rule) *AlarmMetric
 	alarmMetric := &AlarmMetric{
 		MetricName:     rule.Alert,
 		ContinuePeriod: (rulefor / interval).Nanoseconds(),
-		Evaluator:      NewEvaluatorFromExpr(rule.Expr.String(), isBool),
+	}
+
+	if kind, ok := rule.Annotations[ruleKindKey]; ok {
+		alarmMetric.Kind = kind
+		alarmMetric.Expr = rule.Annotations[exprKey]
+		alarmMetric.EventReason = rule.Annotations[eventReasonKey]
+		alarmMetric.EventObjectKind = rule.Annotations[eventObjectKindKey]
+		alarmMetric.LogKeyword = rule.Annotations[logKeywordKey]
+		alarmMetric.Evaluator = NewEvaluatorFromAnnotations(rule.Annotations)
+	} else {
+		alarmMetric.Evaluator = NewEvaluatorFromExpr(rule.Expr.String(), isBool)
 	}
 
 	if v, ok := rule.Annotations[unitKey]; ok {
@@ -576,6 +736,29 @@ func NewEvaluatorFromExpr(expr string, isBool bool) *Evaluator {
 	return evaluator
 }
 
+// NewEvaluatorFromAnnotations creates Evaluator from the annotations of the
+// rule whose expr is not built from the metric name, returns nil if the rule
+// has no evaluator
+func NewEvaluatorFromAnnotations(annotations map[string]string) *Evaluator {
+	op, ok := annotations[evaluateTypeKey]
+	if !ok {
+		return nil
+	}
+	var etype string
+	switch op {
+	case equalExpr:
+		etype = equalStr
+	case greaterExpr:
+		etype = greaterStr
+	case lessExpr:
+		etype = lessStr
+	}
+	return &Evaluator{
+		Type:  etype,
+		Value: annotations[evaluateValueKey],
+	}
+}
+
 func parseBool(str string) (bool, error) {
 	_, err := strconv.ParseInt(str, 10, 64)
 	if err == nil {
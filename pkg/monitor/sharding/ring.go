@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sharding
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// virtualNodes is the number of the points of each shard on the ring.
+const virtualNodes = 128
+
+// Ring maps the clusters to the shards by consistent hashing. Each shard owns
+// the virtual nodes on the ring, so that changing the number of shards only
+// moves the clusters next to the virtual nodes of the changed shards.
+type Ring struct {
+	hashes []uint64
+	shards map[uint64]int
+}
+
+// NewRing creates a ring of the shards numbered from 0 to shards-1.
+func NewRing(shards int) *Ring {
+	r := &Ring{shards: make(map[uint64]int, shards*virtualNodes)}
+	for shard := 0; shard < shards; shard++ {
+		for i := 0; i < virtualNodes; i++ {
+			h := hash(fmt.Sprintf("shard-%d-%d", shard, i))
+			if _, ok := r.shards[h]; ok {
+				continue
+			}
+			r.shards[h] = shard
+			r.hashes = append(r.hashes, h)
+		}
+	}
+	sort.Slice(r.hashes, func(i, j int) bool { return r.hashes[i] < r.hashes[j] })
+	return r
+}
+
+// Shard returns the shard of the key, which is the shard of the first virtual
+// node clockwise from the hash of the key.
+func (r *Ring) Shard(key string) int {
+	if len(r.hashes) == 0 {
+		return 0
+	}
+	h := hash(key)
+	i := sort.Search(len(r.hashes), func(i int) bool { return r.hashes[i] >= h })
+	if i == len(r.hashes) {
+		i = 0
+	}
+	return r.shards[r.hashes[i]]
+}
+
+// Assign assigns the shards to the members by rendezvous hashing, the shard
+// goes to the member with the highest weight among the members which have
+// not reached the even share. Every member computes the same assignment from
+// the same members, and a member joining or leaving mostly moves the shards
+// it takes or gives up only.
+func Assign(shards int, members []string) map[int]string {
+	assignment := make(map[int]string, shards)
+	if len(members) == 0 {
+		return assignment
+	}
+	sorted := append([]string(nil), members...)
+	sort.Strings(sorted)
+	share := (shards + len(sorted) - 1) / len(sorted)
+	loads := make(map[string]int, len(sorted))
+
+	for shard := 0; shard < shards; shard++ {
+		var owner string
+		var max uint64
+		for _, member := range sorted {
+			if loads[member] >= share {
+				continue
+			}
+			if w := hash(fmt.Sprintf("%s/%d", member, shard)); owner == "" || w > max {
+				owner, max = member, w
+			}
+		}
+		assignment[shard] = owner
+		loads[owner]++
+	}
+	return assignment
+}
+
+func hash(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRingShard(t *testing.T) {
+	ring := NewRing(4)
+	counts := make(map[int]int)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("cls-%d", i)
+		shard := ring.Shard(key)
+		if shard < 0 || shard >= 4 {
+			t.Fatalf("shard %d of %s out of range", shard, key)
+		}
+		if again := ring.Shard(key); again != shard {
+			t.Fatalf("expect the same shard of %s, got %d and %d", key, shard, again)
+		}
+		counts[shard]++
+	}
+	for shard := 0; shard < 4; shard++ {
+		if counts[shard] < 150 {
+			t.Errorf("shard %d only has %d of 1000 keys", shard, counts[shard])
+		}
+	}
+
+	// adding a shard only moves the keys to the new shard.
+	bigger := NewRing(5)
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("cls-%d", i)
+		if before, after := ring.Shard(key), bigger.Shard(key); before != after && after != 4 {
+			t.Errorf("key %s moved from shard %d to %d", key, before, after)
+		}
+	}
+
+	if shard := NewRing(0).Shard("cls-a"); shard != 0 {
+		t.Errorf("expect shard 0 of an empty ring, got %d", shard)
+	}
+}
+
+func TestAssign(t *testing.T) {
+	members := []string{"c", "a", "b"}
+	assignment := Assign(8, members)
+	loads := make(map[string]int)
+	for shard := 0; shard < 8; shard++ {
+		loads[assignment[shard]]++
+	}
+	for _, member := range members {
+		if loads[member] < 2 || loads[member] > 3 {
+			t.Errorf("expect member %s leads 2 or 3 shards, got %d", member, loads[member])
+		}
+	}
+
+	again := Assign(8, []string{"b", "c", "a"})
+	for shard := 0; shard < 8; shard++ {
+		if again[shard] != assignment[shard] {
+			t.Errorf("expect the same assignment regardless of the order of members, shard %d", shard)
+		}
+	}
+
+	single := Assign(8, []string{"a"})
+	for shard := 0; shard < 8; shard++ {
+		if single[shard] != "a" {
+			t.Errorf("expect the only member leads all shards, shard %d goes to %q", shard, single[shard])
+		}
+	}
+
+	if len(Assign(8, nil)) != 0 {
+		t.Errorf("expect no assignment without members")
+	}
+}
@@ -0,0 +1,323 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sharding
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	monitorv1client "tkestack.io/tke/api/client/clientset/versioned/typed/monitor/v1"
+	v1 "tkestack.io/tke/api/monitor/v1"
+	"tkestack.io/tke/pkg/util/leaderelection"
+	"tkestack.io/tke/pkg/util/leaderelection/resourcelock"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// memberPrefix is the prefix of the names of the ConfigMaps which record
+	// the heartbeats of the replicas.
+	memberPrefix = "tke-monitor-controller-member-"
+	// shardLockPrefix is the prefix of the names of the locks of the shards.
+	shardLockPrefix = "tke-monitor-controller-shard-"
+
+	memberIdentityAnnotation  = "tkestack.io/shard-member-identity"
+	memberRenewTimeAnnotation = "tkestack.io/shard-member-renew-time"
+
+	// staleMemberFactor times the lease duration without heartbeats, the
+	// ConfigMap of the member is deleted.
+	staleMemberFactor = 10
+)
+
+// Config is the configuration of Sharder.
+type Config struct {
+	// Identity is the unique identity of the replica.
+	Identity string
+	// Shards is the number of the shards.
+	Shards int
+
+	LeaseDuration time.Duration
+	RenewDeadline time.Duration
+	RetryPeriod   time.Duration
+}
+
+// observedMember is the last heartbeat of a member and the local time it was
+// observed, so that the liveness of the members does not depend on the clocks
+// of the other replicas.
+type observedMember struct {
+	renewTime  string
+	observedAt time.Time
+}
+
+type campaign struct {
+	cancel context.CancelFunc
+}
+
+// Sharder assigns the shards to the live replicas of tke-monitor-controller,
+// and campaigns for the leaders of the shards assigned to this replica. A
+// replica only handles the clusters of the shards it leads.
+type Sharder struct {
+	client monitorv1client.MonitorV1Interface
+	config Config
+	ring   *Ring
+
+	// observed is only accessed by the sync loop.
+	observed map[string]observedMember
+
+	mu        sync.RWMutex
+	leading   sets.Int
+	campaigns map[int]*campaign
+	handlers  []func()
+}
+
+// NewSharder creates a new Sharder object.
+func NewSharder(client monitorv1client.MonitorV1Interface, config Config) *Sharder {
+	return &Sharder{
+		client:    client,
+		config:    config,
+		ring:      NewRing(config.Shards),
+		observed:  make(map[string]observedMember),
+		leading:   sets.NewInt(),
+		campaigns: make(map[int]*campaign),
+	}
+}
+
+// Owns returns true if the cluster belongs to a shard led by this replica. A
+// nil Sharder owns all the clusters.
+func (s *Sharder) Owns(clusterName string) bool {
+	if s == nil {
+		return true
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.leading.Has(s.ring.Shard(clusterName))
+}
+
+// AddHandler adds the handler called after the shards led by this replica
+// changed, the controllers resync all the clusters in it.
+func (s *Sharder) AddHandler(handler func()) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers = append(s.handlers, handler)
+}
+
+// Run sends the heartbeats of this replica and rebalances the shards until
+// ctx is done.
+func (s *Sharder) Run(ctx context.Context) {
+	log.Info("Starting sharder", log.String("identity", s.config.Identity), log.Int("shards", s.config.Shards))
+	defer log.Info("Shutting down sharder")
+
+	wait.Until(func() { s.sync(ctx) }, s.config.RetryPeriod, ctx.Done())
+	s.leave()
+}
+
+func (s *Sharder) sync(ctx context.Context) {
+	if err := s.heartbeat(ctx); err != nil {
+		log.Error("Failed to send the heartbeat of sharding member", log.String("identity", s.config.Identity), log.Err(err))
+		return
+	}
+	members, err := s.liveMembers(ctx)
+	if err != nil {
+		log.Error("Failed to list sharding members", log.Err(err))
+		return
+	}
+	assignment := Assign(s.config.Shards, members)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for shard := 0; shard < s.config.Shards; shard++ {
+		c, campaigning := s.campaigns[shard]
+		switch {
+		case assignment[shard] == s.config.Identity && !campaigning:
+			s.campaign(ctx, shard)
+		case assignment[shard] != s.config.Identity && campaigning:
+			// the lease is not released, the assigned member acquires the
+			// shard after the lease expires.
+			log.Info("Give up shard", log.Int("shard", shard), log.String("assignee", assignment[shard]))
+			c.cancel()
+			delete(s.campaigns, shard)
+		}
+	}
+}
+
+// campaign runs the leader election of the shard, it must be called with the
+// lock held.
+func (s *Sharder) campaign(ctx context.Context, shard int) {
+	name := fmt.Sprintf("%s%d", shardLockPrefix, shard)
+	ctx, cancel := context.WithCancel(ctx)
+	elector, err := leaderelection.NewLeaderElector(leaderelection.ElectionConfig{
+		Lock:          resourcelock.NewMonitor(name, s.client, resourcelock.Config{Identity: s.config.Identity}),
+		LeaseDuration: s.config.LeaseDuration,
+		RenewDeadline: s.config.RenewDeadline,
+		RetryPeriod:   s.config.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				s.setLeading(ctx, shard, true)
+			},
+			OnStoppedLeading: func() {
+				s.setLeading(ctx, shard, false)
+			},
+		},
+		Name: name,
+	})
+	if err != nil {
+		cancel()
+		log.Error("Failed to create the leader elector of shard", log.Int("shard", shard), log.Err(err))
+		return
+	}
+
+	c := &campaign{cancel: cancel}
+	s.campaigns[shard] = c
+	go func() {
+		defer cancel()
+		elector.Run(ctx)
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.campaigns[shard] == c {
+			delete(s.campaigns, shard)
+		}
+	}()
+}
+
+func (s *Sharder) setLeading(ctx context.Context, shard int, leading bool) {
+	s.mu.Lock()
+	// the leading callback runs asynchronously, it is dropped if the
+	// election has already stopped.
+	if leading && ctx.Err() != nil {
+		s.mu.Unlock()
+		return
+	}
+	if s.leading.Has(shard) == leading {
+		s.mu.Unlock()
+		return
+	}
+	if leading {
+		s.leading.Insert(shard)
+	} else {
+		s.leading.Delete(shard)
+	}
+	handlers := s.handlers
+	s.mu.Unlock()
+
+	log.Info("Shards led by this replica changed", log.Int("shard", shard), log.Bool("leading", leading))
+	for _, handler := range handlers {
+		handler()
+	}
+}
+
+// memberName returns the name of the ConfigMap of the member, the identity
+// is hashed since it may be not a valid name.
+func memberName(identity string) string {
+	return fmt.Sprintf("%s%x", memberPrefix, sha256.Sum256([]byte(identity)))[:len(memberPrefix)+16]
+}
+
+func (s *Sharder) heartbeat(ctx context.Context) error {
+	name := memberName(s.config.Identity)
+	annotations := map[string]string{
+		memberIdentityAnnotation:  s.config.Identity,
+		memberRenewTimeAnnotation: time.Now().Format(time.RFC3339Nano),
+	}
+	cm, err := s.client.ConfigMaps().Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = s.client.ConfigMaps().Create(ctx, &v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: annotations,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	for k, v := range annotations {
+		cm.Annotations[k] = v
+	}
+	_, err = s.client.ConfigMaps().Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// liveMembers returns the identities of the members whose heartbeats were
+// observed within the lease duration, and deletes the long stale ones.
+func (s *Sharder) liveMembers(ctx context.Context) ([]string, error) {
+	list, err := s.client.ConfigMaps().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	self := memberName(s.config.Identity)
+	members := []string{s.config.Identity}
+	seen := sets.NewString()
+	for _, cm := range list.Items {
+		if !strings.HasPrefix(cm.Name, memberPrefix) || cm.Name == self {
+			continue
+		}
+		seen.Insert(cm.Name)
+		renewTime := cm.Annotations[memberRenewTimeAnnotation]
+		observed, ok := s.observed[cm.Name]
+		if !ok || observed.renewTime != renewTime {
+			observed = observedMember{renewTime: renewTime, observedAt: now}
+			s.observed[cm.Name] = observed
+		}
+
+		age := now.Sub(observed.observedAt)
+		switch {
+		case age < s.config.LeaseDuration:
+			if identity := cm.Annotations[memberIdentityAnnotation]; identity != "" {
+				members = append(members, identity)
+			}
+		case age > staleMemberFactor*s.config.LeaseDuration:
+			log.Info("Delete stale sharding member", log.String("name", cm.Name))
+			if err := s.client.ConfigMaps().Delete(ctx, cm.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+				log.Warn("Failed to delete stale sharding member", log.String("name", cm.Name), log.Err(err))
+			}
+		}
+	}
+	for name := range s.observed {
+		if !seen.Has(name) {
+			delete(s.observed, name)
+		}
+	}
+	return members, nil
+}
+
+// leave deletes the heartbeat of this replica so that the other members take
+// over its shards without waiting for the heartbeat to expire.
+func (s *Sharder) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RenewDeadline)
+	defer cancel()
+	if err := s.client.ConfigMaps().Delete(ctx, memberName(s.config.Identity), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		log.Warn("Failed to delete the heartbeat of sharding member", log.Err(err))
+	}
+}
@@ -39,3 +39,29 @@ func FilterPrometheus(ctx context.Context, prom *monitor.Prometheus) error {
 	}
 	return nil
 }
+
+// FilterEventAlertPolicy is used to filter event alert policy that do not
+// belong to the tenant.
+func FilterEventAlertPolicy(ctx context.Context, policy *monitor.EventAlertPolicy) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if policy.Spec.TenantID != tenantID {
+		return errors.NewNotFound(monitor.Resource("eventalertpolicies"), policy.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterBlackboxProbe is used to filter blackbox probe that do not belong to
+// the tenant.
+func FilterBlackboxProbe(ctx context.Context, probe *monitor.BlackboxProbe) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if probe.Spec.TenantID != tenantID {
+		return errors.NewNotFound(monitor.Resource("blackboxprobes"), probe.ObjectMeta.Name)
+	}
+	return nil
+}
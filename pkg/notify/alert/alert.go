@@ -0,0 +1,315 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alert
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// StatusResolved is the status of the alerts which recovered.
+const StatusResolved = "resolved"
+
+const (
+	alertNameKey          = "alertName"
+	startsAtKey           = "startsAt"
+	alarmPolicyTypeKey    = "alarmPolicyType"
+	alarmPolicyNameKey    = "alarmPolicyName"
+	clusterIDKey          = "clusterID"
+	clusterDisplayNameKey = "clusterDisplayName"
+	valueKey              = "value"
+	workloadKindKey       = "workloadKind"
+	namespaceKey          = "namespace"
+	workloadNameKey       = "workloadName"
+	podNameKey            = "podName"
+	nodeNameKey           = "nodeName"
+	nodeRoleKey           = "nodeRole"
+	unitKey               = "unit"
+	evaluateTypeKey       = "evaluateType"
+	evaluateValueKey      = "evaluateValue"
+	metricDisplayNameKey  = "metricDisplayName"
+	summaryKey            = "summary"
+	alertStatusKey        = "alertStatus"
+	severityKey           = "severity"
+	fingerprintKey        = "fingerprint"
+)
+
+// Alert indicates the alert infos
+type Alert struct {
+	Status      string            `json:"status"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+	Fingerprint string            `json:"fingerprint"`
+}
+
+// Notification indicates the notification for alertmanager of prometheus
+type Notification struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// Route indicates how the alerts are notified, either escalated by the
+// escalation policy, or sent through the channel with the template to the
+// receivers.
+type Route struct {
+	TenantID         string
+	Channel          string
+	Template         string
+	Receivers        []string
+	ReceiverGroups   []string
+	EscalationPolicy string
+	// PassThrough exposes the labels and annotations of the alert as
+	// variables, so that the templates can reference the fields of alerts
+	// from external systems.
+	PassThrough bool
+}
+
+// Dispatch notifies the alert by the route, the escalation of the alert is
+// resolved instead if the alert is resolved.
+func Dispatch(ctx context.Context, notifyClient notifyinternalclient.NotifyInterface, route Route, alert Alert, resolved bool) error {
+	variables := Variables(alert)
+	if route.PassThrough {
+		for _, fields := range []map[string]string{alert.Labels, alert.Annotations} {
+			for k, v := range fields {
+				if _, ok := variables[k]; !ok {
+					variables[k] = v
+				}
+			}
+		}
+	}
+
+	if route.EscalationPolicy != "" {
+		if resolved {
+			return resolveEscalation(ctx, notifyClient, route.EscalationPolicy, alert)
+		}
+		return createEscalation(ctx, notifyClient, route.EscalationPolicy, alert, variables)
+	}
+
+	messageRequest := &notify.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: route.Channel,
+		},
+		Spec: notify.MessageRequestSpec{
+			TenantID:       route.TenantID,
+			TemplateName:   route.Template,
+			Receivers:      route.Receivers,
+			ReceiverGroups: route.ReceiverGroups,
+			Variables:      variables,
+		},
+	}
+	if _, err := notifyClient.MessageRequests(messageRequest.ObjectMeta.Namespace).Create(ctx, messageRequest, metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	log.Infof("messageRequest created: %+v", messageRequest.Spec)
+	return nil
+}
+
+// EscalationName returns the same name for the notifications of an alert, so
+// that an alert firing repeatedly escalates only once.
+func EscalationName(policyName string, alert Alert) string {
+	keys := make([]string, 0, len(alert.Labels))
+	for k := range alert.Labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(policyName))
+	for _, k := range keys {
+		_, _ = h.Write([]byte(k + "=" + alert.Labels[k] + ";"))
+	}
+	_, _ = h.Write([]byte(processStartTime(alert.StartsAt)))
+	return fmt.Sprintf("esc-%016x", h.Sum64())
+}
+
+func createEscalation(ctx context.Context, notifyClient notifyinternalclient.NotifyInterface, policyName string, alert Alert, variables map[string]string) error {
+	escalation := &notify.Escalation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: EscalationName(policyName, alert),
+		},
+		Spec: notify.EscalationSpec{
+			PolicyName: policyName,
+			Variables:  variables,
+		},
+	}
+	_, err := notifyClient.Escalations().Create(ctx, escalation, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	if err == nil {
+		log.Infof("escalation created: %s", escalation.Name)
+	}
+	return nil
+}
+
+func resolveEscalation(ctx context.Context, notifyClient notifyinternalclient.NotifyInterface, policyName string, alert Alert) error {
+	escalation, err := notifyClient.Escalations().Get(ctx, EscalationName(policyName, alert), metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if escalation.Status.Phase == notify.EscalationResolved {
+		return nil
+	}
+	escalation.Status.Phase = notify.EscalationResolved
+	escalation.Status.LastTransitionTime = metav1.Now()
+	_, err = notifyClient.Escalations().UpdateStatus(ctx, escalation, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	log.Infof("escalation resolved: %s", escalation.Name)
+	return nil
+}
+
+// Variables returns the variables of the alert used to render the templates.
+func Variables(alert Alert) map[string]string {
+	summary := "[TKEStack alarm]"
+	variables := make(map[string]string)
+	labels := alert.Labels
+	annotations := alert.Annotations
+
+	summary = fmt.Sprintf("%s\n发生时间：%s", summary, processStartTime(alert.StartsAt))
+
+	alarmPolicyTypeValue, ok := annotations["alarmPolicyType"]
+	if ok {
+		summary = fmt.Sprintf("%s\n告警策略类型：%s", summary, alarmPolicyTypeValue)
+	}
+
+	metricDisplayNameValue, ok := annotations[metricDisplayNameKey]
+	if ok {
+		summary = fmt.Sprintf("%s\n告警项：%s", summary, metricDisplayNameValue)
+	}
+
+	valueValue, ok := annotations["value"]
+	if ok {
+		summary = fmt.Sprintf("%s\n指标值：%s", summary, valueValue)
+	}
+
+	unitValue, ok := annotations[unitKey]
+	if ok {
+		summary = fmt.Sprintf("%s%s", summary, unitValue)
+	}
+
+	evaluateTypeValue, ok := annotations[evaluateTypeKey]
+	if ok {
+		summary = fmt.Sprintf("%s %s", summary, evaluateTypeValue)
+	}
+
+	evaluateValue, ok := annotations[evaluateValueKey]
+	if ok {
+		summary = fmt.Sprintf("%s %s", summary, evaluateValue)
+	}
+
+	alarmPolicyNameValue, ok := labels["alarmPolicyName"]
+	if ok {
+		summary = fmt.Sprintf("%s\n告警策略名：%s", summary, alarmPolicyNameValue)
+	}
+
+	alertNameValue, ok := labels["alertname"]
+	if ok {
+		summary = fmt.Sprintf("%s\n指标名：%s", summary, alertNameValue)
+	}
+
+	clusterIDValue, ok := labels["cluster_id"]
+	if ok {
+		summary = fmt.Sprintf("%s\n集群ID：%s", summary, clusterIDValue)
+	}
+
+	clusterDisplayNameValue, ok := labels["cluster_display_name"]
+	if ok {
+		summary = fmt.Sprintf("%s\n集群名称：%s", summary, clusterDisplayNameValue)
+	}
+
+	workloadKindValue, ok := labels["workload_kind"]
+	if ok {
+		summary = fmt.Sprintf("%s\n工作负载类型：%s", summary, workloadKindValue)
+	}
+
+	workloadNameValue, ok := labels["workload_name"]
+	if ok {
+		summary = fmt.Sprintf("%s\n工作负载名称：%s", summary, workloadNameValue)
+	}
+
+	namespaceValue, ok := labels["namespace"]
+	if ok {
+		summary = fmt.Sprintf("%s\n命名空间：%s", summary, namespaceValue)
+	}
+
+	podNameValue, ok := labels["pod_name"]
+	if ok {
+		summary = fmt.Sprintf("%s\nPOD名称：%s", summary, podNameValue)
+	}
+
+	nodeNameValue, ok := labels["node"]
+	if ok {
+		summary = fmt.Sprintf("%s\n节点名称：%s", summary, nodeNameValue)
+	}
+
+	nodeRoleValue, ok := labels["node_role"]
+	if ok {
+		summary = fmt.Sprintf("%s\n节点类型：%s", summary, nodeRoleValue)
+	}
+
+	variables[startsAtKey] = processStartTime(alert.StartsAt)
+	variables[alarmPolicyTypeKey] = alarmPolicyTypeValue
+	variables[alarmPolicyNameKey] = alarmPolicyNameValue
+	variables[valueKey] = valueValue
+	variables[alertNameKey] = alertNameValue
+	variables[clusterIDKey] = clusterIDValue
+	variables[clusterDisplayNameKey] = clusterDisplayNameValue
+	variables[workloadKindKey] = workloadKindValue
+	variables[workloadNameKey] = workloadNameValue
+	variables[namespaceKey] = namespaceValue
+	variables[podNameKey] = podNameValue
+	variables[nodeNameKey] = nodeNameValue
+	variables[nodeRoleKey] = nodeRoleValue
+	variables[unitKey] = unitValue
+	variables[evaluateTypeKey] = evaluateTypeValue
+	variables[evaluateValueKey] = evaluateValue
+	variables[metricDisplayNameKey] = metricDisplayNameValue
+	variables[summaryKey] = summary
+	variables[alertStatusKey] = alert.Status
+	variables[severityKey] = labels["severity"]
+	variables[fingerprintKey] = alert.Fingerprint
+
+	return variables
+}
+
+func processStartTime(t time.Time) string {
+	return t.Format("2006-01-02T15:04:05Z")
+}
@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// FormatAlertmanager is the payload of the webhook receiver of
+	// alertmanager.
+	FormatAlertmanager = "alertmanager"
+	// FormatGeneric is a generic alert, or a list of them, for monitoring
+	// systems which can customize the payload of their webhooks.
+	FormatGeneric = "generic"
+
+	statusFiring = "firing"
+)
+
+// Formats are the supported payload formats of the alerts ingested.
+var Formats = []string{FormatAlertmanager, FormatGeneric}
+
+// GenericAlert indicates an alert of external monitoring systems.
+type GenericAlert struct {
+	// Status is firing or resolved. Defaults to firing.
+	Status      string            `json:"status"`
+	Title       string            `json:"title"`
+	Severity    string            `json:"severity"`
+	Description string            `json:"description"`
+	Fingerprint string            `json:"fingerprint"`
+	StartsAt    time.Time         `json:"startsAt"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// Parse decodes the alerts from the payload in the format.
+func Parse(format string, body []byte) ([]Alert, error) {
+	switch format {
+	case "", FormatAlertmanager:
+		notification := &Notification{}
+		if err := json.Unmarshal(body, notification); err != nil {
+			return nil, err
+		}
+		return notification.Alerts, nil
+	case FormatGeneric:
+		var genericAlerts []GenericAlert
+		if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+			if err := json.Unmarshal(trimmed, &genericAlerts); err != nil {
+				return nil, err
+			}
+		} else {
+			genericAlert := GenericAlert{}
+			if err := json.Unmarshal(trimmed, &genericAlert); err != nil {
+				return nil, err
+			}
+			genericAlerts = append(genericAlerts, genericAlert)
+		}
+		var alerts []Alert
+		for i, genericAlert := range genericAlerts {
+			if genericAlert.Title == "" {
+				return nil, fmt.Errorf("alert %d: must specify title", i)
+			}
+			alerts = append(alerts, genericAlert.toAlert())
+		}
+		return alerts, nil
+	}
+	return nil, fmt.Errorf("unsupported format %q, must be one of %s", format, strings.Join(Formats, ", "))
+}
+
+// toAlert converts the generic alert to the alert of alertmanager, the title
+// and severity are the labels which the variables of alerts are taken from.
+func (g GenericAlert) toAlert() Alert {
+	alert := Alert{
+		Status:      g.Status,
+		Labels:      make(map[string]string, len(g.Labels)+2),
+		Annotations: make(map[string]string, len(g.Annotations)+1),
+		StartsAt:    g.StartsAt,
+		Fingerprint: g.Fingerprint,
+	}
+	if alert.Status == "" {
+		alert.Status = statusFiring
+	}
+	if alert.StartsAt.IsZero() {
+		alert.StartsAt = time.Now().UTC()
+	}
+	for k, v := range g.Labels {
+		alert.Labels[k] = v
+	}
+	for k, v := range g.Annotations {
+		alert.Annotations[k] = v
+	}
+	alert.Labels["alertname"] = g.Title
+	if g.Severity != "" {
+		alert.Labels["severity"] = g.Severity
+	}
+	if g.Description != "" {
+		alert.Annotations["description"] = g.Description
+	}
+	return alert
+}
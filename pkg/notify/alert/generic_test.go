@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package alert
+
+import (
+	"testing"
+)
+
+func TestParseGeneric(t *testing.T) {
+	alerts, err := Parse(FormatGeneric, []byte(`{"title":"disk full","severity":"critical","description":"/data 95%","labels":{"host":"db-1"}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("expected 1 alert, got %d", len(alerts))
+	}
+	a := alerts[0]
+	if a.Status != statusFiring || a.StartsAt.IsZero() {
+		t.Errorf("status and start time should be defaulted: %+v", a)
+	}
+	if a.Labels["alertname"] != "disk full" || a.Labels["severity"] != "critical" || a.Labels["host"] != "db-1" || a.Annotations["description"] != "/data 95%" {
+		t.Errorf("unexpected alert: %+v", a)
+	}
+
+	alerts, err = Parse(FormatGeneric, []byte(` [{"title":"a","status":"resolved","fingerprint":"f"},{"title":"b"}]`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != 2 || alerts[0].Status != StatusResolved || alerts[0].Fingerprint != "f" {
+		t.Errorf("unexpected alerts: %+v", alerts)
+	}
+
+	if _, err := Parse(FormatGeneric, []byte(`{"severity":"info"}`)); err == nil {
+		t.Errorf("alert without title should be rejected")
+	}
+}
+
+func TestParseAlertmanager(t *testing.T) {
+	alerts, err := Parse(FormatAlertmanager, []byte(`{"status":"firing","alerts":[{"status":"firing","labels":{"alertname":"cpu"},"fingerprint":"f"}]}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(alerts) != 1 || alerts[0].Labels["alertname"] != "cpu" || alerts[0].Fingerprint != "f" {
+		t.Errorf("unexpected alerts: %+v", alerts)
+	}
+
+	if _, err := Parse("nagios", []byte(`{}`)); err == nil {
+		t.Errorf("unsupported format should be rejected")
+	}
+}
+
+func TestVariablesOfGenericAlert(t *testing.T) {
+	alerts, err := Parse(FormatGeneric, []byte(`{"title":"disk full","severity":"critical","fingerprint":"f"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	variables := Variables(alerts[0])
+	if variables[alertNameKey] != "disk full" || variables[severityKey] != "critical" || variables[fingerprintKey] != "f" || variables[alertStatusKey] != statusFiring {
+		t.Errorf("unexpected variables: %+v", variables)
+	}
+}
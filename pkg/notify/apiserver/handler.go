@@ -24,36 +24,17 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
-	"time"
 
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/server/mux"
 	restclient "k8s.io/client-go/rest"
 	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
-	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/alert"
 	"tkestack.io/tke/pkg/util/log"
 )
 
-const (
-	alertNameKey          = "alertName"
-	startsAtKey           = "startsAt"
-	alarmPolicyTypeKey    = "alarmPolicyType"
-	alarmPolicyNameKey    = "alarmPolicyName"
-	clusterIDKey          = "clusterID"
-	clusterDisplayNameKey = "clusterDisplayName"
-	valueKey              = "value"
-	workloadKindKey       = "workloadKind"
-	namespaceKey          = "namespace"
-	workloadNameKey       = "workloadName"
-	podNameKey            = "podName"
-	nodeNameKey           = "nodeName"
-	nodeRoleKey           = "nodeRole"
-	unitKey               = "unit"
-	evaluateTypeKey       = "evaluateType"
-	evaluateValueKey      = "evaluateValue"
-	metricDisplayNameKey  = "metricDisplayName"
-	summaryKey            = "summary"
-)
+// escalationPolicyAnnotation is the annotation of alerts which escalates the
+// alerts by the escalation policy instead of sending them.
+const escalationPolicyAnnotation = "escalationPolicy"
 
 // Request response struct
 type responseMsg struct {
@@ -61,27 +42,6 @@ type responseMsg struct {
 	Msg        string `json:"message"`
 }
 
-// Alert indicates the alert infos
-type Alert struct {
-	Labels      map[string]string `json:"labels"`
-	Annotations map[string]string `json:"annotations"`
-	StartsAt    time.Time         `json:"startsAt"`
-	EndsAt      time.Time         `json:"endsAt"`
-}
-
-// Notification indicates the notification for alertmanager of prometheus
-type Notification struct {
-	Version           string            `json:"version"`
-	GroupKey          string            `json:"groupKey"`
-	Status            string            `json:"status"`
-	Receiver          string            `json:"receiver"`
-	GroupLabels       map[string]string `json:"groupLabels"`
-	CommonLabels      map[string]string `json:"commonLabels"`
-	CommonAnnotations map[string]string `json:"commonAnnotations"`
-	ExternalURL       string            `json:"externalURL"`
-	Alerts            []Alert           `json:"alerts"`
-}
-
 func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclient.Config) {
 	m.HandleFunc("/webhook", func(w http.ResponseWriter, req *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -95,7 +55,7 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 			return
 		}
 		var bodyBytes []byte
-		notifyInfo := &Notification{}
+		notifyInfo := &alert.Notification{}
 		bodyBytes, _ = ioutil.ReadAll(req.Body)
 		err := json.Unmarshal(bodyBytes, notifyInfo)
 		if err != nil {
@@ -107,8 +67,18 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 			return
 		}
 		log.Infof("Receive alerts: %+v", notifyInfo.Alerts)
-		for _, alert := range notifyInfo.Alerts {
-			annotations := alert.Annotations
+		resolved := notifyInfo.Status == alert.StatusResolved
+		for _, a := range notifyInfo.Alerts {
+			annotations := a.Annotations
+			if policyName, ok := annotations[escalationPolicyAnnotation]; ok && policyName != "" {
+				notifyClient := notifyinternalclient.NewForConfigOrDie(loopbackClientConfig)
+				err = alert.Dispatch(req.Context(), notifyClient, alert.Route{EscalationPolicy: policyName}, a, resolved)
+				if err != nil {
+					setErrResponse(err.Error(), http.StatusInternalServerError, w)
+					return
+				}
+				continue
+			}
 			notifyWay, ok := annotations["notifyWay"]
 			if !ok {
 				setErrResponse("The notifyWay does not exist", http.StatusBadRequest, w)
@@ -119,7 +89,6 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 				setErrResponse("notifyWay is nil", http.StatusBadRequest, w)
 				return
 			}
-			variables := getVariables(alert)
 			for _, way := range ways {
 				channelAndTemplate := strings.Split(way, ":")
 				if len(channelAndTemplate) != 2 {
@@ -145,15 +114,19 @@ func registerAlarmWebhook(m *mux.PathRecorderMux, loopbackClientConfig *restclie
 					setErrResponse("receivers and receiverGroups are nil", http.StatusBadRequest, w)
 					return
 				}
-				messageRequest := newMessageRequest(channel, template, receivers, receiverGroups, variables)
+				route := alert.Route{
+					Channel:        channel,
+					Template:       template,
+					Receivers:      receivers,
+					ReceiverGroups: receiverGroups,
+				}
 
 				notifyClient := notifyinternalclient.NewForConfigOrDie(loopbackClientConfig)
-				_, err = notifyClient.MessageRequests(messageRequest.ObjectMeta.Namespace).Create(req.Context(), messageRequest, metav1.CreateOptions{})
+				err = alert.Dispatch(req.Context(), notifyClient, route, a, resolved)
 				if err != nil {
 					setErrResponse(err.Error(), http.StatusInternalServerError, w)
 					return
 				}
-				log.Infof("messageRequest created: %+v", messageRequest.Spec)
 			}
 		}
 		response := &responseMsg{
@@ -176,131 +149,3 @@ func setErrResponse(msg string, statusCode int, w http.ResponseWriter) {
 	jsonMsg, _ := json.Marshal(response)
 	http.Error(w, string(jsonMsg), statusCode)
 }
-
-func newMessageRequest(channel string, template string, receivers []string, receiverGroups []string, variables map[string]string) *notify.MessageRequest {
-	return &notify.MessageRequest{
-		ObjectMeta: metav1.ObjectMeta{
-			Namespace: channel,
-		},
-		Spec: notify.MessageRequestSpec{
-			TemplateName:   template,
-			Receivers:      receivers,
-			ReceiverGroups: receiverGroups,
-			Variables:      variables,
-		},
-	}
-}
-
-func getVariables(alert Alert) map[string]string {
-	summary := "[TKEStack alarm]"
-	variables := make(map[string]string)
-	labels := alert.Labels
-	annotations := alert.Annotations
-
-	summary = fmt.Sprintf("%s\n发生时间：%s", summary, processStartTime(alert.StartsAt))
-
-	alarmPolicyTypeValue, ok := annotations["alarmPolicyType"]
-	if ok {
-		summary = fmt.Sprintf("%s\n告警策略类型：%s", summary, alarmPolicyTypeValue)
-	}
-
-	metricDisplayNameValue, ok := annotations[metricDisplayNameKey]
-	if ok {
-		summary = fmt.Sprintf("%s\n告警项：%s", summary, metricDisplayNameValue)
-	}
-
-	valueValue, ok := annotations["value"]
-	if ok {
-		summary = fmt.Sprintf("%s\n指标值：%s", summary, valueValue)
-	}
-
-	unitValue, ok := annotations[unitKey]
-	if ok {
-		summary = fmt.Sprintf("%s%s", summary, unitValue)
-	}
-
-	evaluateTypeValue, ok := annotations[evaluateTypeKey]
-	if ok {
-		summary = fmt.Sprintf("%s %s", summary, evaluateTypeValue)
-	}
-
-	evaluateValue, ok := annotations[evaluateValueKey]
-	if ok {
-		summary = fmt.Sprintf("%s %s", summary, evaluateValue)
-	}
-
-	alarmPolicyNameValue, ok := labels["alarmPolicyName"]
-	if ok {
-		summary = fmt.Sprintf("%s\n告警策略名：%s", summary, alarmPolicyNameValue)
-	}
-
-	alertNameValue, ok := labels["alertname"]
-	if ok {
-		summary = fmt.Sprintf("%s\n指标名：%s", summary, alertNameValue)
-	}
-
-	clusterIDValue, ok := labels["cluster_id"]
-	if ok {
-		summary = fmt.Sprintf("%s\n集群ID：%s", summary, clusterIDValue)
-	}
-
-	clusterDisplayNameValue, ok := labels["cluster_display_name"]
-	if ok {
-		summary = fmt.Sprintf("%s\n集群名称：%s", summary, clusterDisplayNameValue)
-	}
-
-	workloadKindValue, ok := labels["workload_kind"]
-	if ok {
-		summary = fmt.Sprintf("%s\n工作负载类型：%s", summary, workloadKindValue)
-	}
-
-	workloadNameValue, ok := labels["workload_name"]
-	if ok {
-		summary = fmt.Sprintf("%s\n工作负载名称：%s", summary, workloadNameValue)
-	}
-
-	namespaceValue, ok := labels["namespace"]
-	if ok {
-		summary = fmt.Sprintf("%s\n命名空间：%s", summary, namespaceValue)
-	}
-
-	podNameValue, ok := labels["pod_name"]
-	if ok {
-		summary = fmt.Sprintf("%s\nPOD名称：%s", summary, podNameValue)
-	}
-
-	nodeNameValue, ok := labels["node"]
-	if ok {
-		summary = fmt.Sprintf("%s\n节点名称：%s", summary, nodeNameValue)
-	}
-
-	nodeRoleValue, ok := labels["node_role"]
-	if ok {
-		summary = fmt.Sprintf("%s\n节点类型：%s", summary, nodeRoleValue)
-	}
-
-	variables[startsAtKey] = processStartTime(alert.StartsAt)
-	variables[alarmPolicyTypeKey] = alarmPolicyTypeValue
-	variables[alarmPolicyNameKey] = alarmPolicyNameValue
-	variables[valueKey] = valueValue
-	variables[alertNameKey] = alertNameValue
-	variables[clusterIDKey] = clusterIDValue
-	variables[clusterDisplayNameKey] = clusterDisplayNameValue
-	variables[workloadKindKey] = workloadKindValue
-	variables[workloadNameKey] = workloadNameValue
-	variables[namespaceKey] = namespaceValue
-	variables[podNameKey] = podNameValue
-	variables[nodeNameKey] = nodeNameValue
-	variables[nodeRoleKey] = nodeRoleValue
-	variables[unitKey] = unitValue
-	variables[evaluateTypeKey] = evaluateTypeValue
-	variables[evaluateValueKey] = evaluateValue
-	variables[metricDisplayNameKey] = metricDisplayNameValue
-	variables[summaryKey] = summary
-
-	return variables
-}
-
-func processStartTime(t time.Time) string {
-	return t.Format("2006-01-02T15:04:05Z")
-}
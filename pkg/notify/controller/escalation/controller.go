@@ -0,0 +1,297 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package escalation
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	notifyv1informer "tkestack.io/tke/api/client/informers/externalversions/notify/v1"
+	notifyv1lister "tkestack.io/tke/api/client/listers/notify/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	clientRetryCount    = 5
+	clientRetryInterval = 5 * time.Second
+)
+
+const (
+	controllerName = "escalation"
+)
+
+// Controller is responsible for notifying the steps of escalations until
+// they are acknowledged, resolved or exhausted.
+type Controller struct {
+	client       clientset.Interface
+	queue        workqueue.RateLimitingInterface
+	lister       notifyv1lister.EscalationLister
+	listerSynced cache.InformerSynced
+	stopCh       <-chan struct{}
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, informer notifyv1informer.EscalationInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.NotifyV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.NotifyV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueEscalation,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueEscalation(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.Escalation, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueueEscalation(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting escalation controller")
+	defer log.Info("Shutting down escalation controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for escalation caches to sync")
+		return
+	}
+
+	c.stopCh = stopCh
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of escalation objects.
+// Each escalation can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncEscalation(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing escalation %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncEscalation will notify the due step of the escalation with the given
+// key and requeue it until the next step is due. This function is not meant
+// to be invoked concurrently with the same key.
+func (c *Controller) syncEscalation(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing escalation", log.String("escalationName", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	escalation, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Escalation has been deleted", log.String("escalationName", key))
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve escalation from store", log.String("escalationName", key), log.Err(err))
+		return err
+	}
+
+	if escalation.Status.Phase != v1.EscalationEscalating {
+		return nil
+	}
+	return c.escalate(context.Background(), key, escalation)
+}
+
+func (c *Controller) escalate(ctx context.Context, key string, escalation *v1.Escalation) error {
+	policy, err := c.client.NotifyV1().EscalationPolicies().Get(ctx, escalation.Spec.PolicyName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	step := int(escalation.Status.Step)
+	if step >= len(policy.Spec.Steps) {
+		escalation = escalation.DeepCopy()
+		escalation.Status.Phase = v1.EscalationExhausted
+		escalation.Status.LastTransitionTime = metav1.Now()
+		return c.persistUpdate(ctx, escalation)
+	}
+
+	since := escalation.Status.LastStepTime
+	if since.IsZero() {
+		since = escalation.ObjectMeta.CreationTimestamp
+	}
+	due := since.Add(time.Duration(policy.Spec.Steps[step].WaitSeconds) * time.Second)
+	if delay := time.Until(due); delay > 0 {
+		c.queue.AddAfter(key, delay)
+		return nil
+	}
+
+	// the message request of the step is named after it, so that the step is
+	// notified only once even if the status below fails to persist and the
+	// step is retried.
+	if err := c.notifyStep(ctx, escalation, &policy.Spec.Steps[step]); err != nil {
+		return err
+	}
+
+	escalation = escalation.DeepCopy()
+	escalation.Status.Step++
+	escalation.Status.LastStepTime = metav1.Now()
+	if int(escalation.Status.Step) >= len(policy.Spec.Steps) {
+		escalation.Status.Phase = v1.EscalationExhausted
+		escalation.Status.LastTransitionTime = metav1.Now()
+	}
+	return c.persistUpdate(ctx, escalation)
+}
+
+func (c *Controller) notifyStep(ctx context.Context, escalation *v1.Escalation, step *v1.EscalationStep) error {
+	receivers := append([]string{}, step.Receivers...)
+	for _, scheduleName := range step.OnCallSchedules {
+		receiver, err := c.onCallReceiver(ctx, scheduleName, time.Now())
+		if err != nil {
+			log.Warn("Failed to get on-call receiver", log.String("escalationName", escalation.Name), log.String("onCallSchedule", scheduleName), log.Err(err))
+			continue
+		}
+		if receiver != "" {
+			receivers = append(receivers, receiver)
+		}
+	}
+
+	messageRequest := &v1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: step.Channel,
+			Name:      messageRequestName(escalation),
+		},
+		Spec: v1.MessageRequestSpec{
+			TenantID:       escalation.Spec.TenantID,
+			TemplateName:   step.Template,
+			Receivers:      receivers,
+			ReceiverGroups: step.ReceiverGroups,
+			Variables:      escalation.Spec.Variables,
+		},
+	}
+	_, err := c.client.NotifyV1().MessageRequests(step.Channel).Create(ctx, messageRequest, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		log.Info("Escalation step has been notified", log.String("escalationName", escalation.Name), log.Int32("step", escalation.Status.Step))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("Escalation step notified", log.String("escalationName", escalation.Name), log.Int32("step", escalation.Status.Step), log.Strings("receivers", receivers), log.Strings("receiverGroups", step.ReceiverGroups))
+	return nil
+}
+
+// messageRequestName returns the name of the message request notifying the
+// current step of escalation, which is unique to the step of the escalation
+// even if an escalation of the same name is created again.
+func messageRequestName(escalation *v1.Escalation) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%d", escalation.Name, escalation.UID, escalation.Status.Step)))
+	return fmt.Sprintf("esc-%x", sum[:8])
+}
+
+func (c *Controller) onCallReceiver(ctx context.Context, scheduleName string, now time.Time) (string, error) {
+	schedule, err := c.client.NotifyV1().OnCallSchedules().Get(ctx, scheduleName, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	group, err := c.client.NotifyV1().ReceiverGroups().Get(ctx, schedule.Spec.ReceiverGroup, metav1.GetOptions{})
+	if err != nil {
+		return "", err
+	}
+	return OnCallReceiver(&schedule.Spec, group.Spec.Receivers, now), nil
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, escalation *v1.Escalation) error {
+	var err error
+	for i := 0; i < clientRetryCount; i++ {
+		_, err = c.client.NotifyV1().Escalations().UpdateStatus(ctx, escalation, metav1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		// If the object no longer exists, we don't want to recreate it. Just bail
+		// out so that we can process the delete, which we should soon be receiving
+		// if we haven't already.
+		if errors.IsNotFound(err) {
+			log.Info("Not persisting update to escalation that no longer exists", log.String("escalationName", escalation.ObjectMeta.Name), log.Err(err))
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("not persisting update to escalation '%s' that has been changed since we received it: %v", escalation.ObjectMeta.Name, err)
+		}
+		log.Warn(fmt.Sprintf("Failed to persist updated status of escalation '%s/%s'", escalation.ObjectMeta.Name, escalation.Status.Phase), log.String("escalationName", escalation.ObjectMeta.Name), log.Err(err))
+		time.Sleep(clientRetryInterval)
+	}
+
+	return err
+}
@@ -0,0 +1,110 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package escalation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	core "k8s.io/client-go/testing"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestEscalateNotifiesStepOnce(t *testing.T) {
+	policy := &v1.EscalationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy"},
+		Spec: v1.EscalationPolicySpec{
+			Steps: []v1.EscalationStep{
+				{Channel: "chn", Template: "tpl", Receivers: []string{"alice"}},
+				{Channel: "chn", Template: "tpl", Receivers: []string{"bob"}, WaitSeconds: 3600},
+			},
+		},
+	}
+	escalation := &v1.Escalation{
+		ObjectMeta: metav1.ObjectMeta{Name: "esc", UID: "uid", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Minute))},
+		Spec:       v1.EscalationSpec{PolicyName: "policy"},
+		Status:     v1.EscalationStatus{Phase: v1.EscalationEscalating},
+	}
+	client := fake.NewSimpleClientset(policy, escalation)
+	conflicted := false
+	client.PrependReactor("update", "escalations", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "status" || conflicted {
+			return false, nil, nil
+		}
+		conflicted = true
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "escalations"}, "esc", nil)
+	})
+	c := &Controller{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+	defer c.queue.ShutDown()
+	ctx := context.Background()
+
+	if err := c.escalate(ctx, "esc", escalation); err == nil {
+		t.Fatal("escalate() should fail if the status fails to persist")
+	}
+	// the step is retried with the stale escalation
+	if err := c.escalate(ctx, "esc", escalation); err != nil {
+		t.Fatalf("escalate() error = %v", err)
+	}
+
+	messageRequests, err := client.NotifyV1().MessageRequests("chn").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(messageRequests.Items) != 1 {
+		t.Errorf("message requests = %d, want the step notified once", len(messageRequests.Items))
+	}
+	got, err := client.NotifyV1().Escalations().Get(ctx, "esc", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Step != 1 || got.Status.Phase != v1.EscalationEscalating {
+		t.Errorf("status = %+v, want step 1 escalating", got.Status)
+	}
+}
+
+func TestMessageRequestName(t *testing.T) {
+	escalation := &v1.Escalation{ObjectMeta: metav1.ObjectMeta{Name: "esc", UID: "uid"}}
+	first := messageRequestName(escalation)
+	if first != messageRequestName(escalation.DeepCopy()) {
+		t.Error("messageRequestName() is not stable")
+	}
+
+	next := escalation.DeepCopy()
+	next.Status.Step = 1
+	recreated := escalation.DeepCopy()
+	recreated.UID = "uid2"
+	for _, other := range []*v1.Escalation{next, recreated} {
+		if messageRequestName(other) == first {
+			t.Errorf("messageRequestName() = %s, want different for %+v", first, other)
+		}
+	}
+	if len(first) > 63 {
+		t.Errorf("messageRequestName() = %s, want a DNS label", first)
+	}
+}
@@ -0,0 +1,36 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package escalation
+
+import (
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+// OnCallReceiver returns the receiver on call at the given time. Receivers
+// take turns in order, each for a shift of the schedule, starting from the
+// start time of the schedule. No one is on call before the start time.
+func OnCallReceiver(schedule *v1.OnCallScheduleSpec, receivers []string, now time.Time) string {
+	if len(receivers) == 0 || schedule.ShiftSeconds <= 0 || now.Before(schedule.StartTime.Time) {
+		return ""
+	}
+	shift := int64(now.Sub(schedule.StartTime.Time) / (time.Duration(schedule.ShiftSeconds) * time.Second))
+	return receivers[shift%int64(len(receivers))]
+}
@@ -0,0 +1,60 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package escalation
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestOnCallReceiver(t *testing.T) {
+	start := time.Date(2020, 1, 1, 9, 0, 0, 0, time.UTC)
+	schedule := &v1.OnCallScheduleSpec{
+		StartTime:    metav1.NewTime(start),
+		ShiftSeconds: 3600,
+	}
+	receivers := []string{"alice", "bob", "carol"}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want string
+	}{
+		{"before start", start.Add(-time.Second), ""},
+		{"first shift", start, "alice"},
+		{"end of first shift", start.Add(time.Hour - time.Second), "alice"},
+		{"second shift", start.Add(time.Hour), "bob"},
+		{"third shift", start.Add(2*time.Hour + 30*time.Minute), "carol"},
+		{"rotate back", start.Add(3 * time.Hour), "alice"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := OnCallReceiver(schedule, receivers, tt.now); got != tt.want {
+				t.Errorf("OnCallReceiver() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if got := OnCallReceiver(schedule, nil, start); got != "" {
+		t.Errorf("OnCallReceiver() with no receivers = %q, want empty", got)
+	}
+}
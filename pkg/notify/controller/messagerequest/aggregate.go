@@ -0,0 +1,192 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+// messageAggregator deduplicates the message requests of the channels with
+// aggregation, and suppresses the message requests whose deduplication key is
+// flapping.
+type messageAggregator struct {
+	mu        sync.Mutex
+	groups    map[string]*messageGroup
+	flaps     map[string]*flapState
+	lastPrune time.Time
+	now       func() time.Time
+}
+
+// aggregatorPruneInterval is the interval to drop the groups whose leader is
+// gone and the flap states not seen within their window.
+const aggregatorPruneInterval = time.Minute
+
+// messageGroup is the duplicate message requests waiting to be sent as the
+// leader.
+type messageGroup struct {
+	leader    string
+	count     int32
+	firstTime time.Time
+	flushTime time.Time
+}
+
+type flapState struct {
+	value    string
+	changes  []time.Time
+	window   time.Duration
+	lastSeen time.Time
+}
+
+// admission is the decision of the aggregator on a pending message request.
+type admission struct {
+	// phase is Pending if the message request waits for the duplicates.
+	phase v1.MessageRequestPhase
+	// delay is the time to wait before admitting the message request again.
+	delay time.Duration
+	// count is the number of message requests merged into the one sent.
+	count     int32
+	firstTime time.Time
+}
+
+func newMessageAggregator() *messageAggregator {
+	return &messageAggregator{
+		groups: make(map[string]*messageGroup),
+		flaps:  make(map[string]*flapState),
+		now:    time.Now,
+	}
+}
+
+// admit decides whether the pending message request is sent, merged into a
+// duplicate one, or suppressed.
+func (a *messageAggregator) admit(aggregation *v1.ChannelAggregation, messageRequest *v1.MessageRequest) admission {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := a.now()
+	if now.Sub(a.lastPrune) >= aggregatorPruneInterval {
+		a.prune(now)
+	}
+	key := dedupKey(aggregation, messageRequest)
+	flapValue := messageRequest.Spec.Variables[aggregation.FlapKey]
+	groupKey := key + "\x00" + flapValue
+
+	if g, ok := a.groups[groupKey]; ok && g.leader == messageRequest.ObjectMeta.Name {
+		if now.Before(g.flushTime) {
+			return admission{phase: v1.MessageRequestPending, delay: g.flushTime.Sub(now)}
+		}
+		delete(a.groups, groupKey)
+		return admission{phase: v1.MessageRequestSending, count: g.count, firstTime: g.firstTime}
+	}
+
+	if aggregation.FlapThreshold > 0 && a.flapping(key, flapValue, time.Duration(aggregation.FlapWindowSeconds)*time.Second, aggregation.FlapThreshold, now) {
+		return admission{phase: v1.MessageRequestSuppressed}
+	}
+
+	if aggregation.GroupWaitSeconds <= 0 {
+		return admission{phase: v1.MessageRequestSending, count: 1, firstTime: now}
+	}
+	if g, ok := a.groups[groupKey]; ok && now.Before(g.flushTime) {
+		g.count++
+		return admission{phase: v1.MessageRequestAggregated}
+	}
+	wait := time.Duration(aggregation.GroupWaitSeconds) * time.Second
+	a.groups[groupKey] = &messageGroup{
+		leader:    messageRequest.ObjectMeta.Name,
+		count:     1,
+		firstTime: now,
+		flushTime: now.Add(wait),
+	}
+	return admission{phase: v1.MessageRequestPending, delay: wait}
+}
+
+// flapping records the value of the flap key and reports whether it changed
+// at least threshold times within the window.
+func (a *messageAggregator) flapping(key string, value string, window time.Duration, threshold int32, now time.Time) bool {
+	s, ok := a.flaps[key]
+	if !ok {
+		a.flaps[key] = &flapState{value: value, window: window, lastSeen: now}
+		return false
+	}
+	s.window, s.lastSeen = window, now
+	if s.value != value {
+		s.value = value
+		s.changes = append(s.changes, now)
+	}
+	i := 0
+	for i < len(s.changes) && now.Sub(s.changes[i]) > window {
+		i++
+	}
+	s.changes = s.changes[i:]
+	return int32(len(s.changes)) >= threshold
+}
+
+func (a *messageAggregator) prune(now time.Time) {
+	a.lastPrune = now
+	for k, g := range a.groups {
+		if now.Sub(g.flushTime) >= aggregatorPruneInterval {
+			delete(a.groups, k)
+		}
+	}
+	for k, s := range a.flaps {
+		if now.Sub(s.lastSeen) > s.window {
+			delete(a.flaps, k)
+		}
+	}
+}
+
+// dedupKey identifies the duplicate message requests by the channel,
+// template, receivers and values of the deduplication keys, excluding the
+// flap key.
+func dedupKey(aggregation *v1.ChannelAggregation, messageRequest *v1.MessageRequest) string {
+	keys := aggregation.DedupKeys
+	if len(keys) == 0 {
+		for k := range messageRequest.Spec.Variables {
+			if k != aggregation.FlapKey {
+				keys = append(keys, k)
+			}
+		}
+	}
+	keys = append([]string{}, keys...)
+	sort.Strings(keys)
+	receivers := append([]string{}, messageRequest.Spec.Receivers...)
+	sort.Strings(receivers)
+	receiverGroups := append([]string{}, messageRequest.Spec.ReceiverGroups...)
+	sort.Strings(receiverGroups)
+
+	var b strings.Builder
+	b.WriteString(messageRequest.ObjectMeta.Namespace)
+	b.WriteString("\x00")
+	b.WriteString(messageRequest.Spec.TemplateName)
+	b.WriteString("\x00")
+	b.WriteString(strings.Join(receivers, ","))
+	b.WriteString("\x00")
+	b.WriteString(strings.Join(receiverGroups, ","))
+	for _, k := range keys {
+		b.WriteString("\x00")
+		b.WriteString(k)
+		b.WriteString("=")
+		b.WriteString(messageRequest.Spec.Variables[k])
+	}
+	return b.String()
+}
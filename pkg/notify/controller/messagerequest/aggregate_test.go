@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func newAlertMessageRequest(name string, status string) *v1.MessageRequest {
+	return &v1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ops", Name: name},
+		Spec: v1.MessageRequestSpec{
+			TemplateName: "alarm",
+			Receivers:    []string{"alice"},
+			Variables: map[string]string{
+				"alarmPolicyName": "cpu",
+				"startsAt":        name,
+				"alertStatus":     status,
+			},
+		},
+	}
+}
+
+func TestMessageAggregatorDeduplicate(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := newMessageAggregator()
+	a.now = func() time.Time { return now }
+	aggregation := &v1.ChannelAggregation{
+		DedupKeys:        []string{"alarmPolicyName"},
+		GroupWaitSeconds: 60,
+		FlapKey:          "alertStatus",
+	}
+
+	result := a.admit(aggregation, newAlertMessageRequest("a", "firing"))
+	if result.phase != v1.MessageRequestPending || result.delay != time.Minute {
+		t.Fatalf("leader should wait for the group, got %+v", result)
+	}
+	for _, name := range []string{"b", "c"} {
+		now = now.Add(time.Second)
+		if result := a.admit(aggregation, newAlertMessageRequest(name, "firing")); result.phase != v1.MessageRequestAggregated {
+			t.Errorf("duplicate %s should be aggregated, got %+v", name, result)
+		}
+	}
+	if result := a.admit(aggregation, newAlertMessageRequest("r", "resolved")); result.phase != v1.MessageRequestPending {
+		t.Errorf("resolved message should not be merged into firing ones, got %+v", result)
+	}
+
+	result = a.admit(aggregation, newAlertMessageRequest("a", "firing"))
+	if result.phase != v1.MessageRequestPending || result.delay != 58*time.Second {
+		t.Errorf("leader should keep waiting before the group is due, got %+v", result)
+	}
+
+	now = time.Unix(60, 0)
+	result = a.admit(aggregation, newAlertMessageRequest("a", "firing"))
+	if result.phase != v1.MessageRequestSending || result.count != 3 || !result.firstTime.Equal(time.Unix(0, 0)) {
+		t.Errorf("leader should be sent with the count of duplicates, got %+v", result)
+	}
+	if result := a.admit(aggregation, newAlertMessageRequest("d", "firing")); result.phase != v1.MessageRequestPending {
+		t.Errorf("message after the group is sent should start a new group, got %+v", result)
+	}
+}
+
+func TestMessageAggregatorFlapping(t *testing.T) {
+	now := time.Unix(0, 0)
+	a := newMessageAggregator()
+	a.now = func() time.Time { return now }
+	aggregation := &v1.ChannelAggregation{
+		DedupKeys:         []string{"alarmPolicyName"},
+		FlapKey:           "alertStatus",
+		FlapWindowSeconds: 600,
+		FlapThreshold:     3,
+	}
+
+	statuses := []string{"firing", "resolved", "firing", "resolved", "firing"}
+	want := []v1.MessageRequestPhase{
+		v1.MessageRequestSending,
+		v1.MessageRequestSending,
+		v1.MessageRequestSending,
+		v1.MessageRequestSuppressed,
+		v1.MessageRequestSuppressed,
+	}
+	for i, status := range statuses {
+		now = now.Add(time.Minute)
+		result := a.admit(aggregation, newAlertMessageRequest(status, status))
+		if result.phase != want[i] {
+			t.Errorf("message %d: phase = %s, want %s", i, result.phase, want[i])
+		}
+	}
+
+	now = now.Add(20 * time.Minute)
+	if result := a.admit(aggregation, newAlertMessageRequest("resolved", "resolved")); result.phase != v1.MessageRequestSending {
+		t.Errorf("message should be sent after flapping stops, got %+v", result)
+	}
+}
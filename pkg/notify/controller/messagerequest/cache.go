@@ -20,6 +20,7 @@ package messagerequest
 
 import (
 	"sync"
+	"time"
 
 	v1 "tkestack.io/tke/api/notify/v1"
 )
@@ -27,6 +28,10 @@ import (
 type cachedMessageRequest struct {
 	// The cached state of the message request
 	state *v1.MessageRequest
+	// The number of duplicate message requests merged into this one, and the
+	// time of the first one, if the channel aggregates message requests.
+	aggregatedCount int32
+	aggregatedSince time.Time
 }
 
 type messageRequestCache struct {
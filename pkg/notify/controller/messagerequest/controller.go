@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,10 +38,17 @@ import (
 	notifyv1lister "tkestack.io/tke/api/client/listers/notify/v1"
 	v1 "tkestack.io/tke/api/notify/v1"
 	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/dingtalk"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/lark"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/opsgenie"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/pagerduty"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/smtp"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/tencentcloudsms"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/voice"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/webhook"
 	"tkestack.io/tke/pkg/notify/controller/messagerequest/wechat"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/wecom"
+	"tkestack.io/tke/pkg/notify/render"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/metrics"
 )
@@ -51,9 +59,26 @@ const (
 )
 
 const (
-	controllerName = "message-request"
+	controllerName        = "message-request"
+	messageControllerName = "message-delivery"
 )
 
+const (
+	// aggregatedCountKey is the variable of the number of duplicate message
+	// requests merged into the message sent.
+	aggregatedCountKey = "aggregatedCount"
+	// aggregatedSinceKey is the variable of the time of the first duplicate
+	// message request.
+	aggregatedSinceKey = "aggregatedSince"
+	// projectKey is the variable of the project which the message is sent
+	// for, and consumes the quota of the project in the channel.
+	projectKey = "project"
+)
+
+// quotaReportPeriod is the period of reporting the quota usages to the status
+// of channels.
+const quotaReportPeriod = 30 * time.Second
+
 // Controller is responsible for performing actions dependent upon a message request controller phase.
 type Controller struct {
 	client       clientset.Interface
@@ -62,15 +87,27 @@ type Controller struct {
 	lister       notifyv1lister.MessageRequestLister
 	listerSynced cache.InformerSynced
 	stopCh       <-chan struct{}
+	rateLimiter  *channelRateLimiter
+	aggregator   *messageAggregator
+	quota        *sendingQuota
+
+	// messages failed to be delivered are retried by the message queue.
+	messageQueue        workqueue.RateLimitingInterface
+	messageLister       notifyv1lister.MessageLister
+	messageListerSynced cache.InformerSynced
 }
 
 // NewController creates a new Controller object.
-func NewController(client clientset.Interface, informer notifyv1informer.MessageRequestInformer, resyncPeriod time.Duration) *Controller {
+func NewController(client clientset.Interface, informer notifyv1informer.MessageRequestInformer, messageInformer notifyv1informer.MessageInformer, resyncPeriod time.Duration) *Controller {
 	// create the controller so we can inject the enqueue function
 	controller := &Controller{
-		client: client,
-		cache:  &messageRequestCache{messageRequestMap: make(map[string]*cachedMessageRequest)},
-		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		client:       client,
+		cache:        &messageRequestCache{messageRequestMap: make(map[string]*cachedMessageRequest)},
+		queue:        workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		messageQueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), messageControllerName),
+		rateLimiter:  newChannelRateLimiter(),
+		aggregator:   newMessageAggregator(),
+		quota:        newSendingQuota(),
 	}
 
 	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
@@ -95,6 +132,18 @@ func NewController(client clientset.Interface, informer notifyv1informer.Message
 	controller.lister = informer.Lister()
 	controller.listerSynced = informer.Informer().HasSynced
 
+	messageInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueMessage,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueMessage(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.messageLister = messageInformer.Lister()
+	controller.messageListerSynced = messageInformer.Informer().HasSynced
+
 	return controller
 }
 
@@ -117,12 +166,13 @@ func (c *Controller) needsUpdate(old *v1.MessageRequest, new *v1.MessageRequest)
 func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 	defer runtime.HandleCrash()
 	defer c.queue.ShutDown()
+	defer c.messageQueue.ShutDown()
 
 	// Start the informer factories to begin populating the informer caches
 	log.Info("Starting message request controller")
 	defer log.Info("Shutting down message request controller")
 
-	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.messageListerSynced); !ok {
 		log.Error("Failed to wait for message request caches to sync")
 		return
 	}
@@ -131,7 +181,9 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
 
 	for i := 0; i < workers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
+		go wait.Until(c.messageWorker, time.Second, stopCh)
 	}
+	go wait.Until(c.reportQuotaUsages, quotaReportPeriod, stopCh)
 
 	<-stopCh
 }
@@ -236,16 +288,42 @@ func (c *Controller) processMessageRequestUpdate(ctx context.Context, cachedMess
 func (c *Controller) createMessageRequestIfNeeded(ctx context.Context, key string, cachedMessageRequest *cachedMessageRequest, messageRequest *v1.MessageRequest) error {
 	switch messageRequest.Status.Phase {
 	case v1.MessageRequestPending:
+		phase := v1.MessageRequestSending
+		channel, err := c.client.NotifyV1().Channels().Get(ctx, messageRequest.ObjectMeta.Namespace, metav1.GetOptions{})
+		if err == nil && channel.Spec.Aggregation != nil {
+			result := c.aggregator.admit(channel.Spec.Aggregation, messageRequest)
+			if result.phase == v1.MessageRequestPending {
+				c.queue.AddAfter(key, result.delay)
+				return nil
+			}
+			phase = result.phase
+			cachedMessageRequest.aggregatedCount = result.count
+			cachedMessageRequest.aggregatedSince = result.firstTime
+			if phase != v1.MessageRequestSending {
+				log.Info("Message request will not be sent", log.String("messageRequestName", key), log.String("phase", string(phase)))
+			}
+		}
 		messageRequest = messageRequest.DeepCopy()
-		messageRequest.Status.Phase = v1.MessageRequestSending
+		messageRequest.Status.Phase = phase
 		messageRequest.Status.LastTransitionTime = metav1.Now()
 		return c.persistUpdate(ctx, messageRequest)
 	case v1.MessageRequestSending:
 		if cachedMessageRequest.state != nil && cachedMessageRequest.state.Status.Phase == v1.MessageRequestPending {
+			if cachedMessageRequest.aggregatedCount > 0 {
+				messageRequest = messageRequest.DeepCopy()
+				if messageRequest.Spec.Variables == nil {
+					messageRequest.Spec.Variables = make(map[string]string)
+				}
+				messageRequest.Spec.Variables[aggregatedCountKey] = strconv.Itoa(int(cachedMessageRequest.aggregatedCount))
+				messageRequest.Spec.Variables[aggregatedSinceKey] = cachedMessageRequest.aggregatedSince.Format(time.RFC3339)
+			}
 			sentMessages, failedReceiverErrors := c.sendMessage(ctx, messageRequest)
 			if len(sentMessages) > 0 {
 				c.archiveMessage(ctx, messageRequest, sentMessages)
 			}
+			if len(failedReceiverErrors) > 0 {
+				c.archiveFailedMessage(ctx, messageRequest, failedReceiverErrors)
+			}
 			messageRequest = messageRequest.DeepCopy()
 			messageRequest.Status.LastTransitionTime = metav1.Now()
 			if len(failedReceiverErrors) == 0 {
@@ -305,6 +383,15 @@ type sentMessage struct {
 func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.MessageRequest) (sentMessages []sentMessage, failedReceiverErrors map[string]string) {
 	failedReceiverErrors = make(map[string]string)
 	receiversSet := sets.NewString()
+	// orderedReceiverNames keeps the order of the receivers, the receivers
+	// specified directly come first, which is the order of voice calls.
+	var orderedReceiverNames []string
+	for _, receiver := range messageRequest.Spec.Receivers {
+		if receiver != "" && !receiversSet.Has(receiver) {
+			receiversSet.Insert(receiver)
+			orderedReceiverNames = append(orderedReceiverNames, receiver)
+		}
+	}
 	for _, receiverGroupName := range messageRequest.Spec.ReceiverGroups {
 		if receiverGroupName == "" {
 			continue
@@ -312,16 +399,14 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 		if receiverGroup, err := c.client.NotifyV1().ReceiverGroups().Get(ctx, receiverGroupName, metav1.GetOptions{}); err != nil {
 			log.Error("Failed to retrieve the specify receiver group", log.String("receiverGroupName", receiverGroupName), log.Err(err))
 		} else {
-			if len(receiverGroup.Spec.Receivers) != 0 {
-				receiversSet.Insert(receiverGroup.Spec.Receivers...)
+			for _, receiver := range receiverGroup.Spec.Receivers {
+				if receiver != "" && !receiversSet.Has(receiver) {
+					receiversSet.Insert(receiver)
+					orderedReceiverNames = append(orderedReceiverNames, receiver)
+				}
 			}
 		}
 	}
-	for _, receiver := range messageRequest.Spec.Receivers {
-		if receiver != "" {
-			receiversSet.Insert(receiver)
-		}
-	}
 
 	if receiversSet.Len() == 0 {
 		return
@@ -345,7 +430,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 	}
 
 	var receivers []*v1.Receiver
-	for _, receiverName := range receiversSet.List() {
+	for _, receiverName := range orderedReceiverNames {
 		receiver, err := c.client.NotifyV1().Receivers().Get(ctx, receiverName, metav1.GetOptions{})
 		if err != nil {
 			if errors.IsNotFound(err) {
@@ -371,7 +456,12 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 	if v, ok := messageRequest.Spec.Variables["clusterID"]; ok {
 		clusterID = v
 	}
+	project := messageRequest.Spec.Variables[projectKey]
 	if channel.Spec.Webhook != nil && template.Spec.Text != nil {
+		if err := c.quota.consume(channel, project, 1); err != nil {
+			failedReceiverErrors[strings.Join(receiversSet.List(), ",")] = err.Error()
+			return
+		}
 		content, err := webhook.Send(channel.Spec.Webhook, template.Spec.Text, receivers, messageRequest.Spec.Variables)
 		if err != nil {
 			failedReceiverErrors[strings.Join(receiversSet.List(), ",")] = err.Error()
@@ -389,10 +479,180 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 		})
 		return
 	}
+
+	// The group robots send one message to the group for all receivers, and
+	// mention the receivers by their identities of the robot.
+	var (
+		robotChannel   v1.ReceiverChannel
+		robotRateLimit int32
+		sendToRobot    func(mentions []string) (string, error)
+	)
+	switch {
+	case channel.Spec.WeCom != nil && template.Spec.WeCom != nil:
+		robotChannel, robotRateLimit = v1.ReceiverChannelWeCom, channel.Spec.WeCom.RateLimit
+		sendToRobot = func(mentions []string) (string, error) {
+			return wecom.Send(channel.Spec.WeCom, template.Spec.WeCom, mentions, messageRequest.Spec.Variables)
+		}
+	case channel.Spec.DingTalk != nil && template.Spec.DingTalk != nil:
+		robotChannel, robotRateLimit = v1.ReceiverChannelDingTalk, channel.Spec.DingTalk.RateLimit
+		sendToRobot = func(mentions []string) (string, error) {
+			return dingtalk.Send(channel.Spec.DingTalk, template.Spec.DingTalk, mentions, messageRequest.Spec.Variables)
+		}
+	case channel.Spec.Lark != nil && template.Spec.Lark != nil:
+		robotChannel, robotRateLimit = v1.ReceiverChannelLark, channel.Spec.Lark.RateLimit
+		sendToRobot = func(mentions []string) (string, error) {
+			return lark.Send(channel.Spec.Lark, template.Spec.Lark, mentions, messageRequest.Spec.Variables)
+		}
+	}
+	if sendToRobot != nil {
+		receiverNames := strings.Join(receiversSet.List(), ",")
+		if !c.rateLimiter.allow(channel.ObjectMeta.Name, robotRateLimit) {
+			failedReceiverErrors[receiverNames] = fmt.Sprintf("The notification channel exceeded the rate limit of %d messages per minute", robotRateLimit)
+			return
+		}
+		if err := c.quota.consume(channel, project, 1); err != nil {
+			failedReceiverErrors[receiverNames] = err.Error()
+			return
+		}
+		var mentions []string
+		for _, receiver := range receivers {
+			if identity := receiver.Spec.Identities[robotChannel]; identity != "" {
+				mentions = append(mentions, identity)
+			}
+		}
+		content, err := sendToRobot(mentions)
+		if err != nil {
+			failedReceiverErrors[receiverNames] = err.Error()
+			return
+		}
+		sentMessages = append(sentMessages, sentMessage{
+			receiverName:        receiverNames,
+			receiverChannel:     robotChannel,
+			identity:            strings.Join(mentions, ","),
+			body:                content,
+			alarmPolicyName:     alarmPolicyName,
+			alarmPolicyType:     alarmPolicyType,
+			receiverChannelName: channel.Name,
+			clusterID:           clusterID,
+		})
+		return
+	}
+
+	// The incident management services open one incident for all receivers,
+	// and close it by the incident key when the alert recovers.
+	var (
+		incidentURL  string
+		sendIncident func() (string, string, error)
+	)
+	switch {
+	case channel.Spec.PagerDuty != nil && template.Spec.PagerDuty != nil:
+		incidentURL = channel.Spec.PagerDuty.URL
+		sendIncident = func() (string, string, error) {
+			return pagerduty.Send(channel.Spec.PagerDuty, template.Spec.PagerDuty, messageRequest.Spec.Variables)
+		}
+	case channel.Spec.Opsgenie != nil && template.Spec.Opsgenie != nil:
+		incidentURL = channel.Spec.Opsgenie.URL
+		sendIncident = func() (string, string, error) {
+			var responders []string
+			for _, receiver := range receivers {
+				if email := receiver.Spec.Identities[v1.ReceiverChannelEmail]; email != "" {
+					responders = append(responders, email)
+				}
+			}
+			return opsgenie.Send(channel.Spec.Opsgenie, template.Spec.Opsgenie, responders, messageRequest.Spec.Variables)
+		}
+	}
+	if sendIncident != nil {
+		receiverNames := strings.Join(receiversSet.List(), ",")
+		if err := c.quota.consume(channel, project, 1); err != nil {
+			failedReceiverErrors[receiverNames] = err.Error()
+			return
+		}
+		content, incidentKey, err := sendIncident()
+		if err != nil {
+			failedReceiverErrors[receiverNames] = err.Error()
+			return
+		}
+		sentMessages = append(sentMessages, sentMessage{
+			receiverName:        receiverNames,
+			receiverChannel:     v1.ReceiverChannelWebhook,
+			identity:            incidentURL,
+			body:                content,
+			messageID:           incidentKey,
+			alarmPolicyName:     alarmPolicyName,
+			alarmPolicyType:     alarmPolicyType,
+			receiverChannelName: channel.Name,
+			clusterID:           clusterID,
+		})
+		return
+	}
+
+	// The voice calls are made for the alarms of the configured severities, to
+	// the receivers one after another until one of them answers.
+	if channel.Spec.Voice != nil && template.Spec.Voice != nil {
+		if !voice.Triggers(channel.Spec.Voice, messageRequest.Spec.Variables) {
+			log.Debug("Skip voice call of the alarm severity", log.String("messageRequestName", messageRequest.ObjectMeta.Name), log.String("severity", messageRequest.Spec.Variables["severity"]))
+			return
+		}
+		receiverNames := strings.Join(receiversSet.List(), ",")
+		var callees []voice.Callee
+		usernames := make(map[string]string)
+		for _, receiver := range receivers {
+			mobile := receiver.Spec.Identities[v1.ReceiverChannelMobile]
+			if mobile == "" {
+				failedReceiverErrors[receiver.ObjectMeta.Name] = "The notification recipient did not configure the mobile"
+				continue
+			}
+			callees = append(callees, voice.Callee{Name: receiver.ObjectMeta.Name, Mobile: mobile})
+			usernames[receiver.ObjectMeta.Name] = receiver.Spec.Username
+		}
+		if len(callees) == 0 {
+			return
+		}
+		caller, content, err := voice.NewCaller(channel.Spec.Voice, template.Spec.Voice, messageRequest.Spec.Variables)
+		if err != nil {
+			failedReceiverErrors[receiverNames] = err.Error()
+			return
+		}
+		answerTimeout := time.Duration(channel.Spec.Voice.AnswerTimeoutSeconds) * time.Second
+		attempts, answered := voice.Dial(caller, callees, answerTimeout, func(voice.Callee) error {
+			return c.quota.consume(channel, project, 1)
+		})
+		for _, attempt := range attempts {
+			if attempt.CallID == "" {
+				log.Warn("Failed to call the receiver", log.String("receiverName", attempt.Callee.Name), log.Err(attempt.Err))
+				continue
+			}
+			sentMessages = append(sentMessages, sentMessage{
+				receiverName:        attempt.Callee.Name,
+				receiverChannel:     v1.ReceiverChannelMobile,
+				identity:            attempt.Callee.Mobile,
+				username:            usernames[attempt.Callee.Name],
+				body:                content,
+				messageID:           attempt.CallID,
+				alarmPolicyName:     alarmPolicyName,
+				alarmPolicyType:     alarmPolicyType,
+				receiverChannelName: channel.Name,
+				clusterID:           clusterID,
+			})
+		}
+		if !answered {
+			// the receivers are joined in the order of calls, so that the
+			// retry calls them in the same order.
+			calleeNames := make([]string, 0, len(callees))
+			for _, callee := range callees {
+				calleeNames = append(calleeNames, callee.Name)
+			}
+			failedReceiverErrors[strings.Join(calleeNames, ",")] = fmt.Sprintf("None of the %d receivers answered the voice call", len(callees))
+		}
+		return
+	}
+
 	for _, receiver := range receivers {
 		receiverName := receiver.ObjectMeta.Name
+		templateSpec := render.Localize(&template.Spec, receiver.Spec.Locale)
 		templateCount := 0
-		if template.Spec.TencentCloudSMS != nil {
+		if templateSpec.TencentCloudSMS != nil {
 			templateCount++
 			if channel.Spec.TencentCloudSMS == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding tencent cloud account"
@@ -403,7 +663,11 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				failedReceiverErrors[receiverName] = "The notification recipient did not configure the mobile"
 				continue
 			}
-			messageID, body, err := tencentcloudsms.Send(channel.Spec.TencentCloudSMS, template.Spec.TencentCloudSMS, mobile, messageRequest.Spec.Variables)
+			if err := c.quota.consume(channel, project, 1); err != nil {
+				failedReceiverErrors[receiverName] = err.Error()
+				continue
+			}
+			messageID, body, err := tencentcloudsms.Send(channel.Spec.TencentCloudSMS, templateSpec.TencentCloudSMS, mobile, messageRequest.Spec.Variables)
 			if err != nil {
 				failedReceiverErrors[receiverName] = err.Error()
 				continue
@@ -421,7 +685,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				clusterID:           clusterID,
 			})
 		}
-		if template.Spec.Wechat != nil {
+		if templateSpec.Wechat != nil {
 			templateCount++
 			if channel.Spec.Wechat == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding Wechat account"
@@ -432,7 +696,11 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				failedReceiverErrors[receiverName] = "The notification recipient did not configure the Wechat openid"
 				continue
 			}
-			messageID, body, err := wechat.Send(channel.Spec.Wechat, template.Spec.Wechat, openID, messageRequest.Spec.Variables)
+			if err := c.quota.consume(channel, project, 1); err != nil {
+				failedReceiverErrors[receiverName] = err.Error()
+				continue
+			}
+			messageID, body, err := wechat.Send(channel.Spec.Wechat, templateSpec.Wechat, openID, messageRequest.Spec.Variables)
 			if err != nil {
 				failedReceiverErrors[receiverName] = err.Error()
 				continue
@@ -450,7 +718,7 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				clusterID:           clusterID,
 			})
 		}
-		if template.Spec.Text != nil {
+		if templateSpec.Text != nil {
 			templateCount++
 			if channel.Spec.SMTP == nil {
 				failedReceiverErrors[receiverName] = "The notification sending template is not configured with the corresponding smtp server"
@@ -461,7 +729,11 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 				failedReceiverErrors[receiverName] = "The notification recipient did not configure the email"
 				continue
 			}
-			header, body, err := smtp.Send(channel.Spec.SMTP, template.Spec.Text, email, messageRequest.Spec.Variables)
+			if err := c.quota.consume(channel, project, 1); err != nil {
+				failedReceiverErrors[receiverName] = err.Error()
+				continue
+			}
+			header, body, err := smtp.Send(channel.Spec.SMTP, templateSpec.Text, email, messageRequest.Spec.Variables)
 			if err != nil {
 				failedReceiverErrors[receiverName] = err.Error()
 				continue
@@ -486,6 +758,25 @@ func (c *Controller) sendMessage(ctx context.Context, messageRequest *v1.Message
 	return
 }
 
+// reportQuotaUsages updates the quota usages of the channels which sent
+// messages since last report, so that the remaining quota can be queried and
+// the usages are restored after the controller restarted.
+func (c *Controller) reportQuotaUsages() {
+	ctx := context.Background()
+	for _, channelName := range c.quota.dirtyChannels() {
+		channel, err := c.client.NotifyV1().Channels().Get(ctx, channelName, metav1.GetOptions{})
+		if err != nil {
+			log.Error("Failed to get channel object to report quota usages", log.String("channelName", channelName), log.Err(err))
+			continue
+		}
+		channel.Status.QuotaUsages = c.quota.usages(channelName)
+		channel.Status.LastQuotaUpdateTime = metav1.Now()
+		if _, err := c.client.NotifyV1().Channels().UpdateStatus(ctx, channel, metav1.UpdateOptions{}); err != nil {
+			log.Error("Failed to report quota usages of channel", log.String("channelName", channelName), log.Err(err))
+		}
+	}
+}
+
 func (c *Controller) archiveMessage(ctx context.Context, messageRequest *v1.MessageRequest, sentMessages []sentMessage) {
 	for _, sentMessage := range sentMessages {
 		message := &v1.Message{
@@ -510,6 +801,7 @@ func (c *Controller) archiveMessage(ctx context.Context, messageRequest *v1.Mess
 				Phase: v1.MessageUnread,
 			},
 		}
+		recordDeliveryAttempt(&message.Status, time.Now(), "")
 		if _, err := c.client.NotifyV1().Messages().Create(ctx, message, metav1.CreateOptions{}); err != nil {
 			log.Error("Failed to create message object", log.String("messageRequestName", messageRequest.ObjectMeta.Name), log.String("receiverName", sentMessage.receiverName), log.Err(err))
 		}
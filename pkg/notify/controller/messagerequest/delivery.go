@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+const (
+	// maxDeliveryRetries is the number of failed attempts since which the
+	// message is parked as a dead letter.
+	maxDeliveryRetries = 5
+	// maxDeliveryAttempts is the number of the latest attempts kept in the
+	// status of message.
+	maxDeliveryAttempts = 10
+
+	deliveryBackoffBase = 30 * time.Second
+	deliveryBackoffMax  = 30 * time.Minute
+)
+
+// deliveryBackoff returns the time to wait before retrying the message after
+// the given number of failed attempts, doubling from 30 seconds up to 30
+// minutes.
+func deliveryBackoff(retryCount int32) time.Duration {
+	backoff := deliveryBackoffBase
+	for i := int32(1); i < retryCount; i++ {
+		backoff *= 2
+		if backoff >= deliveryBackoffMax {
+			return deliveryBackoffMax
+		}
+	}
+	return backoff
+}
+
+// recordDeliveryAttempt updates the delivery status of message by the result
+// of an attempt, an empty error means the message has been delivered.
+func recordDeliveryAttempt(status *v1.MessageStatus, now time.Time, errMessage string) {
+	status.Attempts = append(status.Attempts, v1.MessageDeliveryAttempt{
+		Time:  metav1.NewTime(now),
+		Error: errMessage,
+	})
+	if len(status.Attempts) > maxDeliveryAttempts {
+		status.Attempts = status.Attempts[len(status.Attempts)-maxDeliveryAttempts:]
+	}
+
+	if errMessage == "" {
+		status.DeliveryPhase = v1.MessageDelivered
+		status.NextAttemptTime = metav1.Time{}
+		return
+	}
+	status.RetryCount++
+	if status.RetryCount >= maxDeliveryRetries {
+		status.DeliveryPhase = v1.MessageDeadLetter
+		status.NextAttemptTime = metav1.Time{}
+		return
+	}
+	status.DeliveryPhase = v1.MessageRetrying
+	status.NextAttemptTime = metav1.NewTime(now.Add(deliveryBackoff(status.RetryCount)))
+}
+
+// receiverChannelOf returns the identity type of receivers used by the
+// channel.
+func receiverChannelOf(channel *v1.Channel) v1.ReceiverChannel {
+	switch {
+	case channel.Spec.TencentCloudSMS != nil, channel.Spec.Voice != nil:
+		return v1.ReceiverChannelMobile
+	case channel.Spec.Wechat != nil:
+		return v1.ReceiverChannelWechatOpenID
+	case channel.Spec.SMTP != nil:
+		return v1.ReceiverChannelEmail
+	case channel.Spec.Webhook != nil, channel.Spec.PagerDuty != nil, channel.Spec.Opsgenie != nil:
+		return v1.ReceiverChannelWebhook
+	case channel.Spec.WeCom != nil:
+		return v1.ReceiverChannelWeCom
+	case channel.Spec.DingTalk != nil:
+		return v1.ReceiverChannelDingTalk
+	case channel.Spec.Lark != nil:
+		return v1.ReceiverChannelLark
+	}
+	return ""
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"testing"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestDeliveryBackoff(t *testing.T) {
+	tests := []struct {
+		retryCount int32
+		want       time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 30 * time.Minute},
+		{100, 30 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := deliveryBackoff(tt.retryCount); got != tt.want {
+			t.Errorf("deliveryBackoff(%d) = %v, want %v", tt.retryCount, got, tt.want)
+		}
+	}
+}
+
+func TestRecordDeliveryAttempt(t *testing.T) {
+	now := time.Unix(0, 0)
+	status := &v1.MessageStatus{}
+
+	recordDeliveryAttempt(status, now, "timeout")
+	if status.DeliveryPhase != v1.MessageRetrying || status.RetryCount != 1 {
+		t.Fatalf("failed message should be retried, got %+v", status)
+	}
+	if !status.NextAttemptTime.Time.Equal(now.Add(30 * time.Second)) {
+		t.Errorf("next attempt = %v, want 30s later", status.NextAttemptTime.Time)
+	}
+
+	for i := 1; i < maxDeliveryRetries; i++ {
+		recordDeliveryAttempt(status, now, "timeout")
+	}
+	if status.DeliveryPhase != v1.MessageDeadLetter || !status.NextAttemptTime.IsZero() {
+		t.Fatalf("message should be a dead letter after %d retries, got %+v", maxDeliveryRetries, status)
+	}
+
+	status.RetryCount = 0
+	for i := 0; i < maxDeliveryAttempts; i++ {
+		recordDeliveryAttempt(status, now, "timeout")
+		status.RetryCount = 0
+	}
+	recordDeliveryAttempt(status, now, "")
+	if status.DeliveryPhase != v1.MessageDelivered {
+		t.Errorf("message should be delivered, got %s", status.DeliveryPhase)
+	}
+	if len(status.Attempts) != maxDeliveryAttempts || status.Attempts[len(status.Attempts)-1].Error != "" {
+		t.Errorf("only the latest %d attempts should be kept, got %+v", maxDeliveryAttempts, status.Attempts)
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dingtalk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type markdown struct {
+	Title string `json:"title"`
+	Text  string `json:"text"`
+}
+
+type at struct {
+	AtMobiles []string `json:"atMobiles,omitempty"`
+	IsAtAll   bool     `json:"isAtAll"`
+}
+
+type bodyInfo struct {
+	MsgType  string   `json:"msgtype"`
+	Markdown markdown `json:"markdown"`
+	At       at       `json:"at"`
+}
+
+type resMessageBody struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// Send notification to the group through the dingtalk robot, and mention the
+// given mobiles at the end of the message.
+func Send(channel *v1.ChannelDingTalk, template *v1.TemplateDingTalk, mentions []string, variables map[string]string) (content string, err error) {
+	title, err := util.ParseTemplate("dingtalkTitle", template.Title, variables)
+	if err != nil {
+		return "", err
+	}
+	content, err = util.ParseTemplate("dingtalkContent", template.Content, variables)
+	if err != nil {
+		return "", err
+	}
+
+	reqURL, err := url.Parse(channel.URL)
+	if err != nil {
+		return content, err
+	}
+	query := reqURL.Query()
+	if channel.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
+		query.Set("timestamp", timestamp)
+		query.Set("sign", sign(timestamp, channel.Secret))
+	}
+
+	// The mobiles must also appear in the text, otherwise dingtalk does not
+	// highlight the mentions.
+	text := content
+	if len(mentions) > 0 {
+		var ats []string
+		for _, mobile := range mentions {
+			ats = append(ats, "@"+mobile)
+		}
+		text = content + "\n\n" + strings.Join(ats, " ")
+	}
+	reqBody := bodyInfo{
+		MsgType:  "markdown",
+		Markdown: markdown{Title: title, Text: text},
+		At:       at{AtMobiles: mentions},
+	}
+
+	option := util.Option{
+		Protocol: reqURL.Scheme,
+		Host:     reqURL.Host,
+		Path:     reqURL.Path + "?" + query.Encode(),
+		Method:   http.MethodPost,
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Body:     reqBody,
+	}
+
+	var resMessage resMessageBody
+	response, err := util.Request(option)
+	if err != nil {
+		log.Errorf("Request error %v", err)
+		return content, err
+	}
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return content, err
+	}
+	if resMessage.ErrCode != 0 {
+		return content, fmt.Errorf("post dingtalk robot error: errcode=%v, errmsg=%v", resMessage.ErrCode, resMessage.ErrMsg)
+	}
+
+	return content, nil
+}
+
+// sign computes the signature of the request with the secret of robot.
+// See: https://developers.dingtalk.com/document/app/customize-robot-security-settings
+func sign(timestamp string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,88 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dingtalk
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestDingTalkSend(t *testing.T) {
+	var (
+		query url.Values
+		body  bodyInfo
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelDingTalk{
+		URL:    server.URL + "/robot/send?access_token=token",
+		Secret: "secret",
+	}
+	template := &v1.TemplateDingTalk{
+		Title:   "{{.alarmPolicyName}}",
+		Content: "value: {{.value}}",
+	}
+	variables := map[string]string{
+		"alarmPolicyName": "cpu",
+		"value":           "90",
+	}
+	content, err := Send(channel, template, []string{"13800000000"}, variables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "value: 90" {
+		t.Errorf("unexpected content %q", content)
+	}
+	if query.Get("access_token") != "token" {
+		t.Errorf("access token is lost, query: %v", query)
+	}
+	if timestamp := query.Get("timestamp"); timestamp == "" || query.Get("sign") != sign(timestamp, "secret") {
+		t.Errorf("unexpected signature, query: %v", query)
+	}
+	if body.Markdown.Title != "cpu" {
+		t.Errorf("unexpected title %q", body.Markdown.Title)
+	}
+	if len(body.At.AtMobiles) != 1 || !strings.Contains(body.Markdown.Text, "@13800000000") {
+		t.Errorf("receiver is not mentioned: %+v", body)
+	}
+}
+
+func TestDingTalkSendError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"errcode":310000,"errmsg":"sign not match"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelDingTalk{URL: server.URL + "/robot/send?access_token=token"}
+	template := &v1.TemplateDingTalk{Title: "title", Content: "content"}
+	if _, err := Send(channel, template, nil, nil); err == nil {
+		t.Errorf("expected error when robot rejects the message")
+	}
+}
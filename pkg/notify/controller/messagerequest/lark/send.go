@@ -0,0 +1,150 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lark
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const defaultCardColor = "blue"
+
+type text struct {
+	Tag     string `json:"tag"`
+	Content string `json:"content"`
+}
+
+type header struct {
+	Title    text   `json:"title"`
+	Template string `json:"template"`
+}
+
+type element struct {
+	Tag  string `json:"tag"`
+	Text text   `json:"text"`
+}
+
+type config struct {
+	WideScreenMode bool `json:"wide_screen_mode"`
+}
+
+type card struct {
+	Config   config    `json:"config"`
+	Header   header    `json:"header"`
+	Elements []element `json:"elements"`
+}
+
+type bodyInfo struct {
+	Timestamp string `json:"timestamp,omitempty"`
+	Sign      string `json:"sign,omitempty"`
+	MsgType   string `json:"msg_type"`
+	Card      card   `json:"card"`
+}
+
+type resMessageBody struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// Send notification card to the group through the lark bot, and mention the
+// given open_ids at the end of the card.
+func Send(channel *v1.ChannelLark, template *v1.TemplateLark, mentions []string, variables map[string]string) (content string, err error) {
+	title, err := util.ParseTemplate("larkTitle", template.Title, variables)
+	if err != nil {
+		return "", err
+	}
+	content, err = util.ParseTemplate("larkContent", template.Content, variables)
+	if err != nil {
+		return "", err
+	}
+
+	reqURL, err := url.Parse(channel.URL)
+	if err != nil {
+		return content, err
+	}
+
+	color := template.Color
+	if color == "" {
+		color = defaultCardColor
+	}
+	elements := []element{{Tag: "div", Text: text{Tag: "lark_md", Content: content}}}
+	if len(mentions) > 0 {
+		var ats []string
+		for _, openID := range mentions {
+			ats = append(ats, fmt.Sprintf("<at id=%s></at>", openID))
+		}
+		elements = append(elements, element{Tag: "div", Text: text{Tag: "lark_md", Content: strings.Join(ats, " ")}})
+	}
+	reqBody := bodyInfo{
+		MsgType: "interactive",
+		Card: card{
+			Config:   config{WideScreenMode: true},
+			Header:   header{Title: text{Tag: "plain_text", Content: title}, Template: color},
+			Elements: elements,
+		},
+	}
+	if channel.Secret != "" {
+		reqBody.Timestamp = strconv.FormatInt(time.Now().Unix(), 10)
+		reqBody.Sign = sign(reqBody.Timestamp, channel.Secret)
+	}
+
+	option := util.Option{
+		Protocol: reqURL.Scheme,
+		Host:     reqURL.Host,
+		Path:     reqURL.Path,
+		Method:   http.MethodPost,
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Body:     reqBody,
+	}
+
+	var resMessage resMessageBody
+	response, err := util.Request(option)
+	if err != nil {
+		log.Errorf("Request error %v", err)
+		return content, err
+	}
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return content, err
+	}
+	if resMessage.Code != 0 {
+		return content, fmt.Errorf("post lark bot error: code=%v, msg=%v", resMessage.Code, resMessage.Msg)
+	}
+
+	return content, nil
+}
+
+// sign computes the signature of the request with the secret of bot, the
+// timestamp and secret are the key of hmac and the message is empty.
+// See: https://open.feishu.cn/document/ukTMukTMukTM/ucTM5YjL3ETO24yNxkjN
+func sign(timestamp string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lark
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestLarkSend(t *testing.T) {
+	var body bodyInfo
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"code":0,"msg":"success"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelLark{
+		URL:    server.URL + "/open-apis/bot/v2/hook/token",
+		Secret: "secret",
+	}
+	template := &v1.TemplateLark{
+		Title:   "{{.alarmPolicyName}}",
+		Content: "value: {{.value}}",
+	}
+	variables := map[string]string{
+		"alarmPolicyName": "cpu",
+		"value":           "90",
+	}
+	content, err := Send(channel, template, []string{"ou_1", "ou_2"}, variables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "value: 90" {
+		t.Errorf("unexpected content %q", content)
+	}
+	if body.Timestamp == "" || body.Sign != sign(body.Timestamp, "secret") {
+		t.Errorf("unexpected signature: %+v", body)
+	}
+	if body.MsgType != "interactive" || body.Card.Header.Title.Content != "cpu" || body.Card.Header.Template != defaultCardColor {
+		t.Errorf("unexpected card: %+v", body.Card)
+	}
+	if len(body.Card.Elements) != 2 || body.Card.Elements[1].Text.Content != "<at id=ou_1></at> <at id=ou_2></at>" {
+		t.Errorf("receivers are not mentioned: %+v", body.Card.Elements)
+	}
+}
@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+)
+
+var (
+	quotaConsumedCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_notify_quota_consumed_messages",
+			Help:           "Counter of messages sent through the notification channels broken out by channel and project.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"channel", "project"},
+	)
+
+	quotaRejectedCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_notify_quota_rejected_messages",
+			Help:           "Counter of messages rejected by the quota of the notification channels broken out by channel, project and window.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"channel", "project", "window"},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(quotaConsumedCounter)
+	legacyregistry.MustRegister(quotaRejectedCounter)
+}
+
+func recordQuotaConsumed(channelName, project string, n int32) {
+	quotaConsumedCounter.WithLabelValues(channelName, project).Add(float64(n))
+}
+
+func recordQuotaRejected(channelName, project, window string) {
+	quotaRejectedCounter.WithLabelValues(channelName, project, window).Inc()
+}
@@ -0,0 +1,136 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package opsgenie
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	defaultSource   = "tke"
+	defaultPriority = "P3"
+	alertResolved   = "resolved"
+)
+
+// defaultPriorities maps the severity label of alerts to the priority of
+// opsgenie alerts.
+var defaultPriorities = map[string]string{
+	"critical": "P1",
+	"error":    "P2",
+	"major":    "P2",
+	"warning":  "P3",
+	"info":     "P5",
+}
+
+type responder struct {
+	Type     string `json:"type"`
+	Username string `json:"username"`
+}
+
+type createBody struct {
+	Message     string            `json:"message"`
+	Alias       string            `json:"alias"`
+	Description string            `json:"description,omitempty"`
+	Responders  []responder       `json:"responders,omitempty"`
+	Priority    string            `json:"priority"`
+	Source      string            `json:"source"`
+	Details     map[string]string `json:"details,omitempty"`
+}
+
+type closeBody struct {
+	Source string `json:"source"`
+	Note   string `json:"note,omitempty"`
+}
+
+type resMessageBody struct {
+	Result    string `json:"result"`
+	Message   string `json:"message"`
+	RequestID string `json:"requestId"`
+}
+
+// Send creates an alert in opsgenie which is assigned to the given users,
+// or closes it by the alias when the alert recovers. The alias correlates
+// the alerts and is returned.
+// See: https://docs.opsgenie.com/docs/alert-api
+func Send(channel *v1.ChannelOpsgenie, template *v1.TemplateOpsgenie, responders []string, variables map[string]string) (content string, alias string, err error) {
+	content, err = util.ParseTemplate("opsgenieMessage", template.Message, variables)
+	if err != nil {
+		return "", "", err
+	}
+	description, err := util.ParseTemplate("opsgenieDescription", template.Description, variables)
+	if err != nil {
+		return content, "", err
+	}
+	alias = util.IncidentKey(variables)
+
+	apiURL := strings.TrimSuffix(channel.URL, "/") + "/v2/alerts"
+	var reqBody interface{}
+	if strings.EqualFold(variables["alertStatus"], alertResolved) {
+		apiURL = fmt.Sprintf("%s/%s/close?identifierType=alias", apiURL, url.PathEscape(alias))
+		reqBody = closeBody{Source: defaultSource, Note: description}
+	} else {
+		body := createBody{
+			Message:     content,
+			Alias:       alias,
+			Description: description,
+			Priority:    priorityOf(channel, variables["severity"]),
+			Source:      defaultSource,
+			Details:     variables,
+		}
+		for _, username := range responders {
+			body.Responders = append(body.Responders, responder{Type: "user", Username: username})
+		}
+		reqBody = body
+	}
+
+	headers := map[string]string{"Authorization": "GenieKey " + channel.APIKey}
+	response, err := util.PostJSON(apiURL, headers, reqBody)
+	if err != nil {
+		log.Errorf("Request error %v", err)
+		return content, alias, err
+	}
+	var resMessage resMessageBody
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return content, alias, err
+	}
+	if resMessage.RequestID == "" {
+		return content, alias, fmt.Errorf("post opsgenie alert error: result=%v, message=%v", resMessage.Result, resMessage.Message)
+	}
+
+	return content, alias, nil
+}
+
+// priorityOf maps the severity of alert to opsgenie priority, the mapping
+// of channel takes precedence over the default mapping.
+func priorityOf(channel *v1.ChannelOpsgenie, severity string) string {
+	if p, ok := channel.Priorities[severity]; ok {
+		return p
+	}
+	if p, ok := defaultPriorities[strings.ToLower(severity)]; ok {
+		return p
+	}
+	return defaultPriority
+}
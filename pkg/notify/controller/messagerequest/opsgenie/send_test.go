@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package opsgenie
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestOpsgenieSend(t *testing.T) {
+	var (
+		path          string
+		authorization string
+		body          map[string]interface{}
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.RequestURI()
+		authorization = r.Header.Get("Authorization")
+		body = nil
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result":"Request will be processed","took":0.1,"requestId":"id"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelOpsgenie{
+		APIKey: "key",
+		URL:    server.URL,
+	}
+	template := &v1.TemplateOpsgenie{
+		Message:     "{{.alarmPolicyName}}",
+		Description: "value: {{.value}}",
+	}
+	variables := map[string]string{
+		"alarmPolicyName": "cpu",
+		"value":           "90",
+		"severity":        "critical",
+		"fingerprint":     "abc",
+	}
+	content, alias, err := Send(channel, template, []string{"a@example.com"}, variables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "cpu" || alias != "abc" {
+		t.Errorf("unexpected content %q or alias %q", content, alias)
+	}
+	if path != "/v2/alerts" || authorization != "GenieKey key" {
+		t.Errorf("unexpected request %s with authorization %q", path, authorization)
+	}
+	if body["alias"] != "abc" || body["priority"] != "P1" || body["description"] != "value: 90" {
+		t.Errorf("unexpected alert: %+v", body)
+	}
+	if responders, ok := body["responders"].([]interface{}); !ok || len(responders) != 1 {
+		t.Errorf("unexpected responders: %+v", body["responders"])
+	}
+
+	variables["alertStatus"] = "resolved"
+	if _, _, err := Send(channel, template, nil, variables); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "/v2/alerts/abc/close?identifierType=alias" || body["source"] != defaultSource {
+		t.Errorf("unexpected close request %s: %+v", path, body)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	eventActionTrigger = "trigger"
+	eventActionResolve = "resolve"
+
+	defaultSource   = "tke"
+	defaultSeverity = "error"
+	alertResolved   = "resolved"
+)
+
+// defaultSeverities maps the severity label of alerts to the severity of
+// pagerduty events.
+var defaultSeverities = map[string]string{
+	"critical": "critical",
+	"error":    "error",
+	"major":    "error",
+	"warning":  "warning",
+	"info":     "info",
+}
+
+type payload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+type event struct {
+	RoutingKey  string   `json:"routing_key"`
+	EventAction string   `json:"event_action"`
+	DedupKey    string   `json:"dedup_key"`
+	Payload     *payload `json:"payload,omitempty"`
+}
+
+type resMessageBody struct {
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+	DedupKey string `json:"dedup_key"`
+}
+
+// Send triggers an incident of the pagerduty service, or resolves it when
+// the alert recovers. The incidents of the same alert are correlated by the
+// dedup key which is returned.
+// See: https://developer.pagerduty.com/docs/events-api-v2/trigger-events/
+func Send(channel *v1.ChannelPagerDuty, template *v1.TemplatePagerDuty, variables map[string]string) (content string, dedupKey string, err error) {
+	content, err = util.ParseTemplate("pagerDutySummary", template.Summary, variables)
+	if err != nil {
+		return "", "", err
+	}
+	dedupKey = util.IncidentKey(variables)
+
+	reqBody := event{
+		RoutingKey:  channel.RoutingKey,
+		EventAction: eventActionTrigger,
+		DedupKey:    dedupKey,
+	}
+	if strings.EqualFold(variables["alertStatus"], alertResolved) {
+		reqBody.EventAction = eventActionResolve
+	} else {
+		source, err := util.ParseTemplate("pagerDutySource", template.Source, variables)
+		if err != nil {
+			return content, dedupKey, err
+		}
+		if source == "" {
+			source = defaultSource
+		}
+		reqBody.Payload = &payload{
+			Summary:       content,
+			Source:        source,
+			Severity:      severityOf(channel, variables["severity"]),
+			CustomDetails: variables,
+		}
+	}
+
+	response, err := util.PostJSON(channel.URL, nil, reqBody)
+	if err != nil {
+		log.Errorf("Request error %v", err)
+		return content, dedupKey, err
+	}
+	var resMessage resMessageBody
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return content, dedupKey, err
+	}
+	if resMessage.Status != "success" {
+		return content, dedupKey, fmt.Errorf("post pagerduty event error: status=%v, message=%v", resMessage.Status, resMessage.Message)
+	}
+
+	return content, dedupKey, nil
+}
+
+// severityOf maps the severity of alert to pagerduty event severity, the
+// mapping of channel takes precedence over the default mapping.
+func severityOf(channel *v1.ChannelPagerDuty, severity string) string {
+	if s, ok := channel.Severities[severity]; ok {
+		return s
+	}
+	if s, ok := defaultSeverities[strings.ToLower(severity)]; ok {
+		return s
+	}
+	return defaultSeverity
+}
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestPagerDutySend(t *testing.T) {
+	var body event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"abc"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelPagerDuty{
+		RoutingKey: "key",
+		URL:        server.URL + "/v2/enqueue",
+		Severities: map[string]string{"major": "critical"},
+	}
+	template := &v1.TemplatePagerDuty{
+		Summary: "{{.alarmPolicyName}}: {{.value}}",
+	}
+	variables := map[string]string{
+		"alarmPolicyName": "cpu",
+		"value":           "90",
+		"severity":        "major",
+		"fingerprint":     "abc",
+		"alertStatus":     "firing",
+	}
+	content, dedupKey, err := Send(channel, template, variables)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "cpu: 90" || dedupKey != "abc" {
+		t.Errorf("unexpected content %q or dedup key %q", content, dedupKey)
+	}
+	if body.RoutingKey != "key" || body.EventAction != eventActionTrigger || body.DedupKey != "abc" {
+		t.Errorf("unexpected event: %+v", body)
+	}
+	if body.Payload == nil || body.Payload.Severity != "critical" || body.Payload.Source != defaultSource {
+		t.Errorf("unexpected payload: %+v", body.Payload)
+	}
+
+	variables["alertStatus"] = "resolved"
+	body = event{}
+	if _, _, err := Send(channel, template, variables); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body.EventAction != eventActionResolve || body.DedupKey != "abc" || body.Payload != nil {
+		t.Errorf("unexpected resolve event: %+v", body)
+	}
+}
+
+func TestSeverityOf(t *testing.T) {
+	channel := &v1.ChannelPagerDuty{}
+	tests := map[string]string{
+		"critical": "critical",
+		"Major":    "error",
+		"warning":  "warning",
+		"info":     "info",
+		"":         defaultSeverity,
+	}
+	for severity, expected := range tests {
+		if got := severityOf(channel, severity); got != expected {
+			t.Errorf("severityOf(%q) = %q, expected %q", severity, got, expected)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+const (
+	quotaWindowMinute = "minute"
+	quotaWindowDay    = "day"
+)
+
+// slidingWindow approximates the count of the last window by weighting the
+// count of the previous fixed window with its overlap with the sliding one.
+type slidingWindow struct {
+	size     time.Duration
+	start    time.Time
+	previous int32
+	current  int32
+}
+
+func (w *slidingWindow) advance(now time.Time) {
+	elapsed := now.Sub(w.start)
+	switch {
+	case elapsed >= 2*w.size:
+		w.start, w.previous, w.current = now, 0, 0
+	case elapsed >= w.size:
+		w.start, w.previous, w.current = w.start.Add(w.size), w.current, 0
+	}
+}
+
+func (w *slidingWindow) count(now time.Time) int32 {
+	w.advance(now)
+	weight := 1 - float64(now.Sub(w.start))/float64(w.size)
+	return int32(math.Ceil(float64(w.previous)*weight)) + w.current
+}
+
+func (w *slidingWindow) add(now time.Time, n int32) {
+	w.advance(now)
+	w.current += n
+}
+
+type quotaCounter struct {
+	minute slidingWindow
+	day    slidingWindow
+}
+
+// sendingQuota counts the messages sent through each channel and through
+// each channel for each project, and rejects the messages exceeding the
+// quota of the channel.
+type sendingQuota struct {
+	mu       sync.Mutex
+	counters map[string]*quotaCounter
+	// dirty are the channels of which the usages changed since last report.
+	dirty map[string]bool
+	now   func() time.Time
+}
+
+func newSendingQuota() *sendingQuota {
+	return &sendingQuota{
+		counters: make(map[string]*quotaCounter),
+		dirty:    make(map[string]bool),
+		now:      time.Now,
+	}
+}
+
+func quotaKey(channelName, project string) string {
+	return channelName + "/" + project
+}
+
+// counter returns the counter of the channel or the project, which is
+// restored from the usages reported in the channel status if the controller
+// restarted.
+func (q *sendingQuota) counter(channel *v1.Channel, project string, now time.Time) *quotaCounter {
+	key := quotaKey(channel.ObjectMeta.Name, project)
+	if c, ok := q.counters[key]; ok {
+		return c
+	}
+	c := &quotaCounter{
+		minute: slidingWindow{size: time.Minute, start: now},
+		day:    slidingWindow{size: 24 * time.Hour, start: now},
+	}
+	reported := now.Sub(channel.Status.LastQuotaUpdateTime.Time)
+	for _, usage := range channel.Status.QuotaUsages {
+		if usage.Project != project {
+			continue
+		}
+		if reported < c.minute.size {
+			c.minute.previous = usage.MessagesLastMinute
+		}
+		if reported < c.day.size {
+			c.day.previous = usage.MessagesLastDay
+		}
+	}
+	q.counters[key] = c
+	return c
+}
+
+// consume takes n messages from the quota of the channel and the project,
+// a message without project is only counted for the channel.
+func (q *sendingQuota) consume(channel *v1.Channel, project string, n int32) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	quota := channel.Spec.Quota
+	if quota == nil {
+		quota = &v1.ChannelQuota{}
+	}
+	channelCounter := q.counter(channel, "", now)
+	if exceeded(&channelCounter.minute, now, n, quota.MessagesPerMinute) {
+		recordQuotaRejected(channel.ObjectMeta.Name, project, quotaWindowMinute)
+		return fmt.Errorf("The notification channel exceeded the quota of %d messages per minute", quota.MessagesPerMinute)
+	}
+	if exceeded(&channelCounter.day, now, n, quota.MessagesPerDay) {
+		recordQuotaRejected(channel.ObjectMeta.Name, project, quotaWindowDay)
+		return fmt.Errorf("The notification channel exceeded the quota of %d messages per day", quota.MessagesPerDay)
+	}
+	var projectCounter *quotaCounter
+	if project != "" {
+		projectCounter = q.counter(channel, project, now)
+		if exceeded(&projectCounter.minute, now, n, quota.ProjectMessagesPerMinute) {
+			recordQuotaRejected(channel.ObjectMeta.Name, project, quotaWindowMinute)
+			return fmt.Errorf("The project %s exceeded the quota of %d messages per minute in the notification channel", project, quota.ProjectMessagesPerMinute)
+		}
+		if exceeded(&projectCounter.day, now, n, quota.ProjectMessagesPerDay) {
+			recordQuotaRejected(channel.ObjectMeta.Name, project, quotaWindowDay)
+			return fmt.Errorf("The project %s exceeded the quota of %d messages per day in the notification channel", project, quota.ProjectMessagesPerDay)
+		}
+	}
+
+	for _, c := range []*quotaCounter{channelCounter, projectCounter} {
+		if c != nil {
+			c.minute.add(now, n)
+			c.day.add(now, n)
+		}
+	}
+	q.dirty[channel.ObjectMeta.Name] = true
+	recordQuotaConsumed(channel.ObjectMeta.Name, project, n)
+	return nil
+}
+
+// exceeded reports whether n more messages exceed the limit of the window,
+// a non-positive limit means no limit.
+func exceeded(w *slidingWindow, now time.Time, n int32, limit int32) bool {
+	return limit > 0 && w.count(now)+n > limit
+}
+
+// dirtyChannels returns the channels of which the usages changed since the
+// last call.
+func (q *sendingQuota) dirtyChannels() []string {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	channelNames := make([]string, 0, len(q.dirty))
+	for channelName := range q.dirty {
+		channelNames = append(channelNames, channelName)
+	}
+	q.dirty = make(map[string]bool)
+	sort.Strings(channelNames)
+	return channelNames
+}
+
+// usages returns the usages of the channel and its projects, the counters of
+// projects without messages in the last day are dropped.
+func (q *sendingQuota) usages(channelName string) []v1.ChannelQuotaUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	now := q.now()
+	var usages []v1.ChannelQuotaUsage
+	prefix := quotaKey(channelName, "")
+	for key, c := range q.counters {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		usage := v1.ChannelQuotaUsage{
+			Project:            strings.TrimPrefix(key, prefix),
+			MessagesLastMinute: c.minute.count(now),
+			MessagesLastDay:    c.day.count(now),
+		}
+		if usage.Project != "" && usage.MessagesLastDay == 0 {
+			delete(q.counters, key)
+			continue
+		}
+		usages = append(usages, usage)
+	}
+	sort.Slice(usages, func(i, j int) bool {
+		return usages[i].Project < usages[j].Project
+	})
+	return usages
+}
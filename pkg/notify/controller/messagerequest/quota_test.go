@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestSlidingWindow(t *testing.T) {
+	now := time.Unix(0, 0)
+	w := slidingWindow{size: time.Minute, start: now}
+	w.add(now, 10)
+	if got := w.count(now.Add(30 * time.Second)); got != 10 {
+		t.Errorf("count in the first window = %d, expected 10", got)
+	}
+	// a quarter of the previous window overlaps with the sliding window
+	if got := w.count(now.Add(105 * time.Second)); got != 3 {
+		t.Errorf("count in the next window = %d, expected 3", got)
+	}
+	if got := w.count(now.Add(5 * time.Minute)); got != 0 {
+		t.Errorf("count after windows expired = %d, expected 0", got)
+	}
+}
+
+func TestSendingQuota(t *testing.T) {
+	now := time.Unix(0, 0)
+	q := newSendingQuota()
+	q.now = func() time.Time { return now }
+
+	channel := &v1.Channel{
+		ObjectMeta: metav1.ObjectMeta{Name: "sms"},
+		Spec: v1.ChannelSpec{
+			Quota: &v1.ChannelQuota{
+				MessagesPerMinute:     5,
+				MessagesPerDay:        6,
+				ProjectMessagesPerDay: 2,
+			},
+		},
+	}
+	for i := 0; i < 2; i++ {
+		if err := q.consume(channel, "prj-a", 1); err != nil {
+			t.Fatalf("message %d should be allowed: %v", i, err)
+		}
+	}
+	if err := q.consume(channel, "prj-a", 1); err == nil {
+		t.Errorf("message should be limited by the project quota")
+	}
+	for i := 0; i < 3; i++ {
+		if err := q.consume(channel, "", 1); err != nil {
+			t.Fatalf("message %d without project should be allowed: %v", i, err)
+		}
+	}
+	if err := q.consume(channel, "prj-b", 1); err == nil {
+		t.Errorf("message should be limited by the channel quota per minute")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := q.consume(channel, "prj-b", 1); err != nil {
+		t.Errorf("message should be allowed in the next minute: %v", err)
+	}
+	if err := q.consume(channel, "prj-b", 1); err == nil {
+		t.Errorf("message should be limited by the channel quota per day")
+	}
+
+	if dirty := q.dirtyChannels(); len(dirty) != 1 || dirty[0] != "sms" {
+		t.Errorf("unexpected dirty channels %v", dirty)
+	}
+	usages := q.usages("sms")
+	if len(usages) != 3 || usages[0].Project != "" || usages[0].MessagesLastDay != 6 || usages[1].Project != "prj-a" || usages[1].MessagesLastDay != 2 {
+		t.Errorf("unexpected usages %+v", usages)
+	}
+}
+
+func TestSendingQuotaRestore(t *testing.T) {
+	now := time.Unix(3600, 0)
+	q := newSendingQuota()
+	q.now = func() time.Time { return now }
+
+	channel := &v1.Channel{
+		ObjectMeta: metav1.ObjectMeta{Name: "sms"},
+		Spec: v1.ChannelSpec{
+			Quota: &v1.ChannelQuota{MessagesPerDay: 10},
+		},
+		Status: v1.ChannelStatus{
+			QuotaUsages:         []v1.ChannelQuotaUsage{{MessagesLastMinute: 1, MessagesLastDay: 10}},
+			LastQuotaUpdateTime: metav1.NewTime(now.Add(-time.Hour)),
+		},
+	}
+	if err := q.consume(channel, "", 1); err == nil {
+		t.Errorf("message should be limited by the usages restored from status")
+	}
+}
@@ -0,0 +1,69 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"sync"
+	"time"
+)
+
+// rateLimitWindow is the window in which the group robots limit the messages.
+const rateLimitWindow = time.Minute
+
+// channelRateLimiter limits the messages sent through each channel within a
+// fixed window, so that the robots do not reject or ban the webhook.
+type channelRateLimiter struct {
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+	now     func() time.Time
+}
+
+type rateWindow struct {
+	start time.Time
+	count int32
+}
+
+func newChannelRateLimiter() *channelRateLimiter {
+	return &channelRateLimiter{
+		windows: make(map[string]*rateWindow),
+		now:     time.Now,
+	}
+}
+
+// allow reports whether one more message can be sent through the channel,
+// a non-positive limit means no limit.
+func (l *channelRateLimiter) allow(channelName string, limit int32) bool {
+	if limit <= 0 {
+		return true
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.windows[channelName]
+	if !ok || now.Sub(w.start) >= rateLimitWindow {
+		w = &rateWindow{start: now}
+		l.windows[channelName] = w
+	}
+	if w.count >= limit {
+		return false
+	}
+	w.count++
+	return true
+}
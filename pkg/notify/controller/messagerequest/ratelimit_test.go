@@ -0,0 +1,50 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChannelRateLimiter(t *testing.T) {
+	now := time.Unix(0, 0)
+	l := newChannelRateLimiter()
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if !l.allow("wecom", 3) {
+			t.Fatalf("message %d should be allowed", i)
+		}
+	}
+	if l.allow("wecom", 3) {
+		t.Errorf("message should be limited after reaching the limit")
+	}
+	if !l.allow("dingtalk", 3) {
+		t.Errorf("channels should be limited separately")
+	}
+	if !l.allow("lark", 0) {
+		t.Errorf("zero limit should not limit messages")
+	}
+
+	now = now.Add(rateLimitWindow)
+	if !l.allow("wecom", 3) {
+		t.Errorf("message should be allowed in the next window")
+	}
+}
@@ -0,0 +1,183 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package messagerequest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	v1 "tkestack.io/tke/api/notify/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// obj could be an *v1.Message, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueueMessage(obj interface{}) {
+	message, ok := obj.(*v1.Message)
+	if !ok || message.Status.DeliveryPhase != v1.MessageRetrying {
+		return
+	}
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.messageQueue.Add(key)
+}
+
+// messageWorker processes the queue of messages to retry.
+func (c *Controller) messageWorker() {
+	for c.processNextMessage() {
+	}
+}
+
+func (c *Controller) processNextMessage() bool {
+	key, quit := c.messageQueue.Get()
+	if quit {
+		return false
+	}
+	defer c.messageQueue.Done(key)
+
+	err := c.retryMessage(context.Background(), key.(string))
+	if err == nil {
+		c.messageQueue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error retrying message %v (will retry): %v", key, err))
+	c.messageQueue.AddRateLimited(key)
+	return true
+}
+
+// retryMessage sends the message again to the receivers if the retry is due,
+// and records the attempt in the status of message.
+func (c *Controller) retryMessage(ctx context.Context, key string) error {
+	message, err := c.messageLister.Get(key)
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if message.Status.DeliveryPhase != v1.MessageRetrying {
+		return nil
+	}
+	if delay := time.Until(message.Status.NextAttemptTime.Time); delay > 0 {
+		c.messageQueue.AddAfter(key, delay)
+		return nil
+	}
+
+	messageRequest := &v1.MessageRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: message.Spec.ReceiverChannelName,
+			Name:      message.ObjectMeta.Name,
+		},
+		Spec: v1.MessageRequestSpec{
+			TenantID:     message.Spec.TenantID,
+			TemplateName: message.Spec.TemplateName,
+			Receivers:    strings.Split(message.Spec.ReceiverName, ","),
+			Variables:    message.Spec.Variables,
+		},
+	}
+	sentMessages, failedReceiverErrors := c.sendMessage(ctx, messageRequest)
+
+	message = message.DeepCopy()
+	if len(failedReceiverErrors) == 0 && len(sentMessages) > 0 {
+		sent := sentMessages[0]
+		message.Spec.ReceiverChannel = sent.receiverChannel
+		message.Spec.Identity = sent.identity
+		message.Spec.Username = sent.username
+		message.Spec.Header = sent.header
+		message.Spec.Body = sent.body
+		message.Spec.ChannelMessageID = sent.messageID
+		recordDeliveryAttempt(&message.Status, time.Now(), "")
+		_, err = c.client.NotifyV1().Messages().Update(ctx, message, metav1.UpdateOptions{})
+		if err == nil {
+			log.Info("Message delivered after retry", log.String("messageName", message.ObjectMeta.Name), log.Int32("retryCount", message.Status.RetryCount))
+		}
+		return err
+	}
+
+	errMessage := joinReceiverErrors(failedReceiverErrors)
+	if errMessage == "" {
+		errMessage = "No receiver to send the message to"
+	}
+	recordDeliveryAttempt(&message.Status, time.Now(), errMessage)
+	if message.Status.DeliveryPhase == v1.MessageDeadLetter {
+		log.Warn("Message failed to be delivered after retries", log.String("messageName", message.ObjectMeta.Name), log.String("error", errMessage))
+	}
+	_, err = c.client.NotifyV1().Messages().UpdateStatus(ctx, message, metav1.UpdateOptions{})
+	return err
+}
+
+// archiveFailedMessage records the receivers failed to send to as messages to
+// be retried.
+func (c *Controller) archiveFailedMessage(ctx context.Context, messageRequest *v1.MessageRequest, failedReceiverErrors map[string]string) {
+	channel, err := c.client.NotifyV1().Channels().Get(ctx, messageRequest.ObjectMeta.Namespace, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Failed to get channel object, the failed messages will not be retried", log.String("messageRequestName", messageRequest.ObjectMeta.Name), log.Err(err))
+		return
+	}
+	receiverChannel := receiverChannelOf(channel)
+	now := time.Now()
+	for receiverName, errMessage := range failedReceiverErrors {
+		message := &v1.Message{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s-%s-retry", messageRequest.ObjectMeta.Name, strings.Split(receiverName, ",")[0]),
+			},
+			Spec: v1.MessageSpec{
+				TenantID:            messageRequest.Spec.TenantID,
+				ReceiverName:        receiverName,
+				ReceiverChannel:     receiverChannel,
+				AlarmPolicyName:     messageRequest.Spec.Variables["alarmPolicyName"],
+				AlarmPolicyType:     messageRequest.Spec.Variables["alarmPolicyType"],
+				ReceiverChannelName: channel.ObjectMeta.Name,
+				ClusterID:           messageRequest.Spec.Variables["clusterID"],
+				TemplateName:        messageRequest.Spec.TemplateName,
+				Variables:           messageRequest.Spec.Variables,
+			},
+			Status: v1.MessageStatus{
+				Phase: v1.MessageUnread,
+			},
+		}
+		recordDeliveryAttempt(&message.Status, now, errMessage)
+		if _, err := c.client.NotifyV1().Messages().Create(ctx, message, metav1.CreateOptions{}); err != nil {
+			log.Error("Failed to create failed message object", log.String("messageRequestName", messageRequest.ObjectMeta.Name), log.String("receiverName", receiverName), log.Err(err))
+		}
+	}
+}
+
+func joinReceiverErrors(failedReceiverErrors map[string]string) string {
+	receivers := make([]string, 0, len(failedReceiverErrors))
+	for receiver := range failedReceiverErrors {
+		receivers = append(receivers, receiver)
+	}
+	sort.Strings(receivers)
+	errs := make([]string, 0, len(receivers))
+	for _, receiver := range receivers {
+		errs = append(errs, fmt.Sprintf("%s: %s", receiver, failedReceiverErrors[receiver]))
+	}
+	return strings.Join(errs, "; ")
+}
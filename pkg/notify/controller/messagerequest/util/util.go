@@ -23,11 +23,14 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
-	htmlTemplate "html/template"
+	"hash/fnv"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
+	"tkestack.io/tke/pkg/notify/render"
 	"tkestack.io/tke/pkg/util/log"
 )
 
@@ -85,15 +88,77 @@ func Request(options Option) ([]byte, error) {
 	return ioutil.ReadAll(resp.Body)
 }
 
-// ParseString is used to get body according to template
-func ParseTemplate(name string, template string, variables map[string]string) (string, error) {
-	var buffer bytes.Buffer
-	tmplBody := htmlTemplate.Must(htmlTemplate.New(name).Parse(template))
-	err := tmplBody.Execute(&buffer, variables)
+// PostJSON posts the body as json to the url, and accepts any 2xx response
+// since the incident management services reply 202 for queued events.
+func PostJSON(url string, headers map[string]string, body interface{}) ([]byte, error) {
+	rawBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	log.Debugf("rawBody: %v", string(rawBody))
+	return doJSON(http.MethodPost, url, headers, bytes.NewReader(rawBody))
+}
+
+// GetJSON gets the json response of the url, and accepts any 2xx response.
+func GetJSON(url string, headers map[string]string) ([]byte, error) {
+	return doJSON(http.MethodGet, url, headers, nil)
+}
+
+func doJSON(method string, url string, headers map[string]string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	c := http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+		},
+	}
+	resp, err := c.Do(req)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return respBody, fmt.Errorf("http %s error : url=%v , statusCode=%v, body=%s", strings.ToLower(method), url, resp.StatusCode, respBody)
 	}
-	return buffer.String(), nil
+	return respBody, nil
+}
+
+// incidentKeyVariables identify the alerting object when the alert does not
+// carry a fingerprint.
+var incidentKeyVariables = []string{"alarmPolicyName", "clusterID", "alertName", "workloadKind", "namespace", "workloadName", "podName", "nodeName"}
+
+// IncidentKey returns the key which correlates the firing and resolved
+// notifications of an alert to the same incident.
+func IncidentKey(variables map[string]string) string {
+	if fingerprint := variables["fingerprint"]; fingerprint != "" {
+		return fingerprint
+	}
+	h := fnv.New64a()
+	for _, k := range incidentKeyVariables {
+		_, _ = h.Write([]byte(variables[k]))
+		_, _ = h.Write([]byte{0})
+	}
+	return fmt.Sprintf("tke-%016x", h.Sum64())
+}
+
+// ParseTemplate is used to get body according to template
+func ParseTemplate(name string, template string, variables map[string]string) (string, error) {
+	return render.Render(name, template, variables)
 }
 
 // GetCurrentTime returns current timestamp
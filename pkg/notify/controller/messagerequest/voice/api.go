@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package voice
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+)
+
+type callBody struct {
+	Callee  string `json:"callee"`
+	Content string `json:"content"`
+}
+
+type callResponse struct {
+	CallID string `json:"callID"`
+}
+
+type statusResponse struct {
+	Status string `json:"status"`
+}
+
+// apiCaller calls through the HTTP API of a voice provider, see
+// ChannelVoiceAPI for the protocol.
+type apiCaller struct {
+	channel *v1.ChannelVoiceAPI
+	content string
+}
+
+func newAPICaller(channel *v1.ChannelVoiceAPI, content string) *apiCaller {
+	return &apiCaller{channel: channel, content: content}
+}
+
+func (c *apiCaller) Call(mobile string) (string, error) {
+	response, err := util.PostJSON(c.channel.URL, c.channel.Headers, callBody{Callee: mobile, Content: c.content})
+	if err != nil {
+		return "", err
+	}
+	var res callResponse
+	if err := json.Unmarshal(response, &res); err != nil {
+		return "", err
+	}
+	if res.CallID == "" {
+		return "", fmt.Errorf("post voice call error: no call id in response %s", response)
+	}
+	return res.CallID, nil
+}
+
+func (c *apiCaller) Status(callID string) (CallStatus, error) {
+	response, err := util.GetJSON(strings.TrimSuffix(c.channel.URL, "/")+"/"+url.PathEscape(callID), c.channel.Headers)
+	if err != nil {
+		return CallInProgress, err
+	}
+	var res statusResponse
+	if err := json.Unmarshal(response, &res); err != nil {
+		return CallInProgress, err
+	}
+	switch strings.ToLower(res.Status) {
+	case "answered":
+		return CallAnswered, nil
+	case "failed", "busy", "noanswer", "canceled":
+		return CallUnanswered, nil
+	}
+	return CallInProgress, nil
+}
@@ -0,0 +1,226 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package voice
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+)
+
+const (
+	chineseCode = "86"
+	// pullStatusMax is the maximum number of call reports pulled at a time.
+	pullStatusMax = 100
+	// reportTTL is the time the reports not queried are kept, such as the
+	// reports of the calls which exceeded the answer timeout.
+	reportTTL = time.Hour
+)
+
+var (
+	tencentCloudVMSCallURL   = "https://cloud.tim.qq.com/v5/tlsvoicesvr/sendtvoice"
+	tencentCloudVMSStatusURL = "https://cloud.tim.qq.com/v5/tlsvoicesvr/pullstatus"
+
+	variableRegexp = regexp.MustCompile(`{{\.([a-zA-Z_][a-zA-Z0-9_]*)}}`)
+)
+
+type telInfo struct {
+	NationCode string `json:"nationcode"`
+	Mobile     string `json:"mobile"`
+}
+
+type vmsCallBody struct {
+	Tel       telInfo  `json:"tel"`
+	TplID     int      `json:"tpl_id"`
+	Params    []string `json:"params"`
+	PlayTimes int32    `json:"playtimes"`
+	Sig       string   `json:"sig"`
+	Time      int64    `json:"time"`
+}
+
+type vmsCallResponse struct {
+	Result int    `json:"result"`
+	Errmsg string `json:"errmsg"`
+	CallID string `json:"callid"`
+}
+
+type vmsStatusBody struct {
+	Sig  string `json:"sig"`
+	Time int64  `json:"time"`
+	Type int    `json:"type"`
+	Max  int    `json:"max"`
+}
+
+type vmsReport struct {
+	CallID     string `json:"callid"`
+	AcceptTime string `json:"accept_time"`
+}
+
+type vmsStatusResponse struct {
+	Result int         `json:"result"`
+	Errmsg string      `json:"errmsg"`
+	Data   []vmsReport `json:"data"`
+}
+
+// vmsReports keeps the reports of calls pulled from Tencent Cloud VMS, since
+// a report is returned only once and may belong to a call of another
+// message.
+var vmsReports = struct {
+	sync.Mutex
+	status     map[string]CallStatus
+	reportedAt map[string]time.Time
+}{status: make(map[string]CallStatus), reportedAt: make(map[string]time.Time)}
+
+// popReport returns and removes the report of the call.
+func popReport(callID string) (CallStatus, bool) {
+	status, ok := vmsReports.status[callID]
+	delete(vmsReports.status, callID)
+	delete(vmsReports.reportedAt, callID)
+	return status, ok
+}
+
+// tencentCloudVMSCaller calls through Tencent Cloud Voice Message Service
+// with the voice template.
+// See: https://cloud.tencent.com/document/product/1128
+type tencentCloudVMSCaller struct {
+	channel   *v1.ChannelTencentCloudVMS
+	template  *v1.TemplateVoice
+	variables map[string]string
+}
+
+func newTencentCloudVMSCaller(channel *v1.ChannelTencentCloudVMS, template *v1.TemplateVoice, variables map[string]string) *tencentCloudVMSCaller {
+	return &tencentCloudVMSCaller{channel: channel, template: template, variables: variables}
+}
+
+func (c *tencentCloudVMSCaller) Call(mobile string) (string, error) {
+	tplID, err := strconv.Atoi(c.template.TemplateID)
+	if err != nil {
+		return "", err
+	}
+	random := getRandom()
+	now := util.GetCurrentTime()
+	reqBody := vmsCallBody{
+		Tel:       telInfo{NationCode: chineseCode, Mobile: mobile},
+		TplID:     tplID,
+		Params:    templateParams(c.variables, c.template.Content),
+		PlayTimes: c.channel.PlayTimes,
+		Sig:       calculateSignature("appkey=" + c.channel.AppKey + "&random=" + strconv.Itoa(random) + "&time=" + strconv.FormatInt(now, 10) + "&mobile=" + mobile),
+		Time:      now,
+	}
+
+	response, err := util.PostJSON(c.requestURL(tencentCloudVMSCallURL, random), nil, reqBody)
+	if err != nil {
+		return "", err
+	}
+	var res vmsCallResponse
+	if err := json.Unmarshal(response, &res); err != nil {
+		return "", err
+	}
+	if res.Result != 0 {
+		return "", fmt.Errorf("post tencent cloud vms error: errcode=%v, errmsg=%v", res.Result, res.Errmsg)
+	}
+	return res.CallID, nil
+}
+
+// Status pulls the call reports of the application and returns the state
+// of the call. A call without report is in progress, and a reported call is
+// answered if it has the accept time.
+func (c *tencentCloudVMSCaller) Status(callID string) (CallStatus, error) {
+	vmsReports.Lock()
+	defer vmsReports.Unlock()
+
+	if status, ok := popReport(callID); ok {
+		return status, nil
+	}
+
+	random := getRandom()
+	now := util.GetCurrentTime()
+	reqBody := vmsStatusBody{
+		Sig:  calculateSignature("appkey=" + c.channel.AppKey + "&random=" + strconv.Itoa(random) + "&time=" + strconv.FormatInt(now, 10)),
+		Time: now,
+		Max:  pullStatusMax,
+	}
+	response, err := util.PostJSON(c.requestURL(tencentCloudVMSStatusURL, random), nil, reqBody)
+	if err != nil {
+		return CallInProgress, err
+	}
+	var res vmsStatusResponse
+	if err := json.Unmarshal(response, &res); err != nil {
+		return CallInProgress, err
+	}
+	if res.Result != 0 {
+		return CallInProgress, fmt.Errorf("pull tencent cloud vms status error: errcode=%v, errmsg=%v", res.Result, res.Errmsg)
+	}
+	for _, report := range res.Data {
+		status := CallUnanswered
+		if report.AcceptTime != "" && report.AcceptTime != "0" {
+			status = CallAnswered
+		}
+		vmsReports.status[report.CallID] = status
+		vmsReports.reportedAt[report.CallID] = time.Now()
+	}
+	for id, reportedAt := range vmsReports.reportedAt {
+		if time.Since(reportedAt) > reportTTL {
+			popReport(id)
+		}
+	}
+
+	if status, ok := popReport(callID); ok {
+		return status, nil
+	}
+	return CallInProgress, nil
+}
+
+func (c *tencentCloudVMSCaller) requestURL(apiURL string, random int) string {
+	return apiURL + "?sdkappid=" + c.channel.SdkAppID + "&random=" + strconv.Itoa(random)
+}
+
+func getRandom() int {
+	min := 100000
+	max := 999999
+	return rand.Intn(max-min) + min
+}
+
+func calculateSignature(text string) string {
+	h := sha256.New()
+	if _, err := h.Write([]byte(text)); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// templateParams returns the values of the variables referenced in the
+// content in order, which fill the parameters of the voice template.
+func templateParams(variables map[string]string, content string) []string {
+	keys := variableRegexp.FindAllStringSubmatch(content, -1)
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+		params = append(params, variables[key[1]])
+	}
+	return params
+}
@@ -0,0 +1,143 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package voice
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+)
+
+// CallStatus is the state of a voice call.
+type CallStatus string
+
+const (
+	// CallInProgress means the call is ringing or has not been reported.
+	CallInProgress CallStatus = "InProgress"
+	// CallAnswered means the callee answered the call.
+	CallAnswered CallStatus = "Answered"
+	// CallUnanswered means the call ended without being answered.
+	CallUnanswered CallStatus = "Unanswered"
+)
+
+// pollInterval is the interval to query the state of calls.
+var pollInterval = 5 * time.Second
+
+// Caller places the voice calls and queries their states.
+type Caller interface {
+	// Call calls the mobile and returns the id of the call.
+	Call(mobile string) (callID string, err error)
+	// Status returns the state of the call.
+	Status(callID string) (CallStatus, error)
+}
+
+// NewCaller creates the caller of the voice provider of the channel, and
+// returns the content read out in the calls.
+func NewCaller(channel *v1.ChannelVoice, template *v1.TemplateVoice, variables map[string]string) (Caller, string, error) {
+	content, err := util.ParseTemplate("voiceContent", template.Content, variables)
+	if err != nil {
+		return nil, "", err
+	}
+	switch {
+	case channel.TencentCloudVMS != nil:
+		return newTencentCloudVMSCaller(channel.TencentCloudVMS, template, variables), content, nil
+	case channel.API != nil:
+		return newAPICaller(channel.API, content), content, nil
+	}
+	return nil, content, fmt.Errorf("voice provider is not configured")
+}
+
+// Triggers reports whether the alarm of the severity triggers calls. The
+// resolved alarms never trigger calls.
+func Triggers(channel *v1.ChannelVoice, variables map[string]string) bool {
+	if strings.EqualFold(variables["alertStatus"], "resolved") {
+		return false
+	}
+	severity := variables["severity"]
+	for _, s := range channel.Severities {
+		if strings.EqualFold(s, severity) {
+			return true
+		}
+	}
+	return false
+}
+
+// Callee is a receiver to call.
+type Callee struct {
+	Name   string
+	Mobile string
+}
+
+// Attempt records a call to a callee.
+type Attempt struct {
+	Callee Callee
+	CallID string
+	Status CallStatus
+	Err    error
+}
+
+// Dial calls the callees one after another until one of them answers, the
+// next callee is called when the call is not answered in the answer timeout.
+// The admit function is consulted before each call, such as the quota of the
+// channel, and the callee is skipped if it returns an error.
+func Dial(caller Caller, callees []Callee, answerTimeout time.Duration, admit func(Callee) error) (attempts []Attempt, answered bool) {
+	for _, callee := range callees {
+		attempt := Attempt{Callee: callee}
+		if err := admit(callee); err != nil {
+			attempt.Err = err
+			attempts = append(attempts, attempt)
+			continue
+		}
+		attempt.CallID, attempt.Err = caller.Call(callee.Mobile)
+		if attempt.Err == nil {
+			attempt.Status, attempt.Err = waitAnswer(caller, attempt.CallID, answerTimeout)
+		}
+		attempts = append(attempts, attempt)
+		if attempt.Status == CallAnswered {
+			return attempts, true
+		}
+	}
+	return attempts, false
+}
+
+// waitAnswer polls the state of the call until it is answered or ended, or
+// the answer timeout elapses.
+func waitAnswer(caller Caller, callID string, answerTimeout time.Duration) (CallStatus, error) {
+	deadline := time.Now().Add(answerTimeout)
+	for {
+		status, err := caller.Status(callID)
+		if err != nil {
+			return CallInProgress, err
+		}
+		if status != CallInProgress {
+			return status, nil
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return CallUnanswered, nil
+		}
+		if remaining > pollInterval {
+			remaining = pollInterval
+		}
+		time.Sleep(remaining)
+	}
+}
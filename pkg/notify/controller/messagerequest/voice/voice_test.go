@@ -0,0 +1,158 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package voice
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+type fakeCaller struct {
+	answered map[string]bool
+	calls    []string
+}
+
+func (c *fakeCaller) Call(mobile string) (string, error) {
+	c.calls = append(c.calls, mobile)
+	if mobile == "" {
+		return "", errors.New("no mobile")
+	}
+	return "call-" + mobile, nil
+}
+
+func (c *fakeCaller) Status(callID string) (CallStatus, error) {
+	if c.answered[callID] {
+		return CallAnswered, nil
+	}
+	return CallInProgress, nil
+}
+
+func TestDial(t *testing.T) {
+	pollInterval = time.Millisecond
+	callees := []Callee{{Name: "alice", Mobile: "1"}, {Name: "bob", Mobile: "2"}, {Name: "carol", Mobile: "3"}}
+	admit := func(Callee) error { return nil }
+
+	caller := &fakeCaller{answered: map[string]bool{"call-2": true}}
+	attempts, answered := Dial(caller, callees, 5*time.Millisecond, admit)
+	if !answered {
+		t.Fatalf("Dial() answered = false, want true")
+	}
+	if !reflect.DeepEqual(caller.calls, []string{"1", "2"}) {
+		t.Errorf("Dial() called %v, want the alternate to be called after the primary", caller.calls)
+	}
+	if len(attempts) != 2 || attempts[0].Status != CallUnanswered || attempts[1].Status != CallAnswered {
+		t.Errorf("Dial() attempts = %+v", attempts)
+	}
+
+	caller = &fakeCaller{}
+	rejected := func(callee Callee) error {
+		if callee.Name == "alice" {
+			return errors.New("quota exceeded")
+		}
+		return nil
+	}
+	attempts, answered = Dial(caller, callees, time.Millisecond, rejected)
+	if answered {
+		t.Errorf("Dial() answered = true, want false")
+	}
+	if !reflect.DeepEqual(caller.calls, []string{"2", "3"}) {
+		t.Errorf("Dial() called %v, want the rejected callee to be skipped", caller.calls)
+	}
+	if len(attempts) != 3 || attempts[0].Err == nil {
+		t.Errorf("Dial() attempts = %+v", attempts)
+	}
+}
+
+func TestTriggers(t *testing.T) {
+	channel := &v1.ChannelVoice{Severities: []string{"critical"}}
+	tests := []struct {
+		variables map[string]string
+		want      bool
+	}{
+		{map[string]string{"severity": "critical"}, true},
+		{map[string]string{"severity": "Critical"}, true},
+		{map[string]string{"severity": "warning"}, false},
+		{map[string]string{}, false},
+		{map[string]string{"severity": "critical", "alertStatus": "resolved"}, false},
+	}
+	for _, tt := range tests {
+		if got := Triggers(channel, tt.variables); got != tt.want {
+			t.Errorf("Triggers(%v) = %v, want %v", tt.variables, got, tt.want)
+		}
+	}
+}
+
+func TestAPICaller(t *testing.T) {
+	var body callBody
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_, _ = w.Write([]byte(`{"callID":"c1"}`))
+		case http.MethodGet:
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"status":%q}`, map[string]string{"/calls/c1": "answered"}[r.URL.Path])))
+		}
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelVoice{API: &v1.ChannelVoiceAPI{
+		URL:     server.URL + "/calls",
+		Headers: map[string]string{"Authorization": "Bearer token"},
+	}}
+	template := &v1.TemplateVoice{Content: "{{.alarmPolicyName}} is firing"}
+	caller, content, err := NewCaller(channel, template, map[string]string{"alarmPolicyName": "cpu"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "cpu is firing" {
+		t.Errorf("unexpected content %q", content)
+	}
+	callID, err := caller.Call("13800000000")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if callID != "c1" || body.Callee != "13800000000" || body.Content != content {
+		t.Errorf("unexpected call %q of body %+v", callID, body)
+	}
+	if status, err := caller.Status(callID); err != nil || status != CallAnswered {
+		t.Errorf("Status() = %v, %v, want answered", status, err)
+	}
+	if status, err := caller.Status("c2"); err != nil || status != CallInProgress {
+		t.Errorf("Status() = %v, %v, want in progress", status, err)
+	}
+}
+
+func TestTemplateParams(t *testing.T) {
+	params := templateParams(map[string]string{"alarmPolicyName": "cpu", "value": "90"}, "{{.alarmPolicyName}} is {{.value}}, {{.unknown}}")
+	if !reflect.DeepEqual(params, []string{"cpu", "90", ""}) {
+		t.Errorf("templateParams() = %v", params)
+	}
+}
@@ -0,0 +1,96 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package wecom
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+	"tkestack.io/tke/pkg/notify/controller/messagerequest/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+type markdown struct {
+	Content string `json:"content"`
+}
+
+type bodyInfo struct {
+	MsgType  string   `json:"msgtype"`
+	Markdown markdown `json:"markdown"`
+}
+
+type resMessageBody struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// Send notification to the group through the wecom robot, and mention the
+// given userids at the end of the message.
+func Send(channel *v1.ChannelWeCom, template *v1.TemplateWeCom, mentions []string, variables map[string]string) (content string, err error) {
+	content, err = util.ParseTemplate("wecomContent", template.Content, variables)
+	if err != nil {
+		return "", err
+	}
+
+	reqURL, err := url.Parse(channel.URL)
+	if err != nil {
+		return content, err
+	}
+
+	text := content
+	if len(mentions) > 0 {
+		var at []string
+		for _, userID := range mentions {
+			at = append(at, fmt.Sprintf("<@%s>", userID))
+		}
+		text = content + "\n" + strings.Join(at, " ")
+	}
+	reqBody := bodyInfo{
+		MsgType:  "markdown",
+		Markdown: markdown{Content: text},
+	}
+
+	option := util.Option{
+		Protocol: reqURL.Scheme,
+		Host:     reqURL.Host,
+		Path:     reqURL.Path + "?" + reqURL.RawQuery,
+		Method:   http.MethodPost,
+		Headers:  map[string]string{"Content-Type": "application/json"},
+		Body:     reqBody,
+	}
+
+	var resMessage resMessageBody
+	response, err := util.Request(option)
+	if err != nil {
+		log.Errorf("Request error %v", err)
+		return content, err
+	}
+	if err = json.Unmarshal(response, &resMessage); err != nil {
+		return content, err
+	}
+	if resMessage.ErrCode != 0 {
+		return content, fmt.Errorf("post wecom robot error: errcode=%v, errmsg=%v", resMessage.ErrCode, resMessage.ErrMsg)
+	}
+
+	return content, nil
+}
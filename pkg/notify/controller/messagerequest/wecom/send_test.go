@@ -0,0 +1,57 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package wecom
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestWeComSend(t *testing.T) {
+	var (
+		key  string
+		body bodyInfo
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key = r.URL.Query().Get("key")
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		_, _ = w.Write([]byte(`{"errcode":0,"errmsg":"ok"}`))
+	}))
+	defer server.Close()
+
+	channel := &v1.ChannelWeCom{URL: server.URL + "/cgi-bin/webhook/send?key=robot"}
+	template := &v1.TemplateWeCom{Content: "value: {{.value}}"}
+	content, err := Send(channel, template, []string{"zhangsan"}, map[string]string{"value": "90"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content != "value: 90" {
+		t.Errorf("unexpected content %q", content)
+	}
+	if key != "robot" {
+		t.Errorf("key of robot is lost")
+	}
+	if body.MsgType != "markdown" || body.Markdown.Content != "value: 90\n<@zhangsan>" {
+		t.Errorf("unexpected message: %+v", body)
+	}
+}
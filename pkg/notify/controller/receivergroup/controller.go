@@ -0,0 +1,280 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package receivergroup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	authv1client "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	notifyv1informer "tkestack.io/tke/api/client/informers/externalversions/notify/v1"
+	notifyv1lister "tkestack.io/tke/api/client/listers/notify/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+	authutil "tkestack.io/tke/pkg/auth/util"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "receivergroup-sync"
+)
+
+// Controller is responsible for synchronizing the receiver groups which map
+// to the groups of tke-auth, and the receivers of their members.
+type Controller struct {
+	client       clientset.Interface
+	authClient   authv1client.AuthV1Interface
+	queue        workqueue.RateLimitingInterface
+	lister       notifyv1lister.ReceiverGroupLister
+	listerSynced cache.InformerSynced
+	stopCh       <-chan struct{}
+}
+
+// NewController creates a new Controller object. The receiver groups are
+// resynchronized in the resync period, so that the changes of identity
+// providers are followed.
+func NewController(client clientset.Interface, authClient authv1client.AuthV1Interface, informer notifyv1informer.ReceiverGroupInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client:     client,
+		authClient: authClient,
+		queue:      workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.NotifyV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.NotifyV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueReceiverGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				controller.enqueueReceiverGroup(newObj)
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.ReceiverGroup, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueueReceiverGroup(obj interface{}) {
+	if group, ok := obj.(*v1.ReceiverGroup); ok && group.Spec.AuthGroup == "" {
+		return
+	}
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting receiver group sync controller")
+	defer log.Info("Shutting down receiver group sync controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		log.Error("Failed to wait for receiver group caches to sync")
+		return
+	}
+
+	c.stopCh = stopCh
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+}
+
+// worker processes the queue of receiver group objects.
+// Each receiver group can be in the queue at most once.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncReceiverGroup(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing receiver group %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncReceiverGroup will synchronize the receivers of the receiver group with
+// the members of the group of tke-auth. This function is not meant to be
+// invoked concurrently with the same key.
+func (c *Controller) syncReceiverGroup(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Debug("Finished syncing receiver group", log.String("receiverGroupName", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	receiverGroup, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("Receiver group has been deleted", log.String("receiverGroupName", key))
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve receiver group from store", log.String("receiverGroupName", key), log.Err(err))
+		return err
+	}
+
+	if receiverGroup.Spec.AuthGroup == "" {
+		return nil
+	}
+	return c.sync(context.Background(), receiverGroup)
+}
+
+func (c *Controller) sync(ctx context.Context, receiverGroup *v1.ReceiverGroup) error {
+	tenantID := receiverGroup.Spec.TenantID
+	group, err := c.authClient.Groups().Get(ctx, authutil.CombineTenantAndName(tenantID, receiverGroup.Spec.AuthGroup), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	var receivers []string
+	for _, subject := range group.Status.Users {
+		username := subject.Name
+		if username == "" {
+			username = subject.ID
+		}
+		user, err := c.authClient.Users().Get(ctx, authutil.CombineTenantAndName(tenantID, username), metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				log.Warn("Member of group does not exist in identity provider", log.String("group", group.ObjectMeta.Name), log.String("username", username))
+				continue
+			}
+			return err
+		}
+		receiver, err := c.ensureReceiver(ctx, tenantID, user.Spec)
+		if err != nil {
+			return err
+		}
+		receivers = append(receivers, receiver)
+	}
+
+	removed := sets.NewString(receiverGroup.Spec.Receivers...).Difference(sets.NewString(receivers...))
+	if !sets.NewString(receiverGroup.Spec.Receivers...).Equal(sets.NewString(receivers...)) {
+		receiverGroup = receiverGroup.DeepCopy()
+		receiverGroup.Spec.Receivers = sets.NewString(receivers...).List()
+		if _, err := c.client.NotifyV1().ReceiverGroups().Update(ctx, receiverGroup, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		log.Info("Receiver group synchronized", log.String("receiverGroupName", receiverGroup.ObjectMeta.Name), log.Strings("receivers", receiverGroup.Spec.Receivers))
+	}
+
+	return c.deleteOrphanReceivers(ctx, receiverGroup, removed.List())
+}
+
+// ensureReceiver creates or updates the receiver of the user, and returns its
+// name.
+func (c *Controller) ensureReceiver(ctx context.Context, tenantID string, user authv1.UserSpec) (string, error) {
+	name := receiverName(tenantID, user.Name)
+	receiver, err := c.client.NotifyV1().Receivers().Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		receiver = &v1.Receiver{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		updateReceiver(receiver, tenantID, user)
+		_, err = c.client.NotifyV1().Receivers().Create(ctx, receiver, metav1.CreateOptions{})
+		return name, err
+	}
+	if err != nil {
+		return "", err
+	}
+	if updateReceiver(receiver, tenantID, user) {
+		_, err = c.client.NotifyV1().Receivers().Update(ctx, receiver, metav1.UpdateOptions{})
+	}
+	return name, err
+}
+
+// deleteOrphanReceivers deletes the receivers synchronized from the users
+// which left the receiver group, unless they are still referenced by another
+// receiver group of the tenant.
+func (c *Controller) deleteOrphanReceivers(ctx context.Context, receiverGroup *v1.ReceiverGroup, removed []string) error {
+	if len(removed) == 0 {
+		return nil
+	}
+	groups, err := c.lister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	members := sets.NewString()
+	for _, group := range groups {
+		if group.Spec.TenantID == receiverGroup.Spec.TenantID && group.ObjectMeta.Name != receiverGroup.ObjectMeta.Name {
+			members.Insert(group.Spec.Receivers...)
+		}
+	}
+	for _, name := range removed {
+		if members.Has(name) {
+			continue
+		}
+		receiver, err := c.client.NotifyV1().Receivers().Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if receiver.Spec.AuthUser == "" {
+			continue
+		}
+		if err := c.client.NotifyV1().Receivers().Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		log.Info("Receiver of user left the synchronized groups deleted", log.String("receiverName", name), log.String("username", receiver.Spec.AuthUser))
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package receivergroup
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	authv1 "tkestack.io/tke/api/auth/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+// receiverName returns the name of the receiver synchronized from the user,
+// which is stable for the tenant and the username.
+func receiverName(tenantID, username string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(tenantID))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(username))
+	return fmt.Sprintf("rcv-%016x", h.Sum64())
+}
+
+// updateReceiver fills the receiver with the attributes of the user and
+// reports whether the receiver changed. The identities of the channels which
+// the identity provider knows nothing about are kept as they are.
+func updateReceiver(receiver *v1.Receiver, tenantID string, user authv1.UserSpec) bool {
+	changed := false
+	set := func(field *string, value string) {
+		if *field != value {
+			*field = value
+			changed = true
+		}
+	}
+	displayName := user.DisplayName
+	if displayName == "" {
+		displayName = user.Name
+	}
+	set(&receiver.Spec.TenantID, tenantID)
+	set(&receiver.Spec.DisplayName, displayName)
+	set(&receiver.Spec.Username, user.Name)
+	set(&receiver.Spec.AuthUser, user.Name)
+
+	identities := map[v1.ReceiverChannel]string{
+		v1.ReceiverChannelEmail:  user.Email,
+		v1.ReceiverChannelMobile: user.PhoneNumber,
+	}
+	for channel, value := range identities {
+		current, ok := receiver.Spec.Identities[channel]
+		switch {
+		case value == "" && ok:
+			delete(receiver.Spec.Identities, channel)
+			changed = true
+		case value != "" && current != value:
+			if receiver.Spec.Identities == nil {
+				receiver.Spec.Identities = make(map[v1.ReceiverChannel]string)
+			}
+			receiver.Spec.Identities[channel] = value
+			changed = true
+		}
+	}
+	return changed
+}
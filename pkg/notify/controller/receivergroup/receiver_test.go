@@ -0,0 +1,71 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package receivergroup
+
+import (
+	"testing"
+
+	authv1 "tkestack.io/tke/api/auth/v1"
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestReceiverName(t *testing.T) {
+	if receiverName("default", "alice") != receiverName("default", "alice") {
+		t.Errorf("receiverName() is not stable")
+	}
+	if receiverName("default", "alice") == receiverName("other", "alice") {
+		t.Errorf("receiverName() collides across tenants")
+	}
+	if receiverName("a", "bc") == receiverName("ab", "c") {
+		t.Errorf("receiverName() collides on concatenation")
+	}
+}
+
+func TestUpdateReceiver(t *testing.T) {
+	receiver := &v1.Receiver{}
+	user := authv1.UserSpec{Name: "alice", Email: "alice@example.com", PhoneNumber: "13800000000"}
+	if !updateReceiver(receiver, "default", user) {
+		t.Fatalf("updateReceiver() of a new receiver reported no change")
+	}
+	if receiver.Spec.DisplayName != "alice" || receiver.Spec.AuthUser != "alice" || receiver.Spec.TenantID != "default" {
+		t.Errorf("updateReceiver() spec = %+v", receiver.Spec)
+	}
+	if receiver.Spec.Identities[v1.ReceiverChannelEmail] != user.Email || receiver.Spec.Identities[v1.ReceiverChannelMobile] != user.PhoneNumber {
+		t.Errorf("updateReceiver() identities = %v", receiver.Spec.Identities)
+	}
+	if updateReceiver(receiver, "default", user) {
+		t.Errorf("updateReceiver() of an unchanged user reported a change")
+	}
+
+	receiver.Spec.Identities[v1.ReceiverChannelWeCom] = "alice"
+	user.PhoneNumber = ""
+	user.DisplayName = "Alice"
+	if !updateReceiver(receiver, "default", user) {
+		t.Fatalf("updateReceiver() of a changed user reported no change")
+	}
+	if _, ok := receiver.Spec.Identities[v1.ReceiverChannelMobile]; ok {
+		t.Errorf("updateReceiver() kept the removed phone number")
+	}
+	if receiver.Spec.Identities[v1.ReceiverChannelWeCom] != "alice" {
+		t.Errorf("updateReceiver() dropped the identity unknown to the identity provider")
+	}
+	if receiver.Spec.DisplayName != "Alice" {
+		t.Errorf("updateReceiver() display name = %q, want Alice", receiver.Spec.DisplayName)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/alert"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// AlertsREST implements the REST endpoint for ingesting the alerts of
+// external monitoring systems, which are notified through the channel or
+// escalated like the alarms of tke.
+type AlertsREST struct {
+	rest.Storage
+	store        *registry.Store
+	notifyClient *notifyinternalclient.NotifyClient
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *AlertsREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *AlertsREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler that routes the alerts in the request body by the
+// query parameters.
+func (r *AlertsREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &alertsHandler{channel: obj.(*notify.Channel), notifyClient: r.notifyClient}, nil
+}
+
+// New creates a new channel object
+func (r *AlertsREST) New() runtime.Object {
+	return &notify.Channel{}
+}
+
+type alertsResponse struct {
+	Received int `json:"received"`
+}
+
+type alertsHandler struct {
+	channel      *notify.Channel
+	notifyClient *notifyinternalclient.NotifyClient
+}
+
+func (h *alertsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	route, err := h.route(req)
+	if err != nil {
+		responsewriters.WriteRawJSON(int(err.Status().Code), err, w)
+		return
+	}
+
+	body, readErr := ioutil.ReadAll(req.Body)
+	if readErr != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(readErr.Error()), w)
+		return
+	}
+	alerts, parseErr := alert.Parse(req.URL.Query().Get("format"), body)
+	if parseErr != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(fmt.Sprintf("invalid alerts: %v", parseErr)), w)
+		return
+	}
+
+	for _, a := range alerts {
+		if err := alert.Dispatch(req.Context(), h.notifyClient, *route, a, a.Status == alert.StatusResolved); err != nil {
+			log.Error("Failed to dispatch ingested alert", log.String("channelName", h.channel.ObjectMeta.Name), log.Err(err))
+			responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+			return
+		}
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, &alertsResponse{Received: len(alerts)}, w)
+}
+
+// route returns the route of the alerts from the query parameters `template`,
+// `receivers` and `receiverGroups`, or `escalationPolicy` which must be owned
+// by the tenant of the channel.
+func (h *alertsHandler) route(req *http.Request) (*alert.Route, errors.APIStatus) {
+	query := req.URL.Query()
+	route := &alert.Route{
+		TenantID:         h.channel.Spec.TenantID,
+		Channel:          h.channel.ObjectMeta.Name,
+		Template:         query.Get("template"),
+		Receivers:        splitList(query.Get("receivers")),
+		ReceiverGroups:   splitList(query.Get("receiverGroups")),
+		EscalationPolicy: query.Get("escalationPolicy"),
+		PassThrough:      true,
+	}
+
+	if route.EscalationPolicy != "" {
+		policy, err := h.notifyClient.EscalationPolicies().Get(req.Context(), route.EscalationPolicy, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil, errors.NewBadRequest(fmt.Sprintf("escalation policy %s does not exist", route.EscalationPolicy))
+			}
+			return nil, errors.NewInternalError(err)
+		}
+		if policy.Spec.TenantID != h.channel.Spec.TenantID {
+			return nil, errors.NewForbidden(notify.Resource("escalationpolicies"), route.EscalationPolicy, fmt.Errorf("not authorized to escalate by the escalation policy of other tenants"))
+		}
+		return route, nil
+	}
+
+	if route.Template == "" {
+		return nil, errors.NewBadRequest("must specify template or escalationPolicy")
+	}
+	if len(route.Receivers) == 0 && len(route.ReceiverGroups) == 0 {
+		return nil, errors.NewBadRequest("must specify receivers or receiverGroups")
+	}
+	return route, nil
+}
+
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
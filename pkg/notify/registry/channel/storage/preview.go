@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+	v1 "tkestack.io/tke/api/notify/v1"
+	templatestrategy "tkestack.io/tke/pkg/notify/registry/template"
+	"tkestack.io/tke/pkg/notify/render"
+)
+
+// PreviewREST implements the REST endpoint for rendering a template of the
+// channel before it is saved.
+type PreviewREST struct {
+	rest.Storage
+	store *registry.Store
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *PreviewREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *PreviewREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler that renders the template in the request body.
+func (r *PreviewREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{}); err != nil {
+		return nil, err
+	}
+	return &previewHandler{}, nil
+}
+
+// New creates a new channel object
+func (r *PreviewREST) New() runtime.Object {
+	return &notify.Channel{}
+}
+
+// previewRequest is the template to render, with the variables and the
+// locale of receivers. The variables not given are rendered as their names.
+type previewRequest struct {
+	Template  v1.TemplateSpec   `json:"template"`
+	Variables map[string]string `json:"variables,omitempty"`
+	Locale    string            `json:"locale,omitempty"`
+}
+
+// previewResponse is the rendered template, and the errors of validating and
+// rendering the template.
+type previewResponse struct {
+	Template *v1.TemplateSpec `json:"template,omitempty"`
+	Errors   []string         `json:"errors,omitempty"`
+}
+
+type previewHandler struct{}
+
+func (h *previewHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()), w)
+		return
+	}
+	preview := &previewRequest{}
+	if err := json.Unmarshal(body, preview); err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(fmt.Sprintf("invalid preview request: %v", err)), w)
+		return
+	}
+
+	response := &previewResponse{}
+	spec := &notify.TemplateSpec{}
+	if err := v1.Convert_v1_TemplateSpec_To_notify_TemplateSpec(&preview.Template, spec, nil); err != nil {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()), w)
+		return
+	}
+	for _, err := range templatestrategy.ValidateTemplateTexts(spec, field.NewPath("template")) {
+		response.Errors = append(response.Errors, err.Error())
+	}
+
+	rendered := render.Localize(&preview.Template, preview.Locale).DeepCopy()
+	variables := make(map[string]string)
+	for k, v := range preview.Variables {
+		variables[k] = v
+	}
+	renderText := func(path string, text *string) {
+		if *text == "" {
+			return
+		}
+		names, _ := render.Variables(*text)
+		for _, name := range names {
+			if _, ok := variables[name]; !ok {
+				variables[name] = fmt.Sprintf("${%s}", name)
+			}
+		}
+		content, err := render.Render(path, *text, variables)
+		if err != nil {
+			response.Errors = append(response.Errors, fmt.Sprintf("%s: %v", path, err))
+			return
+		}
+		*text = content
+	}
+	if rendered.TencentCloudSMS != nil {
+		renderText("tencentCloudSMS.body", &rendered.TencentCloudSMS.Body)
+	}
+	if rendered.Wechat != nil {
+		renderText("wechat.body", &rendered.Wechat.Body)
+	}
+	if rendered.Text != nil {
+		renderText("text.header", &rendered.Text.Header)
+		renderText("text.body", &rendered.Text.Body)
+	}
+	if rendered.WeCom != nil {
+		renderText("weCom.content", &rendered.WeCom.Content)
+	}
+	if rendered.DingTalk != nil {
+		renderText("dingTalk.title", &rendered.DingTalk.Title)
+		renderText("dingTalk.content", &rendered.DingTalk.Content)
+	}
+	if rendered.Lark != nil {
+		renderText("lark.title", &rendered.Lark.Title)
+		renderText("lark.content", &rendered.Lark.Content)
+	}
+	if rendered.PagerDuty != nil {
+		renderText("pagerDuty.summary", &rendered.PagerDuty.Summary)
+		renderText("pagerDuty.source", &rendered.PagerDuty.Source)
+	}
+	if rendered.Opsgenie != nil {
+		renderText("opsgenie.message", &rendered.Opsgenie.Message)
+		renderText("opsgenie.description", &rendered.Opsgenie.Description)
+	}
+	if rendered.Voice != nil {
+		renderText("voice.content", &rendered.Voice.Content)
+	}
+	rendered.Localizations = nil
+	response.Template = rendered
+
+	responsewriters.WriteRawJSON(http.StatusOK, response, w)
+}
@@ -0,0 +1,152 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+)
+
+// QuotaREST implements the REST endpoint for querying the remaining sending
+// quota of the channel.
+type QuotaREST struct {
+	rest.Storage
+	store *registry.Store
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *QuotaREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *QuotaREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler that responds the quota of the channel and its
+// projects, the quota of a project is only responded if the project is
+// specified by the query parameter `project`.
+func (r *QuotaREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &quotaHandler{channel: obj.(*notify.Channel)}, nil
+}
+
+// New creates a new channel object
+func (r *QuotaREST) New() runtime.Object {
+	return &notify.Channel{}
+}
+
+// quotaRemaining is the limits, usages and remaining quota of the channel or
+// a project, the remaining quota is omitted if not limited.
+type quotaRemaining struct {
+	Project            string `json:"project,omitempty"`
+	MessagesPerMinute  int32  `json:"messagesPerMinute,omitempty"`
+	MessagesPerDay     int32  `json:"messagesPerDay,omitempty"`
+	MessagesLastMinute int32  `json:"messagesLastMinute"`
+	MessagesLastDay    int32  `json:"messagesLastDay"`
+	RemainingPerMinute *int32 `json:"remainingPerMinute,omitempty"`
+	RemainingPerDay    *int32 `json:"remainingPerDay,omitempty"`
+}
+
+type quotaResponse struct {
+	Channel    quotaRemaining   `json:"channel"`
+	Projects   []quotaRemaining `json:"projects,omitempty"`
+	UpdateTime metav1.Time      `json:"updateTime,omitempty"`
+}
+
+type quotaHandler struct {
+	channel *notify.Channel
+}
+
+func (h *quotaHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	quota := h.channel.Spec.Quota
+	if quota == nil {
+		quota = &notify.ChannelQuota{}
+	}
+	status := h.channel.Status
+	response := &quotaResponse{
+		Channel:    quotaRemaining{MessagesPerMinute: quota.MessagesPerMinute, MessagesPerDay: quota.MessagesPerDay},
+		UpdateTime: status.LastQuotaUpdateTime,
+	}
+
+	projects := req.URL.Query()["project"]
+	for _, project := range projects {
+		response.Projects = append(response.Projects, quotaRemaining{
+			Project:           project,
+			MessagesPerMinute: quota.ProjectMessagesPerMinute,
+			MessagesPerDay:    quota.ProjectMessagesPerDay,
+		})
+	}
+
+	// the usages reported before the window are expired
+	reported := time.Since(status.LastQuotaUpdateTime.Time)
+	for _, usage := range status.QuotaUsages {
+		remaining := &response.Channel
+		if usage.Project != "" {
+			remaining = nil
+			for i := range response.Projects {
+				if response.Projects[i].Project == usage.Project {
+					remaining = &response.Projects[i]
+				}
+			}
+			if remaining == nil {
+				continue
+			}
+		}
+		if reported < time.Minute {
+			remaining.MessagesLastMinute = usage.MessagesLastMinute
+		}
+		if reported < 24*time.Hour {
+			remaining.MessagesLastDay = usage.MessagesLastDay
+		}
+	}
+
+	fillRemaining(&response.Channel)
+	for i := range response.Projects {
+		fillRemaining(&response.Projects[i])
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, response, w)
+}
+
+func fillRemaining(r *quotaRemaining) {
+	remaining := func(limit, used int32) *int32 {
+		if limit <= 0 {
+			return nil
+		}
+		left := limit - used
+		if left < 0 {
+			left = 0
+		}
+		return &left
+	}
+	r.RemainingPerMinute = remaining(r.MessagesPerMinute, r.MessagesLastMinute)
+	r.RemainingPerDay = remaining(r.MessagesPerDay, r.MessagesLastDay)
+}
@@ -32,6 +32,7 @@ import (
 	"k8s.io/apiserver/pkg/storage"
 	storageerr "k8s.io/apiserver/pkg/storage/errors"
 	"k8s.io/apiserver/pkg/util/dryrun"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
 	"tkestack.io/tke/api/notify"
 	"tkestack.io/tke/pkg/apiserver/authentication"
 	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
@@ -45,10 +46,13 @@ type Storage struct {
 	Channel  *REST
 	Status   *StatusREST
 	Finalize *FinalizeREST
+	Preview  *PreviewREST
+	Quota    *QuotaREST
+	Alerts   *AlertsREST
 }
 
 // NewStorage returns a Storage object that will work against channels.
-func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername string) *Storage {
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, notifyClient *notifyinternalclient.NotifyClient, privilegedUsername string) *Storage {
 	strategy := channelstrategy.NewStrategy()
 	store := &registry.Store{
 		NewFunc:                  func() runtime.Object { return &notify.Channel{} },
@@ -84,6 +88,9 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 		Channel:  &REST{store, privilegedUsername},
 		Status:   &StatusREST{&statusStore},
 		Finalize: &FinalizeREST{&finalizeStore},
+		Preview:  &PreviewREST{store: store},
+		Quota:    &QuotaREST{store: store},
+		Alerts:   &AlertsREST{store: store, notifyClient: notifyClient},
 	}
 }
 
@@ -19,7 +19,10 @@
 package channel
 
 import (
+	"net/url"
+
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/notify"
 )
@@ -28,6 +31,11 @@ import (
 // subdomain.
 var ValidateChannelName = apimachineryvalidation.NameIsDNSLabel
 
+var (
+	pagerDutySeverities = sets.NewString("critical", "error", "warning", "info")
+	opsgeniePriorities  = sets.NewString("P1", "P2", "P3", "P4", "P5")
+)
+
 // ValidateChannel tests if required fields in the channel are set.
 func ValidateChannel(channel *notify.Channel) field.ErrorList {
 	allErrs := apimachineryvalidation.ValidateObjectMeta(&channel.ObjectMeta, false, ValidateChannelName, field.NewPath("metadata"))
@@ -84,10 +92,194 @@ func ValidateChannel(channel *notify.Channel) field.ErrorList {
 		}
 	}
 
+	if channel.Spec.WeCom != nil {
+		channelCount++
+		fldPath := field.NewPath("spec", "weCom")
+		allErrs = append(allErrs, validateRobot(channel.Spec.WeCom.URL, channel.Spec.WeCom.RateLimit, fldPath)...)
+	}
+
+	if channel.Spec.DingTalk != nil {
+		channelCount++
+		fldPath := field.NewPath("spec", "dingTalk")
+		allErrs = append(allErrs, validateRobot(channel.Spec.DingTalk.URL, channel.Spec.DingTalk.RateLimit, fldPath)...)
+	}
+
+	if channel.Spec.Lark != nil {
+		channelCount++
+		fldPath := field.NewPath("spec", "lark")
+		allErrs = append(allErrs, validateRobot(channel.Spec.Lark.URL, channel.Spec.Lark.RateLimit, fldPath)...)
+	}
+
+	if channel.Spec.PagerDuty != nil {
+		channelCount++
+		fldPath := field.NewPath("spec", "pagerDuty")
+		if channel.Spec.PagerDuty.RoutingKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("routingKey"), "must specify integration key of pagerduty service"))
+		}
+		allErrs = append(allErrs, validateIncidentURL(channel.Spec.PagerDuty.URL, fldPath)...)
+		for severity, eventSeverity := range channel.Spec.PagerDuty.Severities {
+			if !pagerDutySeverities.Has(eventSeverity) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("severities").Key(severity), eventSeverity, pagerDutySeverities.List()))
+			}
+		}
+	}
+
+	if channel.Spec.Opsgenie != nil {
+		channelCount++
+		fldPath := field.NewPath("spec", "opsgenie")
+		if channel.Spec.Opsgenie.APIKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("apiKey"), "must specify key of opsgenie api integration"))
+		}
+		allErrs = append(allErrs, validateIncidentURL(channel.Spec.Opsgenie.URL, fldPath)...)
+		for severity, priority := range channel.Spec.Opsgenie.Priorities {
+			if !opsgeniePriorities.Has(priority) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("priorities").Key(severity), priority, opsgeniePriorities.List()))
+			}
+		}
+	}
+
+	if channel.Spec.Voice != nil {
+		channelCount++
+		allErrs = append(allErrs, validateVoice(channel.Spec.Voice, field.NewPath("spec", "voice"))...)
+	}
+
 	if channelCount == 0 {
-		allErrs = append(allErrs, field.Required(field.NewPath("spec"), "must specify one of channel type: `tencentCloudSMS`, `wechat`, `webhook` or `smtp`"))
+		allErrs = append(allErrs, field.Required(field.NewPath("spec"), "must specify one of channel type: `tencentCloudSMS`, `wechat`, `webhook`, `smtp`, `weCom`, `dingTalk`, `lark`, `pagerDuty`, `opsgenie` or `voice`"))
 	} else if channelCount > 1 {
-		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "may not specify more than 1 channel type: `tencentCloudSMS`, `wechat`, `webhook` or `smtp`"))
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "may not specify more than 1 channel type: `tencentCloudSMS`, `wechat`, `webhook`, `smtp`, `weCom`, `dingTalk`, `lark`, `pagerDuty`, `opsgenie` or `voice`"))
+	}
+
+	if channel.Spec.Aggregation != nil {
+		allErrs = append(allErrs, validateAggregation(channel.Spec.Aggregation, field.NewPath("spec", "aggregation"))...)
+	}
+
+	if channel.Spec.Quota != nil {
+		allErrs = append(allErrs, validateQuota(channel.Spec.Quota, field.NewPath("spec", "quota"))...)
+	}
+
+	return allErrs
+}
+
+// validateIncidentURL tests the api address of incident management services,
+// which is defaulted if not specified.
+func validateIncidentURL(apiURL string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if apiURL == "" {
+		return allErrs
+	}
+	if u, err := url.Parse(apiURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), apiURL, "must be a valid http or https url"))
+	}
+
+	return allErrs
+}
+
+// validateVoice tests the voice provider and the call settings.
+func validateVoice(voice *notify.ChannelVoice, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch {
+	case voice.TencentCloudVMS == nil && voice.API == nil:
+		allErrs = append(allErrs, field.Required(fldPath, "must specify one of voice provider: `tencentCloudVMS` or `api`"))
+	case voice.TencentCloudVMS != nil && voice.API != nil:
+		allErrs = append(allErrs, field.Forbidden(fldPath, "may not specify more than 1 voice provider: `tencentCloudVMS` or `api`"))
+	}
+
+	if voice.TencentCloudVMS != nil {
+		if voice.TencentCloudVMS.AppKey == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("tencentCloudVMS", "appKey"), "must specify appKey of tencent cloud vms"))
+		}
+		if voice.TencentCloudVMS.SdkAppID == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("tencentCloudVMS", "sdkAppID"), "must specify sdkAppID of tencent cloud vms"))
+		}
+		if voice.TencentCloudVMS.PlayTimes < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("tencentCloudVMS", "playTimes"), voice.TencentCloudVMS.PlayTimes, "must be greater than 0"))
+		}
+	}
+
+	if voice.API != nil {
+		if voice.API.URL == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("api", "url"), "must specify url of voice api"))
+		} else {
+			allErrs = append(allErrs, validateIncidentURL(voice.API.URL, fldPath.Child("api"))...)
+		}
+	}
+
+	for i, severity := range voice.Severities {
+		if severity == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("severities").Index(i), "must specify severity"))
+		}
+	}
+	if voice.AnswerTimeoutSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("answerTimeoutSeconds"), voice.AnswerTimeoutSeconds, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// validateAggregation tests the deduplication and flap detection settings.
+func validateAggregation(aggregation *notify.ChannelAggregation, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, key := range aggregation.DedupKeys {
+		if key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("dedupKeys").Index(i), "must specify variable name"))
+		}
+	}
+	if aggregation.GroupWaitSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("groupWaitSeconds"), aggregation.GroupWaitSeconds, "must be greater than or equal to 0"))
+	}
+	if aggregation.FlapThreshold < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flapThreshold"), aggregation.FlapThreshold, "must be greater than or equal to 0"))
+	}
+	if aggregation.FlapThreshold > 0 && aggregation.FlapWindowSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("flapWindowSeconds"), aggregation.FlapWindowSeconds, "must be greater than 0 when flap detection is enabled"))
+	}
+
+	return allErrs
+}
+
+// validateQuota tests the sending limits of the channel and each project.
+func validateQuota(quota *notify.ChannelQuota, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	limits := []struct {
+		name  string
+		value int32
+	}{
+		{"messagesPerMinute", quota.MessagesPerMinute},
+		{"messagesPerDay", quota.MessagesPerDay},
+		{"projectMessagesPerMinute", quota.ProjectMessagesPerMinute},
+		{"projectMessagesPerDay", quota.ProjectMessagesPerDay},
+	}
+	for _, limit := range limits {
+		if limit.value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(limit.name), limit.value, "must be greater than or equal to 0"))
+		}
+	}
+	if quota.MessagesPerDay > 0 && quota.MessagesPerMinute > quota.MessagesPerDay {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("messagesPerMinute"), quota.MessagesPerMinute, "must be less than or equal to messagesPerDay"))
+	}
+	if quota.ProjectMessagesPerDay > 0 && quota.ProjectMessagesPerMinute > quota.ProjectMessagesPerDay {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("projectMessagesPerMinute"), quota.ProjectMessagesPerMinute, "must be less than or equal to projectMessagesPerDay"))
+	}
+
+	return allErrs
+}
+
+// validateRobot tests the webhook address and rate limit of the group robots.
+func validateRobot(robotURL string, rateLimit int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if robotURL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "must specify webhook url of robot"))
+	} else if u, err := url.Parse(robotURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), robotURL, "must be a valid http or https url"))
+	}
+
+	if rateLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rateLimit"), rateLimit, "must be greater than or equal to 0"))
 	}
 
 	return allErrs
@@ -0,0 +1,103 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+)
+
+// AcknowledgeREST implements the REST endpoint for acknowledging an escalation.
+type AcknowledgeREST struct {
+	rest.Storage
+	store       *registry.Store
+	statusStore *registry.Store
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *AcknowledgeREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *AcknowledgeREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler that stops the escalation by given name.
+func (r *AcknowledgeREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	escalation := obj.(*notify.Escalation)
+	username, _ := authentication.UsernameAndTenantID(ctx)
+
+	return &acknowledgeHandler{
+		ctx:         ctx,
+		escalation:  escalation,
+		username:    username,
+		statusStore: r.statusStore,
+	}, nil
+}
+
+// New creates a new escalation object
+func (r *AcknowledgeREST) New() runtime.Object {
+	return &notify.Escalation{}
+}
+
+type acknowledgeHandler struct {
+	ctx         context.Context
+	escalation  *notify.Escalation
+	username    string
+	statusStore *registry.Store
+}
+
+func (h *acknowledgeHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	escalation := h.escalation.DeepCopy()
+	switch escalation.Status.Phase {
+	case notify.EscalationAcknowledged, notify.EscalationResolved:
+		responsewriters.WriteRawJSON(http.StatusConflict, errors.NewConflict(notify.Resource("escalations"), escalation.Name, fmt.Errorf("escalation has already been %s", escalation.Status.Phase)), w)
+		return
+	}
+
+	escalation.Status.Phase = notify.EscalationAcknowledged
+	escalation.Status.AcknowledgedBy = h.username
+	escalation.Status.LastTransitionTime = metav1.Now()
+	obj, _, err := h.statusStore.Update(h.ctx, escalation.Name, rest.DefaultUpdatedObjectInfo(escalation), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		if status, ok := err.(errors.APIStatus); ok {
+			responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, obj, w)
+}
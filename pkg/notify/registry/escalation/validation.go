@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+package escalation
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateEscalationName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateEscalationName = apimachineryvalidation.NameIsDNSLabel
+
+var escalationPhases = sets.NewString(
+	string(notify.EscalationEscalating),
+	string(notify.EscalationAcknowledged),
+	string(notify.EscalationResolved),
+	string(notify.EscalationExhausted),
+)
+
+// ValidateEscalation tests if required fields in the escalation are set.
+func ValidateEscalation(ctx context.Context, escalation *notify.Escalation, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&escalation.ObjectMeta, false, ValidateEscalationName, field.NewPath("metadata"))
+
+	if escalation.Spec.PolicyName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "policyName"), "must specify escalation policy"))
+	} else {
+		policy, err := notifyClient.EscalationPolicies().Get(ctx, escalation.Spec.PolicyName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(field.NewPath("spec", "policyName"), escalation.Spec.PolicyName))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(field.NewPath("spec", "policyName"), err))
+		} else if policy.Spec.TenantID != escalation.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "policyName"), fmt.Sprintf("no authorized to use escalation policy %s", escalation.Spec.PolicyName)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateEscalationUpdate tests if required fields in the escalation are set during
+// an update.
+func ValidateEscalationUpdate(ctx context.Context, escalation *notify.Escalation, old *notify.Escalation, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&escalation.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	if escalation.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	if escalation.Spec.PolicyName != old.Spec.PolicyName {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "policyName"), "disallowed change the escalation policy"))
+	}
+	return allErrs
+}
+
+// ValidateEscalationStatusUpdate tests if required fields in the status of
+// escalation are set during an update.
+func ValidateEscalationStatusUpdate(escalation *notify.Escalation, old *notify.Escalation) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&escalation.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+
+	if !escalationPhases.Has(string(escalation.Status.Phase)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("status", "phase"), escalation.Status.Phase, escalationPhases.List()))
+	}
+	if escalation.Status.Step < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("status", "step"), escalation.Status.Step, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package escalationpolicy
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateEscalationPolicyName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateEscalationPolicyName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateEscalationPolicy tests if required fields in the escalationPolicy are set.
+func ValidateEscalationPolicy(ctx context.Context, escalationPolicy *notify.EscalationPolicy, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&escalationPolicy.ObjectMeta, false, ValidateEscalationPolicyName, field.NewPath("metadata"))
+
+	if escalationPolicy.Spec.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
+	}
+
+	stepsPath := field.NewPath("spec", "steps")
+	if len(escalationPolicy.Spec.Steps) == 0 {
+		allErrs = append(allErrs, field.Required(stepsPath, "must specify a step"))
+	}
+	for i, step := range escalationPolicy.Spec.Steps {
+		allErrs = append(allErrs, validateStep(ctx, escalationPolicy.Spec.TenantID, step, stepsPath.Index(i), notifyClient)...)
+	}
+
+	return allErrs
+}
+
+func validateStep(ctx context.Context, tenantID string, step notify.EscalationStep, fldPath *field.Path, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if step.WaitSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("waitSeconds"), step.WaitSeconds, "must be greater than or equal to 0"))
+	}
+
+	if step.Channel == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("channel"), "must specify channel"))
+	} else {
+		channel, err := notifyClient.Channels().Get(ctx, step.Channel, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("channel"), step.Channel))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("channel"), err))
+		} else if channel.Spec.TenantID != tenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("channel"), fmt.Sprintf("no authorized to use channel %s", step.Channel)))
+		} else if step.Template == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("template"), "must specify template"))
+		} else if _, err := notifyClient.Templates(step.Channel).Get(ctx, step.Template, metav1.GetOptions{}); err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("template"), step.Template))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("template"), err))
+		}
+	}
+
+	if len(step.Receivers) == 0 && len(step.ReceiverGroups) == 0 && len(step.OnCallSchedules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify receivers, receiverGroups or onCallSchedules"))
+	}
+
+	for _, receiverName := range step.Receivers {
+		receiver, err := notifyClient.Receivers().Get(ctx, receiverName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("receivers").Key(receiverName), receiverName))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("receivers").Key(receiverName), err))
+		} else if receiver.Spec.TenantID != tenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("receivers").Key(receiverName), fmt.Sprintf("no authorized to notify receiver %s", receiverName)))
+		}
+	}
+
+	for _, receiverGroupName := range step.ReceiverGroups {
+		receiverGroup, err := notifyClient.ReceiverGroups().Get(ctx, receiverGroupName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("receiverGroups").Key(receiverGroupName), receiverGroupName))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("receiverGroups").Key(receiverGroupName), err))
+		} else if receiverGroup.Spec.TenantID != tenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("receiverGroups").Key(receiverGroupName), fmt.Sprintf("no authorized to notify receiver group %s", receiverGroupName)))
+		}
+	}
+
+	for _, scheduleName := range step.OnCallSchedules {
+		schedule, err := notifyClient.OnCallSchedules().Get(ctx, scheduleName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("onCallSchedules").Key(scheduleName), scheduleName))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("onCallSchedules").Key(scheduleName), err))
+		} else if schedule.Spec.TenantID != tenantID {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("onCallSchedules").Key(scheduleName), fmt.Sprintf("no authorized to notify on-call schedule %s", scheduleName)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateEscalationPolicyUpdate tests if required fields in the escalationPolicy are set during
+// an update.
+func ValidateEscalationPolicyUpdate(ctx context.Context, escalationPolicy *notify.EscalationPolicy, old *notify.EscalationPolicy, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&escalationPolicy.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateEscalationPolicy(ctx, escalationPolicy, notifyClient)...)
+
+	if escalationPolicy.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
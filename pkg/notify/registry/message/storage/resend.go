@@ -0,0 +1,98 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/notify"
+)
+
+// ResendREST implements the REST endpoint for re-sending a message that
+// failed to be delivered.
+type ResendREST struct {
+	rest.Storage
+	store       *registry.Store
+	statusStore *registry.Store
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *ResendREST) ConnectMethods() []string {
+	return []string{"POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *ResendREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return nil, false, ""
+}
+
+// Connect returns a handler that puts the dead letter message back to retry.
+func (r *ResendREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &resendHandler{
+		ctx:         ctx,
+		message:     obj.(*notify.Message),
+		statusStore: r.statusStore,
+	}, nil
+}
+
+// New creates a new message object
+func (r *ResendREST) New() runtime.Object {
+	return &notify.Message{}
+}
+
+type resendHandler struct {
+	ctx         context.Context
+	message     *notify.Message
+	statusStore *registry.Store
+}
+
+func (h *resendHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	message := h.message.DeepCopy()
+	if message.Status.DeliveryPhase != notify.MessageDeadLetter {
+		responsewriters.WriteRawJSON(http.StatusConflict, errors.NewConflict(notify.Resource("messages"), message.Name, fmt.Errorf("only dead letter messages can be re-sent")), w)
+		return
+	}
+
+	message.Status.DeliveryPhase = notify.MessageRetrying
+	message.Status.RetryCount = 0
+	message.Status.NextAttemptTime = metav1.Now()
+	obj, _, err := h.statusStore.Update(h.ctx, message.Name, rest.DefaultUpdatedObjectInfo(message), rest.ValidateAllObjectFunc, rest.ValidateAllObjectUpdateFunc, false, &metav1.UpdateOptions{})
+	if err != nil {
+		if status, ok := err.(errors.APIStatus); ok {
+			responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusOK, obj, w)
+}
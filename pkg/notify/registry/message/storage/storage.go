@@ -41,6 +41,7 @@ import (
 type Storage struct {
 	Message *REST
 	Status  *StatusREST
+	Resend  *ResendREST
 }
 
 // NewStorage returns a Storage object that will work against messages.
@@ -78,6 +79,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 	return &Storage{
 		Message: &REST{store, privilegedUsername},
 		Status:  &StatusREST{&statusStore},
+		Resend:  &ResendREST{store: store, statusStore: &statusStore},
 	}
 }
 
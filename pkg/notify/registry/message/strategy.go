@@ -138,6 +138,7 @@ func MatchMessage(label labels.Selector, field fields.Selector) storage.Selectio
 			"spec.username",
 			"spec.channelMessageID",
 			"status.phase",
+			"status.deliveryPhase",
 			"metadata.name",
 			"spec.alarmPolicyName",
 			"spec.alarmPolicyType",
@@ -156,6 +157,7 @@ func ToSelectableFields(message *notify.Message) fields.Set {
 		"spec.username":            message.Spec.Username,
 		"spec.channelMessageID":    message.Spec.ChannelMessageID,
 		"status.phase":             string(message.Status.Phase),
+		"status.deliveryPhase":     string(message.Status.DeliveryPhase),
 		"spec.alarmPolicyName":     message.Spec.AlarmPolicyName,
 		"spec.alarmPolicyType":     message.Spec.AlarmPolicyType,
 		"spec.receiverChannelName": message.Spec.ReceiverChannelName,
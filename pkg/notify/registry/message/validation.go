@@ -43,13 +43,23 @@ func ValidateMessage(message *notify.Message) field.ErrorList {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "receiverChannel"), message.Spec.ReceiverChannel, "must be a standard channel receiver"))
 	}
 
-	if message.Spec.Identity == "" {
+	// The identity of receiver may be unknown if the message failed to be
+	// sent.
+	if message.Spec.Identity == "" && !isUndelivered(message) {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "identity"), "must specify receiver identity"))
 	}
 
+	if isUndelivered(message) && message.Spec.ReceiverChannelName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "receiverChannelName"), "must specify channel of the message to retry"))
+	}
+
 	return allErrs
 }
 
+func isUndelivered(message *notify.Message) bool {
+	return message.Status.DeliveryPhase == notify.MessageRetrying || message.Status.DeliveryPhase == notify.MessageDeadLetter
+}
+
 // ValidateMessageUpdate tests if required fields in the message are set during
 // an update.
 func ValidateMessageUpdate(message *notify.Message, old *notify.Message) field.ErrorList {
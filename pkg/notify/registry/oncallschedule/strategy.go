@@ -0,0 +1,156 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package oncallschedule
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/util/log"
+	namesutil "tkestack.io/tke/pkg/util/names"
+)
+
+// Strategy implements verification logic for onCallSchedule.
+type Strategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+
+	notifyClient *notifyinternalclient.NotifyClient
+}
+
+// NewStrategy creates a strategy that is the default logic that applies when
+// creating and updating onCallSchedule objects.
+func NewStrategy(notifyClient *notifyinternalclient.NotifyClient) *Strategy {
+	return &Strategy{notify.Scheme, namesutil.Generator, notifyClient}
+}
+
+// DefaultGarbageCollectionPolicy returns the default garbage collection behavior.
+func (Strategy) DefaultGarbageCollectionPolicy(ctx context.Context) rest.GarbageCollectionPolicy {
+	return rest.Unsupported
+}
+
+// PrepareForUpdate is invoked on update before validation to normalize the
+// object.
+func (s *Strategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+	oldOnCallSchedule := old.(*notify.OnCallSchedule)
+	onCallSchedule, _ := obj.(*notify.OnCallSchedule)
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if len(tenantID) != 0 {
+		if oldOnCallSchedule.Spec.TenantID != tenantID {
+			log.Panic("Unauthorized update onCallSchedule information", log.String("oldTenantID", oldOnCallSchedule.Spec.TenantID), log.String("newTenantID", onCallSchedule.Spec.TenantID), log.String("userTenantID", tenantID))
+		}
+		onCallSchedule.Spec.TenantID = tenantID
+	}
+
+	if onCallSchedule.Name == "" && onCallSchedule.GenerateName == "" {
+		onCallSchedule.GenerateName = "oncall-"
+	}
+}
+
+// NamespaceScoped is false for on-call schedules.
+func (Strategy) NamespaceScoped() bool {
+	return false
+}
+
+// Export strips fields that can not be set by the user.
+func (Strategy) Export(ctx context.Context, obj runtime.Object, exact bool) error {
+	return nil
+}
+
+// PrepareForCreate is invoked on create before validation to normalize
+// the object.
+func (s *Strategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	onCallSchedule, _ := obj.(*notify.OnCallSchedule)
+	if len(tenantID) != 0 {
+		onCallSchedule.Spec.TenantID = tenantID
+	}
+
+	if onCallSchedule.Name == "" && onCallSchedule.GenerateName == "" {
+		onCallSchedule.GenerateName = "oncall-"
+	}
+}
+
+// Validate validates a new onCallSchedule.
+func (s *Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	return ValidateOnCallSchedule(ctx, obj.(*notify.OnCallSchedule), s.notifyClient)
+}
+
+// AllowCreateOnUpdate is false for on-call schedules.
+func (Strategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate returns true if the object can be updated
+// unconditionally (irrespective of the latest resource version), when there is
+// no resource version specified in the object.
+func (Strategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// Canonicalize normalizes the object after validation.
+func (Strategy) Canonicalize(obj runtime.Object) {
+}
+
+// ValidateUpdate is the default update validation for an end onCallSchedule.
+func (s *Strategy) ValidateUpdate(ctx context.Context, obj, old runtime.Object) field.ErrorList {
+	return ValidateOnCallScheduleUpdate(ctx, obj.(*notify.OnCallSchedule), old.(*notify.OnCallSchedule), s.notifyClient)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	onCallSchedule, ok := obj.(*notify.OnCallSchedule)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a onCallSchedule")
+	}
+	return labels.Set(onCallSchedule.ObjectMeta.Labels), ToSelectableFields(onCallSchedule), nil
+}
+
+// MatchOnCallSchedule returns a generic matcher for a given label and field selector.
+func MatchOnCallSchedule(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+		IndexFields: []string{
+			"spec.tenantID",
+			"metadata.name",
+		},
+	}
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(onCallSchedule *notify.OnCallSchedule) fields.Set {
+	objectMetaFieldsSet := genericregistry.ObjectMetaFieldsSet(&onCallSchedule.ObjectMeta, false)
+	specificFieldsSet := fields.Set{
+		"spec.tenantID": onCallSchedule.Spec.TenantID,
+	}
+	return genericregistry.MergeFieldsSets(objectMetaFieldsSet, specificFieldsSet)
+}
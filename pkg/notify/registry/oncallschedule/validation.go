@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package oncallschedule
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
+	"tkestack.io/tke/api/notify"
+)
+
+// ValidateOnCallScheduleName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateOnCallScheduleName = apimachineryvalidation.NameIsDNSLabel
+
+// ValidateOnCallSchedule tests if required fields in the onCallSchedule are set.
+func ValidateOnCallSchedule(ctx context.Context, onCallSchedule *notify.OnCallSchedule, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMeta(&onCallSchedule.ObjectMeta, false, ValidateOnCallScheduleName, field.NewPath("metadata"))
+
+	if onCallSchedule.Spec.DisplayName == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
+	}
+
+	if onCallSchedule.Spec.ReceiverGroup == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "receiverGroup"), "must specify receiver group"))
+	} else {
+		receiverGroup, err := notifyClient.ReceiverGroups().Get(ctx, onCallSchedule.Spec.ReceiverGroup, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			allErrs = append(allErrs, field.NotFound(field.NewPath("spec", "receiverGroup"), onCallSchedule.Spec.ReceiverGroup))
+		} else if err != nil {
+			allErrs = append(allErrs, field.InternalError(field.NewPath("spec", "receiverGroup"), err))
+		} else if receiverGroup.Spec.TenantID != onCallSchedule.Spec.TenantID {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "receiverGroup"), fmt.Sprintf("no authorized to schedule receiver group %s", onCallSchedule.Spec.ReceiverGroup)))
+		}
+	}
+
+	if onCallSchedule.Spec.StartTime.IsZero() {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "startTime"), "must specify start time"))
+	}
+
+	if onCallSchedule.Spec.ShiftSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "shiftSeconds"), onCallSchedule.Spec.ShiftSeconds, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateOnCallScheduleUpdate tests if required fields in the onCallSchedule are set during
+// an update.
+func ValidateOnCallScheduleUpdate(ctx context.Context, onCallSchedule *notify.OnCallSchedule, old *notify.OnCallSchedule, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
+	allErrs := apimachineryvalidation.ValidateObjectMetaUpdate(&onCallSchedule.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateOnCallSchedule(ctx, onCallSchedule, notifyClient)...)
+
+	if onCallSchedule.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "tenantID"), "disallowed change the tenant"))
+	}
+	return allErrs
+}
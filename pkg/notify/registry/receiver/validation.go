@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/render"
 )
 
 // ValidateReceiverName is a ValidateNameFunc for names that must be a DNS
@@ -43,6 +44,10 @@ func ValidateReceiver(receiver *notify.Receiver) field.ErrorList {
 		}
 	}
 
+	if receiver.Spec.Locale != "" && !supportedLocales.Has(receiver.Spec.Locale) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "locale"), receiver.Spec.Locale, supportedLocales.List()))
+	}
+
 	return allErrs
 }
 
@@ -58,11 +63,16 @@ func ValidateReceiverUpdate(receiver *notify.Receiver, old *notify.Receiver) fie
 	return allErrs
 }
 
+var supportedLocales = sets.NewString(render.SupportedLocales...)
+
 var standardReceiverChannel = sets.NewString(
 	string(notify.ReceiverChannelEmail),
 	string(notify.ReceiverChannelMobile),
 	string(notify.ReceiverChannelWechatOpenID),
 	string(notify.ReceiverChannelWebhook),
+	string(notify.ReceiverChannelWeCom),
+	string(notify.ReceiverChannelDingTalk),
+	string(notify.ReceiverChannelLark),
 )
 
 // IsStandardReceiverChannel returns true if the receiver channel is known to
@@ -42,7 +42,9 @@ func ValidateReceiverGroup(ctx context.Context, receiverGroup *notify.ReceiverGr
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "displayName"), "must specify display name"))
 	}
 
-	if len(receiverGroup.Spec.Receivers) == 0 {
+	// the receivers of the group synchronized from tke-auth are filled by the
+	// controller, and the group may have no member.
+	if len(receiverGroup.Spec.Receivers) == 0 && receiverGroup.Spec.AuthGroup == "" {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "receivers"), "must specify a receiver"))
 	} else {
 		for _, receiverName := range receiverGroup.Spec.Receivers {
@@ -31,8 +31,11 @@ import (
 	"tkestack.io/tke/pkg/apiserver/storage"
 	channelstorage "tkestack.io/tke/pkg/notify/registry/channel/storage"
 	configmapstorage "tkestack.io/tke/pkg/notify/registry/configmap/storage"
+	escalationstorage "tkestack.io/tke/pkg/notify/registry/escalation/storage"
+	escalationpolicystorage "tkestack.io/tke/pkg/notify/registry/escalationpolicy/storage"
 	messagestorage "tkestack.io/tke/pkg/notify/registry/message/storage"
 	messagerequeststorage "tkestack.io/tke/pkg/notify/registry/messagerequest/storage"
+	oncallschedulestorage "tkestack.io/tke/pkg/notify/registry/oncallschedule/storage"
 	receiverstorage "tkestack.io/tke/pkg/notify/registry/receiver/storage"
 	receivergroupstorage "tkestack.io/tke/pkg/notify/registry/receivergroup/storage"
 	templatestorage "tkestack.io/tke/pkg/notify/registry/template/storage"
@@ -70,10 +73,13 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 
 	storageMap := make(map[string]rest.Storage)
 	{
-		channelREST := channelstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		channelREST := channelstorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
 		storageMap["channels"] = channelREST.Channel
 		storageMap["channels/status"] = channelREST.Status
 		storageMap["channels/finalize"] = channelREST.Finalize
+		storageMap["channels/preview"] = channelREST.Preview
+		storageMap["channels/quota"] = channelREST.Quota
+		storageMap["channels/alerts"] = channelREST.Alerts
 
 		templateREST := templatestorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
 		storageMap["templates"] = templateREST.Template
@@ -84,6 +90,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		messageREST := messagestorage.NewStorage(restOptionsGetter, s.PrivilegedUsername, s.MessageTTL)
 		storageMap["messages"] = messageREST.Message
 		storageMap["messages/status"] = messageREST.Status
+		storageMap["messages/resend"] = messageREST.Resend
 
 		messageRequestREST := messagerequeststorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername, s.MessageTTL)
 		storageMap["messagerequests"] = messageRequestREST.MessageRequest
@@ -94,6 +101,17 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 
 		receiverGroupREST := receivergroupstorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
 		storageMap["receivergroups"] = receiverGroupREST.ReceiverGroup
+
+		escalationPolicyREST := escalationpolicystorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
+		storageMap["escalationpolicies"] = escalationPolicyREST.EscalationPolicy
+
+		onCallScheduleREST := oncallschedulestorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername)
+		storageMap["oncallschedules"] = onCallScheduleREST.OnCallSchedule
+
+		escalationREST := escalationstorage.NewStorage(restOptionsGetter, notifyClient, s.PrivilegedUsername, s.MessageTTL)
+		storageMap["escalations"] = escalationREST.Escalation
+		storageMap["escalations/status"] = escalationREST.Status
+		storageMap["escalations/acknowledge"] = escalationREST.Acknowledge
 	}
 
 	return storageMap
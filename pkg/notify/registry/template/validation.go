@@ -20,19 +20,29 @@ package template
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	notifyinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/notify/internalversion"
 	"tkestack.io/tke/api/notify"
+	"tkestack.io/tke/pkg/notify/render"
 )
 
 // ValidateTemplateName is a ValidateNameFunc for names that must be a DNS
 // subdomain.
 var ValidateTemplateName = apimachineryvalidation.NameIsDNSLabel
 
+// larkCardColors are the template colors supported by the header of lark
+// message cards.
+var larkCardColors = sets.NewString("blue", "wathet", "turquoise", "green", "yellow", "orange", "red", "carmine", "violet", "purple", "indigo", "grey")
+
+var supportedLocales = sets.NewString(render.SupportedLocales...)
+
 // ValidateTemplate tests if required fields in the template are set.
 func ValidateTemplate(ctx context.Context, template *notify.Template, notifyClient *notifyinternalclient.NotifyClient) field.ErrorList {
 	allErrs := apimachineryvalidation.ValidateObjectMeta(&template.ObjectMeta, true, ValidateTemplateName, field.NewPath("metadata"))
@@ -79,7 +89,146 @@ func ValidateTemplate(ctx context.Context, template *notify.Template, notifyClie
 					}
 				}
 			}
+
+			if channel.Spec.WeCom != nil {
+				if template.Spec.WeCom == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("weCom"), "must specify wecom template"))
+				} else if template.Spec.WeCom.Content == "" {
+					allErrs = append(allErrs, field.Required(field.NewPath("weCom", "content"), "must specify content of wecom message"))
+				}
+			}
+
+			if channel.Spec.DingTalk != nil {
+				if template.Spec.DingTalk == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("dingTalk"), "must specify dingtalk template"))
+				} else {
+					if template.Spec.DingTalk.Title == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("dingTalk", "title"), "must specify title of dingtalk message"))
+					}
+					if template.Spec.DingTalk.Content == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("dingTalk", "content"), "must specify content of dingtalk message"))
+					}
+				}
+			}
+
+			if channel.Spec.Lark != nil {
+				if template.Spec.Lark == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("lark"), "must specify lark template"))
+				} else {
+					if template.Spec.Lark.Title == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("lark", "title"), "must specify title of lark message card"))
+					}
+					if template.Spec.Lark.Content == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("lark", "content"), "must specify content of lark message card"))
+					}
+					if template.Spec.Lark.Color != "" && !larkCardColors.Has(template.Spec.Lark.Color) {
+						allErrs = append(allErrs, field.NotSupported(field.NewPath("lark", "color"), template.Spec.Lark.Color, larkCardColors.List()))
+					}
+				}
+			}
+
+			if channel.Spec.PagerDuty != nil {
+				if template.Spec.PagerDuty == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("pagerDuty"), "must specify pagerduty template"))
+				} else if template.Spec.PagerDuty.Summary == "" {
+					allErrs = append(allErrs, field.Required(field.NewPath("pagerDuty", "summary"), "must specify summary of pagerduty event"))
+				}
+			}
+
+			if channel.Spec.Opsgenie != nil {
+				if template.Spec.Opsgenie == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("opsgenie"), "must specify opsgenie template"))
+				} else if template.Spec.Opsgenie.Message == "" {
+					allErrs = append(allErrs, field.Required(field.NewPath("opsgenie", "message"), "must specify message of opsgenie alert"))
+				}
+			}
+
+			if channel.Spec.Voice != nil {
+				if template.Spec.Voice == nil {
+					allErrs = append(allErrs, field.Required(field.NewPath("voice"), "must specify voice template"))
+				} else {
+					if template.Spec.Voice.Content == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("voice", "content"), "must specify content of voice call"))
+					}
+					if channel.Spec.Voice.TencentCloudVMS != nil && template.Spec.Voice.TemplateID == "" {
+						allErrs = append(allErrs, field.Required(field.NewPath("voice", "templateID"), "must specify template id of tencent cloud vms"))
+					}
+				}
+			}
+		}
+	}
+
+	allErrs = append(allErrs, ValidateTemplateTexts(&template.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateTemplateTexts tests if the go templates of the template and its
+// localizations can be parsed and only reference the alarm variables or the
+// declared keys.
+func ValidateTemplateTexts(spec *notify.TemplateSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	validateText := func(path *field.Path, text string) {
+		if text == "" {
+			return
+		}
+		unknown, err := render.UnknownVariables(text, spec.Keys)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path, text, fmt.Sprintf("invalid template: %v", err)))
+		} else if len(unknown) > 0 {
+			allErrs = append(allErrs, field.Invalid(path, text, fmt.Sprintf("undefined variables %s, must be alarm variables or declared in keys", strings.Join(unknown, ", "))))
+		}
+	}
+
+	if spec.TencentCloudSMS != nil {
+		validateText(fldPath.Child("tencentCloudSMS", "body"), spec.TencentCloudSMS.Body)
+	}
+	if spec.Wechat != nil {
+		validateText(fldPath.Child("wechat", "body"), spec.Wechat.Body)
+	}
+	if spec.Text != nil {
+		validateText(fldPath.Child("text", "header"), spec.Text.Header)
+		validateText(fldPath.Child("text", "body"), spec.Text.Body)
+	}
+	if spec.WeCom != nil {
+		validateText(fldPath.Child("weCom", "content"), spec.WeCom.Content)
+	}
+	if spec.DingTalk != nil {
+		validateText(fldPath.Child("dingTalk", "title"), spec.DingTalk.Title)
+		validateText(fldPath.Child("dingTalk", "content"), spec.DingTalk.Content)
+	}
+	if spec.Lark != nil {
+		validateText(fldPath.Child("lark", "title"), spec.Lark.Title)
+		validateText(fldPath.Child("lark", "content"), spec.Lark.Content)
+	}
+	if spec.PagerDuty != nil {
+		validateText(fldPath.Child("pagerDuty", "summary"), spec.PagerDuty.Summary)
+		validateText(fldPath.Child("pagerDuty", "source"), spec.PagerDuty.Source)
+	}
+	if spec.Opsgenie != nil {
+		validateText(fldPath.Child("opsgenie", "message"), spec.Opsgenie.Message)
+		validateText(fldPath.Child("opsgenie", "description"), spec.Opsgenie.Description)
+	}
+	if spec.Voice != nil {
+		validateText(fldPath.Child("voice", "content"), spec.Voice.Content)
+	}
+
+	locales := sets.NewString()
+	for i, localization := range spec.Localizations {
+		path := fldPath.Child("localizations").Index(i)
+		if localization.Locale == "" {
+			allErrs = append(allErrs, field.Required(path.Child("locale"), "must specify locale"))
+		} else if !supportedLocales.Has(localization.Locale) {
+			allErrs = append(allErrs, field.NotSupported(path.Child("locale"), localization.Locale, supportedLocales.List()))
+		} else if locales.Has(localization.Locale) {
+			allErrs = append(allErrs, field.Duplicate(path.Child("locale"), localization.Locale))
 		}
+		locales.Insert(localization.Locale)
+		validateText(path.Child("header"), localization.Header)
+		validateText(path.Child("body"), localization.Body)
+		validateText(path.Child("title"), localization.Title)
+		validateText(path.Child("content"), localization.Content)
 	}
 
 	return allErrs
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package render
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+func TestRender(t *testing.T) {
+	variables := map[string]string{
+		"alarmPolicyName": "cpu",
+		"summary":         "<b>high</b>",
+	}
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{"variable", "{{.alarmPolicyName}}", "cpu", false},
+		{"escaped", "{{.summary}}", "&lt;b&gt;high&lt;/b&gt;", false},
+		{"missing", "[{{.value}}]", "[]", false},
+		{"default", `{{default "none" .value}}`, "none", false},
+		{"upper", "{{upper .alarmPolicyName}}", "CPU", false},
+		{"truncate", "{{truncate 2 .alarmPolicyName}}", "cp...", false},
+		{"invalid", "{{.alarmPolicyName", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.name, tt.text, variables)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnknownVariables(t *testing.T) {
+	text := `{{.alarmPolicyName}} {{if .project}}{{.cluster}}{{else}}{{default "-" .phase}}{{end}} {{.value}}`
+	got, err := UnknownVariables(text, []string{"project"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"cluster", "phase"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("UnknownVariables() = %v, want %v", got, want)
+	}
+
+	if _, err := UnknownVariables("{{.value", nil); err == nil {
+		t.Errorf("UnknownVariables() should fail on invalid template")
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	template := &v1.TemplateSpec{
+		Text: &v1.TemplateText{Header: "告警", Body: "{{.summary}}"},
+		Lark: &v1.TemplateLark{Title: "告警", Content: "{{.summary}}"},
+		Localizations: []v1.TemplateLocalization{
+			{Locale: "en", Header: "Alarm", Title: "Alarm"},
+		},
+	}
+
+	if got := Localize(template, "zh"); got != template {
+		t.Errorf("template without the localization should be used as it is")
+	}
+	got := Localize(template, "en")
+	if got.Text.Header != "Alarm" || got.Text.Body != "{{.summary}}" || got.Lark.Title != "Alarm" {
+		t.Errorf("Localize() = %+v, %+v", got.Text, got.Lark)
+	}
+	if template.Text.Header != "告警" {
+		t.Errorf("Localize() should not modify the template")
+	}
+}
@@ -0,0 +1,127 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package render
+
+import (
+	v1 "tkestack.io/tke/api/notify/v1"
+)
+
+// AlarmVariables are the variables of messages set by the alarm webhook of
+// tke-notify-api and the message request controller.
+var AlarmVariables = []string{
+	"alertName",
+	"alertStatus",
+	"severity",
+	"fingerprint",
+	"startsAt",
+	"alarmPolicyType",
+	"alarmPolicyName",
+	"clusterID",
+	"clusterDisplayName",
+	"value",
+	"workloadKind",
+	"namespace",
+	"workloadName",
+	"podName",
+	"nodeName",
+	"nodeRole",
+	"unit",
+	"evaluateType",
+	"evaluateValue",
+	"metricDisplayName",
+	"summary",
+	"aggregatedCount",
+	"aggregatedSince",
+}
+
+// SupportedLocales are the locales of receivers and template localizations.
+var SupportedLocales = []string{"zh", "en"}
+
+// UnknownVariables returns the variables referenced by the template which
+// are neither alarm variables nor the given keys.
+func UnknownVariables(text string, keys []string) ([]string, error) {
+	names, err := Variables(text)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]bool, len(AlarmVariables)+len(keys))
+	for _, k := range AlarmVariables {
+		known[k] = true
+	}
+	for _, k := range keys {
+		known[k] = true
+	}
+	var unknown []string
+	for _, name := range names {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	return unknown, nil
+}
+
+// Localize returns the template for the receivers of the locale, or the
+// template itself if it has no localization of the locale.
+func Localize(template *v1.TemplateSpec, locale string) *v1.TemplateSpec {
+	if locale == "" {
+		return template
+	}
+	var localization *v1.TemplateLocalization
+	for i := range template.Localizations {
+		if template.Localizations[i].Locale == locale {
+			localization = &template.Localizations[i]
+			break
+		}
+	}
+	if localization == nil {
+		return template
+	}
+
+	localized := template.DeepCopy()
+	override := func(field *string, value string) {
+		if value != "" {
+			*field = value
+		}
+	}
+	if localized.TencentCloudSMS != nil {
+		override(&localized.TencentCloudSMS.Body, localization.Body)
+	}
+	if localized.Wechat != nil {
+		override(&localized.Wechat.Body, localization.Body)
+	}
+	if localized.Text != nil {
+		override(&localized.Text.Header, localization.Header)
+		override(&localized.Text.Body, localization.Body)
+	}
+	if localized.WeCom != nil {
+		override(&localized.WeCom.Content, localization.Content)
+	}
+	if localized.DingTalk != nil {
+		override(&localized.DingTalk.Title, localization.Title)
+		override(&localized.DingTalk.Content, localization.Content)
+	}
+	if localized.Lark != nil {
+		override(&localized.Lark.Title, localization.Title)
+		override(&localized.Lark.Content, localization.Content)
+	}
+	if localized.Voice != nil {
+		override(&localized.Voice.Content, localization.Content)
+	}
+	return localized
+}
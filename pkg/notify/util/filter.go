@@ -98,3 +98,39 @@ func FilterTemplate(ctx context.Context, tpl *notify.Template) error {
 	}
 	return nil
 }
+
+// FilterEscalationPolicy is used to filter escalation policy that do not belong to the tenant.
+func FilterEscalationPolicy(ctx context.Context, policy *notify.EscalationPolicy) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if policy.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("escalationpolicy"), policy.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterOnCallSchedule is used to filter on-call schedule that do not belong to the tenant.
+func FilterOnCallSchedule(ctx context.Context, schedule *notify.OnCallSchedule) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if schedule.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("oncallschedule"), schedule.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterEscalation is used to filter escalation that do not belong to the tenant.
+func FilterEscalation(ctx context.Context, escalation *notify.Escalation) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if escalation.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("escalation"), escalation.ObjectMeta.Name)
+	}
+	return nil
+}
@@ -21,6 +21,8 @@ package apiserver
 import (
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 	"unsafe"
 
 	v1 "k8s.io/api/core/v1"
@@ -275,3 +277,32 @@ func autoConvert_url_Values_To_v1_PodLogOptions(in *url.Values, out *v1.PodLogOp
 func Convert_url_Values_To_v1_PodLogOptions(in *url.Values, out *v1.PodLogOptions, s conversion.Scope) error {
 	return autoConvert_url_Values_To_v1_PodLogOptions(in, out, s)
 }
+
+// Convert_url_Values_To_v1_PodPortForwardOptions converts the query parameters
+// of port forward, the ports may be repeated or separated by commas.
+func Convert_url_Values_To_v1_PodPortForwardOptions(in *url.Values, out *v1.PodPortForwardOptions, s conversion.Scope) error {
+	out.Ports = nil
+	for _, value := range map[string][]string(*in)["ports"] {
+		for _, item := range strings.Split(value, ",") {
+			port, err := strconv.ParseInt(strings.TrimSpace(item), 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid port %q: %v", item, err)
+			}
+			out.Ports = append(out.Ports, int32(port))
+		}
+	}
+	return nil
+}
+
+// Convert_url_Values_To_v1_ServiceProxyOptions converts the query parameters
+// of service proxy.
+func Convert_url_Values_To_v1_ServiceProxyOptions(in *url.Values, out *v1.ServiceProxyOptions, s conversion.Scope) error {
+	if values, ok := map[string][]string(*in)["path"]; ok && len(values) > 0 {
+		if err := runtime.Convert_Slice_string_To_string(&values, &out.Path, s); err != nil {
+			return err
+		}
+	} else {
+		out.Path = ""
+	}
+	return nil
+}
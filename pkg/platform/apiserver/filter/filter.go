@@ -30,6 +30,7 @@ import (
 const clusterContextKey = "clusterName"
 const requestBodyKey = "requestBody"
 const fuzzyResourceContextKey = "fuzzyResourceName"
+const operationTTLContextKey = "operationTTL"
 
 const namespaceContextKey = "namespace"
 const namespaceParamKey = "namespace"
@@ -43,6 +44,11 @@ const ProjectNameHeaderKey = "X-TKE-ProjectName"
 // FuzzyResourceNameHeaderKey is the header name of fuzzy resource query name.
 const FuzzyResourceNameHeaderKey = "X-TKE-FuzzyResourceName"
 
+// OperationTTLHeaderKey is the header name of how long the mutation is queued
+// if the cluster is unreachable, such as 30m. The mutation is not queued if it
+// is 0.
+const OperationTTLHeaderKey = "X-TKE-OperationTTL"
+
 // RequestBody represents the body of HTTP request.
 type RequestBody struct {
 	Data        []byte
@@ -67,6 +73,15 @@ func FuzzyResourceFrom(ctx context.Context) string {
 	return fuzzyResourceName
 }
 
+// OperationTTLFrom get the operation TTL from request context.
+func OperationTTLFrom(ctx context.Context) string {
+	ttl, ok := ctx.Value(operationTTLContextKey).(string)
+	if !ok {
+		return ""
+	}
+	return ttl
+}
+
 // RequestBodyFrom returns the RequestBody object.
 func RequestBodyFrom(ctx context.Context) (*RequestBody, bool) {
 	val := ctx.Value(requestBodyKey)
@@ -111,6 +126,18 @@ func WithFuzzyResource(handler http.Handler) http.Handler {
 	})
 }
 
+// WithOperationTTL adds the operation TTL to the context of the http access
+// chain.
+func WithOperationTTL(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ttl := req.Header.Get(OperationTTLHeaderKey)
+		if ttl != "" {
+			req = req.WithContext(genericrequest.WithValue(req.Context(), operationTTLContextKey, ttl))
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
 // WithRequestBody adds the request body to the context of the http access chain.
 func WithRequestBody(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
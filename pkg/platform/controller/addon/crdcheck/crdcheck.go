@@ -0,0 +1,166 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package crdcheck
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// OwnerLabel marks a CRD as owned by a TKE addon.
+	OwnerLabel = "platform.tkestack.io/addon"
+
+	helmReleaseAnnotation = "meta.helm.sh/release-name"
+	managedByLabel        = "app.kubernetes.io/managed-by"
+)
+
+// Requirement describes a CRD an addon needs and the version it must serve.
+type Requirement struct {
+	Name    string
+	Version string
+}
+
+var (
+	// TappControllerCRDs are the CRDs required by the tapp controller addon.
+	TappControllerCRDs = []Requirement{{Name: "tapps.apps.tkestack.io", Version: "v1"}}
+	// CronHPACRDs are the CRDs required by the cronhpa addon.
+	CronHPACRDs = []Requirement{{Name: "cronhpas.extensions.tkestack.io", Version: "v1"}}
+	// CSIOperatorCRDs are the CRDs required by the csi-operator addon.
+	CSIOperatorCRDs = []Requirement{{Name: "csis.storage.tkestack.io", Version: "v1"}}
+)
+
+// Check lists the CRDs required by the addon in the cluster and reports the
+// ones that are owned by someone else or don't serve the required version.
+// CRDs without any owner marker are considered leftovers of the addon itself.
+func Check(ctx context.Context, client apiextensionsclient.Interface, addon string, reqs []Requirement) ([]platformv1.CRDConflict, error) {
+	var conflicts []platformv1.CRDConflict
+	for _, req := range reqs {
+		crd, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().Get(ctx, req.Name, metav1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		served := servedVersions(crd)
+		if owner := foreignOwner(crd, addon); owner != "" {
+			conflicts = append(conflicts, platformv1.CRDConflict{
+				Name:            req.Name,
+				Reason:          platformv1.CRDConflictOwner,
+				Message:         fmt.Sprintf("crd %s is managed by %s", req.Name, owner),
+				Owner:           owner,
+				ServedVersions:  served,
+				RequiredVersion: req.Version,
+			})
+			continue
+		}
+		if !contains(served, req.Version) {
+			conflicts = append(conflicts, platformv1.CRDConflict{
+				Name:            req.Name,
+				Reason:          platformv1.CRDConflictVersion,
+				Message:         fmt.Sprintf("crd %s doesn't serve version %s", req.Name, req.Version),
+				ServedVersions:  served,
+				RequiredVersion: req.Version,
+			})
+		}
+	}
+	return conflicts, nil
+}
+
+// Resolve applies the conflict policy and returns the conflicts that remain.
+// Adopt takes over CRDs owned by someone else but can't fix version
+// mismatches, Overwrite deletes every conflicting CRD so the addon can
+// recreate it.
+func Resolve(ctx context.Context, client apiextensionsclient.Interface, addon string, policy platformv1.CRDConflictPolicy, conflicts []platformv1.CRDConflict) ([]platformv1.CRDConflict, error) {
+	var remains []platformv1.CRDConflict
+	for _, conflict := range conflicts {
+		switch {
+		case policy == platformv1.CRDConflictOverwrite:
+			log.Warn("Delete conflicting crd", log.String("addon", addon), log.String("crd", conflict.Name), log.String("reason", string(conflict.Reason)))
+			err := client.ApiextensionsV1beta1().CustomResourceDefinitions().Delete(ctx, conflict.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+		case policy == platformv1.CRDConflictAdopt && conflict.Reason == platformv1.CRDConflictOwner:
+			log.Info("Adopt conflicting crd", log.String("addon", addon), log.String("crd", conflict.Name), log.String("owner", conflict.Owner))
+			patch := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, OwnerLabel, addon)
+			if _, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().Patch(ctx, conflict.Name, types.MergePatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
+				return nil, err
+			}
+		default:
+			remains = append(remains, conflict)
+		}
+	}
+	return remains, nil
+}
+
+// Error summarizes the conflicts for the status reason.
+func Error(conflicts []platformv1.CRDConflict) error {
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%d crd conflict(s), first: %s", len(conflicts), conflicts[0].Message)
+}
+
+func foreignOwner(crd *v1beta1.CustomResourceDefinition, addon string) string {
+	if owner, ok := crd.Labels[OwnerLabel]; ok {
+		if owner == addon {
+			return ""
+		}
+		return "addon/" + owner
+	}
+	if release, ok := crd.Annotations[helmReleaseAnnotation]; ok {
+		return "helm/" + release
+	}
+	if manager, ok := crd.Labels[managedByLabel]; ok {
+		return manager
+	}
+	return ""
+}
+
+func servedVersions(crd *v1beta1.CustomResourceDefinition) []string {
+	var versions []string
+	for _, version := range crd.Spec.Versions {
+		if version.Served {
+			versions = append(versions, version.Name)
+		}
+	}
+	if len(versions) == 0 && crd.Spec.Version != "" {
+		versions = append(versions, crd.Spec.Version)
+	}
+	return versions
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
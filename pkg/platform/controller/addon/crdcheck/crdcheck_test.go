@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package crdcheck
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func crd(name string, labels, annotations map[string]string, versions ...string) *v1beta1.CustomResourceDefinition {
+	obj := &v1beta1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations},
+	}
+	for _, version := range versions {
+		obj.Spec.Versions = append(obj.Spec.Versions, v1beta1.CustomResourceDefinitionVersion{Name: version, Served: true})
+	}
+	return obj
+}
+
+func TestCheck(t *testing.T) {
+	reqs := []Requirement{
+		{Name: "a.example.com", Version: "v1"},
+		{Name: "b.example.com", Version: "v1"},
+		{Name: "c.example.com", Version: "v1"},
+		{Name: "d.example.com", Version: "v1"},
+		{Name: "e.example.com", Version: "v1"},
+	}
+	client := fake.NewSimpleClientset(
+		crd("a.example.com", nil, nil, "v1"),
+		crd("b.example.com", map[string]string{OwnerLabel: "tapp"}, nil, "v1"),
+		crd("c.example.com", nil, map[string]string{helmReleaseAnnotation: "tapp"}, "v1"),
+		crd("d.example.com", map[string]string{OwnerLabel: "other"}, nil, "v1"),
+		crd("e.example.com", nil, nil, "v1alpha1"),
+	)
+
+	conflicts, err := Check(context.Background(), client, "tapp", reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]platformv1.CRDConflictReason{
+		"c.example.com": platformv1.CRDConflictOwner,
+		"d.example.com": platformv1.CRDConflictOwner,
+		"e.example.com": platformv1.CRDConflictVersion,
+	}
+	if len(conflicts) != len(want) {
+		t.Fatalf("got %d conflicts, want %d: %v", len(conflicts), len(want), conflicts)
+	}
+	for _, conflict := range conflicts {
+		if want[conflict.Name] != conflict.Reason {
+			t.Errorf("conflict %s: got reason %s, want %s", conflict.Name, conflict.Reason, want[conflict.Name])
+		}
+	}
+}
+
+func TestResolve(t *testing.T) {
+	ctx := context.Background()
+	conflicts := []platformv1.CRDConflict{
+		{Name: "c.example.com", Reason: platformv1.CRDConflictOwner},
+		{Name: "e.example.com", Reason: platformv1.CRDConflictVersion},
+	}
+	newClient := func() *fake.Clientset {
+		return fake.NewSimpleClientset(
+			crd("c.example.com", nil, map[string]string{helmReleaseAnnotation: "tapp"}, "v1"),
+			crd("e.example.com", nil, nil, "v1alpha1"),
+		)
+	}
+
+	client := newClient()
+	remains, err := Resolve(ctx, client, "tapp", platformv1.CRDConflictFail, conflicts)
+	if err != nil || len(remains) != 2 {
+		t.Fatalf("fail policy: got %v, %v", remains, err)
+	}
+
+	client = newClient()
+	remains, err = Resolve(ctx, client, "tapp", platformv1.CRDConflictAdopt, conflicts)
+	if err != nil || len(remains) != 1 || remains[0].Name != "e.example.com" {
+		t.Fatalf("adopt policy: got %v, %v", remains, err)
+	}
+	adopted, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().Get(ctx, "c.example.com", metav1.GetOptions{})
+	if err != nil || adopted.Labels[OwnerLabel] != "tapp" {
+		t.Fatalf("adopt policy: crd not labeled: %v, %v", adopted, err)
+	}
+
+	client = newClient()
+	remains, err = Resolve(ctx, client, "tapp", platformv1.CRDConflictOverwrite, conflicts)
+	if err != nil || len(remains) != 0 {
+		t.Fatalf("overwrite policy: got %v, %v", remains, err)
+	}
+	list, err := client.ApiextensionsV1beta1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	if err != nil || len(list.Items) != 0 {
+		t.Fatalf("overwrite policy: crds not deleted: %v, %v", list, err)
+	}
+}
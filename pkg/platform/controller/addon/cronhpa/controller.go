@@ -20,10 +20,6 @@ package cronhpa
 
 import (
 	"context"
-	normalerrors "errors"
-	"fmt"
-	"reflect"
-	"sync"
 	"time"
 
 	"tkestack.io/tke/pkg/platform/controller/addon/cronhpa/images"
@@ -31,32 +27,21 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/workqueue"
 
 	clientset "tkestack.io/tke/api/client/clientset/versioned"
 	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
 	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
 	v1 "tkestack.io/tke/api/platform/v1"
-	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/controller/addon/crdcheck"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
 	"tkestack.io/tke/pkg/platform/util"
-	"tkestack.io/tke/pkg/util/log"
-	"tkestack.io/tke/pkg/util/metrics"
 )
 
 const (
-	clientRetryCount    = 5
-	clientRetryInterval = 5 * time.Second
-
-	maxRetryCount = 5
-	timeOut       = 5 * time.Minute
+	crdOwner = "cronhpa"
 )
 
 const (
@@ -65,341 +50,115 @@ const (
 	svcCronHPAName        = "cron-hpa-controller"
 	svcAccountCronHPAName = "cron-hpa-controller"
 	crbCronHPAName        = "cron-hpa-controller"
-)
 
-// Controller is responsible for performing actions dependent upon a CronHPA phase.
-type Controller struct {
-	client       clientset.Interface
-	cache        *cronHPACache
-	health       sync.Map
-	checking     sync.Map
-	upgrading    sync.Map
-	queue        workqueue.RateLimitingInterface
-	lister       platformv1lister.CronHPALister
-	listerSynced cache.InformerSynced
-	stopCh       <-chan struct{}
-}
+	zoneInfoVolume = "zoneinfo"
+	zoneInfoPath   = "/usr/share/zoneinfo"
+)
 
-// NewController creates a new Controller object.
-func NewController(client clientset.Interface, informer platformv1informer.CronHPAInformer, resyncPeriod time.Duration) *Controller {
-	// create the controller so we can inject the enqueue function
-	controller := &Controller{
+// NewController creates a new controller which drives the CronHPAs
+// through the addon lifecycle.
+func NewController(client clientset.Interface, informer platformv1informer.CronHPAInformer, resyncPeriod time.Duration) *lifecycle.Controller {
+	addon := &cronHPAAddon{
 		client: client,
-		cache:  &cronHPACache{cronHPAMap: make(map[string]*cachedCronHPA)},
-		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
-	}
-
-	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
-		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.PlatformV1().RESTClient().GetRateLimiter())
+		lister: informer.Lister(),
 	}
-
-	// configure the CronHPA informer event handlers
-	informer.Informer().AddEventHandlerWithResyncPeriod(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: controller.enqueueCronHPA,
-			UpdateFunc: func(oldObj, newObj interface{}) {
-				oldCronHPA, ok1 := oldObj.(*v1.CronHPA)
-				curCronHPA, ok2 := newObj.(*v1.CronHPA)
-				if ok1 && ok2 && controller.needsUpdate(oldCronHPA, curCronHPA) {
-					controller.enqueueCronHPA(newObj)
-				}
-			},
-			DeleteFunc: controller.enqueueCronHPA,
-		},
-		resyncPeriod,
-	)
-	controller.lister = informer.Lister()
-	controller.listerSynced = informer.Informer().HasSynced
-
-	return controller
+	return lifecycle.NewController(controllerName, client, informer.Informer(), addon, resyncPeriod)
 }
 
-// obj could be an *v1.CronHPA, or a DeletionFinalStateUnknown marker item.
-func (c *Controller) enqueueCronHPA(obj interface{}) {
-	key, err := controllerutil.KeyFunc(obj)
-	if err != nil {
-		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
-		return
-	}
-	c.queue.Add(key)
+// cronHPAObject adapts the CronHPA to the addon lifecycle.
+type cronHPAObject struct {
+	*v1.CronHPA
 }
 
-func (c *Controller) needsUpdate(old *v1.CronHPA, new *v1.CronHPA) bool {
-	return !reflect.DeepEqual(old, new)
+func (o cronHPAObject) ClusterName() string {
+	return o.Spec.ClusterName
 }
 
-// Run will set up the event handlers for types we are interested in, as well
-// as syncing informer caches and starting workers.
-func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
-	defer runtime.HandleCrash()
-	defer c.queue.ShutDown()
-
-	// Start the informer factories to begin populating the informer caches
-	log.Info("Starting CronHPA controller")
-	defer log.Info("Shutting down CronHPA controller")
-
-	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
-		return fmt.Errorf("failed to wait for cluster caches to sync")
-	}
-
-	c.stopCh = stopCh
-
-	for i := 0; i < workers; i++ {
-		go wait.Until(c.worker, time.Second, stopCh)
-	}
-
-	<-stopCh
-	return nil
+func (o cronHPAObject) Version() string {
+	return o.Spec.Version
 }
 
-// worker processes the queue of namespace objects.
-// Each namespace can be in the queue at most once.
-// The system ensures that no two workers can process
-// the same namespace at the same time.
-func (c *Controller) worker() {
-	for c.processNextWorkItem() {
-	}
+func (o cronHPAObject) DriftPolicy() v1.AddonDriftPolicy {
+	return o.Spec.DriftPolicy
 }
 
-func (c *Controller) processNextWorkItem() bool {
-	key, quit := c.queue.Get()
-	if quit {
-		return false
-	}
-	defer c.queue.Done(key)
-
-	err := c.syncCronHPA(key.(string))
-	if err == nil {
-		c.queue.Forget(key)
-		return true
+func (o cronHPAObject) GetStatus() lifecycle.Status {
+	return lifecycle.Status{
+		Version:                     o.Status.Version,
+		Phase:                       o.Status.Phase,
+		Reason:                      o.Status.Reason,
+		RetryCount:                  o.Status.RetryCount,
+		LastReInitializingTimestamp: o.Status.LastReInitializingTimestamp,
+		Conditions:                  o.Status.Conditions,
 	}
-
-	runtime.HandleError(fmt.Errorf("error processing CronHPA %v (will retry): %v", key, err))
-	c.queue.AddRateLimited(key)
-	return true
 }
 
-// syncCronHPA will sync the CronHPA with the given key if it has had
-// its expectations fulfilled, meaning it did not expect to see any more of its
-// namespaces created or deleted. This function is not meant to be invoked
-// concurrently with the same key.
-func (c *Controller) syncCronHPA(key string) error {
-	startTime := time.Now()
-	defer func() {
-		log.Info("Finished syncing CronHPA", log.String("CronHPA", key), log.Duration("processTime", time.Since(startTime)))
-	}()
-
-	_, name, err := cache.SplitMetaNamespaceKey(key)
-	if err != nil {
-		return err
-	}
-
-	// cronHPA holds the latest cronHPA info from apiserver
-	cronHPA, err := c.lister.Get(name)
-	switch {
-	case errors.IsNotFound(err):
-		log.Info("CronHPA has been deleted. Attempting to cleanup resources", log.String("CronHPA", key))
-		err = c.processCronHPADeletion(context.Background(), key)
-	case err != nil:
-		log.Warn("Unable to retrieve CronHPA from store", log.String("CronHPA", key), log.Err(err))
-	default:
-		cachedCronHPA := c.cache.getOrCreate(key)
-		err = c.processCronHPAUpdate(context.Background(), cachedCronHPA, cronHPA, key)
-	}
-	return err
+func (o cronHPAObject) WithStatus(status lifecycle.Status) lifecycle.Object {
+	cronHPA := o.DeepCopy()
+	cronHPA.Status.Version = status.Version
+	cronHPA.Status.Phase = status.Phase
+	cronHPA.Status.Reason = status.Reason
+	cronHPA.Status.RetryCount = status.RetryCount
+	cronHPA.Status.LastReInitializingTimestamp = status.LastReInitializingTimestamp
+	cronHPA.Status.Conditions = status.Conditions
+	return cronHPAObject{cronHPA}
 }
 
-func (c *Controller) processCronHPADeletion(ctx context.Context, key string) error {
-	cachedCronHPA, ok := c.cache.get(key)
-	if !ok {
-		log.Error("CronHPA not in cache even though the watcher thought it was. Ignoring the deletion", log.String("CronHPA", key))
-		return nil
-	}
-	return c.processCronHPADelete(ctx, cachedCronHPA, key)
+type cronHPAAddon struct {
+	client clientset.Interface
+	lister platformv1lister.CronHPALister
 }
 
-func (c *Controller) processCronHPADelete(ctx context.Context, cachedCronHPA *cachedCronHPA, key string) error {
-	log.Info("CronHPA will be dropped", log.String("CronHPA", key))
-
-	if c.cache.Exist(key) {
-		log.Info("Delete the CronHPA cache", log.String("CronHPA", key))
-		c.cache.delete(key)
-	}
-
-	if _, ok := c.health.Load(key); ok {
-		log.Info("Delete the CronHPA health cache", log.String("CronHPA", key))
-		c.health.Delete(key)
-	}
-
-	cronHPA := cachedCronHPA.state
-	return c.uninstallCronHPA(ctx, cronHPA)
+func (a *cronHPAAddon) Kind() string {
+	return "CronHPA"
 }
 
-func (c *Controller) processCronHPAUpdate(ctx context.Context, cachedCronHPA *cachedCronHPA, cronHPA *v1.CronHPA, key string) error {
-	if cachedCronHPA.state != nil {
-		// exist and the cluster name changed
-		if cachedCronHPA.state.UID != cronHPA.UID {
-			if err := c.processCronHPADelete(ctx, cachedCronHPA, key); err != nil {
-				return err
-			}
-		}
-	}
-	err := c.createCronHPAIfNeeded(ctx, key, cachedCronHPA, cronHPA)
+func (a *cronHPAAddon) Get(name string) (lifecycle.Object, error) {
+	cronHPA, err := a.lister.Get(name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	cachedCronHPA.state = cronHPA
-	// Always update the cache upon success.
-	c.cache.set(key, cachedCronHPA)
-	return nil
+	return cronHPAObject{cronHPA}, nil
 }
 
-func (c *Controller) cronHPAReinitialize(ctx context.Context, key string, cachedCronHPA *cachedCronHPA, cronHPA *v1.CronHPA) func() (bool, error) {
-	// this func will always return true that keeps the poll once
-	return func() (bool, error) {
-		err := c.installCronHPA(ctx, cronHPA)
-		if err == nil {
-			cronHPA = cronHPA.DeepCopy()
-			cronHPA.Status.Phase = v1.AddonPhaseChecking
-			cronHPA.Status.Reason = ""
-			cronHPA.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
-			err = c.persistUpdate(ctx, cronHPA)
-			if err != nil {
-				return true, err
-			}
-			return true, nil
-		}
-		// First, rollback the cronHPA
-		if err := c.uninstallCronHPA(ctx, cronHPA); err != nil {
-			log.Error("Uninstall CronHPA error.")
-			return true, err
-		}
-		if cronHPA.Status.RetryCount == maxRetryCount {
-			cronHPA = cronHPA.DeepCopy()
-			cronHPA.Status.Phase = v1.AddonPhaseFailed
-			cronHPA.Status.Reason = fmt.Sprintf("Install error and retried max(%d) times already.", maxRetryCount)
-			err := c.persistUpdate(ctx, cronHPA)
-			if err != nil {
-				log.Error("Update CronHPA error.")
-				return true, err
-			}
-			return true, nil
-		}
-		// Add the retry count will trigger reinitialize function from the persistent controller again.
-		cronHPA = cronHPA.DeepCopy()
-		cronHPA.Status.Phase = v1.AddonPhaseReinitializing
-		cronHPA.Status.Reason = err.Error()
-		cronHPA.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
-		cronHPA.Status.RetryCount++
-		err = c.persistUpdate(ctx, cronHPA)
-		if err != nil {
-			return true, err
-		}
-		return true, nil
-	}
+func (a *cronHPAAddon) UpdateStatus(ctx context.Context, obj lifecycle.Object) error {
+	_, err := a.client.PlatformV1().CronHPAs().UpdateStatus(ctx, obj.(cronHPAObject).CronHPA, metav1.UpdateOptions{})
+	return err
 }
 
-func (c *Controller) createCronHPAIfNeeded(ctx context.Context, key string, cachedCronHPA *cachedCronHPA, cronHPA *v1.CronHPA) error {
-	switch cronHPA.Status.Phase {
-	case v1.AddonPhaseInitializing:
-		log.Error("CronHPA will be created", log.String("CronHPA", key))
-		err := c.installCronHPA(ctx, cronHPA)
-		if err == nil {
-			cronHPA = cronHPA.DeepCopy()
-			cronHPA.Status.Version = cronHPA.Spec.Version
-			cronHPA.Status.Phase = v1.AddonPhaseChecking
-			cronHPA.Status.Reason = ""
-			cronHPA.Status.RetryCount = 0
-			return c.persistUpdate(ctx, cronHPA)
-		}
-		cronHPA = cronHPA.DeepCopy()
-		cronHPA.Status.Version = cronHPA.Spec.Version
-		cronHPA.Status.Phase = v1.AddonPhaseReinitializing
-		cronHPA.Status.Reason = err.Error()
-		cronHPA.Status.RetryCount = 1
-		cronHPA.Status.LastReInitializingTimestamp = metav1.Now()
-		return c.persistUpdate(ctx, cronHPA)
-	case v1.AddonPhaseReinitializing:
-		var interval = time.Since(cronHPA.Status.LastReInitializingTimestamp.Time)
-		var waitTime time.Duration
-		if interval >= timeOut {
-			waitTime = time.Duration(1)
-		} else {
-			waitTime = timeOut - interval
-		}
-		go wait.Poll(waitTime, timeOut, c.cronHPAReinitialize(ctx, key, cachedCronHPA, cronHPA))
-	case v1.AddonPhaseChecking:
-		if _, ok := c.checking.Load(key); !ok {
-			c.checking.Store(key, true)
-			initDelay := time.Now().Add(5 * time.Minute)
-			go func() {
-				defer c.checking.Delete(key)
-				wait.PollImmediate(5*time.Second, 5*time.Minute, c.checkCronHPAStatus(ctx, cronHPA, key, initDelay))
-			}()
-		}
-	case v1.AddonPhaseRunning:
-		if needUpgrade(cronHPA) {
-			c.health.Delete(key)
-			cronHPA = cronHPA.DeepCopy()
-			cronHPA.Status.Phase = v1.AddonPhaseUpgrading
-			cronHPA.Status.Reason = ""
-			cronHPA.Status.RetryCount = 0
-			return c.persistUpdate(ctx, cronHPA)
-		}
-		if _, ok := c.health.Load(key); !ok {
-			c.health.Store(key, true)
-			go wait.PollImmediateUntil(5*time.Minute, c.watchCronHPAHealth(ctx, key), c.stopCh)
-		}
-	case v1.AddonPhaseUpgrading:
-		if _, ok := c.upgrading.Load(key); !ok {
-			c.upgrading.Store(key, true)
-			upgradeDelay := time.Now().Add(timeOut)
-			go func() {
-				defer c.upgrading.Delete(key)
-				wait.PollImmediate(5*time.Second, timeOut, c.upgradeCronHPA(ctx, cronHPA, key, upgradeDelay))
-			}()
-		}
-	case v1.AddonPhaseFailed:
-		log.Info("CronHPA is error", log.String("CronHPA", key))
-		c.health.Delete(key)
-		c.checking.Delete(key)
-		c.upgrading.Delete(key)
+func (a *cronHPAAddon) Bundle(version string, obj lifecycle.Object) (lifecycle.Bundle, error) {
+	if err := images.Validate(version); err != nil {
+		return nil, err
 	}
-	return nil
+	return lifecycle.Bundle{
+		serviceAccountCronHPA(),
+		crbCronHPA(),
+		deploymentCronHPA(version, obj.(cronHPAObject).Spec.TimeZone),
+		serviceCronHPA(),
+	}, nil
 }
 
-func needUpgrade(cronHPA *v1.CronHPA) bool {
-	return cronHPA.Spec.Version != cronHPA.Status.Version
-}
-
-func (c *Controller) installCronHPA(ctx context.Context, cronHPA *v1.CronHPA) error {
-	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, cronHPA.Spec.ClusterName, metav1.GetOptions{})
+// Preflight checks the CRDs required by CronHPA in the cluster and
+// applies the conflict policy, the conflicts remained fail the installation.
+func (a *cronHPAAddon) Preflight(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) (lifecycle.Object, error) {
+	extClient, err := util.BuildExternalExtensionClientSet(ctx, cluster, a.client.PlatformV1())
 	if err != nil {
-		return err
+		return obj, err
 	}
-	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	conflicts, err := crdcheck.Check(ctx, extClient, crdOwner, crdcheck.CronHPACRDs)
 	if err != nil {
-		return err
-	}
-	// ServiceAccount CronHPA
-	if _, err := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Create(ctx, serviceAccountCronHPA(), metav1.CreateOptions{}); err != nil {
-		return err
+		return obj, err
 	}
-	// ClusterRoleBinding CronHPA
-	if _, err := kubeClient.RbacV1().ClusterRoleBindings().Create(ctx, crbCronHPA(), metav1.CreateOptions{}); err != nil {
-		return err
-	}
-	// Deployment CronHPA
-	if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Create(ctx, deploymentCronHPA(cronHPA.Spec.Version), metav1.CreateOptions{}); err != nil {
-		return err
+	conflicts, err = crdcheck.Resolve(ctx, extClient, crdOwner, obj.(cronHPAObject).Spec.CRDConflictPolicy, conflicts)
+	if err != nil {
+		return obj, err
 	}
-	// Service CronHPA
-	if _, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Create(ctx, serviceCronHPA(), metav1.CreateOptions{}); err != nil {
-		return err
+	cronHPA := obj.(cronHPAObject).DeepCopy()
+	cronHPA.Status.CRDConflicts = conflicts
+	if len(conflicts) > 0 {
+		return cronHPAObject{cronHPA}, crdcheck.Error(conflicts)
 	}
-
-	return nil
+	return cronHPAObject{cronHPA}, nil
 }
 
 func serviceAccountCronHPA() *corev1.ServiceAccount {
@@ -439,8 +198,8 @@ func crbCronHPA() *rbacv1.ClusterRoleBinding {
 	}
 }
 
-func deploymentCronHPA(cronHPAVersion string) *appsv1.Deployment {
-	return &appsv1.Deployment{
+func deploymentCronHPA(cronHPAVersion string, timeZone string) *appsv1.Deployment {
+	deploy := &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Deployment",
 			APIVersion: "apps/v1",
@@ -480,6 +239,27 @@ func deploymentCronHPA(cronHPAVersion string) *appsv1.Deployment {
 			},
 		},
 	}
+	if timeZone != "" {
+		setTimeZone(&deploy.Spec.Template.Spec, timeZone)
+	}
+	return deploy
+}
+
+// setTimeZone runs cron-hpa-controller in the time zone, which is loaded from
+// the zoneinfo of nodes.
+func setTimeZone(spec *corev1.PodSpec, timeZone string) {
+	spec.Containers[0].Env = append(spec.Containers[0].Env, corev1.EnvVar{Name: "TZ", Value: timeZone})
+	spec.Containers[0].VolumeMounts = append(spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      zoneInfoVolume,
+		MountPath: zoneInfoPath,
+		ReadOnly:  true,
+	})
+	spec.Volumes = append(spec.Volumes, corev1.Volume{
+		Name: zoneInfoVolume,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: zoneInfoPath},
+		},
+	})
 }
 
 func serviceCronHPA() *corev1.Service {
@@ -504,187 +284,3 @@ func serviceCronHPA() *corev1.Service {
 }
 
 func int32Ptr(i int32) *int32 { return &i }
-
-func (c *Controller) uninstallCronHPA(ctx context.Context, cronHPA *v1.CronHPA) error {
-	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, cronHPA.Spec.ClusterName, metav1.GetOptions{})
-	if err != nil && errors.IsNotFound(err) {
-		return nil
-	}
-	if err != nil {
-		return err
-	}
-	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-	if err != nil {
-		return err
-	}
-	// Deployment CronHPA
-	deployCronHPAErr := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Delete(ctx, deployCronHPAName, metav1.DeleteOptions{})
-	// ClusterRoleBinding CronHPA
-	crbCronHPAErr := kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, crbCronHPAName, metav1.DeleteOptions{})
-	// ServiceAccount CronHPA
-	svcAccountCronHPAErr := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Delete(ctx, svcAccountCronHPAName, metav1.DeleteOptions{})
-	// Service CronHPA
-	svcCronHPAErr := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Delete(ctx, svcCronHPAName, metav1.DeleteOptions{})
-
-	if (deployCronHPAErr != nil && !errors.IsNotFound(deployCronHPAErr)) ||
-		(crbCronHPAErr != nil && !errors.IsNotFound(crbCronHPAErr)) ||
-		(svcAccountCronHPAErr != nil && !errors.IsNotFound(svcAccountCronHPAErr)) ||
-		(svcCronHPAErr != nil && !errors.IsNotFound(svcCronHPAErr)) {
-		return normalerrors.New("delete CronHPA error")
-	}
-	return nil
-}
-
-func (c *Controller) watchCronHPAHealth(ctx context.Context, key string) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start check CronHPA in cluster health", log.String("CronHPA", key))
-		cronHPA, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, cronHPA.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.health.Load(key); !ok {
-			log.Info("Health check over.")
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		// TODO: check CronHPA controller service
-		if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, deployCronHPAName, metav1.GetOptions{}); err != nil {
-			cronHPA = cronHPA.DeepCopy()
-			cronHPA.Status.Phase = v1.AddonPhaseFailed
-			cronHPA.Status.Reason = "CronHPA is not healthy."
-			if err = c.persistUpdate(ctx, cronHPA); err != nil {
-				return false, err
-			}
-			return true, nil
-		}
-		return false, nil
-	}
-}
-
-func (c *Controller) checkCronHPAStatus(ctx context.Context, cronHPA *v1.CronHPA, key string, initDelay time.Time) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start to check CronHPA health", log.String("CronHPA", cronHPA.Name))
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, cronHPA.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.checking.Load(key); !ok {
-			log.Debug("Checking over CronHPA addon status")
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		cronHPA, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-		if deploy, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, deployCronHPAName, metav1.GetOptions{}); err != nil ||
-			(deploy.Spec.Replicas != nil && deploy.Status.AvailableReplicas < *deploy.Spec.Replicas) {
-			if time.Now().After(initDelay) {
-				cronHPA = cronHPA.DeepCopy()
-				cronHPA.Status.Phase = v1.AddonPhaseFailed
-				cronHPA.Status.Reason = "CronHPA is not healthy."
-				if err = c.persistUpdate(ctx, cronHPA); err != nil {
-					return false, err
-				}
-				return true, nil
-			}
-			return false, nil
-		}
-		cronHPA = cronHPA.DeepCopy()
-		cronHPA.Status.Phase = v1.AddonPhaseRunning
-		cronHPA.Status.Reason = ""
-		if err = c.persistUpdate(ctx, cronHPA); err != nil {
-			return false, err
-		}
-		return true, nil
-	}
-}
-
-func (c *Controller) upgradeCronHPA(ctx context.Context, cronHPA *v1.CronHPA, key string, initDelay time.Time) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start to upgrade CronHPA", log.String("CronHPA", cronHPA.Name))
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, cronHPA.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.upgrading.Load(key); !ok {
-			log.Debug("Upgrading CronHPA", log.String("CronHPA", cronHPA.Name))
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		cronHPA, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-		newImage := images.Get(cronHPA.Spec.Version).CronHPA.FullName()
-
-		patch := fmt.Sprintf(`[{"op":"replace","path":"/spec/template/spec/containers/0/image","value":"%s"}]`, newImage)
-		if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Patch(ctx, deployCronHPAName, types.JSONPatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
-			if time.Now().After(initDelay) {
-				cronHPA = cronHPA.DeepCopy()
-				cronHPA.Status.Phase = v1.AddonPhaseFailed
-				cronHPA.Status.Reason = "Failed to upgrade CronHPA."
-				if err = c.persistUpdate(ctx, cronHPA); err != nil {
-					return false, err
-				}
-				return true, nil
-			}
-			return false, nil
-		}
-		cronHPA = cronHPA.DeepCopy()
-		cronHPA.Status.Version = cronHPA.Spec.Version
-		cronHPA.Status.Phase = v1.AddonPhaseChecking
-		cronHPA.Status.Reason = ""
-		if err = c.persistUpdate(ctx, cronHPA); err != nil {
-			return false, err
-		}
-		return true, nil
-	}
-}
-
-func (c *Controller) persistUpdate(ctx context.Context, cronHPA *v1.CronHPA) error {
-	var err error
-	for i := 0; i < clientRetryCount; i++ {
-		_, err = c.client.PlatformV1().CronHPAs().UpdateStatus(ctx, cronHPA, metav1.UpdateOptions{})
-		if err == nil {
-			return nil
-		}
-		// If the object no longer exists, we don't want to recreate it. Just bail
-		// out so that we can process the delete, which we should soon be receiving
-		// if we haven't already.
-		if errors.IsNotFound(err) {
-			log.Info("Not persisting update to cronHPA that no longer exists", log.String("clusterName", cronHPA.Spec.ClusterName), log.Err(err))
-			return nil
-		}
-		if errors.IsConflict(err) {
-			return fmt.Errorf("not persisting update to CronHPA '%s' that has been changed since we received it: %v", cronHPA.Spec.ClusterName, err)
-		}
-		log.Warn(fmt.Sprintf("Failed to persist updated status of CronHPA '%s/%s'", cronHPA.Spec.ClusterName, cronHPA.Status.Phase), log.String("clusterName", cronHPA.Spec.ClusterName), log.Err(err))
-		time.Sleep(clientRetryInterval)
-	}
-
-	return err
-}
@@ -0,0 +1,47 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cronhpa
+
+import (
+	"testing"
+
+	"tkestack.io/tke/pkg/platform/controller/addon/cronhpa/images"
+)
+
+func TestDeploymentCronHPATimeZone(t *testing.T) {
+	deploy := deploymentCronHPA(images.LatestVersion, "")
+	spec := deploy.Spec.Template.Spec
+	if len(spec.Containers[0].Env) != 0 || len(spec.Volumes) != 0 {
+		t.Errorf("deploymentCronHPA() without time zone = %+v, want the node time zone", spec)
+	}
+
+	deploy = deploymentCronHPA(images.LatestVersion, "Asia/Shanghai")
+	spec = deploy.Spec.Template.Spec
+	container := spec.Containers[0]
+	if len(container.Env) != 1 || container.Env[0].Name != "TZ" || container.Env[0].Value != "Asia/Shanghai" {
+		t.Errorf("env = %+v, want TZ=Asia/Shanghai", container.Env)
+	}
+	if len(container.VolumeMounts) != 1 || container.VolumeMounts[0].MountPath != zoneInfoPath || !container.VolumeMounts[0].ReadOnly {
+		t.Errorf("volumeMounts = %+v, want %s mounted read only", container.VolumeMounts, zoneInfoPath)
+	}
+	if len(spec.Volumes) != 1 || spec.Volumes[0].Name != container.VolumeMounts[0].Name ||
+		spec.Volumes[0].HostPath == nil || spec.Volumes[0].HostPath.Path != zoneInfoPath {
+		t.Errorf("volumes = %+v, want the zoneinfo of node", spec.Volumes)
+	}
+}
@@ -0,0 +1,123 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dependency
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// addon finds and creates the objects of an addon type in cluster.
+type addon struct {
+	// phase returns the phase of the addon in cluster, false if not installed.
+	phase func(ctx context.Context, client clientset.Interface, clusterName string) (v1.AddonPhase, bool, error)
+	// create installs the addon in cluster with the default version, nil if
+	// the addon must be installed by hand.
+	create func(ctx context.Context, client clientset.Interface, cluster *v1.Cluster) error
+}
+
+var addons = map[string]addon{
+	"CSIOperator": {
+		phase:  csiOperatorPhase,
+		create: createCSIOperator,
+	},
+	"VolumeDecorator": {
+		phase: volumeDecoratorPhase,
+	},
+}
+
+// Ensure creates the prerequisites of the addon type which are missing in
+// cluster in order, and returns an error until all of them are running.
+func Ensure(ctx context.Context, client clientset.Interface, cluster *v1.Cluster, addonType string) error {
+	deps, err := Prerequisites(addonType)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, dep := range deps {
+		a, ok := addons[dep]
+		if !ok {
+			return fmt.Errorf("unknown addon %s required by %s", dep, addonType)
+		}
+		phase, found, err := a.phase(ctx, client, cluster.Name)
+		if err != nil {
+			return err
+		}
+		if !found {
+			if a.create == nil {
+				return fmt.Errorf("addon %s required by %s must be installed in cluster %s", dep, addonType, cluster.Name)
+			}
+			if err := a.create(ctx, client, cluster); err != nil {
+				return fmt.Errorf("failed to create addon %s required by %s: %v", dep, addonType, err)
+			}
+			log.Info("Create the addon required", log.String("addon", dep), log.String("dependent", addonType), log.String("clusterName", cluster.Name))
+			pending = append(pending, dep)
+			continue
+		}
+		// the later prerequisites may depend on the pending ones
+		if phase != v1.AddonPhaseRunning {
+			pending = append(pending, dep)
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("waiting for the addons %s required by %s", strings.Join(pending, ", "), addonType)
+	}
+	return nil
+}
+
+func clusterSelector(clusterName string) metav1.ListOptions {
+	return metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", clusterName).String(),
+	}
+}
+
+func csiOperatorPhase(ctx context.Context, client clientset.Interface, clusterName string) (v1.AddonPhase, bool, error) {
+	l, err := client.PlatformV1().CSIOperators().List(ctx, clusterSelector(clusterName))
+	if err != nil || len(l.Items) == 0 {
+		return "", false, err
+	}
+	return l.Items[0].Status.Phase, true, nil
+}
+
+func createCSIOperator(ctx context.Context, client clientset.Interface, cluster *v1.Cluster) error {
+	// the version is defaulted by the compatibility of cluster
+	_, err := client.PlatformV1().CSIOperators().Create(ctx, &v1.CSIOperator{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "co-"},
+		Spec: v1.CSIOperatorSpec{
+			TenantID:    cluster.Spec.TenantID,
+			ClusterName: cluster.Name,
+		},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+func volumeDecoratorPhase(ctx context.Context, client clientset.Interface, clusterName string) (v1.AddonPhase, bool, error) {
+	l, err := client.PlatformV1().VolumeDecorators().List(ctx, clusterSelector(clusterName))
+	if err != nil || len(l.Items) == 0 {
+		return "", false, err
+	}
+	return l.Items[0].Status.Phase, true, nil
+}
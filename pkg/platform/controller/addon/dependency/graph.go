@@ -0,0 +1,97 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package dependency resolves the addons required by an addon, which are
+// installed in the same cluster before it.
+package dependency
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Graph maps an addon type to the addon types required by it directly.
+var Graph = map[string][]string{
+	// the volume decorator maintains the volumes provisioned by CSI
+	"VolumeDecorator": {"CSIOperator"},
+}
+
+const (
+	visiting = iota + 1
+	visited
+)
+
+// Prerequisites returns the addon types required by the addon type directly
+// or indirectly, each one is ordered after its own prerequisites.
+func Prerequisites(addonType string) ([]string, error) {
+	return prerequisites(Graph, addonType)
+}
+
+func prerequisites(graph map[string][]string, addonType string) ([]string, error) {
+	var order []string
+	states := make(map[string]int)
+	var visit func(t string, path []string) error
+	visit = func(t string, path []string) error {
+		path = append(path[:len(path):len(path)], t)
+		switch states[t] {
+		case visiting:
+			return fmt.Errorf("addon dependency cycle: %s", strings.Join(path, " -> "))
+		case visited:
+			return nil
+		}
+		states[t] = visiting
+		for _, dep := range graph[t] {
+			if err := visit(dep, path); err != nil {
+				return err
+			}
+		}
+		states[t] = visited
+		order = append(order, t)
+		return nil
+	}
+	if err := visit(addonType, nil); err != nil {
+		return nil, err
+	}
+	// the addon type itself is the last one
+	return order[:len(order)-1], nil
+}
+
+// Dependents returns the sorted addon types which require the addon type
+// directly or indirectly.
+func Dependents(addonType string) []string {
+	return dependents(Graph, addonType)
+}
+
+func dependents(graph map[string][]string, addonType string) []string {
+	result := sets.NewString()
+	queue := []string{addonType}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for dependent, deps := range graph {
+			if result.Has(dependent) || !sets.NewString(deps...).Has(current) {
+				continue
+			}
+			result.Insert(dependent)
+			queue = append(queue, dependent)
+		}
+	}
+	return result.List()
+}
@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dependency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrerequisites(t *testing.T) {
+	graph := map[string][]string{
+		"A": {"B", "C"},
+		"B": {"D"},
+		"C": {"D"},
+	}
+	order, err := prerequisites(graph, "A")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"D", "B", "C"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("got %v, want %v", order, want)
+	}
+	if order, err := prerequisites(graph, "D"); err != nil || len(order) != 0 {
+		t.Errorf("got %v %v, want no prerequisites", order, err)
+	}
+
+	graph["D"] = []string{"A"}
+	if _, err := prerequisites(graph, "A"); err == nil {
+		t.Error("expected the cycle to be rejected")
+	}
+}
+
+func TestDependents(t *testing.T) {
+	graph := map[string][]string{
+		"A": {"B"},
+		"B": {"C"},
+		"D": {"C"},
+	}
+	if got, want := dependents(graph, "C"), []string{"A", "B", "D"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := dependents(graph, "A"); len(got) != 0 {
+		t.Errorf("got %v, want no dependents", got)
+	}
+}
+
+func TestGraph(t *testing.T) {
+	for addonType := range Graph {
+		deps, err := Prerequisites(addonType)
+		if err != nil {
+			t.Error(err)
+		}
+		for _, dep := range append(deps, addonType) {
+			if _, ok := addons[dep]; !ok {
+				t.Errorf("addon %s in graph can not be found", dep)
+			}
+			if _, ok := installed[dep]; !ok {
+				t.Errorf("addon %s in graph can not be found by registry", dep)
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package dependency
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+)
+
+// installed returns whether an addon type is installed in cluster, used by
+// the registry of platform api.
+var installed = map[string]func(ctx context.Context, platformClient platforminternalclient.PlatformInterface, options metav1.ListOptions) (bool, error){
+	"CSIOperator": func(ctx context.Context, platformClient platforminternalclient.PlatformInterface, options metav1.ListOptions) (bool, error) {
+		l, err := platformClient.CSIOperators().List(ctx, options)
+		return err == nil && len(l.Items) > 0, err
+	},
+	"VolumeDecorator": func(ctx context.Context, platformClient platforminternalclient.PlatformInterface, options metav1.ListOptions) (bool, error) {
+		l, err := platformClient.VolumeDecorators().List(ctx, options)
+		return err == nil && len(l.Items) > 0, err
+	},
+}
+
+// InstalledDependents returns the addon types installed in cluster which
+// require the addon type, they stop working if the addon type is deleted.
+func InstalledDependents(ctx context.Context, platformClient platforminternalclient.PlatformInterface, clusterName string, addonType string) ([]string, error) {
+	options := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", clusterName).String(),
+	}
+	var result []string
+	for _, dependent := range Dependents(addonType) {
+		find, ok := installed[dependent]
+		if !ok {
+			continue
+		}
+		found, err := find(ctx, platformClient, options)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			result = append(result, dependent)
+		}
+	}
+	return result, nil
+}
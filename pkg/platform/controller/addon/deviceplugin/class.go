@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"path"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	"tkestack.io/tke/pkg/util/containerregistry"
+)
+
+const (
+	// deviceLabelPrefix labels the nodes advertising the devices of a class,
+	// such as device.platform.tkestack.io/rdma=true.
+	deviceLabelPrefix = "device.platform.tkestack.io/"
+
+	kubeletDevicePluginPath = "/var/lib/kubelet/device-plugins"
+)
+
+// classProfile is the default settings of a built-in device class.
+type classProfile struct {
+	resourcePrefix string
+	configPath     string
+	args           []string
+	hostPaths      []string
+}
+
+var classProfiles = map[string]classProfile{
+	images.ClassRDMA: {
+		resourcePrefix: "rdma/",
+		configPath:     "/k8s-rdma-shared-dev-plugin/config.json",
+		hostPaths:      []string{"/dev"},
+	},
+	images.ClassFPGA: {
+		resourcePrefix: "fpga.intel.com/",
+		args:           []string{"-mode", "af"},
+		hostPaths:      []string{"/dev", "/sys"},
+	},
+	images.ClassQAT: {
+		resourcePrefix: "qat.intel.com/",
+		args:           []string{"-dpdk-driver", "vfio-pci", "-kernel-vf-drivers", "c6xxvf,4xxxvf", "-max-num-devices", "10"},
+		hostPaths:      []string{"/dev/vfio", "/sys/bus/pci", "/sys/devices", "/lib/modules"},
+	},
+}
+
+// pluginConfig is the spec of DevicePlugin merged with the defaults of its
+// class, which is what deployed to the cluster.
+type pluginConfig struct {
+	// image is pulled by the nodes, which is the mirror of sourceImage if
+	// the image is mirrored.
+	image string
+	// sourceImage is copied to image by the mirror job, empty if the image is
+	// not mirrored.
+	sourceImage    string
+	resourcePrefix string
+	configPath     string
+	args           []string
+	hostPaths      []string
+}
+
+// resolvePluginConfig merges the spec with the defaults of the built-in class.
+func resolvePluginConfig(plugin *v1.DevicePlugin) pluginConfig {
+	profile := classProfiles[plugin.Spec.DeviceClass]
+	config := pluginConfig{
+		resourcePrefix: profile.resourcePrefix,
+		configPath:     profile.configPath,
+		args:           profile.args,
+	}
+	if plugin.Spec.ResourcePrefix != "" {
+		config.resourcePrefix = plugin.Spec.ResourcePrefix
+	}
+	if plugin.Spec.ConfigPath != "" {
+		config.configPath = plugin.Spec.ConfigPath
+	}
+	if len(plugin.Spec.Args) > 0 {
+		config.args = plugin.Spec.Args
+	}
+	paths := map[string]bool{kubeletDevicePluginPath: true}
+	for _, p := range append(append([]string{}, profile.hostPaths...), plugin.Spec.HostPaths...) {
+		p = path.Clean(p)
+		if !paths[p] {
+			paths[p] = true
+			config.hostPaths = append(config.hostPaths, p)
+		}
+	}
+
+	image := plugin.Spec.Image
+	if image == "" {
+		if defaultImage, ok := images.Get(plugin.Spec.Version).DevicePlugin(plugin.Spec.DeviceClass); ok {
+			image = defaultImage.FullName()
+		}
+	} else if !hasRegistryDomain(image) {
+		image = containerregistry.GetImagePrefix(image)
+	}
+	config.image = image
+	if plugin.Spec.MirrorImage && !strings.HasPrefix(image, containerregistry.GetPrefix()+"/") {
+		config.sourceImage = image
+		config.image = mirrorImage(image)
+	}
+	return config
+}
+
+// hasRegistryDomain returns whether the first component of image is a
+// registry, following the rule of docker reference.
+func hasRegistryDomain(image string) bool {
+	i := strings.IndexRune(image, '/')
+	if i < 0 {
+		return false
+	}
+	domain := image[:i]
+	return strings.ContainsAny(domain, ".:") || domain == "localhost"
+}
+
+// mirrorImage returns the image in the registry of platform which image is
+// copied to, the repository path is flattened to its last component.
+func mirrorImage(image string) string {
+	return containerregistry.GetImagePrefix(path.Base(image))
+}
+
+// nodeHasDevices returns whether the node advertises any extended resource
+// with the prefix.
+func nodeHasDevices(node *corev1.Node, resourcePrefix string) bool {
+	for name, quantity := range node.Status.Capacity {
+		if strings.HasPrefix(string(name), resourcePrefix) && !quantity.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// devicePluginNodes returns the sorted names of nodes advertising the devices.
+func devicePluginNodes(nodes []corev1.Node, resourcePrefix string) []string {
+	var names []string
+	for i := range nodes {
+		if nodeHasDevices(&nodes[i], resourcePrefix) {
+			names = append(names, nodes[i].Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func deviceLabel(class string) string {
+	return deviceLabelPrefix + class
+}
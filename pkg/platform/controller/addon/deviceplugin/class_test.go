@@ -0,0 +1,114 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	"tkestack.io/tke/pkg/util/containerregistry"
+)
+
+func TestResolvePluginConfig(t *testing.T) {
+	containerregistry.Init("default.registry.tke.com", "tkestack")
+	cases := []struct {
+		name string
+		spec v1.DevicePluginSpec
+		want pluginConfig
+	}{
+		{
+			name: "builtin",
+			spec: v1.DevicePluginSpec{DeviceClass: images.ClassQAT, HostPaths: []string{"/dev/vfio/", "/opt/qat"}},
+			want: pluginConfig{
+				image:          "default.registry.tke.com/tkestack/intel-qat-plugin:0.20.0",
+				resourcePrefix: "qat.intel.com/",
+				args:           classProfiles[images.ClassQAT].args,
+				hostPaths:      []string{"/dev/vfio", "/sys/bus/pci", "/sys/devices", "/lib/modules", "/opt/qat"},
+			},
+		},
+		{
+			name: "custom image in platform registry",
+			spec: v1.DevicePluginSpec{DeviceClass: "vpu", Image: "vpu-plugin:v1", ResourcePrefix: "vpu.example.com/", MirrorImage: true},
+			want: pluginConfig{
+				image:          "default.registry.tke.com/tkestack/vpu-plugin:v1",
+				resourcePrefix: "vpu.example.com/",
+			},
+		},
+		{
+			name: "mirrored",
+			spec: v1.DevicePluginSpec{
+				DeviceClass: images.ClassRDMA, Image: "ghcr.io/mellanox/k8s-rdma-shared-dev-plugin:v1.3.0", MirrorImage: true,
+				Config: "{}", Args: []string{"-v"},
+			},
+			want: pluginConfig{
+				image:          "default.registry.tke.com/tkestack/k8s-rdma-shared-dev-plugin:v1.3.0",
+				sourceImage:    "ghcr.io/mellanox/k8s-rdma-shared-dev-plugin:v1.3.0",
+				resourcePrefix: "rdma/",
+				configPath:     "/k8s-rdma-shared-dev-plugin/config.json",
+				args:           []string{"-v"},
+				hostPaths:      []string{"/dev"},
+			},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.spec.Version = images.LatestVersion
+			got := resolvePluginConfig(&v1.DevicePlugin{Spec: c.spec})
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("resolvePluginConfig() = %+v, want %+v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestHasRegistryDomain(t *testing.T) {
+	cases := map[string]bool{
+		"intel-qat-plugin:0.20.0":          false,
+		"intel/intel-qat-plugin:0.20.0":    false,
+		"docker.io/intel/intel-qat-plugin": true,
+		"localhost/plugin":                 true,
+		"10.0.0.1:5000/plugin":             true,
+	}
+	for image, want := range cases {
+		if got := hasRegistryDomain(image); got != want {
+			t.Errorf("hasRegistryDomain(%q) = %v, want %v", image, got, want)
+		}
+	}
+}
+
+func TestDevicePluginNodes(t *testing.T) {
+	node := func(name string, resources corev1.ResourceList) corev1.Node {
+		return corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}, Status: corev1.NodeStatus{Capacity: resources}}
+	}
+	nodes := []corev1.Node{
+		node("node-c", corev1.ResourceList{"qat.intel.com/generic": resource.MustParse("4")}),
+		node("node-b", corev1.ResourceList{"qat.intel.com/generic": resource.MustParse("0")}),
+		node("node-a", corev1.ResourceList{"qat.intel.com/cy": resource.MustParse("2"), corev1.ResourceCPU: resource.MustParse("8")}),
+		node("node-d", corev1.ResourceList{"rdma/hca": resource.MustParse("1")}),
+	}
+	want := []string{"node-a", "node-c"}
+	if got := devicePluginNodes(nodes, "qat.intel.com/"); !reflect.DeepEqual(got, want) {
+		t.Errorf("devicePluginNodes() = %v, want %v", got, want)
+	}
+}
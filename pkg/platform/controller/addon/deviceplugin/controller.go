@@ -0,0 +1,381 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	controllerName = "deviceplugin-controller"
+	appName        = "tke-device-plugin"
+
+	deviceClassLabel  = "platform.tkestack.io/device-class"
+	devicePluginLabel = "platform.tkestack.io/device-plugin"
+)
+
+// NewController creates a new controller which drives the DevicePlugins
+// through the addon lifecycle.
+func NewController(client clientset.Interface, informer platformv1informer.DevicePluginInformer, resyncPeriod time.Duration) *lifecycle.Controller {
+	addon := &devicePluginAddon{
+		client: client,
+		lister: informer.Lister(),
+	}
+	return lifecycle.NewController(controllerName, client, informer.Informer(), addon, resyncPeriod)
+}
+
+// devicePluginObject adapts the DevicePlugin to the addon lifecycle.
+type devicePluginObject struct {
+	*v1.DevicePlugin
+}
+
+func (o devicePluginObject) ClusterName() string {
+	return o.Spec.ClusterName
+}
+
+func (o devicePluginObject) Version() string {
+	return o.Spec.Version
+}
+
+func (o devicePluginObject) DriftPolicy() v1.AddonDriftPolicy {
+	return v1.AddonDriftRepair
+}
+
+func (o devicePluginObject) GetStatus() lifecycle.Status {
+	return lifecycle.Status{
+		Version:                     o.Status.Version,
+		Phase:                       o.Status.Phase,
+		Reason:                      o.Status.Reason,
+		RetryCount:                  o.Status.RetryCount,
+		LastReInitializingTimestamp: o.Status.LastReInitializingTimestamp,
+		Conditions:                  o.Status.Conditions,
+	}
+}
+
+func (o devicePluginObject) WithStatus(status lifecycle.Status) lifecycle.Object {
+	plugin := o.DeepCopy()
+	plugin.Status.Version = status.Version
+	plugin.Status.Phase = status.Phase
+	plugin.Status.Reason = status.Reason
+	plugin.Status.RetryCount = status.RetryCount
+	plugin.Status.LastReInitializingTimestamp = status.LastReInitializingTimestamp
+	plugin.Status.Conditions = status.Conditions
+	return devicePluginObject{plugin}
+}
+
+type devicePluginAddon struct {
+	client clientset.Interface
+	lister platformv1lister.DevicePluginLister
+}
+
+func (a *devicePluginAddon) Kind() string {
+	return "DevicePlugin"
+}
+
+func (a *devicePluginAddon) Get(name string) (lifecycle.Object, error) {
+	plugin, err := a.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return devicePluginObject{plugin}, nil
+}
+
+func (a *devicePluginAddon) UpdateStatus(ctx context.Context, obj lifecycle.Object) error {
+	_, err := a.client.PlatformV1().DevicePlugins().UpdateStatus(ctx, obj.(devicePluginObject).DevicePlugin, metav1.UpdateOptions{})
+	return err
+}
+
+// atVersion returns the DevicePlugin of obj rendered at version, the default
+// image of device class depends on the version.
+func atVersion(version string, obj lifecycle.Object) *v1.DevicePlugin {
+	plugin := obj.(devicePluginObject).DeepCopy()
+	plugin.Spec.Version = version
+	return plugin
+}
+
+func (a *devicePluginAddon) Bundle(version string, obj lifecycle.Object) (lifecycle.Bundle, error) {
+	if err := images.Validate(version); err != nil {
+		return nil, err
+	}
+	plugin := atVersion(version, obj)
+	config := resolvePluginConfig(plugin)
+	if config.image == "" {
+		return nil, fmt.Errorf("no image of device class %s", plugin.Spec.DeviceClass)
+	}
+	bundle := lifecycle.Bundle{}
+	if plugin.Spec.Config != "" {
+		bundle = append(bundle, configMapDevicePlugin(plugin))
+	}
+	return append(bundle, daemonSetDevicePlugin(plugin, config)), nil
+}
+
+// Prepare mirrors the image of version if required before the DaemonSet is
+// applied, the pods keep pulling the mirrored image until the mirror job
+// completes.
+func (a *devicePluginAddon) Prepare(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, version string, obj lifecycle.Object) error {
+	plugin := atVersion(version, obj)
+	config := resolvePluginConfig(plugin)
+	if config.sourceImage == "" {
+		return deleteMirror(ctx, kubeClient, plugin)
+	}
+	// the passwords of registries are kept in the credential of cluster
+	c, err := typesv1.GetCluster(ctx, a.client.PlatformV1(), cluster)
+	if err != nil {
+		return err
+	}
+	return ensureMirror(ctx, kubeClient, plugin, cluster, c.RegistryPasswords(), config)
+}
+
+// Configure returns an error until the image is mirrored, the failure of
+// mirror job is reported as the reason of unhealthy.
+func (a *devicePluginAddon) Configure(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) error {
+	plugin := atVersion(obj.GetStatus().Version, obj)
+	config := resolvePluginConfig(plugin)
+	if config.sourceImage == "" {
+		return nil
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, a.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	complete, err := isMirrorComplete(ctx, kubeClient, plugin)
+	if err != nil {
+		return err
+	}
+	if !complete {
+		return fmt.Errorf("mirror image %s is not complete", config.sourceImage)
+	}
+	return nil
+}
+
+// Observe labels the nodes advertising the devices, which may be plugged or
+// added since last check, and reports them with the image in status.
+func (a *devicePluginAddon) Observe(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, obj lifecycle.Object) (lifecycle.Object, error) {
+	plugin := atVersion(obj.GetStatus().Version, obj)
+	nodes, err := syncNodeLabels(ctx, kubeClient, plugin, false)
+	if err != nil {
+		return obj, err
+	}
+	observed := obj.(devicePluginObject).DeepCopy()
+	observed.Status.Image = resolvePluginConfig(plugin).image
+	observed.Status.Nodes = nodes
+	return devicePluginObject{observed}, nil
+}
+
+// Cleanup deletes the mirror job with its secret and the labels of nodes,
+// which are not in the bundle.
+func (a *devicePluginAddon) Cleanup(ctx context.Context, kubeClient kubernetes.Interface, obj lifecycle.Object) error {
+	plugin := obj.(devicePluginObject).DevicePlugin
+	if err := deleteMirror(ctx, kubeClient, plugin); err != nil {
+		return err
+	}
+	_, err := syncNodeLabels(ctx, kubeClient, plugin, true)
+	return err
+}
+
+// resourceName is the name of the DaemonSet, ConfigMap and mirror job of
+// DevicePlugin, there may be multiple DevicePlugins of the same class with
+// different node selectors.
+func resourceName(plugin *v1.DevicePlugin) string {
+	return "tke-" + plugin.Name
+}
+
+func labels(plugin *v1.DevicePlugin) map[string]string {
+	return map[string]string{
+		"app":             appName,
+		deviceClassLabel:  plugin.Spec.DeviceClass,
+		devicePluginLabel: plugin.Name,
+	}
+}
+
+func configMapDevicePlugin(plugin *v1.DevicePlugin) *corev1.ConfigMap {
+	config := resolvePluginConfig(plugin)
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceName(plugin),
+			Labels:    labels(plugin),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			path.Base(config.configPath): plugin.Spec.Config,
+		},
+	}
+}
+
+func hostPathVolume(name string, path string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: path},
+		},
+	}
+}
+
+// daemonSetDevicePlugin runs the privileged device plugin on the selected
+// nodes, which registers to kubelet through the socket in the device plugin
+// directory of kubelet.
+func daemonSetDevicePlugin(plugin *v1.DevicePlugin, config pluginConfig) *appsv1.DaemonSet {
+	ls := labels(plugin)
+	selector := map[string]string{"kubernetes.io/os": "linux"}
+	for k, v := range plugin.Spec.NodeSelector {
+		selector[k] = v
+	}
+	container := corev1.Container{
+		Name:  "device-plugin",
+		Image: config.image,
+		Args:  config.args,
+		SecurityContext: &corev1.SecurityContext{
+			Privileged: boolPtr(true),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    *resource.NewMilliQuantity(50, resource.DecimalSI),
+				corev1.ResourceMemory: *resource.NewQuantity(50*1024*1024, resource.BinarySI),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "device-plugins", MountPath: kubeletDevicePluginPath},
+		},
+	}
+	volumes := []corev1.Volume{
+		hostPathVolume("device-plugins", kubeletDevicePluginPath),
+	}
+	for i, hostPath := range config.hostPaths {
+		name := fmt.Sprintf("host-%d", i)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{Name: name, MountPath: hostPath})
+		volumes = append(volumes, hostPathVolume(name, hostPath))
+	}
+	if plugin.Spec.Config != "" {
+		key := path.Base(config.configPath)
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      "config",
+			MountPath: config.configPath,
+			SubPath:   key,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "config",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: resourceName(plugin)},
+				},
+			},
+		})
+	}
+
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resourceName(plugin),
+			Labels:    ls,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: ls,
+			},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: ls,
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName: "system-node-critical",
+					NodeSelector:      selector,
+					Tolerations: []corev1.Toleration{
+						{Operator: corev1.TolerationOpExists},
+					},
+					Containers: []corev1.Container{container},
+					Volumes:    volumes,
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+// syncNodeLabels labels the nodes advertising the devices of the plugin and
+// removes the label from the others, the label is removed from all nodes if
+// remove is true.
+func syncNodeLabels(ctx context.Context, kubeClient kubernetes.Interface, plugin *v1.DevicePlugin, remove bool) ([]string, error) {
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	config := resolvePluginConfig(plugin)
+	label := deviceLabel(plugin.Spec.DeviceClass)
+	var names []string
+	if !remove {
+		names = devicePluginNodes(nodes.Items, config.resourcePrefix)
+	}
+	has := make(map[string]bool, len(names))
+	for _, name := range names {
+		has[name] = true
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		_, labeled := node.Labels[label]
+		if has[node.Name] == labeled {
+			continue
+		}
+		node = node.DeepCopy()
+		if has[node.Name] {
+			if node.Labels == nil {
+				node.Labels = make(map[string]string)
+			}
+			node.Labels[label] = "true"
+		} else {
+			delete(node.Labels, label)
+		}
+		log.Info("Label the devices of node", log.String("node", node.Name), log.String("deviceClass", plugin.Spec.DeviceClass), log.Bool("labeled", has[node.Name]))
+		if _, err := kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,119 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package images
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"tkestack.io/tke/pkg/util/containerregistry"
+)
+
+const (
+	// LatestVersion is latest version of addon.
+	LatestVersion = "v1.0.0"
+)
+
+const (
+	// ClassRDMA shares the RDMA devices of Mellanox NICs.
+	ClassRDMA = "rdma"
+	// ClassFPGA advertises the Intel FPGA accelerator functions.
+	ClassFPGA = "fpga"
+	// ClassQAT advertises the Intel QuickAssist virtual functions.
+	ClassQAT = "qat"
+)
+
+// BuiltinClasses are the device classes which have default images.
+var BuiltinClasses = []string{ClassRDMA, ClassFPGA, ClassQAT}
+
+type Components struct {
+	RDMADevicePlugin containerregistry.Image
+	FPGADevicePlugin containerregistry.Image
+	QATDevicePlugin  containerregistry.Image
+	// Skopeo copies the images of device plugins to the registry of platform.
+	Skopeo containerregistry.Image
+}
+
+func (c Components) Get(name string) *containerregistry.Image {
+	v := reflect.ValueOf(c)
+	for i := 0; i < v.NumField(); i++ {
+		v, _ := v.Field(i).Interface().(containerregistry.Image)
+		if v.Name == name {
+			return &v
+		}
+	}
+	return nil
+}
+
+// DevicePlugin returns the default image of the built-in device class.
+func (c Components) DevicePlugin(class string) (containerregistry.Image, bool) {
+	switch class {
+	case ClassRDMA:
+		return c.RDMADevicePlugin, true
+	case ClassFPGA:
+		return c.FPGADevicePlugin, true
+	case ClassQAT:
+		return c.QATDevicePlugin, true
+	}
+	return containerregistry.Image{}, false
+}
+
+var versionMap = map[string]Components{
+	LatestVersion: {
+		RDMADevicePlugin: containerregistry.Image{Name: "k8s-rdma-shared-dev-plugin", Tag: "v1.2.1"},
+		FPGADevicePlugin: containerregistry.Image{Name: "intel-fpga-plugin", Tag: "0.20.0"},
+		QATDevicePlugin:  containerregistry.Image{Name: "intel-qat-plugin", Tag: "0.20.0"},
+		Skopeo:           containerregistry.Image{Name: "skopeo", Tag: "v1.2.2"},
+	},
+}
+
+func List() []string {
+	items := make([]string, 0, len(versionMap))
+	keys := make([]string, 0, len(versionMap))
+	for key := range versionMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		v := reflect.ValueOf(versionMap[key])
+		for i := 0; i < v.NumField(); i++ {
+			v, _ := v.Field(i).Interface().(containerregistry.Image)
+			items = append(items, v.BaseName())
+		}
+	}
+
+	return items
+}
+
+func Validate(version string) error {
+	_, ok := versionMap[version]
+	if !ok {
+		return fmt.Errorf("the component version definition corresponding to version %s could not be found", version)
+	}
+	return nil
+}
+
+func Get(version string) Components {
+	cv, ok := versionMap[version]
+	if !ok {
+		panic(fmt.Sprintf("the component version definition corresponding to version %s could not be found", version))
+	}
+	return cv
+}
@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	mirrorAuthFile = "auth.json"
+	mirrorAuthPath = "/etc/skopeo"
+
+	// the annotations of mirror job record the images copied, the job is
+	// recreated once they changed since the template of job is immutable.
+	sourceImageAnnotation = "platform.tkestack.io/source-image"
+	imageAnnotation       = "platform.tkestack.io/image"
+
+	mirrorBackoffLimit = 3
+)
+
+// registryAuths is the auth file of skopeo, which is the same as the config
+// file of docker.
+type registryAuths struct {
+	Auths map[string]registryAuth `json:"auths"`
+}
+
+type registryAuth struct {
+	Auth string `json:"auth"`
+}
+
+func mirrorName(plugin *v1.DevicePlugin) string {
+	return resourceName(plugin) + "-mirror"
+}
+
+// mirrorAuthConfig renders the auth file from the registry credentials of
+// cluster, which are used to pull the source image and push to the registry
+// of platform. The passwords are kept in the credential of cluster.
+func mirrorAuthConfig(cluster *v1.Cluster, passwords map[string][]byte) ([]byte, error) {
+	auths := registryAuths{Auths: make(map[string]registryAuth)}
+	if cluster.Spec.ContainerRegistries != nil {
+		for _, auth := range cluster.Spec.ContainerRegistries.Auths {
+			password, ok := passwords[auth.Registry]
+			if !ok {
+				return nil, fmt.Errorf("password of registry %s is not found in cluster credential", auth.Registry)
+			}
+			auths.Auths[auth.Registry] = registryAuth{
+				Auth: base64.StdEncoding.EncodeToString([]byte(auth.Username + ":" + string(password))),
+			}
+		}
+	}
+	return json.Marshal(auths)
+}
+
+// isInsecureRegistry returns whether the registry of image is configured as
+// insecure in cluster.
+func isInsecureRegistry(cluster *v1.Cluster, image string) bool {
+	if cluster.Spec.ContainerRegistries == nil {
+		return false
+	}
+	domain := strings.SplitN(image, "/", 2)[0]
+	for _, registry := range cluster.Spec.ContainerRegistries.InsecureRegistries {
+		if registry == domain {
+			return true
+		}
+	}
+	return false
+}
+
+func secretMirrorAuth(plugin *v1.DevicePlugin, cluster *v1.Cluster, passwords map[string][]byte) (*corev1.Secret, error) {
+	data, err := mirrorAuthConfig(cluster, passwords)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mirrorName(plugin),
+			Labels:    labels(plugin),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string][]byte{
+			mirrorAuthFile: data,
+		},
+	}, nil
+}
+
+// jobMirror copies the image of device plugin with all its architectures to
+// the registry of platform by skopeo.
+func jobMirror(plugin *v1.DevicePlugin, cluster *v1.Cluster, config pluginConfig) *batchv1.Job {
+	components := images.Get(plugin.Spec.Version)
+	args := []string{
+		"copy", "--all",
+		"--authfile", path.Join(mirrorAuthPath, mirrorAuthFile),
+	}
+	if isInsecureRegistry(cluster, config.sourceImage) {
+		args = append(args, "--src-tls-verify=false")
+	}
+	if isInsecureRegistry(cluster, config.image) {
+		args = append(args, "--dest-tls-verify=false")
+	}
+	args = append(args, "docker://"+config.sourceImage, "docker://"+config.image)
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      mirrorName(plugin),
+			Labels:    labels(plugin),
+			Namespace: metav1.NamespaceSystem,
+			Annotations: map[string]string{
+				sourceImageAnnotation: config.sourceImage,
+				imageAnnotation:       config.image,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: int32Ptr(mirrorBackoffLimit),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels(plugin),
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:  "skopeo",
+							Image: components.Skopeo.FullName(),
+							Args:  args,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "auth", MountPath: mirrorAuthPath, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "auth",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: mirrorName(plugin)},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ensureMirror runs the mirror job if the image is mirrored, the job of
+// previous images is replaced.
+func ensureMirror(ctx context.Context, kubeClient kubernetes.Interface, plugin *v1.DevicePlugin, cluster *v1.Cluster, passwords map[string][]byte, config pluginConfig) error {
+	if config.sourceImage == "" {
+		return deleteMirror(ctx, kubeClient, plugin)
+	}
+	secret, err := secretMirrorAuth(plugin, cluster, passwords)
+	if err != nil {
+		return err
+	}
+	if err := apiclient.CreateOrUpdateSecret(ctx, kubeClient, secret); err != nil {
+		return err
+	}
+	job := jobMirror(plugin, cluster, config)
+	existing, err := kubeClient.BatchV1().Jobs(metav1.NamespaceSystem).Get(ctx, job.Name, metav1.GetOptions{})
+	if err == nil {
+		if existing.Annotations[sourceImageAnnotation] == config.sourceImage && existing.Annotations[imageAnnotation] == config.image {
+			return nil
+		}
+		log.Info("Images of DevicePlugin changed, recreate the mirror job", log.String("DevicePlugin", plugin.Name), log.String("image", config.image))
+		if err := deleteJob(ctx, kubeClient, job.Name); err != nil {
+			return err
+		}
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+	// the previous job may be still terminating, which is retried later
+	_, err = kubeClient.BatchV1().Jobs(metav1.NamespaceSystem).Create(ctx, job, metav1.CreateOptions{})
+	return err
+}
+
+// isMirrorComplete returns whether the mirror job is complete, an error is
+// returned if the job failed.
+func isMirrorComplete(ctx context.Context, kubeClient kubernetes.Interface, plugin *v1.DevicePlugin) (bool, error) {
+	job, err := kubeClient.BatchV1().Jobs(metav1.NamespaceSystem).Get(ctx, mirrorName(plugin), metav1.GetOptions{})
+	if err != nil {
+		return false, err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return false, fmt.Errorf("mirror image %s failed: %s", job.Annotations[sourceImageAnnotation], condition.Message)
+		}
+	}
+	return false, nil
+}
+
+func deleteMirror(ctx context.Context, kubeClient kubernetes.Interface, plugin *v1.DevicePlugin) error {
+	if err := deleteJob(ctx, kubeClient, mirrorName(plugin)); err != nil {
+		return err
+	}
+	if err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, mirrorName(plugin), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func deleteJob(ctx context.Context, kubeClient kubernetes.Interface, name string) error {
+	propagation := metav1.DeletePropagationBackground
+	err := kubeClient.BatchV1().Jobs(metav1.NamespaceSystem).Delete(ctx, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func int32Ptr(i int32) *int32 { return &i }
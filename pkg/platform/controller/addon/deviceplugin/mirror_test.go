@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"encoding/json"
+	"testing"
+
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestMirrorAuthConfig(t *testing.T) {
+	cluster := &v1.Cluster{
+		Spec: v1.ClusterSpec{
+			ContainerRegistries: &v1.ContainerRegistryConfig{
+				Auths: []v1.RegistryAuth{{Registry: "registry.example.com", Username: "admin"}},
+			},
+		},
+	}
+
+	data, err := mirrorAuthConfig(cluster, map[string][]byte{"registry.example.com": []byte("secret")})
+	if err != nil {
+		t.Fatalf("mirrorAuthConfig() error = %v", err)
+	}
+	var auths registryAuths
+	if err := json.Unmarshal(data, &auths); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := auths.Auths["registry.example.com"].Auth, "YWRtaW46c2VjcmV0"; got != want {
+		t.Errorf("auth = %q, want %q", got, want)
+	}
+
+	if _, err := mirrorAuthConfig(cluster, nil); err == nil {
+		t.Error("mirrorAuthConfig() without password in credential should fail")
+	}
+}
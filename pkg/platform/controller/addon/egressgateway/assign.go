@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+// assignIPs assigns each egress IP to a gateway node of its pool. An IP stays
+// on its node until the node is not ready for longer than timeout, or the node
+// is cordoned, deleted or not selected by the pool anymore, then it moves to
+// the ready node holding the fewest IPs of the pool.
+func assignIPs(pools []v1.EgressIPPool, current []v1.EgressIPAssignment, nodes []corev1.Node, timeout time.Duration, now time.Time) []v1.EgressIPAssignment {
+	currentByIP := make(map[string]v1.EgressIPAssignment, len(current))
+	for _, a := range current {
+		currentByIP[a.IP] = a
+	}
+
+	var result []v1.EgressIPAssignment
+	for _, pool := range pools {
+		selector := labels.SelectorFromSet(pool.NodeSelector)
+		kept := make(map[string]bool)
+		var ready []string
+		for i := range nodes {
+			node := &nodes[i]
+			if !selector.Matches(labels.Set(node.Labels)) {
+				continue
+			}
+			if nodeReady(node) {
+				ready = append(ready, node.Name)
+			}
+			if nodeKept(node, timeout, now) {
+				kept[node.Name] = true
+			}
+		}
+		sort.Strings(ready)
+
+		load := make(map[string]int)
+		assignments := make([]v1.EgressIPAssignment, len(pool.IPs))
+		for i, ip := range pool.IPs {
+			assignments[i] = v1.EgressIPAssignment{Pool: pool.Name, IP: ip}
+			if cur, ok := currentByIP[ip]; ok && cur.Pool == pool.Name {
+				assignments[i] = cur
+			}
+			if kept[assignments[i].Node] {
+				load[assignments[i].Node]++
+			}
+		}
+		for i := range assignments {
+			a := &assignments[i]
+			if kept[a.Node] {
+				continue
+			}
+			node := leastLoaded(ready, load)
+			if node != "" {
+				load[node]++
+			}
+			if node != a.Node {
+				a.Node = node
+				a.LastTransitionTime = metav1.NewTime(now)
+			}
+		}
+		result = append(result, assignments...)
+	}
+	return result
+}
+
+// nodeReady returns whether new egress IPs can be assigned to the node.
+func nodeReady(node *corev1.Node) bool {
+	if node.DeletionTimestamp != nil || node.Spec.Unschedulable {
+		return false
+	}
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// nodeKept returns whether the node keeps its egress IPs, the IPs do not move
+// if the node is not ready for a short while.
+func nodeKept(node *corev1.Node, timeout time.Duration, now time.Time) bool {
+	if node.DeletionTimestamp != nil || node.Spec.Unschedulable {
+		return false
+	}
+	for _, c := range node.Status.Conditions {
+		if c.Type == corev1.NodeReady {
+			return c.Status == corev1.ConditionTrue || now.Sub(c.LastTransitionTime.Time) < timeout
+		}
+	}
+	return false
+}
+
+func leastLoaded(nodes []string, load map[string]int) string {
+	selected := ""
+	for _, node := range nodes {
+		if selected == "" || load[node] < load[selected] {
+			selected = node
+		}
+	}
+	return selected
+}
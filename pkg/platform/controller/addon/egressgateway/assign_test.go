@@ -0,0 +1,115 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func node(name string, ready corev1.ConditionStatus, since time.Time, labels map[string]string) corev1.Node {
+	return corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: ready, LastTransitionTime: metav1.NewTime(since)},
+			},
+		},
+	}
+}
+
+func nodesOf(assignments []v1.EgressIPAssignment) map[string]string {
+	result := make(map[string]string)
+	for _, a := range assignments {
+		result[a.IP] = a.Node
+	}
+	return result
+}
+
+func TestAssignIPs(t *testing.T) {
+	now := time.Now()
+	gateway := map[string]string{"egress": "true"}
+	pools := []v1.EgressIPPool{
+		{Name: "pool", IPs: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, NodeSelector: gateway},
+	}
+	timeout := 40 * time.Second
+
+	// the IPs are spread over the ready gateway nodes
+	nodes := []corev1.Node{
+		node("a", corev1.ConditionTrue, now, gateway),
+		node("b", corev1.ConditionTrue, now, gateway),
+		node("c", corev1.ConditionTrue, now, nil),
+	}
+	assignments := assignIPs(pools, nil, nodes, timeout, now.Add(-time.Hour))
+	got := nodesOf(assignments)
+	want := map[string]string{"10.0.0.1": "a", "10.0.0.2": "b", "10.0.0.3": "a"}
+	for ip, n := range want {
+		if got[ip] != n {
+			t.Errorf("initial: %s assigned to %q, want %q", ip, got[ip], n)
+		}
+	}
+
+	// the IPs stay on the node not ready within the timeout
+	nodes[0] = node("a", corev1.ConditionFalse, now.Add(-10*time.Second), gateway)
+	kept := assignIPs(pools, assignments, nodes, timeout, now)
+	if got := nodesOf(kept); got["10.0.0.1"] != "a" || got["10.0.0.3"] != "a" {
+		t.Errorf("within timeout: got %v, want IPs kept on a", got)
+	}
+
+	// the IPs move to the ready node after the timeout
+	nodes[0] = node("a", corev1.ConditionFalse, now.Add(-time.Minute), gateway)
+	moved := assignIPs(pools, assignments, nodes, timeout, now)
+	for _, a := range moved {
+		if a.Node != "b" {
+			t.Errorf("after timeout: %s assigned to %q, want b", a.IP, a.Node)
+		}
+	}
+	for i, a := range moved {
+		changed := assignments[i].Node != a.Node
+		if changed != a.LastTransitionTime.Equal(&metav1.Time{Time: now}) {
+			t.Errorf("after timeout: unexpected transition time of %s: %v", a.IP, a.LastTransitionTime)
+		}
+	}
+
+	// the IPs are unassigned if no gateway node is ready
+	nodes[1] = node("b", corev1.ConditionUnknown, now.Add(-time.Minute), gateway)
+	for _, a := range assignIPs(pools, moved, nodes, timeout, now) {
+		if a.Node != "" {
+			t.Errorf("no ready node: %s assigned to %q", a.IP, a.Node)
+		}
+	}
+}
+
+func TestAssignIPsCordoned(t *testing.T) {
+	now := time.Now()
+	pools := []v1.EgressIPPool{{Name: "pool", IPs: []string{"10.0.0.1"}}}
+	nodes := []corev1.Node{
+		node("a", corev1.ConditionTrue, now, nil),
+		node("b", corev1.ConditionTrue, now, nil),
+	}
+	current := []v1.EgressIPAssignment{{Pool: "pool", IP: "10.0.0.1", Node: "a"}}
+	nodes[0].Spec.Unschedulable = true
+	if got := nodesOf(assignIPs(pools, current, nodes, time.Minute, now)); got["10.0.0.1"] != "b" {
+		t.Errorf("cordoned: got %v, want the IP moved to b", got)
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"sync"
+
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+type cachedEgressGateway struct {
+	// The cached state of the EgressGateway
+	state *v1.EgressGateway
+}
+
+type egressGatewayCache struct {
+	mu               sync.Mutex // protects egressGatewayMap
+	egressGatewayMap map[string]*cachedEgressGateway
+}
+
+// ListKeys implements the interface required by DeltaFIFO to list the keys we
+// already know about.
+func (s *egressGatewayCache) ListKeys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.egressGatewayMap))
+	for k := range s.egressGatewayMap {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// GetByKey returns the value stored in the egressGatewayMap under the given key
+func (s *egressGatewayCache) GetByKey(key string) (interface{}, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.egressGatewayMap[key]; ok {
+		return v, true, nil
+	}
+	return nil, false, nil
+}
+
+func (s *egressGatewayCache) get(egressGatewayName string) (*cachedEgressGateway, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	egressGateway, ok := s.egressGatewayMap[egressGatewayName]
+	return egressGateway, ok
+}
+
+func (s *egressGatewayCache) Exist(egressGatewayName string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.egressGatewayMap[egressGatewayName]
+	return ok
+}
+
+func (s *egressGatewayCache) getOrCreate(egressGatewayName string) *cachedEgressGateway {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	egressGateway, ok := s.egressGatewayMap[egressGatewayName]
+	if !ok {
+		egressGateway = &cachedEgressGateway{}
+		s.egressGatewayMap[egressGatewayName] = egressGateway
+	}
+	return egressGateway
+}
+
+func (s *egressGatewayCache) set(egressGatewayName string, egressGateway *cachedEgressGateway) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.egressGatewayMap[egressGatewayName] = egressGateway
+}
+
+func (s *egressGatewayCache) delete(egressGatewayName string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.egressGatewayMap, egressGatewayName)
+}
@@ -0,0 +1,871 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"context"
+	"encoding/json"
+	normalerrors "errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"tkestack.io/tke/pkg/platform/controller/addon/egressgateway/images"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	clientRetryCount    = 5
+	clientRetryInterval = 5 * time.Second
+
+	maxRetryCount = 5
+	timeOut       = 5 * time.Minute
+)
+
+const (
+	controllerName              = "egress-gateway-controller"
+	egressGatewayName           = "egress-gateway"
+	cmEgressGatewayName         = "egress-gateway"
+	svcAccountEgressGatewayName = "egress-gateway"
+	crbEgressGatewayName        = "egress-gateway"
+
+	ipPoolsFileName     = "pools.json"
+	assignmentsFileName = "assignments.json"
+	configDir           = "/etc/egress-gateway"
+	// projectLabel is the label of namespaces which records the project they belong to.
+	projectLabel = "tkestack.io/projectName"
+
+	defaultFailoverTimeout = 40 * time.Second
+	failoverInterval       = 10 * time.Second
+)
+
+// Controller is responsible for performing actions dependent upon a EgressGateway phase.
+type Controller struct {
+	client       clientset.Interface
+	cache        *egressGatewayCache
+	health       sync.Map
+	checking     sync.Map
+	upgrading    sync.Map
+	failover     sync.Map
+	queue        workqueue.RateLimitingInterface
+	lister       platformv1lister.EgressGatewayLister
+	listerSynced cache.InformerSynced
+	stopCh       <-chan struct{}
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, informer platformv1informer.EgressGatewayInformer, resyncPeriod time.Duration) *Controller {
+	// create the controller so we can inject the enqueue function
+	controller := &Controller{
+		client: client,
+		cache:  &egressGatewayCache{egressGatewayMap: make(map[string]*cachedEgressGateway)},
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.PlatformV1().RESTClient().GetRateLimiter())
+	}
+
+	// configure the EgressGateway informer event handlers
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueueEgressGateway,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldEgressGateway, ok1 := oldObj.(*v1.EgressGateway)
+				curEgressGateway, ok2 := newObj.(*v1.EgressGateway)
+				if ok1 && ok2 && controller.needsUpdate(oldEgressGateway, curEgressGateway) {
+					controller.enqueueEgressGateway(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueueEgressGateway,
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.EgressGateway, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueueEgressGateway(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) needsUpdate(old *v1.EgressGateway, new *v1.EgressGateway) bool {
+	return !reflect.DeepEqual(old, new)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	// Start the informer factories to begin populating the informer caches
+	log.Info("Starting EgressGateway controller")
+	defer log.Info("Shutting down EgressGateway controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for cluster caches to sync")
+	}
+
+	c.stopCh = stopCh
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+// worker processes the queue of namespace objects.
+// Each namespace can be in the queue at most once.
+// The system ensures that no two workers can process
+// the same namespace at the same time.
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncEgressGateway(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing EgressGateway %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncEgressGateway will sync the EgressGateway with the given key if it has had
+// its expectations fulfilled, meaning it did not expect to see any more of its
+// namespaces created or deleted. This function is not meant to be invoked
+// concurrently with the same key.
+func (c *Controller) syncEgressGateway(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing EgressGateway", log.String("EgressGateway", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	// egressGateway holds the latest egressGateway info from apiserver
+	egressGateway, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info("EgressGateway has been deleted. Attempting to cleanup resources", log.String("EgressGateway", key))
+		err = c.processEgressGatewayDeletion(context.Background(), key)
+	case err != nil:
+		log.Warn("Unable to retrieve EgressGateway from store", log.String("EgressGateway", key), log.Err(err))
+	default:
+		cachedEgressGateway := c.cache.getOrCreate(key)
+		err = c.processEgressGatewayUpdate(context.Background(), cachedEgressGateway, egressGateway, key)
+	}
+	return err
+}
+
+func (c *Controller) processEgressGatewayDeletion(ctx context.Context, key string) error {
+	cachedEgressGateway, ok := c.cache.get(key)
+	if !ok {
+		log.Error("EgressGateway not in cache even though the watcher thought it was. Ignoring the deletion", log.String("EgressGateway", key))
+		return nil
+	}
+	return c.processEgressGatewayDelete(ctx, cachedEgressGateway, key)
+}
+
+func (c *Controller) processEgressGatewayDelete(ctx context.Context, cachedEgressGateway *cachedEgressGateway, key string) error {
+	log.Info("EgressGateway will be dropped", log.String("EgressGateway", key))
+
+	if c.cache.Exist(key) {
+		log.Info("Delete the EgressGateway cache", log.String("EgressGateway", key))
+		c.cache.delete(key)
+	}
+
+	if _, ok := c.health.Load(key); ok {
+		log.Info("Delete the EgressGateway health cache", log.String("EgressGateway", key))
+		c.health.Delete(key)
+	}
+	c.failover.Delete(key)
+
+	egressGateway := cachedEgressGateway.state
+	return c.uninstallEgressGateway(ctx, egressGateway)
+}
+
+func (c *Controller) processEgressGatewayUpdate(ctx context.Context, cachedEgressGateway *cachedEgressGateway, egressGateway *v1.EgressGateway, key string) error {
+	if cachedEgressGateway.state != nil {
+		// exist and the cluster name changed
+		if cachedEgressGateway.state.UID != egressGateway.UID {
+			if err := c.processEgressGatewayDelete(ctx, cachedEgressGateway, key); err != nil {
+				return err
+			}
+		}
+	}
+	err := c.createEgressGatewayIfNeeded(ctx, key, cachedEgressGateway, egressGateway)
+	if err != nil {
+		return err
+	}
+
+	cachedEgressGateway.state = egressGateway
+	// Always update the cache upon success.
+	c.cache.set(key, cachedEgressGateway)
+	return nil
+}
+
+func (c *Controller) egressGatewayReinitialize(ctx context.Context, key string, cachedEgressGateway *cachedEgressGateway, egressGateway *v1.EgressGateway) func() (bool, error) {
+	// this func will always return true that keeps the poll once
+	return func() (bool, error) {
+		err := c.installEgressGateway(ctx, egressGateway)
+		if err == nil {
+			egressGateway = egressGateway.DeepCopy()
+			egressGateway.Status.Phase = v1.AddonPhaseChecking
+			egressGateway.Status.Reason = ""
+			egressGateway.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
+			err = c.persistUpdate(ctx, egressGateway)
+			if err != nil {
+				return true, err
+			}
+			return true, nil
+		}
+		// First, rollback the egressGateway
+		if err := c.uninstallEgressGateway(ctx, egressGateway); err != nil {
+			log.Error("Uninstall EgressGateway error.")
+			return true, err
+		}
+		if egressGateway.Status.RetryCount == maxRetryCount {
+			egressGateway = egressGateway.DeepCopy()
+			egressGateway.Status.Phase = v1.AddonPhaseFailed
+			egressGateway.Status.Reason = fmt.Sprintf("Install error and retried max(%d) times already.", maxRetryCount)
+			err := c.persistUpdate(ctx, egressGateway)
+			if err != nil {
+				log.Error("Update EgressGateway error.")
+				return true, err
+			}
+			return true, nil
+		}
+		// Add the retry count will trigger reinitialize function from the persistent controller again.
+		egressGateway = egressGateway.DeepCopy()
+		egressGateway.Status.Phase = v1.AddonPhaseReinitializing
+		egressGateway.Status.Reason = err.Error()
+		egressGateway.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
+		egressGateway.Status.RetryCount++
+		err = c.persistUpdate(ctx, egressGateway)
+		if err != nil {
+			return true, err
+		}
+		return true, nil
+	}
+}
+
+func (c *Controller) createEgressGatewayIfNeeded(ctx context.Context, key string, cachedEgressGateway *cachedEgressGateway, egressGateway *v1.EgressGateway) error {
+	switch egressGateway.Status.Phase {
+	case v1.AddonPhaseInitializing:
+		log.Error("EgressGateway will be created", log.String("EgressGateway", key))
+		err := c.installEgressGateway(ctx, egressGateway)
+		if err == nil {
+			egressGateway = egressGateway.DeepCopy()
+			egressGateway.Status.Version = egressGateway.Spec.Version
+			egressGateway.Status.Phase = v1.AddonPhaseChecking
+			egressGateway.Status.Reason = ""
+			egressGateway.Status.RetryCount = 0
+			return c.persistUpdate(ctx, egressGateway)
+		}
+		egressGateway = egressGateway.DeepCopy()
+		egressGateway.Status.Version = egressGateway.Spec.Version
+		egressGateway.Status.Phase = v1.AddonPhaseReinitializing
+		egressGateway.Status.Reason = err.Error()
+		egressGateway.Status.RetryCount = 1
+		egressGateway.Status.LastReInitializingTimestamp = metav1.Now()
+		return c.persistUpdate(ctx, egressGateway)
+	case v1.AddonPhaseReinitializing:
+		var interval = time.Since(egressGateway.Status.LastReInitializingTimestamp.Time)
+		var waitTime time.Duration
+		if interval >= timeOut {
+			waitTime = time.Duration(1)
+		} else {
+			waitTime = timeOut - interval
+		}
+		go wait.Poll(waitTime, timeOut, c.egressGatewayReinitialize(ctx, key, cachedEgressGateway, egressGateway))
+	case v1.AddonPhaseChecking:
+		if _, ok := c.checking.Load(key); !ok {
+			c.checking.Store(key, true)
+			initDelay := time.Now().Add(5 * time.Minute)
+			go func() {
+				defer c.checking.Delete(key)
+				wait.PollImmediate(5*time.Second, 5*time.Minute, c.checkEgressGatewayStatus(ctx, egressGateway, key, initDelay))
+			}()
+		}
+	case v1.AddonPhaseRunning:
+		if needUpgrade(egressGateway) {
+			c.health.Delete(key)
+			egressGateway = egressGateway.DeepCopy()
+			egressGateway.Status.Phase = v1.AddonPhaseUpgrading
+			egressGateway.Status.Reason = ""
+			egressGateway.Status.RetryCount = 0
+			return c.persistUpdate(ctx, egressGateway)
+		}
+		if cachedEgressGateway.state == nil || !reflect.DeepEqual(cachedEgressGateway.state.Spec.IPPools, egressGateway.Spec.IPPools) {
+			log.Info("EgressGateway ip pools will be updated", log.String("EgressGateway", key))
+			if err := c.updateIPPools(ctx, egressGateway); err != nil {
+				return err
+			}
+		}
+		if _, ok := c.health.Load(key); !ok {
+			c.health.Store(key, true)
+			go wait.PollImmediateUntil(5*time.Minute, c.watchEgressGatewayHealth(ctx, key), c.stopCh)
+		}
+		if _, ok := c.failover.Load(key); !ok {
+			c.failover.Store(key, true)
+			go wait.PollImmediateUntil(failoverInterval, c.reconcileAssignments(ctx, key), c.stopCh)
+		}
+	case v1.AddonPhaseUpgrading:
+		if _, ok := c.upgrading.Load(key); !ok {
+			c.upgrading.Store(key, true)
+			upgradeDelay := time.Now().Add(timeOut)
+			go func() {
+				defer c.upgrading.Delete(key)
+				wait.PollImmediate(5*time.Second, timeOut, c.upgradeEgressGateway(ctx, egressGateway, key, upgradeDelay))
+			}()
+		}
+	case v1.AddonPhaseFailed:
+		log.Info("EgressGateway is error", log.String("EgressGateway", key))
+		c.health.Delete(key)
+		c.checking.Delete(key)
+		c.upgrading.Delete(key)
+		c.failover.Delete(key)
+	}
+	return nil
+}
+
+func needUpgrade(egressGateway *v1.EgressGateway) bool {
+	return egressGateway.Spec.Version != egressGateway.Status.Version
+}
+
+func (c *Controller) installEgressGateway(ctx context.Context, egressGateway *v1.EgressGateway) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	// ServiceAccount EgressGateway
+	if _, err := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Create(ctx, serviceAccountEgressGateway(), metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	// ClusterRoleBinding EgressGateway
+	if _, err := kubeClient.RbacV1().ClusterRoleBindings().Create(ctx, crbEgressGateway(), metav1.CreateOptions{}); err != nil {
+		return err
+	}
+	// ConfigMap and DaemonSets EgressGateway
+	return ensureIPPools(ctx, kubeClient, egressGateway)
+}
+
+func (c *Controller) updateIPPools(ctx context.Context, egressGateway *v1.EgressGateway) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	return ensureIPPools(ctx, kubeClient, egressGateway)
+}
+
+// ensureIPPools writes the ip pools to the gateway config and runs a gateway
+// DaemonSet on the nodes of each pool, the DaemonSets of removed pools are deleted.
+func ensureIPPools(ctx context.Context, kubeClient kubernetes.Interface, egressGateway *v1.EgressGateway) error {
+	cm, err := configMapEgressGateway(egressGateway.Spec.IPPools, egressGateway.Status.Assignments)
+	if err != nil {
+		return err
+	}
+	if err := apiclient.CreateOrUpdateConfigMap(ctx, kubeClient, cm); err != nil {
+		return err
+	}
+	pools := sets.NewString()
+	for _, pool := range egressGateway.Spec.IPPools {
+		pools.Insert(daemonSetEgressGatewayName(pool.Name))
+		if err := apiclient.CreateOrUpdateDaemonSet(ctx, kubeClient, daemonSetEgressGateway(egressGateway.Spec.Version, pool)); err != nil {
+			return err
+		}
+	}
+	dsList, err := listEgressGatewayDaemonSets(ctx, kubeClient)
+	if err != nil {
+		return err
+	}
+	for _, ds := range dsList.Items {
+		if pools.Has(ds.Name) {
+			continue
+		}
+		if err := kubeClient.AppsV1().DaemonSets(metav1.NamespaceSystem).Delete(ctx, ds.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func listEgressGatewayDaemonSets(ctx context.Context, kubeClient kubernetes.Interface) (*appsv1.DaemonSetList, error) {
+	return kubeClient.AppsV1().DaemonSets(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": egressGatewayName}}),
+	})
+}
+
+func serviceAccountEgressGateway() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svcAccountEgressGatewayName,
+			Namespace: metav1.NamespaceSystem,
+		},
+	}
+}
+
+func crbEgressGateway() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRoleBinding",
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crbEgressGatewayName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      svcAccountEgressGatewayName,
+				Namespace: metav1.NamespaceSystem,
+			},
+		},
+	}
+}
+
+func configMapEgressGateway(pools []v1.EgressIPPool, assignments []v1.EgressIPAssignment) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(pools)
+	if err != nil {
+		return nil, err
+	}
+	if assignments == nil {
+		assignments = []v1.EgressIPAssignment{}
+	}
+	assignmentsData, err := json.Marshal(assignments)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmEgressGatewayName,
+			Labels:    map[string]string{"app": egressGatewayName},
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			ipPoolsFileName:     string(data),
+			assignmentsFileName: string(assignmentsData),
+		},
+	}, nil
+}
+
+func daemonSetEgressGatewayName(poolName string) string {
+	return fmt.Sprintf("%s-%s", egressGatewayName, poolName)
+}
+
+func daemonSetEgressGateway(egressGatewayVersion string, pool v1.EgressIPPool) *appsv1.DaemonSet {
+	labels := map[string]string{"app": egressGatewayName, "pool": pool.Name}
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      daemonSetEgressGatewayName(pool.Name),
+			Labels:    labels,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName:  "system-node-critical",
+					ServiceAccountName: svcAccountEgressGatewayName,
+					HostNetwork:        true,
+					NodeSelector:       pool.NodeSelector,
+					Containers: []corev1.Container{
+						{
+							Name:  egressGatewayName,
+							Image: images.Get(egressGatewayVersion).EgressGateway.FullName(),
+							Args: []string{
+								"--pool", pool.Name,
+								"--config", fmt.Sprintf("%s/%s", configDir, ipPoolsFileName),
+								"--assignments", fmt.Sprintf("%s/%s", configDir, assignmentsFileName),
+								"--project-label", projectLabel,
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name: "NODE_NAME",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+									},
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								Privileged: boolPtr(true),
+								Capabilities: &corev1.Capabilities{
+									Add: []corev1.Capability{"NET_ADMIN"},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "config", MountPath: configDir, ReadOnly: true},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									// TODO: add support for configuring them
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(128*1024*1024, resource.BinarySI),
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "config",
+							VolumeSource: corev1.VolumeSource{
+								ConfigMap: &corev1.ConfigMapVolumeSource{
+									LocalObjectReference: corev1.LocalObjectReference{Name: cmEgressGatewayName},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+// isEgressGatewayReady returns whether the gateways of all pools are running.
+func isEgressGatewayReady(ctx context.Context, kubeClient kubernetes.Interface, egressGateway *v1.EgressGateway) (bool, error) {
+	for _, pool := range egressGateway.Spec.IPPools {
+		ds, err := kubeClient.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, daemonSetEgressGatewayName(pool.Name), metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if ds.Status.DesiredNumberScheduled == 0 || ds.Status.NumberReady < ds.Status.DesiredNumberScheduled {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (c *Controller) uninstallEgressGateway(ctx context.Context, egressGateway *v1.EgressGateway) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	// DaemonSets EgressGateway
+	dsEgressGatewayErr := kubeClient.AppsV1().DaemonSets(metav1.NamespaceSystem).DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: map[string]string{"app": egressGatewayName}}),
+	})
+	// ConfigMap EgressGateway
+	cmEgressGatewayErr := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Delete(ctx, cmEgressGatewayName, metav1.DeleteOptions{})
+	// ClusterRoleBinding EgressGateway
+	crbEgressGatewayErr := kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, crbEgressGatewayName, metav1.DeleteOptions{})
+	// ServiceAccount EgressGateway
+	svcAccountEgressGatewayErr := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Delete(ctx, svcAccountEgressGatewayName, metav1.DeleteOptions{})
+
+	if (dsEgressGatewayErr != nil && !errors.IsNotFound(dsEgressGatewayErr)) ||
+		(cmEgressGatewayErr != nil && !errors.IsNotFound(cmEgressGatewayErr)) ||
+		(crbEgressGatewayErr != nil && !errors.IsNotFound(crbEgressGatewayErr)) ||
+		(svcAccountEgressGatewayErr != nil && !errors.IsNotFound(svcAccountEgressGatewayErr)) {
+		return normalerrors.New("delete EgressGateway error")
+	}
+	return nil
+}
+
+func (c *Controller) watchEgressGatewayHealth(ctx context.Context, key string) func() (bool, error) {
+	return func() (bool, error) {
+		log.Info("Start check EgressGateway in cluster health", log.String("EgressGateway", key))
+		egressGateway, err := c.lister.Get(key)
+		if err != nil {
+			return false, err
+		}
+
+		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			return false, err
+		}
+		if err != nil {
+			return false, nil
+		}
+		if _, ok := c.health.Load(key); !ok {
+			log.Info("Health check over.")
+			return true, nil
+		}
+		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+		if err != nil {
+			return false, err
+		}
+		if ready, err := isEgressGatewayReady(ctx, kubeClient, egressGateway); err != nil || !ready {
+			egressGateway = egressGateway.DeepCopy()
+			egressGateway.Status.Phase = v1.AddonPhaseFailed
+			egressGateway.Status.Reason = "EgressGateway is not healthy."
+			if err = c.persistUpdate(ctx, egressGateway); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+		return false, nil
+	}
+}
+
+// reconcileAssignments moves the egress IPs of the failed gateway nodes to the
+// ready ones, the gateways only hold the IPs assigned to their nodes.
+func (c *Controller) reconcileAssignments(ctx context.Context, key string) func() (bool, error) {
+	return func() (bool, error) {
+		if _, ok := c.failover.Load(key); !ok {
+			log.Info("Failover over.", log.String("EgressGateway", key))
+			return true, nil
+		}
+		egressGateway, err := c.lister.Get(key)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return true, nil
+			}
+			return false, nil
+		}
+		if egressGateway.Status.Phase != v1.AddonPhaseRunning {
+			c.failover.Delete(key)
+			return true, nil
+		}
+		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+		if err != nil {
+			return false, nil
+		}
+		nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Warn("List nodes for EgressGateway failover failed", log.String("EgressGateway", key), log.Err(err))
+			return false, nil
+		}
+		timeout := defaultFailoverTimeout
+		if egressGateway.Spec.FailoverTimeout != nil {
+			timeout = egressGateway.Spec.FailoverTimeout.Duration
+		}
+		assignments := assignIPs(egressGateway.Spec.IPPools, egressGateway.Status.Assignments, nodes.Items, timeout, time.Now())
+		if reflect.DeepEqual(assignments, egressGateway.Status.Assignments) {
+			return false, nil
+		}
+		log.Info("EgressGateway assignments changed", log.String("EgressGateway", key), log.Any("assignments", assignments))
+		cm, err := configMapEgressGateway(egressGateway.Spec.IPPools, assignments)
+		if err != nil {
+			return false, nil
+		}
+		if err := apiclient.CreateOrUpdateConfigMap(ctx, kubeClient, cm); err != nil {
+			log.Warn("Update EgressGateway assignments failed", log.String("EgressGateway", key), log.Err(err))
+			return false, nil
+		}
+		egressGateway = egressGateway.DeepCopy()
+		egressGateway.Status.Assignments = assignments
+		if err := c.persistUpdate(ctx, egressGateway); err != nil {
+			log.Warn("Persist EgressGateway assignments failed", log.String("EgressGateway", key), log.Err(err))
+		}
+		return false, nil
+	}
+}
+
+func (c *Controller) checkEgressGatewayStatus(ctx context.Context, egressGateway *v1.EgressGateway, key string, initDelay time.Time) func() (bool, error) {
+	return func() (bool, error) {
+		log.Info("Start to check EgressGateway health", log.String("EgressGateway", egressGateway.Name))
+		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			return false, err
+		}
+		if err != nil {
+			return false, nil
+		}
+		if _, ok := c.checking.Load(key); !ok {
+			log.Debug("Checking over EgressGateway addon status")
+			return true, nil
+		}
+		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+		if err != nil {
+			return false, err
+		}
+		egressGateway, err := c.lister.Get(key)
+		if err != nil {
+			return false, err
+		}
+		if ready, err := isEgressGatewayReady(ctx, kubeClient, egressGateway); err != nil || !ready {
+			if time.Now().After(initDelay) {
+				egressGateway = egressGateway.DeepCopy()
+				egressGateway.Status.Phase = v1.AddonPhaseFailed
+				egressGateway.Status.Reason = "EgressGateway is not healthy."
+				if err = c.persistUpdate(ctx, egressGateway); err != nil {
+					return false, err
+				}
+				return true, nil
+			}
+			return false, nil
+		}
+		egressGateway = egressGateway.DeepCopy()
+		egressGateway.Status.Phase = v1.AddonPhaseRunning
+		egressGateway.Status.Reason = ""
+		if err = c.persistUpdate(ctx, egressGateway); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+func (c *Controller) upgradeEgressGateway(ctx context.Context, egressGateway *v1.EgressGateway, key string, initDelay time.Time) func() (bool, error) {
+	return func() (bool, error) {
+		log.Info("Start to upgrade EgressGateway", log.String("EgressGateway", egressGateway.Name))
+		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, egressGateway.Spec.ClusterName, metav1.GetOptions{})
+		if err != nil && errors.IsNotFound(err) {
+			return false, err
+		}
+		if err != nil {
+			return false, nil
+		}
+		if _, ok := c.upgrading.Load(key); !ok {
+			log.Debug("Upgrading EgressGateway", log.String("EgressGateway", egressGateway.Name))
+			return true, nil
+		}
+		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+		if err != nil {
+			return false, err
+		}
+		egressGateway, err := c.lister.Get(key)
+		if err != nil {
+			return false, err
+		}
+		if err := ensureIPPools(ctx, kubeClient, egressGateway); err != nil {
+			if time.Now().After(initDelay) {
+				egressGateway = egressGateway.DeepCopy()
+				egressGateway.Status.Phase = v1.AddonPhaseFailed
+				egressGateway.Status.Reason = "Failed to upgrade EgressGateway."
+				if err = c.persistUpdate(ctx, egressGateway); err != nil {
+					return false, err
+				}
+				return true, nil
+			}
+			return false, nil
+		}
+		egressGateway = egressGateway.DeepCopy()
+		egressGateway.Status.Version = egressGateway.Spec.Version
+		egressGateway.Status.Phase = v1.AddonPhaseChecking
+		egressGateway.Status.Reason = ""
+		if err = c.persistUpdate(ctx, egressGateway); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, egressGateway *v1.EgressGateway) error {
+	var err error
+	for i := 0; i < clientRetryCount; i++ {
+		_, err = c.client.PlatformV1().EgressGateways().UpdateStatus(ctx, egressGateway, metav1.UpdateOptions{})
+		if err == nil {
+			return nil
+		}
+		// If the object no longer exists, we don't want to recreate it. Just bail
+		// out so that we can process the delete, which we should soon be receiving
+		// if we haven't already.
+		if errors.IsNotFound(err) {
+			log.Info("Not persisting update to egressGateway that no longer exists", log.String("clusterName", egressGateway.Spec.ClusterName), log.Err(err))
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("not persisting update to EgressGateway '%s' that has been changed since we received it: %v", egressGateway.Spec.ClusterName, err)
+		}
+		log.Warn(fmt.Sprintf("Failed to persist updated status of EgressGateway '%s/%s'", egressGateway.Spec.ClusterName, egressGateway.Status.Phase), log.String("clusterName", egressGateway.Spec.ClusterName), log.Err(err))
+		time.Sleep(clientRetryInterval)
+	}
+
+	return err
+}
@@ -0,0 +1,201 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	platformfake "tkestack.io/tke/api/client/clientset/versioned/fake"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/egressgateway/images"
+)
+
+func newEgressGateway(pools ...v1.EgressIPPool) *v1.EgressGateway {
+	return &v1.EgressGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "eg"},
+		Spec: v1.EgressGatewaySpec{
+			ClusterName: "cls",
+			Version:     images.LatestVersion,
+			IPPools:     pools,
+		},
+	}
+}
+
+func TestConfigMapEgressGateway(t *testing.T) {
+	pools := []v1.EgressIPPool{{Name: "a", IPs: []string{"10.0.0.1"}, Namespaces: []string{"default"}}}
+	cm, err := configMapEgressGateway(pools, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cm.Data[assignmentsFileName]; got != "[]" {
+		t.Errorf("assignments without status = %s, want []", got)
+	}
+	var gotPools []v1.EgressIPPool
+	if err := json.Unmarshal([]byte(cm.Data[ipPoolsFileName]), &gotPools); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(gotPools, pools) {
+		t.Errorf("pools = %v, want %v", gotPools, pools)
+	}
+}
+
+func TestEnsureIPPools(t *testing.T) {
+	ctx := context.Background()
+	stale := daemonSetEgressGateway(images.LatestVersion, v1.EgressIPPool{Name: "removed"})
+	kubeClient := fake.NewSimpleClientset(stale)
+
+	egressGateway := newEgressGateway(
+		v1.EgressIPPool{Name: "a", IPs: []string{"10.0.0.1"}, AllNamespaces: true, NodeSelector: map[string]string{"egress": "a"}},
+		v1.EgressIPPool{Name: "b", IPs: []string{"10.0.0.2"}, Projects: []string{"prj-a"}},
+	)
+	egressGateway.Status.Assignments = []v1.EgressIPAssignment{{Pool: "a", IP: "10.0.0.1", Node: "node-a"}}
+	if err := ensureIPPools(ctx, kubeClient, egressGateway); err != nil {
+		t.Fatal(err)
+	}
+
+	cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmEgressGatewayName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var assignments []v1.EgressIPAssignment
+	if err := json.Unmarshal([]byte(cm.Data[assignmentsFileName]), &assignments); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(assignments, egressGateway.Status.Assignments) {
+		t.Errorf("assignments = %v, want %v", assignments, egressGateway.Status.Assignments)
+	}
+
+	dsList, err := listEgressGatewayDaemonSets(ctx, kubeClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]*appsv1.DaemonSet)
+	for i := range dsList.Items {
+		names[dsList.Items[i].Name] = &dsList.Items[i]
+	}
+	if len(names) != 2 || names["egress-gateway-a"] == nil || names["egress-gateway-b"] == nil {
+		t.Fatalf("daemonsets = %v, want egress-gateway-a and egress-gateway-b", names)
+	}
+	if got := names["egress-gateway-a"].Spec.Template.Spec.NodeSelector; !reflect.DeepEqual(got, map[string]string{"egress": "a"}) {
+		t.Errorf("node selector of pool a = %v", got)
+	}
+
+	// the DaemonSet of the pool removed from spec is deleted
+	egressGateway.Spec.IPPools = egressGateway.Spec.IPPools[:1]
+	if err := ensureIPPools(ctx, kubeClient, egressGateway); err != nil {
+		t.Fatal(err)
+	}
+	dsList, err = listEgressGatewayDaemonSets(ctx, kubeClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dsList.Items) != 1 || dsList.Items[0].Name != "egress-gateway-a" {
+		t.Errorf("daemonsets after removing pool b = %v", dsList.Items)
+	}
+}
+
+func TestIsEgressGatewayReady(t *testing.T) {
+	ctx := context.Background()
+	pool := v1.EgressIPPool{Name: "a", IPs: []string{"10.0.0.1"}, AllNamespaces: true}
+	tests := []struct {
+		name    string
+		status  *appsv1.DaemonSetStatus
+		want    bool
+		wantErr bool
+	}{
+		{
+			name:    "not found",
+			wantErr: true,
+		},
+		{
+			name:   "no nodes scheduled",
+			status: &appsv1.DaemonSetStatus{},
+		},
+		{
+			name:   "partially ready",
+			status: &appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 1},
+		},
+		{
+			name:   "ready",
+			status: &appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, NumberReady: 2},
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var objects []runtime.Object
+			if tt.status != nil {
+				ds := daemonSetEgressGateway(images.LatestVersion, pool)
+				ds.Status = *tt.status
+				objects = append(objects, ds)
+			}
+			got, err := isEgressGatewayReady(ctx, fake.NewSimpleClientset(objects...), newEgressGateway(pool))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("isEgressGatewayReady() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("isEgressGatewayReady() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPersistUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	// the deleted EgressGateway is not recreated
+	client := platformfake.NewSimpleClientset()
+	c := &Controller{client: client}
+	if err := c.persistUpdate(ctx, newEgressGateway()); err != nil {
+		t.Errorf("persistUpdate() of deleted EgressGateway error = %v", err)
+	}
+
+	egressGateway := newEgressGateway()
+	client = platformfake.NewSimpleClientset(egressGateway)
+	c = &Controller{client: client}
+	updated := egressGateway.DeepCopy()
+	updated.Status.Phase = v1.AddonPhaseRunning
+	if err := c.persistUpdate(ctx, updated); err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.PlatformV1().EgressGateways().Get(ctx, egressGateway.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != v1.AddonPhaseRunning {
+		t.Errorf("phase = %s, want %s", got.Status.Phase, v1.AddonPhaseRunning)
+	}
+
+	client.PrependReactor("update", "egressgateways", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.NewConflict(schema.GroupResource{Resource: "egressgateways"}, egressGateway.Name, nil)
+	})
+	if err := c.persistUpdate(ctx, updated); err == nil {
+		t.Error("persistUpdate() should fail on conflict")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	normalerrors "errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -52,6 +53,9 @@ const (
 	ipamMaxRetryCount = 5
 	ipamTimeOut       = 5 * time.Minute
 	ipamRetryInterval = 5 * time.Second
+
+	// poolUsagePeriod is the interval of collecting the usage of floating IP pools.
+	poolUsagePeriod = time.Minute
 )
 
 const (
@@ -74,6 +78,9 @@ type Controller struct {
 	lister       platformv1lister.IPAMLister
 	listerSynced cache.InformerSynced
 	stopCh       <-chan struct{}
+	// usageSubnets records the subnets whose usage metrics are exported for
+	// each IPAM, so that the metrics of removed subnets can be deleted.
+	usageSubnets sync.Map
 }
 
 // NewController creates a new Controller object.
@@ -146,6 +153,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
 	for i := 0; i < workers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
 	}
+	go wait.Until(c.collectPoolUsage, poolUsagePeriod, stopCh)
 
 	<-stopCh
 	return nil
@@ -232,6 +240,7 @@ func (c *Controller) processIPAMDelete(ctx context.Context, cachedIPAM *cachedIP
 	}
 
 	ipam := cachedIPAM.state
+	c.deletePoolUsage(key, ipam)
 	return c.uninstallIPAM(ctx, ipam)
 }
 
@@ -0,0 +1,105 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ipam
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// collectPoolUsage exports the allocated and free floating IPs of each subnet
+// of the running IPAMs.
+func (c *Controller) collectPoolUsage() {
+	ipams, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Warn("Failed to list ipams", log.Err(err))
+		return
+	}
+	for _, ipam := range ipams {
+		if ipam.Status.Phase != v1.AddonPhaseRunning {
+			continue
+		}
+		if err := c.updatePoolUsage(context.Background(), ipam); err != nil {
+			log.Warn("Failed to collect the usage of floating ip pools", log.String("ipam", ipam.Name), log.Err(err))
+		}
+	}
+}
+
+func (c *Controller) updatePoolUsage(ctx context.Context, ipam *v1.IPAM) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, ipam.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	credential, err := util.GetClusterCredentialV1(ctx, c.client.PlatformV1(), cluster)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := util.BuildExternalDynamicClientSet(cluster, credential)
+	if err != nil {
+		return err
+	}
+
+	pools, err := getFloatingIPPools(ctx, kubeClient)
+	if err != nil {
+		return err
+	}
+	floatingIPs, err := listFloatingIPs(ctx, dynamicClient)
+	if err != nil {
+		return err
+	}
+	var allocatedIPs []string
+	for _, fip := range floatingIPs {
+		allocatedIPs = append(allocatedIPs, fip.GetName())
+	}
+
+	subnets := sets.NewString()
+	for _, usage := range calculatePoolUsage(pools, allocatedIPs) {
+		subnets.Insert(usage.subnet)
+		UpdateMetricFloatingIPUsage(ipam.Spec.TenantID, ipam.Spec.ClusterName, usage.subnet, usage.allocated, usage.free)
+	}
+	if previous, ok := c.usageSubnets.Load(ipam.Name); ok {
+		for _, subnet := range previous.(sets.String).Difference(subnets).UnsortedList() {
+			DeleteMetricFloatingIPUsage(ipam.Spec.TenantID, ipam.Spec.ClusterName, subnet)
+		}
+	}
+	c.usageSubnets.Store(ipam.Name, subnets)
+	return nil
+}
+
+// deletePoolUsage deletes the usage metrics of the IPAM.
+func (c *Controller) deletePoolUsage(key string, ipam *v1.IPAM) {
+	previous, ok := c.usageSubnets.Load(key)
+	if !ok || ipam == nil {
+		return
+	}
+	for _, subnet := range previous.(sets.String).UnsortedList() {
+		DeleteMetricFloatingIPUsage(ipam.Spec.TenantID, ipam.Spec.ClusterName, subnet)
+	}
+	c.usageSubnets.Delete(key)
+}
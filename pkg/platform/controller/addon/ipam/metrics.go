@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ipam
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	floatingIPAllocated = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ipam_floating_ip_allocated",
+			Help: "number of allocated floating ips of the subnet",
+		},
+		[]string{"tenant_id", "cluster_name", "subnet"})
+	floatingIPFree = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ipam_floating_ip_free",
+			Help: "number of free floating ips of the subnet",
+		},
+		[]string{"tenant_id", "cluster_name", "subnet"})
+)
+
+func init() {
+	prometheus.MustRegister(floatingIPAllocated, floatingIPFree)
+}
+
+func UpdateMetricFloatingIPUsage(tenantID string, clusterName string, subnet string, allocated int, free int) {
+	labels := map[string]string{"tenant_id": tenantID, "cluster_name": clusterName, "subnet": subnet}
+	floatingIPAllocated.With(labels).Set(float64(allocated))
+	floatingIPFree.With(labels).Set(float64(free))
+}
+
+func DeleteMetricFloatingIPUsage(tenantID string, clusterName string, subnet string) {
+	labels := map[string]string{"tenant_id": tenantID, "cluster_name": clusterName, "subnet": subnet}
+	floatingIPAllocated.Delete(labels)
+	floatingIPFree.Delete(labels)
+}
@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ipam
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	floatingIPKey = "floatingips"
+	// floatingIPTypeLabel is the label of galaxy floating IPs which records the
+	// type of them.
+	floatingIPTypeLabel = "ipType"
+	floatingIPInternal  = "internalIP"
+)
+
+// floatingIPResource is the resource of the floating IPs allocated by galaxy-ipam.
+var floatingIPResource = schema.GroupVersionResource{Group: "galaxy.k8s.io", Version: "v1alpha1", Resource: "floatingips"}
+
+// floatingIPPool is the pool format of galaxy-ipam.
+type floatingIPPool struct {
+	RoutableSubnet string   `json:"routableSubnet"`
+	IPs            []string `json:"ips"`
+	Subnet         string   `json:"subnet"`
+	Gateway        string   `json:"gateway"`
+	Vlan           uint16   `json:"vlan,omitempty"`
+}
+
+// contains returns whether the ip is one of the floating IPs of the pool.
+func (p *floatingIPPool) contains(ip net.IP) bool {
+	for _, item := range p.IPs {
+		first, last, err := parseIPRange(item)
+		if err != nil {
+			continue
+		}
+		if v := ipToUint32(ip); v >= first && v <= last {
+			return true
+		}
+	}
+	return false
+}
+
+// size returns the number of floating IPs of the pool.
+func (p *floatingIPPool) size() int {
+	count := 0
+	for _, item := range p.IPs {
+		first, last, err := parseIPRange(item)
+		if err != nil {
+			continue
+		}
+		count += int(last-first) + 1
+	}
+	return count
+}
+
+// parseIPRange parses an IP such as 10.0.0.10 or an IP range such as
+// 10.0.0.20~10.0.0.100.
+func parseIPRange(s string) (uint32, uint32, error) {
+	parts := strings.SplitN(s, "~", 2)
+	first := net.ParseIP(strings.TrimSpace(parts[0])).To4()
+	if first == nil {
+		return 0, 0, fmt.Errorf("invalid ip range %q", s)
+	}
+	last := first
+	if len(parts) == 2 {
+		last = net.ParseIP(strings.TrimSpace(parts[1])).To4()
+		if last == nil {
+			return 0, 0, fmt.Errorf("invalid ip range %q", s)
+		}
+	}
+	if ipToUint32(first) > ipToUint32(last) {
+		return 0, 0, fmt.Errorf("invalid ip range %q", s)
+	}
+	return ipToUint32(first), ipToUint32(last), nil
+}
+
+func ipToUint32(ip net.IP) uint32 {
+	ip = ip.To4()
+	if ip == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(ip)
+}
+
+// parseFloatingIPPools returns the pools of the floatingip ConfigMap.
+func parseFloatingIPPools(cm *corev1.ConfigMap) ([]floatingIPPool, error) {
+	var pools []floatingIPPool
+	data := cm.Data[floatingIPKey]
+	if strings.TrimSpace(data) == "" {
+		return pools, nil
+	}
+	if err := json.Unmarshal([]byte(data), &pools); err != nil {
+		return nil, fmt.Errorf("invalid floating ip pools of ConfigMap %s: %v", cm.Name, err)
+	}
+	return pools, nil
+}
+
+// getFloatingIPPools returns the floating IP pools configured in cluster.
+func getFloatingIPPools(ctx context.Context, kubeClient kubernetes.Interface) ([]floatingIPPool, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmFloatingIPName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return parseFloatingIPPools(cm)
+}
+
+// findPool returns the pool which the ip belongs to.
+func findPool(pools []floatingIPPool, ip net.IP) *floatingIPPool {
+	for i := range pools {
+		if pools[i].contains(ip) {
+			return &pools[i]
+		}
+	}
+	return nil
+}
+
+// listFloatingIPs returns the floating IPs allocated by galaxy-ipam.
+func listFloatingIPs(ctx context.Context, dynamicClient dynamic.Interface) ([]unstructured.Unstructured, error) {
+	list, err := dynamicClient.Resource(floatingIPResource).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", floatingIPTypeLabel, floatingIPInternal),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+// poolUsage is the number of allocated and free floating IPs of a pool.
+type poolUsage struct {
+	subnet    string
+	allocated int
+	free      int
+}
+
+// calculatePoolUsage counts the allocated and free floating IPs of each pool,
+// the floating IPs out of the pools are ignored.
+func calculatePoolUsage(pools []floatingIPPool, allocatedIPs []string) []poolUsage {
+	result := make([]poolUsage, len(pools))
+	for i := range pools {
+		result[i] = poolUsage{subnet: pools[i].Subnet, free: pools[i].size()}
+	}
+	for _, s := range allocatedIPs {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			continue
+		}
+		for i := range pools {
+			if pools[i].contains(ip) {
+				result[i].allocated++
+				result[i].free--
+				break
+			}
+		}
+	}
+	return result
+}
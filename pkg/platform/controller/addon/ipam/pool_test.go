@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ipam
+
+import (
+	"net"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestCalculatePoolUsage(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: cmFloatingIPName},
+		Data: map[string]string{
+			floatingIPKey: `[{"routableSubnet":"10.0.0.0/24","ips":["10.0.0.10~10.0.0.19","10.0.0.30"],"subnet":"10.0.0.0/24","gateway":"10.0.0.1"},
+{"routableSubnet":"10.0.1.0/24","ips":["10.0.1.10~10.0.1.11"],"subnet":"10.0.1.0/24","gateway":"10.0.1.1"}]`,
+		},
+	}
+	pools, err := parseFloatingIPPools(cm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pool := findPool(pools, net.ParseIP("10.0.0.30")); pool == nil || pool.Subnet != "10.0.0.0/24" {
+		t.Errorf("expected 10.0.0.30 in pool 10.0.0.0/24, got %v", pool)
+	}
+	if pool := findPool(pools, net.ParseIP("10.0.0.20")); pool != nil {
+		t.Errorf("expected 10.0.0.20 out of pools, got %v", pool)
+	}
+
+	got := calculatePoolUsage(pools, []string{"10.0.0.10", "10.0.0.30", "10.0.1.11", "10.0.2.1"})
+	want := []poolUsage{
+		{subnet: "10.0.0.0/24", allocated: 2, free: 9},
+		{subnet: "10.0.1.0/24", allocated: 1, free: 1},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("calculatePoolUsage() = %v, want %v", got, want)
+	}
+}
+
+func TestReservationKey(t *testing.T) {
+	tests := []struct {
+		spec v1.FloatingIPReservationSpec
+		want string
+	}{
+		{v1.FloatingIPReservationSpec{Namespace: "default", WorkloadKind: "Deployment", WorkloadName: "web"}, "dp_default_web_"},
+		{v1.FloatingIPReservationSpec{Namespace: "default", WorkloadKind: "StatefulSet", WorkloadName: "db", PodName: "db-0"}, "sts_default_db_db-0"},
+		{v1.FloatingIPReservationSpec{Namespace: "default", WorkloadKind: "TApp", WorkloadName: "app", PodName: "app-1"}, "tapp_default_app_app-1"},
+	}
+	for _, test := range tests {
+		if got := reservationKey(&v1.FloatingIPReservation{Spec: test.spec}); got != test.want {
+			t.Errorf("reservationKey(%v) = %s, want %s", test.spec, got, test.want)
+		}
+	}
+}
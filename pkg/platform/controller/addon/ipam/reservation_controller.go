@@ -0,0 +1,364 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package ipam
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	reservationControllerName = "floating-ip-reservation-controller"
+
+	// release policies of galaxy floating IPs
+	releasePolicyImmutable = 1
+	releasePolicyNever     = 2
+
+	reasonIPAMNotRunning   = "IPAMNotRunning"
+	reasonOutOfPool        = "OutOfPool"
+	reasonAllocatedToOther = "AllocatedToOther"
+	reasonReserveFailed    = "ReserveFailed"
+)
+
+// ReservationController is responsible for reserving the floating IPs of
+// FloatingIPReservations in galaxy-ipam of clusters.
+type ReservationController struct {
+	client       clientset.Interface
+	queue        workqueue.RateLimitingInterface
+	lister       platformv1lister.FloatingIPReservationLister
+	listerSynced cache.InformerSynced
+	ipamLister   platformv1lister.IPAMLister
+	ipamSynced   cache.InformerSynced
+	// reserved records the last seen reservations, which are used to release
+	// the floating IPs after the reservations are deleted.
+	reserved sync.Map
+}
+
+// NewReservationController creates a new ReservationController object.
+func NewReservationController(client clientset.Interface, informer platformv1informer.FloatingIPReservationInformer, ipamInformer platformv1informer.IPAMInformer, resyncPeriod time.Duration) *ReservationController {
+	controller := &ReservationController{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), reservationControllerName),
+	}
+
+	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(reservationControllerName, client.PlatformV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldReservation, ok1 := oldObj.(*v1.FloatingIPReservation)
+				curReservation, ok2 := newObj.(*v1.FloatingIPReservation)
+				if ok1 && ok2 && !reflect.DeepEqual(oldReservation, curReservation) {
+					controller.enqueue(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueue,
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+	controller.ipamLister = ipamInformer.Lister()
+	controller.ipamSynced = ipamInformer.Informer().HasSynced
+
+	return controller
+}
+
+// obj could be an *v1.FloatingIPReservation, or a DeletionFinalStateUnknown marker item.
+func (c *ReservationController) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *ReservationController) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting FloatingIPReservation controller")
+	defer log.Info("Shutting down FloatingIPReservation controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.ipamSynced); !ok {
+		return fmt.Errorf("failed to wait for FloatingIPReservation caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *ReservationController) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *ReservationController) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncReservation(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing FloatingIPReservation %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *ReservationController) syncReservation(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing FloatingIPReservation", log.String("reservation", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	reservation, err := c.lister.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		previous, ok := c.reserved.Load(key)
+		if !ok {
+			return nil
+		}
+		log.Info("FloatingIPReservation has been deleted, release the floating ip", log.String("reservation", key))
+		if err := c.release(ctx, previous.(*v1.FloatingIPReservation)); err != nil {
+			return err
+		}
+		c.reserved.Delete(key)
+		return nil
+	case err != nil:
+		log.Warn("Unable to retrieve FloatingIPReservation from store", log.String("reservation", key), log.Err(err))
+		return err
+	}
+
+	c.reserved.Store(key, reservation)
+	return c.reserve(ctx, reservation)
+}
+
+// reserve creates the galaxy floating IP of the reservation, the status of the
+// reservation is updated to the result.
+func (c *ReservationController) reserve(ctx context.Context, reservation *v1.FloatingIPReservation) error {
+	if !c.isIPAMRunning(reservation.Spec.ClusterName) {
+		return c.persistPhase(ctx, reservation, v1.FloatingIPReservationPending, reasonIPAMNotRunning, "")
+	}
+
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, reservation.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := c.dynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+
+	pools, err := getFloatingIPPools(ctx, kubeClient)
+	if err != nil {
+		return err
+	}
+	pool := findPool(pools, net.ParseIP(reservation.Spec.IP))
+	if pool == nil {
+		return c.persistPhase(ctx, reservation, v1.FloatingIPReservationFailed, reasonOutOfPool, "")
+	}
+
+	key := reservationKey(reservation)
+	fip, err := dynamicClient.Resource(floatingIPResource).Get(ctx, reservation.Spec.IP, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		_, err = dynamicClient.Resource(floatingIPResource).Create(ctx, newFloatingIP(reservation, pool), metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			log.Warn("Failed to reserve floating ip", log.String("reservation", reservation.Name), log.Err(err))
+			return c.persistPhase(ctx, reservation, v1.FloatingIPReservationFailed, reasonReserveFailed, pool.Subnet)
+		}
+		if errors.IsAlreadyExists(err) {
+			// allocated by galaxy-ipam meanwhile, check it again later
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		if current, _, _ := unstructured.NestedString(fip.Object, "spec", "key"); current != key {
+			return c.persistPhase(ctx, reservation, v1.FloatingIPReservationFailed, reasonAllocatedToOther, pool.Subnet)
+		}
+	}
+
+	return c.persistPhase(ctx, reservation, v1.FloatingIPReservationReserved, "", pool.Subnet)
+}
+
+// release deletes the galaxy floating IP of the reservation if it is still
+// reserved for the workload.
+func (c *ReservationController) release(ctx context.Context, reservation *v1.FloatingIPReservation) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, reservation.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := c.dynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	fip, err := dynamicClient.Resource(floatingIPResource).Get(ctx, reservation.Spec.IP, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if current, _, _ := unstructured.NestedString(fip.Object, "spec", "key"); current != reservationKey(reservation) {
+		return nil
+	}
+	err = dynamicClient.Resource(floatingIPResource).Delete(ctx, reservation.Spec.IP, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: stringPtr(fip.GetResourceVersion())},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (c *ReservationController) isIPAMRunning(clusterName string) bool {
+	ipams, err := c.ipamLister.List(labels.Everything())
+	if err != nil {
+		return false
+	}
+	for _, ipam := range ipams {
+		if ipam.Spec.ClusterName == clusterName && ipam.Status.Phase == v1.AddonPhaseRunning {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *ReservationController) dynamicClient(ctx context.Context, cluster *v1.Cluster) (dynamic.Interface, error) {
+	credential, err := util.GetClusterCredentialV1(ctx, c.client.PlatformV1(), cluster)
+	if err != nil {
+		return nil, err
+	}
+	return util.BuildExternalDynamicClientSet(cluster, credential)
+}
+
+func (c *ReservationController) persistPhase(ctx context.Context, reservation *v1.FloatingIPReservation, phase v1.FloatingIPReservationPhase, reason string, subnet string) error {
+	if reservation.Status.Phase == phase && reservation.Status.Reason == reason && reservation.Status.Subnet == subnet {
+		return nil
+	}
+	reservation = reservation.DeepCopy()
+	if reservation.Status.Phase != phase {
+		reservation.Status.LastTransitionTime = metav1.Now()
+	}
+	reservation.Status.Phase = phase
+	reservation.Status.Reason = reason
+	reservation.Status.Subnet = subnet
+	_, err := c.client.PlatformV1().FloatingIPReservations().UpdateStatus(ctx, reservation, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// reservationKey returns the key of the floating IP in galaxy-ipam, the IP of
+// Deployment is shared by its pods, the IPs of StatefulSet and TApp belong to
+// the pods.
+func reservationKey(reservation *v1.FloatingIPReservation) string {
+	spec := reservation.Spec
+	switch spec.WorkloadKind {
+	case "Deployment":
+		return fmt.Sprintf("dp_%s_%s_", spec.Namespace, spec.WorkloadName)
+	case "StatefulSet":
+		return fmt.Sprintf("sts_%s_%s_%s", spec.Namespace, spec.WorkloadName, spec.PodName)
+	default:
+		return fmt.Sprintf("tapp_%s_%s_%s", spec.Namespace, spec.WorkloadName, spec.PodName)
+	}
+}
+
+func newFloatingIP(reservation *v1.FloatingIPReservation, pool *floatingIPPool) *unstructured.Unstructured {
+	policy := releasePolicyNever
+	if reservation.Spec.WorkloadKind == "Deployment" {
+		policy = releasePolicyImmutable
+	}
+	routableSubnet := pool.RoutableSubnet
+	if routableSubnet == "" {
+		routableSubnet = pool.Subnet
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": floatingIPResource.GroupVersion().String(),
+			"kind":       "FloatingIP",
+			"metadata": map[string]interface{}{
+				"name":   reservation.Spec.IP,
+				"labels": map[string]interface{}{floatingIPTypeLabel: floatingIPInternal},
+			},
+			"spec": map[string]interface{}{
+				"key":        reservationKey(reservation),
+				"attribute":  "",
+				"policy":     int64(policy),
+				"subnet":     routableSubnet,
+				"updateTime": time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+	}
+}
+
+func stringPtr(s string) *string { return &s }
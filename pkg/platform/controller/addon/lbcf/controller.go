@@ -31,6 +31,7 @@ import (
 	v1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/controller"
 	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/lbcf/driver"
 	"tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/metrics"
 
@@ -372,6 +373,11 @@ func (c *Controller) createLBCFIfNeeded(ctx context.Context, key string, cachedL
 			go wait.PollImmediate(5*time.Second, 5*time.Minute, c.checkLBCFStatus(ctx, lbcf, key, initDelay))
 		}
 	case v1.AddonPhaseRunning:
+		if cachedLBCF.state == nil || !reflect.DeepEqual(cachedLBCF.state.Spec, lbcf.Spec) {
+			if err := c.ensureDriverConfig(ctx, lbcf); err != nil {
+				return err
+			}
+		}
 		if _, ok := c.health.Load(key); !ok {
 			c.health.Store(key, true)
 			go wait.PollImmediateUntil(5*time.Minute, c.watchLBCFHealth(ctx, key), c.stopCh)
@@ -422,6 +428,9 @@ func (c *Controller) installLBCF(ctx context.Context, lbcf *v1.LBCF) error {
 	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret(), metav1.CreateOptions{}); err != nil {
 		return err
 	}
+	if err := applyDriverConfig(ctx, kubeClient, lbcf); err != nil {
+		return err
+	}
 	if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Create(ctx, deployment(lbcf.Spec.Version), metav1.CreateOptions{}); err != nil {
 		return err
 	}
@@ -503,6 +512,11 @@ func (c *Controller) uninstallLBCF(ctx context.Context, lbcf *v1.LBCF) error {
 			return err
 		}
 	}
+	if err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Delete(ctx, driver.ConfigMapName, metav1.DeleteOptions{}); err != nil {
+		if !errors.IsNotFound(err) {
+			return err
+		}
+	}
 
 	if err := removeFinalizers(ctx, dynamicClient, driverRes); err != nil {
 		return err
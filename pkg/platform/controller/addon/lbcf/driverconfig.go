@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lbcf
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/lbcf/driver"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// driverConfigMap publishes the health check and webhook timeout of the LBCF
+// to the drivers, which load it by driver.LoadConfig.
+func driverConfigMap(lbcf *v1.LBCF) (*corev1.ConfigMap, error) {
+	data, err := json.Marshal(driver.NewConfig(lbcf))
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      driver.ConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{driver.ConfigKey: string(data)},
+	}, nil
+}
+
+// applyDriverConfig creates the driver ConfigMap or updates it if the config
+// is changed.
+func applyDriverConfig(ctx context.Context, kubeClient kubernetes.Interface, lbcf *v1.LBCF) error {
+	cm, err := driverConfigMap(lbcf)
+	if err != nil {
+		return err
+	}
+	existing, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cm.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if existing.Data[driver.ConfigKey] == cm.Data[driver.ConfigKey] {
+		return nil
+	}
+	existing = existing.DeepCopy()
+	existing.Data = cm.Data
+	_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+func (c *Controller) ensureDriverConfig(ctx context.Context, lbcf *v1.LBCF) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, lbcf.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	return applyDriverConfig(ctx, kubeClient, lbcf)
+}
@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+// Bundle is the declarative manifests of an addon version, which are applied
+// in order and deleted in reverse order.
+type Bundle []runtime.Object
+
+// Apply creates or updates the objects of bundle.
+func (b Bundle) Apply(ctx context.Context, kubeClient kubernetes.Interface) error {
+	for _, obj := range b {
+		if err := apply(ctx, kubeClient, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func apply(ctx context.Context, kubeClient kubernetes.Interface, obj runtime.Object) error {
+	switch o := obj.(type) {
+	case *corev1.ServiceAccount:
+		return apiclient.CreateOrUpdateServiceAccount(ctx, kubeClient, o)
+	case *corev1.ConfigMap:
+		return apiclient.CreateOrUpdateConfigMap(ctx, kubeClient, o)
+	case *corev1.Secret:
+		return apiclient.CreateOrUpdateSecret(ctx, kubeClient, o)
+	case *corev1.Service:
+		return apiclient.CreateOrUpdateService(ctx, kubeClient, o)
+	case *rbacv1.ClusterRole:
+		return apiclient.CreateOrUpdateClusterRole(ctx, kubeClient, o)
+	case *rbacv1.ClusterRoleBinding:
+		return apiclient.CreateOrUpdateClusterRoleBinding(ctx, kubeClient, o)
+	case *rbacv1.Role:
+		return apiclient.CreateOrUpdateRole(ctx, kubeClient, o)
+	case *rbacv1.RoleBinding:
+		return apiclient.CreateOrUpdateRoleBinding(ctx, kubeClient, o)
+	case *appsv1.Deployment:
+		return apiclient.CreateOrUpdateDeployment(ctx, kubeClient, o)
+	case *appsv1.DaemonSet:
+		return apiclient.CreateOrUpdateDaemonSet(ctx, kubeClient, o)
+	case *appsv1.StatefulSet:
+		return apiclient.CreateOrUpdateStatefulSet(ctx, kubeClient, o)
+	}
+	return fmt.Errorf("unsupported object %s in addon bundle", kindOf(obj))
+}
+
+// Delete deletes the objects of bundle, the objects not found are ignored.
+func (b Bundle) Delete(ctx context.Context, kubeClient kubernetes.Interface) error {
+	for i := len(b) - 1; i >= 0; i-- {
+		if err := deleteObject(ctx, kubeClient, b[i]); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteObject(ctx context.Context, kubeClient kubernetes.Interface, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return err
+	}
+	ns, name := accessor.GetNamespace(), accessor.GetName()
+	opts := metav1.DeleteOptions{}
+	switch obj.(type) {
+	case *corev1.ServiceAccount:
+		return kubeClient.CoreV1().ServiceAccounts(ns).Delete(ctx, name, opts)
+	case *corev1.ConfigMap:
+		return kubeClient.CoreV1().ConfigMaps(ns).Delete(ctx, name, opts)
+	case *corev1.Secret:
+		return kubeClient.CoreV1().Secrets(ns).Delete(ctx, name, opts)
+	case *corev1.Service:
+		return kubeClient.CoreV1().Services(ns).Delete(ctx, name, opts)
+	case *rbacv1.ClusterRole:
+		return kubeClient.RbacV1().ClusterRoles().Delete(ctx, name, opts)
+	case *rbacv1.ClusterRoleBinding:
+		return kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, name, opts)
+	case *rbacv1.Role:
+		return kubeClient.RbacV1().Roles(ns).Delete(ctx, name, opts)
+	case *rbacv1.RoleBinding:
+		return kubeClient.RbacV1().RoleBindings(ns).Delete(ctx, name, opts)
+	case *appsv1.Deployment:
+		return kubeClient.AppsV1().Deployments(ns).Delete(ctx, name, opts)
+	case *appsv1.DaemonSet:
+		return kubeClient.AppsV1().DaemonSets(ns).Delete(ctx, name, opts)
+	case *appsv1.StatefulSet:
+		return kubeClient.AppsV1().StatefulSets(ns).Delete(ctx, name, opts)
+	}
+	return fmt.Errorf("unsupported object %s in addon bundle", kindOf(obj))
+}
+
+// Prune deletes the objects of bundle which are not in the kept bundle, such
+// as the objects added by a version which is rolled back.
+func (b Bundle) Prune(ctx context.Context, kubeClient kubernetes.Interface, kept Bundle) error {
+	return b.Difference(kept).Delete(ctx, kubeClient)
+}
+
+// Difference returns the objects of bundle which are not in other.
+func (b Bundle) Difference(other Bundle) Bundle {
+	keys := make(map[string]bool, len(other))
+	for _, obj := range other {
+		keys[keyOf(obj)] = true
+	}
+	var result Bundle
+	for _, obj := range b {
+		if !keys[keyOf(obj)] {
+			result = append(result, obj)
+		}
+	}
+	return result
+}
+
+func keyOf(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kindOf(obj)
+	}
+	return fmt.Sprintf("%s/%s/%s", kindOf(obj), accessor.GetNamespace(), accessor.GetName())
+}
+
+func kindOf(obj runtime.Object) string {
+	return fmt.Sprintf("%T", obj)
+}
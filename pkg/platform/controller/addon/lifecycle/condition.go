@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+// The condition types reported by all addons.
+const (
+	// ConditionInstalled is true once the bundle of addon is applied.
+	ConditionInstalled = "Installed"
+	// ConditionHealthy is true if the workloads of addon are rolled out.
+	ConditionHealthy = "Healthy"
+	// ConditionUpgraded is true once the addon is upgraded to the version of
+	// condition, and false if the upgrade is rolled back.
+	ConditionUpgraded = "Upgraded"
+	// ConditionSynced is true if the components of addon in cluster are in
+	// the desired state, and false if the drifts are reported only.
+	ConditionSynced = "Synced"
+)
+
+// The reasons of conditions.
+const (
+	ReasonDependenciesNotReady = "DependenciesNotReady"
+	ReasonPreflightFailed      = "PreflightFailed"
+	ReasonInstallFailed        = "InstallFailed"
+	ReasonRetriesExceeded      = "RetriesExceeded"
+	ReasonUnhealthy            = "Unhealthy"
+	ReasonUpgrading            = "Upgrading"
+	ReasonRolledBack           = "RolledBack"
+	ReasonRollbackFailed       = "RollbackFailed"
+)
+
+// GetCondition returns the condition of type, nil if not found.
+func GetCondition(conditions []v1.AddonCondition, conditionType string) *v1.AddonCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// SetCondition returns a copy of conditions with the condition set, the
+// transition time is kept unless the status changed.
+func SetCondition(conditions []v1.AddonCondition, condition v1.AddonCondition) []v1.AddonCondition {
+	conditions = append([]v1.AddonCondition(nil), conditions...)
+	now := metav1.Now()
+	condition.LastProbeTime = now
+	condition.LastTransitionTime = now
+	for i := range conditions {
+		if conditions[i].Type != condition.Type {
+			continue
+		}
+		if conditions[i].Status == condition.Status {
+			condition.LastTransitionTime = conditions[i].LastTransitionTime
+		}
+		conditions[i] = condition
+		return conditions
+	}
+	return append(conditions, condition)
+}
+
+// sameCondition returns whether the condition of the same type in conditions
+// has the same status, version, reason and message.
+func sameCondition(conditions []v1.AddonCondition, condition v1.AddonCondition) bool {
+	existing := GetCondition(conditions, condition.Type)
+	return existing != nil && existing.Status == condition.Status && existing.Version == condition.Version &&
+		existing.Reason == condition.Reason && existing.Message == condition.Message
+}
+
+// IsConditionTrue returns whether the condition of type is true.
+func IsConditionTrue(conditions []v1.AddonCondition, conditionType string) bool {
+	condition := GetCondition(conditions, conditionType)
+	return condition != nil && condition.Status == v1.ConditionTrue
+}
+
+func newCondition(conditionType string, status v1.ConditionStatus, version string, reason string, message string) v1.AddonCondition {
+	return v1.AddonCondition{
+		Type:    conditionType,
+		Status:  status,
+		Version: version,
+		Reason:  reason,
+		Message: message,
+	}
+}
+
+// isRolledBack returns whether the upgrade to version has been rolled back,
+// which is not tried again until the version of spec is changed.
+func isRolledBack(status Status, version string) bool {
+	condition := GetCondition(status.Conditions, ConditionUpgraded)
+	return condition != nil && condition.Status == v1.ConditionFalse &&
+		condition.Reason == ReasonRolledBack && condition.Version == version
+}
@@ -0,0 +1,907 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package lifecycle implements the lifecycle shared by addons, which installs
+// the manifest bundle of the addon version, probes the health of workloads,
+// retries the failed installation, and upgrades the addon with rollback. The
+// same phases and conditions are reported by all addons built on it.
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	v1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/controller/addon/dependency"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	clientRetryCount    = 5
+	clientRetryInterval = 5 * time.Second
+
+	maxRetryCount  = 5
+	timeOut        = 5 * time.Minute
+	probeInterval  = 5 * time.Second
+	healthInterval = 5 * time.Minute
+)
+
+// Status is the status shared by all addon objects.
+type Status struct {
+	Version                     string
+	Phase                       v1.AddonPhase
+	Reason                      string
+	RetryCount                  int32
+	LastReInitializingTimestamp metav1.Time
+	Conditions                  []v1.AddonCondition
+}
+
+// Object is an addon object adapted to the lifecycle.
+type Object interface {
+	GetName() string
+	GetUID() types.UID
+	// ClusterName is the cluster which the addon is installed in.
+	ClusterName() string
+	// Version is the desired version of spec.
+	Version() string
+	// DriftPolicy defines how to handle the components which drift from the
+	// desired state, an empty policy means Repair.
+	DriftPolicy() v1.AddonDriftPolicy
+	GetStatus() Status
+	// WithStatus returns a copy of object with the status.
+	WithStatus(status Status) Object
+}
+
+// Catalog renders the manifest bundles of the versions of an addon.
+type Catalog interface {
+	// Bundle renders the manifests of the version for the addon object, an
+	// error is returned if the version is not in the catalog.
+	Bundle(version string, obj Object) (Bundle, error)
+}
+
+// Addon adapts an addon type to the lifecycle.
+type Addon interface {
+	Catalog
+	// Kind is the kind of addon, such as CronHPA.
+	Kind() string
+	// Get returns the addon object of name from the lister.
+	Get(name string) (Object, error)
+	// UpdateStatus persists the status of the addon object.
+	UpdateStatus(ctx context.Context, obj Object) error
+}
+
+// Preflighter is optionally implemented by the addons which check the cluster
+// before installing, such as the conflicts of CRDs.
+type Preflighter interface {
+	// Preflight returns the object with the status specific to the addon, and
+	// an error if the addon can not be installed until the cluster or spec is
+	// changed. The addon fails without retrying, and the preflight is run
+	// again on each resync until it passes.
+	Preflight(ctx context.Context, cluster *v1.Cluster, obj Object) (Object, error)
+}
+
+// Configurer is optionally implemented by the addons which configure the
+// cluster once their workloads are rolled out, such as the custom resource
+// definitions registered by the workloads.
+type Configurer interface {
+	// Configure returns an error if the cluster can not be configured yet,
+	// it is retried while probing the addon.
+	Configure(ctx context.Context, cluster *v1.Cluster, obj Object) error
+}
+
+// Preparer is optionally implemented by the addons which prepare the cluster
+// for the bundle before it is applied, such as mirroring the images pulled by
+// the workloads.
+type Preparer interface {
+	// Prepare returns an error if the bundle of version can not be applied
+	// yet, which fails the installation or upgrade like applying it.
+	Prepare(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, version string, obj Object) error
+}
+
+// Observer is optionally implemented by the addons which report the status
+// specific to the addon once their workloads are healthy, such as the nodes
+// the addon runs on.
+type Observer interface {
+	// Observe returns the object with the status specific to the addon.
+	Observe(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, obj Object) (Object, error)
+}
+
+// Cleaner is optionally implemented by the addons which create the objects
+// out of the bundle, such as the generated secrets kept across upgrades.
+type Cleaner interface {
+	// Cleanup deletes the objects once the bundle of addon is deleted.
+	Cleanup(ctx context.Context, kubeClient kubernetes.Interface, obj Object) error
+}
+
+// Controller drives the addon objects through the phases of lifecycle.
+type Controller struct {
+	name           string
+	addon          Addon
+	client         clientset.Interface
+	mu             sync.Mutex // protects states
+	states         map[string]Object
+	health         sync.Map
+	checking       sync.Map
+	upgrading      sync.Map
+	reinitializing sync.Map
+	queue          workqueue.RateLimitingInterface
+	listerSynced   cache.InformerSynced
+	stopCh         <-chan struct{}
+}
+
+// NewController creates a new Controller object.
+func NewController(name string, client clientset.Interface, informer cache.SharedIndexInformer, addon Addon, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		name:   name,
+		addon:  addon,
+		client: client,
+		states: make(map[string]Object),
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), name),
+	}
+
+	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(name, client.PlatformV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				if !reflect.DeepEqual(oldObj, newObj) {
+					controller.enqueue(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueue,
+		},
+		resyncPeriod,
+	)
+	controller.listerSynced = informer.HasSynced
+
+	return controller
+}
+
+// obj could be an addon object, or a DeletionFinalStateUnknown marker item.
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info(fmt.Sprintf("Starting %s controller", c.addon.Kind()))
+	defer log.Info(fmt.Sprintf("Shutting down %s controller", c.addon.Kind()))
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for %s caches to sync", c.addon.Kind())
+	}
+
+	c.stopCh = stopCh
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.sync(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing %s %v (will retry): %v", c.addon.Kind(), key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) sync(key string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info(fmt.Sprintf("Finished syncing %s", c.addon.Kind()), log.String("name", key), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	obj, err := c.addon.Get(name)
+	switch {
+	case errors.IsNotFound(err):
+		log.Info(fmt.Sprintf("%s has been deleted. Attempting to cleanup resources", c.addon.Kind()), log.String("name", key))
+		err = c.processDeletion(ctx, key)
+	case err != nil:
+		log.Warn(fmt.Sprintf("Unable to retrieve %s from store", c.addon.Kind()), log.String("name", key), log.Err(err))
+	default:
+		err = c.processUpdate(ctx, key, obj)
+	}
+	return err
+}
+
+func (c *Controller) getState(key string) Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.states[key]
+}
+
+func (c *Controller) setState(key string, obj Object) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if obj == nil {
+		delete(c.states, key)
+	} else {
+		c.states[key] = obj
+	}
+}
+
+func (c *Controller) processDeletion(ctx context.Context, key string) error {
+	state := c.getState(key)
+	if state == nil {
+		log.Error(fmt.Sprintf("%s not in cache even though the watcher thought it was. Ignoring the deletion", c.addon.Kind()), log.String("name", key))
+		return nil
+	}
+	c.setState(key, nil)
+	c.health.Delete(key)
+	c.checking.Delete(key)
+	c.upgrading.Delete(key)
+	return c.uninstall(ctx, state)
+}
+
+func (c *Controller) processUpdate(ctx context.Context, key string, obj Object) error {
+	// the object is recreated with the same name
+	if state := c.getState(key); state != nil && state.GetUID() != obj.GetUID() {
+		if err := c.processDeletion(ctx, key); err != nil {
+			return err
+		}
+	}
+	if err := c.reconcile(ctx, key, obj); err != nil {
+		return err
+	}
+	// Always update the cache upon success.
+	c.setState(key, obj)
+	return nil
+}
+
+func (c *Controller) reconcile(ctx context.Context, key string, obj Object) error {
+	status := obj.GetStatus()
+	switch status.Phase {
+	case v1.AddonPhaseInitializing:
+		log.Info(fmt.Sprintf("%s will be created", c.addon.Kind()), log.String("name", key))
+		return c.initialize(ctx, obj)
+	case v1.AddonPhaseReinitializing:
+		if _, ok := c.reinitializing.Load(key); !ok {
+			c.reinitializing.Store(key, true)
+			waitTime := timeOut - time.Since(status.LastReInitializingTimestamp.Time)
+			if waitTime <= 0 {
+				waitTime = time.Duration(1)
+			}
+			go func() {
+				defer c.reinitializing.Delete(key)
+				_ = wait.Poll(waitTime, timeOut, c.reinitialize(ctx, obj))
+			}()
+		}
+	case v1.AddonPhaseChecking:
+		if _, ok := c.checking.Load(key); !ok {
+			c.checking.Store(key, true)
+			deadline := time.Now().Add(timeOut)
+			go func() {
+				defer c.checking.Delete(key)
+				_ = wait.PollImmediate(probeInterval, timeOut, c.check(ctx, key, deadline))
+			}()
+		}
+	case v1.AddonPhaseRunning:
+		if needUpgrade(obj) {
+			c.health.Delete(key)
+			status.Phase = v1.AddonPhaseUpgrading
+			status.Reason = ""
+			status.RetryCount = 0
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionUpgraded, v1.ConditionUnknown, obj.Version(), ReasonUpgrading, fmt.Sprintf("upgrading from %s", status.Version)))
+			return c.persistUpdate(ctx, obj.WithStatus(status))
+		}
+		if previous, changed := c.specChanged(key, obj); changed {
+			return c.reapply(ctx, obj, previous)
+		}
+		c.watchHealth(ctx, key)
+	case v1.AddonPhaseUpgrading:
+		if _, ok := c.upgrading.Load(key); !ok {
+			c.upgrading.Store(key, true)
+			go func() {
+				defer c.upgrading.Delete(key)
+				if err := c.upgrade(ctx, obj); err != nil {
+					log.Error(fmt.Sprintf("Upgrade %s error", c.addon.Kind()), log.String("name", key), log.Err(err))
+				}
+			}()
+		}
+	case v1.AddonPhaseFailed:
+		log.Info(fmt.Sprintf("%s is error", c.addon.Kind()), log.String("name", key))
+		condition := GetCondition(status.Conditions, ConditionInstalled)
+		if condition != nil && condition.Reason == ReasonPreflightFailed {
+			return c.retryPreflight(ctx, obj)
+		}
+		// the addon installed recovers once its workloads are healthy again
+		if IsConditionTrue(status.Conditions, ConditionInstalled) {
+			c.watchHealth(ctx, key)
+		}
+	}
+	return nil
+}
+
+// specChanged returns whether the bundle rendered by the spec of the same
+// version is changed since last synced, such as the configuration, and the
+// bundle rendered last time.
+func (c *Controller) specChanged(key string, obj Object) (Bundle, bool) {
+	state := c.getState(key)
+	if state == nil || state.GetUID() != obj.GetUID() {
+		return nil, false
+	}
+	version := obj.GetStatus().Version
+	previous, err := c.bundle(version, state)
+	if err != nil {
+		return nil, false
+	}
+	current, err := c.bundle(version, obj)
+	if err != nil {
+		return nil, false
+	}
+	return previous, !reflect.DeepEqual(previous, current)
+}
+
+// reapply applies the bundle of the changed spec, deletes the objects removed
+// from the previous bundle, and checks the addon again.
+func (c *Controller) reapply(ctx context.Context, obj Object, previous Bundle) error {
+	log.Info(fmt.Sprintf("%s spec is changed, apply it again", c.addon.Kind()), log.String("name", obj.GetName()))
+	cluster, kubeClient, err := c.clients(ctx, obj)
+	if err != nil {
+		return err
+	}
+	version := obj.GetStatus().Version
+	bundle, err := c.bundle(version, obj)
+	if err != nil {
+		return err
+	}
+	if err := c.prepare(ctx, cluster, kubeClient, version, obj); err != nil {
+		return err
+	}
+	if err := bundle.Apply(ctx, kubeClient); err != nil {
+		return err
+	}
+	if err := previous.Prune(ctx, kubeClient, bundle); err != nil {
+		return err
+	}
+	c.health.Delete(obj.GetName())
+	status := obj.GetStatus()
+	status.Phase = v1.AddonPhaseChecking
+	status.Reason = ""
+	return c.persistUpdate(ctx, obj.WithStatus(status))
+}
+
+// needUpgrade returns whether the version of spec is changed, the version
+// rolled back is not upgraded to again.
+func needUpgrade(obj Object) bool {
+	status := obj.GetStatus()
+	return obj.Version() != status.Version && !isRolledBack(status, obj.Version())
+}
+
+func (c *Controller) clients(ctx context.Context, obj Object) (*v1.Cluster, kubernetes.Interface, error) {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, obj.ClusterName(), metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return nil, nil, err
+	}
+	return cluster, kubeClient, nil
+}
+
+// bundle renders the bundle of version, whose objects are stamped with the
+// ownership and the hash to detect drifts.
+func (c *Controller) bundle(version string, obj Object) (Bundle, error) {
+	bundle, err := c.addon.Bundle(version, obj)
+	if err != nil {
+		return nil, err
+	}
+	return bundle.Stamp(strings.ToLower(c.addon.Kind()))
+}
+
+// preflight runs the preflight of addon if implemented.
+func (c *Controller) preflight(ctx context.Context, cluster *v1.Cluster, obj Object) (Object, error) {
+	preflighter, ok := c.addon.(Preflighter)
+	if !ok {
+		return obj, nil
+	}
+	return preflighter.Preflight(ctx, cluster, obj)
+}
+
+func (c *Controller) initialize(ctx context.Context, obj Object) error {
+	cluster, kubeClient, err := c.clients(ctx, obj)
+	if err == nil {
+		if dependencyErr := dependency.Ensure(ctx, c.client, cluster, c.addon.Kind()); dependencyErr != nil {
+			return c.waitDependencies(ctx, obj, dependencyErr)
+		}
+		var preflightErr error
+		obj, preflightErr = c.preflight(ctx, cluster, obj)
+		if preflightErr != nil {
+			log.Warn(fmt.Sprintf("%s preflight failed", c.addon.Kind()), log.String("name", obj.GetName()), log.String("clusterName", obj.ClusterName()), log.Err(preflightErr))
+			status := obj.GetStatus()
+			status.Version = obj.Version()
+			status.Phase = v1.AddonPhaseFailed
+			status.Reason = preflightErr.Error()
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionFalse, obj.Version(), ReasonPreflightFailed, preflightErr.Error()))
+			return c.persistUpdate(ctx, obj.WithStatus(status))
+		}
+		err = c.install(ctx, cluster, kubeClient, obj)
+	}
+
+	status := obj.GetStatus()
+	status.Version = obj.Version()
+	if err == nil {
+		status.Phase = v1.AddonPhaseChecking
+		status.Reason = ""
+		status.RetryCount = 0
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionTrue, obj.Version(), "", ""))
+		return c.persistUpdate(ctx, obj.WithStatus(status))
+	}
+	status.Phase = v1.AddonPhaseReinitializing
+	status.Reason = err.Error()
+	status.RetryCount = 1
+	status.LastReInitializingTimestamp = metav1.Now()
+	status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionFalse, obj.Version(), ReasonInstallFailed, err.Error()))
+	return c.persistUpdate(ctx, obj.WithStatus(status))
+}
+
+// waitDependencies keeps the addon initializing until the addons it requires
+// are running, the error is returned to retry later.
+func (c *Controller) waitDependencies(ctx context.Context, obj Object, dependencyErr error) error {
+	status := obj.GetStatus()
+	if status.Reason != dependencyErr.Error() {
+		status.Reason = dependencyErr.Error()
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionUnknown, obj.Version(), ReasonDependenciesNotReady, dependencyErr.Error()))
+		if err := c.persistUpdate(ctx, obj.WithStatus(status)); err != nil {
+			return err
+		}
+	}
+	return dependencyErr
+}
+
+func (c *Controller) install(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, obj Object) error {
+	bundle, err := c.bundle(obj.Version(), obj)
+	if err != nil {
+		return err
+	}
+	if err := c.prepare(ctx, cluster, kubeClient, obj.Version(), obj); err != nil {
+		return err
+	}
+	return bundle.Apply(ctx, kubeClient)
+}
+
+// prepare runs the preparation of addon for the bundle of version if
+// implemented.
+func (c *Controller) prepare(ctx context.Context, cluster *v1.Cluster, kubeClient kubernetes.Interface, version string, obj Object) error {
+	preparer, ok := c.addon.(Preparer)
+	if !ok {
+		return nil
+	}
+	return preparer.Prepare(ctx, cluster, kubeClient, version, obj)
+}
+
+// observe returns the object with the status observed by addon if
+// implemented.
+func (c *Controller) observe(ctx context.Context, obj Object) (Object, error) {
+	observer, ok := c.addon.(Observer)
+	if !ok {
+		return obj, nil
+	}
+	cluster, kubeClient, err := c.clients(ctx, obj)
+	if err != nil {
+		return obj, err
+	}
+	return observer.Observe(ctx, cluster, kubeClient, obj)
+}
+
+// reinitialize installs the addon again after an interval, the bundle is
+// deleted once the retries are exceeded.
+func (c *Controller) reinitialize(ctx context.Context, obj Object) func() (bool, error) {
+	// this func will always return true that keeps the poll once
+	return func() (bool, error) {
+		cluster, kubeClient, err := c.clients(ctx, obj)
+		if err == nil {
+			err = c.install(ctx, cluster, kubeClient, obj)
+		}
+		status := obj.GetStatus()
+		if err == nil {
+			status.Phase = v1.AddonPhaseChecking
+			status.Reason = ""
+			status.LastReInitializingTimestamp = metav1.Now()
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionTrue, obj.Version(), "", ""))
+			return true, c.persistUpdate(ctx, obj.WithStatus(status))
+		}
+		if status.RetryCount >= maxRetryCount {
+			if err := c.uninstall(ctx, obj); err != nil {
+				log.Error(fmt.Sprintf("Uninstall %s error", c.addon.Kind()), log.String("name", obj.GetName()), log.Err(err))
+			}
+			status.Phase = v1.AddonPhaseFailed
+			status.Reason = fmt.Sprintf("Install error and retried max(%d) times already.", maxRetryCount)
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionFalse, obj.Version(), ReasonRetriesExceeded, err.Error()))
+			return true, c.persistUpdate(ctx, obj.WithStatus(status))
+		}
+		// Add the retry count will trigger reinitialize function from the persistent controller again.
+		status.Phase = v1.AddonPhaseReinitializing
+		status.Reason = err.Error()
+		status.LastReInitializingTimestamp = metav1.Now()
+		status.RetryCount++
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionFalse, obj.Version(), ReasonInstallFailed, err.Error()))
+		return true, c.persistUpdate(ctx, obj.WithStatus(status))
+	}
+}
+
+// retryPreflight installs the addon again once its preflight passes, either
+// the cluster or the spec is changed.
+func (c *Controller) retryPreflight(ctx context.Context, obj Object) error {
+	cluster, _, err := c.clients(ctx, obj)
+	if err != nil {
+		return err
+	}
+	updated, preflightErr := c.preflight(ctx, cluster, obj)
+	status := obj.GetStatus()
+	if preflightErr != nil {
+		if preflightErr.Error() == status.Reason && reflect.DeepEqual(obj, updated) {
+			return nil
+		}
+		status.Reason = preflightErr.Error()
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionFalse, obj.Version(), ReasonPreflightFailed, preflightErr.Error()))
+		return c.persistUpdate(ctx, updated.WithStatus(status))
+	}
+	status.Phase = v1.AddonPhaseInitializing
+	status.Reason = ""
+	status.Conditions = SetCondition(status.Conditions, newCondition(ConditionInstalled, v1.ConditionUnknown, obj.Version(), "", ""))
+	return c.persistUpdate(ctx, updated.WithStatus(status))
+}
+
+// check probes the addon until its workloads are healthy or the deadline.
+func (c *Controller) check(ctx context.Context, key string, deadline time.Time) func() (bool, error) {
+	return func() (bool, error) {
+		if _, ok := c.checking.Load(key); !ok {
+			log.Debug(fmt.Sprintf("Checking over %s addon status", c.addon.Kind()))
+			return true, nil
+		}
+		obj, err := c.addon.Get(key)
+		if err != nil {
+			return false, err
+		}
+		if obj.GetStatus().Phase != v1.AddonPhaseChecking {
+			return true, nil
+		}
+		probeErr := c.probe(ctx, obj)
+		if probeErr != nil && time.Now().Before(deadline) {
+			return false, nil
+		}
+		status := obj.GetStatus()
+		if probeErr != nil {
+			status.Phase = v1.AddonPhaseFailed
+			status.Reason = fmt.Sprintf("%s is not healthy.", c.addon.Kind())
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionHealthy, v1.ConditionFalse, status.Version, ReasonUnhealthy, probeErr.Error()))
+		} else {
+			observed, err := c.observe(ctx, obj)
+			if err != nil {
+				log.Warn(fmt.Sprintf("Failed to observe %s", c.addon.Kind()), log.String("name", key), log.Err(err))
+				return false, nil
+			}
+			obj = observed
+			status.Phase = v1.AddonPhaseRunning
+			status.Reason = ""
+			status.Conditions = SetCondition(status.Conditions, newCondition(ConditionHealthy, v1.ConditionTrue, status.Version, "", ""))
+		}
+		if err := c.persistUpdate(ctx, obj.WithStatus(status)); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+}
+
+// probe returns an error if the workloads of addon are not rolled out, or the
+// cluster is not configured by the addon yet.
+func (c *Controller) probe(ctx context.Context, obj Object) error {
+	cluster, kubeClient, err := c.clients(ctx, obj)
+	if err != nil {
+		return err
+	}
+	bundle, err := c.bundle(obj.GetStatus().Version, obj)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Probe(ctx, kubeClient); err != nil {
+		return err
+	}
+	return c.configure(ctx, cluster, obj)
+}
+
+// configure runs the configuration of addon if implemented.
+func (c *Controller) configure(ctx context.Context, cluster *v1.Cluster, obj Object) error {
+	configurer, ok := c.addon.(Configurer)
+	if !ok {
+		return nil
+	}
+	return configurer.Configure(ctx, cluster, obj)
+}
+
+// watchHealth probes the running or failed addon periodically, the phase is
+// switched between them by the result.
+func (c *Controller) watchHealth(ctx context.Context, key string) {
+	if _, ok := c.health.Load(key); ok {
+		return
+	}
+	c.health.Store(key, true)
+	go func() {
+		defer c.health.Delete(key)
+		_ = wait.PollImmediateUntil(healthInterval, func() (bool, error) {
+			if _, ok := c.health.Load(key); !ok {
+				log.Info("Health check over.")
+				return true, nil
+			}
+			obj, err := c.addon.Get(key)
+			if err != nil {
+				return errors.IsNotFound(err), nil
+			}
+			status := obj.GetStatus()
+			if status.Phase != v1.AddonPhaseRunning && status.Phase != v1.AddonPhaseFailed {
+				return true, nil
+			}
+			changed := false
+			if condition, ok := c.detectDrift(ctx, obj); ok && !sameCondition(status.Conditions, condition) {
+				status.Conditions = SetCondition(status.Conditions, condition)
+				changed = true
+			}
+			log.Info(fmt.Sprintf("Start check %s in cluster health", c.addon.Kind()), log.String("name", key))
+			probeErr := c.probe(ctx, obj)
+			switch {
+			case probeErr != nil && status.Phase == v1.AddonPhaseRunning:
+				status.Phase = v1.AddonPhaseFailed
+				status.Reason = fmt.Sprintf("%s is not healthy.", c.addon.Kind())
+				status.Conditions = SetCondition(status.Conditions, newCondition(ConditionHealthy, v1.ConditionFalse, status.Version, ReasonUnhealthy, probeErr.Error()))
+				changed = true
+			case probeErr == nil && status.Phase == v1.AddonPhaseFailed:
+				log.Info(fmt.Sprintf("%s is healthy again", c.addon.Kind()), log.String("name", key))
+				status.Phase = v1.AddonPhaseRunning
+				status.Reason = ""
+				status.Conditions = SetCondition(status.Conditions, newCondition(ConditionHealthy, v1.ConditionTrue, status.Version, "", ""))
+				changed = true
+			}
+			if probeErr == nil {
+				// the status specific to the addon may change since last check
+				if observed, err := c.observe(ctx, obj); err != nil {
+					log.Warn(fmt.Sprintf("Failed to observe %s", c.addon.Kind()), log.String("name", key), log.Err(err))
+				} else if !reflect.DeepEqual(observed, obj) {
+					obj = observed
+					changed = true
+				}
+			}
+			if !changed {
+				return false, nil
+			}
+			if err := c.persistUpdate(ctx, obj.WithStatus(status)); err != nil {
+				log.Warn(fmt.Sprintf("Update %s health error", c.addon.Kind()), log.String("name", key), log.Err(err))
+			}
+			return false, nil
+		}, c.stopCh)
+	}()
+}
+
+// detectDrift compares the components of addon in cluster with the desired
+// state, the drifts are repaired unless the policy is Report. The condition
+// Synced is returned, false if the drifts can not be detected.
+func (c *Controller) detectDrift(ctx context.Context, obj Object) (v1.AddonCondition, bool) {
+	version := obj.GetStatus().Version
+	_, kubeClient, err := c.clients(ctx, obj)
+	if err != nil {
+		log.Warn(fmt.Sprintf("Failed to detect the drifts of %s", c.addon.Kind()), log.String("name", obj.GetName()), log.Err(err))
+		return v1.AddonCondition{}, false
+	}
+	bundle, err := c.bundle(version, obj)
+	if err != nil {
+		log.Warn(fmt.Sprintf("Failed to detect the drifts of %s", c.addon.Kind()), log.String("name", obj.GetName()), log.Err(err))
+		return v1.AddonCondition{}, false
+	}
+	drifts, err := bundle.Drift(ctx, kubeClient)
+	if err != nil {
+		log.Warn(fmt.Sprintf("Failed to detect the drifts of %s", c.addon.Kind()), log.String("name", obj.GetName()), log.Err(err))
+		return v1.AddonCondition{}, false
+	}
+	if len(drifts) == 0 {
+		return newCondition(ConditionSynced, v1.ConditionTrue, version, "", ""), true
+	}
+
+	message := describeDrifts(drifts)
+	log.Warn(fmt.Sprintf("%s drifts from the desired state", c.addon.Kind()), log.String("name", obj.GetName()), log.String("clusterName", obj.ClusterName()), log.String("drifts", message))
+	if obj.DriftPolicy() == v1.AddonDriftReport {
+		return newCondition(ConditionSynced, v1.ConditionFalse, version, ReasonDrifted, message), true
+	}
+	drifted := make(Bundle, 0, len(drifts))
+	for _, drift := range drifts {
+		drifted = append(drifted, drift.Object)
+	}
+	if err := drifted.Apply(ctx, kubeClient); err != nil {
+		return newCondition(ConditionSynced, v1.ConditionFalse, version, ReasonDrifted, fmt.Sprintf("%s, repair failed: %v", message, err)), true
+	}
+	log.Info(fmt.Sprintf("%s is repaired", c.addon.Kind()), log.String("name", obj.GetName()), log.String("clusterName", obj.ClusterName()))
+	return newCondition(ConditionSynced, v1.ConditionTrue, version, ReasonRepaired, "repaired "+message), true
+}
+
+// upgrade applies the bundle of the spec version and waits for it rolling
+// out, the bundle of the previous version is applied back if it fails.
+func (c *Controller) upgrade(ctx context.Context, obj Object) error {
+	log.Info(fmt.Sprintf("Start to upgrade %s", c.addon.Kind()), log.String("name", obj.GetName()), log.String("version", obj.Version()))
+	status := obj.GetStatus()
+	from, to := status.Version, obj.Version()
+	cluster, kubeClient, err := c.clients(ctx, obj)
+	if err != nil {
+		return err
+	}
+
+	target, err := c.bundle(to, obj)
+	if err == nil {
+		err = c.prepare(ctx, cluster, kubeClient, to, obj)
+	}
+	if err == nil {
+		err = target.Apply(ctx, kubeClient)
+	}
+	if err == nil {
+		var probeErr error
+		err = wait.PollImmediate(probeInterval, timeOut, func() (bool, error) {
+			probeErr = target.Probe(ctx, kubeClient)
+			if probeErr == nil {
+				probeErr = c.configure(ctx, cluster, obj)
+			}
+			return probeErr == nil, nil
+		})
+		if err == wait.ErrWaitTimeout {
+			err = fmt.Errorf("%s is not healthy: %v", c.addon.Kind(), probeErr)
+		}
+	}
+	if err == nil {
+		status.Version = to
+		status.Phase = v1.AddonPhaseRunning
+		status.Reason = ""
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionUpgraded, v1.ConditionTrue, to, "", fmt.Sprintf("upgraded from %s", from)))
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionHealthy, v1.ConditionTrue, to, "", ""))
+		return c.persistUpdate(ctx, obj.WithStatus(status))
+	}
+
+	log.Warn(fmt.Sprintf("Upgrade %s failed, roll back", c.addon.Kind()), log.String("name", obj.GetName()), log.String("from", from), log.String("to", to), log.Err(err))
+	message := fmt.Sprintf("upgrade from %s failed: %v", from, err)
+	previous, rollbackErr := c.bundle(from, obj)
+	if rollbackErr == nil {
+		rollbackErr = c.prepare(ctx, cluster, kubeClient, from, obj)
+	}
+	if rollbackErr == nil {
+		rollbackErr = previous.Apply(ctx, kubeClient)
+	}
+	if rollbackErr == nil && target != nil {
+		rollbackErr = target.Prune(ctx, kubeClient, previous)
+	}
+	if rollbackErr != nil {
+		status.Phase = v1.AddonPhaseFailed
+		status.Reason = fmt.Sprintf("Failed to upgrade %s.", c.addon.Kind())
+		status.Conditions = SetCondition(status.Conditions, newCondition(ConditionUpgraded, v1.ConditionFalse, to, ReasonRollbackFailed, fmt.Sprintf("%s, rollback failed: %v", message, rollbackErr)))
+		return c.persistUpdate(ctx, obj.WithStatus(status))
+	}
+	// the previous version is checked again
+	status.Phase = v1.AddonPhaseChecking
+	status.Reason = ""
+	status.Conditions = SetCondition(status.Conditions, newCondition(ConditionUpgraded, v1.ConditionFalse, to, ReasonRolledBack, message))
+	return c.persistUpdate(ctx, obj.WithStatus(status))
+}
+
+// uninstall deletes the bundle of the installed version, the components are
+// kept if the cluster is being deleted.
+func (c *Controller) uninstall(ctx context.Context, obj Object) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, obj.ClusterName(), metav1.GetOptions{})
+	if err != nil && errors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if cluster.Status.Phase == v1.ClusterTerminating {
+		log.Info(fmt.Sprintf("Keep the components of %s %s when deleting the cluster", c.addon.Kind(), obj.GetName()))
+		return nil
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	version := obj.GetStatus().Version
+	if version == "" {
+		version = obj.Version()
+	}
+	bundle, err := c.bundle(version, obj)
+	if err != nil {
+		return err
+	}
+	if err := bundle.Delete(ctx, kubeClient); err != nil {
+		return err
+	}
+	if cleaner, ok := c.addon.(Cleaner); ok {
+		return cleaner.Cleanup(ctx, kubeClient, obj)
+	}
+	return nil
+}
+
+func (c *Controller) persistUpdate(ctx context.Context, obj Object) error {
+	var err error
+	for i := 0; i < clientRetryCount; i++ {
+		err = c.addon.UpdateStatus(ctx, obj)
+		if err == nil {
+			return nil
+		}
+		// If the object no longer exists, we don't want to recreate it. Just bail
+		// out so that we can process the delete, which we should soon be receiving
+		// if we haven't already.
+		if errors.IsNotFound(err) {
+			log.Info(fmt.Sprintf("Not persisting update to %s that no longer exists", c.addon.Kind()), log.String("name", obj.GetName()), log.Err(err))
+			return nil
+		}
+		if errors.IsConflict(err) {
+			return fmt.Errorf("not persisting update to %s '%s' that has been changed since we received it: %v", c.addon.Kind(), obj.GetName(), err)
+		}
+		log.Warn(fmt.Sprintf("Failed to persist updated status of %s '%s/%s'", c.addon.Kind(), obj.GetName(), obj.GetStatus().Phase), log.String("clusterName", obj.ClusterName()), log.Err(err))
+		time.Sleep(clientRetryInterval)
+	}
+
+	return err
+}
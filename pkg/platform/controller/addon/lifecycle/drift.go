@@ -0,0 +1,206 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"tkestack.io/tke/pkg/platform/controller/addon/crdcheck"
+)
+
+// hashAnnotation is the hash of the desired state of a component.
+const hashAnnotation = "platform.tkestack.io/addon-hash"
+
+// The reasons of drifts.
+const (
+	// DriftMissing means the component is deleted.
+	DriftMissing = "Missing"
+	// DriftModified means the component is replaced or its images are changed.
+	DriftModified = "Modified"
+)
+
+// Drift is a component of addon which drifts from the desired state.
+type Drift struct {
+	Object runtime.Object
+	Reason string
+}
+
+func (d Drift) String() string {
+	return fmt.Sprintf("%s %s", nameOf(d.Object), d.Reason)
+}
+
+// Stamp returns a copy of bundle whose objects are labeled with the owner
+// addon and annotated with the hash of their desired state.
+func (b Bundle) Stamp(owner string) (Bundle, error) {
+	stamped := make(Bundle, 0, len(b))
+	for _, obj := range b {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		obj = obj.DeepCopyObject()
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return nil, err
+		}
+		labels := accessor.GetLabels()
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[crdcheck.OwnerLabel] = owner
+		accessor.SetLabels(labels)
+		annotations := accessor.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[hashAnnotation] = fmt.Sprintf("%x", sha256.Sum256(data))
+		accessor.SetAnnotations(annotations)
+		stamped = append(stamped, obj)
+	}
+	return stamped, nil
+}
+
+// Drift compares the objects of bundle deployed in cluster with their desired
+// state, an object is modified if its hash or the images of workload differ.
+func (b Bundle) Drift(ctx context.Context, kubeClient kubernetes.Interface) ([]Drift, error) {
+	var drifts []Drift
+	for _, desired := range b {
+		actual, err := getObject(ctx, kubeClient, desired)
+		if errors.IsNotFound(err) {
+			drifts = append(drifts, Drift{Object: desired, Reason: DriftMissing})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if modified(desired, actual) {
+			drifts = append(drifts, Drift{Object: desired, Reason: DriftModified})
+		}
+	}
+	return drifts, nil
+}
+
+func modified(desired runtime.Object, actual runtime.Object) bool {
+	desiredAccessor, err := meta.Accessor(desired)
+	if err != nil {
+		return false
+	}
+	actualAccessor, err := meta.Accessor(actual)
+	if err != nil {
+		return false
+	}
+	if desiredAccessor.GetAnnotations()[hashAnnotation] != actualAccessor.GetAnnotations()[hashAnnotation] {
+		return true
+	}
+	return !reflect.DeepEqual(imagesOf(desired), imagesOf(actual))
+}
+
+func imagesOf(obj runtime.Object) []string {
+	var spec *corev1.PodSpec
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		spec = &o.Spec.Template.Spec
+	case *appsv1.DaemonSet:
+		spec = &o.Spec.Template.Spec
+	case *appsv1.StatefulSet:
+		spec = &o.Spec.Template.Spec
+	default:
+		return nil
+	}
+	var images []string
+	for _, c := range spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range spec.Containers {
+		images = append(images, c.Image)
+	}
+	return images
+}
+
+func getObject(ctx context.Context, kubeClient kubernetes.Interface, obj runtime.Object) (runtime.Object, error) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return nil, err
+	}
+	ns, name := accessor.GetNamespace(), accessor.GetName()
+	opts := metav1.GetOptions{}
+	switch obj.(type) {
+	case *corev1.ServiceAccount:
+		return kubeClient.CoreV1().ServiceAccounts(ns).Get(ctx, name, opts)
+	case *corev1.ConfigMap:
+		return kubeClient.CoreV1().ConfigMaps(ns).Get(ctx, name, opts)
+	case *corev1.Secret:
+		return kubeClient.CoreV1().Secrets(ns).Get(ctx, name, opts)
+	case *corev1.Service:
+		return kubeClient.CoreV1().Services(ns).Get(ctx, name, opts)
+	case *rbacv1.ClusterRole:
+		return kubeClient.RbacV1().ClusterRoles().Get(ctx, name, opts)
+	case *rbacv1.ClusterRoleBinding:
+		return kubeClient.RbacV1().ClusterRoleBindings().Get(ctx, name, opts)
+	case *rbacv1.Role:
+		return kubeClient.RbacV1().Roles(ns).Get(ctx, name, opts)
+	case *rbacv1.RoleBinding:
+		return kubeClient.RbacV1().RoleBindings(ns).Get(ctx, name, opts)
+	case *appsv1.Deployment:
+		return kubeClient.AppsV1().Deployments(ns).Get(ctx, name, opts)
+	case *appsv1.DaemonSet:
+		return kubeClient.AppsV1().DaemonSets(ns).Get(ctx, name, opts)
+	case *appsv1.StatefulSet:
+		return kubeClient.AppsV1().StatefulSets(ns).Get(ctx, name, opts)
+	}
+	return nil, fmt.Errorf("unsupported object %s in addon bundle", kindOf(obj))
+}
+
+// nameOf returns the kind and name of object, such as Deployment kube-system/foo.
+func nameOf(obj runtime.Object) string {
+	kind := kindOf(obj)
+	if i := strings.LastIndex(kind, "."); i >= 0 {
+		kind = kind[i+1:]
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return kind
+	}
+	if accessor.GetNamespace() == "" {
+		return fmt.Sprintf("%s %s", kind, accessor.GetName())
+	}
+	return fmt.Sprintf("%s %s/%s", kind, accessor.GetNamespace(), accessor.GetName())
+}
+
+// describeDrifts joins the drifts into a message of condition.
+func describeDrifts(drifts []Drift) string {
+	messages := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		messages = append(messages, drift.String())
+	}
+	return strings.Join(messages, ", ")
+}
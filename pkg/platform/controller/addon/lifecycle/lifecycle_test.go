@@ -0,0 +1,198 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func deployment(name, image string) *appsv1.Deployment {
+	replicas := int32(1)
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": name}},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: name, Image: image}}},
+			},
+		},
+	}
+}
+
+func serviceAccount(name string) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem}}
+}
+
+func TestBundle(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	bundle := Bundle{serviceAccount("demo"), deployment("demo", "demo:v1")}
+
+	if err := bundle.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if err := bundle.Probe(ctx, client); err == nil {
+		t.Error("expected the deployment without available replicas to be unhealthy")
+	}
+
+	deploy, err := client.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, "demo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deploy.Status.UpdatedReplicas = 1
+	deploy.Status.AvailableReplicas = 1
+	if _, err := client.AppsV1().Deployments(metav1.NamespaceSystem).UpdateStatus(ctx, deploy, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bundle.Probe(ctx, client); err != nil {
+		t.Errorf("expected the rolled out deployment to be healthy: %v", err)
+	}
+
+	upgraded := Bundle{serviceAccount("demo"), deployment("demo", "demo:v2")}
+	if err := upgraded.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	deploy, err = client.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, "demo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if image := deploy.Spec.Template.Spec.Containers[0].Image; image != "demo:v2" {
+		t.Errorf("got image %s, want demo:v2", image)
+	}
+
+	if err := bundle.Delete(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	// deleting again ignores the objects not found
+	if err := bundle.Delete(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Get(ctx, "demo", metav1.GetOptions{}); err == nil {
+		t.Error("expected the service account to be deleted")
+	}
+}
+
+func TestBundleDifference(t *testing.T) {
+	previous := Bundle{serviceAccount("demo"), deployment("demo", "demo:v1")}
+	target := Bundle{serviceAccount("demo"), deployment("demo", "demo:v2"), deployment("demo-webhook", "webhook:v2")}
+
+	diff := target.Difference(previous)
+	if len(diff) != 1 {
+		t.Fatalf("got %d objects, want 1: %v", len(diff), diff)
+	}
+	if name := diff[0].(*appsv1.Deployment).Name; name != "demo-webhook" {
+		t.Errorf("got %s, want demo-webhook", name)
+	}
+	if diff := previous.Difference(target); len(diff) != 0 {
+		t.Errorf("got %d objects, want 0: %v", len(diff), diff)
+	}
+}
+
+func TestSetCondition(t *testing.T) {
+	conditions := SetCondition(nil, newCondition(ConditionHealthy, v1.ConditionTrue, "v1", "", ""))
+	transition := metav1.NewTime(conditions[0].LastTransitionTime.Add(-60e9))
+	conditions[0].LastTransitionTime = transition
+
+	same := SetCondition(conditions, newCondition(ConditionHealthy, v1.ConditionTrue, "v1", "", ""))
+	if !same[0].LastTransitionTime.Equal(&transition) {
+		t.Error("expected the transition time to be kept if the status is unchanged")
+	}
+
+	changed := SetCondition(conditions, newCondition(ConditionHealthy, v1.ConditionFalse, "v1", ReasonUnhealthy, "not ready"))
+	if changed[0].LastTransitionTime.Equal(&transition) {
+		t.Error("expected the transition time to be updated if the status is changed")
+	}
+	if conditions[0].Status != v1.ConditionTrue {
+		t.Error("expected the conditions passed in to be unchanged")
+	}
+	if IsConditionTrue(changed, ConditionHealthy) {
+		t.Error("expected the condition to be false")
+	}
+}
+
+func TestIsRolledBack(t *testing.T) {
+	status := Status{
+		Version: "v1",
+		Conditions: []v1.AddonCondition{
+			newCondition(ConditionUpgraded, v1.ConditionFalse, "v2", ReasonRolledBack, "upgrade from v1 failed"),
+		},
+	}
+	if !isRolledBack(status, "v2") {
+		t.Error("expected v2 to be rolled back")
+	}
+	if isRolledBack(status, "v3") {
+		t.Error("expected v3 to be upgraded")
+	}
+}
+
+func TestBundleDrift(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	bundle, err := Bundle{serviceAccount("demo"), deployment("demo", "demo:v1")}.Stamp("demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := bundle.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if drifts, err := bundle.Drift(ctx, client); err != nil || len(drifts) != 0 {
+		t.Fatalf("got drifts %v %v, want none", drifts, err)
+	}
+
+	deploy, err := client.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, "demo", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deploy.Spec.Template.Spec.Containers[0].Image = "demo:debug"
+	if _, err := client.AppsV1().Deployments(metav1.NamespaceSystem).Update(ctx, deploy, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Delete(ctx, "demo", metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+
+	drifts, err := bundle.Drift(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := describeDrifts(drifts), "ServiceAccount kube-system/demo Missing, Deployment kube-system/demo Modified"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// a bundle stamped again has the same hash
+	again, err := Bundle{serviceAccount("demo"), deployment("demo", "demo:v1")}.Stamp("demo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := again.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	if drifts, err := bundle.Drift(ctx, client); err != nil || len(drifts) != 0 {
+		t.Errorf("got drifts %v %v, want none after repaired", drifts, err)
+	}
+}
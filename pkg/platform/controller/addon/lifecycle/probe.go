@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Probe returns an error describing the first workload of bundle which is not
+// rolled out, the workloads are healthy if all their replicas are updated and
+// available.
+func (b Bundle) Probe(ctx context.Context, kubeClient kubernetes.Interface) error {
+	for _, obj := range b {
+		var err error
+		switch o := obj.(type) {
+		case *appsv1.Deployment:
+			err = probeDeployment(ctx, kubeClient, o)
+		case *appsv1.DaemonSet:
+			err = probeDaemonSet(ctx, kubeClient, o)
+		case *appsv1.StatefulSet:
+			err = probeStatefulSet(ctx, kubeClient, o)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func probeDeployment(ctx context.Context, kubeClient kubernetes.Interface, desired *appsv1.Deployment) error {
+	deploy, err := kubeClient.AppsV1().Deployments(desired.Namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	if deploy.Status.ObservedGeneration < deploy.Generation ||
+		deploy.Status.UpdatedReplicas < replicas ||
+		deploy.Status.AvailableReplicas < replicas {
+		return fmt.Errorf("deployment %s/%s has %d updated and %d available replicas of %d",
+			deploy.Namespace, deploy.Name, deploy.Status.UpdatedReplicas, deploy.Status.AvailableReplicas, replicas)
+	}
+	return nil
+}
+
+func probeDaemonSet(ctx context.Context, kubeClient kubernetes.Interface, desired *appsv1.DaemonSet) error {
+	ds, err := kubeClient.AppsV1().DaemonSets(desired.Namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ds.Status.ObservedGeneration < ds.Generation ||
+		ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled ||
+		ds.Status.NumberAvailable < ds.Status.DesiredNumberScheduled {
+		return fmt.Errorf("daemonset %s/%s has %d updated and %d available pods of %d",
+			ds.Namespace, ds.Name, ds.Status.UpdatedNumberScheduled, ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled)
+	}
+	return nil
+}
+
+func probeStatefulSet(ctx context.Context, kubeClient kubernetes.Interface, desired *appsv1.StatefulSet) error {
+	sts, err := kubeClient.AppsV1().StatefulSets(desired.Namespace).Get(ctx, desired.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	replicas := int32(1)
+	if sts.Spec.Replicas != nil {
+		replicas = *sts.Spec.Replicas
+	}
+	if sts.Status.ObservedGeneration < sts.Generation ||
+		sts.Status.UpdatedReplicas < replicas ||
+		sts.Status.ReadyReplicas < replicas {
+		return fmt.Errorf("statefulset %s/%s has %d updated and %d ready replicas of %d",
+			sts.Namespace, sts.Name, sts.Status.UpdatedReplicas, sts.Status.ReadyReplicas, replicas)
+	}
+	return nil
+}
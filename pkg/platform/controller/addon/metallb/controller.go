@@ -0,0 +1,485 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"tkestack.io/tke/pkg/platform/controller/addon/metallb/images"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+const (
+	controllerName        = "metallb-controller"
+	metalLBName           = "metallb"
+	cmMetalLBName         = "metallb-config"
+	secretMetalLBName     = "metallb-memberlist"
+	svcAccountMetalLBName = "metallb"
+	crbMetalLBName        = "metallb"
+	controllerDeployName  = "metallb-controller"
+	speakerDaemonSetName  = "metallb-speaker"
+
+	configKey      = "config"
+	secretKeyName  = "secretkey"
+	monitoringPort = 7472
+)
+
+// NewController creates a new controller which drives the MetalLBs through
+// the addon lifecycle.
+func NewController(client clientset.Interface, informer platformv1informer.MetalLBInformer, resyncPeriod time.Duration) *lifecycle.Controller {
+	addon := &metalLBAddon{
+		client: client,
+		lister: informer.Lister(),
+	}
+	return lifecycle.NewController(controllerName, client, informer.Informer(), addon, resyncPeriod)
+}
+
+// metalLBObject adapts the MetalLB to the addon lifecycle.
+type metalLBObject struct {
+	*v1.MetalLB
+}
+
+func (o metalLBObject) ClusterName() string {
+	return o.Spec.ClusterName
+}
+
+func (o metalLBObject) Version() string {
+	return o.Spec.Version
+}
+
+func (o metalLBObject) DriftPolicy() v1.AddonDriftPolicy {
+	return v1.AddonDriftRepair
+}
+
+func (o metalLBObject) GetStatus() lifecycle.Status {
+	return lifecycle.Status{
+		Version:                     o.Status.Version,
+		Phase:                       o.Status.Phase,
+		Reason:                      o.Status.Reason,
+		RetryCount:                  o.Status.RetryCount,
+		LastReInitializingTimestamp: o.Status.LastReInitializingTimestamp,
+		Conditions:                  o.Status.Conditions,
+	}
+}
+
+func (o metalLBObject) WithStatus(status lifecycle.Status) lifecycle.Object {
+	metalLB := o.DeepCopy()
+	metalLB.Status.Version = status.Version
+	metalLB.Status.Phase = status.Phase
+	metalLB.Status.Reason = status.Reason
+	metalLB.Status.RetryCount = status.RetryCount
+	metalLB.Status.LastReInitializingTimestamp = status.LastReInitializingTimestamp
+	metalLB.Status.Conditions = status.Conditions
+	return metalLBObject{metalLB}
+}
+
+type metalLBAddon struct {
+	client clientset.Interface
+	lister platformv1lister.MetalLBLister
+}
+
+func (a *metalLBAddon) Kind() string {
+	return "MetalLB"
+}
+
+func (a *metalLBAddon) Get(name string) (lifecycle.Object, error) {
+	metalLB, err := a.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return metalLBObject{metalLB}, nil
+}
+
+func (a *metalLBAddon) UpdateStatus(ctx context.Context, obj lifecycle.Object) error {
+	_, err := a.client.PlatformV1().MetalLBs().UpdateStatus(ctx, obj.(metalLBObject).MetalLB, metav1.UpdateOptions{})
+	return err
+}
+
+// Bundle renders the config of the address pools and peers, which is
+// reloaded by the controller and speakers, and runs them with the images of
+// the version. The memberlist secret is not in the bundle as its key is
+// generated once and kept across upgrades.
+func (a *metalLBAddon) Bundle(version string, obj lifecycle.Object) (lifecycle.Bundle, error) {
+	if err := images.Validate(version); err != nil {
+		return nil, err
+	}
+	cm, err := configMapMetalLB(obj.(metalLBObject).Spec)
+	if err != nil {
+		return nil, err
+	}
+	return lifecycle.Bundle{
+		serviceAccountMetalLB(),
+		crbMetalLB(),
+		cm,
+		deploymentMetalLB(version),
+		daemonSetMetalLB(version),
+	}, nil
+}
+
+// Preflight checks the kube-proxy of the cluster for the layer 2 pools, and
+// creates the memberlist secret which the speakers start with.
+func (a *metalLBAddon) Preflight(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) (lifecycle.Object, error) {
+	if err := checkStrictARP(cluster, obj.(metalLBObject).MetalLB); err != nil {
+		return obj, err
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, a.client.PlatformV1())
+	if err != nil {
+		return obj, err
+	}
+	return obj, ensureSecret(ctx, kubeClient)
+}
+
+// Cleanup deletes the memberlist secret.
+func (a *metalLBAddon) Cleanup(ctx context.Context, kubeClient kubernetes.Interface, obj lifecycle.Object) error {
+	err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, secretMetalLBName, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// checkStrictARP returns an error if the layer 2 mode is used in cluster whose
+// kube-proxy runs in ipvs mode without strict ARP, the nodes will answer the ARP
+// queries for the load balancer addresses bound on kube-ipvs0 in that case.
+func checkStrictARP(cluster *v1.Cluster, metalLB *v1.MetalLB) error {
+	if cluster.KubeProxyMode() != v1.KubeProxyModeIPVS {
+		return nil
+	}
+	if cluster.Spec.Features.KubeProxy != nil && cluster.Spec.Features.KubeProxy.StrictARP {
+		return nil
+	}
+	for _, pool := range metalLB.Spec.AddressPools {
+		if pool.Protocol == v1.MetalLBProtocolLayer2 {
+			return fmt.Errorf("address pool %q uses layer2 protocol, which requires strictARP of kube-proxy in ipvs mode", pool.Name)
+		}
+	}
+	return nil
+}
+
+// ensureSecret creates the memberlist secret if it does not exist, the key of
+// the existing secret is kept.
+func ensureSecret(ctx context.Context, kubeClient kubernetes.Interface) error {
+	secret, err := secretMetalLB()
+	if err != nil {
+		return err
+	}
+	_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+func serviceAccountMetalLB() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svcAccountMetalLBName,
+			Namespace: metav1.NamespaceSystem,
+		},
+	}
+}
+
+func crbMetalLB() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRoleBinding",
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crbMetalLBName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      svcAccountMetalLBName,
+				Namespace: metav1.NamespaceSystem,
+			},
+		},
+	}
+}
+
+// secretMetalLB returns the secret with the key used by the speakers to
+// encrypt the memberlist traffic.
+func secretMetalLB() (*corev1.Secret, error) {
+	key := make([]byte, 128)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretMetalLBName,
+			Labels:    map[string]string{"app": metalLBName},
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string][]byte{
+			secretKeyName: []byte(base64.StdEncoding.EncodeToString(key)),
+		},
+	}, nil
+}
+
+type config struct {
+	Peers        []peer        `json:"peers,omitempty"`
+	AddressPools []addressPool `json:"address-pools"`
+}
+
+type peer struct {
+	PeerAddress   string          `json:"peer-address"`
+	PeerASN       int64           `json:"peer-asn"`
+	MyASN         int64           `json:"my-asn"`
+	PeerPort      int32           `json:"peer-port,omitempty"`
+	NodeSelectors []nodeSelectors `json:"node-selectors,omitempty"`
+}
+
+type nodeSelectors struct {
+	MatchLabels map[string]string `json:"match-labels"`
+}
+
+type addressPool struct {
+	Name       string   `json:"name"`
+	Protocol   string   `json:"protocol"`
+	Addresses  []string `json:"addresses"`
+	AutoAssign *bool    `json:"auto-assign,omitempty"`
+}
+
+func configMapMetalLB(spec v1.MetalLBSpec) (*corev1.ConfigMap, error) {
+	cfg := config{}
+	for _, p := range spec.Peers {
+		item := peer{
+			PeerAddress: p.PeerAddress,
+			PeerASN:     p.PeerASN,
+			MyASN:       p.MyASN,
+			PeerPort:    p.PeerPort,
+		}
+		if len(p.NodeSelector) > 0 {
+			item.NodeSelectors = []nodeSelectors{{MatchLabels: p.NodeSelector}}
+		}
+		cfg.Peers = append(cfg.Peers, item)
+	}
+	for _, p := range spec.AddressPools {
+		cfg.AddressPools = append(cfg.AddressPools, addressPool{
+			Name:       p.Name,
+			Protocol:   string(p.Protocol),
+			Addresses:  p.Addresses,
+			AutoAssign: p.AutoAssign,
+		})
+	}
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmMetalLBName,
+			Labels:    map[string]string{"app": metalLBName},
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			configKey: string(data),
+		},
+	}, nil
+}
+
+func deploymentMetalLB(metalLBVersion string) *appsv1.Deployment {
+	labels := map[string]string{"app": metalLBName, "component": "controller"}
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Deployment",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controllerDeployName,
+			Labels:    labels,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(1),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName:  "system-cluster-critical",
+					ServiceAccountName: svcAccountMetalLBName,
+					NodeSelector:       map[string]string{"kubernetes.io/os": "linux"},
+					Containers: []corev1.Container{
+						{
+							Name:  "controller",
+							Image: images.Get(metalLBVersion).Controller.FullName(),
+							Args: []string{
+								fmt.Sprintf("--port=%d", monitoringPort),
+								fmt.Sprintf("--config=%s", cmMetalLBName),
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "monitoring", ContainerPort: monitoringPort},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									// TODO: add support for configuring them
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(100*1024*1024, resource.BinarySI),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func daemonSetMetalLB(metalLBVersion string) *appsv1.DaemonSet {
+	labels := map[string]string{"app": metalLBName, "component": "speaker"}
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      speakerDaemonSetName,
+			Labels:    labels,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					PriorityClassName:  "system-node-critical",
+					ServiceAccountName: svcAccountMetalLBName,
+					HostNetwork:        true,
+					NodeSelector:       map[string]string{"kubernetes.io/os": "linux"},
+					Tolerations: []corev1.Toleration{
+						{Operator: corev1.TolerationOpExists},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:  "speaker",
+							Image: images.Get(metalLBVersion).Speaker.FullName(),
+							Args: []string{
+								fmt.Sprintf("--port=%d", monitoringPort),
+								fmt.Sprintf("--config=%s", cmMetalLBName),
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name: "METALLB_NODE_NAME",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+									},
+								},
+								{
+									Name: "METALLB_HOST",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.hostIP"},
+									},
+								},
+								{
+									Name: "METALLB_ML_BIND_ADDR",
+									ValueFrom: &corev1.EnvVarSource{
+										FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+									},
+								},
+								{
+									Name:  "METALLB_ML_LABELS",
+									Value: "app=" + metalLBName + ",component=speaker",
+								},
+								{
+									Name:  "METALLB_ML_NAMESPACE",
+									Value: metav1.NamespaceSystem,
+								},
+								{
+									Name: "METALLB_ML_SECRET_KEY",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: secretMetalLBName},
+											Key:                  secretKeyName,
+										},
+									},
+								},
+							},
+							Ports: []corev1.ContainerPort{
+								{Name: "monitoring", ContainerPort: monitoringPort},
+							},
+							SecurityContext: &corev1.SecurityContext{
+								ReadOnlyRootFilesystem: boolPtr(true),
+								Capabilities: &corev1.Capabilities{
+									Add:  []corev1.Capability{"NET_RAW"},
+									Drop: []corev1.Capability{"ALL"},
+								},
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									// TODO: add support for configuring them
+									corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
+									corev1.ResourceMemory: *resource.NewQuantity(100*1024*1024, resource.BinarySI),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func boolPtr(b bool) *bool { return &b }
+
+func int32Ptr(i int32) *int32 { return &i }
@@ -0,0 +1,234 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
+	"tkestack.io/tke/pkg/platform/controller/addon/metallb/images"
+)
+
+func newMetalLB() *v1.MetalLB {
+	autoAssign := false
+	return &v1.MetalLB{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlb"},
+		Spec: v1.MetalLBSpec{
+			ClusterName: "cls",
+			Version:     images.LatestVersion,
+			AddressPools: []v1.MetalLBAddressPool{
+				{Name: "l2", Protocol: v1.MetalLBProtocolLayer2, Addresses: []string{"192.168.1.100-192.168.1.200"}},
+				{Name: "bgp", Protocol: v1.MetalLBProtocolBGP, Addresses: []string{"10.0.0.0/24"}, AutoAssign: &autoAssign},
+			},
+			Peers: []v1.MetalLBPeer{
+				{PeerAddress: "10.1.0.1", PeerASN: 64501, MyASN: 64500, NodeSelector: map[string]string{"rack": "a"}},
+			},
+		},
+	}
+}
+
+func TestObjectStatus(t *testing.T) {
+	obj := metalLBObject{newMetalLB()}
+	status := lifecycle.Status{Version: images.LatestVersion, Phase: v1.AddonPhaseRunning, RetryCount: 1}
+
+	updated := obj.WithStatus(status)
+	if got := updated.GetStatus(); got.Version != status.Version || got.Phase != status.Phase || got.RetryCount != status.RetryCount {
+		t.Errorf("GetStatus() = %+v, want %+v", got, status)
+	}
+	if obj.Status.Phase != "" {
+		t.Error("WithStatus() changed the object passed in")
+	}
+	if updated.ClusterName() != "cls" || updated.Version() != images.LatestVersion {
+		t.Errorf("got cluster %s version %s", updated.ClusterName(), updated.Version())
+	}
+}
+
+func TestBundle(t *testing.T) {
+	addon := &metalLBAddon{}
+	if _, err := addon.Bundle("v0.0.1", metalLBObject{newMetalLB()}); err == nil {
+		t.Error("Bundle() with unsupported version should fail")
+	}
+
+	bundle, err := addon.Bundle(images.LatestVersion, metalLBObject{newMetalLB()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err = bundle.Stamp("mlb")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	if err := bundle.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+
+	cm, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmMetalLBName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"peer-address: 10.1.0.1",
+		"peer-asn: 64501",
+		"my-asn: 64500",
+		"rack: a",
+		"protocol: layer2",
+		"- 192.168.1.100-192.168.1.200",
+		"auto-assign: false",
+	} {
+		if !strings.Contains(cm.Data[configKey], s) {
+			t.Errorf("config does not contain %q:\n%s", s, cm.Data[configKey])
+		}
+	}
+	deploy, err := client.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, controllerDeployName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := deploy.Spec.Template.Spec.Containers[0].Image, images.Get(images.LatestVersion).Controller.FullName(); got != want {
+		t.Errorf("controller image = %s, want %s", got, want)
+	}
+
+	if err := bundle.Probe(ctx, client); err == nil {
+		t.Error("Probe() should fail before the controller and speakers are ready")
+	}
+	deploy.Status = appsv1.DeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}
+	if _, err := client.AppsV1().Deployments(metav1.NamespaceSystem).UpdateStatus(ctx, deploy, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, speakerDaemonSetName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds.Status = appsv1.DaemonSetStatus{DesiredNumberScheduled: 2, UpdatedNumberScheduled: 2, NumberAvailable: 2}
+	if _, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).UpdateStatus(ctx, ds, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := bundle.Probe(ctx, client); err != nil {
+		t.Errorf("Probe() error = %v", err)
+	}
+	if drifts, err := bundle.Drift(ctx, client); err != nil || len(drifts) != 0 {
+		t.Errorf("Drift() = %v, %v, want none", drifts, err)
+	}
+
+	// the changed pools are rendered into the config map
+	changed := newMetalLB()
+	changed.Spec.AddressPools = changed.Spec.AddressPools[:1]
+	changedBundle, err := addon.Bundle(images.LatestVersion, metalLBObject{changed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := changedBundle.Apply(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	cm, err = client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmMetalLBName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(cm.Data[configKey], "10.0.0.0/24") {
+		t.Errorf("config contains the removed pool:\n%s", cm.Data[configKey])
+	}
+}
+
+func TestCheckStrictARP(t *testing.T) {
+	ipvs := true
+	layer2 := newMetalLB()
+	bgp := newMetalLB()
+	bgp.Spec.AddressPools = bgp.Spec.AddressPools[1:]
+	tests := []struct {
+		name    string
+		feature v1.ClusterFeature
+		metalLB *v1.MetalLB
+		wantErr bool
+	}{
+		{
+			name:    "iptables",
+			metalLB: layer2,
+		},
+		{
+			name:    "ipvs without strict arp",
+			feature: v1.ClusterFeature{IPVS: &ipvs},
+			metalLB: layer2,
+			wantErr: true,
+		},
+		{
+			name:    "ipvs with strict arp",
+			feature: v1.ClusterFeature{KubeProxy: &v1.KubeProxy{Mode: v1.KubeProxyModeIPVS, StrictARP: true}},
+			metalLB: layer2,
+		},
+		{
+			name:    "ipvs with bgp pools only",
+			feature: v1.ClusterFeature{IPVS: &ipvs},
+			metalLB: bgp,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &v1.Cluster{Spec: v1.ClusterSpec{Features: tt.feature}}
+			if err := checkStrictARP(cluster, tt.metalLB); (err != nil) != tt.wantErr {
+				t.Errorf("checkStrictARP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSecret(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset()
+	if err := ensureSecret(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, secretMetalLBName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := string(secret.Data[secretKeyName])
+	if key == "" {
+		t.Fatal("the memberlist key is empty")
+	}
+
+	// the key is kept across upgrades
+	if err := ensureSecret(ctx, client); err != nil {
+		t.Fatal(err)
+	}
+	secret, err = client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, secretMetalLBName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(secret.Data[secretKeyName]) != key {
+		t.Error("the memberlist key is changed")
+	}
+
+	addon := &metalLBAddon{}
+	if err := addon.Cleanup(ctx, client, metalLBObject{newMetalLB()}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, secretMetalLBName, metav1.GetOptions{}); err == nil {
+		t.Error("the memberlist secret is not deleted")
+	}
+	// cleaning up again ignores the secret not found
+	if err := addon.Cleanup(ctx, client, metalLBObject{newMetalLB()}); err != nil {
+		t.Errorf("Cleanup() again error = %v", err)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multus
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	cniVersion            = "0.3.1"
+	defaultResourcePrefix = "intel.com"
+
+	// resourceNameAnnotation makes the network resources injector of multus
+	// request the SR-IOV virtual functions for the pods using the attachment.
+	resourceNameAnnotation = "k8s.v1.cni.cncf.io/resourceName"
+	// attachmentLabel marks the attachments managed by the addon, the
+	// attachments removed from spec are pruned by it.
+	attachmentLabel = "platform.tkestack.io/multus"
+)
+
+var networkAttachmentResource = schema.GroupVersionResource{Group: "k8s.cni.cncf.io", Version: "v1", Resource: "network-attachment-definitions"}
+
+type ipamConfig struct {
+	Type       string        `json:"type"`
+	Subnet     string        `json:"subnet,omitempty"`
+	RangeStart string        `json:"rangeStart,omitempty"`
+	RangeEnd   string        `json:"rangeEnd,omitempty"`
+	Gateway    string        `json:"gateway,omitempty"`
+	Routes     []routeConfig `json:"routes,omitempty"`
+}
+
+type routeConfig struct {
+	Dst string `json:"dst"`
+}
+
+type pluginConfig struct {
+	CNIVersion string      `json:"cniVersion"`
+	Name       string      `json:"name"`
+	Type       string      `json:"type"`
+	Master     string      `json:"master,omitempty"`
+	Device     string      `json:"device,omitempty"`
+	Mode       string      `json:"mode,omitempty"`
+	VLAN       int32       `json:"vlan,omitempty"`
+	MTU        int32       `json:"mtu,omitempty"`
+	IPAM       *ipamConfig `json:"ipam,omitempty"`
+}
+
+type sriovdpConfig struct {
+	ResourceList []sriovdpResource `json:"resourceList"`
+}
+
+type sriovdpResource struct {
+	ResourcePrefix string           `json:"resourcePrefix"`
+	ResourceName   string           `json:"resourceName"`
+	Selectors      sriovdpSelectors `json:"selectors"`
+}
+
+type sriovdpSelectors struct {
+	Vendors []string `json:"vendors,omitempty"`
+	Devices []string `json:"devices,omitempty"`
+	Drivers []string `json:"drivers,omitempty"`
+	PFNames []string `json:"pfNames,omitempty"`
+}
+
+func resourcePrefix(sriov *v1.MultusSRIOV) string {
+	if sriov == nil || sriov.ResourcePrefix == "" {
+		return defaultResourcePrefix
+	}
+	return sriov.ResourcePrefix
+}
+
+// sriovDevicePluginConfig returns the config of SR-IOV network device plugin,
+// which advertises the virtual functions matching the selectors as extended
+// resources of nodes.
+func sriovDevicePluginConfig(sriov *v1.MultusSRIOV) (string, error) {
+	cfg := sriovdpConfig{ResourceList: []sriovdpResource{}}
+	for _, resource := range sriov.Resources {
+		cfg.ResourceList = append(cfg.ResourceList, sriovdpResource{
+			ResourcePrefix: resourcePrefix(sriov),
+			ResourceName:   resource.ResourceName,
+			Selectors: sriovdpSelectors{
+				Vendors: resource.Vendors,
+				Devices: resource.Devices,
+				Drivers: resource.Drivers,
+				PFNames: resource.PFNames,
+			},
+		})
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// networkAttachmentConfig returns the CNI config of the attachment, the raw
+// config is used as is if specified.
+func networkAttachmentConfig(attachment v1.MultusNetworkAttachment) (string, error) {
+	if attachment.Config != "" {
+		return attachment.Config, nil
+	}
+	cfg := pluginConfig{
+		CNIVersion: cniVersion,
+		Name:       attachment.Name,
+		Type:       attachment.Type,
+		Mode:       attachment.Mode,
+		MTU:        attachment.MTU,
+	}
+	switch attachment.Type {
+	case "host-device":
+		cfg.Device = attachment.Master
+	case "sriov":
+		cfg.VLAN = attachment.VLAN
+	default:
+		cfg.Master = attachment.Master
+	}
+	if attachment.IPAM != nil {
+		cfg.IPAM = &ipamConfig{
+			Type:       attachment.IPAM.Type,
+			Subnet:     attachment.IPAM.Subnet,
+			RangeStart: attachment.IPAM.RangeStart,
+			RangeEnd:   attachment.IPAM.RangeEnd,
+			Gateway:    attachment.IPAM.Gateway,
+		}
+		if cfg.IPAM.Type == "" {
+			cfg.IPAM.Type = "host-local"
+		}
+		for _, route := range attachment.IPAM.Routes {
+			cfg.IPAM.Routes = append(cfg.IPAM.Routes, routeConfig{Dst: route})
+		}
+	}
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// networkAttachmentDefinition returns the NetworkAttachmentDefinition of the
+// attachment, which is referenced by the k8s.v1.cni.cncf.io/networks
+// annotation of pods.
+func networkAttachmentDefinition(multus *v1.Multus, attachment v1.MultusNetworkAttachment) (*unstructured.Unstructured, error) {
+	config, err := networkAttachmentConfig(attachment)
+	if err != nil {
+		return nil, err
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(networkAttachmentResource.GroupVersion().String())
+	obj.SetKind("NetworkAttachmentDefinition")
+	obj.SetName(attachment.Name)
+	obj.SetNamespace(attachment.Namespace)
+	obj.SetLabels(map[string]string{attachmentLabel: multus.Name})
+	if attachment.Type == "sriov" && attachment.Config == "" {
+		obj.SetAnnotations(map[string]string{
+			resourceNameAnnotation: resourcePrefix(multus.Spec.SRIOV) + "/" + attachment.ResourceName,
+		})
+	}
+	if err := unstructured.SetNestedField(obj.Object, config, "spec", "config"); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
@@ -0,0 +1,102 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multus
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestNetworkAttachmentConfig(t *testing.T) {
+	cases := []struct {
+		name       string
+		attachment v1.MultusNetworkAttachment
+		want       string
+	}{
+		{
+			name: "macvlan",
+			attachment: v1.MultusNetworkAttachment{
+				Name: "macvlan-conf", Type: "macvlan", Master: "eth1", Mode: "bridge",
+				IPAM: &v1.MultusIPAM{Subnet: "192.168.1.0/24", Gateway: "192.168.1.1", Routes: []string{"0.0.0.0/0"}},
+			},
+			want: `{"cniVersion":"0.3.1","name":"macvlan-conf","type":"macvlan","master":"eth1","mode":"bridge","ipam":{"type":"host-local","subnet":"192.168.1.0/24","gateway":"192.168.1.1","routes":[{"dst":"0.0.0.0/0"}]}}`,
+		},
+		{
+			name:       "host-device",
+			attachment: v1.MultusNetworkAttachment{Name: "hostdev", Type: "host-device", Master: "eth2"},
+			want:       `{"cniVersion":"0.3.1","name":"hostdev","type":"host-device","device":"eth2"}`,
+		},
+		{
+			name: "sriov",
+			attachment: v1.MultusNetworkAttachment{
+				Name: "sriov-net", Type: "sriov", ResourceName: "sriov_netdevice", VLAN: 100,
+				IPAM: &v1.MultusIPAM{Type: "dhcp"},
+			},
+			want: `{"cniVersion":"0.3.1","name":"sriov-net","type":"sriov","vlan":100,"ipam":{"type":"dhcp"}}`,
+		},
+		{
+			name:       "raw",
+			attachment: v1.MultusNetworkAttachment{Name: "raw", Config: `{"type":"bridge"}`},
+			want:       `{"type":"bridge"}`,
+		},
+	}
+	for _, c := range cases {
+		got, err := networkAttachmentConfig(c.attachment)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", c.name, err)
+		}
+		if got != c.want {
+			t.Errorf("%s: got %s, want %s", c.name, got, c.want)
+		}
+	}
+}
+
+func TestNetworkAttachmentDefinition(t *testing.T) {
+	multus := &v1.Multus{
+		ObjectMeta: metav1.ObjectMeta{Name: "multus-abc"},
+		Spec: v1.MultusSpec{
+			SRIOV: &v1.MultusSRIOV{
+				Resources: []v1.MultusSRIOVResource{{ResourceName: "sriov_netdevice", Vendors: []string{"8086"}}},
+			},
+		},
+	}
+	obj, err := networkAttachmentDefinition(multus, v1.MultusNetworkAttachment{
+		Name: "sriov-net", Namespace: "telco", Type: "sriov", ResourceName: "sriov_netdevice",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.GetNamespace() != "telco" || obj.GetLabels()[attachmentLabel] != "multus-abc" {
+		t.Errorf("unexpected metadata: %s/%s %v", obj.GetNamespace(), obj.GetName(), obj.GetLabels())
+	}
+	if got := obj.GetAnnotations()[resourceNameAnnotation]; got != "intel.com/sriov_netdevice" {
+		t.Errorf("got resource name %q, want intel.com/sriov_netdevice", got)
+	}
+
+	config, err := sriovDevicePluginConfig(multus.Spec.SRIOV)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"resourceList":[{"resourcePrefix":"intel.com","resourceName":"sriov_netdevice","selectors":{"vendors":["8086"]}}]}`
+	if config != want {
+		t.Errorf("got device plugin config %s, want %s", config, want)
+	}
+}
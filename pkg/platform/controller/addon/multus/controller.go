@@ -0,0 +1,516 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"tkestack.io/tke/pkg/platform/controller/addon/multus/images"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	extensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+const (
+	controllerName                 = "multus-controller"
+	multusName                     = "multus"
+	svcAccountMultusName           = "multus"
+	crMultusName                   = "multus"
+	crbMultusName                  = "multus"
+	multusDaemonSetName            = "kube-multus-ds"
+	cmSRIOVDevicePluginName        = "sriovdp-config"
+	sriovDevicePluginDaemonSetName = "kube-sriov-device-plugin"
+	sriovCNIDaemonSetName          = "kube-sriov-cni-ds"
+	crdNetworkAttachmentName       = "network-attachment-definitions.k8s.cni.cncf.io"
+
+	sriovConfigKey = "config.json"
+)
+
+// NewController creates a new controller which drives the Multuses through
+// the addon lifecycle.
+func NewController(client clientset.Interface, informer platformv1informer.MultusInformer, resyncPeriod time.Duration) *lifecycle.Controller {
+	addon := &multusAddon{
+		client: client,
+		lister: informer.Lister(),
+	}
+	return lifecycle.NewController(controllerName, client, informer.Informer(), addon, resyncPeriod)
+}
+
+// multusObject adapts the Multus to the addon lifecycle.
+type multusObject struct {
+	*v1.Multus
+}
+
+func (o multusObject) ClusterName() string {
+	return o.Spec.ClusterName
+}
+
+func (o multusObject) Version() string {
+	return o.Spec.Version
+}
+
+func (o multusObject) DriftPolicy() v1.AddonDriftPolicy {
+	return v1.AddonDriftRepair
+}
+
+func (o multusObject) GetStatus() lifecycle.Status {
+	return lifecycle.Status{
+		Version:                     o.Status.Version,
+		Phase:                       o.Status.Phase,
+		Reason:                      o.Status.Reason,
+		RetryCount:                  o.Status.RetryCount,
+		LastReInitializingTimestamp: o.Status.LastReInitializingTimestamp,
+		Conditions:                  o.Status.Conditions,
+	}
+}
+
+func (o multusObject) WithStatus(status lifecycle.Status) lifecycle.Object {
+	multus := o.DeepCopy()
+	multus.Status.Version = status.Version
+	multus.Status.Phase = status.Phase
+	multus.Status.Reason = status.Reason
+	multus.Status.RetryCount = status.RetryCount
+	multus.Status.LastReInitializingTimestamp = status.LastReInitializingTimestamp
+	multus.Status.Conditions = status.Conditions
+	return multusObject{multus}
+}
+
+type multusAddon struct {
+	client clientset.Interface
+	lister platformv1lister.MultusLister
+}
+
+func (a *multusAddon) Kind() string {
+	return "Multus"
+}
+
+func (a *multusAddon) Get(name string) (lifecycle.Object, error) {
+	multus, err := a.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return multusObject{multus}, nil
+}
+
+func (a *multusAddon) UpdateStatus(ctx context.Context, obj lifecycle.Object) error {
+	_, err := a.client.PlatformV1().Multuses().UpdateStatus(ctx, obj.(multusObject).Multus, metav1.UpdateOptions{})
+	return err
+}
+
+// Bundle renders multus with the images of the version, and the SR-IOV device
+// plugin and CNI if configured, which are pruned once SR-IOV is removed from
+// the spec.
+func (a *multusAddon) Bundle(version string, obj lifecycle.Object) (lifecycle.Bundle, error) {
+	if err := images.Validate(version); err != nil {
+		return nil, err
+	}
+	components := images.Get(version)
+	bundle := lifecycle.Bundle{
+		serviceAccountMultus(),
+		crMultus(),
+		crbMultus(),
+		daemonSetMultus(components),
+	}
+	if sriov := obj.(multusObject).Spec.SRIOV; sriov != nil {
+		cm, err := configMapSRIOVDevicePlugin(sriov)
+		if err != nil {
+			return nil, err
+		}
+		bundle = append(bundle, cm, daemonSetSRIOVDevicePlugin(components, sriov), daemonSetSRIOVCNI(components, sriov))
+	}
+	return bundle, nil
+}
+
+// Preflight registers the CRD of NetworkAttachmentDefinition, which may be
+// created by other CNI meta plugins already.
+func (a *multusAddon) Preflight(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) (lifecycle.Object, error) {
+	crdClient, err := util.BuildExternalExtensionClientSet(ctx, cluster, a.client.PlatformV1())
+	if err != nil {
+		return obj, err
+	}
+	_, err = crdClient.ApiextensionsV1beta1().CustomResourceDefinitions().Create(ctx, crdNetworkAttachmentDefinition(), metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return obj, err
+	}
+	return obj, nil
+}
+
+// Configure syncs the network attachments with the spec once multus is
+// rolled out.
+func (a *multusAddon) Configure(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) error {
+	dynamicClient, err := a.dynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	return ensureNetworkAttachments(ctx, dynamicClient, obj.(multusObject).Multus)
+}
+
+// Cleanup deletes the NetworkAttachmentDefinitions of the addon, the CRD is
+// kept since the attachments created by users are deleted along with it.
+func (a *multusAddon) Cleanup(ctx context.Context, kubeClient kubernetes.Interface, obj lifecycle.Object) error {
+	cluster, err := a.client.PlatformV1().Clusters().Get(ctx, obj.ClusterName(), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := a.dynamicClient(ctx, cluster)
+	if err != nil {
+		return err
+	}
+	err = deleteNetworkAttachments(ctx, dynamicClient, obj.(multusObject).Multus, nil)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (a *multusAddon) dynamicClient(ctx context.Context, cluster *v1.Cluster) (dynamic.Interface, error) {
+	credential, err := util.GetClusterCredentialV1(ctx, a.client.PlatformV1(), cluster)
+	if err != nil {
+		return nil, err
+	}
+	return util.BuildExternalDynamicClientSet(cluster, credential)
+}
+
+// ensureNetworkAttachments creates or updates the NetworkAttachmentDefinitions
+// of spec and prunes the ones removed from it.
+func ensureNetworkAttachments(ctx context.Context, dynamicClient dynamic.Interface, multus *v1.Multus) error {
+	keys := make(map[string]bool)
+	for _, attachment := range multus.Spec.NetworkAttachments {
+		obj, err := networkAttachmentDefinition(multus, attachment)
+		if err != nil {
+			return err
+		}
+		keys[attachment.Namespace+"/"+attachment.Name] = true
+		client := dynamicClient.Resource(networkAttachmentResource).Namespace(attachment.Namespace)
+		existing, err := client.Get(ctx, attachment.Name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			if _, err := client.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		obj.SetResourceVersion(existing.GetResourceVersion())
+		if _, err := client.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+	return deleteNetworkAttachments(ctx, dynamicClient, multus, keys)
+}
+
+// deleteNetworkAttachments deletes the NetworkAttachmentDefinitions of the
+// addon except the ones in keys.
+func deleteNetworkAttachments(ctx context.Context, dynamicClient dynamic.Interface, multus *v1.Multus, keys map[string]bool) error {
+	list, err := dynamicClient.Resource(networkAttachmentResource).Namespace(metav1.NamespaceAll).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", attachmentLabel, multus.Name),
+	})
+	if err != nil {
+		return err
+	}
+	for _, item := range list.Items {
+		if keys[item.GetNamespace()+"/"+item.GetName()] {
+			continue
+		}
+		if err := dynamicClient.Resource(networkAttachmentResource).Namespace(item.GetNamespace()).Delete(ctx, item.GetName(), metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func crdNetworkAttachmentDefinition() *extensionsv1.CustomResourceDefinition {
+	return &extensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crdNetworkAttachmentName,
+		},
+		Spec: extensionsv1.CustomResourceDefinitionSpec{
+			Group: networkAttachmentResource.Group,
+			Names: extensionsv1.CustomResourceDefinitionNames{
+				Kind:       "NetworkAttachmentDefinition",
+				ListKind:   "NetworkAttachmentDefinitionList",
+				Plural:     networkAttachmentResource.Resource,
+				Singular:   "network-attachment-definition",
+				ShortNames: []string{"net-attach-def"},
+			},
+			Scope:   extensionsv1.NamespaceScoped,
+			Version: networkAttachmentResource.Version,
+		},
+	}
+}
+
+func serviceAccountMultus() *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ServiceAccount",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      svcAccountMultusName,
+			Namespace: metav1.NamespaceSystem,
+		},
+	}
+}
+
+func crMultus() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRole",
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crMultusName,
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{networkAttachmentResource.Group},
+				Resources: []string{"*"},
+				Verbs:     []string{"*"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"pods", "pods/status"},
+				Verbs:     []string{"get", "update"},
+			},
+			{
+				APIGroups: []string{"", "events.k8s.io"},
+				Resources: []string{"events"},
+				Verbs:     []string{"create", "patch", "update"},
+			},
+		},
+	}
+}
+
+func crbMultus() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ClusterRoleBinding",
+			APIVersion: "rbac.authorization.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crbMultusName,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     crMultusName,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      svcAccountMultusName,
+				Namespace: metav1.NamespaceSystem,
+			},
+		},
+	}
+}
+
+func configMapSRIOVDevicePlugin(sriov *v1.MultusSRIOV) (*corev1.ConfigMap, error) {
+	config, err := sriovDevicePluginConfig(sriov)
+	if err != nil {
+		return nil, err
+	}
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ConfigMap",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmSRIOVDevicePluginName,
+			Labels:    map[string]string{"app": multusName},
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			sriovConfigKey: config,
+		},
+	}, nil
+}
+
+func hostPathVolume(name string, path string) corev1.Volume {
+	return corev1.Volume{
+		Name: name,
+		VolumeSource: corev1.VolumeSource{
+			HostPath: &corev1.HostPathVolumeSource{Path: path},
+		},
+	}
+}
+
+func nodeSelector(sriov *v1.MultusSRIOV) map[string]string {
+	selector := map[string]string{"kubernetes.io/os": "linux"}
+	if sriov != nil {
+		for k, v := range sriov.NodeSelector {
+			selector[k] = v
+		}
+	}
+	return selector
+}
+
+// daemonSet returns the privileged DaemonSet running on host network, which
+// is shared by multus and the SR-IOV components.
+func daemonSet(name string, component string, podSpec corev1.PodSpec) *appsv1.DaemonSet {
+	labels := map[string]string{"app": multusName, "component": component}
+	podSpec.PriorityClassName = "system-node-critical"
+	podSpec.HostNetwork = true
+	podSpec.Tolerations = []corev1.Toleration{
+		{Operator: corev1.TolerationOpExists},
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].SecurityContext = &corev1.SecurityContext{
+			Privileged: boolPtr(true),
+		}
+		podSpec.Containers[i].Resources = corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				// TODO: add support for configuring them
+				corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
+				corev1.ResourceMemory: *resource.NewQuantity(50*1024*1024, resource.BinarySI),
+			},
+		}
+	}
+	return &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "DaemonSet",
+			APIVersion: "apps/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Labels:    labels,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: podSpec,
+			},
+		},
+	}
+}
+
+// daemonSetMultus installs the multus binary and generates its config on all
+// nodes, which delegates the default network to the CNI plugin already there.
+func daemonSetMultus(components images.Components) *appsv1.DaemonSet {
+	return daemonSet(multusDaemonSetName, "multus", corev1.PodSpec{
+		ServiceAccountName: svcAccountMultusName,
+		NodeSelector:       nodeSelector(nil),
+		Containers: []corev1.Container{
+			{
+				Name:    "kube-multus",
+				Image:   components.Multus.FullName(),
+				Command: []string{"/entrypoint.sh"},
+				Args: []string{
+					"--multus-conf-file=auto",
+					"--cni-version=" + cniVersion,
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "cni", MountPath: "/host/etc/cni/net.d"},
+					{Name: "cnibin", MountPath: "/host/opt/cni/bin"},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			hostPathVolume("cni", "/etc/cni/net.d"),
+			hostPathVolume("cnibin", "/opt/cni/bin"),
+		},
+	})
+}
+
+// daemonSetSRIOVDevicePlugin advertises the virtual functions as extended
+// resources on the nodes selected by the SR-IOV node selector.
+func daemonSetSRIOVDevicePlugin(components images.Components, sriov *v1.MultusSRIOV) *appsv1.DaemonSet {
+	return daemonSet(sriovDevicePluginDaemonSetName, "sriov-device-plugin", corev1.PodSpec{
+		ServiceAccountName: svcAccountMultusName,
+		NodeSelector:       nodeSelector(sriov),
+		Containers: []corev1.Container{
+			{
+				Name:  "kube-sriovdp",
+				Image: components.SRIOVDevicePlugin.FullName(),
+				Args: []string{
+					"--log-dir=sriovdp",
+					"--log-level=10",
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "devicesock", MountPath: "/var/lib/kubelet/"},
+					{Name: "log", MountPath: "/var/log"},
+					{Name: "config-volume", MountPath: "/etc/pcidp"},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			hostPathVolume("devicesock", "/var/lib/kubelet/"),
+			hostPathVolume("log", "/var/log"),
+			{
+				Name: "config-volume",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{
+						LocalObjectReference: corev1.LocalObjectReference{Name: cmSRIOVDevicePluginName},
+						Items: []corev1.KeyToPath{
+							{Key: sriovConfigKey, Path: sriovConfigKey},
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// daemonSetSRIOVCNI installs the sriov CNI plugin used by the sriov network
+// attachments.
+func daemonSetSRIOVCNI(components images.Components, sriov *v1.MultusSRIOV) *appsv1.DaemonSet {
+	return daemonSet(sriovCNIDaemonSetName, "sriov-cni", corev1.PodSpec{
+		NodeSelector: nodeSelector(sriov),
+		Containers: []corev1.Container{
+			{
+				Name:  "kube-sriov-cni",
+				Image: components.SRIOVCNI.FullName(),
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "cnibin", MountPath: "/host/opt/cni/bin"},
+				},
+			},
+		},
+		Volumes: []corev1.Volume{
+			hostPathVolume("cnibin", "/opt/cni/bin"),
+		},
+	})
+}
+
+func boolPtr(b bool) *bool { return &b }
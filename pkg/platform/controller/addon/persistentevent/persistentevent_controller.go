@@ -22,10 +22,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	normalerrors "errors"
 	"fmt"
-	"html/template"
 	"reflect"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 
 	"tkestack.io/tke/pkg/platform/controller/addon/persistentevent/images"
@@ -55,6 +58,14 @@ const (
 	persistentEventMaxRetryCount       = 5
 	persistentEventTimeOut             = 5 * time.Minute
 
+	tlsSecretName = "fluentd-tls"
+	tlsMountPath  = "/etc/fluentd/tls"
+	tlsCACertKey  = "ca.crt"
+	// metricsPort exposes the delivery metrics of fluentd, such as the retries
+	// and buffered events of output, and k8s_event_total counting the events
+	// by reason for the event alarm rules of tke-monitor.
+	metricsPort = 24231
+
 	configTemplate = `<source>
   @type tail
   path /data/log/*
@@ -64,7 +75,30 @@ const (
   read_from_head true
   path_key path
 </source>
+<source>
+  @type prometheus
+  port <<.MetricsPort>>
+</source>
+<source>
+  @type prometheus_output_monitor
+</source>
+<filter **>
+  @type prometheus
+  <metric>
+    name k8s_event_total
+    type counter
+    desc The total number of kubernetes events
+    <labels>
+      namespace $.involvedObject.namespace
+      kind $.involvedObject.kind
+      name $.involvedObject.name
+      reason $.reason
+      type $.type
+    </labels>
+  </metric>
+</filter>
 <match **>
+<<- if .ES>>
   @type elasticsearch
   host <<.ES.IP>>
   port <<.ES.Port>>
@@ -74,16 +108,45 @@ const (
   type_name _doc<<if .ES.User>>
   user %{<<.ES.User>>}
   password %{<<.ES.Password>>}<<end>>
+<<- else if .Kafka>>
+  @type kafka2
+  brokers <<join .Kafka.Brokers>>
+  default_topic <<quote .Kafka.Topic>>
+  required_acks -1<<if .Kafka.SASL>>
+  username <<quote .Kafka.SASL.User>>
+  password <<quote .Kafka.SASL.Password>><<if scram .Kafka.SASL.Mechanism>>
+  scram_mechanism <<scram .Kafka.SASL.Mechanism>><<end>>
+  sasl_over_ssl <<if .TLS>>true<<else>>false<<end>><<end>><<if .TLS>><<if .TLS.CACert>>
+  ssl_ca_cert <<.TLSPath>>/<<.CACertFile>><<end>><<if .TLS.ClientCert>>
+  ssl_client_cert <<.TLSPath>>/<<.ClientCertFile>>
+  ssl_client_cert_key <<.TLSPath>>/<<.ClientKeyFile>><<end>><<if .TLS.InsecureSkipVerify>>
+  ssl_verify_hostname false<<end>><<end>>
+  <format>
+    @type json
+  </format>
+<<- else if .Loki>>
+  @type loki
+  url <<quote .Loki.URL>><<if .Loki.TenantID>>
+  tenant <<quote .Loki.TenantID>><<end>><<if .Loki.User>>
+  username <<quote .Loki.User>>
+  password <<quote .Loki.Password>><<end>><<if .Loki.Labels>>
+  extra_labels <<json .Loki.Labels>><<end>><<if .TLS>><<if .TLS.CACert>>
+  ca_cert <<.TLSPath>>/<<.CACertFile>><<end>><<if .TLS.ClientCert>>
+  cert <<.TLSPath>>/<<.ClientCertFile>>
+  key <<.TLSPath>>/<<.ClientKeyFile>><<end>><<if .TLS.InsecureSkipVerify>>
+  insecure_tls true<<end>><<end>>
+  line_format json
+<<- end>>
   flush_interval 5s
   <buffer>
     flush_mode interval
     retry_type exponential_backoff
-    total_limit_size 32MB
+    total_limit_size <<.Buffer.TotalLimitSize>>
     chunk_limit_size 1MB
     chunk_full_threshold 0.8
     @type file
     path /var/log/td-agent/buffer/ccs.cluster.log_collector.buffer.audit-event-collector.host-path
-    overflow_action block
+    overflow_action <<.Buffer.OverflowAction>>
     flush_interval 1s
     flush_thread_burst_interval 0.01
     chunk_limit_records 8000
@@ -93,7 +156,27 @@ const (
 )
 
 var (
-	configTmpl = template.Must(template.New("fluentd-config").Delims("<<", ">>").Parse(configTemplate))
+	configTmpl = template.Must(template.New("fluentd-config").Delims("<<", ">>").Funcs(template.FuncMap{
+		"quote": strconv.Quote,
+		"join": func(values []string) string {
+			return strconv.Quote(strings.Join(values, ","))
+		},
+		"json": func(value interface{}) (string, error) {
+			data, err := json.Marshal(value)
+			return string(data), err
+		},
+		// scram is the scram_mechanism of fluent-plugin-kafka, which is empty
+		// for SASL/PLAIN.
+		"scram": func(mechanism string) string {
+			switch mechanism {
+			case "SCRAM-SHA-256":
+				return "sha256"
+			case "SCRAM-SHA-512":
+				return "sha512"
+			}
+			return ""
+		},
+	}).Parse(configTemplate))
 )
 
 // Controller is used to synchronize the installation, upgrade and
@@ -341,7 +424,8 @@ func (c *Controller) createPersistentEventIfNeeded(ctx context.Context, key stri
 	if persistentEvent.Status.Phase == v1.AddonPhaseRunning &&
 		cachedPersistentEvent != nil &&
 		cachedPersistentEvent.state != nil &&
-		!reflect.DeepEqual(cachedPersistentEvent.state.Spec.PersistentBackEnd, persistentEvent.Spec.PersistentBackEnd) {
+		(!reflect.DeepEqual(cachedPersistentEvent.state.Spec.PersistentBackEnd, persistentEvent.Spec.PersistentBackEnd) ||
+			!reflect.DeepEqual(cachedPersistentEvent.state.Spec.Buffer, persistentEvent.Spec.Buffer)) {
 		// delete from health check map
 		if c.health.Exist(key) {
 			c.health.Del(key)
@@ -421,10 +505,11 @@ func (c *Controller) installPersistentEventComponent(ctx context.Context, persis
 	clusterRole := c.makeClusterRole()
 	clusterRoleBinding := c.makeClusterRoleBinding()
 	deployment := c.makeDeployment(persistentEvent.Spec.Version)
-	config, err := c.makeConfigMap(ctx, &persistentEvent.Spec.PersistentBackEnd)
+	config, err := c.makeConfigMap(ctx, &persistentEvent.Spec.PersistentBackEnd, persistentEvent.Spec.Buffer)
 	if err != nil {
 		return err
 	}
+	tlsSecret := c.makeTLSSecret(&persistentEvent.Spec.PersistentBackEnd)
 
 	_, err = kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Get(ctx, "tke-event-watcher", metav1.GetOptions{})
 	if err != nil && errors.IsNotFound(err) {
@@ -462,6 +547,12 @@ func (c *Controller) installPersistentEventComponent(ctx context.Context, persis
 		return err
 	}
 
+	if tlsSecret != nil {
+		if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, tlsSecret, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
 	_, err = kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, "tke-persistent-event", metav1.GetOptions{})
 	if err != nil && errors.IsNotFound(err) {
 		if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Create(ctx, deployment, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
@@ -498,6 +589,11 @@ func (c *Controller) uninstallPersistentEventComponent(ctx context.Context, pers
 		failed = true
 		log.Error("Failed to delete configmap", log.Err(configMapErr))
 	}
+	secretErr := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, tlsSecretName, metav1.DeleteOptions{})
+	if secretErr != nil && !errors.IsNotFound(secretErr) {
+		failed = true
+		log.Error("Failed to delete secret", log.Err(secretErr))
+	}
 	serviceAccountErr := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Delete(ctx, "tke-event-watcher", metav1.DeleteOptions{})
 	if serviceAccountErr != nil && !errors.IsNotFound(serviceAccountErr) {
 		failed = true
@@ -730,6 +826,10 @@ func (c *Controller) makeDeployment(version string) *appsv1.Deployment {
 						"qcloud-app": "tke-persistent-event",
 						"k8s-app":    "tke-persistent-event",
 					},
+					Annotations: map[string]string{
+						"prometheus.io/scrape": "true",
+						"prometheus.io/port":   strconv.Itoa(metricsPort),
+					},
 				},
 				Spec: corev1.PodSpec{
 					Containers: []corev1.Container{
@@ -747,6 +847,9 @@ func (c *Controller) makeDeployment(version string) *appsv1.Deployment {
 						{
 							Name:  "tke-persistent-event-fluentd",
 							Image: images.Get(version).Collector.FullName(),
+							Ports: []corev1.ContainerPort{
+								{Name: "metrics", ContainerPort: metricsPort},
+							},
 							VolumeMounts: []corev1.VolumeMount{
 								{
 									Name:      "fluentd-config",
@@ -757,6 +860,11 @@ func (c *Controller) makeDeployment(version string) *appsv1.Deployment {
 									MountPath: "/data/log",
 									ReadOnly:  true,
 								},
+								{
+									Name:      tlsSecretName,
+									MountPath: tlsMountPath,
+									ReadOnly:  true,
+								},
 							},
 						},
 					},
@@ -780,6 +888,15 @@ func (c *Controller) makeDeployment(version string) *appsv1.Deployment {
 								EmptyDir: &corev1.EmptyDirVolumeSource{},
 							},
 						},
+						{
+							Name: tlsSecretName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: tlsSecretName,
+									Optional:   controllerutil.BoolPtr(true),
+								},
+							},
+						},
 					},
 				},
 			},
@@ -787,37 +904,119 @@ func (c *Controller) makeDeployment(version string) *appsv1.Deployment {
 	}
 }
 
-func (c *Controller) makeConfigMap(ctx context.Context, backend *v1.PersistentBackEnd) (*corev1.ConfigMap, error) {
+// fluentdConfig is the data of the fluentd config template, only one of the
+// sinks is set.
+type fluentdConfig struct {
+	ES    *v1.StorageBackEndES
+	Kafka *v1.StorageBackEndKafka
+	Loki  *v1.StorageBackEndLoki
+	// TLS is the certificates of the sink, which are mounted from the TLS
+	// secret.
+	TLS            *v1.StorageBackEndTLS
+	TLSPath        string
+	CACertFile     string
+	ClientCertFile string
+	ClientKeyFile  string
+	Buffer         v1.PersistentEventBuffer
+	MetricsPort    int32
+}
+
+func decodePassword(password string) (string, error) {
+	if password == "" {
+		return "", nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(password)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse password %v", err)
+	}
+	return string(decoded), nil
+}
+
+func (c *Controller) makeConfigMap(ctx context.Context, backend *v1.PersistentBackEnd, buffer *v1.PersistentEventBuffer) (*corev1.ConfigMap, error) {
 	var err error
-	password := backend.ES.Password
-	if password != "" {
-		password, err := base64.StdEncoding.DecodeString(password)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse password %v", err)
+	backend = backend.DeepCopy()
+	config := fluentdConfig{
+		ES:             backend.ES,
+		Kafka:          backend.Kafka,
+		Loki:           backend.Loki,
+		TLSPath:        tlsMountPath,
+		CACertFile:     tlsCACertKey,
+		ClientCertFile: corev1.TLSCertKey,
+		ClientKeyFile:  corev1.TLSPrivateKeyKey,
+		Buffer:         v1.PersistentEventBuffer{TotalLimitSize: "32MB", OverflowAction: "block"},
+		MetricsPort:    metricsPort,
+	}
+	if buffer != nil {
+		if buffer.TotalLimitSize != "" {
+			config.Buffer.TotalLimitSize = buffer.TotalLimitSize
+		}
+		if buffer.OverflowAction != "" {
+			config.Buffer.OverflowAction = buffer.OverflowAction
 		}
-		backend.ES.Password = string(password)
 	}
 
-	if backend.ES != nil {
+	switch {
+	case backend.ES != nil:
+		if backend.ES.Password, err = decodePassword(backend.ES.Password); err != nil {
+			return nil, err
+		}
 		backend.ES.IndexName = template.JSEscapeString(backend.ES.IndexName)
 		backend.ES.Scheme = template.JSEscapeString(backend.ES.Scheme)
 		backend.ES.IP = template.JSEscapeString(backend.ES.IP)
-	} else if backend.CLS != nil {
-		backend.CLS.LogSetID = template.JSEscapeString(backend.CLS.LogSetID)
-		backend.CLS.TopicID = template.JSEscapeString(backend.CLS.TopicID)
+	case backend.CLS != nil:
+		return nil, normalerrors.New("CLS backend is not supported by the event collector")
+	case backend.Kafka != nil:
+		if backend.Kafka.SASL != nil {
+			if backend.Kafka.SASL.Password, err = decodePassword(backend.Kafka.SASL.Password); err != nil {
+				return nil, err
+			}
+		}
+		config.TLS = backend.Kafka.TLS
+	case backend.Loki != nil:
+		if backend.Loki.Password, err = decodePassword(backend.Loki.Password); err != nil {
+			return nil, err
+		}
+		config.TLS = backend.Loki.TLS
 	}
 
 	var b bytes.Buffer
-	if err = configTmpl.Execute(&b, backend); err != nil {
+	if err = configTmpl.Execute(&b, config); err != nil {
 		return nil, err
 	}
-	config := b.String()
 	return &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: "fluentd-config",
 		},
 		Data: map[string]string{
-			"fluentd.conf": config,
+			"fluentd.conf": b.String(),
 		},
 	}, nil
 }
+
+// makeTLSSecret creates the secret of the certificates of Kafka or Loki, it
+// is mounted optionally so nil is returned if there are no certificates.
+func (c *Controller) makeTLSSecret(backend *v1.PersistentBackEnd) *corev1.Secret {
+	var tls *v1.StorageBackEndTLS
+	if backend.Kafka != nil {
+		tls = backend.Kafka.TLS
+	} else if backend.Loki != nil {
+		tls = backend.Loki.TLS
+	}
+	if tls == nil {
+		return nil
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: tlsSecretName,
+		},
+		Data: make(map[string][]byte),
+	}
+	if tls.CACert != "" {
+		secret.Data[tlsCACertKey] = []byte(tls.CACert)
+	}
+	if tls.ClientCert != "" {
+		secret.Data[corev1.TLSCertKey] = []byte(tls.ClientCert)
+		secret.Data[corev1.TLSPrivateKeyKey] = []byte(tls.ClientKey)
+	}
+	return secret
+}
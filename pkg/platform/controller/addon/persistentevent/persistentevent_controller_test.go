@@ -18,7 +18,7 @@ func TestMakeConfigMap(t *testing.T) {
 		},
 	}
 
-	cm, _ := c.makeConfigMap(context.TODO(), backend)
+	cm, _ := c.makeConfigMap(context.TODO(), backend, nil)
 
 	assert.Equal(t, cm.Data["fluentd.conf"], `<source>
   @type tail
@@ -29,6 +29,28 @@ func TestMakeConfigMap(t *testing.T) {
   read_from_head true
   path_key path
 </source>
+<source>
+  @type prometheus
+  port 24231
+</source>
+<source>
+  @type prometheus_output_monitor
+</source>
+<filter **>
+  @type prometheus
+  <metric>
+    name k8s_event_total
+    type counter
+    desc The total number of kubernetes events
+    <labels>
+      namespace $.involvedObject.namespace
+      kind $.involvedObject.kind
+      name $.involvedObject.name
+      reason $.reason
+      type $.type
+    </labels>
+  </metric>
+</filter>
 <match **>
   @type elasticsearch
   host 127.0.0.1
@@ -56,7 +78,7 @@ func TestMakeConfigMap(t *testing.T) {
 
 	backend.ES.User = "user"
 	backend.ES.Password = "cGFzc3dvcmQK"
-	cm, _ = c.makeConfigMap(context.TODO(), backend)
+	cm, _ = c.makeConfigMap(context.TODO(), backend, nil)
 	assert.Equal(t, cm.Data["fluentd.conf"], `<source>
   @type tail
   path /data/log/*
@@ -66,6 +88,28 @@ func TestMakeConfigMap(t *testing.T) {
   read_from_head true
   path_key path
 </source>
+<source>
+  @type prometheus
+  port 24231
+</source>
+<source>
+  @type prometheus_output_monitor
+</source>
+<filter **>
+  @type prometheus
+  <metric>
+    name k8s_event_total
+    type counter
+    desc The total number of kubernetes events
+    <labels>
+      namespace $.involvedObject.namespace
+      kind $.involvedObject.kind
+      name $.involvedObject.name
+      reason $.reason
+      type $.type
+    </labels>
+  </metric>
+</filter>
 <match **>
   @type elasticsearch
   host 127.0.0.1
@@ -94,3 +138,84 @@ func TestMakeConfigMap(t *testing.T) {
 </match>
 `)
 }
+
+func TestMakeConfigMapKafka(t *testing.T) {
+	c := &Controller{}
+	backend := &v1.PersistentBackEnd{
+		Kafka: &v1.StorageBackEndKafka{
+			Brokers: []string{"10.0.0.1:9092", "10.0.0.2:9092"},
+			Topic:   "events",
+			SASL: &v1.StorageBackEndSASL{
+				Mechanism: "SCRAM-SHA-512",
+				User:      "user",
+				Password:  "cGFzc3dvcmQ=",
+			},
+			TLS: &v1.StorageBackEndTLS{CACert: "ca"},
+		},
+	}
+
+	cm, err := c.makeConfigMap(context.TODO(), backend, &v1.PersistentEventBuffer{OverflowAction: "drop_oldest_chunk"})
+	assert.NilError(t, err)
+	assert.Equal(t, cm.Data["fluentd.conf"], `<source>
+  @type tail
+  path /data/log/*
+  pos_file /data/pos
+  tag host.path.*
+  format json
+  read_from_head true
+  path_key path
+</source>
+<source>
+  @type prometheus
+  port 24231
+</source>
+<source>
+  @type prometheus_output_monitor
+</source>
+<filter **>
+  @type prometheus
+  <metric>
+    name k8s_event_total
+    type counter
+    desc The total number of kubernetes events
+    <labels>
+      namespace $.involvedObject.namespace
+      kind $.involvedObject.kind
+      name $.involvedObject.name
+      reason $.reason
+      type $.type
+    </labels>
+  </metric>
+</filter>
+<match **>
+  @type kafka2
+  brokers "10.0.0.1:9092,10.0.0.2:9092"
+  default_topic "events"
+  required_acks -1
+  username "user"
+  password "password"
+  scram_mechanism sha512
+  sasl_over_ssl true
+  ssl_ca_cert /etc/fluentd/tls/ca.crt
+  <format>
+    @type json
+  </format>
+  flush_interval 5s
+  <buffer>
+    flush_mode interval
+    retry_type exponential_backoff
+    total_limit_size 32MB
+    chunk_limit_size 1MB
+    chunk_full_threshold 0.8
+    @type file
+    path /var/log/td-agent/buffer/ccs.cluster.log_collector.buffer.audit-event-collector.host-path
+    overflow_action drop_oldest_chunk
+    flush_interval 1s
+    flush_thread_burst_interval 0.01
+    chunk_limit_records 8000
+   </buffer>
+</match>
+`)
+	assert.Equal(t, backend.Kafka.SASL.Password, "cGFzc3dvcmQ=")
+	assert.Equal(t, string(c.makeTLSSecret(backend).Data["ca.crt"]), "ca")
+}
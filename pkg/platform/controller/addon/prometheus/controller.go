@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math/rand"
 	"reflect"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -101,6 +102,9 @@ const (
 	PrometheusRuleAlert          = "prometheus-alerts"
 	prometheusConfigName         = "prometheus.config.yaml"
 	prometheusImagePath          = "prometheus"
+	// gpuMemoryUnitAnnotation records the GPU memory unit of the record rules,
+	// which are updated if the unit of cluster is changed.
+	gpuMemoryUnitAnnotation = v1.GroupName + "/gpu-memory-unit-mib"
 
 	// AlertManagerService defines the service for alert manager app
 	AlertManagerService = "alertmanager"
@@ -547,6 +551,13 @@ func (c *Controller) createPrometheusIfNeeded(ctx context.Context, key string, c
 			return c.persistUpdate(ctx, prometheus)
 		}
 
+		if cachedPrometheus.state != nil && remoteWriteChanged(cachedPrometheus.state, prometheus) {
+			log.Info("Prometheus remote write changed", log.String("prome", key))
+			if err := c.applyRemoteWrite(ctx, prometheus); err != nil {
+				return err
+			}
+		}
+
 		if _, ok := c.health.Load(key); !ok {
 			c.health.Store(key, prometheus)
 			go wait.PollImmediateUntil(5*time.Minute, c.watchPrometheusHealth(ctx, key), c.stopCh)
@@ -747,7 +758,7 @@ func (c *Controller) installPrometheus(ctx context.Context, prometheus *v1.Prome
 		return fmt.Errorf("create prometheus Service failed: %v", err)
 	}
 	// Secret for prometheus
-	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, createSecretForPrometheus(), metav1.CreateOptions{}); err != nil {
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, createSecretForPrometheus(cluster), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus Secret failed: %v", err)
 	}
 	// ServiceAccount for prometheus
@@ -763,13 +774,21 @@ func (c *Controller) installPrometheus(ctx context.Context, prometheus *v1.Prome
 		return fmt.Errorf("create prometheus ClusterRoleBinding failed: %v", err)
 	}
 	// prometheus rule record
-	if _, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Create(ctx, recordsForPrometheus(), metav1.CreateOptions{}); err != nil {
+	if _, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Create(ctx, recordsForPrometheus(cluster), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus rule record failed: %v", err)
 	}
 	// prometheus rule alert, empty for now, edit by tke-monitor
 	if _, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Create(ctx, alertsForPrometheus(), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus rule alert failed: %v", err)
 	}
+	// Secret for remote write credentials
+	secretRemoteWrite, err := secretRemoteWritePrometheus(prometheus)
+	if err != nil {
+		return err
+	}
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secretRemoteWrite, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("create prometheus remote write secret failed: %v", err)
+	}
 	// Crd prometheus instance
 	if _, err := mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Create(ctx, createPrometheusCRD(components, prometheus, cluster, remoteWrites, remoteReads, c.remoteType), metav1.CreateOptions{}); err != nil {
 		return fmt.Errorf("create prometheus crd instance failed: %v", err)
@@ -1283,6 +1302,8 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 
 		remoteWriteSpecs = append(remoteWriteSpecs, rw)
 	}
+	remoteWriteSpecs = append(remoteWriteSpecs, remoteWriteSpecsForPrometheus(prometheus)...)
+	retention, retentionSize := retentionForPrometheus(prometheus)
 	monitorV1Prometheus := &monitoringv1.Prometheus{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: monitoring.GroupName + "/v1",
@@ -1300,19 +1321,19 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 					"prometheus.io/port":   "9090",
 				},
 			},
-			ExternalLabels:     map[string]string{"cluster_id": cluster.Name, "tenant_id": prometheus.Spec.TenantID, "cluster_display_name": cluster.Spec.DisplayName},
+			ExternalLabels:     externalLabelsForPrometheus(prometheus, cluster),
 			ScrapeInterval:     "60s",
 			RemoteRead:         remoteReadSpecs,
 			RemoteWrite:        remoteWriteSpecs,
-			Retention:          "30m",
-			RetentionSize:      "5GB",
+			Retention:          retention,
+			RetentionSize:      retentionSize,
 			EvaluationInterval: "1m",
 			AdditionalScrapeConfigs: &corev1.SecretKeySelector{
 				LocalObjectReference: corev1.LocalObjectReference{Name: prometheusSecret},
 				Key:                  prometheusConfigName,
 				Optional:             controllerutil.BoolPtr(false),
 			},
-			Secrets: []string{prometheusETCDSecret},
+			Secrets: []string{prometheusETCDSecret, prometheusRemoteWriteSecret},
 			Alerting: &monitoringv1.AlertingSpec{
 				Alertmanagers: []monitoringv1.AlertmanagerEndpoints{
 					{
@@ -1324,16 +1345,7 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 			},
 			BaseImage: containerregistryutil.GetImagePrefix(prometheusImagePath),
 			Replicas:  controllerutil.Int32Ptr(1),
-			Resources: corev1.ResourceRequirements{
-				Requests: corev1.ResourceList{
-					corev1.ResourceCPU:    *resource.NewMilliQuantity(100, resource.DecimalSI),
-					corev1.ResourceMemory: *resource.NewQuantity(128*1024*1024, resource.BinarySI),
-				},
-				Limits: corev1.ResourceList{
-					corev1.ResourceCPU:    *resource.NewMilliQuantity(4000, resource.DecimalSI),
-					corev1.ResourceMemory: *resource.NewQuantity(8*1024*1024*1024, resource.BinarySI),
-				},
-			},
+			Resources: resourcesForPrometheus(prometheus),
 			Tolerations: []corev1.Toleration{
 				{
 					Key:      "node-role.kubernetes.io/master",
@@ -1375,24 +1387,11 @@ func createPrometheusCRD(components images.Components, prometheus *v1.Prometheus
 			},
 		}
 	}
-	if len(prometheus.Spec.Resources.Requests) > 0 {
-		resources := corev1.ResourceRequirements{
-			Limits:   corev1.ResourceList{},
-			Requests: corev1.ResourceList{},
-		}
-		for k, v := range prometheus.Spec.Resources.Limits {
-			resources.Limits[corev1.ResourceName(k)] = v
-		}
-		for k, v := range prometheus.Spec.Resources.Requests {
-			resources.Requests[corev1.ResourceName(k)] = v
-		}
-		monitorV1Prometheus.Spec.Resources = resources
-	}
 	return monitorV1Prometheus
 }
 
-func createSecretForPrometheus() *corev1.Secret {
-	config := scrapeConfigForPrometheus()
+func createSecretForPrometheus(cluster *v1.Cluster) *corev1.Secret {
+	config := scrapeConfigForPrometheus(!cluster.KubeletServerTLSBootstrapEnabled())
 
 	return &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1421,8 +1420,9 @@ func secretETCDPrometheus(cred *v1.ClusterCredential) *corev1.Secret {
 	}
 }
 
-func recordsForPrometheus() *monitoringv1.PrometheusRule {
-	records := recordRulesForPrometheus()
+func recordsForPrometheus(cluster *v1.Cluster) *monitoringv1.PrometheusRule {
+	gpuMemoryUnit := cluster.GPUMemoryUnitMiB()
+	records := recordRulesForPrometheus(gpuMemoryUnit)
 	reader := strings.NewReader(records)
 	prometheusRuleSpec := &monitoringv1.PrometheusRuleSpec{}
 	err := yaml.NewYAMLOrJSONDecoder(reader, 4096).Decode(prometheusRuleSpec)
@@ -1436,14 +1436,40 @@ func recordsForPrometheus() *monitoringv1.PrometheusRule {
 			Kind:       monitoringv1.PrometheusRuleKind,
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      prometheusRuleRecord,
-			Namespace: metav1.NamespaceSystem,
-			Labels:    map[string]string{PrometheusService: PrometheusCRDName, "role": "alert-rules"},
+			Name:        prometheusRuleRecord,
+			Namespace:   metav1.NamespaceSystem,
+			Labels:      map[string]string{PrometheusService: PrometheusCRDName, "role": "alert-rules"},
+			Annotations: map[string]string{gpuMemoryUnitAnnotation: strconv.Itoa(int(gpuMemoryUnit))},
 		},
 		Spec: *prometheusRuleSpec,
 	}
 }
 
+// ensureRecordsForPrometheus updates the record rules if the GPU memory unit of
+// cluster is changed.
+func (c *Controller) ensureRecordsForPrometheus(ctx context.Context, cluster *v1.Cluster) error {
+	mclient, err := util.BuildExternalMonitoringClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	rule, err := mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Get(ctx, prometheusRuleRecord, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	records := recordsForPrometheus(cluster)
+	if records == nil || rule.Annotations[gpuMemoryUnitAnnotation] == records.Annotations[gpuMemoryUnitAnnotation] {
+		return nil
+	}
+	rule = rule.DeepCopy()
+	if rule.Annotations == nil {
+		rule.Annotations = make(map[string]string)
+	}
+	rule.Annotations[gpuMemoryUnitAnnotation] = records.Annotations[gpuMemoryUnitAnnotation]
+	rule.Spec = records.Spec
+	_, err = mclient.MonitoringV1().PrometheusRules(metav1.NamespaceSystem).Update(ctx, rule, metav1.UpdateOptions{})
+	return err
+}
+
 func alertsForPrometheus() *monitoringv1.PrometheusRule {
 	return &monitoringv1.PrometheusRule{
 		TypeMeta: metav1.TypeMeta{
@@ -2609,6 +2635,10 @@ func (c *Controller) uninstallPrometheus(ctx context.Context, prometheus *v1.Pro
 	if err != nil && !errors.IsNotFound(err) {
 		errs = append(errs, err)
 	}
+	err = kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Delete(ctx, prometheusRemoteWriteSecret, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		errs = append(errs, err)
+	}
 	err = kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, prometheusClusterRoleBinding, metav1.DeleteOptions{})
 	if err != nil && !errors.IsNotFound(err) {
 		errs = append(errs, err)
@@ -2898,6 +2928,9 @@ func (c *Controller) watchPrometheusHealth(ctx context.Context, key string) func
 			return true, nil
 		}
 		log.Debug("Prometheus health is ok", log.String("prome", key))
+		if err := c.ensureRecordsForPrometheus(ctx, cluster); err != nil {
+			log.Error("Update prometheus record rules failed", log.String("prome", key), log.Err(err))
+		}
 		return false, nil
 	}
 }
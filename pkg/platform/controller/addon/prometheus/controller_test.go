@@ -0,0 +1,72 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"strings"
+	"testing"
+
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestRecordsForPrometheus(t *testing.T) {
+	tests := []struct {
+		name           string
+		gpuManager     *v1.GPUManagerFeature
+		wantUnit       string
+		wantUsedBytes  string
+		wantPodRequest string
+	}{
+		{"default unit", nil, "256",
+			"k8s_pod_gpu_memory_used * 256 * 1024 * 1024", "sum(container_request_gpu_memory / 256)  without(container_name)"},
+		{"configured unit", &v1.GPUManagerFeature{MemoryUnitMiB: 1024}, "1024",
+			"k8s_pod_gpu_memory_used * 1024 * 1024 * 1024", "sum(container_request_gpu_memory / 1024)  without(container_name)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &v1.Cluster{Spec: v1.ClusterSpec{Features: v1.ClusterFeature{GPUManager: tt.gpuManager}}}
+			records := recordsForPrometheus(cluster)
+			if records == nil {
+				t.Fatal("recordsForPrometheus() = nil")
+			}
+			if got := records.Annotations[gpuMemoryUnitAnnotation]; got != tt.wantUnit {
+				t.Errorf("annotation %s = %q, want %q", gpuMemoryUnitAnnotation, got, tt.wantUnit)
+			}
+			exprs := map[string]string{}
+			for _, group := range records.Spec.Groups {
+				for _, rule := range group.Rules {
+					exprs[rule.Record] = rule.Expr.String()
+				}
+			}
+			if got := exprs["k8s_pod_gpu_memory_used_bytes"]; got != tt.wantUsedBytes {
+				t.Errorf("k8s_pod_gpu_memory_used_bytes = %q, want %q", got, tt.wantUsedBytes)
+			}
+			if got := exprs["k8s_pod_gpu_memory_request"]; got != tt.wantPodRequest {
+				t.Errorf("k8s_pod_gpu_memory_request = %q, want %q", got, tt.wantPodRequest)
+			}
+		})
+	}
+}
+
+func TestRecordRulesForPrometheusFormatted(t *testing.T) {
+	// the rules are a format string, a literal % breaks the other rules
+	if rules := recordRulesForPrometheus(256); strings.Contains(rules, "%!") {
+		t.Errorf("recordRulesForPrometheus() has a bad format verb")
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"path"
+	"reflect"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+const (
+	// prometheusRemoteWriteSecret stores the credentials of the remote write
+	// endpoints in spec, mounted by prometheus-operator at
+	// /etc/prometheus/secrets/prometheus-remote-write.
+	prometheusRemoteWriteSecret = "prometheus-remote-write"
+	prometheusSecretsPath       = "/etc/prometheus/secrets"
+
+	defaultRetention     = "30m"
+	defaultRetentionSize = "5GB"
+)
+
+// remoteWriteSecretKey returns the key of a credential of the remote write
+// endpoint in the secret.
+func remoteWriteSecretKey(name, item string) string {
+	return name + "-" + item
+}
+
+func remoteWriteSecretFile(name, item string) string {
+	return path.Join(prometheusSecretsPath, prometheusRemoteWriteSecret, remoteWriteSecretKey(name, item))
+}
+
+// secretRemoteWritePrometheus collects the decoded credentials of all remote
+// write endpoints, the secret is created even if empty since it is always
+// mounted to prometheus.
+func secretRemoteWritePrometheus(prometheus *v1.Prometheus) (*corev1.Secret, error) {
+	data := make(map[string][]byte)
+	for _, rw := range prometheus.Spec.RemoteWrites {
+		if rw.BasicAuth != nil {
+			password, err := base64.StdEncoding.DecodeString(rw.BasicAuth.Password)
+			if err != nil {
+				return nil, fmt.Errorf("decode password of remote write %s failed: %v", rw.Name, err)
+			}
+			data[remoteWriteSecretKey(rw.Name, "username")] = []byte(rw.BasicAuth.User)
+			data[remoteWriteSecretKey(rw.Name, "password")] = password
+		}
+		if rw.BearerToken != "" {
+			token, err := base64.StdEncoding.DecodeString(rw.BearerToken)
+			if err != nil {
+				return nil, fmt.Errorf("decode bearer token of remote write %s failed: %v", rw.Name, err)
+			}
+			data[remoteWriteSecretKey(rw.Name, "token")] = token
+		}
+		if rw.TLS != nil {
+			if rw.TLS.CACert != "" {
+				data[remoteWriteSecretKey(rw.Name, "ca.crt")] = []byte(rw.TLS.CACert)
+			}
+			if rw.TLS.ClientCert != "" {
+				data[remoteWriteSecretKey(rw.Name, "client.crt")] = []byte(rw.TLS.ClientCert)
+				data[remoteWriteSecretKey(rw.Name, "client.key")] = []byte(rw.TLS.ClientKey)
+			}
+		}
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      prometheusRemoteWriteSecret,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}, nil
+}
+
+// remoteWriteSpecsForPrometheus converts the remote write endpoints in spec,
+// which are named so that they can be told from the built-in ones.
+func remoteWriteSpecsForPrometheus(prometheus *v1.Prometheus) []monitoringv1.RemoteWriteSpec {
+	var specs []monitoringv1.RemoteWriteSpec
+	for _, rw := range prometheus.Spec.RemoteWrites {
+		spec := monitoringv1.RemoteWriteSpec{
+			Name: rw.Name,
+			URL:  rw.URL,
+		}
+		if rw.BasicAuth != nil {
+			spec.BasicAuth = &monitoringv1.BasicAuth{
+				Username: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: prometheusRemoteWriteSecret},
+					Key:                  remoteWriteSecretKey(rw.Name, "username"),
+				},
+				Password: corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: prometheusRemoteWriteSecret},
+					Key:                  remoteWriteSecretKey(rw.Name, "password"),
+				},
+			}
+		}
+		if rw.BearerToken != "" {
+			spec.BearerTokenFile = remoteWriteSecretFile(rw.Name, "token")
+		}
+		if rw.TLS != nil {
+			spec.TLSConfig = &monitoringv1.TLSConfig{
+				InsecureSkipVerify: rw.TLS.InsecureSkipVerify,
+			}
+			if rw.TLS.CACert != "" {
+				spec.TLSConfig.CAFile = remoteWriteSecretFile(rw.Name, "ca.crt")
+			}
+			if rw.TLS.ClientCert != "" {
+				spec.TLSConfig.CertFile = remoteWriteSecretFile(rw.Name, "client.crt")
+				spec.TLSConfig.KeyFile = remoteWriteSecretFile(rw.Name, "client.key")
+			}
+		}
+		if rw.MetricsRegex != "" {
+			spec.WriteRelabelConfigs = []monitoringv1.RelabelConfig{
+				{
+					SourceLabels: []string{"__name__"},
+					Regex:        rw.MetricsRegex,
+					Action:       "keep",
+				},
+			}
+		}
+		specs = append(specs, spec)
+	}
+	return specs
+}
+
+// externalLabelsForPrometheus merges the external labels in spec with the
+// built-in ones, which take precedence.
+func externalLabelsForPrometheus(prometheus *v1.Prometheus, cluster *v1.Cluster) map[string]string {
+	labels := make(map[string]string, len(prometheus.Spec.ExternalLabels)+3)
+	for k, v := range prometheus.Spec.ExternalLabels {
+		labels[k] = v
+	}
+	labels["cluster_id"] = cluster.Name
+	labels["tenant_id"] = prometheus.Spec.TenantID
+	labels["cluster_display_name"] = cluster.Spec.DisplayName
+	return labels
+}
+
+func retentionForPrometheus(prometheus *v1.Prometheus) (string, string) {
+	retention, retentionSize := defaultRetention, defaultRetentionSize
+	if prometheus.Spec.Retention != "" {
+		retention = prometheus.Spec.Retention
+	}
+	if prometheus.Spec.RetentionSize != "" {
+		retentionSize = prometheus.Spec.RetentionSize
+	}
+	return retention, retentionSize
+}
+
+// resourcesForPrometheus returns the resources of prometheus, Resources in
+// spec takes precedence over SizingTier.
+func resourcesForPrometheus(prometheus *v1.Prometheus) corev1.ResourceRequirements {
+	if len(prometheus.Spec.Resources.Requests) > 0 {
+		resources := corev1.ResourceRequirements{
+			Limits:   corev1.ResourceList{},
+			Requests: corev1.ResourceList{},
+		}
+		for k, v := range prometheus.Spec.Resources.Limits {
+			resources.Limits[corev1.ResourceName(k)] = v
+		}
+		for k, v := range prometheus.Spec.Resources.Requests {
+			resources.Requests[corev1.ResourceName(k)] = v
+		}
+		return resources
+	}
+
+	requestCPU, requestMemory, limitCPU, limitMemory := int64(100), int64(128), int64(4000), int64(8*1024)
+	switch prometheus.Spec.SizingTier {
+	case v1.PrometheusSizingSmall:
+		requestCPU, requestMemory, limitCPU, limitMemory = 500, 1024, 2000, 4*1024
+	case v1.PrometheusSizingMedium:
+		requestCPU, requestMemory, limitCPU, limitMemory = 1000, 4*1024, 4000, 8*1024
+	case v1.PrometheusSizingLarge:
+		requestCPU, requestMemory, limitCPU, limitMemory = 2000, 8*1024, 8000, 16*1024
+	}
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewMilliQuantity(requestCPU, resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(requestMemory*1024*1024, resource.BinarySI),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceCPU:    *resource.NewMilliQuantity(limitCPU, resource.DecimalSI),
+			corev1.ResourceMemory: *resource.NewQuantity(limitMemory*1024*1024, resource.BinarySI),
+		},
+	}
+}
+
+// remoteWriteChanged returns true if the remote writes, external labels,
+// retention or resources of prometheus are changed.
+func remoteWriteChanged(old, prometheus *v1.Prometheus) bool {
+	return !reflect.DeepEqual(old.Spec.RemoteWrites, prometheus.Spec.RemoteWrites) ||
+		!reflect.DeepEqual(old.Spec.ExternalLabels, prometheus.Spec.ExternalLabels) ||
+		old.Spec.Retention != prometheus.Spec.Retention ||
+		old.Spec.RetentionSize != prometheus.Spec.RetentionSize ||
+		old.Spec.SizingTier != prometheus.Spec.SizingTier ||
+		!reflect.DeepEqual(old.Spec.Resources, prometheus.Spec.Resources)
+}
+
+// applyRemoteWrite updates the secret and the prometheus instance with the
+// remote writes, external labels, retention and resources in spec, the
+// built-in remote writes are kept.
+func (c *Controller) applyRemoteWrite(ctx context.Context, prometheus *v1.Prometheus) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, prometheus.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get cluster failed: %v", err)
+	}
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return fmt.Errorf("get kubeClient failed: %v", err)
+	}
+	mclient, err := util.BuildExternalMonitoringClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return fmt.Errorf("get mclient failed: %v", err)
+	}
+
+	if err := ensureRemoteWriteSecret(ctx, kubeClient, prometheus); err != nil {
+		return err
+	}
+
+	instance, err := mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Get(ctx, PrometheusCRDName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get prometheus crd instance failed: %v", err)
+	}
+	var remoteWrites []monitoringv1.RemoteWriteSpec
+	for _, rw := range instance.Spec.RemoteWrite {
+		if rw.Name == "" {
+			remoteWrites = append(remoteWrites, rw)
+		}
+	}
+	instance.Spec.RemoteWrite = append(remoteWrites, remoteWriteSpecsForPrometheus(prometheus)...)
+	instance.Spec.ExternalLabels = externalLabelsForPrometheus(prometheus, cluster)
+	instance.Spec.Retention, instance.Spec.RetentionSize = retentionForPrometheus(prometheus)
+	instance.Spec.Resources = resourcesForPrometheus(prometheus)
+	if !containsString(instance.Spec.Secrets, prometheusRemoteWriteSecret) {
+		instance.Spec.Secrets = append(instance.Spec.Secrets, prometheusRemoteWriteSecret)
+	}
+	if _, err := mclient.MonitoringV1().Prometheuses(metav1.NamespaceSystem).Update(ctx, instance, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update prometheus crd instance failed: %v", err)
+	}
+	return nil
+}
+
+// ensureRemoteWriteSecret creates or updates the secret of the remote write
+// credentials.
+func ensureRemoteWriteSecret(ctx context.Context, kubeClient kubernetes.Interface, prometheus *v1.Prometheus) error {
+	secret, err := secretRemoteWritePrometheus(prometheus)
+	if err != nil {
+		return err
+	}
+	old, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, prometheusRemoteWriteSecret, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("create prometheus remote write secret failed: %v", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("get prometheus remote write secret failed: %v", err)
+	}
+	old.Data = secret.Data
+	if _, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Update(ctx, old, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update prometheus remote write secret failed: %v", err)
+	}
+	return nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
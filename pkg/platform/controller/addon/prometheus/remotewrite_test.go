@@ -0,0 +1,259 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"context"
+	"encoding/base64"
+	"reflect"
+	"testing"
+
+	monitoringv1 "github.com/coreos/prometheus-operator/pkg/apis/monitoring/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newRemoteWritePrometheus() *v1.Prometheus {
+	return &v1.Prometheus{
+		Spec: v1.PrometheusSpec{
+			TenantID:    "default",
+			ClusterName: "cls-a",
+			RemoteWrites: []v1.PrometheusRemoteWrite{
+				{
+					Name:      "thanos",
+					URL:       "https://thanos.example.com/api/v1/receive",
+					BasicAuth: &v1.PrometheusBasicAuth{User: "admin", Password: base64.StdEncoding.EncodeToString([]byte("secret"))},
+					TLS: &v1.PrometheusTLSConfig{
+						CACert:             "ca",
+						ClientCert:         "cert",
+						ClientKey:          "key",
+						InsecureSkipVerify: true,
+					},
+					MetricsRegex: "kube_.*",
+				},
+				{
+					Name:        "cortex",
+					URL:         "http://cortex.example.com/api/prom/push",
+					BearerToken: base64.StdEncoding.EncodeToString([]byte("token")),
+				},
+			},
+		},
+	}
+}
+
+func TestSecretRemoteWritePrometheus(t *testing.T) {
+	secret, err := secretRemoteWritePrometheus(newRemoteWritePrometheus())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"thanos-username":   "admin",
+		"thanos-password":   "secret",
+		"thanos-ca.crt":     "ca",
+		"thanos-client.crt": "cert",
+		"thanos-client.key": "key",
+		"cortex-token":      "token",
+	}
+	got := make(map[string]string)
+	for k, v := range secret.Data {
+		got[k] = string(v)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("secret data = %v, want %v", got, want)
+	}
+
+	// the secret is created without remote writes since it is always mounted
+	secret, err = secretRemoteWritePrometheus(&v1.Prometheus{})
+	if err != nil || secret.Name != prometheusRemoteWriteSecret || len(secret.Data) != 0 {
+		t.Errorf("secret without remote writes = %v, %v", secret, err)
+	}
+
+	invalid := newRemoteWritePrometheus()
+	invalid.Spec.RemoteWrites[1].BearerToken = "not base64"
+	if _, err := secretRemoteWritePrometheus(invalid); err == nil {
+		t.Error("secretRemoteWritePrometheus() with invalid token should fail")
+	}
+}
+
+func TestRemoteWriteSpecsForPrometheus(t *testing.T) {
+	specs := remoteWriteSpecsForPrometheus(newRemoteWritePrometheus())
+	if len(specs) != 2 {
+		t.Fatalf("got %d remote writes, want 2", len(specs))
+	}
+
+	thanos := specs[0]
+	if thanos.Name != "thanos" || thanos.BasicAuth == nil || thanos.BasicAuth.Password.Key != "thanos-password" ||
+		thanos.BasicAuth.Password.Name != prometheusRemoteWriteSecret {
+		t.Errorf("basic auth of thanos = %+v", thanos.BasicAuth)
+	}
+	wantTLS := &monitoringv1.TLSConfig{
+		CAFile:             "/etc/prometheus/secrets/prometheus-remote-write/thanos-ca.crt",
+		CertFile:           "/etc/prometheus/secrets/prometheus-remote-write/thanos-client.crt",
+		KeyFile:            "/etc/prometheus/secrets/prometheus-remote-write/thanos-client.key",
+		InsecureSkipVerify: true,
+	}
+	if !reflect.DeepEqual(thanos.TLSConfig, wantTLS) {
+		t.Errorf("tls of thanos = %+v, want %+v", thanos.TLSConfig, wantTLS)
+	}
+	if len(thanos.WriteRelabelConfigs) != 1 || thanos.WriteRelabelConfigs[0].Regex != "kube_.*" || thanos.WriteRelabelConfigs[0].Action != "keep" {
+		t.Errorf("relabel configs of thanos = %+v", thanos.WriteRelabelConfigs)
+	}
+
+	cortex := specs[1]
+	if cortex.BearerTokenFile != "/etc/prometheus/secrets/prometheus-remote-write/cortex-token" {
+		t.Errorf("bearer token file of cortex = %s", cortex.BearerTokenFile)
+	}
+	if cortex.BasicAuth != nil || cortex.TLSConfig != nil || cortex.WriteRelabelConfigs != nil {
+		t.Errorf("cortex = %+v, want only the bearer token", cortex)
+	}
+}
+
+func TestExternalLabelsForPrometheus(t *testing.T) {
+	prometheus := &v1.Prometheus{Spec: v1.PrometheusSpec{
+		TenantID:       "default",
+		ExternalLabels: map[string]string{"region": "gz", "cluster_id": "fake"},
+	}}
+	cluster := &v1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+		Spec:       v1.ClusterSpec{DisplayName: "prod"},
+	}
+	want := map[string]string{
+		"region":               "gz",
+		"cluster_id":           "cls-a",
+		"tenant_id":            "default",
+		"cluster_display_name": "prod",
+	}
+	if got := externalLabelsForPrometheus(prometheus, cluster); !reflect.DeepEqual(got, want) {
+		t.Errorf("externalLabelsForPrometheus() = %v, want %v", got, want)
+	}
+}
+
+func TestRetentionForPrometheus(t *testing.T) {
+	retention, size := retentionForPrometheus(&v1.Prometheus{})
+	if retention != defaultRetention || size != defaultRetentionSize {
+		t.Errorf("default retention = %s %s", retention, size)
+	}
+	retention, size = retentionForPrometheus(&v1.Prometheus{Spec: v1.PrometheusSpec{Retention: "15d", RetentionSize: "50GB"}})
+	if retention != "15d" || size != "50GB" {
+		t.Errorf("retention = %s %s, want 15d 50GB", retention, size)
+	}
+}
+
+func TestResourcesForPrometheus(t *testing.T) {
+	tests := []struct {
+		name          string
+		spec          v1.PrometheusSpec
+		requestCPU    string
+		limitMemory   string
+		requestMemory string
+	}{
+		{name: "default", requestCPU: "100m", requestMemory: "128Mi", limitMemory: "8Gi"},
+		{name: "small", spec: v1.PrometheusSpec{SizingTier: v1.PrometheusSizingSmall}, requestCPU: "500m", requestMemory: "1Gi", limitMemory: "4Gi"},
+		{name: "medium", spec: v1.PrometheusSpec{SizingTier: v1.PrometheusSizingMedium}, requestCPU: "1", requestMemory: "4Gi", limitMemory: "8Gi"},
+		{name: "large", spec: v1.PrometheusSpec{SizingTier: v1.PrometheusSizingLarge}, requestCPU: "2", requestMemory: "8Gi", limitMemory: "16Gi"},
+		{
+			name: "resources take precedence over tier",
+			spec: v1.PrometheusSpec{
+				SizingTier: v1.PrometheusSizingLarge,
+				Resources: v1.ResourceRequirements{
+					Requests: v1.ResourceList{"cpu": resource.MustParse("3"), "memory": resource.MustParse("2Gi")},
+					Limits:   v1.ResourceList{"memory": resource.MustParse("6Gi")},
+				},
+			},
+			requestCPU:    "3",
+			requestMemory: "2Gi",
+			limitMemory:   "6Gi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resourcesForPrometheus(&v1.Prometheus{Spec: tt.spec})
+			for _, q := range []struct {
+				value resource.Quantity
+				want  string
+			}{
+				{got.Requests[corev1.ResourceCPU], tt.requestCPU},
+				{got.Requests[corev1.ResourceMemory], tt.requestMemory},
+				{got.Limits[corev1.ResourceMemory], tt.limitMemory},
+			} {
+				if q.value.Cmp(resource.MustParse(q.want)) != 0 {
+					t.Errorf("resourcesForPrometheus() = %v, want %s", got, q.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRemoteWriteChanged(t *testing.T) {
+	old := newRemoteWritePrometheus()
+	tests := []struct {
+		name   string
+		update func(*v1.Prometheus)
+		want   bool
+	}{
+		{name: "unchanged", update: func(p *v1.Prometheus) {}},
+		{name: "status", update: func(p *v1.Prometheus) { p.Status.Phase = v1.AddonPhaseRunning }},
+		{name: "remote write", update: func(p *v1.Prometheus) { p.Spec.RemoteWrites[0].URL = "https://other" }, want: true},
+		{name: "external labels", update: func(p *v1.Prometheus) { p.Spec.ExternalLabels = map[string]string{"a": "b"} }, want: true},
+		{name: "retention", update: func(p *v1.Prometheus) { p.Spec.Retention = "1d" }, want: true},
+		{name: "retention size", update: func(p *v1.Prometheus) { p.Spec.RetentionSize = "1GB" }, want: true},
+		{name: "sizing tier", update: func(p *v1.Prometheus) { p.Spec.SizingTier = v1.PrometheusSizingSmall }, want: true},
+		{
+			name: "resources",
+			update: func(p *v1.Prometheus) {
+				p.Spec.Resources.Requests = v1.ResourceList{"cpu": resource.MustParse("1")}
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prometheus := old.DeepCopy()
+			tt.update(prometheus)
+			if got := remoteWriteChanged(old, prometheus); got != tt.want {
+				t.Errorf("remoteWriteChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureRemoteWriteSecret(t *testing.T) {
+	ctx := context.Background()
+	kubeClient := fake.NewSimpleClientset()
+	prometheus := newRemoteWritePrometheus()
+	if err := ensureRemoteWriteSecret(ctx, kubeClient, prometheus); err != nil {
+		t.Fatal(err)
+	}
+
+	// the credentials of the removed remote writes are removed from secret
+	prometheus.Spec.RemoteWrites = prometheus.Spec.RemoteWrites[1:]
+	if err := ensureRemoteWriteSecret(ctx, kubeClient, prometheus); err != nil {
+		t.Fatal(err)
+	}
+	secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, prometheusRemoteWriteSecret, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secret.Data) != 1 || string(secret.Data["cortex-token"]) != "token" {
+		t.Errorf("secret data = %v, want only the token of cortex", secret.Data)
+	}
+}
@@ -23,85 +23,10 @@ import (
 	"strings"
 )
 
-func scrapeConfigForPrometheus() string {
-	cfgStr := `
-    # Use kubelet_running_pod_count to get kube node labels
-    - job_name: 'kubernetes-nodes'
-      scrape_timeout: 60s
-      scheme: https
-      tls_config:
-        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
-        insecure_skip_verify: true
-      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
-      kubernetes_sd_configs:
-      - role: node
-      relabel_configs:
-      - action: labelmap
-        regex: __meta_kubernetes_node_label_(.+)
-      - source_labels: [ "__meta_kubernetes_node_annotation_device_type" ]
-        target_label: device_type
-      metric_relabel_configs:
-      - source_labels: [ __name__ ]
-        regex: 'kubelet_running_pod_count|kubelet_volume_stats_used_bytes|kubelet_volume_stats_available_bytes|kubelet_volume_stats_capacity_bytes|kubelet_pleg_relist_duration_seconds_sum|kubelet_pleg_relist_duration_seconds_count|kubelet_docker_operations_errors|kubelet_docker_operations_errors_total|kubelet_running_container_count|volume_manager_total_volumes|kubelet_node_config_error|kubelet_runtime_operations_total|kubelet_runtime_operations_errors_total|kubelet_pod_start_duration_seconds_(.*)|kubelet_pod_worker_duration_seconds_(.*)|storage_operation_duration_seconds_(.*)|storage_operation_errors_total|kubelet_runtime_operations_duration_seconds_(.*)|kubelet_cgroup_manager_duration_seconds_(.*)|go_goroutines|process_resident_memory_bytes|process_cpu_seconds_total|rest_client_requests_total|rest_client_request_latency_seconds_(.*)'
-        action: keep
-      - regex: (__name__|instance|node_role_kubernetes_io_master|device_type|state|namespace|persistentvolumeclaim|operation_type|container_state|plugin_name|operation_name|volume_plugin)
-        action: labelkeep
-      - source_labels: [ __name__ ]
-        target_label: "node_role"
-        replacement: "Node"
-      - source_labels: [node_role_kubernetes_io_master]
-        regex: "true"
-        target_label: "node_role"
-        replacement: "Master"
-      - source_labels: [instance]
-        target_label: "node"
-      - regex: "instance|node_role_kubernetes_io_master"
-        action: labeldrop
-
-    - job_name: 'kubernetes-cadvisor'
-      scrape_timeout: 60s
-      scheme: https
-      tls_config:
-        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
-        insecure_skip_verify: true
-      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
-      kubernetes_sd_configs:
-      - role: node
-      relabel_configs:
-      - action: labelmap
-        regex: __meta_kubernetes_node_label_(.+)
-      - target_label: __metrics_path__
-        replacement: /metrics/cadvisor
-      metric_relabel_configs:
-      - source_labels: [ __name__ ]
-        regex: 'container_fs_writes_bytes_total|container_fs_reads_bytes_total|container_fs_writes_total|container_fs_reads_total|container_cpu_usage_seconds_total|container_memory_usage_bytes|container_memory_cache|container_network_receive_bytes_total|container_network_transmit_bytes_total|container_network_receive_packets_total|container_network_transmit_packets_total|container_spec_cpu_quota|container_spec_cpu_period|container_spec_memory_limit_bytes|container_memory_working_set_bytes'
-        action: keep
-      - source_labels: [container]
-        regex: "(.+)"
-        action: replace
-        target_label: container_name
-      - source_labels: [pod]
-        regex: "(.+)"
-        action: replace
-        target_label: pod_name
-      - regex: (__name__|container_name|pod_name|namespace|cpu|interface|device)
-        action: labelkeep
-      - source_labels: [pod_name]
-        regex: "^$"
-        action: drop
-      - source_labels: [container_name]
-        regex: "^$"
-        action: drop
-      - source_labels: [container_name]
-        regex: "POD"
-        target_label: container_name
-        replacement: "pause"
-      - source_labels: [id]
-        regex: "/kubepods/(.*)pod(.*)/(.*)"
-        target_label: container_id
-        replacement: $2
-
-    - job_name: 'kube-state-metrics'
+// scrapeConfigForPrometheus returns the additional scrape configs. The serving
+// certificates of kubelet are verified by the cluster CA unless insecureKubelet.
+func scrapeConfigForPrometheus(insecureKubelet bool) string {
+	cfgStr := scrapeConfigForKubelet(insecureKubelet) + `    - job_name: 'kube-state-metrics'
       scrape_timeout: 60s
       tls_config:
         insecure_skip_verify: true
@@ -443,12 +368,126 @@ func scrapeConfigForPrometheus() string {
         action: keep
       - regex: "instance|job|pod_name|namespace|scope|subresource"
         action: labeldrop
+
+    # The log agents expose the metrics of the log collection rules on the
+    # port 24231 of the host network.
+    - job_name: 'tke-logagent'
+      scrape_timeout: 60s
+      honor_labels: false
+      kubernetes_sd_configs:
+      - role: pod
+        namespaces:
+          names:
+          - kube-system
+      relabel_configs:
+      - source_labels: [__meta_kubernetes_pod_label_app]
+        action: keep
+        regex: logagent-controller
+      - source_labels: [__meta_kubernetes_pod_container_name]
+        action: keep
+        regex: logagent
+      - source_labels: [__meta_kubernetes_pod_node_name]
+        action: replace
+        target_label: node
+      - source_labels: [__meta_kubernetes_pod_ip]
+        action: replace
+        replacement: $1:24231
+        target_label: __address__
+      metric_relabel_configs:
+      - source_labels: [ __name__ ]
+        regex: 'logcollector_(.*)|fluentd_output_status_(.*)'
+        action: keep
+      - regex: "instance|job|pod_name|namespace|scope|subresource"
+        action: labeldrop
 `
 	return cfgStr
 }
 
-func recordRulesForPrometheus() string {
-	rules := `
+func scrapeConfigForKubelet(insecureSkipVerify bool) string {
+	return fmt.Sprintf(`
+    # Use kubelet_running_pod_count to get kube node labels
+    - job_name: 'kubernetes-nodes'
+      scrape_timeout: 60s
+      scheme: https
+      tls_config:
+        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+        insecure_skip_verify: %t
+      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
+      kubernetes_sd_configs:
+      - role: node
+      relabel_configs:
+      - action: labelmap
+        regex: __meta_kubernetes_node_label_(.+)
+      - source_labels: [ "__meta_kubernetes_node_annotation_device_type" ]
+        target_label: device_type
+      metric_relabel_configs:
+      - source_labels: [ __name__ ]
+        regex: 'kubelet_running_pod_count|kubelet_volume_stats_used_bytes|kubelet_volume_stats_available_bytes|kubelet_volume_stats_capacity_bytes|kubelet_pleg_relist_duration_seconds_sum|kubelet_pleg_relist_duration_seconds_count|kubelet_docker_operations_errors|kubelet_docker_operations_errors_total|kubelet_running_container_count|volume_manager_total_volumes|kubelet_node_config_error|kubelet_runtime_operations_total|kubelet_runtime_operations_errors_total|kubelet_pod_start_duration_seconds_(.*)|kubelet_pod_worker_duration_seconds_(.*)|storage_operation_duration_seconds_(.*)|storage_operation_errors_total|kubelet_runtime_operations_duration_seconds_(.*)|kubelet_cgroup_manager_duration_seconds_(.*)|go_goroutines|process_resident_memory_bytes|process_cpu_seconds_total|rest_client_requests_total|rest_client_request_latency_seconds_(.*)'
+        action: keep
+      - regex: (__name__|instance|node_role_kubernetes_io_master|device_type|state|namespace|persistentvolumeclaim|operation_type|container_state|plugin_name|operation_name|volume_plugin)
+        action: labelkeep
+      - source_labels: [ __name__ ]
+        target_label: "node_role"
+        replacement: "Node"
+      - source_labels: [node_role_kubernetes_io_master]
+        regex: "true"
+        target_label: "node_role"
+        replacement: "Master"
+      - source_labels: [instance]
+        target_label: "node"
+      - regex: "instance|node_role_kubernetes_io_master"
+        action: labeldrop
+
+    - job_name: 'kubernetes-cadvisor'
+      scrape_timeout: 60s
+      scheme: https
+      tls_config:
+        ca_file: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt
+        insecure_skip_verify: %t
+      bearer_token_file: /var/run/secrets/kubernetes.io/serviceaccount/token
+      kubernetes_sd_configs:
+      - role: node
+      relabel_configs:
+      - action: labelmap
+        regex: __meta_kubernetes_node_label_(.+)
+      - target_label: __metrics_path__
+        replacement: /metrics/cadvisor
+      metric_relabel_configs:
+      - source_labels: [ __name__ ]
+        regex: 'container_fs_writes_bytes_total|container_fs_reads_bytes_total|container_fs_writes_total|container_fs_reads_total|container_cpu_usage_seconds_total|container_memory_usage_bytes|container_memory_cache|container_network_receive_bytes_total|container_network_transmit_bytes_total|container_network_receive_packets_total|container_network_transmit_packets_total|container_spec_cpu_quota|container_spec_cpu_period|container_spec_memory_limit_bytes|container_memory_working_set_bytes'
+        action: keep
+      - source_labels: [container]
+        regex: "(.+)"
+        action: replace
+        target_label: container_name
+      - source_labels: [pod]
+        regex: "(.+)"
+        action: replace
+        target_label: pod_name
+      - regex: (__name__|container_name|pod_name|namespace|cpu|interface|device)
+        action: labelkeep
+      - source_labels: [pod_name]
+        regex: "^$"
+        action: drop
+      - source_labels: [container_name]
+        regex: "^$"
+        action: drop
+      - source_labels: [container_name]
+        regex: "POD"
+        target_label: container_name
+        replacement: "pause"
+      - source_labels: [id]
+        regex: "/kubepods/(.*)pod(.*)/(.*)"
+        target_label: container_id
+        replacement: $2
+
+`, insecureSkipVerify, insecureSkipVerify)
+}
+
+// recordRulesForPrometheus returns the record rules, the GPU memory used and
+// requested are counted in units of gpuMemoryUnit MiB.
+func recordRulesForPrometheus(gpuMemoryUnit int32) string {
+	rules := fmt.Sprintf(`
 groups:
 - name: k8s-ag-data
   rules:
@@ -531,10 +570,10 @@ groups:
     expr: k8s_container_gpu_used * 100 / on(node) group_left kube_node_status_capacity_gpu
 
   - record: k8s_container_gpu_memory_used
-    expr: container_gpu_memory_total{gpu_memory="total"} / 256 * on(namespace, pod_name) group_left(workload_kind,workload_name,node, node_role) __pod_info2
+    expr: container_gpu_memory_total{gpu_memory="total"} / %[1]d * on(namespace, pod_name) group_left(workload_kind,workload_name,node, node_role) __pod_info2
 
   - record: k8s_container_rate_gpu_memory_used_request
-    expr: k8s_container_gpu_memory_used * 100 / on (pod_name,namespace,container_name) group_left() (container_request_gpu_memory / 256)
+    expr: k8s_container_gpu_memory_used * 100 / on (pod_name,namespace,container_name) group_left() (container_request_gpu_memory / %[1]d)
 
   - record: k8s_container_rate_gpu_memory_used_node
     expr: k8s_container_gpu_memory_used * 100 / on(node) group_left() kube_node_status_capacity_gpu_memory
@@ -627,7 +666,13 @@ groups:
     expr: sum(k8s_container_gpu_memory_used) without (container_name,container_id)
 
   - record: k8s_pod_gpu_memory_request
-    expr: sum(container_request_gpu_memory / 256)  without(container_name)
+    expr: sum(container_request_gpu_memory / %[1]d)  without(container_name)
+
+  - record: k8s_pod_gpu_memory_used_bytes
+    expr: k8s_pod_gpu_memory_used * %[1]d * 1024 * 1024
+
+  - record: k8s_pod_gpu_memory_request_bytes
+    expr: k8s_pod_gpu_memory_request * %[1]d * 1024 * 1024
 
   - record: k8s_pod_rate_gpu_memory_used_request
     expr: sum(k8s_container_gpu_memory_used + on (container_name, pod_name, namespace) group_left container_request_gpu_memory * 0) without(container_name) * 100  / on (pod_name,namespace) group_left k8s_pod_gpu_memory_request
@@ -978,6 +1023,9 @@ groups:
   - record: k8s_namespace_gpu_memory_used
     expr: sum(k8s_pod_gpu_memory_used) by (namespace)
 
+  - record: k8s_namespace_pvc_request_bytes
+    expr: sum(kube_persistentvolumeclaim_resource_requests_storage_bytes) by (namespace)
+
   - record: k8s_namespace_rate_gpu_memory_used_cluster
     expr: k8s_namespace_gpu_memory_used * 100 / scalar(k8s_cluster_gpu_memory_total)
 
@@ -1131,6 +1179,9 @@ groups:
   - record: project_namespace_gpu_memory_used
     expr: k8s_namespace_gpu_memory_used* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
 
+  - record: project_namespace_pvc_request_bytes
+    expr: k8s_namespace_pvc_request_bytes* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
+
   - record: project_namespace_network_receive_bytes_bw
     expr: k8s_namespace_network_receive_bytes_bw* on(namespace) group_left(project_name,namespace_name) kube_namespace_labels
 
@@ -1226,7 +1277,19 @@ groups:
 
   - record: k8s_component_etcd_version
     expr: label_replace(max(etcd_server_version) by (server_version),"gitVersion", "$1", "server_version", "(.*)")
-`
+
+  - record: k8s_logcollector_lines_rate
+    expr: sum(rate(logcollector_lines_total[2m])) by (logcollector_namespace, logcollector)
+
+  - record: k8s_logcollector_bytes_rate
+    expr: sum(rate(logcollector_bytes_total[2m])) by (logcollector_namespace, logcollector)
+
+  - record: k8s_logcollector_dropped_lines_rate
+    expr: sum(rate(logcollector_lines_total[2m])) by (logcollector_namespace, logcollector) - sum(rate(logcollector_emitted_lines_total[2m])) by (logcollector_namespace, logcollector)
+
+  - record: k8s_logcollector_send_latency
+    expr: label_replace(label_replace(sum(rate(fluentd_output_status_flush_time_count{plugin_id=~"logcollector\\..+"}[2m])) by (plugin_id) / sum(rate(fluentd_output_status_write_count{plugin_id=~"logcollector\\..+"}[2m])) by (plugin_id), "logcollector_namespace", "$1", "plugin_id", "logcollector\\.([^.]+)\\..+"), "logcollector", "$1", "plugin_id", "logcollector\\.[^.]+\\.(.+)")
+`, gpuMemoryUnit)
 
 	return rules
 }
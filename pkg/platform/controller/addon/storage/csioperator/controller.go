@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"sync"
 	"time"
 
@@ -45,6 +46,7 @@ import (
 	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
 	v1 "tkestack.io/tke/api/platform/v1"
 	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/controller/addon/crdcheck"
 	storageutil "tkestack.io/tke/pkg/platform/controller/addon/storage/util"
 	"tkestack.io/tke/pkg/platform/util"
 	containerregistryutil "tkestack.io/tke/pkg/util/containerregistry"
@@ -53,6 +55,8 @@ import (
 )
 
 const (
+	crdOwner = "csi-operator"
+
 	controllerName = "csi-operator-controller"
 
 	crbName        = "csi-operator-role-binding"
@@ -309,10 +313,18 @@ func (c *Controller) createCSIOperatorIfNeeded(
 	switch csiOperator.Status.Phase {
 	case v1.AddonPhaseInitializing:
 		log.Info("CSIOperator will be created", log.String("name", key))
-		svVersion, err := c.installCSIOperator(ctx, csiOperator)
+		conflicts, err := c.resolveCRDConflicts(ctx, csiOperator)
+		if err == nil && len(conflicts) > 0 {
+			return c.reportCRDConflicts(ctx, csiOperator, conflicts)
+		}
+		var svVersion string
+		if err == nil {
+			svVersion, err = c.installCSIOperator(ctx, csiOperator)
+		}
 		if err == nil {
 			csiOperator = csiOperator.DeepCopy()
 			fillOperatorStatus(csiOperator, svVersion)
+			csiOperator.Status.CRDConflicts = nil
 			csiOperator.Status.Phase = v1.AddonPhaseChecking
 			csiOperator.Status.Reason = ""
 			csiOperator.Status.RetryCount = 0
@@ -400,6 +412,9 @@ func (c *Controller) createCSIOperatorIfNeeded(
 		}
 	case v1.AddonPhaseFailed:
 		log.Info("CSIOperator failed", log.String("name", key))
+		if len(csiOperator.Status.CRDConflicts) > 0 {
+			return c.retryCRDConflicts(ctx, csiOperator)
+		}
 		c.health.Delete(key)
 		c.checking.Delete(key)
 		c.upgrading.Delete(key)
@@ -450,6 +465,54 @@ func (c *Controller) installCSIOperator(ctx context.Context, csiOperator *v1.CSI
 	return version, c.installDeployment(ctx, csiOperator, kubeClient, svInfo)
 }
 
+// resolveCRDConflicts checks the CRDs required by CSIOperator in the cluster
+// and applies the conflict policy, returning the conflicts that remain.
+func (c *Controller) resolveCRDConflicts(ctx context.Context, csiOperator *v1.CSIOperator) ([]v1.CRDConflict, error) {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, csiOperator.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	extClient, err := util.BuildExternalExtensionClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return nil, err
+	}
+	conflicts, err := crdcheck.Check(ctx, extClient, crdOwner, crdcheck.CSIOperatorCRDs)
+	if err != nil {
+		return nil, err
+	}
+	return crdcheck.Resolve(ctx, extClient, crdOwner, csiOperator.Spec.CRDConflictPolicy, conflicts)
+}
+
+func (c *Controller) reportCRDConflicts(ctx context.Context, csiOperator *v1.CSIOperator, conflicts []v1.CRDConflict) error {
+	log.Warn("CSIOperator has crd conflicts", log.String("name", csiOperator.Name), log.String("clusterName", csiOperator.Spec.ClusterName), log.Int("conflicts", len(conflicts)))
+	csiOperator = csiOperator.DeepCopy()
+	csiOperator.Status.Version = csiOperator.Spec.Version
+	csiOperator.Status.Phase = v1.AddonPhaseFailed
+	csiOperator.Status.Reason = crdcheck.Error(conflicts).Error()
+	csiOperator.Status.CRDConflicts = conflicts
+	return c.persistUpdate(ctx, csiOperator)
+}
+
+// retryCRDConflicts installs CSIOperator again once the conflicts are gone,
+// either removed by hand or resolved by a changed conflict policy.
+func (c *Controller) retryCRDConflicts(ctx context.Context, csiOperator *v1.CSIOperator) error {
+	conflicts, err := c.resolveCRDConflicts(ctx, csiOperator)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		if reflect.DeepEqual(conflicts, csiOperator.Status.CRDConflicts) {
+			return nil
+		}
+		return c.reportCRDConflicts(ctx, csiOperator, conflicts)
+	}
+	csiOperator = csiOperator.DeepCopy()
+	csiOperator.Status.Phase = v1.AddonPhaseInitializing
+	csiOperator.Status.Reason = ""
+	csiOperator.Status.CRDConflicts = nil
+	return c.persistUpdate(ctx, csiOperator)
+}
+
 func (c *Controller) installSVC(ctx context.Context, csiOperator *v1.CSIOperator, kubeClient kubernetes.Interface) error {
 	svc := genServiceAccount()
 	svcClient := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem)
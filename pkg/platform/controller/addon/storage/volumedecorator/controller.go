@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/controller/addon/dependency"
 	storageutil "tkestack.io/tke/pkg/platform/controller/addon/storage/util"
 	"tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/metrics"
@@ -73,6 +74,7 @@ const (
 	clientRetryInterval = 5 * time.Second
 
 	timeOut       = 5 * time.Minute
+	usagePeriod   = time.Minute
 	maxRetryCount = 5
 
 	tlsCert  = "tls.cert"
@@ -88,15 +90,17 @@ const (
 
 // Controller is responsible for performing actions dependent upon a LogCollector phase.
 type Controller struct {
-	client       clientset.Interface
-	cache        *volumeDecoratorCache
-	health       sync.Map
-	checking     sync.Map
-	upgrading    sync.Map
-	queue        workqueue.RateLimitingInterface
-	lister       platformv1lister.VolumeDecoratorLister
-	listerSynced cache.InformerSynced
-	stopCh       <-chan struct{}
+	client        clientset.Interface
+	cache         *volumeDecoratorCache
+	health        sync.Map
+	checking      sync.Map
+	upgrading     sync.Map
+	usageClaims   sync.Map
+	annotatedPods sync.Map
+	queue         workqueue.RateLimitingInterface
+	lister        platformv1lister.VolumeDecoratorLister
+	listerSynced  cache.InformerSynced
+	stopCh        <-chan struct{}
 }
 
 // NewController creates a new LogCollector Controller object.
@@ -160,6 +164,7 @@ func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
 	for i := 0; i < workers; i++ {
 		go wait.Until(c.worker, time.Second, stopCh)
 	}
+	go wait.Until(c.collectVolumeUsage, usagePeriod, stopCh)
 
 	<-stopCh
 	return nil
@@ -246,6 +251,7 @@ func (c *Controller) processVolumeDecoratorDelete(ctx context.Context, cachedDec
 	}
 
 	decorator := cachedDecorator.state
+	c.deleteVolumeUsage(key, decorator)
 	return c.uninstallDecorator(ctx, decorator)
 }
 
@@ -331,6 +337,9 @@ func (c *Controller) createDecoratorIfNeeded(
 	switch decorator.Status.Phase {
 	case v1.AddonPhaseInitializing:
 		log.Error("LogCollector will be created", log.String("name", key))
+		if err := c.waitDependencies(ctx, decorator); err != nil {
+			return err
+		}
 		svVersion, err := c.installDecorator(ctx, decorator)
 		if err == nil {
 			log.Error("Install LogCollector success",
@@ -455,6 +464,24 @@ func (c *Controller) needUpgrade(ctx context.Context, decorator *v1.VolumeDecora
 	return version != decorator.Status.StorageVendorVersion
 }
 
+// waitDependencies keeps the decorator initializing until the addons it
+// requires are running, the error is returned to retry later.
+func (c *Controller) waitDependencies(ctx context.Context, decorator *v1.VolumeDecorator) error {
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, decorator.Spec.ClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	err = dependency.Ensure(ctx, c.client, cluster, "VolumeDecorator")
+	if err != nil && decorator.Status.Reason != err.Error() {
+		decorator = decorator.DeepCopy()
+		decorator.Status.Reason = err.Error()
+		if persistErr := c.persistUpdate(ctx, decorator); persistErr != nil {
+			return persistErr
+		}
+	}
+	return err
+}
+
 func (c *Controller) installDecorator(ctx context.Context, decorator *v1.VolumeDecorator) (string, error) {
 	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, decorator.Spec.ClusterName, metav1.GetOptions{})
 	if err != nil {
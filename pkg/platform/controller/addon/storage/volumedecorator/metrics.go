@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package volumedecorator
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	volumeUsedBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "volume_decorator_pvc_used_bytes",
+			Help: "bytes used by the filesystem of the persistent volume claim",
+		},
+		[]string{"tenant_id", "cluster_name", "namespace", "persistentvolumeclaim"})
+	volumeCapacityBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "volume_decorator_pvc_capacity_bytes",
+			Help: "total bytes of the filesystem of the persistent volume claim",
+		},
+		[]string{"tenant_id", "cluster_name", "namespace", "persistentvolumeclaim"})
+)
+
+func init() {
+	prometheus.MustRegister(volumeUsedBytes, volumeCapacityBytes)
+}
+
+func UpdateMetricVolumeUsage(tenantID string, clusterName string, namespace string, name string, used int64, capacity int64) {
+	labels := map[string]string{"tenant_id": tenantID, "cluster_name": clusterName, "namespace": namespace, "persistentvolumeclaim": name}
+	volumeUsedBytes.With(labels).Set(float64(used))
+	volumeCapacityBytes.With(labels).Set(float64(capacity))
+}
+
+func DeleteMetricVolumeUsage(tenantID string, clusterName string, namespace string, name string) {
+	labels := map[string]string{"tenant_id": tenantID, "cluster_name": clusterName, "namespace": namespace, "persistentvolumeclaim": name}
+	volumeUsedBytes.Delete(labels)
+	volumeCapacityBytes.Delete(labels)
+}
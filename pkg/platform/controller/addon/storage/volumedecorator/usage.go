@@ -0,0 +1,291 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package volumedecorator
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// exceededAnnotation lists the persistent volume claims of a pod which exceed
+// the usage threshold.
+const exceededAnnotation = "platform.tkestack.io/volume-usage-exceeded"
+
+// summary is the subset of the stats summary of kubelet which describes the
+// volumes of pods.
+type summary struct {
+	Pods []podStats `json:"pods"`
+}
+
+type podStats struct {
+	PodRef      objectReference `json:"podRef"`
+	VolumeStats []volumeStats   `json:"volume,omitempty"`
+}
+
+type volumeStats struct {
+	Name          string           `json:"name"`
+	PVCRef        *objectReference `json:"pvcRef,omitempty"`
+	UsedBytes     *uint64          `json:"usedBytes,omitempty"`
+	CapacityBytes *uint64          `json:"capacityBytes,omitempty"`
+}
+
+type objectReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+// collectVolumeUsage records the filesystem usage of persistent volume claims
+// of the running VolumeDecorators and enforces the usage threshold.
+func (c *Controller) collectVolumeUsage() {
+	decorators, err := c.lister.List(labels.Everything())
+	if err != nil {
+		log.Warn("Failed to list volume decorators", log.Err(err))
+		return
+	}
+	for _, decorator := range decorators {
+		if decorator.Status.Phase != v1.AddonPhaseRunning {
+			continue
+		}
+		if err := c.updateVolumeUsage(context.Background(), decorator); err != nil {
+			log.Warn("Failed to collect the usage of persistent volume claims", log.String("name", decorator.Name), log.Err(err))
+		}
+	}
+}
+
+func (c *Controller) updateVolumeUsage(ctx context.Context, decorator *v1.VolumeDecorator) error {
+	kubeClient, err := c.getKubeClient(ctx, decorator.Spec.ClusterName)
+	if err != nil || kubeClient == nil {
+		return err
+	}
+	summaries, err := getSummaries(ctx, kubeClient)
+	if err != nil {
+		return err
+	}
+
+	usages, exceeded := calculateVolumeUsage(summaries, decorator.Spec.Enforcement)
+	claims := sets.NewString()
+	for _, usage := range usages {
+		claims.Insert(usage.Namespace + "/" + usage.Name)
+		UpdateMetricVolumeUsage(decorator.Spec.TenantID, decorator.Spec.ClusterName, usage.Namespace, usage.Name, usage.UsedBytes, usage.CapacityBytes)
+	}
+	if previous, ok := c.usageClaims.Load(decorator.Name); ok {
+		for _, claim := range previous.(sets.String).Difference(claims).UnsortedList() {
+			namespace, name := splitKey(claim)
+			DeleteMetricVolumeUsage(decorator.Spec.TenantID, decorator.Spec.ClusterName, namespace, name)
+		}
+	}
+	c.usageClaims.Store(decorator.Name, claims)
+
+	if decorator.Spec.Enforcement != nil {
+		c.enforceVolumeUsage(ctx, kubeClient, decorator, exceeded)
+	}
+
+	if reflect.DeepEqual(decorator.Status.Usages, usages) {
+		return nil
+	}
+	decorator = decorator.DeepCopy()
+	decorator.Status.Usages = usages
+	return c.persistUpdate(ctx, decorator)
+}
+
+// getSummaries gets the stats summaries of the ready nodes through the proxy
+// of kube-apiserver, the nodes which fail to report are skipped.
+func getSummaries(ctx context.Context, kubeClient kubernetes.Interface) ([]summary, error) {
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var summaries []summary
+	for _, node := range nodes.Items {
+		if !isNodeReady(&node) {
+			continue
+		}
+		data, err := kubeClient.CoreV1().RESTClient().Get().
+			Resource("nodes").Name(node.Name).SubResource("proxy").Suffix("stats/summary").
+			DoRaw(ctx)
+		if err != nil {
+			log.Warn("Failed to get the stats summary of node", log.String("node", node.Name), log.Err(err))
+			continue
+		}
+		var s summary
+		if err := json.Unmarshal(data, &s); err != nil {
+			log.Warn("Failed to decode the stats summary of node", log.String("node", node.Name), log.Err(err))
+			continue
+		}
+		summaries = append(summaries, s)
+	}
+	return summaries, nil
+}
+
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// calculateVolumeUsage returns the usages of persistent volume claims sorted
+// by namespace and name, and the exceeded claims of each pod. A claim mounted
+// by several pods takes the largest usage reported.
+func calculateVolumeUsage(summaries []summary, enforcement *v1.VolumeUsageEnforcement) ([]v1.PersistentVolumeClaimUsage, map[types.NamespacedName][]string) {
+	usages := make(map[types.NamespacedName]*v1.PersistentVolumeClaimUsage)
+	mounted := make(map[types.NamespacedName]sets.String)
+	for _, s := range summaries {
+		for _, pod := range s.Pods {
+			podKey := types.NamespacedName{Namespace: pod.PodRef.Namespace, Name: pod.PodRef.Name}
+			for _, volume := range pod.VolumeStats {
+				if volume.PVCRef == nil || volume.UsedBytes == nil || volume.CapacityBytes == nil {
+					continue
+				}
+				claimKey := types.NamespacedName{Namespace: volume.PVCRef.Namespace, Name: volume.PVCRef.Name}
+				usage, ok := usages[claimKey]
+				if !ok {
+					usage = &v1.PersistentVolumeClaimUsage{Namespace: claimKey.Namespace, Name: claimKey.Name}
+					usages[claimKey] = usage
+				}
+				if used := int64(*volume.UsedBytes); used > usage.UsedBytes {
+					usage.UsedBytes = used
+					usage.CapacityBytes = int64(*volume.CapacityBytes)
+				}
+				if mounted[podKey] == nil {
+					mounted[podKey] = sets.NewString()
+				}
+				mounted[podKey].Insert(claimKey.Name)
+			}
+		}
+	}
+
+	var result []v1.PersistentVolumeClaimUsage
+	for _, usage := range usages {
+		if enforcement != nil && usage.CapacityBytes > 0 &&
+			usage.UsedBytes*100 >= int64(enforcement.Threshold)*usage.CapacityBytes {
+			usage.Exceeded = true
+		}
+		result = append(result, *usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		return result[i].Name < result[j].Name
+	})
+
+	exceeded := make(map[types.NamespacedName][]string)
+	for podKey, claims := range mounted {
+		for _, claim := range claims.List() {
+			if usages[types.NamespacedName{Namespace: podKey.Namespace, Name: claim}].Exceeded {
+				exceeded[podKey] = append(exceeded[podKey], claim)
+			}
+		}
+	}
+	return result, exceeded
+}
+
+// enforceVolumeUsage annotates the pods with their exceeded claims and evicts
+// them if required, the annotations of the pods no longer exceeded are removed.
+func (c *Controller) enforceVolumeUsage(
+	ctx context.Context,
+	kubeClient kubernetes.Interface,
+	decorator *v1.VolumeDecorator,
+	exceeded map[types.NamespacedName][]string) {
+	annotated := sets.NewString()
+	for podKey, claims := range exceeded {
+		annotated.Insert(podKey.String())
+		value := strings.Join(claims, ",")
+		if err := annotatePod(ctx, kubeClient, podKey, &value); err != nil {
+			log.Warn("Failed to annotate the pod with exceeded volumes", log.String("pod", podKey.String()), log.Err(err))
+			continue
+		}
+		if decorator.Spec.Enforcement.Action != v1.VolumeUsageEvict {
+			continue
+		}
+		log.Info("Evict the pod with exceeded volumes", log.String("pod", podKey.String()), log.String("claims", value))
+		err := kubeClient.CoreV1().Pods(podKey.Namespace).Evict(ctx, &policyv1beta1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: podKey.Name, Namespace: podKey.Namespace},
+		})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			log.Warn("Failed to evict the pod with exceeded volumes", log.String("pod", podKey.String()), log.Err(err))
+		}
+	}
+	if previous, ok := c.annotatedPods.Load(decorator.Name); ok {
+		for _, pod := range previous.(sets.String).Difference(annotated).UnsortedList() {
+			namespace, name := splitKey(pod)
+			if err := annotatePod(ctx, kubeClient, types.NamespacedName{Namespace: namespace, Name: name}, nil); err != nil {
+				log.Warn("Failed to remove the exceeded volumes of pod", log.String("pod", pod), log.Err(err))
+			}
+		}
+	}
+	c.annotatedPods.Store(decorator.Name, annotated)
+}
+
+// annotatePod sets the exceeded annotation of the pod, or removes it if the
+// value is nil. The pods which have been deleted are ignored.
+func annotatePod(ctx context.Context, kubeClient kubernetes.Interface, podKey types.NamespacedName, value *string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]*string{exceededAnnotation: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = kubeClient.CoreV1().Pods(podKey.Namespace).Patch(ctx, podKey.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// deleteVolumeUsage deletes the usage metrics of the VolumeDecorator.
+func (c *Controller) deleteVolumeUsage(key string, decorator *v1.VolumeDecorator) {
+	c.annotatedPods.Delete(key)
+	previous, ok := c.usageClaims.Load(key)
+	if !ok || decorator == nil {
+		return
+	}
+	for _, claim := range previous.(sets.String).UnsortedList() {
+		namespace, name := splitKey(claim)
+		DeleteMetricVolumeUsage(decorator.Spec.TenantID, decorator.Spec.ClusterName, namespace, name)
+	}
+	c.usageClaims.Delete(key)
+}
+
+func splitKey(key string) (string, string) {
+	parts := strings.SplitN(key, "/", 2)
+	if len(parts) != 2 {
+		return "", key
+	}
+	return parts[0], parts[1]
+}
@@ -0,0 +1,77 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package volumedecorator
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func uint64Ptr(i uint64) *uint64 { return &i }
+
+func TestCalculateVolumeUsage(t *testing.T) {
+	summaries := []summary{
+		{Pods: []podStats{
+			{
+				PodRef: objectReference{Namespace: "default", Name: "web-0"},
+				VolumeStats: []volumeStats{
+					{Name: "data", PVCRef: &objectReference{Namespace: "default", Name: "data-web-0"}, UsedBytes: uint64Ptr(95), CapacityBytes: uint64Ptr(100)},
+					{Name: "token", UsedBytes: uint64Ptr(1), CapacityBytes: uint64Ptr(100)},
+				},
+			},
+			{
+				PodRef: objectReference{Namespace: "default", Name: "app-a"},
+				VolumeStats: []volumeStats{
+					{Name: "shared", PVCRef: &objectReference{Namespace: "default", Name: "shared"}, UsedBytes: uint64Ptr(10), CapacityBytes: uint64Ptr(100)},
+				},
+			},
+		}},
+		{Pods: []podStats{
+			{
+				PodRef: objectReference{Namespace: "default", Name: "app-b"},
+				VolumeStats: []volumeStats{
+					{Name: "shared", PVCRef: &objectReference{Namespace: "default", Name: "shared"}, UsedBytes: uint64Ptr(40), CapacityBytes: uint64Ptr(100)},
+				},
+			},
+		}},
+	}
+
+	usages, exceeded := calculateVolumeUsage(summaries, &v1.VolumeUsageEnforcement{Threshold: 90})
+	wantUsages := []v1.PersistentVolumeClaimUsage{
+		{Namespace: "default", Name: "data-web-0", UsedBytes: 95, CapacityBytes: 100, Exceeded: true},
+		{Namespace: "default", Name: "shared", UsedBytes: 40, CapacityBytes: 100},
+	}
+	if !reflect.DeepEqual(usages, wantUsages) {
+		t.Errorf("calculateVolumeUsage() usages = %v, want %v", usages, wantUsages)
+	}
+	wantExceeded := map[types.NamespacedName][]string{
+		{Namespace: "default", Name: "web-0"}: {"data-web-0"},
+	}
+	if !reflect.DeepEqual(exceeded, wantExceeded) {
+		t.Errorf("calculateVolumeUsage() exceeded = %v, want %v", exceeded, wantExceeded)
+	}
+
+	usages, exceeded = calculateVolumeUsage(summaries, nil)
+	if usages[0].Exceeded || len(exceeded) != 0 {
+		t.Errorf("calculateVolumeUsage() without enforcement = %v, %v", usages, exceeded)
+	}
+}
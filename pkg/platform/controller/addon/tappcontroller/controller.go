@@ -20,10 +20,7 @@ package tappcontroller
 
 import (
 	"context"
-	normalerrors "errors"
-	"fmt"
-	"reflect"
-	"sync"
+	"strconv"
 	"time"
 
 	"tkestack.io/tke/pkg/platform/controller/addon/tappcontroller/images"
@@ -31,32 +28,21 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/apimachinery/pkg/util/wait"
-	"k8s.io/client-go/tools/cache"
-	"k8s.io/client-go/util/workqueue"
 
 	clientset "tkestack.io/tke/api/client/clientset/versioned"
 	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
 	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
 	v1 "tkestack.io/tke/api/platform/v1"
-	controllerutil "tkestack.io/tke/pkg/controller"
+	"tkestack.io/tke/pkg/platform/controller/addon/crdcheck"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
 	"tkestack.io/tke/pkg/platform/util"
-	"tkestack.io/tke/pkg/util/log"
-	"tkestack.io/tke/pkg/util/metrics"
 )
 
 const (
-	clientRetryCount    = 5
-	clientRetryInterval = 5 * time.Second
-
-	maxRetryCount = 5
-	timeOut       = 5 * time.Minute
+	crdOwner = "tapp"
 )
 
 const (
@@ -67,345 +53,110 @@ const (
 	crbTappControllerName        = "tapp-controller"
 )
 
-// Controller is responsible for performing actions dependent upon a tapp controller phase.
-type Controller struct {
-	client       clientset.Interface
-	cache        *tappControllerCache
-	health       sync.Map
-	checking     sync.Map
-	upgrading    sync.Map
-	queue        workqueue.RateLimitingInterface
-	lister       platformv1lister.TappControllerLister
-	listerSynced cache.InformerSynced
-	stopCh       <-chan struct{}
-}
-
-// NewController creates a new Controller object.
-func NewController(client clientset.Interface, informer platformv1informer.TappControllerInformer, resyncPeriod time.Duration) *Controller {
-	// create the controller so we can inject the enqueue function
-	controller := &Controller{
-
+// NewController creates a new controller which drives the tapp controllers
+// through the addon lifecycle.
+func NewController(client clientset.Interface, informer platformv1informer.TappControllerInformer, resyncPeriod time.Duration) *lifecycle.Controller {
+	addon := &tappControllerAddon{
 		client: client,
-		cache:  &tappControllerCache{tappControllerMap: make(map[string]*cachedTappController)},
-		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		lister: informer.Lister(),
 	}
-
-	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
-		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.PlatformV1().RESTClient().GetRateLimiter())
-	}
-
-	// configure the tapp controller informer event handlers
-	informer.Informer().AddEventHandlerWithResyncPeriod(
-		cache.ResourceEventHandlerFuncs{
-			AddFunc: controller.enqueueTappController,
-			UpdateFunc: func(oldObj, newObj interface{}) {
-				oldTappController, ok1 := oldObj.(*v1.TappController)
-				curTappController, ok2 := newObj.(*v1.TappController)
-				if ok1 && ok2 && controller.needsUpdate(oldTappController, curTappController) {
-					controller.enqueueTappController(newObj)
-				}
-			},
-			DeleteFunc: controller.enqueueTappController,
-		},
-		resyncPeriod,
-	)
-	controller.lister = informer.Lister()
-	controller.listerSynced = informer.Informer().HasSynced
-
-	return controller
+	return lifecycle.NewController(controllerName, client, informer.Informer(), addon, resyncPeriod)
 }
 
-// obj could be an *v1.TappController, or a DeletionFinalStateUnknown marker item.
-func (c *Controller) enqueueTappController(obj interface{}) {
-	key, err := controllerutil.KeyFunc(obj)
-	if err != nil {
-		log.Error("Couldn't get key for object", log.Any("object", obj), log.Err(err))
-		return
-	}
-	c.queue.Add(key)
+// tappControllerObject adapts the tapp controller to the addon lifecycle.
+type tappControllerObject struct {
+	*v1.TappController
 }
 
-func (c *Controller) needsUpdate(old *v1.TappController, new *v1.TappController) bool {
-	return !reflect.DeepEqual(old, new)
+func (o tappControllerObject) ClusterName() string {
+	return o.Spec.ClusterName
 }
 
-// Run will set up the event handlers for types we are interested in, as well
-// as syncing informer caches and starting workers.
-func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
-	defer runtime.HandleCrash()
-	defer c.queue.ShutDown()
-
-	// Start the informer factories to begin populating the informer caches
-	log.Info("Starting tappcontroller controller")
-	defer log.Info("Shutting down tappcontroller controller")
-
-	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
-		return fmt.Errorf("failed to wait for cluster caches to sync")
-	}
-
-	c.stopCh = stopCh
-
-	for i := 0; i < workers; i++ {
-		go wait.Until(c.worker, time.Second, stopCh)
-	}
-
-	<-stopCh
-	return nil
+func (o tappControllerObject) Version() string {
+	return o.Spec.Version
 }
 
-// worker processes the queue of namespace objects.
-// Each namespace can be in the queue at most once.
-// The system ensures that no two workers can process
-// the same namespace at the same time.
-func (c *Controller) worker() {
-	for c.processNextWorkItem() {
-	}
+func (o tappControllerObject) DriftPolicy() v1.AddonDriftPolicy {
+	return o.Spec.DriftPolicy
 }
 
-func (c *Controller) processNextWorkItem() bool {
-	key, quit := c.queue.Get()
-	if quit {
-		return false
+func (o tappControllerObject) GetStatus() lifecycle.Status {
+	return lifecycle.Status{
+		Version:                     o.Status.Version,
+		Phase:                       o.Status.Phase,
+		Reason:                      o.Status.Reason,
+		RetryCount:                  o.Status.RetryCount,
+		LastReInitializingTimestamp: o.Status.LastReInitializingTimestamp,
+		Conditions:                  o.Status.Conditions,
 	}
-	defer c.queue.Done(key)
-
-	err := c.syncTappController(key.(string))
-	if err == nil {
-		c.queue.Forget(key)
-		return true
-	}
-
-	runtime.HandleError(fmt.Errorf("error processing tapp controller %v (will retry): %v", key, err))
-	c.queue.AddRateLimited(key)
-	return true
 }
 
-// syncTappController will sync the tapp controller with the given key if it has had
-// its expectations fulfilled, meaning it did not expect to see any more of its
-// namespaces created or deleted. This function is not meant to be invoked
-// concurrently with the same key.
-func (c *Controller) syncTappController(key string) error {
-	startTime := time.Now()
-	defer func() {
-		log.Info("Finished syncing tappController", log.String("tappControllerName", key), log.Duration("processTime", time.Since(startTime)))
-	}()
-
-	_, name, err := cache.SplitMetaNamespaceKey(key)
-	if err != nil {
-		return err
-	}
-
-	// tappController holds the latest tappController info from apiserver
-	tappController, err := c.lister.Get(name)
-	switch {
-	case errors.IsNotFound(err):
-		log.Info("Tapp controller has been deleted. Attempting to cleanup resources", log.String("tappControllerName", key))
-		err = c.processTappControllerDeletion(context.Background(), key)
-	case err != nil:
-		log.Warn("Unable to retrieve tapp controller from store", log.String("tappControllerName", key), log.Err(err))
-	default:
-		cachedTappController := c.cache.getOrCreate(key)
-		err = c.processTappControllerUpdate(context.Background(), cachedTappController, tappController, key)
-	}
-	return err
+func (o tappControllerObject) WithStatus(status lifecycle.Status) lifecycle.Object {
+	tappController := o.DeepCopy()
+	tappController.Status.Version = status.Version
+	tappController.Status.Phase = status.Phase
+	tappController.Status.Reason = status.Reason
+	tappController.Status.RetryCount = status.RetryCount
+	tappController.Status.LastReInitializingTimestamp = status.LastReInitializingTimestamp
+	tappController.Status.Conditions = status.Conditions
+	return tappControllerObject{tappController}
 }
 
-func (c *Controller) processTappControllerDeletion(ctx context.Context, key string) error {
-	cachedTappController, ok := c.cache.get(key)
-	if !ok {
-		log.Error("Tapp controller not in cache even though the watcher thought it was. Ignoring the deletion", log.String("tappControllerName", key))
-		return nil
-	}
-	return c.processTappControllerDelete(ctx, cachedTappController, key)
+type tappControllerAddon struct {
+	client clientset.Interface
+	lister platformv1lister.TappControllerLister
 }
 
-func (c *Controller) processTappControllerDelete(ctx context.Context, cachedTappController *cachedTappController, key string) error {
-	log.Info("Tapp controller will be dropped", log.String("tappControllerName", key))
-
-	if c.cache.Exist(key) {
-		log.Info("Delete the tapp controller cache", log.String("tappControllerName", key))
-		c.cache.delete(key)
-	}
-
-	if _, ok := c.health.Load(key); ok {
-		log.Info("Delete the tapp controller health cache", log.String("tappControllerName", key))
-		c.health.Delete(key)
-	}
-
-	tappController := cachedTappController.state
-	return c.uninstallTappController(ctx, tappController)
+func (a *tappControllerAddon) Kind() string {
+	return "TappController"
 }
 
-func (c *Controller) processTappControllerUpdate(ctx context.Context, cachedTappController *cachedTappController, tappController *v1.TappController, key string) error {
-	if cachedTappController.state != nil {
-		// exist and the cluster name changed
-		if cachedTappController.state.UID != tappController.UID {
-			if err := c.processTappControllerDelete(ctx, cachedTappController, key); err != nil {
-				return err
-			}
-		}
-	}
-	err := c.createTappControllerIfNeeded(ctx, key, cachedTappController, tappController)
+func (a *tappControllerAddon) Get(name string) (lifecycle.Object, error) {
+	tappController, err := a.lister.Get(name)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	cachedTappController.state = tappController
-	// Always update the cache upon success.
-	c.cache.set(key, cachedTappController)
-	return nil
+	return tappControllerObject{tappController}, nil
 }
 
-func (c *Controller) tappControllerReinitialize(ctx context.Context, key string, cachedTappController *cachedTappController, tappController *v1.TappController) func() (bool, error) {
-	// this func will always return true that keeps the poll once
-	return func() (bool, error) {
-		err := c.installTappController(ctx, tappController)
-		if err == nil {
-			tappController = tappController.DeepCopy()
-			tappController.Status.Phase = v1.AddonPhaseChecking
-			tappController.Status.Reason = ""
-			tappController.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
-			err = c.persistUpdate(ctx, tappController)
-			if err != nil {
-				return true, err
-			}
-			return true, nil
-		}
-		// First, rollback the tappController
-		if err := c.uninstallTappController(ctx, tappController); err != nil {
-			log.Error("Uninstall tapp controller error.")
-			return true, err
-		}
-		if tappController.Status.RetryCount == maxRetryCount {
-			tappController = tappController.DeepCopy()
-			tappController.Status.Phase = v1.AddonPhaseFailed
-			tappController.Status.Reason = fmt.Sprintf("Install error and retried max(%d) times already.", maxRetryCount)
-			err := c.persistUpdate(ctx, tappController)
-			if err != nil {
-				log.Error("Update tapp controller error.")
-				return true, err
-			}
-			return true, nil
-		}
-		// Add the retry count will trigger reinitialize function from the persistent controller again.
-		tappController = tappController.DeepCopy()
-		tappController.Status.Phase = v1.AddonPhaseReinitializing
-		tappController.Status.Reason = err.Error()
-		tappController.Status.LastReInitializingTimestamp = metav1.NewTime(time.Now())
-		tappController.Status.RetryCount++
-		err = c.persistUpdate(ctx, tappController)
-		if err != nil {
-			return true, err
-		}
-		return true, nil
-	}
-}
-
-func (c *Controller) createTappControllerIfNeeded(ctx context.Context, key string, cachedTappController *cachedTappController, tappController *v1.TappController) error {
-	switch tappController.Status.Phase {
-	case v1.AddonPhaseInitializing:
-		log.Error("Tapp controller will be created", log.String("tappControllerName", key))
-		err := c.installTappController(ctx, tappController)
-		if err == nil {
-			tappController = tappController.DeepCopy()
-			tappController.Status.Version = tappController.Spec.Version
-			tappController.Status.Phase = v1.AddonPhaseChecking
-			tappController.Status.Reason = ""
-			tappController.Status.RetryCount = 0
-			return c.persistUpdate(ctx, tappController)
-		}
-		tappController = tappController.DeepCopy()
-		tappController.Status.Version = tappController.Spec.Version
-		tappController.Status.Phase = v1.AddonPhaseReinitializing
-		tappController.Status.Reason = err.Error()
-		tappController.Status.RetryCount = 1
-		tappController.Status.LastReInitializingTimestamp = metav1.Now()
-		return c.persistUpdate(ctx, tappController)
-	case v1.AddonPhaseReinitializing:
-		var interval = time.Since(tappController.Status.LastReInitializingTimestamp.Time)
-		var waitTime time.Duration
-		if interval >= timeOut {
-			waitTime = time.Duration(1)
-		} else {
-			waitTime = timeOut - interval
-		}
-		go wait.Poll(waitTime, timeOut, c.tappControllerReinitialize(ctx, key, cachedTappController, tappController))
-	case v1.AddonPhaseChecking:
-		if _, ok := c.checking.Load(key); !ok {
-			c.checking.Store(key, true)
-			initDelay := time.Now().Add(5 * time.Minute)
-			go func() {
-				defer c.checking.Delete(key)
-				wait.PollImmediate(5*time.Second, 5*time.Minute, c.checkTappControllerStatus(ctx, tappController, key, initDelay))
-			}()
-		}
-	case v1.AddonPhaseRunning:
-		if needUpgrade(tappController) {
-			c.health.Delete(key)
-			tappController = tappController.DeepCopy()
-			tappController.Status.Phase = v1.AddonPhaseUpgrading
-			tappController.Status.Reason = ""
-			tappController.Status.RetryCount = 0
-			return c.persistUpdate(ctx, tappController)
-		}
-		c.checkHealth(ctx, key)
-	case v1.AddonPhaseUpgrading:
-		if _, ok := c.upgrading.Load(key); !ok {
-			c.upgrading.Store(key, true)
-			upgradeDelay := time.Now().Add(timeOut)
-			go func() {
-				defer c.upgrading.Delete(key)
-				wait.PollImmediate(5*time.Second, timeOut, c.upgradeTappController(ctx, tappController, key, upgradeDelay))
-			}()
-		}
-	case v1.AddonPhaseFailed:
-		log.Info("Tapp controller is error", log.String("tappControllerName", key))
-		c.checkHealth(ctx, key)
-	}
-	return nil
+func (a *tappControllerAddon) UpdateStatus(ctx context.Context, obj lifecycle.Object) error {
+	_, err := a.client.PlatformV1().TappControllers().UpdateStatus(ctx, obj.(tappControllerObject).TappController, metav1.UpdateOptions{})
+	return err
 }
 
-func (c *Controller) checkHealth(ctx context.Context, key string) {
-	if _, ok := c.health.Load(key); !ok {
-		c.health.Store(key, true)
-		go func() {
-			defer c.health.Delete(key)
-			wait.PollImmediateUntil(5*time.Minute, c.watchTappControllerHealth(ctx, key), c.stopCh)
-		}()
+func (a *tappControllerAddon) Bundle(version string, obj lifecycle.Object) (lifecycle.Bundle, error) {
+	if err := images.Validate(version); err != nil {
+		return nil, err
 	}
+	return lifecycle.Bundle{
+		serviceAccountTappController(),
+		crbTappController(),
+		deploymentTappController(images.Get(version), obj.(tappControllerObject).Spec.Config),
+		serviceTappController(),
+	}, nil
 }
 
-func needUpgrade(tappController *v1.TappController) bool {
-	return tappController.Spec.Version != tappController.Status.Version
-}
-
-func (c *Controller) installTappController(ctx context.Context, tappController *v1.TappController) error {
-	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, tappController.Spec.ClusterName, metav1.GetOptions{})
+// Preflight checks the CRDs required by Tapp controller in the cluster and
+// applies the conflict policy, the conflicts remained fail the installation.
+func (a *tappControllerAddon) Preflight(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) (lifecycle.Object, error) {
+	extClient, err := util.BuildExternalExtensionClientSet(ctx, cluster, a.client.PlatformV1())
 	if err != nil {
-		return err
+		return obj, err
 	}
-	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	conflicts, err := crdcheck.Check(ctx, extClient, crdOwner, crdcheck.TappControllerCRDs)
 	if err != nil {
-		return err
-	}
-	// ServiceAccount TappController
-	if _, err := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Create(ctx, serviceAccountTappController(), metav1.CreateOptions{}); err != nil {
-		return err
+		return obj, err
 	}
-	// ClusterRoleBinding TappController
-	if _, err := kubeClient.RbacV1().ClusterRoleBindings().Create(ctx, crbTappController(), metav1.CreateOptions{}); err != nil {
-		return err
-	}
-	// Deployment TappController
-	if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Create(ctx, deploymentTappController(images.Get(tappController.Spec.Version)), metav1.CreateOptions{}); err != nil {
-		return err
+	conflicts, err = crdcheck.Resolve(ctx, extClient, crdOwner, obj.(tappControllerObject).Spec.CRDConflictPolicy, conflicts)
+	if err != nil {
+		return obj, err
 	}
-	// Service TappController
-	if _, err := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Create(ctx, serviceTappController(), metav1.CreateOptions{}); err != nil {
-		return err
+	tappController := obj.(tappControllerObject).DeepCopy()
+	tappController.Status.CRDConflicts = conflicts
+	if len(conflicts) > 0 {
+		return tappControllerObject{tappController}, crdcheck.Error(conflicts)
 	}
-
-	return nil
+	return tappControllerObject{tappController}, nil
 }
 
 func serviceAccountTappController() *corev1.ServiceAccount {
@@ -445,7 +196,7 @@ func crbTappController() *rbacv1.ClusterRoleBinding {
 	}
 }
 
-func deploymentTappController(components images.Components) *appsv1.Deployment {
+func deploymentTappController(components images.Components, config *v1.TappControllerConfig) *appsv1.Deployment {
 	return &appsv1.Deployment{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Deployment",
@@ -472,7 +223,7 @@ func deploymentTappController(components images.Components) *appsv1.Deployment {
 						{
 							Name:  controllerName,
 							Image: components.TappController.FullName(),
-							Args:  []string{"--v", "3", "--register-admission", "true"},
+							Args:  tappControllerArgs(config),
 							Resources: corev1.ResourceRequirements{
 								Requests: corev1.ResourceList{
 									// TODO: add support for configuring them
@@ -509,193 +260,22 @@ func serviceTappController() *corev1.Service {
 	}
 }
 
-func int32Ptr(i int32) *int32 { return &i }
-
-func (c *Controller) uninstallTappController(ctx context.Context, tappController *v1.TappController) error {
-	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, tappController.Spec.ClusterName, metav1.GetOptions{})
-	if err != nil && errors.IsNotFound(err) {
-		return nil
+// tappControllerArgs renders the arguments of tapp-controller by the config.
+func tappControllerArgs(config *v1.TappControllerConfig) []string {
+	args := []string{"--v", "3", "--register-admission", "true"}
+	if config == nil {
+		return args
 	}
-	if err != nil {
-		return err
+	if config.Workers > 0 {
+		args = append(args, "--worker", strconv.Itoa(int(config.Workers)))
 	}
-	if cluster.Status.Phase == v1.ClusterTerminating {
-		log.Info(fmt.Sprintf("Keep the components of TappController %s when deleting the cluster", tappController.Name))
-		return nil
+	if config.ForceDeletePod {
+		args = append(args, "--force-delete-pod", "true")
 	}
-
-	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-	if err != nil {
-		return err
-	}
-	// Deployment TappController
-	deployTappControllerErr := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Delete(ctx, deployTappControllerName, metav1.DeleteOptions{})
-	// ClusterRoleBinding TappController
-	crbTappControllerErr := kubeClient.RbacV1().ClusterRoleBindings().Delete(ctx, crbTappControllerName, metav1.DeleteOptions{})
-	// ServiceAccount TappController
-	svcAccountTappControllerErr := kubeClient.CoreV1().ServiceAccounts(metav1.NamespaceSystem).Delete(ctx, svcAccountTappControllerName, metav1.DeleteOptions{})
-	// Service TappController
-	svcTappControllerErr := kubeClient.CoreV1().Services(metav1.NamespaceSystem).Delete(ctx, svcTappControllerName, metav1.DeleteOptions{})
-
-	if (deployTappControllerErr != nil && !errors.IsNotFound(deployTappControllerErr)) ||
-		(crbTappControllerErr != nil && !errors.IsNotFound(crbTappControllerErr)) ||
-		(svcAccountTappControllerErr != nil && !errors.IsNotFound(svcAccountTappControllerErr)) ||
-		(svcTappControllerErr != nil && !errors.IsNotFound(svcTappControllerErr)) {
-		return normalerrors.New("delete tapp controller error")
-	}
-	return nil
-}
-
-func (c *Controller) watchTappControllerHealth(ctx context.Context, key string) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start check tapp controller in cluster health", log.String("tappControllerName", key))
-		tappController, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, tappController.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.health.Load(key); !ok {
-			log.Info("Health check over.")
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		// TODO: check tapp controller service
-		if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, deployTappControllerName, metav1.GetOptions{}); err != nil {
-			tappController = tappController.DeepCopy()
-			tappController.Status.Phase = v1.AddonPhaseFailed
-			tappController.Status.Reason = "Tapp controller is not healthy."
-			if err = c.persistUpdate(ctx, tappController); err != nil {
-				return false, err
-			}
-			return true, nil
-		}
-		return false, nil
+	if config.MaxUnavailable > 0 {
+		args = append(args, "--default-max-unavailable", strconv.Itoa(int(config.MaxUnavailable)))
 	}
+	return args
 }
 
-func (c *Controller) checkTappControllerStatus(ctx context.Context, tappController *v1.TappController, key string, initDelay time.Time) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start to check tapp controller health", log.String("tappControllerName", tappController.Name))
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, tappController.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.checking.Load(key); !ok {
-			log.Debug("Checking over tapp controller addon status")
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		tappController, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-		if deploy, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, deployTappControllerName, metav1.GetOptions{}); err != nil ||
-			(deploy.Spec.Replicas != nil && deploy.Status.AvailableReplicas < *deploy.Spec.Replicas) {
-			if time.Now().After(initDelay) {
-				tappController = tappController.DeepCopy()
-				tappController.Status.Phase = v1.AddonPhaseFailed
-				tappController.Status.Reason = "Tapp controller is not healthy."
-				if err = c.persistUpdate(ctx, tappController); err != nil {
-					return false, err
-				}
-				return true, nil
-			}
-			return false, nil
-		}
-		tappController = tappController.DeepCopy()
-		tappController.Status.Phase = v1.AddonPhaseRunning
-		tappController.Status.Reason = ""
-		if err = c.persistUpdate(ctx, tappController); err != nil {
-			return false, err
-		}
-		return true, nil
-	}
-}
-
-func (c *Controller) upgradeTappController(ctx context.Context, tappController *v1.TappController, key string, initDelay time.Time) func() (bool, error) {
-	return func() (bool, error) {
-		log.Info("Start to upgrade tapp controller", log.String("tappControllerName", tappController.Name))
-		cluster, err := c.client.PlatformV1().Clusters().Get(ctx, tappController.Spec.ClusterName, metav1.GetOptions{})
-		if err != nil && errors.IsNotFound(err) {
-			return false, err
-		}
-		if err != nil {
-			return false, nil
-		}
-		if _, ok := c.upgrading.Load(key); !ok {
-			log.Debug("Upgrading tapp controller", log.String("tappControllerName", tappController.Name))
-			return true, nil
-		}
-		kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
-		if err != nil {
-			return false, err
-		}
-		tappController, err := c.lister.Get(key)
-		if err != nil {
-			return false, err
-		}
-		newImage := images.Get(tappController.Spec.Version).TappController.FullName()
-
-		patch := fmt.Sprintf(`[{"op":"replace","path":"/spec/template/spec/containers/0/image","value":"%s"}]`, newImage)
-		if _, err := kubeClient.AppsV1().Deployments(metav1.NamespaceSystem).Patch(ctx, deployTappControllerName, types.JSONPatchType, []byte(patch), metav1.PatchOptions{}); err != nil {
-			if time.Now().After(initDelay) {
-				tappController = tappController.DeepCopy()
-				tappController.Status.Phase = v1.AddonPhaseFailed
-				tappController.Status.Reason = "Failed to upgrade tapp controller."
-				if err = c.persistUpdate(ctx, tappController); err != nil {
-					return false, err
-				}
-				return true, nil
-			}
-			return false, nil
-		}
-		tappController = tappController.DeepCopy()
-		tappController.Status.Version = tappController.Spec.Version
-		tappController.Status.Phase = v1.AddonPhaseChecking
-		tappController.Status.Reason = ""
-		if err = c.persistUpdate(ctx, tappController); err != nil {
-			return false, err
-		}
-		return true, nil
-	}
-}
-
-func (c *Controller) persistUpdate(ctx context.Context, tappController *v1.TappController) error {
-	var err error
-	for i := 0; i < clientRetryCount; i++ {
-		_, err = c.client.PlatformV1().TappControllers().UpdateStatus(ctx, tappController, metav1.UpdateOptions{})
-		if err == nil {
-			return nil
-		}
-		// If the object no longer exists, we don't want to recreate it. Just bail
-		// out so that we can process the delete, which we should soon be receiving
-		// if we haven't already.
-		if errors.IsNotFound(err) {
-			log.Info("Not persisting update to tappController that no longer exists", log.String("clusterName", tappController.Spec.ClusterName), log.Err(err))
-			return nil
-		}
-		if errors.IsConflict(err) {
-			return fmt.Errorf("not persisting update to tappController '%s' that has been changed since we received it: %v", tappController.Spec.ClusterName, err)
-		}
-		log.Warn(fmt.Sprintf("Failed to persist updated status of tappController '%s/%s'", tappController.Spec.ClusterName, tappController.Status.Phase), log.String("clusterName", tappController.Spec.ClusterName), log.Err(err))
-		time.Sleep(clientRetryInterval)
-	}
-
-	return err
-}
+func int32Ptr(i int32) *int32 { return &i }
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tappcontroller
+
+import (
+	"context"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/addon/lifecycle"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	tappCRDName = "tapps.apps.tkestack.io"
+
+	specReplicasPath   = ".spec.replicas"
+	statusReplicasPath = ".status.replicas"
+	labelSelectorPath  = ".status.scaleLabelSelector"
+)
+
+// Configure registers the scale subresource of TApp once tapp-controller
+// registered its CRD, so that TApps are autoscaled by HPA and CronHPA through
+// the scale API.
+func (a *tappControllerAddon) Configure(ctx context.Context, cluster *v1.Cluster, obj lifecycle.Object) error {
+	extClient, err := util.BuildExternalExtensionClientSet(ctx, cluster, a.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	crd, err := extClient.ApiextensionsV1beta1().CustomResourceDefinitions().Get(ctx, tappCRDName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	crd = crd.DeepCopy()
+	if !setScaleSubresource(crd) {
+		return nil
+	}
+	if _, err := extClient.ApiextensionsV1beta1().CustomResourceDefinitions().Update(ctx, crd, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	log.Info("Register the scale subresource of TApp", log.String("name", obj.GetName()), log.String("clusterName", cluster.Name))
+	return nil
+}
+
+// setScaleSubresource sets the status and scale subresources of the CRD,
+// returns false if they are set already. The subresources are set per version
+// if any version has its own.
+func setScaleSubresource(crd *v1beta1.CustomResourceDefinition) bool {
+	perVersion := false
+	for _, version := range crd.Spec.Versions {
+		if version.Subresources != nil {
+			perVersion = true
+		}
+	}
+	if !perVersion {
+		if crd.Spec.Subresources == nil {
+			crd.Spec.Subresources = &v1beta1.CustomResourceSubresources{}
+		}
+		return setSubresources(crd.Spec.Subresources)
+	}
+	changed := false
+	for i := range crd.Spec.Versions {
+		if crd.Spec.Versions[i].Subresources == nil {
+			crd.Spec.Versions[i].Subresources = &v1beta1.CustomResourceSubresources{}
+		}
+		if setSubresources(crd.Spec.Versions[i].Subresources) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func setSubresources(subresources *v1beta1.CustomResourceSubresources) bool {
+	changed := false
+	if subresources.Status == nil {
+		subresources.Status = &v1beta1.CustomResourceSubresourceStatus{}
+		changed = true
+	}
+	if subresources.Scale == nil {
+		selector := labelSelectorPath
+		subresources.Scale = &v1beta1.CustomResourceSubresourceScale{
+			SpecReplicasPath:   specReplicasPath,
+			StatusReplicasPath: statusReplicasPath,
+			LabelSelectorPath:  &selector,
+		}
+		changed = true
+	}
+	return changed
+}
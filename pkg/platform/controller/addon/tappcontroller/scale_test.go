@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tappcontroller
+
+import (
+	"testing"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestSetScaleSubresource(t *testing.T) {
+	crd := &v1beta1.CustomResourceDefinition{}
+	if !setScaleSubresource(crd) {
+		t.Fatal("expected the subresources to be set")
+	}
+	scale := crd.Spec.Subresources.Scale
+	if scale.SpecReplicasPath != specReplicasPath || scale.StatusReplicasPath != statusReplicasPath || *scale.LabelSelectorPath != labelSelectorPath {
+		t.Errorf("unexpected scale subresource %+v", scale)
+	}
+	if setScaleSubresource(crd) {
+		t.Error("expected the subresources set already to be kept")
+	}
+
+	crd = &v1beta1.CustomResourceDefinition{
+		Spec: v1beta1.CustomResourceDefinitionSpec{
+			Versions: []v1beta1.CustomResourceDefinitionVersion{
+				{Name: "v1", Subresources: &v1beta1.CustomResourceSubresources{Status: &v1beta1.CustomResourceSubresourceStatus{}}},
+				{Name: "v1beta1"},
+			},
+		},
+	}
+	if !setScaleSubresource(crd) {
+		t.Fatal("expected the subresources to be set")
+	}
+	if crd.Spec.Subresources != nil {
+		t.Error("expected the subresources to be set per version")
+	}
+	for _, version := range crd.Spec.Versions {
+		if version.Subresources == nil || version.Subresources.Scale == nil {
+			t.Errorf("expected the scale subresource of version %s", version.Name)
+		}
+	}
+}
+
+func TestTappControllerArgs(t *testing.T) {
+	args := tappControllerArgs(&v1.TappControllerConfig{Workers: 10, ForceDeletePod: true})
+	want := []string{"--v", "3", "--register-admission", "true", "--worker", "10", "--force-delete-pod", "true"}
+	if len(args) != len(want) {
+		t.Fatalf("got %v, want %v", args, want)
+	}
+	for i := range want {
+		if args[i] != want[i] {
+			t.Errorf("got %v, want %v", args, want)
+			break
+		}
+	}
+}
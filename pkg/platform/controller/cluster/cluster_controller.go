@@ -482,6 +482,7 @@ func (c *Controller) checkHealth(ctx context.Context, cluster *typesv1.Cluster)
 	client, err := cluster.Clientset()
 	if err != nil {
 		cluster.Status.Phase = platformv1.ClusterFailed
+		cluster.Status.Health = unreachableClusterHealth(err)
 
 		healthCheckCondition.Reason = failedHealthCheckReason
 		healthCheckCondition.Message = err.Error()
@@ -489,6 +490,7 @@ func (c *Controller) checkHealth(ctx context.Context, cluster *typesv1.Cluster)
 		version, err := client.Discovery().ServerVersion()
 		if err != nil {
 			cluster.Status.Phase = platformv1.ClusterFailed
+			cluster.Status.Health = unreachableClusterHealth(err)
 
 			healthCheckCondition.Reason = failedHealthCheckReason
 			healthCheckCondition.Message = err.Error()
@@ -496,6 +498,14 @@ func (c *Controller) checkHealth(ctx context.Context, cluster *typesv1.Cluster)
 			cluster.Status.Phase = platformv1.ClusterRunning
 			cluster.Status.Version = strings.TrimPrefix(version.String(), "v")
 			cluster.Status.KubeVendor = vendor.GetKubeVendor(cluster.Status.Version)
+			cluster.Status.Health = computeHealth(ctx, cluster, client)
+			// keep the last known images if failed to list pods
+			pods, err := client.CoreV1().Pods(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				log.FromContext(ctx).Error(err, "List pods of kube-system failed")
+			} else {
+				cluster.Status.Images = resolveImages(pods.Items)
+			}
 
 			healthCheckCondition.Status = platformv1.ConditionTrue
 		}
@@ -506,7 +516,8 @@ func (c *Controller) checkHealth(ctx context.Context, cluster *typesv1.Cluster)
 	log.FromContext(ctx).Info("Update cluster health status",
 		"version", cluster.Status.Version,
 		"kubevendor", cluster.Status.KubeVendor,
-		"phase", cluster.Status.Phase)
+		"phase", cluster.Status.Phase,
+		"healthScore", cluster.Status.Health.Score)
 
 	return cluster
 }
@@ -0,0 +1,283 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+const (
+	healthDimensionControlPlane = "ControlPlane"
+	healthDimensionNodes        = "Nodes"
+	healthDimensionAddons       = "Addons"
+	healthDimensionCertificates = "Certificates"
+	healthDimensionCapacity     = "Capacity"
+
+	// certificates expiring within the window lower the score linearly
+	certificateExpirationWindow = 30 * 24 * time.Hour
+	// maxHealthMessageItems limits the number of items listed in the message of dimension
+	maxHealthMessageItems = 3
+)
+
+// healthDimensionWeights are the percentages of dimensions in the composite score.
+var healthDimensionWeights = map[string]int32{
+	healthDimensionControlPlane: 30,
+	healthDimensionNodes:        25,
+	healthDimensionAddons:       15,
+	healthDimensionCertificates: 15,
+	healthDimensionCapacity:     15,
+}
+
+// unreachableClusterHealth is the health of cluster whose apiserver is unreachable,
+// the other dimensions are unknown so that the cluster is the riskiest.
+func unreachableClusterHealth(err error) *platformv1.ClusterHealth {
+	return &platformv1.ClusterHealth{
+		Score: 0,
+		Dimensions: []platformv1.ClusterHealthDimension{{
+			Name:    healthDimensionControlPlane,
+			Score:   0,
+			Weight:  healthDimensionWeights[healthDimensionControlPlane],
+			Message: err.Error(),
+		}},
+		LastUpdateTime: metav1.Now(),
+	}
+}
+
+// computeHealth scores the dimensions of cluster and weights them into the
+// composite score. The dimension failed to be scored is treated as unhealthy.
+func computeHealth(ctx context.Context, cluster *typesv1.Cluster, client kubernetes.Interface) *platformv1.ClusterHealth {
+	health := &platformv1.ClusterHealth{LastUpdateTime: metav1.Now()}
+	add := func(name string, score int32, message string) {
+		health.Dimensions = append(health.Dimensions, platformv1.ClusterHealthDimension{
+			Name:    name,
+			Score:   score,
+			Weight:  healthDimensionWeights[name],
+			Message: message,
+		})
+	}
+
+	score, message := scoreControlPlane(ctx, client)
+	add(healthDimensionControlPlane, score, message)
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		add(healthDimensionNodes, 0, err.Error())
+		add(healthDimensionCapacity, 0, err.Error())
+	} else {
+		score, message = scoreNodes(nodes.Items)
+		add(healthDimensionNodes, score, message)
+		score, message = scoreCapacity(nodes.Items)
+		add(healthDimensionCapacity, score, message)
+	}
+
+	deployments, err := client.AppsV1().Deployments(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		add(healthDimensionAddons, 0, err.Error())
+	} else {
+		daemonSets, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			add(healthDimensionAddons, 0, err.Error())
+		} else {
+			score, message = scoreAddons(deployments.Items, daemonSets.Items)
+			add(healthDimensionAddons, score, message)
+		}
+	}
+
+	score, message = scoreCertificates(time.Now(), certificateExpirations(cluster))
+	add(healthDimensionCertificates, score, message)
+
+	health.Score = compositeHealthScore(health.Dimensions)
+
+	return health
+}
+
+// compositeHealthScore returns the weighted average score of dimensions.
+func compositeHealthScore(dimensions []platformv1.ClusterHealthDimension) int32 {
+	var total, weights int32
+	for _, one := range dimensions {
+		total += one.Score * one.Weight
+		weights += one.Weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return total / weights
+}
+
+// scoreControlPlane scores by the ratio of passed readyz checks, and falls back
+// to healthz for the apiserver without readyz.
+func scoreControlPlane(ctx context.Context, client kubernetes.Interface) (int32, string) {
+	data, err := client.Discovery().RESTClient().Get().AbsPath("/readyz").Param("verbose", "").DoRaw(ctx)
+	if err == nil || len(data) != 0 {
+		var passed, failed []string
+		for _, line := range strings.Split(string(data), "\n") {
+			switch {
+			case strings.HasPrefix(line, "[+]"):
+				passed = append(passed, line)
+			case strings.HasPrefix(line, "[-]"):
+				failed = append(failed, strings.TrimPrefix(line, "[-]"))
+			}
+		}
+		if len(passed)+len(failed) != 0 {
+			return ratioScore(len(passed), len(passed)+len(failed)), joinHealthMessage("readyz check failed", failed)
+		}
+	}
+
+	data, err = client.Discovery().RESTClient().Get().AbsPath("/healthz").DoRaw(ctx)
+	if err != nil {
+		return 0, err.Error()
+	}
+	if strings.TrimSpace(string(data)) != "ok" {
+		return 0, fmt.Sprintf("healthz: %s", data)
+	}
+	return 100, ""
+}
+
+// scoreNodes scores by the ratio of ready nodes.
+func scoreNodes(nodes []corev1.Node) (int32, string) {
+	if len(nodes) == 0 {
+		return 0, "no node"
+	}
+	var notReady []string
+	for _, node := range nodes {
+		if !isNodeConditionTrue(node, corev1.NodeReady) {
+			notReady = append(notReady, node.Name)
+		}
+	}
+	return ratioScore(len(nodes)-len(notReady), len(nodes)), joinHealthMessage("not ready nodes", notReady)
+}
+
+// scoreCapacity scores by the ratio of nodes without memory, disk or pid pressure.
+func scoreCapacity(nodes []corev1.Node) (int32, string) {
+	if len(nodes) == 0 {
+		return 0, "no node"
+	}
+	var pressured []string
+	for _, node := range nodes {
+		for _, conditionType := range []corev1.NodeConditionType{corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure} {
+			if isNodeConditionTrue(node, conditionType) {
+				pressured = append(pressured, fmt.Sprintf("%s(%s)", node.Name, conditionType))
+				break
+			}
+		}
+	}
+	return ratioScore(len(nodes)-len(pressured), len(nodes)), joinHealthMessage("pressured nodes", pressured)
+}
+
+// scoreAddons scores by the ratio of available deployments and daemonsets in kube-system.
+func scoreAddons(deployments []appsv1.Deployment, daemonSets []appsv1.DaemonSet) (int32, string) {
+	var unavailable []string
+	for _, one := range deployments {
+		replicas := int32(1)
+		if one.Spec.Replicas != nil {
+			replicas = *one.Spec.Replicas
+		}
+		if one.Status.AvailableReplicas < replicas {
+			unavailable = append(unavailable, "deployment/"+one.Name)
+		}
+	}
+	for _, one := range daemonSets {
+		if one.Status.NumberAvailable < one.Status.DesiredNumberScheduled {
+			unavailable = append(unavailable, "daemonset/"+one.Name)
+		}
+	}
+	total := len(deployments) + len(daemonSets)
+	if total == 0 {
+		return 100, ""
+	}
+	return ratioScore(total-len(unavailable), total), joinHealthMessage("unavailable addons", unavailable)
+}
+
+// certificateExpirations returns the expiration of certificates on masters and
+// the client certificate of cluster credential.
+func certificateExpirations(cluster *typesv1.Cluster) map[string]time.Time {
+	expirations := map[string]time.Time{}
+	if cluster.Status.Certificates != nil {
+		for _, one := range cluster.Status.Certificates.Certificates {
+			expirations[fmt.Sprintf("%s on %s", one.Name, one.Node)] = one.NotAfter.Time
+		}
+	}
+	if cluster.ClusterCredential != nil && len(cluster.ClusterCredential.ClientCert) != 0 {
+		certs, err := certutil.ParseCertsPEM(cluster.ClusterCredential.ClientCert)
+		if err == nil && len(certs) != 0 {
+			expirations["credential client certificate"] = certs[0].NotAfter
+		}
+	}
+	return expirations
+}
+
+// scoreCertificates scores by the certificate expiring soonest, the score decreases
+// linearly to 0 within the expiration window.
+func scoreCertificates(now time.Time, expirations map[string]time.Time) (int32, string) {
+	if len(expirations) == 0 {
+		return 100, ""
+	}
+	var soonest string
+	for name, notAfter := range expirations {
+		if soonest == "" || notAfter.Before(expirations[soonest]) {
+			soonest = name
+		}
+	}
+	left := expirations[soonest].Sub(now)
+	switch {
+	case left <= 0:
+		return 0, fmt.Sprintf("%s expired", soonest)
+	case left >= certificateExpirationWindow:
+		return 100, ""
+	default:
+		return int32(100 * left / certificateExpirationWindow),
+			fmt.Sprintf("%s expires in %d days", soonest, int(left.Hours()/24))
+	}
+}
+
+func isNodeConditionTrue(node corev1.Node, conditionType corev1.NodeConditionType) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func ratioScore(good int, total int) int32 {
+	return int32(100 * good / total)
+}
+
+func joinHealthMessage(prefix string, items []string) string {
+	if len(items) == 0 {
+		return ""
+	}
+	sort.Strings(items)
+	if len(items) > maxHealthMessageItems {
+		return fmt.Sprintf("%s: %s and %d more", prefix, strings.Join(items[:maxHealthMessageItems], ", "), len(items)-maxHealthMessageItems)
+	}
+	return fmt.Sprintf("%s: %s", prefix, strings.Join(items, ", "))
+}
@@ -0,0 +1,242 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	certutil "k8s.io/client-go/util/cert"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func healthNode(name string, conditions map[corev1.NodeConditionType]corev1.ConditionStatus) corev1.Node {
+	node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	for conditionType, status := range conditions {
+		node.Status.Conditions = append(node.Status.Conditions, corev1.NodeCondition{Type: conditionType, Status: status})
+	}
+	return node
+}
+
+func TestScoreNodes(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodes       []corev1.Node
+		wantScore   int32
+		wantMessage string
+	}{
+		{
+			name:        "no node",
+			wantScore:   0,
+			wantMessage: "no node",
+		},
+		{
+			name: "all ready",
+			nodes: []corev1.Node{
+				healthNode("node1", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeReady: corev1.ConditionTrue}),
+				healthNode("node2", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeReady: corev1.ConditionTrue}),
+			},
+			wantScore: 100,
+		},
+		{
+			name: "not ready and unknown",
+			nodes: []corev1.Node{
+				healthNode("node1", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeReady: corev1.ConditionTrue}),
+				healthNode("node2", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeReady: corev1.ConditionTrue}),
+				healthNode("node4", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeReady: corev1.ConditionUnknown}),
+				healthNode("node3", nil),
+			},
+			wantScore:   50,
+			wantMessage: "not ready nodes: node3, node4",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, message := scoreNodes(tt.nodes)
+			if score != tt.wantScore || message != tt.wantMessage {
+				t.Errorf("scoreNodes() = %d, %q, want %d, %q", score, message, tt.wantScore, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestScoreCapacity(t *testing.T) {
+	nodes := []corev1.Node{
+		healthNode("node1", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeMemoryPressure: corev1.ConditionFalse}),
+		healthNode("node2", map[corev1.NodeConditionType]corev1.ConditionStatus{corev1.NodeDiskPressure: corev1.ConditionTrue}),
+		healthNode("node3", map[corev1.NodeConditionType]corev1.ConditionStatus{
+			corev1.NodeMemoryPressure: corev1.ConditionTrue,
+			corev1.NodePIDPressure:    corev1.ConditionFalse,
+		}),
+		healthNode("node4", nil),
+	}
+	score, message := scoreCapacity(nodes)
+	if score != 50 || message != "pressured nodes: node2(DiskPressure), node3(MemoryPressure)" {
+		t.Errorf("scoreCapacity() = %d, %q", score, message)
+	}
+}
+
+func TestScoreAddons(t *testing.T) {
+	two := int32(2)
+	deployments := []appsv1.Deployment{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "coredns"},
+			Spec:       appsv1.DeploymentSpec{Replicas: &two},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: 2},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "metrics-server"},
+			Status:     appsv1.DeploymentStatus{AvailableReplicas: 0},
+		},
+	}
+	daemonSets := []appsv1.DaemonSet{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-proxy"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 3},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "galaxy"},
+			Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 2},
+		},
+	}
+	score, message := scoreAddons(deployments, daemonSets)
+	if score != 50 || message != "unavailable addons: daemonset/galaxy, deployment/metrics-server" {
+		t.Errorf("scoreAddons() = %d, %q", score, message)
+	}
+
+	score, message = scoreAddons(nil, nil)
+	if score != 100 || message != "" {
+		t.Errorf("scoreAddons() without addons = %d, %q", score, message)
+	}
+}
+
+func TestScoreCertificates(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	tests := []struct {
+		name        string
+		expirations map[string]time.Time
+		wantScore   int32
+		wantMessage string
+	}{
+		{
+			name:      "no certificate",
+			wantScore: 100,
+		},
+		{
+			name: "out of window",
+			expirations: map[string]time.Time{
+				"apiserver.crt on 10.0.0.1":   now.Add(365 * day),
+				"etcd/server.crt on 10.0.0.1": now.Add(30 * day),
+			},
+			wantScore: 100,
+		},
+		{
+			name: "expiring",
+			expirations: map[string]time.Time{
+				"apiserver.crt on 10.0.0.1":          now.Add(365 * day),
+				"credential client certificate":      now.Add(15 * day),
+				"front-proxy-client.crt on 10.0.0.1": now.Add(20 * day),
+			},
+			wantScore:   50,
+			wantMessage: "credential client certificate expires in 15 days",
+		},
+		{
+			name: "expired",
+			expirations: map[string]time.Time{
+				"apiserver.crt on 10.0.0.1": now.Add(-day),
+				"apiserver.crt on 10.0.0.2": now.Add(day),
+			},
+			wantScore:   0,
+			wantMessage: "apiserver.crt on 10.0.0.1 expired",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score, message := scoreCertificates(now, tt.expirations)
+			if score != tt.wantScore || message != tt.wantMessage {
+				t.Errorf("scoreCertificates() = %d, %q, want %d, %q", score, message, tt.wantScore, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestCertificateExpirations(t *testing.T) {
+	certPEM, _, err := certutil.GenerateSelfSignedCertKey("admin", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notAfter := metav1.NewTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	cluster := &typesv1.Cluster{
+		Cluster: &platformv1.Cluster{
+			Status: platformv1.ClusterStatus{
+				Certificates: &platformv1.CertificatesStatus{
+					Certificates: []platformv1.CertificateExpiration{{Name: "apiserver.crt", Node: "10.0.0.1", NotAfter: notAfter}},
+				},
+			},
+		},
+		ClusterCredential: &platformv1.ClusterCredential{ClientCert: certPEM},
+	}
+	expirations := certificateExpirations(cluster)
+	if len(expirations) != 2 {
+		t.Fatalf("expected 2 certificates, got %v", expirations)
+	}
+	if !expirations["apiserver.crt on 10.0.0.1"].Equal(notAfter.Time) {
+		t.Errorf("unexpected expiration of apiserver.crt: %v", expirations["apiserver.crt on 10.0.0.1"])
+	}
+	if _, ok := expirations["credential client certificate"]; !ok {
+		t.Errorf("expected expiration of credential client certificate, got %v", expirations)
+	}
+
+	cluster.ClusterCredential.ClientCert = []byte("invalid")
+	if expirations := certificateExpirations(cluster); len(expirations) != 1 {
+		t.Errorf("expected invalid client certificate ignored, got %v", expirations)
+	}
+}
+
+func TestCompositeHealthScore(t *testing.T) {
+	dimensions := []platformv1.ClusterHealthDimension{
+		{Name: healthDimensionControlPlane, Score: 100, Weight: healthDimensionWeights[healthDimensionControlPlane]},
+		{Name: healthDimensionNodes, Score: 50, Weight: healthDimensionWeights[healthDimensionNodes]},
+		{Name: healthDimensionAddons, Score: 100, Weight: healthDimensionWeights[healthDimensionAddons]},
+		{Name: healthDimensionCertificates, Score: 0, Weight: healthDimensionWeights[healthDimensionCertificates]},
+		{Name: healthDimensionCapacity, Score: 100, Weight: healthDimensionWeights[healthDimensionCapacity]},
+	}
+	// (100*30 + 50*25 + 100*15 + 0*15 + 100*15) / 100
+	if score := compositeHealthScore(dimensions); score != 72 {
+		t.Errorf("compositeHealthScore() = %d, want 72", score)
+	}
+	if score := compositeHealthScore(nil); score != 0 {
+		t.Errorf("compositeHealthScore() without dimensions = %d, want 0", score)
+	}
+}
+
+func TestJoinHealthMessage(t *testing.T) {
+	if message := joinHealthMessage("not ready nodes", nil); message != "" {
+		t.Errorf("expected empty message, got %q", message)
+	}
+	message := joinHealthMessage("not ready nodes", []string{"node5", "node2", "node4", "node1", "node3"})
+	if message != "not ready nodes: node1, node2, node3 and 2 more" {
+		t.Errorf("unexpected message %q", message)
+	}
+}
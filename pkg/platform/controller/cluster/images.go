@@ -0,0 +1,109 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// resolveImages returns the images of containers run by pods, the pods of a
+// workload on different nodes running the same image are merged into one.
+func resolveImages(pods []corev1.Pod) []platformv1.ClusterImage {
+	seen := map[platformv1.ClusterImage]bool{}
+	var images []platformv1.ClusterImage
+	add := func(component string, containers []corev1.Container, statuses []corev1.ContainerStatus) {
+		for _, container := range containers {
+			image := platformv1.ClusterImage{
+				Component: component,
+				Container: container.Name,
+				Image:     container.Image,
+			}
+			for _, status := range statuses {
+				if status.Name == container.Name {
+					image.Digest = imageDigest(status.ImageID)
+					break
+				}
+			}
+			if !seen[image] {
+				seen[image] = true
+				images = append(images, image)
+			}
+		}
+	}
+	for _, pod := range pods {
+		component := podComponent(pod)
+		add(component, pod.Spec.InitContainers, pod.Status.InitContainerStatuses)
+		add(component, pod.Spec.Containers, pod.Status.ContainerStatuses)
+	}
+	sort.Slice(images, func(i, j int) bool {
+		if images[i].Component != images[j].Component {
+			return images[i].Component < images[j].Component
+		}
+		if images[i].Container != images[j].Container {
+			return images[i].Container < images[j].Container
+		}
+		if images[i].Image != images[j].Image {
+			return images[i].Image < images[j].Image
+		}
+		return images[i].Digest < images[j].Digest
+	})
+	return images
+}
+
+// podComponent returns the workload of pod, the pods created by ReplicaSet
+// belong to its Deployment and static pods are named by the component label
+// of kubeadm.
+func podComponent(pod corev1.Pod) string {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Controller == nil || !*owner.Controller {
+			continue
+		}
+		switch owner.Kind {
+		case "ReplicaSet":
+			if hash, ok := pod.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; ok {
+				return "deployment/" + strings.TrimSuffix(owner.Name, "-"+hash)
+			}
+			return "replicaset/" + owner.Name
+		case "Node":
+			if component, ok := pod.Labels["component"]; ok {
+				return "pod/" + component
+			}
+			return "pod/" + strings.TrimSuffix(pod.Name, "-"+pod.Spec.NodeName)
+		default:
+			return strings.ToLower(owner.Kind) + "/" + owner.Name
+		}
+	}
+	return "pod/" + pod.Name
+}
+
+// imageDigest returns the digest in the image ID reported by container runtime,
+// such as docker-pullable://nginx@sha256:... or nginx@sha256:..., the ID
+// without repository is the digest of image config rather than the manifest.
+func imageDigest(imageID string) string {
+	index := strings.LastIndex(imageID, "@")
+	if index < 0 {
+		return ""
+	}
+	return imageID[index+1:]
+}
@@ -0,0 +1,70 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func systemPod(name string, labels map[string]string, kind, owner, image, imageID string) corev1.Pod {
+	controller := true
+	p := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Spec: corev1.PodSpec{
+			NodeName:   "node1",
+			Containers: []corev1.Container{{Name: "main", Image: image}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "main", ImageID: imageID}},
+		},
+	}
+	if kind != "" {
+		p.OwnerReferences = []metav1.OwnerReference{{Kind: kind, Name: owner, Controller: &controller}}
+	}
+	return p
+}
+
+func TestResolveImages(t *testing.T) {
+	pods := []corev1.Pod{
+		systemPod("coredns-5d4f8c-abcde", map[string]string{"pod-template-hash": "5d4f8c"}, "ReplicaSet", "coredns-5d4f8c",
+			"coredns:1.8.4", "docker-pullable://coredns@sha256:aaa"),
+		systemPod("kube-proxy-x1", nil, "DaemonSet", "kube-proxy", "kube-proxy:v1.20.6", "sha256:config"),
+		systemPod("kube-proxy-x2", nil, "DaemonSet", "kube-proxy", "kube-proxy:v1.20.6", "sha256:config"),
+		systemPod("kube-apiserver-node1", map[string]string{"component": "kube-apiserver"}, "Node", "node1",
+			"kube-apiserver:v1.20.6", "kube-apiserver@sha256:bbb"),
+		systemPod("etcd-node1", nil, "Node", "node1", "etcd:3.4.13", ""),
+		systemPod("debug", nil, "", "", "busybox:1.33", ""),
+	}
+	got := resolveImages(pods)
+	want := []platformv1.ClusterImage{
+		{Component: "daemonset/kube-proxy", Container: "main", Image: "kube-proxy:v1.20.6"},
+		{Component: "deployment/coredns", Container: "main", Image: "coredns:1.8.4", Digest: "sha256:aaa"},
+		{Component: "pod/debug", Container: "main", Image: "busybox:1.33"},
+		{Component: "pod/etcd", Container: "main", Image: "etcd:3.4.13"},
+		{Component: "pod/kube-apiserver", Container: "main", Image: "kube-apiserver:v1.20.6", Digest: "sha256:bbb"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("resolveImages() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,340 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clusteroperation
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+	"tkestack.io/tke/pkg/util/pkiutil"
+)
+
+const (
+	controllerName = "cluster-operation-controller"
+
+	// retryInterval is the interval to retry the operations of unreachable
+	// clusters.
+	retryInterval = 15 * time.Second
+	// attemptTimeout is the timeout of each attempt of an operation.
+	attemptTimeout = 30 * time.Second
+	// finishedRetention is how long the finished operations are kept for
+	// users to check the results.
+	finishedRetention = 24 * time.Hour
+)
+
+// Controller is responsible for applying the operations queued while clusters
+// are unreachable. The operations of a cluster are applied in the order they
+// are submitted, the later ones wait while the earlier one is unreachable.
+type Controller struct {
+	client       clientset.Interface
+	queue        workqueue.RateLimitingInterface
+	lister       platformv1lister.ClusterOperationLister
+	listerSynced cache.InformerSynced
+	// now is replaced in tests
+	now func() time.Time
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, informer platformv1informer.ClusterOperationInformer, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		now:    time.Now,
+	}
+
+	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.PlatformV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldOperation, ok1 := oldObj.(*v1.ClusterOperation)
+				curOperation, ok2 := newObj.(*v1.ClusterOperation)
+				if ok1 && ok2 && !reflect.DeepEqual(oldOperation.Status, curOperation.Status) {
+					controller.enqueue(newObj)
+				}
+			},
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+
+	return controller
+}
+
+// enqueue adds the cluster of the operation to the queue, the operations are
+// synced by cluster to keep the order.
+func (c *Controller) enqueue(obj interface{}) {
+	operation, ok := obj.(*v1.ClusterOperation)
+	if !ok {
+		log.Error("Couldn't get cluster operation from object", log.Any("object", obj))
+		return
+	}
+	c.queue.Add(operation.Spec.ClusterName)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting cluster operation controller")
+	defer log.Info("Shutting down cluster operation controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for cluster operation caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing operations of cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncCluster applies the pending operations of the cluster in order, expires
+// the ones out of TTL and deletes the finished ones out of retention.
+func (c *Controller) syncCluster(clusterName string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing cluster operations", log.String("clusterName", clusterName), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	all, err := c.lister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var operations []*v1.ClusterOperation
+	for _, one := range all {
+		if one.Spec.ClusterName == clusterName {
+			operations = append(operations, one)
+		}
+	}
+	sortOperations(operations)
+
+	ctx := context.Background()
+	var next time.Duration
+	requeueAfter := func(d time.Duration) {
+		if next == 0 || d < next {
+			next = d
+		}
+	}
+	blocked := false
+	for _, operation := range operations {
+		now := c.now()
+		if operation.Status.Phase != v1.ClusterOperationPending {
+			expired := operation.Status.LastTransitionTime.Add(finishedRetention)
+			if now.Before(expired) {
+				requeueAfter(expired.Sub(now))
+				continue
+			}
+			err := c.client.PlatformV1().ClusterOperations().Delete(ctx, operation.Name, metav1.DeleteOptions{})
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+			continue
+		}
+		if !now.Before(operation.Spec.ExpireTime.Time) {
+			message := fmt.Sprintf("cluster %s is unreachable until the operation expires", clusterName)
+			if operation.Status.Message != "" {
+				message = fmt.Sprintf("%s, last error: %s", message, operation.Status.Message)
+			}
+			if err := c.persistStatus(ctx, operation, v1.ClusterOperationExpired, 0, message, false); err != nil {
+				return err
+			}
+			continue
+		}
+		// the later operations must not be applied before the earlier ones
+		if blocked {
+			requeueAfter(retryInterval)
+			continue
+		}
+		if retryTime := operation.Status.LastAttemptTime.Add(retryInterval); operation.Status.Attempts > 0 && now.Before(retryTime) {
+			blocked = true
+			requeueAfter(retryTime.Sub(now))
+			continue
+		}
+
+		code, err := c.apply(ctx, operation)
+		switch {
+		case err == nil:
+			err = c.persistStatus(ctx, operation, v1.ClusterOperationSucceeded, code, "", true)
+		case isRejected(err):
+			err = c.persistStatus(ctx, operation, v1.ClusterOperationFailed, code, err.Error(), true)
+		default:
+			log.Info("Cluster is still unreachable, retry the operation later",
+				log.String("clusterName", clusterName), log.String("operation", operation.Name), log.Err(err))
+			blocked = true
+			requeueAfter(retryInterval)
+			err = c.persistStatus(ctx, operation, v1.ClusterOperationPending, code, err.Error(), true)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if next > 0 {
+		c.queue.AddAfter(clusterName, next)
+	}
+	return nil
+}
+
+// apply sends the request of operation to the cluster as the user who
+// submitted it, it returns the HTTP status code of response.
+func (c *Controller) apply(ctx context.Context, operation *v1.ClusterOperation) (int, error) {
+	cluster, err := typesv1.GetClusterByName(ctx, c.client.PlatformV1(), operation.Spec.ClusterName)
+	if err != nil {
+		return 0, err
+	}
+	if cluster.ClusterCredential == nil {
+		return 0, fmt.Errorf("credential of cluster %s is not found", cluster.Name)
+	}
+
+	config := &rest.Config{Timeout: attemptTimeout}
+	if cluster.AuthzWebhookEnabled() {
+		certData, keyData, err := pkiutil.GenerateClientCertAndKey(operation.Spec.Username, operation.Spec.Groups,
+			cluster.ClusterCredential.CACert, cluster.ClusterCredential.CAKey)
+		if err != nil {
+			return 0, err
+		}
+		config, err = cluster.RESTConfigForClientX509(config, certData, keyData)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		config, err = cluster.RESTConfig(config)
+		if err != nil {
+			return 0, err
+		}
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return 0, err
+	}
+
+	u, err := url.Parse(operation.Spec.Path)
+	if err != nil {
+		return 0, err
+	}
+	req := kubeClient.Discovery().RESTClient().Verb(operation.Spec.Verb).AbsPath(u.Path)
+	for key, values := range u.Query() {
+		for _, value := range values {
+			req = req.Param(key, value)
+		}
+	}
+	if operation.Spec.ContentType != "" {
+		req = req.SetHeader("Content-Type", operation.Spec.ContentType)
+	}
+	if operation.Spec.Body != "" {
+		req = req.Body([]byte(operation.Spec.Body))
+	}
+	var code int
+	err = req.Do(ctx).StatusCode(&code).Error()
+	return code, err
+}
+
+func (c *Controller) persistStatus(ctx context.Context, operation *v1.ClusterOperation, phase v1.ClusterOperationPhase, code int, message string, attempted bool) error {
+	operation = operation.DeepCopy()
+	now := metav1.NewTime(c.now())
+	if operation.Status.Phase != phase {
+		operation.Status.LastTransitionTime = now
+	}
+	operation.Status.Phase = phase
+	operation.Status.Message = message
+	if attempted {
+		operation.Status.Attempts++
+		operation.Status.LastAttemptTime = now
+		operation.Status.Code = int32(code)
+	}
+	_, err := c.client.PlatformV1().ClusterOperations().UpdateStatus(ctx, operation, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// isRejected reports whether the operation is rejected by the cluster, or can
+// never be applied since the cluster is deleted.
+func isRejected(err error) bool {
+	if util.IsClusterUnreachable(err) {
+		return false
+	}
+	_, ok := err.(errors.APIStatus)
+	return ok && !errors.IsServiceUnavailable(err) && !errors.IsTimeout(err) && !errors.IsServerTimeout(err) && !errors.IsTooManyRequests(err)
+}
+
+// sortOperations sorts the operations in the order they are submitted.
+func sortOperations(operations []*v1.ClusterOperation) {
+	sort.Slice(operations, func(i, j int) bool {
+		ti, tj := operations[i].CreationTimestamp, operations[j].CreationTimestamp
+		if !ti.Equal(&tj) {
+			return ti.Before(&tj)
+		}
+		return operations[i].Name < operations[j].Name
+	})
+}
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clusteroperation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newOperation(name string, created time.Time, phase v1.ClusterOperationPhase) *v1.ClusterOperation {
+	return &v1.ClusterOperation{
+		ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: metav1.NewTime(created)},
+		Spec: v1.ClusterOperationSpec{
+			ClusterName: "cls-test",
+			Verb:        "POST",
+			Path:        "/api/v1/namespaces/default/configmaps",
+			Username:    "admin",
+			ExpireTime:  metav1.NewTime(created.Add(time.Hour)),
+		},
+		Status: v1.ClusterOperationStatus{Phase: phase, LastTransitionTime: metav1.NewTime(created)},
+	}
+}
+
+func TestSyncClusterExpiresAndCleans(t *testing.T) {
+	now := time.Now()
+	expired := newOperation("co-expired", now.Add(-2*time.Hour), v1.ClusterOperationPending)
+	finished := newOperation("co-finished", now.Add(-2*finishedRetention), v1.ClusterOperationSucceeded)
+	recent := newOperation("co-recent", now.Add(-time.Minute), v1.ClusterOperationFailed)
+
+	client := fake.NewSimpleClientset(expired, finished, recent)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, one := range []*v1.ClusterOperation{expired, finished, recent} {
+		_ = indexer.Add(one)
+	}
+	c := &Controller{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+		lister: platformv1lister.NewClusterOperationLister(indexer),
+		now:    func() time.Time { return now },
+	}
+	defer c.queue.ShutDown()
+
+	if err := c.syncCluster("cls-test"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	got, err := client.PlatformV1().ClusterOperations().Get(ctx, expired.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status.Phase != v1.ClusterOperationExpired || got.Status.Attempts != 0 {
+		t.Errorf("expired operation got status %+v", got.Status)
+	}
+	if _, err := client.PlatformV1().ClusterOperations().Get(ctx, finished.Name, metav1.GetOptions{}); err == nil {
+		t.Errorf("finished operation out of retention is not deleted")
+	}
+	if _, err := client.PlatformV1().ClusterOperations().Get(ctx, recent.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("finished operation in retention is deleted: %v", err)
+	}
+}
+
+func TestSortOperations(t *testing.T) {
+	now := time.Now()
+	operations := []*v1.ClusterOperation{
+		newOperation("co-c", now, v1.ClusterOperationPending),
+		newOperation("co-b", now, v1.ClusterOperationPending),
+		newOperation("co-a", now.Add(time.Second), v1.ClusterOperationPending),
+	}
+	sortOperations(operations)
+	for i, want := range []string{"co-b", "co-c", "co-a"} {
+		if operations[i].Name != want {
+			t.Errorf("sortOperations()[%d] = %s, want %s", i, operations[i].Name, want)
+		}
+	}
+}
@@ -0,0 +1,340 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package gpunodeconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	clientset "tkestack.io/tke/api/client/clientset/versioned"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	v1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	controllerName = "gpu-node-config-controller"
+
+	// applyingInterval is the interval to check the state of nodes whose GPUs
+	// are being repartitioned.
+	applyingInterval = 15 * time.Second
+
+	reasonMIGNotEnabled = "MIGNotEnabled"
+	reasonNodeNotFound  = "NodeNotFound"
+	reasonNodeConflict  = "NodeConflict"
+	reasonApplyFailed   = "ApplyFailed"
+)
+
+// Controller is responsible for reconciling the MIG geometry of nodes from
+// GPUNodeConfigs. The GPUNodeConfigs of a cluster are rendered into the
+// mig-parted config of nvidia-mig-manager, which repartitions the GPUs of a
+// node after the node is labeled with its config.
+type Controller struct {
+	client       clientset.Interface
+	queue        workqueue.RateLimitingInterface
+	lister       platformv1lister.GPUNodeConfigLister
+	listerSynced cache.InformerSynced
+}
+
+// NewController creates a new Controller object.
+func NewController(client clientset.Interface, informer platformv1informer.GPUNodeConfigInformer, resyncPeriod time.Duration) *Controller {
+	controller := &Controller{
+		client: client,
+		queue:  workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), controllerName),
+	}
+
+	if client != nil && client.PlatformV1().RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage(controllerName, client.PlatformV1().RESTClient().GetRateLimiter())
+	}
+
+	informer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: controller.enqueue,
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				oldConfig, ok1 := oldObj.(*v1.GPUNodeConfig)
+				curConfig, ok2 := newObj.(*v1.GPUNodeConfig)
+				// the periodic resyncs refresh the discovered MIG profiles
+				if ok1 && ok2 && (oldConfig.ResourceVersion == curConfig.ResourceVersion || !reflect.DeepEqual(oldConfig.Spec, curConfig.Spec)) {
+					controller.enqueue(newObj)
+				}
+			},
+			DeleteFunc: controller.enqueue,
+		},
+		resyncPeriod,
+	)
+	controller.lister = informer.Lister()
+	controller.listerSynced = informer.Informer().HasSynced
+
+	return controller
+}
+
+// enqueue adds the cluster of the GPUNodeConfig to the queue, the
+// GPUNodeConfigs of a cluster share the same mig-parted config.
+func (c *Controller) enqueue(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	config, ok := obj.(*v1.GPUNodeConfig)
+	if !ok {
+		log.Error("Couldn't get GPUNodeConfig from object", log.Any("object", obj))
+		return
+	}
+	c.queue.Add(config.Spec.ClusterName)
+}
+
+// Run will set up the event handlers for types we are interested in, as well
+// as syncing informer caches and starting workers.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer runtime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting GPUNodeConfig controller")
+	defer log.Info("Shutting down GPUNodeConfig controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for GPUNodeConfig caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	runtime.HandleError(fmt.Errorf("error processing GPUNodeConfigs of cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+// syncCluster reconciles all the GPUNodeConfigs of the cluster.
+func (c *Controller) syncCluster(clusterName string) error {
+	startTime := time.Now()
+	defer func() {
+		log.Info("Finished syncing GPUNodeConfigs", log.String("clusterName", clusterName), log.Duration("processTime", time.Since(startTime)))
+	}()
+
+	all, err := c.lister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	var configs []*v1.GPUNodeConfig
+	for _, config := range all {
+		if config.Spec.ClusterName == clusterName && config.DeletionTimestamp == nil {
+			configs = append(configs, config)
+		}
+	}
+	sort.Slice(configs, func(i, j int) bool {
+		return configs[i].Name < configs[j].Name
+	})
+
+	ctx := context.Background()
+	cluster, err := c.client.PlatformV1().Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if cluster.MIGStrategy() == v1.GPUMIGStrategyNone {
+		for _, config := range configs {
+			if err := c.persistStatus(ctx, config, v1.GPUNodeConfigPending, reasonMIGNotEnabled, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	kubeClient, err := util.BuildExternalClientSet(ctx, cluster, c.client.PlatformV1())
+	if err != nil {
+		return err
+	}
+	if err := ensureMIGPartedConfig(ctx, kubeClient, configs); err != nil {
+		return err
+	}
+
+	nodes, err := kubeClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	nodeMap := make(map[string]*corev1.Node, len(nodes.Items))
+	for i := range nodes.Items {
+		nodeMap[nodes.Items[i].Name] = &nodes.Items[i]
+	}
+
+	// desired records the mig-parted config of each node, the first
+	// GPUNodeConfig by name wins if a node is declared more than once
+	desired := make(map[string]string)
+	applying := false
+	for _, config := range configs {
+		node, ok := nodeMap[config.Spec.NodeName]
+		if !ok {
+			if err := c.persistStatus(ctx, config, v1.GPUNodeConfigFailed, reasonNodeNotFound, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := desired[node.Name]; ok {
+			if err := c.persistStatus(ctx, config, v1.GPUNodeConfigFailed, reasonNodeConflict, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		name := migConfigName(config)
+		desired[node.Name] = name
+		if node.Labels[migConfigLabel] != name {
+			node, err = labelMIGConfig(ctx, kubeClient, node, name)
+			if err != nil {
+				return err
+			}
+		}
+
+		phase := migPhase(node, name)
+		reason := ""
+		switch phase {
+		case v1.GPUNodeConfigApplying:
+			applying = true
+		case v1.GPUNodeConfigFailed:
+			reason = reasonApplyFailed
+		}
+		if err := c.persistStatus(ctx, config, phase, reason, migProfiles(node)); err != nil {
+			return err
+		}
+	}
+
+	// the nodes whose GPUNodeConfigs are deleted keep the current geometry
+	for _, node := range nodeMap {
+		if _, ok := node.Labels[migConfigLabel]; ok && desired[node.Name] == "" {
+			if _, err := labelMIGConfig(ctx, kubeClient, node, ""); err != nil {
+				return err
+			}
+		}
+	}
+
+	if applying {
+		c.queue.AddAfter(clusterName, applyingInterval)
+	}
+	return nil
+}
+
+// ensureMIGPartedConfig creates or updates the ConfigMap of mig-parted config
+// mounted by nvidia-mig-manager.
+func ensureMIGPartedConfig(ctx context.Context, kubeClient kubernetes.Interface, configs []*v1.GPUNodeConfig) error {
+	data, err := renderMIGPartedConfig(configs)
+	if err != nil {
+		return err
+	}
+	cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, gpu.MIGPartedConfigMapName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      gpu.MIGPartedConfigMapName,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{gpu.MIGPartedConfigKey: string(data)},
+		}
+		_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Create(ctx, cm, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Data[gpu.MIGPartedConfigKey] == string(data) {
+		return nil
+	}
+	cm = cm.DeepCopy()
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+	cm.Data[gpu.MIGPartedConfigKey] = string(data)
+	_, err = kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// labelMIGConfig selects the mig-parted config of the node, the label is
+// removed if name is empty. The state is reset to pending so that the
+// previous result of nvidia-mig-manager is not taken as the new one.
+func labelMIGConfig(ctx context.Context, kubeClient kubernetes.Interface, node *corev1.Node, name string) (*corev1.Node, error) {
+	node = node.DeepCopy()
+	if name == "" {
+		delete(node.Labels, migConfigLabel)
+		delete(node.Labels, migConfigStateLabel)
+	} else {
+		if node.Labels == nil {
+			node.Labels = make(map[string]string)
+		}
+		node.Labels[migConfigLabel] = name
+		node.Labels[migConfigStateLabel] = migConfigStatePending
+	}
+	log.Info("Label the mig-parted config of node", log.String("node", node.Name), log.String("config", name))
+	return kubeClient.CoreV1().Nodes().Update(ctx, node, metav1.UpdateOptions{})
+}
+
+func (c *Controller) persistStatus(ctx context.Context, config *v1.GPUNodeConfig, phase v1.GPUNodeConfigPhase, reason string, profiles []v1.GPUMIGProfile) error {
+	if config.Status.Phase == phase && config.Status.Reason == reason && reflect.DeepEqual(config.Status.Profiles, profiles) {
+		return nil
+	}
+	config = config.DeepCopy()
+	if config.Status.Phase != phase {
+		config.Status.LastTransitionTime = metav1.Now()
+	}
+	config.Status.Phase = phase
+	config.Status.Reason = reason
+	config.Status.Profiles = profiles
+	_, err := c.client.PlatformV1().GPUNodeConfigs().UpdateStatus(ctx, config, metav1.UpdateOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package gpunodeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	// migConfigLabel selects the mig-parted config applied by nvidia-mig-manager.
+	migConfigLabel = "nvidia.com/mig.config"
+	// migConfigStateLabel is reported by nvidia-mig-manager, which is pending,
+	// rebooting, success or failed.
+	migConfigStateLabel = "nvidia.com/mig.config.state"
+	// migResourcePrefix is the prefix of the extended resources advertised by
+	// the device plugin with mixed strategy, such as nvidia.com/mig-1g.5gb.
+	migResourcePrefix = "nvidia.com/mig-"
+
+	migConfigStatePending = "pending"
+	migConfigStateSuccess = "success"
+	migConfigStateFailed  = "failed"
+
+	// the label value is at most 63 characters, including the hash suffix
+	maxMIGConfigNamePrefix = 54
+)
+
+// migPartedConfig is the config file of mig-parted.
+// https://github.com/NVIDIA/mig-parted/blob/v0.1.0/examples/config.yaml
+type migPartedConfig struct {
+	Version    string                       `json:"version"`
+	MIGConfigs map[string][]migDeviceFilter `json:"mig-configs"`
+}
+
+type migDeviceFilter struct {
+	// Devices is "all" or the indexes of the GPUs.
+	Devices    interface{}      `json:"devices"`
+	MIGEnabled bool             `json:"mig-enabled"`
+	MIGDevices map[string]int32 `json:"mig-devices,omitempty"`
+}
+
+// migDeviceFilters converts the MIG geometry of GPUNodeConfig to mig-parted,
+// MIG is disabled on all the GPUs if the geometry is empty.
+func migDeviceFilters(config *v1.GPUNodeConfig) []migDeviceFilter {
+	if len(config.Spec.MIGDevices) == 0 {
+		return []migDeviceFilter{{Devices: "all", MIGEnabled: false}}
+	}
+	filters := make([]migDeviceFilter, 0, len(config.Spec.MIGDevices))
+	for _, device := range config.Spec.MIGDevices {
+		filter := migDeviceFilter{Devices: "all", MIGEnabled: len(device.Slices) > 0}
+		if len(device.Devices) > 0 {
+			filter.Devices = device.Devices
+		}
+		if filter.MIGEnabled {
+			filter.MIGDevices = make(map[string]int32, len(device.Slices))
+			for _, slice := range device.Slices {
+				filter.MIGDevices[slice.Profile] = slice.Count
+			}
+		}
+		filters = append(filters, filter)
+	}
+	return filters
+}
+
+// migConfigName returns the name of the mig-parted config of GPUNodeConfig,
+// which is suffixed with the hash of the geometry since nvidia-mig-manager only
+// reapplies the config when the node label changes.
+func migConfigName(config *v1.GPUNodeConfig) string {
+	data, _ := json.Marshal(config.Spec.MIGDevices)
+	hash := fnv.New32a()
+	_, _ = hash.Write(data)
+	prefix := config.Name
+	if len(prefix) > maxMIGConfigNamePrefix {
+		prefix = prefix[:maxMIGConfigNamePrefix]
+	}
+	return fmt.Sprintf("%s-%08x", prefix, hash.Sum32())
+}
+
+// renderMIGPartedConfig renders the mig-parted config of all the
+// GPUNodeConfigs of a cluster.
+func renderMIGPartedConfig(configs []*v1.GPUNodeConfig) ([]byte, error) {
+	parted := migPartedConfig{
+		Version:    "v1",
+		MIGConfigs: make(map[string][]migDeviceFilter, len(configs)),
+	}
+	for _, config := range configs {
+		parted.MIGConfigs[migConfigName(config)] = migDeviceFilters(config)
+	}
+	return yaml.Marshal(parted)
+}
+
+// migPhase returns the phase of GPUNodeConfig by the state reported by
+// nvidia-mig-manager on the node.
+func migPhase(node *corev1.Node, name string) v1.GPUNodeConfigPhase {
+	if node.Labels[migConfigLabel] != name {
+		return v1.GPUNodeConfigPending
+	}
+	switch node.Labels[migConfigStateLabel] {
+	case migConfigStateSuccess:
+		return v1.GPUNodeConfigApplied
+	case migConfigStateFailed:
+		return v1.GPUNodeConfigFailed
+	default:
+		return v1.GPUNodeConfigApplying
+	}
+}
+
+// migProfiles returns the MIG devices advertised by the device plugin on the
+// node, which are only discovered with mixed strategy.
+func migProfiles(node *corev1.Node) []v1.GPUMIGProfile {
+	var profiles []v1.GPUMIGProfile
+	for name, quantity := range node.Status.Capacity {
+		if !strings.HasPrefix(string(name), migResourcePrefix) {
+			continue
+		}
+		profile := v1.GPUMIGProfile{
+			Profile:      strings.TrimPrefix(string(name), migResourcePrefix),
+			ResourceName: string(name),
+			Capacity:     quantity.Value(),
+		}
+		if allocatable, ok := node.Status.Allocatable[name]; ok {
+			profile.Allocatable = allocatable.Value()
+		}
+		profiles = append(profiles, profile)
+	}
+	sort.Slice(profiles, func(i, j int) bool {
+		return profiles[i].Profile < profiles[j].Profile
+	})
+	return profiles
+}
@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package gpunodeconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+	v1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newGPUNodeConfig(name string, devices ...v1.GPUMIGDeviceConfig) *v1.GPUNodeConfig {
+	return &v1.GPUNodeConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       v1.GPUNodeConfigSpec{ClusterName: "cls-test", NodeName: "node-" + name, MIGDevices: devices},
+	}
+}
+
+func TestRenderMIGPartedConfig(t *testing.T) {
+	disabled := newGPUNodeConfig("disabled")
+	mixed := newGPUNodeConfig("mixed",
+		v1.GPUMIGDeviceConfig{Devices: []int32{0}, Slices: []v1.GPUMIGSlice{{Profile: "1g.5gb", Count: 7}}},
+		v1.GPUMIGDeviceConfig{Devices: []int32{1}, Slices: []v1.GPUMIGSlice{{Profile: "3g.20gb", Count: 1}, {Profile: "2g.10gb", Count: 2}}},
+		v1.GPUMIGDeviceConfig{Devices: []int32{2, 3}},
+	)
+	all := newGPUNodeConfig("all", v1.GPUMIGDeviceConfig{Slices: []v1.GPUMIGSlice{{Profile: "7g.40gb", Count: 1}}})
+
+	data, err := renderMIGPartedConfig([]*v1.GPUNodeConfig{all, disabled, mixed})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// compare in JSON since the format of YAML is not significant
+	var parted migPartedConfig
+	if err := yaml.Unmarshal(data, &parted); err != nil {
+		t.Fatalf("invalid mig-parted config: %v\n%s", err, data)
+	}
+	got, _ := json.Marshal(parted)
+	want := fmt.Sprintf(`{"version":"v1","mig-configs":{`+
+		`%q:[{"devices":"all","mig-enabled":true,"mig-devices":{"7g.40gb":1}}],`+
+		`%q:[{"devices":"all","mig-enabled":false}],`+
+		`%q:[{"devices":[0],"mig-enabled":true,"mig-devices":{"1g.5gb":7}},{"devices":[1],"mig-enabled":true,"mig-devices":{"2g.10gb":2,"3g.20gb":1}},{"devices":[2,3],"mig-enabled":false}]}}`,
+		migConfigName(all), migConfigName(disabled), migConfigName(mixed))
+	if string(got) != want {
+		t.Errorf("renderMIGPartedConfig() = %s, want %s", got, want)
+	}
+}
+
+func TestMIGConfigName(t *testing.T) {
+	config := newGPUNodeConfig("gpu", v1.GPUMIGDeviceConfig{Slices: []v1.GPUMIGSlice{{Profile: "1g.5gb", Count: 7}}})
+	name := migConfigName(config)
+	if !strings.HasPrefix(name, "gpu-") || name != migConfigName(config.DeepCopy()) {
+		t.Errorf("migConfigName() = %s, want a stable name prefixed with gpu-", name)
+	}
+
+	changed := config.DeepCopy()
+	changed.Spec.MIGDevices[0].Slices[0] = v1.GPUMIGSlice{Profile: "2g.10gb", Count: 3}
+	if migConfigName(changed) == name {
+		t.Errorf("migConfigName() = %s, want a new name after the geometry changed", name)
+	}
+
+	long := newGPUNodeConfig(strings.Repeat("a", 253))
+	if got := migConfigName(long); len(got) > 63 {
+		t.Errorf("migConfigName() = %s, want at most 63 characters", got)
+	}
+}
+
+func TestMIGPhase(t *testing.T) {
+	cases := []struct {
+		name   string
+		labels map[string]string
+		want   v1.GPUNodeConfigPhase
+	}{
+		{"unlabeled", nil, v1.GPUNodeConfigPending},
+		{"other config", map[string]string{migConfigLabel: "other", migConfigStateLabel: migConfigStateSuccess}, v1.GPUNodeConfigPending},
+		{"pending", map[string]string{migConfigLabel: "gpu", migConfigStateLabel: migConfigStatePending}, v1.GPUNodeConfigApplying},
+		{"rebooting", map[string]string{migConfigLabel: "gpu", migConfigStateLabel: "rebooting"}, v1.GPUNodeConfigApplying},
+		{"success", map[string]string{migConfigLabel: "gpu", migConfigStateLabel: migConfigStateSuccess}, v1.GPUNodeConfigApplied},
+		{"failed", map[string]string{migConfigLabel: "gpu", migConfigStateLabel: migConfigStateFailed}, v1.GPUNodeConfigFailed},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node", Labels: c.labels}}
+			if got := migPhase(node, "gpu"); got != c.want {
+				t.Errorf("migPhase() = %s, want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestMIGProfiles(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceCPU:       resource.MustParse("64"),
+				"nvidia.com/gpu":         resource.MustParse("0"),
+				"nvidia.com/mig-3g.20gb": resource.MustParse("2"),
+				"nvidia.com/mig-1g.5gb":  resource.MustParse("7"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:      resource.MustParse("63"),
+				"nvidia.com/mig-1g.5gb": resource.MustParse("6"),
+			},
+		},
+	}
+	want := []v1.GPUMIGProfile{
+		{Profile: "1g.5gb", ResourceName: "nvidia.com/mig-1g.5gb", Capacity: 7, Allocatable: 6},
+		{Profile: "3g.20gb", ResourceName: "nvidia.com/mig-3g.20gb", Capacity: 2, Allocatable: 0},
+	}
+	if got := migProfiles(node); !reflect.DeepEqual(got, want) {
+		t.Errorf("migProfiles() = %+v, want %+v", got, want)
+	}
+}
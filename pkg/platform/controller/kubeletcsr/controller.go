@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeletcsr
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+// Controller approves the kubelet serving CSRs of clusters whose kubelets
+// request serving certificates from the cluster CA. The clusters are resynced
+// periodically to pick up the CSRs created by new nodes and certificate rotations.
+type Controller struct {
+	queue        workqueue.RateLimitingInterface
+	lister       platformv1lister.ClusterLister
+	listerSynced cache.InformerSynced
+
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformClient platformversionedclient.PlatformV1Interface,
+	clusterInformer platformv1informer.ClusterInformer,
+	resyncPeriod time.Duration) *Controller {
+	c := &Controller{
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "kubeletcsr"),
+
+		log:            log.WithName("KubeletCSRController"),
+		platformClient: platformClient,
+	}
+
+	if platformClient != nil && platformClient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("kubeletcsr_controller", platformClient.RESTClient().GetRateLimiter())
+	}
+
+	clusterInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.FilteringResourceEventHandler{
+			Handler: cache.ResourceEventHandlerFuncs{
+				AddFunc: c.enqueue,
+				UpdateFunc: func(old, obj interface{}) {
+					c.enqueue(obj)
+				},
+			},
+			FilterFunc: func(obj interface{}) bool {
+				cluster, ok := obj.(*platformv1.Cluster)
+				if !ok {
+					return false
+				}
+				return cluster.KubeletServerTLSBootstrapEnabled() &&
+					cluster.Status.Phase != platformv1.ClusterTerminating
+			},
+		},
+		resyncPeriod,
+	)
+
+	c.lister = clusterInformer.Lister()
+	c.listerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts workers to approve the kubelet serving CSRs until stopCh is closed.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting kubelet csr controller")
+	defer log.Info("Shutting down kubelet csr controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced); !ok {
+		return fmt.Errorf("failed to wait for cluster caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncCluster(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("error processing cluster %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncCluster(key string) error {
+	ctx := c.log.WithValues("cluster", key).WithContext(context.TODO())
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	cluster, err := c.lister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// the apiserver is not ready yet
+	if cluster.Status.Phase == platformv1.ClusterInitializing && len(cluster.Status.Addresses) == 0 {
+		return nil
+	}
+
+	clusterWrapper, err := typesv1.GetCluster(ctx, c.platformClient, cluster)
+	if err != nil {
+		return err
+	}
+	client, err := clusterWrapper.Clientset()
+	if err != nil {
+		return err
+	}
+	csrs, err := client.CertificatesV1beta1().CertificateSigningRequests().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	var nodeIPs map[string]sets.String
+	for i := range csrs.Items {
+		csr := &csrs.Items[i]
+		if csr.Spec.SignerName == nil || *csr.Spec.SignerName != kubeletServingSignerName || isFinished(csr) {
+			continue
+		}
+		if nodeIPs == nil {
+			nodeIPs, err = c.getNodeIPs(ctx, cluster)
+			if err != nil {
+				return err
+			}
+		}
+		logger := log.FromContext(ctx).WithValues("csr", csr.Name, "username", csr.Spec.Username)
+		err = validateKubeletServingCSR(csr, nodeIPs)
+		if err != nil {
+			// leave the csr pending for administrators to check
+			logger.Info("Skip approving kubelet serving csr", "reason", err.Error())
+			continue
+		}
+
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1beta1.CertificateSigningRequestCondition{
+			Type:           certificatesv1beta1.CertificateApproved,
+			Reason:         "AutoApproved",
+			Message:        "Auto approving kubelet serving certificate after validating the node addresses against machines.",
+			LastUpdateTime: metav1.Now(),
+		})
+		_, err = client.CertificatesV1beta1().CertificateSigningRequests().UpdateApproval(ctx, csr, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("approve csr %s error: %w", csr.Name, err)
+		}
+		logger.Info("Kubelet serving csr approved")
+	}
+
+	return nil
+}
+
+// getNodeIPs returns the IPs of masters and machines in cluster indexed by the
+// node name, which is the IP of machine.
+func (c *Controller) getNodeIPs(ctx context.Context, cluster *platformv1.Cluster) (map[string]sets.String, error) {
+	nodeIPs := map[string]sets.String{}
+	for _, machine := range cluster.Spec.Machines {
+		nodeIPs[machine.IP] = sets.NewString(machine.IP)
+	}
+
+	machines, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, cluster.Name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, machine := range machines.Items {
+		ips := sets.NewString(machine.Spec.IP)
+		for _, address := range machine.Status.Addresses {
+			if address.Type == platformv1.MachineInternalIP || address.Type == platformv1.MachineExternalIP {
+				ips.Insert(address.Address)
+			}
+		}
+		nodeIPs[machine.Spec.IP] = ips
+	}
+
+	return nodeIPs, nil
+}
+
+func isFinished(csr *certificatesv1beta1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		if condition.Type == certificatesv1beta1.CertificateApproved ||
+			condition.Type == certificatesv1beta1.CertificateDenied {
+			return true
+		}
+	}
+	return false
+}
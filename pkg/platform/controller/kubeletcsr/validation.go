@@ -0,0 +1,99 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeletcsr
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	kubeletServingSignerName = "kubernetes.io/kubelet-serving"
+	nodeUserNamePrefix       = "system:node:"
+	nodesGroup               = "system:nodes"
+)
+
+var kubeletServingUsages = sets.NewString(
+	string(certificatesv1beta1.UsageDigitalSignature),
+	string(certificatesv1beta1.UsageKeyEncipherment),
+	string(certificatesv1beta1.UsageServerAuth),
+)
+
+// validateKubeletServingCSR checks the csr is requested by the node itself and
+// the subject alternative names are the addresses of the machine of node.
+func validateKubeletServingCSR(csr *certificatesv1beta1.CertificateSigningRequest, nodeIPs map[string]sets.String) error {
+	if !strings.HasPrefix(csr.Spec.Username, nodeUserNamePrefix) {
+		return fmt.Errorf("username %q is not a node", csr.Spec.Username)
+	}
+	if !sets.NewString(csr.Spec.Groups...).Has(nodesGroup) {
+		return fmt.Errorf("groups %v do not contain %s", csr.Spec.Groups, nodesGroup)
+	}
+	nodeName := strings.TrimPrefix(csr.Spec.Username, nodeUserNamePrefix)
+	ips, ok := nodeIPs[nodeName]
+	if !ok {
+		return fmt.Errorf("node %s is not a machine of cluster", nodeName)
+	}
+
+	usages := sets.NewString()
+	for _, one := range csr.Spec.Usages {
+		usages.Insert(string(one))
+	}
+	if !usages.Has(string(certificatesv1beta1.UsageServerAuth)) || !kubeletServingUsages.IsSuperset(usages) {
+		return fmt.Errorf("usages %v are not for kubelet serving", usages.List())
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return errors.New("request is not a PEM encoded certificate request")
+	}
+	req, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return err
+	}
+	if req.Subject.CommonName != csr.Spec.Username {
+		return fmt.Errorf("common name %q is not the username", req.Subject.CommonName)
+	}
+	if len(req.Subject.Organization) != 1 || req.Subject.Organization[0] != nodesGroup {
+		return fmt.Errorf("organization %v is not %s", req.Subject.Organization, nodesGroup)
+	}
+	if len(req.EmailAddresses) != 0 || len(req.URIs) != 0 {
+		return errors.New("email and uri subject alternative names are not allowed")
+	}
+	if len(req.DNSNames)+len(req.IPAddresses) == 0 {
+		return errors.New("no subject alternative name")
+	}
+	for _, one := range req.DNSNames {
+		if one != nodeName {
+			return fmt.Errorf("dns name %s is not the node name", one)
+		}
+	}
+	for _, one := range req.IPAddresses {
+		if !ips.Has(one.String()) {
+			return fmt.Errorf("ip %s is not an address of machine %s", one, nodeName)
+		}
+	}
+
+	return nil
+}
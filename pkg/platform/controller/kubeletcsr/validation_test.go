@@ -0,0 +1,101 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubeletcsr
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"testing"
+
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func newCSR(t *testing.T, username string, dnsNames []string, ips []string) *certificatesv1beta1.CertificateSigningRequest {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: username, Organization: []string{nodesGroup}},
+		DNSNames: dnsNames,
+	}
+	for _, one := range ips {
+		template.IPAddresses = append(template.IPAddresses, net.ParseIP(one))
+	}
+	der, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signerName := kubeletServingSignerName
+	return &certificatesv1beta1.CertificateSigningRequest{
+		Spec: certificatesv1beta1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+			SignerName: &signerName,
+			Username:   username,
+			Groups:     []string{nodesGroup, "system:authenticated"},
+			Usages: []certificatesv1beta1.KeyUsage{
+				certificatesv1beta1.UsageDigitalSignature,
+				certificatesv1beta1.UsageKeyEncipherment,
+				certificatesv1beta1.UsageServerAuth,
+			},
+		},
+	}
+}
+
+func TestValidateKubeletServingCSR(t *testing.T) {
+	nodeIPs := map[string]sets.String{
+		"10.0.0.1": sets.NewString("10.0.0.1"),
+		"10.0.0.2": sets.NewString("10.0.0.2", "192.168.0.2"),
+	}
+	tests := []struct {
+		name    string
+		csr     *certificatesv1beta1.CertificateSigningRequest
+		wantErr bool
+	}{
+		{"valid", newCSR(t, "system:node:10.0.0.2", nil, []string{"10.0.0.2", "192.168.0.2"}), false},
+		{"valid with node name", newCSR(t, "system:node:10.0.0.1", []string{"10.0.0.1"}, []string{"10.0.0.1"}), false},
+		{"unknown node", newCSR(t, "system:node:10.0.0.3", nil, []string{"10.0.0.3"}), true},
+		{"ip of other machine", newCSR(t, "system:node:10.0.0.1", nil, []string{"10.0.0.2"}), true},
+		{"unknown dns name", newCSR(t, "system:node:10.0.0.1", []string{"evil.example.com"}, []string{"10.0.0.1"}), true},
+		{"no san", newCSR(t, "system:node:10.0.0.1", nil, nil), true},
+		{"not a node", newCSR(t, "admin", nil, []string{"10.0.0.1"}), true},
+	}
+	clientAuth := newCSR(t, "system:node:10.0.0.1", nil, []string{"10.0.0.1"})
+	clientAuth.Spec.Usages = append(clientAuth.Spec.Usages, certificatesv1beta1.UsageClientAuth)
+	tests = append(tests, struct {
+		name    string
+		csr     *certificatesv1beta1.CertificateSigningRequest
+		wantErr bool
+	}{"client auth usage", clientAuth, true})
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKubeletServingCSR(tt.csr, nodeIPs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateKubeletServingCSR() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
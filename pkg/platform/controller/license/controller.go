@@ -0,0 +1,336 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	"context"
+	"crypto/ed25519"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	controllerutil "tkestack.io/tke/pkg/controller"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/metrics"
+)
+
+const (
+	// warningInterval is the minimum interval between two warnings of the
+	// same license.
+	warningInterval = 24 * time.Hour
+)
+
+// Controller verifies the signatures of licenses and evaluates the usage of the
+// installation against the entitlements recorded by them, and warns the
+// receivers through notify when the usage is approaching or exceeding the limits.
+type Controller struct {
+	queue               workqueue.RateLimitingInterface
+	lister              platformv1lister.LicenseLister
+	listerSynced        cache.InformerSynced
+	clusterLister       platformv1lister.ClusterLister
+	clusterListerSynced cache.InformerSynced
+
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	publicKey      ed25519.PublicKey
+}
+
+// installationUsage is the usage of the installation which is limited by licenses.
+type installationUsage struct {
+	clusters int32
+	nodes    int32
+	// features are the addon types installed in the clusters.
+	features []string
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformClient platformversionedclient.PlatformV1Interface,
+	licenseInformer platformv1informer.LicenseInformer,
+	clusterInformer platformv1informer.ClusterInformer,
+	resyncPeriod time.Duration,
+	publicKey ed25519.PublicKey) *Controller {
+	c := &Controller{
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "license"),
+
+		log:            log.WithName("LicenseController"),
+		platformClient: platformClient,
+		publicKey:      publicKey,
+	}
+
+	if platformClient != nil && platformClient.RESTClient().GetRateLimiter() != nil {
+		_ = metrics.RegisterMetricAndTrackRateLimiterUsage("license_controller", platformClient.RESTClient().GetRateLimiter())
+	}
+
+	licenseInformer.Informer().AddEventHandlerWithResyncPeriod(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: c.enqueue,
+			UpdateFunc: func(old, obj interface{}) {
+				oldLicense, ok1 := old.(*platformv1.License)
+				license, ok2 := obj.(*platformv1.License)
+				// skip the status updates made by ourselves, the usage is
+				// refreshed by the periodic resync
+				if ok1 && ok2 && oldLicense.ResourceVersion != license.ResourceVersion &&
+					reflect.DeepEqual(oldLicense.Spec, license.Spec) {
+					return
+				}
+				c.enqueue(obj)
+			},
+		},
+		resyncPeriod,
+	)
+
+	c.lister = licenseInformer.Lister()
+	c.listerSynced = licenseInformer.Informer().HasSynced
+	c.clusterLister = clusterInformer.Lister()
+	c.clusterListerSynced = clusterInformer.Informer().HasSynced
+
+	return c
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := controllerutil.KeyFunc(obj)
+	if err != nil {
+		utilruntime.HandleError(fmt.Errorf("couldn't get key for object %+v: %v", obj, err))
+		return
+	}
+	c.queue.Add(key)
+}
+
+// Run starts workers to evaluate the licenses until stopCh is closed.
+func (c *Controller) Run(workers int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer c.queue.ShutDown()
+
+	log.Info("Starting license controller")
+	defer log.Info("Shutting down license controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.listerSynced, c.clusterListerSynced); !ok {
+		return fmt.Errorf("failed to wait for license caches to sync")
+	}
+
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.worker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) worker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *Controller) processNextWorkItem() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.syncLicense(key.(string))
+	if err == nil {
+		c.queue.Forget(key)
+		return true
+	}
+
+	utilruntime.HandleError(fmt.Errorf("error processing license %v (will retry): %v", key, err))
+	c.queue.AddRateLimited(key)
+	return true
+}
+
+func (c *Controller) syncLicense(key string) error {
+	ctx := c.log.WithValues("license", key).WithContext(context.TODO())
+
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+	license, err := c.lister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	u, err := c.usage(ctx)
+	if err != nil {
+		return err
+	}
+
+	license = license.DeepCopy()
+	now := time.Now()
+	license.Status.Clusters = u.clusters
+	license.Status.Nodes = u.nodes
+	if err := verify(&license.Spec, c.publicKey); err != nil {
+		license.Status.Phase, license.Status.Message = platformv1.LicenseInvalid, err.Error()
+	} else {
+		license.Status.Phase, license.Status.Message = evaluate(&license.Spec, u, now)
+	}
+	license.Status.LastUpdateTime = metav1.NewTime(now)
+
+	if license.Status.Phase != platformv1.LicenseValid && license.Spec.Notify != nil &&
+		now.Sub(license.Status.LastWarningTime.Time) >= warningInterval {
+		if err := c.warn(ctx, license); err != nil {
+			log.FromContext(ctx).Error(err, "Send license warning failed")
+		} else {
+			license.Status.LastWarningTime = metav1.NewTime(now)
+		}
+	}
+
+	_, err = c.platformClient.Licenses().UpdateStatus(ctx, license, metav1.UpdateOptions{})
+	return err
+}
+
+// usage counts the clusters, the nodes and the addon types of all clusters
+// managed by the platform. Clusters that can not be reached are counted with
+// the master machines recorded in their spec.
+func (c *Controller) usage(ctx context.Context) (installationUsage, error) {
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		return installationUsage{}, err
+	}
+
+	var u installationUsage
+	features := sets.NewString()
+	for _, cluster := range clusters {
+		if cluster.Status.Phase == platformv1.ClusterTerminating {
+			continue
+		}
+		u.clusters++
+		n, err := c.countNodes(ctx, cluster)
+		if err != nil {
+			log.FromContext(ctx).Error(err, "Count nodes failed", "cluster", cluster.Name)
+			n = int32(len(cluster.Spec.Machines))
+		}
+		u.nodes += n
+
+		addons := &platformv1.ClusterAddonList{}
+		err = c.platformClient.RESTClient().Get().
+			Resource("clusters").
+			Name(cluster.Name).
+			SubResource("addons").
+			Do(ctx).
+			Into(addons)
+		if err != nil {
+			return installationUsage{}, err
+		}
+		for _, addon := range addons.Items {
+			features.Insert(addon.Spec.Type)
+		}
+	}
+	u.features = features.List()
+
+	return u, nil
+}
+
+func (c *Controller) countNodes(ctx context.Context, cluster *platformv1.Cluster) (int32, error) {
+	clusterWrapper, err := typesv1.GetCluster(ctx, c.platformClient, cluster)
+	if err != nil {
+		return 0, err
+	}
+	client, err := clusterWrapper.Clientset()
+	if err != nil {
+		return 0, err
+	}
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return 0, err
+	}
+	if nodes.RemainingItemCount != nil {
+		return int32(len(nodes.Items)) + int32(*nodes.RemainingItemCount), nil
+	}
+	if nodes.Continue == "" {
+		return int32(len(nodes.Items)), nil
+	}
+	// the remaining count is not supported, fall back to the full list
+	nodes, err = client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return 0, err
+	}
+	return int32(len(nodes.Items)), nil
+}
+
+// evaluate returns the phase of the license and a human readable message of
+// the usage against the entitlements.
+func evaluate(spec *platformv1.LicenseSpec, u installationUsage, now time.Time) (platformv1.LicensePhase, string) {
+	if !spec.NotBefore.IsZero() && now.Before(spec.NotBefore.Time) {
+		return platformv1.LicenseNotYetValid, fmt.Sprintf("license is not valid before %s", spec.NotBefore.Format(time.RFC3339))
+	}
+	if !spec.NotAfter.IsZero() && now.After(spec.NotAfter.Time) {
+		return platformv1.LicenseExpired, fmt.Sprintf("license expired at %s", spec.NotAfter.Format(time.RFC3339))
+	}
+
+	summary := fmt.Sprintf("nodes %s, clusters %s", usageString(u.nodes, spec.MaxNodes), usageString(u.clusters, spec.MaxClusters))
+	if unentitled := unentitledFeatures(spec.Features, u.features); len(unentitled) > 0 {
+		return platformv1.LicenseExceeded, fmt.Sprintf("features not entitled: %s: %s", strings.Join(unentitled, ","), summary)
+	}
+	if exceeded(u.nodes, spec.MaxNodes) || exceeded(u.clusters, spec.MaxClusters) {
+		return platformv1.LicenseExceeded, "entitlement exceeded: " + summary
+	}
+	if approaching(u.nodes, spec.MaxNodes, spec.WarningPercent) || approaching(u.clusters, spec.MaxClusters, spec.WarningPercent) {
+		return platformv1.LicenseWarning, "approaching entitlement: " + summary
+	}
+	if !spec.NotAfter.IsZero() && spec.NotAfter.Sub(now) < 30*24*time.Hour {
+		return platformv1.LicenseWarning, fmt.Sprintf("license expires at %s: %s", spec.NotAfter.Format(time.RFC3339), summary)
+	}
+	return platformv1.LicenseValid, summary
+}
+
+// unentitledFeatures returns the used features which are not entitled, empty
+// entitled features means all features.
+func unentitledFeatures(entitled, used []string) []string {
+	if len(entitled) == 0 {
+		return nil
+	}
+	return sets.NewString(used...).Difference(sets.NewString(entitled...)).List()
+}
+
+// exceeded returns whether the used amount exceeds the limit, a limit of 0
+// means unlimited.
+func exceeded(used, limit int32) bool {
+	return limit > 0 && used > limit
+}
+
+func approaching(used, limit, percent int32) bool {
+	return limit > 0 && int64(used)*100 >= int64(limit)*int64(percent)
+}
+
+func usageString(used, limit int32) string {
+	if limit == 0 {
+		return fmt.Sprintf("%d/unlimited", used)
+	}
+	return fmt.Sprintf("%d/%d", used, limit)
+}
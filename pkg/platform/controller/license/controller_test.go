@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestEvaluate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name     string
+		spec     platformv1.LicenseSpec
+		clusters int32
+		nodes    int32
+		features []string
+		want     platformv1.LicensePhase
+	}{
+		{
+			name:  "unlimited",
+			spec:  platformv1.LicenseSpec{WarningPercent: 90},
+			nodes: 1000,
+			want:  platformv1.LicenseValid,
+		},
+		{
+			name:  "below warning",
+			spec:  platformv1.LicenseSpec{MaxNodes: 100, WarningPercent: 90},
+			nodes: 89,
+			want:  platformv1.LicenseValid,
+		},
+		{
+			name:  "approaching nodes",
+			spec:  platformv1.LicenseSpec{MaxNodes: 100, WarningPercent: 90},
+			nodes: 90,
+			want:  platformv1.LicenseWarning,
+		},
+		{
+			name:     "exceeded clusters",
+			spec:     platformv1.LicenseSpec{MaxNodes: 100, MaxClusters: 2, WarningPercent: 90},
+			clusters: 3,
+			nodes:    10,
+			want:     platformv1.LicenseExceeded,
+		},
+		{
+			name: "expired",
+			spec: platformv1.LicenseSpec{WarningPercent: 90, NotAfter: metav1.NewTime(now.Add(-time.Hour))},
+			want: platformv1.LicenseExpired,
+		},
+		{
+			name: "not valid yet",
+			spec: platformv1.LicenseSpec{WarningPercent: 90, NotBefore: metav1.NewTime(now.Add(time.Hour))},
+			want: platformv1.LicenseNotYetValid,
+		},
+		{
+			name:     "all features entitled",
+			spec:     platformv1.LicenseSpec{WarningPercent: 90},
+			features: []string{"TappController", "Prometheus"},
+			want:     platformv1.LicenseValid,
+		},
+		{
+			name:     "entitled features",
+			spec:     platformv1.LicenseSpec{WarningPercent: 90, Features: []string{"TappController", "Prometheus"}},
+			features: []string{"TappController"},
+			want:     platformv1.LicenseValid,
+		},
+		{
+			name:     "features not entitled",
+			spec:     platformv1.LicenseSpec{WarningPercent: 90, Features: []string{"TappController"}},
+			features: []string{"TappController", "Prometheus"},
+			want:     platformv1.LicenseExceeded,
+		},
+		{
+			name: "expiring soon",
+			spec: platformv1.LicenseSpec{WarningPercent: 90, NotAfter: metav1.NewTime(now.Add(24 * time.Hour))},
+			want: platformv1.LicenseWarning,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := installationUsage{clusters: tt.clusters, nodes: tt.nodes, features: tt.features}
+			got, msg := evaluate(&tt.spec, u, now)
+			if got != tt.want {
+				t.Errorf("evaluate() = %v (%s), want %v", got, msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	notifyapi "tkestack.io/tke/cmd/tke-notify-api/app"
+)
+
+const (
+	licenseAlertName       = "LicenseEntitlement"
+	licenseAlarmPolicyType = "license"
+)
+
+// alert and notification mirror the alertmanager webhook payload accepted by
+// the notify api.
+type alert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+}
+
+type notification struct {
+	Status string  `json:"status"`
+	Alerts []alert `json:"alerts"`
+}
+
+var notifyHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	},
+}
+
+// warn sends the license status to the receivers of the license through
+// the webhook of notify api.
+func (c *Controller) warn(ctx context.Context, license *platformv1.License) error {
+	cm, err := c.platformClient.ConfigMaps().Get(ctx, notifyapi.NotifyApiConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	address, ok := cm.Annotations[notifyapi.NotifyAPIAddressKey]
+	if !ok || address == "" {
+		return fmt.Errorf("notify api address not found in configmap %s", notifyapi.NotifyApiConfigMapName)
+	}
+
+	body, err := json.Marshal(newNotification(license))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address+"/webhook", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := notifyHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		data, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("notify api returned %d: %s", resp.StatusCode, string(data))
+	}
+	return nil
+}
+
+func newNotification(license *platformv1.License) *notification {
+	notify := license.Spec.Notify
+	return &notification{
+		Status: "firing",
+		Alerts: []alert{{
+			Labels: map[string]string{
+				"alertname":       licenseAlertName,
+				"alarmPolicyName": license.Name,
+			},
+			Annotations: map[string]string{
+				"notifyWay":         notify.Channel + ":" + notify.Template,
+				"receivers":         strings.Join(notify.Receivers, ","),
+				"receiverGroups":    strings.Join(notify.ReceiverGroups, ","),
+				"alarmPolicyType":   licenseAlarmPolicyType,
+				"metricDisplayName": license.Status.Message,
+				"value":             fmt.Sprintf("%d", license.Status.Nodes),
+				"unit":              " nodes",
+				"evaluateType":      "limit",
+				"evaluateValue":     fmt.Sprintf("%d", license.Spec.MaxNodes),
+			},
+			StartsAt: time.Now(),
+		}},
+	}
+}
@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// entitlements are the fields of license spec signed by the issuer, the
+// warning percent and notify are left to the operators.
+type entitlements struct {
+	Licensee    string      `json:"licensee"`
+	MaxNodes    int32       `json:"maxNodes"`
+	MaxClusters int32       `json:"maxClusters"`
+	Features    []string    `json:"features"`
+	NotBefore   metav1.Time `json:"notBefore"`
+	NotAfter    metav1.Time `json:"notAfter"`
+}
+
+// SignedContent returns the content of license spec which is signed by the
+// issuer.
+func SignedContent(spec *platformv1.LicenseSpec) ([]byte, error) {
+	return json.Marshal(entitlements{
+		Licensee:    spec.Licensee,
+		MaxNodes:    spec.MaxNodes,
+		MaxClusters: spec.MaxClusters,
+		Features:    spec.Features,
+		NotBefore:   spec.NotBefore,
+		NotAfter:    spec.NotAfter,
+	})
+}
+
+// LoadPublicKey reads the PEM encoded Ed25519 public key of the issuer.
+func LoadPublicKey(filename string) (ed25519.PublicKey, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", filename)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("public key in %s is %T, not Ed25519", filename, key)
+	}
+	return publicKey, nil
+}
+
+// verify returns an error if the signature of license is missing or doesn't
+// match the entitlements.
+func verify(spec *platformv1.LicenseSpec, publicKey ed25519.PublicKey) error {
+	if len(spec.Signature) == 0 {
+		return errors.New("license is not signed")
+	}
+	content, err := SignedContent(spec)
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(publicKey, content, spec.Signature) {
+		return errors.New("license signature is invalid")
+	}
+	return nil
+}
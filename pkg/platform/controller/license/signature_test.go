@@ -0,0 +1,120 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestVerify(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec := platformv1.LicenseSpec{
+		Licensee:       "tkestack",
+		MaxNodes:       100,
+		Features:       []string{"TappController"},
+		NotAfter:       metav1.NewTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)),
+		WarningPercent: 90,
+	}
+	content, err := SignedContent(&spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.Signature = ed25519.Sign(privateKey, content)
+
+	if err := verify(&spec, publicKey); err != nil {
+		t.Errorf("verify() error = %v", err)
+	}
+
+	// the fields not signed can be changed by the operators
+	changed := *spec.DeepCopy()
+	changed.WarningPercent = 80
+	if err := verify(&changed, publicKey); err != nil {
+		t.Errorf("verify() with changed warning percent error = %v", err)
+	}
+
+	tampered := *spec.DeepCopy()
+	tampered.MaxNodes = 1000
+	if err := verify(&tampered, publicKey); err == nil {
+		t.Error("verify() with tampered max nodes succeeded")
+	}
+
+	unsigned := *spec.DeepCopy()
+	unsigned.Signature = nil
+	if err := verify(&unsigned, publicKey); err == nil {
+		t.Error("verify() without signature succeeded")
+	}
+
+	otherKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := verify(&spec, otherKey); err == nil {
+		t.Error("verify() with other public key succeeded")
+	}
+}
+
+func TestLoadPublicKey(t *testing.T) {
+	publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "license")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "public.pem")
+	if err := ioutil.WriteFile(filename, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := LoadPublicKey(filename)
+	if err != nil {
+		t.Fatalf("LoadPublicKey() error = %v", err)
+	}
+	if !bytes.Equal(got, publicKey) {
+		t.Errorf("LoadPublicKey() = %x, want %x", got, publicKey)
+	}
+
+	if err := ioutil.WriteFile(filename, []byte("not a key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadPublicKey(filename); err == nil {
+		t.Error("LoadPublicKey() with invalid file succeeded")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -38,6 +39,8 @@ import (
 	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/controller/machine/deletion"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/drift"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/encryption"
 	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
 	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/platform/util"
@@ -281,6 +284,8 @@ func (c *Controller) onUpdate(ctx context.Context, machine *platformv1.Machine)
 
 	err = provider.OnUpdate(ctx, machine, cluster)
 	machine = c.checkHealth(ctx, machine)
+	machine = c.checkNetworkEncryption(ctx, machine, cluster)
+	machine = c.checkConfigDrift(ctx, machine)
 	if err != nil {
 		// Update status, ignore failure
 		_, _ = c.platformClient.Machines().UpdateStatus(ctx, machine, metav1.UpdateOptions{})
@@ -332,6 +337,87 @@ func (c *Controller) checkHealth(ctx context.Context, machine *platformv1.Machin
 	return machine
 }
 
+func (c *Controller) checkNetworkEncryption(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) *platformv1.Machine {
+	if cluster.Spec.Features.NetworkEncryption == nil || machine.Status.Phase != platformv1.MachineRunning {
+		return machine
+	}
+
+	condition := platformv1.MachineCondition{
+		Type:   encryption.ConditionTypeNetworkEncryption,
+		Status: platformv1.ConditionFalse,
+	}
+
+	client, err := cluster.Clientset()
+	if err != nil {
+		condition.Status = platformv1.ConditionUnknown
+		condition.Message = err.Error()
+	} else {
+		encrypted, reason, message, err := encryption.NodeStatus(ctx, client, cluster.Spec.Features.NetworkEncryption, machine.Spec.IP)
+		if err != nil {
+			condition.Status = platformv1.ConditionUnknown
+			condition.Message = err.Error()
+		} else if encrypted {
+			condition.Status = platformv1.ConditionTrue
+		} else {
+			condition.Reason = reason
+			condition.Message = message
+		}
+	}
+
+	machine.SetCondition(condition)
+
+	return machine
+}
+
+// checkConfigDrift detects the changes of files managed by provider on node
+// periodically, and restores them if requested by annotation.
+func (c *Controller) checkConfigDrift(ctx context.Context, machine *platformv1.Machine) *platformv1.Machine {
+	if machine.Status.Phase != platformv1.MachineRunning {
+		return machine
+	}
+	remediate := machine.Annotations[drift.AnnotationRemediate] == "true"
+	if current := machine.GetCondition(drift.ConditionTypeConfigDrift); !remediate && current != nil &&
+		time.Since(current.LastProbeTime.Time) < drift.CheckInterval {
+		return machine
+	}
+
+	condition := platformv1.MachineCondition{
+		Type:   drift.ConditionTypeConfigDrift,
+		Status: platformv1.ConditionFalse,
+	}
+
+	var drifted []string
+	s, err := machine.Spec.SSH()
+	if err == nil {
+		drifted, err = drift.Check(s)
+	}
+	if err == nil && remediate {
+		if len(drifted) > 0 {
+			log.FromContext(ctx).Info("Remediate config drift", "files", drifted)
+			err = drift.Remediate(s, drifted)
+			if err == nil {
+				drifted, err = drift.Check(s)
+			}
+		}
+		if err == nil {
+			delete(machine.Annotations, drift.AnnotationRemediate)
+		}
+	}
+	switch {
+	case err != nil:
+		condition.Status = platformv1.ConditionUnknown
+		condition.Message = err.Error()
+	case len(drifted) > 0:
+		condition.Status = platformv1.ConditionTrue
+		condition.Reason = drift.ReasonDrifted
+		condition.Message = fmt.Sprintf("files changed: %s", strings.Join(drifted, ","))
+	}
+
+	machine.SetCondition(condition)
+
+	return machine
+}
+
 func (c *Controller) ensureSyncMachineNodeLabel(ctx context.Context, machine *platformv1.Machine) {
 
 	cluster, err := typesv1.GetClusterByName(ctx, c.platformClient, machine.Spec.ClusterName)
@@ -0,0 +1,33 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package config
+
+import "time"
+
+// TelemetryControllerConfiguration contains elements describing TelemetryController.
+type TelemetryControllerConfiguration struct {
+	// Endpoint is the url which the anonymized reports are posted to, no
+	// report leaves the installation if it is empty.
+	Endpoint string
+	// ReportDir is the local directory which the reports are written to,
+	// which is useful for support cases without reporting anything.
+	ReportDir string
+	// ReportPeriod is the period for generating the reports.
+	ReportPeriod time.Duration
+}
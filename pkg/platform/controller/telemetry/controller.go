@@ -0,0 +1,199 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	platformv1informer "tkestack.io/tke/api/client/informers/externalversions/platform/v1"
+	platformv1lister "tkestack.io/tke/api/client/listers/platform/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/telemetry/config"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// configMapName is the name of the platform configmap which persists the
+	// installation id.
+	configMapName     = "tke-telemetry"
+	installationIDKey = "installationID"
+
+	// latestReportFile is the file name of the latest local report.
+	latestReportFile = "latest.json"
+)
+
+// Controller periodically aggregates the anonymized usage stats of the
+// installation, and posts them to the telemetry endpoint or writes them to
+// the local report directory. It is disabled by default and only runs if
+// the administrator opts in.
+type Controller struct {
+	clusterLister       platformv1lister.ClusterLister
+	clusterListerSynced cache.InformerSynced
+
+	log            log.Logger
+	platformClient platformversionedclient.PlatformV1Interface
+	config         config.TelemetryControllerConfiguration
+	httpClient     *http.Client
+}
+
+// NewController creates a new Controller object.
+func NewController(
+	platformClient platformversionedclient.PlatformV1Interface,
+	clusterInformer platformv1informer.ClusterInformer,
+	cfg config.TelemetryControllerConfiguration) *Controller {
+	return &Controller{
+		clusterLister:       clusterInformer.Lister(),
+		clusterListerSynced: clusterInformer.Informer().HasSynced,
+
+		log:            log.WithName("TelemetryController"),
+		platformClient: platformClient,
+		config:         cfg,
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Run generates the reports until stopCh is closed.
+func (c *Controller) Run(stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+
+	log.Info("Starting telemetry controller")
+	defer log.Info("Shutting down telemetry controller")
+
+	if ok := cache.WaitForCacheSync(stopCh, c.clusterListerSynced); !ok {
+		return fmt.Errorf("failed to wait for cluster caches to sync")
+	}
+
+	go wait.Until(func() {
+		if err := c.report(); err != nil {
+			c.log.Error(err, "Generate telemetry report failed")
+		}
+	}, c.config.ReportPeriod, stopCh)
+
+	<-stopCh
+	return nil
+}
+
+func (c *Controller) report() error {
+	ctx := c.log.WithContext(context.TODO())
+
+	installationID, err := c.installationID(ctx)
+	if err != nil {
+		return err
+	}
+	report, err := c.generate(ctx, installationID)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if c.config.ReportDir != "" {
+		if err := c.writeLocal(report, data); err != nil {
+			return err
+		}
+	}
+	if c.config.Endpoint != "" {
+		if err := c.post(ctx, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// installationID returns the random id of the installation, and generates
+// one on first use.
+func (c *Controller) installationID(ctx context.Context) (string, error) {
+	cm, err := c.platformClient.ConfigMaps().Get(ctx, configMapName, metav1.GetOptions{})
+	if err == nil {
+		if id := cm.Data[installationIDKey]; id != "" {
+			return id, nil
+		}
+		cm = cm.DeepCopy()
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[installationIDKey] = string(uuid.NewUUID())
+		cm, err = c.platformClient.ConfigMaps().Update(ctx, cm, metav1.UpdateOptions{})
+		if err != nil {
+			return "", err
+		}
+		return cm.Data[installationIDKey], nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return "", err
+	}
+
+	cm = &platformv1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: configMapName},
+		Data:       map[string]string{installationIDKey: string(uuid.NewUUID())},
+	}
+	cm, err = c.platformClient.ConfigMaps().Create(ctx, cm, metav1.CreateOptions{})
+	if err != nil {
+		return "", err
+	}
+	return cm.Data[installationIDKey], nil
+}
+
+// writeLocal writes the report with its generation time in the file name, and
+// also as the latest report for support cases to pick up.
+func (c *Controller) writeLocal(report *Report, data []byte) error {
+	if err := os.MkdirAll(c.config.ReportDir, 0755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("report-%s.json", report.GeneratedAt.Format("20060102150405"))
+	if err := ioutil.WriteFile(filepath.Join(c.config.ReportDir, name), data, 0644); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(c.config.ReportDir, latestReportFile), data, 0644)
+}
+
+func (c *Controller) post(ctx context.Context, data []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.config.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("telemetry endpoint returned %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
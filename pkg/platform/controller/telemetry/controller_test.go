@@ -0,0 +1,207 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	informers "tkestack.io/tke/api/client/informers/externalversions"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/controller/telemetry/config"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+)
+
+func newCluster(name, clusterType, version string, phase platformv1.ClusterPhase, masters ...string) *platformv1.Cluster {
+	cluster := &platformv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       platformv1.ClusterSpec{Type: clusterType, Version: version},
+		Status:     platformv1.ClusterStatus{Phase: phase},
+	}
+	for _, ip := range masters {
+		cluster.Spec.Machines = append(cluster.Spec.Machines, platformv1.ClusterMachine{IP: ip})
+	}
+	return cluster
+}
+
+func newTestController(t *testing.T, cfg config.TelemetryControllerConfiguration, clusters []*platformv1.Cluster, objects ...runtime.Object) (*Controller, *fake.Clientset) {
+	client := fake.NewSimpleClientset(objects...)
+	clusterInformer := informers.NewSharedInformerFactory(client, 0).Platform().V1().Clusters()
+	for _, cluster := range clusters {
+		if err := clusterInformer.Informer().GetIndexer().Add(cluster); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return NewController(client.PlatformV1(), clusterInformer, cfg), client
+}
+
+func TestGenerate(t *testing.T) {
+	clusters := []*platformv1.Cluster{
+		newCluster("cls-secret", "Baremetal", "1.20.4", platformv1.ClusterRunning, "10.0.0.1", "10.0.0.2", "10.0.0.3"),
+		newCluster("global", "Baremetal", "1.20.4", platformv1.ClusterRunning, "10.0.1.1"),
+		newCluster("cls-imported", "Imported", "1.19.7", platformv1.ClusterFailed),
+		newCluster("cls-deleting", "Baremetal", "1.18.3", platformv1.ClusterTerminating, "10.0.2.1"),
+	}
+	c, _ := newTestController(t, config.TelemetryControllerConfiguration{}, clusters,
+		&platformv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "mc-1"}, Spec: platformv1.MachineSpec{IP: "10.0.0.4"}},
+		&platformv1.Machine{ObjectMeta: metav1.ObjectMeta{Name: "mc-2"}, Spec: platformv1.MachineSpec{IP: "10.0.0.5"}},
+	)
+
+	report, err := c.generate(context.Background(), "id-1")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	want := &Report{
+		InstallationID:     "id-1",
+		Version:            report.Version,
+		GeneratedAt:        report.GeneratedAt,
+		Clusters:           3,
+		ClusterTypes:       map[string]int{"Baremetal": 2, "Imported": 1},
+		ClusterPhases:      map[string]int{"Running": 2, "Failed": 1},
+		KubernetesVersions: map[string]int{"1.20.4": 2, "1.19.7": 1},
+		MasterMachines:     4,
+		Machines:           2,
+		Addons:             map[string]int{},
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("generate() = %+v, want %+v", report, want)
+	}
+
+	// nothing identifies the clusters of the users
+	data, err := json.Marshal(report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, identity := range []string{"cls-secret", "global", "10.0."} {
+		if strings.Contains(string(data), identity) {
+			t.Errorf("report %s contains %s", data, identity)
+		}
+	}
+}
+
+func TestGenerateAddons(t *testing.T) {
+	clusters := []*platformv1.Cluster{newCluster("cls-a", "Baremetal", "1.20.4", platformv1.ClusterRunning)}
+	c, _ := newTestController(t, config.TelemetryControllerConfiguration{}, clusters,
+		&platformv1.CronHPA{ObjectMeta: metav1.ObjectMeta{Name: "hpa-1"}, Spec: platformv1.CronHPASpec{ClusterName: "cls-a"}},
+		&platformv1.CronHPA{ObjectMeta: metav1.ObjectMeta{Name: "hpa-2"}, Spec: platformv1.CronHPASpec{ClusterName: "cls-a"}},
+		&platformv1.TappController{ObjectMeta: metav1.ObjectMeta{Name: "tapp-1"}, Spec: platformv1.TappControllerSpec{ClusterName: "cls-a"}},
+	)
+
+	report, err := c.generate(context.Background(), "id-1")
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	// the addon is counted once per cluster
+	want := map[string]int{compatibility.CronHPA: 1, compatibility.TappController: 1}
+	if !reflect.DeepEqual(report.Addons, want) {
+		t.Errorf("generate() addons = %v, want %v", report.Addons, want)
+	}
+}
+
+func TestInstallationID(t *testing.T) {
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		want    string
+	}{
+		{"generated", nil, ""},
+		{"generated for empty configmap", []runtime.Object{&platformv1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: configMapName}}}, ""},
+		{"persisted", []runtime.Object{&platformv1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: configMapName},
+			Data:       map[string]string{installationIDKey: "id-1"},
+		}}, "id-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, client := newTestController(t, config.TelemetryControllerConfiguration{}, nil, tt.objects...)
+			id, err := c.installationID(context.Background())
+			if err != nil {
+				t.Fatalf("installationID() error = %v", err)
+			}
+			if id == "" || (tt.want != "" && id != tt.want) {
+				t.Errorf("installationID() = %q, want %q", id, tt.want)
+			}
+			cm, err := client.PlatformV1().ConfigMaps().Get(context.Background(), configMapName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if cm.Data[installationIDKey] != id {
+				t.Errorf("installation id persisted = %q, want %q", cm.Data[installationIDKey], id)
+			}
+			// the same id is used for the next reports
+			if again, _ := c.installationID(context.Background()); again != id {
+				t.Errorf("installationID() = %q then %q", id, again)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	var received []*Report
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		report := &Report{}
+		if err := json.NewDecoder(req.Body).Decode(report); err != nil {
+			t.Errorf("decode report error = %v", err)
+		}
+		received = append(received, report)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "telemetry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	clusters := []*platformv1.Cluster{newCluster("cls-a", "Baremetal", "1.20.4", platformv1.ClusterRunning)}
+	c, _ := newTestController(t, config.TelemetryControllerConfiguration{
+		Endpoint:  server.URL,
+		ReportDir: filepath.Join(dir, "reports"),
+	}, clusters)
+	if err := c.report(); err != nil {
+		t.Fatalf("report() error = %v", err)
+	}
+	if len(received) != 1 || received[0].Clusters != 1 || received[0].InstallationID == "" {
+		t.Errorf("reports posted = %+v", received)
+	}
+	files, err := ioutil.ReadDir(filepath.Join(dir, "reports"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 || files[0].Name() != latestReportFile || !strings.HasPrefix(files[1].Name(), "report-") {
+		t.Errorf("local reports = %v, want the latest and the timestamped one", files)
+	}
+
+	status = http.StatusServiceUnavailable
+	if err := c.report(); err == nil {
+		t.Errorf("report() succeeded while the endpoint failed")
+	}
+}
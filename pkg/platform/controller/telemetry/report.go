@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package telemetry
+
+import (
+	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	appversion "tkestack.io/tke/pkg/app/version"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Report is the anonymized usage stats of an installation. It must never
+// carry names, addresses or any other identity of the users and their
+// clusters.
+type Report struct {
+	// InstallationID is a random id generated by the installation, which
+	// is only used to deduplicate the reports.
+	InstallationID string    `json:"installationID"`
+	Version        string    `json:"version"`
+	GeneratedAt    time.Time `json:"generatedAt"`
+
+	Clusters           int            `json:"clusters"`
+	ClusterTypes       map[string]int `json:"clusterTypes"`
+	ClusterPhases      map[string]int `json:"clusterPhases"`
+	KubernetesVersions map[string]int `json:"kubernetesVersions"`
+	MasterMachines     int            `json:"masterMachines"`
+	Machines           int            `json:"machines"`
+	// Addons is the number of clusters which enabled the addon.
+	Addons map[string]int `json:"addons"`
+}
+
+func (c *Controller) generate(ctx context.Context, installationID string) (*Report, error) {
+	report := &Report{
+		InstallationID:     installationID,
+		Version:            appversion.Get().GitVersion,
+		GeneratedAt:        time.Now().UTC(),
+		ClusterTypes:       make(map[string]int),
+		ClusterPhases:      make(map[string]int),
+		KubernetesVersions: make(map[string]int),
+		Addons:             make(map[string]int),
+	}
+
+	clusters, err := c.clusterLister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, cluster := range clusters {
+		if cluster.Status.Phase == platformv1.ClusterTerminating {
+			continue
+		}
+		report.Clusters++
+		report.ClusterTypes[cluster.Spec.Type]++
+		report.ClusterPhases[string(cluster.Status.Phase)]++
+		report.KubernetesVersions[cluster.Spec.Version]++
+		report.MasterMachines += len(cluster.Spec.Machines)
+
+		addons, err := compatibility.ListClusterAddons(ctx, c.platformClient, cluster.Name)
+		if err != nil {
+			// the report is best effort, lack of addons of a cluster is acceptable
+			log.FromContext(ctx).Error(err, "List cluster addons failed", "cluster", cluster.Name)
+			continue
+		}
+		enabled := make(map[string]bool)
+		for _, addon := range addons {
+			enabled[addon.Addon] = true
+		}
+		for addon := range enabled {
+			report.Addons[addon]++
+		}
+	}
+
+	machines, err := c.platformClient.Machines().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	report.Machines = len(machines.Items)
+
+	return report, nil
+}
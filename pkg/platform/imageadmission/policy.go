@@ -0,0 +1,111 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package imageadmission
+
+import (
+	"strings"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	// ExemptAnnotation exempts the pod, or all pods of the namespace if it is
+	// set on the namespace, from image admission when its value is "true".
+	ExemptAnnotation = "tkestack.io/image-admission-exempt"
+
+	defaultDomain = "docker.io"
+	officialRepo  = "library"
+)
+
+// normalizeImage returns the fully qualified name of image, for example nginx
+// is normalized to docker.io/library/nginx.
+func normalizeImage(image string) string {
+	i := strings.IndexRune(image, '/')
+	if i == -1 {
+		return defaultDomain + "/" + officialRepo + "/" + image
+	}
+	domain := image[:i]
+	if strings.ContainsAny(domain, ".:") || domain == "localhost" {
+		return image
+	}
+	return defaultDomain + "/" + image
+}
+
+// hasPrefix reports whether the image is under the registry prefix, the prefix
+// must match whole path components so that registry.example.com doesn't allow
+// registry.example.com.evil.io.
+func hasPrefix(image, prefix string) bool {
+	if !strings.HasPrefix(image, prefix) {
+		return false
+	}
+	if len(image) == len(prefix) || strings.HasSuffix(prefix, "/") {
+		return true
+	}
+	switch image[len(prefix)] {
+	case '/', ':', '@':
+		return true
+	}
+	return false
+}
+
+// allowedRegistries returns the image prefixes allowed in namespaces of the
+// project.
+func allowedRegistries(policy *platformv1.ImageAdmission, project string) []string {
+	registries := policy.AllowedRegistries
+	if project == "" {
+		return registries
+	}
+	for _, one := range policy.Projects {
+		if one.ProjectName == project {
+			registries = append(registries[:len(registries):len(registries)], one.AllowedRegistries...)
+		}
+	}
+	return registries
+}
+
+// DisallowedImages returns the images not from the registries allowed for the
+// project by policy.
+func DisallowedImages(policy *platformv1.ImageAdmission, project string, images []string) []string {
+	registries := allowedRegistries(policy, project)
+	var result []string
+	for _, image := range images {
+		normalized := normalizeImage(image)
+		allowed := false
+		for _, prefix := range registries {
+			if hasPrefix(image, prefix) || hasPrefix(normalized, prefix) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			result = append(result, image)
+		}
+	}
+	return result
+}
+
+// IsExemptNamespace reports whether the namespace is not checked by policy.
+func IsExemptNamespace(policy *platformv1.ImageAdmission, namespace string) bool {
+	for _, one := range policy.ExemptNamespaces {
+		if one == namespace {
+			return true
+		}
+	}
+	return false
+}
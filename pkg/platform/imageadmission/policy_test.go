@@ -0,0 +1,89 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package imageadmission
+
+import (
+	"reflect"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestDisallowedImages(t *testing.T) {
+	policy := &platformv1.ImageAdmission{
+		AllowedRegistries: []string{"registry.example.com", "docker.io/library/"},
+		Projects: []platformv1.ProjectImageAdmission{
+			{ProjectName: "prj-a", AllowedRegistries: []string{"quay.io/prj-a/"}},
+		},
+	}
+	tests := []struct {
+		name    string
+		project string
+		images  []string
+		want    []string
+	}{
+		{
+			name:   "allowed registries",
+			images: []string{"registry.example.com/app:v1", "registry.example.com:5000/app", "nginx", "docker.io/library/busybox:1.31"},
+		},
+		{
+			name:   "prefix must match whole component",
+			images: []string{"registry.example.com.evil.io/app", "evil/nginx"},
+			want:   []string{"registry.example.com.evil.io/app", "evil/nginx"},
+		},
+		{
+			name:    "project registries",
+			project: "prj-a",
+			images:  []string{"quay.io/prj-a/app", "quay.io/prj-b/app"},
+			want:    []string{"quay.io/prj-b/app"},
+		},
+		{
+			name:    "other project",
+			project: "prj-b",
+			images:  []string{"quay.io/prj-a/app"},
+			want:    []string{"quay.io/prj-a/app"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DisallowedImages(policy, tt.project, tt.images)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DisallowedImages() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if len(policy.AllowedRegistries) != 2 {
+		t.Errorf("policy is modified: %v", policy.AllowedRegistries)
+	}
+}
+
+func TestNormalizeImage(t *testing.T) {
+	tests := map[string]string{
+		"nginx":                     "docker.io/library/nginx",
+		"tkestack/tke":              "docker.io/tkestack/tke",
+		"localhost/app":             "localhost/app",
+		"registry.example.com/a/b":  "registry.example.com/a/b",
+		"registry.example.com:5000": "docker.io/library/registry.example.com:5000",
+	}
+	for image, want := range tests {
+		if got := normalizeImage(image); got != want {
+			t.Errorf("normalizeImage(%s) = %s, want %s", image, got, want)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package imageadmission
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// maxRequestSize bounds the size of admission review read from apiserver.
+const maxRequestSize = 3 * 1024 * 1024
+
+// Webhook serves the validating admission reviews of pods, the policy is
+// reloaded from file when it is changed.
+type Webhook struct {
+	policyFile      string
+	namespaceLister corelisters.NamespaceLister
+
+	mu      sync.RWMutex
+	policy  *platformv1.ImageAdmission
+	modTime time.Time
+}
+
+// NewWebhook creates the webhook with the policy file which contains the json
+// of ImageAdmission.
+func NewWebhook(policyFile string, namespaceLister corelisters.NamespaceLister) (*Webhook, error) {
+	w := &Webhook{
+		policyFile:      policyFile,
+		namespaceLister: namespaceLister,
+	}
+	if err := w.reload(); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// reload reads the policy file if it has been modified since last load.
+func (w *Webhook) reload() error {
+	info, err := os.Stat(w.policyFile)
+	if err != nil {
+		return err
+	}
+	w.mu.RLock()
+	unchanged := w.policy != nil && info.ModTime().Equal(w.modTime)
+	w.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	data, err := ioutil.ReadFile(w.policyFile)
+	if err != nil {
+		return err
+	}
+	policy := new(platformv1.ImageAdmission)
+	if err := json.Unmarshal(data, policy); err != nil {
+		return fmt.Errorf("parse policy file %s error: %w", w.policyFile, err)
+	}
+	if policy.Mode == "" {
+		policy.Mode = platformv1.ImageAdmissionEnforce
+	}
+
+	w.mu.Lock()
+	w.policy = policy
+	w.modTime = info.ModTime()
+	w.mu.Unlock()
+	log.Info("Image admission policy loaded", log.String("mode", string(policy.Mode)),
+		log.Strings("allowedRegistries", policy.AllowedRegistries))
+	return nil
+}
+
+func (w *Webhook) currentPolicy() *platformv1.ImageAdmission {
+	if err := w.reload(); err != nil {
+		// keep serving with the last loaded policy
+		log.Error("Reload image admission policy failed", log.Err(err))
+	}
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.policy
+}
+
+// ServeHTTP implements http.Handler.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if contentType := req.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/json") {
+		http.Error(rw, fmt.Sprintf("unsupported content type %s", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(rw, req.Body, maxRequestSize))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := new(admissionv1.AdmissionReview)
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(rw, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = w.Review(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(data)
+}
+
+// Review checks the images of pod in the admission request by policy.
+func (w *Webhook) Review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	allowed := &admissionv1.AdmissionResponse{Allowed: true}
+	if req.Kind.Kind != "Pod" {
+		return allowed
+	}
+	pod := new(corev1.Pod)
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		return &admissionv1.AdmissionResponse{
+			Result: &metav1.Status{
+				Status:  metav1.StatusFailure,
+				Code:    http.StatusBadRequest,
+				Reason:  metav1.StatusReasonBadRequest,
+				Message: fmt.Sprintf("decode pod error: %v", err),
+			},
+		}
+	}
+
+	policy := w.currentPolicy()
+	if IsExemptNamespace(policy, req.Namespace) || pod.Annotations[ExemptAnnotation] == "true" {
+		return allowed
+	}
+	var project string
+	namespace, err := w.namespaceLister.Get(req.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error("Get namespace for image admission failed", log.String("namespace", req.Namespace), log.Err(err))
+	}
+	if namespace != nil {
+		if namespace.Annotations[ExemptAnnotation] == "true" {
+			return allowed
+		}
+		project = namespace.Labels[businessutil.LabelProjectName]
+	}
+
+	disallowed := DisallowedImages(policy, project, podImages(pod))
+	if len(disallowed) == 0 {
+		return allowed
+	}
+	message := fmt.Sprintf("images %s are not from the allowed registries %s",
+		strings.Join(disallowed, ", "), strings.Join(allowedRegistries(policy, project), ", "))
+	log.Info("Image admission denied", log.String("namespace", req.Namespace), log.String("name", pod.Name),
+		log.String("user", req.UserInfo.Username), log.String("mode", string(policy.Mode)), log.Strings("images", disallowed))
+	if policy.Mode == platformv1.ImageAdmissionWarn {
+		allowed.Warnings = []string{message}
+		return allowed
+	}
+	return &admissionv1.AdmissionResponse{
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusForbidden,
+			Reason:  metav1.StatusReasonForbidden,
+			Message: message,
+		},
+	}
+}
+
+func podImages(pod *corev1.Pod) []string {
+	var images []string
+	for _, c := range pod.Spec.InitContainers {
+		images = append(images, c.Image)
+	}
+	for _, c := range pod.Spec.Containers {
+		images = append(images, c.Image)
+	}
+	for _, c := range pod.Spec.EphemeralContainers {
+		images = append(images, c.Image)
+	}
+	return images
+}
@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const (
+	// ConfigMapName is the ConfigMap in kube-system which the LBCF addon
+	// publishes the defaults of drivers in.
+	ConfigMapName = "lbcf-driver-config"
+	// ConfigKey is the key of the Config json in the ConfigMap.
+	ConfigKey = "config.json"
+
+	defaultWebhookTimeoutSeconds = 10
+	defaultIntervalSeconds       = 5
+	defaultTimeoutSeconds        = 2
+	defaultThreshold             = 3
+)
+
+// Config is the defaults of drivers configured in the LBCF addon.
+type Config struct {
+	// WebhookTimeoutSeconds is the timeout of webhooks.
+	WebhookTimeoutSeconds int32 `json:"webhookTimeoutSeconds"`
+	// HealthCheck is the health check of the registered backends.
+	HealthCheck platformv1.LBCFHealthCheck `json:"healthCheck"`
+}
+
+// NewConfig creates the Config of the LBCF addon, the unset fields are
+// defaulted.
+func NewConfig(lbcf *platformv1.LBCF) *Config {
+	config := &Config{WebhookTimeoutSeconds: lbcf.Spec.WebhookTimeoutSeconds}
+	if lbcf.Spec.HealthCheck != nil {
+		config.HealthCheck = *lbcf.Spec.HealthCheck
+	}
+	config.Default()
+	return config
+}
+
+// Default sets the unset fields to their defaults.
+func (c *Config) Default() {
+	if c.WebhookTimeoutSeconds <= 0 {
+		c.WebhookTimeoutSeconds = defaultWebhookTimeoutSeconds
+	}
+	if c.HealthCheck.IntervalSeconds <= 0 {
+		c.HealthCheck.IntervalSeconds = defaultIntervalSeconds
+	}
+	if c.HealthCheck.TimeoutSeconds <= 0 {
+		c.HealthCheck.TimeoutSeconds = defaultTimeoutSeconds
+	}
+	if c.HealthCheck.HealthyThreshold <= 0 {
+		c.HealthCheck.HealthyThreshold = defaultThreshold
+	}
+	if c.HealthCheck.UnhealthyThreshold <= 0 {
+		c.HealthCheck.UnhealthyThreshold = defaultThreshold
+	}
+}
+
+// WebhookTimeout is the timeout of webhooks as a duration.
+func (c *Config) WebhookTimeout() time.Duration {
+	return time.Duration(c.WebhookTimeoutSeconds) * time.Second
+}
+
+// ParseConfig decodes the Config json and defaults it.
+func ParseConfig(data []byte) (*Config, error) {
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("decode LBCF driver config failed: %v", err)
+	}
+	config.Default()
+	return config, nil
+}
+
+// LoadConfig reads the Config from the ConfigMap in kube-system, the defaults
+// are returned if the ConfigMap is not published by the LBCF addon.
+func LoadConfig(ctx context.Context, kubeClient kubernetes.Interface) (*Config, error) {
+	cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err != nil || cm.Data[ConfigKey] == "" {
+		config := &Config{}
+		config.Default()
+		return config, nil
+	}
+	return ParseConfig([]byte(cm.Data[ConfigKey]))
+}
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type fakeDriver struct {
+	UnimplementedDriver
+}
+
+func (fakeDriver) CreateLoadBalancer(ctx context.Context, req *CreateLoadBalancerRequest) (*CreateLoadBalancerResponse, error) {
+	if req.LBSpec["vpcID"] == "" {
+		return nil, errors.New("vpcID is required")
+	}
+	resp := &CreateLoadBalancerResponse{LBInfo: map[string]string{"loadBalancerID": "lb-1"}}
+	resp.Status = StatusSucc
+	return resp, nil
+}
+
+func post(t *testing.T, handler http.Handler, webhook string, req interface{}, resp interface{}) int {
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/"+webhook, bytes.NewReader(body)))
+	if recorder.Code == http.StatusOK {
+		if err := json.Unmarshal(recorder.Body.Bytes(), resp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return recorder.Code
+}
+
+func TestHandler(t *testing.T) {
+	handler := NewHandler(fakeDriver{}, time.Second)
+
+	created := &CreateLoadBalancerResponse{}
+	post(t, handler, CreateLoadBalancer, &CreateLoadBalancerRequest{LBSpec: map[string]string{"vpcID": "vpc-1"}}, created)
+	if created.Status != StatusSucc || created.LBInfo["loadBalancerID"] != "lb-1" {
+		t.Errorf("CreateLoadBalancer = %+v", created)
+	}
+
+	failed := &CreateLoadBalancerResponse{}
+	post(t, handler, CreateLoadBalancer, &CreateLoadBalancerRequest{}, failed)
+	if failed.Status != StatusFail || failed.Msg != "vpcID is required" {
+		t.Errorf("CreateLoadBalancer without vpcID = %+v", failed)
+	}
+
+	validated := &ValidateLoadBalancerResponse{}
+	post(t, handler, ValidateLoadBalancer, &ValidateLoadBalancerRequest{}, validated)
+	if validated.Succ {
+		t.Errorf("unimplemented ValidateLoadBalancer = %+v", validated)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/"+EnsureLoadBalancer, bytes.NewReader([]byte("{"))))
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("invalid request = %d, want %d", recorder.Code, http.StatusBadRequest)
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	config, err := ParseConfig([]byte(`{"healthCheck":{"path":"/healthz","intervalSeconds":10}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.WebhookTimeout() != 10*time.Second {
+		t.Errorf("WebhookTimeout() = %v, want 10s", config.WebhookTimeout())
+	}
+	if config.HealthCheck.Path != "/healthz" || config.HealthCheck.IntervalSeconds != 10 ||
+		config.HealthCheck.TimeoutSeconds != defaultTimeoutSeconds || config.HealthCheck.UnhealthyThreshold != defaultThreshold {
+		t.Errorf("HealthCheck = %+v", config.HealthCheck)
+	}
+}
@@ -0,0 +1,52 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package driver
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// LoadBalancerDriver creates the LoadBalancerDriver which registers the
+// driver served at url to lbcf-controller, all the webhooks time out after
+// the timeout of config.
+func LoadBalancerDriver(name string, namespace string, url string, config *Config) *unstructured.Unstructured {
+	timeout := config.WebhookTimeout().String()
+	var webhooks []interface{}
+	for _, webhook := range Webhooks {
+		webhooks = append(webhooks, map[string]interface{}{
+			"name":    webhook,
+			"timeout": timeout,
+		})
+	}
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "lbcf.tkestack.io/v1beta1",
+			"kind":       "LoadBalancerDriver",
+			"metadata": map[string]interface{}{
+				"name":      name,
+				"namespace": namespace,
+			},
+			"spec": map[string]interface{}{
+				"driverType": "Webhook",
+				"url":        url,
+				"webhooks":   webhooks,
+			},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package driver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// maxRequestSize bounds the size of webhook requests read from lbcf-controller.
+const maxRequestSize = 3 * 1024 * 1024
+
+// Driver implements the webhooks of a load balancer, the context of each call
+// is cancelled when the webhook times out.
+type Driver interface {
+	ValidateLoadBalancer(ctx context.Context, req *ValidateLoadBalancerRequest) (*ValidateLoadBalancerResponse, error)
+	CreateLoadBalancer(ctx context.Context, req *CreateLoadBalancerRequest) (*CreateLoadBalancerResponse, error)
+	EnsureLoadBalancer(ctx context.Context, req *EnsureLoadBalancerRequest) (*EnsureLoadBalancerResponse, error)
+	DeleteLoadBalancer(ctx context.Context, req *DeleteLoadBalancerRequest) (*DeleteLoadBalancerResponse, error)
+	ValidateBackend(ctx context.Context, req *ValidateBackendRequest) (*ValidateBackendResponse, error)
+	GenerateBackendAddr(ctx context.Context, req *GenerateBackendAddrRequest) (*GenerateBackendAddrResponse, error)
+	EnsureBackendRecord(ctx context.Context, req *BackendOperationRequest) (*BackendOperationResponse, error)
+	DeregisterBackend(ctx context.Context, req *BackendOperationRequest) (*BackendOperationResponse, error)
+}
+
+// UnimplementedDriver rejects all the webhooks, drivers embed it to implement
+// only the webhooks they need.
+type UnimplementedDriver struct{}
+
+var _ Driver = UnimplementedDriver{}
+
+func (UnimplementedDriver) ValidateLoadBalancer(ctx context.Context, req *ValidateLoadBalancerRequest) (*ValidateLoadBalancerResponse, error) {
+	return nil, errNotImplemented(ValidateLoadBalancer)
+}
+
+func (UnimplementedDriver) CreateLoadBalancer(ctx context.Context, req *CreateLoadBalancerRequest) (*CreateLoadBalancerResponse, error) {
+	return nil, errNotImplemented(CreateLoadBalancer)
+}
+
+func (UnimplementedDriver) EnsureLoadBalancer(ctx context.Context, req *EnsureLoadBalancerRequest) (*EnsureLoadBalancerResponse, error) {
+	return nil, errNotImplemented(EnsureLoadBalancer)
+}
+
+func (UnimplementedDriver) DeleteLoadBalancer(ctx context.Context, req *DeleteLoadBalancerRequest) (*DeleteLoadBalancerResponse, error) {
+	return nil, errNotImplemented(DeleteLoadBalancer)
+}
+
+func (UnimplementedDriver) ValidateBackend(ctx context.Context, req *ValidateBackendRequest) (*ValidateBackendResponse, error) {
+	return nil, errNotImplemented(ValidateBackend)
+}
+
+func (UnimplementedDriver) GenerateBackendAddr(ctx context.Context, req *GenerateBackendAddrRequest) (*GenerateBackendAddrResponse, error) {
+	return nil, errNotImplemented(GenerateBackendAddr)
+}
+
+func (UnimplementedDriver) EnsureBackendRecord(ctx context.Context, req *BackendOperationRequest) (*BackendOperationResponse, error) {
+	return nil, errNotImplemented(EnsureBackendRecord)
+}
+
+func (UnimplementedDriver) DeregisterBackend(ctx context.Context, req *BackendOperationRequest) (*BackendOperationResponse, error) {
+	return nil, errNotImplemented(DeregisterBackend)
+}
+
+func errNotImplemented(webhook string) error {
+	return fmt.Errorf("webhook %s is not implemented", webhook)
+}
+
+// NewHandler serves the webhooks of the driver on the paths of their names,
+// the calls are cancelled after the timeout if it is positive. The errors
+// returned by driver are sent to lbcf-controller as failures, which are
+// retried after RetryIntervalInSeconds by the retried webhooks.
+func NewHandler(driver Driver, timeout time.Duration) http.Handler {
+	mux := http.NewServeMux()
+	handle := func(webhook string, newRequest func() interface{}, call func(context.Context, interface{}) (interface{}, error), failure func(error) interface{}) {
+		mux.HandleFunc("/"+webhook, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+				return
+			}
+			body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestSize))
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			req := newRequest()
+			if err := json.Unmarshal(body, req); err != nil {
+				http.Error(w, fmt.Sprintf("decode request of %s failed: %v", webhook, err), http.StatusBadRequest)
+				return
+			}
+
+			ctx := r.Context()
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			resp, err := call(ctx, req)
+			if err != nil {
+				log.Warn("LBCF driver webhook failed", log.String("webhook", webhook), log.Err(err))
+				resp = failure(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewEncoder(w).Encode(resp); err != nil {
+				log.Warn("Failed to write the response of LBCF driver webhook", log.String("webhook", webhook), log.Err(err))
+			}
+		})
+	}
+	noRetry := func(err error) interface{} {
+		return &ResponseForNoRetryHooks{Succ: false, Msg: err.Error()}
+	}
+	failRetry := func(err error) interface{} {
+		return &ResponseForFailRetryHooks{Status: StatusFail, Msg: err.Error()}
+	}
+
+	handle(ValidateLoadBalancer, func() interface{} { return &ValidateLoadBalancerRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.ValidateLoadBalancer(ctx, req.(*ValidateLoadBalancerRequest))
+		}, noRetry)
+	handle(CreateLoadBalancer, func() interface{} { return &CreateLoadBalancerRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.CreateLoadBalancer(ctx, req.(*CreateLoadBalancerRequest))
+		}, failRetry)
+	handle(EnsureLoadBalancer, func() interface{} { return &EnsureLoadBalancerRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.EnsureLoadBalancer(ctx, req.(*EnsureLoadBalancerRequest))
+		}, failRetry)
+	handle(DeleteLoadBalancer, func() interface{} { return &DeleteLoadBalancerRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.DeleteLoadBalancer(ctx, req.(*DeleteLoadBalancerRequest))
+		}, failRetry)
+	handle(ValidateBackend, func() interface{} { return &ValidateBackendRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.ValidateBackend(ctx, req.(*ValidateBackendRequest))
+		}, noRetry)
+	handle(GenerateBackendAddr, func() interface{} { return &GenerateBackendAddrRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.GenerateBackendAddr(ctx, req.(*GenerateBackendAddrRequest))
+		}, failRetry)
+	handle(EnsureBackendRecord, func() interface{} { return &BackendOperationRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.EnsureBackendRecord(ctx, req.(*BackendOperationRequest))
+		}, failRetry)
+	handle(DeregisterBackend, func() interface{} { return &BackendOperationRequest{} },
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return driver.DeregisterBackend(ctx, req.(*BackendOperationRequest))
+		}, failRetry)
+	return mux
+}
@@ -0,0 +1,212 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package driver is the SDK to implement the drivers of LBCF, which serve the
+// webhooks called by lbcf-controller to operate load balancers and backends.
+package driver
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// The names of webhooks, which are also the paths served by drivers.
+const (
+	ValidateLoadBalancer = "validateLoadBalancer"
+	CreateLoadBalancer   = "createLoadBalancer"
+	EnsureLoadBalancer   = "ensureLoadBalancer"
+	DeleteLoadBalancer   = "deleteLoadBalancer"
+	ValidateBackend      = "validateBackend"
+	GenerateBackendAddr  = "generateBackendAddr"
+	EnsureBackendRecord  = "ensureBackendRecord"
+	DeregisterBackend    = "deregisterBackend"
+)
+
+// Webhooks are all the webhooks implemented by a driver.
+var Webhooks = []string{
+	ValidateLoadBalancer,
+	CreateLoadBalancer,
+	EnsureLoadBalancer,
+	DeleteLoadBalancer,
+	ValidateBackend,
+	GenerateBackendAddr,
+	EnsureBackendRecord,
+	DeregisterBackend,
+}
+
+// Status is the result of the webhooks which are retried by lbcf-controller.
+type Status string
+
+const (
+	// StatusSucc means the operation is done.
+	StatusSucc Status = "Succ"
+	// StatusFail means the operation failed and will be retried after
+	// RetryIntervalInSeconds.
+	StatusFail Status = "Fail"
+	// StatusRunning means the operation is in progress and the webhook will be
+	// called again after RetryIntervalInSeconds.
+	StatusRunning Status = "Running"
+)
+
+// Operation is the operation of the objects to validate.
+type Operation string
+
+const (
+	OperationCreate Operation = "Create"
+	OperationUpdate Operation = "Update"
+)
+
+// RequestForRetryHooks identifies the object which the retried webhook is
+// called for.
+type RequestForRetryHooks struct {
+	RecordNamespace string `json:"recordNamespace"`
+	RecordName      string `json:"recordName"`
+}
+
+// ResponseForNoRetryHooks is the response of the validating webhooks.
+type ResponseForNoRetryHooks struct {
+	Succ bool   `json:"succ"`
+	Msg  string `json:"msg"`
+}
+
+// ResponseForFailRetryHooks is the response of the retried webhooks.
+type ResponseForFailRetryHooks struct {
+	Status                 Status `json:"status"`
+	Msg                    string `json:"msg"`
+	RetryIntervalInSeconds int32  `json:"retryIntervalInSeconds,omitempty"`
+}
+
+// ValidateLoadBalancerRequest validates the spec and attributes of a
+// LoadBalancer.
+type ValidateLoadBalancerRequest struct {
+	LBSpec        map[string]string `json:"lbSpec"`
+	Operation     Operation         `json:"operation"`
+	Attributes    map[string]string `json:"attributes"`
+	OldAttributes map[string]string `json:"oldAttributes,omitempty"`
+}
+
+// ValidateLoadBalancerResponse is the response of ValidateLoadBalancer.
+type ValidateLoadBalancerResponse struct {
+	ResponseForNoRetryHooks
+}
+
+// CreateLoadBalancerRequest creates the load balancer of the spec.
+type CreateLoadBalancerRequest struct {
+	RequestForRetryHooks
+	LBSpec     map[string]string `json:"lbSpec"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// CreateLoadBalancerResponse returns the info identifying the created load
+// balancer, which is passed to the other webhooks.
+type CreateLoadBalancerResponse struct {
+	ResponseForFailRetryHooks
+	LBInfo map[string]string `json:"lbInfo"`
+}
+
+// EnsureLoadBalancerRequest ensures the attributes of the load balancer.
+type EnsureLoadBalancerRequest struct {
+	RequestForRetryHooks
+	LBInfo     map[string]string `json:"lbInfo"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// EnsureLoadBalancerResponse is the response of EnsureLoadBalancer.
+type EnsureLoadBalancerResponse struct {
+	ResponseForFailRetryHooks
+}
+
+// DeleteLoadBalancerRequest deletes the load balancer.
+type DeleteLoadBalancerRequest struct {
+	RequestForRetryHooks
+	LBInfo     map[string]string `json:"lbInfo"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// DeleteLoadBalancerResponse is the response of DeleteLoadBalancer.
+type DeleteLoadBalancerResponse struct {
+	ResponseForFailRetryHooks
+}
+
+// ValidateBackendRequest validates the parameters of a BackendGroup.
+type ValidateBackendRequest struct {
+	BackendType   string            `json:"backendType"`
+	LBInfo        map[string]string `json:"lbInfo"`
+	Operation     Operation         `json:"operation"`
+	Parameters    map[string]string `json:"parameters"`
+	OldParameters map[string]string `json:"oldParameters,omitempty"`
+}
+
+// ValidateBackendResponse is the response of ValidateBackend.
+type ValidateBackendResponse struct {
+	ResponseForNoRetryHooks
+}
+
+// PortSelector selects the port of a pod or service.
+type PortSelector struct {
+	PortNumber int32  `json:"portNumber"`
+	Protocol   string `json:"protocol"`
+}
+
+// PodBackend is the pod to generate the backend address for.
+type PodBackend struct {
+	Pod  corev1.Pod   `json:"pod"`
+	Port PortSelector `json:"port"`
+}
+
+// ServiceBackend is the node port of service to generate the backend address
+// for.
+type ServiceBackend struct {
+	Service  corev1.Service `json:"service"`
+	Port     PortSelector   `json:"port"`
+	NodeName string         `json:"nodeName"`
+	Node     corev1.Node    `json:"node"`
+}
+
+// GenerateBackendAddrRequest generates the address registered to the load
+// balancer for a pod or service backend.
+type GenerateBackendAddrRequest struct {
+	RequestForRetryHooks
+	PodBackend     *PodBackend       `json:"podBackend,omitempty"`
+	ServiceBackend *ServiceBackend   `json:"serviceBackend,omitempty"`
+	LBInfo         map[string]string `json:"lbInfo"`
+	LBAttributes   map[string]string `json:"lbAttributes"`
+	Parameters     map[string]string `json:"parameters"`
+}
+
+// GenerateBackendAddrResponse is the response of GenerateBackendAddr.
+type GenerateBackendAddrResponse struct {
+	ResponseForFailRetryHooks
+	BackendAddr string `json:"backendAddr"`
+}
+
+// BackendOperationRequest registers or deregisters the backend address of a
+// BackendRecord.
+type BackendOperationRequest struct {
+	RequestForRetryHooks
+	LBInfo       map[string]string `json:"lbInfo"`
+	LBAttributes map[string]string `json:"lbAttributes"`
+	BackendAddr  string            `json:"backendAddr"`
+	Parameters   map[string]string `json:"parameters"`
+	InjectedInfo map[string]string `json:"injectedInfo"`
+}
+
+// BackendOperationResponse returns the info injected to the BackendRecord.
+type BackendOperationResponse struct {
+	ResponseForFailRetryHooks
+	InjectedInfo map[string]string `json:"injectedInfo"`
+}
@@ -25,9 +25,9 @@ import (
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"net/http"
 	"os"
-	"path"
 	"reflect"
 	"strings"
 	"time"
@@ -37,8 +37,11 @@ import (
 	"github.com/segmentio/ksuid"
 	"github.com/thoas/go-funk"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	bootstraputil "k8s.io/cluster-bootstrap/token/util"
 	kubeaggregatorclientset "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
@@ -48,22 +51,33 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/addons/cniplugins"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/authzwebhook"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/calico"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/chrony"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/coredns"
 	csioperatorimage "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/docker"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/encryption"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/etcd"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy"
 	galaxyimages "tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/image"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/imageadmission"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/keepalived"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeconfig"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubelet"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/networkpolicy"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/sandbox"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/secretsencryption"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/thirdpartyha"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/preflight"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	"tkestack.io/tke/pkg/platform/provider/util/mark"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/platform/util/netcheck"
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/cmdstring"
 	containerregistryutil "tkestack.io/tke/pkg/util/containerregistry"
@@ -73,9 +87,7 @@ import (
 )
 
 const (
-	sysctlFile       = "/etc/sysctl.conf"
-	sysctlCustomFile = "/etc/sysctl.d/99-tke.conf"
-	moduleFile       = "/etc/modules-load.d/tke.conf"
+	sysctlFile = "/etc/sysctl.conf"
 )
 
 func (p *Provider) EnsureCopyFiles(ctx context.Context, c *v1.Cluster) error {
@@ -154,6 +166,33 @@ func (p *Provider) EnsurePreflight(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
+// EnsureEtcdDataDevice mounts the dedicated data device of etcd before it starts.
+func (p *Provider) EnsureEtcdDataDevice(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Etcd == nil || c.Spec.Etcd.Local == nil || c.Spec.Etcd.Local.DataDevice == "" {
+		return nil
+	}
+	dataDir := c.Spec.Etcd.Local.DataDir
+	if dataDir == "" {
+		dataDir = constants.EtcdDataDir
+	}
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range machines {
+		machineSSH, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		err = etcd.MountDataDevice(machineSSH, c.Spec.Etcd.Local.DataDevice, dataDir)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureRegistryHosts(ctx context.Context, c *v1.Cluster) error {
 	if !p.config.Registry.NeedSetHosts() {
 		return nil
@@ -186,29 +225,15 @@ func (p *Provider) EnsureRegistryHosts(ctx context.Context, c *v1.Cluster) error
 }
 
 func (p *Provider) EnsureKernelModule(ctx context.Context, c *v1.Cluster) error {
-	var data bytes.Buffer
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	for _, machine := range machines {
-		modules := []string{"iptable_nat", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh"}
-
 		s, err := machine.SSH()
 		if err != nil {
 			return err
 		}
-		if _, err := s.CombinedOutput("modinfo br_netfilter"); err == nil {
-			modules = append(modules, "br_netfilter")
-		}
-
-		for _, m := range modules {
-			_, err := s.CombinedOutput(fmt.Sprintf("modprobe %s", m))
-			if err != nil {
-				return errors.Wrap(err, machine.IP)
-			}
-			data.WriteString(m + "\n")
-		}
-		err = s.WriteFile(strings.NewReader(data.String()), moduleFile)
+		_, err = tuning.ApplyKernelModules(s, tuning.ForCluster(c.Cluster).KernelModules)
 		if err != nil {
 			return errors.Wrap(err, machine.IP)
 		}
@@ -234,15 +259,29 @@ func (p *Provider) EnsureSysctl(ctx context.Context, c *v1.Cluster) error {
 			return errors.Wrap(err, machine.IP)
 		}
 
-		f, err := os.Open(path.Join(constants.ConfDir, "sysctl.conf"))
-		if err == nil {
-			err = machineSSH.WriteFile(f, sysctlCustomFile)
-			if err != nil {
-				return err
-			}
+		_, err = tuning.ApplySysctls(machineSSH, c.SystemTuning().Sysctls)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
 		}
+	}
 
-		_, err = machineSSH.CombinedOutput("sysctl --system")
+	return nil
+}
+
+func (p *Provider) EnsureTimeSync(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.TimeSync == nil {
+		return nil
+	}
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range machines {
+		machineSSH, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		err = chrony.Install(machineSSH, c.Spec.Features.TimeSync.Servers)
 		if err != nil {
 			return errors.Wrap(err, machine.IP)
 		}
@@ -506,15 +545,14 @@ func (p *Provider) EnsureDocker(ctx context.Context, c *v1.Cluster) error {
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
-	insecureRegistries := fmt.Sprintf(`"%s"`, p.config.Registry.Domain)
-	if p.config.Registry.NeedSetHosts() && c.Spec.TenantID != "" {
-		insecureRegistries = fmt.Sprintf(`%s,"%s"`, insecureRegistries, c.Spec.TenantID+"."+p.config.Registry.Domain)
-	}
+	insecureRegistries, mirrors, auths := p.getContainerRegistries(c.Spec.TenantID, c)
 	extraArgs := c.Spec.DockerExtraArgs
 	utilruntime.Must(mergo.Merge(&extraArgs, p.config.Docker.ExtraArgs))
 	option := &docker.Option{
-		InsecureRegistries: insecureRegistries,
+		InsecureRegistries: docker.FormatRegistries(insecureRegistries),
+		RegistryMirrors:    docker.FormatRegistries(mirrors),
 		RegistryDomain:     p.config.Registry.Domain,
+		CgroupDriver:       c.CgroupDriver(),
 		ExtraArgs:          extraArgs,
 	}
 	for _, machine := range machines {
@@ -524,10 +562,63 @@ func (p *Provider) EnsureDocker(ctx context.Context, c *v1.Cluster) error {
 		}
 
 		option.IsGPU = gpu.IsEnable(machine.Labels)
+		option.SandboxRuntimeHandler, option.SandboxRuntimePath = "", ""
+		if c.Spec.Features.SandboxRuntime != nil && sandbox.IsEnable(machine.Labels) {
+			r, err := sandbox.Get(c.Spec.Features.SandboxRuntime.Type)
+			if err != nil {
+				return err
+			}
+			option.SandboxRuntimeHandler, option.SandboxRuntimePath = r.Handler, r.Path
+		}
 		err = docker.Install(machineSSH, option)
 		if err != nil {
 			return errors.Wrap(err, machine.IP)
 		}
+		_, err = docker.ApplyRegistryAuths(machineSSH, auths, c.RegistryPasswords())
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+
+	return nil
+}
+
+// getContainerRegistries returns the insecure registries, registry mirrors and
+// registry credentials of the container runtime for the nodes of tenant in cluster.
+func (p *Provider) getContainerRegistries(tenantID string, c *v1.Cluster) ([]string, []string, []platformv1.RegistryAuth) {
+	insecureRegistries := p.config.Registry.InsecureRegistries(tenantID)
+	registries := c.Spec.ContainerRegistries
+	if registries == nil {
+		return insecureRegistries, nil, nil
+	}
+	for _, one := range registries.InsecureRegistries {
+		if !funk.ContainsString(insecureRegistries, one) {
+			insecureRegistries = append(insecureRegistries, one)
+		}
+	}
+	return insecureRegistries, registries.Mirrors, registries.Auths
+}
+
+func (p *Provider) EnsureSandboxRuntime(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.SandboxRuntime == nil {
+		return nil
+	}
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range machines {
+		if !sandbox.IsEnable(machine.Labels) {
+			continue
+		}
+		machineSSH, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		err = sandbox.Install(machineSSH, c.Spec.Features.SandboxRuntime.Type)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
 	}
 
 	return nil
@@ -571,6 +662,29 @@ func (p *Provider) EnsureConntrackTools(ctx context.Context, c *v1.Cluster) erro
 	return nil
 }
 
+func (p *Provider) EnsureWireGuard(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.NetworkEncryption == nil ||
+		c.Spec.Features.NetworkEncryption.Type != platformv1.NetworkEncryptionWireGuard {
+		return nil
+	}
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range machines {
+		machineSSH, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		err = encryption.InstallWireGuard(machineSSH)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureKubeadm(ctx context.Context, c *v1.Cluster) error {
 	machines := map[bool][]platformv1.ClusterMachine{
 		true:  c.Spec.ScalingMachines,
@@ -704,6 +818,7 @@ func (p *Provider) EnsureAuthzWebhook(ctx context.Context, c *v1.Cluster) error
 		}
 		option := authzwebhook.Option{
 			AuthzWebhookEndpoint: authzEndpoint,
+			ClusterName:          c.Name,
 			IsGlobalCluster:      isGlobalCluster,
 			IsClusterUpscaling:   isClusterUpscaling,
 		}
@@ -721,7 +836,6 @@ func (p *Provider) EnsurePrepareForControlplane(ctx context.Context, c *v1.Clust
 		true:  c.Spec.ScalingMachines,
 		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
 	oidcCa, _ := ioutil.ReadFile(constants.OIDCConfigFile)
-	auditPolicyData, _ := ioutil.ReadFile(constants.AuditPolicyConfigFile)
 	GPUQuotaAdmissionHost := c.Annotations[constants.GPUQuotaAdmissionIPAnnotaion]
 	if GPUQuotaAdmissionHost == "" {
 		GPUQuotaAdmissionHost = "gpu-quota-admission"
@@ -737,12 +851,13 @@ func (p *Provider) EnsurePrepareForControlplane(ctx context.Context, c *v1.Clust
 	if err != nil {
 		return errors.Wrap(err, "parse schedulerPolicyConfig error")
 	}
-	auditWebhookConfig, err := template.ParseString(auditWebhookConfig, map[string]interface{}{
-		"AuditBackendAddress": p.config.Audit.Address,
-		"ClusterName":         c.Name,
-	})
+	auditFiles, err := p.getAuditFiles(c)
 	if err != nil {
-		return errors.Wrap(err, "parse auditWebhookConfig error")
+		return err
+	}
+	encryptionConfig, err := p.getSecretsEncryptionConfig(c)
+	if err != nil {
+		return err
 	}
 	for _, machine := range machines {
 		machineSSH, err := machine.SSH()
@@ -768,16 +883,17 @@ func (p *Provider) EnsurePrepareForControlplane(ctx context.Context, c *v1.Clust
 			}
 		}
 
-		if p.config.AuditEnabled() {
-			if len(auditPolicyData) != 0 {
-				err = machineSSH.WriteFile(bytes.NewReader(auditPolicyData), constants.KubernetesAuditPolicyConfigFile)
-				if err != nil {
-					return errors.Wrap(err, machine.IP)
-				}
-				err = machineSSH.WriteFile(bytes.NewReader(auditWebhookConfig), constants.KubernetesAuditWebhookConfigFile)
-				if err != nil {
-					return errors.Wrap(err, machine.IP)
-				}
+		for file, data := range auditFiles {
+			err = machineSSH.WriteFile(bytes.NewReader(data), file)
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
+			}
+		}
+
+		if len(encryptionConfig) != 0 {
+			err = machineSSH.WriteFile(bytes.NewReader(encryptionConfig), constants.KubernetesEncryptionConfigFile)
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
 			}
 		}
 	}
@@ -785,6 +901,65 @@ func (p *Provider) EnsurePrepareForControlplane(ctx context.Context, c *v1.Clust
 	return nil
 }
 
+// getSecretsEncryptionConfig returns the encryption config of the first master,
+// a new one is generated if the first master has not been created.
+func (p *Provider) getSecretsEncryptionConfig(c *v1.Cluster) ([]byte, error) {
+	if c.Spec.Features.SecretsEncryption == nil {
+		return nil, nil
+	}
+	s, err := c.Spec.Machines[0].SSH()
+	if err != nil {
+		return nil, err
+	}
+	current, err := readFileIfExist(s, constants.KubernetesEncryptionConfigFile)
+	if err != nil {
+		return nil, errors.Wrap(err, c.Spec.Machines[0].IP)
+	}
+	if len(current) != 0 {
+		return current, nil
+	}
+	config, _, err := secretsencryption.NextConfig(nil, c.Spec.Features.SecretsEncryption, time.Now())
+	return config, err
+}
+
+// getAuditFiles returns the audit policy and webhook config files which should be
+// written to master nodes, keyed by file path.
+func (p *Provider) getAuditFiles(c *v1.Cluster) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	audit := c.Spec.Features.Audit
+	if audit == nil && !p.config.AuditEnabled() {
+		return files, nil
+	}
+
+	var policy []byte
+	if audit != nil && audit.Policy != "" {
+		policy = []byte(audit.Policy)
+	} else {
+		policy, _ = ioutil.ReadFile(constants.AuditPolicyConfigFile)
+	}
+	if len(policy) == 0 {
+		if audit != nil {
+			return nil, fmt.Errorf("audit policy is empty and %s not exists", constants.AuditPolicyConfigFile)
+		}
+		return files, nil
+	}
+	files[constants.KubernetesAuditPolicyConfigFile] = policy
+
+	if address := p.auditWebhookAddress(c); address != "" {
+		webhookConfig, err := template.ParseString(auditWebhookConfig, map[string]interface{}{
+			"AuditBackendAddress": address,
+			"ClusterName":         c.Name,
+			"Token":               p.auditWebhookToken(c, address),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "parse auditWebhookConfig error")
+		}
+		files[constants.KubernetesAuditWebhookConfigFile] = webhookConfig
+	}
+
+	return files, nil
+}
+
 func (p *Provider) EnsureKubeadmInitPhaseKubeletStart(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
@@ -885,6 +1060,10 @@ func (p *Provider) EnsureKubeadmInitPhaseAddon(ctx context.Context, c *v1.Cluste
 	if err != nil {
 		return err
 	}
+	// kube-proxy is replaced by cilium
+	if c.Spec.Features.Cilium != nil && c.Spec.Features.Cilium.KubeProxyReplacement {
+		return kubeadm.Init(machineSSH, p.getKubeadmInitConfig(c), "addon coredns")
+	}
 	return kubeadm.Init(machineSSH, p.getKubeadmInitConfig(c), "addon all")
 }
 
@@ -904,11 +1083,16 @@ func (p *Provider) EnsureGalaxy(ctx context.Context, c *v1.Cluster) error {
 			backendType = backendTypeArg
 		}
 	}
+	if clusterSpec.Features.NetworkEncryption != nil &&
+		clusterSpec.Features.NetworkEncryption.Type == platformv1.NetworkEncryptionWireGuard {
+		backendType = galaxy.BackendTypeWireGuard
+	}
 	return galaxy.Install(ctx, clientset, &galaxy.Option{
 		Version:     galaxyimages.LatestVersion,
 		NodeCIDR:    clusterSpec.ClusterCIDR,
 		NetDevice:   clusterSpec.NetworkDevice,
 		BackendType: backendType,
+		Network:     clusterSpec.Features.GalaxyNetwork,
 	})
 }
 
@@ -1085,6 +1269,18 @@ func (p *Provider) EnsureStoreCredential(ctx context.Context, c *v1.Cluster) err
 		c.IsCredentialChanged = true
 	}
 
+	// without VIP, clients fail over to other masters when the recorded one is down.
+	var endpoints []string
+	if c.Spec.Features.HA == nil && len(c.Spec.Machines) > 1 {
+		for _, machine := range c.Spec.Machines {
+			endpoints = append(endpoints, net.JoinHostPort(machine.IP, "6443"))
+		}
+	}
+	if !reflect.DeepEqual(c.ClusterCredential.Endpoints, endpoints) {
+		c.ClusterCredential.Endpoints = endpoints
+		c.IsCredentialChanged = true
+	}
+
 	return nil
 }
 
@@ -1138,6 +1334,11 @@ func (p *Provider) EnsureKubelet(ctx context.Context, c *v1.Cluster) error {
 			return err
 		}
 
+		err = util.VerifyKubernetesNodePackage(ctx, p.platformClient, machineSSH, c.Spec.Version)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+
 		err = kubelet.Install(machineSSH, c.Spec.Version)
 		if err != nil {
 			return errors.Wrap(err, machine.IP)
@@ -1236,18 +1437,33 @@ func (p *Provider) EnsureNvidiaDevicePlugin(ctx context.Context, c *v1.Cluster)
 		return nil
 	}
 
-	client, err := c.ClientsetForBootstrap()
+	client, err := c.Clientset()
 	if err != nil {
 		return err
 	}
 	option := &gpu.NvidiaDevicePluginOption{
 		Image: images.Get().NvidiaDevicePlugin.FullName(),
 	}
+	strategy := c.Cluster.MIGStrategy()
+	if strategy != platformv1.GPUMIGStrategyNone {
+		option.Image = images.Get().NvidiaDevicePluginMIG.FullName()
+		option.MIGStrategy = string(strategy)
+	}
 	err = gpu.InstallNvidiaDevicePlugin(ctx, client, option)
 	if err != nil {
 		return err
 	}
 
+	if strategy != platformv1.GPUMIGStrategyNone {
+		err = gpu.InstallNvidiaMIGManager(ctx, client, &gpu.NvidiaMIGManagerOption{
+			Image:         images.Get().NvidiaMIGManager.FullName(),
+			ConfigMapName: gpu.MIGPartedConfigMapName,
+		})
+		if err != nil {
+			return errors.Wrap(err, "install nvidia mig manager error")
+		}
+	}
+
 	return nil
 }
 
@@ -1269,11 +1485,18 @@ func (p *Provider) EnsureGPUManager(ctx context.Context, c *v1.Cluster) error {
 		return err
 	}
 
+	var nodeSelector map[string]string
+	if c.Cluster.Spec.Features.GPUManager != nil {
+		nodeSelector = c.Cluster.Spec.Features.GPUManager.NodeSelector
+	}
 	option := map[string]interface{}{
 		"GPUManagerImage":        images.Get().GPUManager.FullName(),
 		"BusyboxImage":           images.Get().Busybox.FullName(),
 		"GPUQuotaAdmissionImage": images.Get().GPUQuotaAdmission.FullName(),
 		"GPUQuotaAdmissionHost":  c.Annotations[constants.GPUQuotaAdmissionIPAnnotaion],
+		"SharePolicy":            strings.ToLower(string(c.Cluster.GPUSharePolicy())),
+		"MemoryUnitMiB":          c.Cluster.GPUMemoryUnitMiB(),
+		"NodeSelector":           nodeSelector,
 	}
 
 	err = apiclient.CreateResourceWithFile(ctx, client, constants.GPUManagerManifest, option)
@@ -1284,6 +1507,38 @@ func (p *Provider) EnsureGPUManager(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
+func (p *Provider) EnsureRuntimeClass(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if c.Cluster.Spec.Features.SandboxRuntime == nil {
+		return nil
+	}
+
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	sandboxRuntime := c.Cluster.Spec.Features.SandboxRuntime
+	err = sandbox.InstallRuntimeClass(ctx, client, sandboxRuntime.Type)
+	if err != nil {
+		return errors.Wrap(err, "install runtime class error")
+	}
+
+	err = sandbox.InstallAdmission(ctx, client)
+	if err != nil {
+		return errors.Wrap(err, "install sandbox admission error")
+	}
+
+	err = sandbox.MarkUntrustedNamespaces(ctx, client, sandboxRuntime.Type, sandboxRuntime.UntrustedNamespaces)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureMetricsServer(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
@@ -1316,6 +1571,121 @@ func (p *Provider) EnsureMetricsServer(ctx context.Context, c *v1.Cluster) error
 	return nil
 }
 
+// EnsureImageAdmission installs or updates the image admission webhook by
+// cluster feature, and uninstalls it once the feature is removed.
+func (p *Provider) EnsureImageAdmission(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	if c.Spec.Features.ImageAdmission == nil {
+		installed, err := imageadmission.Installed(ctx, client)
+		if err != nil || !installed {
+			return err
+		}
+		return imageadmission.Uninstall(ctx, client)
+	}
+	err = imageadmission.Install(ctx, client, c.Spec.Features.ImageAdmission)
+	if err != nil {
+		return errors.Wrap(err, "install image admission error")
+	}
+
+	return nil
+}
+
+// EnsureCoreDNS renders the Corefile of CoreDNS and deploys dns-autoscaler
+// with the DNS feature, it runs after upgrades as well since kubeadm restores
+// the default Corefile.
+func (p *Provider) EnsureCoreDNS(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	err = coredns.Apply(ctx, client, c.Spec.Features.DNS, c.Spec.DNSDomain)
+	if err != nil {
+		return errors.Wrap(err, "apply CoreDNS error")
+	}
+
+	return nil
+}
+
+// EnsureNetworkCheck verifies the network connectivity of the new cluster
+// with probe pods, it fails if any check fails.
+func (p *Provider) EnsureNetworkCheck(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if !c.Spec.Features.EnableNetworkCheck {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	config, err := c.RESTConfig(&rest.Config{})
+	if err != nil {
+		return err
+	}
+	report, err := netcheck.Run(ctx, client, config, netcheck.Option{
+		Image:         images.Get().Busybox.FullName(),
+		ClusterDomain: c.Spec.DNSDomain,
+	})
+	if err != nil {
+		return errors.Wrap(err, "run network check error")
+	}
+	if len(report.Failures()) > 0 {
+		return fmt.Errorf("network check failed, %s", report.Summary())
+	}
+
+	return nil
+}
+
+// EnsureNetworkPolicy installs the default-deny NetworkPolicies and checks
+// if the CNI enforces NetworkPolicy with probe pods. The cluster without
+// enforcement is not failed, which is reported in status instead.
+func (p *Provider) EnsureNetworkPolicy(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if c.Spec.Features.NetworkPolicy == nil {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	err = networkpolicy.Apply(ctx, client, c.Spec.Features.NetworkPolicy)
+	if err != nil {
+		return errors.Wrap(err, "install default-deny NetworkPolicy error")
+	}
+	config, err := c.RESTConfig(&rest.Config{})
+	if err != nil {
+		return err
+	}
+	result, err := netcheck.RunPolicy(ctx, client, config, netcheck.Option{
+		Image: images.Get().Busybox.FullName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "run NetworkPolicy check error")
+	}
+	c.Status.NetworkPolicy = &platformv1.NetworkPolicyStatus{
+		Enforced:      result.Enforced,
+		Message:       result.Message,
+		LastProbeTime: metav1.Now(),
+	}
+	if !result.Enforced {
+		log.FromContext(ctx).Info("NetworkPolicy is not enforced", "message", result.Message)
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
@@ -1327,6 +1697,13 @@ func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 	if err := util.CleanFlannelInterfaces("cilium"); err != nil {
 		return err
 	}
+
+	return installCilium(ctx, c)
+}
+
+// installCilium creates or updates the cilium workloads, it is shared by
+// cluster creating and upgrading.
+func installCilium(ctx context.Context, c *v1.Cluster) error {
 	client, err := c.Clientset()
 	if err != nil {
 		return err
@@ -1340,15 +1717,39 @@ func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 		}
 	}
 	option := map[string]interface{}{
-		"CiliumImage":         images.Get().Cilium.FullName(),
-		"CiliumOperatorImage": images.Get().CiliumOperator.FullName(),
-		"IpamdImage":          images.Get().Ipamd.FullName(),
-		"MasqImage":           images.Get().Masq.FullName(),
-		"CiliumRouterImage":   images.Get().CiliumRouter.FullName(),
-		"NetworkMode":         networkMode,
-		"ClusterCIDR":         c.Cluster.Spec.ClusterCIDR,
-		"MaskSize":            c.Cluster.Status.NodeCIDRMaskSize,
-		"MaxNodePodNum":       c.Cluster.Spec.Properties.MaxNodePodNum,
+		"CiliumImage":          images.Get().Cilium.FullName(),
+		"CiliumOperatorImage":  images.Get().CiliumOperator.FullName(),
+		"IpamdImage":           images.Get().Ipamd.FullName(),
+		"MasqImage":            images.Get().Masq.FullName(),
+		"CiliumRouterImage":    images.Get().CiliumRouter.FullName(),
+		"NetworkMode":          networkMode,
+		"ClusterCIDR":          c.Cluster.Spec.ClusterCIDR,
+		"MaskSize":             c.Cluster.Status.NodeCIDRMaskSize,
+		"MaxNodePodNum":        c.Cluster.Spec.Properties.MaxNodePodNum,
+		"EnableIPsec":          false,
+		"KubeProxyReplacement": false,
+	}
+	if clusterSpec.Features.Cilium != nil && clusterSpec.Features.Cilium.KubeProxyReplacement {
+		// cilium can not reach apiserver by service ip without kube-proxy
+		host, err := c.HostForBootstrap()
+		if err != nil {
+			return err
+		}
+		apiserverHost, apiserverPort, err := net.SplitHostPort(host)
+		if err != nil {
+			return err
+		}
+		option["KubeProxyReplacement"] = true
+		option["APIServerHost"] = apiserverHost
+		option["APIServerPort"] = apiserverPort
+	}
+	if clusterSpec.Features.NetworkEncryption != nil &&
+		clusterSpec.Features.NetworkEncryption.Type == platformv1.NetworkEncryptionIPsec {
+		err = encryption.EnsureIPsecKeys(ctx, client)
+		if err != nil {
+			return errors.Wrap(err, "create IPsec keys error")
+		}
+		option["EnableIPsec"] = true
 	}
 
 	err = apiclient.CreateResourceWithDir(ctx, client, constants.CiliumManifest, option)
@@ -1359,6 +1760,40 @@ func (p *Provider) EnsureCilium(ctx context.Context, c *v1.Cluster) error {
 	return nil
 }
 
+func (p *Provider) EnsureCalico(ctx context.Context, c *v1.Cluster) error {
+	if c.Status.Phase == platformv1.ClusterUpscaling {
+		return nil
+	}
+	if c.Cluster.Network() != platformv1.NetworkTypeCalico {
+		return nil
+	}
+
+	return installCalico(ctx, c)
+}
+
+// installCalico creates or updates the calico custom resource definitions and
+// workloads, it is shared by cluster creating and upgrading.
+func installCalico(ctx context.Context, c *v1.Cluster) error {
+	config, err := c.RESTConfig(&rest.Config{})
+	if err != nil {
+		return err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	crdClient, err := apiextensionsclient.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	err = calico.Install(ctx, client, crdClient, calico.NewOption(c.Cluster))
+	if err != nil {
+		return errors.Wrap(err, "install Calico error")
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureCSIOperator(ctx context.Context, c *v1.Cluster) error {
 	if c.Status.Phase == platformv1.ClusterUpscaling {
 		return nil
@@ -1399,7 +1834,7 @@ func (p *Provider) EnsureKeepalivedWithLBOption(ctx context.Context, c *v1.Clust
 		return nil
 	}
 
-	ipvs := c.Spec.Features.IPVS != nil && *c.Spec.Features.IPVS
+	ipvs := c.KubeProxyMode() == platformv1.KubeProxyModeIPVS
 	kubernetesSvcIP, err := kubernetesSvcIP(c)
 	if err != nil {
 		return err
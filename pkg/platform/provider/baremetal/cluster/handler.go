@@ -19,10 +19,115 @@
 package cluster
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/spec"
 )
 
 func (p *Provider) ping(resp http.ResponseWriter, req *http.Request) {
 	fmt.Fprint(resp, "pong")
 }
+
+// versions returns the installable versions, or the upgradable versions of
+// the version specified by query parameter from, in the version catalog.
+func (p *Provider) versions(resp http.ResponseWriter, req *http.Request) {
+	catalog, err := p.getVersionCatalog(req.Context())
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	versions := catalog.Installable()
+	if from := req.URL.Query().Get("from"); from != "" {
+		versions, err = catalog.Upgradable(from)
+		if err != nil {
+			http.Error(resp, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	result := &util.VersionCatalog{Versions: []util.CatalogVersion{}}
+	for _, version := range versions {
+		v, _ := catalog.Get(version)
+		result.Versions = append(result.Versions, *v)
+	}
+
+	writeJSON(resp, result)
+}
+
+// versionComponents describes the components of a kubernetes version which
+// the provider installs, and the addon versions which work with it.
+type versionComponents struct {
+	Version        string              `json:"version"`
+	UpgradableFrom string              `json:"upgradableFrom,omitempty"`
+	Images         []string            `json:"images"`
+	Addons         map[string][]string `json:"addons"`
+}
+
+// matrix returns the components and compatible addon versions of the
+// installable kubernetes versions, or the version specified by query
+// parameter version.
+func (p *Provider) matrix(resp http.ResponseWriter, req *http.Request) {
+	catalog, err := p.getVersionCatalog(req.Context())
+	if err != nil {
+		http.Error(resp, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	versions := catalog.Installable()
+	if version := req.URL.Query().Get("version"); version != "" {
+		if _, ok := catalog.Get(version); !ok {
+			http.Error(resp, fmt.Sprintf("version %s is not supported", version), http.StatusNotFound)
+			return
+		}
+		versions = []string{version}
+	}
+
+	result := []versionComponents{}
+	for _, version := range versions {
+		v, _ := catalog.Get(version)
+		components := versionComponents{
+			Version:        version,
+			UpgradableFrom: v.UpgradableFrom,
+			Images:         images.ListKubernetesImageFullNamesWithVerion(version),
+			Addons:         map[string][]string{},
+		}
+		for _, addon := range compatibility.Addons() {
+			addonVersions, err := compatibility.Compatible(addon, version)
+			if err != nil {
+				http.Error(resp, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			components.Addons[addon] = addonVersions
+		}
+		result = append(result, components)
+	}
+
+	writeJSON(resp, result)
+}
+
+func (p *Provider) getVersionCatalog(ctx context.Context) (*util.VersionCatalog, error) {
+	if p.platformClient == nil {
+		catalog := &util.VersionCatalog{}
+		for _, version := range spec.K8sVersions {
+			catalog.Versions = append(catalog.Versions, util.CatalogVersion{Version: version})
+		}
+		return catalog, nil
+	}
+	client, err := util.BuildExternalClientSetWithName(ctx, p.platformClient, "global")
+	if err != nil {
+		return nil, err
+	}
+
+	return util.GetVersionCatalog(ctx, client)
+}
+
+func writeJSON(resp http.ResponseWriter, v interface{}) {
+	resp.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(resp).Encode(v)
+}
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/spec"
+)
+
+func TestVersions(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"installable", "", spec.K8sVersions},
+		{"upgradable", "?from=1.19.7", []string{"1.20.4-tke.1", "1.20.4", "1.18.3"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{}
+			w := httptest.NewRecorder()
+			p.versions(w, httptest.NewRequest(http.MethodGet, "/versions"+tt.query, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("versions() status = %d, body %s", w.Code, w.Body.String())
+			}
+			catalog := &util.VersionCatalog{}
+			if err := json.Unmarshal(w.Body.Bytes(), catalog); err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, v := range catalog.Versions {
+				got = append(got, v.Version)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("versions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
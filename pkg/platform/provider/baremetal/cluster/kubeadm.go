@@ -21,23 +21,35 @@ package cluster
 import (
 	"fmt"
 	"net"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/imdario/mergo"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	utilsnet "k8s.io/utils/net"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/audit/sink"
 	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
 	kubeletv1beta1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubelet/config/v1beta1"
 	kubeproxyv1alpha1 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeproxy/config/v1alpha1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/json"
 	"tkestack.io/tke/pkg/util/version"
 )
 
+const (
+	auditLogVolumeName  = "audit-log"
+	kmsSocketVolumeName = "kms-socket"
+)
+
 func (p *Provider) getKubeadmInitConfig(c *v1.Cluster) *kubeadm.InitConfig {
 	config := new(kubeadm.InitConfig)
 	config.InitConfiguration = p.getInitConfiguration(c)
@@ -157,7 +169,7 @@ func (p *Provider) getClusterConfiguration(c *v1.Cluster) *kubeadmv1beta2.Cluste
 		APIServer: kubeadmv1beta2.APIServer{
 			ControlPlaneComponent: kubeadmv1beta2.ControlPlaneComponent{
 				ExtraArgs:    p.getAPIServerExtraArgs(c),
-				ExtraVolumes: []kubeadmv1beta2.HostPathMount{kubernetesVolume},
+				ExtraVolumes: append(append([]kubeadmv1beta2.HostPathMount{kubernetesVolume}, p.getAuditVolumes(c)...), p.getSecretsEncryptionVolumes(c)...),
 			},
 			CertSANs: GetAPIServerCertSANs(c.Cluster),
 		},
@@ -186,17 +198,47 @@ func (p *Provider) getClusterConfiguration(c *v1.Cluster) *kubeadmv1beta2.Cluste
 	utilruntime.Must(json.Merge(&config.Etcd, &c.Spec.Etcd))
 	if config.Etcd.Local != nil {
 		config.Etcd.Local.ImageTag = images.Get().ETCD.Tag
+		if c.Spec.Etcd != nil && c.Spec.Etcd.Local != nil {
+			if config.Etcd.Local.ExtraArgs == nil {
+				config.Etcd.Local.ExtraArgs = make(map[string]string)
+			}
+			for k, v := range getEtcdTuningArgs(c.Spec.Etcd.Local) {
+				config.Etcd.Local.ExtraArgs[k] = v
+			}
+		}
 	}
 
 	return config
 }
 
+func getEtcdTuningArgs(local *platformv1.LocalEtcd) map[string]string {
+	args := make(map[string]string)
+	if local.QuotaBackendBytes != nil {
+		args["quota-backend-bytes"] = strconv.FormatInt(*local.QuotaBackendBytes, 10)
+	}
+	if local.HeartbeatInterval != nil {
+		args["heartbeat-interval"] = strconv.Itoa(int(*local.HeartbeatInterval))
+	}
+	if local.ElectionTimeout != nil {
+		args["election-timeout"] = strconv.Itoa(int(*local.ElectionTimeout))
+	}
+
+	return args
+}
+
 func (p *Provider) getKubeProxyConfiguration(c *v1.Cluster) *kubeproxyv1alpha1.KubeProxyConfiguration {
 	config := &kubeproxyv1alpha1.KubeProxyConfiguration{}
-	config.Mode = "iptables"
-	if c.Spec.Features.IPVS != nil && *c.Spec.Features.IPVS {
-		config.Mode = "ipvs"
+	mode := c.KubeProxyMode()
+	config.Mode = kubeproxyv1alpha1.ProxyMode(mode)
+	if mode == platformv1.KubeProxyModeIPVS {
 		config.ClusterCIDR = c.Spec.ClusterCIDR
+		config.IPVS.Scheduler = tuning.DefaultIPVSScheduler
+		if kp := c.Spec.Features.KubeProxy; kp != nil {
+			if kp.IPVSScheduler != "" {
+				config.IPVS.Scheduler = kp.IPVSScheduler
+			}
+			config.IPVS.StrictARP = kp.StrictARP
+		}
 		if c.Spec.Features.HA != nil {
 			if c.Spec.Features.HA.TKEHA != nil {
 				config.IPVS.ExcludeCIDRs = []string{fmt.Sprintf("%s/32", c.Spec.Features.HA.TKEHA.VIP)}
@@ -223,7 +265,9 @@ func (p *Provider) getKubeletConfiguration(c *v1.Cluster) *kubeletv1beta1.Kubele
 			"cpu":    "100m",
 			"memory": "500Mi",
 		},
-		MaxPods: *c.Spec.Properties.MaxNodePodNum,
+		MaxPods:            *c.Spec.Properties.MaxNodePodNum,
+		ServerTLSBootstrap: c.KubeletServerTLSBootstrapEnabled(),
+		CgroupDriver:       c.CgroupDriver(),
 	}
 }
 
@@ -231,13 +275,20 @@ func (p *Provider) getAPIServerExtraArgs(c *v1.Cluster) map[string]string {
 	args := map[string]string{
 		"token-auth-file": constants.TokenFile,
 	}
-	if p.config.AuditEnabled() {
-		args["audit-policy-file"] = constants.KubernetesAuditPolicyConfigFile
-		args["audit-webhook-config-file"] = constants.KubernetesAuditWebhookConfigFile
+	for k, v := range p.getAuditExtraArgs(c) {
+		args[k] = v
+	}
+	if c.Spec.Features.SecretsEncryption != nil {
+		args["encryption-provider-config"] = constants.KubernetesEncryptionConfigFile
 	}
 	if c.AuthzWebhookEnabled() {
 		args["authorization-webhook-config-file"] = constants.KubernetesAuthzWebhookConfigFile
 		args["authorization-mode"] = "Node,RBAC,Webhook"
+		// The tokens of the kubeconfigs issued by tke-auth are authenticated
+		// by its token webhook, the short cache ttl bounds the delay of
+		// revoking them.
+		args["authentication-token-webhook-config-file"] = constants.KubernetesAuthnWebhookConfigFile
+		args["authentication-token-webhook-cache-ttl"] = "30s"
 	}
 	for k, v := range c.Spec.APIServerExtraArgs {
 		args[k] = v
@@ -249,6 +300,95 @@ func (p *Provider) getAPIServerExtraArgs(c *v1.Cluster) map[string]string {
 	return args
 }
 
+func (p *Provider) getAuditExtraArgs(c *v1.Cluster) map[string]string {
+	args := map[string]string{}
+	audit := c.Spec.Features.Audit
+	if audit == nil {
+		if p.config.AuditEnabled() {
+			args["audit-policy-file"] = constants.KubernetesAuditPolicyConfigFile
+			args["audit-webhook-config-file"] = constants.KubernetesAuditWebhookConfigFile
+		}
+		return args
+	}
+
+	args["audit-policy-file"] = constants.KubernetesAuditPolicyConfigFile
+	if audit.Log != nil {
+		args["audit-log-path"] = auditLogPath(audit.Log)
+		args["audit-log-maxage"] = strconv.Itoa(int(audit.Log.MaxAge))
+		args["audit-log-maxbackup"] = strconv.Itoa(int(audit.Log.MaxBackup))
+		args["audit-log-maxsize"] = strconv.Itoa(int(audit.Log.MaxSize))
+	}
+	if p.auditWebhookAddress(c) != "" {
+		args["audit-webhook-config-file"] = constants.KubernetesAuditWebhookConfigFile
+	}
+
+	return args
+}
+
+// getAuditVolumes returns the volume of audit log dir which should be mounted into apiserver.
+func (p *Provider) getAuditVolumes(c *v1.Cluster) []kubeadmv1beta2.HostPathMount {
+	audit := c.Spec.Features.Audit
+	if audit == nil || audit.Log == nil {
+		return nil
+	}
+	dir := filepath.Dir(auditLogPath(audit.Log))
+	return []kubeadmv1beta2.HostPathMount{
+		{
+			Name:      auditLogVolumeName,
+			HostPath:  dir,
+			MountPath: dir,
+			PathType:  corev1.HostPathDirectoryOrCreate,
+		},
+	}
+}
+
+// getSecretsEncryptionVolumes returns the volume of KMS plugin socket dir which should be mounted into apiserver.
+func (p *Provider) getSecretsEncryptionVolumes(c *v1.Cluster) []kubeadmv1beta2.HostPathMount {
+	encryption := c.Spec.Features.SecretsEncryption
+	if encryption == nil || encryption.KMS == nil {
+		return nil
+	}
+	dir := filepath.Dir(strings.TrimPrefix(encryption.KMS.Endpoint, "unix://"))
+	return []kubeadmv1beta2.HostPathMount{
+		{
+			Name:      kmsSocketVolumeName,
+			HostPath:  dir,
+			MountPath: dir,
+			PathType:  corev1.HostPathDirectoryOrCreate,
+		},
+	}
+}
+
+func (p *Provider) auditWebhookAddress(c *v1.Cluster) string {
+	audit := c.Spec.Features.Audit
+	if audit == nil {
+		return p.config.Audit.Address
+	}
+	if audit.Webhook == nil {
+		return ""
+	}
+	if audit.Webhook.Address != "" {
+		return audit.Webhook.Address
+	}
+	return p.config.Audit.Address
+}
+
+// auditWebhookToken returns the token of the cluster derived from the token
+// of the sink of the platform, which is not sent to the other webhook addresses.
+func (p *Provider) auditWebhookToken(c *v1.Cluster, address string) string {
+	if address != p.config.Audit.Address || p.config.Audit.Token == "" {
+		return ""
+	}
+	return sink.ClusterToken(p.config.Audit.Token, c.Name)
+}
+
+func auditLogPath(backend *platformv1.AuditLogBackend) string {
+	if backend.Path != "" {
+		return backend.Path
+	}
+	return constants.AuditLogFile
+}
+
 func (p *Provider) getControllerManagerExtraArgs(c *v1.Cluster) map[string]string {
 	args := map[string]string{
 		"allocate-node-cidrs": "true",
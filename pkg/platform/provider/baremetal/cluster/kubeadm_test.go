@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestGetKubeProxyConfiguration(t *testing.T) {
+	enabled := true
+	tests := []struct {
+		name          string
+		features      platformv1.ClusterFeature
+		clusterCIDR   string
+		wantMode      string
+		wantScheduler string
+		wantStrictARP bool
+		wantExclude   []string
+		wantBind      string
+	}{
+		{name: "default", clusterCIDR: "10.244.0.0/16", wantMode: "iptables"},
+		{name: "ipvs feature", features: platformv1.ClusterFeature{IPVS: &enabled}, clusterCIDR: "10.244.0.0/16",
+			wantMode: "ipvs", wantScheduler: "rr"},
+		{name: "ipvs options", features: platformv1.ClusterFeature{
+			KubeProxy: &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeIPVS, IPVSScheduler: "wrr", StrictARP: true},
+			HA:        &platformv1.HA{TKEHA: &platformv1.TKEHA{VIP: "10.0.0.100"}},
+		}, clusterCIDR: "10.244.0.0/16", wantMode: "ipvs", wantScheduler: "wrr", wantStrictARP: true, wantExclude: []string{"10.0.0.100/32"}},
+		{name: "nftables", features: platformv1.ClusterFeature{
+			KubeProxy: &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeNFTables},
+		}, clusterCIDR: "10.244.0.0/16", wantMode: "nftables"},
+		{name: "ipv6", clusterCIDR: "fd00::/104", wantMode: "iptables", wantBind: "::"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &v1.Cluster{Cluster: &platformv1.Cluster{
+				Spec: platformv1.ClusterSpec{ClusterCIDR: tt.clusterCIDR, Features: tt.features},
+			}}
+			got := (&Provider{}).getKubeProxyConfiguration(c)
+			if string(got.Mode) != tt.wantMode {
+				t.Errorf("mode = %q, want %q", got.Mode, tt.wantMode)
+			}
+			if got.IPVS.Scheduler != tt.wantScheduler || got.IPVS.StrictARP != tt.wantStrictARP {
+				t.Errorf("ipvs = %+v, want scheduler %q and strictARP %v", got.IPVS, tt.wantScheduler, tt.wantStrictARP)
+			}
+			if !reflect.DeepEqual(got.IPVS.ExcludeCIDRs, tt.wantExclude) {
+				t.Errorf("ipvs excludeCIDRs = %v, want %v", got.IPVS.ExcludeCIDRs, tt.wantExclude)
+			}
+			if got.BindAddress != tt.wantBind {
+				t.Errorf("bindAddress = %q, want %q", got.BindAddress, tt.wantBind)
+			}
+		})
+	}
+}
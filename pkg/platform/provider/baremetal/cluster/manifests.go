@@ -67,10 +67,19 @@ clusters:
     cluster:
       server: {{.AuditBackendAddress}}/apis/audit.tkestack.io/v1/events/sink/{{.ClusterName}}
       insecure-skip-tls-verify: true
+{{- if .Token }}
+users:
+  - name: tke
+    user:
+      token: {{.Token}}
+{{- end }}
 current-context: tke
 contexts:
   - context:
       cluster: tke
+{{- if .Token }}
+      user: tke
+{{- end }}
     name: tke
 `
 )
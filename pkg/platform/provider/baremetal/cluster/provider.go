@@ -19,6 +19,7 @@
 package cluster
 
 import (
+	"os"
 	"path"
 	"strings"
 
@@ -31,6 +32,7 @@ import (
 	"tkestack.io/tke/pkg/platform/provider/baremetal/config"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	csioperatorimage "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/validation"
 	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
 	"tkestack.io/tke/pkg/platform/types"
@@ -77,20 +79,26 @@ func NewProvider() (*Provider, error) {
 			p.EnsureInitAPIServerHost,
 			p.EnsureKernelModule,
 			p.EnsureSysctl,
+			p.EnsureSystemTuning,
+			p.EnsureTimeSync,
 			p.EnsureDisableSwap,
 			p.EnsurePreflight, // wait basic setting done
+			p.EnsureEtcdDataDevice,
 
 			p.EnsureClusterComplete,
 
 			// install packages
 			p.EnsureNvidiaDriver,
 			p.EnsureNvidiaContainerRuntime,
+			p.EnsureSandboxRuntime,
 			p.EnsureDocker,
 			p.EnsureKubernetesImages,
 			p.EnsureKubelet,
 			p.EnsureCNIPlugins,
 			p.EnsureConntrackTools,
+			p.EnsureWireGuard,
 			p.EnsureKubeadm,
+			p.EnsureServiceOverrides,
 			p.EnsureKeepalivedInit,
 			p.EnsureThirdPartyHAInit,
 			p.EnsureAuthzWebhook,
@@ -111,6 +119,7 @@ func NewProvider() (*Provider, error) {
 
 			p.EnsureGalaxy,
 			p.EnsureCilium,
+			p.EnsureCalico,
 
 			p.EnsureJoinPhasePreflight,
 			p.EnsureJoinPhaseControlPlanePrepare,
@@ -119,6 +128,7 @@ func NewProvider() (*Provider, error) {
 			p.EnsureJoinPhaseControlPlaneJoinUpdateStatus,
 
 			p.EnsurePatchAnnotation, // wait rest master ready
+			p.EnsureStaticPodOverrides,
 			p.EnsureMarkControlPlane,
 			p.EnsureKeepalivedWithLBOption,
 			p.EnsureThirdPartyHA,
@@ -126,8 +136,13 @@ func NewProvider() (*Provider, error) {
 			// deploy apps
 			p.EnsureNvidiaDevicePlugin,
 			p.EnsureGPUManager,
+			p.EnsureRuntimeClass,
 			p.EnsureCSIOperator,
 			p.EnsureMetricsServer,
+			p.EnsureCoreDNS,
+			p.EnsureImageAdmission,
+			p.EnsureNetworkCheck,
+			p.EnsureNetworkPolicy,
 
 			p.EnsureCleanup,
 			p.EnsureCreateClusterMark,
@@ -141,11 +156,30 @@ func NewProvider() (*Provider, error) {
 			p.EnsureStoreCredential,
 			p.EnsureKeepalivedWithLBOption,
 			p.EnsureThirdPartyHA,
+			p.EnsureNetworkEncryptionKeyRotation,
+			p.EnsureGalaxyNetwork,
+			p.EnsureImageAdmission,
+			p.EnsureAudit,
+			p.EnsureSecretsEncryption,
+			p.EnsureStaticPodOverrides,
+			p.EnsureContainerRegistries,
+			p.EnsureSystemTuning,
+			p.EnsureServiceOverrides,
+			p.EnsureNvidiaDevicePlugin,
+			p.EnsureGPUManager,
+			p.EnsureCoreDNS,
+			p.EnsureEtcdMaintenance,
+			p.EnsureUpgradeWorkerNodes,
 		},
 		UpgradeHandlers: []clusterprovider.Handler{
 			p.EnsurePreClusterUpgradeHook,
+			p.EnsureEtcdSnapshot,
+			p.EnsureUpgradeCanary,
 			p.EnsureUpgradeCoreDNS,
 			p.EnsureUpgradeControlPlaneNode,
+			p.EnsureUpgradeCNI,
+			p.EnsureUpgradeAddons,
+			p.EnsureCoreDNS,
 			p.EnsurePostClusterUpgradeHook,
 		},
 		ScaleDownHandlers: []clusterprovider.Handler{
@@ -165,6 +199,11 @@ func NewProvider() (*Provider, error) {
 	p.config = cfg
 
 	containerregistry.Init(cfg.Registry.Domain, cfg.Registry.Namespace)
+	res.Init(cfg.ArtifactServer.URL)
+	// only the components with packages serve them, e.g. tke-platform-controller
+	if _, err := os.Stat(constants.SrcDir); err == nil && cfg.ArtifactServer.BindAddress != "" {
+		go res.ServeArtifacts(cfg.ArtifactServer.BindAddress, constants.SrcDir)
+	}
 
 	// Run for compatibility with installer.
 	// TODO: Installer reuse platform components
@@ -187,6 +226,8 @@ func (p *Provider) RegisterHandler(mux *mux.PathRecorderMux) {
 	prefix := "/provider/" + strings.ToLower(p.Name())
 
 	mux.HandleFunc(path.Join(prefix, "ping"), p.ping)
+	mux.HandleFunc(path.Join(prefix, "versions"), p.versions)
+	mux.HandleFunc(path.Join(prefix, "matrix"), p.matrix)
 }
 
 func (p *Provider) Validate(cluster *types.Cluster) field.ErrorList {
@@ -204,12 +245,27 @@ func (p *Provider) PreCreate(cluster *types.Cluster) error {
 		cluster.Spec.NetworkDevice = "eth0"
 
 	}
+	if cluster.Spec.Features.KubeletServerTLSBootstrap == nil {
+		cluster.Spec.Features.KubeletServerTLSBootstrap = pointer.ToBool(true)
+	}
+	if cluster.Spec.Features.CgroupDriver == "" {
+		cluster.Spec.Features.CgroupDriver = platform.CgroupDriverSystemd
+	}
 	if cluster.Spec.Features.CSIOperator != nil {
 		if cluster.Spec.Features.CSIOperator.Version == "" {
 			cluster.Spec.Features.CSIOperator.Version = csioperatorimage.LatestVersion
 		}
 	}
 
+	if audit := cluster.Spec.Features.Audit; audit != nil {
+		if audit.Log != nil && audit.Log.Path == "" {
+			audit.Log.Path = constants.AuditLogFile
+		}
+		if audit.Webhook != nil && audit.Webhook.Address == "" {
+			audit.Webhook.Address = p.config.Audit.Address
+		}
+	}
+
 	if p.config.AuditEnabled() {
 		if !cluster.AuthzWebhookEnabled() {
 			cluster.Spec.Features.AuthzWebhookAddr = &platform.AuthzWebhookAddr{Builtin: &platform.
@@ -231,14 +287,25 @@ func (p *Provider) PreCreate(cluster *types.Cluster) error {
 		cluster.Spec.Properties.MaxNodePodNum = pointer.ToInt32(256)
 	}
 	// append SkipConditions when disable the cluster features.
-	if cluster.Spec.Features.EnableCilium {
-		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureGalaxy")
-	} else {
-		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureCilium")
+	cluster.Spec.NetworkType = cluster.Network()
+	cluster.Spec.Features.EnableCilium = cluster.Spec.NetworkType == platform.NetworkTypeCilium
+	if cluster.Spec.NetworkType == platform.NetworkTypeCalico && cluster.Spec.Features.Calico == nil {
+		cluster.Spec.Features.Calico = &platform.CalicoNetwork{}
+	}
+	if cluster.Spec.Features.Calico != nil && cluster.Spec.Features.Calico.Mode == "" {
+		cluster.Spec.Features.Calico.Mode = platform.CalicoModeVXLAN
+	}
+	for _, networkType := range []platform.NetworkType{platform.NetworkTypeGalaxy, platform.NetworkTypeCilium, platform.NetworkTypeCalico} {
+		if networkType != cluster.Spec.NetworkType {
+			cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "Ensure"+string(networkType))
+		}
 	}
 	if !cluster.Spec.Features.EnableMetricsServer {
 		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureMetricsServer")
 	}
+	if !cluster.Spec.Features.EnableNetworkCheck {
+		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, "EnsureNetworkCheck")
+	}
 	if p.config.Feature.SkipConditions != nil {
 		cluster.Spec.Features.SkipConditions = append(cluster.Spec.Features.SkipConditions, p.config.Feature.SkipConditions...)
 	}
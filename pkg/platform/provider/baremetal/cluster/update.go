@@ -19,69 +19,848 @@
 package cluster
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 	certutil "k8s.io/client-go/util/cert"
+	sigsyaml "sigs.k8s.io/yaml"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/canary"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/docker"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/drift"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/encryption"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/etcd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/secretsencryption"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/staticpod"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/systemd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
+	clusterprovider "tkestack.io/tke/pkg/platform/provider/cluster"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
 	"tkestack.io/tke/pkg/util/version"
 )
 
+// rotatedCertificates are the certificates renewed by kubeadm which are tracked
+// in cluster status, the etcd ones are absent with external etcd.
+var rotatedCertificates = []string{
+	constants.APIServerCertName,
+	constants.APIServerKubeletClientCertName,
+	constants.APIServerEtcdClientCertName,
+	constants.FrontProxyClientCertName,
+	constants.EtcdServerCertName,
+	constants.EtcdPeerCertName,
+	constants.EtcdHealthcheckClientCertName,
+}
+
+// trackedCertificates are all the certificates tracked in cluster status, the
+// CA certificates and the kubelet client certificate are not renewed by kubeadm.
+var trackedCertificates = append([]string{
+	constants.CACertName,
+	constants.FrontProxyCACertName,
+	constants.EtcdCACertName,
+	constants.KubeletClientCurrent,
+}, rotatedCertificates...)
+
+// EnsureRenewCerts records the expiration of control plane certificates, and
+// renews them on masters one by one before expiration or on demand. The
+// masters are probed again only if the recorded certificates are about to
+// expire or are out of date.
 func (p *Provider) EnsureRenewCerts(ctx context.Context, c *v1.Cluster) error {
+	logger := log.FromContext(ctx)
+	now := time.Now()
+	threshold := renewCertsThreshold(c)
+	if !needProbeCerts(c, threshold, now) {
+		return nil
+	}
+
+	expirations, err := getCertificateExpirations(c)
+	if err != nil {
+		logger.Error(err, "get certificate expirations error")
+		return nil
+	}
+	if c.Status.Certificates == nil {
+		c.Status.Certificates = &platformv1.CertificatesStatus{}
+	}
+	c.Status.Certificates.Certificates = expirations
+	for _, one := range expirations {
+		if !isRotatedCertificate(one.Name) && one.NotAfter.Sub(now) <= threshold {
+			logger.Info("Certificate is about to expire and is not renewed by kubeadm",
+				"name", one.Name, "node", one.Node, "notAfter", one.NotAfter.String())
+		}
+	}
+
+	reason := needRenewCerts(c, expirations, threshold, now)
+	if reason == "" {
+		return nil
+	}
+
+	err = p.snapshotEtcd(ctx, c, "Renew certificates")
+	if err != nil {
+		return err
+	}
+	for _, machine := range c.Spec.Machines {
+		logger := logger.WithValues("node", machine.IP)
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		logger.Info("RenewCerts doing", "reason", reason)
+		err = kubeadm.RenewCerts(s)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		// etcd only loads certificates on start
+		ok, err := s.Exist(constants.EtcdPodManifestFile)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		if ok {
+			err = kubeadm.RestartContainerByFilter(s, kubeadm.DockerFilterForControlPlane("etcd"))
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
+			}
+		}
+		err = waitAPIServerHealthy(s)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		logger.Info("RenewCerts done")
+	}
+
+	expirations, err = getCertificateExpirations(c)
+	if err != nil {
+		return err
+	}
+	c.Status.Certificates.Certificates = expirations
+	c.Status.Certificates.LastRotationTime = metav1.Now()
+
+	return nil
+}
+
+// renewCertsThreshold returns how long before the expiration certificates are
+// renewed.
+func renewCertsThreshold(c *v1.Cluster) time.Duration {
+	rotation := c.Spec.Features.CertificateRotation
+	if rotation != nil && rotation.RenewBefore != "" {
+		renewBefore, err := time.ParseDuration(rotation.RenewBefore)
+		if err == nil {
+			return renewBefore
+		}
+	}
+	return constants.RenewCertsTimeThreshold
+}
+
+// rotationRequested returns true if a rotation is requested after the last one.
+func rotationRequested(c *v1.Cluster, now time.Time) bool {
+	rotation := c.Spec.Features.CertificateRotation
+	if rotation == nil || rotation.RotateRequestedAt == nil || rotation.RotateRequestedAt.After(now) {
+		return false
+	}
+	return c.Status.Certificates == nil || c.Status.Certificates.LastRotationTime.Before(rotation.RotateRequestedAt)
+}
+
+// needProbeCerts returns true if the certificate expirations recorded in
+// status can't be trusted: no record of some masters, a rotation is requested
+// or some certificates are about to expire.
+func needProbeCerts(c *v1.Cluster, threshold time.Duration, now time.Time) bool {
+	if c.Status.Certificates == nil || rotationRequested(c, now) {
+		return true
+	}
+	// every master has a CA certificate, the record predates the tracking of
+	// CA certificates or the masters are changed if any is missing
+	caNodes := sets.NewString()
+	for _, one := range c.Status.Certificates.Certificates {
+		if one.Name == strings.TrimPrefix(constants.CACertName, constants.CertificatesDir) {
+			caNodes.Insert(one.Node)
+		}
+		if one.NotAfter.Sub(now) <= threshold {
+			return true
+		}
+	}
+	masters := sets.NewString()
+	for _, machine := range c.Spec.Machines {
+		masters.Insert(machine.IP)
+	}
+
+	return !caNodes.Equal(masters)
+}
+
+// needRenewCerts returns the reason to renew certificates, empty if no need.
+func needRenewCerts(c *v1.Cluster, expirations []platformv1.CertificateExpiration, threshold time.Duration, now time.Time) string {
+	rotation := c.Spec.Features.CertificateRotation
+	if rotationRequested(c, now) {
+		return "requested at " + rotation.RotateRequestedAt.String()
+	}
+	if rotation != nil && rotation.Mode == platformv1.CertificateRotationManual {
+		return ""
+	}
+
+	for _, one := range expirations {
+		if isRotatedCertificate(one.Name) && one.NotAfter.Sub(now) <= threshold {
+			return fmt.Sprintf("%s on %s expires at %s", one.Name, one.Node, one.NotAfter.String())
+		}
+	}
+
+	return ""
+}
+
+func isRotatedCertificate(name string) bool {
+	for _, file := range rotatedCertificates {
+		if strings.TrimPrefix(file, constants.CertificatesDir) == name {
+			return true
+		}
+	}
+	return false
+}
+
+func getCertificateExpirations(c *v1.Cluster) ([]platformv1.CertificateExpiration, error) {
+	var expirations []platformv1.CertificateExpiration
+	for _, machine := range c.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range trackedCertificates {
+			data, err := readFileIfExist(s, file)
+			if err != nil {
+				return nil, errors.Wrap(err, machine.IP)
+			}
+			if len(data) == 0 {
+				continue
+			}
+			certs, err := certutil.ParseCertsPEM(data)
+			if err != nil {
+				return nil, errors.Wrapf(err, "%s %s", machine.IP, file)
+			}
+			expirations = append(expirations, platformv1.CertificateExpiration{
+				Name:     strings.TrimPrefix(file, constants.CertificatesDir),
+				Node:     machine.IP,
+				NotAfter: metav1.NewTime(certs[0].NotAfter),
+			})
+		}
+	}
+
+	return expirations, nil
+}
+
+func (p *Provider) EnsureNetworkEncryptionKeyRotation(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.NetworkEncryption == nil {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	err = encryption.RotateKeys(ctx, client, c.Spec.Features.NetworkEncryption)
+	if err != nil {
+		return errors.Wrap(err, "rotate network encryption keys error")
+	}
+
+	return nil
+}
+
+// EnsureGalaxyNetwork reconciles the underlay device and floating IP pools of
+// Galaxy with the cluster.
+func (p *Provider) EnsureGalaxyNetwork(ctx context.Context, c *v1.Cluster) error {
+	if c.Cluster.Network() != platformv1.NetworkTypeGalaxy || c.Spec.Features.GalaxyNetwork == nil {
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	err = galaxy.ApplyNetwork(ctx, client, &galaxy.Option{
+		NetDevice: c.Spec.NetworkDevice,
+		Network:   c.Spec.Features.GalaxyNetwork,
+	})
+	if err != nil {
+		return errors.Wrap(err, "apply galaxy network error")
+	}
+
+	return nil
+}
+
+// EnsureAudit applies the audit policy and backends to apiserver on masters one
+// by one, and waits for apiserver to be healthy before moving to the next one.
+// The clusters without audit settings are changed to send the audit events to
+// the platform audit component only if the rollout to the existing clusters is
+// enabled, as it restarts their apiservers. The clusters already sending the
+// events to the platform are migrated to the token of their own.
+func (p *Provider) EnsureAudit(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.Audit == nil {
+		if !p.config.AuditEnabled() {
+			return nil
+		}
+		if !p.config.Audit.RolloutToExistingClusters {
+			// the clusters created with the audit webhook of platform are
+			// kept up to date, such as the token of the sink
+			installed, err := auditWebhookInstalled(c)
+			if err != nil || !installed {
+				return err
+			}
+		}
+	}
+	files, err := p.getAuditFiles(c)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	return p.rollAPIServerConfig(ctx, c, p.getAuditAPIServerConfig(c, files))
+}
+
+func (p *Provider) getAuditAPIServerConfig(c *v1.Cluster, files map[string][]byte) *apiServerConfig {
+	config := &apiServerConfig{
+		files:      files,
+		argPrefix:  "audit-",
+		args:       map[string]string{},
+		volumeName: auditLogVolumeName,
+		volumes:    p.getAuditVolumes(c),
+	}
+	for k, v := range p.getAPIServerExtraArgs(c) {
+		if strings.HasPrefix(k, config.argPrefix) {
+			config.args[k] = v
+		}
+	}
+	return config
+}
+
+// auditWebhookInstalled tests if the audit webhook is configured on the first master.
+func auditWebhookInstalled(c *v1.Cluster) (bool, error) {
+	s, err := c.Spec.Machines[0].SSH()
+	if err != nil {
+		return false, err
+	}
+	installed, err := s.Exist(constants.KubernetesAuditWebhookConfigFile)
+	if err != nil {
+		return false, errors.Wrap(err, c.Spec.Machines[0].IP)
+	}
+	return installed, nil
+}
+
+// EnsureSecretsEncryption enables the encryption of secrets and rotates keys.
+// Each call converges all masters to the config of the first master, then
+// applies the next step to all of them.
+func (p *Provider) EnsureSecretsEncryption(ctx context.Context, c *v1.Cluster) error {
+	encryption := c.Spec.Features.SecretsEncryption
+	if encryption == nil {
+		return nil
+	}
+	s, err := c.Spec.Machines[0].SSH()
+	if err != nil {
+		return err
+	}
+	current, err := readFileIfExist(s, constants.KubernetesEncryptionConfigFile)
+	if err != nil {
+		return errors.Wrap(err, c.Spec.Machines[0].IP)
+	}
+	if len(current) != 0 {
+		err = p.rollAPIServerConfig(ctx, c, p.getSecretsEncryptionAPIServerConfig(c, current))
+		if err != nil {
+			return err
+		}
+	}
+
+	next, step, err := secretsencryption.NextConfig(current, encryption, time.Now())
+	if err != nil {
+		return err
+	}
+	if step == secretsencryption.StepNone && bytes.Equal(current, next) {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	if step == secretsencryption.StepRetireKey {
+		client, err := c.Clientset()
+		if err != nil {
+			return err
+		}
+		logger.Info("Migrate secrets to the primary key")
+		err = secretsencryption.MigrateSecrets(ctx, client)
+		if err != nil {
+			return err
+		}
+	}
+	logger.Info("Apply secrets encryption config", "step", step)
+
+	return p.rollAPIServerConfig(ctx, c, p.getSecretsEncryptionAPIServerConfig(c, next))
+}
+
+func (p *Provider) getSecretsEncryptionAPIServerConfig(c *v1.Cluster, config []byte) *apiServerConfig {
+	return &apiServerConfig{
+		files: map[string][]byte{
+			constants.KubernetesEncryptionConfigFile: config,
+		},
+		argPrefix: "encryption-provider-config",
+		args: map[string]string{
+			"encryption-provider-config": constants.KubernetesEncryptionConfigFile,
+		},
+		volumeName: kmsSocketVolumeName,
+		volumes:    p.getSecretsEncryptionVolumes(c),
+	}
+}
+
+// EnsureStaticPodOverrides injects the ControlPlaneOverrides into the static pod
+// manifests on masters one by one. The manifests regenerated by kubeadm, such as
+// by upgrades, are patched again on the next update.
+func (p *Provider) EnsureStaticPodOverrides(ctx context.Context, c *v1.Cluster) error {
+	machines := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	overrides := c.Spec.ControlPlaneOverrides
+	if overrides == nil {
+		// remove the items injected before
+		overrides = &platformv1.ControlPlaneOverrides{}
+	}
+	components := []struct {
+		name     string
+		file     string
+		override *platformv1.StaticPodOverride
+	}{
+		{"kube-apiserver", constants.KubeAPIServerPodManifestFile, overrides.APIServer},
+		{"kube-controller-manager", constants.KubeControllerManagerPodManifestFile, overrides.ControllerManager},
+		{"kube-scheduler", constants.KubeSchedulerPodManifestFile, overrides.Scheduler},
+		{"etcd", constants.EtcdPodManifestFile, overrides.Etcd},
+	}
+	for _, machine := range machines {
+		logger := log.FromContext(ctx).WithValues("node", machine.IP)
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+
+		for _, component := range components {
+			manifest, err := readFileIfExist(s, component.file)
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
+			}
+			// no manifest for external etcd
+			if len(manifest) == 0 {
+				continue
+			}
+			manifest, changed, err := staticpod.ApplyOverride(manifest, component.override)
+			if err != nil {
+				return errors.Wrapf(err, "%s %s", machine.IP, component.name)
+			}
+			if !changed {
+				continue
+			}
+
+			logger.Info("Apply static pod override", "component", component.name)
+			filter := kubeadm.DockerFilterForControlPlane(component.name)
+			oldID, _ := s.CombinedOutput(fmt.Sprintf("docker ps -q -f '%s'", filter))
+			err = s.WriteFile(bytes.NewReader(manifest), component.file)
+			if err != nil {
+				return errors.Wrap(err, machine.IP)
+			}
+			err = waitContainerReplaced(s, filter, string(oldID))
+			if err != nil {
+				return errors.Wrapf(err, "%s %s", machine.IP, component.name)
+			}
+			if component.name == "kube-apiserver" {
+				err = waitAPIServerHealthy(s)
+				if err != nil {
+					return errors.Wrap(err, machine.IP)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// EnsureContainerRegistries applies the ContainerRegistries to the container
+// runtime of masters and worker machines. Docker is reloaded only on the nodes
+// whose configuration is changed.
+func (p *Provider) EnsureContainerRegistries(ctx context.Context, c *v1.Cluster) error {
+	for _, machine := range c.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+		err = p.applyContainerRegistries(ctx, s, machine.IP, c.Spec.TenantID, c)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+
+	machines, err := p.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, machine := range machines.Items {
+		// the machines being initialized get the configuration on installation
+		if machine.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		s, err := machine.Spec.SSH()
+		if err != nil {
+			return err
+		}
+		err = p.applyContainerRegistries(ctx, s, machine.Spec.IP, machine.Spec.TenantID, c)
+		if err != nil {
+			return errors.Wrap(err, machine.Spec.IP)
+		}
+	}
+
+	return nil
+}
+
+// EnsureSystemTuning enforces the SystemTuning on masters and worker machines,
+// which keeps the kernel parameters, kernel modules, ulimits and transparent
+// hugepage setting of nodes the same as the cluster declares.
+func (p *Provider) EnsureSystemTuning(ctx context.Context, c *v1.Cluster) error {
+	masters := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range masters {
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+		changed, err := tuning.Apply(s, tuning.ForCluster(c.Cluster))
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		if changed {
+			log.FromContext(ctx).Info("System tuning applied", "node", machine.IP)
+		}
+	}
+
+	machines, err := p.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, machine := range machines.Items {
+		// the machines being initialized get the tuning on installation
+		if machine.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		s, err := machine.Spec.SSH()
+		if err != nil {
+			return err
+		}
+		changed, err := tuning.Apply(s, tuning.ForCluster(c.Cluster))
+		if err != nil {
+			return errors.Wrap(err, machine.Spec.IP)
+		}
+		if !changed {
+			continue
+		}
+		log.FromContext(ctx).Info("System tuning applied", "node", machine.Spec.IP)
+		// the tuned files are the expected content, not config drift
+		err = drift.Snapshot(s)
+		if err != nil {
+			return errors.Wrap(err, machine.Spec.IP)
+		}
+	}
+
+	return nil
+}
+
+// EnsureServiceOverrides enforces the environment variables and drop-in
+// overrides of kubelet and container runtime on masters and worker machines,
+// the overrides of machine are merged over the cluster's.
+func (p *Provider) EnsureServiceOverrides(ctx context.Context, c *v1.Cluster) error {
+	masters := map[bool][]platformv1.ClusterMachine{
+		true:  c.Spec.ScalingMachines,
+		false: c.Spec.Machines}[len(c.Spec.ScalingMachines) > 0]
+	for _, machine := range masters {
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+		changed, err := systemd.Apply(s, c.Spec.Features.ServiceOverrides)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		if changed {
+			log.FromContext(ctx).Info("Service overrides applied", "node", machine.IP)
+		}
+	}
+
+	machines, err := p.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, machine := range machines.Items {
+		// the machines being initialized get the overrides on installation
+		if machine.Status.Phase != platformv1.MachineRunning {
+			continue
+		}
+		s, err := machine.Spec.SSH()
+		if err != nil {
+			return err
+		}
+		changed, err := systemd.Apply(s, systemd.MergeOverrides(c.Spec.Features.ServiceOverrides, machine.Spec.ServiceOverrides))
+		if err != nil {
+			return errors.Wrap(err, machine.Spec.IP)
+		}
+		if !changed {
+			continue
+		}
+		log.FromContext(ctx).Info("Service overrides applied", "node", machine.Spec.IP)
+		err = drift.Snapshot(s)
+		if err != nil {
+			return errors.Wrap(err, machine.Spec.IP)
+		}
+	}
+
+	return nil
+}
+
+func (p *Provider) applyContainerRegistries(ctx context.Context, s ssh.Interface, ip string, tenantID string, c *v1.Cluster) error {
+	insecureRegistries, mirrors, auths := p.getContainerRegistries(tenantID, c)
+	registriesChanged, err := docker.ApplyRegistries(s, insecureRegistries, mirrors)
+	if err != nil {
+		return err
+	}
+	authsChanged, err := docker.ApplyRegistryAuths(s, auths, c.RegistryPasswords())
+	if err != nil {
+		return err
+	}
+	if registriesChanged || authsChanged {
+		log.FromContext(ctx).Info("Container registries applied", "node", ip,
+			"registriesChanged", registriesChanged, "authsChanged", authsChanged)
+	}
+
+	return nil
+}
+
+// apiServerConfig describes the files, flags and volumes of apiserver which
+// are managed by a feature.
+type apiServerConfig struct {
+	files map[string][]byte
+	// all flags with the prefix are replaced by args
+	argPrefix string
+	args      map[string]string
+	// all volumes with the name are replaced by volumes
+	volumeName string
+	volumes    []kubeadmv1beta2.HostPathMount
+}
+
+// rollAPIServerConfig applies the config to apiserver on masters one by one, and
+// waits for apiserver to be healthy before moving to the next one.
+func (p *Provider) rollAPIServerConfig(ctx context.Context, c *v1.Cluster, config *apiServerConfig) error {
+	needUpload := false
 	for _, machine := range c.Spec.Machines {
-		logger := log.FromContext(ctx).WithValues("node", machine.IP)
+		ctx := log.FromContext(ctx).WithValues("node", machine.IP).WithContext(ctx)
 		s, err := machine.SSH()
 		if err != nil {
 			return err
 		}
-
-		data, err := s.ReadFile(constants.APIServerCertName)
+		manifestChanged, err := applyAPIServerConfig(ctx, s, config)
 		if err != nil {
-			logger.Error(err, "read cert file error")
-			return nil
+			return errors.Wrap(err, machine.IP)
 		}
-		certs, err := certutil.ParseCertsPEM(data)
+		needUpload = needUpload || manifestChanged
+	}
+
+	if needUpload {
+		// keep apiserver flags in kubeadm-config for later upgrades
+		err := p.EnsureKubeadmInitPhaseUploadConfig(ctx, c)
 		if err != nil {
-			logger.Error(err, "ParseCertsPEM error")
-			return nil
+			return err
 		}
-		expirationDuration := time.Until(certs[0].NotAfter)
-		if expirationDuration > constants.RenewCertsTimeThreshold {
-			logger.Info("Skip EnsureRenewCerts because expiration duration > threshold",
-				"duration", expirationDuration.String(),
-				"threshold", constants.RenewCertsTimeThreshold.String(),
-			)
-			return nil
+	}
+
+	return nil
+}
+
+// applyAPIServerConfig applies the config to apiserver on the node, which is
+// restarted only if the files or the static pod manifest are changed. It
+// reports whether the manifest is changed.
+func applyAPIServerConfig(ctx context.Context, s ssh.Interface, config *apiServerConfig) (bool, error) {
+	logger := log.FromContext(ctx)
+	filesChanged := false
+	for file, data := range config.files {
+		actual, err := s.ReadFile(file)
+		if err == nil && bytes.Equal(actual, data) {
+			continue
 		}
+		err = s.WriteFile(bytes.NewReader(data), file)
+		if err != nil {
+			return false, err
+		}
+		filesChanged = true
+	}
 
-		logger.Info("RenewCerts doing")
-		err = kubeadm.RenewCerts(s)
+	manifest, err := s.ReadFile(constants.KubeAPIServerPodManifestFile)
+	if err != nil {
+		return false, err
+	}
+	manifest, manifestChanged, err := setAPIServerConfig(manifest, config)
+	if err != nil {
+		return false, err
+	}
+	if !filesChanged && !manifestChanged {
+		return false, nil
+	}
+
+	logger.Info("Apply apiserver configuration", "filesChanged", filesChanged, "manifestChanged", manifestChanged)
+	filter := kubeadm.DockerFilterForControlPlane("kube-apiserver")
+	if manifestChanged {
+		// kubelet recreates apiserver when the static pod manifest changed
+		oldID, _ := s.CombinedOutput(fmt.Sprintf("docker ps -q -f '%s'", filter))
+		err = s.WriteFile(bytes.NewReader(manifest), constants.KubeAPIServerPodManifestFile)
 		if err != nil {
-			return errors.Wrap(err, machine.IP)
+			return false, err
 		}
-		logger.Info("RenewCerts done")
+		err = waitContainerReplaced(s, filter, string(oldID))
+	} else {
+		err = kubeadm.RestartContainerByFilter(s, filter)
+	}
+	if err != nil {
+		return false, err
+	}
+	err = waitAPIServerHealthy(s)
+	if err != nil {
+		return false, err
 	}
+	logger.Info("Apiserver configuration applied")
 
-	return nil
+	return manifestChanged, nil
+}
+
+// setAPIServerConfig replaces the flags and volumes of the apiserver static pod
+// manifest, and reports whether the manifest is changed.
+func setAPIServerConfig(manifest []byte, config *apiServerConfig) ([]byte, bool, error) {
+	pod := &corev1.Pod{}
+	err := sigsyaml.Unmarshal(manifest, pod)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return nil, false, errors.New("no container in apiserver manifest")
+	}
+	origin := pod.DeepCopy()
+	container := &pod.Spec.Containers[0]
+
+	var command []string
+	for _, one := range container.Command {
+		if !strings.HasPrefix(one, "--"+config.argPrefix) {
+			command = append(command, one)
+		}
+	}
+	keys := make([]string, 0, len(config.args))
+	for k := range config.args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		command = append(command, fmt.Sprintf("--%s=%s", k, config.args[k]))
+	}
+	container.Command = command
+
+	var podVolumes []corev1.Volume
+	for _, one := range pod.Spec.Volumes {
+		if one.Name != config.volumeName {
+			podVolumes = append(podVolumes, one)
+		}
+	}
+	var volumeMounts []corev1.VolumeMount
+	for _, one := range container.VolumeMounts {
+		if one.Name != config.volumeName {
+			volumeMounts = append(volumeMounts, one)
+		}
+	}
+	for _, one := range config.volumes {
+		pathType := one.PathType
+		podVolumes = append(podVolumes, corev1.Volume{
+			Name: one.Name,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: one.HostPath,
+					Type: &pathType,
+				},
+			},
+		})
+		volumeMounts = append(volumeMounts, corev1.VolumeMount{
+			Name:      one.Name,
+			MountPath: one.MountPath,
+			ReadOnly:  one.ReadOnly,
+		})
+	}
+	pod.Spec.Volumes = podVolumes
+	container.VolumeMounts = volumeMounts
+
+	if apiequality.Semantic.DeepEqual(origin, pod) {
+		return manifest, false, nil
+	}
+	data, err := sigsyaml.Marshal(pod)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+func readFileIfExist(s ssh.Interface, file string) ([]byte, error) {
+	ok, err := s.Exist(file)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return s.ReadFile(file)
+}
+
+func waitContainerReplaced(s ssh.Interface, filter string, oldID string) error {
+	oldID = strings.TrimSpace(oldID)
+	return wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		output, err := s.CombinedOutput(fmt.Sprintf("docker ps -q -f '%s'", filter))
+		if err != nil {
+			return false, nil
+		}
+		id := strings.TrimSpace(string(output))
+		return id != "" && id != oldID, nil
+	})
+}
+
+func waitAPIServerHealthy(s ssh.Interface) error {
+	return wait.PollImmediate(5*time.Second, 5*time.Minute, func() (bool, error) {
+		output, err := s.CombinedOutput("curl -sk https://127.0.0.1:6443/healthz")
+		if err != nil {
+			return false, nil
+		}
+		return strings.TrimSpace(string(output)) == "ok", nil
+	})
 }
 
 func (p *Provider) EnsureAPIServerCert(ctx context.Context, c *v1.Cluster) error {
@@ -146,6 +925,180 @@ func (p *Provider) EnsurePreClusterUpgradeHook(ctx context.Context, c *v1.Cluste
 	return util.ExcuteCustomizedHook(ctx, c, platformv1.HookPreClusterUpgrade, c.Spec.Machines[:1])
 }
 
+// EnsureEtcdSnapshot takes an etcd snapshot before upgrading control plane.
+func (p *Provider) EnsureEtcdSnapshot(ctx context.Context, c *v1.Cluster) error {
+	return p.snapshotEtcd(ctx, c, fmt.Sprintf("Upgrade to %s", c.Spec.Version))
+}
+
+// snapshotEtcd saves an etcd snapshot on the first master, uploads it to
+// object storage if configured, and records it on the cluster status.
+func (p *Provider) snapshotEtcd(ctx context.Context, c *v1.Cluster, reason string) error {
+	backup := c.Spec.Features.EtcdBackup
+	if backup == nil {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	if c.Spec.Etcd == nil || c.Spec.Etcd.Local == nil {
+		logger.Info("Skip etcd snapshot because etcd is not local")
+		return nil
+	}
+
+	// the secret access key is kept in credential instead of spec
+	var secretAccessKey string
+	if backup.S3 != nil {
+		if c.ClusterCredential == nil || len(c.ClusterCredential.EtcdBackupSecretAccessKey) == 0 {
+			return errors.New("etcdBackupSecretAccessKey of cluster credential is required to upload etcd snapshots")
+		}
+		secretAccessKey = string(c.ClusterCredential.EtcdBackupSecretAccessKey)
+	}
+
+	machine := c.Spec.Machines[0]
+	s, err := machine.SSH()
+	if err != nil {
+		return err
+	}
+	dir := backup.Path
+	if dir == "" {
+		dir = constants.EtcdBackupDir
+	}
+	name := fmt.Sprintf("%s-%s.db", c.Name, time.Now().Format("20060102150405"))
+	file, err := etcd.Snapshot(s, dir, name)
+	if err != nil {
+		return errors.Wrap(err, machine.IP)
+	}
+	location := fmt.Sprintf("%s:%s", machine.IP, file)
+	if backup.S3 != nil {
+		location, err = etcd.Upload(s, file, backup.S3, secretAccessKey)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+	}
+	logger.Info("Etcd snapshot saved", "location", location, "reason", reason)
+
+	c.Status.EtcdSnapshot = &platformv1.EtcdSnapshot{
+		Location:     location,
+		Reason:       reason,
+		Version:      c.Status.Version,
+		CreationTime: metav1.Now(),
+	}
+
+	return nil
+}
+
+// EnsureEtcdMaintenance compacts the etcd history and defragments each
+// member one by one when the maintenance is due or requested.
+func (p *Provider) EnsureEtcdMaintenance(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Etcd == nil || c.Spec.Etcd.Local == nil || c.Spec.Etcd.Local.Maintenance == nil {
+		return nil
+	}
+	if c.Status.EtcdMaintenance == nil {
+		c.Status.EtcdMaintenance = &platformv1.EtcdMaintenanceStatus{}
+	}
+	reason := needEtcdMaintenance(c.Spec.Etcd.Local.Maintenance, c.Status.EtcdMaintenance, c.CreationTimestamp, time.Now())
+	if reason == "" {
+		return nil
+	}
+	logger := log.FromContext(ctx)
+	logger.Info("Start etcd maintenance", "reason", reason)
+
+	s, err := c.Spec.Machines[0].SSH()
+	if err != nil {
+		return err
+	}
+	revision, err := etcd.Revision(s)
+	if err != nil {
+		return errors.Wrap(err, c.Spec.Machines[0].IP)
+	}
+	err = etcd.Compact(s, revision)
+	if err != nil {
+		return errors.Wrap(err, c.Spec.Machines[0].IP)
+	}
+	// defragment blocks reads and writes of the member, so do it one by one.
+	for _, machine := range c.Spec.Machines {
+		s, err := machine.SSH()
+		if err != nil {
+			return err
+		}
+		err = etcd.Defragment(s)
+		if err != nil {
+			return errors.Wrap(err, machine.IP)
+		}
+		logger.Info("Etcd member defragmented", "node", machine.IP)
+	}
+
+	c.Status.EtcdMaintenance.CompactedRevision = revision
+	c.Status.EtcdMaintenance.LastRunTime = metav1.Now()
+
+	return nil
+}
+
+func needEtcdMaintenance(maintenance *platformv1.EtcdMaintenance, status *platformv1.EtcdMaintenanceStatus,
+	creationTime metav1.Time, now time.Time) string {
+	if maintenance.RunRequestedAt != nil && !maintenance.RunRequestedAt.After(now) &&
+		status.LastRunTime.Before(maintenance.RunRequestedAt) {
+		return "requested at " + maintenance.RunRequestedAt.String()
+	}
+	interval, err := time.ParseDuration(maintenance.Interval)
+	if err != nil {
+		return ""
+	}
+	// the interval of first maintenance starts from the creation of cluster.
+	last := status.LastRunTime
+	if last.IsZero() {
+		last = creationTime
+	}
+	if now.Sub(last.Time) >= interval {
+		return fmt.Sprintf("interval %s elapsed", maintenance.Interval)
+	}
+
+	return ""
+}
+
+// EnsureUpgradeAddons upgrades the addons which don't work with the new
+// kubernetes version to the latest compatible versions.
+func (p *Provider) EnsureUpgradeAddons(ctx context.Context, c *v1.Cluster) error {
+	if p.platformClient == nil {
+		return nil
+	}
+	addons, err := compatibility.ListClusterAddons(ctx, p.platformClient, c.Name)
+	if err != nil {
+		return err
+	}
+	for _, addon := range addons {
+		if compatibility.Check(addon.Addon, addon.Version, c.Spec.Version) == nil {
+			continue
+		}
+		latest, ok, err := compatibility.LatestCompatible(addon.Addon, c.Spec.Version)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("no version of %s %s works with kubernetes %s", addon.Addon, addon.Name, c.Spec.Version)
+		}
+		log.FromContext(ctx).Info("Upgrade addon for compatibility", "addon", addon.Addon, "name", addon.Name,
+			"from", addon.Version, "to", latest)
+		err = compatibility.SetClusterAddonVersion(ctx, p.platformClient, addon, latest)
+		if err != nil {
+			return errors.Wrapf(err, "upgrade %s %s", addon.Addon, addon.Name)
+		}
+	}
+
+	return nil
+}
+
+// EnsureUpgradeCNI re-applies the manifests of calico or cilium to roll them
+// to the images shipped with current version.
+func (p *Provider) EnsureUpgradeCNI(ctx context.Context, c *v1.Cluster) error {
+	switch c.Cluster.Network() {
+	case platformv1.NetworkTypeCalico:
+		return installCalico(ctx, c)
+	case platformv1.NetworkTypeCilium:
+		return installCilium(ctx, c)
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureUpgradeCoreDNS(ctx context.Context, c *v1.Cluster) error {
 	logger := log.FromContext(ctx).WithName("Upgrade coreDNS")
 	if version.Compare(c.Status.Version, constants.NeedUpgradeCoreDNSK8sVersion) >= 0 {
@@ -194,36 +1147,19 @@ func updateCoreDNSVersion(ctx context.Context, client kubernetes.Interface, vers
 }
 
 func (p *Provider) EnsureUpgradeControlPlaneNode(ctx context.Context, c *v1.Cluster) error {
-	// check all machines are upgraded before upgrade cluster
-	requirement, err := labels.NewRequirement(constants.LabelNodeNeedUpgrade, selection.Exists, []string{})
-	if err != nil {
-		return err
+	if c.Spec.Features.Upgrade.Paused {
+		return clusterprovider.ErrUpgradePaused
 	}
-	machines, err := p.platformClient.Machines().List(context.TODO(), metav1.ListOptions{
-		LabelSelector: requirement.String(),
-		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
-	})
+	err := p.checkMachinesUpgraded(c)
 	if err != nil {
 		return err
 	}
-	if len(machines.Items) != 0 {
-		var itemsName []string
-		for _, item := range machines.Items {
-			itemsName = append(itemsName, item.Name)
-		}
-		return fmt.Errorf("some machines, [%s], need to be upgraded", strings.Join(itemsName, ","))
-	}
 
 	client, err := c.Clientset()
 	if err != nil {
 		return err
 	}
-	option := kubeadm.UpgradeOption{
-		NodeRole:               kubeadm.NodeRoleMaster,
-		Version:                c.Spec.Version,
-		MaxUnready:             c.Spec.Features.Upgrade.Strategy.MaxUnready,
-		DrainNodeBeforeUpgrade: c.Spec.Features.Upgrade.Strategy.DrainNodeBeforeUpgrade,
-	}
+	option := getUpgradeControlPlaneOption(c)
 	logger := log.FromContext(ctx).WithName("Cluster upgrade")
 	for i, machine := range c.Spec.Machines {
 		option.MachineName = machine.Username
@@ -247,7 +1183,7 @@ func (p *Provider) EnsureUpgradeControlPlaneNode(ctx context.Context, c *v1.Clus
 			if err := kubeadm.AddNeedUpgradeLabel(p.platformClient, c.Name, labelValue); err != nil {
 				return err
 			}
-			err = kubeadm.MarkNextUpgradeWorkerNode(client, p.platformClient, option.Version, c.Name)
+			err = kubeadm.MarkNextUpgradeWorkerNode(client, p.platformClient, option.Version, c.Name, c.Spec.Features.Upgrade)
 			if err != nil {
 				return err
 			}
@@ -256,6 +1192,135 @@ func (p *Provider) EnsureUpgradeControlPlaneNode(ctx context.Context, c *v1.Clus
 	return nil
 }
 
+// EnsureUpgradeCanary upgrades the first master only, checks its health and
+// holds the upgrade until the version is approved.
+func (p *Provider) EnsureUpgradeCanary(ctx context.Context, c *v1.Cluster) error {
+	upgradeCanary := c.Spec.Features.Upgrade.Canary
+	if upgradeCanary == nil {
+		return nil
+	}
+	if c.Spec.Features.Upgrade.Paused {
+		return clusterprovider.ErrUpgradePaused
+	}
+	err := p.checkMachinesUpgraded(c)
+	if err != nil {
+		return err
+	}
+
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+	machine := c.Spec.Machines[0]
+	s, err := machine.SSH()
+	if err != nil {
+		return err
+	}
+	option := getUpgradeControlPlaneOption(c)
+	option.MachineName = machine.Username
+	option.MachineIP = machine.IP
+	option.BootstrapNode = true
+	logger := log.FromContext(ctx).WithName("Cluster upgrade canary")
+	_, err = kubeadm.UpgradeNode(s, client, p.platformClient, logger, c, option)
+	if err != nil {
+		return err
+	}
+
+	if c.Status.UpgradeCanary == nil || c.Status.UpgradeCanary.Version != c.Spec.Version {
+		c.Status.UpgradeCanary = &platformv1.UpgradeCanaryStatus{
+			Version: c.Spec.Version,
+			Node:    machine.IP,
+		}
+	}
+	if c.Status.UpgradeCanary.Approved {
+		return nil
+	}
+	if upgradeCanary.ApprovedVersion == c.Spec.Version ||
+		c.Annotations[platformv1.AnnotationUpgradeCanaryApproved] == c.Spec.Version {
+		logger.Info("Canary upgrade approved", "version", c.Spec.Version)
+		c.Status.UpgradeCanary.Approved = true
+		return nil
+	}
+
+	node, err := apiclient.GetNodeByMachineIP(ctx, client, machine.IP)
+	if err != nil {
+		return err
+	}
+	c.Status.UpgradeCanary.Checks = canary.Check(ctx, s, client, node.Name)
+	if failed := canary.Failed(c.Status.UpgradeCanary.Checks); len(failed) > 0 {
+		return fmt.Errorf("canary master %s failed checks %s", machine.IP, strings.Join(failed, ","))
+	}
+
+	return fmt.Errorf("canary master %s is upgraded to %s, waiting for approval", machine.IP, c.Spec.Version)
+}
+
+func getUpgradeControlPlaneOption(c *v1.Cluster) kubeadm.UpgradeOption {
+	return kubeadm.UpgradeOption{
+		NodeRole:               kubeadm.NodeRoleMaster,
+		Version:                c.Spec.Version,
+		MaxUnready:             c.Spec.Features.Upgrade.Strategy.MaxUnready,
+		DrainNodeBeforeUpgrade: c.Spec.Features.Upgrade.Strategy.DrainNodeBeforeUpgrade,
+	}
+}
+
+// checkMachinesUpgraded checks all machines are upgraded before upgrade cluster.
+func (p *Provider) checkMachinesUpgraded(c *v1.Cluster) error {
+	requirement, err := labels.NewRequirement(constants.LabelNodeNeedUpgrade, selection.Exists, []string{})
+	if err != nil {
+		return err
+	}
+	machines, err := p.platformClient.Machines().List(context.TODO(), metav1.ListOptions{
+		LabelSelector: requirement.String(),
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return err
+	}
+	if len(machines.Items) != 0 {
+		var itemsName []string
+		for _, item := range machines.Items {
+			itemsName = append(itemsName, item.Name)
+		}
+		return fmt.Errorf("some machines, [%s], need to be upgraded", strings.Join(itemsName, ","))
+	}
+
+	return nil
+}
+
+// EnsureUpgradeWorkerNodes marks worker nodes waiting for upgrade to be upgraded,
+// which resumes the paused upgrade and keeps maxUnavailable nodes upgrading.
+// The worker nodes being upgraded are not interrupted by pausing the upgrade.
+func (p *Provider) EnsureUpgradeWorkerNodes(ctx context.Context, c *v1.Cluster) error {
+	if c.Spec.Features.Upgrade.Mode != platformv1.UpgradeModeAuto {
+		return nil
+	}
+	if c.Spec.Features.Upgrade.Paused {
+		machines, err := p.platformClient.Machines().List(ctx, metav1.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+		})
+		if err != nil {
+			return err
+		}
+		var waiting []string
+		for _, machine := range machines.Items {
+			if machine.Status.Phase != platformv1.MachineUpgrading &&
+				machine.Labels[constants.LabelNodeNeedUpgrade] == kubeadm.WillUpgrade {
+				waiting = append(waiting, machine.Spec.IP)
+			}
+		}
+		if len(waiting) > 0 {
+			return fmt.Errorf("%w, worker nodes [%s] are waiting to be upgraded", clusterprovider.ErrUpgradePaused, strings.Join(waiting, ","))
+		}
+		return nil
+	}
+	client, err := c.Clientset()
+	if err != nil {
+		return err
+	}
+
+	return kubeadm.MarkNextUpgradeWorkerNode(client, p.platformClient, c.Spec.Version, c.Name, c.Spec.Features.Upgrade)
+}
+
 func (p *Provider) EnsurePostClusterUpgradeHook(ctx context.Context, c *v1.Cluster) error {
 
 	return util.ExcuteCustomizedHook(ctx, c, platformv1.HookPostClusterUpgrade, c.Spec.Machines[:1])
@@ -0,0 +1,705 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	sigsyaml "sigs.k8s.io/yaml"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/audit/sink"
+	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/config"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestSnapshotEtcdRequiresSecretAccessKey(t *testing.T) {
+	cluster := func(credential *platformv1.ClusterCredential) *v1.Cluster {
+		return &v1.Cluster{
+			Cluster: &platformv1.Cluster{
+				Spec: platformv1.ClusterSpec{
+					Etcd: &platformv1.Etcd{Local: &platformv1.LocalEtcd{}},
+					Features: platformv1.ClusterFeature{
+						EtcdBackup: &platformv1.EtcdBackup{
+							S3: &platformv1.S3Storage{
+								Endpoint:    "https://cos.ap-guangzhou.myqcloud.com",
+								Bucket:      "backup",
+								AccessKeyID: "id",
+							},
+						},
+					},
+				},
+			},
+			ClusterCredential: credential,
+		}
+	}
+	tests := []struct {
+		name       string
+		credential *platformv1.ClusterCredential
+	}{
+		{
+			name: "no credential",
+		},
+		{
+			name:       "no secret access key",
+			credential: &platformv1.ClusterCredential{ClusterName: "cls"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{}
+			if err := p.snapshotEtcd(context.Background(), cluster(tt.credential), "test"); err == nil {
+				t.Error("snapshotEtcd() should fail without the secret access key")
+			}
+		})
+	}
+}
+
+func certificatesCluster(rotation *platformv1.CertificateRotation, certificates *platformv1.CertificatesStatus) *v1.Cluster {
+	return &v1.Cluster{
+		Cluster: &platformv1.Cluster{
+			Spec: platformv1.ClusterSpec{
+				Machines: []platformv1.ClusterMachine{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				Features: platformv1.ClusterFeature{CertificateRotation: rotation},
+			},
+			Status: platformv1.ClusterStatus{Certificates: certificates},
+		},
+	}
+}
+
+func TestNeedProbeCerts(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := func(name, node string, notAfter time.Duration) platformv1.CertificateExpiration {
+		return platformv1.CertificateExpiration{Name: name, Node: node, NotAfter: metav1.NewTime(now.Add(notAfter))}
+	}
+	year := 365 * 24 * time.Hour
+	recorded := []platformv1.CertificateExpiration{
+		expiration("ca.crt", "10.0.0.1", 10*year),
+		expiration("apiserver.crt", "10.0.0.1", year),
+		expiration("ca.crt", "10.0.0.2", 10*year),
+		expiration("apiserver.crt", "10.0.0.2", year),
+	}
+	tests := []struct {
+		name         string
+		rotation     *platformv1.CertificateRotation
+		certificates *platformv1.CertificatesStatus
+		want         bool
+	}{
+		{
+			name: "not recorded",
+			want: true,
+		},
+		{
+			name:         "recorded",
+			certificates: &platformv1.CertificatesStatus{Certificates: recorded},
+			want:         false,
+		},
+		{
+			name: "about to expire",
+			certificates: &platformv1.CertificatesStatus{Certificates: append(recorded[:3:3],
+				expiration(constants.KubeletClientCurrent, "10.0.0.2", 24*time.Hour))},
+			want: true,
+		},
+		{
+			name:         "master not recorded",
+			certificates: &platformv1.CertificatesStatus{Certificates: recorded[:2]},
+			want:         true,
+		},
+		{
+			name: "master removed",
+			certificates: &platformv1.CertificatesStatus{Certificates: append(recorded[:4:4],
+				expiration("ca.crt", "10.0.0.3", 10*year))},
+			want: true,
+		},
+		{
+			name:         "rotation requested",
+			rotation:     &platformv1.CertificateRotation{RotateRequestedAt: &metav1.Time{Time: now.Add(-time.Hour)}},
+			certificates: &platformv1.CertificatesStatus{Certificates: recorded, LastRotationTime: metav1.NewTime(now.Add(-year))},
+			want:         true,
+		},
+		{
+			name:         "rotation done",
+			rotation:     &platformv1.CertificateRotation{RotateRequestedAt: &metav1.Time{Time: now.Add(-time.Hour)}},
+			certificates: &platformv1.CertificatesStatus{Certificates: recorded, LastRotationTime: metav1.NewTime(now.Add(-time.Minute))},
+			want:         false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := certificatesCluster(tt.rotation, tt.certificates)
+			if got := needProbeCerts(c, renewCertsThreshold(c), now); got != tt.want {
+				t.Errorf("needProbeCerts() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNeedRenewCerts(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := func(name string, notAfter time.Duration) platformv1.CertificateExpiration {
+		return platformv1.CertificateExpiration{Name: name, Node: "10.0.0.1", NotAfter: metav1.NewTime(now.Add(notAfter))}
+	}
+	tests := []struct {
+		name        string
+		rotation    *platformv1.CertificateRotation
+		expirations []platformv1.CertificateExpiration
+		want        bool
+	}{
+		{
+			name:        "not expiring",
+			expirations: []platformv1.CertificateExpiration{expiration("apiserver.crt", 60*24*time.Hour)},
+			want:        false,
+		},
+		{
+			name:        "expiring",
+			expirations: []platformv1.CertificateExpiration{expiration("apiserver.crt", 24*time.Hour)},
+			want:        true,
+		},
+		{
+			name:        "expiring with renew before",
+			rotation:    &platformv1.CertificateRotation{RenewBefore: "1440h"},
+			expirations: []platformv1.CertificateExpiration{expiration("apiserver.crt", 50*24*time.Hour)},
+			want:        true,
+		},
+		{
+			name:        "expiring in manual mode",
+			rotation:    &platformv1.CertificateRotation{Mode: platformv1.CertificateRotationManual},
+			expirations: []platformv1.CertificateExpiration{expiration("apiserver.crt", 24*time.Hour)},
+			want:        false,
+		},
+		{
+			name: "not renewed by kubeadm",
+			expirations: []platformv1.CertificateExpiration{
+				expiration("ca.crt", 24*time.Hour),
+				expiration(constants.KubeletClientCurrent, 24*time.Hour),
+			},
+			want: false,
+		},
+		{
+			name: "requested",
+			rotation: &platformv1.CertificateRotation{
+				Mode:              platformv1.CertificateRotationManual,
+				RotateRequestedAt: &metav1.Time{Time: now.Add(-time.Hour)},
+			},
+			expirations: []platformv1.CertificateExpiration{expiration("apiserver.crt", 60*24*time.Hour)},
+			want:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := certificatesCluster(tt.rotation, &platformv1.CertificatesStatus{Certificates: tt.expirations})
+			if got := needRenewCerts(c, tt.expirations, renewCertsThreshold(c), now); (got != "") != tt.want {
+				t.Errorf("needRenewCerts() = %q, want renew %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureUpgradeCanaryPreconditions(t *testing.T) {
+	needUpgrade := &platformv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: "mc-a", Labels: map[string]string{constants.LabelNodeNeedUpgrade: ""}},
+		Spec:       platformv1.MachineSpec{ClusterName: "cls-a"},
+	}
+	tests := []struct {
+		name    string
+		upgrade platformv1.Upgrade
+		objects []runtime.Object
+		wantErr string
+	}{
+		{
+			name: "canary disabled",
+		},
+		{
+			name:    "paused",
+			upgrade: platformv1.Upgrade{Paused: true, Canary: &platformv1.UpgradeCanary{}},
+			wantErr: "upgrade is paused",
+		},
+		{
+			name:    "machines not upgraded",
+			upgrade: platformv1.Upgrade{Canary: &platformv1.UpgradeCanary{}},
+			objects: []runtime.Object{needUpgrade},
+			wantErr: "some machines, [mc-a], need to be upgraded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{platformClient: fake.NewSimpleClientset(tt.objects...).PlatformV1()}
+			c := &v1.Cluster{Cluster: &platformv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+				Spec: platformv1.ClusterSpec{
+					Version:  "1.20.4",
+					Features: platformv1.ClusterFeature{Upgrade: tt.upgrade},
+				},
+			}}
+			err := p.EnsureUpgradeCanary(context.Background(), c)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("EnsureUpgradeCanary() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("EnsureUpgradeCanary() error = %v, want %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGetUpgradeControlPlaneOption(t *testing.T) {
+	maxUnready := intstr.FromInt(1)
+	drain := true
+	c := &v1.Cluster{Cluster: &platformv1.Cluster{
+		Spec: platformv1.ClusterSpec{
+			Version: "1.20.4",
+			Features: platformv1.ClusterFeature{Upgrade: platformv1.Upgrade{
+				Strategy: platformv1.UpgradeStrategy{MaxUnready: &maxUnready, DrainNodeBeforeUpgrade: &drain},
+			}},
+		},
+	}}
+	option := getUpgradeControlPlaneOption(c)
+	if option.NodeRole != kubeadm.NodeRoleMaster || option.Version != "1.20.4" || option.MaxUnready != &maxUnready ||
+		option.DrainNodeBeforeUpgrade == nil || !*option.DrainNodeBeforeUpgrade {
+		t.Errorf("getUpgradeControlPlaneOption() = %+v", option)
+	}
+}
+
+// fakeSSH keeps the files written in memory and records the commands, the
+// apiserver container is replaced on each docker ps.
+type fakeSSH struct {
+	files       map[string][]byte
+	commands    []string
+	containerID int
+}
+
+func (f *fakeSSH) Ping() error                          { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error       { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error) { return file, nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	switch {
+	case strings.HasPrefix(cmd, "docker ps"):
+		f.containerID++
+		return []byte(fmt.Sprintf("container-%d\n", f.containerID)), nil
+	case strings.HasPrefix(cmd, "curl"):
+		return []byte("ok"), nil
+	}
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	output, err := f.CombinedOutput(cmd)
+	return string(output), "", 0, err
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+const apiServerManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-apiserver
+    command:
+    - kube-apiserver
+    - --advertise-address=10.0.0.1
+    - --audit-log-maxage=30
+    - --audit-policy-file=/etc/kubernetes/audit-policy.yaml
+    volumeMounts:
+    - name: k8s-certs
+      mountPath: /etc/kubernetes/pki
+      readOnly: true
+    - name: audit-log
+      mountPath: /var/log/old
+  volumes:
+  - name: k8s-certs
+    hostPath:
+      path: /etc/kubernetes/pki
+  - name: audit-log
+    hostPath:
+      path: /var/log/old
+      type: DirectoryOrCreate
+`
+
+func apiServerPod(t *testing.T, manifest []byte) *corev1.Pod {
+	pod := &corev1.Pod{}
+	if err := sigsyaml.Unmarshal(manifest, pod); err != nil {
+		t.Fatal(err)
+	}
+	return pod
+}
+
+func auditLogMount(dir string) []kubeadmv1beta2.HostPathMount {
+	return []kubeadmv1beta2.HostPathMount{{
+		Name:      auditLogVolumeName,
+		HostPath:  dir,
+		MountPath: dir,
+		PathType:  corev1.HostPathDirectoryOrCreate,
+	}}
+}
+
+func TestSetAPIServerConfig(t *testing.T) {
+	tests := []struct {
+		name         string
+		config       *apiServerConfig
+		wantChanged  bool
+		wantCommand  []string
+		wantVolumes  []string
+		wantAuditDir string
+	}{
+		{
+			name: "flags are replaced and volume is moved",
+			config: &apiServerConfig{
+				argPrefix: "audit-",
+				args: map[string]string{
+					"audit-policy-file":         "/etc/kubernetes/audit-policy.yaml",
+					"audit-webhook-config-file": "/etc/kubernetes/audit-api-client-config.yaml",
+				},
+				volumeName: auditLogVolumeName,
+				volumes:    auditLogMount("/var/log/kubernetes/audit"),
+			},
+			wantChanged: true,
+			wantCommand: []string{
+				"kube-apiserver",
+				"--advertise-address=10.0.0.1",
+				"--audit-policy-file=/etc/kubernetes/audit-policy.yaml",
+				"--audit-webhook-config-file=/etc/kubernetes/audit-api-client-config.yaml",
+			},
+			wantVolumes:  []string{"k8s-certs", auditLogVolumeName},
+			wantAuditDir: "/var/log/kubernetes/audit",
+		},
+		{
+			name: "flags and volume are removed",
+			config: &apiServerConfig{
+				argPrefix:  "audit-",
+				args:       map[string]string{},
+				volumeName: auditLogVolumeName,
+			},
+			wantChanged: true,
+			wantCommand: []string{"kube-apiserver", "--advertise-address=10.0.0.1"},
+			wantVolumes: []string{"k8s-certs"},
+		},
+		{
+			name: "no change",
+			config: &apiServerConfig{
+				argPrefix: "audit-",
+				args: map[string]string{
+					"audit-log-maxage":  "30",
+					"audit-policy-file": "/etc/kubernetes/audit-policy.yaml",
+				},
+				volumeName: auditLogVolumeName,
+				volumes:    auditLogMount("/var/log/old"),
+			},
+			wantCommand: []string{
+				"kube-apiserver",
+				"--advertise-address=10.0.0.1",
+				"--audit-log-maxage=30",
+				"--audit-policy-file=/etc/kubernetes/audit-policy.yaml",
+			},
+			wantVolumes:  []string{"k8s-certs", auditLogVolumeName},
+			wantAuditDir: "/var/log/old",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest, changed, err := setAPIServerConfig([]byte(apiServerManifest), tt.config)
+			if err != nil {
+				t.Fatalf("setAPIServerConfig() error = %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("setAPIServerConfig() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !changed && string(manifest) != apiServerManifest {
+				t.Errorf("setAPIServerConfig() rewrote the manifest without changes")
+			}
+			pod := apiServerPod(t, manifest)
+			container := pod.Spec.Containers[0]
+			if !reflect.DeepEqual(container.Command, tt.wantCommand) {
+				t.Errorf("command = %v, want %v", container.Command, tt.wantCommand)
+			}
+			var volumes, mounts []string
+			auditDir := ""
+			for _, volume := range pod.Spec.Volumes {
+				volumes = append(volumes, volume.Name)
+				if volume.Name == auditLogVolumeName {
+					auditDir = volume.HostPath.Path
+				}
+			}
+			for _, mount := range container.VolumeMounts {
+				mounts = append(mounts, mount.Name)
+			}
+			if !reflect.DeepEqual(volumes, tt.wantVolumes) || !reflect.DeepEqual(mounts, tt.wantVolumes) {
+				t.Errorf("volumes = %v, mounts = %v, want %v", volumes, mounts, tt.wantVolumes)
+			}
+			if auditDir != tt.wantAuditDir {
+				t.Errorf("audit log dir = %q, want %q", auditDir, tt.wantAuditDir)
+			}
+		})
+	}
+}
+
+func TestApplyAPIServerConfig(t *testing.T) {
+	policy := []byte("apiVersion: audit.k8s.io/v1\nkind: Policy\n")
+	unchanged := &apiServerConfig{
+		files:     map[string][]byte{constants.KubernetesAuditPolicyConfigFile: policy},
+		argPrefix: "audit-",
+		args: map[string]string{
+			"audit-log-maxage":  "30",
+			"audit-policy-file": "/etc/kubernetes/audit-policy.yaml",
+		},
+		volumeName: auditLogVolumeName,
+		volumes:    auditLogMount("/var/log/old"),
+	}
+	tests := []struct {
+		name            string
+		files           map[string][]byte
+		config          *apiServerConfig
+		wantChanged     bool
+		wantRestart     bool
+		wantManifestNew bool
+	}{
+		{
+			name: "no change",
+			files: map[string][]byte{
+				constants.KubernetesAuditPolicyConfigFile: policy,
+			},
+			config: unchanged,
+		},
+		{
+			name:        "files changed",
+			files:       map[string][]byte{constants.KubernetesAuditPolicyConfigFile: []byte("old")},
+			config:      unchanged,
+			wantRestart: true,
+		},
+		{
+			name:  "manifest changed",
+			files: map[string][]byte{constants.KubernetesAuditPolicyConfigFile: policy},
+			config: &apiServerConfig{
+				files:      unchanged.files,
+				argPrefix:  "audit-",
+				args:       map[string]string{"audit-policy-file": "/etc/kubernetes/audit-policy.yaml"},
+				volumeName: auditLogVolumeName,
+			},
+			wantChanged:     true,
+			wantManifestNew: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeSSH{files: map[string][]byte{constants.KubeAPIServerPodManifestFile: []byte(apiServerManifest)}}
+			for file, data := range tt.files {
+				s.files[file] = data
+			}
+			changed, err := applyAPIServerConfig(context.Background(), s, tt.config)
+			if err != nil {
+				t.Fatalf("applyAPIServerConfig() error = %v", err)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("applyAPIServerConfig() changed = %v, want %v", changed, tt.wantChanged)
+			}
+			if !bytes.Equal(s.files[constants.KubernetesAuditPolicyConfigFile], policy) {
+				t.Errorf("policy = %q, want %q", s.files[constants.KubernetesAuditPolicyConfigFile], policy)
+			}
+			if manifestNew := string(s.files[constants.KubeAPIServerPodManifestFile]) != apiServerManifest; manifestNew != tt.wantManifestNew {
+				t.Errorf("manifest rewritten = %v, want %v", manifestNew, tt.wantManifestNew)
+			}
+			restarted := false
+			for _, cmd := range s.commands {
+				if strings.HasPrefix(cmd, "docker rm") {
+					restarted = true
+				}
+			}
+			if restarted != tt.wantRestart {
+				t.Errorf("apiserver restarted = %v, want %v, commands %v", restarted, tt.wantRestart, s.commands)
+			}
+			if !tt.wantRestart && !tt.wantManifestNew && len(s.commands) != 0 {
+				t.Errorf("commands = %v, want apiserver untouched", s.commands)
+			}
+		})
+	}
+}
+
+func auditCluster(audit *platformv1.ClusterAudit) *v1.Cluster {
+	return &v1.Cluster{
+		Cluster: &platformv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cls-1"},
+			Spec: platformv1.ClusterSpec{
+				Features: platformv1.ClusterFeature{Audit: audit},
+			},
+		},
+	}
+}
+
+func TestGetAuditFiles(t *testing.T) {
+	const policy = "apiVersion: audit.k8s.io/v1\nkind: Policy\n"
+	platformAudit := config.Audit{Address: "https://audit.tke:443", Token: "secret"}
+	tests := []struct {
+		name        string
+		audit       config.Audit
+		cluster     *platformv1.ClusterAudit
+		wantErr     bool
+		wantFiles   []string
+		wantToken   string
+		wantAddress string
+	}{
+		{
+			name: "audit disabled",
+		},
+		{
+			name:    "platform audit without default policy",
+			audit:   platformAudit,
+			wantErr: false,
+		},
+		{
+			name:    "cluster audit without policy",
+			cluster: &platformv1.ClusterAudit{Log: &platformv1.AuditLogBackend{}},
+			wantErr: true,
+		},
+		{
+			name:      "log backend only",
+			audit:     platformAudit,
+			cluster:   &platformv1.ClusterAudit{Policy: policy, Log: &platformv1.AuditLogBackend{}},
+			wantFiles: []string{constants.KubernetesAuditPolicyConfigFile},
+		},
+		{
+			name:        "platform webhook",
+			audit:       platformAudit,
+			cluster:     &platformv1.ClusterAudit{Policy: policy, Webhook: &platformv1.AuditWebhookBackend{}},
+			wantFiles:   []string{constants.KubernetesAuditPolicyConfigFile, constants.KubernetesAuditWebhookConfigFile},
+			wantToken:   sink.ClusterToken("secret", "cls-1"),
+			wantAddress: "https://audit.tke:443",
+		},
+		{
+			name:        "external webhook",
+			audit:       platformAudit,
+			cluster:     &platformv1.ClusterAudit{Policy: policy, Webhook: &platformv1.AuditWebhookBackend{Address: "https://audit.example.com"}},
+			wantFiles:   []string{constants.KubernetesAuditPolicyConfigFile, constants.KubernetesAuditWebhookConfigFile},
+			wantAddress: "https://audit.example.com",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Provider{config: &config.Config{Audit: tt.audit}}
+			files, err := p.getAuditFiles(auditCluster(tt.cluster))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getAuditFiles() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var names []string
+			for name := range files {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			sort.Strings(tt.wantFiles)
+			if !reflect.DeepEqual(names, tt.wantFiles) {
+				t.Fatalf("getAuditFiles() = %v, want %v", names, tt.wantFiles)
+			}
+			webhookConfig := string(files[constants.KubernetesAuditWebhookConfigFile])
+			if webhookConfig == "" {
+				return
+			}
+			if !strings.Contains(webhookConfig, tt.wantAddress+"/apis/audit.tkestack.io/v1/events/sink/cls-1") {
+				t.Errorf("webhook config %s, want address %s", webhookConfig, tt.wantAddress)
+			}
+			if strings.Contains(webhookConfig, "secret") {
+				t.Errorf("webhook config %s contains the token of the sink", webhookConfig)
+			}
+			if tt.wantToken != "" && !strings.Contains(webhookConfig, "token: "+tt.wantToken) {
+				t.Errorf("webhook config %s, want token %s", webhookConfig, tt.wantToken)
+			}
+			if tt.wantToken == "" && strings.Contains(webhookConfig, "token:") {
+				t.Errorf("webhook config %s, want no token", webhookConfig)
+			}
+		})
+	}
+}
+
+func TestGetAuditAPIServerConfig(t *testing.T) {
+	p := &Provider{config: &config.Config{Audit: config.Audit{Address: "https://audit.tke:443"}}}
+	c := auditCluster(&platformv1.ClusterAudit{
+		Log:     &platformv1.AuditLogBackend{Path: "/data/audit/audit.log", MaxAge: 7, MaxBackup: 3, MaxSize: 100},
+		Webhook: &platformv1.AuditWebhookBackend{},
+	})
+	c.Spec.APIServerExtraArgs = map[string]string{"audit-log-maxage": "14", "v": "4"}
+
+	got := p.getAuditAPIServerConfig(c, nil)
+	want := map[string]string{
+		"audit-policy-file":         constants.KubernetesAuditPolicyConfigFile,
+		"audit-webhook-config-file": constants.KubernetesAuditWebhookConfigFile,
+		"audit-log-path":            "/data/audit/audit.log",
+		"audit-log-maxage":          "14",
+		"audit-log-maxbackup":       "3",
+		"audit-log-maxsize":         "100",
+	}
+	if !reflect.DeepEqual(got.args, want) {
+		t.Errorf("args = %v, want %v", got.args, want)
+	}
+	if !reflect.DeepEqual(got.volumes, auditLogMount("/data/audit")) {
+		t.Errorf("volumes = %v, want %v", got.volumes, auditLogMount("/data/audit"))
+	}
+}
+
+func TestEnsureAuditDisabled(t *testing.T) {
+	p := &Provider{config: &config.Config{}}
+	// the cluster has no machines, it fails if any of them is touched
+	if err := p.EnsureAudit(context.Background(), auditCluster(nil)); err != nil {
+		t.Errorf("EnsureAudit() error = %v", err)
+	}
+}
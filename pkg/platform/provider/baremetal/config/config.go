@@ -56,6 +56,7 @@ type Config struct {
 	Scheduler               Scheduler         `yaml:"scheduler"`
 	AuthzWebhook            AuthzWebhook      `yaml:"authzWebhook"`
 	Business                Business          `yaml:"business"`
+	ArtifactServer          ArtifactServer    `yaml:"artifactServer"`
 }
 
 func (c *Config) Save(filename string) error {
@@ -88,8 +89,26 @@ func (r *Registry) NeedSetHosts() bool {
 	return r.IP != ""
 }
 
+// InsecureRegistries returns the registries of platform which are trusted by
+// the container runtime of nodes belonging to the tenant.
+func (r *Registry) InsecureRegistries(tenantID string) []string {
+	registries := []string{r.Domain}
+	if r.NeedSetHosts() && tenantID != "" {
+		registries = append(registries, tenantID+"."+r.Domain)
+	}
+	return registries
+}
+
 type Audit struct {
 	Address string `yaml:"address"`
+	// Token is the token of the sink of the platform, the apiserver of each
+	// cluster sends the audit events with the token derived from it for the
+	// cluster, so the token itself is never written to the clusters.
+	Token string `yaml:"token"`
+	// RolloutToExistingClusters enables the audit webhook on the clusters
+	// without audit settings when they are updated, which restarts their
+	// apiservers one by one.
+	RolloutToExistingClusters bool `yaml:"rolloutToExistingClusters"`
 }
 
 type Feature struct {
@@ -122,3 +141,12 @@ type AuthzWebhook struct {
 type Business struct {
 	Enabled bool `yaml:"enabled"`
 }
+
+// ArtifactServer serves the packages of provider to nodes over http instead of
+// copying them by ssh.
+type ArtifactServer struct {
+	// BindAddress is the address to serve the packages on, e.g. ":9446".
+	BindAddress string `yaml:"bindAddress"`
+	// URL is the address which nodes download the packages from.
+	URL string `yaml:"url"`
+}
@@ -27,6 +27,7 @@ import (
 const (
 	AuditPolicyConfigName  = "audit-policy.yaml"
 	AuthzWebhookConfigName = "tke-authz-webhook.yaml"
+	AuthnWebhookConfigName = "tke-authn-webhook.yaml"
 	OIDCCACertName         = "oidc-ca.crt"
 	AdminCertName          = "admin.crt"
 	AdminKeyName           = "admin.key"
@@ -39,9 +40,14 @@ const (
 	TokenFile                           = KubernetesDir + "known_tokens.csv"
 	KubernetesAuditPolicyConfigFile     = KubernetesDir + AuditPolicyConfigName
 	KubernetesAuthzWebhookConfigFile    = KubernetesDir + AuthzWebhookConfigName
+	KubernetesAuthnWebhookConfigFile    = KubernetesDir + AuthnWebhookConfigName
+	KubernetesEncryptionConfigFile      = KubernetesDir + "encryption-provider-config.yaml"
 	KubeadmConfigFileName               = KubernetesDir + "kubeadm-config.yaml"
 	KubeletKubeConfigFileName           = KubernetesDir + "kubelet.conf"
 
+	// AuditLogFile is the default file of apiserver audit log backend
+	AuditLogFile = "/var/log/kubernetes/audit/audit.log"
+
 	KubeletPodManifestDir                = KubernetesDir + "manifests/"
 	EtcdPodManifestFile                  = KubeletPodManifestDir + "etcd.yaml"
 	KubeAPIServerPodManifestFile         = KubeletPodManifestDir + "kube-apiserver.yaml"
@@ -66,6 +72,9 @@ const (
 
 	// ETC
 	EtcdDataDir          = "/var/lib/etcd"
+	EtcdBackupDir        = "/var/lib/etcd-backup"
+	UpgradeBackupDir     = "/var/lib/upgrade-backup"
+	ConfigBaselineDir    = "/var/lib/config-baseline"
 	KubectlConfigFile    = "/root/.kube/config"
 	KeepavliedConfigFile = "/etc/keepalived/keepalived.conf"
 
@@ -87,10 +96,18 @@ const (
 	APIServerKeyName = CertificatesDir + "apiserver.key"
 	// KubeletClientCurrent defines kubelet rotate certificates
 	KubeletClientCurrent = "/var/lib/kubelet/pki/kubelet-client-current.pem"
+	// KubeletConfigFile defines the kubelet configuration file written by kubeadm
+	KubeletConfigFile = "/var/lib/kubelet/config.yaml"
+	// KubeletDockerConfigFile defines the registry credentials used by kubelet to pull images
+	KubeletDockerConfigFile = "/var/lib/kubelet/config.json"
 	// EtcdCACertName defines etcd's CA certificate name
 	EtcdCACertName = CertificatesDir + "etcd/ca.crt"
 	// EtcdCAKeyName defines etcd's CA key name
 	EtcdCAKeyName = CertificatesDir + "etcd/ca.key"
+	// EtcdHealthcheckClientCertName defines etcd's healthcheck client certificate name
+	EtcdHealthcheckClientCertName = CertificatesDir + "etcd/healthcheck-client.crt"
+	// EtcdHealthcheckClientKeyName defines etcd's healthcheck client key name
+	EtcdHealthcheckClientKeyName = CertificatesDir + "etcd/healthcheck-client.key"
 	// EtcdListenClientPort defines the port etcd listen on for client traffic
 	EtcdListenClientPort = 2379
 	// EtcdListenPeerPort defines the port etcd listen on for peer traffic
@@ -99,6 +116,16 @@ const (
 	APIServerEtcdClientCertName = CertificatesDir + "apiserver-etcd-client.crt"
 	// APIServerEtcdClientKeyName defines apiserver's etcd client key name
 	APIServerEtcdClientKeyName = CertificatesDir + "apiserver-etcd-client.key"
+	// APIServerKubeletClientCertName defines apiserver's kubelet client certificate name
+	APIServerKubeletClientCertName = CertificatesDir + "apiserver-kubelet-client.crt"
+	// FrontProxyCACertName defines front proxy's CA certificate name
+	FrontProxyCACertName = CertificatesDir + "front-proxy-ca.crt"
+	// FrontProxyClientCertName defines front proxy's client certificate name
+	FrontProxyClientCertName = CertificatesDir + "front-proxy-client.crt"
+	// EtcdServerCertName defines etcd's server certificate name
+	EtcdServerCertName = CertificatesDir + "etcd/server.crt"
+	// EtcdPeerCertName defines etcd's peer certificate name
+	EtcdPeerCertName = CertificatesDir + "etcd/peer.crt"
 
 	// LabelNodeRoleMaster specifies that a node is a control-plane
 	// This is a duplicate definition of the constant in pkg/controller/service/service_controller.go
@@ -119,6 +146,11 @@ const (
 	CSIOperatorManifest   = ManifestsDir + "csi-operator/csi-operator.yaml"
 	MetricsServerManifest = ManifestsDir + "metrics-server/metrics-server.yaml"
 	CiliumManifest        = ManifestsDir + "cilium/*.yaml"
+	CalicoManifest        = ManifestsDir + "calico/*.yaml"
+
+	ImageAdmissionManifest   = ManifestsDir + "image-admission/image-admission.yaml"
+	SandboxAdmissionManifest = ManifestsDir + "sandbox-admission/sandbox-admission.yaml"
+	DNSAutoscalerManifest    = ManifestsDir + "dns-autoscaler/dns-autoscaler.yaml"
 
 	KUBERNETES                   = 1
 	DNSIPIndex                   = 10
@@ -133,6 +165,10 @@ const (
 	// MinNumCPU mininum cpu number.
 	MinNumCPU = 2
 
+	// DefaultMaxClockSkew is the max clock skew allowed between node and
+	// platform controller when time sync is enabled.
+	DefaultMaxClockSkew = 5 * time.Second
+
 	APIServerHostName = "api.tke.com"
 
 	NeedUpgradeCoreDNSK8sVersion = "1.19.0"
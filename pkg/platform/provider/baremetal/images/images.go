@@ -20,6 +20,7 @@ import (
 	"reflect"
 	"sort"
 
+	"tkestack.io/tke/pkg/app/version"
 	"tkestack.io/tke/pkg/spec"
 	"tkestack.io/tke/pkg/util/containerregistry"
 )
@@ -31,17 +32,29 @@ type Components struct {
 	NvidiaDevicePlugin containerregistry.Image
 	Keepalived         containerregistry.Image
 
+	// the device plugin of MIG strategy and MIG manager for physical GPU
+	NvidiaDevicePluginMIG containerregistry.Image
+	NvidiaMIGManager      containerregistry.Image
+
 	GPUManager        containerregistry.Image
 	Busybox           containerregistry.Image
 	GPUQuotaAdmission containerregistry.Image
 
 	MetricsServer  containerregistry.Image
 	AddonResizer   containerregistry.Image
+	DNSAutoscaler  containerregistry.Image
 	Cilium         containerregistry.Image
 	CiliumOperator containerregistry.Image
 	Ipamd          containerregistry.Image
 	Masq           containerregistry.Image
 	CiliumRouter   containerregistry.Image
+
+	CalicoNode            containerregistry.Image
+	CalicoCNI             containerregistry.Image
+	CalicoKubeControllers containerregistry.Image
+
+	ImageAdmission   containerregistry.Image
+	SandboxAdmission containerregistry.Image
 }
 
 func (c Components) Get(name string) *containerregistry.Image {
@@ -63,18 +76,29 @@ var components = Components{
 	NvidiaDevicePlugin: containerregistry.Image{Name: "nvidia-device-plugin", Tag: "1.0.0-beta4"},
 	Keepalived:         containerregistry.Image{Name: "keepalived", Tag: "2.0.16-r0"},
 
+	NvidiaDevicePluginMIG: containerregistry.Image{Name: "nvidia-device-plugin", Tag: "v0.9.0"},
+	NvidiaMIGManager:      containerregistry.Image{Name: "k8s-mig-manager", Tag: "v0.1.0"},
+
 	GPUManager:        containerregistry.Image{Name: "gpu-manager", Tag: "v1.0.6"},
 	Busybox:           containerregistry.Image{Name: "busybox", Tag: "1.31.1"},
 	GPUQuotaAdmission: containerregistry.Image{Name: "gpu-quota-admission", Tag: "v1.0.0"},
 
 	MetricsServer: containerregistry.Image{Name: "metrics-server", Tag: "v0.3.6"},
 	AddonResizer:  containerregistry.Image{Name: "addon-resizer", Tag: "1.8.11"},
+	DNSAutoscaler: containerregistry.Image{Name: "cluster-proportional-autoscaler", Tag: "1.8.3"},
 
 	Cilium:         containerregistry.Image{Name: "cilium", Tag: "v1.9.5"},
 	CiliumOperator: containerregistry.Image{Name: "cilium-operator-generic", Tag: "v1.9.5"},
 	Ipamd:          containerregistry.Image{Name: "tke-eni-ipamd", Tag: "v3.2.6"},
 	Masq:           containerregistry.Image{Name: "ip-masq-agent", Tag: "v1.0.0"},
 	CiliumRouter:   containerregistry.Image{Name: "cilium-router", Tag: "v0.1.0"},
+
+	CalicoNode:            containerregistry.Image{Name: "calico-node", Tag: "v3.17.3"},
+	CalicoCNI:             containerregistry.Image{Name: "calico-cni", Tag: "v3.17.3"},
+	CalicoKubeControllers: containerregistry.Image{Name: "calico-kube-controllers", Tag: "v3.17.3"},
+
+	ImageAdmission:   containerregistry.Image{Name: "tke-image-admission", Tag: version.Get().GitVersion},
+	SandboxAdmission: containerregistry.Image{Name: "tke-sandbox-admission", Tag: version.Get().GitVersion},
 }
 
 func List() []string {
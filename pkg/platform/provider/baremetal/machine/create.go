@@ -19,26 +19,32 @@
 package machine
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 	"os"
-	"path"
-	"strings"
 	"time"
 
 	"github.com/imdario/mergo"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/addons/cniplugins"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/chrony"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/docker"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/drift"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/encryption"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeadm"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubeconfig"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/kubelet"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/sandbox"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/systemd"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/preflight"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
@@ -49,9 +55,7 @@ import (
 )
 
 const (
-	sysctlFile       = "/etc/sysctl.conf"
-	sysctlCustomFile = "/etc/sysctl.d/99-tke.conf"
-	moduleFile       = "/etc/modules-load.d/tke.conf"
+	sysctlFile = "/etc/sysctl.conf"
 )
 
 func (p *Provider) EnsureCopyFiles(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
@@ -168,19 +172,7 @@ func (p *Provider) EnsureKernelModule(ctx context.Context, machine *platformv1.M
 		return err
 	}
 
-	modules := []string{"iptable_nat", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh"}
-	if _, err := s.CombinedOutput("modinfo br_netfilter"); err == nil {
-		modules = append(modules, "br_netfilter")
-	}
-	var data bytes.Buffer
-	for _, m := range modules {
-		_, err := s.CombinedOutput(fmt.Sprintf("modprobe %s", m))
-		if err != nil {
-			return err
-		}
-		data.WriteString(m + "\n")
-	}
-	err = s.WriteFile(strings.NewReader(data.String()), moduleFile)
+	_, err = tuning.ApplyKernelModules(s, tuning.ForCluster(cluster.Cluster).KernelModules)
 	if err != nil {
 		return err
 	}
@@ -204,18 +196,43 @@ func (p *Provider) EnsureSysctl(ctx context.Context, machine *platformv1.Machine
 		return err
 	}
 
-	f, err := os.Open(path.Join(constants.ConfDir, "sysctl.conf"))
-	if err == nil {
-		err = machineSSH.WriteFile(f, sysctlCustomFile)
-		if err != nil {
-			return err
-		}
+	_, err = tuning.ApplySysctls(machineSSH, cluster.SystemTuning().Sysctls)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// EnsureSystemTuning applies the ulimits and transparent hugepage setting
+// besides the kernel parameters and modules declared by cluster.
+func (p *Provider) EnsureSystemTuning(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
 	}
 
-	_, err = machineSSH.CombinedOutput("sysctl --system")
+	_, err = tuning.Apply(machineSSH, tuning.ForCluster(cluster.Cluster))
 	if err != nil {
 		return err
 	}
+
+	return nil
+}
+
+func (p *Provider) EnsureTimeSync(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if cluster.Spec.Features.TimeSync == nil {
+		return nil
+	}
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	err = chrony.Install(machineSSH, cluster.Spec.Features.TimeSync.Servers)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -247,6 +264,17 @@ func (p *Provider) EnsureDisableOffloading(ctx context.Context, machine *platfor
 	return nil
 }
 
+// EnsureConfigBaseline records the files rendered by provider on node, which
+// are used to detect and remediate config drift.
+func (p *Provider) EnsureConfigBaseline(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	return drift.Snapshot(machineSSH)
+}
+
 func (p *Provider) EnsureManifestDir(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 	machineSSH, err := machine.Spec.SSH()
 	if err != nil {
@@ -311,29 +339,62 @@ func (p *Provider) EnsureNvidiaContainerRuntime(ctx context.Context, machine *pl
 	return gpu.InstallNvidiaContainerRuntime(machineSSH, &gpu.NvidiaContainerRuntimeOption{})
 }
 
+func (p *Provider) EnsureSandboxRuntime(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if cluster.Spec.Features.SandboxRuntime == nil || !sandbox.IsEnable(machine.Spec.Labels) {
+		return nil
+	}
+
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	return sandbox.Install(machineSSH, cluster.Spec.Features.SandboxRuntime.Type)
+}
+
 func (p *Provider) EnsureDocker(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 	machineSSH, err := machine.Spec.SSH()
 	if err != nil {
 		return err
 	}
 
-	insecureRegistries := fmt.Sprintf(`"%s"`, p.config.Registry.Domain)
-	if p.config.Registry.NeedSetHosts() && machine.Spec.TenantID != "" {
-		insecureRegistries = fmt.Sprintf(`%s,"%s"`, insecureRegistries, machine.Spec.TenantID+"."+p.config.Registry.Domain)
+	insecureRegistries := p.config.Registry.InsecureRegistries(machine.Spec.TenantID)
+	var mirrors []string
+	var auths []platformv1.RegistryAuth
+	if registries := cluster.Spec.ContainerRegistries; registries != nil {
+		for _, one := range registries.InsecureRegistries {
+			if !funk.ContainsString(insecureRegistries, one) {
+				insecureRegistries = append(insecureRegistries, one)
+			}
+		}
+		mirrors, auths = registries.Mirrors, registries.Auths
 	}
 
 	extraArgs := cluster.Spec.DockerExtraArgs
 	utilruntime.Must(mergo.Merge(&extraArgs, p.config.Docker.ExtraArgs))
 	option := &docker.Option{
-		InsecureRegistries: insecureRegistries,
+		InsecureRegistries: docker.FormatRegistries(insecureRegistries),
+		RegistryMirrors:    docker.FormatRegistries(mirrors),
 		RegistryDomain:     p.config.Registry.Domain,
+		CgroupDriver:       cluster.CgroupDriver(),
 		IsGPU:              gpu.IsEnable(machine.Spec.Labels),
 		ExtraArgs:          extraArgs,
 	}
+	if cluster.Spec.Features.SandboxRuntime != nil && sandbox.IsEnable(machine.Spec.Labels) {
+		r, err := sandbox.Get(cluster.Spec.Features.SandboxRuntime.Type)
+		if err != nil {
+			return err
+		}
+		option.SandboxRuntimeHandler, option.SandboxRuntimePath = r.Handler, r.Path
+	}
 	err = docker.Install(machineSSH, option)
 	if err != nil {
 		return err
 	}
+	_, err = docker.ApplyRegistryAuths(machineSSH, auths, cluster.RegistryPasswords())
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -344,6 +405,11 @@ func (p *Provider) EnsureKubelet(ctx context.Context, machine *platformv1.Machin
 		return err
 	}
 
+	err = util.VerifyKubernetesNodePackage(ctx, p.platformClient, machineSSH, cluster.Spec.Version)
+	if err != nil {
+		return err
+	}
+
 	err = kubelet.Install(machineSSH, cluster.Spec.Version)
 	if err != nil {
 		return err
@@ -380,6 +446,24 @@ func (p *Provider) EnsureConntrackTools(ctx context.Context, machine *platformv1
 	return nil
 }
 
+func (p *Provider) EnsureWireGuard(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if cluster.Spec.Features.NetworkEncryption == nil ||
+		cluster.Spec.Features.NetworkEncryption.Type != platformv1.NetworkEncryptionWireGuard {
+		return nil
+	}
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	err = encryption.InstallWireGuard(machineSSH)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureKubeadm(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 	machineSSH, err := machine.Spec.SSH()
 	if err != nil {
@@ -394,6 +478,20 @@ func (p *Provider) EnsureKubeadm(ctx context.Context, machine *platformv1.Machin
 	return nil
 }
 
+func (p *Provider) EnsureServiceOverrides(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	_, err = systemd.Apply(machineSSH, systemd.MergeOverrides(cluster.Spec.Features.ServiceOverrides, machine.Spec.ServiceOverrides))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (p *Provider) EnsureJoinPhasePreflight(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 	machineSSH, err := machine.Spec.SSH()
 	if err != nil {
@@ -421,6 +519,51 @@ func (p *Provider) EnsureJoinPhaseKubeletStart(ctx context.Context, machine *pla
 	return nil
 }
 
+func (p *Provider) EnsureKubeletConfiguration(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	clientset, err := cluster.Clientset()
+	if err != nil {
+		return err
+	}
+	name, err := kubelet.ConfigMapName(cluster.Spec.Version)
+	if err != nil {
+		return err
+	}
+	cm, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "get kubelet configuration %s error", name)
+	}
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+
+	err = kubelet.ApplyConfiguration(machineSSH, []byte(cm.Data[kubelet.ConfigMapKey]), machine.Spec.KubeletConfiguration)
+	if err != nil {
+		return err
+	}
+	hash := kubelet.ConfigurationOverridesHash(machine.Spec.KubeletConfiguration)
+	if hash == "" {
+		delete(machine.Annotations, platformv1.AnnotationMachineKubeletConfigurationHash)
+		return nil
+	}
+	if machine.Annotations == nil {
+		machine.Annotations = make(map[string]string)
+	}
+	machine.Annotations[platformv1.AnnotationMachineKubeletConfigurationHash] = hash
+
+	return nil
+}
+
+// EnsureKubeletConfigurationOverrides applies the kubelet configuration of
+// running machine again once its overrides are changed.
+func (p *Provider) EnsureKubeletConfigurationOverrides(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if machine.Annotations[platformv1.AnnotationMachineKubeletConfigurationHash] == kubelet.ConfigurationOverridesHash(machine.Spec.KubeletConfiguration) {
+		return nil
+	}
+
+	return p.EnsureKubeletConfiguration(ctx, machine, cluster)
+}
+
 func (p *Provider) EnsureMarkNode(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 	clientset, err := cluster.Clientset()
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"tkestack.io/tke/api/platform"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/config"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/validation"
 	machineprovider "tkestack.io/tke/pkg/platform/provider/machine"
 	"tkestack.io/tke/pkg/util/containerregistry"
@@ -67,6 +68,8 @@ func NewProvider() (*Provider, error) {
 
 			p.EnsureKernelModule,
 			p.EnsureSysctl,
+			p.EnsureSystemTuning,
+			p.EnsureTimeSync,
 			p.EnsureDisableSwap,
 			p.EnsureManifestDir,
 
@@ -74,26 +77,37 @@ func NewProvider() (*Provider, error) {
 
 			p.EnsureNvidiaDriver,
 			p.EnsureNvidiaContainerRuntime,
+			p.EnsureSandboxRuntime,
 			p.EnsureDocker,
 			p.EnsureKubelet,
 			p.EnsureCNIPlugins,
 			p.EnsureConntrackTools,
+			p.EnsureWireGuard,
 			p.EnsureKubeadm,
+			p.EnsureServiceOverrides,
 
 			p.EnsureJoinPhasePreflight,
 			p.EnsureJoinPhaseKubeletStart,
+			p.EnsureKubeletConfiguration,
 
 			p.EnsureKubeconfig,
 			p.EnsureMarkNode,
 			p.EnsureNodeReady,
 			p.EnsureDisableOffloading, // will remove it when upgrade to k8s v1.18.5
+			p.EnsureConfigBaseline,
 			p.EnsurePostInstallHook,
 		},
 		UpdateHandlers: []machineprovider.Handler{
+			p.EnsureUpgradeRollback,
 			p.EnsurePreUpgradeHook,
 			p.EnsureUpgrade,
+			p.EnsureKubeletConfiguration,
+			p.EnsureConfigBaseline,
 			p.EnsurePostUpgradeHook,
 		},
+		ReconcileHandlers: []machineprovider.Handler{
+			p.EnsureKubeletConfigurationOverrides,
+		},
 	}
 
 	cfg, err := config.New(constants.ConfigFile)
@@ -103,6 +117,7 @@ func NewProvider() (*Provider, error) {
 	p.config = cfg
 
 	containerregistry.Init(cfg.Registry.Domain, cfg.Registry.Namespace)
+	res.Init(cfg.ArtifactServer.URL)
 
 	// Run for compatibility with installer.
 	// TODO: Installer reuse platform components
@@ -20,6 +20,7 @@ package machine
 
 import (
 	"context"
+	"fmt"
 
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
@@ -66,6 +67,15 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 		DrainNodeBeforeUpgrade: cluster.Spec.Features.Upgrade.Strategy.DrainNodeBeforeUpgrade,
 	}
 	logger := log.FromContext(ctx).WithName("Cluster upgrade")
+	// keep the backup taken before the first attempt, the retries of a failed
+	// upgrade start from a half upgraded node.
+	if machine.Status.UpgradeBackup == nil || machine.Status.UpgradeBackup.TargetVersion != option.Version {
+		backup, err := kubeadm.BackupNode(machineSSH, clientset, machine.Spec.IP, option.Version)
+		if err != nil {
+			return fmt.Errorf("backup before upgrade error: %w", err)
+		}
+		machine.Status.UpgradeBackup = backup
+	}
 	upgraded, err := kubeadm.UpgradeNode(machineSSH, clientset, p.platformClient, logger, cluster, option)
 	if err != nil {
 		return err
@@ -79,7 +89,7 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 		return err
 	}
 
-	err = kubeadm.MarkNextUpgradeWorkerNode(clientset, p.platformClient, option.Version, cluster.Name)
+	err = kubeadm.MarkNextUpgradeWorkerNode(clientset, p.platformClient, option.Version, cluster.Name, cluster.Spec.Features.Upgrade)
 	if err != nil {
 		return err
 	}
@@ -87,6 +97,39 @@ func (p *Provider) EnsureUpgrade(ctx context.Context, machine *platformv1.Machin
 	return nil
 }
 
+// EnsureUpgradeRollback restores the machine to the backup taken before
+// upgrade when it is annotated to roll back, and stops upgrading it.
+func (p *Provider) EnsureUpgradeRollback(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
+	if machine.Annotations[platformv1.AnnotationMachineUpgradeRollback] != "true" {
+		return nil
+	}
+	backup := machine.Status.UpgradeBackup
+	if backup == nil {
+		return fmt.Errorf("no backup before upgrade to roll back to")
+	}
+
+	machineSSH, err := machine.Spec.SSH()
+	if err != nil {
+		return err
+	}
+	clientset, err := cluster.Clientset()
+	if err != nil {
+		return err
+	}
+	err = kubeadm.RollbackNode(machineSSH, clientset, machine.Spec.IP, backup)
+	if err != nil {
+		return err
+	}
+	log.FromContext(ctx).Info("Machine rolled back", "version", backup.Version, "backup", backup.Dir)
+
+	delete(machine.Annotations, platformv1.AnnotationMachineUpgradeRollback)
+	delete(machine.Labels, constants.LabelNodeNeedUpgrade)
+	machine.Status.Phase = platformv1.MachineRunning
+	machine.Status.UpgradeBackup = nil
+
+	return nil
+}
+
 func (p *Provider) EnsurePostUpgradeHook(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
 
 	mc := []platformv1.ClusterMachine{
@@ -0,0 +1,58 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machine
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestEnsureUpgradeRollback(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		backup      *platformv1.MachineUpgradeBackup
+		wantErr     bool
+	}{
+		{"not annotated", nil, &platformv1.MachineUpgradeBackup{Version: "v1.19.7"}, false},
+		{"annotated false", map[string]string{platformv1.AnnotationMachineUpgradeRollback: "false"}, nil, false},
+		{"no backup", map[string]string{platformv1.AnnotationMachineUpgradeRollback: "true"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			machine := &platformv1.Machine{
+				ObjectMeta: metav1.ObjectMeta{Name: "mc-1", Annotations: tt.annotations},
+				Status:     platformv1.MachineStatus{Phase: platformv1.MachineUpgrading, UpgradeBackup: tt.backup},
+			}
+			p := &Provider{}
+			err := p.EnsureUpgradeRollback(context.Background(), machine, &typesv1.Cluster{Cluster: &platformv1.Cluster{}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnsureUpgradeRollback() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			// the machine is kept upgrading until it is rolled back
+			if machine.Status.Phase != platformv1.MachineUpgrading || machine.Status.UpgradeBackup != tt.backup {
+				t.Errorf("EnsureUpgradeRollback() changed machine status to %+v", machine.Status)
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ package authzwebhook
 import (
 	"bytes"
 	"io/ioutil"
+	"strings"
 
 	installerconstants "tkestack.io/tke/cmd/tke-installer/app/installer/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
@@ -32,13 +33,18 @@ import (
 )
 
 const (
+	// authnWebhookPath is the path of the token webhook of tke-auth, which
+	// authenticates the tokens of the short-lived kubeconfigs issued for the
+	// cluster name following it.
+	authnWebhookPath = "/kubeconfigs/tokenreview"
+
 	authzWebhookConfig = `
 apiVersion: v1
 kind: Config
 clusters:
   - name: tke
     cluster:
-      server: {{.AuthzEndpoint}}
+      server: {{.Endpoint}}
       insecure-skip-tls-verify: true
 users:
   - name: admin-cert
@@ -54,8 +60,16 @@ contexts:
 `
 )
 
+// AuthnWebhookEndpoint returns the endpoint of the token webhook of tke-auth
+// serving along with the authorization webhook endpoint, which only
+// authenticates the kubeconfigs issued for the cluster.
+func AuthnWebhookEndpoint(authzWebhookEndpoint string, clusterName string) string {
+	return strings.TrimSuffix(authzWebhookEndpoint, "/authz") + authnWebhookPath + "/" + clusterName
+}
+
 type Option struct {
 	AuthzWebhookEndpoint string
+	ClusterName          string
 	IsGlobalCluster      bool
 	IsClusterUpscaling   bool
 }
@@ -87,7 +101,7 @@ func Install(s ssh.Interface, option *Option) error {
 	}
 
 	authzWebhookConfig, err := template.ParseString(authzWebhookConfig, map[string]interface{}{
-		"AuthzEndpoint":   option.AuthzWebhookEndpoint,
+		"Endpoint":        option.AuthzWebhookEndpoint,
 		"WebhookCertFile": webhookCertFile,
 		"WebhookKeyFile":  webhookKeyFile,
 	})
@@ -99,6 +113,21 @@ func Install(s ssh.Interface, option *Option) error {
 	if err != nil {
 		return err
 	}
+	// The token webhook shares the kubeconfig format and the client
+	// certificate with the authorization webhook.
+	authnWebhookConfig, err := template.ParseString(authzWebhookConfig, map[string]interface{}{
+		"Endpoint":        AuthnWebhookEndpoint(option.AuthzWebhookEndpoint, option.ClusterName),
+		"WebhookCertFile": webhookCertFile,
+		"WebhookKeyFile":  webhookKeyFile,
+	})
+	if err != nil {
+		return errors.Wrap(err, "parse authnWebhookConfig error")
+	}
+
+	err = s.WriteFile(bytes.NewReader(authnWebhookConfig), constants.KubernetesAuthnWebhookConfigFile)
+	if err != nil {
+		return err
+	}
 	webhookCertData, err := ioutil.ReadFile(basePath + webhookCertName)
 	if err != nil {
 		return err
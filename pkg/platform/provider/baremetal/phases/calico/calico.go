@@ -0,0 +1,190 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package calico
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	crdGroup = "crd.projectcalico.org"
+
+	defaultASNumber  = 64512
+	defaultVXLANMTU  = 1450
+	defaultNativeMTU = 1500
+)
+
+// crdKinds are the custom resources used by calico with the kubernetes datastore.
+var crdKinds = []string{
+	"BGPConfiguration",
+	"BGPPeer",
+	"BlockAffinity",
+	"ClusterInformation",
+	"FelixConfiguration",
+	"GlobalNetworkPolicy",
+	"GlobalNetworkSet",
+	"HostEndpoint",
+	"IPAMBlock",
+	"IPAMConfig",
+	"IPAMHandle",
+	"IPPool",
+	"KubeControllersConfiguration",
+	"NetworkPolicy",
+	"NetworkSet",
+}
+
+// namespacedKinds are the namespaced custom resources of calico.
+var namespacedKinds = map[string]bool{
+	"NetworkPolicy": true,
+	"NetworkSet":    true,
+}
+
+// Option for calico
+type Option struct {
+	NodeImage            string
+	CNIImage             string
+	KubeControllersImage string
+	ClusterCIDR          string
+	NetDevice            string
+	// Backend is bird for BGP mode and vxlan for VXLAN mode.
+	Backend   string
+	VXLANMode string
+	ASNumber  int32
+	MTU       int32
+}
+
+// NewOption returns the option of calico for the cluster.
+func NewOption(cluster *platformv1.Cluster) *Option {
+	network := cluster.Spec.Features.Calico
+	if network == nil {
+		network = &platformv1.CalicoNetwork{}
+	}
+	option := &Option{
+		NodeImage:            images.Get().CalicoNode.FullName(),
+		CNIImage:             images.Get().CalicoCNI.FullName(),
+		KubeControllersImage: images.Get().CalicoKubeControllers.FullName(),
+		ClusterCIDR:          cluster.Spec.ClusterCIDR,
+		NetDevice:            cluster.Spec.NetworkDevice,
+		ASNumber:             network.ASNumber,
+		MTU:                  network.MTU,
+	}
+	if network.Mode == platformv1.CalicoModeBGP {
+		option.Backend = "bird"
+		option.VXLANMode = "Never"
+		if option.ASNumber == 0 {
+			option.ASNumber = defaultASNumber
+		}
+		if option.MTU == 0 {
+			option.MTU = defaultNativeMTU
+		}
+	} else {
+		option.Backend = "vxlan"
+		option.VXLANMode = "Always"
+		if option.MTU == 0 {
+			option.MTU = defaultVXLANMTU
+		}
+	}
+
+	return option
+}
+
+// Install creates the custom resource definitions of calico and installs the
+// calico workloads, it is also used to upgrade calico to the images of current
+// version since all resources are created or updated.
+func Install(ctx context.Context, client kubernetes.Interface, crdClient apiextensionsclient.Interface, option *Option) error {
+	for _, crd := range crds() {
+		err := createOrUpdateCRD(ctx, crdClient, crd)
+		if err != nil {
+			return errors.Wrapf(err, "create crd %s error", crd.Name)
+		}
+	}
+
+	return apiclient.CreateResourceWithDir(ctx, client, constants.CalicoManifest, option)
+}
+
+func createOrUpdateCRD(ctx context.Context, crdClient apiextensionsclient.Interface, crd *apiextensionsv1.CustomResourceDefinition) error {
+	_, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Create(ctx, crd, metav1.CreateOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	existing, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, crd.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	crd.ResourceVersion = existing.ResourceVersion
+	_, err = crdClient.ApiextensionsV1().CustomResourceDefinitions().Update(ctx, crd, metav1.UpdateOptions{})
+	return err
+}
+
+func crds() []*apiextensionsv1.CustomResourceDefinition {
+	preserveUnknownFields := true
+	var result []*apiextensionsv1.CustomResourceDefinition
+	for _, kind := range crdKinds {
+		plural := strings.ToLower(kind) + "s"
+		scope := apiextensionsv1.ClusterScoped
+		if namespacedKinds[kind] {
+			scope = apiextensionsv1.NamespaceScoped
+		}
+		result = append(result, &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: plural + "." + crdGroup,
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: crdGroup,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{
+					Kind:     kind,
+					ListKind: kind + "List",
+					Plural:   plural,
+					Singular: strings.ToLower(kind),
+				},
+				Scope: scope,
+				Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{
+						Name:    "v1",
+						Served:  true,
+						Storage: true,
+						Schema: &apiextensionsv1.CustomResourceValidation{
+							OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+								Type:                   "object",
+								XPreserveUnknownFields: &preserveUnknownFields,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return result
+}
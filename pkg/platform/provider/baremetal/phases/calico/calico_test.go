@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package calico
+
+import (
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestNewOption(t *testing.T) {
+	tests := []struct {
+		name      string
+		network   *platformv1.CalicoNetwork
+		backend   string
+		vxlanMode string
+		asNumber  int32
+		mtu       int32
+	}{
+		{
+			name:      "default",
+			backend:   "vxlan",
+			vxlanMode: "Always",
+			mtu:       defaultVXLANMTU,
+		},
+		{
+			name:      "bgp",
+			network:   &platformv1.CalicoNetwork{Mode: platformv1.CalicoModeBGP},
+			backend:   "bird",
+			vxlanMode: "Never",
+			asNumber:  defaultASNumber,
+			mtu:       defaultNativeMTU,
+		},
+		{
+			name:      "bgp with options",
+			network:   &platformv1.CalicoNetwork{Mode: platformv1.CalicoModeBGP, ASNumber: 65000, MTU: 9000},
+			backend:   "bird",
+			vxlanMode: "Never",
+			asNumber:  65000,
+			mtu:       9000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &platformv1.Cluster{}
+			cluster.Spec.ClusterCIDR = "10.244.0.0/16"
+			cluster.Spec.Features.Calico = tt.network
+			option := NewOption(cluster)
+			if option.Backend != tt.backend || option.VXLANMode != tt.vxlanMode ||
+				option.ASNumber != tt.asNumber || option.MTU != tt.mtu {
+				t.Errorf("NewOption() = %+v", option)
+			}
+			if option.ClusterCIDR != cluster.Spec.ClusterCIDR {
+				t.Errorf("ClusterCIDR = %s, want %s", option.ClusterCIDR, cluster.Spec.ClusterCIDR)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// CheckAPIServer checks the kube-apiserver on the canary master is healthy.
+	CheckAPIServer = "APIServer"
+	// CheckScheduling checks a pod can be scheduled to and run on the canary master.
+	CheckScheduling = "Scheduling"
+	// CheckDNS checks the cluster DNS has ready endpoints.
+	CheckDNS = "DNS"
+
+	schedulingCheckPodName = "upgrade-canary-check"
+	dnsServiceName         = "kube-dns"
+)
+
+// Check runs the health checks against the canary master, and returns the
+// result of each check as a condition.
+func Check(ctx context.Context, s ssh.Interface, client kubernetes.Interface, nodeName string) []platformv1.ClusterCondition {
+	checks := []struct {
+		name  string
+		check func() error
+	}{
+		{CheckAPIServer, func() error { return checkAPIServer(s) }},
+		{CheckScheduling, func() error { return checkScheduling(ctx, client, nodeName) }},
+		{CheckDNS, func() error { return checkDNS(ctx, client) }},
+	}
+	var conditions []platformv1.ClusterCondition
+	for _, one := range checks {
+		condition := platformv1.ClusterCondition{
+			Type:          one.name,
+			Status:        platformv1.ConditionTrue,
+			LastProbeTime: metav1.Now(),
+		}
+		if err := one.check(); err != nil {
+			condition.Status = platformv1.ConditionFalse
+			condition.Reason = "CheckFailed"
+			condition.Message = err.Error()
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return conditions
+}
+
+// Failed returns the names of failed checks.
+func Failed(conditions []platformv1.ClusterCondition) []string {
+	var names []string
+	for _, condition := range conditions {
+		if condition.Status != platformv1.ConditionTrue {
+			names = append(names, condition.Type)
+		}
+	}
+
+	return names
+}
+
+func checkAPIServer(s ssh.Interface) error {
+	output, err := s.CombinedOutput("curl -sk https://127.0.0.1:6443/readyz")
+	if err != nil {
+		return err
+	}
+	if result := strings.TrimSpace(string(output)); result != "ok" {
+		return fmt.Errorf("kube-apiserver is not ready: %s", result)
+	}
+
+	return nil
+}
+
+func checkScheduling(ctx context.Context, client kubernetes.Interface, nodeName string) error {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      schedulingCheckPodName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: corev1.PodSpec{
+			NodeSelector: map[string]string{corev1.LabelHostname: nodeName},
+			Tolerations: []corev1.Toleration{
+				{Key: constants.LabelNodeRoleMaster, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+			Containers: []corev1.Container{
+				{Name: "pause", Image: images.Get().Pause.FullName()},
+			},
+		},
+	}
+	pods := client.CoreV1().Pods(pod.Namespace)
+	err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	err = wait.PollImmediate(time.Second, time.Minute, func() (bool, error) {
+		_, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+		return apierrors.IsNotFound(err), nil
+	})
+	if err != nil {
+		return fmt.Errorf("wait previous check pod deleted error: %w", err)
+	}
+	_, err = pods.Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	defer pods.Delete(ctx, pod.Name, metav1.DeleteOptions{})
+
+	var phase corev1.PodPhase
+	err = wait.PollImmediate(5*time.Second, 2*time.Minute, func() (bool, error) {
+		current, err := pods.Get(ctx, pod.Name, metav1.GetOptions{})
+		if err != nil {
+			return false, nil
+		}
+		phase = current.Status.Phase
+		return phase == corev1.PodRunning, nil
+	})
+	if err != nil {
+		return fmt.Errorf("pod is not running on %s: phase %s", nodeName, phase)
+	}
+
+	return nil
+}
+
+func checkDNS(ctx context.Context, client kubernetes.Interface) error {
+	endpoints, err := client.CoreV1().Endpoints(metav1.NamespaceSystem).Get(ctx, dnsServiceName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	for _, subset := range endpoints.Subsets {
+		if len(subset.Addresses) > 0 {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("service %s has no ready endpoints", dnsServiceName)
+}
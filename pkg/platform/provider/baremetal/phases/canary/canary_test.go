@@ -0,0 +1,142 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// fakeSSH answers every command with output.
+type fakeSSH struct {
+	output string
+}
+
+func (f *fakeSSH) Ping() error                               { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error            { return nil }
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error { return nil }
+func (f *fakeSSH) Exist(filename string) (bool, error)       { return false, nil }
+func (f *fakeSSH) LookPath(file string) (string, error)      { return file, nil }
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) OpenFile(name string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", name)
+}
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	return []byte(f.output), nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	return f.output, "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func dnsEndpoints(addresses ...string) *corev1.Endpoints {
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{Name: dnsServiceName, Namespace: metav1.NamespaceSystem},
+	}
+	subset := corev1.EndpointSubset{}
+	for _, address := range addresses {
+		subset.Addresses = append(subset.Addresses, corev1.EndpointAddress{IP: address})
+	}
+	endpoints.Subsets = append(endpoints.Subsets, subset)
+	return endpoints
+}
+
+// runPods makes the created pods running at once.
+func runPods(client *fake.Clientset) {
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		pod := action.(core.CreateAction).GetObject().(*corev1.Pod)
+		pod.Status.Phase = corev1.PodRunning
+		return false, nil, nil
+	})
+}
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	client := fake.NewSimpleClientset(dnsEndpoints("10.0.0.10"))
+	runPods(client)
+	var scheduled *corev1.Pod
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		scheduled = action.(core.CreateAction).GetObject().(*corev1.Pod).DeepCopy()
+		return false, nil, nil
+	})
+
+	conditions := Check(ctx, &fakeSSH{output: "ok\n"}, client, "master-0")
+	var types []string
+	for _, condition := range conditions {
+		types = append(types, condition.Type)
+		if condition.Status != platformv1.ConditionTrue {
+			t.Errorf("check %s failed: %s", condition.Type, condition.Message)
+		}
+	}
+	if want := []string{CheckAPIServer, CheckScheduling, CheckDNS}; !reflect.DeepEqual(types, want) {
+		t.Errorf("checks = %v, want %v", types, want)
+	}
+	if failed := Failed(conditions); len(failed) != 0 {
+		t.Errorf("Failed() = %v, want none", failed)
+	}
+
+	if scheduled == nil {
+		t.Fatal("no pod is scheduled to the canary master")
+	}
+	if got := scheduled.Spec.NodeSelector[corev1.LabelHostname]; got != "master-0" {
+		t.Errorf("check pod is scheduled to %q, want master-0", got)
+	}
+	// the check pod is deleted after checking
+	if _, err := client.CoreV1().Pods(metav1.NamespaceSystem).Get(ctx, schedulingCheckPodName, metav1.GetOptions{}); !apierrors.IsNotFound(err) {
+		t.Errorf("check pod is not deleted: %v", err)
+	}
+}
+
+func TestCheckFailed(t *testing.T) {
+	client := fake.NewSimpleClientset(dnsEndpoints())
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, schedulingCheckPodName, fmt.Errorf("quota exceeded"))
+	})
+
+	conditions := Check(context.Background(), &fakeSSH{output: "[-]etcd failed"}, client, "master-0")
+	if failed := Failed(conditions); !reflect.DeepEqual(failed, []string{CheckAPIServer, CheckScheduling, CheckDNS}) {
+		t.Errorf("Failed() = %v, want all checks", failed)
+	}
+	for _, condition := range conditions {
+		if condition.Reason != "CheckFailed" || condition.Message == "" {
+			t.Errorf("condition %s has no reason: %+v", condition.Type, condition)
+		}
+	}
+}
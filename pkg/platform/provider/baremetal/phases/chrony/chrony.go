@@ -0,0 +1,112 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package chrony
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// ConfigFile is the config file of chrony on RedHat like systems.
+	ConfigFile = "/etc/chrony.conf"
+	// DebianConfigFile is the config file of chrony on Debian like systems.
+	DebianConfigFile = "/etc/chrony/chrony.conf"
+)
+
+// Install installs chrony if not present, configures it to use the given NTP
+// servers and steps the clock immediately.
+func Install(s ssh.Interface, servers []string) error {
+	if _, err := s.LookPath("chronyd"); err != nil {
+		cmd := "yum install -y chrony || apt-get install -y chrony"
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+	}
+
+	configFile := ConfigFile
+	if ok, err := s.Exist(DebianConfigFile); err == nil && ok {
+		configFile = DebianConfigFile
+	}
+	changed, err := writeIfChanged(s, configFile, renderConfig(servers))
+	if err != nil {
+		return err
+	}
+
+	cmd := "systemctl enable chronyd || systemctl enable chrony"
+	if changed {
+		cmd += " && (systemctl restart chronyd || systemctl restart chrony)"
+	} else {
+		cmd += " && (systemctl start chronyd || systemctl start chrony)"
+	}
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	// step the clock now instead of slewing it slowly, the skew is checked
+	// by preflight before the node joins the cluster.
+	cmd = "chronyc waitsync 10 1; chronyc -a makestep"
+	_, stderr, exit, err = s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return nil
+}
+
+func renderConfig(servers []string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# Generated by TKE, do not edit.\n")
+	for _, server := range servers {
+		buf.WriteString(fmt.Sprintf("server %s iburst\n", server))
+	}
+	buf.WriteString("driftfile /var/lib/chrony/drift\n")
+	buf.WriteString("makestep 1.0 3\n")
+	buf.WriteString("rtcsync\n")
+	buf.WriteString("logdir /var/log/chrony\n")
+
+	return buf.Bytes()
+}
+
+func writeIfChanged(s ssh.Interface, filename string, data []byte) (bool, error) {
+	ok, err := s.Exist(filename)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		current, err := s.ReadFile(filename)
+		if err != nil {
+			return false, errors.Wrapf(err, "read %s error", filename)
+		}
+		if bytes.Equal(current, data) {
+			return false, nil
+		}
+	}
+	err = s.WriteFile(bytes.NewReader(data), filename)
+	if err != nil {
+		return false, errors.Wrapf(err, "write %s error", filename)
+	}
+
+	return true, nil
+}
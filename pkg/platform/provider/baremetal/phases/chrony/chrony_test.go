@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package chrony
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// fakeSSH keeps the files written in memory and records the commands.
+type fakeSSH struct {
+	files    map[string][]byte
+	commands []string
+	chronyd  bool
+}
+
+func (f *fakeSSH) Ping() error                    { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error { return nil }
+
+func (f *fakeSSH) LookPath(file string) (string, error) {
+	if !f.chronyd {
+		return "", fmt.Errorf("%s not found", file)
+	}
+	return file, nil
+}
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+func TestInstall(t *testing.T) {
+	servers := []string{"10.0.0.1", "ntp.example.com"}
+	tests := []struct {
+		name        string
+		ssh         *fakeSSH
+		wantFile    string
+		wantInstall bool
+		wantRestart bool
+	}{
+		{
+			name:        "not installed",
+			ssh:         &fakeSSH{files: map[string][]byte{}},
+			wantFile:    ConfigFile,
+			wantInstall: true,
+			wantRestart: true,
+		},
+		{
+			name:        "debian",
+			ssh:         &fakeSSH{files: map[string][]byte{DebianConfigFile: []byte("pool 2.debian.pool.ntp.org iburst\n")}, chronyd: true},
+			wantFile:    DebianConfigFile,
+			wantRestart: true,
+		},
+		{
+			name:     "config unchanged",
+			ssh:      &fakeSSH{files: map[string][]byte{ConfigFile: renderConfig(servers)}, chronyd: true},
+			wantFile: ConfigFile,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Install(tt.ssh, servers); err != nil {
+				t.Fatalf("Install() error = %v", err)
+			}
+			config := string(tt.ssh.files[tt.wantFile])
+			for _, server := range servers {
+				if !strings.Contains(config, "server "+server+" iburst\n") {
+					t.Errorf("%s = %q, want server %s", tt.wantFile, config, server)
+				}
+			}
+			commands := strings.Join(tt.ssh.commands, "\n")
+			if installed := strings.Contains(commands, "install -y chrony"); installed != tt.wantInstall {
+				t.Errorf("chrony installed = %v, want %v", installed, tt.wantInstall)
+			}
+			if restarted := strings.Contains(commands, "systemctl restart chronyd"); restarted != tt.wantRestart {
+				t.Errorf("chronyd restarted = %v, want %v", restarted, tt.wantRestart)
+			}
+			if !strings.Contains(commands, "chronyc -a makestep") {
+				t.Errorf("commands = %v, want the clock stepped", tt.ssh.commands)
+			}
+		})
+	}
+}
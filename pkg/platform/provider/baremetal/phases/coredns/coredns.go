@@ -0,0 +1,233 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package coredns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	namespace = metav1.NamespaceSystem
+	// ConfigMapName is the configmap of CoreDNS created by kubeadm.
+	ConfigMapName = "coredns"
+	// CorefileKey is the key of Corefile in the configmap of CoreDNS.
+	CorefileKey    = "Corefile"
+	autoscalerName = "dns-autoscaler"
+
+	// ManagedAnnotation marks the Corefile is rendered from the cluster spec,
+	// only the managed Corefile is restored to default once the DNS feature
+	// is removed.
+	ManagedAnnotation = platformv1.GroupName + "/managed-corefile"
+
+	defaultCacheTTL        = 30
+	defaultCoresPerReplica = 256
+	defaultNodesPerReplica = 16
+	defaultMinReplicas     = 1
+)
+
+// Corefile renders the Corefile of CoreDNS, it is the same as the one of
+// kubeadm when dns is nil.
+func Corefile(dns *platformv1.ClusterDNS, clusterDomain string) string {
+	if dns == nil {
+		dns = &platformv1.ClusterDNS{}
+	}
+	cacheTTL := dns.CacheTTL
+	if cacheTTL == 0 {
+		cacheTTL = defaultCacheTTL
+	}
+	upstream := "/etc/resolv.conf"
+	if len(dns.UpstreamNameservers) > 0 {
+		upstream = strings.Join(dns.UpstreamNameservers, " ")
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, `.:53 {
+    errors
+    health {
+       lameduck 5s
+    }
+    ready
+    kubernetes %s in-addr.arpa ip6.arpa {
+       pods insecure
+       fallthrough in-addr.arpa ip6.arpa
+       ttl 30
+    }
+    prometheus :9153
+    forward . %s {
+       max_concurrent 1000
+    }
+    cache %d
+    loop
+    reload
+    loadbalance
+}
+`, clusterDomain, upstream, cacheTTL)
+	for _, stub := range dns.StubDomains {
+		fmt.Fprintf(buf, `%s:53 {
+    errors
+    cache %d
+    loop
+    forward . %s
+}
+`, stub.Domain, cacheTTL, strings.Join(stub.Nameservers, " "))
+	}
+	return buf.String()
+}
+
+// Apply reconciles the Corefile and dns-autoscaler of CoreDNS with the DNS
+// feature of cluster. The Corefile is reloaded by CoreDNS itself.
+func Apply(ctx context.Context, client kubernetes.Interface, dns *platformv1.ClusterDNS, clusterDomain string) error {
+	err := applyCorefile(ctx, client, dns, clusterDomain)
+	if err != nil {
+		return errors.Wrap(err, "update Corefile error")
+	}
+	if dns == nil || dns.Autoscaler == nil {
+		return uninstallAutoscaler(ctx, client)
+	}
+	err = installAutoscaler(ctx, client, dns.Autoscaler)
+	if err != nil {
+		return errors.Wrap(err, "install dns-autoscaler error")
+	}
+	return nil
+}
+
+func applyCorefile(ctx context.Context, client kubernetes.Interface, dns *platformv1.ClusterDNS, clusterDomain string) error {
+	cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	managed := cm.Annotations[ManagedAnnotation] == "true"
+	// leave the Corefile alone which is never managed by the cluster spec
+	if dns == nil && !managed {
+		return nil
+	}
+	corefile := Corefile(dns, clusterDomain)
+	if cm.Data[CorefileKey] == corefile && managed == (dns != nil) {
+		return nil
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[CorefileKey] = corefile
+	if cm.Annotations == nil {
+		cm.Annotations = map[string]string{}
+	}
+	if dns != nil {
+		cm.Annotations[ManagedAnnotation] = "true"
+	} else {
+		delete(cm.Annotations, ManagedAnnotation)
+	}
+	_, err = client.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
+
+// linearParams is the linear control mode of cluster-proportional-autoscaler.
+type linearParams struct {
+	CoresPerReplica           int32 `json:"coresPerReplica"`
+	NodesPerReplica           int32 `json:"nodesPerReplica"`
+	Min                       int32 `json:"min"`
+	Max                       int32 `json:"max,omitempty"`
+	PreventSinglePointFailure bool  `json:"preventSinglePointFailure"`
+}
+
+// LinearParams returns the linear parameters of dns-autoscaler with defaults.
+func LinearParams(autoscaler *platformv1.DNSAutoscaler) (string, error) {
+	params := linearParams{
+		CoresPerReplica:           autoscaler.CoresPerReplica,
+		NodesPerReplica:           autoscaler.NodesPerReplica,
+		Min:                       autoscaler.Min,
+		Max:                       autoscaler.Max,
+		PreventSinglePointFailure: autoscaler.PreventSinglePointFailure,
+	}
+	if params.CoresPerReplica == 0 {
+		params.CoresPerReplica = defaultCoresPerReplica
+	}
+	if params.NodesPerReplica == 0 {
+		params.NodesPerReplica = defaultNodesPerReplica
+	}
+	if params.Min == 0 {
+		params.Min = defaultMinReplicas
+	}
+	data, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func installAutoscaler(ctx context.Context, client kubernetes.Interface, autoscaler *platformv1.DNSAutoscaler) error {
+	params, err := LinearParams(autoscaler)
+	if err != nil {
+		return err
+	}
+	err = apiclient.CreateOrUpdateConfigMap(ctx, client, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      autoscalerName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{"linear": params},
+	})
+	if err != nil {
+		return err
+	}
+	option := map[string]interface{}{
+		"Image": images.Get().DNSAutoscaler.FullName(),
+	}
+	return apiclient.CreateResourceWithFile(ctx, client, constants.DNSAutoscalerManifest, option)
+}
+
+func uninstallAutoscaler(ctx context.Context, client kubernetes.Interface) error {
+	deletes := []func() error{
+		func() error {
+			return client.AppsV1().Deployments(namespace).Delete(ctx, autoscalerName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().ConfigMaps(namespace).Delete(ctx, autoscalerName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.RbacV1().ClusterRoleBindings().Delete(ctx, "system:"+autoscalerName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.RbacV1().ClusterRoles().Delete(ctx, "system:"+autoscalerName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().ServiceAccounts(namespace).Delete(ctx, autoscalerName, metav1.DeleteOptions{})
+		},
+	}
+	for _, f := range deletes {
+		if err := f(); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package coredns
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestCorefile(t *testing.T) {
+	dns := &platformv1.ClusterDNS{
+		StubDomains: []platformv1.DNSStubDomain{
+			{Domain: "example.com", Nameservers: []string{"10.0.0.1", "10.0.0.2:5353"}},
+		},
+		UpstreamNameservers: []string{"8.8.8.8"},
+		CacheTTL:            60,
+	}
+	corefile := Corefile(dns, "cluster.local")
+	for _, want := range []string{
+		"kubernetes cluster.local in-addr.arpa ip6.arpa {",
+		"forward . 8.8.8.8 {",
+		"cache 60\n",
+		"example.com:53 {",
+		"forward . 10.0.0.1 10.0.0.2:5353\n",
+	} {
+		if !strings.Contains(corefile, want) {
+			t.Errorf("Corefile() missing %q in\n%s", want, corefile)
+		}
+	}
+
+	corefile = Corefile(nil, "cluster.local")
+	if !strings.Contains(corefile, "forward . /etc/resolv.conf {") || !strings.Contains(corefile, "cache 30\n") {
+		t.Errorf("Corefile(nil) is not the default one\n%s", corefile)
+	}
+}
+
+func TestLinearParams(t *testing.T) {
+	params, err := LinearParams(&platformv1.DNSAutoscaler{Max: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"coresPerReplica":256,"nodesPerReplica":16,"min":1,"max":10,"preventSinglePointFailure":false}`
+	if params != want {
+		t.Errorf("LinearParams() = %s, want %s", params, want)
+	}
+}
+
+func TestApplyCorefile(t *testing.T) {
+	ctx := context.Background()
+	manual := "manual"
+	client := fake.NewSimpleClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName, Namespace: namespace},
+		Data:       map[string]string{CorefileKey: manual},
+	})
+	get := func() *corev1.ConfigMap {
+		cm, err := client.CoreV1().ConfigMaps(namespace).Get(ctx, ConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cm
+	}
+
+	// the Corefile edited manually is kept without the DNS feature
+	if err := applyCorefile(ctx, client, nil, "cluster.local"); err != nil {
+		t.Fatal(err)
+	}
+	if get().Data[CorefileKey] != manual {
+		t.Fatalf("applyCorefile(nil) overwrote the unmanaged Corefile")
+	}
+
+	dns := &platformv1.ClusterDNS{UpstreamNameservers: []string{"8.8.8.8"}}
+	if err := applyCorefile(ctx, client, dns, "cluster.local"); err != nil {
+		t.Fatal(err)
+	}
+	cm := get()
+	if cm.Data[CorefileKey] != Corefile(dns, "cluster.local") || cm.Annotations[ManagedAnnotation] != "true" {
+		t.Fatalf("applyCorefile() got %v", cm)
+	}
+
+	// upgrades restore the default Corefile and drop the annotation
+	cm.Data[CorefileKey] = Corefile(nil, "cluster.local")
+	cm.Annotations = nil
+	if _, err := client.CoreV1().ConfigMaps(namespace).Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := applyCorefile(ctx, client, dns, "cluster.local"); err != nil {
+		t.Fatal(err)
+	}
+	if get().Data[CorefileKey] != Corefile(dns, "cluster.local") {
+		t.Fatalf("applyCorefile() did not reapply the Corefile after upgrade")
+	}
+
+	if err := applyCorefile(ctx, client, nil, "cluster.local"); err != nil {
+		t.Fatal(err)
+	}
+	cm = get()
+	if cm.Data[CorefileKey] != Corefile(nil, "cluster.local") || cm.Annotations[ManagedAnnotation] != "" {
+		t.Fatalf("applyCorefile(nil) did not restore the default Corefile, got %v", cm)
+	}
+}
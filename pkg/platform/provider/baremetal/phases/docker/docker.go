@@ -20,6 +20,8 @@ package docker
 
 import (
 	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"path"
 	"strings"
@@ -27,6 +29,7 @@ import (
 	"tkestack.io/tke/pkg/util/template"
 
 	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/util/ssh"
@@ -35,10 +38,16 @@ import (
 
 type Option struct {
 	InsecureRegistries string
+	RegistryMirrors    string
 	RegistryDomain     string
 	Options            string
 	IsGPU              bool
 	ExtraArgs          map[string]string
+	// CgroupDriver must be the same as the cgroup driver of kubelet.
+	CgroupDriver string
+	// SandboxRuntimeHandler and SandboxRuntimePath register a sandboxed runtime to docker.
+	SandboxRuntimeHandler string
+	SandboxRuntimePath    string
 }
 
 const (
@@ -92,3 +101,100 @@ func Install(s ssh.Interface, option *Option) error {
 
 	return nil
 }
+
+// FormatRegistries formats the registries as the items of a json array.
+func FormatRegistries(registries []string) string {
+	items := make([]string, 0, len(registries))
+	for _, one := range registries {
+		items = append(items, fmt.Sprintf("%q", one))
+	}
+	return strings.Join(items, ",")
+}
+
+// ApplyRegistries sets the insecure registries and registry mirrors in the
+// daemon.json, and reloads docker if changed. Both are reloadable without
+// restarting containers.
+func ApplyRegistries(s ssh.Interface, insecureRegistries []string, mirrors []string) (bool, error) {
+	data, err := s.ReadFile(dockerDaemonFile)
+	if err != nil {
+		return false, errors.Wrapf(err, "read %s error", dockerDaemonFile)
+	}
+	config := map[string]interface{}{}
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		return false, errors.Wrapf(err, "parse %s error", dockerDaemonFile)
+	}
+	origin, _ := json.Marshal(config)
+
+	config["insecure-registries"] = insecureRegistries
+	if len(mirrors) > 0 {
+		config["registry-mirrors"] = mirrors
+	} else {
+		delete(config, "registry-mirrors")
+	}
+	current, _ := json.Marshal(config)
+	if bytes.Equal(origin, current) {
+		return false, nil
+	}
+
+	data, err = json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return false, err
+	}
+	err = s.WriteFile(bytes.NewReader(data), dockerDaemonFile)
+	if err != nil {
+		return false, errors.Wrapf(err, "write %s error", dockerDaemonFile)
+	}
+	cmd := "systemctl reload docker"
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil {
+		return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return true, nil
+}
+
+// ApplyRegistryAuths writes the registry credentials for kubelet to pull images,
+// and reports whether the credentials are changed. The passwords of registries
+// are taken from the credential of cluster.
+func ApplyRegistryAuths(s ssh.Interface, auths []platformv1.RegistryAuth, passwords map[string][]byte) (bool, error) {
+	exist, err := s.Exist(constants.KubeletDockerConfigFile)
+	if err != nil {
+		return false, err
+	}
+	if !exist && len(auths) == 0 {
+		return false, nil
+	}
+
+	type authConfig struct {
+		Auth string `json:"auth"`
+	}
+	config := struct {
+		Auths map[string]authConfig `json:"auths"`
+	}{Auths: map[string]authConfig{}}
+	for _, one := range auths {
+		password, ok := passwords[one.Registry]
+		if !ok {
+			return false, errors.Errorf("password of registry %s is not found in cluster credential", one.Registry)
+		}
+		auth := base64.StdEncoding.EncodeToString([]byte(one.Username + ":" + string(password)))
+		config.Auths[one.Registry] = authConfig{Auth: auth}
+	}
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return false, err
+	}
+	if exist {
+		actual, err := s.ReadFile(constants.KubeletDockerConfigFile)
+		if err == nil && bytes.Equal(actual, data) {
+			return false, nil
+		}
+	}
+
+	err = s.WriteFile(bytes.NewReader(data), constants.KubeletDockerConfigFile)
+	if err != nil {
+		return false, errors.Wrapf(err, "write %s error", constants.KubeletDockerConfigFile)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+// fakeSSH keeps the files written in memory.
+type fakeSSH struct {
+	files map[string][]byte
+}
+
+func (f *fakeSSH) Ping() error                                  { return nil }
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error)    { return nil, nil }
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) { return "", "", 0, nil }
+func (f *fakeSSH) CopyFile(src, dst string) error               { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error)         { return file, nil }
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+func TestApplyRegistryAuths(t *testing.T) {
+	auths := []platformv1.RegistryAuth{{Registry: "registry.example.com", Username: "admin"}}
+	passwords := map[string][]byte{"registry.example.com": []byte("secret")}
+
+	s := &fakeSSH{files: map[string][]byte{}}
+	changed, err := ApplyRegistryAuths(s, auths, passwords)
+	if err != nil || !changed {
+		t.Fatalf("ApplyRegistryAuths() = %v, %v, want changed", changed, err)
+	}
+	config := struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(s.files[constants.KubeletDockerConfigFile], &config); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := config.Auths["registry.example.com"].Auth, "YWRtaW46c2VjcmV0"; got != want {
+		t.Errorf("auth = %q, want %q", got, want)
+	}
+
+	changed, err = ApplyRegistryAuths(s, auths, passwords)
+	if err != nil || changed {
+		t.Errorf("ApplyRegistryAuths() again = %v, %v, want unchanged", changed, err)
+	}
+
+	if _, err := ApplyRegistryAuths(&fakeSSH{files: map[string][]byte{}}, auths, nil); err == nil {
+		t.Error("ApplyRegistryAuths() without password in credential should fail")
+	}
+
+	changed, err = ApplyRegistryAuths(&fakeSSH{files: map[string][]byte{}}, nil, nil)
+	if err != nil || changed {
+		t.Errorf("ApplyRegistryAuths() without auths = %v, %v, want nothing written", changed, err)
+	}
+}
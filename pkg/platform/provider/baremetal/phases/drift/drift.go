@@ -0,0 +1,185 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package drift
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// ConditionTypeConfigDrift is the condition type of machine config drift status.
+	ConditionTypeConfigDrift = "ConfigDrift"
+	// ReasonDrifted is the condition reason when managed files are changed on node.
+	ReasonDrifted = "Drifted"
+
+	// AnnotationRemediate requests to restore the drifted files of machine.
+	AnnotationRemediate = platformv1.GroupName + "/remediate-config-drift"
+
+	// CheckInterval is the interval of detecting config drift on machine.
+	CheckInterval = 10 * time.Minute
+
+	checksumFile = "SHA256SUMS"
+	cniConfGlob  = "/etc/cni/net.d/*"
+)
+
+// managedFile is a file managed by provider on node, reload is executed
+// after the file is restored.
+type managedFile struct {
+	path   string
+	reload string
+}
+
+var managedFiles = []managedFile{
+	{path: constants.KubeletConfigFile, reload: "systemctl restart kubelet"},
+	{path: "/var/lib/kubelet/kubeadm-flags.env", reload: "systemctl restart kubelet"},
+	{path: "/etc/docker/daemon.json", reload: "systemctl restart docker"},
+	{path: "/etc/systemd/system/kubelet.service.d/99-tke-override.conf", reload: "systemctl daemon-reload && systemctl restart kubelet"},
+	{path: "/etc/systemd/system/docker.service.d/99-tke-override.conf", reload: "systemctl daemon-reload && systemctl restart docker"},
+	{path: "/etc/sysctl.d/99-tke.conf", reload: "sysctl --system"},
+	{path: "/etc/modules-load.d/tke.conf", reload: "systemctl restart systemd-modules-load"},
+	// limits take effect on new sessions, so nothing is reloaded
+	{path: "/etc/security/limits.d/99-tke.conf"},
+}
+
+// Snapshot records the managed files on node as the expected content, which
+// should be called whenever provider renders the files.
+func Snapshot(s ssh.Interface) error {
+	files, err := listManagedFiles(s)
+	if err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("rm -rf %[1]s && mkdir -p %[1]s && cp -a --parents %[2]s %[1]s && cd / && sha256sum %[2]s > %[1]s/%[3]s",
+		constants.ConfigBaselineDir, strings.Join(files, " "), checksumFile)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return nil
+}
+
+// Check returns the managed files on node which are different from the
+// snapshot. The snapshot is recorded first if it doesn't exist.
+func Check(s ssh.Interface) ([]string, error) {
+	sums := path.Join(constants.ConfigBaselineDir, checksumFile)
+	ok, err := s.Exist(sums)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, Snapshot(s)
+	}
+
+	// sha256sum exits 1 if any file doesn't match, so only stdout is checked
+	cmd := fmt.Sprintf("cd / && sha256sum --quiet -c %s 2>/dev/null", sums)
+	stdout, _, _, err := s.Exec(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("exec %q failed:error %s", cmd, err)
+	}
+
+	var drifted []string
+	for _, line := range strings.Split(stdout, "\n") {
+		i := strings.LastIndex(line, ": FAILED")
+		if i == -1 {
+			continue
+		}
+		drifted = append(drifted, line[:i])
+	}
+	sort.Strings(drifted)
+
+	return drifted, nil
+}
+
+// Remediate restores the drifted files from the snapshot and reloads the
+// services which use them.
+func Remediate(s ssh.Interface, drifted []string) error {
+	var reloads []string
+	for _, file := range drifted {
+		cmd := fmt.Sprintf("cp -a %s %s", path.Join(constants.ConfigBaselineDir, file), file)
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+		reload := reloadCommand(file)
+		if reload != "" && !contains(reloads, reload) {
+			reloads = append(reloads, reload)
+		}
+	}
+
+	for _, cmd := range reloads {
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+	}
+
+	return nil
+}
+
+func listManagedFiles(s ssh.Interface) ([]string, error) {
+	var files []string
+	for _, file := range managedFiles {
+		ok, err := s.Exist(file.path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			files = append(files, file.path)
+		}
+	}
+
+	// CNI configuration is rendered by the network plugin on node
+	stdout, _, _, err := s.Exec(fmt.Sprintf("ls -d %s 2>/dev/null", cniConfGlob))
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, strings.Fields(stdout)...)
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no managed files found")
+	}
+
+	return files, nil
+}
+
+func reloadCommand(file string) string {
+	for _, f := range managedFiles {
+		if f.path == file {
+			return f.reload
+		}
+	}
+	return ""
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,167 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package drift
+
+import (
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+// fakeSSH reports the files as existing, and answers the commands with the
+// output of the first matched prefix.
+type fakeSSH struct {
+	files    map[string]bool
+	outputs  map[string]string
+	commands []string
+}
+
+func (f *fakeSSH) Ping() error                               { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error            { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error)      { return file, nil }
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error { return nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	stdout, _, _, err := f.Exec(cmd)
+	return []byte(stdout), err
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	for prefix, output := range f.outputs {
+		if strings.HasPrefix(cmd, prefix) {
+			return output, "", 0, nil
+		}
+	}
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	return f.files[filename], nil
+}
+
+func TestSnapshot(t *testing.T) {
+	s := &fakeSSH{
+		files:   map[string]bool{constants.KubeletConfigFile: true, "/etc/docker/daemon.json": true},
+		outputs: map[string]string{"ls -d": "/etc/cni/net.d/10-flannel.conflist\n"},
+	}
+	if err := Snapshot(s); err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	files := constants.KubeletConfigFile + " /etc/docker/daemon.json /etc/cni/net.d/10-flannel.conflist"
+	want := fmt.Sprintf("rm -rf %[1]s && mkdir -p %[1]s && cp -a --parents %[2]s %[1]s && cd / && sha256sum %[2]s > %[1]s/SHA256SUMS",
+		constants.ConfigBaselineDir, files)
+	if got := s.commands[len(s.commands)-1]; got != want {
+		t.Errorf("Snapshot() command = %s, want %s", got, want)
+	}
+
+	if err := Snapshot(&fakeSSH{}); err == nil {
+		t.Errorf("Snapshot() of node without managed files succeeded")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	sums := path.Join(constants.ConfigBaselineDir, checksumFile)
+	tests := []struct {
+		name         string
+		s            *fakeSSH
+		want         []string
+		wantSnapshot bool
+	}{
+		{
+			name: "no snapshot",
+			s: &fakeSSH{
+				files:   map[string]bool{constants.KubeletConfigFile: true},
+				outputs: map[string]string{},
+			},
+			wantSnapshot: true,
+		},
+		{
+			name: "no drift",
+			s: &fakeSSH{
+				files:   map[string]bool{sums: true},
+				outputs: map[string]string{"cd / && sha256sum --quiet -c": ""},
+			},
+		},
+		{
+			name: "drifted",
+			s: &fakeSSH{
+				files: map[string]bool{sums: true},
+				outputs: map[string]string{"cd / && sha256sum --quiet -c": "/etc/sysctl.d/99-tke.conf: FAILED\n" +
+					"/etc/docker/daemon.json: FAILED open or read\n" +
+					constants.KubeletConfigFile + ": FAILED\n"},
+			},
+			want: []string{"/etc/docker/daemon.json", "/etc/sysctl.d/99-tke.conf", constants.KubeletConfigFile},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Check(tt.s)
+			if err != nil {
+				t.Fatalf("Check() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+			snapshot := strings.HasPrefix(tt.s.commands[len(tt.s.commands)-1], "rm -rf "+constants.ConfigBaselineDir)
+			if snapshot != tt.wantSnapshot {
+				t.Errorf("Check() snapshot = %v, want %v", snapshot, tt.wantSnapshot)
+			}
+		})
+	}
+}
+
+func TestRemediate(t *testing.T) {
+	s := &fakeSSH{}
+	drifted := []string{
+		constants.KubeletConfigFile,
+		"/var/lib/kubelet/kubeadm-flags.env",
+		"/etc/security/limits.d/99-tke.conf",
+		"/etc/sysctl.d/99-tke.conf",
+	}
+	if err := Remediate(s, drifted); err != nil {
+		t.Fatalf("Remediate() error = %v", err)
+	}
+	var want []string
+	for _, file := range drifted {
+		want = append(want, fmt.Sprintf("cp -a %s %s", path.Join(constants.ConfigBaselineDir, file), file))
+	}
+	// kubelet is restarted once, and nothing is reloaded for limits
+	want = append(want, "systemctl restart kubelet", "sysctl --system")
+	if !reflect.DeepEqual(s.commands, want) {
+		t.Errorf("Remediate() commands = %v, want %v", s.commands, want)
+	}
+}
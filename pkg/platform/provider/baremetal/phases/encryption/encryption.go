@@ -0,0 +1,241 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clientset "k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// AnnotationKeyRotatedAt records the last time the encryption keys were rotated.
+	AnnotationKeyRotatedAt = platformv1.GroupName + "/network-encryption-key-rotated-at"
+
+	// IPsecKeysSecretName is the secret which cilium reads IPsec keys from.
+	IPsecKeysSecretName = "cilium-ipsec-keys"
+	ipsecKeysSecretKey  = "keys"
+	// cilium only supports key id in range [1, 15]
+	maxIPsecKeyID = 15
+
+	ciliumDaemonSetName  = "cilium"
+	flannelDaemonSetName = "flannel"
+)
+
+const (
+	// ConditionTypeNetworkEncryption is the condition type of machine network encryption status.
+	ConditionTypeNetworkEncryption = "NetworkEncryption"
+
+	ReasonAgentNotReady = "AgentNotReady"
+	ReasonKeyRotating   = "KeyRotating"
+)
+
+// InstallWireGuard installs wireguard tools on node and loads the kernel module.
+func InstallWireGuard(s ssh.Interface) error {
+	err := res.WireGuardTools.InstallWithDefault(s)
+	if err != nil {
+		return err
+	}
+
+	cmd := "modprobe wireguard && echo wireguard > /etc/modules-load.d/wireguard.conf"
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return nil
+}
+
+// EnsureIPsecKeys creates the IPsec keys secret for cilium if not exists.
+func EnsureIPsecKeys(ctx context.Context, client clientset.Interface) error {
+	_, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, IPsecKeysSecretName, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	keys, err := ipsecKeys(1)
+	if err != nil {
+		return err
+	}
+
+	return apiclient.CreateOrUpdateSecret(ctx, client, ipsecKeysSecret(keys))
+}
+
+// RotateKeys rotates the encryption keys if they are older than the rotation
+// period. WireGuard keys are regenerated by restarting flannel, while IPsec
+// keys are replaced with a new key id before cilium is restarted. The keys are
+// not rotated until the agent is installed and all of its pods are updated,
+// so that the nodes never run with more than two generations of keys.
+func RotateKeys(ctx context.Context, client clientset.Interface, encryption *platformv1.NetworkEncryption) error {
+	if encryption.KeyRotationPeriod == "" {
+		return nil
+	}
+	period, err := time.ParseDuration(encryption.KeyRotationPeriod)
+	if err != nil {
+		return err
+	}
+
+	name := agentDaemonSetName(encryption.Type)
+	ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if isDaemonSetRolling(ds) {
+		return nil
+	}
+	rotatedAt, ok := ds.Annotations[AnnotationKeyRotatedAt]
+	if !ok {
+		// keys were generated when the agent was installed, only record the time.
+		return patchDaemonSetAnnotation(ctx, client, name, time.Now(), false)
+	}
+	last, err := time.Parse(time.RFC3339, rotatedAt)
+	if err == nil && time.Since(last) < period {
+		return nil
+	}
+
+	if encryption.Type == platformv1.NetworkEncryptionIPsec {
+		secret, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(ctx, IPsecKeysSecretName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		keys, err := ipsecKeys(nextIPsecKeyID(string(secret.Data[ipsecKeysSecretKey])))
+		if err != nil {
+			return err
+		}
+		err = apiclient.CreateOrUpdateSecret(ctx, client, ipsecKeysSecret(keys))
+		if err != nil {
+			return errors.Wrap(err, "update IPsec keys error")
+		}
+	}
+
+	return patchDaemonSetAnnotation(ctx, client, name, time.Now(), true)
+}
+
+// NodeStatus checks whether the encryption agent on node is ready and uses the
+// current keys. It returns the reason and message if not.
+func NodeStatus(ctx context.Context, client clientset.Interface, encryption *platformv1.NetworkEncryption, nodeName string) (bool, string, string, error) {
+	name := agentDaemonSetName(encryption.Type)
+	ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return false, "", "", err
+	}
+	pods, err := client.CoreV1().Pods(metav1.NamespaceSystem).List(ctx, metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(ds.Spec.Selector),
+		FieldSelector: "spec.nodeName=" + nodeName,
+	})
+	if err != nil {
+		return false, "", "", err
+	}
+	if len(pods.Items) == 0 {
+		return false, ReasonAgentNotReady, fmt.Sprintf("no %s pod on node %s", name, nodeName), nil
+	}
+	for _, pod := range pods.Items {
+		if !isPodReady(&pod) {
+			return false, ReasonAgentNotReady, fmt.Sprintf("pod %s is not ready", pod.Name), nil
+		}
+		if pod.Annotations[AnnotationKeyRotatedAt] != ds.Spec.Template.Annotations[AnnotationKeyRotatedAt] {
+			return false, ReasonKeyRotating, fmt.Sprintf("pod %s is using the old keys", pod.Name), nil
+		}
+	}
+
+	return true, "", "", nil
+}
+
+func agentDaemonSetName(encryptionType platformv1.NetworkEncryptionType) string {
+	if encryptionType == platformv1.NetworkEncryptionIPsec {
+		return ciliumDaemonSetName
+	}
+	return flannelDaemonSetName
+}
+
+func patchDaemonSetAnnotation(ctx context.Context, client clientset.Interface, name string, now time.Time, restart bool) error {
+	value := now.Format(time.RFC3339)
+	patch := fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}}}`, AnnotationKeyRotatedAt, value)
+	if restart {
+		patch = fmt.Sprintf(`{"metadata":{"annotations":{%q:%q}},"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`,
+			AnnotationKeyRotatedAt, value, AnnotationKeyRotatedAt, value)
+	}
+	_, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Patch(ctx, name, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	return err
+}
+
+func ipsecKeysSecret(keys string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      IPsecKeysSecretName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string][]byte{
+			ipsecKeysSecretKey: []byte(keys),
+		},
+	}
+}
+
+func ipsecKeys(id int) (string, error) {
+	key := make([]byte, 20)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d rfc4106(gcm(aes)) %s 128", id, hex.EncodeToString(key)), nil
+}
+
+func nextIPsecKeyID(keys string) int {
+	id, err := strconv.Atoi(strings.Fields(keys + " 0")[0])
+	if err != nil {
+		return 1
+	}
+	return id%maxIPsecKeyID + 1
+}
+
+func isDaemonSetRolling(ds *appsv1.DaemonSet) bool {
+	return ds.Status.ObservedGeneration < ds.Generation ||
+		ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled ||
+		ds.Status.NumberUnavailable > 0
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,308 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package encryption
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func newDaemonSet(name string, rotatedAt *time.Time, rolling bool) *appsv1.DaemonSet {
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceSystem, Generation: 1},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"k8s-app": name}},
+		},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+		},
+	}
+	if rotatedAt != nil {
+		value := rotatedAt.Format(time.RFC3339)
+		ds.Annotations = map[string]string{AnnotationKeyRotatedAt: value}
+		ds.Spec.Template.Annotations = map[string]string{AnnotationKeyRotatedAt: value}
+	}
+	if rolling {
+		ds.Status.UpdatedNumberScheduled = 1
+	}
+	return ds
+}
+
+func TestRotateKeys(t *testing.T) {
+	recent := time.Now().Add(-time.Hour)
+	old := time.Now().Add(-48 * time.Hour)
+	wireGuard := &platformv1.NetworkEncryption{Type: platformv1.NetworkEncryptionWireGuard, KeyRotationPeriod: "24h"}
+	ipsec := &platformv1.NetworkEncryption{Type: platformv1.NetworkEncryptionIPsec, KeyRotationPeriod: "24h"}
+	tests := []struct {
+		name       string
+		encryption *platformv1.NetworkEncryption
+		objects    []runtime.Object
+		wantErr    bool
+		// wantRecorded is true if the rotation time is recorded on the daemon set.
+		wantRecorded bool
+		// wantRestart is true if the agent pods are restarted with new keys.
+		wantRestart bool
+		// wantKeyID is the id of IPsec keys after rotation.
+		wantKeyID string
+	}{
+		{
+			name:       "rotation disabled",
+			encryption: &platformv1.NetworkEncryption{Type: platformv1.NetworkEncryptionWireGuard},
+			objects:    []runtime.Object{newDaemonSet(flannelDaemonSetName, &old, false)},
+		},
+		{
+			name:       "invalid rotation period",
+			encryption: &platformv1.NetworkEncryption{Type: platformv1.NetworkEncryptionWireGuard, KeyRotationPeriod: "daily"},
+			wantErr:    true,
+		},
+		{
+			name:       "agent not installed yet",
+			encryption: ipsec,
+		},
+		{
+			name:         "first rotation only records the time",
+			encryption:   wireGuard,
+			objects:      []runtime.Object{newDaemonSet(flannelDaemonSetName, nil, false)},
+			wantRecorded: true,
+		},
+		{
+			name:       "keys are not expired",
+			encryption: wireGuard,
+			objects:    []runtime.Object{newDaemonSet(flannelDaemonSetName, &recent, false)},
+		},
+		{
+			name:         "wireguard keys are expired",
+			encryption:   wireGuard,
+			objects:      []runtime.Object{newDaemonSet(flannelDaemonSetName, &old, false)},
+			wantRecorded: true,
+			wantRestart:  true,
+		},
+		{
+			name:       "wireguard agent is still rolling",
+			encryption: wireGuard,
+			objects:    []runtime.Object{newDaemonSet(flannelDaemonSetName, &old, true)},
+		},
+		{
+			name:       "ipsec keys are expired",
+			encryption: ipsec,
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &old, false),
+				ipsecKeysSecret("3 rfc4106(gcm(aes)) 00 128"),
+			},
+			wantRecorded: true,
+			wantRestart:  true,
+			wantKeyID:    "4",
+		},
+		{
+			name:       "ipsec key id wraps around",
+			encryption: ipsec,
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &old, false),
+				ipsecKeysSecret("15 rfc4106(gcm(aes)) 00 128"),
+			},
+			wantRecorded: true,
+			wantRestart:  true,
+			wantKeyID:    "1",
+		},
+		{
+			name:       "ipsec agent is still rolling",
+			encryption: ipsec,
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &old, true),
+				ipsecKeysSecret("3 rfc4106(gcm(aes)) 00 128"),
+			},
+			wantKeyID: "3",
+		},
+		{
+			name:       "ipsec keys secret is missing",
+			encryption: ipsec,
+			objects:    []runtime.Object{newDaemonSet(ciliumDaemonSetName, &old, false)},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.objects...)
+			start := time.Now().Truncate(time.Second)
+			err := RotateKeys(context.Background(), client, tt.encryption)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RotateKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr || len(tt.objects) == 0 {
+				return
+			}
+
+			name := agentDaemonSetName(tt.encryption.Type)
+			ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.Background(), name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			rotatedAt, _ := time.Parse(time.RFC3339, ds.Annotations[AnnotationKeyRotatedAt])
+			if recorded := !rotatedAt.Before(start); recorded != tt.wantRecorded {
+				t.Errorf("rotation recorded = %v, want %v", recorded, tt.wantRecorded)
+			}
+			restarted := ds.Spec.Template.Annotations[AnnotationKeyRotatedAt] == ds.Annotations[AnnotationKeyRotatedAt] && tt.wantRecorded
+			if restarted != tt.wantRestart {
+				t.Errorf("agent restarted = %v, want %v", restarted, tt.wantRestart)
+			}
+			if tt.wantKeyID == "" {
+				return
+			}
+			secret, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.Background(), IPsecKeysSecretName, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id := strings.Fields(string(secret.Data[ipsecKeysSecretKey]))[0]; id != tt.wantKeyID {
+				t.Errorf("IPsec key id = %s, want %s", id, tt.wantKeyID)
+			}
+		})
+	}
+}
+
+func TestNextIPsecKeyID(t *testing.T) {
+	tests := []struct {
+		keys string
+		want int
+	}{
+		{"", 1},
+		{"1 rfc4106(gcm(aes)) 00 128", 2},
+		{"14 rfc4106(gcm(aes)) 00 128", 15},
+		{"15 rfc4106(gcm(aes)) 00 128", 1},
+		{"invalid", 1},
+	}
+	for _, tt := range tests {
+		if got := nextIPsecKeyID(tt.keys); got != tt.want {
+			t.Errorf("nextIPsecKeyID(%q) = %d, want %d", tt.keys, got, tt.want)
+		}
+	}
+}
+
+func TestEnsureIPsecKeys(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	if err := EnsureIPsecKeys(context.Background(), client); err != nil {
+		t.Fatalf("EnsureIPsecKeys() error = %v", err)
+	}
+	secret, err := client.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.Background(), IPsecKeysSecretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := string(secret.Data[ipsecKeysSecretKey])
+	if !strings.HasPrefix(keys, "1 rfc4106(gcm(aes)) ") {
+		t.Errorf("keys = %q, want key id 1", keys)
+	}
+
+	// the existing keys are kept
+	if err := EnsureIPsecKeys(context.Background(), client); err != nil {
+		t.Fatalf("EnsureIPsecKeys() again error = %v", err)
+	}
+	secret, _ = client.CoreV1().Secrets(metav1.NamespaceSystem).Get(context.Background(), IPsecKeysSecretName, metav1.GetOptions{})
+	if string(secret.Data[ipsecKeysSecretKey]) != keys {
+		t.Errorf("keys = %q, want unchanged %q", secret.Data[ipsecKeysSecretKey], keys)
+	}
+}
+
+func newAgentPod(name string, ready bool, rotatedAt string) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   metav1.NamespaceSystem,
+			Labels:      map[string]string{"k8s-app": ciliumDaemonSetName},
+			Annotations: map[string]string{AnnotationKeyRotatedAt: rotatedAt},
+		},
+		Spec: corev1.PodSpec{NodeName: "192.168.0.2"},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func TestNodeStatus(t *testing.T) {
+	rotatedAt := time.Now().Add(-time.Hour)
+	current := rotatedAt.Format(time.RFC3339)
+	previous := rotatedAt.Add(-24 * time.Hour).Format(time.RFC3339)
+	ipsec := &platformv1.NetworkEncryption{Type: platformv1.NetworkEncryptionIPsec}
+	tests := []struct {
+		name       string
+		objects    []runtime.Object
+		wantOK     bool
+		wantReason string
+		wantErr    bool
+	}{
+		{
+			name:    "agent not installed",
+			wantErr: true,
+		},
+		{
+			name:       "no agent pod on node",
+			objects:    []runtime.Object{newDaemonSet(ciliumDaemonSetName, &rotatedAt, false)},
+			wantReason: ReasonAgentNotReady,
+		},
+		{
+			name: "agent pod not ready",
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &rotatedAt, false),
+				newAgentPod("cilium-a", false, current),
+			},
+			wantReason: ReasonAgentNotReady,
+		},
+		{
+			name: "agent pod uses old keys",
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &rotatedAt, true),
+				newAgentPod("cilium-a", true, previous),
+			},
+			wantReason: ReasonKeyRotating,
+		},
+		{
+			name: "agent pod uses current keys",
+			objects: []runtime.Object{
+				newDaemonSet(ciliumDaemonSetName, &rotatedAt, false),
+				newAgentPod("cilium-a", true, current),
+			},
+			wantOK: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(tt.objects...)
+			ok, reason, message, err := NodeStatus(context.Background(), client, ipsec, "192.168.0.2")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NodeStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOK || reason != tt.wantReason {
+				t.Errorf("NodeStatus() = %v, %q, %q, want %v, %q", ok, reason, message, tt.wantOK, tt.wantReason)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	etcdctlCmd = `docker exec -e ETCDCTL_API=3 $(docker ps -q -f 'label=io.kubernetes.container.name=etcd') ` +
+		`etcdctl --endpoints=https://127.0.0.1:%d --cacert=%s --cert=%s --key=%s --command-timeout=%s %s`
+)
+
+func etcdctl(s ssh.Interface, args string, timeout time.Duration) ([]byte, error) {
+	cmd := fmt.Sprintf(etcdctlCmd, constants.EtcdListenClientPort, constants.EtcdCACertName,
+		constants.EtcdHealthcheckClientCertName, constants.EtcdHealthcheckClientKeyName, timeout, args)
+	stdout, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return nil, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return []byte(stdout), nil
+}
+
+// Snapshot saves a snapshot of the local etcd member on node to dir, and
+// returns the path of snapshot file.
+func Snapshot(s ssh.Interface, dir string, name string) (string, error) {
+	// etcd container only mounts the data dir, save to it and move out later.
+	tmpFile := path.Join(constants.EtcdDataDir, name)
+	file := path.Join(dir, name)
+	_, err := etcdctl(s, fmt.Sprintf("snapshot save %s", tmpFile), 5*time.Minute)
+	if err != nil {
+		return "", err
+	}
+	cmd := fmt.Sprintf("mkdir -p %s && mv -f %s %s", dir, tmpFile, file)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return "", fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return file, nil
+}
+
+// Upload streams the snapshot file on node to S3 compatible storage, and
+// returns the url of uploaded object. The snapshot is uploaded in parts
+// without reading it into memory as a whole.
+func Upload(s ssh.Interface, file string, storage *platformv1.S3Storage, secretAccessKey string) (string, error) {
+	body, err := s.OpenFile(file)
+	if err != nil {
+		return "", errors.Wrap(err, "open snapshot error")
+	}
+	defer body.Close()
+
+	config := aws.NewConfig().
+		WithEndpoint(storage.Endpoint).
+		WithRegion(storage.Region).
+		WithS3ForcePathStyle(true).
+		WithCredentials(credentials.NewStaticCredentials(storage.AccessKeyID, secretAccessKey, ""))
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return "", errors.Wrap(err, "create s3 session error")
+	}
+	key := path.Join(storage.Prefix, path.Base(file))
+	_, err = s3manager.NewUploader(sess).Upload(&s3manager.UploadInput{
+		Bucket: aws.String(storage.Bucket),
+		Key:    aws.String(key),
+		Body:   body,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "upload snapshot error")
+	}
+
+	return fmt.Sprintf("s3://%s/%s", storage.Bucket, key), nil
+}
+
+// Revision returns the current revision of the local etcd member on node.
+func Revision(s ssh.Interface) (int64, error) {
+	out, err := etcdctl(s, "endpoint status --write-out=json", 30*time.Second)
+	if err != nil {
+		return 0, err
+	}
+	var statuses []struct {
+		Status struct {
+			Header struct {
+				Revision int64 `json:"revision"`
+			} `json:"header"`
+		}
+	}
+	if err := json.Unmarshal(out, &statuses); err != nil {
+		return 0, errors.Wrap(err, "parse endpoint status error")
+	}
+	if len(statuses) == 0 {
+		return 0, errors.New("empty endpoint status")
+	}
+
+	return statuses[0].Status.Header.Revision, nil
+}
+
+// Compact discards the etcd history prior to revision. Compaction is
+// replicated to all members, so it only needs to run on one node.
+func Compact(s ssh.Interface, revision int64) error {
+	_, err := etcdctl(s, fmt.Sprintf("compact %d --physical", revision), 5*time.Minute)
+	return err
+}
+
+// Defragment releases the free space of the local etcd member on node,
+// and disarms the NOSPACE alarm raised before.
+func Defragment(s ssh.Interface) error {
+	_, err := etcdctl(s, "defrag", 5*time.Minute)
+	if err != nil {
+		return err
+	}
+	_, err = etcdctl(s, "alarm disarm", 30*time.Second)
+	return err
+}
+
+// MountDataDevice formats device if it has no filesystem and mounts it to
+// dir persistently. It is safe to retry, the fstab entry of device is only
+// added once.
+func MountDataDevice(s ssh.Interface, device string, dir string) error {
+	_, _, exit, err := s.Execf("mountpoint -q %s", dir)
+	if err == nil && exit == 0 {
+		return nil
+	}
+
+	// blkid exits with 2 if device has no filesystem.
+	_, stderr, exit, err := s.Execf("blkid %s", device)
+	if err != nil {
+		return errors.Wrapf(err, "probe device %s error", device)
+	}
+	switch exit {
+	case 0:
+	case 2:
+		if _, stderr, exit, err := s.Execf("mkfs.xfs -f %s", device); err != nil || exit != 0 {
+			return fmt.Errorf("format device %s failed:exit %d:stderr %s:error %s", device, exit, stderr, err)
+		}
+	default:
+		return fmt.Errorf("probe device %s failed:exit %d:stderr %s", device, exit, stderr)
+	}
+
+	uuid, err := blkidValue(s, device, "UUID")
+	if err != nil {
+		return err
+	}
+	fsType, err := blkidValue(s, device, "TYPE")
+	if err != nil {
+		return err
+	}
+	cmds := []string{
+		fmt.Sprintf("mkdir -p %s", dir),
+		fmt.Sprintf(`(grep -q "^UUID=%s " /etc/fstab || echo "UUID=%s %s %s defaults 0 0" >> /etc/fstab)`, uuid, uuid, dir, fsType),
+		fmt.Sprintf("mount %s", dir),
+	}
+	cmd := strings.Join(cmds, " && ")
+	_, stderr, exit, err = s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return nil
+}
+
+// blkidValue returns the value of tag, such as UUID or TYPE, of the
+// filesystem on device.
+func blkidValue(s ssh.Interface, device string, tag string) (string, error) {
+	stdout, stderr, exit, err := s.Execf("blkid -s %s -o value %s", tag, device)
+	if err != nil || exit != 0 {
+		return "", fmt.Errorf("get %s of device %s failed:exit %d:stderr %s:error %s", tag, device, exit, stderr, err)
+	}
+	value := strings.TrimSpace(stdout)
+	if value == "" {
+		return "", fmt.Errorf("device %s has no %s", device, tag)
+	}
+
+	return value, nil
+}
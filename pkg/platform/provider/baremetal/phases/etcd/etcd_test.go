@@ -0,0 +1,218 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package etcd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+type result struct {
+	stdout string
+	exit   int
+}
+
+// fakeSSH records executed commands and answers them with the result of the
+// first rule whose key is a prefix of the command.
+type fakeSSH struct {
+	rules    map[string]result
+	executed []string
+	files    map[string][]byte
+}
+
+func (f *fakeSSH) Ping() error { return nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	stdout, _, _, err := f.Exec(cmd)
+	return []byte(stdout), err
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.executed = append(f.executed, cmd)
+	for prefix, r := range f.rules {
+		if strings.HasPrefix(cmd, prefix) {
+			return r.stdout, "", r.exit, nil
+		}
+	}
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) CopyFile(src, dst string) error            { return nil }
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error { return nil }
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error)  { return nil, nil }
+func (f *fakeSSH) Exist(filename string) (bool, error)       { return false, nil }
+func (f *fakeSSH) LookPath(file string) (string, error)      { return file, nil }
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return ioutil.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeSSH) ran(prefix string) bool {
+	for _, cmd := range f.executed {
+		if strings.HasPrefix(cmd, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMountDataDevice(t *testing.T) {
+	const (
+		device = "/dev/vdb"
+		dir    = "/var/lib/etcd"
+	)
+	tests := []struct {
+		name      string
+		rules     map[string]result
+		wantErr   bool
+		wantMkfs  bool
+		wantMount string
+	}{
+		{
+			name:  "already mounted",
+			rules: map[string]result{"mountpoint": {exit: 0}},
+		},
+		{
+			name: "existing filesystem",
+			rules: map[string]result{
+				"mountpoint":      {exit: 1},
+				"blkid -s UUID":   {stdout: "1234\n"},
+				"blkid -s TYPE":   {stdout: "ext4\n"},
+				"blkid " + device: {exit: 0},
+			},
+			wantMount: `echo "UUID=1234 /var/lib/etcd ext4 defaults 0 0"`,
+		},
+		{
+			name: "empty device",
+			rules: map[string]result{
+				"mountpoint":      {exit: 1},
+				"blkid -s UUID":   {stdout: "5678"},
+				"blkid -s TYPE":   {stdout: "xfs"},
+				"blkid " + device: {exit: 2},
+			},
+			wantMkfs:  true,
+			wantMount: `echo "UUID=5678 /var/lib/etcd xfs defaults 0 0"`,
+		},
+		{
+			name: "probe failed",
+			rules: map[string]result{
+				"mountpoint":      {exit: 1},
+				"blkid " + device: {exit: 4},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no uuid",
+			rules: map[string]result{
+				"mountpoint":      {exit: 1},
+				"blkid -s UUID":   {stdout: ""},
+				"blkid " + device: {exit: 0},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeSSH{rules: tt.rules}
+			err := MountDataDevice(s, device, dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MountDataDevice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := s.ran("mkfs"); got != tt.wantMkfs {
+				t.Errorf("mkfs executed = %v, want %v: %v", got, tt.wantMkfs, s.executed)
+			}
+			if tt.wantMount == "" {
+				if s.ran("mkdir") {
+					t.Errorf("unexpected mount: %v", s.executed)
+				}
+				return
+			}
+			last := s.executed[len(s.executed)-1]
+			if !strings.Contains(last, tt.wantMount) {
+				t.Errorf("mount command %q does not contain %q", last, tt.wantMount)
+			}
+			if !strings.Contains(last, `grep -q "^UUID=`) {
+				t.Errorf("mount command %q does not guard the fstab entry", last)
+			}
+		})
+	}
+}
+
+func TestUpload(t *testing.T) {
+	const file = "/var/lib/etcd-backup/global-20201016000000.db"
+	snapshot := bytes.Repeat([]byte("etcd"), 4096)
+
+	var (
+		gotPath string
+		gotAuth string
+		gotBody []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		gotAuth = r.Header.Get("Authorization")
+		gotBody, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	storage := &platformv1.S3Storage{
+		Endpoint:    server.URL,
+		Region:      "ap-guangzhou",
+		Bucket:      "backup",
+		Prefix:      "etcd",
+		AccessKeyID: "id",
+	}
+	s := &fakeSSH{files: map[string][]byte{file: snapshot}}
+	location, err := Upload(s, file, storage, "secret")
+	if err != nil {
+		t.Fatalf("Upload() error = %v", err)
+	}
+	if want := "s3://backup/etcd/global-20201016000000.db"; location != want {
+		t.Errorf("Upload() location = %q, want %q", location, want)
+	}
+	if want := "/backup/etcd/global-20201016000000.db"; gotPath != want {
+		t.Errorf("uploaded to %q, want %q", gotPath, want)
+	}
+	if !strings.Contains(gotAuth, "Credential=id/") {
+		t.Errorf("request is not signed by the access key: %q", gotAuth)
+	}
+	if !bytes.Equal(gotBody, snapshot) {
+		t.Errorf("uploaded %d bytes, want the snapshot of %d bytes", len(gotBody), len(snapshot))
+	}
+
+	if _, err := Upload(&fakeSSH{}, file, storage, "secret"); err == nil {
+		t.Error("Upload() of missing snapshot should fail")
+	}
+}
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/util"
 	"tkestack.io/tke/pkg/util/log"
@@ -47,12 +48,19 @@ const (
 	crbName              = "galaxy"
 )
 
+const (
+	// BackendTypeWireGuard encrypts the flannel traffic with WireGuard by extension backend.
+	BackendTypeWireGuard = "wireguard"
+)
+
 // Option for coredns
 type Option struct {
 	Version     string
 	NodeCIDR    string
 	NetDevice   string
 	BackendType string
+	// Network is the underlay network of galaxy, its device overrides NetDevice.
+	Network *platformv1.GalaxyNetwork
 }
 
 // Install to install the galaxy workload
@@ -87,7 +95,7 @@ func Install(ctx context.Context, clientset kubernetes.Interface, option *Option
 		}
 	}
 	// Daemonset Flannel
-	flannelObj, err := daemonsetFlannel(option.Version)
+	flannelObj, err := daemonsetFlannel(option.Version, option.BackendType)
 	if err != nil {
 		return err
 	}
@@ -108,7 +116,7 @@ func Install(ctx context.Context, clientset kubernetes.Interface, option *Option
 		if !errors.IsNotFound(err) {
 			return err
 		}
-		cms, err := configMapGalaxy(option.NetDevice)
+		cms, err := configMapGalaxy(netDevice(option))
 		if err != nil {
 			return err
 		}
@@ -118,6 +126,18 @@ func Install(ctx context.Context, clientset kubernetes.Interface, option *Option
 			}
 		}
 	}
+	// floating IPs are allocated by galaxy-ipam addon, which keeps the existing ConfigMap
+	if option.Network != nil && len(option.Network.FloatingIPPools) > 0 {
+		cm, err := configMapFloatingIP(option.Network.FloatingIPPools)
+		if err != nil {
+			return err
+		}
+		if _, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Create(ctx, cm, metav1.CreateOptions{}); err != nil {
+			if !errors.IsAlreadyExists(err) {
+				return err
+			}
+		}
+	}
 	// Daemonset Galaxy
 	galaxyObj, err := daemonsetGalaxy(option.Version)
 	if err != nil {
@@ -133,7 +153,11 @@ func Install(ctx context.Context, clientset kubernetes.Interface, option *Option
 
 func configMapFlannel(clusterCIDR, backendType string) (*corev1.ConfigMap, error) {
 	flannelCM := strings.Replace(FlannelCM, "{{ .Network }}", clusterCIDR, 1)
-	flannelCM = strings.Replace(flannelCM, "{{ .Type }}", backendType, 1)
+	if backendType == BackendTypeWireGuard {
+		flannelCM = strings.Replace(flannelCM, `"Type": "{{ .Type }}"`, FlannelWireGuardBackend, 1)
+	} else {
+		flannelCM = strings.Replace(flannelCM, "{{ .Type }}", backendType, 1)
+	}
 	reader := strings.NewReader(flannelCM)
 	decoder := yaml.NewYAMLOrJSONDecoder(reader, 4096)
 	payload := &corev1.ConfigMap{}
@@ -164,7 +188,7 @@ func configMapGalaxy(netDevice string) ([]*corev1.ConfigMap, error) {
 	return payloads, nil
 }
 
-func daemonsetFlannel(version string) (*appsv1.DaemonSet, error) {
+func daemonsetFlannel(version string, backendType string) (*appsv1.DaemonSet, error) {
 	imageName := images.Get(version).Flannel.FullName()
 	reader := strings.NewReader(strings.Replace(FlannelDaemonset, "{{ .Image }}", imageName, -1))
 	payload := &appsv1.DaemonSet{}
@@ -173,6 +197,19 @@ func daemonsetFlannel(version string) (*appsv1.DaemonSet, error) {
 		return nil, err
 	}
 	payload.Name = daemonsetFlannelName
+	if backendType == BackendTypeWireGuard {
+		// wireguard commands are executed in host root by chroot
+		payload.Spec.Template.Spec.Volumes = append(payload.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: "host-root",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+			},
+		})
+		payload.Spec.Template.Spec.Containers[0].VolumeMounts = append(payload.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      "host-root",
+			MountPath: "/host",
+		})
+	}
 	return payload, nil
 }
 
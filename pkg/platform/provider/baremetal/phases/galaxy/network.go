@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package galaxy
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	cmFloatingIP  = "floatingip-config"
+	floatingIPKey = "floatingips"
+
+	// AnnotationConfigHash is the hash of galaxy config on the galaxy pod template,
+	// changing it restarts galaxy to load the new config.
+	AnnotationConfigHash = "platform.tkestack.io/galaxy-config-hash"
+)
+
+// floatingIPPool is the pool format of galaxy-ipam.
+type floatingIPPool struct {
+	RoutableSubnet string   `json:"routableSubnet"`
+	IPs            []string `json:"ips"`
+	Subnet         string   `json:"subnet"`
+	Gateway        string   `json:"gateway"`
+	Vlan           uint16   `json:"vlan,omitempty"`
+}
+
+// netDevice returns the underlay device of galaxy, the device of network
+// takes precedence over the network device of cluster.
+func netDevice(option *Option) string {
+	if option.Network != nil && option.Network.Device != "" {
+		return option.Network.Device
+	}
+	return option.NetDevice
+}
+
+// ApplyNetwork reconciles the galaxy and floatingip ConfigMaps with the
+// network of option, galaxy is restarted if its config is changed. Nothing
+// is changed if network is not set, so hand edited ConfigMaps are preserved.
+func ApplyNetwork(ctx context.Context, clientset kubernetes.Interface, option *Option) error {
+	if option.Network == nil {
+		return nil
+	}
+
+	cms, err := configMapGalaxy(netDevice(option))
+	if err != nil {
+		return err
+	}
+	for _, cm := range cms {
+		if cm.Name != cmGalaxy {
+			continue
+		}
+		current, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmGalaxy, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(current.Data, cm.Data) {
+			continue
+		}
+		current.Data = cm.Data
+		if _, err := clientset.CoreV1().ConfigMaps(metav1.NamespaceSystem).Update(ctx, current, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+		if err := restartGalaxy(ctx, clientset, cm.Data); err != nil {
+			return err
+		}
+	}
+
+	// galaxy-ipam watches the floating IPs, so no restart is needed
+	if len(option.Network.FloatingIPPools) > 0 {
+		cm, err := configMapFloatingIP(option.Network.FloatingIPPools)
+		if err != nil {
+			return err
+		}
+		if err := apiclient.CreateOrUpdateConfigMap(ctx, clientset, cm); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func restartGalaxy(ctx context.Context, clientset kubernetes.Interface, data map[string]string) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(b)
+	patch := fmt.Sprintf(`{"spec":{"template":{"metadata":{"annotations":{%q:%q}}}}}`, AnnotationConfigHash, hex.EncodeToString(sum[:]))
+	_, err = clientset.AppsV1().DaemonSets(metav1.NamespaceSystem).Patch(ctx, daemonsetGalaxyName, types.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	if errors.IsNotFound(err) {
+		// galaxy is not installed yet, which loads the config on start
+		return nil
+	}
+	return err
+}
+
+func configMapFloatingIP(pools []platformv1.FloatingIPPool) (*corev1.ConfigMap, error) {
+	var items []floatingIPPool
+	for _, pool := range pools {
+		routableSubnet := pool.NodeSubnet
+		if routableSubnet == "" {
+			routableSubnet = pool.Subnet
+		}
+		items = append(items, floatingIPPool{
+			RoutableSubnet: routableSubnet,
+			IPs:            pool.IPs,
+			Subnet:         pool.Subnet,
+			Gateway:        pool.Gateway,
+			Vlan:           uint16(pool.VLAN),
+		})
+	}
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cmFloatingIP,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			floatingIPKey: string(data),
+		},
+	}, nil
+}
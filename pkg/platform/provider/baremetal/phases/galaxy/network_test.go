@@ -0,0 +1,163 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package galaxy
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestNetDevice(t *testing.T) {
+	tests := []struct {
+		name   string
+		option *Option
+		want   string
+	}{
+		{"without network", &Option{NetDevice: "eth0"}, "eth0"},
+		{"without device", &Option{NetDevice: "eth0", Network: &platformv1.GalaxyNetwork{}}, "eth0"},
+		{"device of network", &Option{NetDevice: "eth0", Network: &platformv1.GalaxyNetwork{Device: "bond0"}}, "bond0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := netDevice(tt.option); got != tt.want {
+				t.Errorf("netDevice() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfigMapFloatingIP(t *testing.T) {
+	cm, err := configMapFloatingIP([]platformv1.FloatingIPPool{
+		{Subnet: "10.1.0.0/24", Gateway: "10.1.0.1", IPs: []string{"10.1.0.10~10.1.0.20"}},
+		{Subnet: "10.2.0.0/24", NodeSubnet: "10.0.0.0/24", Gateway: "10.2.0.1", VLAN: 100, IPs: []string{"10.2.0.10", "10.2.0.11"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cm.Name != cmFloatingIP || cm.Namespace != metav1.NamespaceSystem {
+		t.Errorf("unexpected configmap %s/%s", cm.Namespace, cm.Name)
+	}
+	var got []floatingIPPool
+	if err := json.Unmarshal([]byte(cm.Data[floatingIPKey]), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []floatingIPPool{
+		{RoutableSubnet: "10.1.0.0/24", IPs: []string{"10.1.0.10~10.1.0.20"}, Subnet: "10.1.0.0/24", Gateway: "10.1.0.1"},
+		{RoutableSubnet: "10.0.0.0/24", IPs: []string{"10.2.0.10", "10.2.0.11"}, Subnet: "10.2.0.0/24", Gateway: "10.2.0.1", Vlan: 100},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("floating IP pools = %+v, want %+v", got, want)
+	}
+}
+
+func TestApplyNetwork(t *testing.T) {
+	ctx := context.Background()
+	cms, err := configMapGalaxy("eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var galaxyCM *corev1.ConfigMap
+	for _, cm := range cms {
+		if cm.Name == cmGalaxy {
+			galaxyCM = cm
+		}
+	}
+	client := fake.NewSimpleClientset(galaxyCM, &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Name: daemonsetGalaxyName, Namespace: metav1.NamespaceSystem},
+	})
+
+	if err := ApplyNetwork(ctx, client, &Option{NetDevice: "eth0"}); err != nil {
+		t.Fatal(err)
+	}
+	if len(client.Actions()) != 0 {
+		t.Errorf("expected nothing changed without network, got %v", client.Actions())
+	}
+
+	option := &Option{
+		NetDevice: "eth0",
+		Network: &platformv1.GalaxyNetwork{
+			Device: "bond0",
+			FloatingIPPools: []platformv1.FloatingIPPool{
+				{Subnet: "10.1.0.0/24", Gateway: "10.1.0.1", IPs: []string{"10.1.0.10~10.1.0.20"}},
+			},
+		},
+	}
+	if err := ApplyNetwork(ctx, client, option); err != nil {
+		t.Fatal(err)
+	}
+	cm, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmGalaxy, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(cm.Data["galaxy.json"], `"device":"bond0"`) {
+		t.Errorf("expected device bond0 in galaxy config, got %s", cm.Data["galaxy.json"])
+	}
+	ds, err := client.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, daemonsetGalaxyName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := ds.Spec.Template.Annotations[AnnotationConfigHash]
+	if hash == "" {
+		t.Error("expected galaxy restarted by the config hash annotation")
+	}
+	if _, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmFloatingIP, metav1.GetOptions{}); err != nil {
+		t.Errorf("expected floating IP configmap created: %v", err)
+	}
+
+	// the unchanged config doesn't restart galaxy
+	client.ClearActions()
+	if err := ApplyNetwork(ctx, client, option); err != nil {
+		t.Fatal(err)
+	}
+	for _, action := range client.Actions() {
+		if action.GetVerb() == "patch" {
+			t.Errorf("unexpected restart of galaxy: %v", action)
+		}
+	}
+}
+
+func TestApplyNetworkWithoutGalaxy(t *testing.T) {
+	ctx := context.Background()
+	cms, err := configMapGalaxy("eth0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cms[0].Name != cmGalaxy {
+		t.Fatalf("expected the first configmap is %s, got %s", cmGalaxy, cms[0].Name)
+	}
+	client := fake.NewSimpleClientset(cms[0])
+
+	// galaxy which is not installed yet loads the config on start
+	err = ApplyNetwork(ctx, client, &Option{NetDevice: "eth0", Network: &platformv1.GalaxyNetwork{Device: "bond0"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(ctx, cmFloatingIP, metav1.GetOptions{}); err == nil {
+		t.Error("expected floating IP configmap not created without pools")
+	}
+}
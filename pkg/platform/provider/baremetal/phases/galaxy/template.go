@@ -258,4 +258,14 @@ data:
       }
     }
 `
+
+	// FlannelWireGuardBackend replaces the backend type of FlannelCM to set up a
+	// WireGuard mesh by extension backend. The private key is regenerated every
+	// time flannel starts, so restarting flannel rotates the keys.
+	FlannelWireGuardBackend = `"Type": "extension",
+        "PreStartupCommand": "chroot /host sh -c 'mkdir -p /etc/wireguard && umask 077 && wg genkey > /etc/wireguard/flannel.key && wg pubkey < /etc/wireguard/flannel.key'",
+        "PostStartupCommand": "export SUBNET_IP=$(echo $SUBNET | cut -d'/' -f 1); chroot /host ip link del flannel-wg 2>/dev/null; chroot /host ip link add flannel-wg type wireguard && chroot /host wg set flannel-wg listen-port 51820 private-key /etc/wireguard/flannel.key && chroot /host ip addr add $SUBNET_IP/32 dev flannel-wg && chroot /host ip link set flannel-wg up && chroot /host ip route add $NETWORK dev flannel-wg",
+        "ShutdownCommand": "chroot /host ip link del flannel-wg",
+        "SubnetAddCommand": "read PUBLICKEY; chroot /host wg set flannel-wg peer $PUBLICKEY endpoint $PUBLIC_IP:51820 allowed-ips $SUBNET",
+        "SubnetRemoveCommand": "read PUBLICKEY; chroot /host wg set flannel-wg peer $PUBLICKEY remove"`
 )
@@ -83,8 +83,18 @@ func InstallNvidiaContainerRuntime(s ssh.Interface, option *NvidiaContainerRunti
 	return nil
 }
 
+const (
+	// MIGPartedConfigMapName is the ConfigMap in kube-system holding the
+	// mig-parted config of nvidia-mig-manager.
+	MIGPartedConfigMapName = "nvidia-mig-parted-config"
+	// MIGPartedConfigKey is the key of the mig-parted config in the ConfigMap.
+	MIGPartedConfigKey = "config.yaml"
+)
+
 type NvidiaDevicePluginOption struct {
 	Image string
+	// MIGStrategy is passed to the device plugin if MIG is enabled.
+	MIGStrategy string
 }
 
 func InstallNvidiaDevicePlugin(ctx context.Context, clientset clientset.Interface, option *NvidiaDevicePluginOption) error {
@@ -95,6 +105,18 @@ func InstallNvidiaDevicePlugin(ctx context.Context, clientset clientset.Interfac
 	return nil
 }
 
+type NvidiaMIGManagerOption struct {
+	Image         string
+	ConfigMapName string
+}
+
+// InstallNvidiaMIGManager deploys nvidia-mig-manager which repartitions the
+// GPUs of nodes as the mig-parted config selected by the node label
+// nvidia.com/mig.config.
+func InstallNvidiaMIGManager(ctx context.Context, clientset clientset.Interface, option *NvidiaMIGManagerOption) error {
+	return apiclient.CreateResourceWithFile(ctx, clientset, constants.ManifestsDir+"gpu/nvidia-mig-manager.yaml", option)
+}
+
 func IsEnable(labels map[string]string) bool {
 	return labels["nvidia-device-enable"] == "enable"
 }
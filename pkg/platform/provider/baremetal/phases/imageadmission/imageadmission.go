@@ -0,0 +1,150 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package imageadmission
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/imageadmission"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+const (
+	namespace           = metav1.NamespaceSystem
+	name                = "tke-image-admission"
+	certsSecretName     = name + "-certs"
+	policyConfigMapName = name + "-policy"
+	policyFileName      = "policy.json"
+)
+
+// Install deploys the image admission webhook with the policy, the serving
+// certificate is generated once and kept in a secret.
+func Install(ctx context.Context, client kubernetes.Interface, policy *platformv1.ImageAdmission) error {
+	// the webhook must not block the pods of itself and other system components
+	err := ensureExemptNamespace(ctx, client, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "exempt namespace %s error", namespace)
+	}
+	caCert, err := apiclient.EnsureWebhookServingCert(ctx, client, namespace, name, certsSecretName)
+	if err != nil {
+		return errors.Wrap(err, "create serving certificate error")
+	}
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	err = apiclient.CreateOrUpdateConfigMap(ctx, client, &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      policyConfigMapName,
+			Namespace: namespace,
+		},
+		Data: map[string]string{policyFileName: string(data)},
+	})
+	if err != nil {
+		return errors.Wrap(err, "create policy configmap error")
+	}
+
+	// pods are admitted if the webhook is unavailable in warn mode
+	failurePolicy := "Fail"
+	if policy.Mode == platformv1.ImageAdmissionWarn {
+		failurePolicy = "Ignore"
+	}
+	option := map[string]interface{}{
+		"Image":         images.Get().ImageAdmission.FullName(),
+		"CABundle":      base64.StdEncoding.EncodeToString(caCert),
+		"FailurePolicy": failurePolicy,
+		"ExemptLabel":   imageadmission.ExemptAnnotation,
+	}
+	return apiclient.CreateResourceWithFile(ctx, client, constants.ImageAdmissionManifest, option)
+}
+
+// Uninstall removes the image admission webhook, the webhook configuration is
+// deleted first to stop blocking pods.
+func Uninstall(ctx context.Context, client kubernetes.Interface) error {
+	deletes := []func() error{
+		func() error {
+			return client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.AppsV1().Deployments(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().Services(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().ConfigMaps(namespace).Delete(ctx, policyConfigMapName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().Secrets(namespace).Delete(ctx, certsSecretName, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.RbacV1().ClusterRoleBindings().Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.RbacV1().ClusterRoles().Delete(ctx, name, metav1.DeleteOptions{})
+		},
+		func() error {
+			return client.CoreV1().ServiceAccounts(namespace).Delete(ctx, name, metav1.DeleteOptions{})
+		},
+	}
+	for _, f := range deletes {
+		if err := f(); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// Installed reports whether the image admission webhook is installed.
+func Installed(ctx context.Context, client kubernetes.Interface) (bool, error) {
+	_, err := client.AdmissionregistrationV1beta1().ValidatingWebhookConfigurations().Get(ctx, name, metav1.GetOptions{})
+	if err == nil {
+		return true, nil
+	}
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+func ensureExemptNamespace(ctx context.Context, client kubernetes.Interface, name string) error {
+	ns, err := client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if ns.Labels[imageadmission.ExemptAnnotation] == "true" {
+		return nil
+	}
+	if ns.Labels == nil {
+		ns.Labels = map[string]string{}
+	}
+	ns.Labels[imageadmission.ExemptAnnotation] = "true"
+	_, err = client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{})
+	return err
+}
@@ -23,11 +23,13 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/Masterminds/semver"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -37,6 +39,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	utilsnet "k8s.io/utils/net"
 	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	platformv1 "tkestack.io/tke/api/platform/v1"
 	kubeadmv1beta2 "tkestack.io/tke/pkg/platform/provider/baremetal/apis/kubeadm/v1beta2"
@@ -48,6 +51,7 @@ import (
 	"tkestack.io/tke/pkg/util/apiclient"
 	"tkestack.io/tke/pkg/util/log"
 	"tkestack.io/tke/pkg/util/ssh"
+	"tkestack.io/tke/pkg/util/supervisor"
 	"tkestack.io/tke/pkg/util/template"
 )
 
@@ -369,7 +373,7 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 			return upgraded, err
 		}
 		if needUpgrade {
-			logger.Infof("Start drain node to %s", option.MachineIP)
+			logger.Infof("Start upgrade control plane of %s", option.MachineIP)
 			if cluster.Spec.Machines[0].IP == option.MachineIP {
 				err = upgradeBootstrapNode(s, client, option.Version)
 				if err != nil {
@@ -381,7 +385,19 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 					return upgraded, err
 				}
 			}
-			logger.Infof("End drain node to %s", option.MachineIP)
+			logger.Infof("End upgrade control plane of %s", option.MachineIP)
+		}
+
+		// verify control plane is running with the target version before upgrading next node
+		err = wait.PollImmediate(10*time.Second, 5*time.Minute, func() (bool, error) {
+			needUpgrade, err := needUpgradeControlPlane(client, node.Name, option.Version)
+			if err != nil {
+				return false, nil
+			}
+			return !needUpgrade, nil
+		})
+		if err != nil {
+			return upgraded, fmt.Errorf("wait control plane of %s to be upgraded error: %w", option.MachineIP, err)
 		}
 	}
 
@@ -426,9 +442,115 @@ func UpgradeNode(s ssh.Interface, client kubernetes.Interface, platformClient pl
 		return upgraded, err
 	}
 
+	// Step 6: wait for node to be ready
+	err = wait.PollImmediate(10*time.Second, 5*time.Minute, func() (bool, error) {
+		return isNodeReady(client, node.Name), nil
+	})
+	if err != nil {
+		return upgraded, fmt.Errorf("wait node %s to be ready error: %w", node.Name, err)
+	}
+
 	return true, nil
 }
 
+// upgradeBackupFiles are the binaries and configurations changed by node upgrade.
+var upgradeBackupFiles = []string{
+	path.Join(constants.DstBinDir, "kubelet"),
+	path.Join(constants.DstBinDir, "kubectl"),
+	path.Join(constants.DstBinDir, "kubeadm"),
+	path.Join(supervisor.DefaultSystemdUnitFilePath, "kubelet.service"),
+	kubeadmKubeletConf,
+	constants.KubeletConfigFile,
+	"/var/lib/kubelet/kubeadm-flags.env",
+}
+
+// BackupNode saves the files changed by upgrade on node before upgrading it
+// to version, and returns the backup which the node can be rolled back to.
+func BackupNode(s ssh.Interface, client kubernetes.Interface, machineIP string, version string) (*platformv1.MachineUpgradeBackup, error) {
+	node, err := apiclient.GetNodeByMachineIP(context.TODO(), client, machineIP)
+	if err != nil {
+		return nil, err
+	}
+	backup := &platformv1.MachineUpgradeBackup{
+		Version:       node.Status.NodeInfo.KubeletVersion,
+		TargetVersion: version,
+		Dir:           path.Join(constants.UpgradeBackupDir, time.Now().Format("20060102150405")),
+		CreationTime:  metav1.Now(),
+	}
+	for _, file := range upgradeBackupFiles {
+		ok, err := s.Exist(file)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		dst := path.Join(backup.Dir, file)
+		cmd := fmt.Sprintf("mkdir -p %s && cp -af %s %s", path.Dir(dst), file, dst)
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return nil, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+		backup.Files = append(backup.Files, file)
+	}
+
+	return backup, nil
+}
+
+// RollbackNode restores the files saved before upgrade on node, restarts
+// kubelet and uncordons the node once it is ready with the previous version.
+func RollbackNode(s ssh.Interface, client kubernetes.Interface, machineIP string, backup *platformv1.MachineUpgradeBackup) error {
+	node, err := apiclient.GetNodeByMachineIP(context.TODO(), client, machineIP)
+	if err != nil {
+		return err
+	}
+	err = kubelet.ServiceOperate(s, kubelet.Stop)
+	if err != nil {
+		return err
+	}
+	for _, file := range backup.Files {
+		cmd := fmt.Sprintf("cp -af %s %s", path.Join(backup.Dir, file), file)
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+	}
+	_, err = s.CombinedOutput("systemctl daemon-reload")
+	if err != nil {
+		return err
+	}
+	err = kubelet.ServiceOperate(s, kubelet.Start)
+	if err != nil {
+		return err
+	}
+
+	err = wait.PollImmediate(10*time.Second, 5*time.Minute, func() (bool, error) {
+		same, err := checkKubeletVersion(client, node.Name, backup.Version, false)
+		if err != nil || !same {
+			return false, nil
+		}
+		return isNodeReady(client, node.Name), nil
+	})
+	if err != nil {
+		return fmt.Errorf("wait node %s to be ready with version %s error: %w", node.Name, backup.Version, err)
+	}
+
+	return uncordonNode(s, node.Name)
+}
+
+func isNodeReady(client kubernetes.Interface, nodeName string) bool {
+	node, err := client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		return false
+	}
+	for _, one := range node.Status.Conditions {
+		if one.Type == corev1.NodeReady {
+			return one.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
 func checkKubeletVersion(client kubernetes.Interface, nodeName, version string, ignorePatchVersion bool) (same bool, err error) {
 	node, err := client.CoreV1().Nodes().Get(context.TODO(), nodeName, metav1.GetOptions{})
 	if err != nil {
@@ -608,31 +730,57 @@ func uncordonNode(s ssh.Interface, nodeName string) error {
 	return nil
 }
 
-// markNextUpgradeWorkerNode marks next wokrer node to be upgraded.
-func MarkNextUpgradeWorkerNode(client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, version, clusterName string) error {
+// MarkNextUpgradeWorkerNode marks next worker nodes to be upgraded, at most
+// maxUnavailable+maxSurge worker nodes are upgrading at the same time and no
+// more worker nodes are marked once maxUnavailable worker nodes are unavailable.
+func MarkNextUpgradeWorkerNode(client kubernetes.Interface, platformClient platformv1client.PlatformV1Interface, version, clusterName string, upgrade platformv1.Upgrade) error {
+	if upgrade.Paused {
+		return nil
+	}
 	machines, err := platformClient.Machines().List(context.TODO(), metav1.ListOptions{
-		LabelSelector: fields.OneTermEqualSelector(constants.LabelNodeNeedUpgrade, WillUpgrade).String(),
 		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, clusterName).String(),
 	})
 	if err != nil {
 		return err
 	}
-	// No machines need to be upgraded.
-	if len(machines.Items) == 0 {
-		return nil
+
+	nodes, err := client.CoreV1().Nodes().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return err
 	}
+	nodeByIP := nodesByMachineIP(nodes.Items)
 
-	// Get next upgraded machine by lowest name.
-	var nextMachineName string
+	var (
+		upgrading   int
+		unavailable int
+		nexts       []string
+	)
 	for _, machine := range machines.Items {
-		if nextMachineName == "" {
-			nextMachineName = machine.Name
-		} else if strings.Compare(machine.Name, nextMachineName) < 0 {
-			nextMachineName = machine.Name
+		node, ok := nodeByIP(machine.Spec.IP)
+		if !ok || isNodeUnavailable(node) {
+			unavailable++
+		}
+		if machine.Status.Phase == platformv1.MachineUpgrading {
+			upgrading++
+			continue
+		}
+		if machine.Labels[constants.LabelNodeNeedUpgrade] == WillUpgrade {
+			nexts = append(nexts, machine.Name)
 		}
 	}
-	if nextMachineName != "" {
-		err = platformapiclient.PatchMachine(context.TODO(), platformClient, nextMachineName, func(machine *platformv1.Machine) {
+	// No machines need to be upgraded.
+	if len(nexts) == 0 {
+		return nil
+	}
+
+	quota, err := upgradeQuota(upgrade.Strategy, len(machines.Items), upgrading, unavailable)
+	if err != nil {
+		return err
+	}
+	// Get next upgraded machines by lowest name.
+	sort.Strings(nexts)
+	for i := 0; i < len(nexts) && i < quota; i++ {
+		err = platformapiclient.PatchMachine(context.TODO(), platformClient, nexts[i], func(machine *platformv1.Machine) {
 			machine.Status.Phase = platformv1.MachineUpgrading
 		})
 		if err != nil {
@@ -643,6 +791,76 @@ func MarkNextUpgradeWorkerNode(client kubernetes.Interface, platformClient platf
 	return nil
 }
 
+// upgradeQuota returns the number of worker nodes which can be marked to
+// upgrade, given the number of worker nodes which are upgrading and the number
+// of worker nodes which are unavailable.
+func upgradeQuota(strategy platformv1.UpgradeStrategy, total, upgrading, unavailable int) (int, error) {
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(
+		intstr.ValueOrDefault(strategy.MaxUnavailable, intstr.FromInt(1)), total, false)
+	if err != nil {
+		return 0, err
+	}
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	maxSurge, err := intstr.GetValueFromIntOrPercent(
+		intstr.ValueOrDefault(strategy.MaxSurge, intstr.FromInt(0)), total, true)
+	if err != nil {
+		return 0, err
+	}
+
+	quota := maxUnavailable + maxSurge - upgrading
+	if available := maxUnavailable - unavailable; available < quota {
+		quota = available
+	}
+	if quota < 0 {
+		quota = 0
+	}
+	return quota, nil
+}
+
+// nodesByMachineIP returns a func to find the node of a machine ip in nodes,
+// which matches the node like apiclient.GetNodeByMachineIP.
+func nodesByMachineIP(nodes []corev1.Node) func(ip string) (*corev1.Node, bool) {
+	index := map[string]*corev1.Node{}
+	for i := range nodes {
+		node := &nodes[i]
+		if ip, ok := node.Labels[string(apiclient.LabelMachineIPV4)]; ok {
+			index[ip] = node
+		}
+		head, tail := node.Labels[string(apiclient.LabelMachineIPV6Head)], node.Labels[string(apiclient.LabelMachineIPV6Tail)]
+		if head != "" && tail != "" {
+			index[fmt.Sprintf("%s=%s,%s=%s", apiclient.LabelMachineIPV6Head, head, apiclient.LabelMachineIPV6Tail, tail)] = node
+		}
+	}
+	// the node named by the machine ip takes precedence
+	for i := range nodes {
+		index[nodes[i].Name] = &nodes[i]
+	}
+	return func(ip string) (*corev1.Node, bool) {
+		if node, ok := index[ip]; ok {
+			return node, true
+		}
+		if utilsnet.IsIPv6String(ip) {
+			node, ok := index[apiclient.GetNodeIPV6Label(ip)]
+			return node, ok
+		}
+		return nil, false
+	}
+}
+
+func isNodeUnavailable(node *corev1.Node) bool {
+	if node.Spec.Unschedulable {
+		return true
+	}
+	for _, one := range node.Status.Conditions {
+		if one.Type == corev1.NodeReady {
+			return one.Status != corev1.ConditionTrue
+		}
+	}
+	return true
+}
+
 func RemoveUpgradeLabel(platformClient platformv1client.PlatformV1Interface, machine *platformv1.Machine) error {
 	err := platformapiclient.PatchMachine(context.TODO(), platformClient, machine.Name, func(machine *platformv1.Machine) {
 		// Remove upgrade label
@@ -19,7 +19,25 @@
 package kubeadm
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"tkestack.io/tke/api/client/clientset/versioned/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/apiclient"
 )
 
 func Test_sameVersion(t *testing.T) {
@@ -116,3 +134,302 @@ func Test_sameVersion(t *testing.T) {
 		})
 	}
 }
+
+func Test_upgradeQuota(t *testing.T) {
+	intOrStr := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+	tests := []struct {
+		name        string
+		strategy    platformv1.UpgradeStrategy
+		total       int
+		upgrading   int
+		unavailable int
+		want        int
+		wantErr     bool
+	}{
+		{"default one by one", platformv1.UpgradeStrategy{}, 10, 0, 0, 1, false},
+		{"default wait upgrading node", platformv1.UpgradeStrategy{}, 10, 1, 1, 0, false},
+		{"max unavailable", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromInt(3))}, 10, 1, 1, 2, false},
+		{"max unavailable percent", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("50%"))}, 10, 0, 0, 5, false},
+		{"max unavailable at least one", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("1%"))}, 10, 0, 0, 1, false},
+		{"unavailable node not upgrading", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromInt(2))}, 10, 0, 1, 1, false},
+		{"max surge with available upgrading node", platformv1.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromInt(1))}, 10, 1, 0, 1, false},
+		{"max surge with unavailable upgrading node", platformv1.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromInt(1))}, 10, 1, 1, 0, false},
+		{"max surge percent rounds up", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromInt(3)), MaxSurge: intOrStr(intstr.FromString("15%"))}, 10, 3, 0, 2, false},
+		{"max surge limits upgrading", platformv1.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromInt(1))}, 10, 2, 0, 0, false},
+		{"too many unavailable", platformv1.UpgradeStrategy{}, 10, 0, 3, 0, false},
+		{"max unavailable percent rounds down", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("25%"))}, 10, 0, 0, 2, false},
+		{"max unavailable percent rounds down to one", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("25%"))}, 3, 0, 0, 1, false},
+		{"max surge percent rounds up to one", platformv1.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromString("10%"))}, 3, 1, 0, 1, false},
+		{"max surge percent bounded by unavailable", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("50%")), MaxSurge: intOrStr(intstr.FromString("34%"))}, 7, 2, 1, 2, false},
+		{"both percents", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("50%")), MaxSurge: intOrStr(intstr.FromString("34%"))}, 7, 5, 0, 1, false},
+		{"invalid max unavailable", platformv1.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("a%"))}, 10, 0, 0, 0, true},
+		{"invalid max surge", platformv1.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromString("1"))}, 10, 0, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := upgradeQuota(tt.strategy, tt.total, tt.upgrading, tt.unavailable)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("upgradeQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("upgradeQuota() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func node(name string, ready bool, labels map[string]string) *corev1.Node {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+		},
+	}
+}
+
+func Test_nodesByMachineIP(t *testing.T) {
+	ipv6Labels, err := labels.ConvertSelectorToLabelsMap(apiclient.GetNodeIPV6Label("fd00::a:1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodes := []corev1.Node{
+		*node("10.0.0.1", true, nil),
+		*node("node-2", true, map[string]string{string(apiclient.LabelMachineIPV4): "10.0.0.2"}),
+		*node("node-3", true, ipv6Labels),
+		*node("node-4", true, map[string]string{string(apiclient.LabelMachineIPV4): "10.0.0.1"}),
+	}
+	nodeByIP := nodesByMachineIP(nodes)
+	tests := []struct {
+		ip   string
+		want string
+	}{
+		{"10.0.0.1", "10.0.0.1"},
+		{"10.0.0.2", "node-2"},
+		{"fd00::a:1", "node-3"},
+		{"fd00::a:2", ""},
+		{"10.0.0.3", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			got, ok := nodeByIP(tt.ip)
+			if ok != (tt.want != "") {
+				t.Fatalf("nodesByMachineIP(%s) found = %v, want %q", tt.ip, ok, tt.want)
+			}
+			if ok && got.Name != tt.want {
+				t.Errorf("nodesByMachineIP(%s) = %s, want %s", tt.ip, got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkNextUpgradeWorkerNode(t *testing.T) {
+	machine := func(name, ip string, phase platformv1.MachinePhase, needUpgrade bool) *platformv1.Machine {
+		m := &platformv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}},
+			Spec:       platformv1.MachineSpec{ClusterName: "cls-1", IP: ip},
+			Status:     platformv1.MachineStatus{Phase: phase},
+		}
+		if needUpgrade {
+			m.Labels[constants.LabelNodeNeedUpgrade] = WillUpgrade
+		}
+		return m
+	}
+	maxUnavailable := intstr.FromString("50%")
+	maxSurge := intstr.FromInt(1)
+	three := intstr.FromInt(3)
+	tests := []struct {
+		name    string
+		upgrade platformv1.Upgrade
+		nodes   []runtime.Object
+		want    []string
+	}{
+		{
+			name:    "mark the lowest names",
+			upgrade: platformv1.Upgrade{Strategy: platformv1.UpgradeStrategy{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge}},
+			nodes: []runtime.Object{
+				node("10.0.0.0", true, nil), node("10.0.0.1", true, nil), node("10.0.0.2", true, nil),
+				node("10.0.0.3", true, nil), node("10.0.0.4", true, nil),
+			},
+			want: []string{"mc-1", "mc-2", "mc-3"},
+		},
+		{
+			name:    "unavailable and missing nodes take quota",
+			upgrade: platformv1.Upgrade{Strategy: platformv1.UpgradeStrategy{MaxUnavailable: &three}},
+			nodes: []runtime.Object{
+				node("10.0.0.0", true, nil), node("10.0.0.1", false, nil),
+				node("10.0.0.2", true, nil), node("10.0.0.3", true, nil),
+			},
+			want: []string{"mc-1", "mc-3"},
+		},
+		{
+			name:    "paused",
+			upgrade: platformv1.Upgrade{Paused: true, Strategy: platformv1.UpgradeStrategy{MaxUnavailable: &maxUnavailable}},
+			nodes: []runtime.Object{
+				node("10.0.0.1", true, nil), node("10.0.0.2", true, nil),
+				node("10.0.0.3", true, nil), node("10.0.0.4", true, nil),
+			},
+			want: []string{"mc-3"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// mc-3 is upgrading, mc-0 is upgraded
+			platformClient := fake.NewSimpleClientset(
+				machine("mc-0", "10.0.0.0", platformv1.MachineRunning, false),
+				machine("mc-1", "10.0.0.1", platformv1.MachineRunning, true),
+				machine("mc-2", "10.0.0.2", platformv1.MachineRunning, true),
+				machine("mc-3", "10.0.0.3", platformv1.MachineUpgrading, false),
+				machine("mc-4", "10.0.0.4", platformv1.MachineRunning, true),
+			)
+			client := k8sfake.NewSimpleClientset(tt.nodes...)
+			err := MarkNextUpgradeWorkerNode(client, platformClient.PlatformV1(), "1.20.4", "cls-1", tt.upgrade)
+			if err != nil {
+				t.Fatalf("MarkNextUpgradeWorkerNode() error = %v", err)
+			}
+			machines, err := platformClient.PlatformV1().Machines().List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, one := range machines.Items {
+				if one.Status.Phase == platformv1.MachineUpgrading {
+					got = append(got, one.Name)
+				}
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("upgrading machines = %v, want %v", got, tt.want)
+			}
+			nodeLists := 0
+			for _, action := range client.Actions() {
+				if action.GetResource().Resource == "nodes" {
+					if action.GetVerb() != "list" {
+						t.Errorf("unexpected %s nodes", action.GetVerb())
+					}
+					nodeLists++
+				}
+			}
+			if !tt.upgrade.Paused && nodeLists != 1 {
+				t.Errorf("nodes listed %d times, want 1", nodeLists)
+			}
+		})
+	}
+}
+
+// fakeSSH records the commands and reports the files as existing.
+type fakeSSH struct {
+	files    map[string]bool
+	commands []string
+}
+
+func (f *fakeSSH) Ping() error                          { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error       { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error) { return file, nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error { return nil }
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	return f.files[filename], nil
+}
+
+func TestBackupNode(t *testing.T) {
+	worker := node("10.0.0.1", true, nil)
+	worker.Status.NodeInfo.KubeletVersion = "v1.19.7"
+	client := k8sfake.NewSimpleClientset(worker)
+	s := &fakeSSH{files: map[string]bool{
+		path.Join(constants.DstBinDir, "kubelet"): true,
+		constants.KubeletConfigFile:               true,
+	}}
+
+	backup, err := BackupNode(s, client, "10.0.0.1", "1.20.4")
+	if err != nil {
+		t.Fatalf("BackupNode() error = %v", err)
+	}
+	if backup.Version != "v1.19.7" || backup.TargetVersion != "1.20.4" {
+		t.Errorf("BackupNode() version = %s, target version = %s", backup.Version, backup.TargetVersion)
+	}
+	if !strings.HasPrefix(backup.Dir, constants.UpgradeBackupDir+"/") {
+		t.Errorf("BackupNode() dir = %s, want in %s", backup.Dir, constants.UpgradeBackupDir)
+	}
+	wantFiles := []string{path.Join(constants.DstBinDir, "kubelet"), constants.KubeletConfigFile}
+	if !reflect.DeepEqual(backup.Files, wantFiles) {
+		t.Errorf("BackupNode() files = %v, want %v", backup.Files, wantFiles)
+	}
+	for _, file := range wantFiles {
+		dst := path.Join(backup.Dir, file)
+		want := fmt.Sprintf("mkdir -p %s && cp -af %s %s", path.Dir(dst), file, dst)
+		if !containsString(s.commands, want) {
+			t.Errorf("commands = %v, want %s", s.commands, want)
+		}
+	}
+	if len(s.commands) != len(wantFiles) {
+		t.Errorf("commands = %v, want the missing files skipped", s.commands)
+	}
+
+	if _, err := BackupNode(s, client, "10.0.0.2", "1.20.4"); err == nil {
+		t.Errorf("BackupNode() of machine without node succeeded")
+	}
+}
+
+func TestRollbackNode(t *testing.T) {
+	backup := &platformv1.MachineUpgradeBackup{
+		Version:       "v1.19.7",
+		TargetVersion: "1.20.4",
+		Dir:           "/var/lib/upgrade-backup/20261016000000",
+		Files:         []string{path.Join(constants.DstBinDir, "kubelet"), constants.KubeletConfigFile},
+	}
+	worker := node("10.0.0.1", true, nil)
+	worker.Status.NodeInfo.KubeletVersion = "v1.19.7"
+	s := &fakeSSH{}
+
+	err := RollbackNode(s, k8sfake.NewSimpleClientset(worker), "10.0.0.1", backup)
+	if err != nil {
+		t.Fatalf("RollbackNode() error = %v", err)
+	}
+	want := []string{
+		"systemctl stop kubelet",
+		fmt.Sprintf("cp -af %s %s", path.Join(backup.Dir, backup.Files[0]), backup.Files[0]),
+		fmt.Sprintf("cp -af %s %s", path.Join(backup.Dir, backup.Files[1]), backup.Files[1]),
+		"systemctl daemon-reload",
+		"systemctl start kubelet",
+		"kubectl uncordon 10.0.0.1",
+	}
+	if !reflect.DeepEqual(s.commands, want) {
+		t.Errorf("RollbackNode() commands = %v, want %v", s.commands, want)
+	}
+}
+
+func containsString(list []string, s string) bool {
+	for _, one := range list {
+		if one == s {
+			return true
+		}
+	}
+	return false
+}
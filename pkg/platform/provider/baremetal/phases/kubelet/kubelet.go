@@ -20,9 +20,17 @@ package kubelet
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"path"
+	"reflect"
 
+	"github.com/pkg/errors"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	"tkestack.io/tke/pkg/util/ssh"
@@ -33,8 +41,9 @@ import (
 type ServiceOperation string
 
 var (
-	Start ServiceOperation = "start"
-	Stop  ServiceOperation = "stop"
+	Start   ServiceOperation = "start"
+	Stop    ServiceOperation = "stop"
+	Restart ServiceOperation = "restart"
 )
 
 func Install(s ssh.Interface, version string) error {
@@ -106,3 +115,95 @@ func ServiceOperate(s ssh.Interface, op ServiceOperation) (err error) {
 	}
 	return nil
 }
+
+// ConfigMapKey is the key of kubelet configuration in the config map uploaded
+// by kubeadm.
+const ConfigMapKey = "kubelet"
+
+// ConfigMapName returns the name of the config map in kube-system namespace,
+// which holds the kubelet configuration of cluster uploaded by kubeadm.
+func ConfigMapName(kubernetesVersion string) (string, error) {
+	v, err := utilversion.ParseGeneric(kubernetesVersion)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse kubernetes version %s error", kubernetesVersion)
+	}
+	return fmt.Sprintf("kubelet-config-%d.%d", v.Major(), v.Minor()), nil
+}
+
+// ApplyConfiguration writes the kubelet configuration of cluster with the
+// overrides merged into the configuration file on node, so the node reverts to
+// the configuration of cluster once the overrides are removed. The kubelet is
+// restarted only if the configuration is changed.
+func ApplyConfiguration(s ssh.Interface, clusterConfig []byte, overrides *platformv1.KubeletConfigurationOverrides) error {
+	config := make(map[string]interface{})
+	err := yaml.Unmarshal(clusterConfig, &config)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal kubelet configuration of cluster error")
+	}
+	merged := MergeConfiguration(config, overrides)
+
+	data, err := s.ReadFile(constants.KubeletConfigFile)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]interface{})
+	err = yaml.Unmarshal(data, &current)
+	if err != nil {
+		return errors.Wrapf(err, "unmarshal %s error", constants.KubeletConfigFile)
+	}
+	if reflect.DeepEqual(current, merged) {
+		return nil
+	}
+
+	data, err = yaml.Marshal(merged)
+	if err != nil {
+		return err
+	}
+	err = s.WriteFile(bytes.NewReader(data), constants.KubeletConfigFile)
+	if err != nil {
+		return err
+	}
+
+	return ServiceOperate(s, Restart)
+}
+
+// ConfigurationOverridesHash returns the hash of the kubelet configuration
+// overrides, or empty if there is nothing to override.
+func ConfigurationOverridesHash(overrides *platformv1.KubeletConfigurationOverrides) string {
+	if overrides == nil || reflect.DeepEqual(*overrides, platformv1.KubeletConfigurationOverrides{}) {
+		return ""
+	}
+	data, _ := json.Marshal(overrides)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:8])
+}
+
+// MergeConfiguration returns a copy of kubelet configuration with the
+// overrides merged.
+func MergeConfiguration(config map[string]interface{}, overrides *platformv1.KubeletConfigurationOverrides) map[string]interface{} {
+	merged := make(map[string]interface{}, len(config))
+	for k, v := range config {
+		merged[k] = v
+	}
+	if overrides == nil {
+		return merged
+	}
+	if overrides.MaxPods != nil {
+		merged["maxPods"] = float64(*overrides.MaxPods)
+	}
+	if len(overrides.EvictionHard) > 0 {
+		evictionHard := make(map[string]interface{}, len(overrides.EvictionHard))
+		for k, v := range overrides.EvictionHard {
+			evictionHard[k] = v
+		}
+		merged["evictionHard"] = evictionHard
+	}
+	if overrides.ReservedCPUs != "" {
+		merged["reservedSystemCPUs"] = overrides.ReservedCPUs
+	}
+	if overrides.TopologyManagerPolicy != "" {
+		merged["topologyManagerPolicy"] = overrides.TopologyManagerPolicy
+	}
+
+	return merged
+}
@@ -0,0 +1,217 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package kubelet
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+)
+
+// fakeSSH keeps the files written in memory and records the commands.
+type fakeSSH struct {
+	files    map[string][]byte
+	commands []string
+}
+
+func (f *fakeSSH) Ping() error                          { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error       { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error) { return file, nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+const clusterConfig = `apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+maxPods: 110
+evictionHard:
+  memory.available: 100Mi
+`
+
+func TestConfigMapName(t *testing.T) {
+	tests := []struct {
+		version string
+		want    string
+		wantErr bool
+	}{
+		{version: "1.20.4", want: "kubelet-config-1.20"},
+		{version: "1.20.4-tke.1", want: "kubelet-config-1.20"},
+		{version: "v1.18.3", want: "kubelet-config-1.18"},
+		{version: "latest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			got, err := ConfigMapName(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConfigMapName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ConfigMapName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeConfiguration(t *testing.T) {
+	config := map[string]interface{}{
+		"maxPods":      float64(110),
+		"evictionHard": map[string]interface{}{"memory.available": "100Mi"},
+	}
+	maxPods := int32(64)
+	got := MergeConfiguration(config, &platformv1.KubeletConfigurationOverrides{
+		MaxPods:               &maxPods,
+		EvictionHard:          map[string]string{"nodefs.available": "10%"},
+		ReservedCPUs:          "0-1",
+		TopologyManagerPolicy: "single-numa-node",
+	})
+	want := map[string]interface{}{
+		"maxPods":               float64(64),
+		"evictionHard":          map[string]interface{}{"nodefs.available": "10%"},
+		"reservedSystemCPUs":    "0-1",
+		"topologyManagerPolicy": "single-numa-node",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeConfiguration() = %v, want %v", got, want)
+	}
+	if config["maxPods"] != float64(110) {
+		t.Errorf("MergeConfiguration() changed the configuration of cluster")
+	}
+	if got := MergeConfiguration(config, nil); !reflect.DeepEqual(got, config) {
+		t.Errorf("MergeConfiguration() without overrides = %v, want %v", got, config)
+	}
+}
+
+func TestApplyConfiguration(t *testing.T) {
+	s := &fakeSSH{files: map[string][]byte{constants.KubeletConfigFile: []byte(clusterConfig)}}
+	maxPods := int32(64)
+	overrides := &platformv1.KubeletConfigurationOverrides{MaxPods: &maxPods}
+
+	if err := ApplyConfiguration(s, []byte(clusterConfig), overrides); err != nil {
+		t.Fatalf("ApplyConfiguration() error = %v", err)
+	}
+	config := make(map[string]interface{})
+	if err := yaml.Unmarshal(s.files[constants.KubeletConfigFile], &config); err != nil {
+		t.Fatal(err)
+	}
+	if config["maxPods"] != float64(64) {
+		t.Errorf("maxPods = %v, want 64", config["maxPods"])
+	}
+	if len(s.commands) != 1 {
+		t.Errorf("commands = %v, want kubelet restarted", s.commands)
+	}
+
+	s.commands = nil
+	if err := ApplyConfiguration(s, []byte(clusterConfig), overrides); err != nil {
+		t.Fatalf("ApplyConfiguration() again error = %v", err)
+	}
+	if len(s.commands) != 0 {
+		t.Errorf("commands = %v, want kubelet not restarted without changes", s.commands)
+	}
+
+	// the node reverts to the configuration of cluster without overrides
+	if err := ApplyConfiguration(s, []byte(clusterConfig), nil); err != nil {
+		t.Fatalf("ApplyConfiguration() without overrides error = %v", err)
+	}
+	config = make(map[string]interface{})
+	if err := yaml.Unmarshal(s.files[constants.KubeletConfigFile], &config); err != nil {
+		t.Fatal(err)
+	}
+	if config["maxPods"] != float64(110) {
+		t.Errorf("maxPods = %v, want 110", config["maxPods"])
+	}
+	if len(s.commands) != 1 {
+		t.Errorf("commands = %v, want kubelet restarted", s.commands)
+	}
+}
+
+func TestConfigurationOverridesHash(t *testing.T) {
+	maxPods := int32(64)
+	otherMaxPods := int32(32)
+	overrides := &platformv1.KubeletConfigurationOverrides{
+		MaxPods:      &maxPods,
+		EvictionHard: map[string]string{"memory.available": "300Mi", "nodefs.available": "10%"},
+	}
+
+	if got := ConfigurationOverridesHash(nil); got != "" {
+		t.Errorf("ConfigurationOverridesHash(nil) = %q, want empty", got)
+	}
+	if got := ConfigurationOverridesHash(&platformv1.KubeletConfigurationOverrides{}); got != "" {
+		t.Errorf("ConfigurationOverridesHash() of empty overrides = %q, want empty", got)
+	}
+	hash := ConfigurationOverridesHash(overrides)
+	if hash == "" {
+		t.Fatalf("ConfigurationOverridesHash() = empty, want hash")
+	}
+	if got := ConfigurationOverridesHash(overrides.DeepCopy()); got != hash {
+		t.Errorf("ConfigurationOverridesHash() of the same overrides = %q, want %q", got, hash)
+	}
+	changed := overrides.DeepCopy()
+	changed.MaxPods = &otherMaxPods
+	if got := ConfigurationOverridesHash(changed); got == hash {
+		t.Errorf("ConfigurationOverridesHash() of changed maxPods = %q, want different from %q", got, hash)
+	}
+	changed = overrides.DeepCopy()
+	changed.EvictionHard["memory.available"] = "500Mi"
+	if got := ConfigurationOverridesHash(changed); got == hash {
+		t.Errorf("ConfigurationOverridesHash() of changed evictionHard = %q, want different from %q", got, hash)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package networkpolicy installs the default-deny NetworkPolicies of cluster.
+package networkpolicy
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/apiclient"
+)
+
+// DefaultDenyName is the name of the default-deny NetworkPolicy.
+const DefaultDenyName = "default-deny"
+
+// DefaultDeny returns the NetworkPolicy which selects all pods in the namespace
+// and allows no ingress traffic. The egress traffic except DNS queries is
+// denied too if denyEgress is true.
+func DefaultDeny(namespace string, denyEgress bool) *networkingv1.NetworkPolicy {
+	policy := &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DefaultDenyName,
+			Namespace: namespace,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}
+	if denyEgress {
+		udp, tcp := corev1.ProtocolUDP, corev1.ProtocolTCP
+		dns := intstr.FromInt(53)
+		policy.Spec.PolicyTypes = append(policy.Spec.PolicyTypes, networkingv1.PolicyTypeEgress)
+		policy.Spec.Egress = []networkingv1.NetworkPolicyEgressRule{{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: &udp, Port: &dns},
+				{Protocol: &tcp, Port: &dns},
+			},
+		}}
+	}
+	return policy
+}
+
+// Apply creates the namespaces of feature if not exist and installs the
+// default-deny NetworkPolicy in them.
+func Apply(ctx context.Context, client kubernetes.Interface, feature *platformv1.NetworkPolicyFeature) error {
+	if feature == nil {
+		return nil
+	}
+	for _, namespace := range feature.DefaultDenyNamespaces {
+		_, err := client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+		}, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return errors.Wrapf(err, "create namespace %s error", namespace)
+		}
+		err = apiclient.CreateOrUpdateNetworkPolicy(ctx, client, DefaultDeny(namespace, feature.DenyEgress))
+		if err != nil {
+			return errors.Wrapf(err, "namespace %s", namespace)
+		}
+	}
+	return nil
+}
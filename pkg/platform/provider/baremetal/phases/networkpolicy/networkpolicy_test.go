@@ -0,0 +1,48 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package networkpolicy
+
+import (
+	"testing"
+
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+func TestDefaultDeny(t *testing.T) {
+	policy := DefaultDeny("prod", false)
+	if policy.Namespace != "prod" || len(policy.Spec.PodSelector.MatchLabels) != 0 {
+		t.Fatalf("unexpected policy: %+v", policy)
+	}
+	if len(policy.Spec.PolicyTypes) != 1 || policy.Spec.PolicyTypes[0] != networkingv1.PolicyTypeIngress || len(policy.Spec.Ingress) != 0 {
+		t.Errorf("expected ingress denied only, got %+v", policy.Spec)
+	}
+
+	policy = DefaultDeny("prod", true)
+	if len(policy.Spec.PolicyTypes) != 2 || policy.Spec.PolicyTypes[1] != networkingv1.PolicyTypeEgress {
+		t.Fatalf("expected egress denied, got %+v", policy.Spec.PolicyTypes)
+	}
+	if len(policy.Spec.Egress) != 1 || len(policy.Spec.Egress[0].To) != 0 || len(policy.Spec.Egress[0].Ports) != 2 {
+		t.Fatalf("expected DNS egress allowed, got %+v", policy.Spec.Egress)
+	}
+	for _, port := range policy.Spec.Egress[0].Ports {
+		if port.Port.IntValue() != 53 {
+			t.Errorf("unexpected egress port: %v", port.Port)
+		}
+	}
+}
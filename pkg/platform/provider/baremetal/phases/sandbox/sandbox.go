@@ -0,0 +1,193 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sandbox
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
+	"tkestack.io/tke/pkg/util/apiclient"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// LabelSandboxRuntimeEnable marks the machines which sandboxed runtime will be installed on.
+	LabelSandboxRuntimeEnable = "sandbox-runtime-enable"
+
+	admissionNamespace       = metav1.NamespaceSystem
+	admissionName            = "tke-sandbox-admission"
+	admissionCertsSecretName = admissionName + "-certs"
+)
+
+// Runtime describes how a sandboxed runtime is installed and registered.
+type Runtime struct {
+	// Package is the offline package of the runtime.
+	Package *res.Package
+	// Handler is the runtime name registered to docker and referenced by RuntimeClass.
+	Handler string
+	// Path is the runtime binary path on node.
+	Path string
+	// RuntimeClassName is the name of RuntimeClass created in cluster.
+	RuntimeClassName string
+}
+
+var runtimes = map[platformv1.SandboxRuntimeType]Runtime{
+	platformv1.SandboxRuntimeKata: {
+		Package:          &res.KataContainers,
+		Handler:          "kata-runtime",
+		Path:             "/opt/kata/bin/kata-runtime",
+		RuntimeClassName: "kata",
+	},
+	platformv1.SandboxRuntimeGVisor: {
+		Package:          &res.GVisor,
+		Handler:          "runsc",
+		Path:             "/usr/bin/runsc",
+		RuntimeClassName: "gvisor",
+	},
+}
+
+// Get returns the Runtime of sandboxed runtime type.
+func Get(runtimeType platformv1.SandboxRuntimeType) (Runtime, error) {
+	r, ok := runtimes[runtimeType]
+	if !ok {
+		return Runtime{}, fmt.Errorf("unsupported sandbox runtime %q", runtimeType)
+	}
+	return r, nil
+}
+
+// Types returns all supported sandboxed runtime types.
+func Types() []string {
+	var types []string
+	for t := range runtimes {
+		types = append(types, string(t))
+	}
+	sort.Strings(types)
+	return types
+}
+
+func IsEnable(labels map[string]string) bool {
+	return labels[LabelSandboxRuntimeEnable] == "enable"
+}
+
+// Install installs the sandboxed runtime binary on node. The runtime will be
+// registered to docker by docker phase.
+func Install(s ssh.Interface, runtimeType platformv1.SandboxRuntimeType) error {
+	r, err := Get(runtimeType)
+	if err != nil {
+		return err
+	}
+
+	err = r.Package.InstallWithDefault(s)
+	if err != nil {
+		return err
+	}
+
+	cmd := fmt.Sprintf("chmod +x %s && %s --version", r.Path, r.Path)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return nil
+}
+
+// InstallRuntimeClass creates the RuntimeClass of sandboxed runtime, pods
+// using it are only scheduled to the machines with sandboxed runtime.
+func InstallRuntimeClass(ctx context.Context, client clientset.Interface, runtimeType platformv1.SandboxRuntimeType) error {
+	r, err := Get(runtimeType)
+	if err != nil {
+		return err
+	}
+
+	runtimeClass := &nodev1beta1.RuntimeClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: r.RuntimeClassName,
+		},
+		Handler: r.Handler,
+		Scheduling: &nodev1beta1.Scheduling{
+			NodeSelector: map[string]string{
+				LabelSandboxRuntimeEnable: "enable",
+			},
+		},
+	}
+
+	return apiclient.CreateOrUpdateRuntimeClass(ctx, client, runtimeClass)
+}
+
+// MarkUntrustedNamespaces labels namespaces as untrusted so pods created in
+// them default to the sandboxed RuntimeClass. Missing namespaces are created.
+func MarkUntrustedNamespaces(ctx context.Context, client clientset.Interface, runtimeType platformv1.SandboxRuntimeType, namespaces []string) error {
+	r, err := Get(runtimeType)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range namespaces {
+		ns, err := client.CoreV1().Namespaces().Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return errors.Wrapf(err, "get namespace %s error", name)
+			}
+			ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		}
+		if ns.Labels == nil {
+			ns.Labels = make(map[string]string)
+		}
+		if ns.Annotations == nil {
+			ns.Annotations = make(map[string]string)
+		}
+		ns.Labels[platformv1.LabelUntrustedNamespace] = "true"
+		ns.Annotations[platformv1.AnnotationSandboxRuntimeClass] = r.RuntimeClassName
+
+		err = apiclient.CreateOrUpdateNamespace(ctx, client, ns)
+		if err != nil {
+			return errors.Wrapf(err, "mark namespace %s untrusted error", name)
+		}
+	}
+
+	return nil
+}
+
+// InstallAdmission deploys the admission webhook which defaults the
+// RuntimeClass of pods in untrusted namespaces, so that the pods created in
+// the cluster directly are sandboxed too.
+func InstallAdmission(ctx context.Context, client clientset.Interface) error {
+	caCert, err := apiclient.EnsureWebhookServingCert(ctx, client, admissionNamespace, admissionName, admissionCertsSecretName)
+	if err != nil {
+		return errors.Wrap(err, "create serving certificate error")
+	}
+	option := map[string]interface{}{
+		"Image":          images.Get().SandboxAdmission.FullName(),
+		"CABundle":       base64.StdEncoding.EncodeToString(caCert),
+		"UntrustedLabel": platformv1.LabelUntrustedNamespace,
+	}
+	return apiclient.CreateResourceWithFile(ctx, client, constants.SandboxAdmissionManifest, option)
+}
@@ -0,0 +1,255 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package secretsencryption
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/config/v1"
+	clientset "k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// Step is the step of enabling encryption or rotating keys.
+type Step string
+
+const (
+	// StepNone means the config is up to date.
+	StepNone Step = ""
+	// StepEnable adds the encryption provider before the identity provider
+	// which reads the secrets not encrypted yet.
+	StepEnable Step = "Enable"
+	// StepAddKey adds the new key as a secondary key, so that all apiservers
+	// can decrypt the secrets encrypted by it before it is used.
+	StepAddKey Step = "AddKey"
+	// StepPromoteKey makes the new key the primary key to encrypt secrets.
+	StepPromoteKey Step = "PromoteKey"
+	// StepRetireKey removes all keys but the primary key, the secrets must be
+	// migrated to the primary key before.
+	StepRetireKey Step = "RetireKey"
+)
+
+const (
+	keyNamePrefix     = "key-"
+	keyNameTimeFormat = "20060102150405"
+	keySize           = 32
+)
+
+// entry is a secretbox key, a KMS plugin or the identity provider.
+type entry struct {
+	key      *apiserverconfigv1.Key
+	kms      *apiserverconfigv1.KMSConfiguration
+	identity bool
+}
+
+// NextConfig returns the config of the next step from the current config of
+// masters. One step should be applied to all masters before the next one.
+func NextConfig(current []byte, encryption *platformv1.SecretsEncryption, now time.Time) ([]byte, Step, error) {
+	if len(current) == 0 {
+		one, err := newEntry(encryption, now)
+		if err != nil {
+			return nil, StepNone, err
+		}
+		data, err := marshal([]entry{one, {identity: true}})
+		return data, StepEnable, err
+	}
+
+	entries, err := parse(current)
+	if err != nil {
+		return nil, StepNone, err
+	}
+	if len(entries) == 0 {
+		return nil, StepNone, fmt.Errorf("no provider in encryption config")
+	}
+
+	step := StepNone
+	switch {
+	case len(entries) > 1 && isDesired(entries, 0, encryption):
+		entries = entries[:1]
+		step = StepRetireKey
+	case len(entries) > 1 && isDesired(entries, 1, encryption):
+		entries[0], entries[1] = entries[1], entries[0]
+		step = StepPromoteKey
+	case !isDesired(entries, 0, encryption) || needRotate(entries[0], encryption, now):
+		one, err := newEntry(encryption, now)
+		if err != nil {
+			return nil, StepNone, err
+		}
+		entries = append([]entry{entries[0], one}, entries[1:]...)
+		step = StepAddKey
+	default:
+		if entries[0].kms != nil {
+			entries[0].kms.CacheSize = encryption.KMS.CacheSize
+		}
+	}
+
+	data, err := marshal(entries)
+	return data, step, err
+}
+
+// MigrateSecrets rewrites all secrets, so that they are encrypted by the primary key.
+func MigrateSecrets(ctx context.Context, client clientset.Interface) error {
+	secrets, err := client.CoreV1().Secrets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		_, err = client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		// the secret has been rewritten by others
+		if err != nil && !apierrors.IsConflict(err) && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("migrate secret %s/%s error: %w", secret.Namespace, secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// isDesired reports whether the entry at index i is the one the encryption wants,
+// the secretbox key must be the newest one.
+func isDesired(entries []entry, i int, encryption *platformv1.SecretsEncryption) bool {
+	one := entries[i]
+	switch encryption.Provider {
+	case platformv1.SecretsEncryptionSecretbox:
+		if one.key == nil {
+			return false
+		}
+		for _, other := range entries {
+			if other.key != nil && other.key.Name > one.key.Name {
+				return false
+			}
+		}
+		return true
+	case platformv1.SecretsEncryptionKMS:
+		return one.kms != nil && encryption.KMS != nil &&
+			one.kms.Name == encryption.KMS.Name && one.kms.Endpoint == encryption.KMS.Endpoint
+	}
+	return false
+}
+
+func needRotate(one entry, encryption *platformv1.SecretsEncryption, now time.Time) bool {
+	if one.key == nil || encryption.KeyRotationPeriod == "" {
+		return false
+	}
+	period, err := time.ParseDuration(encryption.KeyRotationPeriod)
+	if err != nil {
+		return false
+	}
+	// keys not generated by platform are never rotated
+	createdAt, err := time.Parse(keyNameTimeFormat, strings.TrimPrefix(one.key.Name, keyNamePrefix))
+	if err != nil {
+		return false
+	}
+	return now.Sub(createdAt) >= period
+}
+
+func newEntry(encryption *platformv1.SecretsEncryption, now time.Time) (entry, error) {
+	switch encryption.Provider {
+	case platformv1.SecretsEncryptionSecretbox:
+		secret := make([]byte, keySize)
+		_, err := rand.Read(secret)
+		if err != nil {
+			return entry{}, err
+		}
+		return entry{key: &apiserverconfigv1.Key{
+			Name:   keyNamePrefix + now.UTC().Format(keyNameTimeFormat),
+			Secret: base64.StdEncoding.EncodeToString(secret),
+		}}, nil
+	case platformv1.SecretsEncryptionKMS:
+		if encryption.KMS == nil {
+			return entry{}, fmt.Errorf("kms is required for provider %s", encryption.Provider)
+		}
+		return entry{kms: &apiserverconfigv1.KMSConfiguration{
+			Name:      encryption.KMS.Name,
+			Endpoint:  encryption.KMS.Endpoint,
+			CacheSize: encryption.KMS.CacheSize,
+		}}, nil
+	}
+	return entry{}, fmt.Errorf("unsupported secrets encryption provider %s", encryption.Provider)
+}
+
+func parse(data []byte) ([]entry, error) {
+	config := &apiserverconfigv1.EncryptionConfiguration{}
+	err := yaml.Unmarshal(data, config)
+	if err != nil {
+		return nil, err
+	}
+	var entries []entry
+	for _, resource := range config.Resources {
+		for _, provider := range resource.Providers {
+			switch {
+			case provider.Secretbox != nil:
+				for i := range provider.Secretbox.Keys {
+					entries = append(entries, entry{key: &provider.Secretbox.Keys[i]})
+				}
+			case provider.KMS != nil:
+				entries = append(entries, entry{kms: provider.KMS})
+			case provider.Identity != nil:
+				entries = append(entries, entry{identity: true})
+			}
+		}
+	}
+
+	return entries, nil
+}
+
+// marshal groups the adjacent secretbox keys into one provider.
+func marshal(entries []entry) ([]byte, error) {
+	var providers []apiserverconfigv1.ProviderConfiguration
+	for _, one := range entries {
+		switch {
+		case one.key != nil:
+			n := len(providers)
+			if n > 0 && providers[n-1].Secretbox != nil {
+				providers[n-1].Secretbox.Keys = append(providers[n-1].Secretbox.Keys, *one.key)
+				continue
+			}
+			providers = append(providers, apiserverconfigv1.ProviderConfiguration{
+				Secretbox: &apiserverconfigv1.SecretboxConfiguration{Keys: []apiserverconfigv1.Key{*one.key}},
+			})
+		case one.kms != nil:
+			providers = append(providers, apiserverconfigv1.ProviderConfiguration{KMS: one.kms})
+		case one.identity:
+			providers = append(providers, apiserverconfigv1.ProviderConfiguration{
+				Identity: &apiserverconfigv1.IdentityConfiguration{},
+			})
+		}
+	}
+
+	return yaml.Marshal(&apiserverconfigv1.EncryptionConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiserverconfigv1.SchemeGroupVersion.String(),
+			Kind:       "EncryptionConfiguration",
+		},
+		Resources: []apiserverconfigv1.ResourceConfiguration{
+			{
+				Resources: []string{"secrets"},
+				Providers: providers,
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package secretsencryption
+
+import (
+	"testing"
+	"time"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestNextConfig(t *testing.T) {
+	encryption := &platformv1.SecretsEncryption{
+		Provider:          platformv1.SecretsEncryptionSecretbox,
+		KeyRotationPeriod: "24h",
+	}
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var config []byte
+	steps := []struct {
+		now       time.Time
+		want      Step
+		wantNames []string
+	}{
+		{now, StepEnable, []string{"key-20210101000000", "identity"}},
+		{now, StepRetireKey, []string{"key-20210101000000"}},
+		{now.Add(time.Hour), StepNone, []string{"key-20210101000000"}},
+		{now.Add(25 * time.Hour), StepAddKey, []string{"key-20210101000000", "key-20210102010000"}},
+		{now.Add(25 * time.Hour), StepPromoteKey, []string{"key-20210102010000", "key-20210101000000"}},
+		{now.Add(25 * time.Hour), StepRetireKey, []string{"key-20210102010000"}},
+		{now.Add(26 * time.Hour), StepNone, []string{"key-20210102010000"}},
+	}
+	for i, step := range steps {
+		next, got, err := NextConfig(config, encryption, step.now)
+		if err != nil {
+			t.Fatalf("step %d: NextConfig() error = %v", i, err)
+		}
+		if got != step.want {
+			t.Fatalf("step %d: NextConfig() step = %q, want %q", i, got, step.want)
+		}
+		entries, err := parse(next)
+		if err != nil {
+			t.Fatalf("step %d: parse() error = %v", i, err)
+		}
+		var names []string
+		for _, one := range entries {
+			if one.identity {
+				names = append(names, "identity")
+			} else {
+				names = append(names, one.key.Name)
+			}
+		}
+		if len(names) != len(step.wantNames) {
+			t.Fatalf("step %d: keys = %v, want %v", i, names, step.wantNames)
+		}
+		for j := range names {
+			if names[j] != step.wantNames[j] {
+				t.Fatalf("step %d: keys = %v, want %v", i, names, step.wantNames)
+			}
+		}
+		config = next
+	}
+}
@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package staticpod
+
+import (
+	"encoding/json"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// AppliedOverrideAnnotation records the override last applied to the manifest,
+// so that the items removed from the override can be removed from the manifest.
+const AppliedOverrideAnnotation = "platform.tkestack.io/applied-override"
+
+// ApplyOverride injects the override into the static pod manifest, and reports
+// whether the manifest is changed. A nil override removes the items injected before.
+func ApplyOverride(manifest []byte, override *platformv1.StaticPodOverride) ([]byte, bool, error) {
+	pod := &corev1.Pod{}
+	err := yaml.Unmarshal(manifest, pod)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return nil, false, errors.New("no container in static pod manifest")
+	}
+	origin := pod.DeepCopy()
+
+	if data, ok := pod.Annotations[AppliedOverrideAnnotation]; ok {
+		applied := &platformv1.StaticPodOverride{}
+		err = json.Unmarshal([]byte(data), applied)
+		if err != nil {
+			return nil, false, err
+		}
+		remove(pod, applied)
+		delete(pod.Annotations, AppliedOverrideAnnotation)
+	}
+	if override != nil {
+		data, err := json.Marshal(override)
+		if err != nil {
+			return nil, false, err
+		}
+		add(pod, override)
+		if pod.Annotations == nil {
+			pod.Annotations = map[string]string{}
+		}
+		pod.Annotations[AppliedOverrideAnnotation] = string(data)
+	}
+
+	if apiequality.Semantic.DeepEqual(origin, pod) {
+		return manifest, false, nil
+	}
+	data, err := yaml.Marshal(pod)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+func remove(pod *corev1.Pod, override *platformv1.StaticPodOverride) {
+	container := &pod.Spec.Containers[0]
+
+	volumes := map[string]bool{}
+	for _, one := range override.ExtraVolumes {
+		volumes[one.Name] = true
+	}
+	var podVolumes []corev1.Volume
+	for _, one := range pod.Spec.Volumes {
+		if !volumes[one.Name] {
+			podVolumes = append(podVolumes, one)
+		}
+	}
+	pod.Spec.Volumes = podVolumes
+
+	mounts := map[string]bool{}
+	for _, one := range override.ExtraVolumeMounts {
+		mounts[one.MountPath] = true
+	}
+	var volumeMounts []corev1.VolumeMount
+	for _, one := range container.VolumeMounts {
+		if !mounts[one.MountPath] {
+			volumeMounts = append(volumeMounts, one)
+		}
+	}
+	container.VolumeMounts = volumeMounts
+
+	envs := map[string]bool{}
+	for _, one := range override.ExtraEnv {
+		envs[one.Name] = true
+	}
+	var env []corev1.EnvVar
+	for _, one := range container.Env {
+		if !envs[one.Name] {
+			env = append(env, one)
+		}
+	}
+	container.Env = env
+
+	sidecars := map[string]bool{}
+	for _, one := range override.Sidecars {
+		sidecars[one.Name] = true
+	}
+	containers := pod.Spec.Containers[:1]
+	for _, one := range pod.Spec.Containers[1:] {
+		if !sidecars[one.Name] {
+			containers = append(containers, one)
+		}
+	}
+	pod.Spec.Containers = containers
+}
+
+func add(pod *corev1.Pod, override *platformv1.StaticPodOverride) {
+	// remove the items generated with the same name before, the override wins
+	remove(pod, override)
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, override.ExtraVolumes...)
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, override.ExtraVolumeMounts...)
+	container.Env = append(container.Env, override.ExtraEnv...)
+	pod.Spec.Containers = append(pod.Spec.Containers, override.Sidecars...)
+}
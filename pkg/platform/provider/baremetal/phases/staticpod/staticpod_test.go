@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package staticpod
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/yaml"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+const manifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-apiserver
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-apiserver
+    image: kube-apiserver:v1.19.7
+    volumeMounts:
+    - name: k8s-certs
+      mountPath: /etc/kubernetes/pki
+  volumes:
+  - name: k8s-certs
+    hostPath:
+      path: /etc/kubernetes/pki
+`
+
+func TestApplyOverride(t *testing.T) {
+	override := &platformv1.StaticPodOverride{
+		ExtraVolumes:      []corev1.Volume{{Name: "audit-log"}},
+		ExtraVolumeMounts: []corev1.VolumeMount{{Name: "audit-log", MountPath: "/var/log/kubernetes/audit"}},
+		ExtraEnv:          []corev1.EnvVar{{Name: "GOMAXPROCS", Value: "4"}},
+		Sidecars:          []corev1.Container{{Name: "fluent-bit", Image: "fluent-bit:1.7"}},
+	}
+
+	applied, changed, err := ApplyOverride([]byte(manifest), override)
+	if err != nil || !changed {
+		t.Fatalf("ApplyOverride() changed = %v, error = %v", changed, err)
+	}
+	pod := &corev1.Pod{}
+	if err := yaml.Unmarshal(applied, pod); err != nil {
+		t.Fatal(err)
+	}
+	if len(pod.Spec.Volumes) != 2 || len(pod.Spec.Containers) != 2 ||
+		len(pod.Spec.Containers[0].VolumeMounts) != 2 || len(pod.Spec.Containers[0].Env) != 1 {
+		t.Fatalf("ApplyOverride() got unexpected pod %s", applied)
+	}
+
+	_, changed, err = ApplyOverride(applied, override)
+	if err != nil || changed {
+		t.Fatalf("ApplyOverride() again changed = %v, error = %v", changed, err)
+	}
+
+	removed, changed, err := ApplyOverride(applied, nil)
+	if err != nil || !changed {
+		t.Fatalf("ApplyOverride(nil) changed = %v, error = %v", changed, err)
+	}
+	want := &corev1.Pod{}
+	if err := yaml.Unmarshal([]byte(manifest), want); err != nil {
+		t.Fatal(err)
+	}
+	got := &corev1.Pod{}
+	if err := yaml.Unmarshal(removed, got); err != nil {
+		t.Fatal(err)
+	}
+	got.Annotations = nil
+	if !apiequality.Semantic.DeepEqual(want, got) {
+		t.Fatalf("ApplyOverride(nil) got %s, want %s", removed, manifest)
+	}
+}
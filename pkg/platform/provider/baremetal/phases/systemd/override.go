@@ -0,0 +1,165 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package systemd
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// KubeletDropInFile is the drop-in file of kubelet service rendered from overrides.
+	KubeletDropInFile = "/etc/systemd/system/kubelet.service.d/99-tke-override.conf"
+	// DockerDropInFile is the drop-in file of docker service rendered from overrides.
+	DockerDropInFile = "/etc/systemd/system/docker.service.d/99-tke-override.conf"
+)
+
+// MergeOverrides returns the overrides of machine merged over the overrides
+// of cluster, the env of machine takes precedence and the drop-ins are joined.
+func MergeOverrides(cluster, machine *platformv1.ServiceOverrides) *platformv1.ServiceOverrides {
+	if machine == nil {
+		return cluster
+	}
+	if cluster == nil {
+		return machine
+	}
+
+	return &platformv1.ServiceOverrides{
+		Kubelet:          mergeOverride(cluster.Kubelet, machine.Kubelet),
+		ContainerRuntime: mergeOverride(cluster.ContainerRuntime, machine.ContainerRuntime),
+	}
+}
+
+func mergeOverride(base, override *platformv1.ServiceOverride) *platformv1.ServiceOverride {
+	if override == nil {
+		return base
+	}
+	if base == nil {
+		return override
+	}
+
+	merged := &platformv1.ServiceOverride{Env: make(map[string]string)}
+	for k, v := range base.Env {
+		merged.Env[k] = v
+	}
+	for k, v := range override.Env {
+		merged.Env[k] = v
+	}
+	merged.DropIn = strings.TrimSpace(strings.Join([]string{base.DropIn, override.DropIn}, "\n"))
+
+	return merged
+}
+
+// Apply renders the overrides of kubelet and container runtime as drop-in
+// files, and restarts the services whose drop-in is changed. The drop-in is
+// removed if the service has no override. It returns whether any service is
+// restarted.
+func Apply(s ssh.Interface, overrides *platformv1.ServiceOverrides) (bool, error) {
+	if overrides == nil {
+		overrides = &platformv1.ServiceOverrides{}
+	}
+
+	kubeletChanged, err := ApplyDropIn(s, "kubelet", KubeletDropInFile, overrides.Kubelet)
+	if err != nil {
+		return false, err
+	}
+	dockerChanged, err := ApplyDropIn(s, "docker", DockerDropInFile, overrides.ContainerRuntime)
+	if err != nil {
+		return false, err
+	}
+
+	return kubeletChanged || dockerChanged, nil
+}
+
+// ApplyDropIn writes the override to the drop-in file of service, then
+// reloads systemd and restarts the service if the file is changed.
+func ApplyDropIn(s ssh.Interface, service string, filename string, override *platformv1.ServiceOverride) (bool, error) {
+	ok, err := s.Exist(filename)
+	if err != nil {
+		return false, err
+	}
+
+	data := renderDropIn(override)
+	if data == nil {
+		if !ok {
+			return false, nil
+		}
+		cmd := fmt.Sprintf("rm -f %s", filename)
+		_, stderr, exit, err := s.Exec(cmd)
+		if err != nil || exit != 0 {
+			return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+		}
+	} else {
+		if ok {
+			current, err := s.ReadFile(filename)
+			if err != nil {
+				return false, errors.Wrapf(err, "read %s error", filename)
+			}
+			if bytes.Equal(current, data) {
+				return false, nil
+			}
+		}
+		err = s.WriteFile(bytes.NewReader(data), filename)
+		if err != nil {
+			return false, errors.Wrapf(err, "write %s error", filename)
+		}
+	}
+
+	// the service may be not installed yet, which gets the drop-in on start
+	cmd := fmt.Sprintf("systemctl daemon-reload && (! systemctl is-active -q %[1]s || systemctl restart %[1]s)", service)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return true, nil
+}
+
+func renderDropIn(override *platformv1.ServiceOverride) []byte {
+	if override == nil || (len(override.Env) == 0 && strings.TrimSpace(override.DropIn) == "") {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("# Generated by TKE, do not edit.\n")
+	// the directives of DropIn without section header belong to [Service]
+	buf.WriteString("[Service]\n")
+	keys := make([]string, 0, len(override.Env))
+	for k := range override.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		// % starts a specifier in unit files
+		value := strings.ReplaceAll(override.Env[k], "%", "%%")
+		buf.WriteString(fmt.Sprintf("Environment=%q\n", k+"="+value))
+	}
+	if dropIn := strings.TrimSpace(override.DropIn); dropIn != "" {
+		buf.WriteString(dropIn)
+		buf.WriteString("\n")
+	}
+
+	return buf.Bytes()
+}
@@ -0,0 +1,197 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package systemd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// fakeSSH keeps the files written in memory and records the commands executed.
+type fakeSSH struct {
+	files map[string][]byte
+	cmds  []string
+	exit  int
+}
+
+func (f *fakeSSH) Ping() error                               { return nil }
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) { return nil, nil }
+func (f *fakeSSH) CopyFile(src, dst string) error            { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error)      { return file, nil }
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.cmds = append(f.cmds, cmd)
+	if strings.HasPrefix(cmd, "rm -f ") {
+		delete(f.files, strings.TrimPrefix(cmd, "rm -f "))
+	}
+	return "", "", f.exit, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+func TestMergeOverrides(t *testing.T) {
+	cluster := &platformv1.ServiceOverrides{
+		Kubelet: &platformv1.ServiceOverride{
+			Env:    map[string]string{"HTTP_PROXY": "http://proxy:3128", "NO_PROXY": "10.0.0.0/8"},
+			DropIn: "LimitNOFILE=65536",
+		},
+		ContainerRuntime: &platformv1.ServiceOverride{Env: map[string]string{"HTTP_PROXY": "http://proxy:3128"}},
+	}
+	machine := &platformv1.ServiceOverrides{
+		Kubelet: &platformv1.ServiceOverride{
+			Env:    map[string]string{"HTTP_PROXY": "http://local:3128"},
+			DropIn: "CPUAccounting=true",
+		},
+	}
+
+	if got := MergeOverrides(cluster, nil); got != cluster {
+		t.Errorf("MergeOverrides() without machine overrides = %+v, want cluster overrides", got)
+	}
+	if got := MergeOverrides(nil, machine); got != machine {
+		t.Errorf("MergeOverrides() without cluster overrides = %+v, want machine overrides", got)
+	}
+
+	got := MergeOverrides(cluster, machine)
+	want := &platformv1.ServiceOverrides{
+		Kubelet: &platformv1.ServiceOverride{
+			Env:    map[string]string{"HTTP_PROXY": "http://local:3128", "NO_PROXY": "10.0.0.0/8"},
+			DropIn: "LimitNOFILE=65536\nCPUAccounting=true",
+		},
+		ContainerRuntime: cluster.ContainerRuntime,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeOverrides() = %+v, want %+v", got, want)
+	}
+	if cluster.Kubelet.Env["HTTP_PROXY"] != "http://proxy:3128" {
+		t.Error("MergeOverrides() should not modify the cluster overrides")
+	}
+}
+
+func TestRenderDropIn(t *testing.T) {
+	if data := renderDropIn(nil); data != nil {
+		t.Errorf("renderDropIn(nil) = %q, want nil", data)
+	}
+	if data := renderDropIn(&platformv1.ServiceOverride{DropIn: " \n"}); data != nil {
+		t.Errorf("renderDropIn() with blank drop-in = %q, want nil", data)
+	}
+
+	data := renderDropIn(&platformv1.ServiceOverride{
+		Env:    map[string]string{"NO_PROXY": "10.0.0.0/8", "HTTP_PROXY": "http://proxy:3128/%u"},
+		DropIn: "\nLimitNOFILE=65536\n[Unit]\nAfter=network-online.target\n",
+	})
+	want := `# Generated by TKE, do not edit.
+[Service]
+Environment="HTTP_PROXY=http://proxy:3128/%%u"
+Environment="NO_PROXY=10.0.0.0/8"
+LimitNOFILE=65536
+[Unit]
+After=network-online.target
+`
+	if string(data) != want {
+		t.Errorf("renderDropIn() = %q, want %q", data, want)
+	}
+}
+
+func TestApply(t *testing.T) {
+	s := &fakeSSH{files: map[string][]byte{}}
+	overrides := &platformv1.ServiceOverrides{
+		Kubelet: &platformv1.ServiceOverride{Env: map[string]string{"HTTP_PROXY": "http://proxy:3128"}},
+	}
+
+	changed, err := Apply(s, overrides)
+	if err != nil || !changed {
+		t.Fatalf("Apply() = %v, %v, want changed", changed, err)
+	}
+	if _, ok := s.files[KubeletDropInFile]; !ok {
+		t.Errorf("expected %s written", KubeletDropInFile)
+	}
+	if _, ok := s.files[DockerDropInFile]; ok {
+		t.Errorf("expected %s not written", DockerDropInFile)
+	}
+	want := []string{"systemctl daemon-reload && (! systemctl is-active -q kubelet || systemctl restart kubelet)"}
+	if !reflect.DeepEqual(s.cmds, want) {
+		t.Errorf("commands = %q, want %q", s.cmds, want)
+	}
+
+	s.cmds = nil
+	changed, err = Apply(s, overrides)
+	if err != nil || changed {
+		t.Errorf("Apply() again = %v, %v, want unchanged", changed, err)
+	}
+	if len(s.cmds) != 0 {
+		t.Errorf("expected no command executed, got %q", s.cmds)
+	}
+
+	changed, err = Apply(s, nil)
+	if err != nil || !changed {
+		t.Fatalf("Apply() without overrides = %v, %v, want changed", changed, err)
+	}
+	if _, ok := s.files[KubeletDropInFile]; ok {
+		t.Errorf("expected %s removed", KubeletDropInFile)
+	}
+	want = []string{
+		"rm -f " + KubeletDropInFile,
+		"systemctl daemon-reload && (! systemctl is-active -q kubelet || systemctl restart kubelet)",
+	}
+	if !reflect.DeepEqual(s.cmds, want) {
+		t.Errorf("commands = %q, want %q", s.cmds, want)
+	}
+}
+
+func TestApplyDropInFailed(t *testing.T) {
+	s := &fakeSSH{files: map[string][]byte{}, exit: 1}
+	override := &platformv1.ServiceOverride{Env: map[string]string{"HTTP_PROXY": "http://proxy:3128"}}
+	if changed, err := ApplyDropIn(s, "docker", DockerDropInFile, override); err == nil || changed {
+		t.Errorf("ApplyDropIn() = %v, %v, want error", changed, err)
+	}
+}
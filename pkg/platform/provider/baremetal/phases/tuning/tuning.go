@@ -0,0 +1,277 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tuning
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// SysctlFile is the kernel parameters rendered by provider.
+	SysctlFile = "/etc/sysctl.d/99-tke.conf"
+	// ModuleFile is the kernel modules loaded on boot.
+	ModuleFile = "/etc/modules-load.d/tke.conf"
+	// LimitsFile is the ulimits of all users.
+	LimitsFile = "/etc/security/limits.d/99-tke.conf"
+
+	thpTmpfilesFile = "/etc/tmpfiles.d/tke-thp.conf"
+	thpFile         = "/sys/kernel/mm/transparent_hugepage/enabled"
+
+	TransparentHugepageAlways  = "always"
+	TransparentHugepageMadvise = "madvise"
+	TransparentHugepageNever   = "never"
+)
+
+// defaultModules are required by kube-proxy and network plugins.
+var defaultModules = []string{"iptable_nat", "ip_vs", "ip_vs_rr", "ip_vs_wrr", "ip_vs_sh"}
+
+// DefaultIPVSScheduler is the IPVS scheduler used if not specified.
+const DefaultIPVSScheduler = "rr"
+
+// KubeProxyModules returns the kernel modules required by the proxy mode of
+// kube-proxy.
+func KubeProxyModules(cluster *platformv1.Cluster) []string {
+	switch cluster.KubeProxyMode() {
+	case platformv1.KubeProxyModeIPVS:
+		scheduler := DefaultIPVSScheduler
+		if kp := cluster.Spec.Features.KubeProxy; kp != nil && kp.IPVSScheduler != "" {
+			scheduler = kp.IPVSScheduler
+		}
+		return []string{"ip_vs", "ip_vs_" + scheduler, "nf_conntrack"}
+	case platformv1.KubeProxyModeNFTables:
+		return []string{"nf_tables"}
+	}
+	return nil
+}
+
+// ForCluster returns the system tuning of cluster with the kernel modules
+// required by kube-proxy.
+func ForCluster(cluster *platformv1.Cluster) *platformv1.SystemTuning {
+	tuning := cluster.SystemTuning().DeepCopy()
+	for _, m := range KubeProxyModules(cluster) {
+		if !contains(tuning.KernelModules, m) {
+			tuning.KernelModules = append(tuning.KernelModules, m)
+		}
+	}
+	return tuning
+}
+
+// Apply enforces the system tuning on node, only the files whose content is
+// different from the desired are rewritten. It returns whether any file is changed.
+func Apply(s ssh.Interface, tuning *platformv1.SystemTuning) (bool, error) {
+	if tuning == nil {
+		tuning = &platformv1.SystemTuning{}
+	}
+
+	modulesChanged, err := ApplyKernelModules(s, tuning.KernelModules)
+	if err != nil {
+		return false, err
+	}
+	sysctlsChanged, err := ApplySysctls(s, tuning.Sysctls)
+	if err != nil {
+		return false, err
+	}
+	ulimitsChanged, err := ApplyUlimits(s, tuning.Ulimits)
+	if err != nil {
+		return false, err
+	}
+	thpChanged, err := ApplyTransparentHugepage(s, tuning.TransparentHugepage)
+	if err != nil {
+		return false, err
+	}
+
+	return modulesChanged || sysctlsChanged || ulimitsChanged || thpChanged, nil
+}
+
+// ApplyKernelModules loads the default modules and the extra modules, and
+// makes them loaded on boot.
+func ApplyKernelModules(s ssh.Interface, extra []string) (bool, error) {
+	modules := append([]string{}, defaultModules...)
+	if _, err := s.CombinedOutput("modinfo br_netfilter"); err == nil {
+		modules = append(modules, "br_netfilter")
+	}
+	for _, m := range extra {
+		if !contains(modules, m) {
+			modules = append(modules, m)
+		}
+	}
+
+	var data bytes.Buffer
+	for _, m := range modules {
+		_, err := s.CombinedOutput(fmt.Sprintf("modprobe %s", m))
+		if err != nil {
+			return false, errors.Wrapf(err, "load kernel module %s error", m)
+		}
+		data.WriteString(m + "\n")
+	}
+
+	return writeIfChanged(s, ModuleFile, data.Bytes())
+}
+
+// ApplySysctls renders the default kernel parameters with the overrides, and
+// reloads them if changed.
+func ApplySysctls(s ssh.Interface, overrides map[string]string) (bool, error) {
+	data, err := renderSysctls(overrides)
+	if err != nil {
+		return false, err
+	}
+	changed, err := writeIfChanged(s, SysctlFile, data)
+	if err != nil || !changed {
+		return changed, err
+	}
+	cmd := "sysctl --system"
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return true, nil
+}
+
+// ApplyUlimits writes the ulimits of all users, which take effect on new sessions.
+func ApplyUlimits(s ssh.Interface, ulimits []platformv1.Ulimit) (bool, error) {
+	if len(ulimits) == 0 {
+		return removeIfExist(s, LimitsFile)
+	}
+
+	var data bytes.Buffer
+	for _, u := range ulimits {
+		data.WriteString(fmt.Sprintf("* soft %s %s\n", u.Name, u.Soft))
+		data.WriteString(fmt.Sprintf("* hard %s %s\n", u.Name, u.Hard))
+	}
+
+	return writeIfChanged(s, LimitsFile, data.Bytes())
+}
+
+// ApplyTransparentHugepage sets the transparent hugepage mode immediately and
+// on boot. The kernel default is kept if mode is empty.
+func ApplyTransparentHugepage(s ssh.Interface, mode string) (bool, error) {
+	if mode == "" {
+		return removeIfExist(s, thpTmpfilesFile)
+	}
+
+	data := fmt.Sprintf("w %s - - - - %s\n", thpFile, mode)
+	changed, err := writeIfChanged(s, thpTmpfilesFile, []byte(data))
+	if err != nil {
+		return false, err
+	}
+	// the active mode is shown in brackets, such as "always [madvise] never"
+	cmd := fmt.Sprintf("grep -q '\\[%[1]s\\]' %[2]s || echo %[1]s > %[2]s", mode, thpFile)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return changed, nil
+}
+
+// renderSysctls merges the overrides into the default kernel parameters
+// shipped with provider, the parameters are sorted by key.
+func renderSysctls(overrides map[string]string) ([]byte, error) {
+	sysctls := map[string]string{
+		"net.ipv4.ip_forward":                "1",
+		"net.bridge.bridge-nf-call-iptables": "1",
+	}
+	data, err := ioutil.ReadFile(path.Join(constants.ConfDir, "sysctl.conf"))
+	if err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(data))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+				continue
+			}
+			kv := strings.SplitN(line, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			sysctls[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	for k, v := range overrides {
+		sysctls[k] = v
+	}
+
+	keys := make([]string, 0, len(sysctls))
+	for k := range sysctls {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var buf bytes.Buffer
+	for _, k := range keys {
+		buf.WriteString(fmt.Sprintf("%s = %s\n", k, sysctls[k]))
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeIfChanged(s ssh.Interface, filename string, data []byte) (bool, error) {
+	ok, err := s.Exist(filename)
+	if err != nil {
+		return false, err
+	}
+	if ok {
+		current, err := s.ReadFile(filename)
+		if err != nil {
+			return false, errors.Wrapf(err, "read %s error", filename)
+		}
+		if bytes.Equal(current, data) {
+			return false, nil
+		}
+	}
+	err = s.WriteFile(bytes.NewReader(data), filename)
+	if err != nil {
+		return false, errors.Wrapf(err, "write %s error", filename)
+	}
+
+	return true, nil
+}
+
+func removeIfExist(s ssh.Interface, filename string) (bool, error) {
+	ok, err := s.Exist(filename)
+	if err != nil || !ok {
+		return false, err
+	}
+	cmd := fmt.Sprintf("rm -f %s", filename)
+	_, stderr, exit, err := s.Exec(cmd)
+	if err != nil || exit != 0 {
+		return false, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", cmd, exit, stderr, err)
+	}
+
+	return true, nil
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
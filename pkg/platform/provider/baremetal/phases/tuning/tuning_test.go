@@ -0,0 +1,241 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package tuning
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// fakeSSH keeps the files written in memory and records the commands, the
+// kernel modules in missing fail to load.
+type fakeSSH struct {
+	files   map[string][]byte
+	cmds    []string
+	missing map[string]bool
+}
+
+func newFakeSSH() *fakeSSH {
+	return &fakeSSH{files: map[string][]byte{}, missing: map[string]bool{}}
+}
+
+func (f *fakeSSH) Ping() error                          { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error       { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error) { return file, nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.cmds = append(f.cmds, cmd)
+	fields := strings.Fields(cmd)
+	if len(fields) == 2 && f.missing[fields[1]] {
+		return nil, fmt.Errorf("module %s not found", fields[1])
+	}
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.cmds = append(f.cmds, cmd)
+	if strings.HasPrefix(cmd, "rm -f ") {
+		delete(f.files, strings.TrimPrefix(cmd, "rm -f "))
+	}
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error {
+	buf := new(bytes.Buffer)
+	if _, err := buf.ReadFrom(src); err != nil {
+		return err
+	}
+	f.files[dst] = buf.Bytes()
+	return nil
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+func (f *fakeSSH) ran(cmd string) bool {
+	for _, one := range f.cmds {
+		if one == cmd {
+			return true
+		}
+	}
+	return false
+}
+
+func TestApply(t *testing.T) {
+	tuning := &platformv1.SystemTuning{
+		Sysctls:             map[string]string{"net.core.somaxconn": "32768", "vm.max_map_count": "262144"},
+		KernelModules:       []string{"ip_vs", "nf_conntrack"},
+		Ulimits:             []platformv1.Ulimit{{Name: "nofile", Soft: "65536", Hard: "unlimited"}},
+		TransparentHugepage: TransparentHugepageNever,
+	}
+	s := newFakeSSH()
+	changed, err := Apply(s, tuning)
+	if err != nil || !changed {
+		t.Fatalf("Apply() = %v, %v, want changed", changed, err)
+	}
+
+	sysctls := string(s.files[SysctlFile])
+	for _, line := range []string{"net.core.somaxconn = 32768\n", "vm.max_map_count = 262144\n", "net.ipv4.ip_forward = 1\n"} {
+		if !strings.Contains(sysctls, line) {
+			t.Errorf("%s = %q, want %q", SysctlFile, sysctls, line)
+		}
+	}
+	if !s.ran("sysctl --system") {
+		t.Errorf("sysctls are not reloaded, commands = %v", s.cmds)
+	}
+	// ip_vs is a default module and loaded once
+	if got := strings.Count(string(s.files[ModuleFile]), "ip_vs\n"); got != 1 {
+		t.Errorf("%s = %q, want ip_vs once", ModuleFile, s.files[ModuleFile])
+	}
+	if !strings.HasSuffix(string(s.files[ModuleFile]), "br_netfilter\nnf_conntrack\n") || !s.ran("modprobe nf_conntrack") {
+		t.Errorf("%s = %q, commands = %v, want nf_conntrack loaded", ModuleFile, s.files[ModuleFile], s.cmds)
+	}
+	if got, want := string(s.files[LimitsFile]), "* soft nofile 65536\n* hard nofile unlimited\n"; got != want {
+		t.Errorf("%s = %q, want %q", LimitsFile, got, want)
+	}
+	if got, want := string(s.files[thpTmpfilesFile]), "w "+thpFile+" - - - - never\n"; got != want {
+		t.Errorf("%s = %q, want %q", thpTmpfilesFile, got, want)
+	}
+
+	s.cmds = nil
+	changed, err = Apply(s, tuning)
+	if err != nil || changed {
+		t.Errorf("Apply() again = %v, %v, want unchanged", changed, err)
+	}
+	if s.ran("sysctl --system") {
+		t.Errorf("unchanged sysctls are reloaded")
+	}
+
+	// removing the settings falls back to the defaults
+	changed, err = Apply(s, nil)
+	if err != nil || !changed {
+		t.Fatalf("Apply() without tuning = %v, %v, want changed", changed, err)
+	}
+	for _, file := range []string{LimitsFile, thpTmpfilesFile} {
+		if _, ok := s.files[file]; ok {
+			t.Errorf("%s is not removed", file)
+		}
+	}
+	if strings.Contains(string(s.files[SysctlFile]), "somaxconn") || strings.Contains(string(s.files[ModuleFile]), "nf_conntrack") {
+		t.Errorf("the overrides are kept, sysctls = %q, modules = %q", s.files[SysctlFile], s.files[ModuleFile])
+	}
+}
+
+func TestApplyKernelModules(t *testing.T) {
+	s := newFakeSSH()
+	s.missing["br_netfilter"] = true
+	if _, err := ApplyKernelModules(s, nil); err != nil {
+		t.Fatalf("ApplyKernelModules() error = %v", err)
+	}
+	if strings.Contains(string(s.files[ModuleFile]), "br_netfilter") || s.ran("modprobe br_netfilter") {
+		t.Errorf("br_netfilter is loaded although it is built in the kernel")
+	}
+
+	s = newFakeSSH()
+	s.missing["nf_nat_ftp"] = true
+	if _, err := ApplyKernelModules(s, []string{"nf_nat_ftp"}); err == nil {
+		t.Errorf("ApplyKernelModules() succeeded with a missing module")
+	}
+	if _, ok := s.files[ModuleFile]; ok {
+		t.Errorf("%s is written although a module failed to load", ModuleFile)
+	}
+}
+
+func TestRenderSysctls(t *testing.T) {
+	data, err := renderSysctls(map[string]string{"net.ipv4.ip_forward": "0", "fs.file-max": "1048576"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	for i := 1; i < len(lines); i++ {
+		if strings.Fields(lines[i-1])[0] > strings.Fields(lines[i])[0] {
+			t.Errorf("sysctls are not sorted: %q", data)
+			break
+		}
+	}
+	if !strings.Contains(string(data), "net.ipv4.ip_forward = 0\n") || !strings.Contains(string(data), "fs.file-max = 1048576\n") {
+		t.Errorf("renderSysctls() = %q, want the overrides", data)
+	}
+}
+
+func TestKubeProxyModules(t *testing.T) {
+	enabled := true
+	tests := []struct {
+		name     string
+		features platformv1.ClusterFeature
+		want     []string
+	}{
+		{"iptables", platformv1.ClusterFeature{}, nil},
+		{"ipvs feature", platformv1.ClusterFeature{IPVS: &enabled}, []string{"ip_vs", "ip_vs_rr", "nf_conntrack"}},
+		{"ipvs scheduler", platformv1.ClusterFeature{
+			KubeProxy: &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeIPVS, IPVSScheduler: "lc"},
+		}, []string{"ip_vs", "ip_vs_lc", "nf_conntrack"}},
+		{"nftables", platformv1.ClusterFeature{
+			KubeProxy: &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeNFTables},
+		}, []string{"nf_tables"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &platformv1.Cluster{Spec: platformv1.ClusterSpec{Features: tt.features}}
+			if got := KubeProxyModules(cluster); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KubeProxyModules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestForCluster(t *testing.T) {
+	tuning := &platformv1.SystemTuning{KernelModules: []string{"nf_conntrack"}}
+	cluster := &platformv1.Cluster{Spec: platformv1.ClusterSpec{Features: platformv1.ClusterFeature{
+		KubeProxy:    &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeIPVS, IPVSScheduler: "wrr"},
+		SystemTuning: tuning,
+	}}}
+	got := ForCluster(cluster)
+	if want := []string{"nf_conntrack", "ip_vs", "ip_vs_wrr"}; !reflect.DeepEqual(got.KernelModules, want) {
+		t.Errorf("ForCluster() modules = %v, want %v", got.KernelModules, want)
+	}
+	if len(tuning.KernelModules) != 1 {
+		t.Errorf("ForCluster() modified the cluster: %v", tuning.KernelModules)
+	}
+}
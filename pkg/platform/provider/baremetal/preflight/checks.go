@@ -23,11 +23,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	platformv1 "tkestack.io/tke/api/platform/v1"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
 	"tkestack.io/tke/pkg/util/ssh"
+	"tkestack.io/tke/pkg/util/version"
 )
 
 const (
@@ -59,6 +64,12 @@ func newCommonChecks(c *v1.Cluster, s ssh.Interface) []Checker {
 		KernelCheck{Interface: s, MinKernelVersion: 3, MinMajorVersion: 10},
 
 		KernelModuleCheck{Interface: s, Module: "iptable_nat"},
+		CgroupCheck{
+			Interface:         s,
+			CgroupDriver:      c.CgroupDriver(),
+			KubernetesVersion: c.Spec.Version,
+			DockerVersion:     res.Docker.DefaultVersion(),
+		},
 
 		FileContentCheck{Interface: s, Path: ipv4Forward, Content: []byte{'1'}},
 
@@ -71,6 +82,16 @@ func newCommonChecks(c *v1.Cluster, s ssh.Interface) []Checker {
 		PortOpenCheck{Interface: s, port: constants.ProxyStatusPort},
 		PortOpenCheck{Interface: s, port: constants.KubeletPort},
 	}...)
+	for _, m := range tuning.KubeProxyModules(c.Cluster) {
+		checks = append(checks, KernelModuleCheck{Interface: s, Module: m})
+	}
+	if ts := c.Spec.Features.TimeSync; ts != nil {
+		maxSkew := time.Duration(ts.MaxSkewSeconds) * time.Second
+		if maxSkew == 0 {
+			maxSkew = constants.DefaultMaxClockSkew
+		}
+		checks = append(checks, ClockSkewCheck{Interface: s, MaxSkew: maxSkew})
+	}
 	return checks
 }
 
@@ -88,6 +109,10 @@ func RunMasterChecks(c *v1.Cluster, s ssh.Interface) error {
 		PortOpenCheck{Interface: s, port: constants.EtcdListenClientPort},
 		PortOpenCheck{Interface: s, port: constants.EtcdListenPeerPort},
 	}...)
+	if c.Spec.Etcd != nil && c.Spec.Etcd.Local != nil && c.Spec.Etcd.Local.DataDevice != "" {
+		checks = append(checks, BlockDeviceCheck{Interface: s, Device: c.Spec.Etcd.Local.DataDevice})
+		checks = append(checks, InPathCheck{Interface: s, executable: "mkfs.xfs"})
+	}
 
 	for _, tool := range tools {
 		checks = append(checks, InPathCheck{Interface: s, executable: tool})
@@ -389,6 +414,34 @@ func (dac DirAvailableCheck) Check() (warnings, errorList []error) {
 	return nil, errorList
 }
 
+// BlockDeviceCheck checks if the device is an unused block device.
+type BlockDeviceCheck struct {
+	ssh.Interface
+	Device string
+}
+
+// Name returns label for BlockDeviceCheck
+func (bdc BlockDeviceCheck) Name() string {
+	return fmt.Sprintf("BlockDevice-%s", strings.Replace(bdc.Device, "/", "-", -1))
+}
+
+// Check validates if the device is a block device without partitions and not mounted.
+func (bdc BlockDeviceCheck) Check() (warnings, errorList []error) {
+	if _, _, exit, err := bdc.Execf("test -b %s", bdc.Device); err != nil || exit != 0 {
+		errorList = append(errorList, errors.Errorf("%s is not a block device", bdc.Device))
+		return
+	}
+	result, err := bdc.CombinedOutput(fmt.Sprintf("lsblk -n -o MOUNTPOINT %s", bdc.Device))
+	if err != nil {
+		errorList = append(errorList, err)
+		return
+	}
+	if mountpoints := strings.TrimSpace(string(result)); mountpoints != "" {
+		errorList = append(errorList, errors.Errorf("%s or its partitions are mounted at %s", bdc.Device, mountpoints))
+	}
+	return warnings, errorList
+}
+
 // Check the kernel version and kernel parameter for Cilium installation.
 type KernelParameterCheck struct {
 	ssh.Interface
@@ -483,3 +536,109 @@ func (kmc KernelModuleCheck) Check() (warnings, errorList []error) {
 
 	return nil, errorList
 }
+
+const (
+	// CgroupV1 is the legacy or hybrid cgroup hierarchy.
+	CgroupV1 = "v1"
+	// CgroupV2 is the unified cgroup hierarchy.
+	CgroupV2 = "v2"
+
+	// cgroupV1Hint is the way to fall back to cgroup v1 for the combinations
+	// that don't support cgroup v2.
+	cgroupV1Hint = "or boot the host with kernel parameter systemd.unified_cgroup_hierarchy=0 to use cgroup v1"
+)
+
+// DetectCgroupMode returns the cgroup mode of the host by the filesystem
+// type mounted at /sys/fs/cgroup.
+func DetectCgroupMode(s ssh.Interface) (string, error) {
+	output, err := s.CombinedOutput("stat -fc %T /sys/fs/cgroup/")
+	if err != nil {
+		return "", err
+	}
+	if strings.TrimSpace(string(output)) == "cgroup2fs" {
+		return CgroupV2, nil
+	}
+	return CgroupV1, nil
+}
+
+// CgroupCheck checks if the cgroup mode of host is supported by the cgroup
+// driver, the kubernetes version and the container runtime version.
+type CgroupCheck struct {
+	ssh.Interface
+	CgroupDriver      string
+	KubernetesVersion string
+	DockerVersion     string
+}
+
+// Name returns label for CgroupCheck
+func (cc CgroupCheck) Name() string {
+	return "CgroupCheck"
+}
+
+// Check validates the combination with cgroup v2. All the combinations work
+// with cgroup v1.
+func (cc CgroupCheck) Check() (warnings, errorList []error) {
+	mode, err := DetectCgroupMode(cc)
+	if err != nil {
+		return nil, []error{err}
+	}
+	if mode != CgroupV2 {
+		return nil, nil
+	}
+
+	if version.Compare(cc.KubernetesVersion, "1.19.0") < 0 {
+		errorList = append(errorList, errors.Errorf("cgroup v2 is not supported by kubernetes %s, use kubernetes 1.19 or later, %s",
+			cc.KubernetesVersion, cgroupV1Hint))
+	}
+	if cc.CgroupDriver != platformv1.CgroupDriverSystemd {
+		errorList = append(errorList, errors.Errorf("cgroup v2 requires the systemd cgroup driver but the cluster uses %s, add the node to a cluster using systemd, %s",
+			cc.CgroupDriver, cgroupV1Hint))
+	}
+	if version.Compare(cc.DockerVersion, "20.10.0") < 0 {
+		errorList = append(errorList, errors.Errorf("cgroup v2 is not supported by docker %s, provide docker 20.10 or later, %s",
+			cc.DockerVersion, cgroupV1Hint))
+	}
+
+	return nil, errorList
+}
+
+// ClockSkewCheck checks if the clock skew between the node and the platform
+// controller is within the threshold. Certificates and etcd are broken by large
+// clock skew.
+type ClockSkewCheck struct {
+	ssh.Interface
+	MaxSkew time.Duration
+}
+
+// Name returns label for ClockSkewCheck
+func (csc ClockSkewCheck) Name() string {
+	return "ClockSkew"
+}
+
+// Check compares the time of node with the local time, the round trip time of
+// ssh is excluded by comparing with the middle of the request.
+func (csc ClockSkewCheck) Check() (warnings, errorList []error) {
+	start := time.Now()
+	stdout, stderr, exit, err := csc.Exec("date +%s.%N")
+	if err != nil || exit != 0 {
+		return nil, []error{errors.Errorf("exec %q failed:exit %d:stderr %s:error %s", "date +%s.%N", exit, stderr, err)}
+	}
+	end := time.Now()
+	seconds, err := strconv.ParseFloat(strings.TrimSpace(stdout), 64)
+	if err != nil {
+		return nil, []error{err}
+	}
+	remote := time.Unix(0, int64(seconds*float64(time.Second)))
+	local := start.Add(end.Sub(start) / 2)
+
+	skew := remote.Sub(local)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > csc.MaxSkew+end.Sub(start)/2 {
+		errorList = append(errorList, errors.Errorf("clock skew %s exceeds the max %s, synchronize the time of node before joining the cluster",
+			skew.Round(time.Millisecond), csc.MaxSkew))
+	}
+
+	return nil, errorList
+}
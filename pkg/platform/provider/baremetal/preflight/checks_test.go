@@ -0,0 +1,236 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package preflight
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/constants"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+// fakeSSH answers date with the node time.
+type fakeSSH struct {
+	ssh.Interface
+	now  func() time.Time
+	exit int
+	err  error
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	if f.now == nil {
+		return "not a time", "", f.exit, f.err
+	}
+	now := f.now()
+	return fmt.Sprintf("%d.%09d\n", now.Unix(), now.Nanosecond()), "", f.exit, f.err
+}
+
+// cgroupSSH answers stat with the filesystem type of /sys/fs/cgroup.
+type cgroupSSH struct {
+	ssh.Interface
+	fsType string
+	err    error
+}
+
+func (f *cgroupSSH) CombinedOutput(cmd string) ([]byte, error) {
+	return []byte(f.fsType + "\n"), f.err
+}
+
+func TestClockSkewCheck(t *testing.T) {
+	offset := func(d time.Duration) func() time.Time {
+		return func() time.Time { return time.Now().Add(d) }
+	}
+	tests := []struct {
+		name    string
+		ssh     *fakeSSH
+		wantErr bool
+	}{
+		{"in sync", &fakeSSH{now: time.Now}, false},
+		{"ahead within max skew", &fakeSSH{now: offset(3 * time.Second)}, false},
+		{"behind within max skew", &fakeSSH{now: offset(-3 * time.Second)}, false},
+		{"ahead", &fakeSSH{now: offset(time.Minute)}, true},
+		{"behind", &fakeSSH{now: offset(-time.Minute)}, true},
+		{"date failed", &fakeSSH{now: time.Now, exit: 1}, true},
+		{"ssh failed", &fakeSSH{now: time.Now, err: errors.New("connection refused")}, true},
+		{"invalid output", &fakeSSH{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			warnings, errs := ClockSkewCheck{Interface: tt.ssh, MaxSkew: 5 * time.Second}.Check()
+			if len(warnings) != 0 {
+				t.Errorf("Check() warnings = %v", warnings)
+			}
+			if (len(errs) != 0) != tt.wantErr {
+				t.Errorf("Check() errors = %v, wantErr %v", errs, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewCommonChecksClockSkew(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeSync *platformv1.TimeSync
+		want     time.Duration
+	}{
+		{"disabled", nil, 0},
+		{"default max skew", &platformv1.TimeSync{Servers: []string{"ntp.example.com"}}, constants.DefaultMaxClockSkew},
+		{"max skew", &platformv1.TimeSync{Servers: []string{"ntp.example.com"}, MaxSkewSeconds: 30}, 30 * time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &v1.Cluster{Cluster: &platformv1.Cluster{
+				Spec: platformv1.ClusterSpec{
+					Version:  "1.20.4",
+					Features: platformv1.ClusterFeature{TimeSync: tt.timeSync},
+				},
+			}}
+			var got time.Duration
+			for _, check := range newCommonChecks(c, &fakeSSH{}) {
+				if one, ok := check.(ClockSkewCheck); ok {
+					got = one.MaxSkew
+				}
+			}
+			if got != tt.want {
+				t.Errorf("max clock skew = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectCgroupMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		ssh     *cgroupSSH
+		want    string
+		wantErr bool
+	}{
+		{"unified", &cgroupSSH{fsType: "cgroup2fs"}, CgroupV2, false},
+		{"hybrid", &cgroupSSH{fsType: "tmpfs"}, CgroupV1, false},
+		{"ssh failed", &cgroupSSH{err: errors.New("connection refused")}, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DetectCgroupMode(tt.ssh)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("DetectCgroupMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("DetectCgroupMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCgroupCheck(t *testing.T) {
+	tests := []struct {
+		name     string
+		fsType   string
+		driver   string
+		k8s      string
+		docker   string
+		wantErrs int
+	}{
+		{"v1 with anything", "tmpfs", platformv1.CgroupDriverCgroupfs, "1.18.3", "19.03.14", 0},
+		{"v2 supported", "cgroup2fs", platformv1.CgroupDriverSystemd, "1.20.4", "20.10.5", 0},
+		{"v2 with old kubernetes", "cgroup2fs", platformv1.CgroupDriverSystemd, "1.18.3", "20.10.5", 1},
+		{"v2 with cgroupfs", "cgroup2fs", platformv1.CgroupDriverCgroupfs, "1.20.4", "20.10.5", 1},
+		{"v2 with old docker", "cgroup2fs", platformv1.CgroupDriverSystemd, "1.20.4", "19.03.14", 1},
+		{"v2 with nothing supported", "cgroup2fs", platformv1.CgroupDriverCgroupfs, "1.18.3", "19.03.14", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := CgroupCheck{
+				Interface:         &cgroupSSH{fsType: tt.fsType},
+				CgroupDriver:      tt.driver,
+				KubernetesVersion: tt.k8s,
+				DockerVersion:     tt.docker,
+			}
+			warnings, errs := check.Check()
+			if len(warnings) != 0 {
+				t.Errorf("Check() warnings = %v", warnings)
+			}
+			if len(errs) != tt.wantErrs {
+				t.Errorf("Check() errors = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+
+	_, errs := CgroupCheck{Interface: &cgroupSSH{err: errors.New("connection refused")}}.Check()
+	if len(errs) != 1 {
+		t.Errorf("Check() errors = %v, want the ssh error", errs)
+	}
+}
+
+func TestNewCommonChecksCgroupDriver(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		want   string
+	}{
+		{"default", "", platformv1.CgroupDriverCgroupfs},
+		{"systemd", platformv1.CgroupDriverSystemd, platformv1.CgroupDriverSystemd},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &v1.Cluster{Cluster: &platformv1.Cluster{
+				Spec: platformv1.ClusterSpec{
+					Version:  "1.20.4",
+					Features: platformv1.ClusterFeature{CgroupDriver: tt.driver},
+				},
+			}}
+			var got string
+			for _, check := range newCommonChecks(c, &fakeSSH{}) {
+				if one, ok := check.(CgroupCheck); ok {
+					got = one.CgroupDriver
+				}
+			}
+			if got != tt.want {
+				t.Errorf("cgroup driver = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCommonChecksKubeProxyModules(t *testing.T) {
+	c := &v1.Cluster{Cluster: &platformv1.Cluster{
+		Spec: platformv1.ClusterSpec{
+			Version: "1.20.4",
+			Features: platformv1.ClusterFeature{
+				KubeProxy: &platformv1.KubeProxy{Mode: platformv1.KubeProxyModeIPVS, IPVSScheduler: "sh"},
+			},
+		},
+	}}
+	var got []string
+	for _, check := range newCommonChecks(c, &fakeSSH{}) {
+		if one, ok := check.(KernelModuleCheck); ok {
+			got = append(got, one.Module)
+		}
+	}
+	want := []string{"iptable_nat", "ip_vs", "ip_vs_sh", "nf_conntrack"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("kernel module checks = %v, want %v", got, want)
+	}
+}
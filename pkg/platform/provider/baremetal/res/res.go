@@ -56,6 +56,21 @@ var (
 		Name:     "nvidia-container-runtime",
 		Versions: spec.NvidiaContainerRuntimeVersions,
 	}
+	KataContainers = Package{
+		Name:      "kata-containers",
+		Versions:  spec.KataContainersVersions,
+		TargetDir: "/",
+	}
+	GVisor = Package{
+		Name:      "gvisor",
+		Versions:  spec.GVisorVersions,
+		TargetDir: "/usr/bin/",
+	}
+	WireGuardTools = Package{
+		Name:      "wireguard-tools",
+		Versions:  spec.WireGuardToolsVersions,
+		TargetDir: "/usr/bin/",
+	}
 )
 
 type Package struct {
@@ -95,12 +110,17 @@ func (p *Package) CopyToNodeWithDefault(s ssh.Interface) (string, error) {
 }
 
 // CopyToNode copy package which use default version to node and return dst filename
+// The package is downloaded from artifact server on node if it's configured.
 func (p *Package) CopyToNode(s ssh.Interface, version string) (string, error) {
-	srcFile, err := p.ResourceForNode(s, version)
+	arch := Arch(s)
+	srcFile, err := p.Resource(arch, version)
 	if err != nil {
 		return "", err
 	}
 	dstFile := path.Join(constants.DstTmpDir, filepath.Base(srcFile))
+	if artifactServerURL != "" {
+		return dstFile, p.downloadToNode(s, srcFile, dstFile, arch, version)
+	}
 	err = s.CopyFile(srcFile, dstFile)
 	if err != nil {
 		return "", err
@@ -125,6 +145,25 @@ func (p *Package) Resource(arch, version string) (string, error) {
 	return srcFile, nil
 }
 
+// Verify checks the package for arch and version matches the sha256 digest,
+// which may be prefixed with "sha256:".
+func (p *Package) Verify(arch, version, digest string) error {
+	srcFile, err := p.Resource(arch, version)
+	if err != nil {
+		return err
+	}
+	actual, err := fileSHA256(srcFile)
+	if err != nil {
+		return err
+	}
+	expected := strings.TrimPrefix(digest, "sha256:")
+	if actual != expected {
+		return fmt.Errorf("digest of %s is sha256:%s, expected sha256:%s", srcFile, actual, expected)
+	}
+
+	return nil
+}
+
 func (p *Package) DefaultVersion() string {
 	return p.Versions[0]
 }
@@ -0,0 +1,241 @@
+/*
+ * Copyright 2019 THL A29 Limited, a Tencent company.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package res
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// ManifestFile is the path of SHA256 manifest of all artifacts on artifact server.
+	ManifestFile = "SHA256SUMS"
+
+	// HeaderChecksum is the response header of artifact SHA256 checksum.
+	HeaderChecksum = "X-Checksum-Sha256"
+	// HeaderVersion is the response header of artifact version after negotiation.
+	HeaderVersion = "X-Artifact-Version"
+)
+
+// packages are the packages served by artifact server.
+var packages = []*Package{
+	&Docker, &CNIPlugins, &ConntrackTools, &KubernetesNode, &NvidiaDriver,
+	&NvidiaContainerRuntime, &KataContainers, &GVisor, &WireGuardTools,
+}
+
+// artifactServerURL is the url which nodes download packages from, packages
+// are copied to nodes by ssh if it's empty.
+var artifactServerURL string
+
+// Init sets the url of artifact server which nodes download packages from.
+func Init(serverURL string) {
+	artifactServerURL = strings.TrimSuffix(serverURL, "/")
+}
+
+type checksum struct {
+	size    int64
+	modTime time.Time
+	sum     string
+}
+
+var (
+	checksumsLock sync.Mutex
+	checksums     = map[string]checksum{}
+)
+
+// fileSHA256 returns the sha256 checksum of file, which is cached until the
+// file is changed.
+func fileSHA256(filename string) (string, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return "", err
+	}
+	checksumsLock.Lock()
+	c, ok := checksums[filename]
+	checksumsLock.Unlock()
+	if ok && c.size == info.Size() && c.modTime.Equal(info.ModTime()) {
+		return c.sum, nil
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("read %s error: %w", filename, err)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	checksumsLock.Lock()
+	checksums[filename] = checksum{size: info.Size(), modTime: info.ModTime(), sum: sum}
+	checksumsLock.Unlock()
+
+	return sum, nil
+}
+
+// downloadToNode downloads the package from artifact server on node, the
+// partially downloaded file is resumed and the checksum is verified.
+func (p *Package) downloadToNode(s ssh.Interface, srcFile string, dstFile string, arch string, version string) error {
+	sum, err := fileSHA256(srcFile)
+	if err != nil {
+		return err
+	}
+	url := fmt.Sprintf("%s/packages/%s?arch=%s&version=%s", artifactServerURL, p.Name, arch, version)
+	download := fmt.Sprintf("mkdir -p %s && curl -fsSL --retry 3 -C - -o %s %q", path.Dir(dstFile), dstFile, url)
+	verify := fmt.Sprintf("echo '%s  %s' | sha256sum -c --quiet", sum, dstFile)
+
+	for i := 0; i < 2; i++ {
+		_, stderr, exit, err := s.Exec(download)
+		if err != nil || exit != 0 {
+			return fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", download, exit, stderr, err)
+		}
+		if _, _, exit, err = s.Exec(verify); err == nil && exit == 0 {
+			return nil
+		}
+		// the partially downloaded file may be stale, download it again
+		_, _ = s.CombinedOutput(fmt.Sprintf("rm -f %s", dstFile))
+	}
+
+	return fmt.Errorf("checksum of %s downloaded from %s mismatch, expected sha256:%s", dstFile, url, sum)
+}
+
+// NewArtifactHandler returns a handler which serves the packages in root dir.
+// /SHA256SUMS returns the checksums of all packages, and
+// /packages/{name}?arch={arch}&version={version} returns the package of arch
+// and version, the default version is used if version is empty.
+func NewArtifactHandler(root string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/"+ManifestFile, func(w http.ResponseWriter, req *http.Request) {
+		serveManifest(w, root)
+	})
+	mux.HandleFunc("/packages/", func(w http.ResponseWriter, req *http.Request) {
+		servePackage(w, req, root)
+	})
+	return mux
+}
+
+// ServeArtifacts serves the packages in root dir on bind address until it fails.
+func ServeArtifacts(bindAddress string, root string) {
+	log.Infof("Serving artifacts of %s on %s", root, bindAddress)
+	server := &http.Server{
+		Addr:              bindAddress,
+		Handler:           NewArtifactHandler(root),
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+	if err := server.ListenAndServe(); err != nil {
+		log.Errorf("Serve artifacts error: %v", err)
+	}
+}
+
+func serveManifest(w http.ResponseWriter, root string) {
+	var files []string
+	err := filepath.Walk(root, func(name string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && strings.HasSuffix(name, ".tar.gz") {
+			files = append(files, name)
+		}
+		return nil
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sort.Strings(files)
+
+	var b strings.Builder
+	for _, file := range files {
+		sum, err := fileSHA256(file)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		rel, _ := filepath.Rel(root, file)
+		fmt.Fprintf(&b, "%s  %s\n", sum, rel)
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, _ = io.WriteString(w, b.String())
+}
+
+func servePackage(w http.ResponseWriter, req *http.Request, root string) {
+	name := strings.TrimPrefix(req.URL.Path, "/packages/")
+	var pkg *Package
+	for _, one := range packages {
+		if one.Name == name {
+			pkg = one
+			break
+		}
+	}
+	if pkg == nil {
+		http.Error(w, fmt.Sprintf("unknown package %q", name), http.StatusNotFound)
+		return
+	}
+
+	arch := req.URL.Query().Get("arch")
+	if arch == "" {
+		arch = "amd64"
+	}
+	version := req.URL.Query().Get("version")
+	if version == "" {
+		version = pkg.DefaultVersion()
+	}
+	version, err := pkg.NormalizeVersion(version)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unsupported version %s of %s, supported versions: %v", req.URL.Query().Get("version"), name, pkg.Versions), http.StatusNotFound)
+		return
+	}
+
+	filename := filepath.Join(root, fmt.Sprintf("linux-%s/%s-linux-%s-%s.tar.gz", arch, pkg.Name, arch, version))
+	f, err := os.Open(filename)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("package %s %s for %s not found", name, version, arch), http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	sum, err := fileSHA256(filename)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set(HeaderChecksum, sum)
+	w.Header().Set(HeaderVersion, version)
+	w.Header().Set("Content-Type", "application/gzip")
+	// ServeContent handles the range requests, so the download can be resumed
+	http.ServeContent(w, req, filepath.Base(filename), info.ModTime(), f)
+}
@@ -0,0 +1,224 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package res
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func writePackage(t *testing.T, root string, rel string, data []byte) string {
+	filename := filepath.Join(root, rel)
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return filename
+}
+
+func TestFileSHA256(t *testing.T) {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	filename := writePackage(t, root, "docker.tar.gz", []byte("docker"))
+
+	sum, err := fileSHA256(filename)
+	if err != nil || sum != sha256Hex([]byte("docker")) {
+		t.Fatalf("fileSHA256() = %s, %v", sum, err)
+	}
+	// the cached checksum is dropped once the file is changed
+	if err := ioutil.WriteFile(filename, []byte("docker-ce"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(filename, later, later); err != nil {
+		t.Fatal(err)
+	}
+	sum, err = fileSHA256(filename)
+	if err != nil || sum != sha256Hex([]byte("docker-ce")) {
+		t.Errorf("fileSHA256() of changed file = %s, %v", sum, err)
+	}
+	if _, err := fileSHA256(filepath.Join(root, "missing.tar.gz")); err == nil {
+		t.Errorf("fileSHA256() of missing file succeeded")
+	}
+}
+
+func TestArtifactHandler(t *testing.T) {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	node := []byte("kubernetes-node package")
+	nodeRel := "linux-amd64/kubernetes-node-linux-amd64-v1.20.4.tar.gz"
+	writePackage(t, root, nodeRel, node)
+	cniRel := fmt.Sprintf("linux-arm64/cni-plugins-linux-arm64-%s.tar.gz", CNIPlugins.DefaultVersion())
+	writePackage(t, root, cniRel, []byte("cni"))
+	writePackage(t, root, "README", []byte("not a package"))
+
+	server := httptest.NewServer(NewArtifactHandler(root))
+	defer server.Close()
+
+	t.Run("manifest", func(t *testing.T) {
+		body, _ := get(t, server.URL+"/"+ManifestFile, "", http.StatusOK)
+		want := fmt.Sprintf("%s  %s\n%s  %s\n", sha256Hex(node), nodeRel, sha256Hex([]byte("cni")), cniRel)
+		if body != want {
+			t.Errorf("manifest = %q, want %q", body, want)
+		}
+	})
+	t.Run("package", func(t *testing.T) {
+		body, header := get(t, server.URL+"/packages/kubernetes-node?arch=amd64&version=1.20.4", "", http.StatusOK)
+		if body != string(node) {
+			t.Errorf("package = %q, want %q", body, node)
+		}
+		if header.Get(HeaderChecksum) != sha256Hex(node) || header.Get(HeaderVersion) != "v1.20.4" {
+			t.Errorf("headers = %v", header)
+		}
+	})
+	t.Run("default version and arch", func(t *testing.T) {
+		get(t, server.URL+"/packages/cni-plugins", "", http.StatusNotFound)
+		body, header := get(t, server.URL+"/packages/cni-plugins?arch=arm64", "", http.StatusOK)
+		if body != "cni" || header.Get(HeaderVersion) != CNIPlugins.DefaultVersion() {
+			t.Errorf("package = %q, headers = %v", body, header)
+		}
+	})
+	t.Run("resume", func(t *testing.T) {
+		body, _ := get(t, server.URL+"/packages/kubernetes-node?version=v1.20.4", "bytes=11-", http.StatusPartialContent)
+		if body != string(node[11:]) {
+			t.Errorf("resumed package = %q, want %q", body, node[11:])
+		}
+	})
+	t.Run("unknown package", func(t *testing.T) {
+		get(t, server.URL+"/packages/etcd", "", http.StatusNotFound)
+	})
+	t.Run("unsupported version", func(t *testing.T) {
+		get(t, server.URL+"/packages/docker?version=0.0.1", "", http.StatusNotFound)
+	})
+}
+
+func get(t *testing.T, url string, byteRange string, wantStatus int) (string, http.Header) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if byteRange != "" {
+		req.Header.Set("Range", byteRange)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode != wantStatus {
+		t.Fatalf("GET %s status = %d, want %d, body %s", url, resp.StatusCode, wantStatus, body)
+	}
+	return string(body), resp.Header
+}
+
+// fakeSSH records the commands and fails the checksum verification for the
+// first failures times.
+type fakeSSH struct {
+	failures int
+	commands []string
+}
+
+func (f *fakeSSH) Ping() error                                     { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error                  { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error)            { return file, nil }
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error       { return nil }
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error)        { return nil, os.ErrNotExist }
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) { return nil, os.ErrNotExist }
+func (f *fakeSSH) Exist(filename string) (bool, error)             { return false, nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	if strings.Contains(cmd, "sha256sum -c") && f.failures > 0 {
+		f.failures--
+		return "", "FAILED", 1, nil
+	}
+	return "", "", 0, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func TestDownloadToNode(t *testing.T) {
+	root, err := ioutil.TempDir("", "artifacts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(root)
+	srcFile := writePackage(t, root, "linux-amd64/docker-linux-amd64-19.03.14.tar.gz", []byte("docker"))
+	Init("http://10.0.0.1:8099/")
+	defer Init("")
+
+	dstFile := "/tmp/k8s/docker-linux-amd64-19.03.14.tar.gz"
+	download := fmt.Sprintf("mkdir -p /tmp/k8s && curl -fsSL --retry 3 -C - -o %s %q", dstFile,
+		"http://10.0.0.1:8099/packages/docker?arch=amd64&version=19.03.14")
+	verify := fmt.Sprintf("echo '%s  %s' | sha256sum -c --quiet", sha256Hex([]byte("docker")), dstFile)
+	remove := "rm -f " + dstFile
+	tests := []struct {
+		name     string
+		failures int
+		want     []string
+		wantErr  bool
+	}{
+		{"downloaded", 0, []string{download, verify}, false},
+		{"downloaded again", 1, []string{download, verify, remove, download, verify}, false},
+		{"checksum mismatch", 2, []string{download, verify, remove, download, verify, remove}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &fakeSSH{failures: tt.failures}
+			err := Docker.downloadToNode(s, srcFile, dstFile, "amd64", "19.03.14")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("downloadToNode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if strings.Join(s.commands, "\n") != strings.Join(tt.want, "\n") {
+				t.Errorf("downloadToNode() commands = %v, want %v", s.commands, tt.want)
+			}
+		})
+	}
+}
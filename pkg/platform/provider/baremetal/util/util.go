@@ -28,9 +28,13 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/rand"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/res"
 	v1 "tkestack.io/tke/pkg/platform/types/v1"
+	platformutil "tkestack.io/tke/pkg/platform/util"
 	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
 )
 
 func GetMasterEndpoint(addresses []platformv1.ClusterAddress) (string, error) {
@@ -110,3 +114,31 @@ func CleanFlannelInterfaces(cni string) error {
 	}
 	return err
 }
+
+// VerifyKubernetesNodePackage verifies the kubernetes-node package for the arch
+// of node against the digest recorded in the version catalog of platform.
+// Nothing is verified if the catalog doesn't record the digest.
+func VerifyKubernetesNodePackage(ctx context.Context, platformClient platformv1client.PlatformV1Interface, s ssh.Interface, version string) error {
+	if platformClient == nil {
+		return nil
+	}
+	client, err := platformutil.BuildExternalClientSetWithName(ctx, platformClient, "global")
+	if err != nil {
+		return err
+	}
+	catalog, err := platformutil.GetVersionCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+	catalogVersion, ok := catalog.Get(version)
+	if !ok {
+		return nil
+	}
+	arch := res.Arch(s)
+	digest, ok := catalogVersion.PackageDigests[arch]
+	if !ok {
+		return nil
+	}
+
+	return res.KubernetesNode.Verify(arch, version, digest)
+}
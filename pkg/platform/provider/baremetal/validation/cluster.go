@@ -20,26 +20,54 @@ package validation
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
 	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/thoas/go-funk"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	auditv1 "k8s.io/apiserver/pkg/apis/audit/v1"
+	"sigs.k8s.io/yaml"
 	"tkestack.io/tke/api/platform"
 
 	netutils "k8s.io/utils/net"
 	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
 	csioperatorimage "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
+	galaxyimages "tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy/images"
 	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/gpu"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/sandbox"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/phases/tuning"
 	"tkestack.io/tke/pkg/platform/types"
 	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/platform/util/netcheck"
 	"tkestack.io/tke/pkg/platform/util/vendor"
 	"tkestack.io/tke/pkg/spec"
 	"tkestack.io/tke/pkg/util/ipallocator"
 	"tkestack.io/tke/pkg/util/validation"
 	utilvalidation "tkestack.io/tke/pkg/util/validation"
+	"tkestack.io/tke/pkg/util/version"
+)
+
+const (
+	maxEtcdQuotaBackendBytes     = 8 * 1024 * 1024 * 1024
+	maxEtcdElectionTimeout       = 50000
+	defaultEtcdHeartbeatInterval = 100
+	defaultEtcdElectionTimeout   = 1000
 )
 
 var (
@@ -63,6 +91,19 @@ func ValidatClusterSpec(platformClient platformv1client.PlatformV1Interface, clu
 	allErrs = append(allErrs, ValidateClusterProperty(spec, fldPath.Child("properties"))...)
 	allErrs = append(allErrs, ValidateClusterMachines(spec.Machines, fldPath.Child("machines"))...)
 	allErrs = append(allErrs, ValidateClusterFeature(spec, fldPath.Child("features"))...)
+	allErrs = append(allErrs, ValidateNetworkType(spec, fldPath)...)
+	if phase == platform.ClusterInitializing {
+		allErrs = append(allErrs, ValidateProviderAddonCompatibility(spec, fldPath.Child("version"))...)
+	}
+	if spec.ControlPlaneOverrides != nil {
+		allErrs = append(allErrs, ValidateControlPlaneOverrides(spec.ControlPlaneOverrides, fldPath.Child("controlPlaneOverrides"))...)
+	}
+	if spec.ContainerRegistries != nil {
+		allErrs = append(allErrs, ValidateContainerRegistries(spec.ContainerRegistries, fldPath.Child("containerRegistries"))...)
+	}
+	if spec.Etcd != nil && spec.Etcd.Local != nil {
+		allErrs = append(allErrs, ValidateLocalEtcd(spec.Etcd.Local, fldPath.Child("etcd", "local"))...)
+	}
 
 	return allErrs
 }
@@ -92,23 +133,132 @@ func ValidateClusterSpecVersion(platformClient platformv1client.PlatformV1Interf
 				err,
 				"current kubevendor is not supported to upgrade to input version"))
 		}
+		allErrs = append(allErrs, validateUpgradableVersion(platformClient, c, version, fldPath)...)
+		allErrs = append(allErrs, validateAddonCompatibility(platformClient, c, version, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// validateAddonCompatibility blocks the upgrade if any addon doesn't work with
+// the new version. The addon objects are upgraded to compatible versions during
+// cluster upgrade, so they only block if there is no compatible version.
+func validateAddonCompatibility(platformClient platformv1client.PlatformV1Interface, c *platformv1.Cluster, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// the addons installed by provider can't be upgraded
+	features := c.Spec.Features
+	csiOperatorVersion := ""
+	if features.CSIOperator != nil {
+		csiOperatorVersion = features.CSIOperator.Version
+	}
+	installed := providerAddons(c.Network() == platformv1.NetworkTypeGalaxy,
+		features.GPUType != nil && *features.GPUType == platformv1.GPUVirtual, csiOperatorVersion)
+	allErrs = append(allErrs, validateInstalledAddons(installed, version, fldPath)...)
+
+	addons, err := compatibility.ListClusterAddons(context.Background(), platformClient, c.Name)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+		return allErrs
+	}
+	for _, addon := range addons {
+		if compatibility.Check(addon.Addon, addon.Version, version) == nil {
+			continue
+		}
+		_, ok, err := compatibility.LatestCompatible(addon.Addon, version)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		} else if !ok {
+			allErrs = append(allErrs, field.Invalid(fldPath, version,
+				fmt.Sprintf("no version of %s %s works with kubernetes %s", addon.Addon, addon.Name, version)))
+		}
 	}
 
 	return allErrs
 }
 
+// ValidateProviderAddonCompatibility validates the addons installed by provider
+// work with the kubernetes version of the cluster to be created.
+func ValidateProviderAddonCompatibility(spec *platform.ClusterSpec, fldPath *field.Path) field.ErrorList {
+	csiOperatorVersion := ""
+	if spec.Features.CSIOperator != nil {
+		csiOperatorVersion = spec.Features.CSIOperator.Version
+	}
+	installed := providerAddons(networkType(spec) == platform.NetworkTypeGalaxy,
+		spec.Features.GPUType != nil && *spec.Features.GPUType == platform.GPUVirtual, csiOperatorVersion)
+	return validateInstalledAddons(installed, spec.Version, fldPath)
+}
+
+// providerAddons returns the addons installed by provider with the cluster,
+// which are installed at the versions shipped with the provider.
+func providerAddons(galaxy bool, virtualGPU bool, csiOperatorVersion string) []compatibility.ClusterAddon {
+	var installed []compatibility.ClusterAddon
+	if galaxy {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.Galaxy, Version: galaxyimages.LatestVersion})
+	}
+	if virtualGPU {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.GPUManager, Version: images.Get().GPUManager.Tag})
+	}
+	if csiOperatorVersion != "" {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.CSIOperator, Version: csiOperatorVersion})
+	}
+	return installed
+}
+
+func validateInstalledAddons(installed []compatibility.ClusterAddon, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, addon := range installed {
+		if err := compatibility.Check(addon.Addon, addon.Version, version); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, version, err.Error()))
+		}
+	}
+	return allErrs
+}
+
 func getK8sValidVersions(platformClient platformv1client.PlatformV1Interface, clsName string) (validVersions []string, err error) {
-	k8sValidVersions := []string{}
 	if clsName == "global" || platformClient == nil {
 		return spec.K8sVersions, nil
 	}
+	catalog, err := getVersionCatalog(platformClient)
+	if err != nil {
+		return []string{}, err
+	}
+
+	return catalog.Installable(), nil
+}
+
+func getVersionCatalog(platformClient platformv1client.PlatformV1Interface) (*util.VersionCatalog, error) {
 	client, err := util.BuildExternalClientSetWithName(context.Background(), platformClient, "global")
 	if err != nil {
-		return k8sValidVersions, err
+		return nil, err
+	}
+
+	return util.GetVersionCatalog(context.Background(), client)
+}
+
+// validateUpgradableVersion validates the version can be upgraded to from the
+// current version of cluster according to the version catalog.
+func validateUpgradableVersion(platformClient platformv1client.PlatformV1Interface, c *platformv1.Cluster, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if c.Name == "global" || c.Status.Version == "" || c.Status.Version == version {
+		return allErrs
+	}
+
+	catalog, err := getVersionCatalog(platformClient)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+		return allErrs
+	}
+	upgradableVersions, err := catalog.Upgradable(c.Status.Version)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(fldPath, err))
+		return allErrs
+	}
+	if !funk.ContainsString(upgradableVersions, version) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, version, upgradableVersions))
 	}
-	_, k8sValidVersions, err = util.GetPlatformVersionsFromClusterInfo(context.Background(), client)
 
-	return k8sValidVersions, err
+	return allErrs
 }
 
 func validateKubevendor(srcKubevendor, dstKubevendor platformv1.KubeVendorType) (err error) {
@@ -256,7 +406,534 @@ func ValidateClusterFeature(spec *platform.ClusterSpec, fldPath *field.Path) fie
 	if features.IPVS != nil {
 		allErrs = append(allErrs, ValidateIPVS(spec, features.IPVS, fldPath.Child("ipvs"))...)
 	}
+	if features.SandboxRuntime != nil {
+		allErrs = append(allErrs, ValidateSandboxRuntime(features.SandboxRuntime, fldPath.Child("sandboxRuntime"))...)
+	}
+	if features.NetworkEncryption != nil {
+		allErrs = append(allErrs, ValidateNetworkEncryption(spec, features.NetworkEncryption, fldPath.Child("networkEncryption"))...)
+	}
+	if features.Audit != nil {
+		allErrs = append(allErrs, ValidateClusterAudit(features.Audit, fldPath.Child("audit"))...)
+	}
+	allErrs = append(allErrs, ValidateUpgradeStrategy(&features.Upgrade.Strategy, fldPath.Child("upgrade", "strategy"))...)
+	if features.EtcdBackup != nil {
+		allErrs = append(allErrs, ValidateEtcdBackup(spec, features.EtcdBackup, fldPath.Child("etcdBackup"))...)
+	}
+	if features.SecretsEncryption != nil {
+		allErrs = append(allErrs, ValidateSecretsEncryption(features.SecretsEncryption, fldPath.Child("secretsEncryption"))...)
+	}
+	if features.CertificateRotation != nil {
+		allErrs = append(allErrs, ValidateCertificateRotation(features.CertificateRotation, fldPath.Child("certificateRotation"))...)
+	}
+	if features.CgroupDriver != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(features.CgroupDriver, fldPath.Child("cgroupDriver"),
+			[]string{platform.CgroupDriverSystemd, platform.CgroupDriverCgroupfs})...)
+	}
+	if features.SystemTuning != nil {
+		allErrs = append(allErrs, ValidateSystemTuning(features.SystemTuning, fldPath.Child("systemTuning"))...)
+	}
+	if features.TimeSync != nil {
+		allErrs = append(allErrs, ValidateTimeSync(features.TimeSync, fldPath.Child("timeSync"))...)
+	}
+	if features.ServiceOverrides != nil {
+		allErrs = append(allErrs, ValidateServiceOverrides(features.ServiceOverrides, fldPath.Child("serviceOverrides"))...)
+	}
+	if features.GalaxyNetwork != nil {
+		allErrs = append(allErrs, ValidateGalaxyNetwork(spec, features.GalaxyNetwork, fldPath.Child("galaxyNetwork"))...)
+	}
+	if features.Calico != nil {
+		allErrs = append(allErrs, ValidateCalicoNetwork(features.Calico, fldPath.Child("calico"))...)
+	}
+	if features.ImageAdmission != nil {
+		allErrs = append(allErrs, ValidateImageAdmission(features.ImageAdmission, fldPath.Child("imageAdmission"))...)
+	}
+	if features.KubeProxy != nil {
+		allErrs = append(allErrs, ValidateKubeProxy(spec, features.KubeProxy, fldPath.Child("kubeProxy"))...)
+	}
+	if features.DNS != nil {
+		allErrs = append(allErrs, ValidateClusterDNS(spec, features.DNS, fldPath.Child("dns"))...)
+	}
+	if features.NetworkPolicy != nil {
+		allErrs = append(allErrs, ValidateNetworkPolicyFeature(features.NetworkPolicy, fldPath.Child("networkPolicy"))...)
+	}
+	if features.GPUManager != nil {
+		allErrs = append(allErrs, ValidateGPUManagerFeature(spec, features.GPUManager, fldPath.Child("gpuManager"))...)
+	}
+	if features.MIGStrategy != "" {
+		allErrs = append(allErrs, ValidateMIGStrategy(spec, features.MIGStrategy, fldPath.Child("migStrategy"))...)
+	}
+
+	return allErrs
+}
+
+// networkType returns the network type of cluster spec, which falls back to
+// Cilium or Galaxy by EnableCilium if not set.
+func networkType(spec *platform.ClusterSpec) platform.NetworkType {
+	if spec.NetworkType != "" {
+		return spec.NetworkType
+	}
+	if spec.Features.EnableCilium {
+		return platform.NetworkTypeCilium
+	}
+	return platform.NetworkTypeGalaxy
+}
+
+// ValidateNetworkType validates the network type of cluster and the network
+// options in features, which must match the network type.
+func ValidateNetworkType(spec *platform.ClusterSpec, specPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := specPath.Child("features")
+	if spec.NetworkType != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(spec.NetworkType), specPath.Child("networkType"),
+			[]string{string(platform.NetworkTypeGalaxy), string(platform.NetworkTypeCilium), string(platform.NetworkTypeCalico)})...)
+		if spec.Features.EnableCilium && spec.NetworkType != platform.NetworkTypeCilium {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("enableCilium"), spec.Features.EnableCilium, "must be false unless networkType is Cilium"))
+		}
+	}
+
+	network := networkType(spec)
+	if spec.Features.GalaxyNetwork != nil && network != platform.NetworkTypeGalaxy {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("galaxyNetwork"), "only supported when networkType is Galaxy"))
+	}
+	if spec.Features.Calico != nil && network != platform.NetworkTypeCalico {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("calico"), "only supported when networkType is Calico"))
+	}
+	if spec.Features.Cilium != nil && network != platform.NetworkTypeCilium {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cilium"), "only supported when networkType is Cilium"))
+	}
+	// kube-proxy is not installed if cilium replaces it
+	if spec.Features.Cilium != nil && spec.Features.Cilium.KubeProxyReplacement && spec.Features.IPVS != nil && *spec.Features.IPVS {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipvs"), *spec.Features.IPVS, "must be false when cilium replaces kube-proxy"))
+	}
+	return allErrs
+}
+
+// ValidateImageAdmission validates the mode, registry prefixes and exempt
+// namespaces of image admission.
+func ValidateImageAdmission(admission *platform.ImageAdmission, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if admission.Mode != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(admission.Mode), fldPath.Child("mode"),
+			[]string{string(platform.ImageAdmissionEnforce), string(platform.ImageAdmissionWarn)})...)
+	}
+	if len(admission.AllowedRegistries) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("allowedRegistries"), "at least one registry must be allowed"))
+	}
+	allErrs = append(allErrs, validateRegistryPrefixes(admission.AllowedRegistries, fldPath.Child("allowedRegistries"))...)
+	projects := sets.NewString()
+	for i, project := range admission.Projects {
+		idxPath := fldPath.Child("projects").Index(i)
+		if project.ProjectName == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("projectName"), ""))
+		} else if projects.Has(project.ProjectName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("projectName"), project.ProjectName))
+		}
+		projects.Insert(project.ProjectName)
+		allErrs = append(allErrs, validateRegistryPrefixes(project.AllowedRegistries, idxPath.Child("allowedRegistries"))...)
+	}
+	for i, ns := range admission.ExemptNamespaces {
+		for _, msg := range k8svalidation.IsDNS1123Label(ns) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("exemptNamespaces").Index(i), ns, msg))
+		}
+	}
+	return allErrs
+}
+
+func validateRegistryPrefixes(prefixes []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, prefix := range prefixes {
+		if prefix == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+		} else if strings.Contains(prefix, "://") || strings.ContainsAny(prefix, " \t\n") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), prefix, "must be an image prefix without scheme such as registry.example.com/"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateCalicoNetwork validates the routing mode, AS number and MTU of Calico.
+func ValidateCalicoNetwork(network *platform.CalicoNetwork, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if network.Mode != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(network.Mode), fldPath.Child("mode"),
+			[]string{string(platform.CalicoModeBGP), string(platform.CalicoModeVXLAN)})...)
+	}
+	if network.ASNumber < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("asNumber"), network.ASNumber, "must be positive"))
+	} else if network.ASNumber != 0 && network.Mode != platform.CalicoModeBGP {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("asNumber"), network.ASNumber, "only supported in BGP mode"))
+	}
+	if network.MTU != 0 && (network.MTU < 576 || network.MTU > 9000) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mtu"), network.MTU, "must be between 576 and 9000"))
+	}
+	return allErrs
+}
+
+var (
+	sysctlKeyRegexp    = regexp.MustCompile(`^[a-z0-9_-]+([./][a-z0-9_-]+)+$`)
+	envNameRegexp      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	netDeviceRegexp    = regexp.MustCompile(`^[a-zA-Z0-9_.:-]{1,15}$`)
+	kernelModuleRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+	ulimitNames        = sets.NewString("core", "data", "fsize", "memlock", "nofile", "rss", "stack", "cpu",
+		"nproc", "as", "maxlogins", "maxsyslogins", "priority", "locks", "sigpending", "msgqueue", "nice", "rtprio")
+)
+
+// ValidateSystemTuning validates the kernel parameters, kernel modules, ulimits
+// and transparent hugepage setting, which are rendered into files and commands on nodes.
+func ValidateSystemTuning(systemTuning *platform.SystemTuning, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for key, value := range systemTuning.Sysctls {
+		if !sysctlKeyRegexp.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(key), key, "must be a kernel parameter such as net.core.somaxconn"))
+		}
+		if strings.TrimSpace(value) == "" || strings.ContainsAny(value, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(key), value, "must be a non-empty single line value"))
+		}
+	}
+	moduleSet := sets.NewString()
+	for i, module := range systemTuning.KernelModules {
+		if !kernelModuleRegexp.MatchString(module) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("kernelModules").Index(i), module, "must be a kernel module name"))
+		}
+		if moduleSet.Has(module) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("kernelModules").Index(i), module))
+		}
+		moduleSet.Insert(module)
+	}
+	nameSet := sets.NewString()
+	for i, ulimit := range systemTuning.Ulimits {
+		idxPath := fldPath.Child("ulimits").Index(i)
+		if !ulimitNames.Has(ulimit.Name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), ulimit.Name, ulimitNames.List()))
+		}
+		if nameSet.Has(ulimit.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), ulimit.Name))
+		}
+		nameSet.Insert(ulimit.Name)
+		soft, softErrs := validateUlimitValue(ulimit.Soft, idxPath.Child("soft"))
+		hard, hardErrs := validateUlimitValue(ulimit.Hard, idxPath.Child("hard"))
+		allErrs = append(allErrs, softErrs...)
+		allErrs = append(allErrs, hardErrs...)
+		if len(softErrs) == 0 && len(hardErrs) == 0 && hard >= 0 && (soft < 0 || soft > hard) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("soft"), ulimit.Soft, "must be less than or equal to hard"))
+		}
+	}
+	if systemTuning.TransparentHugepage != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(systemTuning.TransparentHugepage, fldPath.Child("transparentHugepage"),
+			[]string{tuning.TransparentHugepageAlways, tuning.TransparentHugepageMadvise, tuning.TransparentHugepageNever})...)
+	}
+
+	return allErrs
+}
+
+// ValidateTimeSync validates the NTP servers which are rendered into chrony
+// config, and the max clock skew.
+func ValidateTimeSync(timeSync *platform.TimeSync, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(timeSync.Servers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("servers"), "must specify at least one NTP server"))
+	}
+	serverSet := sets.NewString()
+	for i, server := range timeSync.Servers {
+		if net.ParseIP(server) == nil && len(k8svalidation.IsDNS1123Subdomain(server)) != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("servers").Index(i), server, "must be an IP or a domain name"))
+		}
+		if serverSet.Has(server) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("servers").Index(i), server))
+		}
+		serverSet.Insert(server)
+	}
+	if timeSync.MaxSkewSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSkewSeconds"), timeSync.MaxSkewSeconds, "must be greater than or equal to 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateServiceOverrides validates the environment variables and drop-ins
+// which are rendered into systemd drop-in files of kubelet and container runtime.
+func ValidateServiceOverrides(overrides *platform.ServiceOverrides, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if overrides.Kubelet != nil {
+		allErrs = append(allErrs, validateServiceOverride(overrides.Kubelet, fldPath.Child("kubelet"))...)
+	}
+	if overrides.ContainerRuntime != nil {
+		allErrs = append(allErrs, validateServiceOverride(overrides.ContainerRuntime, fldPath.Child("containerRuntime"))...)
+	}
+
+	return allErrs
+}
+
+func validateServiceOverride(override *platform.ServiceOverride, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for name, value := range override.Env {
+		if !envNameRegexp.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("env").Key(name), name, "must be an environment variable name such as HTTP_PROXY"))
+		}
+		if strings.ContainsAny(value, "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("env").Key(name), value, "must be a single line value"))
+		}
+	}
+	for i, line := range strings.Split(override.DropIn, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("dropIn"), line, fmt.Sprintf("line %d must be a section header such as [Service]", i+1)))
+			}
+			continue
+		}
+		if !strings.Contains(line, "=") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dropIn"), line, fmt.Sprintf("line %d must be a directive such as ExecStartPre=/bin/true", i+1)))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateGalaxyNetwork validates the underlay device and floating IP pools of
+// Galaxy, the IP ranges of pools must not overlap each other.
+func ValidateGalaxyNetwork(spec *platform.ClusterSpec, network *platform.GalaxyNetwork, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if network.Device != "" && !netDeviceRegexp.MatchString(network.Device) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("device"), network.Device, "must be a network interface name such as eth1 or bond0"))
+	}
+
+	cidrs := []string{spec.ClusterCIDR}
+	if spec.ServiceCIDR != nil {
+		cidrs = append(cidrs, *spec.ServiceCIDR)
+	}
+	var clusterCIDRs []*net.IPNet
+	for _, cidr := range cidrs {
+		if _, ipNet, err := net.ParseCIDR(cidr); err == nil {
+			clusterCIDRs = append(clusterCIDRs, ipNet)
+		}
+	}
+	type ipRange struct {
+		value      string
+		start, end uint32
+	}
+	var ranges []ipRange
+	for i, pool := range network.FloatingIPPools {
+		idxPath := fldPath.Child("floatingIPPools").Index(i)
+		_, subnet, err := net.ParseCIDR(pool.Subnet)
+		if err != nil || subnet.IP.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("subnet"), pool.Subnet, "must be an IPv4 CIDR"))
+			continue
+		}
+		for _, cidr := range clusterCIDRs {
+			if cidr.Contains(subnet.IP) || subnet.Contains(cidr.IP) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("subnet"), pool.Subnet, fmt.Sprintf("must not overlap with %s of cluster", cidr)))
+			}
+		}
+		if pool.NodeSubnet != "" {
+			if _, _, err := net.ParseCIDR(pool.NodeSubnet); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("nodeSubnet"), pool.NodeSubnet, "must be a CIDR"))
+			}
+		}
+		gateway := net.ParseIP(pool.Gateway)
+		if gateway == nil || !subnet.Contains(gateway) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("gateway"), pool.Gateway, "must be an IP in subnet"))
+		}
+		if pool.VLAN < 0 || pool.VLAN > 4094 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("vlan"), pool.VLAN, "must be between 0 and 4094"))
+		}
+		if len(pool.IPs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ips"), "must specify at least one IP"))
+		}
+		for j, value := range pool.IPs {
+			ipPath := idxPath.Child("ips").Index(j)
+			bounds := strings.SplitN(value, "~", 2)
+			start, end := net.ParseIP(bounds[0]), net.ParseIP(bounds[len(bounds)-1])
+			if start == nil || end == nil || !subnet.Contains(start) || !subnet.Contains(end) {
+				allErrs = append(allErrs, field.Invalid(ipPath, value, "must be an IP or an IP range such as 10.0.0.20~10.0.0.100 in subnet"))
+				continue
+			}
+			r := ipRange{value: value, start: binary.BigEndian.Uint32(start.To4()), end: binary.BigEndian.Uint32(end.To4())}
+			if r.start > r.end {
+				allErrs = append(allErrs, field.Invalid(ipPath, value, "the start IP must not be greater than the end IP"))
+				continue
+			}
+			if gateway != nil && gateway.To4() != nil {
+				if g := binary.BigEndian.Uint32(gateway.To4()); g >= r.start && g <= r.end {
+					allErrs = append(allErrs, field.Invalid(ipPath, value, "must not contain the gateway"))
+				}
+			}
+			for _, other := range ranges {
+				if r.start <= other.end && other.start <= r.end {
+					allErrs = append(allErrs, field.Invalid(ipPath, value, fmt.Sprintf("overlaps with %s", other.value)))
+				}
+			}
+			ranges = append(ranges, r)
+		}
+	}
+
+	return allErrs
+}
+
+// validateUlimitValue returns -1 for unlimited values.
+func validateUlimitValue(value string, fldPath *field.Path) (int64, field.ErrorList) {
+	allErrs := field.ErrorList{}
+	if value == "unlimited" || value == "infinity" || value == "-1" {
+		return -1, allErrs
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be a non-negative integer or unlimited"))
+	}
+	return n, allErrs
+}
+
+// ValidateContainerRegistries validates the registries of container runtime.
+func ValidateContainerRegistries(registries *platform.ContainerRegistryConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, mirror := range registries.Mirrors {
+		u, err := url.Parse(mirror)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("mirrors").Index(i), mirror, "must be a http or https url"))
+		}
+	}
+	for i, registry := range registries.InsecureRegistries {
+		allErrs = append(allErrs, validateRegistryAddress(registry, fldPath.Child("insecureRegistries").Index(i))...)
+	}
+	registrySet := sets.NewString()
+	for i, auth := range registries.Auths {
+		idxPath := fldPath.Child("auths").Index(i)
+		allErrs = append(allErrs, validateRegistryAddress(auth.Registry, idxPath.Child("registry"))...)
+		if registrySet.Has(auth.Registry) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("registry"), auth.Registry))
+		}
+		registrySet.Insert(auth.Registry)
+		if auth.Username == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("username"), ""))
+		}
+	}
+	return allErrs
+}
+
+// validateRegistryAddress validates the registry address in the form of host[:port].
+func validateRegistryAddress(registry string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if registry == "" {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+	host := registry
+	if h, _, err := net.SplitHostPort(registry); err == nil {
+		host = h
+	}
+	if net.ParseIP(host) == nil && len(k8svalidation.IsDNS1123Subdomain(host)) != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, registry, "must be a host or ip with optional port"))
+	}
+	return allErrs
+}
+
+// reservedVolumeNames are the volumes of static pods managed by kubeadm and platform.
+var reservedVolumeNames = sets.NewString("k8s-certs", "ca-certs", "kubeconfig", "etc-pki", "etc-ca-certificates",
+	"usr-share-ca-certificates", "usr-local-share-ca-certificates", "flexvolume-dir", "etcd-data", "etcd-certs",
+	"audit-log", "kms-socket")
+
+func ValidateControlPlaneOverrides(overrides *platform.ControlPlaneOverrides, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, one := range []struct {
+		component string
+		field     string
+		override  *platform.StaticPodOverride
+	}{
+		{"kube-apiserver", "apiServer", overrides.APIServer},
+		{"kube-controller-manager", "controllerManager", overrides.ControllerManager},
+		{"kube-scheduler", "scheduler", overrides.Scheduler},
+		{"etcd", "etcd", overrides.Etcd},
+	} {
+		if one.override != nil {
+			allErrs = append(allErrs, ValidateStaticPodOverride(one.component, one.override, fldPath.Child(one.field))...)
+		}
+	}
+	return allErrs
+}
+
+// ValidateStaticPodOverride validates the override of static pod, which can't
+// refer to any object in cluster because kubelet creates it without apiserver.
+func ValidateStaticPodOverride(component string, override *platform.StaticPodOverride, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	volumes := sets.NewString()
+	for i, volume := range override.ExtraVolumes {
+		idxPath := fldPath.Child("extraVolumes").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Label(volume.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), volume.Name, msg))
+		}
+		if reservedVolumeNames.Has(volume.Name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("%s is reserved", volume.Name)))
+		}
+		if volumes.Has(volume.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), volume.Name))
+		}
+		volumes.Insert(volume.Name)
+		if (volume.HostPath == nil) == (volume.EmptyDir == nil) {
+			allErrs = append(allErrs, field.Invalid(idxPath, volume.Name, "must be either hostPath or emptyDir"))
+		} else if volume.HostPath != nil && !path.IsAbs(volume.HostPath.Path) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("hostPath", "path"), volume.HostPath.Path, "must be an absolute path"))
+		}
+	}
+
+	allErrs = append(allErrs, validateStaticPodVolumeMounts(override.ExtraVolumeMounts, fldPath.Child("extraVolumeMounts"))...)
+	allErrs = append(allErrs, validateStaticPodEnv(override.ExtraEnv, fldPath.Child("extraEnv"))...)
+
+	sidecars := sets.NewString(component)
+	for i, sidecar := range override.Sidecars {
+		idxPath := fldPath.Child("sidecars").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Label(sidecar.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sidecar.Name, msg))
+		}
+		if sidecars.Has(sidecar.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sidecar.Name))
+		}
+		sidecars.Insert(sidecar.Name)
+		if sidecar.Image == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("image"), ""))
+		}
+		if len(sidecar.EnvFrom) != 0 {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("envFrom"), "not supported by static pod"))
+		}
+		allErrs = append(allErrs, validateStaticPodVolumeMounts(sidecar.VolumeMounts, idxPath.Child("volumeMounts"))...)
+		allErrs = append(allErrs, validateStaticPodEnv(sidecar.Env, idxPath.Child("env"))...)
+	}
+
+	return allErrs
+}
+
+func validateStaticPodVolumeMounts(mounts []corev1.VolumeMount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	mountPaths := sets.NewString()
+	for i, mount := range mounts {
+		idxPath := fldPath.Index(i)
+		if mount.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), ""))
+		}
+		if !path.IsAbs(mount.MountPath) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("mountPath"), mount.MountPath, "must be an absolute path"))
+		}
+		if mountPaths.Has(mount.MountPath) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("mountPath"), mount.MountPath))
+		}
+		mountPaths.Insert(mount.MountPath)
+	}
+	return allErrs
+}
 
+func validateStaticPodEnv(env []corev1.EnvVar, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, one := range env {
+		idxPath := fldPath.Index(i)
+		for _, msg := range k8svalidation.IsEnvVarName(one.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), one.Name, msg))
+		}
+		if one.ValueFrom != nil && (one.ValueFrom.ConfigMapKeyRef != nil || one.ValueFrom.SecretKeyRef != nil) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("valueFrom"), "configMapKeyRef and secretKeyRef are not supported by static pod"))
+		}
+	}
 	return allErrs
 }
 
@@ -273,3 +950,376 @@ func ValidateIPVS(spec *platform.ClusterSpec, ipvs *bool, fldPath *field.Path) f
 	}
 	return allErrs
 }
+
+var ipvsSchedulers = []string{"rr", "wrr", "lc", "wlc", "lblc", "lblcr", "dh", "sh", "sed", "nq"}
+
+// ValidateKubeProxy validates the proxy mode, IPVS scheduler and strict ARP of
+// kube-proxy, the IPVS options are only supported in ipvs mode.
+func ValidateKubeProxy(spec *platform.ClusterSpec, kubeProxy *platform.KubeProxy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	ipvs := spec.Features.IPVS != nil && *spec.Features.IPVS
+	mode := kubeProxy.Mode
+	if mode == "" {
+		mode = platform.KubeProxyModeIPTables
+		if ipvs {
+			mode = platform.KubeProxyModeIPVS
+		}
+	}
+	if kubeProxy.Mode != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(kubeProxy.Mode), fldPath.Child("mode"),
+			[]string{string(platform.KubeProxyModeIPTables), string(platform.KubeProxyModeIPVS), string(platform.KubeProxyModeNFTables)})...)
+	}
+	if ipvs && mode != platform.KubeProxyModeIPVS {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), kubeProxy.Mode, "must be ipvs when ipvs feature is enabled"))
+	}
+	if mode == platform.KubeProxyModeIPVS && spec.ServiceCIDR == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), kubeProxy.Mode, "ServiceCIDR is required in ipvs mode"))
+	}
+	if mode == platform.KubeProxyModeNFTables && spec.Version != "" && version.Compare(spec.Version, "1.29.0") < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mode"), kubeProxy.Mode, "nftables mode is supported since kubernetes 1.29"))
+	}
+	if kubeProxy.IPVSScheduler != "" {
+		if mode != platform.KubeProxyModeIPVS {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipvsScheduler"), "only supported in ipvs mode"))
+		} else {
+			allErrs = append(allErrs, utilvalidation.ValidateEnum(kubeProxy.IPVSScheduler, fldPath.Child("ipvsScheduler"), ipvsSchedulers)...)
+		}
+	}
+	if kubeProxy.StrictARP && mode != platform.KubeProxyModeIPVS {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("strictARP"), "only supported in ipvs mode"))
+	}
+	// kube-proxy is not installed if cilium replaces it
+	if spec.Features.Cilium != nil && spec.Features.Cilium.KubeProxyReplacement {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "not supported when cilium replaces kube-proxy"))
+	}
+	return allErrs
+}
+
+const maxDNSCacheTTL = 3600
+
+// ValidateNetworkPolicyFeature validates the namespaces of default-deny
+// NetworkPolicy, the system namespaces are not allowed since the denied
+// traffic breaks the cluster.
+func ValidateNetworkPolicyFeature(feature *platform.NetworkPolicyFeature, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	reserved := sets.NewString(metav1.NamespaceSystem, metav1.NamespacePublic, corev1.NamespaceNodeLease, netcheck.PolicyNamespace)
+	namespaces := sets.NewString()
+	for i, namespace := range feature.DefaultDenyNamespaces {
+		idxPath := fldPath.Child("defaultDenyNamespaces").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Label(namespace) {
+			allErrs = append(allErrs, field.Invalid(idxPath, namespace, msg))
+		}
+		if reserved.Has(namespace) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, fmt.Sprintf("namespace %s is reserved", namespace)))
+		}
+		if namespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, namespace))
+		}
+		namespaces.Insert(namespace)
+	}
+
+	return allErrs
+}
+
+// ValidateGPUManagerFeature validates the share policy, memory unit and node
+// selector of GPUManager, which is only deployed for virtual GPU.
+func ValidateGPUManagerFeature(spec *platform.ClusterSpec, feature *platform.GPUManagerFeature, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.Features.GPUType == nil || *spec.Features.GPUType != platform.GPUVirtual {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only supported by virtual GPU"))
+	}
+	if feature.SharePolicy != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(feature.SharePolicy, fldPath.Child("sharePolicy"),
+			[]platform.GPUSharePolicy{platform.GPUShareFractional, platform.GPUShareExclusive})...)
+	}
+	if feature.MemoryUnitMiB < 0 || feature.MemoryUnitMiB%64 != 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("memoryUnitMiB"), feature.MemoryUnitMiB, "must be a non-negative multiple of 64"))
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(feature.NodeSelector, fldPath.Child("nodeSelector"))...)
+
+	return allErrs
+}
+
+// ValidateMIGStrategy validates the MIG strategy of NVIDIA device plugin, MIG
+// devices are only supported by physical GPU.
+func ValidateMIGStrategy(spec *platform.ClusterSpec, strategy platform.GPUMIGStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := utilvalidation.ValidateEnum(strategy, fldPath,
+		[]platform.GPUMIGStrategy{platform.GPUMIGStrategyNone, platform.GPUMIGStrategySingle, platform.GPUMIGStrategyMixed})
+	if strategy != platform.GPUMIGStrategyNone && (spec.Features.GPUType == nil || *spec.Features.GPUType != platform.GPUPhysical) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "only supported by physical GPU"))
+	}
+
+	return allErrs
+}
+
+// ValidateClusterDNS validates the stub domains, upstream nameservers, cache
+// TTL and autoscaler of CoreDNS.
+func ValidateClusterDNS(spec *platform.ClusterSpec, dns *platform.ClusterDNS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	domains := sets.NewString()
+	for i, stub := range dns.StubDomains {
+		idxPath := fldPath.Child("stubDomains").Index(i)
+		for _, msg := range k8svalidation.IsDNS1123Subdomain(stub.Domain) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("domain"), stub.Domain, msg))
+		}
+		if stub.Domain == spec.DNSDomain {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("domain"), stub.Domain, "must not be the cluster domain"))
+		}
+		if domains.Has(stub.Domain) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("domain"), stub.Domain))
+		}
+		domains.Insert(stub.Domain)
+		if len(stub.Nameservers) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("nameservers"), "at least one nameserver is required"))
+		}
+		allErrs = append(allErrs, validateNameservers(stub.Nameservers, idxPath.Child("nameservers"))...)
+	}
+	allErrs = append(allErrs, validateNameservers(dns.UpstreamNameservers, fldPath.Child("upstreamNameservers"))...)
+	if dns.CacheTTL < 0 || dns.CacheTTL > maxDNSCacheTTL {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheTTL"), dns.CacheTTL, fmt.Sprintf("must be between 0 and %d", maxDNSCacheTTL)))
+	}
+	if autoscaler := dns.Autoscaler; autoscaler != nil {
+		asPath := fldPath.Child("autoscaler")
+		for _, f := range []struct {
+			name  string
+			value int32
+		}{
+			{"coresPerReplica", autoscaler.CoresPerReplica},
+			{"nodesPerReplica", autoscaler.NodesPerReplica},
+			{"min", autoscaler.Min},
+			{"max", autoscaler.Max},
+		} {
+			if f.value < 0 {
+				allErrs = append(allErrs, field.Invalid(asPath.Child(f.name), f.value, "must be non-negative"))
+			}
+		}
+		if autoscaler.Max > 0 && autoscaler.Max < autoscaler.Min {
+			allErrs = append(allErrs, field.Invalid(asPath.Child("max"), autoscaler.Max, "must not be less than min"))
+		}
+	}
+	return allErrs
+}
+
+// validateNameservers validates the nameservers are IP addresses with optional
+// ports.
+func validateNameservers(nameservers []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, nameserver := range nameservers {
+		host := nameserver
+		if h, port, err := net.SplitHostPort(nameserver); err == nil {
+			host = h
+			n, _ := strconv.Atoi(port)
+			for _, msg := range k8svalidation.IsValidPortNum(n) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), nameserver, msg))
+			}
+		}
+		if net.ParseIP(host) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), nameserver, "must be an IP address with optional port"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateSandboxRuntime(sandboxRuntime *platform.SandboxRuntime, fldPath *field.Path) field.ErrorList {
+	allErrs := utilvalidation.ValidateEnum(string(sandboxRuntime.Type), fldPath.Child("type"), sandbox.Types())
+	for i, ns := range sandboxRuntime.UntrustedNamespaces {
+		for _, msg := range k8svalidation.IsDNS1123Label(ns) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("untrustedNamespaces").Index(i), ns, msg))
+		}
+	}
+	return allErrs
+}
+
+func ValidateNetworkEncryption(spec *platform.ClusterSpec, encryption *platform.NetworkEncryption, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// cilium encrypts traffic by IPsec, and galaxy by WireGuard
+	switch networkType(spec) {
+	case platform.NetworkTypeGalaxy:
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(encryption.Type), fldPath.Child("type"),
+			[]string{string(platform.NetworkEncryptionWireGuard)})...)
+	case platform.NetworkTypeCilium:
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(encryption.Type), fldPath.Child("type"),
+			[]string{string(platform.NetworkEncryptionIPsec)})...)
+	default:
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("not supported by %s", networkType(spec))))
+	}
+	if encryption.KeyRotationPeriod != "" {
+		period, err := time.ParseDuration(encryption.KeyRotationPeriod)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyRotationPeriod"), encryption.KeyRotationPeriod, err.Error()))
+		} else if period < time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyRotationPeriod"), encryption.KeyRotationPeriod, "must be at least 1h"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateSecretsEncryption(encryption *platform.SecretsEncryption, fldPath *field.Path) field.ErrorList {
+	allErrs := utilvalidation.ValidateEnum(string(encryption.Provider), fldPath.Child("provider"),
+		[]string{string(platform.SecretsEncryptionSecretbox), string(platform.SecretsEncryptionKMS)})
+	if encryption.Provider == platform.SecretsEncryptionKMS {
+		if encryption.KMS == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kms"), "kms is required for provider KMS"))
+		} else {
+			if encryption.KMS.Name == "" {
+				allErrs = append(allErrs, field.Required(fldPath.Child("kms", "name"), ""))
+			}
+			if !strings.HasPrefix(encryption.KMS.Endpoint, "unix://") {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("kms", "endpoint"), encryption.KMS.Endpoint, "must be a unix socket like unix:///var/run/kms.sock"))
+			}
+		}
+	}
+	if encryption.KeyRotationPeriod != "" {
+		period, err := time.ParseDuration(encryption.KeyRotationPeriod)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyRotationPeriod"), encryption.KeyRotationPeriod, err.Error()))
+		} else if period < time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("keyRotationPeriod"), encryption.KeyRotationPeriod, "must be at least 1h"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateCertificateRotation(rotation *platform.CertificateRotation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if rotation.Mode != "" {
+		allErrs = append(allErrs, utilvalidation.ValidateEnum(string(rotation.Mode), fldPath.Child("mode"),
+			[]string{string(platform.CertificateRotationAuto), string(platform.CertificateRotationManual)})...)
+	}
+	if rotation.RenewBefore != "" {
+		renewBefore, err := time.ParseDuration(rotation.RenewBefore)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), rotation.RenewBefore, err.Error()))
+		} else if renewBefore < time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), rotation.RenewBefore, "must be at least 1h"))
+		} else if renewBefore >= 365*24*time.Hour {
+			// kubeadm renews certificates for one year
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("renewBefore"), rotation.RenewBefore, "must be less than 8760h"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateLocalEtcd validates the tuning and maintenance of local etcd.
+func ValidateLocalEtcd(local *platform.LocalEtcd, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if local.QuotaBackendBytes != nil {
+		// etcd suggests no more than 8GiB
+		if *local.QuotaBackendBytes <= 0 || *local.QuotaBackendBytes > maxEtcdQuotaBackendBytes {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("quotaBackendBytes"), *local.QuotaBackendBytes,
+				fmt.Sprintf("must be greater than 0 and no more than %d", maxEtcdQuotaBackendBytes)))
+		}
+	}
+	var heartbeatInterval, electionTimeout int32 = defaultEtcdHeartbeatInterval, defaultEtcdElectionTimeout
+	if local.HeartbeatInterval != nil {
+		heartbeatInterval = *local.HeartbeatInterval
+		if heartbeatInterval <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("heartbeatInterval"), heartbeatInterval, "must be greater than 0"))
+		}
+	}
+	if local.ElectionTimeout != nil {
+		electionTimeout = *local.ElectionTimeout
+		if electionTimeout <= 0 || electionTimeout > maxEtcdElectionTimeout {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("electionTimeout"), electionTimeout,
+				fmt.Sprintf("must be greater than 0 and no more than %d", maxEtcdElectionTimeout)))
+		}
+	}
+	if electionTimeout < 5*heartbeatInterval {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("electionTimeout"), electionTimeout,
+			fmt.Sprintf("must be at least 5 times of heartbeat interval %d", heartbeatInterval)))
+	}
+	if local.DataDevice != "" && (!path.IsAbs(local.DataDevice) || !strings.HasPrefix(path.Clean(local.DataDevice), "/dev/")) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("dataDevice"), local.DataDevice, "must be a device under /dev"))
+	}
+	if local.Maintenance != nil && local.Maintenance.Interval != "" {
+		interval, err := time.ParseDuration(local.Maintenance.Interval)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenance", "interval"), local.Maintenance.Interval, err.Error()))
+		} else if interval < time.Hour {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maintenance", "interval"), local.Maintenance.Interval, "must be at least 1h"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateUpgradeStrategy(strategy *platform.UpgradeStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	// 100 is used to check the percentage only
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetValueFromIntOrPercent(strategy.MaxUnavailable, 100, false)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), err.Error()))
+		} else if maxUnavailable <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), "must be greater than 0"))
+		}
+	}
+	if strategy.MaxSurge != nil {
+		maxSurge, err := intstr.GetValueFromIntOrPercent(strategy.MaxSurge, 100, true)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), strategy.MaxSurge.String(), err.Error()))
+		} else if maxSurge < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), strategy.MaxSurge.String(), "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+func ValidateClusterAudit(audit *platform.ClusterAudit, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if audit.Policy != "" {
+		policy := auditv1.Policy{}
+		if err := yaml.UnmarshalStrict([]byte(audit.Policy), &policy); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("policy"), audit.Policy, err.Error()))
+		} else if policy.Kind != "Policy" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("policy"), policy.Kind, "kind must be Policy"))
+		} else if len(policy.Rules) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("policy", "rules"), "at least one rule is required"))
+		}
+	}
+	if audit.Log != nil {
+		logPath := fldPath.Child("log")
+		if audit.Log.Path != "" && !path.IsAbs(audit.Log.Path) {
+			allErrs = append(allErrs, field.Invalid(logPath.Child("path"), audit.Log.Path, "must be an absolute path"))
+		}
+		for _, v := range []struct {
+			name  string
+			value int32
+		}{{"maxAge", audit.Log.MaxAge}, {"maxBackup", audit.Log.MaxBackup}, {"maxSize", audit.Log.MaxSize}} {
+			if v.value < 0 {
+				allErrs = append(allErrs, field.Invalid(logPath.Child(v.name), v.value, "must be greater than or equal to 0"))
+			}
+		}
+	}
+	if audit.Webhook != nil && audit.Webhook.Address != "" {
+		if err := validation.IsURL(audit.Webhook.Address); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("webhook", "address"), audit.Webhook.Address, err.Error()))
+		}
+	}
+	if audit.Log == nil && audit.Webhook == nil {
+		allErrs = append(allErrs, field.Required(fldPath, "at least one of log and webhook backend is required"))
+	}
+	return allErrs
+}
+
+func ValidateEtcdBackup(spec *platform.ClusterSpec, backup *platform.EtcdBackup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if spec.Etcd != nil && spec.Etcd.External != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "etcd backup is not supported for external etcd"))
+	}
+	if backup.Path != "" && !path.IsAbs(backup.Path) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), backup.Path, "must be an absolute path"))
+	}
+	if backup.S3 != nil {
+		s3Path := fldPath.Child("s3")
+		if err := validation.IsURL(backup.S3.Endpoint); err != nil {
+			allErrs = append(allErrs, field.Invalid(s3Path.Child("endpoint"), backup.S3.Endpoint, err.Error()))
+		}
+		if backup.S3.Bucket == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("bucket"), ""))
+		}
+		if backup.S3.AccessKeyID == "" {
+			allErrs = append(allErrs, field.Required(s3Path.Child("accessKeyID"), ""))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,338 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package validation
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+func TestValidateUpgradeStrategy(t *testing.T) {
+	intOrStr := func(v intstr.IntOrString) *intstr.IntOrString { return &v }
+	tests := []struct {
+		name     string
+		strategy platform.UpgradeStrategy
+		wantErrs int
+	}{
+		{"unset", platform.UpgradeStrategy{}, 0},
+		{"valid", platform.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("20%")), MaxSurge: intOrStr(intstr.FromInt(1))}, 0},
+		{"zero max surge", platform.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromInt(1)), MaxSurge: intOrStr(intstr.FromInt(0))}, 0},
+		{"zero max unavailable", platform.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromInt(0))}, 1},
+		{"invalid max unavailable", platform.UpgradeStrategy{MaxUnavailable: intOrStr(intstr.FromString("one"))}, 1},
+		{"negative max surge", platform.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromInt(-1))}, 1},
+		{"invalid max surge", platform.UpgradeStrategy{MaxSurge: intOrStr(intstr.FromString("1"))}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateUpgradeStrategy(&tt.strategy, field.NewPath("strategy"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateUpgradeStrategy() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateProviderAddonCompatibility(t *testing.T) {
+	virtual := platform.GPUVirtual
+	tests := []struct {
+		name     string
+		spec     platform.ClusterSpec
+		wantErrs int
+	}{
+		{"galaxy", platform.ClusterSpec{Version: "1.20.4"}, 0},
+		{"galaxy too old", platform.ClusterSpec{Version: "1.10.0"}, 1},
+		{"galaxy not installed", platform.ClusterSpec{Version: "1.10.0", NetworkType: platform.NetworkTypeCalico}, 0},
+		{"gpu manager", platform.ClusterSpec{Version: "1.20.4", NetworkType: platform.NetworkTypeCalico,
+			Features: platform.ClusterFeature{GPUType: &virtual}}, 0},
+		{"gpu manager too new", platform.ClusterSpec{Version: "1.22.5", NetworkType: platform.NetworkTypeCalico,
+			Features: platform.ClusterFeature{GPUType: &virtual}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateProviderAddonCompatibility(&tt.spec, field.NewPath("spec", "version"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateProviderAddonCompatibility() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateServiceOverrides(t *testing.T) {
+	tests := []struct {
+		name      string
+		overrides platform.ServiceOverrides
+		wantErrs  int
+	}{
+		{"empty", platform.ServiceOverrides{}, 0},
+		{"valid", platform.ServiceOverrides{
+			Kubelet: &platform.ServiceOverride{
+				Env:    map[string]string{"HTTP_PROXY": "http://proxy:3128", "_no_proxy": "10.0.0.0/8"},
+				DropIn: "# comment\n; comment\n\nLimitNOFILE=65536\n[Unit]\nAfter=network-online.target",
+			},
+			ContainerRuntime: &platform.ServiceOverride{Env: map[string]string{"HTTPS_PROXY": "http://proxy:3128"}},
+		}, 0},
+		{"invalid env name", platform.ServiceOverrides{
+			Kubelet: &platform.ServiceOverride{Env: map[string]string{"1PROXY": "http://proxy:3128"}},
+		}, 1},
+		{"multiline env value", platform.ServiceOverrides{
+			ContainerRuntime: &platform.ServiceOverride{Env: map[string]string{"HTTP_PROXY": "http://proxy:3128\nExecStart="}},
+		}, 1},
+		{"invalid drop-in", platform.ServiceOverrides{
+			Kubelet: &platform.ServiceOverride{DropIn: "[Service\nLimitNOFILE"},
+		}, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateServiceOverrides(&tt.overrides, field.NewPath("serviceOverrides"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateServiceOverrides() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateGalaxyNetwork(t *testing.T) {
+	serviceCIDR := "10.96.0.0/16"
+	spec := &platform.ClusterSpec{ClusterCIDR: "172.16.0.0/16", ServiceCIDR: &serviceCIDR}
+	pool := func(subnet, gateway string, ips ...string) platform.FloatingIPPool {
+		return platform.FloatingIPPool{Subnet: subnet, Gateway: gateway, IPs: ips}
+	}
+	tests := []struct {
+		name     string
+		network  platform.GalaxyNetwork
+		wantErrs int
+	}{
+		{"empty", platform.GalaxyNetwork{}, 0},
+		{"valid", platform.GalaxyNetwork{
+			Device: "bond0.100",
+			FloatingIPPools: []platform.FloatingIPPool{
+				pool("10.1.0.0/24", "10.1.0.1", "10.1.0.10~10.1.0.20", "10.1.0.30"),
+				{Subnet: "10.2.0.0/24", NodeSubnet: "10.0.0.0/24", Gateway: "10.2.0.1", VLAN: 100, IPs: []string{"10.2.0.10"}},
+			},
+		}, 0},
+		{"invalid device", platform.GalaxyNetwork{Device: "eth0 eth1"}, 1},
+		{"ipv6 subnet", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{pool("fd00::/64", "fd00::1", "fd00::10")},
+		}, 1},
+		{"overlap with cluster cidr", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{pool("172.16.1.0/24", "172.16.1.1", "172.16.1.10")},
+		}, 1},
+		{"overlap with service cidr", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{pool("10.0.0.0/8", "10.1.0.1", "10.1.0.10")},
+		}, 1},
+		{"invalid pool", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{
+				{Subnet: "10.1.0.0/24", NodeSubnet: "10.0.0.0", Gateway: "10.2.0.1", VLAN: 4095},
+			},
+		}, 4},
+		{"invalid ips", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{
+				pool("10.1.0.0/24", "10.1.0.1", "10.2.0.10", "10.1.0.20~10.1.0.10", "10.1.0.1~10.1.0.5", "10.1.0.x"),
+			},
+		}, 4},
+		{"overlapped ips", platform.GalaxyNetwork{
+			FloatingIPPools: []platform.FloatingIPPool{
+				pool("10.1.0.0/24", "10.1.0.1", "10.1.0.10~10.1.0.20"),
+				pool("10.1.0.0/24", "10.1.0.1", "10.1.0.20~10.1.0.30"),
+			},
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateGalaxyNetwork(spec, &tt.network, field.NewPath("galaxyNetwork"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateGalaxyNetwork() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateNetworkEncryption(t *testing.T) {
+	tests := []struct {
+		name       string
+		spec       platform.ClusterSpec
+		encryption platform.NetworkEncryption
+		wantErrs   int
+	}{
+		{"wireguard on galaxy", platform.ClusterSpec{NetworkType: platform.NetworkTypeGalaxy}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard}, 0},
+		{"wireguard on default network", platform.ClusterSpec{}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard}, 0},
+		{"ipsec on galaxy", platform.ClusterSpec{NetworkType: platform.NetworkTypeGalaxy}, platform.NetworkEncryption{Type: platform.NetworkEncryptionIPsec}, 1},
+		{"ipsec on cilium", platform.ClusterSpec{NetworkType: platform.NetworkTypeCilium}, platform.NetworkEncryption{Type: platform.NetworkEncryptionIPsec}, 0},
+		{"ipsec on cilium feature", platform.ClusterSpec{Features: platform.ClusterFeature{EnableCilium: true}}, platform.NetworkEncryption{Type: platform.NetworkEncryptionIPsec}, 0},
+		{"wireguard on cilium", platform.ClusterSpec{NetworkType: platform.NetworkTypeCilium}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard}, 1},
+		{"calico", platform.ClusterSpec{NetworkType: platform.NetworkTypeCalico}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard}, 1},
+		{"rotation period", platform.ClusterSpec{}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard, KeyRotationPeriod: "24h"}, 0},
+		{"short rotation period", platform.ClusterSpec{}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard, KeyRotationPeriod: "30m"}, 1},
+		{"invalid rotation period", platform.ClusterSpec{}, platform.NetworkEncryption{Type: platform.NetworkEncryptionWireGuard, KeyRotationPeriod: "daily"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateNetworkEncryption(&tt.spec, &tt.encryption, field.NewPath("networkEncryption"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateNetworkEncryption() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateTimeSync(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeSync platform.TimeSync
+		wantErrs int
+	}{
+		{"valid", platform.TimeSync{Servers: []string{"10.0.0.1", "ntp.example.com"}, MaxSkewSeconds: 10}, 0},
+		{"no server", platform.TimeSync{}, 1},
+		{"invalid server", platform.TimeSync{Servers: []string{"ntp server"}}, 1},
+		{"duplicate server", platform.TimeSync{Servers: []string{"10.0.0.1", "10.0.0.1"}}, 1},
+		{"negative max skew", platform.TimeSync{Servers: []string{"10.0.0.1"}, MaxSkewSeconds: -1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateTimeSync(&tt.timeSync, field.NewPath("timeSync"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateTimeSync() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateClusterFeatureCgroupDriver(t *testing.T) {
+	tests := []struct {
+		name     string
+		driver   string
+		wantErrs int
+	}{
+		{"unset", "", 0},
+		{"systemd", platform.CgroupDriverSystemd, 0},
+		{"cgroupfs", platform.CgroupDriverCgroupfs, 0},
+		{"unknown", "cgroup", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &platform.ClusterSpec{Features: platform.ClusterFeature{CgroupDriver: tt.driver}}
+			errs := ValidateClusterFeature(spec, field.NewPath("features"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateClusterFeature() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateSystemTuning(t *testing.T) {
+	tests := []struct {
+		name     string
+		tuning   platform.SystemTuning
+		wantErrs int
+	}{
+		{"valid", platform.SystemTuning{
+			Sysctls:             map[string]string{"net.core.somaxconn": "32768", "net/ipv4/tcp_tw_reuse": "1"},
+			KernelModules:       []string{"nf_conntrack", "br-netfilter"},
+			Ulimits:             []platform.Ulimit{{Name: "nofile", Soft: "65536", Hard: "unlimited"}, {Name: "nproc", Soft: "-1", Hard: "infinity"}},
+			TransparentHugepage: "madvise",
+		}, 0},
+		{"invalid sysctl key", platform.SystemTuning{Sysctls: map[string]string{"somaxconn": "1"}}, 1},
+		{"multiline sysctl value", platform.SystemTuning{Sysctls: map[string]string{"net.core.somaxconn": "1\nkernel.panic = 1"}}, 1},
+		{"empty sysctl value", platform.SystemTuning{Sysctls: map[string]string{"net.core.somaxconn": " "}}, 1},
+		{"invalid kernel module", platform.SystemTuning{KernelModules: []string{"ip_vs; reboot"}}, 1},
+		{"duplicate kernel module", platform.SystemTuning{KernelModules: []string{"ip_vs", "ip_vs"}}, 1},
+		{"unknown ulimit", platform.SystemTuning{Ulimits: []platform.Ulimit{{Name: "files", Soft: "1", Hard: "1"}}}, 1},
+		{"duplicate ulimit", platform.SystemTuning{Ulimits: []platform.Ulimit{{Name: "nofile", Soft: "1", Hard: "1"}, {Name: "nofile", Soft: "2", Hard: "2"}}}, 1},
+		{"invalid ulimit value", platform.SystemTuning{Ulimits: []platform.Ulimit{{Name: "nofile", Soft: "many", Hard: "-2"}}}, 2},
+		{"soft above hard", platform.SystemTuning{Ulimits: []platform.Ulimit{{Name: "nofile", Soft: "2048", Hard: "1024"}}}, 1},
+		{"unlimited soft with hard", platform.SystemTuning{Ulimits: []platform.Ulimit{{Name: "nofile", Soft: "unlimited", Hard: "1024"}}}, 1},
+		{"invalid transparent hugepage", platform.SystemTuning{TransparentHugepage: "sometimes"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateSystemTuning(&tt.tuning, field.NewPath("systemTuning"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateSystemTuning() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateKubeProxy(t *testing.T) {
+	enabled := true
+	serviceCIDR := "10.96.0.0/16"
+	tests := []struct {
+		name      string
+		spec      platform.ClusterSpec
+		kubeProxy platform.KubeProxy
+		wantErrs  int
+	}{
+		{"default", platform.ClusterSpec{}, platform.KubeProxy{}, 0},
+		{"ipvs", platform.ClusterSpec{ServiceCIDR: &serviceCIDR}, platform.KubeProxy{Mode: platform.KubeProxyModeIPVS, IPVSScheduler: "wrr", StrictARP: true}, 0},
+		{"ipvs without service cidr", platform.ClusterSpec{}, platform.KubeProxy{Mode: platform.KubeProxyModeIPVS}, 1},
+		{"ipvs feature", platform.ClusterSpec{ServiceCIDR: &serviceCIDR, Features: platform.ClusterFeature{IPVS: &enabled}}, platform.KubeProxy{StrictARP: true}, 0},
+		{"iptables with ipvs feature", platform.ClusterSpec{Features: platform.ClusterFeature{IPVS: &enabled}}, platform.KubeProxy{Mode: platform.KubeProxyModeIPTables}, 1},
+		{"unknown mode", platform.ClusterSpec{}, platform.KubeProxy{Mode: "userspace"}, 1},
+		{"unknown scheduler", platform.ClusterSpec{ServiceCIDR: &serviceCIDR}, platform.KubeProxy{Mode: platform.KubeProxyModeIPVS, IPVSScheduler: "random"}, 1},
+		{"scheduler in iptables mode", platform.ClusterSpec{}, platform.KubeProxy{IPVSScheduler: "rr"}, 1},
+		{"strict arp in iptables mode", platform.ClusterSpec{}, platform.KubeProxy{StrictARP: true}, 1},
+		{"nftables", platform.ClusterSpec{Version: "1.29.0"}, platform.KubeProxy{Mode: platform.KubeProxyModeNFTables}, 0},
+		{"nftables on old kubernetes", platform.ClusterSpec{Version: "1.28.2"}, platform.KubeProxy{Mode: platform.KubeProxyModeNFTables}, 1},
+		{"cilium replacement", platform.ClusterSpec{Features: platform.ClusterFeature{Cilium: &platform.CiliumNetwork{KubeProxyReplacement: true}}}, platform.KubeProxy{}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateKubeProxy(&tt.spec, &tt.kubeProxy, field.NewPath("kubeProxy"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateKubeProxy() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
+
+func TestValidateGPUManagerFeature(t *testing.T) {
+	virtual := platform.GPUVirtual
+	physical := platform.GPUPhysical
+	tests := []struct {
+		name     string
+		gpuType  *platform.GPUType
+		feature  platform.GPUManagerFeature
+		wantErrs int
+	}{
+		{"default", &virtual, platform.GPUManagerFeature{}, 0},
+		{"valid", &virtual, platform.GPUManagerFeature{
+			SharePolicy:   platform.GPUShareExclusive,
+			MemoryUnitMiB: 512,
+			NodeSelector:  map[string]string{"gpu.example.com/model": "t4"},
+		}, 0},
+		{"physical gpu", &physical, platform.GPUManagerFeature{}, 1},
+		{"no gpu", nil, platform.GPUManagerFeature{}, 1},
+		{"unknown share policy", &virtual, platform.GPUManagerFeature{SharePolicy: "Shared"}, 1},
+		{"negative memory unit", &virtual, platform.GPUManagerFeature{MemoryUnitMiB: -64}, 1},
+		{"unaligned memory unit", &virtual, platform.GPUManagerFeature{MemoryUnitMiB: 100}, 1},
+		{"invalid node selector", &virtual, platform.GPUManagerFeature{NodeSelector: map[string]string{"gpu model": "t4"}}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := &platform.ClusterSpec{Features: platform.ClusterFeature{GPUType: tt.gpuType}}
+			errs := ValidateGPUManagerFeature(spec, &tt.feature, field.NewPath("gpuManager"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateGPUManagerFeature() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
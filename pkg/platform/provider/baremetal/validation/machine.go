@@ -45,6 +45,9 @@ func ValidateMachineSpec(spec *platform.MachineSpec, fldPath *field.Path) field.
 			}
 		}
 	}
+	if spec.ServiceOverrides != nil {
+		allErrs = append(allErrs, ValidateServiceOverrides(spec.ServiceOverrides, fldPath.Child("serviceOverrides"))...)
+	}
 
 	return allErrs
 }
@@ -43,10 +43,16 @@ const (
 	ReasonFailedInit   = "FailedInit"
 	ReasonFailedUpdate = "FailedUpdate"
 	ReasonFailedDelete = "FailedDelete"
+	ReasonPaused       = "Paused"
 
 	ConditionTypeDone = "EnsureDone"
 )
 
+// ErrUpgradePaused is returned by the handlers which can't go on because the
+// upgrade of the cluster is paused, the condition is kept unknown with reason
+// Paused instead of failed until the upgrade is resumed.
+var ErrUpgradePaused = errors.New("upgrade is paused")
+
 type APIProvider interface {
 	RegisterHandler(mux *mux.PathRecorderMux)
 	Validate(cluster *types.Cluster) field.ErrorList
@@ -173,6 +179,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, cluster *v1.Cluster) er
 		return err
 	}
 
+	progress := p.getProgress(cluster, condition.Type, p.CreateHandlers)
 	if cluster.Spec.Features.SkipConditions != nil &&
 		funk.ContainsString(cluster.Spec.Features.SkipConditions, condition.Type) {
 		cluster.SetCondition(platformv1.ClusterCondition{
@@ -181,6 +188,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, cluster *v1.Cluster) er
 			Reason:  ReasonSkip,
 			Message: "Skip current condition",
 		}, false)
+		progress.SkipPhase(condition.Type)
 	} else {
 		handler := p.getHandler(condition.Type, p.CreateHandlers)
 		if handler == nil {
@@ -189,7 +197,9 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, cluster *v1.Cluster) er
 		ctx = log.FromContext(ctx).WithName("ClusterProvider.OnCreate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
+		progress.StartPhase(condition.Type)
 		err = handler(ctx, cluster)
+		progress.FinishPhase(condition.Type, err)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			cluster.SetCondition(platformv1.ClusterCondition{
@@ -248,6 +258,7 @@ func (p *DelegateProvider) OnUpdate(ctx context.Context, cluster *v1.Cluster) er
 	if condition == nil {
 		return nil
 	}
+	progress := p.getProgress(cluster, condition.Type, handlers)
 	if cluster.Spec.Features.SkipConditions != nil &&
 		funk.ContainsString(cluster.Spec.Features.SkipConditions, condition.Type) {
 		cluster.SetCondition(platformv1.ClusterCondition{
@@ -256,6 +267,7 @@ func (p *DelegateProvider) OnUpdate(ctx context.Context, cluster *v1.Cluster) er
 			Reason:  ReasonSkip,
 			Message: "Skip current condition",
 		}, true)
+		progress.SkipPhase(condition.Type)
 	} else {
 		handler := p.getHandler(condition.Type, handlers)
 		if handler == nil {
@@ -264,8 +276,21 @@ func (p *DelegateProvider) OnUpdate(ctx context.Context, cluster *v1.Cluster) er
 		ctx := log.FromContext(ctx).WithName("ClusterProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
+		progress.StartPhase(condition.Type)
 		err = handler(ctx, cluster)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
+		if errors.Is(err, ErrUpgradePaused) {
+			cluster.SetCondition(platformv1.ClusterCondition{
+				Type:    condition.Type,
+				Status:  platformv1.ConditionUnknown,
+				Message: err.Error(),
+				Reason:  ReasonPaused,
+			}, true)
+			cluster.Status.Reason = ReasonPaused
+			cluster.Status.Message = err.Error()
+			return nil
+		}
+		progress.FinishPhase(condition.Type, err)
 		if err != nil {
 			cluster.SetCondition(platformv1.ClusterCondition{
 				Type:    condition.Type,
@@ -355,19 +380,45 @@ func (p *DelegateProvider) getHandler(conditionType string, handlers []Handler)
 	return nil
 }
 
+// getProgress returns the progress of the operation executing handlers, the
+// progress is reset when the operation starts again from the first handler.
+func (p *DelegateProvider) getProgress(cluster *v1.Cluster, conditionType string, handlers []Handler) *platformv1.Progress {
+	var names []string
+	for _, handler := range handlers {
+		names = append(names, handler.Name())
+	}
+	if !cluster.Status.Progress.IsFor(names) ||
+		(len(names) > 0 && conditionType == names[0] && cluster.Status.Progress.IsDone()) {
+		cluster.Status.Progress = platformv1.NewProgress(names)
+	}
+
+	return cluster.Status.Progress
+}
+
 func (p *DelegateProvider) houseKeeping(ctx context.Context, cluster *v1.Cluster, handlers []Handler) error {
+	var paused error
 	for _, handler := range p.UpdateHandlers {
 		ctx := log.FromContext(ctx).WithName("ClusterProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
 		err := handler(ctx, cluster)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
+		if errors.Is(err, ErrUpgradePaused) {
+			// the other handlers go on while the upgrade is paused
+			paused = err
+			continue
+		}
 		if err != nil {
 			cluster.Status.Reason = ReasonFailedUpdate
 			cluster.Status.Message = fmt.Sprintf("%s error: %v", handler.Name(), err)
 			return err
 		}
 	}
+	if paused != nil {
+		cluster.Status.Reason = ReasonPaused
+		cluster.Status.Message = paused.Error()
+		return nil
+	}
 	cluster.Status.Reason = ""
 	cluster.Status.Message = ""
 	return nil
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	v1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func ensurePaused(ctx context.Context, c *v1.Cluster) error {
+	return fmt.Errorf("%w, worker nodes [10.0.0.1] are waiting to be upgraded", ErrUpgradePaused)
+}
+
+func ensureFailed(ctx context.Context, c *v1.Cluster) error {
+	return errors.New("failed")
+}
+
+func ensureNothing(ctx context.Context, c *v1.Cluster) error {
+	return nil
+}
+
+func newCluster(phase platformv1.ClusterPhase) *v1.Cluster {
+	return &v1.Cluster{Cluster: &platformv1.Cluster{Status: platformv1.ClusterStatus{Phase: phase}}}
+}
+
+func TestOnUpdatePaused(t *testing.T) {
+	p := &DelegateProvider{UpgradeHandlers: []Handler{ensurePaused, ensureNothing}}
+	cluster := newCluster(platformv1.ClusterUpgrading)
+
+	if err := p.OnUpdate(context.Background(), cluster); err != nil {
+		t.Fatalf("OnUpdate() error = %v", err)
+	}
+	if len(cluster.Status.Conditions) != 1 {
+		t.Fatalf("conditions = %v, want only the paused one", cluster.Status.Conditions)
+	}
+	condition := cluster.Status.Conditions[0]
+	if condition.Type != "ensurePaused" || condition.Status != platformv1.ConditionUnknown || condition.Reason != ReasonPaused {
+		t.Errorf("condition = %+v, want unknown ensurePaused with reason %s", condition, ReasonPaused)
+	}
+	if cluster.Status.Reason != ReasonPaused {
+		t.Errorf("reason = %s, want %s", cluster.Status.Reason, ReasonPaused)
+	}
+	if cluster.Status.Phase != platformv1.ClusterUpgrading {
+		t.Errorf("phase = %s, want %s", cluster.Status.Phase, platformv1.ClusterUpgrading)
+	}
+	if retries := cluster.Status.Progress.Phases[0].Retries; retries != 0 {
+		t.Errorf("retries = %d, want 0 for paused phase", retries)
+	}
+
+	// the paused condition is picked up again once the upgrade is resumed
+	p.UpgradeHandlers = []Handler{ensureNothing, ensurePaused}
+	condition2, err := p.getCurrentCondition(cluster, platformv1.ClusterUpgrading, p.UpgradeHandlers)
+	if err != nil {
+		t.Fatalf("getCurrentCondition() error = %v", err)
+	}
+	if condition2.Type != "ensurePaused" {
+		t.Errorf("current condition = %s, want ensurePaused", condition2.Type)
+	}
+}
+
+func TestOnUpdateFailed(t *testing.T) {
+	p := &DelegateProvider{UpgradeHandlers: []Handler{ensureFailed, ensureNothing}}
+	cluster := newCluster(platformv1.ClusterUpgrading)
+
+	if err := p.OnUpdate(context.Background(), cluster); err != nil {
+		t.Fatalf("OnUpdate() error = %v", err)
+	}
+	condition := cluster.Status.Conditions[0]
+	if condition.Status != platformv1.ConditionFalse || condition.Reason != ReasonFailedUpdate {
+		t.Errorf("condition = %+v, want false with reason %s", condition, ReasonFailedUpdate)
+	}
+	if retries := cluster.Status.Progress.Phases[0].Retries; retries != 1 {
+		t.Errorf("retries = %d, want 1", retries)
+	}
+}
+
+func TestHouseKeepingPaused(t *testing.T) {
+	var called bool
+	after := Handler(func(ctx context.Context, c *v1.Cluster) error {
+		called = true
+		return nil
+	})
+	p := &DelegateProvider{UpdateHandlers: []Handler{ensurePaused, after}}
+	cluster := newCluster(platformv1.ClusterRunning)
+
+	if err := p.OnUpdate(context.Background(), cluster); err != nil {
+		t.Fatalf("OnUpdate() error = %v", err)
+	}
+	if !called {
+		t.Error("handlers after the paused one are not called")
+	}
+	if cluster.Status.Reason != ReasonPaused {
+		t.Errorf("reason = %s, want %s", cluster.Status.Reason, ReasonPaused)
+	}
+
+	p.UpdateHandlers = []Handler{ensureNothing}
+	if err := p.OnUpdate(context.Background(), cluster); err != nil {
+		t.Fatalf("OnUpdate() error = %v", err)
+	}
+	if cluster.Status.Reason != "" {
+		t.Errorf("reason = %s, want it cleared after resumed", cluster.Status.Reason)
+	}
+}
+
+func TestGetProgress(t *testing.T) {
+	p := &DelegateProvider{}
+	handlers := []Handler{ensureNothing, ensurePaused}
+	cluster := newCluster(platformv1.ClusterUpgrading)
+
+	progress := p.getProgress(cluster, "ensureNothing", handlers)
+	if progress.Total != 2 || progress.Phases[0].Name != "ensureNothing" {
+		t.Fatalf("progress = %+v, want the phases of handlers", progress)
+	}
+
+	// the progress is kept while in progress
+	progress.StartPhase("ensureNothing")
+	progress.FinishPhase("ensureNothing", nil)
+	if got := p.getProgress(cluster, "ensurePaused", handlers); got != progress {
+		t.Errorf("progress = %+v, want the progress kept", got)
+	}
+
+	// the progress is reset once done and started over
+	progress.StartPhase("ensurePaused")
+	progress.FinishPhase("ensurePaused", nil)
+	if !progress.IsDone() {
+		t.Fatalf("progress = %+v, want done", progress)
+	}
+	if got := p.getProgress(cluster, "ensureNothing", handlers); got == progress || got.IsDone() {
+		t.Errorf("progress = %+v, want a new one", got)
+	}
+
+	// the progress is reset for other handlers
+	if got := p.getProgress(cluster, "ensureFailed", []Handler{ensureFailed}); got.Total != 1 || got.Phases[0].Name != "ensureFailed" {
+		t.Errorf("progress = %+v, want the phases of other handlers", got)
+	}
+}
+
+func TestGetProgressWithoutHandlers(t *testing.T) {
+	p := &DelegateProvider{}
+	cluster := newCluster(platformv1.ClusterRunning)
+	cluster.Status.Progress = platformv1.NewProgress(nil)
+
+	progress := p.getProgress(cluster, "", nil)
+	if progress == nil || progress.Total != 0 {
+		t.Errorf("progress = %+v, want an empty one", progress)
+	}
+}
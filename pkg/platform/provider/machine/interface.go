@@ -84,6 +84,10 @@ type DelegateProvider struct {
 	CreateHandlers []Handler
 	DeleteHandlers []Handler
 	UpdateHandlers []Handler
+	// ReconcileHandlers are called on every update of the running machine
+	// instead of UpdateHandlers, they must do nothing if the machine is
+	// already in the desired state.
+	ReconcileHandlers []Handler
 }
 
 func (p *DelegateProvider) Name() string {
@@ -123,6 +127,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, machine *platformv1.Mac
 		return err
 	}
 
+	progress := p.getProgress(machine, condition.Type)
 	if cluster.Spec.Features.SkipConditions != nil &&
 		funk.ContainsString(cluster.Spec.Features.SkipConditions, condition.Type) {
 		machine.SetCondition(platformv1.MachineCondition{
@@ -131,6 +136,7 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, machine *platformv1.Mac
 			Reason:  ReasonSkip,
 			Message: "Skip current condition",
 		})
+		progress.SkipPhase(condition.Type)
 	} else {
 		handler := p.getCreateHandler(condition.Type)
 		if handler == nil {
@@ -139,7 +145,9 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, machine *platformv1.Mac
 		ctx := log.FromContext(ctx).WithName("MachineProvider.OnCreate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
+		progress.StartPhase(condition.Type)
 		err = handler(ctx, machine, cluster)
+		progress.FinishPhase(condition.Type, err)
 		log.FromContext(ctx).Info("Done", "error", err, "cost", time.Since(startTime).String())
 		if err != nil {
 			machine.SetCondition(platformv1.MachineCondition{
@@ -173,10 +181,21 @@ func (p *DelegateProvider) OnCreate(ctx context.Context, machine *platformv1.Mac
 }
 
 func (p *DelegateProvider) OnUpdate(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster) error {
-	if machine.Status.Phase != platformv1.MachineUpgrading {
+	switch machine.Status.Phase {
+	case platformv1.MachineUpgrading:
+		return p.update(ctx, machine, cluster, p.UpdateHandlers)
+	case platformv1.MachineRunning:
+		if len(p.ReconcileHandlers) == 0 {
+			return nil
+		}
+		return p.update(ctx, machine, cluster, p.ReconcileHandlers)
+	default:
 		return nil
 	}
-	for _, handler := range p.UpdateHandlers {
+}
+
+func (p *DelegateProvider) update(ctx context.Context, machine *platformv1.Machine, cluster *typesv1.Cluster, handlers []Handler) error {
+	for _, handler := range handlers {
 		ctx := log.FromContext(ctx).WithName("MachineProvider.OnUpdate").WithName(handler.Name()).WithContext(ctx)
 		log.FromContext(ctx).Info("Doing")
 		startTime := time.Now()
@@ -232,6 +251,21 @@ func (p *DelegateProvider) getNextConditionType(conditionType string) string {
 	return next.Name()
 }
 
+// getProgress returns the progress of create handlers, the progress is reset
+// when the machine is created again from the first handler.
+func (p *DelegateProvider) getProgress(machine *platformv1.Machine, conditionType string) *platformv1.Progress {
+	var names []string
+	for _, handler := range p.CreateHandlers {
+		names = append(names, handler.Name())
+	}
+	if !machine.Status.Progress.IsFor(names) ||
+		(len(names) > 0 && conditionType == names[0] && machine.Status.Progress.IsDone()) {
+		machine.Status.Progress = platformv1.NewProgress(names)
+	}
+
+	return machine.Status.Progress
+}
+
 func (p *DelegateProvider) getCreateHandler(conditionType string) Handler {
 	for _, f := range p.CreateHandlers {
 		if conditionType == f.Name() {
@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package machine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	typesv1 "tkestack.io/tke/pkg/platform/types/v1"
+)
+
+func TestDelegateProviderOnUpdate(t *testing.T) {
+	var called []string
+	handler := func(name string, err error) Handler {
+		return func(context.Context, *platformv1.Machine, *typesv1.Cluster) error {
+			called = append(called, name)
+			return err
+		}
+	}
+	tests := []struct {
+		name       string
+		phase      platformv1.MachinePhase
+		reconcile  error
+		want       []string
+		wantErr    bool
+		wantReason string
+	}{
+		{
+			name:  "upgrading machine runs update handlers",
+			phase: platformv1.MachineUpgrading,
+			want:  []string{"upgrade"},
+		},
+		{
+			name:  "running machine runs reconcile handlers",
+			phase: platformv1.MachineRunning,
+			want:  []string{"reconcile"},
+		},
+		{
+			name:       "failed reconcile is recorded",
+			phase:      platformv1.MachineRunning,
+			reconcile:  errors.New("ssh error"),
+			want:       []string{"reconcile"},
+			wantErr:    true,
+			wantReason: ReasonFailedUpdate,
+		},
+		{
+			name:  "failed machine runs nothing",
+			phase: platformv1.MachineFailed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called = nil
+			p := &DelegateProvider{
+				UpdateHandlers:    []Handler{handler("upgrade", nil)},
+				ReconcileHandlers: []Handler{handler("reconcile", tt.reconcile)},
+			}
+			machine := &platformv1.Machine{Status: platformv1.MachineStatus{Phase: tt.phase}}
+			err := p.OnUpdate(context.Background(), machine, &typesv1.Cluster{Cluster: &platformv1.Cluster{}})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("OnUpdate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(called) != len(tt.want) || (len(called) > 0 && called[0] != tt.want[0]) {
+				t.Errorf("OnUpdate() called %v, want %v", called, tt.want)
+			}
+			if machine.Status.Reason != tt.wantReason {
+				t.Errorf("OnUpdate() reason = %q, want %q", machine.Status.Reason, tt.wantReason)
+			}
+		})
+	}
+
+	// the running machine is left alone without reconcile handlers
+	called = nil
+	p := &DelegateProvider{UpdateHandlers: []Handler{handler("upgrade", nil)}}
+	machine := &platformv1.Machine{Status: platformv1.MachineStatus{Phase: platformv1.MachineRunning, Reason: "Unhealthy"}}
+	if err := p.OnUpdate(context.Background(), machine, &typesv1.Cluster{Cluster: &platformv1.Cluster{}}); err != nil {
+		t.Fatalf("OnUpdate() error = %v", err)
+	}
+	if len(called) != 0 || machine.Status.Reason != "Unhealthy" {
+		t.Errorf("OnUpdate() called %v with reason %q, want nothing changed", called, machine.Status.Reason)
+	}
+}
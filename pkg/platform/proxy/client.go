@@ -61,7 +61,13 @@ func GetConfig(ctx context.Context, platformClient platforminternalclient.Platfo
 	if clusterName == "" {
 		return nil, errors.NewBadRequest("clusterName is required")
 	}
+	return GetConfigForCluster(ctx, platformClient, clusterName)
+}
 
+// GetConfigForCluster returns the config to access the cluster on behalf of the
+// user of ctx, the user and groups are presented by the client certificate if
+// the authz webhook of cluster is enabled.
+func GetConfigForCluster(ctx context.Context, platformClient platforminternalclient.PlatformInterface, clusterName string) (*rest.Config, error) {
 	cluster, err := platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -110,7 +116,10 @@ func ClientSet(ctx context.Context, platformClient platforminternalclient.Platfo
 	return kubernetes.NewForConfig(config)
 }
 
-func getOrCreateClientCert(ctx context.Context, credential *platform.ClusterCredential) ([]byte, []byte, error) {
+// clientIdentity returns the user and groups in the client certificate of the
+// request, the tenant and namespace are presented as groups to the authz
+// webhook of cluster.
+func clientIdentity(ctx context.Context) (string, []string) {
 	groups := authentication.Groups(ctx)
 	username, tenantID := authentication.UsernameAndTenantID(ctx)
 	if tenantID != "" {
@@ -121,7 +130,11 @@ func getOrCreateClientCert(ctx context.Context, credential *platform.ClusterCred
 	if ok {
 		groups = append(groups, fmt.Sprintf("namespace:%s", ns))
 	}
+	return username, groups
+}
 
+func getOrCreateClientCert(ctx context.Context, credential *platform.ClusterCredential) ([]byte, []byte, error) {
+	username, groups := clientIdentity(ctx)
 	cache, ok := pool.sm.Load(makeClientKey(username, groups))
 	if ok {
 		return cache.(*clientX509Cache).clientCertData, cache.(*clientX509Cache).clientKeyData, nil
@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// portForwardAnnotationKey is the audit annotation which records the forwarded
+// ports of pod.
+const portForwardAnnotationKey = "platform.tkestack.io/portforward"
+
+// PortForwardREST implements the port forward endpoint for a Pod
+type PortForwardREST struct {
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// New returns an empty podPortForwardOptions object.
+func (r *PortForwardREST) New() runtime.Object {
+	return &corev1api.PodPortForwardOptions{}
+}
+
+// NewConnectOptions returns the versioned object that represents the
+// port forwarding parameters
+func (r *PortForwardREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &corev1api.PodPortForwardOptions{}, false, ""
+}
+
+// ConnectMethods returns the methods supported by port forward
+func (r *PortForwardREST) ConnectMethods() []string {
+	return upgradeableMethods
+}
+
+// Connect returns a handler for the pod port forward proxy
+func (r *PortForwardREST) Connect(ctx context.Context, name string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	portForwardOpts, ok := opts.(*corev1api.PodPortForwardOptions)
+	if !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+
+	location, transport, token, err := util.APIServerLocation(ctx, r.platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	var ports []string
+	for _, port := range portForwardOpts.Ports {
+		params.Add("ports", strconv.Itoa(int(port)))
+		ports = append(ports, strconv.Itoa(int(port)))
+	}
+	location.RawQuery = params.Encode()
+
+	namespace, _ := request.NamespaceFrom(ctx)
+	audit.LogAnnotation(request.AuditEventFrom(ctx), portForwardAnnotationKey, fmt.Sprintf("%s/%s:%s", namespace, name, strings.Join(ports, ",")))
+
+	return &execHandler{
+		upgradeAwareHandler: newThrottledUpgradeAwareProxyHandler(location, transport, false, true, responder),
+		token:               token,
+	}, nil
+}
@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+	"tkestack.io/tke/pkg/platform/proxy"
+	"tkestack.io/tke/pkg/platform/sandboxadmission"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Create defaults the RuntimeClass of pods in untrusted namespaces to the
+// sandboxed one, and rejects pods in them which require another RuntimeClass.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	if pod, ok := obj.(*corev1.Pod); ok {
+		if err := r.admitRuntimeClass(ctx, pod); err != nil {
+			return nil, err
+		}
+	}
+	return r.Store.Create(ctx, obj, createValidation, options)
+}
+
+func (r *REST) admitRuntimeClass(ctx context.Context, pod *corev1.Pod) error {
+	client, err := proxy.ClientSet(ctx, r.PlatformClient)
+	if err != nil {
+		return err
+	}
+	return admitRuntimeClass(ctx, client, pod)
+}
+
+// admitRuntimeClass rejects the pod if the namespace can not be got, so that
+// pods are never created in untrusted namespaces without the sandbox.
+func admitRuntimeClass(ctx context.Context, client kubernetes.Interface, pod *corev1.Pod) error {
+	namespaceName, ok := request.NamespaceFrom(ctx)
+	if !ok {
+		return errors.NewBadRequest("a namespace must be specified")
+	}
+
+	namespace, err := client.CoreV1().Namespaces().Get(ctx, namespaceName, metav1.GetOptions{})
+	if err != nil {
+		log.Error("Get namespace for runtime class admission failed", log.String("namespace", namespaceName), log.Err(err))
+		return err
+	}
+	runtimeClassName, err := sandboxadmission.RuntimeClass(namespace, pod)
+	if err != nil {
+		return errors.NewForbidden(corev1.Resource("pods"), pod.Name, err)
+	}
+	if runtimeClassName == "" || pod.Spec.RuntimeClassName != nil {
+		return nil
+	}
+
+	requestBody, ok := filter.RequestBodyFrom(ctx)
+	if !ok {
+		return errors.NewBadRequest("request body is required")
+	}
+	pod.Spec.RuntimeClassName = &runtimeClassName
+	pod.APIVersion = corev1.SchemeGroupVersion.String()
+	pod.Kind = "Pod"
+	data, err := json.Marshal(pod)
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+	requestBody.Data = data
+	requestBody.ContentType = runtime.ContentTypeJSON
+
+	return nil
+}
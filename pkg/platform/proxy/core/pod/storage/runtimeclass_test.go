@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes/fake"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+)
+
+// requestContext returns the context with the namespace and the request body
+// of pod as the platform apiserver builds.
+func requestContext(t *testing.T, namespace string, pod *corev1.Pod) context.Context {
+	data, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ctx context.Context
+	handler := filter.WithRequestBody(http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+		ctx = req.Context()
+	}))
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/namespaces/"+namespace+"/pods", strings.NewReader(string(data)))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+	return request.WithNamespace(ctx, namespace)
+}
+
+func TestAdmitRuntimeClass(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "untrusted",
+			Labels:      map[string]string{platformv1.LabelUntrustedNamespace: "true"},
+			Annotations: map[string]string{platformv1.AnnotationSandboxRuntimeClass: "kata"},
+		},
+	}, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "trusted"}})
+
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	ctx := requestContext(t, "untrusted", pod)
+	if err := admitRuntimeClass(ctx, client, pod); err != nil {
+		t.Fatalf("admitRuntimeClass() error = %v", err)
+	}
+	requestBody, _ := filter.RequestBodyFrom(ctx)
+	admitted := &corev1.Pod{}
+	if err := json.Unmarshal(requestBody.Data, admitted); err != nil {
+		t.Fatal(err)
+	}
+	if admitted.Spec.RuntimeClassName == nil || *admitted.Spec.RuntimeClassName != "kata" {
+		t.Errorf("expect the runtime class of pod to default to kata, got %v", admitted.Spec.RuntimeClassName)
+	}
+
+	runc := "runc"
+	pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}, Spec: corev1.PodSpec{RuntimeClassName: &runc}}
+	if err := admitRuntimeClass(requestContext(t, "untrusted", pod), client, pod); !errors.IsForbidden(err) {
+		t.Errorf("expect pod with another runtime class to be forbidden, got %v", err)
+	}
+	if err := admitRuntimeClass(requestContext(t, "trusted", pod), client, pod); err != nil {
+		t.Errorf("expect pod in trusted namespace to be admitted, got %v", err)
+	}
+
+	// The pod is not admitted without knowing whether the namespace is
+	// untrusted.
+	pod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod"}}
+	if err := admitRuntimeClass(requestContext(t, "unknown", pod), client, pod); err == nil {
+		t.Errorf("expect pod to be rejected if the namespace can not be got")
+	}
+}
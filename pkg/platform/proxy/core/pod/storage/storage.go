@@ -34,12 +34,13 @@ import (
 
 // Storage includes storage for resources.
 type Storage struct {
-	Pod     *REST
-	Status  *StatusREST
-	Binding *BindingREST
-	Events  *EventREST
-	Log     *LogREST
-	Exec    *ExecREST
+	Pod         *REST
+	Status      *StatusREST
+	Binding     *BindingREST
+	Events      *EventREST
+	Log         *LogREST
+	Exec        *ExecREST
+	PortForward *PortForwardREST
 }
 
 // REST implements pkg/api/rest.StandardStorage.
@@ -75,6 +76,9 @@ func NewStorage(_ genericregistry.RESTOptionsGetter, platformClient platforminte
 		Exec: &ExecREST{
 			platformClient: platformClient,
 		},
+		PortForward: &PortForwardREST{
+			platformClient: platformClient,
+		},
 	}
 }
 
@@ -90,6 +90,7 @@ func (c LegacyRESTStorageProvider) NewLegacyRESTStorage(restOptionsGetter generi
 		"pods/events":                   podStore.Events,
 		"pods/log":                      podStore.Log,
 		"pods/exec":                     podStore.Exec,
+		"pods/portforward":              podStore.PortForward,
 		"bindings":                      podStore.Binding,
 		"podTemplates":                  podTemplateStore.PodTemplate,
 		"replicationControllers":        replicationControllerStore.ReplicationController,
@@ -100,6 +101,7 @@ func (c LegacyRESTStorageProvider) NewLegacyRESTStorage(restOptionsGetter generi
 		"services":                      serviceStore.Service,
 		"services/status":               serviceStore.Status,
 		"services/events":               serviceStore.Events,
+		"services/proxy":                serviceStore.Proxy,
 		"endpoints":                     endpointsStore.Endpoint,
 		"limitRanges":                   limitRangeStore.LimitRange,
 		"resourceQuotas":                resourceQuotaStore.ResourceQuota,
@@ -0,0 +1,93 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/proxy"
+	"k8s.io/apiserver/pkg/registry/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+// proxyMethods are the methods supported by the service proxy.
+var proxyMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "HEAD", "OPTIONS"}
+
+// ProxyREST implements the proxy subresource for a Service, the requests are
+// proxied by the api server of cluster to the service.
+type ProxyREST struct {
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// New returns an empty service proxy options object.
+func (r *ProxyREST) New() runtime.Object {
+	return &corev1.ServiceProxyOptions{}
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *ProxyREST) ConnectMethods() []string {
+	return proxyMethods
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *ProxyREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &corev1.ServiceProxyOptions{}, true, "path"
+}
+
+// Connect returns a handler for the service proxy
+func (r *ProxyREST) Connect(ctx context.Context, id string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	if _, ok := opts.(*corev1.ServiceProxyOptions); !ok {
+		return nil, fmt.Errorf("invalid options object: %#v", opts)
+	}
+
+	// the location contains the whole path of request, including the path
+	// proxied to the service.
+	location, transport, token, err := util.APIServerLocation(ctx, r.platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	handler := proxy.NewUpgradeAwareHandler(location, transport, false, false, proxy.NewErrorResponder(responder))
+	handler.InterceptRedirects = true
+	handler.RequireSameHostRedirects = true
+	return &proxyHandler{
+		upgradeAwareHandler: handler,
+		token:               token,
+	}, nil
+}
+
+type proxyHandler struct {
+	upgradeAwareHandler *proxy.UpgradeAwareHandler
+	token               string
+}
+
+func (h *proxyHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	newReq := req.WithContext(req.Context())
+	newReq.Header = utilnet.CloneHeader(req.Header)
+	if h.token != "" {
+		newReq.Header.Set("Authorization", fmt.Sprintf("Bearer %s", h.token))
+	}
+	h.upgradeAwareHandler.ServeHTTP(w, newReq)
+}
@@ -36,6 +36,7 @@ type Storage struct {
 	Service *REST
 	Status  *StatusREST
 	Events  *EventREST
+	Proxy   *ProxyREST
 }
 
 // REST implements pkg/api/rest.StandardStorage
@@ -62,6 +63,9 @@ func NewStorage(_ genericregistry.RESTOptionsGetter, platformClient platforminte
 		Events: &EventREST{
 			platformClient: platformClient,
 		},
+		Proxy: &ProxyREST{
+			platformClient: platformClient,
+		},
 	}
 }
 
@@ -0,0 +1,160 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	clientrest "k8s.io/client-go/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/platform/apiserver/filter"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	maxOperationTTL = 24 * time.Hour
+
+	// OperationTTLAnnotation is the annotation of cluster which queues the
+	// mutations for the duration while the cluster is unreachable, unless the
+	// request specifies its own TTL.
+	OperationTTLAnnotation = "platform.tkestack.io/operation-ttl"
+
+	// StatusReasonQueued means the mutation is queued since the cluster is
+	// unreachable.
+	StatusReasonQueued metav1.StatusReason = "Queued"
+)
+
+// operationTTL returns how long the mutation is queued, which is given by the
+// header of request or the annotation of cluster. The mutation is not queued
+// if neither is specified.
+func operationTTL(ctx context.Context, platformClient platforminternalclient.PlatformInterface, clusterName string) (time.Duration, error) {
+	if value := filter.OperationTTLFrom(ctx); value != "" {
+		ttl, err := parseOperationTTL(value)
+		if err != nil {
+			return 0, errors.NewBadRequest(fmt.Sprintf("invalid %s %q, must be a duration between 0 and %s",
+				filter.OperationTTLHeaderKey, value, maxOperationTTL))
+		}
+		return ttl, nil
+	}
+
+	cluster, err := platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		log.Warn("Failed to get the operation TTL of cluster", log.String("clusterName", clusterName), log.Err(err))
+		return 0, nil
+	}
+	value, ok := cluster.Annotations[OperationTTLAnnotation]
+	if !ok {
+		return 0, nil
+	}
+	ttl, err := parseOperationTTL(value)
+	if err != nil {
+		log.Warn("Invalid operation TTL of cluster", log.String("clusterName", clusterName), log.String("ttl", value))
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+func parseOperationTTL(value string) (time.Duration, error) {
+	ttl, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if ttl < 0 || ttl > maxOperationTTL {
+		return 0, fmt.Errorf("out of range")
+	}
+	return ttl, nil
+}
+
+// queueOperation records the mutation as a ClusterOperation if the cluster is
+// unreachable, which is applied by platform controller once the cluster is
+// reachable again. It returns the cause if the mutation is not queued.
+func queueOperation(ctx context.Context, platformClient platforminternalclient.PlatformInterface, requestInfo *request.RequestInfo, method string, req *clientrest.Request, body *filter.RequestBody, cause error) (*metav1.Status, error) {
+	// the request is timed out or canceled by client
+	if !util.IsClusterUnreachable(cause) || ctx.Err() != nil {
+		return nil, cause
+	}
+	clusterName := filter.ClusterFrom(ctx)
+	ttl, err := operationTTL(ctx, platformClient, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if ttl == 0 {
+		return nil, cause
+	}
+
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	username, groups := clientIdentity(ctx)
+	operation := &platform.ClusterOperation{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: "co-"},
+		Spec: platform.ClusterOperationSpec{
+			TenantID:    tenantID,
+			ClusterName: clusterName,
+			Verb:        method,
+			Path:        requestInfo.Path,
+			Username:    username,
+			Groups:      groups,
+			ExpireTime:  metav1.NewTime(time.Now().Add(ttl)),
+		},
+	}
+	if query := req.URL().RawQuery; query != "" {
+		operation.Spec.Path += "?" + query
+	}
+	if body != nil {
+		// the binary body can not be kept in the operation
+		if strings.Contains(body.ContentType, "protobuf") {
+			return nil, cause
+		}
+		operation.Spec.ContentType = body.ContentType
+		operation.Spec.Body = string(body.Data)
+	}
+	operation, err = platformClient.ClusterOperations().Create(ctx, operation, metav1.CreateOptions{})
+	if err != nil {
+		log.Warn("Failed to queue the operation of unreachable cluster", log.String("clusterName", clusterName), log.Err(err))
+		return nil, cause
+	}
+	log.Info("Queued the operation of unreachable cluster",
+		log.String("clusterName", clusterName),
+		log.String("operation", operation.Name),
+		log.String("path", operation.Spec.Path),
+		log.Err(cause))
+
+	return &metav1.Status{
+		Status: metav1.StatusSuccess,
+		Code:   http.StatusAccepted,
+		Reason: StatusReasonQueued,
+		Message: fmt.Sprintf("cluster %s is unreachable, the operation is queued as clusteroperation %s until %s",
+			clusterName, operation.Name, operation.Spec.ExpireTime.UTC().Format(time.RFC3339)),
+		Details: &metav1.StatusDetails{
+			Name:  operation.Name,
+			Group: platform.GroupName,
+			Kind:  "clusteroperations",
+			UID:   operation.UID,
+		},
+	}, nil
+}
@@ -21,6 +21,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 
@@ -210,17 +211,20 @@ func (s *Store) Create(ctx context.Context, obj runtime.Object, createValidation
 	}
 
 	result := s.New()
-	if err := client.
+	req := client.
 		Post().
 		SetHeader("Content-Type", requestBody.ContentType).
 		NamespaceIfScoped(requestInfo.Namespace, requestInfo.Namespace != "" && requestInfo.Resource != "namespaces").
 		Resource(requestInfo.Resource).
 		SubResource(requestInfo.Subresource).
 		VersionedParams(options, platform.ParameterCodec).
-		Body(requestBody.Data).
-		Do(ctx).
-		Into(result); err != nil {
-		return nil, err
+		Body(requestBody.Data)
+	if err := req.Do(ctx).Into(result); err != nil {
+		status, err := queueOperation(ctx, s.PlatformClient, requestInfo, http.MethodPost, req, requestBody, err)
+		if err != nil {
+			return nil, err
+		}
+		return status, nil
 	}
 	return result, nil
 }
@@ -241,24 +245,30 @@ func (s *Store) Update(ctx context.Context, name string, objInfo rest.UpdatedObj
 	result := s.New()
 
 	var req *clientrest.Request
+	var method string
 	if requestInfo.Verb == "patch" {
 		req = client.Patch(types.PatchType(requestBody.ContentType))
+		method = http.MethodPatch
 	} else if requestInfo.Verb == "update" || requestInfo.Verb == "put" {
 		req = client.Put()
+		method = http.MethodPut
 	} else {
 		return nil, false, errors.NewBadRequest("unsupported request method")
 	}
-	if err := req.
+	req = req.
 		SetHeader("Content-Type", requestBody.ContentType).
 		NamespaceIfScoped(requestInfo.Namespace, requestInfo.Namespace != "" && requestInfo.Resource != "namespaces").
 		Resource(requestInfo.Resource).
 		SubResource(requestInfo.Subresource).
 		VersionedParams(options, platform.ParameterCodec).
 		Name(name).
-		Body(requestBody.Data).
-		Do(ctx).
-		Into(result); err != nil {
-		return nil, false, err
+		Body(requestBody.Data)
+	if err := req.Do(ctx).Into(result); err != nil {
+		status, err := queueOperation(ctx, s.PlatformClient, requestInfo, method, req, requestBody, err)
+		if err != nil {
+			return nil, false, err
+		}
+		return status, false, nil
 	}
 
 	return result, true, nil
@@ -271,18 +281,23 @@ func (s *Store) Delete(ctx context.Context, name string, deleteValidation rest.V
 		return nil, false, err
 	}
 
-	result := client.
+	req := client.
 		Delete().
 		NamespaceIfScoped(requestInfo.Namespace, requestInfo.Namespace != "" && requestInfo.Resource != "namespaces").
 		Resource(requestInfo.Resource).
 		SubResource(requestInfo.Subresource).
 		VersionedParams(options, platform.ParameterCodec).
 		Name(name).
-		Body(options).
-		Do(ctx)
+		Body(options)
+	result := req.Do(ctx)
 	resultErr := result.Error()
 	if resultErr != nil {
-		return nil, false, resultErr
+		requestBody, _ := filter.RequestBodyFrom(ctx)
+		status, err := queueOperation(ctx, s.PlatformClient, requestInfo, http.MethodDelete, req, requestBody, resultErr)
+		if err != nil {
+			return nil, false, err
+		}
+		return status, false, nil
 	}
 	returnedObj, err := result.Get()
 	if err != nil {
@@ -95,6 +95,10 @@ var (
 		prometheus,
 		lbcf,
 		ipam,
+		egressGateway,
+		metalLB,
+		multus,
+		devicePlugin,
 	}
 )
 
@@ -451,3 +455,139 @@ func lbcf(ctx context.Context, a *addonFinder) {
 	})
 	a.mutex.Unlock()
 }
+
+func egressGateway(ctx context.Context, a *addonFinder) {
+	defer a.wg.Done()
+	l, err := a.platformClient.EgressGateways().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", a.clusterName).String(),
+	})
+	if err != nil {
+		a.mutex.Lock()
+		a.errors = append(a.errors, err)
+		a.mutex.Unlock()
+		return
+	}
+	if len(l.Items) == 0 {
+		return
+	}
+	a.mutex.Lock()
+	a.addons = append(a.addons, platform.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              l.Items[0].ObjectMeta.Name,
+			CreationTimestamp: l.Items[0].ObjectMeta.CreationTimestamp,
+		},
+		Spec: platform.ClusterAddonSpec{
+			Type:    string(clusteraddontype.EgressGateway),
+			Level:   clusteraddontype.Types[clusteraddontype.EgressGateway].Level,
+			Version: l.Items[0].Spec.Version,
+		},
+		Status: platform.ClusterAddonStatus{
+			Version: l.Items[0].Status.Version,
+			Phase:   string(l.Items[0].Status.Phase),
+			Reason:  l.Items[0].Status.Reason,
+		},
+	})
+	a.mutex.Unlock()
+}
+
+func metalLB(ctx context.Context, a *addonFinder) {
+	defer a.wg.Done()
+	l, err := a.platformClient.MetalLBs().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", a.clusterName).String(),
+	})
+	if err != nil {
+		a.mutex.Lock()
+		a.errors = append(a.errors, err)
+		a.mutex.Unlock()
+		return
+	}
+	if len(l.Items) == 0 {
+		return
+	}
+	a.mutex.Lock()
+	a.addons = append(a.addons, platform.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              l.Items[0].ObjectMeta.Name,
+			CreationTimestamp: l.Items[0].ObjectMeta.CreationTimestamp,
+		},
+		Spec: platform.ClusterAddonSpec{
+			Type:    string(clusteraddontype.MetalLB),
+			Level:   clusteraddontype.Types[clusteraddontype.MetalLB].Level,
+			Version: l.Items[0].Spec.Version,
+		},
+		Status: platform.ClusterAddonStatus{
+			Version: l.Items[0].Status.Version,
+			Phase:   string(l.Items[0].Status.Phase),
+			Reason:  l.Items[0].Status.Reason,
+		},
+	})
+	a.mutex.Unlock()
+}
+
+func multus(ctx context.Context, a *addonFinder) {
+	defer a.wg.Done()
+	l, err := a.platformClient.Multuses().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", a.clusterName).String(),
+	})
+	if err != nil {
+		a.mutex.Lock()
+		a.errors = append(a.errors, err)
+		a.mutex.Unlock()
+		return
+	}
+	if len(l.Items) == 0 {
+		return
+	}
+	a.mutex.Lock()
+	a.addons = append(a.addons, platform.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              l.Items[0].ObjectMeta.Name,
+			CreationTimestamp: l.Items[0].ObjectMeta.CreationTimestamp,
+		},
+		Spec: platform.ClusterAddonSpec{
+			Type:    string(clusteraddontype.Multus),
+			Level:   clusteraddontype.Types[clusteraddontype.Multus].Level,
+			Version: l.Items[0].Spec.Version,
+		},
+		Status: platform.ClusterAddonStatus{
+			Version: l.Items[0].Status.Version,
+			Phase:   string(l.Items[0].Status.Phase),
+			Reason:  l.Items[0].Status.Reason,
+		},
+	})
+	a.mutex.Unlock()
+}
+
+func devicePlugin(ctx context.Context, a *addonFinder) {
+	defer a.wg.Done()
+	l, err := a.platformClient.DevicePlugins().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", a.clusterName).String(),
+	})
+	if err != nil {
+		a.mutex.Lock()
+		a.errors = append(a.errors, err)
+		a.mutex.Unlock()
+		return
+	}
+	// there may be a DevicePlugin for each device class
+	a.mutex.Lock()
+	for _, item := range l.Items {
+		a.addons = append(a.addons, platform.ClusterAddon{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              item.ObjectMeta.Name,
+				CreationTimestamp: item.ObjectMeta.CreationTimestamp,
+			},
+			Spec: platform.ClusterAddonSpec{
+				Type:    string(clusteraddontype.DevicePlugin),
+				Level:   clusteraddontype.Types[clusteraddontype.DevicePlugin].Level,
+				Version: item.Spec.Version,
+			},
+			Status: platform.ClusterAddonStatus{
+				Version: item.Status.Version,
+				Phase:   string(item.Status.Phase),
+				Reason:  item.Status.Reason,
+			},
+		})
+	}
+	a.mutex.Unlock()
+}
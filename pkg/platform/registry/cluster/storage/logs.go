@@ -0,0 +1,236 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/audit"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	"tkestack.io/tke/pkg/apiserver/filter"
+	businessutil "tkestack.io/tke/pkg/business/util"
+	"tkestack.io/tke/pkg/platform/proxy"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// maxLogBytes is the max bytes of logs returned by a request, which is
+	// also the default if limitBytes is not specified.
+	maxLogBytes = 10 * 1024 * 1024
+	// maxLogTailLines is the max lines of logs from the end.
+	maxLogTailLines = 10000
+
+	logsAnnotationKey = "platform.tkestack.io/logs"
+)
+
+// LogsREST implements streaming the logs of the pods of cluster through
+// platform api, so the console and the clients don't need to access the
+// cluster directly.
+type LogsREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *LogsREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents the options of
+// the logs
+func (r *LogsREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.ClusterLogsOptions{}, false, ""
+}
+
+// Connect returns a handler which streams the logs of the container specified
+// by the options, the options are the same as the pod log options. The logs
+// are read on behalf of the user presented to the cluster, and the user must
+// be in a project unless the cluster authorizes the user by itself.
+func (r *LogsREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	logsOptions, ok := opts.(*platform.ClusterLogsOptions)
+	if !ok {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid options object: %#v", opts))
+	}
+	if logsOptions.Namespace == "" || logsOptions.Pod == "" {
+		return nil, errors.NewBadRequest("namespace and pod must be specified")
+	}
+	options, err := podLogOptions(logsOptions)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	clusterObject, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c := clusterObject.(*platform.Cluster)
+	if err := util.FilterCluster(ctx, c); err != nil {
+		return nil, err
+	}
+
+	project := filter.GetValueFromGroups(authentication.Groups(ctx), "project")
+	if project == "" && !c.AuthzWebhookEnabled() {
+		return nil, errors.NewForbidden(platform.Resource("clusters/logs"), clusterName, fmt.Errorf("logs of cluster without authz webhook can only be read in a project"))
+	}
+	if project != "" {
+		if err := r.authorizeProject(ctx, c, project, logsOptions.Namespace); err != nil {
+			return nil, err
+		}
+	}
+
+	// The client certificate presents the namespace to the authz webhook.
+	ctx = request.WithNamespace(ctx, logsOptions.Namespace)
+	config, err := proxy.GetConfigForCluster(ctx, r.platformClient, c.Name)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &logsHandler{
+		cluster:   c,
+		namespace: logsOptions.Namespace,
+		pod:       logsOptions.Pod,
+		options:   options,
+		clientset: clientset,
+	}, nil
+}
+
+// New creates a new cluster logs options object
+func (r *LogsREST) New() runtime.Object {
+	return &platform.ClusterLogsOptions{}
+}
+
+// authorizeProject forbids the request in a project to read the logs of the
+// namespace which does not belong to the project. The namespace is read with
+// the credential of cluster as the user may not be allowed to get it.
+func (r *LogsREST) authorizeProject(ctx context.Context, cluster *platform.Cluster, project, namespace string) error {
+	clientset, err := util.ClientSetByCluster(ctx, cluster, r.platformClient)
+	if err != nil {
+		return err
+	}
+	ns, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.NewNotFound(corev1.Resource("namespaces"), namespace)
+		}
+		return errors.NewInternalError(err)
+	}
+	if ns.Labels[businessutil.LabelProjectName] != project {
+		return errors.NewForbidden(corev1.Resource("namespaces"), namespace, fmt.Errorf("namespace does not belong to project %s", project))
+	}
+	return nil
+}
+
+type logsHandler struct {
+	cluster   *platform.Cluster
+	namespace string
+	pod       string
+	options   *corev1.PodLogOptions
+	clientset kubernetes.Interface
+}
+
+func (h *logsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	target := fmt.Sprintf("%s/%s/%s/%s", h.cluster.Name, h.namespace, h.pod, h.options.Container)
+	audit.LogAnnotation(request.AuditEventFrom(ctx), logsAnnotationKey, target)
+	log.Info("Stream pod logs", log.String("user", username), log.String("target", target), log.Bool("follow", h.options.Follow))
+
+	stream, err := h.clientset.CoreV1().Pods(h.namespace).GetLogs(h.pod, h.options).Stream(ctx)
+	if err != nil {
+		if status, ok := err.(errors.APIStatus); ok {
+			responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+			return
+		}
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	defer stream.Close()
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(flushWriter{w}, io.LimitReader(stream, *h.options.LimitBytes)); err != nil && ctx.Err() == nil {
+		log.Warn("Stream pod logs failed", log.String("target", target), log.Err(err))
+	}
+}
+
+// podLogOptions validates the options of the logs, and limits the size of
+// logs.
+func podLogOptions(in *platform.ClusterLogsOptions) (*corev1.PodLogOptions, error) {
+	options := &corev1.PodLogOptions{
+		Container:    in.Container,
+		Follow:       in.Follow,
+		Previous:     in.Previous,
+		Timestamps:   in.Timestamps,
+		SinceSeconds: in.SinceSeconds,
+		SinceTime:    in.SinceTime,
+		TailLines:    in.TailLines,
+		LimitBytes:   in.LimitBytes,
+	}
+	for name, value := range map[string]*int64{"tailLines": options.TailLines, "sinceSeconds": options.SinceSeconds, "limitBytes": options.LimitBytes} {
+		if value != nil && *value <= 0 {
+			return nil, fmt.Errorf("%s must be a positive integer", name)
+		}
+	}
+	if options.SinceSeconds != nil && options.SinceTime != nil {
+		return nil, fmt.Errorf("at most one of sinceTime or sinceSeconds may be specified")
+	}
+
+	if options.TailLines != nil && *options.TailLines > maxLogTailLines {
+		return nil, fmt.Errorf("tailLines must be no more than %d", maxLogTailLines)
+	}
+	if options.LimitBytes == nil || *options.LimitBytes > maxLogBytes {
+		limitBytes := int64(maxLogBytes)
+		options.LimitBytes = &limitBytes
+	}
+	return options, nil
+}
+
+// flushWriter flushes the logs to client after each write when following.
+type flushWriter struct {
+	w http.ResponseWriter
+}
+
+func (fw flushWriter) Write(p []byte) (int, error) {
+	n, err := fw.w.Write(p)
+	if flusher, ok := fw.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+	return n, err
+}
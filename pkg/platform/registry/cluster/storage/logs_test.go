@@ -0,0 +1,73 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/platform"
+)
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestPodLogOptions(t *testing.T) {
+	options, err := podLogOptions(&platform.ClusterLogsOptions{
+		Container:  "app",
+		Follow:     true,
+		TailLines:  int64Ptr(100),
+		LimitBytes: int64Ptr(1024),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.Container != "app" || !options.Follow || *options.TailLines != 100 || *options.LimitBytes != 1024 {
+		t.Errorf("unexpected options: %+v", options)
+	}
+
+	options, err = podLogOptions(&platform.ClusterLogsOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if options.LimitBytes == nil || *options.LimitBytes != maxLogBytes {
+		t.Errorf("expected limitBytes defaults to %d, got %v", maxLogBytes, options.LimitBytes)
+	}
+
+	options, err = podLogOptions(&platform.ClusterLogsOptions{LimitBytes: int64Ptr(maxLogBytes * 2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *options.LimitBytes != maxLogBytes {
+		t.Errorf("expected limitBytes limited to %d, got %d", maxLogBytes, *options.LimitBytes)
+	}
+
+	sinceTime := metav1.Now()
+	for _, invalid := range []*platform.ClusterLogsOptions{
+		{TailLines: int64Ptr(100000)},
+		{TailLines: int64Ptr(-1)},
+		{SinceSeconds: int64Ptr(0)},
+		{SinceSeconds: int64Ptr(60), SinceTime: &sinceTime},
+	} {
+		if _, err := podLogOptions(invalid); err == nil {
+			t.Errorf("expected error for %+v", invalid)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/platform/util/netcheck"
+)
+
+// NetCheckREST implements the network connectivity check of cluster, which
+// launches probe pods on all nodes and reports the failures of node-to-node,
+// pod-to-pod, pod-to-service and DNS checks.
+type NetCheckREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *NetCheckREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *NetCheckREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.HelmProxyOptions{}, false, ""
+}
+
+// Connect returns a handler which runs the network check of cluster, the
+// probe image and the timeout in seconds can be specified by query parameters
+// image and timeout.
+func (r *NetCheckREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	clusterObject, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c := clusterObject.(*platform.Cluster)
+	if err := util.FilterCluster(ctx, c); err != nil {
+		return nil, err
+	}
+
+	credential, err := util.GetClusterCredential(ctx, r.platformClient, c)
+	if err != nil {
+		return nil, err
+	}
+	config, err := util.GetInternalRestConfig(c, credential)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &netCheckHandler{
+		cluster:   c,
+		clientset: clientset,
+		config:    config,
+	}, nil
+}
+
+// New creates a new helm proxy options object
+func (r *NetCheckREST) New() runtime.Object {
+	return &platform.HelmProxyOptions{}
+}
+
+type netCheckHandler struct {
+	cluster   *platform.Cluster
+	clientset kubernetes.Interface
+	config    *restclient.Config
+}
+
+func (h *netCheckHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	option := netcheck.Option{
+		Image:         req.URL.Query().Get("image"),
+		ClusterDomain: h.cluster.Spec.DNSDomain,
+	}
+	if timeout := req.URL.Query().Get("timeout"); timeout != "" {
+		seconds, err := strconv.Atoi(timeout)
+		if err != nil || seconds <= 0 {
+			responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest("timeout must be a positive number of seconds"), w)
+			return
+		}
+		option.Timeout = time.Duration(seconds) * time.Second
+	}
+
+	report, err := netcheck.Run(req.Context(), h.clientset, h.config, option)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+
+	responsewriters.WriteRawJSON(http.StatusOK, report, w)
+}
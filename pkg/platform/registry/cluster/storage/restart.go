@@ -0,0 +1,244 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util"
+)
+
+const (
+	// restartedAtAnnotation is the same annotation used by kubectl rollout restart.
+	restartedAtAnnotation = "kubectl.kubernetes.io/restartedAt"
+
+	restartKindDaemonSet  = "daemonsets"
+	restartKindDeployment = "deployments"
+)
+
+// RestartREST implements rolling restart of the platform-managed components,
+// such as the CNI, CSI node plugins and log agents, in the kube-system
+// namespace of cluster.
+type RestartREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *RestartREST) ConnectMethods() []string {
+	return []string{"GET", "POST"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *RestartREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.HelmProxyOptions{}, true, "path"
+}
+
+// Connect returns a handler to restart or report the restart progress of the
+// component specified by path in the form of {daemonsets|deployments}/{name}.
+func (r *RestartREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	clusterObject, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c := clusterObject.(*platform.Cluster)
+	if err := util.FilterCluster(ctx, c); err != nil {
+		return nil, err
+	}
+	proxyOpts := opts.(*platform.HelmProxyOptions)
+
+	clientset, err := util.ClientSetByCluster(ctx, c, r.platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &restartHandler{
+		requestPath: proxyOpts.Path,
+		clientset:   clientset,
+	}, nil
+}
+
+// New creates a new helm proxy options object
+func (r *RestartREST) New() runtime.Object {
+	return &platform.HelmProxyOptions{}
+}
+
+// restartProgress reports the rolling restart progress of a component.
+type restartProgress struct {
+	Kind        string `json:"kind"`
+	Namespace   string `json:"namespace"`
+	Name        string `json:"name"`
+	RestartedAt string `json:"restartedAt,omitempty"`
+	Desired     int32  `json:"desired"`
+	Updated     int32  `json:"updated"`
+	Ready       int32  `json:"ready"`
+	Available   int32  `json:"available"`
+	Completed   bool   `json:"completed"`
+}
+
+type restartHandler struct {
+	requestPath string
+	clientset   kubernetes.Interface
+}
+
+func (h *restartHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	parts := strings.Split(strings.Trim(h.requestPath, "/"), "/")
+	if len(parts) != 2 || (parts[0] != restartKindDaemonSet && parts[0] != restartKindDeployment) || parts[1] == "" {
+		responsewriters.WriteRawJSON(http.StatusBadRequest,
+			errors.NewBadRequest(fmt.Sprintf("path must be %s/{name} or %s/{name}", restartKindDaemonSet, restartKindDeployment)), w)
+		return
+	}
+	kind, name := parts[0], parts[1]
+
+	status := http.StatusOK
+	if req.Method == http.MethodPost {
+		patch, err := restartPatch(kind, req.URL.Query().Get("maxUnavailable"), req.URL.Query().Get("maxSurge"))
+		if err != nil {
+			responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest(err.Error()), w)
+			return
+		}
+		if kind == restartKindDaemonSet {
+			_, err = h.clientset.AppsV1().DaemonSets(metav1.NamespaceSystem).Patch(req.Context(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+		} else {
+			_, err = h.clientset.AppsV1().Deployments(metav1.NamespaceSystem).Patch(req.Context(), name, types.MergePatchType, patch, metav1.PatchOptions{})
+		}
+		if err != nil {
+			writeRestartError(w, err)
+			return
+		}
+		status = http.StatusCreated
+	}
+
+	progress, err := h.progress(req.Context(), kind, name)
+	if err != nil {
+		writeRestartError(w, err)
+		return
+	}
+	responsewriters.WriteRawJSON(status, progress, w)
+}
+
+func (h *restartHandler) progress(ctx context.Context, kind string, name string) (*restartProgress, error) {
+	progress := &restartProgress{
+		Kind:      kind,
+		Namespace: metav1.NamespaceSystem,
+		Name:      name,
+	}
+	if kind == restartKindDaemonSet {
+		ds, err := h.clientset.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		progress.RestartedAt = ds.Spec.Template.Annotations[restartedAtAnnotation]
+		progress.Desired = ds.Status.DesiredNumberScheduled
+		progress.Updated = ds.Status.UpdatedNumberScheduled
+		progress.Ready = ds.Status.NumberReady
+		progress.Available = ds.Status.NumberAvailable
+		progress.Completed = ds.Status.ObservedGeneration >= ds.Generation &&
+			progress.Updated == progress.Desired &&
+			progress.Available == progress.Desired &&
+			ds.Status.CurrentNumberScheduled == progress.Desired
+		return progress, nil
+	}
+
+	deploy, err := h.clientset.AppsV1().Deployments(metav1.NamespaceSystem).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	progress.RestartedAt = deploy.Spec.Template.Annotations[restartedAtAnnotation]
+	progress.Desired = 1
+	if deploy.Spec.Replicas != nil {
+		progress.Desired = *deploy.Spec.Replicas
+	}
+	progress.Updated = deploy.Status.UpdatedReplicas
+	progress.Ready = deploy.Status.ReadyReplicas
+	progress.Available = deploy.Status.AvailableReplicas
+	progress.Completed = deploy.Status.ObservedGeneration >= deploy.Generation &&
+		progress.Updated == progress.Desired &&
+		progress.Available == progress.Desired &&
+		deploy.Status.Replicas == progress.Desired
+	return progress, nil
+}
+
+// restartPatch returns the merge patch which triggers a rolling restart of the
+// component, with the optional surge and unavailable controls of the rolling
+// update strategy.
+func restartPatch(kind string, maxUnavailable string, maxSurge string) ([]byte, error) {
+	rollingUpdate := make(map[string]interface{})
+	for key, value := range map[string]string{"maxUnavailable": maxUnavailable, "maxSurge": maxSurge} {
+		if value == "" {
+			continue
+		}
+		v := intstr.Parse(value)
+		if _, err := intstr.GetValueFromIntOrPercent(&v, 100, true); err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %v", key, value, err)
+		}
+		if v.Type == intstr.Int && v.IntVal < 0 {
+			return nil, fmt.Errorf("invalid %s %q: must be greater than or equal to 0", key, value)
+		}
+		rollingUpdate[key] = v
+	}
+
+	spec := map[string]interface{}{
+		"template": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"annotations": map[string]string{
+					restartedAtAnnotation: time.Now().Format(time.RFC3339),
+				},
+			},
+		},
+	}
+	if len(rollingUpdate) > 0 {
+		strategyKey, strategyType := "strategy", string(appsv1.RollingUpdateDeploymentStrategyType)
+		if kind == restartKindDaemonSet {
+			strategyKey, strategyType = "updateStrategy", string(appsv1.RollingUpdateDaemonSetStrategyType)
+		}
+		spec[strategyKey] = map[string]interface{}{
+			"type":          strategyType,
+			"rollingUpdate": rollingUpdate,
+		}
+	}
+
+	return json.Marshal(map[string]interface{}{"spec": spec})
+}
+
+func writeRestartError(w http.ResponseWriter, err error) {
+	if status, ok := err.(errors.APIStatus); ok {
+		responsewriters.WriteRawJSON(int(status.Status().Code), status.Status(), w)
+		return
+	}
+	responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+}
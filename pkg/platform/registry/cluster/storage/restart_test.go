@@ -0,0 +1,153 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestRestartPatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		kind           string
+		maxUnavailable string
+		maxSurge       string
+		wantStrategy   string
+		wantRolling    map[string]interface{}
+		wantErr        bool
+	}{
+		{name: "daemonset", kind: restartKindDaemonSet},
+		{name: "daemonset max unavailable", kind: restartKindDaemonSet, maxUnavailable: "10%",
+			wantStrategy: "updateStrategy", wantRolling: map[string]interface{}{"maxUnavailable": "10%"}},
+		{name: "deployment surge", kind: restartKindDeployment, maxUnavailable: "0", maxSurge: "1",
+			wantStrategy: "strategy", wantRolling: map[string]interface{}{"maxUnavailable": float64(0), "maxSurge": float64(1)}},
+		{name: "invalid percent", kind: restartKindDeployment, maxSurge: "a%", wantErr: true},
+		{name: "negative", kind: restartKindDaemonSet, maxUnavailable: "-1", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := restartPatch(tt.kind, tt.maxUnavailable, tt.maxSurge)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("restartPatch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			var patch struct {
+				Spec map[string]struct {
+					Metadata struct {
+						Annotations map[string]string `json:"annotations"`
+					} `json:"metadata"`
+					Type          string                 `json:"type"`
+					RollingUpdate map[string]interface{} `json:"rollingUpdate"`
+				} `json:"spec"`
+			}
+			if err := json.Unmarshal(data, &patch); err != nil {
+				t.Fatal(err)
+			}
+			if patch.Spec["template"].Metadata.Annotations[restartedAtAnnotation] == "" {
+				t.Errorf("restartPatch() = %s, want %s annotation", data, restartedAtAnnotation)
+			}
+			if tt.wantStrategy == "" {
+				if len(patch.Spec) != 1 {
+					t.Errorf("restartPatch() = %s, want the strategy unchanged", data)
+				}
+				return
+			}
+			strategy := patch.Spec[tt.wantStrategy]
+			if strategy.Type != "RollingUpdate" || !reflect.DeepEqual(strategy.RollingUpdate, tt.wantRolling) {
+				t.Errorf("restartPatch() = %s, want %s rolling update %v", data, tt.wantStrategy, tt.wantRolling)
+			}
+		})
+	}
+}
+
+func TestRestartHandler(t *testing.T) {
+	replicas := int32(2)
+	clientset := k8sfake.NewSimpleClientset(
+		&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "galaxy", Namespace: metav1.NamespaceSystem, Generation: 2},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration: 2, DesiredNumberScheduled: 3, CurrentNumberScheduled: 3,
+				UpdatedNumberScheduled: 3, NumberReady: 3, NumberAvailable: 3,
+			},
+		},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: metav1.NamespaceSystem, Generation: 3},
+			Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+			Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2},
+		},
+	)
+	tests := []struct {
+		name          string
+		method        string
+		path          string
+		query         string
+		wantStatus    int
+		wantCompleted bool
+	}{
+		{name: "invalid kind", method: http.MethodGet, path: "statefulsets/etcd", wantStatus: http.StatusBadRequest},
+		{name: "no name", method: http.MethodGet, path: "daemonsets/", wantStatus: http.StatusBadRequest},
+		{name: "not found", method: http.MethodGet, path: "daemonsets/flannel", wantStatus: http.StatusNotFound},
+		{name: "daemonset completed", method: http.MethodGet, path: "daemonsets/galaxy", wantStatus: http.StatusOK, wantCompleted: true},
+		{name: "deployment not observed", method: http.MethodGet, path: "/deployments/coredns/", wantStatus: http.StatusOK},
+		{name: "invalid max surge", method: http.MethodPost, path: "deployments/coredns", query: "maxSurge=-1", wantStatus: http.StatusBadRequest},
+		{name: "restart", method: http.MethodPost, path: "daemonsets/galaxy", query: "maxUnavailable=1", wantStatus: http.StatusCreated, wantCompleted: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &restartHandler{requestPath: tt.path, clientset: clientset}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(tt.method, "/restart?"+tt.query, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ServeHTTP() status = %d, want %d, body %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if w.Code >= http.StatusBadRequest {
+				return
+			}
+			progress := &restartProgress{}
+			if err := json.Unmarshal(w.Body.Bytes(), progress); err != nil {
+				t.Fatal(err)
+			}
+			if progress.Completed != tt.wantCompleted {
+				t.Errorf("ServeHTTP() progress = %+v, want completed %v", progress, tt.wantCompleted)
+			}
+			if tt.method == http.MethodPost && progress.RestartedAt == "" {
+				t.Errorf("ServeHTTP() progress = %+v, want restarted", progress)
+			}
+		})
+	}
+
+	ds, err := clientset.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(context.Background(), "galaxy", metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ds.Spec.UpdateStrategy.RollingUpdate == nil || ds.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable.IntValue() != 1 {
+		t.Errorf("daemonset update strategy = %+v, want max unavailable 1", ds.Spec.UpdateStrategy)
+	}
+}
@@ -0,0 +1,86 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/platform/util/sosreport"
+)
+
+// SOSReportREST implements the collection of journals, network state and key
+// config files of the master machines of cluster over SSH for debugging.
+type SOSReportREST struct {
+	rest.Storage
+	store *registry.Store
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *SOSReportREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *SOSReportREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.HelmProxyOptions{}, true, "path"
+}
+
+// Connect returns a handler which downloads the report tarball of the master
+// machine whose ip is specified by path.
+func (r *SOSReportREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	clusterObject, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c := clusterObject.(*platform.Cluster)
+	if err := util.FilterCluster(ctx, c); err != nil {
+		return nil, err
+	}
+	proxyOpts := opts.(*platform.HelmProxyOptions)
+
+	ip := strings.Trim(proxyOpts.Path, "/")
+	machines := append(append([]platform.ClusterMachine{}, c.Spec.Machines...), c.Spec.ScalingMachines...)
+	for i := range machines {
+		if machines[i].IP != ip {
+			continue
+		}
+		s, err := machines[i].SSH()
+		if err != nil {
+			return nil, err
+		}
+		return &sosreport.Handler{Name: ip, SSH: s}, nil
+	}
+
+	return nil, errors.NewBadRequest(fmt.Sprintf("path must be the ip of a master machine of cluster %s", clusterName))
+}
+
+// New creates a new helm proxy options object
+func (r *SOSReportREST) New() runtime.Object {
+	return &platform.HelmProxyOptions{}
+}
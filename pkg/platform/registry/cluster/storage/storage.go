@@ -62,6 +62,11 @@ type Storage struct {
 	LBCFBackendGroup  *LBCFBackendGroupREST
 	LBCFBackendRecord *LBCFBackendRecordREST
 	Drain             *DrainREST
+	Restart           *RestartREST
+	SOSReport         *SOSReportREST
+	UpgradePlan       *UpgradePlanREST
+	NetCheck          *NetCheckREST
+	Logs              *LogsREST
 	Proxy             *ProxyREST
 }
 
@@ -161,6 +166,25 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, platformClient pla
 			store:          store,
 			platformClient: platformClient,
 		},
+		Restart: &RestartREST{
+			store:          store,
+			platformClient: platformClient,
+		},
+		SOSReport: &SOSReportREST{
+			store: store,
+		},
+		UpgradePlan: &UpgradePlanREST{
+			store:          store,
+			platformClient: platformClient,
+		},
+		NetCheck: &NetCheckREST{
+			store:          store,
+			platformClient: platformClient,
+		},
+		Logs: &LogsREST{
+			store:          store,
+			platformClient: platformClient,
+		},
 		Proxy: &ProxyREST{
 			store:          store,
 			host:           host,
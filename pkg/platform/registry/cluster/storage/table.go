@@ -35,6 +35,7 @@ func AddHandlers(h printers.PrintHandler) {
 		{Name: "Type", Type: "string", Description: platformv1.ClusterSpec{}.SwaggerDoc()["type"]},
 		{Name: "Version", Type: "string", Description: platformv1.ClusterStatus{}.SwaggerDoc()["version"]},
 		{Name: "Status", Type: "string", Description: platformv1.ClusterStatus{}.SwaggerDoc()["phase"]},
+		{Name: "Health", Type: "integer", Description: platformv1.ClusterHealth{}.SwaggerDoc()["score"]},
 		{Name: "Age", Type: "string", Description: metav1.ObjectMeta{}.SwaggerDoc()["creationTimestamp"]},
 	}
 	h.TableHandler(clusterColumnDefinitions, printClusterList)
@@ -57,6 +58,10 @@ func printCluster(cluster *platform.Cluster, options printers.PrintOptions) ([]m
 	row := metav1.TableRow{
 		Object: runtime.RawExtension{Object: cluster},
 	}
-	row.Cells = append(row.Cells, cluster.Name, cluster.Spec.Type, cluster.Status.Version, cluster.Status.Phase, printers.TranslateTimestampSince(cluster.CreationTimestamp))
+	var health interface{} = "<unknown>"
+	if cluster.Status.Health != nil {
+		health = cluster.Status.Health.Score
+	}
+	row.Cells = append(row.Cells, cluster.Name, cluster.Spec.Type, cluster.Status.Version, cluster.Status.Phase, health, printers.TranslateTimestampSince(cluster.CreationTimestamp))
 	return []metav1beta1.TableRow{row}, nil
 }
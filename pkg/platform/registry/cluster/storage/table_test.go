@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/util/printers"
+)
+
+func TestPrintClusterHealth(t *testing.T) {
+	list := &platform.ClusterList{
+		Items: []platform.Cluster{
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+				Status:     platform.ClusterStatus{Health: &platform.ClusterHealth{Score: 72}},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{Name: "cls-b"},
+			},
+		},
+	}
+	rows, err := printClusterList(list, printers.PrintOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+	// Name, Type, Version, Status, Health, Age
+	if health := rows[0].Cells[4]; health != int32(72) {
+		t.Errorf("expected health 72, got %v", health)
+	}
+	if health := rows[1].Cells[4]; health != "<unknown>" {
+		t.Errorf("expected unknown health, got %v", health)
+	}
+}
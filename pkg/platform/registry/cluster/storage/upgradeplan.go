@@ -0,0 +1,394 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/client-go/kubernetes"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
+	"tkestack.io/tke/api/platform"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/provider/baremetal/images"
+	csioperatorimages "tkestack.io/tke/pkg/platform/provider/baremetal/phases/csioperator/images"
+	galaxyimages "tkestack.io/tke/pkg/platform/provider/baremetal/phases/galaxy/images"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/platform/util/vendor"
+)
+
+// upgradeComponents are the components whose versions follow the kubernetes version.
+var upgradeComponents = []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler", "kube-proxy", "kubelet", "kubeadm"}
+
+// deprecatedAPIMetric matches the requested deprecated apis metric of kube-apiserver.
+var deprecatedAPIMetric = regexp.MustCompile(`^apiserver_requested_deprecated_apis\{(.*)\}`)
+
+var metricLabel = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+// UpgradePlanREST implements the upgrade plan of cluster, which reports what
+// will change if the cluster is upgraded to the version without mutating anything.
+type UpgradePlanREST struct {
+	rest.Storage
+	store          *registry.Store
+	platformClient platforminternalclient.PlatformInterface
+}
+
+// ConnectMethods returns the list of HTTP methods that can be proxied
+func (r *UpgradePlanREST) ConnectMethods() []string {
+	return []string{"GET"}
+}
+
+// NewConnectOptions returns versioned resource that represents proxy parameters
+func (r *UpgradePlanREST) NewConnectOptions() (runtime.Object, bool, string) {
+	return &platform.HelmProxyOptions{}, false, ""
+}
+
+// Connect returns a handler which reports the upgrade plan to the version
+// specified by query parameter version.
+func (r *UpgradePlanREST) Connect(ctx context.Context, clusterName string, opts runtime.Object, responder rest.Responder) (http.Handler, error) {
+	clusterObject, err := r.store.Get(ctx, clusterName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	c := clusterObject.(*platform.Cluster)
+	if err := util.FilterCluster(ctx, c); err != nil {
+		return nil, err
+	}
+
+	clientset, err := util.ClientSetByCluster(ctx, c, r.platformClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &upgradePlanHandler{
+		cluster:        c,
+		clientset:      clientset,
+		platformClient: r.platformClient,
+	}, nil
+}
+
+// New creates a new helm proxy options object
+func (r *UpgradePlanREST) New() runtime.Object {
+	return &platform.HelmProxyOptions{}
+}
+
+// upgradePlan is the plan of upgrading cluster to the target version.
+type upgradePlan struct {
+	Cluster        string `json:"cluster"`
+	CurrentVersion string `json:"currentVersion"`
+	TargetVersion  string `json:"targetVersion"`
+	// Blockers are the reasons which prevent the upgrade.
+	Blockers       []string             `json:"blockers,omitempty"`
+	Components     []upgradeChange      `json:"components"`
+	Batches        []upgradeBatch       `json:"batches"`
+	Addons         []upgradeChange      `json:"addons,omitempty"`
+	DeprecatedAPIs []deprecatedAPIUsage `json:"deprecatedAPIs,omitempty"`
+}
+
+type upgradeChange struct {
+	Name    string `json:"name"`
+	Current string `json:"current"`
+	Target  string `json:"target"`
+}
+
+// upgradeBatch is the nodes upgraded at the same time, batches are upgraded in order.
+type upgradeBatch struct {
+	Role  string   `json:"role"`
+	Nodes []string `json:"nodes"`
+	// Note describes how the batch is started or held.
+	Note string `json:"note,omitempty"`
+}
+
+type deprecatedAPIUsage struct {
+	Group          string `json:"group"`
+	Version        string `json:"version"`
+	Resource       string `json:"resource"`
+	RemovedRelease string `json:"removedRelease"`
+}
+
+type upgradePlanHandler struct {
+	cluster        *platform.Cluster
+	clientset      kubernetes.Interface
+	platformClient platforminternalclient.PlatformInterface
+}
+
+func (h *upgradePlanHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	target := req.URL.Query().Get("version")
+	if target == "" {
+		responsewriters.WriteRawJSON(http.StatusBadRequest, errors.NewBadRequest("version must be specified"), w)
+		return
+	}
+	ctx := req.Context()
+	c := h.cluster
+
+	plan := &upgradePlan{
+		Cluster:        c.Name,
+		CurrentVersion: c.Status.Version,
+		TargetVersion:  target,
+	}
+	if c.Status.Phase != platform.ClusterRunning {
+		plan.Blockers = append(plan.Blockers, fmt.Sprintf("cluster phase is %s", c.Status.Phase))
+	}
+	if err := h.checkUpgradable(ctx, c.Status.Version, target); err != nil {
+		plan.Blockers = append(plan.Blockers, err.Error())
+	}
+	for _, name := range upgradeComponents {
+		plan.Components = append(plan.Components, upgradeChange{Name: name, Current: "v" + c.Status.Version, Target: "v" + target})
+	}
+
+	var err error
+	plan.Batches, err = h.batches(ctx)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	plan.Addons, plan.Blockers, err = h.addons(ctx, target, plan.Blockers)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+	plan.DeprecatedAPIs, err = h.deprecatedAPIs(ctx, target)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+
+	responsewriters.WriteRawJSON(http.StatusOK, plan, w)
+}
+
+// checkUpgradable checks the target version is in the version catalog of
+// global cluster and is upgradable from the current version.
+func (h *upgradePlanHandler) checkUpgradable(ctx context.Context, current, target string) error {
+	if current == target {
+		return fmt.Errorf("cluster is already %s", target)
+	}
+	if vendor.GetKubeVendor(current) == platformv1.KubeVendorTKE && vendor.GetKubeVendor(target) != platformv1.KubeVendorTKE {
+		return fmt.Errorf("not support upgrade from vendor %s to vendor %s", vendor.GetKubeVendor(current), vendor.GetKubeVendor(target))
+	}
+
+	global, err := h.platformClient.Clusters().Get(ctx, "global", metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	credential, err := util.GetClusterCredential(ctx, h.platformClient, global)
+	if err != nil {
+		return err
+	}
+	client, err := util.BuildClientSet(ctx, global, credential)
+	if err != nil {
+		return err
+	}
+	catalog, err := util.GetVersionCatalog(ctx, client)
+	if err != nil {
+		return err
+	}
+	upgradableVersions, err := catalog.Upgradable(current)
+	if err != nil {
+		return err
+	}
+	for _, v := range upgradableVersions {
+		if v == target {
+			return nil
+		}
+	}
+	return fmt.Errorf("version %s can't be upgraded to from %s, supported versions: %v", target, current, upgradableVersions)
+}
+
+// batches returns the nodes in the order they are upgraded, masters are
+// upgraded one by one and workers are upgraded by the max unavailable plus the
+// max surge of upgrade strategy in the order of machine names.
+func (h *upgradePlanHandler) batches(ctx context.Context) ([]upgradeBatch, error) {
+	c := h.cluster
+	upgrade := c.Spec.Features.Upgrade
+
+	var batches []upgradeBatch
+	for i, machine := range c.Spec.Machines {
+		batch := upgradeBatch{Role: "master", Nodes: []string{machine.IP}}
+		if i == 0 && upgrade.Canary != nil {
+			batch.Note = "canary, the upgrade is held for approval after this batch"
+		}
+		batches = append(batches, batch)
+	}
+
+	machines, err := h.platformClient.Machines().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(platformv1.MachineClusterField, c.Name).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(machines.Items) == 0 {
+		return batches, nil
+	}
+	sort.Slice(machines.Items, func(i, j int) bool {
+		return machines.Items[i].Name < machines.Items[j].Name
+	})
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(
+		intstr.ValueOrDefault(upgrade.Strategy.MaxUnavailable, intstr.FromInt(1)), len(machines.Items), false)
+	if err != nil {
+		return nil, err
+	}
+	if maxUnavailable < 1 {
+		maxUnavailable = 1
+	}
+	maxSurge, err := intstr.GetValueFromIntOrPercent(
+		intstr.ValueOrDefault(upgrade.Strategy.MaxSurge, intstr.FromInt(0)), len(machines.Items), true)
+	if err != nil {
+		return nil, err
+	}
+	size := maxUnavailable + maxSurge
+	note := ""
+	if upgrade.Mode == platform.UpgradeModeManual {
+		note = "manual mode, only the machines labeled to upgrade are upgraded"
+	}
+	for i := 0; i < len(machines.Items); i += size {
+		batch := upgradeBatch{Role: "worker", Note: note}
+		for j := i; j < i+size && j < len(machines.Items); j++ {
+			batch.Nodes = append(batch.Nodes, machines.Items[j].Spec.IP)
+		}
+		batches = append(batches, batch)
+	}
+
+	return batches, nil
+}
+
+// addons returns the addon objects which are upgraded to compatible versions,
+// the addons which have no compatible version are appended to blockers.
+func (h *upgradePlanHandler) addons(ctx context.Context, target string, blockers []string) ([]upgradeChange, []string, error) {
+	c := h.cluster
+	options := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", c.Name).String(),
+	}
+
+	var addons []compatibility.ClusterAddon
+	cronHPAs, err := h.platformClient.CronHPAs().List(ctx, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, one := range cronHPAs.Items {
+		addons = append(addons, compatibility.ClusterAddon{Addon: compatibility.CronHPA, Name: one.Name, Version: one.Spec.Version})
+	}
+	tappControllers, err := h.platformClient.TappControllers().List(ctx, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, one := range tappControllers.Items {
+		addons = append(addons, compatibility.ClusterAddon{Addon: compatibility.TappController, Name: one.Name, Version: one.Spec.Version})
+	}
+	csiOperators, err := h.platformClient.CSIOperators().List(ctx, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, one := range csiOperators.Items {
+		addons = append(addons, compatibility.ClusterAddon{Addon: compatibility.CSIOperator, Name: one.Name, Version: one.Spec.Version})
+	}
+
+	var changes []upgradeChange
+	for _, addon := range addons {
+		if compatibility.Check(addon.Addon, addon.Version, target) == nil {
+			continue
+		}
+		latest, ok, err := compatibility.LatestCompatible(addon.Addon, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			blockers = append(blockers, fmt.Sprintf("no version of %s %s works with kubernetes %s", addon.Addon, addon.Name, target))
+			continue
+		}
+		changes = append(changes, upgradeChange{Name: fmt.Sprintf("%s/%s", addon.Addon, addon.Name), Current: addon.Version, Target: latest})
+	}
+
+	// the addons installed by provider can't be upgraded
+	var installed []compatibility.ClusterAddon
+	if c.Network() == platform.NetworkTypeGalaxy {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.Galaxy, Version: galaxyimages.LatestVersion})
+	}
+	if c.Spec.Features.GPUType != nil && *c.Spec.Features.GPUType == platform.GPUVirtual {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.GPUManager, Version: images.Get().GPUManager.Tag})
+	}
+	if c.Spec.Features.CSIOperator != nil {
+		installed = append(installed, compatibility.ClusterAddon{Addon: compatibility.CSIOperator, Version: csioperatorimages.LatestVersion})
+	}
+	for _, addon := range installed {
+		if err := compatibility.Check(addon.Addon, addon.Version, target); err != nil {
+			blockers = append(blockers, err.Error())
+		}
+	}
+
+	return changes, blockers, nil
+}
+
+// deprecatedAPIs returns the deprecated apis requested in cluster which are
+// removed in the target version, according to the metrics of kube-apiserver.
+func (h *upgradePlanHandler) deprecatedAPIs(ctx context.Context, target string) ([]deprecatedAPIUsage, error) {
+	targetVersion, err := version.ParseGeneric(target)
+	if err != nil {
+		return nil, err
+	}
+	data, err := h.clientset.CoreV1().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []deprecatedAPIUsage
+	seen := map[deprecatedAPIUsage]bool{}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		match := deprecatedAPIMetric.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		labels := map[string]string{}
+		for _, label := range metricLabel.FindAllStringSubmatch(match[1], -1) {
+			labels[label[1]] = label[2]
+		}
+		removed, err := version.ParseGeneric(labels["removed_release"])
+		if err != nil || targetVersion.LessThan(removed) {
+			continue
+		}
+		usage := deprecatedAPIUsage{
+			Group:          labels["group"],
+			Version:        labels["version"],
+			Resource:       labels["resource"],
+			RemovedRelease: labels["removed_release"],
+		}
+		if !seen[usage] {
+			seen[usage] = true
+			usages = append(usages, usage)
+		}
+	}
+
+	return usages, scanner.Err()
+}
@@ -0,0 +1,196 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"tkestack.io/tke/api/client/clientset/internalversion/fake"
+	"tkestack.io/tke/api/platform"
+)
+
+func newMachine(name string, ip string) *platform.Machine {
+	return &platform.Machine{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       platform.MachineSpec{ClusterName: "cls-a", IP: ip},
+	}
+}
+
+func TestUpgradePlanBatches(t *testing.T) {
+	maxUnavailable := intstr.FromInt(2)
+	maxSurge := intstr.FromString("25%")
+	tests := []struct {
+		name    string
+		upgrade platform.Upgrade
+		want    []upgradeBatch
+	}{
+		{
+			name: "default strategy",
+			want: []upgradeBatch{
+				{Role: "master", Nodes: []string{"10.0.0.1"}},
+				{Role: "master", Nodes: []string{"10.0.0.2"}},
+				{Role: "worker", Nodes: []string{"10.0.1.1"}},
+				{Role: "worker", Nodes: []string{"10.0.1.2"}},
+				{Role: "worker", Nodes: []string{"10.0.1.3"}},
+				{Role: "worker", Nodes: []string{"10.0.1.4"}},
+			},
+		},
+		{
+			name: "canary and manual mode",
+			upgrade: platform.Upgrade{
+				Mode:     platform.UpgradeModeManual,
+				Strategy: platform.UpgradeStrategy{MaxUnavailable: &maxUnavailable, MaxSurge: &maxSurge},
+				Canary:   &platform.UpgradeCanary{},
+			},
+			want: []upgradeBatch{
+				{Role: "master", Nodes: []string{"10.0.0.1"}, Note: "canary, the upgrade is held for approval after this batch"},
+				{Role: "master", Nodes: []string{"10.0.0.2"}},
+				{Role: "worker", Nodes: []string{"10.0.1.1", "10.0.1.2", "10.0.1.3"}, Note: "manual mode, only the machines labeled to upgrade are upgraded"},
+				{Role: "worker", Nodes: []string{"10.0.1.4"}, Note: "manual mode, only the machines labeled to upgrade are upgraded"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				newMachine("mc-d", "10.0.1.4"),
+				newMachine("mc-b", "10.0.1.2"),
+				newMachine("mc-a", "10.0.1.1"),
+				newMachine("mc-c", "10.0.1.3"),
+			)
+			h := &upgradePlanHandler{
+				cluster: &platform.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+					Spec: platform.ClusterSpec{
+						Features: platform.ClusterFeature{Upgrade: tt.upgrade},
+						Machines: []platform.ClusterMachine{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+					},
+				},
+				platformClient: client.Platform(),
+			}
+			got, err := h.batches(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("batches() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpgradePlanBatchesWithoutWorkers(t *testing.T) {
+	h := &upgradePlanHandler{
+		cluster: &platform.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+			Spec:       platform.ClusterSpec{Machines: []platform.ClusterMachine{{IP: "10.0.0.1"}}},
+		},
+		platformClient: fake.NewSimpleClientset().Platform(),
+	}
+	got, err := h.batches(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []upgradeBatch{{Role: "master", Nodes: []string{"10.0.0.1"}}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("batches() = %+v, want %+v", got, want)
+	}
+}
+
+func TestUpgradePlanAddons(t *testing.T) {
+	virtual := platform.GPUVirtual
+	tests := []struct {
+		name         string
+		cluster      platform.ClusterSpec
+		target       string
+		wantBlockers []string
+	}{
+		{
+			name:    "compatible",
+			cluster: platform.ClusterSpec{NetworkType: platform.NetworkTypeCilium},
+			target:  "1.20.4",
+		},
+		{
+			name:    "incompatible addons",
+			cluster: platform.ClusterSpec{NetworkType: platform.NetworkTypeGalaxy},
+			target:  "1.11.0",
+			wantBlockers: []string{
+				"no version of TappController tapp works with kubernetes 1.11.0",
+				"Galaxy v1.0.0 is not compatible with kubernetes 1.11.0, requires >= 1.12.0",
+			},
+		},
+		{
+			name: "incompatible gpu manager",
+			cluster: platform.ClusterSpec{
+				NetworkType: platform.NetworkTypeCilium,
+				Features:    platform.ClusterFeature{GPUType: &virtual},
+			},
+			target: "1.22.0",
+			wantBlockers: []string{
+				"GPUManager v1.0.6 is not compatible with kubernetes 1.22.0, requires >= 1.10.0, < 1.22.0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset(
+				&platform.CronHPA{
+					ObjectMeta: metav1.ObjectMeta{Name: "cronhpa"},
+					Spec:       platform.CronHPASpec{ClusterName: "cls-a", Version: "v1.0.1"},
+				},
+				&platform.TappController{
+					ObjectMeta: metav1.ObjectMeta{Name: "tapp"},
+					Spec:       platform.TappControllerSpec{ClusterName: "cls-a", Version: "v1.2.1"},
+				},
+			)
+			h := &upgradePlanHandler{
+				cluster: &platform.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "cls-a"},
+					Spec:       tt.cluster,
+				},
+				platformClient: client.Platform(),
+			}
+			changes, blockers, err := h.addons(context.Background(), tt.target, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 0 {
+				t.Errorf("expected no addon changes, got %+v", changes)
+			}
+			if !reflect.DeepEqual(blockers, tt.wantBlockers) {
+				t.Errorf("addons() blockers = %q, want %q", blockers, tt.wantBlockers)
+			}
+		})
+	}
+}
+
+func TestUpgradePlanRequiresVersion(t *testing.T) {
+	h := &upgradePlanHandler{cluster: &platform.Cluster{}}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/upgradeplan", nil))
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -25,10 +25,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"tkestack.io/tke/api/platform"
 	cronhpa "tkestack.io/tke/pkg/platform/controller/addon/cronhpa/images"
+	"tkestack.io/tke/pkg/platform/controller/addon/dependency"
+	deviceplugin "tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+	egressgateway "tkestack.io/tke/pkg/platform/controller/addon/egressgateway/images"
 	helm "tkestack.io/tke/pkg/platform/controller/addon/helm/images"
 	ipam "tkestack.io/tke/pkg/platform/controller/addon/ipam/images"
 	lbcf "tkestack.io/tke/pkg/platform/controller/addon/lbcf/images"
 	logcollector "tkestack.io/tke/pkg/platform/controller/addon/logcollector/images"
+	metallb "tkestack.io/tke/pkg/platform/controller/addon/metallb/images"
+	multus "tkestack.io/tke/pkg/platform/controller/addon/multus/images"
 	persistentevent "tkestack.io/tke/pkg/platform/controller/addon/persistentevent/images"
 	prometheus "tkestack.io/tke/pkg/platform/controller/addon/prometheus/images"
 	volumedecorator "tkestack.io/tke/pkg/platform/controller/addon/storage/volumedecorator/images"
@@ -64,6 +69,14 @@ const (
 	IPAM AddonType = "IPAM"
 	// LBCF is type for LBCF
 	LBCF AddonType = "LBCF"
+	// EgressGateway is type for EgressGateway
+	EgressGateway AddonType = "EgressGateway"
+	// MetalLB is type for MetalLB
+	MetalLB AddonType = "MetalLB"
+	// Multus is type for Multus
+	Multus AddonType = "Multus"
+	// DevicePlugin is type for DevicePlugin
+	DevicePlugin AddonType = "DevicePlugin"
 )
 
 // Types defines the type of each plugin and the mapping table of the latest
@@ -169,6 +182,53 @@ var Types = map[AddonType]platform.ClusterAddonType{
 		Description:           description("LBCF.md"),
 		CompatibleClusterType: cluster.Providers(),
 	},
+	EgressGateway: {
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.ToLower(string(EgressGateway)),
+		},
+		Type:                  string(EgressGateway),
+		Level:                 platform.LevelEnhance,
+		LatestVersion:         egressgateway.LatestVersion,
+		Description:           description("EgressGateway.md"),
+		CompatibleClusterType: cluster.Providers(),
+	},
+	MetalLB: {
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.ToLower(string(MetalLB)),
+		},
+		Type:                  string(MetalLB),
+		Level:                 platform.LevelEnhance,
+		LatestVersion:         metallb.LatestVersion,
+		Description:           description("MetalLB.md"),
+		CompatibleClusterType: cluster.Providers(),
+	},
+	Multus: {
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.ToLower(string(Multus)),
+		},
+		Type:                  string(Multus),
+		Level:                 platform.LevelEnhance,
+		LatestVersion:         multus.LatestVersion,
+		Description:           description("Multus.md"),
+		CompatibleClusterType: cluster.Providers(),
+	},
+	DevicePlugin: {
+		ObjectMeta: metav1.ObjectMeta{
+			Name: strings.ToLower(string(DevicePlugin)),
+		},
+		Type:                  string(DevicePlugin),
+		Level:                 platform.LevelEnhance,
+		LatestVersion:         deviceplugin.LatestVersion,
+		Description:           description("DevicePlugin.md"),
+		CompatibleClusterType: cluster.Providers(),
+	},
+}
+
+func init() {
+	for t, addonType := range Types {
+		addonType.Dependencies = dependency.Graph[string(t)]
+		Types[t] = addonType
+	}
 }
 
 func description(name string) string {
@@ -0,0 +1,284 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metainternal "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication"
+	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/platform/registry/clusteroperation"
+	"tkestack.io/tke/pkg/platform/util"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// Storage includes storage for ClusterOperation and all sub resources.
+type Storage struct {
+	ClusterOperation *REST
+	Status           *StatusREST
+}
+
+// NewStorage returns a Storage object that will work against ClusterOperation.
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername string) *Storage {
+	strategy := clusteroperation.NewStrategy()
+	store := &registry.Store{
+		NewFunc:                  func() runtime.Object { return &platform.ClusterOperation{} },
+		NewListFunc:              func() runtime.Object { return &platform.ClusterOperationList{} },
+		DefaultQualifiedResource: platform.Resource("clusteroperations"),
+		PredicateFunc:            clusteroperation.MatchClusterOperation,
+
+		CreateStrategy: strategy,
+		UpdateStrategy: strategy,
+		DeleteStrategy: strategy,
+		ExportStrategy: strategy,
+	}
+	store.TableConvertor = rest.NewDefaultTableConvertor(store.DefaultQualifiedResource)
+	options := &genericregistry.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    clusteroperation.GetAttrs,
+	}
+
+	if err := store.CompleteWithOptions(options); err != nil {
+		log.Panic("Failed to create ClusterOperation etcd rest storage", log.Err(err))
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = clusteroperation.NewStatusStrategy(strategy)
+	statusStore.ExportStrategy = clusteroperation.NewStatusStrategy(strategy)
+
+	return &Storage{
+		ClusterOperation: &REST{store, privilegedUsername},
+		Status:           &StatusREST{&statusStore, privilegedUsername},
+	}
+}
+
+// ValidateGetObjectAndTenantID validate name and tenantID, if success return ClusterOperation
+func ValidateGetObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := store.Get(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := obj.(*platform.ClusterOperation)
+	if err := util.FilterClusterOperation(ctx, operation); err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// ValidateExportObjectAndTenantID validate name and tenantID, if success return ClusterOperation
+func ValidateExportObjectAndTenantID(ctx context.Context, store *registry.Store, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	obj, err := store.Export(ctx, name, options)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := obj.(*platform.ClusterOperation)
+	if err := util.FilterClusterOperation(ctx, operation); err != nil {
+		return nil, err
+	}
+	return operation, nil
+}
+
+// redact hides the request body of the operations from the users other than
+// administrators, as the body may contain secrets of the cluster.
+func redact(ctx context.Context, privilegedUsername string, obj runtime.Object) runtime.Object {
+	if authentication.IsAdministrator(ctx, privilegedUsername) {
+		return obj
+	}
+	switch o := obj.(type) {
+	case *platform.ClusterOperation:
+		if o.Spec.Body == "" {
+			return o
+		}
+		operation := o.DeepCopy()
+		operation.Spec.Body = ""
+		return operation
+	case *platform.ClusterOperationList:
+		list := o.DeepCopy()
+		for i := range list.Items {
+			list.Items[i].Spec.Body = ""
+		}
+		return list
+	}
+	return obj
+}
+
+// REST implements a RESTStorage for ClusterOperation against etcd.
+type REST struct {
+	*registry.Store
+	privilegedUsername string
+}
+
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of
+// short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"co"}
+}
+
+// Create accepts the operations queued by platform apiserver only, which
+// records the identity of the user who submitted the request.
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	username, _ := authentication.UsernameAndTenantID(ctx)
+	if username != user.APIServerUser && !authentication.IsAdministrator(ctx, r.privilegedUsername) {
+		return nil, errors.NewMethodNotSupported(platform.Resource("clusteroperations"), "create")
+	}
+	return r.Store.Create(ctx, obj, createValidation, options)
+}
+
+// List selects resources in the storage which match to the selector. 'options' can be nil.
+func (r *REST) List(ctx context.Context, options *metainternal.ListOptions) (runtime.Object, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	obj, err := r.Store.List(ctx, wrappedOptions)
+	if err != nil {
+		return nil, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), nil
+}
+
+// Watch makes a matcher for the given label and field, and calls the
+// underlying storage to watch the resources.
+func (r *REST) Watch(ctx context.Context, options *metainternal.ListOptions) (watch.Interface, error) {
+	wrappedOptions := apiserverutil.PredicateListOptions(ctx, options)
+	w, err := r.Store.Watch(ctx, wrappedOptions)
+	if err != nil || authentication.IsAdministrator(ctx, r.privilegedUsername) {
+		return w, err
+	}
+	return watch.Filter(w, func(in watch.Event) (watch.Event, bool) {
+		in.Object = redact(ctx, r.privilegedUsername, in.Object)
+		return in, true
+	}), nil
+}
+
+// Get finds a resource in the storage by name and returns it.
+func (r *REST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), nil
+}
+
+// Export an object.  Fields that are not user specified are stripped out
+// Returns the stripped object.
+func (r *REST) Export(ctx context.Context, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	obj, err := ValidateExportObjectAndTenantID(ctx, r.Store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), nil
+}
+
+// Update finds a resource in the storage and updates it.
+func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// sub resources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	obj, created, err := r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), created, nil
+}
+
+// Delete enforces life-cycle rules for cluster termination
+func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
+	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	obj, deleted, err := r.Store.Delete(ctx, name, deleteValidation, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), deleted, nil
+}
+
+// DeleteCollection selects all resources in the storage matching given 'listOptions'
+// and deletes them.
+func (r *REST) DeleteCollection(ctx context.Context, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions, listOptions *metainternal.ListOptions) (runtime.Object, error) {
+	if !authentication.IsAdministrator(ctx, r.privilegedUsername) {
+		return nil, errors.NewMethodNotSupported(platform.Resource("clusteroperations"), "delete collection")
+	}
+	return r.Store.DeleteCollection(ctx, deleteValidation, options, listOptions)
+}
+
+// StatusREST implements the REST endpoint for changing the status of a ClusterOperation.
+type StatusREST struct {
+	store              *registry.Store
+	privilegedUsername string
+}
+
+// StatusREST implements Patcher.
+var _ = rest.Patcher(&StatusREST{})
+
+// New returns an empty object that can be used with Create and Update after
+// request data has been put into it.
+func (r *StatusREST) New() runtime.Object {
+	return r.store.New()
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), nil
+}
+
+// Export an object.  Fields that are not user specified are stripped out
+// Returns the stripped object.
+func (r *StatusREST) Export(ctx context.Context, name string, options metav1.ExportOptions) (runtime.Object, error) {
+	obj, err := ValidateExportObjectAndTenantID(ctx, r.store, name, options)
+	if err != nil {
+		return nil, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), nil
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	// We are explicitly setting forceAllowCreate to false in the call to the underlying storage because
+	// sub resources should never allow create on update.
+	_, err := ValidateGetObjectAndTenantID(ctx, r.store, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+	obj, created, err := r.store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
+	if err != nil {
+		return nil, false, err
+	}
+	return redact(ctx, r.privilegedUsername, obj), created, nil
+}
@@ -0,0 +1,62 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/apiserver/authentication/authenticator/oidc"
+)
+
+func TestRedact(t *testing.T) {
+	operation := &platform.ClusterOperation{
+		Spec: platform.ClusterOperationSpec{
+			Verb: "POST",
+			Path: "/api/v1/namespaces/default/secrets",
+			Body: `{"kind":"Secret","data":{"password":"cGFzc3dvcmQ="}}`,
+		},
+	}
+	admin := request.WithUser(context.Background(), &user.DefaultInfo{Name: "admin"})
+	tenant := request.WithUser(context.Background(), &user.DefaultInfo{
+		Name:  "admin",
+		Extra: map[string][]string{oidc.TenantIDKey: {"default"}},
+	})
+
+	if got := redact(admin, "admin", operation).(*platform.ClusterOperation); got.Spec.Body != operation.Spec.Body {
+		t.Errorf("expected the body is kept for administrator, got %q", got.Spec.Body)
+	}
+	got := redact(tenant, "admin", operation).(*platform.ClusterOperation)
+	if got.Spec.Body != "" {
+		t.Errorf("expected the body is redacted for tenant, got %q", got.Spec.Body)
+	}
+	if got.Spec.Path != operation.Spec.Path || operation.Spec.Body == "" {
+		t.Errorf("expected only the body of a copy is redacted")
+	}
+
+	list := &platform.ClusterOperationList{Items: []platform.ClusterOperation{*operation, *operation}}
+	for _, item := range redact(tenant, "admin", list).(*platform.ClusterOperationList).Items {
+		if item.Spec.Body != "" {
+			t.Errorf("expected the body of list is redacted for tenant, got %q", item.Spec.Body)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package clusteroperation
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// supportedVerbs are the HTTP methods of the mutations which can be queued.
+var supportedVerbs = []string{http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// ValidateClusterOperation tests if required fields in the operation are set.
+func ValidateClusterOperation(operation *platform.ClusterOperation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&operation.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(operation.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("clusterName"), "must specify a cluster name"))
+	}
+	supported := false
+	for _, verb := range supportedVerbs {
+		if operation.Spec.Verb == verb {
+			supported = true
+		}
+	}
+	if !supported {
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("verb"), operation.Spec.Verb, supportedVerbs))
+	}
+	if u, err := url.ParseRequestURI(operation.Spec.Path); err != nil || u.IsAbs() || !strings.HasPrefix(u.Path, "/api") {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("path"), operation.Spec.Path, "must be a request URI of kubernetes api"))
+	}
+	if len(operation.Spec.Username) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("username"), "must specify the user who submitted the operation"))
+	}
+	if operation.Spec.ExpireTime.IsZero() {
+		allErrs = append(allErrs, field.Required(specPath.Child("expireTime"), "must specify the expire time"))
+	}
+
+	return allErrs
+}
+
+// ValidateClusterOperationUpdate tests if required fields in the operation are
+// set during an update.
+func ValidateClusterOperationUpdate(new *platform.ClusterOperation, old *platform.ClusterOperation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateClusterOperation(new)...)
+
+	// the request is replayed as the submitter, it must not be changed
+	if !apiequality.Semantic.DeepEqual(new.Spec, old.Spec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "disallowed change the operation"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
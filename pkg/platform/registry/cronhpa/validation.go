@@ -19,11 +19,21 @@
 package cronhpa
 
 import (
+	"context"
+	"regexp"
+
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/platform/util/validation"
 )
 
+// timeZoneRegexp matches the names of IANA time zones, which are loaded from
+// the zoneinfo of nodes by cron-hpa-controller.
+var timeZoneRegexp = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_+-]*(/[A-Za-z0-9_+-]+)*$`)
+
 // ValidateName is a ValidateNameFunc for names that must be a DNS
 // subdomain.
 var ValidateName = apiMachineryValidation.ValidateNamespaceName
@@ -35,10 +45,23 @@ func ValidateCronHPA(cronHPA *platform.CronHPA) field.ErrorList {
 	if len(cronHPA.Spec.ClusterName) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
 	}
+	allErrs = append(allErrs, validation.ValidateCRDConflictPolicy(cronHPA.Spec.CRDConflictPolicy, field.NewPath("spec", "crdConflictPolicy"))...)
+	allErrs = append(allErrs, validation.ValidateAddonDriftPolicy(cronHPA.Spec.DriftPolicy, field.NewPath("spec", "driftPolicy"))...)
+	if cronHPA.Spec.TimeZone != "" && !timeZoneRegexp.MatchString(cronHPA.Spec.TimeZone) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "timeZone"), cronHPA.Spec.TimeZone, "must be an IANA time zone such as Asia/Shanghai"))
+	}
 
 	return allErrs
 }
 
+// ValidateCronHPAVersion tests if the version works with the kubernetes version of cluster.
+func ValidateCronHPAVersion(ctx context.Context, cronHPA *platform.CronHPA, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	if len(cronHPA.Spec.ClusterName) == 0 {
+		return nil
+	}
+	return validation.ValidateAddonCompatibility(ctx, platformClient, cronHPA.Spec.ClusterName, compatibility.CronHPA, cronHPA.Spec.Version)
+}
+
 // ValidateCronHPAUpdate tests if required fields in the namespace set are
 // set during an update.
 func ValidateCronHPAUpdate(new *platform.CronHPA, old *platform.CronHPA) field.ErrorList {
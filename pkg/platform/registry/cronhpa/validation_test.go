@@ -0,0 +1,55 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package cronhpa
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"tkestack.io/tke/api/platform"
+)
+
+func TestValidateCronHPATimeZone(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeZone string
+		wantErrs int
+	}{
+		{"default", "", 0},
+		{"area and location", "Asia/Shanghai", 0},
+		{"nested location", "America/Argentina/Buenos_Aires", 0},
+		{"offset", "Etc/GMT+8", 0},
+		{"utc", "UTC", 0},
+		{"path traversal", "../../etc/passwd", 1},
+		{"absolute path", "/usr/share/zoneinfo/UTC", 1},
+		{"space", "Asia/Hong Kong", 1},
+		{"trailing slash", "Asia/", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronHPA := &platform.CronHPA{
+				ObjectMeta: metav1.ObjectMeta{Name: "cronhpa-a"},
+				Spec:       platform.CronHPASpec{ClusterName: "cls-a", TimeZone: tt.timeZone},
+			}
+			if errs := ValidateCronHPA(cronHPA); len(errs) != tt.wantErrs {
+				t.Errorf("ValidateCronHPA() = %v, want %d errors", errs, tt.wantErrs)
+			}
+		})
+	}
+}
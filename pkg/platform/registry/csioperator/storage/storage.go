@@ -20,11 +20,14 @@ package storage
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"tkestack.io/tke/pkg/apiserver/authentication"
 
 	apiserverutil "tkestack.io/tke/pkg/apiserver/util"
+	"tkestack.io/tke/pkg/platform/controller/addon/dependency"
 	"tkestack.io/tke/pkg/platform/registry/csioperator"
 	"tkestack.io/tke/pkg/platform/util"
 
@@ -34,6 +37,8 @@ import (
 	genericregistry "k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/generic/registry"
 	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/apiserver/pkg/warning"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	"tkestack.io/tke/api/platform"
 	"tkestack.io/tke/pkg/util/log"
 )
@@ -45,8 +50,8 @@ type Storage struct {
 }
 
 // NewStorage returns a Storage object that will work against LogCollector.
-func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername string) *Storage {
-	strategy := csioperator.NewStrategy()
+func NewStorage(optsGetter genericregistry.RESTOptionsGetter, platformClient platforminternalclient.PlatformInterface, privilegedUsername string) *Storage {
+	strategy := csioperator.NewStrategy(platformClient)
 	store := &registry.Store{
 		NewFunc:                  func() runtime.Object { return &platform.CSIOperator{} },
 		NewListFunc:              func() runtime.Object { return &platform.CSIOperatorList{} },
@@ -73,7 +78,7 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, privilegedUsername
 	statusStore.ExportStrategy = csioperator.NewStatusStrategy(strategy)
 
 	return &Storage{
-		CSIOperator: &REST{store, privilegedUsername},
+		CSIOperator: &REST{store, platformClient, privilegedUsername},
 		Status:      &StatusREST{&statusStore},
 	}
 }
@@ -109,6 +114,7 @@ func ValidateExportObjectAndTenantID(ctx context.Context, store *registry.Store,
 // REST implements a RESTStorage for LogCollector against etcd.
 type REST struct {
 	*registry.Store
+	platformClient     platforminternalclient.PlatformInterface
 	privilegedUsername string
 }
 
@@ -148,12 +154,21 @@ func (r *REST) Update(ctx context.Context, name string, objInfo rest.UpdatedObje
 	return r.Store.Update(ctx, name, objInfo, createValidation, updateValidation, false, options)
 }
 
-// Delete enforces life-cycle rules for cluster termination
+// Delete enforces life-cycle rules for cluster termination, and warns about
+// the addons in the cluster which require CSIOperator.
 func (r *REST) Delete(ctx context.Context, name string, deleteValidation rest.ValidateObjectFunc, options *metav1.DeleteOptions) (runtime.Object, bool, error) {
-	_, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
+	obj, err := ValidateGetObjectAndTenantID(ctx, r.Store, name, &metav1.GetOptions{})
 	if err != nil {
 		return nil, false, err
 	}
+	clusterName := obj.(*platform.CSIOperator).Spec.ClusterName
+	dependents, err := dependency.InstalledDependents(ctx, r.platformClient, clusterName, "CSIOperator")
+	if err != nil {
+		log.Warn("Failed to find the addons requiring CSIOperator", log.String("clusterName", clusterName), log.Err(err))
+	}
+	if len(dependents) > 0 {
+		warning.AddWarning(ctx, "", fmt.Sprintf("addons %s in cluster %s require CSIOperator and will stop working", strings.Join(dependents, ", "), clusterName))
+	}
 	return r.Store.Delete(ctx, name, deleteValidation, options)
 }
 
@@ -19,9 +19,14 @@
 package csioperator
 
 import (
+	"context"
+
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/platform/util/validation"
 )
 
 // ValidateName is a ValidateNameFunc for names that must be a DNS sub domain.
@@ -34,10 +39,19 @@ func ValidateCSIOperator(csiOperator *platform.CSIOperator) field.ErrorList {
 	if len(csiOperator.Spec.ClusterName) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
 	}
+	allErrs = append(allErrs, validation.ValidateCRDConflictPolicy(csiOperator.Spec.CRDConflictPolicy, field.NewPath("spec", "crdConflictPolicy"))...)
 
 	return allErrs
 }
 
+// ValidateCSIOperatorVersion tests if the version works with the kubernetes version of cluster.
+func ValidateCSIOperatorVersion(ctx context.Context, csiOperator *platform.CSIOperator, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	if len(csiOperator.Spec.ClusterName) == 0 {
+		return nil
+	}
+	return validation.ValidateAddonCompatibility(ctx, platformClient, csiOperator.Spec.ClusterName, compatibility.CSIOperator, csiOperator.Spec.Version)
+}
+
 // ValidateCSIOperatorUpdate tests if required fields in the namespace set are
 // set during an update.
 func ValidateCSIOperatorUpdate(new *platform.CSIOperator, old *platform.CSIOperator) field.ErrorList {
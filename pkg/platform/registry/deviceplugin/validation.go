@@ -0,0 +1,138 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package deviceplugin
+
+import (
+	"path"
+	"strings"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/controller/addon/deviceplugin/images"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// kubeletDevicePluginPath is mounted into all the device plugins.
+const kubeletDevicePluginPath = "/var/lib/kubelet/device-plugins"
+
+// ValidateDevicePlugin tests if required fields in the DevicePlugin are set.
+func ValidateDevicePlugin(plugin *platform.DevicePlugin) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&plugin.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(plugin.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("clusterName"), "must specify a cluster name"))
+	}
+	if err := images.Validate(plugin.Spec.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("version"), plugin.Spec.Version, err.Error()))
+	}
+
+	builtin := sets.NewString(images.BuiltinClasses...).Has(plugin.Spec.DeviceClass)
+	for _, msg := range utilvalidation.IsDNS1123Label(plugin.Spec.DeviceClass) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("deviceClass"), plugin.Spec.DeviceClass, msg))
+	}
+	if plugin.Spec.Image == "" {
+		if !builtin {
+			allErrs = append(allErrs, field.Required(specPath.Child("image"), "must specify the image of device class "+plugin.Spec.DeviceClass))
+		}
+	} else if strings.ContainsAny(plugin.Spec.Image, " \t\n") {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("image"), plugin.Spec.Image, "must not contain whitespaces"))
+	}
+	if plugin.Spec.ResourcePrefix == "" {
+		if !builtin {
+			allErrs = append(allErrs, field.Required(specPath.Child("resourcePrefix"), "must specify the resource prefix of device class "+plugin.Spec.DeviceClass))
+		}
+	} else {
+		allErrs = append(allErrs, ValidateResourcePrefix(plugin.Spec.ResourcePrefix, specPath.Child("resourcePrefix"))...)
+	}
+
+	if plugin.Spec.ConfigPath != "" {
+		allErrs = append(allErrs, validateHostPath(plugin.Spec.ConfigPath, specPath.Child("configPath"))...)
+	} else if plugin.Spec.Config != "" && plugin.Spec.DeviceClass != images.ClassRDMA {
+		allErrs = append(allErrs, field.Required(specPath.Child("configPath"), "must specify the path of config file"))
+	}
+	paths := sets.NewString()
+	for i, hostPath := range plugin.Spec.HostPaths {
+		idxPath := specPath.Child("hostPaths").Index(i)
+		allErrs = append(allErrs, validateHostPath(hostPath, idxPath)...)
+		if path.Clean(hostPath) == kubeletDevicePluginPath {
+			allErrs = append(allErrs, field.Invalid(idxPath, hostPath, "is mounted by default"))
+		}
+		if paths.Has(path.Clean(hostPath)) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, hostPath))
+		}
+		paths.Insert(path.Clean(hostPath))
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(plugin.Spec.NodeSelector, specPath.Child("nodeSelector"))...)
+
+	return allErrs
+}
+
+// ValidateResourcePrefix validates the prefix of extended resources, which is
+// a DNS subdomain ending with a slash, such as qat.intel.com/.
+func ValidateResourcePrefix(prefix string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !strings.HasSuffix(prefix, "/") {
+		return append(allErrs, field.Invalid(fldPath, prefix, "must end with '/'"))
+	}
+	for _, msg := range utilvalidation.IsDNS1123Subdomain(strings.TrimSuffix(prefix, "/")) {
+		allErrs = append(allErrs, field.Invalid(fldPath, prefix, msg))
+	}
+	return allErrs
+}
+
+func validateHostPath(hostPath string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !path.IsAbs(hostPath) {
+		allErrs = append(allErrs, field.Invalid(fldPath, hostPath, "must be an absolute path"))
+	}
+	return allErrs
+}
+
+// ValidateDevicePluginUpdate tests if required fields in the DevicePlugin are
+// set during an update.
+func ValidateDevicePluginUpdate(new *platform.DevicePlugin, old *platform.DevicePlugin) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateDevicePlugin(new)...)
+
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	if new.Spec.DeviceClass != old.Spec.DeviceClass {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "deviceClass"), new.Spec.DeviceClass, "disallowed change the device class"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
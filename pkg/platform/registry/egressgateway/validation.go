@@ -0,0 +1,134 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// minFailoverTimeout avoids moving the egress IPs when a node is not ready
+// for a short while, such as restarting kubelet.
+const minFailoverTimeout = 10 * time.Second
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// ValidateEgressGateway tests if required fields in the cluster are set.
+func ValidateEgressGateway(egressGateway *platform.EgressGateway) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&egressGateway.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	if len(egressGateway.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
+	}
+	allErrs = append(allErrs, ValidateIPPools(egressGateway.Spec.IPPools, field.NewPath("spec", "ipPools"))...)
+	if timeout := egressGateway.Spec.FailoverTimeout; timeout != nil && timeout.Duration < minFailoverTimeout {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "failoverTimeout"), timeout.Duration.String(), fmt.Sprintf("must be at least %s", minFailoverTimeout)))
+	}
+
+	return allErrs
+}
+
+// ValidateIPPools validates the egress ip pools, an ip or a namespace can
+// only belong to one pool, and only one pool can be used by all namespaces.
+func ValidateIPPools(pools []platform.EgressIPPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.NewString()
+	ips := sets.NewString()
+	namespaces := sets.NewString()
+	projects := sets.NewString()
+	defaultPool := ""
+	for i, pool := range pools {
+		idxPath := fldPath.Index(i)
+		for _, msg := range utilvalidation.IsDNS1123Label(pool.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+		}
+		if names.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		names.Insert(pool.Name)
+
+		if len(pool.IPs) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("ips"), "must specify at least one egress ip"))
+		}
+		for j, ip := range pool.IPs {
+			if net.ParseIP(ip) == nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("ips").Index(j), ip, "must be a valid IP address"))
+				continue
+			}
+			if ips.Has(ip) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("ips").Index(j), ip))
+			}
+			ips.Insert(ip)
+		}
+
+		if pool.AllNamespaces {
+			if defaultPool != "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("allNamespaces"), pool.AllNamespaces, fmt.Sprintf("pool %s is already used by all namespaces", defaultPool)))
+			}
+			defaultPool = pool.Name
+		} else if len(pool.Namespaces) == 0 && len(pool.Projects) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify namespaces or projects using the pool"))
+		}
+		for j, namespace := range pool.Namespaces {
+			if namespaces.Has(namespace) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("namespaces").Index(j), namespace))
+			}
+			namespaces.Insert(namespace)
+		}
+		for j, project := range pool.Projects {
+			if projects.Has(project) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("projects").Index(j), project))
+			}
+			projects.Insert(project)
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateEgressGatewayUpdate tests if required fields in the namespace set are
+// set during an update.
+func ValidateEgressGatewayUpdate(new *platform.EgressGateway, old *platform.EgressGateway) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateEgressGateway(new)...)
+
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,207 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package egressgateway
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+func fields(errs field.ErrorList) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Field)
+	}
+	return result
+}
+
+func TestValidateIPPools(t *testing.T) {
+	tests := []struct {
+		name  string
+		pools []platform.EgressIPPool
+		want  []string
+	}{
+		{
+			name: "valid",
+			pools: []platform.EgressIPPool{
+				{Name: "default", IPs: []string{"10.0.0.1"}, AllNamespaces: true},
+				{Name: "a", IPs: []string{"10.0.0.2", "fd00::2"}, Namespaces: []string{"ns-a"}, Projects: []string{"prj-a"}},
+			},
+		},
+		{
+			name:  "invalid name",
+			pools: []platform.EgressIPPool{{Name: "Pool", IPs: []string{"10.0.0.1"}, AllNamespaces: true}},
+			want:  []string{"spec.ipPools[0].name"},
+		},
+		{
+			name: "duplicate names",
+			pools: []platform.EgressIPPool{
+				{Name: "a", IPs: []string{"10.0.0.1"}, Namespaces: []string{"ns-a"}},
+				{Name: "a", IPs: []string{"10.0.0.2"}, Namespaces: []string{"ns-b"}},
+			},
+			want: []string{"spec.ipPools[1].name"},
+		},
+		{
+			name:  "no ips",
+			pools: []platform.EgressIPPool{{Name: "a", Namespaces: []string{"ns-a"}}},
+			want:  []string{"spec.ipPools[0].ips"},
+		},
+		{
+			name:  "invalid ip",
+			pools: []platform.EgressIPPool{{Name: "a", IPs: []string{"10.0.0.256"}, Namespaces: []string{"ns-a"}}},
+			want:  []string{"spec.ipPools[0].ips[0]"},
+		},
+		{
+			name: "ip in two pools",
+			pools: []platform.EgressIPPool{
+				{Name: "a", IPs: []string{"10.0.0.1"}, Namespaces: []string{"ns-a"}},
+				{Name: "b", IPs: []string{"10.0.0.1"}, Namespaces: []string{"ns-b"}},
+			},
+			want: []string{"spec.ipPools[1].ips[0]"},
+		},
+		{
+			name: "two default pools",
+			pools: []platform.EgressIPPool{
+				{Name: "a", IPs: []string{"10.0.0.1"}, AllNamespaces: true},
+				{Name: "b", IPs: []string{"10.0.0.2"}, AllNamespaces: true},
+			},
+			want: []string{"spec.ipPools[1].allNamespaces"},
+		},
+		{
+			name:  "no namespaces",
+			pools: []platform.EgressIPPool{{Name: "a", IPs: []string{"10.0.0.1"}}},
+			want:  []string{"spec.ipPools[0]"},
+		},
+		{
+			name: "namespace and project in two pools",
+			pools: []platform.EgressIPPool{
+				{Name: "a", IPs: []string{"10.0.0.1"}, Namespaces: []string{"ns-a"}, Projects: []string{"prj-a"}},
+				{Name: "b", IPs: []string{"10.0.0.2"}, Namespaces: []string{"ns-a"}, Projects: []string{"prj-a"}},
+			},
+			want: []string{"spec.ipPools[1].namespaces[0]", "spec.ipPools[1].projects[0]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(ValidateIPPools(tt.pools, field.NewPath("spec", "ipPools")))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateIPPools() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEgressGateway(t *testing.T) {
+	tests := []struct {
+		name    string
+		cluster string
+		timeout *metav1.Duration
+		want    []string
+	}{
+		{
+			name:    "default timeout",
+			cluster: "cls",
+		},
+		{
+			name:    "timeout",
+			cluster: "cls",
+			timeout: &metav1.Duration{Duration: time.Minute},
+		},
+		{
+			name:    "short timeout",
+			cluster: "cls",
+			timeout: &metav1.Duration{Duration: time.Second},
+			want:    []string{"spec.failoverTimeout"},
+		},
+		{
+			name: "no cluster",
+			want: []string{"spec.clusterName"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			egressGateway := &platform.EgressGateway{
+				ObjectMeta: metav1.ObjectMeta{Name: "eg"},
+				Spec: platform.EgressGatewaySpec{
+					ClusterName:     tt.cluster,
+					IPPools:         []platform.EgressIPPool{{Name: "a", IPs: []string{"10.0.0.1"}, AllNamespaces: true}},
+					FailoverTimeout: tt.timeout,
+				},
+			}
+			got := fields(ValidateEgressGateway(egressGateway))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateEgressGateway() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEgressGatewayUpdate(t *testing.T) {
+	old := &platform.EgressGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "eg", ResourceVersion: "1"},
+		Spec: platform.EgressGatewaySpec{
+			TenantID:    "default",
+			ClusterName: "cls",
+			IPPools:     []platform.EgressIPPool{{Name: "a", IPs: []string{"10.0.0.1"}, AllNamespaces: true}},
+		},
+		Status: platform.EgressGatewayStatus{Phase: platform.AddonPhaseRunning},
+	}
+	tests := []struct {
+		name   string
+		update func(*platform.EgressGateway)
+		want   []string
+	}{
+		{
+			name: "pools changed",
+			update: func(e *platform.EgressGateway) {
+				e.Spec.IPPools[0].IPs = append(e.Spec.IPPools[0].IPs, "10.0.0.2")
+			},
+		},
+		{
+			name:   "cluster changed",
+			update: func(e *platform.EgressGateway) { e.Spec.ClusterName = "other" },
+			want:   []string{"spec.clusterName"},
+		},
+		{
+			name:   "tenant changed",
+			update: func(e *platform.EgressGateway) { e.Spec.TenantID = "other" },
+			want:   []string{"spec.tenantID"},
+		},
+		{
+			name:   "no phase",
+			update: func(e *platform.EgressGateway) { e.Status.Phase = "" },
+			want:   []string{"status.phase"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			egressGateway := old.DeepCopy()
+			tt.update(egressGateway)
+			got := fields(ValidateEgressGatewayUpdate(egressGateway, old))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateEgressGatewayUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package floatingipreservation
+
+import (
+	"net"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// supportedWorkloadKinds are the workload kinds which can reserve a floating IP.
+var supportedWorkloadKinds = []string{"Deployment", "StatefulSet", "TApp"}
+
+// ValidateFloatingIPReservation tests if required fields in the reservation are set.
+func ValidateFloatingIPReservation(reservation *platform.FloatingIPReservation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&reservation.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(reservation.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("clusterName"), "must specify a cluster name"))
+	}
+	if net.ParseIP(reservation.Spec.IP) == nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("ip"), reservation.Spec.IP, "must be a valid IP address"))
+	}
+	if len(reservation.Spec.Namespace) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("namespace"), "must specify the namespace of workload"))
+	}
+	if len(reservation.Spec.WorkloadName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("workloadName"), "must specify the name of workload"))
+	}
+	switch reservation.Spec.WorkloadKind {
+	case "Deployment":
+		if len(reservation.Spec.PodName) != 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("podName"), "the IP is shared by the pods of Deployment"))
+		}
+	case "StatefulSet", "TApp":
+		if len(reservation.Spec.PodName) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("podName"), "must specify the pod using the IP"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(specPath.Child("workloadKind"), reservation.Spec.WorkloadKind, supportedWorkloadKinds))
+	}
+
+	return allErrs
+}
+
+// ValidateFloatingIPReservationUpdate tests if required fields in the
+// reservation are set during an update.
+func ValidateFloatingIPReservationUpdate(new *platform.FloatingIPReservation, old *platform.FloatingIPReservation) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateFloatingIPReservation(new)...)
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+	if new.Spec.IP != old.Spec.IP {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "ip"), new.Spec.IP, "disallowed change the ip"))
+	}
+	if new.Spec.Namespace != old.Spec.Namespace || new.Spec.WorkloadKind != old.Spec.WorkloadKind ||
+		new.Spec.WorkloadName != old.Spec.WorkloadName || new.Spec.PodName != old.Spec.PodName {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"), "disallowed change the workload"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,118 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package gpunodeconfig
+
+import (
+	"regexp"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// migProfileRegexp matches the MIG profiles such as 1g.5gb and 1g.10gb, the
+// profiles with media extensions such as 1g.5gb+me are also allowed.
+var migProfileRegexp = regexp.MustCompile(`^[1-9]g\.[1-9][0-9]*gb(\+me)?$`)
+
+// ValidateGPUNodeConfig tests if required fields in the GPUNodeConfig are set.
+func ValidateGPUNodeConfig(config *platform.GPUNodeConfig) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&config.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(config.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("clusterName"), "must specify a cluster name"))
+	}
+	if len(config.Spec.NodeName) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("nodeName"), "must specify a node name"))
+	}
+	allErrs = append(allErrs, ValidateMIGDevices(config.Spec.MIGDevices, specPath.Child("migDevices"))...)
+
+	return allErrs
+}
+
+// ValidateMIGDevices validates the MIG geometry, each GPU can be selected by
+// one device config at most, and only one device config can select all the
+// GPUs.
+func ValidateMIGDevices(devices []platform.GPUMIGDeviceConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	selected := sets.NewInt32()
+	all := false
+	for i, device := range devices {
+		idxPath := fldPath.Index(i)
+		if len(device.Devices) == 0 {
+			if all || len(devices) > 1 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("devices"), device.Devices, "all the GPUs are selected by other device configs"))
+			}
+			all = true
+		}
+		for j, index := range device.Devices {
+			if index < 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("devices").Index(j), index, "must be non-negative"))
+			}
+			if selected.Has(index) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("devices").Index(j), index))
+			}
+			selected.Insert(index)
+		}
+		profiles := sets.NewString()
+		for j, slice := range device.Slices {
+			slicePath := idxPath.Child("slices").Index(j)
+			if !migProfileRegexp.MatchString(slice.Profile) {
+				allErrs = append(allErrs, field.Invalid(slicePath.Child("profile"), slice.Profile, "must be a MIG profile such as 1g.5gb"))
+			}
+			if profiles.Has(slice.Profile) {
+				allErrs = append(allErrs, field.Duplicate(slicePath.Child("profile"), slice.Profile))
+			}
+			profiles.Insert(slice.Profile)
+			if slice.Count <= 0 {
+				allErrs = append(allErrs, field.Invalid(slicePath.Child("count"), slice.Count, "must be greater than 0"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateGPUNodeConfigUpdate tests if required fields in the GPUNodeConfig
+// are set during an update.
+func ValidateGPUNodeConfigUpdate(new *platform.GPUNodeConfig, old *platform.GPUNodeConfig) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateGPUNodeConfig(new)...)
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+	if new.Spec.NodeName != old.Spec.NodeName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "nodeName"), new.Spec.NodeName, "disallowed change the node name"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
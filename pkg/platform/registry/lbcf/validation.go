@@ -20,6 +20,7 @@ package lbcf
 
 import (
 	"context"
+	"strings"
 
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -37,6 +38,39 @@ func ValidateLBCF(ctx context.Context, obj *platform.LBCF, platformClient platfo
 	allErrs := apiMachineryValidation.ValidateObjectMeta(&obj.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
 	allErrs = append(allErrs, validation.ValidateCluster(ctx, platformClient, obj.Spec.ClusterName)...)
 
+	if obj.Spec.WebhookTimeoutSeconds < 0 || obj.Spec.WebhookTimeoutSeconds > 60 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "webhookTimeoutSeconds"), obj.Spec.WebhookTimeoutSeconds, "must be between 1 and 60"))
+	}
+	if obj.Spec.HealthCheck != nil {
+		allErrs = append(allErrs, ValidateLBCFHealthCheck(obj.Spec.HealthCheck, field.NewPath("spec", "healthCheck"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateLBCFHealthCheck validates the default health check of backends.
+func ValidateLBCFHealthCheck(healthCheck *platform.LBCFHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if healthCheck.Path != "" && !strings.HasPrefix(healthCheck.Path, "/") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), healthCheck.Path, "must be an absolute path"))
+	}
+	for _, f := range []struct {
+		name  string
+		value int32
+	}{
+		{"intervalSeconds", healthCheck.IntervalSeconds},
+		{"timeoutSeconds", healthCheck.TimeoutSeconds},
+		{"healthyThreshold", healthCheck.HealthyThreshold},
+		{"unhealthyThreshold", healthCheck.UnhealthyThreshold},
+	} {
+		if f.value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(f.name), f.value, "must be non-negative"))
+		}
+	}
+	if healthCheck.IntervalSeconds > 0 && healthCheck.TimeoutSeconds > healthCheck.IntervalSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), healthCheck.TimeoutSeconds, "must not be greater than intervalSeconds"))
+	}
+
 	return allErrs
 }
 
@@ -0,0 +1,92 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package license
+
+import (
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// ValidateLicense tests if required fields in the license are set.
+func ValidateLicense(license *platform.License) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&license.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	specPath := field.NewPath("spec")
+	if len(license.Spec.Licensee) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("licensee"), "must specify a licensee"))
+	}
+	if len(license.Spec.Signature) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("signature"), "must specify the signature of entitlements"))
+	}
+	if license.Spec.MaxNodes < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxNodes"), license.Spec.MaxNodes, "must be greater than or equal to 0"))
+	}
+	if license.Spec.MaxClusters < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("maxClusters"), license.Spec.MaxClusters, "must be greater than or equal to 0"))
+	}
+	if license.Spec.WarningPercent < 1 || license.Spec.WarningPercent > 100 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("warningPercent"), license.Spec.WarningPercent, "must be between 1 and 100"))
+	}
+	if !license.Spec.NotBefore.IsZero() && !license.Spec.NotAfter.IsZero() &&
+		!license.Spec.NotAfter.After(license.Spec.NotBefore.Time) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("notAfter"), license.Spec.NotAfter, "must be after notBefore"))
+	}
+
+	features := sets.NewString()
+	for i, feature := range license.Spec.Features {
+		if len(feature) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("features").Index(i), "must specify a feature name"))
+			continue
+		}
+		if features.Has(feature) {
+			allErrs = append(allErrs, field.Duplicate(specPath.Child("features").Index(i), feature))
+		}
+		features.Insert(feature)
+	}
+
+	if notify := license.Spec.Notify; notify != nil {
+		notifyPath := specPath.Child("notify")
+		if len(notify.Channel) == 0 {
+			allErrs = append(allErrs, field.Required(notifyPath.Child("channel"), "must specify a notify channel"))
+		}
+		if len(notify.Template) == 0 {
+			allErrs = append(allErrs, field.Required(notifyPath.Child("template"), "must specify a notify template"))
+		}
+		if len(notify.Receivers) == 0 && len(notify.ReceiverGroups) == 0 {
+			allErrs = append(allErrs, field.Required(notifyPath, "must specify receivers or receiverGroups"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateLicenseUpdate tests if required fields in the license are set
+// during an update.
+func ValidateLicenseUpdate(new *platform.License, old *platform.License) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateLicense(new)...)
+
+	return allErrs
+}
@@ -45,9 +45,10 @@ import (
 
 // Storage includes storage for machines and all sub resources.
 type Storage struct {
-	Machine  *REST
-	Status   *StatusREST
-	Finalize *FinalizeREST
+	Machine   *REST
+	Status    *StatusREST
+	Finalize  *FinalizeREST
+	SOSReport *SOSReportREST
 }
 
 // NewStorage returns a Storage object that will work against machines.
@@ -85,9 +86,10 @@ func NewStorage(optsGetter genericregistry.RESTOptionsGetter, platformClient pla
 	finalizeStore.ExportStrategy = machine.NewFinalizerStrategy(strategy)
 
 	return &Storage{
-		Machine:  &REST{store, privilegedUsername},
-		Status:   &StatusREST{&statusStore},
-		Finalize: &FinalizeREST{&finalizeStore},
+		Machine:   &REST{store, privilegedUsername},
+		Status:    &StatusREST{&statusStore},
+		Finalize:  &FinalizeREST{&finalizeStore},
+		SOSReport: &SOSReportREST{store: store},
 	}
 }
 
@@ -0,0 +1,202 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net"
+	"strings"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+// ValidateMetalLB tests if required fields in the cluster are set.
+func ValidateMetalLB(metalLB *platform.MetalLB) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&metalLB.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	if len(metalLB.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
+	}
+	allErrs = append(allErrs, ValidateAddressPools(metalLB.Spec.AddressPools, metalLB.Spec.Peers, field.NewPath("spec", "addressPools"))...)
+	allErrs = append(allErrs, ValidatePeers(metalLB.Spec.Peers, field.NewPath("spec", "peers"))...)
+
+	return allErrs
+}
+
+// ValidateAddressPools validates the address pools of MetalLB, the addresses
+// of pools must not overlap and the bgp pools require BGP peers.
+func ValidateAddressPools(pools []platform.MetalLBAddressPool, peers []platform.MetalLBPeer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(pools) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify at least one address pool"))
+	}
+
+	names := sets.NewString()
+	bgp := false
+	var ranges []addressRange
+	for i, pool := range pools {
+		idxPath := fldPath.Index(i)
+		for _, msg := range utilvalidation.IsDNS1123Label(pool.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+		}
+		if names.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		names.Insert(pool.Name)
+
+		switch pool.Protocol {
+		case platform.MetalLBProtocolLayer2:
+		case platform.MetalLBProtocolBGP:
+			bgp = true
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), pool.Protocol,
+				[]string{string(platform.MetalLBProtocolLayer2), string(platform.MetalLBProtocolBGP)}))
+		}
+
+		if len(pool.Addresses) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("addresses"), "must specify at least one address"))
+		}
+		for j, address := range pool.Addresses {
+			r, err := parseAddressRange(address)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("addresses").Index(j), address, err.Error()))
+				continue
+			}
+			for _, other := range ranges {
+				if r.overlaps(other) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("addresses").Index(j), address, fmt.Sprintf("overlaps with %s", other.address)))
+				}
+			}
+			ranges = append(ranges, r)
+		}
+	}
+	if bgp && len(peers) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "peers"), "must specify BGP peers for bgp pools"))
+	}
+
+	return allErrs
+}
+
+// ValidatePeers validates the BGP peers of MetalLB.
+func ValidatePeers(peers []platform.MetalLBPeer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	addresses := sets.NewString()
+	for i, peer := range peers {
+		idxPath := fldPath.Index(i)
+		if net.ParseIP(peer.PeerAddress) == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("peerAddress"), peer.PeerAddress, "must be a valid IP address"))
+		} else if addresses.Has(peer.PeerAddress) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("peerAddress"), peer.PeerAddress))
+		}
+		addresses.Insert(peer.PeerAddress)
+		if peer.PeerASN < 1 || peer.PeerASN > math.MaxUint32 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("peerASN"), peer.PeerASN, "must be between 1 and 4294967295"))
+		}
+		if peer.MyASN < 1 || peer.MyASN > math.MaxUint32 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("myASN"), peer.MyASN, "must be between 1 and 4294967295"))
+		}
+		if peer.PeerPort != 0 {
+			for _, msg := range utilvalidation.IsValidPortNum(int(peer.PeerPort)) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("peerPort"), peer.PeerPort, msg))
+			}
+		}
+		allErrs = append(allErrs, metav1validation.ValidateLabels(peer.NodeSelector, idxPath.Child("nodeSelector"))...)
+	}
+
+	return allErrs
+}
+
+// addressRange is the first and last address of a CIDR or an address range.
+type addressRange struct {
+	address     string
+	first, last *big.Int
+}
+
+func (r addressRange) overlaps(other addressRange) bool {
+	return r.first.Cmp(other.last) <= 0 && other.first.Cmp(r.last) <= 0
+}
+
+// parseAddressRange parses a CIDR like 192.168.1.0/24 or a range like
+// 192.168.1.100-192.168.1.200.
+func parseAddressRange(address string) (addressRange, error) {
+	if strings.Contains(address, "-") {
+		parts := strings.SplitN(address, "-", 2)
+		first, last := net.ParseIP(strings.TrimSpace(parts[0])), net.ParseIP(strings.TrimSpace(parts[1]))
+		if first == nil || last == nil {
+			return addressRange{}, fmt.Errorf("must be a CIDR or an address range")
+		}
+		if (first.To4() == nil) != (last.To4() == nil) {
+			return addressRange{}, fmt.Errorf("must be addresses of the same family")
+		}
+		r := addressRange{address: address, first: ipToInt(first), last: ipToInt(last)}
+		if r.first.Cmp(r.last) > 0 {
+			return addressRange{}, fmt.Errorf("the first address must not be greater than the last one")
+		}
+		return r, nil
+	}
+	_, cidr, err := net.ParseCIDR(address)
+	if err != nil {
+		return addressRange{}, fmt.Errorf("must be a CIDR or an address range")
+	}
+	last := make(net.IP, len(cidr.IP))
+	for i := range cidr.IP {
+		last[i] = cidr.IP[i] | ^cidr.Mask[i]
+	}
+	return addressRange{address: address, first: ipToInt(cidr.IP), last: ipToInt(last)}, nil
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	}
+	return new(big.Int).SetBytes(ip)
+}
+
+// ValidateMetalLBUpdate tests if required fields in the namespace set are
+// set during an update.
+func ValidateMetalLBUpdate(new *platform.MetalLB, old *platform.MetalLB) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMetalLB(new)...)
+
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,211 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package metallb
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+func fields(errs field.ErrorList) []string {
+	var result []string
+	for _, err := range errs {
+		result = append(result, err.Field)
+	}
+	return result
+}
+
+func layer2Pool(name string, addresses ...string) platform.MetalLBAddressPool {
+	return platform.MetalLBAddressPool{Name: name, Protocol: platform.MetalLBProtocolLayer2, Addresses: addresses}
+}
+
+func TestValidateAddressPools(t *testing.T) {
+	peers := []platform.MetalLBPeer{{PeerAddress: "10.1.0.1", PeerASN: 64501, MyASN: 64500}}
+	tests := []struct {
+		name  string
+		pools []platform.MetalLBAddressPool
+		peers []platform.MetalLBPeer
+		want  []string
+	}{
+		{
+			name: "no pools",
+			want: []string{"spec.addressPools"},
+		},
+		{
+			name:  "cidr and range",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.0/25", "192.168.1.128-192.168.1.200")},
+		},
+		{
+			name:  "invalid name",
+			pools: []platform.MetalLBAddressPool{layer2Pool("A_B", "192.168.1.0/24")},
+			want:  []string{"spec.addressPools[0].name"},
+		},
+		{
+			name:  "duplicate names",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.0/24"), layer2Pool("a", "192.168.2.0/24")},
+			want:  []string{"spec.addressPools[1].name"},
+		},
+		{
+			name:  "unsupported protocol",
+			pools: []platform.MetalLBAddressPool{{Name: "a", Protocol: "arp", Addresses: []string{"192.168.1.0/24"}}},
+			want:  []string{"spec.addressPools[0].protocol"},
+		},
+		{
+			name:  "no addresses",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a")},
+			want:  []string{"spec.addressPools[0].addresses"},
+		},
+		{
+			name:  "invalid address",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.300/24")},
+			want:  []string{"spec.addressPools[0].addresses[0]"},
+		},
+		{
+			name:  "reversed range",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.200-192.168.1.100")},
+			want:  []string{"spec.addressPools[0].addresses[0]"},
+		},
+		{
+			name:  "mixed families range",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.1-fd00::1")},
+			want:  []string{"spec.addressPools[0].addresses[0]"},
+		},
+		{
+			name:  "overlapping cidr and range across pools",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.0/24"), layer2Pool("b", "192.168.1.250-192.168.2.10")},
+			want:  []string{"spec.addressPools[1].addresses[0]"},
+		},
+		{
+			name:  "adjacent ranges",
+			pools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.0/24"), layer2Pool("b", "192.168.2.0-192.168.2.10")},
+		},
+		{
+			name:  "bgp without peers",
+			pools: []platform.MetalLBAddressPool{{Name: "a", Protocol: platform.MetalLBProtocolBGP, Addresses: []string{"10.0.0.0/24"}}},
+			want:  []string{"spec.peers"},
+		},
+		{
+			name:  "bgp with peers",
+			pools: []platform.MetalLBAddressPool{{Name: "a", Protocol: platform.MetalLBProtocolBGP, Addresses: []string{"10.0.0.0/24"}}},
+			peers: peers,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(ValidateAddressPools(tt.pools, tt.peers, field.NewPath("spec", "addressPools")))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateAddressPools() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidatePeers(t *testing.T) {
+	tests := []struct {
+		name  string
+		peers []platform.MetalLBPeer
+		want  []string
+	}{
+		{
+			name:  "valid",
+			peers: []platform.MetalLBPeer{{PeerAddress: "10.1.0.1", PeerASN: 64501, MyASN: 64500, PeerPort: 1179, NodeSelector: map[string]string{"rack": "a"}}},
+		},
+		{
+			name:  "invalid address",
+			peers: []platform.MetalLBPeer{{PeerAddress: "router", PeerASN: 64501, MyASN: 64500}},
+			want:  []string{"spec.peers[0].peerAddress"},
+		},
+		{
+			name:  "duplicate address",
+			peers: []platform.MetalLBPeer{{PeerAddress: "10.1.0.1", PeerASN: 64501, MyASN: 64500}, {PeerAddress: "10.1.0.1", PeerASN: 64502, MyASN: 64500}},
+			want:  []string{"spec.peers[1].peerAddress"},
+		},
+		{
+			name:  "asn out of range",
+			peers: []platform.MetalLBPeer{{PeerAddress: "10.1.0.1", PeerASN: 0, MyASN: 4294967296}},
+			want:  []string{"spec.peers[0].peerASN", "spec.peers[0].myASN"},
+		},
+		{
+			name:  "invalid port",
+			peers: []platform.MetalLBPeer{{PeerAddress: "10.1.0.1", PeerASN: 64501, MyASN: 64500, PeerPort: 65536}},
+			want:  []string{"spec.peers[0].peerPort"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fields(ValidatePeers(tt.peers, field.NewPath("spec", "peers")))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidatePeers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMetalLBUpdate(t *testing.T) {
+	old := &platform.MetalLB{
+		ObjectMeta: metav1.ObjectMeta{Name: "mlb", ResourceVersion: "1"},
+		Spec: platform.MetalLBSpec{
+			TenantID:     "default",
+			ClusterName:  "cls",
+			AddressPools: []platform.MetalLBAddressPool{layer2Pool("a", "192.168.1.0/24")},
+		},
+		Status: platform.MetalLBStatus{Phase: platform.AddonPhaseRunning},
+	}
+	tests := []struct {
+		name   string
+		update func(*platform.MetalLB)
+		want   []string
+	}{
+		{
+			name: "pools changed",
+			update: func(m *platform.MetalLB) {
+				m.Spec.AddressPools = append(m.Spec.AddressPools, layer2Pool("b", "192.168.2.0/24"))
+			},
+		},
+		{
+			name:   "cluster changed",
+			update: func(m *platform.MetalLB) { m.Spec.ClusterName = "other" },
+			want:   []string{"spec.clusterName"},
+		},
+		{
+			name:   "tenant changed",
+			update: func(m *platform.MetalLB) { m.Spec.TenantID = "other" },
+			want:   []string{"spec.tenantID"},
+		},
+		{
+			name:   "no phase",
+			update: func(m *platform.MetalLB) { m.Status.Phase = "" },
+			want:   []string{"status.phase"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metalLB := old.DeepCopy()
+			tt.update(metalLB)
+			got := fields(ValidateMetalLBUpdate(metalLB, old))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateMetalLBUpdate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package multus
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+
+	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/controller/addon/multus/images"
+)
+
+// ValidateName is a ValidateNameFunc for names that must be a DNS
+// subdomain.
+var ValidateName = apiMachineryValidation.ValidateNamespaceName
+
+var (
+	// the resource names of SR-IOV network device plugin are alphanumeric
+	sriovResourceNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+	pciIDRegexp             = regexp.MustCompile(`^[0-9a-fA-F]{4}$`)
+
+	networkTypes = map[string]sets.String{
+		"macvlan":     sets.NewString("", "bridge", "private", "vepa", "passthru"),
+		"ipvlan":      sets.NewString("", "l2", "l3", "l3s"),
+		"host-device": sets.NewString(""),
+		"sriov":       sets.NewString(""),
+	}
+	ipamTypes = sets.NewString("", "host-local", "static", "dhcp")
+)
+
+// ValidateMultus tests if required fields in the cluster are set.
+func ValidateMultus(multus *platform.Multus) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMeta(&multus.ObjectMeta, false, ValidateName, field.NewPath("metadata"))
+
+	if len(multus.Spec.ClusterName) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
+	}
+	if err := images.Validate(multus.Spec.Version); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "version"), multus.Spec.Version, err.Error()))
+	}
+	resources := sets.NewString()
+	if multus.Spec.SRIOV != nil {
+		allErrs = append(allErrs, ValidateSRIOV(multus.Spec.SRIOV, field.NewPath("spec", "sriov"))...)
+		for _, resource := range multus.Spec.SRIOV.Resources {
+			resources.Insert(resource.ResourceName)
+		}
+	}
+	allErrs = append(allErrs, ValidateNetworkAttachments(multus.Spec.NetworkAttachments, resources, field.NewPath("spec", "networkAttachments"))...)
+
+	return allErrs
+}
+
+// ValidateSRIOV validates the resources of SR-IOV network device plugin.
+func ValidateSRIOV(sriov *platform.MultusSRIOV, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if sriov.ResourcePrefix != "" {
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(sriov.ResourcePrefix) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourcePrefix"), sriov.ResourcePrefix, msg))
+		}
+	}
+	if len(sriov.Resources) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("resources"), "must specify at least one resource"))
+	}
+	names := sets.NewString()
+	for i, resource := range sriov.Resources {
+		idxPath := fldPath.Child("resources").Index(i)
+		if !sriovResourceNameRegexp.MatchString(resource.ResourceName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), resource.ResourceName, "must consist of alphanumeric characters or '_'"))
+		}
+		if names.Has(resource.ResourceName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("resourceName"), resource.ResourceName))
+		}
+		names.Insert(resource.ResourceName)
+		for _, f := range []struct {
+			name   string
+			values []string
+		}{
+			{"vendors", resource.Vendors},
+			{"devices", resource.Devices},
+		} {
+			for j, value := range f.values {
+				if !pciIDRegexp.MatchString(value) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child(f.name).Index(j), value, "must be 4 hex digits"))
+				}
+			}
+		}
+		if len(resource.Vendors)+len(resource.Devices)+len(resource.Drivers)+len(resource.PFNames) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must specify at least one selector"))
+		}
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(sriov.NodeSelector, fldPath.Child("nodeSelector"))...)
+
+	return allErrs
+}
+
+// ValidateNetworkAttachments validates the network attachments, the sriov
+// attachments must reference the SR-IOV resources.
+func ValidateNetworkAttachments(attachments []platform.MultusNetworkAttachment, resources sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	keys := sets.NewString()
+	for i, attachment := range attachments {
+		idxPath := fldPath.Index(i)
+		for _, msg := range utilvalidation.IsDNS1123Subdomain(attachment.Name) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), attachment.Name, msg))
+		}
+		for _, msg := range apiMachineryValidation.ValidateNamespaceName(attachment.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), attachment.Namespace, msg))
+		}
+		key := attachment.Namespace + "/" + attachment.Name
+		if keys.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), key))
+		}
+		keys.Insert(key)
+
+		if attachment.Config != "" {
+			var config map[string]interface{}
+			if err := json.Unmarshal([]byte(attachment.Config), &config); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("config"), attachment.Config, fmt.Sprintf("must be a JSON object: %v", err)))
+			} else if _, ok := config["type"]; !ok {
+				allErrs = append(allErrs, field.Required(idxPath.Child("config"), "must specify the type of CNI plugin"))
+			}
+			continue
+		}
+		allErrs = append(allErrs, validateNetworkAttachment(attachment, resources, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateNetworkAttachment(attachment platform.MultusNetworkAttachment, resources sets.String, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	modes, ok := networkTypes[attachment.Type]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), attachment.Type, []string{"macvlan", "ipvlan", "host-device", "sriov"}))
+		return allErrs
+	}
+	if modes.Len() == 1 && attachment.Mode != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("mode"), "only supported by macvlan and ipvlan"))
+	} else if !modes.Has(attachment.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), attachment.Mode, modes.Difference(sets.NewString("")).List()))
+	}
+	switch attachment.Type {
+	case "host-device":
+		if attachment.Master == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("master"), "must specify the device moved into pods"))
+		}
+	case "sriov":
+		if attachment.ResourceName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("resourceName"), "must specify the SR-IOV resource"))
+		} else if !resources.Has(attachment.ResourceName) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("resourceName"), attachment.ResourceName))
+		}
+	}
+	if attachment.Type != "sriov" && attachment.VLAN != 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("vlan"), "only supported by sriov"))
+	}
+	if attachment.VLAN < 0 || attachment.VLAN > 4094 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("vlan"), attachment.VLAN, "must be between 0 and 4094"))
+	}
+	if attachment.MTU < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("mtu"), attachment.MTU, "must be non-negative"))
+	}
+	if attachment.IPAM != nil {
+		allErrs = append(allErrs, ValidateIPAM(attachment.IPAM, fldPath.Child("ipam"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateIPAM validates the IPAM of a network attachment, the subnet is
+// required by host-local and the addresses must be in the subnet.
+func ValidateIPAM(ipam *platform.MultusIPAM, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if !ipamTypes.Has(ipam.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), ipam.Type, ipamTypes.Difference(sets.NewString("")).List()))
+	}
+	var subnet *net.IPNet
+	if ipam.Type == "" || ipam.Type == "host-local" {
+		var err error
+		_, subnet, err = net.ParseCIDR(ipam.Subnet)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("subnet"), ipam.Subnet, "must be a valid CIDR"))
+		}
+	}
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"rangeStart", ipam.RangeStart},
+		{"rangeEnd", ipam.RangeEnd},
+		{"gateway", ipam.Gateway},
+	} {
+		if f.value == "" {
+			continue
+		}
+		ip := net.ParseIP(f.value)
+		if ip == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(f.name), f.value, "must be a valid IP address"))
+		} else if subnet != nil && !subnet.Contains(ip) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(f.name), f.value, fmt.Sprintf("must be in subnet %s", ipam.Subnet)))
+		}
+	}
+	for i, route := range ipam.Routes {
+		if _, _, err := net.ParseCIDR(route); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("routes").Index(i), route, "must be a valid CIDR"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateMultusUpdate tests if required fields in the namespace set are
+// set during an update.
+func ValidateMultusUpdate(new *platform.Multus, old *platform.Multus) field.ErrorList {
+	allErrs := apiMachineryValidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateMultus(new)...)
+
+	if new.Spec.ClusterName != old.Spec.ClusterName {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), new.Spec.ClusterName, "disallowed change the cluster name"))
+	}
+
+	if new.Spec.TenantID != old.Spec.TenantID {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "tenantID"), new.Spec.TenantID, "disallowed change the tenant"))
+	}
+
+	if new.Status.Phase == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("status", "phase"), string(new.Status.Phase)))
+	}
+
+	return allErrs
+}
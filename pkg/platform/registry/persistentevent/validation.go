@@ -19,13 +19,22 @@
 package persistentevent
 
 import (
+	"encoding/base64"
+	"encoding/pem"
+	"net"
+	"net/url"
 	"reflect"
+	"regexp"
 
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/platform"
 )
 
+// sizeRegexp matches the sizes of fluentd, such as 32MB.
+var sizeRegexp = regexp.MustCompile(`^[0-9]+[kKmMgG]?[bB]?$`)
+
 // ValidateName is a ValidateNameFunc for names that must be a DNS
 // subdomain.
 var ValidateName = apiMachineryValidation.ValidateNamespaceName
@@ -75,6 +84,119 @@ func ValidatePersistentEvent(persistentEvent *platform.PersistentEvent) field.Er
 		}
 	}
 
+	if persistentEvent.Spec.PersistentBackEnd.Kafka != nil {
+		allErrs = append(allErrs, ValidateKafka(persistentEvent.Spec.PersistentBackEnd.Kafka, field.NewPath("spec", "persistentBackend", "kafka"))...)
+	}
+
+	if persistentEvent.Spec.PersistentBackEnd.Loki != nil {
+		allErrs = append(allErrs, ValidateLoki(persistentEvent.Spec.PersistentBackEnd.Loki, field.NewPath("spec", "persistentBackend", "loki"))...)
+	}
+
+	if persistentEvent.Spec.Buffer != nil {
+		allErrs = append(allErrs, ValidateBuffer(persistentEvent.Spec.Buffer, field.NewPath("spec", "buffer"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateKafka validates the brokers, topic and authentication of Kafka.
+func ValidateKafka(kafka *platform.StorageBackEndKafka, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(kafka.Brokers) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("brokers"), "must specify Kafka brokers"))
+	}
+	for i, broker := range kafka.Brokers {
+		if _, _, err := net.SplitHostPort(broker); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("brokers").Index(i), broker, "must be host:port"))
+		}
+	}
+	if kafka.Topic == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("topic"), "must specify Kafka topic"))
+	}
+	if kafka.SASL != nil {
+		switch kafka.SASL.Mechanism {
+		case "", "PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512":
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("sasl", "mechanism"), kafka.SASL.Mechanism, []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"}))
+		}
+		if kafka.SASL.User == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("sasl", "user"), "must specify SASL user"))
+		}
+		allErrs = append(allErrs, validatePassword(kafka.SASL.Password, fldPath.Child("sasl", "password"))...)
+	}
+	if kafka.TLS != nil {
+		allErrs = append(allErrs, ValidateTLS(kafka.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateLoki validates the url and authentication of Loki.
+func ValidateLoki(loki *platform.StorageBackEndLoki, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if u, err := url.Parse(loki.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), loki.URL, "must be a http or https url"))
+	}
+	if loki.Password != "" {
+		allErrs = append(allErrs, validatePassword(loki.Password, fldPath.Child("password"))...)
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabels(loki.Labels, fldPath.Child("labels"))...)
+	if loki.TLS != nil {
+		allErrs = append(allErrs, ValidateTLS(loki.TLS, fldPath.Child("tls"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateTLS validates the PEM encoded certificates, the client certificate
+// and key must be specified together.
+func ValidateTLS(tls *platform.StorageBackEndTLS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"caCert", tls.CACert},
+		{"clientCert", tls.ClientCert},
+		{"clientKey", tls.ClientKey},
+	} {
+		if f.value == "" {
+			continue
+		}
+		if block, _ := pem.Decode([]byte(f.value)); block == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(f.name), "", "must be PEM encoded"))
+		}
+	}
+	if (tls.ClientCert == "") != (tls.ClientKey == "") {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientKey"), "must specify client certificate and key together"))
+	}
+
+	return allErrs
+}
+
+// ValidateBuffer validates the size and overflow action of buffer.
+func ValidateBuffer(buffer *platform.PersistentEventBuffer, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if buffer.TotalLimitSize != "" && !sizeRegexp.MatchString(buffer.TotalLimitSize) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("totalLimitSize"), buffer.TotalLimitSize, "must be a size such as 32MB"))
+	}
+	switch buffer.OverflowAction {
+	case "", "block", "drop_oldest_chunk", "throw_exception":
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("overflowAction"), buffer.OverflowAction, []string{"block", "drop_oldest_chunk", "throw_exception"}))
+	}
+
+	return allErrs
+}
+
+func validatePassword(password string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if password == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify password"))
+	} else if _, err := base64.StdEncoding.DecodeString(password); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "must be base64 encoded"))
+	}
+
 	return allErrs
 }
 
@@ -19,11 +19,29 @@
 package prometheus
 
 import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/url"
+	"regexp"
+
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/platform"
 )
 
+var (
+	durationRegexp  = regexp.MustCompile(`^[0-9]+(ms|s|m|h|d|w|y)$`)
+	sizeRegexp      = regexp.MustCompile(`^[0-9]+(B|KB|MB|GB|TB|PB|EB)$`)
+	labelNameRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	// reservedExternalLabels are set by the controller for every prometheus.
+	reservedExternalLabels = sets.NewString("cluster_id", "tenant_id", "cluster_display_name")
+
+	sizingTiers = sets.NewString(string(platform.PrometheusSizingSmall), string(platform.PrometheusSizingMedium), string(platform.PrometheusSizingLarge))
+)
+
 // ValidateName is a ValidateNameFunc for names that must be a DNS
 // subdomain.
 var ValidateName = apiMachineryValidation.ValidateNamespaceName
@@ -36,6 +54,110 @@ func ValidatePrometheus(prom *platform.Prometheus) field.ErrorList {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
 	}
 
+	names := sets.NewString()
+	for i, rw := range prom.Spec.RemoteWrites {
+		fldPath := field.NewPath("spec", "remoteWrites").Index(i)
+		allErrs = append(allErrs, ValidateRemoteWrite(&rw, fldPath)...)
+		if names.Has(rw.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("name"), rw.Name))
+		}
+		names.Insert(rw.Name)
+	}
+
+	for k, v := range prom.Spec.ExternalLabels {
+		fldPath := field.NewPath("spec", "externalLabels").Key(k)
+		if !labelNameRegexp.MatchString(k) {
+			allErrs = append(allErrs, field.Invalid(fldPath, k, "must be a valid prometheus label name"))
+		}
+		if reservedExternalLabels.Has(k) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "reserved external label"))
+		}
+		if v == "" {
+			allErrs = append(allErrs, field.Required(fldPath, "must specify the label value"))
+		}
+	}
+
+	if prom.Spec.Retention != "" && !durationRegexp.MatchString(prom.Spec.Retention) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "retention"), prom.Spec.Retention, "must be a duration such as 30m, 2h or 15d"))
+	}
+	if prom.Spec.RetentionSize != "" && !sizeRegexp.MatchString(prom.Spec.RetentionSize) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "retentionSize"), prom.Spec.RetentionSize, "must be a size such as 512MB or 5GB"))
+	}
+	if prom.Spec.SizingTier != "" && !sizingTiers.Has(string(prom.Spec.SizingTier)) {
+		allErrs = append(allErrs, field.NotSupported(field.NewPath("spec", "sizingTier"), prom.Spec.SizingTier, sizingTiers.List()))
+	}
+
+	return allErrs
+}
+
+// ValidateRemoteWrite validates the address and credentials of a remote write
+// endpoint.
+func ValidateRemoteWrite(rw *platform.PrometheusRemoteWrite, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, msg := range validation.IsDNS1123Label(rw.Name) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), rw.Name, msg))
+	}
+	if u, err := url.Parse(rw.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), rw.URL, "must be a http or https url"))
+	}
+	if rw.BasicAuth != nil && rw.BearerToken != "" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("bearerToken"), "can not specify both basicAuth and bearerToken"))
+	}
+	if rw.BasicAuth != nil {
+		if rw.BasicAuth.User == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("basicAuth", "user"), "must specify user"))
+		}
+		allErrs = append(allErrs, validateBase64(rw.BasicAuth.Password, fldPath.Child("basicAuth", "password"))...)
+	}
+	if rw.BearerToken != "" {
+		allErrs = append(allErrs, validateBase64(rw.BearerToken, fldPath.Child("bearerToken"))...)
+	}
+	if rw.TLS != nil {
+		allErrs = append(allErrs, ValidateTLS(rw.TLS, fldPath.Child("tls"))...)
+	}
+	if rw.MetricsRegex != "" {
+		if _, err := regexp.Compile(rw.MetricsRegex); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("metricsRegex"), rw.MetricsRegex, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateTLS validates the PEM encoded certificates, the client certificate
+// and key must be specified together.
+func ValidateTLS(tls *platform.PrometheusTLSConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, f := range []struct {
+		name  string
+		value string
+	}{
+		{"caCert", tls.CACert},
+		{"clientCert", tls.ClientCert},
+		{"clientKey", tls.ClientKey},
+	} {
+		if f.value == "" {
+			continue
+		}
+		if block, _ := pem.Decode([]byte(f.value)); block == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(f.name), "", "must be PEM encoded"))
+		}
+	}
+	if (tls.ClientCert == "") != (tls.ClientKey == "") {
+		allErrs = append(allErrs, field.Required(fldPath.Child("clientKey"), "must specify client certificate and key together"))
+	}
+
+	return allErrs
+}
+
+func validateBase64(value string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if value == "" {
+		allErrs = append(allErrs, field.Required(fldPath, "must specify the value"))
+	} else if _, err := base64.StdEncoding.DecodeString(value); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, "", "must be base64 encoded"))
+	}
+
 	return allErrs
 }
 
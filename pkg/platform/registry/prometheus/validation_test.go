@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package prometheus
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"tkestack.io/tke/api/platform"
+)
+
+const testPEM = "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"
+
+func newPrometheus(update func(*platform.Prometheus)) *platform.Prometheus {
+	prom := &platform.Prometheus{
+		ObjectMeta: metav1.ObjectMeta{Name: "prom"},
+		Spec: platform.PrometheusSpec{
+			ClusterName: "cls-a",
+			RemoteWrites: []platform.PrometheusRemoteWrite{
+				{
+					Name:      "thanos",
+					URL:       "https://thanos.example.com/api/v1/receive",
+					BasicAuth: &platform.PrometheusBasicAuth{User: "admin", Password: "c2VjcmV0"},
+					TLS:       &platform.PrometheusTLSConfig{CACert: testPEM, ClientCert: testPEM, ClientKey: testPEM},
+				},
+			},
+			ExternalLabels: map[string]string{"region": "gz"},
+			Retention:      "15d",
+			RetentionSize:  "50GB",
+			SizingTier:     platform.PrometheusSizingMedium,
+		},
+	}
+	if update != nil {
+		update(prom)
+	}
+	return prom
+}
+
+func TestValidatePrometheus(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*platform.Prometheus)
+		want   []string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name: "duplicate remote writes",
+			update: func(p *platform.Prometheus) {
+				p.Spec.RemoteWrites = append(p.Spec.RemoteWrites, p.Spec.RemoteWrites[0])
+			},
+			want: []string{"spec.remoteWrites[1].name"},
+		},
+		{
+			name:   "invalid label name",
+			update: func(p *platform.Prometheus) { p.Spec.ExternalLabels = map[string]string{"region-a": "gz"} },
+			want:   []string{"spec.externalLabels[region-a]"},
+		},
+		{
+			name:   "reserved label",
+			update: func(p *platform.Prometheus) { p.Spec.ExternalLabels = map[string]string{"tenant_id": "other"} },
+			want:   []string{"spec.externalLabels[tenant_id]"},
+		},
+		{
+			name:   "empty label value",
+			update: func(p *platform.Prometheus) { p.Spec.ExternalLabels = map[string]string{"region": ""} },
+			want:   []string{"spec.externalLabels[region]"},
+		},
+		{
+			name:   "invalid retention",
+			update: func(p *platform.Prometheus) { p.Spec.Retention = "15 days" },
+			want:   []string{"spec.retention"},
+		},
+		{
+			name:   "invalid retention size",
+			update: func(p *platform.Prometheus) { p.Spec.RetentionSize = "50G" },
+			want:   []string{"spec.retentionSize"},
+		},
+		{
+			name:   "unsupported sizing tier",
+			update: func(p *platform.Prometheus) { p.Spec.SizingTier = "Huge" },
+			want:   []string{"spec.sizingTier"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, err := range ValidatePrometheus(newPrometheus(tt.update)) {
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidatePrometheus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateRemoteWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(*platform.PrometheusRemoteWrite)
+		want   []string
+	}{
+		{
+			name: "bearer token",
+			update: func(rw *platform.PrometheusRemoteWrite) {
+				rw.BasicAuth = nil
+				rw.BearerToken = "dG9rZW4="
+			},
+		},
+		{
+			name:   "invalid name",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.Name = "Thanos" },
+			want:   []string{"rw.name"},
+		},
+		{
+			name:   "invalid url",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.URL = "thanos:19291" },
+			want:   []string{"rw.url"},
+		},
+		{
+			name:   "basic auth and bearer token",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.BearerToken = "dG9rZW4=" },
+			want:   []string{"rw.bearerToken"},
+		},
+		{
+			name:   "no user",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.BasicAuth.User = "" },
+			want:   []string{"rw.basicAuth.user"},
+		},
+		{
+			name:   "password not base64",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.BasicAuth.Password = "secret!" },
+			want:   []string{"rw.basicAuth.password"},
+		},
+		{
+			name:   "certificate not pem",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.TLS.CACert = "ca" },
+			want:   []string{"rw.tls.caCert"},
+		},
+		{
+			name:   "client cert without key",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.TLS.ClientKey = "" },
+			want:   []string{"rw.tls.clientKey"},
+		},
+		{
+			name:   "invalid metrics regex",
+			update: func(rw *platform.PrometheusRemoteWrite) { rw.MetricsRegex = "kube_(" },
+			want:   []string{"rw.metricsRegex"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rw := newPrometheus(nil).Spec.RemoteWrites[0]
+			tt.update(&rw)
+			var got []string
+			for _, err := range ValidateRemoteWrite(&rw, field.NewPath("rw")) {
+				got = append(got, err.Field)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateRemoteWrite() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -31,14 +31,22 @@ import (
 	clusterstorage "tkestack.io/tke/pkg/platform/registry/cluster/storage"
 	clusteraddontypestorage "tkestack.io/tke/pkg/platform/registry/clusteraddontype/storage"
 	clustercredentialstorage "tkestack.io/tke/pkg/platform/registry/clustercredential/storage"
+	clusteroperationstorage "tkestack.io/tke/pkg/platform/registry/clusteroperation/storage"
 	configmapstorage "tkestack.io/tke/pkg/platform/registry/configmap/storage"
 	cronhpastorage "tkestack.io/tke/pkg/platform/registry/cronhpa/storage"
 	csioperatorstorage "tkestack.io/tke/pkg/platform/registry/csioperator/storage"
+	devicepluginstorage "tkestack.io/tke/pkg/platform/registry/deviceplugin/storage"
+	egressgatewaystorage "tkestack.io/tke/pkg/platform/registry/egressgateway/storage"
+	floatingipreservationstorage "tkestack.io/tke/pkg/platform/registry/floatingipreservation/storage"
+	gpunodeconfigstorage "tkestack.io/tke/pkg/platform/registry/gpunodeconfig/storage"
 	helmstorage "tkestack.io/tke/pkg/platform/registry/helm/storage"
 	ipamstorage "tkestack.io/tke/pkg/platform/registry/ipam/storage"
 	lbcfstorage "tkestack.io/tke/pkg/platform/registry/lbcf/storage"
+	licensestorage "tkestack.io/tke/pkg/platform/registry/license/storage"
 	logcollectorstorage "tkestack.io/tke/pkg/platform/registry/logcollector/storage"
 	machinestorage "tkestack.io/tke/pkg/platform/registry/machine/storage"
+	metallbstorage "tkestack.io/tke/pkg/platform/registry/metallb/storage"
+	multusstorage "tkestack.io/tke/pkg/platform/registry/multus/storage"
 	persistenteventstorage "tkestack.io/tke/pkg/platform/registry/persistentevent/storage"
 	promstorage "tkestack.io/tke/pkg/platform/registry/prometheus/storage"
 	registrystorage "tkestack.io/tke/pkg/platform/registry/registry/storage"
@@ -82,6 +90,11 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["clusters/status"] = clusterREST.Status
 		storageMap["clusters/finalize"] = clusterREST.Finalize
 		storageMap["clusters/drain"] = clusterREST.Drain
+		storageMap["clusters/restart"] = clusterREST.Restart
+		storageMap["clusters/sosreport"] = clusterREST.SOSReport
+		storageMap["clusters/upgradeplan"] = clusterREST.UpgradePlan
+		storageMap["clusters/netcheck"] = clusterREST.NetCheck
+		storageMap["clusters/logs"] = clusterREST.Logs
 		storageMap["clusters/proxy"] = clusterREST.Proxy
 		storageMap["clusters/apply"] = clusterREST.Apply
 		storageMap["clusters/helm"] = clusterREST.Helm
@@ -101,6 +114,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["machines"] = machineREST.Machine
 		storageMap["machines/status"] = machineREST.Status
 		storageMap["machines/finalize"] = machineREST.Finalize
+		storageMap["machines/sosreport"] = machineREST.SOSReport
 
 		clusterCredentialREST := clustercredentialstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["clustercredentials"] = clusterCredentialREST.ClusterCredential
@@ -126,11 +140,11 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		registryREST := registrystorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
 		storageMap["registries"] = registryREST.Registry
 
-		tappControllerREST := tappcontrollertorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		tappControllerREST := tappcontrollertorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["tappcontrollers"] = tappControllerREST.TappController
 		storageMap["tappcontrollers/status"] = tappControllerREST.Status
 
-		csiOperatorREST := csioperatorstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		csiOperatorREST := csioperatorstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["csioperators"] = csiOperatorREST.CSIOperator
 		storageMap["csioperators/status"] = csiOperatorREST.Status
 
@@ -142,7 +156,7 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		storageMap["logcollectors"] = logCollectorREST.LogCollector
 		storageMap["logcollectors/status"] = logCollectorREST.Status
 
-		cronHPAREST := cronhpastorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		cronHPAREST := cronhpastorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["cronhpas"] = cronHPAREST.CronHPA
 		storageMap["cronhpas/status"] = cronHPAREST.Status
 
@@ -153,6 +167,38 @@ func (s *StorageProvider) v1Storage(apiResourceConfigSource serverstorage.APIRes
 		lbcfREST := lbcfstorage.NewStorage(restOptionsGetter, platformClient, s.PrivilegedUsername)
 		storageMap["lbcfs"] = lbcfREST.LBCF
 		storageMap["lbcfs/status"] = lbcfREST.Status
+
+		egressGatewayREST := egressgatewaystorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["egressgateways"] = egressGatewayREST.EgressGateway
+		storageMap["egressgateways/status"] = egressGatewayREST.Status
+
+		floatingIPReservationREST := floatingipreservationstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["floatingipreservations"] = floatingIPReservationREST.FloatingIPReservation
+		storageMap["floatingipreservations/status"] = floatingIPReservationREST.Status
+
+		gpuNodeConfigREST := gpunodeconfigstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["gpunodeconfigs"] = gpuNodeConfigREST.GPUNodeConfig
+		storageMap["gpunodeconfigs/status"] = gpuNodeConfigREST.Status
+
+		clusterOperationREST := clusteroperationstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["clusteroperations"] = clusterOperationREST.ClusterOperation
+		storageMap["clusteroperations/status"] = clusterOperationREST.Status
+
+		licenseREST := licensestorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["licenses"] = licenseREST.License
+		storageMap["licenses/status"] = licenseREST.Status
+
+		metalLBREST := metallbstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["metallbs"] = metalLBREST.MetalLB
+		storageMap["metallbs/status"] = metalLBREST.Status
+
+		multusREST := multusstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["multuses"] = multusREST.Multus
+		storageMap["multuses/status"] = multusREST.Status
+
+		devicePluginREST := devicepluginstorage.NewStorage(restOptionsGetter, s.PrivilegedUsername)
+		storageMap["deviceplugins"] = devicePluginREST.DevicePlugin
+		storageMap["deviceplugins/status"] = devicePluginREST.Status
 	}
 
 	return storageMap
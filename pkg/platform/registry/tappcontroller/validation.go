@@ -19,9 +19,14 @@
 package tappcontroller
 
 import (
+	"context"
+
 	apiMachineryValidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	"tkestack.io/tke/api/platform"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
+	"tkestack.io/tke/pkg/platform/util/validation"
 )
 
 // ValidateName is a ValidateNameFunc for names that must be a DNS
@@ -35,10 +40,36 @@ func ValidateTappController(tappController *platform.TappController) field.Error
 	if len(tappController.Spec.ClusterName) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
 	}
+	allErrs = append(allErrs, validation.ValidateCRDConflictPolicy(tappController.Spec.CRDConflictPolicy, field.NewPath("spec", "crdConflictPolicy"))...)
+	allErrs = append(allErrs, validation.ValidateAddonDriftPolicy(tappController.Spec.DriftPolicy, field.NewPath("spec", "driftPolicy"))...)
+	if tappController.Spec.Config != nil {
+		allErrs = append(allErrs, ValidateTappControllerConfig(tappController.Spec.Config, field.NewPath("spec", "config"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateTappControllerConfig validates the configuration of tapp-controller.
+func ValidateTappControllerConfig(config *platform.TappControllerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config.Workers < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workers"), config.Workers, "must be non-negative"))
+	}
+	if config.MaxUnavailable < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), config.MaxUnavailable, "must be non-negative"))
+	}
 
 	return allErrs
 }
 
+// ValidateTappControllerVersion tests if the version works with the kubernetes version of cluster.
+func ValidateTappControllerVersion(ctx context.Context, tappController *platform.TappController, platformClient platforminternalclient.PlatformInterface) field.ErrorList {
+	if len(tappController.Spec.ClusterName) == 0 {
+		return nil
+	}
+	return validation.ValidateAddonCompatibility(ctx, platformClient, tappController.Spec.ClusterName, compatibility.TappController, tappController.Spec.Version)
+}
+
 // ValidateTappControllerUpdate tests if required fields in the namespace set are
 // set during an update.
 func ValidateTappControllerUpdate(new *platform.TappController, old *platform.TappController) field.ErrorList {
@@ -34,6 +34,26 @@ func ValidateVolumeDecorator(decorator *platform.VolumeDecorator) field.ErrorLis
 	if len(decorator.Spec.ClusterName) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("spec", "clusterName"), "must specify a cluster name"))
 	}
+	if decorator.Spec.Enforcement != nil {
+		allErrs = append(allErrs, ValidateVolumeUsageEnforcement(decorator.Spec.Enforcement, field.NewPath("spec", "enforcement"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateVolumeUsageEnforcement validates the threshold and action of the
+// enforcement.
+func ValidateVolumeUsageEnforcement(enforcement *platform.VolumeUsageEnforcement, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if enforcement.Threshold < 1 || enforcement.Threshold > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("threshold"), enforcement.Threshold, "must be between 1 and 100"))
+	}
+	switch enforcement.Action {
+	case "", platform.VolumeUsageAnnotate, platform.VolumeUsageEvict:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), enforcement.Action,
+			[]string{string(platform.VolumeUsageAnnotate), string(platform.VolumeUsageEvict)}))
+	}
 
 	return allErrs
 }
@@ -0,0 +1,43 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sandboxadmission
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// RuntimeClass returns the sandboxed RuntimeClass the pod in the namespace
+// must use, or empty if the namespace is trusted. An error is returned if the
+// pod requires another RuntimeClass.
+func RuntimeClass(namespace *corev1.Namespace, pod *corev1.Pod) (string, error) {
+	if namespace.Labels[platformv1.LabelUntrustedNamespace] != "true" {
+		return "", nil
+	}
+	runtimeClassName := namespace.Annotations[platformv1.AnnotationSandboxRuntimeClass]
+	if runtimeClassName == "" {
+		return "", fmt.Errorf("untrusted namespace %s has no sandbox runtime class", namespace.Name)
+	}
+	if pod.Spec.RuntimeClassName != nil && *pod.Spec.RuntimeClassName != runtimeClassName {
+		return "", fmt.Errorf("pods in untrusted namespace %s must use runtime class %s", namespace.Name, runtimeClassName)
+	}
+	return runtimeClassName, nil
+}
@@ -0,0 +1,132 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sandboxadmission
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+// maxRequestSize bounds the size of admission review read from apiserver.
+const maxRequestSize = 3 * 1024 * 1024
+
+// Webhook serves the mutating admission reviews of pods, which defaults the
+// RuntimeClass of pods in untrusted namespaces to the sandboxed one and
+// rejects the pods requiring another RuntimeClass. The pods created in the
+// cluster directly are admitted the same as the ones created by the platform.
+type Webhook struct {
+	namespaceLister corelisters.NamespaceLister
+}
+
+// NewWebhook creates the webhook with the lister of namespaces.
+func NewWebhook(namespaceLister corelisters.NamespaceLister) *Webhook {
+	return &Webhook{namespaceLister: namespaceLister}
+}
+
+// ServeHTTP implements http.Handler.
+func (w *Webhook) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(rw, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if contentType := req.Header.Get("Content-Type"); !strings.HasPrefix(contentType, "application/json") {
+		http.Error(rw, fmt.Sprintf("unsupported content type %s", contentType), http.StatusUnsupportedMediaType)
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(rw, req.Body, maxRequestSize))
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return
+	}
+	review := new(admissionv1.AdmissionReview)
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(rw, "invalid admission review", http.StatusBadRequest)
+		return
+	}
+
+	review.Response = w.Review(review.Request)
+	review.Response.UID = review.Request.UID
+	review.Request = nil
+	data, err := json.Marshal(review)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	_, _ = rw.Write(data)
+}
+
+// Review admits the RuntimeClass of pod in the admission request. The pod is
+// rejected if its namespace can not be got.
+func (w *Webhook) Review(req *admissionv1.AdmissionRequest) *admissionv1.AdmissionResponse {
+	allowed := &admissionv1.AdmissionResponse{Allowed: true}
+	if req.Kind.Kind != "Pod" || req.Operation != admissionv1.Create {
+		return allowed
+	}
+	pod := new(corev1.Pod)
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		return denied(http.StatusBadRequest, metav1.StatusReasonBadRequest, fmt.Sprintf("decode pod error: %v", err))
+	}
+	namespace, err := w.namespaceLister.Get(req.Namespace)
+	if err != nil {
+		log.Error("Get namespace for runtime class admission failed", log.String("namespace", req.Namespace), log.Err(err))
+		return denied(http.StatusInternalServerError, metav1.StatusReasonInternalError, fmt.Sprintf("get namespace %s error: %v", req.Namespace, err))
+	}
+
+	runtimeClassName, err := RuntimeClass(namespace, pod)
+	if err != nil {
+		log.Info("Runtime class admission denied", log.String("namespace", req.Namespace), log.String("name", pod.Name),
+			log.String("user", req.UserInfo.Username), log.Err(err))
+		return denied(http.StatusForbidden, metav1.StatusReasonForbidden, err.Error())
+	}
+	if runtimeClassName == "" || pod.Spec.RuntimeClassName != nil {
+		return allowed
+	}
+
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "add", "path": "/spec/runtimeClassName", "value": runtimeClassName},
+	})
+	if err != nil {
+		return denied(http.StatusInternalServerError, metav1.StatusReasonInternalError, err.Error())
+	}
+	patchType := admissionv1.PatchTypeJSONPatch
+	allowed.Patch = patch
+	allowed.PatchType = &patchType
+	return allowed
+}
+
+func denied(code int32, reason metav1.StatusReason, message string) *admissionv1.AdmissionResponse {
+	return &admissionv1.AdmissionResponse{
+		Result: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    code,
+			Reason:  reason,
+			Message: message,
+		},
+	}
+}
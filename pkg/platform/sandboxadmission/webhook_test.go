@@ -0,0 +1,106 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sandboxadmission
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+func TestWebhookReview(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range []*corev1.Namespace{
+		{ObjectMeta: metav1.ObjectMeta{Name: "trusted"}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:        "untrusted",
+			Labels:      map[string]string{platformv1.LabelUntrustedNamespace: "true"},
+			Annotations: map[string]string{platformv1.AnnotationSandboxRuntimeClass: "kata"},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:   "misconfigured",
+			Labels: map[string]string{platformv1.LabelUntrustedNamespace: "true"},
+		}},
+	} {
+		if err := indexer.Add(ns); err != nil {
+			t.Fatal(err)
+		}
+	}
+	w := NewWebhook(corelisters.NewNamespaceLister(indexer))
+
+	runc, kata := "runc", "kata"
+	tests := []struct {
+		name         string
+		namespace    string
+		operation    admissionv1.Operation
+		runtimeClass *string
+		wantAllowed  bool
+		wantCode     int32
+		wantPatch    bool
+	}{
+		{name: "trusted namespace", namespace: "trusted", operation: admissionv1.Create, wantAllowed: true},
+		{name: "default runtime class", namespace: "untrusted", operation: admissionv1.Create, wantAllowed: true, wantPatch: true},
+		{name: "sandbox runtime class", namespace: "untrusted", operation: admissionv1.Create, runtimeClass: &kata, wantAllowed: true},
+		{name: "other runtime class", namespace: "untrusted", operation: admissionv1.Create, runtimeClass: &runc, wantCode: http.StatusForbidden},
+		{name: "no sandbox runtime class", namespace: "misconfigured", operation: admissionv1.Create, wantCode: http.StatusForbidden},
+		{name: "unknown namespace", namespace: "unknown", operation: admissionv1.Create, wantCode: http.StatusInternalServerError},
+		{name: "update", namespace: "untrusted", operation: admissionv1.Update, runtimeClass: &runc, wantAllowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: tt.namespace},
+				Spec:       corev1.PodSpec{RuntimeClassName: tt.runtimeClass},
+			}
+			raw, _ := json.Marshal(pod)
+			resp := w.Review(&admissionv1.AdmissionRequest{
+				Kind:      metav1.GroupVersionKind{Version: "v1", Kind: "Pod"},
+				Namespace: tt.namespace,
+				Operation: tt.operation,
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+			if resp.Allowed != tt.wantAllowed {
+				t.Fatalf("Review() allowed = %v, want %v: %+v", resp.Allowed, tt.wantAllowed, resp.Result)
+			}
+			if !tt.wantAllowed && resp.Result.Code != tt.wantCode {
+				t.Errorf("Review() code = %d, want %d", resp.Result.Code, tt.wantCode)
+			}
+			if (resp.Patch != nil) != tt.wantPatch {
+				t.Fatalf("Review() patch = %s, want patch %v", resp.Patch, tt.wantPatch)
+			}
+			if tt.wantPatch {
+				var patch []map[string]interface{}
+				if err := json.Unmarshal(resp.Patch, &patch); err != nil {
+					t.Fatal(err)
+				}
+				if len(patch) != 1 || patch[0]["path"] != "/spec/runtimeClassName" || patch[0]["value"] != "kata" {
+					t.Errorf("unexpected patch %s", resp.Patch)
+				}
+			}
+		})
+	}
+}
@@ -235,3 +235,12 @@ func (c *Cluster) HostForBootstrap() (string, error) {
 
 	return "", errors.New("can't find bootstrap address")
 }
+
+// RegistryPasswords returns the passwords of the registries in
+// ContainerRegistries, which are kept in the credential by registry.
+func (c *Cluster) RegistryPasswords() map[string][]byte {
+	if c.ClusterCredential == nil {
+		return nil
+	}
+	return c.ClusterCredential.RegistryPasswords
+}
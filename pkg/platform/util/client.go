@@ -180,7 +180,12 @@ func GetExternalRestConfig(cluster *platformv1.Cluster, credential *platformv1.C
 		AuthInfo: contextName,
 	}
 	clientConfig := clientcmd.NewNonInteractiveClientConfig(*config, contextName, &clientcmd.ConfigOverrides{Timeout: "30s"}, nil)
-	return clientConfig.ClientConfig()
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	setFailover(restConfig, credential.Endpoints)
+	return restConfig, nil
 }
 
 // GetInternalRestConfig returns rest config according to cluster
@@ -222,7 +227,12 @@ func GetInternalRestConfig(cluster *platform.Cluster, credential *platform.Clust
 		AuthInfo: contextName,
 	}
 	clientConfig := clientcmd.NewNonInteractiveClientConfig(*config, contextName, &clientcmd.ConfigOverrides{Timeout: "30s"}, nil)
-	return clientConfig.ClientConfig()
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	setFailover(restConfig, credential.Endpoints)
+	return restConfig, nil
 }
 
 // BuildInternalDynamicClientSet creates the dynamic clientset of kubernetes by given cluster
@@ -449,6 +459,7 @@ func BuildClientSet(ctx context.Context, cluster *platform.Cluster, credential *
 	}
 	restConfig.QPS = clientQPS
 	restConfig.Burst = clientBurst
+	setFailover(restConfig, credential.Endpoints)
 	return kubernetes.NewForConfig(restConfig)
 }
 
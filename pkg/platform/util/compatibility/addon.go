@@ -0,0 +1,100 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package compatibility
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	platformv1client "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+)
+
+// ClusterAddon is an addon object of cluster.
+type ClusterAddon struct {
+	Addon   string
+	Name    string
+	Version string
+}
+
+// ListClusterAddons lists the addon objects of cluster whose versions are
+// registered in the compatibility matrix.
+func ListClusterAddons(ctx context.Context, platformClient platformv1client.PlatformV1Interface, clusterName string) ([]ClusterAddon, error) {
+	options := metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.clusterName", clusterName).String(),
+	}
+	var addons []ClusterAddon
+
+	cronHPAs, err := platformClient.CronHPAs().List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range cronHPAs.Items {
+		addons = append(addons, ClusterAddon{Addon: CronHPA, Name: one.Name, Version: one.Spec.Version})
+	}
+	tappControllers, err := platformClient.TappControllers().List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range tappControllers.Items {
+		addons = append(addons, ClusterAddon{Addon: TappController, Name: one.Name, Version: one.Spec.Version})
+	}
+	csiOperators, err := platformClient.CSIOperators().List(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+	for _, one := range csiOperators.Items {
+		addons = append(addons, ClusterAddon{Addon: CSIOperator, Name: one.Name, Version: one.Spec.Version})
+	}
+
+	return addons, nil
+}
+
+// SetClusterAddonVersion changes the version of addon object, the addon is
+// upgraded by its controller later.
+func SetClusterAddonVersion(ctx context.Context, platformClient platformv1client.PlatformV1Interface, addon ClusterAddon, version string) error {
+	switch addon.Addon {
+	case CronHPA:
+		obj, err := platformClient.CronHPAs().Get(ctx, addon.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		obj.Spec.Version = version
+		_, err = platformClient.CronHPAs().Update(ctx, obj, metav1.UpdateOptions{})
+		return err
+	case TappController:
+		obj, err := platformClient.TappControllers().Get(ctx, addon.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		obj.Spec.Version = version
+		_, err = platformClient.TappControllers().Update(ctx, obj, metav1.UpdateOptions{})
+		return err
+	case CSIOperator:
+		obj, err := platformClient.CSIOperators().Get(ctx, addon.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		obj.Spec.Version = version
+		_, err = platformClient.CSIOperators().Update(ctx, obj, metav1.UpdateOptions{})
+		return err
+	}
+	return fmt.Errorf("unsupported addon %s", addon.Addon)
+}
@@ -0,0 +1,173 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package compatibility records the kubernetes versions each addon version
+// works with, it is consulted when addons are created or upgraded and when
+// clusters are upgraded.
+package compatibility
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// The addons registered in the compatibility matrix.
+const (
+	CronHPA        = "CronHPA"
+	TappController = "TappController"
+	CSIOperator    = "CSIOperator"
+	Galaxy         = "Galaxy"
+	GPUManager     = "GPUManager"
+)
+
+// Range is the range of kubernetes versions [Min, Max) which an addon version
+// works with, an empty bound means unlimited.
+type Range struct {
+	Min string
+	Max string
+}
+
+// matrix records the compatible kubernetes versions of each addon version,
+// a new addon version must be registered here with its images.
+var matrix = map[string]map[string]Range{
+	CronHPA: {
+		"v1.0.1": {Min: "1.10.0"},
+	},
+	TappController: {
+		"v1.2.1": {Min: "1.12.0"},
+	},
+	CSIOperator: {
+		"v1.0.2": {Min: "1.14.0"},
+	},
+	Galaxy: {
+		"v1.0.0": {Min: "1.12.0"},
+	},
+	GPUManager: {
+		"v1.0.6": {Min: "1.10.0", Max: "1.22.0"},
+	},
+}
+
+// Check returns an error if the addon version doesn't work with the kubernetes
+// version. The versions not registered are not checked.
+func Check(addon string, addonVersion string, k8sVersion string) error {
+	r, ok := matrix[addon][addonVersion]
+	if !ok {
+		return nil
+	}
+	ok, err := r.contains(k8sVersion)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%s %s is not compatible with kubernetes %s, requires %s", addon, addonVersion, k8sVersion, r)
+	}
+	return nil
+}
+
+// LatestCompatible returns the latest version of addon which works with the
+// kubernetes version, false is returned if there is none.
+func LatestCompatible(addon string, k8sVersion string) (string, bool, error) {
+	latest := ""
+	var latestVersion *version.Version
+	for one, r := range matrix[addon] {
+		ok, err := r.contains(k8sVersion)
+		if err != nil {
+			return "", false, err
+		}
+		if !ok {
+			continue
+		}
+		v, err := version.ParseGeneric(one)
+		if err != nil {
+			return "", false, err
+		}
+		if latestVersion == nil || latestVersion.LessThan(v) {
+			latest, latestVersion = one, v
+		}
+	}
+
+	return latest, latestVersion != nil, nil
+}
+
+// Addons returns the addons registered in the compatibility matrix.
+func Addons() []string {
+	addons := make([]string, 0, len(matrix))
+	for addon := range matrix {
+		addons = append(addons, addon)
+	}
+	sort.Strings(addons)
+
+	return addons
+}
+
+// Compatible returns the versions of addon which work with the kubernetes
+// version, from the oldest to the latest.
+func Compatible(addon string, k8sVersion string) ([]string, error) {
+	var versions []*version.Version
+	names := map[*version.Version]string{}
+	for one, r := range matrix[addon] {
+		ok, err := r.contains(k8sVersion)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		v, err := version.ParseGeneric(one)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+		names[v] = one
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].LessThan(versions[j])
+	})
+
+	result := make([]string, 0, len(versions))
+	for _, v := range versions {
+		result = append(result, names[v])
+	}
+	return result, nil
+}
+
+func (r Range) contains(k8sVersion string) (bool, error) {
+	v, err := version.ParseGeneric(k8sVersion)
+	if err != nil {
+		return false, err
+	}
+	if r.Min != "" && v.LessThan(version.MustParseGeneric(r.Min)) {
+		return false, nil
+	}
+	if r.Max != "" && !v.LessThan(version.MustParseGeneric(r.Max)) {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (r Range) String() string {
+	switch {
+	case r.Max == "":
+		return fmt.Sprintf(">= %s", r.Min)
+	case r.Min == "":
+		return fmt.Sprintf("< %s", r.Max)
+	}
+	return fmt.Sprintf(">= %s, < %s", r.Min, r.Max)
+}
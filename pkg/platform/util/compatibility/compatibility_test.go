@@ -0,0 +1,81 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package compatibility
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	matrix["test"] = map[string]Range{
+		"v1.0.0": {Max: "1.19.0"},
+		"v1.1.0": {Min: "1.18.0", Max: "1.21.0"},
+		"v1.2.0": {Min: "1.20.0"},
+	}
+	defer delete(matrix, "test")
+
+	tests := []struct {
+		addonVersion string
+		k8sVersion   string
+		wantErr      bool
+	}{
+		{"v1.0.0", "1.18.3", false},
+		{"v1.0.0", "1.19.7", true},
+		{"v1.1.0", "1.20.4-tke.1", false},
+		{"v1.2.0", "1.19.7", true},
+		{"v0.9.0", "1.19.7", false},
+	}
+	for _, tt := range tests {
+		err := Check("test", tt.addonVersion, tt.k8sVersion)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Check(%s, %s) error = %v, wantErr %v", tt.addonVersion, tt.k8sVersion, err, tt.wantErr)
+		}
+	}
+
+	latest := []struct {
+		k8sVersion string
+		want       string
+		wantOK     bool
+	}{
+		{"1.18.3", "v1.1.0", true},
+		{"1.20.4", "v1.2.0", true},
+		{"1.16.0", "v1.0.0", true},
+	}
+	for _, tt := range latest {
+		got, ok, err := LatestCompatible("test", tt.k8sVersion)
+		if err != nil || got != tt.want || ok != tt.wantOK {
+			t.Errorf("LatestCompatible(%s) = %s, %v, %v, want %s, %v", tt.k8sVersion, got, ok, err, tt.want, tt.wantOK)
+		}
+	}
+	compatible := []struct {
+		k8sVersion string
+		want       string
+	}{
+		{"1.18.3", "v1.0.0,v1.1.0"},
+		{"1.20.4", "v1.1.0,v1.2.0"},
+		{"1.22.0", "v1.2.0"},
+	}
+	for _, tt := range compatible {
+		got, err := Compatible("test", tt.k8sVersion)
+		if err != nil || strings.Join(got, ",") != tt.want {
+			t.Errorf("Compatible(%s) = %v, %v, want %s", tt.k8sVersion, got, err, tt.want)
+		}
+	}
+}
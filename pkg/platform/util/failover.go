@@ -0,0 +1,176 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+	"k8s.io/apimachinery/pkg/util/wait"
+	restclient "k8s.io/client-go/rest"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	endpointProbeInterval = 10 * time.Second
+	endpointProbeTimeout  = 3 * time.Second
+)
+
+// unhealthyEndpoints records the kube-apiserver endpoints of member clusters
+// which failed to connect, shared by all clients of the process.
+var unhealthyEndpoints = &endpointHealth{failed: make(map[string]bool)}
+
+type endpointHealth struct {
+	sync.RWMutex
+	failed map[string]bool
+}
+
+func (h *endpointHealth) healthy(endpoint string) bool {
+	h.RLock()
+	defer h.RUnlock()
+	return !h.failed[endpoint]
+}
+
+// markFailed marks the endpoint unhealthy and probes it in background until
+// it can be connected again.
+func (h *endpointHealth) markFailed(endpoint string) {
+	h.Lock()
+	defer h.Unlock()
+	if h.failed[endpoint] {
+		return
+	}
+	h.failed[endpoint] = true
+	log.Warn("Kube-apiserver endpoint is unreachable", log.String("endpoint", endpoint))
+
+	go func() {
+		_ = wait.PollImmediateInfinite(endpointProbeInterval, func() (bool, error) {
+			conn, err := net.DialTimeout("tcp", endpoint, endpointProbeTimeout)
+			if err != nil {
+				return false, nil
+			}
+			conn.Close()
+			return true, nil
+		})
+		h.Lock()
+		delete(h.failed, endpoint)
+		h.Unlock()
+		log.Info("Kube-apiserver endpoint is reachable again", log.String("endpoint", endpoint))
+	}()
+}
+
+// sort returns the endpoints with healthy ones first.
+func (h *endpointHealth) sort(endpoints []string) []string {
+	var healthy, failed []string
+	for _, one := range endpoints {
+		if h.healthy(one) {
+			healthy = append(healthy, one)
+		} else {
+			failed = append(failed, one)
+		}
+	}
+	return append(healthy, failed...)
+}
+
+// failoverRoundTripper sends the request to the next endpoint when the
+// connection to the current one fails.
+type failoverRoundTripper struct {
+	endpoints []string
+	delegate  http.RoundTripper
+}
+
+func (rt *failoverRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	var lastErr error
+	for _, endpoint := range unhealthyEndpoints.sort(rt.endpoints) {
+		r := utilnet.CloneRequest(req)
+		u := *req.URL
+		u.Host = endpoint
+		r.URL = &u
+		if req.Body != nil && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			r.Body = body
+		}
+		resp, err := rt.delegate.RoundTrip(r)
+		if err == nil || !isDialError(err) {
+			return resp, err
+		}
+		unhealthyEndpoints.markFailed(endpoint)
+		lastErr = err
+		// the body can't be sent again
+		if req.Body != nil && req.GetBody == nil {
+			break
+		}
+	}
+
+	return nil, lastErr
+}
+
+func (rt *failoverRoundTripper) WrappedRoundTripper() http.RoundTripper {
+	return rt.delegate
+}
+
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// IsClusterUnreachable reports whether the error is caused by the network
+// between platform and the cluster rather than returned by the cluster, the
+// request may succeed once the cluster is reachable again.
+func IsClusterUnreachable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if isDialError(err) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err)
+}
+
+// setFailover makes the config fail over from its host to endpoints, which
+// are host:port of kube-apiservers.
+func setFailover(config *restclient.Config, endpoints []string) {
+	if len(endpoints) == 0 {
+		return
+	}
+	u, err := url.Parse(config.Host)
+	if err != nil || u.Host == "" {
+		return
+	}
+	all := []string{u.Host}
+	for _, one := range endpoints {
+		if one != u.Host {
+			all = append(all, one)
+		}
+	}
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &failoverRoundTripper{endpoints: all, delegate: rt}
+	})
+}
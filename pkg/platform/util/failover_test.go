@@ -0,0 +1,155 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	restclient "k8s.io/client-go/rest"
+)
+
+// closedEndpoint returns an endpoint refusing connections.
+func closedEndpoint(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoint := l.Addr().String()
+	l.Close()
+	return endpoint
+}
+
+func TestFailoverRoundTripper(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+	live := strings.TrimPrefix(server.URL, "http://")
+	dead := closedEndpoint(t)
+
+	rt := &failoverRoundTripper{endpoints: []string{dead, live}, delegate: http.DefaultTransport}
+	req, err := http.NewRequest(http.MethodPost, "http://"+dead+"/api/v1/namespaces", bytes.NewReader([]byte("namespace")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+	if !reflect.DeepEqual(bodies, []string{"namespace"}) {
+		t.Errorf("bodies received = %v, want the body sent again", bodies)
+	}
+	if unhealthyEndpoints.healthy(dead) {
+		t.Errorf("endpoint %s is not marked unhealthy", dead)
+	}
+	if got := unhealthyEndpoints.sort([]string{dead, live}); !reflect.DeepEqual(got, []string{live, dead}) {
+		t.Errorf("sort() = %v, want the healthy endpoint first", got)
+	}
+
+	// the body which can't be sent again is not failed over
+	another := closedEndpoint(t)
+	rt = &failoverRoundTripper{endpoints: []string{another, live}, delegate: http.DefaultTransport}
+	req, err = http.NewRequest(http.MethodPost, "http://"+another+"/api/v1/namespaces", ioutil.NopCloser(strings.NewReader("namespace")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.RoundTrip(req); !isDialError(err) {
+		t.Errorf("RoundTrip() error = %v, want the dial error", err)
+	}
+	if len(bodies) != 1 {
+		t.Errorf("bodies received = %v, want the request not sent again", bodies)
+	}
+}
+
+func TestSetFailover(t *testing.T) {
+	tests := []struct {
+		name      string
+		host      string
+		endpoints []string
+		want      []string
+	}{
+		{"no endpoints", "https://10.0.0.1:6443", nil, nil},
+		{"invalid host", "10.0.0.1:6443", []string{"10.0.0.2:6443"}, nil},
+		{"host first", "https://10.0.0.2:6443", []string{"10.0.0.1:6443", "10.0.0.2:6443", "10.0.0.3:6443"},
+			[]string{"10.0.0.2:6443", "10.0.0.1:6443", "10.0.0.3:6443"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &restclient.Config{Host: tt.host}
+			setFailover(config, tt.endpoints)
+			if config.WrapTransport == nil {
+				if tt.want != nil {
+					t.Fatalf("setFailover() didn't wrap the transport")
+				}
+				return
+			}
+			wrapped := config.WrapTransport(http.DefaultTransport)
+			rt, ok := wrapped.(*failoverRoundTripper)
+			if !ok {
+				t.Fatalf("setFailover() wrapped the transport with %T", wrapped)
+			}
+			if !reflect.DeepEqual(rt.endpoints, tt.want) {
+				t.Errorf("setFailover() endpoints = %v, want %v", rt.endpoints, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsClusterUnreachable(t *testing.T) {
+	dialErr := &net.OpError{Op: "dial", Net: "tcp", Err: io.ErrUnexpectedEOF}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"dial", &url.Error{Op: "Get", URL: "https://10.0.0.1:6443", Err: dialErr}, true},
+		{"eof", io.EOF, true},
+		{"timeout", &url.Error{Op: "Get", URL: "https://10.0.0.1:6443", Err: timeoutError{}}, true},
+		{"returned by cluster", apierrors.NewNotFound(schema.GroupResource{Resource: "namespaces"}, "default"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsClusterUnreachable(tt.err); got != tt.want {
+				t.Errorf("IsClusterUnreachable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
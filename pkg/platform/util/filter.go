@@ -203,3 +203,92 @@ func FilterLBCF(ctx context.Context, lbcf *platform.LBCF) error {
 	}
 	return nil
 }
+
+// FilterEgressGateway is used to filter EgressGateway that do not belong
+// to the tenant.
+func FilterEgressGateway(ctx context.Context, egressGateway *platform.EgressGateway) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if egressGateway.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("egressgateway"), egressGateway.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterFloatingIPReservation is used to filter FloatingIPReservation that do
+// not belong to the tenant.
+func FilterFloatingIPReservation(ctx context.Context, reservation *platform.FloatingIPReservation) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if reservation.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("floatingipreservation"), reservation.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterMetalLB is used to filter MetalLB that do not belong to the tenant.
+func FilterMetalLB(ctx context.Context, metalLB *platform.MetalLB) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if metalLB.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("metallb"), metalLB.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterMultus is used to filter Multus that do not belong to the tenant.
+func FilterMultus(ctx context.Context, multus *platform.Multus) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if multus.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("multus"), multus.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterClusterOperation is used to filter ClusterOperation that do not belong
+// to the tenant.
+func FilterClusterOperation(ctx context.Context, operation *platform.ClusterOperation) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if operation.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("clusteroperation"), operation.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterGPUNodeConfig is used to filter GPUNodeConfig that do not belong to
+// the tenant.
+func FilterGPUNodeConfig(ctx context.Context, config *platform.GPUNodeConfig) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if config.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("gpunodeconfig"), config.ObjectMeta.Name)
+	}
+	return nil
+}
+
+// FilterDevicePlugin is used to filter DevicePlugin that do not belong to the
+// tenant.
+func FilterDevicePlugin(ctx context.Context, plugin *platform.DevicePlugin) error {
+	_, tenantID := authentication.UsernameAndTenantID(ctx)
+	if tenantID == "" {
+		return nil
+	}
+	if plugin.Spec.TenantID != tenantID {
+		return errors.NewNotFound(v1.Resource("deviceplugin"), plugin.ObjectMeta.Name)
+	}
+	return nil
+}
@@ -0,0 +1,444 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package netcheck verifies the network connectivity of a cluster by launching
+// probe pods on every node and running node-to-node, pod-to-pod,
+// pod-to-service and DNS checks between them.
+package netcheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/workqueue"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// CheckNodeToNode checks the host network between nodes.
+	CheckNodeToNode = "node-to-node"
+	// CheckPodToPod checks the pod network between nodes.
+	CheckPodToPod = "pod-to-pod"
+	// CheckPodToService checks the cluster ip of service from pods.
+	CheckPodToService = "pod-to-service"
+	// CheckDNS checks the cluster DNS from pods.
+	CheckDNS = "dns"
+
+	// DefaultImage is the image of probe pods, which must have sh, httpd,
+	// wget, ping and nslookup.
+	DefaultImage = "busybox:1.31.1"
+	// DefaultTimeout is the default timeout of waiting for probe pods ready.
+	DefaultTimeout = 3 * time.Minute
+
+	name     = "tke-netcheck"
+	hostName = "tke-netcheck-host"
+	port     = 8080
+	workers  = 10
+)
+
+// Checks are all checks in order.
+var Checks = []string{CheckNodeToNode, CheckPodToPod, CheckPodToService, CheckDNS}
+
+// Option of network check.
+type Option struct {
+	Image         string
+	Namespace     string
+	ClusterDomain string
+	Timeout       time.Duration
+}
+
+// Result is the result of a check from the node to the target.
+type Result struct {
+	Check   string `json:"check"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Success bool   `json:"success"`
+	Message string `json:"message,omitempty"`
+}
+
+// Report is the results of all checks.
+type Report struct {
+	Nodes   []string `json:"nodes"`
+	Results []Result `json:"results"`
+}
+
+// Failures returns the failed results.
+func (r *Report) Failures() []Result {
+	var failures []Result
+	for _, one := range r.Results {
+		if !one.Success {
+			failures = append(failures, one)
+		}
+	}
+	return failures
+}
+
+// Summary describes the failures of report in a line.
+func (r *Report) Summary() string {
+	failures := r.Failures()
+	if len(failures) == 0 {
+		return fmt.Sprintf("all checks passed on %d nodes", len(r.Nodes))
+	}
+	var items []string
+	for _, one := range failures {
+		items = append(items, fmt.Sprintf("%s %s->%s", one.Check, one.From, one.To))
+	}
+	return fmt.Sprintf("%d checks failed: %s", len(failures), strings.Join(items, ", "))
+}
+
+// Matrix returns the results of the check indexed by source and target.
+func (r *Report) Matrix(check string) map[string]map[string]*Result {
+	matrix := map[string]map[string]*Result{}
+	for i := range r.Results {
+		one := &r.Results[i]
+		if one.Check != check {
+			continue
+		}
+		if matrix[one.From] == nil {
+			matrix[one.From] = map[string]*Result{}
+		}
+		matrix[one.From][one.To] = one
+	}
+	return matrix
+}
+
+func (o *Option) setDefaults() {
+	if o.Image == "" {
+		o.Image = DefaultImage
+	}
+	if o.Namespace == "" {
+		o.Namespace = metav1.NamespaceSystem
+	}
+	if o.ClusterDomain == "" {
+		o.ClusterDomain = "cluster.local"
+	}
+	if o.Timeout == 0 {
+		o.Timeout = DefaultTimeout
+	}
+}
+
+// Run launches the probe pods, runs all checks and removes the probe pods.
+func Run(ctx context.Context, client kubernetes.Interface, config *rest.Config, option Option) (*Report, error) {
+	option.setDefaults()
+	logger := log.FromContext(ctx).WithName("netcheck")
+
+	defer func() {
+		// clean up even if the context is canceled
+		if err := cleanup(context.Background(), client, option.Namespace); err != nil {
+			logger.Error(err, "Clean up probe pods failed")
+		}
+	}()
+	if err := deploy(ctx, client, option); err != nil {
+		return nil, fmt.Errorf("deploy probe pods error: %w", err)
+	}
+	pods, hostPods, err := waitReady(ctx, client, option)
+	if err != nil {
+		return nil, err
+	}
+	svc, err := client.CoreV1().Services(option.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, pod := range hostPods {
+		report.Nodes = append(report.Nodes, pod.Spec.NodeName)
+	}
+	e := &executor{client: client, config: config}
+
+	var mu sync.Mutex
+	add := func(results []Result) {
+		mu.Lock()
+		defer mu.Unlock()
+		report.Results = append(report.Results, results...)
+	}
+	var hostTargets, podTargets []target
+	for _, pod := range hostPods {
+		hostTargets = append(hostTargets, target{name: pod.Spec.NodeName, address: pod.Status.HostIP})
+	}
+	for _, pod := range pods {
+		podTargets = append(podTargets, target{name: pod.Spec.NodeName, address: fmt.Sprintf("http://%s:%d/", pod.Status.PodIP, port)})
+	}
+	serviceTarget := target{name: "service/" + name, address: fmt.Sprintf("http://%s:%d/", svc.Spec.ClusterIP, port)}
+	dnsTarget := target{name: "kubernetes.default", address: "kubernetes.default.svc." + option.ClusterDomain}
+
+	workqueue.ParallelizeUntil(ctx, workers, len(hostPods), func(i int) {
+		add(e.probe(ctx, &hostPods[i], CheckNodeToNode, "ping -c 1 -W 2", hostTargets))
+	})
+	workqueue.ParallelizeUntil(ctx, workers, len(pods), func(i int) {
+		add(e.probe(ctx, &pods[i], CheckPodToPod, "wget -q -T 2 -O /dev/null", podTargets))
+		add(e.probe(ctx, &pods[i], CheckPodToService, "wget -q -T 2 -O /dev/null", []target{serviceTarget}))
+		add(e.probe(ctx, &pods[i], CheckDNS, "nslookup", []target{dnsTarget}))
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	order := map[string]int{}
+	for i, check := range Checks {
+		order[check] = i
+	}
+	sort.Slice(report.Results, func(i, j int) bool {
+		a, b := report.Results[i], report.Results[j]
+		if a.Check != b.Check {
+			return order[a.Check] < order[b.Check]
+		}
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		return a.To < b.To
+	})
+	sort.Strings(report.Nodes)
+	return report, nil
+}
+
+type target struct {
+	name    string
+	address string
+}
+
+type executor struct {
+	client kubernetes.Interface
+	config *rest.Config
+}
+
+// probe runs the command against all targets in the pod by one exec, and
+// parses the status printed for each target.
+func (e *executor) probe(ctx context.Context, pod *corev1.Pod, check string, command string, targets []target) []Result {
+	stdout, err := e.exec(ctx, pod, probeScript(command, targets))
+	if err != nil {
+		results := newResults(pod.Spec.NodeName, check, targets)
+		for i := range results {
+			results[i].Message = fmt.Sprintf("exec in %s error: %v", pod.Name, err)
+		}
+		return results
+	}
+	return parseResults(pod.Spec.NodeName, check, command, targets, stdout)
+}
+
+// probeScript returns the shell script which prints "<index> ok" or
+// "<index> fail" for each target.
+func probeScript(command string, targets []target) string {
+	var script strings.Builder
+	for i, t := range targets {
+		fmt.Fprintf(&script, "if %s %s >/dev/null 2>&1; then echo %d ok; else echo %d fail; fi\n", command, t.address, i, i)
+	}
+	return script.String()
+}
+
+func newResults(from string, check string, targets []target) []Result {
+	results := make([]Result, len(targets))
+	for i, t := range targets {
+		results[i] = Result{Check: check, From: from, To: t.name, Message: "no result"}
+	}
+	return results
+}
+
+// parseResults parses the output of probeScript, the targets without status
+// are treated as failed.
+func parseResults(from string, check string, command string, targets []target, stdout string) []Result {
+	results := newResults(from, check, targets)
+	for _, line := range strings.Split(stdout, "\n") {
+		var i int
+		var status string
+		if _, err := fmt.Sscanf(line, "%d %s", &i, &status); err != nil || i < 0 || i >= len(results) {
+			continue
+		}
+		results[i].Success = status == "ok"
+		results[i].Message = ""
+		if !results[i].Success {
+			results[i].Message = fmt.Sprintf("%s %s failed", strings.Fields(command)[0], targets[i].address)
+		}
+	}
+	return results
+}
+
+func (e *executor) exec(ctx context.Context, pod *corev1.Pod, script string) (string, error) {
+	req := e.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: pod.Spec.Containers[0].Name,
+			Command:   []string{"sh", "-c", script},
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+	exec, err := remotecommand.NewSPDYExecutor(e.config, "POST", req.URL())
+	if err != nil {
+		return "", err
+	}
+	var stdout, stderr bytes.Buffer
+	err = exec.Stream(remotecommand.StreamOptions{Stdout: &stdout, Stderr: &stderr})
+	if err != nil {
+		return "", fmt.Errorf("%v: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+func deploy(ctx context.Context, client kubernetes.Interface, option Option) error {
+	labels := map[string]string{"app": name}
+	hostLabels := map[string]string{"app": hostName}
+	tolerations := []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+	var gracePeriod int64
+
+	daemonSets := []*appsv1.DaemonSet{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: option.Namespace, Labels: labels},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: labels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: labels},
+					Spec: corev1.PodSpec{
+						Tolerations:                   tolerations,
+						TerminationGracePeriodSeconds: &gracePeriod,
+						Containers: []corev1.Container{{
+							Name:    "probe",
+							Image:   option.Image,
+							Command: []string{"sh", "-c", fmt.Sprintf("echo ok > /tmp/index.html && exec httpd -f -p %d -h /tmp", port)},
+							Ports:   []corev1.ContainerPort{{ContainerPort: port}},
+							ReadinessProbe: &corev1.Probe{
+								Handler: corev1.Handler{
+									TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(port)},
+								},
+								PeriodSeconds: 2,
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: hostName, Namespace: option.Namespace, Labels: hostLabels},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: hostLabels},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{Labels: hostLabels},
+					Spec: corev1.PodSpec{
+						HostNetwork:                   true,
+						Tolerations:                   tolerations,
+						TerminationGracePeriodSeconds: &gracePeriod,
+						Containers: []corev1.Container{{
+							Name:    "probe",
+							Image:   option.Image,
+							Command: []string{"sh", "-c", "exec sleep 86400"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	for _, ds := range daemonSets {
+		_, err := client.AppsV1().DaemonSets(option.Namespace).Create(ctx, ds, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	_, err := client.CoreV1().Services(option.Namespace).Create(ctx, &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: option.Namespace, Labels: labels},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports:    []corev1.ServicePort{{Port: port, TargetPort: intstr.FromInt(port)}},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// waitReady waits until the probe pods are ready on all schedulable nodes.
+func waitReady(ctx context.Context, client kubernetes.Interface, option Option) ([]corev1.Pod, []corev1.Pod, error) {
+	var pods, hostPods []corev1.Pod
+	var lastErr error
+	err := wait.PollImmediate(2*time.Second, option.Timeout, func() (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		pods, lastErr = readyPods(ctx, client, option.Namespace, name)
+		if lastErr != nil {
+			return false, nil
+		}
+		hostPods, lastErr = readyPods(ctx, client, option.Namespace, hostName)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return nil, nil, fmt.Errorf("wait probe pods ready error: %w", lastErr)
+		}
+		return nil, nil, err
+	}
+	return pods, hostPods, nil
+}
+
+func readyPods(ctx context.Context, client kubernetes.Interface, namespace, dsName string) ([]corev1.Pod, error) {
+	ds, err := client.AppsV1().DaemonSets(namespace).Get(ctx, dsName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	desired := ds.Status.DesiredNumberScheduled
+	if desired == 0 || ds.Status.NumberReady < desired {
+		return nil, fmt.Errorf("daemonset %s has %d of %d pods ready", dsName, ds.Status.NumberReady, desired)
+	}
+	list, err := client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{LabelSelector: "app=" + dsName})
+	if err != nil {
+		return nil, err
+	}
+	var pods []corev1.Pod
+	for _, pod := range list.Items {
+		if pod.DeletionTimestamp == nil && pod.Status.Phase == corev1.PodRunning {
+			pods = append(pods, pod)
+		}
+	}
+	if int32(len(pods)) < desired {
+		return nil, fmt.Errorf("daemonset %s has %d of %d pods running", dsName, len(pods), desired)
+	}
+	return pods, nil
+}
+
+func cleanup(ctx context.Context, client kubernetes.Interface, namespace string) error {
+	propagation := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{PropagationPolicy: &propagation}
+	for _, dsName := range []string{name, hostName} {
+		err := client.AppsV1().DaemonSets(namespace).Delete(ctx, dsName, options)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+	err := client.CoreV1().Services(namespace).Delete(ctx, name, options)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
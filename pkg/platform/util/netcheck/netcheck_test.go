@@ -0,0 +1,74 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package netcheck
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseResults(t *testing.T) {
+	targets := []target{
+		{name: "node1", address: "10.0.0.1"},
+		{name: "node2", address: "10.0.0.2"},
+		{name: "node3", address: "10.0.0.3"},
+	}
+	script := probeScript("ping -c 1", targets)
+	if !strings.Contains(script, "ping -c 1 10.0.0.2 >/dev/null 2>&1; then echo 1 ok; else echo 1 fail; fi") {
+		t.Fatalf("unexpected script: %s", script)
+	}
+
+	results := parseResults("node1", CheckNodeToNode, "ping -c 1", targets, "0 ok\n1 fail\nunexpected line\n")
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(results))
+	}
+	if !results[0].Success || results[0].To != "node1" || results[0].From != "node1" {
+		t.Errorf("unexpected result: %+v", results[0])
+	}
+	if results[1].Success || results[1].Message != "ping 10.0.0.2 failed" {
+		t.Errorf("unexpected result: %+v", results[1])
+	}
+	if results[2].Success || results[2].Message != "no result" {
+		t.Errorf("target without status should fail: %+v", results[2])
+	}
+}
+
+func TestReportFailures(t *testing.T) {
+	report := &Report{
+		Nodes: []string{"node1", "node2"},
+		Results: []Result{
+			{Check: CheckPodToPod, From: "node1", To: "node2", Success: true},
+			{Check: CheckDNS, From: "node2", To: "kubernetes.default"},
+		},
+	}
+	if failures := report.Failures(); len(failures) != 1 || failures[0].Check != CheckDNS {
+		t.Fatalf("unexpected failures: %v", failures)
+	}
+	if summary := report.Summary(); summary != "1 checks failed: dns node2->kubernetes.default" {
+		t.Errorf("unexpected summary: %s", summary)
+	}
+	if matrix := report.Matrix(CheckPodToPod); matrix["node1"]["node2"] == nil || !matrix["node1"]["node2"].Success {
+		t.Errorf("unexpected matrix: %v", matrix)
+	}
+
+	report.Results = report.Results[:1]
+	if summary := report.Summary(); summary != "all checks passed on 2 nodes" {
+		t.Errorf("unexpected summary: %s", summary)
+	}
+}
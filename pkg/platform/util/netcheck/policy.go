@@ -0,0 +1,209 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package netcheck
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	// PolicyNamespace is the namespace of the probe pods of policy check, which
+	// is deleted after the check.
+	PolicyNamespace = "tke-netcheck-policy"
+	// DefaultPolicyTimeout is the default time to wait for the CNI to enforce
+	// the NetworkPolicy.
+	DefaultPolicyTimeout = 30 * time.Second
+
+	policyServerName = "tke-netcheck-policy-server"
+	policyClientName = "tke-netcheck-policy-client"
+)
+
+// PolicyResult is the result of NetworkPolicy enforcement check.
+type PolicyResult struct {
+	Enforced bool   `json:"enforced"`
+	Message  string `json:"message,omitempty"`
+}
+
+// RunPolicy verifies whether the CNI enforces NetworkPolicy. It launches a
+// server pod and a client pod in PolicyNamespace, denies the ingress traffic
+// of the server pod after the client pod reaches it, and checks if the client
+// pod is blocked in DefaultPolicyTimeout. The Namespace of option is ignored.
+func RunPolicy(ctx context.Context, client kubernetes.Interface, config *rest.Config, option Option) (*PolicyResult, error) {
+	option.setDefaults()
+	logger := log.FromContext(ctx).WithName("netcheck")
+
+	defer func() {
+		// clean up even if the context is canceled
+		err := client.CoreV1().Namespaces().Delete(context.Background(), PolicyNamespace, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			logger.Error(err, "Clean up policy probe namespace failed")
+		}
+	}()
+	if err := deployPolicyProbes(ctx, client, option); err != nil {
+		return nil, fmt.Errorf("deploy policy probe pods error: %w", err)
+	}
+	var server, probe *corev1.Pod
+	var lastErr error
+	err := wait.PollImmediate(2*time.Second, option.Timeout, func() (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		server, lastErr = readyPod(ctx, client, policyServerName)
+		if lastErr != nil {
+			return false, nil
+		}
+		probe, lastErr = readyPod(ctx, client, policyClientName)
+		return lastErr == nil, nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return nil, fmt.Errorf("wait policy probe pods ready error: %w", lastErr)
+		}
+		return nil, err
+	}
+
+	e := &executor{client: client, config: config}
+	serverTarget := []target{{name: server.Name, address: fmt.Sprintf("http://%s:%d/", server.Status.PodIP, port)}}
+	reach := func() (bool, error) {
+		stdout, err := e.exec(ctx, probe, probeScript("wget -q -T 2 -O /dev/null", serverTarget))
+		if err != nil {
+			return false, err
+		}
+		return parseResults(probe.Spec.NodeName, CheckPodToPod, "wget", serverTarget, stdout)[0].Success, nil
+	}
+	// the traffic must be allowed before the policy is applied, otherwise the
+	// blocking can not be attributed to the policy
+	reachable, err := reach()
+	if err != nil {
+		return nil, err
+	}
+	if !reachable {
+		return nil, fmt.Errorf("client pod can not reach server pod %s before applying NetworkPolicy", server.Status.PodIP)
+	}
+
+	_, err = client.NetworkingV1().NetworkPolicies(PolicyNamespace).Create(ctx, &networkingv1.NetworkPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: policyServerName, Namespace: PolicyNamespace},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{MatchLabels: map[string]string{"app": policyServerName}},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, err
+	}
+	var execErr error
+	err = wait.PollImmediate(2*time.Second, DefaultPolicyTimeout, func() (bool, error) {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		reachable, execErr = reach()
+		return execErr == nil && !reachable, nil
+	})
+	if err == nil {
+		return &PolicyResult{Enforced: true}, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if execErr != nil {
+		return nil, fmt.Errorf("probe server pod after applying NetworkPolicy error: %w", execErr)
+	}
+	return &PolicyResult{
+		Message: fmt.Sprintf("traffic denied by NetworkPolicy is not blocked in %s, the CNI may not support NetworkPolicy", DefaultPolicyTimeout),
+	}, nil
+}
+
+func deployPolicyProbes(ctx context.Context, client kubernetes.Interface, option Option) error {
+	_, err := client.CoreV1().Namespaces().Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: PolicyNamespace},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	tolerations := []corev1.Toleration{{Operator: corev1.TolerationOpExists}}
+	var gracePeriod int64
+	pods := []*corev1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: policyServerName, Namespace: PolicyNamespace, Labels: map[string]string{"app": policyServerName}},
+			Spec: corev1.PodSpec{
+				Tolerations:                   tolerations,
+				TerminationGracePeriodSeconds: &gracePeriod,
+				Containers: []corev1.Container{{
+					Name:    "probe",
+					Image:   option.Image,
+					Command: []string{"sh", "-c", fmt.Sprintf("echo ok > /tmp/index.html && exec httpd -f -p %d -h /tmp", port)},
+					Ports:   []corev1.ContainerPort{{ContainerPort: port}},
+					ReadinessProbe: &corev1.Probe{
+						Handler: corev1.Handler{
+							TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt(port)},
+						},
+						PeriodSeconds: 2,
+					},
+				}},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: policyClientName, Namespace: PolicyNamespace, Labels: map[string]string{"app": policyClientName}},
+			Spec: corev1.PodSpec{
+				Tolerations:                   tolerations,
+				TerminationGracePeriodSeconds: &gracePeriod,
+				Containers: []corev1.Container{{
+					Name:    "probe",
+					Image:   option.Image,
+					Command: []string{"sh", "-c", "exec sleep 86400"},
+				}},
+			},
+		},
+	}
+	for _, pod := range pods {
+		_, err := client.CoreV1().Pods(PolicyNamespace).Create(ctx, pod, metav1.CreateOptions{})
+		if err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func readyPod(ctx context.Context, client kubernetes.Interface, name string) (*corev1.Pod, error) {
+	pod, err := client.CoreV1().Pods(PolicyNamespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		return nil, fmt.Errorf("pod %s is %s", name, pod.Status.Phase)
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return pod, nil
+		}
+	}
+	return nil, fmt.Errorf("pod %s is not ready", name)
+}
@@ -0,0 +1,141 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package sosreport collects the logs, network state and key config files of
+// a node over SSH for debugging, which works even if the node never joined the
+// cluster and has no in-cluster agent.
+package sosreport
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/endpoints/handlers/responsewriters"
+	"tkestack.io/tke/pkg/util/log"
+	"tkestack.io/tke/pkg/util/ssh"
+)
+
+const (
+	// DefaultSince is the default time range of the collected journals.
+	DefaultSince = 24 * time.Hour
+	// MaxSince is the max time range of the collected journals.
+	MaxSince = 7 * 24 * time.Hour
+)
+
+// collectScript collects the report into a temporary directory and prints the
+// path of the tarball. Every step is allowed to fail, so that a broken node
+// still produces a report with whatever is available.
+const collectScript = `
+set -u
+dir=$(mktemp -d /tmp/tke-sosreport.XXXXXX)
+name=sosreport-$(hostname)-$(date +%%Y%%m%%d%%H%%M%%S)
+out=$dir/$name
+mkdir -p $out/journal $out/network $out/system $out/config
+
+for unit in kubelet containerd docker; do
+    journalctl -u $unit --no-pager --since "-%ds" > $out/journal/$unit.log 2>&1
+done
+dmesg -T > $out/system/dmesg.log 2>&1 || dmesg > $out/system/dmesg.log 2>&1
+
+uname -a > $out/system/uname 2>&1
+cat /etc/os-release > $out/system/os-release 2>&1
+uptime > $out/system/uptime 2>&1
+df -h > $out/system/df 2>&1
+free -m > $out/system/free 2>&1
+ps auxww > $out/system/ps 2>&1
+systemctl status kubelet containerd docker --no-pager > $out/system/systemctl-status 2>&1
+systemctl list-units --failed --no-pager > $out/system/systemctl-failed 2>&1
+
+ip addr > $out/network/ip-addr 2>&1
+ip route > $out/network/ip-route 2>&1
+ip rule > $out/network/ip-rule 2>&1
+ip neigh > $out/network/ip-neigh 2>&1
+iptables-save > $out/network/iptables-save 2>&1
+ipvsadm -Ln > $out/network/ipvsadm 2>&1
+ss -tunlp > $out/network/ss 2>&1
+cp /etc/resolv.conf /etc/hosts $out/network/ 2>/dev/null
+
+for f in /etc/kubernetes /var/lib/kubelet/config.yaml /var/lib/kubelet/kubeadm-flags.env \
+    /etc/sysconfig/kubelet /etc/systemd/system/kubelet.service.d /etc/docker/daemon.json \
+    /etc/containerd/config.toml /etc/sysctl.d /etc/modules-load.d; do
+    [ -e $f ] && cp -r --parents $f $out/config/ 2>/dev/null
+done
+# never carry the private keys and credentials out of the node
+find $out/config \( -name '*.key' -o -name '*token*' -o -name '*encryption*' -o -name '*.conf' -path '*/kubernetes/*' \) -type f -delete 2>/dev/null
+
+tar -czf $dir/$name.tar.gz -C $dir $name >/dev/null 2>&1 || exit 1
+rm -rf $out
+echo $dir/$name.tar.gz
+`
+
+// Collect collects the report of the node which s connects to, journals are
+// collected since the duration before now. It returns the gzipped tarball.
+func Collect(s ssh.Interface, since time.Duration) ([]byte, error) {
+	stdout, stderr, exit, err := s.Execf(collectScript, int64(since.Seconds()))
+	if err != nil || exit != 0 {
+		return nil, fmt.Errorf("exec %q failed:exit %d:stderr %s:error %s", "sosreport", exit, stderr, err)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	tarball := strings.TrimSpace(lines[len(lines)-1])
+	if !strings.HasPrefix(tarball, "/tmp/tke-sosreport.") {
+		return nil, fmt.Errorf("unexpected sosreport output %q", stdout)
+	}
+	defer func() {
+		if _, err := s.CombinedOutput(fmt.Sprintf("rm -rf %s", tarball[:strings.LastIndex(tarball, "/")])); err != nil {
+			log.Warn("Clean sosreport failed", log.String("tarball", tarball), log.Err(err))
+		}
+	}()
+
+	return s.ReadFile(tarball)
+}
+
+// Handler serves the report of a node as a tarball download, the time range
+// of the journals is specified by the query parameter since, such as 2h.
+type Handler struct {
+	// Name is the name of node used in the file name of the tarball.
+	Name string
+	SSH  ssh.Interface
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	since := DefaultSince
+	if v := req.URL.Query().Get("since"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 || d > MaxSince {
+			responsewriters.WriteRawJSON(http.StatusBadRequest,
+				errors.NewBadRequest(fmt.Sprintf("since must be a positive duration no more than %s", MaxSince)), w)
+			return
+		}
+		since = d
+	}
+
+	data, err := Collect(h.SSH, since)
+	if err != nil {
+		responsewriters.WriteRawJSON(http.StatusInternalServerError, errors.NewInternalError(err), w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition",
+		fmt.Sprintf("attachment; filename=sosreport-%s-%s.tar.gz", h.Name, time.Now().Format("20060102150405")))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
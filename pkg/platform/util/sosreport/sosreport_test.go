@@ -0,0 +1,164 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sosreport
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+const tarball = "/tmp/tke-sosreport.abc123/sosreport-node-20261016000000.tar.gz"
+
+// fakeSSH answers the collect script with stdout and serves the files.
+type fakeSSH struct {
+	stdout   string
+	exit     int
+	files    map[string][]byte
+	commands []string
+}
+
+func (f *fakeSSH) Ping() error                               { return nil }
+func (f *fakeSSH) CopyFile(src, dst string) error            { return nil }
+func (f *fakeSSH) LookPath(file string) (string, error)      { return file, nil }
+func (f *fakeSSH) WriteFile(src io.Reader, dst string) error { return nil }
+
+func (f *fakeSSH) CombinedOutput(cmd string) ([]byte, error) {
+	f.commands = append(f.commands, cmd)
+	return nil, nil
+}
+
+func (f *fakeSSH) Exec(cmd string) (string, string, int, error) {
+	f.commands = append(f.commands, cmd)
+	return f.stdout, "", f.exit, nil
+}
+
+func (f *fakeSSH) Execf(format string, a ...interface{}) (string, string, int, error) {
+	return f.Exec(fmt.Sprintf(format, a...))
+}
+
+func (f *fakeSSH) ReadFile(filename string) ([]byte, error) {
+	data, ok := f.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("%s not found", filename)
+	}
+	return data, nil
+}
+
+func (f *fakeSSH) OpenFile(filename string) (io.ReadCloser, error) {
+	return nil, fmt.Errorf("%s not found", filename)
+}
+
+func (f *fakeSSH) Exist(filename string) (bool, error) {
+	_, ok := f.files[filename]
+	return ok, nil
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name      string
+		s         *fakeSSH
+		want      string
+		wantErr   bool
+		wantClean bool
+	}{
+		{
+			name:      "collected",
+			s:         &fakeSSH{stdout: "tar: removing leading '/'\n" + tarball + "\n", files: map[string][]byte{tarball: []byte("report")}},
+			want:      "report",
+			wantClean: true,
+		},
+		{
+			name:    "script failed",
+			s:       &fakeSSH{exit: 1},
+			wantErr: true,
+		},
+		{
+			name:    "unexpected output",
+			s:       &fakeSSH{stdout: "/etc/passwd\n"},
+			wantErr: true,
+		},
+		{
+			name:      "tarball not found",
+			s:         &fakeSSH{stdout: tarball},
+			wantErr:   true,
+			wantClean: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Collect(tt.s, 2*time.Hour)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Collect() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(data) != tt.want {
+				t.Errorf("Collect() = %q, want %q", data, tt.want)
+			}
+			if !strings.Contains(tt.s.commands[0], `--since "-7200s"`) {
+				t.Errorf("Collect() script doesn't collect journals of 2h: %s", tt.s.commands[0])
+			}
+			clean := tt.s.commands[len(tt.s.commands)-1] == "rm -rf /tmp/tke-sosreport.abc123"
+			if clean != tt.wantClean {
+				t.Errorf("Collect() cleaned = %v, want %v, commands %v", clean, tt.wantClean, tt.s.commands[1:])
+			}
+		})
+	}
+}
+
+func TestHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		s          *fakeSSH
+		wantStatus int
+	}{
+		{"default since", "", &fakeSSH{stdout: tarball, files: map[string][]byte{tarball: []byte("report")}}, http.StatusOK},
+		{"since", "?since=30m", &fakeSSH{stdout: tarball, files: map[string][]byte{tarball: []byte("report")}}, http.StatusOK},
+		{"invalid since", "?since=1d", &fakeSSH{}, http.StatusBadRequest},
+		{"negative since", "?since=-1h", &fakeSSH{}, http.StatusBadRequest},
+		{"since too long", "?since=200h", &fakeSSH{}, http.StatusBadRequest},
+		{"collect failed", "", &fakeSSH{exit: 1}, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := &Handler{Name: "10.0.0.1", SSH: tt.s}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/sosreport"+tt.query, nil))
+			if w.Code != tt.wantStatus {
+				t.Fatalf("ServeHTTP() status = %d, want %d, body %s", w.Code, tt.wantStatus, w.Body.String())
+			}
+			if w.Code != http.StatusOK {
+				if w.Code == http.StatusBadRequest && len(tt.s.commands) != 0 {
+					t.Errorf("ServeHTTP() collected with invalid since")
+				}
+				return
+			}
+			if w.Body.String() != "report" {
+				t.Errorf("ServeHTTP() body = %q", w.Body.String())
+			}
+			if disposition := w.Header().Get("Content-Disposition"); !strings.HasPrefix(disposition, "attachment; filename=sosreport-10.0.0.1-") {
+				t.Errorf("ServeHTTP() Content-Disposition = %s", disposition)
+			}
+		})
+	}
+}
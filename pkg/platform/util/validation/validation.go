@@ -24,12 +24,15 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"tkestack.io/tke/api/business"
 	businessinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/business/internalversion"
 	platforminternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/platform/internalversion"
 	platformversionedclient "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	"tkestack.io/tke/api/platform"
 	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/pkg/platform/util/compatibility"
 )
 
 type ClusterGetter interface {
@@ -103,3 +106,54 @@ func ValidateClusterVersioned(ctx context.Context, getter ClusterGetter, cluster
 	}
 	return allErrs
 }
+
+// ValidateAddonCompatibility validates the addon version works with the
+// kubernetes version of cluster.
+func ValidateAddonCompatibility(ctx context.Context, platformClient platforminternalclient.PlatformInterface, clusterName string, addon string, version string) field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := field.NewPath("spec", "version")
+	cluster, err := platformClient.Clusters().Get(ctx, clusterName, metav1.GetOptions{})
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "clusterName"), clusterName, fmt.Sprintf("can't get cluster:%s", err)))
+		return allErrs
+	}
+	if err := compatibility.Check(addon, version, cluster.Spec.Version); err != nil {
+		msg := err.Error()
+		if latest, ok, _ := compatibility.LatestCompatible(addon, cluster.Spec.Version); ok {
+			msg = fmt.Sprintf("%s, version %s works with the cluster", msg, latest)
+		}
+		allErrs = append(allErrs, field.Invalid(fldPath, version, msg))
+	}
+	return allErrs
+}
+
+var supportedCRDConflictPolicies = sets.NewString(
+	string(platform.CRDConflictFail),
+	string(platform.CRDConflictAdopt),
+	string(platform.CRDConflictOverwrite),
+)
+
+// ValidateCRDConflictPolicy validates the policy used when the CRDs of an
+// addon already exist in the cluster. An empty policy means Fail.
+func ValidateCRDConflictPolicy(policy platform.CRDConflictPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(policy) != 0 && !supportedCRDConflictPolicies.Has(string(policy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, supportedCRDConflictPolicies.List()))
+	}
+	return allErrs
+}
+
+var supportedAddonDriftPolicies = sets.NewString(
+	string(platform.AddonDriftRepair),
+	string(platform.AddonDriftReport),
+)
+
+// ValidateAddonDriftPolicy validates the policy used when the components of
+// an addon drift from the desired state. An empty policy means Repair.
+func ValidateAddonDriftPolicy(policy platform.AddonDriftPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(policy) != 0 && !supportedAddonDriftPolicies.Has(string(policy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, supportedAddonDriftPolicies.List()))
+	}
+	return allErrs
+}
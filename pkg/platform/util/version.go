@@ -21,11 +21,111 @@ package util
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"tkestack.io/tke/pkg/util/apiclient"
 )
 
+const (
+	// VersionCatalogName is the name of configmap in kube-public namespace of
+	// global cluster which holds the catalog of kubernetes versions.
+	VersionCatalogName = "k8s-version-catalog"
+	// VersionCatalogKey is the key of the version catalog in configmap data.
+	VersionCatalogKey = "versions"
+)
+
+// VersionCatalog describes the kubernetes versions which can be installed or
+// upgraded to, so new versions can be rolled out without rebuilding
+// tke-platform.
+type VersionCatalog struct {
+	Versions []CatalogVersion `json:"versions"`
+}
+
+// CatalogVersion describes a kubernetes version in the catalog.
+type CatalogVersion struct {
+	Version string `json:"version"`
+	// Deprecated versions can't be used to create clusters or upgraded to.
+	Deprecated bool `json:"deprecated,omitempty"`
+	// UpgradableFrom is the semver constraint of the versions which can be
+	// upgraded to this version, e.g. ">= 1.19.0". Empty means any version.
+	UpgradableFrom string `json:"upgradableFrom,omitempty"`
+	// Images is the digests of required images keyed by name:tag.
+	Images map[string]string `json:"images,omitempty"`
+	// PackageDigests is the sha256 digests of kubernetes-node package keyed by
+	// arch, e.g. amd64.
+	PackageDigests map[string]string `json:"packageDigests,omitempty"`
+}
+
+// Get returns the version in catalog.
+func (c *VersionCatalog) Get(version string) (*CatalogVersion, bool) {
+	for i := range c.Versions {
+		if c.Versions[i].Version == version {
+			return &c.Versions[i], true
+		}
+	}
+	return nil, false
+}
+
+// Installable returns the versions which can be used to create clusters.
+func (c *VersionCatalog) Installable() []string {
+	versions := []string{}
+	for _, v := range c.Versions {
+		if !v.Deprecated {
+			versions = append(versions, v.Version)
+		}
+	}
+	return versions
+}
+
+// Upgradable returns the versions which can be upgraded to from the version.
+func (c *VersionCatalog) Upgradable(from string) ([]string, error) {
+	versions := []string{}
+	for _, v := range c.Versions {
+		if v.Deprecated || v.Version == from {
+			continue
+		}
+		if v.UpgradableFrom != "" {
+			ok, err := apiclient.CheckVersion(from, v.UpgradableFrom)
+			if err != nil {
+				return nil, fmt.Errorf("check version %s upgradable from %s error: %w", v.Version, from, err)
+			}
+			if !ok {
+				continue
+			}
+		}
+		versions = append(versions, v.Version)
+	}
+	return versions, nil
+}
+
+// GetVersionCatalog returns the version catalog of platform. If the catalog
+// doesn't exist, it's built from the valid versions in cluster-info.
+func GetVersionCatalog(ctx context.Context, client kubernetes.Interface) (*VersionCatalog, error) {
+	catalog := &VersionCatalog{}
+	cm, err := client.CoreV1().ConfigMaps("kube-public").Get(ctx, VersionCatalogName, metav1.GetOptions{})
+	if err == nil {
+		if err := json.Unmarshal([]byte(cm.Data[VersionCatalogKey]), &catalog.Versions); err != nil {
+			return nil, fmt.Errorf("invalid version catalog: %w", err)
+		}
+		return catalog, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	_, k8sValidVersions, err := GetPlatformVersionsFromClusterInfo(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, version := range k8sValidVersions {
+		catalog.Versions = append(catalog.Versions, CatalogVersion{Version: version})
+	}
+	return catalog, nil
+}
+
 func GetPlatformVersionsFromClusterInfo(ctx context.Context, client kubernetes.Interface) (tkeVersion string, k8sValidVersions []string, err error) {
 	k8sValidVersions = []string{}
 	clusterInfo, err := client.CoreV1().ConfigMaps("kube-public").Get(ctx, "cluster-info", metav1.GetOptions{})
@@ -0,0 +1,135 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package util
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func testVersionCatalog() *VersionCatalog {
+	return &VersionCatalog{Versions: []CatalogVersion{
+		{Version: "1.18.3", Deprecated: true},
+		{Version: "1.19.7"},
+		{Version: "1.20.4", UpgradableFrom: ">= 1.19.0"},
+		{Version: "1.21.1", UpgradableFrom: ">= 1.20.0, < 1.21.0"},
+	}}
+}
+
+func TestVersionCatalogInstallable(t *testing.T) {
+	catalog := testVersionCatalog()
+	want := []string{"1.19.7", "1.20.4", "1.21.1"}
+	if got := catalog.Installable(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Installable() = %v, want %v", got, want)
+	}
+	if got := (&VersionCatalog{}).Installable(); got == nil || len(got) != 0 {
+		t.Errorf("Installable() of empty catalog = %#v, want empty", got)
+	}
+
+	v, ok := catalog.Get("1.20.4")
+	if !ok || v.UpgradableFrom != ">= 1.19.0" {
+		t.Errorf("Get() = %v, %v", v, ok)
+	}
+	if _, ok := catalog.Get("1.22.0"); ok {
+		t.Errorf("Get() found version not in catalog")
+	}
+}
+
+func TestVersionCatalogUpgradable(t *testing.T) {
+	tests := []struct {
+		from    string
+		want    []string
+		wantErr bool
+	}{
+		{"1.18.3", []string{"1.19.7"}, false},
+		{"1.19.7", []string{"1.20.4"}, false},
+		{"1.20.4", []string{"1.19.7", "1.21.1"}, false},
+		{"1.21.1", []string{"1.19.7", "1.20.4"}, false},
+		{"latest", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from, func(t *testing.T) {
+			got, err := testVersionCatalog().Upgradable(tt.from)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Upgradable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Upgradable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetVersionCatalog(t *testing.T) {
+	configMap := func(name string, data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "kube-public"},
+			Data:       data,
+		}
+	}
+	clusterInfo := configMap("cluster-info", map[string]string{
+		"tkeVersion":       "v1.6.0",
+		"k8sValidVersions": `["1.20.4","1.19.7"]`,
+	})
+	tests := []struct {
+		name    string
+		objects []runtime.Object
+		want    []CatalogVersion
+		wantErr bool
+	}{
+		{
+			name: "catalog",
+			objects: []runtime.Object{clusterInfo, configMap(VersionCatalogName, map[string]string{
+				VersionCatalogKey: `[{"version":"1.21.1","upgradableFrom":">= 1.20.0","packageDigests":{"amd64":"sha256:abc"}}]`,
+			})},
+			want: []CatalogVersion{{Version: "1.21.1", UpgradableFrom: ">= 1.20.0", PackageDigests: map[string]string{"amd64": "sha256:abc"}}},
+		},
+		{
+			name:    "invalid catalog",
+			objects: []runtime.Object{configMap(VersionCatalogName, map[string]string{VersionCatalogKey: "1.21.1"})},
+			wantErr: true,
+		},
+		{
+			name:    "cluster info",
+			objects: []runtime.Object{clusterInfo},
+			want:    []CatalogVersion{{Version: "1.20.4"}, {Version: "1.19.7"}},
+		},
+		{
+			name:    "neither",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog, err := GetVersionCatalog(context.Background(), fake.NewSimpleClientset(tt.objects...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetVersionCatalog() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(catalog.Versions, tt.want) {
+				t.Errorf("GetVersionCatalog() = %+v, want %+v", catalog.Versions, tt.want)
+			}
+		})
+	}
+}
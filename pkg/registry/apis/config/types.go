@@ -129,6 +129,29 @@ type Security struct {
 	AdminPassword     string
 	// +optional
 	EnableAnonymous *bool
+	// TokenRateLimit throttles the token service and bans the clients and accounts
+	// failing to log in repeatedly, it is disabled if not set.
+	// +optional
+	TokenRateLimit *TokenRateLimit
+}
+
+// TokenRateLimit configures the rate limits and temporary bans of the token service.
+type TokenRateLimit struct {
+	// RequestsPerSecondPerIP is the rate of token requests allowed per client IP.
+	RequestsPerSecondPerIP int32
+	// BurstPerIP is the burst of token requests allowed per client IP.
+	BurstPerIP int32
+	// MaxFailures is the number of failed logins in FailureWindowSeconds after
+	// which the client IP or account is banned.
+	MaxFailures int32
+	// FailureWindowSeconds is the window of counting failed logins.
+	FailureWindowSeconds int64
+	// BanSeconds is the duration of a ban.
+	BanSeconds int64
+	// TrustedProxies is the CIDRs of the proxies whose X-Forwarded-For and
+	// X-Real-Ip headers are trusted to identify the client IP. The remote
+	// address of the connection is used if not set.
+	TrustedProxies []string
 }
 
 // Redis configures the redis pool available to the registry cache.
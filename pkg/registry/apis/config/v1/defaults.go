@@ -31,4 +31,21 @@ func SetDefaults_RegistryConfiguration(obj *RegistryConfiguration) {
 	if obj.Security.TokenExpiredHours == nil {
 		obj.Security.TokenExpiredHours = utilpointer.Int64Ptr(30 * 24)
 	}
+	if rl := obj.Security.TokenRateLimit; rl != nil {
+		if rl.RequestsPerSecondPerIP == 0 {
+			rl.RequestsPerSecondPerIP = 10
+		}
+		if rl.BurstPerIP == 0 {
+			rl.BurstPerIP = 20
+		}
+		if rl.MaxFailures == 0 {
+			rl.MaxFailures = 10
+		}
+		if rl.FailureWindowSeconds == 0 {
+			rl.FailureWindowSeconds = 300
+		}
+		if rl.BanSeconds == 0 {
+			rl.BanSeconds = 900
+		}
+	}
 }
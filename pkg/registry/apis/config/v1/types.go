@@ -130,6 +130,30 @@ type Security struct {
 	AdminPassword     string `json:"adminPassword" yaml:"adminPassword"`
 	// +optional
 	EnableAnonymous *bool `json:"enableAnonymous" yaml:"enableAnonymous"`
+	// TokenRateLimit throttles the token service and bans the clients and accounts
+	// failing to log in repeatedly, it is disabled if not set.
+	// +optional
+	TokenRateLimit *TokenRateLimit `json:"tokenRateLimit,omitempty" yaml:"tokenRateLimit,omitempty"`
+}
+
+// TokenRateLimit configures the rate limits and temporary bans of the token service.
+type TokenRateLimit struct {
+	// RequestsPerSecondPerIP is the rate of token requests allowed per client IP.
+	RequestsPerSecondPerIP int32 `json:"requestsPerSecondPerIP,omitempty" yaml:"requestsPerSecondPerIP,omitempty"`
+	// BurstPerIP is the burst of token requests allowed per client IP.
+	BurstPerIP int32 `json:"burstPerIP,omitempty" yaml:"burstPerIP,omitempty"`
+	// MaxFailures is the number of failed logins in FailureWindowSeconds after
+	// which the client IP or account is banned.
+	MaxFailures int32 `json:"maxFailures,omitempty" yaml:"maxFailures,omitempty"`
+	// FailureWindowSeconds is the window of counting failed logins.
+	FailureWindowSeconds int64 `json:"failureWindowSeconds,omitempty" yaml:"failureWindowSeconds,omitempty"`
+	// BanSeconds is the duration of a ban.
+	BanSeconds int64 `json:"banSeconds,omitempty" yaml:"banSeconds,omitempty"`
+	// TrustedProxies is the CIDRs of the proxies whose X-Forwarded-For and
+	// X-Real-Ip headers are trusted to identify the client IP. The remote
+	// address of the connection is used if not set.
+	// +optional
+	TrustedProxies []string `json:"trustedProxies,omitempty" yaml:"trustedProxies,omitempty"`
 }
 
 // Redis configures the redis pool available to the registry cache.
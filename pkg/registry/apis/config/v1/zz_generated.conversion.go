@@ -127,6 +127,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*TokenRateLimit)(nil), (*config.TokenRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_TokenRateLimit_To_config_TokenRateLimit(a.(*TokenRateLimit), b.(*config.TokenRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TokenRateLimit)(nil), (*TokenRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TokenRateLimit_To_v1_TokenRateLimit(a.(*config.TokenRateLimit), b.(*TokenRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -356,6 +366,7 @@ func autoConvert_v1_Security_To_config_Security(in *Security, out *config.Securi
 	out.AdminUsername = in.AdminUsername
 	out.AdminPassword = in.AdminPassword
 	out.EnableAnonymous = (*bool)(unsafe.Pointer(in.EnableAnonymous))
+	out.TokenRateLimit = (*config.TokenRateLimit)(unsafe.Pointer(in.TokenRateLimit))
 	return nil
 }
 
@@ -372,6 +383,7 @@ func autoConvert_config_Security_To_v1_Security(in *config.Security, out *Securi
 	out.AdminUsername = in.AdminUsername
 	out.AdminPassword = in.AdminPassword
 	out.EnableAnonymous = (*bool)(unsafe.Pointer(in.EnableAnonymous))
+	out.TokenRateLimit = (*TokenRateLimit)(unsafe.Pointer(in.TokenRateLimit))
 	return nil
 }
 
@@ -407,3 +419,33 @@ func autoConvert_config_Storage_To_v1_Storage(in *config.Storage, out *Storage,
 func Convert_config_Storage_To_v1_Storage(in *config.Storage, out *Storage, s conversion.Scope) error {
 	return autoConvert_config_Storage_To_v1_Storage(in, out, s)
 }
+
+func autoConvert_v1_TokenRateLimit_To_config_TokenRateLimit(in *TokenRateLimit, out *config.TokenRateLimit, s conversion.Scope) error {
+	out.RequestsPerSecondPerIP = in.RequestsPerSecondPerIP
+	out.BurstPerIP = in.BurstPerIP
+	out.MaxFailures = in.MaxFailures
+	out.FailureWindowSeconds = in.FailureWindowSeconds
+	out.BanSeconds = in.BanSeconds
+	out.TrustedProxies = *(*[]string)(unsafe.Pointer(&in.TrustedProxies))
+	return nil
+}
+
+// Convert_v1_TokenRateLimit_To_config_TokenRateLimit is an autogenerated conversion function.
+func Convert_v1_TokenRateLimit_To_config_TokenRateLimit(in *TokenRateLimit, out *config.TokenRateLimit, s conversion.Scope) error {
+	return autoConvert_v1_TokenRateLimit_To_config_TokenRateLimit(in, out, s)
+}
+
+func autoConvert_config_TokenRateLimit_To_v1_TokenRateLimit(in *config.TokenRateLimit, out *TokenRateLimit, s conversion.Scope) error {
+	out.RequestsPerSecondPerIP = in.RequestsPerSecondPerIP
+	out.BurstPerIP = in.BurstPerIP
+	out.MaxFailures = in.MaxFailures
+	out.FailureWindowSeconds = in.FailureWindowSeconds
+	out.BanSeconds = in.BanSeconds
+	out.TrustedProxies = *(*[]string)(unsafe.Pointer(&in.TrustedProxies))
+	return nil
+}
+
+// Convert_config_TokenRateLimit_To_v1_TokenRateLimit is an autogenerated conversion function.
+func Convert_config_TokenRateLimit_To_v1_TokenRateLimit(in *config.TokenRateLimit, out *TokenRateLimit, s conversion.Scope) error {
+	return autoConvert_config_TokenRateLimit_To_v1_TokenRateLimit(in, out, s)
+}
@@ -287,6 +287,11 @@ func (in *Security) DeepCopyInto(out *Security) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.TokenRateLimit != nil {
+		in, out := &in.TokenRateLimit, &out.TokenRateLimit
+		*out = new(TokenRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -340,3 +345,24 @@ func (in *Storage) DeepCopy() *Storage {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenRateLimit) DeepCopyInto(out *TokenRateLimit) {
+	*out = *in
+	if in.TrustedProxies != nil {
+		in, out := &in.TrustedProxies, &out.TrustedProxies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenRateLimit.
+func (in *TokenRateLimit) DeepCopy() *TokenRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
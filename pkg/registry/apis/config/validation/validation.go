@@ -19,6 +19,8 @@
 package validation
 
 import (
+	"net"
+
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
@@ -95,6 +97,15 @@ func ValidateRegistryConfiguration(rc *registryconfig.RegistryConfiguration) err
 		}
 	}
 
+	if rc.Security.TokenRateLimit != nil {
+		proxiesFld := securityFld.Child("tokenRateLimit", "trustedProxies")
+		for i, cidr := range rc.Security.TokenRateLimit.TrustedProxies {
+			if _, _, err := net.ParseCIDR(cidr); err != nil {
+				allErrors = append(allErrors, field.Invalid(proxiesFld.Index(i), cidr, err.Error()))
+			}
+		}
+	}
+
 	if rc.DefaultTenant == "" {
 		allErrors = append(allErrors, field.Required(field.NewPath("defaultTenant"), "must be specify"))
 	}
@@ -37,6 +37,9 @@ func TestValidateRegistryConfiguration(t *testing.T) {
 			TokenPublicKeyFile:  "fake_public_key.pem",
 			AdminUsername:       "fake_username",
 			AdminPassword:       "fake_password",
+			TokenRateLimit: &registryconfig.TokenRateLimit{
+				TrustedProxies: []string{"10.0.0.0/8", "fd00::/8"},
+			},
 		},
 		DefaultTenant: "default",
 	}
@@ -53,9 +56,14 @@ func TestValidateRegistryConfiguration(t *testing.T) {
 				Bucket: "",
 			},
 		},
+		Security: registryconfig.Security{
+			TokenRateLimit: &registryconfig.TokenRateLimit{
+				TrustedProxies: []string{"10.0.0.1"},
+			},
+		},
 		Redis: &registryconfig.Redis{},
 	}
-	const numErrs = 9
+	const numErrs = 10
 	if allErrors := ValidateRegistryConfiguration(errorCase); len(allErrors.(utilerrors.Aggregate).Errors()) != numErrs {
 		t.Errorf("expect %d errors, got %v", numErrs, len(allErrors.(utilerrors.Aggregate).Errors()))
 	}
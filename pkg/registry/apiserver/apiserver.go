@@ -116,6 +116,7 @@ func (c completedConfig) New(delegationTarget genericapiserver.DelegationTarget)
 			OIDCCAFile:           c.ExtraConfig.OIDCCAFile,
 			OIDCTokenReviewPath:  c.ExtraConfig.OIDCTokenReviewPath,
 			OIDCIssuerURL:        c.ExtraConfig.OIDCIssuerURL,
+			AuditBackend:         c.GenericConfig.AuditBackend,
 		}
 		if err := distribution.RegisterRoute(s.Handler.NonGoRestfulMux, distributionOpts); err != nil {
 			return nil, err
@@ -22,11 +22,18 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/docker/distribution/registry/auth/token"
 	"github.com/docker/libtrust"
 	jsoniter "github.com/json-iterator/go"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	auditinternal "k8s.io/apiserver/pkg/apis/audit"
+	"k8s.io/apiserver/pkg/audit"
 	"k8s.io/apiserver/pkg/authentication/authenticator"
 	restclient "k8s.io/client-go/rest"
 	registryinternalclient "tkestack.io/tke/api/client/clientset/internalversion/typed/registry/internalversion"
@@ -52,6 +59,9 @@ type Options struct {
 	DomainSuffix    string
 	DefaultTenant   string
 	LoopbackConfig  *restclient.Config
+	// AuditBackend receives the audit events of the bans issued by the token
+	// service, no events are sent if it is nil.
+	AuditBackend audit.Backend
 }
 
 type handler struct {
@@ -61,6 +71,8 @@ type handler struct {
 	domainSuffix  string
 	defaultTenant string
 	authenticator authenticator.Request
+	rateLimiter   *rateLimiter
+	auditBackend  audit.Backend
 }
 
 // NewHandler creates a new handler object and returns it.
@@ -108,6 +120,8 @@ func NewHandler(opts *Options) (http.Handler, error) {
 		domainSuffix:  opts.DomainSuffix,
 		defaultTenant: opts.DefaultTenant,
 		authenticator: at,
+		rateLimiter:   newRateLimiter(opts.SecurityConfig.TokenRateLimit),
+		auditBackend:  opts.AuditBackend,
 	}, nil
 }
 
@@ -115,12 +129,39 @@ func (h *handler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	scopes := parseScopes(req.URL)
 	log.Debug("Received docker registry authentication request", log.Strings("scopes", scopes))
 
+	received := time.Now()
+	account, _, hasCredential := req.BasicAuth()
+	var clientIP string
+	if h.rateLimiter != nil {
+		clientIP = h.rateLimiter.clientIP(req)
+		if ok, reason, retryAfter := h.rateLimiter.allow(clientIP, account); !ok {
+			log.Warn("Rejected docker registry authentication request",
+				log.String("clientIP", clientIP),
+				log.String("account", account),
+				log.String("reason", reason))
+			w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+	}
+
 	var username, userTenantID string
 	user, authenticated := authenticationutil.RequestUser(req, h.authenticator)
 	if user != nil {
 		username = user.GetName()
 		userTenantID = user.TenantID()
 	}
+	if h.rateLimiter != nil && hasCredential {
+		if authenticated {
+			h.rateLimiter.recordSuccess(account)
+		} else if bans := h.rateLimiter.recordFailure(clientIP, account); len(bans) > 0 {
+			log.Warn("Temporarily banned docker registry authentication after repeated failed logins",
+				log.String("clientIP", clientIP),
+				log.String("account", account),
+				log.Strings("bans", bans))
+			h.auditBan(req, received, clientIP, account, bans)
+		}
+	}
 	requestTenantID := utilregistryrequest.TenantID(req, h.domainSuffix, h.defaultTenant)
 
 	if len(scopes) == 0 {
@@ -210,6 +251,37 @@ func (h *handler) resourceActions(scopes []string, requestTenantID string) []*to
 	return res
 }
 
+// auditBan sends the audit event of the bans issued by the failed login to
+// the audit backend.
+func (h *handler) auditBan(req *http.Request, received time.Time, clientIP, account string, bans []string) {
+	if h.auditBackend == nil {
+		return
+	}
+	h.auditBackend.ProcessEvents(&auditinternal.Event{
+		Level:      auditinternal.LevelMetadata,
+		AuditID:    uuid.NewUUID(),
+		Stage:      auditinternal.StageResponseComplete,
+		RequestURI: req.URL.RequestURI(),
+		Verb:       strings.ToLower(req.Method),
+		User:       authenticationv1.UserInfo{Username: account},
+		SourceIPs:  []string{clientIP},
+		UserAgent:  req.UserAgent(),
+		ResponseStatus: &metav1.Status{
+			Status:  metav1.StatusFailure,
+			Code:    http.StatusUnauthorized,
+			Reason:  metav1.StatusReasonUnauthorized,
+			Message: "repeated failed logins",
+		},
+		RequestReceivedTimestamp: metav1.NewMicroTime(received),
+		StageTimestamp:           metav1.NewMicroTime(time.Now()),
+		Annotations: map[string]string{
+			"registry.tkestack.io/token-bans":     strings.Join(bans, ","),
+			"registry.tkestack.io/ban-duration":   h.rateLimiter.banDuration.String(),
+			"registry.tkestack.io/failure-window": h.rateLimiter.window.String(),
+		},
+	})
+}
+
 // filterAccess iterate a list of resource actions and try to use the filter that matches the resource type to filter the actions.
 func filterAccess(ctx context.Context, access []*token.ResourceActions, filters map[string]accessFilter, u *userRequest) error {
 	var err error
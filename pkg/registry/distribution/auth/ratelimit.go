@@ -0,0 +1,364 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package auth
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/flowcontrol"
+	"k8s.io/component-base/metrics"
+	"k8s.io/component-base/metrics/legacyregistry"
+	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
+	"tkestack.io/tke/pkg/util/log"
+)
+
+const (
+	rejectReasonRateLimited      = "rate_limited"
+	rejectReasonIPBanned         = "ip_banned"
+	rejectReasonAccountBackedOff = "account_backed_off"
+
+	banTypeIP             = "ip"
+	banTypeAccountBackoff = "account_backoff"
+
+	// initialAccountBackoff is the first delay of the account after too many
+	// failed logins, which doubles for each further failure.
+	initialAccountBackoff = time.Second
+	// maxTrackedKeys bounds the number of the client IPs and accounts tracked
+	// by the rate limiter, the idle ones are evicted when it is exceeded.
+	maxTrackedKeys = 10000
+	// minPruneInterval is the min interval of evicting the idle limiters and
+	// the expired failure records.
+	minPruneInterval = time.Minute
+)
+
+var (
+	tokenRejectedCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_registry_token_rejected_requests",
+			Help:           "Counter of token requests rejected by the rate limiter broken out by reason.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"reason"},
+	)
+
+	tokenFailedLoginCounter = metrics.NewCounter(
+		&metrics.CounterOpts{
+			Name:           "tke_registry_token_failed_logins",
+			Help:           "Counter of token requests carrying invalid credentials.",
+			StabilityLevel: metrics.ALPHA,
+		},
+	)
+
+	tokenBanCounter = metrics.NewCounterVec(
+		&metrics.CounterOpts{
+			Name:           "tke_registry_token_bans",
+			Help:           "Counter of temporary bans and backoffs issued by the token service broken out by type.",
+			StabilityLevel: metrics.ALPHA,
+		},
+		[]string{"type"},
+	)
+)
+
+func init() {
+	legacyregistry.MustRegister(tokenRejectedCounter)
+	legacyregistry.MustRegister(tokenFailedLoginCounter)
+	legacyregistry.MustRegister(tokenBanCounter)
+}
+
+// failureRecord counts the failed logins of a client IP or an account.
+type failureRecord struct {
+	count       int32
+	windowStart time.Time
+	bannedUntil time.Time
+	backoff     time.Duration
+}
+
+type ipLimiter struct {
+	limiter  flowcontrol.RateLimiter
+	lastSeen time.Time
+}
+
+// rateLimiter throttles the token requests per client IP, bans the client IPs
+// failing to log in too many times in a window, and backs off the accounts
+// failing to log in too many times. The accounts are backed off instead of
+// banned, so the clients guessing the password can not lock the owner out.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	qps            float32
+	burst          int
+	maxFailures    int32
+	window         time.Duration
+	banDuration    time.Duration
+	trustedProxies []*net.IPNet
+
+	ipLimiters      map[string]*ipLimiter
+	ipFailures      map[string]*failureRecord
+	accountFailures map[string]*failureRecord
+	lastPrune       time.Time
+
+	now func() time.Time
+}
+
+// newRateLimiter creates the rate limiter by given configuration, returns nil
+// if the configuration is not set.
+func newRateLimiter(cfg *registryconfig.TokenRateLimit) *rateLimiter {
+	if cfg == nil {
+		return nil
+	}
+	r := &rateLimiter{
+		qps:             float32(cfg.RequestsPerSecondPerIP),
+		burst:           int(cfg.BurstPerIP),
+		maxFailures:     cfg.MaxFailures,
+		window:          time.Duration(cfg.FailureWindowSeconds) * time.Second,
+		banDuration:     time.Duration(cfg.BanSeconds) * time.Second,
+		ipLimiters:      make(map[string]*ipLimiter),
+		ipFailures:      make(map[string]*failureRecord),
+		accountFailures: make(map[string]*failureRecord),
+		now:             time.Now,
+	}
+	for _, cidr := range cfg.TrustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Warn("Ignored invalid trusted proxy of docker registry token service", log.String("cidr", cidr), log.Err(err))
+			continue
+		}
+		r.trustedProxies = append(r.trustedProxies, ipNet)
+	}
+	return r
+}
+
+// clientIP returns the IP of the client sending the request. The forwarded
+// headers are only trusted if the request comes from a trusted proxy, the
+// client is the last address in X-Forwarded-For which is not a trusted proxy.
+func (r *rateLimiter) clientIP(req *http.Request) string {
+	remote := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !r.trusted(net.ParseIP(remote)) {
+		return remote
+	}
+
+	if value := req.Header.Get("X-Forwarded-For"); value != "" {
+		hops := strings.Split(value, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break
+			}
+			if !r.trusted(ip) || i == 0 {
+				return ip.String()
+			}
+		}
+	}
+	if ip := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-Ip"))); ip != nil {
+		return ip.String()
+	}
+	return remote
+}
+
+func (r *rateLimiter) trusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range r.trustedProxies {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// allow checks whether the request from the client IP for the account can be
+// served, returns the reject reason and the duration to wait before retrying
+// if it is not allowed.
+func (r *rateLimiter) allow(ip, account string) (bool, string, time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	r.pruneLocked(now)
+
+	if rec, ok := r.ipFailures[ip]; ok && now.Before(rec.bannedUntil) {
+		tokenRejectedCounter.WithLabelValues(rejectReasonIPBanned).Inc()
+		return false, rejectReasonIPBanned, rec.bannedUntil.Sub(now)
+	}
+	if account != "" {
+		if rec, ok := r.accountFailures[account]; ok && now.Before(rec.bannedUntil) {
+			tokenRejectedCounter.WithLabelValues(rejectReasonAccountBackedOff).Inc()
+			return false, rejectReasonAccountBackedOff, rec.bannedUntil.Sub(now)
+		}
+	}
+
+	if r.qps > 0 {
+		l, ok := r.ipLimiters[ip]
+		if !ok {
+			l = &ipLimiter{limiter: flowcontrol.NewTokenBucketRateLimiter(r.qps, r.burst)}
+			r.ipLimiters[ip] = l
+		}
+		l.lastSeen = now
+		if !l.limiter.TryAccept() {
+			tokenRejectedCounter.WithLabelValues(rejectReasonRateLimited).Inc()
+			return false, rejectReasonRateLimited, time.Second
+		}
+	}
+	return true, "", 0
+}
+
+// recordFailure counts a failed login of the client IP and the account, and
+// returns the bans and backoffs issued by this failure.
+func (r *rateLimiter) recordFailure(ip, account string) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tokenFailedLoginCounter.Inc()
+	now := r.now()
+	var bans []string
+	if r.recordIPFailureLocked(ip, now) {
+		bans = append(bans, banTypeIP)
+	}
+	if account != "" && r.recordAccountFailureLocked(account, now) {
+		bans = append(bans, banTypeAccountBackoff)
+	}
+	for _, b := range bans {
+		tokenBanCounter.WithLabelValues(b).Inc()
+	}
+	return bans
+}
+
+// recordSuccess resets the failed logins of the account.
+func (r *rateLimiter) recordSuccess(account string) {
+	if account == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.accountFailures, account)
+}
+
+// recordIPFailureLocked bans the client IP for the ban duration once it fails
+// max failures in the window.
+func (r *rateLimiter) recordIPFailureLocked(ip string, now time.Time) bool {
+	rec := r.failureRecordLocked(r.ipFailures, ip, now)
+	if rec == nil {
+		return false
+	}
+	rec.count++
+	if rec.count >= r.maxFailures {
+		rec.bannedUntil = now.Add(r.banDuration)
+		rec.count = 0
+		rec.windowStart = now
+		return true
+	}
+	return false
+}
+
+// recordAccountFailureLocked backs off the account once it fails max failures
+// in the window, the backoff doubles for each further failure up to the ban
+// duration until the account logs in successfully.
+func (r *rateLimiter) recordAccountFailureLocked(account string, now time.Time) bool {
+	rec := r.failureRecordLocked(r.accountFailures, account, now)
+	if rec == nil {
+		return false
+	}
+	rec.count++
+	if rec.count < r.maxFailures {
+		return false
+	}
+	if rec.backoff == 0 {
+		rec.backoff = initialAccountBackoff
+	} else {
+		rec.backoff *= 2
+	}
+	if rec.backoff > r.banDuration {
+		rec.backoff = r.banDuration
+	}
+	rec.bannedUntil = now.Add(rec.backoff)
+	return true
+}
+
+// failureRecordLocked returns the failure record of key in the window, or nil
+// if the failed logins are not limited.
+func (r *rateLimiter) failureRecordLocked(records map[string]*failureRecord, key string, now time.Time) *failureRecord {
+	if r.maxFailures <= 0 {
+		return nil
+	}
+	rec, ok := records[key]
+	if !ok || (now.Sub(rec.windowStart) > r.window && now.After(rec.bannedUntil)) {
+		if !ok && len(records) >= maxTrackedKeys {
+			r.pruneLocked(now)
+		}
+		rec = &failureRecord{windowStart: now}
+		records[key] = rec
+	}
+	return rec
+}
+
+// pruneLocked evicts the idle limiters and the expired failure records to
+// bound the memory used by the rate limiter. It runs at most once per prune
+// interval unless the tracked keys exceed the limit.
+func (r *rateLimiter) pruneLocked(now time.Time) {
+	full := len(r.ipLimiters) >= maxTrackedKeys || len(r.ipFailures) >= maxTrackedKeys || len(r.accountFailures) >= maxTrackedKeys
+	if !full && now.Sub(r.lastPrune) < minPruneInterval {
+		return
+	}
+	r.lastPrune = now
+
+	// A limiter idle for longer than refilling its bucket is the same as a
+	// new one.
+	idle := minPruneInterval
+	if r.qps > 0 {
+		if refill := time.Duration(float64(r.burst) / float64(r.qps) * float64(time.Second)); refill > idle {
+			idle = refill
+		}
+	}
+	for ip, l := range r.ipLimiters {
+		if now.Sub(l.lastSeen) > idle {
+			delete(r.ipLimiters, ip)
+		}
+	}
+	for ip := range r.ipLimiters {
+		if len(r.ipLimiters) < maxTrackedKeys {
+			break
+		}
+		delete(r.ipLimiters, ip)
+	}
+	for _, records := range []map[string]*failureRecord{r.ipFailures, r.accountFailures} {
+		for key, rec := range records {
+			if now.After(rec.bannedUntil) && now.Sub(rec.windowStart) > r.window {
+				delete(records, key)
+			}
+		}
+		// Only the records not banned are evicted when the limit is still
+		// exceeded, which loses the failures counted in the window at most.
+		for key, rec := range records {
+			if len(records) < maxTrackedKeys {
+				break
+			}
+			if now.After(rec.bannedUntil) {
+				delete(records, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
+)
+
+func TestRateLimiterBan(t *testing.T) {
+	now := time.Now()
+	r := newRateLimiter(&registryconfig.TokenRateLimit{
+		MaxFailures:          3,
+		FailureWindowSeconds: 60,
+		BanSeconds:           120,
+	})
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 2; i++ {
+		if bans := r.recordFailure("10.0.0.1", "alice"); len(bans) != 0 {
+			t.Fatalf("unexpected bans after %d failures: %v", i+1, bans)
+		}
+	}
+	if bans := r.recordFailure("10.0.0.1", "alice"); len(bans) != 2 {
+		t.Fatalf("expected ip ban and account backoff, got %v", bans)
+	}
+
+	if ok, reason, _ := r.allow("10.0.0.1", "bob"); ok || reason != rejectReasonIPBanned {
+		t.Errorf("expected ip to be banned, got %v %q", ok, reason)
+	}
+	if ok, reason, retryAfter := r.allow("10.0.0.2", "alice"); ok || reason != rejectReasonAccountBackedOff || retryAfter != time.Second {
+		t.Errorf("expected account to be backed off for 1s, got %v %q %v", ok, reason, retryAfter)
+	}
+	if ok, _, _ := r.allow("10.0.0.2", "bob"); !ok {
+		t.Errorf("expected unrelated request to be allowed")
+	}
+
+	// The account is not locked out by the failures once the backoff passes.
+	now = now.Add(2 * time.Second)
+	if ok, reason, _ := r.allow("10.0.0.2", "alice"); !ok {
+		t.Errorf("expected account backoff to expire, got %q", reason)
+	}
+	if bans := r.recordFailure("10.0.0.3", "alice"); len(bans) != 1 || bans[0] != banTypeAccountBackoff {
+		t.Fatalf("expected account backoff, got %v", bans)
+	}
+	if _, _, retryAfter := r.allow("10.0.0.3", "alice"); retryAfter != 2*time.Second {
+		t.Errorf("expected account backoff to double, got %v", retryAfter)
+	}
+	r.recordSuccess("alice")
+	if ok, reason, _ := r.allow("10.0.0.3", "alice"); !ok {
+		t.Errorf("expected successful login to reset the backoff, got %q", reason)
+	}
+
+	now = now.Add(121 * time.Second)
+	if ok, reason, _ := r.allow("10.0.0.1", "alice"); !ok {
+		t.Errorf("expected ban to expire, got %q", reason)
+	}
+}
+
+func TestRateLimiterThrottle(t *testing.T) {
+	r := newRateLimiter(&registryconfig.TokenRateLimit{
+		RequestsPerSecondPerIP: 1,
+		BurstPerIP:             2,
+	})
+	for i := 0; i < 2; i++ {
+		if ok, reason, _ := r.allow("10.0.0.1", ""); !ok {
+			t.Fatalf("request %d unexpectedly rejected: %q", i, reason)
+		}
+	}
+	if ok, reason, _ := r.allow("10.0.0.1", ""); ok || reason != rejectReasonRateLimited {
+		t.Errorf("expected request to be throttled, got %v %q", ok, reason)
+	}
+	if ok, _, _ := r.allow("10.0.0.2", ""); !ok {
+		t.Errorf("expected other client to be allowed")
+	}
+}
+
+func TestRateLimiterClientIP(t *testing.T) {
+	r := newRateLimiter(&registryconfig.TokenRateLimit{
+		TrustedProxies: []string{"10.0.0.0/24"},
+	})
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		want       string
+	}{
+		{
+			name:       "untrusted remote ignores forwarded headers",
+			remoteAddr: "192.168.1.1:5000",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4", "X-Real-Ip": "1.2.3.5"},
+			want:       "192.168.1.1",
+		},
+		{
+			name:       "trusted proxy uses the last untrusted hop",
+			remoteAddr: "10.0.0.1:5000",
+			headers:    map[string]string{"X-Forwarded-For": "1.2.3.4, 5.6.7.8, 10.0.0.2"},
+			want:       "5.6.7.8",
+		},
+		{
+			name:       "trusted proxy falls back to real ip",
+			remoteAddr: "10.0.0.1:5000",
+			headers:    map[string]string{"X-Real-Ip": "1.2.3.5"},
+			want:       "1.2.3.5",
+		},
+		{
+			name:       "trusted proxy without headers",
+			remoteAddr: "10.0.0.1:5000",
+			want:       "10.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(http.MethodGet, "/registry/auth", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if got := r.clientIP(req); got != tt.want {
+				t.Errorf("clientIP() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterPrune(t *testing.T) {
+	now := time.Now()
+	r := newRateLimiter(&registryconfig.TokenRateLimit{
+		RequestsPerSecondPerIP: 10,
+		BurstPerIP:             10,
+		MaxFailures:            3,
+		FailureWindowSeconds:   60,
+		BanSeconds:             300,
+	})
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 100; i++ {
+		ip := fmt.Sprintf("10.0.%d.%d", i/256, i%256)
+		r.allow(ip, "")
+		r.recordFailure(ip, "")
+	}
+	for i := 0; i < 3; i++ {
+		r.recordFailure("10.1.0.1", "")
+	}
+	if len(r.ipLimiters) != 100 || len(r.ipFailures) != 101 {
+		t.Fatalf("unexpected tracked entries: %d limiters, %d failures", len(r.ipLimiters), len(r.ipFailures))
+	}
+
+	now = now.Add(2 * time.Minute)
+	r.allow("10.2.0.1", "")
+	if len(r.ipLimiters) != 1 {
+		t.Errorf("expected idle limiters to be evicted, got %d", len(r.ipLimiters))
+	}
+	if _, ok := r.ipFailures["10.1.0.1"]; !ok || len(r.ipFailures) != 1 {
+		t.Errorf("expected only the banned ip to be kept, got %d failure records", len(r.ipFailures))
+	}
+}
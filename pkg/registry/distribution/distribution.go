@@ -25,6 +25,7 @@ import (
 
 	"github.com/docker/distribution/configuration"
 	"github.com/docker/distribution/registry/handlers"
+	"k8s.io/apiserver/pkg/audit"
 	"k8s.io/apiserver/pkg/server/mux"
 	restclient "k8s.io/client-go/rest"
 	registryconfig "tkestack.io/tke/pkg/registry/apis/config"
@@ -59,6 +60,7 @@ type Options struct {
 	OIDCIssuerURL        string
 	OIDCTokenReviewPath  string
 	OIDCCAFile           string
+	AuditBackend         audit.Backend
 }
 
 // IgnoredAuthPathPrefixes returns a list of path prefixes that does not need to
@@ -92,6 +94,7 @@ func RegisterRoute(m *mux.PathRecorderMux, opts *Options) error {
 		DomainSuffix:    opts.RegistryConfig.DomainSuffix,
 		DefaultTenant:   opts.RegistryConfig.DefaultTenant,
 		LoopbackConfig:  opts.LoopbackClientConfig,
+		AuditBackend:    opts.AuditBackend,
 	})
 	if err != nil {
 		return err
@@ -41,4 +41,7 @@ var (
 	ConntrackToolsVersions         = []string{"1.4.4"}
 	NvidiaDriverVersions           = []string{"440.31"}
 	NvidiaContainerRuntimeVersions = []string{"3.1.4"}
+	KataContainersVersions         = []string{"2.0.4"}
+	GVisorVersions                 = []string{"20210301"}
+	WireGuardToolsVersions         = []string{"1.0.20210223"}
 )
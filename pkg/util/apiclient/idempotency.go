@@ -35,6 +35,8 @@ import (
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	extensionsv1beta1 "k8s.io/api/extensions/v1beta1"
+	networkingv1 "k8s.io/api/networking/v1"
+	nodev1beta1 "k8s.io/api/node/v1beta1"
 	rbac "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -605,6 +607,36 @@ func CreateOrUpdateMutatingWebhookConfiguration(ctx context.Context, client clie
 	return nil
 }
 
+// CreateOrUpdateRuntimeClass creates a RuntimeClass if the target resource doesn't exist. If the resource exists already, this function will update the resource instead.
+func CreateOrUpdateRuntimeClass(ctx context.Context, client clientset.Interface, obj *nodev1beta1.RuntimeClass) error {
+	if _, err := client.NodeV1beta1().RuntimeClasses().Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return errors.Wrap(err, "unable to create RuntimeClass")
+		}
+
+		if _, err := client.NodeV1beta1().RuntimeClasses().Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrap(err, "unable to update RuntimeClass")
+		}
+	}
+
+	return nil
+}
+
+// CreateOrUpdateNetworkPolicy creates a NetworkPolicy if the target resource doesn't exist. If the resource exists already, this function will update the resource instead.
+func CreateOrUpdateNetworkPolicy(ctx context.Context, client clientset.Interface, obj *networkingv1.NetworkPolicy) error {
+	if _, err := client.NetworkingV1().NetworkPolicies(obj.Namespace).Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return errors.Wrap(err, "unable to create NetworkPolicy")
+		}
+
+		if _, err := client.NetworkingV1().NetworkPolicies(obj.Namespace).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrap(err, "unable to update NetworkPolicy")
+		}
+	}
+
+	return nil
+}
+
 // MarkNode mark node by adding labels and taints
 func MarkNode(ctx context.Context, client clientset.Interface, nodeName string, labels map[string]string, taints []corev1.Taint) error {
 	return PatchNode(ctx, client, nodeName, func(n *corev1.Node) {
@@ -0,0 +1,80 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package apiclient
+
+import (
+	"context"
+	"crypto/x509"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	certutil "k8s.io/client-go/util/cert"
+	"tkestack.io/tke/pkg/util/pkiutil"
+)
+
+// WebhookCACertName is the key of the CA certificate in the secret of the
+// serving certificate of webhook.
+const WebhookCACertName = "ca.crt"
+
+// EnsureWebhookServingCert creates the serving certificate of the webhook
+// service in the secret if not exists, and returns the CA certificate which is
+// the CA bundle of the webhook configuration.
+func EnsureWebhookServingCert(ctx context.Context, client kubernetes.Interface, namespace, service, secretName string) ([]byte, error) {
+	secret, err := client.CoreV1().Secrets(namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err == nil && len(secret.Data[WebhookCACertName]) > 0 {
+		return secret.Data[WebhookCACertName], nil
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	caCert, caKey, err := pkiutil.NewCertificateAuthority(&certutil.Config{CommonName: service + "-ca"})
+	if err != nil {
+		return nil, err
+	}
+	cert, key, err := pkiutil.NewCertAndKey(caCert, caKey, &certutil.Config{
+		CommonName: service + "." + namespace + ".svc",
+		AltNames: certutil.AltNames{
+			DNSNames: []string{service, service + "." + namespace, service + "." + namespace + ".svc"},
+		},
+		Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	if err != nil {
+		return nil, err
+	}
+	caCertPEM := pkiutil.EncodeCertPEM(caCert)
+	err = CreateOrUpdateSecret(ctx, client, &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			WebhookCACertName:       caCertPEM,
+			corev1.TLSCertKey:       pkiutil.EncodeCertPEM(cert),
+			corev1.TLSPrivateKeyKey: pkiutil.EncodePrivateKeyPEM(key),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return caCertPEM, nil
+}
@@ -20,6 +20,7 @@ package resourcelock
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	applicationv1 "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
 	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
 	businessv1 "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
@@ -150,3 +151,15 @@ func NewMesh(name string, client meshv1.MeshV1Interface, rlc Config) Interface {
 		LockConfig: rlc,
 	}
 }
+
+// NewKube will create a lock of a given type according to the input parameters
+func NewKube(namespace, name string, client corev1.CoreV1Interface, rlc Config) Interface {
+	return &KubeConfigMapLock{
+		ConfigMapMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Client:     client,
+		LockConfig: rlc,
+	}
+}
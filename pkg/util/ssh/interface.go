@@ -30,6 +30,7 @@ type Interface interface {
 	CopyFile(src, dst string) error
 	WriteFile(src io.Reader, dst string) error
 	ReadFile(filename string) ([]byte, error)
+	OpenFile(filename string) (io.ReadCloser, error)
 	Exist(filename string) (bool, error)
 
 	LookPath(file string) (string, error)
@@ -231,6 +231,32 @@ func (s *SSH) ReadFile(filename string) ([]byte, error) {
 	return s.CombinedOutput(fmt.Sprintf("cat %s", filename))
 }
 
+// OpenFile opens the file through sftp to read it as a stream, which must be
+// closed by the caller.
+func (s *SSH) OpenFile(filename string) (io.ReadCloser, error) {
+	sftpClient, closer, err := s.newSFTPClient()
+	if err != nil {
+		return nil, err
+	}
+	file, err := sftpClient.Open(filename)
+	if err != nil {
+		closer()
+		return nil, err
+	}
+	return &sftpFile{File: file, closer: closer}, nil
+}
+
+// sftpFile closes the sftp client with the file.
+type sftpFile struct {
+	*sftp.File
+	closer func()
+}
+
+func (f *sftpFile) Close() error {
+	defer f.closer()
+	return f.File.Close()
+}
+
 func (s *SSH) Exist(filename string) (bool, error) {
 	_, _, exit, err := s.Execf("ls %s", filename)
 	if err != nil {
@@ -0,0 +1,78 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	authv1 "tkestack.io/tke/api/auth/v1"
+	"tkestack.io/tke/api/client/clientset/versioned"
+)
+
+// apiKeyPasswordPath is the path to apply an api key by the password of user,
+// which does not require the access credential.
+const apiKeyPasswordPath = "/apis/auth.tkestack.io/v1/apikeys/default/password"
+
+// APIKeyForPassword applies an access credential of the user by the password,
+// the Token of cfg is ignored. The credential expires after expire, which is
+// seven days if zero.
+func APIKeyForPassword(ctx context.Context, cfg *Config, tenantID, username, password string, expire time.Duration) (string, error) {
+	if cfg == nil || cfg.Host == "" {
+		return "", errors.New("host of the gateway is required")
+	}
+	clientset, err := versioned.NewForConfig(restConfig(cfg))
+	if err != nil {
+		return "", err
+	}
+	req := &authv1.APIKeyReqPassword{
+		TenantID:    tenantID,
+		Username:    username,
+		Password:    base64.StdEncoding.EncodeToString([]byte(password)),
+		Description: "applied by " + defaultUserAgent(),
+		Expire:      metav1.Duration{Duration: expire},
+	}
+	apiKey := &authv1.APIKey{}
+	if err := clientset.AuthV1().RESTClient().Post().
+		AbsPath(apiKeyPasswordPath).
+		Body(req).
+		Do(ctx).
+		Into(apiKey); err != nil {
+		return "", err
+	}
+	if apiKey.Spec.APIkey == "" {
+		return "", errors.New("no api key returned")
+	}
+	return apiKey.Spec.APIkey, nil
+}
+
+// NewForPassword applies an access credential by the password of user and
+// creates a client with it.
+func NewForPassword(ctx context.Context, cfg *Config, tenantID, username, password string) (*Client, error) {
+	token, err := APIKeyForPassword(ctx, cfg, tenantID, username, password, 0)
+	if err != nil {
+		return nil, err
+	}
+	config := *cfg
+	config.Token = token
+	return New(&config)
+}
@@ -0,0 +1,184 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"tkestack.io/tke/api/client/clientset/versioned"
+	applicationv1 "tkestack.io/tke/api/client/clientset/versioned/typed/application/v1"
+	authv1 "tkestack.io/tke/api/client/clientset/versioned/typed/auth/v1"
+	businessv1 "tkestack.io/tke/api/client/clientset/versioned/typed/business/v1"
+	platformv1 "tkestack.io/tke/api/client/clientset/versioned/typed/platform/v1"
+	registryv1 "tkestack.io/tke/api/client/clientset/versioned/typed/registry/v1"
+)
+
+const (
+	// clusterNameHeader is the header used by the gateway to proxy the
+	// requests of kubernetes resources to the cluster.
+	clusterNameHeader = "X-TKE-ClusterName"
+
+	defaultTimeout = 30 * time.Second
+	defaultQPS     = 20
+	defaultBurst   = 40
+)
+
+// Config holds the options to connect to the gateway of TKEStack.
+type Config struct {
+	// Host is the address of the gateway, such as https://console.tke.com.
+	Host string
+	// Token is the access credential applied in the console or by
+	// APIKeyForPassword, it is sent in the Authorization header.
+	Token string
+	// Insecure skips the verification of the server certificate.
+	Insecure bool
+	// CAData holds the PEM-encoded certificate authorities to verify the
+	// server certificate, the system roots are used if empty.
+	CAData []byte
+	// Timeout is the timeout of each request, which is 30s if zero.
+	Timeout time.Duration
+	// QPS and Burst limit the requests sent by the client.
+	QPS   float32
+	Burst int
+	// Retry is the policy to retry the failed requests, DefaultRetryPolicy is
+	// used if nil.
+	Retry *RetryPolicy
+	// UserAgent is the User-Agent header, which is tke-sdk-go/<version> if empty.
+	UserAgent string
+}
+
+// Client is the entry of the TKEStack APIs.
+type Client struct {
+	config    *rest.Config
+	clientset versioned.Interface
+}
+
+// New creates a client with the given config.
+func New(cfg *Config) (*Client, error) {
+	if cfg == nil || cfg.Host == "" {
+		return nil, errors.New("host of the gateway is required")
+	}
+	if cfg.Token == "" {
+		return nil, errors.New("token is required, apply one in the console or by APIKeyForPassword")
+	}
+	restConfig := restConfig(cfg)
+	restConfig.BearerToken = cfg.Token
+	clientset, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create clientset: %v", err)
+	}
+	return &Client{config: restConfig, clientset: clientset}, nil
+}
+
+func restConfig(cfg *Config) *rest.Config {
+	config := &rest.Config{
+		Host:      cfg.Host,
+		Timeout:   cfg.Timeout,
+		QPS:       cfg.QPS,
+		Burst:     cfg.Burst,
+		UserAgent: cfg.UserAgent,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: cfg.Insecure,
+			CAData:   cfg.CAData,
+		},
+	}
+	if config.Timeout == 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.QPS == 0 {
+		config.QPS = defaultQPS
+	}
+	if config.Burst == 0 {
+		config.Burst = defaultBurst
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = defaultUserAgent()
+	}
+	policy := cfg.Retry
+	if policy == nil {
+		policy = DefaultRetryPolicy()
+	}
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return newRetryRoundTripper(rt, policy)
+	}
+	return config
+}
+
+// Clientset returns the clientset of all the APIs, the typed clients below
+// are preferred since their APIs are stable across the SDK versions.
+func (c *Client) Clientset() versioned.Interface {
+	return c.clientset
+}
+
+// Platform returns the client of platform.tkestack.io/v1.
+func (c *Client) Platform() platformv1.PlatformV1Interface {
+	return c.clientset.PlatformV1()
+}
+
+// Business returns the client of business.tkestack.io/v1.
+func (c *Client) Business() businessv1.BusinessV1Interface {
+	return c.clientset.BusinessV1()
+}
+
+// Auth returns the client of auth.tkestack.io/v1.
+func (c *Client) Auth() authv1.AuthV1Interface {
+	return c.clientset.AuthV1()
+}
+
+// Registry returns the client of registry.tkestack.io/v1.
+func (c *Client) Registry() registryv1.RegistryV1Interface {
+	return c.clientset.RegistryV1()
+}
+
+// Application returns the client of application.tkestack.io/v1.
+func (c *Client) Application() applicationv1.ApplicationV1Interface {
+	return c.clientset.ApplicationV1()
+}
+
+// Cluster returns the kubernetes client of the cluster, whose requests are
+// proxied to the cluster by the gateway.
+func (c *Client) Cluster(clusterName string) (kubernetes.Interface, error) {
+	if clusterName == "" {
+		return nil, errors.New("cluster name is required")
+	}
+	config := rest.CopyConfig(c.config)
+	wrap := config.WrapTransport
+	config.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		return wrap(&headerRoundTripper{rt: rt, key: clusterNameHeader, value: clusterName})
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+// headerRoundTripper sets the header of all requests.
+type headerRoundTripper struct {
+	rt    http.RoundTripper
+	key   string
+	value string
+}
+
+func (h *headerRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(h.key, h.value)
+	return h.rt.RoundTrip(req)
+}
@@ -0,0 +1,43 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+// Package sdk provides a Go SDK to call the TKEStack APIs through the gateway,
+// it wraps the typed clients of the platform, business, auth, registry and
+// application APIs with the authentication, retry and pagination helpers, so
+// integrators only depend on the api and sdk packages of this repository.
+//
+// A client is created with the access credential applied in the console:
+//
+//	client, err := sdk.New(&sdk.Config{
+//		Host:  "https://console.tke.com",
+//		Token: "xxxxxxx",
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	it := sdk.NewIterator(func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+//		return client.Platform().Clusters().List(ctx, opts)
+//	}, metav1.ListOptions{})
+//	for it.Next(ctx) {
+//		cluster := it.Object().(*platformv1.Cluster)
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+package sdk // import "tkestack.io/tke/sdk"
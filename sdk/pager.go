@@ -0,0 +1,121 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DefaultPageSize is the number of objects got by each list request if the
+// limit is not set in the list options.
+const DefaultPageSize = 500
+
+// ListFunc lists a page of objects with the options, it is usually the List
+// method of a typed client.
+type ListFunc func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error)
+
+// Iterator iterates the objects of all pages returned by a ListFunc, the next
+// page is requested with the continue token of the last page once all the
+// objects of it are iterated.
+type Iterator struct {
+	list  ListFunc
+	opts  metav1.ListOptions
+	items []runtime.Object
+	index int
+	done  bool
+	err   error
+}
+
+// NewIterator returns an iterator to list the objects with the options.
+func NewIterator(list ListFunc, opts metav1.ListOptions) *Iterator {
+	if opts.Limit == 0 {
+		opts.Limit = DefaultPageSize
+	}
+	opts.Continue = ""
+	return &Iterator{list: list, opts: opts, index: -1}
+}
+
+// Next advances the iterator to the next object, it returns false when all the
+// objects are iterated or an error occurs, which is returned by Err.
+func (it *Iterator) Next(ctx context.Context) bool {
+	for it.err == nil {
+		if it.index+1 < len(it.items) {
+			it.index++
+			return true
+		}
+		if it.done {
+			return false
+		}
+		it.fetch(ctx)
+	}
+	return false
+}
+
+// Object returns the current object of the iterator, which is the pointer of
+// the item in the list object, such as *platformv1.Cluster.
+func (it *Iterator) Object() runtime.Object {
+	if it.index < 0 || it.index >= len(it.items) {
+		return nil
+	}
+	return it.items[it.index]
+}
+
+// Err returns the error occurred in iterating. The continue token expires if
+// iterating takes too long, and the error satisfies errors.IsResourceExpired.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+func (it *Iterator) fetch(ctx context.Context) {
+	obj, err := it.list(ctx, it.opts)
+	if err != nil {
+		it.err = err
+		return
+	}
+	listMeta, err := meta.ListAccessor(obj)
+	if err != nil {
+		it.err = err
+		return
+	}
+	items, err := meta.ExtractList(obj)
+	if err != nil {
+		it.err = err
+		return
+	}
+	it.items = items
+	it.index = -1
+	it.opts.Continue = listMeta.GetContinue()
+	it.done = it.opts.Continue == ""
+}
+
+// ForEach calls fn with each object listed by the ListFunc, it stops at the
+// first error returned by fn.
+func ForEach(ctx context.Context, list ListFunc, opts metav1.ListOptions, fn func(obj runtime.Object) error) error {
+	it := NewIterator(list, opts)
+	for it.Next(ctx) {
+		if err := fn(it.Object()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
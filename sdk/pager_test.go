@@ -0,0 +1,107 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+)
+
+// listClusters returns a ListFunc which pages total clusters.
+func listClusters(total int, calls *int) ListFunc {
+	return func(ctx context.Context, opts metav1.ListOptions) (runtime.Object, error) {
+		*calls++
+		start := 0
+		if opts.Continue != "" {
+			start, _ = strconv.Atoi(opts.Continue)
+		}
+		list := &platformv1.ClusterList{}
+		end := start + int(opts.Limit)
+		if end >= total {
+			end = total
+		} else {
+			list.Continue = strconv.Itoa(end)
+		}
+		for i := start; i < end; i++ {
+			list.Items = append(list.Items, platformv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("cls-%d", i)}})
+		}
+		return list, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		total     int
+		limit     int64
+		wantCalls int
+	}{
+		{0, 2, 1},
+		{1, 2, 1},
+		{4, 2, 2},
+		{5, 2, 3},
+		{5, 0, 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		it := NewIterator(listClusters(tt.total, &calls), metav1.ListOptions{Limit: tt.limit})
+		var names []string
+		for it.Next(context.Background()) {
+			names = append(names, it.Object().(*platformv1.Cluster).Name)
+		}
+		if err := it.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(names) != tt.total {
+			t.Errorf("total %d limit %d: got %d clusters", tt.total, tt.limit, len(names))
+		}
+		for i, name := range names {
+			if want := fmt.Sprintf("cls-%d", i); name != want {
+				t.Errorf("got cluster %s at %d, want %s", name, i, want)
+			}
+		}
+		if calls != tt.wantCalls {
+			t.Errorf("total %d limit %d: got %d calls, want %d", tt.total, tt.limit, calls, tt.wantCalls)
+		}
+	}
+}
+
+func TestForEachStopsOnError(t *testing.T) {
+	calls := 0
+	visited := 0
+	stop := fmt.Errorf("stop")
+	err := ForEach(context.Background(), listClusters(5, &calls), metav1.ListOptions{Limit: 2}, func(obj runtime.Object) error {
+		visited++
+		if visited == 3 {
+			return stop
+		}
+		return nil
+	})
+	if err != stop {
+		t.Errorf("got error %v, want %v", err, stop)
+	}
+	if calls != 2 {
+		t.Errorf("got %d calls, want 2", calls)
+	}
+}
@@ -0,0 +1,137 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy is the policy to retry the requests which failed for the
+// connection errors or the temporary errors of the server. Only the
+// idempotent requests are retried, the others are returned as is since they
+// may have been done by the server.
+type RetryPolicy struct {
+	// MaxRetries is the max times to retry a request, 0 disables retrying.
+	MaxRetries int
+	// InitialInterval is the interval before the first retry, which is
+	// doubled for each retry after that.
+	InitialInterval time.Duration
+	// MaxInterval caps the interval between the retries.
+	MaxInterval time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used if not configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:      3,
+		InitialInterval: 500 * time.Millisecond,
+		MaxInterval:     10 * time.Second,
+	}
+}
+
+// interval returns the interval before the retry'th retry, starting from 0.
+func (p *RetryPolicy) interval(retry int) time.Duration {
+	d := p.InitialInterval
+	for i := 0; i < retry; i++ {
+		d *= 2
+		if p.MaxInterval > 0 && d >= p.MaxInterval {
+			return p.MaxInterval
+		}
+	}
+	return d
+}
+
+var idempotentMethods = map[string]bool{
+	http.MethodGet:     true,
+	http.MethodHead:    true,
+	http.MethodOptions: true,
+	http.MethodPut:     true,
+	http.MethodDelete:  true,
+}
+
+var retryableStatusCodes = map[int]bool{
+	http.StatusTooManyRequests:    true,
+	http.StatusBadGateway:         true,
+	http.StatusServiceUnavailable: true,
+	http.StatusGatewayTimeout:     true,
+}
+
+type retryRoundTripper struct {
+	rt     http.RoundTripper
+	policy *RetryPolicy
+}
+
+func newRetryRoundTripper(rt http.RoundTripper, policy *RetryPolicy) http.RoundTripper {
+	return &retryRoundTripper{rt: rt, policy: policy}
+}
+
+func (r *retryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !r.retryable(req) {
+		return r.rt.RoundTrip(req)
+	}
+	for retry := 0; ; retry++ {
+		resp, err := r.rt.RoundTrip(req)
+		if retry >= r.policy.MaxRetries || (err == nil && !retryableStatusCodes[resp.StatusCode]) {
+			return resp, err
+		}
+		wait := r.policy.interval(retry)
+		if err == nil {
+			if after := retryAfter(resp); after > 0 {
+				wait = after
+			}
+			_, _ = io.Copy(ioutil.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+		if req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// retryable returns whether the request can be sent again, the body of it
+// must be able to be read again.
+func (r *retryRoundTripper) retryable(req *http.Request) bool {
+	if r.policy.MaxRetries <= 0 || !idempotentMethods[req.Method] {
+		return false
+	}
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// retryAfter returns the duration in the Retry-After header in seconds.
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
@@ -0,0 +1,84 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryRoundTripper(t *testing.T) {
+	policy := &RetryPolicy{MaxRetries: 2, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		wantCode int
+		wantReqs int32
+	}{
+		{"get succeeds after retries", http.MethodGet, []int{503, 502, 200}, 200, 3},
+		{"get gives up after max retries", http.MethodGet, []int{503, 503, 503, 200}, 503, 3},
+		{"put with body is retried", http.MethodPut, []int{429, 200}, 200, 2},
+		{"post is not retried", http.MethodPost, []int{503, 200}, 503, 1},
+		{"client error is not retried", http.MethodGet, []int{404, 200}, 404, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reqs int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&reqs, 1)
+				if body, _ := ioutil.ReadAll(r.Body); string(body) != "body" {
+					t.Errorf("request %d got body %q", n, body)
+				}
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(tt.method, server.URL, strings.NewReader("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := newRetryRoundTripper(http.DefaultTransport, policy).RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.wantCode {
+				t.Errorf("got status %d, want %d", resp.StatusCode, tt.wantCode)
+			}
+			if reqs != tt.wantReqs {
+				t.Errorf("got %d requests, want %d", reqs, tt.wantReqs)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyInterval(t *testing.T) {
+	p := &RetryPolicy{InitialInterval: time.Second, MaxInterval: 5 * time.Second}
+	for retry, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.interval(retry); got != want {
+			t.Errorf("interval(%d) = %v, want %v", retry, got, want)
+		}
+	}
+}
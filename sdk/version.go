@@ -0,0 +1,33 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package sdk
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Version is the version of the SDK, which follows the semantic versioning
+// and is bumped when the exported API of the SDK changes.
+const Version = "v1.0.0"
+
+// defaultUserAgent returns the User-Agent header sent by the SDK.
+func defaultUserAgent() string {
+	return fmt.Sprintf("tke-sdk-go/%s (%s/%s)", Version, runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,168 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+	"tkestack.io/tke/sdk"
+	"tkestack.io/tke/test/loadtest"
+)
+
+func main() {
+	var (
+		opts               loadtest.Options
+		host               = pflag.String("host", "", "Address of the platform API or the gateway")
+		token              = pflag.String("token", "", "Access credential to call the API")
+		insecure           = pflag.Bool("insecure", false, "Skip the verification of the server certificate")
+		machineEndpoints   = pflag.String("machine-endpoints", "", "File of the ssh endpoints of machines, one ip:port per line")
+		output             = pflag.String("output", "", "File to write the report, the report is printed if empty")
+		baseline           = pflag.String("baseline", "", "Baseline report to compare with, exits with 1 if regressed")
+		tolerance          = pflag.Float64("tolerance", 0.2, "Tolerance of the regression from the baseline")
+		profileTargets     = pflag.StringSlice("profile", nil, "Components to capture profiles in the run, name=https://host:port")
+		profilingTokenFile = pflag.String("profiling-token-file", "", "File with the profiling token of the components")
+		profileDir         = pflag.String("profile-dir", "profiles", "Directory to save the profiles")
+		profileDuration    = pflag.Duration("profile-duration", 30*time.Second, "Duration of the cpu profiles")
+	)
+	pflag.StringVar(&opts.RunID, "run-id", fmt.Sprintf("%d", time.Now().Unix()), "ID of the run, used to label the objects created")
+	pflag.IntVar(&opts.Clusters, "clusters", 100, "Number of the simulated clusters")
+	pflag.IntVar(&opts.Machines, "machines", 0, "Number of the machines, each of them requires a ssh endpoint")
+	pflag.StringVar(&opts.MachineUsername, "machine-username", "root", "SSH username of the machines")
+	pflag.StringVar(&opts.MachinePassword, "machine-password", "", "SSH password of the machines")
+	pflag.IntVar(&opts.Concurrency, "concurrency", 20, "Number of the concurrent requests")
+	pflag.DurationVar(&opts.Duration, "duration", 10*time.Minute, "Duration of the status churn")
+	pflag.DurationVar(&opts.ChurnInterval, "churn-interval", 10*time.Second, "Interval to update the status of each object")
+	pflag.DurationVar(&opts.ListInterval, "list-interval", 30*time.Second, "Interval to list all objects")
+	pflag.BoolVar(&opts.Cleanup, "cleanup", true, "Delete the objects created after the run")
+	pflag.Parse()
+
+	if *machineEndpoints != "" {
+		data, err := ioutil.ReadFile(*machineEndpoints)
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				opts.MachineEndpoints = append(opts.MachineEndpoints, line)
+			}
+		}
+	}
+
+	// the latency is measured without retries of the SDK
+	client, err := sdk.New(&sdk.Config{
+		Host:     *host,
+		Token:    *token,
+		Insecure: *insecure,
+		QPS:      -1,
+		Retry:    &sdk.RetryPolicy{},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-sigCh
+		log.Print("Interrupted, stop the run")
+		cancel()
+	}()
+
+	if len(*profileTargets) > 0 {
+		profiler, err := newProfiler(*profileTargets, *profilingTokenFile, *profileDir, *profileDuration)
+		if err != nil {
+			log.Fatal(err)
+		}
+		go func() {
+			// capture the profiles in the middle of the churn
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(opts.Duration / 2):
+			}
+			if err := profiler.Capture(ctx); err != nil {
+				log.Print(err)
+			}
+		}()
+	}
+
+	report, err := loadtest.NewRunner(client, opts).Run(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *output == "" {
+		fmt.Println(string(data))
+	} else if err := ioutil.WriteFile(*output, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+
+	if *baseline == "" {
+		return
+	}
+	data, err = ioutil.ReadFile(*baseline)
+	if err != nil {
+		log.Fatal(err)
+	}
+	base := &loadtest.Report{}
+	if err := json.Unmarshal(data, base); err != nil {
+		log.Fatal(err)
+	}
+	if regressions := loadtest.Compare(base, report, *tolerance); len(regressions) > 0 {
+		for _, r := range regressions {
+			log.Print(r)
+		}
+		os.Exit(1)
+	}
+}
+
+func newProfiler(targets []string, tokenFile string, dir string, duration time.Duration) (*loadtest.Profiler, error) {
+	token, err := ioutil.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("profiling token is required: %v", err)
+	}
+	profiler := &loadtest.Profiler{
+		Token:       strings.TrimSpace(string(token)),
+		Dir:         dir,
+		CPUDuration: duration,
+	}
+	for _, target := range targets {
+		parts := strings.SplitN(target, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid profile target %q, must be name=address", target)
+		}
+		profiler.Targets = append(profiler.Targets, loadtest.ProfileTarget{Name: parts[0], Address: parts[1]})
+	}
+	return profiler, nil
+}
@@ -0,0 +1,316 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	platformv1 "tkestack.io/tke/api/platform/v1"
+	"tkestack.io/tke/sdk"
+)
+
+const (
+	// RunLabel is the label of the objects created by a run, whose value is
+	// the run id.
+	RunLabel = "tkestack.io/loadtest-run"
+
+	// clusterType is the cluster provider of the simulated clusters, which
+	// does not connect to the clusters on creating.
+	clusterType = "Registered"
+	machineType = "Baremetal"
+
+	conditionType = "LoadTest"
+)
+
+// Options holds the options of a load test run.
+type Options struct {
+	// RunID identifies the objects created by the run.
+	RunID string
+	// Clusters is the number of the simulated clusters.
+	Clusters int
+	// Machines is the number of the machines spread over the clusters. The
+	// machines are validated by ssh on creating, so each of them requires an
+	// endpoint in MachineEndpoints, such as a container running sshd.
+	Machines         int
+	MachineEndpoints []string
+	MachineUsername  string
+	MachinePassword  string
+	// Concurrency is the number of the concurrent requests.
+	Concurrency int
+	// Duration is how long the status churn lasts after the objects created.
+	Duration time.Duration
+	// ChurnInterval is the interval to update the status of each object.
+	ChurnInterval time.Duration
+	// ListInterval is the interval to list all clusters and machines.
+	ListInterval time.Duration
+	// Cleanup deletes the objects created after the run.
+	Cleanup bool
+}
+
+// Validate returns an error if the options are invalid.
+func (o *Options) Validate() error {
+	if o.RunID == "" {
+		return fmt.Errorf("run id is required")
+	}
+	if o.Clusters <= 0 {
+		return fmt.Errorf("at least one cluster is required")
+	}
+	if o.Machines > len(o.MachineEndpoints) {
+		return fmt.Errorf("%d machines require as many ssh endpoints, got %d", o.Machines, len(o.MachineEndpoints))
+	}
+	if o.Concurrency <= 0 {
+		return fmt.Errorf("concurrency must be greater than 0")
+	}
+	if o.ChurnInterval <= 0 || o.ListInterval <= 0 {
+		return fmt.Errorf("churn and list intervals must be greater than 0")
+	}
+	return nil
+}
+
+// Runner creates the simulated clusters and machines through the platform API
+// and keeps updating their status, which makes the apiserver and controllers
+// busy as the real ones.
+type Runner struct {
+	client   *sdk.Client
+	opts     Options
+	recorder *Recorder
+	clusters []string
+	machines []string
+	seq      int64
+}
+
+// NewRunner creates a Runner with the client and options.
+func NewRunner(client *sdk.Client, opts Options) *Runner {
+	return &Runner{client: client, opts: opts, recorder: NewRecorder()}
+}
+
+// Run runs the load test and returns the report, the objects are deleted at
+// the end if Cleanup is set even if the run failed.
+func (r *Runner) Run(ctx context.Context) (*Report, error) {
+	if err := r.opts.Validate(); err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	err := r.run(ctx)
+	if r.opts.Cleanup {
+		r.cleanup(context.Background())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		RunID:      r.opts.RunID,
+		Clusters:   len(r.clusters),
+		Machines:   len(r.machines),
+		Duration:   time.Since(start).Round(time.Second).String(),
+		Operations: r.recorder.Stats(),
+	}, nil
+}
+
+func (r *Runner) run(ctx context.Context) error {
+	if err := r.createClusters(ctx); err != nil {
+		return err
+	}
+	if err := r.createMachines(ctx); err != nil {
+		return err
+	}
+	r.churn(ctx)
+	return nil
+}
+
+func (r *Runner) labels() map[string]string {
+	return map[string]string{RunLabel: r.opts.RunID}
+}
+
+func (r *Runner) createClusters(ctx context.Context) error {
+	names := make([]string, r.opts.Clusters)
+	var failed int32
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, r.opts.Clusters, func(i int) {
+		cluster := &platformv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "cls-",
+				Labels:       r.labels(),
+			},
+			Spec: platformv1.ClusterSpec{
+				DisplayName: fmt.Sprintf("loadtest-%s-%d", r.opts.RunID, i),
+				Type:        clusterType,
+			},
+		}
+		start := time.Now()
+		created, err := r.client.Platform().Clusters().Create(ctx, cluster, metav1.CreateOptions{})
+		r.recorder.Observe("cluster.create", start, err)
+		if err != nil {
+			atomic.AddInt32(&failed, 1)
+			return
+		}
+		names[i] = created.Name
+	})
+	for _, name := range names {
+		if name != "" {
+			r.clusters = append(r.clusters, name)
+		}
+	}
+	if len(r.clusters) == 0 {
+		return fmt.Errorf("failed to create any of %d clusters", failed)
+	}
+	return nil
+}
+
+func (r *Runner) createMachines(ctx context.Context) error {
+	if r.opts.Machines == 0 {
+		return nil
+	}
+	names := make([]string, r.opts.Machines)
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, r.opts.Machines, func(i int) {
+		host, port, err := net.SplitHostPort(r.opts.MachineEndpoints[i])
+		if err != nil {
+			r.recorder.Observe("machine.create", time.Now(), err)
+			return
+		}
+		p, err := strconv.Atoi(port)
+		if err != nil {
+			r.recorder.Observe("machine.create", time.Now(), err)
+			return
+		}
+		machine := &platformv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName: "mc-",
+				Labels:       r.labels(),
+			},
+			Spec: platformv1.MachineSpec{
+				ClusterName: r.clusters[i%len(r.clusters)],
+				Type:        machineType,
+				IP:          host,
+				Port:        int32(p),
+				Username:    r.opts.MachineUsername,
+				Password:    []byte(r.opts.MachinePassword),
+			},
+		}
+		start := time.Now()
+		created, err := r.client.Platform().Machines().Create(ctx, machine, metav1.CreateOptions{})
+		r.recorder.Observe("machine.create", start, err)
+		if err == nil {
+			names[i] = created.Name
+		}
+	})
+	for _, name := range names {
+		if name != "" {
+			r.machines = append(r.machines, name)
+		}
+	}
+	if len(r.machines) == 0 {
+		return fmt.Errorf("failed to create any of %d machines", r.opts.Machines)
+	}
+	return nil
+}
+
+// churn updates the status of all objects every ChurnInterval and lists them
+// every ListInterval until Duration elapsed.
+func (r *Runner) churn(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, r.opts.Duration)
+	defer cancel()
+	churnTicker := time.NewTicker(r.opts.ChurnInterval)
+	defer churnTicker.Stop()
+	listTicker := time.NewTicker(r.opts.ListInterval)
+	defer listTicker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-churnTicker.C:
+			r.updateStatus(ctx)
+		case <-listTicker.C:
+			r.list(ctx)
+		}
+	}
+}
+
+// statusPatch returns the strategic merge patch of the status conditions,
+// which does not conflict with the updates of the controllers.
+func (r *Runner) statusPatch() []byte {
+	seq := atomic.AddInt64(&r.seq, 1)
+	now := time.Now().UTC().Format(time.RFC3339)
+	return []byte(fmt.Sprintf(`{"status":{"conditions":[{"type":%q,"status":"True","lastProbeTime":%q,"message":"churn %d"}]}}`, conditionType, now, seq))
+}
+
+func (r *Runner) updateStatus(ctx context.Context) {
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, len(r.clusters), func(i int) {
+		start := time.Now()
+		_, err := r.client.Platform().Clusters().Patch(ctx, r.clusters[i], types.StrategicMergePatchType, r.statusPatch(), metav1.PatchOptions{}, "status")
+		r.observe(ctx, "cluster.patchStatus", start, err)
+		start = time.Now()
+		_, err = r.client.Platform().Clusters().Get(ctx, r.clusters[i], metav1.GetOptions{})
+		r.observe(ctx, "cluster.get", start, err)
+	})
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, len(r.machines), func(i int) {
+		start := time.Now()
+		_, err := r.client.Platform().Machines().Patch(ctx, r.machines[i], types.StrategicMergePatchType, r.statusPatch(), metav1.PatchOptions{}, "status")
+		r.observe(ctx, "machine.patchStatus", start, err)
+	})
+}
+
+func (r *Runner) list(ctx context.Context) {
+	selector := metav1.FormatLabelSelector(&metav1.LabelSelector{MatchLabels: r.labels()})
+	start := time.Now()
+	_, err := r.client.Platform().Clusters().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	r.observe(ctx, "cluster.list", start, err)
+	if len(r.machines) == 0 {
+		return
+	}
+	start = time.Now()
+	_, err = r.client.Platform().Machines().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	r.observe(ctx, "machine.list", start, err)
+}
+
+func (r *Runner) cleanup(ctx context.Context) {
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, len(r.machines), func(i int) {
+		start := time.Now()
+		err := r.client.Platform().Machines().Delete(ctx, r.machines[i], metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			err = nil
+		}
+		r.recorder.Observe("machine.delete", start, err)
+	})
+	workqueue.ParallelizeUntil(ctx, r.opts.Concurrency, len(r.clusters), func(i int) {
+		start := time.Now()
+		err := r.client.Platform().Clusters().Delete(ctx, r.clusters[i], metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			err = nil
+		}
+		r.recorder.Observe("cluster.delete", start, err)
+	})
+}
+
+// observe records the operation unless it is canceled at the end of the
+// churn, which is not a failure of the server.
+func (r *Runner) observe(ctx context.Context, op string, start time.Time, err error) {
+	if ctx.Err() != nil {
+		return
+	}
+	r.recorder.Observe(op, start, err)
+}
@@ -0,0 +1,113 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// ProfileTarget is a component whose profiles are captured during the run,
+// the profiling of it must be enabled with the token.
+type ProfileTarget struct {
+	// Name is the prefix of the profile files, such as tke-platform-api.
+	Name string
+	// Address is the address of the secure port, such as https://127.0.0.1:9443.
+	Address string
+}
+
+// Profiler captures the cpu and heap profiles of the targets.
+type Profiler struct {
+	Targets []ProfileTarget
+	// Token is the bearer token set by --profiling-token-file of the targets.
+	Token string
+	// Dir is the directory to save the profiles.
+	Dir string
+	// CPUDuration is how long the cpu profile lasts.
+	CPUDuration time.Duration
+
+	client *http.Client
+}
+
+// Capture captures the profiles of all targets concurrently, it returns after
+// the cpu profiles are done.
+func (p *Profiler) Capture(ctx context.Context) error {
+	if p.client == nil {
+		p.client = &http.Client{
+			Transport: &http.Transport{
+				// the components are usually served with self-signed certificates
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+			},
+		}
+	}
+	if err := os.MkdirAll(p.Dir, 0755); err != nil {
+		return err
+	}
+	errCh := make(chan error, len(p.Targets))
+	for _, target := range p.Targets {
+		go func(target ProfileTarget) {
+			seconds := int(p.CPUDuration.Seconds())
+			if err := p.fetch(ctx, target, fmt.Sprintf("profile?seconds=%d", seconds), "cpu"); err != nil {
+				errCh <- err
+				return
+			}
+			errCh <- p.fetch(ctx, target, "heap", "heap")
+		}(target)
+	}
+	var errs []error
+	for range p.Targets {
+		if err := <-errCh; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to capture profiles: %v", errs)
+	}
+	return nil
+}
+
+func (p *Profiler) fetch(ctx context.Context, target ProfileTarget, path string, kind string) error {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/debug/pprof/%s", target.Address, path), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Authorization", "Bearer "+p.Token)
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s: %v", target.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: get %s profile returned %s", target.Name, kind, resp.Status)
+	}
+	f, err := os.Create(filepath.Join(p.Dir, fmt.Sprintf("%s.%s.pprof", target.Name, kind)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(f, resp.Body)
+	return err
+}
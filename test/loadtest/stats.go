@@ -0,0 +1,149 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OperationStats is the latency distribution of an operation in milliseconds.
+type OperationStats struct {
+	Count  int     `json:"count"`
+	Errors int     `json:"errors"`
+	P50    float64 `json:"p50Ms"`
+	P90    float64 `json:"p90Ms"`
+	P99    float64 `json:"p99Ms"`
+	Max    float64 `json:"maxMs"`
+}
+
+// Report is the result of a load test run, which is also the format of the
+// baselines.
+type Report struct {
+	RunID      string                    `json:"runID"`
+	Clusters   int                       `json:"clusters"`
+	Machines   int                       `json:"machines"`
+	Duration   string                    `json:"duration"`
+	Operations map[string]OperationStats `json:"operations"`
+}
+
+// Recorder records the latency and errors of the operations, it is safe for
+// concurrent use.
+type Recorder struct {
+	mu      sync.Mutex
+	samples map[string][]time.Duration
+	errors  map[string]int
+}
+
+// NewRecorder creates an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		samples: make(map[string][]time.Duration),
+		errors:  make(map[string]int),
+	}
+}
+
+// Observe records an operation started at start, the failed operations are
+// counted as errors and not included in the latency.
+func (r *Recorder) Observe(op string, start time.Time, err error) {
+	d := time.Since(start)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.errors[op]++
+		return
+	}
+	r.samples[op] = append(r.samples[op], d)
+}
+
+// Stats returns the stats of all operations observed.
+func (r *Recorder) Stats() map[string]OperationStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stats := make(map[string]OperationStats)
+	for op, errs := range r.errors {
+		stats[op] = OperationStats{Count: errs, Errors: errs}
+	}
+	for op, samples := range r.samples {
+		sorted := make([]time.Duration, len(samples))
+		copy(sorted, samples)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		s := stats[op]
+		s.Count += len(sorted)
+		s.P50 = milliseconds(percentile(sorted, 0.5))
+		s.P90 = milliseconds(percentile(sorted, 0.9))
+		s.P99 = milliseconds(percentile(sorted, 0.99))
+		s.Max = milliseconds(sorted[len(sorted)-1])
+		stats[op] = s
+	}
+	return stats
+}
+
+// percentile returns the p-th percentile of the sorted durations by the
+// nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	return sorted[rank]
+}
+
+func milliseconds(d time.Duration) float64 {
+	return math.Round(float64(d)/float64(time.Microsecond)) / 1000
+}
+
+// Compare returns the regressions of current from baseline, an operation
+// regresses if its p99 latency or error rate exceeds the baseline by more than
+// tolerance, such as 0.2 for 20%. The operations not in baseline are ignored.
+func Compare(baseline, current *Report, tolerance float64) []string {
+	var regressions []string
+	ops := make([]string, 0, len(baseline.Operations))
+	for op := range baseline.Operations {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+	for _, op := range ops {
+		base := baseline.Operations[op]
+		cur, ok := current.Operations[op]
+		if !ok {
+			continue
+		}
+		if base.P99 > 0 && cur.P99 > base.P99*(1+tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s: p99 %.3fms exceeds baseline %.3fms", op, cur.P99, base.P99))
+		}
+		if errorRate(cur) > errorRate(base)+tolerance*errorRate(base) && cur.Errors > 0 {
+			regressions = append(regressions, fmt.Sprintf("%s: error rate %.4f exceeds baseline %.4f", op, errorRate(cur), errorRate(base)))
+		}
+	}
+	return regressions
+}
+
+func errorRate(s OperationStats) float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Count)
+}
@@ -0,0 +1,90 @@
+/*
+ * Tencent is pleased to support the open source community by making TKEStack
+ * available.
+ *
+ * Copyright (C) 2012-2019 Tencent. All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use
+ * this file except in compliance with the License. You may obtain a copy of the
+ * License at
+ *
+ * https://opensource.org/licenses/Apache-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+ * WARRANTIES OF ANY KIND, either express or implied.  See the License for the
+ * specific language governing permissions and limitations under the License.
+ */
+
+package loadtest
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, time.Millisecond},
+		{0.5, 50 * time.Millisecond},
+		{0.9, 90 * time.Millisecond},
+		{0.99, 99 * time.Millisecond},
+		{1, 100 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		if got := percentile(sorted, tt.p); got != tt.want {
+			t.Errorf("percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("percentile of empty = %v, want 0", got)
+	}
+}
+
+func TestRecorderStats(t *testing.T) {
+	r := NewRecorder()
+	start := time.Now().Add(-time.Second)
+	r.Observe("get", start, nil)
+	r.Observe("get", start, nil)
+	r.Observe("get", start, errors.New("failed"))
+	r.Observe("create", start, errors.New("failed"))
+
+	stats := r.Stats()
+	if get := stats["get"]; get.Count != 3 || get.Errors != 1 || get.P50 <= 0 {
+		t.Errorf("unexpected stats of get: %+v", get)
+	}
+	if create := stats["create"]; create.Count != 1 || create.Errors != 1 || create.P50 != 0 {
+		t.Errorf("unexpected stats of create: %+v", create)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := &Report{Operations: map[string]OperationStats{
+		"cluster.get":    {Count: 100, P99: 10},
+		"cluster.list":   {Count: 100, P99: 100},
+		"cluster.create": {Count: 100, Errors: 10, P99: 50},
+		"machine.create": {Count: 100, P99: 50},
+	}}
+	current := &Report{Operations: map[string]OperationStats{
+		"cluster.get":    {Count: 100, P99: 11.9},
+		"cluster.list":   {Count: 100, P99: 130},
+		"cluster.create": {Count: 100, Errors: 20, P99: 50},
+		"cluster.delete": {Count: 100, P99: 1000},
+	}}
+	want := []string{
+		"cluster.create: error rate 0.2000 exceeds baseline 0.1000",
+		"cluster.list: p99 130.000ms exceeds baseline 100.000ms",
+	}
+	if got := Compare(baseline, current, 0.2); !reflect.DeepEqual(got, want) {
+		t.Errorf("Compare() = %v, want %v", got, want)
+	}
+}